@@ -66,10 +66,224 @@ func (x *_GenesisState_2_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]*AccountActivity
+}
+
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AccountActivity)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AccountActivity)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	v := new(AccountActivity)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := new(AccountActivity)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*AccountProfile
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AccountProfile)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AccountProfile)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(AccountProfile)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(AccountProfile)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]*AccountAuthenticators
+}
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AccountAuthenticators)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AccountAuthenticators)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	v := new(AccountAuthenticators)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := new(AccountAuthenticators)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*PubKeyRotation
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PubKeyRotation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PubKeyRotation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(PubKeyRotation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(PubKeyRotation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState          protoreflect.MessageDescriptor
-	fd_GenesisState_params   protoreflect.FieldDescriptor
-	fd_GenesisState_accounts protoreflect.FieldDescriptor
+	md_GenesisState                        protoreflect.MessageDescriptor
+	fd_GenesisState_params                 protoreflect.FieldDescriptor
+	fd_GenesisState_accounts               protoreflect.FieldDescriptor
+	fd_GenesisState_gas_pricing_policy     protoreflect.FieldDescriptor
+	fd_GenesisState_account_activities     protoreflect.FieldDescriptor
+	fd_GenesisState_profiles               protoreflect.FieldDescriptor
+	fd_GenesisState_fee_market_params      protoreflect.FieldDescriptor
+	fd_GenesisState_fee_market_state       protoreflect.FieldDescriptor
+	fd_GenesisState_fee_abstraction_params protoreflect.FieldDescriptor
+	fd_GenesisState_authenticators         protoreflect.FieldDescriptor
+	fd_GenesisState_pub_key_rotations      protoreflect.FieldDescriptor
+	fd_GenesisState_msg_surcharge_params   protoreflect.FieldDescriptor
+	fd_GenesisState_fee_routing_params     protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -77,6 +291,16 @@ func init() {
 	md_GenesisState = File_cosmos_auth_v1beta1_genesis_proto.Messages().ByName("GenesisState")
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
 	fd_GenesisState_accounts = md_GenesisState.Fields().ByName("accounts")
+	fd_GenesisState_gas_pricing_policy = md_GenesisState.Fields().ByName("gas_pricing_policy")
+	fd_GenesisState_account_activities = md_GenesisState.Fields().ByName("account_activities")
+	fd_GenesisState_profiles = md_GenesisState.Fields().ByName("profiles")
+	fd_GenesisState_fee_market_params = md_GenesisState.Fields().ByName("fee_market_params")
+	fd_GenesisState_fee_market_state = md_GenesisState.Fields().ByName("fee_market_state")
+	fd_GenesisState_fee_abstraction_params = md_GenesisState.Fields().ByName("fee_abstraction_params")
+	fd_GenesisState_authenticators = md_GenesisState.Fields().ByName("authenticators")
+	fd_GenesisState_pub_key_rotations = md_GenesisState.Fields().ByName("pub_key_rotations")
+	fd_GenesisState_msg_surcharge_params = md_GenesisState.Fields().ByName("msg_surcharge_params")
+	fd_GenesisState_fee_routing_params = md_GenesisState.Fields().ByName("fee_routing_params")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -156,6 +380,66 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.GasPricingPolicy != nil {
+		value := protoreflect.ValueOfMessage(x.GasPricingPolicy.ProtoReflect())
+		if !f(fd_GenesisState_gas_pricing_policy, value) {
+			return
+		}
+	}
+	if len(x.AccountActivities) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.AccountActivities})
+		if !f(fd_GenesisState_account_activities, value) {
+			return
+		}
+	}
+	if len(x.Profiles) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.Profiles})
+		if !f(fd_GenesisState_profiles, value) {
+			return
+		}
+	}
+	if x.FeeMarketParams != nil {
+		value := protoreflect.ValueOfMessage(x.FeeMarketParams.ProtoReflect())
+		if !f(fd_GenesisState_fee_market_params, value) {
+			return
+		}
+	}
+	if x.FeeMarketState != nil {
+		value := protoreflect.ValueOfMessage(x.FeeMarketState.ProtoReflect())
+		if !f(fd_GenesisState_fee_market_state, value) {
+			return
+		}
+	}
+	if x.FeeAbstractionParams != nil {
+		value := protoreflect.ValueOfMessage(x.FeeAbstractionParams.ProtoReflect())
+		if !f(fd_GenesisState_fee_abstraction_params, value) {
+			return
+		}
+	}
+	if len(x.Authenticators) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.Authenticators})
+		if !f(fd_GenesisState_authenticators, value) {
+			return
+		}
+	}
+	if len(x.PubKeyRotations) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.PubKeyRotations})
+		if !f(fd_GenesisState_pub_key_rotations, value) {
+			return
+		}
+	}
+	if x.MsgSurchargeParams != nil {
+		value := protoreflect.ValueOfMessage(x.MsgSurchargeParams.ProtoReflect())
+		if !f(fd_GenesisState_msg_surcharge_params, value) {
+			return
+		}
+	}
+	if x.FeeRoutingParams != nil {
+		value := protoreflect.ValueOfMessage(x.FeeRoutingParams.ProtoReflect())
+		if !f(fd_GenesisState_fee_routing_params, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -175,6 +459,26 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.Params != nil
 	case "cosmos.auth.v1beta1.GenesisState.accounts":
 		return len(x.Accounts) != 0
+	case "cosmos.auth.v1beta1.GenesisState.gas_pricing_policy":
+		return x.GasPricingPolicy != nil
+	case "cosmos.auth.v1beta1.GenesisState.account_activities":
+		return len(x.AccountActivities) != 0
+	case "cosmos.auth.v1beta1.GenesisState.profiles":
+		return len(x.Profiles) != 0
+	case "cosmos.auth.v1beta1.GenesisState.fee_market_params":
+		return x.FeeMarketParams != nil
+	case "cosmos.auth.v1beta1.GenesisState.fee_market_state":
+		return x.FeeMarketState != nil
+	case "cosmos.auth.v1beta1.GenesisState.fee_abstraction_params":
+		return x.FeeAbstractionParams != nil
+	case "cosmos.auth.v1beta1.GenesisState.authenticators":
+		return len(x.Authenticators) != 0
+	case "cosmos.auth.v1beta1.GenesisState.pub_key_rotations":
+		return len(x.PubKeyRotations) != 0
+	case "cosmos.auth.v1beta1.GenesisState.msg_surcharge_params":
+		return x.MsgSurchargeParams != nil
+	case "cosmos.auth.v1beta1.GenesisState.fee_routing_params":
+		return x.FeeRoutingParams != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.GenesisState"))
@@ -195,6 +499,26 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.Params = nil
 	case "cosmos.auth.v1beta1.GenesisState.accounts":
 		x.Accounts = nil
+	case "cosmos.auth.v1beta1.GenesisState.gas_pricing_policy":
+		x.GasPricingPolicy = nil
+	case "cosmos.auth.v1beta1.GenesisState.account_activities":
+		x.AccountActivities = nil
+	case "cosmos.auth.v1beta1.GenesisState.profiles":
+		x.Profiles = nil
+	case "cosmos.auth.v1beta1.GenesisState.fee_market_params":
+		x.FeeMarketParams = nil
+	case "cosmos.auth.v1beta1.GenesisState.fee_market_state":
+		x.FeeMarketState = nil
+	case "cosmos.auth.v1beta1.GenesisState.fee_abstraction_params":
+		x.FeeAbstractionParams = nil
+	case "cosmos.auth.v1beta1.GenesisState.authenticators":
+		x.Authenticators = nil
+	case "cosmos.auth.v1beta1.GenesisState.pub_key_rotations":
+		x.PubKeyRotations = nil
+	case "cosmos.auth.v1beta1.GenesisState.msg_surcharge_params":
+		x.MsgSurchargeParams = nil
+	case "cosmos.auth.v1beta1.GenesisState.fee_routing_params":
+		x.FeeRoutingParams = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.GenesisState"))
@@ -220,6 +544,48 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 		}
 		listValue := &_GenesisState_2_list{list: &x.Accounts}
 		return protoreflect.ValueOfList(listValue)
+	case "cosmos.auth.v1beta1.GenesisState.gas_pricing_policy":
+		value := x.GasPricingPolicy
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.auth.v1beta1.GenesisState.account_activities":
+		if len(x.AccountActivities) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.AccountActivities}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.auth.v1beta1.GenesisState.profiles":
+		if len(x.Profiles) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.Profiles}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.auth.v1beta1.GenesisState.fee_market_params":
+		value := x.FeeMarketParams
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.auth.v1beta1.GenesisState.fee_market_state":
+		value := x.FeeMarketState
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.auth.v1beta1.GenesisState.fee_abstraction_params":
+		value := x.FeeAbstractionParams
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.auth.v1beta1.GenesisState.authenticators":
+		if len(x.Authenticators) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.Authenticators}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.auth.v1beta1.GenesisState.pub_key_rotations":
+		if len(x.PubKeyRotations) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.PubKeyRotations}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.auth.v1beta1.GenesisState.msg_surcharge_params":
+		value := x.MsgSurchargeParams
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.auth.v1beta1.GenesisState.fee_routing_params":
+		value := x.FeeRoutingParams
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.GenesisState"))
@@ -246,6 +612,34 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_GenesisState_2_list)
 		x.Accounts = *clv.list
+	case "cosmos.auth.v1beta1.GenesisState.gas_pricing_policy":
+		x.GasPricingPolicy = value.Message().Interface().(*GasPricingPolicy)
+	case "cosmos.auth.v1beta1.GenesisState.account_activities":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.AccountActivities = *clv.list
+	case "cosmos.auth.v1beta1.GenesisState.profiles":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.Profiles = *clv.list
+	case "cosmos.auth.v1beta1.GenesisState.fee_market_params":
+		x.FeeMarketParams = value.Message().Interface().(*FeeMarketParams)
+	case "cosmos.auth.v1beta1.GenesisState.fee_market_state":
+		x.FeeMarketState = value.Message().Interface().(*FeeMarketState)
+	case "cosmos.auth.v1beta1.GenesisState.fee_abstraction_params":
+		x.FeeAbstractionParams = value.Message().Interface().(*FeeAbstractionParams)
+	case "cosmos.auth.v1beta1.GenesisState.authenticators":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.Authenticators = *clv.list
+	case "cosmos.auth.v1beta1.GenesisState.pub_key_rotations":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.PubKeyRotations = *clv.list
+	case "cosmos.auth.v1beta1.GenesisState.msg_surcharge_params":
+		x.MsgSurchargeParams = value.Message().Interface().(*MsgSurchargeParams)
+	case "cosmos.auth.v1beta1.GenesisState.fee_routing_params":
+		x.FeeRoutingParams = value.Message().Interface().(*FeeRoutingParams)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.GenesisState"))
@@ -277,6 +671,60 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_2_list{list: &x.Accounts}
 		return protoreflect.ValueOfList(value)
+	case "cosmos.auth.v1beta1.GenesisState.gas_pricing_policy":
+		if x.GasPricingPolicy == nil {
+			x.GasPricingPolicy = new(GasPricingPolicy)
+		}
+		return protoreflect.ValueOfMessage(x.GasPricingPolicy.ProtoReflect())
+	case "cosmos.auth.v1beta1.GenesisState.account_activities":
+		if x.AccountActivities == nil {
+			x.AccountActivities = []*AccountActivity{}
+		}
+		value := &_GenesisState_4_list{list: &x.AccountActivities}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.auth.v1beta1.GenesisState.profiles":
+		if x.Profiles == nil {
+			x.Profiles = []*AccountProfile{}
+		}
+		value := &_GenesisState_5_list{list: &x.Profiles}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.auth.v1beta1.GenesisState.fee_market_params":
+		if x.FeeMarketParams == nil {
+			x.FeeMarketParams = new(FeeMarketParams)
+		}
+		return protoreflect.ValueOfMessage(x.FeeMarketParams.ProtoReflect())
+	case "cosmos.auth.v1beta1.GenesisState.fee_market_state":
+		if x.FeeMarketState == nil {
+			x.FeeMarketState = new(FeeMarketState)
+		}
+		return protoreflect.ValueOfMessage(x.FeeMarketState.ProtoReflect())
+	case "cosmos.auth.v1beta1.GenesisState.fee_abstraction_params":
+		if x.FeeAbstractionParams == nil {
+			x.FeeAbstractionParams = new(FeeAbstractionParams)
+		}
+		return protoreflect.ValueOfMessage(x.FeeAbstractionParams.ProtoReflect())
+	case "cosmos.auth.v1beta1.GenesisState.authenticators":
+		if x.Authenticators == nil {
+			x.Authenticators = []*AccountAuthenticators{}
+		}
+		value := &_GenesisState_9_list{list: &x.Authenticators}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.auth.v1beta1.GenesisState.pub_key_rotations":
+		if x.PubKeyRotations == nil {
+			x.PubKeyRotations = []*PubKeyRotation{}
+		}
+		value := &_GenesisState_10_list{list: &x.PubKeyRotations}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.auth.v1beta1.GenesisState.msg_surcharge_params":
+		if x.MsgSurchargeParams == nil {
+			x.MsgSurchargeParams = new(MsgSurchargeParams)
+		}
+		return protoreflect.ValueOfMessage(x.MsgSurchargeParams.ProtoReflect())
+	case "cosmos.auth.v1beta1.GenesisState.fee_routing_params":
+		if x.FeeRoutingParams == nil {
+			x.FeeRoutingParams = new(FeeRoutingParams)
+		}
+		return protoreflect.ValueOfMessage(x.FeeRoutingParams.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.GenesisState"))
@@ -296,6 +744,36 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "cosmos.auth.v1beta1.GenesisState.accounts":
 		list := []*anypb.Any{}
 		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+	case "cosmos.auth.v1beta1.GenesisState.gas_pricing_policy":
+		m := new(GasPricingPolicy)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.auth.v1beta1.GenesisState.account_activities":
+		list := []*AccountActivity{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "cosmos.auth.v1beta1.GenesisState.profiles":
+		list := []*AccountProfile{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "cosmos.auth.v1beta1.GenesisState.fee_market_params":
+		m := new(FeeMarketParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.auth.v1beta1.GenesisState.fee_market_state":
+		m := new(FeeMarketState)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.auth.v1beta1.GenesisState.fee_abstraction_params":
+		m := new(FeeAbstractionParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.auth.v1beta1.GenesisState.authenticators":
+		list := []*AccountAuthenticators{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "cosmos.auth.v1beta1.GenesisState.pub_key_rotations":
+		list := []*PubKeyRotation{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "cosmos.auth.v1beta1.GenesisState.msg_surcharge_params":
+		m := new(MsgSurchargeParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.auth.v1beta1.GenesisState.fee_routing_params":
+		m := new(FeeRoutingParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.GenesisState"))
@@ -375,6 +853,54 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.GasPricingPolicy != nil {
+			l = options.Size(x.GasPricingPolicy)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.AccountActivities) > 0 {
+			for _, e := range x.AccountActivities {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Profiles) > 0 {
+			for _, e := range x.Profiles {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.FeeMarketParams != nil {
+			l = options.Size(x.FeeMarketParams)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.FeeMarketState != nil {
+			l = options.Size(x.FeeMarketState)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.FeeAbstractionParams != nil {
+			l = options.Size(x.FeeAbstractionParams)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Authenticators) > 0 {
+			for _, e := range x.Authenticators {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PubKeyRotations) > 0 {
+			for _, e := range x.PubKeyRotations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MsgSurchargeParams != nil {
+			l = options.Size(x.MsgSurchargeParams)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.FeeRoutingParams != nil {
+			l = options.Size(x.FeeRoutingParams)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -404,22 +930,170 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Accounts) > 0 {
-			for iNdEx := len(x.Accounts) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Accounts[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
-		}
+		if x.FeeRoutingParams != nil {
+			encoded, err := options.Marshal(x.FeeRoutingParams)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.MsgSurchargeParams != nil {
+			encoded, err := options.Marshal(x.MsgSurchargeParams)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.PubKeyRotations) > 0 {
+			for iNdEx := len(x.PubKeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PubKeyRotations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.Authenticators) > 0 {
+			for iNdEx := len(x.Authenticators) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Authenticators[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if x.FeeAbstractionParams != nil {
+			encoded, err := options.Marshal(x.FeeAbstractionParams)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.FeeMarketState != nil {
+			encoded, err := options.Marshal(x.FeeMarketState)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.FeeMarketParams != nil {
+			encoded, err := options.Marshal(x.FeeMarketParams)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Profiles) > 0 {
+			for iNdEx := len(x.Profiles) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Profiles[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.AccountActivities) > 0 {
+			for iNdEx := len(x.AccountActivities) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AccountActivities[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.GasPricingPolicy != nil {
+			encoded, err := options.Marshal(x.GasPricingPolicy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Accounts) > 0 {
+			for iNdEx := len(x.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Accounts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
 		if x.Params != nil {
 			encoded, err := options.Marshal(x.Params)
 			if err != nil {
@@ -553,6 +1227,358 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasPricingPolicy", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.GasPricingPolicy == nil {
+					x.GasPricingPolicy = &GasPricingPolicy{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GasPricingPolicy); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccountActivities", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AccountActivities = append(x.AccountActivities, &AccountActivity{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AccountActivities[len(x.AccountActivities)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Profiles", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Profiles = append(x.Profiles, &AccountProfile{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Profiles[len(x.Profiles)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeMarketParams", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FeeMarketParams == nil {
+					x.FeeMarketParams = &FeeMarketParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeMarketParams); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeMarketState", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FeeMarketState == nil {
+					x.FeeMarketState = &FeeMarketState{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeMarketState); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeAbstractionParams", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FeeAbstractionParams == nil {
+					x.FeeAbstractionParams = &FeeAbstractionParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeAbstractionParams); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authenticators", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authenticators = append(x.Authenticators, &AccountAuthenticators{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Authenticators[len(x.Authenticators)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PubKeyRotations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PubKeyRotations = append(x.PubKeyRotations, &PubKeyRotation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PubKeyRotations[len(x.PubKeyRotations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgSurchargeParams", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MsgSurchargeParams == nil {
+					x.MsgSurchargeParams = &MsgSurchargeParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MsgSurchargeParams); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeRoutingParams", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FeeRoutingParams == nil {
+					x.FeeRoutingParams = &FeeRoutingParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeRoutingParams); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -611,6 +1637,26 @@ type GenesisState struct {
 	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
 	// accounts are the accounts present at genesis.
 	Accounts []*anypb.Any `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// gas_pricing_policy defines the tiered gas pricing policy.
+	GasPricingPolicy *GasPricingPolicy `protobuf:"bytes,3,opt,name=gas_pricing_policy,json=gasPricingPolicy,proto3" json:"gas_pricing_policy,omitempty"`
+	// account_activities are the tracked account activities.
+	AccountActivities []*AccountActivity `protobuf:"bytes,4,rep,name=account_activities,json=accountActivities,proto3" json:"account_activities,omitempty"`
+	// profiles are the account profiles.
+	Profiles []*AccountProfile `protobuf:"bytes,5,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	// fee_market_params defines the parameters of the fee market.
+	FeeMarketParams *FeeMarketParams `protobuf:"bytes,6,opt,name=fee_market_params,json=feeMarketParams,proto3" json:"fee_market_params,omitempty"`
+	// fee_market_state defines the state of the fee market.
+	FeeMarketState *FeeMarketState `protobuf:"bytes,7,opt,name=fee_market_state,json=feeMarketState,proto3" json:"fee_market_state,omitempty"`
+	// fee_abstraction_params defines the fee denoms converted to the native fee denom.
+	FeeAbstractionParams *FeeAbstractionParams `protobuf:"bytes,8,opt,name=fee_abstraction_params,json=feeAbstractionParams,proto3" json:"fee_abstraction_params,omitempty"`
+	// authenticators are the authenticators of the smart accounts.
+	Authenticators []*AccountAuthenticators `protobuf:"bytes,9,rep,name=authenticators,proto3" json:"authenticators,omitempty"`
+	// pub_key_rotations are the public key rotations of the accounts.
+	PubKeyRotations []*PubKeyRotation `protobuf:"bytes,10,rep,name=pub_key_rotations,json=pubKeyRotations,proto3" json:"pub_key_rotations,omitempty"`
+	// msg_surcharge_params defines the surcharges applied to the messages of given types.
+	MsgSurchargeParams *MsgSurchargeParams `protobuf:"bytes,11,opt,name=msg_surcharge_params,json=msgSurchargeParams,proto3" json:"msg_surcharge_params,omitempty"`
+	// fee_routing_params defines how the transaction fees are split between sinks.
+	FeeRoutingParams *FeeRoutingParams `protobuf:"bytes,12,opt,name=fee_routing_params,json=feeRoutingParams,proto3" json:"fee_routing_params,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -647,6 +1693,76 @@ func (x *GenesisState) GetAccounts() []*anypb.Any {
 	return nil
 }
 
+func (x *GenesisState) GetGasPricingPolicy() *GasPricingPolicy {
+	if x != nil {
+		return x.GasPricingPolicy
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAccountActivities() []*AccountActivity {
+	if x != nil {
+		return x.AccountActivities
+	}
+	return nil
+}
+
+func (x *GenesisState) GetProfiles() []*AccountProfile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+func (x *GenesisState) GetFeeMarketParams() *FeeMarketParams {
+	if x != nil {
+		return x.FeeMarketParams
+	}
+	return nil
+}
+
+func (x *GenesisState) GetFeeMarketState() *FeeMarketState {
+	if x != nil {
+		return x.FeeMarketState
+	}
+	return nil
+}
+
+func (x *GenesisState) GetFeeAbstractionParams() *FeeAbstractionParams {
+	if x != nil {
+		return x.FeeAbstractionParams
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAuthenticators() []*AccountAuthenticators {
+	if x != nil {
+		return x.Authenticators
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPubKeyRotations() []*PubKeyRotation {
+	if x != nil {
+		return x.PubKeyRotations
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMsgSurchargeParams() *MsgSurchargeParams {
+	if x != nil {
+		return x.MsgSurchargeParams
+	}
+	return nil
+}
+
+func (x *GenesisState) GetFeeRoutingParams() *FeeRoutingParams {
+	if x != nil {
+		return x.FeeRoutingParams
+	}
+	return nil
+}
+
 var File_cosmos_auth_v1beta1_genesis_proto protoreflect.FileDescriptor
 
 var file_cosmos_auth_v1beta1_genesis_proto_rawDesc = []byte{
@@ -659,7 +1775,7 @@ var file_cosmos_auth_v1beta1_genesis_proto_rawDesc = []byte{
 	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x61,
 	0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f,
-	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x80, 0x01, 0x0a,
+	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb0, 0x08, 0x0a,
 	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3e, 0x0a,
 	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x62, 0x65,
@@ -667,7 +1783,66 @@ var file_cosmos_auth_v1beta1_genesis_proto_rawDesc = []byte{
 	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x30, 0x0a,
 	0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x42,
+	0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12,
+	0x5e, 0x0a, 0x12, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x5f, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x67,
+	0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x5e, 0x0a, 0x12, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12,
+	0x4a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x5b, 0x0a, 0x11, 0x66,
+	0x65, 0x65, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x46, 0x65, 0x65,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde,
+	0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x66, 0x65, 0x65, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x58, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x6a, 0x0a, 0x16, 0x66, 0x65, 0x65, 0x5f, 0x61, 0x62, 0x73, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x41, 0x62, 0x73, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8,
+	0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14, 0x66, 0x65, 0x65, 0x41, 0x62, 0x73,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x5d,
+	0x0a, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x61,
+	0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x5a, 0x0a,
+	0x11, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50,
+	0x75, 0x62, 0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0xc8,
+	0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79,
+	0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x64, 0x0a, 0x14, 0x6d, 0x73, 0x67,
+	0x5f, 0x73, 0x75, 0x72, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x53, 0x75, 0x72, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x12, 0x6d, 0x73, 0x67,
+	0x53, 0x75, 0x72, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x5e, 0x0a, 0x12, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x66,
+	0x65, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42,
 	0xc7, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61,
 	0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e,
 	0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73,
@@ -698,18 +1873,38 @@ func file_cosmos_auth_v1beta1_genesis_proto_rawDescGZIP() []byte {
 
 var file_cosmos_auth_v1beta1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_cosmos_auth_v1beta1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: cosmos.auth.v1beta1.GenesisState
-	(*Params)(nil),       // 1: cosmos.auth.v1beta1.Params
-	(*anypb.Any)(nil),    // 2: google.protobuf.Any
+	(*GenesisState)(nil),          // 0: cosmos.auth.v1beta1.GenesisState
+	(*Params)(nil),                // 1: cosmos.auth.v1beta1.Params
+	(*anypb.Any)(nil),             // 2: google.protobuf.Any
+	(*GasPricingPolicy)(nil),      // 3: cosmos.auth.v1beta1.GasPricingPolicy
+	(*AccountActivity)(nil),       // 4: cosmos.auth.v1beta1.AccountActivity
+	(*AccountProfile)(nil),        // 5: cosmos.auth.v1beta1.AccountProfile
+	(*FeeMarketParams)(nil),       // 6: cosmos.auth.v1beta1.FeeMarketParams
+	(*FeeMarketState)(nil),        // 7: cosmos.auth.v1beta1.FeeMarketState
+	(*FeeAbstractionParams)(nil),  // 8: cosmos.auth.v1beta1.FeeAbstractionParams
+	(*AccountAuthenticators)(nil), // 9: cosmos.auth.v1beta1.AccountAuthenticators
+	(*PubKeyRotation)(nil),        // 10: cosmos.auth.v1beta1.PubKeyRotation
+	(*MsgSurchargeParams)(nil),    // 11: cosmos.auth.v1beta1.MsgSurchargeParams
+	(*FeeRoutingParams)(nil),      // 12: cosmos.auth.v1beta1.FeeRoutingParams
 }
 var file_cosmos_auth_v1beta1_genesis_proto_depIdxs = []int32{
-	1, // 0: cosmos.auth.v1beta1.GenesisState.params:type_name -> cosmos.auth.v1beta1.Params
-	2, // 1: cosmos.auth.v1beta1.GenesisState.accounts:type_name -> google.protobuf.Any
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	1,  // 0: cosmos.auth.v1beta1.GenesisState.params:type_name -> cosmos.auth.v1beta1.Params
+	2,  // 1: cosmos.auth.v1beta1.GenesisState.accounts:type_name -> google.protobuf.Any
+	3,  // 2: cosmos.auth.v1beta1.GenesisState.gas_pricing_policy:type_name -> cosmos.auth.v1beta1.GasPricingPolicy
+	4,  // 3: cosmos.auth.v1beta1.GenesisState.account_activities:type_name -> cosmos.auth.v1beta1.AccountActivity
+	5,  // 4: cosmos.auth.v1beta1.GenesisState.profiles:type_name -> cosmos.auth.v1beta1.AccountProfile
+	6,  // 5: cosmos.auth.v1beta1.GenesisState.fee_market_params:type_name -> cosmos.auth.v1beta1.FeeMarketParams
+	7,  // 6: cosmos.auth.v1beta1.GenesisState.fee_market_state:type_name -> cosmos.auth.v1beta1.FeeMarketState
+	8,  // 7: cosmos.auth.v1beta1.GenesisState.fee_abstraction_params:type_name -> cosmos.auth.v1beta1.FeeAbstractionParams
+	9,  // 8: cosmos.auth.v1beta1.GenesisState.authenticators:type_name -> cosmos.auth.v1beta1.AccountAuthenticators
+	10, // 9: cosmos.auth.v1beta1.GenesisState.pub_key_rotations:type_name -> cosmos.auth.v1beta1.PubKeyRotation
+	11, // 10: cosmos.auth.v1beta1.GenesisState.msg_surcharge_params:type_name -> cosmos.auth.v1beta1.MsgSurchargeParams
+	12, // 11: cosmos.auth.v1beta1.GenesisState.fee_routing_params:type_name -> cosmos.auth.v1beta1.FeeRoutingParams
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_auth_v1beta1_genesis_proto_init() }
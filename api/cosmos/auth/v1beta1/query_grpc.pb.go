@@ -29,6 +29,15 @@ const (
 	Query_AddressBytesToString_FullMethodName = "/cosmos.auth.v1beta1.Query/AddressBytesToString"
 	Query_AddressStringToBytes_FullMethodName = "/cosmos.auth.v1beta1.Query/AddressStringToBytes"
 	Query_AccountInfo_FullMethodName          = "/cosmos.auth.v1beta1.Query/AccountInfo"
+	Query_GasPricingPolicy_FullMethodName     = "/cosmos.auth.v1beta1.Query/GasPricingPolicy"
+	Query_AccountActivity_FullMethodName      = "/cosmos.auth.v1beta1.Query/AccountActivity"
+	Query_Profile_FullMethodName              = "/cosmos.auth.v1beta1.Query/Profile"
+	Query_BaseFee_FullMethodName              = "/cosmos.auth.v1beta1.Query/BaseFee"
+	Query_FeeAbstractionParams_FullMethodName = "/cosmos.auth.v1beta1.Query/FeeAbstractionParams"
+	Query_Authenticators_FullMethodName       = "/cosmos.auth.v1beta1.Query/Authenticators"
+	Query_PubKeyRotations_FullMethodName      = "/cosmos.auth.v1beta1.Query/PubKeyRotations"
+	Query_MsgSurchargeParams_FullMethodName   = "/cosmos.auth.v1beta1.Query/MsgSurchargeParams"
+	Query_FeeRoutingParams_FullMethodName     = "/cosmos.auth.v1beta1.Query/FeeRoutingParams"
 )
 
 // QueryClient is the client API for Query service.
@@ -72,6 +81,28 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error)
+	// GasPricingPolicy returns the tiered gas pricing policy.
+	GasPricingPolicy(ctx context.Context, in *QueryGasPricingPolicyRequest, opts ...grpc.CallOption) (*QueryGasPricingPolicyResponse, error)
+	// AccountActivity returns the tracked activity of an account and the fee
+	// multiplier currently applied to its transactions.
+	AccountActivity(ctx context.Context, in *QueryAccountActivityRequest, opts ...grpc.CallOption) (*QueryAccountActivityResponse, error)
+	// Profile returns the profile of an account.
+	Profile(ctx context.Context, in *QueryProfileRequest, opts ...grpc.CallOption) (*QueryProfileResponse, error)
+	// BaseFee returns the fee market parameters and the base fee of the current
+	// block.
+	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
+	// FeeAbstractionParams returns the fee denoms converted to the native fee
+	// denom and their conversion rates.
+	FeeAbstractionParams(ctx context.Context, in *QueryFeeAbstractionParamsRequest, opts ...grpc.CallOption) (*QueryFeeAbstractionParamsResponse, error)
+	// Authenticators returns the authenticators of a smart account.
+	Authenticators(ctx context.Context, in *QueryAuthenticatorsRequest, opts ...grpc.CallOption) (*QueryAuthenticatorsResponse, error)
+	// PubKeyRotations returns the public key rotation history of an account.
+	PubKeyRotations(ctx context.Context, in *QueryPubKeyRotationsRequest, opts ...grpc.CallOption) (*QueryPubKeyRotationsResponse, error)
+	// MsgSurchargeParams returns the surcharges applied to the messages of given
+	// types.
+	MsgSurchargeParams(ctx context.Context, in *QueryMsgSurchargeParamsRequest, opts ...grpc.CallOption) (*QueryMsgSurchargeParamsResponse, error)
+	// FeeRoutingParams returns how the transaction fees are split between sinks.
+	FeeRoutingParams(ctx context.Context, in *QueryFeeRoutingParamsRequest, opts ...grpc.CallOption) (*QueryFeeRoutingParamsResponse, error)
 }
 
 type queryClient struct {
@@ -172,6 +203,87 @@ func (c *queryClient) AccountInfo(ctx context.Context, in *QueryAccountInfoReque
 	return out, nil
 }
 
+func (c *queryClient) GasPricingPolicy(ctx context.Context, in *QueryGasPricingPolicyRequest, opts ...grpc.CallOption) (*QueryGasPricingPolicyResponse, error) {
+	out := new(QueryGasPricingPolicyResponse)
+	err := c.cc.Invoke(ctx, Query_GasPricingPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountActivity(ctx context.Context, in *QueryAccountActivityRequest, opts ...grpc.CallOption) (*QueryAccountActivityResponse, error) {
+	out := new(QueryAccountActivityResponse)
+	err := c.cc.Invoke(ctx, Query_AccountActivity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Profile(ctx context.Context, in *QueryProfileRequest, opts ...grpc.CallOption) (*QueryProfileResponse, error) {
+	out := new(QueryProfileResponse)
+	err := c.cc.Invoke(ctx, Query_Profile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
+	out := new(QueryBaseFeeResponse)
+	err := c.cc.Invoke(ctx, Query_BaseFee_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeAbstractionParams(ctx context.Context, in *QueryFeeAbstractionParamsRequest, opts ...grpc.CallOption) (*QueryFeeAbstractionParamsResponse, error) {
+	out := new(QueryFeeAbstractionParamsResponse)
+	err := c.cc.Invoke(ctx, Query_FeeAbstractionParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Authenticators(ctx context.Context, in *QueryAuthenticatorsRequest, opts ...grpc.CallOption) (*QueryAuthenticatorsResponse, error) {
+	out := new(QueryAuthenticatorsResponse)
+	err := c.cc.Invoke(ctx, Query_Authenticators_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PubKeyRotations(ctx context.Context, in *QueryPubKeyRotationsRequest, opts ...grpc.CallOption) (*QueryPubKeyRotationsResponse, error) {
+	out := new(QueryPubKeyRotationsResponse)
+	err := c.cc.Invoke(ctx, Query_PubKeyRotations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MsgSurchargeParams(ctx context.Context, in *QueryMsgSurchargeParamsRequest, opts ...grpc.CallOption) (*QueryMsgSurchargeParamsResponse, error) {
+	out := new(QueryMsgSurchargeParamsResponse)
+	err := c.cc.Invoke(ctx, Query_MsgSurchargeParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeRoutingParams(ctx context.Context, in *QueryFeeRoutingParamsRequest, opts ...grpc.CallOption) (*QueryFeeRoutingParamsResponse, error) {
+	out := new(QueryFeeRoutingParamsResponse)
+	err := c.cc.Invoke(ctx, Query_FeeRoutingParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -213,6 +325,28 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error)
+	// GasPricingPolicy returns the tiered gas pricing policy.
+	GasPricingPolicy(context.Context, *QueryGasPricingPolicyRequest) (*QueryGasPricingPolicyResponse, error)
+	// AccountActivity returns the tracked activity of an account and the fee
+	// multiplier currently applied to its transactions.
+	AccountActivity(context.Context, *QueryAccountActivityRequest) (*QueryAccountActivityResponse, error)
+	// Profile returns the profile of an account.
+	Profile(context.Context, *QueryProfileRequest) (*QueryProfileResponse, error)
+	// BaseFee returns the fee market parameters and the base fee of the current
+	// block.
+	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
+	// FeeAbstractionParams returns the fee denoms converted to the native fee
+	// denom and their conversion rates.
+	FeeAbstractionParams(context.Context, *QueryFeeAbstractionParamsRequest) (*QueryFeeAbstractionParamsResponse, error)
+	// Authenticators returns the authenticators of a smart account.
+	Authenticators(context.Context, *QueryAuthenticatorsRequest) (*QueryAuthenticatorsResponse, error)
+	// PubKeyRotations returns the public key rotation history of an account.
+	PubKeyRotations(context.Context, *QueryPubKeyRotationsRequest) (*QueryPubKeyRotationsResponse, error)
+	// MsgSurchargeParams returns the surcharges applied to the messages of given
+	// types.
+	MsgSurchargeParams(context.Context, *QueryMsgSurchargeParamsRequest) (*QueryMsgSurchargeParamsResponse, error)
+	// FeeRoutingParams returns how the transaction fees are split between sinks.
+	FeeRoutingParams(context.Context, *QueryFeeRoutingParamsRequest) (*QueryFeeRoutingParamsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -250,6 +384,33 @@ func (UnimplementedQueryServer) AddressStringToBytes(context.Context, *AddressSt
 func (UnimplementedQueryServer) AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
 }
+func (UnimplementedQueryServer) GasPricingPolicy(context.Context, *QueryGasPricingPolicyRequest) (*QueryGasPricingPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPricingPolicy not implemented")
+}
+func (UnimplementedQueryServer) AccountActivity(context.Context, *QueryAccountActivityRequest) (*QueryAccountActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountActivity not implemented")
+}
+func (UnimplementedQueryServer) Profile(context.Context, *QueryProfileRequest) (*QueryProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Profile not implemented")
+}
+func (UnimplementedQueryServer) BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
+}
+func (UnimplementedQueryServer) FeeAbstractionParams(context.Context, *QueryFeeAbstractionParamsRequest) (*QueryFeeAbstractionParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeAbstractionParams not implemented")
+}
+func (UnimplementedQueryServer) Authenticators(context.Context, *QueryAuthenticatorsRequest) (*QueryAuthenticatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Authenticators not implemented")
+}
+func (UnimplementedQueryServer) PubKeyRotations(context.Context, *QueryPubKeyRotationsRequest) (*QueryPubKeyRotationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PubKeyRotations not implemented")
+}
+func (UnimplementedQueryServer) MsgSurchargeParams(context.Context, *QueryMsgSurchargeParamsRequest) (*QueryMsgSurchargeParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgSurchargeParams not implemented")
+}
+func (UnimplementedQueryServer) FeeRoutingParams(context.Context, *QueryFeeRoutingParamsRequest) (*QueryFeeRoutingParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeRoutingParams not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -443,6 +604,168 @@ func _Query_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GasPricingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGasPricingPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasPricingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GasPricingPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasPricingPolicy(ctx, req.(*QueryGasPricingPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountActivity(ctx, req.(*QueryAccountActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Profile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Profile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Profile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Profile(ctx, req.(*QueryProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BaseFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFee(ctx, req.(*QueryBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeAbstractionParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeAbstractionParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeAbstractionParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeeAbstractionParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeAbstractionParams(ctx, req.(*QueryFeeAbstractionParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Authenticators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuthenticatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Authenticators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Authenticators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Authenticators(ctx, req.(*QueryAuthenticatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PubKeyRotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPubKeyRotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PubKeyRotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PubKeyRotations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PubKeyRotations(ctx, req.(*QueryPubKeyRotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MsgSurchargeParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMsgSurchargeParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MsgSurchargeParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MsgSurchargeParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MsgSurchargeParams(ctx, req.(*QueryMsgSurchargeParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeRoutingParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeRoutingParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeRoutingParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeeRoutingParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeRoutingParams(ctx, req.(*QueryFeeRoutingParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -490,6 +813,42 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AccountInfo",
 			Handler:    _Query_AccountInfo_Handler,
 		},
+		{
+			MethodName: "GasPricingPolicy",
+			Handler:    _Query_GasPricingPolicy_Handler,
+		},
+		{
+			MethodName: "AccountActivity",
+			Handler:    _Query_AccountActivity_Handler,
+		},
+		{
+			MethodName: "Profile",
+			Handler:    _Query_Profile_Handler,
+		},
+		{
+			MethodName: "BaseFee",
+			Handler:    _Query_BaseFee_Handler,
+		},
+		{
+			MethodName: "FeeAbstractionParams",
+			Handler:    _Query_FeeAbstractionParams_Handler,
+		},
+		{
+			MethodName: "Authenticators",
+			Handler:    _Query_Authenticators_Handler,
+		},
+		{
+			MethodName: "PubKeyRotations",
+			Handler:    _Query_PubKeyRotations_Handler,
+		},
+		{
+			MethodName: "MsgSurchargeParams",
+			Handler:    _Query_MsgSurchargeParams_Handler,
+		},
+		{
+			MethodName: "FeeRoutingParams",
+			Handler:    _Query_FeeRoutingParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
@@ -19,7 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName = "/cosmos.auth.v1beta1.Msg/UpdateParams"
+	Msg_UpdateParams_FullMethodName               = "/cosmos.auth.v1beta1.Msg/UpdateParams"
+	Msg_UpdateGasPricingPolicy_FullMethodName     = "/cosmos.auth.v1beta1.Msg/UpdateGasPricingPolicy"
+	Msg_SetProfile_FullMethodName                 = "/cosmos.auth.v1beta1.Msg/SetProfile"
+	Msg_UpdateFeeMarketParams_FullMethodName      = "/cosmos.auth.v1beta1.Msg/UpdateFeeMarketParams"
+	Msg_UpdateFeeAbstractionParams_FullMethodName = "/cosmos.auth.v1beta1.Msg/UpdateFeeAbstractionParams"
+	Msg_AddAuthenticator_FullMethodName           = "/cosmos.auth.v1beta1.Msg/AddAuthenticator"
+	Msg_RemoveAuthenticator_FullMethodName        = "/cosmos.auth.v1beta1.Msg/RemoveAuthenticator"
+	Msg_RotatePubKey_FullMethodName               = "/cosmos.auth.v1beta1.Msg/RotatePubKey"
+	Msg_UpdateMsgSurchargeParams_FullMethodName   = "/cosmos.auth.v1beta1.Msg/UpdateMsgSurchargeParams"
+	Msg_UpdateFeeRoutingParams_FullMethodName     = "/cosmos.auth.v1beta1.Msg/UpdateFeeRoutingParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -31,6 +40,35 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateGasPricingPolicy defines a (governance) operation for updating the
+	// tiered gas pricing policy. The authority defaults to the x/gov module account.
+	UpdateGasPricingPolicy(ctx context.Context, in *MsgUpdateGasPricingPolicy, opts ...grpc.CallOption) (*MsgUpdateGasPricingPolicyResponse, error)
+	// SetProfile defines a method for an account to set, or clear, its profile.
+	SetProfile(ctx context.Context, in *MsgSetProfile, opts ...grpc.CallOption) (*MsgSetProfileResponse, error)
+	// UpdateFeeMarketParams defines a (governance) operation for updating the
+	// fee market parameters. The authority defaults to the x/gov module account.
+	UpdateFeeMarketParams(ctx context.Context, in *MsgUpdateFeeMarketParams, opts ...grpc.CallOption) (*MsgUpdateFeeMarketParamsResponse, error)
+	// UpdateFeeAbstractionParams defines a (governance) operation for updating the
+	// fee denoms converted to the native fee denom and their conversion rates. The
+	// authority defaults to the x/gov module account.
+	UpdateFeeAbstractionParams(ctx context.Context, in *MsgUpdateFeeAbstractionParams, opts ...grpc.CallOption) (*MsgUpdateFeeAbstractionParamsResponse, error)
+	// AddAuthenticator defines a method for an account to register an
+	// authenticator, making it a smart account.
+	AddAuthenticator(ctx context.Context, in *MsgAddAuthenticator, opts ...grpc.CallOption) (*MsgAddAuthenticatorResponse, error)
+	// RemoveAuthenticator defines a method for a smart account to remove one of
+	// its authenticators.
+	RemoveAuthenticator(ctx context.Context, in *MsgRemoveAuthenticator, opts ...grpc.CallOption) (*MsgRemoveAuthenticatorResponse, error)
+	// RotatePubKey defines a method for an account to replace its public key,
+	// keeping its address, account number and sequence.
+	RotatePubKey(ctx context.Context, in *MsgRotatePubKey, opts ...grpc.CallOption) (*MsgRotatePubKeyResponse, error)
+	// UpdateMsgSurchargeParams defines a (governance) operation for updating the
+	// surcharges applied to the messages of given types. The authority defaults
+	// to the x/gov module account.
+	UpdateMsgSurchargeParams(ctx context.Context, in *MsgUpdateMsgSurchargeParams, opts ...grpc.CallOption) (*MsgUpdateMsgSurchargeParamsResponse, error)
+	// UpdateFeeRoutingParams defines a (governance) operation for updating how
+	// the transaction fees are split between sinks. The authority defaults to
+	// the x/gov module account.
+	UpdateFeeRoutingParams(ctx context.Context, in *MsgUpdateFeeRoutingParams, opts ...grpc.CallOption) (*MsgUpdateFeeRoutingParamsResponse, error)
 }
 
 type msgClient struct {
@@ -50,6 +88,87 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateGasPricingPolicy(ctx context.Context, in *MsgUpdateGasPricingPolicy, opts ...grpc.CallOption) (*MsgUpdateGasPricingPolicyResponse, error) {
+	out := new(MsgUpdateGasPricingPolicyResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateGasPricingPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetProfile(ctx context.Context, in *MsgSetProfile, opts ...grpc.CallOption) (*MsgSetProfileResponse, error) {
+	out := new(MsgSetProfileResponse)
+	err := c.cc.Invoke(ctx, Msg_SetProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateFeeMarketParams(ctx context.Context, in *MsgUpdateFeeMarketParams, opts ...grpc.CallOption) (*MsgUpdateFeeMarketParamsResponse, error) {
+	out := new(MsgUpdateFeeMarketParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateFeeMarketParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateFeeAbstractionParams(ctx context.Context, in *MsgUpdateFeeAbstractionParams, opts ...grpc.CallOption) (*MsgUpdateFeeAbstractionParamsResponse, error) {
+	out := new(MsgUpdateFeeAbstractionParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateFeeAbstractionParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddAuthenticator(ctx context.Context, in *MsgAddAuthenticator, opts ...grpc.CallOption) (*MsgAddAuthenticatorResponse, error) {
+	out := new(MsgAddAuthenticatorResponse)
+	err := c.cc.Invoke(ctx, Msg_AddAuthenticator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAuthenticator(ctx context.Context, in *MsgRemoveAuthenticator, opts ...grpc.CallOption) (*MsgRemoveAuthenticatorResponse, error) {
+	out := new(MsgRemoveAuthenticatorResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveAuthenticator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RotatePubKey(ctx context.Context, in *MsgRotatePubKey, opts ...grpc.CallOption) (*MsgRotatePubKeyResponse, error) {
+	out := new(MsgRotatePubKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_RotatePubKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateMsgSurchargeParams(ctx context.Context, in *MsgUpdateMsgSurchargeParams, opts ...grpc.CallOption) (*MsgUpdateMsgSurchargeParamsResponse, error) {
+	out := new(MsgUpdateMsgSurchargeParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateMsgSurchargeParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateFeeRoutingParams(ctx context.Context, in *MsgUpdateFeeRoutingParams, opts ...grpc.CallOption) (*MsgUpdateFeeRoutingParamsResponse, error) {
+	out := new(MsgUpdateFeeRoutingParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateFeeRoutingParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -59,6 +178,35 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateGasPricingPolicy defines a (governance) operation for updating the
+	// tiered gas pricing policy. The authority defaults to the x/gov module account.
+	UpdateGasPricingPolicy(context.Context, *MsgUpdateGasPricingPolicy) (*MsgUpdateGasPricingPolicyResponse, error)
+	// SetProfile defines a method for an account to set, or clear, its profile.
+	SetProfile(context.Context, *MsgSetProfile) (*MsgSetProfileResponse, error)
+	// UpdateFeeMarketParams defines a (governance) operation for updating the
+	// fee market parameters. The authority defaults to the x/gov module account.
+	UpdateFeeMarketParams(context.Context, *MsgUpdateFeeMarketParams) (*MsgUpdateFeeMarketParamsResponse, error)
+	// UpdateFeeAbstractionParams defines a (governance) operation for updating the
+	// fee denoms converted to the native fee denom and their conversion rates. The
+	// authority defaults to the x/gov module account.
+	UpdateFeeAbstractionParams(context.Context, *MsgUpdateFeeAbstractionParams) (*MsgUpdateFeeAbstractionParamsResponse, error)
+	// AddAuthenticator defines a method for an account to register an
+	// authenticator, making it a smart account.
+	AddAuthenticator(context.Context, *MsgAddAuthenticator) (*MsgAddAuthenticatorResponse, error)
+	// RemoveAuthenticator defines a method for a smart account to remove one of
+	// its authenticators.
+	RemoveAuthenticator(context.Context, *MsgRemoveAuthenticator) (*MsgRemoveAuthenticatorResponse, error)
+	// RotatePubKey defines a method for an account to replace its public key,
+	// keeping its address, account number and sequence.
+	RotatePubKey(context.Context, *MsgRotatePubKey) (*MsgRotatePubKeyResponse, error)
+	// UpdateMsgSurchargeParams defines a (governance) operation for updating the
+	// surcharges applied to the messages of given types. The authority defaults
+	// to the x/gov module account.
+	UpdateMsgSurchargeParams(context.Context, *MsgUpdateMsgSurchargeParams) (*MsgUpdateMsgSurchargeParamsResponse, error)
+	// UpdateFeeRoutingParams defines a (governance) operation for updating how
+	// the transaction fees are split between sinks. The authority defaults to
+	// the x/gov module account.
+	UpdateFeeRoutingParams(context.Context, *MsgUpdateFeeRoutingParams) (*MsgUpdateFeeRoutingParamsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -69,6 +217,33 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) UpdateGasPricingPolicy(context.Context, *MsgUpdateGasPricingPolicy) (*MsgUpdateGasPricingPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGasPricingPolicy not implemented")
+}
+func (UnimplementedMsgServer) SetProfile(context.Context, *MsgSetProfile) (*MsgSetProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProfile not implemented")
+}
+func (UnimplementedMsgServer) UpdateFeeMarketParams(context.Context, *MsgUpdateFeeMarketParams) (*MsgUpdateFeeMarketParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeeMarketParams not implemented")
+}
+func (UnimplementedMsgServer) UpdateFeeAbstractionParams(context.Context, *MsgUpdateFeeAbstractionParams) (*MsgUpdateFeeAbstractionParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeeAbstractionParams not implemented")
+}
+func (UnimplementedMsgServer) AddAuthenticator(context.Context, *MsgAddAuthenticator) (*MsgAddAuthenticatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAuthenticator not implemented")
+}
+func (UnimplementedMsgServer) RemoveAuthenticator(context.Context, *MsgRemoveAuthenticator) (*MsgRemoveAuthenticatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAuthenticator not implemented")
+}
+func (UnimplementedMsgServer) RotatePubKey(context.Context, *MsgRotatePubKey) (*MsgRotatePubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotatePubKey not implemented")
+}
+func (UnimplementedMsgServer) UpdateMsgSurchargeParams(context.Context, *MsgUpdateMsgSurchargeParams) (*MsgUpdateMsgSurchargeParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMsgSurchargeParams not implemented")
+}
+func (UnimplementedMsgServer) UpdateFeeRoutingParams(context.Context, *MsgUpdateFeeRoutingParams) (*MsgUpdateFeeRoutingParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeeRoutingParams not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -100,6 +275,168 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateGasPricingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGasPricingPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGasPricingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateGasPricingPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGasPricingPolicy(ctx, req.(*MsgUpdateGasPricingPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetProfile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetProfile(ctx, req.(*MsgSetProfile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateFeeMarketParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFeeMarketParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFeeMarketParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateFeeMarketParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFeeMarketParams(ctx, req.(*MsgUpdateFeeMarketParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateFeeAbstractionParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFeeAbstractionParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFeeAbstractionParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateFeeAbstractionParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFeeAbstractionParams(ctx, req.(*MsgUpdateFeeAbstractionParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddAuthenticator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAuthenticator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddAuthenticator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddAuthenticator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAuthenticator(ctx, req.(*MsgAddAuthenticator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAuthenticator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAuthenticator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAuthenticator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveAuthenticator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAuthenticator(ctx, req.(*MsgRemoveAuthenticator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotatePubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotatePubKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotatePubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RotatePubKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotatePubKey(ctx, req.(*MsgRotatePubKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateMsgSurchargeParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMsgSurchargeParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMsgSurchargeParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateMsgSurchargeParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMsgSurchargeParams(ctx, req.(*MsgUpdateMsgSurchargeParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateFeeRoutingParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFeeRoutingParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFeeRoutingParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateFeeRoutingParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFeeRoutingParams(ctx, req.(*MsgUpdateFeeRoutingParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -111,6 +448,42 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateGasPricingPolicy",
+			Handler:    _Msg_UpdateGasPricingPolicy_Handler,
+		},
+		{
+			MethodName: "SetProfile",
+			Handler:    _Msg_SetProfile_Handler,
+		},
+		{
+			MethodName: "UpdateFeeMarketParams",
+			Handler:    _Msg_UpdateFeeMarketParams_Handler,
+		},
+		{
+			MethodName: "UpdateFeeAbstractionParams",
+			Handler:    _Msg_UpdateFeeAbstractionParams_Handler,
+		},
+		{
+			MethodName: "AddAuthenticator",
+			Handler:    _Msg_AddAuthenticator_Handler,
+		},
+		{
+			MethodName: "RemoveAuthenticator",
+			Handler:    _Msg_RemoveAuthenticator_Handler,
+		},
+		{
+			MethodName: "RotatePubKey",
+			Handler:    _Msg_RotatePubKey_Handler,
+		},
+		{
+			MethodName: "UpdateMsgSurchargeParams",
+			Handler:    _Msg_UpdateMsgSurchargeParams_Handler,
+		},
+		{
+			MethodName: "UpdateFeeRoutingParams",
+			Handler:    _Msg_UpdateFeeRoutingParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/tx.proto",
@@ -25,6 +25,7 @@ const (
 	Msg_Exec_FullMethodName               = "/cosmos.authz.v1beta1.Msg/Exec"
 	Msg_Revoke_FullMethodName             = "/cosmos.authz.v1beta1.Msg/Revoke"
 	Msg_PruneExpiredGrants_FullMethodName = "/cosmos.authz.v1beta1.Msg/PruneExpiredGrants"
+	Msg_ExtendGrant_FullMethodName        = "/cosmos.authz.v1beta1.Msg/ExtendGrant"
 )
 
 // MsgClient is the client API for Msg service.
@@ -47,6 +48,10 @@ type MsgClient interface {
 	//
 	// Since cosmos-sdk 0.51
 	PruneExpiredGrants(ctx context.Context, in *MsgPruneExpiredGrants, opts ...grpc.CallOption) (*MsgPruneExpiredGrantsResponse, error)
+	// ExtendGrant extends the expiration and/or increases the limit of an existing
+	// grant in place, without the revoke and re-grant that would interrupt the
+	// grantee in the meantime.
+	ExtendGrant(ctx context.Context, in *MsgExtendGrant, opts ...grpc.CallOption) (*MsgExtendGrantResponse, error)
 }
 
 type msgClient struct {
@@ -93,6 +98,15 @@ func (c *msgClient) PruneExpiredGrants(ctx context.Context, in *MsgPruneExpiredG
 	return out, nil
 }
 
+func (c *msgClient) ExtendGrant(ctx context.Context, in *MsgExtendGrant, opts ...grpc.CallOption) (*MsgExtendGrantResponse, error) {
+	out := new(MsgExtendGrantResponse)
+	err := c.cc.Invoke(ctx, Msg_ExtendGrant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -113,6 +127,10 @@ type MsgServer interface {
 	//
 	// Since cosmos-sdk 0.51
 	PruneExpiredGrants(context.Context, *MsgPruneExpiredGrants) (*MsgPruneExpiredGrantsResponse, error)
+	// ExtendGrant extends the expiration and/or increases the limit of an existing
+	// grant in place, without the revoke and re-grant that would interrupt the
+	// grantee in the meantime.
+	ExtendGrant(context.Context, *MsgExtendGrant) (*MsgExtendGrantResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -132,6 +150,9 @@ func (UnimplementedMsgServer) Revoke(context.Context, *MsgRevoke) (*MsgRevokeRes
 func (UnimplementedMsgServer) PruneExpiredGrants(context.Context, *MsgPruneExpiredGrants) (*MsgPruneExpiredGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PruneExpiredGrants not implemented")
 }
+func (UnimplementedMsgServer) ExtendGrant(context.Context, *MsgExtendGrant) (*MsgExtendGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendGrant not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -217,6 +238,24 @@ func _Msg_PruneExpiredGrants_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ExtendGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExtendGrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExtendGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ExtendGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExtendGrant(ctx, req.(*MsgExtendGrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -240,6 +279,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PruneExpiredGrants",
 			Handler:    _Msg_PruneExpiredGrants_Handler,
 		},
+		{
+			MethodName: "ExtendGrant",
+			Handler:    _Msg_ExtendGrant_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/tx.proto",
@@ -21,10 +21,15 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	Query_Balance_FullMethodName                    = "/cosmos.bank.v1beta1.Query/Balance"
 	Query_AllBalances_FullMethodName                = "/cosmos.bank.v1beta1.Query/AllBalances"
+	Query_BalancesBatch_FullMethodName              = "/cosmos.bank.v1beta1.Query/BalancesBatch"
 	Query_SpendableBalances_FullMethodName          = "/cosmos.bank.v1beta1.Query/SpendableBalances"
 	Query_SpendableBalanceByDenom_FullMethodName    = "/cosmos.bank.v1beta1.Query/SpendableBalanceByDenom"
+	Query_Holds_FullMethodName                      = "/cosmos.bank.v1beta1.Query/Holds"
+	Query_SpendingLimits_FullMethodName             = "/cosmos.bank.v1beta1.Query/SpendingLimits"
 	Query_TotalSupply_FullMethodName                = "/cosmos.bank.v1beta1.Query/TotalSupply"
 	Query_SupplyOf_FullMethodName                   = "/cosmos.bank.v1beta1.Query/SupplyOf"
+	Query_CirculatingSupply_FullMethodName          = "/cosmos.bank.v1beta1.Query/CirculatingSupply"
+	Query_NonCirculatingAddresses_FullMethodName    = "/cosmos.bank.v1beta1.Query/NonCirculatingAddresses"
 	Query_Params_FullMethodName                     = "/cosmos.bank.v1beta1.Query/Params"
 	Query_DenomMetadata_FullMethodName              = "/cosmos.bank.v1beta1.Query/DenomMetadata"
 	Query_DenomMetadataByQueryString_FullMethodName = "/cosmos.bank.v1beta1.Query/DenomMetadataByQueryString"
@@ -32,6 +37,16 @@ const (
 	Query_DenomOwners_FullMethodName                = "/cosmos.bank.v1beta1.Query/DenomOwners"
 	Query_DenomOwnersByQuery_FullMethodName         = "/cosmos.bank.v1beta1.Query/DenomOwnersByQuery"
 	Query_SendEnabled_FullMethodName                = "/cosmos.bank.v1beta1.Query/SendEnabled"
+	Query_BalanceAlerts_FullMethodName              = "/cosmos.bank.v1beta1.Query/BalanceAlerts"
+	Query_FactoryDenom_FullMethodName               = "/cosmos.bank.v1beta1.Query/FactoryDenom"
+	Query_FactoryDenomsFromCreator_FullMethodName   = "/cosmos.bank.v1beta1.Query/FactoryDenomsFromCreator"
+	Query_BalanceHistory_FullMethodName             = "/cosmos.bank.v1beta1.Query/BalanceHistory"
+	Query_SendRestrictionPolicy_FullMethodName      = "/cosmos.bank.v1beta1.Query/SendRestrictionPolicy"
+	Query_SendRestrictionPolicies_FullMethodName    = "/cosmos.bank.v1beta1.Query/SendRestrictionPolicies"
+	Query_DenomTransferHook_FullMethodName          = "/cosmos.bank.v1beta1.Query/DenomTransferHook"
+	Query_DenomTransferHooks_FullMethodName         = "/cosmos.bank.v1beta1.Query/DenomTransferHooks"
+	Query_DenomTransferFee_FullMethodName           = "/cosmos.bank.v1beta1.Query/DenomTransferFee"
+	Query_DenomTransferFees_FullMethodName          = "/cosmos.bank.v1beta1.Query/DenomTransferFees"
 )
 
 // QueryClient is the client API for Query service.
@@ -45,6 +60,9 @@ type QueryClient interface {
 	// When called from another module, this query might consume a high amount of
 	// gas if the pagination field is incorrectly set.
 	AllBalances(ctx context.Context, in *QueryAllBalancesRequest, opts ...grpc.CallOption) (*QueryAllBalancesResponse, error)
+	// BalancesBatch queries the balances of up to 100 accounts in a single
+	// request, optionally restricted to a single denom.
+	BalancesBatch(ctx context.Context, in *QueryBalancesBatchRequest, opts ...grpc.CallOption) (*QueryBalancesBatchResponse, error)
 	// SpendableBalances queries the spendable balance of all coins for a single
 	// account.
 	//
@@ -61,6 +79,11 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SpendableBalanceByDenom(ctx context.Context, in *QuerySpendableBalanceByDenomRequest, opts ...grpc.CallOption) (*QuerySpendableBalanceByDenomResponse, error)
+	// Holds queries the coins of an account placed on hold by modules, which
+	// are excluded from its spendable balance.
+	Holds(ctx context.Context, in *QueryHoldsRequest, opts ...grpc.CallOption) (*QueryHoldsResponse, error)
+	// SpendingLimits queries the spending limits set by an account.
+	SpendingLimits(ctx context.Context, in *QuerySpendingLimitsRequest, opts ...grpc.CallOption) (*QuerySpendingLimitsResponse, error)
 	// TotalSupply queries the total supply of all coins.
 	//
 	// When called from another module, this query might consume a high amount of
@@ -71,6 +94,16 @@ type QueryClient interface {
 	// When called from another module, this query might consume a high amount of
 	// gas if the pagination field is incorrectly set.
 	SupplyOf(ctx context.Context, in *QuerySupplyOfRequest, opts ...grpc.CallOption) (*QuerySupplyOfResponse, error)
+	// CirculatingSupply queries the circulating supply of a single coin, that is
+	// its total supply minus the balances of module accounts, the locked
+	// portions of vesting accounts and the balances of the non-circulating
+	// addresses set by governance.
+	//
+	// This query iterates over all the holders of the coin.
+	CirculatingSupply(ctx context.Context, in *QueryCirculatingSupplyRequest, opts ...grpc.CallOption) (*QueryCirculatingSupplyResponse, error)
+	// NonCirculatingAddresses queries the addresses excluded from the
+	// circulating supply by governance.
+	NonCirculatingAddresses(ctx context.Context, in *QueryNonCirculatingAddressesRequest, opts ...grpc.CallOption) (*QueryNonCirculatingAddressesResponse, error)
 	// Params queries the parameters of x/bank module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// DenomMetadata queries the client metadata of a given coin denomination.
@@ -101,6 +134,29 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error)
+	// BalanceAlerts queries all the balance alerts registered on module accounts.
+	BalanceAlerts(ctx context.Context, in *QueryBalanceAlertsRequest, opts ...grpc.CallOption) (*QueryBalanceAlertsResponse, error)
+	// FactoryDenom queries the admin of a denom created through the token factory.
+	FactoryDenom(ctx context.Context, in *QueryFactoryDenomRequest, opts ...grpc.CallOption) (*QueryFactoryDenomResponse, error)
+	// FactoryDenomsFromCreator queries the denoms created through the token
+	// factory by an account.
+	FactoryDenomsFromCreator(ctx context.Context, in *QueryFactoryDenomsFromCreatorRequest, opts ...grpc.CallOption) (*QueryFactoryDenomsFromCreatorResponse, error)
+	// BalanceHistory queries the balance changes of an account recorded in the
+	// balance journal, oldest first.
+	BalanceHistory(ctx context.Context, in *QueryBalanceHistoryRequest, opts ...grpc.CallOption) (*QueryBalanceHistoryResponse, error)
+	// SendRestrictionPolicy queries a send restriction policy by name.
+	SendRestrictionPolicy(ctx context.Context, in *QuerySendRestrictionPolicyRequest, opts ...grpc.CallOption) (*QuerySendRestrictionPolicyResponse, error)
+	// SendRestrictionPolicies queries the send restriction policies registered by
+	// governance.
+	SendRestrictionPolicies(ctx context.Context, in *QuerySendRestrictionPoliciesRequest, opts ...grpc.CallOption) (*QuerySendRestrictionPoliciesResponse, error)
+	// DenomTransferHook queries the transfer hook registered for a denom.
+	DenomTransferHook(ctx context.Context, in *QueryDenomTransferHookRequest, opts ...grpc.CallOption) (*QueryDenomTransferHookResponse, error)
+	// DenomTransferHooks queries the transfer hooks registered for all denoms.
+	DenomTransferHooks(ctx context.Context, in *QueryDenomTransferHooksRequest, opts ...grpc.CallOption) (*QueryDenomTransferHooksResponse, error)
+	// DenomTransferFee queries the transfer fee charged on a denom.
+	DenomTransferFee(ctx context.Context, in *QueryDenomTransferFeeRequest, opts ...grpc.CallOption) (*QueryDenomTransferFeeResponse, error)
+	// DenomTransferFees queries the transfer fees charged on all denoms.
+	DenomTransferFees(ctx context.Context, in *QueryDenomTransferFeesRequest, opts ...grpc.CallOption) (*QueryDenomTransferFeesResponse, error)
 }
 
 type queryClient struct {
@@ -129,6 +185,15 @@ func (c *queryClient) AllBalances(ctx context.Context, in *QueryAllBalancesReque
 	return out, nil
 }
 
+func (c *queryClient) BalancesBatch(ctx context.Context, in *QueryBalancesBatchRequest, opts ...grpc.CallOption) (*QueryBalancesBatchResponse, error) {
+	out := new(QueryBalancesBatchResponse)
+	err := c.cc.Invoke(ctx, Query_BalancesBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) SpendableBalances(ctx context.Context, in *QuerySpendableBalancesRequest, opts ...grpc.CallOption) (*QuerySpendableBalancesResponse, error) {
 	out := new(QuerySpendableBalancesResponse)
 	err := c.cc.Invoke(ctx, Query_SpendableBalances_FullMethodName, in, out, opts...)
@@ -147,6 +212,24 @@ func (c *queryClient) SpendableBalanceByDenom(ctx context.Context, in *QuerySpen
 	return out, nil
 }
 
+func (c *queryClient) Holds(ctx context.Context, in *QueryHoldsRequest, opts ...grpc.CallOption) (*QueryHoldsResponse, error) {
+	out := new(QueryHoldsResponse)
+	err := c.cc.Invoke(ctx, Query_Holds_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SpendingLimits(ctx context.Context, in *QuerySpendingLimitsRequest, opts ...grpc.CallOption) (*QuerySpendingLimitsResponse, error) {
+	out := new(QuerySpendingLimitsResponse)
+	err := c.cc.Invoke(ctx, Query_SpendingLimits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TotalSupply(ctx context.Context, in *QueryTotalSupplyRequest, opts ...grpc.CallOption) (*QueryTotalSupplyResponse, error) {
 	out := new(QueryTotalSupplyResponse)
 	err := c.cc.Invoke(ctx, Query_TotalSupply_FullMethodName, in, out, opts...)
@@ -165,6 +248,24 @@ func (c *queryClient) SupplyOf(ctx context.Context, in *QuerySupplyOfRequest, op
 	return out, nil
 }
 
+func (c *queryClient) CirculatingSupply(ctx context.Context, in *QueryCirculatingSupplyRequest, opts ...grpc.CallOption) (*QueryCirculatingSupplyResponse, error) {
+	out := new(QueryCirculatingSupplyResponse)
+	err := c.cc.Invoke(ctx, Query_CirculatingSupply_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NonCirculatingAddresses(ctx context.Context, in *QueryNonCirculatingAddressesRequest, opts ...grpc.CallOption) (*QueryNonCirculatingAddressesResponse, error) {
+	out := new(QueryNonCirculatingAddressesResponse)
+	err := c.cc.Invoke(ctx, Query_NonCirculatingAddresses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
@@ -228,6 +329,96 @@ func (c *queryClient) SendEnabled(ctx context.Context, in *QuerySendEnabledReque
 	return out, nil
 }
 
+func (c *queryClient) BalanceAlerts(ctx context.Context, in *QueryBalanceAlertsRequest, opts ...grpc.CallOption) (*QueryBalanceAlertsResponse, error) {
+	out := new(QueryBalanceAlertsResponse)
+	err := c.cc.Invoke(ctx, Query_BalanceAlerts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FactoryDenom(ctx context.Context, in *QueryFactoryDenomRequest, opts ...grpc.CallOption) (*QueryFactoryDenomResponse, error) {
+	out := new(QueryFactoryDenomResponse)
+	err := c.cc.Invoke(ctx, Query_FactoryDenom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FactoryDenomsFromCreator(ctx context.Context, in *QueryFactoryDenomsFromCreatorRequest, opts ...grpc.CallOption) (*QueryFactoryDenomsFromCreatorResponse, error) {
+	out := new(QueryFactoryDenomsFromCreatorResponse)
+	err := c.cc.Invoke(ctx, Query_FactoryDenomsFromCreator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BalanceHistory(ctx context.Context, in *QueryBalanceHistoryRequest, opts ...grpc.CallOption) (*QueryBalanceHistoryResponse, error) {
+	out := new(QueryBalanceHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_BalanceHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SendRestrictionPolicy(ctx context.Context, in *QuerySendRestrictionPolicyRequest, opts ...grpc.CallOption) (*QuerySendRestrictionPolicyResponse, error) {
+	out := new(QuerySendRestrictionPolicyResponse)
+	err := c.cc.Invoke(ctx, Query_SendRestrictionPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SendRestrictionPolicies(ctx context.Context, in *QuerySendRestrictionPoliciesRequest, opts ...grpc.CallOption) (*QuerySendRestrictionPoliciesResponse, error) {
+	out := new(QuerySendRestrictionPoliciesResponse)
+	err := c.cc.Invoke(ctx, Query_SendRestrictionPolicies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomTransferHook(ctx context.Context, in *QueryDenomTransferHookRequest, opts ...grpc.CallOption) (*QueryDenomTransferHookResponse, error) {
+	out := new(QueryDenomTransferHookResponse)
+	err := c.cc.Invoke(ctx, Query_DenomTransferHook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomTransferHooks(ctx context.Context, in *QueryDenomTransferHooksRequest, opts ...grpc.CallOption) (*QueryDenomTransferHooksResponse, error) {
+	out := new(QueryDenomTransferHooksResponse)
+	err := c.cc.Invoke(ctx, Query_DenomTransferHooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomTransferFee(ctx context.Context, in *QueryDenomTransferFeeRequest, opts ...grpc.CallOption) (*QueryDenomTransferFeeResponse, error) {
+	out := new(QueryDenomTransferFeeResponse)
+	err := c.cc.Invoke(ctx, Query_DenomTransferFee_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomTransferFees(ctx context.Context, in *QueryDenomTransferFeesRequest, opts ...grpc.CallOption) (*QueryDenomTransferFeesResponse, error) {
+	out := new(QueryDenomTransferFeesResponse)
+	err := c.cc.Invoke(ctx, Query_DenomTransferFees_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -239,6 +430,9 @@ type QueryServer interface {
 	// When called from another module, this query might consume a high amount of
 	// gas if the pagination field is incorrectly set.
 	AllBalances(context.Context, *QueryAllBalancesRequest) (*QueryAllBalancesResponse, error)
+	// BalancesBatch queries the balances of up to 100 accounts in a single
+	// request, optionally restricted to a single denom.
+	BalancesBatch(context.Context, *QueryBalancesBatchRequest) (*QueryBalancesBatchResponse, error)
 	// SpendableBalances queries the spendable balance of all coins for a single
 	// account.
 	//
@@ -255,6 +449,11 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SpendableBalanceByDenom(context.Context, *QuerySpendableBalanceByDenomRequest) (*QuerySpendableBalanceByDenomResponse, error)
+	// Holds queries the coins of an account placed on hold by modules, which
+	// are excluded from its spendable balance.
+	Holds(context.Context, *QueryHoldsRequest) (*QueryHoldsResponse, error)
+	// SpendingLimits queries the spending limits set by an account.
+	SpendingLimits(context.Context, *QuerySpendingLimitsRequest) (*QuerySpendingLimitsResponse, error)
 	// TotalSupply queries the total supply of all coins.
 	//
 	// When called from another module, this query might consume a high amount of
@@ -265,6 +464,16 @@ type QueryServer interface {
 	// When called from another module, this query might consume a high amount of
 	// gas if the pagination field is incorrectly set.
 	SupplyOf(context.Context, *QuerySupplyOfRequest) (*QuerySupplyOfResponse, error)
+	// CirculatingSupply queries the circulating supply of a single coin, that is
+	// its total supply minus the balances of module accounts, the locked
+	// portions of vesting accounts and the balances of the non-circulating
+	// addresses set by governance.
+	//
+	// This query iterates over all the holders of the coin.
+	CirculatingSupply(context.Context, *QueryCirculatingSupplyRequest) (*QueryCirculatingSupplyResponse, error)
+	// NonCirculatingAddresses queries the addresses excluded from the
+	// circulating supply by governance.
+	NonCirculatingAddresses(context.Context, *QueryNonCirculatingAddressesRequest) (*QueryNonCirculatingAddressesResponse, error)
 	// Params queries the parameters of x/bank module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// DenomMetadata queries the client metadata of a given coin denomination.
@@ -295,6 +504,29 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error)
+	// BalanceAlerts queries all the balance alerts registered on module accounts.
+	BalanceAlerts(context.Context, *QueryBalanceAlertsRequest) (*QueryBalanceAlertsResponse, error)
+	// FactoryDenom queries the admin of a denom created through the token factory.
+	FactoryDenom(context.Context, *QueryFactoryDenomRequest) (*QueryFactoryDenomResponse, error)
+	// FactoryDenomsFromCreator queries the denoms created through the token
+	// factory by an account.
+	FactoryDenomsFromCreator(context.Context, *QueryFactoryDenomsFromCreatorRequest) (*QueryFactoryDenomsFromCreatorResponse, error)
+	// BalanceHistory queries the balance changes of an account recorded in the
+	// balance journal, oldest first.
+	BalanceHistory(context.Context, *QueryBalanceHistoryRequest) (*QueryBalanceHistoryResponse, error)
+	// SendRestrictionPolicy queries a send restriction policy by name.
+	SendRestrictionPolicy(context.Context, *QuerySendRestrictionPolicyRequest) (*QuerySendRestrictionPolicyResponse, error)
+	// SendRestrictionPolicies queries the send restriction policies registered by
+	// governance.
+	SendRestrictionPolicies(context.Context, *QuerySendRestrictionPoliciesRequest) (*QuerySendRestrictionPoliciesResponse, error)
+	// DenomTransferHook queries the transfer hook registered for a denom.
+	DenomTransferHook(context.Context, *QueryDenomTransferHookRequest) (*QueryDenomTransferHookResponse, error)
+	// DenomTransferHooks queries the transfer hooks registered for all denoms.
+	DenomTransferHooks(context.Context, *QueryDenomTransferHooksRequest) (*QueryDenomTransferHooksResponse, error)
+	// DenomTransferFee queries the transfer fee charged on a denom.
+	DenomTransferFee(context.Context, *QueryDenomTransferFeeRequest) (*QueryDenomTransferFeeResponse, error)
+	// DenomTransferFees queries the transfer fees charged on all denoms.
+	DenomTransferFees(context.Context, *QueryDenomTransferFeesRequest) (*QueryDenomTransferFeesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -308,18 +540,33 @@ func (UnimplementedQueryServer) Balance(context.Context, *QueryBalanceRequest) (
 func (UnimplementedQueryServer) AllBalances(context.Context, *QueryAllBalancesRequest) (*QueryAllBalancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllBalances not implemented")
 }
+func (UnimplementedQueryServer) BalancesBatch(context.Context, *QueryBalancesBatchRequest) (*QueryBalancesBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BalancesBatch not implemented")
+}
 func (UnimplementedQueryServer) SpendableBalances(context.Context, *QuerySpendableBalancesRequest) (*QuerySpendableBalancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SpendableBalances not implemented")
 }
 func (UnimplementedQueryServer) SpendableBalanceByDenom(context.Context, *QuerySpendableBalanceByDenomRequest) (*QuerySpendableBalanceByDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SpendableBalanceByDenom not implemented")
 }
+func (UnimplementedQueryServer) Holds(context.Context, *QueryHoldsRequest) (*QueryHoldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Holds not implemented")
+}
+func (UnimplementedQueryServer) SpendingLimits(context.Context, *QuerySpendingLimitsRequest) (*QuerySpendingLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpendingLimits not implemented")
+}
 func (UnimplementedQueryServer) TotalSupply(context.Context, *QueryTotalSupplyRequest) (*QueryTotalSupplyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalSupply not implemented")
 }
 func (UnimplementedQueryServer) SupplyOf(context.Context, *QuerySupplyOfRequest) (*QuerySupplyOfResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SupplyOf not implemented")
 }
+func (UnimplementedQueryServer) CirculatingSupply(context.Context, *QueryCirculatingSupplyRequest) (*QueryCirculatingSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CirculatingSupply not implemented")
+}
+func (UnimplementedQueryServer) NonCirculatingAddresses(context.Context, *QueryNonCirculatingAddressesRequest) (*QueryNonCirculatingAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NonCirculatingAddresses not implemented")
+}
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -341,6 +588,36 @@ func (UnimplementedQueryServer) DenomOwnersByQuery(context.Context, *QueryDenomO
 func (UnimplementedQueryServer) SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendEnabled not implemented")
 }
+func (UnimplementedQueryServer) BalanceAlerts(context.Context, *QueryBalanceAlertsRequest) (*QueryBalanceAlertsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BalanceAlerts not implemented")
+}
+func (UnimplementedQueryServer) FactoryDenom(context.Context, *QueryFactoryDenomRequest) (*QueryFactoryDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FactoryDenom not implemented")
+}
+func (UnimplementedQueryServer) FactoryDenomsFromCreator(context.Context, *QueryFactoryDenomsFromCreatorRequest) (*QueryFactoryDenomsFromCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FactoryDenomsFromCreator not implemented")
+}
+func (UnimplementedQueryServer) BalanceHistory(context.Context, *QueryBalanceHistoryRequest) (*QueryBalanceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BalanceHistory not implemented")
+}
+func (UnimplementedQueryServer) SendRestrictionPolicy(context.Context, *QuerySendRestrictionPolicyRequest) (*QuerySendRestrictionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendRestrictionPolicy not implemented")
+}
+func (UnimplementedQueryServer) SendRestrictionPolicies(context.Context, *QuerySendRestrictionPoliciesRequest) (*QuerySendRestrictionPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendRestrictionPolicies not implemented")
+}
+func (UnimplementedQueryServer) DenomTransferHook(context.Context, *QueryDenomTransferHookRequest) (*QueryDenomTransferHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomTransferHook not implemented")
+}
+func (UnimplementedQueryServer) DenomTransferHooks(context.Context, *QueryDenomTransferHooksRequest) (*QueryDenomTransferHooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomTransferHooks not implemented")
+}
+func (UnimplementedQueryServer) DenomTransferFee(context.Context, *QueryDenomTransferFeeRequest) (*QueryDenomTransferFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomTransferFee not implemented")
+}
+func (UnimplementedQueryServer) DenomTransferFees(context.Context, *QueryDenomTransferFeesRequest) (*QueryDenomTransferFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomTransferFees not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -390,6 +667,24 @@ func _Query_AllBalances_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BalancesBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalancesBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BalancesBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BalancesBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BalancesBatch(ctx, req.(*QueryBalancesBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_SpendableBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QuerySpendableBalancesRequest)
 	if err := dec(in); err != nil {
@@ -426,6 +721,42 @@ func _Query_SpendableBalanceByDenom_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Holds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHoldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Holds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Holds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Holds(ctx, req.(*QueryHoldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpendingLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpendingLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpendingLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SpendingLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpendingLimits(ctx, req.(*QuerySpendingLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TotalSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTotalSupplyRequest)
 	if err := dec(in); err != nil {
@@ -462,6 +793,42 @@ func _Query_SupplyOf_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CirculatingSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCirculatingSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CirculatingSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CirculatingSupply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CirculatingSupply(ctx, req.(*QueryCirculatingSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NonCirculatingAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNonCirculatingAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NonCirculatingAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_NonCirculatingAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NonCirculatingAddresses(ctx, req.(*QueryNonCirculatingAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -588,6 +955,186 @@ func _Query_SendEnabled_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BalanceAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalanceAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BalanceAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BalanceAlerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BalanceAlerts(ctx, req.(*QueryBalanceAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FactoryDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFactoryDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FactoryDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FactoryDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FactoryDenom(ctx, req.(*QueryFactoryDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FactoryDenomsFromCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFactoryDenomsFromCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FactoryDenomsFromCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FactoryDenomsFromCreator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FactoryDenomsFromCreator(ctx, req.(*QueryFactoryDenomsFromCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BalanceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalanceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BalanceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BalanceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BalanceHistory(ctx, req.(*QueryBalanceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SendRestrictionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySendRestrictionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SendRestrictionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SendRestrictionPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SendRestrictionPolicy(ctx, req.(*QuerySendRestrictionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SendRestrictionPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySendRestrictionPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SendRestrictionPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SendRestrictionPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SendRestrictionPolicies(ctx, req.(*QuerySendRestrictionPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomTransferHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomTransferHookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomTransferHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomTransferHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomTransferHook(ctx, req.(*QueryDenomTransferHookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomTransferHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomTransferHooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomTransferHooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomTransferHooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomTransferHooks(ctx, req.(*QueryDenomTransferHooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomTransferFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomTransferFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomTransferFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomTransferFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomTransferFee(ctx, req.(*QueryDenomTransferFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomTransferFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomTransferFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomTransferFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomTransferFees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomTransferFees(ctx, req.(*QueryDenomTransferFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -603,6 +1150,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllBalances",
 			Handler:    _Query_AllBalances_Handler,
 		},
+		{
+			MethodName: "BalancesBatch",
+			Handler:    _Query_BalancesBatch_Handler,
+		},
 		{
 			MethodName: "SpendableBalances",
 			Handler:    _Query_SpendableBalances_Handler,
@@ -611,6 +1162,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SpendableBalanceByDenom",
 			Handler:    _Query_SpendableBalanceByDenom_Handler,
 		},
+		{
+			MethodName: "Holds",
+			Handler:    _Query_Holds_Handler,
+		},
+		{
+			MethodName: "SpendingLimits",
+			Handler:    _Query_SpendingLimits_Handler,
+		},
 		{
 			MethodName: "TotalSupply",
 			Handler:    _Query_TotalSupply_Handler,
@@ -619,6 +1178,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SupplyOf",
 			Handler:    _Query_SupplyOf_Handler,
 		},
+		{
+			MethodName: "CirculatingSupply",
+			Handler:    _Query_CirculatingSupply_Handler,
+		},
+		{
+			MethodName: "NonCirculatingAddresses",
+			Handler:    _Query_NonCirculatingAddresses_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -647,6 +1214,46 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendEnabled",
 			Handler:    _Query_SendEnabled_Handler,
 		},
+		{
+			MethodName: "BalanceAlerts",
+			Handler:    _Query_BalanceAlerts_Handler,
+		},
+		{
+			MethodName: "FactoryDenom",
+			Handler:    _Query_FactoryDenom_Handler,
+		},
+		{
+			MethodName: "FactoryDenomsFromCreator",
+			Handler:    _Query_FactoryDenomsFromCreator_Handler,
+		},
+		{
+			MethodName: "BalanceHistory",
+			Handler:    _Query_BalanceHistory_Handler,
+		},
+		{
+			MethodName: "SendRestrictionPolicy",
+			Handler:    _Query_SendRestrictionPolicy_Handler,
+		},
+		{
+			MethodName: "SendRestrictionPolicies",
+			Handler:    _Query_SendRestrictionPolicies_Handler,
+		},
+		{
+			MethodName: "DenomTransferHook",
+			Handler:    _Query_DenomTransferHook_Handler,
+		},
+		{
+			MethodName: "DenomTransferHooks",
+			Handler:    _Query_DenomTransferHooks_Handler,
+		},
+		{
+			MethodName: "DenomTransferFee",
+			Handler:    _Query_DenomTransferFee_Handler,
+		},
+		{
+			MethodName: "DenomTransferFees",
+			Handler:    _Query_DenomTransferFees_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
@@ -19,11 +19,28 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Send_FullMethodName           = "/cosmos.bank.v1beta1.Msg/Send"
-	Msg_MultiSend_FullMethodName      = "/cosmos.bank.v1beta1.Msg/MultiSend"
-	Msg_Burn_FullMethodName           = "/cosmos.bank.v1beta1.Msg/Burn"
-	Msg_UpdateParams_FullMethodName   = "/cosmos.bank.v1beta1.Msg/UpdateParams"
-	Msg_SetSendEnabled_FullMethodName = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_Send_FullMethodName                       = "/cosmos.bank.v1beta1.Msg/Send"
+	Msg_MultiSend_FullMethodName                  = "/cosmos.bank.v1beta1.Msg/MultiSend"
+	Msg_Burn_FullMethodName                       = "/cosmos.bank.v1beta1.Msg/Burn"
+	Msg_UpdateParams_FullMethodName               = "/cosmos.bank.v1beta1.Msg/UpdateParams"
+	Msg_SetSendEnabled_FullMethodName             = "/cosmos.bank.v1beta1.Msg/SetSendEnabled"
+	Msg_SetBalanceAlerts_FullMethodName           = "/cosmos.bank.v1beta1.Msg/SetBalanceAlerts"
+	Msg_CreateDenom_FullMethodName                = "/cosmos.bank.v1beta1.Msg/CreateDenom"
+	Msg_FactoryMint_FullMethodName                = "/cosmos.bank.v1beta1.Msg/FactoryMint"
+	Msg_FactoryBurn_FullMethodName                = "/cosmos.bank.v1beta1.Msg/FactoryBurn"
+	Msg_ForceTransfer_FullMethodName              = "/cosmos.bank.v1beta1.Msg/ForceTransfer"
+	Msg_ChangeDenomAdmin_FullMethodName           = "/cosmos.bank.v1beta1.Msg/ChangeDenomAdmin"
+	Msg_SetFactoryDenomMetadata_FullMethodName    = "/cosmos.bank.v1beta1.Msg/SetFactoryDenomMetadata"
+	Msg_SetSendRestrictionPolicies_FullMethodName = "/cosmos.bank.v1beta1.Msg/SetSendRestrictionPolicies"
+	Msg_SetNonCirculatingAddresses_FullMethodName = "/cosmos.bank.v1beta1.Msg/SetNonCirculatingAddresses"
+	Msg_SetDenomTransferHook_FullMethodName       = "/cosmos.bank.v1beta1.Msg/SetDenomTransferHook"
+	Msg_RemoveDenomTransferHook_FullMethodName    = "/cosmos.bank.v1beta1.Msg/RemoveDenomTransferHook"
+	Msg_SetDenomTransferFee_FullMethodName        = "/cosmos.bank.v1beta1.Msg/SetDenomTransferFee"
+	Msg_RemoveDenomTransferFee_FullMethodName     = "/cosmos.bank.v1beta1.Msg/RemoveDenomTransferFee"
+	Msg_SetDenomMetadata_FullMethodName           = "/cosmos.bank.v1beta1.Msg/SetDenomMetadata"
+	Msg_UpdateDenomMetadata_FullMethodName        = "/cosmos.bank.v1beta1.Msg/UpdateDenomMetadata"
+	Msg_SweepDust_FullMethodName                  = "/cosmos.bank.v1beta1.Msg/SweepDust"
+	Msg_SetSpendingLimit_FullMethodName           = "/cosmos.bank.v1beta1.Msg/SetSpendingLimit"
 )
 
 // MsgClient is the client API for Msg service.
@@ -50,6 +67,61 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error)
+	// SetBalanceAlerts is a governance operation for registering balance
+	// thresholds on module accounts. Only the entries to add, update or delete
+	// should be included.
+	SetBalanceAlerts(ctx context.Context, in *MsgSetBalanceAlerts, opts ...grpc.CallOption) (*MsgSetBalanceAlertsResponse, error)
+	// CreateDenom creates a denom in the factory/{sender}/{subdenom} format
+	// through the token factory, with the sender as admin.
+	CreateDenom(ctx context.Context, in *MsgCreateDenom, opts ...grpc.CallOption) (*MsgCreateDenomResponse, error)
+	// FactoryMint mints coins of a factory denom. It can only be called by the
+	// admin of the denom.
+	FactoryMint(ctx context.Context, in *MsgFactoryMint, opts ...grpc.CallOption) (*MsgFactoryMintResponse, error)
+	// FactoryBurn burns coins of a factory denom. It can only be called by the
+	// admin of the denom.
+	FactoryBurn(ctx context.Context, in *MsgFactoryBurn, opts ...grpc.CallOption) (*MsgFactoryBurnResponse, error)
+	// ForceTransfer transfers coins of a factory denom between any two accounts.
+	// It can only be called by the admin of the denom.
+	ForceTransfer(ctx context.Context, in *MsgForceTransfer, opts ...grpc.CallOption) (*MsgForceTransferResponse, error)
+	// ChangeDenomAdmin changes the admin of a factory denom. It can only be called
+	// by the admin of the denom.
+	ChangeDenomAdmin(ctx context.Context, in *MsgChangeDenomAdmin, opts ...grpc.CallOption) (*MsgChangeDenomAdminResponse, error)
+	// SetFactoryDenomMetadata sets the metadata of a factory denom. It can only be
+	// called by the admin of the denom.
+	SetFactoryDenomMetadata(ctx context.Context, in *MsgSetFactoryDenomMetadata, opts ...grpc.CallOption) (*MsgSetFactoryDenomMetadataResponse, error)
+	// SetSendRestrictionPolicies is a governance operation for registering,
+	// updating and removing send restriction policies. Only the policies to
+	// register, update or remove should be included.
+	SetSendRestrictionPolicies(ctx context.Context, in *MsgSetSendRestrictionPolicies, opts ...grpc.CallOption) (*MsgSetSendRestrictionPoliciesResponse, error)
+	// SetNonCirculatingAddresses is a governance operation for adding and
+	// removing addresses from the list of accounts whose balances are excluded
+	// from the circulating supply.
+	SetNonCirculatingAddresses(ctx context.Context, in *MsgSetNonCirculatingAddresses, opts ...grpc.CallOption) (*MsgSetNonCirculatingAddressesResponse, error)
+	// SetDenomTransferHook registers or updates the transfer hook of a denom. It
+	// can be called by the authority, or by the admin of a factory denom.
+	SetDenomTransferHook(ctx context.Context, in *MsgSetDenomTransferHook, opts ...grpc.CallOption) (*MsgSetDenomTransferHookResponse, error)
+	// RemoveDenomTransferHook removes the transfer hook of a denom. It can be
+	// called by the authority, or by the admin of a factory denom.
+	RemoveDenomTransferHook(ctx context.Context, in *MsgRemoveDenomTransferHook, opts ...grpc.CallOption) (*MsgRemoveDenomTransferHookResponse, error)
+	// SetDenomTransferFee registers or updates the transfer fee of a denom. It
+	// can be called by the authority, or by the admin of a factory denom.
+	SetDenomTransferFee(ctx context.Context, in *MsgSetDenomTransferFee, opts ...grpc.CallOption) (*MsgSetDenomTransferFeeResponse, error)
+	// RemoveDenomTransferFee removes the transfer fee of a denom. It can be
+	// called by the authority, or by the admin of a factory denom.
+	RemoveDenomTransferFee(ctx context.Context, in *MsgRemoveDenomTransferFee, opts ...grpc.CallOption) (*MsgRemoveDenomTransferFeeResponse, error)
+	// SetDenomMetadata registers the metadata of a denom that has none. It can be
+	// called by the authority, or by the admin of a factory denom.
+	SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadata, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error)
+	// UpdateDenomMetadata replaces the registered metadata of a denom. It can be
+	// called by the authority, or by the admin of a factory denom.
+	UpdateDenomMetadata(ctx context.Context, in *MsgUpdateDenomMetadata, opts ...grpc.CallOption) (*MsgUpdateDenomMetadataResponse, error)
+	// SweepDust sweeps the dust balances of inactive accounts to the community
+	// pool. It can be called by anyone.
+	SweepDust(ctx context.Context, in *MsgSweepDust, opts ...grpc.CallOption) (*MsgSweepDustResponse, error)
+	// SetSpendingLimit sets the daily limit of the amount of a denom the signer
+	// can send to other accounts. Lowering the limit takes effect immediately,
+	// raising or removing it after the spending_limit_change_delay param.
+	SetSpendingLimit(ctx context.Context, in *MsgSetSpendingLimit, opts ...grpc.CallOption) (*MsgSetSpendingLimitResponse, error)
 }
 
 type msgClient struct {
@@ -105,6 +177,159 @@ func (c *msgClient) SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, o
 	return out, nil
 }
 
+func (c *msgClient) SetBalanceAlerts(ctx context.Context, in *MsgSetBalanceAlerts, opts ...grpc.CallOption) (*MsgSetBalanceAlertsResponse, error) {
+	out := new(MsgSetBalanceAlertsResponse)
+	err := c.cc.Invoke(ctx, Msg_SetBalanceAlerts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateDenom(ctx context.Context, in *MsgCreateDenom, opts ...grpc.CallOption) (*MsgCreateDenomResponse, error) {
+	out := new(MsgCreateDenomResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateDenom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FactoryMint(ctx context.Context, in *MsgFactoryMint, opts ...grpc.CallOption) (*MsgFactoryMintResponse, error) {
+	out := new(MsgFactoryMintResponse)
+	err := c.cc.Invoke(ctx, Msg_FactoryMint_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FactoryBurn(ctx context.Context, in *MsgFactoryBurn, opts ...grpc.CallOption) (*MsgFactoryBurnResponse, error) {
+	out := new(MsgFactoryBurnResponse)
+	err := c.cc.Invoke(ctx, Msg_FactoryBurn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ForceTransfer(ctx context.Context, in *MsgForceTransfer, opts ...grpc.CallOption) (*MsgForceTransferResponse, error) {
+	out := new(MsgForceTransferResponse)
+	err := c.cc.Invoke(ctx, Msg_ForceTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ChangeDenomAdmin(ctx context.Context, in *MsgChangeDenomAdmin, opts ...grpc.CallOption) (*MsgChangeDenomAdminResponse, error) {
+	out := new(MsgChangeDenomAdminResponse)
+	err := c.cc.Invoke(ctx, Msg_ChangeDenomAdmin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFactoryDenomMetadata(ctx context.Context, in *MsgSetFactoryDenomMetadata, opts ...grpc.CallOption) (*MsgSetFactoryDenomMetadataResponse, error) {
+	out := new(MsgSetFactoryDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, Msg_SetFactoryDenomMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSendRestrictionPolicies(ctx context.Context, in *MsgSetSendRestrictionPolicies, opts ...grpc.CallOption) (*MsgSetSendRestrictionPoliciesResponse, error) {
+	out := new(MsgSetSendRestrictionPoliciesResponse)
+	err := c.cc.Invoke(ctx, Msg_SetSendRestrictionPolicies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetNonCirculatingAddresses(ctx context.Context, in *MsgSetNonCirculatingAddresses, opts ...grpc.CallOption) (*MsgSetNonCirculatingAddressesResponse, error) {
+	out := new(MsgSetNonCirculatingAddressesResponse)
+	err := c.cc.Invoke(ctx, Msg_SetNonCirculatingAddresses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomTransferHook(ctx context.Context, in *MsgSetDenomTransferHook, opts ...grpc.CallOption) (*MsgSetDenomTransferHookResponse, error) {
+	out := new(MsgSetDenomTransferHookResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDenomTransferHook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveDenomTransferHook(ctx context.Context, in *MsgRemoveDenomTransferHook, opts ...grpc.CallOption) (*MsgRemoveDenomTransferHookResponse, error) {
+	out := new(MsgRemoveDenomTransferHookResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveDenomTransferHook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomTransferFee(ctx context.Context, in *MsgSetDenomTransferFee, opts ...grpc.CallOption) (*MsgSetDenomTransferFeeResponse, error) {
+	out := new(MsgSetDenomTransferFeeResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDenomTransferFee_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveDenomTransferFee(ctx context.Context, in *MsgRemoveDenomTransferFee, opts ...grpc.CallOption) (*MsgRemoveDenomTransferFeeResponse, error) {
+	out := new(MsgRemoveDenomTransferFeeResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveDenomTransferFee_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadata, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error) {
+	out := new(MsgSetDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDenomMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateDenomMetadata(ctx context.Context, in *MsgUpdateDenomMetadata, opts ...grpc.CallOption) (*MsgUpdateDenomMetadataResponse, error) {
+	out := new(MsgUpdateDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateDenomMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SweepDust(ctx context.Context, in *MsgSweepDust, opts ...grpc.CallOption) (*MsgSweepDustResponse, error) {
+	out := new(MsgSweepDustResponse)
+	err := c.cc.Invoke(ctx, Msg_SweepDust_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSpendingLimit(ctx context.Context, in *MsgSetSpendingLimit, opts ...grpc.CallOption) (*MsgSetSpendingLimitResponse, error) {
+	out := new(MsgSetSpendingLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_SetSpendingLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -129,6 +354,61 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
+	// SetBalanceAlerts is a governance operation for registering balance
+	// thresholds on module accounts. Only the entries to add, update or delete
+	// should be included.
+	SetBalanceAlerts(context.Context, *MsgSetBalanceAlerts) (*MsgSetBalanceAlertsResponse, error)
+	// CreateDenom creates a denom in the factory/{sender}/{subdenom} format
+	// through the token factory, with the sender as admin.
+	CreateDenom(context.Context, *MsgCreateDenom) (*MsgCreateDenomResponse, error)
+	// FactoryMint mints coins of a factory denom. It can only be called by the
+	// admin of the denom.
+	FactoryMint(context.Context, *MsgFactoryMint) (*MsgFactoryMintResponse, error)
+	// FactoryBurn burns coins of a factory denom. It can only be called by the
+	// admin of the denom.
+	FactoryBurn(context.Context, *MsgFactoryBurn) (*MsgFactoryBurnResponse, error)
+	// ForceTransfer transfers coins of a factory denom between any two accounts.
+	// It can only be called by the admin of the denom.
+	ForceTransfer(context.Context, *MsgForceTransfer) (*MsgForceTransferResponse, error)
+	// ChangeDenomAdmin changes the admin of a factory denom. It can only be called
+	// by the admin of the denom.
+	ChangeDenomAdmin(context.Context, *MsgChangeDenomAdmin) (*MsgChangeDenomAdminResponse, error)
+	// SetFactoryDenomMetadata sets the metadata of a factory denom. It can only be
+	// called by the admin of the denom.
+	SetFactoryDenomMetadata(context.Context, *MsgSetFactoryDenomMetadata) (*MsgSetFactoryDenomMetadataResponse, error)
+	// SetSendRestrictionPolicies is a governance operation for registering,
+	// updating and removing send restriction policies. Only the policies to
+	// register, update or remove should be included.
+	SetSendRestrictionPolicies(context.Context, *MsgSetSendRestrictionPolicies) (*MsgSetSendRestrictionPoliciesResponse, error)
+	// SetNonCirculatingAddresses is a governance operation for adding and
+	// removing addresses from the list of accounts whose balances are excluded
+	// from the circulating supply.
+	SetNonCirculatingAddresses(context.Context, *MsgSetNonCirculatingAddresses) (*MsgSetNonCirculatingAddressesResponse, error)
+	// SetDenomTransferHook registers or updates the transfer hook of a denom. It
+	// can be called by the authority, or by the admin of a factory denom.
+	SetDenomTransferHook(context.Context, *MsgSetDenomTransferHook) (*MsgSetDenomTransferHookResponse, error)
+	// RemoveDenomTransferHook removes the transfer hook of a denom. It can be
+	// called by the authority, or by the admin of a factory denom.
+	RemoveDenomTransferHook(context.Context, *MsgRemoveDenomTransferHook) (*MsgRemoveDenomTransferHookResponse, error)
+	// SetDenomTransferFee registers or updates the transfer fee of a denom. It
+	// can be called by the authority, or by the admin of a factory denom.
+	SetDenomTransferFee(context.Context, *MsgSetDenomTransferFee) (*MsgSetDenomTransferFeeResponse, error)
+	// RemoveDenomTransferFee removes the transfer fee of a denom. It can be
+	// called by the authority, or by the admin of a factory denom.
+	RemoveDenomTransferFee(context.Context, *MsgRemoveDenomTransferFee) (*MsgRemoveDenomTransferFeeResponse, error)
+	// SetDenomMetadata registers the metadata of a denom that has none. It can be
+	// called by the authority, or by the admin of a factory denom.
+	SetDenomMetadata(context.Context, *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error)
+	// UpdateDenomMetadata replaces the registered metadata of a denom. It can be
+	// called by the authority, or by the admin of a factory denom.
+	UpdateDenomMetadata(context.Context, *MsgUpdateDenomMetadata) (*MsgUpdateDenomMetadataResponse, error)
+	// SweepDust sweeps the dust balances of inactive accounts to the community
+	// pool. It can be called by anyone.
+	SweepDust(context.Context, *MsgSweepDust) (*MsgSweepDustResponse, error)
+	// SetSpendingLimit sets the daily limit of the amount of a denom the signer
+	// can send to other accounts. Lowering the limit takes effect immediately,
+	// raising or removing it after the spending_limit_change_delay param.
+	SetSpendingLimit(context.Context, *MsgSetSpendingLimit) (*MsgSetSpendingLimitResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -151,6 +431,57 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetSendEnabled not implemented")
 }
+func (UnimplementedMsgServer) SetBalanceAlerts(context.Context, *MsgSetBalanceAlerts) (*MsgSetBalanceAlertsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBalanceAlerts not implemented")
+}
+func (UnimplementedMsgServer) CreateDenom(context.Context, *MsgCreateDenom) (*MsgCreateDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDenom not implemented")
+}
+func (UnimplementedMsgServer) FactoryMint(context.Context, *MsgFactoryMint) (*MsgFactoryMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FactoryMint not implemented")
+}
+func (UnimplementedMsgServer) FactoryBurn(context.Context, *MsgFactoryBurn) (*MsgFactoryBurnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FactoryBurn not implemented")
+}
+func (UnimplementedMsgServer) ForceTransfer(context.Context, *MsgForceTransfer) (*MsgForceTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceTransfer not implemented")
+}
+func (UnimplementedMsgServer) ChangeDenomAdmin(context.Context, *MsgChangeDenomAdmin) (*MsgChangeDenomAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeDenomAdmin not implemented")
+}
+func (UnimplementedMsgServer) SetFactoryDenomMetadata(context.Context, *MsgSetFactoryDenomMetadata) (*MsgSetFactoryDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFactoryDenomMetadata not implemented")
+}
+func (UnimplementedMsgServer) SetSendRestrictionPolicies(context.Context, *MsgSetSendRestrictionPolicies) (*MsgSetSendRestrictionPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSendRestrictionPolicies not implemented")
+}
+func (UnimplementedMsgServer) SetNonCirculatingAddresses(context.Context, *MsgSetNonCirculatingAddresses) (*MsgSetNonCirculatingAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNonCirculatingAddresses not implemented")
+}
+func (UnimplementedMsgServer) SetDenomTransferHook(context.Context, *MsgSetDenomTransferHook) (*MsgSetDenomTransferHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomTransferHook not implemented")
+}
+func (UnimplementedMsgServer) RemoveDenomTransferHook(context.Context, *MsgRemoveDenomTransferHook) (*MsgRemoveDenomTransferHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDenomTransferHook not implemented")
+}
+func (UnimplementedMsgServer) SetDenomTransferFee(context.Context, *MsgSetDenomTransferFee) (*MsgSetDenomTransferFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomTransferFee not implemented")
+}
+func (UnimplementedMsgServer) RemoveDenomTransferFee(context.Context, *MsgRemoveDenomTransferFee) (*MsgRemoveDenomTransferFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDenomTransferFee not implemented")
+}
+func (UnimplementedMsgServer) SetDenomMetadata(context.Context, *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomMetadata not implemented")
+}
+func (UnimplementedMsgServer) UpdateDenomMetadata(context.Context, *MsgUpdateDenomMetadata) (*MsgUpdateDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDenomMetadata not implemented")
+}
+func (UnimplementedMsgServer) SweepDust(context.Context, *MsgSweepDust) (*MsgSweepDustResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SweepDust not implemented")
+}
+func (UnimplementedMsgServer) SetSpendingLimit(context.Context, *MsgSetSpendingLimit) (*MsgSetSpendingLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSpendingLimit not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -254,6 +585,312 @@ func _Msg_SetSendEnabled_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetBalanceAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBalanceAlerts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBalanceAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetBalanceAlerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBalanceAlerts(ctx, req.(*MsgSetBalanceAlerts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDenom(ctx, req.(*MsgCreateDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FactoryMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFactoryMint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FactoryMint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FactoryMint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FactoryMint(ctx, req.(*MsgFactoryMint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FactoryBurn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFactoryBurn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FactoryBurn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FactoryBurn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FactoryBurn(ctx, req.(*MsgFactoryBurn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ForceTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForceTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForceTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ForceTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForceTransfer(ctx, req.(*MsgForceTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeDenomAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeDenomAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeDenomAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ChangeDenomAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeDenomAdmin(ctx, req.(*MsgChangeDenomAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFactoryDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFactoryDenomMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFactoryDenomMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetFactoryDenomMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFactoryDenomMetadata(ctx, req.(*MsgSetFactoryDenomMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSendRestrictionPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSendRestrictionPolicies)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSendRestrictionPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetSendRestrictionPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSendRestrictionPolicies(ctx, req.(*MsgSetSendRestrictionPolicies))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetNonCirculatingAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetNonCirculatingAddresses)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetNonCirculatingAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetNonCirculatingAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetNonCirculatingAddresses(ctx, req.(*MsgSetNonCirculatingAddresses))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomTransferHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomTransferHook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomTransferHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetDenomTransferHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomTransferHook(ctx, req.(*MsgSetDenomTransferHook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveDenomTransferHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveDenomTransferHook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveDenomTransferHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveDenomTransferHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveDenomTransferHook(ctx, req.(*MsgRemoveDenomTransferHook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomTransferFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomTransferFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomTransferFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetDenomTransferFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomTransferFee(ctx, req.(*MsgSetDenomTransferFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveDenomTransferFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveDenomTransferFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveDenomTransferFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveDenomTransferFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveDenomTransferFee(ctx, req.(*MsgRemoveDenomTransferFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetDenomMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomMetadata(ctx, req.(*MsgSetDenomMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDenomMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDenomMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateDenomMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDenomMetadata(ctx, req.(*MsgUpdateDenomMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SweepDust_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSweepDust)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SweepDust(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SweepDust_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SweepDust(ctx, req.(*MsgSweepDust))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSpendingLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSpendingLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSpendingLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetSpendingLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSpendingLimit(ctx, req.(*MsgSetSpendingLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -281,6 +918,74 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetSendEnabled",
 			Handler:    _Msg_SetSendEnabled_Handler,
 		},
+		{
+			MethodName: "SetBalanceAlerts",
+			Handler:    _Msg_SetBalanceAlerts_Handler,
+		},
+		{
+			MethodName: "CreateDenom",
+			Handler:    _Msg_CreateDenom_Handler,
+		},
+		{
+			MethodName: "FactoryMint",
+			Handler:    _Msg_FactoryMint_Handler,
+		},
+		{
+			MethodName: "FactoryBurn",
+			Handler:    _Msg_FactoryBurn_Handler,
+		},
+		{
+			MethodName: "ForceTransfer",
+			Handler:    _Msg_ForceTransfer_Handler,
+		},
+		{
+			MethodName: "ChangeDenomAdmin",
+			Handler:    _Msg_ChangeDenomAdmin_Handler,
+		},
+		{
+			MethodName: "SetFactoryDenomMetadata",
+			Handler:    _Msg_SetFactoryDenomMetadata_Handler,
+		},
+		{
+			MethodName: "SetSendRestrictionPolicies",
+			Handler:    _Msg_SetSendRestrictionPolicies_Handler,
+		},
+		{
+			MethodName: "SetNonCirculatingAddresses",
+			Handler:    _Msg_SetNonCirculatingAddresses_Handler,
+		},
+		{
+			MethodName: "SetDenomTransferHook",
+			Handler:    _Msg_SetDenomTransferHook_Handler,
+		},
+		{
+			MethodName: "RemoveDenomTransferHook",
+			Handler:    _Msg_RemoveDenomTransferHook_Handler,
+		},
+		{
+			MethodName: "SetDenomTransferFee",
+			Handler:    _Msg_SetDenomTransferFee_Handler,
+		},
+		{
+			MethodName: "RemoveDenomTransferFee",
+			Handler:    _Msg_RemoveDenomTransferFee_Handler,
+		},
+		{
+			MethodName: "SetDenomMetadata",
+			Handler:    _Msg_SetDenomMetadata_Handler,
+		},
+		{
+			MethodName: "UpdateDenomMetadata",
+			Handler:    _Msg_UpdateDenomMetadata_Handler,
+		},
+		{
+			MethodName: "SweepDust",
+			Handler:    _Msg_SweepDust_Handler,
+		},
+		{
+			MethodName: "SetSpendingLimit",
+			Handler:    _Msg_SetSpendingLimit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/tx.proto",
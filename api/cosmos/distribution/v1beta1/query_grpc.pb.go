@@ -28,6 +28,12 @@ const (
 	Query_DelegationTotalRewards_FullMethodName      = "/cosmos.distribution.v1beta1.Query/DelegationTotalRewards"
 	Query_DelegatorValidators_FullMethodName         = "/cosmos.distribution.v1beta1.Query/DelegatorValidators"
 	Query_DelegatorWithdrawAddress_FullMethodName    = "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawAddress"
+	Query_RewardEventsExport_FullMethodName          = "/cosmos.distribution.v1beta1.Query/RewardEventsExport"
+	Query_ValidatorExternalRewards_FullMethodName    = "/cosmos.distribution.v1beta1.Query/ValidatorExternalRewards"
+	Query_CommissionSplit_FullMethodName             = "/cosmos.distribution.v1beta1.Query/CommissionSplit"
+	Query_TruncationDust_FullMethodName              = "/cosmos.distribution.v1beta1.Query/TruncationDust"
+	Query_HistoricalRewardsStats_FullMethodName      = "/cosmos.distribution.v1beta1.Query/HistoricalRewardsStats"
+	Query_ProjectedRewards_FullMethodName            = "/cosmos.distribution.v1beta1.Query/ProjectedRewards"
 	Query_CommunityPool_FullMethodName               = "/cosmos.distribution.v1beta1.Query/CommunityPool"
 )
 
@@ -54,6 +60,25 @@ type QueryClient interface {
 	DelegatorValidators(ctx context.Context, in *QueryDelegatorValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
+	// RewardEventsExport exports the reward withdrawal history of a delegator,
+	// from the log maintained when reward events are enabled.
+	RewardEventsExport(ctx context.Context, in *QueryRewardEventsExportRequest, opts ...grpc.CallOption) (*QueryRewardEventsExportResponse, error)
+	// ValidatorExternalRewards queries the rewards added to a validator by the
+	// external reward sources, per source.
+	ValidatorExternalRewards(ctx context.Context, in *QueryValidatorExternalRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorExternalRewardsResponse, error)
+	// CommissionSplit queries the payout addresses between which the commission
+	// of a validator is split on withdrawal.
+	CommissionSplit(ctx context.Context, in *QueryCommissionSplitRequest, opts ...grpc.CallOption) (*QueryCommissionSplitResponse, error)
+	// TruncationDust queries the cumulative fractions of coins by which the
+	// rewards paid out for a validator were rounded.
+	TruncationDust(ctx context.Context, in *QueryTruncationDustRequest, opts ...grpc.CallOption) (*QueryTruncationDustResponse, error)
+	// HistoricalRewardsStats queries the number of historical rewards records and
+	// slash events stored per validator.
+	HistoricalRewardsStats(ctx context.Context, in *QueryHistoricalRewardsStatsRequest, opts ...grpc.CallOption) (*QueryHistoricalRewardsStatsResponse, error)
+	// ProjectedRewards queries the estimated annual rewards of the delegations of
+	// a delegator, per validator, based on the current inflation, community tax,
+	// validator commissions and bonded tokens.
+	ProjectedRewards(ctx context.Context, in *QueryProjectedRewardsRequest, opts ...grpc.CallOption) (*QueryProjectedRewardsResponse, error)
 	// Deprecated: Do not use.
 	// CommunityPool queries the community pool coins.
 	//
@@ -151,6 +176,60 @@ func (c *queryClient) DelegatorWithdrawAddress(ctx context.Context, in *QueryDel
 	return out, nil
 }
 
+func (c *queryClient) RewardEventsExport(ctx context.Context, in *QueryRewardEventsExportRequest, opts ...grpc.CallOption) (*QueryRewardEventsExportResponse, error) {
+	out := new(QueryRewardEventsExportResponse)
+	err := c.cc.Invoke(ctx, Query_RewardEventsExport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorExternalRewards(ctx context.Context, in *QueryValidatorExternalRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorExternalRewardsResponse, error) {
+	out := new(QueryValidatorExternalRewardsResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorExternalRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CommissionSplit(ctx context.Context, in *QueryCommissionSplitRequest, opts ...grpc.CallOption) (*QueryCommissionSplitResponse, error) {
+	out := new(QueryCommissionSplitResponse)
+	err := c.cc.Invoke(ctx, Query_CommissionSplit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TruncationDust(ctx context.Context, in *QueryTruncationDustRequest, opts ...grpc.CallOption) (*QueryTruncationDustResponse, error) {
+	out := new(QueryTruncationDustResponse)
+	err := c.cc.Invoke(ctx, Query_TruncationDust_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HistoricalRewardsStats(ctx context.Context, in *QueryHistoricalRewardsStatsRequest, opts ...grpc.CallOption) (*QueryHistoricalRewardsStatsResponse, error) {
+	out := new(QueryHistoricalRewardsStatsResponse)
+	err := c.cc.Invoke(ctx, Query_HistoricalRewardsStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectedRewards(ctx context.Context, in *QueryProjectedRewardsRequest, opts ...grpc.CallOption) (*QueryProjectedRewardsResponse, error) {
+	out := new(QueryProjectedRewardsResponse)
+	err := c.cc.Invoke(ctx, Query_ProjectedRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error) {
 	out := new(QueryCommunityPoolResponse)
@@ -184,6 +263,25 @@ type QueryServer interface {
 	DelegatorValidators(context.Context, *QueryDelegatorValidatorsRequest) (*QueryDelegatorValidatorsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
+	// RewardEventsExport exports the reward withdrawal history of a delegator,
+	// from the log maintained when reward events are enabled.
+	RewardEventsExport(context.Context, *QueryRewardEventsExportRequest) (*QueryRewardEventsExportResponse, error)
+	// ValidatorExternalRewards queries the rewards added to a validator by the
+	// external reward sources, per source.
+	ValidatorExternalRewards(context.Context, *QueryValidatorExternalRewardsRequest) (*QueryValidatorExternalRewardsResponse, error)
+	// CommissionSplit queries the payout addresses between which the commission
+	// of a validator is split on withdrawal.
+	CommissionSplit(context.Context, *QueryCommissionSplitRequest) (*QueryCommissionSplitResponse, error)
+	// TruncationDust queries the cumulative fractions of coins by which the
+	// rewards paid out for a validator were rounded.
+	TruncationDust(context.Context, *QueryTruncationDustRequest) (*QueryTruncationDustResponse, error)
+	// HistoricalRewardsStats queries the number of historical rewards records and
+	// slash events stored per validator.
+	HistoricalRewardsStats(context.Context, *QueryHistoricalRewardsStatsRequest) (*QueryHistoricalRewardsStatsResponse, error)
+	// ProjectedRewards queries the estimated annual rewards of the delegations of
+	// a delegator, per validator, based on the current inflation, community tax,
+	// validator commissions and bonded tokens.
+	ProjectedRewards(context.Context, *QueryProjectedRewardsRequest) (*QueryProjectedRewardsResponse, error)
 	// Deprecated: Do not use.
 	// CommunityPool queries the community pool coins.
 	//
@@ -224,6 +322,24 @@ func (UnimplementedQueryServer) DelegatorValidators(context.Context, *QueryDeleg
 func (UnimplementedQueryServer) DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorWithdrawAddress not implemented")
 }
+func (UnimplementedQueryServer) RewardEventsExport(context.Context, *QueryRewardEventsExportRequest) (*QueryRewardEventsExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardEventsExport not implemented")
+}
+func (UnimplementedQueryServer) ValidatorExternalRewards(context.Context, *QueryValidatorExternalRewardsRequest) (*QueryValidatorExternalRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorExternalRewards not implemented")
+}
+func (UnimplementedQueryServer) CommissionSplit(context.Context, *QueryCommissionSplitRequest) (*QueryCommissionSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommissionSplit not implemented")
+}
+func (UnimplementedQueryServer) TruncationDust(context.Context, *QueryTruncationDustRequest) (*QueryTruncationDustResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TruncationDust not implemented")
+}
+func (UnimplementedQueryServer) HistoricalRewardsStats(context.Context, *QueryHistoricalRewardsStatsRequest) (*QueryHistoricalRewardsStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HistoricalRewardsStats not implemented")
+}
+func (UnimplementedQueryServer) ProjectedRewards(context.Context, *QueryProjectedRewardsRequest) (*QueryProjectedRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedRewards not implemented")
+}
 func (UnimplementedQueryServer) CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
@@ -402,6 +518,114 @@ func _Query_DelegatorWithdrawAddress_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RewardEventsExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardEventsExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardEventsExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RewardEventsExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardEventsExport(ctx, req.(*QueryRewardEventsExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorExternalRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorExternalRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorExternalRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorExternalRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorExternalRewards(ctx, req.(*QueryValidatorExternalRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CommissionSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommissionSplitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommissionSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CommissionSplit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommissionSplit(ctx, req.(*QueryCommissionSplitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TruncationDust_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTruncationDustRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TruncationDust(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TruncationDust_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TruncationDust(ctx, req.(*QueryTruncationDustRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HistoricalRewardsStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHistoricalRewardsStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HistoricalRewardsStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_HistoricalRewardsStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HistoricalRewardsStats(ctx, req.(*QueryHistoricalRewardsStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectedRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProjectedRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedRewards(ctx, req.(*QueryProjectedRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryCommunityPoolRequest)
 	if err := dec(in); err != nil {
@@ -463,6 +687,30 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorWithdrawAddress",
 			Handler:    _Query_DelegatorWithdrawAddress_Handler,
 		},
+		{
+			MethodName: "RewardEventsExport",
+			Handler:    _Query_RewardEventsExport_Handler,
+		},
+		{
+			MethodName: "ValidatorExternalRewards",
+			Handler:    _Query_ValidatorExternalRewards_Handler,
+		},
+		{
+			MethodName: "CommissionSplit",
+			Handler:    _Query_CommissionSplit_Handler,
+		},
+		{
+			MethodName: "TruncationDust",
+			Handler:    _Query_TruncationDust_Handler,
+		},
+		{
+			MethodName: "HistoricalRewardsStats",
+			Handler:    _Query_HistoricalRewardsStats_Handler,
+		},
+		{
+			MethodName: "ProjectedRewards",
+			Handler:    _Query_ProjectedRewards_Handler,
+		},
 		{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
@@ -19,8 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Evidence_FullMethodName    = "/cosmos.evidence.v1beta1.Query/Evidence"
-	Query_AllEvidence_FullMethodName = "/cosmos.evidence.v1beta1.Query/AllEvidence"
+	Query_Evidence_FullMethodName                   = "/cosmos.evidence.v1beta1.Query/Evidence"
+	Query_AllEvidence_FullMethodName                = "/cosmos.evidence.v1beta1.Query/AllEvidence"
+	Query_ExternalInfractionPolicies_FullMethodName = "/cosmos.evidence.v1beta1.Query/ExternalInfractionPolicies"
+	Query_ExternalInfractions_FullMethodName        = "/cosmos.evidence.v1beta1.Query/ExternalInfractions"
 )
 
 // QueryClient is the client API for Query service.
@@ -31,6 +33,11 @@ type QueryClient interface {
 	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(ctx context.Context, in *QueryAllEvidenceRequest, opts ...grpc.CallOption) (*QueryAllEvidenceResponse, error)
+	// ExternalInfractionPolicies queries all external infraction policies.
+	ExternalInfractionPolicies(ctx context.Context, in *QueryExternalInfractionPoliciesRequest, opts ...grpc.CallOption) (*QueryExternalInfractionPoliciesResponse, error)
+	// ExternalInfractions queries all external infractions converted to local
+	// slashing.
+	ExternalInfractions(ctx context.Context, in *QueryExternalInfractionsRequest, opts ...grpc.CallOption) (*QueryExternalInfractionsResponse, error)
 }
 
 type queryClient struct {
@@ -59,6 +66,24 @@ func (c *queryClient) AllEvidence(ctx context.Context, in *QueryAllEvidenceReque
 	return out, nil
 }
 
+func (c *queryClient) ExternalInfractionPolicies(ctx context.Context, in *QueryExternalInfractionPoliciesRequest, opts ...grpc.CallOption) (*QueryExternalInfractionPoliciesResponse, error) {
+	out := new(QueryExternalInfractionPoliciesResponse)
+	err := c.cc.Invoke(ctx, Query_ExternalInfractionPolicies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExternalInfractions(ctx context.Context, in *QueryExternalInfractionsRequest, opts ...grpc.CallOption) (*QueryExternalInfractionsResponse, error) {
+	out := new(QueryExternalInfractionsResponse)
+	err := c.cc.Invoke(ctx, Query_ExternalInfractions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -67,6 +92,11 @@ type QueryServer interface {
 	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error)
+	// ExternalInfractionPolicies queries all external infraction policies.
+	ExternalInfractionPolicies(context.Context, *QueryExternalInfractionPoliciesRequest) (*QueryExternalInfractionPoliciesResponse, error)
+	// ExternalInfractions queries all external infractions converted to local
+	// slashing.
+	ExternalInfractions(context.Context, *QueryExternalInfractionsRequest) (*QueryExternalInfractionsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -80,6 +110,12 @@ func (UnimplementedQueryServer) Evidence(context.Context, *QueryEvidenceRequest)
 func (UnimplementedQueryServer) AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllEvidence not implemented")
 }
+func (UnimplementedQueryServer) ExternalInfractionPolicies(context.Context, *QueryExternalInfractionPoliciesRequest) (*QueryExternalInfractionPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExternalInfractionPolicies not implemented")
+}
+func (UnimplementedQueryServer) ExternalInfractions(context.Context, *QueryExternalInfractionsRequest) (*QueryExternalInfractionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExternalInfractions not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -129,6 +165,42 @@ func _Query_AllEvidence_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ExternalInfractionPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExternalInfractionPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExternalInfractionPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ExternalInfractionPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExternalInfractionPolicies(ctx, req.(*QueryExternalInfractionPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExternalInfractions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExternalInfractionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExternalInfractions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ExternalInfractions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExternalInfractions(ctx, req.(*QueryExternalInfractionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -144,6 +216,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllEvidence",
 			Handler:    _Query_AllEvidence_Handler,
 		},
+		{
+			MethodName: "ExternalInfractionPolicies",
+			Handler:    _Query_ExternalInfractionPolicies_Handler,
+		},
+		{
+			MethodName: "ExternalInfractions",
+			Handler:    _Query_ExternalInfractions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evidence/v1beta1/query.proto",
@@ -19,7 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_SubmitEvidence_FullMethodName = "/cosmos.evidence.v1beta1.Msg/SubmitEvidence"
+	Msg_SubmitEvidence_FullMethodName              = "/cosmos.evidence.v1beta1.Msg/SubmitEvidence"
+	Msg_SubmitExternalInfraction_FullMethodName    = "/cosmos.evidence.v1beta1.Msg/SubmitExternalInfraction"
+	Msg_SetExternalInfractionPolicy_FullMethodName = "/cosmos.evidence.v1beta1.Msg/SetExternalInfractionPolicy"
 )
 
 // MsgClient is the client API for Msg service.
@@ -29,6 +31,14 @@ type MsgClient interface {
 	// SubmitEvidence submits an arbitrary Evidence of misbehavior such as equivocation or
 	// counterfactual signing.
 	SubmitEvidence(ctx context.Context, in *MsgSubmitEvidence, opts ...grpc.CallOption) (*MsgSubmitEvidenceResponse, error)
+	// SubmitExternalInfraction defines a governance operation for slashing a
+	// validator for a misbehavior observed on an external chain, according to the
+	// external infraction policy of the chain.
+	SubmitExternalInfraction(ctx context.Context, in *MsgSubmitExternalInfraction, opts ...grpc.CallOption) (*MsgSubmitExternalInfractionResponse, error)
+	// SetExternalInfractionPolicy defines a governance operation for setting how
+	// the misbehaviors observed on an external chain are converted to local
+	// slashing.
+	SetExternalInfractionPolicy(ctx context.Context, in *MsgSetExternalInfractionPolicy, opts ...grpc.CallOption) (*MsgSetExternalInfractionPolicyResponse, error)
 }
 
 type msgClient struct {
@@ -48,6 +58,24 @@ func (c *msgClient) SubmitEvidence(ctx context.Context, in *MsgSubmitEvidence, o
 	return out, nil
 }
 
+func (c *msgClient) SubmitExternalInfraction(ctx context.Context, in *MsgSubmitExternalInfraction, opts ...grpc.CallOption) (*MsgSubmitExternalInfractionResponse, error) {
+	out := new(MsgSubmitExternalInfractionResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitExternalInfraction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetExternalInfractionPolicy(ctx context.Context, in *MsgSetExternalInfractionPolicy, opts ...grpc.CallOption) (*MsgSetExternalInfractionPolicyResponse, error) {
+	out := new(MsgSetExternalInfractionPolicyResponse)
+	err := c.cc.Invoke(ctx, Msg_SetExternalInfractionPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -55,6 +83,14 @@ type MsgServer interface {
 	// SubmitEvidence submits an arbitrary Evidence of misbehavior such as equivocation or
 	// counterfactual signing.
 	SubmitEvidence(context.Context, *MsgSubmitEvidence) (*MsgSubmitEvidenceResponse, error)
+	// SubmitExternalInfraction defines a governance operation for slashing a
+	// validator for a misbehavior observed on an external chain, according to the
+	// external infraction policy of the chain.
+	SubmitExternalInfraction(context.Context, *MsgSubmitExternalInfraction) (*MsgSubmitExternalInfractionResponse, error)
+	// SetExternalInfractionPolicy defines a governance operation for setting how
+	// the misbehaviors observed on an external chain are converted to local
+	// slashing.
+	SetExternalInfractionPolicy(context.Context, *MsgSetExternalInfractionPolicy) (*MsgSetExternalInfractionPolicyResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -65,6 +101,12 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) SubmitEvidence(context.Context, *MsgSubmitEvidence) (*MsgSubmitEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitEvidence not implemented")
 }
+func (UnimplementedMsgServer) SubmitExternalInfraction(context.Context, *MsgSubmitExternalInfraction) (*MsgSubmitExternalInfractionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitExternalInfraction not implemented")
+}
+func (UnimplementedMsgServer) SetExternalInfractionPolicy(context.Context, *MsgSetExternalInfractionPolicy) (*MsgSetExternalInfractionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExternalInfractionPolicy not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -96,6 +138,42 @@ func _Msg_SubmitEvidence_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitExternalInfraction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitExternalInfraction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitExternalInfraction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitExternalInfraction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitExternalInfraction(ctx, req.(*MsgSubmitExternalInfraction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetExternalInfractionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetExternalInfractionPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetExternalInfractionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetExternalInfractionPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetExternalInfractionPolicy(ctx, req.(*MsgSetExternalInfractionPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -107,6 +185,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitEvidence",
 			Handler:    _Msg_SubmitEvidence_Handler,
 		},
+		{
+			MethodName: "SubmitExternalInfraction",
+			Handler:    _Msg_SubmitExternalInfraction_Handler,
+		},
+		{
+			MethodName: "SetExternalInfractionPolicy",
+			Handler:    _Msg_SetExternalInfractionPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evidence/v1beta1/tx.proto",
@@ -21,17 +21,26 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Constitution_FullMethodName        = "/cosmos.gov.v1.Query/Constitution"
-	Query_Proposal_FullMethodName            = "/cosmos.gov.v1.Query/Proposal"
-	Query_Proposals_FullMethodName           = "/cosmos.gov.v1.Query/Proposals"
-	Query_Vote_FullMethodName                = "/cosmos.gov.v1.Query/Vote"
-	Query_Votes_FullMethodName               = "/cosmos.gov.v1.Query/Votes"
-	Query_Params_FullMethodName              = "/cosmos.gov.v1.Query/Params"
-	Query_Deposit_FullMethodName             = "/cosmos.gov.v1.Query/Deposit"
-	Query_Deposits_FullMethodName            = "/cosmos.gov.v1.Query/Deposits"
-	Query_TallyResult_FullMethodName         = "/cosmos.gov.v1.Query/TallyResult"
-	Query_ProposalVoteOptions_FullMethodName = "/cosmos.gov.v1.Query/ProposalVoteOptions"
-	Query_MessageBasedParams_FullMethodName  = "/cosmos.gov.v1.Query/MessageBasedParams"
+	Query_Constitution_FullMethodName          = "/cosmos.gov.v1.Query/Constitution"
+	Query_Proposal_FullMethodName              = "/cosmos.gov.v1.Query/Proposal"
+	Query_Proposals_FullMethodName             = "/cosmos.gov.v1.Query/Proposals"
+	Query_Vote_FullMethodName                  = "/cosmos.gov.v1.Query/Vote"
+	Query_Votes_FullMethodName                 = "/cosmos.gov.v1.Query/Votes"
+	Query_Params_FullMethodName                = "/cosmos.gov.v1.Query/Params"
+	Query_Deposit_FullMethodName               = "/cosmos.gov.v1.Query/Deposit"
+	Query_Deposits_FullMethodName              = "/cosmos.gov.v1.Query/Deposits"
+	Query_TallyResult_FullMethodName           = "/cosmos.gov.v1.Query/TallyResult"
+	Query_ProposalVoteOptions_FullMethodName   = "/cosmos.gov.v1.Query/ProposalVoteOptions"
+	Query_MessageBasedParams_FullMethodName    = "/cosmos.gov.v1.Query/MessageBasedParams"
+	Query_ArchivedProposal_FullMethodName      = "/cosmos.gov.v1.Query/ArchivedProposal"
+	Query_ArchivedProposals_FullMethodName     = "/cosmos.gov.v1.Query/ArchivedProposals"
+	Query_DepositHistory_FullMethodName        = "/cosmos.gov.v1.Query/DepositHistory"
+	Query_LineItemVetoes_FullMethodName        = "/cosmos.gov.v1.Query/LineItemVetoes"
+	Query_Schedule_FullMethodName              = "/cosmos.gov.v1.Query/Schedule"
+	Query_ProposalExecution_FullMethodName     = "/cosmos.gov.v1.Query/ProposalExecution"
+	Query_WhaleAlerts_FullMethodName           = "/cosmos.gov.v1.Query/WhaleAlerts"
+	Query_ProposalDraft_FullMethodName         = "/cosmos.gov.v1.Query/ProposalDraft"
+	Query_ProposalDraftVersions_FullMethodName = "/cosmos.gov.v1.Query/ProposalDraftVersions"
 )
 
 // QueryClient is the client API for Query service.
@@ -62,6 +71,39 @@ type QueryClient interface {
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	// Since: cosmos-sdk x/gov v1.0.0
 	MessageBasedParams(ctx context.Context, in *QueryMessageBasedParamsRequest, opts ...grpc.CallOption) (*QueryMessageBasedParamsResponse, error)
+	// ArchivedProposal queries the summary of an archived proposal based on its ID.
+	// Since: cosmos-sdk x/gov v1.0.0
+	ArchivedProposal(ctx context.Context, in *QueryArchivedProposalRequest, opts ...grpc.CallOption) (*QueryArchivedProposalResponse, error)
+	// ArchivedProposals queries the summaries of all archived proposals.
+	// Since: cosmos-sdk x/gov v1.0.0
+	ArchivedProposals(ctx context.Context, in *QueryArchivedProposalsRequest, opts ...grpc.CallOption) (*QueryArchivedProposalsResponse, error)
+	// DepositHistory queries the deposit history of a depositor across proposals.
+	// Since: cosmos-sdk x/gov v1.0.0
+	DepositHistory(ctx context.Context, in *QueryDepositHistoryRequest, opts ...grpc.CallOption) (*QueryDepositHistoryResponse, error)
+	// LineItemVetoes queries the proposal messages struck by the line-item veto authority, optionally
+	// for a single proposal.
+	// Since: cosmos-sdk x/gov v1.0.0
+	LineItemVetoes(ctx context.Context, in *QueryLineItemVetoesRequest, opts ...grpc.CallOption) (*QueryLineItemVetoesResponse, error)
+	// Schedule queries the upcoming governance events, such as the end of the deposit and voting
+	// periods and the execution of timelocked proposals, in chronological order.
+	// Since: cosmos-sdk x/gov v1.0.0
+	Schedule(ctx context.Context, in *QueryScheduleRequest, opts ...grpc.CallOption) (*QueryScheduleResponse, error)
+	// ProposalExecution queries the progress of the chunked execution of a passed proposal.
+	//
+	// Since: x/gov 1.0.0
+	ProposalExecution(ctx context.Context, in *QueryProposalExecutionRequest, opts ...grpc.CallOption) (*QueryProposalExecutionResponse, error)
+	// WhaleAlerts queries the aggregated whale alerts of a proposal.
+	//
+	// Since: x/gov 1.0.0
+	WhaleAlerts(ctx context.Context, in *QueryWhaleAlertsRequest, opts ...grpc.CallOption) (*QueryWhaleAlertsResponse, error)
+	// ProposalDraft queries a proposal draft along with the content of its latest version.
+	//
+	// Since: x/gov 1.0.0
+	ProposalDraft(ctx context.Context, in *QueryProposalDraftRequest, opts ...grpc.CallOption) (*QueryProposalDraftResponse, error)
+	// ProposalDraftVersions queries the versions of a proposal draft, from the oldest to the latest.
+	//
+	// Since: x/gov 1.0.0
+	ProposalDraftVersions(ctx context.Context, in *QueryProposalDraftVersionsRequest, opts ...grpc.CallOption) (*QueryProposalDraftVersionsResponse, error)
 }
 
 type queryClient struct {
@@ -171,6 +213,87 @@ func (c *queryClient) MessageBasedParams(ctx context.Context, in *QueryMessageBa
 	return out, nil
 }
 
+func (c *queryClient) ArchivedProposal(ctx context.Context, in *QueryArchivedProposalRequest, opts ...grpc.CallOption) (*QueryArchivedProposalResponse, error) {
+	out := new(QueryArchivedProposalResponse)
+	err := c.cc.Invoke(ctx, Query_ArchivedProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ArchivedProposals(ctx context.Context, in *QueryArchivedProposalsRequest, opts ...grpc.CallOption) (*QueryArchivedProposalsResponse, error) {
+	out := new(QueryArchivedProposalsResponse)
+	err := c.cc.Invoke(ctx, Query_ArchivedProposals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DepositHistory(ctx context.Context, in *QueryDepositHistoryRequest, opts ...grpc.CallOption) (*QueryDepositHistoryResponse, error) {
+	out := new(QueryDepositHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_DepositHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LineItemVetoes(ctx context.Context, in *QueryLineItemVetoesRequest, opts ...grpc.CallOption) (*QueryLineItemVetoesResponse, error) {
+	out := new(QueryLineItemVetoesResponse)
+	err := c.cc.Invoke(ctx, Query_LineItemVetoes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Schedule(ctx context.Context, in *QueryScheduleRequest, opts ...grpc.CallOption) (*QueryScheduleResponse, error) {
+	out := new(QueryScheduleResponse)
+	err := c.cc.Invoke(ctx, Query_Schedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalExecution(ctx context.Context, in *QueryProposalExecutionRequest, opts ...grpc.CallOption) (*QueryProposalExecutionResponse, error) {
+	out := new(QueryProposalExecutionResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WhaleAlerts(ctx context.Context, in *QueryWhaleAlertsRequest, opts ...grpc.CallOption) (*QueryWhaleAlertsResponse, error) {
+	out := new(QueryWhaleAlertsResponse)
+	err := c.cc.Invoke(ctx, Query_WhaleAlerts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalDraft(ctx context.Context, in *QueryProposalDraftRequest, opts ...grpc.CallOption) (*QueryProposalDraftResponse, error) {
+	out := new(QueryProposalDraftResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalDraft_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalDraftVersions(ctx context.Context, in *QueryProposalDraftVersionsRequest, opts ...grpc.CallOption) (*QueryProposalDraftVersionsResponse, error) {
+	out := new(QueryProposalDraftVersionsResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalDraftVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -199,6 +322,39 @@ type QueryServer interface {
 	// MessageBasedParams queries the message specific governance params based on a msg url.
 	// Since: cosmos-sdk x/gov v1.0.0
 	MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error)
+	// ArchivedProposal queries the summary of an archived proposal based on its ID.
+	// Since: cosmos-sdk x/gov v1.0.0
+	ArchivedProposal(context.Context, *QueryArchivedProposalRequest) (*QueryArchivedProposalResponse, error)
+	// ArchivedProposals queries the summaries of all archived proposals.
+	// Since: cosmos-sdk x/gov v1.0.0
+	ArchivedProposals(context.Context, *QueryArchivedProposalsRequest) (*QueryArchivedProposalsResponse, error)
+	// DepositHistory queries the deposit history of a depositor across proposals.
+	// Since: cosmos-sdk x/gov v1.0.0
+	DepositHistory(context.Context, *QueryDepositHistoryRequest) (*QueryDepositHistoryResponse, error)
+	// LineItemVetoes queries the proposal messages struck by the line-item veto authority, optionally
+	// for a single proposal.
+	// Since: cosmos-sdk x/gov v1.0.0
+	LineItemVetoes(context.Context, *QueryLineItemVetoesRequest) (*QueryLineItemVetoesResponse, error)
+	// Schedule queries the upcoming governance events, such as the end of the deposit and voting
+	// periods and the execution of timelocked proposals, in chronological order.
+	// Since: cosmos-sdk x/gov v1.0.0
+	Schedule(context.Context, *QueryScheduleRequest) (*QueryScheduleResponse, error)
+	// ProposalExecution queries the progress of the chunked execution of a passed proposal.
+	//
+	// Since: x/gov 1.0.0
+	ProposalExecution(context.Context, *QueryProposalExecutionRequest) (*QueryProposalExecutionResponse, error)
+	// WhaleAlerts queries the aggregated whale alerts of a proposal.
+	//
+	// Since: x/gov 1.0.0
+	WhaleAlerts(context.Context, *QueryWhaleAlertsRequest) (*QueryWhaleAlertsResponse, error)
+	// ProposalDraft queries a proposal draft along with the content of its latest version.
+	//
+	// Since: x/gov 1.0.0
+	ProposalDraft(context.Context, *QueryProposalDraftRequest) (*QueryProposalDraftResponse, error)
+	// ProposalDraftVersions queries the versions of a proposal draft, from the oldest to the latest.
+	//
+	// Since: x/gov 1.0.0
+	ProposalDraftVersions(context.Context, *QueryProposalDraftVersionsRequest) (*QueryProposalDraftVersionsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -239,6 +395,33 @@ func (UnimplementedQueryServer) ProposalVoteOptions(context.Context, *QueryPropo
 func (UnimplementedQueryServer) MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MessageBasedParams not implemented")
 }
+func (UnimplementedQueryServer) ArchivedProposal(context.Context, *QueryArchivedProposalRequest) (*QueryArchivedProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchivedProposal not implemented")
+}
+func (UnimplementedQueryServer) ArchivedProposals(context.Context, *QueryArchivedProposalsRequest) (*QueryArchivedProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchivedProposals not implemented")
+}
+func (UnimplementedQueryServer) DepositHistory(context.Context, *QueryDepositHistoryRequest) (*QueryDepositHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositHistory not implemented")
+}
+func (UnimplementedQueryServer) LineItemVetoes(context.Context, *QueryLineItemVetoesRequest) (*QueryLineItemVetoesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LineItemVetoes not implemented")
+}
+func (UnimplementedQueryServer) Schedule(context.Context, *QueryScheduleRequest) (*QueryScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Schedule not implemented")
+}
+func (UnimplementedQueryServer) ProposalExecution(context.Context, *QueryProposalExecutionRequest) (*QueryProposalExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalExecution not implemented")
+}
+func (UnimplementedQueryServer) WhaleAlerts(context.Context, *QueryWhaleAlertsRequest) (*QueryWhaleAlertsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhaleAlerts not implemented")
+}
+func (UnimplementedQueryServer) ProposalDraft(context.Context, *QueryProposalDraftRequest) (*QueryProposalDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalDraft not implemented")
+}
+func (UnimplementedQueryServer) ProposalDraftVersions(context.Context, *QueryProposalDraftVersionsRequest) (*QueryProposalDraftVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalDraftVersions not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -450,6 +633,168 @@ func _Query_MessageBasedParams_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ArchivedProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryArchivedProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ArchivedProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ArchivedProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ArchivedProposal(ctx, req.(*QueryArchivedProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ArchivedProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryArchivedProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ArchivedProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ArchivedProposals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ArchivedProposals(ctx, req.(*QueryArchivedProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DepositHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DepositHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DepositHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DepositHistory(ctx, req.(*QueryDepositHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LineItemVetoes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLineItemVetoesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LineItemVetoes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LineItemVetoes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LineItemVetoes(ctx, req.(*QueryLineItemVetoesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Schedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Schedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Schedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Schedule(ctx, req.(*QueryScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalExecution(ctx, req.(*QueryProposalExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WhaleAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWhaleAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WhaleAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_WhaleAlerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WhaleAlerts(ctx, req.(*QueryWhaleAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalDraftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalDraft(ctx, req.(*QueryProposalDraftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalDraftVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalDraftVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalDraftVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalDraftVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalDraftVersions(ctx, req.(*QueryProposalDraftVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -501,6 +846,42 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MessageBasedParams",
 			Handler:    _Query_MessageBasedParams_Handler,
 		},
+		{
+			MethodName: "ArchivedProposal",
+			Handler:    _Query_ArchivedProposal_Handler,
+		},
+		{
+			MethodName: "ArchivedProposals",
+			Handler:    _Query_ArchivedProposals_Handler,
+		},
+		{
+			MethodName: "DepositHistory",
+			Handler:    _Query_DepositHistory_Handler,
+		},
+		{
+			MethodName: "LineItemVetoes",
+			Handler:    _Query_LineItemVetoes_Handler,
+		},
+		{
+			MethodName: "Schedule",
+			Handler:    _Query_Schedule_Handler,
+		},
+		{
+			MethodName: "ProposalExecution",
+			Handler:    _Query_ProposalExecution_Handler,
+		},
+		{
+			MethodName: "WhaleAlerts",
+			Handler:    _Query_WhaleAlerts_Handler,
+		},
+		{
+			MethodName: "ProposalDraft",
+			Handler:    _Query_ProposalDraft_Handler,
+		},
+		{
+			MethodName: "ProposalDraftVersions",
+			Handler:    _Query_ProposalDraftVersions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -31,6 +31,11 @@ const (
 	Msg_SubmitMultipleChoiceProposal_FullMethodName = "/cosmos.gov.v1.Msg/SubmitMultipleChoiceProposal"
 	Msg_UpdateMessageParams_FullMethodName          = "/cosmos.gov.v1.Msg/UpdateMessageParams"
 	Msg_SudoExec_FullMethodName                     = "/cosmos.gov.v1.Msg/SudoExec"
+	Msg_VoteRanked_FullMethodName                   = "/cosmos.gov.v1.Msg/VoteRanked"
+	Msg_VetoProposalMessages_FullMethodName         = "/cosmos.gov.v1.Msg/VetoProposalMessages"
+	Msg_CreateProposalDraft_FullMethodName          = "/cosmos.gov.v1.Msg/CreateProposalDraft"
+	Msg_UpdateProposalDraft_FullMethodName          = "/cosmos.gov.v1.Msg/UpdateProposalDraft"
+	Msg_PromoteProposalDraft_FullMethodName         = "/cosmos.gov.v1.Msg/PromoteProposalDraft"
 )
 
 // MsgClient is the client API for Msg service.
@@ -70,6 +75,30 @@ type MsgClient interface {
 	//
 	// Since: x/gov 1.0.0
 	SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.CallOption) (*MsgSudoExecResponse, error)
+	// VoteRanked defines a method to add a ranked ballot on a multiple choice proposal.
+	//
+	// Since: x/gov 1.0.0
+	VoteRanked(ctx context.Context, in *MsgVoteRanked, opts ...grpc.CallOption) (*MsgVoteRankedResponse, error)
+	// VetoProposalMessages defines a method for the line-item veto authority to strike messages of a
+	// passed multi-message proposal during its timelock.
+	//
+	// Since: x/gov v1.0.0
+	VetoProposalMessages(ctx context.Context, in *MsgVetoProposalMessages, opts ...grpc.CallOption) (*MsgVetoProposalMessagesResponse, error)
+	// CreateProposalDraft defines a method to create a proposal draft, iterated on by its owner
+	// before its submission.
+	//
+	// Since: x/gov v1.0.0
+	CreateProposalDraft(ctx context.Context, in *MsgCreateProposalDraft, opts ...grpc.CallOption) (*MsgCreateProposalDraftResponse, error)
+	// UpdateProposalDraft defines a method for the owner of a proposal draft to record a new version
+	// of its content.
+	//
+	// Since: x/gov v1.0.0
+	UpdateProposalDraft(ctx context.Context, in *MsgUpdateProposalDraft, opts ...grpc.CallOption) (*MsgUpdateProposalDraftResponse, error)
+	// PromoteProposalDraft defines a method for the owner of a proposal draft to submit its latest
+	// version as a proposal.
+	//
+	// Since: x/gov v1.0.0
+	PromoteProposalDraft(ctx context.Context, in *MsgPromoteProposalDraft, opts ...grpc.CallOption) (*MsgPromoteProposalDraftResponse, error)
 }
 
 type msgClient struct {
@@ -170,6 +199,51 @@ func (c *msgClient) SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) VoteRanked(ctx context.Context, in *MsgVoteRanked, opts ...grpc.CallOption) (*MsgVoteRankedResponse, error) {
+	out := new(MsgVoteRankedResponse)
+	err := c.cc.Invoke(ctx, Msg_VoteRanked_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VetoProposalMessages(ctx context.Context, in *MsgVetoProposalMessages, opts ...grpc.CallOption) (*MsgVetoProposalMessagesResponse, error) {
+	out := new(MsgVetoProposalMessagesResponse)
+	err := c.cc.Invoke(ctx, Msg_VetoProposalMessages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateProposalDraft(ctx context.Context, in *MsgCreateProposalDraft, opts ...grpc.CallOption) (*MsgCreateProposalDraftResponse, error) {
+	out := new(MsgCreateProposalDraftResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateProposalDraft_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateProposalDraft(ctx context.Context, in *MsgUpdateProposalDraft, opts ...grpc.CallOption) (*MsgUpdateProposalDraftResponse, error) {
+	out := new(MsgUpdateProposalDraftResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateProposalDraft_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PromoteProposalDraft(ctx context.Context, in *MsgPromoteProposalDraft, opts ...grpc.CallOption) (*MsgPromoteProposalDraftResponse, error) {
+	out := new(MsgPromoteProposalDraftResponse)
+	err := c.cc.Invoke(ctx, Msg_PromoteProposalDraft_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -207,6 +281,30 @@ type MsgServer interface {
 	//
 	// Since: x/gov 1.0.0
 	SudoExec(context.Context, *MsgSudoExec) (*MsgSudoExecResponse, error)
+	// VoteRanked defines a method to add a ranked ballot on a multiple choice proposal.
+	//
+	// Since: x/gov 1.0.0
+	VoteRanked(context.Context, *MsgVoteRanked) (*MsgVoteRankedResponse, error)
+	// VetoProposalMessages defines a method for the line-item veto authority to strike messages of a
+	// passed multi-message proposal during its timelock.
+	//
+	// Since: x/gov v1.0.0
+	VetoProposalMessages(context.Context, *MsgVetoProposalMessages) (*MsgVetoProposalMessagesResponse, error)
+	// CreateProposalDraft defines a method to create a proposal draft, iterated on by its owner
+	// before its submission.
+	//
+	// Since: x/gov v1.0.0
+	CreateProposalDraft(context.Context, *MsgCreateProposalDraft) (*MsgCreateProposalDraftResponse, error)
+	// UpdateProposalDraft defines a method for the owner of a proposal draft to record a new version
+	// of its content.
+	//
+	// Since: x/gov v1.0.0
+	UpdateProposalDraft(context.Context, *MsgUpdateProposalDraft) (*MsgUpdateProposalDraftResponse, error)
+	// PromoteProposalDraft defines a method for the owner of a proposal draft to submit its latest
+	// version as a proposal.
+	//
+	// Since: x/gov v1.0.0
+	PromoteProposalDraft(context.Context, *MsgPromoteProposalDraft) (*MsgPromoteProposalDraftResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -244,6 +342,21 @@ func (UnimplementedMsgServer) UpdateMessageParams(context.Context, *MsgUpdateMes
 func (UnimplementedMsgServer) SudoExec(context.Context, *MsgSudoExec) (*MsgSudoExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SudoExec not implemented")
 }
+func (UnimplementedMsgServer) VoteRanked(context.Context, *MsgVoteRanked) (*MsgVoteRankedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteRanked not implemented")
+}
+func (UnimplementedMsgServer) VetoProposalMessages(context.Context, *MsgVetoProposalMessages) (*MsgVetoProposalMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VetoProposalMessages not implemented")
+}
+func (UnimplementedMsgServer) CreateProposalDraft(context.Context, *MsgCreateProposalDraft) (*MsgCreateProposalDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProposalDraft not implemented")
+}
+func (UnimplementedMsgServer) UpdateProposalDraft(context.Context, *MsgUpdateProposalDraft) (*MsgUpdateProposalDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProposalDraft not implemented")
+}
+func (UnimplementedMsgServer) PromoteProposalDraft(context.Context, *MsgPromoteProposalDraft) (*MsgPromoteProposalDraftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteProposalDraft not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -437,6 +550,96 @@ func _Msg_SudoExec_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_VoteRanked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteRanked)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoteRanked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_VoteRanked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteRanked(ctx, req.(*MsgVoteRanked))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VetoProposalMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVetoProposalMessages)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VetoProposalMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_VetoProposalMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VetoProposalMessages(ctx, req.(*MsgVetoProposalMessages))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateProposalDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateProposalDraft)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateProposalDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateProposalDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateProposalDraft(ctx, req.(*MsgCreateProposalDraft))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateProposalDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateProposalDraft)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateProposalDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateProposalDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateProposalDraft(ctx, req.(*MsgUpdateProposalDraft))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PromoteProposalDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPromoteProposalDraft)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PromoteProposalDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PromoteProposalDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PromoteProposalDraft(ctx, req.(*MsgPromoteProposalDraft))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -484,6 +687,26 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SudoExec",
 			Handler:    _Msg_SudoExec_Handler,
 		},
+		{
+			MethodName: "VoteRanked",
+			Handler:    _Msg_VoteRanked_Handler,
+		},
+		{
+			MethodName: "VetoProposalMessages",
+			Handler:    _Msg_VetoProposalMessages_Handler,
+		},
+		{
+			MethodName: "CreateProposalDraft",
+			Handler:    _Msg_CreateProposalDraft_Handler,
+		},
+		{
+			MethodName: "UpdateProposalDraft",
+			Handler:    _Msg_UpdateProposalDraft_Handler,
+		},
+		{
+			MethodName: "PromoteProposalDraft",
+			Handler:    _Msg_PromoteProposalDraft_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
@@ -17,11 +17,13 @@ import (
 )
 
 var (
-	md_Module                          protoreflect.MessageDescriptor
-	fd_Module_max_execution_period     protoreflect.FieldDescriptor
-	fd_Module_max_metadata_len         protoreflect.FieldDescriptor
-	fd_Module_max_proposal_title_len   protoreflect.FieldDescriptor
-	fd_Module_max_proposal_summary_len protoreflect.FieldDescriptor
+	md_Module                                   protoreflect.MessageDescriptor
+	fd_Module_max_execution_period              protoreflect.FieldDescriptor
+	fd_Module_max_metadata_len                  protoreflect.FieldDescriptor
+	fd_Module_max_proposal_title_len            protoreflect.FieldDescriptor
+	fd_Module_max_proposal_summary_len          protoreflect.FieldDescriptor
+	fd_Module_recurring_proposal_epoch          protoreflect.FieldDescriptor
+	fd_Module_max_recurring_proposal_executions protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -31,6 +33,8 @@ func init() {
 	fd_Module_max_metadata_len = md_Module.Fields().ByName("max_metadata_len")
 	fd_Module_max_proposal_title_len = md_Module.Fields().ByName("max_proposal_title_len")
 	fd_Module_max_proposal_summary_len = md_Module.Fields().ByName("max_proposal_summary_len")
+	fd_Module_recurring_proposal_epoch = md_Module.Fields().ByName("recurring_proposal_epoch")
+	fd_Module_max_recurring_proposal_executions = md_Module.Fields().ByName("max_recurring_proposal_executions")
 }
 
 var _ protoreflect.Message = (*fastReflection_Module)(nil)
@@ -122,6 +126,18 @@ func (x *fastReflection_Module) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.RecurringProposalEpoch != nil {
+		value := protoreflect.ValueOfMessage(x.RecurringProposalEpoch.ProtoReflect())
+		if !f(fd_Module_recurring_proposal_epoch, value) {
+			return
+		}
+	}
+	if x.MaxRecurringProposalExecutions != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxRecurringProposalExecutions)
+		if !f(fd_Module_max_recurring_proposal_executions, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -145,6 +161,10 @@ func (x *fastReflection_Module) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.MaxProposalTitleLen != uint64(0)
 	case "cosmos.group.module.v1.Module.max_proposal_summary_len":
 		return x.MaxProposalSummaryLen != uint64(0)
+	case "cosmos.group.module.v1.Module.recurring_proposal_epoch":
+		return x.RecurringProposalEpoch != nil
+	case "cosmos.group.module.v1.Module.max_recurring_proposal_executions":
+		return x.MaxRecurringProposalExecutions != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.module.v1.Module"))
@@ -169,6 +189,10 @@ func (x *fastReflection_Module) Clear(fd protoreflect.FieldDescriptor) {
 		x.MaxProposalTitleLen = uint64(0)
 	case "cosmos.group.module.v1.Module.max_proposal_summary_len":
 		x.MaxProposalSummaryLen = uint64(0)
+	case "cosmos.group.module.v1.Module.recurring_proposal_epoch":
+		x.RecurringProposalEpoch = nil
+	case "cosmos.group.module.v1.Module.max_recurring_proposal_executions":
+		x.MaxRecurringProposalExecutions = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.module.v1.Module"))
@@ -197,6 +221,12 @@ func (x *fastReflection_Module) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cosmos.group.module.v1.Module.max_proposal_summary_len":
 		value := x.MaxProposalSummaryLen
 		return protoreflect.ValueOfUint64(value)
+	case "cosmos.group.module.v1.Module.recurring_proposal_epoch":
+		value := x.RecurringProposalEpoch
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.group.module.v1.Module.max_recurring_proposal_executions":
+		value := x.MaxRecurringProposalExecutions
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.module.v1.Module"))
@@ -225,6 +255,10 @@ func (x *fastReflection_Module) Set(fd protoreflect.FieldDescriptor, value proto
 		x.MaxProposalTitleLen = value.Uint()
 	case "cosmos.group.module.v1.Module.max_proposal_summary_len":
 		x.MaxProposalSummaryLen = value.Uint()
+	case "cosmos.group.module.v1.Module.recurring_proposal_epoch":
+		x.RecurringProposalEpoch = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.group.module.v1.Module.max_recurring_proposal_executions":
+		x.MaxRecurringProposalExecutions = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.module.v1.Module"))
@@ -250,12 +284,19 @@ func (x *fastReflection_Module) Mutable(fd protoreflect.FieldDescriptor) protore
 			x.MaxExecutionPeriod = new(durationpb.Duration)
 		}
 		return protoreflect.ValueOfMessage(x.MaxExecutionPeriod.ProtoReflect())
+	case "cosmos.group.module.v1.Module.recurring_proposal_epoch":
+		if x.RecurringProposalEpoch == nil {
+			x.RecurringProposalEpoch = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.RecurringProposalEpoch.ProtoReflect())
 	case "cosmos.group.module.v1.Module.max_metadata_len":
 		panic(fmt.Errorf("field max_metadata_len of message cosmos.group.module.v1.Module is not mutable"))
 	case "cosmos.group.module.v1.Module.max_proposal_title_len":
 		panic(fmt.Errorf("field max_proposal_title_len of message cosmos.group.module.v1.Module is not mutable"))
 	case "cosmos.group.module.v1.Module.max_proposal_summary_len":
 		panic(fmt.Errorf("field max_proposal_summary_len of message cosmos.group.module.v1.Module is not mutable"))
+	case "cosmos.group.module.v1.Module.max_recurring_proposal_executions":
+		panic(fmt.Errorf("field max_recurring_proposal_executions of message cosmos.group.module.v1.Module is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.module.v1.Module"))
@@ -278,6 +319,11 @@ func (x *fastReflection_Module) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "cosmos.group.module.v1.Module.max_proposal_summary_len":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.group.module.v1.Module.recurring_proposal_epoch":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.group.module.v1.Module.max_recurring_proposal_executions":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.module.v1.Module"))
@@ -360,6 +406,13 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 		if x.MaxProposalSummaryLen != 0 {
 			n += 1 + runtime.Sov(uint64(x.MaxProposalSummaryLen))
 		}
+		if x.RecurringProposalEpoch != nil {
+			l = options.Size(x.RecurringProposalEpoch)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxRecurringProposalExecutions != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxRecurringProposalExecutions))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -389,6 +442,25 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxRecurringProposalExecutions != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxRecurringProposalExecutions))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.RecurringProposalEpoch != nil {
+			encoded, err := options.Marshal(x.RecurringProposalEpoch)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
 		if x.MaxProposalSummaryLen != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxProposalSummaryLen))
 			i--
@@ -560,6 +632,61 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecurringProposalEpoch", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RecurringProposalEpoch == nil {
+					x.RecurringProposalEpoch = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RecurringProposalEpoch); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRecurringProposalExecutions", wireType)
+				}
+				x.MaxRecurringProposalExecutions = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxRecurringProposalExecutions |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -632,6 +759,14 @@ type Module struct {
 	// summary field
 	// Defaults to 10200 if not explicitly set.
 	MaxProposalSummaryLen uint64 `protobuf:"varint,4,opt,name=max_proposal_summary_len,json=maxProposalSummaryLen,proto3" json:"max_proposal_summary_len,omitempty"`
+	// recurring_proposal_epoch defines the length of an epoch used to schedule
+	// the executions of recurring proposals.
+	// Defaults to one day if not explicitly set.
+	RecurringProposalEpoch *durationpb.Duration `protobuf:"bytes,5,opt,name=recurring_proposal_epoch,json=recurringProposalEpoch,proto3" json:"recurring_proposal_epoch,omitempty"`
+	// max_recurring_proposal_executions defines the max number of recurring
+	// proposals executed in a block, the others being executed in the next blocks.
+	// Defaults to 100 if not explicitly set.
+	MaxRecurringProposalExecutions uint64 `protobuf:"varint,6,opt,name=max_recurring_proposal_executions,json=maxRecurringProposalExecutions,proto3" json:"max_recurring_proposal_executions,omitempty"`
 }
 
 func (x *Module) Reset() {
@@ -682,6 +817,20 @@ func (x *Module) GetMaxProposalSummaryLen() uint64 {
 	return 0
 }
 
+func (x *Module) GetRecurringProposalEpoch() *durationpb.Duration {
+	if x != nil {
+		return x.RecurringProposalEpoch
+	}
+	return nil
+}
+
+func (x *Module) GetMaxRecurringProposalExecutions() uint64 {
+	if x != nil {
+		return x.MaxRecurringProposalExecutions
+	}
+	return 0
+}
+
 var File_cosmos_group_module_v1_module_proto protoreflect.FileDescriptor
 
 var file_cosmos_group_module_v1_module_proto_rawDesc = []byte{
@@ -695,7 +844,7 @@ var file_cosmos_group_module_v1_module_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69,
-	0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9a, 0x02, 0x0a, 0x06, 0x4d, 0x6f, 0x64,
+	0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc9, 0x03, 0x0a, 0x06, 0x4d, 0x6f, 0x64,
 	0x75, 0x6c, 0x65, 0x12, 0x5a, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75,
 	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
@@ -711,23 +860,34 @@ var file_cosmos_group_module_v1_module_proto_rawDesc = []byte{
 	0x0a, 0x18, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x73,
 	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
 	0x52, 0x15, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x75, 0x6d,
-	0x6d, 0x61, 0x72, 0x79, 0x4c, 0x65, 0x6e, 0x3a, 0x1c, 0xba, 0xc0, 0x96, 0xda, 0x01, 0x16, 0x0a,
-	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x78, 0x2f,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0xd6, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
-	0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x47, 0x4d, 0xaa, 0x02, 0x16, 0x43, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
-	0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x16, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x5c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x22,
-	0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x5c, 0x4d, 0x6f, 0x64,
-	0x75, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x3a, 0x3a, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6d, 0x61, 0x72, 0x79, 0x4c, 0x65, 0x6e, 0x12, 0x62, 0x0a, 0x18, 0x72, 0x65, 0x63, 0x75, 0x72,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x16, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x49, 0x0a, 0x21, 0x6d,
+	0x61, 0x78, 0x5f, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1e, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x63, 0x75, 0x72,
+	0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x1c, 0xba, 0xc0, 0x96, 0xda, 0x01, 0x16, 0x0a, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x78, 0x2f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x42, 0xd6, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x47, 0x4d, 0xaa, 0x02, 0x16, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x2e, 0x56, 0x31, 0xca, 0x02, 0x16, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x5c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x22, 0x43,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x5c, 0x4d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x3a, 0x3a, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -749,11 +909,12 @@ var file_cosmos_group_module_v1_module_proto_goTypes = []interface{}{
 }
 var file_cosmos_group_module_v1_module_proto_depIdxs = []int32{
 	1, // 0: cosmos.group.module.v1.Module.max_execution_period:type_name -> google.protobuf.Duration
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1, // 1: cosmos.group.module.v1.Module.recurring_proposal_epoch:type_name -> google.protobuf.Duration
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_group_module_v1_module_proto_init() }
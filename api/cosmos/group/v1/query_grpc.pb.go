@@ -21,20 +21,23 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_GroupInfo_FullMethodName              = "/cosmos.group.v1.Query/GroupInfo"
-	Query_GroupPolicyInfo_FullMethodName        = "/cosmos.group.v1.Query/GroupPolicyInfo"
-	Query_GroupMembers_FullMethodName           = "/cosmos.group.v1.Query/GroupMembers"
-	Query_GroupsByAdmin_FullMethodName          = "/cosmos.group.v1.Query/GroupsByAdmin"
-	Query_GroupPoliciesByGroup_FullMethodName   = "/cosmos.group.v1.Query/GroupPoliciesByGroup"
-	Query_GroupPoliciesByAdmin_FullMethodName   = "/cosmos.group.v1.Query/GroupPoliciesByAdmin"
-	Query_Proposal_FullMethodName               = "/cosmos.group.v1.Query/Proposal"
-	Query_ProposalsByGroupPolicy_FullMethodName = "/cosmos.group.v1.Query/ProposalsByGroupPolicy"
-	Query_VoteByProposalVoter_FullMethodName    = "/cosmos.group.v1.Query/VoteByProposalVoter"
-	Query_VotesByProposal_FullMethodName        = "/cosmos.group.v1.Query/VotesByProposal"
-	Query_VotesByVoter_FullMethodName           = "/cosmos.group.v1.Query/VotesByVoter"
-	Query_GroupsByMember_FullMethodName         = "/cosmos.group.v1.Query/GroupsByMember"
-	Query_TallyResult_FullMethodName            = "/cosmos.group.v1.Query/TallyResult"
-	Query_Groups_FullMethodName                 = "/cosmos.group.v1.Query/Groups"
+	Query_GroupInfo_FullMethodName                       = "/cosmos.group.v1.Query/GroupInfo"
+	Query_GroupPolicyInfo_FullMethodName                 = "/cosmos.group.v1.Query/GroupPolicyInfo"
+	Query_GroupMembers_FullMethodName                    = "/cosmos.group.v1.Query/GroupMembers"
+	Query_GroupsByAdmin_FullMethodName                   = "/cosmos.group.v1.Query/GroupsByAdmin"
+	Query_GroupPoliciesByGroup_FullMethodName            = "/cosmos.group.v1.Query/GroupPoliciesByGroup"
+	Query_GroupPoliciesByAdmin_FullMethodName            = "/cosmos.group.v1.Query/GroupPoliciesByAdmin"
+	Query_Proposal_FullMethodName                        = "/cosmos.group.v1.Query/Proposal"
+	Query_ProposalsByGroupPolicy_FullMethodName          = "/cosmos.group.v1.Query/ProposalsByGroupPolicy"
+	Query_VoteByProposalVoter_FullMethodName             = "/cosmos.group.v1.Query/VoteByProposalVoter"
+	Query_VotesByProposal_FullMethodName                 = "/cosmos.group.v1.Query/VotesByProposal"
+	Query_VotesByVoter_FullMethodName                    = "/cosmos.group.v1.Query/VotesByVoter"
+	Query_GroupsByMember_FullMethodName                  = "/cosmos.group.v1.Query/GroupsByMember"
+	Query_TallyResult_FullMethodName                     = "/cosmos.group.v1.Query/TallyResult"
+	Query_Groups_FullMethodName                          = "/cosmos.group.v1.Query/Groups"
+	Query_RecurringProposal_FullMethodName               = "/cosmos.group.v1.Query/RecurringProposal"
+	Query_RecurringProposalsByGroupPolicy_FullMethodName = "/cosmos.group.v1.Query/RecurringProposalsByGroupPolicy"
+	Query_ProposalVoteReceipts_FullMethodName            = "/cosmos.group.v1.Query/ProposalVoteReceipts"
 )
 
 // QueryClient is the client API for Query service.
@@ -75,6 +78,19 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(ctx context.Context, in *QueryGroupsRequest, opts ...grpc.CallOption) (*QueryGroupsResponse, error)
+	// RecurringProposal queries the recurrence of a recurring proposal based on proposal id.
+	//
+	// Since: x/group 1.0.0
+	RecurringProposal(ctx context.Context, in *QueryRecurringProposalRequest, opts ...grpc.CallOption) (*QueryRecurringProposalResponse, error)
+	// RecurringProposalsByGroupPolicy queries recurring proposals based on account address of group policy.
+	//
+	// Since: x/group 1.0.0
+	RecurringProposalsByGroupPolicy(ctx context.Context, in *QueryRecurringProposalsByGroupPolicyRequest, opts ...grpc.CallOption) (*QueryRecurringProposalsByGroupPolicyResponse, error)
+	// ProposalVoteReceipts queries the vote receipts of a proposal, kept after its
+	// final tally when its group policy stores vote receipts.
+	//
+	// Since: x/group 1.0.0
+	ProposalVoteReceipts(ctx context.Context, in *QueryProposalVoteReceiptsRequest, opts ...grpc.CallOption) (*QueryProposalVoteReceiptsResponse, error)
 }
 
 type queryClient struct {
@@ -211,6 +227,33 @@ func (c *queryClient) Groups(ctx context.Context, in *QueryGroupsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) RecurringProposal(ctx context.Context, in *QueryRecurringProposalRequest, opts ...grpc.CallOption) (*QueryRecurringProposalResponse, error) {
+	out := new(QueryRecurringProposalResponse)
+	err := c.cc.Invoke(ctx, Query_RecurringProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecurringProposalsByGroupPolicy(ctx context.Context, in *QueryRecurringProposalsByGroupPolicyRequest, opts ...grpc.CallOption) (*QueryRecurringProposalsByGroupPolicyResponse, error) {
+	out := new(QueryRecurringProposalsByGroupPolicyResponse)
+	err := c.cc.Invoke(ctx, Query_RecurringProposalsByGroupPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalVoteReceipts(ctx context.Context, in *QueryProposalVoteReceiptsRequest, opts ...grpc.CallOption) (*QueryProposalVoteReceiptsResponse, error) {
+	out := new(QueryProposalVoteReceiptsResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalVoteReceipts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -249,6 +292,19 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error)
+	// RecurringProposal queries the recurrence of a recurring proposal based on proposal id.
+	//
+	// Since: x/group 1.0.0
+	RecurringProposal(context.Context, *QueryRecurringProposalRequest) (*QueryRecurringProposalResponse, error)
+	// RecurringProposalsByGroupPolicy queries recurring proposals based on account address of group policy.
+	//
+	// Since: x/group 1.0.0
+	RecurringProposalsByGroupPolicy(context.Context, *QueryRecurringProposalsByGroupPolicyRequest) (*QueryRecurringProposalsByGroupPolicyResponse, error)
+	// ProposalVoteReceipts queries the vote receipts of a proposal, kept after its
+	// final tally when its group policy stores vote receipts.
+	//
+	// Since: x/group 1.0.0
+	ProposalVoteReceipts(context.Context, *QueryProposalVoteReceiptsRequest) (*QueryProposalVoteReceiptsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -298,6 +354,15 @@ func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRe
 func (UnimplementedQueryServer) Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Groups not implemented")
 }
+func (UnimplementedQueryServer) RecurringProposal(context.Context, *QueryRecurringProposalRequest) (*QueryRecurringProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecurringProposal not implemented")
+}
+func (UnimplementedQueryServer) RecurringProposalsByGroupPolicy(context.Context, *QueryRecurringProposalsByGroupPolicyRequest) (*QueryRecurringProposalsByGroupPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecurringProposalsByGroupPolicy not implemented")
+}
+func (UnimplementedQueryServer) ProposalVoteReceipts(context.Context, *QueryProposalVoteReceiptsRequest) (*QueryProposalVoteReceiptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalVoteReceipts not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -563,6 +628,60 @@ func _Query_Groups_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RecurringProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecurringProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecurringProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RecurringProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecurringProposal(ctx, req.(*QueryRecurringProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecurringProposalsByGroupPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecurringProposalsByGroupPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecurringProposalsByGroupPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RecurringProposalsByGroupPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecurringProposalsByGroupPolicy(ctx, req.(*QueryRecurringProposalsByGroupPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalVoteReceipts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalVoteReceiptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalVoteReceipts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalVoteReceipts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalVoteReceipts(ctx, req.(*QueryProposalVoteReceiptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -626,6 +745,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Groups",
 			Handler:    _Query_Groups_Handler,
 		},
+		{
+			MethodName: "RecurringProposal",
+			Handler:    _Query_RecurringProposal_Handler,
+		},
+		{
+			MethodName: "RecurringProposalsByGroupPolicy",
+			Handler:    _Query_RecurringProposalsByGroupPolicy_Handler,
+		},
+		{
+			MethodName: "ProposalVoteReceipts",
+			Handler:    _Query_ProposalVoteReceipts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
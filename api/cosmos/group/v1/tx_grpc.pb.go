@@ -21,20 +21,24 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_CreateGroup_FullMethodName                     = "/cosmos.group.v1.Msg/CreateGroup"
-	Msg_UpdateGroupMembers_FullMethodName              = "/cosmos.group.v1.Msg/UpdateGroupMembers"
-	Msg_UpdateGroupAdmin_FullMethodName                = "/cosmos.group.v1.Msg/UpdateGroupAdmin"
-	Msg_UpdateGroupMetadata_FullMethodName             = "/cosmos.group.v1.Msg/UpdateGroupMetadata"
-	Msg_CreateGroupPolicy_FullMethodName               = "/cosmos.group.v1.Msg/CreateGroupPolicy"
-	Msg_CreateGroupWithPolicy_FullMethodName           = "/cosmos.group.v1.Msg/CreateGroupWithPolicy"
-	Msg_UpdateGroupPolicyAdmin_FullMethodName          = "/cosmos.group.v1.Msg/UpdateGroupPolicyAdmin"
-	Msg_UpdateGroupPolicyDecisionPolicy_FullMethodName = "/cosmos.group.v1.Msg/UpdateGroupPolicyDecisionPolicy"
-	Msg_UpdateGroupPolicyMetadata_FullMethodName       = "/cosmos.group.v1.Msg/UpdateGroupPolicyMetadata"
-	Msg_SubmitProposal_FullMethodName                  = "/cosmos.group.v1.Msg/SubmitProposal"
-	Msg_WithdrawProposal_FullMethodName                = "/cosmos.group.v1.Msg/WithdrawProposal"
-	Msg_Vote_FullMethodName                            = "/cosmos.group.v1.Msg/Vote"
-	Msg_Exec_FullMethodName                            = "/cosmos.group.v1.Msg/Exec"
-	Msg_LeaveGroup_FullMethodName                      = "/cosmos.group.v1.Msg/LeaveGroup"
+	Msg_CreateGroup_FullMethodName                        = "/cosmos.group.v1.Msg/CreateGroup"
+	Msg_UpdateGroupMembers_FullMethodName                 = "/cosmos.group.v1.Msg/UpdateGroupMembers"
+	Msg_UpdateGroupAdmin_FullMethodName                   = "/cosmos.group.v1.Msg/UpdateGroupAdmin"
+	Msg_UpdateGroupMetadata_FullMethodName                = "/cosmos.group.v1.Msg/UpdateGroupMetadata"
+	Msg_CreateGroupPolicy_FullMethodName                  = "/cosmos.group.v1.Msg/CreateGroupPolicy"
+	Msg_CreateGroupWithPolicy_FullMethodName              = "/cosmos.group.v1.Msg/CreateGroupWithPolicy"
+	Msg_UpdateGroupPolicyAdmin_FullMethodName             = "/cosmos.group.v1.Msg/UpdateGroupPolicyAdmin"
+	Msg_UpdateGroupPolicyDecisionPolicy_FullMethodName    = "/cosmos.group.v1.Msg/UpdateGroupPolicyDecisionPolicy"
+	Msg_UpdateGroupPolicyMetadata_FullMethodName          = "/cosmos.group.v1.Msg/UpdateGroupPolicyMetadata"
+	Msg_UpdateGroupPolicyVoteReceipts_FullMethodName      = "/cosmos.group.v1.Msg/UpdateGroupPolicyVoteReceipts"
+	Msg_UpdateGroupPolicyExecutionFeeLimit_FullMethodName = "/cosmos.group.v1.Msg/UpdateGroupPolicyExecutionFeeLimit"
+	Msg_SubmitProposal_FullMethodName                     = "/cosmos.group.v1.Msg/SubmitProposal"
+	Msg_WithdrawProposal_FullMethodName                   = "/cosmos.group.v1.Msg/WithdrawProposal"
+	Msg_Vote_FullMethodName                               = "/cosmos.group.v1.Msg/Vote"
+	Msg_SubmitVotesBatch_FullMethodName                   = "/cosmos.group.v1.Msg/SubmitVotesBatch"
+	Msg_Exec_FullMethodName                               = "/cosmos.group.v1.Msg/Exec"
+	Msg_LeaveGroup_FullMethodName                         = "/cosmos.group.v1.Msg/LeaveGroup"
+	Msg_CancelRecurringProposal_FullMethodName            = "/cosmos.group.v1.Msg/CancelRecurringProposal"
 )
 
 // MsgClient is the client API for Msg service.
@@ -59,16 +63,34 @@ type MsgClient interface {
 	UpdateGroupPolicyDecisionPolicy(ctx context.Context, in *MsgUpdateGroupPolicyDecisionPolicy, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyDecisionPolicyResponse, error)
 	// UpdateGroupPolicyMetadata updates a group policy metadata.
 	UpdateGroupPolicyMetadata(ctx context.Context, in *MsgUpdateGroupPolicyMetadata, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyMetadataResponse, error)
+	// UpdateGroupPolicyVoteReceipts updates whether a group policy stores vote receipts.
+	//
+	// Since: x/group 1.0.0
+	UpdateGroupPolicyVoteReceipts(ctx context.Context, in *MsgUpdateGroupPolicyVoteReceipts, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyVoteReceiptsResponse, error)
+	// UpdateGroupPolicyExecutionFeeLimit updates the spend limit of the fee
+	// allowances granted by a group policy to the executors of its proposals.
+	//
+	// Since: x/group 1.0.0
+	UpdateGroupPolicyExecutionFeeLimit(ctx context.Context, in *MsgUpdateGroupPolicyExecutionFeeLimit, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyExecutionFeeLimitResponse, error)
 	// SubmitProposal submits a new proposal.
 	SubmitProposal(ctx context.Context, in *MsgSubmitProposal, opts ...grpc.CallOption) (*MsgSubmitProposalResponse, error)
 	// WithdrawProposal withdraws a proposal.
 	WithdrawProposal(ctx context.Context, in *MsgWithdrawProposal, opts ...grpc.CallOption) (*MsgWithdrawProposalResponse, error)
 	// Vote allows a voter to vote on a proposal.
 	Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error)
+	// SubmitVotesBatch submits a batch of votes signed off-chain by the group
+	// members, aggregated by a relayer paying the transaction fees.
+	//
+	// Since: x/group 1.0.0
+	SubmitVotesBatch(ctx context.Context, in *MsgSubmitVotesBatch, opts ...grpc.CallOption) (*MsgSubmitVotesBatchResponse, error)
 	// Exec executes a proposal.
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error)
+	// CancelRecurringProposal stops the recurrence of a recurring proposal.
+	//
+	// Since: x/group 1.0.0
+	CancelRecurringProposal(ctx context.Context, in *MsgCancelRecurringProposal, opts ...grpc.CallOption) (*MsgCancelRecurringProposalResponse, error)
 }
 
 type msgClient struct {
@@ -160,6 +182,24 @@ func (c *msgClient) UpdateGroupPolicyMetadata(ctx context.Context, in *MsgUpdate
 	return out, nil
 }
 
+func (c *msgClient) UpdateGroupPolicyVoteReceipts(ctx context.Context, in *MsgUpdateGroupPolicyVoteReceipts, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyVoteReceiptsResponse, error) {
+	out := new(MsgUpdateGroupPolicyVoteReceiptsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateGroupPolicyVoteReceipts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateGroupPolicyExecutionFeeLimit(ctx context.Context, in *MsgUpdateGroupPolicyExecutionFeeLimit, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyExecutionFeeLimitResponse, error) {
+	out := new(MsgUpdateGroupPolicyExecutionFeeLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateGroupPolicyExecutionFeeLimit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SubmitProposal(ctx context.Context, in *MsgSubmitProposal, opts ...grpc.CallOption) (*MsgSubmitProposalResponse, error) {
 	out := new(MsgSubmitProposalResponse)
 	err := c.cc.Invoke(ctx, Msg_SubmitProposal_FullMethodName, in, out, opts...)
@@ -187,6 +227,15 @@ func (c *msgClient) Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) SubmitVotesBatch(ctx context.Context, in *MsgSubmitVotesBatch, opts ...grpc.CallOption) (*MsgSubmitVotesBatchResponse, error) {
+	out := new(MsgSubmitVotesBatchResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitVotesBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error) {
 	out := new(MsgExecResponse)
 	err := c.cc.Invoke(ctx, Msg_Exec_FullMethodName, in, out, opts...)
@@ -205,6 +254,15 @@ func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) CancelRecurringProposal(ctx context.Context, in *MsgCancelRecurringProposal, opts ...grpc.CallOption) (*MsgCancelRecurringProposalResponse, error) {
+	out := new(MsgCancelRecurringProposalResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelRecurringProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -227,16 +285,34 @@ type MsgServer interface {
 	UpdateGroupPolicyDecisionPolicy(context.Context, *MsgUpdateGroupPolicyDecisionPolicy) (*MsgUpdateGroupPolicyDecisionPolicyResponse, error)
 	// UpdateGroupPolicyMetadata updates a group policy metadata.
 	UpdateGroupPolicyMetadata(context.Context, *MsgUpdateGroupPolicyMetadata) (*MsgUpdateGroupPolicyMetadataResponse, error)
+	// UpdateGroupPolicyVoteReceipts updates whether a group policy stores vote receipts.
+	//
+	// Since: x/group 1.0.0
+	UpdateGroupPolicyVoteReceipts(context.Context, *MsgUpdateGroupPolicyVoteReceipts) (*MsgUpdateGroupPolicyVoteReceiptsResponse, error)
+	// UpdateGroupPolicyExecutionFeeLimit updates the spend limit of the fee
+	// allowances granted by a group policy to the executors of its proposals.
+	//
+	// Since: x/group 1.0.0
+	UpdateGroupPolicyExecutionFeeLimit(context.Context, *MsgUpdateGroupPolicyExecutionFeeLimit) (*MsgUpdateGroupPolicyExecutionFeeLimitResponse, error)
 	// SubmitProposal submits a new proposal.
 	SubmitProposal(context.Context, *MsgSubmitProposal) (*MsgSubmitProposalResponse, error)
 	// WithdrawProposal withdraws a proposal.
 	WithdrawProposal(context.Context, *MsgWithdrawProposal) (*MsgWithdrawProposalResponse, error)
 	// Vote allows a voter to vote on a proposal.
 	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
+	// SubmitVotesBatch submits a batch of votes signed off-chain by the group
+	// members, aggregated by a relayer paying the transaction fees.
+	//
+	// Since: x/group 1.0.0
+	SubmitVotesBatch(context.Context, *MsgSubmitVotesBatch) (*MsgSubmitVotesBatchResponse, error)
 	// Exec executes a proposal.
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error)
+	// CancelRecurringProposal stops the recurrence of a recurring proposal.
+	//
+	// Since: x/group 1.0.0
+	CancelRecurringProposal(context.Context, *MsgCancelRecurringProposal) (*MsgCancelRecurringProposalResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -271,6 +347,12 @@ func (UnimplementedMsgServer) UpdateGroupPolicyDecisionPolicy(context.Context, *
 func (UnimplementedMsgServer) UpdateGroupPolicyMetadata(context.Context, *MsgUpdateGroupPolicyMetadata) (*MsgUpdateGroupPolicyMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupPolicyMetadata not implemented")
 }
+func (UnimplementedMsgServer) UpdateGroupPolicyVoteReceipts(context.Context, *MsgUpdateGroupPolicyVoteReceipts) (*MsgUpdateGroupPolicyVoteReceiptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupPolicyVoteReceipts not implemented")
+}
+func (UnimplementedMsgServer) UpdateGroupPolicyExecutionFeeLimit(context.Context, *MsgUpdateGroupPolicyExecutionFeeLimit) (*MsgUpdateGroupPolicyExecutionFeeLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupPolicyExecutionFeeLimit not implemented")
+}
 func (UnimplementedMsgServer) SubmitProposal(context.Context, *MsgSubmitProposal) (*MsgSubmitProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitProposal not implemented")
 }
@@ -280,12 +362,18 @@ func (UnimplementedMsgServer) WithdrawProposal(context.Context, *MsgWithdrawProp
 func (UnimplementedMsgServer) Vote(context.Context, *MsgVote) (*MsgVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
 }
+func (UnimplementedMsgServer) SubmitVotesBatch(context.Context, *MsgSubmitVotesBatch) (*MsgSubmitVotesBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitVotesBatch not implemented")
+}
 func (UnimplementedMsgServer) Exec(context.Context, *MsgExec) (*MsgExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Exec not implemented")
 }
 func (UnimplementedMsgServer) LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
 }
+func (UnimplementedMsgServer) CancelRecurringProposal(context.Context, *MsgCancelRecurringProposal) (*MsgCancelRecurringProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRecurringProposal not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -461,6 +549,42 @@ func _Msg_UpdateGroupPolicyMetadata_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateGroupPolicyVoteReceipts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGroupPolicyVoteReceipts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGroupPolicyVoteReceipts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateGroupPolicyVoteReceipts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGroupPolicyVoteReceipts(ctx, req.(*MsgUpdateGroupPolicyVoteReceipts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateGroupPolicyExecutionFeeLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGroupPolicyExecutionFeeLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGroupPolicyExecutionFeeLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateGroupPolicyExecutionFeeLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGroupPolicyExecutionFeeLimit(ctx, req.(*MsgUpdateGroupPolicyExecutionFeeLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SubmitProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSubmitProposal)
 	if err := dec(in); err != nil {
@@ -515,6 +639,24 @@ func _Msg_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitVotesBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitVotesBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitVotesBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitVotesBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitVotesBatch(ctx, req.(*MsgSubmitVotesBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgExec)
 	if err := dec(in); err != nil {
@@ -551,6 +693,24 @@ func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelRecurringProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRecurringProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRecurringProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelRecurringProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRecurringProposal(ctx, req.(*MsgCancelRecurringProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -594,6 +754,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateGroupPolicyMetadata",
 			Handler:    _Msg_UpdateGroupPolicyMetadata_Handler,
 		},
+		{
+			MethodName: "UpdateGroupPolicyVoteReceipts",
+			Handler:    _Msg_UpdateGroupPolicyVoteReceipts_Handler,
+		},
+		{
+			MethodName: "UpdateGroupPolicyExecutionFeeLimit",
+			Handler:    _Msg_UpdateGroupPolicyExecutionFeeLimit_Handler,
+		},
 		{
 			MethodName: "SubmitProposal",
 			Handler:    _Msg_SubmitProposal_Handler,
@@ -606,6 +774,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Vote",
 			Handler:    _Msg_Vote_Handler,
 		},
+		{
+			MethodName: "SubmitVotesBatch",
+			Handler:    _Msg_SubmitVotesBatch_Handler,
+		},
 		{
 			MethodName: "Exec",
 			Handler:    _Msg_Exec_Handler,
@@ -614,6 +786,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LeaveGroup",
 			Handler:    _Msg_LeaveGroup_Handler,
 		},
+		{
+			MethodName: "CancelRecurringProposal",
+			Handler:    _Msg_CancelRecurringProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/tx.proto",
@@ -19,9 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName           = "/cosmos.mint.v1beta1.Query/Params"
-	Query_Inflation_FullMethodName        = "/cosmos.mint.v1beta1.Query/Inflation"
-	Query_AnnualProvisions_FullMethodName = "/cosmos.mint.v1beta1.Query/AnnualProvisions"
+	Query_Params_FullMethodName            = "/cosmos.mint.v1beta1.Query/Params"
+	Query_Inflation_FullMethodName         = "/cosmos.mint.v1beta1.Query/Inflation"
+	Query_AnnualProvisions_FullMethodName  = "/cosmos.mint.v1beta1.Query/AnnualProvisions"
+	Query_EmissionSchedules_FullMethodName = "/cosmos.mint.v1beta1.Query/EmissionSchedules"
+	Query_DenomMinter_FullMethodName       = "/cosmos.mint.v1beta1.Query/DenomMinter"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +36,11 @@ type QueryClient interface {
 	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// EmissionSchedules returns the emission schedules of the denoms minted in
+	// addition to the mint denom.
+	EmissionSchedules(ctx context.Context, in *QueryEmissionSchedulesRequest, opts ...grpc.CallOption) (*QueryEmissionSchedulesResponse, error)
+	// DenomMinter returns the minting state of a denom with an emission schedule.
+	DenomMinter(ctx context.Context, in *QueryDenomMinterRequest, opts ...grpc.CallOption) (*QueryDenomMinterResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +78,24 @@ func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvi
 	return out, nil
 }
 
+func (c *queryClient) EmissionSchedules(ctx context.Context, in *QueryEmissionSchedulesRequest, opts ...grpc.CallOption) (*QueryEmissionSchedulesResponse, error) {
+	out := new(QueryEmissionSchedulesResponse)
+	err := c.cc.Invoke(ctx, Query_EmissionSchedules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomMinter(ctx context.Context, in *QueryDenomMinterRequest, opts ...grpc.CallOption) (*QueryDenomMinterResponse, error) {
+	out := new(QueryDenomMinterResponse)
+	err := c.cc.Invoke(ctx, Query_DenomMinter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +106,11 @@ type QueryServer interface {
 	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// EmissionSchedules returns the emission schedules of the denoms minted in
+	// addition to the mint denom.
+	EmissionSchedules(context.Context, *QueryEmissionSchedulesRequest) (*QueryEmissionSchedulesResponse, error)
+	// DenomMinter returns the minting state of a denom with an emission schedule.
+	DenomMinter(context.Context, *QueryDenomMinterRequest) (*QueryDenomMinterResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +127,12 @@ func (UnimplementedQueryServer) Inflation(context.Context, *QueryInflationReques
 func (UnimplementedQueryServer) AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
 }
+func (UnimplementedQueryServer) EmissionSchedules(context.Context, *QueryEmissionSchedulesRequest) (*QueryEmissionSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmissionSchedules not implemented")
+}
+func (UnimplementedQueryServer) DenomMinter(context.Context, *QueryDenomMinterRequest) (*QueryDenomMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomMinter not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +200,42 @@ func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EmissionSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmissionSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EmissionSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EmissionSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EmissionSchedules(ctx, req.(*QueryEmissionSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomMinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomMinterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomMinter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomMinter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomMinter(ctx, req.(*QueryDenomMinterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +255,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AnnualProvisions",
 			Handler:    _Query_AnnualProvisions_Handler,
 		},
+		{
+			MethodName: "EmissionSchedules",
+			Handler:    _Query_EmissionSchedules_Handler,
+		},
+		{
+			MethodName: "DenomMinter",
+			Handler:    _Query_DenomMinter_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/query.proto",
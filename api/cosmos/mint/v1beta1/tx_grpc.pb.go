@@ -19,7 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName = "/cosmos.mint.v1beta1.Msg/UpdateParams"
+	Msg_UpdateParams_FullMethodName           = "/cosmos.mint.v1beta1.Msg/UpdateParams"
+	Msg_SetEmissionSchedule_FullMethodName    = "/cosmos.mint.v1beta1.Msg/SetEmissionSchedule"
+	Msg_RemoveEmissionSchedule_FullMethodName = "/cosmos.mint.v1beta1.Msg/RemoveEmissionSchedule"
 )
 
 // MsgClient is the client API for Msg service.
@@ -31,6 +33,13 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetEmissionSchedule defines a governance operation for setting the emission
+	// schedule of a denom minted in addition to the mint denom. The minting state
+	// of the denom is reset to the initial annual provisions of the schedule.
+	SetEmissionSchedule(ctx context.Context, in *MsgSetEmissionSchedule, opts ...grpc.CallOption) (*MsgSetEmissionScheduleResponse, error)
+	// RemoveEmissionSchedule defines a governance operation for removing the
+	// emission schedule of a denom, which stops minting it.
+	RemoveEmissionSchedule(ctx context.Context, in *MsgRemoveEmissionSchedule, opts ...grpc.CallOption) (*MsgRemoveEmissionScheduleResponse, error)
 }
 
 type msgClient struct {
@@ -50,6 +59,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetEmissionSchedule(ctx context.Context, in *MsgSetEmissionSchedule, opts ...grpc.CallOption) (*MsgSetEmissionScheduleResponse, error) {
+	out := new(MsgSetEmissionScheduleResponse)
+	err := c.cc.Invoke(ctx, Msg_SetEmissionSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveEmissionSchedule(ctx context.Context, in *MsgRemoveEmissionSchedule, opts ...grpc.CallOption) (*MsgRemoveEmissionScheduleResponse, error) {
+	out := new(MsgRemoveEmissionScheduleResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveEmissionSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -59,6 +86,13 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetEmissionSchedule defines a governance operation for setting the emission
+	// schedule of a denom minted in addition to the mint denom. The minting state
+	// of the denom is reset to the initial annual provisions of the schedule.
+	SetEmissionSchedule(context.Context, *MsgSetEmissionSchedule) (*MsgSetEmissionScheduleResponse, error)
+	// RemoveEmissionSchedule defines a governance operation for removing the
+	// emission schedule of a denom, which stops minting it.
+	RemoveEmissionSchedule(context.Context, *MsgRemoveEmissionSchedule) (*MsgRemoveEmissionScheduleResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -69,6 +103,12 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) SetEmissionSchedule(context.Context, *MsgSetEmissionSchedule) (*MsgSetEmissionScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEmissionSchedule not implemented")
+}
+func (UnimplementedMsgServer) RemoveEmissionSchedule(context.Context, *MsgRemoveEmissionSchedule) (*MsgRemoveEmissionScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveEmissionSchedule not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -100,6 +140,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetEmissionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetEmissionSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetEmissionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetEmissionSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetEmissionSchedule(ctx, req.(*MsgSetEmissionSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveEmissionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveEmissionSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveEmissionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveEmissionSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveEmissionSchedule(ctx, req.(*MsgRemoveEmissionSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -111,6 +187,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetEmissionSchedule",
+			Handler:    _Msg_SetEmissionSchedule_Handler,
+		},
+		{
+			MethodName: "RemoveEmissionSchedule",
+			Handler:    _Msg_RemoveEmissionSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/tx.proto",
@@ -19,7 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Send_FullMethodName = "/cosmos.nft.v1beta1.Msg/Send"
+	Msg_Send_FullMethodName       = "/cosmos.nft.v1beta1.Msg/Send"
+	Msg_GrantLease_FullMethodName = "/cosmos.nft.v1beta1.Msg/GrantLease"
+	Msg_EndLease_FullMethodName   = "/cosmos.nft.v1beta1.Msg/EndLease"
 )
 
 // MsgClient is the client API for Msg service.
@@ -28,6 +30,15 @@ const (
 type MsgClient interface {
 	// Send defines a method to send a nft from one account to another account.
 	Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOption) (*MsgSendResponse, error)
+	// GrantLease defines a method for the owner of a nft to grant its usage rights to another account
+	// until an expiration time.
+	//
+	// Since: nft v0.1.1
+	GrantLease(ctx context.Context, in *MsgGrantLease, opts ...grpc.CallOption) (*MsgGrantLeaseResponse, error)
+	// EndLease defines a method for a lessee to end its lease of a nft before its expiration.
+	//
+	// Since: nft v0.1.1
+	EndLease(ctx context.Context, in *MsgEndLease, opts ...grpc.CallOption) (*MsgEndLeaseResponse, error)
 }
 
 type msgClient struct {
@@ -47,12 +58,39 @@ func (c *msgClient) Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) GrantLease(ctx context.Context, in *MsgGrantLease, opts ...grpc.CallOption) (*MsgGrantLeaseResponse, error) {
+	out := new(MsgGrantLeaseResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantLease_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) EndLease(ctx context.Context, in *MsgEndLease, opts ...grpc.CallOption) (*MsgEndLeaseResponse, error) {
+	out := new(MsgEndLeaseResponse)
+	err := c.cc.Invoke(ctx, Msg_EndLease_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
 type MsgServer interface {
 	// Send defines a method to send a nft from one account to another account.
 	Send(context.Context, *MsgSend) (*MsgSendResponse, error)
+	// GrantLease defines a method for the owner of a nft to grant its usage rights to another account
+	// until an expiration time.
+	//
+	// Since: nft v0.1.1
+	GrantLease(context.Context, *MsgGrantLease) (*MsgGrantLeaseResponse, error)
+	// EndLease defines a method for a lessee to end its lease of a nft before its expiration.
+	//
+	// Since: nft v0.1.1
+	EndLease(context.Context, *MsgEndLease) (*MsgEndLeaseResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -63,6 +101,12 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) Send(context.Context, *MsgSend) (*MsgSendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Send not implemented")
 }
+func (UnimplementedMsgServer) GrantLease(context.Context, *MsgGrantLease) (*MsgGrantLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantLease not implemented")
+}
+func (UnimplementedMsgServer) EndLease(context.Context, *MsgEndLease) (*MsgEndLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndLease not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -94,6 +138,42 @@ func _Msg_Send_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_GrantLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantLease)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantLease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantLease(ctx, req.(*MsgGrantLease))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EndLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEndLease)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EndLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_EndLease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EndLease(ctx, req.(*MsgEndLease))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -105,6 +185,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Send",
 			Handler:    _Msg_Send_Handler,
 		},
+		{
+			MethodName: "GrantLease",
+			Handler:    _Msg_GrantLease_Handler,
+		},
+		{
+			MethodName: "EndLease",
+			Handler:    _Msg_EndLease_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/nft/v1beta1/tx.proto",
@@ -0,0 +1,2444 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package overviewv1
+
+import (
+	v1beta13 "cosmossdk.io/api/cosmos/authz/v1beta1"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	v1beta12 "cosmossdk.io/api/cosmos/distribution/v1beta1"
+	v1beta14 "cosmossdk.io/api/cosmos/feegrant/v1beta1"
+	_ "cosmossdk.io/api/cosmos/query/v1"
+	v1beta11 "cosmossdk.io/api/cosmos/staking/v1beta1"
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_QueryAccountOverviewRequest         protoreflect.MessageDescriptor
+	fd_QueryAccountOverviewRequest_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_overview_v1_query_proto_init()
+	md_QueryAccountOverviewRequest = File_cosmos_overview_v1_query_proto.Messages().ByName("QueryAccountOverviewRequest")
+	fd_QueryAccountOverviewRequest_address = md_QueryAccountOverviewRequest.Fields().ByName("address")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAccountOverviewRequest)(nil)
+
+type fastReflection_QueryAccountOverviewRequest QueryAccountOverviewRequest
+
+func (x *QueryAccountOverviewRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAccountOverviewRequest)(x)
+}
+
+func (x *QueryAccountOverviewRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_overview_v1_query_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAccountOverviewRequest_messageType fastReflection_QueryAccountOverviewRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAccountOverviewRequest_messageType{}
+
+type fastReflection_QueryAccountOverviewRequest_messageType struct{}
+
+func (x fastReflection_QueryAccountOverviewRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAccountOverviewRequest)(nil)
+}
+func (x fastReflection_QueryAccountOverviewRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAccountOverviewRequest)
+}
+func (x fastReflection_QueryAccountOverviewRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAccountOverviewRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAccountOverviewRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAccountOverviewRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAccountOverviewRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAccountOverviewRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAccountOverviewRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryAccountOverviewRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAccountOverviewRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryAccountOverviewRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAccountOverviewRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_QueryAccountOverviewRequest_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAccountOverviewRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.overview.v1.QueryAccountOverviewRequest.address":
+		return x.Address != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.overview.v1.QueryAccountOverviewRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.overview.v1.QueryAccountOverviewRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAccountOverviewRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.overview.v1.QueryAccountOverviewRequest.address":
+		x.Address = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.overview.v1.QueryAccountOverviewRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.overview.v1.QueryAccountOverviewRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAccountOverviewRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.overview.v1.QueryAccountOverviewRequest.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.overview.v1.QueryAccountOverviewRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.overview.v1.QueryAccountOverviewRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAccountOverviewRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.overview.v1.QueryAccountOverviewRequest.address":
+		x.Address = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.overview.v1.QueryAccountOverviewRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.overview.v1.QueryAccountOverviewRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAccountOverviewRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.overview.v1.QueryAccountOverviewRequest.address":
+		panic(fmt.Errorf("field address of message cosmos.overview.v1.QueryAccountOverviewRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.overview.v1.QueryAccountOverviewRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.overview.v1.QueryAccountOverviewRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAccountOverviewRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.overview.v1.QueryAccountOverviewRequest.address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.overview.v1.QueryAccountOverviewRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.overview.v1.QueryAccountOverviewRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAccountOverviewRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.overview.v1.QueryAccountOverviewRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAccountOverviewRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAccountOverviewRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAccountOverviewRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAccountOverviewRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAccountOverviewRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAccountOverviewRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAccountOverviewRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAccountOverviewRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAccountOverviewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryAccountOverviewResponse_2_list)(nil)
+
+type _QueryAccountOverviewResponse_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_QueryAccountOverviewResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryAccountOverviewResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryAccountOverviewResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryAccountOverviewResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryAccountOverviewResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAccountOverviewResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryAccountOverviewResponse_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAccountOverviewResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryAccountOverviewResponse_3_list)(nil)
+
+type _QueryAccountOverviewResponse_3_list struct {
+	list *[]*v1beta11.DelegationResponse
+}
+
+func (x *_QueryAccountOverviewResponse_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryAccountOverviewResponse_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryAccountOverviewResponse_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.DelegationResponse)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryAccountOverviewResponse_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.DelegationResponse)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryAccountOverviewResponse_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.DelegationResponse)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAccountOverviewResponse_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryAccountOverviewResponse_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.DelegationResponse)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAccountOverviewResponse_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryAccountOverviewResponse_4_list)(nil)
+
+type _QueryAccountOverviewResponse_4_list struct {
+	list *[]*v1beta11.UnbondingDelegation
+}
+
+func (x *_QueryAccountOverviewResponse_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryAccountOverviewResponse_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryAccountOverviewResponse_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.UnbondingDelegation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryAccountOverviewResponse_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.UnbondingDelegation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryAccountOverviewResponse_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.UnbondingDelegation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAccountOverviewResponse_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryAccountOverviewResponse_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.UnbondingDelegation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAccountOverviewResponse_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryAccountOverviewResponse_5_list)(nil)
+
+type _QueryAccountOverviewResponse_5_list struct {
+	list *[]*v1beta12.DelegationDelegatorReward
+}
+
+func (x *_QueryAccountOverviewResponse_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryAccountOverviewResponse_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryAccountOverviewResponse_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta12.DelegationDelegatorReward)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryAccountOverviewResponse_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta12.DelegationDelegatorReward)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryAccountOverviewResponse_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta12.DelegationDelegatorReward)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAccountOverviewResponse_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryAccountOverviewResponse_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta12.DelegationDelegatorReward)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAccountOverviewResponse_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryAccountOverviewResponse_6_list)(nil)
+
+type _QueryAccountOverviewResponse_6_list struct {
+	list *[]*v1beta1.DecCoin
+}
+
+func (x *_QueryAccountOverviewResponse_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryAccountOverviewResponse_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryAccountOverviewResponse_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryAccountOverviewResponse_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryAccountOverviewResponse_6_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAccountOverviewResponse_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryAccountOverviewResponse_6_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAccountOverviewResponse_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryAccountOverviewResponse_7_list)(nil)
+
+type _QueryAccountOverviewResponse_7_list struct {
+	list *[]*v1beta13.GrantAuthorization
+}
+
+func (x *_QueryAccountOverviewResponse_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryAccountOverviewResponse_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryAccountOverviewResponse_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta13.GrantAuthorization)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryAccountOverviewResponse_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta13.GrantAuthorization)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryAccountOverviewResponse_7_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta13.GrantAuthorization)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAccountOverviewResponse_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryAccountOverviewResponse_7_list) NewElement() protoreflect.Value {
+	v := new(v1beta13.GrantAuthorization)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAccountOverviewResponse_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryAccountOverviewResponse_8_list)(nil)
+
+type _QueryAccountOverviewResponse_8_list struct {
+	list *[]*v1beta13.GrantAuthorization
+}
+
+func (x *_QueryAccountOverviewResponse_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryAccountOverviewResponse_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryAccountOverviewResponse_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta13.GrantAuthorization)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryAccountOverviewResponse_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta13.GrantAuthorization)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryAccountOverviewResponse_8_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta13.GrantAuthorization)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAccountOverviewResponse_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryAccountOverviewResponse_8_list) NewElement() protoreflect.Value {
+	v := new(v1beta13.GrantAuthorization)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAccountOverviewResponse_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryAccountOverviewResponse_9_list)(nil)
+
+type _QueryAccountOverviewResponse_9_list struct {
+	list *[]*v1beta14.Grant
+}
+
+func (x *_QueryAccountOverviewResponse_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryAccountOverviewResponse_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryAccountOverviewResponse_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta14.Grant)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryAccountOverviewResponse_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta14.Grant)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryAccountOverviewResponse_9_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta14.Grant)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAccountOverviewResponse_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryAccountOverviewResponse_9_list) NewElement() protoreflect.Value {
+	v := new(v1beta14.Grant)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAccountOverviewResponse_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryAccountOverviewResponse_10_list)(nil)
+
+type _QueryAccountOverviewResponse_10_list struct {
+	list *[]*v1beta14.Grant
+}
+
+func (x *_QueryAccountOverviewResponse_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryAccountOverviewResponse_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryAccountOverviewResponse_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta14.Grant)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryAccountOverviewResponse_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta14.Grant)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryAccountOverviewResponse_10_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta14.Grant)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAccountOverviewResponse_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryAccountOverviewResponse_10_list) NewElement() protoreflect.Value {
+	v := new(v1beta14.Grant)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryAccountOverviewResponse_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryAccountOverviewResponse                         protoreflect.MessageDescriptor
+	fd_QueryAccountOverviewResponse_height                  protoreflect.FieldDescriptor
+	fd_QueryAccountOverviewResponse_balances                protoreflect.FieldDescriptor
+	fd_QueryAccountOverviewResponse_delegations             protoreflect.FieldDescriptor
+	fd_QueryAccountOverviewResponse_unbonding_delegations   protoreflect.FieldDescriptor
+	fd_QueryAccountOverviewResponse_rewards                 protoreflect.FieldDescriptor
+	fd_QueryAccountOverviewResponse_total_rewards           protoreflect.FieldDescriptor
+	fd_QueryAccountOverviewResponse_granted_authorizations  protoreflect.FieldDescriptor
+	fd_QueryAccountOverviewResponse_received_authorizations protoreflect.FieldDescriptor
+	fd_QueryAccountOverviewResponse_granted_allowances      protoreflect.FieldDescriptor
+	fd_QueryAccountOverviewResponse_received_allowances     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_overview_v1_query_proto_init()
+	md_QueryAccountOverviewResponse = File_cosmos_overview_v1_query_proto.Messages().ByName("QueryAccountOverviewResponse")
+	fd_QueryAccountOverviewResponse_height = md_QueryAccountOverviewResponse.Fields().ByName("height")
+	fd_QueryAccountOverviewResponse_balances = md_QueryAccountOverviewResponse.Fields().ByName("balances")
+	fd_QueryAccountOverviewResponse_delegations = md_QueryAccountOverviewResponse.Fields().ByName("delegations")
+	fd_QueryAccountOverviewResponse_unbonding_delegations = md_QueryAccountOverviewResponse.Fields().ByName("unbonding_delegations")
+	fd_QueryAccountOverviewResponse_rewards = md_QueryAccountOverviewResponse.Fields().ByName("rewards")
+	fd_QueryAccountOverviewResponse_total_rewards = md_QueryAccountOverviewResponse.Fields().ByName("total_rewards")
+	fd_QueryAccountOverviewResponse_granted_authorizations = md_QueryAccountOverviewResponse.Fields().ByName("granted_authorizations")
+	fd_QueryAccountOverviewResponse_received_authorizations = md_QueryAccountOverviewResponse.Fields().ByName("received_authorizations")
+	fd_QueryAccountOverviewResponse_granted_allowances = md_QueryAccountOverviewResponse.Fields().ByName("granted_allowances")
+	fd_QueryAccountOverviewResponse_received_allowances = md_QueryAccountOverviewResponse.Fields().ByName("received_allowances")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAccountOverviewResponse)(nil)
+
+type fastReflection_QueryAccountOverviewResponse QueryAccountOverviewResponse
+
+func (x *QueryAccountOverviewResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAccountOverviewResponse)(x)
+}
+
+func (x *QueryAccountOverviewResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_overview_v1_query_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAccountOverviewResponse_messageType fastReflection_QueryAccountOverviewResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAccountOverviewResponse_messageType{}
+
+type fastReflection_QueryAccountOverviewResponse_messageType struct{}
+
+func (x fastReflection_QueryAccountOverviewResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAccountOverviewResponse)(nil)
+}
+func (x fastReflection_QueryAccountOverviewResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAccountOverviewResponse)
+}
+func (x fastReflection_QueryAccountOverviewResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAccountOverviewResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAccountOverviewResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAccountOverviewResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAccountOverviewResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAccountOverviewResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAccountOverviewResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryAccountOverviewResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAccountOverviewResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryAccountOverviewResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAccountOverviewResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_QueryAccountOverviewResponse_height, value) {
+			return
+		}
+	}
+	if len(x.Balances) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAccountOverviewResponse_2_list{list: &x.Balances})
+		if !f(fd_QueryAccountOverviewResponse_balances, value) {
+			return
+		}
+	}
+	if len(x.Delegations) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAccountOverviewResponse_3_list{list: &x.Delegations})
+		if !f(fd_QueryAccountOverviewResponse_delegations, value) {
+			return
+		}
+	}
+	if len(x.UnbondingDelegations) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAccountOverviewResponse_4_list{list: &x.UnbondingDelegations})
+		if !f(fd_QueryAccountOverviewResponse_unbonding_delegations, value) {
+			return
+		}
+	}
+	if len(x.Rewards) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAccountOverviewResponse_5_list{list: &x.Rewards})
+		if !f(fd_QueryAccountOverviewResponse_rewards, value) {
+			return
+		}
+	}
+	if len(x.TotalRewards) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAccountOverviewResponse_6_list{list: &x.TotalRewards})
+		if !f(fd_QueryAccountOverviewResponse_total_rewards, value) {
+			return
+		}
+	}
+	if len(x.GrantedAuthorizations) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAccountOverviewResponse_7_list{list: &x.GrantedAuthorizations})
+		if !f(fd_QueryAccountOverviewResponse_granted_authorizations, value) {
+			return
+		}
+	}
+	if len(x.ReceivedAuthorizations) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAccountOverviewResponse_8_list{list: &x.ReceivedAuthorizations})
+		if !f(fd_QueryAccountOverviewResponse_received_authorizations, value) {
+			return
+		}
+	}
+	if len(x.GrantedAllowances) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAccountOverviewResponse_9_list{list: &x.GrantedAllowances})
+		if !f(fd_QueryAccountOverviewResponse_granted_allowances, value) {
+			return
+		}
+	}
+	if len(x.ReceivedAllowances) != 0 {
+		value := protoreflect.ValueOfList(&_QueryAccountOverviewResponse_10_list{list: &x.ReceivedAllowances})
+		if !f(fd_QueryAccountOverviewResponse_received_allowances, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAccountOverviewResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.height":
+		return x.Height != int64(0)
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.balances":
+		return len(x.Balances) != 0
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.delegations":
+		return len(x.Delegations) != 0
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.unbonding_delegations":
+		return len(x.UnbondingDelegations) != 0
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.rewards":
+		return len(x.Rewards) != 0
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.total_rewards":
+		return len(x.TotalRewards) != 0
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.granted_authorizations":
+		return len(x.GrantedAuthorizations) != 0
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.received_authorizations":
+		return len(x.ReceivedAuthorizations) != 0
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.granted_allowances":
+		return len(x.GrantedAllowances) != 0
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.received_allowances":
+		return len(x.ReceivedAllowances) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.overview.v1.QueryAccountOverviewResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.overview.v1.QueryAccountOverviewResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAccountOverviewResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.height":
+		x.Height = int64(0)
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.balances":
+		x.Balances = nil
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.delegations":
+		x.Delegations = nil
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.unbonding_delegations":
+		x.UnbondingDelegations = nil
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.rewards":
+		x.Rewards = nil
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.total_rewards":
+		x.TotalRewards = nil
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.granted_authorizations":
+		x.GrantedAuthorizations = nil
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.received_authorizations":
+		x.ReceivedAuthorizations = nil
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.granted_allowances":
+		x.GrantedAllowances = nil
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.received_allowances":
+		x.ReceivedAllowances = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.overview.v1.QueryAccountOverviewResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.overview.v1.QueryAccountOverviewResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAccountOverviewResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.balances":
+		if len(x.Balances) == 0 {
+			return protoreflect.ValueOfList(&_QueryAccountOverviewResponse_2_list{})
+		}
+		listValue := &_QueryAccountOverviewResponse_2_list{list: &x.Balances}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.delegations":
+		if len(x.Delegations) == 0 {
+			return protoreflect.ValueOfList(&_QueryAccountOverviewResponse_3_list{})
+		}
+		listValue := &_QueryAccountOverviewResponse_3_list{list: &x.Delegations}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.unbonding_delegations":
+		if len(x.UnbondingDelegations) == 0 {
+			return protoreflect.ValueOfList(&_QueryAccountOverviewResponse_4_list{})
+		}
+		listValue := &_QueryAccountOverviewResponse_4_list{list: &x.UnbondingDelegations}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.rewards":
+		if len(x.Rewards) == 0 {
+			return protoreflect.ValueOfList(&_QueryAccountOverviewResponse_5_list{})
+		}
+		listValue := &_QueryAccountOverviewResponse_5_list{list: &x.Rewards}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.total_rewards":
+		if len(x.TotalRewards) == 0 {
+			return protoreflect.ValueOfList(&_QueryAccountOverviewResponse_6_list{})
+		}
+		listValue := &_QueryAccountOverviewResponse_6_list{list: &x.TotalRewards}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.granted_authorizations":
+		if len(x.GrantedAuthorizations) == 0 {
+			return protoreflect.ValueOfList(&_QueryAccountOverviewResponse_7_list{})
+		}
+		listValue := &_QueryAccountOverviewResponse_7_list{list: &x.GrantedAuthorizations}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.received_authorizations":
+		if len(x.ReceivedAuthorizations) == 0 {
+			return protoreflect.ValueOfList(&_QueryAccountOverviewResponse_8_list{})
+		}
+		listValue := &_QueryAccountOverviewResponse_8_list{list: &x.ReceivedAuthorizations}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.granted_allowances":
+		if len(x.GrantedAllowances) == 0 {
+			return protoreflect.ValueOfList(&_QueryAccountOverviewResponse_9_list{})
+		}
+		listValue := &_QueryAccountOverviewResponse_9_list{list: &x.GrantedAllowances}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.received_allowances":
+		if len(x.ReceivedAllowances) == 0 {
+			return protoreflect.ValueOfList(&_QueryAccountOverviewResponse_10_list{})
+		}
+		listValue := &_QueryAccountOverviewResponse_10_list{list: &x.ReceivedAllowances}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.overview.v1.QueryAccountOverviewResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.overview.v1.QueryAccountOverviewResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAccountOverviewResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.height":
+		x.Height = value.Int()
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.balances":
+		lv := value.List()
+		clv := lv.(*_QueryAccountOverviewResponse_2_list)
+		x.Balances = *clv.list
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.delegations":
+		lv := value.List()
+		clv := lv.(*_QueryAccountOverviewResponse_3_list)
+		x.Delegations = *clv.list
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.unbonding_delegations":
+		lv := value.List()
+		clv := lv.(*_QueryAccountOverviewResponse_4_list)
+		x.UnbondingDelegations = *clv.list
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.rewards":
+		lv := value.List()
+		clv := lv.(*_QueryAccountOverviewResponse_5_list)
+		x.Rewards = *clv.list
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.total_rewards":
+		lv := value.List()
+		clv := lv.(*_QueryAccountOverviewResponse_6_list)
+		x.TotalRewards = *clv.list
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.granted_authorizations":
+		lv := value.List()
+		clv := lv.(*_QueryAccountOverviewResponse_7_list)
+		x.GrantedAuthorizations = *clv.list
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.received_authorizations":
+		lv := value.List()
+		clv := lv.(*_QueryAccountOverviewResponse_8_list)
+		x.ReceivedAuthorizations = *clv.list
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.granted_allowances":
+		lv := value.List()
+		clv := lv.(*_QueryAccountOverviewResponse_9_list)
+		x.GrantedAllowances = *clv.list
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.received_allowances":
+		lv := value.List()
+		clv := lv.(*_QueryAccountOverviewResponse_10_list)
+		x.ReceivedAllowances = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.overview.v1.QueryAccountOverviewResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.overview.v1.QueryAccountOverviewResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAccountOverviewResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.balances":
+		if x.Balances == nil {
+			x.Balances = []*v1beta1.Coin{}
+		}
+		value := &_QueryAccountOverviewResponse_2_list{list: &x.Balances}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.delegations":
+		if x.Delegations == nil {
+			x.Delegations = []*v1beta11.DelegationResponse{}
+		}
+		value := &_QueryAccountOverviewResponse_3_list{list: &x.Delegations}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.unbonding_delegations":
+		if x.UnbondingDelegations == nil {
+			x.UnbondingDelegations = []*v1beta11.UnbondingDelegation{}
+		}
+		value := &_QueryAccountOverviewResponse_4_list{list: &x.UnbondingDelegations}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.rewards":
+		if x.Rewards == nil {
+			x.Rewards = []*v1beta12.DelegationDelegatorReward{}
+		}
+		value := &_QueryAccountOverviewResponse_5_list{list: &x.Rewards}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.total_rewards":
+		if x.TotalRewards == nil {
+			x.TotalRewards = []*v1beta1.DecCoin{}
+		}
+		value := &_QueryAccountOverviewResponse_6_list{list: &x.TotalRewards}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.granted_authorizations":
+		if x.GrantedAuthorizations == nil {
+			x.GrantedAuthorizations = []*v1beta13.GrantAuthorization{}
+		}
+		value := &_QueryAccountOverviewResponse_7_list{list: &x.GrantedAuthorizations}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.received_authorizations":
+		if x.ReceivedAuthorizations == nil {
+			x.ReceivedAuthorizations = []*v1beta13.GrantAuthorization{}
+		}
+		value := &_QueryAccountOverviewResponse_8_list{list: &x.ReceivedAuthorizations}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.granted_allowances":
+		if x.GrantedAllowances == nil {
+			x.GrantedAllowances = []*v1beta14.Grant{}
+		}
+		value := &_QueryAccountOverviewResponse_9_list{list: &x.GrantedAllowances}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.received_allowances":
+		if x.ReceivedAllowances == nil {
+			x.ReceivedAllowances = []*v1beta14.Grant{}
+		}
+		value := &_QueryAccountOverviewResponse_10_list{list: &x.ReceivedAllowances}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.height":
+		panic(fmt.Errorf("field height of message cosmos.overview.v1.QueryAccountOverviewResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.overview.v1.QueryAccountOverviewResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.overview.v1.QueryAccountOverviewResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAccountOverviewResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.balances":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_QueryAccountOverviewResponse_2_list{list: &list})
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.delegations":
+		list := []*v1beta11.DelegationResponse{}
+		return protoreflect.ValueOfList(&_QueryAccountOverviewResponse_3_list{list: &list})
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.unbonding_delegations":
+		list := []*v1beta11.UnbondingDelegation{}
+		return protoreflect.ValueOfList(&_QueryAccountOverviewResponse_4_list{list: &list})
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.rewards":
+		list := []*v1beta12.DelegationDelegatorReward{}
+		return protoreflect.ValueOfList(&_QueryAccountOverviewResponse_5_list{list: &list})
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.total_rewards":
+		list := []*v1beta1.DecCoin{}
+		return protoreflect.ValueOfList(&_QueryAccountOverviewResponse_6_list{list: &list})
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.granted_authorizations":
+		list := []*v1beta13.GrantAuthorization{}
+		return protoreflect.ValueOfList(&_QueryAccountOverviewResponse_7_list{list: &list})
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.received_authorizations":
+		list := []*v1beta13.GrantAuthorization{}
+		return protoreflect.ValueOfList(&_QueryAccountOverviewResponse_8_list{list: &list})
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.granted_allowances":
+		list := []*v1beta14.Grant{}
+		return protoreflect.ValueOfList(&_QueryAccountOverviewResponse_9_list{list: &list})
+	case "cosmos.overview.v1.QueryAccountOverviewResponse.received_allowances":
+		list := []*v1beta14.Grant{}
+		return protoreflect.ValueOfList(&_QueryAccountOverviewResponse_10_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.overview.v1.QueryAccountOverviewResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.overview.v1.QueryAccountOverviewResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAccountOverviewResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.overview.v1.QueryAccountOverviewResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAccountOverviewResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAccountOverviewResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAccountOverviewResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAccountOverviewResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAccountOverviewResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if len(x.Balances) > 0 {
+			for _, e := range x.Balances {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Delegations) > 0 {
+			for _, e := range x.Delegations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.UnbondingDelegations) > 0 {
+			for _, e := range x.UnbondingDelegations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Rewards) > 0 {
+			for _, e := range x.Rewards {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TotalRewards) > 0 {
+			for _, e := range x.TotalRewards {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.GrantedAuthorizations) > 0 {
+			for _, e := range x.GrantedAuthorizations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ReceivedAuthorizations) > 0 {
+			for _, e := range x.ReceivedAuthorizations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.GrantedAllowances) > 0 {
+			for _, e := range x.GrantedAllowances {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ReceivedAllowances) > 0 {
+			for _, e := range x.ReceivedAllowances {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAccountOverviewResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ReceivedAllowances) > 0 {
+			for iNdEx := len(x.ReceivedAllowances) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ReceivedAllowances[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.GrantedAllowances) > 0 {
+			for iNdEx := len(x.GrantedAllowances) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.GrantedAllowances[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.ReceivedAuthorizations) > 0 {
+			for iNdEx := len(x.ReceivedAuthorizations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ReceivedAuthorizations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.GrantedAuthorizations) > 0 {
+			for iNdEx := len(x.GrantedAuthorizations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.GrantedAuthorizations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.TotalRewards) > 0 {
+			for iNdEx := len(x.TotalRewards) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TotalRewards[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.Rewards) > 0 {
+			for iNdEx := len(x.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Rewards[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.UnbondingDelegations) > 0 {
+			for iNdEx := len(x.UnbondingDelegations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.UnbondingDelegations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Delegations) > 0 {
+			for iNdEx := len(x.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Delegations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Balances) > 0 {
+			for iNdEx := len(x.Balances) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Balances[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAccountOverviewResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAccountOverviewResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAccountOverviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Balances = append(x.Balances, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Balances[len(x.Balances)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegations = append(x.Delegations, &v1beta11.DelegationResponse{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Delegations[len(x.Delegations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnbondingDelegations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UnbondingDelegations = append(x.UnbondingDelegations, &v1beta11.UnbondingDelegation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UnbondingDelegations[len(x.UnbondingDelegations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Rewards = append(x.Rewards, &v1beta12.DelegationDelegatorReward{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Rewards[len(x.Rewards)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalRewards", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalRewards = append(x.TotalRewards, &v1beta1.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalRewards[len(x.TotalRewards)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GrantedAuthorizations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GrantedAuthorizations = append(x.GrantedAuthorizations, &v1beta13.GrantAuthorization{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GrantedAuthorizations[len(x.GrantedAuthorizations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReceivedAuthorizations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReceivedAuthorizations = append(x.ReceivedAuthorizations, &v1beta13.GrantAuthorization{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReceivedAuthorizations[len(x.ReceivedAuthorizations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GrantedAllowances", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GrantedAllowances = append(x.GrantedAllowances, &v1beta14.Grant{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GrantedAllowances[len(x.GrantedAllowances)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReceivedAllowances", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReceivedAllowances = append(x.ReceivedAllowances, &v1beta14.Grant{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReceivedAllowances[len(x.ReceivedAllowances)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: cosmos/overview/v1/query.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueryAccountOverviewRequest is the Query/AccountOverview request type.
+type QueryAccountOverviewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the address to query the overview for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *QueryAccountOverviewRequest) Reset() {
+	*x = QueryAccountOverviewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_overview_v1_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAccountOverviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAccountOverviewRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryAccountOverviewRequest.ProtoReflect.Descriptor instead.
+func (*QueryAccountOverviewRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_overview_v1_query_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QueryAccountOverviewRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// QueryAccountOverviewResponse is the Query/AccountOverview response type.
+type QueryAccountOverviewResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// height is the height at which all the sections were read.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// balances are the balances of the address.
+	Balances []*v1beta1.Coin `protobuf:"bytes,2,rep,name=balances,proto3" json:"balances,omitempty"`
+	// delegations are the delegations of the address.
+	Delegations []*v1beta11.DelegationResponse `protobuf:"bytes,3,rep,name=delegations,proto3" json:"delegations,omitempty"`
+	// unbonding_delegations are the unbonding delegations of the address.
+	UnbondingDelegations []*v1beta11.UnbondingDelegation `protobuf:"bytes,4,rep,name=unbonding_delegations,json=unbondingDelegations,proto3" json:"unbonding_delegations,omitempty"`
+	// rewards are the outstanding delegation rewards of the address, per validator.
+	Rewards []*v1beta12.DelegationDelegatorReward `protobuf:"bytes,5,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	// total_rewards is the sum of the outstanding delegation rewards of the address.
+	TotalRewards []*v1beta1.DecCoin `protobuf:"bytes,6,rep,name=total_rewards,json=totalRewards,proto3" json:"total_rewards,omitempty"`
+	// granted_authorizations are the authz grants given by the address.
+	GrantedAuthorizations []*v1beta13.GrantAuthorization `protobuf:"bytes,7,rep,name=granted_authorizations,json=grantedAuthorizations,proto3" json:"granted_authorizations,omitempty"`
+	// received_authorizations are the authz grants received by the address.
+	ReceivedAuthorizations []*v1beta13.GrantAuthorization `protobuf:"bytes,8,rep,name=received_authorizations,json=receivedAuthorizations,proto3" json:"received_authorizations,omitempty"`
+	// granted_allowances are the fee allowances given by the address.
+	GrantedAllowances []*v1beta14.Grant `protobuf:"bytes,9,rep,name=granted_allowances,json=grantedAllowances,proto3" json:"granted_allowances,omitempty"`
+	// received_allowances are the fee allowances received by the address.
+	ReceivedAllowances []*v1beta14.Grant `protobuf:"bytes,10,rep,name=received_allowances,json=receivedAllowances,proto3" json:"received_allowances,omitempty"`
+}
+
+func (x *QueryAccountOverviewResponse) Reset() {
+	*x = QueryAccountOverviewResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_overview_v1_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAccountOverviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAccountOverviewResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryAccountOverviewResponse.ProtoReflect.Descriptor instead.
+func (*QueryAccountOverviewResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_overview_v1_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryAccountOverviewResponse) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *QueryAccountOverviewResponse) GetBalances() []*v1beta1.Coin {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+func (x *QueryAccountOverviewResponse) GetDelegations() []*v1beta11.DelegationResponse {
+	if x != nil {
+		return x.Delegations
+	}
+	return nil
+}
+
+func (x *QueryAccountOverviewResponse) GetUnbondingDelegations() []*v1beta11.UnbondingDelegation {
+	if x != nil {
+		return x.UnbondingDelegations
+	}
+	return nil
+}
+
+func (x *QueryAccountOverviewResponse) GetRewards() []*v1beta12.DelegationDelegatorReward {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *QueryAccountOverviewResponse) GetTotalRewards() []*v1beta1.DecCoin {
+	if x != nil {
+		return x.TotalRewards
+	}
+	return nil
+}
+
+func (x *QueryAccountOverviewResponse) GetGrantedAuthorizations() []*v1beta13.GrantAuthorization {
+	if x != nil {
+		return x.GrantedAuthorizations
+	}
+	return nil
+}
+
+func (x *QueryAccountOverviewResponse) GetReceivedAuthorizations() []*v1beta13.GrantAuthorization {
+	if x != nil {
+		return x.ReceivedAuthorizations
+	}
+	return nil
+}
+
+func (x *QueryAccountOverviewResponse) GetGrantedAllowances() []*v1beta14.Grant {
+	if x != nil {
+		return x.GrantedAllowances
+	}
+	return nil
+}
+
+func (x *QueryAccountOverviewResponse) GetReceivedAllowances() []*v1beta14.Grant {
+	if x != nil {
+		return x.ReceivedAllowances
+	}
+	return nil
+}
+
+var File_cosmos_overview_v1_query_proto protoreflect.FileDescriptor
+
+var file_cosmos_overview_v1_query_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65,
+	0x77, 0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x12, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65,
+	0x77, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x61, 0x75, 0x74, 0x68, 0x7a, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x61, 0x75,
+	0x74, 0x68, 0x7a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2f, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x37, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x96, 0x06, 0x0a, 0x1c, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x76,
+	0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x52, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x4c, 0x0a, 0x0b, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0b, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x60, 0x0a, 0x15, 0x75, 0x6e, 0x62, 0x6f,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x14, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x44,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x50, 0x0a, 0x07, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x41, 0x0a, 0x0d,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69,
+	0x6e, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12,
+	0x5f, 0x0a, 0x16, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x15, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x65, 0x64, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x61, 0x0a, 0x17, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x7a,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x4d, 0x0a, 0x12, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52,
+	0x11, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x12, 0x4f, 0x0a, 0x13, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x66, 0x65, 0x65, 0x67, 0x72, 0x61, 0x6e,
+	0x74, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52,
+	0x12, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x32, 0x84, 0x01, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x7b, 0x0a,
+	0x0f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77,
+	0x12, 0x2f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6f, 0x76, 0x65, 0x72, 0x76, 0x69,
+	0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x30, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6f, 0x76, 0x65, 0x72, 0x76,
+	0x69, 0x65, 0x77, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x05, 0x88, 0xe7, 0xb0, 0x2a, 0x00, 0x42, 0xbe, 0x01, 0x0a, 0x16, 0x63,
+	0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x6f, 0x76, 0x65, 0x72, 0x76, 0x69,
+	0x65, 0x77, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6f, 0x76, 0x65,
+	0x72, 0x76, 0x69, 0x65, 0x77, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65,
+	0x77, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x4f, 0x58, 0xaa, 0x02, 0x12, 0x43, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x12, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x4f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1e, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x4f, 0x76, 0x65,
+	0x72, 0x76, 0x69, 0x65, 0x77, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x4f,
+	0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cosmos_overview_v1_query_proto_rawDescOnce sync.Once
+	file_cosmos_overview_v1_query_proto_rawDescData = file_cosmos_overview_v1_query_proto_rawDesc
+)
+
+func file_cosmos_overview_v1_query_proto_rawDescGZIP() []byte {
+	file_cosmos_overview_v1_query_proto_rawDescOnce.Do(func() {
+		file_cosmos_overview_v1_query_proto_rawDescData = protoimpl.X.CompressGZIP(file_cosmos_overview_v1_query_proto_rawDescData)
+	})
+	return file_cosmos_overview_v1_query_proto_rawDescData
+}
+
+var file_cosmos_overview_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cosmos_overview_v1_query_proto_goTypes = []interface{}{
+	(*QueryAccountOverviewRequest)(nil),        // 0: cosmos.overview.v1.QueryAccountOverviewRequest
+	(*QueryAccountOverviewResponse)(nil),       // 1: cosmos.overview.v1.QueryAccountOverviewResponse
+	(*v1beta1.Coin)(nil),                       // 2: cosmos.base.v1beta1.Coin
+	(*v1beta11.DelegationResponse)(nil),        // 3: cosmos.staking.v1beta1.DelegationResponse
+	(*v1beta11.UnbondingDelegation)(nil),       // 4: cosmos.staking.v1beta1.UnbondingDelegation
+	(*v1beta12.DelegationDelegatorReward)(nil), // 5: cosmos.distribution.v1beta1.DelegationDelegatorReward
+	(*v1beta1.DecCoin)(nil),                    // 6: cosmos.base.v1beta1.DecCoin
+	(*v1beta13.GrantAuthorization)(nil),        // 7: cosmos.authz.v1beta1.GrantAuthorization
+	(*v1beta14.Grant)(nil),                     // 8: cosmos.feegrant.v1beta1.Grant
+}
+var file_cosmos_overview_v1_query_proto_depIdxs = []int32{
+	2,  // 0: cosmos.overview.v1.QueryAccountOverviewResponse.balances:type_name -> cosmos.base.v1beta1.Coin
+	3,  // 1: cosmos.overview.v1.QueryAccountOverviewResponse.delegations:type_name -> cosmos.staking.v1beta1.DelegationResponse
+	4,  // 2: cosmos.overview.v1.QueryAccountOverviewResponse.unbonding_delegations:type_name -> cosmos.staking.v1beta1.UnbondingDelegation
+	5,  // 3: cosmos.overview.v1.QueryAccountOverviewResponse.rewards:type_name -> cosmos.distribution.v1beta1.DelegationDelegatorReward
+	6,  // 4: cosmos.overview.v1.QueryAccountOverviewResponse.total_rewards:type_name -> cosmos.base.v1beta1.DecCoin
+	7,  // 5: cosmos.overview.v1.QueryAccountOverviewResponse.granted_authorizations:type_name -> cosmos.authz.v1beta1.GrantAuthorization
+	7,  // 6: cosmos.overview.v1.QueryAccountOverviewResponse.received_authorizations:type_name -> cosmos.authz.v1beta1.GrantAuthorization
+	8,  // 7: cosmos.overview.v1.QueryAccountOverviewResponse.granted_allowances:type_name -> cosmos.feegrant.v1beta1.Grant
+	8,  // 8: cosmos.overview.v1.QueryAccountOverviewResponse.received_allowances:type_name -> cosmos.feegrant.v1beta1.Grant
+	0,  // 9: cosmos.overview.v1.Query.AccountOverview:input_type -> cosmos.overview.v1.QueryAccountOverviewRequest
+	1,  // 10: cosmos.overview.v1.Query.AccountOverview:output_type -> cosmos.overview.v1.QueryAccountOverviewResponse
+	10, // [10:11] is the sub-list for method output_type
+	9,  // [9:10] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_cosmos_overview_v1_query_proto_init() }
+func file_cosmos_overview_v1_query_proto_init() {
+	if File_cosmos_overview_v1_query_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_cosmos_overview_v1_query_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAccountOverviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_overview_v1_query_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAccountOverviewResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_cosmos_overview_v1_query_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cosmos_overview_v1_query_proto_goTypes,
+		DependencyIndexes: file_cosmos_overview_v1_query_proto_depIdxs,
+		MessageInfos:      file_cosmos_overview_v1_query_proto_msgTypes,
+	}.Build()
+	File_cosmos_overview_v1_query_proto = out.File
+	file_cosmos_overview_v1_query_proto_rawDesc = nil
+	file_cosmos_overview_v1_query_proto_goTypes = nil
+	file_cosmos_overview_v1_query_proto_depIdxs = nil
+}
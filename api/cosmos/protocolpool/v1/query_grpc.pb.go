@@ -19,8 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_CommunityPool_FullMethodName   = "/cosmos.protocolpool.v1.Query/CommunityPool"
-	Query_UnclaimedBudget_FullMethodName = "/cosmos.protocolpool.v1.Query/UnclaimedBudget"
+	Query_CommunityPool_FullMethodName      = "/cosmos.protocolpool.v1.Query/CommunityPool"
+	Query_UnclaimedBudget_FullMethodName    = "/cosmos.protocolpool.v1.Query/UnclaimedBudget"
+	Query_EscrowedSpend_FullMethodName      = "/cosmos.protocolpool.v1.Query/EscrowedSpend"
+	Query_EscrowedSpends_FullMethodName     = "/cosmos.protocolpool.v1.Query/EscrowedSpends"
+	Query_SpendAllowance_FullMethodName     = "/cosmos.protocolpool.v1.Query/SpendAllowance"
+	Query_SpendAllowances_FullMethodName    = "/cosmos.protocolpool.v1.Query/SpendAllowances"
+	Query_DenomPolicies_FullMethodName      = "/cosmos.protocolpool.v1.Query/DenomPolicies"
+	Query_FundingSources_FullMethodName     = "/cosmos.protocolpool.v1.Query/FundingSources"
+	Query_RecurringGrant_FullMethodName     = "/cosmos.protocolpool.v1.Query/RecurringGrant"
+	Query_RecurringGrants_FullMethodName    = "/cosmos.protocolpool.v1.Query/RecurringGrants"
+	Query_PoolStaking_FullMethodName        = "/cosmos.protocolpool.v1.Query/PoolStaking"
+	Query_PoolBalanceChanges_FullMethodName = "/cosmos.protocolpool.v1.Query/PoolBalanceChanges"
+	Query_Position_FullMethodName           = "/cosmos.protocolpool.v1.Query/Position"
+	Query_Positions_FullMethodName          = "/cosmos.protocolpool.v1.Query/Positions"
+	Query_DeployedCapital_FullMethodName    = "/cosmos.protocolpool.v1.Query/DeployedCapital"
+	Query_OutflowFreeze_FullMethodName      = "/cosmos.protocolpool.v1.Query/OutflowFreeze"
 )
 
 // QueryClient is the client API for Query service.
@@ -31,6 +45,42 @@ type QueryClient interface {
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
 	// UnclaimedBudget queries the remaining budget left to be claimed and it gives overall budget allocation view.
 	UnclaimedBudget(ctx context.Context, in *QueryUnclaimedBudgetRequest, opts ...grpc.CallOption) (*QueryUnclaimedBudgetResponse, error)
+	// EscrowedSpend queries an escrowed spend by its id.
+	EscrowedSpend(ctx context.Context, in *QueryEscrowedSpendRequest, opts ...grpc.CallOption) (*QueryEscrowedSpendResponse, error)
+	// EscrowedSpends queries all the escrowed spends.
+	EscrowedSpends(ctx context.Context, in *QueryEscrowedSpendsRequest, opts ...grpc.CallOption) (*QueryEscrowedSpendsResponse, error)
+	// SpendAllowance queries the remaining amount of a denom which can be spent
+	// from the community pool within the current spend window.
+	SpendAllowance(ctx context.Context, in *QuerySpendAllowanceRequest, opts ...grpc.CallOption) (*QuerySpendAllowanceResponse, error)
+	// SpendAllowances queries the remaining amounts of all the limited denoms which
+	// can be spent from the community pool within their current spend window.
+	SpendAllowances(ctx context.Context, in *QuerySpendAllowancesRequest, opts ...grpc.CallOption) (*QuerySpendAllowancesResponse, error)
+	// DenomPolicies queries the policies applied to the community pool holdings of
+	// unwanted denoms.
+	DenomPolicies(ctx context.Context, in *QueryDenomPoliciesRequest, opts ...grpc.CallOption) (*QueryDenomPoliciesResponse, error)
+	// FundingSources queries the cumulative amounts which entered the community
+	// pool, per funding source.
+	FundingSources(ctx context.Context, in *QueryFundingSourcesRequest, opts ...grpc.CallOption) (*QueryFundingSourcesResponse, error)
+	// RecurringGrant queries the recurring grant of a recipient.
+	RecurringGrant(ctx context.Context, in *QueryRecurringGrantRequest, opts ...grpc.CallOption) (*QueryRecurringGrantResponse, error)
+	// RecurringGrants queries all the recurring grants.
+	RecurringGrants(ctx context.Context, in *QueryRecurringGrantsRequest, opts ...grpc.CallOption) (*QueryRecurringGrantsResponse, error)
+	// PoolStaking queries the liquid bond denom balance and the delegations of the
+	// community pool.
+	PoolStaking(ctx context.Context, in *QueryPoolStakingRequest, opts ...grpc.CallOption) (*QueryPoolStakingResponse, error)
+	// PoolBalanceChanges streams the changes of the community pool balance made
+	// by the protocolpool module, as their blocks are committed.
+	PoolBalanceChanges(ctx context.Context, in *QueryPoolBalanceChangesRequest, opts ...grpc.CallOption) (Query_PoolBalanceChangesClient, error)
+	// Position queries a community pool investment position by its id.
+	Position(ctx context.Context, in *QueryPositionRequest, opts ...grpc.CallOption) (*QueryPositionResponse, error)
+	// Positions queries all the community pool investment positions.
+	Positions(ctx context.Context, in *QueryPositionsRequest, opts ...grpc.CallOption) (*QueryPositionsResponse, error)
+	// DeployedCapital queries the total principal and current value of the open
+	// community pool investment positions.
+	DeployedCapital(ctx context.Context, in *QueryDeployedCapitalRequest, opts ...grpc.CallOption) (*QueryDeployedCapitalResponse, error)
+	// OutflowFreeze queries the freeze parameters and the active freeze of the
+	// community pool outflows, if any.
+	OutflowFreeze(ctx context.Context, in *QueryOutflowFreezeRequest, opts ...grpc.CallOption) (*QueryOutflowFreezeResponse, error)
 }
 
 type queryClient struct {
@@ -59,6 +109,155 @@ func (c *queryClient) UnclaimedBudget(ctx context.Context, in *QueryUnclaimedBud
 	return out, nil
 }
 
+func (c *queryClient) EscrowedSpend(ctx context.Context, in *QueryEscrowedSpendRequest, opts ...grpc.CallOption) (*QueryEscrowedSpendResponse, error) {
+	out := new(QueryEscrowedSpendResponse)
+	err := c.cc.Invoke(ctx, Query_EscrowedSpend_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EscrowedSpends(ctx context.Context, in *QueryEscrowedSpendsRequest, opts ...grpc.CallOption) (*QueryEscrowedSpendsResponse, error) {
+	out := new(QueryEscrowedSpendsResponse)
+	err := c.cc.Invoke(ctx, Query_EscrowedSpends_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SpendAllowance(ctx context.Context, in *QuerySpendAllowanceRequest, opts ...grpc.CallOption) (*QuerySpendAllowanceResponse, error) {
+	out := new(QuerySpendAllowanceResponse)
+	err := c.cc.Invoke(ctx, Query_SpendAllowance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SpendAllowances(ctx context.Context, in *QuerySpendAllowancesRequest, opts ...grpc.CallOption) (*QuerySpendAllowancesResponse, error) {
+	out := new(QuerySpendAllowancesResponse)
+	err := c.cc.Invoke(ctx, Query_SpendAllowances_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomPolicies(ctx context.Context, in *QueryDenomPoliciesRequest, opts ...grpc.CallOption) (*QueryDenomPoliciesResponse, error) {
+	out := new(QueryDenomPoliciesResponse)
+	err := c.cc.Invoke(ctx, Query_DenomPolicies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FundingSources(ctx context.Context, in *QueryFundingSourcesRequest, opts ...grpc.CallOption) (*QueryFundingSourcesResponse, error) {
+	out := new(QueryFundingSourcesResponse)
+	err := c.cc.Invoke(ctx, Query_FundingSources_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecurringGrant(ctx context.Context, in *QueryRecurringGrantRequest, opts ...grpc.CallOption) (*QueryRecurringGrantResponse, error) {
+	out := new(QueryRecurringGrantResponse)
+	err := c.cc.Invoke(ctx, Query_RecurringGrant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecurringGrants(ctx context.Context, in *QueryRecurringGrantsRequest, opts ...grpc.CallOption) (*QueryRecurringGrantsResponse, error) {
+	out := new(QueryRecurringGrantsResponse)
+	err := c.cc.Invoke(ctx, Query_RecurringGrants_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolStaking(ctx context.Context, in *QueryPoolStakingRequest, opts ...grpc.CallOption) (*QueryPoolStakingResponse, error) {
+	out := new(QueryPoolStakingResponse)
+	err := c.cc.Invoke(ctx, Query_PoolStaking_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolBalanceChanges(ctx context.Context, in *QueryPoolBalanceChangesRequest, opts ...grpc.CallOption) (Query_PoolBalanceChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[0], Query_PoolBalanceChanges_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryPoolBalanceChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_PoolBalanceChangesClient interface {
+	Recv() (*QueryPoolBalanceChangesResponse, error)
+	grpc.ClientStream
+}
+
+type queryPoolBalanceChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryPoolBalanceChangesClient) Recv() (*QueryPoolBalanceChangesResponse, error) {
+	m := new(QueryPoolBalanceChangesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *queryClient) Position(ctx context.Context, in *QueryPositionRequest, opts ...grpc.CallOption) (*QueryPositionResponse, error) {
+	out := new(QueryPositionResponse)
+	err := c.cc.Invoke(ctx, Query_Position_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Positions(ctx context.Context, in *QueryPositionsRequest, opts ...grpc.CallOption) (*QueryPositionsResponse, error) {
+	out := new(QueryPositionsResponse)
+	err := c.cc.Invoke(ctx, Query_Positions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeployedCapital(ctx context.Context, in *QueryDeployedCapitalRequest, opts ...grpc.CallOption) (*QueryDeployedCapitalResponse, error) {
+	out := new(QueryDeployedCapitalResponse)
+	err := c.cc.Invoke(ctx, Query_DeployedCapital_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OutflowFreeze(ctx context.Context, in *QueryOutflowFreezeRequest, opts ...grpc.CallOption) (*QueryOutflowFreezeResponse, error) {
+	out := new(QueryOutflowFreezeResponse)
+	err := c.cc.Invoke(ctx, Query_OutflowFreeze_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -67,6 +266,42 @@ type QueryServer interface {
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
 	// UnclaimedBudget queries the remaining budget left to be claimed and it gives overall budget allocation view.
 	UnclaimedBudget(context.Context, *QueryUnclaimedBudgetRequest) (*QueryUnclaimedBudgetResponse, error)
+	// EscrowedSpend queries an escrowed spend by its id.
+	EscrowedSpend(context.Context, *QueryEscrowedSpendRequest) (*QueryEscrowedSpendResponse, error)
+	// EscrowedSpends queries all the escrowed spends.
+	EscrowedSpends(context.Context, *QueryEscrowedSpendsRequest) (*QueryEscrowedSpendsResponse, error)
+	// SpendAllowance queries the remaining amount of a denom which can be spent
+	// from the community pool within the current spend window.
+	SpendAllowance(context.Context, *QuerySpendAllowanceRequest) (*QuerySpendAllowanceResponse, error)
+	// SpendAllowances queries the remaining amounts of all the limited denoms which
+	// can be spent from the community pool within their current spend window.
+	SpendAllowances(context.Context, *QuerySpendAllowancesRequest) (*QuerySpendAllowancesResponse, error)
+	// DenomPolicies queries the policies applied to the community pool holdings of
+	// unwanted denoms.
+	DenomPolicies(context.Context, *QueryDenomPoliciesRequest) (*QueryDenomPoliciesResponse, error)
+	// FundingSources queries the cumulative amounts which entered the community
+	// pool, per funding source.
+	FundingSources(context.Context, *QueryFundingSourcesRequest) (*QueryFundingSourcesResponse, error)
+	// RecurringGrant queries the recurring grant of a recipient.
+	RecurringGrant(context.Context, *QueryRecurringGrantRequest) (*QueryRecurringGrantResponse, error)
+	// RecurringGrants queries all the recurring grants.
+	RecurringGrants(context.Context, *QueryRecurringGrantsRequest) (*QueryRecurringGrantsResponse, error)
+	// PoolStaking queries the liquid bond denom balance and the delegations of the
+	// community pool.
+	PoolStaking(context.Context, *QueryPoolStakingRequest) (*QueryPoolStakingResponse, error)
+	// PoolBalanceChanges streams the changes of the community pool balance made
+	// by the protocolpool module, as their blocks are committed.
+	PoolBalanceChanges(*QueryPoolBalanceChangesRequest, Query_PoolBalanceChangesServer) error
+	// Position queries a community pool investment position by its id.
+	Position(context.Context, *QueryPositionRequest) (*QueryPositionResponse, error)
+	// Positions queries all the community pool investment positions.
+	Positions(context.Context, *QueryPositionsRequest) (*QueryPositionsResponse, error)
+	// DeployedCapital queries the total principal and current value of the open
+	// community pool investment positions.
+	DeployedCapital(context.Context, *QueryDeployedCapitalRequest) (*QueryDeployedCapitalResponse, error)
+	// OutflowFreeze queries the freeze parameters and the active freeze of the
+	// community pool outflows, if any.
+	OutflowFreeze(context.Context, *QueryOutflowFreezeRequest) (*QueryOutflowFreezeResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -80,6 +315,48 @@ func (UnimplementedQueryServer) CommunityPool(context.Context, *QueryCommunityPo
 func (UnimplementedQueryServer) UnclaimedBudget(context.Context, *QueryUnclaimedBudgetRequest) (*QueryUnclaimedBudgetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnclaimedBudget not implemented")
 }
+func (UnimplementedQueryServer) EscrowedSpend(context.Context, *QueryEscrowedSpendRequest) (*QueryEscrowedSpendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowedSpend not implemented")
+}
+func (UnimplementedQueryServer) EscrowedSpends(context.Context, *QueryEscrowedSpendsRequest) (*QueryEscrowedSpendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowedSpends not implemented")
+}
+func (UnimplementedQueryServer) SpendAllowance(context.Context, *QuerySpendAllowanceRequest) (*QuerySpendAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpendAllowance not implemented")
+}
+func (UnimplementedQueryServer) SpendAllowances(context.Context, *QuerySpendAllowancesRequest) (*QuerySpendAllowancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpendAllowances not implemented")
+}
+func (UnimplementedQueryServer) DenomPolicies(context.Context, *QueryDenomPoliciesRequest) (*QueryDenomPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomPolicies not implemented")
+}
+func (UnimplementedQueryServer) FundingSources(context.Context, *QueryFundingSourcesRequest) (*QueryFundingSourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundingSources not implemented")
+}
+func (UnimplementedQueryServer) RecurringGrant(context.Context, *QueryRecurringGrantRequest) (*QueryRecurringGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecurringGrant not implemented")
+}
+func (UnimplementedQueryServer) RecurringGrants(context.Context, *QueryRecurringGrantsRequest) (*QueryRecurringGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecurringGrants not implemented")
+}
+func (UnimplementedQueryServer) PoolStaking(context.Context, *QueryPoolStakingRequest) (*QueryPoolStakingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolStaking not implemented")
+}
+func (UnimplementedQueryServer) PoolBalanceChanges(*QueryPoolBalanceChangesRequest, Query_PoolBalanceChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method PoolBalanceChanges not implemented")
+}
+func (UnimplementedQueryServer) Position(context.Context, *QueryPositionRequest) (*QueryPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Position not implemented")
+}
+func (UnimplementedQueryServer) Positions(context.Context, *QueryPositionsRequest) (*QueryPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Positions not implemented")
+}
+func (UnimplementedQueryServer) DeployedCapital(context.Context, *QueryDeployedCapitalRequest) (*QueryDeployedCapitalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeployedCapital not implemented")
+}
+func (UnimplementedQueryServer) OutflowFreeze(context.Context, *QueryOutflowFreezeRequest) (*QueryOutflowFreezeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutflowFreeze not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -129,6 +406,261 @@ func _Query_UnclaimedBudget_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EscrowedSpend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowedSpendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowedSpend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EscrowedSpend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowedSpend(ctx, req.(*QueryEscrowedSpendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EscrowedSpends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowedSpendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowedSpends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EscrowedSpends_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowedSpends(ctx, req.(*QueryEscrowedSpendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpendAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpendAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpendAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SpendAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpendAllowance(ctx, req.(*QuerySpendAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpendAllowances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpendAllowancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpendAllowances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SpendAllowances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpendAllowances(ctx, req.(*QuerySpendAllowancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomPolicies(ctx, req.(*QueryDenomPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FundingSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFundingSourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FundingSources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FundingSources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FundingSources(ctx, req.(*QueryFundingSourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecurringGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecurringGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecurringGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RecurringGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecurringGrant(ctx, req.(*QueryRecurringGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecurringGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecurringGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecurringGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RecurringGrants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecurringGrants(ctx, req.(*QueryRecurringGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolStaking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolStakingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolStaking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PoolStaking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolStaking(ctx, req.(*QueryPoolStakingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolBalanceChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryPoolBalanceChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).PoolBalanceChanges(m, &queryPoolBalanceChangesServer{stream})
+}
+
+type Query_PoolBalanceChangesServer interface {
+	Send(*QueryPoolBalanceChangesResponse) error
+	grpc.ServerStream
+}
+
+type queryPoolBalanceChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryPoolBalanceChangesServer) Send(m *QueryPoolBalanceChangesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Query_Position_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Position(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Position_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Position(ctx, req.(*QueryPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Positions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Positions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Positions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Positions(ctx, req.(*QueryPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeployedCapital_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeployedCapitalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeployedCapital(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DeployedCapital_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeployedCapital(ctx, req.(*QueryDeployedCapitalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OutflowFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutflowFreezeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OutflowFreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OutflowFreeze_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutflowFreeze(ctx, req.(*QueryOutflowFreezeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -144,7 +676,65 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnclaimedBudget",
 			Handler:    _Query_UnclaimedBudget_Handler,
 		},
+		{
+			MethodName: "EscrowedSpend",
+			Handler:    _Query_EscrowedSpend_Handler,
+		},
+		{
+			MethodName: "EscrowedSpends",
+			Handler:    _Query_EscrowedSpends_Handler,
+		},
+		{
+			MethodName: "SpendAllowance",
+			Handler:    _Query_SpendAllowance_Handler,
+		},
+		{
+			MethodName: "SpendAllowances",
+			Handler:    _Query_SpendAllowances_Handler,
+		},
+		{
+			MethodName: "DenomPolicies",
+			Handler:    _Query_DenomPolicies_Handler,
+		},
+		{
+			MethodName: "FundingSources",
+			Handler:    _Query_FundingSources_Handler,
+		},
+		{
+			MethodName: "RecurringGrant",
+			Handler:    _Query_RecurringGrant_Handler,
+		},
+		{
+			MethodName: "RecurringGrants",
+			Handler:    _Query_RecurringGrants_Handler,
+		},
+		{
+			MethodName: "PoolStaking",
+			Handler:    _Query_PoolStaking_Handler,
+		},
+		{
+			MethodName: "Position",
+			Handler:    _Query_Position_Handler,
+		},
+		{
+			MethodName: "Positions",
+			Handler:    _Query_Positions_Handler,
+		},
+		{
+			MethodName: "DeployedCapital",
+			Handler:    _Query_DeployedCapital_Handler,
+		},
+		{
+			MethodName: "OutflowFreeze",
+			Handler:    _Query_OutflowFreeze_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PoolBalanceChanges",
+			Handler:       _Query_PoolBalanceChanges_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/protocolpool/v1/query.proto",
 }
@@ -19,13 +19,28 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_FundCommunityPool_FullMethodName      = "/cosmos.protocolpool.v1.Msg/FundCommunityPool"
-	Msg_CommunityPoolSpend_FullMethodName     = "/cosmos.protocolpool.v1.Msg/CommunityPoolSpend"
-	Msg_SubmitBudgetProposal_FullMethodName   = "/cosmos.protocolpool.v1.Msg/SubmitBudgetProposal"
-	Msg_ClaimBudget_FullMethodName            = "/cosmos.protocolpool.v1.Msg/ClaimBudget"
-	Msg_CreateContinuousFund_FullMethodName   = "/cosmos.protocolpool.v1.Msg/CreateContinuousFund"
-	Msg_WithdrawContinuousFund_FullMethodName = "/cosmos.protocolpool.v1.Msg/WithdrawContinuousFund"
-	Msg_CancelContinuousFund_FullMethodName   = "/cosmos.protocolpool.v1.Msg/CancelContinuousFund"
+	Msg_FundCommunityPool_FullMethodName        = "/cosmos.protocolpool.v1.Msg/FundCommunityPool"
+	Msg_CommunityPoolSpend_FullMethodName       = "/cosmos.protocolpool.v1.Msg/CommunityPoolSpend"
+	Msg_SubmitBudgetProposal_FullMethodName     = "/cosmos.protocolpool.v1.Msg/SubmitBudgetProposal"
+	Msg_ClaimBudget_FullMethodName              = "/cosmos.protocolpool.v1.Msg/ClaimBudget"
+	Msg_CreateContinuousFund_FullMethodName     = "/cosmos.protocolpool.v1.Msg/CreateContinuousFund"
+	Msg_WithdrawContinuousFund_FullMethodName   = "/cosmos.protocolpool.v1.Msg/WithdrawContinuousFund"
+	Msg_CancelContinuousFund_FullMethodName     = "/cosmos.protocolpool.v1.Msg/CancelContinuousFund"
+	Msg_CommunityPoolEscrowSpend_FullMethodName = "/cosmos.protocolpool.v1.Msg/CommunityPoolEscrowSpend"
+	Msg_ApproveMilestone_FullMethodName         = "/cosmos.protocolpool.v1.Msg/ApproveMilestone"
+	Msg_SetSpendLimits_FullMethodName           = "/cosmos.protocolpool.v1.Msg/SetSpendLimits"
+	Msg_SetDenomPolicies_FullMethodName         = "/cosmos.protocolpool.v1.Msg/SetDenomPolicies"
+	Msg_CreateRecurringGrant_FullMethodName     = "/cosmos.protocolpool.v1.Msg/CreateRecurringGrant"
+	Msg_RenewRecurringGrant_FullMethodName      = "/cosmos.protocolpool.v1.Msg/RenewRecurringGrant"
+	Msg_CancelRecurringGrant_FullMethodName     = "/cosmos.protocolpool.v1.Msg/CancelRecurringGrant"
+	Msg_PoolDelegate_FullMethodName             = "/cosmos.protocolpool.v1.Msg/PoolDelegate"
+	Msg_PoolUndelegate_FullMethodName           = "/cosmos.protocolpool.v1.Msg/PoolUndelegate"
+	Msg_OpenPosition_FullMethodName             = "/cosmos.protocolpool.v1.Msg/OpenPosition"
+	Msg_ReportPosition_FullMethodName           = "/cosmos.protocolpool.v1.Msg/ReportPosition"
+	Msg_SetFreezeParams_FullMethodName          = "/cosmos.protocolpool.v1.Msg/SetFreezeParams"
+	Msg_FreezeOutflows_FullMethodName           = "/cosmos.protocolpool.v1.Msg/FreezeOutflows"
+	Msg_RatifyFreeze_FullMethodName             = "/cosmos.protocolpool.v1.Msg/RatifyFreeze"
+	Msg_LiftFreeze_FullMethodName               = "/cosmos.protocolpool.v1.Msg/LiftFreeze"
 )
 
 // MsgClient is the client API for Msg service.
@@ -50,6 +65,58 @@ type MsgClient interface {
 	WithdrawContinuousFund(ctx context.Context, in *MsgWithdrawContinuousFund, opts ...grpc.CallOption) (*MsgWithdrawContinuousFundResponse, error)
 	// CancelContinuousFund defines a method for cancelling continuous fund.
 	CancelContinuousFund(ctx context.Context, in *MsgCancelContinuousFund, opts ...grpc.CallOption) (*MsgCancelContinuousFundResponse, error)
+	// CommunityPoolEscrowSpend defines a governance operation for moving tokens from
+	// the community pool to an escrow, from which they are released to the
+	// recipient milestone by milestone. The authority is defined in the keeper.
+	CommunityPoolEscrowSpend(ctx context.Context, in *MsgCommunityPoolEscrowSpend, opts ...grpc.CallOption) (*MsgCommunityPoolEscrowSpendResponse, error)
+	// ApproveMilestone defines a method for the reviewer or the authority to approve
+	// a milestone of an escrowed spend and release its funds to the recipient.
+	ApproveMilestone(ctx context.Context, in *MsgApproveMilestone, opts ...grpc.CallOption) (*MsgApproveMilestoneResponse, error)
+	// SetSpendLimits defines a governance operation for setting the per-denom
+	// limits of the community pool spends. The authority is defined in the keeper.
+	SetSpendLimits(ctx context.Context, in *MsgSetSpendLimits, opts ...grpc.CallOption) (*MsgSetSpendLimitsResponse, error)
+	// SetDenomPolicies defines a governance operation for setting the policies
+	// applied to the community pool holdings of unwanted denoms. The authority is
+	// defined in the keeper.
+	SetDenomPolicies(ctx context.Context, in *MsgSetDenomPolicies, opts ...grpc.CallOption) (*MsgSetDenomPoliciesResponse, error)
+	// CreateRecurringGrant defines a governance operation for paying a fixed amount
+	// from the community pool to a recipient every period. The authority is defined
+	// in the keeper.
+	CreateRecurringGrant(ctx context.Context, in *MsgCreateRecurringGrant, opts ...grpc.CallOption) (*MsgCreateRecurringGrantResponse, error)
+	// RenewRecurringGrant defines a governance operation for continuing a recurring
+	// grant until its next renewal. It is the message of the renewal proposals. The
+	// authority is defined in the keeper.
+	RenewRecurringGrant(ctx context.Context, in *MsgRenewRecurringGrant, opts ...grpc.CallOption) (*MsgRenewRecurringGrantResponse, error)
+	// CancelRecurringGrant defines a governance operation for cancelling a recurring
+	// grant. The authority is defined in the keeper.
+	CancelRecurringGrant(ctx context.Context, in *MsgCancelRecurringGrant, opts ...grpc.CallOption) (*MsgCancelRecurringGrantResponse, error)
+	// PoolDelegate defines a governance operation for delegating community pool
+	// funds to a validator. The authority is defined in the keeper.
+	PoolDelegate(ctx context.Context, in *MsgPoolDelegate, opts ...grpc.CallOption) (*MsgPoolDelegateResponse, error)
+	// PoolUndelegate defines a governance operation for undelegating community pool
+	// funds from a validator. The authority is defined in the keeper.
+	PoolUndelegate(ctx context.Context, in *MsgPoolUndelegate, opts ...grpc.CallOption) (*MsgPoolUndelegateResponse, error)
+	// OpenPosition defines a governance operation for recording an investment
+	// position of the community pool, optionally sending its principal out of the
+	// community pool. The authority is defined in the keeper.
+	OpenPosition(ctx context.Context, in *MsgOpenPosition, opts ...grpc.CallOption) (*MsgOpenPositionResponse, error)
+	// ReportPosition defines a method for the reporter of a position, or the
+	// authority, to report its current value and to close it.
+	ReportPosition(ctx context.Context, in *MsgReportPosition, opts ...grpc.CallOption) (*MsgReportPositionResponse, error)
+	// SetFreezeParams defines a governance operation for setting who can freeze the
+	// community pool outflows in an emergency, and for how long. The authority is
+	// defined in the keeper.
+	SetFreezeParams(ctx context.Context, in *MsgSetFreezeParams, opts ...grpc.CallOption) (*MsgSetFreezeParamsResponse, error)
+	// FreezeOutflows defines a method for the security authority, or the authority,
+	// to freeze the community pool outflows for a bounded duration.
+	FreezeOutflows(ctx context.Context, in *MsgFreezeOutflows, opts ...grpc.CallOption) (*MsgFreezeOutflowsResponse, error)
+	// RatifyFreeze defines a governance operation for ratifying the active freeze
+	// of the community pool outflows. It is the message of the ratification
+	// proposals. The authority is defined in the keeper.
+	RatifyFreeze(ctx context.Context, in *MsgRatifyFreeze, opts ...grpc.CallOption) (*MsgRatifyFreezeResponse, error)
+	// LiftFreeze defines a method for the authority, or the security authority, to
+	// lift the active freeze of the community pool outflows.
+	LiftFreeze(ctx context.Context, in *MsgLiftFreeze, opts ...grpc.CallOption) (*MsgLiftFreezeResponse, error)
 }
 
 type msgClient struct {
@@ -123,6 +190,141 @@ func (c *msgClient) CancelContinuousFund(ctx context.Context, in *MsgCancelConti
 	return out, nil
 }
 
+func (c *msgClient) CommunityPoolEscrowSpend(ctx context.Context, in *MsgCommunityPoolEscrowSpend, opts ...grpc.CallOption) (*MsgCommunityPoolEscrowSpendResponse, error) {
+	out := new(MsgCommunityPoolEscrowSpendResponse)
+	err := c.cc.Invoke(ctx, Msg_CommunityPoolEscrowSpend_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveMilestone(ctx context.Context, in *MsgApproveMilestone, opts ...grpc.CallOption) (*MsgApproveMilestoneResponse, error) {
+	out := new(MsgApproveMilestoneResponse)
+	err := c.cc.Invoke(ctx, Msg_ApproveMilestone_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSpendLimits(ctx context.Context, in *MsgSetSpendLimits, opts ...grpc.CallOption) (*MsgSetSpendLimitsResponse, error) {
+	out := new(MsgSetSpendLimitsResponse)
+	err := c.cc.Invoke(ctx, Msg_SetSpendLimits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomPolicies(ctx context.Context, in *MsgSetDenomPolicies, opts ...grpc.CallOption) (*MsgSetDenomPoliciesResponse, error) {
+	out := new(MsgSetDenomPoliciesResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDenomPolicies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateRecurringGrant(ctx context.Context, in *MsgCreateRecurringGrant, opts ...grpc.CallOption) (*MsgCreateRecurringGrantResponse, error) {
+	out := new(MsgCreateRecurringGrantResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateRecurringGrant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RenewRecurringGrant(ctx context.Context, in *MsgRenewRecurringGrant, opts ...grpc.CallOption) (*MsgRenewRecurringGrantResponse, error) {
+	out := new(MsgRenewRecurringGrantResponse)
+	err := c.cc.Invoke(ctx, Msg_RenewRecurringGrant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelRecurringGrant(ctx context.Context, in *MsgCancelRecurringGrant, opts ...grpc.CallOption) (*MsgCancelRecurringGrantResponse, error) {
+	out := new(MsgCancelRecurringGrantResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelRecurringGrant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PoolDelegate(ctx context.Context, in *MsgPoolDelegate, opts ...grpc.CallOption) (*MsgPoolDelegateResponse, error) {
+	out := new(MsgPoolDelegateResponse)
+	err := c.cc.Invoke(ctx, Msg_PoolDelegate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PoolUndelegate(ctx context.Context, in *MsgPoolUndelegate, opts ...grpc.CallOption) (*MsgPoolUndelegateResponse, error) {
+	out := new(MsgPoolUndelegateResponse)
+	err := c.cc.Invoke(ctx, Msg_PoolUndelegate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) OpenPosition(ctx context.Context, in *MsgOpenPosition, opts ...grpc.CallOption) (*MsgOpenPositionResponse, error) {
+	out := new(MsgOpenPositionResponse)
+	err := c.cc.Invoke(ctx, Msg_OpenPosition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReportPosition(ctx context.Context, in *MsgReportPosition, opts ...grpc.CallOption) (*MsgReportPositionResponse, error) {
+	out := new(MsgReportPositionResponse)
+	err := c.cc.Invoke(ctx, Msg_ReportPosition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFreezeParams(ctx context.Context, in *MsgSetFreezeParams, opts ...grpc.CallOption) (*MsgSetFreezeParamsResponse, error) {
+	out := new(MsgSetFreezeParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_SetFreezeParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FreezeOutflows(ctx context.Context, in *MsgFreezeOutflows, opts ...grpc.CallOption) (*MsgFreezeOutflowsResponse, error) {
+	out := new(MsgFreezeOutflowsResponse)
+	err := c.cc.Invoke(ctx, Msg_FreezeOutflows_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RatifyFreeze(ctx context.Context, in *MsgRatifyFreeze, opts ...grpc.CallOption) (*MsgRatifyFreezeResponse, error) {
+	out := new(MsgRatifyFreezeResponse)
+	err := c.cc.Invoke(ctx, Msg_RatifyFreeze_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LiftFreeze(ctx context.Context, in *MsgLiftFreeze, opts ...grpc.CallOption) (*MsgLiftFreezeResponse, error) {
+	out := new(MsgLiftFreezeResponse)
+	err := c.cc.Invoke(ctx, Msg_LiftFreeze_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -145,6 +347,58 @@ type MsgServer interface {
 	WithdrawContinuousFund(context.Context, *MsgWithdrawContinuousFund) (*MsgWithdrawContinuousFundResponse, error)
 	// CancelContinuousFund defines a method for cancelling continuous fund.
 	CancelContinuousFund(context.Context, *MsgCancelContinuousFund) (*MsgCancelContinuousFundResponse, error)
+	// CommunityPoolEscrowSpend defines a governance operation for moving tokens from
+	// the community pool to an escrow, from which they are released to the
+	// recipient milestone by milestone. The authority is defined in the keeper.
+	CommunityPoolEscrowSpend(context.Context, *MsgCommunityPoolEscrowSpend) (*MsgCommunityPoolEscrowSpendResponse, error)
+	// ApproveMilestone defines a method for the reviewer or the authority to approve
+	// a milestone of an escrowed spend and release its funds to the recipient.
+	ApproveMilestone(context.Context, *MsgApproveMilestone) (*MsgApproveMilestoneResponse, error)
+	// SetSpendLimits defines a governance operation for setting the per-denom
+	// limits of the community pool spends. The authority is defined in the keeper.
+	SetSpendLimits(context.Context, *MsgSetSpendLimits) (*MsgSetSpendLimitsResponse, error)
+	// SetDenomPolicies defines a governance operation for setting the policies
+	// applied to the community pool holdings of unwanted denoms. The authority is
+	// defined in the keeper.
+	SetDenomPolicies(context.Context, *MsgSetDenomPolicies) (*MsgSetDenomPoliciesResponse, error)
+	// CreateRecurringGrant defines a governance operation for paying a fixed amount
+	// from the community pool to a recipient every period. The authority is defined
+	// in the keeper.
+	CreateRecurringGrant(context.Context, *MsgCreateRecurringGrant) (*MsgCreateRecurringGrantResponse, error)
+	// RenewRecurringGrant defines a governance operation for continuing a recurring
+	// grant until its next renewal. It is the message of the renewal proposals. The
+	// authority is defined in the keeper.
+	RenewRecurringGrant(context.Context, *MsgRenewRecurringGrant) (*MsgRenewRecurringGrantResponse, error)
+	// CancelRecurringGrant defines a governance operation for cancelling a recurring
+	// grant. The authority is defined in the keeper.
+	CancelRecurringGrant(context.Context, *MsgCancelRecurringGrant) (*MsgCancelRecurringGrantResponse, error)
+	// PoolDelegate defines a governance operation for delegating community pool
+	// funds to a validator. The authority is defined in the keeper.
+	PoolDelegate(context.Context, *MsgPoolDelegate) (*MsgPoolDelegateResponse, error)
+	// PoolUndelegate defines a governance operation for undelegating community pool
+	// funds from a validator. The authority is defined in the keeper.
+	PoolUndelegate(context.Context, *MsgPoolUndelegate) (*MsgPoolUndelegateResponse, error)
+	// OpenPosition defines a governance operation for recording an investment
+	// position of the community pool, optionally sending its principal out of the
+	// community pool. The authority is defined in the keeper.
+	OpenPosition(context.Context, *MsgOpenPosition) (*MsgOpenPositionResponse, error)
+	// ReportPosition defines a method for the reporter of a position, or the
+	// authority, to report its current value and to close it.
+	ReportPosition(context.Context, *MsgReportPosition) (*MsgReportPositionResponse, error)
+	// SetFreezeParams defines a governance operation for setting who can freeze the
+	// community pool outflows in an emergency, and for how long. The authority is
+	// defined in the keeper.
+	SetFreezeParams(context.Context, *MsgSetFreezeParams) (*MsgSetFreezeParamsResponse, error)
+	// FreezeOutflows defines a method for the security authority, or the authority,
+	// to freeze the community pool outflows for a bounded duration.
+	FreezeOutflows(context.Context, *MsgFreezeOutflows) (*MsgFreezeOutflowsResponse, error)
+	// RatifyFreeze defines a governance operation for ratifying the active freeze
+	// of the community pool outflows. It is the message of the ratification
+	// proposals. The authority is defined in the keeper.
+	RatifyFreeze(context.Context, *MsgRatifyFreeze) (*MsgRatifyFreezeResponse, error)
+	// LiftFreeze defines a method for the authority, or the security authority, to
+	// lift the active freeze of the community pool outflows.
+	LiftFreeze(context.Context, *MsgLiftFreeze) (*MsgLiftFreezeResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -173,6 +427,51 @@ func (UnimplementedMsgServer) WithdrawContinuousFund(context.Context, *MsgWithdr
 func (UnimplementedMsgServer) CancelContinuousFund(context.Context, *MsgCancelContinuousFund) (*MsgCancelContinuousFundResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelContinuousFund not implemented")
 }
+func (UnimplementedMsgServer) CommunityPoolEscrowSpend(context.Context, *MsgCommunityPoolEscrowSpend) (*MsgCommunityPoolEscrowSpendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommunityPoolEscrowSpend not implemented")
+}
+func (UnimplementedMsgServer) ApproveMilestone(context.Context, *MsgApproveMilestone) (*MsgApproveMilestoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveMilestone not implemented")
+}
+func (UnimplementedMsgServer) SetSpendLimits(context.Context, *MsgSetSpendLimits) (*MsgSetSpendLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSpendLimits not implemented")
+}
+func (UnimplementedMsgServer) SetDenomPolicies(context.Context, *MsgSetDenomPolicies) (*MsgSetDenomPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomPolicies not implemented")
+}
+func (UnimplementedMsgServer) CreateRecurringGrant(context.Context, *MsgCreateRecurringGrant) (*MsgCreateRecurringGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecurringGrant not implemented")
+}
+func (UnimplementedMsgServer) RenewRecurringGrant(context.Context, *MsgRenewRecurringGrant) (*MsgRenewRecurringGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewRecurringGrant not implemented")
+}
+func (UnimplementedMsgServer) CancelRecurringGrant(context.Context, *MsgCancelRecurringGrant) (*MsgCancelRecurringGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRecurringGrant not implemented")
+}
+func (UnimplementedMsgServer) PoolDelegate(context.Context, *MsgPoolDelegate) (*MsgPoolDelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolDelegate not implemented")
+}
+func (UnimplementedMsgServer) PoolUndelegate(context.Context, *MsgPoolUndelegate) (*MsgPoolUndelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolUndelegate not implemented")
+}
+func (UnimplementedMsgServer) OpenPosition(context.Context, *MsgOpenPosition) (*MsgOpenPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenPosition not implemented")
+}
+func (UnimplementedMsgServer) ReportPosition(context.Context, *MsgReportPosition) (*MsgReportPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportPosition not implemented")
+}
+func (UnimplementedMsgServer) SetFreezeParams(context.Context, *MsgSetFreezeParams) (*MsgSetFreezeParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFreezeParams not implemented")
+}
+func (UnimplementedMsgServer) FreezeOutflows(context.Context, *MsgFreezeOutflows) (*MsgFreezeOutflowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeOutflows not implemented")
+}
+func (UnimplementedMsgServer) RatifyFreeze(context.Context, *MsgRatifyFreeze) (*MsgRatifyFreezeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RatifyFreeze not implemented")
+}
+func (UnimplementedMsgServer) LiftFreeze(context.Context, *MsgLiftFreeze) (*MsgLiftFreezeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiftFreeze not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -312,6 +611,276 @@ func _Msg_CancelContinuousFund_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CommunityPoolEscrowSpend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommunityPoolEscrowSpend)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommunityPoolEscrowSpend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CommunityPoolEscrowSpend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommunityPoolEscrowSpend(ctx, req.(*MsgCommunityPoolEscrowSpend))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveMilestone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveMilestone)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveMilestone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ApproveMilestone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveMilestone(ctx, req.(*MsgApproveMilestone))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSpendLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSpendLimits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSpendLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetSpendLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSpendLimits(ctx, req.(*MsgSetSpendLimits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomPolicies)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetDenomPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomPolicies(ctx, req.(*MsgSetDenomPolicies))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateRecurringGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateRecurringGrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateRecurringGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateRecurringGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateRecurringGrant(ctx, req.(*MsgCreateRecurringGrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RenewRecurringGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRenewRecurringGrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RenewRecurringGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RenewRecurringGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RenewRecurringGrant(ctx, req.(*MsgRenewRecurringGrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelRecurringGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRecurringGrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRecurringGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelRecurringGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRecurringGrant(ctx, req.(*MsgCancelRecurringGrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PoolDelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPoolDelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PoolDelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PoolDelegate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PoolDelegate(ctx, req.(*MsgPoolDelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PoolUndelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPoolUndelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PoolUndelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PoolUndelegate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PoolUndelegate(ctx, req.(*MsgPoolUndelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OpenPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOpenPosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OpenPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_OpenPosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OpenPosition(ctx, req.(*MsgOpenPosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReportPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReportPosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReportPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReportPosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReportPosition(ctx, req.(*MsgReportPosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFreezeParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFreezeParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFreezeParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetFreezeParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFreezeParams(ctx, req.(*MsgSetFreezeParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FreezeOutflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeOutflows)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeOutflows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FreezeOutflows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeOutflows(ctx, req.(*MsgFreezeOutflows))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RatifyFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRatifyFreeze)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RatifyFreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RatifyFreeze_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RatifyFreeze(ctx, req.(*MsgRatifyFreeze))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LiftFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiftFreeze)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LiftFreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_LiftFreeze_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LiftFreeze(ctx, req.(*MsgLiftFreeze))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -347,6 +916,66 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelContinuousFund",
 			Handler:    _Msg_CancelContinuousFund_Handler,
 		},
+		{
+			MethodName: "CommunityPoolEscrowSpend",
+			Handler:    _Msg_CommunityPoolEscrowSpend_Handler,
+		},
+		{
+			MethodName: "ApproveMilestone",
+			Handler:    _Msg_ApproveMilestone_Handler,
+		},
+		{
+			MethodName: "SetSpendLimits",
+			Handler:    _Msg_SetSpendLimits_Handler,
+		},
+		{
+			MethodName: "SetDenomPolicies",
+			Handler:    _Msg_SetDenomPolicies_Handler,
+		},
+		{
+			MethodName: "CreateRecurringGrant",
+			Handler:    _Msg_CreateRecurringGrant_Handler,
+		},
+		{
+			MethodName: "RenewRecurringGrant",
+			Handler:    _Msg_RenewRecurringGrant_Handler,
+		},
+		{
+			MethodName: "CancelRecurringGrant",
+			Handler:    _Msg_CancelRecurringGrant_Handler,
+		},
+		{
+			MethodName: "PoolDelegate",
+			Handler:    _Msg_PoolDelegate_Handler,
+		},
+		{
+			MethodName: "PoolUndelegate",
+			Handler:    _Msg_PoolUndelegate_Handler,
+		},
+		{
+			MethodName: "OpenPosition",
+			Handler:    _Msg_OpenPosition_Handler,
+		},
+		{
+			MethodName: "ReportPosition",
+			Handler:    _Msg_ReportPosition_Handler,
+		},
+		{
+			MethodName: "SetFreezeParams",
+			Handler:    _Msg_SetFreezeParams_Handler,
+		},
+		{
+			MethodName: "FreezeOutflows",
+			Handler:    _Msg_FreezeOutflows_Handler,
+		},
+		{
+			MethodName: "RatifyFreeze",
+			Handler:    _Msg_RatifyFreeze_Handler,
+		},
+		{
+			MethodName: "LiftFreeze",
+			Handler:    _Msg_LiftFreeze_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/protocolpool/v1/tx.proto",
@@ -19,9 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName       = "/cosmos.slashing.v1beta1.Query/Params"
-	Query_SigningInfo_FullMethodName  = "/cosmos.slashing.v1beta1.Query/SigningInfo"
-	Query_SigningInfos_FullMethodName = "/cosmos.slashing.v1beta1.Query/SigningInfos"
+	Query_Params_FullMethodName           = "/cosmos.slashing.v1beta1.Query/Params"
+	Query_SigningInfo_FullMethodName      = "/cosmos.slashing.v1beta1.Query/SigningInfo"
+	Query_SigningInfos_FullMethodName     = "/cosmos.slashing.v1beta1.Query/SigningInfos"
+	Query_DowntimeOffenses_FullMethodName = "/cosmos.slashing.v1beta1.Query/DowntimeOffenses"
+	Query_SigningWindows_FullMethodName   = "/cosmos.slashing.v1beta1.Query/SigningWindows"
+	Query_InsuranceBond_FullMethodName    = "/cosmos.slashing.v1beta1.Query/InsuranceBond"
+	Query_InsuranceBonds_FullMethodName   = "/cosmos.slashing.v1beta1.Query/InsuranceBonds"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +38,16 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// DowntimeOffenses queries the downtime offense counter of given cons
+	// address, and the fraction slashed for its next downtime offense.
+	DowntimeOffenses(ctx context.Context, in *QueryDowntimeOffensesRequest, opts ...grpc.CallOption) (*QueryDowntimeOffensesResponse, error)
+	// SigningWindows queries the uptime of given cons address over windows of
+	// recent blocks.
+	SigningWindows(ctx context.Context, in *QuerySigningWindowsRequest, opts ...grpc.CallOption) (*QuerySigningWindowsResponse, error)
+	// InsuranceBond queries the insurance bond of given validator.
+	InsuranceBond(ctx context.Context, in *QueryInsuranceBondRequest, opts ...grpc.CallOption) (*QueryInsuranceBondResponse, error)
+	// InsuranceBonds queries the insurance bonds of all validators.
+	InsuranceBonds(ctx context.Context, in *QueryInsuranceBondsRequest, opts ...grpc.CallOption) (*QueryInsuranceBondsResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +85,42 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) DowntimeOffenses(ctx context.Context, in *QueryDowntimeOffensesRequest, opts ...grpc.CallOption) (*QueryDowntimeOffensesResponse, error) {
+	out := new(QueryDowntimeOffensesResponse)
+	err := c.cc.Invoke(ctx, Query_DowntimeOffenses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SigningWindows(ctx context.Context, in *QuerySigningWindowsRequest, opts ...grpc.CallOption) (*QuerySigningWindowsResponse, error) {
+	out := new(QuerySigningWindowsResponse)
+	err := c.cc.Invoke(ctx, Query_SigningWindows_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InsuranceBond(ctx context.Context, in *QueryInsuranceBondRequest, opts ...grpc.CallOption) (*QueryInsuranceBondResponse, error) {
+	out := new(QueryInsuranceBondResponse)
+	err := c.cc.Invoke(ctx, Query_InsuranceBond_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InsuranceBonds(ctx context.Context, in *QueryInsuranceBondsRequest, opts ...grpc.CallOption) (*QueryInsuranceBondsResponse, error) {
+	out := new(QueryInsuranceBondsResponse)
+	err := c.cc.Invoke(ctx, Query_InsuranceBonds_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +131,16 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// DowntimeOffenses queries the downtime offense counter of given cons
+	// address, and the fraction slashed for its next downtime offense.
+	DowntimeOffenses(context.Context, *QueryDowntimeOffensesRequest) (*QueryDowntimeOffensesResponse, error)
+	// SigningWindows queries the uptime of given cons address over windows of
+	// recent blocks.
+	SigningWindows(context.Context, *QuerySigningWindowsRequest) (*QuerySigningWindowsResponse, error)
+	// InsuranceBond queries the insurance bond of given validator.
+	InsuranceBond(context.Context, *QueryInsuranceBondRequest) (*QueryInsuranceBondResponse, error)
+	// InsuranceBonds queries the insurance bonds of all validators.
+	InsuranceBonds(context.Context, *QueryInsuranceBondsRequest) (*QueryInsuranceBondsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +157,18 @@ func (UnimplementedQueryServer) SigningInfo(context.Context, *QuerySigningInfoRe
 func (UnimplementedQueryServer) SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (UnimplementedQueryServer) DowntimeOffenses(context.Context, *QueryDowntimeOffensesRequest) (*QueryDowntimeOffensesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DowntimeOffenses not implemented")
+}
+func (UnimplementedQueryServer) SigningWindows(context.Context, *QuerySigningWindowsRequest) (*QuerySigningWindowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SigningWindows not implemented")
+}
+func (UnimplementedQueryServer) InsuranceBond(context.Context, *QueryInsuranceBondRequest) (*QueryInsuranceBondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsuranceBond not implemented")
+}
+func (UnimplementedQueryServer) InsuranceBonds(context.Context, *QueryInsuranceBondsRequest) (*QueryInsuranceBondsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsuranceBonds not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +236,78 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DowntimeOffenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDowntimeOffensesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DowntimeOffenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DowntimeOffenses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DowntimeOffenses(ctx, req.(*QueryDowntimeOffensesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SigningWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySigningWindowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SigningWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SigningWindows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SigningWindows(ctx, req.(*QuerySigningWindowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InsuranceBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInsuranceBondRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InsuranceBond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_InsuranceBond_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InsuranceBond(ctx, req.(*QueryInsuranceBondRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InsuranceBonds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInsuranceBondsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InsuranceBonds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_InsuranceBonds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InsuranceBonds(ctx, req.(*QueryInsuranceBondsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +327,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "DowntimeOffenses",
+			Handler:    _Query_DowntimeOffenses_Handler,
+		},
+		{
+			MethodName: "SigningWindows",
+			Handler:    _Query_SigningWindows_Handler,
+		},
+		{
+			MethodName: "InsuranceBond",
+			Handler:    _Query_InsuranceBond_Handler,
+		},
+		{
+			MethodName: "InsuranceBonds",
+			Handler:    _Query_InsuranceBonds_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
@@ -19,8 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Unjail_FullMethodName       = "/cosmos.slashing.v1beta1.Msg/Unjail"
-	Msg_UpdateParams_FullMethodName = "/cosmos.slashing.v1beta1.Msg/UpdateParams"
+	Msg_Unjail_FullMethodName                = "/cosmos.slashing.v1beta1.Msg/Unjail"
+	Msg_Heartbeat_FullMethodName             = "/cosmos.slashing.v1beta1.Msg/Heartbeat"
+	Msg_DepositInsuranceBond_FullMethodName  = "/cosmos.slashing.v1beta1.Msg/DepositInsuranceBond"
+	Msg_WithdrawInsuranceBond_FullMethodName = "/cosmos.slashing.v1beta1.Msg/WithdrawInsuranceBond"
+	Msg_UpdateParams_FullMethodName          = "/cosmos.slashing.v1beta1.Msg/UpdateParams"
+	Msg_RevertTombstone_FullMethodName       = "/cosmos.slashing.v1beta1.Msg/RevertTombstone"
 )
 
 // MsgClient is the client API for Msg service.
@@ -31,11 +35,25 @@ type MsgClient interface {
 	// them into the bonded validator set, so they can begin receiving provisions
 	// and rewards again.
 	Unjail(ctx context.Context, in *MsgUnjail, opts ...grpc.CallOption) (*MsgUnjailResponse, error)
+	// Heartbeat defines a method for a validator outside the active set to signal
+	// that it is online and ready to (re-)enter the active set.
+	Heartbeat(ctx context.Context, in *MsgHeartbeat, opts ...grpc.CallOption) (*MsgHeartbeatResponse, error)
+	// DepositInsuranceBond defines a method for a validator to lock funds in its
+	// insurance bond, which compensates its delegators when it is slashed for
+	// downtime.
+	DepositInsuranceBond(ctx context.Context, in *MsgDepositInsuranceBond, opts ...grpc.CallOption) (*MsgDepositInsuranceBondResponse, error)
+	// WithdrawInsuranceBond defines a method for a validator which is not jailed
+	// to withdraw funds from its insurance bond.
+	WithdrawInsuranceBond(ctx context.Context, in *MsgWithdrawInsuranceBond, opts ...grpc.CallOption) (*MsgWithdrawInsuranceBondResponse, error)
 	// UpdateParams defines a governance operation for updating the x/slashing module
 	// parameters. The authority defaults to the x/gov module account.
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RevertTombstone defines a governance operation for reverting the tombstone
+	// of a validator, e.g. after a proven false-positive equivocation. The
+	// validator can be unjailed once the tombstone reversal cooldown has passed.
+	RevertTombstone(ctx context.Context, in *MsgRevertTombstone, opts ...grpc.CallOption) (*MsgRevertTombstoneResponse, error)
 }
 
 type msgClient struct {
@@ -55,6 +73,33 @@ func (c *msgClient) Unjail(ctx context.Context, in *MsgUnjail, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) Heartbeat(ctx context.Context, in *MsgHeartbeat, opts ...grpc.CallOption) (*MsgHeartbeatResponse, error) {
+	out := new(MsgHeartbeatResponse)
+	err := c.cc.Invoke(ctx, Msg_Heartbeat_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DepositInsuranceBond(ctx context.Context, in *MsgDepositInsuranceBond, opts ...grpc.CallOption) (*MsgDepositInsuranceBondResponse, error) {
+	out := new(MsgDepositInsuranceBondResponse)
+	err := c.cc.Invoke(ctx, Msg_DepositInsuranceBond_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawInsuranceBond(ctx context.Context, in *MsgWithdrawInsuranceBond, opts ...grpc.CallOption) (*MsgWithdrawInsuranceBondResponse, error) {
+	out := new(MsgWithdrawInsuranceBondResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawInsuranceBond_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
@@ -64,6 +109,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RevertTombstone(ctx context.Context, in *MsgRevertTombstone, opts ...grpc.CallOption) (*MsgRevertTombstoneResponse, error) {
+	out := new(MsgRevertTombstoneResponse)
+	err := c.cc.Invoke(ctx, Msg_RevertTombstone_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -72,11 +126,25 @@ type MsgServer interface {
 	// them into the bonded validator set, so they can begin receiving provisions
 	// and rewards again.
 	Unjail(context.Context, *MsgUnjail) (*MsgUnjailResponse, error)
+	// Heartbeat defines a method for a validator outside the active set to signal
+	// that it is online and ready to (re-)enter the active set.
+	Heartbeat(context.Context, *MsgHeartbeat) (*MsgHeartbeatResponse, error)
+	// DepositInsuranceBond defines a method for a validator to lock funds in its
+	// insurance bond, which compensates its delegators when it is slashed for
+	// downtime.
+	DepositInsuranceBond(context.Context, *MsgDepositInsuranceBond) (*MsgDepositInsuranceBondResponse, error)
+	// WithdrawInsuranceBond defines a method for a validator which is not jailed
+	// to withdraw funds from its insurance bond.
+	WithdrawInsuranceBond(context.Context, *MsgWithdrawInsuranceBond) (*MsgWithdrawInsuranceBondResponse, error)
 	// UpdateParams defines a governance operation for updating the x/slashing module
 	// parameters. The authority defaults to the x/gov module account.
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RevertTombstone defines a governance operation for reverting the tombstone
+	// of a validator, e.g. after a proven false-positive equivocation. The
+	// validator can be unjailed once the tombstone reversal cooldown has passed.
+	RevertTombstone(context.Context, *MsgRevertTombstone) (*MsgRevertTombstoneResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -87,9 +155,21 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) Unjail(context.Context, *MsgUnjail) (*MsgUnjailResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Unjail not implemented")
 }
+func (UnimplementedMsgServer) Heartbeat(context.Context, *MsgHeartbeat) (*MsgHeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (UnimplementedMsgServer) DepositInsuranceBond(context.Context, *MsgDepositInsuranceBond) (*MsgDepositInsuranceBondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositInsuranceBond not implemented")
+}
+func (UnimplementedMsgServer) WithdrawInsuranceBond(context.Context, *MsgWithdrawInsuranceBond) (*MsgWithdrawInsuranceBondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawInsuranceBond not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) RevertTombstone(context.Context, *MsgRevertTombstone) (*MsgRevertTombstoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertTombstone not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -121,6 +201,60 @@ func _Msg_Unjail_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgHeartbeat)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Heartbeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Heartbeat(ctx, req.(*MsgHeartbeat))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DepositInsuranceBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositInsuranceBond)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositInsuranceBond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DepositInsuranceBond_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositInsuranceBond(ctx, req.(*MsgDepositInsuranceBond))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawInsuranceBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawInsuranceBond)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawInsuranceBond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawInsuranceBond_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawInsuranceBond(ctx, req.(*MsgWithdrawInsuranceBond))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -139,6 +273,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RevertTombstone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevertTombstone)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevertTombstone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevertTombstone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevertTombstone(ctx, req.(*MsgRevertTombstone))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -150,10 +302,26 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Unjail",
 			Handler:    _Msg_Unjail_Handler,
 		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _Msg_Heartbeat_Handler,
+		},
+		{
+			MethodName: "DepositInsuranceBond",
+			Handler:    _Msg_DepositInsuranceBond_Handler,
+		},
+		{
+			MethodName: "WithdrawInsuranceBond",
+			Handler:    _Msg_WithdrawInsuranceBond_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RevertTombstone",
+			Handler:    _Msg_RevertTombstone_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/tx.proto",
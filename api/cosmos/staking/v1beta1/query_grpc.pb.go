@@ -19,20 +19,37 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Validators_FullMethodName                    = "/cosmos.staking.v1beta1.Query/Validators"
-	Query_Validator_FullMethodName                     = "/cosmos.staking.v1beta1.Query/Validator"
-	Query_ValidatorDelegations_FullMethodName          = "/cosmos.staking.v1beta1.Query/ValidatorDelegations"
-	Query_ValidatorUnbondingDelegations_FullMethodName = "/cosmos.staking.v1beta1.Query/ValidatorUnbondingDelegations"
-	Query_Delegation_FullMethodName                    = "/cosmos.staking.v1beta1.Query/Delegation"
-	Query_UnbondingDelegation_FullMethodName           = "/cosmos.staking.v1beta1.Query/UnbondingDelegation"
-	Query_DelegatorDelegations_FullMethodName          = "/cosmos.staking.v1beta1.Query/DelegatorDelegations"
-	Query_DelegatorUnbondingDelegations_FullMethodName = "/cosmos.staking.v1beta1.Query/DelegatorUnbondingDelegations"
-	Query_Redelegations_FullMethodName                 = "/cosmos.staking.v1beta1.Query/Redelegations"
-	Query_DelegatorValidators_FullMethodName           = "/cosmos.staking.v1beta1.Query/DelegatorValidators"
-	Query_DelegatorValidator_FullMethodName            = "/cosmos.staking.v1beta1.Query/DelegatorValidator"
-	Query_HistoricalInfo_FullMethodName                = "/cosmos.staking.v1beta1.Query/HistoricalInfo"
-	Query_Pool_FullMethodName                          = "/cosmos.staking.v1beta1.Query/Pool"
-	Query_Params_FullMethodName                        = "/cosmos.staking.v1beta1.Query/Params"
+	Query_Validators_FullMethodName                     = "/cosmos.staking.v1beta1.Query/Validators"
+	Query_Validator_FullMethodName                      = "/cosmos.staking.v1beta1.Query/Validator"
+	Query_ValidatorDelegations_FullMethodName           = "/cosmos.staking.v1beta1.Query/ValidatorDelegations"
+	Query_ValidatorUnbondingDelegations_FullMethodName  = "/cosmos.staking.v1beta1.Query/ValidatorUnbondingDelegations"
+	Query_Delegation_FullMethodName                     = "/cosmos.staking.v1beta1.Query/Delegation"
+	Query_UnbondingDelegation_FullMethodName            = "/cosmos.staking.v1beta1.Query/UnbondingDelegation"
+	Query_DelegatorDelegations_FullMethodName           = "/cosmos.staking.v1beta1.Query/DelegatorDelegations"
+	Query_DelegatorUnbondingDelegations_FullMethodName  = "/cosmos.staking.v1beta1.Query/DelegatorUnbondingDelegations"
+	Query_Redelegations_FullMethodName                  = "/cosmos.staking.v1beta1.Query/Redelegations"
+	Query_DelegatorValidators_FullMethodName            = "/cosmos.staking.v1beta1.Query/DelegatorValidators"
+	Query_DelegatorValidator_FullMethodName             = "/cosmos.staking.v1beta1.Query/DelegatorValidator"
+	Query_HistoricalInfo_FullMethodName                 = "/cosmos.staking.v1beta1.Query/HistoricalInfo"
+	Query_Pool_FullMethodName                           = "/cosmos.staking.v1beta1.Query/Pool"
+	Query_Params_FullMethodName                         = "/cosmos.staking.v1beta1.Query/Params"
+	Query_DelegationPosition_FullMethodName             = "/cosmos.staking.v1beta1.Query/DelegationPosition"
+	Query_OwnerDelegationPositions_FullMethodName       = "/cosmos.staking.v1beta1.Query/OwnerDelegationPositions"
+	Query_TokenizeShareRecordById_FullMethodName        = "/cosmos.staking.v1beta1.Query/TokenizeShareRecordById"
+	Query_TokenizeShareRecordByDenom_FullMethodName     = "/cosmos.staking.v1beta1.Query/TokenizeShareRecordByDenom"
+	Query_TokenizeShareRecordsOwned_FullMethodName      = "/cosmos.staking.v1beta1.Query/TokenizeShareRecordsOwned"
+	Query_AllTokenizeShareRecords_FullMethodName        = "/cosmos.staking.v1beta1.Query/AllTokenizeShareRecords"
+	Query_TotalLiquidStaked_FullMethodName              = "/cosmos.staking.v1beta1.Query/TotalLiquidStaked"
+	Query_DelegatorAutoCompoundSettings_FullMethodName  = "/cosmos.staking.v1beta1.Query/DelegatorAutoCompoundSettings"
+	Query_ScheduledCommissionChange_FullMethodName      = "/cosmos.staking.v1beta1.Query/ScheduledCommissionChange"
+	Query_ValidatorPerformance_FullMethodName           = "/cosmos.staking.v1beta1.Query/ValidatorPerformance"
+	Query_UnbondingQueue_FullMethodName                 = "/cosmos.staking.v1beta1.Query/UnbondingQueue"
+	Query_RedelegationQueue_FullMethodName              = "/cosmos.staking.v1beta1.Query/RedelegationQueue"
+	Query_DenomBondedAmounts_FullMethodName             = "/cosmos.staking.v1beta1.Query/DenomBondedAmounts"
+	Query_ValidatorMultiAssetPools_FullMethodName       = "/cosmos.staking.v1beta1.Query/ValidatorMultiAssetPools"
+	Query_DelegatorMultiAssetDelegations_FullMethodName = "/cosmos.staking.v1beta1.Query/DelegatorMultiAssetDelegations"
+	Query_MaxValidatorsSchedule_FullMethodName          = "/cosmos.staking.v1beta1.Query/MaxValidatorsSchedule"
+	Query_RedelegationExemptions_FullMethodName         = "/cosmos.staking.v1beta1.Query/RedelegationExemptions"
 )
 
 // QueryClient is the client API for Query service.
@@ -92,6 +109,58 @@ type QueryClient interface {
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// DelegationPosition queries a delegation position by id.
+	DelegationPosition(ctx context.Context, in *QueryDelegationPositionRequest, opts ...grpc.CallOption) (*QueryDelegationPositionResponse, error)
+	// OwnerDelegationPositions queries all delegation positions of a given owner.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	OwnerDelegationPositions(ctx context.Context, in *QueryOwnerDelegationPositionsRequest, opts ...grpc.CallOption) (*QueryOwnerDelegationPositionsResponse, error)
+	// TokenizeShareRecordById queries a tokenize share record by id.
+	TokenizeShareRecordById(ctx context.Context, in *QueryTokenizeShareRecordByIdRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordByIdResponse, error)
+	// TokenizeShareRecordByDenom queries a tokenize share record by the denom of
+	// its share tokens.
+	TokenizeShareRecordByDenom(ctx context.Context, in *QueryTokenizeShareRecordByDenomRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordByDenomResponse, error)
+	// TokenizeShareRecordsOwned queries the tokenize share records of a given owner.
+	TokenizeShareRecordsOwned(ctx context.Context, in *QueryTokenizeShareRecordsOwnedRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordsOwnedResponse, error)
+	// AllTokenizeShareRecords queries all the tokenize share records.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	AllTokenizeShareRecords(ctx context.Context, in *QueryAllTokenizeShareRecordsRequest, opts ...grpc.CallOption) (*QueryAllTokenizeShareRecordsResponse, error)
+	// TotalLiquidStaked queries the amount of bond denom tokens tokenized into
+	// share tokens, globally and for a given validator.
+	TotalLiquidStaked(ctx context.Context, in *QueryTotalLiquidStakedRequest, opts ...grpc.CallOption) (*QueryTotalLiquidStakedResponse, error)
+	// DelegatorAutoCompoundSettings queries the delegations of a delegator whose
+	// rewards are auto-compounded.
+	DelegatorAutoCompoundSettings(ctx context.Context, in *QueryDelegatorAutoCompoundSettingsRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundSettingsResponse, error)
+	// ScheduledCommissionChange queries the commission rate change scheduled by
+	// a validator.
+	ScheduledCommissionChange(ctx context.Context, in *QueryScheduledCommissionChangeRequest, opts ...grpc.CallOption) (*QueryScheduledCommissionChangeResponse, error)
+	// ValidatorPerformance queries the signed, missed and proposed blocks of a
+	// validator over the performance window.
+	ValidatorPerformance(ctx context.Context, in *QueryValidatorPerformanceRequest, opts ...grpc.CallOption) (*QueryValidatorPerformanceResponse, error)
+	// UnbondingQueue queries the unbonding delegation entries maturing within a
+	// time range, along with the amounts maturing per day.
+	UnbondingQueue(ctx context.Context, in *QueryUnbondingQueueRequest, opts ...grpc.CallOption) (*QueryUnbondingQueueResponse, error)
+	// RedelegationQueue queries the redelegation entries maturing within a time
+	// range, along with the amounts maturing per day.
+	RedelegationQueue(ctx context.Context, in *QueryRedelegationQueueRequest, opts ...grpc.CallOption) (*QueryRedelegationQueueResponse, error)
+	// DenomBondedAmounts queries the amounts of tokens bonded per bondable
+	// denom, including the bond denom, along with their weights.
+	DenomBondedAmounts(ctx context.Context, in *QueryDenomBondedAmountsRequest, opts ...grpc.CallOption) (*QueryDenomBondedAmountsResponse, error)
+	// ValidatorMultiAssetPools queries the tokens of the bondable denoms other
+	// than the bond denom delegated to a validator.
+	ValidatorMultiAssetPools(ctx context.Context, in *QueryValidatorMultiAssetPoolsRequest, opts ...grpc.CallOption) (*QueryValidatorMultiAssetPoolsResponse, error)
+	// DelegatorMultiAssetDelegations queries the delegations of the bondable
+	// denoms other than the bond denom of a delegator.
+	DelegatorMultiAssetDelegations(ctx context.Context, in *QueryDelegatorMultiAssetDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorMultiAssetDelegationsResponse, error)
+	// MaxValidatorsSchedule queries the changes of the MaxValidators param
+	// scheduled at future heights.
+	MaxValidatorsSchedule(ctx context.Context, in *QueryMaxValidatorsScheduleRequest, opts ...grpc.CallOption) (*QueryMaxValidatorsScheduleResponse, error)
+	// RedelegationExemptions queries the redelegation exemptions approved by
+	// governance for validator consolidations.
+	RedelegationExemptions(ctx context.Context, in *QueryRedelegationExemptionsRequest, opts ...grpc.CallOption) (*QueryRedelegationExemptionsResponse, error)
 }
 
 type queryClient struct {
@@ -228,6 +297,159 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) DelegationPosition(ctx context.Context, in *QueryDelegationPositionRequest, opts ...grpc.CallOption) (*QueryDelegationPositionResponse, error) {
+	out := new(QueryDelegationPositionResponse)
+	err := c.cc.Invoke(ctx, Query_DelegationPosition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OwnerDelegationPositions(ctx context.Context, in *QueryOwnerDelegationPositionsRequest, opts ...grpc.CallOption) (*QueryOwnerDelegationPositionsResponse, error) {
+	out := new(QueryOwnerDelegationPositionsResponse)
+	err := c.cc.Invoke(ctx, Query_OwnerDelegationPositions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenizeShareRecordById(ctx context.Context, in *QueryTokenizeShareRecordByIdRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordByIdResponse, error) {
+	out := new(QueryTokenizeShareRecordByIdResponse)
+	err := c.cc.Invoke(ctx, Query_TokenizeShareRecordById_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenizeShareRecordByDenom(ctx context.Context, in *QueryTokenizeShareRecordByDenomRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordByDenomResponse, error) {
+	out := new(QueryTokenizeShareRecordByDenomResponse)
+	err := c.cc.Invoke(ctx, Query_TokenizeShareRecordByDenom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenizeShareRecordsOwned(ctx context.Context, in *QueryTokenizeShareRecordsOwnedRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordsOwnedResponse, error) {
+	out := new(QueryTokenizeShareRecordsOwnedResponse)
+	err := c.cc.Invoke(ctx, Query_TokenizeShareRecordsOwned_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllTokenizeShareRecords(ctx context.Context, in *QueryAllTokenizeShareRecordsRequest, opts ...grpc.CallOption) (*QueryAllTokenizeShareRecordsResponse, error) {
+	out := new(QueryAllTokenizeShareRecordsResponse)
+	err := c.cc.Invoke(ctx, Query_AllTokenizeShareRecords_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalLiquidStaked(ctx context.Context, in *QueryTotalLiquidStakedRequest, opts ...grpc.CallOption) (*QueryTotalLiquidStakedResponse, error) {
+	out := new(QueryTotalLiquidStakedResponse)
+	err := c.cc.Invoke(ctx, Query_TotalLiquidStaked_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorAutoCompoundSettings(ctx context.Context, in *QueryDelegatorAutoCompoundSettingsRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundSettingsResponse, error) {
+	out := new(QueryDelegatorAutoCompoundSettingsResponse)
+	err := c.cc.Invoke(ctx, Query_DelegatorAutoCompoundSettings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledCommissionChange(ctx context.Context, in *QueryScheduledCommissionChangeRequest, opts ...grpc.CallOption) (*QueryScheduledCommissionChangeResponse, error) {
+	out := new(QueryScheduledCommissionChangeResponse)
+	err := c.cc.Invoke(ctx, Query_ScheduledCommissionChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorPerformance(ctx context.Context, in *QueryValidatorPerformanceRequest, opts ...grpc.CallOption) (*QueryValidatorPerformanceResponse, error) {
+	out := new(QueryValidatorPerformanceResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorPerformance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnbondingQueue(ctx context.Context, in *QueryUnbondingQueueRequest, opts ...grpc.CallOption) (*QueryUnbondingQueueResponse, error) {
+	out := new(QueryUnbondingQueueResponse)
+	err := c.cc.Invoke(ctx, Query_UnbondingQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RedelegationQueue(ctx context.Context, in *QueryRedelegationQueueRequest, opts ...grpc.CallOption) (*QueryRedelegationQueueResponse, error) {
+	out := new(QueryRedelegationQueueResponse)
+	err := c.cc.Invoke(ctx, Query_RedelegationQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomBondedAmounts(ctx context.Context, in *QueryDenomBondedAmountsRequest, opts ...grpc.CallOption) (*QueryDenomBondedAmountsResponse, error) {
+	out := new(QueryDenomBondedAmountsResponse)
+	err := c.cc.Invoke(ctx, Query_DenomBondedAmounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorMultiAssetPools(ctx context.Context, in *QueryValidatorMultiAssetPoolsRequest, opts ...grpc.CallOption) (*QueryValidatorMultiAssetPoolsResponse, error) {
+	out := new(QueryValidatorMultiAssetPoolsResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorMultiAssetPools_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorMultiAssetDelegations(ctx context.Context, in *QueryDelegatorMultiAssetDelegationsRequest, opts ...grpc.CallOption) (*QueryDelegatorMultiAssetDelegationsResponse, error) {
+	out := new(QueryDelegatorMultiAssetDelegationsResponse)
+	err := c.cc.Invoke(ctx, Query_DelegatorMultiAssetDelegations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MaxValidatorsSchedule(ctx context.Context, in *QueryMaxValidatorsScheduleRequest, opts ...grpc.CallOption) (*QueryMaxValidatorsScheduleResponse, error) {
+	out := new(QueryMaxValidatorsScheduleResponse)
+	err := c.cc.Invoke(ctx, Query_MaxValidatorsSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RedelegationExemptions(ctx context.Context, in *QueryRedelegationExemptionsRequest, opts ...grpc.CallOption) (*QueryRedelegationExemptionsResponse, error) {
+	out := new(QueryRedelegationExemptionsResponse)
+	err := c.cc.Invoke(ctx, Query_RedelegationExemptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -285,6 +507,58 @@ type QueryServer interface {
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// DelegationPosition queries a delegation position by id.
+	DelegationPosition(context.Context, *QueryDelegationPositionRequest) (*QueryDelegationPositionResponse, error)
+	// OwnerDelegationPositions queries all delegation positions of a given owner.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	OwnerDelegationPositions(context.Context, *QueryOwnerDelegationPositionsRequest) (*QueryOwnerDelegationPositionsResponse, error)
+	// TokenizeShareRecordById queries a tokenize share record by id.
+	TokenizeShareRecordById(context.Context, *QueryTokenizeShareRecordByIdRequest) (*QueryTokenizeShareRecordByIdResponse, error)
+	// TokenizeShareRecordByDenom queries a tokenize share record by the denom of
+	// its share tokens.
+	TokenizeShareRecordByDenom(context.Context, *QueryTokenizeShareRecordByDenomRequest) (*QueryTokenizeShareRecordByDenomResponse, error)
+	// TokenizeShareRecordsOwned queries the tokenize share records of a given owner.
+	TokenizeShareRecordsOwned(context.Context, *QueryTokenizeShareRecordsOwnedRequest) (*QueryTokenizeShareRecordsOwnedResponse, error)
+	// AllTokenizeShareRecords queries all the tokenize share records.
+	//
+	// When called from another module, this query might consume a high amount of
+	// gas if the pagination field is incorrectly set.
+	AllTokenizeShareRecords(context.Context, *QueryAllTokenizeShareRecordsRequest) (*QueryAllTokenizeShareRecordsResponse, error)
+	// TotalLiquidStaked queries the amount of bond denom tokens tokenized into
+	// share tokens, globally and for a given validator.
+	TotalLiquidStaked(context.Context, *QueryTotalLiquidStakedRequest) (*QueryTotalLiquidStakedResponse, error)
+	// DelegatorAutoCompoundSettings queries the delegations of a delegator whose
+	// rewards are auto-compounded.
+	DelegatorAutoCompoundSettings(context.Context, *QueryDelegatorAutoCompoundSettingsRequest) (*QueryDelegatorAutoCompoundSettingsResponse, error)
+	// ScheduledCommissionChange queries the commission rate change scheduled by
+	// a validator.
+	ScheduledCommissionChange(context.Context, *QueryScheduledCommissionChangeRequest) (*QueryScheduledCommissionChangeResponse, error)
+	// ValidatorPerformance queries the signed, missed and proposed blocks of a
+	// validator over the performance window.
+	ValidatorPerformance(context.Context, *QueryValidatorPerformanceRequest) (*QueryValidatorPerformanceResponse, error)
+	// UnbondingQueue queries the unbonding delegation entries maturing within a
+	// time range, along with the amounts maturing per day.
+	UnbondingQueue(context.Context, *QueryUnbondingQueueRequest) (*QueryUnbondingQueueResponse, error)
+	// RedelegationQueue queries the redelegation entries maturing within a time
+	// range, along with the amounts maturing per day.
+	RedelegationQueue(context.Context, *QueryRedelegationQueueRequest) (*QueryRedelegationQueueResponse, error)
+	// DenomBondedAmounts queries the amounts of tokens bonded per bondable
+	// denom, including the bond denom, along with their weights.
+	DenomBondedAmounts(context.Context, *QueryDenomBondedAmountsRequest) (*QueryDenomBondedAmountsResponse, error)
+	// ValidatorMultiAssetPools queries the tokens of the bondable denoms other
+	// than the bond denom delegated to a validator.
+	ValidatorMultiAssetPools(context.Context, *QueryValidatorMultiAssetPoolsRequest) (*QueryValidatorMultiAssetPoolsResponse, error)
+	// DelegatorMultiAssetDelegations queries the delegations of the bondable
+	// denoms other than the bond denom of a delegator.
+	DelegatorMultiAssetDelegations(context.Context, *QueryDelegatorMultiAssetDelegationsRequest) (*QueryDelegatorMultiAssetDelegationsResponse, error)
+	// MaxValidatorsSchedule queries the changes of the MaxValidators param
+	// scheduled at future heights.
+	MaxValidatorsSchedule(context.Context, *QueryMaxValidatorsScheduleRequest) (*QueryMaxValidatorsScheduleResponse, error)
+	// RedelegationExemptions queries the redelegation exemptions approved by
+	// governance for validator consolidations.
+	RedelegationExemptions(context.Context, *QueryRedelegationExemptionsRequest) (*QueryRedelegationExemptionsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -334,6 +608,57 @@ func (UnimplementedQueryServer) Pool(context.Context, *QueryPoolRequest) (*Query
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) DelegationPosition(context.Context, *QueryDelegationPositionRequest) (*QueryDelegationPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationPosition not implemented")
+}
+func (UnimplementedQueryServer) OwnerDelegationPositions(context.Context, *QueryOwnerDelegationPositionsRequest) (*QueryOwnerDelegationPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OwnerDelegationPositions not implemented")
+}
+func (UnimplementedQueryServer) TokenizeShareRecordById(context.Context, *QueryTokenizeShareRecordByIdRequest) (*QueryTokenizeShareRecordByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareRecordById not implemented")
+}
+func (UnimplementedQueryServer) TokenizeShareRecordByDenom(context.Context, *QueryTokenizeShareRecordByDenomRequest) (*QueryTokenizeShareRecordByDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareRecordByDenom not implemented")
+}
+func (UnimplementedQueryServer) TokenizeShareRecordsOwned(context.Context, *QueryTokenizeShareRecordsOwnedRequest) (*QueryTokenizeShareRecordsOwnedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareRecordsOwned not implemented")
+}
+func (UnimplementedQueryServer) AllTokenizeShareRecords(context.Context, *QueryAllTokenizeShareRecordsRequest) (*QueryAllTokenizeShareRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllTokenizeShareRecords not implemented")
+}
+func (UnimplementedQueryServer) TotalLiquidStaked(context.Context, *QueryTotalLiquidStakedRequest) (*QueryTotalLiquidStakedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalLiquidStaked not implemented")
+}
+func (UnimplementedQueryServer) DelegatorAutoCompoundSettings(context.Context, *QueryDelegatorAutoCompoundSettingsRequest) (*QueryDelegatorAutoCompoundSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorAutoCompoundSettings not implemented")
+}
+func (UnimplementedQueryServer) ScheduledCommissionChange(context.Context, *QueryScheduledCommissionChangeRequest) (*QueryScheduledCommissionChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledCommissionChange not implemented")
+}
+func (UnimplementedQueryServer) ValidatorPerformance(context.Context, *QueryValidatorPerformanceRequest) (*QueryValidatorPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPerformance not implemented")
+}
+func (UnimplementedQueryServer) UnbondingQueue(context.Context, *QueryUnbondingQueueRequest) (*QueryUnbondingQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondingQueue not implemented")
+}
+func (UnimplementedQueryServer) RedelegationQueue(context.Context, *QueryRedelegationQueueRequest) (*QueryRedelegationQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedelegationQueue not implemented")
+}
+func (UnimplementedQueryServer) DenomBondedAmounts(context.Context, *QueryDenomBondedAmountsRequest) (*QueryDenomBondedAmountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomBondedAmounts not implemented")
+}
+func (UnimplementedQueryServer) ValidatorMultiAssetPools(context.Context, *QueryValidatorMultiAssetPoolsRequest) (*QueryValidatorMultiAssetPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorMultiAssetPools not implemented")
+}
+func (UnimplementedQueryServer) DelegatorMultiAssetDelegations(context.Context, *QueryDelegatorMultiAssetDelegationsRequest) (*QueryDelegatorMultiAssetDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorMultiAssetDelegations not implemented")
+}
+func (UnimplementedQueryServer) MaxValidatorsSchedule(context.Context, *QueryMaxValidatorsScheduleRequest) (*QueryMaxValidatorsScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MaxValidatorsSchedule not implemented")
+}
+func (UnimplementedQueryServer) RedelegationExemptions(context.Context, *QueryRedelegationExemptionsRequest) (*QueryRedelegationExemptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedelegationExemptions not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -599,6 +924,312 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegationPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegationPosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationPosition(ctx, req.(*QueryDelegationPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OwnerDelegationPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOwnerDelegationPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OwnerDelegationPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OwnerDelegationPositions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OwnerDelegationPositions(ctx, req.(*QueryOwnerDelegationPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenizeShareRecordById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecordById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenizeShareRecordById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecordById(ctx, req.(*QueryTokenizeShareRecordByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenizeShareRecordByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordByDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecordByDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenizeShareRecordByDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecordByDenom(ctx, req.(*QueryTokenizeShareRecordByDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenizeShareRecordsOwned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordsOwnedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecordsOwned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TokenizeShareRecordsOwned_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecordsOwned(ctx, req.(*QueryTokenizeShareRecordsOwnedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllTokenizeShareRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTokenizeShareRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllTokenizeShareRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllTokenizeShareRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllTokenizeShareRecords(ctx, req.(*QueryAllTokenizeShareRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalLiquidStaked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalLiquidStakedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalLiquidStaked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TotalLiquidStaked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalLiquidStaked(ctx, req.(*QueryTotalLiquidStakedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorAutoCompoundSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorAutoCompoundSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorAutoCompoundSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegatorAutoCompoundSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorAutoCompoundSettings(ctx, req.(*QueryDelegatorAutoCompoundSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledCommissionChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledCommissionChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledCommissionChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScheduledCommissionChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledCommissionChange(ctx, req.(*QueryScheduledCommissionChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorPerformance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorPerformance(ctx, req.(*QueryValidatorPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnbondingQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondingQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UnbondingQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondingQueue(ctx, req.(*QueryUnbondingQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RedelegationQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedelegationQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RedelegationQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RedelegationQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedelegationQueue(ctx, req.(*QueryRedelegationQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomBondedAmounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomBondedAmountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomBondedAmounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomBondedAmounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomBondedAmounts(ctx, req.(*QueryDenomBondedAmountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorMultiAssetPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorMultiAssetPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorMultiAssetPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorMultiAssetPools_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorMultiAssetPools(ctx, req.(*QueryValidatorMultiAssetPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorMultiAssetDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorMultiAssetDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorMultiAssetDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DelegatorMultiAssetDelegations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorMultiAssetDelegations(ctx, req.(*QueryDelegatorMultiAssetDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MaxValidatorsSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMaxValidatorsScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MaxValidatorsSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MaxValidatorsSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MaxValidatorsSchedule(ctx, req.(*QueryMaxValidatorsScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RedelegationExemptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedelegationExemptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RedelegationExemptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RedelegationExemptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedelegationExemptions(ctx, req.(*QueryRedelegationExemptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -662,6 +1293,74 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "DelegationPosition",
+			Handler:    _Query_DelegationPosition_Handler,
+		},
+		{
+			MethodName: "OwnerDelegationPositions",
+			Handler:    _Query_OwnerDelegationPositions_Handler,
+		},
+		{
+			MethodName: "TokenizeShareRecordById",
+			Handler:    _Query_TokenizeShareRecordById_Handler,
+		},
+		{
+			MethodName: "TokenizeShareRecordByDenom",
+			Handler:    _Query_TokenizeShareRecordByDenom_Handler,
+		},
+		{
+			MethodName: "TokenizeShareRecordsOwned",
+			Handler:    _Query_TokenizeShareRecordsOwned_Handler,
+		},
+		{
+			MethodName: "AllTokenizeShareRecords",
+			Handler:    _Query_AllTokenizeShareRecords_Handler,
+		},
+		{
+			MethodName: "TotalLiquidStaked",
+			Handler:    _Query_TotalLiquidStaked_Handler,
+		},
+		{
+			MethodName: "DelegatorAutoCompoundSettings",
+			Handler:    _Query_DelegatorAutoCompoundSettings_Handler,
+		},
+		{
+			MethodName: "ScheduledCommissionChange",
+			Handler:    _Query_ScheduledCommissionChange_Handler,
+		},
+		{
+			MethodName: "ValidatorPerformance",
+			Handler:    _Query_ValidatorPerformance_Handler,
+		},
+		{
+			MethodName: "UnbondingQueue",
+			Handler:    _Query_UnbondingQueue_Handler,
+		},
+		{
+			MethodName: "RedelegationQueue",
+			Handler:    _Query_RedelegationQueue_Handler,
+		},
+		{
+			MethodName: "DenomBondedAmounts",
+			Handler:    _Query_DenomBondedAmounts_Handler,
+		},
+		{
+			MethodName: "ValidatorMultiAssetPools",
+			Handler:    _Query_ValidatorMultiAssetPools_Handler,
+		},
+		{
+			MethodName: "DelegatorMultiAssetDelegations",
+			Handler:    _Query_DelegatorMultiAssetDelegations_Handler,
+		},
+		{
+			MethodName: "MaxValidatorsSchedule",
+			Handler:    _Query_MaxValidatorsSchedule_Handler,
+		},
+		{
+			MethodName: "RedelegationExemptions",
+			Handler:    _Query_RedelegationExemptions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/query.proto",
@@ -19,14 +19,32 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_CreateValidator_FullMethodName           = "/cosmos.staking.v1beta1.Msg/CreateValidator"
-	Msg_EditValidator_FullMethodName             = "/cosmos.staking.v1beta1.Msg/EditValidator"
-	Msg_Delegate_FullMethodName                  = "/cosmos.staking.v1beta1.Msg/Delegate"
-	Msg_BeginRedelegate_FullMethodName           = "/cosmos.staking.v1beta1.Msg/BeginRedelegate"
-	Msg_Undelegate_FullMethodName                = "/cosmos.staking.v1beta1.Msg/Undelegate"
-	Msg_CancelUnbondingDelegation_FullMethodName = "/cosmos.staking.v1beta1.Msg/CancelUnbondingDelegation"
-	Msg_UpdateParams_FullMethodName              = "/cosmos.staking.v1beta1.Msg/UpdateParams"
-	Msg_RotateConsPubKey_FullMethodName          = "/cosmos.staking.v1beta1.Msg/RotateConsPubKey"
+	Msg_CreateValidator_FullMethodName              = "/cosmos.staking.v1beta1.Msg/CreateValidator"
+	Msg_EditValidator_FullMethodName                = "/cosmos.staking.v1beta1.Msg/EditValidator"
+	Msg_Delegate_FullMethodName                     = "/cosmos.staking.v1beta1.Msg/Delegate"
+	Msg_BeginRedelegate_FullMethodName              = "/cosmos.staking.v1beta1.Msg/BeginRedelegate"
+	Msg_Undelegate_FullMethodName                   = "/cosmos.staking.v1beta1.Msg/Undelegate"
+	Msg_CancelUnbondingDelegation_FullMethodName    = "/cosmos.staking.v1beta1.Msg/CancelUnbondingDelegation"
+	Msg_UpdateParams_FullMethodName                 = "/cosmos.staking.v1beta1.Msg/UpdateParams"
+	Msg_RotateConsPubKey_FullMethodName             = "/cosmos.staking.v1beta1.Msg/RotateConsPubKey"
+	Msg_TokenizeDelegation_FullMethodName           = "/cosmos.staking.v1beta1.Msg/TokenizeDelegation"
+	Msg_TransferDelegationPosition_FullMethodName   = "/cosmos.staking.v1beta1.Msg/TransferDelegationPosition"
+	Msg_RedeemDelegationPosition_FullMethodName     = "/cosmos.staking.v1beta1.Msg/RedeemDelegationPosition"
+	Msg_TokenizeShares_FullMethodName               = "/cosmos.staking.v1beta1.Msg/TokenizeShares"
+	Msg_RedeemTokensForShares_FullMethodName        = "/cosmos.staking.v1beta1.Msg/RedeemTokensForShares"
+	Msg_TransferTokenizeShareRecord_FullMethodName  = "/cosmos.staking.v1beta1.Msg/TransferTokenizeShareRecord"
+	Msg_SetAutoCompound_FullMethodName              = "/cosmos.staking.v1beta1.Msg/SetAutoCompound"
+	Msg_ScheduleCommissionChange_FullMethodName     = "/cosmos.staking.v1beta1.Msg/ScheduleCommissionChange"
+	Msg_CancelCommissionChange_FullMethodName       = "/cosmos.staking.v1beta1.Msg/CancelCommissionChange"
+	Msg_FlashUndelegate_FullMethodName              = "/cosmos.staking.v1beta1.Msg/FlashUndelegate"
+	Msg_ExecStakingOperations_FullMethodName        = "/cosmos.staking.v1beta1.Msg/ExecStakingOperations"
+	Msg_SetBondableDenom_FullMethodName             = "/cosmos.staking.v1beta1.Msg/SetBondableDenom"
+	Msg_DelegateMultiAsset_FullMethodName           = "/cosmos.staking.v1beta1.Msg/DelegateMultiAsset"
+	Msg_UndelegateMultiAsset_FullMethodName         = "/cosmos.staking.v1beta1.Msg/UndelegateMultiAsset"
+	Msg_ScheduleMaxValidators_FullMethodName        = "/cosmos.staking.v1beta1.Msg/ScheduleMaxValidators"
+	Msg_ApproveRedelegationExemption_FullMethodName = "/cosmos.staking.v1beta1.Msg/ApproveRedelegationExemption"
+	Msg_ForceUndelegate_FullMethodName              = "/cosmos.staking.v1beta1.Msg/ForceUndelegate"
+	Msg_UnfreezeDelegation_FullMethodName           = "/cosmos.staking.v1beta1.Msg/UnfreezeDelegation"
 )
 
 // MsgClient is the client API for Msg service.
@@ -59,6 +77,62 @@ type MsgClient interface {
 	// of a validator.
 	// Since: cosmos-sdk 0.51
 	RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKey, opts ...grpc.CallOption) (*MsgRotateConsPubKeyResponse, error)
+	// TokenizeDelegation defines an operation for tokenizing delegation shares
+	// into a transferable delegation position.
+	TokenizeDelegation(ctx context.Context, in *MsgTokenizeDelegation, opts ...grpc.CallOption) (*MsgTokenizeDelegationResponse, error)
+	// TransferDelegationPosition defines an operation for transferring a
+	// delegation position, and its delegation shares, to a new owner.
+	TransferDelegationPosition(ctx context.Context, in *MsgTransferDelegationPosition, opts ...grpc.CallOption) (*MsgTransferDelegationPositionResponse, error)
+	// RedeemDelegationPosition defines an operation for redeeming a delegation
+	// position, unlocking its delegation shares.
+	RedeemDelegationPosition(ctx context.Context, in *MsgRedeemDelegationPosition, opts ...grpc.CallOption) (*MsgRedeemDelegationPositionResponse, error)
+	// TokenizeShares defines an operation for tokenizing delegation shares into
+	// fungible share tokens.
+	TokenizeShares(ctx context.Context, in *MsgTokenizeShares, opts ...grpc.CallOption) (*MsgTokenizeSharesResponse, error)
+	// RedeemTokensForShares defines an operation for redeeming share tokens for
+	// the delegation shares they represent.
+	RedeemTokensForShares(ctx context.Context, in *MsgRedeemTokensForShares, opts ...grpc.CallOption) (*MsgRedeemTokensForSharesResponse, error)
+	// TransferTokenizeShareRecord defines an operation for transferring a
+	// tokenize share record, and the rewards of its shares, to a new owner.
+	TransferTokenizeShareRecord(ctx context.Context, in *MsgTransferTokenizeShareRecord, opts ...grpc.CallOption) (*MsgTransferTokenizeShareRecordResponse, error)
+	// SetAutoCompound defines a method for a delegator to opt into, or out of,
+	// the automatic re-delegation of the rewards of a delegation.
+	SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error)
+	// ScheduleCommissionChange defines a method for a validator to schedule a
+	// commission rate change, applied after the commission change notice period.
+	ScheduleCommissionChange(ctx context.Context, in *MsgScheduleCommissionChange, opts ...grpc.CallOption) (*MsgScheduleCommissionChangeResponse, error)
+	// CancelCommissionChange defines a method for a validator to cancel its
+	// scheduled commission rate change.
+	CancelCommissionChange(ctx context.Context, in *MsgCancelCommissionChange, opts ...grpc.CallOption) (*MsgCancelCommissionChangeResponse, error)
+	// FlashUndelegate defines a method for undelegating tokens without waiting
+	// for the unbonding period, in exchange for a penalty.
+	FlashUndelegate(ctx context.Context, in *MsgFlashUndelegate, opts ...grpc.CallOption) (*MsgFlashUndelegateResponse, error)
+	// ExecStakingOperations defines a method for executing a batch of
+	// delegations, redelegations and restakings of rewards of a delegator,
+	// typically on its behalf with a DelegateOnBehalfAuthorization.
+	ExecStakingOperations(ctx context.Context, in *MsgExecStakingOperations, opts ...grpc.CallOption) (*MsgExecStakingOperationsResponse, error)
+	// SetBondableDenom defines a governance operation for registering, updating
+	// or removing a bondable denom other than the bond denom.
+	SetBondableDenom(ctx context.Context, in *MsgSetBondableDenom, opts ...grpc.CallOption) (*MsgSetBondableDenomResponse, error)
+	// DelegateMultiAsset defines a method for delegating tokens of a bondable
+	// denom other than the bond denom to a validator.
+	DelegateMultiAsset(ctx context.Context, in *MsgDelegateMultiAsset, opts ...grpc.CallOption) (*MsgDelegateMultiAssetResponse, error)
+	// UndelegateMultiAsset defines a method for undelegating tokens of a
+	// bondable denom other than the bond denom from a validator.
+	UndelegateMultiAsset(ctx context.Context, in *MsgUndelegateMultiAsset, opts ...grpc.CallOption) (*MsgUndelegateMultiAssetResponse, error)
+	// ScheduleMaxValidators defines a governance operation for scheduling a ramp
+	// of changes of the MaxValidators param at future heights.
+	ScheduleMaxValidators(ctx context.Context, in *MsgScheduleMaxValidators, opts ...grpc.CallOption) (*MsgScheduleMaxValidatorsResponse, error)
+	// ApproveRedelegationExemption defines a governance operation for approving a
+	// validator consolidation, during which the delegators of the source
+	// validator can redelegate from it without the redelegation restrictions.
+	ApproveRedelegationExemption(ctx context.Context, in *MsgApproveRedelegationExemption, opts ...grpc.CallOption) (*MsgApproveRedelegationExemptionResponse, error)
+	// ForceUndelegate defines an operation of the forced undelegation authority
+	// for forcibly undelegating a delegation, and optionally freezing it.
+	ForceUndelegate(ctx context.Context, in *MsgForceUndelegate, opts ...grpc.CallOption) (*MsgForceUndelegateResponse, error)
+	// UnfreezeDelegation defines an operation of the forced undelegation
+	// authority for unfreezing a delegation frozen by a forced undelegation.
+	UnfreezeDelegation(ctx context.Context, in *MsgUnfreezeDelegation, opts ...grpc.CallOption) (*MsgUnfreezeDelegationResponse, error)
 }
 
 type msgClient struct {
@@ -141,6 +215,168 @@ func (c *msgClient) RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKe
 	return out, nil
 }
 
+func (c *msgClient) TokenizeDelegation(ctx context.Context, in *MsgTokenizeDelegation, opts ...grpc.CallOption) (*MsgTokenizeDelegationResponse, error) {
+	out := new(MsgTokenizeDelegationResponse)
+	err := c.cc.Invoke(ctx, Msg_TokenizeDelegation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferDelegationPosition(ctx context.Context, in *MsgTransferDelegationPosition, opts ...grpc.CallOption) (*MsgTransferDelegationPositionResponse, error) {
+	out := new(MsgTransferDelegationPositionResponse)
+	err := c.cc.Invoke(ctx, Msg_TransferDelegationPosition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RedeemDelegationPosition(ctx context.Context, in *MsgRedeemDelegationPosition, opts ...grpc.CallOption) (*MsgRedeemDelegationPositionResponse, error) {
+	out := new(MsgRedeemDelegationPositionResponse)
+	err := c.cc.Invoke(ctx, Msg_RedeemDelegationPosition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TokenizeShares(ctx context.Context, in *MsgTokenizeShares, opts ...grpc.CallOption) (*MsgTokenizeSharesResponse, error) {
+	out := new(MsgTokenizeSharesResponse)
+	err := c.cc.Invoke(ctx, Msg_TokenizeShares_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RedeemTokensForShares(ctx context.Context, in *MsgRedeemTokensForShares, opts ...grpc.CallOption) (*MsgRedeemTokensForSharesResponse, error) {
+	out := new(MsgRedeemTokensForSharesResponse)
+	err := c.cc.Invoke(ctx, Msg_RedeemTokensForShares_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferTokenizeShareRecord(ctx context.Context, in *MsgTransferTokenizeShareRecord, opts ...grpc.CallOption) (*MsgTransferTokenizeShareRecordResponse, error) {
+	out := new(MsgTransferTokenizeShareRecordResponse)
+	err := c.cc.Invoke(ctx, Msg_TransferTokenizeShareRecord_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error) {
+	out := new(MsgSetAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, Msg_SetAutoCompound_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleCommissionChange(ctx context.Context, in *MsgScheduleCommissionChange, opts ...grpc.CallOption) (*MsgScheduleCommissionChangeResponse, error) {
+	out := new(MsgScheduleCommissionChangeResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleCommissionChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelCommissionChange(ctx context.Context, in *MsgCancelCommissionChange, opts ...grpc.CallOption) (*MsgCancelCommissionChangeResponse, error) {
+	out := new(MsgCancelCommissionChangeResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelCommissionChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FlashUndelegate(ctx context.Context, in *MsgFlashUndelegate, opts ...grpc.CallOption) (*MsgFlashUndelegateResponse, error) {
+	out := new(MsgFlashUndelegateResponse)
+	err := c.cc.Invoke(ctx, Msg_FlashUndelegate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExecStakingOperations(ctx context.Context, in *MsgExecStakingOperations, opts ...grpc.CallOption) (*MsgExecStakingOperationsResponse, error) {
+	out := new(MsgExecStakingOperationsResponse)
+	err := c.cc.Invoke(ctx, Msg_ExecStakingOperations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetBondableDenom(ctx context.Context, in *MsgSetBondableDenom, opts ...grpc.CallOption) (*MsgSetBondableDenomResponse, error) {
+	out := new(MsgSetBondableDenomResponse)
+	err := c.cc.Invoke(ctx, Msg_SetBondableDenom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DelegateMultiAsset(ctx context.Context, in *MsgDelegateMultiAsset, opts ...grpc.CallOption) (*MsgDelegateMultiAssetResponse, error) {
+	out := new(MsgDelegateMultiAssetResponse)
+	err := c.cc.Invoke(ctx, Msg_DelegateMultiAsset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateMultiAsset(ctx context.Context, in *MsgUndelegateMultiAsset, opts ...grpc.CallOption) (*MsgUndelegateMultiAssetResponse, error) {
+	out := new(MsgUndelegateMultiAssetResponse)
+	err := c.cc.Invoke(ctx, Msg_UndelegateMultiAsset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleMaxValidators(ctx context.Context, in *MsgScheduleMaxValidators, opts ...grpc.CallOption) (*MsgScheduleMaxValidatorsResponse, error) {
+	out := new(MsgScheduleMaxValidatorsResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleMaxValidators_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveRedelegationExemption(ctx context.Context, in *MsgApproveRedelegationExemption, opts ...grpc.CallOption) (*MsgApproveRedelegationExemptionResponse, error) {
+	out := new(MsgApproveRedelegationExemptionResponse)
+	err := c.cc.Invoke(ctx, Msg_ApproveRedelegationExemption_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ForceUndelegate(ctx context.Context, in *MsgForceUndelegate, opts ...grpc.CallOption) (*MsgForceUndelegateResponse, error) {
+	out := new(MsgForceUndelegateResponse)
+	err := c.cc.Invoke(ctx, Msg_ForceUndelegate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnfreezeDelegation(ctx context.Context, in *MsgUnfreezeDelegation, opts ...grpc.CallOption) (*MsgUnfreezeDelegationResponse, error) {
+	out := new(MsgUnfreezeDelegationResponse)
+	err := c.cc.Invoke(ctx, Msg_UnfreezeDelegation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -171,6 +407,62 @@ type MsgServer interface {
 	// of a validator.
 	// Since: cosmos-sdk 0.51
 	RotateConsPubKey(context.Context, *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error)
+	// TokenizeDelegation defines an operation for tokenizing delegation shares
+	// into a transferable delegation position.
+	TokenizeDelegation(context.Context, *MsgTokenizeDelegation) (*MsgTokenizeDelegationResponse, error)
+	// TransferDelegationPosition defines an operation for transferring a
+	// delegation position, and its delegation shares, to a new owner.
+	TransferDelegationPosition(context.Context, *MsgTransferDelegationPosition) (*MsgTransferDelegationPositionResponse, error)
+	// RedeemDelegationPosition defines an operation for redeeming a delegation
+	// position, unlocking its delegation shares.
+	RedeemDelegationPosition(context.Context, *MsgRedeemDelegationPosition) (*MsgRedeemDelegationPositionResponse, error)
+	// TokenizeShares defines an operation for tokenizing delegation shares into
+	// fungible share tokens.
+	TokenizeShares(context.Context, *MsgTokenizeShares) (*MsgTokenizeSharesResponse, error)
+	// RedeemTokensForShares defines an operation for redeeming share tokens for
+	// the delegation shares they represent.
+	RedeemTokensForShares(context.Context, *MsgRedeemTokensForShares) (*MsgRedeemTokensForSharesResponse, error)
+	// TransferTokenizeShareRecord defines an operation for transferring a
+	// tokenize share record, and the rewards of its shares, to a new owner.
+	TransferTokenizeShareRecord(context.Context, *MsgTransferTokenizeShareRecord) (*MsgTransferTokenizeShareRecordResponse, error)
+	// SetAutoCompound defines a method for a delegator to opt into, or out of,
+	// the automatic re-delegation of the rewards of a delegation.
+	SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error)
+	// ScheduleCommissionChange defines a method for a validator to schedule a
+	// commission rate change, applied after the commission change notice period.
+	ScheduleCommissionChange(context.Context, *MsgScheduleCommissionChange) (*MsgScheduleCommissionChangeResponse, error)
+	// CancelCommissionChange defines a method for a validator to cancel its
+	// scheduled commission rate change.
+	CancelCommissionChange(context.Context, *MsgCancelCommissionChange) (*MsgCancelCommissionChangeResponse, error)
+	// FlashUndelegate defines a method for undelegating tokens without waiting
+	// for the unbonding period, in exchange for a penalty.
+	FlashUndelegate(context.Context, *MsgFlashUndelegate) (*MsgFlashUndelegateResponse, error)
+	// ExecStakingOperations defines a method for executing a batch of
+	// delegations, redelegations and restakings of rewards of a delegator,
+	// typically on its behalf with a DelegateOnBehalfAuthorization.
+	ExecStakingOperations(context.Context, *MsgExecStakingOperations) (*MsgExecStakingOperationsResponse, error)
+	// SetBondableDenom defines a governance operation for registering, updating
+	// or removing a bondable denom other than the bond denom.
+	SetBondableDenom(context.Context, *MsgSetBondableDenom) (*MsgSetBondableDenomResponse, error)
+	// DelegateMultiAsset defines a method for delegating tokens of a bondable
+	// denom other than the bond denom to a validator.
+	DelegateMultiAsset(context.Context, *MsgDelegateMultiAsset) (*MsgDelegateMultiAssetResponse, error)
+	// UndelegateMultiAsset defines a method for undelegating tokens of a
+	// bondable denom other than the bond denom from a validator.
+	UndelegateMultiAsset(context.Context, *MsgUndelegateMultiAsset) (*MsgUndelegateMultiAssetResponse, error)
+	// ScheduleMaxValidators defines a governance operation for scheduling a ramp
+	// of changes of the MaxValidators param at future heights.
+	ScheduleMaxValidators(context.Context, *MsgScheduleMaxValidators) (*MsgScheduleMaxValidatorsResponse, error)
+	// ApproveRedelegationExemption defines a governance operation for approving a
+	// validator consolidation, during which the delegators of the source
+	// validator can redelegate from it without the redelegation restrictions.
+	ApproveRedelegationExemption(context.Context, *MsgApproveRedelegationExemption) (*MsgApproveRedelegationExemptionResponse, error)
+	// ForceUndelegate defines an operation of the forced undelegation authority
+	// for forcibly undelegating a delegation, and optionally freezing it.
+	ForceUndelegate(context.Context, *MsgForceUndelegate) (*MsgForceUndelegateResponse, error)
+	// UnfreezeDelegation defines an operation of the forced undelegation
+	// authority for unfreezing a delegation frozen by a forced undelegation.
+	UnfreezeDelegation(context.Context, *MsgUnfreezeDelegation) (*MsgUnfreezeDelegationResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -202,6 +494,60 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) RotateConsPubKey(context.Context, *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RotateConsPubKey not implemented")
 }
+func (UnimplementedMsgServer) TokenizeDelegation(context.Context, *MsgTokenizeDelegation) (*MsgTokenizeDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeDelegation not implemented")
+}
+func (UnimplementedMsgServer) TransferDelegationPosition(context.Context, *MsgTransferDelegationPosition) (*MsgTransferDelegationPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferDelegationPosition not implemented")
+}
+func (UnimplementedMsgServer) RedeemDelegationPosition(context.Context, *MsgRedeemDelegationPosition) (*MsgRedeemDelegationPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemDelegationPosition not implemented")
+}
+func (UnimplementedMsgServer) TokenizeShares(context.Context, *MsgTokenizeShares) (*MsgTokenizeSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShares not implemented")
+}
+func (UnimplementedMsgServer) RedeemTokensForShares(context.Context, *MsgRedeemTokensForShares) (*MsgRedeemTokensForSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemTokensForShares not implemented")
+}
+func (UnimplementedMsgServer) TransferTokenizeShareRecord(context.Context, *MsgTransferTokenizeShareRecord) (*MsgTransferTokenizeShareRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferTokenizeShareRecord not implemented")
+}
+func (UnimplementedMsgServer) SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoCompound not implemented")
+}
+func (UnimplementedMsgServer) ScheduleCommissionChange(context.Context, *MsgScheduleCommissionChange) (*MsgScheduleCommissionChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleCommissionChange not implemented")
+}
+func (UnimplementedMsgServer) CancelCommissionChange(context.Context, *MsgCancelCommissionChange) (*MsgCancelCommissionChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCommissionChange not implemented")
+}
+func (UnimplementedMsgServer) FlashUndelegate(context.Context, *MsgFlashUndelegate) (*MsgFlashUndelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlashUndelegate not implemented")
+}
+func (UnimplementedMsgServer) ExecStakingOperations(context.Context, *MsgExecStakingOperations) (*MsgExecStakingOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecStakingOperations not implemented")
+}
+func (UnimplementedMsgServer) SetBondableDenom(context.Context, *MsgSetBondableDenom) (*MsgSetBondableDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBondableDenom not implemented")
+}
+func (UnimplementedMsgServer) DelegateMultiAsset(context.Context, *MsgDelegateMultiAsset) (*MsgDelegateMultiAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateMultiAsset not implemented")
+}
+func (UnimplementedMsgServer) UndelegateMultiAsset(context.Context, *MsgUndelegateMultiAsset) (*MsgUndelegateMultiAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateMultiAsset not implemented")
+}
+func (UnimplementedMsgServer) ScheduleMaxValidators(context.Context, *MsgScheduleMaxValidators) (*MsgScheduleMaxValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMaxValidators not implemented")
+}
+func (UnimplementedMsgServer) ApproveRedelegationExemption(context.Context, *MsgApproveRedelegationExemption) (*MsgApproveRedelegationExemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveRedelegationExemption not implemented")
+}
+func (UnimplementedMsgServer) ForceUndelegate(context.Context, *MsgForceUndelegate) (*MsgForceUndelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceUndelegate not implemented")
+}
+func (UnimplementedMsgServer) UnfreezeDelegation(context.Context, *MsgUnfreezeDelegation) (*MsgUnfreezeDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeDelegation not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -359,6 +705,330 @@ func _Msg_RotateConsPubKey_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TokenizeDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenizeDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TokenizeDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TokenizeDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TokenizeDelegation(ctx, req.(*MsgTokenizeDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferDelegationPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferDelegationPosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferDelegationPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TransferDelegationPosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferDelegationPosition(ctx, req.(*MsgTransferDelegationPosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RedeemDelegationPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeemDelegationPosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedeemDelegationPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RedeemDelegationPosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedeemDelegationPosition(ctx, req.(*MsgRedeemDelegationPosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TokenizeShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTokenizeShares)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TokenizeShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TokenizeShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TokenizeShares(ctx, req.(*MsgTokenizeShares))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RedeemTokensForShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeemTokensForShares)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedeemTokensForShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RedeemTokensForShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedeemTokensForShares(ctx, req.(*MsgRedeemTokensForShares))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferTokenizeShareRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferTokenizeShareRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferTokenizeShareRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TransferTokenizeShareRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferTokenizeShareRecord(ctx, req.(*MsgTransferTokenizeShareRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetAutoCompound_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoCompound(ctx, req.(*MsgSetAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleCommissionChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleCommissionChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleCommissionChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleCommissionChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleCommissionChange(ctx, req.(*MsgScheduleCommissionChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelCommissionChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelCommissionChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelCommissionChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelCommissionChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelCommissionChange(ctx, req.(*MsgCancelCommissionChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FlashUndelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFlashUndelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FlashUndelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FlashUndelegate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FlashUndelegate(ctx, req.(*MsgFlashUndelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExecStakingOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecStakingOperations)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExecStakingOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ExecStakingOperations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecStakingOperations(ctx, req.(*MsgExecStakingOperations))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetBondableDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBondableDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBondableDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetBondableDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBondableDenom(ctx, req.(*MsgSetBondableDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelegateMultiAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateMultiAsset)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateMultiAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DelegateMultiAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateMultiAsset(ctx, req.(*MsgDelegateMultiAsset))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateMultiAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateMultiAsset)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateMultiAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UndelegateMultiAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateMultiAsset(ctx, req.(*MsgUndelegateMultiAsset))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleMaxValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleMaxValidators)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleMaxValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleMaxValidators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleMaxValidators(ctx, req.(*MsgScheduleMaxValidators))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveRedelegationExemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveRedelegationExemption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveRedelegationExemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ApproveRedelegationExemption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveRedelegationExemption(ctx, req.(*MsgApproveRedelegationExemption))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ForceUndelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForceUndelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForceUndelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ForceUndelegate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForceUndelegate(ctx, req.(*MsgForceUndelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnfreezeDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnfreezeDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnfreezeDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnfreezeDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnfreezeDelegation(ctx, req.(*MsgUnfreezeDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -398,6 +1068,78 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RotateConsPubKey",
 			Handler:    _Msg_RotateConsPubKey_Handler,
 		},
+		{
+			MethodName: "TokenizeDelegation",
+			Handler:    _Msg_TokenizeDelegation_Handler,
+		},
+		{
+			MethodName: "TransferDelegationPosition",
+			Handler:    _Msg_TransferDelegationPosition_Handler,
+		},
+		{
+			MethodName: "RedeemDelegationPosition",
+			Handler:    _Msg_RedeemDelegationPosition_Handler,
+		},
+		{
+			MethodName: "TokenizeShares",
+			Handler:    _Msg_TokenizeShares_Handler,
+		},
+		{
+			MethodName: "RedeemTokensForShares",
+			Handler:    _Msg_RedeemTokensForShares_Handler,
+		},
+		{
+			MethodName: "TransferTokenizeShareRecord",
+			Handler:    _Msg_TransferTokenizeShareRecord_Handler,
+		},
+		{
+			MethodName: "SetAutoCompound",
+			Handler:    _Msg_SetAutoCompound_Handler,
+		},
+		{
+			MethodName: "ScheduleCommissionChange",
+			Handler:    _Msg_ScheduleCommissionChange_Handler,
+		},
+		{
+			MethodName: "CancelCommissionChange",
+			Handler:    _Msg_CancelCommissionChange_Handler,
+		},
+		{
+			MethodName: "FlashUndelegate",
+			Handler:    _Msg_FlashUndelegate_Handler,
+		},
+		{
+			MethodName: "ExecStakingOperations",
+			Handler:    _Msg_ExecStakingOperations_Handler,
+		},
+		{
+			MethodName: "SetBondableDenom",
+			Handler:    _Msg_SetBondableDenom_Handler,
+		},
+		{
+			MethodName: "DelegateMultiAsset",
+			Handler:    _Msg_DelegateMultiAsset_Handler,
+		},
+		{
+			MethodName: "UndelegateMultiAsset",
+			Handler:    _Msg_UndelegateMultiAsset_Handler,
+		},
+		{
+			MethodName: "ScheduleMaxValidators",
+			Handler:    _Msg_ScheduleMaxValidators_Handler,
+		},
+		{
+			MethodName: "ApproveRedelegationExemption",
+			Handler:    _Msg_ApproveRedelegationExemption_Handler,
+		},
+		{
+			MethodName: "ForceUndelegate",
+			Handler:    _Msg_ForceUndelegate_Handler,
+		},
+		{
+			MethodName: "UnfreezeDelegation",
+			Handler:    _Msg_UnfreezeDelegation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/tx.proto",
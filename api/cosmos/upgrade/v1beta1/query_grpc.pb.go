@@ -24,6 +24,7 @@ const (
 	Query_UpgradedConsensusState_FullMethodName = "/cosmos.upgrade.v1beta1.Query/UpgradedConsensusState"
 	Query_ModuleVersions_FullMethodName         = "/cosmos.upgrade.v1beta1.Query/ModuleVersions"
 	Query_Authority_FullMethodName              = "/cosmos.upgrade.v1beta1.Query/Authority"
+	Query_SkipUpgrade_FullMethodName            = "/cosmos.upgrade.v1beta1.Query/SkipUpgrade"
 )
 
 // QueryClient is the client API for Query service.
@@ -50,6 +51,9 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	Authority(ctx context.Context, in *QueryAuthorityRequest, opts ...grpc.CallOption) (*QueryAuthorityResponse, error)
+	// SkipUpgrade queries the validator signals and the on-chain skip record
+	// for the upgrade scheduled at the given height.
+	SkipUpgrade(ctx context.Context, in *QuerySkipUpgradeRequest, opts ...grpc.CallOption) (*QuerySkipUpgradeResponse, error)
 }
 
 type queryClient struct {
@@ -106,6 +110,15 @@ func (c *queryClient) Authority(ctx context.Context, in *QueryAuthorityRequest,
 	return out, nil
 }
 
+func (c *queryClient) SkipUpgrade(ctx context.Context, in *QuerySkipUpgradeRequest, opts ...grpc.CallOption) (*QuerySkipUpgradeResponse, error) {
+	out := new(QuerySkipUpgradeResponse)
+	err := c.cc.Invoke(ctx, Query_SkipUpgrade_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -130,6 +143,9 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error)
+	// SkipUpgrade queries the validator signals and the on-chain skip record
+	// for the upgrade scheduled at the given height.
+	SkipUpgrade(context.Context, *QuerySkipUpgradeRequest) (*QuerySkipUpgradeResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -152,6 +168,9 @@ func (UnimplementedQueryServer) ModuleVersions(context.Context, *QueryModuleVers
 func (UnimplementedQueryServer) Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authority not implemented")
 }
+func (UnimplementedQueryServer) SkipUpgrade(context.Context, *QuerySkipUpgradeRequest) (*QuerySkipUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SkipUpgrade not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -255,6 +274,24 @@ func _Query_Authority_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SkipUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySkipUpgradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SkipUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SkipUpgrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SkipUpgrade(ctx, req.(*QuerySkipUpgradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -282,6 +319,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Authority",
 			Handler:    _Query_Authority_Handler,
 		},
+		{
+			MethodName: "SkipUpgrade",
+			Handler:    _Query_SkipUpgrade_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
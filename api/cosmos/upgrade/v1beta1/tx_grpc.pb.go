@@ -21,8 +21,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_SoftwareUpgrade_FullMethodName = "/cosmos.upgrade.v1beta1.Msg/SoftwareUpgrade"
-	Msg_CancelUpgrade_FullMethodName   = "/cosmos.upgrade.v1beta1.Msg/CancelUpgrade"
+	Msg_SoftwareUpgrade_FullMethodName   = "/cosmos.upgrade.v1beta1.Msg/SoftwareUpgrade"
+	Msg_CancelUpgrade_FullMethodName     = "/cosmos.upgrade.v1beta1.Msg/CancelUpgrade"
+	Msg_SignalSkipUpgrade_FullMethodName = "/cosmos.upgrade.v1beta1.Msg/SignalSkipUpgrade"
 )
 
 // MsgClient is the client API for Msg service.
@@ -38,6 +39,11 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opts ...grpc.CallOption) (*MsgCancelUpgradeResponse, error)
+	// SignalSkipUpgrade defines an operation for a bonded validator to signal
+	// that the currently scheduled upgrade should be skipped. Once validators
+	// holding more than 2/3 of the last bonded power have signalled, the
+	// upgrade is recorded on-chain as skipped.
+	SignalSkipUpgrade(ctx context.Context, in *MsgSignalSkipUpgrade, opts ...grpc.CallOption) (*MsgSignalSkipUpgradeResponse, error)
 }
 
 type msgClient struct {
@@ -66,6 +72,15 @@ func (c *msgClient) CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opt
 	return out, nil
 }
 
+func (c *msgClient) SignalSkipUpgrade(ctx context.Context, in *MsgSignalSkipUpgrade, opts ...grpc.CallOption) (*MsgSignalSkipUpgradeResponse, error) {
+	out := new(MsgSignalSkipUpgradeResponse)
+	err := c.cc.Invoke(ctx, Msg_SignalSkipUpgrade_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -79,6 +94,11 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CancelUpgrade(context.Context, *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error)
+	// SignalSkipUpgrade defines an operation for a bonded validator to signal
+	// that the currently scheduled upgrade should be skipped. Once validators
+	// holding more than 2/3 of the last bonded power have signalled, the
+	// upgrade is recorded on-chain as skipped.
+	SignalSkipUpgrade(context.Context, *MsgSignalSkipUpgrade) (*MsgSignalSkipUpgradeResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -92,6 +112,9 @@ func (UnimplementedMsgServer) SoftwareUpgrade(context.Context, *MsgSoftwareUpgra
 func (UnimplementedMsgServer) CancelUpgrade(context.Context, *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelUpgrade not implemented")
 }
+func (UnimplementedMsgServer) SignalSkipUpgrade(context.Context, *MsgSignalSkipUpgrade) (*MsgSignalSkipUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalSkipUpgrade not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -141,6 +164,24 @@ func _Msg_CancelUpgrade_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SignalSkipUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSignalSkipUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SignalSkipUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SignalSkipUpgrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SignalSkipUpgrade(ctx, req.(*MsgSignalSkipUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -156,6 +197,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelUpgrade",
 			Handler:    _Msg_CancelUpgrade_Handler,
 		},
+		{
+			MethodName: "SignalSkipUpgrade",
+			Handler:    _Msg_SignalSkipUpgrade_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/tx.proto",
@@ -346,6 +346,14 @@ func (app *BaseApp) ApplySnapshotChunk(req *abci.RequestApplySnapshotChunk) (*ab
 // will contain relevant error information. Regardless of tx execution outcome,
 // the ResponseCheckTx will contain relevant gas execution context.
 func (app *BaseApp) CheckTx(req *abci.RequestCheckTx) (*abci.ResponseCheckTx, error) {
+	return app.CheckTxWithMetadata(req, nil)
+}
+
+// CheckTxWithMetadata implements CheckTx, passing the metadata about the origin
+// of the transaction to the CheckTx filters. It allows apps ingesting
+// transactions through their own transport to filter them, e.g., per client IP.
+// The filters only apply to new transactions, not to the rechecked ones.
+func (app *BaseApp) CheckTxWithMetadata(req *abci.RequestCheckTx, md CheckTxMetadata) (*abci.ResponseCheckTx, error) {
 	var mode execMode
 
 	switch {
@@ -359,6 +367,13 @@ func (app *BaseApp) CheckTx(req *abci.RequestCheckTx) (*abci.ResponseCheckTx, er
 		return nil, fmt.Errorf("unknown RequestCheckTx type: %s", req.Type)
 	}
 
+	if mode == execModeCheck {
+		if err := app.filterCheckTx(req.Tx, md); err != nil {
+			telemetry.IncrCounter(1, "tx", "check_tx_filtered")
+			return sdkerrors.ResponseCheckTxWithEvents(err, 0, 0, nil, app.trace), nil
+		}
+	}
+
 	gInfo, result, anteEvents, err := app.runTx(mode, req.Tx)
 	if err != nil {
 		return sdkerrors.ResponseCheckTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, anteEvents, app.trace), nil
@@ -525,6 +540,14 @@ func (app *BaseApp) ProcessProposal(req *abci.RequestProcessProposal) (resp *abc
 		WithConsensusParams(app.GetConsensusParams(app.processProposalState.Context())).
 		WithBlockGasMeter(app.getBlockGasMeter(app.processProposalState.Context())))
 
+	// registered before the panic recovery so that rejections caused by a panic
+	// are observed as well
+	defer func() {
+		if resp != nil {
+			app.blockHealth.ObserveProposal(req.Height, proposalSize(req.Txs), resp.Status == abci.ResponseProcessProposal_ACCEPT)
+		}
+	}()
+
 	defer func() {
 		if err := recover(); err != nil {
 			app.logger.Error(
@@ -711,8 +734,6 @@ func (app *BaseApp) VerifyVoteExtension(req *abci.RequestVerifyVoteExtension) (r
 // only used to handle early cancellation, for anything related to state app.finalizeBlockState.Context()
 // must be used.
 func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
-	var events []abci.Event
-
 	if err := app.checkHalt(req.Height, req.Time); err != nil {
 		return nil, err
 	}
@@ -744,17 +765,49 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 	}
 
 	// Context is now updated with Header information.
-	app.finalizeBlockState.SetContext(app.finalizeBlockState.Context().
+	app.finalizeBlockState.SetContext(app.finalizeBlockContext(app.finalizeBlockState.Context(), req, header))
+
+	app.blockHealth.ObserveBlock(req.Height, req.Time, time.Now(), req.DecidedLastCommit.Round)
+
+	if app.checkState != nil {
+		app.checkState.SetContext(app.checkState.Context().
+			WithBlockGasMeter(app.finalizeBlockState.Context().BlockGasMeter()).
+			WithHeaderHash(req.Hash))
+	}
+
+	if app.replayCheck != nil {
+		app.replayCheck.executing(app.replayStateSnapshotters)
+	}
+
+	app.blockProfiler.BeginBlock(req.Height)
+	res, err := app.executeBlock(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var gasUsed uint64
+	for _, txResult := range res.TxResults {
+		gasUsed += uint64(txResult.GasUsed)
+	}
+	app.blockProfiler.EndBlock(len(res.TxResults), gasUsed)
+
+	return res, nil
+}
+
+// finalizeBlockContext returns the given context updated with the header of the
+// block being finalized, its consensus params and a new block gas meter.
+func (app *BaseApp) finalizeBlockContext(ctx sdk.Context, req *abci.RequestFinalizeBlock, header cmtproto.Header) sdk.Context {
+	ctx = ctx.
 		WithBlockHeader(header).
 		WithHeaderHash(req.Hash).
 		WithHeaderInfo(coreheader.Info{
-			ChainID: app.chainID,
+			ChainID: header.ChainID,
 			Height:  req.Height,
 			Time:    req.Time,
 			Hash:    req.Hash,
-			AppHash: app.LastCommitID().Hash,
+			AppHash: header.AppHash,
 		}).
-		WithConsensusParams(app.GetConsensusParams(app.finalizeBlockState.Context())).
+		WithConsensusParams(app.GetConsensusParams(ctx)).
 		WithVoteInfos(req.DecidedLastCommit.Votes).
 		WithExecMode(sdk.ExecModeFinalize).
 		WithCometInfo(corecomet.Info{
@@ -762,26 +815,31 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 			ValidatorsHash:  req.NextValidatorsHash,
 			ProposerAddress: req.ProposerAddress,
 			LastCommit:      sdk.ToSDKCommitInfo(req.DecidedLastCommit),
-		}))
+		})
 
 	// GasMeter must be set after we get a context with updated consensus params.
-	gasMeter := app.getBlockGasMeter(app.finalizeBlockState.Context())
-	app.finalizeBlockState.SetContext(app.finalizeBlockState.Context().WithBlockGasMeter(gasMeter))
+	return ctx.WithBlockGasMeter(app.getBlockGasMeter(ctx))
+}
 
-	if app.checkState != nil {
-		app.checkState.SetContext(app.checkState.Context().
-			WithBlockGasMeter(gasMeter).
-			WithHeaderHash(req.Hash))
-	}
+// executeBlock runs the PreBlock, BeginBlock, transactions and EndBlock of the
+// block against app.finalizeBlockState, whose context must have been set up with
+// finalizeBlockContext. The context received is only used to handle early
+// cancellation.
+func (app *BaseApp) executeBlock(ctx context.Context, req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+	var events []abci.Event
 
+	start := time.Now()
 	if err := app.preBlock(req); err != nil {
 		return nil, err
 	}
+	app.blockProfiler.ObservePhase(telemetry.BlockPhasePreBlock, start)
 
+	start = time.Now()
 	beginBlock, err := app.beginBlock(req)
 	if err != nil {
 		return nil, err
 	}
+	app.blockProfiler.ObservePhase(telemetry.BlockPhaseBeginBlock, start)
 
 	// First check for an abort signal after beginBlock, as it's the first place
 	// we spend any significant amount of time.
@@ -795,7 +853,7 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 	events = append(events, beginBlock.Events...)
 
 	// Reset the gas meter so that the AnteHandlers aren't required to
-	gasMeter = app.getBlockGasMeter(app.finalizeBlockState.Context())
+	gasMeter := app.getBlockGasMeter(app.finalizeBlockState.Context())
 	app.finalizeBlockState.SetContext(app.finalizeBlockState.Context().WithBlockGasMeter(gasMeter))
 
 	// Iterate over all raw transactions in the proposal and attempt to execute
@@ -803,6 +861,7 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 	//
 	// NOTE: Not all raw transactions may adhere to the sdk.Tx interface, e.g.
 	// vote extensions, so skip those.
+	start = time.Now()
 	txResults := make([]*abci.ExecTxResult, 0, len(req.Txs))
 	for _, rawTx := range req.Txs {
 		var response *abci.ExecTxResult
@@ -832,15 +891,18 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 
 		txResults = append(txResults, response)
 	}
+	app.blockProfiler.ObservePhase(telemetry.BlockPhaseTxs, start)
 
 	if app.finalizeBlockState.ms.TracingEnabled() {
 		app.finalizeBlockState.ms = app.finalizeBlockState.ms.SetTracingContext(nil).(storetypes.CacheMultiStore)
 	}
 
+	start = time.Now()
 	endBlock, err := app.endBlock(app.finalizeBlockState.Context())
 	if err != nil {
 		return nil, err
 	}
+	app.blockProfiler.ObservePhase(telemetry.BlockPhaseEndBlock, start)
 
 	// check after endBlock if we should abort, to avoid propagating the result
 	select {
@@ -873,6 +935,10 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 // where they adhere to the sdk.Tx interface.
 func (app *BaseApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (res *abci.ResponseFinalizeBlock, err error) {
 	defer func() {
+		if app.replayCheck != nil && err == nil {
+			app.replayCheck.finalized(req, res)
+		}
+
 		// call the streaming service hooks with the FinalizeBlock messages
 		for _, streamingListener := range app.streamingManager.ABCIListeners {
 			if err := streamingListener.ListenFinalizeBlock(app.finalizeBlockState.Context(), *req, *res); err != nil {
@@ -936,6 +1002,7 @@ func (app *BaseApp) checkHalt(height int64, time time.Time) error {
 // against that height and gracefully halt if it matches the latest committed
 // height.
 func (app *BaseApp) Commit() (*abci.ResponseCommit, error) {
+	start := time.Now()
 	header := app.finalizeBlockState.Context().BlockHeader()
 	retainHeight := app.GetBlockRetentionHeight(header.Height)
 
@@ -967,6 +1034,12 @@ func (app *BaseApp) Commit() (*abci.ResponseCommit, error) {
 		}
 	}
 
+	app.blockProfiler.Commit(start)
+
+	if app.replayCheck != nil {
+		app.checkBlockReplay(header)
+	}
+
 	// Reset the CheckTx state to the latest committed.
 	//
 	// NOTE: This is safe because CometBFT holds a lock on the mempool for
@@ -1357,3 +1430,12 @@ func toVoteInfo(votes []abci.ExtendedVoteInfo) []abci.VoteInfo {
 
 	return legacyVotes
 }
+
+// proposalSize returns the total size in bytes of the transactions of a proposal.
+func proposalSize(txs [][]byte) int64 {
+	var size int64
+	for _, tx := range txs {
+		size += int64(len(tx))
+	}
+	return size
+}
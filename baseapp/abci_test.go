@@ -6,10 +6,14 @@ import (
 	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/rand"
+	"os"
+	"path/filepath"
 	"strconv"
+	"maps"
 	"strings"
 	"testing"
 	"time"
@@ -37,6 +41,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
 	"github.com/cosmos/cosmos-sdk/baseapp/testutil/mock"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -2396,3 +2401,237 @@ func TestOptimisticExecution(t *testing.T) {
 
 	require.Equal(t, int64(50), suite.baseApp.LastBlockHeight())
 }
+
+// nondeterministicCounterServer stores the number of times it has been executed
+// when nondeterministic is set, and the counter of the message otherwise.
+type nondeterministicCounterServer struct {
+	capKey           storetypes.StoreKey
+	executions       int64
+	nondeterministic bool
+}
+
+func (m *nondeterministicCounterServer) IncrementCounter(ctx context.Context, msg *baseapptestutil.MsgCounter) (*baseapptestutil.MsgCreateCounterResponse, error) {
+	m.executions++
+	value := msg.Counter
+	if m.nondeterministic {
+		value = m.executions
+	}
+
+	sdk.UnwrapSDKContext(ctx).KVStore(m.capKey).Set([]byte("counter"), binary.BigEndian.AppendUint64(nil, uint64(value)))
+	return &baseapptestutil.MsgCreateCounterResponse{}, nil
+}
+
+func TestABCI_ReplayCheck(t *testing.T) {
+	exportDir := t.TempDir()
+	suite := NewBaseAppSuite(t, baseapp.SetReplayCheck(true, exportDir))
+
+	server := &nondeterministicCounterServer{capKey: capKey1}
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), server)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	for height := int64(1); height <= 3; height++ {
+		// only the last block is executed non-deterministically
+		server.nondeterministic = height == 3
+
+		tx := newTxCounter(t, suite.txConfig, height, height)
+		txBytes, err := suite.txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+
+		res, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height, Txs: [][]byte{txBytes}})
+		require.NoError(t, err)
+		require.True(t, res.TxResults[0].IsOK())
+
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+
+	// every block but the first one is replayed, and only the last one diverges
+	require.Equal(t, int64(5), server.executions)
+
+	// the replay does not write to the committed state
+	store := suite.baseApp.CommitMultiStore().GetKVStore(capKey1)
+	require.Equal(t, binary.BigEndian.AppendUint64(nil, 4), store.Get([]byte("counter")))
+
+	entries, err := os.ReadDir(exportDir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "3.json", entries[0].Name())
+
+	bz, err := os.ReadFile(filepath.Join(exportDir, entries[0].Name()))
+	require.NoError(t, err)
+
+	var divergence baseapp.ReplayDivergence
+	require.NoError(t, json.Unmarshal(bz, &divergence))
+	require.Equal(t, int64(3), divergence.Height)
+	require.NotEqual(t, divergence.StateHash, divergence.ReplayStateHash)
+	require.Len(t, divergence.Mismatches, 1)
+
+	mismatch := divergence.Mismatches[0]
+	require.Equal(t, baseapp.ReplayMismatchState, mismatch.Kind)
+	require.Equal(t, capKey1.Name(), mismatch.Store)
+	require.Equal(t, hex.EncodeToString([]byte("counter")), strings.ToLower(mismatch.Key))
+	require.Equal(t, "0000000000000004", mismatch.Expected)
+	require.Equal(t, "0000000000000005", mismatch.Actual)
+
+	require.Contains(t, suite.logBuffer.String(), "block replay diverged from committed execution")
+}
+
+// seenTxs rejects the txs already executed, tracking them outside of the
+// multistore as the unordered tx manager does.
+type seenTxs map[string]bool
+
+func (s seenTxs) SnapshotReplayState() func() {
+	snapshot := maps.Clone(s)
+	return func() {
+		clear(s)
+		maps.Copy(s, snapshot)
+	}
+}
+
+func TestABCI_ReplayCheck_StateSnapshotters(t *testing.T) {
+	seen := seenTxs{}
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			if seen[string(ctx.TxBytes())] {
+				return ctx, sdkerrors.ErrInvalidRequest.Wrap("duplicated tx")
+			}
+			if ctx.ExecMode() == sdk.ExecModeFinalize {
+				seen[string(ctx.TxBytes())] = true
+			}
+			return ctx, nil
+		})
+		bapp.SetReplayStateSnapshotters(seen)
+	}
+	suite := NewBaseAppSuite(t, baseapp.SetReplayCheck(true, ""), anteOpt)
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), &nondeterministicCounterServer{capKey: capKey1})
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	for height := int64(1); height <= 3; height++ {
+		tx := newTxCounter(t, suite.txConfig, height, height)
+		txBytes, err := suite.txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+
+		res, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height, Txs: [][]byte{txBytes}})
+		require.NoError(t, err)
+		require.True(t, res.TxResults[0].IsOK())
+
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+
+	// the blocks are replayed from the txs seen before them, and the txs seen
+	// after them are restored
+	require.Len(t, seen, 3)
+	require.NotContains(t, suite.logBuffer.String(), "block replay diverged from committed execution")
+}
+
+func TestABCI_BlockProfiling(t *testing.T) {
+	suite := NewBaseAppSuite(t, baseapp.SetBlockProfiling(true), baseapp.SetReplayCheck(true, ""))
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), &nondeterministicCounterServer{capKey: capKey1})
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	profiler := suite.baseApp.BlockProfiler()
+	require.NotNil(t, profiler)
+	require.NoError(t, profiler.Start(2, []string{telemetry.BlockProfileHeap}))
+
+	for height := int64(1); height <= 3; height++ {
+		tx := newTxCounter(t, suite.txConfig, height, height, height)
+		txBytes, err := suite.txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+
+		_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height, Txs: [][]byte{txBytes}})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+
+	// only the first two blocks are profiled, and their replays are not recorded
+	report, ok := profiler.Report()
+	require.True(t, ok)
+	require.True(t, report.Done)
+	require.Len(t, report.Breakdowns, 2)
+
+	for i, block := range report.Breakdowns {
+		require.Equal(t, int64(i+1), block.Height)
+		require.Equal(t, 1, block.NumTxs)
+		require.Len(t, block.Modules, 1)
+
+		module := block.Modules[0]
+		require.Equal(t, sdk.MsgTypeURL(&baseapptestutil.MsgCounter{}), module.Module)
+		require.Equal(t, uint64(2), module.Msgs)
+		require.NotZero(t, module.GasUsed)
+		require.LessOrEqual(t, module.GasUsed, block.GasUsed)
+	}
+
+	data, err := profiler.Profile(telemetry.BlockProfileHeap)
+	require.NoError(t, err)
+	require.NotEmpty(t, data)
+}
+
+func TestABCI_CheckTx_Filters(t *testing.T) {
+	counterKey := []byte("counter-key")
+	var filtered int
+	ipFilter := func(_ sdk.Context, _ sdk.Tx, md baseapp.CheckTxMetadata) error {
+		filtered++
+		if md["ip"] == "10.0.0.1" {
+			return sdkerrors.ErrUnauthorized.Wrap("blocked ip")
+		}
+		return nil
+	}
+	opt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, counterKey))
+		bapp.SetCheckTxFilters(ipFilter, baseapp.BlockedMsgsCheckTxFilter(sdk.MsgTypeURL(&baseapptestutil.MsgKeyValue{})))
+	}
+	suite := NewBaseAppSuite(t, opt)
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, counterKey})
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 0, 0))
+	require.NoError(t, err)
+
+	// the filters receive the metadata of the transaction
+	r, err := suite.baseApp.CheckTxWithMetadata(&abci.RequestCheckTx{Tx: txBytes}, baseapp.CheckTxMetadata{"ip": "10.0.0.1"})
+	require.NoError(t, err)
+	require.Equal(t, sdkerrors.ErrUnauthorized.ABCICode(), r.Code)
+	require.Equal(t, 1, filtered)
+
+	r, err = suite.baseApp.CheckTx(&abci.RequestCheckTx{Tx: txBytes})
+	require.NoError(t, err)
+	require.True(t, r.IsOK(), fmt.Sprintf("%v", r))
+	require.Equal(t, 2, filtered)
+
+	// the blocked messages are rejected before the AnteHandler runs
+	_, _, addr := testdata.KeyTestPubAddr()
+	builder := suite.txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(&baseapptestutil.MsgKeyValue{Key: []byte("key"), Value: []byte("value"), Signer: addr.String()}))
+	txBytes, err = suite.txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	r, err = suite.baseApp.CheckTx(&abci.RequestCheckTx{Tx: txBytes})
+	require.NoError(t, err)
+	require.Equal(t, sdkerrors.ErrUnauthorized.ABCICode(), r.Code)
+	require.Contains(t, r.Log, "is not accepted by this node")
+	require.Equal(t, int64(1), getIntFromStore(t, getCheckStateCtx(suite.baseApp).KVStore(capKey1), counterKey))
+
+	// rechecked transactions are not filtered
+	txBytes, err = suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 1, 1))
+	require.NoError(t, err)
+	_, err = suite.baseApp.CheckTx(&abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_Recheck})
+	require.NoError(t, err)
+	require.Equal(t, 3, filtered)
+}
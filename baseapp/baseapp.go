@@ -7,6 +7,7 @@ import (
 	"sort"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -177,6 +178,10 @@ type BaseApp struct {
 	// streamingManager for managing instances and configuration of ABCIListener services
 	streamingManager storetypes.StreamingManager
 
+	// ipcPublisher streams the results of the committed blocks to the local
+	// sidecars. It is nil unless enabled.
+	ipcPublisher *IPCPublisher
+
 	chainID string
 
 	cdc codec.Codec
@@ -185,6 +190,26 @@ type BaseApp struct {
 	// including the goroutine handling.This is experimental and must be enabled
 	// by developers.
 	optimisticExec *oe.OptimisticExecution
+
+	// blockHealth emits block production health metrics and logs a warning when
+	// they cross their alert thresholds.
+	blockHealth *telemetry.BlockHealthMonitor
+
+	// replayCheck re-executes every committed block against its pre-state to
+	// detect non-deterministic state transitions. It is nil unless enabled.
+	replayCheck *replayCheck
+
+	// replayStateSnapshotters snapshot the state kept outside of the multistore
+	// for the block replay determinism checker.
+	replayStateSnapshotters []ReplayStateSnapshotter
+
+	// blockProfiler captures runtime profiles and a per module breakdown of the
+	// next blocks on demand. It is nil unless enabled.
+	blockProfiler *telemetry.BlockProfiler
+
+	// checkTxFilters are applied to the new transactions in CheckTx before the
+	// AnteHandler runs.
+	checkTxFilters []CheckTxFilter
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -232,6 +257,9 @@ func NewBaseApp(
 	if app.interBlockCache != nil {
 		app.cms.SetInterBlockCache(app.interBlockCache)
 	}
+	if app.blockHealth == nil {
+		app.blockHealth = telemetry.NewBlockHealthMonitor(app.logger, telemetry.BlockHealthThresholds{})
+	}
 
 	app.runTxRecoveryMiddleware = newDefaultRecoveryMiddleware()
 
@@ -273,6 +301,12 @@ func (app *BaseApp) Logger() log.Logger {
 	return app.logger
 }
 
+// BlockProfiler returns the block profiler of the BaseApp, or nil if block
+// profiling is not enabled.
+func (app *BaseApp) BlockProfiler() *telemetry.BlockProfiler {
+	return app.blockProfiler
+}
+
 // Trace returns the boolean value for logging error stack traces.
 func (app *BaseApp) Trace() bool {
 	return app.trace
@@ -482,6 +516,13 @@ func (app *BaseApp) IsSealed() bool { return app.sealed }
 // multi-store branch, and provided header.
 func (app *BaseApp) setState(mode execMode, h cmtproto.Header) {
 	ms := app.cms.CacheMultiStore()
+	if mode == execModeFinalize && app.replayCheck != nil {
+		// record the state changes of the block to compare them with its replay
+		if recordingMS, ok := app.newChangeSetMultiStore(ms); ok {
+			ms = recordingMS
+		}
+	}
+
 	headerInfo := header.Info{
 		Height:  h.Height,
 		Time:    h.Time,
@@ -962,11 +1003,11 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 			return gInfo, nil, anteEvents, errors.Join(err, errPostHandler)
 		}
 
-		// we don't want runTx to panic if runMsgs has failed earlier
-		if result == nil {
-			result = &sdk.Result{}
+		// no result is returned if runMsgs has failed earlier, and so are the
+		// events of the postHandlers, its state being reverted
+		if result != nil {
+			result.Events = append(result.Events, newCtx.EventManager().ABCIEvents()...)
 		}
-		result.Events = append(result.Events, newCtx.EventManager().ABCIEvents()...)
 	}
 
 	if err == nil {
@@ -1007,7 +1048,16 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, msgsV2 []protov2.Me
 		}
 
 		// ADR 031 request type routing
+		msgStart, msgGas := time.Now(), ctx.GasMeter().GasConsumed()
 		msgResult, err := handler(ctx, msg)
+		if mode == execModeFinalize && app.blockProfiler != nil {
+			// messages outside of a module package are accounted by type URL
+			module := sdk.GetModuleNameFromTypeURL(sdk.MsgTypeURL(msg))
+			if module == "" {
+				module = sdk.MsgTypeURL(msg)
+			}
+			app.blockProfiler.ObserveMsg(module, msgStart, ctx.GasMeter().GasConsumed()-msgGas)
+		}
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "failed to execute message; message index: %d", i)
 		}
@@ -1156,5 +1206,12 @@ func (app *BaseApp) Close() error {
 		}
 	}
 
+	if app.ipcPublisher != nil {
+		app.logger.Info("Closing ipc streaming socket")
+		if err := app.ipcPublisher.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	return errors.Join(errs...)
 }
@@ -0,0 +1,66 @@
+package baseapp
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CheckTxMetadata carries information about the origin of a transaction which
+// is not part of the ABCI request, e.g. the IP address of the client which
+// submitted it. It is empty for the transactions received from CometBFT, and
+// set by the apps ingesting transactions through their own transport with
+// CheckTxWithMetadata.
+type CheckTxMetadata map[string]string
+
+// CheckTxFilter is a cheap stateful filter applied to the new transactions in
+// CheckTx, once decoded but before the AnteHandler runs, to shed spam before the
+// expensive signature verification. The context is a branch of the check state
+// whose writes are discarded, so filters needing counters must keep their own.
+// A filter rejects a transaction by returning an error.
+//
+// Filters run before the signatures are verified, so the signers of the
+// transaction are claimed but not proven.
+type CheckTxFilter func(ctx sdk.Context, tx sdk.Tx, md CheckTxMetadata) error
+
+// BlockedMsgsCheckTxFilter returns a CheckTxFilter rejecting the transactions
+// containing a message of one of the given type URLs.
+func BlockedMsgsCheckTxFilter(typeURLs ...string) CheckTxFilter {
+	blocked := make(map[string]struct{}, len(typeURLs))
+	for _, typeURL := range typeURLs {
+		blocked[typeURL] = struct{}{}
+	}
+
+	return func(_ sdk.Context, tx sdk.Tx, _ CheckTxMetadata) error {
+		for _, msg := range tx.GetMsgs() {
+			typeURL := sdk.MsgTypeURL(msg)
+			if _, ok := blocked[typeURL]; ok {
+				return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "message %s is not accepted by this node", typeURL)
+			}
+		}
+
+		return nil
+	}
+}
+
+// filterCheckTx applies the CheckTx filters to a new transaction.
+func (app *BaseApp) filterCheckTx(txBytes []byte, md CheckTxMetadata) error {
+	if len(app.checkTxFilters) == 0 {
+		return nil
+	}
+
+	tx, err := app.txDecoder(txBytes)
+	if err != nil {
+		return err
+	}
+
+	ctx, _ := app.getContextForTx(execModeCheck, txBytes).CacheContext()
+	for _, filter := range app.checkTxFilters {
+		if err := filter(ctx, tx, md); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,354 @@
+package baseapp
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultIPCBufferSize is the default number of blocks buffered for each
+	// sidecar before the publisher applies backpressure.
+	DefaultIPCBufferSize = 16
+	// DefaultIPCSendTimeout is the default time the publisher waits for a sidecar
+	// with a full buffer before disconnecting it.
+	DefaultIPCSendTimeout = time.Second
+)
+
+var _ storetypes.ABCIListener = (*IPCPublisher)(nil)
+
+// IPCBlockResult is the message streamed to the sidecars for every committed
+// block. Messages are newline delimited JSON documents.
+type IPCBlockResult struct {
+	Height       int64               `json:"height"`
+	Time         time.Time           `json:"time"`
+	AppHash      string              `json:"app_hash"`
+	TxResults    []IPCTxResult       `json:"tx_results"`
+	StateChanges []IPCStoreChanges   `json:"state_changes"`
+	Events       []abci.Event        `json:"events,omitempty"`
+	Summary      IPCStateChangeStats `json:"summary"`
+}
+
+// IPCTxResult is the execution result of a transaction of a committed block.
+type IPCTxResult struct {
+	Index     int          `json:"index"`
+	Hash      string       `json:"hash"`
+	Code      uint32       `json:"code"`
+	Codespace string       `json:"codespace,omitempty"`
+	Log       string       `json:"log,omitempty"`
+	GasWanted int64        `json:"gas_wanted"`
+	GasUsed   int64        `json:"gas_used"`
+	Events    []abci.Event `json:"events,omitempty"`
+}
+
+// IPCStoreChanges summarizes the state writes of a committed block to a store.
+type IPCStoreChanges struct {
+	Store string `json:"store"`
+	IPCStateChangeStats
+}
+
+// IPCStateChangeStats counts state writes.
+type IPCStateChangeStats struct {
+	Sets         int `json:"sets"`
+	Deletes      int `json:"deletes"`
+	BytesWritten int `json:"bytes_written"`
+}
+
+// IPCPublisher is an ABCIListener streaming the results of the committed blocks
+// to the local sidecar processes connected to its unix socket, so they don't
+// need to poll the RPC or run the file streaming service.
+//
+// Each sidecar has a bounded buffer of blocks. When it is full, the commit of
+// the next block waits for the sidecar up to the send timeout, after which the
+// sidecar is disconnected so that it cannot stall the node.
+type IPCPublisher struct {
+	logger      log.Logger
+	listener    net.Listener
+	bufferSize  int
+	sendTimeout time.Duration
+
+	mtx         sync.Mutex
+	subscribers map[*ipcSubscriber]struct{}
+	pending     *IPCBlockResult
+	closed      bool
+}
+
+type ipcSubscriber struct {
+	conn  net.Conn
+	queue chan []byte
+	done  chan struct{}
+	once  sync.Once
+}
+
+// NewIPCPublisher listens on the unix socket at the given path, replacing a
+// stale socket left by a previous run, and accepts sidecar connections until
+// closed. A non-positive buffer size or send timeout selects the default.
+func NewIPCPublisher(logger log.Logger, path string, bufferSize int, sendTimeout time.Duration) (*IPCPublisher, error) {
+	if path == "" {
+		return nil, errors.New("ipc socket path cannot be empty")
+	}
+	if bufferSize <= 0 {
+		bufferSize = DefaultIPCBufferSize
+	}
+	if sendTimeout <= 0 {
+		sendTimeout = DefaultIPCSendTimeout
+	}
+
+	if fi, err := os.Stat(path); err == nil {
+		if fi.Mode()&os.ModeSocket == 0 {
+			return nil, fmt.Errorf("ipc socket path %s exists and is not a socket", path)
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, fmt.Errorf("failed to remove stale ipc socket: %w", err)
+		}
+	}
+
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on ipc socket: %w", err)
+	}
+
+	p := &IPCPublisher{
+		logger:      logger.With(log.ModuleKey, "ipc-streaming"),
+		listener:    listener,
+		bufferSize:  bufferSize,
+		sendTimeout: sendTimeout,
+		subscribers: make(map[*ipcSubscriber]struct{}),
+	}
+	go p.acceptLoop()
+
+	p.logger.Info("streaming block results to sidecars", "path", path)
+
+	return p, nil
+}
+
+// NumSubscribers returns the number of connected sidecars.
+func (p *IPCPublisher) NumSubscribers() int {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	return len(p.subscribers)
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener. It records the results
+// of the block, which are streamed once the block is committed.
+func (p *IPCPublisher) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	block := &IPCBlockResult{
+		Height:    req.Height,
+		Time:      req.Time,
+		AppHash:   strings.ToUpper(hex.EncodeToString(res.AppHash)),
+		TxResults: make([]IPCTxResult, 0, len(res.TxResults)),
+		Events:    res.Events,
+	}
+
+	for i, txRes := range res.TxResults {
+		if txRes == nil || i >= len(req.Txs) {
+			continue
+		}
+
+		hash := sha256.Sum256(req.Txs[i])
+		block.TxResults = append(block.TxResults, IPCTxResult{
+			Index:     i,
+			Hash:      strings.ToUpper(hex.EncodeToString(hash[:])),
+			Code:      txRes.Code,
+			Codespace: txRes.Codespace,
+			Log:       txRes.Log,
+			GasWanted: txRes.GasWanted,
+			GasUsed:   txRes.GasUsed,
+			Events:    txRes.Events,
+		})
+	}
+
+	p.mtx.Lock()
+	p.pending = block
+	p.mtx.Unlock()
+
+	return nil
+}
+
+// ListenCommit implements storetypes.ABCIListener. It streams the results of the
+// committed block along with a summary of its state changes.
+func (p *IPCPublisher) ListenCommit(ctx context.Context, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	p.mtx.Lock()
+	block := p.pending
+	p.pending = nil
+	p.mtx.Unlock()
+
+	if block == nil {
+		// the block was not finalized by this process, e.g. on a restart
+		block = &IPCBlockResult{Height: sdk.UnwrapSDKContext(ctx).BlockHeight()}
+	}
+
+	block.StateChanges, block.Summary = summarizeChangeSet(changeSet)
+
+	bz, err := json.Marshal(block)
+	if err != nil {
+		return err
+	}
+
+	p.publish(append(bz, '\n'))
+
+	return nil
+}
+
+// Close stops accepting sidecars, disconnects the connected ones and removes
+// the socket.
+func (p *IPCPublisher) Close() error {
+	p.mtx.Lock()
+	if p.closed {
+		p.mtx.Unlock()
+		return nil
+	}
+	p.closed = true
+	subscribers := p.subscribers
+	p.subscribers = make(map[*ipcSubscriber]struct{})
+	p.mtx.Unlock()
+
+	for sub := range subscribers {
+		sub.close()
+	}
+
+	// closing a unix listener also removes its socket
+	return p.listener.Close()
+}
+
+func (p *IPCPublisher) acceptLoop() {
+	for {
+		conn, err := p.listener.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				p.logger.Error("failed to accept sidecar connection", "err", err)
+			}
+			return
+		}
+
+		sub := &ipcSubscriber{
+			conn:  conn,
+			queue: make(chan []byte, p.bufferSize),
+			done:  make(chan struct{}),
+		}
+
+		p.mtx.Lock()
+		if p.closed {
+			p.mtx.Unlock()
+			_ = conn.Close()
+			return
+		}
+		p.subscribers[sub] = struct{}{}
+		p.mtx.Unlock()
+
+		go p.writeLoop(sub)
+	}
+}
+
+func (p *IPCPublisher) writeLoop(sub *ipcSubscriber) {
+	defer p.remove(sub)
+
+	for {
+		select {
+		case bz := <-sub.queue:
+			if _, err := sub.conn.Write(bz); err != nil {
+				p.logger.Debug("sidecar disconnected", "err", err)
+				return
+			}
+		case <-sub.done:
+			return
+		}
+	}
+}
+
+// publish queues the message for every sidecar. The sidecars whose buffer is
+// still full once the send timeout has elapsed are disconnected.
+func (p *IPCPublisher) publish(bz []byte) {
+	p.mtx.Lock()
+	subscribers := make([]*ipcSubscriber, 0, len(p.subscribers))
+	for sub := range p.subscribers {
+		subscribers = append(subscribers, sub)
+	}
+	p.mtx.Unlock()
+
+	deadline := time.Now().Add(p.sendTimeout)
+	for _, sub := range subscribers {
+		select {
+		case sub.queue <- bz:
+			continue
+		case <-sub.done:
+			continue
+		default:
+		}
+
+		timer := time.NewTimer(time.Until(deadline))
+		select {
+		case sub.queue <- bz:
+		case <-sub.done:
+		case <-timer.C:
+			p.logger.Error("disconnecting sidecar not keeping up with the block results", "buffer_size", p.bufferSize)
+			p.remove(sub)
+		}
+		timer.Stop()
+	}
+}
+
+func (p *IPCPublisher) remove(sub *ipcSubscriber) {
+	p.mtx.Lock()
+	delete(p.subscribers, sub)
+	p.mtx.Unlock()
+
+	sub.close()
+}
+
+func (s *ipcSubscriber) close() {
+	s.once.Do(func() {
+		close(s.done)
+		_ = s.conn.Close()
+	})
+}
+
+// summarizeChangeSet counts the state writes of a block by store, sorted by
+// store name.
+func summarizeChangeSet(changeSet []*storetypes.StoreKVPair) ([]IPCStoreChanges, IPCStateChangeStats) {
+	byStore := make(map[string]*IPCStoreChanges)
+	var total IPCStateChangeStats
+
+	for _, pair := range changeSet {
+		changes, ok := byStore[pair.StoreKey]
+		if !ok {
+			changes = &IPCStoreChanges{Store: pair.StoreKey}
+			byStore[pair.StoreKey] = changes
+		}
+
+		if pair.Delete {
+			changes.Deletes++
+			total.Deletes++
+		} else {
+			changes.Sets++
+			total.Sets++
+		}
+		changes.BytesWritten += len(pair.Key) + len(pair.Value)
+		total.BytesWritten += len(pair.Key) + len(pair.Value)
+	}
+
+	stores := make([]IPCStoreChanges, 0, len(byStore))
+	for _, changes := range byStore {
+		stores = append(stores, *changes)
+	}
+	sort.Slice(stores, func(i, j int) bool { return stores[i].Store < stores[j].Store })
+
+	return stores, total
+}
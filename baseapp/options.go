@@ -18,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp/oe"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
 )
@@ -119,6 +120,51 @@ func SetOptimisticExecution(opts ...func(*oe.OptimisticExecution)) func(*BaseApp
 	}
 }
 
+// SetBlockHealthThresholds sets the alert thresholds of the block production
+// health metrics.
+func SetBlockHealthThresholds(thresholds telemetry.BlockHealthThresholds) func(*BaseApp) {
+	return func(app *BaseApp) {
+		app.blockHealth = telemetry.NewBlockHealthMonitor(app.logger, thresholds)
+	}
+}
+
+// SetReplayCheck enables or disables the block replay determinism checker. When
+// enabled, every committed block is re-executed against its pre-state in a
+// shadow store, and any divergence from the committed execution is logged along
+// with the offending transaction and messages. If exportDir is not empty, the
+// divergence reports are also written to it as JSON files.
+//
+// The replay doubles the execution time of the blocks, so it is meant to be
+// enabled on dedicated non-validator nodes. Memory stores are not versioned, so
+// the replay reads them at their latest state. The state kept outside of the multistore
+// must be registered with SetReplayStateSnapshotters to be replayed from its
+// state before the block.
+func SetReplayCheck(enabled bool, exportDir string) func(*BaseApp) {
+	return func(app *BaseApp) {
+		if !enabled {
+			app.replayCheck = nil
+			return
+		}
+
+		app.replayCheck = &replayCheck{exportDir: exportDir}
+	}
+}
+
+// SetBlockProfiling enables or disables the block profiler, which captures
+// runtime profiles bounded to the execution of the next blocks, along with a
+// gas and time breakdown per module of these blocks, whenever a profiling
+// session is started through BaseApp.BlockProfiler.
+func SetBlockProfiling(enabled bool) func(*BaseApp) {
+	return func(app *BaseApp) {
+		if !enabled {
+			app.blockProfiler = nil
+			return
+		}
+
+		app.blockProfiler = telemetry.NewBlockProfiler(app.logger)
+	}
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -249,6 +295,27 @@ func (app *BaseApp) SetPostHandler(ph sdk.PostHandler) {
 	app.postHandler = ph
 }
 
+// SetReplayStateSnapshotters sets the snapshotters of the state kept outside of
+// the multistore, which the block replay determinism checker restores to its
+// state before the replayed block. See ReplayStateSnapshotter.
+func (app *BaseApp) SetReplayStateSnapshotters(snapshotters ...ReplayStateSnapshotter) {
+	if app.sealed {
+		panic("SetReplayStateSnapshotters() on sealed BaseApp")
+	}
+
+	app.replayStateSnapshotters = snapshotters
+}
+
+// SetCheckTxFilters sets the filters applied to the new transactions in CheckTx
+// before the AnteHandler runs, in the given order. See CheckTxFilter.
+func (app *BaseApp) SetCheckTxFilters(filters ...CheckTxFilter) {
+	if app.sealed {
+		panic("SetCheckTxFilters() on sealed BaseApp")
+	}
+
+	app.checkTxFilters = filters
+}
+
 func (app *BaseApp) SetAddrPeerFilter(pf sdk.PeerFilter) {
 	if app.sealed {
 		panic("SetAddrPeerFilter() on sealed BaseApp")
@@ -0,0 +1,524 @@
+package baseapp
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ReplayMismatchTxResult is the kind of a mismatch between the results of a
+	// transaction.
+	ReplayMismatchTxResult = "tx_result"
+	// ReplayMismatchValidatorUpdates is the kind of a mismatch between the
+	// validator updates of a block.
+	ReplayMismatchValidatorUpdates = "validator_updates"
+	// ReplayMismatchConsensusParams is the kind of a mismatch between the
+	// consensus param updates of a block.
+	ReplayMismatchConsensusParams = "consensus_params"
+	// ReplayMismatchState is the kind of a mismatch between the state writes of
+	// a block.
+	ReplayMismatchState = "state"
+
+	// maxReplayStateMismatches is the maximum number of diverging state writes
+	// reported for a block.
+	maxReplayStateMismatches = 10
+)
+
+// ReplayDivergence describes how the replay of a block against its pre-state
+// diverged from its committed execution.
+type ReplayDivergence struct {
+	Height int64 `json:"height"`
+	// StateHash and ReplayStateHash are the hashes of the state writes of the
+	// committed execution and of the replay. As both executions start from the
+	// same pre-state, the app hashes differ if and only if these hashes differ.
+	StateHash       string           `json:"state_hash"`
+	ReplayStateHash string           `json:"replay_state_hash"`
+	Mismatches      []ReplayMismatch `json:"mismatches"`
+}
+
+// ReplayMismatch is a single difference between the committed execution of a
+// block and its replay.
+type ReplayMismatch struct {
+	Kind string `json:"kind"`
+	// TxIndex, TxHash and Msgs identify the transaction of a tx result mismatch.
+	TxIndex *int     `json:"tx_index,omitempty"`
+	TxHash  string   `json:"tx_hash,omitempty"`
+	Msgs    []string `json:"msgs,omitempty"`
+	// Store and Key identify the write of a state mismatch, the key being hex
+	// encoded.
+	Store    string `json:"store,omitempty"`
+	Key      string `json:"key,omitempty"`
+	Expected string `json:"expected"`
+	Actual   string `json:"actual"`
+}
+
+// ReplayStateSnapshotter is implemented by the state kept outside of the
+// multistore that is updated while executing the blocks, such as the unordered
+// tx manager. The block replay determinism checker replays the blocks from this
+// state as it was before the block, and restores it as it is after the block
+// once done, so that the replay neither falsely diverges nor affects the
+// committed execution.
+type ReplayStateSnapshotter interface {
+	// SnapshotReplayState returns a function restoring the state as it is when
+	// SnapshotReplayState is called.
+	SnapshotReplayState() (restore func())
+}
+
+// replayCheck holds the configuration of the block replay determinism checker
+// along with the last finalized block, which is replayed once committed.
+type replayCheck struct {
+	exportDir string
+
+	req *abci.RequestFinalizeBlock
+	res *abci.ResponseFinalizeBlock
+
+	// restorePreState restores the state kept outside of the multistore as it
+	// was before the last finalized block.
+	restorePreState []func()
+}
+
+// executing snapshots the state kept outside of the multistore before a block is
+// executed.
+func (rc *replayCheck) executing(snapshotters []ReplayStateSnapshotter) {
+	rc.restorePreState = snapshotReplayState(snapshotters)
+}
+
+// finalized records the request and response of a finalized block.
+func (rc *replayCheck) finalized(req *abci.RequestFinalizeBlock, res *abci.ResponseFinalizeBlock) {
+	rc.req, rc.res = req, res
+}
+
+// snapshotReplayState returns the functions restoring the state of the given
+// snapshotters as it is when called.
+func snapshotReplayState(snapshotters []ReplayStateSnapshotter) []func() {
+	restore := make([]func(), 0, len(snapshotters))
+	for _, snapshotter := range snapshotters {
+		restore = append(restore, snapshotter.SnapshotReplayState())
+	}
+
+	return restore
+}
+
+// checkBlockReplay re-executes the block being committed against its pre-state
+// in a shadow store, and reports any divergence from its committed execution.
+// It must be called once the block is committed, before app.finalizeBlockState
+// is reset.
+func (app *BaseApp) checkBlockReplay(header cmtproto.Header) {
+	req, res, restorePreState := app.replayCheck.req, app.replayCheck.res, app.replayCheck.restorePreState
+	app.replayCheck.req, app.replayCheck.res, app.replayCheck.restorePreState = nil, nil, nil
+
+	ms, ok := app.finalizeBlockState.ms.(*changeSetMultiStore)
+	if !ok || req == nil || res == nil || req.Height != header.Height {
+		return
+	}
+
+	logger := app.logger.With("height", header.Height)
+
+	// the pre-state of the initial block is the genesis state, which is not
+	// committed on its own
+	rms := app.cms.(*rootmulti.Store)
+	if _, err := rms.GetCommitInfo(header.Height - 1); err != nil {
+		logger.Debug("skipping block replay check, pre-state is not available", "err", err)
+		return
+	}
+
+	replayRes, replayChanges, err := app.replayBlock(rms, req, header, restorePreState)
+	if err != nil {
+		logger.Error("failed to replay block", "err", err)
+		telemetry.IncrCounter(1, "replay_check", "failed")
+		return
+	}
+
+	divergence := app.compareReplay(req, res, ms.changes, replayRes, replayChanges)
+	if divergence == nil {
+		logger.Debug("block replay matches committed execution")
+		return
+	}
+
+	app.reportReplayDivergence(divergence)
+}
+
+// replayBlock executes the block against the state of the previous height in a
+// shadow store, and returns its response along with its state writes. Nothing is
+// written to the committed state.
+func (app *BaseApp) replayBlock(rms *rootmulti.Store, req *abci.RequestFinalizeBlock, header cmtproto.Header, restorePreState []func()) (res *abci.ResponseFinalizeBlock, changes changeSet, err error) {
+	preState, err := rms.CacheMultiStoreWithVersion(header.Height - 1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	shadow, _ := app.newChangeSetMultiStore(preState.CacheMultiStore())
+
+	// the state kept outside of the multistore is replayed from its state before
+	// the block, and restored to its state after the block once done
+	restorePostState := snapshotReplayState(app.replayStateSnapshotters)
+	for _, restore := range restorePreState {
+		restore()
+	}
+
+	finalizeBlockState := app.finalizeBlockState
+	defer func() {
+		app.finalizeBlockState = finalizeBlockState
+		for _, restore := range restorePostState {
+			restore()
+		}
+
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic during block replay: %v", r)
+		}
+	}()
+
+	// the replay runs in a context of its own, so that its logs do not get mixed
+	// up with the ones of the committed execution
+	app.finalizeBlockState = &state{
+		ms:  shadow,
+		ctx: sdk.NewContext(shadow, false, log.NewNopLogger()),
+	}
+	app.finalizeBlockState.SetContext(app.finalizeBlockContext(app.finalizeBlockState.Context(), req, header))
+
+	res, err = app.executeBlock(context.Background(), req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	shadow.Write()
+
+	return res, shadow.changes, nil
+}
+
+// compareReplay returns the divergence between the committed execution of a
+// block and its replay, or nil if they match.
+func (app *BaseApp) compareReplay(
+	req *abci.RequestFinalizeBlock,
+	res *abci.ResponseFinalizeBlock,
+	changes changeSet,
+	replayRes *abci.ResponseFinalizeBlock,
+	replayChanges changeSet,
+) *ReplayDivergence {
+	var mismatches []ReplayMismatch
+
+	for i := 0; i < len(res.TxResults) || i < len(replayRes.TxResults); i++ {
+		expected, actual := txResultAt(res.TxResults, i), txResultAt(replayRes.TxResults, i)
+		if expected == actual {
+			continue
+		}
+
+		index := i
+		mismatch := ReplayMismatch{
+			Kind:     ReplayMismatchTxResult,
+			TxIndex:  &index,
+			Expected: expected,
+			Actual:   actual,
+		}
+		if i < len(req.Txs) {
+			mismatch.TxHash = fmt.Sprintf("%X", sha256.Sum256(req.Txs[i]))
+			mismatch.Msgs = app.msgTypeURLs(req.Txs[i])
+		}
+		mismatches = append(mismatches, mismatch)
+	}
+
+	expected, actual := validatorUpdatesString(res.ValidatorUpdates), validatorUpdatesString(replayRes.ValidatorUpdates)
+	if expected != actual {
+		mismatches = append(mismatches, ReplayMismatch{
+			Kind:     ReplayMismatchValidatorUpdates,
+			Expected: expected,
+			Actual:   actual,
+		})
+	}
+
+	if expected, actual := res.ConsensusParamUpdates.String(), replayRes.ConsensusParamUpdates.String(); expected != actual {
+		mismatches = append(mismatches, ReplayMismatch{
+			Kind:     ReplayMismatchConsensusParams,
+			Expected: expected,
+			Actual:   actual,
+		})
+	}
+
+	stateHash, replayStateHash := changes.hash(), replayChanges.hash()
+	if !bytes.Equal(stateHash, replayStateHash) {
+		mismatches = append(mismatches, changes.diff(replayChanges, maxReplayStateMismatches)...)
+	}
+
+	if len(mismatches) == 0 {
+		return nil
+	}
+
+	return &ReplayDivergence{
+		Height:          req.Height,
+		StateHash:       fmt.Sprintf("%X", stateHash),
+		ReplayStateHash: fmt.Sprintf("%X", replayStateHash),
+		Mismatches:      mismatches,
+	}
+}
+
+// reportReplayDivergence logs the divergence and writes it to the export
+// directory, if any.
+func (app *BaseApp) reportReplayDivergence(divergence *ReplayDivergence) {
+	telemetry.IncrCounter(1, "replay_check", "divergence")
+
+	logger := app.logger.With("height", divergence.Height)
+	logger.Error(
+		"block replay diverged from committed execution",
+		"state_hash", divergence.StateHash,
+		"replay_state_hash", divergence.ReplayStateHash,
+		"mismatches", len(divergence.Mismatches),
+	)
+	for _, mismatch := range divergence.Mismatches {
+		logger.Error(
+			"block replay mismatch",
+			"kind", mismatch.Kind,
+			"tx_hash", mismatch.TxHash,
+			"msgs", mismatch.Msgs,
+			"store", mismatch.Store,
+			"key", mismatch.Key,
+			"expected", mismatch.Expected,
+			"actual", mismatch.Actual,
+		)
+	}
+
+	if app.replayCheck.exportDir == "" {
+		return
+	}
+
+	if err := writeReplayDivergence(app.replayCheck.exportDir, divergence); err != nil {
+		logger.Error("failed to export block replay divergence", "err", err)
+	}
+}
+
+// writeReplayDivergence writes the divergence to <dir>/<height>.json.
+func writeReplayDivergence(dir string, divergence *ReplayDivergence) error {
+	bz, err := json.MarshalIndent(divergence, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, fmt.Sprintf("%d.json", divergence.Height)), bz, 0o600)
+}
+
+// msgTypeURLs returns the type URLs of the messages of a raw transaction.
+func (app *BaseApp) msgTypeURLs(rawTx []byte) []string {
+	tx, err := app.txDecoder(rawTx)
+	if err != nil {
+		return nil
+	}
+
+	msgs := tx.GetMsgs()
+	typeURLs := make([]string, len(msgs))
+	for i, msg := range msgs {
+		typeURLs[i] = sdk.MsgTypeURL(msg)
+	}
+
+	return typeURLs
+}
+
+// txResultAt returns the consensus relevant fields of a transaction result, the
+// ones committed to in the LastResultsHash of the next block.
+func txResultAt(results []*abci.ExecTxResult, i int) string {
+	if i >= len(results) {
+		return "missing"
+	}
+
+	res := results[i]
+	return fmt.Sprintf("code=%d gas_wanted=%d gas_used=%d data=%X log=%q", res.Code, res.GasWanted, res.GasUsed, res.Data, res.Log)
+}
+
+func validatorUpdatesString(updates []abci.ValidatorUpdate) string {
+	var buf bytes.Buffer
+	for _, update := range updates {
+		buf.WriteString(update.String())
+		buf.WriteString(";")
+	}
+
+	return buf.String()
+}
+
+// changeSet holds the writes flushed to the persistent stores of a multistore
+// branch, by store name and key. A nil value is a deletion.
+type changeSet map[string]map[string][]byte
+
+func (cs changeSet) set(storeName string, key, value []byte) {
+	writes, ok := cs[storeName]
+	if !ok {
+		writes = make(map[string][]byte)
+		cs[storeName] = writes
+	}
+
+	if value != nil {
+		value = append([]byte{}, value...)
+	}
+	writes[string(key)] = value
+}
+
+// hash returns a hash of the writes, ordered by store name and key.
+func (cs changeSet) hash() []byte {
+	h := sha256.New()
+	writeBytes := func(bz []byte) {
+		var length [8]byte
+		binary.BigEndian.PutUint64(length[:], uint64(len(bz)))
+		h.Write(length[:])
+		h.Write(bz)
+	}
+
+	for _, storeName := range sortedKeys(cs) {
+		writeBytes([]byte(storeName))
+		writes := cs[storeName]
+		for _, key := range sortedKeys(writes) {
+			writeBytes([]byte(key))
+			if value := writes[key]; value == nil {
+				h.Write([]byte{0})
+			} else {
+				h.Write([]byte{1})
+				writeBytes(value)
+			}
+		}
+	}
+
+	return h.Sum(nil)
+}
+
+// diff returns up to limit writes which differ between the change sets,
+// ordered by store name and key.
+func (cs changeSet) diff(other changeSet, limit int) []ReplayMismatch {
+	var mismatches []ReplayMismatch
+	for _, storeName := range sortedKeys(cs, other) {
+		writes, otherWrites := cs[storeName], other[storeName]
+		for _, key := range sortedKeys(writes, otherWrites) {
+			value, ok := writes[key]
+			otherValue, otherOk := otherWrites[key]
+			if ok == otherOk && (value == nil) == (otherValue == nil) && bytes.Equal(value, otherValue) {
+				continue
+			}
+
+			mismatches = append(mismatches, ReplayMismatch{
+				Kind:     ReplayMismatchState,
+				Store:    storeName,
+				Key:      fmt.Sprintf("%X", key),
+				Expected: writeString(value, ok),
+				Actual:   writeString(otherValue, otherOk),
+			})
+			if len(mismatches) == limit {
+				return mismatches
+			}
+		}
+	}
+
+	return mismatches
+}
+
+func writeString(value []byte, ok bool) string {
+	switch {
+	case !ok:
+		return "not written"
+	case value == nil:
+		return "deleted"
+	default:
+		return fmt.Sprintf("%X", value)
+	}
+}
+
+// sortedKeys returns the sorted union of the keys of the maps.
+func sortedKeys[V any](maps ...map[string]V) []string {
+	seen := make(map[string]struct{})
+	var keys []string
+	for _, m := range maps {
+		for key := range m {
+			if _, ok := seen[key]; !ok {
+				seen[key] = struct{}{}
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// recordingStore is a KVStore recording the writes made to it in a change set.
+type recordingStore struct {
+	storetypes.KVStore
+
+	name    string
+	changes changeSet
+}
+
+func (s recordingStore) Set(key, value []byte) {
+	storetypes.AssertValidValue(value)
+	s.changes.set(s.name, key, value)
+	s.KVStore.Set(key, value)
+}
+
+func (s recordingStore) Delete(key []byte) {
+	s.changes.set(s.name, key, nil)
+	s.KVStore.Delete(key)
+}
+
+// changeSetMultiStore is a CacheMultiStore branching off a parent one, which
+// records the writes it flushes to the persistent stores of its parent.
+type changeSetMultiStore struct {
+	cachemulti.Store
+
+	parent  storetypes.CacheMultiStore
+	changes changeSet
+}
+
+// newChangeSetMultiStore branches the given CacheMultiStore of app.cms into a
+// changeSetMultiStore. It returns false if app.cms is not a root multistore.
+func (app *BaseApp) newChangeSetMultiStore(parent storetypes.CacheMultiStore) (*changeSetMultiStore, bool) {
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		return nil, false
+	}
+
+	ms := &changeSetMultiStore{
+		parent:  parent,
+		changes: make(changeSet),
+	}
+
+	keys := rms.StoreKeysByName()
+	stores := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(keys))
+	for name, key := range keys {
+		store := parent.GetKVStore(key)
+
+		// transient and memory stores are not part of the app hash
+		switch rms.GetCommitKVStore(key).GetStoreType() {
+		case storetypes.StoreTypeTransient, storetypes.StoreTypeMemory:
+		default:
+			store = recordingStore{KVStore: store, name: name, changes: ms.changes}
+		}
+
+		stores[key] = store
+	}
+	ms.Store = cachemulti.NewFromKVStore(dbadapter.Store{DB: dbm.NewMemDB()}, stores, keys, nil, nil)
+
+	return ms, true
+}
+
+// Write flushes the branch to its parent, then the parent to its own parent.
+func (ms *changeSetMultiStore) Write() {
+	ms.Store.Write()
+	ms.parent.Write()
+}
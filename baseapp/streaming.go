@@ -20,6 +20,12 @@ const (
 	StreamingABCIPluginTomlKey        = "plugin"
 	StreamingABCIKeysTomlKey          = "keys"
 	StreamingABCIStopNodeOnErrTomlKey = "stop-node-on-err"
+
+	StreamingIPCTomlKey            = "ipc"
+	StreamingIPCAddressTomlKey     = "address"
+	StreamingIPCKeysTomlKey        = "keys"
+	StreamingIPCBufferSizeTomlKey  = "buffer-size"
+	StreamingIPCSendTimeoutTomlKey = "send-timeout"
 )
 
 // RegisterStreamingServices registers streaming services with the BaseApp.
@@ -41,6 +47,36 @@ func (app *BaseApp) RegisterStreamingServices(appOpts servertypes.AppOptions, ke
 		}
 	}
 
+	return app.registerIPCPublisher(appOpts, keys)
+}
+
+// registerIPCPublisher registers the publisher streaming the results of the
+// committed blocks to the local sidecars, if a socket address is configured.
+func (app *BaseApp) registerIPCPublisher(appOpts servertypes.AppOptions, keys map[string]*storetypes.KVStoreKey) error {
+	ipcKey := func(key string) string {
+		return fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingIPCTomlKey, key)
+	}
+
+	address := strings.TrimSpace(cast.ToString(appOpts.Get(ipcKey(StreamingIPCAddressTomlKey))))
+	if address == "" {
+		return nil
+	}
+
+	publisher, err := NewIPCPublisher(
+		app.logger,
+		address,
+		cast.ToInt(appOpts.Get(ipcKey(StreamingIPCBufferSizeTomlKey))),
+		cast.ToDuration(appOpts.Get(ipcKey(StreamingIPCSendTimeoutTomlKey))),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to register ipc streaming: %w", err)
+	}
+
+	exposedKeys := exposeStoreKeysSorted(cast.ToStringSlice(appOpts.Get(ipcKey(StreamingIPCKeysTomlKey))), keys)
+	app.cms.AddListeners(exposedKeys)
+	app.ipcPublisher = publisher
+	app.streamingManager.ABCIListeners = append(app.streamingManager.ABCIListeners, publisher)
+
 	return nil
 }
 
@@ -1,14 +1,23 @@
 package baseapp_test
 
 import (
+	"bufio"
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -146,3 +155,74 @@ func Test_Ctx_with_StreamingManager(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+func TestIPCPublisher(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.sock")
+	publisher, err := baseapp.NewIPCPublisher(log.NewNopLogger(), path, 0, 0)
+	require.NoError(t, err)
+
+	conn, err := net.Dial("unix", path)
+	require.NoError(t, err)
+	defer conn.Close()
+	require.Eventually(t, func() bool { return publisher.NumSubscribers() == 1 }, time.Second, 10*time.Millisecond)
+
+	req := abci.RequestFinalizeBlock{Height: 3, Txs: [][]byte{[]byte("tx1"), []byte("tx2")}}
+	res := abci.ResponseFinalizeBlock{
+		TxResults: []*abci.ExecTxResult{
+			{Code: 0, GasUsed: 10, Events: []abci.Event{{Type: "transfer"}}},
+			{Code: 5, Codespace: "sdk", Log: "insufficient funds"},
+		},
+		AppHash: []byte{0xab},
+	}
+	require.NoError(t, publisher.ListenFinalizeBlock(context.Background(), req, res))
+	changeSet := []*storetypes.StoreKVPair{
+		{StoreKey: "bank", Key: []byte("k1"), Value: []byte("v1")},
+		{StoreKey: "acc", Key: []byte("k2"), Delete: true},
+		{StoreKey: "bank", Key: []byte("k3"), Value: []byte("v3")},
+	}
+	require.NoError(t, publisher.ListenCommit(context.Background(), abci.ResponseCommit{}, changeSet))
+
+	line, err := bufio.NewReader(conn).ReadBytes('\n')
+	require.NoError(t, err)
+	var block baseapp.IPCBlockResult
+	require.NoError(t, json.Unmarshal(line, &block))
+
+	require.Equal(t, int64(3), block.Height)
+	require.Equal(t, "AB", block.AppHash)
+	require.Len(t, block.TxResults, 2)
+	require.Equal(t, fmt.Sprintf("%X", sha256.Sum256([]byte("tx2"))), block.TxResults[1].Hash)
+	require.Equal(t, uint32(5), block.TxResults[1].Code)
+	require.Equal(t, "insufficient funds", block.TxResults[1].Log)
+	require.Equal(t, "transfer", block.TxResults[0].Events[0].Type)
+	require.Equal(t, []baseapp.IPCStoreChanges{
+		{Store: "acc", IPCStateChangeStats: baseapp.IPCStateChangeStats{Deletes: 1, BytesWritten: 2}},
+		{Store: "bank", IPCStateChangeStats: baseapp.IPCStateChangeStats{Sets: 2, BytesWritten: 8}},
+	}, block.StateChanges)
+	require.Equal(t, baseapp.IPCStateChangeStats{Sets: 2, Deletes: 1, BytesWritten: 10}, block.Summary)
+
+	require.NoError(t, publisher.Close())
+	_, err = os.Stat(path)
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestIPCPublisher_SlowSidecar(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.sock")
+	publisher, err := baseapp.NewIPCPublisher(log.NewNopLogger(), path, 1, 10*time.Millisecond)
+	require.NoError(t, err)
+	defer publisher.Close()
+
+	// the sidecar never reads the block results
+	conn, err := net.Dial("unix", path)
+	require.NoError(t, err)
+	defer conn.Close()
+	require.Eventually(t, func() bool { return publisher.NumSubscribers() == 1 }, time.Second, 10*time.Millisecond)
+
+	res := abci.ResponseFinalizeBlock{TxResults: []*abci.ExecTxResult{{Log: strings.Repeat("x", 1<<20)}}}
+	for height := int64(1); height <= 10 && publisher.NumSubscribers() > 0; height++ {
+		req := abci.RequestFinalizeBlock{Height: height, Txs: [][]byte{[]byte("tx")}}
+		require.NoError(t, publisher.ListenFinalizeBlock(context.Background(), req, res))
+		require.NoError(t, publisher.ListenCommit(context.Background(), abci.ResponseCommit{}, nil))
+	}
+
+	require.Equal(t, 0, publisher.NumSubscribers())
+}
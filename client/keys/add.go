@@ -38,6 +38,7 @@ const (
 	flagHDPath       = "hd-path"
 	flagPubKeyBase64 = "pubkey-base64"
 	flagIndiscreet   = "indiscreet"
+	flagWatchOnly    = "watch-only"
 
 	// DefaultKeyPass contains the default key password for genesis transactions
 	DefaultKeyPass = "12345678"
@@ -59,6 +60,9 @@ If run with --dry-run, a key would be generated (or recovered) but not stored to
 local keystore.
 Use the --pubkey flag to add arbitrary public keys to the keystore for constructing
 multisig transactions.
+Use the --watch-only flag together with --address or --pubkey to track an account that
+can never sign. Watch-only keys can be used for queries, for building transactions with
+--generate-only and, when a public key is known, for assembling multisig keys.
 
 You can create and store a multisig key by passing the list of key names stored in a keyring
 and the minimum number of signatures required through --multisig-threshold. The keys are
@@ -66,6 +70,7 @@ sorted by address, unless the flag --nosort is set.
 Example:
 
     keys add mymultisig --multisig "keyname1,keyname2,keyname3" --multisig-threshold 2
+    keys add treasury --watch-only --address cosmos1...
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: runAddCmdPrepare,
@@ -76,6 +81,8 @@ Example:
 	f.Bool(flagNoSort, false, "Keys passed to --multisig are taken in the order they're supplied")
 	f.String(FlagPublicKey, "", "Parse a public key in JSON format and saves key info to <name> file.")
 	f.String(flagPubKeyBase64, "", "Parse a public key in base64 format and saves key info.")
+	f.Bool(flagWatchOnly, false, "Store the key as watch-only; it can never be used for signing. Requires --address or --pubkey")
+	f.String(FlagAddress, "", "Address of the account to watch. For use in conjunction with --watch-only")
 	f.BoolP(flagInteractive, "i", false, "Interactively prompt user for BIP39 passphrase and mnemonic")
 	f.Bool(flags.FlagUseLedger, false, "Store a local reference to a private key on a Ledger device")
 	f.Bool(flagRecover, false, "Provide seed phrase to recover existing key instead of creating")
@@ -208,18 +215,12 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 	if pubKey != "" && pubKeyBase64 != "" {
 		return fmt.Errorf(`flags %s and %s cannot be used simultaneously`, FlagPublicKey, flagPubKeyBase64)
 	}
+
+	var pk cryptotypes.PubKey
 	if pubKey != "" {
-		var pk cryptotypes.PubKey
 		if err = ctx.Codec.UnmarshalInterfaceJSON([]byte(pubKey), &pk); err != nil {
 			return err
 		}
-
-		k, err := kb.SaveOfflineKey(name, pk)
-		if err != nil {
-			return err
-		}
-
-		return printCreate(ctx, cmd, k, false, false, "", outputFormat)
 	}
 	if pubKeyBase64 != "" {
 		b64, err := base64.StdEncoding.DecodeString(pubKeyBase64)
@@ -227,8 +228,6 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 			return err
 		}
 
-		var pk cryptotypes.PubKey
-
 		// create an empty seckp256k1 pubkey since it is the key returned by algo Generate function.
 		enotySecpPubKey, err := codectypes.NewAnyWithValue(&secp256k1.PubKey{})
 		if err != nil {
@@ -246,7 +245,21 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 		if err = ctx.Codec.UnmarshalInterfaceJSON(jsonPub, &pk); err != nil {
 			return err
 		}
+	}
+
+	if watchOnly, _ := cmd.Flags().GetBool(flagWatchOnly); watchOnly {
+		k, err := addWatchOnlyKey(ctx, cmd, kb, name, pk)
+		if err != nil {
+			return err
+		}
 
+		return printCreate(ctx, cmd, k, false, false, "", outputFormat)
+	}
+	if addrStr, _ := cmd.Flags().GetString(FlagAddress); addrStr != "" {
+		return fmt.Errorf("flag %s can only be used with --%s", FlagAddress, flagWatchOnly)
+	}
+
+	if pk != nil {
 		k, err := kb.SaveOfflineKey(name, pk)
 		if err != nil {
 			return fmt.Errorf("failed to save offline key: %w", err)
@@ -356,6 +369,28 @@ func runAddCmd(ctx client.Context, cmd *cobra.Command, args []string, inBuf *buf
 	return printCreate(ctx, cmd, k, showMnemonic, showMnemonicIndiscreetly, mnemonic, outputFormat)
 }
 
+// addWatchOnlyKey stores a watch-only entry built from the --address flag
+// and/or the given public key. When both are provided they must match.
+func addWatchOnlyKey(ctx client.Context, cmd *cobra.Command, kb keyring.Keyring, name string, pk cryptotypes.PubKey) (*keyring.Record, error) {
+	addrStr, _ := cmd.Flags().GetString(FlagAddress)
+	if addrStr == "" && pk == nil {
+		return nil, fmt.Errorf("--%s requires either --%s or --%s", flagWatchOnly, FlagAddress, FlagPublicKey)
+	}
+
+	var addr sdk.AccAddress
+	if addrStr != "" {
+		bz, err := ctx.AddressCodec.StringToBytes(addrStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address %s: %w", addrStr, err)
+		}
+		addr = bz
+	} else {
+		addr = pk.Address().Bytes()
+	}
+
+	return kb.SaveWatchOnlyKey(name, addr, pk)
+}
+
 func printCreate(ctx client.Context, cmd *cobra.Command, k *keyring.Record, showMnemonic, showMnemonicIndiscreetly bool, mnemonic, outputFormat string) error {
 	switch outputFormat {
 	case flags.OutputFormatText:
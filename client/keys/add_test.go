@@ -346,6 +346,68 @@ func Test_runAddCmdDryRun(t *testing.T) {
 	}
 }
 
+func Test_runAddCmdWatchOnly(t *testing.T) {
+	pubkey := `{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"AtObiFVE4s+9+RX5SP8TN9r2mxpoaT4eGj9CJfK7VRzN"}`
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	addrCodec := addresscodec.NewBech32Codec("cosmos")
+	addr, err := addrCodec.BytesToString(sdk.AccAddress("watched_address_____"))
+	require.NoError(t, err)
+
+	cmd := AddKeyCommand()
+	cmd.Flags().AddFlagSet(Commands().PersistentFlags())
+
+	kbHome := t.TempDir()
+	mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, mockIn, cdc)
+	require.NoError(t, err)
+
+	clientCtx := client.Context{}.
+		WithCodec(cdc).
+		WithKeyringDir(kbHome).
+		WithKeyring(kb).
+		WithAddressCodec(addrCodec).
+		WithValidatorAddressCodec(addresscodec.NewBech32Codec("cosmosvaloper")).
+		WithConsensusAddressCodec(addresscodec.NewBech32Codec("cosmosvalcons"))
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	// --watch-only requires an address or a public key
+	cmd.SetArgs([]string{"watched", fmt.Sprintf("--%s", flagWatchOnly)})
+	require.ErrorContains(t, cmd.ExecuteContext(ctx), "requires either")
+
+	// --address is only valid for watch-only keys
+	cmd.SetArgs([]string{"watched", fmt.Sprintf("--%s=false", flagWatchOnly), fmt.Sprintf("--%s=%s", FlagAddress, addr)})
+	require.ErrorContains(t, cmd.ExecuteContext(ctx), "can only be used with")
+
+	b := bytes.NewBufferString("")
+	cmd.SetOut(b)
+	cmd.SetArgs([]string{"watched", fmt.Sprintf("--%s", flagWatchOnly), fmt.Sprintf("--%s=%s", FlagAddress, addr)})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	out, err := io.ReadAll(b)
+	require.NoError(t, err)
+	require.Contains(t, string(out), "type: watch-only")
+	require.Contains(t, string(out), addr)
+
+	k, err := kb.Key("watched")
+	require.NoError(t, err)
+	require.Equal(t, keyring.TypeWatchOnly, k.GetType())
+
+	cmd.SetArgs([]string{"watchedpk", fmt.Sprintf("--%s", flagWatchOnly), fmt.Sprintf("--%s=", FlagAddress), fmt.Sprintf("--%s=%s", FlagPublicKey, pubkey)})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+	k, err = kb.Key("watchedpk")
+	require.NoError(t, err)
+	require.Equal(t, keyring.TypeWatchOnly, k.GetType())
+
+	// watch-only keys with a known public key can be part of a multisig
+	cmd.SetArgs([]string{"multi", fmt.Sprintf("--%s=%s", flagMultisig, "watchedpk")})
+	require.NoError(t, cmd.ExecuteContext(ctx))
+
+	// but not those that were imported from a bare address
+	cmd.SetArgs([]string{"multi2", fmt.Sprintf("--%s=%s", flagMultisig, "watched,watchedpk"), fmt.Sprintf("--%s=%d", flagMultiSigThreshold, 2)})
+	require.ErrorIs(t, cmd.ExecuteContext(ctx), keyring.ErrPubKeyNotAvailable)
+}
+
 func TestAddRecoverFileBackend(t *testing.T) {
 	cmd := AddKeyCommand()
 	cmd.Flags().AddFlagSet(Commands().PersistentFlags())
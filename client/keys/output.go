@@ -21,16 +21,21 @@ type KeyOutput struct {
 	Mnemonic string `json:"mnemonic,omitempty" yaml:"mnemonic"`
 }
 
-// NewKeyOutput creates a default KeyOutput instance without Mnemonic, Threshold and PubKeys
+// NewKeyOutput creates a default KeyOutput instance without Mnemonic, Threshold and PubKeys.
+// A nil public key, as held by watch-only keys imported from an address, is
+// rendered as an empty string.
 func NewKeyOutput(name string, keyType keyring.KeyType, addr []byte, pk cryptotypes.PubKey, addressCodec address.Codec) (KeyOutput, error) {
-	apk, err := codectypes.NewAnyWithValue(pk)
-	if err != nil {
-		return KeyOutput{}, err
-	}
+	var bz []byte
+	if pk != nil {
+		apk, err := codectypes.NewAnyWithValue(pk)
+		if err != nil {
+			return KeyOutput{}, err
+		}
 
-	bz, err := codec.ProtoMarshalJSON(apk, nil)
-	if err != nil {
-		return KeyOutput{}, err
+		bz, err = codec.ProtoMarshalJSON(apk, nil)
+		if err != nil {
+			return KeyOutput{}, err
+		}
 	}
 
 	addrStr, err := addressCodec.BytesToString(addr)
@@ -57,18 +62,23 @@ func MkConsKeyOutput(k *keyring.Record, consensusAddressCodec address.Codec) (Ke
 
 // MkValKeyOutput create a KeyOutput for validator addresses.
 func MkValKeyOutput(k *keyring.Record, validatorAddressCodec address.Codec) (KeyOutput, error) {
-	pk, err := k.GetPubKey()
-	if err != nil {
-		return KeyOutput{}, err
-	}
-
-	return NewKeyOutput(k.Name, k.GetType(), pk.Address(), pk, validatorAddressCodec)
+	return mkKeyOutputFromAddress(k, validatorAddressCodec)
 }
 
 // MkAccKeyOutput create a KeyOutput in with "acc" Bech32 prefixes. If the
 // public key is a multisig public key, then the threshold and constituent
 // public keys will be added.
 func MkAccKeyOutput(k *keyring.Record, addressCodec address.Codec) (KeyOutput, error) {
+	return mkKeyOutputFromAddress(k, addressCodec)
+}
+
+// mkKeyOutputFromAddress creates a KeyOutput from the record address, leaving
+// the public key empty for watch-only records that were imported without one.
+func mkKeyOutputFromAddress(k *keyring.Record, addressCodec address.Codec) (KeyOutput, error) {
+	if w := k.GetWatchOnly(); w != nil && k.PubKey == nil {
+		return NewKeyOutput(k.Name, k.GetType(), w.Address, nil, addressCodec)
+	}
+
 	pk, err := k.GetPubKey()
 	if err != nil {
 		return KeyOutput{}, err
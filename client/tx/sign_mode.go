@@ -0,0 +1,157 @@
+package tx
+
+import (
+	"fmt"
+
+	apisigning "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	authsigning "cosmossdk.io/x/auth/signing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// NegotiateSignMode returns the sign mode a key signs with when none is set in
+// the Factory. Ledger keys sign with SIGN_MODE_TEXTUAL, which the device
+// displays, or SIGN_MODE_LEGACY_AMINO_JSON if textual isn't enabled. Multisig
+// keys sign with SIGN_MODE_LEGACY_AMINO_JSON, and software keys with the
+// default sign mode of the tx config.
+func NegotiateSignMode(txConfig client.TxConfig, k *keyring.Record) (signing.SignMode, error) {
+	handler := txConfig.SignModeHandler()
+
+	switch k.GetType() {
+	case keyring.TypeLedger:
+		for _, mode := range handler.SupportedModes() {
+			if mode == apisigning.SignMode_SIGN_MODE_TEXTUAL {
+				return signing.SignMode_SIGN_MODE_TEXTUAL, nil
+			}
+		}
+		return signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, nil
+	case keyring.TypeMulti:
+		return signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, nil
+	default:
+		return authsigning.APISignModeToInternal(handler.DefaultMode())
+	}
+}
+
+// PrepareSigners sets the signer infos of all the signers of a tx, with their
+// sign modes and sequences but without signatures. As the auth info signed over
+// by SIGN_MODE_DIRECT and SIGN_MODE_TEXTUAL doesn't change anymore, the signers
+// can then sign in any order and with mixed sign modes, and their partially
+// signed txs can be combined with MergeSignatures.
+func PrepareSigners(txBuilder client.TxBuilder, signers ...signing.SignatureV2) error {
+	placeholders := make([]signing.SignatureV2, len(signers))
+	for i, signer := range signers {
+		data, ok := signer.Data.(*signing.SingleSignatureData)
+		if !ok {
+			return fmt.Errorf("expected single signature data for signer %s, got %T", signer.PubKey.Address(), signer.Data)
+		}
+
+		placeholders[i] = signing.SignatureV2{
+			PubKey:   signer.PubKey,
+			Data:     &signing.SingleSignatureData{SignMode: data.SignMode},
+			Sequence: signer.Sequence,
+		}
+	}
+
+	return txBuilder.SetSignatures(placeholders...)
+}
+
+// MergeSignatures adds signatures, such as the ones of partially signed copies
+// of a tx, to the tx in any order. A signature fills the signer info of its
+// public key if the tx already has one, which must have the same sign mode and
+// sequence, and is appended otherwise. Signatures without signature bytes are
+// ignored.
+func MergeSignatures(txBuilder client.TxBuilder, sigs ...signing.SignatureV2) error {
+	prevSignatures, err := txBuilder.GetTx().GetSignaturesV2()
+	if err != nil {
+		return err
+	}
+
+	for _, sig := range sigs {
+		if !hasSignatureBytes(sig.Data) {
+			continue
+		}
+
+		i := signerIndex(prevSignatures, sig.PubKey)
+		if i < 0 {
+			if err := checkSignedAuthInfo(prevSignatures); err != nil {
+				return err
+			}
+			prevSignatures = append(prevSignatures, sig)
+			continue
+		}
+
+		if err := checkSignerInfo(prevSignatures[i], sig); err != nil {
+			return err
+		}
+		prevSignatures[i] = sig
+	}
+
+	return txBuilder.SetSignatures(prevSignatures...)
+}
+
+// signerIndex returns the index of the signature of a public key, or -1 if the
+// public key has no signature.
+func signerIndex(sigs []signing.SignatureV2, pubKey cryptotypes.PubKey) int {
+	for i, sig := range sigs {
+		if sig.PubKey != nil && sig.PubKey.Equals(pubKey) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// checkSignerInfo checks that a signature matches the signer info it fills, so
+// that the auth info signed over by the other signers doesn't change.
+func checkSignerInfo(signerInfo, sig signing.SignatureV2) error {
+	if signerInfo.Sequence != sig.Sequence {
+		return sdkerrors.ErrInvalidSequence.Wrapf("signature of %s has sequence %d, expected %d", sig.PubKey.Address(), sig.Sequence, signerInfo.Sequence)
+	}
+
+	expected, ok := signerInfo.Data.(*signing.SingleSignatureData)
+	if !ok {
+		return nil
+	}
+	if data, ok := sig.Data.(*signing.SingleSignatureData); !ok || data.SignMode != expected.SignMode {
+		return sdkerrors.ErrNotSupported.Wrapf("signature of %s doesn't use the sign mode of its signer info %s", sig.PubKey.Address(), expected.SignMode)
+	}
+
+	return nil
+}
+
+// checkSignedAuthInfo checks that adding a signer info doesn't invalidate the
+// signatures over the auth info, i.e. the SIGN_MODE_DIRECT and
+// SIGN_MODE_TEXTUAL ones, of the signers who already signed.
+func checkSignedAuthInfo(sigs []signing.SignatureV2) error {
+	for _, sig := range sigs {
+		data, ok := sig.Data.(*signing.SingleSignatureData)
+		if !ok || len(data.Signature) == 0 {
+			continue
+		}
+
+		if data.SignMode == signing.SignMode_SIGN_MODE_DIRECT || data.SignMode == signing.SignMode_SIGN_MODE_TEXTUAL {
+			return sdkerrors.ErrNotSupported.Wrapf(
+				"adding a signer invalidates the %s signature of %s; set all the signers with PrepareSigners before signing",
+				data.SignMode, sig.PubKey.Address(),
+			)
+		}
+	}
+
+	return nil
+}
+
+// hasSignatureBytes returns whether a signature data holds a signature.
+func hasSignatureBytes(data signing.SignatureData) bool {
+	switch data := data.(type) {
+	case *signing.SingleSignatureData:
+		return len(data.Signature) > 0
+	case *signing.MultiSignatureData:
+		return len(data.Signatures) > 0
+	default:
+		return false
+	}
+}
@@ -15,9 +15,9 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
@@ -203,76 +203,51 @@ func SignWithPrivKey(
 	return sigV2, nil
 }
 
-// countDirectSigners counts the number of DIRECT signers in a signature data.
-func countDirectSigners(data signing.SignatureData) int {
-	switch data := data.(type) {
-	case *signing.SingleSignatureData:
-		if data.SignMode == signing.SignMode_SIGN_MODE_DIRECT {
-			return 1
-		}
-
-		return 0
-	case *signing.MultiSignatureData:
-		directSigners := 0
-		for _, d := range data.Signatures {
-			directSigners += countDirectSigners(d)
-		}
-
-		return directSigners
-	default:
-		panic("unreachable case")
-	}
-}
-
-// checkMultipleSigners checks that there can be maximum one DIRECT signer in
-// a tx.
-func checkMultipleSigners(tx authsigning.Tx) error {
-	directSigners := 0
-	sigsV2, err := tx.GetSignaturesV2()
-	if err != nil {
-		return err
-	}
-	for _, sig := range sigsV2 {
-		directSigners += countDirectSigners(sig.Data)
-		if directSigners > 1 {
-			return sdkerrors.ErrNotSupported.Wrap("txs signed with CLI can have maximum 1 DIRECT signer")
-		}
-	}
-
-	return nil
-}
-
 // Sign signs a given tx with a named key. The bytes signed over are canconical.
 // The resulting signature will be added to the transaction builder overwriting the previous
-// ones if overwrite=true (otherwise, the signature will be appended).
-// Signing a transaction with mutltiple signers in the DIRECT mode is not supported and will
-// return an error.
+// ones if overwrite=true (otherwise, the signature will be appended, or fill the signer info
+// of the key if the tx already has one, e.g. set with PrepareSigners).
+// When the Factory has no sign mode, the sign mode of the signer info of the key is used, or
+// one is negotiated for the key with NegotiateSignMode.
+// Appending a signature fails if it would invalidate the DIRECT or TEXTUAL signature of an
+// earlier signer, as these sign over the signer infos of all the signers.
 // An error is returned upon failure.
 func Sign(ctx context.Context, txf Factory, name string, txBuilder client.TxBuilder, overwriteSig bool) error {
 	if txf.keybase == nil {
 		return errors.New("keybase must be set prior to signing a transaction")
 	}
 
-	var err error
-	signMode := txf.signMode
-	if signMode == signing.SignMode_SIGN_MODE_UNSPECIFIED {
-		// use the SignModeHandler's default mode if unspecified
-		signMode, err = authsigning.APISignModeToInternal(txf.txConfig.SignModeHandler().DefaultMode())
-		if err != nil {
-			return err
-		}
-	}
-
 	k, err := txf.keybase.Key(name)
 	if err != nil {
 		return err
 	}
+	if k.GetType() == keyring.TypeWatchOnly {
+		return fmt.Errorf("%s: %w", name, keyring.ErrWatchOnlySign)
+	}
 
 	pubKey, err := k.GetPubKey()
 	if err != nil {
 		return err
 	}
 
+	var prevSignatures []signing.SignatureV2
+	if !overwriteSig {
+		prevSignatures, err = txBuilder.GetTx().GetSignaturesV2()
+		if err != nil {
+			return err
+		}
+	}
+	signerIdx := signerIndex(prevSignatures, pubKey)
+
+	signMode := txf.signMode
+	if signMode == signing.SignMode_SIGN_MODE_UNSPECIFIED {
+		if data, ok := signerSignatureData(prevSignatures, signerIdx); ok {
+			signMode = data.SignMode
+		} else if signMode, err = NegotiateSignMode(txf.txConfig, k); err != nil {
+			return err
+		}
+	}
+
 	signerData := authsigning.SignerData{
 		ChainID:       txf.chainID,
 		AccountNumber: txf.accountNumber,
@@ -299,29 +274,31 @@ func Sign(ctx context.Context, txf Factory, name string, txBuilder client.TxBuil
 		Sequence: txf.Sequence(),
 	}
 
-	var prevSignatures []signing.SignatureV2
-	if !overwriteSig {
-		prevSignatures, err = txBuilder.GetTx().GetSignaturesV2()
-		if err != nil {
-			return err
-		}
-	}
-	// Overwrite or append signer infos.
+	// Overwrite, fill or append signer infos.
 	var sigs []signing.SignatureV2
-	if overwriteSig {
+	switch {
+	case overwriteSig:
 		sigs = []signing.SignatureV2{sig}
-	} else {
-		sigs = append(sigs, prevSignatures...)
-		sigs = append(sigs, sig)
+		signerIdx = 0
+	case signerIdx >= 0:
+		// the signer infos don't change, so the signatures of the other
+		// signers over them stay valid
+		if err := checkSignerInfo(prevSignatures[signerIdx], sig); err != nil {
+			return err
+		}
+		sigs = prevSignatures
+		sigs[signerIdx] = sig
+	default:
+		if err := checkSignedAuthInfo(prevSignatures); err != nil {
+			return err
+		}
+		sigs = append(prevSignatures, sig)
+		signerIdx = len(sigs) - 1
 	}
 	if err := txBuilder.SetSignatures(sigs...); err != nil {
 		return err
 	}
 
-	if err := checkMultipleSigners(txBuilder.GetTx()); err != nil {
-		return err
-	}
-
 	bytesToSign, err := authsigning.GetSignBytesAdapter(ctx, txf.txConfig.SignModeHandler(), signMode, signerData, txBuilder.GetTx())
 	if err != nil {
 		return err
@@ -338,20 +315,13 @@ func Sign(ctx context.Context, txf Factory, name string, txBuilder client.TxBuil
 		SignMode:  signMode,
 		Signature: sigBytes,
 	}
-	sig = signing.SignatureV2{
+	sigs[signerIdx] = signing.SignatureV2{
 		PubKey:   pubKey,
 		Data:     &sigData,
 		Sequence: txf.Sequence(),
 	}
 
-	if overwriteSig {
-		err = txBuilder.SetSignatures(sig)
-	} else {
-		prevSignatures = append(prevSignatures, sig)
-		err = txBuilder.SetSignatures(prevSignatures...)
-	}
-
-	if err != nil {
+	if err := txBuilder.SetSignatures(sigs...); err != nil {
 		return fmt.Errorf("unable to set signatures on payload: %w", err)
 	}
 
@@ -360,6 +330,17 @@ func Sign(ctx context.Context, txf Factory, name string, txBuilder client.TxBuil
 	return txf.PreprocessTx(name, txBuilder)
 }
 
+// signerSignatureData returns the single signature data of the signer at the
+// given index, if any.
+func signerSignatureData(sigs []signing.SignatureV2, i int) (*signing.SingleSignatureData, bool) {
+	if i < 0 {
+		return nil, false
+	}
+
+	data, ok := sigs[i].Data.(*signing.SingleSignatureData)
+	return data, ok
+}
+
 // GasEstimateResponse defines a response definition for tx gas estimation.
 type GasEstimateResponse struct {
 	GasEstimate uint64 `json:"gas_estimate" yaml:"gas_estimate"`
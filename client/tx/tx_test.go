@@ -368,6 +368,75 @@ func TestSign(t *testing.T) {
 	}
 }
 
+func TestSignMixedModesOutOfOrder(t *testing.T) {
+	txConfig, cdc := newTestTxConfig()
+	requireT := require.New(t)
+	path := hd.CreateHDPath(118, 0, 0).String()
+	kb, err := keyring.New(t.Name(), "test", t.TempDir(), nil, cdc)
+	requireT.NoError(err)
+
+	k1, _, err := kb.NewMnemonic("test_key1", keyring.English, path, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	requireT.NoError(err)
+	k2, _, err := kb.NewMnemonic("test_key2", keyring.English, path, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	requireT.NoError(err)
+	pubKey1, err := k1.GetPubKey()
+	requireT.NoError(err)
+	pubKey2, err := k2.GetPubKey()
+	requireT.NoError(err)
+	addr1, err := k1.GetAddress()
+	requireT.NoError(err)
+	addr2, err := k2.GetAddress()
+	requireT.NoError(err)
+
+	// software keys negotiate the default sign mode of the tx config
+	mode, err := NegotiateSignMode(txConfig, k1)
+	requireT.NoError(err)
+	requireT.Equal(signingtypes.SignMode_SIGN_MODE_DIRECT, mode)
+
+	txf := mockTxFactory(txConfig).WithKeybase(kb)
+	msg1 := &countertypes.MsgIncreaseCounter{Signer: addr1.String(), Count: 1}
+	msg2 := &countertypes.MsgIncreaseCounter{Signer: addr2.String(), Count: 1}
+	signers := []signingtypes.SignatureV2{
+		{PubKey: pubKey1, Data: &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_DIRECT}, Sequence: txf.Sequence()},
+		{PubKey: pubKey2, Data: &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON}, Sequence: txf.Sequence()},
+	}
+
+	// each signer signs its own copy of the tx, the second one first
+	txb1, err := txf.BuildUnsignedTx(msg1, msg2)
+	requireT.NoError(err)
+	requireT.NoError(PrepareSigners(txb1, signers...))
+	txb2, err := txf.BuildUnsignedTx(msg1, msg2)
+	requireT.NoError(err)
+	requireT.NoError(PrepareSigners(txb2, signers...))
+
+	requireT.NoError(Sign(context.TODO(), txf, "test_key2", txb2, false))
+	requireT.NoError(Sign(context.TODO(), txf, "test_key1", txb1, false))
+
+	// the sign mode of a signer info can't be changed
+	requireT.Error(Sign(context.TODO(), txf.WithSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT), "test_key2", txb2, false))
+
+	sigs2, err := txb2.GetTx().GetSignaturesV2()
+	requireT.NoError(err)
+	sigs1 := testSigners(requireT, txb1.GetTx(), pubKey1, pubKey2)
+	requireT.NoError(MergeSignatures(txb1, sigs2...))
+
+	sigs := testSigners(requireT, txb1.GetTx(), pubKey1, pubKey2)
+	requireT.Equal(sigs1[0], sigs[0])
+	requireT.Equal(sigs2[1], sigs[1])
+	for i, sig := range sigs {
+		data, ok := sig.Data.(*signingtypes.SingleSignatureData)
+		requireT.True(ok)
+		requireT.Equal(signers[i].Data.(*signingtypes.SingleSignatureData).SignMode, data.SignMode)
+		requireT.NotEmpty(data.Signature)
+	}
+
+	// a new signer can't be appended once a DIRECT signature is set
+	txb3, err := txf.BuildUnsignedTx(msg1, msg2)
+	requireT.NoError(err)
+	requireT.NoError(Sign(context.TODO(), txf.WithSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT), "test_key1", txb3, false))
+	requireT.Error(MergeSignatures(txb3, sigs2[1]))
+}
+
 func TestPreprocessHook(t *testing.T) {
 	_, _, addr2 := testdata.KeyTestPubAddr()
 
@@ -1,6 +1,8 @@
 package client
 
 import (
+	"time"
+
 	"cosmossdk.io/x/auth/signing"
 	txsigning "cosmossdk.io/x/tx/signing"
 
@@ -49,6 +51,7 @@ type (
 		SetGasLimit(limit uint64)
 		SetTimeoutHeight(height uint64)
 		SetUnordered(v bool)
+		SetTimeoutTimestamp(timestamp time.Time)
 		SetFeeGranter(feeGranter sdk.AccAddress)
 		AddAuxSignerData(tx.AuxSignerData) error
 	}
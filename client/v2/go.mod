@@ -175,6 +175,7 @@ replace github.com/cosmos/cosmos-sdk => ./../../
 
 replace (
 	cosmossdk.io/api => ./../../api
+	cosmossdk.io/collections => ./../../collections
 	cosmossdk.io/core => ./../../core
 	cosmossdk.io/depinject => ./../../depinject
 	cosmossdk.io/x/accounts => ./../../x/accounts
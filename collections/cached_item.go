@@ -0,0 +1,96 @@
+package collections
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"cosmossdk.io/collections/codec"
+)
+
+// CachedItem is an Item which caches its decoded value. It is meant for values
+// read much more often than they are written, such as module params, which hot
+// paths like begin and end blockers or ante handlers would otherwise decode
+// again on every read.
+//
+// The value bytes are still read from the store on every Get, so that the gas
+// consumed does not depend on the state of the cache, and the cached value is
+// only returned if its bytes are equal to the stored ones. This keeps the cache
+// consistent with branched stores, whose writes may be discarded, and across
+// blocks. The cache is invalidated on Set and Remove.
+//
+// The cached value is shared between the callers of Get and must not be
+// mutated: values holding slices, maps or pointers must be copied before being
+// modified.
+type CachedItem[V any] struct {
+	Item[V]
+
+	cache *atomic.Pointer[cachedValue[V]]
+}
+
+// cachedValue is a decoded value along with its encoded bytes.
+type cachedValue[V any] struct {
+	bytes []byte
+	value V
+}
+
+// NewCachedItem instantiates a new CachedItem instance, given the value encoder of the item V.
+// Name and prefix must be unique within the schema and name must match the format specified by NameRegex, or
+// else this method will panic.
+func NewCachedItem[V any](
+	schema *SchemaBuilder,
+	prefix Prefix,
+	name string,
+	valueCodec codec.ValueCodec[V],
+) CachedItem[V] {
+	return CachedItem[V]{
+		Item:  NewItem(schema, prefix, name, valueCodec),
+		cache: new(atomic.Pointer[cachedValue[V]]),
+	}
+}
+
+// Get gets the item, if it is not set it returns an ErrNotFound error.
+// The value is only decoded if its bytes differ from the ones of the cached
+// value. If value decoding fails then an ErrEncoding is returned.
+func (i CachedItem[V]) Get(ctx context.Context) (v V, err error) {
+	m := (Map[noKey, V])(i.Item)
+	bytesKey, err := EncodeKeyWithPrefix(m.prefix, m.kc, noKey{})
+	if err != nil {
+		return v, err
+	}
+
+	valueBytes, err := m.sa(ctx).Get(bytesKey)
+	if err != nil {
+		return v, err
+	}
+	if valueBytes == nil {
+		return v, fmt.Errorf("%w: key '%s' of type %s", ErrNotFound, m.kc.Stringify(noKey{}), m.vc.ValueType())
+	}
+
+	if cached := i.cache.Load(); cached != nil && bytes.Equal(cached.bytes, valueBytes) {
+		return cached.value, nil
+	}
+
+	v, err = m.vc.Decode(valueBytes)
+	if err != nil {
+		return v, fmt.Errorf("%w: value decode: %w", ErrEncoding, err)
+	}
+
+	// the store may reuse the returned bytes
+	i.cache.Store(&cachedValue[V]{bytes: bytes.Clone(valueBytes), value: v})
+	return v, nil
+}
+
+// Set sets the item in the store and invalidates the cache. If Value encoding
+// fails then an ErrEncoding is returned.
+func (i CachedItem[V]) Set(ctx context.Context, value V) error {
+	i.cache.Store(nil)
+	return i.Item.Set(ctx, value)
+}
+
+// Remove removes the item in the store and invalidates the cache.
+func (i CachedItem[V]) Remove(ctx context.Context) error {
+	i.cache.Store(nil)
+	return i.Item.Remove(ctx)
+}
@@ -0,0 +1,75 @@
+package collections
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections/codec"
+)
+
+// decodeCounter counts the values decoded by a value codec.
+type decodeCounter[V any] struct {
+	codec.ValueCodec[V]
+	decoded int
+}
+
+func (c *decodeCounter[V]) Decode(b []byte) (V, error) {
+	c.decoded++
+	return c.ValueCodec.Decode(b)
+}
+
+func TestCachedItem(t *testing.T) {
+	sk, ctx := deps()
+	schemaBuilder := NewSchemaBuilder(sk)
+	vc := &decodeCounter[uint64]{ValueCodec: Uint64Value}
+	item := NewCachedItem[uint64](schemaBuilder, NewPrefix("item"), "item", vc)
+	_, err := schemaBuilder.Build()
+	require.NoError(t, err)
+
+	// get on an unset item
+	_, err = item.Get(ctx)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	// set, the value is decoded once
+	require.NoError(t, item.Set(ctx, 1000))
+	for j := 0; j < 3; j++ {
+		i, err := item.Get(ctx)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1000), i)
+	}
+	require.Equal(t, 1, vc.decoded)
+
+	// set invalidates the cache
+	require.NoError(t, item.Set(ctx, 2000))
+	i, err := item.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2000), i)
+	require.Equal(t, 2, vc.decoded)
+
+	// a value written to the store without the item, e.g. when a branched
+	// store whose write was cached is discarded, is decoded again
+	kvStore := sk.OpenKVStore(ctx)
+	require.NoError(t, kvStore.Set(NewPrefix("item").Bytes(), encodeValue(t, Uint64Value, 3000)))
+	i, err = item.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3000), i)
+	require.Equal(t, 3, vc.decoded)
+
+	// has
+	has, err := item.Has(ctx)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// remove
+	require.NoError(t, item.Remove(ctx))
+	_, err = item.Get(ctx)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func encodeValue[V any](t *testing.T, vc codec.ValueCodec[V], value V) []byte {
+	t.Helper()
+	b, err := vc.Encode(value)
+	require.NoError(t, err)
+	return b
+}
@@ -23,6 +23,8 @@ var (
 	ErrUnableToSerialize = errors.New("unable to serialize record")
 	// ErrOfflineSign is raised when trying to sign offline record.
 	ErrOfflineSign = errors.New("cannot sign with offline keys")
+	// ErrWatchOnlySign is raised when trying to sign with a watch-only record.
+	ErrWatchOnlySign = errors.New("cannot sign with watch-only keys")
 	// ErrDuplicatedAddress is raised when creating a key with the same address as a key that already exists.
 	ErrDuplicatedAddress = errors.New("duplicated address created")
 	// ErrLedgerGenerateKey is raised when a ledger can't generate a key
@@ -96,6 +96,10 @@ type Keyring interface {
 	// SaveMultisig stores and returns a new multsig (offline) key reference.
 	SaveMultisig(uid string, pubkey types.PubKey) (*Record, error)
 
+	// SaveWatchOnlyKey stores an address, and optionally its public key, as a
+	// watch-only entry. Watch-only entries can never be used for signing.
+	SaveWatchOnlyKey(uid string, address sdk.AccAddress, pubkey types.PubKey) (*Record, error)
+
 	Signer
 
 	Importer
@@ -407,6 +411,11 @@ func (ks keystore) Sign(uid string, msg []byte, signMode signing.SignMode) ([]by
 	case k.GetLedger() != nil:
 		return SignWithLedger(k, msg, signMode)
 
+	case k.GetWatchOnly() != nil:
+		// the public key of a watch-only record is optional
+		pub, _ := k.GetPubKey()
+		return nil, pub, ErrWatchOnlySign
+
 		// multi or offline record
 	default:
 		pub, err := k.GetPubKey()
@@ -458,6 +467,15 @@ func (ks keystore) SaveOfflineKey(uid string, pubkey types.PubKey) (*Record, err
 	return ks.writeOfflineKey(uid, pubkey)
 }
 
+func (ks keystore) SaveWatchOnlyKey(uid string, address sdk.AccAddress, pubkey types.PubKey) (*Record, error) {
+	k, err := NewWatchOnlyRecord(uid, address, pubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	return k, ks.writeRecord(k)
+}
+
 func (ks keystore) DeleteByAddress(address []byte) error {
 	k, err := ks.KeyByAddress(address)
 	if err != nil {
@@ -478,6 +496,21 @@ func (ks keystore) Rename(oldName, newName string) error {
 		return errorsmod.Wrap(ErrKeyAlreadyExists, fmt.Sprintf("rename failed, %s", newName))
 	}
 
+	k, err := ks.Key(oldName)
+	if err != nil {
+		return err
+	}
+
+	// watch-only records hold no private key, so they are copied under the new name
+	if k.GetWatchOnly() != nil {
+		if err := ks.Delete(oldName); err != nil {
+			return err
+		}
+
+		k.Name = newName
+		return ks.writeRecord(k)
+	}
+
 	armor, err := ks.ExportPrivKeyArmor(oldName, passPhrase)
 	if err != nil {
 		return err
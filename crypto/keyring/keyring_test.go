@@ -1736,6 +1736,44 @@ func TestAltKeyring_SaveOfflineKey(t *testing.T) {
 	}
 }
 
+func TestAltKeyring_SaveWatchOnlyKey(t *testing.T) {
+	cdc := getCodec()
+	kr, err := New(t.Name(), BackendTest, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+
+	pub := ed25519.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pub.Address())
+
+	k, err := kr.SaveWatchOnlyKey("watched", addr, nil)
+	require.NoError(t, err)
+	require.Equal(t, TypeWatchOnly, k.GetType())
+
+	k, err = kr.KeyByAddress(addr)
+	require.NoError(t, err)
+	require.Equal(t, "watched", k.Name)
+
+	_, _, err = kr.Sign("watched", []byte("msg"), signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, ErrWatchOnlySign)
+
+	require.NoError(t, kr.Rename("watched", "renamed"))
+	k, err = kr.Key("renamed")
+	require.NoError(t, err)
+	recAddr, err := k.GetAddress()
+	require.NoError(t, err)
+	require.Equal(t, addr, recAddr)
+
+	require.NoError(t, kr.Delete("renamed"))
+	_, err = kr.SaveWatchOnlyKey("watchedWithPubKey", addr, pub)
+	require.NoError(t, err)
+	_, signPub, err := kr.Sign("watchedWithPubKey", []byte("msg"), signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, ErrWatchOnlySign)
+	require.True(t, pub.Equals(signPub))
+
+	list, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+}
+
 func TestNonConsistentKeyring_SavePubKey(t *testing.T) {
 	cdc := getCodec()
 	kr, err := New(t.Name(), BackendTest, t.TempDir(), nil, cdc)
@@ -18,6 +18,8 @@ var (
 	ErrPrivKeyNotAvailable = errors.New("private key is not available")
 	// ErrCastAny is used to output an error if cast from types.Any fails.
 	ErrCastAny = errors.New("unable to cast to cryptotypes")
+	// ErrPubKeyNotAvailable is used when a watch-only record was imported without a public key.
+	ErrPubKeyNotAvailable = errors.New("public key is not available")
 )
 
 func newRecord(name string, pk cryptotypes.PubKey, item isRecord_Item) (*Record, error) {
@@ -67,8 +69,32 @@ func NewMultiRecord(name string, pk cryptotypes.PubKey) (*Record, error) {
 	return newRecord(name, pk, recordMultiItem)
 }
 
+// NewWatchOnlyRecord creates a new Record with watch-only item. The public key
+// is optional: watch-only entries may be imported from a bare address.
+func NewWatchOnlyRecord(name string, addr types.AccAddress, pk cryptotypes.PubKey) (*Record, error) {
+	if len(addr) == 0 {
+		return nil, errors.New("watch-only record requires an address")
+	}
+
+	recordWatchOnly := &Record_WatchOnly{Address: addr}
+	recordWatchOnlyItem := &Record_WatchOnly_{recordWatchOnly}
+	if pk == nil {
+		return &Record{Name: name, Item: recordWatchOnlyItem}, nil
+	}
+
+	if !addr.Equals(types.AccAddress(pk.Address())) {
+		return nil, errors.Newf("public key does not match address %s", addr)
+	}
+
+	return newRecord(name, pk, recordWatchOnlyItem)
+}
+
 // GetPubKey fetches a public key of the record
 func (k *Record) GetPubKey() (cryptotypes.PubKey, error) {
+	if k.PubKey == nil && k.GetWatchOnly() != nil {
+		return nil, errorsmod.Wrap(ErrPubKeyNotAvailable, k.Name)
+	}
+
 	pk, ok := k.PubKey.GetCachedValue().(cryptotypes.PubKey)
 	if !ok {
 		return nil, errorsmod.Wrap(ErrCastAny, "PubKey")
@@ -79,6 +105,10 @@ func (k *Record) GetPubKey() (cryptotypes.PubKey, error) {
 
 // GetAddress fetches an address of the record
 func (k Record) GetAddress() (types.AccAddress, error) {
+	if w := k.GetWatchOnly(); w != nil {
+		return w.Address, nil
+	}
+
 	pk, err := k.GetPubKey()
 	if err != nil {
 		return nil, err
@@ -97,6 +127,8 @@ func (k Record) GetType() KeyType {
 		return TypeMulti
 	case k.GetOffline() != nil:
 		return TypeOffline
+	case k.GetWatchOnly() != nil:
+		return TypeWatchOnly
 	default:
 		panic("unrecognized record type")
 	}
@@ -104,6 +136,10 @@ func (k Record) GetType() KeyType {
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (k *Record) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if k.PubKey == nil && k.GetWatchOnly() != nil {
+		return nil
+	}
+
 	var pk cryptotypes.PubKey
 	if err := unpacker.UnpackAny(k.PubKey, &pk); err != nil {
 		return err
@@ -38,6 +38,7 @@ type Record struct {
 	//	*Record_Ledger_
 	//	*Record_Multi_
 	//	*Record_Offline_
+	//	*Record_WatchOnly_
 	Item isRecord_Item `protobuf_oneof:"item"`
 }
 
@@ -92,11 +93,15 @@ type Record_Multi_ struct {
 type Record_Offline_ struct {
 	Offline *Record_Offline `protobuf:"bytes,6,opt,name=offline,proto3,oneof" json:"offline,omitempty"`
 }
+type Record_WatchOnly_ struct {
+	WatchOnly *Record_WatchOnly `protobuf:"bytes,7,opt,name=watch_only,json=watchOnly,proto3,oneof" json:"watch_only,omitempty"`
+}
 
-func (*Record_Local_) isRecord_Item()   {}
-func (*Record_Ledger_) isRecord_Item()  {}
-func (*Record_Multi_) isRecord_Item()   {}
-func (*Record_Offline_) isRecord_Item() {}
+func (*Record_Local_) isRecord_Item()     {}
+func (*Record_Ledger_) isRecord_Item()    {}
+func (*Record_Multi_) isRecord_Item()     {}
+func (*Record_Offline_) isRecord_Item()   {}
+func (*Record_WatchOnly_) isRecord_Item() {}
 
 func (m *Record) GetItem() isRecord_Item {
 	if m != nil {
@@ -133,6 +138,13 @@ func (m *Record) GetOffline() *Record_Offline {
 	return nil
 }
 
+func (m *Record) GetWatchOnly() *Record_WatchOnly {
+	if x, ok := m.GetItem().(*Record_WatchOnly_); ok {
+		return x.WatchOnly
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Record) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -140,6 +152,7 @@ func (*Record) XXX_OneofWrappers() []interface{} {
 		(*Record_Ledger_)(nil),
 		(*Record_Multi_)(nil),
 		(*Record_Offline_)(nil),
+		(*Record_WatchOnly_)(nil),
 	}
 }
 
@@ -294,12 +307,53 @@ func (m *Record_Offline) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Record_Offline proto.InternalMessageInfo
 
+// WatchOnly item
+type Record_WatchOnly struct {
+	// address is the account address being watched. It is set even when
+	// the public key of the account is not known.
+	Address []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *Record_WatchOnly) Reset()         { *m = Record_WatchOnly{} }
+func (m *Record_WatchOnly) String() string { return proto.CompactTextString(m) }
+func (*Record_WatchOnly) ProtoMessage()    {}
+func (*Record_WatchOnly) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36d640103edea005, []int{0, 4}
+}
+func (m *Record_WatchOnly) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Record_WatchOnly) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Record_WatchOnly.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Record_WatchOnly) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Record_WatchOnly.Merge(m, src)
+}
+func (m *Record_WatchOnly) XXX_Size() int {
+	return m.Size()
+}
+func (m *Record_WatchOnly) XXX_DiscardUnknown() {
+	xxx_messageInfo_Record_WatchOnly.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Record_WatchOnly proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Record)(nil), "cosmos.crypto.keyring.v1.Record")
 	proto.RegisterType((*Record_Local)(nil), "cosmos.crypto.keyring.v1.Record.Local")
 	proto.RegisterType((*Record_Ledger)(nil), "cosmos.crypto.keyring.v1.Record.Ledger")
 	proto.RegisterType((*Record_Multi)(nil), "cosmos.crypto.keyring.v1.Record.Multi")
 	proto.RegisterType((*Record_Offline)(nil), "cosmos.crypto.keyring.v1.Record.Offline")
+	proto.RegisterType((*Record_WatchOnly)(nil), "cosmos.crypto.keyring.v1.Record.WatchOnly")
 }
 
 func init() {
@@ -307,33 +361,36 @@ func init() {
 }
 
 var fileDescriptor_36d640103edea005 = []byte{
-	// 411 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbd, 0xae, 0xd3, 0x30,
-	0x1c, 0xc5, 0x1d, 0x6e, 0x3e, 0xb8, 0x66, 0xb3, 0xee, 0x10, 0x22, 0x64, 0x55, 0x48, 0x40, 0x25,
-	0x54, 0x5b, 0x85, 0x0e, 0x4c, 0x95, 0x5a, 0x31, 0x14, 0x95, 0x8a, 0x2a, 0x23, 0x0b, 0xca, 0x87,
-	0x9b, 0x44, 0x4d, 0xe2, 0xc8, 0x49, 0x2a, 0xe5, 0x2d, 0x18, 0x79, 0xa4, 0x8e, 0x1d, 0x19, 0xa1,
-	0xd9, 0x78, 0x0a, 0x64, 0x3b, 0x1d, 0xa8, 0x04, 0x65, 0x8a, 0x23, 0xff, 0xce, 0xff, 0x9c, 0x63,
-	0xfd, 0xe1, 0x8b, 0x88, 0xd7, 0x05, 0xaf, 0x69, 0x24, 0xba, 0xaa, 0xe1, 0x74, 0xcf, 0x3a, 0x91,
-	0x95, 0x09, 0x3d, 0x4c, 0xa9, 0x60, 0x11, 0x17, 0x31, 0xa9, 0x04, 0x6f, 0x38, 0x72, 0x35, 0x46,
-	0x34, 0x46, 0x06, 0x8c, 0x1c, 0xa6, 0xde, 0x43, 0xc2, 0x13, 0xae, 0x20, 0x2a, 0x4f, 0x9a, 0xf7,
-	0x9e, 0x26, 0x9c, 0x27, 0x39, 0xa3, 0xea, 0x2f, 0x6c, 0x77, 0x34, 0x28, 0xbb, 0xe1, 0xea, 0xd9,
-	0x9f, 0x8e, 0x69, 0x2c, 0xcd, 0xd2, 0xc1, 0xe8, 0xf9, 0xaf, 0x3b, 0x68, 0xfb, 0xca, 0x19, 0x21,
-	0x68, 0x96, 0x41, 0xc1, 0x5c, 0x63, 0x64, 0x8c, 0xef, 0x7d, 0x75, 0x46, 0x13, 0xe8, 0x54, 0x6d,
-	0xf8, 0x65, 0xcf, 0x3a, 0xf7, 0xd1, 0xc8, 0x18, 0x3f, 0x79, 0xf3, 0x40, 0xb4, 0x13, 0xb9, 0x38,
-	0x91, 0x45, 0xd9, 0xf9, 0x76, 0xd5, 0x86, 0x6b, 0xd6, 0xa1, 0x39, 0xb4, 0x72, 0x1e, 0x05, 0xb9,
-	0x7b, 0xa7, 0xe0, 0x97, 0xe4, 0x6f, 0x35, 0x88, 0xf6, 0x24, 0x1f, 0x25, 0xbd, 0x02, 0xbe, 0x96,
-	0xa1, 0x05, 0xb4, 0x73, 0x16, 0x27, 0x4c, 0xb8, 0xa6, 0x1a, 0xf0, 0xea, 0xf6, 0x00, 0x85, 0xaf,
-	0x80, 0x3f, 0x08, 0x65, 0x84, 0xa2, 0xcd, 0x9b, 0xcc, 0xb5, 0xfe, 0x33, 0xc2, 0x46, 0xd2, 0x32,
-	0x82, 0x92, 0xa1, 0xf7, 0xd0, 0xe1, 0xbb, 0x5d, 0x9e, 0x95, 0xcc, 0xb5, 0xd5, 0x84, 0xf1, 0xcd,
-	0x09, 0x9f, 0x34, 0xbf, 0x02, 0xfe, 0x45, 0xea, 0xbd, 0x83, 0x96, 0xaa, 0x86, 0x28, 0x7c, 0x5c,
-	0x89, 0xec, 0xa0, 0x5e, 0xd0, 0xf8, 0xc7, 0x0b, 0x3a, 0x92, 0x5a, 0xb3, 0xce, 0x9b, 0x43, 0x5b,
-	0x77, 0x42, 0x33, 0x68, 0x56, 0x41, 0x93, 0x0e, 0xb2, 0xd1, 0x55, 0x8c, 0x34, 0x96, 0x09, 0x96,
-	0x1f, 0xb6, 0xb3, 0xd9, 0x36, 0x10, 0x41, 0x51, 0xfb, 0x8a, 0xf6, 0x1c, 0x68, 0xa9, 0x46, 0xde,
-	0x3d, 0x74, 0x86, 0x60, 0x4b, 0x1b, 0x9a, 0x59, 0xc3, 0x8a, 0xe5, 0xe6, 0xf8, 0x13, 0x83, 0xe3,
-	0x19, 0x1b, 0xa7, 0x33, 0x36, 0x7e, 0x9c, 0xb1, 0xf1, 0xb5, 0xc7, 0xe0, 0x5b, 0x8f, 0xc1, 0xa9,
-	0xc7, 0xe0, 0x7b, 0x8f, 0xc1, 0xe7, 0xd7, 0x49, 0xd6, 0xa4, 0x6d, 0x48, 0x22, 0x5e, 0xd0, 0xcb,
-	0xde, 0xa8, 0xcf, 0xa4, 0x8e, 0xf7, 0x57, 0x4b, 0x1b, 0xda, 0xaa, 0xc1, 0xdb, 0xdf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x47, 0x24, 0x2f, 0xa9, 0xd4, 0x02, 0x00, 0x00,
+	// 458 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4d, 0x8b, 0xd3, 0x40,
+	0x18, 0xc7, 0x13, 0x6d, 0x13, 0xfb, 0xe8, 0x69, 0xd8, 0xc3, 0x18, 0x24, 0x14, 0x61, 0xb5, 0x28,
+	0x3b, 0xc3, 0x6a, 0x0f, 0x9e, 0x16, 0xb6, 0x78, 0xa8, 0xd4, 0x65, 0x97, 0xb9, 0x08, 0x5e, 0x96,
+	0xbc, 0x4c, 0x93, 0xd0, 0x24, 0x13, 0x26, 0x49, 0x97, 0xf9, 0x16, 0x1e, 0xfd, 0x48, 0x7b, 0xdc,
+	0xa3, 0x27, 0xd1, 0xf6, 0x8b, 0xc8, 0x4c, 0x12, 0xc1, 0x05, 0xad, 0xa7, 0xcc, 0x90, 0xdf, 0xff,
+	0x65, 0x9e, 0x49, 0xe0, 0x38, 0x12, 0x75, 0x21, 0x6a, 0x1a, 0x49, 0x55, 0x35, 0x82, 0x6e, 0xb8,
+	0x92, 0x59, 0x99, 0xd0, 0xed, 0x29, 0x95, 0x3c, 0x12, 0x32, 0x26, 0x95, 0x14, 0x8d, 0x40, 0xb8,
+	0xc3, 0x48, 0x87, 0x91, 0x1e, 0x23, 0xdb, 0x53, 0xef, 0x28, 0x11, 0x89, 0x30, 0x10, 0xd5, 0xab,
+	0x8e, 0xf7, 0x9e, 0x26, 0x42, 0x24, 0x39, 0xa7, 0x66, 0x17, 0xb6, 0x6b, 0x1a, 0x94, 0xaa, 0x7f,
+	0xf5, 0xec, 0xcf, 0xc4, 0x34, 0xd6, 0x61, 0x69, 0x1f, 0xf4, 0xfc, 0xfb, 0x08, 0x1c, 0x66, 0x92,
+	0x11, 0x82, 0x51, 0x19, 0x14, 0x1c, 0xdb, 0x53, 0x7b, 0x36, 0x61, 0x66, 0x8d, 0x4e, 0xc0, 0xad,
+	0xda, 0xf0, 0x7a, 0xc3, 0x15, 0x7e, 0x30, 0xb5, 0x67, 0x8f, 0xdf, 0x1c, 0x91, 0x2e, 0x89, 0x0c,
+	0x49, 0xe4, 0xbc, 0x54, 0xcc, 0xa9, 0xda, 0x70, 0xc5, 0x15, 0x3a, 0x83, 0x71, 0x2e, 0xa2, 0x20,
+	0xc7, 0x0f, 0x0d, 0xfc, 0x82, 0xfc, 0xed, 0x18, 0xa4, 0xcb, 0x24, 0x1f, 0x35, 0xbd, 0xb4, 0x58,
+	0x27, 0x43, 0xe7, 0xe0, 0xe4, 0x3c, 0x4e, 0xb8, 0xc4, 0x23, 0x63, 0xf0, 0xf2, 0xb0, 0x81, 0xc1,
+	0x97, 0x16, 0xeb, 0x85, 0xba, 0x42, 0xd1, 0xe6, 0x4d, 0x86, 0xc7, 0xff, 0x59, 0xe1, 0x42, 0xd3,
+	0xba, 0x82, 0x91, 0xa1, 0xf7, 0xe0, 0x8a, 0xf5, 0x3a, 0xcf, 0x4a, 0x8e, 0x1d, 0xe3, 0x30, 0x3b,
+	0xe8, 0x70, 0xd9, 0xf1, 0x4b, 0x8b, 0x0d, 0x52, 0xb4, 0x02, 0xb8, 0x09, 0x9a, 0x28, 0xbd, 0x16,
+	0x65, 0xae, 0xb0, 0x6b, 0x8c, 0x5e, 0x1d, 0x34, 0xfa, 0xa4, 0x25, 0x97, 0x65, 0xae, 0x96, 0x16,
+	0x9b, 0xdc, 0x0c, 0x1b, 0xef, 0x1d, 0x8c, 0xcd, 0x9c, 0x10, 0x85, 0x47, 0x95, 0xcc, 0xb6, 0xe6,
+	0x3a, 0xec, 0x7f, 0x5c, 0x87, 0xab, 0xa9, 0x15, 0x57, 0xde, 0x19, 0x38, 0xdd, 0x80, 0xd0, 0x1c,
+	0x46, 0x55, 0xd0, 0xa4, 0xbd, 0x6c, 0x7a, 0xaf, 0x4a, 0x1a, 0xeb, 0x16, 0x8b, 0x0f, 0x57, 0xf3,
+	0xf9, 0x55, 0x20, 0x83, 0xa2, 0x66, 0x86, 0xf6, 0x5c, 0x18, 0x9b, 0xf1, 0x78, 0x13, 0x70, 0xfb,
+	0x53, 0x7a, 0xc7, 0x30, 0xf9, 0xdd, 0x13, 0x61, 0x70, 0x83, 0x38, 0x96, 0xbc, 0xae, 0x8d, 0xf3,
+	0x13, 0x36, 0x6c, 0x17, 0x0e, 0x8c, 0xb2, 0x86, 0x17, 0x8b, 0x8b, 0xdb, 0x9f, 0xbe, 0x75, 0xbb,
+	0xf3, 0xed, 0xbb, 0x9d, 0x6f, 0xff, 0xd8, 0xf9, 0xf6, 0x97, 0xbd, 0x6f, 0x7d, 0xdd, 0xfb, 0xd6,
+	0xdd, 0xde, 0xb7, 0xbe, 0xed, 0x7d, 0xeb, 0xf3, 0xeb, 0x24, 0x6b, 0xd2, 0x36, 0x24, 0x91, 0x28,
+	0xe8, 0xf0, 0xad, 0x9a, 0xc7, 0x49, 0x1d, 0x6f, 0xee, 0xfd, 0x28, 0xa1, 0x63, 0x0e, 0xfa, 0xf6,
+	0xd7, 0x00, 0xb6, 0x20, 0x3d, 0x95, 0x48, 0x03, 0x00, 0x00,
 }
 
 func (m *Record) Marshal() (dAtA []byte, err error) {
@@ -471,6 +528,27 @@ func (m *Record_Offline_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Record_WatchOnly_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Record_WatchOnly_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.WatchOnly != nil {
+		{
+			size, err := m.WatchOnly.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRecord(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *Record_Local) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -587,6 +665,36 @@ func (m *Record_Offline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Record_WatchOnly) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Record_WatchOnly) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Record_WatchOnly) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRecord(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRecord(v)
 	base := offset
@@ -666,6 +774,18 @@ func (m *Record_Offline_) Size() (n int) {
 	}
 	return n
 }
+func (m *Record_WatchOnly_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WatchOnly != nil {
+		l = m.WatchOnly.Size()
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	return n
+}
 func (m *Record_Local) Size() (n int) {
 	if m == nil {
 		return 0
@@ -710,6 +830,19 @@ func (m *Record_Offline) Size() (n int) {
 	return n
 }
 
+func (m *Record_WatchOnly) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRecord(uint64(l))
+	}
+	return n
+}
+
 func sovRecord(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -953,6 +1086,41 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 			}
 			m.Item = &Record_Offline_{v}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WatchOnly", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Record_WatchOnly{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Item = &Record_WatchOnly_{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRecord(dAtA[iNdEx:])
@@ -1246,6 +1414,90 @@ func (m *Record_Offline) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Record_WatchOnly) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchOnly: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchOnly: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRecord(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types"
 )
 
 type RecordTestSuite struct {
@@ -46,6 +47,38 @@ func (s *RecordTestSuite) TestOfflineRecordMarshaling() {
 	s.Require().True(s.pub.Equals(pk2))
 }
 
+func (s *RecordTestSuite) TestWatchOnlyRecordMarshaling() {
+	addr := types.AccAddress(s.pub.Address())
+
+	k, err := NewWatchOnlyRecord("testrecord", addr, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(TypeWatchOnly, k.GetType())
+
+	bz, err := s.cdc.Marshal(k)
+	s.Require().NoError(err)
+
+	var k2 Record
+	s.Require().NoError(s.cdc.Unmarshal(bz, &k2))
+	s.Require().Equal(k.Name, k2.Name)
+	s.Require().Nil(k2.PubKey)
+
+	addr2, err := k2.GetAddress()
+	s.Require().NoError(err)
+	s.Require().Equal(addr, addr2)
+
+	_, err = k2.GetPubKey()
+	s.Require().ErrorIs(err, ErrPubKeyNotAvailable)
+
+	k, err = NewWatchOnlyRecord("testrecord", addr, s.pub)
+	s.Require().NoError(err)
+	pk, err := k.GetPubKey()
+	s.Require().NoError(err)
+	s.Require().True(s.pub.Equals(pk))
+
+	_, err = NewWatchOnlyRecord("testrecord", addr, ed25519.GenPrivKey().PubKey())
+	s.Require().Error(err)
+}
+
 func (s *RecordTestSuite) TestLocalRecordMarshaling() {
 	dir := s.T().TempDir()
 	mockIn := strings.NewReader("")
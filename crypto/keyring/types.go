@@ -49,6 +49,9 @@ const (
 	TypeLedger  KeyType = 1
 	TypeOffline KeyType = 2
 	TypeMulti   KeyType = 3
+	// TypeWatchOnly keys only track an address (and optionally its public
+	// key) and can never be used for signing.
+	TypeWatchOnly KeyType = 4
 )
 
 var keyTypes = map[KeyType]string{
@@ -56,6 +59,8 @@ var keyTypes = map[KeyType]string{
 	TypeLedger:  "ledger",
 	TypeOffline: "offline",
 	TypeMulti:   "multi",
+
+	TypeWatchOnly: "watch-only",
 }
 
 // String implements the stringer interface for KeyType.
@@ -2,6 +2,7 @@ package runtime
 
 import (
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	overviewv1 "cosmossdk.io/api/cosmos/overview/v1"
 	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
 
 	"github.com/cosmos/cosmos-sdk/runtime/services"
@@ -17,5 +18,7 @@ func (a *App) registerRuntimeServices(cfg module.Configurator) error { // nolint
 	}
 	reflectionv1.RegisterReflectionServiceServer(cfg.QueryServer(), reflectionSvc)
 
+	overviewv1.RegisterQueryServer(cfg.QueryServer(), services.NewAccountOverviewService(a.GRPCQueryRouter()))
+
 	return nil
 }
@@ -0,0 +1,124 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/runtime/protoiface"
+
+	authzv1beta1 "cosmossdk.io/api/cosmos/authz/v1beta1"
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	distributionv1beta1 "cosmossdk.io/api/cosmos/distribution/v1beta1"
+	feegrantv1beta1 "cosmossdk.io/api/cosmos/feegrant/v1beta1"
+	overviewv1 "cosmossdk.io/api/cosmos/overview/v1"
+	stakingv1beta1 "cosmossdk.io/api/cosmos/staking/v1beta1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// QueryRouter routes queries to the query services of the modules.
+type QueryRouter interface {
+	HybridHandlerByRequestName(name string) []func(ctx context.Context, req, resp protoiface.MessageV1) error
+}
+
+// queryMessage is a protov2 message which can be passed to the hybrid query handlers.
+type queryMessage interface {
+	protobuf.Message
+	protoiface.MessageV1
+}
+
+// AccountOverviewService implements the cosmos.overview.v1.Query service.
+type AccountOverviewService struct {
+	overviewv1.UnimplementedQueryServer
+
+	router QueryRouter
+}
+
+// NewAccountOverviewService returns an AccountOverviewService assembling the
+// overview from the queries routed by the provided router.
+func NewAccountOverviewService(router QueryRouter) *AccountOverviewService {
+	return &AccountOverviewService{router: router}
+}
+
+// AccountOverview assembles the overview of an address from the queries of the
+// bank, staking, distribution, authz and feegrant modules. All the queries are
+// executed against the context of the request, so all the sections are read at
+// the same height.
+func (s AccountOverviewService) AccountOverview(ctx context.Context, req *overviewv1.QueryAccountOverviewRequest) (*overviewv1.QueryAccountOverviewResponse, error) {
+	if req == nil || req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty address")
+	}
+
+	res := &overviewv1.QueryAccountOverviewResponse{Height: sdk.UnwrapSDKContext(ctx).BlockHeight()}
+
+	balances := &bankv1beta1.QueryAllBalancesResponse{}
+	if err := s.query(ctx, &bankv1beta1.QueryAllBalancesRequest{Address: req.Address}, balances); err != nil {
+		return nil, err
+	}
+	res.Balances = balances.Balances
+
+	delegations := &stakingv1beta1.QueryDelegatorDelegationsResponse{}
+	if err := s.query(ctx, &stakingv1beta1.QueryDelegatorDelegationsRequest{DelegatorAddr: req.Address}, delegations); err != nil {
+		return nil, err
+	}
+	res.Delegations = delegations.DelegationResponses
+
+	unbondings := &stakingv1beta1.QueryDelegatorUnbondingDelegationsResponse{}
+	if err := s.query(ctx, &stakingv1beta1.QueryDelegatorUnbondingDelegationsRequest{DelegatorAddr: req.Address}, unbondings); err != nil {
+		return nil, err
+	}
+	res.UnbondingDelegations = unbondings.UnbondingResponses
+
+	rewards := &distributionv1beta1.QueryDelegationTotalRewardsResponse{}
+	if err := s.query(ctx, &distributionv1beta1.QueryDelegationTotalRewardsRequest{DelegatorAddress: req.Address}, rewards); err != nil {
+		return nil, err
+	}
+	res.Rewards = rewards.Rewards
+	res.TotalRewards = rewards.Total
+
+	granterGrants := &authzv1beta1.QueryGranterGrantsResponse{}
+	if err := s.query(ctx, &authzv1beta1.QueryGranterGrantsRequest{Granter: req.Address}, granterGrants); err != nil {
+		return nil, err
+	}
+	res.GrantedAuthorizations = granterGrants.Grants
+
+	granteeGrants := &authzv1beta1.QueryGranteeGrantsResponse{}
+	if err := s.query(ctx, &authzv1beta1.QueryGranteeGrantsRequest{Grantee: req.Address}, granteeGrants); err != nil {
+		return nil, err
+	}
+	res.ReceivedAuthorizations = granteeGrants.Grants
+
+	grantedAllowances := &feegrantv1beta1.QueryAllowancesByGranterResponse{}
+	if err := s.query(ctx, &feegrantv1beta1.QueryAllowancesByGranterRequest{Granter: req.Address}, grantedAllowances); err != nil {
+		return nil, err
+	}
+	res.GrantedAllowances = grantedAllowances.Allowances
+
+	receivedAllowances := &feegrantv1beta1.QueryAllowancesResponse{}
+	if err := s.query(ctx, &feegrantv1beta1.QueryAllowancesRequest{Grantee: req.Address}, receivedAllowances); err != nil {
+		return nil, err
+	}
+	res.ReceivedAllowances = receivedAllowances.Allowances
+
+	return res, nil
+}
+
+// query executes a module query and merges its result into resp. resp is left
+// empty if no module of the app handles the query.
+func (s AccountOverviewService) query(ctx context.Context, req, resp queryMessage) error {
+	name := string(req.ProtoReflect().Descriptor().FullName())
+	handlers := s.router.HybridHandlerByRequestName(name)
+	if len(handlers) == 0 {
+		return nil
+	}
+	if len(handlers) > 1 {
+		return fmt.Errorf("multiple handlers for query: %s", name)
+	}
+
+	return handlers[0](ctx, req, resp)
+}
+
+var _ overviewv1.QueryServer = &AccountOverviewService{}
@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/runtime/protoiface"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	overviewv1 "cosmossdk.io/api/cosmos/overview/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// bankOnlyRouter routes the bank balance queries only.
+type bankOnlyRouter struct{}
+
+func (bankOnlyRouter) HybridHandlerByRequestName(name string) []func(ctx context.Context, req, resp protoiface.MessageV1) error {
+	if name != "cosmos.bank.v1beta1.QueryAllBalancesRequest" {
+		return nil
+	}
+
+	return []func(ctx context.Context, req, resp protoiface.MessageV1) error{
+		func(_ context.Context, _, resp protoiface.MessageV1) error {
+			protobuf.Merge(resp.(protobuf.Message), &bankv1beta1.QueryAllBalancesResponse{
+				Balances: []*basev1beta1.Coin{{Denom: "stake", Amount: "100"}},
+			})
+			return nil
+		},
+	}
+}
+
+func TestAccountOverview(t *testing.T) {
+	svc := NewAccountOverviewService(bankOnlyRouter{})
+	ctx := sdk.Context{}.WithBlockHeight(7)
+
+	_, err := svc.AccountOverview(ctx, &overviewv1.QueryAccountOverviewRequest{})
+	require.ErrorContains(t, err, "empty address")
+
+	res, err := svc.AccountOverview(ctx, &overviewv1.QueryAccountOverviewRequest{Address: "cosmos1addr"})
+	require.NoError(t, err)
+	require.Equal(t, int64(7), res.Height)
+	require.Len(t, res.Balances, 1)
+	require.Equal(t, "100", res.Balances[0].Amount)
+	// the sections of the modules which are not routed are left empty
+	require.Empty(t, res.Delegations)
+	require.Empty(t, res.ReceivedAllowances)
+}
@@ -40,6 +40,10 @@ type transientStoreService struct {
 	key *storetypes.TransientStoreKey
 }
 
+func NewTransientStoreService(storeKey *storetypes.TransientStoreKey) store.TransientStoreService {
+	return &transientStoreService{key: storeKey}
+}
+
 func (t transientStoreService) OpenTransientStore(ctx context.Context) store.KVStore {
 	return newKVStore(sdk.UnwrapSDKContext(ctx).KVStore(t.key))
 }
@@ -0,0 +1,140 @@
+package api
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/pprof"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// RegisterDiagnostics registers the admin endpoints serving the pprof profiles
+// of the node under /debug/pprof, and profiling the next blocks on demand under
+// /diagnostics/block-profile. The endpoints require the given bearer token,
+// which cannot be empty as they are served by the public API router. The block
+// profiling endpoints are unavailable if the profiler is nil.
+func (s *Server) RegisterDiagnostics(profiler *telemetry.BlockProfiler, token string) error {
+	if token == "" {
+		return errors.New("the diagnostics endpoints require a diagnostics token")
+	}
+
+	router := s.Router.NewRoute().Subrouter()
+	router.Use(func(next http.Handler) http.Handler {
+		return diagnosticsAuth(token, next)
+	})
+
+	router.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	router.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	router.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	router.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	router.PathPrefix("/debug/pprof/").HandlerFunc(pprof.Index)
+
+	router.HandleFunc("/diagnostics/block-profile", func(w http.ResponseWriter, r *http.Request) {
+		startBlockProfile(w, r, profiler)
+	}).Methods("POST")
+	router.HandleFunc("/diagnostics/block-profile", func(w http.ResponseWriter, r *http.Request) {
+		blockProfileReport(w, profiler)
+	}).Methods("GET")
+	router.HandleFunc("/diagnostics/block-profile/{profile}", func(w http.ResponseWriter, r *http.Request) {
+		blockProfileData(w, mux.Vars(r)["profile"], profiler)
+	}).Methods("GET")
+
+	return nil
+}
+
+// diagnosticsAuth only lets through the requests carrying the bearer token.
+func diagnosticsAuth(token string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := []byte(r.Header.Get("Authorization"))
+		if subtle.ConstantTimeCompare(auth, []byte("Bearer "+token)) != 1 {
+			writeErrorResponse(w, http.StatusUnauthorized, "invalid diagnostics token")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// startBlockProfile starts profiling the number of blocks given by the `blocks`
+// parameter, capturing the comma separated runtime profiles given by the
+// `profiles` parameter.
+func startBlockProfile(w http.ResponseWriter, r *http.Request, profiler *telemetry.BlockProfiler) {
+	if profiler == nil {
+		writeErrorResponse(w, http.StatusServiceUnavailable, "block profiling is not enabled")
+		return
+	}
+
+	blocks, err := strconv.ParseInt(r.FormValue("blocks"), 10, 64)
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid number of blocks: %s", err))
+		return
+	}
+
+	var profiles []string
+	if p := strings.TrimSpace(r.FormValue("profiles")); p != "" {
+		for _, profile := range strings.Split(p, ",") {
+			profiles = append(profiles, strings.TrimSpace(profile))
+		}
+	}
+
+	if err := profiler.Start(blocks, profiles); err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, telemetry.ErrBlockProfilingInProgress) {
+			status = http.StatusConflict
+		}
+		writeErrorResponse(w, status, err.Error())
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// blockProfileReport writes the report of the current or last block profiling
+// session.
+func blockProfileReport(w http.ResponseWriter, profiler *telemetry.BlockProfiler) {
+	if profiler == nil {
+		writeErrorResponse(w, http.StatusServiceUnavailable, "block profiling is not enabled")
+		return
+	}
+
+	report, ok := profiler.Report()
+	if !ok {
+		writeErrorResponse(w, http.StatusNotFound, "no block profiling session")
+		return
+	}
+
+	bz, err := json.Marshal(report)
+	if err != nil {
+		writeErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(bz)
+}
+
+// blockProfileData writes a runtime profile captured by the last completed
+// block profiling session.
+func blockProfileData(w http.ResponseWriter, profile string, profiler *telemetry.BlockProfiler) {
+	if profiler == nil {
+		writeErrorResponse(w, http.StatusServiceUnavailable, "block profiling is not enabled")
+		return
+	}
+
+	data, err := profiler.Profile(profile)
+	if err != nil {
+		writeErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", profile+".pprof"))
+	_, _ = w.Write(data)
+}
@@ -0,0 +1,83 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+func TestDiagnostics(t *testing.T) {
+	profiler := telemetry.NewBlockProfiler(log.NewNopLogger())
+
+	s := &Server{Router: mux.NewRouter()}
+	require.NoError(t, s.RegisterDiagnostics(profiler, "secret"))
+	s.Router.PathPrefix("/").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+
+	serve := func(method, target, remoteAddr string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, nil)
+		req.RemoteAddr = remoteAddr
+		req.Header.Set("Authorization", "Bearer secret")
+		rec := httptest.NewRecorder()
+		s.Router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	require.Equal(t, http.StatusOK, serve("GET", "/debug/pprof/", "10.0.0.1:1234").Code)
+
+	// other routes are left to the routers registered afterwards
+	require.Equal(t, http.StatusTeapot, serve("GET", "/cosmos/bank/v1beta1/params", "10.0.0.1:1234").Code)
+
+	require.Equal(t, http.StatusNotFound, serve("GET", "/diagnostics/block-profile", "[::1]:1234").Code)
+	require.Equal(t, http.StatusBadRequest, serve("POST", "/diagnostics/block-profile?blocks=x", "[::1]:1234").Code)
+	require.Equal(t, http.StatusAccepted, serve("POST", "/diagnostics/block-profile?blocks=1&profiles=heap,goroutine", "[::1]:1234").Code)
+	require.Equal(t, http.StatusConflict, serve("POST", "/diagnostics/block-profile?blocks=1", "[::1]:1234").Code)
+	require.Equal(t, http.StatusNotFound, serve("GET", "/diagnostics/block-profile/heap", "[::1]:1234").Code)
+
+	profiler.BeginBlock(5)
+	profiler.EndBlock(0, 0)
+	profiler.Commit(time.Now())
+
+	rec := serve("GET", "/diagnostics/block-profile", "[::1]:1234")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var report telemetry.BlockProfileReport
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+	require.True(t, report.Done)
+	require.Equal(t, int64(5), report.Breakdowns[0].Height)
+
+	rec = serve("GET", "/diagnostics/block-profile/goroutine", "[::1]:1234")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NotEmpty(t, rec.Body.Bytes())
+}
+
+func TestDiagnosticsToken(t *testing.T) {
+	s := &Server{Router: mux.NewRouter()}
+	require.Error(t, s.RegisterDiagnostics(nil, ""))
+	require.NoError(t, s.RegisterDiagnostics(nil, "secret"))
+
+	serve := func(auth string) int {
+		req := httptest.NewRequest("GET", "/diagnostics/block-profile", nil)
+		req.RemoteAddr = "127.0.0.1:1234"
+		if auth != "" {
+			req.Header.Set("Authorization", auth)
+		}
+		rec := httptest.NewRecorder()
+		s.Router.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	// the token is required, even from the loopback interface
+	require.Equal(t, http.StatusUnauthorized, serve(""))
+	require.Equal(t, http.StatusUnauthorized, serve("Bearer wrong"))
+	require.Equal(t, http.StatusServiceUnavailable, serve("Bearer secret"))
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"math"
+	"time"
 
 	"github.com/spf13/viper"
 
@@ -91,6 +92,14 @@ type BaseConfig struct {
 	// AppDBBackend defines the type of Database to use for the application and snapshots databases.
 	// An empty string indicates that the CometBFT config's DBBackend value should be used.
 	AppDBBackend string `mapstructure:"app-db-backend"`
+
+	// ReplayCheck enables the block replay determinism checker, which re-executes
+	// every committed block against its pre-state and reports any divergence.
+	ReplayCheck bool `mapstructure:"replay-check"`
+
+	// ReplayCheckExportDir defines the directory the divergence reports of the
+	// replay checker are written to. If empty, divergences are only logged.
+	ReplayCheckExportDir string `mapstructure:"replay-check-export-dir"`
 }
 
 // APIConfig defines the API listener configuration.
@@ -119,6 +128,14 @@ type APIConfig struct {
 	// RPCMaxBodyBytes defines the CometBFT maximum request body (in bytes)
 	RPCMaxBodyBytes uint `mapstructure:"rpc-max-body-bytes"`
 
+	// EnableDiagnostics defines if the pprof and block profiling admin endpoints
+	// should be registered.
+	EnableDiagnostics bool `mapstructure:"enable-diagnostics"`
+
+	// DiagnosticsToken defines the bearer token required by the admin endpoints.
+	// It must be set for the endpoints to be enabled.
+	DiagnosticsToken string `mapstructure:"diagnostics-token"`
+
 	// TODO: TLS/Proxy configuration.
 	//
 	// Ref: https://github.com/cosmos/cosmos-sdk/issues/6420
@@ -173,6 +190,7 @@ type (
 	// StreamingConfig defines application configuration for external streaming services
 	StreamingConfig struct {
 		ABCI ABCIListenerConfig `mapstructure:"abci"`
+		IPC  IPCStreamingConfig `mapstructure:"ipc"`
 	}
 	// ABCIListenerConfig defines application configuration for ABCIListener streaming service
 	ABCIListenerConfig struct {
@@ -180,6 +198,14 @@ type (
 		Plugin        string   `mapstructure:"plugin"`
 		StopNodeOnErr bool     `mapstructure:"stop-node-on-err"`
 	}
+	// IPCStreamingConfig defines application configuration for the streaming of
+	// the committed block results to local sidecars over a unix socket
+	IPCStreamingConfig struct {
+		Address     string        `mapstructure:"address"`
+		Keys        []string      `mapstructure:"keys"`
+		BufferSize  int           `mapstructure:"buffer-size"`
+		SendTimeout time.Duration `mapstructure:"send-timeout"`
+	}
 )
 
 // Config defines the server's top level configuration
@@ -230,6 +256,7 @@ func DefaultConfig() *Config {
 			IAVLCacheSize:       781250,
 			IAVLDisableFastNode: false,
 			AppDBBackend:        "",
+			ReplayCheck:         false,
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
@@ -261,6 +288,11 @@ func DefaultConfig() *Config {
 				Keys:          []string{},
 				StopNodeOnErr: true,
 			},
+			IPC: IPCStreamingConfig{
+				Keys:        []string{},
+				BufferSize:  16,
+				SendTimeout: time.Second,
+			},
 		},
 		Mempool: MempoolConfig{
 			MaxTxs: 5_000,
@@ -287,6 +319,12 @@ func (c Config) ValidateBasic() error {
 			"cannot enable state sync snapshots with '%s' pruning setting", pruningtypes.PruningOptionEverything,
 		)
 	}
+	if c.Streaming.IPC.BufferSize < 0 {
+		return sdkerrors.ErrAppConfig.Wrap("streaming ipc buffer size cannot be negative")
+	}
+	if c.API.EnableDiagnostics && c.API.DiagnosticsToken == "" {
+		return sdkerrors.ErrAppConfig.Wrap("cannot enable the api diagnostics endpoints without a diagnostics token")
+	}
 
 	return nil
 }
@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
@@ -52,6 +53,12 @@ func TestStreamingConfig(t *testing.T) {
 				Plugin:        "plugin-A",
 				StopNodeOnErr: false,
 			},
+			IPC: IPCStreamingConfig{
+				Address:     "/tmp/app.sock",
+				Keys:        []string{"bank"},
+				BufferSize:  8,
+				SendTimeout: 500 * time.Millisecond,
+			},
 		},
 	}
 
@@ -69,6 +76,10 @@ func TestStreamingConfig(t *testing.T) {
 		`keys = ["one", "two", ]`,
 		`plugin = "plugin-A"`,
 		`stop-node-on-err = false`,
+		`address = "/tmp/app.sock"`,
+		`keys = ["bank", ]`,
+		`buffer-size = 8`,
+		`send-timeout = "500ms"`,
 	}
 
 	for _, line := range expectedLines {
@@ -238,3 +249,15 @@ func TestAppConfig(t *testing.T) {
 	require.NoError(t, v.Unmarshal(appCfg))
 	require.EqualValues(t, appCfg, defAppConfig)
 }
+
+func TestValidateDiagnostics(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.MinGasPrices = "0stake"
+	require.NoError(t, cfg.ValidateBasic())
+
+	cfg.API.EnableDiagnostics = true
+	require.ErrorContains(t, cfg.ValidateBasic(), "without a diagnostics token")
+
+	cfg.API.DiagnosticsToken = "secret"
+	require.NoError(t, cfg.ValidateBasic())
+}
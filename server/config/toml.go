@@ -86,6 +86,17 @@ iavl-disable-fastnode = {{ .BaseConfig.IAVLDisableFastNode }}
 # The fallback is the db_backend value set in CometBFT's config.toml.
 app-db-backend = "{{ .BaseConfig.AppDBBackend }}"
 
+# ReplayCheck enables the block replay determinism checker. Every committed block
+# is re-executed against its pre-state in a shadow store and any divergence from
+# the committed execution is logged along with the offending transaction.
+# As it doubles the block execution time, it should only be enabled on
+# dedicated non-validator nodes.
+replay-check = {{ .BaseConfig.ReplayCheck }}
+
+# ReplayCheckExportDir defines the directory the divergence reports are written
+# to as <height>.json files. If empty, divergences are only logged.
+replay-check-export-dir = "{{ .BaseConfig.ReplayCheckExportDir }}"
+
 ###############################################################################
 ###                         Telemetry Configuration                         ###
 ###############################################################################
@@ -132,6 +143,23 @@ statsd-addr = "{{ .Telemetry.StatsdAddr }}"
 # Datadog. Only utilized if MetricsSink is set to "dogstatsd".
 datadog-hostname = "{{ .Telemetry.DatadogHostname }}"
 
+# AlertBlockTimeDrift defines, in seconds, the block time drift from the local
+# time above which a warning is logged. Zero disables the alert.
+alert-block-time-drift = {{ .Telemetry.AlertBlockTimeDrift }}
+
+# AlertRoundsPerHeight defines the number of consensus rounds per height above
+# which a warning is logged. Zero disables the alert.
+alert-rounds-per-height = {{ .Telemetry.AlertRoundsPerHeight }}
+
+# AlertProposalSize defines the block proposal size, in bytes, above which a
+# warning is logged. Zero disables the alert.
+alert-proposal-size = {{ .Telemetry.AlertProposalSize }}
+
+# AlertFailedProposals defines the number of proposals rejected by
+# ProcessProposal at a single height above which a warning is logged. Zero
+# disables the alert.
+alert-failed-proposals = {{ .Telemetry.AlertFailedProposals }}
+
 ###############################################################################
 ###                           API Configuration                             ###
 ###############################################################################
@@ -162,6 +190,15 @@ rpc-max-body-bytes = {{ .API.RPCMaxBodyBytes }}
 # EnableUnsafeCORS defines if CORS should be enabled (unsafe - use it at your own risk).
 enabled-unsafe-cors = {{ .API.EnableUnsafeCORS }}
 
+# EnableDiagnostics defines if the admin endpoints serving pprof profiles and
+# profiling the next blocks on demand (under /debug/pprof and /diagnostics)
+# should be registered.
+enable-diagnostics = {{ .API.EnableDiagnostics }}
+
+# DiagnosticsToken defines the bearer token required by the admin endpoints. It
+# must be set for the endpoints to be enabled.
+diagnostics-token = "{{ .API.DiagnosticsToken }}"
+
 ###############################################################################
 ###                           gRPC Configuration                            ###
 ###############################################################################
@@ -234,6 +271,28 @@ plugin = "{{ .Streaming.ABCI.Plugin }}"
 # stop-node-on-err specifies whether to stop the node on message delivery error.
 stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 
+# streaming.ipc specifies the configuration for the streaming of the committed
+# block results to local sidecar processes, e.g. risk engines or compliance
+# scanners. Every committed block is written to the connected sidecars as a
+# newline delimited JSON document holding its tx results and a summary of its
+# state changes.
+[streaming.ipc]
+
+# The path of the unix socket the sidecars connect to.
+# Streaming is only enabled if this is set.
+address = "{{ .Streaming.IPC.Address }}"
+
+# List of kv store keys whose state changes are summarized, as for streaming.abci.keys.
+keys = [{{ range .Streaming.IPC.Keys }}{{ printf "%q, " . }}{{end}}]
+
+# The number of blocks buffered for each sidecar. When the buffer of a sidecar is
+# full, the commit of the next block waits for it up to send-timeout, after which
+# the sidecar is disconnected.
+buffer-size = {{ .Streaming.IPC.BufferSize }}
+
+# The time the commit of a block waits for the sidecars whose buffer is full.
+send-timeout = "{{ .Streaming.IPC.SendTimeout }}"
+
 ###############################################################################
 ###                         Mempool                                         ###
 ###############################################################################
@@ -75,6 +75,10 @@ const (
 	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
 	FlagShutdownGrace       = "shutdown-grace"
 
+	// block replay check flags
+	FlagReplayCheck          = "replay-check"
+	FlagReplayCheckExportDir = "replay-check-export-dir"
+
 	// state sync-related flags
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
 	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
@@ -88,6 +92,7 @@ const (
 	FlagRPCWriteTimeout       = "api.rpc-write-timeout"
 	FlagRPCMaxBodyBytes       = "api.rpc-max-body-bytes"
 	FlagAPIEnableUnsafeCORS   = "api.enabled-unsafe-cors"
+	FlagAPIEnableDiagnostics  = "api.enable-diagnostics"
 
 	// gRPC-related flags
 	flagGRPCOnly      = "grpc-only"
@@ -496,6 +501,12 @@ func startGrpcServer(
 	return grpcSrv, clientCtx, nil
 }
 
+// blockProfilerApp is implemented by the applications embedding a BaseApp, whose
+// block profiler is exposed by the diagnostics endpoints.
+type blockProfilerApp interface {
+	BlockProfiler() *telemetry.BlockProfiler
+}
+
 func startAPIServer(
 	ctx context.Context,
 	g *errgroup.Group,
@@ -521,6 +532,16 @@ func startAPIServer(
 		apiSrv.SetTelemetry(metrics)
 	}
 
+	if svrCfg.API.EnableDiagnostics {
+		var profiler *telemetry.BlockProfiler
+		if p, ok := app.(blockProfilerApp); ok {
+			profiler = p.BlockProfiler()
+		}
+		if err := apiSrv.RegisterDiagnostics(profiler, svrCfg.API.DiagnosticsToken); err != nil {
+			return err
+		}
+	}
+
 	g.Go(func() error {
 		return apiSrv.Start(ctx, svrCfg)
 	})
@@ -973,6 +994,7 @@ func addStartNodeFlags[T types.Application](cmd *cobra.Command, opts StartCmdOpt
 	cmd.Flags().Uint(FlagRPCWriteTimeout, 0, "Define the CometBFT RPC write timeout (in seconds)")
 	cmd.Flags().Uint(FlagRPCMaxBodyBytes, 1000000, "Define the CometBFT maximum request body (in bytes)")
 	cmd.Flags().Bool(FlagAPIEnableUnsafeCORS, false, "Define if CORS should be enabled (unsafe - use it at your own risk)")
+	cmd.Flags().Bool(FlagAPIEnableDiagnostics, false, "Define if the pprof and block profiling admin endpoints should be registered (Note: the API must also be enabled)")
 	cmd.Flags().Bool(flagGRPCOnly, false, "Start the node in gRPC query only mode (no CometBFT process is started)")
 	cmd.Flags().Bool(flagGRPCEnable, true, "Define if the gRPC server should be enabled")
 	cmd.Flags().String(flagGRPCAddress, serverconfig.DefaultGRPCAddress, "the gRPC server address to listen on")
@@ -982,6 +1004,8 @@ func addStartNodeFlags[T types.Application](cmd *cobra.Command, opts StartCmdOpt
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
 	cmd.Flags().Int(FlagMempoolMaxTxs, mempool.DefaultMaxTx, "Sets MaxTx value for the app-side mempool")
 	cmd.Flags().Duration(FlagShutdownGrace, 0*time.Second, "On Shutdown, duration to wait for resource clean up")
+	cmd.Flags().Bool(FlagReplayCheck, false, "Re-execute every committed block against its pre-state and report non-deterministic state transitions")
+	cmd.Flags().String(FlagReplayCheckExportDir, "", "Directory to write the block replay divergence reports to")
 
 	// support old flags name for backwards compatibility
 	cmd.Flags().SetNormalizeFunc(func(f *pflag.FlagSet, name string) pflag.NormalizedName {
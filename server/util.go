@@ -34,6 +34,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -528,6 +529,13 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		)
 	}
 
+	telemetryCfg := telemetry.Config{
+		AlertBlockTimeDrift:  cast.ToInt64(appOpts.Get("telemetry.alert-block-time-drift")),
+		AlertRoundsPerHeight: cast.ToUint32(appOpts.Get("telemetry.alert-rounds-per-height")),
+		AlertProposalSize:    cast.ToInt64(appOpts.Get("telemetry.alert-proposal-size")),
+		AlertFailedProposals: cast.ToUint64(appOpts.Get("telemetry.alert-failed-proposals")),
+	}
+
 	return []func(*baseapp.BaseApp){
 		baseapp.SetPruning(pruningOpts),
 		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(FlagMinGasPrices))),
@@ -543,6 +551,9 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		defaultMempool,
 		baseapp.SetChainID(chainID),
 		baseapp.SetQueryGasLimit(cast.ToUint64(appOpts.Get(FlagQueryGasLimit))),
+		baseapp.SetBlockHealthThresholds(telemetryCfg.BlockHealthThresholds()),
+		baseapp.SetReplayCheck(cast.ToBool(appOpts.Get(FlagReplayCheck)), cast.ToString(appOpts.Get(FlagReplayCheckExportDir))),
+		baseapp.SetBlockProfiling(cast.ToBool(appOpts.Get(FlagAPIEnable)) && cast.ToBool(appOpts.Get(FlagAPIEnableDiagnostics))),
 	}
 }
 
@@ -39,12 +39,18 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		ante.NewValidateBasicDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
-		ante.NewUnorderedTxDecorator(unorderedtx.DefaultMaxUnOrderedTTL, options.TxManager),
+		ante.NewUnorderedTxDecorator(unorderedtx.DefaultMaxTimeoutDuration, options.TxManager),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		ante.NewFeeAbstractionDecorator(options.FeeAbstractionKeeper, options.BankKeeper, options.FeeRateProvider),
+		ante.NewTieredGasPricingDecorator(options.GasPricingKeeper),
 		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		ante.NewFeeMarketDecorator(options.FeeMarketKeeper, options.BankKeeper),
+		ante.NewFeeRoutingDecorator(options.FeeRoutingKeeper, options.BankKeeper),
+		ante.NewMsgSurchargeDecorator(options.MsgSurchargeKeeper, options.BankKeeper),
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
-		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigGasConsumer, options.AccountAbstractionKeeper),
+		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigGasConsumer, options.AccountAbstractionKeeper).
+			WithSmartAccounts(options.SmartAccountKeeper, options.SpendingBankKeeper),
 	}
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
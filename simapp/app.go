@@ -15,6 +15,7 @@ import (
 	"github.com/spf13/cast"
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	overviewv1 "cosmossdk.io/api/cosmos/overview/v1"
 	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
 	"cosmossdk.io/client/v2/autocli"
 	"cosmossdk.io/core/appmodule"
@@ -118,15 +119,19 @@ var (
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName:     nil,
-		distrtypes.ModuleName:          nil,
-		pooltypes.ModuleName:           nil,
-		pooltypes.StreamAccount:        nil,
-		minttypes.ModuleName:           {authtypes.Minter},
-		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:            {authtypes.Burner},
-		nft.ModuleName:                 nil,
+		authtypes.FeeCollectorName:      {authtypes.Burner},
+		distrtypes.ModuleName:           nil,
+		pooltypes.ModuleName:            {authtypes.Burner},
+		pooltypes.StreamAccount:         nil,
+		pooltypes.EscrowAccount:         nil,
+		minttypes.ModuleName:            {authtypes.Minter},
+		stakingtypes.BondedPoolName:     {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:  {authtypes.Burner, authtypes.Staking},
+		stakingtypes.ModuleName:         {authtypes.Burner},
+		stakingtypes.ShareTokenPoolName: {authtypes.Minter, authtypes.Burner},
+		govtypes.ModuleName:             {authtypes.Burner},
+		nft.ModuleName:                  nil,
+		slashingtypes.ModuleName:        nil,
 	}
 )
 
@@ -259,6 +264,8 @@ func NewSimApp(
 		authzkeeper.StoreKey, nftkeeper.StoreKey, group.StoreKey, pooltypes.StoreKey,
 		accounts.StoreKey,
 	)
+	memKeys := storetypes.NewMemoryStoreKeys(pooltypes.MemStoreKey)
+	tkeys := storetypes.NewTransientStoreKeys(banktypes.TStoreKey)
 
 	// register streaming services
 	if err := bApp.RegisterStreamingServices(appOpts, keys); err != nil {
@@ -310,6 +317,7 @@ func NewSimApp(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		logger,
 	)
+	app.BankKeeper.SetModuleTransferTraceStore(runtime.NewTransientStoreService(tkeys[banktypes.TStoreKey]))
 
 	// optional: enable sign mode textual by overwriting the default tx config (after setting the bank keeper)
 	enabledSignModes := append(authtx.DefaultSignModes, sigtypes.SignMode_SIGN_MODE_TEXTUAL)
@@ -330,13 +338,17 @@ func NewSimApp(
 		appCodec, runtime.NewEnvironment(runtime.NewKVStoreService(keys[stakingtypes.StoreKey]), logger), app.AuthKeeper, app.BankKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String(), authcodec.NewBech32Codec(sdk.Bech32PrefixValAddr), authcodec.NewBech32Codec(sdk.Bech32PrefixConsAddr),
 	)
 	app.MintKeeper = mintkeeper.NewKeeper(appCodec, runtime.NewEnvironment(runtime.NewKVStoreService(keys[minttypes.StoreKey]), logger), app.StakingKeeper, app.AuthKeeper, app.BankKeeper, authtypes.FeeCollectorName, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	app.BankKeeper.SetBurnHooks(app.MintKeeper.Hooks())
 
-	app.PoolKeeper = poolkeeper.NewKeeper(appCodec, runtime.NewEnvironment(runtime.NewKVStoreService(keys[pooltypes.StoreKey]), logger), app.AuthKeeper, app.BankKeeper, app.StakingKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	poolEnv := runtime.NewEnvironment(runtime.NewKVStoreService(keys[pooltypes.StoreKey]), logger)
+	poolEnv.MemStoreService = runtime.NewMemStoreService(memKeys[pooltypes.MemStoreKey])
+	app.PoolKeeper = poolkeeper.NewKeeper(appCodec, poolEnv, app.AuthKeeper, app.BankKeeper, app.StakingKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	app.DistrKeeper = distrkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[distrtypes.StoreKey]), app.AuthKeeper, app.BankKeeper, app.StakingKeeper, app.PoolKeeper, authtypes.FeeCollectorName, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	app.DistrKeeper.SetMintKeeper(app.MintKeeper)
 
 	app.SlashingKeeper = slashingkeeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[slashingtypes.StoreKey]), logger),
-		appCodec, legacyAmino, app.StakingKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		appCodec, legacyAmino, app.AuthKeeper, app.BankKeeper, app.StakingKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[feegrant.StoreKey]), logger), appCodec, app.AuthKeeper)
@@ -346,6 +358,11 @@ func NewSimApp(
 	app.StakingKeeper.SetHooks(
 		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
 	)
+	// break the ties for the last seats of the active set by validator uptime
+	app.StakingKeeper.SetPerformanceScorer(app.SlashingKeeper)
+	// withdraw the rewards of the auto-compounding delegations
+	app.StakingKeeper.SetRewardsWithdrawer(app.DistrKeeper)
+	app.StakingKeeper.SetCommunityPoolFunder(app.PoolKeeper)
 
 	app.CircuitKeeper = circuitkeeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[circuittypes.StoreKey]), logger), appCodec, authtypes.NewModuleAddress(govtypes.ModuleName).String(), app.AuthKeeper.AddressCodec())
 	app.BaseApp.SetCircuitBreaker(&app.CircuitKeeper)
@@ -361,6 +378,7 @@ func NewSimApp(
 		config.MaxProposalSummaryLen = 10200 	// example max summary length in characters
 	*/
 	app.GroupKeeper = groupkeeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[group.StoreKey]), logger), appCodec, app.MsgServiceRouter(), app.AuthKeeper, groupConfig)
+	app.GroupKeeper.SetFeegrantKeeper(app.FeeGrantKeeper)
 
 	// get skipUpgradeHeights from the app options
 	skipUpgradeHeights := map[int64]bool{}
@@ -369,7 +387,7 @@ func NewSimApp(
 	}
 	homePath := cast.ToString(appOpts.Get(flags.FlagHome))
 	// set the governance module account as the authority for conducting upgrades
-	app.UpgradeKeeper = upgradekeeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[upgradetypes.StoreKey]), logger), skipUpgradeHeights, appCodec, homePath, app.BaseApp, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	app.UpgradeKeeper = upgradekeeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[upgradetypes.StoreKey]), logger), skipUpgradeHeights, appCodec, homePath, app.BaseApp, app.StakingKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	// Register the proposal types
 	// Deprecated: Avoid adding new handlers, instead use the new proposal flow
@@ -391,15 +409,17 @@ func NewSimApp(
 
 	app.GovKeeper = *govKeeper.SetHooks(
 		govtypes.NewMultiGovHooks(
-		// register the governance hooks
+			// register the governance hooks
+			app.PoolKeeper.Hooks(),
 		),
 	)
+	app.PoolKeeper.SetGovMsgServer(govkeeper.NewMsgServerImpl(&app.GovKeeper))
 
 	app.NFTKeeper = nftkeeper.NewKeeper(runtime.NewEnvironment(runtime.NewKVStoreService(keys[nftkeeper.StoreKey]), logger), appCodec, app.AuthKeeper, app.BankKeeper)
 
 	// create evidence keeper with router
 	evidenceKeeper := evidencekeeper.NewKeeper(
-		appCodec, runtime.NewEnvironment(runtime.NewKVStoreService(keys[evidencetypes.StoreKey]), logger), app.StakingKeeper, app.SlashingKeeper, app.AuthKeeper.AddressCodec(),
+		appCodec, runtime.NewEnvironment(runtime.NewKVStoreService(keys[evidencetypes.StoreKey]), logger), app.StakingKeeper, app.SlashingKeeper, app.AuthKeeper.AddressCodec(), authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	app.EvidenceKeeper = *evidenceKeeper
@@ -412,7 +432,7 @@ func NewSimApp(
 		genutil.NewAppModule(app.AuthKeeper, app.StakingKeeper, app, txConfig, genutiltypes.DefaultMessageValidator),
 		accounts.NewAppModule(app.AccountsKeeper),
 		auth.NewAppModule(appCodec, app.AuthKeeper, authsims.RandomGenesisAccounts),
-		vesting.NewAppModule(app.AuthKeeper, app.BankKeeper),
+		vesting.NewAppModule(app.AuthKeeper, app.BankKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String()),
 		bank.NewAppModule(appCodec, app.BankKeeper, app.AuthKeeper),
 		feegrantmodule.NewAppModule(appCodec, app.AuthKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		gov.NewAppModule(appCodec, &app.GovKeeper, app.AuthKeeper, app.BankKeeper, app.PoolKeeper),
@@ -454,10 +474,14 @@ func NewSimApp(
 		stakingtypes.ModuleName,
 		genutiltypes.ModuleName,
 		feegrant.ModuleName,
+		nft.ModuleName,
 		group.ModuleName,
 		pooltypes.ModuleName,
+		banktypes.ModuleName,
 	)
 
+	app.ModuleManager.SetOrderPrecommiters(pooltypes.ModuleName)
+
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
 	// NOTE: The genutils module must also occur after auth so that it can access the params from auth.
@@ -492,6 +516,8 @@ func NewSimApp(
 	}
 	reflectionv1.RegisterReflectionServiceServer(app.GRPCQueryRouter(), reflectionSvc)
 
+	overviewv1.RegisterQueryServer(app.GRPCQueryRouter(), runtimeservices.NewAccountOverviewService(app.GRPCQueryRouter()))
+
 	// add test gRPC service for testing gRPC queries in isolation
 	testdata_pulsar.RegisterQueryServer(app.GRPCQueryRouter(), testdata_pulsar.QueryImpl{})
 
@@ -513,6 +539,9 @@ func NewSimApp(
 		panic(fmt.Errorf("failed to initialize unordered tx manager: %w", err))
 	}
 
+	// replay the blocks from the unordered txs tracked before them
+	app.SetReplayStateSnapshotters(app.UnorderedTxManager)
+
 	// register custom snapshot extensions (if any)
 	if manager := app.SnapshotManager(); manager != nil {
 		err := manager.RegisterExtensions(
@@ -527,12 +556,15 @@ func NewSimApp(
 
 	// initialize stores
 	app.MountKVStores(keys)
+	app.MountMemoryStores(memKeys)
+	app.MountTransientStores(tkeys)
 
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetPreBlocker(app.PreBlocker)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
+	app.SetPrecommiter(app.Precommiter)
 	app.setAnteHandler(txConfig)
 
 	// In v0.46, the SDK introduces _postHandlers_. PostHandlers are like
@@ -581,7 +613,14 @@ func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
 				BankKeeper:               app.BankKeeper,
 				SignModeHandler:          txConfig.SignModeHandler(),
 				FeegrantKeeper:           app.FeeGrantKeeper,
+				FeeMarketKeeper:          app.AuthKeeper,
+				FeeAbstractionKeeper:     app.AuthKeeper,
+				FeeRoutingKeeper:         app.AuthKeeper,
+				GasPricingKeeper:         app.AuthKeeper,
+				MsgSurchargeKeeper:       app.AuthKeeper,
 				SigGasConsumer:           ante.DefaultSigVerificationGasConsumer,
+				SmartAccountKeeper:       app.AuthKeeper,
+				SpendingBankKeeper:       app.BankKeeper,
 			},
 			&app.CircuitKeeper,
 			app.UnorderedTxManager,
@@ -597,7 +636,11 @@ func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
 
 func (app *SimApp) setPostHandler() {
 	postHandler, err := posthandler.NewPostHandler(
-		posthandler.HandlerOptions{},
+		posthandler.HandlerOptions{
+			FeeMarketKeeper:    app.AuthKeeper,
+			SmartAccountKeeper: app.AuthKeeper,
+			BankKeeper:         app.BankKeeper,
+		},
 	)
 	if err != nil {
 		panic(err)
@@ -617,6 +660,9 @@ func (app *SimApp) Name() string { return app.BaseApp.Name() }
 
 // PreBlocker application updates every pre block
 func (app *SimApp) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	// purge the unordered txs whose timeout timestamp is past the block time
+	app.UnorderedTxManager.OnNewBlock(ctx.HeaderInfo().Time)
+
 	return app.ModuleManager.PreBlock(ctx)
 }
 
@@ -630,6 +676,13 @@ func (app *SimApp) EndBlocker(ctx sdk.Context) (sdk.EndBlock, error) {
 	return app.ModuleManager.EndBlock(ctx)
 }
 
+// Precommiter application updates every commit
+func (app *SimApp) Precommiter(ctx sdk.Context) {
+	if err := app.ModuleManager.Precommit(ctx); err != nil {
+		panic(err)
+	}
+}
+
 func (a *SimApp) Configurator() module.Configurator { // nolint:staticcheck // SA1019: Configurator is deprecated but still used in runtime v1.
 	return a.configurator
 }
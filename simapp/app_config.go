@@ -70,15 +70,19 @@ import (
 var (
 	// module account permissions
 	moduleAccPerms = []*authmodulev1.ModuleAccountPermission{
-		{Account: authtypes.FeeCollectorName},
+		{Account: authtypes.FeeCollectorName, Permissions: []string{authtypes.Burner}},
 		{Account: distrtypes.ModuleName},
-		{Account: pooltypes.ModuleName},
+		{Account: pooltypes.ModuleName, Permissions: []string{authtypes.Burner}},
 		{Account: pooltypes.StreamAccount},
+		{Account: pooltypes.EscrowAccount},
 		{Account: minttypes.ModuleName, Permissions: []string{authtypes.Minter}},
 		{Account: stakingtypes.BondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
 		{Account: stakingtypes.NotBondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
+		{Account: stakingtypes.ModuleName, Permissions: []string{authtypes.Burner}},
+		{Account: stakingtypes.ShareTokenPoolName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
 		{Account: govtypes.ModuleName, Permissions: []string{authtypes.Burner}},
 		{Account: nft.ModuleName},
+		{Account: slashingtypes.ModuleName},
 	}
 
 	// blocked account addresses
@@ -88,6 +92,8 @@ var (
 		minttypes.ModuleName,
 		stakingtypes.BondedPoolName,
 		stakingtypes.NotBondedPoolName,
+		stakingtypes.ModuleName,
+		stakingtypes.ShareTokenPoolName,
 		nft.ModuleName,
 		// We allow the following module accounts to receive funds:
 		// govtypes.ModuleName
@@ -121,8 +127,13 @@ var (
 						govtypes.ModuleName,
 						stakingtypes.ModuleName,
 						feegrant.ModuleName,
+						nft.ModuleName,
 						group.ModuleName,
 						pooltypes.ModuleName,
+						banktypes.ModuleName,
+					},
+					Precommiters: []string{
+						pooltypes.ModuleName,
 					},
 					OverrideStoreKeys: []*runtimev1alpha1.StoreKeyConfig{
 						{
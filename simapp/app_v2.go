@@ -9,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/spf13/cast"
 
@@ -47,6 +48,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	testdata_pulsar "github.com/cosmos/cosmos-sdk/testutil/testdata/testpb"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	consensuskeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
 )
@@ -279,6 +281,9 @@ func NewSimApp(
 		panic(fmt.Errorf("failed to initialize unordered tx manager: %w", err))
 	}
 
+	// replay the blocks from the unordered txs tracked before them
+	app.SetReplayStateSnapshotters(app.UnorderedTxManager)
+
 	// register custom snapshot extensions (if any)
 	if manager := app.SnapshotManager(); manager != nil {
 		err := manager.RegisterExtensions(
@@ -289,6 +294,9 @@ func NewSimApp(
 		}
 	}
 
+	// purge the expired unordered txs every block
+	app.SetPreBlocker(app.PreBlocker)
+
 	if err := app.Load(loadLatest); err != nil {
 		panic(err)
 	}
@@ -296,6 +304,14 @@ func NewSimApp(
 	return app
 }
 
+// PreBlocker application updates every pre block
+func (app *SimApp) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	// purge the unordered txs whose timeout timestamp is past the block time
+	app.UnorderedTxManager.OnNewBlock(ctx.HeaderInfo().Time)
+
+	return app.App.PreBlocker(ctx, req)
+}
+
 // Close implements the Application interface and closes all necessary application
 // resources.
 func (app *SimApp) Close() error {
@@ -74,6 +74,11 @@ func (app *SimApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs []
 
 	/* Handle fee distribution state. */
 
+	// write the pending reward allocations to the records of the validators
+	if err := app.DistrKeeper.FlushPendingRewardAllocations(ctx); err != nil {
+		panic(err)
+	}
+
 	// withdraw all validator commission
 	err := app.StakingKeeper.IterateValidators(ctx, func(_ int64, val sdk.ValidatorI) (stop bool) {
 		valBz, err := app.StakingKeeper.ValidatorAddressCodec().StringToBytes(val.GetOperator())
@@ -115,6 +120,10 @@ func (app *SimApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs []
 	if err != nil {
 		panic(err)
 	}
+	err = app.DistrKeeper.HistoricalRewardsCount.Remove(ctx)
+	if err != nil {
+		panic(err)
+	}
 
 	// set context height to zero
 	height := ctx.BlockHeight()
@@ -2,13 +2,16 @@ package snapshots
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha256"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"math"
 	"os"
 	"sort"
+	"strconv"
 	"sync"
 
 	errorsmod "cosmossdk.io/errors"
@@ -39,6 +42,10 @@ type Manager struct {
 	commitSnapshotter CommitSnapshotter
 	// storageSnapshotter is the snapshotter for the storage state.
 	storageSnapshotter StorageSnapshotter
+	// manifestSigner signs the snapshot manifests, they are left unsigned if nil.
+	manifestSigner ManifestSigner
+	// mirrors are the remote locations snapshots are published to once taken.
+	mirrors []Mirror
 
 	logger log.Logger
 
@@ -107,6 +114,16 @@ func (m *Manager) RegisterExtensions(extensions ...ExtensionSnapshotter) error {
 	return nil
 }
 
+// SetManifestSigner sets the signer of the manifests produced for new snapshots.
+func (m *Manager) SetManifestSigner(signer ManifestSigner) {
+	m.manifestSigner = signer
+}
+
+// RegisterMirrors registers mirrors new snapshots are published to once taken.
+func (m *Manager) RegisterMirrors(mirrors ...Mirror) {
+	m.mirrors = append(m.mirrors, mirrors...)
+}
+
 // begin starts an operation, or errors if one is in progress. It manages the mutex itself.
 func (m *Manager) begin(op operation) error {
 	m.mtx.Lock()
@@ -188,7 +205,37 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 	ch := make(chan io.ReadCloser)
 	go m.createSnapshot(height, ch)
 
-	return m.store.Save(height, types.CurrentFormat, ch)
+	snapshot, err := m.store.Save(height, types.CurrentFormat, ch)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.createManifest(snapshot); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to create snapshot manifest")
+	}
+	return snapshot, nil
+}
+
+// createManifest creates, signs if a signer is set, and saves the manifest of a snapshot.
+func (m *Manager) createManifest(snapshot *types.Snapshot) error {
+	var appHash []byte
+	if getter, ok := m.commitSnapshotter.(commitInfoGetter); ok {
+		commitInfo, err := getter.GetCommitInfo(snapshot.Height)
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to get commit info at height %v", snapshot.Height)
+		}
+		if commitInfo != nil {
+			appHash = commitInfo.Hash()
+		}
+	}
+
+	manifest := NewManifest(snapshot, appHash)
+	if m.manifestSigner != nil {
+		if err := manifest.Sign(m.manifestSigner); err != nil {
+			return errorsmod.Wrap(err, "failed to sign snapshot manifest")
+		}
+	}
+	return m.store.SaveManifest(manifest)
 }
 
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
@@ -257,6 +304,50 @@ func (m *Manager) CreateMigration(height uint64, protoWriter WriteCloser) error
 	return nil
 }
 
+// Manifest returns the manifest of a snapshot, or nil if the snapshot has no manifest.
+// It can be called concurrently with other operations.
+func (m *Manager) Manifest(height uint64, format uint32) (*Manifest, error) {
+	return m.store.LoadManifest(height, format)
+}
+
+// Publish uploads the chunks and then the manifest of a snapshot to every registered
+// mirror. The manifest is uploaded last, so that consumers seeing it on a mirror can
+// fetch all of the chunks. It can be called concurrently with other operations.
+func (m *Manager) Publish(ctx context.Context, height uint64, format uint32) error {
+	manifest, err := m.store.LoadManifest(height, format)
+	if err != nil {
+		return err
+	}
+	if manifest == nil {
+		return errorsmod.Wrapf(store.ErrLogic, "snapshot manifest doesn't exist, height: %d, format: %d", height, format)
+	}
+	manifestBz, err := json.Marshal(manifest)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to encode snapshot manifest")
+	}
+
+	prefix := fmt.Sprintf("%d/%d/", height, format)
+	for _, mirror := range m.mirrors {
+		for index := uint32(0); index < manifest.Chunks; index++ {
+			chunk, err := m.LoadChunk(height, format, index)
+			if err != nil {
+				return err
+			}
+			if chunk == nil {
+				return errorsmod.Wrapf(store.ErrLogic, "snapshot chunk doesn't exist, height: %d, format: %d, chunk: %d", height, format, index)
+			}
+			if err := mirror.Put(ctx, prefix+strconv.FormatUint(uint64(index), 10), chunk); err != nil {
+				return errorsmod.Wrapf(err, "failed to publish snapshot to mirror %s", mirror.Name())
+			}
+		}
+
+		if err := mirror.Put(ctx, prefix+ManifestFilename, manifestBz); err != nil {
+			return errorsmod.Wrapf(err, "failed to publish snapshot to mirror %s", mirror.Name())
+		}
+	}
+	return nil
+}
+
 // List lists snapshots, mirroring ABCI ListSnapshots. It can be concurrent with other operations.
 func (m *Manager) List() ([]*types.Snapshot, error) {
 	return m.store.List()
@@ -585,6 +676,14 @@ func (m *Manager) snapshot(height int64) {
 
 	m.logger.Info("completed state snapshot", "height", height, "format", snapshot.Format)
 
+	if len(m.mirrors) > 0 {
+		if err := m.Publish(context.Background(), snapshot.Height, snapshot.Format); err != nil {
+			m.logger.Error("failed to publish state snapshot", "height", height, "err", err)
+		} else {
+			m.logger.Info("published state snapshot", "height", height, "mirrors", len(m.mirrors))
+		}
+	}
+
 	if m.opts.KeepRecent > 0 {
 		m.logger.Debug("pruning state snapshots")
 
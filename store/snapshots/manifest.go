@@ -0,0 +1,163 @@
+package snapshots
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"strings"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/store/v2/snapshots/types"
+)
+
+// ManifestFilename is the name of the manifest file stored next to the chunks of a snapshot.
+const ManifestFilename = "manifest.json"
+
+// Manifest describes a snapshot so that state sync consumers fetching it from a mirror
+// can verify the chunks and the app hash it restores to without trusting the mirror.
+type Manifest struct {
+	Height      uint64   `json:"height"`
+	Format      uint32   `json:"format"`
+	Chunks      uint32   `json:"chunks"`
+	Hash        []byte   `json:"hash"`
+	AppHash     []byte   `json:"app_hash"`
+	ChunkHashes [][]byte `json:"chunk_hashes"`
+	// PubKey is the ed25519 public key of the manifest signer, empty if unsigned.
+	PubKey []byte `json:"pub_key,omitempty"`
+	// Signature is the ed25519 signature of SignBytes, empty if unsigned.
+	Signature []byte `json:"signature,omitempty"`
+}
+
+// NewManifest creates an unsigned manifest for the given snapshot. The app hash is the
+// hash of the committed state at the snapshot height.
+func NewManifest(snapshot *types.Snapshot, appHash []byte) *Manifest {
+	return &Manifest{
+		Height:      snapshot.Height,
+		Format:      snapshot.Format,
+		Chunks:      snapshot.Chunks,
+		Hash:        snapshot.Hash,
+		AppHash:     appHash,
+		ChunkHashes: snapshot.Metadata.ChunkHashes,
+	}
+}
+
+// SignBytes returns the bytes signed by the manifest signer, the JSON encoding of the
+// manifest without its signature.
+func (m *Manifest) SignBytes() ([]byte, error) {
+	unsigned := *m
+	unsigned.Signature = nil
+	return json.Marshal(unsigned)
+}
+
+// Sign signs the manifest with the given signer.
+func (m *Manifest) Sign(signer ManifestSigner) error {
+	m.PubKey = signer.PubKey()
+	signBytes, err := m.SignBytes()
+	if err != nil {
+		return err
+	}
+
+	m.Signature, err = signer.Sign(signBytes)
+	return err
+}
+
+// Verify checks that the manifest is signed by the given trusted public key.
+func (m *Manifest) Verify(pubKey ed25519.PublicKey) error {
+	if len(m.Signature) == 0 {
+		return errors.Wrap(types.ErrInvalidManifestSignature, "manifest is not signed")
+	}
+	if len(pubKey) != ed25519.PublicKeySize || !bytes.Equal(m.PubKey, pubKey) {
+		return errors.Wrapf(types.ErrInvalidManifestSignature, "manifest is signed by %X, expected %X", m.PubKey, []byte(pubKey))
+	}
+
+	signBytes, err := m.SignBytes()
+	if err != nil {
+		return err
+	}
+	if !ed25519.Verify(pubKey, signBytes, m.Signature) {
+		return errors.Wrap(types.ErrInvalidManifestSignature, "signature verification failed")
+	}
+	return nil
+}
+
+// ValidateSnapshot checks that the given snapshot metadata matches the manifest.
+func (m *Manifest) ValidateSnapshot(snapshot *types.Snapshot) error {
+	if snapshot.Height != m.Height || snapshot.Format != m.Format || snapshot.Chunks != m.Chunks {
+		return errors.Wrapf(types.ErrInvalidManifest, "snapshot at height %v format %v with %v chunks, expected height %v format %v with %v chunks",
+			snapshot.Height, snapshot.Format, snapshot.Chunks, m.Height, m.Format, m.Chunks)
+	}
+	if !bytes.Equal(snapshot.Hash, m.Hash) {
+		return errors.Wrapf(types.ErrInvalidManifest, "snapshot hash %X, expected %X", snapshot.Hash, m.Hash)
+	}
+	if len(snapshot.Metadata.ChunkHashes) != len(m.ChunkHashes) {
+		return errors.Wrapf(types.ErrInvalidManifest, "snapshot has %v chunk hashes, expected %v",
+			len(snapshot.Metadata.ChunkHashes), len(m.ChunkHashes))
+	}
+	for i, chunkHash := range snapshot.Metadata.ChunkHashes {
+		if !bytes.Equal(chunkHash, m.ChunkHashes[i]) {
+			return errors.Wrapf(types.ErrInvalidManifest, "chunk %v hash %X, expected %X", i, chunkHash, m.ChunkHashes[i])
+		}
+	}
+	return nil
+}
+
+// VerifyChunk checks the hash of the chunk with the given index against the manifest.
+func (m *Manifest) VerifyChunk(index uint32, chunk []byte) error {
+	if index >= uint32(len(m.ChunkHashes)) {
+		return errors.Wrapf(types.ErrInvalidManifest, "chunk %v out of range, manifest has %v chunks", index, len(m.ChunkHashes))
+	}
+
+	hash := sha256.Sum256(chunk)
+	if !bytes.Equal(hash[:], m.ChunkHashes[index]) {
+		return errors.Wrapf(types.ErrChunkHashMismatch, "chunk %v: expected %X, got %X", index, m.ChunkHashes[index], hash)
+	}
+	return nil
+}
+
+// ManifestSigner signs snapshot manifests.
+type ManifestSigner interface {
+	// PubKey returns the ed25519 public key matching the signatures.
+	PubKey() []byte
+
+	// Sign returns the ed25519 signature of msg.
+	Sign(msg []byte) ([]byte, error)
+}
+
+type ed25519Signer struct {
+	key ed25519.PrivateKey
+}
+
+// NewEd25519ManifestSigner returns a ManifestSigner signing with the given ed25519 key.
+func NewEd25519ManifestSigner(key ed25519.PrivateKey) ManifestSigner {
+	return ed25519Signer{key: key}
+}
+
+// LoadManifestSigner loads an ed25519 ManifestSigner from a file containing the hex
+// encoded 32 bytes seed of the key.
+func LoadManifestSigner(path string) (ManifestSigner, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read manifest key file %q", path)
+	}
+
+	seed, err := hex.DecodeString(strings.TrimSpace(string(bz)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode manifest key file %q", path)
+	}
+	if len(seed) != ed25519.SeedSize {
+		return nil, errors.Wrapf(types.ErrInvalidManifestSignature, "manifest key seed must be %v bytes, got %v", ed25519.SeedSize, len(seed))
+	}
+
+	return NewEd25519ManifestSigner(ed25519.NewKeyFromSeed(seed)), nil
+}
+
+func (s ed25519Signer) PubKey() []byte {
+	return s.key.Public().(ed25519.PublicKey)
+}
+
+func (s ed25519Signer) Sign(msg []byte) ([]byte, error) {
+	return ed25519.Sign(s.key, msg), nil
+}
@@ -0,0 +1,198 @@
+package snapshots_test
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2/proof"
+	"cosmossdk.io/store/v2/snapshots"
+	"cosmossdk.io/store/v2/snapshots/types"
+)
+
+// commitInfoSnapshotter is a mockCommitSnapshotter exposing the commit info of every version.
+type commitInfoSnapshotter struct {
+	mockCommitSnapshotter
+	commitHash []byte
+}
+
+func (m *commitInfoSnapshotter) GetCommitInfo(version uint64) (*proof.CommitInfo, error) {
+	return &proof.CommitInfo{
+		Version:    version,
+		StoreInfos: []proof.StoreInfo{{Name: "store"}},
+		CommitHash: m.commitHash,
+	}, nil
+}
+
+func TestManager_Manifest(t *testing.T) {
+	store := setupStore(t)
+	appHash := sha256.Sum256([]byte("app hash"))
+	commitSnapshotter := &commitInfoSnapshotter{
+		mockCommitSnapshotter: mockCommitSnapshotter{items: [][]byte{{1, 2, 3}, {4, 5, 6}}},
+		commitHash:            appHash[:],
+	}
+	manager := snapshots.NewManager(store, opts, commitSnapshotter, &mockStorageSnapshotter{}, nil, log.NewNopLogger())
+
+	_, key, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	manager.SetManifestSigner(snapshots.NewEd25519ManifestSigner(key))
+
+	snapshot, err := manager.Create(5)
+	require.NoError(t, err)
+
+	manifest, err := manager.Manifest(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	require.NotNil(t, manifest)
+	require.Equal(t, snapshot.Height, manifest.Height)
+	require.Equal(t, snapshot.Format, manifest.Format)
+	require.Equal(t, snapshot.Chunks, manifest.Chunks)
+	require.Equal(t, snapshot.Hash, manifest.Hash)
+	require.Equal(t, appHash[:], manifest.AppHash)
+	require.Equal(t, snapshot.Metadata.ChunkHashes, manifest.ChunkHashes)
+
+	require.NoError(t, manifest.Verify(key.Public().(ed25519.PublicKey)))
+	require.NoError(t, manifest.ValidateSnapshot(snapshot))
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := manager.LoadChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+		require.NoError(t, manifest.VerifyChunk(i, chunk))
+	}
+
+	// missing manifests should return nil
+	manifest, err = manager.Manifest(9, snapshot.Format)
+	require.NoError(t, err)
+	require.Nil(t, manifest)
+
+	// the manifest should be removed with its snapshot
+	require.NoError(t, store.Delete(snapshot.Height, snapshot.Format))
+	manifest, err = manager.Manifest(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	require.Nil(t, manifest)
+}
+
+func TestManifest_Verify(t *testing.T) {
+	pubKey, key, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	otherPubKey, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	newManifest := func() *snapshots.Manifest {
+		return snapshots.NewManifest(&types.Snapshot{
+			Height:   3,
+			Format:   types.CurrentFormat,
+			Chunks:   2,
+			Hash:     []byte{1, 2, 3},
+			Metadata: types.Metadata{ChunkHashes: checksums([][]byte{{3, 1, 0}, {3, 1, 1}})},
+		}, []byte{4, 5, 6})
+	}
+
+	testCases := []struct {
+		name   string
+		malate func(m *snapshots.Manifest)
+		pubKey ed25519.PublicKey
+		expErr error
+	}{
+		{
+			name:   "valid",
+			malate: func(m *snapshots.Manifest) {},
+			pubKey: pubKey,
+		},
+		{
+			name:   "unsigned",
+			malate: func(m *snapshots.Manifest) { m.Signature = nil },
+			pubKey: pubKey,
+			expErr: types.ErrInvalidManifestSignature,
+		},
+		{
+			name:   "untrusted signer",
+			malate: func(m *snapshots.Manifest) {},
+			pubKey: otherPubKey,
+			expErr: types.ErrInvalidManifestSignature,
+		},
+		{
+			name:   "tampered app hash",
+			malate: func(m *snapshots.Manifest) { m.AppHash = []byte{6, 5, 4} },
+			pubKey: pubKey,
+			expErr: types.ErrInvalidManifestSignature,
+		},
+		{
+			name:   "tampered chunk hash",
+			malate: func(m *snapshots.Manifest) { m.ChunkHashes[1] = []byte{1} },
+			pubKey: pubKey,
+			expErr: types.ErrInvalidManifestSignature,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			manifest := newManifest()
+			require.NoError(t, manifest.Sign(snapshots.NewEd25519ManifestSigner(key)))
+			tc.malate(manifest)
+
+			err := manifest.Verify(tc.pubKey)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestManifest_ValidateSnapshot(t *testing.T) {
+	chunks := [][]byte{{3, 1, 0}, {3, 1, 1}}
+	snapshot := &types.Snapshot{
+		Height:   3,
+		Format:   types.CurrentFormat,
+		Chunks:   2,
+		Hash:     hash(chunks),
+		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
+	}
+	manifest := snapshots.NewManifest(snapshot, nil)
+	require.NoError(t, manifest.ValidateSnapshot(snapshot))
+
+	other := *snapshot
+	other.Height = 4
+	require.ErrorIs(t, manifest.ValidateSnapshot(&other), types.ErrInvalidManifest)
+
+	other = *snapshot
+	other.Hash = []byte{1}
+	require.ErrorIs(t, manifest.ValidateSnapshot(&other), types.ErrInvalidManifest)
+
+	other = *snapshot
+	other.Metadata = types.Metadata{ChunkHashes: checksums([][]byte{{3, 1, 0}, {3, 1, 2}})}
+	require.ErrorIs(t, manifest.ValidateSnapshot(&other), types.ErrInvalidManifest)
+
+	require.NoError(t, manifest.VerifyChunk(1, chunks[1]))
+	require.ErrorIs(t, manifest.VerifyChunk(1, chunks[0]), types.ErrChunkHashMismatch)
+	require.ErrorIs(t, manifest.VerifyChunk(2, chunks[0]), types.ErrInvalidManifest)
+}
+
+func TestLoadManifestSigner(t *testing.T) {
+	dir := GetTempDir(t)
+	seed := make([]byte, ed25519.SeedSize)
+	seed[0] = 1
+
+	path := filepath.Join(dir, "key")
+	require.NoError(t, os.WriteFile(path, []byte(hex.EncodeToString(seed)+"\n"), 0o600))
+	signer, err := snapshots.LoadManifestSigner(path)
+	require.NoError(t, err)
+	require.Equal(t, []byte(ed25519.NewKeyFromSeed(seed).Public().(ed25519.PublicKey)), signer.PubKey())
+
+	require.NoError(t, os.WriteFile(path, []byte(hex.EncodeToString(seed[:16])), 0o600))
+	_, err = snapshots.LoadManifestSigner(path)
+	require.ErrorIs(t, err, types.ErrInvalidManifestSignature)
+
+	require.NoError(t, os.WriteFile(path, []byte("not hex"), 0o600))
+	_, err = snapshots.LoadManifestSigner(path)
+	require.Error(t, err)
+
+	_, err = snapshots.LoadManifestSigner(filepath.Join(dir, "missing"))
+	require.Error(t, err)
+}
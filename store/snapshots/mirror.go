@@ -0,0 +1,207 @@
+package snapshots
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/store/v2"
+)
+
+const (
+	// MirrorTypeHTTP publishes snapshots with HTTP PUT requests below a base URL.
+	MirrorTypeHTTP = "http"
+	// MirrorTypeS3 publishes snapshots to an S3 compatible bucket.
+	MirrorTypeS3 = "s3"
+
+	mirrorTimeout = 5 * time.Minute
+)
+
+// Mirror is a remote location snapshots are published to, so that state sync
+// consumers can fetch them without connecting to the node.
+//
+// A snapshot is published as its chunks under "<height>/<format>/<index>" followed by
+// its manifest under "<height>/<format>/manifest.json".
+type Mirror interface {
+	// Name returns a name identifying the mirror in logs.
+	Name() string
+
+	// Put uploads body under the given key.
+	Put(ctx context.Context, key string, body []byte) error
+}
+
+// MirrorConfig defines the configuration of a snapshot mirror.
+type MirrorConfig struct {
+	// Type is the type of mirror, either "http" or "s3".
+	Type string `mapstructure:"type"`
+
+	// URL is the base URL of an http mirror, or the endpoint of an s3 mirror
+	// (e.g. https://s3.us-east-1.amazonaws.com).
+	URL string `mapstructure:"url"`
+
+	// Headers are additional headers sent with every request of an http mirror.
+	Headers map[string]string `mapstructure:"headers"`
+
+	// Bucket is the bucket of an s3 mirror.
+	Bucket string `mapstructure:"bucket"`
+
+	// Prefix is prepended to the keys of an s3 mirror.
+	Prefix string `mapstructure:"prefix"`
+
+	// Region is the region of an s3 mirror.
+	Region string `mapstructure:"region"`
+
+	// AccessKeyID and SecretAccessKey are the credentials of an s3 mirror.
+	AccessKeyID     string `mapstructure:"access-key-id"`
+	SecretAccessKey string `mapstructure:"secret-access-key"`
+}
+
+// NewMirror creates a mirror from its configuration.
+func NewMirror(cfg MirrorConfig) (Mirror, error) {
+	base, err := url.Parse(cfg.URL)
+	if err != nil || base.Scheme == "" || base.Host == "" {
+		return nil, errors.Wrapf(store.ErrLogic, "invalid snapshot mirror url %q", cfg.URL)
+	}
+
+	switch cfg.Type {
+	case MirrorTypeHTTP:
+		return NewHTTPMirror(cfg.URL, cfg.Headers), nil
+
+	case MirrorTypeS3:
+		if cfg.Bucket == "" || cfg.Region == "" || cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
+			return nil, errors.Wrapf(store.ErrLogic, "s3 snapshot mirror %q requires a bucket, a region and credentials", cfg.URL)
+		}
+		return NewS3Mirror(cfg.URL, cfg.Bucket, cfg.Prefix, cfg.Region, cfg.AccessKeyID, cfg.SecretAccessKey), nil
+
+	default:
+		return nil, errors.Wrapf(store.ErrLogic, "unknown snapshot mirror type %q", cfg.Type)
+	}
+}
+
+// httpMirror publishes snapshots with HTTP PUT requests.
+type httpMirror struct {
+	baseURL string
+	headers map[string]string
+	client  *http.Client
+	// sign is called on every request before it is sent, with the request body.
+	sign func(req *http.Request, body []byte)
+}
+
+// NewHTTPMirror returns a mirror uploading every key with a PUT request to
+// "<baseURL>/<key>", sending the given headers with every request.
+func NewHTTPMirror(baseURL string, headers map[string]string) Mirror {
+	return &httpMirror{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		headers: headers,
+		client:  &http.Client{Timeout: mirrorTimeout},
+	}
+}
+
+// NewS3Mirror returns a mirror uploading every key to "<prefix>/<key>" in the given
+// bucket of an S3 compatible endpoint, using path-style requests signed with AWS
+// signature version 4.
+func NewS3Mirror(endpoint, bucket, prefix, region, accessKeyID, secretAccessKey string) Mirror {
+	s := s3Signer{
+		region:          region,
+		accessKeyID:     accessKeyID,
+		secretAccessKey: secretAccessKey,
+	}
+	baseURL := strings.TrimSuffix(endpoint, "/") + "/" + bucket
+	if prefix = strings.Trim(prefix, "/"); prefix != "" {
+		baseURL += "/" + prefix
+	}
+
+	return &httpMirror{
+		baseURL: baseURL,
+		client:  &http.Client{Timeout: mirrorTimeout},
+		sign:    s.sign,
+	}
+}
+
+func (h *httpMirror) Name() string {
+	return h.baseURL
+}
+
+func (h *httpMirror) Put(ctx context.Context, key string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, h.baseURL+"/"+key, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.ContentLength = int64(len(body))
+	for k, v := range h.headers {
+		req.Header.Set(k, v)
+	}
+	if h.sign != nil {
+		h.sign(req, body)
+	}
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to put %s", key)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("failed to put %s: %s: %s", key, resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// s3Signer signs requests with AWS signature version 4.
+type s3Signer struct {
+	region          string
+	accessKeyID     string
+	secretAccessKey string
+}
+
+func (s s3Signer) sign(req *http.Request, body []byte) {
+	t := time.Now().UTC()
+	amzDate := t.Format("20060102T150405Z")
+	date := t.Format("20060102")
+
+	payloadHash := sha256.Sum256(body)
+	payloadHashHex := hex.EncodeToString(payloadHash[:])
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHashHex)
+
+	const signedHeaders = "host;x-amz-content-sha256;x-amz-date"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		"host:" + req.URL.Host + "\n" +
+			"x-amz-content-sha256:" + payloadHashHex + "\n" +
+			"x-amz-date:" + amzDate + "\n",
+		signedHeaders,
+		payloadHashHex,
+	}, "\n")
+
+	scope := date + "/" + s.region + "/s3/aws4_request"
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(canonicalRequestHash[:])
+
+	key := hmacSHA256([]byte("AWS4"+s.secretAccessKey), date)
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.accessKeyID, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
@@ -0,0 +1,149 @@
+package snapshots_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2/snapshots"
+)
+
+// mirrorServer records the bodies and headers of the PUT requests it receives.
+type mirrorServer struct {
+	*httptest.Server
+
+	mtx     sync.Mutex
+	keys    []string
+	bodies  map[string][]byte
+	headers map[string]http.Header
+}
+
+func newMirrorServer(t *testing.T) *mirrorServer {
+	t.Helper()
+	s := &mirrorServer{
+		bodies:  map[string][]byte{},
+		headers: map[string]http.Header{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		s.mtx.Lock()
+		defer s.mtx.Unlock()
+		s.keys = append(s.keys, r.URL.Path)
+		s.bodies[r.URL.Path] = body
+		s.headers[r.URL.Path] = r.Header
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestManager_Publish(t *testing.T) {
+	store := setupStore(t)
+	manager := snapshots.NewManager(store, opts, &mockCommitSnapshotter{}, &mockStorageSnapshotter{}, nil, log.NewNopLogger())
+
+	server := newMirrorServer(t)
+	manager.RegisterMirrors(snapshots.NewHTTPMirror(server.URL+"/snapshots/", map[string]string{"Authorization": "Bearer token"}))
+
+	// snapshots without a manifest can't be published
+	require.Error(t, manager.Publish(context.Background(), 2, 1))
+
+	snapshot, err := store.Get(2, 1)
+	require.NoError(t, err)
+	require.NoError(t, store.SaveManifest(snapshots.NewManifest(snapshot, []byte{1, 2, 3})))
+	require.NoError(t, manager.Publish(context.Background(), 2, 1))
+
+	// chunks are published first, then the manifest
+	require.Equal(t, []string{
+		"/snapshots/2/1/0",
+		"/snapshots/2/1/1",
+		"/snapshots/2/1/manifest.json",
+	}, server.keys)
+	for i := 0; i < 2; i++ {
+		chunk, err := manager.LoadChunk(2, 1, uint32(i))
+		require.NoError(t, err)
+		key := fmt.Sprintf("/snapshots/2/1/%d", i)
+		require.Equal(t, chunk, server.bodies[key])
+		require.Equal(t, "Bearer token", server.headers[key].Get("Authorization"))
+	}
+
+	var manifest snapshots.Manifest
+	require.NoError(t, json.Unmarshal(server.bodies["/snapshots/2/1/manifest.json"], &manifest))
+	require.Equal(t, snapshot.Hash, manifest.Hash)
+	require.Equal(t, []byte{1, 2, 3}, manifest.AppHash)
+
+	// errors returned by the mirror should be returned
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer failing.Close()
+	manager.RegisterMirrors(snapshots.NewHTTPMirror(failing.URL, nil))
+	require.ErrorContains(t, manager.Publish(context.Background(), 2, 1), "403")
+}
+
+func TestS3Mirror(t *testing.T) {
+	server := newMirrorServer(t)
+	mirror, err := snapshots.NewMirror(snapshots.MirrorConfig{
+		Type:            snapshots.MirrorTypeS3,
+		URL:             server.URL,
+		Bucket:          "bucket",
+		Prefix:          "/chain-1/",
+		Region:          "us-east-1",
+		AccessKeyID:     "access",
+		SecretAccessKey: "secret",
+	})
+	require.NoError(t, err)
+	require.NoError(t, mirror.Put(context.Background(), "2/1/0", []byte{2, 1, 0}))
+
+	require.Equal(t, []string{"/bucket/chain-1/2/1/0"}, server.keys)
+	header := server.headers["/bucket/chain-1/2/1/0"]
+	require.NotEmpty(t, header.Get("X-Amz-Date"))
+	payloadHash := sha256.Sum256([]byte{2, 1, 0})
+	require.Equal(t, hex.EncodeToString(payloadHash[:]), header.Get("X-Amz-Content-Sha256"))
+	auth := header.Get("Authorization")
+	require.True(t, strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=access/"), auth)
+	require.Contains(t, auth, "/us-east-1/s3/aws4_request, SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature=")
+}
+
+func TestNewMirror(t *testing.T) {
+	testCases := []struct {
+		name   string
+		cfg    snapshots.MirrorConfig
+		expErr bool
+	}{
+		{"http", snapshots.MirrorConfig{Type: snapshots.MirrorTypeHTTP, URL: "https://snapshots.example.com"}, false},
+		{"s3", snapshots.MirrorConfig{Type: snapshots.MirrorTypeS3, URL: "https://s3.example.com", Bucket: "b", Region: "r", AccessKeyID: "a", SecretAccessKey: "s"}, false},
+		{"s3 without credentials", snapshots.MirrorConfig{Type: snapshots.MirrorTypeS3, URL: "https://s3.example.com", Bucket: "b", Region: "r"}, true},
+		{"unknown type", snapshots.MirrorConfig{Type: "ftp", URL: "ftp://snapshots.example.com"}, true},
+		{"invalid url", snapshots.MirrorConfig{Type: snapshots.MirrorTypeHTTP, URL: "snapshots"}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := snapshots.NewMirror(tc.cfg)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -16,3 +16,36 @@ func NewSnapshotOptions(interval uint64, keepRecent uint32) SnapshotOptions {
 		KeepRecent: keepRecent,
 	}
 }
+
+// Config defines the snapshot manifest and mirror configuration, as read from the
+// [state-sync] section of app.toml.
+type Config struct {
+	// ManifestKeyFile is the path to the file holding the hex encoded ed25519 seed used
+	// to sign snapshot manifests. Manifests are left unsigned if empty.
+	ManifestKeyFile string `mapstructure:"snapshot-manifest-key-file"`
+
+	// Mirrors are the mirrors snapshots are published to once taken.
+	Mirrors []MirrorConfig `mapstructure:"snapshot-mirrors"`
+}
+
+// Configure sets the manifest signer and registers the mirrors of the config on the manager.
+func (cfg Config) Configure(m *Manager) error {
+	if cfg.ManifestKeyFile != "" {
+		signer, err := LoadManifestSigner(cfg.ManifestKeyFile)
+		if err != nil {
+			return err
+		}
+		m.SetManifestSigner(signer)
+	}
+
+	mirrors := make([]Mirror, 0, len(cfg.Mirrors))
+	for _, mirrorCfg := range cfg.Mirrors {
+		mirror, err := NewMirror(mirrorCfg)
+		if err != nil {
+			return err
+		}
+		mirrors = append(mirrors, mirror)
+	}
+	m.RegisterMirrors(mirrors...)
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	protoio "github.com/cosmos/gogoproto/io"
 
 	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/proof"
 	"cosmossdk.io/store/v2/snapshots/types"
 )
 
@@ -17,6 +18,12 @@ type CommitSnapshotter interface {
 	Restore(version uint64, format uint32, protoReader protoio.Reader, chStorage chan<- *store.KVPair) (types.SnapshotItem, error)
 }
 
+// commitInfoGetter is implemented by commitment snapshotters exposing the commit info of a
+// version, whose hash is recorded as the app hash in the snapshot manifests.
+type commitInfoGetter interface {
+	GetCommitInfo(version uint64) (*proof.CommitInfo, error)
+}
+
 // StorageSnapshotter defines an API for restoring snapshots of the storage state.
 type StorageSnapshotter interface {
 	// Restore restores the storage state from the given channel.
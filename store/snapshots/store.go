@@ -3,6 +3,7 @@ package snapshots
 import (
 	"crypto/sha256"
 	"encoding/binary"
+	"encoding/json"
 	"hash"
 	"io"
 	"math"
@@ -343,6 +344,40 @@ func (s *Store) saveSnapshot(snapshot *types.Snapshot) error {
 	return nil
 }
 
+// SaveManifest saves the manifest of a snapshot next to its chunks.
+func (s *Store) SaveManifest(manifest *Manifest) error {
+	bz, err := json.Marshal(manifest)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode snapshot manifest")
+	}
+	if err := os.MkdirAll(s.pathSnapshot(manifest.Height, manifest.Format), 0o755); err != nil {
+		return errors.Wrapf(err, "failed to create snapshot directory for height %v format %v",
+			manifest.Height, manifest.Format)
+	}
+	if err := os.WriteFile(s.PathManifest(manifest.Height, manifest.Format), bz, 0o600); err != nil {
+		return errors.Wrapf(err, "failed to save snapshot manifest for height %v format %v",
+			manifest.Height, manifest.Format)
+	}
+	return nil
+}
+
+// LoadManifest loads the manifest of a snapshot. Returns nil if the manifest does not exist.
+func (s *Store) LoadManifest(height uint64, format uint32) (*Manifest, error) {
+	bz, err := os.ReadFile(s.PathManifest(height, format))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load snapshot manifest for height %v format %v", height, format)
+	}
+
+	manifest := &Manifest{}
+	if err := json.Unmarshal(bz, manifest); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode snapshot manifest for height %v format %v", height, format)
+	}
+	return manifest, nil
+}
+
 // pathHeight generates the path to a height, containing multiple snapshot formats.
 func (s *Store) pathHeight(height uint64) string {
 	return filepath.Join(s.dir, strconv.FormatUint(height, 10))
@@ -358,6 +393,11 @@ func (s *Store) PathChunk(height uint64, format, chunk uint32) string {
 	return filepath.Join(s.pathSnapshot(height, format), strconv.FormatUint(uint64(chunk), 10))
 }
 
+// PathManifest generates a snapshot manifest path.
+func (s *Store) PathManifest(height uint64, format uint32) string {
+	return filepath.Join(s.pathSnapshot(height, format), ManifestFilename)
+}
+
 // decodeKey decodes a snapshot key.
 func decodeKey(k []byte) (uint64, uint32, error) {
 	if len(k) != 13 {
@@ -16,4 +16,10 @@ var (
 
 	// ErrInvalidSnapshotVersion is returned when the snapshot version is invalid
 	ErrInvalidSnapshotVersion = errors.New("invalid snapshot version")
+
+	// ErrInvalidManifest is returned when a snapshot manifest doesn't match the snapshot.
+	ErrInvalidManifest = errors.New("invalid snapshot manifest")
+
+	// ErrInvalidManifestSignature is returned when the signature of a snapshot manifest is invalid.
+	ErrInvalidManifestSignature = errors.New("invalid snapshot manifest signature")
 )
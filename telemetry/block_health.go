@@ -0,0 +1,148 @@
+package telemetry
+
+import (
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+)
+
+// Block production health metric keys
+const (
+	MetricKeyBlockTimeDrift         = "block_time_drift"
+	MetricKeyConsensusRounds        = "consensus_rounds"
+	MetricKeyProposalSize           = "proposal_size"
+	MetricKeyProcessProposalFailure = "process_proposal_failed"
+)
+
+// BlockHealthThresholds defines the alert thresholds of the block production
+// health metrics. A zero value disables the corresponding alert.
+type BlockHealthThresholds struct {
+	// MaxBlockTimeDrift is the maximum absolute difference between the block time
+	// and the local time at which the block is finalized.
+	MaxBlockTimeDrift time.Duration
+
+	// MaxRoundsPerHeight is the maximum number of consensus rounds needed to commit
+	// a height.
+	MaxRoundsPerHeight uint32
+
+	// MaxProposalSize is the maximum size, in bytes, of the transactions of a
+	// block proposal.
+	MaxProposalSize int64
+
+	// MaxFailedProposals is the maximum number of proposals rejected by
+	// ProcessProposal at a single height.
+	MaxFailedProposals uint64
+}
+
+// BlockHealthThresholds returns the block production health alert thresholds
+// defined in the telemetry configuration.
+func (cfg Config) BlockHealthThresholds() BlockHealthThresholds {
+	return BlockHealthThresholds{
+		MaxBlockTimeDrift:  time.Duration(cfg.AlertBlockTimeDrift) * time.Second,
+		MaxRoundsPerHeight: cfg.AlertRoundsPerHeight,
+		MaxProposalSize:    cfg.AlertProposalSize,
+		MaxFailedProposals: cfg.AlertFailedProposals,
+	}
+}
+
+// BlockHealthMonitor emits block production health metrics, namely block time
+// drift, consensus rounds per height, proposal sizes and failed ProcessProposal
+// counts, and logs a warning whenever one of them crosses its alert threshold.
+type BlockHealthMonitor struct {
+	logger     log.Logger
+	thresholds BlockHealthThresholds
+
+	mu              sync.Mutex
+	proposalHeight  int64
+	failedProposals uint64
+}
+
+// NewBlockHealthMonitor creates a new BlockHealthMonitor with the given alert
+// thresholds.
+func NewBlockHealthMonitor(logger log.Logger, thresholds BlockHealthThresholds) *BlockHealthMonitor {
+	return &BlockHealthMonitor{
+		logger:     logger,
+		thresholds: thresholds,
+	}
+}
+
+// Thresholds returns the alert thresholds of the monitor.
+func (m *BlockHealthMonitor) Thresholds() BlockHealthThresholds {
+	return m.thresholds
+}
+
+// ObserveProposal records the size of a proposal processed at the given height
+// and whether it was accepted by ProcessProposal.
+func (m *BlockHealthMonitor) ObserveProposal(height, size int64, accepted bool) {
+	SetGauge(float32(size), MetricKeyProposalSize)
+	if m.thresholds.MaxProposalSize > 0 && size > m.thresholds.MaxProposalSize {
+		m.logger.Warn(
+			"block proposal size exceeds alert threshold",
+			"height", height, "size", size, "threshold", m.thresholds.MaxProposalSize,
+		)
+	}
+
+	m.mu.Lock()
+	if m.proposalHeight != height {
+		m.proposalHeight = height
+		m.failedProposals = 0
+	}
+	if !accepted {
+		m.failedProposals++
+	}
+	failed := m.failedProposals
+	m.mu.Unlock()
+
+	if accepted {
+		return
+	}
+
+	IncrCounter(1, MetricKeyProcessProposalFailure)
+
+	if m.thresholds.MaxFailedProposals > 0 && failed > m.thresholds.MaxFailedProposals {
+		m.logger.Warn(
+			"failed ProcessProposal count exceeds alert threshold",
+			"height", height, "failed", failed, "threshold", m.thresholds.MaxFailedProposals,
+		)
+	}
+}
+
+// ObserveBlock records the drift between the time of a block finalized at the
+// given height and the local time, along with the consensus round at which the
+// previous height was committed, as reported in the block's last commit info.
+//
+// The block time drift alert is only raised for blocks which went through
+// ProcessProposal, so that a node replaying or syncing old blocks does not
+// report a drift.
+func (m *BlockHealthMonitor) ObserveBlock(height int64, blockTime, localTime time.Time, lastCommitRound int32) {
+	drift := localTime.Sub(blockTime)
+	SetGauge(float32(drift.Milliseconds()), MetricKeyBlockTimeDrift)
+	if drift < 0 {
+		drift = -drift
+	}
+
+	m.mu.Lock()
+	live := m.proposalHeight == height
+	m.mu.Unlock()
+
+	if live && m.thresholds.MaxBlockTimeDrift > 0 && drift > m.thresholds.MaxBlockTimeDrift {
+		m.logger.Warn(
+			"block time drift exceeds alert threshold",
+			"height", height, "drift", drift, "threshold", m.thresholds.MaxBlockTimeDrift,
+		)
+	}
+
+	// rounds are zero-indexed, hence the round at which a height was committed
+	// plus one is the number of rounds it took to commit it
+	if height > 1 && lastCommitRound >= 0 {
+		rounds := uint32(lastCommitRound) + 1
+		SetGauge(float32(rounds), MetricKeyConsensusRounds)
+		if m.thresholds.MaxRoundsPerHeight > 0 && rounds > m.thresholds.MaxRoundsPerHeight {
+			m.logger.Warn(
+				"consensus rounds per height exceed alert threshold",
+				"height", height-1, "rounds", rounds, "threshold", m.thresholds.MaxRoundsPerHeight,
+			)
+		}
+	}
+}
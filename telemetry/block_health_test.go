@@ -0,0 +1,78 @@
+package telemetry
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+)
+
+func TestBlockHealthMonitor_Alerts(t *testing.T) {
+	var buf bytes.Buffer
+	m := NewBlockHealthMonitor(log.NewLogger(&buf, log.OutputJSONOption()), BlockHealthThresholds{
+		MaxBlockTimeDrift:  5 * time.Second,
+		MaxRoundsPerHeight: 2,
+		MaxProposalSize:    10,
+		MaxFailedProposals: 1,
+	})
+	now := time.Now()
+
+	// within thresholds
+	m.ObserveProposal(2, 10, true)
+	m.ObserveBlock(2, now, now.Add(time.Second), 1)
+	require.Empty(t, buf.String())
+
+	m.ObserveProposal(3, 11, true)
+	require.Contains(t, buf.String(), "block proposal size exceeds alert threshold")
+	buf.Reset()
+
+	m.ObserveProposal(4, 1, false)
+	require.Empty(t, buf.String())
+	m.ObserveProposal(4, 1, false)
+	require.Contains(t, buf.String(), "failed ProcessProposal count exceeds alert threshold")
+	buf.Reset()
+
+	// failed proposals are counted per height
+	m.ObserveProposal(5, 1, false)
+	require.Empty(t, buf.String())
+
+	m.ObserveBlock(5, now, now.Add(10*time.Second), 2)
+	out := buf.String()
+	require.Contains(t, out, "block time drift exceeds alert threshold")
+	require.Contains(t, out, "consensus rounds per height exceed alert threshold")
+	buf.Reset()
+
+	// no drift alert for blocks not seen in ProcessProposal, e.g. when syncing
+	m.ObserveBlock(6, now, now.Add(10*time.Second), 0)
+	require.False(t, strings.Contains(buf.String(), "block time drift"))
+}
+
+func TestBlockHealthMonitor_Disabled(t *testing.T) {
+	var buf bytes.Buffer
+	m := NewBlockHealthMonitor(log.NewLogger(&buf), BlockHealthThresholds{})
+	now := time.Now()
+
+	m.ObserveProposal(2, 1<<20, false)
+	m.ObserveProposal(2, 1<<20, false)
+	m.ObserveBlock(2, now, now.Add(time.Hour), 10)
+	require.Empty(t, buf.String())
+}
+
+func TestConfig_BlockHealthThresholds(t *testing.T) {
+	cfg := Config{
+		AlertBlockTimeDrift:  3,
+		AlertRoundsPerHeight: 4,
+		AlertProposalSize:    1024,
+		AlertFailedProposals: 2,
+	}
+	require.Equal(t, BlockHealthThresholds{
+		MaxBlockTimeDrift:  3 * time.Second,
+		MaxRoundsPerHeight: 4,
+		MaxProposalSize:    1024,
+		MaxFailedProposals: 2,
+	}, cfg.BlockHealthThresholds())
+}
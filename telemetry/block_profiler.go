@@ -0,0 +1,353 @@
+package telemetry
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"runtime/pprof"
+	"sort"
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+)
+
+// Runtime profiles captured by the block profiler
+const (
+	BlockProfileCPU       = "cpu"
+	BlockProfileHeap      = "heap"
+	BlockProfileGoroutine = "goroutine"
+)
+
+// Block execution phases timed by the block profiler
+const (
+	BlockPhasePreBlock   = "pre_block"
+	BlockPhaseBeginBlock = "begin_block"
+	BlockPhaseTxs        = "txs"
+	BlockPhaseEndBlock   = "end_block"
+)
+
+// MaxProfiledBlocks is the maximum number of blocks of a profiling session.
+const MaxProfiledBlocks = 100
+
+// ErrBlockProfilingInProgress is returned when starting a profiling session
+// while another one is in progress.
+var ErrBlockProfilingInProgress = errors.New("block profiling already in progress")
+
+// BlockProfileReport describes a block profiling session and the breakdown of
+// the blocks profiled so far.
+type BlockProfileReport struct {
+	// Blocks is the number of blocks to profile.
+	Blocks int64 `json:"blocks"`
+	// Profiles are the runtime profiles captured along with the breakdown.
+	Profiles []string `json:"profiles"`
+	// Done is set once all the blocks are profiled and the runtime profiles
+	// are captured.
+	Done bool `json:"done"`
+	// Errors lists the runtime profiles that could not be captured, e.g. a CPU
+	// profile while another one is running.
+	Errors     []string         `json:"errors,omitempty"`
+	Breakdowns []BlockBreakdown `json:"breakdowns"`
+}
+
+// BlockBreakdown is the gas and time breakdown of the execution of a block. The
+// durations are in nanoseconds.
+type BlockBreakdown struct {
+	Height int64 `json:"height"`
+	NumTxs int   `json:"num_txs"`
+	// GasUsed is the gas used by all the transactions of the block, including
+	// the gas consumed outside of their messages, e.g. by the AnteHandler.
+	GasUsed    uint64            `json:"gas_used"`
+	PreBlock   time.Duration     `json:"pre_block_ns"`
+	BeginBlock time.Duration     `json:"begin_block_ns"`
+	Txs        time.Duration     `json:"txs_ns"`
+	EndBlock   time.Duration     `json:"end_block_ns"`
+	Commit     time.Duration     `json:"commit_ns"`
+	Modules    []ModuleBreakdown `json:"modules"`
+}
+
+// ModuleBreakdown is the gas and time spent executing the messages routed to a
+// module in a block. The duration is in nanoseconds.
+type ModuleBreakdown struct {
+	Module   string        `json:"module"`
+	Msgs     uint64        `json:"msgs"`
+	GasUsed  uint64        `json:"gas_used"`
+	Duration time.Duration `json:"duration_ns"`
+}
+
+// BlockProfiler captures runtime profiles bounded to the execution of the next
+// blocks, along with a gas and time breakdown per module of these blocks. A
+// profiling session is started on demand and begins with the next finalized
+// block, so that nodes don't need to be rebuilt with instrumentation to
+// investigate performance issues.
+type BlockProfiler struct {
+	logger log.Logger
+
+	mu      sync.Mutex
+	session *blockProfileSession
+}
+
+// blockProfileSession holds the state of a profiling session.
+type blockProfileSession struct {
+	report  BlockProfileReport
+	started bool
+	cpu     *bytes.Buffer
+	data    map[string][]byte
+
+	// current is the breakdown of the block being executed, and recording is
+	// set while the block is finalized, so that a replay of the block is not
+	// recorded.
+	current   *BlockBreakdown
+	modules   map[string]*ModuleBreakdown
+	recording bool
+}
+
+// NewBlockProfiler creates a new BlockProfiler.
+func NewBlockProfiler(logger log.Logger) *BlockProfiler {
+	return &BlockProfiler{logger: logger}
+}
+
+// Start starts a profiling session of the given number of blocks, capturing the
+// given runtime profiles. It replaces the report and profiles of the previous
+// session, if any.
+func (p *BlockProfiler) Start(blocks int64, profiles []string) error {
+	if blocks <= 0 || blocks > MaxProfiledBlocks {
+		return fmt.Errorf("number of blocks must be between 1 and %d, got %d", MaxProfiledBlocks, blocks)
+	}
+
+	seen := make(map[string]bool, len(profiles))
+	for _, profile := range profiles {
+		switch profile {
+		case BlockProfileCPU, BlockProfileHeap, BlockProfileGoroutine:
+		default:
+			return fmt.Errorf("unknown profile %q", profile)
+		}
+		if seen[profile] {
+			return fmt.Errorf("duplicate profile %q", profile)
+		}
+		seen[profile] = true
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.session != nil && !p.session.report.Done {
+		return ErrBlockProfilingInProgress
+	}
+
+	p.session = &blockProfileSession{
+		report: BlockProfileReport{
+			Blocks:     blocks,
+			Profiles:   append([]string(nil), profiles...),
+			Breakdowns: []BlockBreakdown{},
+		},
+		data: make(map[string][]byte),
+	}
+	p.logger.Info("block profiling scheduled", "blocks", blocks, "profiles", profiles)
+
+	return nil
+}
+
+// Report returns the report of the current or last profiling session, and false
+// if no session was ever started.
+func (p *BlockProfiler) Report() (BlockProfileReport, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.session == nil {
+		return BlockProfileReport{}, false
+	}
+
+	report := p.session.report
+	report.Profiles = append([]string(nil), report.Profiles...)
+	report.Errors = append([]string(nil), report.Errors...)
+	report.Breakdowns = append([]BlockBreakdown{}, report.Breakdowns...)
+	return report, true
+}
+
+// Profile returns a runtime profile, in the pprof format, captured by the last
+// completed profiling session.
+func (p *BlockProfiler) Profile(profile string) ([]byte, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.session == nil || !p.session.report.Done {
+		return nil, errors.New("no completed block profiling session")
+	}
+
+	data, ok := p.session.data[profile]
+	if !ok {
+		return nil, fmt.Errorf("profile %q was not captured", profile)
+	}
+
+	return data, nil
+}
+
+// BeginBlock starts recording the breakdown of a finalized block, and starts
+// the CPU profile if the block is the first one of the session. Recording the
+// same height again, e.g. after an aborted optimistic execution, discards the
+// breakdown recorded so far for it.
+func (p *BlockProfiler) BeginBlock(height int64) {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	s := p.session
+	if s == nil || s.report.Done {
+		return
+	}
+
+	if !s.started {
+		s.started = true
+		for _, profile := range s.report.Profiles {
+			if profile != BlockProfileCPU {
+				continue
+			}
+
+			s.cpu = new(bytes.Buffer)
+			if err := pprof.StartCPUProfile(s.cpu); err != nil {
+				s.cpu = nil
+				s.report.Errors = append(s.report.Errors, fmt.Sprintf("%s: %s", profile, err))
+			}
+		}
+		p.logger.Info("block profiling started", "height", height)
+	}
+
+	s.current = &BlockBreakdown{Height: height}
+	s.modules = make(map[string]*ModuleBreakdown)
+	s.recording = true
+}
+
+// ObservePhase records the time spent in a phase of the block being finalized.
+func (p *BlockProfiler) ObservePhase(phase string, start time.Time) {
+	if p == nil {
+		return
+	}
+
+	elapsed := time.Since(start)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	s := p.session
+	if s == nil || !s.recording {
+		return
+	}
+
+	switch phase {
+	case BlockPhasePreBlock:
+		s.current.PreBlock += elapsed
+	case BlockPhaseBeginBlock:
+		s.current.BeginBlock += elapsed
+	case BlockPhaseTxs:
+		s.current.Txs += elapsed
+	case BlockPhaseEndBlock:
+		s.current.EndBlock += elapsed
+	}
+}
+
+// ObserveMsg records the gas and time spent executing a message routed to the
+// given module in the block being finalized.
+func (p *BlockProfiler) ObserveMsg(module string, start time.Time, gasUsed uint64) {
+	if p == nil {
+		return
+	}
+
+	elapsed := time.Since(start)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	s := p.session
+	if s == nil || !s.recording {
+		return
+	}
+
+	m, ok := s.modules[module]
+	if !ok {
+		m = &ModuleBreakdown{Module: module}
+		s.modules[module] = m
+	}
+	m.Msgs++
+	m.GasUsed += gasUsed
+	m.Duration += elapsed
+}
+
+// EndBlock stops recording the breakdown of the block being finalized, with
+// its number of transactions and the gas they used.
+func (p *BlockProfiler) EndBlock(numTxs int, gasUsed uint64) {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	s := p.session
+	if s == nil || !s.recording {
+		return
+	}
+
+	s.recording = false
+	s.current.NumTxs = numTxs
+	s.current.GasUsed = gasUsed
+	s.current.Modules = make([]ModuleBreakdown, 0, len(s.modules))
+	for _, m := range s.modules {
+		s.current.Modules = append(s.current.Modules, *m)
+	}
+	sort.Slice(s.current.Modules, func(i, j int) bool {
+		return s.current.Modules[i].Module < s.current.Modules[j].Module
+	})
+}
+
+// Commit records the time spent committing the block, adds its breakdown to the
+// report and, once all the blocks of the session are profiled, captures the
+// runtime profiles.
+func (p *BlockProfiler) Commit(start time.Time) {
+	if p == nil {
+		return
+	}
+
+	elapsed := time.Since(start)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	s := p.session
+	if s == nil || s.current == nil || s.recording {
+		return
+	}
+
+	s.current.Commit = elapsed
+	s.report.Breakdowns = append(s.report.Breakdowns, *s.current)
+	s.current, s.modules = nil, nil
+	if int64(len(s.report.Breakdowns)) < s.report.Blocks {
+		return
+	}
+
+	for _, profile := range s.report.Profiles {
+		switch profile {
+		case BlockProfileCPU:
+			if s.cpu == nil {
+				continue
+			}
+			pprof.StopCPUProfile()
+			s.data[profile] = s.cpu.Bytes()
+			s.cpu = nil
+
+		case BlockProfileHeap, BlockProfileGoroutine:
+			var buf bytes.Buffer
+			if err := pprof.Lookup(profile).WriteTo(&buf, 0); err != nil {
+				s.report.Errors = append(s.report.Errors, fmt.Sprintf("%s: %s", profile, err))
+				continue
+			}
+			s.data[profile] = buf.Bytes()
+		}
+	}
+
+	s.report.Done = true
+	p.logger.Info("block profiling completed", "blocks", s.report.Blocks, "height", s.report.Breakdowns[len(s.report.Breakdowns)-1].Height)
+}
@@ -0,0 +1,90 @@
+package telemetry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+)
+
+func TestBlockProfiler(t *testing.T) {
+	p := NewBlockProfiler(log.NewNopLogger())
+
+	_, ok := p.Report()
+	require.False(t, ok)
+
+	require.ErrorContains(t, p.Start(0, nil), "number of blocks")
+	require.ErrorContains(t, p.Start(MaxProfiledBlocks+1, nil), "number of blocks")
+	require.ErrorContains(t, p.Start(1, []string{"mutex"}), "unknown profile")
+	require.ErrorContains(t, p.Start(1, []string{BlockProfileHeap, BlockProfileHeap}), "duplicate profile")
+
+	// blocks finalized before a session is started are not recorded
+	p.BeginBlock(1)
+	p.EndBlock(0, 0)
+	p.Commit(time.Now())
+
+	require.NoError(t, p.Start(2, []string{BlockProfileCPU, BlockProfileHeap}))
+	require.ErrorIs(t, p.Start(1, nil), ErrBlockProfilingInProgress)
+
+	// an aborted execution of a height is discarded
+	p.BeginBlock(2)
+	p.ObserveMsg("bank", time.Now(), 1000)
+
+	p.BeginBlock(2)
+	p.ObservePhase(BlockPhaseBeginBlock, time.Now().Add(-time.Millisecond))
+	p.ObserveMsg("staking", time.Now(), 300)
+	p.ObserveMsg("bank", time.Now(), 100)
+	p.ObserveMsg("bank", time.Now(), 200)
+	p.EndBlock(2, 1000)
+
+	// a replay of the block after it is finalized is not recorded
+	p.ObserveMsg("bank", time.Now(), 5000)
+	p.Commit(time.Now())
+
+	report, ok := p.Report()
+	require.True(t, ok)
+	require.False(t, report.Done)
+	require.Len(t, report.Breakdowns, 1)
+
+	block := report.Breakdowns[0]
+	require.Equal(t, int64(2), block.Height)
+	require.Equal(t, 2, block.NumTxs)
+	require.Equal(t, uint64(1000), block.GasUsed)
+	require.GreaterOrEqual(t, block.BeginBlock, time.Millisecond)
+	require.Equal(t, []string{"bank", "staking"}, []string{block.Modules[0].Module, block.Modules[1].Module})
+	require.Equal(t, uint64(2), block.Modules[0].Msgs)
+	require.Equal(t, uint64(300), block.Modules[0].GasUsed)
+	require.Equal(t, uint64(300), block.Modules[1].GasUsed)
+
+	_, err := p.Profile(BlockProfileHeap)
+	require.ErrorContains(t, err, "no completed block profiling session")
+
+	p.BeginBlock(3)
+	p.EndBlock(0, 0)
+	p.Commit(time.Now())
+
+	report, _ = p.Report()
+	require.True(t, report.Done)
+	require.Len(t, report.Breakdowns, 2)
+
+	for _, profile := range []string{BlockProfileCPU, BlockProfileHeap} {
+		data, err := p.Profile(profile)
+		require.NoError(t, err)
+		require.NotEmpty(t, data)
+	}
+	_, err = p.Profile(BlockProfileGoroutine)
+	require.ErrorContains(t, err, "was not captured")
+
+	// the blocks after the session are not recorded, and a new one can start
+	p.BeginBlock(4)
+	p.EndBlock(0, 0)
+	p.Commit(time.Now())
+	report, _ = p.Report()
+	require.Len(t, report.Breakdowns, 2)
+
+	require.NoError(t, p.Start(1, nil))
+	report, _ = p.Report()
+	require.Empty(t, report.Breakdowns)
+}
@@ -74,6 +74,23 @@ type Config struct {
 	// DatadogHostname defines the hostname to use when emitting metrics to
 	// Datadog. Only utilized if MetricsSink is set to "dogstatsd".
 	DatadogHostname string `mapstructure:"datadog-hostname"`
+
+	// AlertBlockTimeDrift defines, in seconds, the block time drift from the local
+	// time above which a warning is logged. Zero disables the alert.
+	AlertBlockTimeDrift int64 `mapstructure:"alert-block-time-drift"`
+
+	// AlertRoundsPerHeight defines the number of consensus rounds per height above
+	// which a warning is logged. Zero disables the alert.
+	AlertRoundsPerHeight uint32 `mapstructure:"alert-rounds-per-height"`
+
+	// AlertProposalSize defines the block proposal size, in bytes, above which a
+	// warning is logged. Zero disables the alert.
+	AlertProposalSize int64 `mapstructure:"alert-proposal-size"`
+
+	// AlertFailedProposals defines the number of proposals rejected by
+	// ProcessProposal at a single height above which a warning is logged. Zero
+	// disables the alert.
+	AlertFailedProposals uint64 `mapstructure:"alert-failed-proposals"`
 }
 
 // Metrics defines a wrapper around application telemetry functionality. It allows
@@ -0,0 +1,118 @@
+package auth_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	_ "cosmossdk.io/x/auth"
+	authkeeper "cosmossdk.io/x/auth/keeper"
+	_ "cosmossdk.io/x/auth/tx/config"
+	authtypes "cosmossdk.io/x/auth/types"
+	_ "cosmossdk.io/x/bank"
+	bankkeeper "cosmossdk.io/x/bank/keeper"
+	"cosmossdk.io/x/bank/testutil"
+	banktypes "cosmossdk.io/x/bank/types"
+	_ "cosmossdk.io/x/staking"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/configurator"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/x/consensus"
+)
+
+// TestSmartAccountSpendingPolicy checks that the default ante and post handlers
+// authenticate a smart account with a limited authenticator and enforce its
+// spending policy, while its primary key stays unrestricted.
+func TestSmartAccountSpendingPolicy(t *testing.T) {
+	var (
+		accountKeeper authkeeper.AccountKeeper
+		bankKeeper    bankkeeper.Keeper
+		txConfig      client.TxConfig
+	)
+
+	primaryPriv := secp256k1.GenPrivKey()
+	limitedPriv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(primaryPriv.PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	startupCfg := simtestutil.DefaultStartUpConfig()
+	startupCfg.GenesisAccounts = []simtestutil.GenesisAccount{{GenesisAccount: &authtypes.BaseAccount{Address: addr.String()}}}
+
+	app, err := simtestutil.SetupWithConfiguration(
+		depinject.Configs(
+			configurator.NewAppConfig(
+				configurator.AuthModule(),
+				configurator.StakingModule(),
+				configurator.TxModule(),
+				configurator.ConsensusModule(),
+				configurator.BankModule(),
+			),
+			depinject.Supply(log.NewNopLogger()),
+		),
+		startupCfg, &accountKeeper, &bankKeeper, &txConfig)
+	require.NoError(t, err)
+
+	baseApp := app.BaseApp
+	ctx := baseApp.NewContext(false)
+	require.NoError(t, testutil.FundAccount(ctx, bankKeeper, addr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 1000))))
+	_, err = baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: baseApp.LastBlockHeight() + 1})
+	require.NoError(t, err)
+	_, err = baseApp.Commit()
+	require.NoError(t, err)
+
+	deliver := func(priv cryptotypes.PrivKey, expPass bool, msgs ...sdk.Msg) {
+		t.Helper()
+		acc := accountKeeper.GetAccount(baseApp.NewContext(true), addr)
+		header := header.Info{Height: baseApp.LastBlockHeight() + 1}
+		_, _, err := simtestutil.SignCheckDeliver(t, txConfig, baseApp, header, msgs, "", []uint64{acc.GetAccountNumber()}, []uint64{acc.GetSequence()}, expPass, expPass, priv)
+		if expPass {
+			require.NoError(t, err)
+		} else {
+			require.Error(t, err)
+		}
+	}
+	send := func(amount int64) sdk.Msg {
+		return banktypes.NewMsgSend(addr.String(), recipient.String(), sdk.NewCoins(sdk.NewInt64Coin("foocoin", amount)))
+	}
+
+	limitedKey, err := codectypes.NewAnyWithValue(limitedPriv.PubKey())
+	require.NoError(t, err)
+	addLimited := &authtypes.MsgAddAuthenticator{
+		Sender: addr.String(),
+		Authenticator: authtypes.Authenticator{
+			PubKeys:        []*codectypes.Any{limitedKey},
+			SpendingPolicy: &authtypes.SpendingPolicy{Limit: sdk.NewCoins(sdk.NewInt64Coin("foocoin", 100))},
+		},
+	}
+
+	// the primary key registers the limited authenticator
+	deliver(primaryPriv, true, addLimited)
+
+	// the limited key spends within the limit of its spending policy
+	deliver(limitedPriv, true, send(60))
+
+	// the limited key is rejected once the spending policy limit is exceeded
+	deliver(limitedPriv, false, send(50))
+
+	// the limited key cannot lift its own limit
+	deliver(limitedPriv, false, addLimited)
+	deliver(limitedPriv, false, &authtypes.MsgRemoveAuthenticator{Sender: addr.String(), Id: 0})
+
+	// the primary key is not restricted by the spending policy
+	deliver(primaryPriv, true, send(500))
+
+	ctx = baseApp.NewContext(true)
+	require.Equal(t, sdk.NewInt64Coin("foocoin", 440), bankKeeper.GetBalance(ctx, addr, "foocoin"))
+	authenticators, err := accountKeeper.GetAuthenticators(ctx, addr)
+	require.NoError(t, err)
+	require.Len(t, authenticators, 1)
+}
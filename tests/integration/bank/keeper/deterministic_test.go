@@ -225,7 +225,7 @@ func TestGRPCQuerySpendableBalances(t *testing.T) {
 	assert.NilError(t, err)
 
 	req := banktypes.NewQuerySpendableBalancesRequest(addr1, nil)
-	testdata.DeterministicIterations(t, f.ctx, req, f.queryClient.SpendableBalances, 1777, false)
+	testdata.DeterministicIterations(t, f.ctx, req, f.queryClient.SpendableBalances, 1807, false)
 }
 
 func TestGRPCQueryTotalSupply(t *testing.T) {
@@ -331,7 +331,7 @@ func TestGRPCQueryParams(t *testing.T) {
 	err := f.bankKeeper.SetParams(f.ctx, params)
 	assert.NilError(t, err)
 	req := &banktypes.QueryParamsRequest{}
-	testdata.DeterministicIterations(t, f.ctx, req, f.queryClient.Params, 1003, false)
+	testdata.DeterministicIterations(t, f.ctx, req, f.queryClient.Params, 1009, false)
 }
 
 func createAndReturnMetadatas(t *rapid.T, count int) []banktypes.Metadata {
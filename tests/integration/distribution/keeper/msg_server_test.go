@@ -78,6 +78,7 @@ func initFixture(t *testing.T) *fixture {
 	maccPerms := map[string][]string{
 		pooltypes.ModuleName:           {},
 		pooltypes.StreamAccount:        {},
+		pooltypes.EscrowAccount:        {},
 		distrtypes.ModuleName:          {authtypes.Minter},
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
@@ -463,6 +463,94 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	require.Equal(t, v1.StatusFailed, proposal.Status)
 }
 
+func TestLineItemVetoEndBlocker(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.app
+	ctx := app.BaseApp.NewContext(false)
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 2, valTokens)
+
+	SortAddresses(addrs)
+
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+
+	valAddr := sdk.ValAddress(addrs[0])
+	proposer := addrs[0]
+
+	ac := addresscodec.NewBech32Codec("cosmos")
+	addrStr, err := ac.BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+	require.NoError(t, err)
+	authorityStr, err := ac.BytesToString(addrs[0])
+	require.NoError(t, err)
+	toAddrStr, err := ac.BytesToString(addrs[1])
+	require.NoError(t, err)
+
+	acc := suite.AccountKeeper.NewAccountWithAddress(ctx, addrs[0])
+	suite.AccountKeeper.SetAccount(ctx, acc)
+
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	_, err = suite.StakingKeeper.EndBlocker(ctx)
+	require.NoError(t, err)
+
+	params, err := suite.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.LineItemVeto.Authority = authorityStr
+	require.NoError(t, suite.GovKeeper.Params.Set(ctx, params))
+
+	// fund the gov account so that the proposal messages can be executed
+	funds := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(3)))
+	require.NoError(t, suite.BankKeeper.SendCoinsFromAccountToModule(ctx, addrs[0], types.ModuleName, funds))
+	initialBalance := suite.BankKeeper.GetBalance(ctx, addrs[1], sdk.DefaultBondDenom)
+
+	msgs := []sdk.Msg{
+		banktypes.NewMsgSend(addrStr, toAddrStr, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1)))),
+		banktypes.NewMsgSend(addrStr, toAddrStr, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(2)))),
+	}
+	proposal, err := suite.GovKeeper.SubmitProposal(ctx, msgs, "", "title", "summary", proposer, v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+
+	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
+	govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+	_, err = govMsgSvr.Deposit(ctx, v1.NewMsgDeposit(addrs[0], proposal.Id, proposalCoins))
+	require.NoError(t, err)
+
+	err = suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+	require.NoError(t, err)
+
+	newHeader := ctx.HeaderInfo()
+	newHeader.Time = ctx.HeaderInfo().Time.Add(*params.MaxDepositPeriod).Add(*params.VotingPeriod)
+	ctx = ctx.WithHeaderInfo(newHeader)
+
+	// the passed proposal enters its timelock instead of being executed
+	require.NoError(t, gov.EndBlocker(ctx, suite.GovKeeper))
+	proposal, err = suite.GovKeeper.Proposals.Get(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, v1.StatusTimelock, proposal.Status)
+	require.Equal(t, initialBalance.String(), suite.BankKeeper.GetBalance(ctx, addrs[1], sdk.DefaultBondDenom).String())
+
+	_, err = govMsgSvr.VetoProposalMessages(ctx, &v1.MsgVetoProposalMessages{
+		Authority:      authorityStr,
+		ProposalId:     proposal.Id,
+		MessageIndexes: []uint32{1},
+		Justification:  "out of scope",
+	})
+	require.NoError(t, err)
+
+	newHeader = ctx.HeaderInfo()
+	newHeader.Time = proposal.TimelockEndTime.Add(time.Second)
+	ctx = ctx.WithHeaderInfo(newHeader)
+
+	// only the message that was not struck is executed
+	require.NoError(t, gov.EndBlocker(ctx, suite.GovKeeper))
+	proposal, err = suite.GovKeeper.Proposals.Get(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, v1.StatusPassed, proposal.Status)
+	require.Equal(t, initialBalance.AddAmount(math.NewInt(1)).String(), suite.BankKeeper.GetBalance(ctx, addrs[1], sdk.DefaultBondDenom).String())
+
+	has, err := suite.GovKeeper.TimelockProposalsQueue.Has(ctx, collections.Join(*proposal.TimelockEndTime, proposal.Id))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
 func TestExpeditedProposal_PassAndConversionToRegular(t *testing.T) {
 	testcases := []struct {
 		name string
@@ -64,6 +64,7 @@ func initFixture(tb testing.TB) *fixture {
 	maccPerms := map[string][]string{
 		pooltypes.ModuleName:           {},
 		pooltypes.StreamAccount:        {},
+		pooltypes.EscrowAccount:        {},
 		minttypes.ModuleName:           {authtypes.Minter},
 		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
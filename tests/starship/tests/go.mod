@@ -15,6 +15,7 @@ replace (
 // SimApp on main always tests the latest extracted SDK modules importing the sdk
 replace (
 	cosmossdk.io/api => ../../../api
+	cosmossdk.io/collections => ../../../collections
 	cosmossdk.io/client/v2 => ../../../client/v2
 	cosmossdk.io/core => ../../../core
 	cosmossdk.io/depinject => ../../../depinject
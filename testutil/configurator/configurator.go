@@ -160,8 +160,9 @@ func AuthModule() ModuleOption {
 					{Account: "not_bonded_tokens_pool", Permissions: []string{"burner", testutil.StakingModuleName}},
 					{Account: testutil.GovModuleName, Permissions: []string{"burner"}},
 					{Account: testutil.NFTModuleName},
-					{Account: testutil.ProtocolPoolModuleName},
+					{Account: testutil.ProtocolPoolModuleName, Permissions: []string{"burner"}},
 					{Account: "stream_acc"},
+					{Account: "escrow_acc"},
 				},
 			}),
 		}
@@ -14,15 +14,19 @@ import (
 	signing "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -362,9 +366,6 @@ type TxBody struct {
 	// timeout_height is the block height after which this transaction will not
 	// be processed by the chain.
 	//
-	// Note, if unordered=true this value MUST be set
-	// and will act as a short-lived TTL in which the transaction is deemed valid
-	// and kept in memory to prevent duplicates.
 	TimeoutHeight uint64 `protobuf:"varint,3,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
 	// unordered, when set to true, indicates that the transaction signer(s)
 	// intend for the transaction to be evaluated and executed in an un-ordered
@@ -372,10 +373,15 @@ type TxBody struct {
 	// incremented, which allows for fire-and-forget as well as concurrent
 	// transaction execution.
 	//
-	// Note, when set to true, the existing 'timeout_height' value must be set and
-	// will be used to correspond to a height in which the transaction is deemed
-	// valid.
+	// Note, when set to true, the 'timeout_timestamp' value must be set and will
+	// act as a short-lived TTL in which the transaction is deemed valid and kept
+	// in memory to prevent duplicates.
 	Unordered bool `protobuf:"varint,4,opt,name=unordered,proto3" json:"unordered,omitempty"`
+	// timeout_timestamp is the block time after which this transaction will not
+	// be processed by the chain.
+	//
+	// Note, if unordered=true this value MUST be set.
+	TimeoutTimestamp *time.Time `protobuf:"bytes,5,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3,stdtime" json:"timeout_timestamp,omitempty"`
 	// extension_options are arbitrary options that can be added by chains
 	// when the default options are not sufficient. If any of these are present
 	// and can't be handled, the transaction will be rejected
@@ -447,6 +453,13 @@ func (m *TxBody) GetUnordered() bool {
 	return false
 }
 
+func (m *TxBody) GetTimeoutTimestamp() *time.Time {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return nil
+}
+
 func (m *TxBody) GetExtensionOptions() []*types.Any {
 	if m != nil {
 		return m.ExtensionOptions
@@ -614,7 +627,6 @@ type ModeInfo struct {
 	// multisig signer
 	//
 	// Types that are valid to be assigned to Sum:
-	//
 	//	*ModeInfo_Single_
 	//	*ModeInfo_Multi_
 	Sum isModeInfo_Sum `protobuf_oneof:"sum"`
@@ -1044,75 +1056,77 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/tx.proto", fileDescriptor_96d1575ffde80842) }
 
 var fileDescriptor_96d1575ffde80842 = []byte{
-	// 1076 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x41, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0x7a, 0x6d, 0xc7, 0x7e, 0x4d, 0xda, 0x64, 0x14, 0xfd, 0xe5, 0x38, 0xff, 0xba, 0xc1,
-	0x55, 0xc1, 0xaa, 0xc8, 0x6e, 0x9b, 0x1e, 0x28, 0x15, 0x02, 0xec, 0x86, 0x28, 0x55, 0x29, 0x48,
-	0x93, 0x9c, 0x7a, 0x59, 0x8d, 0x77, 0x27, 0xeb, 0x51, 0xbd, 0x33, 0xcb, 0xce, 0x2c, 0x78, 0x8f,
-	0x7c, 0x00, 0xa4, 0x8a, 0x0b, 0x12, 0x67, 0x0e, 0x88, 0x53, 0x25, 0x10, 0x9f, 0xa1, 0x27, 0x54,
-	0x71, 0xe2, 0x04, 0x55, 0x72, 0xe8, 0x9d, 0x2f, 0x00, 0xda, 0xd9, 0x59, 0x27, 0x2d, 0xa9, 0x53,
-	0x04, 0x12, 0x17, 0x7b, 0xe6, 0xed, 0xef, 0xbd, 0xf9, 0xbd, 0x37, 0xbf, 0x79, 0x0f, 0x3a, 0xbe,
-	0x90, 0x91, 0x90, 0xae, 0x9a, 0xba, 0x9f, 0x5e, 0x1f, 0x51, 0x45, 0xae, 0xbb, 0x6a, 0xea, 0xc4,
-	0x89, 0x50, 0x02, 0xad, 0x14, 0xdf, 0x1c, 0x35, 0x75, 0xcc, 0xb7, 0xce, 0x0a, 0x89, 0x18, 0x17,
-	0xae, 0xfe, 0x2d, 0x50, 0x9d, 0xd5, 0x50, 0x84, 0x42, 0x2f, 0xdd, 0x7c, 0x65, 0xac, 0x9b, 0x26,
-	0xae, 0x9f, 0x64, 0xb1, 0x12, 0x6e, 0x94, 0x4e, 0x14, 0x93, 0x2c, 0x9c, 0x1d, 0x52, 0x1a, 0x0c,
-	0xbc, 0x6b, 0xe0, 0x23, 0x22, 0xe9, 0x0c, 0xe3, 0x0b, 0xc6, 0xcd, 0xf7, 0x37, 0x8e, 0x69, 0x4a,
-	0x16, 0x72, 0xc6, 0x8f, 0x23, 0x99, 0xbd, 0x01, 0xae, 0x85, 0x42, 0x84, 0x13, 0xea, 0xea, 0xdd,
-	0x28, 0x3d, 0x70, 0x09, 0xcf, 0xca, 0x4f, 0x45, 0x0c, 0xaf, 0xe0, 0x6a, 0x72, 0xd3, 0x9b, 0xde,
-	0x17, 0x16, 0x54, 0xf7, 0xa7, 0x68, 0x13, 0x6a, 0x23, 0x11, 0x64, 0x6d, 0x6b, 0xc3, 0xea, 0x9f,
-	0xdb, 0x5a, 0x73, 0xfe, 0x92, 0xbf, 0xb3, 0x3f, 0x1d, 0x8a, 0x20, 0xc3, 0x1a, 0x86, 0x6e, 0x42,
-	0x8b, 0xa4, 0x6a, 0xec, 0x31, 0x7e, 0x20, 0xda, 0x55, 0xed, 0xb3, 0x7e, 0x8a, 0xcf, 0x20, 0x55,
-	0xe3, 0x3b, 0xfc, 0x40, 0xe0, 0x26, 0x31, 0x2b, 0xd4, 0x05, 0xc8, 0x69, 0x13, 0x95, 0x26, 0x54,
-	0xb6, 0xed, 0x0d, 0xbb, 0xbf, 0x88, 0x4f, 0x58, 0x7a, 0x1c, 0xea, 0xfb, 0x53, 0x4c, 0x3e, 0x43,
-	0x17, 0x01, 0xf2, 0xa3, 0xbc, 0x51, 0xa6, 0xa8, 0xd4, 0xbc, 0x16, 0x71, 0x2b, 0xb7, 0x0c, 0x73,
-	0x03, 0x7a, 0x1d, 0x2e, 0xcc, 0x18, 0x18, 0x4c, 0x55, 0x63, 0x96, 0xca, 0xa3, 0x0a, 0xdc, 0x59,
-	0xe7, 0x7d, 0x69, 0xc1, 0xc2, 0x1e, 0x0b, 0xf9, 0xb6, 0xf0, 0xff, 0xad, 0x23, 0xd7, 0xa0, 0xe9,
-	0x8f, 0x09, 0xe3, 0x1e, 0x0b, 0xda, 0xf6, 0x86, 0xd5, 0x6f, 0xe1, 0x05, 0xbd, 0xbf, 0x13, 0xa0,
-	0x2b, 0x70, 0x9e, 0xf8, 0xbe, 0x48, 0xb9, 0xf2, 0x78, 0x1a, 0x8d, 0x68, 0xd2, 0xae, 0x6d, 0x58,
-	0xfd, 0x1a, 0x5e, 0x32, 0xd6, 0x8f, 0xb4, 0xb1, 0xf7, 0xbb, 0x05, 0xcb, 0x86, 0xd4, 0x36, 0x4b,
-	0xa8, 0xaf, 0x06, 0xe9, 0xf4, 0x2c, 0x76, 0x37, 0x00, 0xe2, 0x74, 0x34, 0x61, 0xbe, 0xf7, 0x80,
-	0x66, 0xe6, 0x4e, 0x56, 0x9d, 0x42, 0x13, 0x4e, 0xa9, 0x09, 0x67, 0xc0, 0x33, 0xdc, 0x2a, 0x70,
-	0x77, 0x69, 0xf6, 0xcf, 0xa9, 0xa2, 0x0e, 0x34, 0x25, 0xfd, 0x24, 0xa5, 0xdc, 0xa7, 0xed, 0xba,
-	0x06, 0xcc, 0xf6, 0xe8, 0x4d, 0xb0, 0x15, 0x8b, 0xdb, 0x0d, 0xcd, 0xe5, 0x7f, 0xa7, 0x69, 0x8a,
-	0xc5, 0xc3, 0x6a, 0xdb, 0xc2, 0x39, 0xac, 0xf7, 0x7d, 0x15, 0x1a, 0x85, 0xc8, 0xd0, 0x35, 0x68,
-	0x46, 0x54, 0x4a, 0x12, 0xea, 0x44, 0xed, 0x97, 0x66, 0x32, 0x43, 0x21, 0x04, 0xb5, 0x88, 0x46,
-	0x85, 0x16, 0x5b, 0x58, 0xaf, 0xf3, 0x0c, 0x14, 0x8b, 0xa8, 0x48, 0x95, 0x37, 0xa6, 0x2c, 0x1c,
-	0x2b, 0x9d, 0x62, 0x0d, 0x2f, 0x19, 0xeb, 0xae, 0x36, 0xa2, 0xff, 0x43, 0x2b, 0xe5, 0x22, 0x09,
-	0x68, 0x42, 0x03, 0x9d, 0x63, 0x13, 0x1f, 0x1b, 0xd0, 0x10, 0x56, 0xe8, 0x54, 0x51, 0x2e, 0x99,
-	0xe0, 0x9e, 0x88, 0x15, 0x13, 0x5c, 0xb6, 0xff, 0x58, 0x98, 0x43, 0x6a, 0x79, 0x86, 0xff, 0xb8,
-	0x80, 0xa3, 0xfb, 0xd0, 0xe5, 0x82, 0x7b, 0x7e, 0xc2, 0x14, 0xf3, 0xc9, 0xc4, 0x3b, 0x25, 0xe0,
-	0x85, 0x39, 0x01, 0xd7, 0xb9, 0xe0, 0xb7, 0x8d, 0xef, 0x07, 0x2f, 0xc4, 0xee, 0x7d, 0x63, 0x41,
-	0xb3, 0x7c, 0x66, 0xe8, 0x7d, 0x58, 0xcc, 0xa5, 0x4d, 0x13, 0xad, 0xd1, 0xb2, 0x76, 0x17, 0x4f,
-	0xa9, 0xfc, 0x9e, 0x86, 0xe9, 0xb7, 0x79, 0x4e, 0xce, 0xd6, 0x12, 0xf5, 0xc1, 0x3e, 0xa0, 0xd4,
-	0xc8, 0xe7, 0xb4, 0x2b, 0xdb, 0xa1, 0x14, 0xe7, 0x90, 0xf2, 0x72, 0xed, 0x57, 0xbb, 0xdc, 0xaf,
-	0x2c, 0x80, 0xe3, 0x33, 0x5f, 0x10, 0xab, 0xf5, 0x6a, 0x62, 0xbd, 0x09, 0xad, 0x48, 0x04, 0xf4,
-	0xac, 0xa6, 0x73, 0x4f, 0x04, 0xb4, 0x68, 0x3a, 0x91, 0x59, 0x3d, 0x27, 0x52, 0xfb, 0x79, 0x91,
-	0xf6, 0x9e, 0x56, 0xa1, 0x59, 0xba, 0xa0, 0x77, 0xa0, 0x21, 0x19, 0x0f, 0x27, 0xd4, 0x70, 0xea,
-	0xcd, 0x89, 0xef, 0xec, 0x69, 0xe4, 0x6e, 0x05, 0x1b, 0x1f, 0xf4, 0x36, 0xd4, 0x75, 0x73, 0x37,
-	0xe4, 0x5e, 0x9b, 0xe7, 0x7c, 0x2f, 0x07, 0xee, 0x56, 0x70, 0xe1, 0xd1, 0x19, 0x40, 0xa3, 0x08,
-	0x87, 0xde, 0x82, 0x5a, 0xce, 0x5b, 0x13, 0x38, 0xbf, 0x75, 0xf9, 0x44, 0x8c, 0xb2, 0xdd, 0x9f,
-	0xbc, 0xc3, 0x3c, 0x1e, 0xd6, 0x0e, 0x9d, 0x87, 0x16, 0xd4, 0x75, 0x54, 0x74, 0x17, 0x9a, 0x23,
-	0xa6, 0x48, 0x92, 0x90, 0xb2, 0xb6, 0x6e, 0x19, 0xa6, 0x18, 0x4a, 0xce, 0x6c, 0x06, 0x95, 0xb1,
-	0x6e, 0x8b, 0x28, 0x26, 0xbe, 0x1a, 0x32, 0x35, 0xc8, 0xdd, 0xf0, 0x2c, 0x00, 0xba, 0x05, 0x30,
-	0xab, 0x7a, 0xde, 0xf0, 0xec, 0xb3, 0xca, 0xde, 0x2a, 0xcb, 0x2e, 0x87, 0x75, 0xb0, 0x65, 0x1a,
-	0xf5, 0x3e, 0xaf, 0x82, 0xbd, 0x43, 0x29, 0xca, 0xa0, 0x41, 0xa2, 0xbc, 0x77, 0x18, 0x61, 0xce,
-	0xc6, 0x4c, 0x3e, 0xfb, 0x4e, 0x50, 0x61, 0x7c, 0xb8, 0xf3, 0xf8, 0xd7, 0x4b, 0x95, 0xef, 0x7e,
-	0xbb, 0xd4, 0x0f, 0x99, 0x1a, 0xa7, 0x23, 0xc7, 0x17, 0x91, 0x5b, 0xce, 0x55, 0xfd, 0xb7, 0x29,
-	0x83, 0x07, 0xae, 0xca, 0x62, 0x2a, 0xb5, 0x83, 0xfc, 0xfa, 0xd9, 0xa3, 0xab, 0x8b, 0x13, 0x1a,
-	0x12, 0x3f, 0xf3, 0xf2, 0xe9, 0x29, 0xbf, 0x7d, 0xf6, 0xe8, 0xaa, 0x85, 0xcd, 0x81, 0x68, 0x1d,
-	0x5a, 0x21, 0x91, 0xde, 0x84, 0x45, 0x4c, 0xe9, 0xeb, 0xa9, 0xe1, 0x66, 0x48, 0xe4, 0x87, 0xf9,
-	0x1e, 0x39, 0x50, 0x8f, 0x49, 0x46, 0x93, 0xa2, 0x05, 0x0e, 0xdb, 0x3f, 0xff, 0xb0, 0xb9, 0x6a,
-	0x98, 0x0d, 0x82, 0x20, 0xa1, 0x52, 0xee, 0xa9, 0x84, 0xf1, 0x10, 0x17, 0x30, 0xb4, 0x05, 0x0b,
-	0x61, 0x42, 0xb8, 0x32, 0x3d, 0x71, 0x9e, 0x47, 0x09, 0xec, 0xfd, 0x68, 0x81, 0xbd, 0xcf, 0xe2,
-	0xff, 0xb2, 0x06, 0xd7, 0xa0, 0xa1, 0x58, 0x1c, 0xd3, 0xa4, 0xe8, 0x92, 0x73, 0x58, 0x1b, 0xdc,
-	0xad, 0x6a, 0xdb, 0xea, 0xfd, 0x64, 0xc1, 0xd2, 0x20, 0x9d, 0x16, 0x8f, 0x77, 0x9b, 0x28, 0x92,
-	0xa7, 0x4f, 0x0a, 0xb8, 0x56, 0xd7, 0xdc, 0xf4, 0x0d, 0x10, 0xbd, 0x0b, 0xcd, 0x5c, 0xbe, 0x5e,
-	0x20, 0x7c, 0xf3, 0x3a, 0x2e, 0xbf, 0xa4, 0x2b, 0x9d, 0x9c, 0x79, 0x78, 0x41, 0x9a, 0xd1, 0x5c,
-	0xbe, 0x0a, 0xfb, 0x6f, 0xbe, 0x0a, 0xb4, 0x0c, 0xb6, 0x64, 0xa1, 0xbe, 0xa7, 0x45, 0x9c, 0x2f,
-	0x87, 0xef, 0x3d, 0x3e, 0xec, 0x5a, 0x4f, 0x0e, 0xbb, 0xd6, 0xd3, 0xc3, 0xae, 0xf5, 0xf0, 0xa8,
-	0x5b, 0x79, 0x72, 0xd4, 0xad, 0xfc, 0x72, 0xd4, 0xad, 0xdc, 0xbf, 0x72, 0x76, 0xa1, 0x5d, 0x35,
-	0x1d, 0x35, 0x74, 0x83, 0xba, 0xf1, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb1, 0x48, 0xf7, 0x44,
-	0x41, 0x0a, 0x00, 0x00,
+	// 1110 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xc1, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf6, 0x7a, 0x6d, 0xc7, 0x7e, 0x4d, 0xda, 0x64, 0x54, 0xfd, 0xb4, 0x75, 0x7f, 0x75, 0x82,
+	0xab, 0x82, 0x55, 0x91, 0xdd, 0x36, 0x3d, 0x50, 0x2a, 0x04, 0xd8, 0x2d, 0x55, 0xab, 0x12, 0x90,
+	0x36, 0x39, 0xf5, 0xb2, 0x1a, 0xef, 0x4e, 0xd6, 0xa3, 0x7a, 0x67, 0x96, 0x9d, 0x59, 0xf0, 0x1e,
+	0xe1, 0x8e, 0x14, 0x71, 0x41, 0xe2, 0xcc, 0x01, 0x71, 0xea, 0x01, 0xf1, 0x37, 0xf4, 0x84, 0x2a,
+	0x4e, 0x9c, 0x68, 0x95, 0x1c, 0x7a, 0xe7, 0x1f, 0x00, 0xed, 0xec, 0xec, 0x26, 0x4d, 0x93, 0xb8,
+	0x08, 0x24, 0x2e, 0xf6, 0xcc, 0xdb, 0xef, 0xbd, 0xf9, 0xde, 0xcc, 0xf7, 0xde, 0x83, 0xae, 0xcf,
+	0x45, 0xc4, 0x85, 0x23, 0x67, 0xce, 0xe7, 0xd7, 0xc7, 0x44, 0xe2, 0xeb, 0x8e, 0x9c, 0xd9, 0x71,
+	0xc2, 0x25, 0x47, 0x2b, 0xc5, 0x37, 0x5b, 0xce, 0x6c, 0xfd, 0xad, 0xbb, 0x82, 0x23, 0xca, 0xb8,
+	0xa3, 0x7e, 0x0b, 0x54, 0xf7, 0x7c, 0xc8, 0x43, 0xae, 0x96, 0x4e, 0xbe, 0xd2, 0xd6, 0x75, 0x1d,
+	0xd7, 0x4f, 0xb2, 0x58, 0x72, 0x27, 0x4a, 0xa7, 0x92, 0x0a, 0x1a, 0x56, 0x87, 0x94, 0x06, 0x0d,
+	0xef, 0x69, 0xf8, 0x18, 0x0b, 0x52, 0x61, 0x7c, 0x4e, 0x99, 0xfe, 0xfe, 0xd6, 0x01, 0x4d, 0x41,
+	0x43, 0x46, 0xd9, 0x41, 0x24, 0xbd, 0xd7, 0xc0, 0x0b, 0x21, 0xe7, 0xe1, 0x94, 0x38, 0x6a, 0x37,
+	0x4e, 0x77, 0x1c, 0xcc, 0x32, 0xfd, 0x69, 0xf5, 0xe8, 0x27, 0x49, 0x23, 0x22, 0x24, 0x8e, 0xe2,
+	0xd2, 0xb7, 0x38, 0xc4, 0x2b, 0x92, 0xd1, 0xc9, 0xab, 0x4d, 0xff, 0x6b, 0x03, 0xea, 0xdb, 0x33,
+	0xb4, 0x0e, 0x8d, 0x31, 0x0f, 0x32, 0xcb, 0x58, 0x33, 0x06, 0x67, 0x36, 0x2e, 0xd8, 0xaf, 0x5c,
+	0x90, 0xbd, 0x3d, 0x1b, 0xf1, 0x20, 0x73, 0x15, 0x0c, 0xdd, 0x84, 0x0e, 0x4e, 0xe5, 0xc4, 0xa3,
+	0x6c, 0x87, 0x5b, 0x75, 0xe5, 0x73, 0xf1, 0x18, 0x9f, 0x61, 0x2a, 0x27, 0xf7, 0xd9, 0x0e, 0x77,
+	0xdb, 0x58, 0xaf, 0x50, 0x0f, 0x20, 0xcf, 0x0b, 0xcb, 0x34, 0x21, 0xc2, 0x32, 0xd7, 0xcc, 0xc1,
+	0xa2, 0x7b, 0xc8, 0xd2, 0x67, 0xd0, 0xdc, 0x9e, 0xb9, 0xf8, 0x0b, 0x74, 0x09, 0x20, 0x3f, 0xca,
+	0x1b, 0x67, 0x92, 0x08, 0xc5, 0x6b, 0xd1, 0xed, 0xe4, 0x96, 0x51, 0x6e, 0x40, 0x6f, 0xc2, 0xb9,
+	0x8a, 0x81, 0xc6, 0xd4, 0x15, 0x66, 0xa9, 0x3c, 0xaa, 0xc0, 0xcd, 0x3b, 0xef, 0x1b, 0x03, 0x16,
+	0xb6, 0x68, 0xc8, 0xee, 0x70, 0xff, 0xdf, 0x3a, 0xf2, 0x02, 0xb4, 0xfd, 0x09, 0xa6, 0xcc, 0xa3,
+	0x81, 0x65, 0xae, 0x19, 0x83, 0x8e, 0xbb, 0xa0, 0xf6, 0xf7, 0x03, 0x74, 0x05, 0xce, 0x62, 0xdf,
+	0xe7, 0x29, 0x93, 0x1e, 0x4b, 0xa3, 0x31, 0x49, 0xac, 0xc6, 0x9a, 0x31, 0x68, 0xb8, 0x4b, 0xda,
+	0xfa, 0x89, 0x32, 0xf6, 0xff, 0x30, 0x60, 0x59, 0x93, 0xba, 0x43, 0x13, 0xe2, 0xcb, 0x61, 0x3a,
+	0x9b, 0xc7, 0xee, 0x06, 0x40, 0x9c, 0x8e, 0xa7, 0xd4, 0xf7, 0x1e, 0x91, 0x4c, 0xbf, 0xc9, 0x79,
+	0xbb, 0x50, 0x86, 0x5d, 0x2a, 0xc3, 0x1e, 0xb2, 0xcc, 0xed, 0x14, 0xb8, 0x07, 0x24, 0xfb, 0xe7,
+	0x54, 0x51, 0x17, 0xda, 0x82, 0x7c, 0x96, 0x12, 0xe6, 0x13, 0xab, 0xa9, 0x00, 0xd5, 0x1e, 0xbd,
+	0x0d, 0xa6, 0xa4, 0xb1, 0xd5, 0x52, 0x5c, 0xfe, 0x77, 0x9c, 0xa6, 0x68, 0x3c, 0xaa, 0x5b, 0x86,
+	0x9b, 0xc3, 0xfa, 0x5f, 0x99, 0xd0, 0x2a, 0x44, 0x86, 0xae, 0x41, 0x3b, 0x22, 0x42, 0xe0, 0x50,
+	0x25, 0x6a, 0x9e, 0x98, 0x49, 0x85, 0x42, 0x08, 0x1a, 0x11, 0x89, 0x0a, 0x2d, 0x76, 0x5c, 0xb5,
+	0xce, 0x33, 0xc8, 0x0b, 0x81, 0xa7, 0xd2, 0x9b, 0x10, 0x1a, 0x4e, 0xa4, 0x4a, 0xb1, 0xe1, 0x2e,
+	0x69, 0xeb, 0x3d, 0x65, 0x44, 0xff, 0x87, 0x4e, 0xca, 0x78, 0x12, 0x90, 0x84, 0x04, 0x2a, 0xc7,
+	0xb6, 0x7b, 0x60, 0x40, 0x9b, 0xb0, 0x52, 0x06, 0xa9, 0xaa, 0x4a, 0x25, 0x7a, 0x66, 0xa3, 0xfb,
+	0x0a, 0xa7, 0xed, 0x12, 0x31, 0x6a, 0xec, 0x3e, 0x5b, 0x35, 0xdc, 0x65, 0xed, 0x5a, 0xd9, 0xd1,
+	0x08, 0x56, 0xc8, 0x4c, 0x12, 0x26, 0x28, 0x67, 0x1e, 0x8f, 0x25, 0xe5, 0x4c, 0x58, 0x7f, 0x2e,
+	0x9c, 0x92, 0xe3, 0x72, 0x85, 0xff, 0xb4, 0x80, 0xa3, 0x87, 0xd0, 0x63, 0x9c, 0x79, 0x7e, 0x42,
+	0x25, 0xf5, 0xf1, 0xd4, 0x3b, 0x26, 0xe0, 0xb9, 0x53, 0x02, 0x5e, 0x64, 0x9c, 0xdd, 0xd6, 0xbe,
+	0x1f, 0x1d, 0x89, 0xdd, 0xff, 0xde, 0x80, 0x76, 0x59, 0xb5, 0xe8, 0x43, 0x58, 0xcc, 0x2b, 0x85,
+	0x24, 0x4a, 0xf2, 0xe5, 0x53, 0x5c, 0x3a, 0xe6, 0x21, 0xb7, 0x14, 0x4c, 0x95, 0xfa, 0x19, 0x51,
+	0xad, 0x05, 0x1a, 0x80, 0xb9, 0x43, 0x88, 0x55, 0x3f, 0x51, 0x01, 0x77, 0x09, 0x71, 0x73, 0x48,
+	0xa9, 0x15, 0xf3, 0xf5, 0xb4, 0xf2, 0xad, 0x01, 0x70, 0x70, 0xe6, 0x11, 0xed, 0x1b, 0xaf, 0xa7,
+	0xfd, 0x9b, 0xd0, 0x89, 0x78, 0x40, 0xe6, 0xf5, 0xb0, 0x4d, 0x1e, 0x90, 0xa2, 0x87, 0x45, 0x7a,
+	0xf5, 0x92, 0xe6, 0xcd, 0x97, 0x35, 0xdf, 0x7f, 0x5e, 0x87, 0x76, 0xe9, 0x82, 0xde, 0x83, 0x96,
+	0xa0, 0x2c, 0x9c, 0x12, 0xcd, 0xa9, 0x7f, 0x4a, 0x7c, 0x7b, 0x4b, 0x21, 0xef, 0xd5, 0x5c, 0xed,
+	0x83, 0xde, 0x85, 0xa6, 0x1a, 0x26, 0x9a, 0xdc, 0x1b, 0xa7, 0x39, 0x6f, 0xe6, 0xc0, 0x7b, 0x35,
+	0xb7, 0xf0, 0xe8, 0x0e, 0xa1, 0x55, 0x84, 0x43, 0xef, 0x40, 0x23, 0xe7, 0xad, 0x08, 0x9c, 0xdd,
+	0xb8, 0x7c, 0x28, 0x46, 0x39, 0x5e, 0x0e, 0xbf, 0x61, 0x1e, 0xcf, 0x55, 0x0e, 0xdd, 0x5d, 0x03,
+	0x9a, 0x2a, 0x2a, 0x7a, 0x00, 0xed, 0x31, 0x95, 0x38, 0x49, 0x70, 0x79, 0xb7, 0x4e, 0x19, 0xa6,
+	0x18, 0x82, 0x76, 0x35, 0xf3, 0xca, 0x58, 0xb7, 0x79, 0x14, 0x63, 0x5f, 0x8e, 0xa8, 0x1c, 0xe6,
+	0x6e, 0x6e, 0x15, 0x00, 0xdd, 0x02, 0xa8, 0x6e, 0x3d, 0xef, 0x9f, 0xe6, 0xbc, 0x6b, 0xef, 0x94,
+	0xd7, 0x2e, 0x46, 0x4d, 0x30, 0x45, 0x1a, 0xf5, 0xbf, 0xac, 0x83, 0x79, 0x97, 0x10, 0x94, 0x41,
+	0x0b, 0x47, 0x79, 0x2b, 0xd2, 0xc2, 0xac, 0xa6, 0x56, 0x3e, 0x6b, 0x0f, 0x51, 0xa1, 0x6c, 0x74,
+	0xf7, 0xc9, 0xef, 0xab, 0xb5, 0x1f, 0x9f, 0xad, 0x0e, 0x42, 0x2a, 0x27, 0xe9, 0xd8, 0xf6, 0x79,
+	0xe4, 0x94, 0x73, 0x5c, 0xfd, 0xad, 0x8b, 0xe0, 0x91, 0x23, 0xb3, 0x98, 0x08, 0xe5, 0x20, 0xbe,
+	0x7b, 0xf1, 0xf8, 0xea, 0xe2, 0x94, 0x84, 0xd8, 0xcf, 0xbc, 0x7c, 0x5a, 0x8b, 0x1f, 0x5e, 0x3c,
+	0xbe, 0x6a, 0xb8, 0xfa, 0x40, 0x74, 0x11, 0x3a, 0x21, 0x16, 0xde, 0x94, 0x46, 0x54, 0xaa, 0xe7,
+	0x69, 0xb8, 0xed, 0x10, 0x8b, 0x8f, 0xf3, 0x3d, 0xb2, 0xa1, 0x19, 0xe3, 0x8c, 0x24, 0x45, 0x47,
+	0x1d, 0x59, 0xbf, 0xfe, 0xb4, 0x7e, 0x5e, 0x33, 0x1b, 0x06, 0x41, 0x42, 0x84, 0xd8, 0x92, 0x09,
+	0x65, 0xa1, 0x5b, 0xc0, 0xd0, 0x06, 0x2c, 0x84, 0x09, 0x66, 0x52, 0xb7, 0xd8, 0xd3, 0x3c, 0x4a,
+	0x60, 0xff, 0x67, 0x03, 0xcc, 0x6d, 0x1a, 0xff, 0x97, 0x77, 0x70, 0x0d, 0x5a, 0x92, 0xc6, 0x31,
+	0x49, 0xac, 0xfa, 0x1c, 0xd6, 0x1a, 0x77, 0xab, 0x6e, 0x19, 0xfd, 0x5f, 0x0c, 0x58, 0x1a, 0xa6,
+	0xb3, 0xa2, 0x78, 0xef, 0x60, 0x89, 0xf3, 0xf4, 0x71, 0x01, 0xb7, 0x8c, 0x39, 0x81, 0x4a, 0x20,
+	0x7a, 0x1f, 0xda, 0xb9, 0x7c, 0xbd, 0x80, 0xfb, 0xba, 0x3a, 0x2e, 0x9f, 0xd0, 0x95, 0x0e, 0x8f,
+	0x50, 0x77, 0x41, 0x14, 0x96, 0xaa, 0x2a, 0xcc, 0xbf, 0x59, 0x15, 0x68, 0x19, 0x4c, 0x41, 0x43,
+	0xf5, 0x4e, 0x8b, 0x6e, 0xbe, 0x1c, 0x7d, 0xf0, 0x64, 0xaf, 0x67, 0x3c, 0xdd, 0xeb, 0x19, 0xcf,
+	0xf7, 0x7a, 0xc6, 0xee, 0x7e, 0xaf, 0xf6, 0x74, 0xbf, 0x57, 0xfb, 0x6d, 0xbf, 0x57, 0x7b, 0x78,
+	0x65, 0xfe, 0x45, 0x3b, 0x72, 0x36, 0x6e, 0xa9, 0x06, 0x75, 0xe3, 0xaf, 0x01, 0x00, 0x69, 0x60,
+	0x65, 0x08, 0xb1, 0x0a, 0x00, 0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -1389,6 +1403,16 @@ func (m *TxBody) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xfa
 		}
 	}
+	if m.TimeoutTimestamp != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.TimeoutTimestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TimeoutTimestamp):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintTx(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Unordered {
 		i--
 		if m.Unordered {
@@ -1980,6 +2004,10 @@ func (m *TxBody) Size() (n int) {
 	if m.Unordered {
 		n += 2
 	}
+	if m.TimeoutTimestamp != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TimeoutTimestamp)
+		n += 1 + l + sovTx(uint64(l))
+	}
 	if len(m.ExtensionOptions) > 0 {
 		for _, e := range m.ExtensionOptions {
 			l = e.Size()
@@ -3013,6 +3041,42 @@ func (m *TxBody) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Unordered = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimeoutTimestamp == nil {
+				m.TimeoutTimestamp = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.TimeoutTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 1023:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionOptions", wireType)
@@ -18,8 +18,16 @@ type HandlerOptions struct {
 	BankKeeper               types.BankKeeper
 	ExtensionOptionChecker   ExtensionOptionChecker
 	FeegrantKeeper           FeegrantKeeper
+	FeeMarketKeeper          FeeMarketKeeper
+	FeeAbstractionKeeper     FeeAbstractionKeeper
+	FeeRateProvider          FeeRateProvider
+	FeeRoutingKeeper         FeeRoutingKeeper
+	GasPricingKeeper         GasPricingKeeper
+	MsgSurchargeKeeper       MsgSurchargeKeeper
 	SignModeHandler          *txsigning.HandlerMap
 	SigGasConsumer           func(meter storetypes.GasMeter, sig signing.SignatureV2, params types.Params) error
+	SmartAccountKeeper       SmartAccountKeeper
+	SpendingBankKeeper       SpendingBankKeeper
 	TxFeeChecker             TxFeeChecker
 }
 
@@ -46,9 +54,15 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		NewTxTimeoutHeightDecorator(),
 		NewValidateMemoDecorator(options.AccountKeeper),
 		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		NewFeeAbstractionDecorator(options.FeeAbstractionKeeper, options.BankKeeper, options.FeeRateProvider),
+		NewTieredGasPricingDecorator(options.GasPricingKeeper),
 		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		NewFeeMarketDecorator(options.FeeMarketKeeper, options.BankKeeper),
+		NewFeeRoutingDecorator(options.FeeRoutingKeeper, options.BankKeeper),
+		NewMsgSurchargeDecorator(options.MsgSurchargeKeeper, options.BankKeeper),
 		NewValidateSigCountDecorator(options.AccountKeeper),
-		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigGasConsumer, options.AccountAbstractionKeeper),
+		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigGasConsumer, options.AccountAbstractionKeeper).
+			WithSmartAccounts(options.SmartAccountKeeper, options.SpendingBankKeeper),
 	}
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
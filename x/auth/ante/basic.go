@@ -192,7 +192,8 @@ func NewTxTimeoutHeightDecorator() TxTimeoutHeightDecorator {
 // AnteHandle implements an AnteHandler decorator for the TxHeightTimeoutDecorator
 // type where the current block height is checked against the tx's height timeout.
 // If a height timeout is provided (non-zero) and is less than the current block
-// height, then an error is returned.
+// height, then an error is returned. Likewise, if the tx has a timeout timestamp
+// before the current block time, then an error is returned.
 func (txh TxTimeoutHeightDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	timeoutTx, ok := tx.(TxWithTimeoutHeight)
 	if !ok {
@@ -206,5 +207,15 @@ func (txh TxTimeoutHeightDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		)
 	}
 
+	if timeoutTimeTx, ok := tx.(sdk.TxWithTimeoutTimeStamp); ok {
+		timeoutTimestamp := timeoutTimeTx.GetTimeoutTimeStamp()
+		blockTime := ctx.HeaderInfo().Time
+		if !timeoutTimestamp.IsZero() && blockTime.After(timeoutTimestamp) {
+			return ctx, errorsmod.Wrapf(
+				sdkerrors.ErrTxTimeout, "block time: %s, timeout timestamp: %s", blockTime, timeoutTimestamp,
+			)
+		}
+	}
+
 	return next(ctx, tx, simulate)
 }
@@ -3,9 +3,11 @@ package ante_test
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/core/header"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/auth/ante"
 
@@ -192,16 +194,22 @@ func TestTxHeightTimeoutDecorator(t *testing.T) {
 	feeAmount := testdata.NewTestFeeAmount()
 	gasLimit := testdata.NewTestGasLimit()
 
+	blockTime := time.Unix(1700000000, 0).UTC()
+
 	testCases := []struct {
-		name        string
-		timeout     uint64
-		height      int64
-		expectedErr error
+		name             string
+		timeout          uint64
+		height           int64
+		timeoutTimestamp time.Time
+		expectedErr      error
 	}{
-		{"default value", 0, 10, nil},
-		{"no timeout (greater height)", 15, 10, nil},
-		{"no timeout (same height)", 10, 10, nil},
-		{"timeout (smaller height)", 9, 10, sdkerrors.ErrTxTimeoutHeight},
+		{"default value", 0, 10, time.Time{}, nil},
+		{"no timeout (greater height)", 15, 10, time.Time{}, nil},
+		{"no timeout (same height)", 10, 10, time.Time{}, nil},
+		{"timeout (smaller height)", 9, 10, time.Time{}, sdkerrors.ErrTxTimeoutHeight},
+		{"no timeout (later timestamp)", 0, 10, blockTime.Add(time.Second), nil},
+		{"no timeout (same timestamp)", 0, 10, blockTime, nil},
+		{"timeout (earlier timestamp)", 0, 10, blockTime.Add(-time.Second), sdkerrors.ErrTxTimeout},
 	}
 
 	for _, tc := range testCases {
@@ -216,12 +224,13 @@ func TestTxHeightTimeoutDecorator(t *testing.T) {
 			suite.txBuilder.SetGasLimit(gasLimit)
 			suite.txBuilder.SetMemo(strings.Repeat("01234567890", 10))
 			suite.txBuilder.SetTimeoutHeight(tc.timeout)
+			suite.txBuilder.SetTimeoutTimestamp(tc.timeoutTimestamp)
 
 			privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
 			tx, err := suite.CreateTestTx(suite.ctx, privs, accNums, accSeqs, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
 			require.NoError(t, err)
 
-			ctx := suite.ctx.WithBlockHeight(tc.height)
+			ctx := suite.ctx.WithBlockHeight(tc.height).WithHeaderInfo(header.Info{Time: blockTime})
 			_, err = antehandler(ctx, tx, true)
 			require.ErrorIs(t, err, tc.expectedErr)
 		})
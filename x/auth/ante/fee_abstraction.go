@@ -0,0 +1,157 @@
+package ante
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FeeAbstractionKeeper defines the contract needed to convert the fees paid in
+// the accepted fee denoms to the native fee denom.
+type FeeAbstractionKeeper interface {
+	GetFeeAbstractionParams(ctx context.Context) (types.FeeAbstractionParams, error)
+}
+
+// FeeRateProvider provides the conversion rates of fee denoms to the native fee
+// denom, e.g. from an oracle. A rate is the amount of the native denom one unit
+// of the denom converts to.
+type FeeRateProvider interface {
+	GetFeeConversionRate(ctx context.Context, denom, nativeDenom string) (rate math.LegacyDec, found bool, err error)
+}
+
+// FeeAbstractionDecorator converts the fees paid in the accepted fee denoms to
+// the native fee denom before they are checked and deducted: it sends them from
+// the fee payer, or the fee granter if any, to the reserve module account, in
+// exchange for their value in the native denom at the conversion rate quoted by
+// the FeeRateProvider, if any, or else set by governance. The following
+// decorators then check and deduct the converted fee, see ConvertedFee. The
+// transaction is rejected if the reserve can't cover the conversion.
+// The decorator does nothing if no FeeAbstractionKeeper is provided or if fee
+// abstraction is disabled.
+// CONTRACT: Tx must implement FeeTx interface to use FeeAbstractionDecorator
+// CONTRACT: FeeAbstractionDecorator must run before the DeductFeeDecorator
+type FeeAbstractionDecorator struct {
+	fak          FeeAbstractionKeeper
+	bankKeeper   types.BankKeeper
+	rateProvider FeeRateProvider
+}
+
+func NewFeeAbstractionDecorator(fak FeeAbstractionKeeper, bk types.BankKeeper, rp FeeRateProvider) FeeAbstractionDecorator {
+	return FeeAbstractionDecorator{
+		fak:          fak,
+		bankKeeper:   bk,
+		rateProvider: rp,
+	}
+}
+
+func (fad FeeAbstractionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// skip fee abstraction for genesis transactions
+	if fad.fak == nil || ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	params, err := fad.fak.GetFeeAbstractionParams(ctx)
+	if err != nil {
+		return ctx, err
+	}
+	if !params.Enabled {
+		return next(ctx, tx, simulate)
+	}
+
+	fee, paidFee, nativeFee := sdk.NewCoins(), sdk.NewCoins(), math.ZeroInt()
+	for _, coin := range feeTx.GetFee() {
+		accepted, ok := params.AcceptedDenom(coin.Denom)
+		if !ok || !coin.IsPositive() {
+			fee = fee.Add(coin)
+			continue
+		}
+
+		rate, err := fad.conversionRate(ctx, params, accepted)
+		if err != nil {
+			return ctx, err
+		}
+
+		paidFee = paidFee.Add(coin)
+		nativeFee = nativeFee.Add(math.LegacyNewDecFromInt(coin.Amount).Mul(rate).TruncateInt())
+	}
+	if paidFee.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	payer := sdk.AccAddress(feeTx.FeePayer())
+	if feeGranter := feeTx.FeeGranter(); feeGranter != nil {
+		payer = feeGranter
+	}
+
+	if err := fad.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, params.Reserve, paidFee); err != nil {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+	}
+
+	nativeFees := sdk.NewCoins(sdk.NewCoin(params.NativeDenom, nativeFee))
+	if !nativeFees.IsZero() {
+		if err := fad.bankKeeper.SendCoinsFromModuleToAccount(ctx, params.Reserve, payer, nativeFees); err != nil {
+			return ctx, errorsmod.Wrapf(err, "fee abstraction reserve %s cannot convert %s to %s", params.Reserve, paidFee, nativeFees)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConvertFee,
+			sdk.NewAttribute(types.AttributeKeyPaidFee, paidFee.String()),
+			sdk.NewAttribute(types.AttributeKeyNativeFee, nativeFees.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, payer.String()),
+		),
+	)
+
+	return next(contextWithConvertedFee(ctx, fee.Add(nativeFees...)), tx, simulate)
+}
+
+// conversionRate returns the conversion rate of an accepted fee denom, quoted by
+// the rate provider if any, or else set by governance.
+func (fad FeeAbstractionDecorator) conversionRate(ctx sdk.Context, params types.FeeAbstractionParams, accepted types.AcceptedFeeDenom) (math.LegacyDec, error) {
+	if fad.rateProvider != nil {
+		rate, found, err := fad.rateProvider.GetFeeConversionRate(ctx, accepted.Denom, params.NativeDenom)
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+		if found && rate.IsPositive() {
+			return rate, nil
+		}
+	}
+
+	if !accepted.ConversionRate.IsPositive() {
+		return math.LegacyDec{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "no conversion rate for fee denom %s", accepted.Denom)
+	}
+
+	return accepted.ConversionRate, nil
+}
+
+type convertedFeeKey struct{}
+
+// contextWithConvertedFee returns the context with the fee of the transaction
+// converted to the native fee denom.
+func contextWithConvertedFee(ctx sdk.Context, fee sdk.Coins) sdk.Context {
+	return ctx.WithValue(convertedFeeKey{}, fee)
+}
+
+// ConvertedFee returns the fee of a transaction, with the fees paid in the
+// accepted fee denoms converted to the native fee denom by the
+// FeeAbstractionDecorator. It is the fee to check and deduct, including by the
+// custom TxFeeCheckers.
+func ConvertedFee(ctx context.Context, feeTx sdk.FeeTx) sdk.Coins {
+	if fee, ok := ctx.Value(convertedFeeKey{}).(sdk.Coins); ok {
+		return fee
+	}
+
+	return feeTx.GetFee()
+}
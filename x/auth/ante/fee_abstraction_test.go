@@ -0,0 +1,120 @@
+package ante_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/auth/ante"
+	authtypes "cosmossdk.io/x/auth/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+type mockFeeRateProvider map[string]math.LegacyDec
+
+func (p mockFeeRateProvider) GetFeeConversionRate(_ context.Context, denom, _ string) (math.LegacyDec, bool, error) {
+	rate, found := p[denom]
+	return rate, found, nil
+}
+
+func TestFeeAbstractionDecorator(t *testing.T) {
+	s := SetupTestSuite(t, false)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	rates := mockFeeRateProvider{}
+	antehandler := sdk.ChainAnteDecorators(ante.NewFeeAbstractionDecorator(s.accountKeeper, s.bankKeeper, rates))
+
+	accs := s.CreateTestAccounts(1)
+	require.NoError(t, s.txBuilder.SetMsgs(testdata.NewTestMsg(accs[0].acc.GetAddress())))
+	s.txBuilder.SetGasLimit(100)
+
+	newTx := func(fee sdk.Coins) sdk.Tx {
+		s.txBuilder.SetFeeAmount(fee)
+		privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+		tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		return tx
+	}
+	ctx := s.ctx.WithBlockHeight(1)
+	payer := accs[0].acc.GetAddress()
+	usdcFee := sdk.NewCoins(sdk.NewInt64Coin("usdc", 41))
+
+	// nothing is converted while fee abstraction is disabled
+	_, err := antehandler(ctx, newTx(usdcFee), false)
+	require.NoError(t, err)
+
+	require.NoError(t, s.accountKeeper.SetFeeAbstractionParams(s.ctx, authtypes.FeeAbstractionParams{
+		Enabled:     true,
+		NativeDenom: "atom",
+		Reserve:     "mint",
+		AcceptedDenoms: []authtypes.AcceptedFeeDenom{
+			{Denom: "usdc", ConversionRate: math.LegacyNewDecWithPrec(25, 1)},
+			{Denom: "osmo", ConversionRate: math.LegacyZeroDec()},
+		},
+	}))
+
+	// the native fee denom is left as paid
+	atomFee := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	tx := newTx(atomFee)
+	newCtx, err := antehandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, atomFee, ante.ConvertedFee(newCtx, tx.(sdk.FeeTx)))
+
+	// the accepted denoms are swapped for the native denom at the governance rate
+	// on the fee payer account, before the fees are checked and deducted
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), payer, "mint", usdcFee).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), "mint", payer, sdk.NewCoins(sdk.NewInt64Coin("atom", 102))).Return(nil)
+	tx = newTx(usdcFee.Add(sdk.NewInt64Coin("stake", 5)))
+	newCtx, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 102), sdk.NewInt64Coin("stake", 5)), ante.ConvertedFee(newCtx, tx.(sdk.FeeTx)))
+	events := newCtx.EventManager().Events()
+	require.Equal(t, authtypes.EventTypeConvertFee, events[len(events)-1].Type)
+
+	// the transaction is rejected if the fee payer or the reserve cannot cover the
+	// conversion
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), payer, "mint", usdcFee).Return(sdkerrors.ErrInsufficientFunds)
+	_, err = antehandler(ctx, newTx(usdcFee), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), payer, "mint", usdcFee).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), "mint", payer, gomock.Any()).Return(sdkerrors.ErrInsufficientFunds)
+	_, err = antehandler(ctx, newTx(usdcFee), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	// a denom without a governance rate requires a quoted rate
+	osmoFee := sdk.NewCoins(sdk.NewInt64Coin("osmo", 10))
+	_, err = antehandler(ctx, newTx(osmoFee), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// a quoted rate takes precedence over the governance rate
+	rates["osmo"] = math.LegacyNewDec(3)
+	rates["usdc"] = math.LegacyOneDec()
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), payer, "mint", osmoFee.Add(usdcFee...)).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), "mint", payer, sdk.NewCoins(sdk.NewInt64Coin("atom", 71))).Return(nil)
+	_, err = antehandler(ctx, newTx(osmoFee.Add(usdcFee...)), false)
+	require.NoError(t, err)
+
+	// the converted fee is checked against the minimum gas prices and deducted
+	antehandler = sdk.ChainAnteDecorators(
+		ante.NewFeeAbstractionDecorator(s.accountKeeper, s.bankKeeper, rates),
+		ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, nil, nil),
+	)
+	checkCtx := ctx.WithExecMode(sdk.ExecModeCheck).WithMinGasPrices(sdk.NewDecCoins(sdk.NewInt64DecCoin("atom", 1)))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), payer, "mint", usdcFee).Return(nil).Times(2)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), "mint", payer, sdk.NewCoins(sdk.NewInt64Coin("atom", 41))).Return(nil)
+	_, err = antehandler(checkCtx, newTx(usdcFee), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+	rates["usdc"] = math.LegacyNewDecWithPrec(25, 1)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), "mint", payer, sdk.NewCoins(sdk.NewInt64Coin("atom", 102))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), payer, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin("atom", 102))).Return(nil)
+	_, err = antehandler(checkCtx, newTx(usdcFee), false)
+	require.NoError(t, err)
+}
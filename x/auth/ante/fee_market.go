@@ -0,0 +1,107 @@
+package ante
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FeeMarketKeeper defines the contract needed to enforce the base fee of the fee
+// market and to track the gas used by the blocks.
+type FeeMarketKeeper interface {
+	GetFeeMarketParams(ctx context.Context) (types.FeeMarketParams, error)
+	GetBaseFee(ctx context.Context, params types.FeeMarketParams) (math.LegacyDec, error)
+	RecordBlockGas(ctx context.Context, params types.FeeMarketParams, gas uint64) error
+}
+
+// FeeMarketDecorator enforces the base fee of the fee market: it rejects the
+// transactions whose fees, converted by the FeeAbstractionDecorator if any, are
+// lower than the base fee of the current block times their gas limit, and burns
+// this part of the fees, or sends it to the base fee recipient, out of the fee
+// collector. In DeliverTx, the gas limit of the
+// transaction is added to the gas used by the block, from which the base fee of
+// the next block is computed, the gas left unused by a successful transaction
+// being refunded by the FeeMarketDecorator of the posthandler package.
+// The decorator does nothing if no FeeMarketKeeper is provided or if the fee
+// market is disabled.
+// CONTRACT: Tx must implement FeeTx interface to use FeeMarketDecorator
+// CONTRACT: FeeMarketDecorator must run after the DeductFeeDecorator
+type FeeMarketDecorator struct {
+	fmk        FeeMarketKeeper
+	bankKeeper types.BankKeeper
+}
+
+func NewFeeMarketDecorator(fmk FeeMarketKeeper, bk types.BankKeeper) FeeMarketDecorator {
+	return FeeMarketDecorator{
+		fmk:        fmk,
+		bankKeeper: bk,
+	}
+}
+
+func (fmd FeeMarketDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// skip the fee market for genesis transactions
+	if fmd.fmk == nil || ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	params, err := fmd.fmk.GetFeeMarketParams(ctx)
+	if err != nil {
+		return ctx, err
+	}
+	if !params.Enabled {
+		return next(ctx, tx, simulate)
+	}
+
+	if ctx.ExecMode() != sdk.ExecModeSimulate {
+		baseFee, err := fmd.fmk.GetBaseFee(ctx, params)
+		if err != nil {
+			return ctx, err
+		}
+
+		fee := ConvertedFee(ctx, feeTx)
+		requiredFee := params.RequiredFee(baseFee, feeTx.GetGas())
+		if !fee.IsAllGTE(requiredFee) {
+			return ctx, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees for the base fee %s%s; got: %s required: %s", baseFee, params.Denom, fee, requiredFee)
+		}
+
+		if err := fmd.disposeBaseFee(ctx, params, requiredFee); err != nil {
+			return ctx, err
+		}
+
+		// only the priority tip is left in the fee collector
+		collected := collectedFee(ctx)
+		ctx = contextWithCollectedFee(ctx, collected.Sub(collected.Min(requiredFee)...))
+	}
+
+	if ctx.ExecMode() == sdk.ExecModeFinalize {
+		if err := fmd.fmk.RecordBlockGas(ctx, params, feeTx.GetGas()); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// disposeBaseFee burns the base fee paid by a transaction out of the fee
+// collector, or sends it to the base fee recipient.
+func (fmd FeeMarketDecorator) disposeBaseFee(ctx sdk.Context, params types.FeeMarketParams, baseFee sdk.Coins) error {
+	if baseFee.IsZero() {
+		return nil
+	}
+
+	if params.BaseFeeRecipient != "" {
+		return fmd.bankKeeper.SendCoinsFromModuleToModule(ctx, types.FeeCollectorName, params.BaseFeeRecipient, baseFee)
+	}
+
+	return fmd.bankKeeper.BurnCoins(ctx, types.NewModuleAddress(types.FeeCollectorName), baseFee)
+}
@@ -0,0 +1,138 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/auth/ante"
+	"cosmossdk.io/x/auth/posthandler"
+	authtypes "cosmossdk.io/x/auth/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestFeeMarketDecorator(t *testing.T) {
+	s := SetupTestSuite(t, false)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewFeeMarketDecorator(s.accountKeeper, s.bankKeeper))
+	postHandler := sdk.ChainPostDecorators(posthandler.NewFeeMarketDecorator(s.accountKeeper))
+
+	accs := s.CreateTestAccounts(1)
+	require.NoError(t, s.txBuilder.SetMsgs(testdata.NewTestMsg(accs[0].acc.GetAddress())))
+	s.txBuilder.SetGasLimit(100)
+
+	newTx := func(fee int64) sdk.Tx {
+		s.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", fee)))
+		privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+		tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		return tx
+	}
+	atHeight := func(height int64) sdk.Context {
+		return s.ctx.WithBlockHeight(height).WithHeaderInfo(header.Info{Height: height})
+	}
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	// nothing is enforced while the fee market is disabled
+	_, err := antehandler(atHeight(1), newTx(0), false)
+	require.NoError(t, err)
+
+	params := authtypes.FeeMarketParams{
+		Enabled:                  true,
+		Denom:                    "atom",
+		MinBaseFee:               math.LegacyOneDec(),
+		TargetBlockGas:           50,
+		BaseFeeChangeDenominator: 8,
+	}
+	require.NoError(t, s.accountKeeper.FeeMarketParams.Set(s.ctx, params))
+
+	// the base fee starts at the min base fee and is burned
+	_, err = antehandler(atHeight(1).WithExecMode(sdk.ExecModeFinalize), newTx(99), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), feeCollector, sdk.NewCoins(sdk.NewInt64Coin("atom", 100))).Return(nil)
+	tx := newTx(120)
+	ctx := atHeight(1).WithExecMode(sdk.ExecModeFinalize)
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+
+	// the gas limit is recorded, and the gas left unused by a successful
+	// transaction is refunded
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(100)).WithKVGasConfig(storetypes.GasConfig{})
+	ctx.GasMeter().ConsumeGas(75, "test")
+	_, err = postHandler(ctx, tx, false, false)
+	require.NoError(t, err)
+	state, err := s.accountKeeper.GetFeeMarketState(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), state.BlockGasUsed)
+	_, err = postHandler(ctx, tx, false, true)
+	require.NoError(t, err)
+	state, err = s.accountKeeper.GetFeeMarketState(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(75), state.BlockGasUsed)
+
+	// the fees are not checked in simulation mode
+	_, err = antehandler(atHeight(2).WithExecMode(sdk.ExecModeSimulate), newTx(0), true)
+	require.NoError(t, err)
+
+	// the first block used 1.5 times the target gas, so the base fee increases
+	// by 1/16
+	baseFee, err := s.accountKeeper.GetBaseFee(atHeight(2), params)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(10625, 4), baseFee)
+	_, err = antehandler(atHeight(2), newTx(106), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), feeCollector, sdk.NewCoins(sdk.NewInt64Coin("atom", 107))).Return(nil)
+	_, err = antehandler(atHeight(2), newTx(107), false)
+	require.NoError(t, err)
+
+	// the gas is only recorded in DeliverTx, so the base fee decreases after the
+	// empty second block down to the min base fee
+	baseFee, err = s.accountKeeper.GetBaseFee(atHeight(3), params)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyOneDec(), baseFee)
+
+	// the fees paid in the accepted fee denoms are compared once converted
+	require.NoError(t, s.accountKeeper.SetFeeAbstractionParams(s.ctx, authtypes.FeeAbstractionParams{
+		Enabled:        true,
+		NativeDenom:    "atom",
+		Reserve:        "mint",
+		AcceptedDenoms: []authtypes.AcceptedFeeDenom{{Denom: "usdc", ConversionRate: math.LegacyNewDec(2)}},
+	}))
+	antehandler = sdk.ChainAnteDecorators(
+		ante.NewFeeAbstractionDecorator(s.accountKeeper, s.bankKeeper, nil),
+		ante.NewFeeMarketDecorator(s.accountKeeper, s.bankKeeper),
+	)
+	usdcTx := func(fee int64) sdk.Tx {
+		s.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("usdc", fee)))
+		privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+		tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		return tx
+	}
+	payer := accs[0].acc.GetAddress()
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), payer, "mint", gomock.Any()).Return(nil).Times(2)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), "mint", payer, sdk.NewCoins(sdk.NewInt64Coin("atom", 98))).Return(nil)
+	_, err = antehandler(atHeight(3), usdcTx(49), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), "mint", payer, sdk.NewCoins(sdk.NewInt64Coin("atom", 100))).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), feeCollector, sdk.NewCoins(sdk.NewInt64Coin("atom", 100))).Return(nil)
+	_, err = antehandler(atHeight(3), usdcTx(50), false)
+	require.NoError(t, err)
+
+	// the base fee is sent to the base fee recipient if any
+	params.BaseFeeRecipient = "mint"
+	require.NoError(t, s.accountKeeper.FeeMarketParams.Set(s.ctx, params))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), authtypes.FeeCollectorName, "mint", sdk.NewCoins(sdk.NewInt64Coin("atom", 100))).Return(nil)
+	_, err = antehandler(atHeight(3).WithExecMode(sdk.ExecModeFinalize), newTx(100), false)
+	require.NoError(t, err)
+}
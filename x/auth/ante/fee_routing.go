@@ -0,0 +1,101 @@
+package ante
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-metrics"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/auth/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeRoutingKeeper defines the contract needed to split the collected fees
+// between the sinks set by governance.
+type FeeRoutingKeeper interface {
+	GetFeeRoutingParams(ctx context.Context) (types.FeeRoutingParams, error)
+}
+
+// FeeRoutingDecorator splits the fees collected from a transaction between the
+// sinks set by governance: the share of every other sink than the fee collector
+// is sent out of it to the sink module account, or burned. The collected fees
+// are the fees deducted by the DeductFeeDecorator, converted by the
+// FeeAbstractionDecorator if any, less the base fee disposed of by the
+// FeeMarketDecorator. Genesis transactions are not routed.
+// The decorator does nothing if no FeeRoutingKeeper is provided.
+// CONTRACT: FeeRoutingDecorator must run after the DeductFeeDecorator and the
+// FeeMarketDecorator
+type FeeRoutingDecorator struct {
+	frk        FeeRoutingKeeper
+	bankKeeper types.BankKeeper
+}
+
+func NewFeeRoutingDecorator(frk FeeRoutingKeeper, bk types.BankKeeper) FeeRoutingDecorator {
+	return FeeRoutingDecorator{
+		frk:        frk,
+		bankKeeper: bk,
+	}
+}
+
+func (frd FeeRoutingDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	fee := collectedFee(ctx)
+	if frd.frk == nil || ctx.BlockHeight() == 0 || fee.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	params, err := frd.frk.GetFeeRoutingParams(ctx)
+	if err != nil {
+		return ctx, err
+	}
+
+	for _, routed := range params.Split(fee) {
+		sink := routed.Recipient
+		if sink == "" {
+			sink = "burn"
+			err = frd.bankKeeper.BurnCoins(ctx, types.NewModuleAddress(types.FeeCollectorName), routed.Amount)
+		} else {
+			err = frd.bankKeeper.SendCoinsFromModuleToModule(ctx, types.FeeCollectorName, routed.Recipient, routed.Amount)
+		}
+		if err != nil {
+			return ctx, errorsmod.Wrapf(err, "failed to route fees %s to %s", routed.Amount, sink)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRouteFee,
+				sdk.NewAttribute(types.AttributeKeySink, sink),
+				sdk.NewAttribute(sdk.AttributeKeyFee, routed.Amount.String()),
+			),
+		)
+
+		if ctx.ExecMode() == sdk.ExecModeFinalize {
+			for _, coin := range routed.Amount {
+				if coin.Amount.IsInt64() {
+					telemetry.IncrCounterWithLabels(
+						[]string{types.ModuleName, "routed_fees"},
+						float32(coin.Amount.Int64()),
+						[]metrics.Label{telemetry.NewLabel("sink", sink), telemetry.NewLabel("denom", coin.Denom)},
+					)
+				}
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+type collectedFeeKey struct{}
+
+// contextWithCollectedFee returns the context with the fees of the transaction
+// left in the fee collector.
+func contextWithCollectedFee(ctx sdk.Context, fee sdk.Coins) sdk.Context {
+	return ctx.WithValue(collectedFeeKey{}, fee)
+}
+
+// collectedFee returns the fees of the transaction left in the fee collector.
+func collectedFee(ctx context.Context) sdk.Coins {
+	fee, _ := ctx.Value(collectedFeeKey{}).(sdk.Coins)
+	return fee
+}
@@ -0,0 +1,100 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/auth/ante"
+	authtypes "cosmossdk.io/x/auth/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestFeeRoutingDecorator(t *testing.T) {
+	s := SetupTestSuite(t, false)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	antehandler := sdk.ChainAnteDecorators(
+		ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, nil, nil),
+		ante.NewFeeMarketDecorator(s.accountKeeper, s.bankKeeper),
+		ante.NewFeeRoutingDecorator(s.accountKeeper, s.bankKeeper),
+	)
+
+	accs := s.CreateTestAccounts(1)
+	addr := accs[0].acc.GetAddress()
+	require.NoError(t, s.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	s.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 1001), sdk.NewInt64Coin("uosmo", 100))
+	s.txBuilder.SetFeeAmount(fee)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	ctx := s.ctx.WithBlockHeight(1).WithMinGasPrices(sdk.NewDecCoins())
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	// the fees stay in the fee collector without routes
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), addr, authtypes.FeeCollectorName, fee).Return(nil).Times(3)
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+
+	// 50% stays in the fee collector, 30% is burned and 20% goes to the mint
+	// module account, the uosmo fees being kept in the fee collector
+	require.NoError(t, s.accountKeeper.FeeRoutingParams.Set(s.ctx, authtypes.FeeRoutingParams{
+		Routes: []authtypes.FeeRoute{
+			{Recipient: authtypes.FeeCollectorName, Share: math.LegacyNewDecWithPrec(5, 1)},
+			{Recipient: "", Share: math.LegacyNewDecWithPrec(3, 1)},
+			{Recipient: "mint", Share: math.LegacyNewDecWithPrec(2, 1)},
+		},
+		DenomRoutes: []authtypes.DenomFeeRoutes{{Denom: "uosmo"}},
+	}))
+
+	// genesis transactions are not routed
+	_, err = antehandler(ctx.WithBlockHeight(0), tx, false)
+	require.NoError(t, err)
+
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), feeCollector, sdk.NewCoins(sdk.NewInt64Coin("atom", 300))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), authtypes.FeeCollectorName, "mint", sdk.NewCoins(sdk.NewInt64Coin("atom", 200))).Return(nil)
+	ctx, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+
+	var sinks []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == authtypes.EventTypeRouteFee {
+			sink, ok := event.GetAttribute(authtypes.AttributeKeySink)
+			require.True(t, ok)
+			sinks = append(sinks, sink.Value)
+		}
+	}
+	require.Equal(t, []string{"burn", "mint"}, sinks)
+
+	// only the priority tip left after the base fee is burned is routed
+	require.NoError(t, s.accountKeeper.FeeMarketParams.Set(s.ctx, authtypes.FeeMarketParams{
+		Enabled:                  true,
+		Denom:                    "atom",
+		MinBaseFee:               math.LegacyNewDecWithPrec(5, 6),
+		TargetBlockGas:           testdata.NewTestGasLimit(),
+		BaseFeeChangeDenominator: 8,
+	}))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), addr, authtypes.FeeCollectorName, fee).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), feeCollector, sdk.NewCoins(sdk.NewInt64Coin("atom", 1))).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), feeCollector, sdk.NewCoins(sdk.NewInt64Coin("atom", 300))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), authtypes.FeeCollectorName, "mint", sdk.NewCoins(sdk.NewInt64Coin("atom", 200))).Return(nil)
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.NoError(t, s.accountKeeper.FeeMarketParams.Set(s.ctx, authtypes.FeeMarketParams{}))
+
+	// the transaction is rejected if the fees cannot be routed
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), addr, authtypes.FeeCollectorName, fee).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), feeCollector, gomock.Any()).Return(sdkerrors.ErrInsufficientFunds)
+	_, err = antehandler(s.ctx.WithBlockHeight(1).WithMinGasPrices(sdk.NewDecCoins()), tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+}
@@ -0,0 +1,75 @@
+package ante
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GasPricingKeeper defines the contract needed to apply the tiered gas pricing
+// policy and to track the activity of the fee payers.
+type GasPricingKeeper interface {
+	GetGasPricingPolicy(ctx context.Context) (types.GasPricingPolicy, error)
+	GetFeeMultiplier(ctx context.Context, policy types.GasPricingPolicy, addr sdk.AccAddress) (math.LegacyDec, error)
+	RecordAccountActivity(ctx context.Context, addr sdk.AccAddress, window uint64) error
+}
+
+// TieredGasPricingDecorator applies the tiered gas pricing policy: it rejects the
+// transactions whose fees are lower than the base gas prices of the policy times
+// the fee multiplier of the tier the fee payer qualifies for, and records the
+// transaction in the activity index of the fee payer.
+// The activity is recorded even when the policy is disabled, so that it can be
+// enabled without resetting the age of every account. The decorator does nothing
+// if no GasPricingKeeper is provided.
+// CONTRACT: Tx must implement FeeTx interface to use TieredGasPricingDecorator
+type TieredGasPricingDecorator struct {
+	gpk GasPricingKeeper
+}
+
+func NewTieredGasPricingDecorator(gpk GasPricingKeeper) TieredGasPricingDecorator {
+	return TieredGasPricingDecorator{
+		gpk: gpk,
+	}
+}
+
+func (tgd TieredGasPricingDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// skip the policy for genesis transactions
+	if tgd.gpk == nil || ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	policy, err := tgd.gpk.GetGasPricingPolicy(ctx)
+	if err != nil {
+		return ctx, err
+	}
+
+	feePayer := sdk.AccAddress(feeTx.FeePayer())
+	if policy.Enabled && ctx.ExecMode() != sdk.ExecModeSimulate {
+		multiplier, err := tgd.gpk.GetFeeMultiplier(ctx, policy, feePayer)
+		if err != nil {
+			return ctx, err
+		}
+
+		fee := ConvertedFee(ctx, feeTx)
+		requiredFees := policy.RequiredFees(feeTx.GetGas(), multiplier)
+		if !fee.IsAnyGTE(requiredFees) {
+			return ctx, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees for the fee payer gas pricing tier (multiplier %s); got: %s required: %s", multiplier, fee, requiredFees)
+		}
+	}
+
+	if err := tgd.gpk.RecordAccountActivity(ctx, feePayer, policy.ActivityWindow); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,89 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/auth/ante"
+	authtypes "cosmossdk.io/x/auth/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestTieredGasPricingDecorator(t *testing.T) {
+	s := SetupTestSuite(t, false)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewTieredGasPricingDecorator(s.accountKeeper))
+
+	accs := s.CreateTestAccounts(1)
+	feePayer := accs[0].acc.GetAddress()
+	require.NoError(t, s.txBuilder.SetMsgs(testdata.NewTestMsg(feePayer)))
+	s.txBuilder.SetGasLimit(100)
+
+	newTx := func(fee int64) sdk.Tx {
+		s.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", fee)))
+		privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+		tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+		return tx
+	}
+	atHeight := func(height int64) sdk.Context {
+		return s.ctx.WithBlockHeight(height).WithHeaderInfo(header.Info{Height: height})
+	}
+
+	// the activity is tracked while the policy is disabled
+	_, err := antehandler(atHeight(1), newTx(0), false)
+	require.NoError(t, err)
+	activity, err := s.accountKeeper.GetAccountActivity(s.ctx, feePayer)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), activity.FirstSeenHeight)
+	require.Equal(t, uint64(1), activity.WindowTxCount)
+
+	policy := authtypes.GasPricingPolicy{
+		Enabled:        true,
+		MinGasPrices:   sdk.NewDecCoins(sdk.NewInt64DecCoin("atom", 1)),
+		ActivityWindow: 10,
+		Tiers: []authtypes.GasPricingTier{
+			{MinAccountAge: 100, MinRecentTxs: 2, FeeMultiplier: math.LegacyNewDecWithPrec(5, 1)},
+			{MinAccountAge: 10, FeeMultiplier: math.LegacyOneDec()},
+			{FeeMultiplier: math.LegacyNewDec(2)},
+		},
+	}
+	require.NoError(t, s.accountKeeper.GasPricingPolicy.Set(s.ctx, policy))
+
+	// a fresh account pays a surcharge
+	_, err = antehandler(atHeight(5), newTx(150), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+	_, err = antehandler(atHeight(5), newTx(200), false)
+	require.NoError(t, err)
+
+	// the fees are not checked in simulation mode
+	_, err = antehandler(atHeight(5).WithExecMode(sdk.ExecModeSimulate), newTx(0), true)
+	require.NoError(t, err)
+
+	// an older account pays the base gas prices
+	_, err = antehandler(atHeight(115), newTx(99), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+	_, err = antehandler(atHeight(115), newTx(100), false)
+	require.NoError(t, err)
+	_, err = antehandler(atHeight(116), newTx(100), false)
+	require.NoError(t, err)
+
+	// an old and active account gets a discount
+	_, err = antehandler(atHeight(117), newTx(50), false)
+	require.NoError(t, err)
+
+	activity, err = s.accountKeeper.GetAccountActivity(s.ctx, feePayer)
+	require.NoError(t, err)
+	require.Equal(t, int64(115), activity.WindowStartHeight)
+	require.Equal(t, uint64(3), activity.WindowTxCount)
+	require.Equal(t, uint64(0), activity.PreviousWindowTxCount)
+}
@@ -0,0 +1,81 @@
+package ante
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgSurchargeKeeper defines the contract needed to apply the surcharges set by
+// governance on the messages of given types.
+type MsgSurchargeKeeper interface {
+	GetMsgSurchargeParams(ctx context.Context) (types.MsgSurchargeParams, error)
+}
+
+// MsgSurchargeDecorator applies the surcharges set by governance on the messages
+// of given types: it consumes the extra gas of every surcharged message of the
+// transaction and sends their flat fees from the fee payer to the fee collector,
+// on top of the transaction fees. Only the top-level messages are surcharged.
+// The decorator does nothing if no MsgSurchargeKeeper is provided.
+// CONTRACT: Tx must implement FeeTx interface to use MsgSurchargeDecorator
+type MsgSurchargeDecorator struct {
+	msk        MsgSurchargeKeeper
+	bankKeeper types.BankKeeper
+}
+
+func NewMsgSurchargeDecorator(msk MsgSurchargeKeeper, bk types.BankKeeper) MsgSurchargeDecorator {
+	return MsgSurchargeDecorator{
+		msk:        msk,
+		bankKeeper: bk,
+	}
+}
+
+func (msd MsgSurchargeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// skip the surcharges for genesis transactions
+	if msd.msk == nil || ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	params, err := msd.msk.GetMsgSurchargeParams(ctx)
+	if err != nil {
+		return ctx, err
+	}
+
+	gas, fee, err := params.TxSurcharge(tx.GetMsgs())
+	if err != nil {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if gas == 0 && fee.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	ctx.GasMeter().ConsumeGas(gas, "message surcharge")
+
+	feePayer := sdk.AccAddress(feeTx.FeePayer())
+	if !fee.IsZero() {
+		if err := msd.bankKeeper.SendCoinsFromAccountToModule(ctx, feePayer, types.FeeCollectorName, fee); err != nil {
+			return ctx, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "insufficient funds for the message surcharge %s: %s", fee, err)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMsgSurcharge,
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, feePayer.String()),
+			sdk.NewAttribute(types.AttributeKeySurchargeGas, strconv.FormatUint(gas, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+		),
+	)
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,77 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/auth/ante"
+	authtypes "cosmossdk.io/x/auth/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestMsgSurchargeDecorator(t *testing.T) {
+	s := SetupTestSuite(t, false)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewMsgSurchargeDecorator(s.accountKeeper, s.bankKeeper))
+
+	accs := s.CreateTestAccounts(1)
+	addr := accs[0].acc.GetAddress()
+	require.NoError(t, s.txBuilder.SetMsgs(testdata.NewTestMsg(addr), testdata.NewTestMsg(addr)))
+	s.txBuilder.SetGasLimit(100_000)
+	s.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 100)))
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	// reading the surcharges from the store is free, only the surcharges are metered
+	newCtx := func(height int64) sdk.Context {
+		return s.ctx.WithBlockHeight(height).WithGasMeter(storetypes.NewGasMeter(100_000)).WithKVGasConfig(storetypes.GasConfig{})
+	}
+
+	// nothing is charged without surcharges
+	ctx := newCtx(1)
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.Zero(t, ctx.GasMeter().GasConsumed())
+
+	require.NoError(t, s.accountKeeper.SetMsgSurchargeParams(s.ctx, authtypes.MsgSurchargeParams{
+		Surcharges: []authtypes.MsgSurcharge{
+			{MsgTypeUrl: sdk.MsgTypeURL(&testdata.TestMsg{}), Gas: 5000, Fee: sdk.NewCoins(sdk.NewInt64Coin("atom", 30))},
+		},
+	}))
+
+	// genesis transactions are not surcharged
+	ctx = newCtx(0)
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.Zero(t, ctx.GasMeter().GasConsumed())
+
+	// every surcharged message adds its gas and flat fee
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), addr, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin("atom", 60))).Return(nil)
+	ctx = newCtx(1)
+	ctx, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, storetypes.Gas(10_000), ctx.GasMeter().GasConsumed())
+	events := ctx.EventManager().Events()
+	require.Equal(t, authtypes.EventTypeMsgSurcharge, events[len(events)-1].Type)
+
+	// the transaction is rejected if the fee payer cannot pay the surcharge
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), addr, authtypes.FeeCollectorName, gomock.Any()).Return(sdkerrors.ErrInsufficientFunds)
+	_, err = antehandler(newCtx(1), tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	// the surcharged gas counts against the gas limit
+	require.Panics(t, func() {
+		_, _ = antehandler(newCtx(1).WithGasMeter(storetypes.NewGasMeter(1000)), tx, false)
+	})
+}
@@ -54,6 +54,19 @@ type AccountAbstractionKeeper interface {
 	AuthenticateAccount(ctx context.Context, addr []byte, msg *aa_interface_v1.MsgAuthenticate) error
 }
 
+// SmartAccountKeeper defines the contract needed to verify the signatures of the
+// smart accounts with their authenticators.
+type SmartAccountKeeper interface {
+	GetAuthenticators(ctx context.Context, addr sdk.AccAddress) ([]types.Authenticator, error)
+	GetAuthenticatorVerifier(name string) (types.AuthenticatorVerifier, bool)
+}
+
+// SpendingBankKeeper defines the contract needed to track the coins spent by the
+// smart accounts under the spending policies of their authenticators.
+type SpendingBankKeeper interface {
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+}
+
 // SigVerificationDecorator verifies all signatures for a tx and returns an
 // error if any are invalid.
 // It will populate an account's public key if that is not present only if
@@ -68,10 +81,12 @@ type AccountAbstractionKeeper interface {
 //
 // CONTRACT: Tx must implement SigVerifiableTx interface
 type SigVerificationDecorator struct {
-	ak              AccountKeeper
-	aaKeeper        AccountAbstractionKeeper
-	signModeHandler *txsigning.HandlerMap
-	sigGasConsumer  SignatureVerificationGasConsumer
+	ak                 AccountKeeper
+	aaKeeper           AccountAbstractionKeeper
+	smartAccountKeeper SmartAccountKeeper
+	spendingBankKeeper SpendingBankKeeper
+	signModeHandler    *txsigning.HandlerMap
+	sigGasConsumer     SignatureVerificationGasConsumer
 }
 
 func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler *txsigning.HandlerMap, sigGasConsumer SignatureVerificationGasConsumer, aaKeeper AccountAbstractionKeeper) SigVerificationDecorator {
@@ -83,6 +98,17 @@ func NewSigVerificationDecorator(ak AccountKeeper, signModeHandler *txsigning.Ha
 	}
 }
 
+// WithSmartAccounts returns the decorator verifying the signatures of the smart
+// accounts with their authenticators instead of their public keys. The balances
+// of the smart accounts authenticated by an authenticator with a spending policy
+// are recorded from the bank keeper, for the SpendingPolicyDecorator posthandler
+// to enforce it.
+func (svd SigVerificationDecorator) WithSmartAccounts(sak SmartAccountKeeper, bk SpendingBankKeeper) SigVerificationDecorator {
+	svd.smartAccountKeeper = sak
+	svd.spendingBankKeeper = bk
+	return svd
+}
+
 // OnlyLegacyAminoSigners checks SignatureData to see if all
 // signers are using SIGN_MODE_LEGACY_AMINO_JSON. If this is the case
 // then the corresponding SignatureV2 struct will not have account sequence
@@ -186,7 +212,7 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 	}
 
 	for i := range signers {
-		err = svd.authenticate(ctx, sigTx, signers[i], signatures[i], pubKeys[i], i)
+		ctx, err = svd.authenticate(ctx, sigTx, signers[i], signatures[i], pubKeys[i], i)
 		if err != nil {
 			return ctx, err
 		}
@@ -219,15 +245,26 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 }
 
 // authenticate the authentication of the TX for a specific tx signer.
-func (svd SigVerificationDecorator) authenticate(ctx sdk.Context, tx authsigning.Tx, signer []byte, sig signing.SignatureV2, txPubKey cryptotypes.PubKey, signerIndex int) error {
+func (svd SigVerificationDecorator) authenticate(ctx sdk.Context, tx authsigning.Tx, signer []byte, sig signing.SignatureV2, txPubKey cryptotypes.PubKey, signerIndex int) (sdk.Context, error) {
 	// first we check if it's an AA
 	if svd.aaKeeper != nil {
 		isAa, err := svd.aaKeeper.IsAbstractedAccount(ctx, signer)
 		if err != nil {
-			return err
+			return ctx, err
 		}
 		if isAa {
-			return svd.authenticateAbstractedAccount(ctx, tx, signer, signerIndex)
+			return ctx, svd.authenticateAbstractedAccount(ctx, tx, signer, signerIndex)
+		}
+	}
+
+	// then if it's a smart account
+	if svd.smartAccountKeeper != nil {
+		authenticators, err := svd.smartAccountKeeper.GetAuthenticators(ctx, signer)
+		if err != nil {
+			return ctx, err
+		}
+		if len(authenticators) > 0 && !svd.signsWithPrimaryKey(ctx, signer, txPubKey) {
+			return svd.authenticateSmartAccount(ctx, tx, signer, sig, txPubKey, authenticators)
 		}
 	}
 
@@ -251,27 +288,27 @@ func (svd SigVerificationDecorator) authenticate(ctx sdk.Context, tx authsigning
 	if acc.GetPubKey() == nil {
 		err := svd.setPubKey(ctx, acc, txPubKey)
 		if err != nil {
-			return err
+			return ctx, err
 		}
 	}
 
 	err := svd.consumeSignatureGas(ctx, acc.GetPubKey(), sig)
 	if err != nil {
-		return err
+		return ctx, err
 	}
 
 	err = svd.verifySig(ctx, tx, acc, sig, newlyCreated)
 	if err != nil {
-		return err
+		return ctx, err
 	}
 
 	err = svd.increaseSequence(tx, acc)
 	if err != nil {
-		return err
+		return ctx, err
 	}
 	// update account changes in state.
 	svd.ak.SetAccount(ctx, acc)
-	return nil
+	return ctx, nil
 }
 
 // consumeSignatureGas will consume gas according to the pub-key being verified.
@@ -0,0 +1,179 @@
+package ante
+
+import (
+	"google.golang.org/protobuf/types/known/anypb"
+
+	errorsmod "cosmossdk.io/errors"
+	authsigning "cosmossdk.io/x/auth/signing"
+	"cosmossdk.io/x/auth/types"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// spendingPolicyRestrictedMsgs are the type URLs of the messages which cannot be
+// sent in a tx signed with an authenticator carrying a spending policy: the
+// management of the authenticators and of the primary key of the account, which
+// would lift the policy, and the authz and feegrant delegations, which would let
+// other accounts spend its coins outside of the policy.
+var spendingPolicyRestrictedMsgs = map[string]bool{
+	sdk.MsgTypeURL(&types.MsgAddAuthenticator{}):    true,
+	sdk.MsgTypeURL(&types.MsgRemoveAuthenticator{}): true,
+	sdk.MsgTypeURL(&types.MsgRotatePubKey{}):        true,
+	"/cosmos.authz.v1beta1.MsgGrant":                true,
+	"/cosmos.feegrant.v1beta1.MsgGrantAllowance":    true,
+}
+
+// signsWithPrimaryKey returns whether a smart account signs with its own public
+// key, set in the tx, which is verified like the key of any other account and
+// is not restricted by the spending policies of its authenticators.
+func (svd SigVerificationDecorator) signsWithPrimaryKey(ctx sdk.Context, signer []byte, txPubKey cryptotypes.PubKey) bool {
+	if txPubKey == nil {
+		return false
+	}
+
+	acc := GetSignerAcc(ctx, svd.ak, signer)
+	return acc != nil && acc.GetPubKey() != nil && acc.GetPubKey().Equals(txPubKey)
+}
+
+// authenticateSmartAccount verifies the signature of a smart account with its
+// authenticators instead of its public key, and increases its sequence.
+func (svd SigVerificationDecorator) authenticateSmartAccount(ctx sdk.Context, tx authsigning.Tx, signer []byte, sig signing.SignatureV2, txPubKey cryptotypes.PubKey, authenticators []types.Authenticator) (sdk.Context, error) {
+	acc := GetSignerAcc(ctx, svd.ak, signer)
+	if acc == nil {
+		return ctx, sdkerrors.ErrUnknownAddress.Wrapf("smart account %s does not exist", sdk.AccAddress(signer))
+	}
+
+	if sig.Sequence != acc.GetSequence() {
+		return ctx, errorsmod.Wrapf(
+			sdkerrors.ErrWrongSequence,
+			"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
+		)
+	}
+
+	selected, err := svd.selectAuthenticator(ctx, tx, acc, sig, txPubKey, authenticators)
+	if err != nil {
+		return ctx, err
+	}
+
+	if selected.SpendingPolicy != nil {
+		if svd.spendingBankKeeper == nil {
+			return ctx, errorsmod.Wrap(sdkerrors.ErrLogic, "a bank keeper is required to enforce the spending policies of the smart accounts")
+		}
+
+		for _, msg := range tx.GetMsgs() {
+			if typeURL := sdk.MsgTypeURL(msg); spendingPolicyRestrictedMsgs[typeURL] {
+				return ctx, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s cannot be sent with authenticator %d of smart account %s, which has a spending policy", typeURL, selected.Id, acc.GetAddress())
+			}
+		}
+
+		ctx = types.ContextWithSelectedAuthenticator(ctx, types.SelectedAuthenticator{
+			Address: acc.GetAddress(),
+			ID:      selected.Id,
+			Balance: svd.spendingBankKeeper.GetAllBalances(ctx, acc.GetAddress()),
+		})
+	}
+
+	if err := svd.increaseSequence(tx, acc); err != nil {
+		return ctx, err
+	}
+	svd.ak.SetAccount(ctx, acc)
+	return ctx, nil
+}
+
+// selectAuthenticator returns the authenticator accepting the signature of a
+// smart account: the one holding the public key set for the signer in the tx, if
+// any, or else the first one whose verifier accepts the signature. When the
+// signatures are not verified, the first authenticator is selected if none
+// holds the public key.
+func (svd SigVerificationDecorator) selectAuthenticator(ctx sdk.Context, tx sdk.Tx, acc sdk.AccountI, sig signing.SignatureV2, txPubKey cryptotypes.PubKey, authenticators []types.Authenticator) (types.Authenticator, error) {
+	skipVerification := ctx.ExecMode() == sdk.ExecModeSimulate || ctx.IsReCheckTx() || !ctx.IsSigverifyTx()
+
+	for _, a := range authenticators {
+		if !a.HasPubKey(txPubKey) {
+			continue
+		}
+
+		if err := svd.consumeSignatureGas(ctx, txPubKey, sig); err != nil {
+			return a, err
+		}
+		if skipVerification {
+			return a, nil
+		}
+
+		signerData := svd.smartAccountSignerData(ctx, acc, txPubKey)
+		err := authsigning.VerifySignature(ctx, txPubKey, signerData, sig.Data, svd.signModeHandler, tx.(authsigning.V2AdaptableTx).GetSigningTxData())
+		if err != nil {
+			return a, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signature verification of smart account %s failed: %s", acc.GetAddress(), err)
+		}
+
+		return a, nil
+	}
+
+	if skipVerification {
+		if ctx.ExecMode() == sdk.ExecModeSimulate {
+			if err := svd.consumeSignatureGas(ctx, nil, sig); err != nil {
+				return authenticators[0], err
+			}
+		}
+
+		return authenticators[0], nil
+	}
+
+	data, ok := sig.Data.(*signing.SingleSignatureData)
+	if ok {
+		signerData := svd.smartAccountSignerData(ctx, acc, txPubKey)
+		signBytes, err := authsigning.GetSignBytes(ctx, signerData, data, svd.signModeHandler, tx.(authsigning.V2AdaptableTx).GetSigningTxData())
+		if err != nil {
+			return types.Authenticator{}, err
+		}
+
+		for _, a := range authenticators {
+			if a.Verifier == "" {
+				continue
+			}
+
+			verifier, found := svd.smartAccountKeeper.GetAuthenticatorVerifier(a.Verifier)
+			if !found {
+				continue
+			}
+
+			if err := verifier.VerifySignature(ctx, acc.GetAddress(), a.VerifierData, signBytes, data.Signature); err == nil {
+				return a, nil
+			}
+		}
+	}
+
+	return types.Authenticator{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signature of smart account %s not accepted by any of its authenticators", acc.GetAddress())
+}
+
+// smartAccountSignerData returns the signer data of a smart account, holding the
+// public key set for the signer in the tx.
+func (svd SigVerificationDecorator) smartAccountSignerData(ctx sdk.Context, acc sdk.AccountI, txPubKey cryptotypes.PubKey) txsigning.SignerData {
+	// if we are in genesis the account number is 0
+	var accNum uint64
+	if ctx.BlockHeight() != 0 {
+		accNum = acc.GetAccountNumber()
+	}
+
+	signerData := txsigning.SignerData{
+		Address:       acc.GetAddress().String(),
+		ChainID:       ctx.ChainID(),
+		AccountNumber: accNum,
+		Sequence:      acc.GetSequence(),
+	}
+	if txPubKey != nil {
+		if anyPk, err := codectypes.NewAnyWithValue(txPubKey); err == nil {
+			signerData.PubKey = &anypb.Any{
+				TypeUrl: anyPk.TypeUrl,
+				Value:   anyPk.Value,
+			}
+		}
+	}
+
+	return signerData
+}
@@ -0,0 +1,102 @@
+package ante_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/auth/ante"
+	"cosmossdk.io/x/auth/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestSigVerificationSmartAccount(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	priv, _, addr := testdata.KeyTestPubAddr()
+	sessionPriv, _, _ := testdata.KeyTestPubAddr()
+
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	require.NoError(t, acc.SetPubKey(priv.PubKey()))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	sessionKey, err := codectypes.NewAnyWithValue(sessionPriv.PubKey())
+	require.NoError(t, err)
+	_, err = suite.accountKeeper.AddAuthenticator(suite.ctx, addr, types.Authenticator{PubKeys: []*codectypes.Any{sessionKey}})
+	require.NoError(t, err)
+
+	limitedPriv, _, _ := testdata.KeyTestPubAddr()
+	limitedKey, err := codectypes.NewAnyWithValue(limitedPriv.PubKey())
+	require.NoError(t, err)
+	_, err = suite.accountKeeper.AddAuthenticator(suite.ctx, addr, types.Authenticator{
+		PubKeys:        []*codectypes.Any{limitedKey},
+		SpendingPolicy: &types.SpendingPolicy{Limit: sdk.NewCoins(sdk.NewInt64Coin("atom", 100))},
+	})
+	require.NoError(t, err)
+
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), ante.DefaultSigVerificationGasConsumer, nil).
+		WithSmartAccounts(suite.accountKeeper, spendingBankKeeper{})
+	antehandler := sdk.ChainAnteDecorators(svd)
+
+	unknownPriv, _, _ := testdata.KeyTestPubAddr()
+	addAuthenticator := &types.MsgAddAuthenticator{Sender: addr.String(), Authenticator: types.Authenticator{PubKeys: []*codectypes.Any{sessionKey}}}
+	removeAuthenticator := &types.MsgRemoveAuthenticator{Sender: addr.String(), Id: 0}
+	testCases := []struct {
+		name      string
+		priv      cryptotypes.PrivKey
+		seq       uint64
+		msgs      []sdk.Msg
+		expErrMsg string
+	}{
+		{"primary key", priv, 0, nil, ""},
+		{"authenticator key", sessionPriv, 1, nil, ""},
+		{"wrong sequence", sessionPriv, 1, nil, "account sequence mismatch"},
+		{"authenticator key again", sessionPriv, 2, nil, ""},
+		{"unknown key", unknownPriv, 3, nil, "not accepted by any of its authenticators"},
+		{"limited key", limitedPriv, 3, nil, ""},
+		{"limited key adding an authenticator", limitedPriv, 4, []sdk.Msg{addAuthenticator}, "cannot be sent with authenticator 1"},
+		{"limited key removing an authenticator", limitedPriv, 4, []sdk.Msg{removeAuthenticator}, "cannot be sent with authenticator 1"},
+		{"limited key rotating the primary key", limitedPriv, 4, []sdk.Msg{&types.MsgRotatePubKey{Sender: addr.String()}}, "cannot be sent with authenticator 1"},
+		{"unrestricted key removing an authenticator", sessionPriv, 4, []sdk.Msg{removeAuthenticator}, ""},
+		{"primary key adding an authenticator", priv, 5, []sdk.Msg{addAuthenticator}, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msgs := tc.msgs
+			if msgs == nil {
+				msgs = []sdk.Msg{testdata.NewTestMsg(addr)}
+			}
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			require.NoError(t, suite.txBuilder.SetMsgs(msgs...))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{tc.priv}, []uint64{acc.GetAccountNumber()}, []uint64{tc.seq}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+			require.NoError(t, err)
+
+			_, err = antehandler(suite.ctx, tx, false)
+			if tc.expErrMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErrMsg)
+			}
+		})
+	}
+
+	require.Equal(t, uint64(6), suite.accountKeeper.GetAccount(suite.ctx, addr).GetSequence())
+}
+
+// spendingBankKeeper is a SpendingBankKeeper of accounts without balances.
+type spendingBankKeeper struct{}
+
+func (spendingBankKeeper) GetAllBalances(context.Context, sdk.AccAddress) sdk.Coins {
+	return nil
+}
@@ -2,6 +2,7 @@ package ante
 
 import (
 	"crypto/sha256"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/auth/ante/unorderedtx"
@@ -18,22 +19,23 @@ var _ sdk.AnteDecorator = (*UnorderedTxDecorator)(nil)
 // nonce incremented, which allows fire-and-forget along with possible parallel
 // transaction processing, without having to deal with nonces.
 //
-// The transaction sender must ensure that unordered=true and a timeout_height
+// The transaction sender must ensure that unordered=true and a timeout_timestamp
 // is appropriately set. The AnteHandler will check that the transaction is not
 // a duplicate and will evict it from memory when the timeout is reached.
 //
 // The UnorderedTxDecorator should be placed as early as possible in the AnteHandler
 // chain to ensure that during DeliverTx, the transaction is added to the UnorderedTxManager.
 type UnorderedTxDecorator struct {
-	// maxUnOrderedTTL defines the maximum TTL a transaction can define.
-	maxUnOrderedTTL uint64
-	txManager       *unorderedtx.Manager
+	// maxTimeoutDuration defines the maximum duration, from the block time, to
+	// the timeout timestamp of a transaction.
+	maxTimeoutDuration time.Duration
+	txManager          *unorderedtx.Manager
 }
 
-func NewUnorderedTxDecorator(maxTTL uint64, m *unorderedtx.Manager) *UnorderedTxDecorator {
+func NewUnorderedTxDecorator(maxTimeoutDuration time.Duration, m *unorderedtx.Manager) *UnorderedTxDecorator {
 	return &UnorderedTxDecorator{
-		maxUnOrderedTTL: maxTTL,
-		txManager:       m,
+		maxTimeoutDuration: maxTimeoutDuration,
+		txManager:          m,
 	}
 }
 
@@ -45,29 +47,30 @@ func (d *UnorderedTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 		return next(ctx, tx, simulate)
 	}
 
-	// TTL is defined as a specific block height at which this tx is no longer valid
-	ttl := unorderedTx.GetTimeoutHeight()
+	// the timeout timestamp is the time after which this tx is no longer valid
+	timeout := unorderedTx.GetTimeoutTimeStamp()
+	blockTime := ctx.HeaderInfo().Time
 
-	if ttl == 0 {
-		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unordered transaction must have timeout_height set")
+	if timeout.IsZero() {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unordered transaction must have timeout_timestamp set")
 	}
-	if ttl < uint64(ctx.BlockHeight()) {
-		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unordered transaction has a timeout_height that has already passed")
+	if timeout.Before(blockTime) {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unordered transaction has a timeout_timestamp that has already passed")
 	}
-	if ttl > uint64(ctx.BlockHeight())+d.maxUnOrderedTTL {
-		return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unordered tx ttl exceeds %d", d.maxUnOrderedTTL)
+	if timeout.After(blockTime.Add(d.maxTimeoutDuration)) {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unordered tx timeout_timestamp exceeds the max timeout duration %s", d.maxTimeoutDuration)
 	}
 
 	txHash := sha256.Sum256(ctx.TxBytes())
 
 	// check for duplicates
 	if d.txManager.Contains(txHash) {
-		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "tx %X is duplicated")
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "tx %X is duplicated", txHash)
 	}
 
 	if ctx.ExecMode() == sdk.ExecModeFinalize {
 		// a new tx included in the block, add the hash to the unordered tx manager
-		d.txManager.Add(txHash, ttl)
+		d.txManager.Add(txHash, timeout)
 	}
 
 	return next(ctx, tx, simulate)
@@ -3,9 +3,11 @@ package ante_test
 import (
 	"crypto/sha256"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/x/auth/ante"
 	"cosmossdk.io/x/auth/ante/unorderedtx"
 
@@ -23,16 +25,16 @@ func TestUnorderedTxDecorator_OrderedTx(t *testing.T) {
 
 	txm.Start()
 
-	chain := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(unorderedtx.DefaultMaxUnOrderedTTL, txm))
+	chain := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(unorderedtx.DefaultMaxTimeoutDuration, txm))
 
-	tx, txBz := genUnorderedTx(t, false, 0)
-	ctx := sdk.Context{}.WithTxBytes(txBz).WithBlockHeight(100)
+	tx, txBz := genUnorderedTx(t, false, time.Time{})
+	ctx := sdk.Context{}.WithTxBytes(txBz).WithHeaderInfo(header.Info{Time: time.Unix(100, 0)})
 
 	_, err := chain(ctx, tx, false)
 	require.NoError(t, err)
 }
 
-func TestUnorderedTxDecorator_UnorderedTx_NoTTL(t *testing.T) {
+func TestUnorderedTxDecorator_UnorderedTx_NoTimeout(t *testing.T) {
 	txm := unorderedtx.NewManager(t.TempDir())
 	defer func() {
 		require.NoError(t, txm.Close())
@@ -40,16 +42,16 @@ func TestUnorderedTxDecorator_UnorderedTx_NoTTL(t *testing.T) {
 
 	txm.Start()
 
-	chain := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(unorderedtx.DefaultMaxUnOrderedTTL, txm))
+	chain := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(unorderedtx.DefaultMaxTimeoutDuration, txm))
 
-	tx, txBz := genUnorderedTx(t, true, 0)
-	ctx := sdk.Context{}.WithTxBytes(txBz).WithBlockHeight(100)
+	tx, txBz := genUnorderedTx(t, true, time.Time{})
+	ctx := sdk.Context{}.WithTxBytes(txBz).WithHeaderInfo(header.Info{Time: time.Unix(100, 0)})
 
 	_, err := chain(ctx, tx, false)
 	require.Error(t, err)
 }
 
-func TestUnorderedTxDecorator_UnorderedTx_InvalidTTL(t *testing.T) {
+func TestUnorderedTxDecorator_UnorderedTx_InvalidTimeout(t *testing.T) {
 	txm := unorderedtx.NewManager(t.TempDir())
 	defer func() {
 		require.NoError(t, txm.Close())
@@ -57,10 +59,27 @@ func TestUnorderedTxDecorator_UnorderedTx_InvalidTTL(t *testing.T) {
 
 	txm.Start()
 
-	chain := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(unorderedtx.DefaultMaxUnOrderedTTL, txm))
+	chain := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(unorderedtx.DefaultMaxTimeoutDuration, txm))
 
-	tx, txBz := genUnorderedTx(t, true, 100+unorderedtx.DefaultMaxUnOrderedTTL+1)
-	ctx := sdk.Context{}.WithTxBytes(txBz).WithBlockHeight(100)
+	tx, txBz := genUnorderedTx(t, true, time.Unix(100, 1).Add(unorderedtx.DefaultMaxTimeoutDuration))
+	ctx := sdk.Context{}.WithTxBytes(txBz).WithHeaderInfo(header.Info{Time: time.Unix(100, 0)})
+
+	_, err := chain(ctx, tx, false)
+	require.Error(t, err)
+}
+
+func TestUnorderedTxDecorator_UnorderedTx_Expired(t *testing.T) {
+	txm := unorderedtx.NewManager(t.TempDir())
+	defer func() {
+		require.NoError(t, txm.Close())
+	}()
+
+	txm.Start()
+
+	chain := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(unorderedtx.DefaultMaxTimeoutDuration, txm))
+
+	tx, txBz := genUnorderedTx(t, true, time.Unix(99, 0))
+	ctx := sdk.Context{}.WithTxBytes(txBz).WithHeaderInfo(header.Info{Time: time.Unix(100, 0)})
 
 	_, err := chain(ctx, tx, false)
 	require.Error(t, err)
@@ -74,13 +93,13 @@ func TestUnorderedTxDecorator_UnorderedTx_AlreadyExists(t *testing.T) {
 
 	txm.Start()
 
-	chain := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(unorderedtx.DefaultMaxUnOrderedTTL, txm))
+	chain := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(unorderedtx.DefaultMaxTimeoutDuration, txm))
 
-	tx, txBz := genUnorderedTx(t, true, 150)
-	ctx := sdk.Context{}.WithTxBytes(txBz).WithBlockHeight(100)
+	tx, txBz := genUnorderedTx(t, true, time.Unix(150, 0))
+	ctx := sdk.Context{}.WithTxBytes(txBz).WithHeaderInfo(header.Info{Time: time.Unix(100, 0)})
 
 	txHash := sha256.Sum256(txBz)
-	txm.Add(txHash, 150)
+	txm.Add(txHash, time.Unix(150, 0))
 
 	_, err := chain(ctx, tx, false)
 	require.Error(t, err)
@@ -94,10 +113,10 @@ func TestUnorderedTxDecorator_UnorderedTx_ValidCheckTx(t *testing.T) {
 
 	txm.Start()
 
-	chain := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(unorderedtx.DefaultMaxUnOrderedTTL, txm))
+	chain := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(unorderedtx.DefaultMaxTimeoutDuration, txm))
 
-	tx, txBz := genUnorderedTx(t, true, 150)
-	ctx := sdk.Context{}.WithTxBytes(txBz).WithBlockHeight(100).WithExecMode(sdk.ExecModeCheck)
+	tx, txBz := genUnorderedTx(t, true, time.Unix(150, 0))
+	ctx := sdk.Context{}.WithTxBytes(txBz).WithHeaderInfo(header.Info{Time: time.Unix(100, 0)}).WithExecMode(sdk.ExecModeCheck)
 
 	_, err := chain(ctx, tx, false)
 	require.NoError(t, err)
@@ -111,10 +130,10 @@ func TestUnorderedTxDecorator_UnorderedTx_ValidDeliverTx(t *testing.T) {
 
 	txm.Start()
 
-	chain := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(unorderedtx.DefaultMaxUnOrderedTTL, txm))
+	chain := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(unorderedtx.DefaultMaxTimeoutDuration, txm))
 
-	tx, txBz := genUnorderedTx(t, true, 150)
-	ctx := sdk.Context{}.WithTxBytes(txBz).WithBlockHeight(100).WithExecMode(sdk.ExecModeFinalize)
+	tx, txBz := genUnorderedTx(t, true, time.Unix(150, 0))
+	ctx := sdk.Context{}.WithTxBytes(txBz).WithHeaderInfo(header.Info{Time: time.Unix(100, 0)}).WithExecMode(sdk.ExecModeFinalize)
 
 	_, err := chain(ctx, tx, false)
 	require.NoError(t, err)
@@ -123,7 +142,7 @@ func TestUnorderedTxDecorator_UnorderedTx_ValidDeliverTx(t *testing.T) {
 	require.True(t, txm.Contains(txHash))
 }
 
-func genUnorderedTx(t *testing.T, unordered bool, ttl uint64) (sdk.Tx, []byte) {
+func genUnorderedTx(t *testing.T, unordered bool, timeout time.Time) (sdk.Tx, []byte) {
 	t.Helper()
 
 	s := SetupTestSuite(t, true)
@@ -141,7 +160,7 @@ func genUnorderedTx(t *testing.T, unordered bool, ttl uint64) (sdk.Tx, []byte) {
 	s.txBuilder.SetFeeAmount(feeAmount)
 	s.txBuilder.SetGasLimit(gasLimit)
 	s.txBuilder.SetUnordered(unordered)
-	s.txBuilder.SetTimeoutHeight(ttl)
+	s.txBuilder.SetTimeoutTimestamp(timeout)
 
 	privKeys, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
 	tx, err := s.CreateTestTx(s.ctx, privKeys, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
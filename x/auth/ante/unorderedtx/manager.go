@@ -18,12 +18,17 @@ import (
 )
 
 const (
-	// DefaultMaxUnOrderedTTL defines the default maximum TTL an un-ordered transaction
-	// can set.
-	DefaultMaxUnOrderedTTL = 1024
+	// DefaultMaxTimeoutDuration defines the default maximum duration an un-ordered
+	// transaction can set, from the current block time to its timeout timestamp.
+	DefaultMaxTimeoutDuration = 10 * time.Minute
 
 	dirName  = "unordered_txs"
-	fileName = "data"
+	fileName = "data_v2"
+
+	// legacyFileName is the name of the file holding the unordered transactions
+	// along with their timeout heights, before they were tracked by timeout
+	// timestamp.
+	legacyFileName = "data"
 )
 
 // TxHash defines a transaction hash type alias, which is a fixed array of 32 bytes.
@@ -32,8 +37,8 @@ type TxHash [32]byte
 // Manager contains the tx hash dictionary for duplicates checking, and expire
 // them when block production progresses.
 type Manager struct {
-	// blockCh defines a channel to receive newly committed block heights
-	blockCh chan uint64
+	// blockCh defines a channel to receive the time of the new blocks
+	blockCh chan time.Time
 	// doneCh allows us to ensure the purgeLoop has gracefully terminated prior to closing
 	doneCh chan struct{}
 
@@ -48,10 +53,11 @@ type Manager struct {
 	dataDir string
 
 	mu sync.RWMutex
-	// txHashes defines a map from tx hash -> TTL value, which is used for duplicate
-	// checking and replay protection, as well as purging the map when the TTL is
-	// expired.
-	txHashes map[TxHash]uint64
+	// txHashes defines a map from tx hash -> timeout timestamp, which is used for
+	// duplicate checking and replay protection, as well as purging the map when
+	// the timeout timestamp is reached. A zero timeout timestamp is resolved on
+	// the next block, see addLegacy.
+	txHashes map[TxHash]time.Time
 }
 
 func NewManager(dataDir string) *Manager {
@@ -62,9 +68,9 @@ func NewManager(dataDir string) *Manager {
 
 	m := &Manager{
 		dataDir:  dataDir,
-		blockCh:  make(chan uint64, 16),
+		blockCh:  make(chan time.Time, 16),
 		doneCh:   make(chan struct{}),
-		txHashes: make(map[TxHash]uint64),
+		txHashes: make(map[TxHash]time.Time),
 	}
 
 	return m
@@ -79,7 +85,7 @@ func (m *Manager) Start() {
 // Note, Start() must be called in order for Close() to not hang.
 //
 // It will free all necessary resources as well as writing all unexpired unordered
-// transactions along with their TTL values to file.
+// transactions along with their timeout timestamps to file.
 func (m *Manager) Close() error {
 	close(m.blockCh)
 	<-m.doneCh
@@ -103,17 +109,69 @@ func (m *Manager) Size() int {
 	return len(m.txHashes)
 }
 
-func (m *Manager) Add(txHash TxHash, ttl uint64) {
+// Add tracks the hash of an unordered transaction until its timeout timestamp.
+func (m *Manager) Add(txHash TxHash, timeout time.Time) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	m.txHashes[txHash] = ttl
+	m.txHashes[txHash] = timeout
+}
+
+// addLegacy tracks the hash of an unordered transaction tracked by timeout
+// height, whose timeout timestamp is unknown. The block time at its timeout
+// height being unknown too, it is tracked for DefaultMaxTimeoutDuration from the
+// time of the next block, which is as long as a transaction sent then is.
+func (m *Manager) addLegacy(txHash TxHash) {
+	m.Add(txHash, time.Time{})
+}
+
+// SnapshotReplayState returns a function restoring the tracked unordered
+// transactions as they are when called, for the block replay determinism
+// checker to replay the blocks from the transactions tracked before them.
+func (m *Manager) SnapshotReplayState() func() {
+	m.mu.RLock()
+	txHashes := maps.Clone(m.txHashes)
+	m.mu.RUnlock()
+
+	return func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		m.txHashes = maps.Clone(txHashes)
+	}
 }
 
 // OnInit must be called when a node starts up. Typically, this should be called
 // in an application's constructor, which is called by the server.
+//
+// The transactions of a file written before they were tracked by timeout
+// timestamp are migrated: the current height being unknown, all of them are
+// tracked as if unexpired, see addLegacy, and the file is removed.
 func (m *Manager) OnInit() error {
-	f, err := os.Open(filepath.Join(m.dataDir, dirName, fileName))
+	if err := m.readFile(fileName, func(txHash TxHash, timeoutBz []byte) {
+		m.Add(txHash, timeoutFromBytes(timeoutBz))
+	}); err != nil {
+		return err
+	}
+
+	legacyPath := filepath.Join(m.dataDir, dirName, legacyFileName)
+	if err := m.readFile(legacyFileName, func(txHash TxHash, _ []byte) {
+		m.addLegacy(txHash)
+	}); err != nil {
+		return err
+	}
+
+	if err := os.Remove(legacyPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove legacy unconfirmed txs file: %w", err)
+	}
+
+	return nil
+}
+
+// readFile calls fn with each transaction of the given file in the data
+// directory, along with the bytes of its timeout.
+func (m *Manager) readFile(name string, fn func(txHash TxHash, timeoutBz []byte)) error {
+	f, err := os.Open(filepath.Join(m.dataDir, dirName, name))
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
 			// File does not exist, which we can assume that there are no unexpired
@@ -138,26 +196,30 @@ func (m *Manager) OnInit() error {
 				return fmt.Errorf("failed to read unconfirmed txs file: %w", err)
 			}
 		}
-		if n != 32+8 {
+		if n != chunkSize {
 			return fmt.Errorf("read unexpected number of bytes from unconfirmed txs file: %d", n)
 		}
 
 		var txHash TxHash
 		copy(txHash[:], buf[:txHashSize])
 
-		m.Add(txHash, binary.BigEndian.Uint64(buf[txHashSize:]))
+		fn(txHash, buf[txHashSize:])
 	}
 
 	return nil
 }
 
-// OnNewBlock sends the latest block number to the background purge loop, which
-// should be called in ABCI Commit event.
-func (m *Manager) OnNewBlock(blockHeight uint64) {
-	m.blockCh <- blockHeight
+// OnNewBlock sends the time of the new block to the background purge loop,
+// which removes the transactions whose timeout timestamp it is past. It should
+// be called in the PreBlocker of the application.
+func (m *Manager) OnNewBlock(blockTime time.Time) {
+	m.blockCh <- blockTime
 }
 
-func (m *Manager) exportSnapshot(height uint64, snapshotWriter func([]byte) error) error {
+// exportSnapshot writes the tracked transactions. The block time of the snapshot
+// height is unknown, so expired transactions not yet purged are included; they
+// are purged once the restored node processes a block.
+func (m *Manager) exportSnapshot(_ uint64, snapshotWriter func([]byte) error) error {
 	var buf bytes.Buffer
 	w := bufio.NewWriter(&buf)
 
@@ -165,13 +227,7 @@ func (m *Manager) exportSnapshot(height uint64, snapshotWriter func([]byte) erro
 	sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i][:], keys[j][:]) < 0 })
 
 	for _, txHash := range keys {
-		ttl := m.txHashes[txHash]
-		if height > ttl {
-			// skip expired txs that have yet to be purged
-			continue
-		}
-
-		chunk := unorderedTxToBytes(txHash, ttl)
+		chunk := unorderedTxToBytes(txHash, m.txHashes[txHash])
 
 		if _, err := w.Write(chunk); err != nil {
 			return fmt.Errorf("failed to write unordered tx to buffer: %w", err)
@@ -185,8 +241,8 @@ func (m *Manager) exportSnapshot(height uint64, snapshotWriter func([]byte) erro
 	return snapshotWriter(buf.Bytes())
 }
 
-// flushToFile writes all unexpired unordered transactions along with their TTL
-// to file, overwriting the existing file if it exists.
+// flushToFile writes all unexpired unordered transactions along with their
+// timeout timestamp to file, overwriting the existing file if it exists.
 func (m *Manager) flushToFile() error {
 	f, err := os.Create(filepath.Join(m.dataDir, dirName, fileName))
 	if err != nil {
@@ -195,8 +251,8 @@ func (m *Manager) flushToFile() error {
 	defer f.Close()
 
 	w := bufio.NewWriter(f)
-	for txHash, ttl := range m.txHashes {
-		chunk := unorderedTxToBytes(txHash, ttl)
+	for txHash, timeout := range m.txHashes {
+		chunk := unorderedTxToBytes(txHash, timeout)
 
 		if _, err = w.Write(chunk); err != nil {
 			return fmt.Errorf("failed to write unordered tx to buffer: %w", err)
@@ -210,14 +266,27 @@ func (m *Manager) flushToFile() error {
 	return nil
 }
 
-// expiredTxs returns expired tx hashes based on the provided block height.
-func (m *Manager) expiredTxs(blockHeight uint64) []TxHash {
+// resolveLegacyTimeouts sets the timeout timestamp of the transactions added by
+// addLegacy, from the provided block time.
+func (m *Manager) resolveLegacyTimeouts(blockTime time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for txHash, timeout := range m.txHashes {
+		if timeout.IsZero() {
+			m.txHashes[txHash] = blockTime.Add(DefaultMaxTimeoutDuration)
+		}
+	}
+}
+
+// expiredTxs returns expired tx hashes based on the provided block time.
+func (m *Manager) expiredTxs(blockTime time.Time) []TxHash {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
 	var result []TxHash
-	for txHash, ttl := range m.txHashes {
-		if blockHeight > ttl {
+	for txHash, timeout := range m.txHashes {
+		if !timeout.IsZero() && blockTime.After(timeout) {
 			result = append(result, txHash)
 		}
 	}
@@ -237,49 +306,65 @@ func (m *Manager) purge(txHashes []TxHash) {
 // purgeLoop removes expired tx hashes in the background
 func (m *Manager) purgeLoop() {
 	for {
-		latestHeight, ok := m.batchReceive()
+		latestTime, ok := m.batchReceive()
 		if !ok {
 			// channel closed
 			m.doneCh <- struct{}{}
 			return
 		}
 
-		hashes := m.expiredTxs(latestHeight)
+		if latestTime.IsZero() {
+			// no new block
+			continue
+		}
+
+		m.resolveLegacyTimeouts(latestTime)
+		hashes := m.expiredTxs(latestTime)
 		if len(hashes) > 0 {
 			m.purge(hashes)
 		}
 	}
 }
 
-func (m *Manager) batchReceive() (uint64, bool) {
+func (m *Manager) batchReceive() (time.Time, bool) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	var latestHeight uint64
+	var latestTime time.Time
 	for {
 		select {
 		case <-ctx.Done():
-			return latestHeight, true
+			return latestTime, true
 
-		case blockHeight, ok := <-m.blockCh:
+		case blockTime, ok := <-m.blockCh:
 			if !ok {
 				// channel is closed
-				return 0, false
+				return time.Time{}, false
 			}
-			if blockHeight > latestHeight {
-				latestHeight = blockHeight
+			if blockTime.After(latestTime) {
+				latestTime = blockTime
 			}
 		}
 	}
 }
 
-func unorderedTxToBytes(txHash TxHash, ttl uint64) []byte {
+func unorderedTxToBytes(txHash TxHash, timeout time.Time) []byte {
 	chunk := make([]byte, chunkSize)
 	copy(chunk[:txHashSize], txHash[:])
 
-	ttlBz := make([]byte, ttlSize)
-	binary.BigEndian.PutUint64(ttlBz, ttl)
-	copy(chunk[txHashSize:], ttlBz)
+	// a zero timeout timestamp, not resolved yet, is written as zero
+	if !timeout.IsZero() {
+		binary.BigEndian.PutUint64(chunk[txHashSize:], uint64(timeout.UnixNano()))
+	}
 
 	return chunk
 }
+
+func timeoutFromBytes(bz []byte) time.Time {
+	nanos := int64(binary.BigEndian.Uint64(bz))
+	if nanos == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(0, nanos).UTC()
+}
@@ -1,6 +1,9 @@
 package unorderedtx_test
 
 import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -25,9 +28,9 @@ func TestUnorderedTxManager_SimpleSize(t *testing.T) {
 
 	txm.Start()
 
-	txm.Add([32]byte{0xFF}, 100)
-	txm.Add([32]byte{0xAA}, 100)
-	txm.Add([32]byte{0xCC}, 100)
+	txm.Add([32]byte{0xFF}, time.Unix(100, 0))
+	txm.Add([32]byte{0xAA}, time.Unix(100, 0))
+	txm.Add([32]byte{0xCC}, time.Unix(100, 0))
 
 	require.Equal(t, 3, txm.Size())
 }
@@ -42,7 +45,7 @@ func TestUnorderedTxManager_SimpleContains(t *testing.T) {
 
 	for i := 0; i < 10; i++ {
 		txHash := [32]byte{byte(i)}
-		txm.Add(txHash, 100)
+		txm.Add(txHash, time.Unix(100, 0))
 		require.True(t, txm.Contains(txHash))
 	}
 
@@ -52,6 +55,25 @@ func TestUnorderedTxManager_SimpleContains(t *testing.T) {
 	}
 }
 
+func TestUnorderedTxManager_SnapshotReplayState(t *testing.T) {
+	txm := unorderedtx.NewManager(t.TempDir())
+	defer func() {
+		require.NoError(t, txm.Close())
+	}()
+
+	txm.Start()
+
+	txm.Add([32]byte{0xFF}, time.Unix(100, 0))
+	restore := txm.SnapshotReplayState()
+	txm.Add([32]byte{0xAA}, time.Unix(100, 0))
+	require.Equal(t, 2, txm.Size())
+
+	restore()
+	require.Equal(t, 1, txm.Size())
+	require.True(t, txm.Contains([32]byte{0xFF}))
+	require.False(t, txm.Contains([32]byte{0xAA}))
+}
+
 func TestUnorderedTxManager_InitEmpty(t *testing.T) {
 	txm := unorderedtx.NewManager(t.TempDir())
 	defer func() {
@@ -70,7 +92,7 @@ func TestUnorderedTxManager_CloseInit(t *testing.T) {
 
 	// add a handful of unordered txs
 	for i := 0; i < 100; i++ {
-		txm.Add([32]byte{byte(i)}, 100)
+		txm.Add([32]byte{byte(i)}, time.Unix(100, 0))
 	}
 
 	// close the manager, which should flush all unexpired txs to file
@@ -92,6 +114,45 @@ func TestUnorderedTxManager_CloseInit(t *testing.T) {
 	}
 }
 
+func TestUnorderedTxManager_InitLegacyFile(t *testing.T) {
+	dataDir := t.TempDir()
+	txm := unorderedtx.NewManager(dataDir)
+
+	// write a file of unordered txs along with their timeout heights
+	var legacy []byte
+	for i := 0; i < 10; i++ {
+		chunk := make([]byte, 40)
+		chunk[0] = byte(i)
+		binary.BigEndian.PutUint64(chunk[32:], 100)
+		legacy = append(legacy, chunk...)
+	}
+	legacyPath := filepath.Join(dataDir, "unordered_txs", "data")
+	require.NoError(t, os.WriteFile(legacyPath, legacy, 0o600))
+
+	// the txs are migrated and the legacy file removed
+	txm.Start()
+	require.NoError(t, txm.OnInit())
+	require.Equal(t, 10, txm.Size())
+	_, err := os.Stat(legacyPath)
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	// the migrated txs are kept on restart
+	require.NoError(t, txm.Close())
+
+	txm2 := unorderedtx.NewManager(dataDir)
+	defer func() {
+		require.NoError(t, txm2.Close())
+	}()
+
+	txm2.Start()
+	require.NoError(t, txm2.OnInit())
+	require.Equal(t, 10, txm2.Size())
+
+	for i := 0; i < 10; i++ {
+		require.True(t, txm2.Contains([32]byte{byte(i)}))
+	}
+}
+
 func TestUnorderedTxManager_Flow(t *testing.T) {
 	txm := unorderedtx.NewManager(t.TempDir())
 	defer func() {
@@ -101,14 +162,15 @@ func TestUnorderedTxManager_Flow(t *testing.T) {
 	txm.Start()
 
 	// Seed the manager with a txs, some of which should eventually be purged and
-	// the others will remain. Txs with TTL less than or equal to 50 should be purged.
+	// the others will remain. Txs with a timeout before the block time of 51 should
+	// be purged.
 	for i := 1; i <= 100; i++ {
 		txHash := [32]byte{byte(i)}
 
 		if i <= 50 {
-			txm.Add(txHash, uint64(i))
+			txm.Add(txHash, time.Unix(int64(i), 0))
 		} else {
-			txm.Add(txHash, 100)
+			txm.Add(txHash, time.Unix(100, 0))
 		}
 	}
 
@@ -119,23 +181,24 @@ func TestUnorderedTxManager_Flow(t *testing.T) {
 		defer ticker.Stop()
 
 		var (
-			height uint64 = 1
-			i             = 101
+			blockTime int64 = 1
+			i               = 101
 		)
 		for range ticker.C {
-			txm.OnNewBlock(height)
-			height++
+			txm.OnNewBlock(time.Unix(blockTime, 0))
+			blockTime++
 
-			if height > 51 {
+			if blockTime > 51 {
 				doneBlockCh <- true
 				return
 			} else {
-				txm.Add([32]byte{byte(i)}, 50)
+				txm.Add([32]byte{byte(i)}, time.Unix(50, 0))
 			}
 		}
 	}()
 
-	// Eventually all the txs that should be expired by block 50 should be purged.
+	// Eventually all the txs that should be expired by the block time of 51 should
+	// be purged.
 	// The remaining txs should remain.
 	require.Eventually(
 		t,
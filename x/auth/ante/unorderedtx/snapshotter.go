@@ -9,17 +9,22 @@ import (
 )
 
 const (
-	txHashSize = 32
-	ttlSize    = 8
-	chunkSize  = txHashSize + ttlSize
+	txHashSize  = 32
+	timeoutSize = 8
+	chunkSize   = txHashSize + timeoutSize
 )
 
 var _ snapshot.ExtensionSnapshotter = &Snapshotter{}
 
 const (
 	// SnapshotFormat defines the snapshot format of exported unordered transactions.
-	// No protobuf envelope, no metadata.
-	SnapshotFormat = 1
+	// No protobuf envelope, no metadata. Format 2 holds the timeout timestamps of
+	// the transactions, in unix nanoseconds, instead of their timeout heights.
+	SnapshotFormat = 2
+
+	// LegacySnapshotFormat defines the snapshot format holding the timeout
+	// heights of the transactions, which can still be restored.
+	LegacySnapshotFormat = 1
 
 	// SnapshotName defines the snapshot name of exported unordered transactions.
 	SnapshotName = "unordered_txs"
@@ -42,7 +47,7 @@ func (s *Snapshotter) SnapshotFormat() uint32 {
 }
 
 func (s *Snapshotter) SupportedFormats() []uint32 {
-	return []uint32{SnapshotFormat}
+	return []uint32{SnapshotFormat, LegacySnapshotFormat}
 }
 
 func (s *Snapshotter) SnapshotExtension(height uint64, payloadWriter snapshot.ExtensionPayloadWriter) error {
@@ -51,14 +56,28 @@ func (s *Snapshotter) SnapshotExtension(height uint64, payloadWriter snapshot.Ex
 }
 
 func (s *Snapshotter) RestoreExtension(height uint64, format uint32, payloadReader snapshot.ExtensionPayloadReader) error {
-	if format == SnapshotFormat {
-		return s.restore(height, payloadReader)
+	switch format {
+	case SnapshotFormat:
+		return s.restore(payloadReader, func(txHash TxHash, timeoutBz []byte) {
+			// expired transactions are purged once the node processes a block
+			s.m.Add(txHash, timeoutFromBytes(timeoutBz))
+		})
+
+	case LegacySnapshotFormat:
+		return s.restore(payloadReader, func(txHash TxHash, ttlBz []byte) {
+			// only the transactions unexpired at the snapshot height are tracked,
+			// their timeout timestamps being resolved on the next block
+			if height < binary.BigEndian.Uint64(ttlBz) {
+				s.m.addLegacy(txHash)
+			}
+		})
+
+	default:
+		return snapshot.ErrUnknownFormat
 	}
-
-	return snapshot.ErrUnknownFormat
 }
 
-func (s *Snapshotter) restore(height uint64, payloadReader snapshot.ExtensionPayloadReader) error {
+func (s *Snapshotter) restore(payloadReader snapshot.ExtensionPayloadReader, add func(txHash TxHash, timeoutBz []byte)) error {
 	// the payload should be the entire set of unordered transactions
 	payload, err := payloadReader()
 	if err != nil {
@@ -78,12 +97,7 @@ func (s *Snapshotter) restore(height uint64, payloadReader snapshot.ExtensionPay
 		var txHash TxHash
 		copy(txHash[:], payload[i:i+txHashSize])
 
-		ttl := binary.BigEndian.Uint64(payload[i+txHashSize : i+chunkSize])
-
-		if height < ttl {
-			// only add unordered transactions that are still valid, i.e. unexpired
-			s.m.Add(txHash, ttl)
-		}
+		add(txHash, payload[i+txHashSize:i+chunkSize])
 
 		i += chunkSize
 	}
@@ -1,7 +1,9 @@
 package unorderedtx_test
 
 import (
+	"encoding/binary"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -14,7 +16,7 @@ func TestSnapshotter(t *testing.T) {
 
 	// add a handful of unordered txs
 	for i := 0; i < 100; i++ {
-		txm.Add([32]byte{byte(i)}, 100)
+		txm.Add([32]byte{byte(i)}, time.Unix(100, 0))
 	}
 
 	var unorderedTxBz []byte
@@ -33,24 +35,60 @@ func TestSnapshotter(t *testing.T) {
 	}
 
 	// restore with an invalid format which should result in an error
-	err = s.RestoreExtension(50, 2, pr)
+	err = s.RestoreExtension(50, 3, pr)
 	require.Error(t, err)
 
-	// restore with height > ttl which should result in no unordered txs synced
+	// restore which should result in all unordered txs synced, the expired ones
+	// being purged on the next block
 	txm2 := unorderedtx.NewManager(dataDir)
 	s2 := unorderedtx.NewSnapshotter(txm2)
-	err = s2.RestoreExtension(200, unorderedtx.SnapshotFormat, pr)
+	err = s2.RestoreExtension(50, unorderedtx.SnapshotFormat, pr)
 	require.NoError(t, err)
-	require.Empty(t, txm2.Size())
+	require.Equal(t, 100, txm2.Size())
 
-	// restore with with height < ttl which should result in all unordered txs synced
-	txm3 := unorderedtx.NewManager(dataDir)
-	s3 := unorderedtx.NewSnapshotter(txm3)
-	err = s3.RestoreExtension(50, unorderedtx.SnapshotFormat, pr)
+	for i := 0; i < 100; i++ {
+		require.True(t, txm2.Contains([32]byte{byte(i)}))
+	}
+}
+
+func TestSnapshotterLegacyFormat(t *testing.T) {
+	txm := unorderedtx.NewManager(t.TempDir())
+	defer func() {
+		require.NoError(t, txm.Close())
+	}()
+
+	// a format 1 payload holds the timeout heights of the txs, half of which are
+	// expired at the snapshot height
+	var payload []byte
+	for i := 0; i < 10; i++ {
+		chunk := make([]byte, 40)
+		chunk[0] = byte(i)
+		binary.BigEndian.PutUint64(chunk[32:], uint64(45+i))
+		payload = append(payload, chunk...)
+	}
+
+	s := unorderedtx.NewSnapshotter(txm)
+	err := s.RestoreExtension(50, unorderedtx.LegacySnapshotFormat, func() ([]byte, error) {
+		return payload, nil
+	})
 	require.NoError(t, err)
-	require.Equal(t, 100, txm3.Size())
+	require.Equal(t, 4, txm.Size())
 
-	for i := 0; i < 100; i++ {
-		require.True(t, txm3.Contains([32]byte{byte(i)}))
+	for i := 0; i < 10; i++ {
+		require.Equal(t, 45+i > 50, txm.Contains([32]byte{byte(i)}))
 	}
+
+	// the unexpired txs are tracked for the max timeout duration from the next
+	// block
+	txm.Start()
+	blockTime := time.Unix(1_000_000, 0)
+	txm.OnNewBlock(blockTime)
+	txm.OnNewBlock(blockTime.Add(unorderedtx.DefaultMaxTimeoutDuration / 2))
+	time.Sleep(6 * time.Second)
+	require.Equal(t, 4, txm.Size())
+
+	txm.OnNewBlock(blockTime.Add(2 * unorderedtx.DefaultMaxTimeoutDuration))
+	require.Eventually(t, func() bool {
+		return txm.Size() == 0
+	}, 10*time.Second, 100*time.Millisecond)
 }
@@ -74,6 +74,55 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Query the current auth parameters",
 				},
+				{
+					RpcMethod: "GasPricingPolicy",
+					Use:       "gas-pricing-policy",
+					Short:     "Query the tiered gas pricing policy",
+				},
+				{
+					RpcMethod:      "AccountActivity",
+					Use:            "account-activity [address]",
+					Short:          "Query the tracked activity of an account and the fee multiplier applied to its transactions",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "Profile",
+					Use:            "profile [address]",
+					Short:          "Query the profile of an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "BaseFee",
+					Use:       "base-fee",
+					Short:     "Query the fee market parameters and the base fee of the current block",
+				},
+				{
+					RpcMethod: "FeeAbstractionParams",
+					Use:       "fee-abstraction-params",
+					Short:     "Query the fee denoms converted to the native fee denom and their conversion rates",
+				},
+				{
+					RpcMethod:      "Authenticators",
+					Use:            "authenticators [address]",
+					Short:          "Query the authenticators of a smart account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "PubKeyRotations",
+					Use:            "pub-key-rotations [address]",
+					Short:          "Query the public key rotation history of an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "MsgSurchargeParams",
+					Use:       "msg-surcharge-params",
+					Short:     "Query the gas and fee surcharges applied to the messages of given types",
+				},
+				{
+					RpcMethod: "FeeRoutingParams",
+					Use:       "fee-routing-params",
+					Short:     "Query how the transaction fees are split between the fee collector and other sinks",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -87,6 +136,73 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
 					GovProposal:    true,
 				},
+				{
+					RpcMethod:      "UpdateGasPricingPolicy",
+					Use:            "update-gas-pricing-policy-proposal [policy]",
+					Short:          "Submit a proposal to update the tiered gas pricing policy. Note: the entire policy must be provided.",
+					Example:        fmt.Sprintf(`%s tx auth update-gas-pricing-policy-proposal '{ "enabled": true, "min_gas_prices": [{"denom": "stake", "amount": "0.01"}], "activity_window": 100800, "tiers": [{"min_account_age": 100800, "min_recent_txs": 5, "fee_multiplier": "0.8"}, {"min_account_age": 1000, "fee_multiplier": "1"}, {"fee_multiplier": "2"}] }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "policy"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "SetProfile",
+					Use:            "set-profile [display-name]",
+					Short:          "Set the profile of the sending account. An empty display name and avatar hash clear the profile.",
+					Example:        fmt.Sprintf(`%s tx auth set-profile alice --avatar-hash 2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824 --from alice`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "display_name"}},
+				},
+				{
+					RpcMethod:      "UpdateFeeMarketParams",
+					Use:            "update-fee-market-params-proposal [params]",
+					Short:          "Submit a proposal to update the fee market parameters. Note: the entire params must be provided.",
+					Example:        fmt.Sprintf(`%s tx auth update-fee-market-params-proposal '{ "enabled": true, "denom": "stake", "min_base_fee": "0.0025", "target_block_gas": 15000000, "base_fee_change_denominator": 8, "base_fee_recipient": "" }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "UpdateFeeAbstractionParams",
+					Use:            "update-fee-abstraction-params-proposal [params]",
+					Short:          "Submit a proposal to update the fee denoms converted to the native fee denom. Note: the entire params must be provided.",
+					Example:        fmt.Sprintf(`%s tx auth update-fee-abstraction-params-proposal '{ "enabled": true, "native_denom": "stake", "reserve": "fee_abstraction", "accepted_denoms": [{"denom": "ibc/USDC", "conversion_rate": "2.5"}] }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "AddAuthenticator",
+					Use:            "add-authenticator [authenticator]",
+					Short:          "Register an authenticator for the sending account, making it a smart account",
+					Example:        fmt.Sprintf(`%s tx auth add-authenticator '{ "pub_keys": [{"@type": "/cosmos.crypto.secp256k1.PubKey", "key": "A8fn..."}], "spending_policy": {"limit": [{"denom": "stake", "amount": "1000"}], "period": "86400s"} }' --from alice`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "authenticator"}},
+				},
+				{
+					RpcMethod:      "RemoveAuthenticator",
+					Use:            "remove-authenticator [id]",
+					Short:          "Remove an authenticator of the sending smart account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "RotatePubKey",
+					Use:            "rotate-pub-key [new-pub-key] [proof]",
+					Short:          "Replace the public key of the sending account, keeping its address, account number and sequence. The proof is the signature by the new key of the rotation proof bytes.",
+					Example:        fmt.Sprintf(`%s tx auth rotate-pub-key '{"@type": "/cosmos.crypto.secp256k1.PubKey", "key": "A8fn..."}' 5b0e... --from alice`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "new_pub_key"}, {ProtoField: "proof"}},
+				},
+				{
+					RpcMethod:      "UpdateMsgSurchargeParams",
+					Use:            "update-msg-surcharge-params-proposal [params]",
+					Short:          "Submit a proposal to update the gas and fee surcharges applied to the messages of given types. Note: the entire params must be provided.",
+					Example:        fmt.Sprintf(`%s tx auth update-msg-surcharge-params-proposal '{ "surcharges": [{"msg_type_url": "/cosmos.bank.v1beta1.MsgMultiSend", "gas": 50000, "fee": [{"denom": "stake", "amount": "1000"}]}] }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "UpdateFeeRoutingParams",
+					Use:            "update-fee-routing-params-proposal [params]",
+					Short:          "Submit a proposal to update how the transaction fees are split between the fee collector and other sinks. Note: the entire params must be provided.",
+					Example:        fmt.Sprintf(`%s tx auth update-fee-routing-params-proposal '{ "routes": [{"recipient": "fee_collector", "share": "0.5"}, {"recipient": "", "share": "0.3"}, {"recipient": "protocolpool", "share": "0.2"}] }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
 			},
 		},
 	}
@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// RegisterAuthenticatorVerifier registers a verifier the authenticators of the
+// smart accounts can delegate the verification of their signatures to, e.g. a
+// contract-based verifier. It must be called while setting up the application.
+func (ak AccountKeeper) RegisterAuthenticatorVerifier(name string, verifier types.AuthenticatorVerifier) {
+	if name == "" {
+		panic("authenticator verifier name cannot be empty")
+	}
+	if _, ok := ak.verifiers[name]; ok {
+		panic(fmt.Sprintf("authenticator verifier %s already registered", name))
+	}
+
+	ak.verifiers[name] = verifier
+}
+
+// GetAuthenticatorVerifier returns the verifier registered with the given name,
+// and false if there is none.
+func (ak AccountKeeper) GetAuthenticatorVerifier(name string) (types.AuthenticatorVerifier, bool) {
+	verifier, ok := ak.verifiers[name]
+	return verifier, ok
+}
+
+// GetAuthenticators returns the authenticators of an account, ordered by id. An
+// account with authenticators is a smart account.
+func (ak AccountKeeper) GetAuthenticators(ctx context.Context, addr sdk.AccAddress) ([]types.Authenticator, error) {
+	var authenticators []types.Authenticator
+	err := ak.Authenticators.Walk(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, uint64](addr), func(_ collections.Pair[sdk.AccAddress, uint64], a types.Authenticator) (stop bool, err error) {
+		authenticators = append(authenticators, a)
+		return false, nil
+	})
+
+	return authenticators, err
+}
+
+// AddAuthenticator registers an authenticator for an account, making it a smart
+// account, and returns its id. Once an account has authenticators, its
+// signatures are verified by them instead of its public key.
+func (ak AccountKeeper) AddAuthenticator(ctx context.Context, addr sdk.AccAddress, authenticator types.Authenticator) (uint64, error) {
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return 0, sdkerrors.ErrUnknownAddress.Wrapf("account %s does not exist", addr)
+	}
+	if _, ok := acc.(sdk.ModuleAccountI); ok {
+		return 0, sdkerrors.ErrUnauthorized.Wrapf("module account %s cannot have authenticators", addr)
+	}
+
+	if authenticator.SpendingPolicy != nil {
+		authenticator.SpendingPolicy.Spent = nil
+		authenticator.SpendingPolicy.PeriodStart = ak.Environment.HeaderService.GetHeaderInfo(ctx).Time
+	}
+	if err := authenticator.Validate(); err != nil {
+		return 0, err
+	}
+	if authenticator.Verifier != "" {
+		if _, ok := ak.verifiers[authenticator.Verifier]; !ok {
+			return 0, fmt.Errorf("unknown authenticator verifier %s", authenticator.Verifier)
+		}
+	}
+
+	authenticators, err := ak.GetAuthenticators(ctx, addr)
+	if err != nil {
+		return 0, err
+	}
+	if len(authenticators) >= types.MaxAuthenticators {
+		return 0, fmt.Errorf("account %s cannot have more than %d authenticators", addr, types.MaxAuthenticators)
+	}
+
+	authenticator.Id, err = ak.AuthenticatorSequence.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := ak.Authenticators.Set(ctx, collections.Join(addr, authenticator.Id), authenticator); err != nil {
+		return 0, err
+	}
+
+	return authenticator.Id, ak.emitAuthenticatorEvent(ctx, types.EventTypeAddAuthenticator, addr, authenticator.Id)
+}
+
+// RemoveAuthenticator removes an authenticator of a smart account. An account
+// whose authenticators are all removed is authenticated by its public key again.
+func (ak AccountKeeper) RemoveAuthenticator(ctx context.Context, addr sdk.AccAddress, id uint64) error {
+	key := collections.Join(addr, id)
+	has, err := ak.Authenticators.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return sdkerrors.ErrNotFound.Wrapf("authenticator %d of account %s not found", id, addr)
+	}
+
+	if err := ak.Authenticators.Remove(ctx, key); err != nil {
+		return err
+	}
+
+	return ak.emitAuthenticatorEvent(ctx, types.EventTypeRemoveAuthenticator, addr, id)
+}
+
+// RecordAuthenticatorSpending adds the coins spent by a transaction to the
+// spending of the authenticator it was authenticated by, returning an error if
+// they exceed the limit of its spending policy.
+func (ak AccountKeeper) RecordAuthenticatorSpending(ctx context.Context, addr sdk.AccAddress, id uint64, spent sdk.Coins) error {
+	key := collections.Join(addr, id)
+	authenticator, err := ak.Authenticators.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			// the authenticator was removed by the transaction itself
+			return nil
+		}
+		return err
+	}
+	if authenticator.SpendingPolicy == nil {
+		return nil
+	}
+
+	policy, err := authenticator.SpendingPolicy.Spend(ak.Environment.HeaderService.GetHeaderInfo(ctx).Time, spent)
+	if err != nil {
+		return err
+	}
+
+	authenticator.SpendingPolicy = &policy
+	return ak.Authenticators.Set(ctx, key, authenticator)
+}
+
+// GetAllAccountAuthenticators returns the authenticators of all the smart
+// accounts.
+func (ak AccountKeeper) GetAllAccountAuthenticators(ctx context.Context) ([]types.AccountAuthenticators, error) {
+	var all []types.AccountAuthenticators
+	err := ak.Authenticators.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, uint64], a types.Authenticator) (stop bool, err error) {
+		addr, err := ak.addressCodec.BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+
+		if len(all) == 0 || all[len(all)-1].Address != addr {
+			all = append(all, types.AccountAuthenticators{Address: addr})
+		}
+		all[len(all)-1].Authenticators = append(all[len(all)-1].Authenticators, a)
+		return false, nil
+	})
+
+	return all, err
+}
+
+func (ak AccountKeeper) emitAuthenticatorEvent(ctx context.Context, eventType string, addr sdk.AccAddress, id uint64) error {
+	addrStr, err := ak.addressCodec.BytesToString(addr)
+	if err != nil {
+		return err
+	}
+
+	return ak.Environment.EventService.EventManager(ctx).EmitKV(
+		eventType,
+		event.NewAttribute(types.AttributeKeyAddress, addrStr),
+		event.NewAttribute(types.AttributeKeyAuthenticatorID, strconv.FormatUint(id, 10)),
+	)
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/auth/types"
+)
+
+// GetFeeAbstractionParams returns the fee abstraction parameters, disabled if
+// none were set.
+func (ak AccountKeeper) GetFeeAbstractionParams(ctx context.Context) (types.FeeAbstractionParams, error) {
+	params, err := ak.FeeAbstractionParams.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.FeeAbstractionParams{}, err
+	}
+
+	return params, nil
+}
+
+// SetFeeAbstractionParams validates and sets the fee abstraction parameters.
+// The reserve must be a module account.
+func (ak AccountKeeper) SetFeeAbstractionParams(ctx context.Context, params types.FeeAbstractionParams) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	if params.Reserve != "" {
+		if _, ok := ak.permAddrs[params.Reserve]; !ok {
+			return fmt.Errorf("fee abstraction reserve %s is not a module account", params.Reserve)
+		}
+	}
+
+	return ak.FeeAbstractionParams.Set(ctx, params)
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/auth/types"
+)
+
+// GetFeeMarketParams returns the fee market parameters, disabled if none were
+// set.
+func (ak AccountKeeper) GetFeeMarketParams(ctx context.Context) (types.FeeMarketParams, error) {
+	params, err := ak.FeeMarketParams.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.FeeMarketParams{}, err
+	}
+
+	return params, nil
+}
+
+// SetFeeMarketParams validates and sets the fee market parameters. The base fee
+// recipient must be a module account, and the fee collector must be allowed to
+// burn the base fees when there is none.
+func (ak AccountKeeper) SetFeeMarketParams(ctx context.Context, params types.FeeMarketParams) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	if params.BaseFeeRecipient != "" {
+		if _, ok := ak.permAddrs[params.BaseFeeRecipient]; !ok {
+			return fmt.Errorf("fee market base fee recipient %s is not a module account", params.BaseFeeRecipient)
+		}
+	} else if params.Enabled && !ak.permAddrs[types.FeeCollectorName].HasPermission(types.Burner) {
+		return fmt.Errorf("the %s module account must have the %s permission to burn the base fees", types.FeeCollectorName, types.Burner)
+	}
+
+	return ak.FeeMarketParams.Set(ctx, params)
+}
+
+// GetFeeMarketState returns the fee market state of the last block a
+// transaction was executed in.
+func (ak AccountKeeper) GetFeeMarketState(ctx context.Context) (types.FeeMarketState, error) {
+	state, err := ak.FeeMarketState.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.FeeMarketState{}, err
+	}
+
+	return state, nil
+}
+
+// GetBaseFee returns the base fee per unit of gas at the current block height.
+func (ak AccountKeeper) GetBaseFee(ctx context.Context, params types.FeeMarketParams) (math.LegacyDec, error) {
+	state, err := ak.GetFeeMarketState(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return state.BaseFeeAt(params, ak.Environment.HeaderService.GetHeaderInfo(ctx).Height), nil
+}
+
+// RecordBlockGas adds the gas limit of a transaction to the gas used by the
+// current block, from which the base fee of the next block is computed.
+func (ak AccountKeeper) RecordBlockGas(ctx context.Context, params types.FeeMarketParams, gas uint64) error {
+	state, err := ak.GetFeeMarketState(ctx)
+	if err != nil {
+		return err
+	}
+
+	height := ak.Environment.HeaderService.GetHeaderInfo(ctx).Height
+	return ak.FeeMarketState.Set(ctx, state.Record(params, height, gas))
+}
+
+// RefundBlockGas removes the gas left unused by a transaction from the gas used
+// by the current block, the gas limit of the transaction having been recorded
+// by RecordBlockGas.
+func (ak AccountKeeper) RefundBlockGas(ctx context.Context, gas uint64) error {
+	state, err := ak.GetFeeMarketState(ctx)
+	if err != nil {
+		return err
+	}
+
+	height := ak.Environment.HeaderService.GetHeaderInfo(ctx).Height
+	return ak.FeeMarketState.Set(ctx, state.Refund(height, gas))
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/auth/types"
+)
+
+// GetFeeRoutingParams returns the fee routing parameters, empty if none were
+// set.
+func (ak AccountKeeper) GetFeeRoutingParams(ctx context.Context) (types.FeeRoutingParams, error) {
+	params, err := ak.FeeRoutingParams.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.FeeRoutingParams{}, err
+	}
+
+	return params, nil
+}
+
+// SetFeeRoutingParams validates and sets the fee routing parameters. The route
+// recipients must be module accounts, and the fee collector must be allowed to
+// burn the fees if any route burns them.
+func (ak AccountKeeper) SetFeeRoutingParams(ctx context.Context, params types.FeeRoutingParams) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	routes := params.Routes
+	for _, denomRoutes := range params.DenomRoutes {
+		routes = append(routes[:len(routes):len(routes)], denomRoutes.Routes...)
+	}
+	for _, route := range routes {
+		if route.Recipient == "" {
+			if !ak.permAddrs[types.FeeCollectorName].HasPermission(types.Burner) {
+				return fmt.Errorf("the %s module account must have the %s permission to burn the routed fees", types.FeeCollectorName, types.Burner)
+			}
+			continue
+		}
+
+		if _, ok := ak.permAddrs[route.Recipient]; !ok {
+			return fmt.Errorf("fee route recipient %s is not a module account", route.Recipient)
+		}
+	}
+
+	return ak.FeeRoutingParams.Set(ctx, params)
+}
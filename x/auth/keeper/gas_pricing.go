@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetGasPricingPolicy returns the tiered gas pricing policy, disabled if none
+// was set.
+func (ak AccountKeeper) GetGasPricingPolicy(ctx context.Context) (types.GasPricingPolicy, error) {
+	policy, err := ak.GasPricingPolicy.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.GasPricingPolicy{}, err
+	}
+
+	return policy, nil
+}
+
+// GetAccountActivity returns the tracked activity of an account, empty if the
+// account never paid fees.
+func (ak AccountKeeper) GetAccountActivity(ctx context.Context, addr sdk.AccAddress) (types.AccountActivity, error) {
+	activity, err := ak.AccountActivities.Get(ctx, addr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.AccountActivity{}, err
+	}
+
+	return activity, nil
+}
+
+// GetFeeMultiplier returns the multiplier the policy applies to the base gas
+// prices for the transactions paid by the account at the current block height.
+func (ak AccountKeeper) GetFeeMultiplier(ctx context.Context, policy types.GasPricingPolicy, addr sdk.AccAddress) (math.LegacyDec, error) {
+	if !policy.Enabled {
+		return math.LegacyOneDec(), nil
+	}
+
+	activity, err := ak.GetAccountActivity(ctx, addr)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return policy.FeeMultiplier(activity, ak.Environment.HeaderService.GetHeaderInfo(ctx).Height), nil
+}
+
+// RecordAccountActivity records a transaction paid by the account at the current
+// block height in the account activity index.
+func (ak AccountKeeper) RecordAccountActivity(ctx context.Context, addr sdk.AccAddress, window uint64) error {
+	height := ak.Environment.HeaderService.GetHeaderInfo(ctx).Height
+
+	activity, err := ak.AccountActivities.Get(ctx, addr)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		addrStr, err := ak.addressCodec.BytesToString(addr)
+		if err != nil {
+			return err
+		}
+		activity = types.NewAccountActivity(addrStr, height)
+	case err != nil:
+		return err
+	}
+
+	return ak.AccountActivities.Set(ctx, addr, activity.Record(height, window))
+}
+
+// GetAllAccountActivities returns all the tracked account activities.
+func (ak AccountKeeper) GetAllAccountActivities(ctx context.Context) ([]types.AccountActivity, error) {
+	var activities []types.AccountActivity
+	err := ak.AccountActivities.Walk(ctx, nil, func(_ sdk.AccAddress, activity types.AccountActivity) (stop bool, err error) {
+		activities = append(activities, activity)
+		return false, nil
+	})
+
+	return activities, err
+}
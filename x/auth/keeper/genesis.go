@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/auth/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -35,6 +36,82 @@ func (ak AccountKeeper) InitGenesis(ctx context.Context, data types.GenesisState
 		ak.SetAccount(ctx, acc)
 	}
 
+	if err := ak.GasPricingPolicy.Set(ctx, data.GasPricingPolicy); err != nil {
+		return err
+	}
+
+	for _, activity := range data.AccountActivities {
+		addr, err := ak.addressCodec.StringToBytes(activity.Address)
+		if err != nil {
+			return err
+		}
+		if err := ak.AccountActivities.Set(ctx, addr, activity); err != nil {
+			return err
+		}
+	}
+
+	for _, profile := range data.Profiles {
+		addr, err := ak.addressCodec.StringToBytes(profile.Address)
+		if err != nil {
+			return err
+		}
+		if err := ak.Profiles.Set(ctx, addr, profile); err != nil {
+			return err
+		}
+	}
+
+	if err := ak.SetFeeMarketParams(ctx, data.FeeMarketParams); err != nil {
+		return err
+	}
+
+	if err := ak.FeeMarketState.Set(ctx, data.FeeMarketState); err != nil {
+		return err
+	}
+
+	if err := ak.SetFeeAbstractionParams(ctx, data.FeeAbstractionParams); err != nil {
+		return err
+	}
+
+	var nextAuthenticatorID uint64
+	for _, aa := range data.Authenticators {
+		addr, err := ak.addressCodec.StringToBytes(aa.Address)
+		if err != nil {
+			return err
+		}
+		for _, a := range aa.Authenticators {
+			if err := ak.Authenticators.Set(ctx, collections.Join(sdk.AccAddress(addr), a.Id), a); err != nil {
+				return err
+			}
+			nextAuthenticatorID = max(nextAuthenticatorID, a.Id+1)
+		}
+	}
+	if err := ak.AuthenticatorSequence.Set(ctx, nextAuthenticatorID); err != nil {
+		return err
+	}
+
+	var nextRotationID uint64
+	for _, r := range data.PubKeyRotations {
+		addr, err := ak.addressCodec.StringToBytes(r.Address)
+		if err != nil {
+			return err
+		}
+		if err := ak.PubKeyRotations.Set(ctx, collections.Join(sdk.AccAddress(addr), r.Id), r); err != nil {
+			return err
+		}
+		nextRotationID = max(nextRotationID, r.Id+1)
+	}
+	if err := ak.PubKeyRotationSequence.Set(ctx, nextRotationID); err != nil {
+		return err
+	}
+
+	if err := ak.SetMsgSurchargeParams(ctx, data.MsgSurchargeParams); err != nil {
+		return err
+	}
+
+	if err := ak.SetFeeRoutingParams(ctx, data.FeeRoutingParams); err != nil {
+		return err
+	}
+
 	ak.GetModuleAccount(ctx, types.FeeCollectorName)
 	return nil
 }
@@ -52,5 +129,61 @@ func (ak AccountKeeper) ExportGenesis(ctx context.Context) (*types.GenesisState,
 		genAccounts = append(genAccounts, genAcc)
 		return false, nil
 	})
-	return types.NewGenesisState(params, genAccounts), err
+	if err != nil {
+		return nil, err
+	}
+
+	genState := types.NewGenesisState(params, genAccounts)
+
+	genState.GasPricingPolicy, err = ak.GetGasPricingPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genState.AccountActivities, err = ak.GetAllAccountActivities(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genState.Profiles, err = ak.GetAllProfiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genState.FeeMarketParams, err = ak.GetFeeMarketParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genState.FeeMarketState, err = ak.GetFeeMarketState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genState.FeeAbstractionParams, err = ak.GetFeeAbstractionParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genState.Authenticators, err = ak.GetAllAccountAuthenticators(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genState.PubKeyRotations, err = ak.GetAllPubKeyRotations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genState.MsgSurchargeParams, err = ak.GetMsgSurchargeParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genState.FeeRoutingParams, err = ak.GetFeeRoutingParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return genState, nil
 }
@@ -247,3 +247,187 @@ func (s queryServer) AccountInfo(ctx context.Context, req *types.QueryAccountInf
 		},
 	}, nil
 }
+
+// GasPricingPolicy returns the tiered gas pricing policy.
+func (s queryServer) GasPricingPolicy(ctx context.Context, req *types.QueryGasPricingPolicyRequest) (*types.QueryGasPricingPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	policy, err := s.k.GetGasPricingPolicy(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGasPricingPolicyResponse{Policy: policy}, nil
+}
+
+// AccountActivity returns the tracked activity of an account and the fee
+// multiplier currently applied to its transactions.
+func (s queryServer) AccountActivity(ctx context.Context, req *types.QueryAccountActivityRequest) (*types.QueryAccountActivityResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	addr, err := s.k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	activity, err := s.k.GetAccountActivity(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	policy, err := s.k.GetGasPricingPolicy(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	multiplier, err := s.k.GetFeeMultiplier(ctx, policy, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAccountActivityResponse{Activity: activity, FeeMultiplier: multiplier}, nil
+}
+
+// Profile returns the profile of an account.
+func (s queryServer) Profile(ctx context.Context, req *types.QueryProfileRequest) (*types.QueryProfileResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	addr, err := s.k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	profile, found, err := s.k.GetProfile(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "profile of account %s not found", req.Address)
+	}
+
+	return &types.QueryProfileResponse{Profile: profile}, nil
+}
+
+// BaseFee returns the fee market parameters and the base fee of the current
+// block.
+func (s queryServer) BaseFee(ctx context.Context, req *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	params, err := s.k.GetFeeMarketParams(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	baseFee, err := s.k.GetBaseFee(ctx, params)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBaseFeeResponse{Params: params, BaseFee: baseFee}, nil
+}
+
+// FeeAbstractionParams returns the fee denoms converted to the native fee denom
+// and their conversion rates.
+func (s queryServer) FeeAbstractionParams(ctx context.Context, req *types.QueryFeeAbstractionParamsRequest) (*types.QueryFeeAbstractionParamsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	params, err := s.k.GetFeeAbstractionParams(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFeeAbstractionParamsResponse{Params: params}, nil
+}
+
+// Authenticators returns the authenticators of a smart account.
+func (s queryServer) Authenticators(ctx context.Context, req *types.QueryAuthenticatorsRequest) (*types.QueryAuthenticatorsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	addr, err := s.k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	authenticators, err := s.k.GetAuthenticators(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAuthenticatorsResponse{Authenticators: authenticators}, nil
+}
+
+// PubKeyRotations returns the public key rotation history of an account.
+func (s queryServer) PubKeyRotations(ctx context.Context, req *types.QueryPubKeyRotationsRequest) (*types.QueryPubKeyRotationsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	addr, err := s.k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	rotations, err := s.k.GetPubKeyRotations(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPubKeyRotationsResponse{Rotations: rotations}, nil
+}
+
+// MsgSurchargeParams returns the surcharges applied to the messages of given
+// types.
+func (s queryServer) MsgSurchargeParams(ctx context.Context, req *types.QueryMsgSurchargeParamsRequest) (*types.QueryMsgSurchargeParamsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	params, err := s.k.GetMsgSurchargeParams(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMsgSurchargeParamsResponse{Params: params}, nil
+}
+
+// FeeRoutingParams returns how the transaction fees are split between sinks.
+func (s queryServer) FeeRoutingParams(ctx context.Context, req *types.QueryFeeRoutingParamsRequest) (*types.QueryFeeRoutingParamsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	params, err := s.k.GetFeeRoutingParams(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFeeRoutingParamsResponse{Params: params}, nil
+}
@@ -9,6 +9,8 @@ import (
 
 	"github.com/cosmos/gogoproto/proto"
 
+	"cosmossdk.io/core/header"
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/x/auth/types"
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -536,3 +538,56 @@ func (suite *KeeperTestSuite) TestQueryAccountInfoWithoutPubKey() {
 	suite.Require().Equal(addr.String(), res.Info.Address)
 	suite.Require().Nil(res.Info.PubKey)
 }
+
+func (suite *KeeperTestSuite) TestQueryGasPricing() {
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Height: 10})
+
+	res, err := suite.queryClient.GasPricingPolicy(ctx, &types.QueryGasPricingPolicyRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.GasPricingPolicy{}, res.Policy)
+
+	_, err = suite.queryClient.AccountActivity(ctx, &types.QueryAccountActivityRequest{})
+	suite.Require().ErrorContains(err, "address cannot be empty")
+
+	// an account which never paid fees has no activity
+	activityRes, err := suite.queryClient.AccountActivity(ctx, &types.QueryAccountActivityRequest{Address: addrStr})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.AccountActivity{}, activityRes.Activity)
+	suite.Require().Equal(sdkmath.LegacyOneDec(), activityRes.FeeMultiplier)
+
+	policy := types.GasPricingPolicy{
+		Enabled:        true,
+		MinGasPrices:   sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 1)),
+		ActivityWindow: 100,
+		Tiers:          []types.GasPricingTier{{MinAccountAge: 5, FeeMultiplier: sdkmath.LegacyNewDecWithPrec(5, 1)}, {FeeMultiplier: sdkmath.LegacyNewDec(3)}},
+	}
+	suite.Require().NoError(suite.accountKeeper.GasPricingPolicy.Set(ctx, policy))
+	suite.Require().NoError(suite.accountKeeper.RecordAccountActivity(ctx, addrBytes, policy.ActivityWindow))
+
+	res, err = suite.queryClient.GasPricingPolicy(ctx, &types.QueryGasPricingPolicyRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(policy, res.Policy)
+
+	activityRes, err = suite.queryClient.AccountActivity(ctx, &types.QueryAccountActivityRequest{Address: addrStr})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.AccountActivity{Address: addrStr, FirstSeenHeight: 10, WindowStartHeight: 10, WindowTxCount: 1}, activityRes.Activity)
+	suite.Require().Equal(sdkmath.LegacyNewDec(3), activityRes.FeeMultiplier)
+
+	multiplier, err := suite.accountKeeper.GetFeeMultiplier(ctx.WithHeaderInfo(header.Info{Height: 15}), policy, addrBytes)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdkmath.LegacyNewDecWithPrec(5, 1), multiplier)
+}
+
+func (suite *KeeperTestSuite) TestQueryProfile() {
+	_, err := suite.queryClient.Profile(suite.ctx, &types.QueryProfileRequest{})
+	suite.Require().ErrorContains(err, "address cannot be empty")
+
+	_, err = suite.queryClient.Profile(suite.ctx, &types.QueryProfileRequest{Address: addrStr})
+	suite.Require().ErrorContains(err, "not found")
+
+	suite.Require().NoError(suite.accountKeeper.SetProfile(suite.ctx, addrBytes, "alice", nil))
+
+	res, err := suite.queryClient.Profile(suite.ctx, &types.QueryProfileRequest{Address: addrStr})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.AccountProfile{Address: addrStr, DisplayName: "alice"}, res.Profile)
+}
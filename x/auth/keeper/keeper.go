@@ -90,16 +90,43 @@ type AccountKeeper struct {
 	// The prototypical AccountI constructor.
 	proto func() sdk.AccountI
 
+	// the verifiers the authenticators of the smart accounts can delegate to.
+	verifiers map[string]types.AuthenticatorVerifier
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
 
 	// State
 	Schema        collections.Schema
-	Params        collections.Item[types.Params]
+	Params        collections.CachedItem[types.Params]
 	AccountNumber collections.Sequence
 	// Accounts key: AccAddr | value: AccountI | index: AccountsIndex
 	Accounts *collections.IndexedMap[sdk.AccAddress, sdk.AccountI, AccountsIndexes]
+	// GasPricingPolicy is the tiered gas pricing policy
+	GasPricingPolicy collections.CachedItem[types.GasPricingPolicy]
+	// AccountActivities key: AccAddr | value: AccountActivity
+	AccountActivities collections.Map[sdk.AccAddress, types.AccountActivity]
+	// Profiles key: AccAddr | value: AccountProfile
+	Profiles collections.Map[sdk.AccAddress, types.AccountProfile]
+	// FeeMarketParams are the fee market parameters
+	FeeMarketParams collections.CachedItem[types.FeeMarketParams]
+	// FeeMarketState is the fee market state of the last block a tx was executed in
+	FeeMarketState collections.Item[types.FeeMarketState]
+	// FeeAbstractionParams are the fee denoms converted to the native fee denom
+	FeeAbstractionParams collections.CachedItem[types.FeeAbstractionParams]
+	// Authenticators key: AccAddr+AuthenticatorID | value: Authenticator
+	Authenticators collections.Map[collections.Pair[sdk.AccAddress, uint64], types.Authenticator]
+	// AuthenticatorSequence is the next authenticator id
+	AuthenticatorSequence collections.Sequence
+	// PubKeyRotations key: AccAddr+RotationID | value: PubKeyRotation
+	PubKeyRotations collections.Map[collections.Pair[sdk.AccAddress, uint64], types.PubKeyRotation]
+	// PubKeyRotationSequence is the next public key rotation id
+	PubKeyRotationSequence collections.Sequence
+	// MsgSurchargeParams are the surcharges applied to the messages of given types
+	MsgSurchargeParams collections.CachedItem[types.MsgSurchargeParams]
+	// FeeRoutingParams define how the transaction fees are split between sinks
+	FeeRoutingParams collections.CachedItem[types.FeeRoutingParams]
 }
 
 var _ AccountKeeperI = &AccountKeeper{}
@@ -122,16 +149,29 @@ func NewAccountKeeper(
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 
 	ak := AccountKeeper{
-		addressCodec:  ac,
-		bech32Prefix:  bech32Prefix,
-		Environment:   env,
-		proto:         proto,
-		cdc:           cdc,
-		permAddrs:     permAddrs,
-		authority:     authority,
-		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		AccountNumber: collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
-		Accounts:      collections.NewIndexedMap(sb, types.AddressStoreKeyPrefix, "accounts", sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](cdc), NewAccountIndexes(sb)),
+		addressCodec:           ac,
+		bech32Prefix:           bech32Prefix,
+		Environment:            env,
+		proto:                  proto,
+		cdc:                    cdc,
+		permAddrs:              permAddrs,
+		verifiers:              make(map[string]types.AuthenticatorVerifier),
+		authority:              authority,
+		Params:                 collections.NewCachedItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		AccountNumber:          collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
+		Accounts:               collections.NewIndexedMap(sb, types.AddressStoreKeyPrefix, "accounts", sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](cdc), NewAccountIndexes(sb)),
+		GasPricingPolicy:       collections.NewCachedItem(sb, types.GasPricingPolicyKey, "gas_pricing_policy", codec.CollValue[types.GasPricingPolicy](cdc)),
+		AccountActivities:      collections.NewMap(sb, types.AccountActivityKeyPrefix, "account_activities", sdk.AccAddressKey, codec.CollValue[types.AccountActivity](cdc)),
+		Profiles:               collections.NewMap(sb, types.ProfileKeyPrefix, "profiles", sdk.AccAddressKey, codec.CollValue[types.AccountProfile](cdc)),
+		FeeMarketParams:        collections.NewCachedItem(sb, types.FeeMarketParamsKey, "fee_market_params", codec.CollValue[types.FeeMarketParams](cdc)),
+		FeeMarketState:         collections.NewItem(sb, types.FeeMarketStateKey, "fee_market_state", codec.CollValue[types.FeeMarketState](cdc)),
+		FeeAbstractionParams:   collections.NewCachedItem(sb, types.FeeAbstractionParamsKey, "fee_abstraction_params", codec.CollValue[types.FeeAbstractionParams](cdc)),
+		Authenticators:         collections.NewMap(sb, types.AuthenticatorsKeyPrefix, "authenticators", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key), codec.CollValue[types.Authenticator](cdc)),
+		AuthenticatorSequence:  collections.NewSequence(sb, types.AuthenticatorSequenceKey, "authenticator_sequence"),
+		PubKeyRotations:        collections.NewMap(sb, types.PubKeyRotationsKeyPrefix, "pub_key_rotations", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key), codec.CollValue[types.PubKeyRotation](cdc)),
+		PubKeyRotationSequence: collections.NewSequence(sb, types.PubKeyRotationSequenceKey, "pub_key_rotation_sequence"),
+		MsgSurchargeParams:     collections.NewCachedItem(sb, types.MsgSurchargeParamsKey, "msg_surcharge_params", codec.CollValue[types.MsgSurchargeParams](cdc)),
+		FeeRoutingParams:       collections.NewCachedItem(sb, types.FeeRoutingParamsKey, "fee_routing_params", codec.CollValue[types.FeeRoutingParams](cdc)),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/auth"
 	authcodec "cosmossdk.io/x/auth/codec"
@@ -19,6 +20,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
@@ -231,3 +233,29 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	// we expect nextNum to be 2 because we initialize fee_collector as account number 1
 	suite.Require().Equal(2, int(nextNum))
 }
+
+func (suite *KeeperTestSuite) TestGasPricingGenesis() {
+	suite.SetupTest() // reset
+
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	genState := types.DefaultGenesisState()
+	genState.GasPricingPolicy = types.GasPricingPolicy{
+		Enabled:        true,
+		MinGasPrices:   sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 1)),
+		ActivityWindow: 100,
+		Tiers:          []types.GasPricingTier{{FeeMultiplier: math.LegacyNewDec(2)}},
+	}
+	genState.AccountActivities = []types.AccountActivity{
+		{Address: addr1.String(), FirstSeenHeight: 1, WindowStartHeight: 50, WindowTxCount: 3, PreviousWindowTxCount: 2},
+	}
+	suite.Require().NoError(suite.accountKeeper.InitGenesis(suite.ctx, *genState))
+
+	activity, err := suite.accountKeeper.GetAccountActivity(suite.ctx, addr1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(genState.AccountActivities[0], activity)
+
+	exported, err := suite.accountKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(genState.GasPricingPolicy, exported.GasPricingPolicy)
+	suite.Require().Equal(genState.AccountActivities, exported.AccountActivities)
+}
@@ -5,6 +5,9 @@ import (
 	"fmt"
 
 	"cosmossdk.io/x/auth/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var _ types.MsgServer = msgServer{}
@@ -37,3 +40,151 @@ func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+func (ms msgServer) UpdateGasPricingPolicy(ctx context.Context, msg *types.MsgUpdateGasPricingPolicy) (*types.MsgUpdateGasPricingPolicyResponse, error) {
+	if ms.ak.authority != msg.Authority {
+		return nil, fmt.Errorf(
+			"expected authority account as only signer for proposal message; invalid authority; expected %s, got %s",
+			ms.ak.authority, msg.Authority)
+	}
+
+	if err := msg.Policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.ak.GasPricingPolicy.Set(ctx, msg.Policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateGasPricingPolicyResponse{}, nil
+}
+
+// SetProfile sets, or clears, the profile of the signing account.
+func (ms msgServer) SetProfile(ctx context.Context, msg *types.MsgSetProfile) (*types.MsgSetProfileResponse, error) {
+	addr, err := ms.ak.addressCodec.StringToBytes(msg.Address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address: %w", err)
+	}
+
+	if err := types.ValidateProfileFields(msg.DisplayName, msg.AvatarHash); err != nil {
+		return nil, err
+	}
+
+	if err := ms.ak.SetProfile(ctx, addr, msg.DisplayName, msg.AvatarHash); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetProfileResponse{}, nil
+}
+
+func (ms msgServer) UpdateFeeMarketParams(ctx context.Context, msg *types.MsgUpdateFeeMarketParams) (*types.MsgUpdateFeeMarketParamsResponse, error) {
+	if ms.ak.authority != msg.Authority {
+		return nil, fmt.Errorf(
+			"expected authority account as only signer for proposal message; invalid authority; expected %s, got %s",
+			ms.ak.authority, msg.Authority)
+	}
+
+	if err := ms.ak.SetFeeMarketParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateFeeMarketParamsResponse{}, nil
+}
+
+func (ms msgServer) UpdateFeeAbstractionParams(ctx context.Context, msg *types.MsgUpdateFeeAbstractionParams) (*types.MsgUpdateFeeAbstractionParamsResponse, error) {
+	if ms.ak.authority != msg.Authority {
+		return nil, fmt.Errorf(
+			"expected authority account as only signer for proposal message; invalid authority; expected %s, got %s",
+			ms.ak.authority, msg.Authority)
+	}
+
+	if err := ms.ak.SetFeeAbstractionParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateFeeAbstractionParamsResponse{}, nil
+}
+
+// AddAuthenticator registers an authenticator for the signing account.
+func (ms msgServer) AddAuthenticator(ctx context.Context, msg *types.MsgAddAuthenticator) (*types.MsgAddAuthenticatorResponse, error) {
+	addr, err := ms.ak.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender address: %w", err)
+	}
+
+	id, err := ms.ak.AddAuthenticator(ctx, addr, msg.Authenticator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddAuthenticatorResponse{Id: id}, nil
+}
+
+// RemoveAuthenticator removes an authenticator of the signing smart account.
+func (ms msgServer) RemoveAuthenticator(ctx context.Context, msg *types.MsgRemoveAuthenticator) (*types.MsgRemoveAuthenticatorResponse, error) {
+	addr, err := ms.ak.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender address: %w", err)
+	}
+
+	if err := ms.ak.RemoveAuthenticator(ctx, addr, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveAuthenticatorResponse{}, nil
+}
+
+// RotatePubKey replaces the public key of the signing account.
+func (ms msgServer) RotatePubKey(ctx context.Context, msg *types.MsgRotatePubKey) (*types.MsgRotatePubKeyResponse, error) {
+	addr, err := ms.ak.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender address: %w", err)
+	}
+
+	if msg.NewPubKey == nil {
+		return nil, sdkerrors.ErrInvalidPubKey.Wrap("new public key cannot be empty")
+	}
+	newPubKey, ok := msg.NewPubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidPubKey.Wrapf("expected %T, got %T", (cryptotypes.PubKey)(nil), msg.NewPubKey.GetCachedValue())
+	}
+
+	id, err := ms.ak.RotatePubKey(ctx, addr, newPubKey, msg.Proof)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRotatePubKeyResponse{RotationId: id}, nil
+}
+
+// UpdateMsgSurchargeParams replaces the surcharges applied to the messages of
+// given types.
+func (ms msgServer) UpdateMsgSurchargeParams(ctx context.Context, msg *types.MsgUpdateMsgSurchargeParams) (*types.MsgUpdateMsgSurchargeParamsResponse, error) {
+	if ms.ak.authority != msg.Authority {
+		return nil, fmt.Errorf(
+			"expected authority account as only signer for proposal message; invalid authority; expected %s, got %s",
+			ms.ak.authority, msg.Authority)
+	}
+
+	if err := ms.ak.SetMsgSurchargeParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateMsgSurchargeParamsResponse{}, nil
+}
+
+// UpdateFeeRoutingParams replaces the routes splitting the transaction fees
+// between sinks.
+func (ms msgServer) UpdateFeeRoutingParams(ctx context.Context, msg *types.MsgUpdateFeeRoutingParams) (*types.MsgUpdateFeeRoutingParamsResponse, error) {
+	if ms.ak.authority != msg.Authority {
+		return nil, fmt.Errorf(
+			"expected authority account as only signer for proposal message; invalid authority; expected %s, got %s",
+			ms.ak.authority, msg.Authority)
+	}
+
+	if err := ms.ak.SetFeeRoutingParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateFeeRoutingParamsResponse{}, nil
+}
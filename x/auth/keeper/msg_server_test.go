@@ -1,7 +1,19 @@
 package keeper_test
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"strings"
+	"time"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/auth/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func (s *KeeperTestSuite) TestUpdateParams() {
@@ -109,3 +121,630 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestUpdateGasPricingPolicy() {
+	policy := types.GasPricingPolicy{
+		Enabled:        true,
+		MinGasPrices:   sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 1)),
+		ActivityWindow: 100,
+		Tiers: []types.GasPricingTier{
+			{MinAccountAge: 1000, MinRecentTxs: 5, FeeMultiplier: math.LegacyNewDecWithPrec(8, 1)},
+			{FeeMultiplier: math.LegacyNewDec(2)},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgUpdateGasPricingPolicy
+		expectErr bool
+		expErrMsg string
+	}{
+		{
+			name: "set invalid authority",
+			req: &types.MsgUpdateGasPricingPolicy{
+				Authority: "foo",
+				Policy:    policy,
+			},
+			expectErr: true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "set enabled policy without min gas prices",
+			req: &types.MsgUpdateGasPricingPolicy{
+				Authority: s.accountKeeper.GetAuthority(),
+				Policy:    types.GasPricingPolicy{Enabled: true, ActivityWindow: 100},
+			},
+			expectErr: true,
+			expErrMsg: "gas pricing min gas prices cannot be empty",
+		},
+		{
+			name: "set zero fee multiplier",
+			req: &types.MsgUpdateGasPricingPolicy{
+				Authority: s.accountKeeper.GetAuthority(),
+				Policy: types.GasPricingPolicy{
+					MinGasPrices: policy.MinGasPrices,
+					Tiers:        []types.GasPricingTier{{FeeMultiplier: math.LegacyZeroDec()}},
+				},
+			},
+			expectErr: true,
+			expErrMsg: "fee multiplier must be positive",
+		},
+		{
+			name: "set full valid policy",
+			req: &types.MsgUpdateGasPricingPolicy{
+				Authority: s.accountKeeper.GetAuthority(),
+				Policy:    policy,
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateGasPricingPolicy(s.ctx, tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	stored, err := s.accountKeeper.GetGasPricingPolicy(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(policy, stored)
+}
+
+func (s *KeeperTestSuite) TestUpdateFeeMarketParams() {
+	params := types.FeeMarketParams{
+		Enabled:                  true,
+		Denom:                    "stake",
+		MinBaseFee:               math.LegacyNewDecWithPrec(25, 4),
+		TargetBlockGas:           15_000_000,
+		BaseFeeChangeDenominator: 8,
+		BaseFeeRecipient:         "mint",
+	}
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgUpdateFeeMarketParams
+		expectErr bool
+		expErrMsg string
+	}{
+		{
+			name: "set invalid authority",
+			req: &types.MsgUpdateFeeMarketParams{
+				Authority: "foo",
+				Params:    params,
+			},
+			expectErr: true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "set enabled params without target block gas",
+			req: &types.MsgUpdateFeeMarketParams{
+				Authority: s.accountKeeper.GetAuthority(),
+				Params:    types.FeeMarketParams{Enabled: true, Denom: "stake", BaseFeeChangeDenominator: 8},
+			},
+			expectErr: true,
+			expErrMsg: "fee market target block gas cannot be zero",
+		},
+		{
+			name: "set enabled params without min base fee",
+			req: &types.MsgUpdateFeeMarketParams{
+				Authority: s.accountKeeper.GetAuthority(),
+				Params:    types.FeeMarketParams{Enabled: true, Denom: "stake", TargetBlockGas: 15_000_000, BaseFeeChangeDenominator: 8},
+			},
+			expectErr: true,
+			expErrMsg: "fee market min base fee must be positive",
+		},
+		{
+			name: "set unknown base fee recipient",
+			req: &types.MsgUpdateFeeMarketParams{
+				Authority: s.accountKeeper.GetAuthority(),
+				Params: types.FeeMarketParams{
+					Enabled:                  true,
+					Denom:                    "stake",
+					MinBaseFee:               math.LegacyNewDecWithPrec(25, 4),
+					TargetBlockGas:           15_000_000,
+					BaseFeeChangeDenominator: 8,
+					BaseFeeRecipient:         "unknown",
+				},
+			},
+			expectErr: true,
+			expErrMsg: "is not a module account",
+		},
+		{
+			name: "burn base fees without the burner permission",
+			req: &types.MsgUpdateFeeMarketParams{
+				Authority: s.accountKeeper.GetAuthority(),
+				Params: types.FeeMarketParams{
+					Enabled:                  true,
+					Denom:                    "stake",
+					MinBaseFee:               math.LegacyNewDecWithPrec(25, 4),
+					TargetBlockGas:           15_000_000,
+					BaseFeeChangeDenominator: 8,
+				},
+			},
+			expectErr: true,
+			expErrMsg: "must have the burner permission",
+		},
+		{
+			name: "set full valid params",
+			req: &types.MsgUpdateFeeMarketParams{
+				Authority: s.accountKeeper.GetAuthority(),
+				Params:    params,
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateFeeMarketParams(s.ctx, tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	stored, err := s.accountKeeper.GetFeeMarketParams(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(params, stored)
+}
+
+func (s *KeeperTestSuite) TestUpdateFeeAbstractionParams() {
+	params := types.FeeAbstractionParams{
+		Enabled:        true,
+		NativeDenom:    "stake",
+		Reserve:        "mint",
+		AcceptedDenoms: []types.AcceptedFeeDenom{{Denom: "usdc", ConversionRate: math.LegacyNewDecWithPrec(25, 1)}},
+	}
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgUpdateFeeAbstractionParams
+		expectErr bool
+		expErrMsg string
+	}{
+		{
+			name: "set invalid authority",
+			req: &types.MsgUpdateFeeAbstractionParams{
+				Authority: "foo",
+				Params:    params,
+			},
+			expectErr: true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "accept the native denom",
+			req: &types.MsgUpdateFeeAbstractionParams{
+				Authority: s.accountKeeper.GetAuthority(),
+				Params: types.FeeAbstractionParams{
+					Enabled:        true,
+					NativeDenom:    "stake",
+					Reserve:        "mint",
+					AcceptedDenoms: []types.AcceptedFeeDenom{{Denom: "stake", ConversionRate: math.LegacyOneDec()}},
+				},
+			},
+			expectErr: true,
+			expErrMsg: "native denom",
+		},
+		{
+			name: "set unknown reserve",
+			req: &types.MsgUpdateFeeAbstractionParams{
+				Authority: s.accountKeeper.GetAuthority(),
+				Params: types.FeeAbstractionParams{
+					Enabled:     true,
+					NativeDenom: "stake",
+					Reserve:     "unknown",
+				},
+			},
+			expectErr: true,
+			expErrMsg: "is not a module account",
+		},
+		{
+			name: "set full valid params",
+			req: &types.MsgUpdateFeeAbstractionParams{
+				Authority: s.accountKeeper.GetAuthority(),
+				Params:    params,
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateFeeAbstractionParams(s.ctx, tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	stored, err := s.accountKeeper.GetFeeAbstractionParams(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(params, stored)
+}
+
+func (s *KeeperTestSuite) TestUpdateMsgSurchargeParams() {
+	params := types.MsgSurchargeParams{
+		Surcharges: []types.MsgSurcharge{
+			{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgMultiSend", Gas: 50_000, Fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgUpdateMsgSurchargeParams
+		expectErr bool
+		expErrMsg string
+	}{
+		{
+			name: "set invalid authority",
+			req: &types.MsgUpdateMsgSurchargeParams{
+				Authority: "foo",
+				Params:    params,
+			},
+			expectErr: true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "set empty surcharge",
+			req: &types.MsgUpdateMsgSurchargeParams{
+				Authority: s.accountKeeper.GetAuthority(),
+				Params: types.MsgSurchargeParams{
+					Surcharges: []types.MsgSurcharge{{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgMultiSend"}},
+				},
+			},
+			expectErr: true,
+			expErrMsg: "must have a gas or a fee",
+		},
+		{
+			name: "set full valid params",
+			req: &types.MsgUpdateMsgSurchargeParams{
+				Authority: s.accountKeeper.GetAuthority(),
+				Params:    params,
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateMsgSurchargeParams(s.ctx, tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	res, err := s.queryClient.MsgSurchargeParams(s.ctx, &types.QueryMsgSurchargeParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, res.Params)
+}
+
+func (s *KeeperTestSuite) TestSetProfile() {
+	avatarHash := sha256.Sum256([]byte("avatar"))
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgSetProfile
+		expectErr bool
+		expErrMsg string
+	}{
+		{
+			name:      "invalid address",
+			req:       &types.MsgSetProfile{Address: "foo", DisplayName: "alice"},
+			expectErr: true,
+			expErrMsg: "invalid address",
+		},
+		{
+			name:      "display name too long",
+			req:       &types.MsgSetProfile{Address: addrStr, DisplayName: strings.Repeat("a", types.MaxProfileDisplayNameLength+1)},
+			expectErr: true,
+			expErrMsg: "profile display name cannot be longer than",
+		},
+		{
+			name:      "display name with control characters",
+			req:       &types.MsgSetProfile{Address: addrStr, DisplayName: "ali\nce"},
+			expectErr: true,
+			expErrMsg: "profile display name cannot contain control characters",
+		},
+		{
+			name:      "avatar hash not a SHA-256 hash",
+			req:       &types.MsgSetProfile{Address: addrStr, AvatarHash: bytes.Repeat([]byte{1}, 20)},
+			expectErr: true,
+			expErrMsg: "profile avatar hash must be a 32 bytes SHA-256 hash",
+		},
+		{
+			name:      "valid profile",
+			req:       &types.MsgSetProfile{Address: addrStr, DisplayName: "alice", AvatarHash: avatarHash[:]},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.SetProfile(s.ctx, tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	profile, found, err := s.accountKeeper.GetProfile(s.ctx, addrBytes)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(types.AccountProfile{Address: addrStr, DisplayName: "alice", AvatarHash: avatarHash[:]}, profile)
+
+	// an empty profile clears the profile of the account
+	_, err = s.msgServer.SetProfile(s.ctx, &types.MsgSetProfile{Address: addrStr})
+	s.Require().NoError(err)
+
+	_, found, err = s.accountKeeper.GetProfile(s.ctx, addrBytes)
+	s.Require().NoError(err)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestAddRemoveAuthenticator() {
+	pubKey, err := codectypes.NewAnyWithValue(secp256k1.GenPrivKey().PubKey())
+	s.Require().NoError(err)
+	authenticator := types.Authenticator{
+		PubKeys:        []*codectypes.Any{pubKey},
+		SpendingPolicy: &types.SpendingPolicy{Limit: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), Period: time.Hour},
+	}
+
+	_, err = s.msgServer.AddAuthenticator(s.ctx, &types.MsgAddAuthenticator{Sender: addrStr, Authenticator: authenticator})
+	s.Require().ErrorContains(err, "does not exist")
+
+	s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccountWithAddress(s.ctx, addrBytes))
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgAddAuthenticator
+		expectErr bool
+		expErrMsg string
+	}{
+		{
+			name:      "invalid sender",
+			req:       &types.MsgAddAuthenticator{Sender: "foo", Authenticator: authenticator},
+			expectErr: true,
+			expErrMsg: "invalid sender address",
+		},
+		{
+			name:      "invalid authenticator",
+			req:       &types.MsgAddAuthenticator{Sender: addrStr},
+			expectErr: true,
+			expErrMsg: "either public keys or a verifier",
+		},
+		{
+			name:      "unknown verifier",
+			req:       &types.MsgAddAuthenticator{Sender: addrStr, Authenticator: types.Authenticator{Verifier: "passkey"}},
+			expectErr: true,
+			expErrMsg: "unknown authenticator verifier",
+		},
+		{
+			name:      "valid authenticator",
+			req:       &types.MsgAddAuthenticator{Sender: addrStr, Authenticator: authenticator},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.AddAuthenticator(s.ctx, tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	authenticators, err := s.accountKeeper.GetAuthenticators(s.ctx, addrBytes)
+	s.Require().NoError(err)
+	s.Require().Len(authenticators, 1)
+	s.Require().True(authenticators[0].HasPubKey(pubKey.GetCachedValue().(cryptotypes.PubKey)))
+
+	// the spending policy is enforced from the registration
+	addr := sdk.AccAddress(addrBytes)
+	id := authenticators[0].Id
+	s.Require().NoError(s.accountKeeper.RecordAuthenticatorSpending(s.ctx, addr, id, sdk.NewCoins(sdk.NewInt64Coin("stake", 60))))
+	s.Require().ErrorContains(s.accountKeeper.RecordAuthenticatorSpending(s.ctx, addr, id, sdk.NewCoins(sdk.NewInt64Coin("stake", 60))), "spending policy limit exceeded")
+
+	_, err = s.msgServer.RemoveAuthenticator(s.ctx, &types.MsgRemoveAuthenticator{Sender: addrStr, Id: id + 1})
+	s.Require().ErrorContains(err, "not found")
+
+	_, err = s.msgServer.RemoveAuthenticator(s.ctx, &types.MsgRemoveAuthenticator{Sender: addrStr, Id: id})
+	s.Require().NoError(err)
+
+	authenticators, err = s.accountKeeper.GetAuthenticators(s.ctx, addrBytes)
+	s.Require().NoError(err)
+	s.Require().Empty(authenticators)
+}
+
+func (s *KeeperTestSuite) TestRotatePubKey() {
+	ctx := s.ctx.WithHeaderInfo(header.Info{ChainID: "test-chain", Height: 5, Time: time.Unix(1000, 0).UTC()})
+	oldPrivKey, newPrivKey := secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	newPubKey, err := codectypes.NewAnyWithValue(newPrivKey.PubKey())
+	s.Require().NoError(err)
+
+	acc := s.accountKeeper.NewAccountWithAddress(ctx, addrBytes)
+	s.accountKeeper.SetAccount(ctx, acc)
+
+	_, err = s.msgServer.RotatePubKey(ctx, &types.MsgRotatePubKey{Sender: addrStr, NewPubKey: newPubKey})
+	s.Require().ErrorContains(err, "has no public key to rotate")
+
+	s.Require().NoError(acc.SetPubKey(oldPrivKey.PubKey()))
+	s.Require().NoError(acc.SetSequence(7))
+	s.accountKeeper.SetAccount(ctx, acc)
+
+	proof, err := newPrivKey.Sign(types.PubKeyRotationProofBytes("test-chain", addrStr, acc.GetAccountNumber(), oldPrivKey.PubKey()))
+	s.Require().NoError(err)
+	otherChainProof, err := newPrivKey.Sign(types.PubKeyRotationProofBytes("other-chain", addrStr, acc.GetAccountNumber(), oldPrivKey.PubKey()))
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgRotatePubKey
+		expErrMsg string
+	}{
+		{
+			name:      "invalid sender",
+			req:       &types.MsgRotatePubKey{Sender: "foo", NewPubKey: newPubKey, Proof: proof},
+			expErrMsg: "invalid sender address",
+		},
+		{
+			name:      "missing new public key",
+			req:       &types.MsgRotatePubKey{Sender: addrStr, Proof: proof},
+			expErrMsg: "new public key cannot be empty",
+		},
+		{
+			name:      "proof of another chain",
+			req:       &types.MsgRotatePubKey{Sender: addrStr, NewPubKey: newPubKey, Proof: otherChainProof},
+			expErrMsg: "invalid proof",
+		},
+		{
+			name: "valid rotation",
+			req:  &types.MsgRotatePubKey{Sender: addrStr, NewPubKey: newPubKey, Proof: proof},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.RotatePubKey(ctx, tc.req)
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	// the address, account number and sequence are kept
+	rotated := s.accountKeeper.GetAccount(ctx, addrBytes)
+	s.Require().True(rotated.GetPubKey().Equals(newPrivKey.PubKey()))
+	s.Require().Equal(acc.GetAccountNumber(), rotated.GetAccountNumber())
+	s.Require().Equal(uint64(7), rotated.GetSequence())
+
+	// the proof can't be replayed once the key is rotated
+	_, err = s.msgServer.RotatePubKey(ctx, &types.MsgRotatePubKey{Sender: addrStr, NewPubKey: newPubKey, Proof: proof})
+	s.Require().ErrorContains(err, "must differ from the current one")
+
+	res, err := s.queryClient.PubKeyRotations(ctx, &types.QueryPubKeyRotationsRequest{Address: addrStr})
+	s.Require().NoError(err)
+	s.Require().Len(res.Rotations, 1)
+	s.Require().Equal(addrStr, res.Rotations[0].Address)
+	s.Require().Equal(int64(5), res.Rotations[0].Height)
+	s.Require().Equal(newPubKey.Value, res.Rotations[0].NewPubKey.Value)
+
+	genState, err := s.accountKeeper.ExportGenesis(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(genState.PubKeyRotations, 1)
+}
+
+func (s *KeeperTestSuite) TestUpdateFeeRoutingParams() {
+	params := types.FeeRoutingParams{
+		Routes: []types.FeeRoute{
+			{Recipient: types.FeeCollectorName, Share: math.LegacyNewDecWithPrec(8, 1)},
+			{Recipient: "mint", Share: math.LegacyNewDecWithPrec(2, 1)},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgUpdateFeeRoutingParams
+		expectErr bool
+		expErrMsg string
+	}{
+		{
+			name: "set invalid authority",
+			req: &types.MsgUpdateFeeRoutingParams{
+				Authority: "foo",
+				Params:    params,
+			},
+			expectErr: true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "set shares not adding up to one",
+			req: &types.MsgUpdateFeeRoutingParams{
+				Authority: s.accountKeeper.GetAuthority(),
+				Params: types.FeeRoutingParams{
+					Routes: []types.FeeRoute{{Recipient: "mint", Share: math.LegacyNewDecWithPrec(2, 1)}},
+				},
+			},
+			expectErr: true,
+			expErrMsg: "add up to one",
+		},
+		{
+			name: "set recipient not a module account",
+			req: &types.MsgUpdateFeeRoutingParams{
+				Authority: s.accountKeeper.GetAuthority(),
+				Params: types.FeeRoutingParams{
+					Routes: []types.FeeRoute{{Recipient: "foo", Share: math.LegacyOneDec()}},
+				},
+			},
+			expectErr: true,
+			expErrMsg: "is not a module account",
+		},
+		{
+			name: "set burn route without the burner permission",
+			req: &types.MsgUpdateFeeRoutingParams{
+				Authority: s.accountKeeper.GetAuthority(),
+				Params: types.FeeRoutingParams{
+					DenomRoutes: []types.DenomFeeRoutes{{Denom: "stake", Routes: []types.FeeRoute{{Share: math.LegacyOneDec()}}}},
+				},
+			},
+			expectErr: true,
+			expErrMsg: "permission to burn the routed fees",
+		},
+		{
+			name: "set full valid params",
+			req: &types.MsgUpdateFeeRoutingParams{
+				Authority: s.accountKeeper.GetAuthority(),
+				Params:    params,
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateFeeRoutingParams(s.ctx, tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	res, err := s.queryClient.FeeRoutingParams(s.ctx, &types.QueryFeeRoutingParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, res.Params)
+}
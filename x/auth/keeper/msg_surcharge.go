@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/auth/types"
+)
+
+// GetMsgSurchargeParams returns the message surcharge parameters, empty if none
+// were set.
+func (ak AccountKeeper) GetMsgSurchargeParams(ctx context.Context) (types.MsgSurchargeParams, error) {
+	params, err := ak.MsgSurchargeParams.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.MsgSurchargeParams{}, err
+	}
+
+	return params, nil
+}
+
+// SetMsgSurchargeParams validates and sets the message surcharge parameters.
+func (ak AccountKeeper) SetMsgSurchargeParams(ctx context.Context, params types.MsgSurchargeParams) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	return ak.MsgSurchargeParams.Set(ctx, params)
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetProfile returns the profile of an account, and false if the account has
+// no profile.
+func (ak AccountKeeper) GetProfile(ctx context.Context, addr sdk.AccAddress) (types.AccountProfile, bool, error) {
+	profile, err := ak.Profiles.Get(ctx, addr)
+	switch {
+	case err == nil:
+		return profile, true, nil
+	case errors.Is(err, collections.ErrNotFound):
+		return types.AccountProfile{}, false, nil
+	default:
+		return types.AccountProfile{}, false, err
+	}
+}
+
+// SetProfile sets the profile of an account, or clears it when both the display
+// name and the avatar hash are empty.
+func (ak AccountKeeper) SetProfile(ctx context.Context, addr sdk.AccAddress, displayName string, avatarHash []byte) error {
+	addrStr, err := ak.addressCodec.BytesToString(addr)
+	if err != nil {
+		return err
+	}
+
+	profile := types.AccountProfile{
+		Address:     addrStr,
+		DisplayName: displayName,
+		AvatarHash:  avatarHash,
+	}
+	if profile.IsEmpty() {
+		if err := ak.Profiles.Remove(ctx, addr); err != nil {
+			return err
+		}
+
+		return ak.Environment.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeClearProfile,
+			event.NewAttribute(types.AttributeKeyAddress, addrStr),
+		)
+	}
+
+	if err := profile.Validate(); err != nil {
+		return err
+	}
+
+	if err := ak.Profiles.Set(ctx, addr, profile); err != nil {
+		return err
+	}
+
+	return ak.Environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSetProfile,
+		event.NewAttribute(types.AttributeKeyAddress, addrStr),
+		event.NewAttribute(types.AttributeKeyDisplayName, displayName),
+		event.NewAttribute(types.AttributeKeyAvatarHash, hex.EncodeToString(avatarHash)),
+	)
+}
+
+// GetAllProfiles returns all the account profiles.
+func (ak AccountKeeper) GetAllProfiles(ctx context.Context) ([]types.AccountProfile, error) {
+	var profiles []types.AccountProfile
+	err := ak.Profiles.Walk(ctx, nil, func(_ sdk.AccAddress, profile types.AccountProfile) (stop bool, err error) {
+		profiles = append(profiles, profile)
+		return false, nil
+	})
+
+	return profiles, err
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/auth/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// RotatePubKey replaces the public key of an account, keeping its address,
+// account number and sequence, and returns the id of the recorded rotation. The
+// proof is the signature by the new public key of the rotation proof bytes.
+func (ak AccountKeeper) RotatePubKey(ctx context.Context, addr sdk.AccAddress, newPubKey cryptotypes.PubKey, proof []byte) (uint64, error) {
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return 0, sdkerrors.ErrUnknownAddress.Wrapf("account %s does not exist", addr)
+	}
+	if _, ok := acc.(sdk.ModuleAccountI); ok {
+		return 0, sdkerrors.ErrUnauthorized.Wrapf("module account %s cannot rotate its public key", addr)
+	}
+
+	oldPubKey := acc.GetPubKey()
+	if oldPubKey == nil {
+		return 0, sdkerrors.ErrInvalidPubKey.Wrapf("account %s has no public key to rotate", addr)
+	}
+	if newPubKey == nil {
+		return 0, sdkerrors.ErrInvalidPubKey.Wrap("new public key cannot be empty")
+	}
+	if newPubKey.Equals(oldPubKey) {
+		return 0, sdkerrors.ErrInvalidPubKey.Wrap("new public key must differ from the current one")
+	}
+
+	addrStr, err := ak.addressCodec.BytesToString(addr)
+	if err != nil {
+		return 0, err
+	}
+
+	headerInfo := ak.Environment.HeaderService.GetHeaderInfo(ctx)
+	proofBytes := types.PubKeyRotationProofBytes(headerInfo.ChainID, addrStr, acc.GetAccountNumber(), oldPubKey)
+	if !newPubKey.VerifySignature(proofBytes, proof) {
+		return 0, sdkerrors.ErrUnauthorized.Wrap("invalid proof of ownership of the new public key")
+	}
+
+	if err := acc.SetPubKey(newPubKey); err != nil {
+		return 0, err
+	}
+	ak.SetAccount(ctx, acc)
+
+	oldAny, err := codectypes.NewAnyWithValue(oldPubKey)
+	if err != nil {
+		return 0, err
+	}
+	newAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := ak.PubKeyRotationSequence.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	rotation := types.PubKeyRotation{
+		Id:        id,
+		Address:   addrStr,
+		OldPubKey: oldAny,
+		NewPubKey: newAny,
+		Height:    headerInfo.Height,
+		Time:      headerInfo.Time,
+	}
+	if err := ak.PubKeyRotations.Set(ctx, collections.Join(addr, id), rotation); err != nil {
+		return 0, err
+	}
+
+	return id, ak.Environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeRotatePubKey,
+		event.NewAttribute(types.AttributeKeyAddress, addrStr),
+		event.NewAttribute(types.AttributeKeyRotationID, strconv.FormatUint(id, 10)),
+	)
+}
+
+// GetPubKeyRotations returns the public key rotations of an account, oldest
+// first.
+func (ak AccountKeeper) GetPubKeyRotations(ctx context.Context, addr sdk.AccAddress) ([]types.PubKeyRotation, error) {
+	var rotations []types.PubKeyRotation
+	err := ak.PubKeyRotations.Walk(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, uint64](addr), func(_ collections.Pair[sdk.AccAddress, uint64], r types.PubKeyRotation) (stop bool, err error) {
+		rotations = append(rotations, r)
+		return false, nil
+	})
+
+	return rotations, err
+}
+
+// GetAllPubKeyRotations returns the public key rotations of all the accounts.
+func (ak AccountKeeper) GetAllPubKeyRotations(ctx context.Context) ([]types.PubKeyRotation, error) {
+	var rotations []types.PubKeyRotation
+	err := ak.PubKeyRotations.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, uint64], r types.PubKeyRotation) (stop bool, err error) {
+		rotations = append(rotations, r)
+		return false, nil
+	})
+
+	return rotations, err
+}
@@ -0,0 +1,59 @@
+package posthandler
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FeeMarketKeeper defines the contract needed to refund the gas left unused by
+// the transactions to the gas used by the blocks.
+type FeeMarketKeeper interface {
+	GetFeeMarketParams(ctx context.Context) (types.FeeMarketParams, error)
+	RefundBlockGas(ctx context.Context, gas uint64) error
+}
+
+// FeeMarketDecorator refunds the gas left unused by a successful transaction to
+// the gas used by the block, for the base fee of the next block to be computed
+// from the gas consumed by the transactions. The ante FeeMarketDecorator records
+// their gas limit, which is kept for the failed transactions.
+type FeeMarketDecorator struct {
+	fmk FeeMarketKeeper
+}
+
+func NewFeeMarketDecorator(fmk FeeMarketKeeper) FeeMarketDecorator {
+	return FeeMarketDecorator{
+		fmk: fmk,
+	}
+}
+
+func (fmd FeeMarketDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if !success || fmd.fmk == nil || ctx.ExecMode() != sdk.ExecModeFinalize || ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate, success)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	params, err := fmd.fmk.GetFeeMarketParams(ctx)
+	if err != nil {
+		return ctx, err
+	}
+	if !params.Enabled {
+		return next(ctx, tx, simulate, success)
+	}
+
+	if consumed := ctx.GasMeter().GasConsumed(); consumed < feeTx.GetGas() {
+		if err := fmd.fmk.RefundBlockGas(ctx, feeTx.GetGas()-consumed); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate, success)
+}
@@ -5,11 +5,25 @@ import (
 )
 
 // HandlerOptions are the options required for constructing a default SDK PostHandler.
-type HandlerOptions struct{}
+type HandlerOptions struct {
+	FeeMarketKeeper    FeeMarketKeeper
+	SmartAccountKeeper SmartAccountKeeper
+	BankKeeper         BankKeeper
+}
 
-// NewPostHandler returns an empty PostHandler chain.
-func NewPostHandler(_ HandlerOptions) (sdk.PostHandler, error) {
+// NewPostHandler returns a PostHandler chain refunding the unused gas of the
+// transactions to the fee market and enforcing the spending policies of the
+// smart accounts, when the corresponding keepers are set.
+func NewPostHandler(options HandlerOptions) (sdk.PostHandler, error) {
 	postDecorators := []sdk.PostDecorator{}
 
+	if options.FeeMarketKeeper != nil {
+		postDecorators = append(postDecorators, NewFeeMarketDecorator(options.FeeMarketKeeper))
+	}
+
+	if options.SmartAccountKeeper != nil {
+		postDecorators = append(postDecorators, NewSpendingPolicyDecorator(options.SmartAccountKeeper, options.BankKeeper))
+	}
+
 	return sdk.ChainPostDecorators(postDecorators...), nil
 }
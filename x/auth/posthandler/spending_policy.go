@@ -0,0 +1,69 @@
+package posthandler
+
+import (
+	"context"
+
+	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SmartAccountKeeper defines the contract needed to record the coins spent by the
+// smart accounts under the spending policies of their authenticators.
+type SmartAccountKeeper interface {
+	RecordAuthenticatorSpending(ctx context.Context, addr sdk.AccAddress, id uint64, spent sdk.Coins) error
+}
+
+// BankKeeper defines the contract needed to compute the coins spent by the smart
+// accounts.
+type BankKeeper interface {
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// SpendingPolicyDecorator enforces the spending policies of the authenticators
+// selected by the SigVerificationDecorator. The coins spent by a smart account
+// are the decrease of its balances over the tx execution, fees included, and the
+// tx fails if they exceed the limit of the spending policy for its period.
+type SpendingPolicyDecorator struct {
+	sak SmartAccountKeeper
+	bk  BankKeeper
+}
+
+func NewSpendingPolicyDecorator(sak SmartAccountKeeper, bk BankKeeper) SpendingPolicyDecorator {
+	return SpendingPolicyDecorator{
+		sak: sak,
+		bk:  bk,
+	}
+}
+
+func (spd SpendingPolicyDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if !success || spd.sak == nil || spd.bk == nil {
+		return next(ctx, tx, simulate, success)
+	}
+
+	for _, selected := range types.SelectedAuthenticators(ctx) {
+		balance := spd.bk.GetAllBalances(ctx, selected.Address)
+		spent, _ := selected.Balance.SafeSub(balance...)
+		spent = positiveCoins(spent)
+		if spent.IsZero() {
+			continue
+		}
+
+		if err := spd.sak.RecordAuthenticatorSpending(ctx, selected.Address, selected.ID, spent); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate, success)
+}
+
+// positiveCoins returns the positive coins of the given coins.
+func positiveCoins(coins sdk.Coins) sdk.Coins {
+	var positive sdk.Coins
+	for _, coin := range coins {
+		if coin.IsPositive() {
+			positive = append(positive, coin)
+		}
+	}
+	return positive
+}
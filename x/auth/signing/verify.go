@@ -102,3 +102,20 @@ func VerifySignature(
 		return fmt.Errorf("unexpected SignatureData %T", signatureData)
 	}
 }
+
+// GetSignBytes returns the bytes signed by a single signature, abstracting over
+// the different signing modes.
+func GetSignBytes(
+	ctx context.Context,
+	signerData txsigning.SignerData,
+	signatureData *signing.SingleSignatureData,
+	handler *txsigning.HandlerMap,
+	txData txsigning.TxData,
+) ([]byte, error) {
+	signMode, err := internalSignModeToAPI(signatureData.SignMode)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.GetSignBytes(ctx, signMode, signerData, txData)
+}
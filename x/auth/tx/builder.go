@@ -2,9 +2,11 @@ package tx
 
 import (
 	"fmt"
+	"time"
 
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	multisigv1beta1 "cosmossdk.io/api/cosmos/crypto/multisig/v1beta1"
@@ -58,6 +60,7 @@ func newBuilderFromDecodedTx(addrCodec address.Codec, decoder *decode.Decoder, c
 		codec:                       codec,
 		msgs:                        decoded.msgsV1,
 		timeoutHeight:               decoded.GetTimeoutHeight(),
+		timeoutTimestamp:            decoded.GetTimeoutTimeStamp(),
 		granter:                     decoded.FeeGranter(),
 		payer:                       payer,
 		unordered:                   decoded.GetUnordered(),
@@ -76,16 +79,17 @@ type builder struct {
 	decoder      *decode.Decoder
 	codec        codec.BinaryCodec
 
-	msgs          []sdk.Msg
-	timeoutHeight uint64
-	granter       []byte
-	payer         []byte
-	unordered     bool
-	memo          string
-	gasLimit      uint64
-	fees          sdk.Coins
-	signerInfos   []*tx.SignerInfo
-	signatures    [][]byte
+	msgs             []sdk.Msg
+	timeoutHeight    uint64
+	timeoutTimestamp time.Time
+	granter          []byte
+	payer            []byte
+	unordered        bool
+	memo             string
+	gasLimit         uint64
+	fees             sdk.Coins
+	signerInfos      []*tx.SignerInfo
+	signatures       [][]byte
 
 	extensionOptions            []*codectypes.Any
 	nonCriticalExtensionOptions []*codectypes.Any
@@ -116,6 +120,9 @@ func (w *builder) getTx() (*gogoTxWrapper, error) {
 		ExtensionOptions:            intoAnyV2(w.extensionOptions),
 		NonCriticalExtensionOptions: intoAnyV2(w.nonCriticalExtensionOptions),
 	}
+	if !w.timeoutTimestamp.IsZero() {
+		body.TimeoutTimestamp = timestamppb.New(w.timeoutTimestamp)
+	}
 
 	fee, err := w.getFee()
 	if err != nil {
@@ -188,6 +195,9 @@ func (w *builder) SetTimeoutHeight(height uint64) { w.timeoutHeight = height }
 
 func (w *builder) SetUnordered(v bool) { w.unordered = v }
 
+// SetTimeoutTimestamp sets the transaction's timeout timestamp.
+func (w *builder) SetTimeoutTimestamp(timestamp time.Time) { w.timeoutTimestamp = timestamp }
+
 func (w *builder) SetMemo(memo string) { w.memo = memo }
 
 func (w *builder) SetGasLimit(limit uint64) { w.gasLimit = limit }
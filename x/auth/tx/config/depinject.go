@@ -124,7 +124,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 			// likely to be a state-machine breaking change, which needs a coordinated
 			// upgrade.
 			postHandler, err := posthandler.NewPostHandler(
-				posthandler.HandlerOptions{},
+				newPostHandlerOptions(in),
 			)
 			if err != nil {
 				panic(err)
@@ -145,15 +145,46 @@ func newAnteHandler(txConfig client.TxConfig, in ModuleInputs) (sdk.AnteHandler,
 		return nil, fmt.Errorf("both AccountKeeper and BankKeeper are required")
 	}
 
-	anteHandler, err := ante.NewAnteHandler(
-		ante.HandlerOptions{
-			AccountKeeper:   in.AccountKeeper,
-			BankKeeper:      in.BankKeeper,
-			SignModeHandler: txConfig.SignModeHandler(),
-			FeegrantKeeper:  in.FeeGrantKeeper,
-			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
-		},
-	)
+	options := ante.HandlerOptions{
+		AccountKeeper:   in.AccountKeeper,
+		BankKeeper:      in.BankKeeper,
+		SignModeHandler: txConfig.SignModeHandler(),
+		FeegrantKeeper:  in.FeeGrantKeeper,
+		SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+	}
+
+	// the fees are converted when the account keeper supports fee abstraction
+	if fak, ok := in.AccountKeeper.(ante.FeeAbstractionKeeper); ok {
+		options.FeeAbstractionKeeper = fak
+	}
+
+	// the fee market is enforced when the account keeper supports it
+	if fmk, ok := in.AccountKeeper.(ante.FeeMarketKeeper); ok {
+		options.FeeMarketKeeper = fmk
+	}
+
+	// the fees are routed when the account keeper supports fee routing
+	if frk, ok := in.AccountKeeper.(ante.FeeRoutingKeeper); ok {
+		options.FeeRoutingKeeper = frk
+	}
+
+	// the tiered gas pricing policy is applied when the account keeper supports it
+	if gpk, ok := in.AccountKeeper.(ante.GasPricingKeeper); ok {
+		options.GasPricingKeeper = gpk
+	}
+
+	// the message surcharges are applied when the account keeper supports them
+	if msk, ok := in.AccountKeeper.(ante.MsgSurchargeKeeper); ok {
+		options.MsgSurchargeKeeper = msk
+	}
+
+	// the smart accounts are authenticated when the account keeper supports them
+	if sak, ok := in.AccountKeeper.(ante.SmartAccountKeeper); ok {
+		options.SmartAccountKeeper = sak
+		options.SpendingBankKeeper, _ = in.BankKeeper.(ante.SpendingBankKeeper)
+	}
+
+	anteHandler, err := ante.NewAnteHandler(options)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create ante handler: %w", err)
 	}
@@ -161,6 +192,22 @@ func newAnteHandler(txConfig client.TxConfig, in ModuleInputs) (sdk.AnteHandler,
 	return anteHandler, nil
 }
 
+// newPostHandlerOptions returns the options of the post handler, refunding the
+// unused gas to the fee market and enforcing the spending policies of the smart
+// accounts when the keepers support them.
+func newPostHandlerOptions(in ModuleInputs) posthandler.HandlerOptions {
+	var options posthandler.HandlerOptions
+	if fmk, ok := in.AccountKeeper.(posthandler.FeeMarketKeeper); ok {
+		options.FeeMarketKeeper = fmk
+	}
+	if sak, ok := in.AccountKeeper.(posthandler.SmartAccountKeeper); ok {
+		options.SmartAccountKeeper = sak
+		options.BankKeeper, _ = in.BankKeeper.(posthandler.BankKeeper)
+	}
+
+	return options
+}
+
 // NewBankKeeperCoinMetadataQueryFn creates a new Textual struct using the given
 // BankKeeper to retrieve coin metadata.
 //
@@ -4,21 +4,30 @@
 package types
 
 import (
+	bytes "bytes"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -244,398 +253,5914 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
-	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
-	proto.RegisterType((*ModuleCredential)(nil), "cosmos.auth.v1beta1.ModuleCredential")
-	proto.RegisterType((*Params)(nil), "cosmos.auth.v1beta1.Params")
+// GasPricingPolicy defines the tiered gas pricing policy, which adjusts the fees
+// required from a transaction based on the age and the recent activity of its fee
+// payer.
+type GasPricingPolicy struct {
+	// enabled defines whether the policy is enforced. The account activity is
+	// tracked even when the policy is disabled.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// min_gas_prices defines the base gas prices the fee multipliers apply to.
+	MinGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=min_gas_prices,json=minGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"min_gas_prices"`
+	// activity_window defines the number of blocks over which the recent
+	// activity of an account is counted.
+	ActivityWindow uint64 `protobuf:"varint,3,opt,name=activity_window,json=activityWindow,proto3" json:"activity_window,omitempty"`
+	// tiers defines the pricing tiers. The fee multiplier of the first tier the
+	// fee payer qualifies for applies, accounts qualifying for none pay the base
+	// gas prices.
+	Tiers []GasPricingTier `protobuf:"bytes,4,rep,name=tiers,proto3" json:"tiers"`
 }
 
-func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
-
-var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 728 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0x41, 0x4f, 0xe3, 0x46,
-	0x18, 0x8d, 0x93, 0x14, 0xca, 0x04, 0x68, 0x31, 0x29, 0x35, 0x51, 0x15, 0x9b, 0x48, 0x2d, 0x11,
-	0x2a, 0x4e, 0x13, 0x44, 0xa5, 0xe6, 0x46, 0xd2, 0xaa, 0x42, 0x14, 0x8a, 0x1c, 0x2d, 0x07, 0x2e,
-	0xd6, 0xd8, 0x1e, 0xcc, 0x28, 0xb1, 0xc7, 0xeb, 0x19, 0xa3, 0x98, 0xf3, 0x1e, 0xd0, 0x9e, 0x56,
-	0xfb, 0x0b, 0xd8, 0xfd, 0x05, 0x1c, 0xf8, 0x11, 0xab, 0x3d, 0xa1, 0xbd, 0xec, 0x9e, 0xa2, 0x55,
-	0x38, 0x80, 0x56, 0xfb, 0x23, 0x56, 0x9e, 0x71, 0x20, 0x41, 0xb9, 0x44, 0x9e, 0xf7, 0xde, 0xf7,
-	0x7d, 0xef, 0x7b, 0x9e, 0x18, 0x94, 0x6d, 0x42, 0x3d, 0x42, 0x6b, 0x30, 0x62, 0xa7, 0xb5, 0xb3,
-	0xba, 0x85, 0x18, 0xac, 0xf3, 0x83, 0x1e, 0x84, 0x84, 0x11, 0x79, 0x59, 0xf0, 0x3a, 0x87, 0x52,
-	0xbe, 0xb4, 0x04, 0x3d, 0xec, 0x93, 0x1a, 0xff, 0x15, 0xba, 0xd2, 0xaa, 0xd0, 0x99, 0xfc, 0x54,
-	0x4b, 0x8b, 0x04, 0x55, 0x74, 0x89, 0x4b, 0x04, 0x9e, 0x3c, 0x8d, 0x0a, 0x5c, 0x42, 0xdc, 0x1e,
-	0xaa, 0xf1, 0x93, 0x15, 0x9d, 0xd4, 0xa0, 0x1f, 0x0b, 0xaa, 0xf2, 0x26, 0x0b, 0x0a, 0x2d, 0x48,
-	0xd1, 0x8e, 0x6d, 0x93, 0xc8, 0x67, 0x72, 0x03, 0xcc, 0x42, 0xc7, 0x09, 0x11, 0xa5, 0x8a, 0xa4,
-	0x49, 0xd5, 0xb9, 0x96, 0xf2, 0xe1, 0x7a, 0xb3, 0x98, 0xce, 0xd8, 0x11, 0x4c, 0x87, 0x85, 0xd8,
-	0x77, 0x8d, 0x91, 0x50, 0x3e, 0x02, 0xb3, 0x41, 0x64, 0x99, 0x5d, 0x14, 0x2b, 0x59, 0x4d, 0xaa,
-	0x16, 0x1a, 0x45, 0x5d, 0x0c, 0xd4, 0x47, 0x03, 0xf5, 0x1d, 0x3f, 0x6e, 0xad, 0x7f, 0x19, 0xa8,
-	0xc5, 0x20, 0xb2, 0x7a, 0xd8, 0x4e, 0xb4, 0xbf, 0x13, 0x0f, 0x33, 0xe4, 0x05, 0x2c, 0x7e, 0x7b,
-	0x77, 0xb5, 0x01, 0x1e, 0x09, 0x63, 0x26, 0x88, 0xac, 0x3d, 0x14, 0xcb, 0xbf, 0x82, 0x45, 0x28,
-	0x6c, 0x99, 0x7e, 0xe4, 0x59, 0x28, 0x54, 0x72, 0x9a, 0x54, 0xcd, 0x1b, 0x0b, 0x29, 0x7a, 0xc0,
-	0x41, 0xb9, 0x04, 0xbe, 0xa7, 0xe8, 0x79, 0x84, 0x7c, 0x1b, 0x29, 0x79, 0x2e, 0x78, 0x38, 0x37,
-	0xdb, 0x17, 0x97, 0x6a, 0xe6, 0xfe, 0x52, 0xcd, 0xbc, 0xbf, 0xde, 0xfc, 0x65, 0x4a, 0xbc, 0x7a,
-	0xba, 0xf7, 0xee, 0xcb, 0xbb, 0xab, 0x8d, 0x15, 0x21, 0xd8, 0xa4, 0x4e, 0xb7, 0x36, 0x96, 0x49,
-	0xe5, 0xab, 0x04, 0x16, 0xf6, 0x89, 0x13, 0xf5, 0x1e, 0x52, 0xda, 0x05, 0xf3, 0x16, 0xa4, 0xc8,
-	0x4c, 0x8d, 0xf0, 0xa8, 0x0a, 0x0d, 0x4d, 0x9f, 0x36, 0x61, 0xac, 0x53, 0x2b, 0x7f, 0x33, 0x50,
-	0x25, 0xa3, 0x60, 0x8d, 0x05, 0x2e, 0x83, 0xbc, 0x0f, 0x3d, 0xc4, 0x93, 0x9b, 0x33, 0xf8, 0xb3,
-	0xac, 0x81, 0x42, 0x80, 0x42, 0x0f, 0x53, 0x8a, 0x89, 0x4f, 0x95, 0x9c, 0x96, 0xab, 0xce, 0x19,
-	0xe3, 0x50, 0xf3, 0xf8, 0x42, 0xec, 0x54, 0x99, 0x36, 0x71, 0xc2, 0x2b, 0xdf, 0x4c, 0x19, 0xdb,
-	0x6c, 0x82, 0x7d, 0x7d, 0x77, 0xb5, 0xb1, 0xe8, 0x71, 0x64, 0xb4, 0x4c, 0xe5, 0x85, 0x04, 0x7e,
-	0x14, 0xa2, 0x76, 0x88, 0x1c, 0xe4, 0x33, 0x0c, 0x7b, 0xb2, 0x0a, 0x0a, 0xa9, 0x8c, 0xbb, 0xe5,
-	0x77, 0xc3, 0x00, 0x02, 0x3a, 0x48, 0x3c, 0xaf, 0x83, 0x1f, 0x1c, 0x14, 0xe2, 0x33, 0xc8, 0x30,
-	0xf1, 0x93, 0xd7, 0x48, 0x95, 0xac, 0x96, 0xab, 0xce, 0x1b, 0x8b, 0x8f, 0xf0, 0x1e, 0x8a, 0x69,
-	0xf3, 0xb7, 0xc4, 0xd0, 0xda, 0x98, 0xa1, 0x7f, 0x43, 0x12, 0x05, 0xa9, 0x9f, 0xc7, 0x89, 0x95,
-	0x8f, 0x59, 0x30, 0x73, 0x08, 0x43, 0xe8, 0x51, 0x59, 0x07, 0xcb, 0x1e, 0xec, 0x9b, 0x1e, 0xf2,
-	0x88, 0x69, 0x9f, 0xc2, 0x10, 0xda, 0x0c, 0x85, 0xe2, 0x82, 0xe6, 0x8d, 0x25, 0x0f, 0xf6, 0xf7,
-	0x91, 0x47, 0xda, 0x0f, 0x84, 0xac, 0x81, 0x79, 0xd6, 0x37, 0x29, 0x76, 0xcd, 0x1e, 0xf6, 0x30,
-	0xe3, 0xd9, 0xe6, 0x0d, 0xc0, 0xfa, 0x1d, 0xec, 0xfe, 0x97, 0x20, 0xf2, 0x1f, 0xe0, 0x27, 0xae,
-	0x38, 0x47, 0xa6, 0x4d, 0x28, 0x33, 0x03, 0x14, 0x9a, 0x56, 0xcc, 0x50, 0x7a, 0xc3, 0x96, 0x12,
-	0xe9, 0x39, 0x6a, 0x13, 0xca, 0x0e, 0x51, 0xd8, 0x8a, 0x19, 0x92, 0xff, 0x07, 0x3f, 0x27, 0x0d,
-	0xcf, 0x50, 0x88, 0x4f, 0x62, 0x51, 0x84, 0x9c, 0xc6, 0xf6, 0x76, 0xfd, 0x2f, 0x71, 0xe9, 0x5a,
-	0xca, 0x70, 0xa0, 0x16, 0x3b, 0xd8, 0x3d, 0xe2, 0x8a, 0xa4, 0xf4, 0x9f, 0xbf, 0x39, 0x6f, 0x14,
-	0xe9, 0x04, 0x2a, 0xaa, 0xe4, 0x67, 0x60, 0xf5, 0x69, 0x43, 0x8a, 0xec, 0xa0, 0xb1, 0xfd, 0x67,
-	0xb7, 0xae, 0x7c, 0xc7, 0x5b, 0x96, 0x86, 0x03, 0x75, 0x65, 0xa2, 0x65, 0x67, 0xa4, 0x30, 0x56,
-	0xe8, 0x54, 0xbc, 0xb9, 0x76, 0x7f, 0xa9, 0x4a, 0x4f, 0xdf, 0x79, 0x5f, 0x7c, 0x73, 0x44, 0x9c,
-	0xad, 0xad, 0x77, 0xc3, 0xb2, 0x74, 0x33, 0x2c, 0x4b, 0x9f, 0x87, 0x65, 0xe9, 0xd5, 0x6d, 0x39,
-	0x73, 0x73, 0x5b, 0xce, 0x7c, 0xba, 0x2d, 0x67, 0x8e, 0xd3, 0x2f, 0x0b, 0x75, 0xba, 0x3a, 0x26,
-	0xa3, 0x2a, 0x16, 0x07, 0x88, 0x5a, 0x33, 0xfc, 0xbf, 0xbc, 0xf5, 0x2d, 0x00, 0x00, 0xff, 0xff,
-	0x78, 0xbd, 0x49, 0x53, 0xc5, 0x04, 0x00, 0x00,
+func (m *GasPricingPolicy) Reset()         { *m = GasPricingPolicy{} }
+func (m *GasPricingPolicy) String() string { return proto.CompactTextString(m) }
+func (*GasPricingPolicy) ProtoMessage()    {}
+func (*GasPricingPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{4}
 }
-
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *GasPricingPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPricingPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPricingPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.MaxMemoCharacters != that1.MaxMemoCharacters {
-		return false
-	}
-	if this.TxSigLimit != that1.TxSigLimit {
-		return false
-	}
-	if this.TxSizeCostPerByte != that1.TxSizeCostPerByte {
-		return false
+}
+func (m *GasPricingPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPricingPolicy.Merge(m, src)
+}
+func (m *GasPricingPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPricingPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPricingPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPricingPolicy proto.InternalMessageInfo
+
+func (m *GasPricingPolicy) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	if this.SigVerifyCostED25519 != that1.SigVerifyCostED25519 {
-		return false
+	return false
+}
+
+func (m *GasPricingPolicy) GetMinGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinGasPrices
 	}
-	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
-		return false
+	return nil
+}
+
+func (m *GasPricingPolicy) GetActivityWindow() uint64 {
+	if m != nil {
+		return m.ActivityWindow
 	}
-	return true
+	return 0
 }
-func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *GasPricingPolicy) GetTiers() []GasPricingTier {
+	if m != nil {
+		return m.Tiers
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *BaseAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// GasPricingTier defines the fee multiplier applied to the transactions of the
+// accounts old and active enough to qualify for the tier.
+type GasPricingTier struct {
+	// min_account_age is the minimum number of blocks since the account was first
+	// seen paying fees.
+	MinAccountAge uint64 `protobuf:"varint,1,opt,name=min_account_age,json=minAccountAge,proto3" json:"min_account_age,omitempty"`
+	// min_recent_txs is the minimum number of transactions the account paid fees
+	// for within the activity window.
+	MinRecentTxs uint64 `protobuf:"varint,2,opt,name=min_recent_txs,json=minRecentTxs,proto3" json:"min_recent_txs,omitempty"`
+	// fee_multiplier is applied to the base gas prices, a multiplier below one is
+	// a discount and a multiplier above one a surcharge.
+	FeeMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=fee_multiplier,json=feeMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_multiplier"`
 }
 
-func (m *BaseAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		i = encodeVarintAuth(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.AccountNumber != 0 {
-		i = encodeVarintAuth(dAtA, i, uint64(m.AccountNumber))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.PubKey != nil {
-		{
-			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAuth(dAtA, i, uint64(size))
+func (m *GasPricingTier) Reset()         { *m = GasPricingTier{} }
+func (m *GasPricingTier) String() string { return proto.CompactTextString(m) }
+func (*GasPricingTier) ProtoMessage()    {}
+func (*GasPricingTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{5}
+}
+func (m *GasPricingTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPricingTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPricingTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *GasPricingTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPricingTier.Merge(m, src)
+}
+func (m *GasPricingTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPricingTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPricingTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPricingTier proto.InternalMessageInfo
+
+func (m *GasPricingTier) GetMinAccountAge() uint64 {
+	if m != nil {
+		return m.MinAccountAge
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ModuleAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GasPricingTier) GetMinRecentTxs() uint64 {
+	if m != nil {
+		return m.MinRecentTxs
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ModuleAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// AccountActivity defines the activity tracked for an account paying
+// transaction fees.
+type AccountActivity struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// first_seen_height is the height at which the account paid fees for the
+	// first time.
+	FirstSeenHeight int64 `protobuf:"varint,2,opt,name=first_seen_height,json=firstSeenHeight,proto3" json:"first_seen_height,omitempty"`
+	// window_start_height is the height at which the current activity window
+	// started.
+	WindowStartHeight int64 `protobuf:"varint,3,opt,name=window_start_height,json=windowStartHeight,proto3" json:"window_start_height,omitempty"`
+	// window_tx_count is the number of transactions in the current activity window.
+	WindowTxCount uint64 `protobuf:"varint,4,opt,name=window_tx_count,json=windowTxCount,proto3" json:"window_tx_count,omitempty"`
+	// previous_window_tx_count is the number of transactions in the previous
+	// activity window.
+	PreviousWindowTxCount uint64 `protobuf:"varint,5,opt,name=previous_window_tx_count,json=previousWindowTxCount,proto3" json:"previous_window_tx_count,omitempty"`
 }
 
-func (m *ModuleAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Permissions) > 0 {
-		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Permissions[iNdEx])
-			copy(dAtA[i:], m.Permissions[iNdEx])
-			i = encodeVarintAuth(dAtA, i, uint64(len(m.Permissions[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.BaseAccount != nil {
-		{
-			size, err := m.BaseAccount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAuth(dAtA, i, uint64(size))
+func (m *AccountActivity) Reset()         { *m = AccountActivity{} }
+func (m *AccountActivity) String() string { return proto.CompactTextString(m) }
+func (*AccountActivity) ProtoMessage()    {}
+func (*AccountActivity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{6}
+}
+func (m *AccountActivity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountActivity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountActivity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *AccountActivity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountActivity.Merge(m, src)
+}
+func (m *AccountActivity) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountActivity) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountActivity.DiscardUnknown(m)
 }
 
-func (m *ModuleCredential) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AccountActivity proto.InternalMessageInfo
+
+func (m *AccountActivity) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ModuleCredential) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AccountActivity) GetFirstSeenHeight() int64 {
+	if m != nil {
+		return m.FirstSeenHeight
+	}
+	return 0
 }
 
-func (m *ModuleCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DerivationKeys) > 0 {
-		for iNdEx := len(m.DerivationKeys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DerivationKeys[iNdEx])
-			copy(dAtA[i:], m.DerivationKeys[iNdEx])
-			i = encodeVarintAuth(dAtA, i, uint64(len(m.DerivationKeys[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+func (m *AccountActivity) GetWindowStartHeight() int64 {
+	if m != nil {
+		return m.WindowStartHeight
 	}
-	if len(m.ModuleName) > 0 {
-		i -= len(m.ModuleName)
-		copy(dAtA[i:], m.ModuleName)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.ModuleName)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *AccountActivity) GetWindowTxCount() uint64 {
+	if m != nil {
+		return m.WindowTxCount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AccountActivity) GetPreviousWindowTxCount() uint64 {
+	if m != nil {
+		return m.PreviousWindowTxCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// AccountProfile defines the optional public profile of an account, settable
+// only by the account itself.
+type AccountProfile struct {
+	// address is the address of the account owning the profile.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// display_name is the human-readable name of the account.
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// avatar_hash is the SHA-256 hash of the avatar image of the account, the
+	// image itself being stored off-chain.
+	AvatarHash []byte `protobuf:"bytes,3,opt,name=avatar_hash,json=avatarHash,proto3" json:"avatar_hash,omitempty"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SigVerifyCostSecp256k1 != 0 {
-		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.SigVerifyCostED25519 != 0 {
-		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostED25519))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.TxSizeCostPerByte != 0 {
-		i = encodeVarintAuth(dAtA, i, uint64(m.TxSizeCostPerByte))
-		i--
-		dAtA[i] = 0x18
+func (m *AccountProfile) Reset()         { *m = AccountProfile{} }
+func (m *AccountProfile) String() string { return proto.CompactTextString(m) }
+func (*AccountProfile) ProtoMessage()    {}
+func (*AccountProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{7}
+}
+func (m *AccountProfile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountProfile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.TxSigLimit != 0 {
-		i = encodeVarintAuth(dAtA, i, uint64(m.TxSigLimit))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *AccountProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountProfile.Merge(m, src)
+}
+func (m *AccountProfile) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountProfile proto.InternalMessageInfo
+
+func (m *AccountProfile) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if m.MaxMemoCharacters != 0 {
-		i = encodeVarintAuth(dAtA, i, uint64(m.MaxMemoCharacters))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *AccountProfile) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAuth(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *AccountProfile) GetAvatarHash() []byte {
+	if m != nil {
+		return m.AvatarHash
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *BaseAccount) Size() (n int) {
-	if m == nil {
-		return 0
+
+// FeeMarketParams defines the parameters of the EIP-1559 style fee market, which
+// adjusts a per-block base fee to the gas used by the previous blocks.
+type FeeMarketParams struct {
+	// enabled defines whether the base fee is enforced. The gas used by the
+	// blocks is not tracked while the fee market is disabled.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// denom is the fee denom the base fee is priced in.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// min_base_fee is the lowest base fee per unit of gas, the base fee never
+	// decreases below it.
+	MinBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_base_fee"`
+	// target_block_gas is the gas used by a block for which the base fee stays
+	// the same. The base fee increases after fuller blocks and decreases after
+	// emptier ones.
+	TargetBlockGas uint64 `protobuf:"varint,4,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty"`
+	// base_fee_change_denominator bounds the change of the base fee from a block
+	// to the next one, the base fee changing by at most 1/base_fee_change_denominator.
+	BaseFeeChangeDenominator uint64 `protobuf:"varint,5,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty"`
+	// base_fee_recipient is the name of the module account the base fees are
+	// sent to. The base fees are burned if it is empty.
+	BaseFeeRecipient string `protobuf:"bytes,6,opt,name=base_fee_recipient,json=baseFeeRecipient,proto3" json:"base_fee_recipient,omitempty"`
+}
+
+func (m *FeeMarketParams) Reset()         { *m = FeeMarketParams{} }
+func (m *FeeMarketParams) String() string { return proto.CompactTextString(m) }
+func (*FeeMarketParams) ProtoMessage()    {}
+func (*FeeMarketParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{8}
+}
+func (m *FeeMarketParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeMarketParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeMarketParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
+}
+func (m *FeeMarketParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeMarketParams.Merge(m, src)
+}
+func (m *FeeMarketParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeMarketParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeMarketParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeMarketParams proto.InternalMessageInfo
+
+func (m *FeeMarketParams) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	if m.PubKey != nil {
-		l = m.PubKey.Size()
-		n += 1 + l + sovAuth(uint64(l))
+	return false
+}
+
+func (m *FeeMarketParams) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if m.AccountNumber != 0 {
-		n += 1 + sovAuth(uint64(m.AccountNumber))
+	return ""
+}
+
+func (m *FeeMarketParams) GetTargetBlockGas() uint64 {
+	if m != nil {
+		return m.TargetBlockGas
 	}
-	if m.Sequence != 0 {
-		n += 1 + sovAuth(uint64(m.Sequence))
+	return 0
+}
+
+func (m *FeeMarketParams) GetBaseFeeChangeDenominator() uint64 {
+	if m != nil {
+		return m.BaseFeeChangeDenominator
 	}
-	return n
+	return 0
 }
 
-func (m *ModuleAccount) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FeeMarketParams) GetBaseFeeRecipient() string {
+	if m != nil {
+		return m.BaseFeeRecipient
 	}
-	var l int
-	_ = l
-	if m.BaseAccount != nil {
-		l = m.BaseAccount.Size()
-		n += 1 + l + sovAuth(uint64(l))
+	return ""
+}
+
+// FeeMarketState defines the state of the fee market at the last block a
+// transaction was executed in.
+type FeeMarketState struct {
+	// base_fee is the base fee per unit of gas of the block.
+	BaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee"`
+	// height is the height of the block.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// block_gas_used is the gas used by the transactions of the block.
+	BlockGasUsed uint64 `protobuf:"varint,3,opt,name=block_gas_used,json=blockGasUsed,proto3" json:"block_gas_used,omitempty"`
+}
+
+func (m *FeeMarketState) Reset()         { *m = FeeMarketState{} }
+func (m *FeeMarketState) String() string { return proto.CompactTextString(m) }
+func (*FeeMarketState) ProtoMessage()    {}
+func (*FeeMarketState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{9}
+}
+func (m *FeeMarketState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeMarketState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeMarketState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
+}
+func (m *FeeMarketState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeMarketState.Merge(m, src)
+}
+func (m *FeeMarketState) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeMarketState) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeMarketState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeMarketState proto.InternalMessageInfo
+
+func (m *FeeMarketState) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	if len(m.Permissions) > 0 {
-		for _, s := range m.Permissions {
-			l = len(s)
-			n += 1 + l + sovAuth(uint64(l))
+	return 0
+}
+
+func (m *FeeMarketState) GetBlockGasUsed() uint64 {
+	if m != nil {
+		return m.BlockGasUsed
+	}
+	return 0
+}
+
+// FeeAbstractionParams defines the fee denoms, other than the native fee denom,
+// transaction fees can be paid in. The fees paid in these denoms are converted
+// to the native fee denom for the fee collector.
+type FeeAbstractionParams struct {
+	// enabled defines whether the fees paid in the accepted denoms are converted.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// native_denom is the fee denom the fees are converted to.
+	NativeDenom string `protobuf:"bytes,2,opt,name=native_denom,json=nativeDenom,proto3" json:"native_denom,omitempty"`
+	// reserve is the name of the module account holding the native denom the
+	// fees are converted to, and receiving the converted fees in exchange.
+	Reserve string `protobuf:"bytes,3,opt,name=reserve,proto3" json:"reserve,omitempty"`
+	// accepted_denoms defines the fee denoms converted to the native denom.
+	AcceptedDenoms []AcceptedFeeDenom `protobuf:"bytes,4,rep,name=accepted_denoms,json=acceptedDenoms,proto3" json:"accepted_denoms"`
+}
+
+func (m *FeeAbstractionParams) Reset()         { *m = FeeAbstractionParams{} }
+func (m *FeeAbstractionParams) String() string { return proto.CompactTextString(m) }
+func (*FeeAbstractionParams) ProtoMessage()    {}
+func (*FeeAbstractionParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{10}
+}
+func (m *FeeAbstractionParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeAbstractionParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeAbstractionParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *FeeAbstractionParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeAbstractionParams.Merge(m, src)
+}
+func (m *FeeAbstractionParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeAbstractionParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeAbstractionParams.DiscardUnknown(m)
 }
 
-func (m *ModuleCredential) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_FeeAbstractionParams proto.InternalMessageInfo
+
+func (m *FeeAbstractionParams) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	var l int
-	_ = l
-	l = len(m.ModuleName)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
+	return false
+}
+
+func (m *FeeAbstractionParams) GetNativeDenom() string {
+	if m != nil {
+		return m.NativeDenom
 	}
-	if len(m.DerivationKeys) > 0 {
-		for _, b := range m.DerivationKeys {
-			l = len(b)
-			n += 1 + l + sovAuth(uint64(l))
+	return ""
+}
+
+func (m *FeeAbstractionParams) GetReserve() string {
+	if m != nil {
+		return m.Reserve
+	}
+	return ""
+}
+
+func (m *FeeAbstractionParams) GetAcceptedDenoms() []AcceptedFeeDenom {
+	if m != nil {
+		return m.AcceptedDenoms
+	}
+	return nil
+}
+
+// AcceptedFeeDenom defines a fee denom converted to the native fee denom.
+type AcceptedFeeDenom struct {
+	// denom is the accepted fee denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// conversion_rate is the amount of the native denom one unit of the denom
+	// converts to. The conversion rate quoted by the fee rate provider, if any,
+	// takes precedence, a zero conversion rate requiring one.
+	ConversionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=conversion_rate,json=conversionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"conversion_rate"`
+}
+
+func (m *AcceptedFeeDenom) Reset()         { *m = AcceptedFeeDenom{} }
+func (m *AcceptedFeeDenom) String() string { return proto.CompactTextString(m) }
+func (*AcceptedFeeDenom) ProtoMessage()    {}
+func (*AcceptedFeeDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{11}
+}
+func (m *AcceptedFeeDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AcceptedFeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AcceptedFeeDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AcceptedFeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptedFeeDenom.Merge(m, src)
+}
+func (m *AcceptedFeeDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *AcceptedFeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptedFeeDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptedFeeDenom proto.InternalMessageInfo
+
+func (m *AcceptedFeeDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// Authenticator is an authentication method registered by an account, making it
+// a smart account: the signatures of the account are then verified by its
+// authenticators instead of its public key. Either pub_keys or verifier is set.
+type Authenticator struct {
+	// id is the identifier of the authenticator, unique on the chain.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pub_keys are the public keys whose signatures are accepted, the secondary
+	// keys of the account.
+	PubKeys []*types.Any `protobuf:"bytes,2,rep,name=pub_keys,json=pubKeys,proto3" json:"pub_keys,omitempty"`
+	// verifier is the name of the verifier registered by the application, e.g. a
+	// contract-based verifier, verifying the signatures.
+	Verifier string `protobuf:"bytes,3,opt,name=verifier,proto3" json:"verifier,omitempty"`
+	// verifier_data is the data passed to the verifier, e.g. a contract address.
+	VerifierData []byte `protobuf:"bytes,4,opt,name=verifier_data,json=verifierData,proto3" json:"verifier_data,omitempty"`
+	// spending_policy restricts the coins spent by the transactions authenticated
+	// by this authenticator. If null, then the spending is not restricted.
+	SpendingPolicy *SpendingPolicy `protobuf:"bytes,5,opt,name=spending_policy,json=spendingPolicy,proto3" json:"spending_policy,omitempty"`
+}
+
+func (m *Authenticator) Reset()         { *m = Authenticator{} }
+func (m *Authenticator) String() string { return proto.CompactTextString(m) }
+func (*Authenticator) ProtoMessage()    {}
+func (*Authenticator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{12}
+}
+func (m *Authenticator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Authenticator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Authenticator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Authenticator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Authenticator.Merge(m, src)
+}
+func (m *Authenticator) XXX_Size() int {
+	return m.Size()
+}
+func (m *Authenticator) XXX_DiscardUnknown() {
+	xxx_messageInfo_Authenticator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Authenticator proto.InternalMessageInfo
+
+func (m *Authenticator) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Authenticator) GetPubKeys() []*types.Any {
+	if m != nil {
+		return m.PubKeys
+	}
+	return nil
+}
+
+func (m *Authenticator) GetVerifier() string {
+	if m != nil {
+		return m.Verifier
+	}
+	return ""
+}
+
+func (m *Authenticator) GetVerifierData() []byte {
+	if m != nil {
+		return m.VerifierData
+	}
+	return nil
+}
+
+func (m *Authenticator) GetSpendingPolicy() *SpendingPolicy {
+	if m != nil {
+		return m.SpendingPolicy
+	}
+	return nil
+}
+
+// SpendingPolicy restricts the coins spent by the transactions authenticated by
+// an authenticator, per period.
+type SpendingPolicy struct {
+	// limit is the maximum amount of coins spent in a period, the denoms not
+	// listed cannot be spent.
+	Limit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=limit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"limit"`
+	// period is the duration after which the spent coins are reset. If zero, then
+	// the limit applies to the whole lifetime of the authenticator.
+	Period time.Duration `protobuf:"bytes,2,opt,name=period,proto3,stdduration" json:"period"`
+	// spent is the amount of coins spent in the current period, maintained by the
+	// chain.
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+	// period_start is the start time of the current period, maintained by the
+	// chain.
+	PeriodStart time.Time `protobuf:"bytes,4,opt,name=period_start,json=periodStart,proto3,stdtime" json:"period_start"`
+}
+
+func (m *SpendingPolicy) Reset()         { *m = SpendingPolicy{} }
+func (m *SpendingPolicy) String() string { return proto.CompactTextString(m) }
+func (*SpendingPolicy) ProtoMessage()    {}
+func (*SpendingPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{13}
+}
+func (m *SpendingPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpendingPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpendingPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpendingPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendingPolicy.Merge(m, src)
+}
+func (m *SpendingPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpendingPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendingPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendingPolicy proto.InternalMessageInfo
+
+func (m *SpendingPolicy) GetLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Limit
+	}
+	return nil
+}
+
+func (m *SpendingPolicy) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *SpendingPolicy) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
+func (m *SpendingPolicy) GetPeriodStart() time.Time {
+	if m != nil {
+		return m.PeriodStart
+	}
+	return time.Time{}
+}
+
+// AccountAuthenticators holds the authenticators of a smart account.
+type AccountAuthenticators struct {
+	// address is the account address string.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// authenticators are the authenticators of the account.
+	Authenticators []Authenticator `protobuf:"bytes,2,rep,name=authenticators,proto3" json:"authenticators"`
+}
+
+func (m *AccountAuthenticators) Reset()         { *m = AccountAuthenticators{} }
+func (m *AccountAuthenticators) String() string { return proto.CompactTextString(m) }
+func (*AccountAuthenticators) ProtoMessage()    {}
+func (*AccountAuthenticators) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{14}
+}
+func (m *AccountAuthenticators) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountAuthenticators) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountAuthenticators.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountAuthenticators) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountAuthenticators.Merge(m, src)
+}
+func (m *AccountAuthenticators) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountAuthenticators) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountAuthenticators.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountAuthenticators proto.InternalMessageInfo
+
+func (m *AccountAuthenticators) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountAuthenticators) GetAuthenticators() []Authenticator {
+	if m != nil {
+		return m.Authenticators
+	}
+	return nil
+}
+
+// PubKeyRotation records the replacement of the public key of an account.
+type PubKeyRotation struct {
+	// id is the identifier of the rotation, unique on the chain.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// address is the account address string.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// old_pub_key is the public key replaced by the rotation.
+	OldPubKey *types.Any `protobuf:"bytes,3,opt,name=old_pub_key,json=oldPubKey,proto3" json:"old_pub_key,omitempty"`
+	// new_pub_key is the public key of the account after the rotation.
+	NewPubKey *types.Any `protobuf:"bytes,4,opt,name=new_pub_key,json=newPubKey,proto3" json:"new_pub_key,omitempty"`
+	// height is the block height of the rotation.
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time of the rotation.
+	Time time.Time `protobuf:"bytes,6,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *PubKeyRotation) Reset()         { *m = PubKeyRotation{} }
+func (m *PubKeyRotation) String() string { return proto.CompactTextString(m) }
+func (*PubKeyRotation) ProtoMessage()    {}
+func (*PubKeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{15}
+}
+func (m *PubKeyRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PubKeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PubKeyRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PubKeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PubKeyRotation.Merge(m, src)
+}
+func (m *PubKeyRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PubKeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PubKeyRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PubKeyRotation proto.InternalMessageInfo
+
+func (m *PubKeyRotation) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PubKeyRotation) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PubKeyRotation) GetOldPubKey() *types.Any {
+	if m != nil {
+		return m.OldPubKey
+	}
+	return nil
+}
+
+func (m *PubKeyRotation) GetNewPubKey() *types.Any {
+	if m != nil {
+		return m.NewPubKey
+	}
+	return nil
+}
+
+func (m *PubKeyRotation) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PubKeyRotation) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// MsgSurchargeParams defines the surcharges applied to the messages of given
+// types, pricing heavy operations such as contract instantiations or large
+// multisends on top of the regular transaction fees.
+type MsgSurchargeParams struct {
+	// surcharges defines the surcharges by message type URL.
+	Surcharges []MsgSurcharge `protobuf:"bytes,1,rep,name=surcharges,proto3" json:"surcharges"`
+}
+
+func (m *MsgSurchargeParams) Reset()         { *m = MsgSurchargeParams{} }
+func (m *MsgSurchargeParams) String() string { return proto.CompactTextString(m) }
+func (*MsgSurchargeParams) ProtoMessage()    {}
+func (*MsgSurchargeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{16}
+}
+func (m *MsgSurchargeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSurchargeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSurchargeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSurchargeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSurchargeParams.Merge(m, src)
+}
+func (m *MsgSurchargeParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSurchargeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSurchargeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSurchargeParams proto.InternalMessageInfo
+
+func (m *MsgSurchargeParams) GetSurcharges() []MsgSurcharge {
+	if m != nil {
+		return m.Surcharges
+	}
+	return nil
+}
+
+// MsgSurcharge defines the surcharge applied to each message of a type carried
+// by a transaction.
+type MsgSurcharge struct {
+	// msg_type_url is the type URL of the surcharged messages, e.g.
+	// /cosmos.bank.v1beta1.MsgMultiSend.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// gas is the extra gas consumed by the transaction per message.
+	Gas uint64 `protobuf:"varint,2,opt,name=gas,proto3" json:"gas,omitempty"`
+	// fee is the flat fee paid by the fee payer per message, in addition to the
+	// transaction fees.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+}
+
+func (m *MsgSurcharge) Reset()         { *m = MsgSurcharge{} }
+func (m *MsgSurcharge) String() string { return proto.CompactTextString(m) }
+func (*MsgSurcharge) ProtoMessage()    {}
+func (*MsgSurcharge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{17}
+}
+func (m *MsgSurcharge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSurcharge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSurcharge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSurcharge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSurcharge.Merge(m, src)
+}
+func (m *MsgSurcharge) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSurcharge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSurcharge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSurcharge proto.InternalMessageInfo
+
+func (m *MsgSurcharge) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgSurcharge) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *MsgSurcharge) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+// FeeRoutingParams defines how the transaction fees deducted into the fee
+// collector are split between sinks, e.g. 50% to the fee collector, 30% burned
+// and 20% to the community pool. The fees stay in the fee collector if no
+// routes are set.
+type FeeRoutingParams struct {
+	// routes defines the sinks of the fees and their shares, adding up to one.
+	Routes []FeeRoute `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes"`
+	// denom_routes overrides the routes of the fees paid in given denoms.
+	DenomRoutes []DenomFeeRoutes `protobuf:"bytes,2,rep,name=denom_routes,json=denomRoutes,proto3" json:"denom_routes"`
+}
+
+func (m *FeeRoutingParams) Reset()         { *m = FeeRoutingParams{} }
+func (m *FeeRoutingParams) String() string { return proto.CompactTextString(m) }
+func (*FeeRoutingParams) ProtoMessage()    {}
+func (*FeeRoutingParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{18}
+}
+func (m *FeeRoutingParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeRoutingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeRoutingParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeRoutingParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeRoutingParams.Merge(m, src)
+}
+func (m *FeeRoutingParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeRoutingParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeRoutingParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeRoutingParams proto.InternalMessageInfo
+
+func (m *FeeRoutingParams) GetRoutes() []FeeRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *FeeRoutingParams) GetDenomRoutes() []DenomFeeRoutes {
+	if m != nil {
+		return m.DenomRoutes
+	}
+	return nil
+}
+
+// FeeRoute defines the share of the fees routed to a sink.
+type FeeRoute struct {
+	// recipient is the name of the module account receiving the share of the
+	// fees, the fee collector keeping it. The share is burned if empty.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// share is the fraction of the fees routed to the recipient.
+	Share cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=share,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share"`
+}
+
+func (m *FeeRoute) Reset()         { *m = FeeRoute{} }
+func (m *FeeRoute) String() string { return proto.CompactTextString(m) }
+func (*FeeRoute) ProtoMessage()    {}
+func (*FeeRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{19}
+}
+func (m *FeeRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeRoute.Merge(m, src)
+}
+func (m *FeeRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeRoute proto.InternalMessageInfo
+
+func (m *FeeRoute) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// DenomFeeRoutes defines the routes of the fees paid in a denom, overriding the
+// default routes.
+type DenomFeeRoutes struct {
+	// denom is the fee denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// routes defines the sinks of the fees paid in the denom and their shares,
+	// adding up to one.
+	Routes []FeeRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
+}
+
+func (m *DenomFeeRoutes) Reset()         { *m = DenomFeeRoutes{} }
+func (m *DenomFeeRoutes) String() string { return proto.CompactTextString(m) }
+func (*DenomFeeRoutes) ProtoMessage()    {}
+func (*DenomFeeRoutes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{20}
+}
+func (m *DenomFeeRoutes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomFeeRoutes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomFeeRoutes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomFeeRoutes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomFeeRoutes.Merge(m, src)
+}
+func (m *DenomFeeRoutes) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomFeeRoutes) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomFeeRoutes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomFeeRoutes proto.InternalMessageInfo
+
+func (m *DenomFeeRoutes) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomFeeRoutes) GetRoutes() []FeeRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
+	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
+	proto.RegisterType((*ModuleCredential)(nil), "cosmos.auth.v1beta1.ModuleCredential")
+	proto.RegisterType((*Params)(nil), "cosmos.auth.v1beta1.Params")
+	proto.RegisterType((*GasPricingPolicy)(nil), "cosmos.auth.v1beta1.GasPricingPolicy")
+	proto.RegisterType((*GasPricingTier)(nil), "cosmos.auth.v1beta1.GasPricingTier")
+	proto.RegisterType((*AccountActivity)(nil), "cosmos.auth.v1beta1.AccountActivity")
+	proto.RegisterType((*AccountProfile)(nil), "cosmos.auth.v1beta1.AccountProfile")
+	proto.RegisterType((*FeeMarketParams)(nil), "cosmos.auth.v1beta1.FeeMarketParams")
+	proto.RegisterType((*FeeMarketState)(nil), "cosmos.auth.v1beta1.FeeMarketState")
+	proto.RegisterType((*FeeAbstractionParams)(nil), "cosmos.auth.v1beta1.FeeAbstractionParams")
+	proto.RegisterType((*AcceptedFeeDenom)(nil), "cosmos.auth.v1beta1.AcceptedFeeDenom")
+	proto.RegisterType((*Authenticator)(nil), "cosmos.auth.v1beta1.Authenticator")
+	proto.RegisterType((*SpendingPolicy)(nil), "cosmos.auth.v1beta1.SpendingPolicy")
+	proto.RegisterType((*AccountAuthenticators)(nil), "cosmos.auth.v1beta1.AccountAuthenticators")
+	proto.RegisterType((*PubKeyRotation)(nil), "cosmos.auth.v1beta1.PubKeyRotation")
+	proto.RegisterType((*MsgSurchargeParams)(nil), "cosmos.auth.v1beta1.MsgSurchargeParams")
+	proto.RegisterType((*MsgSurcharge)(nil), "cosmos.auth.v1beta1.MsgSurcharge")
+	proto.RegisterType((*FeeRoutingParams)(nil), "cosmos.auth.v1beta1.FeeRoutingParams")
+	proto.RegisterType((*FeeRoute)(nil), "cosmos.auth.v1beta1.FeeRoute")
+	proto.RegisterType((*DenomFeeRoutes)(nil), "cosmos.auth.v1beta1.DenomFeeRoutes")
+}
+
+func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
+
+var fileDescriptor_7e1f7e915d020d2d = []byte{
+	// 1954 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x17, 0xcd, 0x6f, 0x1c, 0x57,
+	0xdd, 0xb3, 0xbb, 0x76, 0xe2, 0xdf, 0xae, 0xd7, 0xce, 0xab, 0x1b, 0x36, 0x69, 0xf0, 0xda, 0x5b,
+	0x9a, 0x5a, 0xa6, 0x59, 0x13, 0x57, 0x29, 0x60, 0xa9, 0x52, 0xbd, 0x36, 0x4e, 0xa3, 0xda, 0xc1,
+	0x1d, 0x3b, 0x2d, 0x54, 0x42, 0xa3, 0xb7, 0x33, 0x3f, 0xcf, 0x3e, 0x79, 0xe7, 0x83, 0x79, 0x6f,
+	0x6c, 0x6f, 0xd5, 0x23, 0x87, 0x88, 0x03, 0x54, 0x3d, 0x21, 0x2e, 0x84, 0x5e, 0x40, 0x48, 0x48,
+	0x3e, 0xe4, 0xc0, 0x85, 0x13, 0x97, 0xaa, 0x48, 0x28, 0xea, 0x05, 0xc4, 0xc1, 0x45, 0xce, 0xc1,
+	0x15, 0xe2, 0x1f, 0xe0, 0x86, 0xde, 0xc7, 0xec, 0x87, 0xb3, 0x81, 0xe2, 0x48, 0xbd, 0x8c, 0xe6,
+	0xfd, 0xbe, 0xbf, 0x7f, 0xef, 0xc1, 0x8c, 0x1b, 0xf1, 0x20, 0xe2, 0x8b, 0x34, 0x15, 0xad, 0xc5,
+	0xfd, 0x9b, 0x4d, 0x14, 0xf4, 0xa6, 0x3a, 0xd4, 0xe3, 0x24, 0x12, 0x11, 0x79, 0x4e, 0xe3, 0xeb,
+	0x0a, 0x64, 0xf0, 0x57, 0x2f, 0xd1, 0x80, 0x85, 0xd1, 0xa2, 0xfa, 0x6a, 0xba, 0xab, 0x99, 0x9c,
+	0x26, 0xe5, 0xd8, 0x95, 0xe3, 0x46, 0x2c, 0x34, 0xf8, 0x2b, 0x1a, 0xef, 0xa8, 0xd3, 0xa2, 0x11,
+	0xaa, 0x51, 0xd3, 0x7e, 0xe4, 0x47, 0x1a, 0x2e, 0xff, 0x32, 0x06, 0x3f, 0x8a, 0xfc, 0x36, 0x2e,
+	0xaa, 0x53, 0x33, 0xdd, 0x5d, 0xa4, 0x61, 0x27, 0xd3, 0x75, 0x16, 0xe5, 0xa5, 0x09, 0x15, 0x2c,
+	0xca, 0x74, 0x55, 0xcf, 0xe2, 0x05, 0x0b, 0x90, 0x0b, 0x1a, 0xc4, 0x9a, 0xa0, 0xf6, 0xeb, 0x1c,
+	0x14, 0x1b, 0x94, 0xe3, 0x8a, 0xeb, 0x46, 0x69, 0x28, 0xc8, 0x12, 0x5c, 0xa0, 0x9e, 0x97, 0x20,
+	0xe7, 0x15, 0x6b, 0xd6, 0x9a, 0x1f, 0x6f, 0x54, 0x3e, 0x7b, 0x78, 0x63, 0xda, 0x18, 0xb9, 0xa2,
+	0x31, 0xdb, 0x22, 0x61, 0xa1, 0x6f, 0x67, 0x84, 0xe4, 0x1d, 0xb8, 0x10, 0xa7, 0x4d, 0x67, 0x0f,
+	0x3b, 0x95, 0xdc, 0xac, 0x35, 0x5f, 0x5c, 0x9a, 0xae, 0x6b, 0xb5, 0xf5, 0x4c, 0x6d, 0x7d, 0x25,
+	0xec, 0x34, 0x5e, 0xfe, 0xe7, 0x71, 0x75, 0x3a, 0x4e, 0x9b, 0x6d, 0xe6, 0x4a, 0xda, 0x57, 0xa2,
+	0x80, 0x09, 0x0c, 0x62, 0xd1, 0xf9, 0xf8, 0xf4, 0x68, 0x01, 0x7a, 0x08, 0x7b, 0x2c, 0x4e, 0x9b,
+	0x6f, 0x61, 0x87, 0xbc, 0x04, 0x65, 0xaa, 0xcd, 0x72, 0xc2, 0x34, 0x68, 0x62, 0x52, 0xc9, 0xcf,
+	0x5a, 0xf3, 0x05, 0x7b, 0xc2, 0x40, 0xef, 0x2a, 0x20, 0xb9, 0x0a, 0x17, 0x39, 0xfe, 0x38, 0xc5,
+	0xd0, 0xc5, 0x4a, 0x41, 0x11, 0x74, 0xcf, 0xcb, 0xab, 0xf7, 0x1f, 0x54, 0x47, 0xbe, 0x78, 0x50,
+	0x1d, 0xf9, 0xf4, 0xe1, 0x8d, 0x6b, 0x43, 0xf2, 0x57, 0x37, 0x7e, 0xdf, 0xf9, 0xe9, 0xe9, 0xd1,
+	0xc2, 0x65, 0x4d, 0x70, 0x83, 0x7b, 0x7b, 0x8b, 0x7d, 0x31, 0xa9, 0xfd, 0xcb, 0x82, 0x89, 0xcd,
+	0xc8, 0x4b, 0xdb, 0xdd, 0x28, 0xdd, 0x81, 0x92, 0xcc, 0xae, 0x63, 0x0c, 0x51, 0xa1, 0x2a, 0x2e,
+	0xcd, 0xd6, 0x87, 0x69, 0xe8, 0x93, 0xd4, 0x28, 0x3c, 0x3a, 0xae, 0x5a, 0x76, 0xb1, 0xd9, 0x17,
+	0x70, 0x02, 0x85, 0x90, 0x06, 0xa8, 0x22, 0x37, 0x6e, 0xab, 0x7f, 0x32, 0x0b, 0xc5, 0x18, 0x93,
+	0x80, 0x71, 0xce, 0xa2, 0x90, 0x57, 0xf2, 0xb3, 0xf9, 0xf9, 0x71, 0xbb, 0x1f, 0xb4, 0xfc, 0xde,
+	0x7d, 0xed, 0x53, 0x6d, 0x98, 0xc6, 0x01, 0x5b, 0x95, 0x67, 0x95, 0x3e, 0xcf, 0x06, 0xb0, 0x1f,
+	0x9d, 0x1e, 0x2d, 0x94, 0x03, 0x05, 0xc9, 0x9c, 0xa9, 0xfd, 0xc4, 0x82, 0x29, 0x4d, 0xb4, 0x9a,
+	0xa0, 0x87, 0xa1, 0x60, 0xb4, 0x4d, 0xaa, 0x50, 0x34, 0x64, 0xca, 0x5a, 0x55, 0x1b, 0x36, 0x68,
+	0xd0, 0x5d, 0x69, 0xf3, 0xcb, 0x30, 0xe9, 0x61, 0xc2, 0xf6, 0x55, 0xf5, 0xc9, 0x34, 0xf2, 0x4a,
+	0x6e, 0x36, 0x3f, 0x5f, 0xb2, 0xcb, 0x3d, 0xf0, 0x5b, 0xd8, 0xe1, 0xcb, 0xd7, 0xa5, 0x41, 0x73,
+	0x7d, 0x06, 0xdd, 0x4e, 0xa2, 0x34, 0x36, 0xf6, 0xf4, 0x34, 0xd6, 0xfe, 0x9a, 0x83, 0xb1, 0x2d,
+	0x9a, 0xd0, 0x80, 0x93, 0x3a, 0x3c, 0x17, 0xd0, 0x43, 0x27, 0xc0, 0x20, 0x72, 0xdc, 0x16, 0x4d,
+	0xa8, 0x2b, 0x30, 0xd1, 0x05, 0x5a, 0xb0, 0x2f, 0x05, 0xf4, 0x70, 0x13, 0x83, 0x68, 0xb5, 0x8b,
+	0x20, 0xb3, 0x50, 0x12, 0x87, 0x0e, 0x67, 0xbe, 0xd3, 0x66, 0x01, 0x13, 0x2a, 0xb6, 0x05, 0x1b,
+	0xc4, 0xe1, 0x36, 0xf3, 0x37, 0x24, 0x84, 0x7c, 0x0b, 0x9e, 0x57, 0x14, 0xef, 0xa3, 0xe3, 0x46,
+	0x5c, 0x38, 0x31, 0x26, 0x4e, 0xb3, 0x23, 0xd0, 0x54, 0xd8, 0x25, 0x49, 0xfa, 0x3e, 0xae, 0x46,
+	0x5c, 0x6c, 0x61, 0xd2, 0xe8, 0x08, 0x24, 0xdf, 0x87, 0xaf, 0x49, 0x81, 0xfb, 0x98, 0xb0, 0xdd,
+	0x8e, 0x66, 0x42, 0x6f, 0xe9, 0xd6, 0xad, 0x9b, 0xdf, 0xd5, 0x45, 0xd7, 0xa8, 0x9c, 0x1c, 0x57,
+	0xa7, 0xb7, 0x99, 0xff, 0x8e, 0xa2, 0x90, 0xac, 0xdf, 0x5b, 0x53, 0x78, 0x7b, 0x9a, 0x0f, 0x40,
+	0x35, 0x17, 0xb9, 0x07, 0x57, 0xce, 0x0a, 0xe4, 0xe8, 0xc6, 0x4b, 0xb7, 0x5e, 0xdb, 0xbb, 0x59,
+	0x19, 0x55, 0x22, 0xaf, 0x9e, 0x1c, 0x57, 0x2f, 0x0f, 0x88, 0xdc, 0xce, 0x28, 0xec, 0xcb, 0x7c,
+	0x28, 0x7c, 0x79, 0xee, 0x8b, 0x07, 0x55, 0xeb, 0x6c, 0xce, 0x0f, 0xf5, 0x50, 0xd3, 0xe1, 0xac,
+	0xfd, 0x2a, 0x07, 0x53, 0xb7, 0x29, 0xdf, 0x4a, 0x98, 0xcb, 0x42, 0x7f, 0x2b, 0x6a, 0x33, 0xb7,
+	0x43, 0x2a, 0x70, 0x01, 0x43, 0xda, 0x6c, 0xa3, 0xa7, 0xe2, 0x7a, 0xd1, 0xce, 0x8e, 0xe4, 0x03,
+	0x28, 0x07, 0x2c, 0x74, 0x7c, 0x2a, 0x47, 0x16, 0x73, 0x51, 0x27, 0xb6, 0xb8, 0x74, 0x2d, 0x2b,
+	0x77, 0x59, 0xce, 0xdd, 0xe2, 0x5b, 0x43, 0x77, 0x35, 0x62, 0x61, 0xe3, 0x3b, 0x9f, 0x1c, 0x57,
+	0x47, 0x7e, 0xf7, 0x79, 0xf5, 0x9b, 0x3e, 0x13, 0xad, 0xb4, 0x59, 0x77, 0xa3, 0xc0, 0xcc, 0xba,
+	0xc5, 0x3e, 0xc3, 0x44, 0x27, 0x46, 0x9e, 0xf1, 0xf0, 0xdf, 0x9e, 0x1e, 0x2d, 0x58, 0x76, 0x29,
+	0x60, 0xa1, 0x31, 0x0f, 0xb9, 0xac, 0x2b, 0xea, 0x0a, 0xb6, 0xcf, 0x44, 0xc7, 0x39, 0x60, 0xa1,
+	0x17, 0x1d, 0x98, 0x1c, 0x95, 0x33, 0xf0, 0xbb, 0x0a, 0x4a, 0xd6, 0x60, 0x54, 0x30, 0x59, 0x16,
+	0x05, 0x65, 0xdd, 0x8b, 0x43, 0x9b, 0xb1, 0xe7, 0xf6, 0x0e, 0xc3, 0xa4, 0x31, 0x2e, 0x8d, 0xd4,
+	0x5a, 0x35, 0xf3, 0x72, 0x41, 0x86, 0xaf, 0xf6, 0x27, 0x0b, 0xca, 0x83, 0xa4, 0xe4, 0x3a, 0x4c,
+	0xca, 0x28, 0x64, 0x03, 0x89, 0xfa, 0x68, 0xea, 0x6f, 0x22, 0x60, 0xa1, 0xa9, 0xde, 0x15, 0x1f,
+	0xc9, 0x37, 0x74, 0xb4, 0x12, 0x74, 0x31, 0x14, 0x8e, 0x38, 0xe4, 0xa6, 0xfa, 0xa4, 0x57, 0xb6,
+	0x02, 0xee, 0x1c, 0x72, 0xf2, 0x23, 0x28, 0xef, 0x22, 0x3a, 0x41, 0xda, 0x16, 0x2c, 0x6e, 0x33,
+	0x33, 0xda, 0xc6, 0x1b, 0xaf, 0x49, 0x83, 0xfe, 0x7e, 0x5c, 0x7d, 0x41, 0x1b, 0xcf, 0xbd, 0xbd,
+	0x3a, 0x8b, 0x16, 0x03, 0x2a, 0x5a, 0xf5, 0x0d, 0xf4, 0xa9, 0xdb, 0x59, 0x43, 0xf7, 0xb3, 0x87,
+	0x37, 0xc0, 0xf8, 0xb6, 0x86, 0xae, 0xb6, 0x7e, 0x62, 0x17, 0x71, 0xb3, 0x2b, 0xcc, 0x78, 0x71,
+	0x3f, 0x07, 0x93, 0x99, 0x65, 0x26, 0x56, 0xe7, 0x9a, 0xef, 0x0b, 0x70, 0x69, 0x97, 0x25, 0xaa,
+	0x3e, 0x31, 0x74, 0x5a, 0xc8, 0xfc, 0x96, 0xee, 0xa9, 0xbc, 0x3d, 0xa9, 0x10, 0xdb, 0x88, 0xe1,
+	0x9b, 0x0a, 0x2c, 0x5b, 0x55, 0x67, 0xc9, 0xe1, 0x82, 0x26, 0x22, 0xa3, 0xce, 0x2b, 0xea, 0x4b,
+	0x1a, 0xb5, 0x2d, 0x31, 0x86, 0xfe, 0x3a, 0x4c, 0x1a, 0x7a, 0x71, 0xe8, 0xe8, 0x61, 0xaa, 0x67,
+	0xf8, 0x84, 0x06, 0xef, 0x1c, 0xae, 0x4a, 0x20, 0xf9, 0x36, 0x54, 0xe2, 0x04, 0xf7, 0x59, 0x94,
+	0x72, 0xe7, 0x2c, 0x83, 0x6a, 0x16, 0xfb, 0xf9, 0x0c, 0xff, 0x6e, 0x3f, 0xa3, 0x09, 0xc5, 0xcf,
+	0x2c, 0x28, 0x9b, 0x50, 0x6c, 0x25, 0xd1, 0x2e, 0x6b, 0xe3, 0xb9, 0x22, 0x31, 0x07, 0x25, 0x8f,
+	0xf1, 0xb8, 0x4d, 0x3b, 0x4e, 0xdf, 0xd0, 0x2e, 0x1a, 0x98, 0x9a, 0x83, 0x55, 0x28, 0xd2, 0x7d,
+	0x2a, 0x68, 0xe2, 0xb4, 0x28, 0x6f, 0x29, 0xc7, 0x4b, 0x36, 0x68, 0xd0, 0x9b, 0x94, 0xb7, 0x8c,
+	0x41, 0x7f, 0xc8, 0xc1, 0xe4, 0x3a, 0xe2, 0x26, 0x4d, 0xf6, 0x50, 0x98, 0x31, 0xf7, 0xf4, 0x16,
+	0x9c, 0x86, 0x51, 0x0f, 0xc3, 0x28, 0x30, 0x0a, 0xf5, 0x81, 0xfc, 0x00, 0x64, 0x51, 0x39, 0x6a,
+	0x13, 0xed, 0x22, 0x3e, 0x63, 0x09, 0x41, 0xc0, 0x42, 0xb9, 0xab, 0xd6, 0x11, 0xc9, 0x3c, 0x4c,
+	0x09, 0x9a, 0xf8, 0x28, 0x9c, 0x66, 0x3b, 0x72, 0xf7, 0x64, 0xef, 0x9b, 0xb4, 0x94, 0x35, 0xbc,
+	0x21, 0xc1, 0xb7, 0x29, 0x27, 0xaf, 0xc3, 0x0b, 0x99, 0x7e, 0x39, 0x9a, 0x43, 0x1f, 0x1d, 0x65,
+	0x1c, 0x0b, 0xa9, 0x88, 0x12, 0x93, 0x9a, 0x4a, 0x53, 0xcb, 0x5d, 0x55, 0x04, 0x6b, 0x3d, 0x3c,
+	0x79, 0x05, 0x48, 0x97, 0x3d, 0x41, 0x97, 0xc5, 0x0c, 0x43, 0x51, 0x19, 0x53, 0x5e, 0x4e, 0x19,
+	0x2e, 0x3b, 0x83, 0x9b, 0xd0, 0xfd, 0xc6, 0x82, 0x72, 0x37, 0x74, 0xdb, 0x82, 0x0a, 0x24, 0x6f,
+	0xc3, 0xc5, 0x6e, 0x14, 0xac, 0x67, 0x8a, 0xc2, 0x05, 0xa3, 0x94, 0x5c, 0x86, 0xb1, 0x81, 0x4a,
+	0x37, 0x27, 0xd9, 0xdf, 0xdd, 0x98, 0x38, 0x29, 0x47, 0xcf, 0x8c, 0xa3, 0x52, 0xd3, 0x84, 0xe4,
+	0x1e, 0x47, 0xcf, 0x58, 0xfa, 0x17, 0x0b, 0xa6, 0xd7, 0x11, 0x57, 0x9a, 0x5c, 0xc8, 0xd5, 0xc4,
+	0xa2, 0xf0, 0x7f, 0x66, 0x7a, 0x0e, 0x4a, 0x21, 0x15, 0x6c, 0xdf, 0x84, 0x31, 0xab, 0x30, 0x0d,
+	0x53, 0x91, 0x93, 0xcc, 0x09, 0x72, 0x4c, 0xf6, 0x4d, 0xc6, 0xed, 0xec, 0x48, 0x7e, 0x28, 0x67,
+	0xa5, 0x8b, 0xb1, 0x40, 0x4f, 0xb3, 0x67, 0xc3, 0xf0, 0xa5, 0xfa, 0x53, 0xee, 0x3e, 0x8a, 0x76,
+	0x1d, 0xb5, 0xe4, 0xfe, 0x71, 0x58, 0xce, 0x04, 0x29, 0x4c, 0x36, 0x17, 0x7f, 0x6e, 0xc1, 0xd4,
+	0x59, 0xae, 0x5e, 0x71, 0x5a, 0xfd, 0xc5, 0xe9, 0xc0, 0xa4, 0x1b, 0x85, 0xfb, 0x98, 0xc8, 0x0b,
+	0x8b, 0x93, 0x50, 0x61, 0xba, 0xe5, 0xdc, 0x99, 0x29, 0xf7, 0xc4, 0xd9, 0x54, 0xa0, 0xb1, 0xe8,
+	0xdf, 0x16, 0x4c, 0xac, 0xa4, 0xa2, 0x25, 0x2f, 0x0d, 0xae, 0x2a, 0xa9, 0x32, 0xe4, 0x98, 0x67,
+	0x66, 0x73, 0x8e, 0x79, 0xe4, 0x0e, 0x5c, 0x34, 0xb7, 0xd3, 0x6c, 0x71, 0x0d, 0xbf, 0x9e, 0x56,
+	0x3e, 0xed, 0xb5, 0xbf, 0x9b, 0x74, 0x62, 0x11, 0xd5, 0xb7, 0xd4, 0x3d, 0xd4, 0xbe, 0xa0, 0xef,
+	0xa3, 0x5c, 0xde, 0x34, 0xd5, 0xba, 0xee, 0xce, 0x6b, 0xbb, 0x7b, 0x26, 0x2f, 0xc2, 0x44, 0xf6,
+	0xef, 0x78, 0x54, 0x50, 0xd5, 0x2f, 0x25, 0xbb, 0x94, 0x01, 0xd7, 0xa8, 0xa0, 0x64, 0x03, 0x26,
+	0x79, 0x8c, 0xa1, 0xc7, 0x42, 0xdf, 0x89, 0xd5, 0xde, 0x55, 0x1d, 0xf2, 0xb4, 0x6d, 0xb5, 0x6d,
+	0x68, 0xf5, 0x8a, 0xb6, 0xcb, 0x7c, 0xe0, 0x5c, 0xfb, 0x28, 0x0f, 0xe5, 0x41, 0x12, 0x72, 0x00,
+	0xa3, 0xfa, 0xca, 0x63, 0x29, 0x4f, 0xaf, 0x0c, 0x5d, 0xd1, 0x6a, 0x3f, 0xaf, 0x9b, 0xfd, 0x3c,
+	0xff, 0x25, 0xf6, 0xb3, 0x64, 0xe0, 0xbf, 0x3c, 0x3d, 0x5a, 0x28, 0xb5, 0x55, 0x86, 0x1c, 0xb7,
+	0xb7, 0xad, 0xb5, 0x3e, 0xf2, 0x06, 0x8c, 0xc5, 0x98, 0xb0, 0xc8, 0x33, 0x4f, 0x80, 0x2b, 0x4f,
+	0xc4, 0x78, 0xcd, 0xbc, 0x4c, 0x1a, 0x13, 0x52, 0xf3, 0x2f, 0x3e, 0xaf, 0x5a, 0x5a, 0x80, 0xe1,
+	0x93, 0xa6, 0x4b, 0xff, 0x84, 0xba, 0xee, 0x7e, 0x35, 0xa6, 0x2b, 0x7d, 0x64, 0x03, 0x4a, 0xda,
+	0x04, 0xbd, 0xb2, 0x54, 0xe2, 0x8a, 0x4b, 0x57, 0x9f, 0x70, 0x60, 0x27, 0x7b, 0x3a, 0x69, 0x0f,
+	0x3e, 0xec, 0x7a, 0x50, 0xd4, 0xec, 0x6a, 0xad, 0xd5, 0x3e, 0xb6, 0xe0, 0xf9, 0x6c, 0xe9, 0xf6,
+	0xd7, 0x25, 0x3f, 0xd7, 0xc2, 0xb9, 0x07, 0x65, 0x3a, 0x20, 0xc5, 0x94, 0x70, 0x6d, 0x78, 0x43,
+	0xf7, 0x93, 0x0e, 0x76, 0xf3, 0x80, 0x90, 0xda, 0x9f, 0x73, 0x50, 0x36, 0xc5, 0x1d, 0x09, 0x95,
+	0x95, 0x27, 0xda, 0xa6, 0xcf, 0xda, 0xdc, 0x97, 0xb5, 0xf6, 0x2e, 0x14, 0xa3, 0xb6, 0xe7, 0x64,
+	0x8f, 0xc1, 0xfc, 0xac, 0x75, 0x8e, 0x6e, 0x1b, 0x8f, 0xda, 0x9e, 0xfe, 0x95, 0xf2, 0x42, 0x3c,
+	0xe8, 0xca, 0x2b, 0x9c, 0x4f, 0x5e, 0x88, 0x07, 0x46, 0x5e, 0x6f, 0xa6, 0x8f, 0x0e, 0xcc, 0xf4,
+	0xd7, 0xa1, 0x20, 0xdf, 0xc5, 0x95, 0xb1, 0xff, 0x37, 0xf3, 0x8a, 0xad, 0xd6, 0x02, 0xb2, 0xc9,
+	0xfd, 0xed, 0x34, 0x91, 0x6f, 0x13, 0x1f, 0xcd, 0x8c, 0xdf, 0x00, 0xe0, 0x19, 0x88, 0x9b, 0x7e,
+	0x9c, 0x1b, 0x9a, 0xb6, 0x7e, 0xe6, 0xfe, 0xac, 0xf5, 0xf1, 0x9b, 0x69, 0xf7, 0x47, 0x0b, 0x4a,
+	0xfd, 0xd4, 0xf2, 0xa5, 0x13, 0x70, 0xdf, 0x91, 0xb5, 0xee, 0xa4, 0x49, 0xbb, 0xfb, 0x2e, 0xe3,
+	0xfe, 0x4e, 0x27, 0xc6, 0x7b, 0x49, 0x9b, 0x4c, 0x41, 0xde, 0xa7, 0x3a, 0x87, 0x05, 0x5b, 0xfe,
+	0x12, 0x0e, 0x79, 0x7d, 0x5b, 0xf8, 0x8a, 0xda, 0x4c, 0x6a, 0x33, 0xf6, 0xff, 0xde, 0x82, 0x29,
+	0xb9, 0xd1, 0xa3, 0x54, 0xc8, 0x99, 0xa5, 0x03, 0xf5, 0x06, 0x8c, 0x25, 0x51, 0x2a, 0xba, 0x41,
+	0xfa, 0xfa, 0xd0, 0x20, 0x19, 0xb6, 0x81, 0x00, 0x19, 0x3e, 0xf2, 0x36, 0x94, 0xd4, 0xd2, 0x71,
+	0x8c, 0x9c, 0xdc, 0x7f, 0x79, 0x01, 0xa8, 0x9d, 0x95, 0x09, 0xe3, 0xfd, 0xd2, 0x8a, 0x4a, 0x86,
+	0x86, 0x1b, 0x7b, 0x3f, 0x80, 0x8b, 0x19, 0x29, 0xb9, 0x06, 0xe3, 0xbd, 0x1b, 0x8a, 0x8e, 0x73,
+	0x0f, 0x40, 0x36, 0x60, 0x94, 0xb7, 0x68, 0xf2, 0xac, 0x4b, 0x4e, 0x0b, 0x31, 0xda, 0x43, 0x28,
+	0x0f, 0x5a, 0xfb, 0x94, 0x55, 0xdb, 0x0b, 0x60, 0xee, 0x7c, 0x01, 0xd4, 0xfa, 0x1a, 0xaf, 0x7e,
+	0x72, 0x32, 0x63, 0x3d, 0x3a, 0x99, 0xb1, 0xfe, 0x71, 0x32, 0x63, 0x7d, 0xf8, 0x78, 0x66, 0xe4,
+	0xd1, 0xe3, 0x99, 0x91, 0xbf, 0x3d, 0x9e, 0x19, 0x79, 0xef, 0xca, 0x80, 0x1b, 0xe6, 0x35, 0xa9,
+	0x32, 0xdf, 0x1c, 0x53, 0x5d, 0xf2, 0xea, 0x7f, 0x06, 0x00, 0xd7, 0xd0, 0x7e, 0x9b, 0x3e, 0x13,
+	0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxMemoCharacters != that1.MaxMemoCharacters {
+		return false
+	}
+	if this.TxSigLimit != that1.TxSigLimit {
+		return false
+	}
+	if this.TxSizeCostPerByte != that1.TxSizeCostPerByte {
+		return false
+	}
+	if this.SigVerifyCostED25519 != that1.SigVerifyCostED25519 {
+		return false
+	}
+	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
+		return false
+	}
+	return true
+}
+func (this *GasPricingPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GasPricingPolicy)
+	if !ok {
+		that2, ok := that.(GasPricingPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if len(this.MinGasPrices) != len(that1.MinGasPrices) {
+		return false
+	}
+	for i := range this.MinGasPrices {
+		if !this.MinGasPrices[i].Equal(&that1.MinGasPrices[i]) {
+			return false
+		}
+	}
+	if this.ActivityWindow != that1.ActivityWindow {
+		return false
+	}
+	if len(this.Tiers) != len(that1.Tiers) {
+		return false
+	}
+	for i := range this.Tiers {
+		if !this.Tiers[i].Equal(&that1.Tiers[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *GasPricingTier) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GasPricingTier)
+	if !ok {
+		that2, ok := that.(GasPricingTier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MinAccountAge != that1.MinAccountAge {
+		return false
+	}
+	if this.MinRecentTxs != that1.MinRecentTxs {
+		return false
+	}
+	if !this.FeeMultiplier.Equal(that1.FeeMultiplier) {
+		return false
+	}
+	return true
+}
+func (this *AccountActivity) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountActivity)
+	if !ok {
+		that2, ok := that.(AccountActivity)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.FirstSeenHeight != that1.FirstSeenHeight {
+		return false
+	}
+	if this.WindowStartHeight != that1.WindowStartHeight {
+		return false
+	}
+	if this.WindowTxCount != that1.WindowTxCount {
+		return false
+	}
+	if this.PreviousWindowTxCount != that1.PreviousWindowTxCount {
+		return false
+	}
+	return true
+}
+func (this *AccountProfile) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountProfile)
+	if !ok {
+		that2, ok := that.(AccountProfile)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.DisplayName != that1.DisplayName {
+		return false
+	}
+	if !bytes.Equal(this.AvatarHash, that1.AvatarHash) {
+		return false
+	}
+	return true
+}
+func (this *FeeMarketParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeMarketParams)
+	if !ok {
+		that2, ok := that.(FeeMarketParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.MinBaseFee.Equal(that1.MinBaseFee) {
+		return false
+	}
+	if this.TargetBlockGas != that1.TargetBlockGas {
+		return false
+	}
+	if this.BaseFeeChangeDenominator != that1.BaseFeeChangeDenominator {
+		return false
+	}
+	if this.BaseFeeRecipient != that1.BaseFeeRecipient {
+		return false
+	}
+	return true
+}
+func (this *FeeMarketState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeMarketState)
+	if !ok {
+		that2, ok := that.(FeeMarketState)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.BaseFee.Equal(that1.BaseFee) {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.BlockGasUsed != that1.BlockGasUsed {
+		return false
+	}
+	return true
+}
+func (this *FeeAbstractionParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeAbstractionParams)
+	if !ok {
+		that2, ok := that.(FeeAbstractionParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.NativeDenom != that1.NativeDenom {
+		return false
+	}
+	if this.Reserve != that1.Reserve {
+		return false
+	}
+	if len(this.AcceptedDenoms) != len(that1.AcceptedDenoms) {
+		return false
+	}
+	for i := range this.AcceptedDenoms {
+		if !this.AcceptedDenoms[i].Equal(&that1.AcceptedDenoms[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AcceptedFeeDenom) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AcceptedFeeDenom)
+	if !ok {
+		that2, ok := that.(AcceptedFeeDenom)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.ConversionRate.Equal(that1.ConversionRate) {
+		return false
+	}
+	return true
+}
+func (this *MsgSurchargeParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSurchargeParams)
+	if !ok {
+		that2, ok := that.(MsgSurchargeParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Surcharges) != len(that1.Surcharges) {
+		return false
+	}
+	for i := range this.Surcharges {
+		if !this.Surcharges[i].Equal(&that1.Surcharges[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MsgSurcharge) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSurcharge)
+	if !ok {
+		that2, ok := that.(MsgSurcharge)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MsgTypeUrl != that1.MsgTypeUrl {
+		return false
+	}
+	if this.Gas != that1.Gas {
+		return false
+	}
+	if len(this.Fee) != len(that1.Fee) {
+		return false
+	}
+	for i := range this.Fee {
+		if !this.Fee[i].Equal(&that1.Fee[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *FeeRoutingParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeRoutingParams)
+	if !ok {
+		that2, ok := that.(FeeRoutingParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Routes) != len(that1.Routes) {
+		return false
+	}
+	for i := range this.Routes {
+		if !this.Routes[i].Equal(&that1.Routes[i]) {
+			return false
+		}
+	}
+	if len(this.DenomRoutes) != len(that1.DenomRoutes) {
+		return false
+	}
+	for i := range this.DenomRoutes {
+		if !this.DenomRoutes[i].Equal(&that1.DenomRoutes[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *FeeRoute) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeRoute)
+	if !ok {
+		that2, ok := that.(FeeRoute)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if !this.Share.Equal(that1.Share) {
+		return false
+	}
+	return true
+}
+func (this *DenomFeeRoutes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomFeeRoutes)
+	if !ok {
+		that2, ok := that.(DenomFeeRoutes)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if len(this.Routes) != len(that1.Routes) {
+		return false
+	}
+	for i := range this.Routes {
+		if !this.Routes[i].Equal(&that1.Routes[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AccountNumber != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.AccountNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PubKey != nil {
+		{
+			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Permissions[iNdEx])
+			copy(dAtA[i:], m.Permissions[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.Permissions[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BaseAccount != nil {
+		{
+			size, err := m.BaseAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleCredential) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleCredential) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DerivationKeys) > 0 {
+		for iNdEx := len(m.DerivationKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DerivationKeys[iNdEx])
+			copy(dAtA[i:], m.DerivationKeys[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.DerivationKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SigVerifyCostSecp256k1 != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SigVerifyCostED25519 != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostED25519))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TxSizeCostPerByte != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.TxSizeCostPerByte))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TxSigLimit != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.TxSigLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxMemoCharacters != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.MaxMemoCharacters))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPricingPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPricingPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPricingPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tiers) > 0 {
+		for iNdEx := len(m.Tiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ActivityWindow != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.ActivityWindow))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MinGasPrices) > 0 {
+		for iNdEx := len(m.MinGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPricingTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPricingTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPricingTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeeMultiplier.Size()
+		i -= size
+		if _, err := m.FeeMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.MinRecentTxs != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.MinRecentTxs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinAccountAge != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.MinAccountAge))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountActivity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountActivity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountActivity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PreviousWindowTxCount != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.PreviousWindowTxCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.WindowTxCount != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.WindowTxCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WindowStartHeight != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.WindowStartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FirstSeenHeight != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.FirstSeenHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountProfile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountProfile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountProfile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AvatarHash) > 0 {
+		i -= len(m.AvatarHash)
+		copy(dAtA[i:], m.AvatarHash)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.AvatarHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DisplayName) > 0 {
+		i -= len(m.DisplayName)
+		copy(dAtA[i:], m.DisplayName)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.DisplayName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeMarketParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeMarketParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeMarketParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BaseFeeRecipient) > 0 {
+		i -= len(m.BaseFeeRecipient)
+		copy(dAtA[i:], m.BaseFeeRecipient)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.BaseFeeRecipient)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.BaseFeeChangeDenominator))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TargetBlockGas != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.TargetBlockGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeMarketState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeMarketState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeMarketState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockGasUsed != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.BlockGasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeAbstractionParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeAbstractionParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeAbstractionParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AcceptedDenoms) > 0 {
+		for iNdEx := len(m.AcceptedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AcceptedDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Reserve) > 0 {
+		i -= len(m.Reserve)
+		copy(dAtA[i:], m.Reserve)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Reserve)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NativeDenom) > 0 {
+		i -= len(m.NativeDenom)
+		copy(dAtA[i:], m.NativeDenom)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.NativeDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AcceptedFeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AcceptedFeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AcceptedFeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ConversionRate.Size()
+		i -= size
+		if _, err := m.ConversionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Authenticator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Authenticator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Authenticator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpendingPolicy != nil {
+		{
+			size, err := m.SpendingPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.VerifierData) > 0 {
+		i -= len(m.VerifierData)
+		copy(dAtA[i:], m.VerifierData)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.VerifierData)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Verifier) > 0 {
+		i -= len(m.Verifier)
+		copy(dAtA[i:], m.Verifier)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Verifier)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PubKeys) > 0 {
+		for iNdEx := len(m.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PubKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpendingPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpendingPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpendingPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodStart):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintAuth(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintAuth(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Limit) > 0 {
+		for iNdEx := len(m.Limit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Limit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountAuthenticators) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountAuthenticators) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountAuthenticators) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authenticators) > 0 {
+		for iNdEx := len(m.Authenticators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authenticators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PubKeyRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PubKeyRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PubKeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintAuth(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x32
+	if m.Height != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NewPubKey != nil {
+		{
+			size, err := m.NewPubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OldPubKey != nil {
+		{
+			size, err := m.OldPubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSurchargeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSurchargeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSurchargeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Surcharges) > 0 {
+		for iNdEx := len(m.Surcharges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Surcharges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSurcharge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSurcharge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSurcharge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Gas != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeRoutingParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeRoutingParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeRoutingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomRoutes) > 0 {
+		for iNdEx := len(m.DenomRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Share.Size()
+		i -= size
+		if _, err := m.Share.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomFeeRoutes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomFeeRoutes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomFeeRoutes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuth(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BaseAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovAuth(uint64(m.AccountNumber))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovAuth(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *ModuleAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseAccount != nil {
+		l = m.BaseAccount.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		for _, s := range m.Permissions {
+			l = len(s)
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ModuleCredential) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if len(m.DerivationKeys) > 0 {
+		for _, b := range m.DerivationKeys {
+			l = len(b)
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxMemoCharacters != 0 {
+		n += 1 + sovAuth(uint64(m.MaxMemoCharacters))
+	}
+	if m.TxSigLimit != 0 {
+		n += 1 + sovAuth(uint64(m.TxSigLimit))
+	}
+	if m.TxSizeCostPerByte != 0 {
+		n += 1 + sovAuth(uint64(m.TxSizeCostPerByte))
+	}
+	if m.SigVerifyCostED25519 != 0 {
+		n += 1 + sovAuth(uint64(m.SigVerifyCostED25519))
+	}
+	if m.SigVerifyCostSecp256k1 != 0 {
+		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
+	}
+	return n
+}
+
+func (m *GasPricingPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if len(m.MinGasPrices) > 0 {
+		for _, e := range m.MinGasPrices {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.ActivityWindow != 0 {
+		n += 1 + sovAuth(uint64(m.ActivityWindow))
+	}
+	if len(m.Tiers) > 0 {
+		for _, e := range m.Tiers {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GasPricingTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinAccountAge != 0 {
+		n += 1 + sovAuth(uint64(m.MinAccountAge))
+	}
+	if m.MinRecentTxs != 0 {
+		n += 1 + sovAuth(uint64(m.MinRecentTxs))
+	}
+	l = m.FeeMultiplier.Size()
+	n += 1 + l + sovAuth(uint64(l))
+	return n
+}
+
+func (m *AccountActivity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.FirstSeenHeight != 0 {
+		n += 1 + sovAuth(uint64(m.FirstSeenHeight))
+	}
+	if m.WindowStartHeight != 0 {
+		n += 1 + sovAuth(uint64(m.WindowStartHeight))
+	}
+	if m.WindowTxCount != 0 {
+		n += 1 + sovAuth(uint64(m.WindowTxCount))
+	}
+	if m.PreviousWindowTxCount != 0 {
+		n += 1 + sovAuth(uint64(m.PreviousWindowTxCount))
+	}
+	return n
+}
+
+func (m *AccountProfile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.DisplayName)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.AvatarHash)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	return n
+}
+
+func (m *FeeMarketParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovAuth(uint64(l))
+	if m.TargetBlockGas != 0 {
+		n += 1 + sovAuth(uint64(m.TargetBlockGas))
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovAuth(uint64(m.BaseFeeChangeDenominator))
+	}
+	l = len(m.BaseFeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	return n
+}
+
+func (m *FeeMarketState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseFee.Size()
+	n += 1 + l + sovAuth(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovAuth(uint64(m.Height))
+	}
+	if m.BlockGasUsed != 0 {
+		n += 1 + sovAuth(uint64(m.BlockGasUsed))
+	}
+	return n
+}
+
+func (m *FeeAbstractionParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.NativeDenom)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.Reserve)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if len(m.AcceptedDenoms) > 0 {
+		for _, e := range m.AcceptedDenoms {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AcceptedFeeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = m.ConversionRate.Size()
+	n += 1 + l + sovAuth(uint64(l))
+	return n
+}
+
+func (m *Authenticator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAuth(uint64(m.Id))
+	}
+	if len(m.PubKeys) > 0 {
+		for _, e := range m.PubKeys {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	l = len(m.Verifier)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.VerifierData)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.SpendingPolicy != nil {
+		l = m.SpendingPolicy.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	return n
+}
+
+func (m *SpendingPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Limit) > 0 {
+		for _, e := range m.Limit {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovAuth(uint64(l))
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodStart)
+	n += 1 + l + sovAuth(uint64(l))
+	return n
+}
+
+func (m *AccountAuthenticators) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if len(m.Authenticators) > 0 {
+		for _, e := range m.Authenticators {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PubKeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAuth(uint64(m.Id))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.OldPubKey != nil {
+		l = m.OldPubKey.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.NewPubKey != nil {
+		l = m.NewPubKey.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovAuth(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovAuth(uint64(l))
+	return n
+}
+
+func (m *MsgSurchargeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Surcharges) > 0 {
+		for _, e := range m.Surcharges {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSurcharge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovAuth(uint64(m.Gas))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeRoutingParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if len(m.DenomRoutes) > 0 {
+		for _, e := range m.DenomRoutes {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = m.Share.Size()
+	n += 1 + l + sovAuth(uint64(l))
+	return n
+}
+
+func (m *DenomFeeRoutes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAuth(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuth(x uint64) (n int) {
+	return sovAuth(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BaseAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &types.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			}
+			m.AccountNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseAccount == nil {
+				m.BaseAccount = &BaseAccount{}
+			}
+			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleCredential) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleCredential: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleCredential: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivationKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivationKeys = append(m.DerivationKeys, make([]byte, postIndex-iNdEx))
+			copy(m.DerivationKeys[len(m.DerivationKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoCharacters", wireType)
+			}
+			m.MaxMemoCharacters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMemoCharacters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSigLimit", wireType)
+			}
+			m.TxSigLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxSigLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSizeCostPerByte", wireType)
+			}
+			m.TxSizeCostPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxSizeCostPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostED25519", wireType)
+			}
+			m.SigVerifyCostED25519 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyCostED25519 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostSecp256k1", wireType)
+			}
+			m.SigVerifyCostSecp256k1 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyCostSecp256k1 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPricingPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPricingPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPricingPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinGasPrices = append(m.MinGasPrices, types1.DecCoin{})
+			if err := m.MinGasPrices[len(m.MinGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityWindow", wireType)
+			}
+			m.ActivityWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivityWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tiers = append(m.Tiers, GasPricingTier{})
+			if err := m.Tiers[len(m.Tiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPricingTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPricingTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPricingTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAccountAge", wireType)
+			}
+			m.MinAccountAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinAccountAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRecentTxs", wireType)
+			}
+			m.MinRecentTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinRecentTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountActivity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountActivity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountActivity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstSeenHeight", wireType)
+			}
+			m.FirstSeenHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstSeenHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStartHeight", wireType)
+			}
+			m.WindowStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowTxCount", wireType)
+			}
+			m.WindowTxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowTxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousWindowTxCount", wireType)
+			}
+			m.PreviousWindowTxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousWindowTxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountProfile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountProfile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvatarHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvatarHash = append(m.AvatarHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AvatarHash == nil {
+				m.AvatarHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeMarketParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeMarketParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeMarketParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
+			}
+			m.TargetBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			}
+			m.BaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeChangeDenominator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseFeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeMarketState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeMarketState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeMarketState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGasUsed", wireType)
+			}
+			m.BlockGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeAbstractionParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeAbstractionParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeAbstractionParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reserve = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedDenoms = append(m.AcceptedDenoms, AcceptedFeeDenom{})
+			if err := m.AcceptedDenoms[len(m.AcceptedDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AcceptedFeeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AcceptedFeeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AcceptedFeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConversionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Authenticator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Authenticator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Authenticator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeys = append(m.PubKeys, &types.Any{})
+			if err := m.PubKeys[len(m.PubKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifierData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifierData = append(m.VerifierData[:0], dAtA[iNdEx:postIndex]...)
+			if m.VerifierData == nil {
+				m.VerifierData = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendingPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SpendingPolicy == nil {
+				m.SpendingPolicy = &SpendingPolicy{}
+			}
+			if err := m.SpendingPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpendingPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpendingPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpendingPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Limit = append(m.Limit, types1.Coin{})
+			if err := m.Limit[len(m.Limit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types1.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountAuthenticators) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountAuthenticators: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountAuthenticators: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authenticators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authenticators = append(m.Authenticators, Authenticator{})
+			if err := m.Authenticators[len(m.Authenticators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PubKeyRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubKeyRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldPubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldPubKey == nil {
+				m.OldPubKey = &types.Any{}
+			}
+			if err := m.OldPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewPubKey == nil {
+				m.NewPubKey = &types.Any{}
+			}
+			if err := m.NewPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSurchargeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSurchargeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSurchargeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Surcharges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Surcharges = append(m.Surcharges, MsgSurcharge{})
+			if err := m.Surcharges[len(m.Surcharges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MaxMemoCharacters != 0 {
-		n += 1 + sovAuth(uint64(m.MaxMemoCharacters))
-	}
-	if m.TxSigLimit != 0 {
-		n += 1 + sovAuth(uint64(m.TxSigLimit))
-	}
-	if m.TxSizeCostPerByte != 0 {
-		n += 1 + sovAuth(uint64(m.TxSizeCostPerByte))
-	}
-	if m.SigVerifyCostED25519 != 0 {
-		n += 1 + sovAuth(uint64(m.SigVerifyCostED25519))
-	}
-	if m.SigVerifyCostSecp256k1 != 0 {
-		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovAuth(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAuth(x uint64) (n int) {
-	return sovAuth(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *BaseAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgSurcharge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -658,15 +6183,15 @@ func (m *BaseAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BaseAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSurcharge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BaseAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSurcharge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -694,13 +6219,13 @@ func (m *BaseAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
 			}
-			var msglen int
+			m.Gas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -710,33 +6235,16 @@ func (m *BaseAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Gas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAuth
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PubKey == nil {
-				m.PubKey = &types.Any{}
-			}
-			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			m.AccountNumber = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -746,30 +6254,26 @@ func (m *BaseAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccountNumber |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
 			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types1.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -791,7 +6295,7 @@ func (m *BaseAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
+func (m *FeeRoutingParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -814,15 +6318,15 @@ func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModuleAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeRoutingParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModuleAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeRoutingParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -849,18 +6353,16 @@ func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BaseAccount == nil {
-				m.BaseAccount = &BaseAccount{}
-			}
-			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Routes = append(m.Routes, FeeRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomRoutes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -870,55 +6372,25 @@ func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuth
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.DenomRoutes = append(m.DenomRoutes, DenomFeeRoutes{})
+			if err := m.DenomRoutes[len(m.DenomRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -941,7 +6413,7 @@ func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ModuleCredential) Unmarshal(dAtA []byte) error {
+func (m *FeeRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -964,15 +6436,15 @@ func (m *ModuleCredential) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModuleCredential: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModuleCredential: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1000,13 +6472,13 @@ func (m *ModuleCredential) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DerivationKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -1016,23 +6488,25 @@ func (m *ModuleCredential) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DerivationKeys = append(m.DerivationKeys, make([]byte, postIndex-iNdEx))
-			copy(m.DerivationKeys[len(m.DerivationKeys)-1], dAtA[iNdEx:postIndex])
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1055,7 +6529,7 @@ func (m *ModuleCredential) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *DenomFeeRoutes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1078,17 +6552,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomFeeRoutes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomFeeRoutes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoCharacters", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.MaxMemoCharacters = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -1098,35 +6572,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxMemoCharacters |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxSigLimit", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
 			}
-			m.TxSigLimit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TxSigLimit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxSizeCostPerByte", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.TxSizeCostPerByte = 0
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -1136,49 +6604,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxSizeCostPerByte |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostED25519", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
 			}
-			m.SigVerifyCostED25519 = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SigVerifyCostED25519 |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostSecp256k1", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.SigVerifyCostSecp256k1 = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SigVerifyCostSecp256k1 |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Routes = append(m.Routes, FeeRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
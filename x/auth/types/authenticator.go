@@ -0,0 +1,211 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MaxAuthenticators is the maximum number of authenticators of a smart account.
+	MaxAuthenticators = 8
+
+	// MaxAuthenticatorPubKeys is the maximum number of public keys of an
+	// authenticator.
+	MaxAuthenticatorPubKeys = 16
+)
+
+// AuthenticatorVerifier verifies the signatures of the smart accounts for the
+// authenticators delegating to it, e.g. a contract-based verifier. Verifiers
+// are registered by the application with the account keeper and are expected
+// to consume the gas of the verification.
+type AuthenticatorVerifier interface {
+	// VerifySignature returns an error if the signature of the sign bytes isn't
+	// valid for the account, given the verifier data of its authenticator.
+	VerifySignature(ctx context.Context, account sdk.AccAddress, verifierData, signBytes, signature []byte) error
+}
+
+var _ codectypes.UnpackInterfacesMessage = Authenticator{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a Authenticator) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range a.PubKeys {
+		var pubKey cryptotypes.PubKey
+		if err := unpacker.UnpackAny(any, &pubKey); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetCachedPubKeys returns the cached public keys of the authenticator.
+func (a Authenticator) GetCachedPubKeys() ([]cryptotypes.PubKey, error) {
+	pubKeys := make([]cryptotypes.PubKey, len(a.PubKeys))
+	for i, any := range a.PubKeys {
+		pubKey, ok := any.GetCachedValue().(cryptotypes.PubKey)
+		if !ok {
+			return nil, sdkerrors.ErrInvalidPubKey.Wrapf("expected %T, got %T", (cryptotypes.PubKey)(nil), any.GetCachedValue())
+		}
+		pubKeys[i] = pubKey
+	}
+
+	return pubKeys, nil
+}
+
+// HasPubKey returns true if the signatures of the given public key are accepted
+// by the authenticator.
+func (a Authenticator) HasPubKey(pubKey cryptotypes.PubKey) bool {
+	if pubKey == nil {
+		return false
+	}
+
+	pubKeys, err := a.GetCachedPubKeys()
+	if err != nil {
+		return false
+	}
+
+	for _, pk := range pubKeys {
+		if pk.Equals(pubKey) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate performs a stateless validation of the authenticator.
+func (a Authenticator) Validate() error {
+	switch {
+	case len(a.PubKeys) == 0 && a.Verifier == "":
+		return errors.New("authenticator must have either public keys or a verifier")
+	case len(a.PubKeys) > 0 && a.Verifier != "":
+		return errors.New("authenticator cannot have both public keys and a verifier")
+	case len(a.PubKeys) > MaxAuthenticatorPubKeys:
+		return fmt.Errorf("authenticator cannot have more than %d public keys", MaxAuthenticatorPubKeys)
+	case a.Verifier == "" && len(a.VerifierData) > 0:
+		return errors.New("authenticator verifier data requires a verifier")
+	}
+
+	pubKeys, err := a.GetCachedPubKeys()
+	if err != nil {
+		return err
+	}
+	for i, pk := range pubKeys {
+		for _, other := range pubKeys[:i] {
+			if pk.Equals(other) {
+				return fmt.Errorf("duplicate authenticator public key %s", pk)
+			}
+		}
+	}
+
+	if a.SpendingPolicy != nil {
+		return a.SpendingPolicy.Validate()
+	}
+
+	return nil
+}
+
+// Validate performs a stateless validation of the spending policy.
+func (p SpendingPolicy) Validate() error {
+	if err := p.Limit.Validate(); err != nil {
+		return fmt.Errorf("invalid spending policy limit: %w", err)
+	}
+	if p.Limit.Empty() {
+		return errors.New("spending policy limit cannot be empty")
+	}
+	if p.Period < 0 {
+		return fmt.Errorf("spending policy period cannot be negative: %s", p.Period)
+	}
+	if err := p.Spent.Validate(); err != nil {
+		return fmt.Errorf("invalid spending policy spent coins: %w", err)
+	}
+
+	return nil
+}
+
+// Spend returns the policy with the given coins spent at the given time, moving
+// it to the current period first, and an error if they exceed the limit.
+func (p SpendingPolicy) Spend(now time.Time, amount sdk.Coins) (SpendingPolicy, error) {
+	if p.Period > 0 && !now.Before(p.PeriodStart.Add(p.Period)) {
+		elapsed := now.Sub(p.PeriodStart)
+		p.PeriodStart = p.PeriodStart.Add(elapsed - elapsed%p.Period)
+		p.Spent = nil
+	}
+
+	spent := p.Spent.Add(amount...)
+	if !spent.IsAllLTE(p.Limit) {
+		return p, sdkerrors.ErrUnauthorized.Wrapf("spending policy limit exceeded; spent %s, limit %s", spent, p.Limit)
+	}
+
+	p.Spent = spent
+	return p, nil
+}
+
+// Validate performs a stateless validation of the authenticators of an account.
+func (aa AccountAuthenticators) Validate() error {
+	if len(aa.Authenticators) > MaxAuthenticators {
+		return fmt.Errorf("account %s cannot have more than %d authenticators", aa.Address, MaxAuthenticators)
+	}
+
+	for _, a := range aa.Authenticators {
+		if err := a.Validate(); err != nil {
+			return fmt.Errorf("invalid authenticator %d of account %s: %w", a.Id, aa.Address, err)
+		}
+	}
+
+	return nil
+}
+
+var _ codectypes.UnpackInterfacesMessage = AccountAuthenticators{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (aa AccountAuthenticators) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, a := range aa.Authenticators {
+		if err := a.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SelectedAuthenticator is an authenticator a smart account signing a
+// transaction was authenticated by, along with the balance of the account
+// before the execution of the messages.
+type SelectedAuthenticator struct {
+	Address sdk.AccAddress
+	ID      uint64
+	Balance sdk.Coins
+}
+
+type selectedAuthenticatorsKey struct{}
+
+// ContextWithSelectedAuthenticator returns the context with the authenticator
+// a signer of the transaction was authenticated by added, for its spending
+// policy to be enforced after the execution of the messages.
+func ContextWithSelectedAuthenticator(ctx sdk.Context, selected SelectedAuthenticator) sdk.Context {
+	all := append([]SelectedAuthenticator{}, SelectedAuthenticators(ctx)...)
+	all = append(all, selected)
+	return ctx.WithValue(selectedAuthenticatorsKey{}, all)
+}
+
+// SelectedAuthenticators returns the authenticators with a spending policy the
+// signers of the transaction were authenticated by.
+func SelectedAuthenticators(ctx context.Context) []SelectedAuthenticator {
+	selected, _ := ctx.Value(selectedAuthenticatorsKey{}).([]SelectedAuthenticator)
+	return selected
+}
+
+var _ codectypes.UnpackInterfacesMessage = MsgAddAuthenticator{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgAddAuthenticator) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return msg.Authenticator.UnpackInterfaces(unpacker)
+}
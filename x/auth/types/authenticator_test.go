@@ -0,0 +1,75 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/auth/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestAuthenticatorValidate(t *testing.T) {
+	pk1, err := codectypes.NewAnyWithValue(secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	pk2, err := codectypes.NewAnyWithValue(secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	limit := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	testCases := []struct {
+		name          string
+		authenticator types.Authenticator
+		expErrMsg     string
+	}{
+		{"public keys", types.Authenticator{PubKeys: []*codectypes.Any{pk1, pk2}}, ""},
+		{"verifier", types.Authenticator{Verifier: "passkey", VerifierData: []byte{1}}, ""},
+		{"spending policy", types.Authenticator{PubKeys: []*codectypes.Any{pk1}, SpendingPolicy: &types.SpendingPolicy{Limit: limit, Period: time.Hour}}, ""},
+		{"empty", types.Authenticator{}, "either public keys or a verifier"},
+		{"public keys and verifier", types.Authenticator{PubKeys: []*codectypes.Any{pk1}, Verifier: "passkey"}, "both public keys and a verifier"},
+		{"verifier data without verifier", types.Authenticator{PubKeys: []*codectypes.Any{pk1}, VerifierData: []byte{1}}, "requires a verifier"},
+		{"duplicate public key", types.Authenticator{PubKeys: []*codectypes.Any{pk1, pk2, pk1}}, "duplicate authenticator public key"},
+		{"empty spending limit", types.Authenticator{PubKeys: []*codectypes.Any{pk1}, SpendingPolicy: &types.SpendingPolicy{Period: time.Hour}}, "limit cannot be empty"},
+		{"negative spending period", types.Authenticator{PubKeys: []*codectypes.Any{pk1}, SpendingPolicy: &types.SpendingPolicy{Limit: limit, Period: -time.Hour}}, "period cannot be negative"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.authenticator.Validate()
+			if tc.expErrMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErrMsg)
+			}
+		})
+	}
+}
+
+func TestSpendingPolicySpend(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	policy := types.SpendingPolicy{
+		Limit:       sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		Period:      24 * time.Hour,
+		PeriodStart: start,
+	}
+
+	policy, err := policy.Spend(start.Add(time.Hour), sdk.NewCoins(sdk.NewInt64Coin("stake", 60)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), policy.Spent)
+
+	_, err = policy.Spend(start.Add(2*time.Hour), sdk.NewCoins(sdk.NewInt64Coin("stake", 41)))
+	require.ErrorContains(t, err, "spending policy limit exceeded")
+
+	_, err = policy.Spend(start.Add(2*time.Hour), sdk.NewCoins(sdk.NewInt64Coin("atom", 1)))
+	require.ErrorContains(t, err, "spending policy limit exceeded")
+
+	// the spent coins are reset in a new period, aligned on the first one
+	policy, err = policy.Spend(start.Add(50*time.Hour), sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), policy.Spent)
+	require.Equal(t, start.Add(48*time.Hour), policy.PeriodStart)
+}
@@ -22,6 +22,15 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&ModuleCredential{}, "cosmos-sdk/GroupAccountCredential", nil)
 
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/auth/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateGasPricingPolicy{}, "cosmos-sdk/MsgUpdateGasPricingPolicy")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateFeeMarketParams{}, "cosmos-sdk/MsgUpdateFeeMarketParams")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateFeeAbstractionParams{}, "cosmos-sdk/MsgUpdateFeeAbstraction")
+	legacy.RegisterAminoMsg(cdc, &MsgSetProfile{}, "cosmos-sdk/MsgSetProfile")
+	legacy.RegisterAminoMsg(cdc, &MsgAddAuthenticator{}, "cosmos-sdk/MsgAddAuthenticator")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveAuthenticator{}, "cosmos-sdk/MsgRemoveAuthenticator")
+	legacy.RegisterAminoMsg(cdc, &MsgRotatePubKey{}, "cosmos-sdk/MsgRotatePubKey")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateMsgSurchargeParams{}, "cosmos-sdk/MsgUpdateMsgSurchargeParams")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateFeeRoutingParams{}, "cosmos-sdk/MsgUpdateFeeRoutingParams")
 
 	legacytx.RegisterLegacyAminoCodec(cdc)
 }
@@ -58,5 +67,14 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgUpdateGasPricingPolicy{},
+		&MsgUpdateFeeMarketParams{},
+		&MsgUpdateFeeAbstractionParams{},
+		&MsgSetProfile{},
+		&MsgAddAuthenticator{},
+		&MsgRemoveAuthenticator{},
+		&MsgRotatePubKey{},
+		&MsgUpdateMsgSurchargeParams{},
+		&MsgUpdateFeeRoutingParams{},
 	)
 }
@@ -0,0 +1,25 @@
+package types
+
+// auth module event types
+const (
+	EventTypeSetProfile   = "set_profile"
+	EventTypeClearProfile = "clear_profile"
+	EventTypeConvertFee   = "convert_fee"
+	EventTypeMsgSurcharge = "msg_surcharge"
+	EventTypeRouteFee     = "route_fee"
+
+	EventTypeAddAuthenticator    = "add_authenticator"
+	EventTypeRemoveAuthenticator = "remove_authenticator"
+	EventTypeRotatePubKey        = "rotate_pub_key"
+
+	AttributeKeyAddress      = "address"
+	AttributeKeyDisplayName  = "display_name"
+	AttributeKeyAvatarHash   = "avatar_hash"
+	AttributeKeyPaidFee      = "paid_fee"
+	AttributeKeyNativeFee    = "native_fee"
+	AttributeKeySurchargeGas = "surcharge_gas"
+	AttributeKeySink         = "sink"
+
+	AttributeKeyAuthenticatorID = "authenticator_id"
+	AttributeKeyRotationID      = "rotation_id"
+)
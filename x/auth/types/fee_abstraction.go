@@ -0,0 +1,52 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs a stateless validation of the fee abstraction parameters.
+func (p FeeAbstractionParams) Validate() error {
+	if p.Enabled {
+		if err := sdk.ValidateDenom(p.NativeDenom); err != nil {
+			return fmt.Errorf("invalid fee abstraction native denom: %w", err)
+		}
+		if p.Reserve == "" {
+			return errors.New("fee abstraction reserve cannot be empty when fee abstraction is enabled")
+		}
+	}
+
+	seenDenoms := make(map[string]bool, len(p.AcceptedDenoms))
+	for _, accepted := range p.AcceptedDenoms {
+		if err := sdk.ValidateDenom(accepted.Denom); err != nil {
+			return fmt.Errorf("invalid fee abstraction accepted denom: %w", err)
+		}
+		if accepted.Denom == p.NativeDenom {
+			return fmt.Errorf("fee abstraction accepted denom %s cannot be the native denom", accepted.Denom)
+		}
+		if seenDenoms[accepted.Denom] {
+			return fmt.Errorf("duplicate fee abstraction accepted denom %s", accepted.Denom)
+		}
+		seenDenoms[accepted.Denom] = true
+
+		if accepted.ConversionRate.IsNil() || accepted.ConversionRate.IsNegative() {
+			return fmt.Errorf("fee abstraction conversion rate of %s cannot be negative: %s", accepted.Denom, accepted.ConversionRate)
+		}
+	}
+
+	return nil
+}
+
+// AcceptedDenom returns the accepted fee denom with the given denom, and false
+// if the denom isn't accepted.
+func (p FeeAbstractionParams) AcceptedDenom(denom string) (AcceptedFeeDenom, bool) {
+	for _, accepted := range p.AcceptedDenoms {
+		if accepted.Denom == denom {
+			return accepted, true
+		}
+	}
+
+	return AcceptedFeeDenom{}, false
+}
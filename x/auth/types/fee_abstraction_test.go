@@ -0,0 +1,54 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/auth/types"
+)
+
+func TestFeeAbstractionParamsValidate(t *testing.T) {
+	valid := types.FeeAbstractionParams{
+		Enabled:     true,
+		NativeDenom: "stake",
+		Reserve:     "mint",
+		AcceptedDenoms: []types.AcceptedFeeDenom{
+			{Denom: "usdc", ConversionRate: math.LegacyNewDecWithPrec(25, 1)},
+			{Denom: "atom", ConversionRate: math.LegacyZeroDec()},
+		},
+	}
+	require.NoError(t, valid.Validate())
+	require.NoError(t, types.FeeAbstractionParams{}.Validate())
+
+	invalid := valid
+	invalid.NativeDenom = ""
+	require.Error(t, invalid.Validate())
+
+	invalid = valid
+	invalid.Reserve = ""
+	require.Error(t, invalid.Validate())
+
+	invalid = valid
+	invalid.AcceptedDenoms = []types.AcceptedFeeDenom{{Denom: "stake", ConversionRate: math.LegacyOneDec()}}
+	require.Error(t, invalid.Validate())
+
+	invalid = valid
+	invalid.AcceptedDenoms = append(invalid.AcceptedDenoms, types.AcceptedFeeDenom{Denom: "usdc", ConversionRate: math.LegacyOneDec()})
+	require.Error(t, invalid.Validate())
+
+	invalid = valid
+	invalid.AcceptedDenoms = []types.AcceptedFeeDenom{{Denom: "usdc", ConversionRate: math.LegacyNewDec(-1)}}
+	require.Error(t, invalid.Validate())
+
+	invalid = valid
+	invalid.AcceptedDenoms = []types.AcceptedFeeDenom{{Denom: "usdc"}}
+	require.Error(t, invalid.Validate())
+
+	accepted, ok := valid.AcceptedDenom("usdc")
+	require.True(t, ok)
+	require.Equal(t, valid.AcceptedDenoms[0], accepted)
+	_, ok = valid.AcceptedDenom("stake")
+	require.False(t, ok)
+}
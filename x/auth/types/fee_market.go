@@ -0,0 +1,145 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs a stateless validation of the fee market parameters.
+func (p FeeMarketParams) Validate() error {
+	if !p.MinBaseFee.IsNil() && p.MinBaseFee.IsNegative() {
+		return fmt.Errorf("fee market min base fee cannot be negative: %s", p.MinBaseFee)
+	}
+
+	if !p.Enabled {
+		return nil
+	}
+
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return fmt.Errorf("invalid fee market denom: %w", err)
+	}
+	if p.TargetBlockGas == 0 {
+		return errors.New("fee market target block gas cannot be zero when the fee market is enabled")
+	}
+	if p.BaseFeeChangeDenominator == 0 {
+		return errors.New("fee market base fee change denominator cannot be zero when the fee market is enabled")
+	}
+	if p.MinBaseFee.IsNil() || !p.MinBaseFee.IsPositive() {
+		return errors.New("fee market min base fee must be positive when the fee market is enabled")
+	}
+
+	return nil
+}
+
+// minBaseFee returns the min base fee, zero if unset.
+func (p FeeMarketParams) minBaseFee() math.LegacyDec {
+	if p.MinBaseFee.IsNil() {
+		return math.LegacyZeroDec()
+	}
+
+	return p.MinBaseFee
+}
+
+// NextBaseFee returns the base fee of the block following a block with the
+// given base fee and gas used, where
+// next = baseFee * (1 + (gasUsed - targetBlockGas) / targetBlockGas / baseFeeChangeDenominator),
+// bounded below by the min base fee.
+func (p FeeMarketParams) NextBaseFee(baseFee math.LegacyDec, gasUsed uint64) math.LegacyDec {
+	minBaseFee := p.minBaseFee()
+	if baseFee.IsNil() || baseFee.LT(minBaseFee) {
+		baseFee = minBaseFee
+	}
+	if p.TargetBlockGas == 0 || p.BaseFeeChangeDenominator == 0 || gasUsed == p.TargetBlockGas {
+		return baseFee
+	}
+
+	gasUsedDelta := math.NewIntFromUint64(gasUsed).Sub(math.NewIntFromUint64(p.TargetBlockGas))
+	delta := baseFee.MulInt(gasUsedDelta).
+		QuoInt(math.NewIntFromUint64(p.TargetBlockGas)).
+		QuoInt(math.NewIntFromUint64(p.BaseFeeChangeDenominator))
+
+	next := baseFee.Add(delta)
+	if next.LT(minBaseFee) {
+		return minBaseFee
+	}
+
+	return next
+}
+
+// RequiredFee returns the base fee owed by a transaction with the given gas
+// limit, where fee = ceil(baseFee * gasLimit).
+func (p FeeMarketParams) RequiredFee(baseFee math.LegacyDec, gas uint64) sdk.Coins {
+	fee := baseFee.MulInt(math.NewIntFromUint64(gas)).Ceil().RoundInt()
+	return sdk.NewCoins(sdk.NewCoin(p.Denom, fee))
+}
+
+// BaseFeeAt returns the base fee at the given height. The state holds the base
+// fee and gas used of the last block a transaction was executed in, so the base
+// fee is updated for this block and then decreased for every empty block up to
+// the given height.
+func (s FeeMarketState) BaseFeeAt(p FeeMarketParams, height int64) math.LegacyDec {
+	baseFee := s.BaseFee
+	if minBaseFee := p.minBaseFee(); baseFee.IsNil() || baseFee.LT(minBaseFee) {
+		baseFee = minBaseFee
+	}
+	if height <= s.Height {
+		return baseFee
+	}
+
+	baseFee = p.NextBaseFee(baseFee, s.BlockGasUsed)
+	for empty := height - s.Height - 1; empty > 0; empty-- {
+		next := p.NextBaseFee(baseFee, 0)
+		if next.Equal(baseFee) {
+			break
+		}
+		baseFee = next
+	}
+
+	return baseFee
+}
+
+// Record returns the state updated with a transaction of the given gas limit
+// executed at the given height, moving the state to this block first if it
+// holds a previous block.
+func (s FeeMarketState) Record(p FeeMarketParams, height int64, gas uint64) FeeMarketState {
+	if height > s.Height {
+		s = FeeMarketState{
+			BaseFee: s.BaseFeeAt(p, height),
+			Height:  height,
+		}
+	}
+
+	s.BlockGasUsed += gas
+	return s
+}
+
+// Refund returns the state updated with the given gas, recorded at the given
+// height but left unused by a transaction, removed from the gas used by the
+// block. The state is left unchanged if it holds another block.
+func (s FeeMarketState) Refund(height int64, gas uint64) FeeMarketState {
+	if height != s.Height {
+		return s
+	}
+
+	if gas > s.BlockGasUsed {
+		gas = s.BlockGasUsed
+	}
+	s.BlockGasUsed -= gas
+	return s
+}
+
+// Validate performs a stateless validation of the fee market state.
+func (s FeeMarketState) Validate() error {
+	if !s.BaseFee.IsNil() && s.BaseFee.IsNegative() {
+		return fmt.Errorf("fee market base fee cannot be negative: %s", s.BaseFee)
+	}
+	if s.Height < 0 {
+		return fmt.Errorf("fee market height cannot be negative: %d", s.Height)
+	}
+
+	return nil
+}
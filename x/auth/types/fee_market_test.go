@@ -0,0 +1,87 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestFeeMarketParamsValidate(t *testing.T) {
+	valid := types.FeeMarketParams{
+		Enabled:                  true,
+		Denom:                    "stake",
+		MinBaseFee:               math.LegacyNewDecWithPrec(1, 2),
+		TargetBlockGas:           1000,
+		BaseFeeChangeDenominator: 8,
+	}
+	require.NoError(t, valid.Validate())
+	require.NoError(t, types.FeeMarketParams{}.Validate())
+
+	invalid := valid
+	invalid.MinBaseFee = math.LegacyNewDec(-1)
+	require.Error(t, invalid.Validate())
+
+	invalid = valid
+	invalid.MinBaseFee = math.LegacyZeroDec()
+	require.Error(t, invalid.Validate())
+
+	invalid = valid
+	invalid.MinBaseFee = math.LegacyDec{}
+	require.Error(t, invalid.Validate())
+
+	invalid = valid
+	invalid.Denom = ""
+	require.Error(t, invalid.Validate())
+
+	invalid = valid
+	invalid.TargetBlockGas = 0
+	require.Error(t, invalid.Validate())
+
+	invalid = valid
+	invalid.BaseFeeChangeDenominator = 0
+	require.Error(t, invalid.Validate())
+}
+
+func TestFeeMarketBaseFee(t *testing.T) {
+	params := types.FeeMarketParams{
+		Enabled:                  true,
+		Denom:                    "stake",
+		MinBaseFee:               math.LegacyNewDec(10),
+		TargetBlockGas:           1000,
+		BaseFeeChangeDenominator: 8,
+	}
+	baseFee := math.LegacyNewDec(80)
+
+	// full blocks increase the base fee by at most 1/8, empty ones decrease it
+	// by at most 1/8
+	require.Equal(t, math.LegacyNewDec(80), params.NextBaseFee(baseFee, 1000))
+	require.Equal(t, math.LegacyNewDec(90), params.NextBaseFee(baseFee, 2000))
+	require.Equal(t, math.LegacyNewDec(85), params.NextBaseFee(baseFee, 1500))
+	require.Equal(t, math.LegacyNewDec(70), params.NextBaseFee(baseFee, 0))
+	require.Equal(t, math.LegacyNewDec(10), params.NextBaseFee(math.LegacyNewDec(11), 0))
+	require.Equal(t, math.LegacyNewDec(10), params.NextBaseFee(math.LegacyDec{}, 1000))
+
+	state := types.FeeMarketState{BaseFee: baseFee, Height: 5}
+	state = state.Record(params, 5, 1200).Record(params, 5, 800)
+	require.Equal(t, uint64(2000), state.BlockGasUsed)
+	require.Equal(t, baseFee, state.BaseFeeAt(params, 5))
+	require.Equal(t, math.LegacyNewDec(90), state.BaseFeeAt(params, 6))
+	require.Equal(t, math.LegacyMustNewDecFromStr("78.75"), state.BaseFeeAt(params, 7))
+	require.Equal(t, math.LegacyNewDec(10), state.BaseFeeAt(params, 1000))
+
+	state = state.Record(params, 7, 100)
+	require.Equal(t, types.FeeMarketState{BaseFee: math.LegacyMustNewDecFromStr("78.75"), Height: 7, BlockGasUsed: 100}, state)
+
+	// the unused gas is only refunded to the block it was recorded in
+	require.Equal(t, uint64(60), state.Refund(7, 40).BlockGasUsed)
+	require.Equal(t, uint64(0), state.Refund(7, 140).BlockGasUsed)
+	require.Equal(t, state, state.Refund(8, 40))
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 7875)), params.RequiredFee(state.BaseFee, 100))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 79)), params.RequiredFee(state.BaseFee, 1))
+}
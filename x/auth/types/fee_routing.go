@@ -0,0 +1,130 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RoutedFees are the fees routed out of the fee collector to a sink, burned if
+// the recipient is empty.
+type RoutedFees struct {
+	Recipient string
+	Amount    sdk.Coins
+}
+
+// Validate performs a stateless validation of the fee routing parameters.
+func (p FeeRoutingParams) Validate() error {
+	if err := validateFeeRoutes(p.Routes); err != nil {
+		return err
+	}
+
+	seenDenoms := make(map[string]bool, len(p.DenomRoutes))
+	for _, denomRoutes := range p.DenomRoutes {
+		if err := sdk.ValidateDenom(denomRoutes.Denom); err != nil {
+			return fmt.Errorf("invalid fee routes denom: %w", err)
+		}
+		if seenDenoms[denomRoutes.Denom] {
+			return fmt.Errorf("duplicate fee routes for denom %s", denomRoutes.Denom)
+		}
+		seenDenoms[denomRoutes.Denom] = true
+
+		if err := validateFeeRoutes(denomRoutes.Routes); err != nil {
+			return fmt.Errorf("invalid fee routes for denom %s: %w", denomRoutes.Denom, err)
+		}
+	}
+
+	return nil
+}
+
+func validateFeeRoutes(routes []FeeRoute) error {
+	if len(routes) == 0 {
+		return nil
+	}
+
+	total := math.LegacyZeroDec()
+	seenRecipients := make(map[string]bool, len(routes))
+	for _, route := range routes {
+		if seenRecipients[route.Recipient] {
+			return fmt.Errorf("duplicate fee route recipient %q", route.Recipient)
+		}
+		seenRecipients[route.Recipient] = true
+
+		if route.Share.IsNil() || !route.Share.IsPositive() || route.Share.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("fee route share of %q must be positive and at most one: %s", route.Recipient, route.Share)
+		}
+		total = total.Add(route.Share)
+	}
+
+	if !total.Equal(math.LegacyOneDec()) {
+		return fmt.Errorf("fee route shares must add up to one: %s", total)
+	}
+
+	return nil
+}
+
+// RoutesOf returns the routes of the fees paid in the given denom, the denom
+// overrides taking precedence over the default routes.
+func (p FeeRoutingParams) RoutesOf(denom string) []FeeRoute {
+	for _, denomRoutes := range p.DenomRoutes {
+		if denomRoutes.Denom == denom {
+			return denomRoutes.Routes
+		}
+	}
+
+	return p.Routes
+}
+
+// Split returns the fees routed out of the fee collector to every sink, in the
+// order the routes are set, the default routes first. The share of every sink
+// is rounded down, the remainder staying in the fee collector.
+func (p FeeRoutingParams) Split(fees sdk.Coins) []RoutedFees {
+	var (
+		routed  []RoutedFees
+		indexes = make(map[string]int)
+	)
+
+	add := func(recipient string, coin sdk.Coin) {
+		i, ok := indexes[recipient]
+		if !ok {
+			i = len(routed)
+			indexes[recipient] = i
+			routed = append(routed, RoutedFees{Recipient: recipient})
+		}
+		routed[i].Amount = routed[i].Amount.Add(coin)
+	}
+
+	// register the default sinks first so the order does not depend on the
+	// denoms of the fees
+	for _, route := range p.Routes {
+		if route.Recipient != FeeCollectorName {
+			indexes[route.Recipient] = len(routed)
+			routed = append(routed, RoutedFees{Recipient: route.Recipient})
+		}
+	}
+
+	for _, coin := range fees {
+		for _, route := range p.RoutesOf(coin.Denom) {
+			if route.Recipient == FeeCollectorName {
+				continue
+			}
+
+			amount := route.Share.MulInt(coin.Amount).TruncateInt()
+			if amount.IsPositive() {
+				add(route.Recipient, sdk.NewCoin(coin.Denom, amount))
+			}
+		}
+	}
+
+	// drop the sinks receiving nothing
+	nonEmpty := routed[:0]
+	for _, r := range routed {
+		if !r.Amount.IsZero() {
+			nonEmpty = append(nonEmpty, r)
+		}
+	}
+
+	return nonEmpty
+}
@@ -0,0 +1,80 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestFeeRoutingParamsValidate(t *testing.T) {
+	half := math.LegacyNewDecWithPrec(5, 1)
+	valid := types.FeeRoutingParams{
+		Routes: []types.FeeRoute{
+			{Recipient: types.FeeCollectorName, Share: half},
+			{Recipient: "", Share: math.LegacyNewDecWithPrec(3, 1)},
+			{Recipient: "protocolpool", Share: math.LegacyNewDecWithPrec(2, 1)},
+		},
+		DenomRoutes: []types.DenomFeeRoutes{
+			{Denom: "uatom", Routes: []types.FeeRoute{{Recipient: "", Share: math.LegacyOneDec()}}},
+			{Denom: "uosmo"},
+		},
+	}
+	require.NoError(t, valid.Validate())
+	require.NoError(t, types.FeeRoutingParams{}.Validate())
+
+	invalid := types.FeeRoutingParams{Routes: []types.FeeRoute{{Recipient: "", Share: half}}}
+	require.ErrorContains(t, invalid.Validate(), "add up to one")
+
+	invalid = types.FeeRoutingParams{Routes: []types.FeeRoute{{Recipient: "", Share: half}, {Recipient: "", Share: half}}}
+	require.ErrorContains(t, invalid.Validate(), "duplicate fee route recipient")
+
+	invalid = types.FeeRoutingParams{Routes: []types.FeeRoute{{Recipient: "", Share: math.LegacyNewDec(2)}, {Recipient: "mint", Share: math.LegacyNewDec(-1)}}}
+	require.ErrorContains(t, invalid.Validate(), "must be positive and at most one")
+
+	invalid = types.FeeRoutingParams{Routes: []types.FeeRoute{{Recipient: ""}}}
+	require.Error(t, invalid.Validate())
+
+	invalid = types.FeeRoutingParams{DenomRoutes: []types.DenomFeeRoutes{{Denom: "uosmo"}, {Denom: "uosmo"}}}
+	require.ErrorContains(t, invalid.Validate(), "duplicate fee routes for denom")
+
+	invalid = types.FeeRoutingParams{DenomRoutes: []types.DenomFeeRoutes{{Denom: "1"}}}
+	require.Error(t, invalid.Validate())
+
+	invalid = types.FeeRoutingParams{DenomRoutes: []types.DenomFeeRoutes{{Denom: "uosmo", Routes: []types.FeeRoute{{Recipient: "", Share: half}}}}}
+	require.ErrorContains(t, invalid.Validate(), "invalid fee routes for denom uosmo")
+}
+
+func TestFeeRoutingParamsSplit(t *testing.T) {
+	params := types.FeeRoutingParams{
+		Routes: []types.FeeRoute{
+			{Recipient: types.FeeCollectorName, Share: math.LegacyNewDecWithPrec(5, 1)},
+			{Recipient: "", Share: math.LegacyNewDecWithPrec(3, 1)},
+			{Recipient: "protocolpool", Share: math.LegacyNewDecWithPrec(2, 1)},
+		},
+		DenomRoutes: []types.DenomFeeRoutes{
+			{Denom: "uatom", Routes: []types.FeeRoute{{Recipient: "protocolpool", Share: math.LegacyOneDec()}}},
+			{Denom: "uosmo"},
+		},
+	}
+
+	require.Empty(t, types.FeeRoutingParams{}.Split(sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	require.Empty(t, params.Split(nil))
+	require.Empty(t, params.Split(sdk.NewCoins(sdk.NewInt64Coin("uosmo", 100))))
+
+	// the shares are rounded down, the remainder staying in the fee collector
+	require.Equal(t, []types.RoutedFees{
+		{Recipient: "", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 2))},
+		{Recipient: "protocolpool", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))},
+	}, params.Split(sdk.NewCoins(sdk.NewInt64Coin("stake", 9))))
+
+	// the denom overrides take precedence over the default routes
+	require.Equal(t, []types.RoutedFees{
+		{Recipient: "", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 30))},
+		{Recipient: "protocolpool", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 20), sdk.NewInt64Coin("uatom", 7))},
+	}, params.Split(sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("uatom", 7), sdk.NewInt64Coin("uosmo", 5))))
+}
@@ -0,0 +1,132 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs a stateless validation of the gas pricing policy.
+func (p GasPricingPolicy) Validate() error {
+	if err := p.MinGasPrices.Validate(); err != nil {
+		return fmt.Errorf("invalid gas pricing min gas prices: %w", err)
+	}
+
+	if p.Enabled {
+		if p.MinGasPrices.IsZero() {
+			return errors.New("gas pricing min gas prices cannot be empty when the policy is enabled")
+		}
+		if p.ActivityWindow == 0 {
+			return errors.New("gas pricing activity window cannot be zero when the policy is enabled")
+		}
+	}
+
+	for i, tier := range p.Tiers {
+		if tier.FeeMultiplier.IsNil() || !tier.FeeMultiplier.IsPositive() {
+			return fmt.Errorf("gas pricing tier %d fee multiplier must be positive: %s", i, tier.FeeMultiplier)
+		}
+	}
+
+	return nil
+}
+
+// FeeMultiplier returns the fee multiplier of the first tier the account qualifies
+// for at the given height, or one if it qualifies for none or if the policy is
+// disabled.
+func (p GasPricingPolicy) FeeMultiplier(activity AccountActivity, height int64) math.LegacyDec {
+	if !p.Enabled {
+		return math.LegacyOneDec()
+	}
+
+	age, recentTxs := activity.Age(height), activity.RecentTxs(height, p.ActivityWindow)
+	for _, tier := range p.Tiers {
+		if age >= tier.MinAccountAge && recentTxs >= tier.MinRecentTxs {
+			return tier.FeeMultiplier
+		}
+	}
+
+	return math.LegacyOneDec()
+}
+
+// RequiredFees returns the fees required from a transaction with the given gas
+// limit and fee multiplier, where fee = ceil(minGasPrice * multiplier * gasLimit).
+func (p GasPricingPolicy) RequiredFees(gas uint64, multiplier math.LegacyDec) sdk.Coins {
+	requiredFees := make(sdk.Coins, len(p.MinGasPrices))
+
+	glDec := math.LegacyNewDecFromInt(math.NewIntFromUint64(gas))
+	for i, gp := range p.MinGasPrices {
+		fee := gp.Amount.Mul(multiplier).Mul(glDec)
+		requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+	}
+
+	return requiredFees
+}
+
+// NewAccountActivity returns the activity of an account seen for the first time
+// at the given height.
+func NewAccountActivity(address string, height int64) AccountActivity {
+	return AccountActivity{
+		Address:           address,
+		FirstSeenHeight:   height,
+		WindowStartHeight: height,
+	}
+}
+
+// Age returns the number of blocks since the account was first seen.
+func (a AccountActivity) Age(height int64) uint64 {
+	if a.Address == "" || height < a.FirstSeenHeight {
+		return 0
+	}
+
+	return uint64(height - a.FirstSeenHeight)
+}
+
+// RecentTxs returns the number of transactions of the account within the last
+// one to two activity windows, depending on how far the current window went.
+func (a AccountActivity) RecentTxs(height int64, window uint64) uint64 {
+	if a.Address == "" || window == 0 || height < a.WindowStartHeight {
+		return 0
+	}
+
+	switch elapsed := uint64(height - a.WindowStartHeight); {
+	case elapsed < window:
+		return a.WindowTxCount + a.PreviousWindowTxCount
+	case elapsed < 2*window:
+		return a.WindowTxCount
+	default:
+		return 0
+	}
+}
+
+// Record returns the activity updated with a transaction at the given height,
+// starting a new activity window if the current one is over.
+func (a AccountActivity) Record(height int64, window uint64) AccountActivity {
+	if window > 0 && height >= a.WindowStartHeight {
+		if elapsed := uint64(height - a.WindowStartHeight); elapsed >= window {
+			a.PreviousWindowTxCount = 0
+			if elapsed < 2*window {
+				a.PreviousWindowTxCount = a.WindowTxCount
+			}
+			a.WindowStartHeight = height
+			a.WindowTxCount = 0
+		}
+	}
+
+	a.WindowTxCount++
+	return a
+}
+
+// Validate performs a stateless validation of the account activity.
+func (a AccountActivity) Validate() error {
+	if a.Address == "" {
+		return errors.New("account activity address cannot be empty")
+	}
+	if a.FirstSeenHeight < 0 || a.WindowStartHeight < a.FirstSeenHeight {
+		return fmt.Errorf("invalid account activity heights for %s: first seen %d, window start %d", a.Address, a.FirstSeenHeight, a.WindowStartHeight)
+	}
+
+	return nil
+}
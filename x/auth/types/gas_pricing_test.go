@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGasPricingPolicyFeeMultiplier(t *testing.T) {
+	policy := types.GasPricingPolicy{
+		Enabled:        true,
+		MinGasPrices:   sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 1)),
+		ActivityWindow: 10,
+		Tiers: []types.GasPricingTier{
+			{MinAccountAge: 100, MinRecentTxs: 2, FeeMultiplier: math.LegacyNewDecWithPrec(5, 1)},
+			{MinAccountAge: 10, FeeMultiplier: math.LegacyOneDec()},
+			{FeeMultiplier: math.LegacyNewDec(2)},
+		},
+	}
+	require.NoError(t, policy.Validate())
+
+	activity := types.NewAccountActivity("cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", 1).Record(1, policy.ActivityWindow)
+	require.Equal(t, math.LegacyNewDec(2), policy.FeeMultiplier(activity, 5))
+	require.Equal(t, math.LegacyOneDec(), policy.FeeMultiplier(activity, 105))
+	require.Equal(t, math.LegacyNewDec(2), policy.FeeMultiplier(types.AccountActivity{}, 105))
+
+	// the transactions of the previous window count until the current one ends
+	activity = activity.Record(104, policy.ActivityWindow).Record(115, policy.ActivityWindow)
+	require.Equal(t, uint64(1), activity.PreviousWindowTxCount)
+	require.Equal(t, uint64(2), activity.RecentTxs(116, policy.ActivityWindow))
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 1), policy.FeeMultiplier(activity, 116))
+	require.Equal(t, uint64(1), activity.RecentTxs(126, policy.ActivityWindow))
+	require.Equal(t, uint64(0), activity.RecentTxs(135, policy.ActivityWindow))
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 51)), policy.RequiredFees(101, math.LegacyNewDecWithPrec(5, 1)))
+
+	policy.Enabled = false
+	require.Equal(t, math.LegacyOneDec(), policy.FeeMultiplier(activity, 116))
+}
+
+func TestGasPricingPolicyValidate(t *testing.T) {
+	minGasPrices := sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 1))
+
+	testCases := []struct {
+		name   string
+		policy types.GasPricingPolicy
+		expErr bool
+	}{
+		{"default", types.GasPricingPolicy{}, false},
+		{"enabled", types.GasPricingPolicy{Enabled: true, MinGasPrices: minGasPrices, ActivityWindow: 1}, false},
+		{"enabled without min gas prices", types.GasPricingPolicy{Enabled: true, ActivityWindow: 1}, true},
+		{"enabled without activity window", types.GasPricingPolicy{Enabled: true, MinGasPrices: minGasPrices}, true},
+		{"negative fee multiplier", types.GasPricingPolicy{Tiers: []types.GasPricingTier{{FeeMultiplier: math.LegacyNewDec(-1)}}}, true},
+		{"nil fee multiplier", types.GasPricingPolicy{Tiers: []types.GasPricingTier{{}}}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -40,6 +40,16 @@ func (g GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 			return err
 		}
 	}
+	for _, aa := range g.Authenticators {
+		if err := aa.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	for _, r := range g.PubKeyRotations {
+		if err := r.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -67,6 +77,89 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	if err := data.GasPricingPolicy.Validate(); err != nil {
+		return err
+	}
+
+	if err := data.FeeMarketParams.Validate(); err != nil {
+		return err
+	}
+
+	if err := data.FeeMarketState.Validate(); err != nil {
+		return err
+	}
+
+	if err := data.FeeAbstractionParams.Validate(); err != nil {
+		return err
+	}
+
+	if err := data.MsgSurchargeParams.Validate(); err != nil {
+		return err
+	}
+
+	if err := data.FeeRoutingParams.Validate(); err != nil {
+		return err
+	}
+
+	seenActivities := make(map[string]bool, len(data.AccountActivities))
+	for _, activity := range data.AccountActivities {
+		if seenActivities[activity.Address] {
+			return fmt.Errorf("duplicate account activity found in genesis state; address: %s", activity.Address)
+		}
+		seenActivities[activity.Address] = true
+
+		if err := activity.Validate(); err != nil {
+			return err
+		}
+	}
+
+	seenProfiles := make(map[string]bool, len(data.Profiles))
+	for _, profile := range data.Profiles {
+		if seenProfiles[profile.Address] {
+			return fmt.Errorf("duplicate account profile found in genesis state; address: %s", profile.Address)
+		}
+		seenProfiles[profile.Address] = true
+
+		if profile.IsEmpty() {
+			return fmt.Errorf("empty account profile found in genesis state; address: %s", profile.Address)
+		}
+		if err := profile.Validate(); err != nil {
+			return err
+		}
+	}
+
+	seenSmartAccounts := make(map[string]bool, len(data.Authenticators))
+	seenAuthenticators := make(map[uint64]bool)
+	for _, aa := range data.Authenticators {
+		if seenSmartAccounts[aa.Address] {
+			return fmt.Errorf("duplicate account authenticators found in genesis state; address: %s", aa.Address)
+		}
+		seenSmartAccounts[aa.Address] = true
+
+		for _, a := range aa.Authenticators {
+			if seenAuthenticators[a.Id] {
+				return fmt.Errorf("duplicate authenticator found in genesis state; id: %d", a.Id)
+			}
+			seenAuthenticators[a.Id] = true
+		}
+
+		if err := aa.Validate(); err != nil {
+			return err
+		}
+	}
+
+	seenRotations := make(map[uint64]bool, len(data.PubKeyRotations))
+	for _, r := range data.PubKeyRotations {
+		if seenRotations[r.Id] {
+			return fmt.Errorf("duplicate pub key rotation found in genesis state; id: %d", r.Id)
+		}
+		seenRotations[r.Id] = true
+
+		if err := r.Validate(); err != nil {
+			return err
+		}
+	}
+
 	genAccs, err := UnpackAccounts(data.Accounts)
 	if err != nil {
 		return err
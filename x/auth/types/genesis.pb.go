@@ -31,6 +31,26 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// accounts are the accounts present at genesis.
 	Accounts []*types.Any `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// gas_pricing_policy defines the tiered gas pricing policy.
+	GasPricingPolicy GasPricingPolicy `protobuf:"bytes,3,opt,name=gas_pricing_policy,json=gasPricingPolicy,proto3" json:"gas_pricing_policy"`
+	// account_activities are the tracked account activities.
+	AccountActivities []AccountActivity `protobuf:"bytes,4,rep,name=account_activities,json=accountActivities,proto3" json:"account_activities"`
+	// profiles are the account profiles.
+	Profiles []AccountProfile `protobuf:"bytes,5,rep,name=profiles,proto3" json:"profiles"`
+	// fee_market_params defines the parameters of the fee market.
+	FeeMarketParams FeeMarketParams `protobuf:"bytes,6,opt,name=fee_market_params,json=feeMarketParams,proto3" json:"fee_market_params"`
+	// fee_market_state defines the state of the fee market.
+	FeeMarketState FeeMarketState `protobuf:"bytes,7,opt,name=fee_market_state,json=feeMarketState,proto3" json:"fee_market_state"`
+	// fee_abstraction_params defines the fee denoms converted to the native fee denom.
+	FeeAbstractionParams FeeAbstractionParams `protobuf:"bytes,8,opt,name=fee_abstraction_params,json=feeAbstractionParams,proto3" json:"fee_abstraction_params"`
+	// authenticators are the authenticators of the smart accounts.
+	Authenticators []AccountAuthenticators `protobuf:"bytes,9,rep,name=authenticators,proto3" json:"authenticators"`
+	// pub_key_rotations are the public key rotations of the accounts.
+	PubKeyRotations []PubKeyRotation `protobuf:"bytes,10,rep,name=pub_key_rotations,json=pubKeyRotations,proto3" json:"pub_key_rotations"`
+	// msg_surcharge_params defines the surcharges applied to the messages of given types.
+	MsgSurchargeParams MsgSurchargeParams `protobuf:"bytes,11,opt,name=msg_surcharge_params,json=msgSurchargeParams,proto3" json:"msg_surcharge_params"`
+	// fee_routing_params defines how the transaction fees are split between sinks.
+	FeeRoutingParams FeeRoutingParams `protobuf:"bytes,12,opt,name=fee_routing_params,json=feeRoutingParams,proto3" json:"fee_routing_params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,6 +100,76 @@ func (m *GenesisState) GetAccounts() []*types.Any {
 	return nil
 }
 
+func (m *GenesisState) GetGasPricingPolicy() GasPricingPolicy {
+	if m != nil {
+		return m.GasPricingPolicy
+	}
+	return GasPricingPolicy{}
+}
+
+func (m *GenesisState) GetAccountActivities() []AccountActivity {
+	if m != nil {
+		return m.AccountActivities
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProfiles() []AccountProfile {
+	if m != nil {
+		return m.Profiles
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeMarketParams() FeeMarketParams {
+	if m != nil {
+		return m.FeeMarketParams
+	}
+	return FeeMarketParams{}
+}
+
+func (m *GenesisState) GetFeeMarketState() FeeMarketState {
+	if m != nil {
+		return m.FeeMarketState
+	}
+	return FeeMarketState{}
+}
+
+func (m *GenesisState) GetFeeAbstractionParams() FeeAbstractionParams {
+	if m != nil {
+		return m.FeeAbstractionParams
+	}
+	return FeeAbstractionParams{}
+}
+
+func (m *GenesisState) GetAuthenticators() []AccountAuthenticators {
+	if m != nil {
+		return m.Authenticators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPubKeyRotations() []PubKeyRotation {
+	if m != nil {
+		return m.PubKeyRotations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMsgSurchargeParams() MsgSurchargeParams {
+	if m != nil {
+		return m.MsgSurchargeParams
+	}
+	return MsgSurchargeParams{}
+}
+
+func (m *GenesisState) GetFeeRoutingParams() FeeRoutingParams {
+	if m != nil {
+		return m.FeeRoutingParams
+	}
+	return FeeRoutingParams{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.auth.v1beta1.GenesisState")
 }
@@ -87,24 +177,43 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/genesis.proto", fileDescriptor_d897ccbce9822332) }
 
 var fileDescriptor_d897ccbce9822332 = []byte{
-	// 258 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x03, 0x29, 0xd1, 0x83, 0x2a, 0x91, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5,
-	0x07, 0x2b, 0x49, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xa8, 0x97, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0xa8, 0xa8, 0x1c, 0x36, 0x8b, 0xc0, 0x46, 0x42, 0xe4, 0x05,
-	0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x48, 0xa9, 0x81, 0x91, 0x8b, 0xc7, 0x1d,
-	0xe2, 0x94, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x3b, 0x2e, 0xb6, 0x82, 0xc4, 0xa2, 0xc4, 0xdc,
-	0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x69, 0x3d, 0x2c, 0x4e, 0xd3, 0x0b, 0x00, 0x2b,
-	0x71, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x5d, 0x42,
-	0x06, 0x5c, 0x1c, 0x89, 0xc9, 0xc9, 0xf9, 0xa5, 0x79, 0x25, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a,
-	0xdc, 0x46, 0x22, 0x7a, 0x10, 0x7f, 0xe8, 0xc1, 0xfc, 0xa1, 0xe7, 0x98, 0x57, 0x19, 0x04, 0x57,
-	0xe5, 0x64, 0x7c, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e,
-	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x92, 0x10, 0xab,
-	0x8b, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0xf5, 0x2b, 0x20, 0xfe, 0x2a, 0xa9, 0x2c, 0x48, 0x2d, 0x4e,
-	0x62, 0x03, 0x1b, 0x66, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x6c, 0x57, 0x9c, 0xf7, 0x5c, 0x01,
-	0x00, 0x00,
+	// 561 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x4d, 0x6f, 0xd3, 0x3e,
+	0x18, 0xc0, 0x9b, 0xff, 0xfe, 0x8c, 0xce, 0x9b, 0x46, 0x6b, 0x2a, 0x94, 0x0d, 0x29, 0x94, 0x37,
+	0x31, 0x76, 0x48, 0xd8, 0x76, 0x47, 0x2a, 0x87, 0x4d, 0x02, 0x4d, 0xaa, 0xba, 0x0b, 0x1a, 0x82,
+	0xc8, 0xc9, 0x1c, 0xcf, 0xb4, 0x89, 0x23, 0x3f, 0xce, 0x44, 0xbe, 0x05, 0x1f, 0x83, 0xe3, 0x3e,
+	0xc6, 0x8e, 0x3b, 0x72, 0x42, 0xa8, 0x3d, 0xf0, 0x35, 0x50, 0xec, 0xb4, 0x34, 0x6b, 0xda, 0x4b,
+	0x15, 0xe5, 0xf9, 0xf9, 0xf7, 0xbc, 0xc5, 0x45, 0x4f, 0x43, 0x01, 0xb1, 0x00, 0x8f, 0x64, 0xea,
+	0xd2, 0xbb, 0x3a, 0x08, 0xa8, 0x22, 0x07, 0x1e, 0xa3, 0x09, 0x05, 0x0e, 0x6e, 0x2a, 0x85, 0x12,
+	0xf8, 0xa1, 0x41, 0xdc, 0x02, 0x71, 0x4b, 0x64, 0x77, 0x87, 0x09, 0xc1, 0x46, 0xd4, 0xd3, 0x48,
+	0x90, 0x45, 0x1e, 0x49, 0x72, 0xc3, 0xef, 0x76, 0x98, 0x60, 0x42, 0x3f, 0x7a, 0xc5, 0x53, 0xf9,
+	0xd6, 0xa9, 0x4b, 0xa4, 0x95, 0x26, 0xde, 0x26, 0x31, 0x4f, 0x84, 0xa7, 0x7f, 0xcd, 0xab, 0x67,
+	0xd7, 0x4d, 0xb4, 0x75, 0x62, 0x4a, 0x39, 0x53, 0x44, 0x51, 0xfc, 0x16, 0xad, 0xa7, 0x44, 0x92,
+	0x18, 0x6c, 0xab, 0x6b, 0xed, 0x6d, 0x1e, 0x3e, 0x76, 0x6b, 0x4a, 0x73, 0xfb, 0x1a, 0x79, 0xb7,
+	0x71, 0xf3, 0xeb, 0x49, 0xe3, 0xc7, 0x9f, 0xeb, 0x7d, 0x6b, 0x50, 0x9e, 0xc2, 0x6f, 0x50, 0x93,
+	0x84, 0xa1, 0xc8, 0x12, 0x05, 0xf6, 0x7f, 0xdd, 0xb5, 0xbd, 0xcd, 0xc3, 0x8e, 0x6b, 0xfa, 0x70,
+	0xa7, 0x7d, 0xb8, 0xbd, 0x24, 0x1f, 0xcc, 0x28, 0xfc, 0x05, 0x61, 0x46, 0xc0, 0x4f, 0x25, 0x0f,
+	0x79, 0xc2, 0xfc, 0x54, 0x8c, 0x78, 0x98, 0xdb, 0x6b, 0x3a, 0xfb, 0xcb, 0xda, 0xec, 0x27, 0x04,
+	0xfa, 0x86, 0xee, 0x6b, 0x78, 0xbe, 0x8e, 0x16, 0xbb, 0x13, 0x2c, 0xfc, 0x65, 0x2e, 0x9f, 0x84,
+	0x8a, 0x5f, 0x71, 0xc5, 0x29, 0xd8, 0xff, 0xeb, 0xda, 0x5e, 0xd4, 0xfa, 0x7b, 0x06, 0xef, 0x19,
+	0xba, 0xa2, 0x6f, 0x93, 0x4a, 0x8c, 0x53, 0xc0, 0xef, 0x51, 0x33, 0x95, 0x22, 0xe2, 0x23, 0x0a,
+	0xf6, 0x3d, 0x6d, 0x7d, 0xbe, 0xca, 0xda, 0x37, 0xec, 0xbc, 0x74, 0x76, 0x1e, 0x7f, 0x42, 0xed,
+	0x88, 0x52, 0x3f, 0x26, 0x72, 0x48, 0x95, 0x5f, 0x2e, 0x62, 0xbd, 0x6b, 0x2d, 0x2d, 0xf5, 0x98,
+	0xd2, 0x53, 0x0d, 0x2f, 0x6e, 0xe4, 0x41, 0x54, 0x8d, 0xe1, 0x8f, 0xa8, 0x35, 0x27, 0x87, 0x62,
+	0xdd, 0xf6, 0xfd, 0xae, 0xb5, 0xb4, 0xe0, 0x99, 0x5b, 0x7f, 0x19, 0xf3, 0xea, 0xed, 0xa8, 0x12,
+	0xc2, 0x5f, 0xd1, 0xa3, 0xc2, 0x4c, 0x02, 0x50, 0xb2, 0x98, 0xb1, 0x48, 0xa6, 0xb5, 0x37, 0xb5,
+	0xff, 0xf5, 0x32, 0x7f, 0xef, 0xdf, 0x89, 0xc5, 0x06, 0x3a, 0x51, 0x0d, 0x80, 0x3f, 0xa3, 0xed,
+	0xc2, 0x42, 0x13, 0xc5, 0x43, 0xa2, 0x84, 0x04, 0x7b, 0x43, 0x0f, 0x7d, 0x7f, 0xe5, 0x2a, 0x2b,
+	0x27, 0x2a, 0xad, 0x54, 0x65, 0xf8, 0x1c, 0xb5, 0xd3, 0x2c, 0xf0, 0x87, 0x34, 0xf7, 0xa5, 0x50,
+	0xa4, 0x48, 0x0c, 0x36, 0x5a, 0xb1, 0xd6, 0x7e, 0x16, 0x7c, 0xa0, 0xf9, 0xa0, 0x64, 0x2b, 0x0b,
+	0x48, 0x2b, 0x21, 0xc0, 0x17, 0xa8, 0x13, 0x03, 0xf3, 0x21, 0x93, 0xe1, 0x25, 0x91, 0x8c, 0x4e,
+	0x87, 0xb4, 0xa9, 0x87, 0xf4, 0xaa, 0x56, 0x7f, 0x0a, 0xec, 0x6c, 0xca, 0x2f, 0x8e, 0x08, 0xc7,
+	0x0b, 0xe1, 0xe2, 0x7b, 0x2f, 0x96, 0x21, 0x45, 0xa6, 0xf4, 0x7d, 0x32, 0x39, 0xb6, 0x56, 0xdc,
+	0xa7, 0x63, 0x4a, 0x07, 0x86, 0x5e, 0xcc, 0xd0, 0x8a, 0xee, 0x06, 0x8f, 0x6e, 0xc6, 0x8e, 0x75,
+	0x3b, 0x76, 0xac, 0xdf, 0x63, 0xc7, 0xfa, 0x3e, 0x71, 0x1a, 0xb7, 0x13, 0xa7, 0xf1, 0x73, 0xe2,
+	0x34, 0xce, 0x77, 0x8c, 0x1c, 0x2e, 0x86, 0x2e, 0x17, 0xde, 0x37, 0xf3, 0x3f, 0xa4, 0xf2, 0x94,
+	0x42, 0xb0, 0xae, 0x2f, 0xff, 0xd1, 0xdf, 0x01, 0x00, 0x5c, 0x4f, 0x80, 0x05, 0x0c, 0x05, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +236,122 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.FeeRoutingParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.MsgSurchargeParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.PubKeyRotations) > 0 {
+		for iNdEx := len(m.PubKeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PubKeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Authenticators) > 0 {
+		for iNdEx := len(m.Authenticators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authenticators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size, err := m.FeeAbstractionParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.FeeMarketState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.FeeMarketParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Profiles) > 0 {
+		for iNdEx := len(m.Profiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Profiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AccountActivities) > 0 {
+		for iNdEx := len(m.AccountActivities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountActivities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.GasPricingPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Accounts) > 0 {
 		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -179,6 +404,42 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.GasPricingPolicy.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AccountActivities) > 0 {
+		for _, e := range m.AccountActivities {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Profiles) > 0 {
+		for _, e := range m.Profiles {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.FeeMarketParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.FeeMarketState.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.FeeAbstractionParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Authenticators) > 0 {
+		for _, e := range m.Authenticators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PubKeyRotations) > 0 {
+		for _, e := range m.PubKeyRotations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.MsgSurchargeParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.FeeRoutingParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -284,6 +545,340 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPricingPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasPricingPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountActivities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountActivities = append(m.AccountActivities, AccountActivity{})
+			if err := m.AccountActivities[len(m.AccountActivities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profiles = append(m.Profiles, AccountProfile{})
+			if err := m.Profiles[len(m.Profiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeMarketParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeMarketParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeMarketState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeMarketState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeAbstractionParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeAbstractionParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authenticators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authenticators = append(m.Authenticators, AccountAuthenticators{})
+			if err := m.Authenticators[len(m.Authenticators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeyRotations = append(m.PubKeyRotations, PubKeyRotation{})
+			if err := m.PubKeyRotations[len(m.PubKeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgSurchargeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MsgSurchargeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRoutingParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeRoutingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -28,4 +28,42 @@ var (
 
 	// AccountNumberStoreKeyPrefix prefix for account-by-id store
 	AccountNumberStoreKeyPrefix = collections.NewPrefix("accountNumber")
+
+	// GasPricingPolicyKey is the prefix for the tiered gas pricing policy key
+	GasPricingPolicyKey = collections.NewPrefix(3)
+
+	// AccountActivityKeyPrefix prefix for the account activity index
+	AccountActivityKeyPrefix = collections.NewPrefix(4)
+
+	// ProfileKeyPrefix prefix for the account profiles
+	ProfileKeyPrefix = collections.NewPrefix(5)
+
+	// FeeMarketParamsKey is the prefix for the fee market parameters key
+	FeeMarketParamsKey = collections.NewPrefix(6)
+
+	// FeeMarketStateKey is the prefix for the fee market state key
+	FeeMarketStateKey = collections.NewPrefix(7)
+
+	// FeeAbstractionParamsKey is the prefix for the fee abstraction parameters key
+	FeeAbstractionParamsKey = collections.NewPrefix(8)
+
+	// AuthenticatorsKeyPrefix prefix for the authenticators of the smart accounts
+	AuthenticatorsKeyPrefix = collections.NewPrefix(9)
+
+	// AuthenticatorSequenceKey identifies the prefix where the monotonically
+	// increasing authenticator id is stored.
+	AuthenticatorSequenceKey = collections.NewPrefix(10)
+
+	// PubKeyRotationsKeyPrefix prefix for the public key rotation history
+	PubKeyRotationsKeyPrefix = collections.NewPrefix(11)
+
+	// PubKeyRotationSequenceKey identifies the prefix where the monotonically
+	// increasing public key rotation id is stored.
+	PubKeyRotationSequenceKey = collections.NewPrefix(12)
+
+	// MsgSurchargeParamsKey is the prefix for the message surcharge parameters key
+	MsgSurchargeParamsKey = collections.NewPrefix(13)
+
+	// FeeRoutingParamsKey is the prefix for the fee routing parameters key
+	FeeRoutingParamsKey = collections.NewPrefix(14)
 )
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs a stateless validation of the message surcharge parameters.
+func (p MsgSurchargeParams) Validate() error {
+	seenTypeURLs := make(map[string]bool, len(p.Surcharges))
+	for _, surcharge := range p.Surcharges {
+		if !strings.HasPrefix(surcharge.MsgTypeUrl, "/") || len(surcharge.MsgTypeUrl) == 1 {
+			return fmt.Errorf("invalid message surcharge type URL %q", surcharge.MsgTypeUrl)
+		}
+		if seenTypeURLs[surcharge.MsgTypeUrl] {
+			return fmt.Errorf("duplicate message surcharge for %s", surcharge.MsgTypeUrl)
+		}
+		seenTypeURLs[surcharge.MsgTypeUrl] = true
+
+		if err := surcharge.Fee.Validate(); err != nil {
+			return fmt.Errorf("invalid message surcharge fee for %s: %w", surcharge.MsgTypeUrl, err)
+		}
+		if surcharge.Gas == 0 && surcharge.Fee.IsZero() {
+			return fmt.Errorf("message surcharge for %s must have a gas or a fee", surcharge.MsgTypeUrl)
+		}
+	}
+
+	return nil
+}
+
+// Surcharge returns the surcharge of the messages with the given type URL, and
+// false if they have none.
+func (p MsgSurchargeParams) Surcharge(msgTypeURL string) (MsgSurcharge, bool) {
+	for _, surcharge := range p.Surcharges {
+		if surcharge.MsgTypeUrl == msgTypeURL {
+			return surcharge, true
+		}
+	}
+
+	return MsgSurcharge{}, false
+}
+
+// TxSurcharge returns the extra gas and the flat fee the surcharges apply to a
+// transaction carrying the given messages, each message being surcharged.
+func (p MsgSurchargeParams) TxSurcharge(msgs []sdk.Msg) (gas uint64, fee sdk.Coins, err error) {
+	if len(p.Surcharges) == 0 {
+		return 0, nil, nil
+	}
+
+	for _, msg := range msgs {
+		surcharge, ok := p.Surcharge(sdk.MsgTypeURL(msg))
+		if !ok {
+			continue
+		}
+
+		if gas > math.MaxUint64-surcharge.Gas {
+			return 0, nil, errors.New("message surcharge gas overflow")
+		}
+		gas += surcharge.Gas
+		fee = fee.Add(surcharge.Fee...)
+	}
+
+	return gas, fee, nil
+}
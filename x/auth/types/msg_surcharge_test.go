@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/auth/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgSurchargeParamsValidate(t *testing.T) {
+	testMsgURL := sdk.MsgTypeURL(&testdata.TestMsg{})
+	valid := types.MsgSurchargeParams{
+		Surcharges: []types.MsgSurcharge{
+			{MsgTypeUrl: testMsgURL, Gas: 1000},
+			{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgMultiSend", Fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))},
+		},
+	}
+	require.NoError(t, valid.Validate())
+	require.NoError(t, types.MsgSurchargeParams{}.Validate())
+
+	invalid := types.MsgSurchargeParams{Surcharges: []types.MsgSurcharge{{MsgTypeUrl: "cosmos.bank.v1beta1.MsgSend", Gas: 1}}}
+	require.Error(t, invalid.Validate())
+
+	invalid = types.MsgSurchargeParams{Surcharges: append(valid.Surcharges, types.MsgSurcharge{MsgTypeUrl: testMsgURL, Gas: 1})}
+	require.Error(t, invalid.Validate())
+
+	invalid = types.MsgSurchargeParams{Surcharges: []types.MsgSurcharge{{MsgTypeUrl: testMsgURL}}}
+	require.Error(t, invalid.Validate())
+
+	invalid = types.MsgSurchargeParams{Surcharges: []types.MsgSurcharge{{MsgTypeUrl: testMsgURL, Fee: sdk.Coins{sdk.NewInt64Coin("stake", 0)}}}}
+	require.Error(t, invalid.Validate())
+
+	surcharge, ok := valid.Surcharge(testMsgURL)
+	require.True(t, ok)
+	require.Equal(t, valid.Surcharges[0], surcharge)
+	_, ok = valid.Surcharge("/cosmos.bank.v1beta1.MsgSend")
+	require.False(t, ok)
+}
+
+func TestMsgSurchargeParamsTxSurcharge(t *testing.T) {
+	testMsgURL := sdk.MsgTypeURL(&testdata.TestMsg{})
+	params := types.MsgSurchargeParams{
+		Surcharges: []types.MsgSurcharge{
+			{MsgTypeUrl: testMsgURL, Gas: 1000, Fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))},
+		},
+	}
+
+	gas, fee, err := params.TxSurcharge([]sdk.Msg{&testdata.TestMsg{}, &testdata.TestMsg{}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2000), gas)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), fee)
+
+	gas, fee, err = types.MsgSurchargeParams{}.TxSurcharge([]sdk.Msg{&testdata.TestMsg{}})
+	require.NoError(t, err)
+	require.Zero(t, gas)
+	require.True(t, fee.IsZero())
+
+	params.Surcharges[0].Gas = math.MaxUint64
+	_, _, err = params.TxSurcharge([]sdk.Msg{&testdata.TestMsg{}, &testdata.TestMsg{}})
+	require.Error(t, err)
+}
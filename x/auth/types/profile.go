@@ -0,0 +1,54 @@
+package types
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxProfileDisplayNameLength is the maximum length, in bytes, of the display
+// name of an account profile.
+const MaxProfileDisplayNameLength = 64
+
+// IsEmpty returns true if the profile has neither a display name nor an avatar
+// hash, an empty profile clearing the profile of the account.
+func (p AccountProfile) IsEmpty() bool {
+	return p.DisplayName == "" && len(p.AvatarHash) == 0
+}
+
+// Validate performs a stateless validation of the account profile.
+func (p AccountProfile) Validate() error {
+	if p.Address == "" {
+		return errors.New("account profile address cannot be empty")
+	}
+
+	return ValidateProfileFields(p.DisplayName, p.AvatarHash)
+}
+
+// ValidateProfileFields validates the display name and the avatar hash of an
+// account profile. Both are optional, but the display name is bounded in size
+// and cannot contain control characters or surrounding whitespace, and the
+// avatar hash must be a SHA-256 hash.
+func ValidateProfileFields(displayName string, avatarHash []byte) error {
+	if len(displayName) > MaxProfileDisplayNameLength {
+		return fmt.Errorf("profile display name cannot be longer than %d bytes: %d", MaxProfileDisplayNameLength, len(displayName))
+	}
+	if !utf8.ValidString(displayName) {
+		return errors.New("profile display name must be valid UTF-8")
+	}
+	if strings.TrimSpace(displayName) != displayName {
+		return errors.New("profile display name cannot have leading or trailing whitespace")
+	}
+	if strings.IndexFunc(displayName, unicode.IsControl) != -1 {
+		return errors.New("profile display name cannot contain control characters")
+	}
+
+	if len(avatarHash) != 0 && len(avatarHash) != sha256.Size {
+		return fmt.Errorf("profile avatar hash must be a %d bytes SHA-256 hash: %d bytes", sha256.Size, len(avatarHash))
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// PubKeyRotationProofBytes returns the bytes the new public key of a rotation
+// signs to prove its ownership. They bind the chain, the account and its current
+// public key, so a proof can't be replayed on another chain or account.
+func PubKeyRotationProofBytes(chainID, address string, accountNumber uint64, oldPubKey cryptotypes.PubKey) []byte {
+	return []byte(fmt.Sprintf(
+		"cosmos-sdk/MsgRotatePubKey\nchain_id: %s\naddress: %s\naccount_number: %d\nold_pub_key: %X",
+		chainID, address, accountNumber, oldPubKey.Bytes(),
+	))
+}
+
+var _ codectypes.UnpackInterfacesMessage = PubKeyRotation{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r PubKeyRotation) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	if err := unpacker.UnpackAny(r.OldPubKey, &pubKey); err != nil {
+		return err
+	}
+
+	return unpacker.UnpackAny(r.NewPubKey, &pubKey)
+}
+
+// Validate performs a stateless validation of the rotation.
+func (r PubKeyRotation) Validate() error {
+	switch {
+	case r.Address == "":
+		return errors.New("pub key rotation address cannot be empty")
+	case r.OldPubKey == nil || r.NewPubKey == nil:
+		return fmt.Errorf("pub key rotation %d of account %s must have both the old and the new public keys", r.Id, r.Address)
+	case r.Height < 0:
+		return fmt.Errorf("pub key rotation %d of account %s has a negative height", r.Id, r.Address)
+	}
+
+	return nil
+}
+
+var _ codectypes.UnpackInterfacesMessage = MsgRotatePubKey{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgRotatePubKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.NewPubKey, &pubKey)
+}
@@ -11,3 +11,15 @@ func (m *QueryAccountResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker)
 }
 
 var _ codectypes.UnpackInterfacesMessage = &QueryAccountResponse{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryAuthenticatorsResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, a := range m.Authenticators {
+		if err := a.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var _ codectypes.UnpackInterfacesMessage = &QueryAuthenticatorsResponse{}
@@ -0,0 +1,59 @@
+package vesting
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	vestingv1beta1 "cosmossdk.io/api/cosmos/vesting/v1beta1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: vestingv1beta1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "VestingBalances",
+					Use:            "vesting-balances [address]",
+					Short:          "Query the vested and unvested coins of a vesting account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: vestingv1beta1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "CreateVestingAccount",
+					Skip:      true, // skipped because creating single vesting accounts is no longer supported
+				},
+				{
+					RpcMethod: "CreatePermanentLockedAccount",
+					Skip:      true, // skipped because creating single vesting accounts is no longer supported
+				},
+				{
+					RpcMethod: "CreatePeriodicVestingAccount",
+					Skip:      true, // skipped because the custom command reads the vesting periods from a JSON file
+				},
+				{
+					RpcMethod: "CreateClawbackVestingAccount",
+					Skip:      true, // skipped because the custom command reads the vesting periods from a JSON file
+				},
+				{
+					RpcMethod:      "Clawback",
+					Use:            "clawback [address] [dest-address]",
+					Short:          "Claw back the unvested coins of a clawback vesting account funded by the sender",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "dest_address", Optional: true}},
+				},
+				{
+					RpcMethod: "BatchCreateVestingAccounts",
+					Skip:      true, // skipped because the custom command builds the message from a CSV file
+				},
+				{
+					RpcMethod: "AmendVestingSchedule",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+			EnhanceCustomCommand: true,
+		},
+	}
+}
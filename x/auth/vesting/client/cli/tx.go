@@ -0,0 +1,232 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/auth/vesting/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// FlagAuthority is the flag setting the authority of a batch of vesting accounts.
+const FlagAuthority = "authority"
+
+// GetTxCmd returns vesting module's transaction commands.
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Vesting transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewBatchCreateVestingAccountsCmd(),
+		NewCreatePeriodicVestingAccountCmd(),
+		NewCreateClawbackVestingAccountCmd(),
+	)
+
+	return txCmd
+}
+
+// NewBatchCreateVestingAccountsCmd returns a CLI command building a
+// MsgBatchCreateVestingAccounts from a CSV file of accounts, to be included in
+// a governance proposal.
+func NewBatchCreateVestingAccountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-create-vesting-accounts [templates-file] [accounts-csv-file]",
+		Short: "Build a message creating vesting accounts in batch, to be included in a governance proposal",
+		Long: `Build a MsgBatchCreateVestingAccounts creating the vesting accounts listed in a CSV file, and print it
+as JSON to be included in the messages of a governance proposal. The total amount of the message is computed from
+the accounts.
+
+The templates file holds the vesting schedule templates referenced by the accounts, as JSON:
+{
+  "templates": [
+    {"name": "linear", "schedule_type": "VESTING_SCHEDULE_TYPE_CONTINUOUS", "end_time": "1767225600"},
+    {"name": "quarterly", "schedule_type": "VESTING_SCHEDULE_TYPE_PERIODIC", "periods": [
+      {"length": "7776000", "weight": "0.5"}, {"length": "7776000", "weight": "0.5"}
+    ]}
+  ]
+}
+
+The CSV file starts with an "address,amount,template" header, amounts of several denoms being quoted:
+address,amount,template
+cosmos1...,1000stake,linear
+cosmos1...,"500stake,20atom",quarterly
+`,
+		Example: fmt.Sprintf("%s tx vesting batch-create-vesting-accounts templates.json accounts.csv > msg.json", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgBatchCreateVestingAccounts{}
+			if err := clientCtx.Codec.UnmarshalJSON(bz, msg); err != nil {
+				return fmt.Errorf("failed to parse templates file: %w", err)
+			}
+
+			file, err := os.Open(args[1])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			msg.Accounts, err = types.ParseBatchVestingAccountsCSV(file)
+			if err != nil {
+				return fmt.Errorf("failed to parse accounts file: %w", err)
+			}
+
+			msg.TotalAmount = sdk.NewCoins()
+			for _, account := range msg.Accounts {
+				msg.TotalAmount = msg.TotalAmount.Add(account.Amount...)
+			}
+
+			msg.Authority, _ = cmd.Flags().GetString(FlagAuthority)
+			if msg.Authority == "" {
+				msg.Authority, err = clientCtx.AddressCodec.BytesToString(authtypes.NewModuleAddress(types.GovModuleName))
+				if err != nil {
+					return err
+				}
+			}
+
+			if _, err := msg.ValidateBatch(); err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(msg)
+		},
+	}
+
+	cmd.Flags().String(FlagAuthority, "", "The authority of the message, defaults to the gov module account")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCreatePeriodicVestingAccountCmd returns a CLI command creating a periodic
+// vesting account funded by the sender, from a JSON file of vesting periods.
+func NewCreatePeriodicVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-periodic-vesting-account [to_address] [periods_json_file]",
+		Short: "Create a new periodic vesting account funded with an allocation of tokens",
+		Long: `Create a new periodic vesting account funded by the sender with the coins of a sequence of vesting periods.
+Periods are sequential, the first one starting at the start time.
+
+The periods file holds the start time, as unix time in seconds, and the periods, as JSON:
+{
+  "start_time": "1767225600",
+  "vesting_periods": [
+    {"length": "2592000", "amount": [{"denom": "stake", "amount": "500"}]},
+    {"length": "2592000", "amount": [{"denom": "stake", "amount": "500"}]}
+  ]
+}
+`,
+		Example: fmt.Sprintf("%s tx vesting create-periodic-vesting-account cosmos1... periods.json --from sender", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCreatePeriodicVestingAccount{}
+			if err := clientCtx.Codec.UnmarshalJSON(bz, msg); err != nil {
+				return fmt.Errorf("failed to parse periods file: %w", err)
+			}
+
+			msg.FromAddress, err = clientCtx.AddressCodec.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if _, err := clientCtx.AddressCodec.StringToBytes(args[0]); err != nil {
+				return fmt.Errorf("invalid 'to' address: %w", err)
+			}
+			msg.ToAddress = args[0]
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCreateClawbackVestingAccountCmd returns a CLI command creating a clawback
+// vesting account funded by the sender, from a JSON file of vesting periods.
+func NewCreateClawbackVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-clawback-vesting-account [to_address] [periods_json_file]",
+		Short: "Create a new clawback vesting account funded with an allocation of tokens",
+		Long: `Create a new clawback vesting account funded by the sender with the coins of a sequence of vesting periods.
+Periods are sequential, the first one starting at the start time. The sender, as the funder of the account, can
+claw back the coins that have not vested yet with the clawback command.
+
+The periods file holds the start time, as unix time in seconds, and the periods, as JSON:
+{
+  "start_time": "1767225600",
+  "vesting_periods": [
+    {"length": "2592000", "amount": [{"denom": "stake", "amount": "500"}]},
+    {"length": "2592000", "amount": [{"denom": "stake", "amount": "500"}]}
+  ]
+}
+`,
+		Example: fmt.Sprintf("%s tx vesting create-clawback-vesting-account cosmos1... periods.json --from funder", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCreateClawbackVestingAccount{}
+			if err := clientCtx.Codec.UnmarshalJSON(bz, msg); err != nil {
+				return fmt.Errorf("failed to parse periods file: %w", err)
+			}
+
+			msg.FunderAddress, err = clientCtx.AddressCodec.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if _, err := clientCtx.AddressCodec.StringToBytes(args[0]); err != nil {
+				return fmt.Errorf("invalid 'to' address: %w", err)
+			}
+			msg.ToAddress = args[0]
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
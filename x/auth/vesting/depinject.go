@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
 	"cosmossdk.io/x/auth/keeper"
+	authtypes "cosmossdk.io/x/auth/types"
 	"cosmossdk.io/x/auth/vesting/types"
 )
 
@@ -23,6 +24,8 @@ func init() {
 type ModuleInputs struct {
 	depinject.In
 
+	Config *modulev1.Module
+
 	AccountKeeper keeper.AccountKeeper
 	BankKeeper    types.BankKeeper
 }
@@ -34,7 +37,18 @@ type ModuleOutputs struct {
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
-	m := NewAppModule(in.AccountKeeper, in.BankKeeper)
+	// default to governance authority if not provided
+	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+
+	as, err := in.AccountKeeper.AddressCodec().BytesToString(authority)
+	if err != nil {
+		panic(err)
+	}
+
+	m := NewAppModule(in.AccountKeeper, in.BankKeeper, as)
 
 	return ModuleOutputs{Module: m}
 }
@@ -1,10 +1,18 @@
 package vesting
 
 import (
+	"context"
+
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/x/auth/keeper"
+	"cosmossdk.io/x/auth/vesting/client/cli"
 	"cosmossdk.io/x/auth/vesting/types"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -14,19 +22,24 @@ var (
 	_ module.AppModule = AppModule{}
 	_ module.HasName   = AppModule{}
 
-	_ appmodule.AppModule = AppModule{}
+	_ appmodule.AppModule   = AppModule{}
+	_ appmodule.HasServices = AppModule{}
 )
 
 // AppModule implementing the AppModule interface.
 type AppModule struct {
 	accountKeeper keeper.AccountKeeper
 	bankKeeper    types.BankKeeper
+
+	// authority is the address allowed to create vesting accounts in batches.
+	authority string
 }
 
-func NewAppModule(ak keeper.AccountKeeper, bk types.BankKeeper) AppModule {
+func NewAppModule(ak keeper.AccountKeeper, bk types.BankKeeper, authority string) AppModule {
 	return AppModule{
 		accountKeeper: ak,
 		bankKeeper:    bk,
+		authority:     authority,
 	}
 }
 
@@ -43,11 +56,31 @@ func (AppModule) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	types.RegisterLegacyAminoCodec(cdc)
 }
 
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the vesting module.
+func (AppModule) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwruntime.ServeMux) {
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
 // RegisterInterfaces registers the module's interfaces and implementations with
 // the given interface registry.
 func (AppModule) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	types.RegisterInterfaces(registry)
 }
 
+// GetTxCmd returns the root tx command for the vesting module.
+func (AppModule) GetTxCmd() *cobra.Command {
+	return cli.GetTxCmd()
+}
+
+// RegisterServices registers module services.
+func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
+	types.RegisterMsgServer(registrar, NewMsgServerImpl(am.accountKeeper, am.bankKeeper, am.authority))
+	types.RegisterQueryServer(registrar, NewQueryServerImpl(am.accountKeeper))
+
+	return nil
+}
+
 // ConsensusVersion implements HasConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 1 }
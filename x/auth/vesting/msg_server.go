@@ -0,0 +1,286 @@
+package vesting
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/auth/keeper"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/auth/vesting/exported"
+	"cosmossdk.io/x/auth/vesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type msgServer struct {
+	// Creating a single vesting account, other than a periodic or clawback
+	// vesting account, is no longer supported, the accounts module is required
+	// for it, so the corresponding methods are left unimplemented.
+	types.UnimplementedMsgServer
+
+	keeper.AccountKeeper
+	types.BankKeeper
+
+	authority string
+}
+
+// NewMsgServerImpl returns an implementation of the vesting MsgServer interface,
+// wrapping the corresponding AccountKeeper and BankKeeper.
+func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper, authority string) types.MsgServer {
+	return &msgServer{AccountKeeper: k, BankKeeper: bk, authority: authority}
+}
+
+var _ types.MsgServer = &msgServer{}
+
+// BatchCreateVestingAccounts creates the vesting accounts of a batch in order,
+// following their schedule template, and funds them from the authority
+// account. The whole batch fails if any of the accounts already exists.
+func (s msgServer) BatchCreateVestingAccounts(ctx context.Context, msg *types.MsgBatchCreateVestingAccounts) (*types.MsgBatchCreateVestingAccountsResponse, error) {
+	if s.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", s.authority, msg.Authority)
+	}
+
+	authority, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.Authority)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	templates, err := msg.ValidateBatch()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.BankKeeper.IsSendEnabledCoins(ctx, msg.TotalAmount...); err != nil {
+		return nil, err
+	}
+
+	if spendable := s.BankKeeper.SpendableCoins(ctx, authority); !spendable.IsAllGTE(msg.TotalAmount) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "spendable balance %s of the authority is smaller than %s", spendable, msg.TotalAmount)
+	}
+
+	blockTime := s.AccountKeeper.Environment.HeaderService.GetHeaderInfo(ctx).Time.Unix()
+	for _, account := range msg.Accounts {
+		to, err := s.AccountKeeper.AddressCodec().StringToBytes(account.Address)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid account address %s: %s", account.Address, err)
+		}
+
+		if s.BankKeeper.BlockedAddr(to) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", account.Address)
+		}
+
+		if s.AccountKeeper.HasAccount(ctx, to) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", account.Address)
+		}
+
+		baseAccount := authtypes.NewBaseAccountWithAddress(to)
+		baseAccount = s.AccountKeeper.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
+		vestingAccount, err := templates[account.Template].NewVestingAccount(baseAccount, account.Amount, blockTime)
+		if err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s: %s", account.Address, err)
+		}
+
+		s.AccountKeeper.SetAccount(ctx, vestingAccount)
+
+		if err := s.BankKeeper.SendCoins(ctx, authority, to, account.Amount); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgBatchCreateVestingAccountsResponse{}, nil
+}
+
+// CreatePeriodicVestingAccount creates a periodic vesting account and funds it
+// from the sender account.
+func (s msgServer) CreatePeriodicVestingAccount(ctx context.Context, msg *types.MsgCreatePeriodicVestingAccount) (*types.MsgCreatePeriodicVestingAccountResponse, error) {
+	from, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.FromAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'from' address: %s", err)
+	}
+
+	to, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.ToAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'to' address: %s", err)
+	}
+
+	if msg.StartTime < 1 {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid start time of %d, start time must be greater than 0", msg.StartTime)
+	}
+
+	for i, period := range msg.VestingPeriods {
+		if period.Length < 1 {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid period length of %d in period %d, length must be greater than 0", period.Length, i)
+		}
+	}
+
+	totalCoins := types.Periods(msg.VestingPeriods).TotalAmount()
+	if err := s.BankKeeper.IsSendEnabledCoins(ctx, totalCoins...); err != nil {
+		return nil, err
+	}
+
+	if s.BankKeeper.BlockedAddr(to) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	if s.AccountKeeper.HasAccount(ctx, to) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+	}
+
+	baseAccount := authtypes.NewBaseAccountWithAddress(to)
+	baseAccount = s.AccountKeeper.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
+	vestingAccount, err := types.NewPeriodicVestingAccount(baseAccount, totalCoins, msg.StartTime, msg.VestingPeriods)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	s.AccountKeeper.SetAccount(ctx, vestingAccount)
+
+	if err := s.BankKeeper.SendCoins(ctx, from, to, totalCoins); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
+}
+
+// CreateClawbackVestingAccount creates a clawback vesting account, vesting
+// periodically, and funds it from the funder account.
+func (s msgServer) CreateClawbackVestingAccount(ctx context.Context, msg *types.MsgCreateClawbackVestingAccount) (*types.MsgCreateClawbackVestingAccountResponse, error) {
+	funder, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.FunderAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid funder address: %s", err)
+	}
+
+	to, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.ToAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'to' address: %s", err)
+	}
+
+	if msg.StartTime < 1 {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid start time of %d, start time must be greater than 0", msg.StartTime)
+	}
+
+	for i, period := range msg.VestingPeriods {
+		if period.Length < 1 {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid period length of %d in period %d, length must be greater than 0", period.Length, i)
+		}
+	}
+
+	totalCoins := types.Periods(msg.VestingPeriods).TotalAmount()
+	if err := s.BankKeeper.IsSendEnabledCoins(ctx, totalCoins...); err != nil {
+		return nil, err
+	}
+
+	if s.BankKeeper.BlockedAddr(to) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	if s.AccountKeeper.HasAccount(ctx, to) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+	}
+
+	baseAccount := authtypes.NewBaseAccountWithAddress(to)
+	baseAccount = s.AccountKeeper.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
+	vestingAccount, err := types.NewClawbackVestingAccount(baseAccount, msg.FunderAddress, totalCoins, msg.StartTime, msg.VestingPeriods)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	s.AccountKeeper.SetAccount(ctx, vestingAccount)
+
+	if err := s.BankKeeper.SendCoins(ctx, funder, to, totalCoins); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
+
+// Clawback reclaims the coins of a clawback vesting account that have not
+// vested yet, on behalf of its funder. Only the unvested coins held by the
+// account are clawed back: the unvested coins that are delegated stay in the
+// vesting schedule, and can be clawed back once undelegated.
+func (s msgServer) Clawback(ctx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	funder, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.FunderAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid funder address: %s", err)
+	}
+
+	addr, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid account address: %s", err)
+	}
+
+	dest := funder
+	if msg.DestAddress != "" {
+		dest, err = s.AccountKeeper.AddressCodec().StringToBytes(msg.DestAddress)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid destination address: %s", err)
+		}
+	}
+
+	if s.BankKeeper.BlockedAddr(dest) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", sdk.AccAddress(dest))
+	}
+
+	vestingAccount, ok := s.AccountKeeper.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a clawback vesting account", msg.Address)
+	}
+
+	if vestingAccount.FunderAddress != msg.FunderAddress {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the funder of account %s", msg.FunderAddress, msg.Address)
+	}
+
+	// the locked coins are the unvested coins that are not delegated
+	blockTime := s.AccountKeeper.Environment.HeaderService.GetHeaderInfo(ctx).Time
+	clawedBack := vestingAccount.LockedCoins(blockTime).Min(s.BankKeeper.GetAllBalances(ctx, addr))
+	if clawedBack.IsZero() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s has no unvested coins to claw back", msg.Address)
+	}
+
+	if err := vestingAccount.Clawback(blockTime, clawedBack); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	s.AccountKeeper.SetAccount(ctx, vestingAccount)
+
+	if err := s.BankKeeper.SendCoins(ctx, addr, dest, clawedBack); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClawbackResponse{ClawedBack: clawedBack}, nil
+}
+
+// AmendVestingSchedule replaces the vesting schedule of an existing vesting
+// account on behalf of governance. The amended schedule can only extend the
+// current one: the coins vested at the block time stay vested, and no coins
+// vest earlier than under the current schedule.
+func (s msgServer) AmendVestingSchedule(ctx context.Context, msg *types.MsgAmendVestingSchedule) (*types.MsgAmendVestingScheduleResponse, error) {
+	if s.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", s.authority, msg.Authority)
+	}
+
+	addr, err := s.AccountKeeper.AddressCodec().StringToBytes(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid account address: %s", err)
+	}
+
+	vestingAccount, ok := s.AccountKeeper.GetAccount(ctx, addr).(exported.VestingAccount)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a vesting account", msg.Address)
+	}
+
+	amended, err := msg.AmendedAccount(vestingAccount)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s: %s", msg.Address, err)
+	}
+
+	blockTime := s.AccountKeeper.Environment.HeaderService.GetHeaderInfo(ctx).Time
+	if err := types.ValidateScheduleAmendment(vestingAccount, amended, blockTime); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s: %s", msg.Address, err)
+	}
+
+	s.AccountKeeper.SetAccount(ctx, amended)
+
+	return &types.MsgAmendVestingScheduleResponse{}, nil
+}
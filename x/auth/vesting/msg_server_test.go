@@ -0,0 +1,599 @@
+package vesting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	authcodec "cosmossdk.io/x/auth/codec"
+	authkeeper "cosmossdk.io/x/auth/keeper"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/auth/vesting"
+	vestingtestutil "cosmossdk.io/x/auth/vesting/testutil"
+	vestingtypes "cosmossdk.io/x/auth/vesting/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+var (
+	fromAddr   = sdk.AccAddress([]byte("from1________________"))
+	to1Addr    = sdk.AccAddress([]byte("to1__________________"))
+	to2Addr    = sdk.AccAddress([]byte("to2__________________"))
+	govAddr    = authtypes.NewModuleAddress(vestingtypes.GovModuleName)
+	govAddrStr = govAddr.String()
+)
+
+type VestingTestSuite struct {
+	suite.Suite
+
+	ctx           sdk.Context
+	accountKeeper authkeeper.AccountKeeper
+	bankKeeper    *vestingtestutil.MockBankKeeper
+	msgServer     vestingtypes.MsgServer
+}
+
+func (s *VestingTestSuite) SetupTest() {
+	key := storetypes.NewKVStoreKey(authtypes.StoreKey)
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Unix(1000, 0)})
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+
+	maccPerms := map[string][]string{}
+
+	ctrl := gomock.NewController(s.T())
+	s.bankKeeper = vestingtestutil.NewMockBankKeeper(ctrl)
+	s.accountKeeper = authkeeper.NewAccountKeeper(
+		env,
+		encCfg.Codec,
+		authtypes.ProtoBaseAccount,
+		maccPerms,
+		authcodec.NewBech32Codec("cosmos"),
+		"cosmos",
+		govAddrStr,
+	)
+
+	vestingtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+	authtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
+	s.msgServer = vesting.NewMsgServerImpl(s.accountKeeper, s.bankKeeper, govAddrStr)
+}
+
+func (s *VestingTestSuite) TestBatchCreateVestingAccounts() {
+	templates := []vestingtypes.VestingScheduleTemplate{
+		{
+			Name:         "linear",
+			ScheduleType: vestingtypes.VESTING_SCHEDULE_TYPE_CONTINUOUS,
+			EndTime:      5000,
+		},
+		{
+			Name:         "halves",
+			ScheduleType: vestingtypes.VESTING_SCHEDULE_TYPE_PERIODIC,
+			StartTime:    2000,
+			Periods: []vestingtypes.VestingSchedulePeriod{
+				{Length: 100, Weight: math.LegacyNewDecWithPrec(3, 1)},
+				{Length: 200, Weight: math.LegacyNewDecWithPrec(7, 1)},
+			},
+		},
+	}
+	accounts := []vestingtypes.BatchVestingAccount{
+		{Address: to1Addr.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), Template: "linear"},
+		{Address: to2Addr.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 15), sdk.NewInt64Coin("atom", 3)), Template: "halves"},
+	}
+	total := sdk.NewCoins(sdk.NewInt64Coin("stake", 115), sdk.NewInt64Coin("atom", 3))
+
+	testCases := []struct {
+		name      string
+		preRun    func()
+		input     *vestingtypes.MsgBatchCreateVestingAccounts
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			input: &vestingtypes.MsgBatchCreateVestingAccounts{
+				Authority:   fromAddr.String(),
+				Templates:   templates,
+				Accounts:    accounts,
+				TotalAmount: total,
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "total amount mismatch",
+			input: &vestingtypes.MsgBatchCreateVestingAccounts{
+				Authority:   govAddrStr,
+				Templates:   templates,
+				Accounts:    accounts,
+				TotalAmount: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			},
+			expErr:    true,
+			expErrMsg: "does not match the sum of the account amounts",
+		},
+		{
+			name: "unknown template",
+			input: &vestingtypes.MsgBatchCreateVestingAccounts{
+				Authority:   govAddrStr,
+				Templates:   templates[:1],
+				Accounts:    accounts,
+				TotalAmount: total,
+			},
+			expErr:    true,
+			expErrMsg: "unknown template halves",
+		},
+		{
+			name: "duplicate account",
+			input: &vestingtypes.MsgBatchCreateVestingAccounts{
+				Authority:   govAddrStr,
+				Templates:   templates,
+				Accounts:    []vestingtypes.BatchVestingAccount{accounts[0], accounts[0]},
+				TotalAmount: sdk.NewCoins(sdk.NewInt64Coin("stake", 200)),
+			},
+			expErr:    true,
+			expErrMsg: "duplicate account",
+		},
+		{
+			name: "weights not adding up to one",
+			input: &vestingtypes.MsgBatchCreateVestingAccounts{
+				Authority: govAddrStr,
+				Templates: []vestingtypes.VestingScheduleTemplate{{
+					Name:         "linear",
+					ScheduleType: vestingtypes.VESTING_SCHEDULE_TYPE_PERIODIC,
+					Periods:      []vestingtypes.VestingSchedulePeriod{{Length: 100, Weight: math.LegacyNewDecWithPrec(9, 1)}},
+				}},
+				Accounts:    accounts[:1],
+				TotalAmount: accounts[0].Amount,
+			},
+			expErr:    true,
+			expErrMsg: "must add up to one",
+		},
+		{
+			name: "insufficient funds",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), gomock.Any()).Return(nil)
+				s.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), govAddr).Return(sdk.NewCoins(sdk.NewInt64Coin("stake", 115)))
+			},
+			input: &vestingtypes.MsgBatchCreateVestingAccounts{
+				Authority:   govAddrStr,
+				Templates:   templates,
+				Accounts:    accounts,
+				TotalAmount: total,
+			},
+			expErr:    true,
+			expErrMsg: "spendable balance",
+		},
+		{
+			name: "valid batch",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), gomock.Any()).Return(nil)
+				s.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), govAddr).Return(total)
+				s.bankKeeper.EXPECT().BlockedAddr(gomock.Any()).Return(false).Times(2)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), govAddr, to1Addr, accounts[0].Amount).Return(nil)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), govAddr, to2Addr, accounts[1].Amount).Return(nil)
+			},
+			input: &vestingtypes.MsgBatchCreateVestingAccounts{
+				Authority:   govAddrStr,
+				Templates:   templates,
+				Accounts:    accounts,
+				TotalAmount: total,
+			},
+		},
+		{
+			name: "existing account",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), gomock.Any()).Return(nil)
+				s.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), govAddr).Return(total)
+				s.bankKeeper.EXPECT().BlockedAddr(gomock.Any()).Return(false)
+			},
+			input: &vestingtypes.MsgBatchCreateVestingAccounts{
+				Authority:   govAddrStr,
+				Templates:   templates,
+				Accounts:    accounts,
+				TotalAmount: total,
+			},
+			expErr:    true,
+			expErrMsg: "already exists",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+			_, err := s.msgServer.BatchCreateVestingAccounts(s.ctx, tc.input)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	acc1, ok := s.accountKeeper.GetAccount(s.ctx, to1Addr).(*vestingtypes.ContinuousVestingAccount)
+	s.Require().True(ok)
+	s.Require().Equal(int64(1000), acc1.StartTime)
+	s.Require().Equal(int64(5000), acc1.EndTime)
+	s.Require().Equal(accounts[0].Amount, acc1.OriginalVesting)
+
+	acc2, ok := s.accountKeeper.GetAccount(s.ctx, to2Addr).(*vestingtypes.PeriodicVestingAccount)
+	s.Require().True(ok)
+	s.Require().Equal(int64(2000), acc2.StartTime)
+	s.Require().Equal(int64(2300), acc2.EndTime)
+	s.Require().Equal([]vestingtypes.Period{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 4))},
+		{Length: 200, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 11), sdk.NewInt64Coin("atom", 3))},
+	}, acc2.VestingPeriods)
+}
+
+func (s *VestingTestSuite) TestCreatePeriodicVestingAccount() {
+	periods := []vestingtypes.Period{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 40))},
+		{Length: 200, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 60))},
+	}
+
+	testCases := []struct {
+		name      string
+		preRun    func()
+		input     *vestingtypes.MsgCreatePeriodicVestingAccount
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "invalid start time",
+			input:     vestingtypes.NewMsgCreatePeriodicVestingAccount(fromAddr, to1Addr, 0, periods),
+			expErr:    true,
+			expErrMsg: "invalid start time",
+		},
+		{
+			name:      "invalid period length",
+			input:     vestingtypes.NewMsgCreatePeriodicVestingAccount(fromAddr, to1Addr, 1000, []vestingtypes.Period{{Length: 0, Amount: periods[0].Amount}}),
+			expErr:    true,
+			expErrMsg: "invalid period length",
+		},
+		{
+			name: "valid account",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), gomock.Any()).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to1Addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))).Return(nil)
+			},
+			input: vestingtypes.NewMsgCreatePeriodicVestingAccount(fromAddr, to1Addr, 1000, periods),
+		},
+		{
+			name: "existing account",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), gomock.Any()).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+			},
+			input:     vestingtypes.NewMsgCreatePeriodicVestingAccount(fromAddr, to1Addr, 1000, periods),
+			expErr:    true,
+			expErrMsg: "already exists",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+			_, err := s.msgServer.CreatePeriodicVestingAccount(s.ctx, tc.input)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	acc, ok := s.accountKeeper.GetAccount(s.ctx, to1Addr).(*vestingtypes.PeriodicVestingAccount)
+	s.Require().True(ok)
+	s.Require().Equal(int64(1300), acc.EndTime)
+	s.Require().Equal(periods, acc.VestingPeriods)
+}
+
+func (s *VestingTestSuite) TestCreateClawbackVestingAccount() {
+	periods := []vestingtypes.Period{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 40))},
+		{Length: 200, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 60))},
+	}
+
+	testCases := []struct {
+		name      string
+		preRun    func()
+		input     *vestingtypes.MsgCreateClawbackVestingAccount
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "invalid period length",
+			input:     vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, 1000, []vestingtypes.Period{{Length: 0, Amount: periods[0].Amount}}),
+			expErr:    true,
+			expErrMsg: "invalid period length",
+		},
+		{
+			name: "blocked address",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), gomock.Any()).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(true)
+			},
+			input:     vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, 1000, periods),
+			expErr:    true,
+			expErrMsg: "not allowed to receive funds",
+		},
+		{
+			name: "valid account",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), gomock.Any()).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to1Addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))).Return(nil)
+			},
+			input: vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, 1000, periods),
+		},
+		{
+			name: "existing account",
+			preRun: func() {
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), gomock.Any()).Return(nil)
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+			},
+			input:     vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, 1000, periods),
+			expErr:    true,
+			expErrMsg: "already exists",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+			_, err := s.msgServer.CreateClawbackVestingAccount(s.ctx, tc.input)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	acc, ok := s.accountKeeper.GetAccount(s.ctx, to1Addr).(*vestingtypes.ClawbackVestingAccount)
+	s.Require().True(ok)
+	s.Require().Equal(fromAddr.String(), acc.FunderAddress)
+	s.Require().Equal(int64(1300), acc.EndTime)
+	s.Require().Equal(periods, acc.VestingPeriods)
+}
+
+func (s *VestingTestSuite) TestClawback() {
+	baseAccount := authtypes.NewBaseAccountWithAddress(to1Addr)
+	baseAccount = s.accountKeeper.NewAccount(s.ctx, baseAccount).(*authtypes.BaseAccount)
+	vestingAccount, err := vestingtypes.NewClawbackVestingAccount(baseAccount, fromAddr.String(), sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), 500, []vestingtypes.Period{
+		{Length: 500, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 40))},
+		{Length: 1000, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 60))},
+	})
+	s.Require().NoError(err)
+
+	// delegate 20 of the 60 unvested coins, leaving 40 locked in the balance
+	vestingAccount.TrackDelegation(s.ctx.HeaderInfo().Time, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), sdk.NewCoins(sdk.NewInt64Coin("stake", 20)))
+	s.accountKeeper.SetAccount(s.ctx, vestingAccount)
+	balance := sdk.NewCoins(sdk.NewInt64Coin("stake", 80))
+
+	testCases := []struct {
+		name      string
+		preRun    func()
+		input     *vestingtypes.MsgClawback
+		expErr    bool
+		expErrMsg string
+		expAmount sdk.Coins
+	}{
+		{
+			name: "not a clawback vesting account",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(fromAddr).Return(false)
+			},
+			input:     vestingtypes.NewMsgClawback(fromAddr, to2Addr, nil),
+			expErr:    true,
+			expErrMsg: "is not a clawback vesting account",
+		},
+		{
+			name: "not the funder",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(to2Addr).Return(false)
+			},
+			input:     vestingtypes.NewMsgClawback(to2Addr, to1Addr, nil),
+			expErr:    true,
+			expErrMsg: "is not the funder",
+		},
+		{
+			name: "blocked destination",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(govAddr).Return(true)
+			},
+			input:     vestingtypes.NewMsgClawback(fromAddr, to1Addr, govAddr),
+			expErr:    true,
+			expErrMsg: "not allowed to receive funds",
+		},
+		{
+			name: "claw back the unvested coins that are not delegated",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(to2Addr).Return(false)
+				s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), to1Addr).Return(balance)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), to1Addr, to2Addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 40))).Return(nil)
+			},
+			input:     vestingtypes.NewMsgClawback(fromAddr, to1Addr, to2Addr),
+			expAmount: sdk.NewCoins(sdk.NewInt64Coin("stake", 40)),
+		},
+		{
+			name: "delegated unvested coins left",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(fromAddr).Return(false)
+				s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), to1Addr).Return(sdk.NewCoins(sdk.NewInt64Coin("stake", 40)))
+			},
+			input:     vestingtypes.NewMsgClawback(fromAddr, to1Addr, nil),
+			expErr:    true,
+			expErrMsg: "no unvested coins to claw back",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+			res, err := s.msgServer.Clawback(s.ctx, tc.input)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expAmount, res.ClawedBack)
+			}
+		})
+	}
+
+	acc, ok := s.accountKeeper.GetAccount(s.ctx, to1Addr).(*vestingtypes.ClawbackVestingAccount)
+	s.Require().True(ok)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), acc.OriginalVesting)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), acc.DelegatedVesting)
+	s.Require().Equal([]vestingtypes.Period{
+		{Length: 500, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 40))},
+		{Length: 1000, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 20))},
+	}, acc.VestingPeriods)
+
+	res, err := vesting.NewQueryServerImpl(s.accountKeeper).VestingBalances(s.ctx, &vestingtypes.QueryVestingBalancesRequest{Address: to1Addr.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 40)), res.Vested)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), res.Unvested)
+	s.Require().True(res.Locked.IsZero())
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), res.DelegatedVesting)
+}
+
+func (s *VestingTestSuite) TestAmendVestingSchedule() {
+	stake := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("stake", amount)) }
+
+	// 40 coins vested at the block time, 60 vesting at 2000
+	baseAccount := authtypes.NewBaseAccountWithAddress(to1Addr)
+	baseAccount = s.accountKeeper.NewAccount(s.ctx, baseAccount).(*authtypes.BaseAccount)
+	periodicAccount, err := vestingtypes.NewPeriodicVestingAccount(baseAccount, stake(100), 500, []vestingtypes.Period{
+		{Length: 500, Amount: stake(40)},
+		{Length: 1000, Amount: stake(60)},
+	})
+	s.Require().NoError(err)
+	s.accountKeeper.SetAccount(s.ctx, periodicAccount)
+
+	// vesting linearly from 2000 to 3000
+	baseAccount = authtypes.NewBaseAccountWithAddress(to2Addr)
+	baseAccount = s.accountKeeper.NewAccount(s.ctx, baseAccount).(*authtypes.BaseAccount)
+	continuousAccount, err := vestingtypes.NewContinuousVestingAccount(baseAccount, stake(100), 2000, 3000)
+	s.Require().NoError(err)
+	s.accountKeeper.SetAccount(s.ctx, continuousAccount)
+
+	testCases := []struct {
+		name      string
+		input     *vestingtypes.MsgAmendVestingSchedule
+		expErrMsg string
+	}{
+		{
+			name:      "invalid authority",
+			input:     vestingtypes.NewMsgAmendVestingSchedule(fromAddr.String(), to1Addr, 500, 0, periodicAccount.VestingPeriods),
+			expErrMsg: "invalid authority",
+		},
+		{
+			name:      "not a vesting account",
+			input:     vestingtypes.NewMsgAmendVestingSchedule(govAddrStr, fromAddr, 500, 0, periodicAccount.VestingPeriods),
+			expErrMsg: "is not a vesting account",
+		},
+		{
+			name:      "end time of a periodic account",
+			input:     vestingtypes.NewMsgAmendVestingSchedule(govAddrStr, to1Addr, 500, 3000, periodicAccount.VestingPeriods),
+			expErrMsg: "given by their periods",
+		},
+		{
+			name: "different original vesting",
+			input: vestingtypes.NewMsgAmendVestingSchedule(govAddrStr, to1Addr, 500, 0, []vestingtypes.Period{
+				{Length: 500, Amount: stake(40)},
+				{Length: 1500, Amount: stake(70)},
+			}),
+			expErrMsg: "does not match the sum of all coins in vesting periods",
+		},
+		{
+			name: "vested coins locked again",
+			input: vestingtypes.NewMsgAmendVestingSchedule(govAddrStr, to1Addr, 600, 0, []vestingtypes.Period{
+				{Length: 500, Amount: stake(40)},
+				{Length: 1000, Amount: stake(60)},
+			}),
+			expErrMsg: "changes the vested coins",
+		},
+		{
+			name: "coins vesting earlier",
+			input: vestingtypes.NewMsgAmendVestingSchedule(govAddrStr, to1Addr, 500, 0, []vestingtypes.Period{
+				{Length: 500, Amount: stake(40)},
+				{Length: 500, Amount: stake(30)},
+				{Length: 1000, Amount: stake(30)},
+			}),
+			expErrMsg: "earlier than the current schedule",
+		},
+		{
+			name: "extended periodic schedule",
+			input: vestingtypes.NewMsgAmendVestingSchedule(govAddrStr, to1Addr, 500, 0, []vestingtypes.Period{
+				{Length: 500, Amount: stake(40)},
+				{Length: 1000, Amount: stake(30)},
+				{Length: 1000, Amount: stake(30)},
+			}),
+		},
+		{
+			name: "extension cannot be reverted",
+			input: vestingtypes.NewMsgAmendVestingSchedule(govAddrStr, to1Addr, 500, 0, []vestingtypes.Period{
+				{Length: 500, Amount: stake(40)},
+				{Length: 1000, Amount: stake(60)},
+			}),
+			expErrMsg: "earlier than the current schedule",
+		},
+		{
+			name:      "continuous schedule starting earlier",
+			input:     vestingtypes.NewMsgAmendVestingSchedule(govAddrStr, to2Addr, 1500, 4000, nil),
+			expErrMsg: "earlier than the current schedule",
+		},
+		{
+			name:  "extended continuous schedule",
+			input: vestingtypes.NewMsgAmendVestingSchedule(govAddrStr, to2Addr, 2500, 4000, nil),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.AmendVestingSchedule(s.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	periodic, ok := s.accountKeeper.GetAccount(s.ctx, to1Addr).(*vestingtypes.PeriodicVestingAccount)
+	s.Require().True(ok)
+	s.Require().Equal(int64(3000), periodic.EndTime)
+	s.Require().Equal(stake(70), periodic.GetVestedCoins(time.Unix(2000, 0)))
+
+	continuous, ok := s.accountKeeper.GetAccount(s.ctx, to2Addr).(*vestingtypes.ContinuousVestingAccount)
+	s.Require().True(ok)
+	s.Require().Equal(int64(2500), continuous.StartTime)
+	s.Require().Equal(int64(4000), continuous.EndTime)
+}
+
+func TestVestingTestSuite(t *testing.T) {
+	suite.Run(t, new(VestingTestSuite))
+}
@@ -0,0 +1,62 @@
+package vesting
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/x/auth/keeper"
+	"cosmossdk.io/x/auth/vesting/exported"
+	"cosmossdk.io/x/auth/vesting/types"
+)
+
+type queryServer struct {
+	ak keeper.AccountKeeper
+}
+
+// NewQueryServerImpl returns an implementation of the vesting QueryServer
+// interface, wrapping the corresponding AccountKeeper.
+func NewQueryServerImpl(ak keeper.AccountKeeper) types.QueryServer {
+	return queryServer{ak: ak}
+}
+
+var _ types.QueryServer = queryServer{}
+
+// VestingBalances returns the breakdown of the vested and unvested coins of a
+// vesting account at the current block time.
+func (s queryServer) VestingBalances(ctx context.Context, req *types.QueryVestingBalancesRequest) (*types.QueryVestingBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	addr, err := s.ak.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+
+	account := s.ak.GetAccount(ctx, addr)
+	if account == nil {
+		return nil, status.Errorf(codes.NotFound, "account %s not found", req.Address)
+	}
+
+	vestingAccount, ok := account.(exported.VestingAccount)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "account %s is not a vesting account", req.Address)
+	}
+
+	blockTime := s.ak.Environment.HeaderService.GetHeaderInfo(ctx).Time
+
+	return &types.QueryVestingBalancesResponse{
+		OriginalVesting:  vestingAccount.GetOriginalVesting(),
+		Vested:           vestingAccount.GetVestedCoins(blockTime),
+		Unvested:         vestingAccount.GetVestingCoins(blockTime),
+		Locked:           vestingAccount.LockedCoins(blockTime),
+		DelegatedVesting: vestingAccount.GetDelegatedVesting(),
+		DelegatedFree:    vestingAccount.GetDelegatedFree(),
+	}, nil
+}
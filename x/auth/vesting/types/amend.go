@@ -0,0 +1,124 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/x/auth/vesting/exported"
+)
+
+// AmendedAccount returns a copy of a vesting account following the vesting
+// schedule of the message. Continuous vesting accounts take a start and an end
+// time, delayed vesting accounts an end time, and periodic and clawback
+// vesting accounts a start time and vesting periods adding up to their
+// original vesting. Permanent locked accounts cannot be amended.
+func (msg MsgAmendVestingSchedule) AmendedAccount(account exported.VestingAccount) (exported.VestingAccount, error) {
+	switch acc := account.(type) {
+	case *ContinuousVestingAccount:
+		if len(msg.VestingPeriods) > 0 {
+			return nil, errors.New("continuous vesting accounts have no vesting periods")
+		}
+
+		bva := *acc.BaseVestingAccount
+		bva.EndTime = msg.EndTime
+		amended := NewContinuousVestingAccountRaw(&bva, msg.StartTime)
+		return amended, amended.Validate()
+
+	case *DelayedVestingAccount:
+		if msg.StartTime != 0 || len(msg.VestingPeriods) > 0 {
+			return nil, errors.New("delayed vesting accounts only have an end time")
+		}
+
+		bva := *acc.BaseVestingAccount
+		bva.EndTime = msg.EndTime
+		amended := NewDelayedVestingAccountRaw(&bva)
+		return amended, amended.Validate()
+
+	case *PeriodicVestingAccount:
+		if msg.EndTime != 0 {
+			return nil, errors.New("the end time of periodic vesting accounts is given by their periods")
+		}
+
+		bva := *acc.BaseVestingAccount
+		bva.EndTime = msg.StartTime + Periods(msg.VestingPeriods).TotalLength()
+		amended := NewPeriodicVestingAccountRaw(&bva, msg.StartTime, msg.VestingPeriods)
+		return amended, amended.Validate()
+
+	case *ClawbackVestingAccount:
+		if msg.EndTime != 0 {
+			return nil, errors.New("the end time of clawback vesting accounts is given by their periods")
+		}
+
+		bva := *acc.BaseVestingAccount
+		bva.EndTime = msg.StartTime + Periods(msg.VestingPeriods).TotalLength()
+		amended := &ClawbackVestingAccount{
+			BaseVestingAccount: &bva,
+			FunderAddress:      acc.FunderAddress,
+			StartTime:          msg.StartTime,
+			VestingPeriods:     msg.VestingPeriods,
+		}
+		return amended, amended.Validate()
+
+	default:
+		return nil, fmt.Errorf("vesting accounts of type %T cannot be amended", account)
+	}
+}
+
+// ValidateScheduleAmendment checks that the amended vesting schedule of an
+// account only extends its current schedule: the coins vested at the block
+// time are unchanged, and at no later time more coins are vested than under
+// the current schedule.
+//
+// The vested coins of both schedules are compared at every time their vesting
+// changes, which is enough for step-wise and linear schedules alike.
+func ValidateScheduleAmendment(current, amended exported.VestingAccount, blockTime time.Time) error {
+	if !amended.GetOriginalVesting().Equal(current.GetOriginalVesting()) {
+		return fmt.Errorf("amended original vesting %s does not match the original vesting %s", amended.GetOriginalVesting(), current.GetOriginalVesting())
+	}
+
+	currentVested, amendedVested := current.GetVestedCoins(blockTime), amended.GetVestedCoins(blockTime)
+	for _, coin := range current.GetOriginalVesting() {
+		if !amendedVested.AmountOf(coin.Denom).Equal(currentVested.AmountOf(coin.Denom)) {
+			return fmt.Errorf("amended schedule changes the vested coins from %s to %s", currentVested, amendedVested)
+		}
+	}
+
+	for _, t := range append(vestingChangeTimes(current), vestingChangeTimes(amended)...) {
+		if t <= blockTime.Unix() {
+			continue
+		}
+
+		at := time.Unix(t, 0)
+		currentVested, amendedVested := current.GetVestedCoins(at), amended.GetVestedCoins(at)
+		for _, coin := range current.GetOriginalVesting() {
+			if amendedVested.AmountOf(coin.Denom).GT(currentVested.AmountOf(coin.Denom)) {
+				return fmt.Errorf("amended schedule vests %s at %d, earlier than the current schedule vesting %s", amendedVested, t, currentVested)
+			}
+		}
+	}
+
+	return nil
+}
+
+// vestingChangeTimes returns the times at which the vesting of an
+// account changes, either in steps or in slope.
+func vestingChangeTimes(account exported.VestingAccount) []int64 {
+	times := []int64{account.GetStartTime(), account.GetEndTime()}
+
+	var periods Periods
+	switch acc := account.(type) {
+	case *PeriodicVestingAccount:
+		periods = acc.VestingPeriods
+	case *ClawbackVestingAccount:
+		periods = acc.VestingPeriods
+	}
+
+	t := account.GetStartTime()
+	for _, period := range periods {
+		t += period.Length
+		times = append(times, t)
+	}
+
+	return times
+}
@@ -0,0 +1,215 @@
+package types
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	authtypes "cosmossdk.io/x/auth/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// BatchVestingAccountsCSVHeader is the header of the CSV files listing the
+// accounts of a MsgBatchCreateVestingAccounts.
+var BatchVestingAccountsCSVHeader = []string{"address", "amount", "template"}
+
+// Validate performs a stateless validation of a vesting schedule template.
+func (t VestingScheduleTemplate) Validate() error {
+	if strings.TrimSpace(t.Name) == "" {
+		return errors.New("template name cannot be empty")
+	}
+
+	if t.StartTime < 0 || t.EndTime < 0 {
+		return fmt.Errorf("template %s: start and end times cannot be negative", t.Name)
+	}
+
+	switch t.ScheduleType {
+	case VESTING_SCHEDULE_TYPE_CONTINUOUS:
+		if t.EndTime <= 0 || t.EndTime <= t.StartTime {
+			return fmt.Errorf("template %s: end time must be after start time", t.Name)
+		}
+		if len(t.Periods) > 0 {
+			return fmt.Errorf("template %s: periods are only supported by periodic schedules", t.Name)
+		}
+
+	case VESTING_SCHEDULE_TYPE_DELAYED:
+		if t.EndTime <= 0 {
+			return fmt.Errorf("template %s: end time must be positive", t.Name)
+		}
+		if t.StartTime != 0 || len(t.Periods) > 0 {
+			return fmt.Errorf("template %s: delayed schedules only support an end time", t.Name)
+		}
+
+	case VESTING_SCHEDULE_TYPE_PERIODIC:
+		if t.EndTime != 0 {
+			return fmt.Errorf("template %s: the end time of periodic schedules is given by their periods", t.Name)
+		}
+		if len(t.Periods) == 0 {
+			return fmt.Errorf("template %s: periodic schedules must have at least one period", t.Name)
+		}
+
+		total := math.LegacyZeroDec()
+		for i, period := range t.Periods {
+			if period.Length <= 0 {
+				return fmt.Errorf("template %s: period #%d has a non-positive length", t.Name, i)
+			}
+			if period.Weight.IsNil() || !period.Weight.IsPositive() {
+				return fmt.Errorf("template %s: period #%d has a non-positive weight", t.Name, i)
+			}
+			total = total.Add(period.Weight)
+		}
+		if !total.Equal(math.LegacyOneDec()) {
+			return fmt.Errorf("template %s: the weights of the periods must add up to one, got %s", t.Name, total)
+		}
+
+	case VESTING_SCHEDULE_TYPE_PERMANENT_LOCKED:
+		if t.StartTime != 0 || t.EndTime != 0 || len(t.Periods) > 0 {
+			return fmt.Errorf("template %s: permanent locked schedules have no times or periods", t.Name)
+		}
+
+	default:
+		return fmt.Errorf("template %s: invalid schedule type %s", t.Name, t.ScheduleType)
+	}
+
+	return nil
+}
+
+// NewVestingAccount creates a vesting account of the given amount following
+// the template, with the block time as start time if the template has none.
+func (t VestingScheduleTemplate) NewVestingAccount(baseAccount *authtypes.BaseAccount, amount sdk.Coins, blockTime int64) (sdk.AccountI, error) {
+	startTime := t.StartTime
+	if startTime == 0 {
+		startTime = blockTime
+	}
+
+	switch t.ScheduleType {
+	case VESTING_SCHEDULE_TYPE_CONTINUOUS:
+		return NewContinuousVestingAccount(baseAccount, amount, startTime, t.EndTime)
+	case VESTING_SCHEDULE_TYPE_DELAYED:
+		return NewDelayedVestingAccount(baseAccount, amount, t.EndTime)
+	case VESTING_SCHEDULE_TYPE_PERIODIC:
+		return NewPeriodicVestingAccount(baseAccount, amount, startTime, t.vestingPeriods(amount))
+	case VESTING_SCHEDULE_TYPE_PERMANENT_LOCKED:
+		return NewPermanentLockedAccount(baseAccount, amount)
+	default:
+		return nil, fmt.Errorf("invalid schedule type %s", t.ScheduleType)
+	}
+}
+
+// vestingPeriods splits an amount between the periods of a periodic template
+// according to their weights. The amounts are truncated, the last period
+// receiving the remainder so that the periods add up to the amount.
+func (t VestingScheduleTemplate) vestingPeriods(amount sdk.Coins) Periods {
+	periods := make(Periods, len(t.Periods))
+	remaining := amount
+	for i, period := range t.Periods {
+		periods[i].Length = period.Length
+		if i == len(t.Periods)-1 {
+			periods[i].Amount = remaining
+			break
+		}
+
+		periodAmount := sdk.NewCoins()
+		for _, coin := range amount {
+			periodAmount = periodAmount.Add(sdk.NewCoin(coin.Denom, period.Weight.MulInt(coin.Amount).TruncateInt()))
+		}
+		periods[i].Amount = periodAmount
+		remaining = remaining.Sub(periodAmount...)
+	}
+
+	return periods
+}
+
+// ParseBatchVestingAccountsCSV parses the accounts of a
+// MsgBatchCreateVestingAccounts from CSV rows of address, amount and template
+// name, following the BatchVestingAccountsCSVHeader header row. Amounts of
+// several denoms are comma separated and must be quoted.
+func ParseBatchVestingAccountsCSV(r io.Reader) ([]BatchVestingAccount, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = len(BatchVestingAccountsCSVHeader)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+	for i, column := range BatchVestingAccountsCSVHeader {
+		if strings.TrimSpace(header[i]) != column {
+			return nil, fmt.Errorf("invalid CSV header, expected %s", strings.Join(BatchVestingAccountsCSVHeader, ","))
+		}
+	}
+
+	var accounts []BatchVestingAccount
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		amount, err := sdk.ParseCoinsNormalized(record[1])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid amount: %w", line, err)
+		}
+
+		accounts = append(accounts, BatchVestingAccount{
+			Address:  strings.TrimSpace(record[0]),
+			Amount:   amount,
+			Template: strings.TrimSpace(record[2]),
+		})
+	}
+
+	return accounts, nil
+}
+
+// ValidateBatch performs a stateless validation of the templates and accounts
+// of the message, and returns the templates by name.
+func (msg MsgBatchCreateVestingAccounts) ValidateBatch() (map[string]VestingScheduleTemplate, error) {
+	templates := make(map[string]VestingScheduleTemplate, len(msg.Templates))
+	for _, template := range msg.Templates {
+		if err := template.Validate(); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if _, ok := templates[template.Name]; ok {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate template %s", template.Name)
+		}
+		templates[template.Name] = template
+	}
+
+	if len(msg.Accounts) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no accounts to create")
+	}
+
+	total := sdk.NewCoins()
+	addresses := make(map[string]bool, len(msg.Accounts))
+	for _, account := range msg.Accounts {
+		if addresses[account.Address] {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate account %s", account.Address)
+		}
+		addresses[account.Address] = true
+
+		if _, ok := templates[account.Template]; !ok {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s: unknown template %s", account.Address, account.Template)
+		}
+
+		if !account.Amount.IsValid() || !account.Amount.IsAllPositive() {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "account %s: %s", account.Address, account.Amount)
+		}
+		total = total.Add(account.Amount...)
+	}
+
+	if !total.Equal(msg.TotalAmount) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "total amount %s does not match the sum of the account amounts %s", msg.TotalAmount, total)
+	}
+
+	return templates, nil
+}
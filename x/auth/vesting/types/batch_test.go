@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/auth/vesting/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParseBatchVestingAccountsCSV(t *testing.T) {
+	testCases := []struct {
+		name      string
+		csv       string
+		expected  []types.BatchVestingAccount
+		expErrMsg string
+	}{
+		{
+			name: "valid accounts",
+			csv:  "address,amount,template\ncosmos1a,1000stake,linear\ncosmos1b,\"500stake,20atom\",quarterly\n",
+			expected: []types.BatchVestingAccount{
+				{Address: "cosmos1a", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), Template: "linear"},
+				{Address: "cosmos1b", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 500), sdk.NewInt64Coin("atom", 20)), Template: "quarterly"},
+			},
+		},
+		{
+			name:      "missing header",
+			csv:       "cosmos1a,1000stake,linear\n",
+			expErrMsg: "invalid CSV header",
+		},
+		{
+			name:      "unquoted amounts",
+			csv:       "address,amount,template\ncosmos1b,500stake,20atom,quarterly\n",
+			expErrMsg: "wrong number of fields",
+		},
+		{
+			name:      "missing field",
+			csv:       "address,amount,template\ncosmos1a,1000stake\n",
+			expErrMsg: "wrong number of fields",
+		},
+		{
+			name:      "invalid coins",
+			csv:       "address,amount,template\ncosmos1a,stake,linear\n",
+			expErrMsg: "line 2: invalid amount",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			accounts, err := types.ParseBatchVestingAccountsCSV(strings.NewReader(tc.csv))
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, accounts)
+		})
+	}
+}
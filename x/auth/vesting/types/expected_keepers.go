@@ -7,9 +7,11 @@ import (
 )
 
 // BankKeeper defines the expected interface contract the vesting module requires
-// for creating vesting accounts with funds.
+// for creating vesting accounts with funds and clawing back their coins.
 type BankKeeper interface {
 	IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 	BlockedAddr(addr sdk.AccAddress) bool
 }
@@ -8,6 +8,10 @@ var (
 	_ sdk.Msg = &MsgCreateVestingAccount{}
 	_ sdk.Msg = &MsgCreatePermanentLockedAccount{}
 	_ sdk.Msg = &MsgCreatePeriodicVestingAccount{}
+	_ sdk.Msg = &MsgBatchCreateVestingAccounts{}
+	_ sdk.Msg = &MsgCreateClawbackVestingAccount{}
+	_ sdk.Msg = &MsgClawback{}
+	_ sdk.Msg = &MsgAmendVestingSchedule{}
 )
 
 // NewMsgCreateVestingAccount returns a reference to a new MsgCreateVestingAccount.
@@ -39,3 +43,38 @@ func NewMsgCreatePeriodicVestingAccount(fromAddr, toAddr sdk.AccAddress, startTi
 		VestingPeriods: periods,
 	}
 }
+
+// NewMsgCreateClawbackVestingAccount returns a reference to a new MsgCreateClawbackVestingAccount.
+func NewMsgCreateClawbackVestingAccount(funderAddr, toAddr sdk.AccAddress, startTime int64, periods []Period) *MsgCreateClawbackVestingAccount {
+	return &MsgCreateClawbackVestingAccount{
+		FunderAddress:  funderAddr.String(),
+		ToAddress:      toAddr.String(),
+		StartTime:      startTime,
+		VestingPeriods: periods,
+	}
+}
+
+// NewMsgClawback returns a reference to a new MsgClawback.
+func NewMsgClawback(funderAddr, addr, destAddr sdk.AccAddress) *MsgClawback {
+	var dest string
+	if destAddr != nil {
+		dest = destAddr.String()
+	}
+
+	return &MsgClawback{
+		FunderAddress: funderAddr.String(),
+		Address:       addr.String(),
+		DestAddress:   dest,
+	}
+}
+
+// NewMsgAmendVestingSchedule returns a reference to a new MsgAmendVestingSchedule.
+func NewMsgAmendVestingSchedule(authority string, addr sdk.AccAddress, startTime, endTime int64, periods []Period) *MsgAmendVestingSchedule {
+	return &MsgAmendVestingSchedule{
+		Authority:      authority,
+		Address:        addr.String(),
+		StartTime:      startTime,
+		EndTime:        endTime,
+		VestingPeriods: periods,
+	}
+}
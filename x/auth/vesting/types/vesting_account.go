@@ -18,6 +18,7 @@ var (
 	_ vestexported.VestingAccount = (*ContinuousVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*PeriodicVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*DelayedVestingAccount)(nil)
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
 )
 
 // Base Vesting Account
@@ -453,6 +454,137 @@ func (dva DelayedVestingAccount) Validate() error {
 	return dva.BaseVestingAccount.Validate()
 }
 
+//-----------------------------------------------------------------------------
+// Clawback Vesting Account
+
+var (
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+	_ authtypes.GenesisAccount    = (*ClawbackVestingAccount)(nil)
+)
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount
+func NewClawbackVestingAccount(baseAcc *authtypes.BaseAccount, funder string, originalVesting sdk.Coins, startTime int64, periods Periods) (*ClawbackVestingAccount, error) {
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: originalVesting,
+		EndTime:         startTime + periods.TotalLength(),
+	}
+
+	clawbackVestingAccount := &ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FunderAddress:      funder,
+		StartTime:          startTime,
+		VestingPeriods:     periods,
+	}
+
+	return clawbackVestingAccount, clawbackVestingAccount.Validate()
+}
+
+// periodic returns the periodic vesting account following the same vesting
+// schedule as the clawback vesting account.
+func (cva ClawbackVestingAccount) periodic() *PeriodicVestingAccount {
+	return NewPeriodicVestingAccountRaw(cva.BaseVestingAccount, cva.StartTime, cva.VestingPeriods)
+}
+
+// GetVestedCoins returns the total number of vested coins. If no coins are vested,
+// nil is returned.
+func (cva ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	return cva.periodic().GetVestedCoins(blockTime)
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (cva ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return cva.OriginalVesting.Sub(cva.GetVestedCoins(blockTime)...)
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked),
+// defined as the vesting coins that are not delegated.
+func (cva ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return cva.BaseVestingAccount.LockedCoinsFromVesting(cva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (cva *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	cva.BaseVestingAccount.TrackDelegation(balance, cva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a clawback vesting
+// account.
+func (cva ClawbackVestingAccount) GetStartTime() int64 {
+	return cva.StartTime
+}
+
+// GetVestingPeriods returns vesting periods associated with clawback vesting account.
+func (cva ClawbackVestingAccount) GetVestingPeriods() Periods {
+	return cva.VestingPeriods
+}
+
+// Clawback removes an amount of coins that have not vested at the block time
+// from the vesting schedule, taking them from the last periods first. The
+// periods left without coins are merged into the next period, or dropped at the
+// end of the schedule, so that the remaining coins keep vesting at the same
+// time.
+//
+// CONTRACT: The caller is responsible for moving the clawed back coins out of
+// the account.
+func (cva *ClawbackVestingAccount) Clawback(blockTime time.Time, amount sdk.Coins) error {
+	if vesting := cva.GetVestingCoins(blockTime); !vesting.IsAllGTE(amount) {
+		return fmt.Errorf("clawback amount %s is greater than the vesting coins %s", amount, vesting)
+	}
+
+	amounts := make([]sdk.Coins, len(cva.VestingPeriods))
+	remaining := amount
+	for i := len(cva.VestingPeriods) - 1; i >= 0; i-- {
+		taken := cva.VestingPeriods[i].Amount.Min(remaining)
+		amounts[i] = cva.VestingPeriods[i].Amount.Sub(taken...)
+		remaining = remaining.Sub(taken...)
+	}
+
+	var (
+		periods Periods
+		length  int64
+	)
+	for i, period := range cva.VestingPeriods {
+		length += period.Length
+		if amounts[i].IsZero() {
+			continue
+		}
+
+		periods = append(periods, Period{Length: length, Amount: amounts[i]})
+		length = 0
+	}
+
+	cva.OriginalVesting = cva.OriginalVesting.Sub(amount...)
+	cva.VestingPeriods = periods
+	cva.EndTime = cva.StartTime + periods.TotalLength()
+
+	return nil
+}
+
+// Validate checks for errors on the account fields
+func (cva ClawbackVestingAccount) Validate() error {
+	if cva.FunderAddress == "" {
+		return errors.New("funder address cannot be empty")
+	}
+
+	// all the coins of the account may have been clawed back before vesting
+	if len(cva.VestingPeriods) == 0 {
+		if !cva.OriginalVesting.IsZero() || !cva.DelegatedVesting.IsZero() {
+			return errors.New("clawback vesting account without vesting periods cannot have vesting coins")
+		}
+		if cva.EndTime != cva.StartTime {
+			return errors.New("vesting end time does not match length of all vesting periods")
+		}
+
+		return cva.BaseAccount.Validate()
+	}
+
+	return cva.periodic().Validate()
+}
+
 //-----------------------------------------------------------------------------
 // Permanent Locked Vesting Account
 
@@ -28,6 +28,7 @@ var (
 	stakeDenom = "stake"
 	feeDenom   = "fee"
 	emptyCoins = sdk.Coins{}
+	funderAddr = sdk.AccAddress([]byte("funder______________"))
 )
 
 type VestingAccountTestSuite struct {
@@ -828,6 +829,87 @@ func TestTrackUndelegationPermLockedVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, plva.DelegatedVesting)
 }
 
+func TestGetVestedCoinsClawbackVestingAcc(t *testing.T) {
+	now := time.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	_, err := types.NewClawbackVestingAccount(bacc, "", origCoins, now.Unix(), periods)
+	require.ErrorContains(t, err, "funder address cannot be empty")
+
+	cva, err := types.NewClawbackVestingAccount(bacc, funderAddr.String(), origCoins, now.Unix(), periods)
+	require.NoError(t, err)
+
+	// require no coins vested at the beginning of the vesting schedule
+	require.Nil(t, cva.GetVestedCoins(now))
+	require.Equal(t, origCoins, cva.GetVestingCoins(now))
+
+	// require 75% of coins vested after period 2
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 750), sdk.NewInt64Coin(stakeDenom, 75)}, cva.GetVestedCoins(now.Add(18*time.Hour)))
+
+	// require all coins vested at the end of the vesting schedule
+	require.Equal(t, origCoins, cva.GetVestedCoins(now.Add(24*time.Hour)))
+}
+
+func TestClawbackClawbackVestingAcc(t *testing.T) {
+	now := time.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+
+	// require the clawback amount to be vesting
+	cva, err := types.NewClawbackVestingAccount(bacc, funderAddr.String(), origCoins, now.Unix(), periods)
+	require.NoError(t, err)
+	require.Error(t, cva.Clawback(now.Add(12*time.Hour), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 51)}))
+
+	// require the coins to be taken from the last periods first, dropping the
+	// periods left empty at the end of the schedule
+	require.NoError(t, cva.Clawback(now.Add(12*time.Hour), sdk.Coins{sdk.NewInt64Coin(feeDenom, 300), sdk.NewInt64Coin(stakeDenom, 50)}))
+	require.Equal(t, []types.Period{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 200)}},
+	}, cva.VestingPeriods)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 700), sdk.NewInt64Coin(stakeDenom, 50)}, cva.OriginalVesting)
+	require.Equal(t, now.Add(18*time.Hour).Unix(), cva.EndTime)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 200)}, cva.GetVestingCoins(now.Add(12*time.Hour)))
+	require.NoError(t, cva.Validate())
+
+	// require the periods left empty before the end of the schedule to be
+	// merged into the next period
+	periods = types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000)}},
+	}
+	cva, err = types.NewClawbackVestingAccount(bacc, funderAddr.String(), origCoins, now.Unix(), periods)
+	require.NoError(t, err)
+	require.NoError(t, cva.Clawback(now.Add(12*time.Hour), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
+	require.Equal(t, []types.Period{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000)}},
+	}, cva.VestingPeriods)
+	require.Equal(t, now.Add(24*time.Hour).Unix(), cva.EndTime)
+	require.NoError(t, cva.Validate())
+
+	// require the ability to claw back all the coins before vesting starts
+	cva, err = types.NewClawbackVestingAccount(bacc, funderAddr.String(), origCoins, now.Unix(), periods)
+	require.NoError(t, err)
+	require.NoError(t, cva.Clawback(now, origCoins))
+	require.Empty(t, cva.VestingPeriods)
+	require.True(t, cva.OriginalVesting.IsZero())
+	require.Equal(t, cva.StartTime, cva.EndTime)
+	require.True(t, cva.LockedCoins(now).IsZero())
+	require.NoError(t, cva.Validate())
+}
+
 func TestGenesisAccountValidate(t *testing.T) {
 	pubkey := secp256k1.GenPrivKey().PubKey()
 	addr := sdk.AccAddress(pubkey.Address())
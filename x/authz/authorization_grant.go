@@ -63,5 +63,12 @@ func (g Grant) ValidateBasic() error {
 	if !ok {
 		return sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (Authorization)(nil), av)
 	}
+
+	if g.Constraints != nil {
+		if err := g.Constraints.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
 	return a.ValidateBasic()
 }
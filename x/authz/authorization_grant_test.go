@@ -42,6 +42,52 @@ func TestNewGrant(t *testing.T) {
 	}
 }
 
+func TestGrantConstraints(t *testing.T) {
+	start := time.Date(2024, 1, 6, 2, 0, 0, 0, time.UTC)
+	weekly := TimeWindow{Start: start, Duration: 4 * time.Hour, Period: 7 * 24 * time.Hour}
+	once := TimeWindow{Start: start, Duration: time.Hour}
+
+	require.True(t, weekly.Contains(start))
+	require.True(t, weekly.Contains(start.Add(7*24*time.Hour+3*time.Hour)))
+	require.False(t, weekly.Contains(start.Add(4*time.Hour)))
+	require.False(t, weekly.Contains(start.Add(-time.Minute)))
+	require.True(t, once.Contains(start.Add(59*time.Minute)))
+	require.False(t, once.Contains(start.Add(24*time.Hour)))
+
+	require.True(t, HeightWindow{StartHeight: 10}.Contains(1000))
+	require.True(t, HeightWindow{StartHeight: 10, EndHeight: 20}.Contains(20))
+	require.False(t, HeightWindow{StartHeight: 10, EndHeight: 20}.Contains(21))
+
+	constraints := GrantConstraints{
+		HeightWindows: []HeightWindow{{StartHeight: 10, EndHeight: 20}, {StartHeight: 50}},
+		TimeWindows:   []TimeWindow{weekly},
+	}
+	require.NoError(t, constraints.ValidateBasic())
+	require.True(t, constraints.Allows(60, start.Add(time.Hour)))
+	require.False(t, constraints.Allows(30, start.Add(time.Hour)))
+	require.False(t, constraints.Allows(60, start.Add(5*time.Hour)))
+
+	tcs := []struct {
+		title       string
+		constraints GrantConstraints
+		err         string
+	}{
+		{"no window", GrantConstraints{}, "at least one"},
+		{"negative start height", GrantConstraints{HeightWindows: []HeightWindow{{StartHeight: -1}}}, "cannot be negative"},
+		{"end height before start", GrantConstraints{HeightWindows: []HeightWindow{{StartHeight: 10, EndHeight: 5}}}, "cannot be before"},
+		{"zero duration", GrantConstraints{TimeWindows: []TimeWindow{{Start: start}}}, "must be positive"},
+		{"period shorter than duration", GrantConstraints{TimeWindows: []TimeWindow{{Start: start, Duration: time.Hour, Period: time.Minute}}}, "cannot be shorter"},
+		{"too many windows", GrantConstraints{HeightWindows: make([]HeightWindow, MaxGrantWindows+1)}, "cannot have more than"},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.title, func(t *testing.T) {
+			expecError(require.New(t), tc.err, tc.constraints.ValidateBasic())
+		})
+	}
+}
+
 func unixTime(s, ns int64) *time.Time {
 	t := time.Unix(s, ns)
 	return &t
@@ -26,3 +26,12 @@ type Authorization interface {
 	// doesn't require access to any other information.
 	ValidateBasic() error
 }
+
+// LimitedAuthorization is an Authorization with a limit that can be increased
+// with Msg/ExtendGrant.
+type LimitedAuthorization interface {
+	Authorization
+
+	// IncreaseLimit adds the given amount to the limit of the authorization.
+	IncreaseLimit(amount sdk.Coins) error
+}
@@ -11,6 +11,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -78,6 +79,9 @@ type Grant struct {
 	// doesn't have a time expiration (other conditions  in `authorization`
 	// may apply to invalidate the grant)
 	Expiration *time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	// constraints restricts the execution of the grant to planned periods. If
+	// null, then the grant can be executed at any time until it expires.
+	Constraints *GrantConstraints `protobuf:"bytes,3,opt,name=constraints,proto3" json:"constraints,omitempty"`
 }
 
 func (m *Grant) Reset()         { *m = Grant{} }
@@ -113,20 +117,152 @@ func (m *Grant) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Grant proto.InternalMessageInfo
 
+// GrantConstraints restricts the execution of a grant to block height windows
+// and recurring time windows. A grant with height windows can only be executed
+// within one of them, and a grant with time windows can only be executed within
+// one of them.
+type GrantConstraints struct {
+	// height_windows are the block height windows the grant can be executed in.
+	HeightWindows []HeightWindow `protobuf:"bytes,1,rep,name=height_windows,json=heightWindows,proto3" json:"height_windows"`
+	// time_windows are the recurring time windows the grant can be executed in.
+	TimeWindows []TimeWindow `protobuf:"bytes,2,rep,name=time_windows,json=timeWindows,proto3" json:"time_windows"`
+}
+
+func (m *GrantConstraints) Reset()         { *m = GrantConstraints{} }
+func (m *GrantConstraints) String() string { return proto.CompactTextString(m) }
+func (*GrantConstraints) ProtoMessage()    {}
+func (*GrantConstraints) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{2}
+}
+func (m *GrantConstraints) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GrantConstraints) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GrantConstraints.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GrantConstraints) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantConstraints.Merge(m, src)
+}
+func (m *GrantConstraints) XXX_Size() int {
+	return m.Size()
+}
+func (m *GrantConstraints) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantConstraints.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantConstraints proto.InternalMessageInfo
+
+// HeightWindow is a window of block heights, both ends included.
+type HeightWindow struct {
+	// start_height is the first block height of the window.
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the last block height of the window. If zero, then the window
+	// has no end.
+	EndHeight int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *HeightWindow) Reset()         { *m = HeightWindow{} }
+func (m *HeightWindow) String() string { return proto.CompactTextString(m) }
+func (*HeightWindow) ProtoMessage()    {}
+func (*HeightWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{3}
+}
+func (m *HeightWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeightWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeightWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeightWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeightWindow.Merge(m, src)
+}
+func (m *HeightWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeightWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeightWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeightWindow proto.InternalMessageInfo
+
+// TimeWindow is a time window of the given duration starting at the given time
+// and recurring every period, e.g. a weekly maintenance window.
+type TimeWindow struct {
+	// start is the time the first occurrence of the window starts at.
+	Start time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	// duration is the duration of every occurrence of the window.
+	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration"`
+	// period is the time between the starts of two occurrences of the window. If
+	// zero, then the window occurs once.
+	Period time.Duration `protobuf:"bytes,3,opt,name=period,proto3,stdduration" json:"period"`
+}
+
+func (m *TimeWindow) Reset()         { *m = TimeWindow{} }
+func (m *TimeWindow) String() string { return proto.CompactTextString(m) }
+func (*TimeWindow) ProtoMessage()    {}
+func (*TimeWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{4}
+}
+func (m *TimeWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimeWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeWindow.Merge(m, src)
+}
+func (m *TimeWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeWindow proto.InternalMessageInfo
+
 // GrantAuthorization extends a grant with both the addresses of the grantee and granter.
 // It is used in genesis.proto and query.proto
 type GrantAuthorization struct {
-	Granter       string     `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
-	Grantee       string     `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
-	Authorization *types.Any `protobuf:"bytes,3,opt,name=authorization,proto3" json:"authorization,omitempty"`
-	Expiration    *time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	Granter       string            `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	Grantee       string            `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	Authorization *types.Any        `protobuf:"bytes,3,opt,name=authorization,proto3" json:"authorization,omitempty"`
+	Expiration    *time.Time        `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	Constraints   *GrantConstraints `protobuf:"bytes,5,opt,name=constraints,proto3" json:"constraints,omitempty"`
 }
 
 func (m *GrantAuthorization) Reset()         { *m = GrantAuthorization{} }
 func (m *GrantAuthorization) String() string { return proto.CompactTextString(m) }
 func (*GrantAuthorization) ProtoMessage()    {}
 func (*GrantAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{2}
+	return fileDescriptor_544dc2e84b61c637, []int{5}
 }
 func (m *GrantAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -165,7 +301,7 @@ func (m *GrantQueueItem) Reset()         { *m = GrantQueueItem{} }
 func (m *GrantQueueItem) String() string { return proto.CompactTextString(m) }
 func (*GrantQueueItem) ProtoMessage()    {}
 func (*GrantQueueItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{3}
+	return fileDescriptor_544dc2e84b61c637, []int{6}
 }
 func (m *GrantQueueItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -197,6 +333,9 @@ var xxx_messageInfo_GrantQueueItem proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*GenericAuthorization)(nil), "cosmos.authz.v1beta1.GenericAuthorization")
 	proto.RegisterType((*Grant)(nil), "cosmos.authz.v1beta1.Grant")
+	proto.RegisterType((*GrantConstraints)(nil), "cosmos.authz.v1beta1.GrantConstraints")
+	proto.RegisterType((*HeightWindow)(nil), "cosmos.authz.v1beta1.HeightWindow")
+	proto.RegisterType((*TimeWindow)(nil), "cosmos.authz.v1beta1.TimeWindow")
 	proto.RegisterType((*GrantAuthorization)(nil), "cosmos.authz.v1beta1.GrantAuthorization")
 	proto.RegisterType((*GrantQueueItem)(nil), "cosmos.authz.v1beta1.GrantQueueItem")
 }
@@ -204,35 +343,48 @@ func init() {
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/authz.proto", fileDescriptor_544dc2e84b61c637) }
 
 var fileDescriptor_544dc2e84b61c637 = []byte{
-	// 439 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0xe3, 0x75, 0xfc, 0x99, 0xa7, 0x21, 0x88, 0x72, 0x28, 0x3d, 0x24, 0x55, 0x0e, 0x68,
-	0x42, 0x6a, 0xac, 0x15, 0x4e, 0x9c, 0x68, 0x84, 0x34, 0xc1, 0x8d, 0x30, 0x2e, 0x5c, 0x2a, 0x67,
-	0x31, 0x9e, 0xb5, 0x3a, 0x8e, 0x6c, 0x07, 0x2d, 0xfb, 0x08, 0x9c, 0xf6, 0x19, 0xf8, 0x04, 0x20,
-	0xed, 0x43, 0x54, 0x9c, 0x2a, 0x4e, 0x9c, 0xf8, 0xd3, 0x1e, 0xf8, 0x1a, 0xa8, 0x76, 0x22, 0x1a,
-	0x5a, 0x89, 0x1e, 0xb8, 0x44, 0x7e, 0xfd, 0x3e, 0xcf, 0xfb, 0x3e, 0xf9, 0x45, 0x81, 0xfd, 0x53,
-	0xa1, 0xb8, 0x50, 0x08, 0x97, 0xfa, 0xec, 0x12, 0xbd, 0x3b, 0x4a, 0x89, 0xc6, 0x47, 0xb6, 0x8a,
-	0x0a, 0x29, 0xb4, 0x70, 0x3d, 0xab, 0x88, 0xec, 0x5d, 0xad, 0xe8, 0xdd, 0xc3, 0x9c, 0xe5, 0x02,
-	0x99, 0xa7, 0x15, 0xf6, 0xee, 0x5b, 0xe1, 0xd8, 0x54, 0xa8, 0x76, 0xd9, 0x56, 0x40, 0x85, 0xa0,
-	0x13, 0x82, 0x4c, 0x95, 0x96, 0x6f, 0x91, 0x66, 0x9c, 0x28, 0x8d, 0x79, 0x51, 0x0b, 0x3c, 0x2a,
-	0xa8, 0xb0, 0xc6, 0xe5, 0xa9, 0x99, 0xf8, 0xb7, 0x0d, 0xe7, 0x95, 0x6d, 0x85, 0x1a, 0x7a, 0xc7,
-	0x24, 0x27, 0x92, 0x9d, 0x8e, 0x4a, 0x7d, 0x26, 0x24, 0xbb, 0xc4, 0x9a, 0x89, 0xdc, 0xbd, 0x0b,
-	0x3b, 0x5c, 0xd1, 0x2e, 0xe8, 0x83, 0xc3, 0xbd, 0x64, 0x79, 0x7c, 0xf2, 0xe2, 0xf3, 0xf5, 0x20,
-	0xdc, 0xf4, 0x0e, 0x51, 0xcb, 0xf9, 0xfe, 0xd7, 0xc7, 0x87, 0x81, 0x95, 0x0d, 0x54, 0x76, 0x8e,
-	0x36, 0x4d, 0x0f, 0x3f, 0x01, 0x78, 0xe3, 0x58, 0xe2, 0x5c, 0xbb, 0x29, 0x3c, 0xc0, 0xab, 0x2d,
-	0xb3, 0x71, 0x7f, 0xe8, 0x45, 0x36, 0x72, 0xd4, 0x44, 0x8e, 0x46, 0x79, 0x15, 0x3f, 0xd8, 0x2e,
-	0x42, 0xd2, 0x1e, 0xe9, 0x3e, 0x83, 0x90, 0x5c, 0x14, 0x4c, 0xda, 0x05, 0x3b, 0x66, 0x41, 0x6f,
-	0x6d, 0xc1, 0x49, 0x83, 0x32, 0xbe, 0x3d, 0xfd, 0x16, 0x80, 0xab, 0xef, 0x01, 0x48, 0x56, 0x7c,
-	0xe1, 0x87, 0x1d, 0xe8, 0x9a, 0xcc, 0x6d, 0x50, 0x43, 0x78, 0x8b, 0x2e, 0x6f, 0x89, 0xb4, 0xb0,
-	0xe2, 0xee, 0x97, 0xeb, 0x41, 0xf3, 0xad, 0x47, 0x59, 0x26, 0x89, 0x52, 0xaf, 0xb4, 0x64, 0x39,
-	0x4d, 0x1a, 0xe1, 0x1f, 0x0f, 0x31, 0x69, 0xb6, 0xf0, 0x90, 0x75, 0x50, 0x9d, 0xff, 0x0f, 0xea,
-	0x69, 0x0b, 0xd4, 0xee, 0x3f, 0x41, 0xed, 0xae, 0x41, 0x7a, 0x0c, 0xef, 0x18, 0x46, 0x2f, 0x4b,
-	0x52, 0x92, 0xe7, 0x9a, 0x70, 0x37, 0x84, 0x07, 0x5c, 0xd1, 0xb1, 0xae, 0x0a, 0x32, 0x2e, 0xe5,
-	0x44, 0x75, 0x41, 0xbf, 0x73, 0xb8, 0x97, 0xec, 0x73, 0x45, 0x4f, 0xaa, 0x82, 0xbc, 0x96, 0x13,
-	0x15, 0x0f, 0xa7, 0x3f, 0x7d, 0x67, 0x3a, 0xf7, 0xc1, 0x6c, 0xee, 0x83, 0x1f, 0x73, 0x1f, 0x5c,
-	0x2d, 0x7c, 0x67, 0xb6, 0xf0, 0x9d, 0xaf, 0x0b, 0xdf, 0x79, 0x53, 0x83, 0x51, 0xd9, 0x79, 0xc4,
-	0x04, 0xba, 0xb0, 0x3f, 0x55, 0x7a, 0xd3, 0xe4, 0x79, 0xf4, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x60,
-	0x57, 0xde, 0x7c, 0x79, 0x03, 0x00, 0x00,
+	// 649 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xc1, 0x4f, 0x13, 0x4f,
+	0x14, 0xc7, 0x3b, 0x2d, 0xf0, 0xa3, 0xaf, 0x94, 0xf0, 0x9b, 0xf4, 0xb0, 0x90, 0xb8, 0xad, 0x7b,
+	0x20, 0x84, 0x84, 0xdd, 0x50, 0x3d, 0x79, 0x11, 0x2a, 0x09, 0xe8, 0xc1, 0xe8, 0x8a, 0x31, 0xf1,
+	0xd2, 0x6c, 0xd9, 0x71, 0xbb, 0x81, 0x9d, 0x69, 0x66, 0x66, 0x85, 0xf2, 0x27, 0x78, 0xe2, 0xe8,
+	0x9f, 0xe0, 0x91, 0x03, 0x07, 0xff, 0x84, 0xc6, 0x13, 0x31, 0x31, 0xf1, 0x84, 0x0a, 0x07, 0x2e,
+	0xfe, 0x07, 0x5e, 0xcc, 0xce, 0xec, 0x96, 0x96, 0x36, 0x01, 0x8d, 0x97, 0x66, 0x67, 0xde, 0xe7,
+	0xfb, 0x7d, 0x6f, 0xe6, 0xf5, 0x0d, 0xd4, 0x76, 0x98, 0x88, 0x98, 0x70, 0xbc, 0x58, 0xb6, 0x0f,
+	0x9d, 0xb7, 0xab, 0x2d, 0x22, 0xbd, 0x55, 0xbd, 0xb2, 0x3b, 0x9c, 0x49, 0x86, 0x2b, 0x9a, 0xb0,
+	0xf5, 0x5e, 0x4a, 0x2c, 0xfc, 0xef, 0x45, 0x21, 0x65, 0x8e, 0xfa, 0xd5, 0xe0, 0xc2, 0xbc, 0x06,
+	0x9b, 0x6a, 0xe5, 0xa4, 0x2a, 0x1d, 0xaa, 0x06, 0x8c, 0x05, 0x7b, 0xc4, 0x51, 0xab, 0x56, 0xfc,
+	0xc6, 0x91, 0x61, 0x44, 0x84, 0xf4, 0xa2, 0x4e, 0x0a, 0x98, 0xd7, 0x01, 0x3f, 0xe6, 0x9e, 0x0c,
+	0x19, 0x4d, 0xe3, 0x95, 0x80, 0x05, 0x4c, 0x1b, 0x27, 0x5f, 0x59, 0xc6, 0xeb, 0x2a, 0x8f, 0x76,
+	0x75, 0xc8, 0x92, 0x50, 0xd9, 0x24, 0x94, 0xf0, 0x70, 0x67, 0x3d, 0x96, 0x6d, 0xc6, 0xc3, 0x43,
+	0x65, 0x87, 0xe7, 0xa0, 0x10, 0x89, 0xc0, 0x40, 0x35, 0xb4, 0x54, 0x74, 0x93, 0xcf, 0x07, 0x4f,
+	0x3e, 0x9d, 0xac, 0x58, 0xe3, 0xce, 0x68, 0x0f, 0x29, 0xdf, 0x5d, 0x1e, 0x2f, 0x57, 0x35, 0xb6,
+	0x22, 0xfc, 0x5d, 0x67, 0x9c, 0xbb, 0xf5, 0x0b, 0xc1, 0xe4, 0x26, 0xf7, 0xa8, 0xc4, 0x2d, 0x28,
+	0x7b, 0x83, 0x21, 0x95, 0xb1, 0x54, 0xaf, 0xd8, 0xba, 0x64, 0x3b, 0x2b, 0xd9, 0x5e, 0xa7, 0xdd,
+	0xc6, 0xe2, 0xed, 0x4a, 0x70, 0x87, 0x2d, 0xf1, 0x06, 0x00, 0x39, 0xe8, 0x84, 0xfa, 0xa2, 0x8c,
+	0xbc, 0x4a, 0xb0, 0x30, 0x92, 0x60, 0x3b, 0xbb, 0xea, 0xc6, 0x74, 0xef, 0xac, 0x8a, 0x8e, 0xbe,
+	0x55, 0x91, 0x3b, 0xa0, 0xc3, 0x5b, 0x50, 0xda, 0x61, 0x54, 0x48, 0xee, 0x85, 0x54, 0x0a, 0xa3,
+	0xa0, 0x6c, 0x16, 0xed, 0xb1, 0xe5, 0xa8, 0xb3, 0x3d, 0xba, 0xa2, 0xdd, 0x41, 0xa9, 0xf5, 0x11,
+	0xc1, 0xdc, 0x75, 0x02, 0x6f, 0xc3, 0x6c, 0x9b, 0x84, 0x41, 0x5b, 0x36, 0xf7, 0x43, 0xea, 0xb3,
+	0x7d, 0x61, 0xa0, 0x5a, 0x61, 0xa9, 0x54, 0xb7, 0xc6, 0x67, 0xd8, 0x52, 0xec, 0x2b, 0x85, 0x36,
+	0x8a, 0xbd, 0xb3, 0x6a, 0xee, 0xc3, 0xe5, 0xf1, 0x32, 0x72, 0xcb, 0xed, 0x81, 0x80, 0xc0, 0x4f,
+	0x61, 0x26, 0xf9, 0x0b, 0xf5, 0x3d, 0xf3, 0xca, 0xb3, 0x36, 0xde, 0x33, 0xb9, 0x81, 0x51, 0xc7,
+	0x92, 0xec, 0x6f, 0x0b, 0xeb, 0x19, 0xcc, 0x0c, 0x66, 0xc6, 0x77, 0x61, 0x46, 0x48, 0x8f, 0xcb,
+	0xa6, 0x4e, 0xab, 0xba, 0x57, 0x70, 0x4b, 0x6a, 0x4f, 0x83, 0xf8, 0x0e, 0x00, 0xa1, 0x7e, 0x06,
+	0xe4, 0x15, 0x50, 0x24, 0xd4, 0xd7, 0x61, 0xeb, 0x0b, 0x02, 0xb8, 0x4a, 0x8c, 0x1f, 0xc2, 0xa4,
+	0x12, 0x1b, 0xe8, 0xc6, 0x36, 0x95, 0x93, 0x1a, 0x93, 0x36, 0xe9, 0x3a, 0xb5, 0x0e, 0x6f, 0xc0,
+	0x74, 0x36, 0x13, 0x69, 0xab, 0xe7, 0x47, 0x3c, 0x36, 0x52, 0x40, 0x5b, 0xbc, 0xef, 0x5b, 0xf4,
+	0x95, 0x78, 0x0d, 0xa6, 0x3a, 0x84, 0x87, 0xcc, 0x37, 0x0a, 0x7f, 0xe8, 0x91, 0xea, 0xac, 0x9f,
+	0x79, 0xc0, 0xaa, 0xc9, 0xc3, 0x73, 0x55, 0x87, 0xff, 0x82, 0x64, 0x97, 0x70, 0x3d, 0x5b, 0x0d,
+	0xe3, 0xf3, 0xc9, 0x4a, 0xf6, 0x74, 0xac, 0xfb, 0x3e, 0x27, 0x42, 0xbc, 0x90, 0x3c, 0xa4, 0x81,
+	0x9b, 0x81, 0x57, 0x1a, 0x62, 0xe4, 0x6f, 0xa7, 0x21, 0xa3, 0x73, 0x55, 0xf8, 0xf7, 0x73, 0xb5,
+	0x36, 0x34, 0x57, 0x13, 0x37, 0x36, 0x6c, 0xe2, 0xa6, 0x99, 0x9a, 0xfc, 0xfb, 0x99, 0xba, 0x0f,
+	0xb3, 0x0a, 0x78, 0x1e, 0x93, 0x98, 0x3c, 0x96, 0x24, 0xc2, 0x16, 0x94, 0x23, 0x11, 0x34, 0x65,
+	0xb7, 0x43, 0x9a, 0x31, 0xdf, 0xd3, 0xf3, 0x54, 0x74, 0x4b, 0x91, 0x08, 0xb6, 0xbb, 0x1d, 0xf2,
+	0x92, 0xef, 0x89, 0x46, 0xbd, 0xf7, 0xc3, 0xcc, 0xf5, 0xce, 0x4d, 0x74, 0x7a, 0x6e, 0xa2, 0xef,
+	0xe7, 0x26, 0x3a, 0xba, 0x30, 0x73, 0xa7, 0x17, 0x66, 0xee, 0xeb, 0x85, 0x99, 0x7b, 0x9d, 0x5e,
+	0xb1, 0xf0, 0x77, 0xed, 0x90, 0x39, 0x07, 0xfa, 0xb5, 0x6f, 0x4d, 0xa9, 0x93, 0xdd, 0xfb, 0x3d,
+	0x00, 0x5a, 0x20, 0x63, 0x0b, 0x12, 0x06, 0x00, 0x00,
 }
 
 func (m *GenericAuthorization) Marshal() (dAtA []byte, err error) {
@@ -285,13 +437,25 @@ func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Constraints != nil {
+		{
+			size, err := m.Constraints.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Expiration != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
-		if err1 != nil {
-			return 0, err1
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err2 != nil {
+			return 0, err2
 		}
-		i -= n1
-		i = encodeVarintAuthz(dAtA, i, uint64(n1))
+		i -= n2
+		i = encodeVarintAuthz(dAtA, i, uint64(n2))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -310,6 +474,137 @@ func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GrantConstraints) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrantConstraints) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GrantConstraints) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TimeWindows) > 0 {
+		for iNdEx := len(m.TimeWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimeWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.HeightWindows) > 0 {
+		for iNdEx := len(m.HeightWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HeightWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeightWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeightWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeightWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimeWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimeWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintAuthz(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintAuthz(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintAuthz(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *GrantAuthorization) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -330,13 +625,25 @@ func (m *GrantAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Constraints != nil {
+		{
+			size, err := m.Constraints.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Expiration != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
-		if err3 != nil {
-			return 0, err3
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err8 != nil {
+			return 0, err8
 		}
-		i -= n3
-		i = encodeVarintAuthz(dAtA, i, uint64(n3))
+		i -= n8
+		i = encodeVarintAuthz(dAtA, i, uint64(n8))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -439,6 +746,61 @@ func (m *Grant) Size() (n int) {
 		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
 		n += 1 + l + sovAuthz(uint64(l))
 	}
+	if m.Constraints != nil {
+		l = m.Constraints.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func (m *GrantConstraints) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HeightWindows) > 0 {
+		for _, e := range m.HeightWindows {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.TimeWindows) > 0 {
+		for _, e := range m.TimeWindows {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HeightWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovAuthz(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovAuthz(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *TimeWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovAuthz(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovAuthz(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovAuthz(uint64(l))
 	return n
 }
 
@@ -464,6 +826,10 @@ func (m *GrantAuthorization) Size() (n int) {
 		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
 		n += 1 + l + sovAuthz(uint64(l))
 	}
+	if m.Constraints != nil {
+		l = m.Constraints.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
 	return n
 }
 
@@ -671,6 +1037,42 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Constraints == nil {
+				m.Constraints = &GrantConstraints{}
+			}
+			if err := m.Constraints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
@@ -692,7 +1094,7 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GrantAuthorization) Unmarshal(dAtA []byte) error {
+func (m *GrantConstraints) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -715,17 +1117,17 @@ func (m *GrantAuthorization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GrantAuthorization: wiretype end group for non-group")
+			return fmt.Errorf("proto: GrantConstraints: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GrantAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GrantConstraints: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeightWindows", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthz
@@ -735,29 +1137,31 @@ func (m *GrantAuthorization) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuthz
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuthz
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Granter = string(dAtA[iNdEx:postIndex])
+			m.HeightWindows = append(m.HeightWindows, HeightWindow{})
+			if err := m.HeightWindows[len(m.HeightWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeWindows", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthz
@@ -767,13 +1171,366 @@ func (m *GrantAuthorization) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeWindows = append(m.TimeWindows, TimeWindow{})
+			if err := m.TimeWindows[len(m.TimeWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeightWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeightWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeightWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimeWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimeWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimeWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GrantAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GrantAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GrantAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuthz
 			}
 			postIndex := iNdEx + intStringLen
@@ -857,6 +1614,42 @@ func (m *GrantAuthorization) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Constraints == nil {
+				m.Constraints = &GrantConstraints{}
+			}
+			if err := m.Constraints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
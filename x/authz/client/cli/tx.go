@@ -3,6 +3,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -28,6 +29,8 @@ const (
 	FlagAllowedValidators = "allowed-validators"
 	FlagDenyValidators    = "deny-validators"
 	FlagAllowList         = "allow-list"
+	FlagHeightWindows     = "height-windows"
+	FlagTimeWindows       = "time-windows"
 	delegate              = "delegate"
 	redelegate            = "redelegate"
 	unbond                = "unbond"
@@ -98,6 +101,7 @@ func NewCmdGrantAuthorization() *cobra.Command {
 Examples:
  $ %[1]s tx authz grant cosmos1skjw.. send --spend-limit=1000stake --from=cosmos1skl..
  $ %[1]s tx authz grant cosmos1skjw.. generic --msg-type=/cosmos.gov.v1.MsgVote --from=cosmos1sk..
+ $ %[1]s tx authz grant cosmos1skjw.. generic --msg-type=/cosmos.upgrade.v1beta1.MsgCancelUpgrade --time-windows=2024-01-06T02:00:00Z/4h/168h --from=cosmos1sk..
 	`, version.AppName),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -235,6 +239,11 @@ Examples:
 				return err
 			}
 
+			msg.Grant.Constraints, err = getGrantConstraints(cmd)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -245,9 +254,73 @@ Examples:
 	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagAllowList, []string{}, "Allowed addresses grantee is allowed to send funds separated by ,")
 	cmd.Flags().Int64(FlagExpiration, 0, "Expire time as Unix timestamp. Set zero (0) for no expiry. Default is 0.")
+	cmd.Flags().StringSlice(FlagHeightWindows, []string{}, "Block height windows the grant can only be executed in, as start-end (end 0 for no end) separated by ,")
+	cmd.Flags().StringSlice(FlagTimeWindows, []string{}, "Recurring time windows the grant can only be executed in, as RFC3339 start/duration/period (period 0 for once) separated by ,")
 	return cmd
 }
 
+// getGrantConstraints returns the grant constraints from the height and time
+// windows flags, nil if none is set.
+func getGrantConstraints(cmd *cobra.Command) (*authz.GrantConstraints, error) {
+	heightWindows, err := cmd.Flags().GetStringSlice(FlagHeightWindows)
+	if err != nil {
+		return nil, err
+	}
+
+	timeWindows, err := cmd.Flags().GetStringSlice(FlagTimeWindows)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(heightWindows) == 0 && len(timeWindows) == 0 {
+		return nil, nil
+	}
+
+	constraints := &authz.GrantConstraints{}
+	for _, w := range heightWindows {
+		start, end, ok := strings.Cut(w, "-")
+		if !ok {
+			return nil, fmt.Errorf("invalid height window %s, expected start-end", w)
+		}
+
+		window := authz.HeightWindow{}
+		if window.StartHeight, err = strconv.ParseInt(start, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid height window %s start: %w", w, err)
+		}
+		if window.EndHeight, err = strconv.ParseInt(end, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid height window %s end: %w", w, err)
+		}
+
+		constraints.HeightWindows = append(constraints.HeightWindows, window)
+	}
+
+	for _, w := range timeWindows {
+		parts := strings.Split(w, "/")
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("invalid time window %s, expected start/duration/period", w)
+		}
+
+		window := authz.TimeWindow{}
+		if window.Start, err = time.Parse(time.RFC3339, parts[0]); err != nil {
+			return nil, fmt.Errorf("invalid time window %s start: %w", w, err)
+		}
+		if window.Duration, err = time.ParseDuration(parts[1]); err != nil {
+			return nil, fmt.Errorf("invalid time window %s duration: %w", w, err)
+		}
+		if window.Period, err = time.ParseDuration(parts[2]); err != nil {
+			return nil, fmt.Errorf("invalid time window %s period: %w", w, err)
+		}
+
+		constraints.TimeWindows = append(constraints.TimeWindows, window)
+	}
+
+	if err := constraints.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return constraints, nil
+}
+
 func getExpireTime(cmd *cobra.Command) (*time.Time, error) {
 	exp, err := cmd.Flags().GetInt64(FlagExpiration)
 	if err != nil {
@@ -17,6 +17,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgGrant{}, "cosmos-sdk/MsgGrant")
 	legacy.RegisterAminoMsg(cdc, &MsgRevoke{}, "cosmos-sdk/MsgRevoke")
 	legacy.RegisterAminoMsg(cdc, &MsgExec{}, "cosmos-sdk/MsgExec")
+	legacy.RegisterAminoMsg(cdc, &MsgExtendGrant{}, "cosmos-sdk/MsgExtendGrant")
 
 	cdc.RegisterInterface((*Authorization)(nil), nil)
 	cdc.RegisterConcrete(&GenericAuthorization{}, "cosmos-sdk/GenericAuthorization", nil)
@@ -28,6 +29,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgGrant{},
 		&MsgRevoke{},
 		&MsgExec{},
+		&MsgExtendGrant{},
 	)
 
 	// since bank.SendAuthorization and staking.StakeAuthorization both implement Authorization
@@ -40,6 +42,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&GenericAuthorization{},
 		&bank.SendAuthorization{},
 		&staking.StakeAuthorization{},
+		&staking.DelegateOnBehalfAuthorization{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, MsgServiceDesc())
 }
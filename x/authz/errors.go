@@ -20,4 +20,6 @@ var (
 	ErrAuthorizationNumOfSigners = errors.Register(ModuleName, 9, "authorization can be given to msg with only one signer")
 	// ErrNegativeMaxTokens error if the max tokens is negative
 	ErrNegativeMaxTokens = errors.Register(ModuleName, 12, "max tokens should be positive")
+	// ErrGrantOutsideWindow error if a grant is executed outside of the windows of its constraints
+	ErrGrantOutsideWindow = errors.Register(ModuleName, 13, "grant cannot be executed outside of its windows")
 )
@@ -6,16 +6,21 @@ package authz
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -203,33 +208,133 @@ func (m *EventPruneExpiredGrants) GetPruner() string {
 	return ""
 }
 
+// EventExtendGrant is emitted on Msg/ExtendGrant with the changes made to the grant.
+type EventExtendGrant struct {
+	// Msg type URL of the extended grant
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// Granter account address
+	Granter string `protobuf:"bytes,3,opt,name=granter,proto3" json:"granter,omitempty"`
+	// Grantee account address
+	Grantee string `protobuf:"bytes,4,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// Expiration of the grant before the extension, unset if the grant had none
+	PreviousExpiration *time.Time `protobuf:"bytes,5,opt,name=previous_expiration,json=previousExpiration,proto3,stdtime" json:"previous_expiration,omitempty"`
+	// Expiration of the grant after the extension, unset if the grant has none
+	Expiration *time.Time `protobuf:"bytes,6,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	// Amount added to the limit of the authorization, empty if unchanged
+	LimitIncrease string `protobuf:"bytes,7,opt,name=limit_increase,json=limitIncrease,proto3" json:"limit_increase,omitempty"`
+}
+
+func (m *EventExtendGrant) Reset()         { *m = EventExtendGrant{} }
+func (m *EventExtendGrant) String() string { return proto.CompactTextString(m) }
+func (*EventExtendGrant) ProtoMessage()    {}
+func (*EventExtendGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f88cbc71a8baf1f, []int{3}
+}
+func (m *EventExtendGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventExtendGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventExtendGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventExtendGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventExtendGrant.Merge(m, src)
+}
+func (m *EventExtendGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventExtendGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventExtendGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventExtendGrant proto.InternalMessageInfo
+
+func (m *EventExtendGrant) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventExtendGrant) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *EventExtendGrant) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *EventExtendGrant) GetPreviousExpiration() *time.Time {
+	if m != nil {
+		return m.PreviousExpiration
+	}
+	return nil
+}
+
+func (m *EventExtendGrant) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func (m *EventExtendGrant) GetLimitIncrease() string {
+	if m != nil {
+		return m.LimitIncrease
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventGrant)(nil), "cosmos.authz.v1beta1.EventGrant")
 	proto.RegisterType((*EventRevoke)(nil), "cosmos.authz.v1beta1.EventRevoke")
 	proto.RegisterType((*EventPruneExpiredGrants)(nil), "cosmos.authz.v1beta1.EventPruneExpiredGrants")
+	proto.RegisterType((*EventExtendGrant)(nil), "cosmos.authz.v1beta1.EventExtendGrant")
 }
 
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/event.proto", fileDescriptor_1f88cbc71a8baf1f) }
 
 var fileDescriptor_1f88cbc71a8baf1f = []byte{
-	// 269 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xa8, 0xd2, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x81, 0xa8,
-	0xd0, 0x03, 0xab, 0xd0, 0x83, 0xaa, 0x90, 0x92, 0x84, 0x88, 0xc6, 0x83, 0xd5, 0xe8, 0x43, 0x95,
-	0x80, 0x39, 0x4a, 0xd3, 0x18, 0xb9, 0xb8, 0x5c, 0x41, 0x06, 0xb8, 0x17, 0x25, 0xe6, 0x95, 0x08,
-	0x29, 0x70, 0xf1, 0xe4, 0x16, 0xa7, 0xc7, 0x97, 0x54, 0x16, 0xa4, 0xc6, 0x97, 0x16, 0xe5, 0x48,
-	0x30, 0x29, 0x30, 0x6a, 0x70, 0x06, 0x71, 0xe5, 0x16, 0xa7, 0x87, 0x54, 0x16, 0xa4, 0x86, 0x16,
-	0xe5, 0x08, 0x19, 0x71, 0xb1, 0xa7, 0x83, 0x94, 0xa6, 0x16, 0x49, 0x30, 0x83, 0x24, 0x9d, 0x24,
-	0x2e, 0x6d, 0xd1, 0x85, 0x59, 0xeb, 0x98, 0x92, 0x52, 0x94, 0x5a, 0x5c, 0x1c, 0x5c, 0x52, 0x94,
-	0x99, 0x97, 0x1e, 0x04, 0x53, 0x88, 0xd0, 0x93, 0x2a, 0xc1, 0x42, 0x9c, 0x9e, 0x54, 0xa5, 0xe9,
-	0x8c, 0x5c, 0xdc, 0x60, 0x87, 0x05, 0xa5, 0x96, 0xe5, 0x67, 0xa7, 0x0e, 0x22, 0x97, 0x79, 0x73,
-	0x89, 0x83, 0x1d, 0x16, 0x50, 0x54, 0x9a, 0x97, 0xea, 0x5a, 0x51, 0x90, 0x59, 0x94, 0x9a, 0x02,
-	0x0e, 0xbd, 0x62, 0x21, 0x03, 0x2e, 0xb6, 0x02, 0x90, 0x68, 0x11, 0xc4, 0x79, 0x78, 0x4c, 0x83,
-	0xaa, 0x73, 0xd2, 0x3b, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18,
-	0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xa8, 0xbe,
-	0xe2, 0x94, 0x6c, 0xbd, 0xcc, 0x7c, 0xfd, 0x0a, 0x48, 0xa4, 0x27, 0xb1, 0x81, 0xa3, 0xcd, 0x18,
-	0x10, 0x00, 0x00, 0xff, 0xff, 0xe8, 0x9f, 0xa5, 0x63, 0x0b, 0x02, 0x00, 0x00,
+	// 398 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x93, 0x41, 0xcf, 0xd2, 0x30,
+	0x1c, 0xc6, 0x29, 0x22, 0xc4, 0xa2, 0xc6, 0x54, 0x12, 0x27, 0x87, 0xb1, 0x90, 0x98, 0x70, 0xb1,
+	0x13, 0xfc, 0x02, 0x4a, 0xb2, 0x18, 0xe3, 0x45, 0x27, 0x5e, 0xbc, 0x2c, 0x83, 0xfd, 0xad, 0x0d,
+	0xdb, 0xba, 0xb4, 0xdd, 0x02, 0x7e, 0x0a, 0x4e, 0xfa, 0x45, 0xfc, 0x10, 0x1e, 0x89, 0x27, 0x6e,
+	0x1a, 0xf8, 0x22, 0x66, 0xdd, 0xe6, 0xcb, 0xed, 0xe5, 0xc8, 0x6d, 0x7d, 0xf6, 0x7b, 0x9e, 0x3e,
+	0xed, 0xfe, 0xc3, 0xce, 0x4a, 0xa8, 0x44, 0x28, 0x37, 0xcc, 0xf5, 0xd7, 0x6f, 0x6e, 0x31, 0x5d,
+	0x82, 0x0e, 0xa7, 0x2e, 0x14, 0x90, 0x6a, 0x9a, 0x49, 0xa1, 0x05, 0x19, 0x54, 0x04, 0x35, 0x04,
+	0xad, 0x89, 0xe1, 0xd3, 0x4a, 0x0d, 0x0c, 0xe3, 0xd6, 0x88, 0x59, 0x0c, 0x07, 0x4c, 0x30, 0x51,
+	0xe9, 0xe5, 0x53, 0xad, 0x8e, 0x98, 0x10, 0x2c, 0x06, 0xd7, 0xac, 0x96, 0xf9, 0x17, 0x57, 0xf3,
+	0x04, 0x94, 0x0e, 0x93, 0xac, 0x02, 0xc6, 0xdf, 0x11, 0xc6, 0x5e, 0xb9, 0xef, 0x1b, 0x19, 0xa6,
+	0x9a, 0x38, 0xf8, 0x7e, 0xa2, 0x58, 0xa0, 0xb7, 0x19, 0x04, 0xb9, 0x8c, 0xad, 0xb6, 0x83, 0x26,
+	0xf7, 0x7c, 0x9c, 0x28, 0xb6, 0xd8, 0x66, 0xf0, 0x49, 0xc6, 0x64, 0x86, 0x7b, 0xac, 0x44, 0x41,
+	0x5a, 0x77, 0xca, 0x97, 0x73, 0xeb, 0xf7, 0xcf, 0xe7, 0x4d, 0xdb, 0xd7, 0x51, 0x24, 0x41, 0xa9,
+	0x8f, 0x5a, 0xf2, 0x94, 0xf9, 0x0d, 0x78, 0xe3, 0x01, 0xab, 0x73, 0x99, 0x07, 0xc6, 0x3f, 0x10,
+	0xee, 0x9b, 0x62, 0x3e, 0x14, 0x62, 0x0d, 0x57, 0xd4, 0xec, 0x1d, 0x7e, 0x62, 0x8a, 0xbd, 0x97,
+	0x79, 0x0a, 0xde, 0x26, 0xe3, 0x12, 0x22, 0x73, 0x7b, 0x8a, 0xbc, 0xc0, 0xdd, 0xac, 0x54, 0xa5,
+	0xd5, 0xbe, 0x25, 0xad, 0xe6, 0xc6, 0x87, 0x36, 0x7e, 0x64, 0xd2, 0xbc, 0x8d, 0x86, 0x34, 0xba,
+	0xb2, 0xaf, 0x40, 0x3e, 0xe0, 0xc7, 0x99, 0x84, 0x82, 0x8b, 0x5c, 0x05, 0x50, 0x1e, 0x35, 0xd4,
+	0x5c, 0xa4, 0xd6, 0x5d, 0x07, 0x4d, 0xfa, 0xb3, 0x21, 0xad, 0xa6, 0x8b, 0x36, 0xd3, 0x45, 0x17,
+	0xcd, 0x74, 0xcd, 0x3b, 0xbb, 0x3f, 0x23, 0xe4, 0x93, 0xc6, 0xec, 0xfd, 0xf7, 0x92, 0x57, 0x18,
+	0x9f, 0x25, 0x75, 0x2f, 0x4c, 0x3a, 0xf3, 0x90, 0x67, 0xf8, 0x61, 0xcc, 0x13, 0xae, 0x03, 0x9e,
+	0xae, 0x24, 0x84, 0x0a, 0xac, 0x9e, 0xb9, 0xa0, 0x07, 0x46, 0x7d, 0x5b, 0x8b, 0x73, 0xfa, 0xeb,
+	0x68, 0xa3, 0xfd, 0xd1, 0x46, 0x7f, 0x8f, 0x36, 0xda, 0x9d, 0xec, 0xd6, 0xfe, 0x64, 0xb7, 0x0e,
+	0x27, 0xbb, 0xf5, 0xb9, 0x3e, 0xb4, 0x8a, 0xd6, 0x94, 0x0b, 0x77, 0x53, 0xfd, 0x86, 0xcb, 0xae,
+	0xd9, 0xfc, 0xe5, 0xbf, 0x01, 0x00, 0x94, 0xa0, 0xaf, 0x7c, 0x9d, 0x03, 0x00, 0x00,
 }
 
 func (m *EventGrant) Marshal() (dAtA []byte, err error) {
@@ -350,6 +455,77 @@ func (m *EventPruneExpiredGrants) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EventExtendGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventExtendGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventExtendGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LimitIncrease) > 0 {
+		i -= len(m.LimitIncrease)
+		copy(dAtA[i:], m.LimitIncrease)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.LimitIncrease)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Expiration != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintEvent(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PreviousExpiration != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.PreviousExpiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PreviousExpiration):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintEvent(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -416,6 +592,39 @@ func (m *EventPruneExpiredGrants) Size() (n int) {
 	return n
 }
 
+func (m *EventExtendGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PreviousExpiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PreviousExpiration)
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.LimitIncrease)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -796,6 +1005,256 @@ func (m *EventPruneExpiredGrants) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventExtendGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventExtendGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventExtendGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousExpiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreviousExpiration == nil {
+				m.PreviousExpiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.PreviousExpiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitIncrease", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitIncrease = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -22,6 +22,11 @@ func ValidateGenesis(data GenesisState) error {
 		if a.Granter == "" {
 			return fmt.Errorf("authorization: %d,missing granter", i)
 		}
+		if a.Constraints != nil {
+			if err := a.Constraints.ValidateBasic(); err != nil {
+				return fmt.Errorf("authorization: %d, invalid constraints: %w", i, err)
+			}
+		}
 
 	}
 	return nil
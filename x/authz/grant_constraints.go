@@ -0,0 +1,113 @@
+package authz
+
+import (
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxGrantWindows is the maximum number of height windows, and of time windows,
+// of the constraints of a grant.
+const MaxGrantWindows = 32
+
+// ValidateBasic performs a stateless validation of the grant constraints.
+func (c GrantConstraints) ValidateBasic() error {
+	if len(c.HeightWindows) == 0 && len(c.TimeWindows) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("grant constraints must have at least one height or time window")
+	}
+	if len(c.HeightWindows) > MaxGrantWindows || len(c.TimeWindows) > MaxGrantWindows {
+		return sdkerrors.ErrInvalidRequest.Wrapf("grant constraints cannot have more than %d height or time windows", MaxGrantWindows)
+	}
+
+	for _, w := range c.HeightWindows {
+		if err := w.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	for _, w := range c.TimeWindows {
+		if err := w.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Allows returns true if a grant with these constraints can be executed at the
+// given block height and time.
+func (c GrantConstraints) Allows(height int64, t time.Time) bool {
+	if len(c.HeightWindows) > 0 {
+		within := false
+		for _, w := range c.HeightWindows {
+			if w.Contains(height) {
+				within = true
+				break
+			}
+		}
+		if !within {
+			return false
+		}
+	}
+
+	if len(c.TimeWindows) > 0 {
+		within := false
+		for _, w := range c.TimeWindows {
+			if w.Contains(t) {
+				within = true
+				break
+			}
+		}
+		if !within {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ValidateBasic performs a stateless validation of the height window.
+func (w HeightWindow) ValidateBasic() error {
+	if w.StartHeight < 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("height window start cannot be negative: %d", w.StartHeight)
+	}
+	if w.EndHeight != 0 && w.EndHeight < w.StartHeight {
+		return sdkerrors.ErrInvalidRequest.Wrapf("height window end %d cannot be before its start %d", w.EndHeight, w.StartHeight)
+	}
+
+	return nil
+}
+
+// Contains returns true if the given block height is within the window.
+func (w HeightWindow) Contains(height int64) bool {
+	return height >= w.StartHeight && (w.EndHeight == 0 || height <= w.EndHeight)
+}
+
+// ValidateBasic performs a stateless validation of the time window.
+func (w TimeWindow) ValidateBasic() error {
+	if w.Duration <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("time window duration must be positive: %s", w.Duration)
+	}
+	if w.Period < 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("time window period cannot be negative: %s", w.Period)
+	}
+	if w.Period != 0 && w.Period < w.Duration {
+		return sdkerrors.ErrInvalidRequest.Wrapf("time window period %s cannot be shorter than its duration %s", w.Period, w.Duration)
+	}
+
+	return nil
+}
+
+// Contains returns true if the given time is within an occurrence of the window,
+// the start of an occurrence being included and its end excluded.
+func (w TimeWindow) Contains(t time.Time) bool {
+	if t.Before(w.Start) {
+		return false
+	}
+
+	elapsed := t.Sub(w.Start)
+	if w.Period > 0 {
+		elapsed %= w.Period
+	}
+
+	return elapsed < w.Duration
+}
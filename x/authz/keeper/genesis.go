@@ -31,7 +31,7 @@ func (k Keeper) InitGenesis(ctx context.Context, data *authz.GenesisState) {
 			panic("expected authorization")
 		}
 
-		err = k.SaveGrant(ctx, grantee, granter, a, entry.Expiration)
+		err = k.SaveGrantWithConstraints(ctx, grantee, granter, a, entry.Expiration, entry.Constraints)
 		if err != nil {
 			panic(err)
 		}
@@ -47,6 +47,7 @@ func (k Keeper) ExportGenesis(ctx context.Context) *authz.GenesisState {
 			Grantee:       grantee.String(),
 			Expiration:    grant.Expiration,
 			Authorization: grant.Authorization,
+			Constraints:   grant.Constraints,
 		})
 		return false
 	})
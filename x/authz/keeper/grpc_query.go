@@ -54,6 +54,7 @@ func (k Keeper) Grants(ctx context.Context, req *authz.QueryGrantsRequest) (*aut
 			Grants: []*authz.Grant{{
 				Authorization: authorizationAny,
 				Expiration:    grant.Expiration,
+				Constraints:   grant.Constraints,
 			}},
 		}, nil
 	}
@@ -75,6 +76,7 @@ func (k Keeper) Grants(ctx context.Context, req *authz.QueryGrantsRequest) (*aut
 		return &authz.Grant{
 			Authorization: authorizationAny,
 			Expiration:    auth.Expiration,
+			Constraints:   auth.Constraints,
 		}, nil
 	}, func() *authz.Grant {
 		return &authz.Grant{}
@@ -126,6 +128,7 @@ func (k Keeper) GranterGrants(ctx context.Context, req *authz.QueryGranterGrants
 			Grantee:       granteeAddr,
 			Authorization: any,
 			Expiration:    auth.Expiration,
+			Constraints:   auth.Constraints,
 		}, nil
 	}, func() *authz.Grant {
 		return &authz.Grant{}
@@ -177,6 +180,7 @@ func (k Keeper) GranteeGrants(ctx context.Context, req *authz.QueryGranteeGrants
 		return &authz.GrantAuthorization{
 			Authorization: authorizationAny,
 			Expiration:    auth.Expiration,
+			Constraints:   auth.Constraints,
 			Granter:       granterAddr,
 			Grantee:       req.Grantee,
 		}, nil
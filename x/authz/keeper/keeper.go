@@ -123,6 +123,10 @@ func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msg
 				return nil, authz.ErrAuthorizationExpired
 			}
 
+			if grant.Constraints != nil && !grant.Constraints.Allows(sdkCtx.HeaderInfo().Height, now) {
+				return nil, authz.ErrGrantOutsideWindow
+			}
+
 			authorization, err := grant.GetAuthorization()
 			if err != nil {
 				return nil, err
@@ -183,6 +187,12 @@ func (k Keeper) DispatchActions(ctx context.Context, grantee sdk.AccAddress, msg
 // with the provided expiration time and insert authorization key into the grants queue. If there is an existing authorization grant for the
 // same `sdk.Msg` type, this grant overwrites that.
 func (k Keeper) SaveGrant(ctx context.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization, expiration *time.Time) error {
+	return k.SaveGrantWithConstraints(ctx, grantee, granter, authorization, expiration, nil)
+}
+
+// SaveGrantWithConstraints is SaveGrant restricting the execution of the grant
+// to the windows of the provided constraints, if any.
+func (k Keeper) SaveGrantWithConstraints(ctx context.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization, expiration *time.Time, constraints *authz.GrantConstraints) error {
 	msgType := authorization.MsgTypeURL()
 	store := k.environment.KVStoreService.OpenKVStore(ctx)
 	skey := grantStoreKey(grantee, granter, msgType)
@@ -191,6 +201,7 @@ func (k Keeper) SaveGrant(ctx context.Context, grantee, granter sdk.AccAddress,
 	if err != nil {
 		return err
 	}
+	grant.Constraints = constraints
 
 	var oldExp *time.Time
 	if oldGrant, found := k.getGrant(ctx, skey); found {
@@ -227,6 +238,90 @@ func (k Keeper) SaveGrant(ctx context.Context, grantee, granter sdk.AccAddress,
 	})
 }
 
+// ExtendSavedGrant extends the expiration and/or increases the limit of the grant
+// given by the granter to the grantee for the provided message type in place.
+// A nil expiration keeps the current one, and an empty limitIncrease keeps
+// the current limit.
+func (k Keeper) ExtendSavedGrant(ctx context.Context, grantee, granter sdk.AccAddress, msgType string, expiration *time.Time, limitIncrease sdk.Coins) error {
+	if expiration == nil && limitIncrease.Empty() {
+		return sdkerrors.ErrInvalidRequest.Wrap("either expiration or limit increase must be set")
+	}
+
+	store := k.environment.KVStoreService.OpenKVStore(ctx)
+	skey := grantStoreKey(grantee, granter, msgType)
+	grant, found := k.getGrant(ctx, skey)
+	if !found {
+		return errorsmod.Wrapf(authz.ErrNoAuthorizationFound, "failed to extend grant with key %s", string(skey))
+	}
+
+	oldExp := grant.Expiration
+	if oldExp != nil && oldExp.Before(k.environment.HeaderService.GetHeaderInfo(ctx).Time) {
+		return authz.ErrAuthorizationExpired
+	}
+
+	if expiration != nil {
+		if oldExp == nil {
+			return errorsmod.Wrap(authz.ErrInvalidExpirationTime, "grant has no expiration to extend")
+		}
+
+		if !expiration.After(*oldExp) {
+			return errorsmod.Wrapf(authz.ErrInvalidExpirationTime, "expiration must be after the current grant expiration (%v), got %v", oldExp.Format(time.RFC3339), expiration.Format(time.RFC3339))
+		}
+
+		if err := k.removeFromGrantQueue(ctx, skey, granter, grantee, *oldExp); err != nil {
+			return err
+		}
+
+		if err := k.insertIntoGrantQueue(ctx, granter, grantee, msgType, *expiration); err != nil {
+			return err
+		}
+
+		grant.Expiration = expiration
+	}
+
+	if !limitIncrease.Empty() {
+		authorization, err := grant.GetAuthorization()
+		if err != nil {
+			return err
+		}
+
+		limited, ok := authorization.(authz.LimitedAuthorization)
+		if !ok {
+			return sdkerrors.ErrInvalidType.Wrapf("%T has no limit to increase", authorization)
+		}
+
+		if err := limited.IncreaseLimit(limitIncrease); err != nil {
+			return err
+		}
+
+		if err := limited.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if grant.Authorization, err = codectypes.NewAnyWithValue(limited); err != nil {
+			return err
+		}
+	}
+
+	bz, err := k.cdc.Marshal(&grant)
+	if err != nil {
+		return err
+	}
+
+	if err := store.Set(skey, bz); err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).Emit(&authz.EventExtendGrant{
+		MsgTypeUrl:         msgType,
+		Granter:            granter.String(),
+		Grantee:            grantee.String(),
+		PreviousExpiration: oldExp,
+		Expiration:         grant.Expiration,
+		LimitIncrease:      limitIncrease.String(),
+	})
+}
+
 // DeleteGrant revokes any authorization for the provided message type granted to the grantee
 // by the granter.
 func (k Keeper) DeleteGrant(ctx context.Context, grantee, granter sdk.AccAddress, msgType string) error {
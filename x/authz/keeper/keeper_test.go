@@ -285,6 +285,76 @@ func (s *TestSuite) TestDispatchAction() {
 				require.Len(authzs, 0)
 			},
 		},
+		{
+			"expect error outside of the height windows",
+			authz.NewMsgExec(granteeAddr, []sdk.Msg{
+				&banktypes.MsgSend{
+					Amount:      coins10,
+					FromAddress: granterAddr.String(),
+					ToAddress:   recipientAddr.String(),
+				},
+			}),
+			true,
+			"grant cannot be executed outside of its windows",
+			func() sdk.Context {
+				constraints := &authz.GrantConstraints{HeightWindows: []authz.HeightWindow{{StartHeight: 100, EndHeight: 200}}}
+				err := s.authzKeeper.SaveGrantWithConstraints(s.ctx, granteeAddr, granterAddr, a, nil, constraints)
+				require.NoError(err)
+				return s.ctx.WithHeaderInfo(header.Info{Height: 201, Time: now})
+			},
+			func() {},
+		},
+		{
+			"expect error outside of the time windows",
+			authz.NewMsgExec(granteeAddr, []sdk.Msg{
+				&banktypes.MsgSend{
+					Amount:      coins10,
+					FromAddress: granterAddr.String(),
+					ToAddress:   recipientAddr.String(),
+				},
+			}),
+			true,
+			"grant cannot be executed outside of its windows",
+			func() sdk.Context {
+				constraints := &authz.GrantConstraints{TimeWindows: []authz.TimeWindow{{Start: now, Duration: time.Hour, Period: 24 * time.Hour}}}
+				err := s.authzKeeper.SaveGrantWithConstraints(s.ctx, granteeAddr, granterAddr, a, nil, constraints)
+				require.NoError(err)
+				return s.ctx.WithHeaderInfo(header.Info{Time: now.Add(25*time.Hour + time.Minute)})
+			},
+			func() {},
+		},
+		{
+			"valid test within the windows",
+			authz.NewMsgExec(granteeAddr, []sdk.Msg{
+				&banktypes.MsgSend{
+					Amount:      coins10,
+					FromAddress: granterAddr.String(),
+					ToAddress:   recipientAddr.String(),
+				},
+			}),
+			false,
+			"",
+			func() sdk.Context {
+				constraints := &authz.GrantConstraints{
+					HeightWindows: []authz.HeightWindow{{StartHeight: 100, EndHeight: 200}},
+					TimeWindows:   []authz.TimeWindow{{Start: now, Duration: time.Hour, Period: 24 * time.Hour}},
+				}
+				err := s.authzKeeper.SaveGrantWithConstraints(s.ctx, granteeAddr, granterAddr, a, nil, constraints)
+				require.NoError(err)
+				return s.ctx.WithHeaderInfo(header.Info{Height: 150, Time: now.Add(48*time.Hour + 30*time.Minute)})
+			},
+			func() {
+				grant, err := s.queryClient.Grants(s.ctx, &authz.QueryGrantsRequest{
+					Granter:    granterAddr.String(),
+					Grantee:    granteeAddr.String(),
+					MsgTypeUrl: bankSendAuthMsgType,
+				})
+				require.NoError(err)
+				require.Len(grant.Grants, 1)
+				require.NotNil(grant.Grants[0].Constraints)
+				require.Len(grant.Grants[0].Constraints.TimeWindows, 1)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
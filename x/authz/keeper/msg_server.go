@@ -44,7 +44,7 @@ func (k Keeper) Grant(ctx context.Context, msg *authz.MsgGrant) (*authz.MsgGrant
 		return nil, sdkerrors.ErrInvalidType.Wrapf("%s doesn't exist.", t)
 	}
 
-	err = k.SaveGrant(ctx, grantee, granter, authorization, msg.Grant.Expiration)
+	err = k.SaveGrantWithConstraints(ctx, grantee, granter, authorization, msg.Grant.Expiration, msg.Grant.Constraints)
 	if err != nil {
 		return nil, err
 	}
@@ -124,6 +124,37 @@ func (k Keeper) PruneExpiredGrants(ctx context.Context, msg *authz.MsgPruneExpir
 	return &authz.MsgPruneExpiredGrantsResponse{}, nil
 }
 
+// ExtendGrant implements the MsgServer.ExtendGrant method.
+func (k Keeper) ExtendGrant(ctx context.Context, msg *authz.MsgExtendGrant) (*authz.MsgExtendGrantResponse, error) {
+	if strings.EqualFold(msg.Grantee, msg.Granter) {
+		return nil, authz.ErrGranteeIsGranter
+	}
+
+	grantee, err := k.authKeeper.AddressCodec().StringToBytes(msg.Grantee)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
+	}
+
+	granter, err := k.authKeeper.AddressCodec().StringToBytes(msg.Granter)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+
+	if msg.MsgTypeUrl == "" {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("missing msg method name")
+	}
+
+	if !msg.LimitIncrease.IsValid() {
+		return nil, sdkerrors.ErrInvalidCoins.Wrap(msg.LimitIncrease.String())
+	}
+
+	if err := k.ExtendSavedGrant(ctx, grantee, granter, msg.MsgTypeUrl, msg.Expiration, msg.LimitIncrease); err != nil {
+		return nil, err
+	}
+
+	return &authz.MsgExtendGrantResponse{}, nil
+}
+
 func validateMsgs(msgs []sdk.Msg) error {
 	for i, msg := range msgs {
 		m, ok := msg.(sdk.HasValidateBasic)
@@ -304,6 +304,88 @@ func (suite *TestSuite) TestRevoke() {
 	}
 }
 
+func (suite *TestSuite) TestExtendGrant() {
+	addrs := suite.createAccounts()
+	suite.accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	grantee, granter := addrs[0], addrs[1]
+	curBlockTime := suite.ctx.HeaderInfo().Time
+	oneDay := curBlockTime.Add(24 * time.Hour)
+	tenMinutes := curBlockTime.Add(10 * time.Minute)
+	suite.createSendAuthorization(grantee, granter)
+	suite.Require().NoError(suite.authzKeeper.SaveGrant(suite.ctx, grantee, granter, authz.NewGenericAuthorization(bankSendAuthMsgType+"Generic"), &oneDay))
+
+	testCases := []struct {
+		name   string
+		msg    *authz.MsgExtendGrant
+		expErr bool
+		errMsg string
+	}{
+		{
+			name:   "identical grantee and granter",
+			msg:    authz.NewMsgExtendGrant(grantee, grantee, bankSendAuthMsgType, &oneDay, nil),
+			expErr: true,
+			errMsg: "grantee and granter should be different",
+		},
+		{
+			name:   "no msg given",
+			msg:    authz.NewMsgExtendGrant(granter, grantee, "", &oneDay, nil),
+			expErr: true,
+			errMsg: "missing msg method name",
+		},
+		{
+			name:   "nothing to extend",
+			msg:    authz.NewMsgExtendGrant(granter, grantee, bankSendAuthMsgType, nil, nil),
+			expErr: true,
+			errMsg: "either expiration or limit increase must be set",
+		},
+		{
+			name:   "no existing grant to extend",
+			msg:    authz.NewMsgExtendGrant(grantee, granter, bankSendAuthMsgType, &oneDay, nil),
+			expErr: true,
+			errMsg: "authorization not found",
+		},
+		{
+			name:   "expiration not extended",
+			msg:    authz.NewMsgExtendGrant(granter, grantee, bankSendAuthMsgType, &tenMinutes, nil),
+			expErr: true,
+			errMsg: "expiration must be after the current grant expiration",
+		},
+		{
+			name:   "limit increase of an authorization without limit",
+			msg:    authz.NewMsgExtendGrant(granter, grantee, bankSendAuthMsgType+"Generic", nil, sdk.NewCoins(sdk.NewInt64Coin("steak", 50))),
+			expErr: true,
+			errMsg: "has no limit to increase",
+		},
+		{
+			name: "extend expiration and increase limit",
+			msg:  authz.NewMsgExtendGrant(granter, grantee, bankSendAuthMsgType, &oneDay, sdk.NewCoins(sdk.NewInt64Coin("steak", 50))),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.ExtendGrant(suite.ctx, tc.msg)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+
+	authorization, expiration := suite.authzKeeper.GetAuthorization(suite.ctx, grantee, granter, bankSendAuthMsgType)
+	suite.Require().Equal(&banktypes.SendAuthorization{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("steak", 150))}, authorization)
+	suite.Require().Equal(oneDay, *expiration)
+
+	// the grant is no longer pruned at its previous expiration
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Time: curBlockTime.Add(2 * time.Hour)})
+	suite.Require().NoError(suite.authzKeeper.DequeueAndDeleteExpiredGrants(ctx, 10))
+	authorization, _ = suite.authzKeeper.GetAuthorization(ctx, grantee, granter, bankSendAuthMsgType)
+	suite.Require().NotNil(authorization)
+}
+
 func (suite *TestSuite) TestExec() {
 	addrs := suite.createAccounts()
 
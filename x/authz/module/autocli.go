@@ -70,6 +70,17 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "msg_type_url"},
 					},
 				},
+				{
+					RpcMethod: "ExtendGrant",
+					Use:       "extend-grant [grantee] [msg-type-url] --from [granter]",
+					Short:     "Extend the expiration and/or increase the limit of an existing grant",
+					Example: fmt.Sprintf(`%s tx authz extend-grant cosmos1skj.. %s --expiration=2030-01-01T00:00:00Z --limit-increase=100stake --from=cosmos1skj..`,
+						version.AppName, bank.SendAuthorization{}.MsgTypeURL()),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "grantee"},
+						{ProtoField: "msg_type_url"},
+					},
+				},
 				{
 					RpcMethod: "PruneExpiredGrants",
 					Use:       "prune-grants --from [granter]",
@@ -14,6 +14,7 @@ var (
 	_ sdk.Msg = &MsgGrant{}
 	_ sdk.Msg = &MsgRevoke{}
 	_ sdk.Msg = &MsgExec{}
+	_ sdk.Msg = &MsgExtendGrant{}
 
 	_ cdctypes.UnpackInterfacesMessage = &MsgGrant{}
 	_ cdctypes.UnpackInterfacesMessage = &MsgExec{}
@@ -110,3 +111,14 @@ func (msg MsgExec) GetMessages() ([]sdk.Msg, error) {
 
 	return msgs, nil
 }
+
+// NewMsgExtendGrant creates a new MsgExtendGrant
+func NewMsgExtendGrant(granter, grantee sdk.AccAddress, msgTypeURL string, expiration *time.Time, limitIncrease sdk.Coins) *MsgExtendGrant {
+	return &MsgExtendGrant{
+		Granter:       granter.String(),
+		Grantee:       grantee.String(),
+		MsgTypeUrl:    msgTypeURL,
+		Expiration:    expiration,
+		LimitIncrease: limitIncrease,
+	}
+}
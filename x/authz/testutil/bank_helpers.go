@@ -27,3 +27,71 @@ func (k MockBankKeeper) UpdateParams(ctx context.Context, req *bank.MsgUpdatePar
 func (k MockBankKeeper) SetSendEnabled(ctx context.Context, req *bank.MsgSetSendEnabled) (*bank.MsgSetSendEnabledResponse, error) {
 	return nil, nil
 }
+
+func (k MockBankKeeper) SetBalanceAlerts(ctx context.Context, req *bank.MsgSetBalanceAlerts) (*bank.MsgSetBalanceAlertsResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) CreateDenom(ctx context.Context, req *bank.MsgCreateDenom) (*bank.MsgCreateDenomResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) FactoryMint(ctx context.Context, req *bank.MsgFactoryMint) (*bank.MsgFactoryMintResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) FactoryBurn(ctx context.Context, req *bank.MsgFactoryBurn) (*bank.MsgFactoryBurnResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) ForceTransfer(ctx context.Context, req *bank.MsgForceTransfer) (*bank.MsgForceTransferResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) ChangeDenomAdmin(ctx context.Context, req *bank.MsgChangeDenomAdmin) (*bank.MsgChangeDenomAdminResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) SetFactoryDenomMetadata(ctx context.Context, req *bank.MsgSetFactoryDenomMetadata) (*bank.MsgSetFactoryDenomMetadataResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) SetDenomTransferHook(ctx context.Context, req *bank.MsgSetDenomTransferHook) (*bank.MsgSetDenomTransferHookResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) RemoveDenomTransferHook(ctx context.Context, req *bank.MsgRemoveDenomTransferHook) (*bank.MsgRemoveDenomTransferHookResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) SetDenomMetadata(ctx context.Context, req *bank.MsgSetDenomMetadata) (*bank.MsgSetDenomMetadataResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) UpdateDenomMetadata(ctx context.Context, req *bank.MsgUpdateDenomMetadata) (*bank.MsgUpdateDenomMetadataResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) SetSendRestrictionPolicies(ctx context.Context, req *bank.MsgSetSendRestrictionPolicies) (*bank.MsgSetSendRestrictionPoliciesResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) SetNonCirculatingAddresses(ctx context.Context, req *bank.MsgSetNonCirculatingAddresses) (*bank.MsgSetNonCirculatingAddressesResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) SweepDust(ctx context.Context, req *bank.MsgSweepDust) (*bank.MsgSweepDustResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) SetSpendingLimit(ctx context.Context, req *bank.MsgSetSpendingLimit) (*bank.MsgSetSpendingLimitResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) SetDenomTransferFee(ctx context.Context, req *bank.MsgSetDenomTransferFee) (*bank.MsgSetDenomTransferFeeResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) RemoveDenomTransferFee(ctx context.Context, req *bank.MsgRemoveDenomTransferFee) (*bank.MsgRemoveDenomTransferFeeResponse, error) {
+	return nil, nil
+}
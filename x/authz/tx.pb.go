@@ -8,23 +8,29 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -349,6 +355,91 @@ func (m *MsgPruneExpiredGrantsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgPruneExpiredGrantsResponse proto.InternalMessageInfo
 
+// MsgExtendGrant extends the expiration and/or increases the limit of the grant
+// given by the granter to the grantee for the provided msg type.
+type MsgExtendGrant struct {
+	Granter    string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	Grantee    string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	MsgTypeUrl string `protobuf:"bytes,3,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// expiration is the new expiration of the grant. It must be later than the
+	// current one. If unset, the current expiration is kept.
+	Expiration *time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	// limit_increase is added to the limit of the authorization. It is only
+	// supported by authorizations with a limit, such as SendAuthorization and
+	// StakeAuthorization.
+	LimitIncrease github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=limit_increase,json=limitIncrease,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"limit_increase"`
+}
+
+func (m *MsgExtendGrant) Reset()         { *m = MsgExtendGrant{} }
+func (m *MsgExtendGrant) String() string { return proto.CompactTextString(m) }
+func (*MsgExtendGrant) ProtoMessage()    {}
+func (*MsgExtendGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{8}
+}
+func (m *MsgExtendGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExtendGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExtendGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExtendGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExtendGrant.Merge(m, src)
+}
+func (m *MsgExtendGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExtendGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExtendGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExtendGrant proto.InternalMessageInfo
+
+// MsgExtendGrantResponse defines the Msg/ExtendGrant response type.
+type MsgExtendGrantResponse struct {
+}
+
+func (m *MsgExtendGrantResponse) Reset()         { *m = MsgExtendGrantResponse{} }
+func (m *MsgExtendGrantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExtendGrantResponse) ProtoMessage()    {}
+func (*MsgExtendGrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{9}
+}
+func (m *MsgExtendGrantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExtendGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExtendGrantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExtendGrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExtendGrantResponse.Merge(m, src)
+}
+func (m *MsgExtendGrantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExtendGrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExtendGrantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExtendGrantResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgGrant)(nil), "cosmos.authz.v1beta1.MsgGrant")
 	proto.RegisterType((*MsgGrantResponse)(nil), "cosmos.authz.v1beta1.MsgGrantResponse")
@@ -358,50 +449,63 @@ func init() {
 	proto.RegisterType((*MsgRevokeResponse)(nil), "cosmos.authz.v1beta1.MsgRevokeResponse")
 	proto.RegisterType((*MsgPruneExpiredGrants)(nil), "cosmos.authz.v1beta1.MsgPruneExpiredGrants")
 	proto.RegisterType((*MsgPruneExpiredGrantsResponse)(nil), "cosmos.authz.v1beta1.MsgPruneExpiredGrantsResponse")
+	proto.RegisterType((*MsgExtendGrant)(nil), "cosmos.authz.v1beta1.MsgExtendGrant")
+	proto.RegisterType((*MsgExtendGrantResponse)(nil), "cosmos.authz.v1beta1.MsgExtendGrantResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/tx.proto", fileDescriptor_3ceddab7d8589ad1) }
 
 var fileDescriptor_3ceddab7d8589ad1 = []byte{
-	// 604 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x4f, 0x6f, 0x12, 0x41,
-	0x1c, 0x65, 0x4a, 0x0b, 0x32, 0x34, 0xd1, 0x6e, 0x31, 0x6e, 0xb7, 0x61, 0xd9, 0xac, 0x56, 0x09,
-	0x0d, 0xbb, 0x42, 0x6f, 0xc4, 0x4b, 0x49, 0x88, 0x17, 0x89, 0x66, 0xfd, 0x73, 0xf0, 0x42, 0x96,
-	0x32, 0x8e, 0x04, 0x76, 0x67, 0xb3, 0xb3, 0x10, 0xf0, 0x64, 0x3c, 0x7a, 0xf2, 0x63, 0xe8, 0x8d,
-	0x43, 0x8f, 0x7e, 0x00, 0xe2, 0xa9, 0xf1, 0x60, 0x3c, 0x19, 0x85, 0x03, 0x1f, 0xc1, 0xab, 0xd9,
-	0x99, 0xd9, 0xb5, 0x55, 0x68, 0xeb, 0xc5, 0x0b, 0xfc, 0xfe, 0xbc, 0xdf, 0xcc, 0x7b, 0xf3, 0x66,
-	0x16, 0xe6, 0x8f, 0x08, 0x75, 0x08, 0x35, 0xed, 0x41, 0xf0, 0xf2, 0x95, 0x39, 0xac, 0xb4, 0x51,
-	0x60, 0x57, 0xcc, 0x60, 0x64, 0x78, 0x3e, 0x09, 0x88, 0x94, 0xe3, 0x6d, 0x83, 0xb5, 0x0d, 0xd1,
-	0x56, 0x76, 0x78, 0xb5, 0xc5, 0x30, 0xa6, 0x80, 0xb0, 0x44, 0xc9, 0x61, 0x82, 0x09, 0xaf, 0x87,
-	0x91, 0xa8, 0xee, 0x60, 0x42, 0x70, 0x1f, 0x99, 0x2c, 0x6b, 0x0f, 0x5e, 0x98, 0xb6, 0x3b, 0x16,
-	0x2d, 0x6d, 0x29, 0x01, 0xbe, 0x1f, 0x47, 0xdc, 0x10, 0x08, 0x87, 0x62, 0x73, 0x58, 0x09, 0xff,
-	0x44, 0x63, 0xcb, 0x76, 0xba, 0x2e, 0x31, 0xd9, 0x2f, 0x2f, 0xe9, 0x5f, 0x00, 0xbc, 0xd2, 0xa4,
-	0xf8, 0xbe, 0x6f, 0xbb, 0x81, 0x54, 0x85, 0x69, 0x1c, 0x06, 0xc8, 0x97, 0x81, 0x06, 0x8a, 0x99,
-	0xba, 0xfc, 0xf9, 0xb8, 0x1c, 0x29, 0x3a, 0xec, 0x74, 0x7c, 0x44, 0xe9, 0xe3, 0xc0, 0xef, 0xba,
-	0xd8, 0x8a, 0x80, 0xbf, 0x67, 0x90, 0xbc, 0x76, 0xb9, 0x19, 0x24, 0xdd, 0x83, 0x1b, 0x2c, 0x94,
-	0x93, 0x1a, 0x28, 0x66, 0xab, 0xbb, 0xc6, 0xb2, 0x43, 0x33, 0x18, 0xa7, 0x7a, 0x66, 0xfa, 0xad,
-	0x90, 0x78, 0xbf, 0x98, 0x94, 0x80, 0xc5, 0x87, 0x6a, 0xb7, 0xde, 0x2c, 0x26, 0xa5, 0x68, 0xff,
-	0xb7, 0x8b, 0x49, 0x69, 0x9b, 0x8f, 0x97, 0x69, 0xa7, 0x67, 0x46, 0x5a, 0x74, 0x09, 0x5e, 0x8b,
-	0x62, 0x0b, 0x51, 0x8f, 0xb8, 0x14, 0xe9, 0x1f, 0x00, 0x4c, 0x37, 0x29, 0x6e, 0x8c, 0xd0, 0xd1,
-	0x69, 0xde, 0xe0, 0xb2, 0xbc, 0x1b, 0x70, 0xdd, 0xa1, 0x98, 0xca, 0x6b, 0x5a, 0xb2, 0x98, 0xad,
-	0xe6, 0x0c, 0x6e, 0x92, 0x11, 0x99, 0x64, 0x1c, 0xba, 0xe3, 0xfa, 0xee, 0xa7, 0xe3, 0xb2, 0x30,
-	0xc0, 0x68, 0xdb, 0x14, 0xc5, 0x72, 0x9a, 0x14, 0x5b, 0x6c, 0xbc, 0x76, 0xf3, 0x94, 0x00, 0x14,
-	0x0a, 0x90, 0xce, 0x0a, 0x08, 0xf9, 0xe9, 0xfb, 0xf0, 0xaa, 0x08, 0x23, 0xfa, 0x92, 0x0c, 0xd3,
-	0x3e, 0xa2, 0x83, 0x7e, 0x40, 0x65, 0xa0, 0x25, 0x8b, 0x9b, 0x56, 0x94, 0xea, 0x1f, 0x01, 0xcc,
-	0x84, 0xeb, 0xa3, 0x21, 0xe9, 0xa1, 0xff, 0x66, 0xa3, 0x06, 0x37, 0x1d, 0x8a, 0x5b, 0xc1, 0xd8,
-	0x43, 0xad, 0x81, 0xdf, 0x67, 0x6e, 0x66, 0x2c, 0xe8, 0x50, 0xfc, 0x64, 0xec, 0xa1, 0xa7, 0x7e,
-	0xbf, 0xb6, 0xf7, 0xa7, 0x55, 0xb9, 0xb3, 0x4a, 0x39, 0x61, 0x7d, 0x1b, 0x6e, 0xc5, 0x49, 0x6c,
-	0xd6, 0x33, 0x78, 0xbd, 0x49, 0xf1, 0x23, 0x7f, 0xe0, 0xa2, 0xc6, 0xc8, 0xeb, 0xfa, 0xa8, 0xc3,
-	0xcc, 0xa4, 0xd2, 0x5d, 0x98, 0xf2, 0xc2, 0xea, 0xc5, 0xea, 0x04, 0xae, 0x96, 0x0d, 0x69, 0x88,
-	0x44, 0x2f, 0xc0, 0xfc, 0xd2, 0x75, 0xa3, 0x8d, 0xab, 0x3f, 0xd7, 0x60, 0xb2, 0x49, 0xb1, 0xf4,
-	0x10, 0x6e, 0xf0, 0x67, 0xa1, 0x2e, 0xbf, 0x9f, 0xd1, 0xf5, 0x52, 0x6e, 0x9f, 0xdf, 0x8f, 0xfd,
-	0x7b, 0x00, 0xd7, 0xd9, 0xd5, 0xcb, 0xaf, 0xc4, 0x87, 0x6d, 0x65, 0xef, 0xdc, 0x76, 0xbc, 0x9a,
-	0x05, 0x53, 0xc2, 0xef, 0xc2, 0xca, 0x01, 0x0e, 0x50, 0xee, 0x5c, 0x00, 0x88, 0xd7, 0x1c, 0x42,
-	0x69, 0xc9, 0x81, 0xef, 0xaf, 0x1c, 0xff, 0x1b, 0xac, 0x1c, 0xfc, 0x03, 0x38, 0xda, 0x57, 0xd9,
-	0x78, 0x1d, 0x3e, 0xf0, 0x7a, 0x75, 0xfa, 0x43, 0x4d, 0x4c, 0x67, 0x2a, 0x38, 0x99, 0xa9, 0xe0,
-	0xfb, 0x4c, 0x05, 0xef, 0xe6, 0x6a, 0xe2, 0x64, 0xae, 0x26, 0xbe, 0xce, 0xd5, 0xc4, 0x73, 0xe1,
-	0x31, 0xed, 0xf4, 0x8c, 0x2e, 0x31, 0x47, 0xfc, 0x93, 0xd7, 0x4e, 0xb1, 0xd7, 0x77, 0xf0, 0x2b,
-	0x00, 0x00, 0xff, 0xff, 0x06, 0x4f, 0xd8, 0xf8, 0x98, 0x05, 0x00, 0x00,
+	// 782 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0x8f, 0x9b, 0xa4, 0x25, 0x97, 0x52, 0xa8, 0x1b, 0xc0, 0x71, 0x55, 0x27, 0x32, 0x2d, 0x44,
+	0x29, 0xb5, 0x69, 0xba, 0x45, 0x0c, 0x34, 0xa8, 0x20, 0x24, 0x22, 0x90, 0x29, 0x0c, 0x2c, 0x91,
+	0x93, 0x1c, 0x57, 0xab, 0xb1, 0x2f, 0xf2, 0x39, 0x51, 0xc2, 0x84, 0x98, 0x10, 0x53, 0x25, 0x36,
+	0x3e, 0x01, 0x30, 0x65, 0xe8, 0xc8, 0x07, 0xa8, 0x98, 0x2a, 0x06, 0x84, 0x18, 0x28, 0xb4, 0x43,
+	0xbe, 0x06, 0xba, 0xf3, 0xd9, 0xa4, 0x69, 0xfa, 0x87, 0xa5, 0x4b, 0x72, 0xf7, 0xde, 0xef, 0xdd,
+	0xfb, 0xfb, 0x7b, 0x06, 0x73, 0x35, 0x4c, 0x6c, 0x4c, 0x74, 0xb3, 0xe5, 0x6d, 0xbc, 0xd2, 0xdb,
+	0xcb, 0x55, 0xe8, 0x99, 0xcb, 0xba, 0xd7, 0xd1, 0x9a, 0x2e, 0xf6, 0xb0, 0x98, 0xf2, 0xd5, 0x1a,
+	0x53, 0x6b, 0x5c, 0x2d, 0xa7, 0x7d, 0x69, 0x85, 0x61, 0x74, 0x0e, 0x61, 0x17, 0x39, 0x85, 0x30,
+	0xc2, 0xbe, 0x9c, 0x9e, 0xb8, 0x34, 0x8d, 0x30, 0x46, 0x0d, 0xa8, 0xb3, 0x5b, 0xb5, 0xf5, 0x52,
+	0x37, 0x9d, 0x2e, 0x57, 0x65, 0x47, 0x06, 0xe0, 0xfb, 0xf3, 0x11, 0xd7, 0x38, 0xc2, 0x26, 0x48,
+	0x6f, 0x2f, 0xd3, 0x3f, 0xae, 0x98, 0x36, 0x6d, 0xcb, 0xc1, 0x3a, 0xfb, 0xe5, 0xa2, 0xcc, 0xb0,
+	0x23, 0xcf, 0xb2, 0x21, 0xf1, 0x4c, 0xbb, 0xc9, 0x01, 0x0a, 0x7f, 0xac, 0x6a, 0x12, 0x18, 0x7a,
+	0xab, 0x61, 0xcb, 0xf1, 0xf5, 0xea, 0x77, 0x01, 0x5c, 0x28, 0x13, 0xf4, 0xc0, 0x35, 0x1d, 0x4f,
+	0x2c, 0x80, 0x09, 0x44, 0x0f, 0xd0, 0x95, 0x84, 0xac, 0x90, 0x4b, 0x94, 0xa4, 0x6f, 0xdb, 0x4b,
+	0x41, 0x49, 0x56, 0xeb, 0x75, 0x17, 0x12, 0xf2, 0xd4, 0x73, 0x2d, 0x07, 0x19, 0x01, 0xf0, 0x9f,
+	0x0d, 0x94, 0xc6, 0xce, 0x66, 0x03, 0xc5, 0x3b, 0x20, 0xce, 0x8e, 0x52, 0x34, 0x2b, 0xe4, 0x92,
+	0x85, 0x59, 0x6d, 0x54, 0xd5, 0x35, 0x16, 0x53, 0x29, 0xb1, 0xf3, 0x2b, 0x13, 0xf9, 0xd8, 0xef,
+	0xe5, 0x05, 0xc3, 0x37, 0x2a, 0xce, 0xbf, 0xe9, 0xf7, 0xf2, 0x81, 0xff, 0x77, 0xfd, 0x5e, 0x7e,
+	0xc6, 0x37, 0x5f, 0x22, 0xf5, 0x4d, 0x3d, 0xc8, 0x45, 0x15, 0xc1, 0xe5, 0xe0, 0x6c, 0x40, 0xd2,
+	0xc4, 0x0e, 0x81, 0xea, 0x27, 0x01, 0x4c, 0x94, 0x09, 0x5a, 0xeb, 0xc0, 0xda, 0x60, 0xdc, 0xc2,
+	0x59, 0xe3, 0x5e, 0x03, 0x31, 0x9b, 0x20, 0x22, 0x8d, 0x65, 0xa3, 0xb9, 0x64, 0x21, 0xa5, 0xf9,
+	0xc5, 0xd7, 0x82, 0xe2, 0x6b, 0xab, 0x4e, 0xb7, 0x34, 0xfb, 0x75, 0x7b, 0x89, 0x77, 0x50, 0xa3,
+	0x45, 0x0f, 0xd3, 0x29, 0x13, 0x64, 0x30, 0xf3, 0xe2, 0xf5, 0x81, 0x04, 0x20, 0x4d, 0x40, 0x3c,
+	0x9c, 0x00, 0x8d, 0x4f, 0x5d, 0x04, 0x97, 0xf8, 0x31, 0x08, 0x5f, 0x94, 0xc0, 0x84, 0x0b, 0x49,
+	0xab, 0xe1, 0x11, 0x49, 0xc8, 0x46, 0x73, 0x93, 0x46, 0x70, 0x55, 0xbf, 0x08, 0x20, 0x41, 0xdf,
+	0x87, 0x6d, 0xbc, 0x09, 0xcf, 0xad, 0x8d, 0x59, 0x30, 0x69, 0x13, 0x54, 0xf1, 0xba, 0x4d, 0x58,
+	0x69, 0xb9, 0x0d, 0xd6, 0xcd, 0x84, 0x01, 0x6c, 0x82, 0xd6, 0xbb, 0x4d, 0xf8, 0xcc, 0x6d, 0x14,
+	0x17, 0x86, 0x5b, 0x95, 0x3a, 0x9c, 0xa9, 0x1f, 0xb0, 0x3a, 0x03, 0xa6, 0xc3, 0x4b, 0xd8, 0xac,
+	0xe7, 0xe0, 0x4a, 0x99, 0xa0, 0x27, 0x6e, 0xcb, 0x81, 0x6b, 0x9d, 0xa6, 0xe5, 0xc2, 0x3a, 0x6b,
+	0x26, 0x11, 0x6f, 0x83, 0xf1, 0x26, 0x95, 0x9e, 0x9e, 0x1d, 0xc7, 0x15, 0x93, 0x34, 0x0c, 0x7e,
+	0x51, 0x33, 0x60, 0x6e, 0xe4, 0xbb, 0xa1, 0xe3, 0xf7, 0x51, 0x30, 0xc5, 0x4a, 0xef, 0x41, 0xa7,
+	0x7e, 0xbe, 0xc4, 0x38, 0xb5, 0xa2, 0xe2, 0x5d, 0x00, 0x20, 0x8d, 0xda, 0xf4, 0x2c, 0xec, 0x48,
+	0x31, 0xc6, 0x1f, 0xf9, 0xc8, 0x20, 0xae, 0x07, 0x5b, 0xa0, 0x14, 0xdb, 0xda, 0xcb, 0x08, 0xc6,
+	0x80, 0x8d, 0xf8, 0x56, 0x00, 0x53, 0x0d, 0xcb, 0xb6, 0xbc, 0x8a, 0xe5, 0xd4, 0x5c, 0x68, 0x12,
+	0x28, 0xc5, 0xd9, 0x3c, 0xa7, 0xb5, 0x51, 0x63, 0x7b, 0x0f, 0x5b, 0x4e, 0xe9, 0x3e, 0x25, 0xe1,
+	0xe7, 0xbd, 0x4c, 0x0e, 0x59, 0xde, 0x46, 0xab, 0xaa, 0xd5, 0xb0, 0xcd, 0xd7, 0xa0, 0x3e, 0xd0,
+	0x50, 0x1a, 0x36, 0x61, 0x06, 0xe4, 0x43, 0xbf, 0x97, 0x9f, 0x6c, 0x40, 0x64, 0xd6, 0xba, 0x15,
+	0xba, 0x6d, 0x88, 0xcf, 0xe0, 0x8b, 0xcc, 0xf1, 0x43, 0xee, 0xb7, 0x98, 0x1f, 0x1e, 0x8f, 0xf4,
+	0x30, 0x11, 0xc2, 0x16, 0xa8, 0x12, 0xb8, 0x7a, 0x58, 0x12, 0xf4, 0xab, 0xf0, 0x33, 0x0a, 0xa2,
+	0x65, 0x82, 0xc4, 0xc7, 0x20, 0xee, 0x77, 0x4b, 0x19, 0xbd, 0x4f, 0x82, 0x75, 0x20, 0xdf, 0x38,
+	0x59, 0x1f, 0xf2, 0xed, 0x11, 0x88, 0xb1, 0x55, 0x31, 0x77, 0x2c, 0x9e, 0xaa, 0xe5, 0x85, 0x13,
+	0xd5, 0xe1, 0x6b, 0x06, 0x18, 0xe7, 0xfc, 0xcc, 0x1c, 0x6b, 0xe0, 0x03, 0xe4, 0x9b, 0xa7, 0x00,
+	0xc2, 0x37, 0xdb, 0x40, 0x1c, 0x41, 0x90, 0xc5, 0x63, 0xcd, 0x8f, 0x82, 0xe5, 0x95, 0xff, 0x00,
+	0x87, 0x7e, 0x4d, 0x90, 0x1c, 0xa4, 0xc7, 0xfc, 0x09, 0x15, 0x08, 0x51, 0xf2, 0xad, 0xb3, 0xa0,
+	0x02, 0x17, 0x72, 0xfc, 0x35, 0x9d, 0x98, 0x52, 0x61, 0xe7, 0x8f, 0x12, 0xd9, 0xd9, 0x57, 0x84,
+	0xdd, 0x7d, 0x45, 0xf8, 0xbd, 0xaf, 0x08, 0x5b, 0x07, 0x4a, 0x64, 0xf7, 0x40, 0x89, 0xfc, 0x38,
+	0x50, 0x22, 0x2f, 0x38, 0x9d, 0x48, 0x7d, 0x53, 0xb3, 0xb0, 0xde, 0xf1, 0x3f, 0xa3, 0xd5, 0x71,
+	0xc6, 0x83, 0x95, 0xbf, 0x03, 0x00, 0x30, 0xb9, 0xe4, 0xc7, 0xec, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -432,6 +536,10 @@ type MsgClient interface {
 	//
 	// Since cosmos-sdk 0.51
 	PruneExpiredGrants(ctx context.Context, in *MsgPruneExpiredGrants, opts ...grpc.CallOption) (*MsgPruneExpiredGrantsResponse, error)
+	// ExtendGrant extends the expiration and/or increases the limit of an existing
+	// grant in place, without the revoke and re-grant that would interrupt the
+	// grantee in the meantime.
+	ExtendGrant(ctx context.Context, in *MsgExtendGrant, opts ...grpc.CallOption) (*MsgExtendGrantResponse, error)
 }
 
 type msgClient struct {
@@ -478,6 +586,15 @@ func (c *msgClient) PruneExpiredGrants(ctx context.Context, in *MsgPruneExpiredG
 	return out, nil
 }
 
+func (c *msgClient) ExtendGrant(ctx context.Context, in *MsgExtendGrant, opts ...grpc.CallOption) (*MsgExtendGrantResponse, error) {
+	out := new(MsgExtendGrantResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authz.v1beta1.Msg/ExtendGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Grant grants the provided authorization to the grantee on the granter's
@@ -496,6 +613,10 @@ type MsgServer interface {
 	//
 	// Since cosmos-sdk 0.51
 	PruneExpiredGrants(context.Context, *MsgPruneExpiredGrants) (*MsgPruneExpiredGrantsResponse, error)
+	// ExtendGrant extends the expiration and/or increases the limit of an existing
+	// grant in place, without the revoke and re-grant that would interrupt the
+	// grantee in the meantime.
+	ExtendGrant(context.Context, *MsgExtendGrant) (*MsgExtendGrantResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -514,6 +635,9 @@ func (*UnimplementedMsgServer) Revoke(ctx context.Context, req *MsgRevoke) (*Msg
 func (*UnimplementedMsgServer) PruneExpiredGrants(ctx context.Context, req *MsgPruneExpiredGrants) (*MsgPruneExpiredGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PruneExpiredGrants not implemented")
 }
+func (*UnimplementedMsgServer) ExtendGrant(ctx context.Context, req *MsgExtendGrant) (*MsgExtendGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendGrant not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -591,6 +715,24 @@ func _Msg_PruneExpiredGrants_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ExtendGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExtendGrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExtendGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authz.v1beta1.Msg/ExtendGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExtendGrant(ctx, req.(*MsgExtendGrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.authz.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -611,6 +753,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PruneExpiredGrants",
 			Handler:    _Msg_PruneExpiredGrants_Handler,
 		},
+		{
+			MethodName: "ExtendGrant",
+			Handler:    _Msg_ExtendGrant_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/tx.proto",
@@ -882,6 +1028,97 @@ func (m *MsgPruneExpiredGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgExtendGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExtendGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExtendGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LimitIncrease) > 0 {
+		for iNdEx := len(m.LimitIncrease) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitIncrease[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Expiration != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintTx(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExtendGrantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExtendGrantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExtendGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1007,6 +1244,46 @@ func (m *MsgPruneExpiredGrantsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgExtendGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.LimitIncrease) > 0 {
+		for _, e := range m.LimitIncrease {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExtendGrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1736,6 +2013,272 @@ func (m *MsgPruneExpiredGrantsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgExtendGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExtendGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExtendGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitIncrease", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitIncrease = append(m.LimitIncrease, types1.Coin{})
+			if err := m.LimitIncrease[len(m.LimitIncrease)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExtendGrantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExtendGrantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExtendGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
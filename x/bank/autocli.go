@@ -29,6 +29,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Long:           "Query the total balance of an account or of a specific denomination.",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
 				},
+				{
+					RpcMethod:      "BalancesBatch",
+					Use:            "balances-batch [addresses]",
+					Short:          "Query the balances of up to 100 accounts, optionally restricted to a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "addresses", Varargs: true}},
+				},
 				{
 					RpcMethod:      "SpendableBalances",
 					Use:            "spendable-balances [address]",
@@ -41,6 +47,40 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Query the spendable balance of a single denom for a single account.",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "denom"}},
 				},
+				{
+					RpcMethod:      "Holds",
+					Use:            "holds [address]",
+					Short:          "Query the coins of an account placed on hold by modules",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "SpendingLimits",
+					Use:            "spending-limits [address]",
+					Short:          "Query the daily spending limits set by an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "DenomTransferHook",
+					Use:            "denom-transfer-hook [denom]",
+					Short:          "Query the transfer hook registered for a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod: "DenomTransferHooks",
+					Use:       "denom-transfer-hooks",
+					Short:     "Query the transfer hooks registered for all denoms",
+				},
+				{
+					RpcMethod:      "DenomTransferFee",
+					Use:            "denom-transfer-fee [denom]",
+					Short:          "Query the transfer fee charged on a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod: "DenomTransferFees",
+					Use:       "denom-transfer-fees",
+					Short:     "Query the transfer fees charged on all denoms",
+				},
 				{
 					RpcMethod: "TotalSupply",
 					Use:       "total-supply",
@@ -87,6 +127,51 @@ To look up all denoms, do not provide any arguments.`,
 					),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denoms"}},
 				},
+				{
+					RpcMethod: "BalanceAlerts",
+					Use:       "balance-alerts",
+					Short:     "Query the balance alerts registered on module accounts",
+				},
+				{
+					RpcMethod:      "FactoryDenom",
+					Use:            "factory-denom [denom]",
+					Short:          "Query a token factory denom and its admin",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "FactoryDenomsFromCreator",
+					Use:            "factory-denoms-from-creator [creator]",
+					Short:          "Query the token factory denoms created by an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "creator"}},
+				},
+				{
+					RpcMethod:      "SendRestrictionPolicy",
+					Use:            "send-restriction-policy [name]",
+					Short:          "Query a send restriction policy by name",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "name"}},
+				},
+				{
+					RpcMethod: "SendRestrictionPolicies",
+					Use:       "send-restriction-policies",
+					Short:     "Query the send restriction policies, optionally only the enabled ones",
+				},
+				{
+					RpcMethod:      "BalanceHistory",
+					Use:            "balance-history [address]",
+					Short:          "Query the balance journal entries of an account tracked by the balance journal, optionally filtered by denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "CirculatingSupply",
+					Use:            "circulating-supply [denom]",
+					Short:          "Query the circulating supply of a denom, excluding module accounts, locked vesting coins and non-circulating addresses",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod: "NonCirculatingAddresses",
+					Use:       "non-circulating-addresses",
+					Short:     "Query the addresses excluded from the circulating supply",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -128,6 +213,128 @@ Note: multiple coins can be send by space separated.`,
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod:      "SetBalanceAlerts",
+					Use:            "set-balance-alerts-proposal [balance_alerts]",
+					Short:          "Submit a proposal to set/update/delete balance alerts on module accounts. A zero threshold deletes the alert.",
+					Example:        fmt.Sprintf(`%s tx bank set-balance-alerts-proposal '{"address":"cosmos1...","denom":"stake","threshold":"1000000"}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "balance_alerts", Varargs: true}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "SetSendRestrictionPolicies",
+					Use:            "set-send-restriction-policies-proposal [policies]",
+					Short:          "Submit a proposal to set/update send restriction policies (address blocklist, denom freeze, transfer pause)",
+					Example:        fmt.Sprintf(`%s tx bank set-send-restriction-policies-proposal '{"name":"freeze-foo","restriction_type":"SEND_RESTRICTION_TYPE_DENOM_FREEZE","enabled":true,"denoms":["foo"]}' --remove old-policy`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "policies", Varargs: true}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "SetNonCirculatingAddresses",
+					Use:            "set-non-circulating-addresses-proposal [add]",
+					Short:          "Submit a proposal to add/remove addresses excluded from the circulating supply",
+					Example:        fmt.Sprintf(`%s tx bank set-non-circulating-addresses-proposal cosmos1... --remove cosmos1...`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "add", Varargs: true}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "CreateDenom",
+					Use:            "create-denom [subdenom]",
+					Short:          "Create a factory/{creator}/{subdenom} denom with the sender as admin",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "subdenom"}},
+				},
+				{
+					RpcMethod:      "FactoryMint",
+					Use:            "factory-mint [amount]",
+					Short:          "Mint coins of a token factory denom. The coins are minted to the admin unless --mint-to-address is set.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"mint_to_address": {Name: "mint-to-address", Usage: "Address to mint the coins to"},
+					},
+				},
+				{
+					RpcMethod:      "FactoryBurn",
+					Use:            "factory-burn [amount]",
+					Short:          "Burn coins of a token factory denom. The coins are burned from the admin unless --burn-from-address is set.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"burn_from_address": {Name: "burn-from-address", Usage: "Address to burn the coins from"},
+					},
+				},
+				{
+					RpcMethod:      "ForceTransfer",
+					Use:            "force-transfer [amount] [from_address] [to_address]",
+					Short:          "Transfer coins of a token factory denom between two accounts as the denom admin",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}, {ProtoField: "from_address"}, {ProtoField: "to_address"}},
+				},
+				{
+					RpcMethod:      "ChangeDenomAdmin",
+					Use:            "change-denom-admin [denom] [new_admin]",
+					Short:          "Change the admin of a token factory denom. An empty new admin removes the admin for good.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "new_admin"}},
+				},
+				{
+					RpcMethod:      "SetFactoryDenomMetadata",
+					Use:            "set-factory-denom-metadata [metadata]",
+					Short:          "Set the metadata of a token factory denom",
+					Example:        fmt.Sprintf(`%s tx bank set-factory-denom-metadata '{"base":"factory/cosmos1.../mytoken","display":"mytoken","name":"mytoken","symbol":"MTK","denom_units":[{"denom":"factory/cosmos1.../mytoken","exponent":0}]}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "metadata"}},
+				},
+				{
+					RpcMethod:      "SetDenomMetadata",
+					Use:            "set-denom-metadata [metadata]",
+					Short:          "Register the metadata of a denom without metadata as its token factory admin or the authority",
+					Example:        fmt.Sprintf(`%s tx bank set-denom-metadata '{"base":"factory/cosmos1.../mytoken","display":"mytoken","name":"mytoken","symbol":"MTK","denom_units":[{"denom":"factory/cosmos1.../mytoken","exponent":0},{"denom":"mytoken","exponent":6}]}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "metadata"}},
+				},
+				{
+					RpcMethod:      "UpdateDenomMetadata",
+					Use:            "update-denom-metadata [metadata]",
+					Short:          "Replace the metadata of a denom as its token factory admin or the authority. The exponents of the existing denom units cannot change.",
+					Example:        fmt.Sprintf(`%s tx bank update-denom-metadata '{"base":"factory/cosmos1.../mytoken","display":"mytoken","name":"My Token","symbol":"MTK","denom_units":[{"denom":"factory/cosmos1.../mytoken","exponent":0},{"denom":"mytoken","exponent":6}]}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "metadata"}},
+				},
+				{
+					RpcMethod:      "SweepDust",
+					Use:            "sweep-dust [addresses...]",
+					Short:          "Sweep the dust balances of inactive accounts to the community pool",
+					Example:        fmt.Sprintf(`%s tx bank sweep-dust cosmos1... cosmos1... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "addresses", Varargs: true}},
+				},
+				{
+					RpcMethod:      "SetSpendingLimit",
+					Use:            "set-spending-limit [denom] [daily-limit]",
+					Short:          "Set the daily limit of the amount of a denom your account can send, zero to remove it",
+					Long:           "Set the daily limit of the amount of a denom your account can send to other accounts. Lowering the limit takes effect immediately, raising or removing it, with a zero daily limit, after the spending limit change delay.",
+					Example:        fmt.Sprintf(`%s tx bank set-spending-limit stake 1000000 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "daily_limit"}},
+				},
+				{
+					RpcMethod:      "SetDenomTransferHook",
+					Use:            "set-denom-transfer-hook [hook]",
+					Short:          "Register the before/after transfer hook of a denom as its token factory admin or the authority",
+					Example:        fmt.Sprintf(`%s tx bank set-denom-transfer-hook '{"denom":"factory/cosmos1.../mytoken","target":"cosmos1...","before_transfer":true,"gas_limit":"200000"}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "hook"}},
+				},
+				{
+					RpcMethod:      "RemoveDenomTransferHook",
+					Use:            "remove-denom-transfer-hook [denom]",
+					Short:          "Remove the transfer hook of a denom as its token factory admin or the authority",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "SetDenomTransferFee",
+					Use:            "set-denom-transfer-fee [fee]",
+					Short:          "Register the fee charged on every transfer of a denom as its token factory admin or the authority",
+					Example:        fmt.Sprintf(`%s tx bank set-denom-transfer-fee '{"denom":"factory/cosmos1.../mytoken","rate":"0.01","collector":"cosmos1...","exempt_addresses":["cosmos1..."]}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "fee"}},
+				},
+				{
+					RpcMethod:      "RemoveDenomTransferFee",
+					Use:            "remove-denom-transfer-fee [denom]",
+					Short:          "Remove the transfer fee of a denom as its token factory admin or the authority",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
 			},
 		},
 	}
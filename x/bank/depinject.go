@@ -1,8 +1,13 @@
 package bank
 
 import (
+	"sort"
+
+	"golang.org/x/exp/maps"
+
 	modulev1 "cosmossdk.io/api/cosmos/bank/module/v1"
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
 	"cosmossdk.io/log"
@@ -11,6 +16,7 @@ import (
 	"cosmossdk.io/x/bank/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 var _ depinject.OnePerModuleType = AppModule{}
@@ -21,16 +27,19 @@ func (am AppModule) IsOnePerModuleType() {}
 func init() {
 	appconfig.RegisterModule(&modulev1.Module{},
 		appconfig.Provide(ProvideModule),
+		appconfig.Invoke(InvokeSetBurnHooks),
+		appconfig.Invoke(InvokeSetTransferHooks),
 	)
 }
 
 type ModuleInputs struct {
 	depinject.In
 
-	Config      *modulev1.Module
-	Cdc         codec.Codec
-	Environment appmodule.Environment
-	Logger      log.Logger
+	Config                *modulev1.Module
+	Cdc                   codec.Codec
+	Environment           appmodule.Environment
+	TransientStoreService store.TransientStoreService
+	Logger                log.Logger
 
 	AccountKeeper types.AccountKeeper
 }
@@ -85,7 +94,44 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		authStr,
 		in.Logger,
 	)
+	bankKeeper.SetModuleTransferTraceStore(in.TransientStoreService)
 	m := NewAppModule(in.Cdc, bankKeeper, in.AccountKeeper)
 
 	return ModuleOutputs{BankKeeper: bankKeeper, Module: m}
 }
+
+// InvokeSetBurnHooks sets the burn hooks provided by other modules, ordered
+// by module name.
+func InvokeSetBurnHooks(keeper keeper.BaseKeeper, burnHooks map[string]types.BurnHooksWrapper) {
+	if len(burnHooks) == 0 {
+		return
+	}
+
+	modNames := maps.Keys(burnHooks)
+	sort.Strings(modNames)
+
+	var multiHooks types.MultiBurnHooks
+	for _, modName := range modNames {
+		multiHooks = append(multiHooks, burnHooks[modName])
+	}
+
+	keeper.SetBurnHooks(multiHooks)
+}
+
+// InvokeSetTransferHooks sets the transfer hooks provided by other modules,
+// each routed from the address of its module account.
+func InvokeSetTransferHooks(keeper keeper.BaseKeeper, transferHooks map[string]types.TransferHooksWrapper) {
+	if len(transferHooks) == 0 {
+		return
+	}
+
+	modNames := maps.Keys(transferHooks)
+	sort.Strings(modNames)
+
+	router := types.NewTransferHooksRouter(nil)
+	for _, modName := range modNames {
+		router = router.AddRoute(address.Module(modName), transferHooks[modName])
+	}
+
+	keeper.SetTransferHooks(router)
+}
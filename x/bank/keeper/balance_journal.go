@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// maxBalanceJournalPrunedEntries is the maximum number of balance journal
+// entries pruned in a single block, so that pruning a large backlog after the
+// retention is lowered doesn't stall a block.
+const maxBalanceJournalPrunedEntries = 1000
+
+// setBalanceJournalAddresses replaces the set of addresses tracked by the
+// balance journal.
+func (k BaseSendKeeper) setBalanceJournalAddresses(ctx context.Context, addresses []string) error {
+	if err := k.BalanceJournalAddresses.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	for _, addr := range addresses {
+		bz, err := k.ak.AddressCodec().StringToBytes(addr)
+		if err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid balance journal address %s: %s", addr, err)
+		}
+
+		if err := k.BalanceJournalAddresses.Set(ctx, bz); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// recordBalanceChange appends an entry to the balance journal if the address
+// is tracked by the journal and its balance changed.
+func (k BaseSendKeeper) recordBalanceChange(ctx context.Context, addr sdk.AccAddress, previous, current sdk.Coin, reason string) error {
+	tracked, err := k.BalanceJournalAddresses.Has(ctx, addr)
+	if err != nil || !tracked {
+		return err
+	}
+
+	delta := current.Amount.Sub(previous.Amount)
+	if delta.IsZero() {
+		return nil
+	}
+
+	addrStr, err := k.ak.AddressCodec().BytesToString(addr)
+	if err != nil {
+		return err
+	}
+
+	return k.SetBalanceJournalEntry(ctx, types.BalanceJournalEntry{
+		Address: addrStr,
+		Height:  k.environment.HeaderService.GetHeaderInfo(ctx).Height,
+		Denom:   current.Denom,
+		Delta:   delta,
+		Reason:  reason,
+	})
+}
+
+// SetBalanceJournalEntry appends an entry to the balance journal of its
+// address.
+func (k BaseSendKeeper) SetBalanceJournalEntry(ctx context.Context, entry types.BalanceJournalEntry) error {
+	if err := entry.Validate(); err != nil {
+		return err
+	}
+
+	addr, err := k.ak.AddressCodec().StringToBytes(entry.Address)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid balance journal entry address: %s", err)
+	}
+
+	id, err := k.BalanceJournalSequence.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	return k.BalanceJournal.Set(ctx, collections.Join(sdk.AccAddress(addr), id), entry)
+}
+
+// GetAllBalanceJournalEntries returns all the balance journal entries ordered
+// by address and insertion.
+func (k BaseSendKeeper) GetAllBalanceJournalEntries(ctx context.Context) ([]types.BalanceJournalEntry, error) {
+	var entries []types.BalanceJournalEntry
+	err := k.BalanceJournal.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, uint64], entry types.BalanceJournalEntry) (bool, error) {
+		entries = append(entries, entry)
+		return false, nil
+	})
+	return entries, err
+}
+
+// PruneBalanceJournal removes the balance journal entries older than the
+// retention configured in the params. A zero retention keeps the entries
+// forever.
+func (k BaseSendKeeper) PruneBalanceJournal(ctx context.Context) error {
+	params := k.GetParams(ctx)
+	if params.BalanceJournalRetention == 0 {
+		return nil
+	}
+
+	height := k.environment.HeaderService.GetHeaderInfo(ctx).Height
+	if height <= 0 || uint64(height) <= params.BalanceJournalRetention {
+		return nil
+	}
+	cutoff := uint64(height) - params.BalanceJournalRetention
+
+	var pruned []collections.Pair[sdk.AccAddress, uint64]
+	err := k.BalanceJournal.Indexes.Height.Walk(ctx, nil, func(entryHeight uint64, key collections.Pair[sdk.AccAddress, uint64]) (bool, error) {
+		if entryHeight >= cutoff {
+			return true, nil
+		}
+
+		pruned = append(pruned, key)
+		return len(pruned) >= maxBalanceJournalPrunedEntries, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range pruned {
+		if err := k.BalanceJournal.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/bank/keeper"
+	banktestutil "cosmossdk.io/x/bank/testutil"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+// setupBenchmarkKeeper returns a bank keeper with an account keeper mock
+// accepting any call, and a context whose gas meter is reset for each call.
+func setupBenchmarkKeeper(b *testing.B) (sdk.Context, keeper.BaseKeeper) {
+	b.Helper()
+	key := storetypes.NewKVStoreKey(banktypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(b, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+
+	authKeeper := banktestutil.NewMockAccountKeeper(gomock.NewController(b))
+	authKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+	authKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	authKeeper.EXPECT().GetModuleAccount(gomock.Any(), mintAcc.Name).Return(mintAcc).AnyTimes()
+	authKeeper.EXPECT().GetModuleAddress(mintAcc.Name).Return(mintAcc.GetAddress()).AnyTimes()
+
+	bankKeeper := keeper.NewBaseKeeper(
+		runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger()),
+		encCfg.Codec,
+		authKeeper,
+		map[string]bool{},
+		authtypes.NewModuleAddress(banktypes.GovModuleName).String(),
+		log.NewNopLogger(),
+	)
+
+	return testCtx.Ctx, bankKeeper
+}
+
+// multiSendOutputs returns n outputs of 1foo to the given number of distinct
+// recipients, and the input funding them.
+func multiSendOutputs(n, recipients int) (banktypes.Input, []banktypes.Output) {
+	outputs := make([]banktypes.Output, n)
+	for i := range outputs {
+		outputs[i] = banktypes.Output{
+			Address: sdk.AccAddress(fmt.Sprintf("output%014d", i%recipients)).String(),
+			Coins:   sdk.NewCoins(newFooCoin(1)),
+		}
+	}
+
+	return banktypes.Input{Address: accAddrs[0].String(), Coins: sdk.NewCoins(newFooCoin(int64(n)))}, outputs
+}
+
+func BenchmarkInputOutputCoins(b *testing.B) {
+	for _, bc := range []struct {
+		outputs    int
+		recipients int
+	}{
+		{outputs: 100, recipients: 100},
+		{outputs: 1_000, recipients: 1_000},
+		{outputs: 10_000, recipients: 10_000},
+		{outputs: 50_000, recipients: 50_000},
+		{outputs: 10_000, recipients: 100},
+	} {
+		b.Run(fmt.Sprintf("outputs=%d/recipients=%d", bc.outputs, bc.recipients), func(b *testing.B) {
+			ctx, bankKeeper := setupBenchmarkKeeper(b)
+			input, outputs := multiSendOutputs(bc.outputs, bc.recipients)
+			require.NoError(b, banktestutil.FundAccount(ctx, bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(int64(bc.outputs)))))
+
+			var gas uint64
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				// each iteration runs against the same state, as a transaction would
+				cacheCtx, _ := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).CacheContext()
+				if err := bankKeeper.InputOutputCoins(cacheCtx, input, outputs); err != nil {
+					b.Fatal(err)
+				}
+				gas = cacheCtx.GasMeter().GasConsumed()
+			}
+
+			b.ReportMetric(float64(gas)/float64(bc.outputs), "gas/output")
+		})
+	}
+}
+
+func BenchmarkValidateInputOutputs(b *testing.B) {
+	for _, n := range []int{100, 1_000, 10_000, 50_000} {
+		b.Run(fmt.Sprintf("outputs=%d", n), func(b *testing.B) {
+			input, outputs := multiSendOutputs(n, n)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if err := banktypes.ValidateInputOutputs(input, outputs); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
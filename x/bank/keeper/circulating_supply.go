@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetNonCirculatingAddress excludes the balances of the given address from the
+// circulating supply.
+func (k BaseKeeper) SetNonCirculatingAddress(ctx context.Context, address string) error {
+	addr, err := k.ak.AddressCodec().StringToBytes(address)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid non-circulating address %s: %s", address, err)
+	}
+
+	return k.BaseViewKeeper.NonCirculatingAddresses.Set(ctx, addr)
+}
+
+// RemoveNonCirculatingAddress no longer excludes the balances of the given
+// address from the circulating supply.
+func (k BaseKeeper) RemoveNonCirculatingAddress(ctx context.Context, address string) error {
+	addr, err := k.ak.AddressCodec().StringToBytes(address)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid non-circulating address %s: %s", address, err)
+	}
+
+	has, err := k.BaseViewKeeper.NonCirculatingAddresses.Has(ctx, addr)
+	if err != nil {
+		return err
+	}
+
+	if !has {
+		return sdkerrors.ErrNotFound.Wrapf("%s is not a non-circulating address", address)
+	}
+
+	return k.BaseViewKeeper.NonCirculatingAddresses.Remove(ctx, addr)
+}
+
+// GetAllNonCirculatingAddresses returns the addresses excluded from the
+// circulating supply.
+func (k BaseKeeper) GetAllNonCirculatingAddresses(ctx context.Context) ([]string, error) {
+	var addresses []string
+	err := k.BaseViewKeeper.NonCirculatingAddresses.Walk(ctx, nil, func(addr sdk.AccAddress) (bool, error) {
+		address, err := k.ak.AddressCodec().BytesToString(addr)
+		if err != nil {
+			return true, err
+		}
+
+		addresses = append(addresses, address)
+		return false, nil
+	})
+	return addresses, err
+}
+
+// GetCirculatingSupply returns the circulating supply of a denom, along with
+// the part of its total supply that doesn't circulate. The balances of module
+// accounts and of the non-circulating addresses, and the locked portions of
+// vesting accounts, don't circulate.
+//
+// It iterates over all the holders of the denom.
+func (k BaseKeeper) GetCirculatingSupply(ctx context.Context, denom string) (circulating, nonCirculating sdk.Coin, err error) {
+	blockTime := k.environment.HeaderService.GetHeaderInfo(ctx).Time
+	nonCirculatingAmt := math.ZeroInt()
+
+	err = k.Balances.Indexes.Denom.Walk(ctx, collections.NewPrefixedPairRange[string, sdk.AccAddress](denom), func(_ string, addr sdk.AccAddress) (bool, error) {
+		balance, err := k.Balances.Get(ctx, collections.Join(addr, denom))
+		if err != nil {
+			return true, err
+		}
+
+		excluded, err := k.BaseViewKeeper.NonCirculatingAddresses.Has(ctx, addr)
+		if err != nil {
+			return true, err
+		}
+
+		acc := k.ak.GetAccount(ctx, addr)
+		switch acc := acc.(type) {
+		case sdk.ModuleAccountI:
+			excluded = true
+		case types.VestingAccount:
+			if !excluded {
+				nonCirculatingAmt = nonCirculatingAmt.Add(math.MinInt(balance, acc.LockedCoins(blockTime).AmountOf(denom)))
+			}
+		}
+
+		if excluded {
+			nonCirculatingAmt = nonCirculatingAmt.Add(balance)
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	total := k.GetSupply(ctx, denom)
+	circulatingAmt := total.Amount.Sub(nonCirculatingAmt)
+	if circulatingAmt.IsNegative() {
+		circulatingAmt = math.ZeroInt()
+	}
+
+	return sdk.NewCoin(denom, circulatingAmt), sdk.NewCoin(denom, nonCirculatingAmt), nil
+}
@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// recordAccountActivity records the current height as the latest balance
+// change of the given account, if dust sweeping is enabled.
+func (k BaseSendKeeper) recordAccountActivity(ctx context.Context, addr sdk.AccAddress) error {
+	if !k.GetParams(ctx).IsDustSweepEnabled() {
+		return nil
+	}
+
+	return k.AccountActivities.Set(ctx, addr, k.environment.HeaderService.GetHeaderInfo(ctx).Height)
+}
+
+// SweepDust sweeps the dust balances of the given accounts to the community
+// pool. Accounts which are excluded, which are module accounts, which had a
+// balance change within the last DustInactivityBlocks blocks or which hold no
+// spendable dust are skipped. It returns the addresses of the swept accounts
+// along with the total amount swept.
+func (k BaseKeeper) SweepDust(ctx context.Context, sender string, addresses []string) ([]string, sdk.Coins, error) {
+	params := k.GetParams(ctx)
+	if !params.IsDustSweepEnabled() {
+		return nil, nil, types.ErrDustSweepDisabled
+	}
+
+	if len(addresses) > types.MaxDustSweepAddresses {
+		return nil, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cannot sweep more than %d accounts at once", types.MaxDustSweepAddresses)
+	}
+
+	if k.ak.GetModuleAddress(types.ProtocolPoolModuleName) == nil {
+		return nil, nil, errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", types.ProtocolPoolModuleName)
+	}
+
+	excluded := make(map[string]bool, len(params.DustSweepExcludedAddresses))
+	for _, addr := range params.DustSweepExcludedAddresses {
+		excluded[addr] = true
+	}
+
+	var (
+		swept []string
+		total sdk.Coins
+	)
+	seen := make(map[string]bool, len(addresses))
+	for _, addrStr := range addresses {
+		addr, err := k.ak.AddressCodec().StringToBytes(addrStr)
+		if err != nil {
+			return nil, nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid address %s: %s", addrStr, err)
+		}
+		// use the canonical form of the address
+		if addrStr, err = k.ak.AddressCodec().BytesToString(addr); err != nil {
+			return nil, nil, err
+		}
+
+		if seen[addrStr] {
+			return nil, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate address %s", addrStr)
+		}
+		seen[addrStr] = true
+
+		if excluded[addrStr] || k.BlockedAddr(addr) {
+			continue
+		}
+		if _, ok := k.ak.GetAccount(ctx, addr).(sdk.ModuleAccountI); ok {
+			continue
+		}
+
+		lastActivity, err := k.AccountActivities.Get(ctx, addr)
+		tracked := err == nil
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return nil, nil, err
+		}
+
+		dust := k.sweepableDust(ctx, params, addr, lastActivity)
+		if dust.Empty() {
+			continue
+		}
+
+		if err := k.SendCoinsFromAccountToModule(ctx, addr, types.ProtocolPoolModuleName, dust); err != nil {
+			return nil, nil, err
+		}
+
+		// sweeping is not an activity of the account, so its latest activity
+		// is restored, or dropped if the account is left with no balance
+		if tracked && !k.GetAllBalances(ctx, addr).IsZero() {
+			err = k.AccountActivities.Set(ctx, addr, lastActivity)
+		} else {
+			err = k.AccountActivities.Remove(ctx, addr)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if err := k.environment.EventService.EventManager(ctx).Emit(&types.EventSweepDust{
+			Address: addrStr,
+			Amount:  dust,
+			Sender:  sender,
+		}); err != nil {
+			return nil, nil, err
+		}
+
+		swept = append(swept, addrStr)
+		total = total.Add(dust...)
+	}
+
+	return swept, total, nil
+}
+
+// sweepableDust returns the spendable dust balances of an account, or nothing
+// if the account had a balance change within the last DustInactivityBlocks
+// blocks. Accounts with no recorded activity are considered inactive since
+// genesis.
+func (k BaseKeeper) sweepableDust(ctx context.Context, params types.Params, addr sdk.AccAddress, lastActivity int64) sdk.Coins {
+	height := k.environment.HeaderService.GetHeaderInfo(ctx).Height
+	if height-lastActivity < int64(params.DustInactivityBlocks) {
+		return nil
+	}
+
+	var dust sdk.Coins
+	for _, threshold := range params.DustThresholds {
+		balance := k.GetBalance(ctx, addr, threshold.Denom)
+		if balance.IsZero() || !balance.Amount.LT(threshold.Amount) {
+			continue
+		}
+
+		// locked or held dust is left untouched
+		if !k.SpendableCoin(ctx, addr, threshold.Denom).Amount.Equal(balance.Amount) {
+			continue
+		}
+
+		dust = dust.Add(balance)
+	}
+
+	return dust
+}
+
+// SetAccountActivity sets the latest balance change of an account.
+func (k BaseKeeper) SetAccountActivity(ctx context.Context, activity types.AccountActivity) error {
+	if err := activity.Validate(); err != nil {
+		return err
+	}
+
+	addr, err := k.ak.AddressCodec().StringToBytes(activity.Address)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid account activity address: %s", err)
+	}
+
+	return k.AccountActivities.Set(ctx, addr, activity.Height)
+}
+
+// GetAllAccountActivities returns the latest balance change of all the
+// accounts tracked for dust sweeping.
+func (k BaseKeeper) GetAllAccountActivities(ctx context.Context) ([]types.AccountActivity, error) {
+	var activities []types.AccountActivity
+	err := k.AccountActivities.Walk(ctx, nil, func(addr sdk.AccAddress, height int64) (bool, error) {
+		addrStr, err := k.ak.AddressCodec().BytesToString(addr)
+		if err != nil {
+			return true, err
+		}
+		activities = append(activities, types.AccountActivity{Address: addrStr, Height: height})
+		return false, nil
+	})
+
+	return activities, err
+}
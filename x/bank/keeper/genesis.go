@@ -53,6 +53,73 @@ func (k BaseKeeper) InitGenesis(ctx context.Context, genState *types.GenesisStat
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
+
+	// The balance alerts are validated by ValidateGenesis. They are set without
+	// checking that they are registered on module accounts, which may only be
+	// created after the bank genesis.
+	for _, alert := range genState.BalanceAlerts {
+		addr, err := k.ak.AddressCodec().StringToBytes(alert.Address)
+		if err != nil {
+			panic(err)
+		}
+		if err := k.BaseSendKeeper.BalanceAlerts.Set(ctx, collections.Join(sdk.AccAddress(addr), alert.Denom), alert.Threshold); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, factoryDenom := range genState.FactoryDenoms {
+		if err := k.SetFactoryDenom(ctx, factoryDenom); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, policy := range genState.SendRestrictionPolicies {
+		if err := k.SetSendRestrictionPolicy(ctx, policy); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, entry := range genState.BalanceJournal {
+		if err := k.SetBalanceJournalEntry(ctx, entry); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, addr := range genState.NonCirculatingAddresses {
+		if err := k.SetNonCirculatingAddress(ctx, addr); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, hold := range genState.Holds {
+		if err := k.SetHold(ctx, hold); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, hook := range genState.DenomTransferHooks {
+		if err := k.SetDenomTransferHook(ctx, hook); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, activity := range genState.AccountActivities {
+		if err := k.SetAccountActivity(ctx, activity); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, limit := range genState.SpendingLimits {
+		if err := k.SetSpendingLimit(ctx, limit); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, fee := range genState.DenomTransferFees {
+		if err := k.SetDenomTransferFee(ctx, fee); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -69,5 +136,56 @@ func (k BaseKeeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		k.GetAllDenomMetaData(ctx),
 		k.GetAllSendEnabledEntries(ctx),
 	)
+
+	rv.BalanceAlerts, err = k.GetAllBalanceAlerts(ctx)
+	if err != nil {
+		panic(fmt.Errorf("unable to fetch balance alerts %v", err))
+	}
+
+	rv.FactoryDenoms, err = k.GetAllFactoryDenoms(ctx)
+	if err != nil {
+		panic(fmt.Errorf("unable to fetch factory denoms %v", err))
+	}
+
+	rv.SendRestrictionPolicies, err = k.GetAllSendRestrictionPolicies(ctx)
+	if err != nil {
+		panic(fmt.Errorf("unable to fetch send restriction policies %v", err))
+	}
+
+	rv.BalanceJournal, err = k.GetAllBalanceJournalEntries(ctx)
+	if err != nil {
+		panic(fmt.Errorf("unable to fetch balance journal %v", err))
+	}
+
+	rv.NonCirculatingAddresses, err = k.GetAllNonCirculatingAddresses(ctx)
+	if err != nil {
+		panic(fmt.Errorf("unable to fetch non-circulating addresses %v", err))
+	}
+
+	rv.Holds, err = k.GetAllHolds(ctx)
+	if err != nil {
+		panic(fmt.Errorf("unable to fetch holds %v", err))
+	}
+
+	rv.DenomTransferHooks, err = k.GetAllDenomTransferHooks(ctx)
+	if err != nil {
+		panic(fmt.Errorf("unable to fetch denom transfer hooks %v", err))
+	}
+
+	rv.AccountActivities, err = k.GetAllAccountActivities(ctx)
+	if err != nil {
+		panic(fmt.Errorf("unable to fetch account activities %v", err))
+	}
+
+	rv.SpendingLimits, err = k.GetAllSpendingLimits(ctx)
+	if err != nil {
+		panic(fmt.Errorf("unable to fetch spending limits %v", err))
+	}
+
+	rv.DenomTransferFees, err = k.GetAllDenomTransferFees(ctx)
+	if err != nil {
+		panic(fmt.Errorf("unable to fetch denom transfer fees %v", err))
+	}
+
 	return rv
 }
@@ -74,6 +74,139 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Require().Equal(m, m2)
 }
 
+func (suite *KeeperTestSuite) TestBalanceAlertsGenesis() {
+	bk := suite.bankKeeper
+	// the alerts are registered even if the module accounts are only created
+	// after the bank genesis
+	alerts := []types.BalanceAlert{
+		types.NewBalanceAlert(mintAcc.GetAddress().String(), "uatom", sdkmath.NewInt(100)),
+	}
+
+	g := types.DefaultGenesisState()
+	g.BalanceAlerts = alerts
+	bk.InitGenesis(suite.ctx, g)
+
+	suite.Require().Equal(alerts, bk.ExportGenesis(suite.ctx).BalanceAlerts)
+}
+
+func (suite *KeeperTestSuite) TestFactoryDenomsGenesis() {
+	bk := suite.bankKeeper
+	factoryDenoms := []types.FactoryDenom{
+		{Denom: "factory/" + accAddrs[0].String() + "/a", Admin: accAddrs[1].String()},
+		{Denom: "factory/" + accAddrs[0].String() + "/b"},
+	}
+
+	g := types.DefaultGenesisState()
+	g.FactoryDenoms = factoryDenoms
+	bk.InitGenesis(suite.ctx, g)
+
+	factoryDenom, err := bk.GetFactoryDenom(suite.ctx, factoryDenoms[0].Denom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(factoryDenoms[0], factoryDenom)
+	suite.Require().ElementsMatch(factoryDenoms, bk.ExportGenesis(suite.ctx).FactoryDenoms)
+}
+
+func (suite *KeeperTestSuite) TestSendRestrictionPoliciesGenesis() {
+	bk := suite.bankKeeper
+	policies := []types.SendRestrictionPolicy{
+		{
+			Name:            "blocklist",
+			RestrictionType: types.SEND_RESTRICTION_TYPE_ADDRESS_BLOCKLIST,
+			Enabled:         true,
+			Addresses:       []string{accAddrs[0].String()},
+		},
+		{
+			Name:            "freeze",
+			RestrictionType: types.SEND_RESTRICTION_TYPE_DENOM_FREEZE,
+			Denoms:          []string{"uatom"},
+		},
+	}
+
+	g := types.DefaultGenesisState()
+	g.SendRestrictionPolicies = policies
+	bk.InitGenesis(suite.ctx, g)
+
+	policy, err := bk.GetSendRestrictionPolicy(suite.ctx, policies[1].Name)
+	suite.Require().NoError(err)
+	suite.Require().Equal(policies[1], policy)
+	suite.Require().Equal(policies, bk.ExportGenesis(suite.ctx).SendRestrictionPolicies)
+}
+
+func (suite *KeeperTestSuite) TestBalanceJournalGenesis() {
+	bk := suite.bankKeeper
+	entries := []types.BalanceJournalEntry{
+		{Address: accAddrs[0].String(), Height: 3, Denom: "uatom", Delta: sdkmath.NewInt(10), Reason: types.BalanceChangeReasonSend},
+		{Address: accAddrs[0].String(), Height: 4, Denom: "uatom", Delta: sdkmath.NewInt(-5), Reason: types.BalanceChangeReasonBurn},
+		{Address: accAddrs[1].String(), Height: 4, Denom: "uatom", Delta: sdkmath.NewInt(7), Reason: types.BalanceChangeReasonMint},
+	}
+
+	g := types.DefaultGenesisState()
+	g.Params.BalanceJournalAddresses = []string{accAddrs[0].String(), accAddrs[1].String()}
+	g.Params.BalanceJournalRetention = 100
+	g.BalanceJournal = entries
+	bk.InitGenesis(suite.ctx, g)
+
+	exported := bk.ExportGenesis(suite.ctx)
+	suite.Require().Equal(g.Params, exported.Params)
+	suite.Require().Equal(entries, exported.BalanceJournal)
+}
+
+func (suite *KeeperTestSuite) TestNonCirculatingAddressesGenesis() {
+	bk := suite.bankKeeper
+	addresses := []string{accAddrs[0].String(), accAddrs[1].String()}
+
+	g := types.DefaultGenesisState()
+	g.NonCirculatingAddresses = addresses
+	bk.InitGenesis(suite.ctx, g)
+
+	suite.Require().ElementsMatch(addresses, bk.ExportGenesis(suite.ctx).NonCirculatingAddresses)
+}
+
+func (suite *KeeperTestSuite) TestHoldsGenesis() {
+	bk := suite.bankKeeper
+	holds := []types.Hold{
+		{Address: accAddrs[0].String(), Holder: "escrow", Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 10))},
+		{Address: accAddrs[0].String(), Holder: "market", Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 5))},
+	}
+
+	g := types.DefaultGenesisState()
+	g.Holds = holds
+	bk.InitGenesis(suite.ctx, g)
+
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uatom", 15)), bk.GetHeldCoins(suite.ctx, accAddrs[0]))
+	suite.Require().Equal(holds, bk.ExportGenesis(suite.ctx).Holds)
+}
+
+func (suite *KeeperTestSuite) TestDenomTransferHooksGenesis() {
+	bk := suite.bankKeeper
+	hooks := []types.DenomTransferHook{
+		{Denom: "uatom", Target: accAddrs[0].String(), BeforeTransfer: true, GasLimit: 50_000},
+		{Denom: "uosmo", Target: accAddrs[1].String(), AfterTransfer: true, GasLimit: 100_000},
+	}
+
+	bk.SetTransferHooks(types.NewTransferHooksRouter(&transferHooks{}))
+
+	g := types.DefaultGenesisState()
+	g.DenomTransferHooks = hooks
+	bk.InitGenesis(suite.ctx, g)
+
+	suite.Require().Equal(hooks, bk.ExportGenesis(suite.ctx).DenomTransferHooks)
+}
+
+func (suite *KeeperTestSuite) TestDenomTransferFeesGenesis() {
+	bk := suite.bankKeeper
+	fees := []types.DenomTransferFee{
+		{Denom: "uatom", Rate: sdkmath.LegacyNewDecWithPrec(1, 2), Collector: accAddrs[0].String()},
+		{Denom: "uosmo", Rate: sdkmath.LegacyNewDecWithPrec(5, 3), Collector: accAddrs[1].String(), ExemptAddresses: []string{accAddrs[2].String()}},
+	}
+
+	g := types.DefaultGenesisState()
+	g.DenomTransferFees = fees
+	bk.InitGenesis(suite.ctx, g)
+
+	suite.Require().Equal(fees, bk.ExportGenesis(suite.ctx).DenomTransferFees)
+}
+
 func (suite *KeeperTestSuite) TestTotalSupply() {
 	// Prepare some test data.
 	defaultGenesis := types.DefaultGenesisState()
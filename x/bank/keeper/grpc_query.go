@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -79,6 +80,52 @@ func (k BaseKeeper) AllBalances(ctx context.Context, req *types.QueryAllBalances
 	return &types.QueryAllBalancesResponse{Balances: balances, Pagination: pageRes}, nil
 }
 
+// BalancesBatch implements the Query/BalancesBatch gRPC method
+func (k BaseKeeper) BalancesBatch(ctx context.Context, req *types.QueryBalancesBatchRequest) (*types.QueryBalancesBatchResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.Addresses) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "addresses cannot be empty")
+	}
+
+	if len(req.Addresses) > types.MaxBalancesBatchAddresses {
+		return nil, status.Errorf(codes.InvalidArgument, "too many addresses: %d > %d", len(req.Addresses), types.MaxBalancesBatchAddresses)
+	}
+
+	if req.Denom != "" {
+		if err := sdk.ValidateDenom(req.Denom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	seen := make(map[string]bool, len(req.Addresses))
+	balances := make([]types.Balance, len(req.Addresses))
+	for i, address := range req.Addresses {
+		if seen[address] {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate address %s", address)
+		}
+		seen[address] = true
+
+		addr, err := k.ak.AddressCodec().StringToBytes(address)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address %s: %s", address, err.Error())
+		}
+
+		coins := sdk.NewCoins()
+		if req.Denom != "" {
+			coins = coins.Add(k.GetBalance(ctx, addr, req.Denom))
+		} else {
+			coins = k.GetAllBalances(ctx, addr)
+		}
+
+		balances[i] = types.Balance{Address: address, Coins: coins}
+	}
+
+	return &types.QueryBalancesBatchResponse{Balances: balances}, nil
+}
+
 // SpendableBalances implements a gRPC query handler for retrieving an account's
 // spendable balances.
 func (k BaseKeeper) SpendableBalances(ctx context.Context, req *types.QuerySpendableBalancesRequest) (*types.QuerySpendableBalancesResponse, error) {
@@ -131,6 +178,60 @@ func (k BaseKeeper) SpendableBalanceByDenom(ctx context.Context, req *types.Quer
 	return &types.QuerySpendableBalanceByDenomResponse{Balance: &spendable}, nil
 }
 
+// Holds implements the Query/Holds gRPC method
+func (k BaseKeeper) Holds(ctx context.Context, req *types.QueryHoldsRequest) (*types.QueryHoldsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := k.ak.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	holds, pageRes, err := query.CollectionPaginate(ctx, k.BaseViewKeeper.Holds, req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, string], hold types.Hold) (types.Hold, error) {
+			return hold, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, string](addr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryHoldsResponse{Holds: holds, Pagination: pageRes}, nil
+}
+
+// SpendingLimits implements the Query/SpendingLimits gRPC method
+func (k BaseKeeper) SpendingLimits(ctx context.Context, req *types.QuerySpendingLimitsRequest) (*types.QuerySpendingLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := k.ak.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	now := k.environment.HeaderService.GetHeaderInfo(ctx).Time
+	limits, pageRes, err := query.CollectionFilteredPaginate(ctx, k.BaseViewKeeper.SpendingLimits, req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, string], limit types.SpendingLimit) (bool, error) {
+			_, ok := limit.Effective(now)
+			return ok, nil
+		},
+		func(_ collections.Pair[sdk.AccAddress, string], limit types.SpendingLimit) (types.SpendingLimit, error) {
+			limit, _ = limit.Effective(now)
+			return limit, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, string](addr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySpendingLimitsResponse{SpendingLimits: limits, Pagination: pageRes}, nil
+}
+
 // TotalSupply implements the Query/TotalSupply gRPC method
 func (k BaseKeeper) TotalSupply(ctx context.Context, req *types.QueryTotalSupplyRequest) (*types.QueryTotalSupplyResponse, error) {
 	totalSupply, pageRes, err := k.GetPaginatedTotalSupply(ctx, req.Pagination)
@@ -156,6 +257,46 @@ func (k BaseKeeper) SupplyOf(ctx context.Context, req *types.QuerySupplyOfReques
 	return &types.QuerySupplyOfResponse{Amount: sdk.NewCoin(req.Denom, supply.Amount)}, nil
 }
 
+// CirculatingSupply implements the Query/CirculatingSupply gRPC method
+func (k BaseKeeper) CirculatingSupply(ctx context.Context, req *types.QueryCirculatingSupplyRequest) (*types.QueryCirculatingSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	circulating, nonCirculating, err := k.GetCirculatingSupply(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCirculatingSupplyResponse{
+		Circulating:    circulating,
+		NonCirculating: nonCirculating,
+		Total:          k.GetSupply(ctx, req.Denom),
+	}, nil
+}
+
+// NonCirculatingAddresses implements the Query/NonCirculatingAddresses gRPC method
+func (k BaseKeeper) NonCirculatingAddresses(ctx context.Context, req *types.QueryNonCirculatingAddressesRequest) (*types.QueryNonCirculatingAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addresses, pageRes, err := query.CollectionPaginate(ctx, k.BaseViewKeeper.NonCirculatingAddresses, req.Pagination,
+		func(addr sdk.AccAddress, _ collections.NoValue) (string, error) {
+			return k.ak.AddressCodec().BytesToString(addr)
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryNonCirculatingAddressesResponse{Addresses: addresses, Pagination: pageRes}, nil
+}
+
 // Params implements the gRPC service handler for querying x/bank parameters.
 func (k BaseKeeper) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	if req == nil {
@@ -332,6 +473,219 @@ func (k BaseKeeper) SendEnabled(ctx context.Context, req *types.QuerySendEnabled
 	return resp, nil
 }
 
+// BalanceAlerts implements the Query/BalanceAlerts gRPC method
+func (k BaseKeeper) BalanceAlerts(ctx context.Context, req *types.QueryBalanceAlertsRequest) (*types.QueryBalanceAlertsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	alerts, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.BaseViewKeeper.BalanceAlerts,
+		req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, string], threshold math.Int) (types.BalanceAlert, error) {
+			addr, err := k.ak.AddressCodec().BytesToString(key.K1())
+			if err != nil {
+				return types.BalanceAlert{}, err
+			}
+			return types.NewBalanceAlert(addr, key.K2(), threshold), nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBalanceAlertsResponse{BalanceAlerts: alerts, Pagination: pageRes}, nil
+}
+
+// FactoryDenom implements the Query/FactoryDenom gRPC method
+func (k BaseKeeper) FactoryDenom(ctx context.Context, req *types.QueryFactoryDenomRequest) (*types.QueryFactoryDenomResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	factoryDenom, err := k.GetFactoryDenom(ctx, req.Denom)
+	if err != nil {
+		if errors.Is(err, types.ErrFactoryDenomNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryFactoryDenomResponse{FactoryDenom: factoryDenom}, nil
+}
+
+// FactoryDenomsFromCreator implements the Query/FactoryDenomsFromCreator gRPC method
+func (k BaseKeeper) FactoryDenomsFromCreator(ctx context.Context, req *types.QueryFactoryDenomsFromCreatorRequest) (*types.QueryFactoryDenomsFromCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := k.ak.AddressCodec().StringToBytes(req.Creator); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid creator address: %s", err)
+	}
+
+	denoms, pageRes, err := k.GetFactoryDenomsFromCreator(ctx, req.Creator, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFactoryDenomsFromCreatorResponse{Denoms: denoms, Pagination: pageRes}, nil
+}
+
+// SendRestrictionPolicy implements the Query/SendRestrictionPolicy gRPC method
+func (k BaseKeeper) SendRestrictionPolicy(ctx context.Context, req *types.QuerySendRestrictionPolicyRequest) (*types.QuerySendRestrictionPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty policy name")
+	}
+
+	policy, err := k.GetSendRestrictionPolicy(ctx, req.Name)
+	if err != nil {
+		if errors.Is(err, types.ErrSendRestrictionPolicyNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySendRestrictionPolicyResponse{Policy: policy}, nil
+}
+
+// SendRestrictionPolicies implements the Query/SendRestrictionPolicies gRPC method
+func (k BaseKeeper) SendRestrictionPolicies(ctx context.Context, req *types.QuerySendRestrictionPoliciesRequest) (*types.QuerySendRestrictionPoliciesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	policies, pageRes, err := query.CollectionFilteredPaginate(ctx, k.BaseViewKeeper.SendRestrictionPolicies, req.Pagination,
+		func(_ string, value types.SendRestrictionPolicy) (bool, error) {
+			return !req.ActiveOnly || value.Enabled, nil
+		},
+		func(_ string, value types.SendRestrictionPolicy) (types.SendRestrictionPolicy, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySendRestrictionPoliciesResponse{Policies: policies, Pagination: pageRes}, nil
+}
+
+// DenomTransferHook implements the Query/DenomTransferHook gRPC method
+func (k BaseKeeper) DenomTransferHook(ctx context.Context, req *types.QueryDenomTransferHookRequest) (*types.QueryDenomTransferHookResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	hook, err := k.GetDenomTransferHook(ctx, req.Denom)
+	if err != nil {
+		if errors.Is(err, types.ErrDenomTransferHookNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomTransferHookResponse{Hook: hook}, nil
+}
+
+// DenomTransferHooks implements the Query/DenomTransferHooks gRPC method
+func (k BaseKeeper) DenomTransferHooks(ctx context.Context, req *types.QueryDenomTransferHooksRequest) (*types.QueryDenomTransferHooksResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	hooks, pageRes, err := query.CollectionPaginate(ctx, k.BaseViewKeeper.DenomTransferHooks, req.Pagination,
+		func(_ string, value types.DenomTransferHook) (types.DenomTransferHook, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomTransferHooksResponse{Hooks: hooks, Pagination: pageRes}, nil
+}
+
+// DenomTransferFee implements the Query/DenomTransferFee gRPC method
+func (k BaseKeeper) DenomTransferFee(ctx context.Context, req *types.QueryDenomTransferFeeRequest) (*types.QueryDenomTransferFeeResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	fee, err := k.GetDenomTransferFee(ctx, req.Denom)
+	if err != nil {
+		if errors.Is(err, types.ErrDenomTransferFeeNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomTransferFeeResponse{Fee: fee}, nil
+}
+
+// DenomTransferFees implements the Query/DenomTransferFees gRPC method
+func (k BaseKeeper) DenomTransferFees(ctx context.Context, req *types.QueryDenomTransferFeesRequest) (*types.QueryDenomTransferFeesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	fees, pageRes, err := query.CollectionPaginate(ctx, k.BaseViewKeeper.DenomTransferFees, req.Pagination,
+		func(_ string, value types.DenomTransferFee) (types.DenomTransferFee, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomTransferFeesResponse{Fees: fees, Pagination: pageRes}, nil
+}
+
+// BalanceHistory implements the Query/BalanceHistory gRPC method
+func (k BaseKeeper) BalanceHistory(ctx context.Context, req *types.QueryBalanceHistoryRequest) (*types.QueryBalanceHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := k.ak.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	if req.Denom != "" {
+		if err := sdk.ValidateDenom(req.Denom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	entries, pageRes, err := query.CollectionFilteredPaginate(ctx, k.BalanceJournal, req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, uint64], value types.BalanceJournalEntry) (bool, error) {
+			return req.Denom == "" || value.Denom == req.Denom, nil
+		},
+		func(_ collections.Pair[sdk.AccAddress, uint64], value types.BalanceJournalEntry) (types.BalanceJournalEntry, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](addr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBalanceHistoryResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
 // DenomOwnersByQuery is identical to DenomOwner query, but receives denom values via query string.
 func (k BaseKeeper) DenomOwnersByQuery(ctx context.Context, req *types.QueryDenomOwnersByQueryRequest) (*types.QueryDenomOwnersByQueryResponse, error) {
 	if req == nil {
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
 	authtypes "cosmossdk.io/x/auth/types"
 	vestingtypes "cosmossdk.io/x/auth/vesting/types"
 	"cosmossdk.io/x/bank/testutil"
@@ -171,6 +172,86 @@ func (suite *KeeperTestSuite) TestQueryAllBalances() {
 	suite.Nil(res.Pagination.NextKey)
 }
 
+func (suite *KeeperTestSuite) TestQueryBalancesBatch() {
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	suite.mockFundAccount(accAddrs[0])
+	suite.Require().NoError(testutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(50), newBarCoin(30))))
+
+	_, err := queryClient.BalancesBatch(gocontext.Background(), &types.QueryBalancesBatchRequest{})
+	suite.Require().ErrorContains(err, "addresses cannot be empty")
+
+	tooMany := make([]sdk.AccAddress, types.MaxBalancesBatchAddresses+1)
+	for i := range tooMany {
+		tooMany[i] = sdk.AccAddress(fmt.Sprintf("addr%d", i))
+	}
+	_, err = queryClient.BalancesBatch(gocontext.Background(), types.NewQueryBalancesBatchRequest(tooMany, ""))
+	suite.Require().ErrorContains(err, "too many addresses")
+
+	_, err = queryClient.BalancesBatch(gocontext.Background(), types.NewQueryBalancesBatchRequest([]sdk.AccAddress{accAddrs[0], accAddrs[0]}, ""))
+	suite.Require().ErrorContains(err, "duplicate address")
+
+	_, err = queryClient.BalancesBatch(gocontext.Background(), &types.QueryBalancesBatchRequest{Addresses: []string{"invalid"}})
+	suite.Require().ErrorContains(err, "invalid address")
+
+	res, err := queryClient.BalancesBatch(gocontext.Background(), types.NewQueryBalancesBatchRequest([]sdk.AccAddress{accAddrs[1], accAddrs[0]}, ""))
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Balances, 2)
+	suite.Require().Equal(accAddrs[1].String(), res.Balances[0].Address)
+	suite.Require().True(res.Balances[0].Coins.Empty())
+	suite.Require().Equal(accAddrs[0].String(), res.Balances[1].Address)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(50), newBarCoin(30)), res.Balances[1].Coins)
+
+	res, err = queryClient.BalancesBatch(gocontext.Background(), types.NewQueryBalancesBatchRequest([]sdk.AccAddress{accAddrs[0]}, fooDenom))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(50)), res.Balances[0].Coins)
+}
+
+func (suite *KeeperTestSuite) TestQueryCirculatingSupply() {
+	_, _, vestingAddr := testdata.KeyTestPubAddr()
+
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+	queryClient := suite.mockQueryClient(ctx)
+
+	_, err := queryClient.CirculatingSupply(ctx, &types.QueryCirculatingSupplyRequest{})
+	suite.Require().ErrorContains(err, "invalid denom")
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	acc1 := authtypes.NewBaseAccountWithAddress(accAddrs[1])
+	vacc, err := vestingtypes.NewContinuousVestingAccount(
+		authtypes.NewBaseAccountWithAddress(vestingAddr),
+		sdk.NewCoins(newFooCoin(40)),
+		ctx.HeaderInfo().Time.Unix(),
+		ctx.HeaderInfo().Time.Add(time.Hour).Unix(),
+	)
+	suite.Require().NoError(err)
+
+	suite.mockFundAccount(accAddrs[0])
+	suite.Require().NoError(testutil.FundAccount(suite.ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+	suite.mockFundAccount(accAddrs[1])
+	suite.Require().NoError(testutil.FundAccount(suite.ctx, suite.bankKeeper, accAddrs[1], sdk.NewCoins(newFooCoin(50))))
+	suite.mockFundAccount(vestingAddr)
+	suite.Require().NoError(testutil.FundAccount(suite.ctx, suite.bankKeeper, vestingAddr, sdk.NewCoins(newFooCoin(40))))
+	suite.mockMintCoins(mintAcc)
+	suite.Require().NoError(suite.bankKeeper.MintCoins(suite.ctx, types.MintModuleName, sdk.NewCoins(newFooCoin(25))))
+
+	suite.Require().NoError(suite.bankKeeper.SetNonCirculatingAddress(suite.ctx, accAddrs[1].String()))
+
+	for _, acc := range []sdk.AccountI{acc0, acc1, vacc, mintAcc} {
+		suite.mockSpendableCoins(ctx, acc)
+	}
+	res, err := queryClient.CirculatingSupply(ctx, &types.QueryCirculatingSupplyRequest{Denom: fooDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(newFooCoin(215), res.Total)
+	suite.Require().Equal(newFooCoin(115), res.NonCirculating)
+	suite.Require().Equal(newFooCoin(100), res.Circulating)
+
+	addrsRes, err := queryClient.NonCirculatingAddresses(ctx, &types.QueryNonCirculatingAddressesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{accAddrs[1].String()}, addrsRes.Addresses)
+}
+
 func (suite *KeeperTestSuite) TestSpendableBalances() {
 	_, _, addr := testdata.KeyTestPubAddr()
 
@@ -917,3 +998,101 @@ func (suite *KeeperTestSuite) TestGRPCDenomOwnersByQuery() {
 
 	suite.Require().True(true)
 }
+
+func (suite *KeeperTestSuite) TestQueryFactoryDenoms() {
+	ctx, queryClient := suite.ctx, suite.queryClient
+	creator := accAddrs[0]
+
+	denomA, err := suite.bankKeeper.CreateFactoryDenom(ctx, creator.String(), "a")
+	suite.Require().NoError(err)
+	denomB, err := suite.bankKeeper.CreateFactoryDenom(ctx, creator.String(), "b")
+	suite.Require().NoError(err)
+	_, err = suite.bankKeeper.CreateFactoryDenom(ctx, accAddrs[1].String(), "a")
+	suite.Require().NoError(err)
+
+	_, err = queryClient.FactoryDenom(gocontext.Background(), &types.QueryFactoryDenomRequest{Denom: "stake"})
+	suite.Require().ErrorContains(err, "invalid factory denom")
+	_, err = queryClient.FactoryDenom(gocontext.Background(), &types.QueryFactoryDenomRequest{Denom: "factory/" + creator.String() + "/c"})
+	suite.Require().ErrorContains(err, "factory denom not found")
+
+	res, err := queryClient.FactoryDenom(gocontext.Background(), &types.QueryFactoryDenomRequest{Denom: denomA})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.FactoryDenom{Denom: denomA, Admin: creator.String()}, res.FactoryDenom)
+
+	_, err = queryClient.FactoryDenomsFromCreator(gocontext.Background(), &types.QueryFactoryDenomsFromCreatorRequest{Creator: "invalid"})
+	suite.Require().Error(err)
+
+	denomsRes, err := queryClient.FactoryDenomsFromCreator(gocontext.Background(), &types.QueryFactoryDenomsFromCreatorRequest{Creator: creator.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{denomA, denomB}, denomsRes.Denoms)
+	suite.Require().Equal(uint64(2), denomsRes.Pagination.Total)
+
+	denomsRes, err = queryClient.FactoryDenomsFromCreator(gocontext.Background(), &types.QueryFactoryDenomsFromCreatorRequest{Creator: creator.String(), Pagination: &query.PageRequest{Limit: 1}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{denomA}, denomsRes.Denoms)
+	suite.Require().NotNil(denomsRes.Pagination.NextKey)
+}
+
+func (suite *KeeperTestSuite) TestQuerySendRestrictionPolicies() {
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	blocklist := types.SendRestrictionPolicy{
+		Name:            "blocklist",
+		RestrictionType: types.SEND_RESTRICTION_TYPE_ADDRESS_BLOCKLIST,
+		Enabled:         true,
+		Addresses:       []string{accAddrs[0].String()},
+	}
+	pause := types.SendRestrictionPolicy{
+		Name:            "pause",
+		RestrictionType: types.SEND_RESTRICTION_TYPE_TRANSFER_PAUSE,
+		Denoms:          []string{fooDenom},
+	}
+	suite.Require().NoError(suite.bankKeeper.SetSendRestrictionPolicy(ctx, blocklist))
+	suite.Require().NoError(suite.bankKeeper.SetSendRestrictionPolicy(ctx, pause))
+
+	_, err := queryClient.SendRestrictionPolicy(gocontext.Background(), &types.QuerySendRestrictionPolicyRequest{})
+	suite.Require().ErrorContains(err, "empty policy name")
+	_, err = queryClient.SendRestrictionPolicy(gocontext.Background(), &types.QuerySendRestrictionPolicyRequest{Name: "freeze"})
+	suite.Require().ErrorContains(err, "send restriction policy not found")
+
+	res, err := queryClient.SendRestrictionPolicy(gocontext.Background(), &types.QuerySendRestrictionPolicyRequest{Name: blocklist.Name})
+	suite.Require().NoError(err)
+	suite.Require().Equal(blocklist, res.Policy)
+
+	policiesRes, err := queryClient.SendRestrictionPolicies(gocontext.Background(), &types.QuerySendRestrictionPoliciesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SendRestrictionPolicy{blocklist, pause}, policiesRes.Policies)
+
+	policiesRes, err = queryClient.SendRestrictionPolicies(gocontext.Background(), &types.QuerySendRestrictionPoliciesRequest{ActiveOnly: true})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SendRestrictionPolicy{blocklist}, policiesRes.Policies)
+}
+
+func (suite *KeeperTestSuite) TestQueryBalanceHistory() {
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	params := types.DefaultParams()
+	params.BalanceJournalAddresses = []string{accAddrs[0].String()}
+	suite.Require().NoError(suite.bankKeeper.SetParams(ctx, params))
+	suite.mockFundAccount(accAddrs[0])
+	suite.Require().NoError(testutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(50))))
+
+	_, err := queryClient.BalanceHistory(gocontext.Background(), &types.QueryBalanceHistoryRequest{})
+	suite.Require().ErrorContains(err, "invalid address")
+	_, err = queryClient.BalanceHistory(gocontext.Background(), &types.QueryBalanceHistoryRequest{Address: accAddrs[0].String(), Denom: "1"})
+	suite.Require().Error(err)
+
+	res, err := queryClient.BalanceHistory(gocontext.Background(), &types.QueryBalanceHistoryRequest{Address: accAddrs[0].String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Entries, 2)
+
+	res, err = queryClient.BalanceHistory(gocontext.Background(), &types.QueryBalanceHistoryRequest{Address: accAddrs[0].String(), Denom: fooDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.BalanceJournalEntry{
+		{Address: accAddrs[0].String(), Denom: fooDenom, Delta: math.NewInt(100), Reason: types.BalanceChangeReasonSend},
+	}, res.Entries)
+
+	res, err = queryClient.BalanceHistory(gocontext.Background(), &types.QueryBalanceHistoryRequest{Address: accAddrs[1].String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Entries)
+}
@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// PlaceHold places coins of an account on hold on behalf of the holder module.
+// Held coins stay in the balance of the account but are excluded from its
+// spendable coins until the holder releases them. Successive holds of the same
+// holder on an account add up.
+func (k BaseKeeper) PlaceHold(ctx context.Context, holder string, addr sdk.AccAddress, amt sdk.Coins) error {
+	if strings.TrimSpace(holder) == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("holder cannot be empty")
+	}
+
+	if !amt.IsValid() || amt.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	spendable := k.SpendableCoins(ctx, addr)
+	if !spendable.IsAllGTE(amt) {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "spendable balance %s is smaller than %s", spendable, amt)
+	}
+
+	address, err := k.ak.AddressCodec().BytesToString(addr)
+	if err != nil {
+		return err
+	}
+
+	hold, err := k.BaseViewKeeper.Holds.Get(ctx, collections.Join(addr, holder))
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		hold = types.Hold{Address: address, Holder: holder}
+	case err != nil:
+		return err
+	}
+
+	hold.Amount = hold.Amount.Add(amt...)
+	if err := k.BaseViewKeeper.Holds.Set(ctx, collections.Join(addr, holder), hold); err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).Emit(&types.EventPlaceHold{
+		Address: address,
+		Holder:  holder,
+		Amount:  amt.String(),
+	})
+}
+
+// ReleaseHold releases coins of an account previously placed on hold by the
+// holder module, making them spendable again.
+func (k BaseKeeper) ReleaseHold(ctx context.Context, holder string, addr sdk.AccAddress, amt sdk.Coins) error {
+	if !amt.IsValid() || amt.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
+	}
+
+	hold, err := k.BaseViewKeeper.Holds.Get(ctx, collections.Join(addr, holder))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return errorsmod.Wrapf(types.ErrHoldNotFound, "no hold placed by %s on %s", holder, addr)
+		}
+		return err
+	}
+
+	remaining, hasNeg := hold.Amount.SafeSub(amt...)
+	if hasNeg {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "held amount %s is smaller than %s", hold.Amount, amt)
+	}
+
+	if remaining.IsZero() {
+		err = k.BaseViewKeeper.Holds.Remove(ctx, collections.Join(addr, holder))
+	} else {
+		hold.Amount = remaining
+		err = k.BaseViewKeeper.Holds.Set(ctx, collections.Join(addr, holder), hold)
+	}
+	if err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).Emit(&types.EventReleaseHold{
+		Address: hold.Address,
+		Holder:  holder,
+		Amount:  amt.String(),
+	})
+}
+
+// SetHold sets a hold as is, without checking the balance of the account. It
+// is used to import holds at genesis.
+func (k BaseKeeper) SetHold(ctx context.Context, hold types.Hold) error {
+	if err := hold.Validate(); err != nil {
+		return err
+	}
+
+	addr, err := k.ak.AddressCodec().StringToBytes(hold.Address)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid hold address %s: %s", hold.Address, err)
+	}
+
+	return k.BaseViewKeeper.Holds.Set(ctx, collections.Join(sdk.AccAddress(addr), hold.Holder), hold)
+}
+
+// GetAllHolds returns the holds placed on all accounts.
+func (k BaseKeeper) GetAllHolds(ctx context.Context) ([]types.Hold, error) {
+	var holds []types.Hold
+	err := k.BaseViewKeeper.Holds.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, string], hold types.Hold) (bool, error) {
+		holds = append(holds, hold)
+		return false, nil
+	})
+	return holds, err
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/event"
@@ -50,6 +51,44 @@ type Keeper interface {
 	DelegateCoins(ctx context.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoins(ctx context.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
 
+	CreateFactoryDenom(ctx context.Context, creator, subdenom string) (string, error)
+	GetFactoryDenom(ctx context.Context, denom string) (types.FactoryDenom, error)
+	GetAllFactoryDenoms(ctx context.Context) ([]types.FactoryDenom, error)
+	SetFactoryDenom(ctx context.Context, factoryDenom types.FactoryDenom) error
+	FactoryMint(ctx context.Context, admin string, amount sdk.Coin, mintTo string) error
+	FactoryBurn(ctx context.Context, admin string, amount sdk.Coin, burnFrom string) error
+	ForceTransfer(ctx context.Context, admin string, amount sdk.Coin, from, to string) error
+	ChangeFactoryDenomAdmin(ctx context.Context, admin, denom, newAdmin string) error
+	SetFactoryDenomMetadata(ctx context.Context, admin string, metadata types.Metadata) error
+
+	RegisterDenomMetadata(ctx context.Context, sender string, metadata types.Metadata) error
+	ReplaceDenomMetadata(ctx context.Context, sender string, metadata types.Metadata) error
+
+	SweepDust(ctx context.Context, sender string, addresses []string) ([]string, sdk.Coins, error)
+	SetAccountActivity(ctx context.Context, activity types.AccountActivity) error
+	GetAllAccountActivities(ctx context.Context) ([]types.AccountActivity, error)
+
+	SetBalanceJournalEntry(ctx context.Context, entry types.BalanceJournalEntry) error
+	GetAllBalanceJournalEntries(ctx context.Context) ([]types.BalanceJournalEntry, error)
+	PruneBalanceJournal(ctx context.Context) error
+
+	GetModuleTransferTrace(ctx context.Context) ([]types.ModuleTransfer, error)
+	EmitModuleTransferTrace(ctx context.Context) error
+
+	SetNonCirculatingAddress(ctx context.Context, address string) error
+	RemoveNonCirculatingAddress(ctx context.Context, address string) error
+	GetAllNonCirculatingAddresses(ctx context.Context) ([]string, error)
+	GetCirculatingSupply(ctx context.Context, denom string) (circulating, nonCirculating sdk.Coin, err error)
+
+	PlaceHold(ctx context.Context, holder string, addr sdk.AccAddress, amt sdk.Coins) error
+	ReleaseHold(ctx context.Context, holder string, addr sdk.AccAddress, amt sdk.Coins) error
+	SetHold(ctx context.Context, hold types.Hold) error
+	GetAllHolds(ctx context.Context) ([]types.Hold, error)
+
+	UpdateSpendingLimit(ctx context.Context, address, denom string, dailyLimit math.Int) (time.Time, error)
+	SetSpendingLimit(ctx context.Context, limit types.SpendingLimit) error
+	GetAllSpendingLimits(ctx context.Context) ([]types.SpendingLimit, error)
+
 	types.QueryServer
 }
 
@@ -61,6 +100,8 @@ type BaseKeeper struct {
 	cdc                    codec.BinaryCodec
 	environment            appmodule.Environment
 	mintCoinsRestrictionFn types.MintingRestrictionFn
+	burnHooks              *burnHooks
+	moduleTransferTrace    *moduleTransferTrace
 	logger                 log.Logger
 }
 
@@ -103,6 +144,8 @@ func NewBaseKeeper(
 		cdc:                    cdc,
 		environment:            env,
 		mintCoinsRestrictionFn: types.NoOpMintingRestrictionFn,
+		burnHooks:              &burnHooks{},
+		moduleTransferTrace:    &moduleTransferTrace{},
 		logger:                 logger,
 	}
 }
@@ -117,6 +160,15 @@ func (k BaseKeeper) WithMintCoinsRestriction(check types.MintingRestrictionFn) B
 	return k
 }
 
+// SetBurnHooks sets the hooks called when coins are burned.
+func (k BaseKeeper) SetBurnHooks(hooks types.BurnHooks) {
+	if k.burnHooks.hooks != nil {
+		panic("cannot set burn hooks twice")
+	}
+
+	k.burnHooks.hooks = hooks
+}
+
 // DelegateCoins performs delegation by deducting amt coins from an account with
 // address addr. For vesting accounts, delegations amounts are tracked for both
 // vesting and vested coins. The coins are then transferred from the delegator
@@ -133,6 +185,7 @@ func (k BaseKeeper) DelegateCoins(ctx context.Context, delegatorAddr, moduleAccA
 	}
 
 	balances := sdk.NewCoins()
+	held := k.GetHeldCoins(ctx, delegatorAddr)
 
 	for _, coin := range amt {
 		balance := k.GetBalance(ctx, delegatorAddr, coin.GetDenom())
@@ -142,11 +195,23 @@ func (k BaseKeeper) DelegateCoins(ctx context.Context, delegatorAddr, moduleAccA
 			)
 		}
 
+		// coins on hold cannot be delegated, even by vesting accounts
+		if heldAmt := held.AmountOf(coin.Denom); balance.Amount.Sub(heldAmt).LT(coin.Amount) {
+			return errorsmod.Wrapf(
+				sdkerrors.ErrInsufficientFunds, "failed to delegate; %s%s of %s is on hold", heldAmt, coin.Denom, balance,
+			)
+		}
+
 		balances = balances.Add(balance)
-		err := k.setBalance(ctx, delegatorAddr, balance.Sub(coin))
+		newBalance := balance.Sub(coin)
+		err := k.setBalance(ctx, delegatorAddr, newBalance)
 		if err != nil {
 			return err
 		}
+
+		if err := k.recordBalanceChange(ctx, delegatorAddr, balance, newBalance, types.BalanceChangeReasonDelegate); err != nil {
+			return err
+		}
 	}
 
 	if err := k.trackDelegation(ctx, delegatorAddr, balances, amt); err != nil {
@@ -165,12 +230,22 @@ func (k BaseKeeper) DelegateCoins(ctx context.Context, delegatorAddr, moduleAccA
 		return err
 	}
 
-	err = k.addCoins(ctx, moduleAccAddr, amt)
+	hooks, err := k.loadDenomTransferHooks(ctx, amt)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	// the transfers to module accounts are not charged transfer fees
+	if _, _, err := k.beforeTransfer(ctx, hooks, delegatorAddr, moduleAccAddr, amt); err != nil {
+		return err
+	}
+
+	err = k.addCoins(ctx, moduleAccAddr, amt, types.BalanceChangeReasonDelegate)
+	if err != nil {
+		return err
+	}
+
+	return k.afterTransfer(ctx, hooks, delegatorAddr, moduleAccAddr, amt)
 }
 
 // UndelegateCoins performs undelegation by crediting amt coins to an account with
@@ -188,7 +263,7 @@ func (k BaseKeeper) UndelegateCoins(ctx context.Context, moduleAccAddr, delegato
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
 
-	err := k.subUnlockedCoins(ctx, moduleAccAddr, amt)
+	err := k.subUnlockedCoins(ctx, moduleAccAddr, amt, types.BalanceChangeReasonUndelegate)
 	if err != nil {
 		return err
 	}
@@ -197,12 +272,22 @@ func (k BaseKeeper) UndelegateCoins(ctx context.Context, moduleAccAddr, delegato
 		return errorsmod.Wrap(err, "failed to track undelegation")
 	}
 
-	err = k.addCoins(ctx, delegatorAddr, amt)
+	hooks, err := k.loadDenomTransferHooks(ctx, amt)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	// the transfers from module accounts are not charged transfer fees
+	if _, _, err := k.beforeTransfer(ctx, hooks, moduleAccAddr, delegatorAddr, amt); err != nil {
+		return err
+	}
+
+	err = k.addCoins(ctx, delegatorAddr, amt, types.BalanceChangeReasonUndelegate)
+	if err != nil {
+		return err
+	}
+
+	return k.afterTransfer(ctx, hooks, moduleAccAddr, delegatorAddr, amt)
 }
 
 // GetSupply retrieves the Supply from store
@@ -261,6 +346,48 @@ func (k BaseKeeper) SetDenomMetaData(ctx context.Context, denomMetaData types.Me
 	_ = k.BaseViewKeeper.DenomMetadata.Set(ctx, denomMetaData.Base, denomMetaData)
 }
 
+// RegisterDenomMetadata registers the metadata of a denom on behalf of sender,
+// the authority or the admin of the denom. An error is returned if the metadata
+// is invalid or if the denom already has metadata.
+func (k BaseKeeper) RegisterDenomMetadata(ctx context.Context, sender string, metadata types.Metadata) error {
+	if err := metadata.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if k.HasDenomMetaData(ctx, metadata.Base) {
+		return errorsmod.Wrapf(types.ErrDenomMetadataExists, "denom %s", metadata.Base)
+	}
+
+	k.SetDenomMetaData(ctx, metadata)
+
+	return k.environment.EventService.EventManager(ctx).Emit(&types.EventSetDenomMetadata{
+		Denom:  metadata.Base,
+		Sender: sender,
+	})
+}
+
+// ReplaceDenomMetadata replaces the metadata of a denom on behalf of sender, the
+// authority or the admin of the denom. An error is returned if the denom has no
+// metadata or if the metadata cannot replace it, see Metadata.ValidateUpdate.
+func (k BaseKeeper) ReplaceDenomMetadata(ctx context.Context, sender string, metadata types.Metadata) error {
+	prev, found := k.GetDenomMetaData(ctx, metadata.Base)
+	if !found {
+		return errorsmod.Wrapf(types.ErrDenomMetadataNotFound, "denom %s", metadata.Base)
+	}
+
+	if err := metadata.ValidateUpdate(prev); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	k.SetDenomMetaData(ctx, metadata)
+
+	return k.environment.EventService.EventManager(ctx).Emit(&types.EventSetDenomMetadata{
+		Denom:  metadata.Base,
+		Sender: sender,
+		Update: true,
+	})
+}
+
 // SendCoinsFromModuleToAccount transfers coins from a ModuleAccount to an AccAddress.
 // An error is returned if the module account does not exist or if
 // the recipient address is black-listed or if sending the tokens fails.
@@ -294,7 +421,11 @@ func (k BaseKeeper) SendCoinsFromModuleToModule(
 		return errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipientModule)
 	}
 
-	return k.SendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
+	if err := k.SendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt); err != nil {
+		return err
+	}
+
+	return k.traceModuleTransfer(ctx, senderModule, recipientModule, amt)
 }
 
 // SendCoinsFromAccountToModule transfers coins from an AccAddress to a ModuleAccount.
@@ -363,7 +494,7 @@ func (k BaseKeeper) MintCoins(ctx context.Context, moduleName string, amounts sd
 		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "module account %s does not have permissions to mint tokens", moduleName)
 	}
 
-	err = k.addCoins(ctx, acc.GetAddress(), amounts)
+	err = k.addCoins(ctx, acc.GetAddress(), amounts, types.BalanceChangeReasonMint)
 	if err != nil {
 		return err
 	}
@@ -403,7 +534,7 @@ func (k BaseKeeper) BurnCoins(ctx context.Context, address []byte, amounts sdk.C
 		}
 	}
 
-	err := k.subUnlockedCoins(ctx, acc.GetAddress(), amounts)
+	err := k.subUnlockedCoins(ctx, acc.GetAddress(), amounts, types.BalanceChangeReasonBurn)
 	if err != nil {
 		return err
 	}
@@ -416,6 +547,10 @@ func (k BaseKeeper) BurnCoins(ctx context.Context, address []byte, amounts sdk.C
 
 	k.logger.Debug("burned tokens from account", "amount", amounts.String(), "from", address)
 
+	if err := k.burnHooks.afterCoinsBurned(ctx, acc.GetAddress(), amounts); err != nil {
+		return err
+	}
+
 	addrStr, err := k.ak.AddressCodec().BytesToString(acc.GetAddress())
 	if err != nil {
 		return err
@@ -483,3 +618,17 @@ func (k BaseViewKeeper) IterateTotalSupply(ctx context.Context, cb func(sdk.Coin
 		panic(err)
 	}
 }
+
+// burnHooks is a struct that houses the BurnHooks.
+// It exists so that the hooks can be set in the BaseKeeper without needing to have a pointer receiver.
+type burnHooks struct {
+	hooks types.BurnHooks
+}
+
+// afterCoinsBurned calls the burn hooks if there are any. If not, it's a no-op.
+func (h *burnHooks) afterCoinsBurned(ctx context.Context, burner sdk.AccAddress, amount sdk.Coins) error {
+	if h == nil || h.hooks == nil {
+		return nil
+	}
+	return h.hooks.AfterCoinsBurned(ctx, burner, amount)
+}
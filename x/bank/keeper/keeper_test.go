@@ -127,7 +127,8 @@ func TestKeeperTestSuite(t *testing.T) {
 
 func (suite *KeeperTestSuite) SetupTest() {
 	key := storetypes.NewKVStoreKey(banktypes.StoreKey)
-	testCtx := testutil.DefaultContextWithDB(suite.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	tkey := storetypes.NewTransientStoreKey(banktypes.TStoreKey)
+	testCtx := testutil.DefaultContextWithDB(suite.T(), key, tkey)
 	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
 	encCfg := moduletestutil.MakeTestEncodingConfig()
 
@@ -147,6 +148,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 		authtypes.NewModuleAddress(banktypes.GovModuleName).String(),
 		log.NewNopLogger(),
 	)
+	suite.bankKeeper.SetModuleTransferTraceStore(runtime.NewTransientStoreService(tkey))
 
 	banktypes.RegisterInterfaces(encCfg.InterfaceRegistry)
 
@@ -590,6 +592,277 @@ func (suite *KeeperTestSuite) TestSupply_BurnCoins() {
 	require.Equal(supplyAfterInflation.Sub(initCoins...), supplyAfterBurn)
 }
 
+// burnHooks is a banktypes.BurnHooks recording the burned coins.
+type burnHooks struct {
+	err    error
+	burned sdk.Coins
+}
+
+func (h *burnHooks) AfterCoinsBurned(_ context.Context, _ sdk.AccAddress, amount sdk.Coins) error {
+	if h.err != nil {
+		return h.err
+	}
+	h.burned = h.burned.Add(amount...)
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestBurnHooks() {
+	ctx := suite.ctx
+	require := suite.Require()
+	keeper := suite.bankKeeper
+
+	hooks := &burnHooks{}
+	keeper.SetBurnHooks(hooks)
+	require.Panics(func() { keeper.SetBurnHooks(hooks) })
+
+	suite.mockMintCoins(minterAcc)
+	require.NoError(keeper.MintCoins(ctx, authtypes.Minter, initCoins))
+	suite.mockSendCoinsFromModuleToAccount(minterAcc, burnerAcc.GetAddress())
+	require.NoError(keeper.SendCoinsFromModuleToAccount(ctx, authtypes.Minter, burnerAcc.GetAddress(), initCoins))
+
+	suite.mockBurnCoins(burnerAcc)
+	burned := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	require.NoError(keeper.BurnCoins(ctx, burnerAcc.GetAddress(), burned))
+	require.Equal(burned, hooks.burned)
+
+	// a failing hook fails the burn
+	hooks.err = errors.New("hook failed")
+	require.ErrorIs(keeper.BurnCoins(ctx, burnerAcc.GetAddress(), burned), hooks.err)
+}
+
+// transferHooks is a banktypes.TransferHooks recording the transfers it is called for.
+type transferHooks struct {
+	err    error
+	gas    uint64
+	before []sdk.Coin
+	after  []sdk.Coin
+}
+
+func (h *transferHooks) BeforeTransfer(ctx context.Context, _, _, _ sdk.AccAddress, amount sdk.Coin) error {
+	sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(h.gas, "transfer hook")
+	if h.err != nil {
+		return h.err
+	}
+	h.before = append(h.before, amount)
+	return nil
+}
+
+func (h *transferHooks) AfterTransfer(_ context.Context, _, _, _ sdk.AccAddress, amount sdk.Coin) error {
+	h.after = append(h.after, amount)
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestDenomTransferHooks() {
+	ctx := suite.ctx
+	require := suite.Require()
+	keeper := suite.bankKeeper
+	target := authtypes.NewModuleAddress("hooks")
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, keeper, accAddrs[0], sdk.NewCoins(newFooCoin(1000), newBarCoin(100))))
+	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[0]).Return(acc0).AnyTimes()
+	escrowAcc := authtypes.NewEmptyModuleAccount("escrow", authtypes.Staking)
+	suite.authKeeper.EXPECT().GetAccount(ctx, escrowAcc.GetAddress()).Return(escrowAcc).AnyTimes()
+	poolAcc := authtypes.NewEmptyModuleAccount("pool")
+	suite.authKeeper.EXPECT().GetAccount(ctx, poolAcc.GetAddress()).Return(poolAcc).AnyTimes()
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount(authtypes.FeeCollectorName)
+	suite.authKeeper.EXPECT().GetAccount(ctx, feeCollectorAcc.GetAddress()).Return(feeCollectorAcc).AnyTimes()
+	suite.authKeeper.EXPECT().GetAccount(ctx, gomock.Any()).Return(nil).AnyTimes()
+
+	require.ErrorIs(keeper.SetDenomTransferHook(ctx, banktypes.DenomTransferHook{Denom: fooDenom, Target: target.String(), GasLimit: 100_000}), banktypes.ErrInvalidDenomTransferHook)
+	require.ErrorIs(keeper.RemoveDenomTransferHook(ctx, fooDenom), banktypes.ErrDenomTransferHookNotFound)
+
+	// a hook cannot be registered unless its target is dispatched
+	hook := banktypes.DenomTransferHook{Denom: fooDenom, Target: target.String(), BeforeTransfer: true, AfterTransfer: true, GasLimit: 100_000}
+	require.ErrorIs(keeper.SetDenomTransferHook(ctx, hook), banktypes.ErrInvalidDenomTransferHook)
+
+	hooks := &transferHooks{}
+	keeper.SetTransferHooks(banktypes.NewTransferHooksRouter(nil).AddRoute(target, hooks))
+	require.Panics(func() { keeper.SetTransferHooks(hooks) })
+
+	unknownTargetHook := hook
+	unknownTargetHook.Target = accAddrs[3].String()
+	require.ErrorContains(keeper.SetDenomTransferHook(ctx, unknownTargetHook), "no transfer hooks registered")
+
+	require.NoError(keeper.SetDenomTransferHook(ctx, hook))
+
+	res, err := suite.queryClient.DenomTransferHook(ctx, &banktypes.QueryDenomTransferHookRequest{Denom: fooDenom})
+	require.NoError(err)
+	require.Equal(hook, res.Hook)
+	_, err = suite.queryClient.DenomTransferHook(ctx, &banktypes.QueryDenomTransferHookRequest{Denom: barDenom})
+	require.ErrorContains(err, "not found")
+
+	// only the hooks of the denoms sent are called
+	require.NoError(keeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10), newBarCoin(10))))
+	require.Equal([]sdk.Coin{newFooCoin(10)}, hooks.before)
+	require.Equal([]sdk.Coin{newFooCoin(10)}, hooks.after)
+
+	// a multi-send calls the hooks once per output
+	input := banktypes.Input{Address: accAddrs[0].String(), Coins: sdk.NewCoins(newFooCoin(20))}
+	outputs := []banktypes.Output{
+		{Address: accAddrs[1].String(), Coins: sdk.NewCoins(newFooCoin(5))},
+		{Address: accAddrs[2].String(), Coins: sdk.NewCoins(newFooCoin(15))},
+	}
+	require.NoError(keeper.InputOutputCoins(ctx, input, outputs))
+	require.Equal([]sdk.Coin{newFooCoin(10), newFooCoin(5), newFooCoin(15)}, hooks.before)
+	require.Equal([]sdk.Coin{newFooCoin(10), newFooCoin(5), newFooCoin(15)}, hooks.after)
+
+	// a failing before hook aborts the transfer
+	hooks.err = errors.New("transfer rejected")
+	require.ErrorIs(keeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))), hooks.err)
+	require.ErrorIs(keeper.InputOutputCoins(ctx, input, outputs), hooks.err)
+	require.Len(hooks.after, 3)
+
+	// a hook is bounded by its gas limit, the gas it uses is charged to the transfer
+	hooks.err = nil
+	hooks.gas = 40_000
+	gasMeter := sdk.UnwrapSDKContext(ctx).GasMeter()
+	gasBefore := gasMeter.GasConsumed()
+	require.NoError(keeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+	require.GreaterOrEqual(gasMeter.GasConsumed()-gasBefore, uint64(40_000))
+	hooks.gas = 200_000
+	require.ErrorIs(keeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))), sdkerrors.ErrOutOfGas)
+
+	// the transfers of users to module accounts, delegations included, are intercepted
+	hooks.gas = 0
+	require.NoError(keeper.SendCoins(ctx, accAddrs[0], escrowAcc.GetAddress(), sdk.NewCoins(newFooCoin(30))))
+	require.Len(hooks.before, 5)
+	require.Equal(newFooCoin(30), hooks.after[len(hooks.after)-1])
+	hooks.err = errors.New("transfer rejected")
+	require.ErrorIs(keeper.SendCoins(ctx, accAddrs[0], escrowAcc.GetAddress(), sdk.NewCoins(newFooCoin(10))), hooks.err)
+	require.ErrorIs(keeper.DelegateCoins(ctx, accAddrs[0], escrowAcc.GetAddress(), sdk.NewCoins(newFooCoin(10))), hooks.err)
+
+	// the payouts of module accounts and the fees collected never fail on a hook
+	require.NoError(keeper.SendCoins(ctx, escrowAcc.GetAddress(), accAddrs[0], sdk.NewCoins(newFooCoin(10))))
+	require.NoError(keeper.UndelegateCoins(ctx, escrowAcc.GetAddress(), accAddrs[0], sdk.NewCoins(newFooCoin(10))))
+	require.NoError(keeper.SendCoins(ctx, accAddrs[0], feeCollectorAcc.GetAddress(), sdk.NewCoins(newFooCoin(10))))
+	require.Equal(newFooCoin(10), hooks.after[len(hooks.after)-1])
+
+	// the transfers between module accounts are not intercepted
+	hooks.err = nil
+	afterCalls := len(hooks.after)
+	require.NoError(keeper.SendCoins(ctx, escrowAcc.GetAddress(), poolAcc.GetAddress(), sdk.NewCoins(newFooCoin(5))))
+	require.Len(hooks.before, 5)
+	require.Len(hooks.after, afterCalls)
+
+	require.NoError(keeper.RemoveDenomTransferHook(ctx, fooDenom))
+	require.NoError(keeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+	require.Len(hooks.before, 5)
+}
+
+func (suite *KeeperTestSuite) TestDenomTransferFees() {
+	ctx := suite.ctx
+	require := suite.Require()
+	bankKeeper := suite.bankKeeper
+	sender, recipient, recipient2, exempt, collector := accAddrs[0], accAddrs[1], accAddrs[2], accAddrs[3], accAddrs[4]
+
+	suite.mockFundAccount(sender)
+	require.NoError(banktestutil.FundAccount(ctx, bankKeeper, sender, sdk.NewCoins(newFooCoin(1000), newBarCoin(100))))
+	for _, addr := range accAddrs {
+		suite.authKeeper.EXPECT().GetAccount(ctx, addr).Return(authtypes.NewBaseAccountWithAddress(addr)).AnyTimes()
+	}
+	suite.authKeeper.EXPECT().GetAccount(ctx, mintAcc.GetAddress()).Return(mintAcc).AnyTimes()
+	escrowAcc := authtypes.NewEmptyModuleAccount("escrow", authtypes.Staking)
+	suite.authKeeper.EXPECT().GetAccount(ctx, escrowAcc.GetAddress()).Return(escrowAcc).AnyTimes()
+
+	// the transfers move the coins between accounts without minting or burning any
+	requireSupplyConsistent := func() {
+		msg, broken := keeper.TotalSupply(bankKeeper)(sdk.UnwrapSDKContext(ctx))
+		require.False(broken, msg)
+	}
+	requireFooBalances := func(expected ...int64) {
+		for i, addr := range []sdk.AccAddress{sender, recipient, recipient2, exempt, collector} {
+			require.Equal(newFooCoin(expected[i]), bankKeeper.GetBalance(ctx, addr, fooDenom), "balance of account %d", i)
+		}
+		requireSupplyConsistent()
+	}
+
+	fee := banktypes.DenomTransferFee{Denom: fooDenom, Rate: math.LegacyOneDec(), Collector: collector.String()}
+	require.ErrorIs(bankKeeper.SetDenomTransferFee(ctx, fee), banktypes.ErrInvalidDenomTransferFee)
+	fee.Rate = math.LegacyNewDecWithPrec(1, 2)
+	fee.ExemptAddresses = []string{exempt.String(), exempt.String()}
+	require.ErrorIs(bankKeeper.SetDenomTransferFee(ctx, fee), banktypes.ErrInvalidDenomTransferFee)
+	require.ErrorIs(bankKeeper.RemoveDenomTransferFee(ctx, fooDenom), banktypes.ErrDenomTransferFeeNotFound)
+
+	fee.ExemptAddresses = []string{exempt.String()}
+	require.NoError(bankKeeper.SetDenomTransferFee(ctx, fee))
+
+	res, err := suite.queryClient.DenomTransferFee(ctx, &banktypes.QueryDenomTransferFeeRequest{Denom: fooDenom})
+	require.NoError(err)
+	require.Equal(fee, res.Fee)
+	_, err = suite.queryClient.DenomTransferFee(ctx, &banktypes.QueryDenomTransferFeeRequest{Denom: barDenom})
+	require.ErrorContains(err, "not found")
+
+	// the fee is deducted from the coins credited to the recipient, rounded down,
+	// and only charged on the denoms with a transfer fee
+	require.NoError(bankKeeper.SendCoins(ctx, sender, recipient, sdk.NewCoins(newFooCoin(250), newBarCoin(10))))
+	requireFooBalances(750, 248, 0, 0, 2)
+	require.Equal(newBarCoin(10), bankKeeper.GetBalance(ctx, recipient, barDenom))
+
+	// the transfer event reports the coins credited to the recipient, the fee
+	// being reported by its own event
+	var feeEvents int
+	var transferred []string
+	for _, e := range sdk.UnwrapSDKContext(ctx).EventManager().Events() {
+		switch e.Type {
+		case "cosmos.bank.v1beta1.EventTransferFee":
+			feeEvents++
+		case banktypes.EventTypeTransfer:
+			attr, ok := e.GetAttribute(sdk.AttributeKeyAmount)
+			require.True(ok)
+			transferred = append(transferred, attr.Value)
+		}
+	}
+	require.Equal(1, feeEvents)
+	require.Equal(sdk.NewCoins(newFooCoin(248), newBarCoin(10)).String(), transferred[len(transferred)-1])
+
+	// fees rounding down to zero are not charged
+	require.NoError(bankKeeper.SendCoins(ctx, sender, recipient, sdk.NewCoins(newFooCoin(50))))
+	requireFooBalances(700, 298, 0, 0, 2)
+
+	// the transfers from and to exempt addresses and module accounts are not charged
+	require.NoError(bankKeeper.SendCoins(ctx, sender, exempt, sdk.NewCoins(newFooCoin(100))))
+	require.NoError(bankKeeper.SendCoins(ctx, exempt, recipient2, sdk.NewCoins(newFooCoin(100))))
+	requireFooBalances(600, 298, 100, 0, 2)
+	require.NoError(bankKeeper.SendCoins(ctx, sender, mintAcc.GetAddress(), sdk.NewCoins(newFooCoin(100))))
+	require.Equal(newFooCoin(100), bankKeeper.GetBalance(ctx, mintAcc.GetAddress(), fooDenom))
+	requireFooBalances(500, 298, 100, 0, 2)
+
+	// the payouts of module accounts, such as rewards, unbondings or deposit
+	// refunds, are credited in full, and delegations are not charged either
+	require.NoError(bankKeeper.SendCoins(ctx, mintAcc.GetAddress(), recipient, sdk.NewCoins(newFooCoin(100))))
+	requireFooBalances(500, 398, 100, 0, 2)
+	require.NoError(bankKeeper.DelegateCoins(ctx, sender, escrowAcc.GetAddress(), sdk.NewCoins(newFooCoin(100))))
+	require.Equal(newFooCoin(100), bankKeeper.GetBalance(ctx, escrowAcc.GetAddress(), fooDenom))
+	require.NoError(bankKeeper.UndelegateCoins(ctx, escrowAcc.GetAddress(), recipient2, sdk.NewCoins(newFooCoin(100))))
+	requireFooBalances(400, 398, 200, 0, 2)
+
+	// a multi-send charges the fee on each output
+	input := banktypes.Input{Address: sender.String(), Coins: sdk.NewCoins(newFooCoin(300))}
+	outputs := []banktypes.Output{
+		{Address: recipient.String(), Coins: sdk.NewCoins(newFooCoin(100))},
+		{Address: recipient2.String(), Coins: sdk.NewCoins(newFooCoin(200))},
+	}
+	eventManager := sdk.UnwrapSDKContext(ctx).EventManager()
+	numEvents := len(eventManager.Events())
+	require.NoError(bankKeeper.InputOutputCoins(ctx, input, outputs))
+	requireFooBalances(100, 497, 398, 0, 5)
+	transferred = nil
+	for _, e := range eventManager.Events()[numEvents:] {
+		if e.Type == banktypes.EventTypeTransfer {
+			attr, ok := e.GetAttribute(sdk.AttributeKeyAmount)
+			require.True(ok)
+			transferred = append(transferred, attr.Value)
+		}
+	}
+	require.Equal([]string{newFooCoin(99).String(), newFooCoin(198).String()}, transferred)
+
+	require.NoError(bankKeeper.RemoveDenomTransferFee(ctx, fooDenom))
+	require.NoError(bankKeeper.SendCoins(ctx, sender, recipient, sdk.NewCoins(newFooCoin(100))))
+	requireFooBalances(0, 597, 398, 0, 5)
+}
+
 func (suite *KeeperTestSuite) TestSendCoinsNewAccount() {
 	ctx := suite.ctx
 	require := suite.Require()
@@ -901,9 +1174,10 @@ func (suite *KeeperTestSuite) TestInputOutputCoinsWithRestrictions() {
 				},
 			},
 			expErr: "second restriction error",
+			// the outputs of a chunk are only credited once all of them pass the restrictions
 			expBals: expBals{
 				from: sdk.NewCoins(newFooCoin(904), newBarCoin(400)),
-				to1:  sdk.NewCoins(newFooCoin(38)),
+				to1:  sdk.NewCoins(newFooCoin(26)),
 				to2:  sdk.NewCoins(newFooCoin(26), newBarCoin(12)),
 			},
 		},
@@ -932,7 +1206,7 @@ func (suite *KeeperTestSuite) TestInputOutputCoinsWithRestrictions() {
 			},
 			expBals: expBals{
 				from: sdk.NewCoins(newFooCoin(904), newBarCoin(365)),
-				to1:  sdk.NewCoins(newFooCoin(38), newBarCoin(25)),
+				to1:  sdk.NewCoins(newFooCoin(26), newBarCoin(25)),
 				to2:  sdk.NewCoins(newFooCoin(26), newBarCoin(22)),
 			},
 		},
@@ -1435,11 +1709,91 @@ func (suite *KeeperTestSuite) TestMsgMultiSendEvents() {
 		event2.Attributes,
 		abci.EventAttribute{Key: sdk.AttributeKeyAmount, Value: newCoins2.String()},
 	)
-	// events are shifted due to the funding account events
-	require.Equal(abci.Event(event1), events[22])
+	// events are shifted due to the funding account events, the transfer events
+	// are emitted after the coin_spent and coin_received events
+	require.Equal(abci.Event(event1), events[23])
 	require.Equal(abci.Event(event2), events[24])
 }
 
+func (suite *KeeperTestSuite) TestInputOutputCoinsMergesRecipients() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(100))))
+
+	input := banktypes.Input{
+		Address: accAddrs[0].String(),
+		Coins:   sdk.NewCoins(newFooCoin(60), newBarCoin(25)),
+	}
+	outputs := []banktypes.Output{
+		{Address: accAddrs[2].String(), Coins: sdk.NewCoins(newFooCoin(10))},
+		{Address: accAddrs[3].String(), Coins: sdk.NewCoins(newFooCoin(20))},
+		{Address: accAddrs[2].String(), Coins: sdk.NewCoins(newFooCoin(30), newBarCoin(25))},
+	}
+
+	// skip the events of the account funding
+	funding := len(ctx.EventManager().Events())
+	suite.mockInputOutputCoins([]sdk.AccountI{acc0}, accAddrs[2:4])
+	require.NoError(suite.bankKeeper.InputOutputCoins(ctx, input, outputs))
+
+	require.Equal(sdk.NewCoins(newFooCoin(40), newBarCoin(75)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	require.Equal(sdk.NewCoins(newFooCoin(40), newBarCoin(25)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[2]))
+	require.Equal(sdk.NewCoins(newFooCoin(20)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[3]))
+
+	// one coin_received event per recipient, one transfer event per output
+	var received, transfers []sdk.Event
+	for _, e := range ctx.EventManager().Events()[funding:] {
+		switch e.Type {
+		case banktypes.EventTypeCoinReceived:
+			received = append(received, e)
+		case banktypes.EventTypeTransfer:
+			transfers = append(transfers, e)
+		}
+	}
+	require.Len(received, 2)
+	require.Equal(accAddrs[2].String(), received[0].Attributes[0].Value)
+	require.Equal(sdk.NewCoins(newFooCoin(40), newBarCoin(25)).String(), received[0].Attributes[1].Value)
+	require.Equal(accAddrs[3].String(), received[1].Attributes[0].Value)
+	require.Len(transfers, 3)
+	for i, out := range outputs {
+		require.Equal(out.Address, transfers[i].Attributes[0].Value)
+		require.Equal(out.Coins.String(), transfers[i].Attributes[1].Value)
+	}
+}
+
+func (suite *KeeperTestSuite) TestInputOutputCoinsGasLinear() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(1_000_000))))
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), accAddrs[0]).Return(acc0).Times(3)
+
+	// gasFor returns the gas consumed by a multi-send to n distinct recipients
+	gasFor := func(n int) uint64 {
+		outputs := make([]banktypes.Output, n)
+		for i := range outputs {
+			outputs[i] = banktypes.Output{
+				Address: sdk.AccAddress(fmt.Sprintf("output%014d", i)).String(),
+				Coins:   sdk.NewCoins(newFooCoin(1)),
+			}
+		}
+		input := banktypes.Input{Address: accAddrs[0].String(), Coins: sdk.NewCoins(newFooCoin(int64(n)))}
+
+		cacheCtx, _ := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).CacheContext()
+		require.NoError(suite.bankKeeper.InputOutputCoins(cacheCtx, input, outputs))
+		return cacheCtx.GasMeter().GasConsumed()
+	}
+
+	// the outputs span several chunks, every output costs the same gas
+	gas1, gas2, gas3 := gasFor(1500), gasFor(3000), gasFor(4500)
+	require.Equal(gas2-gas1, gas3-gas2)
+	require.Greater(gas2-gas1, uint64(0))
+}
+
 func (suite *KeeperTestSuite) TestSpendableCoins() {
 	ctx := sdk.UnwrapSDKContext(suite.ctx)
 	require := suite.Require()
@@ -1647,6 +2001,188 @@ func (suite *KeeperTestSuite) TestDelegateCoins() {
 	require.Equal(delCoins, vacc.GetDelegatedVesting())
 }
 
+func (suite *KeeperTestSuite) TestHolds() {
+	ctx := suite.ctx
+	require := suite.Require()
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100))))
+	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[0]).Return(acc0).AnyTimes()
+
+	require.ErrorContains(suite.bankKeeper.PlaceHold(ctx, "", accAddrs[0], sdk.NewCoins(newFooCoin(10))), "holder cannot be empty")
+	require.ErrorContains(suite.bankKeeper.PlaceHold(ctx, "escrow", accAddrs[0], sdk.Coins{}), "invalid coins")
+	require.ErrorContains(suite.bankKeeper.PlaceHold(ctx, "escrow", accAddrs[0], sdk.NewCoins(newFooCoin(101))), "spendable balance")
+
+	// holds of the same holder add up
+	require.NoError(suite.bankKeeper.PlaceHold(ctx, "escrow", accAddrs[0], sdk.NewCoins(newFooCoin(60))))
+	require.NoError(suite.bankKeeper.PlaceHold(ctx, "escrow", accAddrs[0], sdk.NewCoins(newFooCoin(10))))
+	require.NoError(suite.bankKeeper.PlaceHold(ctx, "market", accAddrs[0], sdk.NewCoins(newFooCoin(20))))
+	require.ErrorContains(suite.bankKeeper.PlaceHold(ctx, "market", accAddrs[0], sdk.NewCoins(newFooCoin(20))), "spendable balance")
+
+	require.Equal(sdk.NewCoins(newFooCoin(90)), suite.bankKeeper.GetHeldCoins(ctx, accAddrs[0]))
+	require.Equal(sdk.NewCoins(newFooCoin(10)), suite.bankKeeper.SpendableCoins(ctx, accAddrs[0]))
+	require.Equal(sdk.NewCoins(newFooCoin(100)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+
+	res, err := suite.queryClient.Holds(ctx, &banktypes.QueryHoldsRequest{Address: accAddrs[0].String()})
+	require.NoError(err)
+	require.Equal([]banktypes.Hold{
+		{Address: accAddrs[0].String(), Holder: "escrow", Amount: sdk.NewCoins(newFooCoin(70))},
+		{Address: accAddrs[0].String(), Holder: "market", Amount: sdk.NewCoins(newFooCoin(20))},
+	}, res.Holds)
+
+	// held coins can neither be sent nor delegated
+	require.ErrorContains(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(20))), "insufficient funds")
+	suite.authKeeper.EXPECT().GetAccount(ctx, holderAcc.GetAddress()).Return(holderAcc)
+	require.ErrorContains(suite.bankKeeper.DelegateCoins(ctx, accAddrs[0], holderAcc.GetAddress(), sdk.NewCoins(newFooCoin(20))), "is on hold")
+
+	require.ErrorContains(suite.bankKeeper.ReleaseHold(ctx, "unknown", accAddrs[0], sdk.NewCoins(newFooCoin(10))), "hold not found")
+	require.ErrorContains(suite.bankKeeper.ReleaseHold(ctx, "escrow", accAddrs[0], sdk.NewCoins(newFooCoin(80))), "held amount")
+
+	// a fully released hold is removed
+	require.NoError(suite.bankKeeper.ReleaseHold(ctx, "escrow", accAddrs[0], sdk.NewCoins(newFooCoin(70))))
+	holds, err := suite.bankKeeper.GetAllHolds(ctx)
+	require.NoError(err)
+	require.Len(holds, 1)
+	require.Equal(sdk.NewCoins(newFooCoin(80)), suite.bankKeeper.SpendableCoins(ctx, accAddrs[0]))
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(80))))
+}
+
+func (suite *KeeperTestSuite) TestSweepDust() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+	poolAcc := authtypes.NewEmptyModuleAccount(banktypes.ProtocolPoolModuleName)
+
+	suite.authKeeper.EXPECT().GetModuleAddress(banktypes.ProtocolPoolModuleName).Return(poolAcc.GetAddress()).AnyTimes()
+	suite.authKeeper.EXPECT().GetModuleAccount(gomock.Any(), banktypes.ProtocolPoolModuleName).Return(poolAcc).AnyTimes()
+	for _, addr := range accAddrs[:3] {
+		suite.authKeeper.EXPECT().GetAccount(gomock.Any(), addr).Return(authtypes.NewBaseAccountWithAddress(addr)).AnyTimes()
+	}
+
+	// dust sweeping is disabled by default
+	_, _, err := suite.bankKeeper.SweepDust(ctx, accAddrs[0].String(), []string{accAddrs[1].String()})
+	require.ErrorIs(err, banktypes.ErrDustSweepDisabled)
+
+	params := banktypes.DefaultParams()
+	params.DustThresholds = sdk.NewCoins(newFooCoin(10))
+	params.DustInactivityBlocks = 5
+	params.DustSweepExcludedAddresses = []string{accAddrs[2].String()}
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+
+	// balance changes are tracked as account activity
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(5), newBarCoin(5))))
+	suite.mockFundAccount(accAddrs[1])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[1], sdk.NewCoins(newFooCoin(50))))
+	suite.mockFundAccount(accAddrs[2])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[2], sdk.NewCoins(newFooCoin(3))))
+
+	height, err := suite.bankKeeper.AccountActivities.Get(ctx, accAddrs[0])
+	require.NoError(err)
+	require.Equal(ctx.HeaderInfo().Height, height)
+
+	addresses := []string{accAddrs[0].String(), accAddrs[1].String(), accAddrs[2].String()}
+	_, _, err = suite.bankKeeper.SweepDust(ctx, accAddrs[0].String(), []string{accAddrs[0].String(), accAddrs[0].String()})
+	require.ErrorContains(err, "duplicate address")
+
+	// recently active accounts are not swept
+	ctx = ctx.WithHeaderInfo(header.Info{Height: ctx.HeaderInfo().Height + 4, Time: ctx.HeaderInfo().Time})
+	swept, amount, err := suite.bankKeeper.SweepDust(ctx, accAddrs[0].String(), addresses)
+	require.NoError(err)
+	require.Empty(swept)
+	require.True(amount.IsZero())
+
+	// only the dust of the inactive accounts which are not excluded is swept
+	ctx = ctx.WithHeaderInfo(header.Info{Height: ctx.HeaderInfo().Height + 1, Time: ctx.HeaderInfo().Time})
+	swept, amount, err = suite.bankKeeper.SweepDust(ctx, accAddrs[0].String(), addresses)
+	require.NoError(err)
+	require.Equal([]string{accAddrs[0].String()}, swept)
+	require.Equal(sdk.NewCoins(newFooCoin(5)), amount)
+	require.Equal(sdk.NewCoins(newBarCoin(5)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+	require.Equal(sdk.NewCoins(newFooCoin(50)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+	require.Equal(sdk.NewCoins(newFooCoin(3)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[2]))
+	require.Equal(sdk.NewCoins(newFooCoin(5)), suite.bankKeeper.GetAllBalances(ctx, poolAcc.GetAddress()))
+
+	// the sweep does not count as an activity of the swept account
+	height, err = suite.bankKeeper.AccountActivities.Get(ctx, accAddrs[0])
+	require.NoError(err)
+	require.Equal(sdk.UnwrapSDKContext(suite.ctx).HeaderInfo().Height, height)
+}
+
+func (suite *KeeperTestSuite) TestSpendingLimits() {
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+	require := suite.Require()
+	now := ctx.HeaderInfo().Time
+	addr0 := accAddrs[0].String()
+	require.NoError(suite.bankKeeper.SetParams(ctx, banktypes.DefaultParams()))
+
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(1000), newBarCoin(1000))))
+	for _, addr := range accAddrs[:2] {
+		suite.authKeeper.EXPECT().GetAccount(gomock.Any(), addr).Return(authtypes.NewBaseAccountWithAddress(addr)).AnyTimes()
+	}
+
+	_, err := suite.msgServer.SetSpendingLimit(ctx, &banktypes.MsgSetSpendingLimit{Address: addr0, Denom: fooDenom, DailyLimit: math.ZeroInt()})
+	require.ErrorIs(err, banktypes.ErrSpendingLimitNotFound)
+	_, err = suite.msgServer.SetSpendingLimit(ctx, &banktypes.MsgSetSpendingLimit{Address: addr0, Denom: fooDenom, DailyLimit: math.NewInt(-1)})
+	require.ErrorContains(err, "cannot be negative")
+
+	// a first limit takes effect immediately
+	res, err := suite.msgServer.SetSpendingLimit(ctx, &banktypes.MsgSetSpendingLimit{Address: addr0, Denom: fooDenom, DailyLimit: math.NewInt(100)})
+	require.NoError(err)
+	require.Equal(now, res.EffectiveTime)
+
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(60), newBarCoin(500))))
+	require.ErrorIs(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(41))), banktypes.ErrSpendingLimitExceeded)
+	cacheCtx, _ := ctx.CacheContext()
+	err = suite.bankKeeper.InputOutputCoins(cacheCtx, banktypes.NewInput(accAddrs[0], sdk.NewCoins(newFooCoin(50))), []banktypes.Output{
+		banktypes.NewOutput(accAddrs[1], sdk.NewCoins(newFooCoin(20))),
+		banktypes.NewOutput(accAddrs[1], sdk.NewCoins(newFooCoin(30))),
+	})
+	require.ErrorIs(err, banktypes.ErrSpendingLimitExceeded)
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(40))))
+
+	// sending to a module account is not limited
+	suite.authKeeper.EXPECT().GetAccount(gomock.Any(), holderAcc.GetAddress()).Return(holderAcc).AnyTimes()
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], holderAcc.GetAddress(), sdk.NewCoins(newFooCoin(10))))
+
+	// raising the limit is delayed, while the spending is reset every 24 hours
+	res, err = suite.msgServer.SetSpendingLimit(ctx, &banktypes.MsgSetSpendingLimit{Address: addr0, Denom: fooDenom, DailyLimit: math.NewInt(300)})
+	require.NoError(err)
+	require.Equal(now.Add(banktypes.DefaultSpendingLimitChangeDelay), res.EffectiveTime)
+
+	ctx = ctx.WithHeaderInfo(header.Info{Time: now.Add(12 * time.Hour)})
+	require.ErrorIs(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(1))), banktypes.ErrSpendingLimitExceeded)
+
+	ctx = ctx.WithHeaderInfo(header.Info{Time: now.Add(24 * time.Hour)})
+	queryRes, err := suite.mockQueryClient(ctx).SpendingLimits(ctx, &banktypes.QuerySpendingLimitsRequest{Address: addr0})
+	require.NoError(err)
+	require.Len(queryRes.SpendingLimits, 1)
+	require.Equal(math.NewInt(300), queryRes.SpendingLimits[0].DailyLimit)
+	require.True(queryRes.SpendingLimits[0].Spent.IsZero())
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(300))))
+
+	// lowering the limit takes effect immediately and cancels a pending change
+	_, err = suite.msgServer.SetSpendingLimit(ctx, &banktypes.MsgSetSpendingLimit{Address: addr0, Denom: fooDenom, DailyLimit: math.ZeroInt()})
+	require.NoError(err)
+	_, err = suite.msgServer.SetSpendingLimit(ctx, &banktypes.MsgSetSpendingLimit{Address: addr0, Denom: fooDenom, DailyLimit: math.NewInt(200)})
+	require.NoError(err)
+	limits, err := suite.bankKeeper.GetAllSpendingLimits(ctx)
+	require.NoError(err)
+	require.Len(limits, 1)
+	require.Equal(math.NewInt(200), limits[0].DailyLimit)
+	require.Nil(limits[0].PendingTime)
+
+	// a removed limit is dropped once the delay elapsed
+	_, err = suite.msgServer.SetSpendingLimit(ctx, &banktypes.MsgSetSpendingLimit{Address: addr0, Denom: fooDenom, DailyLimit: math.ZeroInt()})
+	require.NoError(err)
+	ctx = ctx.WithHeaderInfo(header.Info{Time: now.Add(48 * time.Hour)})
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(500))))
+	limits, err = suite.bankKeeper.GetAllSpendingLimits(ctx)
+	require.NoError(err)
+	require.Empty(limits)
+}
+
 func (suite *KeeperTestSuite) TestDelegateCoins_Invalid() {
 	ctx := suite.ctx
 	require := suite.Require()
@@ -1886,6 +2422,182 @@ func (suite *KeeperTestSuite) TestBalanceTrackingEvents() {
 	})
 }
 
+func (suite *KeeperTestSuite) TestBalanceAlerts() {
+	require := suite.Require()
+	ctx := sdk.UnwrapSDKContext(suite.ctx)
+
+	// balance alerts can only be registered on module accounts
+	suite.authKeeper.EXPECT().GetAccount(suite.ctx, baseAcc.GetAddress()).Return(baseAcc)
+	err := suite.bankKeeper.SetBalanceAlert(suite.ctx, banktypes.NewBalanceAlert(baseAcc.GetAddress().String(), fooDenom, math.NewInt(100)))
+	require.ErrorContains(err, "is not a module account")
+
+	suite.authKeeper.EXPECT().GetAccount(suite.ctx, multiPermAcc.GetAddress()).Return(multiPermAcc)
+	alert := banktypes.NewBalanceAlert(multiPermAcc.GetAddress().String(), fooDenom, math.NewInt(100))
+	require.NoError(suite.bankKeeper.SetBalanceAlert(suite.ctx, alert))
+
+	alerts, err := suite.bankKeeper.GetAllBalanceAlerts(suite.ctx)
+	require.NoError(err)
+	require.Equal([]banktypes.BalanceAlert{alert}, alerts)
+
+	balanceAlertEvents := func() []abci.Event {
+		var events []abci.Event
+		for _, e := range ctx.EventManager().ABCIEvents() {
+			if e.Type == "cosmos.bank.v1beta1.EventBalanceAlert" {
+				events = append(events, e)
+			}
+		}
+		return events
+	}
+
+	// minting above the threshold emits an alert
+	suite.mockMintCoins(multiPermAcc)
+	require.NoError(suite.bankKeeper.MintCoins(suite.ctx, multiPermAcc.Name, sdk.NewCoins(newFooCoin(150))))
+	events := balanceAlertEvents()
+	require.Len(events, 1)
+	require.Contains(events[0].Attributes, abci.EventAttribute{Key: "below", Value: "false"})
+
+	// staying above the threshold does not emit an alert
+	suite.mockMintCoins(multiPermAcc)
+	require.NoError(suite.bankKeeper.MintCoins(suite.ctx, multiPermAcc.Name, sdk.NewCoins(newFooCoin(50), newBarCoin(50))))
+	require.Len(balanceAlertEvents(), 1)
+
+	// sending below the threshold emits an alert
+	suite.mockSendCoinsFromModuleToAccount(multiPermAcc, accAddrs[0])
+	require.NoError(suite.bankKeeper.SendCoinsFromModuleToAccount(suite.ctx, multiPermAcc.Name, accAddrs[0], sdk.NewCoins(newFooCoin(150))))
+	events = balanceAlertEvents()
+	require.Len(events, 2)
+	require.Contains(events[1].Attributes, abci.EventAttribute{Key: "below", Value: "true"})
+	require.Contains(events[1].Attributes, abci.EventAttribute{Key: "balance", Value: `"50"`})
+
+	// a zero threshold deletes the alert
+	require.NoError(suite.bankKeeper.SetBalanceAlert(suite.ctx, banktypes.NewBalanceAlert(alert.Address, fooDenom, math.ZeroInt())))
+	alerts, err = suite.bankKeeper.GetAllBalanceAlerts(suite.ctx)
+	require.NoError(err)
+	require.Empty(alerts)
+}
+
+func (suite *KeeperTestSuite) TestSendRestrictionPolicies() {
+	require := suite.Require()
+	ctx := suite.ctx
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(100))))
+	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[0]).Return(acc0).AnyTimes()
+	suite.authKeeper.EXPECT().GetAccount(ctx, accAddrs[1]).Return(nil).AnyTimes()
+
+	blocklist := banktypes.SendRestrictionPolicy{
+		Name:            "blocklist",
+		RestrictionType: banktypes.SEND_RESTRICTION_TYPE_ADDRESS_BLOCKLIST,
+		Enabled:         true,
+		Addresses:       []string{accAddrs[1].String()},
+	}
+	require.NoError(suite.bankKeeper.SetSendRestrictionPolicy(ctx, blocklist))
+	err := suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10)))
+	require.ErrorIs(err, banktypes.ErrSendRestricted)
+	err = suite.bankKeeper.InputOutputCoins(ctx,
+		banktypes.NewInput(accAddrs[0], sdk.NewCoins(newFooCoin(10))),
+		[]banktypes.Output{banktypes.NewOutput(accAddrs[1], sdk.NewCoins(newFooCoin(10)))},
+	)
+	require.ErrorIs(err, banktypes.ErrSendRestricted)
+
+	// a disabled policy is not enforced
+	blocklist.Enabled = false
+	require.NoError(suite.bankKeeper.SetSendRestrictionPolicy(ctx, blocklist))
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+
+	// a denom freeze only blocks the frozen denoms of the listed holders
+	freeze := banktypes.SendRestrictionPolicy{
+		Name:            "freeze",
+		RestrictionType: banktypes.SEND_RESTRICTION_TYPE_DENOM_FREEZE,
+		Enabled:         true,
+		Addresses:       []string{accAddrs[0].String()},
+		Denoms:          []string{fooDenom},
+	}
+	require.NoError(suite.bankKeeper.SetSendRestrictionPolicy(ctx, freeze))
+	err = suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10), newBarCoin(10)))
+	require.ErrorIs(err, banktypes.ErrSendRestricted)
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newBarCoin(10))))
+	require.NoError(suite.bankKeeper.RemoveSendRestrictionPolicy(ctx, freeze.Name))
+	require.ErrorIs(suite.bankKeeper.RemoveSendRestrictionPolicy(ctx, freeze.Name), banktypes.ErrSendRestrictionPolicyNotFound)
+
+	// a transfer pause still allows transfers from and to module accounts
+	pause := banktypes.SendRestrictionPolicy{
+		Name:            "pause",
+		RestrictionType: banktypes.SEND_RESTRICTION_TYPE_TRANSFER_PAUSE,
+		Enabled:         true,
+		Denoms:          []string{barDenom},
+	}
+	require.NoError(suite.bankKeeper.SetSendRestrictionPolicy(ctx, pause))
+	err = suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newBarCoin(10)))
+	require.ErrorIs(err, banktypes.ErrSendRestricted)
+	suite.mockFundAccount(accAddrs[0])
+	suite.authKeeper.EXPECT().GetAccount(ctx, mintAcc.GetAddress()).Return(mintAcc)
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newBarCoin(10))))
+
+	// only the allowed sends reached the recipient
+	require.Equal(sdk.NewCoins(newFooCoin(10), newBarCoin(10)), suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+}
+
+func (suite *KeeperTestSuite) TestBalanceJournal() {
+	require := suite.Require()
+	now := time.Now()
+
+	params := banktypes.DefaultParams()
+	params.BalanceJournalAddresses = []string{accAddrs[0].String()}
+	params.BalanceJournalRetention = 10
+	require.NoError(suite.bankKeeper.SetParams(suite.ctx, params))
+
+	suite.ctx = sdk.UnwrapSDKContext(suite.ctx).WithHeaderInfo(header.Info{Height: 5, Time: now})
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(suite.ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(50))))
+
+	suite.ctx = sdk.UnwrapSDKContext(suite.ctx).WithHeaderInfo(header.Info{Height: 8, Time: now})
+	suite.authKeeper.EXPECT().GetAccount(suite.ctx, accAddrs[0]).Return(authtypes.NewBaseAccountWithAddress(accAddrs[0]))
+	require.NoError(suite.bankKeeper.SendCoins(suite.ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+
+	// only the balance changes of the tracked address are recorded
+	expected := []banktypes.BalanceJournalEntry{
+		{Address: accAddrs[0].String(), Height: 5, Denom: barDenom, Delta: math.NewInt(50), Reason: banktypes.BalanceChangeReasonSend},
+		{Address: accAddrs[0].String(), Height: 5, Denom: fooDenom, Delta: math.NewInt(100), Reason: banktypes.BalanceChangeReasonSend},
+		{Address: accAddrs[0].String(), Height: 8, Denom: fooDenom, Delta: math.NewInt(-10), Reason: banktypes.BalanceChangeReasonSend},
+	}
+	entries, err := suite.bankKeeper.GetAllBalanceJournalEntries(suite.ctx)
+	require.NoError(err)
+	require.Equal(expected, entries)
+
+	// entries are kept for the retention period
+	ctx := sdk.UnwrapSDKContext(suite.ctx).WithHeaderInfo(header.Info{Height: 15, Time: now})
+	require.NoError(suite.bankKeeper.PruneBalanceJournal(ctx))
+	entries, err = suite.bankKeeper.GetAllBalanceJournalEntries(ctx)
+	require.NoError(err)
+	require.Len(entries, 3)
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 16, Time: now})
+	require.NoError(suite.bankKeeper.PruneBalanceJournal(ctx))
+	entries, err = suite.bankKeeper.GetAllBalanceJournalEntries(ctx)
+	require.NoError(err)
+	require.Equal(expected[2:], entries)
+
+	// a zero retention keeps the entries forever
+	params.BalanceJournalRetention = 0
+	require.NoError(suite.bankKeeper.SetParams(ctx, params))
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 100, Time: now})
+	require.NoError(suite.bankKeeper.PruneBalanceJournal(ctx))
+	entries, err = suite.bankKeeper.GetAllBalanceJournalEntries(ctx)
+	require.NoError(err)
+	require.Equal(expected[2:], entries)
+
+	// addresses removed from the params are no longer tracked
+	params.BalanceJournalAddresses = nil
+	require.NoError(suite.bankKeeper.SetParams(suite.ctx, params))
+	suite.authKeeper.EXPECT().GetAccount(suite.ctx, accAddrs[0]).Return(authtypes.NewBaseAccountWithAddress(accAddrs[0]))
+	require.NoError(suite.bankKeeper.SendCoins(suite.ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+	entries, err = suite.bankKeeper.GetAllBalanceJournalEntries(suite.ctx)
+	require.NoError(err)
+	require.Len(entries, 1)
+}
+
 func (suite *KeeperTestSuite) getTestMetadata() []banktypes.Metadata {
 	return []banktypes.Metadata{
 		{
@@ -2359,3 +3071,48 @@ func (suite *KeeperTestSuite) TestSetParams() {
 		}
 	})
 }
+
+func (suite *KeeperTestSuite) TestModuleTransferTrace() {
+	ctx := suite.ctx
+	require := suite.Require()
+	keeper := suite.bankKeeper
+
+	// minting is not a transfer between module accounts
+	suite.mockMintCoins(mintAcc)
+	require.NoError(keeper.MintCoins(ctx, banktypes.MintModuleName, initCoins))
+
+	transfers, err := keeper.GetModuleTransferTrace(ctx)
+	require.NoError(err)
+	require.Empty(transfers)
+
+	suite.mockSendCoinsFromModuleToModule(mintAcc, holderAcc)
+	require.NoError(keeper.SendCoinsFromModuleToModule(ctx, banktypes.MintModuleName, holder, initCoins))
+	suite.mockSendCoinsFromModuleToModule(holderAcc, burnerAcc)
+	require.NoError(keeper.SendCoinsFromModuleToModule(ctx, holder, authtypes.Burner, initCoins))
+
+	// a failed transfer is not traced
+	suite.authKeeper.EXPECT().GetModuleAddress(holder).Return(holderAcc.GetAddress())
+	suite.authKeeper.EXPECT().GetModuleAccount(ctx, authtypes.Burner).Return(burnerAcc)
+	suite.authKeeper.EXPECT().GetAccount(ctx, holderAcc.GetAddress()).Return(holderAcc)
+	require.Error(keeper.SendCoinsFromModuleToModule(ctx, holder, authtypes.Burner, initCoins))
+
+	// the transfers are traced in execution order, the calling module being
+	// the bank module for the keeper tests
+	expected := []banktypes.ModuleTransfer{
+		{FromModule: banktypes.MintModuleName, ToModule: holder, Amount: initCoins, CallerModule: banktypes.ModuleName},
+		{FromModule: holder, ToModule: authtypes.Burner, Amount: initCoins, CallerModule: banktypes.ModuleName},
+	}
+	transfers, err = keeper.GetModuleTransferTrace(ctx)
+	require.NoError(err)
+	require.Equal(expected, transfers)
+
+	// the trace is emitted at the end of the block
+	require.NoError(keeper.EmitModuleTransferTrace(ctx))
+	events := sdk.UnwrapSDKContext(ctx).EventManager().Events()
+	event := events[len(events)-1]
+	require.Equal("cosmos.bank.v1beta1.EventModuleTransferTrace", event.Type)
+
+	msg, err := sdk.ParseTypedEvent(abci.Event(event))
+	require.NoError(err)
+	require.Equal(expected, msg.(*banktypes.EventModuleTransferTrace).Transfers)
+}
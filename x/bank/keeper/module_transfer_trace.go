@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"context"
+	"runtime"
+	"strings"
+
+	"github.com/hashicorp/go-metrics"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// moduleTransferTrace houses the transient store of the transfers between
+// module accounts traced within a block.
+// It exists so that the store can be set in the BaseKeeper without needing to have a pointer receiver.
+type moduleTransferTrace struct {
+	enabled   bool
+	transfers collections.Map[uint64, types.ModuleTransfer]
+	sequence  collections.Sequence
+}
+
+// SetModuleTransferTraceStore enables the tracing of the transfers between
+// module accounts, recorded in the given transient store for the duration of
+// a block.
+func (k BaseKeeper) SetModuleTransferTraceStore(storeService store.TransientStoreService) {
+	if k.moduleTransferTrace.enabled {
+		panic("cannot set module transfer trace store twice")
+	}
+
+	sb := collections.NewSchemaBuilderFromAccessor(storeService.OpenTransientStore)
+	k.moduleTransferTrace.transfers = collections.NewMap(sb, types.ModuleTransfersPrefix, "module_transfers", collections.Uint64Key, codec.CollValue[types.ModuleTransfer](k.cdc))
+	k.moduleTransferTrace.sequence = collections.NewSequence(sb, types.ModuleTransferSequenceKey, "module_transfer_sequence")
+	if _, err := sb.Build(); err != nil {
+		panic(err)
+	}
+
+	k.moduleTransferTrace.enabled = true
+}
+
+// traceModuleTransfer records a transfer between module accounts in the trace
+// of the block. The trace is not charged to the caller.
+func (k BaseKeeper) traceModuleTransfer(ctx context.Context, fromModule, toModule string, amt sdk.Coins) error {
+	if !k.moduleTransferTrace.enabled {
+		return nil
+	}
+
+	ctx = sdk.UnwrapSDKContext(ctx).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	id, err := k.moduleTransferTrace.sequence.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	return k.moduleTransferTrace.transfers.Set(ctx, id, types.ModuleTransfer{
+		FromModule:   fromModule,
+		ToModule:     toModule,
+		Amount:       amt,
+		CallerModule: callerModule(),
+	})
+}
+
+// GetModuleTransferTrace returns the transfers between module accounts traced
+// within the current block, in execution order.
+func (k BaseKeeper) GetModuleTransferTrace(ctx context.Context) ([]types.ModuleTransfer, error) {
+	if !k.moduleTransferTrace.enabled {
+		return nil, nil
+	}
+
+	var transfers []types.ModuleTransfer
+	err := k.moduleTransferTrace.transfers.Walk(ctx, nil, func(_ uint64, transfer types.ModuleTransfer) (bool, error) {
+		transfers = append(transfers, transfer)
+		return false, nil
+	})
+	return transfers, err
+}
+
+// EmitModuleTransferTrace emits the transfers between module accounts traced
+// within the current block as an event, and adds their amounts to the
+// module_transfer telemetry counter.
+func (k BaseKeeper) EmitModuleTransferTrace(ctx context.Context) error {
+	transfers, err := k.GetModuleTransferTrace(ctx)
+	if err != nil || len(transfers) == 0 {
+		return err
+	}
+
+	for _, transfer := range transfers {
+		for _, coin := range transfer.Amount {
+			if coin.Amount.IsInt64() {
+				telemetry.IncrCounterWithLabels(
+					[]string{types.ModuleName, "module_transfer"},
+					float32(coin.Amount.Int64()),
+					[]metrics.Label{
+						telemetry.NewLabel("from", transfer.FromModule),
+						telemetry.NewLabel("to", transfer.ToModule),
+						telemetry.NewLabel("caller", transfer.CallerModule),
+						telemetry.NewLabel("denom", coin.Denom),
+					},
+				)
+			}
+		}
+	}
+
+	return k.environment.EventService.EventManager(ctx).Emit(&types.EventModuleTransferTrace{Transfers: transfers})
+}
+
+// callerModule returns the name of the module which requested a transfer: the
+// module of the first function of the call stack outside of the bank keeper
+// whose package is under an x/<module> path. It returns an empty string if
+// no such function is found.
+func callerModule() string {
+	pcs := make([]uintptr, 32)
+	// skip runtime.Callers, callerModule and traceModuleTransfer
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if !strings.Contains(frame.Function, "/x/bank/keeper.") {
+			if module, ok := moduleFromFunction(frame.Function); ok {
+				return module
+			}
+		}
+
+		if !more {
+			return ""
+		}
+	}
+}
+
+// moduleFromFunction returns the module of a fully qualified function name,
+// e.g. distribution for cosmossdk.io/x/distribution/keeper.Keeper.AllocateTokens.
+func moduleFromFunction(function string) (string, bool) {
+	i := strings.Index(function, "/x/")
+	if i < 0 {
+		return "", false
+	}
+
+	module := function[i+len("/x/"):]
+	if end := strings.IndexAny(module, "/."); end >= 0 {
+		module = module[:end]
+	}
+
+	return module, module != ""
+}
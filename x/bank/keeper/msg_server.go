@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"github.com/hashicorp/go-metrics"
 
@@ -177,6 +178,309 @@ func (k msgServer) SetSendEnabled(ctx context.Context, msg *types.MsgSetSendEnab
 	return &types.MsgSetSendEnabledResponse{}, nil
 }
 
+func (k msgServer) SetBalanceAlerts(ctx context.Context, msg *types.MsgSetBalanceAlerts) (*types.MsgSetBalanceAlertsResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	seen := map[string]bool{}
+	for _, alert := range msg.BalanceAlerts {
+		key := alert.Address + "/" + alert.Denom
+		if _, alreadySeen := seen[key]; alreadySeen {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("duplicate balance alert entries found for %q and %q", alert.Address, alert.Denom)
+		}
+
+		seen[key] = true
+
+		if err := alert.Validate(); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid balance alert for %q: %s", alert.Address, err)
+		}
+	}
+
+	for _, alert := range msg.BalanceAlerts {
+		if err := k.SetBalanceAlert(ctx, alert); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgSetBalanceAlertsResponse{}, nil
+}
+
+func (k msgServer) CreateDenom(ctx context.Context, msg *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
+	denom, err := k.CreateFactoryDenom(ctx, msg.Sender, msg.Subdenom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateDenomResponse{NewTokenDenom: denom}, nil
+}
+
+func (k msgServer) FactoryMint(ctx context.Context, msg *types.MsgFactoryMint) (*types.MsgFactoryMintResponse, error) {
+	if err := validateFactoryAmount(msg.Amount); err != nil {
+		return nil, err
+	}
+
+	mintTo := msg.MintToAddress
+	if mintTo == "" {
+		mintTo = msg.Admin
+	}
+
+	if err := k.Keeper.FactoryMint(ctx, msg.Admin, msg.Amount, mintTo); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFactoryMintResponse{}, nil
+}
+
+func (k msgServer) FactoryBurn(ctx context.Context, msg *types.MsgFactoryBurn) (*types.MsgFactoryBurnResponse, error) {
+	if err := validateFactoryAmount(msg.Amount); err != nil {
+		return nil, err
+	}
+
+	burnFrom := msg.BurnFromAddress
+	if burnFrom == "" {
+		burnFrom = msg.Admin
+	}
+
+	if err := k.Keeper.FactoryBurn(ctx, msg.Admin, msg.Amount, burnFrom); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFactoryBurnResponse{}, nil
+}
+
+func (k msgServer) ForceTransfer(ctx context.Context, msg *types.MsgForceTransfer) (*types.MsgForceTransferResponse, error) {
+	if err := validateFactoryAmount(msg.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.ForceTransfer(ctx, msg.Admin, msg.Amount, msg.FromAddress, msg.ToAddress); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgForceTransferResponse{}, nil
+}
+
+func (k msgServer) ChangeDenomAdmin(ctx context.Context, msg *types.MsgChangeDenomAdmin) (*types.MsgChangeDenomAdminResponse, error) {
+	if err := k.ChangeFactoryDenomAdmin(ctx, msg.Admin, msg.Denom, msg.NewAdmin); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgChangeDenomAdminResponse{}, nil
+}
+
+func (k msgServer) SetFactoryDenomMetadata(ctx context.Context, msg *types.MsgSetFactoryDenomMetadata) (*types.MsgSetFactoryDenomMetadataResponse, error) {
+	if err := k.Keeper.SetFactoryDenomMetadata(ctx, msg.Admin, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetFactoryDenomMetadataResponse{}, nil
+}
+
+// validateFactoryAmount checks that the amount of a token factory message is a
+// valid positive amount.
+func validateFactoryAmount(amount sdk.Coin) error {
+	if !amount.IsValid() || !amount.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amount.String())
+	}
+
+	return nil
+}
+
+func (k msgServer) SetSendRestrictionPolicies(ctx context.Context, msg *types.MsgSetSendRestrictionPolicies) (*types.MsgSetSendRestrictionPoliciesResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	seen := map[string]bool{}
+	for _, policy := range msg.Policies {
+		if _, alreadySeen := seen[policy.Name]; alreadySeen {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("duplicate send restriction policy entries found for %q", policy.Name)
+		}
+
+		seen[policy.Name] = true
+
+		if err := policy.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, name := range msg.Remove {
+		if _, alreadySeen := seen[name]; alreadySeen {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("duplicate send restriction policy entries found for %q", name)
+		}
+
+		seen[name] = true
+	}
+
+	for _, policy := range msg.Policies {
+		if err := k.SetSendRestrictionPolicy(ctx, policy); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, name := range msg.Remove {
+		if err := k.RemoveSendRestrictionPolicy(ctx, name); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgSetSendRestrictionPoliciesResponse{}, nil
+}
+
+func (k msgServer) SetDenomTransferHook(ctx context.Context, msg *types.MsgSetDenomTransferHook) (*types.MsgSetDenomTransferHookResponse, error) {
+	if err := msg.Hook.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.assertAuthorityOrDenomAdmin(ctx, msg.Sender, msg.Hook.Denom); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.SetDenomTransferHook(ctx, msg.Hook); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetDenomTransferHookResponse{}, nil
+}
+
+func (k msgServer) RemoveDenomTransferHook(ctx context.Context, msg *types.MsgRemoveDenomTransferHook) (*types.MsgRemoveDenomTransferHookResponse, error) {
+	if err := k.assertAuthorityOrDenomAdmin(ctx, msg.Sender, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RemoveDenomTransferHook(ctx, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveDenomTransferHookResponse{}, nil
+}
+
+func (k msgServer) SetDenomTransferFee(ctx context.Context, msg *types.MsgSetDenomTransferFee) (*types.MsgSetDenomTransferFeeResponse, error) {
+	if err := msg.Fee.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.assertAuthorityOrDenomAdmin(ctx, msg.Sender, msg.Fee.Denom); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.SetDenomTransferFee(ctx, msg.Fee); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetDenomTransferFeeResponse{}, nil
+}
+
+func (k msgServer) RemoveDenomTransferFee(ctx context.Context, msg *types.MsgRemoveDenomTransferFee) (*types.MsgRemoveDenomTransferFeeResponse, error) {
+	if err := k.assertAuthorityOrDenomAdmin(ctx, msg.Sender, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RemoveDenomTransferFee(ctx, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveDenomTransferFeeResponse{}, nil
+}
+
+// assertAuthorityOrDenomAdmin checks that the sender can manage the denom: it
+// must be the authority, or the admin of the factory denom.
+func (k msgServer) assertAuthorityOrDenomAdmin(ctx context.Context, sender, denom string) error {
+	if sender == k.GetAuthority() {
+		return nil
+	}
+
+	factoryDenom, err := k.GetFactoryDenom(ctx, denom)
+	if err != nil && !errors.Is(err, types.ErrFactoryDenomNotFound) && !errors.Is(err, types.ErrInvalidFactoryDenom) {
+		return err
+	}
+	if err != nil || factoryDenom.Admin == "" || factoryDenom.Admin != sender {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is neither the authority nor the admin of %s", sender, denom)
+	}
+
+	return nil
+}
+
+func (k msgServer) SetDenomMetadata(ctx context.Context, msg *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
+	if err := k.assertAuthorityOrDenomAdmin(ctx, msg.Sender, msg.Metadata.Base); err != nil {
+		return nil, err
+	}
+
+	if err := k.RegisterDenomMetadata(ctx, msg.Sender, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetDenomMetadataResponse{}, nil
+}
+
+func (k msgServer) UpdateDenomMetadata(ctx context.Context, msg *types.MsgUpdateDenomMetadata) (*types.MsgUpdateDenomMetadataResponse, error) {
+	if err := k.assertAuthorityOrDenomAdmin(ctx, msg.Sender, msg.Metadata.Base); err != nil {
+		return nil, err
+	}
+
+	if err := k.ReplaceDenomMetadata(ctx, msg.Sender, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateDenomMetadataResponse{}, nil
+}
+
+func (k msgServer) SweepDust(ctx context.Context, msg *types.MsgSweepDust) (*types.MsgSweepDustResponse, error) {
+	if len(msg.Addresses) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no address to sweep")
+	}
+
+	swept, amount, err := k.Keeper.SweepDust(ctx, msg.Sender, msg.Addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSweepDustResponse{SweptAddresses: swept, Amount: amount}, nil
+}
+
+func (k msgServer) SetSpendingLimit(ctx context.Context, msg *types.MsgSetSpendingLimit) (*types.MsgSetSpendingLimitResponse, error) {
+	effectiveTime, err := k.Keeper.UpdateSpendingLimit(ctx, msg.Address, msg.Denom, msg.DailyLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetSpendingLimitResponse{EffectiveTime: effectiveTime}, nil
+}
+
+func (k msgServer) SetNonCirculatingAddresses(ctx context.Context, msg *types.MsgSetNonCirculatingAddresses) (*types.MsgSetNonCirculatingAddressesResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	if len(msg.Add) == 0 && len(msg.Remove) == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("no addresses to add or remove")
+	}
+
+	seen := map[string]bool{}
+	for _, addr := range append(append([]string{}, msg.Add...), msg.Remove...) {
+		if _, alreadySeen := seen[addr]; alreadySeen {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("duplicate non-circulating address entries found for %q", addr)
+		}
+
+		seen[addr] = true
+	}
+
+	for _, addr := range msg.Add {
+		if err := k.SetNonCirculatingAddress(ctx, addr); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, addr := range msg.Remove {
+		if err := k.RemoveNonCirculatingAddress(ctx, addr); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgSetNonCirculatingAddressesResponse{}, nil
+}
+
 func (k msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
 	var (
 		from []byte
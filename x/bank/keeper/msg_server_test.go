@@ -1,10 +1,12 @@
 package keeper_test
 
 import (
+	"cosmossdk.io/math"
 	authtypes "cosmossdk.io/x/auth/types"
 	banktypes "cosmossdk.io/x/bank/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var govAcc = authtypes.NewEmptyModuleAccount(banktypes.GovModuleName, authtypes.Minter)
@@ -363,6 +365,239 @@ func (suite *KeeperTestSuite) TestMsgSetSendEnabled() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgSetBalanceAlerts() {
+	suite.authKeeper.EXPECT().GetAccount(suite.ctx, multiPermAcc.GetAddress()).Return(multiPermAcc).AnyTimes()
+	suite.authKeeper.EXPECT().GetAccount(suite.ctx, baseAcc.GetAddress()).Return(baseAcc).AnyTimes()
+
+	testCases := []struct {
+		name     string
+		req      *banktypes.MsgSetBalanceAlerts
+		isExpErr bool
+		errMsg   string
+	}{
+		{
+			name: "all good",
+			req: banktypes.NewMsgSetBalanceAlerts(
+				govAcc.GetAddress().String(),
+				[]banktypes.BalanceAlert{
+					banktypes.NewBalanceAlert(multiPermAcc.GetAddress().String(), fooDenom, math.NewInt(100)),
+					banktypes.NewBalanceAlert(multiPermAcc.GetAddress().String(), barDenom, math.ZeroInt()),
+				},
+			),
+		},
+		{
+			name: "duplicate entries",
+			req: banktypes.NewMsgSetBalanceAlerts(
+				govAcc.GetAddress().String(),
+				[]banktypes.BalanceAlert{
+					banktypes.NewBalanceAlert(multiPermAcc.GetAddress().String(), fooDenom, math.NewInt(100)),
+					banktypes.NewBalanceAlert(multiPermAcc.GetAddress().String(), fooDenom, math.NewInt(200)),
+				},
+			),
+			isExpErr: true,
+			errMsg:   "duplicate balance alert entries found",
+		},
+		{
+			name: "invalid denom",
+			req: banktypes.NewMsgSetBalanceAlerts(
+				govAcc.GetAddress().String(),
+				[]banktypes.BalanceAlert{
+					banktypes.NewBalanceAlert(multiPermAcc.GetAddress().String(), "not a denom", math.NewInt(100)),
+				},
+			),
+			isExpErr: true,
+			errMsg:   "invalid denom: not a denom",
+		},
+		{
+			name: "negative threshold",
+			req: banktypes.NewMsgSetBalanceAlerts(
+				govAcc.GetAddress().String(),
+				[]banktypes.BalanceAlert{
+					banktypes.NewBalanceAlert(multiPermAcc.GetAddress().String(), fooDenom, math.NewInt(-1)),
+				},
+			),
+			isExpErr: true,
+			errMsg:   "balance alert threshold cannot be nil or negative",
+		},
+		{
+			name: "not a module account",
+			req: banktypes.NewMsgSetBalanceAlerts(
+				govAcc.GetAddress().String(),
+				[]banktypes.BalanceAlert{
+					banktypes.NewBalanceAlert(baseAcc.GetAddress().String(), fooDenom, math.NewInt(100)),
+				},
+			),
+			isExpErr: true,
+			errMsg:   "is not a module account",
+		},
+		{
+			name: "invalid authority",
+			req: banktypes.NewMsgSetBalanceAlerts(
+				"invalid",
+				[]banktypes.BalanceAlert{
+					banktypes.NewBalanceAlert(multiPermAcc.GetAddress().String(), fooDenom, math.NewInt(100)),
+				},
+			),
+			isExpErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.SetBalanceAlerts(suite.ctx, tc.req)
+
+			if tc.isExpErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgSetSendRestrictionPolicies() {
+	freeze := banktypes.SendRestrictionPolicy{
+		Name:            "freeze",
+		RestrictionType: banktypes.SEND_RESTRICTION_TYPE_DENOM_FREEZE,
+		Enabled:         true,
+		Denoms:          []string{fooDenom},
+	}
+	blocklist := banktypes.SendRestrictionPolicy{
+		Name:            "blocklist",
+		RestrictionType: banktypes.SEND_RESTRICTION_TYPE_ADDRESS_BLOCKLIST,
+		Enabled:         true,
+		Addresses:       []string{baseAcc.GetAddress().String()},
+	}
+
+	testCases := []struct {
+		name     string
+		req      *banktypes.MsgSetSendRestrictionPolicies
+		isExpErr bool
+		errMsg   string
+	}{
+		{
+			name: "all good",
+			req:  banktypes.NewMsgSetSendRestrictionPolicies(govAcc.GetAddress().String(), []banktypes.SendRestrictionPolicy{freeze, blocklist}, nil),
+		},
+		{
+			name: "remove",
+			req:  banktypes.NewMsgSetSendRestrictionPolicies(govAcc.GetAddress().String(), nil, []string{freeze.Name}),
+		},
+		{
+			name:     "remove unknown policy",
+			req:      banktypes.NewMsgSetSendRestrictionPolicies(govAcc.GetAddress().String(), nil, []string{freeze.Name}),
+			isExpErr: true,
+			errMsg:   "send restriction policy not found",
+		},
+		{
+			name:     "duplicate entries",
+			req:      banktypes.NewMsgSetSendRestrictionPolicies(govAcc.GetAddress().String(), []banktypes.SendRestrictionPolicy{freeze}, []string{freeze.Name}),
+			isExpErr: true,
+			errMsg:   "duplicate send restriction policy entries found",
+		},
+		{
+			name: "invalid policy",
+			req: banktypes.NewMsgSetSendRestrictionPolicies(govAcc.GetAddress().String(), []banktypes.SendRestrictionPolicy{
+				{Name: "pause", RestrictionType: banktypes.SEND_RESTRICTION_TYPE_TRANSFER_PAUSE, Enabled: true},
+			}, nil),
+			isExpErr: true,
+			errMsg:   "transfer pause pause must have denoms",
+		},
+		{
+			name:     "invalid authority",
+			req:      banktypes.NewMsgSetSendRestrictionPolicies("invalid", []banktypes.SendRestrictionPolicy{freeze}, nil),
+			isExpErr: true,
+			errMsg:   "invalid authority",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.SetSendRestrictionPolicies(suite.ctx, tc.req)
+
+			if tc.isExpErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+
+	policies, err := suite.bankKeeper.GetAllSendRestrictionPolicies(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]banktypes.SendRestrictionPolicy{blocklist}, policies)
+}
+
+func (suite *KeeperTestSuite) TestMsgSetNonCirculatingAddresses() {
+	treasury := accAddrs[0].String()
+	reserve := accAddrs[1].String()
+
+	testCases := []struct {
+		name     string
+		req      *banktypes.MsgSetNonCirculatingAddresses
+		isExpErr bool
+		errMsg   string
+	}{
+		{
+			name: "all good",
+			req:  banktypes.NewMsgSetNonCirculatingAddresses(govAcc.GetAddress().String(), []string{treasury, reserve}, nil),
+		},
+		{
+			name: "remove",
+			req:  banktypes.NewMsgSetNonCirculatingAddresses(govAcc.GetAddress().String(), nil, []string{treasury}),
+		},
+		{
+			name:     "remove unknown address",
+			req:      banktypes.NewMsgSetNonCirculatingAddresses(govAcc.GetAddress().String(), nil, []string{treasury}),
+			isExpErr: true,
+			errMsg:   "is not a non-circulating address",
+		},
+		{
+			name:     "duplicate address",
+			req:      banktypes.NewMsgSetNonCirculatingAddresses(govAcc.GetAddress().String(), []string{treasury}, []string{treasury}),
+			isExpErr: true,
+			errMsg:   "duplicate non-circulating address entries found",
+		},
+		{
+			name:     "invalid address",
+			req:      banktypes.NewMsgSetNonCirculatingAddresses(govAcc.GetAddress().String(), []string{"invalid"}, nil),
+			isExpErr: true,
+			errMsg:   "invalid non-circulating address",
+		},
+		{
+			name:     "nothing to set",
+			req:      banktypes.NewMsgSetNonCirculatingAddresses(govAcc.GetAddress().String(), nil, nil),
+			isExpErr: true,
+			errMsg:   "no addresses to add or remove",
+		},
+		{
+			name:     "invalid authority",
+			req:      banktypes.NewMsgSetNonCirculatingAddresses(treasury, []string{reserve}, nil),
+			isExpErr: true,
+			errMsg:   "invalid authority",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.SetNonCirculatingAddresses(suite.ctx, tc.req)
+
+			if tc.isExpErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+
+	addresses, err := suite.bankKeeper.GetAllNonCirculatingAddresses(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{reserve}, addresses)
+}
+
 func (suite *KeeperTestSuite) TestMsgBurn() {
 	origCoins := sdk.NewInt64Coin("eth", 100)
 	atom0 := sdk.NewInt64Coin("atom", 0)
@@ -421,3 +656,336 @@ func (suite *KeeperTestSuite) TestMsgBurn() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgTokenFactory() {
+	ctx := suite.ctx
+	require := suite.Require()
+	creator, holder, other := accAddrs[0], accAddrs[1], accAddrs[2]
+	holderAcc := authtypes.NewBaseAccountWithAddress(holder)
+
+	res, err := suite.msgServer.CreateDenom(ctx, &banktypes.MsgCreateDenom{Sender: creator.String(), Subdenom: "bitcoin"})
+	require.NoError(err)
+	denom := res.NewTokenDenom
+	require.Equal("factory/"+creator.String()+"/bitcoin", denom)
+
+	metadata, found := suite.bankKeeper.GetDenomMetaData(ctx, denom)
+	require.True(found)
+	require.Equal(denom, metadata.Base)
+
+	_, err = suite.msgServer.CreateDenom(ctx, &banktypes.MsgCreateDenom{Sender: creator.String(), Subdenom: "bitcoin"})
+	require.ErrorIs(err, banktypes.ErrFactoryDenomExists)
+	_, err = suite.msgServer.CreateDenom(ctx, &banktypes.MsgCreateDenom{Sender: creator.String(), Subdenom: ""})
+	require.ErrorIs(err, banktypes.ErrInvalidFactoryDenom)
+
+	// only the admin can mint
+	_, err = suite.msgServer.FactoryMint(ctx, &banktypes.MsgFactoryMint{Admin: other.String(), Amount: sdk.NewInt64Coin(denom, 100)})
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.FactoryMint(ctx, &banktypes.MsgFactoryMint{Admin: creator.String(), Amount: sdk.NewInt64Coin("stake", 100)})
+	require.ErrorIs(err, banktypes.ErrInvalidFactoryDenom)
+	_, err = suite.msgServer.FactoryMint(ctx, &banktypes.MsgFactoryMint{Admin: creator.String(), Amount: sdk.NewInt64Coin(denom, 0)})
+	require.ErrorIs(err, sdkerrors.ErrInvalidCoins)
+	_, err = suite.msgServer.FactoryMint(ctx, &banktypes.MsgFactoryMint{Admin: creator.String(), Amount: sdk.NewInt64Coin(denom, 100), MintToAddress: accAddrs[4].String()})
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = suite.msgServer.FactoryMint(ctx, &banktypes.MsgFactoryMint{Admin: creator.String(), Amount: sdk.NewInt64Coin(denom, 100)})
+	require.NoError(err)
+	_, err = suite.msgServer.FactoryMint(ctx, &banktypes.MsgFactoryMint{Admin: creator.String(), Amount: sdk.NewInt64Coin(denom, 50), MintToAddress: holder.String()})
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(denom, 100), suite.bankKeeper.GetBalance(ctx, creator, denom))
+	require.Equal(sdk.NewInt64Coin(denom, 50), suite.bankKeeper.GetBalance(ctx, holder, denom))
+	require.Equal(sdk.NewInt64Coin(denom, 150), suite.bankKeeper.GetSupply(ctx, denom))
+
+	// the admin can move and burn the coins of any account
+	suite.authKeeper.EXPECT().GetAccount(ctx, holder).Return(holderAcc)
+	suite.authKeeper.EXPECT().GetAccount(ctx, other).Return(authtypes.NewBaseAccountWithAddress(other))
+	suite.mockSendCoins(ctx, holderAcc, other)
+	_, err = suite.msgServer.ForceTransfer(ctx, &banktypes.MsgForceTransfer{Admin: creator.String(), Amount: sdk.NewInt64Coin(denom, 20), FromAddress: holder.String(), ToAddress: other.String()})
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(denom, 30), suite.bankKeeper.GetBalance(ctx, holder, denom))
+	require.Equal(sdk.NewInt64Coin(denom, 20), suite.bankKeeper.GetBalance(ctx, other, denom))
+
+	suite.authKeeper.EXPECT().GetAccount(ctx, holder).Return(holderAcc)
+	suite.mockValidateBalance(holderAcc)
+	_, err = suite.msgServer.FactoryBurn(ctx, &banktypes.MsgFactoryBurn{Admin: creator.String(), Amount: sdk.NewInt64Coin(denom, 30), BurnFromAddress: holder.String()})
+	require.NoError(err)
+	require.True(suite.bankKeeper.GetBalance(ctx, holder, denom).IsZero())
+	require.Equal(sdk.NewInt64Coin(denom, 120), suite.bankKeeper.GetSupply(ctx, denom))
+
+	// but not the coins escrowed by module accounts, nor send coins to them
+	escrowAcc := authtypes.NewEmptyModuleAccount("escrow")
+	suite.authKeeper.EXPECT().GetAccount(ctx, escrowAcc.GetAddress()).Return(escrowAcc).Times(3)
+	_, err = suite.msgServer.ForceTransfer(ctx, &banktypes.MsgForceTransfer{Admin: creator.String(), Amount: sdk.NewInt64Coin(denom, 20), FromAddress: escrowAcc.GetAddress().String(), ToAddress: other.String()})
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.FactoryBurn(ctx, &banktypes.MsgFactoryBurn{Admin: creator.String(), Amount: sdk.NewInt64Coin(denom, 20), BurnFromAddress: escrowAcc.GetAddress().String()})
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.authKeeper.EXPECT().GetAccount(ctx, other).Return(authtypes.NewBaseAccountWithAddress(other))
+	_, err = suite.msgServer.ForceTransfer(ctx, &banktypes.MsgForceTransfer{Admin: creator.String(), Amount: sdk.NewInt64Coin(denom, 20), FromAddress: other.String(), ToAddress: escrowAcc.GetAddress().String()})
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.FactoryBurn(ctx, &banktypes.MsgFactoryBurn{Admin: creator.String(), Amount: sdk.NewInt64Coin(denom, 20), BurnFromAddress: accAddrs[4].String()})
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = suite.msgServer.ForceTransfer(ctx, &banktypes.MsgForceTransfer{Admin: other.String(), Amount: sdk.NewInt64Coin(denom, 20), FromAddress: other.String(), ToAddress: holder.String()})
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.FactoryBurn(ctx, &banktypes.MsgFactoryBurn{Admin: other.String(), Amount: sdk.NewInt64Coin(denom, 20)})
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// metadata
+	metadata.Display = "BTC"
+	metadata.DenomUnits = append(metadata.DenomUnits, &banktypes.DenomUnit{Denom: "BTC", Exponent: 8})
+	_, err = suite.msgServer.SetFactoryDenomMetadata(ctx, &banktypes.MsgSetFactoryDenomMetadata{Admin: other.String(), Metadata: metadata})
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.SetFactoryDenomMetadata(ctx, &banktypes.MsgSetFactoryDenomMetadata{Admin: creator.String(), Metadata: metadata})
+	require.NoError(err)
+	got, found := suite.bankKeeper.GetDenomMetaData(ctx, denom)
+	require.True(found)
+	require.Equal("BTC", got.Display)
+
+	// admin change and renouncement
+	_, err = suite.msgServer.ChangeDenomAdmin(ctx, &banktypes.MsgChangeDenomAdmin{Admin: other.String(), Denom: denom, NewAdmin: other.String()})
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.ChangeDenomAdmin(ctx, &banktypes.MsgChangeDenomAdmin{Admin: creator.String(), Denom: denom, NewAdmin: other.String()})
+	require.NoError(err)
+	_, err = suite.msgServer.FactoryMint(ctx, &banktypes.MsgFactoryMint{Admin: creator.String(), Amount: sdk.NewInt64Coin(denom, 1)})
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.FactoryMint(ctx, &banktypes.MsgFactoryMint{Admin: other.String(), Amount: sdk.NewInt64Coin(denom, 1)})
+	require.NoError(err)
+
+	_, err = suite.msgServer.ChangeDenomAdmin(ctx, &banktypes.MsgChangeDenomAdmin{Admin: other.String(), Denom: denom})
+	require.NoError(err)
+	_, err = suite.msgServer.FactoryMint(ctx, &banktypes.MsgFactoryMint{Admin: other.String(), Amount: sdk.NewInt64Coin(denom, 1)})
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	factoryDenom, err := suite.bankKeeper.GetFactoryDenom(ctx, denom)
+	require.NoError(err)
+	require.Equal(banktypes.FactoryDenom{Denom: denom}, factoryDenom)
+}
+
+func (suite *KeeperTestSuite) TestMsgDenomTransferHooks() {
+	ctx := suite.ctx
+	require := suite.Require()
+	creator, other := accAddrs[0], accAddrs[1]
+	authority := suite.bankKeeper.GetAuthority()
+
+	res, err := suite.msgServer.CreateDenom(ctx, &banktypes.MsgCreateDenom{Sender: creator.String(), Subdenom: "hooked"})
+	require.NoError(err)
+	denom := res.NewTokenDenom
+	hook := banktypes.DenomTransferHook{Denom: denom, Target: other.String(), BeforeTransfer: true, GasLimit: 50_000}
+	suite.bankKeeper.SetTransferHooks(banktypes.NewTransferHooksRouter(&transferHooks{}))
+
+	testCases := []struct {
+		name      string
+		sender    string
+		hook      banktypes.DenomTransferHook
+		expErrMsg string
+	}{
+		{
+			name:      "invalid gas limit",
+			sender:    creator.String(),
+			hook:      banktypes.DenomTransferHook{Denom: denom, Target: other.String(), BeforeTransfer: true, GasLimit: banktypes.MaxTransferHookGasLimit + 1},
+			expErrMsg: "gas limit",
+		},
+		{
+			name:      "not the admin",
+			sender:    other.String(),
+			hook:      hook,
+			expErrMsg: "neither the authority nor the admin",
+		},
+		{
+			name:      "not a factory denom",
+			sender:    creator.String(),
+			hook:      banktypes.DenomTransferHook{Denom: fooDenom, Target: other.String(), BeforeTransfer: true, GasLimit: 50_000},
+			expErrMsg: "neither the authority nor the admin",
+		},
+		{
+			name:   "factory denom admin",
+			sender: creator.String(),
+			hook:   hook,
+		},
+		{
+			name:   "authority",
+			sender: authority,
+			hook:   banktypes.DenomTransferHook{Denom: fooDenom, Target: other.String(), AfterTransfer: true, GasLimit: 50_000},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.SetDenomTransferHook(ctx, &banktypes.MsgSetDenomTransferHook{Sender: tc.sender, Hook: tc.hook})
+			if tc.expErrMsg != "" {
+				require.ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			require.NoError(err)
+			got, err := suite.bankKeeper.GetDenomTransferHook(ctx, tc.hook.Denom)
+			require.NoError(err)
+			require.Equal(tc.hook, got)
+		})
+	}
+
+	hooks, err := suite.queryClient.DenomTransferHooks(ctx, &banktypes.QueryDenomTransferHooksRequest{})
+	require.NoError(err)
+	require.Len(hooks.Hooks, 2)
+
+	_, err = suite.msgServer.RemoveDenomTransferHook(ctx, &banktypes.MsgRemoveDenomTransferHook{Sender: other.String(), Denom: denom})
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.RemoveDenomTransferHook(ctx, &banktypes.MsgRemoveDenomTransferHook{Sender: creator.String(), Denom: denom})
+	require.NoError(err)
+	_, err = suite.msgServer.RemoveDenomTransferHook(ctx, &banktypes.MsgRemoveDenomTransferHook{Sender: authority, Denom: denom})
+	require.ErrorIs(err, banktypes.ErrDenomTransferHookNotFound)
+}
+
+func (suite *KeeperTestSuite) TestMsgDenomMetadata() {
+	ctx := suite.ctx
+	require := suite.Require()
+	creator, other := accAddrs[0], accAddrs[1]
+	authority := suite.bankKeeper.GetAuthority()
+
+	res, err := suite.msgServer.CreateDenom(ctx, &banktypes.MsgCreateDenom{Sender: creator.String(), Subdenom: "meta"})
+	require.NoError(err)
+	denom := res.NewTokenDenom
+
+	newMetadata := func(base, display string, exponent uint32) banktypes.Metadata {
+		return banktypes.Metadata{
+			Name:       "Meta Token",
+			Symbol:     "META",
+			Base:       base,
+			Display:    display,
+			DenomUnits: []*banktypes.DenomUnit{{Denom: base, Exponent: 0}, {Denom: display, Exponent: exponent}},
+		}
+	}
+
+	// the factory denom already has the metadata registered on creation
+	_, err = suite.msgServer.SetDenomMetadata(ctx, &banktypes.MsgSetDenomMetadata{Sender: creator.String(), Metadata: newMetadata(denom, "meta", 6)})
+	require.ErrorIs(err, banktypes.ErrDenomMetadataExists)
+
+	testCases := []struct {
+		name      string
+		msg       *banktypes.MsgUpdateDenomMetadata
+		expErrMsg string
+	}{
+		{
+			name:      "not the admin",
+			msg:       &banktypes.MsgUpdateDenomMetadata{Sender: other.String(), Metadata: newMetadata(denom, "meta", 6)},
+			expErrMsg: "neither the authority nor the admin",
+		},
+		{
+			name:      "display unit missing",
+			msg:       &banktypes.MsgUpdateDenomMetadata{Sender: creator.String(), Metadata: banktypes.Metadata{Name: "Meta Token", Symbol: "META", Base: denom, Display: "meta", DenomUnits: []*banktypes.DenomUnit{{Denom: denom}}}},
+			expErrMsg: "display denom",
+		},
+		{
+			name: "factory denom admin",
+			msg:  &banktypes.MsgUpdateDenomMetadata{Sender: creator.String(), Metadata: newMetadata(denom, "meta", 6)},
+		},
+		{
+			name:      "changed exponent",
+			msg:       &banktypes.MsgUpdateDenomMetadata{Sender: authority, Metadata: newMetadata(denom, "meta", 18)},
+			expErrMsg: "cannot change",
+		},
+		{
+			name:      "no metadata",
+			msg:       &banktypes.MsgUpdateDenomMetadata{Sender: authority, Metadata: newMetadata("uatom", "atom", 6)},
+			expErrMsg: "not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.UpdateDenomMetadata(ctx, tc.msg)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			require.NoError(err)
+			got, found := suite.bankKeeper.GetDenomMetaData(ctx, tc.msg.Metadata.Base)
+			require.True(found)
+			require.Equal(tc.msg.Metadata, got)
+		})
+	}
+
+	// only the authority can register the metadata of a denom that is not a factory denom
+	_, err = suite.msgServer.SetDenomMetadata(ctx, &banktypes.MsgSetDenomMetadata{Sender: creator.String(), Metadata: newMetadata("uatom", "atom", 6)})
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.SetDenomMetadata(ctx, &banktypes.MsgSetDenomMetadata{Sender: authority, Metadata: newMetadata("uatom", "atom", 6)})
+	require.NoError(err)
+	require.True(suite.bankKeeper.HasDenomMetaData(ctx, "uatom"))
+
+	events := sdk.UnwrapSDKContext(ctx).EventManager().Events()
+	event := events[len(events)-1]
+	require.Equal("cosmos.bank.v1beta1.EventSetDenomMetadata", event.Type)
+	_, err = suite.msgServer.UpdateDenomMetadata(ctx, &banktypes.MsgUpdateDenomMetadata{Sender: authority, Metadata: newMetadata("uatom", "atom", 6)})
+	require.NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestMsgDenomTransferFees() {
+	ctx := suite.ctx
+	require := suite.Require()
+	creator, other := accAddrs[0], accAddrs[1]
+	authority := suite.bankKeeper.GetAuthority()
+
+	res, err := suite.msgServer.CreateDenom(ctx, &banktypes.MsgCreateDenom{Sender: creator.String(), Subdenom: "taxed"})
+	require.NoError(err)
+	denom := res.NewTokenDenom
+	fee := banktypes.DenomTransferFee{Denom: denom, Rate: math.LegacyNewDecWithPrec(1, 2), Collector: creator.String()}
+
+	testCases := []struct {
+		name      string
+		sender    string
+		fee       banktypes.DenomTransferFee
+		expErrMsg string
+	}{
+		{
+			name:      "invalid rate",
+			sender:    creator.String(),
+			fee:       banktypes.DenomTransferFee{Denom: denom, Rate: math.LegacyZeroDec(), Collector: creator.String()},
+			expErrMsg: "fee rate must be positive",
+		},
+		{
+			name:      "invalid collector",
+			sender:    creator.String(),
+			fee:       banktypes.DenomTransferFee{Denom: denom, Rate: math.LegacyNewDecWithPrec(1, 2), Collector: "collector"},
+			expErrMsg: "invalid transfer fee collector",
+		},
+		{
+			name:      "not the admin",
+			sender:    other.String(),
+			fee:       fee,
+			expErrMsg: "neither the authority nor the admin",
+		},
+		{
+			name:   "factory denom admin",
+			sender: creator.String(),
+			fee:    fee,
+		},
+		{
+			name:   "authority",
+			sender: authority,
+			fee:    banktypes.DenomTransferFee{Denom: fooDenom, Rate: math.LegacyNewDecWithPrec(5, 3), Collector: other.String(), ExemptAddresses: []string{creator.String()}},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.SetDenomTransferFee(ctx, &banktypes.MsgSetDenomTransferFee{Sender: tc.sender, Fee: tc.fee})
+			if tc.expErrMsg != "" {
+				require.ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			require.NoError(err)
+			got, err := suite.bankKeeper.GetDenomTransferFee(ctx, tc.fee.Denom)
+			require.NoError(err)
+			require.Equal(tc.fee, got)
+		})
+	}
+
+	fees, err := suite.queryClient.DenomTransferFees(ctx, &banktypes.QueryDenomTransferFeesRequest{})
+	require.NoError(err)
+	require.Len(fees.Fees, 2)
+
+	_, err = suite.msgServer.RemoveDenomTransferFee(ctx, &banktypes.MsgRemoveDenomTransferFee{Sender: other.String(), Denom: denom})
+	require.ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.RemoveDenomTransferFee(ctx, &banktypes.MsgRemoveDenomTransferFee{Sender: creator.String(), Denom: denom})
+	require.NoError(err)
+	_, err = suite.msgServer.RemoveDenomTransferFee(ctx, &banktypes.MsgRemoveDenomTransferFee{Sender: authority, Denom: denom})
+	require.ErrorIs(err, banktypes.ErrDenomTransferFeeNotFound)
+}
@@ -40,6 +40,24 @@ type SendKeeper interface {
 	IterateSendEnabledEntries(ctx context.Context, cb func(denom string, sendEnabled bool) (stop bool))
 	GetAllSendEnabledEntries(ctx context.Context) []types.SendEnabled
 
+	SetBalanceAlert(ctx context.Context, alert types.BalanceAlert) error
+	GetAllBalanceAlerts(ctx context.Context) ([]types.BalanceAlert, error)
+
+	SetSendRestrictionPolicy(ctx context.Context, policy types.SendRestrictionPolicy) error
+	RemoveSendRestrictionPolicy(ctx context.Context, name string) error
+	GetSendRestrictionPolicy(ctx context.Context, name string) (types.SendRestrictionPolicy, error)
+	GetAllSendRestrictionPolicies(ctx context.Context) ([]types.SendRestrictionPolicy, error)
+
+	SetDenomTransferHook(ctx context.Context, hook types.DenomTransferHook) error
+	RemoveDenomTransferHook(ctx context.Context, denom string) error
+	GetDenomTransferHook(ctx context.Context, denom string) (types.DenomTransferHook, error)
+	GetAllDenomTransferHooks(ctx context.Context) ([]types.DenomTransferHook, error)
+
+	SetDenomTransferFee(ctx context.Context, fee types.DenomTransferFee) error
+	RemoveDenomTransferFee(ctx context.Context, denom string) error
+	GetDenomTransferFee(ctx context.Context, denom string) (types.DenomTransferFee, error)
+	GetAllDenomTransferFees(ctx context.Context) ([]types.DenomTransferFee, error)
+
 	IsSendEnabledCoin(ctx context.Context, coin sdk.Coin) bool
 	IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error
 
@@ -69,6 +87,7 @@ type BaseSendKeeper struct {
 	authority string
 
 	sendRestriction *sendRestriction
+	transferHooks   *transferHooks
 }
 
 func NewBaseSendKeeper(
@@ -92,6 +111,7 @@ func NewBaseSendKeeper(
 		authority:       authority,
 		logger:          logger,
 		sendRestriction: newSendRestriction(),
+		transferHooks:   &transferHooks{},
 	}
 }
 
@@ -132,14 +152,38 @@ func (k BaseSendKeeper) SetParams(ctx context.Context, params types.Params) erro
 		k.SetAllSendEnabled(ctx, params.SendEnabled)
 
 		// override params without SendEnabled
-		params = types.NewParams(params.DefaultSendEnabled)
+		params.SendEnabled = nil
+	}
+
+	if err := k.setBalanceJournalAddresses(ctx, params.BalanceJournalAddresses); err != nil {
+		return err
 	}
+
 	return k.Params.Set(ctx, params)
 }
 
+// multiSendChunkSize is the number of outputs InputOutputCoins resolves and
+// writes the balances of at once. Outputs of a chunk paying the same recipient
+// are merged into a single balance update.
+const multiSendChunkSize = 1000
+
+// multiSendRecipient is the recipient of the outputs of a chunk, with the sum
+// of their coins.
+type multiSendRecipient struct {
+	address sdk.AccAddress
+	coins   sdk.Coins
+}
+
 // InputOutputCoins performs multi-send functionality. It accepts an
 // input that corresponds to a series of outputs. It returns an error if the
 // input and outputs don't line up or if any single transfer of tokens fails.
+//
+// The send restriction policies are read once for all outputs, and the outputs
+// are processed in chunks of multiSendChunkSize, so that gas and memory scale
+// linearly with the number of outputs. The events are emitted once all the
+// balances are written: a coin_spent event for the input, a coin_received event
+// for each recipient of a chunk and a transfer event for each output, with the
+// coins credited to its recipient once the transfer fees are deducted.
 func (k BaseSendKeeper) InputOutputCoins(ctx context.Context, input types.Input, outputs []types.Output) error {
 	// Safety check ensuring that when sending coins the keeper must maintain the
 	// Check supply invariant and validity of Coins.
@@ -152,31 +196,65 @@ func (k BaseSendKeeper) InputOutputCoins(ctx context.Context, input types.Input,
 		return err
 	}
 
-	err = k.subUnlockedCoins(ctx, inAddress, input.Coins)
+	if err := k.subUnlockedBalances(ctx, inAddress, input.Coins, types.BalanceChangeReasonMultiSend); err != nil {
+		return err
+	}
+
+	policies, err := k.loadSendRestrictionPolicies(ctx)
 	if err != nil {
 		return err
 	}
 
-	var outAddress sdk.AccAddress
-	for _, out := range outputs {
-		outAddress, err = k.ak.AddressCodec().StringToBytes(out.Address)
+	// the outputs sum up to the input, so their denoms are the denoms of the input
+	hooks, err := k.loadDenomTransferHooks(ctx, input.Coins)
+	if err != nil {
+		return err
+	}
+
+	var recipients []multiSendRecipient
+	transferred := make([]sdk.Coins, 0, len(outputs))
+	for start := 0; start < len(outputs); start += multiSendChunkSize {
+		end := min(start+multiSendChunkSize, len(outputs))
+		chunkRecipients, chunkTransferred, err := k.sendOutputs(ctx, inAddress, outputs[start:end], policies, hooks)
 		if err != nil {
 			return err
 		}
+		recipients = append(recipients, chunkRecipients...)
+		transferred = append(transferred, chunkTransferred...)
+	}
 
-		outAddress, err = k.sendRestriction.apply(ctx, inAddress, outAddress, out.Coins)
+	eventManager := k.environment.EventService.EventManager(ctx)
+	inAddressString, err := k.ak.AddressCodec().BytesToString(inAddress)
+	if err != nil {
+		return err
+	}
+	if err := eventManager.EmitKV(
+		types.EventTypeCoinSpent,
+		event.NewAttribute(types.AttributeKeySpender, inAddressString),
+		event.NewAttribute(sdk.AttributeKeyAmount, input.Coins.String()),
+	); err != nil {
+		return err
+	}
+
+	for _, recipient := range recipients {
+		addrStr, err := k.ak.AddressCodec().BytesToString(recipient.address)
 		if err != nil {
 			return err
 		}
-
-		if err := k.addCoins(ctx, outAddress, out.Coins); err != nil {
+		if err := eventManager.EmitKV(
+			types.EventTypeCoinReceived,
+			event.NewAttribute(types.AttributeKeyReceiver, addrStr),
+			event.NewAttribute(sdk.AttributeKeyAmount, recipient.coins.String()),
+		); err != nil {
 			return err
 		}
+	}
 
-		if err := k.environment.EventService.EventManager(ctx).EmitKV(
+	for i, out := range outputs {
+		if err := eventManager.EmitKV(
 			types.EventTypeTransfer,
 			event.NewAttribute(types.AttributeKeyRecipient, out.Address),
-			event.NewAttribute(sdk.AttributeKeyAmount, out.Coins.String()),
+			event.NewAttribute(sdk.AttributeKeyAmount, transferred[i].String()),
 		); err != nil {
 			return err
 		}
@@ -185,11 +263,91 @@ func (k BaseSendKeeper) InputOutputCoins(ctx context.Context, input types.Input,
 	return nil
 }
 
+// sendOutputs adds the coins of a chunk of multi-send outputs to the balances of
+// their recipients, after applying the send restrictions. The balance of each
+// recipient is updated once, with the sum of its outputs. The transfer hooks are
+// called for each output, the before hooks before any balance of the chunk is
+// written and the after hooks once they all are. The transfer fees, charged by
+// the before hooks, are charged on each output, the collectors being credited
+// like the recipients. It returns
+// the recipients in the order of their first output, and the coins credited to
+// the recipient of each output.
+func (k BaseSendKeeper) sendOutputs(
+	ctx context.Context, inAddress sdk.AccAddress, outputs []types.Output,
+	policies sendRestrictionPolicySet, hooks denomTransferHooks,
+) ([]multiSendRecipient, []sdk.Coins, error) {
+	recipients := make([]multiSendRecipient, 0, len(outputs))
+	indexes := make(map[string]int, len(outputs))
+	credit := func(addr sdk.AccAddress, coins sdk.Coins) {
+		if i, ok := indexes[string(addr)]; ok {
+			recipients[i].coins = recipients[i].coins.Add(coins...)
+			return
+		}
+
+		indexes[string(addr)] = len(recipients)
+		recipients = append(recipients, multiSendRecipient{address: addr, coins: coins})
+	}
+
+	transfers := make([]multiSendRecipient, 0, len(outputs))
+	for _, out := range outputs {
+		outAddress, err := k.ak.AddressCodec().StringToBytes(out.Address)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		outAddress, err = k.sendRestriction.apply(ctx, inAddress, outAddress, out.Coins)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if err := k.checkSendRestrictionPolicySet(ctx, policies, inAddress, outAddress, out.Coins); err != nil {
+			return nil, nil, err
+		}
+
+		if err := k.consumeSpendingLimits(ctx, inAddress, outAddress, out.Coins); err != nil {
+			return nil, nil, err
+		}
+
+		received, collected, err := k.beforeTransfer(ctx, hooks, inAddress, outAddress, out.Coins)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		transfers = append(transfers, multiSendRecipient{address: outAddress, coins: received})
+		credit(outAddress, received)
+		for _, c := range collected {
+			credit(c.collector, c.coins)
+		}
+	}
+
+	for _, recipient := range recipients {
+		if err := k.addBalances(ctx, recipient.address, recipient.coins, types.BalanceChangeReasonMultiSend); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	transferred := make([]sdk.Coins, len(transfers))
+	for i, transfer := range transfers {
+		if err := k.afterTransfer(ctx, hooks, inAddress, transfer.address, transfer.coins); err != nil {
+			return nil, nil, err
+		}
+		transferred[i] = transfer.coins
+	}
+
+	return recipients, transferred, nil
+}
+
 // SendCoins transfers amt coins from a sending account to a receiving account.
-// An error is returned upon failure.
+// The before transfer hooks of the denoms of amt are called once the send
+// restrictions passed, before the receiving account is credited, and the after
+// transfer hooks once it is. The transfer fees of the denoms of amt, charged by
+// the before transfer hooks, are deducted from the coins credited to the
+// receiving account and credited to their collectors, the transfer event
+// reporting the coins credited to the receiving account and a transfer fee event
+// for each fee collected. An error is returned upon failure.
 func (k BaseSendKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
 	var err error
-	err = k.subUnlockedCoins(ctx, fromAddr, amt)
+	err = k.subUnlockedCoins(ctx, fromAddr, amt, types.BalanceChangeReasonSend)
 	if err != nil {
 		return err
 	}
@@ -199,7 +357,39 @@ func (k BaseSendKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccA
 		return err
 	}
 
-	err = k.addCoins(ctx, toAddr, amt)
+	err = k.checkSendRestrictionPolicies(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+
+	err = k.consumeSpendingLimits(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+
+	hooks, err := k.loadDenomTransferHooks(ctx, amt)
+	if err != nil {
+		return err
+	}
+
+	received, collected, err := k.beforeTransfer(ctx, hooks, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+
+	err = k.addCoins(ctx, toAddr, received, types.BalanceChangeReasonSend)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range collected {
+		err = k.addCoins(ctx, c.collector, c.coins, types.BalanceChangeReasonTransferFee)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = k.afterTransfer(ctx, hooks, fromAddr, toAddr, received)
 	if err != nil {
 		return err
 	}
@@ -217,20 +407,44 @@ func (k BaseSendKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccA
 		types.EventTypeTransfer,
 		event.NewAttribute(types.AttributeKeyRecipient, toAddrString),
 		event.NewAttribute(types.AttributeKeySender, fromAddrString),
-		event.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+		event.NewAttribute(sdk.AttributeKeyAmount, received.String()),
 	)
 }
 
-// subUnlockedCoins removes the unlocked amt coins of the given account. An error is
+// subUnlockedCoins removes the unlocked amt coins of the given account. The
+// balance changes are recorded in the balance journal with the given reason. An error is
 // returned if the resulting balance is negative or the initial amount is invalid.
 // A coin_spent event is emitted after.
-func (k BaseSendKeeper) subUnlockedCoins(ctx context.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+func (k BaseSendKeeper) subUnlockedCoins(ctx context.Context, addr sdk.AccAddress, amt sdk.Coins, reason string) error {
+	if err := k.subUnlockedBalances(ctx, addr, amt, reason); err != nil {
+		return err
+	}
+
+	addrStr, err := k.ak.AddressCodec().BytesToString(addr)
+	if err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCoinSpent,
+		event.NewAttribute(types.AttributeKeySpender, addrStr),
+		event.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+	)
+}
+
+// subUnlockedBalances is subUnlockedCoins without the coin_spent event.
+func (k BaseSendKeeper) subUnlockedBalances(ctx context.Context, addr sdk.AccAddress, amt sdk.Coins, reason string) error {
 	if !amt.IsValid() {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
 
 	lockedCoins := k.LockedCoins(ctx, addr)
 
+	alerts, err := k.balanceAlerts(ctx, addr)
+	if err != nil {
+		return err
+	}
+
 	for _, coin := range amt {
 		balance := k.GetBalance(ctx, addr, coin.Denom)
 		locked := sdk.NewCoin(coin.Denom, lockedCoins.AmountOf(coin.Denom))
@@ -257,6 +471,27 @@ func (k BaseSendKeeper) subUnlockedCoins(ctx context.Context, addr sdk.AccAddres
 		if err := k.setBalance(ctx, addr, newBalance); err != nil {
 			return err
 		}
+
+		if threshold, ok := alerts[coin.Denom]; ok {
+			if err := k.emitBalanceAlert(ctx, addr, threshold, balance, newBalance); err != nil {
+				return err
+			}
+		}
+
+		if err := k.recordBalanceChange(ctx, addr, balance, newBalance, reason); err != nil {
+			return err
+		}
+	}
+
+	return k.recordAccountActivity(ctx, addr)
+}
+
+// addCoins increase the addr balance by the given amt. Fails if the provided
+// amt is invalid. It emits a coin received event and records the balance
+// changes in the balance journal with the given reason.
+func (k BaseSendKeeper) addCoins(ctx context.Context, addr sdk.AccAddress, amt sdk.Coins, reason string) error {
+	if err := k.addBalances(ctx, addr, amt, reason); err != nil {
+		return err
 	}
 
 	addrStr, err := k.ak.AddressCodec().BytesToString(addr)
@@ -265,19 +500,23 @@ func (k BaseSendKeeper) subUnlockedCoins(ctx context.Context, addr sdk.AccAddres
 	}
 
 	return k.environment.EventService.EventManager(ctx).EmitKV(
-		types.EventTypeCoinSpent,
-		event.NewAttribute(types.AttributeKeySpender, addrStr),
+		types.EventTypeCoinReceived,
+		event.NewAttribute(types.AttributeKeyReceiver, addrStr),
 		event.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
 	)
 }
 
-// addCoins increase the addr balance by the given amt. Fails if the provided
-// amt is invalid. It emits a coin received event.
-func (k BaseSendKeeper) addCoins(ctx context.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+// addBalances is addCoins without the coin_received event.
+func (k BaseSendKeeper) addBalances(ctx context.Context, addr sdk.AccAddress, amt sdk.Coins, reason string) error {
 	if !amt.IsValid() {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
 
+	alerts, err := k.balanceAlerts(ctx, addr)
+	if err != nil {
+		return err
+	}
+
 	for _, coin := range amt {
 		balance := k.GetBalance(ctx, addr, coin.Denom)
 		newBalance := balance.Add(coin)
@@ -286,18 +525,19 @@ func (k BaseSendKeeper) addCoins(ctx context.Context, addr sdk.AccAddress, amt s
 		if err != nil {
 			return err
 		}
-	}
 
-	addrStr, err := k.ak.AddressCodec().BytesToString(addr)
-	if err != nil {
-		return err
+		if threshold, ok := alerts[coin.Denom]; ok {
+			if err := k.emitBalanceAlert(ctx, addr, threshold, balance, newBalance); err != nil {
+				return err
+			}
+		}
+
+		if err := k.recordBalanceChange(ctx, addr, balance, newBalance, reason); err != nil {
+			return err
+		}
 	}
 
-	return k.environment.EventService.EventManager(ctx).EmitKV(
-		types.EventTypeCoinReceived,
-		event.NewAttribute(types.AttributeKeyReceiver, addrStr),
-		event.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
-	)
+	return k.recordAccountActivity(ctx, addr)
 }
 
 // setBalance sets the coin balance for an account by address.
@@ -317,6 +557,55 @@ func (k BaseSendKeeper) setBalance(ctx context.Context, addr sdk.AccAddress, bal
 	return k.Balances.Set(ctx, collections.Join(addr, balance.Denom), balance.Amount)
 }
 
+// balanceAlerts returns the thresholds of the balance alerts registered on an
+// account by denom, read in a single iteration so that balance changes of
+// accounts without alerts, the vast majority, cost a single seek.
+func (k BaseSendKeeper) balanceAlerts(ctx context.Context, addr sdk.AccAddress) (map[string]math.Int, error) {
+	iter, err := k.BalanceAlerts.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, string](addr))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var thresholds map[string]math.Int
+	for ; iter.Valid(); iter.Next() {
+		kv, err := iter.KeyValue()
+		if err != nil {
+			return nil, err
+		}
+		if thresholds == nil {
+			thresholds = make(map[string]math.Int)
+		}
+		thresholds[kv.Key.K2()] = kv.Value
+	}
+
+	return thresholds, nil
+}
+
+// emitBalanceAlert emits an EventBalanceAlert if the balance change of an
+// account crosses the threshold of the balance alert registered for its denom.
+func (k BaseSendKeeper) emitBalanceAlert(ctx context.Context, addr sdk.AccAddress, threshold math.Int, previous, current sdk.Coin) error {
+	alert := types.BalanceAlert{Denom: current.Denom, Threshold: threshold}
+	crossed, below := alert.IsCrossed(previous.Amount, current.Amount)
+	if !crossed {
+		return nil
+	}
+
+	addrStr, err := k.ak.AddressCodec().BytesToString(addr)
+	if err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).Emit(&types.EventBalanceAlert{
+		Address:         addrStr,
+		Denom:           current.Denom,
+		Threshold:       threshold.String(),
+		PreviousBalance: previous.Amount.String(),
+		Balance:         current.Amount.String(),
+		Below:           below,
+	})
+}
+
 // IsSendEnabledCoins checks the coins provided and returns an ErrSendDisabled
 // if any of the coins are not configured for sending. Returns nil if sending is
 // enabled for all provided coins.
@@ -414,6 +703,41 @@ func (k BaseSendKeeper) GetAllSendEnabledEntries(ctx context.Context) []types.Se
 	return rv
 }
 
+// SetBalanceAlert registers a balance alert on a module account. An alert with
+// a zero threshold deletes the alert registered for the address and denom.
+func (k BaseSendKeeper) SetBalanceAlert(ctx context.Context, alert types.BalanceAlert) error {
+	addr, err := k.ak.AddressCodec().StringToBytes(alert.Address)
+	if err != nil {
+		return err
+	}
+
+	key := collections.Join(sdk.AccAddress(addr), alert.Denom)
+	if alert.Threshold.IsZero() {
+		return k.BalanceAlerts.Remove(ctx, key)
+	}
+
+	if _, ok := k.ak.GetAccount(ctx, addr).(sdk.ModuleAccountI); !ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "%s is not a module account", alert.Address)
+	}
+
+	return k.BalanceAlerts.Set(ctx, key, alert.Threshold)
+}
+
+// GetAllBalanceAlerts returns all the balance alerts registered on module accounts.
+func (k BaseSendKeeper) GetAllBalanceAlerts(ctx context.Context) ([]types.BalanceAlert, error) {
+	var alerts []types.BalanceAlert
+	err := k.BalanceAlerts.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, string], threshold math.Int) (stop bool, err error) {
+		addr, err := k.ak.AddressCodec().BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+		alerts = append(alerts, types.NewBalanceAlert(addr, key.K2(), threshold))
+		return false, nil
+	})
+
+	return alerts, err
+}
+
 // getSendEnabled returns whether send is enabled and whether that flag was set
 // for a denom.
 //
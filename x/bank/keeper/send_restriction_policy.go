@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetSendRestrictionPolicy registers or updates a send restriction policy. The
+// addresses of the policy are stored in their canonical form.
+func (k BaseSendKeeper) SetSendRestrictionPolicy(ctx context.Context, policy types.SendRestrictionPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	addresses := make([]string, len(policy.Addresses))
+	for i, addr := range policy.Addresses {
+		bz, err := k.ak.AddressCodec().StringToBytes(addr)
+		if err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid address %s in policy %s: %s", addr, policy.Name, err)
+		}
+		if addresses[i], err = k.ak.AddressCodec().BytesToString(bz); err != nil {
+			return err
+		}
+	}
+	policy.Addresses = addresses
+
+	if err := k.SendRestrictionPolicies.Set(ctx, policy.Name, policy); err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).Emit(&types.EventSetSendRestrictionPolicy{
+		Name:            policy.Name,
+		RestrictionType: policy.RestrictionType,
+		Enabled:         policy.Enabled,
+	})
+}
+
+// RemoveSendRestrictionPolicy removes a send restriction policy.
+func (k BaseSendKeeper) RemoveSendRestrictionPolicy(ctx context.Context, name string) error {
+	if has, err := k.SendRestrictionPolicies.Has(ctx, name); err != nil {
+		return err
+	} else if !has {
+		return errorsmod.Wrapf(types.ErrSendRestrictionPolicyNotFound, "policy %s", name)
+	}
+
+	if err := k.SendRestrictionPolicies.Remove(ctx, name); err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).Emit(&types.EventRemoveSendRestrictionPolicy{
+		Name: name,
+	})
+}
+
+// GetSendRestrictionPolicy returns the send restriction policy with the given name.
+func (k BaseSendKeeper) GetSendRestrictionPolicy(ctx context.Context, name string) (types.SendRestrictionPolicy, error) {
+	policy, err := k.SendRestrictionPolicies.Get(ctx, name)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.SendRestrictionPolicy{}, errorsmod.Wrapf(types.ErrSendRestrictionPolicyNotFound, "policy %s", name)
+		}
+		return types.SendRestrictionPolicy{}, err
+	}
+
+	return policy, nil
+}
+
+// GetAllSendRestrictionPolicies returns all the send restriction policies,
+// enabled or not.
+func (k BaseSendKeeper) GetAllSendRestrictionPolicies(ctx context.Context) ([]types.SendRestrictionPolicy, error) {
+	var policies []types.SendRestrictionPolicy
+	err := k.SendRestrictionPolicies.Walk(ctx, nil, func(_ string, policy types.SendRestrictionPolicy) (stop bool, err error) {
+		policies = append(policies, policy)
+		return false, nil
+	})
+
+	return policies, err
+}
+
+// sendRestrictionPolicySet holds the enabled send restriction policies with
+// their addresses indexed, so that many sends can be checked against them
+// without reading the policies from state for each send.
+type sendRestrictionPolicySet struct {
+	policies  []types.SendRestrictionPolicy
+	addresses []map[string]struct{}
+}
+
+// loadSendRestrictionPolicies reads the enabled send restriction policies.
+func (k BaseSendKeeper) loadSendRestrictionPolicies(ctx context.Context) (sendRestrictionPolicySet, error) {
+	var set sendRestrictionPolicySet
+	err := k.SendRestrictionPolicies.Walk(ctx, nil, func(_ string, policy types.SendRestrictionPolicy) (stop bool, err error) {
+		if !policy.Enabled {
+			return false, nil
+		}
+
+		addresses := make(map[string]struct{}, len(policy.Addresses))
+		for _, addr := range policy.Addresses {
+			addresses[addr] = struct{}{}
+		}
+
+		set.policies = append(set.policies, policy)
+		set.addresses = append(set.addresses, addresses)
+		return false, nil
+	})
+
+	return set, err
+}
+
+// checkSendRestrictionPolicies returns an error if the send of amt from fromAddr
+// to toAddr is blocked by an enabled send restriction policy.
+func (k BaseSendKeeper) checkSendRestrictionPolicies(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	policies, err := k.loadSendRestrictionPolicies(ctx)
+	if err != nil {
+		return err
+	}
+
+	return k.checkSendRestrictionPolicySet(ctx, policies, fromAddr, toAddr, amt)
+}
+
+// checkSendRestrictionPolicySet returns an error if the send of amt from fromAddr
+// to toAddr is blocked by one of the given policies.
+func (k BaseSendKeeper) checkSendRestrictionPolicySet(
+	ctx context.Context, set sendRestrictionPolicySet, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins,
+) error {
+	// the addresses are only converted if a policy lists addresses
+	var from, to string
+	addressStrings := func() (string, string, error) {
+		if from == "" {
+			var err error
+			if from, err = k.ak.AddressCodec().BytesToString(fromAddr); err != nil {
+				return "", "", err
+			}
+			if to, err = k.ak.AddressCodec().BytesToString(toAddr); err != nil {
+				return "", "", err
+			}
+		}
+		return from, to, nil
+	}
+
+	for i, policy := range set.policies {
+		hasAddress := func(addr string) bool {
+			_, ok := set.addresses[i][addr]
+			return ok
+		}
+
+		switch policy.RestrictionType {
+		case types.SEND_RESTRICTION_TYPE_ADDRESS_BLOCKLIST:
+			sender, recipient, err := addressStrings()
+			if err != nil {
+				return err
+			}
+			if hasAddress(sender) {
+				return errorsmod.Wrapf(types.ErrSendRestricted, "%s is blocked by policy %s", sender, policy.Name)
+			}
+			if hasAddress(recipient) {
+				return errorsmod.Wrapf(types.ErrSendRestricted, "%s is blocked by policy %s", recipient, policy.Name)
+			}
+
+		case types.SEND_RESTRICTION_TYPE_DENOM_FREEZE:
+			restricted := policy.RestrictedCoins(amt)
+			if restricted.IsZero() {
+				continue
+			}
+			if len(policy.Addresses) > 0 {
+				sender, _, err := addressStrings()
+				if err != nil {
+					return err
+				}
+				if !hasAddress(sender) {
+					continue
+				}
+			}
+			return errorsmod.Wrapf(types.ErrSendRestricted, "%s frozen by policy %s", restricted, policy.Name)
+
+		case types.SEND_RESTRICTION_TYPE_TRANSFER_PAUSE:
+			restricted := policy.RestrictedCoins(amt)
+			if restricted.IsZero() || k.isModuleAccount(ctx, fromAddr) || k.isModuleAccount(ctx, toAddr) {
+				continue
+			}
+			return errorsmod.Wrapf(types.ErrSendRestricted, "transfers of %s paused by policy %s", restricted, policy.Name)
+		}
+	}
+
+	return nil
+}
+
+// isModuleAccount returns whether the address is the one of a module account.
+func (k BaseSendKeeper) isModuleAccount(ctx context.Context, addr sdk.AccAddress) bool {
+	_, ok := k.ak.GetAccount(ctx, addr).(sdk.ModuleAccountI)
+	return ok
+}
@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// UpdateSpendingLimit sets the daily limit of the amount of denom the account
+// can send to other accounts. Setting a first limit or lowering one takes
+// effect immediately, while raising or removing one, with a zero daily limit,
+// takes effect after the SpendingLimitChangeDelay param, so that a compromised
+// key cannot lift the limit to drain the account at once. It returns the time
+// the new daily limit takes effect at.
+func (k BaseSendKeeper) UpdateSpendingLimit(ctx context.Context, address, denom string, dailyLimit math.Int) (time.Time, error) {
+	addr, err := k.ak.AddressCodec().StringToBytes(address)
+	if err != nil {
+		return time.Time{}, sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return time.Time{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if dailyLimit.IsNil() || dailyLimit.IsNegative() {
+		return time.Time{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "daily limit cannot be negative: %s", dailyLimit)
+	}
+
+	now := k.environment.HeaderService.GetHeaderInfo(ctx).Time
+	limit, found, err := k.getSpendingLimit(ctx, addr, denom, now)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	effectiveTime := now
+	switch {
+	case !found && dailyLimit.IsZero():
+		return time.Time{}, errorsmod.Wrapf(types.ErrSpendingLimitNotFound, "no %s spending limit for %s", denom, address)
+	case !found:
+		limit = types.SpendingLimit{
+			Address:           address,
+			Denom:             denom,
+			DailyLimit:        dailyLimit,
+			WindowStart:       now,
+			Spent:             math.ZeroInt(),
+			PendingDailyLimit: math.ZeroInt(),
+		}
+	case dailyLimit.IsPositive() && dailyLimit.LTE(limit.DailyLimit):
+		limit.DailyLimit = dailyLimit
+		limit.PendingDailyLimit = math.ZeroInt()
+		limit.PendingTime = nil
+	default:
+		effectiveTime = now.Add(k.GetParams(ctx).SpendingLimitChangeDelay)
+		limit.PendingDailyLimit = dailyLimit
+		limit.PendingTime = &effectiveTime
+	}
+
+	if err := k.saveSpendingLimit(ctx, addr, limit, now); err != nil {
+		return time.Time{}, err
+	}
+
+	if err := k.environment.EventService.EventManager(ctx).Emit(&types.EventSetSpendingLimit{
+		Address:       address,
+		Denom:         denom,
+		DailyLimit:    dailyLimit.String(),
+		EffectiveTime: effectiveTime,
+	}); err != nil {
+		return time.Time{}, err
+	}
+
+	return effectiveTime, nil
+}
+
+// SetSpendingLimit sets a spending limit as is.
+func (k BaseSendKeeper) SetSpendingLimit(ctx context.Context, limit types.SpendingLimit) error {
+	if err := limit.Validate(); err != nil {
+		return err
+	}
+
+	addr, err := k.ak.AddressCodec().StringToBytes(limit.Address)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid spending limit address: %s", err)
+	}
+
+	return k.SpendingLimits.Set(ctx, collections.Join(sdk.AccAddress(addr), limit.Denom), limit)
+}
+
+// GetAllSpendingLimits returns all the spending limits as stored, with their
+// pending changes not applied.
+func (k BaseSendKeeper) GetAllSpendingLimits(ctx context.Context) ([]types.SpendingLimit, error) {
+	var limits []types.SpendingLimit
+	err := k.SpendingLimits.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, string], limit types.SpendingLimit) (bool, error) {
+		limits = append(limits, limit)
+		return false, nil
+	})
+
+	return limits, err
+}
+
+// consumeSpendingLimits records amt as spent by fromAddr, failing if it
+// exceeds any of its spending limits. Sending to itself or to a module account
+// is not limited, so that fees and module deposits can still be paid.
+func (k BaseSendKeeper) consumeSpendingLimits(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if fromAddr.Equals(toAddr) {
+		return nil
+	}
+
+	var (
+		now     time.Time
+		checked bool
+	)
+	for _, coin := range amt {
+		has, err := k.SpendingLimits.Has(ctx, collections.Join(fromAddr, coin.Denom))
+		if err != nil {
+			return err
+		}
+		if !has {
+			continue
+		}
+
+		// the recipient is only looked up once a limit applies
+		if !checked {
+			if _, ok := k.ak.GetAccount(ctx, toAddr).(sdk.ModuleAccountI); ok {
+				return nil
+			}
+			now = k.environment.HeaderService.GetHeaderInfo(ctx).Time
+			checked = true
+		}
+
+		limit, found, err := k.getSpendingLimit(ctx, fromAddr, coin.Denom, now)
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+
+		if coin.Amount.GT(limit.Remaining()) {
+			return errorsmod.Wrapf(types.ErrSpendingLimitExceeded, "%s is larger than the remaining daily limit of %s%s", coin, limit.Remaining(), coin.Denom)
+		}
+
+		limit.Spent = limit.Spent.Add(coin.Amount)
+		if err := k.saveSpendingLimit(ctx, fromAddr, limit, now); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getSpendingLimit returns the spending limit of an account for a denom in
+// effect at the given time, removing it if its pending removal is due.
+func (k BaseSendKeeper) getSpendingLimit(ctx context.Context, addr sdk.AccAddress, denom string, now time.Time) (types.SpendingLimit, bool, error) {
+	key := collections.Join(addr, denom)
+	limit, err := k.SpendingLimits.Get(ctx, key)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return types.SpendingLimit{}, false, nil
+	case err != nil:
+		return types.SpendingLimit{}, false, err
+	}
+
+	limit, ok := limit.Effective(now)
+	if !ok {
+		return types.SpendingLimit{}, false, k.SpendingLimits.Remove(ctx, key)
+	}
+
+	return limit, true, nil
+}
+
+// saveSpendingLimit stores a spending limit once its pending change, if due,
+// is applied.
+func (k BaseSendKeeper) saveSpendingLimit(ctx context.Context, addr sdk.AccAddress, limit types.SpendingLimit, now time.Time) error {
+	key := collections.Join(addr, limit.Denom)
+	limit, ok := limit.Effective(now)
+	if !ok {
+		return k.SpendingLimits.Remove(ctx, key)
+	}
+
+	return k.SpendingLimits.Set(ctx, key, limit)
+}
@@ -0,0 +1,319 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// CreateFactoryDenom creates the factory/{creator}/{subdenom} denom with the
+// creator as admin, and sets its default metadata. It returns the created denom.
+func (k BaseKeeper) CreateFactoryDenom(ctx context.Context, creator, subdenom string) (string, error) {
+	creatorAddr, err := k.ak.AddressCodec().StringToBytes(creator)
+	if err != nil {
+		return "", sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+	// use the canonical form of the address in the denom
+	creator, err = k.ak.AddressCodec().BytesToString(creatorAddr)
+	if err != nil {
+		return "", err
+	}
+
+	denom, err := types.GetFactoryDenom(creator, subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	key := collections.Join(sdk.AccAddress(creatorAddr), subdenom)
+	if has, err := k.FactoryDenoms.Has(ctx, key); err != nil {
+		return "", err
+	} else if has {
+		return "", errorsmod.Wrapf(types.ErrFactoryDenomExists, "denom %s", denom)
+	}
+	if k.HasSupply(ctx, denom) || k.HasDenomMetaData(ctx, denom) {
+		return "", errorsmod.Wrapf(types.ErrFactoryDenomExists, "denom %s is already in use", denom)
+	}
+
+	if err := k.FactoryDenoms.Set(ctx, key, creator); err != nil {
+		return "", err
+	}
+	k.SetDenomMetaData(ctx, types.NewFactoryDenomMetadata(denom, subdenom))
+
+	return denom, k.environment.EventService.EventManager(ctx).Emit(&types.EventCreateFactoryDenom{
+		Denom:   denom,
+		Creator: creator,
+	})
+}
+
+// GetFactoryDenom returns the factory denom along with its admin.
+func (k BaseKeeper) GetFactoryDenom(ctx context.Context, denom string) (types.FactoryDenom, error) {
+	key, err := k.factoryDenomKey(denom)
+	if err != nil {
+		return types.FactoryDenom{}, err
+	}
+
+	admin, err := k.FactoryDenoms.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.FactoryDenom{}, errorsmod.Wrapf(types.ErrFactoryDenomNotFound, "denom %s", denom)
+		}
+		return types.FactoryDenom{}, err
+	}
+
+	return types.FactoryDenom{Denom: denom, Admin: admin}, nil
+}
+
+// GetFactoryDenomsFromCreator returns the denoms created through the token
+// factory by the given creator.
+func (k BaseKeeper) GetFactoryDenomsFromCreator(ctx context.Context, creator string, pagination *query.PageRequest) ([]string, *query.PageResponse, error) {
+	creatorAddr, err := k.ak.AddressCodec().StringToBytes(creator)
+	if err != nil {
+		return nil, nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address: %s", err)
+	}
+	creator, err = k.ak.AddressCodec().BytesToString(creatorAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return query.CollectionPaginate(
+		ctx,
+		k.FactoryDenoms,
+		pagination,
+		func(key collections.Pair[sdk.AccAddress, string], _ string) (string, error) {
+			return types.GetFactoryDenom(creator, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, string](creatorAddr),
+	)
+}
+
+// GetAllFactoryDenoms returns all the denoms created through the token factory.
+func (k BaseKeeper) GetAllFactoryDenoms(ctx context.Context) ([]types.FactoryDenom, error) {
+	var factoryDenoms []types.FactoryDenom
+	err := k.FactoryDenoms.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, string], admin string) (stop bool, err error) {
+		creator, err := k.ak.AddressCodec().BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+		denom, err := types.GetFactoryDenom(creator, key.K2())
+		if err != nil {
+			return true, err
+		}
+		factoryDenoms = append(factoryDenoms, types.FactoryDenom{Denom: denom, Admin: admin})
+		return false, nil
+	})
+
+	return factoryDenoms, err
+}
+
+// SetFactoryDenom sets the admin of a factory denom.
+func (k BaseKeeper) SetFactoryDenom(ctx context.Context, factoryDenom types.FactoryDenom) error {
+	key, err := k.factoryDenomKey(factoryDenom.Denom)
+	if err != nil {
+		return err
+	}
+
+	admin := factoryDenom.Admin
+	if admin != "" {
+		adminAddr, err := k.ak.AddressCodec().StringToBytes(admin)
+		if err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+		}
+		if admin, err = k.ak.AddressCodec().BytesToString(adminAddr); err != nil {
+			return err
+		}
+	}
+
+	return k.FactoryDenoms.Set(ctx, key, admin)
+}
+
+// FactoryMint mints coins of a factory denom to the given address. Only the
+// admin of the denom can mint.
+func (k BaseKeeper) FactoryMint(ctx context.Context, admin string, amount sdk.Coin, mintTo string) error {
+	if err := k.assertFactoryDenomAdmin(ctx, admin, amount.Denom); err != nil {
+		return err
+	}
+
+	toAddr, err := k.ak.AddressCodec().StringToBytes(mintTo)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid mint to address: %s", err)
+	}
+	if k.BlockedAddr(toAddr) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", mintTo)
+	}
+
+	amounts := sdk.NewCoins(amount)
+	if err := k.addCoins(ctx, toAddr, amounts, types.BalanceChangeReasonMint); err != nil {
+		return err
+	}
+
+	supply := k.GetSupply(ctx, amount.Denom)
+	k.setSupply(ctx, supply.Add(amount))
+
+	k.logger.Debug("minted factory coins", "amount", amounts.String(), "to", mintTo)
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCoinMint,
+		event.NewAttribute(types.AttributeKeyMinter, admin),
+		event.NewAttribute(sdk.AttributeKeyAmount, amounts.String()),
+	)
+}
+
+// FactoryBurn burns coins of a factory denom from the given address. Only the
+// admin of the denom can burn.
+func (k BaseKeeper) FactoryBurn(ctx context.Context, admin string, amount sdk.Coin, burnFrom string) error {
+	if err := k.assertFactoryDenomAdmin(ctx, admin, amount.Denom); err != nil {
+		return err
+	}
+
+	fromAddr, err := k.ak.AddressCodec().StringToBytes(burnFrom)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid burn from address: %s", err)
+	}
+	if err := k.assertFactoryHolder(ctx, fromAddr, burnFrom); err != nil {
+		return err
+	}
+
+	amounts := sdk.NewCoins(amount)
+	if err := k.subUnlockedCoins(ctx, fromAddr, amounts, types.BalanceChangeReasonBurn); err != nil {
+		return err
+	}
+
+	supply := k.GetSupply(ctx, amount.Denom)
+	k.setSupply(ctx, supply.Sub(amount))
+
+	k.logger.Debug("burned factory coins", "amount", amounts.String(), "from", burnFrom)
+
+	if err := k.burnHooks.afterCoinsBurned(ctx, fromAddr, amounts); err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCoinBurn,
+		event.NewAttribute(types.AttributeKeyBurner, burnFrom),
+		event.NewAttribute(sdk.AttributeKeyAmount, amounts.String()),
+	)
+}
+
+// ForceTransfer transfers coins of a factory denom between two accounts
+// without the consent of the sender. Only the admin of the denom can force
+// transfers.
+func (k BaseKeeper) ForceTransfer(ctx context.Context, admin string, amount sdk.Coin, from, to string) error {
+	if err := k.assertFactoryDenomAdmin(ctx, admin, amount.Denom); err != nil {
+		return err
+	}
+
+	fromAddr, err := k.ak.AddressCodec().StringToBytes(from)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", err)
+	}
+	toAddr, err := k.ak.AddressCodec().StringToBytes(to)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", err)
+	}
+	if err := k.assertFactoryHolder(ctx, fromAddr, from); err != nil {
+		return err
+	}
+	if k.BlockedAddr(toAddr) || k.isModuleAccount(ctx, toAddr) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", to)
+	}
+
+	return k.SendCoins(ctx, fromAddr, toAddr, sdk.NewCoins(amount))
+}
+
+// assertFactoryHolder returns an error if the coins held by an address cannot
+// be moved or burned by the admin of a factory denom, which is the case of
+// blocked addresses and module accounts, whose balances are accounted for by
+// their modules, such as escrowed deposits or staking pools.
+func (k BaseKeeper) assertFactoryHolder(ctx context.Context, addr sdk.AccAddress, addrStr string) error {
+	if k.BlockedAddr(addr) || k.isModuleAccount(ctx, addr) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "the factory coins of module account %s cannot be moved by the denom admin", addrStr)
+	}
+
+	return nil
+}
+
+// ChangeFactoryDenomAdmin changes the admin of a factory denom. An empty new
+// admin removes the admin of the denom for good.
+func (k BaseKeeper) ChangeFactoryDenomAdmin(ctx context.Context, admin, denom, newAdmin string) error {
+	if err := k.assertFactoryDenomAdmin(ctx, admin, denom); err != nil {
+		return err
+	}
+
+	if err := k.SetFactoryDenom(ctx, types.FactoryDenom{Denom: denom, Admin: newAdmin}); err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).Emit(&types.EventChangeDenomAdmin{
+		Denom:    denom,
+		NewAdmin: newAdmin,
+	})
+}
+
+// SetFactoryDenomMetadata sets the metadata of a factory denom.
+func (k BaseKeeper) SetFactoryDenomMetadata(ctx context.Context, admin string, metadata types.Metadata) error {
+	if err := k.assertFactoryDenomAdmin(ctx, admin, metadata.Base); err != nil {
+		return err
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	k.SetDenomMetaData(ctx, metadata)
+	return nil
+}
+
+// assertFactoryDenomAdmin returns an error if the denom is not a factory denom
+// or if the given address is not its admin.
+func (k BaseKeeper) assertFactoryDenomAdmin(ctx context.Context, admin, denom string) error {
+	factoryDenom, err := k.GetFactoryDenom(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if factoryDenom.Admin == "" {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s has no admin", denom)
+	}
+
+	adminAddr, err := k.ak.AddressCodec().StringToBytes(admin)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+	denomAdmin, err := k.ak.AddressCodec().StringToBytes(factoryDenom.Admin)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(adminAddr, denomAdmin) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the admin of %s", admin, denom)
+	}
+
+	return nil
+}
+
+// factoryDenomKey returns the FactoryDenoms key of a factory denom.
+func (k BaseKeeper) factoryDenomKey(denom string) (collections.Pair[sdk.AccAddress, string], error) {
+	creator, subdenom, err := types.ParseFactoryDenom(denom)
+	if err != nil {
+		return collections.Pair[sdk.AccAddress, string]{}, err
+	}
+
+	creatorAddr, err := k.ak.AddressCodec().StringToBytes(creator)
+	if err != nil {
+		return collections.Pair[sdk.AccAddress, string]{}, errorsmod.Wrapf(types.ErrInvalidFactoryDenom, "invalid creator address: %s", err)
+	}
+	// denoms created through the token factory always use the canonical form of the creator address
+	if canonical, err := k.ak.AddressCodec().BytesToString(creatorAddr); err != nil || canonical != creator {
+		return collections.Pair[sdk.AccAddress, string]{}, errorsmod.Wrapf(types.ErrInvalidFactoryDenom, "non canonical creator address %s", creator)
+	}
+
+	return collections.Join(sdk.AccAddress(creatorAddr), subdenom), nil
+}
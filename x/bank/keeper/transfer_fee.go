@@ -0,0 +1,230 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetDenomTransferFee registers or updates the transfer fee of a denom. The
+// collector and the exempt addresses are stored in their canonical form.
+func (k BaseSendKeeper) SetDenomTransferFee(ctx context.Context, fee types.DenomTransferFee) error {
+	if err := fee.Validate(); err != nil {
+		return err
+	}
+
+	var err error
+	if fee.Collector, err = k.canonicalAddress(fee.Collector); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid transfer fee collector: %s", err)
+	}
+
+	exemptAddresses := make([]string, 0, len(fee.ExemptAddresses))
+	for _, addr := range fee.ExemptAddresses {
+		addr, err := k.canonicalAddress(addr)
+		if err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid transfer fee exempt address: %s", err)
+		}
+		exemptAddresses = append(exemptAddresses, addr)
+	}
+	fee.ExemptAddresses = exemptAddresses
+
+	// exempt addresses differing only by their form are duplicates
+	if err := fee.Validate(); err != nil {
+		return err
+	}
+
+	if err := k.DenomTransferFees.Set(ctx, fee.Denom, fee); err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).Emit(&types.EventSetDenomTransferFee{
+		Denom:     fee.Denom,
+		Rate:      fee.Rate.String(),
+		Collector: fee.Collector,
+	})
+}
+
+// RemoveDenomTransferFee removes the transfer fee of a denom.
+func (k BaseSendKeeper) RemoveDenomTransferFee(ctx context.Context, denom string) error {
+	if has, err := k.DenomTransferFees.Has(ctx, denom); err != nil {
+		return err
+	} else if !has {
+		return errorsmod.Wrapf(types.ErrDenomTransferFeeNotFound, "denom %s", denom)
+	}
+
+	if err := k.DenomTransferFees.Remove(ctx, denom); err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).Emit(&types.EventRemoveDenomTransferFee{
+		Denom: denom,
+	})
+}
+
+// GetDenomTransferFee returns the transfer fee charged on a denom.
+func (k BaseSendKeeper) GetDenomTransferFee(ctx context.Context, denom string) (types.DenomTransferFee, error) {
+	fee, err := k.DenomTransferFees.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.DenomTransferFee{}, errorsmod.Wrapf(types.ErrDenomTransferFeeNotFound, "denom %s", denom)
+		}
+		return types.DenomTransferFee{}, err
+	}
+
+	return fee, nil
+}
+
+// GetAllDenomTransferFees returns the transfer fees charged on all denoms.
+func (k BaseSendKeeper) GetAllDenomTransferFees(ctx context.Context) ([]types.DenomTransferFee, error) {
+	var fees []types.DenomTransferFee
+	err := k.DenomTransferFees.Walk(ctx, nil, func(_ string, fee types.DenomTransferFee) (stop bool, err error) {
+		fees = append(fees, fee)
+		return false, nil
+	})
+
+	return fees, err
+}
+
+// canonicalAddress returns the canonical form of an address.
+func (k BaseSendKeeper) canonicalAddress(addr string) (string, error) {
+	bz, err := k.ak.AddressCodec().StringToBytes(addr)
+	if err != nil {
+		return "", err
+	}
+
+	return k.ak.AddressCodec().BytesToString(bz)
+}
+
+// transferFee is a denom transfer fee with its addresses decoded.
+type transferFee struct {
+	types.DenomTransferFee
+	collector sdk.AccAddress
+	exempt    map[string]bool
+}
+
+// collectedFees are the transfer fees credited to a collector.
+type collectedFees struct {
+	collector sdk.AccAddress
+	coins     sdk.Coins
+}
+
+// loadDenomTransferFees returns the transfer fees charged on the denoms of amt.
+func (k BaseSendKeeper) loadDenomTransferFees(ctx context.Context, amt sdk.Coins) (map[string]transferFee, error) {
+	var fees map[string]transferFee
+	for _, coin := range amt {
+		fee, err := k.DenomTransferFees.Get(ctx, coin.Denom)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			return nil, err
+		}
+
+		collector, err := k.ak.AddressCodec().StringToBytes(fee.Collector)
+		if err != nil {
+			return nil, err
+		}
+		exempt := make(map[string]bool, len(fee.ExemptAddresses))
+		for _, addr := range fee.ExemptAddresses {
+			bz, err := k.ak.AddressCodec().StringToBytes(addr)
+			if err != nil {
+				return nil, err
+			}
+			exempt[string(bz)] = true
+		}
+
+		if fees == nil {
+			fees = make(map[string]transferFee)
+		}
+		fees[coin.Denom] = transferFee{DenomTransferFee: fee, collector: collector, exempt: exempt}
+	}
+
+	return fees, nil
+}
+
+// chargeTransferFees charges the transfer fees of the denoms of amt on a
+// transfer, as part of its before transfer hooks. It returns the coins to
+// credit to the recipient, net of the fees, and the fees to credit to each
+// collector. Transfers from or to an exempt address or the collector are not
+// charged, nor are transfers from or to module accounts: their balances are
+// accounted for by their modules, and their payouts, such as unbondings, rewards
+// or deposit refunds, must be credited in full.
+func (k BaseSendKeeper) chargeTransferFees(
+	ctx context.Context, fees map[string]transferFee, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins,
+) (sdk.Coins, []collectedFees, error) {
+	if len(fees) == 0 || fromAddr.Equals(toAddr) {
+		return amt, nil, nil
+	}
+
+	var (
+		received  = amt
+		collected []collectedFees
+		checked   bool
+	)
+	for _, coin := range amt {
+		fee, ok := fees[coin.Denom]
+		if !ok || fee.exempt[string(fromAddr)] || fee.exempt[string(toAddr)] ||
+			fee.collector.Equals(fromAddr) || fee.collector.Equals(toAddr) {
+			continue
+		}
+
+		charged := sdk.NewCoin(coin.Denom, fee.Fee(coin.Amount))
+		if charged.IsZero() {
+			continue
+		}
+
+		// the accounts are only looked up once a fee applies
+		if !checked {
+			if k.isModuleAccount(ctx, fromAddr) || k.isModuleAccount(ctx, toAddr) {
+				return amt, nil, nil
+			}
+			checked = true
+		}
+
+		received = received.Sub(charged)
+
+		i := 0
+		for i < len(collected) && !collected[i].collector.Equals(fee.collector) {
+			i++
+		}
+		if i == len(collected) {
+			collected = append(collected, collectedFees{collector: fee.collector})
+		}
+		collected[i].coins = collected[i].coins.Add(charged)
+	}
+
+	if len(collected) == 0 {
+		return amt, nil, nil
+	}
+
+	fromAddrString, err := k.ak.AddressCodec().BytesToString(fromAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+	toAddrString, err := k.ak.AddressCodec().BytesToString(toAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, c := range collected {
+		collectorString, err := k.ak.AddressCodec().BytesToString(c.collector)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := k.environment.EventService.EventManager(ctx).Emit(&types.EventTransferFee{
+			Sender:    fromAddrString,
+			Recipient: toAddrString,
+			Collector: collectorString,
+			Fee:       c.coins.String(),
+		}); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return received, collected, nil
+}
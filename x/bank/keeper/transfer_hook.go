@@ -0,0 +1,248 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetTransferHooks sets the hooks dispatching the calls of the denom transfer
+// hooks to their targets.
+func (k BaseSendKeeper) SetTransferHooks(hooks types.TransferHooks) {
+	if k.transferHooks.hooks != nil {
+		panic("cannot set transfer hooks twice")
+	}
+
+	k.transferHooks.hooks = hooks
+}
+
+// transferHooksRoutes is implemented by the TransferHooks which know the targets
+// they dispatch the calls for, such as the TransferHooksRouter.
+type transferHooksRoutes interface {
+	HasRoute(target sdk.AccAddress) bool
+}
+
+// SetDenomTransferHook registers or updates the transfer hook of a denom. The
+// target of the hook is stored in its canonical form, and must be dispatched by
+// the TransferHooks set, so that a hook never fails all the transfers of a denom.
+func (k BaseSendKeeper) SetDenomTransferHook(ctx context.Context, hook types.DenomTransferHook) error {
+	if err := hook.Validate(); err != nil {
+		return err
+	}
+
+	target, err := k.ak.AddressCodec().StringToBytes(hook.Target)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid transfer hook target: %s", err)
+	}
+	if hook.Target, err = k.ak.AddressCodec().BytesToString(target); err != nil {
+		return err
+	}
+
+	if k.transferHooks.hooks == nil {
+		return errorsmod.Wrap(types.ErrInvalidDenomTransferHook, "no transfer hooks set to call the transfer hook")
+	}
+	if routes, ok := k.transferHooks.hooks.(transferHooksRoutes); ok && !routes.HasRoute(target) {
+		return errorsmod.Wrapf(types.ErrInvalidDenomTransferHook, "no transfer hooks registered for target %s", hook.Target)
+	}
+
+	if err := k.DenomTransferHooks.Set(ctx, hook.Denom, hook); err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).Emit(&types.EventSetDenomTransferHook{
+		Denom:  hook.Denom,
+		Target: hook.Target,
+	})
+}
+
+// RemoveDenomTransferHook removes the transfer hook of a denom.
+func (k BaseSendKeeper) RemoveDenomTransferHook(ctx context.Context, denom string) error {
+	if has, err := k.DenomTransferHooks.Has(ctx, denom); err != nil {
+		return err
+	} else if !has {
+		return errorsmod.Wrapf(types.ErrDenomTransferHookNotFound, "denom %s", denom)
+	}
+
+	if err := k.DenomTransferHooks.Remove(ctx, denom); err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).Emit(&types.EventRemoveDenomTransferHook{
+		Denom: denom,
+	})
+}
+
+// GetDenomTransferHook returns the transfer hook registered for a denom.
+func (k BaseSendKeeper) GetDenomTransferHook(ctx context.Context, denom string) (types.DenomTransferHook, error) {
+	hook, err := k.DenomTransferHooks.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.DenomTransferHook{}, errorsmod.Wrapf(types.ErrDenomTransferHookNotFound, "denom %s", denom)
+		}
+		return types.DenomTransferHook{}, err
+	}
+
+	return hook, nil
+}
+
+// GetAllDenomTransferHooks returns the transfer hooks registered for all denoms.
+func (k BaseSendKeeper) GetAllDenomTransferHooks(ctx context.Context) ([]types.DenomTransferHook, error) {
+	var hooks []types.DenomTransferHook
+	err := k.DenomTransferHooks.Walk(ctx, nil, func(_ string, hook types.DenomTransferHook) (stop bool, err error) {
+		hooks = append(hooks, hook)
+		return false, nil
+	})
+
+	return hooks, err
+}
+
+// denomTransferHooks are the transfer hooks registered for the denoms of a
+// transfer, along with their transfer fees, which are charged as part of the
+// before transfer hooks.
+type denomTransferHooks struct {
+	hooks map[string]types.DenomTransferHook
+	fees  map[string]transferFee
+}
+
+// loadDenomTransferHooks returns the transfer hooks and the transfer fees of the
+// denoms of amt, so that they are read once for all the transfers of a send.
+func (k BaseSendKeeper) loadDenomTransferHooks(ctx context.Context, amt sdk.Coins) (denomTransferHooks, error) {
+	hooks, err := k.loadDenomTransferHookTargets(ctx, amt)
+	if err != nil {
+		return denomTransferHooks{}, err
+	}
+
+	fees, err := k.loadDenomTransferFees(ctx, amt)
+	if err != nil {
+		return denomTransferHooks{}, err
+	}
+
+	return denomTransferHooks{hooks: hooks, fees: fees}, nil
+}
+
+// loadDenomTransferHookTargets returns the transfer hooks registered for the
+// denoms of amt.
+func (k BaseSendKeeper) loadDenomTransferHookTargets(ctx context.Context, amt sdk.Coins) (map[string]types.DenomTransferHook, error) {
+	var hooks map[string]types.DenomTransferHook
+	for _, coin := range amt {
+		hook, err := k.DenomTransferHooks.Get(ctx, coin.Denom)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			return nil, err
+		}
+
+		if hooks == nil {
+			hooks = make(map[string]types.DenomTransferHook)
+		}
+		hooks[coin.Denom] = hook
+	}
+
+	return hooks, nil
+}
+
+// beforeTransfer calls the before transfer hooks of the denoms of amt, then
+// charges their transfer fees, see chargeTransferFees. It returns the coins to
+// credit to the recipient, net of the fees, and the fees to credit to each
+// collector.
+func (k BaseSendKeeper) beforeTransfer(
+	ctx context.Context, hooks denomTransferHooks, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins,
+) (sdk.Coins, []collectedFees, error) {
+	if err := k.callTransferHooks(ctx, hooks.hooks, true, fromAddr, toAddr, amt); err != nil {
+		return nil, nil, err
+	}
+
+	return k.chargeTransferFees(ctx, hooks.fees, fromAddr, toAddr, amt)
+}
+
+// afterTransfer calls the after transfer hooks of the denoms of amt, the coins
+// credited to the recipient.
+func (k BaseSendKeeper) afterTransfer(
+	ctx context.Context, hooks denomTransferHooks, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins,
+) error {
+	return k.callTransferHooks(ctx, hooks.hooks, false, fromAddr, toAddr, amt)
+}
+
+// callTransferHooks calls the before or after transfer hooks of the denoms of
+// amt, each in a branched context limited to the gas limit of its hook. An error
+// is returned if a hook is registered but no TransferHooks are set to dispatch
+// it, so that a transfer is never silently let through. The transfers between
+// module accounts are not intercepted, and the errors of the hooks of the module
+// flows which must never fail, see transferHooksEnforced, are only logged.
+func (k BaseSendKeeper) callTransferHooks(
+	ctx context.Context, hooks map[string]types.DenomTransferHook, before bool, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins,
+) error {
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	checked, enforced := false, false
+	for _, coin := range amt {
+		hook, ok := hooks[coin.Denom]
+		if !ok || (before && !hook.BeforeTransfer) || (!before && !hook.AfterTransfer) {
+			continue
+		}
+
+		// the accounts are only looked up once a hook applies
+		if !checked {
+			fromModule, toModule := k.isModuleAccount(ctx, fromAddr), k.isModuleAccount(ctx, toAddr)
+			if fromModule && toModule {
+				return nil
+			}
+			enforced = transferHooksEnforced(fromModule, toAddr)
+			checked = true
+		}
+
+		if k.transferHooks.hooks == nil {
+			return errorsmod.Wrapf(sdkerrors.ErrLogic, "no transfer hooks set to call the transfer hook of %s", coin.Denom)
+		}
+
+		target, err := k.ak.AddressCodec().StringToBytes(hook.Target)
+		if err != nil {
+			return err
+		}
+
+		_, err = k.environment.BranchService.ExecuteWithGasLimit(ctx, hook.GasLimit, func(ctx context.Context) error {
+			if before {
+				return k.transferHooks.hooks.BeforeTransfer(ctx, target, fromAddr, toAddr, coin)
+			}
+			return k.transferHooks.hooks.AfterTransfer(ctx, target, fromAddr, toAddr, coin)
+		})
+		if err != nil {
+			if !enforced {
+				k.logger.Error("transfer hook of a module flow failed", "denom", coin.Denom, "target", hook.Target, "err", err)
+				continue
+			}
+			return errorsmod.Wrapf(err, "transfer hook of %s", coin.Denom)
+		}
+	}
+
+	return nil
+}
+
+// feeCollectorAddr is the address of the fee collector module account.
+var feeCollectorAddr = authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+// transferHooksEnforced returns whether the errors of the transfer hooks fail a
+// transfer. They do for the transfers made by users, including those to module
+// accounts such as delegations or deposits, but not for the module flows which
+// must never fail: the payouts of module accounts, e.g. rewards, unbondings or
+// refunds, often made in BeginBlock or EndBlock, and the fees paid to the fee
+// collector.
+func transferHooksEnforced(fromModule bool, toAddr sdk.AccAddress) bool {
+	return !fromModule && !toAddr.Equals(feeCollectorAddr)
+}
+
+// transferHooks is a struct that houses the TransferHooks.
+// It exists so that the hooks can be set in the BaseSendKeeper without needing to have a pointer receiver.
+type transferHooks struct {
+	hooks types.TransferHooks
+}
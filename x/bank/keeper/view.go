@@ -29,6 +29,7 @@ type ViewKeeper interface {
 	GetAccountsBalances(ctx context.Context) []types.Balance
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	LockedCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	GetHeldCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	SpendableCoin(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 
@@ -46,6 +47,27 @@ func newBalancesIndexes(sb *collections.SchemaBuilder) BalancesIndexes {
 	}
 }
 
+func newBalanceJournalIndexes(sb *collections.SchemaBuilder) BalanceJournalIndexes {
+	return BalanceJournalIndexes{
+		Height: indexes.NewMulti(
+			sb, types.BalanceJournalByHeightPrefix, "balance_journal_by_height",
+			collections.Uint64Key, collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key),
+			func(_ collections.Pair[sdk.AccAddress, uint64], entry types.BalanceJournalEntry) (uint64, error) {
+				return uint64(entry.Height), nil
+			},
+		),
+	}
+}
+
+// BalanceJournalIndexes defines the indexes of the balance journal.
+type BalanceJournalIndexes struct {
+	Height *indexes.Multi[uint64, collections.Pair[sdk.AccAddress, uint64], types.BalanceJournalEntry]
+}
+
+func (b BalanceJournalIndexes) IndexesList() []collections.Index[collections.Pair[sdk.AccAddress, uint64], types.BalanceJournalEntry] {
+	return []collections.Index[collections.Pair[sdk.AccAddress, uint64], types.BalanceJournalEntry]{b.Height}
+}
+
 type BalancesIndexes struct {
 	Denom *indexes.ReversePair[sdk.AccAddress, string, math.Int]
 }
@@ -67,6 +89,26 @@ type BaseViewKeeper struct {
 	SendEnabled   collections.Map[string, bool]
 	Balances      *collections.IndexedMap[collections.Pair[sdk.AccAddress, string], math.Int, BalancesIndexes]
 	Params        collections.Item[types.Params]
+	BalanceAlerts collections.Map[collections.Pair[sdk.AccAddress, string], math.Int]
+	FactoryDenoms collections.Map[collections.Pair[sdk.AccAddress, string], string]
+
+	SendRestrictionPolicies collections.Map[string, types.SendRestrictionPolicy]
+
+	BalanceJournal          *collections.IndexedMap[collections.Pair[sdk.AccAddress, uint64], types.BalanceJournalEntry, BalanceJournalIndexes]
+	BalanceJournalSequence  collections.Sequence
+	BalanceJournalAddresses collections.KeySet[sdk.AccAddress]
+
+	NonCirculatingAddresses collections.KeySet[sdk.AccAddress]
+
+	Holds collections.Map[collections.Pair[sdk.AccAddress, string], types.Hold]
+
+	DenomTransferHooks collections.Map[string, types.DenomTransferHook]
+
+	AccountActivities collections.Map[sdk.AccAddress, int64]
+
+	SpendingLimits collections.Map[collections.Pair[sdk.AccAddress, string], types.SpendingLimit]
+
+	DenomTransferFees collections.Map[string, types.DenomTransferFee]
 }
 
 // NewBaseViewKeeper returns a new BaseViewKeeper.
@@ -82,6 +124,26 @@ func NewBaseViewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, ak type
 		SendEnabled:   collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue), // NOTE: we use a bool value which uses protobuf to retain state backwards compat
 		Balances:      collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), types.BalanceValueCodec, newBalancesIndexes(sb)),
 		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		BalanceAlerts: collections.NewMap(sb, types.BalanceAlertsPrefix, "balance_alerts", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), sdk.IntValue),
+		FactoryDenoms: collections.NewMap(sb, types.FactoryDenomsPrefix, "factory_denoms", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), collections.StringValue),
+
+		SendRestrictionPolicies: collections.NewMap(sb, types.SendRestrictionPoliciesPrefix, "send_restriction_policies", collections.StringKey, codec.CollValue[types.SendRestrictionPolicy](cdc)),
+
+		BalanceJournal:          collections.NewIndexedMap(sb, types.BalanceJournalPrefix, "balance_journal", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key), codec.CollValue[types.BalanceJournalEntry](cdc), newBalanceJournalIndexes(sb)),
+		BalanceJournalSequence:  collections.NewSequence(sb, types.BalanceJournalSequenceKey, "balance_journal_sequence"),
+		BalanceJournalAddresses: collections.NewKeySet(sb, types.BalanceJournalAddressesPrefix, "balance_journal_addresses", sdk.AccAddressKey),
+
+		NonCirculatingAddresses: collections.NewKeySet(sb, types.NonCirculatingAddressesPrefix, "non_circulating_addresses", sdk.AccAddressKey),
+
+		Holds: collections.NewMap(sb, types.HoldsPrefix, "holds", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), codec.CollValue[types.Hold](cdc)),
+
+		DenomTransferHooks: collections.NewMap(sb, types.DenomTransferHooksPrefix, "denom_transfer_hooks", collections.StringKey, codec.CollValue[types.DenomTransferHook](cdc)),
+
+		AccountActivities: collections.NewMap(sb, types.AccountActivitiesPrefix, "account_activities", sdk.AccAddressKey, collections.Int64Value),
+
+		SpendingLimits: collections.NewMap(sb, types.SpendingLimitsPrefix, "spending_limits", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), codec.CollValue[types.SpendingLimit](cdc)),
+
+		DenomTransferFees: collections.NewMap(sb, types.DenomTransferFeesPrefix, "denom_transfer_fees", collections.StringKey, codec.CollValue[types.DenomTransferFee](cdc)),
 	}
 
 	schema, err := sb.Build()
@@ -178,19 +240,35 @@ func (k BaseViewKeeper) IterateAllBalances(ctx context.Context, cb func(sdk.AccA
 }
 
 // LockedCoins returns all the coins that are not spendable (i.e. locked) for an
-// account by address. For standard accounts, the result will always be no coins.
-// For vesting accounts, LockedCoins is delegated to the concrete vesting account
-// type.
+// account by address. For standard accounts, the result is the coins placed on
+// hold by modules. For vesting accounts, the locked coins of the concrete
+// vesting account type are added to them.
 func (k BaseViewKeeper) LockedCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	held := k.GetHeldCoins(ctx, addr)
+
 	acc := k.ak.GetAccount(ctx, addr)
 	if acc != nil {
 		vacc, ok := acc.(types.VestingAccount)
 		if ok {
-			return vacc.LockedCoins(k.environment.HeaderService.GetHeaderInfo(ctx).Time)
+			return vacc.LockedCoins(k.environment.HeaderService.GetHeaderInfo(ctx).Time).Add(held...)
 		}
 	}
 
-	return sdk.NewCoins()
+	return held
+}
+
+// GetHeldCoins returns the coins of an account placed on hold by all modules.
+func (k BaseViewKeeper) GetHeldCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	held := sdk.NewCoins()
+	err := k.Holds.Walk(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, string](addr), func(_ collections.Pair[sdk.AccAddress, string], hold types.Hold) (bool, error) {
+		held = held.Add(hold.Amount...)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return held
 }
 
 // SpendableCoins returns the total balances of spendable coins for an account
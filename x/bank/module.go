@@ -40,6 +40,7 @@ var (
 	_ appmodule.AppModule     = AppModule{}
 	_ appmodule.HasServices   = AppModule{}
 	_ appmodule.HasMigrations = AppModule{}
+	_ appmodule.HasEndBlocker = AppModule{}
 )
 
 // AppModule implements an application module for the bank module.
@@ -150,6 +151,17 @@ func (am AppModule) ExportGenesis(ctx context.Context, cdc codec.JSONCodec) json
 	return cdc.MustMarshalJSON(gs)
 }
 
+// EndBlock prunes the balance journal entries older than the configured
+// retention, and emits the transfers between module accounts traced within the
+// block.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	if err := am.keeper.PruneBalanceJournal(ctx); err != nil {
+		return err
+	}
+
+	return am.keeper.EmitModuleTransferTrace(ctx)
+}
+
 // ConsensusVersion implements HasConsensusVersion
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewBalanceAlert creates a new BalanceAlert object.
+func NewBalanceAlert(address, denom string, threshold math.Int) BalanceAlert {
+	return BalanceAlert{
+		Address:   address,
+		Denom:     denom,
+		Threshold: threshold,
+	}
+}
+
+// Validate performs a stateless validation of the balance alert. A zero
+// threshold is valid and is used to delete an existing alert.
+func (ba BalanceAlert) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(ba.Address); err != nil {
+		return fmt.Errorf("invalid balance alert address %q: %w", ba.Address, err)
+	}
+
+	if err := sdk.ValidateDenom(ba.Denom); err != nil {
+		return err
+	}
+
+	if ba.Threshold.IsNil() || ba.Threshold.IsNegative() {
+		return errors.New("balance alert threshold cannot be nil or negative")
+	}
+
+	return nil
+}
+
+// IsCrossed returns whether a balance change from previous to current crosses
+// the threshold, and if so whether the balance fell below it.
+func (ba BalanceAlert) IsCrossed(previous, current math.Int) (crossed, below bool) {
+	wasBelow := previous.LT(ba.Threshold)
+	isBelow := current.LT(ba.Threshold)
+	return wasBelow != isBelow, isBelow
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Reasons of the balance changes recorded in the balance journal.
+const (
+	BalanceChangeReasonSend        = "send"
+	BalanceChangeReasonMultiSend   = "multi_send"
+	BalanceChangeReasonMint        = "mint"
+	BalanceChangeReasonBurn        = "burn"
+	BalanceChangeReasonDelegate    = "delegate"
+	BalanceChangeReasonUndelegate  = "undelegate"
+	BalanceChangeReasonTransferFee = "transfer_fee"
+)
+
+// Validate performs a stateless validation of the balance journal entry.
+func (e BalanceJournalEntry) Validate() error {
+	if e.Address == "" {
+		return errors.New("balance journal entry address cannot be empty")
+	}
+
+	if e.Height < 0 {
+		return fmt.Errorf("balance journal entry height cannot be negative: %d", e.Height)
+	}
+
+	if err := sdk.ValidateDenom(e.Denom); err != nil {
+		return err
+	}
+
+	if e.Delta.IsNil() || e.Delta.IsZero() {
+		return errors.New("balance journal entry delta cannot be nil or zero")
+	}
+
+	return nil
+}
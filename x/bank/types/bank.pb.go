@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -12,15 +13,20 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -28,6 +34,48 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SendRestrictionType enumerates the kinds of send restriction policies.
+type SendRestrictionType int32
+
+const (
+	// SEND_RESTRICTION_TYPE_UNSPECIFIED defines an unspecified restriction type
+	// which will return an error.
+	SEND_RESTRICTION_TYPE_UNSPECIFIED SendRestrictionType = 0
+	// SEND_RESTRICTION_TYPE_ADDRESS_BLOCKLIST blocks all the sends from or to the
+	// addresses of the policy.
+	SEND_RESTRICTION_TYPE_ADDRESS_BLOCKLIST SendRestrictionType = 1
+	// SEND_RESTRICTION_TYPE_DENOM_FREEZE blocks all the sends of the denoms of the
+	// policy, including the ones from or to module accounts. If the policy lists
+	// addresses, only the balances held by these addresses are frozen.
+	SEND_RESTRICTION_TYPE_DENOM_FREEZE SendRestrictionType = 2
+	// SEND_RESTRICTION_TYPE_TRANSFER_PAUSE pauses the transfers of the denoms of
+	// the policy between accounts. Sends from or to module accounts, such as fee
+	// payments, are still allowed.
+	SEND_RESTRICTION_TYPE_TRANSFER_PAUSE SendRestrictionType = 3
+)
+
+var SendRestrictionType_name = map[int32]string{
+	0: "SEND_RESTRICTION_TYPE_UNSPECIFIED",
+	1: "SEND_RESTRICTION_TYPE_ADDRESS_BLOCKLIST",
+	2: "SEND_RESTRICTION_TYPE_DENOM_FREEZE",
+	3: "SEND_RESTRICTION_TYPE_TRANSFER_PAUSE",
+}
+
+var SendRestrictionType_value = map[string]int32{
+	"SEND_RESTRICTION_TYPE_UNSPECIFIED":       0,
+	"SEND_RESTRICTION_TYPE_ADDRESS_BLOCKLIST": 1,
+	"SEND_RESTRICTION_TYPE_DENOM_FREEZE":      2,
+	"SEND_RESTRICTION_TYPE_TRANSFER_PAUSE":    3,
+}
+
+func (x SendRestrictionType) String() string {
+	return proto.EnumName(SendRestrictionType_name, int32(x))
+}
+
+func (SendRestrictionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{0}
+}
+
 // Params defines the parameters for the bank module.
 type Params struct {
 	// Deprecated: Use of SendEnabled in params is deprecated.
@@ -37,6 +85,25 @@ type Params struct {
 	// As of cosmos-sdk 0.47, this only exists for backwards compatibility of genesis files.
 	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"` // Deprecated: Do not use.
 	DefaultSendEnabled bool           `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty"`
+	// balance_journal_addresses are the addresses whose balance changes are
+	// recorded in the balance journal.
+	BalanceJournalAddresses []string `protobuf:"bytes,3,rep,name=balance_journal_addresses,json=balanceJournalAddresses,proto3" json:"balance_journal_addresses,omitempty"`
+	// balance_journal_retention is the number of blocks the balance journal
+	// entries are kept for before being pruned. Zero keeps them forever.
+	BalanceJournalRetention uint64 `protobuf:"varint,4,opt,name=balance_journal_retention,json=balanceJournalRetention,proto3" json:"balance_journal_retention,omitempty"`
+	// dust_thresholds are the per-denom amounts below which a balance is
+	// considered dust and can be swept to the community pool.
+	DustThresholds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=dust_thresholds,json=dustThresholds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"dust_thresholds"`
+	// dust_inactivity_blocks is the number of blocks an account must have had
+	// no balance change for before its dust can be swept. Zero disables dust
+	// sweeping.
+	DustInactivityBlocks uint64 `protobuf:"varint,6,opt,name=dust_inactivity_blocks,json=dustInactivityBlocks,proto3" json:"dust_inactivity_blocks,omitempty"`
+	// dust_sweep_excluded_addresses are the addresses whose dust is never swept.
+	DustSweepExcludedAddresses []string `protobuf:"bytes,7,rep,name=dust_sweep_excluded_addresses,json=dustSweepExcludedAddresses,proto3" json:"dust_sweep_excluded_addresses,omitempty"`
+	// spending_limit_change_delay is the delay before raising or removing a
+	// spending limit takes effect, giving the owner of a compromised key time to
+	// react. Lowering a spending limit takes effect immediately.
+	SpendingLimitChangeDelay time.Duration `protobuf:"bytes,8,opt,name=spending_limit_change_delay,json=spendingLimitChangeDelay,proto3,stdduration" json:"spending_limit_change_delay"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -87,6 +154,48 @@ func (m *Params) GetDefaultSendEnabled() bool {
 	return false
 }
 
+func (m *Params) GetBalanceJournalAddresses() []string {
+	if m != nil {
+		return m.BalanceJournalAddresses
+	}
+	return nil
+}
+
+func (m *Params) GetBalanceJournalRetention() uint64 {
+	if m != nil {
+		return m.BalanceJournalRetention
+	}
+	return 0
+}
+
+func (m *Params) GetDustThresholds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DustThresholds
+	}
+	return nil
+}
+
+func (m *Params) GetDustInactivityBlocks() uint64 {
+	if m != nil {
+		return m.DustInactivityBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetDustSweepExcludedAddresses() []string {
+	if m != nil {
+		return m.DustSweepExcludedAddresses
+	}
+	return nil
+}
+
+func (m *Params) GetSpendingLimitChangeDelay() time.Duration {
+	if m != nil {
+		return m.SpendingLimitChangeDelay
+	}
+	return 0
+}
+
 // SendEnabled maps coin denom to a send_enabled status (whether a denom is
 // sendable).
 type SendEnabled struct {
@@ -141,24 +250,33 @@ func (m *SendEnabled) GetEnabled() bool {
 	return false
 }
 
-// Input models transaction input.
-type Input struct {
-	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Coins   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+// BalanceJournalEntry defines a balance change of an account recorded in the
+// balance journal.
+type BalanceJournalEntry struct {
+	// address is the address of the account whose balance changed.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// height is the block height at which the balance changed.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// denom is the denom of the balance which changed.
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// delta is the signed change of the balance.
+	Delta cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=delta,proto3,customtype=cosmossdk.io/math.Int" json:"delta"`
+	// reason is the operation which changed the balance, e.g. send, mint or burn.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *Input) Reset()         { *m = Input{} }
-func (m *Input) String() string { return proto.CompactTextString(m) }
-func (*Input) ProtoMessage()    {}
-func (*Input) Descriptor() ([]byte, []int) {
+func (m *BalanceJournalEntry) Reset()         { *m = BalanceJournalEntry{} }
+func (m *BalanceJournalEntry) String() string { return proto.CompactTextString(m) }
+func (*BalanceJournalEntry) ProtoMessage()    {}
+func (*BalanceJournalEntry) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dd052eee12edf988, []int{2}
 }
-func (m *Input) XXX_Unmarshal(b []byte) error {
+func (m *BalanceJournalEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Input) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BalanceJournalEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Input.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BalanceJournalEntry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -168,36 +286,70 @@ func (m *Input) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Input) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Input.Merge(m, src)
+func (m *BalanceJournalEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceJournalEntry.Merge(m, src)
 }
-func (m *Input) XXX_Size() int {
+func (m *BalanceJournalEntry) XXX_Size() int {
 	return m.Size()
 }
-func (m *Input) XXX_DiscardUnknown() {
-	xxx_messageInfo_Input.DiscardUnknown(m)
+func (m *BalanceJournalEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceJournalEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Input proto.InternalMessageInfo
+var xxx_messageInfo_BalanceJournalEntry proto.InternalMessageInfo
 
-// Output models transaction outputs.
-type Output struct {
-	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Coins   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+func (m *BalanceJournalEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *Output) Reset()         { *m = Output{} }
-func (m *Output) String() string { return proto.CompactTextString(m) }
-func (*Output) ProtoMessage()    {}
-func (*Output) Descriptor() ([]byte, []int) {
+func (m *BalanceJournalEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BalanceJournalEntry) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *BalanceJournalEntry) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// BalanceAlert defines a governance registered balance threshold for a module
+// account. An EventBalanceAlert is emitted every time a balance change of the
+// account crosses the threshold in either direction.
+type BalanceAlert struct {
+	// address is the address of the monitored module account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// denom is the denom of the monitored balance.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// threshold is the balance amount that triggers an alert when crossed.
+	Threshold cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=threshold,proto3,customtype=cosmossdk.io/math.Int" json:"threshold"`
+}
+
+func (m *BalanceAlert) Reset()         { *m = BalanceAlert{} }
+func (m *BalanceAlert) String() string { return proto.CompactTextString(m) }
+func (*BalanceAlert) ProtoMessage()    {}
+func (*BalanceAlert) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dd052eee12edf988, []int{3}
 }
-func (m *Output) XXX_Unmarshal(b []byte) error {
+func (m *BalanceAlert) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Output) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BalanceAlert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Output.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BalanceAlert.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -207,40 +359,55 @@ func (m *Output) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Output) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Output.Merge(m, src)
+func (m *BalanceAlert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceAlert.Merge(m, src)
 }
-func (m *Output) XXX_Size() int {
+func (m *BalanceAlert) XXX_Size() int {
 	return m.Size()
 }
-func (m *Output) XXX_DiscardUnknown() {
-	xxx_messageInfo_Output.DiscardUnknown(m)
+func (m *BalanceAlert) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceAlert.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Output proto.InternalMessageInfo
+var xxx_messageInfo_BalanceAlert proto.InternalMessageInfo
 
-// Supply represents a struct that passively keeps track of the total supply
-// amounts in the network.
-// This message is deprecated now that supply is indexed by denom.
-//
-// Deprecated: Do not use.
-type Supply struct {
-	// total is the total supply of coins across the network.
-	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
+func (m *BalanceAlert) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *Supply) Reset()         { *m = Supply{} }
-func (m *Supply) String() string { return proto.CompactTextString(m) }
-func (*Supply) ProtoMessage()    {}
-func (*Supply) Descriptor() ([]byte, []int) {
+func (m *BalanceAlert) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// FactoryDenom defines a denom created through the token factory, in the
+// factory/{creator}/{subdenom} format, along with its admin.
+type FactoryDenom struct {
+	// denom is the full denom of the factory denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// admin is the account allowed to mint, burn and force transfer the denom,
+	// and to set its metadata. An empty admin means the denom has no admin
+	// anymore and its supply can no longer be changed through the token factory.
+	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+}
+
+func (m *FactoryDenom) Reset()         { *m = FactoryDenom{} }
+func (m *FactoryDenom) String() string { return proto.CompactTextString(m) }
+func (*FactoryDenom) ProtoMessage()    {}
+func (*FactoryDenom) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dd052eee12edf988, []int{4}
 }
-func (m *Supply) XXX_Unmarshal(b []byte) error {
+func (m *FactoryDenom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Supply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FactoryDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Supply.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FactoryDenom.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -250,45 +417,56 @@ func (m *Supply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Supply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Supply.Merge(m, src)
+func (m *FactoryDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FactoryDenom.Merge(m, src)
 }
-func (m *Supply) XXX_Size() int {
+func (m *FactoryDenom) XXX_Size() int {
 	return m.Size()
 }
-func (m *Supply) XXX_DiscardUnknown() {
-	xxx_messageInfo_Supply.DiscardUnknown(m)
+func (m *FactoryDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_FactoryDenom.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Supply proto.InternalMessageInfo
+var xxx_messageInfo_FactoryDenom proto.InternalMessageInfo
 
-// DenomUnit represents a struct that describes a given
-// denomination unit of the basic token.
-type DenomUnit struct {
-	// denom represents the string name of the given denom unit (e.g uatom).
-	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	// exponent represents power of 10 exponent that one must
-	// raise the base_denom to in order to equal the given DenomUnit's denom
-	// 1 denom = 10^exponent base_denom
-	// (e.g. with a base_denom of uatom, one can create a DenomUnit of 'atom' with
-	// exponent = 6, thus: 1 atom = 10^6 uatom).
-	Exponent uint32 `protobuf:"varint,2,opt,name=exponent,proto3" json:"exponent,omitempty"`
-	// aliases is a list of string aliases for the given denom
-	Aliases []string `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
+func (m *FactoryDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *DenomUnit) Reset()         { *m = DenomUnit{} }
-func (m *DenomUnit) String() string { return proto.CompactTextString(m) }
-func (*DenomUnit) ProtoMessage()    {}
-func (*DenomUnit) Descriptor() ([]byte, []int) {
+func (m *FactoryDenom) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+// Hold defines coins of an account placed on hold by a module. Held coins stay
+// in the balance of the account but are not spendable until the hold is
+// released.
+type Hold struct {
+	// address is the address of the account whose coins are held.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// holder is the name of the module which placed the hold.
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	// amount is the amount of coins held.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *Hold) Reset()         { *m = Hold{} }
+func (m *Hold) String() string { return proto.CompactTextString(m) }
+func (*Hold) ProtoMessage()    {}
+func (*Hold) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dd052eee12edf988, []int{5}
 }
-func (m *DenomUnit) XXX_Unmarshal(b []byte) error {
+func (m *Hold) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DenomUnit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Hold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DenomUnit.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Hold.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -298,82 +476,70 @@ func (m *DenomUnit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *DenomUnit) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DenomUnit.Merge(m, src)
+func (m *Hold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Hold.Merge(m, src)
 }
-func (m *DenomUnit) XXX_Size() int {
+func (m *Hold) XXX_Size() int {
 	return m.Size()
 }
-func (m *DenomUnit) XXX_DiscardUnknown() {
-	xxx_messageInfo_DenomUnit.DiscardUnknown(m)
+func (m *Hold) XXX_DiscardUnknown() {
+	xxx_messageInfo_Hold.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DenomUnit proto.InternalMessageInfo
+var xxx_messageInfo_Hold proto.InternalMessageInfo
 
-func (m *DenomUnit) GetDenom() string {
+func (m *Hold) GetAddress() string {
 	if m != nil {
-		return m.Denom
+		return m.Address
 	}
 	return ""
 }
 
-func (m *DenomUnit) GetExponent() uint32 {
+func (m *Hold) GetHolder() string {
 	if m != nil {
-		return m.Exponent
+		return m.Holder
 	}
-	return 0
+	return ""
 }
 
-func (m *DenomUnit) GetAliases() []string {
+func (m *Hold) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.Aliases
+		return m.Amount
 	}
 	return nil
 }
 
-// Metadata represents a struct that describes
-// a basic token.
-type Metadata struct {
-	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
-	// denom_units represents the list of DenomUnit's for a given coin
-	DenomUnits []*DenomUnit `protobuf:"bytes,2,rep,name=denom_units,json=denomUnits,proto3" json:"denom_units,omitempty"`
-	// base represents the base denom (should be the DenomUnit with exponent = 0).
-	Base string `protobuf:"bytes,3,opt,name=base,proto3" json:"base,omitempty"`
-	// display indicates the suggested denom that should be
-	// displayed in clients.
-	Display string `protobuf:"bytes,4,opt,name=display,proto3" json:"display,omitempty"`
-	// name defines the name of the token (eg: Cosmos Atom)
-	//
-	// Since: cosmos-sdk 0.43
-	Name string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
-	// symbol is the token symbol usually shown on exchanges (eg: ATOM). This can
-	// be the same as the display.
-	//
-	// Since: cosmos-sdk 0.43
-	Symbol string `protobuf:"bytes,6,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	// URI to a document (on or off-chain) that contains additional information. Optional.
-	//
-	// Since: cosmos-sdk 0.46
-	URI string `protobuf:"bytes,7,opt,name=uri,proto3" json:"uri,omitempty"`
-	// URIHash is a sha256 hash of a document pointed by URI. It's used to verify that
-	// the document didn't change. Optional.
-	//
-	// Since: cosmos-sdk 0.46
-	URIHash string `protobuf:"bytes,8,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+// DenomTransferHook defines a callback target registered for a denom, invoked
+// by the bank keeper with a bounded amount of gas before and/or after every
+// transfer of the denom.
+type DenomTransferHook struct {
+	// denom is the denom the hook is registered for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// target is the address of the module or contract the hook is dispatched to.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// before_transfer defines whether the target is called before transfers of
+	// the denom. An error returned by the target aborts the transfer.
+	BeforeTransfer bool `protobuf:"varint,3,opt,name=before_transfer,json=beforeTransfer,proto3" json:"before_transfer,omitempty"`
+	// after_transfer defines whether the target is called after transfers of the
+	// denom. An error returned by the target aborts the transfer.
+	AfterTransfer bool `protobuf:"varint,4,opt,name=after_transfer,json=afterTransfer,proto3" json:"after_transfer,omitempty"`
+	// gas_limit is the maximum amount of gas a single call to the target can
+	// consume.
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 }
 
-func (m *Metadata) Reset()         { *m = Metadata{} }
-func (m *Metadata) String() string { return proto.CompactTextString(m) }
-func (*Metadata) ProtoMessage()    {}
-func (*Metadata) Descriptor() ([]byte, []int) {
+func (m *DenomTransferHook) Reset()         { *m = DenomTransferHook{} }
+func (m *DenomTransferHook) String() string { return proto.CompactTextString(m) }
+func (*DenomTransferHook) ProtoMessage()    {}
+func (*DenomTransferHook) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dd052eee12edf988, []int{6}
 }
-func (m *Metadata) XXX_Unmarshal(b []byte) error {
+func (m *DenomTransferHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Metadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DenomTransferHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Metadata.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DenomTransferHook.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -383,701 +549,4383 @@ func (m *Metadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Metadata) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Metadata.Merge(m, src)
+func (m *DenomTransferHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomTransferHook.Merge(m, src)
 }
-func (m *Metadata) XXX_Size() int {
+func (m *DenomTransferHook) XXX_Size() int {
 	return m.Size()
 }
-func (m *Metadata) XXX_DiscardUnknown() {
-	xxx_messageInfo_Metadata.DiscardUnknown(m)
+func (m *DenomTransferHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomTransferHook.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Metadata proto.InternalMessageInfo
+var xxx_messageInfo_DenomTransferHook proto.InternalMessageInfo
 
-func (m *Metadata) GetDescription() string {
+func (m *DenomTransferHook) GetDenom() string {
 	if m != nil {
-		return m.Description
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *Metadata) GetDenomUnits() []*DenomUnit {
-	if m != nil {
-		return m.DenomUnits
-	}
-	return nil
-}
-
-func (m *Metadata) GetBase() string {
+func (m *DenomTransferHook) GetTarget() string {
 	if m != nil {
-		return m.Base
+		return m.Target
 	}
 	return ""
 }
 
-func (m *Metadata) GetDisplay() string {
+func (m *DenomTransferHook) GetBeforeTransfer() bool {
 	if m != nil {
-		return m.Display
+		return m.BeforeTransfer
 	}
-	return ""
+	return false
 }
 
-func (m *Metadata) GetName() string {
+func (m *DenomTransferHook) GetAfterTransfer() bool {
 	if m != nil {
-		return m.Name
+		return m.AfterTransfer
 	}
-	return ""
+	return false
 }
 
-func (m *Metadata) GetSymbol() string {
+func (m *DenomTransferHook) GetGasLimit() uint64 {
 	if m != nil {
-		return m.Symbol
+		return m.GasLimit
 	}
-	return ""
+	return 0
 }
 
-func (m *Metadata) GetURI() string {
-	if m != nil {
-		return m.URI
-	}
-	return ""
+// DenomTransferFee defines the fee charged by the bank keeper on every transfer
+// of a denom, deducted from the amount credited to the recipient and credited
+// to the collector.
+type DenomTransferFee struct {
+	// denom is the denom the fee is charged on.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate is the fraction of every transfer charged as fee, lower than one. The
+	// fee is rounded down.
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+	// collector is the address of the account the fees are credited to.
+	Collector string `protobuf:"bytes,3,opt,name=collector,proto3" json:"collector,omitempty"`
+	// exempt_addresses are the addresses whose transfers are not charged, as
+	// sender or as recipient.
+	ExemptAddresses []string `protobuf:"bytes,4,rep,name=exempt_addresses,json=exemptAddresses,proto3" json:"exempt_addresses,omitempty"`
 }
 
-func (m *Metadata) GetURIHash() string {
-	if m != nil {
-		return m.URIHash
-	}
-	return ""
+func (m *DenomTransferFee) Reset()         { *m = DenomTransferFee{} }
+func (m *DenomTransferFee) String() string { return proto.CompactTextString(m) }
+func (*DenomTransferFee) ProtoMessage()    {}
+func (*DenomTransferFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{7}
 }
-
-func init() {
-	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
-	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
-	proto.RegisterType((*Input)(nil), "cosmos.bank.v1beta1.Input")
-	proto.RegisterType((*Output)(nil), "cosmos.bank.v1beta1.Output")
-	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
-	proto.RegisterType((*DenomUnit)(nil), "cosmos.bank.v1beta1.DenomUnit")
-	proto.RegisterType((*Metadata)(nil), "cosmos.bank.v1beta1.Metadata")
+func (m *DenomTransferFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
-
-var fileDescriptor_dd052eee12edf988 = []byte{
-	// 683 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xbf, 0x6f, 0x13, 0x4b,
-	0x10, 0xf6, 0xda, 0xf1, 0x8f, 0xac, 0xf3, 0x8a, 0xb7, 0xcf, 0x7a, 0x6f, 0x93, 0x27, 0xce, 0x96,
-	0x0b, 0x64, 0x2c, 0xc5, 0x47, 0x92, 0xce, 0x0d, 0xc2, 0xe1, 0x97, 0x0b, 0x04, 0xba, 0x28, 0x42,
-	0xa2, 0xb1, 0xd6, 0xbe, 0xc5, 0x5e, 0xe5, 0x6e, 0xf7, 0x74, 0xbb, 0x17, 0xe2, 0x96, 0x0a, 0xa5,
-	0xa2, 0xa6, 0x8a, 0xa8, 0x10, 0xa2, 0x70, 0x91, 0x9e, 0x36, 0x4a, 0x15, 0x51, 0x51, 0x05, 0xe4,
-	0x14, 0xce, 0x9f, 0x81, 0x6e, 0xf7, 0xce, 0x71, 0xa4, 0xd0, 0x22, 0xd1, 0xd8, 0x33, 0xf3, 0x7d,
-	0x3b, 0xf3, 0xcd, 0xec, 0xdc, 0x42, 0x6b, 0x20, 0xa4, 0x2f, 0xa4, 0xdd, 0x27, 0x7c, 0xcf, 0xde,
-	0xdf, 0xe8, 0x53, 0x45, 0x36, 0xb4, 0xd3, 0x0a, 0x42, 0xa1, 0x04, 0xfa, 0xc7, 0xe0, 0x2d, 0x1d,
-	0x4a, 0xf0, 0xb5, 0xca, 0x50, 0x0c, 0x85, 0xc6, 0xed, 0xd8, 0x32, 0xd4, 0xb5, 0x55, 0x43, 0xed,
-	0x19, 0x20, 0x39, 0x67, 0xa0, 0xab, 0x2a, 0x92, 0xce, 0xab, 0x0c, 0x04, 0xe3, 0x09, 0xfe, 0x5f,
-	0x82, 0xfb, 0x72, 0x68, 0xef, 0x6f, 0xc4, 0x7f, 0x09, 0xf0, 0x37, 0xf1, 0x19, 0x17, 0xb6, 0xfe,
-	0x35, 0xa1, 0xfa, 0x07, 0x00, 0x0b, 0xcf, 0x49, 0x48, 0x7c, 0x89, 0x1e, 0xc3, 0x15, 0x49, 0xb9,
-	0xdb, 0xa3, 0x9c, 0xf4, 0x3d, 0xea, 0x62, 0x50, 0xcb, 0x35, 0xca, 0x9b, 0xb5, 0xd6, 0x0d, 0x9a,
-	0x5b, 0x3b, 0x94, 0xbb, 0x0f, 0x0d, 0xaf, 0x93, 0xc5, 0xc0, 0x29, 0xcb, 0xab, 0x00, 0xba, 0x0b,
-	0x2b, 0x2e, 0x7d, 0x45, 0x22, 0x4f, 0xf5, 0xae, 0x25, 0xcc, 0xd6, 0x40, 0xa3, 0xe4, 0xa0, 0x04,
-	0x5b, 0x48, 0xd1, 0xbe, 0x75, 0x38, 0x9b, 0x34, 0xb1, 0x29, 0xb4, 0x2e, 0xdd, 0x3d, 0xfb, 0xc0,
-	0x8c, 0xd0, 0x28, 0xab, 0x6f, 0xc3, 0xf2, 0x02, 0x1b, 0x55, 0x60, 0xde, 0xa5, 0x5c, 0xf8, 0x18,
-	0xd4, 0x40, 0x63, 0xd9, 0x31, 0x0e, 0xc2, 0xb0, 0x78, 0xbd, 0x50, 0xea, 0xb6, 0x97, 0x2e, 0x8f,
-	0xaa, 0xa0, 0x7e, 0x0a, 0x60, 0xbe, 0xcb, 0x83, 0x48, 0xa1, 0x4d, 0x58, 0x24, 0xae, 0x1b, 0x52,
-	0x29, 0x4d, 0x86, 0x0e, 0xfe, 0x7a, 0xbc, 0x5e, 0x49, 0xda, 0xbc, 0x6f, 0x90, 0x1d, 0x15, 0x32,
-	0x3e, 0x74, 0x52, 0x22, 0x7a, 0x0d, 0xf3, 0xf1, 0x84, 0x25, 0xce, 0xea, 0xa9, 0xac, 0x5e, 0x4d,
-	0x45, 0xd2, 0xf9, 0x54, 0xb6, 0x05, 0xe3, 0x9d, 0x47, 0x27, 0xe7, 0xd5, 0xcc, 0xa7, 0xef, 0xd5,
-	0xc6, 0x90, 0xa9, 0x51, 0xd4, 0x6f, 0x0d, 0x84, 0x9f, 0x5c, 0x9f, 0xbd, 0xd0, 0xa0, 0x1a, 0x07,
-	0x54, 0xea, 0x03, 0xf2, 0xfd, 0x6c, 0xd2, 0x5c, 0xf1, 0xe8, 0x90, 0x0c, 0xc6, 0x3d, 0x5d, 0xe3,
-	0xe3, 0x6c, 0xd2, 0x04, 0x8e, 0xa9, 0xd7, 0xae, 0xbc, 0x3d, 0xaa, 0x66, 0x2e, 0x8f, 0xaa, 0x99,
-	0x37, 0xb3, 0x49, 0x33, 0x95, 0x53, 0xff, 0x02, 0x60, 0xe1, 0x59, 0xa4, 0xfe, 0xb8, 0x6e, 0x4a,
-	0x69, 0x37, 0xf5, 0xcf, 0x00, 0x16, 0x76, 0xa2, 0x20, 0xf0, 0xc6, 0xb1, 0x1a, 0x25, 0x14, 0xf1,
-	0x92, 0x8d, 0xfb, 0x1d, 0x6a, 0x74, 0xbd, 0xf6, 0x9d, 0x44, 0x0d, 0x38, 0x3d, 0x5e, 0xff, 0xff,
-	0xc6, 0x35, 0xd7, 0x02, 0xbb, 0x18, 0xd4, 0x5f, 0xc0, 0xe5, 0x07, 0xf1, 0x9a, 0xed, 0x72, 0xa6,
-	0x7e, 0xb1, 0x80, 0x6b, 0xb0, 0x44, 0x0f, 0x02, 0xc1, 0x29, 0x57, 0x7a, 0x03, 0xff, 0x72, 0xe6,
-	0x7e, 0xbc, 0x9c, 0xc4, 0x63, 0x44, 0x52, 0x89, 0x73, 0xb5, 0x5c, 0x63, 0xd9, 0x49, 0xdd, 0xfa,
-	0x61, 0x16, 0x96, 0x9e, 0x52, 0x45, 0x5c, 0xa2, 0x08, 0xaa, 0xc1, 0xb2, 0x4b, 0xe5, 0x20, 0x64,
-	0x81, 0x62, 0x82, 0x27, 0xe9, 0x17, 0x43, 0xe8, 0x5e, 0xcc, 0xe0, 0xc2, 0xef, 0x45, 0x9c, 0xa9,
-	0xf4, 0xfe, 0xac, 0x1b, 0xbf, 0xd1, 0xb9, 0x5e, 0x07, 0xba, 0xa9, 0x29, 0x11, 0x82, 0x4b, 0xf1,
-	0x5c, 0x71, 0x4e, 0xe7, 0xd6, 0x76, 0xac, 0xce, 0x65, 0x32, 0xf0, 0xc8, 0x18, 0x2f, 0xe9, 0x70,
-	0xea, 0xc6, 0x6c, 0x4e, 0x7c, 0x8a, 0xf3, 0x86, 0x1d, 0xdb, 0xe8, 0x5f, 0x58, 0x90, 0x63, 0xbf,
-	0x2f, 0x3c, 0x5c, 0xd0, 0xd1, 0xc4, 0x43, 0xab, 0x30, 0x17, 0x85, 0x0c, 0x17, 0xf5, 0x12, 0x16,
-	0xa7, 0xe7, 0xd5, 0xdc, 0xae, 0xd3, 0x75, 0xe2, 0x18, 0xba, 0x0d, 0x4b, 0x51, 0xc8, 0x7a, 0x23,
-	0x22, 0x47, 0xb8, 0xa4, 0xf1, 0xf2, 0xf4, 0xbc, 0x5a, 0xdc, 0x75, 0xba, 0x4f, 0x88, 0x1c, 0x39,
-	0xc5, 0x28, 0x64, 0xb1, 0xd1, 0xd9, 0x3a, 0x99, 0x5a, 0xe0, 0x6c, 0x6a, 0x81, 0x1f, 0x53, 0x0b,
-	0xbc, 0xbb, 0xb0, 0x32, 0x67, 0x17, 0x56, 0xe6, 0xdb, 0x85, 0x95, 0x79, 0x99, 0x3c, 0x87, 0xd2,
-	0xdd, 0x6b, 0x31, 0x91, 0x3e, 0x0f, 0xfa, 0xa2, 0xfb, 0x05, 0xfd, 0x92, 0x6d, 0xfd, 0x0c, 0x00,
-	0x00, 0xff, 0xff, 0xd4, 0xee, 0xbd, 0x69, 0x7d, 0x05, 0x00, 0x00,
+func (m *DenomTransferFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomTransferFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomTransferFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomTransferFee.Merge(m, src)
+}
+func (m *DenomTransferFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomTransferFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomTransferFee.DiscardUnknown(m)
 }
 
-func (this *SendEnabled) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+var xxx_messageInfo_DenomTransferFee proto.InternalMessageInfo
 
-	that1, ok := that.(*SendEnabled)
-	if !ok {
-		that2, ok := that.(SendEnabled)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
-	}
-	if this.Enabled != that1.Enabled {
-		return false
+func (m *DenomTransferFee) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return true
+	return ""
 }
-func (this *Supply) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*Supply)
-	if !ok {
-		that2, ok := that.(Supply)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.Total) != len(that1.Total) {
-		return false
-	}
-	for i := range this.Total {
-		if !this.Total[i].Equal(&that1.Total[i]) {
-			return false
-		}
+func (m *DenomTransferFee) GetCollector() string {
+	if m != nil {
+		return m.Collector
 	}
-	return true
+	return ""
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *DenomTransferFee) GetExemptAddresses() []string {
+	if m != nil {
+		return m.ExemptAddresses
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SendRestrictionPolicy defines a named send restriction registered by
+// governance. It is enforced on every send while enabled, on top of the send
+// restrictions wired at app construction.
+type SendRestrictionPolicy struct {
+	// name uniquely identifies the policy.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// restriction_type is the kind of restriction enforced by the policy.
+	RestrictionType SendRestrictionType `protobuf:"varint,2,opt,name=restriction_type,json=restrictionType,proto3,enum=cosmos.bank.v1beta1.SendRestrictionType" json:"restriction_type,omitempty"`
+	// enabled defines whether the policy is enforced.
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// addresses are the addresses the policy applies to. They are required by
+	// address blocklists, optional for denom freezes and not supported by
+	// transfer pauses.
+	Addresses []string `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// denoms are the denoms the policy applies to. They are required by denom
+	// freezes and transfer pauses, and not supported by address blocklists.
+	Denoms []string `protobuf:"bytes,5,rep,name=denoms,proto3" json:"denoms,omitempty"`
+	// description is an optional description of the policy, e.g. the incident it
+	// responds to.
+	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DefaultSendEnabled {
-		i--
-		if m.DefaultSendEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.SendEnabled) > 0 {
-		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SendEnabled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintBank(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *SendRestrictionPolicy) Reset()         { *m = SendRestrictionPolicy{} }
+func (m *SendRestrictionPolicy) String() string { return proto.CompactTextString(m) }
+func (*SendRestrictionPolicy) ProtoMessage()    {}
+func (*SendRestrictionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{8}
+}
+func (m *SendRestrictionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SendRestrictionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SendRestrictionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *SendEnabled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *SendRestrictionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendRestrictionPolicy.Merge(m, src)
 }
-
-func (m *SendEnabled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SendRestrictionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *SendRestrictionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendRestrictionPolicy.DiscardUnknown(m)
 }
 
-func (m *SendEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_SendRestrictionPolicy proto.InternalMessageInfo
+
+func (m *SendRestrictionPolicy) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Input) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SendRestrictionPolicy) GetRestrictionType() SendRestrictionType {
+	if m != nil {
+		return m.RestrictionType
 	}
-	return dAtA[:n], nil
+	return SEND_RESTRICTION_TYPE_UNSPECIFIED
 }
 
-func (m *Input) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SendRestrictionPolicy) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
 }
 
-func (m *Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintBank(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func (m *SendRestrictionPolicy) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintBank(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *SendRestrictionPolicy) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Output) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SendRestrictionPolicy) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Output) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Input models transaction input.
+type Input struct {
+	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Coins   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
 }
 
-func (m *Output) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Coins) > 0 {
-		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintBank(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintBank(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *Input) Reset()         { *m = Input{} }
+func (m *Input) String() string { return proto.CompactTextString(m) }
+func (*Input) ProtoMessage()    {}
+func (*Input) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{9}
 }
-
-func (m *Supply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Input) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Input) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Input.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *Input) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Input.Merge(m, src)
+}
+func (m *Input) XXX_Size() int {
+	return m.Size()
+}
+func (m *Input) XXX_DiscardUnknown() {
+	xxx_messageInfo_Input.DiscardUnknown(m)
 }
 
-func (m *Supply) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_Input proto.InternalMessageInfo
+
+// Output models transaction outputs.
+type Output struct {
+	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Coins   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
 }
 
-func (m *Supply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Total) > 0 {
-		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintBank(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *Output) Reset()         { *m = Output{} }
+func (m *Output) String() string { return proto.CompactTextString(m) }
+func (*Output) ProtoMessage()    {}
+func (*Output) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{10}
+}
+func (m *Output) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Output) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Output.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *Output) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Output.Merge(m, src)
+}
+func (m *Output) XXX_Size() int {
+	return m.Size()
+}
+func (m *Output) XXX_DiscardUnknown() {
+	xxx_messageInfo_Output.DiscardUnknown(m)
 }
 
-func (m *DenomUnit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Output proto.InternalMessageInfo
+
+// Supply represents a struct that passively keeps track of the total supply
+// amounts in the network.
+// This message is deprecated now that supply is indexed by denom.
+//
+// Deprecated: Do not use.
+type Supply struct {
+	// total is the total supply of coins across the network.
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
+}
+
+func (m *Supply) Reset()         { *m = Supply{} }
+func (m *Supply) String() string { return proto.CompactTextString(m) }
+func (*Supply) ProtoMessage()    {}
+func (*Supply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{11}
+}
+func (m *Supply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Supply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Supply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *Supply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Supply.Merge(m, src)
+}
+func (m *Supply) XXX_Size() int {
+	return m.Size()
+}
+func (m *Supply) XXX_DiscardUnknown() {
+	xxx_messageInfo_Supply.DiscardUnknown(m)
 }
 
-func (m *DenomUnit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_Supply proto.InternalMessageInfo
+
+// DenomUnit represents a struct that describes a given
+// denomination unit of the basic token.
+type DenomUnit struct {
+	// denom represents the string name of the given denom unit (e.g uatom).
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// exponent represents power of 10 exponent that one must
+	// raise the base_denom to in order to equal the given DenomUnit's denom
+	// 1 denom = 10^exponent base_denom
+	// (e.g. with a base_denom of uatom, one can create a DenomUnit of 'atom' with
+	// exponent = 6, thus: 1 atom = 10^6 uatom).
+	Exponent uint32 `protobuf:"varint,2,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	// aliases is a list of string aliases for the given denom
+	Aliases []string `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
 }
 
-func (m *DenomUnit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Aliases) > 0 {
-		for iNdEx := len(m.Aliases) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Aliases[iNdEx])
-			copy(dAtA[i:], m.Aliases[iNdEx])
-			i = encodeVarintBank(dAtA, i, uint64(len(m.Aliases[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *DenomUnit) Reset()         { *m = DenomUnit{} }
+func (m *DenomUnit) String() string { return proto.CompactTextString(m) }
+func (*DenomUnit) ProtoMessage()    {}
+func (*DenomUnit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{12}
+}
+func (m *DenomUnit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomUnit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomUnit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Exponent != 0 {
-		i = encodeVarintBank(dAtA, i, uint64(m.Exponent))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *DenomUnit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomUnit.Merge(m, src)
+}
+func (m *DenomUnit) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomUnit) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomUnit.DiscardUnknown(m)
 }
 
-func (m *Metadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DenomUnit proto.InternalMessageInfo
+
+func (m *DenomUnit) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DenomUnit) GetExponent() uint32 {
+	if m != nil {
+		return m.Exponent
+	}
+	return 0
 }
 
-func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.URIHash) > 0 {
-		i -= len(m.URIHash)
-		copy(dAtA[i:], m.URIHash)
-		i = encodeVarintBank(dAtA, i, uint64(len(m.URIHash)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.URI) > 0 {
-		i -= len(m.URI)
-		copy(dAtA[i:], m.URI)
-		i = encodeVarintBank(dAtA, i, uint64(len(m.URI)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintBank(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0x32
+func (m *DenomUnit) GetAliases() []string {
+	if m != nil {
+		return m.Aliases
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintBank(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x2a
+	return nil
+}
+
+// Metadata represents a struct that describes
+// a basic token.
+type Metadata struct {
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	// denom_units represents the list of DenomUnit's for a given coin
+	DenomUnits []*DenomUnit `protobuf:"bytes,2,rep,name=denom_units,json=denomUnits,proto3" json:"denom_units,omitempty"`
+	// base represents the base denom (should be the DenomUnit with exponent = 0).
+	Base string `protobuf:"bytes,3,opt,name=base,proto3" json:"base,omitempty"`
+	// display indicates the suggested denom that should be
+	// displayed in clients.
+	Display string `protobuf:"bytes,4,opt,name=display,proto3" json:"display,omitempty"`
+	// name defines the name of the token (eg: Cosmos Atom)
+	//
+	// Since: cosmos-sdk 0.43
+	Name string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	// symbol is the token symbol usually shown on exchanges (eg: ATOM). This can
+	// be the same as the display.
+	//
+	// Since: cosmos-sdk 0.43
+	Symbol string `protobuf:"bytes,6,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// URI to a document (on or off-chain) that contains additional information. Optional.
+	//
+	// Since: cosmos-sdk 0.46
+	URI string `protobuf:"bytes,7,opt,name=uri,proto3" json:"uri,omitempty"`
+	// URIHash is a sha256 hash of a document pointed by URI. It's used to verify that
+	// the document didn't change. Optional.
+	//
+	// Since: cosmos-sdk 0.46
+	URIHash string `protobuf:"bytes,8,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+}
+
+func (m *Metadata) Reset()         { *m = Metadata{} }
+func (m *Metadata) String() string { return proto.CompactTextString(m) }
+func (*Metadata) ProtoMessage()    {}
+func (*Metadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{13}
+}
+func (m *Metadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Metadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Metadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Display) > 0 {
-		i -= len(m.Display)
-		copy(dAtA[i:], m.Display)
-		i = encodeVarintBank(dAtA, i, uint64(len(m.Display)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *Metadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Metadata.Merge(m, src)
+}
+func (m *Metadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *Metadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_Metadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Metadata proto.InternalMessageInfo
+
+func (m *Metadata) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if len(m.Base) > 0 {
-		i -= len(m.Base)
-		copy(dAtA[i:], m.Base)
-		i = encodeVarintBank(dAtA, i, uint64(len(m.Base)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *Metadata) GetDenomUnits() []*DenomUnit {
+	if m != nil {
+		return m.DenomUnits
 	}
-	if len(m.DenomUnits) > 0 {
-		for iNdEx := len(m.DenomUnits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DenomUnits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintBank(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	return nil
+}
+
+func (m *Metadata) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *Metadata) GetDisplay() string {
+	if m != nil {
+		return m.Display
+	}
+	return ""
+}
+
+func (m *Metadata) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Metadata) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *Metadata) GetURI() string {
+	if m != nil {
+		return m.URI
+	}
+	return ""
+}
+
+func (m *Metadata) GetURIHash() string {
+	if m != nil {
+		return m.URIHash
+	}
+	return ""
+}
+
+// AccountActivity defines the height of the latest balance change of an
+// account, tracked while dust sweeping is enabled.
+type AccountActivity struct {
+	// address is the address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// height is the block height of the latest balance change of the account.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *AccountActivity) Reset()         { *m = AccountActivity{} }
+func (m *AccountActivity) String() string { return proto.CompactTextString(m) }
+func (*AccountActivity) ProtoMessage()    {}
+func (*AccountActivity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{14}
+}
+func (m *AccountActivity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountActivity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountActivity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintBank(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *AccountActivity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountActivity.Merge(m, src)
+}
+func (m *AccountActivity) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountActivity) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountActivity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountActivity proto.InternalMessageInfo
+
+func (m *AccountActivity) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBank(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *AccountActivity) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+// SpendingLimit defines a daily cap, self-imposed by an account, on the amount
+// of a denom it can send to other accounts.
+type SpendingLimit struct {
+	// address is the address of the account whose spending is limited.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// denom is the denom whose spending is limited.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// daily_limit is the maximum amount of denom the account can send in a
+	// window of 24 hours.
+	DailyLimit cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=daily_limit,json=dailyLimit,proto3,customtype=cosmossdk.io/math.Int" json:"daily_limit"`
+	// window_start is the start of the current window of 24 hours.
+	WindowStart time.Time `protobuf:"bytes,4,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start"`
+	// spent is the amount of denom sent since window_start.
+	Spent cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=spent,proto3,customtype=cosmossdk.io/math.Int" json:"spent"`
+	// pending_daily_limit is the daily limit replacing daily_limit at
+	// pending_time, if set. A zero pending daily limit removes the limit.
+	PendingDailyLimit cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=pending_daily_limit,json=pendingDailyLimit,proto3,customtype=cosmossdk.io/math.Int" json:"pending_daily_limit"`
+	// pending_time is the time the pending daily limit takes effect at, if any.
+	PendingTime *time.Time `protobuf:"bytes,7,opt,name=pending_time,json=pendingTime,proto3,stdtime" json:"pending_time,omitempty"`
+}
+
+func (m *SpendingLimit) Reset()         { *m = SpendingLimit{} }
+func (m *SpendingLimit) String() string { return proto.CompactTextString(m) }
+func (*SpendingLimit) ProtoMessage()    {}
+func (*SpendingLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{15}
+}
+func (m *SpendingLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpendingLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpendingLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.SendEnabled) > 0 {
-		for _, e := range m.SendEnabled {
-			l = e.Size()
-			n += 1 + l + sovBank(uint64(l))
+}
+func (m *SpendingLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendingLimit.Merge(m, src)
+}
+func (m *SpendingLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpendingLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendingLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendingLimit proto.InternalMessageInfo
+
+func (m *SpendingLimit) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SpendingLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *SpendingLimit) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
+	}
+	return time.Time{}
+}
+
+func (m *SpendingLimit) GetPendingTime() *time.Time {
+	if m != nil {
+		return m.PendingTime
+	}
+	return nil
+}
+
+// ModuleTransfer defines a transfer between two module accounts, traced within
+// a block.
+type ModuleTransfer struct {
+	// from_module is the name of the sending module account.
+	FromModule string `protobuf:"bytes,1,opt,name=from_module,json=fromModule,proto3" json:"from_module,omitempty"`
+	// to_module is the name of the receiving module account.
+	ToModule string `protobuf:"bytes,2,opt,name=to_module,json=toModule,proto3" json:"to_module,omitempty"`
+	// amount is the amount transferred.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// caller_module is the name of the module which requested the transfer.
+	CallerModule string `protobuf:"bytes,4,opt,name=caller_module,json=callerModule,proto3" json:"caller_module,omitempty"`
+}
+
+func (m *ModuleTransfer) Reset()         { *m = ModuleTransfer{} }
+func (m *ModuleTransfer) String() string { return proto.CompactTextString(m) }
+func (*ModuleTransfer) ProtoMessage()    {}
+func (*ModuleTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{16}
+}
+func (m *ModuleTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleTransfer.Merge(m, src)
+}
+func (m *ModuleTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleTransfer proto.InternalMessageInfo
+
+func (m *ModuleTransfer) GetFromModule() string {
+	if m != nil {
+		return m.FromModule
+	}
+	return ""
+}
+
+func (m *ModuleTransfer) GetToModule() string {
+	if m != nil {
+		return m.ToModule
+	}
+	return ""
+}
+
+func (m *ModuleTransfer) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *ModuleTransfer) GetCallerModule() string {
+	if m != nil {
+		return m.CallerModule
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.bank.v1beta1.SendRestrictionType", SendRestrictionType_name, SendRestrictionType_value)
+	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
+	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
+	proto.RegisterType((*BalanceJournalEntry)(nil), "cosmos.bank.v1beta1.BalanceJournalEntry")
+	proto.RegisterType((*BalanceAlert)(nil), "cosmos.bank.v1beta1.BalanceAlert")
+	proto.RegisterType((*FactoryDenom)(nil), "cosmos.bank.v1beta1.FactoryDenom")
+	proto.RegisterType((*Hold)(nil), "cosmos.bank.v1beta1.Hold")
+	proto.RegisterType((*DenomTransferHook)(nil), "cosmos.bank.v1beta1.DenomTransferHook")
+	proto.RegisterType((*DenomTransferFee)(nil), "cosmos.bank.v1beta1.DenomTransferFee")
+	proto.RegisterType((*SendRestrictionPolicy)(nil), "cosmos.bank.v1beta1.SendRestrictionPolicy")
+	proto.RegisterType((*Input)(nil), "cosmos.bank.v1beta1.Input")
+	proto.RegisterType((*Output)(nil), "cosmos.bank.v1beta1.Output")
+	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
+	proto.RegisterType((*DenomUnit)(nil), "cosmos.bank.v1beta1.DenomUnit")
+	proto.RegisterType((*Metadata)(nil), "cosmos.bank.v1beta1.Metadata")
+	proto.RegisterType((*AccountActivity)(nil), "cosmos.bank.v1beta1.AccountActivity")
+	proto.RegisterType((*SpendingLimit)(nil), "cosmos.bank.v1beta1.SpendingLimit")
+	proto.RegisterType((*ModuleTransfer)(nil), "cosmos.bank.v1beta1.ModuleTransfer")
+}
+
+func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
+
+var fileDescriptor_dd052eee12edf988 = []byte{
+	// 1633 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4d, 0x6c, 0x1b, 0x4b,
+	0x1d, 0xf7, 0xfa, 0xdb, 0xe3, 0x7c, 0x75, 0x93, 0xd7, 0xb7, 0x49, 0xf4, 0x6c, 0x63, 0x78, 0xef,
+	0x99, 0xa0, 0xd8, 0x6d, 0x1e, 0x7a, 0x87, 0x5c, 0x90, 0xbf, 0x42, 0xfd, 0x48, 0x93, 0xb0, 0x76,
+	0x84, 0x28, 0x87, 0x65, 0xbc, 0x3b, 0xb1, 0x97, 0xec, 0xee, 0x58, 0x3b, 0xb3, 0x4d, 0x7c, 0xe5,
+	0x54, 0xf5, 0x54, 0x71, 0x42, 0x9c, 0x2a, 0x71, 0x41, 0x08, 0xa1, 0x1c, 0x7a, 0x45, 0x88, 0x5b,
+	0xd5, 0x03, 0xaa, 0xca, 0x05, 0x21, 0x91, 0xa2, 0xf4, 0x90, 0x72, 0xe1, 0xca, 0x15, 0xcd, 0xc7,
+	0xda, 0x4e, 0xe3, 0x10, 0x14, 0x55, 0x45, 0xef, 0x92, 0xec, 0xcc, 0xff, 0xf3, 0xf7, 0x9b, 0xff,
+	0xfc, 0xe7, 0x6f, 0x90, 0x33, 0x31, 0x71, 0x31, 0xa9, 0x74, 0xa1, 0x77, 0x58, 0x79, 0x78, 0xb7,
+	0x8b, 0x28, 0xbc, 0xcb, 0x17, 0xe5, 0x81, 0x8f, 0x29, 0x56, 0x17, 0x85, 0xbc, 0xcc, 0xb7, 0xa4,
+	0x7c, 0x65, 0xa9, 0x87, 0x7b, 0x98, 0xcb, 0x2b, 0xec, 0x4b, 0xa8, 0xae, 0x2c, 0x0b, 0x55, 0x43,
+	0x08, 0xa4, 0x9d, 0x10, 0x8d, 0xa3, 0x10, 0x34, 0x8a, 0x62, 0x62, 0xdb, 0x93, 0xf2, 0x8f, 0xa5,
+	0xdc, 0x25, 0xbd, 0xca, 0xc3, 0xbb, 0xec, 0x9f, 0x14, 0xdc, 0x82, 0xae, 0xed, 0xe1, 0x0a, 0xff,
+	0x1b, 0xfa, 0xea, 0x61, 0xdc, 0x73, 0x50, 0x85, 0xaf, 0xba, 0xc1, 0x41, 0xc5, 0x0a, 0x7c, 0x48,
+	0x6d, 0x1c, 0xfa, 0xca, 0xbf, 0x2b, 0xa7, 0xb6, 0x8b, 0x08, 0x85, 0xee, 0x40, 0x28, 0x14, 0xff,
+	0x90, 0x00, 0xc9, 0x3d, 0xe8, 0x43, 0x97, 0xa8, 0xdf, 0x07, 0x33, 0x04, 0x79, 0x96, 0x81, 0x3c,
+	0xd8, 0x75, 0x90, 0xa5, 0x29, 0x85, 0x58, 0x29, 0xbb, 0x51, 0x28, 0x4f, 0x01, 0x5d, 0x6e, 0x23,
+	0xcf, 0x6a, 0x0a, 0xbd, 0x5a, 0x54, 0x53, 0xf4, 0x2c, 0x19, 0x6f, 0xa8, 0x77, 0xc0, 0x92, 0x85,
+	0x0e, 0x60, 0xe0, 0x50, 0xe3, 0x82, 0xc3, 0x68, 0x41, 0x29, 0xa5, 0x75, 0x55, 0xca, 0x26, 0x5c,
+	0xa8, 0x1d, 0xb0, 0xdc, 0x85, 0x0e, 0xf4, 0x4c, 0x64, 0xfc, 0x0c, 0x07, 0xbe, 0x07, 0x1d, 0x03,
+	0x5a, 0x96, 0x8f, 0x08, 0x41, 0x44, 0x8b, 0x15, 0x62, 0xa5, 0x4c, 0x4d, 0x7b, 0xf5, 0x6c, 0x7d,
+	0x49, 0xa6, 0x52, 0x15, 0xb2, 0x36, 0xf5, 0x6d, 0xaf, 0xa7, 0x7f, 0x2c, 0x4d, 0xbf, 0x12, 0x96,
+	0xd5, 0xd0, 0x50, 0xdd, 0xbc, 0xec, 0xd5, 0x47, 0x14, 0x79, 0x8c, 0x1f, 0x2d, 0x5e, 0x50, 0x4a,
+	0xf1, 0x77, 0x6d, 0xf5, 0x50, 0xac, 0x3e, 0x56, 0xc0, 0xbc, 0x15, 0x10, 0x6a, 0xd0, 0xbe, 0x8f,
+	0x48, 0x1f, 0x3b, 0x16, 0xd1, 0x12, 0x9c, 0x90, 0xe5, 0x31, 0x21, 0x04, 0x8d, 0x08, 0xa9, 0x63,
+	0xdb, 0xab, 0x6d, 0x3d, 0x3f, 0xcd, 0x47, 0x7e, 0xfb, 0x3a, 0x5f, 0xea, 0xd9, 0xb4, 0x1f, 0x74,
+	0xcb, 0x26, 0x76, 0xe5, 0xd1, 0xcb, 0x7f, 0xeb, 0xc4, 0x3a, 0xac, 0xd0, 0xe1, 0x00, 0x11, 0x6e,
+	0x40, 0x7e, 0x75, 0x7e, 0xb2, 0x36, 0xe3, 0xa0, 0x1e, 0x34, 0x87, 0x06, 0xab, 0x00, 0xf2, 0x9b,
+	0xf3, 0x93, 0x35, 0x45, 0x9f, 0x63, 0x91, 0x3b, 0xa3, 0xc0, 0xea, 0x77, 0xc1, 0x6d, 0x9e, 0x8b,
+	0xed, 0x41, 0x93, 0xda, 0x0f, 0x6d, 0x3a, 0x34, 0xba, 0x0e, 0x36, 0x0f, 0x89, 0x96, 0xe4, 0x28,
+	0x96, 0x98, 0xb4, 0x35, 0x12, 0xd6, 0xb8, 0x4c, 0xfd, 0x09, 0xf8, 0x84, 0x5b, 0x91, 0x23, 0x84,
+	0x06, 0x06, 0x3a, 0x36, 0x9d, 0xc0, 0x42, 0xd6, 0x04, 0xb1, 0xa9, 0x6b, 0x88, 0x5d, 0x61, 0xe6,
+	0x6d, 0x66, 0xdd, 0x94, 0xc6, 0x63, 0x6e, 0x7b, 0x60, 0x95, 0x0c, 0x90, 0x67, 0xd9, 0x5e, 0xcf,
+	0x70, 0x6c, 0xd7, 0xa6, 0x86, 0xd9, 0x87, 0x5e, 0x0f, 0x19, 0x16, 0x72, 0xe0, 0x50, 0x4b, 0x17,
+	0x14, 0x4e, 0x95, 0x28, 0xbf, 0x72, 0x58, 0x7e, 0xe5, 0x86, 0x2c, 0xcf, 0xda, 0x2c, 0xa3, 0xea,
+	0x97, 0xaf, 0xf3, 0x8a, 0x40, 0xac, 0x85, 0xce, 0xb6, 0x99, 0xaf, 0x3a, 0x77, 0xd5, 0x60, 0x9e,
+	0x36, 0x3f, 0x79, 0x7c, 0x7e, 0xb2, 0xa6, 0x4d, 0xd0, 0x77, 0x2c, 0xae, 0xa7, 0x28, 0xda, 0x62,
+	0x1d, 0x64, 0x27, 0x0b, 0x69, 0x09, 0x24, 0x2c, 0xe4, 0x61, 0x57, 0x53, 0x0a, 0x4a, 0x29, 0xa3,
+	0x8b, 0x85, 0xaa, 0x81, 0xd4, 0xc5, 0x1a, 0x0c, 0x97, 0x9b, 0xf1, 0xb7, 0x4f, 0xf3, 0x4a, 0xf1,
+	0xef, 0x0a, 0x58, 0xac, 0x5d, 0x28, 0x84, 0xa6, 0x47, 0xfd, 0xa1, 0xba, 0x01, 0x52, 0x92, 0x2d,
+	0xe1, 0xef, 0xbf, 0x70, 0x15, 0x2a, 0xaa, 0xb7, 0x41, 0xb2, 0x8f, 0xec, 0x5e, 0x9f, 0xf2, 0x50,
+	0x31, 0x5d, 0xae, 0xc6, 0x99, 0xc5, 0x26, 0x33, 0xdb, 0x62, 0xbb, 0x0e, 0x85, 0xbc, 0x1c, 0x33,
+	0xb5, 0x3b, 0x8c, 0x95, 0xbf, 0x9d, 0xe6, 0x3f, 0x12, 0x31, 0x88, 0x75, 0x58, 0xb6, 0x71, 0xc5,
+	0x85, 0xb4, 0x5f, 0x6e, 0x79, 0xf4, 0xd5, 0xb3, 0x75, 0x20, 0x83, 0xb7, 0x3c, 0x2a, 0x88, 0x13,
+	0xe6, 0x2c, 0xaa, 0x8f, 0x20, 0xc1, 0x9e, 0x96, 0xe0, 0xee, 0xe5, 0x4a, 0xe2, 0xfb, 0xbd, 0x02,
+	0x66, 0x24, 0xbe, 0xaa, 0x83, 0x7c, 0x7a, 0x23, 0x60, 0x23, 0x00, 0xd1, 0x49, 0x00, 0x3b, 0x20,
+	0x33, 0xba, 0x21, 0x5a, 0xec, 0x86, 0x20, 0xc6, 0x2e, 0x64, 0xc2, 0x0f, 0xc0, 0xcc, 0x16, 0x34,
+	0x29, 0xf6, 0x87, 0x0d, 0x1e, 0x65, 0xfa, 0xb1, 0x96, 0x41, 0x02, 0x5a, 0xae, 0xed, 0x69, 0xd1,
+	0x6b, 0x30, 0x08, 0x35, 0xe9, 0xfb, 0x2f, 0x0a, 0x88, 0xdf, 0xc3, 0x8e, 0x75, 0xe3, 0xd3, 0xc5,
+	0x8e, 0x85, 0x7c, 0xc9, 0x82, 0x5c, 0xa9, 0x43, 0x90, 0x84, 0x2e, 0x0e, 0x3c, 0xca, 0xbb, 0xd5,
+	0x07, 0x69, 0x12, 0x32, 0xa0, 0x44, 0xf5, 0x67, 0x05, 0xdc, 0xe2, 0x5c, 0x75, 0x7c, 0xe8, 0x91,
+	0x03, 0xe4, 0xdf, 0xc3, 0xf8, 0xf0, 0x0a, 0xde, 0xee, 0x80, 0x24, 0x85, 0x7e, 0x0f, 0xd1, 0x6b,
+	0x89, 0x93, 0x7a, 0xea, 0xe7, 0x60, 0xbe, 0x8b, 0x0e, 0xb0, 0x8f, 0x0c, 0x2a, 0xdd, 0xf3, 0xb3,
+	0x4e, 0xeb, 0x73, 0x62, 0x3b, 0x0c, 0xaa, 0x7e, 0x0a, 0xe6, 0xe0, 0x01, 0x45, 0xfe, 0x58, 0x2f,
+	0xce, 0xf5, 0x66, 0xf9, 0xee, 0x48, 0x6d, 0x15, 0x64, 0x7a, 0x90, 0x88, 0xc6, 0xc1, 0x2b, 0x36,
+	0xae, 0xa7, 0x7b, 0x90, 0xf0, 0xcb, 0x2f, 0x01, 0xfd, 0x5b, 0x01, 0x0b, 0x17, 0x00, 0x6d, 0x21,
+	0x74, 0x05, 0x9e, 0xaf, 0x40, 0xdc, 0x87, 0x14, 0x49, 0x34, 0x5f, 0xca, 0xf2, 0x5b, 0xbd, 0x5c,
+	0x7e, 0xdb, 0x9c, 0xc4, 0x06, 0x32, 0x27, 0x8a, 0xb0, 0x81, 0x4c, 0xc1, 0x27, 0xf7, 0xa1, 0x7e,
+	0x09, 0x32, 0x26, 0x76, 0x1c, 0xc4, 0x8a, 0x4f, 0x8b, 0x5d, 0x43, 0xcf, 0x58, 0x55, 0xad, 0x83,
+	0x05, 0x74, 0x8c, 0xdc, 0x01, 0x9d, 0xe8, 0xaf, 0xf1, 0x6b, 0xfa, 0xeb, 0xbc, 0xb0, 0x18, 0x35,
+	0x55, 0x89, 0xfc, 0x17, 0x51, 0xf0, 0x11, 0xeb, 0x69, 0x3a, 0x22, 0xd4, 0xb7, 0x4d, 0xd6, 0x2e,
+	0xf7, 0xb0, 0x63, 0x9b, 0x43, 0x55, 0x05, 0x71, 0x0f, 0xba, 0x48, 0xa2, 0xe7, 0xdf, 0x6a, 0x1b,
+	0x2c, 0xf8, 0x63, 0x45, 0x83, 0xd5, 0x0d, 0x27, 0x62, 0x6e, 0xa3, 0x74, 0xe5, 0xcb, 0x3d, 0xe1,
+	0xb9, 0x33, 0x1c, 0x20, 0x7d, 0xde, 0xbf, 0xb8, 0x31, 0xd9, 0x30, 0x63, 0x17, 0x1a, 0x26, 0xe3,
+	0xe7, 0x7f, 0x07, 0x38, 0x56, 0x65, 0x17, 0x87, 0x1f, 0x96, 0x78, 0x45, 0x33, 0xba, 0x5c, 0xa9,
+	0x05, 0x90, 0xb5, 0x10, 0x31, 0x7d, 0x7b, 0xc0, 0x5f, 0xe5, 0x24, 0x47, 0x36, 0xb9, 0x25, 0x49,
+	0x79, 0xa1, 0x80, 0x44, 0xcb, 0x1b, 0x04, 0x37, 0xeb, 0x5d, 0x47, 0x20, 0xc1, 0x6f, 0x8e, 0x16,
+	0xfd, 0x50, 0xb7, 0x53, 0xc4, 0xdb, 0x5c, 0x7a, 0xf4, 0x34, 0x1f, 0x79, 0xfb, 0x34, 0x1f, 0xf9,
+	0xf9, 0xf9, 0xc9, 0x5a, 0x98, 0x4e, 0xf1, 0x8f, 0x0a, 0x48, 0xee, 0x06, 0xf4, 0x6b, 0x87, 0x26,
+	0x1d, 0xa2, 0x29, 0xfe, 0x4e, 0x01, 0xc9, 0x76, 0x30, 0x18, 0x38, 0x43, 0x96, 0x0d, 0xc5, 0x14,
+	0x3a, 0x9a, 0xf2, 0xc1, 0xb2, 0xe1, 0xf1, 0x36, 0xbf, 0x2d, 0xb3, 0x51, 0x5e, 0x3c, 0x5b, 0x5f,
+	0x9d, 0x5a, 0xea, 0x3c, 0xc1, 0x96, 0xa6, 0x14, 0x7f, 0x04, 0x32, 0xbc, 0x97, 0xec, 0x7b, 0x36,
+	0xbd, 0xa2, 0x89, 0xac, 0x80, 0x34, 0x3a, 0x1e, 0x60, 0x0f, 0x79, 0xa2, 0x2d, 0xce, 0xea, 0xa3,
+	0x35, 0xbb, 0x0e, 0xd0, 0xb1, 0xe1, 0x68, 0x18, 0xd5, 0xc3, 0x65, 0xf1, 0x71, 0x14, 0xa4, 0xef,
+	0x23, 0x0a, 0x2d, 0x48, 0xe1, 0xbb, 0xb5, 0xac, 0x5c, 0xaa, 0x65, 0xf5, 0x7b, 0x4c, 0xc3, 0xc3,
+	0xae, 0x11, 0x78, 0x36, 0x0d, 0xcf, 0x2f, 0x37, 0xf5, 0x9e, 0x8e, 0xf2, 0xd5, 0x81, 0x15, 0x7e,
+	0x12, 0xd6, 0x01, 0x18, 0xaf, 0x72, 0x88, 0xe0, 0xdf, 0x2c, 0x3b, 0xcb, 0x26, 0x03, 0x36, 0x76,
+	0xf1, 0x29, 0x42, 0x0f, 0x97, 0xa3, 0x7e, 0x91, 0x98, 0xe8, 0x17, 0xb7, 0x41, 0x92, 0x0c, 0xdd,
+	0x2e, 0x76, 0xe4, 0x5d, 0x93, 0x2b, 0x75, 0x19, 0xc4, 0x02, 0xdf, 0xd6, 0x52, 0xbc, 0x08, 0x53,
+	0x67, 0xa7, 0xf9, 0xd8, 0xbe, 0xde, 0xd2, 0xd9, 0x9e, 0xfa, 0x19, 0x48, 0x07, 0xbe, 0x6d, 0xf4,
+	0x21, 0xe9, 0xf3, 0xc1, 0x2e, 0x53, 0xcb, 0x9e, 0x9d, 0xe6, 0x53, 0xfb, 0x7a, 0xeb, 0x1e, 0x24,
+	0x7d, 0x3d, 0x15, 0xf8, 0x36, 0xfb, 0x28, 0x9a, 0x60, 0xbe, 0x6a, 0x9a, 0xec, 0x51, 0xaa, 0xca,
+	0x49, 0xf4, 0x7d, 0x4e, 0x50, 0xb2, 0x11, 0xfc, 0x33, 0x06, 0x66, 0xdb, 0x93, 0xc3, 0xe2, 0x7b,
+	0x1c, 0x66, 0x7e, 0x08, 0xb2, 0x16, 0xb4, 0x9d, 0xa1, 0x7c, 0x98, 0x6e, 0x3a, 0xce, 0x00, 0xee,
+	0x44, 0x24, 0xb7, 0x0d, 0x66, 0x8e, 0x6c, 0xcf, 0xc2, 0x47, 0x06, 0xa1, 0xd0, 0xa7, 0xfc, 0x84,
+	0xb2, 0x1b, 0x2b, 0x97, 0x06, 0xe3, 0x4e, 0xf8, 0xbb, 0x4c, 0x4c, 0xc6, 0x4f, 0x46, 0x93, 0x71,
+	0x56, 0x98, 0xb7, 0x99, 0x35, 0x1b, 0x17, 0xd9, 0xa0, 0x2c, 0xde, 0xcc, 0x1b, 0x8d, 0x8b, 0xdc,
+	0x5c, 0xfd, 0x29, 0x58, 0x0c, 0x87, 0xf7, 0x49, 0xc0, 0xc9, 0x1b, 0x7a, 0xbd, 0x25, 0x9d, 0x35,
+	0xc6, 0xb8, 0xeb, 0x60, 0x26, 0x8c, 0xc0, 0x7e, 0x72, 0x6a, 0xa9, 0x6b, 0x71, 0xc7, 0x19, 0x66,
+	0x3d, 0x2b, 0xad, 0xd8, 0x7e, 0xf1, 0x5f, 0x0a, 0x98, 0xbb, 0x8f, 0xad, 0xc0, 0x19, 0x0f, 0x18,
+	0x79, 0x90, 0x3d, 0xf0, 0xb1, 0x6b, 0xb8, 0x7c, 0x5b, 0xde, 0x31, 0xc0, 0xb6, 0x84, 0x22, 0x1b,
+	0x2d, 0x28, 0x0e, 0xc5, 0xe2, 0x74, 0xd3, 0x14, 0x4b, 0xe1, 0xff, 0x6f, 0x4c, 0x53, 0xbf, 0x09,
+	0x66, 0x4d, 0xe8, 0x38, 0xc8, 0x0f, 0x73, 0x13, 0x77, 0x75, 0x46, 0x6c, 0x8a, 0xfc, 0xd6, 0xfe,
+	0xa4, 0x80, 0xc5, 0x29, 0x0f, 0xb4, 0xfa, 0x29, 0xf8, 0x46, 0xbb, 0xb9, 0xd3, 0x30, 0xf4, 0x66,
+	0xbb, 0xa3, 0xb7, 0xea, 0x9d, 0xd6, 0xee, 0x8e, 0xd1, 0xf9, 0xf1, 0x5e, 0xd3, 0xd8, 0xdf, 0x69,
+	0xef, 0x35, 0xeb, 0xad, 0xad, 0x56, 0xb3, 0xb1, 0x10, 0x51, 0xbf, 0x03, 0x3e, 0x9f, 0xae, 0x56,
+	0x6d, 0x34, 0xf4, 0x66, 0xbb, 0x6d, 0xd4, 0xb6, 0x77, 0xeb, 0x3f, 0xd8, 0x6e, 0xb5, 0x3b, 0x0b,
+	0x8a, 0xfa, 0x19, 0x28, 0x4e, 0x57, 0x6e, 0x34, 0x77, 0x76, 0xef, 0x1b, 0x5b, 0x7a, 0xb3, 0xf9,
+	0xa0, 0xb9, 0x10, 0x55, 0x4b, 0xe0, 0x5b, 0xd3, 0xf5, 0x3a, 0x7a, 0x75, 0xa7, 0xbd, 0xd5, 0xd4,
+	0x8d, 0xbd, 0xea, 0x7e, 0xbb, 0xb9, 0x10, 0x5b, 0x89, 0x3f, 0xfa, 0x75, 0x2e, 0x52, 0xfb, 0xe2,
+	0xf9, 0x59, 0x4e, 0x79, 0x79, 0x96, 0x53, 0xfe, 0x71, 0x96, 0x53, 0x9e, 0xbc, 0xc9, 0x45, 0x5e,
+	0xbe, 0xc9, 0x45, 0xfe, 0xfa, 0x26, 0x17, 0x79, 0xb0, 0x7c, 0xa1, 0xa0, 0xe4, 0xef, 0x38, 0xce,
+	0x60, 0x37, 0xc9, 0x0b, 0xe2, 0x8b, 0xff, 0x0c, 0x00, 0x9d, 0x59, 0xfe, 0xe1, 0x82, 0x11, 0x00,
+	0x00,
+}
+
+func (this *SendEnabled) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SendEnabled)
+	if !ok {
+		that2, ok := that.(SendEnabled)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	return true
+}
+func (this *BalanceJournalEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BalanceJournalEntry)
+	if !ok {
+		that2, ok := that.(BalanceJournalEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Delta.Equal(that1.Delta) {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	return true
+}
+func (this *BalanceAlert) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BalanceAlert)
+	if !ok {
+		that2, ok := that.(BalanceAlert)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Threshold.Equal(that1.Threshold) {
+		return false
+	}
+	return true
+}
+func (this *FactoryDenom) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FactoryDenom)
+	if !ok {
+		that2, ok := that.(FactoryDenom)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Admin != that1.Admin {
+		return false
+	}
+	return true
+}
+func (this *Hold) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Hold)
+	if !ok {
+		that2, ok := that.(Hold)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Holder != that1.Holder {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DenomTransferHook) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomTransferHook)
+	if !ok {
+		that2, ok := that.(DenomTransferHook)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Target != that1.Target {
+		return false
+	}
+	if this.BeforeTransfer != that1.BeforeTransfer {
+		return false
+	}
+	if this.AfterTransfer != that1.AfterTransfer {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	return true
+}
+func (this *DenomTransferFee) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomTransferFee)
+	if !ok {
+		that2, ok := that.(DenomTransferFee)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Rate.Equal(that1.Rate) {
+		return false
+	}
+	if this.Collector != that1.Collector {
+		return false
+	}
+	if len(this.ExemptAddresses) != len(that1.ExemptAddresses) {
+		return false
+	}
+	for i := range this.ExemptAddresses {
+		if this.ExemptAddresses[i] != that1.ExemptAddresses[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *SendRestrictionPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SendRestrictionPolicy)
+	if !ok {
+		that2, ok := that.(SendRestrictionPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.RestrictionType != that1.RestrictionType {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if this.Addresses[i] != that1.Addresses[i] {
+			return false
+		}
+	}
+	if len(this.Denoms) != len(that1.Denoms) {
+		return false
+	}
+	for i := range this.Denoms {
+		if this.Denoms[i] != that1.Denoms[i] {
+			return false
+		}
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	return true
+}
+func (this *Supply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Supply)
+	if !ok {
+		that2, ok := that.(Supply)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Total) != len(that1.Total) {
+		return false
+	}
+	for i := range this.Total {
+		if !this.Total[i].Equal(&that1.Total[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AccountActivity) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountActivity)
+	if !ok {
+		that2, ok := that.(AccountActivity)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SpendingLimitChangeDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SpendingLimitChangeDelay):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintBank(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	if len(m.DustSweepExcludedAddresses) > 0 {
+		for iNdEx := len(m.DustSweepExcludedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DustSweepExcludedAddresses[iNdEx])
+			copy(dAtA[i:], m.DustSweepExcludedAddresses[iNdEx])
+			i = encodeVarintBank(dAtA, i, uint64(len(m.DustSweepExcludedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.DustInactivityBlocks != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.DustInactivityBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.DustThresholds) > 0 {
+		for iNdEx := len(m.DustThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DustThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.BalanceJournalRetention != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.BalanceJournalRetention))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BalanceJournalAddresses) > 0 {
+		for iNdEx := len(m.BalanceJournalAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BalanceJournalAddresses[iNdEx])
+			copy(dAtA[i:], m.BalanceJournalAddresses[iNdEx])
+			i = encodeVarintBank(dAtA, i, uint64(len(m.BalanceJournalAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.DefaultSendEnabled {
+		i--
+		if m.DefaultSendEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SendEnabled) > 0 {
+		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendEnabled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SendEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BalanceJournalEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BalanceJournalEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BalanceJournalEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Delta.Size()
+		i -= size
+		if _, err := m.Delta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BalanceAlert) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BalanceAlert) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BalanceAlert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FactoryDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FactoryDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FactoryDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Hold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Hold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Hold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomTransferHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomTransferHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomTransferHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AfterTransfer {
+		i--
+		if m.AfterTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BeforeTransfer {
+		i--
+		if m.BeforeTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomTransferFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomTransferFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomTransferFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExemptAddresses) > 0 {
+		for iNdEx := len(m.ExemptAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExemptAddresses[iNdEx])
+			copy(dAtA[i:], m.ExemptAddresses[iNdEx])
+			i = encodeVarintBank(dAtA, i, uint64(len(m.ExemptAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Collector) > 0 {
+		i -= len(m.Collector)
+		copy(dAtA[i:], m.Collector)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Collector)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SendRestrictionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendRestrictionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendRestrictionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintBank(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintBank(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RestrictionType != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.RestrictionType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Input) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Input) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Input) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Output) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Output) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Output) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Supply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Supply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Supply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomUnit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomUnit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomUnit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Aliases) > 0 {
+		for iNdEx := len(m.Aliases) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Aliases[iNdEx])
+			copy(dAtA[i:], m.Aliases[iNdEx])
+			i = encodeVarintBank(dAtA, i, uint64(len(m.Aliases[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Exponent != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Exponent))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Metadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.URIHash) > 0 {
+		i -= len(m.URIHash)
+		copy(dAtA[i:], m.URIHash)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.URIHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.URI) > 0 {
+		i -= len(m.URI)
+		copy(dAtA[i:], m.URI)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.URI)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Display) > 0 {
+		i -= len(m.Display)
+		copy(dAtA[i:], m.Display)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Display)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Base)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DenomUnits) > 0 {
+		for iNdEx := len(m.DenomUnits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomUnits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountActivity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountActivity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountActivity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpendingLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpendingLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpendingLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.PendingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PendingTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintBank(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.PendingDailyLimit.Size()
+		i -= size
+		if _, err := m.PendingDailyLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Spent.Size()
+		i -= size
+		if _, err := m.Spent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintBank(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.DailyLimit.Size()
+		i -= size
+		if _, err := m.DailyLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBank(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CallerModule) > 0 {
+		i -= len(m.CallerModule)
+		copy(dAtA[i:], m.CallerModule)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.CallerModule)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ToModule) > 0 {
+		i -= len(m.ToModule)
+		copy(dAtA[i:], m.ToModule)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.ToModule)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromModule) > 0 {
+		i -= len(m.FromModule)
+		copy(dAtA[i:], m.FromModule)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.FromModule)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBank(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SendEnabled) > 0 {
+		for _, e := range m.SendEnabled {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	if m.DefaultSendEnabled {
+		n += 2
+	}
+	if len(m.BalanceJournalAddresses) > 0 {
+		for _, s := range m.BalanceJournalAddresses {
+			l = len(s)
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	if m.BalanceJournalRetention != 0 {
+		n += 1 + sovBank(uint64(m.BalanceJournalRetention))
+	}
+	if len(m.DustThresholds) > 0 {
+		for _, e := range m.DustThresholds {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	if m.DustInactivityBlocks != 0 {
+		n += 1 + sovBank(uint64(m.DustInactivityBlocks))
+	}
+	if len(m.DustSweepExcludedAddresses) > 0 {
+		for _, s := range m.DustSweepExcludedAddresses {
+			l = len(s)
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SpendingLimitChangeDelay)
+	n += 1 + l + sovBank(uint64(l))
+	return n
+}
+
+func (m *SendEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *BalanceJournalEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBank(uint64(m.Height))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = m.Delta.Size()
+	n += 1 + l + sovBank(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	return n
+}
+
+func (m *BalanceAlert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = m.Threshold.Size()
+	n += 1 + l + sovBank(uint64(l))
+	return n
+}
+
+func (m *FactoryDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	return n
+}
+
+func (m *Hold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomTransferHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if m.BeforeTransfer {
+		n += 2
+	}
+	if m.AfterTransfer {
+		n += 2
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovBank(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *DenomTransferFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovBank(uint64(l))
+	l = len(m.Collector)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.ExemptAddresses) > 0 {
+		for _, s := range m.ExemptAddresses {
+			l = len(s)
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SendRestrictionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if m.RestrictionType != 0 {
+		n += 1 + sovBank(uint64(m.RestrictionType))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	return n
+}
+
+func (m *Input) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Output) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Supply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomUnit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if m.Exponent != 0 {
+		n += 1 + sovBank(uint64(m.Exponent))
+	}
+	if len(m.Aliases) > 0 {
+		for _, s := range m.Aliases {
+			l = len(s)
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Metadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.DenomUnits) > 0 {
+		for _, e := range m.DenomUnits {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Display)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.URIHash)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountActivity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBank(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *SpendingLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = m.DailyLimit.Size()
+	n += 1 + l + sovBank(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovBank(uint64(l))
+	l = m.Spent.Size()
+	n += 1 + l + sovBank(uint64(l))
+	l = m.PendingDailyLimit.Size()
+	n += 1 + l + sovBank(uint64(l))
+	if m.PendingTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PendingTime)
+		n += 1 + l + sovBank(uint64(l))
+	}
+	return n
+}
+
+func (m *ModuleTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromModule)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.ToModule)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	l = len(m.CallerModule)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	return n
+}
+
+func sovBank(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBank(x uint64) (n int) {
+	return sovBank(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendEnabled = append(m.SendEnabled, &SendEnabled{})
+			if err := m.SendEnabled[len(m.SendEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultSendEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DefaultSendEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceJournalAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BalanceJournalAddresses = append(m.BalanceJournalAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceJournalRetention", wireType)
+			}
+			m.BalanceJournalRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BalanceJournalRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DustThresholds = append(m.DustThresholds, types.Coin{})
+			if err := m.DustThresholds[len(m.DustThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustInactivityBlocks", wireType)
+			}
+			m.DustInactivityBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DustInactivityBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DustSweepExcludedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DustSweepExcludedAddresses = append(m.DustSweepExcludedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendingLimitChangeDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SpendingLimitChangeDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BalanceJournalEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BalanceJournalEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BalanceJournalEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BalanceAlert) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BalanceAlert: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BalanceAlert: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FactoryDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FactoryDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FactoryDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Hold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Hold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Hold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomTransferHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomTransferHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomTransferHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeTransfer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BeforeTransfer = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterTransfer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AfterTransfer = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomTransferFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomTransferFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomTransferFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExemptAddresses = append(m.ExemptAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendRestrictionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendRestrictionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendRestrictionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestrictionType", wireType)
+			}
+			m.RestrictionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RestrictionType |= SendRestrictionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Input) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.DefaultSendEnabled {
-		n += 2
-	}
-	return n
-}
 
-func (m *SendEnabled) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovBank(uint64(l))
-	}
-	if m.Enabled {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *Input) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovBank(uint64(l))
-	}
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovBank(uint64(l))
+func (m *Output) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	return n
-}
-
-func (m *Output) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovBank(uint64(l))
-	}
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovBank(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Output: wiretype end group for non-group")
 		}
-	}
-	return n
-}
-
-func (m *Supply) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Total) > 0 {
-		for _, e := range m.Total {
-			l = e.Size()
-			n += 1 + l + sovBank(uint64(l))
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
-	}
-	return n
-}
-
-func (m *DenomUnit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovBank(uint64(l))
-	}
-	if m.Exponent != 0 {
-		n += 1 + sovBank(uint64(m.Exponent))
-	}
-	if len(m.Aliases) > 0 {
-		for _, s := range m.Aliases {
-			l = len(s)
-			n += 1 + l + sovBank(uint64(l))
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *Metadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovBank(uint64(l))
-	}
-	if len(m.DenomUnits) > 0 {
-		for _, e := range m.DenomUnits {
-			l = e.Size()
-			n += 1 + l + sovBank(uint64(l))
-		}
-	}
-	l = len(m.Base)
-	if l > 0 {
-		n += 1 + l + sovBank(uint64(l))
-	}
-	l = len(m.Display)
-	if l > 0 {
-		n += 1 + l + sovBank(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovBank(uint64(l))
-	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovBank(uint64(l))
-	}
-	l = len(m.URI)
-	if l > 0 {
-		n += 1 + l + sovBank(uint64(l))
-	}
-	l = len(m.URIHash)
-	if l > 0 {
-		n += 1 + l + sovBank(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovBank(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBank(x uint64) (n int) {
-	return sovBank(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *Supply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1100,15 +4948,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: Supply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Supply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1135,31 +4983,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SendEnabled = append(m.SendEnabled, &SendEnabled{})
-			if err := m.SendEnabled[len(m.SendEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultSendEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBank
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.DefaultSendEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBank(dAtA[iNdEx:])
@@ -1181,7 +5009,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SendEnabled) Unmarshal(dAtA []byte) error {
+func (m *DenomUnit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1204,10 +5032,10 @@ func (m *SendEnabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SendEnabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomUnit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SendEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomUnit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1244,9 +5072,9 @@ func (m *SendEnabled) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
 			}
-			var v int
+			m.Exponent = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1256,12 +5084,43 @@ func (m *SendEnabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Exponent |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aliases = append(m.Aliases, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBank(dAtA[iNdEx:])
@@ -1283,7 +5142,7 @@ func (m *SendEnabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Input) Unmarshal(dAtA []byte) error {
+func (m *Metadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1306,15 +5165,15 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1342,11 +5201,11 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomUnits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1373,64 +5232,110 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DenomUnits = append(m.DenomUnits, &DenomUnit{})
+			if err := m.DenomUnits[len(m.DenomUnits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBank(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBank
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Output) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBank
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Display", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Display = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Output: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Output: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1458,13 +5363,45 @@ func (m *Output) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1474,25 +5411,23 @@ func (m *Output) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBank
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBank
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URIHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1515,7 +5450,7 @@ func (m *Output) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Supply) Unmarshal(dAtA []byte) error {
+func (m *AccountActivity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1538,17 +5473,17 @@ func (m *Supply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Supply: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountActivity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Supply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountActivity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1558,26 +5493,43 @@ func (m *Supply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBank
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBank
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Total = append(m.Total, types.Coin{})
-			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBank(dAtA[iNdEx:])
@@ -1599,7 +5551,7 @@ func (m *Supply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DenomUnit) Unmarshal(dAtA []byte) error {
+func (m *SpendingLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1622,15 +5574,15 @@ func (m *DenomUnit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenomUnit: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpendingLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomUnit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpendingLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1658,13 +5610,13 @@ func (m *DenomUnit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.Exponent = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1674,14 +5626,27 @@ func (m *DenomUnit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Exponent |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Aliases", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyLimit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1709,63 +5674,15 @@ func (m *DenomUnit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Aliases = append(m.Aliases, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBank(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.DailyLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthBank
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Metadata) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBank
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1775,29 +5692,30 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBank
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBank
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomUnits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1807,29 +5725,29 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBank
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBank
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenomUnits = append(m.DenomUnits, &DenomUnit{})
-			if err := m.DenomUnits[len(m.DenomUnits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDailyLimit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1857,13 +5775,15 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Base = string(dAtA[iNdEx:postIndex])
+			if err := m.PendingDailyLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Display", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1873,27 +5793,81 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBank
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBank
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Display = string(dAtA[iNdEx:postIndex])
+			if m.PendingTime == nil {
+				m.PendingTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.PendingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromModule", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1921,11 +5895,11 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.FromModule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToModule", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1953,13 +5927,13 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.ToModule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBank
@@ -1969,27 +5943,29 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBank
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBank
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URI = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CallerModule", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2017,7 +5993,7 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URIHash = string(dAtA[iNdEx:postIndex])
+			m.CallerModule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15,6 +15,23 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgMultiSend{}, "cosmos-sdk/MsgMultiSend")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/bank/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgSetSendEnabled{}, "cosmos-sdk/MsgSetSendEnabled")
+	legacy.RegisterAminoMsg(cdc, &MsgSetBalanceAlerts{}, "cosmos-sdk/MsgSetBalanceAlerts")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateDenom{}, "cosmos-sdk/MsgCreateDenom")
+	legacy.RegisterAminoMsg(cdc, &MsgFactoryMint{}, "cosmos-sdk/MsgFactoryMint")
+	legacy.RegisterAminoMsg(cdc, &MsgFactoryBurn{}, "cosmos-sdk/MsgFactoryBurn")
+	legacy.RegisterAminoMsg(cdc, &MsgForceTransfer{}, "cosmos-sdk/MsgForceTransfer")
+	legacy.RegisterAminoMsg(cdc, &MsgChangeDenomAdmin{}, "cosmos-sdk/MsgChangeDenomAdmin")
+	legacy.RegisterAminoMsg(cdc, &MsgSetFactoryDenomMetadata{}, "cosmos-sdk/MsgSetFactoryDenomMetadata")
+	legacy.RegisterAminoMsg(cdc, &MsgSetSendRestrictionPolicies{}, "cosmos-sdk/MsgSetSendRestrictions")
+	legacy.RegisterAminoMsg(cdc, &MsgSetNonCirculatingAddresses{}, "cosmos-sdk/MsgSetNonCirculatingAddrs")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDenomTransferHook{}, "cosmos-sdk/MsgSetDenomTransferHook")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveDenomTransferHook{}, "cosmos-sdk/MsgRemoveDenomTransferHook")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDenomMetadata{}, "cosmos-sdk/MsgSetDenomMetadata")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateDenomMetadata{}, "cosmos-sdk/MsgUpdateDenomMetadata")
+	legacy.RegisterAminoMsg(cdc, &MsgSweepDust{}, "cosmos-sdk/MsgSweepDust")
+	legacy.RegisterAminoMsg(cdc, &MsgSetSpendingLimit{}, "cosmos-sdk/MsgSetSpendingLimit")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDenomTransferFee{}, "cosmos-sdk/MsgSetDenomTransferFee")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveDenomTransferFee{}, "cosmos-sdk/MsgRemoveDenomTransferFee")
 
 	cdc.RegisterConcrete(&SendAuthorization{}, "cosmos-sdk/SendAuthorization", nil)
 	cdc.RegisterConcrete(&Params{}, "cosmos-sdk/x/bank/Params", nil)
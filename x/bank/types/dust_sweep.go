@@ -0,0 +1,28 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MaxDustSweepAddresses is the maximum number of accounts a single MsgSweepDust
+// can sweep the dust of.
+const MaxDustSweepAddresses = 100
+
+// IsDustSweepEnabled returns true if the dust of inactive accounts can be swept.
+func (p Params) IsDustSweepEnabled() bool {
+	return p.DustInactivityBlocks > 0 && !p.DustThresholds.Empty()
+}
+
+// Validate performs a basic validation of the account activity.
+func (a AccountActivity) Validate() error {
+	if a.Address == "" {
+		return errors.New("account activity address cannot be empty")
+	}
+
+	if a.Height < 0 {
+		return fmt.Errorf("account activity height cannot be negative: %d", a.Height)
+	}
+
+	return nil
+}
@@ -4,13 +4,28 @@ import "cosmossdk.io/errors"
 
 // x/bank module sentinel errors
 var (
-	ErrNoInputs              = errors.Register(ModuleName, 2, "no inputs to send transaction")
-	ErrNoOutputs             = errors.Register(ModuleName, 3, "no outputs to send transaction")
-	ErrInputOutputMismatch   = errors.Register(ModuleName, 4, "sum inputs != sum outputs")
-	ErrSendDisabled          = errors.Register(ModuleName, 5, "send transactions are disabled")
-	ErrDenomMetadataNotFound = errors.Register(ModuleName, 6, "client denom metadata not found")
-	ErrInvalidKey            = errors.Register(ModuleName, 7, "invalid key")
-	ErrDuplicateEntry        = errors.Register(ModuleName, 8, "duplicate entry")
-	ErrMultipleSenders       = errors.Register(ModuleName, 9, "multiple senders not allowed")
-	ErrInvalidSigner         = errors.Register(ModuleName, 10, "expected authority account as only signer for proposal message")
+	ErrNoInputs                      = errors.Register(ModuleName, 2, "no inputs to send transaction")
+	ErrNoOutputs                     = errors.Register(ModuleName, 3, "no outputs to send transaction")
+	ErrInputOutputMismatch           = errors.Register(ModuleName, 4, "sum inputs != sum outputs")
+	ErrSendDisabled                  = errors.Register(ModuleName, 5, "send transactions are disabled")
+	ErrDenomMetadataNotFound         = errors.Register(ModuleName, 6, "client denom metadata not found")
+	ErrInvalidKey                    = errors.Register(ModuleName, 7, "invalid key")
+	ErrDuplicateEntry                = errors.Register(ModuleName, 8, "duplicate entry")
+	ErrMultipleSenders               = errors.Register(ModuleName, 9, "multiple senders not allowed")
+	ErrInvalidSigner                 = errors.Register(ModuleName, 10, "expected authority account as only signer for proposal message")
+	ErrInvalidFactoryDenom           = errors.Register(ModuleName, 11, "invalid factory denom")
+	ErrFactoryDenomExists            = errors.Register(ModuleName, 12, "factory denom already exists")
+	ErrFactoryDenomNotFound          = errors.Register(ModuleName, 13, "factory denom not found")
+	ErrInvalidSendRestrictionPolicy  = errors.Register(ModuleName, 14, "invalid send restriction policy")
+	ErrSendRestrictionPolicyNotFound = errors.Register(ModuleName, 15, "send restriction policy not found")
+	ErrSendRestricted                = errors.Register(ModuleName, 16, "send restricted by policy")
+	ErrHoldNotFound                  = errors.Register(ModuleName, 17, "hold not found")
+	ErrInvalidDenomTransferHook      = errors.Register(ModuleName, 18, "invalid denom transfer hook")
+	ErrDenomTransferHookNotFound     = errors.Register(ModuleName, 19, "denom transfer hook not found")
+	ErrDenomMetadataExists           = errors.Register(ModuleName, 20, "client denom metadata already exists")
+	ErrDustSweepDisabled             = errors.Register(ModuleName, 21, "dust sweeping is disabled")
+	ErrSpendingLimitExceeded         = errors.Register(ModuleName, 22, "spending limit exceeded")
+	ErrSpendingLimitNotFound         = errors.Register(ModuleName, 23, "spending limit not found")
+	ErrInvalidDenomTransferFee       = errors.Register(ModuleName, 24, "invalid denom transfer fee")
+	ErrDenomTransferFeeNotFound      = errors.Register(ModuleName, 25, "denom transfer fee not found")
 )
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// FactoryDenomPrefix is the first part of the denoms created through the token factory.
+	FactoryDenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of the subdenom of a factory denom.
+	MaxSubdenomLength = 44
+)
+
+// GetFactoryDenom returns the factory/{creator}/{subdenom} denom created by
+// the given creator, checking that it is a valid denom.
+func GetFactoryDenom(creator, subdenom string) (string, error) {
+	if subdenom == "" {
+		return "", errorsmod.Wrap(ErrInvalidFactoryDenom, "subdenom cannot be empty")
+	}
+	if len(subdenom) > MaxSubdenomLength {
+		return "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "subdenom cannot be longer than %d characters", MaxSubdenomLength)
+	}
+	if strings.Contains(creator, "/") {
+		return "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "invalid creator %s", creator)
+	}
+
+	denom := strings.Join([]string{FactoryDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", errorsmod.Wrap(ErrInvalidFactoryDenom, err.Error())
+	}
+
+	return denom, nil
+}
+
+// ParseFactoryDenom returns the creator and subdenom of a factory denom.
+func ParseFactoryDenom(denom string) (creator, subdenom string, err error) {
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) != 3 || parts[0] != FactoryDenomPrefix || parts[1] == "" {
+		return "", "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "%s is not in the %s/{creator}/{subdenom} format", denom, FactoryDenomPrefix)
+	}
+
+	if _, err := GetFactoryDenom(parts[1], parts[2]); err != nil {
+		return "", "", err
+	}
+
+	return parts[1], parts[2], nil
+}
+
+// IsFactoryDenom returns true if the denom is in the factory denom format.
+func IsFactoryDenom(denom string) bool {
+	_, _, err := ParseFactoryDenom(denom)
+	return err == nil
+}
+
+// Validate performs a stateless validation of the factory denom.
+func (fd FactoryDenom) Validate() error {
+	if _, _, err := ParseFactoryDenom(fd.Denom); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(fd.Admin) != fd.Admin {
+		return errors.New("factory denom admin cannot contain spaces")
+	}
+
+	return nil
+}
+
+// NewFactoryDenomMetadata returns the default metadata of a factory denom,
+// with the factory denom as single denom unit.
+func NewFactoryDenomMetadata(denom, subdenom string) Metadata {
+	return Metadata{
+		Description: fmt.Sprintf("token factory denom %s", denom),
+		DenomUnits:  []*DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:        denom,
+		Display:     denom,
+		Name:        denom,
+		Symbol:      subdenom,
+	}
+}
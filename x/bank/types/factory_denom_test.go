@@ -0,0 +1,62 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFactoryDenom(t *testing.T) {
+	const creator = "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t"
+
+	testCases := []struct {
+		name     string
+		creator  string
+		subdenom string
+		expDenom string
+		expErr   bool
+	}{
+		{"valid", creator, "bitcoin", "factory/" + creator + "/bitcoin", false},
+		{"subdenom with slashes", creator, "a/b", "factory/" + creator + "/a/b", false},
+		{"empty subdenom", creator, "", "", true},
+		{"subdenom too long", creator, strings.Repeat("a", MaxSubdenomLength+1), "", true},
+		{"creator with slash", "cosmos/1", "bitcoin", "", true},
+		{"invalid characters", creator, "bit coin", "", true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			denom, err := GetFactoryDenom(tc.creator, tc.subdenom)
+			if tc.expErr {
+				require.ErrorIs(t, err, ErrInvalidFactoryDenom)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expDenom, denom)
+
+			parsedCreator, parsedSubdenom, err := ParseFactoryDenom(denom)
+			require.NoError(t, err)
+			require.Equal(t, tc.creator, parsedCreator)
+			require.Equal(t, tc.subdenom, parsedSubdenom)
+		})
+	}
+}
+
+func TestParseFactoryDenom(t *testing.T) {
+	for _, denom := range []string{
+		"uatom",
+		"factory",
+		"factory/cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+		"factory//bitcoin",
+		"other/cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t/bitcoin",
+		"factory/cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t/",
+	} {
+		_, _, err := ParseFactoryDenom(denom)
+		require.ErrorIs(t, err, ErrInvalidFactoryDenom, denom)
+		require.False(t, IsFactoryDenom(denom))
+	}
+
+	require.True(t, IsFactoryDenom("factory/cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t/bitcoin"))
+}
@@ -62,6 +62,136 @@ func (gs GenesisState) Validate() error {
 		seenMetadatas[metadata.Base] = true
 	}
 
+	seenBalanceAlerts := make(map[string]bool)
+	for _, alert := range gs.BalanceAlerts {
+		key := alert.Address + "/" + alert.Denom
+		if seenBalanceAlerts[key] {
+			return fmt.Errorf("duplicate balance alert for address %s and denom %s", alert.Address, alert.Denom)
+		}
+
+		if err := alert.Validate(); err != nil {
+			return err
+		}
+
+		if !alert.Threshold.IsPositive() {
+			return fmt.Errorf("balance alert threshold must be positive for address %s and denom %s", alert.Address, alert.Denom)
+		}
+
+		seenBalanceAlerts[key] = true
+	}
+
+	seenFactoryDenoms := make(map[string]bool)
+	for _, factoryDenom := range gs.FactoryDenoms {
+		if seenFactoryDenoms[factoryDenom.Denom] {
+			return fmt.Errorf("duplicate factory denom %s", factoryDenom.Denom)
+		}
+
+		if err := factoryDenom.Validate(); err != nil {
+			return err
+		}
+
+		seenFactoryDenoms[factoryDenom.Denom] = true
+	}
+
+	seenPolicies := make(map[string]bool)
+	for _, policy := range gs.SendRestrictionPolicies {
+		if seenPolicies[policy.Name] {
+			return fmt.Errorf("duplicate send restriction policy %s", policy.Name)
+		}
+
+		if err := policy.Validate(); err != nil {
+			return err
+		}
+
+		seenPolicies[policy.Name] = true
+	}
+
+	for _, entry := range gs.BalanceJournal {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+	}
+
+	seenNonCirculating := make(map[string]bool)
+	for _, addr := range gs.NonCirculatingAddresses {
+		if addr == "" {
+			return errors.New("non-circulating address cannot be empty")
+		}
+
+		if seenNonCirculating[addr] {
+			return fmt.Errorf("duplicate non-circulating address %s", addr)
+		}
+
+		seenNonCirculating[addr] = true
+	}
+
+	seenHolds := make(map[string]bool)
+	for _, hold := range gs.Holds {
+		if err := hold.Validate(); err != nil {
+			return err
+		}
+
+		key := hold.Address + "/" + hold.Holder
+		if seenHolds[key] {
+			return fmt.Errorf("duplicate hold of %s on %s", hold.Holder, hold.Address)
+		}
+
+		seenHolds[key] = true
+	}
+
+	seenTransferHooks := make(map[string]bool)
+	for _, hook := range gs.DenomTransferHooks {
+		if err := hook.Validate(); err != nil {
+			return err
+		}
+
+		if seenTransferHooks[hook.Denom] {
+			return fmt.Errorf("duplicate transfer hook for %s", hook.Denom)
+		}
+
+		seenTransferHooks[hook.Denom] = true
+	}
+
+	seenActivities := make(map[string]bool)
+	for _, activity := range gs.AccountActivities {
+		if err := activity.Validate(); err != nil {
+			return err
+		}
+
+		if seenActivities[activity.Address] {
+			return fmt.Errorf("duplicate account activity for %s", activity.Address)
+		}
+
+		seenActivities[activity.Address] = true
+	}
+
+	seenSpendingLimits := make(map[string]bool)
+	for _, limit := range gs.SpendingLimits {
+		if err := limit.Validate(); err != nil {
+			return err
+		}
+
+		key := limit.Address + "/" + limit.Denom
+		if seenSpendingLimits[key] {
+			return fmt.Errorf("duplicate spending limit of %s for %s", limit.Address, limit.Denom)
+		}
+
+		seenSpendingLimits[key] = true
+	}
+
+	seenTransferFees := make(map[string]bool)
+	for _, fee := range gs.DenomTransferFees {
+		if err := fee.Validate(); err != nil {
+			return err
+		}
+
+		if seenTransferFees[fee.Denom] {
+			return fmt.Errorf("duplicate transfer fee for %s", fee.Denom)
+		}
+
+		seenTransferFees[fee.Denom] = true
+	}
+
 	if !gs.Supply.Empty() {
 		// NOTE: this errors if supply for any given coin is zero
 		err := gs.Supply.Validate()
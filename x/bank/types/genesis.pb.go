@@ -42,6 +42,27 @@ type GenesisState struct {
 	//
 	// Since: cosmos-sdk 0.47
 	SendEnabled []SendEnabled `protobuf:"bytes,5,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled"`
+	// balance_alerts defines the balance thresholds registered on module accounts.
+	BalanceAlerts []BalanceAlert `protobuf:"bytes,6,rep,name=balance_alerts,json=balanceAlerts,proto3" json:"balance_alerts"`
+	// factory_denoms defines the denoms created through the token factory.
+	FactoryDenoms []FactoryDenom `protobuf:"bytes,7,rep,name=factory_denoms,json=factoryDenoms,proto3" json:"factory_denoms"`
+	// send_restriction_policies defines the send restriction policies registered by governance.
+	SendRestrictionPolicies []SendRestrictionPolicy `protobuf:"bytes,8,rep,name=send_restriction_policies,json=sendRestrictionPolicies,proto3" json:"send_restriction_policies"`
+	// balance_journal defines the recorded balance changes of the tracked accounts.
+	BalanceJournal []BalanceJournalEntry `protobuf:"bytes,9,rep,name=balance_journal,json=balanceJournal,proto3" json:"balance_journal"`
+	// non_circulating_addresses defines the addresses excluded from the circulating supply by governance.
+	NonCirculatingAddresses []string `protobuf:"bytes,10,rep,name=non_circulating_addresses,json=nonCirculatingAddresses,proto3" json:"non_circulating_addresses,omitempty"`
+	// holds defines the coins placed on hold by modules.
+	Holds []Hold `protobuf:"bytes,11,rep,name=holds,proto3" json:"holds"`
+	// denom_transfer_hooks defines the transfer hooks registered for denoms.
+	DenomTransferHooks []DenomTransferHook `protobuf:"bytes,12,rep,name=denom_transfer_hooks,json=denomTransferHooks,proto3" json:"denom_transfer_hooks"`
+	// account_activities defines the latest balance change of the accounts
+	// tracked for dust sweeping.
+	AccountActivities []AccountActivity `protobuf:"bytes,13,rep,name=account_activities,json=accountActivities,proto3" json:"account_activities"`
+	// spending_limits defines the spending limits set by the accounts.
+	SpendingLimits []SpendingLimit `protobuf:"bytes,14,rep,name=spending_limits,json=spendingLimits,proto3" json:"spending_limits"`
+	// denom_transfer_fees defines the transfer fees charged on denoms.
+	DenomTransferFees []DenomTransferFee `protobuf:"bytes,15,rep,name=denom_transfer_fees,json=denomTransferFees,proto3" json:"denom_transfer_fees"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -112,6 +133,76 @@ func (m *GenesisState) GetSendEnabled() []SendEnabled {
 	return nil
 }
 
+func (m *GenesisState) GetBalanceAlerts() []BalanceAlert {
+	if m != nil {
+		return m.BalanceAlerts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFactoryDenoms() []FactoryDenom {
+	if m != nil {
+		return m.FactoryDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSendRestrictionPolicies() []SendRestrictionPolicy {
+	if m != nil {
+		return m.SendRestrictionPolicies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBalanceJournal() []BalanceJournalEntry {
+	if m != nil {
+		return m.BalanceJournal
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNonCirculatingAddresses() []string {
+	if m != nil {
+		return m.NonCirculatingAddresses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHolds() []Hold {
+	if m != nil {
+		return m.Holds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomTransferHooks() []DenomTransferHook {
+	if m != nil {
+		return m.DenomTransferHooks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccountActivities() []AccountActivity {
+	if m != nil {
+		return m.AccountActivities
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSpendingLimits() []SpendingLimit {
+	if m != nil {
+		return m.SpendingLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomTransferFees() []DenomTransferFee {
+	if m != nil {
+		return m.DenomTransferFees
+	}
+	return nil
+}
+
 // Balance defines an account address and balance pair used in the bank module's
 // genesis state.
 type Balance struct {
@@ -162,36 +253,56 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/genesis.proto", fileDescriptor_8f007de11b420c6e) }
 
 var fileDescriptor_8f007de11b420c6e = []byte{
-	// 463 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x6d, 0x42, 0xd3, 0xf6, 0x12, 0x90, 0x38, 0x3a, 0xb8, 0x05, 0x9c, 0xd0, 0x29, 0xaa,
-	0x54, 0x5b, 0x4d, 0x37, 0x06, 0x24, 0x5c, 0x01, 0x13, 0x3f, 0xd4, 0x6c, 0x2c, 0xd1, 0xd9, 0xf7,
-	0x64, 0xac, 0xc4, 0x77, 0x56, 0xde, 0x15, 0xc8, 0x7f, 0xc0, 0xc8, 0xcc, 0xd4, 0x11, 0xb1, 0xd0,
-	0x81, 0x3f, 0x80, 0xb1, 0x63, 0xc5, 0xc4, 0x04, 0x28, 0x19, 0xca, 0x9f, 0x81, 0x7c, 0x77, 0x75,
-	0x2c, 0xe1, 0x99, 0xc5, 0x3e, 0xdd, 0xf7, 0xfb, 0x3e, 0xef, 0xc7, 0x3d, 0x72, 0x3f, 0x91, 0x98,
-	0x4b, 0x0c, 0x63, 0x26, 0x26, 0xe1, 0x9b, 0x83, 0x18, 0x14, 0x3b, 0x08, 0x53, 0x10, 0x80, 0x19,
-	0x06, 0xc5, 0x4c, 0x2a, 0x49, 0x6f, 0x1b, 0x4b, 0x50, 0x5a, 0x02, 0x6b, 0xd9, 0xd9, 0x4a, 0x65,
-	0x2a, 0xb5, 0x1e, 0x96, 0x27, 0x63, 0xdd, 0xf1, 0x2b, 0x1a, 0x42, 0x45, 0x4b, 0x64, 0x26, 0xfe,
-	0xd1, 0x6b, 0xd9, 0x34, 0xd7, 0xe8, 0xdb, 0x46, 0x1f, 0x1b, 0xb0, 0xcd, 0x6b, 0xa4, 0x5b, 0x2c,
-	0xcf, 0x84, 0x0c, 0xf5, 0xd7, 0x5c, 0xed, 0x7e, 0x69, 0x91, 0xee, 0x53, 0x53, 0xea, 0x48, 0x31,
-	0x05, 0xf4, 0x21, 0x69, 0x17, 0x6c, 0xc6, 0x72, 0xf4, 0xdc, 0xbe, 0x3b, 0xe8, 0x0c, 0xef, 0x04,
-	0x0d, 0xa5, 0x07, 0x2f, 0xb5, 0x25, 0xda, 0x3c, 0xff, 0xd9, 0x73, 0x3e, 0x5d, 0x9e, 0xed, 0xb9,
-	0xc7, 0x36, 0x8a, 0x1e, 0x91, 0x8d, 0x98, 0x4d, 0x99, 0x48, 0x00, 0xbd, 0x6b, 0xfd, 0xd6, 0xa0,
-	0x33, 0xbc, 0xdb, 0x48, 0x88, 0x8c, 0xa9, 0x8e, 0xa8, 0x02, 0xe9, 0x9c, 0xb4, 0xf1, 0xa4, 0x28,
-	0xa6, 0x73, 0xaf, 0xa5, 0x11, 0xdb, 0x2b, 0x04, 0x42, 0x85, 0x38, 0x92, 0x99, 0x88, 0x9e, 0x94,
-	0xf1, 0x9f, 0x7f, 0xf5, 0x06, 0x69, 0xa6, 0x5e, 0x9f, 0xc4, 0x41, 0x22, 0x73, 0xdb, 0xb4, 0xfd,
-	0xed, 0x23, 0x9f, 0x84, 0x6a, 0x5e, 0x00, 0xea, 0x00, 0xfc, 0x78, 0x79, 0xb6, 0xd7, 0x9d, 0x42,
-	0xca, 0x92, 0xf9, 0xb8, 0x1c, 0x2b, 0xda, 0xfa, 0x4d, 0x42, 0xfa, 0x82, 0xdc, 0xe4, 0x20, 0x64,
-	0x3e, 0xce, 0x41, 0x31, 0xce, 0x14, 0xf3, 0xae, 0xeb, 0x12, 0xee, 0x35, 0x76, 0xf1, 0xcc, 0x9a,
-	0xea, 0x6d, 0xdc, 0xd0, 0xf1, 0x57, 0x0a, 0x7d, 0x4e, 0xba, 0x08, 0x82, 0x8f, 0x41, 0xb0, 0x78,
-	0x0a, 0xdc, 0x5b, 0xd3, 0xb8, 0x7e, 0x23, 0x6e, 0x04, 0x82, 0x3f, 0x36, 0xbe, 0x3a, 0xb1, 0x83,
-	0xab, 0xfb, 0xdd, 0x6f, 0x2e, 0x59, 0xb7, 0xc3, 0xa3, 0x43, 0xb2, 0xce, 0x38, 0x9f, 0x01, 0x9a,
-	0xd7, 0xda, 0x8c, 0xbc, 0xef, 0x5f, 0xf7, 0xb7, 0x2c, 0xf9, 0x91, 0x51, 0x46, 0x6a, 0x96, 0x89,
-	0xf4, 0xf8, 0xca, 0x48, 0xdf, 0x92, 0x35, 0xdd, 0xb6, 0x7d, 0x9d, 0xff, 0x30, 0x5a, 0x93, 0xef,
-	0xc1, 0xc6, 0xfb, 0xd3, 0x9e, 0xf3, 0xe7, 0xb4, 0xe7, 0x44, 0x87, 0xe7, 0x0b, 0xdf, 0xbd, 0x58,
-	0xf8, 0xee, 0xef, 0x85, 0xef, 0x7e, 0x58, 0xfa, 0xce, 0xc5, 0xd2, 0x77, 0x7e, 0x2c, 0x7d, 0xe7,
-	0x95, 0x5d, 0x5e, 0xe4, 0x93, 0x20, 0x93, 0xe1, 0x3b, 0xb3, 0xe4, 0x3a, 0x43, 0xdc, 0xd6, 0x0b,
-	0x7b, 0xf8, 0x37, 0x00, 0x00, 0xff, 0xff, 0x9d, 0xa7, 0xab, 0xb1, 0x6e, 0x03, 0x00, 0x00,
+	// 774 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xbf, 0x4f, 0x23, 0x47,
+	0x14, 0xc7, 0x6d, 0x08, 0x06, 0xc6, 0xc6, 0x88, 0x01, 0x89, 0x31, 0x49, 0x6c, 0x83, 0x92, 0xc8,
+	0x42, 0xc2, 0x16, 0xd0, 0x51, 0x44, 0xb2, 0x09, 0x04, 0x45, 0xf9, 0x81, 0x30, 0x4a, 0x11, 0x45,
+	0xd9, 0xcc, 0xee, 0x8e, 0xcd, 0xc4, 0xeb, 0x19, 0x67, 0xdf, 0x98, 0xc4, 0xff, 0x41, 0xca, 0xd4,
+	0x57, 0x51, 0x9e, 0xae, 0xa2, 0xb8, 0xee, 0x9a, 0x2b, 0x29, 0xd1, 0x55, 0x57, 0xdd, 0x9d, 0xa0,
+	0xe0, 0xfe, 0x8c, 0xd3, 0xce, 0xac, 0xed, 0x5d, 0xdf, 0x9e, 0xaf, 0xba, 0x06, 0xac, 0xfd, 0x7e,
+	0xdf, 0xe7, 0xbd, 0x79, 0xef, 0x69, 0x06, 0x6d, 0x3a, 0x12, 0xba, 0x12, 0x6a, 0x36, 0x15, 0x9d,
+	0xda, 0xe5, 0xae, 0xcd, 0x14, 0xdd, 0xad, 0xb5, 0x99, 0x60, 0xc0, 0xa1, 0xda, 0xf3, 0xa5, 0x92,
+	0x78, 0xd5, 0x58, 0xaa, 0x81, 0xa5, 0x1a, 0x5a, 0x36, 0xd6, 0xda, 0xb2, 0x2d, 0xb5, 0x5e, 0x0b,
+	0x7e, 0x19, 0xeb, 0x46, 0x71, 0x44, 0x03, 0x36, 0xa2, 0x39, 0x92, 0x8b, 0xf7, 0xf4, 0x48, 0x36,
+	0xcd, 0x35, 0x7a, 0xc1, 0xe8, 0x96, 0x01, 0x87, 0x79, 0x8d, 0xb4, 0x42, 0xbb, 0x5c, 0xc8, 0x9a,
+	0xfe, 0x6b, 0x3e, 0x6d, 0x3d, 0x43, 0x28, 0xf7, 0xbd, 0x29, 0xb5, 0xa9, 0xa8, 0x62, 0xf8, 0x5b,
+	0x94, 0xe9, 0x51, 0x9f, 0x76, 0x81, 0xa4, 0xcb, 0xe9, 0x4a, 0x76, 0xef, 0xf3, 0x6a, 0x42, 0xe9,
+	0xd5, 0x53, 0x6d, 0x69, 0x2c, 0xde, 0xbc, 0x2a, 0xa5, 0x1e, 0x3f, 0x5c, 0x6f, 0xa7, 0xcf, 0xc2,
+	0x28, 0x7c, 0x88, 0x16, 0x6c, 0xea, 0x51, 0xe1, 0x30, 0x20, 0x33, 0xe5, 0xd9, 0x4a, 0x76, 0xef,
+	0x8b, 0x44, 0x42, 0xc3, 0x98, 0xa2, 0x88, 0x51, 0x20, 0x1e, 0xa0, 0x0c, 0xf4, 0x7b, 0x3d, 0x6f,
+	0x40, 0x66, 0x35, 0xa2, 0x30, 0x46, 0x00, 0x1b, 0x21, 0x0e, 0x25, 0x17, 0x8d, 0xe3, 0x20, 0xfe,
+	0xc9, 0xeb, 0x52, 0xa5, 0xcd, 0xd5, 0x45, 0xdf, 0xae, 0x3a, 0xb2, 0x1b, 0x1e, 0x3a, 0xfc, 0xb7,
+	0x03, 0x6e, 0xa7, 0xa6, 0x06, 0x3d, 0x06, 0x3a, 0x00, 0x1e, 0x3d, 0x5c, 0x6f, 0xe7, 0x3c, 0xd6,
+	0xa6, 0xce, 0xc0, 0x0a, 0xda, 0x0a, 0x61, 0xfd, 0x26, 0x21, 0xfe, 0x05, 0xe5, 0x5d, 0x26, 0x64,
+	0xd7, 0xea, 0x32, 0x45, 0x5d, 0xaa, 0x28, 0xf9, 0x4c, 0x97, 0xf0, 0x65, 0xe2, 0x29, 0x7e, 0x0a,
+	0x4d, 0xd1, 0x63, 0x2c, 0xe9, 0xf8, 0xa1, 0x82, 0x7f, 0x46, 0x39, 0x60, 0xc2, 0xb5, 0x98, 0xa0,
+	0xb6, 0xc7, 0x5c, 0x32, 0xa7, 0x71, 0xe5, 0x44, 0x5c, 0x93, 0x09, 0xf7, 0xc8, 0xf8, 0xa2, 0xc4,
+	0x2c, 0x8c, 0xbf, 0xe3, 0x26, 0xca, 0x87, 0x7d, 0xb2, 0xa8, 0xc7, 0x7c, 0x05, 0x24, 0xa3, 0x89,
+	0x9b, 0xd3, 0xda, 0x5c, 0x0f, 0x9c, 0xb1, 0x22, 0xed, 0x88, 0x00, 0x01, 0xb4, 0x45, 0x1d, 0x25,
+	0xfd, 0x81, 0xa5, 0xab, 0x07, 0x32, 0x3f, 0x05, 0x7a, 0x6c, 0xac, 0xdf, 0x05, 0xce, 0x18, 0xb4,
+	0x15, 0x11, 0x00, 0xff, 0x8d, 0x0a, 0xfa, 0xe4, 0x3e, 0x03, 0xe5, 0x73, 0x47, 0x71, 0x29, 0xac,
+	0x9e, 0xf4, 0xb8, 0xc3, 0x19, 0x90, 0x05, 0xcd, 0xdf, 0xfe, 0x60, 0x1b, 0xce, 0xc6, 0x41, 0xa7,
+	0x41, 0xcc, 0x20, 0x9a, 0x68, 0x1d, 0x12, 0x1c, 0x9c, 0x01, 0xfe, 0x1d, 0x2d, 0x0f, 0x9b, 0xf3,
+	0x97, 0xec, 0xfb, 0x82, 0x7a, 0x64, 0x51, 0x27, 0xaa, 0x4c, 0xeb, 0xce, 0x0f, 0xc6, 0x7a, 0x24,
+	0x94, 0x1f, 0x4b, 0x93, 0xb7, 0x63, 0x3a, 0x3e, 0x47, 0x05, 0x21, 0x85, 0xe5, 0x70, 0xdf, 0xe9,
+	0x7b, 0x54, 0x71, 0xd1, 0xb6, 0xa8, 0xeb, 0xfa, 0x0c, 0x80, 0x01, 0x41, 0xe5, 0xd9, 0xca, 0x62,
+	0x83, 0xbc, 0x78, 0xba, 0xb3, 0x16, 0xa6, 0xaa, 0x1b, 0xad, 0xa9, 0x7c, 0x2e, 0xda, 0x67, 0xeb,
+	0x42, 0x8a, 0xc3, 0x71, 0x64, 0x7d, 0x18, 0x88, 0x0f, 0xd0, 0xdc, 0x85, 0xf4, 0x5c, 0x20, 0xd9,
+	0xc9, 0x5d, 0x8f, 0x54, 0x7a, 0x22, 0xbd, 0xd8, 0x4a, 0x98, 0x10, 0xec, 0xa0, 0x35, 0xb3, 0xad,
+	0xca, 0xa7, 0x02, 0x5a, 0xcc, 0xb7, 0x2e, 0xa4, 0xec, 0x00, 0xc9, 0x69, 0xd4, 0x37, 0x89, 0x28,
+	0x3d, 0x9d, 0xf3, 0xd0, 0x7f, 0x22, 0x65, 0x27, 0xca, 0xc5, 0xee, 0xa4, 0x0a, 0xf8, 0x0f, 0x84,
+	0xa9, 0xe3, 0xc8, 0xbe, 0x50, 0x16, 0x75, 0x14, 0xbf, 0xe4, 0x2a, 0x18, 0xe0, 0x92, 0x4e, 0xf1,
+	0x55, 0x62, 0x8a, 0xba, 0xb1, 0xd7, 0x8d, 0x3b, 0xd6, 0xd3, 0x15, 0x1a, 0xd3, 0x82, 0xa1, 0xfd,
+	0x8a, 0x96, 0xa1, 0xc7, 0x84, 0x1b, 0xf4, 0xd3, 0xe3, 0x5d, 0xae, 0x80, 0xe4, 0x35, 0x7c, 0x2b,
+	0x79, 0x3b, 0x42, 0xef, 0x8f, 0x81, 0x35, 0x36, 0x2e, 0x88, 0x2a, 0x80, 0xff, 0x44, 0xab, 0x13,
+	0xcd, 0x69, 0x31, 0x06, 0x64, 0x59, 0xb3, 0xbf, 0xfe, 0x78, 0x6f, 0x8e, 0x59, 0xec, 0x7a, 0x5a,
+	0x71, 0x27, 0x44, 0xd8, 0x7a, 0x9e, 0x46, 0xf3, 0xe1, 0x0e, 0xe1, 0x3d, 0x34, 0x1f, 0x2e, 0x83,
+	0xbe, 0x39, 0xa7, 0xad, 0xc2, 0xd0, 0x88, 0xff, 0x41, 0x73, 0xfa, 0x0a, 0x22, 0x33, 0x93, 0xa3,
+	0xff, 0x54, 0xd7, 0x9c, 0xc9, 0x77, 0xb0, 0xf0, 0xdf, 0x55, 0x29, 0xf5, 0xf6, 0xaa, 0x94, 0x6a,
+	0xec, 0xdf, 0xdc, 0x15, 0xd3, 0xb7, 0x77, 0xc5, 0xf4, 0x9b, 0xbb, 0x62, 0xfa, 0xff, 0xfb, 0x62,
+	0xea, 0xf6, 0xbe, 0x98, 0x7a, 0x79, 0x5f, 0x4c, 0xfd, 0x16, 0x3e, 0x24, 0xe0, 0x76, 0xaa, 0x5c,
+	0xd6, 0xfe, 0x35, 0x0f, 0x8e, 0xce, 0x60, 0x67, 0xf4, 0xe3, 0xb1, 0xff, 0x6e, 0x00, 0x74, 0x64,
+	0xc4, 0xa3, 0xfa, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -214,6 +325,141 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomTransferFees) > 0 {
+		for iNdEx := len(m.DenomTransferFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomTransferFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.SpendingLimits) > 0 {
+		for iNdEx := len(m.SpendingLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendingLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.AccountActivities) > 0 {
+		for iNdEx := len(m.AccountActivities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountActivities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.DenomTransferHooks) > 0 {
+		for iNdEx := len(m.DenomTransferHooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomTransferHooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Holds) > 0 {
+		for iNdEx := len(m.Holds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Holds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.NonCirculatingAddresses) > 0 {
+		for iNdEx := len(m.NonCirculatingAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NonCirculatingAddresses[iNdEx])
+			copy(dAtA[i:], m.NonCirculatingAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.NonCirculatingAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.BalanceJournal) > 0 {
+		for iNdEx := len(m.BalanceJournal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BalanceJournal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.SendRestrictionPolicies) > 0 {
+		for iNdEx := len(m.SendRestrictionPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendRestrictionPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.FactoryDenoms) > 0 {
+		for iNdEx := len(m.FactoryDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FactoryDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.BalanceAlerts) > 0 {
+		for iNdEx := len(m.BalanceAlerts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BalanceAlerts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.SendEnabled) > 0 {
 		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -370,6 +616,66 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.BalanceAlerts) > 0 {
+		for _, e := range m.BalanceAlerts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FactoryDenoms) > 0 {
+		for _, e := range m.FactoryDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SendRestrictionPolicies) > 0 {
+		for _, e := range m.SendRestrictionPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BalanceJournal) > 0 {
+		for _, e := range m.BalanceJournal {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NonCirculatingAddresses) > 0 {
+		for _, s := range m.NonCirculatingAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Holds) > 0 {
+		for _, e := range m.Holds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomTransferHooks) > 0 {
+		for _, e := range m.DenomTransferHooks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccountActivities) > 0 {
+		for _, e := range m.AccountActivities {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SpendingLimits) > 0 {
+		for _, e := range m.SpendingLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomTransferFees) > 0 {
+		for _, e := range m.DenomTransferFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -596,6 +902,344 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceAlerts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BalanceAlerts = append(m.BalanceAlerts, BalanceAlert{})
+			if err := m.BalanceAlerts[len(m.BalanceAlerts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FactoryDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FactoryDenoms = append(m.FactoryDenoms, FactoryDenom{})
+			if err := m.FactoryDenoms[len(m.FactoryDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendRestrictionPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendRestrictionPolicies = append(m.SendRestrictionPolicies, SendRestrictionPolicy{})
+			if err := m.SendRestrictionPolicies[len(m.SendRestrictionPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceJournal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BalanceJournal = append(m.BalanceJournal, BalanceJournalEntry{})
+			if err := m.BalanceJournal[len(m.BalanceJournal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonCirculatingAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NonCirculatingAddresses = append(m.NonCirculatingAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holds = append(m.Holds, Hold{})
+			if err := m.Holds[len(m.Holds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomTransferHooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomTransferHooks = append(m.DenomTransferHooks, DenomTransferHook{})
+			if err := m.DenomTransferHooks[len(m.DenomTransferHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountActivities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountActivities = append(m.AccountActivities, AccountActivity{})
+			if err := m.AccountActivities[len(m.AccountActivities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendingLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendingLimits = append(m.SpendingLimits, SpendingLimit{})
+			if err := m.SpendingLimits[len(m.SpendingLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomTransferFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomTransferFees = append(m.DenomTransferFees, DenomTransferFee{})
+			if err := m.DenomTransferFees[len(m.DenomTransferFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
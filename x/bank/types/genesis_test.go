@@ -143,6 +143,287 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"duplicate balance alerts",
+			GenesisState{
+				BalanceAlerts: []BalanceAlert{
+					NewBalanceAlert("cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", "uatom", math.NewInt(10)),
+					NewBalanceAlert("cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", "uatom", math.NewInt(20)),
+				},
+			},
+			true,
+		},
+		{
+			"invalid balance alert address",
+			GenesisState{
+				BalanceAlerts: []BalanceAlert{
+					NewBalanceAlert("cosmos1invalid", "uatom", math.NewInt(10)),
+				},
+			},
+			true,
+		},
+		{
+			"zero balance alert threshold",
+			GenesisState{
+				BalanceAlerts: []BalanceAlert{
+					NewBalanceAlert("cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", "uatom", math.ZeroInt()),
+				},
+			},
+			true,
+		},
+		{
+			"valid factory denoms",
+			GenesisState{
+				FactoryDenoms: []FactoryDenom{
+					{Denom: "factory/cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t/a", Admin: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t"},
+					{Denom: "factory/cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t/b"},
+				},
+			},
+			false,
+		},
+		{
+			"duplicate factory denom",
+			GenesisState{
+				FactoryDenoms: []FactoryDenom{
+					{Denom: "factory/cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t/a"},
+					{Denom: "factory/cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t/a"},
+				},
+			},
+			true,
+		},
+		{
+			"invalid factory denom",
+			GenesisState{
+				FactoryDenoms: []FactoryDenom{{Denom: "uatom"}},
+			},
+			true,
+		},
+		{
+			"valid send restriction policies",
+			GenesisState{
+				SendRestrictionPolicies: []SendRestrictionPolicy{
+					{Name: "blocklist", RestrictionType: SEND_RESTRICTION_TYPE_ADDRESS_BLOCKLIST, Enabled: true, Addresses: []string{"cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t"}},
+					{Name: "pause", RestrictionType: SEND_RESTRICTION_TYPE_TRANSFER_PAUSE, Denoms: []string{"uatom"}},
+				},
+			},
+			false,
+		},
+		{
+			"duplicate send restriction policy",
+			GenesisState{
+				SendRestrictionPolicies: []SendRestrictionPolicy{
+					{Name: "pause", RestrictionType: SEND_RESTRICTION_TYPE_TRANSFER_PAUSE, Denoms: []string{"uatom"}},
+					{Name: "pause", RestrictionType: SEND_RESTRICTION_TYPE_DENOM_FREEZE, Denoms: []string{"uatom"}},
+				},
+			},
+			true,
+		},
+		{
+			"unspecified send restriction type",
+			GenesisState{
+				SendRestrictionPolicies: []SendRestrictionPolicy{{Name: "pause", Denoms: []string{"uatom"}}},
+			},
+			true,
+		},
+		{
+			"valid balance journal",
+			GenesisState{
+				BalanceJournal: []BalanceJournalEntry{
+					{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Height: 5, Denom: "uatom", Delta: math.NewInt(10), Reason: BalanceChangeReasonSend},
+					{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Height: 6, Denom: "uatom", Delta: math.NewInt(-4), Reason: BalanceChangeReasonBurn},
+				},
+			},
+			false,
+		},
+		{
+			"zero balance journal delta",
+			GenesisState{
+				BalanceJournal: []BalanceJournalEntry{
+					{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Height: 5, Denom: "uatom", Delta: math.ZeroInt()},
+				},
+			},
+			true,
+		},
+		{
+			"empty balance journal address",
+			GenesisState{
+				BalanceJournal: []BalanceJournalEntry{{Height: 5, Denom: "uatom", Delta: math.NewInt(10)}},
+			},
+			true,
+		},
+		{
+			"valid non-circulating addresses",
+			GenesisState{
+				NonCirculatingAddresses: []string{"cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t"},
+			},
+			false,
+		},
+		{
+			"duplicate non-circulating address",
+			GenesisState{
+				NonCirculatingAddresses: []string{
+					"cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+					"cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t",
+				},
+			},
+			true,
+		},
+		{
+			"empty non-circulating address",
+			GenesisState{
+				NonCirculatingAddresses: []string{""},
+			},
+			true,
+		},
+		{
+			"valid holds",
+			GenesisState{
+				Holds: []Hold{
+					{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Holder: "escrow", Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 10))},
+					{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Holder: "market", Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 5))},
+				},
+			},
+			false,
+		},
+		{
+			"duplicate hold",
+			GenesisState{
+				Holds: []Hold{
+					{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Holder: "escrow", Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 10))},
+					{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Holder: "escrow", Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 5))},
+				},
+			},
+			true,
+		},
+		{
+			"empty hold holder",
+			GenesisState{
+				Holds: []Hold{{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 10))}},
+			},
+			true,
+		},
+		{
+			"zero hold amount",
+			GenesisState{
+				Holds: []Hold{{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Holder: "escrow"}},
+			},
+			true,
+		},
+		{
+			"valid account activities",
+			GenesisState{
+				AccountActivities: []AccountActivity{
+					{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Height: 10},
+				},
+			},
+			false,
+		},
+		{
+			"duplicate account activity",
+			GenesisState{
+				AccountActivities: []AccountActivity{
+					{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Height: 10},
+					{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Height: 20},
+				},
+			},
+			true,
+		},
+		{
+			"valid spending limit",
+			GenesisState{
+				SpendingLimits: []SpendingLimit{
+					{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Denom: "uatom", DailyLimit: math.NewInt(100), Spent: math.NewInt(10), PendingDailyLimit: math.ZeroInt()},
+				},
+			},
+			false,
+		},
+		{
+			"duplicate spending limit",
+			GenesisState{
+				SpendingLimits: []SpendingLimit{
+					{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Denom: "uatom", DailyLimit: math.NewInt(100), Spent: math.ZeroInt(), PendingDailyLimit: math.ZeroInt()},
+					{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Denom: "uatom", DailyLimit: math.NewInt(200), Spent: math.ZeroInt(), PendingDailyLimit: math.ZeroInt()},
+				},
+			},
+			true,
+		},
+		{
+			"spending limit with no daily limit",
+			GenesisState{
+				SpendingLimits: []SpendingLimit{
+					{Address: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", Denom: "uatom", DailyLimit: math.ZeroInt(), Spent: math.ZeroInt(), PendingDailyLimit: math.ZeroInt()},
+				},
+			},
+			true,
+		},
+		{
+			"valid denom transfer hooks",
+			GenesisState{
+				DenomTransferHooks: []DenomTransferHook{
+					{Denom: "uatom", Target: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", BeforeTransfer: true, GasLimit: 50_000},
+					{Denom: "uosmo", Target: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", AfterTransfer: true, GasLimit: 50_000},
+				},
+			},
+			false,
+		},
+		{
+			"duplicate denom transfer hook",
+			GenesisState{
+				DenomTransferHooks: []DenomTransferHook{
+					{Denom: "uatom", Target: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", BeforeTransfer: true, GasLimit: 50_000},
+					{Denom: "uatom", Target: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", AfterTransfer: true, GasLimit: 50_000},
+				},
+			},
+			true,
+		},
+		{
+			"denom transfer hook without a call",
+			GenesisState{
+				DenomTransferHooks: []DenomTransferHook{{Denom: "uatom", Target: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", GasLimit: 50_000}},
+			},
+			true,
+		},
+		{
+			"denom transfer hook gas limit too high",
+			GenesisState{
+				DenomTransferHooks: []DenomTransferHook{{Denom: "uatom", Target: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", BeforeTransfer: true, GasLimit: MaxTransferHookGasLimit + 1}},
+			},
+			true,
+		},
+		{
+			"valid denom transfer fees",
+			GenesisState{
+				DenomTransferFees: []DenomTransferFee{
+					{Denom: "uatom", Rate: math.LegacyNewDecWithPrec(1, 2), Collector: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t"},
+					{Denom: "uosmo", Rate: math.LegacyNewDecWithPrec(5, 3), Collector: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t", ExemptAddresses: []string{"cosmos1a8lrksv8x0hqmwwprfhmjz2rmcem8eaq5ku5y5"}},
+				},
+			},
+			false,
+		},
+		{
+			"duplicate denom transfer fee",
+			GenesisState{
+				DenomTransferFees: []DenomTransferFee{
+					{Denom: "uatom", Rate: math.LegacyNewDecWithPrec(1, 2), Collector: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t"},
+					{Denom: "uatom", Rate: math.LegacyNewDecWithPrec(2, 2), Collector: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t"},
+				},
+			},
+			true,
+		},
+		{
+			"denom transfer fee rate of one",
+			GenesisState{
+				DenomTransferFees: []DenomTransferFee{{Denom: "uatom", Rate: math.LegacyOneDec(), Collector: "cosmos1yq8lgssgxlx9smjhes6ryjasmqmd3ts2559g0t"}},
+			},
+			true,
+		},
+		{
+			"denom transfer fee without a collector",
+			GenesisState{
+				DenomTransferFees: []DenomTransferFee{{Denom: "uatom", Rate: math.LegacyNewDecWithPrec(1, 2)}},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Validate performs a basic validation of the hold fields.
+func (h Hold) Validate() error {
+	if h.Address == "" {
+		return errors.New("hold address cannot be empty")
+	}
+
+	if strings.TrimSpace(h.Holder) == "" {
+		return errors.New("hold holder cannot be empty")
+	}
+
+	if !h.Amount.IsValid() || h.Amount.IsZero() {
+		return fmt.Errorf("invalid hold amount: %s", h.Amount)
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package types
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BurnHooks defines the hooks called by the bank keeper when coins are burned.
+type BurnHooks interface {
+	// AfterCoinsBurned is called after amount was burned from the burner account.
+	AfterCoinsBurned(ctx context.Context, burner sdk.AccAddress, amount sdk.Coins) error
+}
+
+// BurnHooksWrapper is a wrapper for modules to inject BurnHooks using depinject.
+type BurnHooksWrapper struct{ BurnHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (BurnHooksWrapper) IsOnePerModuleType() {}
+
+var _ BurnHooks = MultiBurnHooks{}
+
+// MultiBurnHooks combines multiple burn hooks, all hook functions are run in array sequence.
+type MultiBurnHooks []BurnHooks
+
+// NewMultiBurnHooks returns the given burn hooks combined as MultiBurnHooks.
+func NewMultiBurnHooks(hooks ...BurnHooks) MultiBurnHooks {
+	return hooks
+}
+
+// AfterCoinsBurned implements BurnHooks.
+func (h MultiBurnHooks) AfterCoinsBurned(ctx context.Context, burner sdk.AccAddress, amount sdk.Coins) error {
+	for i := range h {
+		if err := h[i].AfterCoinsBurned(ctx, burner, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TransferHooks defines the hooks called by the bank keeper before and after
+// transfers of the denoms a DenomTransferHook is registered for. The target is
+// the address of the module or contract the hook is registered with, so that a
+// single implementation can dispatch the calls to many targets.
+//
+// Each call is executed in a branched context limited to the gas limit of the
+// hook. Returning an error aborts the transfer.
+type TransferHooks interface {
+	// BeforeTransfer is called before amount is transferred from from to to.
+	BeforeTransfer(ctx context.Context, target, from, to sdk.AccAddress, amount sdk.Coin) error
+
+	// AfterTransfer is called after amount was transferred from from to to.
+	AfterTransfer(ctx context.Context, target, from, to sdk.AccAddress, amount sdk.Coin) error
+}
+
+// TransferHooksWrapper is a wrapper for modules to inject TransferHooks using depinject.
+type TransferHooksWrapper struct{ TransferHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (TransferHooksWrapper) IsOnePerModuleType() {}
+
+var _ TransferHooks = TransferHooksRouter{}
+
+// TransferHooksRouter dispatches transfer hook calls to the TransferHooks
+// registered for their target address, e.g. a module account, falling back to
+// a default TransferHooks, e.g. a contract runtime, for unregistered targets.
+type TransferHooksRouter struct {
+	routes   map[string]TransferHooks
+	fallback TransferHooks
+}
+
+// NewTransferHooksRouter returns a router dispatching the calls for
+// unregistered targets to fallback, which can be nil.
+func NewTransferHooksRouter(fallback TransferHooks) TransferHooksRouter {
+	return TransferHooksRouter{routes: map[string]TransferHooks{}, fallback: fallback}
+}
+
+// AddRoute registers the hooks called for the given target.
+func (r TransferHooksRouter) AddRoute(target sdk.AccAddress, hooks TransferHooks) TransferHooksRouter {
+	r.routes[string(target)] = hooks
+	return r
+}
+
+// BeforeTransfer implements TransferHooks.
+func (r TransferHooksRouter) BeforeTransfer(ctx context.Context, target, from, to sdk.AccAddress, amount sdk.Coin) error {
+	hooks, err := r.route(target)
+	if err != nil {
+		return err
+	}
+	return hooks.BeforeTransfer(ctx, target, from, to, amount)
+}
+
+// AfterTransfer implements TransferHooks.
+func (r TransferHooksRouter) AfterTransfer(ctx context.Context, target, from, to sdk.AccAddress, amount sdk.Coin) error {
+	hooks, err := r.route(target)
+	if err != nil {
+		return err
+	}
+	return hooks.AfterTransfer(ctx, target, from, to, amount)
+}
+
+// HasRoute returns whether the calls for the given target are dispatched,
+// either to the hooks registered for it or to the fallback.
+func (r TransferHooksRouter) HasRoute(target sdk.AccAddress) bool {
+	_, ok := r.routes[string(target)]
+	return ok || r.fallback != nil
+}
+
+func (r TransferHooksRouter) route(target sdk.AccAddress) (TransferHooks, error) {
+	if hooks, ok := r.routes[string(target)]; ok {
+		return hooks, nil
+	}
+	if r.fallback != nil {
+		return r.fallback, nil
+	}
+	return nil, fmt.Errorf("no transfer hooks registered for target %s", target)
+}
@@ -2,33 +2,44 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // ValidateInputOutputs validates that each respective input and output is
-// valid and that the sum of inputs is equal to the sum of outputs.
+// valid and that the sum of inputs is equal to the sum of outputs. The outputs
+// are summed per denom, so that validation is linear in the number of outputs.
 func ValidateInputOutputs(input Input, outputs []Output) error {
-	var totalIn, totalOut sdk.Coins
-
 	if err := input.ValidateBasic(); err != nil {
 		return err
 	}
-	totalIn = input.Coins
 
+	totalOut := make(map[string]math.Int, len(input.Coins))
 	for _, out := range outputs {
 		if err := out.ValidateBasic(); err != nil {
 			return err
 		}
 
-		totalOut = totalOut.Add(out.Coins...)
+		for _, coin := range out.Coins {
+			if total, ok := totalOut[coin.Denom]; ok {
+				totalOut[coin.Denom] = total.Add(coin.Amount)
+			} else {
+				totalOut[coin.Denom] = coin.Amount
+			}
+		}
 	}
 
 	// make sure inputs and outputs match
-	if !totalIn.Equal(totalOut) {
+	if len(totalOut) != len(input.Coins) {
 		return ErrInputOutputMismatch
 	}
+	for _, coin := range input.Coins {
+		if total, ok := totalOut[coin.Denom]; !ok || !total.Equal(coin.Amount) {
+			return ErrInputOutputMismatch
+		}
+	}
 
 	return nil
 }
@@ -15,6 +15,10 @@ const (
 	// StoreKey defines the primary module store key
 	StoreKey = ModuleName
 
+	// TStoreKey defines the transient store key, holding the transfers between
+	// module accounts traced within a block
+	TStoreKey = "transient_" + ModuleName
+
 	// GovModuleName duplicates the gov module's name to avoid a cyclic dependency with x/gov.
 	// It should be synced with the gov module's name if it is ever changed.
 	// See: https://github.com/cosmos/cosmos-sdk/blob/b62a28aac041829da5ded4aeacfcd7a42873d1c8/x/gov/types/keys.go#L9
@@ -24,6 +28,11 @@ const (
 	// It should be synced with the mint module's name if it is ever changed.
 	// See: https://github.com/cosmos/cosmos-sdk/blob/0e34478eb7420b69869ed50f129fc274a97a9b06/x/mint/types/keys.go#L13
 	MintModuleName = "mint"
+
+	// ProtocolPoolModuleName duplicates the protocolpool module's name to avoid a cyclic dependency with x/protocolpool.
+	// It should be synced with the protocolpool module's name if it is ever changed.
+	// The balance of its module account is the community pool.
+	ProtocolPoolModuleName = "protocolpool"
 )
 
 // KVStore keys
@@ -39,6 +48,50 @@ var (
 
 	// ParamsKey is the prefix for x/bank parameters
 	ParamsKey = collections.NewPrefix(5)
+
+	// BalanceAlertsPrefix is the prefix for the balance thresholds registered on module accounts.
+	BalanceAlertsPrefix = collections.NewPrefix(6)
+
+	// FactoryDenomsPrefix is the prefix for the admins of the denoms created through the token factory.
+	FactoryDenomsPrefix = collections.NewPrefix(7)
+
+	// SendRestrictionPoliciesPrefix is the prefix for the send restriction policies registered by governance.
+	SendRestrictionPoliciesPrefix = collections.NewPrefix(8)
+
+	// BalanceJournalPrefix is the prefix for the balance changes recorded in the balance journal.
+	BalanceJournalPrefix = collections.NewPrefix(9)
+	// BalanceJournalByHeightPrefix is the prefix for the index of the balance journal by height.
+	BalanceJournalByHeightPrefix = collections.NewPrefix(10)
+	// BalanceJournalSequenceKey is the key for the sequence of the balance journal entries.
+	BalanceJournalSequenceKey = collections.NewPrefix(11)
+	// BalanceJournalAddressesPrefix is the prefix for the addresses tracked by the balance journal.
+	BalanceJournalAddressesPrefix = collections.NewPrefix(12)
+
+	// NonCirculatingAddressesPrefix is the prefix for the addresses excluded from the circulating supply.
+	NonCirculatingAddressesPrefix = collections.NewPrefix(13)
+
+	// HoldsPrefix is the prefix for the coins placed on hold by modules.
+	HoldsPrefix = collections.NewPrefix(14)
+
+	// DenomTransferHooksPrefix is the prefix for the transfer hooks registered for denoms.
+	DenomTransferHooksPrefix = collections.NewPrefix(15)
+
+	// AccountActivitiesPrefix is the prefix for the height of the latest balance change of the accounts tracked for dust sweeping.
+	AccountActivitiesPrefix = collections.NewPrefix(16)
+
+	// SpendingLimitsPrefix is the prefix for the daily spending limits set by accounts.
+	SpendingLimitsPrefix = collections.NewPrefix(17)
+
+	// DenomTransferFeesPrefix is the prefix for the transfer fees charged on denoms.
+	DenomTransferFeesPrefix = collections.NewPrefix(18)
+)
+
+// Transient store keys
+var (
+	// ModuleTransfersPrefix is the prefix for the transfers between module accounts traced within a block.
+	ModuleTransfersPrefix = collections.NewPrefix(0)
+	// ModuleTransferSequenceKey is the key for the sequence of the transfers between module accounts traced within a block.
+	ModuleTransferSequenceKey = collections.NewPrefix(1)
 )
 
 // BalanceValueCodec is a codec for encoding bank balances in a backwards compatible way.
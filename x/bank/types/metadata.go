@@ -77,6 +77,33 @@ func (m Metadata) Validate() error {
 	return nil
 }
 
+// ValidateUpdate checks that the metadata can replace the previous metadata of
+// the same denom: on top of Validate, the denomination units present in both
+// must keep their exponent, so that the amounts displayed by clients for a unit
+// never change meaning.
+func (m Metadata) ValidateUpdate(prev Metadata) error {
+	if err := m.Validate(); err != nil {
+		return err
+	}
+
+	if m.Base != prev.Base {
+		return fmt.Errorf("metadata base denom '%s' does not match '%s'", m.Base, prev.Base)
+	}
+
+	exponents := make(map[string]uint32, len(prev.DenomUnits))
+	for _, denomUnit := range prev.DenomUnits {
+		exponents[denomUnit.Denom] = denomUnit.Exponent
+	}
+
+	for _, denomUnit := range m.DenomUnits {
+		if exponent, ok := exponents[denomUnit.Denom]; ok && exponent != denomUnit.Exponent {
+			return fmt.Errorf("the exponent of denomination unit %s cannot change from %d to %d", denomUnit.Denom, exponent, denomUnit.Exponent)
+		}
+	}
+
+	return nil
+}
+
 // Validate performs a basic validation of the denomination unit fields
 func (du DenomUnit) Validate() error {
 	if err := sdk.ValidateDenom(du.Denom); err != nil {
@@ -230,6 +230,83 @@ func TestMetadataValidate(t *testing.T) {
 	}
 }
 
+func TestMetadataValidateUpdate(t *testing.T) {
+	prev := types.Metadata{
+		Name:   "Cosmos Hub Atom",
+		Symbol: "ATOM",
+		DenomUnits: []*types.DenomUnit{
+			{"uatom", uint32(0), []string{"microatom"}},
+			{"atom", uint32(6), nil},
+		},
+		Base:    "uatom",
+		Display: "atom",
+	}
+
+	testCases := []struct {
+		name     string
+		metadata types.Metadata
+		expErr   string
+	}{
+		{
+			"new name and unit",
+			types.Metadata{
+				Name:   "Atom",
+				Symbol: "ATOM",
+				DenomUnits: []*types.DenomUnit{
+					{"uatom", uint32(0), []string{"microatom"}},
+					{"matom", uint32(3), nil},
+					{"atom", uint32(6), nil},
+				},
+				Base:    "uatom",
+				Display: "matom",
+			},
+			"",
+		},
+		{
+			"invalid metadata",
+			types.Metadata{Name: "Atom", Base: "uatom"},
+			"symbol field cannot be blank",
+		},
+		{
+			"other base",
+			types.Metadata{
+				Name:       "Atom",
+				Symbol:     "ATOM",
+				DenomUnits: []*types.DenomUnit{{"natom", uint32(0), nil}},
+				Base:       "natom",
+				Display:    "natom",
+			},
+			"does not match",
+		},
+		{
+			"changed exponent",
+			types.Metadata{
+				Name:   "Atom",
+				Symbol: "ATOM",
+				DenomUnits: []*types.DenomUnit{
+					{"uatom", uint32(0), nil},
+					{"atom", uint32(9), nil},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			"cannot change from 6 to 9",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.metadata.ValidateUpdate(prev)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestMarshalJSONMetaData(t *testing.T) {
 	cdc := codec.NewLegacyAmino()
 
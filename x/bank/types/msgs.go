@@ -8,6 +8,15 @@ var (
 	_ sdk.Msg = &MsgSend{}
 	_ sdk.Msg = &MsgMultiSend{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetBalanceAlerts{}
+	_ sdk.Msg = &MsgCreateDenom{}
+	_ sdk.Msg = &MsgFactoryMint{}
+	_ sdk.Msg = &MsgFactoryBurn{}
+	_ sdk.Msg = &MsgForceTransfer{}
+	_ sdk.Msg = &MsgChangeDenomAdmin{}
+	_ sdk.Msg = &MsgSetFactoryDenomMetadata{}
+	_ sdk.Msg = &MsgSetSendRestrictionPolicies{}
+	_ sdk.Msg = &MsgSetNonCirculatingAddresses{}
 )
 
 // NewMsgSend - construct a msg to send coins from one account to another.
@@ -28,3 +37,29 @@ func NewMsgSetSendEnabled(authority string, sendEnabled []*SendEnabled, useDefau
 		UseDefaultFor: useDefaultFor,
 	}
 }
+
+// NewMsgSetBalanceAlerts Construct a message to set one or more BalanceAlert entries.
+func NewMsgSetBalanceAlerts(authority string, balanceAlerts []BalanceAlert) *MsgSetBalanceAlerts {
+	return &MsgSetBalanceAlerts{
+		Authority:     authority,
+		BalanceAlerts: balanceAlerts,
+	}
+}
+
+// NewMsgSetSendRestrictionPolicies Construct a message to register, update or remove send restriction policies.
+func NewMsgSetSendRestrictionPolicies(authority string, policies []SendRestrictionPolicy, remove []string) *MsgSetSendRestrictionPolicies {
+	return &MsgSetSendRestrictionPolicies{
+		Authority: authority,
+		Policies:  policies,
+		Remove:    remove,
+	}
+}
+
+// NewMsgSetNonCirculatingAddresses Construct a message to add or remove addresses excluded from the circulating supply.
+func NewMsgSetNonCirculatingAddresses(authority string, add, remove []string) *MsgSetNonCirculatingAddresses {
+	return &MsgSetNonCirculatingAddresses{
+		Authority: authority,
+		Add:       add,
+		Remove:    remove,
+	}
+}
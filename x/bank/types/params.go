@@ -21,8 +21,9 @@ func NewParams(defaultSendEnabled bool) Params {
 // DefaultParams is the default parameter configuration for the bank module
 func DefaultParams() Params {
 	return Params{
-		SendEnabled:        nil,
-		DefaultSendEnabled: DefaultDefaultSendEnabled,
+		SendEnabled:              nil,
+		DefaultSendEnabled:       DefaultDefaultSendEnabled,
+		SpendingLimitChangeDelay: DefaultSpendingLimitChangeDelay,
 	}
 }
 
@@ -31,6 +32,37 @@ func (p Params) Validate() error {
 	if len(p.SendEnabled) > 0 {
 		return errors.New("use of send_enabled in params is no longer supported")
 	}
+
+	seen := make(map[string]bool, len(p.BalanceJournalAddresses))
+	for _, addr := range p.BalanceJournalAddresses {
+		if addr == "" {
+			return errors.New("balance journal address cannot be empty")
+		}
+		if seen[addr] {
+			return fmt.Errorf("duplicate balance journal address %s", addr)
+		}
+		seen[addr] = true
+	}
+
+	if err := p.DustThresholds.Validate(); err != nil {
+		return fmt.Errorf("invalid dust thresholds: %w", err)
+	}
+
+	excluded := make(map[string]bool, len(p.DustSweepExcludedAddresses))
+	for _, addr := range p.DustSweepExcludedAddresses {
+		if addr == "" {
+			return errors.New("dust sweep excluded address cannot be empty")
+		}
+		if excluded[addr] {
+			return fmt.Errorf("duplicate dust sweep excluded address %s", addr)
+		}
+		excluded[addr] = true
+	}
+
+	if p.SpendingLimitChangeDelay < 0 {
+		return fmt.Errorf("spending limit change delay cannot be negative: %s", p.SpendingLimitChangeDelay)
+	}
+
 	return validateIsBool(p.DefaultSendEnabled)
 }
 
@@ -38,22 +38,22 @@ func Test_ParamsString(t *testing.T) {
 	}{
 		{
 			name:     "default true empty send enabled",
-			params:   Params{[]*SendEnabled{}, true},
+			params:   Params{SendEnabled: []*SendEnabled{}, DefaultSendEnabled: true},
 			expected: "default_send_enabled:true ",
 		},
 		{
 			name:     "default false empty send enabled",
-			params:   Params{[]*SendEnabled{}, false},
+			params:   Params{SendEnabled: []*SendEnabled{}, DefaultSendEnabled: false},
 			expected: "",
 		},
 		{
 			name:     "default true one true send enabled",
-			params:   Params{[]*SendEnabled{{"foocoin", true}}, true},
+			params:   Params{SendEnabled: []*SendEnabled{{"foocoin", true}}, DefaultSendEnabled: true},
 			expected: "send_enabled:<denom:\"foocoin\" enabled:true > default_send_enabled:true ",
 		},
 		{
 			name:     "default true one false send enabled",
-			params:   Params{[]*SendEnabled{{"barcoin", false}}, true},
+			params:   Params{SendEnabled: []*SendEnabled{{"barcoin", false}}, DefaultSendEnabled: true},
 			expected: "send_enabled:<denom:\"barcoin\" > default_send_enabled:true ",
 		},
 	}
@@ -69,5 +69,11 @@ func Test_validateParams(t *testing.T) {
 	assert.NoError(t, DefaultParams().Validate(), "default")
 	assert.NoError(t, NewParams(true).Validate(), "true")
 	assert.NoError(t, NewParams(false).Validate(), "false")
-	assert.Error(t, Params{[]*SendEnabled{{"foocoing", false}}, true}.Validate(), "with SendEnabled entry")
+	assert.Error(t, Params{SendEnabled: []*SendEnabled{{"foocoing", false}}, DefaultSendEnabled: true}.Validate(), "with SendEnabled entry")
+	assert.NoError(t, Params{DefaultSendEnabled: true, BalanceJournalAddresses: []string{"addr1", "addr2"}, BalanceJournalRetention: 100}.Validate(), "with balance journal")
+	assert.Error(t, Params{DefaultSendEnabled: true, BalanceJournalAddresses: []string{""}}.Validate(), "with empty balance journal address")
+	assert.Error(t, Params{DefaultSendEnabled: true, BalanceJournalAddresses: []string{"addr1", "addr1"}}.Validate(), "with duplicate balance journal address")
+	assert.NoError(t, Params{DefaultSendEnabled: true, DustThresholds: sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)), DustInactivityBlocks: 100, DustSweepExcludedAddresses: []string{"addr1"}}.Validate(), "with dust sweep")
+	assert.Error(t, Params{DefaultSendEnabled: true, DustThresholds: sdk.Coins{sdk.NewInt64Coin("uatom", 0)}}.Validate(), "with zero dust threshold")
+	assert.Error(t, Params{DefaultSendEnabled: true, DustSweepExcludedAddresses: []string{"addr1", "addr1"}}.Validate(), "with duplicate dust sweep excluded address")
 }
@@ -13,6 +13,10 @@ const (
 	QuerySupplyOf    = "supply_of"
 )
 
+// MaxBalancesBatchAddresses is the maximum number of addresses of a
+// Query/BalancesBatch request.
+const MaxBalancesBatchAddresses = 100
+
 // NewQueryBalanceRequest creates a new instance of QueryBalanceRequest.
 func NewQueryBalanceRequest(addr sdk.AccAddress, denom string) *QueryBalanceRequest {
 	return &QueryBalanceRequest{Address: addr.String(), Denom: denom}
@@ -23,6 +27,15 @@ func NewQueryAllBalancesRequest(addr sdk.AccAddress, req *query.PageRequest, res
 	return &QueryAllBalancesRequest{Address: addr.String(), Pagination: req, ResolveDenom: resolveDenom}
 }
 
+// NewQueryBalancesBatchRequest creates a new instance of QueryBalancesBatchRequest.
+func NewQueryBalancesBatchRequest(addrs []sdk.AccAddress, denom string) *QueryBalancesBatchRequest {
+	addresses := make([]string, len(addrs))
+	for i, addr := range addrs {
+		addresses[i] = addr.String()
+	}
+	return &QueryBalancesBatchRequest{Addresses: addresses, Denom: denom}
+}
+
 // NewQuerySpendableBalancesRequest creates a new instance of a
 // QuerySpendableBalancesRequest.
 func NewQuerySpendableBalancesRequest(addr sdk.AccAddress, req *query.PageRequest) *QuerySpendableBalancesRequest {
@@ -82,6 +82,17 @@ func (a SendAuthorization) ValidateBasic() error {
 	return nil
 }
 
+// IncreaseLimit implements authz.LimitedAuthorization.IncreaseLimit. It adds
+// the given amount to the spend limit.
+func (a *SendAuthorization) IncreaseLimit(amount sdk.Coins) error {
+	if !amount.IsValid() || amount.IsZero() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid limit increase %s", amount)
+	}
+
+	a.SpendLimit = a.SpendLimit.Add(amount...)
+	return nil
+}
+
 func toBech32Addresses(allowed []sdk.AccAddress) []string {
 	if len(allowed) == 0 {
 		return nil
@@ -108,3 +108,10 @@ func TestSendAuthorization(t *testing.T) {
 	require.True(t, resp.Accept)
 	require.Nil(t, resp.Updated)
 }
+
+func TestSendAuthorizationIncreaseLimit(t *testing.T) {
+	authorization := types.NewSendAuthorization(coins500, nil)
+	require.Error(t, authorization.IncreaseLimit(sdk.NewCoins()))
+	require.NoError(t, authorization.IncreaseLimit(coins500))
+	require.Equal(t, coins1000, authorization.SpendLimit)
+}
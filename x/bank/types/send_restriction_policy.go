@@ -0,0 +1,96 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxSendRestrictionPolicyNameLength is the maximum length of the name of a
+// send restriction policy.
+const MaxSendRestrictionPolicyNameLength = 64
+
+// Validate performs a stateless validation of the send restriction policy.
+func (p SendRestrictionPolicy) Validate() error {
+	if strings.TrimSpace(p.Name) == "" {
+		return errorsmod.Wrap(ErrInvalidSendRestrictionPolicy, "name cannot be empty")
+	}
+	if len(p.Name) > MaxSendRestrictionPolicyNameLength {
+		return errorsmod.Wrapf(ErrInvalidSendRestrictionPolicy, "name cannot be longer than %d characters", MaxSendRestrictionPolicyNameLength)
+	}
+
+	switch p.RestrictionType {
+	case SEND_RESTRICTION_TYPE_ADDRESS_BLOCKLIST:
+		if len(p.Addresses) == 0 {
+			return errorsmod.Wrapf(ErrInvalidSendRestrictionPolicy, "address blocklist %s must have addresses", p.Name)
+		}
+		if len(p.Denoms) > 0 {
+			return errorsmod.Wrapf(ErrInvalidSendRestrictionPolicy, "address blocklist %s cannot have denoms", p.Name)
+		}
+
+	case SEND_RESTRICTION_TYPE_DENOM_FREEZE:
+		if len(p.Denoms) == 0 {
+			return errorsmod.Wrapf(ErrInvalidSendRestrictionPolicy, "denom freeze %s must have denoms", p.Name)
+		}
+
+	case SEND_RESTRICTION_TYPE_TRANSFER_PAUSE:
+		if len(p.Denoms) == 0 {
+			return errorsmod.Wrapf(ErrInvalidSendRestrictionPolicy, "transfer pause %s must have denoms", p.Name)
+		}
+		if len(p.Addresses) > 0 {
+			return errorsmod.Wrapf(ErrInvalidSendRestrictionPolicy, "transfer pause %s cannot have addresses", p.Name)
+		}
+
+	default:
+		return errorsmod.Wrapf(ErrInvalidSendRestrictionPolicy, "invalid restriction type %s", p.RestrictionType)
+	}
+
+	seenAddresses := make(map[string]bool, len(p.Addresses))
+	for _, addr := range p.Addresses {
+		if addr == "" {
+			return errorsmod.Wrapf(ErrInvalidSendRestrictionPolicy, "empty address in policy %s", p.Name)
+		}
+		if seenAddresses[addr] {
+			return errorsmod.Wrapf(ErrInvalidSendRestrictionPolicy, "duplicate address %s in policy %s", addr, p.Name)
+		}
+		seenAddresses[addr] = true
+	}
+
+	seenDenoms := make(map[string]bool, len(p.Denoms))
+	for _, denom := range p.Denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return errorsmod.Wrapf(ErrInvalidSendRestrictionPolicy, "invalid denom in policy %s: %s", p.Name, err)
+		}
+		if seenDenoms[denom] {
+			return errorsmod.Wrapf(ErrInvalidSendRestrictionPolicy, "duplicate denom %s in policy %s", denom, p.Name)
+		}
+		seenDenoms[denom] = true
+	}
+
+	return nil
+}
+
+// HasAddress returns whether the address is listed by the policy.
+func (p SendRestrictionPolicy) HasAddress(addr string) bool {
+	for _, a := range p.Addresses {
+		if a == addr {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RestrictedCoins returns the coins of amt whose denom is listed by the policy.
+func (p SendRestrictionPolicy) RestrictedCoins(amt sdk.Coins) sdk.Coins {
+	var restricted sdk.Coins
+	for _, denom := range p.Denoms {
+		if amount := amt.AmountOf(denom); amount.IsPositive() {
+			restricted = restricted.Add(sdk.NewCoin(denom, amount))
+		}
+	}
+
+	return restricted
+}
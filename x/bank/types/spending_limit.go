@@ -0,0 +1,78 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SpendingLimitWindow is the duration of the windows the spending of an
+// account is limited over.
+const SpendingLimitWindow = 24 * time.Hour
+
+// DefaultSpendingLimitChangeDelay is the default delay before a raised or
+// removed spending limit takes effect.
+const DefaultSpendingLimitChangeDelay = 24 * time.Hour
+
+// Effective returns the spending limit in effect at the given time: its pending
+// change is applied once due and its spending is reset once its window elapsed.
+// It returns false if the limit was removed by its pending change.
+func (l SpendingLimit) Effective(now time.Time) (SpendingLimit, bool) {
+	if l.PendingTime != nil && !now.Before(*l.PendingTime) {
+		if l.PendingDailyLimit.IsZero() {
+			return l, false
+		}
+		l.DailyLimit = l.PendingDailyLimit
+		l.PendingDailyLimit = math.ZeroInt()
+		l.PendingTime = nil
+	}
+
+	if !now.Before(l.WindowStart.Add(SpendingLimitWindow)) {
+		l.WindowStart = now
+		l.Spent = math.ZeroInt()
+	}
+
+	return l, true
+}
+
+// Remaining returns the amount which can still be sent in the current window.
+func (l SpendingLimit) Remaining() math.Int {
+	if l.Spent.GTE(l.DailyLimit) {
+		return math.ZeroInt()
+	}
+
+	return l.DailyLimit.Sub(l.Spent)
+}
+
+// Validate performs a basic validation of the spending limit fields.
+func (l SpendingLimit) Validate() error {
+	if l.Address == "" {
+		return errors.New("spending limit address cannot be empty")
+	}
+
+	if err := sdk.ValidateDenom(l.Denom); err != nil {
+		return fmt.Errorf("invalid spending limit denom: %w", err)
+	}
+
+	if l.DailyLimit.IsNil() || !l.DailyLimit.IsPositive() {
+		return fmt.Errorf("spending limit daily limit must be positive: %s", l.DailyLimit)
+	}
+
+	if l.Spent.IsNil() || l.Spent.IsNegative() {
+		return fmt.Errorf("spending limit spent amount cannot be negative: %s", l.Spent)
+	}
+
+	if l.PendingDailyLimit.IsNil() || l.PendingDailyLimit.IsNegative() {
+		return fmt.Errorf("spending limit pending daily limit cannot be negative: %s", l.PendingDailyLimit)
+	}
+
+	if l.PendingTime == nil && !l.PendingDailyLimit.IsZero() {
+		return errors.New("spending limit pending daily limit requires a pending time")
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs a basic validation of the transfer fee fields.
+func (f DenomTransferFee) Validate() error {
+	if err := sdk.ValidateDenom(f.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidDenomTransferFee, err.Error())
+	}
+
+	if f.Rate.IsNil() || !f.Rate.IsPositive() || f.Rate.GTE(math.LegacyOneDec()) {
+		return errorsmod.Wrapf(ErrInvalidDenomTransferFee, "%s fee rate must be positive and lower than one, got %s", f.Denom, f.Rate)
+	}
+
+	if f.Collector == "" {
+		return errorsmod.Wrapf(ErrInvalidDenomTransferFee, "%s fee collector cannot be empty", f.Denom)
+	}
+
+	seenExemptions := make(map[string]bool, len(f.ExemptAddresses))
+	for _, addr := range f.ExemptAddresses {
+		if addr == "" {
+			return errorsmod.Wrapf(ErrInvalidDenomTransferFee, "%s fee exempt address cannot be empty", f.Denom)
+		}
+		if seenExemptions[addr] {
+			return errorsmod.Wrapf(ErrInvalidDenomTransferFee, "duplicate %s fee exempt address %s", f.Denom, addr)
+		}
+		seenExemptions[addr] = true
+	}
+
+	return nil
+}
+
+// Fee returns the fee charged on a transfer of amount, rounded down.
+func (f DenomTransferFee) Fee(amount math.Int) math.Int {
+	return f.Rate.MulInt(amount).TruncateInt()
+}
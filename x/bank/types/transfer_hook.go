@@ -0,0 +1,31 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxTransferHookGasLimit is the maximum gas limit of a denom transfer hook.
+const MaxTransferHookGasLimit = 1_000_000
+
+// Validate performs a basic validation of the transfer hook fields.
+func (h DenomTransferHook) Validate() error {
+	if err := sdk.ValidateDenom(h.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidDenomTransferHook, err.Error())
+	}
+
+	if h.Target == "" {
+		return errorsmod.Wrapf(ErrInvalidDenomTransferHook, "%s hook target cannot be empty", h.Denom)
+	}
+
+	if !h.BeforeTransfer && !h.AfterTransfer {
+		return errorsmod.Wrapf(ErrInvalidDenomTransferHook, "%s hook must be called before or after transfers", h.Denom)
+	}
+
+	if h.GasLimit == 0 || h.GasLimit > MaxTransferHookGasLimit {
+		return errorsmod.Wrapf(ErrInvalidDenomTransferHook, "%s hook gas limit must be between 1 and %d, got %d", h.Denom, MaxTransferHookGasLimit, h.GasLimit)
+	}
+
+	return nil
+}
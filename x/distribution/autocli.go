@@ -70,6 +70,63 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "validator_address"},
 					},
 				},
+				{
+					RpcMethod: "CommissionSplit",
+					Use:       "commission-split [validator]",
+					Short:     "Query the payout addresses between which the commission of a validator is split",
+					Example:   fmt.Sprintf("$ %s query distribution commission-split [validator-address]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+					},
+				},
+				{
+					RpcMethod: "ProjectedRewards",
+					Use:       "projected-rewards [delegator-addr]",
+					Short:     "Query the estimated annual rewards of a delegator, per validator",
+					Long:      "Query the estimated annual rewards and APR of the delegations of a delegator, per validator, based on the current inflation, community tax, validator commissions and bonded tokens. Transaction fees and external rewards are not included.",
+					Example:   fmt.Sprintf("$ %s query distribution projected-rewards [delegator-address]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+					},
+				},
+				{
+					RpcMethod: "RewardEventsExport",
+					Use:       "reward-events [delegator-addr]",
+					Short:     "Export the reward withdrawal history of a delegator",
+					Long:      "Export the reward withdrawal history of a delegator, optionally restricted to a height range, as protobuf messages or CSV rows. Reward events are only recorded when enabled in the module parameters.",
+					Example:   fmt.Sprintf("$ %s query distribution reward-events [delegator-address] --starting-height 100 --format REWARD_EVENTS_EXPORT_FORMAT_CSV", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+					},
+				},
+				{
+					RpcMethod: "ValidatorExternalRewards",
+					Use:       "validator-external-rewards [validator]",
+					Short:     "Query the rewards added to a validator by external reward sources",
+					Example:   fmt.Sprintf("$ %s query distribution validator-external-rewards [validator-address]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+					},
+				},
+				{
+					RpcMethod: "TruncationDust",
+					Use:       "truncation-dust [validator]",
+					Short:     "Query the cumulative fractions of coins by which the rewards paid out for a validator were rounded",
+					Example:   fmt.Sprintf("$ %s query distribution truncation-dust [validator-address]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+					},
+				},
+				{
+					RpcMethod: "HistoricalRewardsStats",
+					Use:       "historical-rewards-stats [validator]",
+					Short:     "Query the number of historical rewards records and slash events stored per validator",
+					Long:      "Query the number of historical rewards records and slash events stored per validator, and the number of slash events removed by the next pruning pass, optionally for a single validator.",
+					Example:   fmt.Sprintf("$ %s query distribution historical-rewards-stats [validator-address]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address", Optional: true},
+					},
+				},
 				{
 					RpcMethod: "CommunityPool",
 					Use:       "community-pool",
@@ -116,6 +173,32 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "amount", Varargs: true},
 					},
 				},
+				{
+					RpcMethod: "WithdrawTokenizeShareRecordReward",
+					Use:       "withdraw-tokenize-share-rewards --from [owner]",
+					Short:     "Withdraw the rewards of the tokenized shares of all the tokenize share records of an owner",
+					Example:   fmt.Sprintf("%s tx distribution withdraw-tokenize-share-rewards --from mykey", version.AppName),
+				},
+				{
+					RpcMethod: "WithdrawAllDelegatorRewards",
+					Use:       "withdraw-all-delegator-rewards [destination] --from [delegator]",
+					Short:     "Withdraw the rewards of all the delegations of a delegator in a single message, optionally to a destination address",
+					Example:   fmt.Sprintf("%s tx distribution withdraw-all-delegator-rewards cosmos1... --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "destination_address", Optional: true},
+					},
+				},
+				{
+					RpcMethod: "SetCommissionSplit",
+					Use:       "set-commission-split [validator-addr] [recipients]",
+					Short:     "Split the commission of a validator between payout addresses on withdrawal",
+					Long:      "Split the commission of a validator between up to 10 payout addresses on withdrawal. The weights of the recipients must add up to one. Omitting the recipients removes the split.",
+					Example:   fmt.Sprintf(`%s tx distribution set-commission-split cosmosvaloper1... '{"address":"cosmos1...","weight":"0.7"}' '{"address":"cosmos1...","weight":"0.3"}' --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+						{ProtoField: "recipients", Varargs: true},
+					},
+				},
 				{
 					RpcMethod:  "FundCommunityPool",
 					Deprecated: fmt.Sprintf("Use %s tx protocolpool fund-community-pool", version.AppName),
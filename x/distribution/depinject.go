@@ -36,6 +36,7 @@ type ModuleInputs struct {
 	BankKeeper    types.BankKeeper
 	StakingKeeper types.StakingKeeper
 	PoolKeeper    types.PoolKeeper
+	MintKeeper    types.MintKeeper `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -68,6 +69,9 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		feeCollectorName,
 		authority.String(),
 	)
+	if in.MintKeeper != nil {
+		k.SetMintKeeper(in.MintKeeper)
+	}
 
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.StakingKeeper, in.PoolKeeper)
 
@@ -27,12 +27,20 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) error {
 			return err
 		}
 
+		if err := k.flushPendingRewardAllocations(ctx); err != nil {
+			return err
+		}
+
 		// every 1000 blocks send whole coins from decimal pool to community pool
 		if ctx.BlockHeight()%1000 == 0 {
 			if err := k.sendDecimalPoolToCommunityPool(ctx); err != nil {
 				return err
 			}
 		}
+
+		if err := k.pruneHistoricalRewards(ctx); err != nil {
+			return err
+		}
 	}
 
 	// record the proposer for when we payout on the next block
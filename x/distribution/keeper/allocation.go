@@ -48,6 +48,15 @@ func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, bo
 	voteMultiplier := math.LegacyOneDec().Sub(communityTax)
 	feeMultiplier := feesCollected.MulDecTruncate(voteMultiplier)
 
+	// when deferred, the rewards of the validators are accumulated in the
+	// pending reward allocations instead of being written to their records
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	deferred := params.RewardAllocationFlushInterval > 0
+
 	// allocate tokens proportionally to voting power
 	//
 	// TODO: Consider parallelizing later
@@ -66,12 +75,13 @@ func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, bo
 		powerFraction := math.LegacyNewDec(vote.Validator.Power).QuoTruncate(math.LegacyNewDec(totalPreviousPower))
 		reward := feeMultiplier.MulDecTruncate(powerFraction)
 
-		if err = k.AllocateTokensToValidator(ctx, validator, reward); err != nil {
+		if err = k.allocateTokensToValidator(ctx, validator, reward, deferred); err != nil {
 			return err
 		}
 
 		remaining = remaining.Sub(reward)
 	}
+
 	// send to community pool and set remainder in fee pool
 	amt, re := remaining.TruncateDecimal()
 	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.ProtocolPoolModuleName, amt); err != nil {
@@ -93,24 +103,48 @@ func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, bo
 // AllocateTokensToValidator allocate tokens to a particular validator,
 // splitting according to commission.
 func (k Keeper) AllocateTokensToValidator(ctx context.Context, val sdk.ValidatorI, tokens sdk.DecCoins) error {
+	return k.allocateTokensToValidator(ctx, val, tokens, false)
+}
+
+// allocateTokensToValidator allocates tokens to a particular validator,
+// splitting according to commission. The rewards are added to the pending
+// reward allocation of the validator if deferred, and written to its records
+// otherwise.
+func (k Keeper) allocateTokensToValidator(ctx context.Context, val sdk.ValidatorI, tokens sdk.DecCoins, deferred bool) error {
 	// split tokens between validator and delegators according to commission
 	commission := tokens.MulDec(val.GetCommission())
 	shared := tokens.Sub(commission)
 
-	valBz, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(val.GetOperator())
-	if err != nil {
-		return err
-	}
-
-	// update current commission
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	sdkCtx.EventManager().EmitEvent(
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeCommission,
 			sdk.NewAttribute(sdk.AttributeKeyAmount, commission.String()),
 			sdk.NewAttribute(types.AttributeKeyValidator, val.GetOperator()),
 		),
-	)
+		sdk.NewEvent(
+			types.EventTypeRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, tokens.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, val.GetOperator()),
+		),
+	})
+
+	valBz, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(val.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	if deferred {
+		return k.addPendingRewardAllocation(ctx, valBz, commission, shared)
+	}
+
+	return k.addValidatorRewards(ctx, valBz, commission, shared)
+}
+
+// addValidatorRewards adds the commission and the delegator rewards allocated
+// to a validator to its records.
+func (k Keeper) addValidatorRewards(ctx context.Context, valBz sdk.ValAddress, commission, shared sdk.DecCoins) error {
+	// update current commission
 	currentCommission, err := k.ValidatorsAccumulatedCommission.Get(ctx, valBz)
 	if err != nil && !errors.Is(err, collections.ErrNotFound) {
 		return err
@@ -136,20 +170,12 @@ func (k Keeper) AllocateTokensToValidator(ctx context.Context, val sdk.Validator
 	}
 
 	// update outstanding rewards
-	sdkCtx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeRewards,
-			sdk.NewAttribute(sdk.AttributeKeyAmount, tokens.String()),
-			sdk.NewAttribute(types.AttributeKeyValidator, val.GetOperator()),
-		),
-	)
-
 	outstanding, err := k.ValidatorOutstandingRewards.Get(ctx, valBz)
 	if err != nil && !errors.Is(err, collections.ErrNotFound) {
 		return err
 	}
 
-	outstanding.Rewards = outstanding.Rewards.Add(tokens...)
+	outstanding.Rewards = outstanding.Rewards.Add(commission...).Add(shared...)
 	return k.ValidatorOutstandingRewards.Set(ctx, valBz, outstanding)
 }
 
@@ -339,3 +339,139 @@ func TestAllocateTokensTruncation(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, val2OutstandingRewards.Rewards.IsValid())
 }
+
+func TestAllocateTokensDeferred(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModule{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc).Times(2)
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// defer the reward allocations
+	params := disttypes.DefaultParams()
+	params.RewardAllocationFlushInterval = 10
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+	// create validator with 50% commission
+	valAddr0 := sdk.ValAddress(valConsAddr0)
+	val0, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	val0.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk0)).Return(val0, nil).AnyTimes()
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr0).Return(val0, nil).AnyTimes()
+
+	// create second validator with 0% commission
+	valAddr1 := sdk.ValAddress(valConsAddr1)
+	val1, err := distrtestutil.CreateValidator(valConsPk1, math.NewInt(100))
+	require.NoError(t, err)
+	val1.Commission = stakingtypes.NewCommission(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk1)).Return(val1, nil).AnyTimes()
+
+	votes := []comet.VoteInfo{
+		{
+			Validator: comet.Validator{Address: valConsPk0.Address(), Power: 100},
+		},
+		{
+			Validator: comet.Validator{Address: valConsPk1.Address(), Power: 100},
+		},
+	}
+
+	// allocate tokens for two blocks
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees).Times(2)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees).Times(2)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), disttypes.ModuleName, disttypes.ProtocolPoolModuleName, sdk.Coins{{Denom: sdk.DefaultBondDenom, Amount: math.NewInt(2)}}).Times(2) // 2 community pool coins
+	poolKeeper.EXPECT().SetToDistribute(ctx, gomock.Any(), gomock.Any()).Times(2)
+
+	require.NoError(t, distrKeeper.AllocateTokens(ctx, 200, votes))
+	require.NoError(t, distrKeeper.AllocateTokens(ctx, 200, votes))
+
+	// the records of the validators are not written
+	_, err = distrKeeper.ValidatorOutstandingRewards.Get(ctx, valAddr0)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	_, err = distrKeeper.ValidatorCurrentRewards.Get(ctx, valAddr1)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	// 2 * 98 rewards are pending
+	pending, err := distrKeeper.PendingRewardAllocations.Get(ctx, valAddr0)
+	require.NoError(t, err)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(49)}}, pending.Commission)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(49)}}, pending.Rewards)
+	pending, err = distrKeeper.PendingRewardAllocations.Get(ctx, valAddr1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.DecCoins(nil), pending.Commission)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(98)}}, pending.Rewards)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(196)}}, distrKeeper.GetTotalRewards(ctx))
+
+	// the queries include the pending rewards without writing them
+	querier := keeper.NewQuerier(distrKeeper)
+	commissionRes, err := querier.ValidatorCommission(ctx, &disttypes.QueryValidatorCommissionRequest{ValidatorAddress: val0.GetOperator()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(49)}}, commissionRes.Commission.Commission)
+
+	outstandingRes, err := querier.ValidatorOutstandingRewards(ctx, &disttypes.QueryValidatorOutstandingRewardsRequest{ValidatorAddress: val0.GetOperator()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(98)}}, outstandingRes.Rewards.Rewards)
+
+	_, err = distrKeeper.ValidatorOutstandingRewards.Get(ctx, valAddr0)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	has, err := distrKeeper.PendingRewardAllocations.Has(ctx, valAddr0)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// flushing a validator only writes its own pending rewards
+	withdrawnCommission := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(49)))
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, gomock.Any(), withdrawnCommission).Return(nil)
+	_, err = distrKeeper.WithdrawValidatorCommission(ctx, valAddr0)
+	require.NoError(t, err)
+
+	val0OutstandingRewards, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, valAddr0)
+	require.NoError(t, err)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(49)}}, val0OutstandingRewards.Rewards)
+
+	has, err = distrKeeper.PendingRewardAllocations.Has(ctx, valAddr0)
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = distrKeeper.PendingRewardAllocations.Has(ctx, valAddr1)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// flush the remaining rewards
+	require.NoError(t, distrKeeper.FlushPendingRewardAllocations(ctx))
+
+	has, err = distrKeeper.PendingRewardAllocations.Has(ctx, valAddr1)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	val1CurrentRewards, err := distrKeeper.ValidatorCurrentRewards.Get(ctx, valAddr1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(98)}}, val1CurrentRewards.Rewards)
+
+	val1OutstandingRewards, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, valAddr1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(98)}}, val1OutstandingRewards.Rewards)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(147)}}, distrKeeper.GetTotalRewards(ctx))
+}
@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/distribution/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetCommissionSplit sets the payout addresses between which the commission of
+// a validator is split on withdrawal, or removes the split if recipients is
+// empty. Like a withdraw address, a split requires withdraw addresses to be
+// enabled and cannot pay out to a blocked address.
+func (k Keeper) SetCommissionSplit(ctx context.Context, valAddr sdk.ValAddress, recipients []types.CommissionSplitRecipient) error {
+	validator, err := k.stakingKeeper.Validator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if validator == nil {
+		return errorsmod.Wrapf(types.ErrNoValidatorExists, "%s", valAddr)
+	}
+
+	if len(recipients) == 0 {
+		return k.CommissionSplits.Remove(ctx, valAddr)
+	}
+
+	withdrawAddrEnabled, err := k.GetWithdrawAddrEnabled(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !withdrawAddrEnabled {
+		return types.ErrSetWithdrawAddrDisabled
+	}
+
+	if err := types.ValidateCommissionSplitRecipients(recipients); err != nil {
+		return err
+	}
+
+	addresses := make([]string, len(recipients))
+	for i, recipient := range recipients {
+		addr, err := k.authKeeper.AddressCodec().StringToBytes(recipient.Address)
+		if err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+		}
+
+		if k.bankKeeper.BlockedAddr(addr) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", recipient.Address)
+		}
+		addresses[i] = recipient.Address
+	}
+
+	split := types.CommissionSplit{ValidatorAddress: validator.GetOperator(), Recipients: recipients}
+	if err := k.CommissionSplits.Set(ctx, valAddr, split); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetCommissionSplit,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.GetOperator()),
+			sdk.NewAttribute(types.AttributeKeyRecipients, strings.Join(addresses, ",")),
+		),
+	)
+
+	return nil
+}
+
+// payoutValidatorCommission sends the withdrawn commission of a validator to
+// the payout addresses of its commission split if it has one, or else to its
+// withdraw address.
+func (k Keeper) payoutValidatorCommission(ctx context.Context, valAddr sdk.ValAddress, commission sdk.Coins) error {
+	split, err := k.CommissionSplits.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, sdk.AccAddress(valAddr))
+		if err != nil {
+			return err
+		}
+
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, commission)
+	} else if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for i, amount := range split.Split(commission) {
+		if amount.IsZero() {
+			continue
+		}
+
+		recipient := split.Recipients[i].Address
+		addr, err := k.authKeeper.AddressCodec().StringToBytes(recipient)
+		if err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, amount); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCommissionPayout,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, split.ValidatorAddress),
+				sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+			),
+		)
+	}
+
+	return nil
+}
+
+// GetAllCommissionSplits returns the commission splits of all the validators.
+func (k Keeper) GetAllCommissionSplits(ctx context.Context) ([]types.CommissionSplit, error) {
+	var splits []types.CommissionSplit
+	err := k.CommissionSplits.Walk(ctx, nil, func(_ sdk.ValAddress, value types.CommissionSplit) (bool, error) {
+		splits = append(splits, value)
+		return false, nil
+	})
+
+	return splits, err
+}
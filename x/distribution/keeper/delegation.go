@@ -10,6 +10,7 @@ import (
 	"cosmossdk.io/x/distribution/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 // initialize starting info for a new delegation
@@ -200,7 +201,9 @@ func (k Keeper) CalculateDelegationRewards(ctx context.Context, val sdk.Validato
 	return rewards, nil
 }
 
-func (k Keeper) withdrawDelegationRewards(ctx context.Context, val sdk.ValidatorI, del sdk.DelegationI) (sdk.Coins, error) {
+// withdrawDelegationRewards withdraws the rewards of a delegation to the given
+// address, or to the withdraw address of the delegator if it is nil.
+func (k Keeper) withdrawDelegationRewards(ctx context.Context, val sdk.ValidatorI, del sdk.DelegationI, withdrawAddr sdk.AccAddress) (sdk.Coins, error) {
 	addrCodec := k.authKeeper.AddressCodec()
 	delAddr, err := addrCodec.StringToBytes(del.GetDelegatorAddr())
 	if err != nil {
@@ -252,18 +255,39 @@ func (k Keeper) withdrawDelegationRewards(ctx context.Context, val sdk.Validator
 		)
 	}
 
-	// truncate reward dec coins, return remainder to decimal pool
-	finalRewards, remainder := rewards.TruncateDecimal()
+	// round reward dec coins following the rounding policy, the rounded
+	// fractions going to or coming from the decimal pool
+	feePool, err := k.FeePool.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	finalRewards, decimalPool, err := k.roundRewards(ctx, sdk.ValAddress(valAddr), rewards, feePool.DecimalPool)
+	if err != nil {
+		return nil, err
+	}
 
 	// add coins to user account
 	if !finalRewards.IsZero() {
-		withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+		if withdrawAddr == nil {
+			withdrawAddr, err = k.GetDelegatorWithdrawAddr(ctx, delAddr)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// the community pool account is blocked from receiving funds, so the rewards
+		// of the community pool delegations are sent to it module to module
+		if withdrawAddr.Equals(sdk.AccAddress(address.Module(types.ProtocolPoolModuleName))) {
+			err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.ProtocolPoolModuleName, finalRewards)
+		} else {
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, finalRewards)
+		}
 		if err != nil {
 			return nil, err
 		}
 
-		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, finalRewards)
-		if err != nil {
+		if err := k.recordRewardEvent(ctx, delAddr, withdrawAddr, del.GetValidatorAddr(), finalRewards); err != nil {
 			return nil, err
 		}
 	}
@@ -273,12 +297,7 @@ func (k Keeper) withdrawDelegationRewards(ctx context.Context, val sdk.Validator
 		return nil, err
 	}
 
-	feePool, err := k.FeePool.Get(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	feePool.DecimalPool = feePool.DecimalPool.Add(remainder...)
+	feePool.DecimalPool = decimalPool
 	err = k.FeePool.Set(ctx, feePool)
 	if err != nil {
 		return nil, err
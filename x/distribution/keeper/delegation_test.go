@@ -18,6 +18,7 @@ import (
 	stakingtypes "cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -231,6 +232,229 @@ func TestCalculateRewardsAfterSlash(t *testing.T) {
 		valCommission.Commission)
 }
 
+func TestPruneHistoricalRewards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModule{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+
+	valAddr := sdk.ValAddress(valConsAddr0)
+	addr := sdk.AccAddress(valAddr)
+	valPower := int64(100)
+	stake := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	val, err := distrtestutil.CreateValidator(valConsPk0, stake)
+	require.NoError(t, err)
+	val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
+
+	del := stakingtypes.NewDelegation(addr.String(), valAddr.String(), val.DelegatorShares)
+
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del, nil).AnyTimes()
+
+	err = distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr)
+	require.NoError(t, err)
+
+	// slash the validator twice
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 3)
+	for i := 0; i < 2; i++ {
+		distrtestutil.SlashValidator(ctx, valConsAddr0, ctx.BlockHeight(), valPower, math.LegacyNewDecWithPrec(1, 1), &val, &distrKeeper, stakingKeeper)
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	}
+
+	// the delegation started before the slashes, which are all needed
+	stats, err := distrKeeper.GetValidatorHistoricalRewardsStats(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, disttypes.ValidatorHistoricalRewardsStats{
+		ValidatorAddress:    valAddr.String(),
+		HistoricalRewards:   3,
+		ReferenceCount:      4,
+		SlashEvents:         2,
+		PrunableSlashEvents: 0,
+	}, stats)
+
+	pruned, err := distrKeeper.PruneHistoricalRewards(ctx, keeper.HistoricalRewardsPruningLimit)
+	require.NoError(t, err)
+	require.Zero(t, pruned)
+
+	// withdrawing the rewards restarts the delegation after the slashes
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil)
+	_, err = distrKeeper.WithdrawDelegationRewards(ctx, addr, valAddr)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	stats, err = distrKeeper.GetValidatorHistoricalRewardsStats(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), stats.PrunableSlashEvents)
+
+	// the records are visited one by one, the next pass resuming the validator
+	pruned, err = distrKeeper.PruneHistoricalRewards(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), pruned)
+	cursor, err := distrKeeper.HistoricalRewardsPruningCursor.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, valAddr, cursor)
+
+	pruned, err = distrKeeper.PruneHistoricalRewards(ctx, keeper.HistoricalRewardsPruningLimit)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), pruned)
+	_, err = distrKeeper.HistoricalRewardsPruningCursor.Get(ctx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	// only the record referenced by both the validator and the delegation is left
+	stats, err = distrKeeper.GetValidatorHistoricalRewardsStats(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, disttypes.ValidatorHistoricalRewardsStats{
+		ValidatorAddress:  valAddr.String(),
+		HistoricalRewards: 1,
+		ReferenceCount:    2,
+	}, stats)
+
+	count, err := distrKeeper.HistoricalRewardsCount.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), count)
+}
+
+func TestPruneHistoricalRewardsKeepsReferencedRecords(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModule{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+
+	valAddr := sdk.ValAddress(valConsAddr0)
+	addr := sdk.AccAddress(valAddr)
+	addr2 := sdk.AccAddress(valConsAddr1)
+	valPower := int64(100)
+	stake := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	val, err := distrtestutil.CreateValidator(valConsPk0, stake)
+	require.NoError(t, err)
+	val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
+
+	del := stakingtypes.NewDelegation(addr.String(), valAddr.String(), val.DelegatorShares)
+	del2 := stakingtypes.NewDelegation(addr2.String(), valAddr.String(), val.DelegatorShares)
+
+	stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del, nil).AnyTimes()
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), addr2, valAddr).Return(del2, nil).AnyTimes()
+
+	require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr))
+
+	// a second delegation starts between two slashes
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 3)
+	distrtestutil.SlashValidator(ctx, valConsAddr0, ctx.BlockHeight(), valPower, math.LegacyNewDecWithPrec(1, 1), &val, &distrKeeper, stakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.NoError(t, distrKeeper.Hooks().BeforeDelegationCreated(ctx, addr2, valAddr))
+	require.NoError(t, distrKeeper.Hooks().AfterDelegationModified(ctx, addr2, valAddr))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	distrtestutil.SlashValidator(ctx, valConsAddr0, ctx.BlockHeight(), valPower, math.LegacyNewDecWithPrec(1, 1), &val, &distrKeeper, stakingKeeper)
+
+	// withdrawing the rewards of the first delegation restarts it after the slashes
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil)
+	_, err = distrKeeper.WithdrawDelegationRewards(ctx, addr, valAddr)
+	require.NoError(t, err)
+
+	startingInfo, err := distrKeeper.DelegatorStartingInfo.Get(ctx, collections.Join(valAddr, addr2))
+	require.NoError(t, err)
+	secondSlash := startingInfo.PreviousPeriod + 1
+
+	stats, err := distrKeeper.GetValidatorHistoricalRewardsStats(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), stats.HistoricalRewards)
+	require.Equal(t, uint64(2), stats.SlashEvents)
+	require.Equal(t, uint64(1), stats.PrunableSlashEvents)
+
+	// only the first slash, before the second delegation started, is pruned
+	pruned, err := distrKeeper.PruneHistoricalRewards(ctx, keeper.HistoricalRewardsPruningLimit)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), pruned)
+
+	// the records referenced by the second delegation and the second slash survive
+	for _, period := range []uint64{startingInfo.PreviousPeriod, secondSlash} {
+		historical, err := distrKeeper.ValidatorHistoricalRewards.Get(ctx, collections.Join(valAddr, period))
+		require.NoError(t, err)
+		require.Equal(t, uint32(1), historical.ReferenceCount)
+	}
+	has, err := distrKeeper.ValidatorHistoricalRewards.Has(ctx, collections.Join(valAddr, startingInfo.PreviousPeriod-1))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	stats, err = distrKeeper.GetValidatorHistoricalRewardsStats(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, disttypes.ValidatorHistoricalRewardsStats{
+		ValidatorAddress:  valAddr.String(),
+		HistoricalRewards: 3,
+		ReferenceCount:    4,
+		SlashEvents:       1,
+	}, stats)
+
+	// nothing else can be pruned while the second delegation is not restarted
+	pruned, err = distrKeeper.PruneHistoricalRewards(ctx, keeper.HistoricalRewardsPruningLimit)
+	require.NoError(t, err)
+	require.Zero(t, pruned)
+
+	// the second delegation still depends on the second slash
+	err = distrKeeper.ValidatorSlashEvents.Walk(ctx, nil, func(key collections.Triple[sdk.ValAddress, uint64, uint64], _ disttypes.ValidatorSlashEvent) (bool, error) {
+		require.Equal(t, secondSlash, key.K3())
+		return false, nil
+	})
+	require.NoError(t, err)
+
+	count, err := distrKeeper.HistoricalRewardsCount.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), count)
+}
+
 func TestCalculateRewardsAfterManySlashes(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
@@ -486,7 +710,9 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 
 	// reset fee pool
 	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
-	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+	params := disttypes.DefaultParams()
+	params.RewardEventsEnabled = true
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
 
 	// create validator with 50% commission
 	valAddr := sdk.ValAddress(valConsAddr0)
@@ -526,6 +752,16 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 	// historical count should still be 2 (added one record, cleared one)
 	require.Equal(t, 2, getValHistoricalReferenceCount(distrKeeper, ctx))
 
+	// the withdrawal is recorded in the reward events log
+	events, err := distrKeeper.GetAllRewardEvents(ctx)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, addr.String(), events[0].DelegatorAddress)
+	require.Equal(t, valAddr.String(), events[0].ValidatorAddress)
+	require.Equal(t, addr.String(), events[0].WithdrawAddress)
+	require.Equal(t, expRewards, events[0].Amount)
+	require.Equal(t, int64(1), events[0].Height)
+
 	// withdraw commission (the bank keeper should be called with the right amount of tokens to transfer)
 	expCommission := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))}
 	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, disttypes.ModuleName, addr, expCommission)
@@ -533,6 +769,88 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestWithdrawAllDelegationRewards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModule{})
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+	poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		poolKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// reset fee pool
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+	require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+
+	// a delegator delegating to two validators with 50% commission
+	delAddr := sdk.AccAddress(valConsAddr0)
+	var (
+		validators  []stakingtypes.Validator
+		delegations []stakingtypes.Delegation
+	)
+	for _, pk := range []cryptotypes.PubKey{valConsPk0, valConsPk1} {
+		valAddr := sdk.ValAddress(pk.Address())
+		val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+		require.NoError(t, err)
+		val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
+
+		del := stakingtypes.NewDelegation(delAddr.String(), valAddr.String(), val.DelegatorShares)
+		stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+		stakingKeeper.EXPECT().Delegation(gomock.Any(), delAddr, valAddr).Return(del, nil).AnyTimes()
+		validators = append(validators, val)
+		delegations = append(delegations, del)
+
+		require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, delAddr, valAddr))
+	}
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards
+	for _, val := range validators {
+		require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, math.NewInt(1000))}))
+	}
+	stakingKeeper.EXPECT().GetAllDelegatorDelegations(gomock.Any(), delAddr).Return(delegations, nil).AnyTimes()
+
+	// the rewards of both delegations are sent to the destination address
+	destAddr := sdk.AccAddress(valConsAddr1)
+	expRewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500))
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), disttypes.ModuleName, destAddr, expRewards).Times(2)
+
+	gasMeter := storetypes.NewGasMeter(10 * disttypes.WithdrawAllRewardsGasPerValidator)
+	rewards, err := distrKeeper.WithdrawAllDelegationRewards(ctx.WithGasMeter(gasMeter), delAddr, destAddr)
+	require.NoError(t, err)
+	require.Equal(t, expRewards.Add(expRewards...), rewards)
+
+	// the gas of the withdrawals is charged to the gas meter of the context
+	require.Positive(t, gasMeter.GasConsumed())
+	require.LessOrEqual(t, gasMeter.GasConsumed(), 2*disttypes.WithdrawAllRewardsGasPerValidator)
+
+	// no delegations
+	stakingKeeper.EXPECT().GetAllDelegatorDelegations(gomock.Any(), destAddr).Return(nil, nil)
+	_, err = distrKeeper.WithdrawAllDelegationRewards(ctx, destAddr, nil)
+	require.ErrorIs(t, err, disttypes.ErrNoDelegationExists)
+}
+
 func TestCalculateRewardsAfterManySlashesInSameBlock(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	key := storetypes.NewKVStoreKey(disttypes.StoreKey)
@@ -1084,3 +1402,110 @@ func Test100PercentCommissionReward(t *testing.T) {
 	}
 	require.True(t, hasValue)
 }
+
+func TestWithdrawDelegationRewardsRoundingPolicy(t *testing.T) {
+	testCases := []struct {
+		name           string
+		policy         disttypes.RoundingPolicy
+		decimalPool    sdk.DecCoins
+		expRewards     math.Int
+		expTruncated   sdk.DecCoins
+		expRoundedUp   sdk.DecCoins
+		expDecimalPool sdk.DecCoins
+	}{
+		{
+			name:           "truncate to pool",
+			policy:         disttypes.RoundingPolicyTruncateToPool,
+			decimalPool:    sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, math.OneInt())),
+			expRewards:     math.NewInt(11),
+			expTruncated:   sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDecWithPrec(5, 1))),
+			expDecimalPool: sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDecWithPrec(15, 1))),
+		},
+		{
+			name:           "bankers rounding up from the decimal pool",
+			policy:         disttypes.RoundingPolicyBankers,
+			decimalPool:    sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, math.OneInt())),
+			expRewards:     math.NewInt(12),
+			expRoundedUp:   sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDecWithPrec(5, 1))),
+			expDecimalPool: sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDecWithPrec(5, 1))),
+		},
+		{
+			name:           "bankers rounding not covered by the decimal pool",
+			policy:         disttypes.RoundingPolicyBankers,
+			expRewards:     math.NewInt(11),
+			expTruncated:   sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDecWithPrec(5, 1))),
+			expDecimalPool: sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDecWithPrec(5, 1))),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			storeService := runtime.NewKVStoreService(key)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModule{})
+			ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Height: 1})
+
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+			poolKeeper := distrtestutil.NewMockPoolKeeper(ctrl)
+
+			accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+			stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec(sdk.Bech32PrefixValAddr)).AnyTimes()
+			accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec(sdk.Bech32MainPrefix)).AnyTimes()
+
+			distrKeeper := keeper.NewKeeper(
+				encCfg.Codec,
+				storeService,
+				accountKeeper,
+				bankKeeper,
+				stakingKeeper,
+				poolKeeper,
+				"fee_collector",
+				authtypes.NewModuleAddress("gov").String(),
+			)
+
+			require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.FeePool{DecimalPool: tc.decimalPool}))
+			params := disttypes.DefaultParams()
+			params.RoundingPolicy = tc.policy
+			require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+			// create validator with no commission
+			valAddr := sdk.ValAddress(valConsAddr0)
+			addr := sdk.AccAddress(valAddr)
+			val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+			require.NoError(t, err)
+
+			del := stakingtypes.NewDelegation(addr.String(), valAddr.String(), val.DelegatorShares)
+			stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+			stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del, nil).AnyTimes()
+
+			require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr))
+
+			// next block
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+			// allocate 11.5 stake of rewards to the delegator
+			tokens := sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDecWithPrec(115, 1))}
+			require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, tokens))
+
+			expRewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, tc.expRewards))
+			bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, disttypes.ModuleName, addr, expRewards)
+			rewards, err := distrKeeper.WithdrawDelegationRewards(ctx, addr, valAddr)
+			require.NoError(t, err)
+			require.Equal(t, expRewards, rewards)
+
+			feePool, err := distrKeeper.FeePool.Get(ctx)
+			require.NoError(t, err)
+			require.Equal(t, tc.expDecimalPool, feePool.DecimalPool)
+
+			dust, err := distrKeeper.GetTruncationDust(ctx, valAddr)
+			require.NoError(t, err)
+			require.Equal(t, valAddr.String(), dust.ValidatorAddress)
+			require.Equal(t, tc.expTruncated, dust.Truncated)
+			require.Equal(t, tc.expRoundedUp, dust.RoundedUp)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/distribution/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// AddExternalRewards adds rewards from an external source, such as a MEV
+// auction or bridge fees, to the current period of a validator. The rewards are
+// sent by the sender to the distribution module account and allocated to the
+// validator as the block rewards are, splitting them with its commission. The
+// total added by each source is recorded per validator, so that it can be
+// queried, and the source is attributed in the emitted event.
+func (k Keeper) AddExternalRewards(ctx context.Context, sender sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coins, source string) error {
+	if err := types.ValidateExternalRewardSource(source); err != nil {
+		return err
+	}
+
+	if !amount.IsValid() || amount.IsZero() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid external rewards amount: %s", amount)
+	}
+
+	validator, err := k.stakingKeeper.Validator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if validator == nil {
+		return errorsmod.Wrapf(types.ErrNoValidatorExists, "%s", valAddr)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	if err := k.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoinsFromCoins(amount...)); err != nil {
+		return err
+	}
+
+	key := collections.Join(valAddr, source)
+	rewards, err := k.ExternalRewards.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		rewards = types.ExternalRewards{ValidatorAddress: validator.GetOperator(), Source: source}
+	} else if err != nil {
+		return err
+	}
+
+	rewards.Amount = rewards.Amount.Add(amount...)
+	if err := k.ExternalRewards.Set(ctx, key, rewards); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExternalRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.GetOperator()),
+			sdk.NewAttribute(types.AttributeKeySource, source),
+		),
+	)
+
+	return nil
+}
+
+// GetAllExternalRewards returns the rewards added by the external reward
+// sources to all the validators.
+func (k Keeper) GetAllExternalRewards(ctx context.Context) ([]types.ExternalRewards, error) {
+	var rewards []types.ExternalRewards
+	err := k.ExternalRewards.Walk(ctx, nil, func(_ collections.Pair[sdk.ValAddress, string], value types.ExternalRewards) (bool, error) {
+		rewards = append(rewards, value)
+		return false, nil
+	})
+
+	return rewards, err
+}
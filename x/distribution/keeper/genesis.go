@@ -81,6 +81,9 @@ func (k Keeper) InitGenesis(ctx context.Context, data types.GenesisState) {
 			panic(err)
 		}
 	}
+	if err := k.HistoricalRewardsCount.Set(ctx, uint64(len(data.ValidatorHistoricalRewards))); err != nil {
+		panic(err)
+	}
 	for _, cur := range data.ValidatorCurrentRewards {
 		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(cur.ValidatorAddress)
 		if err != nil {
@@ -127,6 +130,59 @@ func (k Keeper) InitGenesis(ctx context.Context, data types.GenesisState) {
 		}
 	}
 
+	var nextRewardEventID uint64
+	for _, event := range data.RewardEvents {
+		delAddr, err := k.authKeeper.AddressCodec().StringToBytes(event.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.RewardEvents.Set(ctx, collections.Join(sdk.AccAddress(delAddr), event.Id), event); err != nil {
+			panic(err)
+		}
+
+		if event.Id >= nextRewardEventID {
+			nextRewardEventID = event.Id + 1
+		}
+	}
+
+	if err := k.RewardEventSequence.Set(ctx, nextRewardEventID); err != nil {
+		panic(err)
+	}
+
+	for _, rewards := range data.ExternalRewards {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(rewards.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.ExternalRewards.Set(ctx, collections.Join(sdk.ValAddress(valAddr), rewards.Source), rewards); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, split := range data.CommissionSplits {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(split.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.CommissionSplits.Set(ctx, valAddr, split); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, dust := range data.TruncationDust {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(dust.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.TruncationDust.Set(ctx, valAddr, dust); err != nil {
+			panic(err)
+		}
+	}
+
 	moduleHoldings = moduleHoldings.Add(data.FeePool.DecimalPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
 
@@ -147,6 +203,13 @@ func (k Keeper) InitGenesis(ctx context.Context, data types.GenesisState) {
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
+	// the pending reward allocations are exported as part of the records of
+	// the validators, without writing them to the store
+	ctx, _ = sdk.UnwrapSDKContext(ctx).CacheContext()
+	if err := k.FlushPendingRewardAllocations(ctx); err != nil {
+		panic(err)
+	}
+
 	feePool, err := k.FeePool.Get(ctx)
 	if err != nil {
 		panic(err)
@@ -261,5 +324,33 @@ func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		panic(err)
 	}
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	rewardEvents, err := k.GetAllRewardEvents(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	gs := types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	gs.RewardEvents = rewardEvents
+
+	externalRewards, err := k.GetAllExternalRewards(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	gs.ExternalRewards = externalRewards
+
+	commissionSplits, err := k.GetAllCommissionSplits(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	gs.CommissionSplits = commissionSplits
+
+	truncationDust, err := k.GetAllTruncationDust(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	gs.TruncationDust = truncationDust
+	return gs
 }
@@ -122,6 +122,13 @@ func (k Querier) ValidatorOutstandingRewards(ctx context.Context, req *types.Que
 		return nil, err
 	}
 
+	// include the rewards not yet written to the records of the validator
+	pending, err := k.getPendingRewardAllocation(ctx, valAdr)
+	if err != nil {
+		return nil, err
+	}
+	rewards.Rewards = rewards.Rewards.Add(pending.Commission...).Add(pending.Rewards...)
+
 	return &types.QueryValidatorOutstandingRewardsResponse{Rewards: rewards}, nil
 }
 
@@ -148,11 +155,19 @@ func (k Querier) ValidatorCommission(ctx context.Context, req *types.QueryValida
 	if validator == nil {
 		return nil, errors.Wrapf(types.ErrNoValidatorExists, req.ValidatorAddress)
 	}
+
 	commission, err := k.ValidatorsAccumulatedCommission.Get(ctx, valAdr)
 	if err != nil && !errors.IsOf(err, collections.ErrNotFound) {
 		return nil, err
 	}
 
+	// include the commission not yet written to the records of the validator
+	pending, err := k.getPendingRewardAllocation(ctx, valAdr)
+	if err != nil {
+		return nil, err
+	}
+	commission.Commission = commission.Commission.Add(pending.Commission...)
+
 	return &types.QueryValidatorCommissionResponse{Commission: commission}, nil
 }
 
@@ -307,6 +322,71 @@ func (k Querier) DelegationTotalRewards(ctx context.Context, req *types.QueryDel
 	return &types.QueryDelegationTotalRewardsResponse{Rewards: delRewards, Total: total}, nil
 }
 
+// ProjectedRewards queries the estimated annual rewards of the delegations of a
+// delegator, per validator
+func (k Querier) ProjectedRewards(ctx context.Context, req *types.QueryProjectedRewardsRequest) (*types.QueryProjectedRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	if k.mintKeeper == nil {
+		return nil, status.Error(codes.Unavailable, "reward projections require the mint keeper")
+	}
+
+	delAdr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	rewardsPerToken, err := k.annualRewardsPerBondedToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	total := sdk.DecCoins{}
+	rewards := []types.ProjectedReward{}
+
+	var iterErr error
+	err = k.stakingKeeper.IterateDelegations(
+		ctx, delAdr,
+		func(_ int64, del sdk.DelegationI) (stop bool) {
+			valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(del.GetValidatorAddr())
+			if err != nil {
+				iterErr = err
+				return true
+			}
+
+			val, err := k.stakingKeeper.Validator(ctx, valAddr)
+			if err != nil {
+				iterErr = err
+				return true
+			}
+
+			reward := projectDelegationRewards(val, del, rewardsPerToken, bondDenom)
+			rewards = append(rewards, reward)
+			total = total.Add(reward.AnnualRewards...)
+			return false
+		},
+	)
+	if iterErr != nil {
+		return nil, iterErr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryProjectedRewardsResponse{Rewards: rewards, Total: total}, nil
+}
+
 // DelegatorValidators queries the validators list of a delegator
 func (k Querier) DelegatorValidators(ctx context.Context, req *types.QueryDelegatorValidatorsRequest) (*types.QueryDelegatorValidatorsResponse, error) {
 	if req == nil {
@@ -360,6 +440,77 @@ func (k Querier) DelegatorWithdrawAddress(ctx context.Context, req *types.QueryD
 	return &types.QueryDelegatorWithdrawAddressResponse{WithdrawAddress: withdrawAddr.String()}, nil
 }
 
+// ValidatorExternalRewards queries the rewards added to a validator by the external reward sources
+func (k Querier) ValidatorExternalRewards(ctx context.Context, req *types.QueryValidatorExternalRewardsRequest) (*types.QueryValidatorExternalRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address")
+	}
+
+	rewards, pageRes, err := query.CollectionPaginate(ctx, k.ExternalRewards, req.Pagination,
+		func(_ collections.Pair[sdk.ValAddress, string], value types.ExternalRewards) (types.ExternalRewards, error) {
+			return value, nil
+		}, query.WithCollectionPaginationPairPrefix[sdk.ValAddress, string](valAddr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorExternalRewardsResponse{Rewards: rewards, Pagination: pageRes}, nil
+}
+
+// RewardEventsExport exports the reward withdrawal history of a delegator
+func (k Querier) RewardEventsExport(ctx context.Context, req *types.QueryRewardEventsExportRequest) (*types.QueryRewardEventsExportResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	if req.EndingHeight != 0 && req.EndingHeight < req.StartingHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "starting height greater than ending height (%d > %d)", req.StartingHeight, req.EndingHeight)
+	}
+
+	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid delegator address")
+	}
+
+	events, pageRes, err := query.CollectionFilteredPaginate(ctx, k.RewardEvents, req.Pagination, func(_ collections.Pair[sdk.AccAddress, uint64], event types.RewardEvent) (include bool, err error) {
+		if event.Height < req.StartingHeight || (req.EndingHeight != 0 && event.Height > req.EndingHeight) {
+			return false, nil
+		}
+		return true, nil
+	}, func(_ collections.Pair[sdk.AccAddress, uint64], value types.RewardEvent) (types.RewardEvent, error) {
+		return value, nil
+	},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](delAddr),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Format == types.RewardEventsExportFormat_REWARD_EVENTS_EXPORT_FORMAT_CSV {
+		csv, err := types.RewardEventsCSV(events)
+		if err != nil {
+			return nil, err
+		}
+		return &types.QueryRewardEventsExportResponse{Csv: csv, Pagination: pageRes}, nil
+	}
+
+	return &types.QueryRewardEventsExportResponse{Events: events, Pagination: pageRes}, nil
+}
+
 // Deprecated: DO NOT USE
 // This method uses deprecated query request. Use CommunityPool from x/protocolpool module instead.
 // CommunityPool queries the community pool coins
@@ -370,3 +521,91 @@ func (k Querier) CommunityPool(ctx context.Context, req *types.QueryCommunityPoo
 	}
 	return &types.QueryCommunityPoolResponse{Pool: sdk.NewDecCoinsFromCoins(pool...)}, nil
 }
+
+// CommissionSplit queries the payout addresses between which the commission of
+// a validator is split on withdrawal
+func (k Querier) CommissionSplit(ctx context.Context, req *types.QueryCommissionSplitRequest) (*types.QueryCommissionSplitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address")
+	}
+
+	split, err := k.CommissionSplits.Get(ctx, valAddr)
+	if err != nil && !errors.IsOf(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCommissionSplitResponse{Recipients: split.Recipients}, nil
+}
+
+// TruncationDust queries the cumulative fractions of coins by which the rewards
+// paid out for a validator were rounded
+func (k Querier) TruncationDust(ctx context.Context, req *types.QueryTruncationDustRequest) (*types.QueryTruncationDustResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator address")
+	}
+
+	dust, err := k.GetTruncationDust(ctx, valAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTruncationDustResponse{Dust: dust}, nil
+}
+
+// HistoricalRewardsStats queries the number of historical rewards records and
+// slash events stored per validator
+func (k Querier) HistoricalRewardsStats(ctx context.Context, req *types.QueryHistoricalRewardsStatsRequest) (*types.QueryHistoricalRewardsStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress != "" {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid validator address")
+		}
+
+		if _, err := k.ValidatorCurrentRewards.Get(ctx, valAddr); err != nil {
+			if errors.IsOf(err, collections.ErrNotFound) {
+				return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddress)
+			}
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		stats, err := k.GetValidatorHistoricalRewardsStats(ctx, valAddr)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		return &types.QueryHistoricalRewardsStatsResponse{Stats: []types.ValidatorHistoricalRewardsStats{stats}}, nil
+	}
+
+	stats, pageRes, err := query.CollectionPaginate(ctx, k.ValidatorCurrentRewards, req.Pagination,
+		func(valAddr sdk.ValAddress, _ types.ValidatorCurrentRewards) (types.ValidatorHistoricalRewardsStats, error) {
+			return k.GetValidatorHistoricalRewardsStats(ctx, valAddr)
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryHistoricalRewardsStatsResponse{Stats: stats, Pagination: pageRes}, nil
+}
@@ -1,11 +1,14 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/distribution/keeper"
 	distrtestutil "cosmossdk.io/x/distribution/testutil"
@@ -177,3 +180,110 @@ func TestQueryCommunityPool(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryRewardEventsExport(t *testing.T) {
+	ctx, addrs, distrKeeper, _ := initFixture(t)
+	queryServer := keeper.NewQuerier(distrKeeper)
+
+	valAddr := sdk.ValAddress(valConsAddr0).String()
+	for i, height := range []int64{10, 20, 30} {
+		event := types.RewardEvent{
+			Id:               uint64(i),
+			DelegatorAddress: addrs[0].String(),
+			ValidatorAddress: valAddr,
+			WithdrawAddress:  addrs[0].String(),
+			Amount:           sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("uatom", 5)),
+			Height:           height,
+			Time:             time.Date(2024, 1, 1, 0, 0, i, 0, time.UTC),
+		}
+		require.NoError(t, distrKeeper.RewardEvents.Set(ctx, collections.Join(addrs[0], event.Id), event))
+	}
+
+	_, err := queryServer.RewardEventsExport(ctx, &types.QueryRewardEventsExportRequest{})
+	require.ErrorContains(t, err, "empty delegator address")
+
+	_, err = queryServer.RewardEventsExport(ctx, &types.QueryRewardEventsExportRequest{DelegatorAddress: addrs[0].String(), StartingHeight: 20, EndingHeight: 10})
+	require.ErrorContains(t, err, "starting height greater than ending height")
+
+	res, err := queryServer.RewardEventsExport(ctx, &types.QueryRewardEventsExportRequest{DelegatorAddress: addrs[1].String()})
+	require.NoError(t, err)
+	require.Empty(t, res.Events)
+
+	res, err = queryServer.RewardEventsExport(ctx, &types.QueryRewardEventsExportRequest{DelegatorAddress: addrs[0].String(), StartingHeight: 20})
+	require.NoError(t, err)
+	require.Len(t, res.Events, 2)
+	require.Equal(t, int64(20), res.Events[0].Height)
+	require.Empty(t, res.Csv)
+
+	res, err = queryServer.RewardEventsExport(ctx, &types.QueryRewardEventsExportRequest{
+		DelegatorAddress: addrs[0].String(),
+		EndingHeight:     10,
+		Format:           types.RewardEventsExportFormat_REWARD_EVENTS_EXPORT_FORMAT_CSV,
+	})
+	require.NoError(t, err)
+	require.Empty(t, res.Events)
+	require.Equal(t, "id,height,time,delegator_address,validator_address,withdraw_address,denom,amount\n"+
+		"0,10,2024-01-01T00:00:00Z,"+addrs[0].String()+","+valAddr+","+addrs[0].String()+",stake,100\n"+
+		"0,10,2024-01-01T00:00:00Z,"+addrs[0].String()+","+valAddr+","+addrs[0].String()+",uatom,5\n", res.Csv)
+}
+
+func TestQueryProjectedRewards(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	queryServer := keeper.NewQuerier(distrKeeper)
+
+	req := &types.QueryProjectedRewardsRequest{DelegatorAddress: addrs[0].String()}
+	_, err := queryServer.ProjectedRewards(ctx, &types.QueryProjectedRewardsRequest{})
+	require.ErrorContains(t, err, "empty delegator address")
+	_, err = queryServer.ProjectedRewards(ctx, req)
+	require.ErrorContains(t, err, "reward projections require the mint keeper")
+
+	mintKeeper := distrtestutil.NewMockMintKeeper(gomock.NewController(t))
+	distrKeeper.SetMintKeeper(mintKeeper)
+	queryServer = keeper.NewQuerier(distrKeeper)
+
+	// a bonded validator with a 10% commission and an unbonded one
+	bonded, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(1000))
+	require.NoError(t, err)
+	bonded.Status = stakingtypes.Bonded
+	bonded.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(1, 1), math.LegacyOneDec(), math.LegacyZeroDec())
+	unbonded, err := distrtestutil.CreateValidator(valConsPk1, math.NewInt(1000))
+	require.NoError(t, err)
+
+	delegations := []stakingtypes.Delegation{
+		stakingtypes.NewDelegation(addrs[0].String(), bonded.OperatorAddress, math.LegacyNewDec(100)),
+		stakingtypes.NewDelegation(addrs[0].String(), unbonded.OperatorAddress, math.LegacyNewDec(50)),
+	}
+
+	mintKeeper.EXPECT().AnnualProvisions(gomock.Any()).Return(sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 1000)), nil)
+	dep.stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(math.NewInt(10000), nil)
+	dep.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil)
+	dep.stakingKeeper.EXPECT().IterateDelegations(gomock.Any(), addrs[0], gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ sdk.AccAddress, fn func(int64, sdk.DelegationI) bool) error {
+			for i, del := range delegations {
+				if fn(int64(i), del) {
+					break
+				}
+			}
+			return nil
+		},
+	)
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), sdk.ValAddress(valConsAddr0)).Return(bonded, nil)
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), sdk.ValAddress(valConsAddr1)).Return(unbonded, nil)
+
+	res, err := queryServer.ProjectedRewards(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, res.Rewards, 2)
+
+	// 1000 provisions, less the 2% community tax, split among 10000 bonded
+	// tokens, less the 10% commission, for 100 tokens
+	expected := sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyMustNewDecFromStr("8.82")))
+	require.Equal(t, bonded.OperatorAddress, res.Rewards[0].ValidatorAddress)
+	require.Equal(t, expected, res.Rewards[0].AnnualRewards)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.0882"), res.Rewards[0].Apr)
+
+	require.Equal(t, unbonded.OperatorAddress, res.Rewards[1].ValidatorAddress)
+	require.True(t, res.Rewards[1].AnnualRewards.IsZero())
+	require.True(t, res.Rewards[1].Apr.IsZero())
+
+	require.Equal(t, expected, res.Total)
+}
@@ -0,0 +1,339 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/distribution/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HistoricalRewardsPruningLimit is the maximum number of historical rewards
+// records visited by a pruning pass, the next pass resuming where it stopped.
+const HistoricalRewardsPruningLimit = 100
+
+// historicalRewardsCompaction holds what can be compacted in the historical
+// rewards records of a validator.
+type historicalRewardsCompaction struct {
+	// slashEvents are the slash events no delegation depends on anymore
+	slashEvents []collections.Triple[sdk.ValAddress, uint64, uint64]
+	// unreferenced are the periods of the records left without any reference
+	unreferenced []uint64
+	// visited is the number of records visited
+	visited uint64
+	// done is whether all the records which can be compacted were visited
+	done bool
+}
+
+// compactableHistoricalRewards visits, in order and up to limit if not zero,
+// the historical rewards records of a validator which can be compacted. A
+// record is referenced by the validator for its last period, by the
+// delegations starting from it and by the slash events ending it. The rewards
+// of a delegation only depend on the slash events ending a later period than
+// its starting one, so the slash events up to the first period a delegation or
+// the validator references are not needed anymore. The records before that
+// period are only referenced by these slash events, or by nothing, and are
+// removed along with them, while the referenced records are kept.
+func (k Keeper) compactableHistoricalRewards(
+	ctx context.Context, valAddr sdk.ValAddress, limit uint64,
+) (compaction historicalRewardsCompaction, err error) {
+	current, err := k.ValidatorCurrentRewards.Get(ctx, valAddr)
+	if err != nil {
+		return compaction, err
+	}
+
+	type record struct {
+		period         uint64
+		referenceCount uint32
+	}
+	var records []record
+	compaction.done = true
+	err = k.ValidatorHistoricalRewards.Walk(ctx, collections.NewPrefixedPairRange[sdk.ValAddress, uint64](valAddr),
+		func(key collections.Pair[sdk.ValAddress, uint64], rewards types.ValidatorHistoricalRewards) (stop bool, err error) {
+			if limit != 0 && uint64(len(records)) == limit {
+				compaction.done = false
+				return true, nil
+			}
+			records = append(records, record{period: key.K2(), referenceCount: rewards.ReferenceCount})
+			// the record referenced by the validator is never compacted
+			return key.K2()+1 >= current.Period, nil
+		},
+	)
+	if err != nil || len(records) == 0 {
+		return compaction, err
+	}
+
+	// the slash events are ordered by height, and so by period
+	lastPeriod := records[len(records)-1].period
+	slashEvents := make(map[uint64][]collections.Triple[sdk.ValAddress, uint64, uint64])
+	err = k.ValidatorSlashEvents.Walk(ctx, collections.NewPrefixedTripleRange[sdk.ValAddress, uint64, uint64](valAddr),
+		func(key collections.Triple[sdk.ValAddress, uint64, uint64], _ types.ValidatorSlashEvent) (stop bool, err error) {
+			if key.K3() > lastPeriod {
+				return true, nil
+			}
+			slashEvents[key.K3()] = append(slashEvents[key.K3()], key)
+			return false, nil
+		},
+	)
+	if err != nil {
+		return compaction, err
+	}
+
+	for _, rec := range records {
+		compaction.visited++
+
+		// the references which are not slash events
+		references := int64(rec.referenceCount) - int64(len(slashEvents[rec.period]))
+		if references < 0 {
+			return compaction, errors.New("historical rewards record referenced by more slash events than its reference count")
+		}
+
+		// the slash events ending the first period referenced otherwise are not
+		// needed either, but the record is kept
+		compaction.slashEvents = append(compaction.slashEvents, slashEvents[rec.period]...)
+		if references > 0 || rec.period+1 >= current.Period {
+			compaction.done = true
+			break
+		}
+
+		if len(slashEvents[rec.period]) == 0 {
+			compaction.unreferenced = append(compaction.unreferenced, rec.period)
+		}
+	}
+
+	return compaction, nil
+}
+
+// PruneHistoricalRewards compacts the historical rewards records of the
+// validators, see compactableHistoricalRewards, visiting at most limit records
+// and resuming from the validator the previous call stopped at. It returns the
+// number of records removed.
+func (k Keeper) PruneHistoricalRewards(ctx context.Context, limit uint64) (uint64, error) {
+	var rng collections.Ranger[sdk.ValAddress]
+	cursor, err := k.HistoricalRewardsPruningCursor.Get(ctx)
+	switch {
+	case err == nil:
+		rng = new(collections.Range[sdk.ValAddress]).StartInclusive(cursor)
+	case !errors.Is(err, collections.ErrNotFound):
+		return 0, err
+	}
+
+	// collect the validators to visit first, their records being modified
+	var (
+		validators []sdk.ValAddress
+		next       sdk.ValAddress
+	)
+	err = k.ValidatorCurrentRewards.Walk(ctx, rng, func(valAddr sdk.ValAddress, _ types.ValidatorCurrentRewards) (stop bool, err error) {
+		// each validator has at least one record
+		if uint64(len(validators)) == limit {
+			next = valAddr
+			return true, nil
+		}
+		validators = append(validators, valAddr)
+		return false, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	visited, removed := uint64(0), uint64(0)
+	for _, valAddr := range validators {
+		if visited == limit {
+			next = valAddr
+			break
+		}
+
+		compaction, err := k.compactableHistoricalRewards(ctx, valAddr, limit-visited)
+		if err != nil {
+			return removed, err
+		}
+		visited += compaction.visited
+
+		n, err := k.compactHistoricalRewards(ctx, valAddr, compaction)
+		if err != nil {
+			return removed, err
+		}
+		removed += n
+
+		if !compaction.done {
+			next = valAddr
+			break
+		}
+	}
+
+	if next == nil {
+		return removed, k.HistoricalRewardsPruningCursor.Remove(ctx)
+	}
+
+	return removed, k.HistoricalRewardsPruningCursor.Set(ctx, next)
+}
+
+// compactHistoricalRewards removes the slash events and records of a
+// compaction, returning the number of records removed.
+func (k Keeper) compactHistoricalRewards(ctx context.Context, valAddr sdk.ValAddress, compaction historicalRewardsCompaction) (uint64, error) {
+	before, err := k.getHistoricalRewardsCount(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, key := range compaction.slashEvents {
+		if err := k.ValidatorSlashEvents.Remove(ctx, key); err != nil {
+			return 0, err
+		}
+
+		if err := k.decrementReferenceCount(ctx, valAddr, key.K3()); err != nil {
+			return 0, err
+		}
+	}
+
+	for _, period := range compaction.unreferenced {
+		if err := k.ValidatorHistoricalRewards.Remove(ctx, collections.Join(valAddr, period)); err != nil {
+			return 0, err
+		}
+	}
+	if err := k.addHistoricalRewardsCount(ctx, -int64(len(compaction.unreferenced))); err != nil {
+		return 0, err
+	}
+
+	after, err := k.getHistoricalRewardsCount(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return before - after, nil
+}
+
+// pruneHistoricalRewards prunes the historical rewards every
+// HistoricalRewardsPruningInterval blocks, and reports the number of records
+// left and removed to telemetry.
+func (k Keeper) pruneHistoricalRewards(ctx sdk.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	interval := params.HistoricalRewardsPruningInterval
+	if interval == 0 || uint64(ctx.BlockHeight())%interval != 0 {
+		return nil
+	}
+
+	removed, err := k.PruneHistoricalRewards(ctx, HistoricalRewardsPruningLimit)
+	if err != nil {
+		return err
+	}
+
+	records, err := k.getHistoricalRewardsCount(ctx)
+	if err != nil {
+		return err
+	}
+
+	telemetry.IncrCounter(float32(removed), types.ModuleName, "pruned_historical_rewards")
+	telemetry.SetGauge(float32(records), types.ModuleName, "historical_rewards_records")
+
+	return nil
+}
+
+// getHistoricalRewardsCount returns the number of historical rewards records.
+func (k Keeper) getHistoricalRewardsCount(ctx context.Context) (uint64, error) {
+	count, err := k.HistoricalRewardsCount.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// addHistoricalRewardsCount adds delta, which may be negative, to the number of
+// historical rewards records.
+func (k Keeper) addHistoricalRewardsCount(ctx context.Context, delta int64) error {
+	if delta == 0 {
+		return nil
+	}
+
+	count, err := k.getHistoricalRewardsCount(ctx)
+	if err != nil {
+		return err
+	}
+
+	if delta < 0 && uint64(-delta) > count {
+		count = 0
+	} else {
+		count = uint64(int64(count) + delta)
+	}
+
+	return k.HistoricalRewardsCount.Set(ctx, count)
+}
+
+// countHistoricalRewards counts the historical rewards records in the store,
+// to initialize their count.
+func (k Keeper) countHistoricalRewards(ctx context.Context) (uint64, error) {
+	count := uint64(0)
+	err := k.ValidatorHistoricalRewards.Walk(ctx, nil, func(_ collections.Pair[sdk.ValAddress, uint64], _ types.ValidatorHistoricalRewards) (stop bool, err error) {
+		count++
+		return false, nil
+	})
+
+	return count, err
+}
+
+// removeValidatorHistoricalRewards removes all the historical rewards records
+// of a validator.
+func (k Keeper) removeValidatorHistoricalRewards(ctx context.Context, valAddr sdk.ValAddress) error {
+	iter, err := k.ValidatorHistoricalRewards.Iterate(ctx, collections.NewPrefixedPairRange[sdk.ValAddress, uint64](valAddr))
+	if err != nil {
+		return err
+	}
+
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := k.ValidatorHistoricalRewards.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return k.addHistoricalRewardsCount(ctx, -int64(len(keys)))
+}
+
+// GetValidatorHistoricalRewardsStats returns the number of historical rewards
+// records and slash events stored for a validator.
+func (k Keeper) GetValidatorHistoricalRewardsStats(ctx context.Context, valAddr sdk.ValAddress) (types.ValidatorHistoricalRewardsStats, error) {
+	valStr, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	if err != nil {
+		return types.ValidatorHistoricalRewardsStats{}, err
+	}
+
+	stats := types.ValidatorHistoricalRewardsStats{ValidatorAddress: valStr}
+	err = k.ValidatorHistoricalRewards.Walk(ctx, collections.NewPrefixedPairRange[sdk.ValAddress, uint64](valAddr),
+		func(_ collections.Pair[sdk.ValAddress, uint64], rewards types.ValidatorHistoricalRewards) (stop bool, err error) {
+			stats.HistoricalRewards++
+			stats.ReferenceCount += uint64(rewards.ReferenceCount)
+			return false, nil
+		},
+	)
+	if err != nil {
+		return types.ValidatorHistoricalRewardsStats{}, err
+	}
+
+	err = k.ValidatorSlashEvents.Walk(ctx, collections.NewPrefixedTripleRange[sdk.ValAddress, uint64, uint64](valAddr), func(_ collections.Triple[sdk.ValAddress, uint64, uint64], _ types.ValidatorSlashEvent) (stop bool, err error) {
+		stats.SlashEvents++
+		return false, nil
+	})
+	if err != nil {
+		return types.ValidatorHistoricalRewardsStats{}, err
+	}
+
+	compaction, err := k.compactableHistoricalRewards(ctx, valAddr, 0)
+	if err != nil {
+		return types.ValidatorHistoricalRewardsStats{}, err
+	}
+	stats.PrunableSlashEvents = uint64(len(compaction.slashEvents))
+
+	return stats, nil
+}
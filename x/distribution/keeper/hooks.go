@@ -36,6 +36,10 @@ func (h Hooks) AfterValidatorCreated(ctx context.Context, valAddr sdk.ValAddress
 
 // AfterValidatorRemoved performs clean up after a validator is removed
 func (h Hooks) AfterValidatorRemoved(ctx context.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	if err := h.k.flushPendingRewardAllocation(ctx, valAddr); err != nil {
+		return err
+	}
+
 	// fetch outstanding
 	outstanding, err := h.k.GetValidatorOutstandingRewardsCoins(ctx, valAddr)
 	if err != nil {
@@ -54,16 +58,19 @@ func (h Hooks) AfterValidatorRemoved(ctx context.Context, _ sdk.ConsAddress, val
 		// subtract from outstanding
 		outstanding = outstanding.Sub(commission)
 
-		// split into integral & remainder
-		coins, remainder := commission.TruncateDecimal()
-
-		// remainder to decimal pool
+		// round to whole coins, the rounded fractions going to or coming from the
+		// decimal pool
 		feePool, err := h.k.FeePool.Get(ctx)
 		if err != nil {
 			return err
 		}
 
-		feePool.DecimalPool = feePool.DecimalPool.Add(remainder...)
+		var coins sdk.Coins
+		coins, feePool.DecimalPool, err = h.k.roundRewards(ctx, valAddr, commission, feePool.DecimalPool)
+		if err != nil {
+			return err
+		}
+
 		err = h.k.FeePool.Set(ctx, feePool)
 		if err != nil {
 			return err
@@ -116,7 +123,7 @@ func (h Hooks) AfterValidatorRemoved(ctx context.Context, _ sdk.ConsAddress, val
 	}
 
 	// clear historical rewards
-	err = h.k.ValidatorHistoricalRewards.Clear(ctx, collections.NewPrefixedPairRange[sdk.ValAddress, uint64](valAddr))
+	err = h.k.removeValidatorHistoricalRewards(ctx, valAddr)
 	if err != nil {
 		return err
 	}
@@ -126,6 +133,12 @@ func (h Hooks) AfterValidatorRemoved(ctx context.Context, _ sdk.ConsAddress, val
 		return err
 	}
 
+	// clear external rewards records
+	err = h.k.ExternalRewards.Clear(ctx, collections.NewPrefixedPairRange[sdk.ValAddress, string](valAddr))
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -152,7 +165,7 @@ func (h Hooks) BeforeDelegationSharesModified(ctx context.Context, delAddr sdk.A
 		return err
 	}
 
-	if _, err := h.k.withdrawDelegationRewards(ctx, val, del); err != nil {
+	if _, err := h.k.withdrawDelegationRewards(ctx, val, del, nil); err != nil {
 		return err
 	}
 
@@ -43,6 +43,11 @@ func AllInvariants(k Keeper) sdk.Invariant {
 // NonNegativeOutstandingInvariant checks that outstanding unwithdrawn fees are never negative
 func NonNegativeOutstandingInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
+		ctx, _ = ctx.CacheContext()
+		if err := k.FlushPendingRewardAllocations(ctx); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "nonnegative outstanding", err.Error()), true
+		}
+
 		var msg string
 		var count int
 		var outstanding sdk.DecCoins
@@ -186,6 +191,12 @@ func ReferenceCountInvariant(k Keeper) sdk.Invariant {
 // is consistent with the sum of validator outstanding rewards
 func ModuleAccountInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
+		// the pending reward allocations are held by the module account
+		ctx, _ = ctx.CacheContext()
+		if err := k.FlushPendingRewardAllocations(ctx); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "module account coins", err.Error()), true
+		}
+
 		var expectedCoins sdk.DecCoins
 		err := k.ValidatorOutstandingRewards.Walk(ctx, nil, func(_ sdk.ValAddress, rewards types.ValidatorOutstandingRewards) (stop bool, err error) {
 			expectedCoins = expectedCoins.Add(rewards.Rewards...)
@@ -26,6 +26,7 @@ type Keeper struct {
 	bankKeeper    types.BankKeeper
 	stakingKeeper types.StakingKeeper
 	poolKeeper    types.PoolKeeper
+	mintKeeper    types.MintKeeper
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
@@ -51,6 +52,22 @@ type Keeper struct {
 	PreviousProposer           collections.Item[sdk.ConsAddress]
 	// ValidatorSlashEvents key: valAddr+height+period | value: ValidatorSlashEvent
 	ValidatorSlashEvents collections.Map[collections.Triple[sdk.ValAddress, uint64, uint64], types.ValidatorSlashEvent]
+	// RewardEvents key: delAddr+id | value: RewardEvent
+	RewardEvents collections.Map[collections.Pair[sdk.AccAddress, uint64], types.RewardEvent]
+	// RewardEventSequence generates the reward event ids
+	RewardEventSequence collections.Sequence
+	// ExternalRewards key: valAddr+source | value: ExternalRewards
+	ExternalRewards collections.Map[collections.Pair[sdk.ValAddress, string], types.ExternalRewards]
+	// CommissionSplits key: valAddr | value: CommissionSplit
+	CommissionSplits collections.Map[sdk.ValAddress, types.CommissionSplit]
+	// PendingRewardAllocations key: valAddr | value: PendingRewardAllocation (rewards allocated to the validator not written to its records yet)
+	PendingRewardAllocations collections.Map[sdk.ValAddress, types.PendingRewardAllocation]
+	// TruncationDust key: valAddr | value: TruncationDust
+	TruncationDust collections.Map[sdk.ValAddress, types.TruncationDust]
+	// HistoricalRewardsCount is the number of ValidatorHistoricalRewards records
+	HistoricalRewardsCount collections.Item[uint64]
+	// HistoricalRewardsPruningCursor value: valAddr of the validator the historical rewards pruning resumes from
+	HistoricalRewardsPruningCursor collections.Item[sdk.ValAddress]
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
 }
@@ -129,6 +146,54 @@ func NewKeeper(
 			collections.TripleKeyCodec(sdk.LengthPrefixedAddressKey(sdk.ValAddressKey), collections.Uint64Key, collections.Uint64Key), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
 			codec.CollValue[types.ValidatorSlashEvent](cdc),
 		),
+		RewardEvents: collections.NewMap(
+			sb,
+			types.RewardEventsPrefix,
+			"reward_events",
+			collections.PairKeyCodec(sdk.LengthPrefixedAddressKey(sdk.AccAddressKey), collections.Uint64Key), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed for prefix iteration by delegator
+			codec.CollValue[types.RewardEvent](cdc),
+		),
+		RewardEventSequence: collections.NewSequence(sb, types.RewardEventSequenceKey, "reward_event_sequence"),
+		ExternalRewards: collections.NewMap(
+			sb,
+			types.ExternalRewardsPrefix,
+			"external_rewards",
+			collections.PairKeyCodec(sdk.LengthPrefixedAddressKey(sdk.ValAddressKey), collections.StringKey), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed for prefix iteration by validator
+			codec.CollValue[types.ExternalRewards](cdc),
+		),
+		CommissionSplits: collections.NewMap(
+			sb,
+			types.CommissionSplitsPrefix,
+			"commission_splits",
+			sdk.ValAddressKey,
+			codec.CollValue[types.CommissionSplit](cdc),
+		),
+		PendingRewardAllocations: collections.NewMap(
+			sb,
+			types.PendingRewardAllocationsPrefix,
+			"pending_reward_allocations",
+			sdk.ValAddressKey,
+			codec.CollValue[types.PendingRewardAllocation](cdc),
+		),
+		TruncationDust: collections.NewMap(
+			sb,
+			types.TruncationDustPrefix,
+			"truncation_dust",
+			sdk.ValAddressKey,
+			codec.CollValue[types.TruncationDust](cdc),
+		),
+		HistoricalRewardsCount: collections.NewItem(
+			sb,
+			types.HistoricalRewardsCountKey,
+			"historical_rewards_count",
+			collections.Uint64Value,
+		),
+		HistoricalRewardsPruningCursor: collections.NewItem(
+			sb,
+			types.HistoricalRewardsPruningCursorKey,
+			"historical_rewards_pruning_cursor",
+			collcodec.KeyToValueCodec(sdk.ValAddressKey),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -139,6 +204,17 @@ func NewKeeper(
 	return k
 }
 
+// SetMintKeeper sets the keeper providing the inflation of the reward
+// projections, which are unavailable until it is set. This method must take a
+// pointer, and the keeper can only be set once.
+func (k *Keeper) SetMintKeeper(mintKeeper types.MintKeeper) {
+	if k.mintKeeper != nil {
+		panic("cannot set mint keeper twice")
+	}
+
+	k.mintKeeper = mintKeeper
+}
+
 // GetAuthority returns the x/distribution module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -178,6 +254,12 @@ func (k Keeper) SetWithdrawAddr(ctx context.Context, delegatorAddr, withdrawAddr
 
 // withdraw rewards from a delegation
 func (k Keeper) WithdrawDelegationRewards(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error) {
+	return k.withdrawDelegationRewardsTo(ctx, delAddr, valAddr, nil)
+}
+
+// withdrawDelegationRewardsTo withdraws the rewards from a delegation to the
+// given address, or to the withdraw address of the delegator if it is nil.
+func (k Keeper) withdrawDelegationRewardsTo(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, withdrawAddr sdk.AccAddress) (sdk.Coins, error) {
 	val, err := k.stakingKeeper.Validator(ctx, valAddr)
 	if err != nil {
 		return nil, err
@@ -197,7 +279,7 @@ func (k Keeper) WithdrawDelegationRewards(ctx context.Context, delAddr sdk.AccAd
 	}
 
 	// withdraw rewards
-	rewards, err := k.withdrawDelegationRewards(ctx, val, del)
+	rewards, err := k.withdrawDelegationRewards(ctx, val, del, withdrawAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -212,6 +294,10 @@ func (k Keeper) WithdrawDelegationRewards(ctx context.Context, delAddr sdk.AccAd
 
 // withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx context.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
+	if err := k.flushPendingRewardAllocation(ctx, valAddr); err != nil {
+		return nil, err
+	}
+
 	// fetch validator accumulated commission
 	accumCommission, err := k.ValidatorsAccumulatedCommission.Get(ctx, valAddr)
 	if err != nil && !errors.Is(err, collections.ErrNotFound) {
@@ -239,14 +325,7 @@ func (k Keeper) WithdrawValidatorCommission(ctx context.Context, valAddr sdk.Val
 	}
 
 	if !commission.IsZero() {
-		accAddr := sdk.AccAddress(valAddr)
-		withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, accAddr)
-		if err != nil {
-			return nil, err
-		}
-
-		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, commission)
-		if err != nil {
+		if err := k.payoutValidatorCommission(ctx, valAddr, commission); err != nil {
 			return nil, err
 		}
 	}
@@ -273,5 +352,15 @@ func (k Keeper) GetTotalRewards(ctx context.Context) (totalRewards sdk.DecCoins)
 		panic(err)
 	}
 
+	// add the rewards allocated to the validators not written to their
+	// records yet
+	err = k.PendingRewardAllocations.Walk(ctx, nil, func(_ sdk.ValAddress, pending types.PendingRewardAllocation) (stop bool, err error) {
+		totalRewards = totalRewards.Add(pending.Commission...).Add(pending.Rewards...)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
 	return totalRewards
 }
@@ -15,12 +15,14 @@ import (
 	"cosmossdk.io/x/distribution/keeper"
 	distrtestutil "cosmossdk.io/x/distribution/testutil"
 	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
 
@@ -131,6 +133,65 @@ func TestWithdrawValidatorCommission(t *testing.T) {
 	}, remainder)
 }
 
+func TestCommissionSplit(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	queryServer := keeper.NewQuerier(distrKeeper)
+
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	valAddr := sdk.ValAddress(valConsAddr0)
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+	blockedAddr := authtypes.NewModuleAddress(types.ModuleName)
+	dep.bankKeeper.EXPECT().BlockedAddr(blockedAddr).Return(true).AnyTimes()
+	dep.bankKeeper.EXPECT().BlockedAddr(gomock.Any()).Return(false).AnyTimes()
+
+	recipients := []types.CommissionSplitRecipient{
+		{Address: addrs[0].String(), Weight: math.LegacyNewDecWithPrec(7, 1)},
+		{Address: addrs[1].String(), Weight: math.LegacyNewDecWithPrec(3, 1)},
+	}
+
+	// invalid splits
+	require.ErrorIs(t, distrKeeper.SetCommissionSplit(ctx, valAddr, recipients[:1]), types.ErrInvalidCommissionSplit)
+	require.ErrorIs(t, distrKeeper.SetCommissionSplit(ctx, valAddr, []types.CommissionSplitRecipient{recipients[0], recipients[0]}), types.ErrInvalidCommissionSplit)
+	require.ErrorIs(t, distrKeeper.SetCommissionSplit(ctx, valAddr, []types.CommissionSplitRecipient{
+		recipients[0],
+		{Address: blockedAddr.String(), Weight: math.LegacyNewDecWithPrec(3, 1)},
+	}), sdkerrors.ErrUnauthorized)
+
+	require.NoError(t, distrKeeper.SetCommissionSplit(ctx, valAddr, recipients))
+	res, err := queryServer.CommissionSplit(ctx, &types.QueryCommissionSplitRequest{ValidatorAddress: val.GetOperator()})
+	require.NoError(t, err)
+	require.Equal(t, recipients, res.Recipients)
+
+	// withdraw the commission, split between the recipients
+	valCommission := sdk.DecCoins{
+		sdk.NewDecCoinFromDec("mytoken", math.LegacyNewDec(5)),
+		sdk.NewDecCoinFromDec("stake", math.LegacyNewDec(101)),
+	}
+	require.NoError(t, distrKeeper.ValidatorOutstandingRewards.Set(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: valCommission}))
+	require.NoError(t, distrKeeper.ValidatorsAccumulatedCommission.Set(ctx, valAddr, types.ValidatorAccumulatedCommission{Commission: valCommission}))
+
+	dep.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, addrs[0],
+		sdk.NewCoins(sdk.NewInt64Coin("mytoken", 4), sdk.NewInt64Coin("stake", 71))).Return(nil)
+	dep.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, addrs[1],
+		sdk.NewCoins(sdk.NewInt64Coin("mytoken", 1), sdk.NewInt64Coin("stake", 30))).Return(nil)
+	_, err = distrKeeper.WithdrawValidatorCommission(ctx, valAddr)
+	require.NoError(t, err)
+
+	// remove the split, the commission is withdrawn to the withdraw address again
+	require.NoError(t, distrKeeper.SetCommissionSplit(ctx, valAddr, nil))
+	res, err = queryServer.CommissionSplit(ctx, &types.QueryCommissionSplitRequest{ValidatorAddress: val.GetOperator()})
+	require.NoError(t, err)
+	require.Empty(t, res.Recipients)
+
+	require.NoError(t, distrKeeper.ValidatorOutstandingRewards.Set(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: valCommission}))
+	require.NoError(t, distrKeeper.ValidatorsAccumulatedCommission.Set(ctx, valAddr, types.ValidatorAccumulatedCommission{Commission: valCommission}))
+	dep.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sdk.AccAddress(valAddr),
+		sdk.NewCoins(sdk.NewInt64Coin("mytoken", 5), sdk.NewInt64Coin("stake", 101))).Return(nil)
+	_, err = distrKeeper.WithdrawValidatorCommission(ctx, valAddr)
+	require.NoError(t, err)
+}
+
 func TestGetTotalRewards(t *testing.T) {
 	ctx, addrs, distrKeeper, _ := initFixture(t)
 
@@ -147,3 +208,54 @@ func TestGetTotalRewards(t *testing.T) {
 
 	require.Equal(t, expectedRewards, totalRewards)
 }
+
+func TestAddExternalRewards(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	queryServer := keeper.NewQuerier(distrKeeper)
+
+	// create validator with 50% commission
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
+	valAddr := sdk.ValAddress(valConsAddr0)
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), sdk.ValAddress(addrs[1])).Return(nil, nil)
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	require.ErrorIs(t, distrKeeper.AddExternalRewards(ctx, addrs[0], valAddr, amount, "MEV"), types.ErrInvalidRewardSource)
+	require.Error(t, distrKeeper.AddExternalRewards(ctx, addrs[0], valAddr, sdk.Coins{}, "mev"))
+	require.ErrorIs(t, distrKeeper.AddExternalRewards(ctx, addrs[0], sdk.ValAddress(addrs[1]), amount, "mev"), types.ErrNoValidatorExists)
+
+	dep.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), addrs[0], types.ModuleName, amount).Return(nil).Times(2)
+	dep.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), addrs[1], types.ModuleName, amount).Return(nil)
+	require.NoError(t, distrKeeper.AddExternalRewards(ctx, addrs[0], valAddr, amount, "mev"))
+	require.NoError(t, distrKeeper.AddExternalRewards(ctx, addrs[0], valAddr, amount, "mev"))
+	require.NoError(t, distrKeeper.AddExternalRewards(ctx, addrs[1], valAddr, amount, "bridge_fees"))
+
+	// the rewards are split with the commission of the validator
+	outstanding, err := distrKeeper.ValidatorOutstandingRewards.Get(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 30)), outstanding.Rewards)
+	commission, err := distrKeeper.ValidatorsAccumulatedCommission.Get(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 15)), commission.Commission)
+
+	_, err = queryServer.ValidatorExternalRewards(ctx, &types.QueryValidatorExternalRewardsRequest{})
+	require.ErrorContains(t, err, "empty validator address")
+
+	res, err := queryServer.ValidatorExternalRewards(ctx, &types.QueryValidatorExternalRewardsRequest{ValidatorAddress: val.GetOperator()})
+	require.NoError(t, err)
+	require.Equal(t, []types.ExternalRewards{
+		{ValidatorAddress: val.GetOperator(), Source: "bridge_fees", Amount: amount},
+		{ValidatorAddress: val.GetOperator(), Source: "mev", Amount: amount.Add(amount...)},
+	}, res.Rewards)
+
+	// the records are removed along with the validator
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, types.InitialFeePool()))
+	dep.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sdk.AccAddress(valAddr), sdk.NewCoins(sdk.NewInt64Coin("stake", 15))).Return(nil)
+	require.NoError(t, distrKeeper.ValidatorCurrentRewards.Set(ctx, valAddr, types.NewValidatorCurrentRewards(sdk.DecCoins{}, 1)))
+	require.NoError(t, distrKeeper.Hooks().AfterValidatorRemoved(ctx, valConsAddr0, valAddr))
+	all, err := distrKeeper.GetAllExternalRewards(ctx)
+	require.NoError(t, err)
+	require.Empty(t, all)
+}
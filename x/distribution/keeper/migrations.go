@@ -40,6 +40,36 @@ func (m Migrator) Migrate3to4(ctx context.Context) error {
 	return m.migrateFunds(ctx)
 }
 
+// Migrate4to5 migrates the x/distribution module state from the consensus
+// version 4 to version 5, which may defer the allocation of the rewards of the
+// validators to their pending reward allocations, stored by validator. The
+// records of the validators are kept as is, the pending reward allocations
+// starting empty, and the deferral is disabled until the reward allocation
+// flush interval is set. The
+// historical rewards records are counted to initialize their count.
+func (m Migrator) Migrate4to5(ctx context.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.RewardAllocationFlushInterval = 0
+	if err := m.keeper.Params.Set(ctx, params); err != nil {
+		return err
+	}
+
+	if err := m.keeper.PendingRewardAllocations.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	count, err := m.keeper.countHistoricalRewards(ctx)
+	if err != nil {
+		return err
+	}
+
+	return m.keeper.HistoricalRewardsCount.Set(ctx, count)
+}
+
 func (m Migrator) migrateFunds(ctx context.Context) error {
 	macc := m.keeper.GetDistributionAccount(ctx)
 	poolMacc := m.keeper.authKeeper.GetModuleAccount(ctx, types.ProtocolPoolModuleName)
@@ -76,6 +76,68 @@ func (k msgServer) WithdrawDelegatorReward(ctx context.Context, msg *types.MsgWi
 	return &types.MsgWithdrawDelegatorRewardResponse{Amount: amount}, nil
 }
 
+func (k msgServer) WithdrawTokenizeShareRecordReward(ctx context.Context, msg *types.MsgWithdrawTokenizeShareRecordReward) (*types.MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	ownerAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.OwnerAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+
+	amount, err := k.Keeper.WithdrawTokenizeShareRecordReward(ctx, ownerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawTokenizeShareRecordRewardResponse{Amount: amount}, nil
+}
+
+func (k msgServer) WithdrawAllDelegatorRewards(ctx context.Context, msg *types.MsgWithdrawAllDelegatorRewards) (*types.MsgWithdrawAllDelegatorRewardsResponse, error) {
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	var destinationAddress sdk.AccAddress
+	if msg.DestinationAddress != "" {
+		destinationAddress, err = k.authKeeper.AddressCodec().StringToBytes(msg.DestinationAddress)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid destination address: %s", err)
+		}
+
+		if k.bankKeeper.BlockedAddr(destinationAddress) {
+			return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", msg.DestinationAddress)
+		}
+
+		// a destination address is a one-off withdraw address
+		withdrawAddrEnabled, err := k.GetWithdrawAddrEnabled(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if !withdrawAddrEnabled {
+			return nil, types.ErrSetWithdrawAddrDisabled
+		}
+	}
+
+	amount, err := k.WithdrawAllDelegationRewards(ctx, delegatorAddress, destinationAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		for _, a := range amount {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "withdraw_reward"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	return &types.MsgWithdrawAllDelegatorRewardsResponse{Amount: amount}, nil
+}
+
 func (k msgServer) WithdrawValidatorCommission(ctx context.Context, msg *types.MsgWithdrawValidatorCommission) (*types.MsgWithdrawValidatorCommissionResponse, error) {
 	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
 	if err != nil {
@@ -102,6 +164,19 @@ func (k msgServer) WithdrawValidatorCommission(ctx context.Context, msg *types.M
 	return &types.MsgWithdrawValidatorCommissionResponse{Amount: amount}, nil
 }
 
+func (k msgServer) SetCommissionSplit(ctx context.Context, msg *types.MsgSetCommissionSplit) (*types.MsgSetCommissionSplitResponse, error) {
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if err := k.Keeper.SetCommissionSplit(ctx, valAddr, msg.Recipients); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetCommissionSplitResponse{}, nil
+}
+
 // Deprecated: DO NOT USE
 // This method uses deprecated message request. Use FundCommunityPool from x/protocolpool module instead.
 func (k msgServer) FundCommunityPool(ctx context.Context, msg *types.MsgFundCommunityPool) (*types.MsgFundCommunityPoolResponse, error) {
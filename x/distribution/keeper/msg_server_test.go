@@ -10,6 +10,7 @@ import (
 	authtypes "cosmossdk.io/x/auth/types"
 	"cosmossdk.io/x/distribution/keeper"
 	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -117,6 +118,132 @@ func TestMsgWithdrawDelegatorReward(t *testing.T) {
 	}
 }
 
+func TestMsgWithdrawTokenizeShareRecordReward(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	record := stakingtypes.TokenizeShareRecord{
+		Id:               1,
+		Owner:            addrs[0].String(),
+		ModuleAccount:    stakingtypes.TokenizeShareModuleAccountName(1),
+		ValidatorAddress: sdk.ValAddress(addrs[1]).String(),
+	}
+	recordAddr := sdk.AccAddress(record.GetModuleAddress())
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	cases := []struct {
+		name   string
+		preRun func()
+		msg    *types.MsgWithdrawTokenizeShareRecordReward
+		expAmt sdk.Coins
+		errMsg string
+	}{
+		{
+			name:   "invalid owner address",
+			msg:    types.NewMsgWithdrawTokenizeShareRecordReward("invalid"),
+			errMsg: "invalid owner address",
+		},
+		{
+			name: "no records",
+			preRun: func() {
+				dep.stakingKeeper.EXPECT().GetTokenizeShareRecordsByOwner(gomock.Any(), addrs[1]).Return(nil, nil)
+			},
+			msg:    types.NewMsgWithdrawTokenizeShareRecordReward(addrs[1].String()),
+			errMsg: "no tokenize share records owned",
+		},
+		{
+			name: "success",
+			preRun: func() {
+				dep.stakingKeeper.EXPECT().GetTokenizeShareRecordsByOwner(gomock.Any(), addrs[0]).Return([]stakingtypes.TokenizeShareRecord{record}, nil)
+				dep.stakingKeeper.EXPECT().GetAllDelegatorDelegations(gomock.Any(), recordAddr).Return(nil, nil)
+				dep.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), recordAddr).Return(rewards)
+				dep.bankKeeper.EXPECT().SendCoins(gomock.Any(), recordAddr, addrs[0], rewards).Return(nil)
+			},
+			msg:    types.NewMsgWithdrawTokenizeShareRecordReward(addrs[0].String()),
+			expAmt: rewards,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+			res, err := msgServer.WithdrawTokenizeShareRecordReward(ctx, tc.msg)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.expAmt, res.Amount)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			}
+		})
+	}
+}
+
+func TestMsgWithdrawAllDelegatorRewards(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	cases := []struct {
+		name   string
+		preRun func()
+		msg    *types.MsgWithdrawAllDelegatorRewards
+		errMsg string
+	}{
+		{
+			name:   "invalid delegator address",
+			msg:    types.NewMsgWithdrawAllDelegatorRewards("invalid", ""),
+			errMsg: "invalid delegator address",
+		},
+		{
+			name:   "invalid destination address",
+			msg:    types.NewMsgWithdrawAllDelegatorRewards(addrs[0].String(), "invalid"),
+			errMsg: "invalid destination address",
+		},
+		{
+			name:   "blocked destination address",
+			msg:    types.NewMsgWithdrawAllDelegatorRewards(addrs[0].String(), distrAcc.GetAddress().String()),
+			errMsg: "not allowed to receive external funds",
+		},
+		{
+			name: "withdraw address disabled",
+			preRun: func() {
+				params := types.DefaultParams()
+				params.WithdrawAddrEnabled = false
+				require.NoError(t, distrKeeper.Params.Set(ctx, params))
+			},
+			msg:    types.NewMsgWithdrawAllDelegatorRewards(addrs[0].String(), addrs[1].String()),
+			errMsg: "set withdraw address disabled",
+		},
+		{
+			name: "no delegations",
+			preRun: func() {
+				dep.stakingKeeper.EXPECT().GetAllDelegatorDelegations(gomock.Any(), addrs[0]).Return(nil, nil)
+			},
+			msg:    types.NewMsgWithdrawAllDelegatorRewards(addrs[0].String(), ""),
+			errMsg: "delegation does not exist",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+			_, err := msgServer.WithdrawAllDelegatorRewards(ctx, tc.msg)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			}
+		})
+	}
+}
+
 func TestMsgWithdrawValidatorCommission(t *testing.T) {
 	ctx, addrs, distrKeeper, _ := initFixture(t)
 	msgServer := keeper.NewMsgServerImpl(distrKeeper)
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/distribution/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// addPendingRewardAllocation adds the commission and delegator rewards
+// allocated to a validator to its pending reward allocation.
+func (k Keeper) addPendingRewardAllocation(ctx context.Context, valAddr sdk.ValAddress, commission, rewards sdk.DecCoins) error {
+	pending, err := k.getPendingRewardAllocation(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	pending.Commission = pending.Commission.Add(commission...)
+	pending.Rewards = pending.Rewards.Add(rewards...)
+	return k.PendingRewardAllocations.Set(ctx, valAddr, pending)
+}
+
+// getPendingRewardAllocation returns the pending reward allocation of a
+// validator, empty if it has none.
+func (k Keeper) getPendingRewardAllocation(ctx context.Context, valAddr sdk.ValAddress) (types.PendingRewardAllocation, error) {
+	pending, err := k.PendingRewardAllocations.Get(ctx, valAddr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.PendingRewardAllocation{}, err
+	}
+
+	return pending, nil
+}
+
+// flushContext returns the context in which the pending reward allocations are
+// written. The rewards being allocated in BeginBlock, writing them is not
+// charged to the transaction which happens to use the records of a validator.
+func flushContext(ctx context.Context) context.Context {
+	return sdk.UnwrapSDKContext(ctx).WithGasMeter(storetypes.NewInfiniteGasMeter())
+}
+
+// flushPendingRewardAllocation writes the pending rewards of a validator to its
+// rewards and commission records. It must be called before these records are
+// used.
+func (k Keeper) flushPendingRewardAllocation(ctx context.Context, valAddr sdk.ValAddress) error {
+	ctx = flushContext(ctx)
+	pending, err := k.PendingRewardAllocations.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if err := k.PendingRewardAllocations.Remove(ctx, valAddr); err != nil {
+		return err
+	}
+
+	return k.addValidatorRewards(ctx, valAddr, pending.Commission, pending.Rewards)
+}
+
+// FlushPendingRewardAllocations writes the pending rewards of all the
+// validators to their rewards and commission records.
+func (k Keeper) FlushPendingRewardAllocations(ctx context.Context) error {
+	ctx = flushContext(ctx)
+	iter, err := k.PendingRewardAllocations.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	allocations, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, allocation := range allocations {
+		if err := k.PendingRewardAllocations.Remove(ctx, allocation.Key); err != nil {
+			return err
+		}
+
+		if err := k.addValidatorRewards(ctx, allocation.Key, allocation.Value.Commission, allocation.Value.Rewards); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// flushPendingRewardAllocations flushes the pending reward allocations every
+// RewardAllocationFlushInterval blocks, or as soon as their deferral is
+// disabled.
+func (k Keeper) flushPendingRewardAllocations(ctx sdk.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	interval := params.RewardAllocationFlushInterval
+	if interval != 0 && uint64(ctx.BlockHeight())%interval != 0 {
+		return nil
+	}
+
+	return k.FlushPendingRewardAllocations(ctx)
+}
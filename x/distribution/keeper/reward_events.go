@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/distribution/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// recordRewardEvent appends a withdrawal of delegation rewards to the reward
+// events log of the delegator, if reward events are enabled.
+func (k Keeper) recordRewardEvent(ctx context.Context, delAddr, withdrawAddr sdk.AccAddress, valAddr string, rewards sdk.Coins) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.RewardEventsEnabled {
+		return nil
+	}
+
+	id, err := k.RewardEventSequence.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	delAddrStr, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return err
+	}
+
+	withdrawAddrStr, err := k.authKeeper.AddressCodec().BytesToString(withdrawAddr)
+	if err != nil {
+		return err
+	}
+
+	headerInfo := sdk.UnwrapSDKContext(ctx).HeaderInfo()
+	return k.RewardEvents.Set(ctx, collections.Join(delAddr, id), types.RewardEvent{
+		Id:               id,
+		DelegatorAddress: delAddrStr,
+		ValidatorAddress: valAddr,
+		WithdrawAddress:  withdrawAddrStr,
+		Amount:           rewards,
+		Height:           headerInfo.Height,
+		Time:             headerInfo.Time,
+	})
+}
+
+// GetAllRewardEvents returns the reward events of all the delegators.
+func (k Keeper) GetAllRewardEvents(ctx context.Context) ([]types.RewardEvent, error) {
+	var events []types.RewardEvent
+	err := k.RewardEvents.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, uint64], event types.RewardEvent) (bool, error) {
+		events = append(events, event)
+		return false, nil
+	})
+
+	return events, err
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/distribution/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// annualRewardsPerBondedToken returns the estimated inflation rewards accrued by
+// a bonded token over a year, before the commission of its validator. The
+// inflation is split among the bonded validators pro rata their tokens, once
+// the community tax is taken.
+func (k Keeper) annualRewardsPerBondedToken(ctx context.Context) (sdk.DecCoins, error) {
+	provisions, err := k.mintKeeper.AnnualProvisions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	totalBonded, err := k.stakingKeeper.TotalBondedTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !totalBonded.IsPositive() {
+		return sdk.DecCoins{}, nil
+	}
+
+	communityTax, err := k.GetCommunityTax(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return provisions.
+		MulDecTruncate(math.LegacyOneDec().Sub(communityTax)).
+		QuoDecTruncate(math.LegacyNewDecFromInt(totalBonded)), nil
+}
+
+// projectDelegationRewards returns the estimated annual rewards of a delegation,
+// given the annual rewards per bonded token. A delegation to a validator not
+// bonded, or jailed, earns no rewards.
+func projectDelegationRewards(val sdk.ValidatorI, del sdk.DelegationI, rewardsPerToken sdk.DecCoins, bondDenom string) types.ProjectedReward {
+	reward := types.ProjectedReward{
+		ValidatorAddress: del.GetValidatorAddr(),
+		AnnualRewards:    sdk.DecCoins{},
+		Apr:              math.LegacyZeroDec(),
+	}
+	if !val.IsBonded() || val.IsJailed() {
+		return reward
+	}
+
+	tokens := val.TokensFromShares(del.GetShares())
+	if !tokens.IsPositive() {
+		return reward
+	}
+
+	reward.AnnualRewards = rewardsPerToken.
+		MulDecTruncate(tokens).
+		MulDecTruncate(math.LegacyOneDec().Sub(val.GetCommission()))
+	reward.Apr = reward.AnnualRewards.AmountOf(bondDenom).Quo(tokens)
+
+	return reward
+}
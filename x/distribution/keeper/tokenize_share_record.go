@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/distribution/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WithdrawTokenizeShareRecordReward withdraws the rewards of the tokenized shares
+// of all the tokenize share records of an owner. The rewards are withdrawn to the
+// module account of each record, then its whole balance, including the rewards
+// withdrawn when its delegation was modified, is sent to the owner.
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx context.Context, owner sdk.AccAddress) (sdk.Coins, error) {
+	records, err := k.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, types.ErrNoTokenizeShareRecords
+	}
+
+	total := sdk.NewCoins()
+	for _, record := range records {
+		recordAddr := sdk.AccAddress(record.GetModuleAddress())
+		delegations, err := k.stakingKeeper.GetAllDelegatorDelegations(ctx, recordAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, delegation := range delegations {
+			valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(delegation.ValidatorAddress)
+			if err != nil {
+				return nil, err
+			}
+
+			if _, err := k.WithdrawDelegationRewards(ctx, recordAddr, valAddr); err != nil {
+				return nil, err
+			}
+		}
+
+		balances := k.bankKeeper.GetAllBalances(ctx, recordAddr)
+		if balances.IsZero() {
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, recordAddr, owner, balances); err != nil {
+			return nil, err
+		}
+		total = total.Add(balances...)
+	}
+
+	return total, nil
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/distribution/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// roundRewards rounds the rewards paid out for a validator to whole coins
+// following the rounding policy, and records the rounded fractions in the
+// truncation dust of the validator. It returns the coins to pay out and the
+// decimal pool with the fractions rounded down added and the fractions rounded
+// up taken from it.
+func (k Keeper) roundRewards(ctx context.Context, valAddr sdk.ValAddress, rewards, decimalPool sdk.DecCoins) (sdk.Coins, sdk.DecCoins, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	coins, remainder := rewards.TruncateDecimal()
+
+	var truncated, roundedUp sdk.DecCoins
+	for _, fraction := range remainder {
+		if params.RoundingPolicy == types.RoundingPolicyBankers {
+			rounded := rewards.AmountOf(fraction.Denom).RoundInt()
+			deficit := math.LegacyOneDec().Sub(fraction.Amount)
+
+			// the fraction rounded up must be covered by the decimal pool
+			if rounded.GT(coins.AmountOf(fraction.Denom)) && decimalPool.AmountOf(fraction.Denom).GTE(deficit) {
+				coins = coins.Add(sdk.NewCoin(fraction.Denom, math.OneInt()))
+				roundedUp = roundedUp.Add(sdk.NewDecCoinFromDec(fraction.Denom, deficit))
+				continue
+			}
+		}
+
+		truncated = truncated.Add(fraction)
+	}
+
+	decimalPool = decimalPool.Add(truncated...).Sub(roundedUp)
+
+	if err := k.addTruncationDust(ctx, valAddr, truncated, roundedUp); err != nil {
+		return nil, nil, err
+	}
+
+	return coins, decimalPool, nil
+}
+
+// addTruncationDust adds the fractions rounded down and up to the truncation
+// dust of a validator.
+func (k Keeper) addTruncationDust(ctx context.Context, valAddr sdk.ValAddress, truncated, roundedUp sdk.DecCoins) error {
+	if truncated.IsZero() && roundedUp.IsZero() {
+		return nil
+	}
+
+	dust, err := k.GetTruncationDust(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	dust.Truncated = dust.Truncated.Add(truncated...)
+	dust.RoundedUp = dust.RoundedUp.Add(roundedUp...)
+
+	return k.TruncationDust.Set(ctx, valAddr, dust)
+}
+
+// GetTruncationDust returns the cumulative fractions of coins by which the
+// rewards paid out for a validator were rounded.
+func (k Keeper) GetTruncationDust(ctx context.Context, valAddr sdk.ValAddress) (types.TruncationDust, error) {
+	dust, err := k.TruncationDust.Get(ctx, valAddr)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.TruncationDust{}, err
+	}
+
+	if dust.ValidatorAddress == "" {
+		dust.ValidatorAddress, err = k.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+		if err != nil {
+			return types.TruncationDust{}, err
+		}
+	}
+
+	return dust, nil
+}
+
+// GetAllTruncationDust returns the truncation dust of all the validators.
+func (k Keeper) GetAllTruncationDust(ctx context.Context) ([]types.TruncationDust, error) {
+	var dust []types.TruncationDust
+	err := k.TruncationDust.Walk(ctx, nil, func(_ sdk.ValAddress, value types.TruncationDust) (bool, error) {
+		dust = append(dust, value)
+		return false, nil
+	})
+
+	return dust, err
+}
@@ -24,6 +24,9 @@ func (k Keeper) initializeValidator(ctx context.Context, val sdk.ValidatorI) err
 	if err != nil {
 		return err
 	}
+	if err := k.addHistoricalRewardsCount(ctx, 1); err != nil {
+		return err
+	}
 
 	// set current rewards (starting at period 1)
 	err = k.ValidatorCurrentRewards.Set(ctx, valBz, types.NewValidatorCurrentRewards(sdk.DecCoins{}, 1))
@@ -49,6 +52,10 @@ func (k Keeper) IncrementValidatorPeriod(ctx context.Context, val sdk.ValidatorI
 		return 0, err
 	}
 
+	if err := k.flushPendingRewardAllocation(ctx, valBz); err != nil {
+		return 0, err
+	}
+
 	// fetch current rewards
 	rewards, err := k.ValidatorCurrentRewards.Get(ctx, valBz)
 	if err != nil && !errors.Is(err, collections.ErrNotFound) {
@@ -108,6 +115,9 @@ func (k Keeper) IncrementValidatorPeriod(ctx context.Context, val sdk.ValidatorI
 	if err != nil {
 		return 0, err
 	}
+	if err := k.addHistoricalRewardsCount(ctx, 1); err != nil {
+		return 0, err
+	}
 
 	// set current rewards, incrementing period by 1
 	err = k.ValidatorCurrentRewards.Set(ctx, valBz, types.NewValidatorCurrentRewards(sdk.DecCoins{}, rewards.Period+1))
@@ -144,7 +154,10 @@ func (k Keeper) decrementReferenceCount(ctx context.Context, valAddr sdk.ValAddr
 	}
 	historical.ReferenceCount--
 	if historical.ReferenceCount == 0 {
-		return k.ValidatorHistoricalRewards.Remove(ctx, collections.Join(valAddr, period))
+		if err := k.ValidatorHistoricalRewards.Remove(ctx, collections.Join(valAddr, period)); err != nil {
+			return err
+		}
+		return k.addHistoricalRewardsCount(ctx, -1)
 	}
 
 	return k.ValidatorHistoricalRewards.Set(ctx, collections.Join(valAddr, period), historical)
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/distribution/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WithdrawAllDelegationRewards withdraws the rewards of all the delegations of a
+// delegator to the given address, or to the withdraw address of the delegator if
+// it is nil. The withdrawal of each delegation is bounded by
+// types.WithdrawAllRewardsGasPerValidator gas, so that a delegation to a validator
+// with an unusually long reward history fails the whole batch early instead of
+// draining its gas.
+func (k Keeper) WithdrawAllDelegationRewards(ctx context.Context, delAddr, withdrawAddr sdk.AccAddress) (sdk.Coins, error) {
+	delegations, err := k.stakingKeeper.GetAllDelegatorDelegations(ctx, delAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(delegations) == 0 {
+		return nil, types.ErrNoDelegationExists
+	}
+
+	total := sdk.NewCoins()
+	for _, delegation := range delegations {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(delegation.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		rewards, err := k.withdrawDelegationRewardsBounded(ctx, delAddr, valAddr, withdrawAddr)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "validator %s", delegation.ValidatorAddress)
+		}
+		total = total.Add(rewards...)
+	}
+
+	return total, nil
+}
+
+// withdrawDelegationRewardsBounded withdraws the rewards of a delegation with a
+// gas meter limited to types.WithdrawAllRewardsGasPerValidator, the gas consumed
+// being charged to the gas meter of the context afterwards.
+func (k Keeper) withdrawDelegationRewardsBounded(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, withdrawAddr sdk.AccAddress) (rewards sdk.Coins, err error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	gasMeter := storetypes.NewGasMeter(types.WithdrawAllRewardsGasPerValidator)
+
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+
+			rewards, err = nil, types.ErrWithdrawGasExceeded.Wrapf("out of gas in location: %s", outOfGas.Descriptor)
+		}
+
+		sdkCtx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "withdraw all delegator rewards")
+	}()
+
+	return k.withdrawDelegationRewardsTo(sdkCtx.WithGasMeter(gasMeter), delAddr, valAddr, withdrawAddr)
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxCommissionSplitRecipients is the maximum number of payout addresses of a
+// commission split.
+const MaxCommissionSplitRecipients = 10
+
+// ValidateCommissionSplitRecipients performs a basic validation of the payout
+// addresses of a commission split: between one and
+// MaxCommissionSplitRecipients distinct addresses with positive weights adding
+// up to one.
+func ValidateCommissionSplitRecipients(recipients []CommissionSplitRecipient) error {
+	if len(recipients) == 0 || len(recipients) > MaxCommissionSplitRecipients {
+		return ErrInvalidCommissionSplit.Wrapf("must have between 1 and %d recipients, got %d", MaxCommissionSplitRecipients, len(recipients))
+	}
+
+	total := math.LegacyZeroDec()
+	seen := make(map[string]bool, len(recipients))
+	for _, recipient := range recipients {
+		if recipient.Address == "" {
+			return ErrInvalidCommissionSplit.Wrap("empty recipient address")
+		}
+		if seen[recipient.Address] {
+			return ErrInvalidCommissionSplit.Wrapf("duplicate recipient %s", recipient.Address)
+		}
+		seen[recipient.Address] = true
+
+		if recipient.Weight.IsNil() || !recipient.Weight.IsPositive() {
+			return ErrInvalidCommissionSplit.Wrapf("recipient %s has a non-positive weight", recipient.Address)
+		}
+		total = total.Add(recipient.Weight)
+	}
+
+	if !total.Equal(math.LegacyOneDec()) {
+		return ErrInvalidCommissionSplit.Wrapf("the weights of the recipients must add up to one, got %s", total)
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the commission split of a validator.
+func (s CommissionSplit) Validate() error {
+	if s.ValidatorAddress == "" {
+		return ErrEmptyValidatorAddr
+	}
+
+	return ValidateCommissionSplitRecipients(s.Recipients)
+}
+
+// Split splits an amount between the recipients according to their weights.
+// The amounts are truncated, the first recipient receiving the remainder so
+// that the amounts add up to the split amount.
+func (s CommissionSplit) Split(amount sdk.Coins) []sdk.Coins {
+	amounts := make([]sdk.Coins, len(s.Recipients))
+	remaining := amount
+	for i := len(s.Recipients) - 1; i > 0; i-- {
+		share := sdk.NewCoins()
+		for _, coin := range amount {
+			share = share.Add(sdk.NewCoin(coin.Denom, s.Recipients[i].Weight.MulInt(coin.Amount).TruncateInt()))
+		}
+		amounts[i] = share
+		remaining = remaining.Sub(share...)
+	}
+	amounts[0] = remaining
+
+	return amounts
+}
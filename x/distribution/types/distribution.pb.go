@@ -12,15 +12,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -28,6 +32,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RoundingPolicy defines how the rewards paid out are rounded to whole coins.
+type RoundingPolicy int32
+
+const (
+	// ROUNDING_POLICY_TRUNCATE_TO_POOL truncates the rewards, the truncated
+	// fractions going to the decimal pool.
+	RoundingPolicyTruncateToPool RoundingPolicy = 0
+	// ROUNDING_POLICY_BANKERS rounds the rewards half to even. The fractions
+	// rounded down go to the decimal pool and the fractions rounded up are taken
+	// from it, the rewards being truncated when the decimal pool cannot cover
+	// them.
+	RoundingPolicyBankers RoundingPolicy = 1
+)
+
+var RoundingPolicy_name = map[int32]string{
+	0: "ROUNDING_POLICY_TRUNCATE_TO_POOL",
+	1: "ROUNDING_POLICY_BANKERS",
+}
+
+var RoundingPolicy_value = map[string]int32{
+	"ROUNDING_POLICY_TRUNCATE_TO_POOL": 0,
+	"ROUNDING_POLICY_BANKERS":          1,
+}
+
+func (x RoundingPolicy) String() string {
+	return proto.EnumName(RoundingPolicy_name, int32(x))
+}
+
+func (RoundingPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{0}
+}
+
 // Params defines the set of params for the distribution module.
 type Params struct {
 	CommunityTax cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=community_tax,json=communityTax,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_tax"`
@@ -38,6 +74,24 @@ type Params struct {
 	// in the x/distribution module's reward mechanism.
 	BonusProposerReward cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonus_proposer_reward"` // Deprecated: Do not use.
 	WithdrawAddrEnabled bool                        `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// reward_events_enabled enables the log of the delegation reward withdrawals,
+	// exported by the RewardEventsExport query.
+	RewardEventsEnabled bool `protobuf:"varint,5,opt,name=reward_events_enabled,json=rewardEventsEnabled,proto3" json:"reward_events_enabled,omitempty"`
+	// historical_rewards_pruning_interval is the number of blocks between the
+	// passes compacting the historical rewards records based on their reference
+	// counts, removing the records and slash events which no delegation of their
+	// validator depends on anymore. Zero disables the pruning.
+	HistoricalRewardsPruningInterval uint64 `protobuf:"varint,6,opt,name=historical_rewards_pruning_interval,json=historicalRewardsPruningInterval,proto3" json:"historical_rewards_pruning_interval,omitempty"`
+	// reward_allocation_flush_interval is the number of blocks during which the
+	// rewards allocated to the validators at the beginning of each block are
+	// accumulated in the pending reward allocations before being written to the
+	// rewards and commission records of the validators. The pending rewards of a
+	// validator are also written before its records are used. Zero writes the
+	// records of the validators every block.
+	RewardAllocationFlushInterval uint64 `protobuf:"varint,7,opt,name=reward_allocation_flush_interval,json=rewardAllocationFlushInterval,proto3" json:"reward_allocation_flush_interval,omitempty"`
+	// rounding_policy is the rounding of the delegation rewards and of the
+	// commission paid out on the removal of a validator to whole coins.
+	RoundingPolicy RoundingPolicy `protobuf:"varint,8,opt,name=rounding_policy,json=roundingPolicy,proto3,enum=cosmos.distribution.v1beta1.RoundingPolicy" json:"rounding_policy,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -80,6 +134,156 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetRewardEventsEnabled() bool {
+	if m != nil {
+		return m.RewardEventsEnabled
+	}
+	return false
+}
+
+func (m *Params) GetHistoricalRewardsPruningInterval() uint64 {
+	if m != nil {
+		return m.HistoricalRewardsPruningInterval
+	}
+	return 0
+}
+
+func (m *Params) GetRewardAllocationFlushInterval() uint64 {
+	if m != nil {
+		return m.RewardAllocationFlushInterval
+	}
+	return 0
+}
+
+func (m *Params) GetRoundingPolicy() RoundingPolicy {
+	if m != nil {
+		return m.RoundingPolicy
+	}
+	return RoundingPolicyTruncateToPool
+}
+
+// TruncationDust tracks the fractions of coins by which the rewards paid out
+// for a validator were rounded.
+type TruncationDust struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// truncated is the cumulative fractions rounded down, sent to the decimal
+	// pool.
+	Truncated github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=truncated,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"truncated"`
+	// rounded_up is the cumulative fractions rounded up, taken from the decimal
+	// pool.
+	RoundedUp github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=rounded_up,json=roundedUp,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rounded_up"`
+}
+
+func (m *TruncationDust) Reset()         { *m = TruncationDust{} }
+func (m *TruncationDust) String() string { return proto.CompactTextString(m) }
+func (*TruncationDust) ProtoMessage()    {}
+func (*TruncationDust) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{1}
+}
+func (m *TruncationDust) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TruncationDust) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TruncationDust.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TruncationDust) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TruncationDust.Merge(m, src)
+}
+func (m *TruncationDust) XXX_Size() int {
+	return m.Size()
+}
+func (m *TruncationDust) XXX_DiscardUnknown() {
+	xxx_messageInfo_TruncationDust.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TruncationDust proto.InternalMessageInfo
+
+func (m *TruncationDust) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *TruncationDust) GetTruncated() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Truncated
+	}
+	return nil
+}
+
+func (m *TruncationDust) GetRoundedUp() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RoundedUp
+	}
+	return nil
+}
+
+// PendingRewardAllocation is the rewards allocated to a validator since its
+// rewards and commission records were last written, stored by validator.
+type PendingRewardAllocation struct {
+	// commission is the commission of the validator.
+	Commission github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=commission,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"commission"`
+	// rewards is the rewards of the delegators of the validator.
+	Rewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards"`
+}
+
+func (m *PendingRewardAllocation) Reset()         { *m = PendingRewardAllocation{} }
+func (m *PendingRewardAllocation) String() string { return proto.CompactTextString(m) }
+func (*PendingRewardAllocation) ProtoMessage()    {}
+func (*PendingRewardAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{2}
+}
+func (m *PendingRewardAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingRewardAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingRewardAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingRewardAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingRewardAllocation.Merge(m, src)
+}
+func (m *PendingRewardAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingRewardAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingRewardAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingRewardAllocation proto.InternalMessageInfo
+
+func (m *PendingRewardAllocation) GetCommission() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Commission
+	}
+	return nil
+}
+
+func (m *PendingRewardAllocation) GetRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -102,7 +306,7 @@ func (m *ValidatorHistoricalRewards) Reset()         { *m = ValidatorHistoricalR
 func (m *ValidatorHistoricalRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorHistoricalRewards) ProtoMessage()    {}
 func (*ValidatorHistoricalRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{1}
+	return fileDescriptor_cd78a31ea281a992, []int{3}
 }
 func (m *ValidatorHistoricalRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -157,7 +361,7 @@ func (m *ValidatorCurrentRewards) Reset()         { *m = ValidatorCurrentRewards
 func (m *ValidatorCurrentRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorCurrentRewards) ProtoMessage()    {}
 func (*ValidatorCurrentRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{2}
+	return fileDescriptor_cd78a31ea281a992, []int{4}
 }
 func (m *ValidatorCurrentRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -210,7 +414,7 @@ func (m *ValidatorAccumulatedCommission) Reset()         { *m = ValidatorAccumul
 func (m *ValidatorAccumulatedCommission) String() string { return proto.CompactTextString(m) }
 func (*ValidatorAccumulatedCommission) ProtoMessage()    {}
 func (*ValidatorAccumulatedCommission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{3}
+	return fileDescriptor_cd78a31ea281a992, []int{5}
 }
 func (m *ValidatorAccumulatedCommission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -256,7 +460,7 @@ func (m *ValidatorOutstandingRewards) Reset()         { *m = ValidatorOutstandin
 func (m *ValidatorOutstandingRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorOutstandingRewards) ProtoMessage()    {}
 func (*ValidatorOutstandingRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{4}
+	return fileDescriptor_cd78a31ea281a992, []int{6}
 }
 func (m *ValidatorOutstandingRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -305,7 +509,7 @@ func (m *ValidatorSlashEvent) Reset()         { *m = ValidatorSlashEvent{} }
 func (m *ValidatorSlashEvent) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEvent) ProtoMessage()    {}
 func (*ValidatorSlashEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{5}
+	return fileDescriptor_cd78a31ea281a992, []int{7}
 }
 func (m *ValidatorSlashEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,7 +554,7 @@ func (m *ValidatorSlashEvents) Reset()         { *m = ValidatorSlashEvents{} }
 func (m *ValidatorSlashEvents) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEvents) ProtoMessage()    {}
 func (*ValidatorSlashEvents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{6}
+	return fileDescriptor_cd78a31ea281a992, []int{8}
 }
 func (m *ValidatorSlashEvents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -397,7 +601,7 @@ func (m *FeePool) Reset()         { *m = FeePool{} }
 func (m *FeePool) String() string { return proto.CompactTextString(m) }
 func (*FeePool) ProtoMessage()    {}
 func (*FeePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{7}
+	return fileDescriptor_cd78a31ea281a992, []int{9}
 }
 func (m *FeePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -462,7 +666,7 @@ func (m *CommunityPoolSpendProposal) Reset()         { *m = CommunityPoolSpendPr
 func (m *CommunityPoolSpendProposal) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolSpendProposal) ProtoMessage()    {}
 func (*CommunityPoolSpendProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{8}
+	return fileDescriptor_cd78a31ea281a992, []int{10}
 }
 func (m *CommunityPoolSpendProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -507,7 +711,7 @@ func (m *DelegatorStartingInfo) Reset()         { *m = DelegatorStartingInfo{} }
 func (m *DelegatorStartingInfo) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfo) ProtoMessage()    {}
 func (*DelegatorStartingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{9}
+	return fileDescriptor_cd78a31ea281a992, []int{11}
 }
 func (m *DelegatorStartingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -561,7 +765,7 @@ func (m *DelegationDelegatorReward) Reset()         { *m = DelegationDelegatorRe
 func (m *DelegationDelegatorReward) String() string { return proto.CompactTextString(m) }
 func (*DelegationDelegatorReward) ProtoMessage()    {}
 func (*DelegationDelegatorReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{10}
+	return fileDescriptor_cd78a31ea281a992, []int{12}
 }
 func (m *DelegationDelegatorReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -608,7 +812,7 @@ func (m *CommunityPoolSpendProposalWithDeposit) Reset()         { *m = Community
 func (m *CommunityPoolSpendProposalWithDeposit) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolSpendProposalWithDeposit) ProtoMessage()    {}
 func (*CommunityPoolSpendProposalWithDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{11}
+	return fileDescriptor_cd78a31ea281a992, []int{13}
 }
 func (m *CommunityPoolSpendProposalWithDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -637,168 +841,494 @@ func (m *CommunityPoolSpendProposalWithDeposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
-	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
-	proto.RegisterType((*ValidatorCurrentRewards)(nil), "cosmos.distribution.v1beta1.ValidatorCurrentRewards")
-	proto.RegisterType((*ValidatorAccumulatedCommission)(nil), "cosmos.distribution.v1beta1.ValidatorAccumulatedCommission")
-	proto.RegisterType((*ValidatorOutstandingRewards)(nil), "cosmos.distribution.v1beta1.ValidatorOutstandingRewards")
-	proto.RegisterType((*ValidatorSlashEvent)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEvent")
-	proto.RegisterType((*ValidatorSlashEvents)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEvents")
-	proto.RegisterType((*FeePool)(nil), "cosmos.distribution.v1beta1.FeePool")
-	proto.RegisterType((*CommunityPoolSpendProposal)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposal")
-	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
-	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
-	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
+// RewardEvent records a withdrawal of delegation rewards, for the export of the
+// reward history of a delegator.
+type RewardEvent struct {
+	// id is the unique identifier of the event, increasing with time.
+	Id               uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DelegatorAddress string `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// withdraw_address is the address the rewards were sent to.
+	WithdrawAddress string                                   `protobuf:"bytes,4,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+	Amount          github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// height and time are the block height and time of the withdrawal.
+	Height int64     `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Time   time.Time `protobuf:"bytes,7,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *RewardEvent) Reset()         { *m = RewardEvent{} }
+func (m *RewardEvent) String() string { return proto.CompactTextString(m) }
+func (*RewardEvent) ProtoMessage()    {}
+func (*RewardEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{14}
+}
+func (m *RewardEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func init() {
-	proto.RegisterFile("cosmos/distribution/v1beta1/distribution.proto", fileDescriptor_cd78a31ea281a992)
+func (m *RewardEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1044 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xe4, 0x87, 0xd3, 0x4c, 0xd2, 0x84, 0x4e, 0x7e, 0xd4, 0x71, 0x8b, 0x9d, 0xae, 0xa8,
-	0x08, 0x81, 0xd8, 0xa4, 0x95, 0x10, 0xf2, 0x05, 0x35, 0x76, 0x2b, 0x90, 0x0a, 0x44, 0x1b, 0x04,
-	0x12, 0x1c, 0x56, 0xe3, 0xdd, 0x89, 0x3d, 0x64, 0x77, 0x66, 0x99, 0x19, 0x3b, 0xc9, 0x09, 0x8e,
-	0x85, 0x03, 0x70, 0x03, 0xf5, 0x54, 0xc1, 0xa5, 0xe2, 0x94, 0x43, 0xfe, 0x88, 0x8a, 0x53, 0x55,
-	0x01, 0x42, 0x1c, 0x02, 0x24, 0x87, 0x20, 0xfe, 0x0a, 0x34, 0x3b, 0xe3, 0x5d, 0x27, 0x04, 0x14,
-	0x82, 0xa2, 0x5e, 0x2c, 0xcf, 0x7b, 0xb3, 0xef, 0xfb, 0xbe, 0x37, 0xef, 0xbd, 0x19, 0x58, 0xf1,
-	0xb9, 0x8c, 0xb8, 0xac, 0x06, 0x54, 0x2a, 0x41, 0x9b, 0x1d, 0x45, 0x39, 0xab, 0x76, 0x97, 0x9b,
-	0x44, 0xe1, 0xe5, 0x23, 0xc6, 0x4a, 0x2c, 0xb8, 0xe2, 0xe8, 0x8a, 0xd9, 0x5f, 0x39, 0xe2, 0xb2,
-	0xfb, 0x8b, 0xd3, 0x2d, 0xde, 0xe2, 0xc9, 0xbe, 0xaa, 0xfe, 0x67, 0x3e, 0x29, 0x96, 0x2c, 0x44,
-	0x13, 0x4b, 0x92, 0x86, 0xf6, 0x39, 0xb5, 0x21, 0x8b, 0x73, 0xc6, 0xef, 0x99, 0x0f, 0x6d, 0x7c,
-	0xe3, 0xba, 0x84, 0x23, 0xca, 0x78, 0x35, 0xf9, 0x35, 0x26, 0xe7, 0xfe, 0x20, 0xcc, 0xaf, 0x62,
-	0x81, 0x23, 0x89, 0x3e, 0x80, 0x17, 0x7d, 0x1e, 0x45, 0x1d, 0x46, 0xd5, 0xb6, 0xa7, 0xf0, 0x56,
-	0x01, 0xcc, 0x83, 0x85, 0xd1, 0x95, 0x57, 0x1e, 0xed, 0x95, 0x73, 0xbf, 0xec, 0x95, 0x2d, 0x55,
-	0x19, 0x6c, 0x54, 0x28, 0xaf, 0x46, 0x58, 0xb5, 0x2b, 0x77, 0x49, 0x0b, 0xfb, 0xdb, 0x0d, 0xe2,
-	0x3f, 0xd9, 0x5d, 0x82, 0x16, 0xa9, 0x41, 0xfc, 0x87, 0x87, 0x3b, 0x8b, 0xc0, 0x1d, 0x4f, 0x83,
-	0xbd, 0x83, 0xb7, 0xd0, 0x87, 0x70, 0x5a, 0x13, 0xd6, 0xac, 0x62, 0x2e, 0x89, 0xf0, 0x04, 0xd9,
-	0xc4, 0x22, 0x28, 0x0c, 0x24, 0x18, 0xaf, 0x9e, 0x0d, 0xa3, 0x00, 0x5c, 0xa4, 0xa3, 0xae, 0xda,
-	0xa0, 0x6e, 0x12, 0x13, 0x85, 0x70, 0xa6, 0xc9, 0x59, 0x47, 0xfe, 0x0d, 0x6c, 0xf0, 0x7f, 0x82,
-	0x4d, 0x25, 0x61, 0x8f, 0xa1, 0xdd, 0x80, 0x33, 0x9b, 0x54, 0xb5, 0x03, 0x81, 0x37, 0x3d, 0x1c,
-	0x04, 0xc2, 0x23, 0x0c, 0x37, 0x43, 0x12, 0x14, 0x86, 0xe6, 0xc1, 0xc2, 0x05, 0x77, 0xaa, 0xe7,
-	0xbc, 0x15, 0x04, 0xe2, 0xb6, 0x71, 0xd5, 0xae, 0x7f, 0x76, 0xb8, 0xb3, 0x38, 0x6f, 0x00, 0x96,
-	0x64, 0xb0, 0x51, 0xdd, 0x3a, 0x5a, 0x31, 0xe6, 0x44, 0x9c, 0x9f, 0x00, 0x2c, 0xbe, 0x8b, 0x43,
-	0x1a, 0x60, 0xc5, 0xc5, 0xeb, 0x54, 0x2a, 0x2e, 0xa8, 0x8f, 0x43, 0x03, 0x2c, 0xd1, 0xe7, 0x00,
-	0x5e, 0xf6, 0x3b, 0x51, 0x27, 0xc4, 0x8a, 0x76, 0x89, 0x15, 0xe9, 0x09, 0xac, 0x28, 0x2f, 0x80,
-	0xf9, 0xc1, 0x85, 0xb1, 0x1b, 0x57, 0x6d, 0x3d, 0x56, 0x74, 0x96, 0x7a, 0x75, 0xa5, 0x15, 0xd5,
-	0x39, 0x65, 0x26, 0x11, 0xdf, 0xfd, 0x5a, 0x7e, 0xb1, 0x45, 0x55, 0xbb, 0xd3, 0xac, 0xf8, 0x3c,
-	0xb2, 0xf5, 0x52, 0xed, 0xa3, 0xa6, 0xb6, 0x63, 0x22, 0x7b, 0xdf, 0x48, 0x73, 0xb6, 0x33, 0x19,
-	0xac, 0x21, 0xe3, 0x6a, 0x50, 0xf4, 0x3c, 0x9c, 0x14, 0x64, 0x9d, 0x08, 0xc2, 0x7c, 0xe2, 0xf9,
-	0xbc, 0xc3, 0x54, 0x72, 0xbe, 0x17, 0xdd, 0x89, 0xd4, 0x5c, 0xd7, 0x56, 0xe7, 0x5b, 0x00, 0x2f,
-	0xa7, 0xc2, 0xea, 0x1d, 0x21, 0x08, 0x53, 0x3d, 0x55, 0x31, 0x1c, 0x31, 0x4a, 0xe4, 0x39, 0x8b,
-	0xe8, 0xc1, 0xa0, 0x59, 0x98, 0x8f, 0x89, 0xa0, 0xdc, 0x54, 0xe3, 0x90, 0x6b, 0x57, 0xce, 0xd7,
-	0x00, 0x96, 0x52, 0x96, 0xb7, 0x7c, 0xab, 0x99, 0x04, 0x75, 0x1e, 0x45, 0x54, 0x4a, 0xca, 0x19,
-	0xea, 0x42, 0xe8, 0xa7, 0xab, 0x73, 0xe6, 0xdb, 0x87, 0xe4, 0x7c, 0x01, 0xe0, 0x95, 0x94, 0xda,
-	0xdb, 0x1d, 0x25, 0x15, 0x66, 0x01, 0x65, 0xad, 0xa7, 0x96, 0x44, 0xcd, 0x68, 0x2a, 0x65, 0xb4,
-	0x16, 0x62, 0xd9, 0xbe, 0xdd, 0x25, 0x4c, 0xa1, 0x17, 0xe0, 0x33, 0xdd, 0x9e, 0xd9, 0xb3, 0x69,
-	0x06, 0x49, 0x9a, 0x27, 0x53, 0xfb, 0x6a, 0x62, 0x46, 0x6f, 0xc2, 0x0b, 0xeb, 0x02, 0xfb, 0xba,
-	0x03, 0xec, 0x5c, 0x58, 0xfe, 0xcf, 0xad, 0xea, 0xa6, 0x21, 0x9c, 0x4f, 0x01, 0x9c, 0x3e, 0x81,
-	0x91, 0x44, 0x1f, 0xc1, 0xd9, 0x8c, 0x92, 0xd4, 0x0e, 0x8f, 0x24, 0x1e, 0x9b, 0xab, 0x97, 0x2b,
-	0xff, 0x32, 0x95, 0x2b, 0x27, 0x84, 0x5c, 0x19, 0xd5, 0x3c, 0x4d, 0x42, 0xa6, 0xbb, 0x27, 0x40,
-	0x3a, 0x9f, 0x0c, 0xc0, 0x91, 0x3b, 0x84, 0xac, 0x72, 0x1e, 0xa2, 0x8f, 0xe1, 0x44, 0x36, 0x67,
-	0x63, 0xce, 0xc3, 0x53, 0x1d, 0x51, 0xed, 0xac, 0x47, 0x54, 0x00, 0x6e, 0x36, 0xd7, 0x13, 0x02,
-	0x0a, 0x8e, 0x07, 0xc4, 0xa7, 0x11, 0x0e, 0x0d, 0xfc, 0xc0, 0x29, 0xe0, 0x6f, 0x9e, 0x01, 0xde,
-	0x1d, 0xb3, 0x30, 0x1a, 0xd5, 0xf9, 0x6a, 0x00, 0x16, 0xeb, 0xfd, 0x3c, 0xd6, 0x62, 0xc2, 0x02,
-	0x33, 0x4c, 0x71, 0x88, 0xa6, 0xe1, 0xb0, 0xa2, 0x2a, 0x24, 0xe6, 0xd6, 0x71, 0xcd, 0x02, 0xcd,
-	0xc3, 0xb1, 0x80, 0x48, 0x5f, 0xd0, 0x38, 0xab, 0x0a, 0xb7, 0xdf, 0x84, 0xae, 0xc2, 0x51, 0x41,
-	0x7c, 0x1a, 0x53, 0xc2, 0x94, 0x19, 0xf0, 0x6e, 0x66, 0x40, 0xdb, 0x30, 0x8f, 0xa3, 0x64, 0x10,
-	0x0d, 0x25, 0x22, 0xe7, 0x4e, 0x14, 0x99, 0x28, 0xbc, 0x63, 0x15, 0x2e, 0x9c, 0x42, 0x61, 0x22,
-	0xef, 0xfe, 0xe1, 0xce, 0xe2, 0x78, 0x98, 0x94, 0xa1, 0xe7, 0x67, 0x1d, 0x61, 0x01, 0x6b, 0x0b,
-	0xf7, 0x1e, 0x94, 0x73, 0x7f, 0x3c, 0x28, 0xe7, 0xbe, 0xdf, 0x5d, 0x2a, 0x5a, 0xd4, 0x16, 0xef,
-	0xf6, 0x81, 0x32, 0xa5, 0x39, 0x03, 0xe7, 0x07, 0x00, 0x67, 0x1a, 0x44, 0x47, 0xd2, 0x55, 0xa3,
-	0xb0, 0x50, 0x94, 0xb5, 0xde, 0x60, 0xeb, 0xc9, 0x40, 0x8d, 0x05, 0xe9, 0x52, 0xae, 0x2f, 0xb3,
-	0xfe, 0xde, 0x99, 0xe8, 0x99, 0x6d, 0xeb, 0xdc, 0x85, 0xc3, 0x52, 0xe1, 0x0d, 0x62, 0xfb, 0xe6,
-	0xac, 0x77, 0xb6, 0x09, 0x82, 0x1a, 0x30, 0xdf, 0x26, 0xb4, 0xd5, 0x36, 0x09, 0x1d, 0x5a, 0x79,
-	0xe9, 0xcf, 0xbd, 0xf2, 0xa4, 0x2f, 0x88, 0x1e, 0xf2, 0xcc, 0x33, 0xae, 0x6f, 0x0e, 0x77, 0x16,
-	0x8f, 0xdb, 0x6c, 0x02, 0xcc, 0xc2, 0xf9, 0x1d, 0xc0, 0x39, 0x2b, 0x8b, 0x72, 0x96, 0x0a, 0xb4,
-	0xd7, 0xe6, 0x5b, 0xf0, 0x52, 0xd6, 0x84, 0xfa, 0xde, 0x24, 0x52, 0xda, 0x17, 0xc7, 0xb5, 0x27,
-	0xbb, 0x4b, 0xcf, 0x5a, 0x6a, 0xd9, 0xfc, 0x35, 0x5b, 0xd6, 0x94, 0xd0, 0x63, 0x2e, 0x9b, 0x29,
-	0xd6, 0x8e, 0x18, 0xcc, 0xa7, 0x4f, 0x8a, 0xf3, 0x1c, 0x78, 0x16, 0xa5, 0x36, 0xa4, 0x8f, 0xd7,
-	0xf9, 0x11, 0xc0, 0xeb, 0xff, 0x5c, 0xd4, 0xef, 0x51, 0xd5, 0x6e, 0x90, 0x98, 0x4b, 0xaa, 0xce,
-	0xa9, 0xbe, 0x67, 0xfb, 0xea, 0x5b, 0xbb, 0xec, 0x0a, 0x15, 0xe0, 0x48, 0x60, 0x80, 0x0b, 0xc3,
-	0x89, 0xa3, 0xb7, 0xac, 0x3d, 0x77, 0xef, 0x14, 0x25, 0xb9, 0xf2, 0xda, 0xc3, 0xfd, 0x12, 0x78,
-	0xb4, 0x5f, 0x02, 0x8f, 0xf7, 0x4b, 0xe0, 0xb7, 0xfd, 0x12, 0xf8, 0xf2, 0xa0, 0x94, 0x7b, 0x7c,
-	0x50, 0xca, 0xfd, 0x7c, 0x50, 0xca, 0xbd, 0x7f, 0xed, 0x48, 0x59, 0x1d, 0x7b, 0xbb, 0x24, 0x49,
-	0x6b, 0xe6, 0x93, 0xe7, 0xe5, 0xcd, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x08, 0x33, 0x8d,
-	0x11, 0x0b, 0x00, 0x00,
+func (m *RewardEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardEvent.Merge(m, src)
+}
+func (m *RewardEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardEvent.DiscardUnknown(m)
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+var xxx_messageInfo_RewardEvent proto.InternalMessageInfo
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *RewardEvent) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *RewardEvent) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	if !this.CommunityTax.Equal(that1.CommunityTax) {
-		return false
+	return ""
+}
+
+func (m *RewardEvent) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	if !this.BaseProposerReward.Equal(that1.BaseProposerReward) {
-		return false
+	return ""
+}
+
+func (m *RewardEvent) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
 	}
-	if !this.BonusProposerReward.Equal(that1.BonusProposerReward) {
-		return false
+	return ""
+}
+
+func (m *RewardEvent) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
-		return false
+	return nil
+}
+
+func (m *RewardEvent) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return true
+	return 0
 }
-func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *RewardEvent) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
+	return time.Time{}
+}
 
-	that1, ok := that.(*ValidatorHistoricalRewards)
-	if !ok {
-		that2, ok := that.(ValidatorHistoricalRewards)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// ExternalRewards records the rewards added to a validator by an external
+// reward source, such as a MEV auction or bridge fees, since the validator was
+// created.
+type ExternalRewards struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// source is the name of the external reward source.
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// amount is the total amount of rewards added by the source.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *ExternalRewards) Reset()         { *m = ExternalRewards{} }
+func (m *ExternalRewards) String() string { return proto.CompactTextString(m) }
+func (*ExternalRewards) ProtoMessage()    {}
+func (*ExternalRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{15}
+}
+func (m *ExternalRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExternalRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExternalRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.CumulativeRewardRatio) != len(that1.CumulativeRewardRatio) {
-		return false
-	}
-	for i := range this.CumulativeRewardRatio {
-		if !this.CumulativeRewardRatio[i].Equal(&that1.CumulativeRewardRatio[i]) {
-			return false
-		}
+}
+func (m *ExternalRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalRewards.Merge(m, src)
+}
+func (m *ExternalRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExternalRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalRewards proto.InternalMessageInfo
+
+func (m *ExternalRewards) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	if this.ReferenceCount != that1.ReferenceCount {
-		return false
+	return ""
+}
+
+func (m *ExternalRewards) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	return true
+	return ""
 }
-func (this *ValidatorCurrentRewards) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ExternalRewards) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
+	return nil
+}
 
-	that1, ok := that.(*ValidatorCurrentRewards)
+// CommissionSplit defines how the commission of a validator is split between
+// payout addresses on withdrawal.
+type CommissionSplit struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// recipients are the payout addresses, with weights adding up to one.
+	Recipients []CommissionSplitRecipient `protobuf:"bytes,2,rep,name=recipients,proto3" json:"recipients"`
+}
+
+func (m *CommissionSplit) Reset()         { *m = CommissionSplit{} }
+func (m *CommissionSplit) String() string { return proto.CompactTextString(m) }
+func (*CommissionSplit) ProtoMessage()    {}
+func (*CommissionSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{16}
+}
+func (m *CommissionSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommissionSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionSplit.Merge(m, src)
+}
+func (m *CommissionSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionSplit proto.InternalMessageInfo
+
+func (m *CommissionSplit) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *CommissionSplit) GetRecipients() []CommissionSplitRecipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+// CommissionSplitRecipient defines a payout address of a commission split.
+type CommissionSplitRecipient struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// weight is the share of the withdrawn commission sent to the address.
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+}
+
+func (m *CommissionSplitRecipient) Reset()         { *m = CommissionSplitRecipient{} }
+func (m *CommissionSplitRecipient) String() string { return proto.CompactTextString(m) }
+func (*CommissionSplitRecipient) ProtoMessage()    {}
+func (*CommissionSplitRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{17}
+}
+func (m *CommissionSplitRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionSplitRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionSplitRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommissionSplitRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionSplitRecipient.Merge(m, src)
+}
+func (m *CommissionSplitRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionSplitRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionSplitRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionSplitRecipient proto.InternalMessageInfo
+
+func (m *CommissionSplitRecipient) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// ValidatorHistoricalRewardsStats reports the historical rewards records and
+// slash events stored for a validator.
+type ValidatorHistoricalRewardsStats struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// historical_rewards is the number of historical rewards records.
+	HistoricalRewards uint64 `protobuf:"varint,2,opt,name=historical_rewards,json=historicalRewards,proto3" json:"historical_rewards,omitempty"`
+	// reference_count is the sum of the reference counts of the records.
+	ReferenceCount uint64 `protobuf:"varint,3,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty"`
+	// slash_events is the number of slash events.
+	SlashEvents uint64 `protobuf:"varint,4,opt,name=slash_events,json=slashEvents,proto3" json:"slash_events,omitempty"`
+	// prunable_slash_events is the number of slash events which no delegation
+	// depends on anymore, removed by the next pruning pass.
+	PrunableSlashEvents uint64 `protobuf:"varint,5,opt,name=prunable_slash_events,json=prunableSlashEvents,proto3" json:"prunable_slash_events,omitempty"`
+}
+
+func (m *ValidatorHistoricalRewardsStats) Reset()         { *m = ValidatorHistoricalRewardsStats{} }
+func (m *ValidatorHistoricalRewardsStats) String() string { return proto.CompactTextString(m) }
+func (*ValidatorHistoricalRewardsStats) ProtoMessage()    {}
+func (*ValidatorHistoricalRewardsStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{18}
+}
+func (m *ValidatorHistoricalRewardsStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorHistoricalRewardsStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorHistoricalRewardsStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorHistoricalRewardsStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorHistoricalRewardsStats.Merge(m, src)
+}
+func (m *ValidatorHistoricalRewardsStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorHistoricalRewardsStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorHistoricalRewardsStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorHistoricalRewardsStats proto.InternalMessageInfo
+
+func (m *ValidatorHistoricalRewardsStats) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorHistoricalRewardsStats) GetHistoricalRewards() uint64 {
+	if m != nil {
+		return m.HistoricalRewards
+	}
+	return 0
+}
+
+func (m *ValidatorHistoricalRewardsStats) GetReferenceCount() uint64 {
+	if m != nil {
+		return m.ReferenceCount
+	}
+	return 0
+}
+
+func (m *ValidatorHistoricalRewardsStats) GetSlashEvents() uint64 {
+	if m != nil {
+		return m.SlashEvents
+	}
+	return 0
+}
+
+func (m *ValidatorHistoricalRewardsStats) GetPrunableSlashEvents() uint64 {
+	if m != nil {
+		return m.PrunableSlashEvents
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.distribution.v1beta1.RoundingPolicy", RoundingPolicy_name, RoundingPolicy_value)
+	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
+	proto.RegisterType((*TruncationDust)(nil), "cosmos.distribution.v1beta1.TruncationDust")
+	proto.RegisterType((*PendingRewardAllocation)(nil), "cosmos.distribution.v1beta1.PendingRewardAllocation")
+	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
+	proto.RegisterType((*ValidatorCurrentRewards)(nil), "cosmos.distribution.v1beta1.ValidatorCurrentRewards")
+	proto.RegisterType((*ValidatorAccumulatedCommission)(nil), "cosmos.distribution.v1beta1.ValidatorAccumulatedCommission")
+	proto.RegisterType((*ValidatorOutstandingRewards)(nil), "cosmos.distribution.v1beta1.ValidatorOutstandingRewards")
+	proto.RegisterType((*ValidatorSlashEvent)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEvent")
+	proto.RegisterType((*ValidatorSlashEvents)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEvents")
+	proto.RegisterType((*FeePool)(nil), "cosmos.distribution.v1beta1.FeePool")
+	proto.RegisterType((*CommunityPoolSpendProposal)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposal")
+	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
+	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
+	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
+	proto.RegisterType((*RewardEvent)(nil), "cosmos.distribution.v1beta1.RewardEvent")
+	proto.RegisterType((*ExternalRewards)(nil), "cosmos.distribution.v1beta1.ExternalRewards")
+	proto.RegisterType((*CommissionSplit)(nil), "cosmos.distribution.v1beta1.CommissionSplit")
+	proto.RegisterType((*CommissionSplitRecipient)(nil), "cosmos.distribution.v1beta1.CommissionSplitRecipient")
+	proto.RegisterType((*ValidatorHistoricalRewardsStats)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewardsStats")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/distribution/v1beta1/distribution.proto", fileDescriptor_cd78a31ea281a992)
+}
+
+var fileDescriptor_cd78a31ea281a992 = []byte{
+	// 1632 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcb, 0x6f, 0x1b, 0xc7,
+	0x19, 0xe7, 0x92, 0x14, 0x65, 0x7d, 0x72, 0x28, 0x7b, 0x2c, 0xd9, 0x34, 0xe3, 0x90, 0xf4, 0xb6,
+	0x41, 0x55, 0xa5, 0x22, 0x6b, 0x05, 0x09, 0x02, 0x5d, 0x0a, 0x89, 0x94, 0x53, 0xa3, 0x8e, 0x44,
+	0xac, 0xe8, 0x16, 0x6d, 0x0f, 0xdb, 0xe5, 0xee, 0x88, 0x9c, 0x7a, 0xb9, 0xb3, 0xdd, 0x9d, 0xa5,
+	0xad, 0x53, 0x0b, 0xf4, 0xe2, 0xfa, 0xd0, 0xe6, 0xd4, 0x17, 0x10, 0xc0, 0x68, 0x2e, 0x41, 0x4f,
+	0x3e, 0xb8, 0xb7, 0xde, 0x7a, 0x09, 0x0a, 0x14, 0x08, 0x8c, 0xb6, 0x28, 0x7a, 0x70, 0x5a, 0xe9,
+	0xa0, 0xa2, 0x7f, 0x45, 0x31, 0x8f, 0xdd, 0x25, 0x69, 0xc9, 0x51, 0x14, 0xa9, 0xbe, 0x10, 0x9c,
+	0x99, 0xef, 0x3d, 0xbf, 0x6f, 0xe6, 0x37, 0x0b, 0x75, 0x9b, 0x86, 0x03, 0x1a, 0x36, 0x1c, 0x12,
+	0xb2, 0x80, 0x74, 0x23, 0x46, 0xa8, 0xd7, 0x18, 0xde, 0xe8, 0x62, 0x66, 0xdd, 0x18, 0x9b, 0xac,
+	0xfb, 0x01, 0x65, 0x14, 0xbd, 0x2a, 0xe5, 0xeb, 0x63, 0x4b, 0x4a, 0xbe, 0x3c, 0xdf, 0xa3, 0x3d,
+	0x2a, 0xe4, 0x1a, 0xfc, 0x9f, 0x54, 0x29, 0x57, 0x94, 0x8b, 0xae, 0x15, 0xe2, 0xc4, 0xb4, 0x4d,
+	0x89, 0x32, 0x59, 0xbe, 0x2a, 0xd7, 0x4d, 0xa9, 0xa8, 0xec, 0xcb, 0xa5, 0x8b, 0xd6, 0x80, 0x78,
+	0xb4, 0x21, 0x7e, 0xd5, 0x54, 0xb5, 0x47, 0x69, 0xcf, 0xc5, 0x0d, 0x31, 0xea, 0x46, 0x3b, 0x0d,
+	0x46, 0x06, 0x38, 0x64, 0xd6, 0xc0, 0x97, 0x02, 0xfa, 0x1f, 0xa7, 0xa0, 0xd0, 0xb6, 0x02, 0x6b,
+	0x10, 0xa2, 0xef, 0xc3, 0x2b, 0x36, 0x1d, 0x0c, 0x22, 0x8f, 0xb0, 0x5d, 0x93, 0x59, 0xf7, 0x4b,
+	0x5a, 0x4d, 0x5b, 0x9c, 0x59, 0x7f, 0xfb, 0xe3, 0x67, 0xd5, 0xcc, 0x3f, 0x9f, 0x55, 0x55, 0x2e,
+	0xa1, 0x73, 0xb7, 0x4e, 0x68, 0x63, 0x60, 0xb1, 0x7e, 0xfd, 0x36, 0xee, 0x59, 0xf6, 0x6e, 0x0b,
+	0xdb, 0x4f, 0x9f, 0x2c, 0x83, 0x0a, 0xa5, 0x85, 0xed, 0x8f, 0x0e, 0x1e, 0x2f, 0x69, 0xc6, 0xf9,
+	0xc4, 0x58, 0xc7, 0xba, 0x8f, 0x7e, 0x08, 0xf3, 0x3c, 0x23, 0x1e, 0xb6, 0x4f, 0x43, 0x1c, 0x98,
+	0x01, 0xbe, 0x67, 0x05, 0x4e, 0x29, 0x2b, 0x7c, 0xbc, 0x73, 0x32, 0x1f, 0x25, 0xcd, 0x40, 0xdc,
+	0x6a, 0x5b, 0x19, 0x35, 0x84, 0x4d, 0xe4, 0xc2, 0x42, 0x97, 0x7a, 0x51, 0xf8, 0x9c, 0xb3, 0xdc,
+	0x17, 0x74, 0x76, 0x49, 0x98, 0x9d, 0xf0, 0xb6, 0x02, 0x0b, 0xf7, 0x08, 0xeb, 0x3b, 0x81, 0x75,
+	0xcf, 0xb4, 0x1c, 0x27, 0x30, 0xb1, 0x67, 0x75, 0x5d, 0xec, 0x94, 0xf2, 0x35, 0x6d, 0xf1, 0x9c,
+	0x71, 0x29, 0x5e, 0x5c, 0x73, 0x9c, 0x60, 0x43, 0x2e, 0x71, 0x1d, 0x19, 0x92, 0x89, 0x87, 0xd8,
+	0x63, 0x61, 0xa2, 0x33, 0x25, 0x75, 0xe4, 0xe2, 0x86, 0x58, 0x8b, 0x75, 0xde, 0x83, 0x2f, 0xf5,
+	0x49, 0xc8, 0x68, 0x40, 0x6c, 0xcb, 0x55, 0x19, 0xf1, 0x14, 0x23, 0x8f, 0x78, 0x3d, 0x93, 0x78,
+	0x0c, 0x07, 0x43, 0xcb, 0x2d, 0x15, 0x6a, 0xda, 0x62, 0xde, 0xa8, 0xa5, 0xa2, 0x32, 0xcc, 0xb0,
+	0x2d, 0x05, 0x6f, 0x29, 0x39, 0xf4, 0x2e, 0xd4, 0x54, 0x08, 0x96, 0xeb, 0x52, 0xdb, 0xe2, 0xd0,
+	0x34, 0x77, 0xdc, 0x28, 0xec, 0xa7, 0xb6, 0xa6, 0x85, 0xad, 0xd7, 0xa4, 0xdc, 0x5a, 0x22, 0x76,
+	0x93, 0x4b, 0x25, 0x86, 0x3a, 0x30, 0x17, 0xd0, 0xc8, 0x73, 0x78, 0x14, 0x3e, 0x75, 0x89, 0xbd,
+	0x5b, 0x3a, 0x57, 0xd3, 0x16, 0x8b, 0x2b, 0x6f, 0xd4, 0x5f, 0x80, 0xfe, 0xba, 0xa1, 0x74, 0xda,
+	0x42, 0xc5, 0x28, 0x06, 0x63, 0xe3, 0xd5, 0xd7, 0x1f, 0x1e, 0x3c, 0x5e, 0xaa, 0x49, 0x03, 0xcb,
+	0xa1, 0x73, 0xb7, 0x71, 0x7f, 0xbc, 0xe9, 0x24, 0x66, 0xf5, 0xbf, 0x64, 0xa1, 0xd8, 0x09, 0x22,
+	0x4f, 0x06, 0xd6, 0x8a, 0x42, 0x86, 0x36, 0xe1, 0xe2, 0xd0, 0x72, 0x89, 0x63, 0x31, 0x1a, 0x88,
+	0x0d, 0xc1, 0x61, 0xa8, 0xa0, 0x7c, 0xfd, 0xe9, 0x93, 0xe5, 0xd7, 0x54, 0x50, 0xdf, 0x8e, 0x65,
+	0xd6, 0xa4, 0xc8, 0x36, 0x0b, 0x88, 0xd7, 0x33, 0x2e, 0x0c, 0x27, 0xe6, 0x11, 0x83, 0x19, 0x26,
+	0x3d, 0x60, 0x0e, 0xd7, 0xdc, 0xe2, 0xec, 0xca, 0xb5, 0x38, 0x33, 0x0e, 0xbe, 0x24, 0xa3, 0x16,
+	0xb6, 0x9b, 0x94, 0x78, 0x12, 0x5f, 0xbf, 0xff, 0xb4, 0xfa, 0x46, 0x8f, 0xb0, 0x7e, 0xd4, 0xad,
+	0xdb, 0x74, 0xa0, 0xfa, 0xb4, 0x31, 0x92, 0x0f, 0xdb, 0xf5, 0x71, 0x18, 0xeb, 0x84, 0xb2, 0x65,
+	0x52, 0x47, 0x28, 0x02, 0x10, 0x15, 0xc1, 0x8e, 0x19, 0xf9, 0xa5, 0xdc, 0xd9, 0xba, 0x55, 0x9e,
+	0xee, 0xf8, 0xfa, 0x4f, 0xb3, 0x70, 0xa5, 0x8d, 0xc5, 0x46, 0x18, 0x13, 0xbb, 0x8e, 0x86, 0x00,
+	0xbc, 0xa5, 0x49, 0x18, 0x12, 0xea, 0x95, 0xb4, 0x33, 0x0d, 0x69, 0xc4, 0x13, 0xf2, 0x61, 0x5a,
+	0xa1, 0xfd, 0x8c, 0xcb, 0x1f, 0xbb, 0xd1, 0xff, 0xae, 0x41, 0x39, 0xc1, 0xc7, 0x37, 0x27, 0x3b,
+	0x09, 0xfd, 0x5c, 0x83, 0x2b, 0x76, 0x34, 0x88, 0x5c, 0x8b, 0x91, 0x21, 0x56, 0xad, 0x68, 0x06,
+	0xbc, 0x4a, 0x67, 0x5c, 0x96, 0x85, 0xd4, 0xad, 0x0c, 0xc6, 0xe0, 0x4e, 0xd1, 0x57, 0x60, 0x2e,
+	0xc0, 0x3b, 0x38, 0xc0, 0x9e, 0x8d, 0x4d, 0x9b, 0x46, 0x1e, 0x13, 0xe7, 0xea, 0x2b, 0x46, 0x31,
+	0x99, 0x6e, 0xf2, 0x59, 0xfd, 0x43, 0x0d, 0xae, 0x24, 0x89, 0x35, 0xa3, 0x20, 0xc0, 0x1e, 0x8b,
+	0xb3, 0x1a, 0x29, 0xb3, 0xf6, 0x7f, 0x29, 0x33, 0xba, 0x0c, 0x05, 0x1f, 0x07, 0x84, 0xca, 0x5b,
+	0x20, 0x6f, 0xa8, 0x91, 0xfe, 0x6b, 0x0d, 0x2a, 0x69, 0x7b, 0xda, 0x2a, 0x67, 0xec, 0x34, 0x53,
+	0x4c, 0xbc, 0x24, 0x2c, 0xea, 0xbf, 0xd0, 0xe0, 0xd5, 0x24, 0xb4, 0xad, 0x88, 0x85, 0xcc, 0x1a,
+	0x69, 0x96, 0x97, 0x50, 0x44, 0x1e, 0xd1, 0xa5, 0x24, 0xa2, 0x6d, 0xd7, 0x0a, 0xfb, 0xe2, 0xda,
+	0x40, 0x5f, 0x85, 0xf4, 0x28, 0x33, 0x55, 0x99, 0x35, 0x51, 0xe6, 0xb9, 0x64, 0xbe, 0x2d, 0xa6,
+	0xd1, 0x7b, 0x70, 0x6e, 0x27, 0xb0, 0x6c, 0xde, 0xe4, 0xea, 0x3e, 0xbe, 0xf1, 0xb9, 0xaf, 0x48,
+	0x23, 0x31, 0xa1, 0xff, 0x4c, 0x83, 0xf9, 0x43, 0x22, 0x0a, 0xd1, 0x8f, 0xe0, 0x72, 0x1a, 0x52,
+	0xc8, 0x17, 0xd4, 0xf5, 0xa7, 0x6a, 0xf5, 0xf5, 0x17, 0x5e, 0x18, 0x87, 0x98, 0x5c, 0x9f, 0xe1,
+	0x71, 0xca, 0x82, 0xcc, 0x0f, 0x0f, 0x71, 0xa9, 0xff, 0x24, 0x0b, 0xd3, 0x37, 0x31, 0x6e, 0x53,
+	0xea, 0xa2, 0x1f, 0x43, 0x31, 0xe5, 0x37, 0x3e, 0xa5, 0xee, 0xb1, 0xb6, 0x68, 0xf5, 0xa4, 0x5b,
+	0x54, 0xd2, 0x8c, 0x94, 0x4f, 0x89, 0x00, 0x18, 0x9c, 0x77, 0xb0, 0x4d, 0x06, 0x96, 0x2b, 0xdd,
+	0x1f, 0xe7, 0x34, 0x7b, 0xf3, 0x04, 0xee, 0x8d, 0x59, 0xe5, 0x86, 0x7b, 0xd5, 0x7f, 0x95, 0x85,
+	0x72, 0x73, 0x34, 0x8e, 0x6d, 0x1f, 0x7b, 0x8e, 0x24, 0x31, 0x96, 0x8b, 0xe6, 0x61, 0x8a, 0x11,
+	0xe6, 0x62, 0x79, 0x45, 0x1a, 0x72, 0x80, 0x6a, 0x30, 0xeb, 0xe0, 0xd0, 0x0e, 0x88, 0x9f, 0xa2,
+	0xc2, 0x18, 0x9d, 0x42, 0xd7, 0x60, 0x26, 0xc0, 0x36, 0xf1, 0x09, 0xf6, 0x98, 0x24, 0x56, 0x46,
+	0x3a, 0x81, 0x76, 0xa1, 0x60, 0x0d, 0xc4, 0x41, 0x94, 0x17, 0x49, 0x5e, 0x3d, 0x34, 0x49, 0x91,
+	0xe1, 0x4d, 0x95, 0xe1, 0xe2, 0x31, 0x32, 0x14, 0xe9, 0xfd, 0xf6, 0xe0, 0xf1, 0xd2, 0x79, 0x57,
+	0xc0, 0xd0, 0xb4, 0xd3, 0x8e, 0x50, 0x0e, 0x57, 0x17, 0x1f, 0x3c, 0xaa, 0x66, 0xfe, 0xf3, 0xa8,
+	0x9a, 0xf9, 0xf3, 0x93, 0xe5, 0xb2, 0xf2, 0xda, 0xa3, 0xc3, 0x11, 0xa7, 0x1e, 0xe3, 0x31, 0x6b,
+	0xfa, 0x5f, 0x35, 0x58, 0x68, 0x61, 0x6e, 0x89, 0xa3, 0x86, 0x59, 0x01, 0x13, 0x04, 0x69, 0x47,
+	0x1c, 0xa8, 0x7e, 0x80, 0x87, 0x84, 0x72, 0x12, 0x39, 0xda, 0x3b, 0xc5, 0x78, 0x5a, 0xb5, 0xce,
+	0x6d, 0x98, 0x0a, 0x99, 0x75, 0x17, 0x97, 0xb2, 0x5f, 0x88, 0x2b, 0x4b, 0x23, 0xa8, 0x05, 0x85,
+	0x3e, 0x26, 0xbd, 0xbe, 0x2c, 0x68, 0x7e, 0xfd, 0x6b, 0xff, 0x7d, 0x56, 0x9d, 0xb3, 0x03, 0x2c,
+	0xc9, 0x99, 0x5c, 0xfa, 0xdd, 0xc1, 0xe3, 0xa5, 0xc9, 0x39, 0x55, 0x00, 0x39, 0xd0, 0xff, 0xad,
+	0xc1, 0x55, 0x95, 0x16, 0xe7, 0x44, 0x71, 0x82, 0x8a, 0xae, 0x9e, 0x36, 0x3d, 0xf2, 0xa0, 0x90,
+	0x50, 0xf9, 0xb3, 0x3c, 0xf0, 0x94, 0x97, 0xd5, 0x3c, 0xdf, 0x5e, 0xfd, 0x6f, 0x1a, 0xbc, 0x7e,
+	0x34, 0xa8, 0xbf, 0x43, 0x58, 0xbf, 0x85, 0x7d, 0x1a, 0x12, 0x76, 0x46, 0xf8, 0xbe, 0x3c, 0x82,
+	0x6f, 0xbe, 0xa4, 0x46, 0xa8, 0x04, 0xd3, 0x8e, 0x74, 0x2c, 0xa8, 0xfc, 0x8c, 0x11, 0x0f, 0x57,
+	0xbf, 0xfc, 0xe0, 0x38, 0x90, 0xfc, 0x43, 0x0e, 0x66, 0x8d, 0x94, 0xfc, 0xa3, 0x22, 0x64, 0x49,
+	0x8c, 0xbd, 0x2c, 0x71, 0xd0, 0x06, 0x5c, 0x74, 0xe2, 0x0d, 0x4d, 0x76, 0x4f, 0x62, 0xaf, 0xf4,
+	0xf4, 0xc9, 0xf2, 0xbc, 0x32, 0x3e, 0xb1, 0x69, 0x89, 0x4a, 0xbc, 0x69, 0x87, 0x82, 0x20, 0x77,
+	0x72, 0x10, 0x34, 0xe1, 0xc2, 0xd8, 0x1b, 0x88, 0x9b, 0xcb, 0x7f, 0x46, 0x54, 0x73, 0xa3, 0x0f,
+	0x23, 0x6e, 0xa4, 0x9f, 0xd4, 0x74, 0xea, 0xb3, 0xce, 0x8c, 0xb7, 0x3e, 0xef, 0x99, 0x31, 0x76,
+	0x44, 0xf0, 0xdd, 0x53, 0x7d, 0xc6, 0x5f, 0x4b, 0xb9, 0xb8, 0x73, 0xd0, 0x3b, 0x90, 0x67, 0x64,
+	0x80, 0xc5, 0xbb, 0x67, 0x76, 0xa5, 0x5c, 0x97, 0x8f, 0xe7, 0x7a, 0xfc, 0x78, 0xae, 0x77, 0xe2,
+	0xc7, 0xf3, 0xfa, 0x39, 0x1e, 0xc0, 0xfb, 0x9f, 0x56, 0x35, 0x43, 0x68, 0xe8, 0xfb, 0x1a, 0xcc,
+	0x6d, 0xdc, 0x67, 0x38, 0xf0, 0x52, 0x9a, 0x78, 0xda, 0x9d, 0x76, 0x19, 0x0a, 0x21, 0x8d, 0x02,
+	0x5b, 0x1d, 0x36, 0x86, 0x1a, 0x8d, 0xd4, 0x2d, 0x77, 0xb6, 0x75, 0xd3, 0xff, 0xa4, 0xc1, 0x5c,
+	0x4a, 0xc2, 0xb6, 0x7d, 0x97, 0x9c, 0xfe, 0x73, 0xeb, 0x07, 0x00, 0x49, 0x9b, 0xc5, 0x84, 0xff,
+	0xad, 0x17, 0x12, 0x83, 0x89, 0x88, 0x8c, 0x58, 0x7b, 0x94, 0x1d, 0x8c, 0xd8, 0xd4, 0x7f, 0xa3,
+	0x41, 0xe9, 0x28, 0x1d, 0xb4, 0x02, 0xd3, 0xe3, 0x49, 0x1c, 0x0d, 0xe0, 0x58, 0x10, 0xdd, 0x82,
+	0xc2, 0x3d, 0x09, 0xa7, 0x13, 0xb3, 0x27, 0x65, 0x40, 0x7f, 0x94, 0x85, 0xea, 0xd1, 0x2f, 0x8f,
+	0x6d, 0x66, 0xb1, 0xd3, 0xc7, 0xd5, 0x32, 0xa0, 0xe7, 0x3f, 0x2c, 0x28, 0x4a, 0x7e, 0xf1, 0xb9,
+	0xef, 0x08, 0x87, 0x3d, 0x36, 0x72, 0xf2, 0x6e, 0x1c, 0x7f, 0x6c, 0xa0, 0xeb, 0x70, 0x7e, 0x8c,
+	0xe4, 0xe5, 0x85, 0xd4, 0x6c, 0x38, 0xc2, 0x08, 0x57, 0x60, 0x81, 0x7f, 0xc0, 0xe0, 0x1f, 0x38,
+	0xc6, 0x09, 0xe1, 0x94, 0x90, 0xbd, 0x14, 0x2f, 0x8e, 0x50, 0xba, 0xa5, 0x5f, 0x6a, 0x50, 0x1c,
+	0xff, 0x78, 0x80, 0x6e, 0x42, 0xcd, 0xd8, 0xba, 0xb3, 0xd9, 0xba, 0xb5, 0xf9, 0xae, 0xd9, 0xde,
+	0xba, 0x7d, 0xab, 0xf9, 0x5d, 0xb3, 0x63, 0xdc, 0xd9, 0x6c, 0xae, 0x75, 0x36, 0xcc, 0xce, 0x96,
+	0xd9, 0xde, 0xda, 0xba, 0x7d, 0x21, 0x53, 0xae, 0x3d, 0xfc, 0xa0, 0x76, 0x6d, 0x5c, 0x53, 0x7d,
+	0x3a, 0xc0, 0x1d, 0x2a, 0x08, 0xda, 0xdb, 0x70, 0x65, 0xd2, 0xce, 0xfa, 0xda, 0xe6, 0xb7, 0x36,
+	0x8c, 0xed, 0x0b, 0x5a, 0xf9, 0xea, 0xc3, 0x0f, 0x6a, 0x0b, 0xe3, 0xea, 0xeb, 0x96, 0x77, 0x17,
+	0x07, 0x61, 0x39, 0xff, 0xe0, 0xc3, 0x4a, 0x66, 0xfd, 0x1b, 0x1f, 0xed, 0x55, 0xb4, 0x8f, 0xf7,
+	0x2a, 0xda, 0x27, 0x7b, 0x15, 0xed, 0x5f, 0x7b, 0x15, 0xed, 0xfd, 0xfd, 0x4a, 0xe6, 0x93, 0xfd,
+	0x4a, 0xe6, 0x1f, 0xfb, 0x95, 0xcc, 0xf7, 0xae, 0x8f, 0x81, 0x61, 0xe2, 0x6b, 0x86, 0xe8, 0xb8,
+	0x6e, 0x41, 0x1c, 0x34, 0x6f, 0xfe, 0x6f, 0x00, 0x51, 0x0d, 0x61, 0x4a, 0x66, 0x14, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
 	if !ok {
-		that2, ok := that.(ValidatorCurrentRewards)
+		that2, ok := that.(Params)
 		if ok {
 			that1 = &that2
 		} else {
@@ -810,27 +1340,40 @@ func (this *ValidatorCurrentRewards) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Rewards) != len(that1.Rewards) {
+	if !this.CommunityTax.Equal(that1.CommunityTax) {
 		return false
 	}
-	for i := range this.Rewards {
-		if !this.Rewards[i].Equal(&that1.Rewards[i]) {
-			return false
-		}
+	if !this.BaseProposerReward.Equal(that1.BaseProposerReward) {
+		return false
 	}
-	if this.Period != that1.Period {
+	if !this.BonusProposerReward.Equal(that1.BonusProposerReward) {
+		return false
+	}
+	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
+		return false
+	}
+	if this.RewardEventsEnabled != that1.RewardEventsEnabled {
+		return false
+	}
+	if this.HistoricalRewardsPruningInterval != that1.HistoricalRewardsPruningInterval {
+		return false
+	}
+	if this.RewardAllocationFlushInterval != that1.RewardAllocationFlushInterval {
+		return false
+	}
+	if this.RoundingPolicy != that1.RoundingPolicy {
 		return false
 	}
 	return true
 }
-func (this *ValidatorAccumulatedCommission) Equal(that interface{}) bool {
+func (this *TruncationDust) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ValidatorAccumulatedCommission)
+	that1, ok := that.(*TruncationDust)
 	if !ok {
-		that2, ok := that.(ValidatorAccumulatedCommission)
+		that2, ok := that.(TruncationDust)
 		if ok {
 			that1 = &that2
 		} else {
@@ -842,24 +1385,35 @@ func (this *ValidatorAccumulatedCommission) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Commission) != len(that1.Commission) {
+	if this.ValidatorAddress != that1.ValidatorAddress {
 		return false
 	}
-	for i := range this.Commission {
-		if !this.Commission[i].Equal(&that1.Commission[i]) {
+	if len(this.Truncated) != len(that1.Truncated) {
+		return false
+	}
+	for i := range this.Truncated {
+		if !this.Truncated[i].Equal(&that1.Truncated[i]) {
+			return false
+		}
+	}
+	if len(this.RoundedUp) != len(that1.RoundedUp) {
+		return false
+	}
+	for i := range this.RoundedUp {
+		if !this.RoundedUp[i].Equal(&that1.RoundedUp[i]) {
 			return false
 		}
 	}
 	return true
 }
-func (this *ValidatorOutstandingRewards) Equal(that interface{}) bool {
+func (this *PendingRewardAllocation) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ValidatorOutstandingRewards)
+	that1, ok := that.(*PendingRewardAllocation)
 	if !ok {
-		that2, ok := that.(ValidatorOutstandingRewards)
+		that2, ok := that.(PendingRewardAllocation)
 		if ok {
 			that1 = &that2
 		} else {
@@ -871,6 +1425,14 @@ func (this *ValidatorOutstandingRewards) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if len(this.Commission) != len(that1.Commission) {
+		return false
+	}
+	for i := range this.Commission {
+		if !this.Commission[i].Equal(&that1.Commission[i]) {
+			return false
+		}
+	}
 	if len(this.Rewards) != len(that1.Rewards) {
 		return false
 	}
@@ -881,14 +1443,14 @@ func (this *ValidatorOutstandingRewards) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *ValidatorSlashEvent) Equal(that interface{}) bool {
+func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ValidatorSlashEvent)
+	that1, ok := that.(*ValidatorHistoricalRewards)
 	if !ok {
-		that2, ok := that.(ValidatorSlashEvent)
+		that2, ok := that.(ValidatorHistoricalRewards)
 		if ok {
 			that1 = &that2
 		} else {
@@ -900,22 +1462,27 @@ func (this *ValidatorSlashEvent) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ValidatorPeriod != that1.ValidatorPeriod {
+	if len(this.CumulativeRewardRatio) != len(that1.CumulativeRewardRatio) {
 		return false
 	}
-	if !this.Fraction.Equal(that1.Fraction) {
+	for i := range this.CumulativeRewardRatio {
+		if !this.CumulativeRewardRatio[i].Equal(&that1.CumulativeRewardRatio[i]) {
+			return false
+		}
+	}
+	if this.ReferenceCount != that1.ReferenceCount {
 		return false
 	}
 	return true
 }
-func (this *ValidatorSlashEvents) Equal(that interface{}) bool {
+func (this *ValidatorCurrentRewards) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ValidatorSlashEvents)
+	that1, ok := that.(*ValidatorCurrentRewards)
 	if !ok {
-		that2, ok := that.(ValidatorSlashEvents)
+		that2, ok := that.(ValidatorCurrentRewards)
 		if ok {
 			that1 = &that2
 		} else {
@@ -927,24 +1494,27 @@ func (this *ValidatorSlashEvents) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.ValidatorSlashEvents) != len(that1.ValidatorSlashEvents) {
+	if len(this.Rewards) != len(that1.Rewards) {
 		return false
 	}
-	for i := range this.ValidatorSlashEvents {
-		if !this.ValidatorSlashEvents[i].Equal(&that1.ValidatorSlashEvents[i]) {
+	for i := range this.Rewards {
+		if !this.Rewards[i].Equal(&that1.Rewards[i]) {
 			return false
 		}
 	}
+	if this.Period != that1.Period {
+		return false
+	}
 	return true
 }
-func (this *FeePool) Equal(that interface{}) bool {
+func (this *ValidatorAccumulatedCommission) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*FeePool)
+	that1, ok := that.(*ValidatorAccumulatedCommission)
 	if !ok {
-		that2, ok := that.(FeePool)
+		that2, ok := that.(ValidatorAccumulatedCommission)
 		if ok {
 			that1 = &that2
 		} else {
@@ -956,32 +1526,24 @@ func (this *FeePool) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.CommunityPool) != len(that1.CommunityPool) {
-		return false
-	}
-	for i := range this.CommunityPool {
-		if !this.CommunityPool[i].Equal(&that1.CommunityPool[i]) {
-			return false
-		}
-	}
-	if len(this.DecimalPool) != len(that1.DecimalPool) {
+	if len(this.Commission) != len(that1.Commission) {
 		return false
 	}
-	for i := range this.DecimalPool {
-		if !this.DecimalPool[i].Equal(&that1.DecimalPool[i]) {
+	for i := range this.Commission {
+		if !this.Commission[i].Equal(&that1.Commission[i]) {
 			return false
 		}
 	}
 	return true
 }
-func (this *DelegatorStartingInfo) Equal(that interface{}) bool {
+func (this *ValidatorOutstandingRewards) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DelegatorStartingInfo)
+	that1, ok := that.(*ValidatorOutstandingRewards)
 	if !ok {
-		that2, ok := that.(DelegatorStartingInfo)
+		that2, ok := that.(ValidatorOutstandingRewards)
 		if ok {
 			that1 = &that2
 		} else {
@@ -993,25 +1555,24 @@ func (this *DelegatorStartingInfo) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.PreviousPeriod != that1.PreviousPeriod {
-		return false
-	}
-	if !this.Stake.Equal(that1.Stake) {
+	if len(this.Rewards) != len(that1.Rewards) {
 		return false
 	}
-	if this.Height != that1.Height {
-		return false
+	for i := range this.Rewards {
+		if !this.Rewards[i].Equal(&that1.Rewards[i]) {
+			return false
+		}
 	}
 	return true
 }
-func (this *DelegationDelegatorReward) Equal(that interface{}) bool {
+func (this *ValidatorSlashEvent) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DelegationDelegatorReward)
+	that1, ok := that.(*ValidatorSlashEvent)
 	if !ok {
-		that2, ok := that.(DelegationDelegatorReward)
+		that2, ok := that.(ValidatorSlashEvent)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1023,14 +1584,137 @@ func (this *DelegationDelegatorReward) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ValidatorAddress != that1.ValidatorAddress {
+	if this.ValidatorPeriod != that1.ValidatorPeriod {
 		return false
 	}
-	if len(this.Reward) != len(that1.Reward) {
+	if !this.Fraction.Equal(that1.Fraction) {
 		return false
 	}
-	for i := range this.Reward {
-		if !this.Reward[i].Equal(&that1.Reward[i]) {
+	return true
+}
+func (this *ValidatorSlashEvents) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorSlashEvents)
+	if !ok {
+		that2, ok := that.(ValidatorSlashEvents)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.ValidatorSlashEvents) != len(that1.ValidatorSlashEvents) {
+		return false
+	}
+	for i := range this.ValidatorSlashEvents {
+		if !this.ValidatorSlashEvents[i].Equal(&that1.ValidatorSlashEvents[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *FeePool) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeePool)
+	if !ok {
+		that2, ok := that.(FeePool)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.CommunityPool) != len(that1.CommunityPool) {
+		return false
+	}
+	for i := range this.CommunityPool {
+		if !this.CommunityPool[i].Equal(&that1.CommunityPool[i]) {
+			return false
+		}
+	}
+	if len(this.DecimalPool) != len(that1.DecimalPool) {
+		return false
+	}
+	for i := range this.DecimalPool {
+		if !this.DecimalPool[i].Equal(&that1.DecimalPool[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DelegatorStartingInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegatorStartingInfo)
+	if !ok {
+		that2, ok := that.(DelegatorStartingInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PreviousPeriod != that1.PreviousPeriod {
+		return false
+	}
+	if !this.Stake.Equal(that1.Stake) {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
+func (this *DelegationDelegatorReward) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegationDelegatorReward)
+	if !ok {
+		that2, ok := that.(DelegationDelegatorReward)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if len(this.Reward) != len(that1.Reward) {
+		return false
+	}
+	for i := range this.Reward {
+		if !this.Reward[i].Equal(&that1.Reward[i]) {
 			return false
 		}
 	}
@@ -1072,140 +1756,295 @@ func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool
 	}
 	return true
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *RewardEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.WithdrawAddrEnabled {
-		i--
-		if m.WithdrawAddrEnabled {
-			dAtA[i] = 1
+	that1, ok := that.(*RewardEvent)
+	if !ok {
+		that2, ok := that.(RewardEvent)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x20
 	}
-	{
-		size := m.BonusProposerReward.Size()
-		i -= size
-		if _, err := m.BonusProposerReward.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.BaseProposerReward.Size()
-		i -= size
-		if _, err := m.BaseProposerReward.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	if this.Id != that1.Id {
+		return false
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.CommunityTax.Size()
-		i -= size
-		if _, err := m.CommunityTax.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	if this.DelegatorAddress != that1.DelegatorAddress {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *ValidatorHistoricalRewards) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ValidatorHistoricalRewards) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ValidatorHistoricalRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ReferenceCount != 0 {
-		i = encodeVarintDistribution(dAtA, i, uint64(m.ReferenceCount))
-		i--
-		dAtA[i] = 0x10
+	if this.WithdrawAddress != that1.WithdrawAddress {
+		return false
 	}
-	if len(m.CumulativeRewardRatio) > 0 {
-		for iNdEx := len(m.CumulativeRewardRatio) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CumulativeRewardRatio[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDistribution(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ValidatorCurrentRewards) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Height != that1.Height {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ValidatorCurrentRewards) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	return true
 }
+func (this *ExternalRewards) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ValidatorCurrentRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	that1, ok := that.(*ExternalRewards)
+	if !ok {
+		that2, ok := that.(ExternalRewards)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.Source != that1.Source {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *CommissionSplit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommissionSplit)
+	if !ok {
+		that2, ok := that.(CommissionSplit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if len(this.Recipients) != len(that1.Recipients) {
+		return false
+	}
+	for i := range this.Recipients {
+		if !this.Recipients[i].Equal(&that1.Recipients[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *CommissionSplitRecipient) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommissionSplitRecipient)
+	if !ok {
+		that2, ok := that.(CommissionSplitRecipient)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
+}
+func (this *ValidatorHistoricalRewardsStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorHistoricalRewardsStats)
+	if !ok {
+		that2, ok := that.(ValidatorHistoricalRewardsStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.HistoricalRewards != that1.HistoricalRewards {
+		return false
+	}
+	if this.ReferenceCount != that1.ReferenceCount {
+		return false
+	}
+	if this.SlashEvents != that1.SlashEvents {
+		return false
+	}
+	if this.PrunableSlashEvents != that1.PrunableSlashEvents {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Period != 0 {
-		i = encodeVarintDistribution(dAtA, i, uint64(m.Period))
+	if m.RoundingPolicy != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.RoundingPolicy))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x40
 	}
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+	if m.RewardAllocationFlushInterval != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.RewardAllocationFlushInterval))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.HistoricalRewardsPruningInterval != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.HistoricalRewardsPruningInterval))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RewardEventsEnabled {
+		i--
+		if m.RewardEventsEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.WithdrawAddrEnabled {
+		i--
+		if m.WithdrawAddrEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.BonusProposerReward.Size()
+		i -= size
+		if _, err := m.BonusProposerReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BaseProposerReward.Size()
+		i -= size
+		if _, err := m.BaseProposerReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.CommunityTax.Size()
+		i -= size
+		if _, err := m.CommunityTax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TruncationDust) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TruncationDust) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TruncationDust) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RoundedUp) > 0 {
+		for iNdEx := len(m.RoundedUp) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RoundedUp[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1213,13 +2052,34 @@ func (m *ValidatorCurrentRewards) MarshalToSizedBuffer(dAtA []byte) (int, error)
 				i = encodeVarintDistribution(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Truncated) > 0 {
+		for iNdEx := len(m.Truncated) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Truncated[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorAccumulatedCommission) Marshal() (dAtA []byte, err error) {
+func (m *PendingRewardAllocation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1229,16 +2089,30 @@ func (m *ValidatorAccumulatedCommission) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorAccumulatedCommission) MarshalTo(dAtA []byte) (int, error) {
+func (m *PendingRewardAllocation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValidatorAccumulatedCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PendingRewardAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Commission) > 0 {
 		for iNdEx := len(m.Commission) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1256,7 +2130,7 @@ func (m *ValidatorAccumulatedCommission) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorOutstandingRewards) Marshal() (dAtA []byte, err error) {
+func (m *ValidatorHistoricalRewards) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1266,20 +2140,25 @@ func (m *ValidatorOutstandingRewards) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorOutstandingRewards) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValidatorHistoricalRewards) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValidatorOutstandingRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValidatorHistoricalRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+	if m.ReferenceCount != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.ReferenceCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CumulativeRewardRatio) > 0 {
+		for iNdEx := len(m.CumulativeRewardRatio) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.CumulativeRewardRatio[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1293,7 +2172,7 @@ func (m *ValidatorOutstandingRewards) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorSlashEvent) Marshal() (dAtA []byte, err error) {
+func (m *ValidatorCurrentRewards) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1303,17 +2182,133 @@ func (m *ValidatorSlashEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorSlashEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValidatorCurrentRewards) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValidatorSlashEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValidatorCurrentRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
+	if m.Period != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorAccumulatedCommission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorAccumulatedCommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorAccumulatedCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commission) > 0 {
+		for iNdEx := len(m.Commission) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commission[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorOutstandingRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorOutstandingRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorOutstandingRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorSlashEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSlashEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSlashEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
 		size := m.Fraction.Size()
 		i -= size
 		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
@@ -1622,123 +2617,326 @@ func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDistribution(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RewardEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CommunityTax.Size()
-	n += 1 + l + sovDistribution(uint64(l))
-	l = m.BaseProposerReward.Size()
-	n += 1 + l + sovDistribution(uint64(l))
-	l = m.BonusProposerReward.Size()
-	n += 1 + l + sovDistribution(uint64(l))
-	if m.WithdrawAddrEnabled {
-		n += 2
-	}
-	return n
+
+func (m *RewardEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValidatorHistoricalRewards) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RewardEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.CumulativeRewardRatio) > 0 {
-		for _, e := range m.CumulativeRewardRatio {
-			l = e.Size()
-			n += 1 + l + sovDistribution(uint64(l))
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintDistribution(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	if m.Height != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	if m.ReferenceCount != 0 {
-		n += 1 + sovDistribution(uint64(m.ReferenceCount))
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-
-func (m *ValidatorCurrentRewards) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovDistribution(uint64(l))
-		}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Period != 0 {
-		n += 1 + sovDistribution(uint64(m.Period))
+	if m.Id != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorAccumulatedCommission) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Commission) > 0 {
-		for _, e := range m.Commission {
-			l = e.Size()
-			n += 1 + l + sovDistribution(uint64(l))
-		}
+func (m *ExternalRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ValidatorOutstandingRewards) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovDistribution(uint64(l))
-		}
-	}
-	return n
+func (m *ExternalRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValidatorSlashEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ExternalRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ValidatorPeriod != 0 {
-		n += 1 + sovDistribution(uint64(m.ValidatorPeriod))
-	}
-	l = m.Fraction.Size()
-	n += 1 + l + sovDistribution(uint64(l))
-	return n
-}
-
-func (m *ValidatorSlashEvents) Size() (n int) {
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommissionSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommissionSplitRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionSplitRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionSplitRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorHistoricalRewardsStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorHistoricalRewardsStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorHistoricalRewardsStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PrunableSlashEvents != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.PrunableSlashEvents))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SlashEvents != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.SlashEvents))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReferenceCount != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.ReferenceCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HistoricalRewards != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.HistoricalRewards))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDistribution(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.ValidatorSlashEvents) > 0 {
-		for _, e := range m.ValidatorSlashEvents {
+	l = m.CommunityTax.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	l = m.BaseProposerReward.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	l = m.BonusProposerReward.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.WithdrawAddrEnabled {
+		n += 2
+	}
+	if m.RewardEventsEnabled {
+		n += 2
+	}
+	if m.HistoricalRewardsPruningInterval != 0 {
+		n += 1 + sovDistribution(uint64(m.HistoricalRewardsPruningInterval))
+	}
+	if m.RewardAllocationFlushInterval != 0 {
+		n += 1 + sovDistribution(uint64(m.RewardAllocationFlushInterval))
+	}
+	if m.RoundingPolicy != 0 {
+		n += 1 + sovDistribution(uint64(m.RoundingPolicy))
+	}
+	return n
+}
+
+func (m *TruncationDust) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Truncated) > 0 {
+		for _, e := range m.Truncated {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if len(m.RoundedUp) > 0 {
+		for _, e := range m.RoundedUp {
 			l = e.Size()
 			n += 1 + l + sovDistribution(uint64(l))
 		}
@@ -1746,20 +2944,20 @@ func (m *ValidatorSlashEvents) Size() (n int) {
 	return n
 }
 
-func (m *FeePool) Size() (n int) {
+func (m *PendingRewardAllocation) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.CommunityPool) > 0 {
-		for _, e := range m.CommunityPool {
+	if len(m.Commission) > 0 {
+		for _, e := range m.Commission {
 			l = e.Size()
 			n += 1 + l + sovDistribution(uint64(l))
 		}
 	}
-	if len(m.DecimalPool) > 0 {
-		for _, e := range m.DecimalPool {
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
 			l = e.Size()
 			n += 1 + l + sovDistribution(uint64(l))
 		}
@@ -1767,105 +2965,1534 @@ func (m *FeePool) Size() (n int) {
 	return n
 }
 
-func (m *CommunityPoolSpendProposal) Size() (n int) {
+func (m *ValidatorHistoricalRewards) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
+	if len(m.CumulativeRewardRatio) > 0 {
+		for _, e := range m.CumulativeRewardRatio {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
+	if m.ReferenceCount != 0 {
+		n += 1 + sovDistribution(uint64(m.ReferenceCount))
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *ValidatorCurrentRewards) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
 			l = e.Size()
 			n += 1 + l + sovDistribution(uint64(l))
 		}
 	}
+	if m.Period != 0 {
+		n += 1 + sovDistribution(uint64(m.Period))
+	}
 	return n
 }
 
-func (m *DelegatorStartingInfo) Size() (n int) {
+func (m *ValidatorAccumulatedCommission) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.PreviousPeriod != 0 {
-		n += 1 + sovDistribution(uint64(m.PreviousPeriod))
-	}
-	l = m.Stake.Size()
-	n += 1 + l + sovDistribution(uint64(l))
-	if m.Height != 0 {
-		n += 1 + sovDistribution(uint64(m.Height))
+	if len(m.Commission) > 0 {
+		for _, e := range m.Commission {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *DelegationDelegatorReward) Size() (n int) {
+func (m *ValidatorOutstandingRewards) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
-	}
-	if len(m.Reward) > 0 {
-		for _, e := range m.Reward {
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
 			l = e.Size()
 			n += 1 + l + sovDistribution(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *ValidatorSlashEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorPeriod != 0 {
+		n += 1 + sovDistribution(uint64(m.ValidatorPeriod))
+	}
+	l = m.Fraction.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *ValidatorSlashEvents) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorSlashEvents) > 0 {
+		for _, e := range m.ValidatorSlashEvents {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CommunityPool) > 0 {
+		for _, e := range m.CommunityPool {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if len(m.DecimalPool) > 0 {
+		for _, e := range m.DecimalPool {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommunityPoolSpendProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegatorStartingInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreviousPeriod != 0 {
+		n += 1 + sovDistribution(uint64(m.PreviousPeriod))
+	}
+	l = m.Stake.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovDistribution(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *DelegationDelegatorReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Reward) > 0 {
+		for _, e := range m.Reward {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	return n
+}
+
+func (m *RewardEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDistribution(uint64(m.Id))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovDistribution(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *ExternalRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommissionSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommissionSplitRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *ValidatorHistoricalRewardsStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.HistoricalRewards != 0 {
+		n += 1 + sovDistribution(uint64(m.HistoricalRewards))
+	}
+	if m.ReferenceCount != 0 {
+		n += 1 + sovDistribution(uint64(m.ReferenceCount))
+	}
+	if m.SlashEvents != 0 {
+		n += 1 + sovDistribution(uint64(m.SlashEvents))
+	}
+	if m.PrunableSlashEvents != 0 {
+		n += 1 + sovDistribution(uint64(m.PrunableSlashEvents))
+	}
+	return n
+}
+
+func sovDistribution(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDistribution(x uint64) (n int) {
+	return sovDistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityTax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseProposerReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseProposerReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BonusProposerReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BonusProposerReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddrEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardEventsEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RewardEventsEnabled = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalRewardsPruningInterval", wireType)
+			}
+			m.HistoricalRewardsPruningInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalRewardsPruningInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAllocationFlushInterval", wireType)
+			}
+			m.RewardAllocationFlushInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardAllocationFlushInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundingPolicy", wireType)
+			}
+			m.RoundingPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundingPolicy |= RoundingPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TruncationDust) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TruncationDust: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TruncationDust: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Truncated = append(m.Truncated, types.DecCoin{})
+			if err := m.Truncated[len(m.Truncated)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundedUp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoundedUp = append(m.RoundedUp, types.DecCoin{})
+			if err := m.RoundedUp[len(m.RoundedUp)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingRewardAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingRewardAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingRewardAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commission = append(m.Commission, types.DecCoin{})
+			if err := m.Commission[len(m.Commission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorHistoricalRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorHistoricalRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeRewardRatio", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CumulativeRewardRatio = append(m.CumulativeRewardRatio, types.DecCoin{})
+			if err := m.CumulativeRewardRatio[len(m.CumulativeRewardRatio)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceCount", wireType)
+			}
+			m.ReferenceCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReferenceCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorCurrentRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorCurrentRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorAccumulatedCommission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorAccumulatedCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commission = append(m.Commission, types.DecCoin{})
+			if err := m.Commission[len(m.Commission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorOutstandingRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorOutstandingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSlashEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSlashEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPeriod", wireType)
+			}
+			m.ValidatorPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSlashEvents: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSlashEvents: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSlashEvents = append(m.ValidatorSlashEvents, ValidatorSlashEvent{})
+			if err := m.ValidatorSlashEvents[len(m.ValidatorSlashEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPool = append(m.CommunityPool, types.DecCoin{})
+			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecimalPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecimalPool = append(m.DecimalPool, types.DecCoin{})
+			if err := m.DecimalPool[len(m.DecimalPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
-	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
-	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
-	}
-	l = len(m.Deposit)
-	if l > 0 {
-		n += 1 + l + sovDistribution(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovDistribution(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDistribution(x uint64) (n int) {
-	return sovDistribution(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1888,15 +4515,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommunityPoolSpendProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommunityPoolSpendProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityTax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1924,13 +4551,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommunityTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseProposerReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1958,13 +4583,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseProposerReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BonusProposerReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1992,15 +4615,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BonusProposerReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddrEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2010,12 +4631,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.WithdrawAddrEnabled = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2037,7 +4672,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
+func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2060,17 +4695,36 @@ func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorHistoricalRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegatorStartingInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorHistoricalRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegatorStartingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPeriod", wireType)
+			}
+			m.PreviousPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeRewardRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2080,31 +4734,31 @@ func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CumulativeRewardRatio = append(m.CumulativeRewardRatio, types.DecCoin{})
-			if err := m.CumulativeRewardRatio[len(m.CumulativeRewardRatio)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.ReferenceCount = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2114,7 +4768,7 @@ func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReferenceCount |= uint32(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2140,7 +4794,7 @@ func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
+func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2163,17 +4817,17 @@ func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorCurrentRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationDelegatorReward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorCurrentRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2183,98 +4837,27 @@ func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Rewards = append(m.Rewards, types.DecCoin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
-			}
-			m.Period = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDistribution
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Period |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDistribution(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDistribution
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorAccumulatedCommission: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorAccumulatedCommission: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2301,8 +4884,8 @@ func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commission = append(m.Commission, types.DecCoin{})
-			if err := m.Commission[len(m.Commission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reward = append(m.Reward, types.DecCoin{})
+			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2327,7 +4910,7 @@ func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
+func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2350,17 +4933,17 @@ func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorOutstandingRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommunityPoolSpendProposalWithDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorOutstandingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommunityPoolSpendProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2370,81 +4953,93 @@ func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, types.DecCoin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDistribution(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDistribution
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSlashEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSlashEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPeriod", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
 			}
-			m.ValidatorPeriod = 0
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2454,14 +5049,27 @@ func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValidatorPeriod |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2489,9 +5097,7 @@ func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2514,7 +5120,7 @@ func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
+func (m *RewardEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2537,17 +5143,36 @@ func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSlashEvents: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSlashEvents: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2557,79 +5182,91 @@ func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSlashEvents = append(m.ValidatorSlashEvents, ValidatorSlashEvent{})
-			if err := m.ValidatorSlashEvents[len(m.ValidatorSlashEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDistribution(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FeePool) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDistribution
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FeePool: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeePool: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2656,14 +5293,33 @@ func (m *FeePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CommunityPool = append(m.CommunityPool, types.DecCoin{})
-			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecimalPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2690,8 +5346,7 @@ func (m *FeePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DecimalPool = append(m.DecimalPool, types.DecCoin{})
-			if err := m.DecimalPool[len(m.DecimalPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2716,7 +5371,7 @@ func (m *FeePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
+func (m *ExternalRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2739,15 +5394,15 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommunityPoolSpendProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExternalRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommunityPoolSpendProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExternalRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2775,11 +5430,11 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2807,41 +5462,9 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDistribution
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -2896,7 +5519,7 @@ func (m *CommunityPoolSpendProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
+func (m *CommissionSplit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2919,34 +5542,15 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegatorStartingInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommissionSplit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegatorStartingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommissionSplit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPeriod", wireType)
-			}
-			m.PreviousPeriod = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDistribution
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PreviousPeriod |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2974,15 +5578,13 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2992,11 +5594,26 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, CommissionSplitRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -3018,7 +5635,7 @@ func (m *DelegatorStartingInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
+func (m *CommissionSplitRecipient) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3041,15 +5658,15 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationDelegatorReward: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommissionSplitRecipient: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationDelegatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommissionSplitRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3077,13 +5694,13 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -3093,23 +5710,23 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reward = append(m.Reward, types.DecCoin{})
-			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3134,7 +5751,7 @@ func (m *DelegationDelegatorReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
+func (m *ValidatorHistoricalRewardsStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3157,15 +5774,15 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommunityPoolSpendProposalWithDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorHistoricalRewardsStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommunityPoolSpendProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorHistoricalRewardsStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3193,13 +5810,13 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalRewards", wireType)
 			}
-			var stringLen uint64
+			m.HistoricalRewards = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -3209,29 +5826,16 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.HistoricalRewards |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceCount", wireType)
 			}
-			var stringLen uint64
+			m.ReferenceCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -3241,29 +5845,16 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ReferenceCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashEvents", wireType)
 			}
-			var stringLen uint64
+			m.SlashEvents = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -3273,29 +5864,16 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SlashEvents |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunableSlashEvents", wireType)
 			}
-			var stringLen uint64
+			m.PrunableSlashEvents = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -3305,24 +5883,11 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PrunableSlashEvents |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDistribution
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Deposit = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
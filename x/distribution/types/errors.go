@@ -18,4 +18,8 @@ var (
 	ErrNoDelegationExists      = errors.Register(ModuleName, 13, "delegation does not exist")
 	ErrInvalidProposalContent  = errors.Register(ModuleName, 14, "invalid proposal content")
 	ErrInvalidSigner           = errors.Register(ModuleName, 15, "expected authority account as only signer for proposal message")
+	ErrNoTokenizeShareRecords  = errors.Register(ModuleName, 16, "no tokenize share records owned")
+	ErrInvalidRewardSource     = errors.Register(ModuleName, 17, "invalid external reward source")
+	ErrWithdrawGasExceeded     = errors.Register(ModuleName, 18, "withdrawal of the rewards of a delegation exceeded its gas limit")
+	ErrInvalidCommissionSplit  = errors.Register(ModuleName, 19, "invalid commission split")
 )
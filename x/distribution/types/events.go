@@ -8,8 +8,14 @@ const (
 	EventTypeWithdrawRewards    = "withdraw_rewards"
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
+	EventTypeExternalRewards    = "external_rewards"
+	EventTypeSetCommissionSplit = "set_commission_split"
+	EventTypeCommissionPayout   = "commission_payout"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
 	AttributeKeyDelegator       = "delegator"
+	AttributeKeySource          = "source"
+	AttributeKeyRecipient       = "recipient"
+	AttributeKeyRecipients      = "recipients"
 )
@@ -4,6 +4,7 @@ import (
 	context "context"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 	stakingtypes "cosmossdk.io/x/staking/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -29,6 +30,7 @@ type BankKeeper interface {
 	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 
 	BlockedAddr(addr sdk.AccAddress) bool
 }
@@ -41,11 +43,18 @@ type PoolKeeper interface {
 	SetToDistribute(ctx context.Context, amount sdk.Coins, addr string) error
 }
 
+// MintKeeper defines the expected interface needed to project the inflation
+// rewards.
+type MintKeeper interface {
+	AnnualProvisions(ctx context.Context) (sdk.DecCoins, error)
+}
+
 // StakingKeeper expected staking keeper (noalias)
 type StakingKeeper interface {
 	ValidatorAddressCodec() address.Codec
 	ConsensusAddressCodec() address.Codec
 	BondDenom(ctx context.Context) (string, error)
+	TotalBondedTokens(ctx context.Context) (math.Int, error)
 
 	// iterate through validators by operator address, execute func for each validator
 	IterateValidators(context.Context,
@@ -64,6 +73,7 @@ type StakingKeeper interface {
 	GetAllSDKDelegations(ctx context.Context) ([]stakingtypes.Delegation, error)
 	GetAllValidators(ctx context.Context) ([]stakingtypes.Validator, error)
 	GetAllDelegatorDelegations(ctx context.Context, delegator sdk.AccAddress) ([]stakingtypes.Delegation, error)
+	GetTokenizeShareRecordsByOwner(ctx context.Context, owner sdk.AccAddress) ([]stakingtypes.TokenizeShareRecord, error)
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
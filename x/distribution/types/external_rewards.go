@@ -0,0 +1,36 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// reExternalRewardSource matches the names of the external reward sources, e.g.
+// "mev_auction" or "bridge-fees".
+var reExternalRewardSource = regexp.MustCompile(`^[a-z][a-z0-9_\-]{0,63}$`)
+
+// ValidateExternalRewardSource validates the name of an external reward source.
+func ValidateExternalRewardSource(source string) error {
+	if !reExternalRewardSource.MatchString(source) {
+		return ErrInvalidRewardSource.Wrapf("%q must be 1 to 64 lowercase alphanumeric, '_' or '-' characters, starting with a letter", source)
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the external rewards of a validator.
+func (r ExternalRewards) Validate() error {
+	if r.ValidatorAddress == "" {
+		return fmt.Errorf("external rewards of %s: empty validator address", r.Source)
+	}
+
+	if err := ValidateExternalRewardSource(r.Source); err != nil {
+		return err
+	}
+
+	if err := r.Amount.Validate(); err != nil {
+		return fmt.Errorf("external rewards of %s: invalid amount: %w", r.Source, err)
+	}
+
+	return nil
+}
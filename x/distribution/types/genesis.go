@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -44,5 +46,55 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+
+	seenRewardEvents := make(map[uint64]bool, len(gs.RewardEvents))
+	for _, event := range gs.RewardEvents {
+		if seenRewardEvents[event.Id] {
+			return fmt.Errorf("duplicate reward event %d", event.Id)
+		}
+		seenRewardEvents[event.Id] = true
+
+		if err := event.Validate(); err != nil {
+			return err
+		}
+	}
+
+	seenExternalRewards := make(map[string]bool, len(gs.ExternalRewards))
+	for _, rewards := range gs.ExternalRewards {
+		key := rewards.ValidatorAddress + "/" + rewards.Source
+		if seenExternalRewards[key] {
+			return fmt.Errorf("duplicate external rewards of %s for validator %s", rewards.Source, rewards.ValidatorAddress)
+		}
+		seenExternalRewards[key] = true
+
+		if err := rewards.Validate(); err != nil {
+			return err
+		}
+	}
+
+	seenCommissionSplits := make(map[string]bool, len(gs.CommissionSplits))
+	for _, split := range gs.CommissionSplits {
+		if seenCommissionSplits[split.ValidatorAddress] {
+			return fmt.Errorf("duplicate commission split for validator %s", split.ValidatorAddress)
+		}
+		seenCommissionSplits[split.ValidatorAddress] = true
+
+		if err := split.Validate(); err != nil {
+			return err
+		}
+	}
+
+	seenTruncationDust := make(map[string]bool, len(gs.TruncationDust))
+	for _, dust := range gs.TruncationDust {
+		if seenTruncationDust[dust.ValidatorAddress] {
+			return fmt.Errorf("duplicate truncation dust for validator %s", dust.ValidatorAddress)
+		}
+		seenTruncationDust[dust.ValidatorAddress] = true
+
+		if err := dust.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return gs.FeePool.ValidateGenesis()
 }
@@ -348,6 +348,16 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// reward_events defines the log of the delegation reward withdrawals.
+	RewardEvents []RewardEvent `protobuf:"bytes,11,rep,name=reward_events,json=rewardEvents,proto3" json:"reward_events"`
+	// external_rewards defines the rewards added to the validators by the
+	// external reward sources.
+	ExternalRewards []ExternalRewards `protobuf:"bytes,12,rep,name=external_rewards,json=externalRewards,proto3" json:"external_rewards"`
+	// commission_splits defines the commission splits of the validators.
+	CommissionSplits []CommissionSplit `protobuf:"bytes,13,rep,name=commission_splits,json=commissionSplits,proto3" json:"commission_splits"`
+	// truncation_dust defines the fractions of coins by which the rewards paid
+	// out for the validators were rounded.
+	TruncationDust []TruncationDust `protobuf:"bytes,14,rep,name=truncation_dust,json=truncationDust,proto3" json:"truncation_dust"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -399,66 +409,73 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 943 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x3a, 0x25, 0x4d, 0x26, 0x45, 0x34, 0xd3, 0x34, 0x6c, 0xd2, 0x76, 0x9d, 0x94, 0x1e,
-	0x0a, 0x28, 0x6b, 0x1a, 0x10, 0x54, 0x45, 0x80, 0x1a, 0xb7, 0xe5, 0xc7, 0x01, 0x22, 0x5b, 0x02,
-	0x81, 0x90, 0xac, 0xf1, 0xee, 0x78, 0x3d, 0xaa, 0xbd, 0x63, 0xcd, 0x8c, 0xd7, 0x80, 0xc4, 0x81,
-	0x53, 0x11, 0xe2, 0xc0, 0x09, 0x71, 0xac, 0x38, 0x55, 0x48, 0x48, 0x1c, 0xfa, 0x47, 0xf4, 0x58,
-	0x55, 0x1c, 0x38, 0x01, 0x72, 0x0e, 0x20, 0x8e, 0xfc, 0x05, 0x68, 0x67, 0x66, 0x77, 0xc7, 0xd9,
-	0xed, 0xd6, 0x0d, 0xf5, 0x25, 0xf1, 0xce, 0xbc, 0xf7, 0xbe, 0xef, 0x7b, 0xef, 0xed, 0x7b, 0x0b,
-	0x9e, 0xf7, 0x28, 0x1f, 0x50, 0x5e, 0xf7, 0x09, 0x17, 0x8c, 0x74, 0x46, 0x82, 0xd0, 0xb0, 0x1e,
-	0x5d, 0xea, 0x60, 0x81, 0x2e, 0xd5, 0x03, 0x1c, 0x62, 0x4e, 0xb8, 0x3b, 0x64, 0x54, 0x50, 0x78,
-	0x46, 0x99, 0xba, 0xa6, 0xa9, 0xab, 0x4d, 0x37, 0xd7, 0x02, 0x1a, 0x50, 0x69, 0x57, 0x8f, 0x7f,
-	0x29, 0x97, 0x4d, 0x47, 0x47, 0xef, 0x20, 0x8e, 0xd3, 0xa8, 0x1e, 0x25, 0xa1, 0xbe, 0x77, 0xcb,
-	0xd0, 0xa7, 0x70, 0x94, 0xfd, 0x86, 0xb2, 0x6f, 0x2b, 0x20, 0xcd, 0x47, 0x5d, 0xad, 0xa2, 0x01,
-	0x09, 0x69, 0x5d, 0xfe, 0x55, 0x47, 0xe7, 0x7f, 0xb6, 0xc0, 0xe9, 0x6b, 0xb8, 0x8f, 0x03, 0x24,
-	0x28, 0xfb, 0x88, 0x88, 0x9e, 0xcf, 0xd0, 0xf8, 0xdd, 0xb0, 0x4b, 0xe1, 0x75, 0xb0, 0xea, 0x27,
-	0x17, 0x6d, 0xe4, 0xfb, 0x0c, 0x73, 0x6e, 0x5b, 0x5b, 0xd6, 0xc5, 0xe5, 0x3d, 0xfb, 0xc1, 0xdd,
-	0x9d, 0x35, 0x1d, 0xf9, 0xaa, 0xba, 0x69, 0x09, 0x46, 0xc2, 0xa0, 0x79, 0x32, 0x75, 0xd1, 0xe7,
-	0xb0, 0x01, 0x4e, 0x8e, 0x75, 0xd8, 0x34, 0x4a, 0xf5, 0x11, 0x51, 0x9e, 0x49, 0x3c, 0xf4, 0xf1,
-	0x95, 0xa5, 0xaf, 0x6f, 0xd7, 0x2a, 0x7f, 0xdf, 0xae, 0x55, 0xce, 0xdf, 0xaa, 0x82, 0xed, 0x0f,
-	0x51, 0x9f, 0xf8, 0x31, 0xc6, 0x07, 0x23, 0xc1, 0x05, 0x0a, 0xfd, 0xd8, 0x07, 0x8f, 0x11, 0xf3,
-	0x79, 0x13, 0x7b, 0x94, 0xf9, 0xf0, 0x7d, 0xb0, 0x1a, 0x25, 0x46, 0x87, 0xb8, 0x6f, 0x3f, 0xb8,
-	0xbb, 0x73, 0x4e, 0xa3, 0xa6, 0x81, 0x0e, 0x89, 0x88, 0x0e, 0x9d, 0xc3, 0x5b, 0x16, 0x38, 0x45,
-	0x33, 0xb0, 0x36, 0x53, 0x68, 0x76, 0x75, 0x6b, 0xe1, 0xe2, 0xca, 0xee, 0x59, 0x5d, 0x22, 0x37,
-	0x2e, 0x61, 0x52, 0x6d, 0xf7, 0x1a, 0xf6, 0x1a, 0x94, 0x84, 0x7b, 0x97, 0xef, 0xfd, 0x5e, 0xab,
-	0xfc, 0xf4, 0x47, 0xed, 0xc5, 0x80, 0x88, 0xde, 0xa8, 0xe3, 0x7a, 0x74, 0xa0, 0xab, 0xa2, 0xff,
-	0xed, 0x70, 0xff, 0x66, 0x5d, 0x7c, 0x3e, 0xc4, 0x3c, 0xf1, 0xe1, 0x77, 0xfe, 0xfa, 0xe5, 0x05,
-	0xab, 0x09, 0x69, 0x4e, 0x9f, 0x91, 0x89, 0x7f, 0x2c, 0x70, 0x21, 0x13, 0xe0, 0x79, 0xa3, 0xc1,
-	0xa8, 0x8f, 0x04, 0xf6, 0x1b, 0x74, 0x30, 0x20, 0x9c, 0x13, 0x1a, 0xce, 0x29, 0x19, 0x3d, 0xb0,
-	0x82, 0x32, 0x38, 0x59, 0xcc, 0x95, 0xdd, 0xd7, 0xdd, 0x92, 0xce, 0x77, 0xcb, 0x79, 0xee, 0x2d,
-	0xc7, 0x29, 0x52, 0x9a, 0xcd, 0xd0, 0x86, 0xd8, 0x7f, 0x2d, 0xb0, 0x95, 0x06, 0x79, 0x87, 0x70,
-	0x41, 0x19, 0xf1, 0x50, 0x7f, 0xbe, 0x55, 0x5f, 0x07, 0x8b, 0x43, 0xcc, 0x08, 0x55, 0x1a, 0x8f,
-	0x35, 0xf5, 0x13, 0xfc, 0x14, 0x1c, 0x4f, 0x1a, 0x60, 0x41, 0x8a, 0x7f, 0x6d, 0x36, 0xf1, 0x39,
-	0xde, 0xa6, 0xf0, 0x24, 0xa4, 0x21, 0xfa, 0x57, 0x0b, 0x9c, 0x4b, 0x9d, 0x1b, 0x23, 0xc6, 0x70,
-	0x28, 0xe6, 0xab, 0xf8, 0xe3, 0x4c, 0x99, 0x2a, 0xeb, 0x2b, 0xb3, 0x29, 0x9b, 0x26, 0xf7, 0x08,
-	0x59, 0x3f, 0x56, 0xc1, 0x99, 0x74, 0xe4, 0xb4, 0x04, 0x62, 0x82, 0x84, 0x41, 0x3c, 0x72, 0xb4,
-	0xa8, 0x27, 0x34, 0x78, 0x0a, 0x73, 0x53, 0x3d, 0x7a, 0x6e, 0x3a, 0xe0, 0x69, 0xae, 0xc9, 0xb6,
-	0x49, 0xd8, 0xa5, 0xba, 0xf6, 0xbb, 0xa5, 0x19, 0x2a, 0xd4, 0x69, 0xe6, 0xe7, 0x04, 0x37, 0x2e,
-	0x8c, 0x24, 0x7d, 0x5f, 0x05, 0x1b, 0x29, 0xb5, 0x56, 0x1f, 0xf1, 0xde, 0xf5, 0x48, 0x66, 0x78,
-	0x5e, 0x9d, 0xde, 0xc3, 0x24, 0xe8, 0x89, 0xa4, 0xd3, 0xd5, 0x93, 0xf1, 0x06, 0x2c, 0x4c, 0xbd,
-	0x01, 0x14, 0x9c, 0xce, 0xf0, 0x79, 0xcc, 0xae, 0x8d, 0x63, 0x7a, 0xf6, 0x31, 0x99, 0x93, 0x97,
-	0x66, 0xeb, 0x9a, 0x4c, 0x96, 0x99, 0x91, 0x53, 0x51, 0xfe, 0xde, 0x48, 0xcc, 0xb7, 0xcb, 0xe0,
-	0xc4, 0xdb, 0x6a, 0xe7, 0xb6, 0x04, 0x12, 0x18, 0xde, 0x00, 0x8b, 0x43, 0xc4, 0xd0, 0x40, 0x25,
-	0x60, 0x65, 0xf7, 0xb9, 0x52, 0xf0, 0x7d, 0x69, 0x6a, 0xe2, 0x69, 0x6f, 0xf8, 0x1e, 0x58, 0xea,
-	0x62, 0xdc, 0x1e, 0x52, 0xda, 0xd7, 0xcd, 0x7f, 0xa1, 0x34, 0xd2, 0x0d, 0x8c, 0xf7, 0x29, 0xed,
-	0x4f, 0x35, 0x7b, 0x57, 0x9d, 0xc1, 0x31, 0xb0, 0xb3, 0x16, 0x4e, 0xd7, 0x5f, 0xdc, 0x35, 0xf1,
-	0xc8, 0x58, 0x98, 0xbd, 0x6d, 0xcc, 0x8d, 0x6c, 0x22, 0xad, 0xfb, 0x45, 0x16, 0x3c, 0x7e, 0x77,
-	0x86, 0x0c, 0x47, 0x84, 0x8e, 0xe4, 0x07, 0xc0, 0x90, 0x72, 0xcc, 0x64, 0x51, 0x4a, 0xdf, 0x9d,
-	0xc4, 0x65, 0x5f, 0x7b, 0xc0, 0x2f, 0x8a, 0xd7, 0xdd, 0x53, 0x92, 0xfa, 0x9b, 0xb3, 0x55, 0xf7,
-	0x61, 0xcb, 0xd9, 0x94, 0x51, 0xb0, 0xe1, 0xe0, 0x0f, 0x16, 0xd8, 0x36, 0x9a, 0x3b, 0x5b, 0x07,
-	0x6d, 0x2f, 0xdd, 0x18, 0xdc, 0x5e, 0x94, 0x54, 0xae, 0xfe, 0x8f, 0xad, 0x93, 0x67, 0x53, 0x8b,
-	0x4a, 0x1d, 0x38, 0xfc, 0xc6, 0x02, 0x67, 0x33, 0x6a, 0xbd, 0x74, 0x9c, 0xa7, 0x09, 0x3a, 0x2e,
-	0x59, 0xbd, 0x71, 0xc4, 0x75, 0x90, 0x67, 0xb4, 0x19, 0x3d, 0xd4, 0x18, 0x7e, 0x65, 0x81, 0x8d,
-	0x8c, 0x8c, 0xa7, 0x26, 0x70, 0xca, 0x64, 0x49, 0x32, 0xb9, 0x72, 0x94, 0xf1, 0x9d, 0xa7, 0xf1,
-	0x6c, 0x54, 0x6c, 0x09, 0xbf, 0x34, 0xfb, 0x7c, 0x6a, 0x3a, 0x72, 0x7b, 0x59, 0x32, 0xb8, 0xfc,
-	0xf8, 0xe3, 0x31, 0x8f, 0x9f, 0x75, 0xbb, 0x69, 0xc7, 0xe1, 0x18, 0xac, 0x17, 0x8e, 0x21, 0x6e,
-	0x03, 0x09, 0xfe, 0xea, 0xe3, 0xce, 0xa1, 0x3c, 0xf4, 0x5a, 0xc1, 0x34, 0x32, 0x96, 0xd9, 0xde,
-	0x5b, 0x77, 0x26, 0x8e, 0x75, 0x6f, 0xe2, 0x58, 0xf7, 0x27, 0x8e, 0xf5, 0xe7, 0xc4, 0xb1, 0xbe,
-	0x3b, 0x70, 0x2a, 0xf7, 0x0f, 0x9c, 0xca, 0x6f, 0x07, 0x4e, 0xe5, 0x93, 0x6d, 0x85, 0xcf, 0xfd,
-	0x9b, 0x2e, 0xa1, 0xf5, 0xcf, 0xa6, 0xbf, 0xe1, 0xe5, 0x27, 0x5f, 0x67, 0x51, 0x7e, 0x87, 0xbf,
-	0xfc, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x95, 0x53, 0xd9, 0xb1, 0x65, 0x0c, 0x00, 0x00,
+	// 1047 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x3a, 0x21, 0x4d, 0xc6, 0x49, 0x93, 0x4c, 0xd3, 0xb0, 0x49, 0x5b, 0x3b, 0x29, 0x3d,
+	0x04, 0x4a, 0xd6, 0x34, 0x20, 0xa8, 0x8a, 0x00, 0x35, 0x1f, 0xe5, 0xe3, 0x00, 0x91, 0x8d, 0x40,
+	0x54, 0xa0, 0xd5, 0x78, 0x77, 0x62, 0x8f, 0x6a, 0xef, 0x58, 0x33, 0xb3, 0x76, 0x41, 0xe2, 0xc0,
+	0xa9, 0x88, 0x13, 0x27, 0xc4, 0xb1, 0xe2, 0x54, 0x21, 0x21, 0x71, 0xe8, 0x1f, 0xd1, 0x63, 0x55,
+	0x71, 0xe0, 0x04, 0x28, 0x39, 0x80, 0x38, 0x72, 0x47, 0x42, 0x3b, 0x33, 0xbb, 0x3b, 0x8e, 0xb7,
+	0x1b, 0x37, 0x34, 0x97, 0x36, 0x9e, 0x79, 0xef, 0xf7, 0xfb, 0xbd, 0x8f, 0x79, 0xcf, 0x06, 0xcf,
+	0x7b, 0x94, 0x77, 0x28, 0xaf, 0xfa, 0x84, 0x0b, 0x46, 0x1a, 0xa1, 0x20, 0x34, 0xa8, 0xf6, 0xae,
+	0x34, 0xb0, 0x40, 0x57, 0xaa, 0x4d, 0x1c, 0x60, 0x4e, 0xb8, 0xd3, 0x65, 0x54, 0x50, 0x78, 0x4e,
+	0x99, 0x3a, 0xa6, 0xa9, 0xa3, 0x4d, 0x97, 0x17, 0x9a, 0xb4, 0x49, 0xa5, 0x5d, 0x35, 0xfa, 0x4b,
+	0xb9, 0x2c, 0x97, 0x35, 0x7a, 0x03, 0x71, 0x9c, 0xa0, 0x7a, 0x94, 0x04, 0xfa, 0xde, 0xc9, 0x63,
+	0x1f, 0xe0, 0x51, 0xf6, 0x4b, 0xca, 0xde, 0x55, 0x44, 0x5a, 0x8f, 0xba, 0x9a, 0x47, 0x1d, 0x12,
+	0xd0, 0xaa, 0xfc, 0x57, 0x1d, 0x5d, 0xfc, 0xc9, 0x02, 0x67, 0xb7, 0x71, 0x1b, 0x37, 0x91, 0xa0,
+	0xec, 0x63, 0x22, 0x5a, 0x3e, 0x43, 0xfd, 0x77, 0x83, 0x3d, 0x0a, 0x77, 0xc0, 0xbc, 0x1f, 0x5f,
+	0xb8, 0xc8, 0xf7, 0x19, 0xe6, 0xdc, 0xb6, 0x56, 0xac, 0xb5, 0xa9, 0x4d, 0xfb, 0xd1, 0xfd, 0xf5,
+	0x05, 0x8d, 0x7c, 0x5d, 0xdd, 0xd4, 0x05, 0x23, 0x41, 0xb3, 0x36, 0x97, 0xb8, 0xe8, 0x73, 0xb8,
+	0x05, 0xe6, 0xfa, 0x1a, 0x36, 0x41, 0x29, 0x1e, 0x81, 0x32, 0x1b, 0x7b, 0xe8, 0xe3, 0x6b, 0x93,
+	0x5f, 0xdf, 0xad, 0x14, 0xfe, 0xba, 0x5b, 0x29, 0x5c, 0xbc, 0x53, 0x04, 0xab, 0x1f, 0xa1, 0x36,
+	0xf1, 0x23, 0x8e, 0x0f, 0x42, 0xc1, 0x05, 0x0a, 0xfc, 0xc8, 0x07, 0xf7, 0x11, 0xf3, 0x79, 0x0d,
+	0x7b, 0x94, 0xf9, 0xf0, 0x7d, 0x30, 0xdf, 0x8b, 0x8d, 0x0e, 0x69, 0x5f, 0x7d, 0x74, 0x7f, 0xfd,
+	0x82, 0x66, 0x4d, 0x80, 0x0e, 0x05, 0xd1, 0x3b, 0x74, 0x0e, 0xef, 0x58, 0xe0, 0x0c, 0x4d, 0xc9,
+	0x5c, 0xa6, 0xd8, 0xec, 0xe2, 0xca, 0xd8, 0x5a, 0x69, 0xe3, 0xbc, 0x2e, 0x91, 0x13, 0x95, 0x30,
+	0xae, 0xb6, 0xb3, 0x8d, 0xbd, 0x2d, 0x4a, 0x82, 0xcd, 0xab, 0x0f, 0x7e, 0xab, 0x14, 0x7e, 0xfc,
+	0xbd, 0x72, 0xb9, 0x49, 0x44, 0x2b, 0x6c, 0x38, 0x1e, 0xed, 0xe8, 0xaa, 0xe8, 0xff, 0xd6, 0xb9,
+	0x7f, 0xab, 0x2a, 0x3e, 0xef, 0x62, 0x1e, 0xfb, 0xf0, 0x7b, 0x7f, 0xfe, 0xfc, 0x82, 0x55, 0x83,
+	0x74, 0x28, 0x3e, 0x23, 0x13, 0x7f, 0x5b, 0xe0, 0x52, 0x1a, 0x80, 0xe7, 0x85, 0x9d, 0xb0, 0x8d,
+	0x04, 0xf6, 0xb7, 0x68, 0xa7, 0x43, 0x38, 0x27, 0x34, 0x38, 0xa1, 0x64, 0xb4, 0x40, 0x09, 0xa5,
+	0x74, 0xb2, 0x98, 0xa5, 0x8d, 0xd7, 0x9d, 0x9c, 0xce, 0x77, 0xf2, 0x75, 0x6e, 0x4e, 0x45, 0x29,
+	0x52, 0x31, 0x9b, 0xd0, 0x46, 0xb0, 0xff, 0x58, 0x60, 0x25, 0x01, 0x79, 0x87, 0x70, 0x41, 0x19,
+	0xf1, 0x50, 0xfb, 0x64, 0xab, 0xbe, 0x08, 0x26, 0xba, 0x98, 0x11, 0xaa, 0x62, 0x1c, 0xaf, 0xe9,
+	0x4f, 0xf0, 0x53, 0x70, 0x2a, 0x6e, 0x80, 0x31, 0x19, 0xfc, 0x6b, 0xa3, 0x05, 0x3f, 0xa4, 0xdb,
+	0x0c, 0x3c, 0x86, 0x34, 0x82, 0xfe, 0xc5, 0x02, 0x17, 0x12, 0xe7, 0xad, 0x90, 0x31, 0x1c, 0x88,
+	0x93, 0x8d, 0xf8, 0x93, 0x34, 0x32, 0x55, 0xd6, 0x57, 0x46, 0x8b, 0x6c, 0x50, 0xdc, 0x11, 0x61,
+	0xfd, 0x50, 0x04, 0xe7, 0x92, 0x91, 0x53, 0x17, 0x88, 0x09, 0x12, 0x34, 0xa3, 0x91, 0xa3, 0x83,
+	0x7a, 0x4a, 0x83, 0x27, 0x33, 0x37, 0xc5, 0xe3, 0xe7, 0xa6, 0x01, 0x66, 0xb8, 0x16, 0xeb, 0x92,
+	0x60, 0x8f, 0xea, 0xda, 0x6f, 0xe4, 0x66, 0x28, 0x33, 0x4e, 0x33, 0x3f, 0xd3, 0xdc, 0xb8, 0x30,
+	0x92, 0xf4, 0x5d, 0x11, 0x2c, 0x25, 0xd2, 0xea, 0x6d, 0xc4, 0x5b, 0x3b, 0x3d, 0x99, 0xe1, 0x93,
+	0xea, 0xf4, 0x16, 0x26, 0xcd, 0x96, 0x88, 0x3b, 0x5d, 0x7d, 0x32, 0x5e, 0xc0, 0xd8, 0xc0, 0x0b,
+	0xa0, 0xe0, 0x6c, 0xca, 0xcf, 0x23, 0x75, 0x2e, 0x8e, 0xe4, 0xd9, 0xe3, 0x32, 0x27, 0x2f, 0x8d,
+	0xd6, 0x35, 0x69, 0x58, 0x66, 0x46, 0xce, 0xf4, 0x86, 0xef, 0x8d, 0xc4, 0xfc, 0x5b, 0x02, 0xd3,
+	0x6f, 0xab, 0x9d, 0x5b, 0x17, 0x48, 0x60, 0x78, 0x03, 0x4c, 0x74, 0x11, 0x43, 0x1d, 0x95, 0x80,
+	0xd2, 0xc6, 0x73, 0xb9, 0xe4, 0xbb, 0xd2, 0xd4, 0xe4, 0xd3, 0xde, 0xf0, 0x3d, 0x30, 0xb9, 0x87,
+	0xb1, 0xdb, 0xa5, 0xb4, 0xad, 0x9b, 0xff, 0x52, 0x2e, 0xd2, 0x0d, 0x8c, 0x77, 0x29, 0x6d, 0x0f,
+	0x34, 0xfb, 0x9e, 0x3a, 0x83, 0x7d, 0x60, 0xa7, 0x2d, 0x9c, 0xac, 0xbf, 0xa8, 0x6b, 0xa2, 0x91,
+	0x31, 0x36, 0x7a, 0xdb, 0x98, 0x1b, 0xd9, 0x64, 0x5a, 0xf4, 0xb3, 0x2c, 0x78, 0xf4, 0x76, 0xba,
+	0x0c, 0xf7, 0x08, 0x0d, 0xe5, 0x17, 0x80, 0x2e, 0xe5, 0x98, 0xd9, 0xe3, 0x47, 0xbd, 0x9d, 0xd8,
+	0x65, 0x57, 0x7b, 0xc0, 0x2f, 0xb2, 0xd7, 0xdd, 0x33, 0x52, 0xfa, 0x9b, 0xa3, 0x55, 0xf7, 0x71,
+	0xcb, 0xd9, 0x0c, 0x23, 0x63, 0xc3, 0xc1, 0xef, 0x2d, 0xb0, 0x6a, 0x34, 0x77, 0xba, 0x0e, 0x5c,
+	0x2f, 0xd9, 0x18, 0xdc, 0x9e, 0x90, 0x52, 0xae, 0xff, 0x8f, 0xad, 0x33, 0xac, 0xa6, 0xd2, 0xcb,
+	0x75, 0xe0, 0xf0, 0x1b, 0x0b, 0x9c, 0x4f, 0xa5, 0xb5, 0x92, 0x71, 0x9e, 0x24, 0xe8, 0x94, 0x54,
+	0xf5, 0xc6, 0x31, 0xd7, 0xc1, 0xb0, 0xa2, 0xe5, 0xde, 0x63, 0x8d, 0xe1, 0x57, 0x16, 0x58, 0x4a,
+	0xc5, 0x78, 0x6a, 0x02, 0x27, 0x4a, 0x26, 0xa5, 0x92, 0x6b, 0xc7, 0x19, 0xdf, 0xc3, 0x32, 0x9e,
+	0xed, 0x65, 0x5b, 0xc2, 0x2f, 0xcd, 0x3e, 0x1f, 0x98, 0x8e, 0xdc, 0x9e, 0x92, 0x0a, 0xae, 0x3e,
+	0xf9, 0x78, 0x1c, 0xe6, 0x5f, 0xf4, 0xb3, 0xec, 0x38, 0xec, 0x83, 0xc5, 0xcc, 0x31, 0xc4, 0x6d,
+	0x20, 0xc9, 0x5f, 0x7d, 0xd2, 0x39, 0x34, 0x4c, 0xbd, 0x90, 0x31, 0x8d, 0x38, 0xac, 0x83, 0x19,
+	0x95, 0xe8, 0x98, 0xaf, 0x24, 0xf9, 0xd6, 0x72, 0xf9, 0x54, 0xd2, 0xd4, 0xbc, 0x1b, 0x8f, 0x18,
+	0x6a, 0xd3, 0x2c, 0x3d, 0xe2, 0xf0, 0x33, 0x30, 0x87, 0x6f, 0x0b, 0xcc, 0x02, 0xa3, 0xa1, 0xa6,
+	0x25, 0xee, 0x8b, 0xb9, 0xb8, 0x3b, 0xda, 0x29, 0xde, 0xbe, 0x0a, 0x7b, 0x16, 0x0f, 0x1e, 0x43,
+	0x17, 0xcc, 0xa7, 0x0f, 0xc8, 0xe5, 0xdd, 0x36, 0x11, 0xdc, 0x9e, 0x19, 0x01, 0x3f, 0x7d, 0x01,
+	0xf5, 0xc8, 0x49, 0xe3, 0xcf, 0x79, 0x83, 0xc7, 0x1c, 0xde, 0x04, 0xb3, 0x82, 0x85, 0x81, 0x87,
+	0x22, 0x6f, 0xd7, 0x0f, 0xb9, 0xb0, 0x4f, 0x4b, 0xf8, 0xcb, 0xb9, 0xf0, 0x1f, 0x26, 0x3e, 0xdb,
+	0x21, 0x8f, 0xd1, 0x4f, 0x8b, 0x81, 0xd3, 0x74, 0xfe, 0x6f, 0xbe, 0x75, 0x6f, 0xbf, 0x6c, 0x3d,
+	0xd8, 0x2f, 0x5b, 0x0f, 0xf7, 0xcb, 0xd6, 0x1f, 0xfb, 0x65, 0xeb, 0xdb, 0x83, 0x72, 0xe1, 0xe1,
+	0x41, 0xb9, 0xf0, 0xeb, 0x41, 0xb9, 0x70, 0x73, 0x55, 0x31, 0x71, 0xff, 0x96, 0x43, 0x68, 0xf5,
+	0xf6, 0xe0, 0x8f, 0x26, 0xf9, 0x1d, 0xbb, 0x31, 0x21, 0x7f, 0xf8, 0xbc, 0xfc, 0xdf, 0x00, 0x28,
+	0x19, 0xb4, 0x9f, 0xd6, 0x0d, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -784,6 +801,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TruncationDust) > 0 {
+		for iNdEx := len(m.TruncationDust) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TruncationDust[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.CommissionSplits) > 0 {
+		for iNdEx := len(m.CommissionSplits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommissionSplits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ExternalRewards) > 0 {
+		for iNdEx := len(m.ExternalRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExternalRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.RewardEvents) > 0 {
+		for iNdEx := len(m.RewardEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1103,6 +1176,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RewardEvents) > 0 {
+		for _, e := range m.RewardEvents {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExternalRewards) > 0 {
+		for _, e := range m.ExternalRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CommissionSplits) > 0 {
+		for _, e := range m.CommissionSplits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TruncationDust) > 0 {
+		for _, e := range m.TruncationDust {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2371,6 +2468,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardEvents = append(m.RewardEvents, RewardEvent{})
+			if err := m.RewardEvents[len(m.RewardEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalRewards = append(m.ExternalRewards, ExternalRewards{})
+			if err := m.ExternalRewards[len(m.ExternalRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionSplits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommissionSplits = append(m.CommissionSplits, CommissionSplit{})
+			if err := m.CommissionSplits[len(m.CommissionSplits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TruncationDust", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TruncationDust = append(m.TruncationDust, TruncationDust{})
+			if err := m.TruncationDust[len(m.TruncationDust)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
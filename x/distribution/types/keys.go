@@ -50,17 +50,39 @@ const (
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height><period>: ValidatorSlashEvent
 //
 // - 0x09: Params
+//
+// - 0x0A<accAddrLen (1 Byte)><accAddr_Bytes><id_Bytes>: RewardEvent
+//
+// - 0x0B: RewardEventSequence
+//
+// - 0x0C<valAddrLen (1 Byte)><valAddr_Bytes><source_Bytes>: ExternalRewards
+//
+// - 0x0D<valAddrLen (1 Byte)><valAddr_Bytes>: CommissionSplit
+//
+// - 0x0E<valAddr_Bytes>: PendingRewardAllocation
+//
+// - 0x10: HistoricalRewardsCount
+//
+// - 0x11: HistoricalRewardsPruningCursor
 var (
-	FeePoolKey                           = collections.NewPrefix(0) // key for global distribution state
-	ProposerKey                          = collections.NewPrefix(1) // key for the proposer operator address
-	ValidatorOutstandingRewardsPrefix    = collections.NewPrefix(2) // key for outstanding rewards
-	DelegatorWithdrawAddrPrefix          = collections.NewPrefix(3) // key for delegator withdraw address
-	DelegatorStartingInfoPrefix          = collections.NewPrefix(4) // key for delegator starting info
-	ValidatorHistoricalRewardsPrefix     = collections.NewPrefix(5) // key for historical validators rewards / stake
-	ValidatorCurrentRewardsPrefix        = collections.NewPrefix(6) // key for current validator rewards
-	ValidatorAccumulatedCommissionPrefix = collections.NewPrefix(7) // key for accumulated validator commission
-	ValidatorSlashEventPrefix            = collections.NewPrefix(8) // key for validator slash fraction
-	ParamsKey                            = collections.NewPrefix(9) // key for distribution module params
+	FeePoolKey                           = collections.NewPrefix(0)  // key for global distribution state
+	ProposerKey                          = collections.NewPrefix(1)  // key for the proposer operator address
+	ValidatorOutstandingRewardsPrefix    = collections.NewPrefix(2)  // key for outstanding rewards
+	DelegatorWithdrawAddrPrefix          = collections.NewPrefix(3)  // key for delegator withdraw address
+	DelegatorStartingInfoPrefix          = collections.NewPrefix(4)  // key for delegator starting info
+	ValidatorHistoricalRewardsPrefix     = collections.NewPrefix(5)  // key for historical validators rewards / stake
+	ValidatorCurrentRewardsPrefix        = collections.NewPrefix(6)  // key for current validator rewards
+	ValidatorAccumulatedCommissionPrefix = collections.NewPrefix(7)  // key for accumulated validator commission
+	ValidatorSlashEventPrefix            = collections.NewPrefix(8)  // key for validator slash fraction
+	ParamsKey                            = collections.NewPrefix(9)  // key for distribution module params
+	RewardEventsPrefix                   = collections.NewPrefix(10) // key for the log of reward withdrawals
+	RewardEventSequenceKey               = collections.NewPrefix(11) // key for the reward event id sequence
+	ExternalRewardsPrefix                = collections.NewPrefix(12) // key for the rewards added by the external reward sources
+	CommissionSplitsPrefix               = collections.NewPrefix(13) // key for the commission splits of the validators
+	PendingRewardAllocationsPrefix       = collections.NewPrefix(14) // key for the rewards allocated to the validators not written to their records yet
+	TruncationDustPrefix                 = collections.NewPrefix(15) // key for the fractions by which the rewards paid out for the validators were rounded
+	HistoricalRewardsCountKey            = collections.NewPrefix(16) // key for the number of historical rewards records
+	HistoricalRewardsPruningCursorKey    = collections.NewPrefix(17) // key for the validator the historical rewards pruning resumes from
 )
 
 // GetValidatorSlashEventAddressHeight creates the height from a validator's slash event key.
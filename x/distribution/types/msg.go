@@ -13,8 +13,15 @@ var (
 	_ sdk.Msg = (*MsgCommunityPoolSpend)(nil)
 	_ sdk.Msg = (*MsgFundCommunityPool)(nil)
 	_ sdk.Msg = (*MsgDepositValidatorRewardsPool)(nil)
+	_ sdk.Msg = (*MsgWithdrawTokenizeShareRecordReward)(nil)
+	_ sdk.Msg = (*MsgWithdrawAllDelegatorRewards)(nil)
+	_ sdk.Msg = (*MsgSetCommissionSplit)(nil)
 )
 
+// WithdrawAllRewardsGasPerValidator is the gas limit of the withdrawal of the
+// rewards of each delegation by MsgWithdrawAllDelegatorRewards.
+const WithdrawAllRewardsGasPerValidator uint64 = 300_000
+
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr sdk.AccAddress) *MsgSetWithdrawAddress {
 	return &MsgSetWithdrawAddress{
 		DelegatorAddress: delAddr.String(),
@@ -53,3 +60,29 @@ func NewMsgDepositValidatorRewardsPool(depositor, valAddr string, amount sdk.Coi
 		ValidatorAddress: valAddr,
 	}
 }
+
+// NewMsgWithdrawTokenizeShareRecordReward returns a new MsgWithdrawTokenizeShareRecordReward
+// for an owner of tokenize share records.
+func NewMsgWithdrawTokenizeShareRecordReward(owner string) *MsgWithdrawTokenizeShareRecordReward {
+	return &MsgWithdrawTokenizeShareRecordReward{
+		OwnerAddress: owner,
+	}
+}
+
+// NewMsgWithdrawAllDelegatorRewards returns a new MsgWithdrawAllDelegatorRewards
+// for a delegator, withdrawing to its withdraw address if destination is empty.
+func NewMsgWithdrawAllDelegatorRewards(delAddr, destination string) *MsgWithdrawAllDelegatorRewards {
+	return &MsgWithdrawAllDelegatorRewards{
+		DelegatorAddress:   delAddr,
+		DestinationAddress: destination,
+	}
+}
+
+// NewMsgSetCommissionSplit returns a new MsgSetCommissionSplit for a validator,
+// removing its commission split if recipients is empty.
+func NewMsgSetCommissionSplit(valAddr string, recipients []CommissionSplitRecipient) *MsgSetCommissionSplit {
+	return &MsgSetCommissionSplit{
+		ValidatorAddress: valAddr,
+		Recipients:       recipients,
+	}
+}
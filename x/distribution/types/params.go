@@ -18,7 +18,19 @@ func DefaultParams() Params {
 
 // ValidateBasic performs basic validation on distribution parameters.
 func (p Params) ValidateBasic() error {
-	return validateCommunityTax(p.CommunityTax)
+	if err := validateCommunityTax(p.CommunityTax); err != nil {
+		return err
+	}
+
+	return validateRoundingPolicy(p.RoundingPolicy)
+}
+
+func validateRoundingPolicy(policy RoundingPolicy) error {
+	if _, ok := RoundingPolicy_name[int32(policy)]; !ok {
+		return fmt.Errorf("invalid rounding policy: %d", policy)
+	}
+
+	return nil
 }
 
 func validateCommunityTax(i interface{}) error {
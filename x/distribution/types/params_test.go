@@ -44,6 +44,16 @@ func TestParams_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestParams_ValidateBasic_RoundingPolicy(t *testing.T) {
+	p := types.DefaultParams()
+
+	p.RoundingPolicy = types.RoundingPolicyBankers
+	require.NoError(t, p.ValidateBasic())
+
+	p.RoundingPolicy = types.RoundingPolicy(2)
+	require.ErrorContains(t, p.ValidateBasic(), "invalid rounding policy")
+}
+
 func TestDefaultParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().ValidateBasic())
 }
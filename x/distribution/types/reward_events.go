@@ -0,0 +1,63 @@
+package types
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// RewardEventsCSVHeader is the header row of the CSV export of reward events.
+var RewardEventsCSVHeader = []string{"id", "height", "time", "delegator_address", "validator_address", "withdraw_address", "denom", "amount"}
+
+// RewardEventsCSV formats reward events as CSV, header included, with a row
+// per withdrawn denom.
+func RewardEventsCSV(events []RewardEvent) (string, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(RewardEventsCSVHeader); err != nil {
+		return "", err
+	}
+
+	for _, event := range events {
+		for _, coin := range event.Amount {
+			if err := w.Write([]string{
+				strconv.FormatUint(event.Id, 10),
+				strconv.FormatInt(event.Height, 10),
+				event.Time.UTC().Format(time.RFC3339),
+				event.DelegatorAddress,
+				event.ValidatorAddress,
+				event.WithdrawAddress,
+				coin.Denom,
+				coin.Amount.String(),
+			}); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	w.Flush()
+	return buf.String(), w.Error()
+}
+
+// Validate performs a basic validation of a reward event.
+func (e RewardEvent) Validate() error {
+	if e.DelegatorAddress == "" {
+		return fmt.Errorf("reward event %d: empty delegator address", e.Id)
+	}
+
+	if e.ValidatorAddress == "" {
+		return fmt.Errorf("reward event %d: empty validator address", e.Id)
+	}
+
+	if err := e.Amount.Validate(); err != nil {
+		return fmt.Errorf("reward event %d: invalid amount: %w", e.Id, err)
+	}
+
+	if e.Height < 0 {
+		return fmt.Errorf("reward event %d: negative height", e.Id)
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package types
+
+import "fmt"
+
+// Validate performs a basic validation of the truncation dust of a validator.
+func (d TruncationDust) Validate() error {
+	if d.ValidatorAddress == "" {
+		return ErrEmptyValidatorAddr
+	}
+
+	if err := d.Truncated.Validate(); err != nil {
+		return fmt.Errorf("invalid truncated dust of validator %s: %w", d.ValidatorAddress, err)
+	}
+
+	if err := d.RoundedUp.Validate(); err != nil {
+		return fmt.Errorf("invalid rounded up dust of validator %s: %w", d.ValidatorAddress, err)
+	}
+
+	return nil
+}
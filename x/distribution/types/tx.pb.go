@@ -665,6 +665,264 @@ func (m *MsgDepositValidatorRewardsPoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDepositValidatorRewardsPoolResponse proto.InternalMessageInfo
 
+// MsgWithdrawTokenizeShareRecordReward withdraws the rewards of the tokenized
+// shares of all the tokenize share records of an owner to the owner address.
+type MsgWithdrawTokenizeShareRecordReward struct {
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) Reset()         { *m = MsgWithdrawTokenizeShareRecordReward{} }
+func (m *MsgWithdrawTokenizeShareRecordReward) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawTokenizeShareRecordReward) ProtoMessage()    {}
+func (*MsgWithdrawTokenizeShareRecordReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{14}
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.Merge(m, src)
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward proto.InternalMessageInfo
+
+// MsgWithdrawTokenizeShareRecordRewardResponse defines the
+// Msg/WithdrawTokenizeShareRecordReward response type.
+type MsgWithdrawTokenizeShareRecordRewardResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Reset() {
+	*m = MsgWithdrawTokenizeShareRecordRewardResponse{}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgWithdrawTokenizeShareRecordRewardResponse) ProtoMessage() {}
+func (*MsgWithdrawTokenizeShareRecordRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{15}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.Merge(m, src)
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawTokenizeShareRecordRewardResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgWithdrawAllDelegatorRewards withdraws the rewards of all the delegations of
+// a delegator, to the destination address if set, or else to the withdraw
+// address of the delegator.
+type MsgWithdrawAllDelegatorRewards struct {
+	DelegatorAddress   string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	DestinationAddress string `protobuf:"bytes,2,opt,name=destination_address,json=destinationAddress,proto3" json:"destination_address,omitempty"`
+}
+
+func (m *MsgWithdrawAllDelegatorRewards) Reset()         { *m = MsgWithdrawAllDelegatorRewards{} }
+func (m *MsgWithdrawAllDelegatorRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawAllDelegatorRewards) ProtoMessage()    {}
+func (*MsgWithdrawAllDelegatorRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{16}
+}
+func (m *MsgWithdrawAllDelegatorRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawAllDelegatorRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawAllDelegatorRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawAllDelegatorRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawAllDelegatorRewards.Merge(m, src)
+}
+func (m *MsgWithdrawAllDelegatorRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawAllDelegatorRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawAllDelegatorRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawAllDelegatorRewards proto.InternalMessageInfo
+
+// MsgWithdrawAllDelegatorRewardsResponse defines the
+// Msg/WithdrawAllDelegatorRewards response type.
+type MsgWithdrawAllDelegatorRewardsResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawAllDelegatorRewardsResponse) Reset() {
+	*m = MsgWithdrawAllDelegatorRewardsResponse{}
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawAllDelegatorRewardsResponse) ProtoMessage()    {}
+func (*MsgWithdrawAllDelegatorRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{17}
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawAllDelegatorRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawAllDelegatorRewardsResponse.Merge(m, src)
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawAllDelegatorRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawAllDelegatorRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawAllDelegatorRewardsResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgSetCommissionSplit sets the payout addresses between which the commission
+// of a validator is split on withdrawal, replacing the previous ones. Empty
+// recipients remove the split, the commission being withdrawn to the withdraw
+// address of the validator again.
+type MsgSetCommissionSplit struct {
+	ValidatorAddress string                     `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Recipients       []CommissionSplitRecipient `protobuf:"bytes,2,rep,name=recipients,proto3" json:"recipients"`
+}
+
+func (m *MsgSetCommissionSplit) Reset()         { *m = MsgSetCommissionSplit{} }
+func (m *MsgSetCommissionSplit) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCommissionSplit) ProtoMessage()    {}
+func (*MsgSetCommissionSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{18}
+}
+func (m *MsgSetCommissionSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCommissionSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCommissionSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCommissionSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCommissionSplit.Merge(m, src)
+}
+func (m *MsgSetCommissionSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCommissionSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCommissionSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCommissionSplit proto.InternalMessageInfo
+
+// MsgSetCommissionSplitResponse defines the Msg/SetCommissionSplit response
+// type.
+type MsgSetCommissionSplitResponse struct {
+}
+
+func (m *MsgSetCommissionSplitResponse) Reset()         { *m = MsgSetCommissionSplitResponse{} }
+func (m *MsgSetCommissionSplitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCommissionSplitResponse) ProtoMessage()    {}
+func (*MsgSetCommissionSplitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{19}
+}
+func (m *MsgSetCommissionSplitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCommissionSplitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCommissionSplitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCommissionSplitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCommissionSplitResponse.Merge(m, src)
+}
+func (m *MsgSetCommissionSplitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCommissionSplitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCommissionSplitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCommissionSplitResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddress")
 	proto.RegisterType((*MsgSetWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddressResponse")
@@ -680,6 +938,12 @@ func init() {
 	proto.RegisterType((*MsgCommunityPoolSpendResponse)(nil), "cosmos.distribution.v1beta1.MsgCommunityPoolSpendResponse")
 	proto.RegisterType((*MsgDepositValidatorRewardsPool)(nil), "cosmos.distribution.v1beta1.MsgDepositValidatorRewardsPool")
 	proto.RegisterType((*MsgDepositValidatorRewardsPoolResponse)(nil), "cosmos.distribution.v1beta1.MsgDepositValidatorRewardsPoolResponse")
+	proto.RegisterType((*MsgWithdrawTokenizeShareRecordReward)(nil), "cosmos.distribution.v1beta1.MsgWithdrawTokenizeShareRecordReward")
+	proto.RegisterType((*MsgWithdrawTokenizeShareRecordRewardResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawTokenizeShareRecordRewardResponse")
+	proto.RegisterType((*MsgWithdrawAllDelegatorRewards)(nil), "cosmos.distribution.v1beta1.MsgWithdrawAllDelegatorRewards")
+	proto.RegisterType((*MsgWithdrawAllDelegatorRewardsResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawAllDelegatorRewardsResponse")
+	proto.RegisterType((*MsgSetCommissionSplit)(nil), "cosmos.distribution.v1beta1.MsgSetCommissionSplit")
+	proto.RegisterType((*MsgSetCommissionSplitResponse)(nil), "cosmos.distribution.v1beta1.MsgSetCommissionSplitResponse")
 }
 
 func init() {
@@ -687,67 +951,80 @@ func init() {
 }
 
 var fileDescriptor_ed4f433d965e58ca = []byte{
-	// 948 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0xce, 0x24, 0x62, 0x51, 0xa6, 0x95, 0xda, 0x58, 0x8b, 0x36, 0xeb, 0xb6, 0x4e, 0xeb, 0x85,
-	0x25, 0x8a, 0x58, 0x5b, 0x09, 0x08, 0x84, 0x8b, 0x54, 0x48, 0x4a, 0x24, 0x0e, 0x41, 0x55, 0x56,
-	0x80, 0xc4, 0x65, 0xe5, 0xc4, 0xc6, 0x3b, 0xea, 0xda, 0x63, 0x79, 0x26, 0x49, 0x73, 0x43, 0x08,
-	0x41, 0xc5, 0x09, 0x89, 0x1b, 0x97, 0x56, 0x42, 0x48, 0x15, 0xa7, 0x1c, 0x7a, 0xe0, 0x27, 0xf4,
-	0x82, 0x54, 0xf5, 0xc4, 0x09, 0x50, 0x56, 0x28, 0x48, 0x9c, 0xf8, 0x03, 0x08, 0xd9, 0x1e, 0x3b,
-	0x76, 0xec, 0xd8, 0xbb, 0x4b, 0x45, 0xb9, 0xec, 0xae, 0x66, 0xde, 0xfb, 0xe6, 0x7b, 0xdf, 0x7c,
-	0xf3, 0x9e, 0x17, 0xbe, 0x38, 0xc4, 0xc4, 0xc4, 0x44, 0xd6, 0x10, 0xa1, 0x0e, 0x1a, 0x8c, 0x28,
-	0xc2, 0x96, 0x3c, 0x6e, 0x0e, 0x74, 0xaa, 0x36, 0x65, 0x7a, 0x47, 0xb2, 0x1d, 0x4c, 0x31, 0x77,
-	0xc9, 0x8f, 0x92, 0xa2, 0x51, 0x12, 0x8b, 0xe2, 0x37, 0x0d, 0x6c, 0x60, 0x2f, 0x4e, 0x76, 0xff,
-	0xf2, 0x53, 0x78, 0x81, 0x01, 0x0f, 0x54, 0xa2, 0x87, 0x80, 0x43, 0x8c, 0x2c, 0xb6, 0xbf, 0xed,
-	0xef, 0x1f, 0xf8, 0x89, 0x0c, 0xdf, 0xdf, 0xda, 0x62, 0xa9, 0x26, 0x31, 0xe4, 0x71, 0xd3, 0xfd,
-	0xc5, 0x36, 0x2a, 0xaa, 0x89, 0x2c, 0x2c, 0x7b, 0x3f, 0xd9, 0x92, 0x94, 0xc5, 0x3f, 0x46, 0xd7,
-	0x8b, 0x17, 0xff, 0x04, 0xf0, 0x85, 0x1e, 0x31, 0xf6, 0x75, 0xfa, 0x11, 0xa2, 0x87, 0x9a, 0xa3,
-	0x4e, 0xde, 0xd1, 0x34, 0x47, 0x27, 0x84, 0x7b, 0x17, 0x56, 0x34, 0xfd, 0x48, 0x37, 0x54, 0x8a,
-	0x9d, 0x03, 0xd5, 0x5f, 0xac, 0x82, 0xab, 0xa0, 0x5e, 0x6e, 0x57, 0x9f, 0x3c, 0xdc, 0xdb, 0x64,
-	0x14, 0x59, 0xf8, 0x3e, 0x75, 0x90, 0x65, 0xf4, 0x2f, 0x86, 0x29, 0x01, 0x4c, 0x07, 0x5e, 0x9c,
-	0x30, 0xe4, 0x10, 0xa5, 0x98, 0x83, 0x72, 0x61, 0x12, 0xe7, 0xa2, 0x74, 0xef, 0xde, 0xaf, 0x15,
-	0xfe, 0xb8, 0x5f, 0x2b, 0x7c, 0xb6, 0x98, 0x35, 0x92, 0xb4, 0xbe, 0x5a, 0xcc, 0x1a, 0x3b, 0x3e,
-	0xd2, 0x1e, 0xd1, 0x6e, 0xcb, 0x3d, 0x62, 0xf4, 0xb0, 0x86, 0x3e, 0x99, 0xae, 0xd4, 0x24, 0xd6,
-	0xe0, 0x95, 0xd4, 0x62, 0xfb, 0x3a, 0xb1, 0xb1, 0x45, 0x74, 0xf1, 0x6f, 0x00, 0xf9, 0x1e, 0x31,
-	0x82, 0xed, 0x9b, 0xc1, 0x49, 0x7d, 0x7d, 0xa2, 0x3a, 0xda, 0xd3, 0xd2, 0xe4, 0x7d, 0x58, 0x19,
-	0xab, 0x47, 0x48, 0x8b, 0xc1, 0xf8, 0xa2, 0x5c, 0x7b, 0xf2, 0x70, 0xef, 0x0a, 0x83, 0xf9, 0x30,
-	0x88, 0x59, 0xc1, 0x1b, 0xaf, 0xac, 0x2b, 0xef, 0xe5, 0xcb, 0xb3, 0x1b, 0x97, 0x67, 0xa5, 0x40,
-	0x84, 0x2d, 0xbf, 0x42, 0xf1, 0x1e, 0x80, 0xe2, 0x7a, 0x01, 0x02, 0x9d, 0xb8, 0x29, 0xdc, 0x50,
-	0x4d, 0x3c, 0xb2, 0x68, 0x15, 0x5c, 0x2d, 0xd5, 0xcf, 0xb5, 0xb6, 0x99, 0xef, 0x24, 0xd7, 0xde,
-	0xc1, 0x4b, 0x90, 0x3a, 0x18, 0x59, 0xed, 0xee, 0xa3, 0x5f, 0x6a, 0x85, 0x1f, 0x7e, 0xad, 0xd5,
-	0x0d, 0x44, 0x0f, 0x47, 0x03, 0x69, 0x88, 0x4d, 0x66, 0x6f, 0x39, 0xc2, 0x89, 0x4e, 0x6d, 0x9d,
-	0x78, 0x09, 0xe4, 0xdb, 0xc5, 0xac, 0x71, 0xde, 0x3d, 0x76, 0x38, 0x3d, 0x70, 0x1f, 0x08, 0x79,
-	0xb0, 0x98, 0x35, 0x40, 0x9f, 0x1d, 0x28, 0xfe, 0x08, 0xa0, 0x10, 0x61, 0x18, 0x8a, 0xd4, 0xc1,
-	0xa6, 0x89, 0x08, 0x41, 0xd8, 0x4a, 0xd7, 0x17, 0x9c, 0x5d, 0xdf, 0xb8, 0xfd, 0x12, 0xd0, 0x29,
-	0xf6, 0x8b, 0xb0, 0x5b, 0xf2, 0x12, 0xbf, 0x03, 0x70, 0x37, 0x9b, 0xfa, 0xff, 0x41, 0xe0, 0x2f,
-	0x8b, 0x70, 0xb3, 0x47, 0x8c, 0xee, 0xc8, 0xd2, 0x5c, 0x62, 0x23, 0x0b, 0xd1, 0xe9, 0x2d, 0x8c,
-	0x8f, 0x9e, 0x21, 0x27, 0xee, 0x75, 0x58, 0xd6, 0x74, 0x1b, 0x13, 0x44, 0xb1, 0x93, 0xdb, 0x3e,
-	0x96, 0xa1, 0xca, 0x5b, 0xd1, 0x9b, 0x5b, 0xae, 0xbb, 0x37, 0x56, 0x8b, 0xdf, 0x58, 0xa2, 0xdc,
-	0x2a, 0x10, 0x45, 0x78, 0x39, 0x6d, 0x27, 0xb8, 0x24, 0xa5, 0x58, 0x05, 0xe2, 0x4f, 0x00, 0x5e,
-	0xe8, 0x11, 0xe3, 0x03, 0x5b, 0x53, 0xa9, 0x7e, 0x4b, 0x75, 0x54, 0x93, 0xb8, 0x6c, 0xd5, 0x11,
-	0x3d, 0xc4, 0x0e, 0xa2, 0xd3, 0xdc, 0xf6, 0xb0, 0x0c, 0xe5, 0xba, 0x70, 0xc3, 0xf6, 0x10, 0xbc,
-	0x12, 0xcf, 0xb5, 0x76, 0xa4, 0x8c, 0x39, 0x23, 0xf9, 0x87, 0xb5, 0xcb, 0xae, 0xd4, 0x4c, 0x2d,
-	0x3f, 0x5b, 0x51, 0xbc, 0x6a, 0x43, 0x5c, 0xb7, 0xda, 0x97, 0x23, 0xd5, 0xc6, 0x66, 0xc3, 0x0a,
-	0x77, 0x71, 0x1b, 0x6e, 0xad, 0x2c, 0x85, 0xcd, 0xf1, 0xfb, 0xa2, 0x37, 0x2b, 0x62, 0x5a, 0xec,
-	0xdb, 0xba, 0xa5, 0x9d, 0xb9, 0xe0, 0xcb, 0xb0, 0xec, 0xe8, 0x43, 0x64, 0x23, 0xdd, 0xa2, 0xfe,
-	0xb5, 0xf6, 0x97, 0x0b, 0x11, 0xbf, 0x95, 0xfe, 0x63, 0xbf, 0x29, 0xd7, 0x93, 0x0a, 0xee, 0xae,
-	0x2a, 0x28, 0xa7, 0x6a, 0x51, 0x05, 0xe2, 0x8e, 0x37, 0x65, 0x92, 0x5b, 0x31, 0xdf, 0xfc, 0x5e,
-	0xf4, 0xda, 0xd8, 0x4d, 0xdf, 0x92, 0x61, 0x2b, 0xf0, 0xfb, 0x2c, 0xf1, 0xde, 0x5b, 0xcc, 0xf4,
-	0xe0, 0xc4, 0xa6, 0x7f, 0xda, 0xe3, 0xe5, 0x59, 0xde, 0xc3, 0xdb, 0xeb, 0xdf, 0xef, 0x4b, 0x69,
-	0xf7, 0xb1, 0x94, 0x93, 0x09, 0x29, 0xd6, 0xbd, 0x96, 0x9b, 0x21, 0x73, 0x70, 0x2b, 0xad, 0xbf,
-	0x9e, 0x87, 0xa5, 0x1e, 0x31, 0xb8, 0xcf, 0x01, 0xe4, 0x52, 0xbe, 0x87, 0x5a, 0x99, 0x8f, 0x31,
-	0xf5, 0xb3, 0x82, 0x57, 0x4e, 0x9f, 0x13, 0x4e, 0x80, 0x6f, 0x00, 0xdc, 0x5a, 0xf7, 0x1d, 0xf2,
-	0x46, 0x1e, 0xee, 0x9a, 0x44, 0xfe, 0xc6, 0x19, 0x13, 0x43, 0x56, 0xf7, 0x00, 0xbc, 0x94, 0x35,
-	0x7a, 0xaf, 0x9f, 0xf4, 0x80, 0x94, 0x64, 0xbe, 0xf3, 0x2f, 0x92, 0x43, 0x86, 0x5f, 0x00, 0x58,
-	0x49, 0xce, 0xae, 0x66, 0x1e, 0x74, 0x22, 0x85, 0x7f, 0xf3, 0xd4, 0x29, 0x61, 0xa7, 0x2c, 0xdd,
-	0x2d, 0x02, 0xce, 0x81, 0xe7, 0x63, 0x53, 0xe1, 0x95, 0x3c, 0xbc, 0x68, 0x34, 0xff, 0xda, 0x69,
-	0xa2, 0xc3, 0xe2, 0x5d, 0xef, 0xa6, 0xf4, 0xe7, 0x5c, 0xef, 0x26, 0x73, 0xf2, 0xbd, 0xbb, 0xbe,
-	0xc1, 0x79, 0x2e, 0xc9, 0xea, 0x6c, 0xb9, 0x2e, 0xc9, 0x48, 0xce, 0x77, 0xc9, 0x09, 0x1e, 0x3b,
-	0xff, 0xdc, 0xa7, 0x6e, 0x9f, 0x69, 0xdf, 0x78, 0x30, 0x17, 0xc0, 0xa3, 0xb9, 0x00, 0x1e, 0xcf,
-	0x05, 0xf0, 0xdb, 0x5c, 0x00, 0x5f, 0x1f, 0x0b, 0x85, 0xc7, 0xc7, 0x42, 0xe1, 0xe7, 0x63, 0xa1,
-	0xf0, 0xf1, 0x35, 0xff, 0x20, 0xa2, 0xdd, 0x96, 0x10, 0x96, 0xef, 0xc4, 0x07, 0xa7, 0xd7, 0xc4,
-	0x06, 0x1b, 0xde, 0xbf, 0x51, 0xaf, 0xfe, 0x13, 0x00, 0x00, 0xff, 0xff, 0x70, 0xb5, 0x49, 0xbe,
-	0x38, 0x0e, 0x00, 0x00,
+	// 1158 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcb, 0x6b, 0x24, 0x45,
+	0x18, 0x9f, 0x9a, 0x60, 0x20, 0x95, 0xc8, 0x6e, 0xda, 0x48, 0x26, 0xbd, 0x9b, 0x99, 0xa4, 0x13,
+	0x63, 0x08, 0x9b, 0x6e, 0x12, 0x5f, 0xd8, 0xab, 0xac, 0xc9, 0xac, 0x81, 0x1c, 0x46, 0x96, 0x19,
+	0x1f, 0xe0, 0x25, 0xf6, 0x4c, 0x97, 0x9d, 0x22, 0x33, 0x5d, 0x43, 0x57, 0x4d, 0x66, 0xc7, 0x93,
+	0x88, 0xe8, 0xe2, 0x41, 0x04, 0x6f, 0x5e, 0x76, 0x41, 0x84, 0x75, 0x4f, 0x11, 0xf6, 0xe0, 0x9f,
+	0xb0, 0x17, 0x65, 0xd9, 0x93, 0x27, 0x95, 0x04, 0x89, 0xe0, 0x1f, 0xe0, 0x4d, 0xa4, 0x9f, 0xd3,
+	0x8f, 0x9a, 0xe9, 0x99, 0x24, 0x6c, 0x72, 0x49, 0x42, 0xd5, 0xf7, 0xfb, 0xf5, 0xef, 0x7b, 0x54,
+	0x7d, 0x5f, 0x05, 0x2e, 0xd6, 0x08, 0x6d, 0x10, 0xaa, 0xe8, 0x98, 0x32, 0x0b, 0x57, 0x5b, 0x0c,
+	0x13, 0x53, 0xd9, 0x5f, 0xab, 0x22, 0xa6, 0xad, 0x29, 0xec, 0xb6, 0xdc, 0xb4, 0x08, 0x23, 0xc2,
+	0x15, 0xd7, 0x4a, 0x0e, 0x5b, 0xc9, 0x9e, 0x95, 0x38, 0x65, 0x10, 0x83, 0x38, 0x76, 0x8a, 0xfd,
+	0x97, 0x0b, 0x11, 0xf3, 0x1e, 0x71, 0x55, 0xa3, 0x28, 0x20, 0xac, 0x11, 0x6c, 0x7a, 0xfb, 0x33,
+	0xee, 0xfe, 0x8e, 0x0b, 0xf4, 0xf8, 0xdd, 0xad, 0x69, 0x0f, 0xda, 0xa0, 0x86, 0xb2, 0xbf, 0x66,
+	0xff, 0xf2, 0x36, 0x26, 0xb5, 0x06, 0x36, 0x89, 0xe2, 0xfc, 0xf4, 0x96, 0xe4, 0x7e, 0xfa, 0x23,
+	0x72, 0x1d, 0x7b, 0xe9, 0x1f, 0x00, 0x9f, 0x2f, 0x51, 0xa3, 0x82, 0xd8, 0x07, 0x98, 0xed, 0xea,
+	0x96, 0xd6, 0xde, 0xd0, 0x75, 0x0b, 0x51, 0x2a, 0xbc, 0x0d, 0x27, 0x75, 0x54, 0x47, 0x86, 0xc6,
+	0x88, 0xb5, 0xa3, 0xb9, 0x8b, 0x39, 0x30, 0x07, 0x96, 0xc7, 0x36, 0x73, 0x4f, 0x1e, 0xae, 0x4e,
+	0x79, 0x12, 0x3d, 0xf3, 0x0a, 0xb3, 0xb0, 0x69, 0x94, 0x2f, 0x07, 0x10, 0x9f, 0xa6, 0x08, 0x2f,
+	0xb7, 0x3d, 0xe6, 0x80, 0x25, 0x9b, 0xc2, 0x72, 0xa9, 0x1d, 0xd5, 0xa2, 0x6e, 0xdd, 0xb9, 0x57,
+	0xc8, 0xfc, 0x7d, 0xaf, 0x90, 0xf9, 0xec, 0xf8, 0x60, 0x25, 0x29, 0xeb, 0xab, 0xe3, 0x83, 0x95,
+	0x05, 0x97, 0x69, 0x95, 0xea, 0x7b, 0x4a, 0x89, 0x1a, 0x25, 0xa2, 0xe3, 0x8f, 0x3b, 0x31, 0x9f,
+	0xa4, 0x02, 0x9c, 0xe5, 0x3a, 0x5b, 0x46, 0xb4, 0x49, 0x4c, 0x8a, 0xa4, 0xff, 0x00, 0x14, 0x4b,
+	0xd4, 0xf0, 0xb7, 0x6f, 0xfa, 0x5f, 0x2a, 0xa3, 0xb6, 0x66, 0xe9, 0x67, 0x15, 0x93, 0x77, 0xe0,
+	0xe4, 0xbe, 0x56, 0xc7, 0x7a, 0x84, 0xc6, 0x0d, 0xca, 0xfc, 0x93, 0x87, 0xab, 0xb3, 0x1e, 0xcd,
+	0xfb, 0xbe, 0x4d, 0x8c, 0x6f, 0x3f, 0xb6, 0xae, 0x6e, 0xa7, 0x87, 0x67, 0x29, 0x1a, 0x9e, 0x98,
+	0x83, 0x98, 0x98, 0xae, 0x87, 0xd2, 0x5d, 0x00, 0xa5, 0xde, 0x01, 0xf0, 0xe3, 0x24, 0x74, 0xe0,
+	0xa8, 0xd6, 0x20, 0x2d, 0x93, 0xe5, 0xc0, 0xdc, 0xc8, 0xf2, 0xf8, 0xfa, 0x8c, 0x57, 0x77, 0xb2,
+	0x5d, 0xde, 0xfe, 0x49, 0x90, 0x8b, 0x04, 0x9b, 0x9b, 0x5b, 0x8f, 0x7e, 0x2f, 0x64, 0x1e, 0xfc,
+	0x51, 0x58, 0x36, 0x30, 0xdb, 0x6d, 0x55, 0xe5, 0x1a, 0x69, 0x78, 0xe5, 0xad, 0x84, 0x34, 0xb1,
+	0x4e, 0x13, 0x51, 0x07, 0x40, 0xbf, 0x3b, 0x3e, 0x58, 0x99, 0xb0, 0x3f, 0x5b, 0xeb, 0xec, 0xd8,
+	0x07, 0x84, 0xde, 0x3f, 0x3e, 0x58, 0x01, 0x65, 0xef, 0x83, 0xd2, 0xcf, 0x00, 0xe6, 0x43, 0x0a,
+	0x83, 0x20, 0x15, 0x49, 0xa3, 0x81, 0x29, 0xc5, 0xc4, 0xe4, 0xc7, 0x17, 0x9c, 0x3c, 0xbe, 0xd1,
+	0xf2, 0x4b, 0x50, 0x73, 0xca, 0x2f, 0xa4, 0xae, 0xab, 0x4b, 0xfa, 0x1e, 0xc0, 0xa5, 0xfe, 0xd2,
+	0x2f, 0x42, 0x80, 0xbf, 0xcc, 0xc2, 0xa9, 0x12, 0x35, 0xb6, 0x5a, 0xa6, 0x6e, 0x0b, 0x6b, 0x99,
+	0x98, 0x75, 0x6e, 0x11, 0x52, 0x3f, 0x47, 0x4d, 0xc2, 0xab, 0x70, 0x4c, 0x47, 0x4d, 0x42, 0x31,
+	0x23, 0x56, 0xea, 0xf5, 0xd1, 0x35, 0x55, 0xdf, 0x08, 0x67, 0xae, 0xbb, 0x6e, 0x67, 0xac, 0x10,
+	0xcd, 0x58, 0xc2, 0xdd, 0x1c, 0x90, 0x24, 0x78, 0x95, 0xb7, 0xe3, 0x27, 0x49, 0xcd, 0xe6, 0x80,
+	0xf4, 0x0b, 0x80, 0x97, 0x4a, 0xd4, 0x78, 0xaf, 0xa9, 0x6b, 0x0c, 0xdd, 0xd2, 0x2c, 0xad, 0x41,
+	0x6d, 0xb5, 0x5a, 0x8b, 0xed, 0x12, 0x0b, 0xb3, 0x4e, 0xea, 0xf5, 0xd0, 0x35, 0x15, 0xb6, 0xe0,
+	0x68, 0xd3, 0x61, 0x70, 0x5c, 0x1c, 0x5f, 0x5f, 0x90, 0xfb, 0xf4, 0x19, 0xd9, 0xfd, 0xd8, 0xe6,
+	0x98, 0x1d, 0x6a, 0x2f, 0x5a, 0x2e, 0x5a, 0x55, 0x1d, 0x6f, 0x03, 0x5e, 0xdb, 0xdb, 0x17, 0x43,
+	0xde, 0x46, 0x7a, 0x43, 0x4c, 0xbb, 0x34, 0x03, 0xa7, 0x63, 0x4b, 0xc1, 0xe5, 0xf8, 0x43, 0xd6,
+	0xe9, 0x15, 0x91, 0x58, 0x54, 0x9a, 0xc8, 0xd4, 0x4f, 0xec, 0xf0, 0x55, 0x38, 0x66, 0xa1, 0x1a,
+	0x6e, 0x62, 0x64, 0x32, 0x37, 0xad, 0xe5, 0xee, 0x42, 0xa8, 0xde, 0x46, 0x9e, 0x72, 0xbd, 0xa9,
+	0xd7, 0x93, 0x11, 0x5c, 0x8a, 0x47, 0x50, 0xe1, 0xc6, 0x22, 0x07, 0xa4, 0x05, 0x38, 0xcb, 0xdd,
+	0x8a, 0xd4, 0xcd, 0x5f, 0x59, 0xe7, 0x1a, 0xbb, 0xe9, 0x96, 0x64, 0x70, 0x15, 0xb8, 0xf7, 0x2c,
+	0x75, 0xce, 0x5b, 0xa4, 0xe8, 0xc1, 0xc0, 0x45, 0x7f, 0xd6, 0xed, 0xe5, 0x3c, 0xf3, 0xf0, 0x56,
+	0xef, 0xf3, 0xfb, 0x02, 0x2f, 0x1f, 0xdd, 0x70, 0x7a, 0x81, 0x94, 0x96, 0xe1, 0x52, 0x64, 0x3d,
+	0x11, 0xe6, 0xa0, 0xbc, 0x1f, 0x00, 0xb8, 0x18, 0xba, 0x9d, 0xdf, 0x25, 0x7b, 0xc8, 0xc4, 0x9f,
+	0xa0, 0xca, 0xae, 0x66, 0xa1, 0x32, 0xaa, 0x11, 0x4b, 0x77, 0x51, 0xc2, 0x9b, 0xf0, 0x59, 0xd2,
+	0x36, 0xd1, 0xe0, 0x13, 0xc0, 0x84, 0x63, 0xee, 0x77, 0x93, 0x62, 0xd8, 0xa7, 0x28, 0x93, 0xed,
+	0xd7, 0x22, 0xbf, 0x93, 0xf8, 0x72, 0xbc, 0x3e, 0xfd, 0x23, 0x80, 0xd7, 0x06, 0x11, 0x7b, 0x11,
+	0x1a, 0xca, 0xbf, 0xd1, 0x8e, 0xbd, 0x51, 0xaf, 0xc7, 0xc6, 0x8a, 0x33, 0x1b, 0x36, 0xb7, 0xe1,
+	0x73, 0x3a, 0xa2, 0x0c, 0x9b, 0xce, 0x48, 0x33, 0xf0, 0xbc, 0x29, 0x84, 0x40, 0xdc, 0x2c, 0xf1,
+	0x67, 0xaa, 0x39, 0x7e, 0xa6, 0x36, 0xea, 0x41, 0xf1, 0xc5, 0x1a, 0x3e, 0xc7, 0xf3, 0x8b, 0x90,
+	0x9f, 0xaf, 0xb3, 0xfe, 0x1b, 0xa0, 0x3b, 0x88, 0x54, 0x9a, 0x75, 0xcc, 0xce, 0x7a, 0x90, 0x12,
+	0x3e, 0x82, 0x30, 0xb8, 0xde, 0xed, 0xb4, 0xd8, 0x8e, 0xbe, 0xd2, 0xb7, 0xc9, 0xc5, 0x14, 0x95,
+	0x7d, 0x74, 0xb8, 0xed, 0x85, 0x38, 0x63, 0x69, 0xe3, 0x8e, 0x6a, 0xb1, 0xb4, 0x25, 0xdd, 0xee,
+	0x3e, 0x13, 0x12, 0x5f, 0x77, 0x93, 0xb5, 0xfe, 0xeb, 0x38, 0x1c, 0x29, 0x51, 0x43, 0xf8, 0x1c,
+	0x40, 0x81, 0xf3, 0x74, 0x5a, 0xef, 0xeb, 0x12, 0xf7, 0x05, 0x22, 0xaa, 0xc3, 0x63, 0x82, 0xda,
+	0xf9, 0x16, 0xc0, 0xe9, 0x5e, 0x4f, 0x96, 0xd7, 0xd2, 0x78, 0x7b, 0x00, 0xc5, 0x1b, 0x27, 0x04,
+	0x06, 0xaa, 0xee, 0x02, 0x78, 0xa5, 0xdf, 0x94, 0x7e, 0x7d, 0xd0, 0x0f, 0x70, 0xc0, 0x62, 0xf1,
+	0x14, 0xe0, 0x40, 0xe1, 0x17, 0x00, 0x4e, 0x26, 0xc7, 0xdc, 0xb5, 0x34, 0xea, 0x04, 0x44, 0x7c,
+	0x7d, 0x68, 0x48, 0xd0, 0x75, 0x46, 0xee, 0x64, 0x81, 0x60, 0xc1, 0x89, 0xc8, 0x00, 0x79, 0x2d,
+	0x8d, 0x2f, 0x6c, 0x2d, 0xbe, 0x3c, 0x8c, 0x75, 0xe0, 0xbc, 0x5d, 0xbb, 0x9c, 0x51, 0x2e, 0xb5,
+	0x76, 0x93, 0x18, 0x51, 0x1d, 0x1e, 0x13, 0xa9, 0x92, 0x7e, 0x43, 0x50, 0x6a, 0x95, 0xf4, 0x01,
+	0x8b, 0xc5, 0x53, 0x80, 0x03, 0x85, 0x3f, 0x01, 0x38, 0x9f, 0x3e, 0x14, 0x6c, 0x0c, 0x5a, 0x90,
+	0x3d, 0x29, 0xc4, 0xed, 0x53, 0x53, 0x70, 0xcf, 0x1e, 0xaf, 0xdf, 0x0e, 0x7c, 0xf6, 0x38, 0x60,
+	0xb1, 0x78, 0x0a, 0x70, 0xa4, 0xfc, 0x38, 0x1d, 0x67, 0x90, 0xab, 0x33, 0x86, 0x11, 0xd5, 0xe1,
+	0x31, 0xbe, 0x0c, 0xf1, 0x99, 0x4f, 0xed, 0x16, 0xb2, 0x79, 0xe3, 0xfe, 0x61, 0x1e, 0x3c, 0x3a,
+	0xcc, 0x83, 0xc7, 0x87, 0x79, 0xf0, 0xe7, 0x61, 0x1e, 0x7c, 0x73, 0x94, 0xcf, 0x3c, 0x3e, 0xca,
+	0x67, 0x7e, 0x3b, 0xca, 0x67, 0x3e, 0x9c, 0x77, 0xf9, 0xa9, 0xbe, 0x27, 0x63, 0xa2, 0xdc, 0x8e,
+	0x3e, 0xa0, 0x9c, 0x3e, 0x5b, 0x1d, 0x75, 0xfe, 0x9d, 0xf6, 0xd2, 0xff, 0x03, 0x00, 0x97, 0x7e,
+	0xbb, 0x81, 0x40, 0x14, 0x00, 0x00,
 }
 
 func (this *MsgSetWithdrawAddressResponse) Equal(that interface{}) bool {
@@ -975,6 +1252,85 @@ func (this *MsgDepositValidatorRewardsPoolResponse) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *MsgWithdrawTokenizeShareRecordRewardResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgWithdrawTokenizeShareRecordRewardResponse)
+	if !ok {
+		that2, ok := that.(MsgWithdrawTokenizeShareRecordRewardResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MsgWithdrawAllDelegatorRewardsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgWithdrawAllDelegatorRewardsResponse)
+	if !ok {
+		that2, ok := that.(MsgWithdrawAllDelegatorRewardsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MsgSetCommissionSplitResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetCommissionSplitResponse)
+	if !ok {
+		that2, ok := that.(MsgSetCommissionSplitResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -1021,6 +1377,15 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.50
 	DepositValidatorRewardsPool(ctx context.Context, in *MsgDepositValidatorRewardsPool, opts ...grpc.CallOption) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// WithdrawTokenizeShareRecordReward defines a method to withdraw the rewards
+	// of the tokenized shares of all the tokenize share records of an owner.
+	WithdrawTokenizeShareRecordReward(ctx context.Context, in *MsgWithdrawTokenizeShareRecordReward, opts ...grpc.CallOption) (*MsgWithdrawTokenizeShareRecordRewardResponse, error)
+	// WithdrawAllDelegatorRewards defines a method to withdraw the rewards of all
+	// the delegations of a delegator in a single message.
+	WithdrawAllDelegatorRewards(ctx context.Context, in *MsgWithdrawAllDelegatorRewards, opts ...grpc.CallOption) (*MsgWithdrawAllDelegatorRewardsResponse, error)
+	// SetCommissionSplit defines a method to split the commission of a validator
+	// between payout addresses on withdrawal.
+	SetCommissionSplit(ctx context.Context, in *MsgSetCommissionSplit, opts ...grpc.CallOption) (*MsgSetCommissionSplitResponse, error)
 }
 
 type msgClient struct {
@@ -1095,6 +1460,33 @@ func (c *msgClient) DepositValidatorRewardsPool(ctx context.Context, in *MsgDepo
 	return out, nil
 }
 
+func (c *msgClient) WithdrawTokenizeShareRecordReward(ctx context.Context, in *MsgWithdrawTokenizeShareRecordReward, opts ...grpc.CallOption) (*MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	out := new(MsgWithdrawTokenizeShareRecordRewardResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/WithdrawTokenizeShareRecordReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawAllDelegatorRewards(ctx context.Context, in *MsgWithdrawAllDelegatorRewards, opts ...grpc.CallOption) (*MsgWithdrawAllDelegatorRewardsResponse, error) {
+	out := new(MsgWithdrawAllDelegatorRewardsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/WithdrawAllDelegatorRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetCommissionSplit(ctx context.Context, in *MsgSetCommissionSplit, opts ...grpc.CallOption) (*MsgSetCommissionSplitResponse, error) {
+	out := new(MsgSetCommissionSplitResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/SetCommissionSplit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetWithdrawAddress defines a method to change the withdraw address
@@ -1130,6 +1522,15 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.50
 	DepositValidatorRewardsPool(context.Context, *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error)
+	// WithdrawTokenizeShareRecordReward defines a method to withdraw the rewards
+	// of the tokenized shares of all the tokenize share records of an owner.
+	WithdrawTokenizeShareRecordReward(context.Context, *MsgWithdrawTokenizeShareRecordReward) (*MsgWithdrawTokenizeShareRecordRewardResponse, error)
+	// WithdrawAllDelegatorRewards defines a method to withdraw the rewards of all
+	// the delegations of a delegator in a single message.
+	WithdrawAllDelegatorRewards(context.Context, *MsgWithdrawAllDelegatorRewards) (*MsgWithdrawAllDelegatorRewardsResponse, error)
+	// SetCommissionSplit defines a method to split the commission of a validator
+	// between payout addresses on withdrawal.
+	SetCommissionSplit(context.Context, *MsgSetCommissionSplit) (*MsgSetCommissionSplitResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1157,6 +1558,15 @@ func (*UnimplementedMsgServer) CommunityPoolSpend(ctx context.Context, req *MsgC
 func (*UnimplementedMsgServer) DepositValidatorRewardsPool(ctx context.Context, req *MsgDepositValidatorRewardsPool) (*MsgDepositValidatorRewardsPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DepositValidatorRewardsPool not implemented")
 }
+func (*UnimplementedMsgServer) WithdrawTokenizeShareRecordReward(ctx context.Context, req *MsgWithdrawTokenizeShareRecordReward) (*MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawTokenizeShareRecordReward not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawAllDelegatorRewards(ctx context.Context, req *MsgWithdrawAllDelegatorRewards) (*MsgWithdrawAllDelegatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAllDelegatorRewards not implemented")
+}
+func (*UnimplementedMsgServer) SetCommissionSplit(ctx context.Context, req *MsgSetCommissionSplit) (*MsgSetCommissionSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCommissionSplit not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1288,27 +1698,81 @@ func _Msg_DepositValidatorRewardsPool_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.distribution.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SetWithdrawAddress",
-			Handler:    _Msg_SetWithdrawAddress_Handler,
-		},
-		{
-			MethodName: "WithdrawDelegatorReward",
-			Handler:    _Msg_WithdrawDelegatorReward_Handler,
-		},
-		{
-			MethodName: "WithdrawValidatorCommission",
-			Handler:    _Msg_WithdrawValidatorCommission_Handler,
-		},
-		{
-			MethodName: "FundCommunityPool",
-			Handler:    _Msg_FundCommunityPool_Handler,
-		},
-		{
+func _Msg_WithdrawTokenizeShareRecordReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawTokenizeShareRecordReward)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawTokenizeShareRecordReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/WithdrawTokenizeShareRecordReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawTokenizeShareRecordReward(ctx, req.(*MsgWithdrawTokenizeShareRecordReward))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawAllDelegatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawAllDelegatorRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawAllDelegatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/WithdrawAllDelegatorRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawAllDelegatorRewards(ctx, req.(*MsgWithdrawAllDelegatorRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetCommissionSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCommissionSplit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCommissionSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/SetCommissionSplit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCommissionSplit(ctx, req.(*MsgSetCommissionSplit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.distribution.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetWithdrawAddress",
+			Handler:    _Msg_SetWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "WithdrawDelegatorReward",
+			Handler:    _Msg_WithdrawDelegatorReward_Handler,
+		},
+		{
+			MethodName: "WithdrawValidatorCommission",
+			Handler:    _Msg_WithdrawValidatorCommission_Handler,
+		},
+		{
+			MethodName: "FundCommunityPool",
+			Handler:    _Msg_FundCommunityPool_Handler,
+		},
+		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
@@ -1320,6 +1784,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DepositValidatorRewardsPool",
 			Handler:    _Msg_DepositValidatorRewardsPool_Handler,
 		},
+		{
+			MethodName: "WithdrawTokenizeShareRecordReward",
+			Handler:    _Msg_WithdrawTokenizeShareRecordReward_Handler,
+		},
+		{
+			MethodName: "WithdrawAllDelegatorRewards",
+			Handler:    _Msg_WithdrawAllDelegatorRewards_Handler,
+		},
+		{
+			MethodName: "SetCommissionSplit",
+			Handler:    _Msg_SetCommissionSplit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/tx.proto",
@@ -1804,6 +2280,214 @@ func (m *MsgDepositValidatorRewardsPoolResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgWithdrawTokenizeShareRecordReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawAllDelegatorRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawAllDelegatorRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawAllDelegatorRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DestinationAddress) > 0 {
+		i -= len(m.DestinationAddress)
+		copy(dAtA[i:], m.DestinationAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestinationAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawAllDelegatorRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawAllDelegatorRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawAllDelegatorRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCommissionSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCommissionSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCommissionSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCommissionSplitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCommissionSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCommissionSplitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -2017,10 +2701,98 @@ func (m *MsgDepositValidatorRewardsPoolResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgWithdrawTokenizeShareRecordReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
+
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdrawAllDelegatorRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DestinationAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawAllDelegatorRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetCommissionSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetCommissionSplitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *MsgSetWithdrawAddress) Unmarshal(dAtA []byte) error {
@@ -3278,6 +4050,536 @@ func (m *MsgDepositValidatorRewardsPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgWithdrawTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawTokenizeShareRecordRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawAllDelegatorRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawAllDelegatorRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawAllDelegatorRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawAllDelegatorRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawAllDelegatorRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawAllDelegatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetCommissionSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCommissionSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCommissionSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, CommissionSplitRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetCommissionSplitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCommissionSplitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCommissionSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
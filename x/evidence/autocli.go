@@ -28,6 +28,43 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query all (paginated) submitted evidence",
 					Example:   fmt.Sprintf("%s query evidence --page=2 --page-limit=50", version.AppName),
 				},
+				{
+					RpcMethod: "ExternalInfractionPolicies",
+					Use:       "external-infraction-policies",
+					Short:     "Query all (paginated) policies converting the misbehaviors observed on external chains to local slashing",
+				},
+				{
+					RpcMethod: "ExternalInfractions",
+					Use:       "external-infractions",
+					Short:     "Query all (paginated) misbehaviors observed on external chains which were converted to local slashing",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              evidencev1beta1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "SubmitEvidence",
+					Skip:      true, // submitted through the registered evidence handlers
+				},
+				{
+					RpcMethod:      "SubmitExternalInfraction",
+					Use:            "submit-external-infraction-proposal [infraction]",
+					Short:          "Submit a proposal to slash a validator for a misbehavior observed on an external chain",
+					Long:           "Submit a proposal to slash a validator for a misbehavior observed on an external chain. The validator is slashed and jailed according to the external infraction policy of the chain.",
+					Example:        fmt.Sprintf(`%s tx evidence submit-external-infraction-proposal '{ "chain_id": "consumer-1", "consensus_address": "cosmosvalcons1...", "infraction_type": "EXTERNAL_INFRACTION_TYPE_DOUBLE_SIGN", "height": "100", "time": "2024-01-01T00:00:00Z" }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "infraction"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "SetExternalInfractionPolicy",
+					Use:            "set-external-infraction-policy-proposal [policy]",
+					Short:          "Submit a proposal to set how the misbehaviors observed on an external chain are converted to local slashing",
+					Example:        fmt.Sprintf(`%s tx evidence set-external-infraction-policy-proposal '{ "chain_id": "consumer-1", "double_sign_slash_fraction": "0.05", "tombstone_double_sign": true, "downtime_slash_fraction": "0.0001", "jail_duration": "600s" }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "policy"}},
+					GovProposal:    true,
+				},
 			},
 		},
 	}
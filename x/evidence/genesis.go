@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/evidence/exported"
 	"cosmossdk.io/x/evidence/keeper"
 	"cosmossdk.io/x/evidence/types"
@@ -31,6 +32,18 @@ func InitGenesis(ctx context.Context, k keeper.Keeper, gs *types.GenesisState) {
 			panic(err)
 		}
 	}
+
+	for _, policy := range gs.ExternalInfractionPolicies {
+		if err := k.ExternalInfractionPolicies.Set(ctx, policy.ChainId, policy); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, infraction := range gs.ExternalInfractions {
+		if err := k.SetExternalInfraction(ctx, infraction); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the evidence module's exported genesis.
@@ -47,5 +60,22 @@ func ExportGenesis(ctx context.Context, k keeper.Keeper) *types.GenesisState {
 	if err != nil {
 		panic(err)
 	}
+
+	err = k.ExternalInfractionPolicies.Walk(ctx, nil, func(_ string, policy types.ExternalInfractionPolicy) (stop bool, err error) {
+		gs.ExternalInfractionPolicies = append(gs.ExternalInfractionPolicies, policy)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.ExternalInfractions.Walk(ctx, nil, func(_ collections.Triple[string, []byte, int64], infraction types.ExternalInfraction) (stop bool, err error) {
+		gs.ExternalInfractions = append(gs.ExternalInfractions, infraction)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
 	return gs
 }
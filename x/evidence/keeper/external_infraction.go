@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	st "cosmossdk.io/api/cosmos/staking/v1beta1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/evidence/types"
+)
+
+// SetExternalInfractionPolicy sets how the misbehaviors observed on the chain
+// of the policy are converted to local slashing.
+func (k Keeper) SetExternalInfractionPolicy(ctx context.Context, policy types.ExternalInfractionPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	if err := k.ExternalInfractionPolicies.Set(ctx, policy.ChainId, policy); err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSetExternalInfractionPolicy,
+		event.NewAttribute(types.AttributeKeyChainID, policy.ChainId),
+	)
+}
+
+// HandleExternalInfraction slashes and jails a validator for a misbehavior
+// observed on an external chain, according to the external infraction policy
+// of the chain. A double sign tombstones the validator if the policy says so.
+// Each infraction is only handled once.
+//
+// The heights of the external chain don't map to local heights, so the
+// infraction is handled as if it happened at the current height: only the
+// currently bonded stake of the validator is slashed, and not its unbonding
+// delegations and redelegations.
+//
+// The infraction is considered invalid if:
+// - the chain has no external infraction policy
+// - the validator is unbonded or does not exist
+// - the validator is already tombstoned
+func (k Keeper) HandleExternalInfraction(ctx context.Context, infraction types.ExternalInfraction) error {
+	if err := infraction.Validate(); err != nil {
+		return err
+	}
+
+	policy, err := k.ExternalInfractionPolicies.Get(ctx, infraction.ChainId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return errorsmod.Wrap(types.ErrNoPolicyExists, infraction.ChainId)
+	} else if err != nil {
+		return err
+	}
+
+	key, err := k.externalInfractionKey(infraction)
+	if err != nil {
+		return err
+	}
+	has, err := k.ExternalInfractions.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+	if has {
+		return errorsmod.Wrapf(types.ErrInfractionExists, "%s at height %d of chain %s", infraction.ConsensusAddress, infraction.Height, infraction.ChainId)
+	}
+
+	validator, err := k.stakingKeeper.ValidatorByConsAddr(ctx, key.K2())
+	if err != nil {
+		return err
+	}
+	if validator == nil || validator.IsUnbonded() {
+		return errorsmod.Wrapf(types.ErrInvalidInfraction, "validator %s is unbonded", infraction.ConsensusAddress)
+	}
+
+	// the validator may have rotated its key since the infraction
+	valConsAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+
+	if k.slashingKeeper.IsTombstoned(ctx, valConsAddr) {
+		return errorsmod.Wrapf(types.ErrInvalidInfraction, "validator %s is already tombstoned", infraction.ConsensusAddress)
+	}
+
+	var (
+		slashFraction math.LegacyDec
+		reason        st.Infraction
+		tombstone     bool
+	)
+	switch infraction.InfractionType {
+	case types.ExternalInfractionType_EXTERNAL_INFRACTION_TYPE_DOUBLE_SIGN:
+		slashFraction = policy.DoubleSignSlashFraction
+		reason = st.Infraction_INFRACTION_DOUBLE_SIGN
+		tombstone = policy.TombstoneDoubleSign
+	default:
+		slashFraction = policy.DowntimeSlashFraction
+		reason = st.Infraction_INFRACTION_DOWNTIME
+	}
+
+	headerInfo := k.environment.HeaderService.GetHeaderInfo(ctx)
+	power := validator.GetConsensusPower(k.stakingKeeper.PowerReduction(ctx))
+	if err := k.slashingKeeper.SlashWithInfractionReason(ctx, valConsAddr, slashFraction, power, headerInfo.Height, reason); err != nil {
+		return err
+	}
+
+	if !validator.IsJailed() {
+		if err := k.slashingKeeper.Jail(ctx, valConsAddr); err != nil {
+			return err
+		}
+	}
+
+	if tombstone {
+		if err := k.slashingKeeper.JailUntil(ctx, valConsAddr, types.DoubleSignJailEndTime); err != nil {
+			return err
+		}
+		if err := k.slashingKeeper.Tombstone(ctx, valConsAddr); err != nil {
+			return err
+		}
+	} else if err := k.slashingKeeper.JailUntil(ctx, valConsAddr, headerInfo.Time.Add(policy.JailDuration)); err != nil {
+		return err
+	}
+
+	if err := k.ExternalInfractions.Set(ctx, key, infraction); err != nil {
+		return err
+	}
+
+	k.Logger().Info(
+		"slashed validator for external infraction",
+		"validator", valConsAddr,
+		"chain_id", infraction.ChainId,
+		"infraction_type", infraction.InfractionType,
+		"infraction_height", infraction.Height,
+		"slash_fraction", slashFraction,
+		"tombstoned", tombstone,
+	)
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeExternalInfraction,
+		event.NewAttribute(types.AttributeKeyChainID, infraction.ChainId),
+		event.NewAttribute(types.AttributeKeyConsensusAddress, infraction.ConsensusAddress),
+		event.NewAttribute(types.AttributeKeyInfractionType, infraction.InfractionType.String()),
+		event.NewAttribute(types.AttributeKeyInfractionHeight, strconv.FormatInt(infraction.Height, 10)),
+		event.NewAttribute(types.AttributeKeySlashFraction, slashFraction.String()),
+		event.NewAttribute(types.AttributeKeyTombstoned, strconv.FormatBool(tombstone)),
+	)
+}
+
+// SetExternalInfraction records an external infraction as handled, without
+// slashing the validator.
+func (k Keeper) SetExternalInfraction(ctx context.Context, infraction types.ExternalInfraction) error {
+	key, err := k.externalInfractionKey(infraction)
+	if err != nil {
+		return err
+	}
+
+	return k.ExternalInfractions.Set(ctx, key, infraction)
+}
+
+func (k Keeper) externalInfractionKey(infraction types.ExternalInfraction) (collections.Triple[string, []byte, int64], error) {
+	consAddr, err := k.stakingKeeper.ConsensusAddressCodec().StringToBytes(infraction.ConsensusAddress)
+	if err != nil {
+		return collections.Triple[string, []byte, int64]{}, errorsmod.Wrapf(types.ErrInvalidInfraction, "invalid consensus address: %s", err)
+	}
+
+	return collections.Join3(infraction.ChainId, consAddr, infraction.Height), nil
+}
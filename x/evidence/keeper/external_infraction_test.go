@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	st "cosmossdk.io/api/cosmos/staking/v1beta1"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/evidence/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestExternalInfractions() {
+	require := suite.Require()
+	authority := suite.evidenceKeeper.GetAuthority()
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Height: 10, Time: time.Unix(1_000_000, 0)})
+
+	consCodec := address.NewBech32Codec("cosmosvalcons")
+	suite.stakingKeeper.EXPECT().ConsensusAddressCodec().Return(consCodec).AnyTimes()
+	suite.stakingKeeper.EXPECT().PowerReduction(gomock.Any()).Return(sdk.DefaultPowerReduction).AnyTimes()
+
+	consAddr := sdk.ConsAddress(pubkeys[0].Address())
+	consStr, err := consCodec.BytesToString(consAddr)
+	require.NoError(err)
+	val, err := stakingtypes.NewValidator(valAddress.String(), pubkeys[0], stakingtypes.Description{})
+	require.NoError(err)
+	val.Status = stakingtypes.Bonded
+	val.Tokens = math.NewInt(10_000_000)
+	suite.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(&val, nil).AnyTimes()
+
+	policy := types.ExternalInfractionPolicy{
+		ChainId:                 "consumer-1",
+		DoubleSignSlashFraction: math.LegacyNewDecWithPrec(5, 2),
+		TombstoneDoubleSign:     true,
+		DowntimeSlashFraction:   math.LegacyNewDecWithPrec(1, 2),
+		JailDuration:            10 * time.Minute,
+	}
+	downtime := types.ExternalInfraction{
+		ChainId:          "consumer-1",
+		ConsensusAddress: consStr,
+		InfractionType:   types.ExternalInfractionType_EXTERNAL_INFRACTION_TYPE_DOWNTIME,
+		Height:           500,
+		Time:             time.Unix(900_000, 0).UTC(),
+	}
+
+	// policies can only be set by the authority
+	_, err = suite.msgServer.SetExternalInfractionPolicy(ctx, &types.MsgSetExternalInfractionPolicy{Authority: sdk.AccAddress(valAddress).String(), Policy: policy})
+	require.ErrorIs(err, types.ErrInvalidSigner)
+
+	invalid := policy
+	invalid.DowntimeSlashFraction = math.LegacyNewDec(2)
+	_, err = suite.msgServer.SetExternalInfractionPolicy(ctx, &types.MsgSetExternalInfractionPolicy{Authority: authority, Policy: invalid})
+	require.ErrorIs(err, types.ErrInvalidPolicy)
+
+	// infractions of chains without a policy are rejected
+	_, err = suite.msgServer.SubmitExternalInfraction(ctx, &types.MsgSubmitExternalInfraction{Authority: authority, Infraction: downtime})
+	require.ErrorIs(err, types.ErrNoPolicyExists)
+
+	_, err = suite.msgServer.SetExternalInfractionPolicy(ctx, &types.MsgSetExternalInfractionPolicy{Authority: authority, Policy: policy})
+	require.NoError(err)
+
+	// downtime slashes and jails the validator for the jail duration
+	suite.slashingKeeper.EXPECT().IsTombstoned(gomock.Any(), consAddr).Return(false)
+	suite.slashingKeeper.EXPECT().SlashWithInfractionReason(gomock.Any(), consAddr, policy.DowntimeSlashFraction, int64(10), int64(10), st.Infraction_INFRACTION_DOWNTIME).Return(nil)
+	suite.slashingKeeper.EXPECT().Jail(gomock.Any(), consAddr).Return(nil)
+	suite.slashingKeeper.EXPECT().JailUntil(gomock.Any(), consAddr, ctx.HeaderInfo().Time.Add(policy.JailDuration)).Return(nil)
+	_, err = suite.msgServer.SubmitExternalInfraction(ctx, &types.MsgSubmitExternalInfraction{Authority: authority, Infraction: downtime})
+	require.NoError(err)
+
+	// each infraction is only handled once
+	_, err = suite.msgServer.SubmitExternalInfraction(ctx, &types.MsgSubmitExternalInfraction{Authority: authority, Infraction: downtime})
+	require.ErrorIs(err, types.ErrInfractionExists)
+
+	// a double sign tombstones the already jailed validator
+	val.Jailed = true
+	doubleSign := downtime
+	doubleSign.InfractionType = types.ExternalInfractionType_EXTERNAL_INFRACTION_TYPE_DOUBLE_SIGN
+	doubleSign.Height = 501
+	suite.slashingKeeper.EXPECT().IsTombstoned(gomock.Any(), consAddr).Return(false)
+	suite.slashingKeeper.EXPECT().SlashWithInfractionReason(gomock.Any(), consAddr, policy.DoubleSignSlashFraction, int64(10), int64(10), st.Infraction_INFRACTION_DOUBLE_SIGN).Return(nil)
+	suite.slashingKeeper.EXPECT().JailUntil(gomock.Any(), consAddr, types.DoubleSignJailEndTime).Return(nil)
+	suite.slashingKeeper.EXPECT().Tombstone(gomock.Any(), consAddr).Return(nil)
+	_, err = suite.msgServer.SubmitExternalInfraction(ctx, &types.MsgSubmitExternalInfraction{Authority: authority, Infraction: doubleSign})
+	require.NoError(err)
+
+	suite.slashingKeeper.EXPECT().IsTombstoned(gomock.Any(), consAddr).Return(true)
+	doubleSign.Height = 502
+	_, err = suite.msgServer.SubmitExternalInfraction(ctx, &types.MsgSubmitExternalInfraction{Authority: authority, Infraction: doubleSign})
+	require.ErrorIs(err, types.ErrInvalidInfraction)
+
+	res, err := suite.queryClient.ExternalInfractions(ctx, &types.QueryExternalInfractionsRequest{})
+	require.NoError(err)
+	doubleSign.Height = 501
+	require.Equal([]types.ExternalInfraction{downtime, doubleSign}, res.Infractions)
+}
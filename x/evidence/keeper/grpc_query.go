@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/evidence/exported"
 	"cosmossdk.io/x/evidence/types"
 
@@ -74,3 +75,35 @@ func (k Querier) AllEvidence(ctx context.Context, req *types.QueryAllEvidenceReq
 
 	return &types.QueryAllEvidenceResponse{Evidence: evidences, Pagination: pageRes}, nil
 }
+
+// ExternalInfractionPolicies implements the Query/ExternalInfractionPolicies gRPC method
+func (k Querier) ExternalInfractionPolicies(ctx context.Context, req *types.QueryExternalInfractionPoliciesRequest) (*types.QueryExternalInfractionPoliciesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	policies, pageRes, err := query.CollectionPaginate(ctx, k.k.ExternalInfractionPolicies, req.Pagination, func(_ string, value types.ExternalInfractionPolicy) (types.ExternalInfractionPolicy, error) {
+		return value, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryExternalInfractionPoliciesResponse{Policies: policies, Pagination: pageRes}, nil
+}
+
+// ExternalInfractions implements the Query/ExternalInfractions gRPC method
+func (k Querier) ExternalInfractions(ctx context.Context, req *types.QueryExternalInfractionsRequest) (*types.QueryExternalInfractionsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	infractions, pageRes, err := query.CollectionPaginate(ctx, k.k.ExternalInfractions, req.Pagination, func(_ collections.Triple[string, []byte, int64], value types.ExternalInfraction) (types.ExternalInfraction, error) {
+		return value, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryExternalInfractionsResponse{Infractions: infractions, Pagination: pageRes}, nil
+}
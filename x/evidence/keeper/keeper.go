@@ -29,15 +29,22 @@ type Keeper struct {
 	slashingKeeper types.SlashingKeeper
 	addressCodec   address.Codec
 
+	// the address capable of executing authority-gated messages, usually the gov module account
+	authority string
+
 	Schema collections.Schema
 	// Evidences key: evidence hash bytes | value: Evidence
 	Evidences collections.Map[[]byte, exported.Evidence]
+	// ExternalInfractionPolicies key: chain id | value: ExternalInfractionPolicy
+	ExternalInfractionPolicies collections.Map[string, types.ExternalInfractionPolicy]
+	// ExternalInfractions key: chain id + consensus address + height | value: ExternalInfraction
+	ExternalInfractions collections.Map[collections.Triple[string, []byte, int64], types.ExternalInfraction]
 }
 
 // NewKeeper creates a new Keeper object.
 func NewKeeper(
 	cdc codec.BinaryCodec, env appmodule.Environment, stakingKeeper types.StakingKeeper,
-	slashingKeeper types.SlashingKeeper, ac address.Codec,
+	slashingKeeper types.SlashingKeeper, ac address.Codec, authority string,
 ) *Keeper {
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 	k := &Keeper{
@@ -46,7 +53,17 @@ func NewKeeper(
 		stakingKeeper:  stakingKeeper,
 		slashingKeeper: slashingKeeper,
 		addressCodec:   ac,
+		authority:      authority,
 		Evidences:      collections.NewMap(sb, types.KeyPrefixEvidence, "evidences", collections.BytesKey, codec.CollInterfaceValue[exported.Evidence](cdc)),
+		ExternalInfractionPolicies: collections.NewMap(
+			sb, types.ExternalInfractionPolicyKeyPrefix, "external_infraction_policies",
+			collections.StringKey, codec.CollValue[types.ExternalInfractionPolicy](cdc),
+		),
+		ExternalInfractions: collections.NewMap(
+			sb, types.ExternalInfractionKeyPrefix, "external_infractions",
+			collections.TripleKeyCodec(collections.StringKey, collections.BytesKey, collections.Int64Key),
+			codec.CollValue[types.ExternalInfraction](cdc),
+		),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -61,6 +78,11 @@ func (k Keeper) Logger() log.Logger {
 	return k.environment.Logger.With("module", "x/"+types.ModuleName)
 }
 
+// GetAuthority returns the x/evidence module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // SetRouter sets the Evidence Handler router for the x/evidence module. Note,
 // we allow the ability to set the router after the Keeper is constructed as a
 // given Handler may need access the Keeper before being constructed. The router
@@ -44,3 +44,29 @@ func (ms msgServer) SubmitEvidence(ctx context.Context, msg *types.MsgSubmitEvid
 		Hash: evidence.Hash(),
 	}, nil
 }
+
+// SubmitExternalInfraction implements the MsgServer.SubmitExternalInfraction method.
+func (ms msgServer) SubmitExternalInfraction(ctx context.Context, msg *types.MsgSubmitExternalInfraction) (*types.MsgSubmitExternalInfractionResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := ms.HandleExternalInfraction(ctx, msg.Infraction); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitExternalInfractionResponse{}, nil
+}
+
+// SetExternalInfractionPolicy implements the MsgServer.SetExternalInfractionPolicy method.
+func (ms msgServer) SetExternalInfractionPolicy(ctx context.Context, msg *types.MsgSetExternalInfractionPolicy) (*types.MsgSetExternalInfractionPolicyResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := ms.Keeper.SetExternalInfractionPolicy(ctx, msg.Policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetExternalInfractionPolicyResponse{}, nil
+}
@@ -15,12 +15,18 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterInterface((*exported.Evidence)(nil), nil)
 	legacy.RegisterAminoMsg(cdc, &MsgSubmitEvidence{}, "cosmos-sdk/MsgSubmitEvidence")
+	legacy.RegisterAminoMsg(cdc, &MsgSubmitExternalInfraction{}, "cosmos-sdk/MsgSubmitExternalInfraction")
+	legacy.RegisterAminoMsg(cdc, &MsgSetExternalInfractionPolicy{}, "cosmos-sdk/MsgSetExtInfractionPolicy")
 	cdc.RegisterConcrete(&Equivocation{}, "cosmos-sdk/Equivocation", nil)
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry.
 func RegisterInterfaces(registry types.InterfaceRegistry) {
-	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgSubmitEvidence{})
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSubmitEvidence{},
+		&MsgSubmitExternalInfraction{},
+		&MsgSetExternalInfractionPolicy{},
+	)
 	registry.RegisterInterface(
 		"cosmos.evidence.v1beta1.Evidence",
 		(*exported.Evidence)(nil),
@@ -7,4 +7,9 @@ var (
 	ErrNoEvidenceHandlerExists = errors.Register(ModuleName, 2, "unregistered handler for evidence type")
 	ErrInvalidEvidence         = errors.Register(ModuleName, 3, "invalid evidence")
 	ErrEvidenceExists          = errors.Register(ModuleName, 5, "evidence already exists")
+	ErrInvalidSigner           = errors.Register(ModuleName, 6, "expected authority account as only signer for proposal message")
+	ErrInvalidPolicy           = errors.Register(ModuleName, 7, "invalid external infraction policy")
+	ErrNoPolicyExists          = errors.Register(ModuleName, 8, "no external infraction policy for chain")
+	ErrInvalidInfraction       = errors.Register(ModuleName, 9, "invalid external infraction")
+	ErrInfractionExists        = errors.Register(ModuleName, 10, "external infraction already exists")
 )
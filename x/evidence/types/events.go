@@ -2,7 +2,15 @@ package types
 
 // evidence module events
 const (
-	EventTypeSubmitEvidence = "submit_evidence"
+	EventTypeSubmitEvidence              = "submit_evidence"
+	EventTypeExternalInfraction          = "external_infraction"
+	EventTypeSetExternalInfractionPolicy = "set_external_infraction_policy"
 
-	AttributeKeyEvidenceHash = "evidence_hash"
+	AttributeKeyEvidenceHash     = "evidence_hash"
+	AttributeKeyChainID          = "chain_id"
+	AttributeKeyConsensusAddress = "consensus_address"
+	AttributeKeyInfractionType   = "infraction_type"
+	AttributeKeyInfractionHeight = "infraction_height"
+	AttributeKeySlashFraction    = "slash_fraction"
+	AttributeKeyTombstoned       = "tombstoned"
 )
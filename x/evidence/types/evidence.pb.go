@@ -4,12 +4,14 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -29,6 +31,41 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ExternalInfractionType defines the type of a misbehavior observed on an
+// external chain.
+type ExternalInfractionType int32
+
+const (
+	// EXTERNAL_INFRACTION_TYPE_UNSPECIFIED defines an unspecified infraction.
+	ExternalInfractionType_EXTERNAL_INFRACTION_TYPE_UNSPECIFIED ExternalInfractionType = 0
+	// EXTERNAL_INFRACTION_TYPE_DOUBLE_SIGN defines a validator that double-signed
+	// a block on the external chain.
+	ExternalInfractionType_EXTERNAL_INFRACTION_TYPE_DOUBLE_SIGN ExternalInfractionType = 1
+	// EXTERNAL_INFRACTION_TYPE_DOWNTIME defines a validator that missed too many
+	// blocks on the external chain.
+	ExternalInfractionType_EXTERNAL_INFRACTION_TYPE_DOWNTIME ExternalInfractionType = 2
+)
+
+var ExternalInfractionType_name = map[int32]string{
+	0: "EXTERNAL_INFRACTION_TYPE_UNSPECIFIED",
+	1: "EXTERNAL_INFRACTION_TYPE_DOUBLE_SIGN",
+	2: "EXTERNAL_INFRACTION_TYPE_DOWNTIME",
+}
+
+var ExternalInfractionType_value = map[string]int32{
+	"EXTERNAL_INFRACTION_TYPE_UNSPECIFIED": 0,
+	"EXTERNAL_INFRACTION_TYPE_DOUBLE_SIGN": 1,
+	"EXTERNAL_INFRACTION_TYPE_DOWNTIME":    2,
+}
+
+func (x ExternalInfractionType) String() string {
+	return proto.EnumName(ExternalInfractionType_name, int32(x))
+}
+
+func (ExternalInfractionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{0}
+}
+
 // Equivocation implements the Evidence interface and defines evidence of double
 // signing misbehavior.
 type Equivocation struct {
@@ -75,8 +112,168 @@ func (m *Equivocation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Equivocation proto.InternalMessageInfo
 
+// ExternalInfraction defines a misbehavior of a validator observed on an
+// external chain, such as a consumer or partner chain secured by the validator
+// set of this chain.
+type ExternalInfraction struct {
+	// chain_id is the chain ID of the external chain.
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// consensus_address is the consensus address of the validator on this chain.
+	ConsensusAddress string `protobuf:"bytes,2,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// infraction_type is the type of the misbehavior.
+	InfractionType ExternalInfractionType `protobuf:"varint,3,opt,name=infraction_type,json=infractionType,proto3,enum=cosmos.evidence.v1beta1.ExternalInfractionType" json:"infraction_type,omitempty"`
+	// height is the height of the misbehavior on the external chain.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the time of the misbehavior on the external chain.
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *ExternalInfraction) Reset()         { *m = ExternalInfraction{} }
+func (m *ExternalInfraction) String() string { return proto.CompactTextString(m) }
+func (*ExternalInfraction) ProtoMessage()    {}
+func (*ExternalInfraction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{1}
+}
+func (m *ExternalInfraction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExternalInfraction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExternalInfraction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExternalInfraction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalInfraction.Merge(m, src)
+}
+func (m *ExternalInfraction) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExternalInfraction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalInfraction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalInfraction proto.InternalMessageInfo
+
+func (m *ExternalInfraction) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ExternalInfraction) GetConsensusAddress() string {
+	if m != nil {
+		return m.ConsensusAddress
+	}
+	return ""
+}
+
+func (m *ExternalInfraction) GetInfractionType() ExternalInfractionType {
+	if m != nil {
+		return m.InfractionType
+	}
+	return ExternalInfractionType_EXTERNAL_INFRACTION_TYPE_UNSPECIFIED
+}
+
+func (m *ExternalInfraction) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ExternalInfraction) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// ExternalInfractionPolicy defines how the misbehaviors observed on an external
+// chain are converted to local slashing.
+type ExternalInfractionPolicy struct {
+	// chain_id is the chain ID of the external chain.
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// double_sign_slash_fraction is the fraction of the local stake slashed for a
+	// double sign on the external chain.
+	DoubleSignSlashFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=double_sign_slash_fraction,json=doubleSignSlashFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"double_sign_slash_fraction"`
+	// tombstone_double_sign defines whether a double sign on the external chain
+	// tombstones the validator, as a local double sign does.
+	TombstoneDoubleSign bool `protobuf:"varint,3,opt,name=tombstone_double_sign,json=tombstoneDoubleSign,proto3" json:"tombstone_double_sign,omitempty"`
+	// downtime_slash_fraction is the fraction of the local stake slashed for
+	// downtime on the external chain.
+	DowntimeSlashFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=downtime_slash_fraction,json=downtimeSlashFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"downtime_slash_fraction"`
+	// jail_duration is the duration for which the validator is jailed for an
+	// infraction which doesn't tombstone it.
+	JailDuration time.Duration `protobuf:"bytes,5,opt,name=jail_duration,json=jailDuration,proto3,stdduration" json:"jail_duration"`
+}
+
+func (m *ExternalInfractionPolicy) Reset()         { *m = ExternalInfractionPolicy{} }
+func (m *ExternalInfractionPolicy) String() string { return proto.CompactTextString(m) }
+func (*ExternalInfractionPolicy) ProtoMessage()    {}
+func (*ExternalInfractionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{2}
+}
+func (m *ExternalInfractionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExternalInfractionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExternalInfractionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExternalInfractionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalInfractionPolicy.Merge(m, src)
+}
+func (m *ExternalInfractionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExternalInfractionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalInfractionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalInfractionPolicy proto.InternalMessageInfo
+
+func (m *ExternalInfractionPolicy) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ExternalInfractionPolicy) GetTombstoneDoubleSign() bool {
+	if m != nil {
+		return m.TombstoneDoubleSign
+	}
+	return false
+}
+
+func (m *ExternalInfractionPolicy) GetJailDuration() time.Duration {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.evidence.v1beta1.ExternalInfractionType", ExternalInfractionType_name, ExternalInfractionType_value)
 	proto.RegisterType((*Equivocation)(nil), "cosmos.evidence.v1beta1.Equivocation")
+	proto.RegisterType((*ExternalInfraction)(nil), "cosmos.evidence.v1beta1.ExternalInfraction")
+	proto.RegisterType((*ExternalInfractionPolicy)(nil), "cosmos.evidence.v1beta1.ExternalInfractionPolicy")
 }
 
 func init() {
@@ -84,31 +281,123 @@ func init() {
 }
 
 var fileDescriptor_dd143e71a177f0dd = []byte{
-	// 349 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x51, 0x41, 0x4e, 0xc2, 0x40,
-	0x14, 0xed, 0x08, 0x92, 0x58, 0x35, 0x91, 0x86, 0x68, 0x25, 0xb1, 0x25, 0xc6, 0x18, 0x42, 0x42,
-	0x27, 0xe8, 0x4e, 0xe3, 0x42, 0x12, 0x2e, 0x80, 0xae, 0xdc, 0x90, 0xd2, 0x8e, 0x65, 0x82, 0xed,
-	0xaf, 0x9d, 0x01, 0xf5, 0x06, 0xc6, 0x15, 0x47, 0x60, 0xc9, 0x92, 0x85, 0x87, 0x60, 0x49, 0x5c,
-	0xb9, 0x52, 0x53, 0x16, 0x70, 0x0c, 0xc3, 0xcc, 0x80, 0x6e, 0x26, 0xff, 0xbd, 0x79, 0xef, 0xbf,
-	0xf9, 0xf3, 0xf5, 0x53, 0x0f, 0x58, 0x08, 0x0c, 0x93, 0x3e, 0xf5, 0x49, 0xe4, 0x11, 0xdc, 0xaf,
-	0xb5, 0x09, 0x77, 0x6b, 0x6b, 0xc2, 0x89, 0x13, 0xe0, 0x60, 0x1c, 0x48, 0x9d, 0xb3, 0xa6, 0x95,
-	0xae, 0x98, 0x77, 0x43, 0x1a, 0x01, 0x16, 0xa7, 0xd4, 0x16, 0x0b, 0x01, 0x04, 0x20, 0x4a, 0xbc,
-	0xac, 0x14, 0x6b, 0x07, 0x00, 0xc1, 0x03, 0xc1, 0x02, 0xb5, 0x7b, 0xf7, 0x98, 0xd3, 0x90, 0x30,
-	0xee, 0x86, 0xb1, 0x12, 0x1c, 0xca, 0x88, 0x96, 0x74, 0xaa, 0x3c, 0x01, 0x8e, 0x17, 0x48, 0xdf,
-	0x69, 0x3c, 0xf6, 0x68, 0x1f, 0x3c, 0x97, 0x53, 0x88, 0x8c, 0x7d, 0x3d, 0xd7, 0x21, 0x34, 0xe8,
-	0x70, 0x13, 0x95, 0x50, 0x39, 0xd3, 0x54, 0xc8, 0xb8, 0xd2, 0xb3, 0xcb, 0xb6, 0xe6, 0x46, 0x09,
-	0x95, 0xb7, 0xcf, 0x8a, 0x8e, 0xcc, 0x74, 0x56, 0x99, 0xce, 0xed, 0x2a, 0xb3, 0xbe, 0x3b, 0xf9,
-	0xb2, 0xb5, 0xc1, 0xb7, 0x8d, 0x46, 0xf3, 0x71, 0x05, 0x35, 0x85, 0xcd, 0x28, 0xe8, 0x9b, 0x31,
-	0x3c, 0x91, 0xc4, 0xcc, 0x88, 0xae, 0x12, 0x18, 0x0d, 0x3d, 0xef, 0x41, 0xc4, 0x48, 0xc4, 0x7a,
-	0xac, 0xe5, 0xfa, 0x7e, 0x42, 0x18, 0x33, 0xb3, 0x25, 0x54, 0xde, 0xaa, 0x9b, 0x1f, 0xef, 0xd5,
-	0x82, 0x7a, 0xea, 0xb5, 0xbc, 0xb9, 0xe1, 0x09, 0x8d, 0x82, 0xe6, 0xde, 0xda, 0xa2, 0xf8, 0x8b,
-	0x93, 0xd7, 0xa1, 0xad, 0x2d, 0x86, 0xb6, 0xf6, 0x36, 0x1f, 0x57, 0xd4, 0x7f, 0x56, 0x99, 0xdf,
-	0xc5, 0xff, 0x27, 0xab, 0x5f, 0x8e, 0x52, 0x0b, 0x4d, 0x52, 0x0b, 0x4d, 0x53, 0x0b, 0xfd, 0xa4,
-	0x16, 0x1a, 0xcc, 0x2c, 0x6d, 0x3a, 0xb3, 0xb4, 0xcf, 0x99, 0xa5, 0xdd, 0x1d, 0x49, 0x1b, 0xf3,
-	0xbb, 0x0e, 0x05, 0xfc, 0xfc, 0xb7, 0x36, 0xfe, 0x12, 0x13, 0xd6, 0xce, 0x89, 0x41, 0xcf, 0x7f,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0x67, 0x41, 0x63, 0x62, 0xd6, 0x01, 0x00, 0x00,
+	// 672 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x4f, 0xd3, 0x50,
+	0x1c, 0x6f, 0xc7, 0x40, 0x78, 0x02, 0x8e, 0xca, 0x8f, 0x31, 0x63, 0x87, 0x04, 0xcd, 0x42, 0x42,
+	0x1b, 0x30, 0xf1, 0xa0, 0xf1, 0xc0, 0x58, 0x31, 0x4d, 0x60, 0x90, 0x6e, 0x44, 0xf4, 0xd2, 0x74,
+	0xed, 0xa3, 0x7b, 0xb2, 0xbd, 0x37, 0xfb, 0x3a, 0x60, 0xff, 0x81, 0xf1, 0x44, 0xe2, 0xc5, 0x8b,
+	0x09, 0x37, 0x39, 0x72, 0xe0, 0x8f, 0xe0, 0x48, 0x38, 0x19, 0x0f, 0x68, 0xc6, 0x01, 0xfe, 0x0c,
+	0xd3, 0xd7, 0xd7, 0xca, 0x2f, 0x97, 0xa8, 0x97, 0xa5, 0xdf, 0x5f, 0x9f, 0xef, 0xe7, 0xfb, 0xf9,
+	0xb4, 0x03, 0x4f, 0x6c, 0x42, 0xeb, 0x84, 0xaa, 0x70, 0x0b, 0x39, 0x10, 0xdb, 0x50, 0xdd, 0x9a,
+	0xad, 0x40, 0xdf, 0x9a, 0x8d, 0x13, 0x4a, 0xc3, 0x23, 0x3e, 0x91, 0xc6, 0xc2, 0x3e, 0x25, 0x4e,
+	0xf3, 0xbe, 0xcc, 0x90, 0x55, 0x47, 0x98, 0xa8, 0xec, 0x37, 0xec, 0xcd, 0x0c, 0xbb, 0xc4, 0x25,
+	0xec, 0x51, 0x0d, 0x9e, 0x78, 0x56, 0x76, 0x09, 0x71, 0x6b, 0x50, 0x65, 0x51, 0xa5, 0xb9, 0xa1,
+	0x3a, 0x4d, 0xcf, 0xf2, 0x11, 0xc1, 0xbc, 0x9e, 0xbd, 0x5e, 0xf7, 0x51, 0x1d, 0x52, 0xdf, 0xaa,
+	0x37, 0x78, 0xc3, 0x78, 0x48, 0xc1, 0x0c, 0x91, 0x39, 0x1f, 0x16, 0x4c, 0x5e, 0x88, 0xa0, 0x5f,
+	0x7b, 0xdf, 0x44, 0x5b, 0xc4, 0x66, 0x90, 0xd2, 0x28, 0xe8, 0xa9, 0x42, 0xe4, 0x56, 0xfd, 0xb4,
+	0x38, 0x21, 0xe6, 0xba, 0x0c, 0x1e, 0x49, 0x2f, 0x41, 0x32, 0x80, 0x4d, 0x27, 0x26, 0xc4, 0xdc,
+	0xdd, 0xb9, 0x8c, 0x12, 0xee, 0x54, 0xa2, 0x9d, 0x4a, 0x39, 0xda, 0x99, 0x1f, 0x38, 0x3a, 0xcd,
+	0x0a, 0xbb, 0x3f, 0xb2, 0xe2, 0xfe, 0xf9, 0xc1, 0xb4, 0x68, 0xb0, 0x31, 0x69, 0x18, 0x74, 0x37,
+	0xc8, 0x36, 0xf4, 0xd2, 0x5d, 0x0c, 0x35, 0x0c, 0x24, 0x0d, 0x0c, 0xd9, 0x04, 0x53, 0x88, 0x69,
+	0x93, 0x9a, 0x96, 0xe3, 0x78, 0x90, 0xd2, 0x74, 0x72, 0x42, 0xcc, 0xf5, 0xe5, 0xd3, 0x27, 0x87,
+	0x33, 0xc3, 0x9c, 0xea, 0x7c, 0x58, 0x29, 0xf9, 0x1e, 0xc2, 0xae, 0x91, 0x8a, 0x47, 0x78, 0xfe,
+	0xf9, 0xd4, 0x87, 0xbd, 0xac, 0x70, 0xb1, 0x97, 0x15, 0x3e, 0x9e, 0x1f, 0x4c, 0x73, 0xbd, 0x67,
+	0xa8, 0xb3, 0xa9, 0x5e, 0xbe, 0x6c, 0xf2, 0x6b, 0x02, 0x48, 0xda, 0x8e, 0x0f, 0x3d, 0x6c, 0xd5,
+	0x74, 0xbc, 0xe1, 0x59, 0x36, 0x3b, 0x78, 0x1c, 0xf4, 0xda, 0x55, 0x0b, 0x61, 0x13, 0x39, 0xec,
+	0xe4, 0x3e, 0xe3, 0x0e, 0x8b, 0x75, 0xe7, 0x76, 0x7a, 0x89, 0xbf, 0xa5, 0x27, 0xad, 0x83, 0x7b,
+	0x28, 0xde, 0x67, 0xfa, 0xad, 0x06, 0x64, 0x2a, 0x0c, 0xce, 0xa9, 0xca, 0x1f, 0xde, 0x0d, 0xe5,
+	0x26, 0xcf, 0x72, 0xab, 0x01, 0x8d, 0x41, 0x74, 0x25, 0xbe, 0x64, 0x56, 0xf2, 0x56, 0xb3, 0xba,
+	0xff, 0xc9, 0xac, 0xc9, 0x2f, 0x5d, 0x20, 0x7d, 0x93, 0xc1, 0x2a, 0xa9, 0x21, 0xbb, 0xd5, 0x49,
+	0x2f, 0x0a, 0x32, 0x0e, 0x69, 0x56, 0x6a, 0xd0, 0xa4, 0xc8, 0xc5, 0x26, 0xad, 0x59, 0xb4, 0x6a,
+	0x46, 0xe3, 0x5c, 0xb8, 0x67, 0xc1, 0xc2, 0xef, 0xa7, 0xd9, 0x07, 0xe1, 0xe9, 0xd4, 0xd9, 0x54,
+	0x10, 0x51, 0xeb, 0x96, 0x5f, 0x55, 0x96, 0xa0, 0x6b, 0xd9, 0xad, 0x02, 0xb4, 0x4f, 0x0e, 0x67,
+	0x00, 0x57, 0xa6, 0x00, 0xed, 0x90, 0xd9, 0x58, 0x88, 0x5c, 0x42, 0x2e, 0x2e, 0x05, 0xb8, 0x8b,
+	0x91, 0x7f, 0x73, 0x60, 0xc4, 0x27, 0xf5, 0x0a, 0xf5, 0x09, 0x86, 0xe6, 0xa5, 0xf5, 0x4c, 0xe3,
+	0x5e, 0xe3, 0x7e, 0x5c, 0x2c, 0xc4, 0x00, 0x12, 0x06, 0x63, 0x0e, 0xd9, 0xc6, 0xc1, 0xb1, 0xd7,
+	0x59, 0x26, 0xff, 0x8b, 0xe5, 0x48, 0x04, 0x7b, 0x95, 0xe3, 0x32, 0x18, 0x78, 0x67, 0xa1, 0x9a,
+	0x19, 0x7d, 0xb8, 0xdc, 0x98, 0xf1, 0x1b, 0xc6, 0x14, 0x78, 0x43, 0xe8, 0xcb, 0xe7, 0xd8, 0x97,
+	0xfe, 0x60, 0x3c, 0x2a, 0x4e, 0x7f, 0x12, 0xc1, 0xe8, 0xed, 0x6f, 0x88, 0x94, 0x03, 0x53, 0xda,
+	0x7a, 0x59, 0x33, 0x8a, 0xf3, 0x4b, 0xa6, 0x5e, 0x5c, 0x34, 0xe6, 0x17, 0xca, 0xfa, 0x4a, 0xd1,
+	0x2c, 0xbf, 0x59, 0xd5, 0xcc, 0xb5, 0x62, 0x69, 0x55, 0x5b, 0xd0, 0x17, 0x75, 0xad, 0x90, 0x12,
+	0x3a, 0x76, 0x16, 0x56, 0xd6, 0xf2, 0x4b, 0x9a, 0x59, 0xd2, 0x5f, 0x15, 0x53, 0xa2, 0xf4, 0x18,
+	0x3c, 0xea, 0xd0, 0xf9, 0xba, 0x58, 0xd6, 0x97, 0xb5, 0x54, 0x22, 0xff, 0x62, 0xbf, 0x2d, 0x8b,
+	0x47, 0x6d, 0x59, 0x3c, 0x6e, 0xcb, 0xe2, 0xcf, 0xb6, 0x2c, 0xee, 0x9e, 0xc9, 0xc2, 0xf1, 0x99,
+	0x2c, 0x7c, 0x3b, 0x93, 0x85, 0xb7, 0x0f, 0xaf, 0x28, 0xb9, 0xf3, 0xfb, 0x6f, 0x33, 0xf8, 0x1e,
+	0x68, 0xa5, 0x87, 0x49, 0xf0, 0xf4, 0xd7, 0x00, 0x8c, 0xde, 0xc9, 0x62, 0x56, 0x05, 0x00, 0x00,
+}
+
+func (this *ExternalInfraction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExternalInfraction)
+	if !ok {
+		that2, ok := that.(ExternalInfraction)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ChainId != that1.ChainId {
+		return false
+	}
+	if this.ConsensusAddress != that1.ConsensusAddress {
+		return false
+	}
+	if this.InfractionType != that1.InfractionType {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	return true
 }
+func (this *ExternalInfractionPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
+	that1, ok := that.(*ExternalInfractionPolicy)
+	if !ok {
+		that2, ok := that.(ExternalInfractionPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ChainId != that1.ChainId {
+		return false
+	}
+	if !this.DoubleSignSlashFraction.Equal(that1.DoubleSignSlashFraction) {
+		return false
+	}
+	if this.TombstoneDoubleSign != that1.TombstoneDoubleSign {
+		return false
+	}
+	if !this.DowntimeSlashFraction.Equal(that1.DowntimeSlashFraction) {
+		return false
+	}
+	if this.JailDuration != that1.JailDuration {
+		return false
+	}
+	return true
+}
 func (m *Equivocation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -157,6 +446,129 @@ func (m *Equivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExternalInfraction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExternalInfraction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExternalInfraction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintEvidence(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InfractionType != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.InfractionType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExternalInfractionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExternalInfractionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExternalInfractionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintEvidence(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.DowntimeSlashFraction.Size()
+		i -= size
+		if _, err := m.DowntimeSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvidence(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TombstoneDoubleSign {
+		i--
+		if m.TombstoneDoubleSign {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.DoubleSignSlashFraction.Size()
+		i -= size
+		if _, err := m.DoubleSignSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvidence(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvidence(v)
 	base := offset
@@ -189,6 +601,53 @@ func (m *Equivocation) Size() (n int) {
 	return n
 }
 
+func (m *ExternalInfraction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.InfractionType != 0 {
+		n += 1 + sovEvidence(uint64(m.InfractionType))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvidence(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovEvidence(uint64(l))
+	return n
+}
+
+func (m *ExternalInfractionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = m.DoubleSignSlashFraction.Size()
+	n += 1 + l + sovEvidence(uint64(l))
+	if m.TombstoneDoubleSign {
+		n += 2
+	}
+	l = m.DowntimeSlashFraction.Size()
+	n += 1 + l + sovEvidence(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovEvidence(uint64(l))
+	return n
+}
+
 func sovEvidence(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -348,6 +807,394 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ExternalInfraction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExternalInfraction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExternalInfraction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionType", wireType)
+			}
+			m.InfractionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InfractionType |= ExternalInfractionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExternalInfractionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExternalInfractionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExternalInfractionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleSignSlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DoubleSignSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TombstoneDoubleSign", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TombstoneDoubleSign = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeSlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DowntimeSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvidence(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
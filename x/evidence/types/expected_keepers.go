@@ -18,6 +18,7 @@ type (
 	StakingKeeper interface {
 		ConsensusAddressCodec() address.Codec
 		ValidatorByConsAddr(context.Context, sdk.ConsAddress) (sdk.ValidatorI, error)
+		PowerReduction(context.Context) math.Int
 	}
 
 	// SlashingKeeper defines the slashing module interface contract needed by the
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// Validate does a sanity check on the external infraction policy.
+func (p ExternalInfractionPolicy) Validate() error {
+	if p.ChainId == "" {
+		return errors.Wrap(ErrInvalidPolicy, "chain id cannot be empty")
+	}
+	if err := validateSlashFraction(p.DoubleSignSlashFraction); err != nil {
+		return errors.Wrapf(ErrInvalidPolicy, "double sign slash fraction: %s", err)
+	}
+	if err := validateSlashFraction(p.DowntimeSlashFraction); err != nil {
+		return errors.Wrapf(ErrInvalidPolicy, "downtime slash fraction: %s", err)
+	}
+	if p.JailDuration < 0 {
+		return errors.Wrapf(ErrInvalidPolicy, "jail duration cannot be negative: %s", p.JailDuration)
+	}
+
+	return nil
+}
+
+// Validate does a sanity check on the external infraction. The consensus
+// address is only checked to be set.
+func (i ExternalInfraction) Validate() error {
+	if i.ChainId == "" {
+		return errors.Wrap(ErrInvalidInfraction, "chain id cannot be empty")
+	}
+	if i.ConsensusAddress == "" {
+		return errors.Wrap(ErrInvalidInfraction, "consensus address cannot be empty")
+	}
+	if i.InfractionType != ExternalInfractionType_EXTERNAL_INFRACTION_TYPE_DOUBLE_SIGN &&
+		i.InfractionType != ExternalInfractionType_EXTERNAL_INFRACTION_TYPE_DOWNTIME {
+		return errors.Wrapf(ErrInvalidInfraction, "invalid infraction type: %s", i.InfractionType)
+	}
+	if i.Height <= 0 {
+		return errors.Wrapf(ErrInvalidInfraction, "height must be positive: %d", i.Height)
+	}
+
+	return nil
+}
+
+func validateSlashFraction(fraction math.LegacyDec) error {
+	if fraction.IsNil() || fraction.IsNegative() || fraction.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("must be between 0 and 1: %s", fraction)
+	}
+	return nil
+}
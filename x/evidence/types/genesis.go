@@ -51,6 +51,24 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	chainIDs := make(map[string]bool, len(gs.ExternalInfractionPolicies))
+	for _, policy := range gs.ExternalInfractionPolicies {
+		if chainIDs[policy.ChainId] {
+			return fmt.Errorf("duplicate external infraction policy for chain %s", policy.ChainId)
+		}
+		chainIDs[policy.ChainId] = true
+
+		if err := policy.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, infraction := range gs.ExternalInfractions {
+		if err := infraction.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
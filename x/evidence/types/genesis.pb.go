@@ -6,6 +6,8 @@ package types
 import (
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -27,6 +29,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// evidence defines all the evidence at genesis.
 	Evidence []*types.Any `protobuf:"bytes,1,rep,name=evidence,proto3" json:"evidence,omitempty"`
+	// external_infraction_policies defines all the external infraction policies
+	// at genesis.
+	ExternalInfractionPolicies []ExternalInfractionPolicy `protobuf:"bytes,2,rep,name=external_infraction_policies,json=externalInfractionPolicies,proto3" json:"external_infraction_policies"`
+	// external_infractions defines all the external infractions converted to
+	// local slashing at genesis.
+	ExternalInfractions []ExternalInfraction `protobuf:"bytes,3,rep,name=external_infractions,json=externalInfractions,proto3" json:"external_infractions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +77,20 @@ func (m *GenesisState) GetEvidence() []*types.Any {
 	return nil
 }
 
+func (m *GenesisState) GetExternalInfractionPolicies() []ExternalInfractionPolicy {
+	if m != nil {
+		return m.ExternalInfractionPolicies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExternalInfractions() []ExternalInfraction {
+	if m != nil {
+		return m.ExternalInfractions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.evidence.v1beta1.GenesisState")
 }
@@ -78,19 +100,27 @@ func init() {
 }
 
 var fileDescriptor_c610c52c26e0e202 = []byte{
-	// 184 bytes of a gzipped FileDescriptorProto
+	// 307 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xce, 0x2f, 0xce,
 	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0x4c, 0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x2f, 0x33, 0x4c, 0x4a,
 	0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
 	0xc9, 0x17, 0x12, 0x87, 0x28, 0xd3, 0x83, 0x29, 0xd3, 0x83, 0x2a, 0x93, 0x92, 0x4c, 0xcf, 0xcf,
-	0x4f, 0xcf, 0x49, 0xd5, 0x07, 0x2b, 0x4b, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xe8, 0x51,
-	0x72, 0xe0, 0xe2, 0x71, 0x87, 0x18, 0x12, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xc0, 0xc5, 0x01,
-	0xd3, 0x2e, 0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x6d, 0x24, 0xa2, 0x07, 0xd1, 0xad, 0x07, 0xd3, 0xad,
-	0xe7, 0x98, 0x57, 0x19, 0x04, 0x57, 0xe5, 0x64, 0x7e, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72,
-	0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7,
-	0x72, 0x0c, 0x51, 0xb2, 0x10, 0xf7, 0x14, 0xa7, 0x64, 0xeb, 0x65, 0xe6, 0xeb, 0x57, 0x20, 0x9c,
-	0x5f, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x36, 0xd0, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff,
-	0x17, 0x25, 0x11, 0x0c, 0xde, 0x00, 0x00, 0x00,
+	0x4f, 0xcf, 0x49, 0xd5, 0x07, 0x2b, 0x4b, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xe8, 0x91,
+	0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0xa8, 0xa8, 0x60, 0x62, 0x6e, 0x66,
+	0x5e, 0xbe, 0x3e, 0x98, 0x84, 0x0a, 0xa9, 0xe1, 0x72, 0x03, 0xdc, 0x36, 0xb0, 0x3a, 0xa5, 0xd5,
+	0x4c, 0x5c, 0x3c, 0xee, 0x10, 0x67, 0x05, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x19, 0x70, 0x71, 0xc0,
+	0x94, 0x48, 0x30, 0x2a, 0x30, 0x6b, 0x70, 0x1b, 0x89, 0xe8, 0x41, 0xdc, 0xa3, 0x07, 0x73, 0x8f,
+	0x9e, 0x63, 0x5e, 0x65, 0x10, 0x5c, 0x95, 0x50, 0x1d, 0x97, 0x4c, 0x6a, 0x45, 0x49, 0x6a, 0x51,
+	0x5e, 0x62, 0x4e, 0x7c, 0x66, 0x5e, 0x5a, 0x51, 0x62, 0x72, 0x49, 0x66, 0x7e, 0x5e, 0x7c, 0x41,
+	0x7e, 0x4e, 0x66, 0x72, 0x66, 0x6a, 0xb1, 0x04, 0x13, 0xd8, 0x14, 0x43, 0x3d, 0x1c, 0xde, 0xd5,
+	0x73, 0x85, 0x6a, 0xf6, 0x84, 0xeb, 0x0d, 0x00, 0x69, 0xad, 0x74, 0xe2, 0x3c, 0x71, 0x4f, 0x9e,
+	0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x52, 0xa9, 0xd8, 0x15, 0x65, 0xa6, 0x16, 0x0b, 0x65,
+	0x72, 0x89, 0x60, 0xb1, 0xbf, 0x58, 0x82, 0x19, 0x6c, 0xaf, 0x36, 0x09, 0xf6, 0x22, 0xdb, 0x28,
+	0x8c, 0x69, 0x63, 0xb1, 0x93, 0xf9, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78,
+	0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44,
+	0xc9, 0x42, 0x6c, 0x29, 0x4e, 0xc9, 0xd6, 0xcb, 0xcc, 0xd7, 0xaf, 0x40, 0x84, 0x7b, 0x49, 0x65,
+	0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0xec, 0x8c, 0x01, 0x03, 0x00, 0x45, 0x9c, 0xb6, 0xa1, 0x1b,
+	0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -113,6 +143,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExternalInfractions) > 0 {
+		for iNdEx := len(m.ExternalInfractions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExternalInfractions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ExternalInfractionPolicies) > 0 {
+		for iNdEx := len(m.ExternalInfractionPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExternalInfractionPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Evidence) > 0 {
 		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -153,6 +211,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ExternalInfractionPolicies) > 0 {
+		for _, e := range m.ExternalInfractionPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExternalInfractions) > 0 {
+		for _, e := range m.ExternalInfractions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -225,6 +295,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalInfractionPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalInfractionPolicies = append(m.ExternalInfractionPolicies, ExternalInfractionPolicy{})
+			if err := m.ExternalInfractionPolicies[len(m.ExternalInfractionPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalInfractions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalInfractions = append(m.ExternalInfractions, ExternalInfraction{})
+			if err := m.ExternalInfractions[len(m.ExternalInfractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
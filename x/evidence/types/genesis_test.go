@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/evidence/exported"
 	"cosmossdk.io/x/evidence/types"
 
@@ -108,6 +109,32 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"duplicate external infraction policy",
+			func() {
+				policy := types.ExternalInfractionPolicy{
+					ChainId:                 "consumer-1",
+					DoubleSignSlashFraction: math.LegacyNewDecWithPrec(5, 2),
+					DowntimeSlashFraction:   math.LegacyZeroDec(),
+				}
+				genesisState = &types.GenesisState{
+					ExternalInfractionPolicies: []types.ExternalInfractionPolicy{policy, policy},
+				}
+			},
+			false,
+		},
+		{
+			"invalid external infraction",
+			func() {
+				genesisState = &types.GenesisState{
+					ExternalInfractions: []types.ExternalInfraction{{
+						ChainId:          "consumer-1",
+						ConsensusAddress: pk.PubKey().Address().String(),
+					}},
+				}
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
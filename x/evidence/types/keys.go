@@ -8,9 +8,14 @@ const (
 
 	// StoreKey defines the primary module store key
 	StoreKey = ModuleName
+
+	// GovModuleName duplicates the gov module's name to avoid a cyclic dependency with x/gov.
+	GovModuleName = "gov"
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixEvidence = collections.NewPrefix(0)
+	KeyPrefixEvidence                 = collections.NewPrefix(0)
+	ExternalInfractionPolicyKeyPrefix = collections.NewPrefix(1)
+	ExternalInfractionKeyPrefix       = collections.NewPrefix(2)
 )
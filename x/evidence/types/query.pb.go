@@ -8,6 +8,8 @@ import (
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -238,11 +240,225 @@ func (m *QueryAllEvidenceResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryExternalInfractionPoliciesRequest is the request type for the
+// Query/ExternalInfractionPolicies RPC method.
+type QueryExternalInfractionPoliciesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExternalInfractionPoliciesRequest) Reset() {
+	*m = QueryExternalInfractionPoliciesRequest{}
+}
+func (m *QueryExternalInfractionPoliciesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExternalInfractionPoliciesRequest) ProtoMessage()    {}
+func (*QueryExternalInfractionPoliciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{4}
+}
+func (m *QueryExternalInfractionPoliciesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExternalInfractionPoliciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExternalInfractionPoliciesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExternalInfractionPoliciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExternalInfractionPoliciesRequest.Merge(m, src)
+}
+func (m *QueryExternalInfractionPoliciesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExternalInfractionPoliciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExternalInfractionPoliciesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExternalInfractionPoliciesRequest proto.InternalMessageInfo
+
+func (m *QueryExternalInfractionPoliciesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryExternalInfractionPoliciesResponse is the response type for the
+// Query/ExternalInfractionPolicies RPC method.
+type QueryExternalInfractionPoliciesResponse struct {
+	// policies returns all external infraction policies.
+	Policies []ExternalInfractionPolicy `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExternalInfractionPoliciesResponse) Reset() {
+	*m = QueryExternalInfractionPoliciesResponse{}
+}
+func (m *QueryExternalInfractionPoliciesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExternalInfractionPoliciesResponse) ProtoMessage()    {}
+func (*QueryExternalInfractionPoliciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{5}
+}
+func (m *QueryExternalInfractionPoliciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExternalInfractionPoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExternalInfractionPoliciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExternalInfractionPoliciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExternalInfractionPoliciesResponse.Merge(m, src)
+}
+func (m *QueryExternalInfractionPoliciesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExternalInfractionPoliciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExternalInfractionPoliciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExternalInfractionPoliciesResponse proto.InternalMessageInfo
+
+func (m *QueryExternalInfractionPoliciesResponse) GetPolicies() []ExternalInfractionPolicy {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+func (m *QueryExternalInfractionPoliciesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryExternalInfractionsRequest is the request type for the
+// Query/ExternalInfractions RPC method.
+type QueryExternalInfractionsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExternalInfractionsRequest) Reset()         { *m = QueryExternalInfractionsRequest{} }
+func (m *QueryExternalInfractionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExternalInfractionsRequest) ProtoMessage()    {}
+func (*QueryExternalInfractionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{6}
+}
+func (m *QueryExternalInfractionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExternalInfractionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExternalInfractionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExternalInfractionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExternalInfractionsRequest.Merge(m, src)
+}
+func (m *QueryExternalInfractionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExternalInfractionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExternalInfractionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExternalInfractionsRequest proto.InternalMessageInfo
+
+func (m *QueryExternalInfractionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryExternalInfractionsResponse is the response type for the
+// Query/ExternalInfractions RPC method.
+type QueryExternalInfractionsResponse struct {
+	// infractions returns all external infractions.
+	Infractions []ExternalInfraction `protobuf:"bytes,1,rep,name=infractions,proto3" json:"infractions"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExternalInfractionsResponse) Reset()         { *m = QueryExternalInfractionsResponse{} }
+func (m *QueryExternalInfractionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExternalInfractionsResponse) ProtoMessage()    {}
+func (*QueryExternalInfractionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{7}
+}
+func (m *QueryExternalInfractionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExternalInfractionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExternalInfractionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExternalInfractionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExternalInfractionsResponse.Merge(m, src)
+}
+func (m *QueryExternalInfractionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExternalInfractionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExternalInfractionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExternalInfractionsResponse proto.InternalMessageInfo
+
+func (m *QueryExternalInfractionsResponse) GetInfractions() []ExternalInfraction {
+	if m != nil {
+		return m.Infractions
+	}
+	return nil
+}
+
+func (m *QueryExternalInfractionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryEvidenceRequest")
 	proto.RegisterType((*QueryEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryEvidenceResponse")
 	proto.RegisterType((*QueryAllEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryAllEvidenceRequest")
 	proto.RegisterType((*QueryAllEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryAllEvidenceResponse")
+	proto.RegisterType((*QueryExternalInfractionPoliciesRequest)(nil), "cosmos.evidence.v1beta1.QueryExternalInfractionPoliciesRequest")
+	proto.RegisterType((*QueryExternalInfractionPoliciesResponse)(nil), "cosmos.evidence.v1beta1.QueryExternalInfractionPoliciesResponse")
+	proto.RegisterType((*QueryExternalInfractionsRequest)(nil), "cosmos.evidence.v1beta1.QueryExternalInfractionsRequest")
+	proto.RegisterType((*QueryExternalInfractionsResponse)(nil), "cosmos.evidence.v1beta1.QueryExternalInfractionsResponse")
 }
 
 func init() {
@@ -250,34 +466,46 @@ func init() {
 }
 
 var fileDescriptor_07043de1a84d215a = []byte{
-	// 428 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcb, 0x6e, 0x1a, 0x31,
-	0x18, 0x85, 0xf1, 0xf4, 0x22, 0x6a, 0xe8, 0xc6, 0xa2, 0x82, 0x8e, 0xda, 0x11, 0x1d, 0xa4, 0x42,
-	0x91, 0x6a, 0x03, 0x5d, 0x74, 0x0d, 0x52, 0x6f, 0xbb, 0x76, 0xba, 0xeb, 0xa6, 0xf2, 0x80, 0x3b,
-	0x8c, 0x4a, 0xed, 0x01, 0x0f, 0xa8, 0xa8, 0xea, 0xa6, 0x4f, 0x50, 0x29, 0xca, 0x2a, 0xca, 0xe3,
-	0x44, 0xca, 0x12, 0x29, 0x9b, 0x2c, 0x23, 0xc8, 0x83, 0x44, 0xd8, 0x1e, 0x20, 0x5c, 0x42, 0xb2,
-	0x83, 0xf9, 0xcf, 0x39, 0xff, 0xa7, 0xf3, 0x1b, 0x96, 0xda, 0x42, 0xfe, 0x12, 0x92, 0xb0, 0x51,
-	0xd8, 0x61, 0xbc, 0xcd, 0xc8, 0xa8, 0xee, 0xb3, 0x98, 0xd6, 0x49, 0x7f, 0xc8, 0x06, 0x63, 0x1c,
-	0x0d, 0x44, 0x2c, 0x50, 0x5e, 0x8b, 0x70, 0x22, 0xc2, 0x46, 0x64, 0x57, 0x8d, 0xdb, 0xa7, 0x92,
-	0x69, 0xc7, 0xc2, 0x1f, 0xd1, 0x20, 0xe4, 0x34, 0x0e, 0x05, 0xd7, 0x21, 0xf6, 0xd3, 0x40, 0x88,
-	0xa0, 0xc7, 0x88, 0xfa, 0xe7, 0x0f, 0x7f, 0x10, 0xca, 0x4d, 0xbe, 0xfd, 0xcc, 0x8c, 0x68, 0x14,
-	0x12, 0xca, 0xb9, 0x88, 0x95, 0x4f, 0xea, 0xa9, 0xfb, 0x15, 0xe6, 0xbe, 0xcc, 0xa3, 0xdf, 0x99,
-	0xed, 0x1e, 0xeb, 0x0f, 0x99, 0x8c, 0x51, 0x19, 0x3e, 0x4e, 0x80, 0xbe, 0x77, 0xa9, 0xec, 0x16,
-	0x40, 0x11, 0x54, 0xb2, 0x2d, 0xab, 0x00, 0xbc, 0x6c, 0x32, 0xf8, 0x48, 0x65, 0x17, 0x21, 0x78,
-	0x5f, 0xcd, 0xad, 0x22, 0xa8, 0x3c, 0xf2, 0xd4, 0x6f, 0xf7, 0x13, 0x7c, 0xb2, 0x16, 0x2a, 0x23,
-	0xc1, 0x25, 0x43, 0x35, 0x98, 0x4e, 0xcc, 0x2a, 0x30, 0xd3, 0xc8, 0x61, 0x8d, 0x87, 0x13, 0x72,
-	0xdc, 0xe4, 0x63, 0x6f, 0xa1, 0x72, 0x29, 0xcc, 0xab, 0xa8, 0x66, 0xaf, 0xb7, 0x8e, 0xf8, 0x1e,
-	0xc2, 0x65, 0x0f, 0x26, 0xee, 0x25, 0x36, 0x6d, 0xce, 0x4b, 0xc3, 0xba, 0x66, 0x53, 0x1a, 0xfe,
-	0x4c, 0x83, 0xc4, 0xeb, 0xad, 0x38, 0xdd, 0x43, 0x00, 0x0b, 0x9b, 0x3b, 0xb6, 0x12, 0xdf, 0xdb,
-	0x4f, 0x8c, 0x3e, 0x5c, 0xc3, 0xb2, 0x14, 0x56, 0x79, 0x2f, 0x96, 0x5e, 0xb7, 0xca, 0xd5, 0x38,
-	0xb1, 0xe0, 0x03, 0xc5, 0x85, 0x8e, 0x00, 0x4c, 0x27, 0x64, 0xe8, 0x35, 0xde, 0xf1, 0x60, 0xf0,
-	0xb6, 0x43, 0xda, 0xf8, 0xb6, 0x72, 0x4d, 0xe0, 0xd6, 0xfe, 0x9d, 0x5d, 0x1e, 0x58, 0x55, 0x54,
-	0x21, 0xbb, 0x1e, 0xef, 0xe2, 0xc3, 0x9f, 0xf9, 0xb1, 0xff, 0xa2, 0x63, 0x00, 0x33, 0x2b, 0xd5,
-	0xa1, 0xda, 0xcd, 0x1b, 0x37, 0x2f, 0x69, 0xd7, 0xef, 0xe0, 0x30, 0x98, 0xaf, 0x14, 0x66, 0x09,
-	0xbd, 0xd8, 0x8b, 0xd9, 0x7a, 0x7b, 0x3a, 0x75, 0xc0, 0x64, 0xea, 0x80, 0x8b, 0xa9, 0x03, 0xfe,
-	0xcf, 0x9c, 0xd4, 0x64, 0xe6, 0xa4, 0xce, 0x67, 0x4e, 0xea, 0xdb, 0x73, 0xed, 0x95, 0x9d, 0x9f,
-	0x38, 0x14, 0xe4, 0xf7, 0x32, 0x23, 0x1e, 0x47, 0x4c, 0xfa, 0x0f, 0xd5, 0x85, 0xdf, 0x5c, 0x05,
-	0x00, 0x00, 0xff, 0xff, 0x94, 0x9c, 0x7a, 0xc6, 0xc7, 0x03, 0x00, 0x00,
+	// 622 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xce, 0x44, 0x2b, 0xed, 0xa4, 0x1e, 0x1c, 0x23, 0x8d, 0x8b, 0x6e, 0x63, 0x0a, 0x49, 0x8c,
+	0x74, 0x26, 0xa9, 0x94, 0xea, 0x41, 0xb4, 0x01, 0x7f, 0xf4, 0x56, 0xd7, 0x8b, 0x78, 0x09, 0x93,
+	0x74, 0xba, 0x59, 0x4c, 0x67, 0xb6, 0x99, 0x4d, 0x69, 0x10, 0x2f, 0xfe, 0x05, 0x82, 0x78, 0x12,
+	0xef, 0x1e, 0xfd, 0x17, 0x3c, 0x08, 0x3d, 0x78, 0x28, 0x78, 0xf1, 0x54, 0x24, 0x11, 0x04, 0xff,
+	0x0a, 0xc9, 0xec, 0xec, 0x26, 0x6d, 0xba, 0xae, 0x29, 0xb9, 0x84, 0xc9, 0xbc, 0xf7, 0xbe, 0xf7,
+	0x7d, 0xdf, 0xec, 0x7b, 0x70, 0xa9, 0x21, 0xe4, 0x8e, 0x90, 0x84, 0xed, 0x39, 0x5b, 0x8c, 0x37,
+	0x18, 0xd9, 0xab, 0xd4, 0x99, 0x47, 0x2b, 0x64, 0xb7, 0xc3, 0xda, 0x5d, 0xec, 0xb6, 0x85, 0x27,
+	0xd0, 0x82, 0x9f, 0x84, 0x83, 0x24, 0xac, 0x93, 0x8c, 0x92, 0xae, 0xae, 0x53, 0xc9, 0xfc, 0x8a,
+	0xb0, 0xde, 0xa5, 0xb6, 0xc3, 0xa9, 0xe7, 0x08, 0xee, 0x83, 0x18, 0x57, 0x6d, 0x21, 0xec, 0x16,
+	0x23, 0xea, 0x5f, 0xbd, 0xb3, 0x4d, 0x28, 0xd7, 0xf8, 0xc6, 0x35, 0x1d, 0xa2, 0xae, 0x43, 0x28,
+	0xe7, 0xc2, 0x53, 0x75, 0x52, 0x47, 0xd3, 0xb6, 0xb0, 0x85, 0x3a, 0x92, 0xc1, 0x49, 0xdf, 0x5e,
+	0xa2, 0x3b, 0x0e, 0x17, 0x44, 0xfd, 0xea, 0xab, 0x7c, 0x94, 0x96, 0x90, 0xb7, 0xca, 0xcb, 0x3d,
+	0x83, 0xe9, 0xa7, 0x03, 0xae, 0x0f, 0xf5, 0xb5, 0xc5, 0x76, 0x3b, 0x4c, 0x7a, 0xa8, 0x00, 0x2f,
+	0x06, 0x99, 0xb5, 0x26, 0x95, 0xcd, 0x0c, 0xc8, 0x82, 0xe2, 0x7c, 0x35, 0x99, 0x01, 0xd6, 0x7c,
+	0x10, 0x78, 0x42, 0x65, 0x13, 0x21, 0x78, 0x5e, 0xc5, 0x93, 0x59, 0x50, 0x9c, 0xb3, 0xd4, 0x39,
+	0xb7, 0x01, 0xaf, 0x9c, 0x00, 0x95, 0xae, 0xe0, 0x92, 0xa1, 0x32, 0x9c, 0x0d, 0x8a, 0x15, 0x60,
+	0x6a, 0x25, 0x8d, 0x7d, 0xbd, 0x38, 0xb0, 0x02, 0xaf, 0xf3, 0xae, 0x15, 0x66, 0xe5, 0x28, 0x5c,
+	0x50, 0x50, 0xeb, 0xad, 0xd6, 0x49, 0x8a, 0x8f, 0x20, 0x1c, 0x1a, 0xab, 0xe1, 0xf2, 0x58, 0x3f,
+	0xcf, 0xe0, 0x15, 0xb0, 0xff, 0x6e, 0x5a, 0x39, 0xde, 0xa4, 0x76, 0x50, 0x6b, 0x8d, 0x54, 0xe6,
+	0xde, 0x03, 0x98, 0x19, 0xef, 0x71, 0x2a, 0xe3, 0x73, 0xf1, 0x8c, 0xd1, 0xe3, 0x63, 0xb4, 0x92,
+	0x8a, 0x56, 0x21, 0x96, 0x96, 0xdf, 0xee, 0x18, 0x2f, 0x17, 0xe6, 0x7d, 0x17, 0xf7, 0x3d, 0xd6,
+	0xe6, 0xb4, 0xb5, 0xc1, 0xb7, 0xdb, 0xb4, 0x31, 0x08, 0x6d, 0x8a, 0x96, 0xd3, 0x70, 0x98, 0x9c,
+	0xb6, 0x13, 0xdf, 0x00, 0x2c, 0xc4, 0xb6, 0xd4, 0xc6, 0x3c, 0x87, 0xb3, 0xae, 0xbe, 0xd3, 0xc6,
+	0x54, 0x70, 0xc4, 0x68, 0xe0, 0x08, 0xb8, 0x6e, 0x75, 0xee, 0xe0, 0x68, 0x31, 0xf1, 0xe9, 0xf7,
+	0xe7, 0x12, 0xb0, 0x42, 0xb4, 0xe9, 0x19, 0xe8, 0xc0, 0xc5, 0x08, 0x35, 0x53, 0x77, 0xee, 0x2b,
+	0x80, 0xd9, 0xe8, 0x5e, 0xa1, 0x65, 0x29, 0x67, 0x78, 0xad, 0x5d, 0xbb, 0x35, 0x81, 0x6b, 0xa3,
+	0x7e, 0x8d, 0x42, 0x4d, 0xcd, 0xb2, 0x95, 0x3f, 0x33, 0x70, 0x46, 0xe9, 0x40, 0x1f, 0x00, 0x9c,
+	0x0d, 0xa6, 0x01, 0x2d, 0x47, 0x92, 0x3c, 0x6d, 0x79, 0x18, 0xf8, 0x7f, 0xd3, 0x7d, 0x06, 0xb9,
+	0xf2, 0x9b, 0xef, 0xbf, 0xde, 0x25, 0x4b, 0xa8, 0x48, 0xe2, 0xb6, 0x16, 0x79, 0x35, 0x58, 0x30,
+	0xaf, 0xd1, 0x47, 0x00, 0x53, 0x23, 0xe3, 0x8a, 0xca, 0xff, 0xee, 0x38, 0xbe, 0x3d, 0x8c, 0xca,
+	0x04, 0x15, 0x9a, 0xe6, 0x4d, 0x45, 0x73, 0x09, 0xdd, 0x88, 0xa5, 0x89, 0x8e, 0x00, 0x34, 0xa2,
+	0x87, 0x08, 0xdd, 0x8f, 0x31, 0x28, 0x6e, 0xe2, 0x8d, 0x07, 0x67, 0x07, 0xd0, 0x62, 0xee, 0x29,
+	0x31, 0x6b, 0x68, 0x35, 0x5a, 0x8c, 0x06, 0xa9, 0x0d, 0xbf, 0xb4, 0x5a, 0x38, 0xa4, 0x5f, 0x00,
+	0xbc, 0x3c, 0xde, 0x45, 0xa2, 0x3b, 0x93, 0x12, 0x0b, 0x25, 0xdd, 0x3d, 0x43, 0xa5, 0xd6, 0xb2,
+	0xaa, 0xb4, 0x10, 0xb4, 0x3c, 0x89, 0x16, 0x59, 0x5d, 0x3b, 0xe8, 0x99, 0xe0, 0xb0, 0x67, 0x82,
+	0x9f, 0x3d, 0x13, 0xbc, 0xed, 0x9b, 0x89, 0xc3, 0xbe, 0x99, 0xf8, 0xd1, 0x37, 0x13, 0x2f, 0xae,
+	0xfb, 0x38, 0x72, 0xeb, 0x25, 0x76, 0x04, 0xd9, 0x1f, 0xe2, 0x79, 0x5d, 0x97, 0xc9, 0xfa, 0x05,
+	0xb5, 0xfa, 0x6f, 0xff, 0x1d, 0x00, 0x97, 0x89, 0xd7, 0xff, 0x31, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -296,6 +524,11 @@ type QueryClient interface {
 	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(ctx context.Context, in *QueryAllEvidenceRequest, opts ...grpc.CallOption) (*QueryAllEvidenceResponse, error)
+	// ExternalInfractionPolicies queries all external infraction policies.
+	ExternalInfractionPolicies(ctx context.Context, in *QueryExternalInfractionPoliciesRequest, opts ...grpc.CallOption) (*QueryExternalInfractionPoliciesResponse, error)
+	// ExternalInfractions queries all external infractions converted to local
+	// slashing.
+	ExternalInfractions(ctx context.Context, in *QueryExternalInfractionsRequest, opts ...grpc.CallOption) (*QueryExternalInfractionsResponse, error)
 }
 
 type queryClient struct {
@@ -324,12 +557,35 @@ func (c *queryClient) AllEvidence(ctx context.Context, in *QueryAllEvidenceReque
 	return out, nil
 }
 
+func (c *queryClient) ExternalInfractionPolicies(ctx context.Context, in *QueryExternalInfractionPoliciesRequest, opts ...grpc.CallOption) (*QueryExternalInfractionPoliciesResponse, error) {
+	out := new(QueryExternalInfractionPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evidence.v1beta1.Query/ExternalInfractionPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExternalInfractions(ctx context.Context, in *QueryExternalInfractionsRequest, opts ...grpc.CallOption) (*QueryExternalInfractionsResponse, error) {
+	out := new(QueryExternalInfractionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evidence.v1beta1.Query/ExternalInfractions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Evidence queries evidence based on evidence hash.
 	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error)
+	// ExternalInfractionPolicies queries all external infraction policies.
+	ExternalInfractionPolicies(context.Context, *QueryExternalInfractionPoliciesRequest) (*QueryExternalInfractionPoliciesResponse, error)
+	// ExternalInfractions queries all external infractions converted to local
+	// slashing.
+	ExternalInfractions(context.Context, *QueryExternalInfractionsRequest) (*QueryExternalInfractionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -342,6 +598,12 @@ func (*UnimplementedQueryServer) Evidence(ctx context.Context, req *QueryEvidenc
 func (*UnimplementedQueryServer) AllEvidence(ctx context.Context, req *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllEvidence not implemented")
 }
+func (*UnimplementedQueryServer) ExternalInfractionPolicies(ctx context.Context, req *QueryExternalInfractionPoliciesRequest) (*QueryExternalInfractionPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExternalInfractionPolicies not implemented")
+}
+func (*UnimplementedQueryServer) ExternalInfractions(ctx context.Context, req *QueryExternalInfractionsRequest) (*QueryExternalInfractionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExternalInfractions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -383,6 +645,42 @@ func _Query_AllEvidence_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ExternalInfractionPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExternalInfractionPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExternalInfractionPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evidence.v1beta1.Query/ExternalInfractionPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExternalInfractionPolicies(ctx, req.(*QueryExternalInfractionPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExternalInfractions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExternalInfractionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExternalInfractions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evidence.v1beta1.Query/ExternalInfractions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExternalInfractions(ctx, req.(*QueryExternalInfractionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evidence.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -395,6 +693,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllEvidence",
 			Handler:    _Query_AllEvidence_Handler,
 		},
+		{
+			MethodName: "ExternalInfractionPolicies",
+			Handler:    _Query_ExternalInfractionPolicies_Handler,
+		},
+		{
+			MethodName: "ExternalInfractions",
+			Handler:    _Query_ExternalInfractions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evidence/v1beta1/query.proto",
@@ -553,89 +859,609 @@ func (m *QueryAllEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 			dAtA[i] = 0xa
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryEvidenceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.EvidenceHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryEvidenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Evidence != nil {
-		l = m.Evidence.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryAllEvidenceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExternalInfractionPoliciesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExternalInfractionPoliciesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExternalInfractionPoliciesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExternalInfractionPoliciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExternalInfractionPoliciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExternalInfractionPoliciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Policies) > 0 {
+		for iNdEx := len(m.Policies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Policies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExternalInfractionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExternalInfractionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExternalInfractionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExternalInfractionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExternalInfractionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExternalInfractionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Infractions) > 0 {
+		for iNdEx := len(m.Infractions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Infractions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryEvidenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvidenceHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Evidence != nil {
+		l = m.Evidence.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllEvidenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Evidence) > 0 {
+		for _, e := range m.Evidence {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExternalInfractionPoliciesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExternalInfractionPoliciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Policies) > 0 {
+		for _, e := range m.Policies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExternalInfractionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExternalInfractionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Infractions) > 0 {
+		for _, e := range m.Infractions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryEvidenceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvidenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvidenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceHash = append(m.EvidenceHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvidenceHash == nil {
+				m.EvidenceHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEvidenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvidenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Evidence == nil {
+				m.Evidence = &types.Any{}
+			}
+			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllEvidenceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllEvidenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllEvidenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryAllEvidenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Evidence) > 0 {
-		for _, e := range m.Evidence {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryEvidenceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllEvidenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -658,17 +1484,17 @@ func (m *QueryEvidenceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEvidenceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllEvidenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEvidenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -678,31 +1504,31 @@ func (m *QueryEvidenceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvidenceHash = append(m.EvidenceHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.EvidenceHash == nil {
-				m.EvidenceHash = []byte{}
+			m.Evidence = append(m.Evidence, &types.Any{})
+			if err := m.Evidence[len(m.Evidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -712,23 +1538,27 @@ func (m *QueryEvidenceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -751,7 +1581,7 @@ func (m *QueryEvidenceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEvidenceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryExternalInfractionPoliciesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -774,15 +1604,15 @@ func (m *QueryEvidenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEvidenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExternalInfractionPoliciesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExternalInfractionPoliciesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -809,10 +1639,10 @@ func (m *QueryEvidenceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Evidence == nil {
-				m.Evidence = &types.Any{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -837,7 +1667,7 @@ func (m *QueryEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllEvidenceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryExternalInfractionPoliciesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -860,10 +1690,130 @@ func (m *QueryAllEvidenceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllEvidenceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExternalInfractionPoliciesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllEvidenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExternalInfractionPoliciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policies = append(m.Policies, ExternalInfractionPolicy{})
+			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExternalInfractionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExternalInfractionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExternalInfractionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -923,7 +1873,7 @@ func (m *QueryAllEvidenceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllEvidenceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryExternalInfractionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -946,15 +1896,15 @@ func (m *QueryAllEvidenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllEvidenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExternalInfractionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExternalInfractionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Infractions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -981,8 +1931,8 @@ func (m *QueryAllEvidenceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Evidence = append(m.Evidence, &types.Any{})
-			if err := m.Evidence[len(m.Evidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Infractions = append(m.Infractions, ExternalInfraction{})
+			if err := m.Infractions[len(m.Infractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
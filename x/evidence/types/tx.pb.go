@@ -121,39 +121,241 @@ func (m *MsgSubmitEvidenceResponse) GetHash() []byte {
 	return nil
 }
 
+// MsgSubmitExternalInfraction is the Msg/SubmitExternalInfraction request type.
+type MsgSubmitExternalInfraction struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// infraction defines the misbehavior observed on the external chain.
+	Infraction ExternalInfraction `protobuf:"bytes,2,opt,name=infraction,proto3" json:"infraction"`
+}
+
+func (m *MsgSubmitExternalInfraction) Reset()         { *m = MsgSubmitExternalInfraction{} }
+func (m *MsgSubmitExternalInfraction) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitExternalInfraction) ProtoMessage()    {}
+func (*MsgSubmitExternalInfraction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3242cb23c956e0, []int{2}
+}
+func (m *MsgSubmitExternalInfraction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitExternalInfraction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitExternalInfraction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitExternalInfraction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitExternalInfraction.Merge(m, src)
+}
+func (m *MsgSubmitExternalInfraction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitExternalInfraction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitExternalInfraction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitExternalInfraction proto.InternalMessageInfo
+
+func (m *MsgSubmitExternalInfraction) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSubmitExternalInfraction) GetInfraction() ExternalInfraction {
+	if m != nil {
+		return m.Infraction
+	}
+	return ExternalInfraction{}
+}
+
+// MsgSubmitExternalInfractionResponse defines the Msg/SubmitExternalInfraction response type.
+type MsgSubmitExternalInfractionResponse struct {
+}
+
+func (m *MsgSubmitExternalInfractionResponse) Reset()         { *m = MsgSubmitExternalInfractionResponse{} }
+func (m *MsgSubmitExternalInfractionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitExternalInfractionResponse) ProtoMessage()    {}
+func (*MsgSubmitExternalInfractionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3242cb23c956e0, []int{3}
+}
+func (m *MsgSubmitExternalInfractionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitExternalInfractionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitExternalInfractionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitExternalInfractionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitExternalInfractionResponse.Merge(m, src)
+}
+func (m *MsgSubmitExternalInfractionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitExternalInfractionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitExternalInfractionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitExternalInfractionResponse proto.InternalMessageInfo
+
+// MsgSetExternalInfractionPolicy is the Msg/SetExternalInfractionPolicy request type.
+type MsgSetExternalInfractionPolicy struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// policy defines the external infraction policy to set.
+	Policy ExternalInfractionPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *MsgSetExternalInfractionPolicy) Reset()         { *m = MsgSetExternalInfractionPolicy{} }
+func (m *MsgSetExternalInfractionPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgSetExternalInfractionPolicy) ProtoMessage()    {}
+func (*MsgSetExternalInfractionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3242cb23c956e0, []int{4}
+}
+func (m *MsgSetExternalInfractionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetExternalInfractionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetExternalInfractionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetExternalInfractionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetExternalInfractionPolicy.Merge(m, src)
+}
+func (m *MsgSetExternalInfractionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetExternalInfractionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetExternalInfractionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetExternalInfractionPolicy proto.InternalMessageInfo
+
+func (m *MsgSetExternalInfractionPolicy) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetExternalInfractionPolicy) GetPolicy() ExternalInfractionPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return ExternalInfractionPolicy{}
+}
+
+// MsgSetExternalInfractionPolicyResponse defines the Msg/SetExternalInfractionPolicy response type.
+type MsgSetExternalInfractionPolicyResponse struct {
+}
+
+func (m *MsgSetExternalInfractionPolicyResponse) Reset() {
+	*m = MsgSetExternalInfractionPolicyResponse{}
+}
+func (m *MsgSetExternalInfractionPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetExternalInfractionPolicyResponse) ProtoMessage()    {}
+func (*MsgSetExternalInfractionPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e3242cb23c956e0, []int{5}
+}
+func (m *MsgSetExternalInfractionPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetExternalInfractionPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetExternalInfractionPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetExternalInfractionPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetExternalInfractionPolicyResponse.Merge(m, src)
+}
+func (m *MsgSetExternalInfractionPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetExternalInfractionPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetExternalInfractionPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetExternalInfractionPolicyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSubmitEvidence)(nil), "cosmos.evidence.v1beta1.MsgSubmitEvidence")
 	proto.RegisterType((*MsgSubmitEvidenceResponse)(nil), "cosmos.evidence.v1beta1.MsgSubmitEvidenceResponse")
+	proto.RegisterType((*MsgSubmitExternalInfraction)(nil), "cosmos.evidence.v1beta1.MsgSubmitExternalInfraction")
+	proto.RegisterType((*MsgSubmitExternalInfractionResponse)(nil), "cosmos.evidence.v1beta1.MsgSubmitExternalInfractionResponse")
+	proto.RegisterType((*MsgSetExternalInfractionPolicy)(nil), "cosmos.evidence.v1beta1.MsgSetExternalInfractionPolicy")
+	proto.RegisterType((*MsgSetExternalInfractionPolicyResponse)(nil), "cosmos.evidence.v1beta1.MsgSetExternalInfractionPolicyResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/evidence/v1beta1/tx.proto", fileDescriptor_3e3242cb23c956e0) }
 
 var fileDescriptor_3e3242cb23c956e0 = []byte{
-	// 383 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0x4c, 0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd1, 0x07,
-	0xb1, 0x20, 0xca, 0xa5, 0x24, 0xd3, 0xf3, 0xf3, 0xd3, 0x73, 0x52, 0xf5, 0xc1, 0xbc, 0xa4, 0xd2,
-	0x34, 0xfd, 0xc4, 0xbc, 0x4a, 0x98, 0x14, 0xc4, 0xa4, 0x78, 0x88, 0x1e, 0xa8, 0xb1, 0x10, 0x29,
-	0xa8, 0x25, 0xfa, 0xb9, 0xc5, 0xe9, 0xfa, 0x65, 0x86, 0x20, 0x0a, 0x2a, 0x21, 0x98, 0x98, 0x9b,
-	0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0x42, 0x4a, 0x77, 0x18, 0xb9, 0x04, 0x7d, 0x8b, 0xd3, 0x83,
-	0x4b, 0x93, 0x72, 0x33, 0x4b, 0x5c, 0xa1, 0xae, 0x12, 0x32, 0xe3, 0xe2, 0x2c, 0x06, 0x8b, 0x94,
-	0xa4, 0x16, 0x49, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x3a, 0x49, 0x5c, 0xda, 0xa2, 0x2b, 0x02, 0xb5,
-	0xc6, 0x31, 0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x38, 0xb8, 0xa4, 0x28, 0x33, 0x2f, 0x3d, 0x08, 0xa1,
-	0x54, 0x28, 0x8c, 0x8b, 0x03, 0xe6, 0x33, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x11, 0x3d,
-	0x88, 0x17, 0xf4, 0x60, 0x5e, 0xd0, 0x73, 0xcc, 0xab, 0x74, 0x52, 0x39, 0xb5, 0x45, 0x57, 0x01,
-	0x47, 0x50, 0xe8, 0xc1, 0x5c, 0x11, 0x04, 0x37, 0xcb, 0xca, 0xbc, 0x63, 0x81, 0x3c, 0xc3, 0x8b,
-	0x05, 0xf2, 0x0c, 0x4d, 0xcf, 0x37, 0x68, 0x21, 0xec, 0xeb, 0x7a, 0xbe, 0x41, 0x4b, 0x06, 0x62,
-	0x8c, 0x6e, 0x71, 0x4a, 0xb6, 0x3e, 0x86, 0x47, 0x94, 0xf4, 0xb9, 0x24, 0x31, 0x04, 0x83, 0x52,
-	0x8b, 0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0x85, 0x84, 0xb8, 0x58, 0x32, 0x12, 0x8b, 0x33, 0x24, 0x58,
-	0x14, 0x18, 0x35, 0x78, 0x82, 0xc0, 0x6c, 0xa3, 0x3a, 0x2e, 0x66, 0xdf, 0xe2, 0x74, 0xa1, 0x02,
-	0x2e, 0x3e, 0xb4, 0x20, 0xd1, 0xd2, 0xc3, 0xe5, 0x5e, 0x0c, 0x0b, 0xa4, 0x8c, 0x88, 0x57, 0x0b,
-	0x73, 0x8c, 0x14, 0x6b, 0xc3, 0xf3, 0x0d, 0x5a, 0x8c, 0x4e, 0xd6, 0x2b, 0x1e, 0xc9, 0x31, 0x9e,
-	0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31,
-	0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x2c, 0xc4, 0xdc, 0xe2, 0x94, 0x6c,
-	0xbd, 0xcc, 0x7c, 0xfd, 0x0a, 0x44, 0x42, 0x2b, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x07,
-	0xb2, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xde, 0xaf, 0x50, 0x22, 0x88, 0x02, 0x00, 0x00,
+	// 574 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x77, 0x6c, 0x2d, 0x76, 0x14, 0xa1, 0x4b, 0xc0, 0x74, 0xab, 0x9b, 0xb0, 0xd6, 0x10,
+	0x22, 0xd9, 0x21, 0x51, 0x0c, 0x46, 0x41, 0x1a, 0xf0, 0xe0, 0xa1, 0x20, 0xa9, 0xf4, 0xe0, 0x45,
+	0x36, 0xd9, 0xe9, 0x66, 0x69, 0xb2, 0xb3, 0xec, 0x4c, 0x42, 0xf6, 0x26, 0x9e, 0xc4, 0x83, 0x78,
+	0xf3, 0xda, 0x63, 0xbd, 0xe5, 0xd0, 0x0f, 0x51, 0x3c, 0x15, 0x4f, 0x1e, 0x44, 0x24, 0x39, 0xa4,
+	0x1f, 0x43, 0x76, 0x76, 0x76, 0x53, 0xb2, 0xdd, 0x58, 0x73, 0x49, 0x26, 0xf3, 0xfe, 0xef, 0xff,
+	0xde, 0xfb, 0xf1, 0x32, 0x30, 0xdf, 0x26, 0xb4, 0x47, 0x28, 0xc2, 0x03, 0xdb, 0xc4, 0x4e, 0x1b,
+	0xa3, 0x41, 0xa5, 0x85, 0x99, 0x51, 0x41, 0x6c, 0xa8, 0xbb, 0x1e, 0x61, 0x44, 0xbe, 0x13, 0x2a,
+	0xf4, 0x48, 0xa1, 0x0b, 0x85, 0x92, 0xb1, 0x88, 0x45, 0xb8, 0x06, 0x05, 0xa7, 0x50, 0xae, 0x6c,
+	0x5a, 0x84, 0x58, 0x5d, 0x8c, 0xf8, 0xaf, 0x56, 0xff, 0x00, 0x19, 0x8e, 0x1f, 0x85, 0x42, 0xa7,
+	0x77, 0x61, 0x8e, 0xb0, 0x0d, 0x43, 0xa2, 0x08, 0xea, 0x51, 0x0b, 0x0d, 0x2a, 0xc1, 0x97, 0x08,
+	0x6c, 0x18, 0x3d, 0xdb, 0x21, 0x88, 0x7f, 0x8a, 0xab, 0x42, 0x5a, 0xcb, 0x71, 0x87, 0x5c, 0xa7,
+	0xfd, 0x02, 0x70, 0x63, 0x97, 0x5a, 0x7b, 0xfd, 0x56, 0xcf, 0x66, 0x2f, 0x45, 0x4c, 0x7e, 0x02,
+	0xd7, 0x29, 0xbf, 0x61, 0xd8, 0xcb, 0x82, 0x3c, 0x28, 0xae, 0x37, 0xb2, 0x3f, 0x4e, 0xca, 0x19,
+	0xd1, 0xce, 0x8e, 0x69, 0x7a, 0x98, 0xd2, 0x3d, 0xe6, 0xd9, 0x8e, 0xd5, 0x9c, 0x49, 0xe5, 0x7d,
+	0x78, 0x23, 0xf2, 0xcf, 0x5e, 0xcb, 0x83, 0xe2, 0xcd, 0x6a, 0x46, 0x0f, 0x47, 0xd5, 0xa3, 0x51,
+	0xf5, 0x1d, 0xc7, 0x6f, 0x6c, 0x7f, 0x3f, 0x29, 0xe7, 0x53, 0x90, 0xe9, 0x51, 0x17, 0xcd, 0xd8,
+	0xab, 0x5e, 0xfb, 0x78, 0x94, 0x93, 0xce, 0x8f, 0x72, 0xd2, 0x87, 0xe9, 0xa8, 0x34, 0xab, 0xf7,
+	0x69, 0x3a, 0x2a, 0xdd, 0x0d, 0x6d, 0xca, 0xd4, 0x3c, 0x44, 0x89, 0x41, 0x34, 0x04, 0x37, 0x13,
+	0x97, 0x4d, 0x4c, 0x5d, 0xe2, 0x50, 0x2c, 0xcb, 0x70, 0xb5, 0x63, 0xd0, 0x4e, 0x76, 0x35, 0x0f,
+	0x8a, 0xb7, 0x9a, 0xfc, 0xac, 0x9d, 0x03, 0xb8, 0x35, 0xcb, 0x18, 0x32, 0xec, 0x39, 0x46, 0xf7,
+	0x95, 0x73, 0xe0, 0x19, 0x6d, 0x66, 0x13, 0x27, 0x20, 0x63, 0xf4, 0x59, 0x87, 0x78, 0x36, 0xf3,
+	0xff, 0x4d, 0x26, 0x96, 0xca, 0xfb, 0x10, 0xda, 0xb1, 0x8b, 0x60, 0xf3, 0x50, 0x4f, 0x45, 0x90,
+	0x28, 0xdc, 0x58, 0x3f, 0xfd, 0x9d, 0x93, 0x8e, 0xa7, 0xa3, 0x12, 0x68, 0x5e, 0x70, 0xaa, 0x3f,
+	0xe5, 0x44, 0xe2, 0x3a, 0x01, 0x91, 0xc2, 0xa5, 0x44, 0x12, 0x8e, 0xda, 0x03, 0x78, 0x7f, 0x41,
+	0x38, 0xa2, 0xa4, 0x4d, 0x01, 0x54, 0x03, 0x1d, 0xbe, 0x44, 0xf4, 0x9a, 0x74, 0xed, 0xb6, 0xbf,
+	0x34, 0x94, 0x37, 0x70, 0xcd, 0xe5, 0x0e, 0x02, 0x48, 0xe5, 0x3f, 0x80, 0x84, 0xa5, 0x2f, 0x62,
+	0x11, 0x5e, 0xf5, 0x5a, 0x12, 0xc9, 0xf6, 0x1c, 0x12, 0x3e, 0xcb, 0xbc, 0x97, 0x56, 0x84, 0x85,
+	0xc5, 0x83, 0x46, 0x4c, 0xaa, 0xdf, 0x56, 0xe0, 0xca, 0x2e, 0xb5, 0x64, 0x17, 0xde, 0x9e, 0xfb,
+	0xe7, 0x94, 0x52, 0x47, 0x48, 0xec, 0xa1, 0x52, 0xbd, 0xba, 0x36, 0xde, 0xd9, 0xcf, 0x00, 0x66,
+	0x53, 0x97, 0xf3, 0xf1, 0x15, 0x0c, 0x13, 0x59, 0xca, 0xf3, 0x65, 0xb2, 0xe2, 0x86, 0xbe, 0x02,
+	0xb8, 0xb5, 0x68, 0x37, 0x6a, 0x0b, 0xdd, 0xd3, 0x13, 0x95, 0x17, 0x4b, 0x26, 0x46, 0x9d, 0x29,
+	0xd7, 0xdf, 0x07, 0x6b, 0xd1, 0x78, 0x76, 0x3c, 0x56, 0xc1, 0xe9, 0x58, 0x05, 0x67, 0x63, 0x15,
+	0xfc, 0x19, 0xab, 0xe0, 0xcb, 0x44, 0x95, 0xce, 0x26, 0xaa, 0xf4, 0x73, 0xa2, 0x4a, 0x6f, 0xef,
+	0x85, 0x45, 0xa8, 0x79, 0xa8, 0xdb, 0x04, 0x0d, 0x67, 0xef, 0x25, 0xf3, 0x5d, 0x4c, 0x5b, 0x6b,
+	0xfc, 0xd9, 0x7a, 0xf4, 0x77, 0x00, 0xb4, 0xcf, 0x60, 0xe8, 0x02, 0x06, 0x00, 0x00,
 }
 
 func (this *MsgSubmitEvidenceResponse) Equal(that interface{}) bool {
@@ -180,6 +382,102 @@ func (this *MsgSubmitEvidenceResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgSubmitExternalInfraction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSubmitExternalInfraction)
+	if !ok {
+		that2, ok := that.(MsgSubmitExternalInfraction)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if !this.Infraction.Equal(&that1.Infraction) {
+		return false
+	}
+	return true
+}
+func (this *MsgSubmitExternalInfractionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSubmitExternalInfractionResponse)
+	if !ok {
+		that2, ok := that.(MsgSubmitExternalInfractionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgSetExternalInfractionPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetExternalInfractionPolicy)
+	if !ok {
+		that2, ok := that.(MsgSetExternalInfractionPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if !this.Policy.Equal(&that1.Policy) {
+		return false
+	}
+	return true
+}
+func (this *MsgSetExternalInfractionPolicyResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetExternalInfractionPolicyResponse)
+	if !ok {
+		that2, ok := that.(MsgSetExternalInfractionPolicyResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -196,6 +494,14 @@ type MsgClient interface {
 	// SubmitEvidence submits an arbitrary Evidence of misbehavior such as equivocation or
 	// counterfactual signing.
 	SubmitEvidence(ctx context.Context, in *MsgSubmitEvidence, opts ...grpc.CallOption) (*MsgSubmitEvidenceResponse, error)
+	// SubmitExternalInfraction defines a governance operation for slashing a
+	// validator for a misbehavior observed on an external chain, according to the
+	// external infraction policy of the chain.
+	SubmitExternalInfraction(ctx context.Context, in *MsgSubmitExternalInfraction, opts ...grpc.CallOption) (*MsgSubmitExternalInfractionResponse, error)
+	// SetExternalInfractionPolicy defines a governance operation for setting how
+	// the misbehaviors observed on an external chain are converted to local
+	// slashing.
+	SetExternalInfractionPolicy(ctx context.Context, in *MsgSetExternalInfractionPolicy, opts ...grpc.CallOption) (*MsgSetExternalInfractionPolicyResponse, error)
 }
 
 type msgClient struct {
@@ -215,11 +521,37 @@ func (c *msgClient) SubmitEvidence(ctx context.Context, in *MsgSubmitEvidence, o
 	return out, nil
 }
 
+func (c *msgClient) SubmitExternalInfraction(ctx context.Context, in *MsgSubmitExternalInfraction, opts ...grpc.CallOption) (*MsgSubmitExternalInfractionResponse, error) {
+	out := new(MsgSubmitExternalInfractionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evidence.v1beta1.Msg/SubmitExternalInfraction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetExternalInfractionPolicy(ctx context.Context, in *MsgSetExternalInfractionPolicy, opts ...grpc.CallOption) (*MsgSetExternalInfractionPolicyResponse, error) {
+	out := new(MsgSetExternalInfractionPolicyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evidence.v1beta1.Msg/SetExternalInfractionPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitEvidence submits an arbitrary Evidence of misbehavior such as equivocation or
 	// counterfactual signing.
 	SubmitEvidence(context.Context, *MsgSubmitEvidence) (*MsgSubmitEvidenceResponse, error)
+	// SubmitExternalInfraction defines a governance operation for slashing a
+	// validator for a misbehavior observed on an external chain, according to the
+	// external infraction policy of the chain.
+	SubmitExternalInfraction(context.Context, *MsgSubmitExternalInfraction) (*MsgSubmitExternalInfractionResponse, error)
+	// SetExternalInfractionPolicy defines a governance operation for setting how
+	// the misbehaviors observed on an external chain are converted to local
+	// slashing.
+	SetExternalInfractionPolicy(context.Context, *MsgSetExternalInfractionPolicy) (*MsgSetExternalInfractionPolicyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -229,6 +561,12 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) SubmitEvidence(ctx context.Context, req *MsgSubmitEvidence) (*MsgSubmitEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitEvidence not implemented")
 }
+func (*UnimplementedMsgServer) SubmitExternalInfraction(ctx context.Context, req *MsgSubmitExternalInfraction) (*MsgSubmitExternalInfractionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitExternalInfraction not implemented")
+}
+func (*UnimplementedMsgServer) SetExternalInfractionPolicy(ctx context.Context, req *MsgSetExternalInfractionPolicy) (*MsgSetExternalInfractionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExternalInfractionPolicy not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -252,6 +590,42 @@ func _Msg_SubmitEvidence_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitExternalInfraction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitExternalInfraction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitExternalInfraction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evidence.v1beta1.Msg/SubmitExternalInfraction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitExternalInfraction(ctx, req.(*MsgSubmitExternalInfraction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetExternalInfractionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetExternalInfractionPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetExternalInfractionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evidence.v1beta1.Msg/SetExternalInfractionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetExternalInfractionPolicy(ctx, req.(*MsgSetExternalInfractionPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evidence.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -260,6 +634,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitEvidence",
 			Handler:    _Msg_SubmitEvidence_Handler,
 		},
+		{
+			MethodName: "SubmitExternalInfraction",
+			Handler:    _Msg_SubmitExternalInfraction_Handler,
+		},
+		{
+			MethodName: "SetExternalInfractionPolicy",
+			Handler:    _Msg_SetExternalInfractionPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evidence/v1beta1/tx.proto",
@@ -337,26 +719,152 @@ func (m *MsgSubmitEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSubmitExternalInfraction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSubmitEvidence) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSubmitExternalInfraction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitExternalInfraction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Submitter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Infraction.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitExternalInfractionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitExternalInfractionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitExternalInfractionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetExternalInfractionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetExternalInfractionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetExternalInfractionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetExternalInfractionPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetExternalInfractionPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetExternalInfractionPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSubmitEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	if m.Evidence != nil {
 		l = m.Evidence.Size()
@@ -378,6 +886,54 @@ func (m *MsgSubmitEvidenceResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSubmitExternalInfraction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Infraction.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSubmitExternalInfractionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetExternalInfractionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetExternalInfractionPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -586,6 +1142,336 @@ func (m *MsgSubmitEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSubmitExternalInfraction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitExternalInfraction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitExternalInfraction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infraction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Infraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitExternalInfractionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitExternalInfractionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitExternalInfractionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetExternalInfractionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetExternalInfractionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetExternalInfractionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetExternalInfractionPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetExternalInfractionPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetExternalInfractionPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
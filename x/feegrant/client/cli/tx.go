@@ -24,6 +24,8 @@ const (
 	FlagPeriodLimit = "period-limit"
 	FlagSpendLimit  = "spend-limit"
 	FlagAllowedMsgs = "allowed-messages"
+	FlagMaxGasPrice = "max-gas-price"
+	FlagMaxGasLimit = "max-gas-limit"
 )
 
 // GetTxCmd returns the transaction commands for feegrant module
@@ -171,6 +173,28 @@ Examples:
 				}
 			}
 
+			maxGasPriceVal, err := cmd.Flags().GetString(FlagMaxGasPrice)
+			if err != nil {
+				return err
+			}
+
+			if maxGasPriceVal != "" {
+				maxGasPrice, err := sdk.ParseDecCoins(maxGasPriceVal)
+				if err != nil {
+					return err
+				}
+
+				maxGasLimit, err := cmd.Flags().GetUint64(FlagMaxGasLimit)
+				if err != nil {
+					return err
+				}
+
+				grant, err = feegrant.NewMaxGasPriceAllowance(grant, maxGasPrice, maxGasLimit)
+				if err != nil {
+					return err
+				}
+			}
+
 			msg, err := feegrant.NewMsgGrantAllowance(grant, granterStr, args[1])
 			if err != nil {
 				return err
@@ -182,6 +206,8 @@ Examples:
 
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().StringSlice(FlagAllowedMsgs, []string{}, "Set of allowed messages for fee allowance")
+	cmd.Flags().String(FlagMaxGasPrice, "", "Maximum gas price covered by the fee allowance, the fee is capped to the tx gas limit times this price")
+	cmd.Flags().Uint64(FlagMaxGasLimit, 0, "Maximum tx gas limit covered by the fee allowance, required with --max-gas-price")
 	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the grant expires for the user")
 	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
 	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration(in seconds) in which period_limit coins can be spent before that allowance is reset (ex: 3600)")
@@ -20,4 +20,8 @@ var (
 	ErrNoMessages = errors.Register(DefaultCodespace, 6, "allowed messages are empty")
 	// ErrMessageNotAllowed error if message is not allowed
 	ErrMessageNotAllowed = errors.Register(DefaultCodespace, 7, "message not allowed")
+	// ErrInvalidGasPrice error if the max gas price of the allowance is invalid
+	ErrInvalidGasPrice = errors.Register(DefaultCodespace, 8, "invalid max gas price")
+	// ErrInvalidGasLimit error if the max gas limit of the allowance is invalid
+	ErrInvalidGasLimit = errors.Register(DefaultCodespace, 9, "invalid max gas limit")
 )
@@ -219,6 +219,58 @@ func (m *AllowedMsgAllowance) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AllowedMsgAllowance proto.InternalMessageInfo
 
+// MaxGasPriceAllowance creates allowance that only covers the gas of the transaction
+// at no more than a maximum gas price, for transactions whose gas limit does not
+// exceed a maximum gas limit. The fee of every transaction is thus bounded by the
+// maximum gas limit times the maximum gas price.
+type MaxGasPriceAllowance struct {
+	// allowance can be any of basic, periodic and allowed msg fee allowance.
+	Allowance *types1.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// max_gas_price is the maximum price per unit of gas the grantee can pay with
+	// this allowance. Fees in a denom not listed here are rejected.
+	MaxGasPrice github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=max_gas_price,json=maxGasPrice,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"max_gas_price"`
+	// last_gas_limit is the gas limit of the last transaction that used the allowance.
+	LastGasLimit uint64 `protobuf:"varint,3,opt,name=last_gas_limit,json=lastGasLimit,proto3" json:"last_gas_limit,omitempty"`
+	// last_fee is the fee of the last transaction that used the allowance.
+	LastFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=last_fee,json=lastFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"last_fee"`
+	// max_gas_limit is the maximum gas limit of the transactions the grantee can pay
+	// for with this allowance.
+	MaxGasLimit uint64 `protobuf:"varint,5,opt,name=max_gas_limit,json=maxGasLimit,proto3" json:"max_gas_limit,omitempty"`
+}
+
+func (m *MaxGasPriceAllowance) Reset()         { *m = MaxGasPriceAllowance{} }
+func (m *MaxGasPriceAllowance) String() string { return proto.CompactTextString(m) }
+func (*MaxGasPriceAllowance) ProtoMessage()    {}
+func (*MaxGasPriceAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{3}
+}
+func (m *MaxGasPriceAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaxGasPriceAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaxGasPriceAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaxGasPriceAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaxGasPriceAllowance.Merge(m, src)
+}
+func (m *MaxGasPriceAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaxGasPriceAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaxGasPriceAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaxGasPriceAllowance proto.InternalMessageInfo
+
 // Grant is stored in the KVStore to record a grant with full context
 type Grant struct {
 	// granter is the address of the user granting an allowance of their funds.
@@ -233,7 +285,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7279582900c30aea, []int{3}
+	return fileDescriptor_7279582900c30aea, []int{4}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -287,6 +339,7 @@ func init() {
 	proto.RegisterType((*BasicAllowance)(nil), "cosmos.feegrant.v1beta1.BasicAllowance")
 	proto.RegisterType((*PeriodicAllowance)(nil), "cosmos.feegrant.v1beta1.PeriodicAllowance")
 	proto.RegisterType((*AllowedMsgAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedMsgAllowance")
+	proto.RegisterType((*MaxGasPriceAllowance)(nil), "cosmos.feegrant.v1beta1.MaxGasPriceAllowance")
 	proto.RegisterType((*Grant)(nil), "cosmos.feegrant.v1beta1.Grant")
 }
 
@@ -295,49 +348,56 @@ func init() {
 }
 
 var fileDescriptor_7279582900c30aea = []byte{
-	// 658 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x4f, 0xd4, 0x4e,
-	0x14, 0xdf, 0x61, 0x81, 0x6f, 0x76, 0x96, 0x2f, 0x42, 0x25, 0xb1, 0x4b, 0x4c, 0x4b, 0x36, 0x51,
-	0x17, 0x12, 0xda, 0x80, 0x37, 0x4e, 0x50, 0x0c, 0xa8, 0x81, 0x84, 0x14, 0x4f, 0x26, 0xa6, 0x99,
-	0xb6, 0x43, 0x9d, 0xb0, 0xed, 0x34, 0x9d, 0xa2, 0xec, 0xd5, 0x93, 0xd1, 0x83, 0x1c, 0x8d, 0x27,
-	0x8e, 0xc6, 0x13, 0x07, 0xfe, 0x08, 0xe2, 0xc1, 0x10, 0x4f, 0x7a, 0x11, 0x03, 0x07, 0xce, 0xfe,
-	0x07, 0xa6, 0x33, 0xd3, 0x6e, 0x61, 0x25, 0x42, 0x62, 0xb8, 0xec, 0x76, 0xde, 0xbc, 0xf7, 0xf9,
-	0xf1, 0xde, 0x6b, 0x0a, 0xef, 0x7a, 0x94, 0x85, 0x94, 0x99, 0x1b, 0x18, 0x07, 0x09, 0x8a, 0x52,
-	0xf3, 0xc5, 0x8c, 0x8b, 0x53, 0x34, 0x53, 0x04, 0x8c, 0x38, 0xa1, 0x29, 0x55, 0x6e, 0x89, 0x3c,
-	0xa3, 0x08, 0xcb, 0xbc, 0xf1, 0xb1, 0x80, 0x06, 0x94, 0xe7, 0x98, 0xd9, 0x93, 0x48, 0x1f, 0x6f,
-	0x04, 0x94, 0x06, 0x6d, 0x6c, 0xf2, 0x93, 0xbb, 0xb5, 0x61, 0xa2, 0xa8, 0x93, 0x5f, 0x09, 0x24,
-	0x47, 0xd4, 0x48, 0x58, 0x71, 0xa5, 0x49, 0x31, 0x2e, 0x62, 0xb8, 0x10, 0xe2, 0x51, 0x12, 0xc9,
-	0xfb, 0x51, 0x14, 0x92, 0x88, 0x9a, 0xfc, 0x57, 0x86, 0xf4, 0xf3, 0x44, 0x29, 0x09, 0x31, 0x4b,
-	0x51, 0x18, 0xe7, 0x98, 0xe7, 0x13, 0xfc, 0xad, 0x04, 0xa5, 0x84, 0x4a, 0xcc, 0xe6, 0x6e, 0x1f,
-	0x1c, 0xb6, 0x10, 0x23, 0xde, 0x42, 0xbb, 0x4d, 0x5f, 0xa2, 0xc8, 0xc3, 0xca, 0x2b, 0x00, 0xeb,
-	0x2c, 0xc6, 0x91, 0xef, 0xb4, 0x49, 0x48, 0x52, 0x15, 0x4c, 0x54, 0x5b, 0xf5, 0xd9, 0x86, 0x21,
-	0xb5, 0x66, 0xea, 0x72, 0xfb, 0xc6, 0x22, 0x25, 0x91, 0xb5, 0x74, 0xf0, 0x43, 0xaf, 0x7c, 0x3a,
-	0xd2, 0x5b, 0x01, 0x49, 0x9f, 0x6f, 0xb9, 0x86, 0x47, 0x43, 0x69, 0x4c, 0xfe, 0x4d, 0x33, 0x7f,
-	0xd3, 0x4c, 0x3b, 0x31, 0x66, 0xbc, 0x80, 0x7d, 0x38, 0xdd, 0x9b, 0x1a, 0x6a, 0xe3, 0x00, 0x79,
-	0x1d, 0x27, 0xf3, 0xc7, 0x3e, 0x9e, 0xee, 0x4d, 0x01, 0x1b, 0x72, 0xd6, 0x95, 0x8c, 0x54, 0x99,
-	0x87, 0x10, 0x6f, 0xc7, 0x44, 0x68, 0x55, 0xfb, 0x26, 0x40, 0xab, 0x3e, 0x3b, 0x6e, 0x08, 0x33,
-	0x46, 0x6e, 0xc6, 0x78, 0x92, 0xbb, 0xb5, 0xfa, 0x77, 0x8e, 0x74, 0x60, 0x97, 0x6a, 0xe6, 0x96,
-	0x3f, 0xef, 0x4f, 0xdf, 0xb9, 0x60, 0x6c, 0xc6, 0x12, 0xc6, 0x85, 0xe1, 0x47, 0x6f, 0x4e, 0xf7,
-	0xa6, 0x1a, 0x25, 0xa5, 0x67, 0xfb, 0xd1, 0xfc, 0xde, 0x0f, 0x47, 0xd7, 0x70, 0x42, 0xa8, 0x5f,
-	0xee, 0xd2, 0x43, 0x38, 0xe0, 0x66, 0x79, 0x2a, 0xe0, 0xda, 0xee, 0x19, 0x17, 0x51, 0x9d, 0x45,
-	0xb3, 0x6a, 0x59, 0xb3, 0x84, 0x5f, 0x01, 0xa0, 0xcc, 0xc3, 0xc1, 0x98, 0xc3, 0x4b, 0x9b, 0x8d,
-	0x1e, 0x9b, 0x0f, 0xe4, 0xcc, 0xac, 0xff, 0xb3, 0xe2, 0xf7, 0x47, 0x3a, 0x10, 0x00, 0xb2, 0x4e,
-	0x79, 0x07, 0xa0, 0x22, 0x1e, 0x9d, 0xf2, 0xe0, 0xaa, 0xd7, 0x35, 0xb8, 0x11, 0x41, 0xbe, 0xde,
-	0x1d, 0xdf, 0x5b, 0x00, 0x65, 0xd0, 0xf1, 0x50, 0x24, 0x54, 0xa9, 0xfd, 0xd7, 0xa5, 0x67, 0x58,
-	0x50, 0x2f, 0xa2, 0x88, 0x4b, 0x52, 0x56, 0xe0, 0x90, 0x14, 0x93, 0x60, 0x86, 0x53, 0x75, 0xe0,
-	0xaf, 0xeb, 0xc4, 0x1b, 0xbd, 0x53, 0x34, 0xba, 0x2e, 0xca, 0xed, 0xac, 0x7a, 0xee, 0xf1, 0x95,
-	0x16, 0xeb, 0x76, 0x49, 0x79, 0xcf, 0x16, 0x35, 0x7f, 0x01, 0x78, 0x93, 0x9f, 0xb0, 0xbf, 0xca,
-	0x82, 0xee, 0x76, 0x3d, 0x83, 0x35, 0x94, 0x1f, 0xe4, 0x86, 0x8d, 0xf5, 0xc8, 0x5d, 0x88, 0x3a,
-	0xd6, 0xe4, 0xa5, 0xc5, 0xd8, 0x5d, 0x44, 0x65, 0x12, 0x8e, 0x20, 0xc1, 0xea, 0x84, 0x98, 0x31,
-	0x14, 0x60, 0xa6, 0xf6, 0x4d, 0x54, 0x5b, 0x35, 0xfb, 0x86, 0x8c, 0xaf, 0xca, 0xf0, 0xdc, 0xda,
-	0xeb, 0x5d, 0xbd, 0x72, 0x25, 0xc7, 0x5a, 0xc9, 0xf1, 0x1f, 0xbc, 0x35, 0xbf, 0x00, 0x38, 0xb0,
-	0x9c, 0x41, 0x28, 0xb3, 0xf0, 0x3f, 0x8e, 0x85, 0x13, 0xee, 0xb1, 0x66, 0xa9, 0x5f, 0xf7, 0xa7,
-	0xc7, 0x24, 0xd1, 0x82, 0xef, 0x27, 0x98, 0xb1, 0xf5, 0x34, 0x21, 0x51, 0x60, 0xe7, 0x89, 0xdd,
-	0x1a, 0xcc, 0x5f, 0x97, 0x4b, 0xd4, 0x9c, 0xeb, 0x66, 0xf5, 0x5f, 0x77, 0xd3, 0x9a, 0x39, 0x38,
-	0xd6, 0xc0, 0xe1, 0xb1, 0x06, 0x7e, 0x1e, 0x6b, 0x60, 0xe7, 0x44, 0xab, 0x1c, 0x9e, 0x68, 0x95,
-	0x6f, 0x27, 0x5a, 0xe5, 0xa9, 0xfc, 0x6c, 0x30, 0x7f, 0xd3, 0x20, 0xd4, 0xdc, 0x2e, 0xbe, 0x2a,
-	0xee, 0x20, 0xa7, 0xbd, 0xff, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x76, 0xbd, 0x15, 0x80, 0x06,
-	0x00, 0x00,
+	// 777 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xee, 0xf6, 0x07, 0xd8, 0x29, 0x20, 0xac, 0x4d, 0xdc, 0x12, 0xb2, 0x25, 0x8d, 0x3f, 0x0a,
+	0x86, 0xdd, 0x50, 0x2f, 0xa6, 0x27, 0xba, 0x10, 0xaa, 0x06, 0x12, 0x5c, 0x3c, 0x99, 0x98, 0x66,
+	0xba, 0x3b, 0xac, 0x13, 0xba, 0x3b, 0xcd, 0xce, 0x22, 0xad, 0x47, 0x2f, 0x1a, 0x3d, 0xc8, 0xd1,
+	0x78, 0xe2, 0x68, 0x3c, 0x71, 0xe0, 0x8f, 0x20, 0x1e, 0x0c, 0xf1, 0xa4, 0x17, 0x31, 0x70, 0xe0,
+	0xec, 0x7f, 0x60, 0x76, 0x66, 0xb6, 0x2d, 0xbf, 0x02, 0x44, 0xc3, 0x05, 0x76, 0xdf, 0xbc, 0xf7,
+	0xbe, 0xef, 0x7b, 0xef, 0x9b, 0x66, 0xc1, 0x1d, 0x8b, 0x50, 0x97, 0x50, 0x7d, 0x05, 0x21, 0xc7,
+	0x87, 0x5e, 0xa0, 0xbf, 0x9c, 0xae, 0xa3, 0x00, 0x4e, 0x77, 0x02, 0x5a, 0xd3, 0x27, 0x01, 0x91,
+	0x6f, 0xf2, 0x3c, 0xad, 0x13, 0x16, 0x79, 0xa3, 0x59, 0x87, 0x38, 0x84, 0xe5, 0xe8, 0xe1, 0x13,
+	0x4f, 0x1f, 0xcd, 0x39, 0x84, 0x38, 0x0d, 0xa4, 0xb3, 0xb7, 0xfa, 0xda, 0x8a, 0x0e, 0xbd, 0x76,
+	0x74, 0xc4, 0x3b, 0xd5, 0x78, 0x8d, 0x68, 0xcb, 0x8f, 0x54, 0x41, 0xa6, 0x0e, 0x29, 0xea, 0x10,
+	0xb1, 0x08, 0xf6, 0xc4, 0xf9, 0x08, 0x74, 0xb1, 0x47, 0x74, 0xf6, 0x57, 0x84, 0xf2, 0xc7, 0x81,
+	0x02, 0xec, 0x22, 0x1a, 0x40, 0xb7, 0x19, 0xf5, 0x3c, 0x9e, 0x60, 0xaf, 0xf9, 0x30, 0xc0, 0x44,
+	0xf4, 0x2c, 0x6c, 0xc6, 0xc1, 0x90, 0x01, 0x29, 0xb6, 0x2a, 0x8d, 0x06, 0x59, 0x87, 0x9e, 0x85,
+	0xe4, 0xd7, 0x12, 0xc8, 0xd0, 0x26, 0xf2, 0xec, 0x5a, 0x03, 0xbb, 0x38, 0x50, 0xa4, 0xf1, 0x44,
+	0x31, 0x53, 0xca, 0x69, 0x82, 0x6b, 0xc8, 0x2e, 0x92, 0xaf, 0xcd, 0x12, 0xec, 0x19, 0xf3, 0x3b,
+	0xbf, 0xf2, 0xb1, 0x2f, 0x7b, 0xf9, 0xa2, 0x83, 0x83, 0x17, 0x6b, 0x75, 0xcd, 0x22, 0xae, 0x10,
+	0x26, 0xfe, 0x4d, 0x51, 0x7b, 0x55, 0x0f, 0xda, 0x4d, 0x44, 0x59, 0x01, 0xfd, 0x74, 0xb8, 0x35,
+	0x39, 0xd0, 0x40, 0x0e, 0xb4, 0xda, 0xb5, 0x50, 0x1f, 0xfd, 0x7c, 0xb8, 0x35, 0x29, 0x99, 0x80,
+	0xa1, 0x2e, 0x84, 0xa0, 0xf2, 0x0c, 0x00, 0xa8, 0xd5, 0xc4, 0x9c, 0xab, 0x12, 0x1f, 0x97, 0x8a,
+	0x99, 0xd2, 0xa8, 0xc6, 0xc5, 0x68, 0x91, 0x18, 0xed, 0x69, 0xa4, 0xd6, 0x48, 0x6e, 0xec, 0xe5,
+	0x25, 0xb3, 0xa7, 0xa6, 0x5c, 0xfd, 0xba, 0x3d, 0x75, 0xfb, 0x8c, 0xb5, 0x69, 0xf3, 0x08, 0x75,
+	0x04, 0x3f, 0x7a, 0x77, 0xb8, 0x35, 0x99, 0xeb, 0x61, 0x7a, 0x74, 0x1e, 0x85, 0x9f, 0x49, 0x30,
+	0xb2, 0x84, 0x7c, 0x4c, 0xec, 0xde, 0x29, 0x3d, 0x04, 0xa9, 0x7a, 0x98, 0xa7, 0x48, 0x8c, 0xdb,
+	0x5d, 0xed, 0x2c, 0xa8, 0xa3, 0xdd, 0x8c, 0x74, 0x38, 0x2c, 0xae, 0x97, 0x37, 0x90, 0x67, 0x40,
+	0x5f, 0x93, 0xb5, 0x17, 0x32, 0x73, 0x27, 0x64, 0xce, 0x89, 0x9d, 0x19, 0x83, 0x61, 0xf1, 0xc7,
+	0xbd, 0xbc, 0xc4, 0x1b, 0x88, 0x3a, 0xf9, 0x83, 0x04, 0x64, 0xfe, 0x58, 0xeb, 0x5d, 0x5c, 0xe2,
+	0xaa, 0x16, 0x37, 0xcc, 0xc1, 0x97, 0xbb, 0xeb, 0x7b, 0x2f, 0x01, 0x11, 0xac, 0x59, 0xd0, 0xe3,
+	0xac, 0x94, 0xe4, 0x55, 0xf1, 0x19, 0xe2, 0xd0, 0xb3, 0xd0, 0x63, 0x94, 0xe4, 0x05, 0x30, 0x20,
+	0xc8, 0xf8, 0x88, 0xa2, 0x40, 0x49, 0x9d, 0x6b, 0x27, 0x36, 0xe8, 0x8d, 0xce, 0xa0, 0x33, 0xbc,
+	0xdc, 0x0c, 0xab, 0xcb, 0x8f, 0x2f, 0x65, 0xac, 0xb1, 0x1e, 0xe6, 0x27, 0x5c, 0x54, 0xf8, 0x23,
+	0x81, 0x1b, 0xec, 0x0d, 0xd9, 0x8b, 0xd4, 0xe9, 0xba, 0xeb, 0x39, 0x48, 0xc3, 0xe8, 0x45, 0x38,
+	0x2c, 0x7b, 0x82, 0x6e, 0xc5, 0x6b, 0x1b, 0x13, 0x17, 0x26, 0x63, 0x76, 0x3b, 0xca, 0x13, 0x60,
+	0x18, 0x72, 0xd4, 0x9a, 0x8b, 0x28, 0x85, 0x0e, 0xa2, 0x4a, 0x7c, 0x3c, 0x51, 0x4c, 0x9b, 0xd7,
+	0x45, 0x7c, 0x51, 0x84, 0xcb, 0x4b, 0x6f, 0x37, 0xf3, 0xb1, 0x4b, 0x29, 0x56, 0x7b, 0x14, 0x9f,
+	0xa2, 0xad, 0xf0, 0x26, 0x09, 0xb2, 0x8b, 0xb0, 0x55, 0x85, 0x74, 0xc9, 0xc7, 0x16, 0xba, 0x32,
+	0xd1, 0xaf, 0xc0, 0xa0, 0x0b, 0x5b, 0x35, 0x07, 0x86, 0x3f, 0xbe, 0xd8, 0x42, 0x4c, 0x71, 0xa6,
+	0x34, 0x76, 0xaa, 0x1f, 0xe7, 0x90, 0xc5, 0x2c, 0xf9, 0x40, 0x58, 0xf2, 0xde, 0x05, 0x2c, 0x29,
+	0x6a, 0x84, 0x09, 0x33, 0x6e, 0x57, 0xa3, 0x7c, 0x0b, 0x0c, 0x35, 0x20, 0x0d, 0x18, 0x78, 0x74,
+	0x39, 0xa5, 0x62, 0xd2, 0x1c, 0x08, 0xa3, 0x55, 0x48, 0xf9, 0xad, 0x59, 0x07, 0xd7, 0x58, 0xd6,
+	0x0a, 0x42, 0xe7, 0x5f, 0x96, 0xca, 0x3f, 0x5f, 0x16, 0xb3, 0x3f, 0x44, 0x9b, 0x47, 0x48, 0x2e,
+	0x74, 0x47, 0xc3, 0xd9, 0xa5, 0x18, 0x3b, 0x21, 0x81, 0x91, 0x2b, 0x3f, 0xb9, 0xb4, 0x11, 0xf2,
+	0x3d, 0x3c, 0x4e, 0x5b, 0x78, 0xe1, 0x9b, 0x04, 0x52, 0xd5, 0xb0, 0x87, 0x5c, 0x02, 0xfd, 0xac,
+	0x19, 0xf2, 0xd9, 0xe2, 0xd3, 0x86, 0xf2, 0x7d, 0x7b, 0x2a, 0x2b, 0x90, 0x2a, 0xb6, 0xed, 0x23,
+	0x4a, 0x97, 0x03, 0x1f, 0x7b, 0x8e, 0x19, 0x25, 0x76, 0x6b, 0x90, 0x12, 0xbf, 0x58, 0xcd, 0x31,
+	0x8b, 0x25, 0xfe, 0xb7, 0xc5, 0x8c, 0xe9, 0x9d, 0x7d, 0x55, 0xda, 0xdd, 0x57, 0xa5, 0xdf, 0xfb,
+	0xaa, 0xb4, 0x71, 0xa0, 0xc6, 0x76, 0x0f, 0xd4, 0xd8, 0x8f, 0x03, 0x35, 0xf6, 0x4c, 0x7c, 0x40,
+	0x50, 0x7b, 0x55, 0xc3, 0x44, 0x6f, 0x75, 0xbe, 0x2f, 0xea, 0x7d, 0x0c, 0xf6, 0xfe, 0xdf, 0x01,
+	0x00, 0xc6, 0x85, 0x86, 0x1d, 0x8a, 0x08, 0x00, 0x00,
 }
 
 func (m *BasicAllowance) Marshal() (dAtA []byte, err error) {
@@ -508,6 +568,79 @@ func (m *AllowedMsgAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MaxGasPriceAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaxGasPriceAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaxGasPriceAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGasLimit != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.MaxGasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.LastFee) > 0 {
+		for iNdEx := len(m.LastFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.LastGasLimit != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.LastGasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MaxGasPrice) > 0 {
+		for iNdEx := len(m.MaxGasPrice) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxGasPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -633,6 +766,37 @@ func (m *AllowedMsgAllowance) Size() (n int) {
 	return n
 }
 
+func (m *MaxGasPriceAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.MaxGasPrice) > 0 {
+		for _, e := range m.MaxGasPrice {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if m.LastGasLimit != 0 {
+		n += 1 + sovFeegrant(uint64(m.LastGasLimit))
+	}
+	if len(m.LastFee) > 0 {
+		for _, e := range m.LastFee {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if m.MaxGasLimit != 0 {
+		n += 1 + sovFeegrant(uint64(m.MaxGasLimit))
+	}
+	return n
+}
+
 func (m *Grant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1115,6 +1279,198 @@ func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MaxGasPriceAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaxGasPriceAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaxGasPriceAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types1.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxGasPrice = append(m.MaxGasPrice, types.DecCoin{})
+			if err := m.MaxGasPrice[len(m.MaxGasPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastGasLimit", wireType)
+			}
+			m.LastGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastFee = append(m.LastFee, types.Coin{})
+			if err := m.LastFee[len(m.LastFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasLimit", wireType)
+			}
+			m.MaxGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,150 @@
+package feegrant
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ FeeAllowanceI                 = (*MaxGasPriceAllowance)(nil)
+	_ types.UnpackInterfacesMessage = (*MaxGasPriceAllowance)(nil)
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *MaxGasPriceAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// NewMaxGasPriceAllowance creates new gas price capped fee allowance.
+func NewMaxGasPriceAllowance(allowance FeeAllowanceI, maxGasPrice sdk.DecCoins, maxGasLimit uint64) (*MaxGasPriceAllowance, error) {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", msg)
+	}
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MaxGasPriceAllowance{
+		Allowance:   any,
+		MaxGasPrice: maxGasPrice,
+		MaxGasLimit: maxGasLimit,
+	}, nil
+}
+
+// GetAllowance returns the wrapped fee allowance.
+func (a *MaxGasPriceAllowance) GetAllowance() (FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil, errorsmod.Wrap(ErrNoAllowance, "failed to get allowance")
+	}
+
+	return allowance, nil
+}
+
+// SetAllowance sets the wrapped fee allowance.
+func (a *MaxGasPriceAllowance) SetAllowance(allowance FeeAllowanceI) error {
+	var err error
+	a.Allowance, err = types.NewAnyWithValue(allowance.(proto.Message))
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+
+	return nil
+}
+
+// Accept checks that the gas limit of the transaction does not exceed the maximum
+// gas limit and that the fee does not exceed the gas limit times the maximum gas
+// price. The gas limit and fee are recorded before delegating to the wrapped
+// allowance.
+func (a *MaxGasPriceAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	gasLimit := sdk.UnwrapSDKContext(ctx).GasMeter().Limit()
+	if gasLimit > a.MaxGasLimit {
+		return false, errorsmod.Wrapf(ErrFeeLimitExceeded, "gas limit %d exceeds max gas limit %d", gasLimit, a.MaxGasLimit)
+	}
+
+	maxFee := a.maxFee(gasLimit)
+	if !fee.IsAllLTE(maxFee) {
+		return false, errorsmod.Wrapf(ErrFeeLimitExceeded, "fee %s exceeds gas limit %d times max gas price %s", fee, gasLimit, a.MaxGasPrice)
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err == nil && !remove {
+		if err = a.SetAllowance(allowance); err != nil {
+			return false, err
+		}
+
+		a.LastGasLimit = gasLimit
+		a.LastFee = fee
+	}
+	return remove, err
+}
+
+// maxFee returns the maximum fee that can be paid for the given gas limit.
+func (a *MaxGasPriceAllowance) maxFee(gasLimit uint64) sdk.Coins {
+	limit := math.LegacyNewDecFromInt(math.NewIntFromUint64(gasLimit))
+
+	maxFee := make(sdk.Coins, 0, len(a.MaxGasPrice))
+	for _, gp := range a.MaxGasPrice {
+		maxFee = append(maxFee, sdk.NewCoin(gp.Denom, gp.Amount.Mul(limit).Ceil().RoundInt()))
+	}
+
+	return maxFee
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *MaxGasPriceAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return errorsmod.Wrap(ErrNoAllowance, "allowance should not be empty")
+	}
+	if len(a.MaxGasPrice) == 0 {
+		return errorsmod.Wrap(ErrInvalidGasPrice, "max gas price shouldn't be empty")
+	}
+	if err := a.MaxGasPrice.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidGasPrice, "invalid max gas price: %s", err)
+	}
+	if a.MaxGasLimit == 0 {
+		return errorsmod.Wrap(ErrInvalidGasLimit, "max gas limit must be positive")
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+
+	return allowance.ValidateBasic()
+}
+
+// ExpiresAt returns the expiry time of the MaxGasPriceAllowance.
+func (a *MaxGasPriceAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+	return allowance.ExpiresAt()
+}
+
+// UpdatePeriodReset update "PeriodReset" of the MaxGasPriceAllowance.
+func (a *MaxGasPriceAllowance) UpdatePeriodReset(validTime time.Time) error {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+	return allowance.UpdatePeriodReset(validTime)
+}
@@ -0,0 +1,158 @@
+package feegrant_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/feegrant"
+	"cosmossdk.io/x/feegrant/module"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestMaxGasPriceValidAllow(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModule{})
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	leftAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 512))
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 10))
+	maxGasPrice := sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", math.LegacyNewDecWithPrec(25, 3)))
+	maxGasLimit := uint64(1000000)
+
+	ac := addresscodec.NewBech32Codec("cosmos")
+
+	// msg we will call in the all cases
+	call := banktypes.MsgSend{}
+	cases := map[string]struct {
+		allowance *feegrant.BasicAllowance
+		gasLimit  uint64
+		fee       sdk.Coins
+		accept    bool
+		remove    bool
+		remains   sdk.Coins
+	}{
+		"fee within gas limit times max gas price": {
+			allowance: &feegrant.BasicAllowance{SpendLimit: atom},
+			gasLimit:  2000,
+			fee:       smallAtom,
+			accept:    true,
+			remains:   leftAtom,
+		},
+		"fee rounded up to max gas price": {
+			allowance: &feegrant.BasicAllowance{SpendLimit: atom},
+			gasLimit:  1701,
+			fee:       smallAtom,
+			accept:    true,
+			remains:   leftAtom,
+		},
+		"fee exceeds gas limit times max gas price": {
+			allowance: &feegrant.BasicAllowance{SpendLimit: atom},
+			gasLimit:  1000,
+			fee:       smallAtom,
+			accept:    false,
+		},
+		"gas limit exceeds max gas limit": {
+			allowance: &feegrant.BasicAllowance{SpendLimit: atom},
+			gasLimit:  1000001,
+			fee:       smallAtom,
+			accept:    false,
+		},
+		"fee denom without max gas price": {
+			allowance: &feegrant.BasicAllowance{},
+			gasLimit:  1000000,
+			fee:       eth,
+			accept:    false,
+		},
+		"fee exceeds wrapped allowance": {
+			allowance: &feegrant.BasicAllowance{SpendLimit: smallAtom},
+			gasLimit:  1000000,
+			fee:       atom,
+			accept:    false,
+		},
+		"all fee of wrapped allowance": {
+			allowance: &feegrant.BasicAllowance{SpendLimit: smallAtom},
+			gasLimit:  2000,
+			fee:       smallAtom,
+			accept:    true,
+			remove:    true,
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			ctx := testCtx.Ctx.
+				WithHeaderInfo(header.Info{Time: time.Now()}).
+				WithGasMeter(storetypes.NewGasMeter(tc.gasLimit))
+
+			allowance, err := feegrant.NewMaxGasPriceAllowance(tc.allowance, maxGasPrice, maxGasLimit)
+			require.NoError(t, err)
+			require.NoError(t, allowance.ValidateBasic())
+
+			removed, err := allowance.Accept(ctx, tc.fee, []sdk.Msg{&call})
+			if !tc.accept {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.remove, removed)
+			if !removed {
+				var granter, grantee sdk.AccAddress
+				granterStr, err := ac.BytesToString(granter)
+				require.NoError(t, err)
+				granteeStr, err := ac.BytesToString(grantee)
+				require.NoError(t, err)
+
+				// mimic save & load process
+				newGrant, err := feegrant.NewGrant(granterStr, granteeStr, allowance)
+				require.NoError(t, err)
+
+				bz, err := encCfg.Codec.Marshal(&newGrant)
+				require.NoError(t, err)
+
+				var loadedGrant feegrant.Grant
+				require.NoError(t, encCfg.Codec.Unmarshal(bz, &loadedGrant))
+
+				newAllowance, err := loadedGrant.GetGrant()
+				require.NoError(t, err)
+				loaded := newAllowance.(*feegrant.MaxGasPriceAllowance)
+				require.Equal(t, tc.gasLimit, loaded.LastGasLimit)
+				require.Equal(t, tc.fee, loaded.LastFee)
+
+				feeAllowance, err := loaded.GetAllowance()
+				require.NoError(t, err)
+				require.Equal(t, tc.remains, feeAllowance.(*feegrant.BasicAllowance).SpendLimit)
+			}
+		})
+	}
+}
+
+func TestMaxGasPriceValidateBasic(t *testing.T) {
+	allowance, err := feegrant.NewMaxGasPriceAllowance(&feegrant.BasicAllowance{}, sdk.DecCoins{}, 1000)
+	require.NoError(t, err)
+	require.ErrorIs(t, allowance.ValidateBasic(), feegrant.ErrInvalidGasPrice)
+
+	allowance, err = feegrant.NewMaxGasPriceAllowance(&feegrant.BasicAllowance{}, sdk.DecCoins{{Denom: "atom", Amount: math.LegacyNewDec(-1)}}, 1000)
+	require.NoError(t, err)
+	require.ErrorIs(t, allowance.ValidateBasic(), feegrant.ErrInvalidGasPrice)
+
+	allowance, err = feegrant.NewMaxGasPriceAllowance(&feegrant.BasicAllowance{}, sdk.NewDecCoins(sdk.NewInt64DecCoin("atom", 1)), 0)
+	require.NoError(t, err)
+	require.ErrorIs(t, allowance.ValidateBasic(), feegrant.ErrInvalidGasLimit)
+
+	allowance = &feegrant.MaxGasPriceAllowance{MaxGasPrice: sdk.NewDecCoins(sdk.NewInt64DecCoin("atom", 1)), MaxGasLimit: 1000}
+	require.ErrorIs(t, allowance.ValidateBasic(), feegrant.ErrNoAllowance)
+}
@@ -194,6 +194,27 @@ func (k Keeper) revokeAllowance(ctx context.Context, granter, grantee sdk.AccAdd
 	)
 }
 
+// GrantBasicAllowance grants a BasicAllowance with a spend limit and an optional
+// expiration, so that other modules, such as x/group, can grant allowances
+// without depending on the x/feegrant types.
+func (k Keeper) GrantBasicAllowance(ctx context.Context, granter, grantee sdk.AccAddress, spendLimit sdk.Coins, expiration *time.Time) error {
+	return k.GrantAllowance(ctx, granter, grantee, &feegrant.BasicAllowance{
+		SpendLimit: spendLimit,
+		Expiration: expiration,
+	})
+}
+
+// RevokeAllowance removes an existing grant, so that other modules can revoke
+// the allowances they granted.
+func (k Keeper) RevokeAllowance(ctx context.Context, granter, grantee sdk.AccAddress) error {
+	return k.revokeAllowance(ctx, granter, grantee)
+}
+
+// HasAllowance returns whether the granter granted an allowance to the grantee.
+func (k Keeper) HasAllowance(ctx context.Context, granter, grantee sdk.AccAddress) (bool, error) {
+	return k.FeeAllowance.Has(ctx, collections.Join(grantee, granter))
+}
+
 // GetAllowance returns the allowance between the granter and grantee.
 // If there is none, it returns nil, nil.
 // Returns an error on parsing issues
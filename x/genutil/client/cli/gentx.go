@@ -157,7 +157,7 @@ $ %s gentx my-key-name 1000000stake --home=/path/to/home/dir --keyring-backend=o
 				return errors.Wrap(err, "failed to build create-validator message")
 			}
 
-			if key.GetType() == keyring.TypeOffline || key.GetType() == keyring.TypeMulti {
+			if key.GetType() == keyring.TypeOffline || key.GetType() == keyring.TypeMulti || key.GetType() == keyring.TypeWatchOnly {
 				cmd.PrintErrln("Offline key passed in. Use `tx sign` command to sign.")
 				return txBldr.PrintUnsignedTx(clientCtx, msg)
 			}
@@ -7,6 +7,7 @@ import (
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/gov/keeper"
 	"cosmossdk.io/x/gov/types"
 	v1 "cosmossdk.io/x/gov/types/v1"
@@ -98,7 +99,8 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 
 	// fetch active proposals whose voting periods have ended (are passed the block time)
 	rng = collections.NewPrefixUntilPairRange[time.Time, uint64](ctx.HeaderInfo().Time)
-	err = keeper.ActiveProposalsQueue.Walk(ctx, rng, func(key collections.Pair[time.Time, uint64], _ uint64) (bool, error) {
+	var queue collections.Map[collections.Pair[time.Time, uint64], uint64]
+	processActiveProposal := func(key collections.Pair[time.Time, uint64], _ uint64) (bool, error) {
 		proposal, err := keeper.Proposals.Get(ctx, key.K2())
 		if err != nil {
 			// if the proposal has an encoding error, this means it cannot be processed by x/gov
@@ -110,7 +112,11 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 					return false, err
 				}
 
-				if err = keeper.ActiveProposalsQueue.Remove(ctx, collections.Join(*proposal.VotingEndTime, proposal.Id)); err != nil {
+				if err = queue.Remove(ctx, collections.Join(*proposal.VotingEndTime, proposal.Id)); err != nil {
+					return false, err
+				}
+
+				if err = keeper.RemoveScheduleEntry(ctx, v1.ScheduleEventType_SCHEDULE_EVENT_TYPE_VOTING_END, key.K1(), proposal.Id); err != nil {
 					return false, err
 				}
 
@@ -152,63 +158,43 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 			)
 		}
 
-		if err = keeper.ActiveProposalsQueue.Remove(ctx, collections.Join(*proposal.VotingEndTime, proposal.Id)); err != nil {
+		if err = queue.Remove(ctx, collections.Join(*proposal.VotingEndTime, proposal.Id)); err != nil {
+			return false, err
+		}
+
+		if err = keeper.RemoveScheduleEntry(ctx, v1.ScheduleEventType_SCHEDULE_EVENT_TYPE_VOTING_END, *proposal.VotingEndTime, proposal.Id); err != nil {
 			return false, err
 		}
 
 		switch {
 		case passes:
-			var (
-				idx    int
-				events sdk.Events
-				msg    sdk.Msg
-			)
-
-			// attempt to execute all messages within the passed proposal
-			// Messages may mutate state thus we use a cached context. If one of
-			// the handlers fails, no state mutation is written and the error
-			// message is logged.
-			cacheCtx, writeCache := ctx.CacheContext()
-			messages, err := proposal.GetMsgs()
+			params, err := keeper.Params.Get(ctx)
 			if err != nil {
-				proposal.Status = v1.StatusFailed
-				proposal.FailedReason = err.Error()
-				tagValue = types.AttributeValueProposalFailed
-				logMsg = fmt.Sprintf("passed proposal (%v) failed to execute; msgs: %s", proposal, err)
-
-				break
+				return false, err
 			}
 
-			// execute all messages
-			for idx, msg = range messages {
-				handler := keeper.Router().Handler(msg)
-				var res *sdk.Result
-				res, err = safeExecuteHandler(cacheCtx, msg, handler)
-				if err != nil {
-					break
-				}
+			// multi-message proposals wait for the line-item veto timelock before their execution,
+			// leaving time to the line-item veto authority to strike some of their messages
+			if len(proposal.Messages) > 1 && params.LineItemVeto.IsEnabled() {
+				timelockEndTime := ctx.HeaderInfo().Time.Add(*params.LineItemVeto.Timelock)
+				proposal.Status = v1.StatusTimelock
+				proposal.TimelockEndTime = &timelockEndTime
 
-				events = append(events, res.GetEvents()...)
-			}
+				if err = keeper.TimelockProposalsQueue.Set(ctx, collections.Join(timelockEndTime, proposal.Id), proposal.Id); err != nil {
+					return false, err
+				}
 
-			// `err == nil` when all handlers passed.
-			// Or else, `idx` and `err` are populated with the msg index and error.
-			if err == nil {
-				proposal.Status = v1.StatusPassed
-				tagValue = types.AttributeValueProposalPassed
-				logMsg = "passed"
+				if err = keeper.SetScheduleEntry(ctx, v1.ScheduleEventType_SCHEDULE_EVENT_TYPE_EXECUTION, timelockEndTime, proposal.Id); err != nil {
+					return false, err
+				}
 
-				// write state to the underlying multi-store
-				writeCache()
+				tagValue = types.AttributeValueProposalTimelocked
+				logMsg = fmt.Sprintf("passed, timelocked until %s", timelockEndTime)
 
-				// propagate the msg events to the current context
-				ctx.EventManager().EmitEvents(events)
-			} else {
-				proposal.Status = v1.StatusFailed
-				proposal.FailedReason = err.Error()
-				tagValue = types.AttributeValueProposalFailed
-				logMsg = fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
+				break
 			}
+
+			tagValue, logMsg = executeProposal(ctx, keeper, &proposal, nil)
 		case !burnDeposits && (proposal.ProposalType == v1.ProposalType_PROPOSAL_TYPE_EXPEDITED ||
 			proposal.ProposalType == v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC):
 			// When a non spammy expedited/optimistic proposal fails, it is converted
@@ -229,6 +215,11 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 				return false, err
 			}
 
+			err = keeper.SetScheduleEntry(ctx, v1.ScheduleEventType_SCHEDULE_EVENT_TYPE_VOTING_END, *proposal.VotingEndTime, proposal.Id)
+			if err != nil {
+				return false, err
+			}
+
 			if proposal.ProposalType == v1.ProposalType_PROPOSAL_TYPE_EXPEDITED {
 				tagValue = types.AttributeValueExpeditedProposalRejected
 				logMsg = "expedited proposal converted to regular"
@@ -249,6 +240,13 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 			return false, err
 		}
 
+		// track the finished proposal so that it gets archived once the retention period is over
+		if proposal.Status != v1.StatusVotingPeriod && proposal.Status != v1.StatusTimelock && proposal.Status != v1.StatusExecuting {
+			if err = keeper.FinishedProposals.Set(ctx, collections.Join(ctx.HeaderInfo().Height, proposal.Id)); err != nil {
+				return false, err
+			}
+		}
+
 		// when proposal become active
 		cacheCtx, writeCache := ctx.CacheContext()
 		err = keeper.Hooks().AfterProposalVotingPeriodEnded(cacheCtx, proposal.Id)
@@ -276,9 +274,309 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 			),
 		)
 
+		return false, nil
+	}
+
+	// security council proposals have their own queue and are processed first, as they are meant for emergency actions
+	for _, queue = range []collections.Map[collections.Pair[time.Time, uint64], uint64]{keeper.SecurityCouncilProposalsQueue, keeper.ActiveProposalsQueue} {
+		if err = queue.Walk(ctx, rng, processActiveProposal); err != nil {
+			return err
+		}
+	}
+
+	// execute the proposals whose line-item veto timelock has ended, without their struck messages
+	rng = collections.NewPrefixUntilPairRange[time.Time, uint64](ctx.HeaderInfo().Time)
+	err = keeper.TimelockProposalsQueue.Walk(ctx, rng, func(key collections.Pair[time.Time, uint64], _ uint64) (bool, error) {
+		proposal, err := keeper.Proposals.Get(ctx, key.K2())
+		if err != nil {
+			return false, err
+		}
+
+		vetoed, err := vetoedMessages(ctx, keeper, proposal.Id)
+		if err != nil {
+			return false, err
+		}
+
+		if err = keeper.TimelockProposalsQueue.Remove(ctx, key); err != nil {
+			return false, err
+		}
+
+		if err = keeper.RemoveScheduleEntry(ctx, v1.ScheduleEventType_SCHEDULE_EVENT_TYPE_EXECUTION, key.K1(), proposal.Id); err != nil {
+			return false, err
+		}
+
+		tagValue, logMsg := executeProposal(ctx, keeper, &proposal, vetoed)
+
+		if err = keeper.Proposals.Set(ctx, proposal.Id, proposal); err != nil {
+			return false, err
+		}
+
+		// track the finished proposal so that it gets archived once the retention period is over
+		if proposal.Status != v1.StatusExecuting {
+			if err = keeper.FinishedProposals.Set(ctx, collections.Join(ctx.HeaderInfo().Height, proposal.Id)); err != nil {
+				return false, err
+			}
+		}
+
+		logger.Info(
+			"proposal timelock ended",
+			"proposal", proposal.Id,
+			"proposal_type", proposal.ProposalType,
+			"status", proposal.Status.String(),
+			"title", proposal.Title,
+			"vetoed_messages", len(vetoed),
+			"results", logMsg,
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTimelockProposal,
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+				sdk.NewAttribute(types.AttributeKeyProposalResult, tagValue),
+				sdk.NewAttribute(types.AttributeKeyProposalLog, logMsg),
+			),
+		)
+
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// continue the chunked executions of the passed proposals under the gas budget of the block
+	if err = executeChunkedProposals(ctx, keeper); err != nil {
+		return err
+	}
+
+	// archive the proposals that have been finished for longer than the retention period
+	return keeper.ArchiveProposals(ctx)
+}
+
+// executeProposal executes the messages of a passed proposal, skipping the vetoed ones, and sets
+// the proposal status accordingly. It returns the proposal result and log.
+func executeProposal(ctx sdk.Context, keeper *keeper.Keeper, proposal *v1.Proposal, vetoed map[uint32]bool) (tagValue, logMsg string) {
+	var (
+		idx    int
+		events sdk.Events
+		msg    sdk.Msg
+	)
+
+	// proposals submitted with chunked execution are executed across the next blocks
+	if proposal.ChunkedExecution {
+		execution := v1.ProposalExecution{ProposalId: proposal.Id, StartHeight: ctx.HeaderInfo().Height}
+		if err := keeper.ProposalExecutions.Set(ctx, proposal.Id, execution); err != nil {
+			proposal.Status = v1.StatusFailed
+			proposal.FailedReason = err.Error()
+			return types.AttributeValueProposalFailed, fmt.Sprintf("passed, but failed to start the chunked execution: %s", err)
+		}
+
+		proposal.Status = v1.StatusExecuting
+		return types.AttributeValueProposalExecuting, "passed, executing across several blocks"
+	}
+
+	// attempt to execute all messages within the passed proposal
+	// Messages may mutate state thus we use a cached context. If one of
+	// the handlers fails, no state mutation is written and the error
+	// message is logged.
+	cacheCtx, writeCache := ctx.CacheContext()
+	messages, err := proposal.GetMsgs()
+	if err != nil {
+		proposal.Status = v1.StatusFailed
+		proposal.FailedReason = err.Error()
+		return types.AttributeValueProposalFailed, fmt.Sprintf("passed proposal (%v) failed to execute; msgs: %s", proposal, err)
+	}
+
+	// execute all messages that were not struck through a line-item veto
+	for idx, msg = range messages {
+		if vetoed[uint32(idx)] {
+			continue
+		}
+
+		handler := keeper.Router().Handler(msg)
+		var res *sdk.Result
+		res, err = safeExecuteHandler(cacheCtx, msg, handler)
+		if err != nil {
+			break
+		}
+
+		events = append(events, res.GetEvents()...)
+	}
+
+	// `err == nil` when all handlers passed.
+	// Or else, `idx` and `err` are populated with the msg index and error.
+	if err != nil {
+		proposal.Status = v1.StatusFailed
+		proposal.FailedReason = err.Error()
+		return types.AttributeValueProposalFailed, fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
+	}
+
+	proposal.Status = v1.StatusPassed
+
+	// write state to the underlying multi-store
+	writeCache()
+
+	// propagate the msg events to the current context
+	ctx.EventManager().EmitEvents(events)
+
+	return types.AttributeValueProposalPassed, "passed"
+}
+
+// executeChunkedProposals executes the messages of the proposals passed with chunked execution, in
+// order of proposal id, until the execution gas budget of the block is spent. Each message is executed
+// in its own cached context under the gas left in the block, so that a huge governance action cannot
+// exceed the block gas limit. A message running out of the gas left in the block is retried in the next
+// block with the full budget, and fails the proposal if it exceeds it. The messages executed in previous
+// blocks are kept when a later message fails.
+func executeChunkedProposals(ctx sdk.Context, keeper *keeper.Keeper) error {
+	params, err := keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	budget := params.ExecutionGasPerBlock
+	if budget == 0 {
+		return nil
+	}
+
+	var executions []v1.ProposalExecution
+	err = keeper.ProposalExecutions.Walk(ctx, nil, func(_ uint64, execution v1.ProposalExecution) (bool, error) {
+		executions = append(executions, execution)
 		return false, nil
 	})
-	return err
+	if err != nil {
+		return err
+	}
+
+	var used uint64
+	for _, execution := range executions {
+		if used >= budget {
+			break
+		}
+
+		proposal, err := keeper.Proposals.Get(ctx, execution.ProposalId)
+		if err != nil {
+			return err
+		}
+
+		vetoed, err := vetoedMessages(ctx, keeper, proposal.Id)
+		if err != nil {
+			return err
+		}
+
+		var (
+			events   sdk.Events
+			executed int
+			gasUsed  uint64
+		)
+
+		messages, execErr := proposal.GetMsgs()
+		for execErr == nil && int(execution.NextMessageIndex) < len(messages) {
+			idx := execution.NextMessageIndex
+			if vetoed[idx] {
+				execution.NextMessageIndex++
+				continue
+			}
+
+			msg := messages[idx]
+			remaining := budget - used
+			gasMeter := storetypes.NewGasMeter(remaining)
+			cacheCtx, writeCache := ctx.CacheContext()
+
+			res, err := safeExecuteHandler(cacheCtx.WithGasMeter(gasMeter), msg, keeper.Router().Handler(msg))
+			if err != nil {
+				if gasMeter.IsOutOfGas() && remaining < budget {
+					// retried in the next block with the full budget
+					used = budget
+					break
+				}
+
+				execErr = fmt.Errorf("msg %d (%s) failed on chunked execution: %w", idx, sdk.MsgTypeURL(msg), err)
+				break
+			}
+
+			writeCache()
+			used += gasMeter.GasConsumed()
+			gasUsed += gasMeter.GasConsumed()
+			events = append(events, res.GetEvents()...)
+			execution.NextMessageIndex++
+			executed++
+
+			if used >= budget {
+				break
+			}
+		}
+
+		execution.GasUsed += gasUsed
+		ctx.EventManager().EmitEvents(events)
+
+		var tagValue string
+		switch {
+		case execErr != nil:
+			proposal.Status = v1.StatusFailed
+			proposal.FailedReason = execErr.Error()
+			tagValue = types.AttributeValueProposalFailed
+		case int(execution.NextMessageIndex) >= len(messages):
+			proposal.Status = v1.StatusPassed
+			tagValue = types.AttributeValueProposalPassed
+		default:
+			tagValue = types.AttributeValueProposalExecuting
+		}
+
+		if proposal.Status == v1.StatusExecuting {
+			if err = keeper.ProposalExecutions.Set(ctx, proposal.Id, execution); err != nil {
+				return err
+			}
+		} else {
+			if err = keeper.ProposalExecutions.Remove(ctx, proposal.Id); err != nil {
+				return err
+			}
+
+			if err = keeper.Proposals.Set(ctx, proposal.Id, proposal); err != nil {
+				return err
+			}
+
+			// track the finished proposal so that it gets archived once the retention period is over
+			if err = keeper.FinishedProposals.Set(ctx, collections.Join(ctx.HeaderInfo().Height, proposal.Id)); err != nil {
+				return err
+			}
+		}
+
+		keeper.Logger(ctx).Info(
+			"proposal executed",
+			"proposal", proposal.Id,
+			"status", proposal.Status.String(),
+			"executed_messages", executed,
+			"next_message_index", execution.NextMessageIndex,
+			"gas_used", gasUsed,
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExecuteProposal,
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+				sdk.NewAttribute(types.AttributeKeyProposalResult, tagValue),
+				sdk.NewAttribute(types.AttributeKeyExecutedMessages, fmt.Sprintf("%d", executed)),
+				sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprintf("%d", gasUsed)),
+			),
+		)
+	}
+
+	return nil
+}
+
+// vetoedMessages returns the indexes of the messages of a proposal struck by the line-item veto authority.
+func vetoedMessages(ctx sdk.Context, keeper *keeper.Keeper, proposalID uint64) (map[uint32]bool, error) {
+	vetoes, err := keeper.GetLineItemVetoes(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	vetoed := make(map[uint32]bool, len(vetoes))
+	for _, veto := range vetoes {
+		vetoed[veto.MessageIndex] = true
+	}
+
+	return vetoed, nil
 }
 
 // executes handle(msg) and recovers from panic.
@@ -317,6 +615,10 @@ func failUnsupportedProposal(
 	eventType := types.EventTypeInactiveProposal
 	if active {
 		eventType = types.EventTypeActiveProposal
+
+		if err := keeper.FinishedProposals.Set(ctx, collections.Join(ctx.HeaderInfo().Height, proposal.Id)); err != nil {
+			return err
+		}
 	}
 
 	ctx.EventManager().EmitEvent(
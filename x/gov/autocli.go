@@ -32,7 +32,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "Proposals",
 					Use:       "proposals",
 					Short:     "Query proposals with optional filters",
-					Example:   fmt.Sprintf("%[1]s query gov proposals --depositor cosmos1...\n%[1]s query gov proposals --voter cosmos1...\n%[1]s query gov proposals --proposal-status (PROPOSAL_STATUS_DEPOSIT_PERIOD|PROPOSAL_STATUS_VOTING_PERIOD|PROPOSAL_STATUS_PASSED|PROPOSAL_STATUS_REJECTED|PROPOSAL_STATUS_FAILED)", version.AppName),
+					Example:   fmt.Sprintf("%[1]s query gov proposals --depositor cosmos1...\n%[1]s query gov proposals --voter cosmos1...\n%[1]s query gov proposals --proposal-status (PROPOSAL_STATUS_DEPOSIT_PERIOD|PROPOSAL_STATUS_VOTING_PERIOD|PROPOSAL_STATUS_PASSED|PROPOSAL_STATUS_REJECTED|PROPOSAL_STATUS_FAILED|PROPOSAL_STATUS_TIMELOCK)", version.AppName),
 				},
 				{
 					RpcMethod: "Proposal",
@@ -72,6 +72,33 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "depositor"},
 					},
 				},
+				{
+					RpcMethod: "LineItemVetoes",
+					Use:       "line-item-vetoes",
+					Short:     "Query the proposal messages struck by the line-item veto authority",
+					Example:   fmt.Sprintf("%s query gov line-item-vetoes --proposal-id 1", version.AppName),
+				},
+				{
+					RpcMethod: "Schedule",
+					Use:       "schedule",
+					Short:     "Query the upcoming governance events in chronological order",
+				},
+				{
+					RpcMethod: "ProposalExecution",
+					Use:       "proposal-execution [proposal-id]",
+					Short:     "Query the progress of the chunked execution of a passed proposal",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+				},
+				{
+					RpcMethod: "WhaleAlerts",
+					Use:       "whale-alerts [proposal-id]",
+					Short:     "Query the aggregated whale alerts of a proposal",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+				},
 				{
 					RpcMethod: "Deposits",
 					Use:       "deposits [proposal-id]",
@@ -94,6 +121,47 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "constitution",
 					Short:     "Query the current chain constitution",
 				},
+				{
+					RpcMethod: "ArchivedProposal",
+					Use:       "archived-proposal [proposal-id]",
+					Short:     "Query the summary of a single archived proposal",
+					Example:   fmt.Sprintf("%s query gov archived-proposal 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+				},
+				{
+					RpcMethod: "ArchivedProposals",
+					Use:       "archived-proposals",
+					Short:     "Query the summaries of all archived proposals",
+				},
+				{
+					RpcMethod: "DepositHistory",
+					Use:       "deposit-history [depositor]",
+					Short:     "Query the deposit history of a depositor across proposals",
+					Example:   fmt.Sprintf("%s query gov deposit-history cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "depositor"},
+					},
+				},
+				{
+					RpcMethod: "ProposalDraft",
+					Use:       "proposal-draft [draft-id]",
+					Short:     "Query a proposal draft along with the content of its latest version",
+					Example:   fmt.Sprintf("%s query gov proposal-draft 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "draft_id"},
+					},
+				},
+				{
+					RpcMethod: "ProposalDraftVersions",
+					Use:       "proposal-draft-versions [draft-id]",
+					Short:     "Query all the versions of a proposal draft, from the oldest to the latest",
+					Example:   fmt.Sprintf("%s query gov proposal-draft-versions 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "draft_id"},
+					},
+				},
 			},
 			EnhanceCustomCommand: true, // We still have manual commands in gov that we want to keep
 		},
@@ -120,6 +188,27 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "proposal_id"},
 					},
 				},
+				{
+					RpcMethod: "VetoProposalMessages",
+					Use:       "veto-proposal-messages [proposal-id] [justification] [message-indexes...]",
+					Short:     "Strike messages from a proposal during its timelock. Must be signed by the line-item veto authority.",
+					Example:   fmt.Sprintf(`$ %s tx gov veto-proposal-messages 1 "out of scope" 0 2 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+						{ProtoField: "justification"},
+						{ProtoField: "message_indexes", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "PromoteProposalDraft",
+					Use:       "promote-proposal-draft [draft-id] [initial-deposit]",
+					Short:     "Submit the latest version of a proposal draft as a proposal. Must be signed by the draft owner.",
+					Example:   fmt.Sprintf(`$ %s tx gov promote-proposal-draft 1 10stake --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "draft_id"},
+						{ProtoField: "initial_deposit", Varargs: true},
+					},
+				},
 				{
 					RpcMethod: "Vote",
 					Use:       "vote [proposal-id] [option]",
@@ -134,6 +223,20 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						"metadata": {Name: "metadata", Usage: "Add a description to the vote"},
 					},
 				},
+				{
+					RpcMethod: "VoteRanked",
+					Use:       "vote-ranked [proposal-id] [ranking...]",
+					Short:     "Vote for an active multiple choice proposal with options ranked from the most to the least preferred",
+					Long:      "Submit a ranked ballot for an active multiple choice proposal. The ballot counts for the most preferred option which has not been eliminated in each round of the instant-runoff tally. Use the --metadata to optionally give a reason.",
+					Example:   fmt.Sprintf("$ %s tx gov vote-ranked 1 three one --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+						{ProtoField: "ranking", Varargs: true},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"metadata": {Name: "metadata", Usage: "Add a description to the vote"},
+					},
+				},
 				{
 					RpcMethod:      "UpdateParams",
 					Use:            "update-params-proposal [params]",
@@ -72,6 +72,8 @@ func NewTxCmd(legacyPropCmds []*cobra.Command) *cobra.Command {
 		NewCmdWeightedVote(),
 		NewCmdSubmitProposal(),
 		NewCmdDraftProposal(),
+		NewCmdCreateProposalDraft(),
+		NewCmdUpdateProposalDraft(),
 
 		// Deprecated
 		cmdSubmitLegacyProp,
@@ -112,6 +114,10 @@ Where proposal.json contains:
   "title": "My proposal",
   "summary": "A short summary of my proposal",
   "proposal_type": "standard",
+  // optional, executes the messages of the passed proposal across several blocks
+  "chunked_execution": false,
+  // optional, forbids changing votes in the final period of the voting period
+  "vote_lockout_period": "24h",
 }
 
 metadata example: 
@@ -142,6 +148,99 @@ metadata example:
 			if err != nil {
 				return fmt.Errorf("invalid message: %w", err)
 			}
+			msg.ChunkedExecution = proposal.ChunkedExecution
+			msg.VoteLockoutPeriod = proposal.voteLockoutPeriod
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdCreateProposalDraft implements creating an on-chain proposal draft transaction command.
+func NewCmdCreateProposalDraft() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-proposal-draft [path/to/proposal.json]",
+		Short: "Create an on-chain proposal draft along with some messages and metadata",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create an on-chain proposal draft along with some messages and metadata.
+The draft is not voted on: it can be updated by its owner, each update recording
+a new version, until it is submitted as a proposal with promote-proposal-draft.
+The proposal is defined in a JSON file in the same format as for submit-proposal,
+the deposit, chunked_execution and vote_lockout_period fields being ignored.
+
+Example:
+$ %s tx gov create-proposal-draft path/to/proposal.json
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, msgs, _, err := parseSubmitProposal(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg, err := v1.NewMsgCreateProposalDraft(msgs, clientCtx.GetFromAddress().String(), proposal.Metadata, proposal.Title, proposal.Summary, proposal.proposalType)
+			if err != nil {
+				return fmt.Errorf("invalid message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdUpdateProposalDraft implements updating an on-chain proposal draft transaction command.
+func NewCmdUpdateProposalDraft() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-proposal-draft [draft-id] [path/to/proposal.json]",
+		Short: "Record a new version of an on-chain proposal draft. Must be signed by the draft owner.",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Record a new version of an on-chain proposal draft which has not been promoted yet.
+The new content replaces the whole content of the draft, the previous versions being kept.
+The proposal is defined in a JSON file in the same format as for create-proposal-draft.
+
+Example:
+$ %s tx gov update-proposal-draft 1 path/to/proposal.json
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			draftID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("draft-id %s not a valid uint, please input a valid draft-id", args[0])
+			}
+
+			proposal, msgs, _, err := parseSubmitProposal(clientCtx.Codec, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg, err := v1.NewMsgUpdateProposalDraft(draftID, msgs, clientCtx.GetFromAddress().String(), proposal.Metadata, proposal.Title, proposal.Summary, proposal.proposalType)
+			if err != nil {
+				return fmt.Errorf("invalid message: %w", err)
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -88,14 +89,17 @@ func parseSubmitLegacyProposal(fs *pflag.FlagSet) (*legacyProposal, error) {
 // proposal defines the new Msg-based proposal.
 type proposal struct {
 	// Msgs defines an array of sdk.Msgs proto-JSON-encoded as Anys.
-	Messages        []json.RawMessage `json:"messages,omitempty"`
-	Metadata        string            `json:"metadata"`
-	Deposit         string            `json:"deposit"`
-	Title           string            `json:"title"`
-	Summary         string            `json:"summary"`
-	ProposalTypeStr string            `json:"proposal_type,omitempty"`
-
-	proposalType govv1.ProposalType `json:"-"`
+	Messages             []json.RawMessage `json:"messages,omitempty"`
+	Metadata             string            `json:"metadata"`
+	Deposit              string            `json:"deposit"`
+	Title                string            `json:"title"`
+	Summary              string            `json:"summary"`
+	ProposalTypeStr      string            `json:"proposal_type,omitempty"`
+	ChunkedExecution     bool              `json:"chunked_execution,omitempty"`
+	VoteLockoutPeriodStr string            `json:"vote_lockout_period,omitempty"`
+
+	proposalType      govv1.ProposalType `json:"-"`
+	voteLockoutPeriod *time.Duration     `json:"-"`
 }
 
 // parseSubmitProposal reads and parses the proposal.
@@ -118,6 +122,14 @@ func parseSubmitProposal(cdc codec.Codec, path string) (proposal, []sdk.Msg, sdk
 	}
 	proposal.proposalType = proposalType
 
+	if proposal.VoteLockoutPeriodStr != "" {
+		voteLockoutPeriod, err := time.ParseDuration(proposal.VoteLockoutPeriodStr)
+		if err != nil {
+			return proposal, nil, nil, fmt.Errorf("invalid vote lockout period: %w", err)
+		}
+		proposal.voteLockoutPeriod = &voteLockoutPeriod
+	}
+
 	msgs := make([]sdk.Msg, len(proposal.Messages))
 	for i, anyJSON := range proposal.Messages {
 		var msg sdk.Msg
@@ -42,6 +42,10 @@ func NormalizeProposalType(proposalType string) v1.ProposalType {
 		return v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE
 	case "Optimistic", "optimistic":
 		return v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC
+	case "SecurityCouncil", "security_council", "security-council":
+		return v1.ProposalType_PROPOSAL_TYPE_SECURITY_COUNCIL
+	case "Emergency", "emergency":
+		return v1.ProposalType_PROPOSAL_TYPE_EMERGENCY
 	default:
 		return v1.ProposalType_PROPOSAL_TYPE_STANDARD
 	}
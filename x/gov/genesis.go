@@ -62,8 +62,33 @@ func InitGenesis(ctx context.Context, ak types.AccountKeeper, bk types.BankKeepe
 			if err != nil {
 				panic(err)
 			}
+			err = k.SetScheduleEntry(ctx, v1.ScheduleEventType_SCHEDULE_EVENT_TYPE_DEPOSIT_END, *proposal.DepositEndTime, proposal.Id)
+			if err != nil {
+				panic(err)
+			}
 		case v1.StatusVotingPeriod:
-			err := k.ActiveProposalsQueue.Set(ctx, collections.Join(*proposal.VotingEndTime, proposal.Id), proposal.Id)
+			err := k.ActiveQueue(proposal.ProposalType).Set(ctx, collections.Join(*proposal.VotingEndTime, proposal.Id), proposal.Id)
+			if err != nil {
+				panic(err)
+			}
+			err = k.SetScheduleEntry(ctx, v1.ScheduleEventType_SCHEDULE_EVENT_TYPE_VOTING_END, *proposal.VotingEndTime, proposal.Id)
+			if err != nil {
+				panic(err)
+			}
+		case v1.StatusTimelock:
+			err := k.TimelockProposalsQueue.Set(ctx, collections.Join(*proposal.TimelockEndTime, proposal.Id), proposal.Id)
+			if err != nil {
+				panic(err)
+			}
+			err = k.SetScheduleEntry(ctx, v1.ScheduleEventType_SCHEDULE_EVENT_TYPE_EXECUTION, *proposal.TimelockEndTime, proposal.Id)
+			if err != nil {
+				panic(err)
+			}
+		case v1.StatusExecuting:
+			// executing proposals resume their chunked execution from the imported progress
+		case v1.StatusPassed, v1.StatusRejected, v1.StatusFailed:
+			// finished proposals start their archive retention period from genesis
+			err := k.FinishedProposals.Set(ctx, collections.Join(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height, proposal.Id))
 			if err != nil {
 				panic(err)
 			}
@@ -73,6 +98,58 @@ func InitGenesis(ctx context.Context, ak types.AccountKeeper, bk types.BankKeepe
 		}
 	}
 
+	for _, archived := range data.ArchivedProposals {
+		if err := k.ArchivedProposals.Set(ctx, archived.Id, *archived); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, record := range data.DepositHistory {
+		depositor, err := ak.AddressCodec().StringToBytes(record.Depositor)
+		if err != nil {
+			panic(err)
+		}
+		if err := k.DepositHistory.Set(ctx, collections.Join(sdk.AccAddress(depositor), record.ProposalId), *record); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, veto := range data.LineItemVetoes {
+		if err := k.LineItemVetoes.Set(ctx, collections.Join(veto.ProposalId, veto.MessageIndex), *veto); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, execution := range data.ProposalExecutions {
+		if err := k.ProposalExecutions.Set(ctx, execution.ProposalId, *execution); err != nil {
+			panic(err)
+		}
+	}
+
+	// the next proposal draft gets an ID after the ones of the genesis drafts
+	var lastDraftID uint64
+	for _, draft := range data.ProposalDrafts {
+		if err := k.ProposalDrafts.Set(ctx, draft.Id, *draft); err != nil {
+			panic(err)
+		}
+		lastDraftID = max(lastDraftID, draft.Id)
+	}
+	if err := k.ProposalDraftID.Set(ctx, lastDraftID); err != nil {
+		panic(err)
+	}
+
+	for _, version := range data.ProposalDraftVersions {
+		if err := k.ProposalDraftVersions.Set(ctx, collections.Join(version.DraftId, version.Version), *version); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, alerts := range data.WhaleAlerts {
+		if err := k.WhaleAlerts.Set(ctx, alerts.ProposalId, *alerts); err != nil {
+			panic(err)
+		}
+	}
+
 	// if account has zero balance it probably means it's not set, so we set it
 	balance := bk.GetAllBalances(ctx, moduleAcc.GetAddress())
 	if balance.IsZero() {
@@ -130,12 +207,87 @@ func ExportGenesis(ctx context.Context, k *keeper.Keeper) (*v1.GenesisState, err
 		panic(err)
 	}
 
+	// export archived proposals
+	var archivedProposals []*v1.ArchivedProposal
+	err = k.ArchivedProposals.Walk(ctx, nil, func(_ uint64, value v1.ArchivedProposal) (stop bool, err error) {
+		archivedProposals = append(archivedProposals, &value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// export deposit history
+	var depositHistory []*v1.DepositRecord
+	err = k.DepositHistory.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, uint64], value v1.DepositRecord) (stop bool, err error) {
+		depositHistory = append(depositHistory, &value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// export line-item vetoes
+	var lineItemVetoes []*v1.LineItemVeto
+	err = k.LineItemVetoes.Walk(ctx, nil, func(_ collections.Pair[uint64, uint32], value v1.LineItemVeto) (stop bool, err error) {
+		lineItemVetoes = append(lineItemVetoes, &value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// export the progress of the chunked executions
+	var proposalExecutions []*v1.ProposalExecution
+	err = k.ProposalExecutions.Walk(ctx, nil, func(_ uint64, value v1.ProposalExecution) (stop bool, err error) {
+		proposalExecutions = append(proposalExecutions, &value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// export the proposal drafts along with all of their versions
+	var proposalDrafts []*v1.ProposalDraft
+	err = k.ProposalDrafts.Walk(ctx, nil, func(_ uint64, value v1.ProposalDraft) (stop bool, err error) {
+		proposalDrafts = append(proposalDrafts, &value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var proposalDraftVersions []*v1.ProposalDraftVersion
+	err = k.ProposalDraftVersions.Walk(ctx, nil, func(_ collections.Pair[uint64, uint64], value v1.ProposalDraftVersion) (stop bool, err error) {
+		proposalDraftVersions = append(proposalDraftVersions, &value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var whaleAlerts []*v1.WhaleAlerts
+	err = k.WhaleAlerts.Walk(ctx, nil, func(_ uint64, value v1.WhaleAlerts) (stop bool, err error) {
+		whaleAlerts = append(whaleAlerts, &value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &v1.GenesisState{
-		StartingProposalId: startingProposalID,
-		Deposits:           proposalsDeposits,
-		Votes:              proposalsVotes,
-		Proposals:          proposals,
-		Params:             &params,
-		Constitution:       constitution,
+		StartingProposalId:    startingProposalID,
+		Deposits:              proposalsDeposits,
+		Votes:                 proposalsVotes,
+		Proposals:             proposals,
+		Params:                &params,
+		Constitution:          constitution,
+		ArchivedProposals:     archivedProposals,
+		DepositHistory:        depositHistory,
+		LineItemVetoes:        lineItemVetoes,
+		ProposalExecutions:    proposalExecutions,
+		ProposalDrafts:        proposalDrafts,
+		ProposalDraftVersions: proposalDraftVersions,
+		WhaleAlerts:           whaleAlerts,
 	}, nil
 }
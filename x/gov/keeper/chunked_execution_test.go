@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/gov"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestChunkedExecution() {
+	suite.reset()
+	ctx := suite.ctx
+
+	params, err := suite.govKeeper.Params.Get(ctx)
+	suite.Require().NoError(err)
+
+	// the chunked execution is disabled by default
+	_, err = suite.msgSrvr.SubmitProposal(ctx, &v1.MsgSubmitProposal{
+		Metadata:         "metadata",
+		Proposer:         suite.addrs[0].String(),
+		Title:            "title",
+		Summary:          "summary",
+		ChunkedExecution: true,
+	})
+	suite.Require().ErrorIs(err, types.ErrChunkedExecution)
+
+	// measure the gas consumed by each message of the proposal
+	msg := &v1.MsgUpdateParams{Authority: govAcct.String(), Params: params}
+	gasMeter := storetypes.NewInfiniteGasMeter()
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = suite.govKeeper.Router().Handler(msg)(cacheCtx.WithGasMeter(gasMeter), msg)
+	suite.Require().NoError(err)
+	msgGas := gasMeter.GasConsumed()
+	suite.Require().NotZero(msgGas)
+
+	// a budget of one and a half message executes one message per block
+	params.ExecutionGasPerBlock = msgGas + msgGas/2
+	suite.Require().NoError(suite.govKeeper.Params.Set(ctx, params))
+	msg.Params = params
+
+	submit := func(chunked bool) v1.Proposal {
+		proposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msg, msg, msg}, "", "test", "summary", suite.addrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+		suite.Require().NoError(err)
+
+		// the passed proposal is executed at the end of its timelock
+		timelockEndTime := ctx.HeaderInfo().Time
+		proposal.Status = v1.StatusTimelock
+		proposal.TimelockEndTime = &timelockEndTime
+		proposal.ChunkedExecution = chunked
+		suite.Require().NoError(suite.govKeeper.Proposals.Set(ctx, proposal.Id, proposal))
+		suite.Require().NoError(suite.govKeeper.TimelockProposalsQueue.Set(ctx, collections.Join(timelockEndTime, proposal.Id), proposal.Id))
+		return proposal
+	}
+
+	proposal := submit(true)
+	for i := 1; i <= 3; i++ {
+		suite.Require().NoError(gov.EndBlocker(ctx, suite.govKeeper))
+
+		proposal, err = suite.govKeeper.Proposals.Get(ctx, proposal.Id)
+		suite.Require().NoError(err)
+		if i < 3 {
+			suite.Require().Equal(v1.StatusExecuting, proposal.Status)
+
+			res, err := suite.queryClient.ProposalExecution(ctx, &v1.QueryProposalExecutionRequest{ProposalId: proposal.Id})
+			suite.Require().NoError(err)
+			suite.Require().Equal(uint32(i), res.Execution.NextMessageIndex)
+			suite.Require().GreaterOrEqual(res.Execution.GasUsed, uint64(i)*msgGas)
+		}
+	}
+	suite.Require().Equal(v1.StatusPassed, proposal.Status)
+
+	_, err = suite.queryClient.ProposalExecution(ctx, &v1.QueryProposalExecutionRequest{ProposalId: proposal.Id})
+	suite.Require().ErrorContains(err, "is not executing")
+
+	// a message exceeding the budget of a whole block fails the proposal
+	params.ExecutionGasPerBlock = msgGas / 2
+	suite.Require().NoError(suite.govKeeper.Params.Set(ctx, params))
+
+	proposal = submit(true)
+	suite.Require().NoError(gov.EndBlocker(ctx, suite.govKeeper))
+	proposal, err = suite.govKeeper.Proposals.Get(ctx, proposal.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(v1.StatusFailed, proposal.Status)
+	suite.Require().Contains(proposal.FailedReason, "msg 0 (/cosmos.gov.v1.MsgUpdateParams) failed on chunked execution")
+
+	// the other proposals are still executed at once
+	proposal = submit(false)
+	suite.Require().NoError(gov.EndBlocker(ctx, suite.govKeeper))
+	proposal, err = suite.govKeeper.Proposals.Get(ctx, proposal.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(v1.StatusPassed, proposal.Status)
+}
@@ -35,30 +35,106 @@ func (k Keeper) GetDeposits(ctx context.Context, proposalID uint64) (deposits v1
 }
 
 // DeleteAndBurnDeposits deletes and burns all the deposits on a specific proposal.
+// Only the deposit_burn_ratio share of each deposit is burned, the remainder is
+// refunded to its depositor.
 func (k Keeper) DeleteAndBurnDeposits(ctx context.Context, proposalID uint64) error {
-	coinsToBurn := sdk.NewCoins()
-	err := k.IterateDeposits(ctx, proposalID, func(key collections.Pair[uint64, sdk.AccAddress], deposit v1.Deposit) (stop bool, err error) {
-		coinsToBurn = coinsToBurn.Add(deposit.Amount...)
-		return false, k.Deposits.Remove(ctx, key)
-	})
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	coinsToBurn, err := k.settleDeposits(ctx, proposalID, params.GetDepositBurnRatioOrDefault())
 	if err != nil {
 		return err
 	}
 
+	if coinsToBurn.IsZero() {
+		return nil
+	}
+
 	return k.bankKeeper.BurnCoins(ctx, k.authKeeper.GetModuleAddress(types.ModuleName), coinsToBurn)
 }
 
 // RefundAndDeleteDeposits refunds and deletes all the deposits on a specific proposal.
 func (k Keeper) RefundAndDeleteDeposits(ctx context.Context, proposalID uint64) error {
-	return k.IterateDeposits(ctx, proposalID, func(key collections.Pair[uint64, sdk.AccAddress], deposit v1.Deposit) (bool, error) {
-		depositor := key.K2()
-		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, deposit.Amount)
+	_, err := k.settleDeposits(ctx, proposalID, sdkmath.LegacyZeroDec())
+	return err
+}
+
+// settleDeposits deletes all the deposits on a specific proposal. The chargeRatio
+// share of each deposit is charged to its depositor, and the remainder refunded.
+// The depositor's refunded and charged amounts are recorded in its deposit history.
+// It returns the total charged amount, which is left in the module account.
+func (k Keeper) settleDeposits(ctx context.Context, proposalID uint64, chargeRatio sdkmath.LegacyDec) (sdk.Coins, error) {
+	var keys []collections.Pair[uint64, sdk.AccAddress]
+	var deposits []v1.Deposit
+	err := k.IterateDeposits(ctx, proposalID, func(key collections.Pair[uint64, sdk.AccAddress], deposit v1.Deposit) (bool, error) {
+		keys = append(keys, key)
+		deposits = append(deposits, deposit)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	charges := sdk.NewCoins()
+	for i, deposit := range deposits {
+		depositor := keys[i].K2()
+
+		var refund, charge sdk.Coins
+		for _, coin := range deposit.Amount {
+			chargeAmount := sdkmath.LegacyNewDecFromInt(coin.Amount).Mul(chargeRatio).TruncateInt()
+			// refund amount = deposit amount - charge amount
+			refund = refund.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(chargeAmount)))
+			charge = charge.Add(sdk.NewCoin(coin.Denom, chargeAmount))
+		}
+
+		if !refund.IsZero() {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, refund); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := k.Deposits.Remove(ctx, keys[i]); err != nil {
+			return nil, err
+		}
+
+		record, err := k.getDepositRecord(ctx, depositor, proposalID)
 		if err != nil {
-			return false, err
+			return nil, err
 		}
-		err = k.Deposits.Remove(ctx, key)
-		return false, err
-	})
+		if len(record.Deposited) == 0 {
+			// deposits made before deposit history was tracked
+			record.Deposited = deposit.Amount
+		}
+		record.Refunded = sdk.NewCoins(record.Refunded...).Add(refund...)
+		record.Charged = sdk.NewCoins(record.Charged...).Add(charge...)
+		if err := k.DepositHistory.Set(ctx, collections.Join(depositor, proposalID), record); err != nil {
+			return nil, err
+		}
+
+		charges = charges.Add(charge...)
+	}
+
+	return charges, nil
+}
+
+// getDepositRecord returns the deposit record of a depositor on a specific proposal,
+// or a new empty record if the depositor has no record yet.
+func (k Keeper) getDepositRecord(ctx context.Context, depositor sdk.AccAddress, proposalID uint64) (v1.DepositRecord, error) {
+	record, err := k.DepositHistory.Get(ctx, collections.Join(depositor, proposalID))
+	switch {
+	case err == nil:
+		return record, nil
+	case errors.IsOf(err, collections.ErrNotFound):
+		depositorStr, err := k.authKeeper.AddressCodec().BytesToString(depositor)
+		if err != nil {
+			return v1.DepositRecord{}, err
+		}
+		return v1.DepositRecord{ProposalId: proposalID, Depositor: depositorStr}, nil
+	default:
+		return v1.DepositRecord{}, err
+	}
 }
 
 // IterateDeposits iterates over all the proposals deposits and performs a callback function
@@ -190,6 +266,26 @@ func (k Keeper) AddDeposit(ctx context.Context, proposalID uint64, depositorAddr
 		return false, err
 	}
 
+	// track the deposit in the depositor's deposit history
+	record, err := k.getDepositRecord(ctx, depositorAddr, proposalID)
+	if err != nil {
+		return false, err
+	}
+	record.Deposited = sdk.NewCoins(record.Deposited...).Add(depositAmount...)
+	if err = k.DepositHistory.Set(ctx, collections.Join(depositorAddr, proposalID), record); err != nil {
+		return false, err
+	}
+
+	if err := k.checkWhaleDeposit(ctx, params, proposalID, depositorAddr, depositAmount, minDepositAmount); err != nil {
+		return false, err
+	}
+
+	// called once the deposit is stored, with the amount added by this deposit only
+	err = k.Hooks().AfterDepositAdded(ctx, proposalID, depositorAddr, depositAmount, activatedVotingPeriod)
+	if err != nil {
+		return false, err
+	}
+
 	return activatedVotingPeriod, nil
 }
 
@@ -198,52 +294,12 @@ func (k Keeper) AddDeposit(ctx context.Context, proposalID uint64, depositorAddr
 // Remaining funds are send back to the depositor.
 func (k Keeper) ChargeDeposit(ctx context.Context, proposalID uint64, destAddress, proposalCancelRate string) error {
 	rate := sdkmath.LegacyMustNewDecFromStr(proposalCancelRate)
-	var cancellationCharges sdk.Coins
 
-	deposits, err := k.GetDeposits(ctx, proposalID)
+	cancellationCharges, err := k.settleDeposits(ctx, proposalID, rate)
 	if err != nil {
 		return err
 	}
 
-	for _, deposit := range deposits {
-		depositerAddress, err := k.authKeeper.AddressCodec().StringToBytes(deposit.Depositor)
-		if err != nil {
-			return err
-		}
-
-		var remainingAmount sdk.Coins
-
-		for _, coin := range deposit.Amount {
-			burnAmount := sdkmath.LegacyNewDecFromInt(coin.Amount).Mul(rate).TruncateInt()
-			// remaining amount = deposits amount - burn amount
-			remainingAmount = remainingAmount.Add(
-				sdk.NewCoin(
-					coin.Denom,
-					coin.Amount.Sub(burnAmount),
-				),
-			)
-			cancellationCharges = cancellationCharges.Add(
-				sdk.NewCoin(
-					coin.Denom,
-					burnAmount,
-				),
-			)
-		}
-
-		if !remainingAmount.IsZero() {
-			err := k.bankKeeper.SendCoinsFromModuleToAccount(
-				ctx, types.ModuleName, depositerAddress, remainingAmount,
-			)
-			if err != nil {
-				return err
-			}
-		}
-		err = k.Deposits.Remove(ctx, collections.Join(deposit.ProposalId, sdk.AccAddress(depositerAddress)))
-		if err != nil {
-			return err
-		}
-	}
-
 	// burn the cancellation fee or send the cancellation charges to destination address.
 	if !cancellationCharges.IsZero() {
 		// get the pool module account address
@@ -295,7 +351,7 @@ func (k Keeper) validateInitialDeposit(ctx context.Context, params v1.Params, in
 
 	var minDepositCoins sdk.Coins
 	switch proposalType {
-	case v1.ProposalType_PROPOSAL_TYPE_EXPEDITED:
+	case v1.ProposalType_PROPOSAL_TYPE_EXPEDITED, v1.ProposalType_PROPOSAL_TYPE_EMERGENCY:
 		minDepositCoins = params.ExpeditedMinDeposit
 	default:
 		minDepositCoins = params.MinDeposit
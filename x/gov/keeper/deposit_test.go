@@ -161,6 +161,53 @@ func TestDeposits(t *testing.T) {
 	}
 }
 
+func TestDeleteAndBurnDepositsProRata(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t)
+	authKeeper, bankKeeper, stakingKeeper := mocks.acctKeeper, mocks.bankKeeper, mocks.stakingKeeper
+	trackMockBalances(bankKeeper)
+	authKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	params, err := govKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.DepositBurnRatio = "0.25"
+	require.NoError(t, govKeeper.Params.Set(ctx, params))
+
+	TestAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 2, sdkmath.NewInt(10000000))
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", TestAddrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+
+	addr0Initial := bankKeeper.GetAllBalances(ctx, TestAddrs[0])
+	addr1Initial := bankKeeper.GetAllBalances(ctx, TestAddrs[1])
+
+	oneStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, stakingKeeper.TokensFromConsensusPower(ctx, 1)))
+	threeStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, stakingKeeper.TokensFromConsensusPower(ctx, 3)))
+	_, err = govKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], oneStake)
+	require.NoError(t, err)
+	_, err = govKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[1], threeStake)
+	require.NoError(t, err)
+
+	require.NoError(t, govKeeper.DeleteAndBurnDeposits(ctx, proposal.Id))
+
+	deposits, err := govKeeper.GetDeposits(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Len(t, deposits, 0)
+
+	// each depositor is refunded 75% of its own deposit
+	for i, deposited := range []sdk.Coins{oneStake, threeStake} {
+		charged := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, deposited.AmountOf(sdk.DefaultBondDenom).QuoRaw(4)))
+		refunded := deposited.Sub(charged...)
+
+		record, err := govKeeper.DepositHistory.Get(ctx, collections.Join(TestAddrs[i], proposal.Id))
+		require.NoError(t, err)
+		require.Equal(t, TestAddrs[i].String(), record.Depositor)
+		require.Equal(t, deposited, sdk.NewCoins(record.Deposited...))
+		require.Equal(t, refunded, sdk.NewCoins(record.Refunded...))
+		require.Equal(t, charged, sdk.NewCoins(record.Charged...))
+	}
+	require.Equal(t, addr0Initial.Sub(oneStake.QuoInt(sdkmath.NewInt(4))...), bankKeeper.GetAllBalances(ctx, TestAddrs[0]))
+	require.Equal(t, addr1Initial.Sub(threeStake.QuoInt(sdkmath.NewInt(4))...), bankKeeper.GetAllBalances(ctx, TestAddrs[1]))
+}
+
 func TestDepositAmount(t *testing.T) {
 	testcases := []struct {
 		name            string
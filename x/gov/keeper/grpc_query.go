@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	stderrors "errors"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -11,6 +12,7 @@ import (
 	"cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	v3 "cosmossdk.io/x/gov/migrations/v3"
+	govtypes "cosmossdk.io/x/gov/types"
 	v1 "cosmossdk.io/x/gov/types/v1"
 	"cosmossdk.io/x/gov/types/v1beta1"
 
@@ -334,6 +336,186 @@ func (q queryServer) TallyResult(ctx context.Context, req *v1.QueryTallyResultRe
 	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
 }
 
+// ArchivedProposal returns the summary of an archived proposal based on its ID.
+func (q queryServer) ArchivedProposal(ctx context.Context, req *v1.QueryArchivedProposalRequest) (*v1.QueryArchivedProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	proposal, err := q.k.ArchivedProposals.Get(ctx, req.ProposalId)
+	if err == nil {
+		return &v1.QueryArchivedProposalResponse{Proposal: &proposal}, nil
+	}
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "archived proposal %d doesn't exist", req.ProposalId)
+	}
+	return nil, status.Error(codes.Internal, err.Error())
+}
+
+// ArchivedProposals returns the summaries of all archived proposals.
+func (q queryServer) ArchivedProposals(ctx context.Context, req *v1.QueryArchivedProposalsRequest) (*v1.QueryArchivedProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	proposals, pageRes, err := query.CollectionPaginate(ctx, q.k.ArchivedProposals, req.Pagination, func(_ uint64, proposal v1.ArchivedProposal) (*v1.ArchivedProposal, error) {
+		return &proposal, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryArchivedProposalsResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
+
+// DepositHistory returns the deposit records of a depositor across proposals.
+func (q queryServer) DepositHistory(ctx context.Context, req *v1.QueryDepositHistoryRequest) (*v1.QueryDepositHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Depositor == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty depositor address")
+	}
+
+	depositor, err := q.k.authKeeper.AddressCodec().StringToBytes(req.Depositor)
+	if err != nil {
+		return nil, err
+	}
+
+	records, pageRes, err := query.CollectionPaginate(ctx, q.k.DepositHistory, req.Pagination, func(_ collections.Pair[sdk.AccAddress, uint64], record v1.DepositRecord) (*v1.DepositRecord, error) {
+		return &record, nil
+	}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](depositor))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryDepositHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// LineItemVetoes returns the proposal messages struck through a line-item veto.
+func (q queryServer) LineItemVetoes(ctx context.Context, req *v1.QueryLineItemVetoesRequest) (*v1.QueryLineItemVetoesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var opts []func(o *query.CollectionsPaginateOptions[collections.Pair[uint64, uint32]])
+	if req.ProposalId != 0 {
+		opts = append(opts, query.WithCollectionPaginationPairPrefix[uint64, uint32](req.ProposalId))
+	}
+
+	vetoes, pageRes, err := query.CollectionPaginate(ctx, q.k.LineItemVetoes, req.Pagination, func(_ collections.Pair[uint64, uint32], veto v1.LineItemVeto) (*v1.LineItemVeto, error) {
+		return &veto, nil
+	}, opts...)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryLineItemVetoesResponse{Vetoes: vetoes, Pagination: pageRes}, nil
+}
+
+// Schedule returns the upcoming governance events in chronological order.
+func (q queryServer) Schedule(ctx context.Context, req *v1.QueryScheduleRequest) (*v1.QueryScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	entries, pageRes, err := query.CollectionPaginate[collections.Triple[time.Time, uint64, int32], collections.NoValue](ctx, q.k.Schedule, req.Pagination, func(key collections.Triple[time.Time, uint64, int32], _ collections.NoValue) (*v1.ScheduleEntry, error) {
+		return &v1.ScheduleEntry{
+			Time:       key.K1(),
+			ProposalId: key.K2(),
+			EventType:  v1.ScheduleEventType(key.K3()),
+		}, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryScheduleResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+// ProposalExecution returns the progress of the chunked execution of a passed proposal.
+func (q queryServer) ProposalExecution(ctx context.Context, req *v1.QueryProposalExecutionRequest) (*v1.QueryProposalExecutionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	execution, err := q.k.ProposalExecutions.Get(ctx, req.ProposalId)
+	if err == nil {
+		return &v1.QueryProposalExecutionResponse{Execution: &execution}, nil
+	}
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "proposal %d is not executing", req.ProposalId)
+	}
+	return nil, status.Error(codes.Internal, err.Error())
+}
+
+// ProposalDraft returns a proposal draft along with the content of its latest version.
+func (q queryServer) ProposalDraft(ctx context.Context, req *v1.QueryProposalDraftRequest) (*v1.QueryProposalDraftResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DraftId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "draft id can not be 0")
+	}
+
+	draft, version, err := q.k.GetProposalDraft(ctx, req.DraftId)
+	if err == nil {
+		return &v1.QueryProposalDraftResponse{Draft: &draft, LatestVersion: &version}, nil
+	}
+	if errors.IsOf(err, govtypes.ErrInvalidProposalDraft) {
+		return nil, status.Errorf(codes.NotFound, "proposal draft %d doesn't exist", req.DraftId)
+	}
+	return nil, status.Error(codes.Internal, err.Error())
+}
+
+// ProposalDraftVersions returns the versions of a proposal draft, from the oldest to the latest.
+func (q queryServer) ProposalDraftVersions(ctx context.Context, req *v1.QueryProposalDraftVersionsRequest) (*v1.QueryProposalDraftVersionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DraftId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "draft id can not be 0")
+	}
+
+	versions, pageRes, err := query.CollectionPaginate(ctx, q.k.ProposalDraftVersions, req.Pagination, func(_ collections.Pair[uint64, uint64], version v1.ProposalDraftVersion) (*v1.ProposalDraftVersion, error) {
+		return &version, nil
+	}, query.WithCollectionPaginationPairPrefix[uint64, uint64](req.DraftId))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryProposalDraftVersionsResponse{Versions: versions, Pagination: pageRes}, nil
+}
+
+// WhaleAlerts returns the aggregated whale alerts of a proposal.
+func (q queryServer) WhaleAlerts(ctx context.Context, req *v1.QueryWhaleAlertsRequest) (*v1.QueryWhaleAlertsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	alerts, err := q.k.GetWhaleAlerts(ctx, req.ProposalId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryWhaleAlertsResponse{WhaleAlerts: &alerts}, nil
+}
+
 var _ v1beta1.QueryServer = legacyQueryServer{}
 
 type legacyQueryServer struct{ qs v1.QueryServer }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	v3 "cosmossdk.io/x/gov/migrations/v3"
 	v1 "cosmossdk.io/x/gov/types/v1"
@@ -1400,6 +1401,75 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposits() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryDepositHistory() {
+	suite.reset()
+	ctx, queryClient, addrs := suite.ctx, suite.queryClient, suite.addrs
+
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, suite.stakingKeeper.TokensFromConsensusPower(ctx, 20)))
+	record1 := v1.DepositRecord{ProposalId: 1, Depositor: addrs[0].String(), Deposited: amount, Refunded: amount}
+	record2 := v1.DepositRecord{ProposalId: 2, Depositor: addrs[0].String(), Deposited: amount}
+	record3 := v1.DepositRecord{ProposalId: 1, Depositor: addrs[1].String(), Deposited: amount, Charged: amount}
+	for _, record := range []v1.DepositRecord{record1, record2, record3} {
+		depositor, err := sdk.AccAddressFromBech32(record.Depositor)
+		suite.Require().NoError(err)
+		suite.Require().NoError(suite.govKeeper.DepositHistory.Set(ctx, collections.Join(depositor, record.ProposalId), record))
+	}
+
+	_, err := queryClient.DepositHistory(gocontext.Background(), &v1.QueryDepositHistoryRequest{})
+	suite.Require().Error(err)
+
+	res, err := queryClient.DepositHistory(gocontext.Background(), &v1.QueryDepositHistoryRequest{Depositor: addrs[0].String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*v1.DepositRecord{&record1, &record2}, res.Records)
+
+	res, err = queryClient.DepositHistory(gocontext.Background(), &v1.QueryDepositHistoryRequest{Depositor: addrs[1].String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*v1.DepositRecord{&record3}, res.Records)
+
+	res, err = queryClient.DepositHistory(gocontext.Background(), &v1.QueryDepositHistoryRequest{Depositor: addrs[2].String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Records)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQuerySchedule() {
+	suite.reset()
+	ctx, queryClient, addrs := suite.ctx, suite.queryClient, suite.addrs
+
+	proposal1, err := suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	suite.Require().NoError(err)
+	proposal2, err := suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0], v1.ProposalType_PROPOSAL_TYPE_EXPEDITED)
+	suite.Require().NoError(err)
+
+	res, err := queryClient.Schedule(gocontext.Background(), &v1.QueryScheduleRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*v1.ScheduleEntry{
+		{Time: proposal1.DepositEndTime.UTC(), ProposalId: proposal1.Id, EventType: v1.ScheduleEventType_SCHEDULE_EVENT_TYPE_DEPOSIT_END},
+		{Time: proposal2.DepositEndTime.UTC(), ProposalId: proposal2.Id, EventType: v1.ScheduleEventType_SCHEDULE_EVENT_TYPE_DEPOSIT_END},
+	}, res.Entries)
+
+	// activating the voting period replaces the deposit end by the voting end
+	suite.Require().NoError(suite.govKeeper.ActivateVotingPeriod(ctx, proposal2))
+	proposal2, err = suite.govKeeper.Proposals.Get(ctx, proposal2.Id)
+	suite.Require().NoError(err)
+	suite.Require().True(proposal2.VotingEndTime.Before(*proposal1.DepositEndTime))
+
+	res, err = queryClient.Schedule(gocontext.Background(), &v1.QueryScheduleRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*v1.ScheduleEntry{
+		{Time: proposal2.VotingEndTime.UTC(), ProposalId: proposal2.Id, EventType: v1.ScheduleEventType_SCHEDULE_EVENT_TYPE_VOTING_END},
+		{Time: proposal1.DepositEndTime.UTC(), ProposalId: proposal1.Id, EventType: v1.ScheduleEventType_SCHEDULE_EVENT_TYPE_DEPOSIT_END},
+	}, res.Entries)
+
+	// deleted proposals are removed from the schedule
+	suite.Require().NoError(suite.govKeeper.DeleteProposal(ctx, proposal2.Id))
+	res, err = queryClient.Schedule(gocontext.Background(), &v1.QueryScheduleRequest{Pagination: &query.PageRequest{Limit: 1}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*v1.ScheduleEntry{
+		{Time: proposal1.DepositEndTime.UTC(), ProposalId: proposal1.Id, EventType: v1.ScheduleEventType_SCHEDULE_EVENT_TYPE_DEPOSIT_END},
+	}, res.Entries)
+	suite.Require().Nil(res.Pagination.NextKey)
+}
+
 func (suite *KeeperTestSuite) TestLegacyGRPCQueryDeposits() {
 	suite.reset()
 	ctx, queryClient, addrs := suite.ctx, suite.legacyQueryClient, suite.addrs
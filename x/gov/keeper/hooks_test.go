@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/gov"
 	"cosmossdk.io/x/gov/keeper"
 	"cosmossdk.io/x/gov/types"
@@ -26,6 +27,12 @@ type MockGovHooksReceiver struct {
 	AfterProposalVoteValid              bool
 	AfterProposalFailedMinDepositValid  bool
 	AfterProposalVotingPeriodEndedValid bool
+	AfterVoteCastValid                  bool
+	AfterDepositAddedValid              bool
+
+	castOptions          []types.VoteCastOption
+	depositAdded         sdk.Coins
+	depositActivatedVote bool
 }
 
 func (h *MockGovHooksReceiver) AfterProposalSubmission(ctx context.Context, proposalID uint64) error {
@@ -53,6 +60,19 @@ func (h *MockGovHooksReceiver) AfterProposalVotingPeriodEnded(ctx context.Contex
 	return nil
 }
 
+func (h *MockGovHooksReceiver) AfterVoteCast(ctx context.Context, proposalID uint64, voterAddr sdk.AccAddress, options []types.VoteCastOption) error {
+	h.AfterVoteCastValid = true
+	h.castOptions = options
+	return nil
+}
+
+func (h *MockGovHooksReceiver) AfterDepositAdded(ctx context.Context, proposalID uint64, depositorAddr sdk.AccAddress, amount sdk.Coins, activatedVotingPeriod bool) error {
+	h.AfterDepositAddedValid = true
+	h.depositAdded = amount
+	h.depositActivatedVote = activatedVotingPeriod
+	return nil
+}
+
 func TestHooks(t *testing.T) {
 	minDeposit := v1.DefaultParams().MinDeposit
 	govKeeper, mocks, _, ctx := setupGovKeeper(t)
@@ -73,6 +93,8 @@ func TestHooks(t *testing.T) {
 	require.False(t, govHooksReceiver.AfterProposalVoteValid)
 	require.False(t, govHooksReceiver.AfterProposalFailedMinDepositValid)
 	require.False(t, govHooksReceiver.AfterProposalVotingPeriodEndedValid)
+	require.False(t, govHooksReceiver.AfterVoteCastValid)
+	require.False(t, govHooksReceiver.AfterDepositAddedValid)
 
 	tp := TestProposal
 	_, err := govKeeper.SubmitProposal(ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), v1.ProposalType_PROPOSAL_TYPE_STANDARD)
@@ -95,10 +117,17 @@ func TestHooks(t *testing.T) {
 	require.True(t, activated)
 	require.NoError(t, err)
 	require.True(t, govHooksReceiver.AfterProposalDepositValid)
+	require.True(t, govHooksReceiver.AfterDepositAddedValid)
+	require.True(t, govHooksReceiver.depositAdded.Equal(sdk.NewCoins(minDeposit...)))
+	require.True(t, govHooksReceiver.depositActivatedVote)
 
 	err = govKeeper.AddVote(ctx, p2.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "")
 	require.NoError(t, err)
 	require.True(t, govHooksReceiver.AfterProposalVoteValid)
+	require.True(t, govHooksReceiver.AfterVoteCastValid)
+	require.Len(t, govHooksReceiver.castOptions, 1)
+	require.Equal(t, int32(v1.OptionYes), govHooksReceiver.castOptions[0].Option)
+	require.True(t, govHooksReceiver.castOptions[0].Weight.Equal(math.LegacyOneDec()))
 
 	newHeader = ctx.HeaderInfo()
 	newHeader.Time = ctx.HeaderInfo().Time.Add(*params.VotingPeriod).Add(time.Duration(1) * time.Second)
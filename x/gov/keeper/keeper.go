@@ -51,8 +51,8 @@ type Keeper struct {
 	Schema collections.Schema
 	// Constitution value: constitution
 	Constitution collections.Item[string]
-	// Params stores the governance parameters
-	Params collections.Item[v1.Params]
+	// Params stores the governance parameters, cached as they are read by every EndBlocker
+	Params collections.CachedItem[v1.Params]
 	// MessageBasedParams store message-based governance parameters
 	// key:proposal-msg-url | value MessageBasedParams
 	MessageBasedParams collections.Map[string, v1.MessageBasedParams]
@@ -71,6 +71,36 @@ type Keeper struct {
 	ActiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
 	// InactiveProposalsQueue key: depositEndTime+proposalID | value: proposalID
 	InactiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
+	// SecurityCouncilProposalsQueue key: votingEndTime+proposalID | value: proposalID
+	// This is used to track the active security council proposals separately from the regular ones.
+	SecurityCouncilProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64]
+	// FinishedProposals key: finishedHeight+proposalID
+	// This is used to track the finished proposals that are yet to be archived.
+	FinishedProposals collections.KeySet[collections.Pair[int64, uint64]]
+	// ArchivedProposals key: proposalID | value: ArchivedProposal
+	ArchivedProposals collections.Map[uint64, v1.ArchivedProposal]
+	// DepositHistory key: depositorAddr+proposalID | value: DepositRecord
+	DepositHistory collections.Map[collections.Pair[sdk.AccAddress, uint64], v1.DepositRecord]
+	// TimelockProposalsQueue key: timelockEndTime+proposalID | value: proposalID
+	// This is used to track the passed proposals waiting for their line-item veto timelock to end.
+	TimelockProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64]
+	// LineItemVetoes key: proposalID+messageIndex | value: LineItemVeto
+	LineItemVetoes collections.Map[collections.Pair[uint64, uint32], v1.LineItemVeto]
+	// Schedule key: time+proposalID+eventType
+	// This indexes the upcoming events of all the proposal queues in chronological order.
+	Schedule collections.KeySet[collections.Triple[time.Time, uint64, int32]]
+	// ProposalExecutions key: proposalID | value: ProposalExecution
+	// This is used to track the progress of the passed proposals executed across several blocks.
+	ProposalExecutions collections.Map[uint64, v1.ProposalExecution]
+	// ProposalDraftID is a counter for proposal drafts. It tracks the number of proposal drafts issued, the IDs starting at 1.
+	ProposalDraftID collections.Sequence
+	// ProposalDrafts key: draftID | value: ProposalDraft
+	ProposalDrafts collections.Map[uint64, v1.ProposalDraft]
+	// ProposalDraftVersions key: draftID+version | value: ProposalDraftVersion
+	// This is the immutable audit trail of the content of the proposal drafts.
+	ProposalDraftVersions collections.Map[collections.Pair[uint64, uint64], v1.ProposalDraftVersion]
+	// WhaleAlerts key: proposalID | value: WhaleAlerts
+	WhaleAlerts collections.Map[uint64, v1.WhaleAlerts]
 }
 
 // GetAuthority returns the x/gov module's authority.
@@ -115,25 +145,37 @@ func NewKeeper(
 
 	sb := collections.NewSchemaBuilder(storeService)
 	k := &Keeper{
-		storeService:           storeService,
-		authKeeper:             authKeeper,
-		bankKeeper:             bankKeeper,
-		sk:                     sk,
-		poolKeeper:             pk,
-		cdc:                    cdc,
-		router:                 router,
-		config:                 config,
-		authority:              authority,
-		Constitution:           collections.NewItem(sb, types.ConstitutionKey, "constitution", collections.StringValue),
-		Params:                 collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[v1.Params](cdc)),
-		MessageBasedParams:     collections.NewMap(sb, types.MessageBasedParamsKey, "proposal_messaged_based_params", collections.StringKey, codec.CollValue[v1.MessageBasedParams](cdc)),
-		Deposits:               collections.NewMap(sb, types.DepositsKeyPrefix, "deposits", collections.PairKeyCodec(collections.Uint64Key, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), codec.CollValue[v1.Deposit](cdc)), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
-		Votes:                  collections.NewMap(sb, types.VotesKeyPrefix, "votes", collections.PairKeyCodec(collections.Uint64Key, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), codec.CollValue[v1.Vote](cdc)),          //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
-		ProposalID:             collections.NewSequence(sb, types.ProposalIDKey, "proposal_id"),
-		Proposals:              collections.NewMap(sb, types.ProposalsKeyPrefix, "proposals", collections.Uint64Key, codec.CollValue[v1.Proposal](cdc)),
-		ProposalVoteOptions:    collections.NewMap(sb, types.ProposalVoteOptionsKeyPrefix, "proposal_vote_options", collections.Uint64Key, codec.CollValue[v1.ProposalVoteOptions](cdc)),
-		ActiveProposalsQueue:   collections.NewMap(sb, types.ActiveProposalQueuePrefix, "active_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),     // sdk.TimeKey is needed to retain state compatibility
-		InactiveProposalsQueue: collections.NewMap(sb, types.InactiveProposalQueuePrefix, "inactive_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value), // sdk.TimeKey is needed to retain state compatibility
+		storeService:                  storeService,
+		authKeeper:                    authKeeper,
+		bankKeeper:                    bankKeeper,
+		sk:                            sk,
+		poolKeeper:                    pk,
+		cdc:                           cdc,
+		router:                        router,
+		config:                        config,
+		authority:                     authority,
+		Constitution:                  collections.NewItem(sb, types.ConstitutionKey, "constitution", collections.StringValue),
+		Params:                        collections.NewCachedItem(sb, types.ParamsKey, "params", codec.CollValue[v1.Params](cdc)),
+		MessageBasedParams:            collections.NewMap(sb, types.MessageBasedParamsKey, "proposal_messaged_based_params", collections.StringKey, codec.CollValue[v1.MessageBasedParams](cdc)),
+		Deposits:                      collections.NewMap(sb, types.DepositsKeyPrefix, "deposits", collections.PairKeyCodec(collections.Uint64Key, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), codec.CollValue[v1.Deposit](cdc)), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
+		Votes:                         collections.NewMap(sb, types.VotesKeyPrefix, "votes", collections.PairKeyCodec(collections.Uint64Key, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), codec.CollValue[v1.Vote](cdc)),          //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
+		ProposalID:                    collections.NewSequence(sb, types.ProposalIDKey, "proposal_id"),
+		Proposals:                     collections.NewMap(sb, types.ProposalsKeyPrefix, "proposals", collections.Uint64Key, codec.CollValue[v1.Proposal](cdc)),
+		ProposalVoteOptions:           collections.NewMap(sb, types.ProposalVoteOptionsKeyPrefix, "proposal_vote_options", collections.Uint64Key, codec.CollValue[v1.ProposalVoteOptions](cdc)),
+		ActiveProposalsQueue:          collections.NewMap(sb, types.ActiveProposalQueuePrefix, "active_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),     // sdk.TimeKey is needed to retain state compatibility
+		InactiveProposalsQueue:        collections.NewMap(sb, types.InactiveProposalQueuePrefix, "inactive_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value), // sdk.TimeKey is needed to retain state compatibility
+		SecurityCouncilProposalsQueue: collections.NewMap(sb, types.SecurityCouncilProposalQueuePrefix, "security_council_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),
+		FinishedProposals:             collections.NewKeySet(sb, types.FinishedProposalsKeyPrefix, "finished_proposals", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		ArchivedProposals:             collections.NewMap(sb, types.ArchivedProposalsKeyPrefix, "archived_proposals", collections.Uint64Key, codec.CollValue[v1.ArchivedProposal](cdc)),
+		DepositHistory:                collections.NewMap(sb, types.DepositHistoryKeyPrefix, "deposit_history", collections.PairKeyCodec(sdk.LengthPrefixedAddressKey(sdk.AccAddressKey), collections.Uint64Key), codec.CollValue[v1.DepositRecord](cdc)), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to prefix iterate on the depositor
+		TimelockProposalsQueue:        collections.NewMap(sb, types.TimelockProposalQueuePrefix, "timelock_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),
+		LineItemVetoes:                collections.NewMap(sb, types.LineItemVetoesKeyPrefix, "line_item_vetoes", collections.PairKeyCodec(collections.Uint64Key, collections.Uint32Key), codec.CollValue[v1.LineItemVeto](cdc)),
+		Schedule:                      collections.NewKeySet(sb, types.ScheduleKeyPrefix, "schedule", collections.TripleKeyCodec(sdk.TimeKey, collections.Uint64Key, collections.Int32Key)),
+		ProposalExecutions:            collections.NewMap(sb, types.ProposalExecutionsKeyPrefix, "proposal_executions", collections.Uint64Key, codec.CollValue[v1.ProposalExecution](cdc)),
+		ProposalDraftID:               collections.NewSequence(sb, types.ProposalDraftIDKey, "proposal_draft_id"),
+		ProposalDrafts:                collections.NewMap(sb, types.ProposalDraftsKeyPrefix, "proposal_drafts", collections.Uint64Key, codec.CollValue[v1.ProposalDraft](cdc)),
+		ProposalDraftVersions:         collections.NewMap(sb, types.ProposalDraftVersionsKeyPrefix, "proposal_draft_versions", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), codec.CollValue[v1.ProposalDraftVersion](cdc)),
+		WhaleAlerts:                   collections.NewMap(sb, types.WhaleAlertsKeyPrefix, "whale_alerts", collections.Uint64Key, codec.CollValue[v1.WhaleAlerts](cdc)),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -248,3 +290,13 @@ func (k Keeper) assertSummaryLength(summary string) error {
 	}
 	return nil
 }
+
+// ActiveQueue returns the queue holding the proposals of the given type during their voting period.
+// Security council proposals are tracked in their own queue, all other proposals share the active proposals queue.
+func (k Keeper) ActiveQueue(proposalType v1.ProposalType) collections.Map[collections.Pair[time.Time, uint64], uint64] {
+	if proposalType == v1.ProposalType_PROPOSAL_TYPE_SECURITY_COUNCIL {
+		return k.SecurityCouncilProposalsQueue
+	}
+
+	return k.ActiveProposalsQueue
+}
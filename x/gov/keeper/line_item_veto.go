@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VetoProposalMessages strikes the messages at the given indexes from a proposal waiting for its
+// line-item veto timelock to end. The struck messages are not executed once the timelock ends,
+// while the remaining ones are executed normally.
+func (k Keeper) VetoProposalMessages(ctx context.Context, authority string, proposalID uint64, indexes []uint32, justification string) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.LineItemVeto.IsEnabled() {
+		return types.ErrInvalidLineItemVeto.Wrap("line-item veto is disabled")
+	}
+
+	if params.LineItemVeto.Authority != authority {
+		return types.ErrInvalidSigner.Wrapf("expected %s got %s", params.LineItemVeto.Authority, authority)
+	}
+
+	proposal, err := k.Proposals.Get(ctx, proposalID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ErrInvalidProposal.Wrapf("proposal %d doesn't exist", proposalID)
+		}
+		return err
+	}
+
+	if proposal.Status != v1.StatusTimelock {
+		return types.ErrInvalidLineItemVeto.Wrapf("proposal %d is not in its timelock period", proposalID)
+	}
+
+	if justification == "" {
+		return types.ErrInvalidLineItemVeto.Wrap("justification cannot be empty")
+	}
+
+	if err := k.assertMetadataLength(justification); err != nil {
+		return err
+	}
+
+	if len(indexes) == 0 {
+		return types.ErrInvalidLineItemVeto.Wrap("no message to strike")
+	}
+
+	struck, err := k.GetLineItemVetoes(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[uint32]bool, len(struck)+len(indexes))
+	for _, veto := range struck {
+		seen[veto.MessageIndex] = true
+	}
+
+	for _, idx := range indexes {
+		if int(idx) >= len(proposal.Messages) {
+			return types.ErrInvalidLineItemVeto.Wrapf("message index %d out of range, proposal %d has %d messages", idx, proposalID, len(proposal.Messages))
+		}
+
+		if seen[idx] {
+			return types.ErrInvalidLineItemVeto.Wrapf("message %d of proposal %d is already struck", idx, proposalID)
+		}
+
+		seen[idx] = true
+	}
+
+	// a proposal with all of its messages struck must be rejected by governance instead
+	if len(seen) == len(proposal.Messages) {
+		return types.ErrInvalidLineItemVeto.Wrap("cannot strike all the messages of a proposal")
+	}
+
+	vetoedAt := sdk.UnwrapSDKContext(ctx).HeaderInfo().Time
+	for _, idx := range indexes {
+		veto := v1.LineItemVeto{
+			ProposalId:    proposalID,
+			MessageIndex:  idx,
+			MsgTypeUrl:    proposal.Messages[idx].TypeUrl,
+			Authority:     authority,
+			Justification: justification,
+			VetoedAt:      vetoedAt,
+		}
+
+		if err := k.LineItemVetoes.Set(ctx, collections.Join(proposalID, idx), veto); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetLineItemVetoes returns the messages struck from a proposal.
+func (k Keeper) GetLineItemVetoes(ctx context.Context, proposalID uint64) ([]v1.LineItemVeto, error) {
+	var vetoes []v1.LineItemVeto
+	rng := collections.NewPrefixedPairRange[uint64, uint32](proposalID)
+	err := k.LineItemVetoes.Walk(ctx, rng, func(_ collections.Pair[uint64, uint32], veto v1.LineItemVeto) (bool, error) {
+		vetoes = append(vetoes, veto)
+		return false, nil
+	})
+
+	return vetoes, err
+}
@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+)
+
+func (suite *KeeperTestSuite) TestVetoProposalMessages() {
+	suite.reset()
+	authority, other := suite.addrs[0].String(), suite.addrs[1].String()
+
+	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", suite.addrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	suite.Require().NoError(err)
+	suite.Require().Len(proposal.Messages, 2)
+
+	// the line-item veto is disabled by default
+	err = suite.govKeeper.VetoProposalMessages(suite.ctx, authority, proposal.Id, []uint32{0}, "out of scope")
+	suite.Require().ErrorIs(err, types.ErrInvalidLineItemVeto)
+
+	params, err := suite.govKeeper.Params.Get(suite.ctx)
+	suite.Require().NoError(err)
+	timelock := time.Hour
+	params.LineItemVeto = &v1.LineItemVetoParams{Authority: authority, Timelock: &timelock}
+	suite.Require().NoError(suite.govKeeper.Params.Set(suite.ctx, params))
+
+	// messages can only be struck during the timelock
+	err = suite.govKeeper.VetoProposalMessages(suite.ctx, authority, proposal.Id, []uint32{0}, "out of scope")
+	suite.Require().ErrorIs(err, types.ErrInvalidLineItemVeto)
+
+	timelockEndTime := suite.ctx.HeaderInfo().Time.Add(timelock)
+	proposal.Status = v1.StatusTimelock
+	proposal.TimelockEndTime = &timelockEndTime
+	suite.Require().NoError(suite.govKeeper.Proposals.Set(suite.ctx, proposal.Id, proposal))
+	suite.Require().NoError(suite.govKeeper.TimelockProposalsQueue.Set(suite.ctx, collections.Join(timelockEndTime, proposal.Id), proposal.Id))
+
+	testCases := []struct {
+		name          string
+		authority     string
+		proposalID    uint64
+		indexes       []uint32
+		justification string
+		expErr        error
+	}{
+		{"not the authority", other, proposal.Id, []uint32{0}, "out of scope", types.ErrInvalidSigner},
+		{"unknown proposal", authority, 100, []uint32{0}, "out of scope", types.ErrInvalidProposal},
+		{"empty justification", authority, proposal.Id, []uint32{0}, "", types.ErrInvalidLineItemVeto},
+		{"no index", authority, proposal.Id, nil, "out of scope", types.ErrInvalidLineItemVeto},
+		{"index out of range", authority, proposal.Id, []uint32{2}, "out of scope", types.ErrInvalidLineItemVeto},
+		{"duplicate index", authority, proposal.Id, []uint32{0, 0}, "out of scope", types.ErrInvalidLineItemVeto},
+		{"all messages", authority, proposal.Id, []uint32{0, 1}, "out of scope", types.ErrInvalidLineItemVeto},
+		{"valid", authority, proposal.Id, []uint32{0}, "out of scope", nil},
+		{"already struck", authority, proposal.Id, []uint32{0}, "out of scope", types.ErrInvalidLineItemVeto},
+		{"remaining message", authority, proposal.Id, []uint32{1}, "out of scope", types.ErrInvalidLineItemVeto},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.VetoProposalMessages(suite.ctx, &v1.MsgVetoProposalMessages{
+				Authority:      tc.authority,
+				ProposalId:     tc.proposalID,
+				MessageIndexes: tc.indexes,
+				Justification:  tc.justification,
+			})
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+		})
+	}
+
+	vetoes, err := suite.govKeeper.GetLineItemVetoes(suite.ctx, proposal.Id)
+	suite.Require().NoError(err)
+	suite.Require().Len(vetoes, 1)
+	suite.Require().Equal(uint32(0), vetoes[0].MessageIndex)
+	suite.Require().Equal(proposal.Messages[0].TypeUrl, vetoes[0].MsgTypeUrl)
+	suite.Require().Equal(authority, vetoes[0].Authority)
+	suite.Require().Equal("out of scope", vetoes[0].Justification)
+
+	res, err := suite.queryClient.LineItemVetoes(suite.ctx, &v1.QueryLineItemVetoesRequest{ProposalId: proposal.Id})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Vetoes, 1)
+
+	res, err = suite.queryClient.LineItemVetoes(suite.ctx, &v1.QueryLineItemVetoesRequest{ProposalId: 100})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Vetoes)
+
+	res, err = suite.queryClient.LineItemVetoes(suite.ctx, &v1.QueryLineItemVetoesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Vetoes, 1)
+
+	// deleting the proposal removes it from the timelock queue
+	suite.Require().NoError(suite.govKeeper.DeleteProposal(suite.ctx, proposal.Id))
+	has, err := suite.govKeeper.TimelockProposalsQueue.Has(suite.ctx, collections.Join(timelockEndTime, proposal.Id))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	govtypes "cosmossdk.io/x/gov/types"
@@ -76,19 +77,45 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 	if msg.Expedited { // checking for backward compatibility
 		msg.ProposalType = v1.ProposalType_PROPOSAL_TYPE_EXPEDITED
 	}
-	if err := k.validateInitialDeposit(ctx, params, msg.GetInitialDeposit(), msg.ProposalType); err != nil {
-		return nil, err
+	// security council proposals skip the deposit period, so no initial deposit is required
+	if msg.ProposalType != v1.ProposalType_PROPOSAL_TYPE_SECURITY_COUNCIL {
+		if err := k.validateInitialDeposit(ctx, params, msg.GetInitialDeposit(), msg.ProposalType); err != nil {
+			return nil, err
+		}
 	}
 
 	if err := k.validateDepositDenom(ctx, params, msg.GetInitialDeposit()); err != nil {
 		return nil, err
 	}
 
+	if msg.ChunkedExecution && params.ExecutionGasPerBlock == 0 {
+		return nil, errors.Wrap(govtypes.ErrChunkedExecution, "the execution gas per block param is not set")
+	}
+
+	if msg.VoteLockoutPeriod != nil {
+		votingPeriod, err := k.votingPeriod(ctx, v1.Proposal{Messages: msg.Messages, ProposalType: msg.ProposalType})
+		if err != nil {
+			return nil, err
+		}
+
+		if *msg.VoteLockoutPeriod <= 0 || *msg.VoteLockoutPeriod >= *votingPeriod {
+			return nil, errors.Wrapf(govtypes.ErrInvalidProposal, "vote lockout period %s must be positive and shorter than the voting period %s", msg.VoteLockoutPeriod, votingPeriod)
+		}
+	}
+
 	proposal, err := k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer, msg.ProposalType)
 	if err != nil {
 		return nil, err
 	}
 
+	if msg.ChunkedExecution || msg.VoteLockoutPeriod != nil {
+		proposal.ChunkedExecution = msg.ChunkedExecution
+		proposal.VoteLockoutPeriod = msg.VoteLockoutPeriod
+		if err := k.Proposals.Set(ctx, proposal.Id, proposal); err != nil {
+			return nil, err
+		}
+	}
+
 	bytes, err := proposal.Marshal()
 	if err != nil {
 		return nil, err
@@ -100,9 +127,20 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 		"submit proposal",
 	)
 
-	votingStarted, err := k.Keeper.AddDeposit(ctx, proposal.Id, proposer, msg.GetInitialDeposit())
-	if err != nil {
-		return nil, err
+	var votingStarted bool
+	if msg.ProposalType == v1.ProposalType_PROPOSAL_TYPE_SECURITY_COUNCIL {
+		// the voting period of security council proposals starts at submission
+		votingStarted = true
+		if !msg.GetInitialDeposit().IsZero() {
+			if _, err := k.Keeper.AddDeposit(ctx, proposal.Id, proposer, msg.GetInitialDeposit()); err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		votingStarted, err = k.Keeper.AddDeposit(ctx, proposal.Id, proposer, msg.GetInitialDeposit())
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if votingStarted {
@@ -121,12 +159,13 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 // SubmitMultipleChoiceProposal implements the MsgServer.SubmitMultipleChoiceProposal method.
 func (k msgServer) SubmitMultipleChoiceProposal(ctx context.Context, msg *v1.MsgSubmitMultipleChoiceProposal) (*v1.MsgSubmitMultipleChoiceProposalResponse, error) {
 	resp, err := k.SubmitProposal(ctx, &v1.MsgSubmitProposal{
-		InitialDeposit: msg.InitialDeposit,
-		Proposer:       msg.Proposer,
-		Title:          msg.Title,
-		Summary:        msg.Summary,
-		Metadata:       msg.Metadata,
-		ProposalType:   v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE,
+		InitialDeposit:    msg.InitialDeposit,
+		Proposer:          msg.Proposer,
+		Title:             msg.Title,
+		Summary:           msg.Summary,
+		Metadata:          msg.Metadata,
+		ProposalType:      v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE,
+		VoteLockoutPeriod: msg.VoteLockoutPeriod,
 	})
 	if err != nil {
 		return nil, err
@@ -184,6 +223,139 @@ func (k msgServer) CancelProposal(goCtx context.Context, msg *v1.MsgCancelPropos
 	}, nil
 }
 
+// VetoProposalMessages implements the MsgServer.VetoProposalMessages method.
+func (k msgServer) VetoProposalMessages(goCtx context.Context, msg *v1.MsgVetoProposalMessages) (*v1.MsgVetoProposalMessagesResponse, error) {
+	if _, err := k.authKeeper.AddressCodec().StringToBytes(msg.Authority); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.VetoProposalMessages(ctx, msg.Authority, msg.ProposalId, msg.MessageIndexes, msg.Justification); err != nil {
+		return nil, err
+	}
+
+	for _, idx := range msg.MessageIndexes {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				govtypes.EventTypeLineItemVeto,
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+				sdk.NewAttribute(govtypes.AttributeKeyProposalID, fmt.Sprint(msg.ProposalId)),
+				sdk.NewAttribute(govtypes.AttributeKeyMessageIndex, fmt.Sprint(idx)),
+			),
+		)
+	}
+
+	return &v1.MsgVetoProposalMessagesResponse{}, nil
+}
+
+// CreateProposalDraft implements the MsgServer.CreateProposalDraft method.
+func (k msgServer) CreateProposalDraft(goCtx context.Context, msg *v1.MsgCreateProposalDraft) (*v1.MsgCreateProposalDraftResponse, error) {
+	if _, err := k.authKeeper.AddressCodec().StringToBytes(msg.Owner); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	draftID, err := k.Keeper.CreateProposalDraft(ctx, msg.Owner, v1.ProposalDraftVersion{
+		Messages:     msg.Messages,
+		Metadata:     msg.Metadata,
+		Title:        msg.Title,
+		Summary:      msg.Summary,
+		ProposalType: msg.ProposalType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			govtypes.EventTypeCreateDraft,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner),
+			sdk.NewAttribute(govtypes.AttributeKeyDraftID, fmt.Sprint(draftID)),
+		),
+	)
+
+	return &v1.MsgCreateProposalDraftResponse{DraftId: draftID}, nil
+}
+
+// UpdateProposalDraft implements the MsgServer.UpdateProposalDraft method.
+func (k msgServer) UpdateProposalDraft(goCtx context.Context, msg *v1.MsgUpdateProposalDraft) (*v1.MsgUpdateProposalDraftResponse, error) {
+	if _, err := k.authKeeper.AddressCodec().StringToBytes(msg.Owner); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	version, err := k.Keeper.UpdateProposalDraft(ctx, msg.Owner, msg.DraftId, v1.ProposalDraftVersion{
+		Messages:     msg.Messages,
+		Metadata:     msg.Metadata,
+		Title:        msg.Title,
+		Summary:      msg.Summary,
+		ProposalType: msg.ProposalType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			govtypes.EventTypeUpdateDraft,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner),
+			sdk.NewAttribute(govtypes.AttributeKeyDraftID, fmt.Sprint(msg.DraftId)),
+			sdk.NewAttribute(govtypes.AttributeKeyDraftVersion, fmt.Sprint(version)),
+		),
+	)
+
+	return &v1.MsgUpdateProposalDraftResponse{Version: version}, nil
+}
+
+// PromoteProposalDraft implements the MsgServer.PromoteProposalDraft method.
+// The latest version of the draft is submitted as a proposal with the same checks as MsgSubmitProposal.
+func (k msgServer) PromoteProposalDraft(goCtx context.Context, msg *v1.MsgPromoteProposalDraft) (*v1.MsgPromoteProposalDraftResponse, error) {
+	if _, err := k.authKeeper.AddressCodec().StringToBytes(msg.Owner); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	draft, err := k.getOwnedProposalDraft(ctx, msg.Owner, msg.DraftId)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := k.ProposalDraftVersions.Get(ctx, collections.Join(draft.Id, draft.LatestVersion))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := k.SubmitProposal(ctx, &v1.MsgSubmitProposal{
+		Messages:       version.Messages,
+		InitialDeposit: msg.InitialDeposit,
+		Proposer:       msg.Owner,
+		Metadata:       version.Metadata,
+		Title:          version.Title,
+		Summary:        version.Summary,
+		ProposalType:   version.ProposalType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	draft.ProposalId = resp.ProposalId
+	if err := k.ProposalDrafts.Set(ctx, draft.Id, draft); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			govtypes.EventTypePromoteDraft,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner),
+			sdk.NewAttribute(govtypes.AttributeKeyDraftID, fmt.Sprint(draft.Id)),
+			sdk.NewAttribute(govtypes.AttributeKeyDraftVersion, fmt.Sprint(draft.LatestVersion)),
+			sdk.NewAttribute(govtypes.AttributeKeyProposalID, fmt.Sprint(resp.ProposalId)),
+		),
+	)
+
+	return &v1.MsgPromoteProposalDraftResponse{ProposalId: resp.ProposalId}, nil
+}
+
 // ExecLegacyContent implements the MsgServer.ExecLegacyContent method.
 func (k msgServer) ExecLegacyContent(goCtx context.Context, msg *v1.MsgExecLegacyContent) (*v1.MsgExecLegacyContentResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -225,6 +397,10 @@ func (k msgServer) Vote(ctx context.Context, msg *v1.MsgVote) (*v1.MsgVoteRespon
 		return nil, errors.Wrap(govtypes.ErrInvalidVote, msg.Option.String())
 	}
 
+	if err := k.checkVoteLockout(ctx, msg.ProposalId, accAddr); err != nil {
+		return nil, err
+	}
+
 	if err = k.Keeper.AddVote(ctx, msg.ProposalId, accAddr, v1.NewNonSplitVoteOption(msg.Option), msg.Metadata); err != nil {
 		return nil, err
 	}
@@ -268,6 +444,10 @@ func (k msgServer) VoteWeighted(ctx context.Context, msg *v1.MsgVoteWeighted) (*
 		return nil, errors.Wrap(govtypes.ErrInvalidVote, "total weight lower than 1.00")
 	}
 
+	if err := k.checkVoteLockout(ctx, msg.ProposalId, accAddr); err != nil {
+		return nil, err
+	}
+
 	err := k.Keeper.AddVote(ctx, msg.ProposalId, accAddr, msg.Options, msg.Metadata)
 	if err != nil {
 		return nil, err
@@ -276,6 +456,58 @@ func (k msgServer) VoteWeighted(ctx context.Context, msg *v1.MsgVoteWeighted) (*
 	return &v1.MsgVoteWeightedResponse{}, nil
 }
 
+// VoteRanked implements the MsgServer.VoteRanked method.
+func (k msgServer) VoteRanked(ctx context.Context, msg *v1.MsgVoteRanked) (*v1.MsgVoteRankedResponse, error) {
+	accAddr, err := k.authKeeper.AddressCodec().StringToBytes(msg.Voter)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
+	}
+
+	if err := k.checkVoteLockout(ctx, msg.ProposalId, accAddr); err != nil {
+		return nil, err
+	}
+
+	if err = k.Keeper.AddRankedVote(ctx, msg.ProposalId, accAddr, msg.Ranking, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgVoteRankedResponse{}, nil
+}
+
+// checkVoteLockout returns an error if the voter has already voted on the
+// proposal and the vote lockout period of the proposal has started, so that
+// the vote cannot be changed anymore.
+func (k msgServer) checkVoteLockout(ctx context.Context, proposalID uint64, voter sdk.AccAddress) error {
+	proposal, err := k.Proposals.Get(ctx, proposalID)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			// the unknown proposal is reported when adding the vote
+			return nil
+		}
+		return err
+	}
+
+	if proposal.VoteLockoutPeriod == nil || proposal.VotingEndTime == nil {
+		return nil
+	}
+
+	lockoutStart := proposal.VotingEndTime.Add(-*proposal.VoteLockoutPeriod)
+	if sdk.UnwrapSDKContext(ctx).HeaderInfo().Time.Before(lockoutStart) {
+		return nil
+	}
+
+	voted, err := k.Votes.Has(ctx, collections.Join(proposalID, voter))
+	if err != nil {
+		return err
+	}
+
+	if voted {
+		return errors.Wrapf(govtypes.ErrVoteLocked, "votes on proposal %d cannot be changed since %s", proposalID, lockoutStart)
+	}
+
+	return nil
+}
+
 // Deposit implements the MsgServer.Deposit method.
 func (k msgServer) Deposit(goCtx context.Context, msg *v1.MsgDeposit) (*v1.MsgDepositResponse, error) {
 	accAddr, err := k.authKeeper.AddressCodec().StringToBytes(msg.Depositor)
@@ -1840,6 +1840,90 @@ func (suite *KeeperTestSuite) TestMsgUpdateParams() {
 			expErr:    true,
 			expErrMsg: "voting period must be positive",
 		},
+		{
+			name: "invalid security council policy address",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.SecurityCouncil = &v1.SecurityCouncilParams{
+					PolicyAddress: "invalid",
+					VotingPeriod:  params.SecurityCouncil.VotingPeriod,
+				}
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "invalid security council policy address",
+		},
+		{
+			name: "invalid line-item veto authority",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.LineItemVeto = &v1.LineItemVetoParams{
+					Authority: "invalid",
+					Timelock:  params.LineItemVeto.Timelock,
+				}
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "invalid line-item veto authority",
+		},
+		{
+			name: "line-item veto timelock not positive",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				timelock := time.Duration(0)
+				params1.LineItemVeto = &v1.LineItemVetoParams{
+					Authority: authority,
+					Timelock:  &timelock,
+				}
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "line-item veto timelock must be positive",
+		},
+		{
+			name: "emergency threshold is not a supermajority",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.Emergency = &v1.EmergencyParams{
+					AllowedMessages: []string{sdk.MsgTypeURL(&v1.MsgExecLegacyContent{})},
+					VotingPeriod:    params.Emergency.VotingPeriod,
+					Threshold:       "0.5",
+				}
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "emergency vote threshold must be a supermajority",
+		},
+		{
+			name: "deposit burn ratio too large",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.DepositBurnRatio = "1.1"
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "deposit burn ratio is too large",
+		},
 	}
 
 	for _, tc := range testCases {
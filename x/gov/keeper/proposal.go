@@ -36,6 +36,29 @@ func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata
 		if len(messages) > 0 { // cannot happen, except when the proposal is created via keeper call instead of message server.
 			return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposalMsg, "multiple choice proposal should not contain any messages")
 		}
+	case v1.ProposalType_PROPOSAL_TYPE_SECURITY_COUNCIL:
+		if !params.SecurityCouncil.IsEnabled() {
+			return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposalType, "security council proposals are disabled")
+		}
+
+		proposerStr, _ := k.authKeeper.AddressCodec().BytesToString(proposer)
+		if proposerStr != params.SecurityCouncil.PolicyAddress {
+			return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposer, "proposer is not the security council")
+		}
+	case v1.ProposalType_PROPOSAL_TYPE_EMERGENCY:
+		if !params.Emergency.IsEnabled() {
+			return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposalType, "emergency proposals are disabled")
+		}
+
+		if len(messages) == 0 {
+			return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposalMsg, "emergency proposal must contain at least one message")
+		}
+
+		for _, msg := range messages {
+			if !params.Emergency.IsMessageAllowed(sdk.MsgTypeURL(msg)) {
+				return v1.Proposal{}, errorsmod.Wrapf(types.ErrInvalidProposalMsg, "message %s is not allowed in emergency proposals", sdk.MsgTypeURL(msg))
+			}
+		}
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -126,6 +149,10 @@ func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata
 	if err != nil {
 		return v1.Proposal{}, err
 	}
+	err = k.SetScheduleEntry(ctx, v1.ScheduleEventType_SCHEDULE_EVENT_TYPE_DEPOSIT_END, *proposal.DepositEndTime, proposalID)
+	if err != nil {
+		return v1.Proposal{}, err
+	}
 
 	// called right after a proposal is submitted
 	err = k.Hooks().AfterProposalSubmission(ctx, proposalID)
@@ -133,6 +160,18 @@ func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata
 		return v1.Proposal{}, err
 	}
 
+	// security council proposals do not have a deposit period and enter the voting period right away
+	if proposalType == v1.ProposalType_PROPOSAL_TYPE_SECURITY_COUNCIL {
+		if err := k.ActivateVotingPeriod(ctx, proposal); err != nil {
+			return v1.Proposal{}, err
+		}
+
+		proposal, err = k.Proposals.Get(ctx, proposalID)
+		if err != nil {
+			return v1.Proposal{}, err
+		}
+	}
+
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeSubmitProposal,
@@ -230,9 +269,27 @@ func (k Keeper) DeleteProposal(ctx context.Context, proposalID uint64) error {
 		if err != nil {
 			return err
 		}
+		err = k.RemoveScheduleEntry(ctx, v1.ScheduleEventType_SCHEDULE_EVENT_TYPE_DEPOSIT_END, *proposal.DepositEndTime, proposalID)
+		if err != nil {
+			return err
+		}
 	}
 	if proposal.VotingEndTime != nil {
-		err := k.ActiveProposalsQueue.Remove(ctx, collections.Join(*proposal.VotingEndTime, proposalID))
+		err := k.ActiveQueue(proposal.ProposalType).Remove(ctx, collections.Join(*proposal.VotingEndTime, proposalID))
+		if err != nil {
+			return err
+		}
+		err = k.RemoveScheduleEntry(ctx, v1.ScheduleEventType_SCHEDULE_EVENT_TYPE_VOTING_END, *proposal.VotingEndTime, proposalID)
+		if err != nil {
+			return err
+		}
+	}
+	if proposal.TimelockEndTime != nil {
+		err := k.TimelockProposalsQueue.Remove(ctx, collections.Join(*proposal.TimelockEndTime, proposalID))
+		if err != nil {
+			return err
+		}
+		err = k.RemoveScheduleEntry(ctx, v1.ScheduleEventType_SCHEDULE_EVENT_TYPE_EXECUTION, *proposal.TimelockEndTime, proposalID)
 		if err != nil {
 			return err
 		}
@@ -241,35 +298,47 @@ func (k Keeper) DeleteProposal(ctx context.Context, proposalID uint64) error {
 	return k.Proposals.Remove(ctx, proposalID)
 }
 
+// votingPeriod returns the voting period of a proposal, depending on its type
+// and on the message based params of its first message.
+func (k Keeper) votingPeriod(ctx context.Context, proposal v1.Proposal) (*time.Duration, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	switch proposal.ProposalType {
+	case v1.ProposalType_PROPOSAL_TYPE_EXPEDITED:
+		return params.ExpeditedVotingPeriod, nil
+	case v1.ProposalType_PROPOSAL_TYPE_SECURITY_COUNCIL:
+		return params.SecurityCouncil.VotingPeriod, nil
+	case v1.ProposalType_PROPOSAL_TYPE_EMERGENCY:
+		return params.Emergency.VotingPeriod, nil
+	}
+
+	if len(proposal.Messages) > 0 {
+		// check if any of the message has message based params
+		customMessageParams, err := k.MessageBasedParams.Get(ctx, sdk.MsgTypeURL(proposal.Messages[0]))
+		if err == nil {
+			return customMessageParams.VotingPeriod, nil
+		} else if !errors.Is(err, collections.ErrNotFound) {
+			return nil, err
+		}
+	}
+
+	return params.VotingPeriod, nil
+}
+
 // ActivateVotingPeriod activates the voting period of a proposal
 func (k Keeper) ActivateVotingPeriod(ctx context.Context, proposal v1.Proposal) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	startTime := sdkCtx.HeaderInfo().Time
 	proposal.VotingStartTime = &startTime
 
-	params, err := k.Params.Get(ctx)
+	votingPeriod, err := k.votingPeriod(ctx, proposal)
 	if err != nil {
 		return err
 	}
 
-	var votingPeriod *time.Duration
-	switch proposal.ProposalType {
-	case v1.ProposalType_PROPOSAL_TYPE_EXPEDITED:
-		votingPeriod = params.ExpeditedVotingPeriod
-	default:
-		votingPeriod = params.VotingPeriod
-
-		if len(proposal.Messages) > 0 {
-			// check if any of the message has message based params
-			customMessageParams, err := k.MessageBasedParams.Get(ctx, sdk.MsgTypeURL(proposal.Messages[0]))
-			if err == nil {
-				votingPeriod = customMessageParams.VotingPeriod
-			} else if err != nil && !errors.Is(err, collections.ErrNotFound) {
-				return err
-			}
-		}
-	}
-
 	endTime := proposal.VotingStartTime.Add(*votingPeriod)
 	proposal.VotingEndTime = &endTime
 	proposal.Status = v1.StatusVotingPeriod
@@ -281,5 +350,94 @@ func (k Keeper) ActivateVotingPeriod(ctx context.Context, proposal v1.Proposal)
 		return err
 	}
 
-	return k.ActiveProposalsQueue.Set(ctx, collections.Join(*proposal.VotingEndTime, proposal.Id), proposal.Id)
+	if err = k.RemoveScheduleEntry(ctx, v1.ScheduleEventType_SCHEDULE_EVENT_TYPE_DEPOSIT_END, *proposal.DepositEndTime, proposal.Id); err != nil {
+		return err
+	}
+
+	if err = k.ActiveQueue(proposal.ProposalType).Set(ctx, collections.Join(*proposal.VotingEndTime, proposal.Id), proposal.Id); err != nil {
+		return err
+	}
+
+	return k.SetScheduleEntry(ctx, v1.ScheduleEventType_SCHEDULE_EVENT_TYPE_VOTING_END, *proposal.VotingEndTime, proposal.Id)
+}
+
+// ArchiveProposals replaces the proposals that have been finished for more than the
+// configured retention by a compacted summary, and prunes their votes and deposits.
+func (k Keeper) ArchiveProposals(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.ProposalArchiveRetention == 0 {
+		return nil
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).HeaderInfo().Height
+	cutoff := height - int64(params.ProposalArchiveRetention)
+	if cutoff < 0 {
+		return nil
+	}
+
+	var toArchive []collections.Pair[int64, uint64]
+	rng := new(collections.Range[collections.Pair[int64, uint64]]).EndExclusive(collections.Join(cutoff+1, uint64(0)))
+	err = k.FinishedProposals.Walk(ctx, rng, func(key collections.Pair[int64, uint64]) (bool, error) {
+		toArchive = append(toArchive, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range toArchive {
+		if err := k.archiveProposal(ctx, key.K2(), key.K1()); err != nil {
+			return err
+		}
+
+		if err := k.FinishedProposals.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// archiveProposal stores the summary of a finished proposal and deletes the proposal along with its votes and deposits.
+func (k Keeper) archiveProposal(ctx context.Context, proposalID uint64, finishedHeight int64) error {
+	proposal, err := k.Proposals.Get(ctx, proposalID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			// the proposal has already been pruned, nothing to archive
+			return nil
+		}
+
+		return err
+	}
+
+	archived := v1.ArchivedProposal{
+		Id:               proposal.Id,
+		Title:            proposal.Title,
+		ProposalType:     proposal.ProposalType,
+		Status:           proposal.Status,
+		FinalTallyResult: proposal.FinalTallyResult,
+		VotingEndTime:    proposal.VotingEndTime,
+		FinishedHeight:   finishedHeight,
+	}
+	if err := k.ArchivedProposals.Set(ctx, proposal.Id, archived); err != nil {
+		return err
+	}
+
+	if err := k.deleteVotes(ctx, proposal.Id); err != nil {
+		return err
+	}
+
+	if err := k.Deposits.Clear(ctx, collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id)); err != nil {
+		return err
+	}
+
+	if err := k.ProposalVoteOptions.Remove(ctx, proposal.Id); err != nil {
+		return err
+	}
+
+	return k.Proposals.Remove(ctx, proposal.Id)
 }
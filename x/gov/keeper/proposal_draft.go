@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CreateProposalDraft creates a proposal draft owned by the given address and records the given content
+// as its first version. Drafts are not voted on, so their messages are only validated once promoted.
+func (k Keeper) CreateProposalDraft(ctx context.Context, owner string, content v1.ProposalDraftVersion) (uint64, error) {
+	if err := k.validateProposalDraftContent(content); err != nil {
+		return 0, err
+	}
+
+	issued, err := k.ProposalDraftID.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	draftID := issued + 1
+	draft := v1.ProposalDraft{
+		Id:        draftID,
+		Owner:     owner,
+		CreatedAt: sdk.UnwrapSDKContext(ctx).HeaderInfo().Time,
+	}
+
+	if _, err := k.addProposalDraftVersion(ctx, draft, content); err != nil {
+		return 0, err
+	}
+
+	return draftID, nil
+}
+
+// UpdateProposalDraft records the given content as the new latest version of a proposal draft which has not
+// been promoted yet, and returns its version number. The previous versions are kept as an audit trail.
+func (k Keeper) UpdateProposalDraft(ctx context.Context, owner string, draftID uint64, content v1.ProposalDraftVersion) (uint64, error) {
+	draft, err := k.getOwnedProposalDraft(ctx, owner, draftID)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := k.validateProposalDraftContent(content); err != nil {
+		return 0, err
+	}
+
+	return k.addProposalDraftVersion(ctx, draft, content)
+}
+
+// GetProposalDraft returns a proposal draft along with the content of its latest version.
+func (k Keeper) GetProposalDraft(ctx context.Context, draftID uint64) (v1.ProposalDraft, v1.ProposalDraftVersion, error) {
+	draft, err := k.ProposalDrafts.Get(ctx, draftID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return v1.ProposalDraft{}, v1.ProposalDraftVersion{}, types.ErrInvalidProposalDraft.Wrapf("proposal draft %d doesn't exist", draftID)
+		}
+		return v1.ProposalDraft{}, v1.ProposalDraftVersion{}, err
+	}
+
+	version, err := k.ProposalDraftVersions.Get(ctx, collections.Join(draftID, draft.LatestVersion))
+	if err != nil {
+		return v1.ProposalDraft{}, v1.ProposalDraftVersion{}, err
+	}
+
+	return draft, version, nil
+}
+
+// validateProposalDraftContent checks the content of a proposal draft version.
+func (k Keeper) validateProposalDraftContent(content v1.ProposalDraftVersion) error {
+	// the vote options of multiple choice proposals are not part of the drafts
+	if content.ProposalType == v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE {
+		return types.ErrInvalidProposalType.Wrap("multiple choice proposals cannot be drafted")
+	}
+
+	return k.validateProposalLengths(content.Metadata, content.Title, content.Summary)
+}
+
+// getOwnedProposalDraft returns a proposal draft which can still be modified by the given owner.
+func (k Keeper) getOwnedProposalDraft(ctx context.Context, owner string, draftID uint64) (v1.ProposalDraft, error) {
+	draft, err := k.ProposalDrafts.Get(ctx, draftID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return v1.ProposalDraft{}, types.ErrInvalidProposalDraft.Wrapf("proposal draft %d doesn't exist", draftID)
+		}
+		return v1.ProposalDraft{}, err
+	}
+
+	if draft.Owner != owner {
+		return v1.ProposalDraft{}, types.ErrInvalidProposalDraft.Wrapf("%s is not the owner of proposal draft %d", owner, draftID)
+	}
+
+	if draft.ProposalId != 0 {
+		return v1.ProposalDraft{}, types.ErrInvalidProposalDraft.Wrapf("proposal draft %d is already promoted to proposal %d", draftID, draft.ProposalId)
+	}
+
+	return draft, nil
+}
+
+// addProposalDraftVersion records the content as the next version of the draft.
+func (k Keeper) addProposalDraftVersion(ctx context.Context, draft v1.ProposalDraft, content v1.ProposalDraftVersion) (uint64, error) {
+	draft.LatestVersion++
+	content.DraftId = draft.Id
+	content.Version = draft.LatestVersion
+	content.CreatedAt = sdk.UnwrapSDKContext(ctx).HeaderInfo().Time
+
+	if err := k.ProposalDraftVersions.Set(ctx, collections.Join(draft.Id, content.Version), content); err != nil {
+		return 0, err
+	}
+
+	if err := k.ProposalDrafts.Set(ctx, draft.Id, draft); err != nil {
+		return 0, err
+	}
+
+	return content.Version, nil
+}
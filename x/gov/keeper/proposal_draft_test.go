@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) TestProposalDrafts() {
+	suite.reset()
+	owner, other := suite.addrs[0].String(), suite.addrs[1].String()
+
+	msg, err := v1.NewMsgCreateProposalDraft(TestProposal, owner, "", "first title", "first summary", v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	suite.Require().NoError(err)
+
+	// the vote options of multiple choice proposals cannot be drafted
+	msg.ProposalType = v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE
+	_, err = suite.msgSrvr.CreateProposalDraft(suite.ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidProposalType)
+
+	msg.ProposalType = v1.ProposalType_PROPOSAL_TYPE_STANDARD
+	msg.Title = ""
+	_, err = suite.msgSrvr.CreateProposalDraft(suite.ctx, msg)
+	suite.Require().ErrorContains(err, "proposal title cannot be empty")
+
+	msg.Title = "first title"
+	res, err := suite.msgSrvr.CreateProposalDraft(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.DraftId)
+
+	update, err := v1.NewMsgUpdateProposalDraft(res.DraftId, TestProposal[:1], owner, "", "second title", "second summary", v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name   string
+		owner  string
+		id     uint64
+		expErr error
+	}{
+		{"not the owner", other, res.DraftId, types.ErrInvalidProposalDraft},
+		{"unknown draft", owner, 100, types.ErrInvalidProposalDraft},
+		{"valid", owner, res.DraftId, nil},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			update.Owner, update.DraftId = tc.owner, tc.id
+			updateRes, err := suite.msgSrvr.UpdateProposalDraft(suite.ctx, update)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(uint64(2), updateRes.Version)
+		})
+	}
+
+	draftRes, err := suite.queryClient.ProposalDraft(suite.ctx, &v1.QueryProposalDraftRequest{DraftId: res.DraftId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(owner, draftRes.Draft.Owner)
+	suite.Require().Equal(uint64(2), draftRes.Draft.LatestVersion)
+	suite.Require().Equal("second title", draftRes.LatestVersion.Title)
+	suite.Require().Len(draftRes.LatestVersion.Messages, 1)
+
+	_, err = suite.queryClient.ProposalDraft(suite.ctx, &v1.QueryProposalDraftRequest{DraftId: 100})
+	suite.Require().ErrorContains(err, "proposal draft 100 doesn't exist")
+
+	// the previous versions are kept
+	versionsRes, err := suite.queryClient.ProposalDraftVersions(suite.ctx, &v1.QueryProposalDraftVersionsRequest{DraftId: res.DraftId})
+	suite.Require().NoError(err)
+	suite.Require().Len(versionsRes.Versions, 2)
+	suite.Require().Equal("first title", versionsRes.Versions[0].Title)
+	suite.Require().Len(versionsRes.Versions[0].Messages, len(TestProposal))
+	suite.Require().Equal(uint64(2), versionsRes.Versions[1].Version)
+
+	params, err := suite.govKeeper.Params.Get(suite.ctx)
+	suite.Require().NoError(err)
+
+	_, err = suite.msgSrvr.PromoteProposalDraft(suite.ctx, v1.NewMsgPromoteProposalDraft(res.DraftId, other, params.MinDeposit))
+	suite.Require().ErrorIs(err, types.ErrInvalidProposalDraft)
+
+	promoteRes, err := suite.msgSrvr.PromoteProposalDraft(suite.ctx, v1.NewMsgPromoteProposalDraft(res.DraftId, owner, params.MinDeposit))
+	suite.Require().NoError(err)
+
+	// the latest version is submitted as a proposal in its voting period
+	proposal, err := suite.govKeeper.Proposals.Get(suite.ctx, promoteRes.ProposalId)
+	suite.Require().NoError(err)
+	suite.Require().Equal("second title", proposal.Title)
+	suite.Require().Equal(owner, proposal.Proposer)
+	suite.Require().Equal(v1.StatusVotingPeriod, proposal.Status)
+	suite.Require().Equal(sdk.NewCoins(params.MinDeposit...), sdk.NewCoins(proposal.TotalDeposit...))
+
+	// a promoted draft cannot be updated nor promoted again
+	update.Owner, update.DraftId = owner, res.DraftId
+	_, err = suite.msgSrvr.UpdateProposalDraft(suite.ctx, update)
+	suite.Require().ErrorIs(err, types.ErrInvalidProposalDraft)
+	_, err = suite.msgSrvr.PromoteProposalDraft(suite.ctx, v1.NewMsgPromoteProposalDraft(res.DraftId, owner, params.MinDeposit))
+	suite.Require().ErrorIs(err, types.ErrInvalidProposalDraft)
+
+	draft, _, err := suite.govKeeper.GetProposalDraft(suite.ctx, res.DraftId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(promoteRes.ProposalId, draft.ProposalId)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/x/gov/types"
 	v1 "cosmossdk.io/x/gov/types/v1"
 	"cosmossdk.io/x/gov/types/v1beta1"
@@ -328,3 +329,115 @@ func TestMigrateProposalMessages(t *testing.T) {
 	require.Equal(t, "Test", content.GetTitle())
 	require.Equal(t, "description", content.GetDescription())
 }
+
+func (suite *KeeperTestSuite) TestSecurityCouncilProposal() {
+	suite.reset()
+	council, other := suite.addrs[0], suite.addrs[1]
+
+	// the security council track is disabled by default
+	_, err := suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", council, v1.ProposalType_PROPOSAL_TYPE_SECURITY_COUNCIL)
+	suite.Require().ErrorIs(err, types.ErrInvalidProposalType)
+
+	params, err := suite.govKeeper.Params.Get(suite.ctx)
+	suite.Require().NoError(err)
+	params.SecurityCouncil.PolicyAddress = council.String()
+	suite.Require().NoError(suite.govKeeper.Params.Set(suite.ctx, params))
+
+	_, err = suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", other, v1.ProposalType_PROPOSAL_TYPE_SECURITY_COUNCIL)
+	suite.Require().ErrorIs(err, types.ErrInvalidProposer)
+
+	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", council, v1.ProposalType_PROPOSAL_TYPE_SECURITY_COUNCIL)
+	suite.Require().NoError(err)
+	suite.Require().Equal(v1.StatusVotingPeriod, proposal.Status)
+	suite.Require().Equal(suite.ctx.HeaderInfo().Time.Add(*params.SecurityCouncil.VotingPeriod), *proposal.VotingEndTime)
+
+	has, err := suite.govKeeper.SecurityCouncilProposalsQueue.Has(suite.ctx, collections.Join(*proposal.VotingEndTime, proposal.Id))
+	suite.Require().NoError(err)
+	suite.Require().True(has)
+	has, err = suite.govKeeper.ActiveProposalsQueue.Has(suite.ctx, collections.Join(*proposal.VotingEndTime, proposal.Id))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+
+	// only the security council can vote
+	err = suite.govKeeper.AddVote(suite.ctx, proposal.Id, other, v1.NewNonSplitVoteOption(v1.OptionYes), "")
+	suite.Require().ErrorIs(err, types.ErrInvalidVote)
+
+	passes, _, _, err := suite.govKeeper.Tally(suite.ctx, proposal)
+	suite.Require().NoError(err)
+	suite.Require().False(passes)
+
+	suite.Require().NoError(suite.govKeeper.AddVote(suite.ctx, proposal.Id, council, v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	passes, burnDeposits, tally, err := suite.govKeeper.Tally(suite.ctx, proposal)
+	suite.Require().NoError(err)
+	suite.Require().True(passes)
+	suite.Require().False(burnDeposits)
+	suite.Require().Equal("1", tally.YesCount)
+
+	// votes are removed once tallied
+	_, err = suite.govKeeper.Votes.Get(suite.ctx, collections.Join(proposal.Id, council))
+	suite.Require().ErrorIs(err, collections.ErrNotFound)
+
+	suite.Require().NoError(suite.govKeeper.DeleteProposal(suite.ctx, proposal.Id))
+	has, err = suite.govKeeper.SecurityCouncilProposalsQueue.Has(suite.ctx, collections.Join(*proposal.VotingEndTime, proposal.Id))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
+
+func (suite *KeeperTestSuite) TestArchiveProposals() {
+	suite.reset()
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Height: 10, Time: suite.ctx.HeaderInfo().Time})
+
+	params, err := suite.govKeeper.Params.Get(ctx)
+	suite.Require().NoError(err)
+	params.ProposalArchiveRetention = 5
+	suite.Require().NoError(suite.govKeeper.Params.Set(ctx, params))
+
+	proposal, err := suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", suite.addrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.govKeeper.ActivateVotingPeriod(ctx, proposal))
+	suite.Require().NoError(suite.govKeeper.AddVote(ctx, proposal.Id, suite.addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	proposal, err = suite.govKeeper.Proposals.Get(ctx, proposal.Id)
+	suite.Require().NoError(err)
+	tally := v1.EmptyTallyResult()
+	proposal.Status = v1.StatusRejected
+	proposal.FinalTallyResult = &tally
+	suite.Require().NoError(suite.govKeeper.Proposals.Set(ctx, proposal.Id, proposal))
+	suite.Require().NoError(suite.govKeeper.FinishedProposals.Set(ctx, collections.Join(int64(10), proposal.Id)))
+
+	// retention period not over yet
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 14, Time: ctx.HeaderInfo().Time})
+	suite.Require().NoError(suite.govKeeper.ArchiveProposals(ctx))
+	_, err = suite.govKeeper.Proposals.Get(ctx, proposal.Id)
+	suite.Require().NoError(err)
+	_, err = suite.govKeeper.ArchivedProposals.Get(ctx, proposal.Id)
+	suite.Require().ErrorIs(err, collections.ErrNotFound)
+
+	// retention period over, the proposal gets archived
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 15, Time: ctx.HeaderInfo().Time})
+	suite.Require().NoError(suite.govKeeper.ArchiveProposals(ctx))
+
+	_, err = suite.govKeeper.Proposals.Get(ctx, proposal.Id)
+	suite.Require().ErrorIs(err, collections.ErrNotFound)
+	_, err = suite.govKeeper.Votes.Get(ctx, collections.Join(proposal.Id, suite.addrs[0]))
+	suite.Require().ErrorIs(err, collections.ErrNotFound)
+	has, err := suite.govKeeper.FinishedProposals.Has(ctx, collections.Join(int64(10), proposal.Id))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+
+	archived, err := suite.govKeeper.ArchivedProposals.Get(ctx, proposal.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(proposal.Id, archived.Id)
+	suite.Require().Equal("test", archived.Title)
+	suite.Require().Equal(v1.StatusRejected, archived.Status)
+	suite.Require().Equal(int64(10), archived.FinishedHeight)
+
+	res, err := suite.queryClient.ArchivedProposal(ctx, &v1.QueryArchivedProposalRequest{ProposalId: proposal.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(proposal.Id, res.Proposal.Id)
+
+	resAll, err := suite.queryClient.ArchivedProposals(ctx, &v1.QueryArchivedProposalsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(resAll.Proposals, 1)
+}
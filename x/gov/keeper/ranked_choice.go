@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	v1 "cosmossdk.io/x/gov/types/v1"
+)
+
+// rankedBallot is the voting power of a voter counted in the instant-runoff tally of a multiple choice proposal,
+// along with the options it can be counted for, from the most to the least preferred one.
+type rankedBallot struct {
+	power   math.LegacyDec
+	ranking []v1.VoteOption
+	// ranked is true when the ballot was cast as a ranked ballot
+	ranked bool
+}
+
+// appendBallots appends the ballots of a vote with the given voting power. A ranked vote is a single ballot
+// with the full voting power, while each option of a weighted vote is a ballot ranking only that option
+// with its share of the voting power.
+func appendBallots(ballots []rankedBallot, power math.LegacyDec, options v1.WeightedVoteOptions, ranking []v1.VoteOption) []rankedBallot {
+	if power.IsZero() {
+		return ballots
+	}
+
+	if len(ranking) > 0 {
+		return append(ballots, rankedBallot{power: power, ranking: ranking, ranked: true})
+	}
+
+	for _, option := range options {
+		weight, _ := math.LegacyNewDecFromStr(option.Weight)
+		ballots = append(ballots, rankedBallot{power: power.Mul(weight), ranking: []v1.VoteOption{option.Option}})
+	}
+
+	return ballots
+}
+
+// hasRankedBallots returns true if at least one of the ballots was cast as a ranked ballot.
+func hasRankedBallots(ballots []rankedBallot) bool {
+	for _, ballot := range ballots {
+		if ballot.ranked {
+			return true
+		}
+	}
+
+	return false
+}
+
+// proposalVoteOptions returns the options set on a multiple choice proposal, in the order they are listed.
+func proposalVoteOptions(options v1.ProposalVoteOptions) []v1.VoteOption {
+	var res []v1.VoteOption
+	for _, option := range []struct {
+		option v1.VoteOption
+		text   string
+	}{
+		{v1.OptionOne, options.OptionOne},
+		{v1.OptionTwo, options.OptionTwo},
+		{v1.OptionThree, options.OptionThree},
+		{v1.OptionFour, options.OptionFour},
+	} {
+		if option.text != "" {
+			res = append(res, option.option)
+		}
+	}
+
+	return res
+}
+
+// tallyRankedChoice runs an instant-runoff tally of the ballots over the given options.
+// In each round, a ballot counts for its most preferred option which has not been eliminated yet, ballots with no
+// such option are exhausted and not counted anymore.
+// An option counted for more than half of the counted voting power of a round wins.
+// Otherwise the option with the lowest count is eliminated and a new round starts, until a single option remains.
+// Ties for the elimination are broken by eliminating the tied option with the lowest count in the latest previous
+// round in which the tied options had different counts, and if they were always tied, the option listed last.
+// If no voting power is counted in a round, the tally has no winner.
+func tallyRankedChoice(ballots []rankedBallot, options []v1.VoteOption) v1.RankedChoiceTally {
+	continuing := make(map[v1.VoteOption]bool, len(options))
+	for _, option := range options {
+		continuing[option] = true
+	}
+
+	var (
+		result v1.RankedChoiceTally
+		rounds []map[v1.VoteOption]math.LegacyDec
+	)
+	for len(continuing) > 0 {
+		counts, total := countRankedChoiceRound(ballots, continuing)
+		rounds = append(rounds, counts)
+		round := newRankedChoiceRound(counts)
+
+		if total.IsZero() {
+			result.Rounds = append(result.Rounds, &round)
+			return result
+		}
+
+		remaining := continuingOptions(options, continuing)
+		for _, option := range remaining {
+			if counts[option].MulInt64(2).GT(total) || len(remaining) == 1 {
+				result.Rounds = append(result.Rounds, &round)
+				result.Winner = option
+				return result
+			}
+		}
+
+		round.Eliminated = rankedChoiceElimination(remaining, rounds)
+		result.Rounds = append(result.Rounds, &round)
+		delete(continuing, round.Eliminated)
+	}
+
+	return result
+}
+
+// countRankedChoiceRound counts each ballot for its most preferred continuing option and returns the counts of the
+// options along with the total counted voting power.
+func countRankedChoiceRound(ballots []rankedBallot, continuing map[v1.VoteOption]bool) (map[v1.VoteOption]math.LegacyDec, math.LegacyDec) {
+	counts := make(map[v1.VoteOption]math.LegacyDec, len(continuing))
+	for option := range continuing {
+		counts[option] = math.LegacyZeroDec()
+	}
+
+	total := math.LegacyZeroDec()
+	for _, ballot := range ballots {
+		for _, option := range ballot.ranking {
+			if continuing[option] {
+				counts[option] = counts[option].Add(ballot.power)
+				total = total.Add(ballot.power)
+				break
+			}
+		}
+	}
+
+	return counts, total
+}
+
+// rankedChoiceElimination returns the option to eliminate among the remaining options, given the counts of all
+// the rounds so far, the last one being the current round.
+func rankedChoiceElimination(remaining []v1.VoteOption, rounds []map[v1.VoteOption]math.LegacyDec) v1.VoteOption {
+	tied := remaining
+	for i := len(rounds) - 1; i >= 0 && len(tied) > 1; i-- {
+		lowest := rounds[i][tied[0]]
+		for _, option := range tied[1:] {
+			if rounds[i][option].LT(lowest) {
+				lowest = rounds[i][option]
+			}
+		}
+
+		var next []v1.VoteOption
+		for _, option := range tied {
+			if rounds[i][option].Equal(lowest) {
+				next = append(next, option)
+			}
+		}
+		tied = next
+	}
+
+	// the last tied option is the one listed last on the proposal
+	return tied[len(tied)-1]
+}
+
+// continuingOptions returns the options which have not been eliminated, in the order of the given options.
+func continuingOptions(options []v1.VoteOption, continuing map[v1.VoteOption]bool) []v1.VoteOption {
+	var res []v1.VoteOption
+	for _, option := range options {
+		if continuing[option] {
+			res = append(res, option)
+		}
+	}
+
+	return res
+}
+
+func newRankedChoiceRound(counts map[v1.VoteOption]math.LegacyDec) v1.RankedChoiceRound {
+	count := func(option v1.VoteOption) string {
+		if c, ok := counts[option]; ok {
+			return c.TruncateInt().String()
+		}
+		return math.ZeroInt().String()
+	}
+
+	return v1.RankedChoiceRound{
+		OptionOneCount:   count(v1.OptionOne),
+		OptionTwoCount:   count(v1.OptionTwo),
+		OptionThreeCount: count(v1.OptionThree),
+		OptionFourCount:  count(v1.OptionFour),
+	}
+}
@@ -0,0 +1,251 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	v1 "cosmossdk.io/x/gov/types/v1"
+)
+
+func ranked(power int64, ranking ...v1.VoteOption) rankedBallot {
+	return rankedBallot{power: math.LegacyNewDec(power), ranking: ranking, ranked: true}
+}
+
+func round(one, two, three, four int64, eliminated v1.VoteOption) *v1.RankedChoiceRound {
+	return &v1.RankedChoiceRound{
+		OptionOneCount:   math.NewInt(one).String(),
+		OptionTwoCount:   math.NewInt(two).String(),
+		OptionThreeCount: math.NewInt(three).String(),
+		OptionFourCount:  math.NewInt(four).String(),
+		Eliminated:       eliminated,
+	}
+}
+
+func TestTallyRankedChoice(t *testing.T) {
+	allOptions := []v1.VoteOption{v1.OptionOne, v1.OptionTwo, v1.OptionThree, v1.OptionFour}
+	none := v1.VoteOption_VOTE_OPTION_UNSPECIFIED
+
+	testCases := []struct {
+		name     string
+		ballots  []rankedBallot
+		options  []v1.VoteOption
+		expected v1.RankedChoiceTally
+	}{
+		{
+			name:    "no ballots",
+			options: allOptions,
+			expected: v1.RankedChoiceTally{
+				Rounds: []*v1.RankedChoiceRound{round(0, 0, 0, 0, none)},
+			},
+		},
+		{
+			name:    "majority in the first round",
+			options: allOptions,
+			ballots: []rankedBallot{
+				ranked(60, v1.OptionOne, v1.OptionTwo),
+				ranked(40, v1.OptionTwo, v1.OptionOne),
+			},
+			expected: v1.RankedChoiceTally{
+				Rounds: []*v1.RankedChoiceRound{round(60, 40, 0, 0, none)},
+				Winner: v1.OptionOne,
+			},
+		},
+		{
+			name:    "exactly half is not a majority",
+			options: []v1.VoteOption{v1.OptionOne, v1.OptionTwo, v1.OptionThree},
+			ballots: []rankedBallot{
+				ranked(50, v1.OptionOne),
+				ranked(30, v1.OptionTwo),
+				ranked(20, v1.OptionThree, v1.OptionTwo),
+			},
+			expected: v1.RankedChoiceTally{
+				Rounds: []*v1.RankedChoiceRound{
+					round(50, 30, 20, 0, v1.OptionThree),
+					round(50, 50, 0, 0, v1.OptionTwo),
+					round(50, 0, 0, 0, none),
+				},
+				Winner: v1.OptionOne,
+			},
+		},
+		{
+			name:    "transfers decide the winner",
+			options: []v1.VoteOption{v1.OptionOne, v1.OptionTwo, v1.OptionThree},
+			ballots: []rankedBallot{
+				ranked(40, v1.OptionOne),
+				ranked(35, v1.OptionTwo, v1.OptionThree),
+				ranked(25, v1.OptionThree, v1.OptionTwo),
+			},
+			expected: v1.RankedChoiceTally{
+				Rounds: []*v1.RankedChoiceRound{
+					round(40, 35, 25, 0, v1.OptionThree),
+					round(40, 60, 0, 0, none),
+				},
+				Winner: v1.OptionTwo,
+			},
+		},
+		{
+			name:    "exhausted ballots are not counted",
+			options: []v1.VoteOption{v1.OptionOne, v1.OptionTwo, v1.OptionThree},
+			ballots: []rankedBallot{
+				ranked(40, v1.OptionOne),
+				ranked(35, v1.OptionTwo),
+				ranked(25, v1.OptionThree),
+			},
+			expected: v1.RankedChoiceTally{
+				Rounds: []*v1.RankedChoiceRound{
+					round(40, 35, 25, 0, v1.OptionThree),
+					round(40, 35, 0, 0, none),
+				},
+				Winner: v1.OptionOne,
+			},
+		},
+		{
+			name:    "weighted votes only count for their options",
+			options: []v1.VoteOption{v1.OptionOne, v1.OptionTwo, v1.OptionThree},
+			ballots: appendBallots(
+				[]rankedBallot{ranked(30, v1.OptionThree, v1.OptionTwo)},
+				math.LegacyNewDec(70),
+				v1.WeightedVoteOptions{
+					{Option: v1.OptionOne, Weight: "0.5"},
+					{Option: v1.OptionTwo, Weight: "0.5"},
+				},
+				nil,
+			),
+			expected: v1.RankedChoiceTally{
+				Rounds: []*v1.RankedChoiceRound{
+					round(35, 35, 30, 0, v1.OptionThree),
+					round(35, 65, 0, 0, none),
+				},
+				Winner: v1.OptionTwo,
+			},
+		},
+		{
+			name:    "options not on the proposal are skipped",
+			options: []v1.VoteOption{v1.OptionOne, v1.OptionTwo},
+			ballots: []rankedBallot{
+				ranked(40, v1.OptionFour, v1.OptionOne),
+				ranked(30, v1.OptionTwo),
+			},
+			expected: v1.RankedChoiceTally{
+				Rounds: []*v1.RankedChoiceRound{round(40, 30, 0, 0, none)},
+				Winner: v1.OptionOne,
+			},
+		},
+		{
+			name:    "tie broken by the previous round",
+			options: allOptions,
+			ballots: []rankedBallot{
+				ranked(40, v1.OptionOne),
+				ranked(25, v1.OptionTwo),
+				ranked(20, v1.OptionThree),
+				ranked(10, v1.OptionFour, v1.OptionThree),
+				ranked(5, v1.OptionFour, v1.OptionTwo),
+			},
+			// after option four is eliminated, options two and three are tied at 30,
+			// option three had less in the first round so it is eliminated
+			expected: v1.RankedChoiceTally{
+				Rounds: []*v1.RankedChoiceRound{
+					round(40, 25, 20, 15, v1.OptionFour),
+					round(40, 30, 30, 0, v1.OptionThree),
+					round(40, 30, 0, 0, none),
+				},
+				Winner: v1.OptionOne,
+			},
+		},
+		{
+			name:    "tie in every round eliminates the option listed last",
+			options: []v1.VoteOption{v1.OptionOne, v1.OptionTwo, v1.OptionThree},
+			ballots: []rankedBallot{
+				ranked(40, v1.OptionOne),
+				ranked(30, v1.OptionTwo),
+				ranked(30, v1.OptionThree),
+			},
+			expected: v1.RankedChoiceTally{
+				Rounds: []*v1.RankedChoiceRound{
+					round(40, 30, 30, 0, v1.OptionThree),
+					round(40, 30, 0, 0, none),
+				},
+				Winner: v1.OptionOne,
+			},
+		},
+		{
+			name:    "tie between the last two options",
+			options: []v1.VoteOption{v1.OptionOne, v1.OptionTwo},
+			ballots: []rankedBallot{
+				ranked(50, v1.OptionOne),
+				ranked(50, v1.OptionTwo),
+			},
+			expected: v1.RankedChoiceTally{
+				Rounds: []*v1.RankedChoiceRound{
+					round(50, 50, 0, 0, v1.OptionTwo),
+					round(50, 0, 0, 0, none),
+				},
+				Winner: v1.OptionOne,
+			},
+		},
+		{
+			name:    "no ballot ranks an option of the proposal",
+			options: []v1.VoteOption{v1.OptionOne, v1.OptionTwo, v1.OptionThree},
+			ballots: []rankedBallot{
+				ranked(10, v1.OptionFour),
+			},
+			expected: v1.RankedChoiceTally{
+				Rounds: []*v1.RankedChoiceRound{
+					round(0, 0, 0, 0, none),
+				},
+			},
+		},
+		{
+			name:    "single option",
+			options: []v1.VoteOption{v1.OptionOne},
+			ballots: []rankedBallot{
+				ranked(10, v1.OptionTwo, v1.OptionOne),
+			},
+			expected: v1.RankedChoiceTally{
+				Rounds: []*v1.RankedChoiceRound{round(10, 0, 0, 0, none)},
+				Winner: v1.OptionOne,
+			},
+		},
+		{
+			name:    "ballot order does not matter",
+			options: []v1.VoteOption{v1.OptionOne, v1.OptionTwo, v1.OptionThree},
+			ballots: []rankedBallot{
+				ranked(25, v1.OptionThree, v1.OptionTwo),
+				ranked(40, v1.OptionOne),
+				ranked(35, v1.OptionTwo, v1.OptionThree),
+			},
+			expected: v1.RankedChoiceTally{
+				Rounds: []*v1.RankedChoiceRound{
+					round(40, 35, 25, 0, v1.OptionThree),
+					round(40, 60, 0, 0, none),
+				},
+				Winner: v1.OptionTwo,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tallyRankedChoice(tc.ballots, tc.options))
+		})
+	}
+}
+
+func TestProposalVoteOptions(t *testing.T) {
+	options := proposalVoteOptions(v1.ProposalVoteOptions{OptionOne: "a", OptionTwo: "b", OptionFour: "d"})
+	require.Equal(t, []v1.VoteOption{v1.OptionOne, v1.OptionTwo, v1.OptionFour}, options)
+}
+
+func TestHasRankedBallots(t *testing.T) {
+	ballots := appendBallots(nil, math.LegacyNewDec(10), v1.NewNonSplitVoteOption(v1.OptionOne), nil)
+	require.False(t, hasRankedBallots(ballots))
+
+	// voters without voting power are not counted
+	ballots = appendBallots(ballots, math.LegacyZeroDec(), nil, []v1.VoteOption{v1.OptionTwo})
+	require.False(t, hasRankedBallots(ballots))
+
+	ballots = appendBallots(ballots, math.LegacyNewDec(10), nil, []v1.VoteOption{v1.OptionTwo})
+	require.True(t, hasRankedBallots(ballots))
+}
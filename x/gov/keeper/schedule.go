@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	v1 "cosmossdk.io/x/gov/types/v1"
+)
+
+// SetScheduleEntry indexes an upcoming event of a proposal in the governance schedule.
+func (k Keeper) SetScheduleEntry(ctx context.Context, eventType v1.ScheduleEventType, eventTime time.Time, proposalID uint64) error {
+	return k.Schedule.Set(ctx, collections.Join3(eventTime, proposalID, int32(eventType)))
+}
+
+// RemoveScheduleEntry removes an event of a proposal from the governance schedule,
+// once it happened or is no longer planned.
+func (k Keeper) RemoveScheduleEntry(ctx context.Context, eventType v1.ScheduleEventType, eventTime time.Time, proposalID uint64) error {
+	return k.Schedule.Remove(ctx, collections.Join3(eventTime, proposalID, int32(eventType)))
+}
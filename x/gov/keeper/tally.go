@@ -13,12 +13,22 @@ import (
 
 // Tally iterates over the votes and updates the tally of a proposal based on the voting power of the voters
 func (k Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
+	// security council proposals are not decided by stake, they have their own tally path
+	if proposal.ProposalType == v1.ProposalType_PROPOSAL_TYPE_SECURITY_COUNCIL {
+		return k.tallySecurityCouncil(ctx, proposal)
+	}
+
+	// emergency proposals are only voted on by validators, they have their own tally path
+	if proposal.ProposalType == v1.ProposalType_PROPOSAL_TYPE_EMERGENCY {
+		return k.tallyEmergency(ctx, proposal)
+	}
+
 	validators, err := k.getCurrentValidators(ctx)
 	if err != nil {
 		return false, false, v1.TallyResult{}, err
 	}
 
-	totalVoterPower, results, err := k.calculateVoteResultsAndVotingPower(ctx, proposal.Id, validators)
+	totalVoterPower, results, ballots, err := k.calculateVoteResultsAndVotingPower(ctx, proposal.Id, validators)
 	if err != nil {
 		return false, false, v1.TallyResult{}, err
 	}
@@ -52,7 +62,7 @@ func (k Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDe
 	case v1.ProposalType_PROPOSAL_TYPE_EXPEDITED:
 		return k.tallyExpedited(totalVoterPower, totalBonded, results, params)
 	case v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE:
-		return k.tallyMultipleChoice(totalVoterPower, totalBonded, results, params)
+		return k.tallyMultipleChoice(ctx, proposal, totalVoterPower, totalBonded, results, ballots, params)
 	default:
 		return k.tallyStandard(ctx, proposal, totalVoterPower, totalBonded, results, params)
 	}
@@ -188,12 +198,23 @@ func (k Keeper) tallyOptimistic(totalVoterPower math.LegacyDec, totalBonded math
 }
 
 // tallyMultipleChoice tallies the votes of a multiple choice proposal
+// If the proposal received ranked ballots, the instant-runoff tally of the ballots is added to the tally results
 // If there is not enough quorum of votes, the proposal fails
 // Any other case, proposal passes
 // Checking for spam votes is done before calling this function
-func (k Keeper) tallyMultipleChoice(totalVoterPower math.LegacyDec, totalBonded math.Int, results map[v1.VoteOption]math.LegacyDec, params v1.Params) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
+func (k Keeper) tallyMultipleChoice(ctx context.Context, proposal v1.Proposal, totalVoterPower math.LegacyDec, totalBonded math.Int, results map[v1.VoteOption]math.LegacyDec, ballots []rankedBallot, params v1.Params) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
 	tallyResults = v1.NewTallyResultFromMap(results)
 
+	if hasRankedBallots(ballots) {
+		proposalOptions, err := k.ProposalVoteOptions.Get(ctx, proposal.Id)
+		if err != nil {
+			return false, false, v1.TallyResult{}, err
+		}
+
+		rankedChoice := tallyRankedChoice(ballots, proposalVoteOptions(proposalOptions))
+		tallyResults.RankedChoice = &rankedChoice
+	}
+
 	// If there is not enough quorum of votes, the proposal fails
 	percentVoting := totalVoterPower.Quo(math.LegacyNewDecFromInt(totalBonded))
 	quorum, _ := math.LegacyNewDecFromStr(params.Quorum)
@@ -206,6 +227,119 @@ func (k Keeper) tallyMultipleChoice(totalVoterPower math.LegacyDec, totalBonded
 	return true, false, tallyResults, nil
 }
 
+// tallySecurityCouncil tallies the vote of a security council proposal
+// Only the vote of the security council policy is taken into account, it counts as a single unit of voting power
+// If the security council did not vote, proposal fails
+// If more than the threshold of the council vote is Yes, proposal passes
+// Any other case, proposal fails
+func (k Keeper) tallySecurityCouncil(ctx context.Context, proposal v1.Proposal) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
+	results := createEmptyResults()
+	defer func() {
+		// votes are removed once tallied, as for any other proposal
+		if err == nil {
+			err = k.deleteVotes(ctx, proposal.Id)
+		}
+	}()
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, false, v1.TallyResult{}, err
+	}
+
+	if !params.SecurityCouncil.IsEnabled() {
+		return false, false, v1.NewTallyResultFromMap(results), nil
+	}
+
+	council, err := k.authKeeper.AddressCodec().StringToBytes(params.SecurityCouncil.PolicyAddress)
+	if err != nil {
+		return false, false, v1.TallyResult{}, err
+	}
+
+	vote, err := k.Votes.Get(ctx, collections.Join(proposal.Id, sdk.AccAddress(council)))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return false, false, v1.NewTallyResultFromMap(results), nil
+		}
+
+		return false, false, v1.TallyResult{}, err
+	}
+
+	for _, option := range vote.Options {
+		weight, _ := math.LegacyNewDecFromStr(option.Weight)
+		results[option.Option] = results[option.Option].Add(weight)
+	}
+	tallyResults = v1.NewTallyResultFromMap(results)
+
+	threshold, _ := math.LegacyNewDecFromStr(params.Threshold)
+	if results[v1.OptionYes].GT(threshold) {
+		return true, false, tallyResults, nil
+	}
+
+	return false, false, tallyResults, nil
+}
+
+// tallyEmergency tallies the votes of an emergency proposal
+// Only the votes of bonded validators are taken into account, with their full bonded tokens as voting power
+// Delegators cannot override the vote of their validator
+// If there is no staked coins, the proposal fails
+// If more than the emergency threshold of the total bonded stake votes Yes, proposal passes
+// Any other case, proposal fails
+func (k Keeper) tallyEmergency(ctx context.Context, proposal v1.Proposal) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
+	results := createEmptyResults()
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, false, v1.TallyResult{}, err
+	}
+
+	validators, err := k.getCurrentValidators(ctx)
+	if err != nil {
+		return false, false, v1.TallyResult{}, err
+	}
+
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id)
+	if err := k.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
+		valAddrStr, err := k.sk.ValidatorAddressCodec().BytesToString(key.K2())
+		if err != nil {
+			return false, err
+		}
+
+		// validators that are not bonded anymore do not count
+		if val, ok := validators[valAddrStr]; ok {
+			for _, option := range vote.Options {
+				weight, _ := math.LegacyNewDecFromStr(option.Weight)
+				results[option.Option] = results[option.Option].Add(weight.MulInt(val.BondedTokens))
+			}
+		}
+
+		return false, nil
+	}); err != nil {
+		return false, false, v1.TallyResult{}, err
+	}
+
+	if err := k.deleteVotes(ctx, proposal.Id); err != nil {
+		return false, false, v1.TallyResult{}, err
+	}
+
+	tallyResults = v1.NewTallyResultFromMap(results)
+
+	totalBonded, err := k.sk.TotalBondedTokens(ctx)
+	if err != nil {
+		return false, false, v1.TallyResult{}, err
+	}
+
+	if totalBonded.IsZero() || !params.Emergency.IsEnabled() {
+		return false, false, tallyResults, nil
+	}
+
+	threshold, _ := math.LegacyNewDecFromStr(params.Emergency.Threshold)
+	if results[v1.OptionYes].Quo(totalBonded.ToLegacyDec()).GT(threshold) {
+		return true, false, tallyResults, nil
+	}
+
+	return false, false, tallyResults, nil
+}
+
 // getCurrentValidators fetches all the bonded validators, insert them into currValidators
 func (k Keeper) getCurrentValidators(ctx context.Context) (map[string]v1.ValidatorGovInfo, error) {
 	currValidators := make(map[string]v1.ValidatorGovInfo)
@@ -231,14 +365,15 @@ func (k Keeper) getCurrentValidators(ctx context.Context) (map[string]v1.Validat
 }
 
 // calculateVoteResultsAndVotingPower iterate over all votes, tally up the voting power of each validator
-// and returns the votes results from voters
+// and returns the votes results and the ballots from voters
 func (k Keeper) calculateVoteResultsAndVotingPower(
 	ctx context.Context,
 	proposalID uint64,
 	validators map[string]v1.ValidatorGovInfo,
-) (math.LegacyDec, map[v1.VoteOption]math.LegacyDec, error) {
+) (math.LegacyDec, map[v1.VoteOption]math.LegacyDec, []rankedBallot, error) {
 	totalVP := math.LegacyZeroDec()
 	results := createEmptyResults()
+	var ballots []rankedBallot
 
 	// iterate over all votes, tally up the voting power of each validator
 	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
@@ -256,10 +391,12 @@ func (k Keeper) calculateVoteResultsAndVotingPower(
 
 		if val, ok := validators[valAddrStr]; ok {
 			val.Vote = vote.Options
+			val.Ranking = vote.Ranking
 			validators[valAddrStr] = val
 		}
 
 		// iterate over all delegations from voter, deduct from any delegated-to validators
+		voterPower := math.LegacyZeroDec()
 		err = k.sk.IterateDelegations(ctx, voter, func(index int64, delegation sdk.DelegationI) (stop bool) {
 			valAddrStr := delegation.GetValidatorAddr()
 
@@ -279,6 +416,7 @@ func (k Keeper) calculateVoteResultsAndVotingPower(
 				}
 
 				totalVP = totalVP.Add(votingPower)
+				voterPower = voterPower.Add(votingPower)
 			}
 
 			return false
@@ -286,10 +424,11 @@ func (k Keeper) calculateVoteResultsAndVotingPower(
 		if err != nil {
 			return false, err
 		}
+		ballots = appendBallots(ballots, voterPower, vote.Options, vote.Ranking)
 
 		return false, k.Votes.Remove(ctx, collections.Join(vote.ProposalId, sdk.AccAddress(voter)))
 	}); err != nil {
-		return math.LegacyDec{}, nil, err
+		return math.LegacyDec{}, nil, nil, err
 	}
 
 	// iterate over the validators again to tally their voting power
@@ -307,9 +446,10 @@ func (k Keeper) calculateVoteResultsAndVotingPower(
 			results[option.Option] = results[option.Option].Add(subPower)
 		}
 		totalVP = totalVP.Add(votingPower)
+		ballots = appendBallots(ballots, votingPower, val.Vote, val.Ranking)
 	}
 
-	return totalVP, results, nil
+	return totalVP, results, ballots, nil
 }
 
 func createEmptyResults() map[v1.VoteOption]math.LegacyDec {
@@ -52,6 +52,11 @@ var (
 		// validatorVote is like delegatorVote but without delegations
 		delegatorVote(s, sdk.AccAddress(voter), nil, vote)
 	}
+	validatorRankedVote = func(s tallyFixture, voter sdk.ValAddress, ranking ...v1.VoteOption) {
+		err := s.keeper.AddRankedVote(s.ctx, s.proposal.Id, sdk.AccAddress(voter), ranking, "")
+		require.NoError(s.t, err)
+		s.mocks.stakingKeeper.EXPECT().IterateDelegations(s.ctx, sdk.AccAddress(voter), gomock.Any())
+	}
 )
 
 func TestTally_Standard(t *testing.T) {
@@ -1408,6 +1413,44 @@ func TestTally_MultipleChoice(t *testing.T) {
 				SpamCount:        "6000000",
 			},
 		},
+		{
+			name: "ranked ballots: instant-runoff tally",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				for _, val := range s.valAddrs[:4] {
+					validatorRankedVote(s, val, v1.VoteOption_VOTE_OPTION_ONE)
+				}
+				for _, val := range s.valAddrs[4:7] {
+					validatorRankedVote(s, val, v1.VoteOption_VOTE_OPTION_TWO, v1.VoteOption_VOTE_OPTION_THREE)
+				}
+				for _, val := range s.valAddrs[7:9] {
+					validatorRankedVote(s, val, v1.VoteOption_VOTE_OPTION_THREE, v1.VoteOption_VOTE_OPTION_TWO)
+				}
+				validatorRankedVote(s, s.valAddrs[9], v1.VoteOption_VOTE_OPTION_FOUR, v1.VoteOption_VOTE_OPTION_THREE)
+			},
+			expectedPass: true,
+			expectedBurn: false,
+			expectedTally: v1.TallyResult{
+				YesCount:         "4000000",
+				AbstainCount:     "3000000",
+				NoCount:          "2000000",
+				NoWithVetoCount:  "1000000",
+				OptionOneCount:   "4000000",
+				OptionTwoCount:   "3000000",
+				OptionThreeCount: "2000000",
+				OptionFourCount:  "1000000",
+				SpamCount:        "0",
+				RankedChoice: &v1.RankedChoiceTally{
+					Rounds: []*v1.RankedChoiceRound{
+						{OptionOneCount: "4000000", OptionTwoCount: "3000000", OptionThreeCount: "2000000", OptionFourCount: "1000000", Eliminated: v1.VoteOption_VOTE_OPTION_FOUR},
+						// options two and three are tied, option three had less in the previous round
+						{OptionOneCount: "4000000", OptionTwoCount: "3000000", OptionThreeCount: "3000000", OptionFourCount: "0", Eliminated: v1.VoteOption_VOTE_OPTION_THREE},
+						{OptionOneCount: "4000000", OptionTwoCount: "5000000", OptionThreeCount: "0", OptionFourCount: "0"},
+					},
+					Winner: v1.VoteOption_VOTE_OPTION_TWO,
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1477,3 +1520,161 @@ func TestTally_MultipleChoice(t *testing.T) {
 		})
 	}
 }
+
+func TestTally_Emergency(t *testing.T) {
+	emergencyVote := func(s tallyFixture, voter sdk.ValAddress, vote v1.VoteOption) {
+		err := s.keeper.AddVote(s.ctx, s.proposal.Id, sdk.AccAddress(voter), v1.NewNonSplitVoteOption(vote), "")
+		require.NoError(s.t, err)
+	}
+
+	tests := []struct {
+		name          string
+		setup         func(tallyFixture)
+		expectedPass  bool
+		expectedBurn  bool
+		expectedTally v1.TallyResult
+	}{
+		{
+			name: "no votes: prop fails",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+			},
+			expectedPass: false,
+			expectedTally: v1.TallyResult{
+				YesCount:         "0",
+				AbstainCount:     "0",
+				NoCount:          "0",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "0",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "0",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name: "delegators cannot vote: prop fails",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				err := s.keeper.AddVote(s.ctx, s.proposal.Id, s.delAddrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+				require.ErrorContains(s.t, err, "only bonded validators can vote on emergency proposals")
+			},
+			expectedPass: false,
+			expectedTally: v1.TallyResult{
+				YesCount:         "0",
+				AbstainCount:     "0",
+				NoCount:          "0",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "0",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "0",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name: "yes below supermajority of total bonded: prop fails",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				for i := 0; i < 6; i++ {
+					emergencyVote(s, s.valAddrs[i], v1.OptionYes)
+				}
+			},
+			expectedPass: false,
+			expectedTally: v1.TallyResult{
+				YesCount:         "6000000",
+				AbstainCount:     "0",
+				NoCount:          "0",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "6000000",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "0",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name: "yes above supermajority of total bonded: prop passes",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				for i := 0; i < 7; i++ {
+					emergencyVote(s, s.valAddrs[i], v1.OptionYes)
+				}
+				emergencyVote(s, s.valAddrs[7], v1.OptionNo)
+			},
+			expectedPass: true,
+			expectedTally: v1.TallyResult{
+				YesCount:         "7000000",
+				AbstainCount:     "0",
+				NoCount:          "1000000",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "7000000",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "1000000",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+			var (
+				numVals       = 10
+				numDelegators = 5
+				addrs         = simtestutil.CreateRandomAccounts(numVals + numDelegators)
+				valAddrs      = simtestutil.ConvertAddrsToValAddrs(addrs[:numVals])
+				delAddrs      = addrs[numVals:]
+			)
+			params := v1.DefaultParams()
+			params.Emergency.AllowedMessages = nil
+			for _, msg := range TestProposal {
+				params.Emergency.AllowedMessages = append(params.Emergency.AllowedMessages, sdk.MsgTypeURL(msg))
+			}
+			require.NoError(t, govKeeper.Params.Set(ctx, params))
+
+			// Mocks a bunch of validators
+			mocks.stakingKeeper.EXPECT().
+				IterateBondedValidatorsByPower(ctx, gomock.Any()).
+				DoAndReturn(
+					func(ctx context.Context, fn func(index int64, validator sdk.ValidatorI) bool) error {
+						for i := int64(0); i < int64(numVals); i++ {
+							fn(i, stakingtypes.Validator{
+								OperatorAddress: valAddrs[i].String(),
+								Status:          stakingtypes.Bonded,
+								Tokens:          sdkmath.NewInt(1000000),
+								DelegatorShares: sdkmath.LegacyNewDec(1000000),
+							})
+						}
+						return nil
+					}).AnyTimes()
+
+			// Submit and activate a proposal
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", delAddrs[0], v1.ProposalType_PROPOSAL_TYPE_EMERGENCY)
+			require.NoError(t, err)
+			err = govKeeper.ActivateVotingPeriod(ctx, proposal)
+			require.NoError(t, err)
+			suite := tallyFixture{
+				t:        t,
+				proposal: proposal,
+				valAddrs: valAddrs,
+				delAddrs: delAddrs,
+				ctx:      ctx,
+				keeper:   govKeeper,
+				mocks:    mocks,
+			}
+			tt.setup(suite)
+
+			pass, burn, tally, err := govKeeper.Tally(ctx, proposal)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPass, pass, "wrong pass")
+			assert.Equal(t, tt.expectedBurn, burn, "wrong burn")
+			assert.Equal(t, tt.expectedTally, tally)
+			// Assert votes removal after tally
+			rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id)
+			_, err = suite.keeper.Votes.Iterate(suite.ctx, rng)
+			assert.NoError(t, err)
+		})
+	}
+}
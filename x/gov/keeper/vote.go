@@ -7,6 +7,7 @@ import (
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/x/gov/types"
 	v1 "cosmossdk.io/x/gov/types/v1"
 
@@ -15,6 +16,32 @@ import (
 
 // AddVote adds a vote on a specific proposal
 func (k Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr sdk.AccAddress, options v1.WeightedVoteOptions, metadata string) error {
+	return k.addVote(ctx, proposalID, voterAddr, options, nil, metadata)
+}
+
+// AddRankedVote adds a ranked ballot on a multiple choice proposal. The ranking lists the options of the proposal
+// from the most to the least preferred one, the vote counts for the most preferred option outside of the
+// instant-runoff tally.
+func (k Keeper) AddRankedVote(ctx context.Context, proposalID uint64, voterAddr sdk.AccAddress, ranking []v1.VoteOption, metadata string) error {
+	if len(ranking) == 0 {
+		return errors.Wrap(types.ErrInvalidVote, "empty ranking")
+	}
+
+	ranked := make(map[v1.VoteOption]bool, len(ranking))
+	for _, option := range ranking {
+		if option < v1.OptionOne || option > v1.OptionFour {
+			return errors.Wrapf(types.ErrInvalidVote, "invalid ranked option %s", option)
+		}
+		if ranked[option] {
+			return errors.Wrapf(types.ErrInvalidVote, "duplicated ranked option %s", option)
+		}
+		ranked[option] = true
+	}
+
+	return k.addVote(ctx, proposalID, voterAddr, v1.NewNonSplitVoteOption(ranking[0]), ranking, metadata)
+}
+
+func (k Keeper) addVote(ctx context.Context, proposalID uint64, voterAddr sdk.AccAddress, options v1.WeightedVoteOptions, ranking []v1.VoteOption, metadata string) error {
 	// get proposal
 	proposal, err := k.Proposals.Get(ctx, proposalID)
 	if err != nil {
@@ -34,6 +61,39 @@ func (k Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr sdk.Ac
 		return err
 	}
 
+	// security council proposals can only be voted on by the security council
+	if proposal.ProposalType == v1.ProposalType_PROPOSAL_TYPE_SECURITY_COUNCIL {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return err
+		}
+
+		voterStr, err := k.authKeeper.AddressCodec().BytesToString(voterAddr)
+		if err != nil {
+			return err
+		}
+
+		if !params.SecurityCouncil.IsEnabled() || voterStr != params.SecurityCouncil.PolicyAddress {
+			return errors.Wrap(types.ErrInvalidVote, "only the security council can vote on security council proposals")
+		}
+	}
+
+	// emergency proposals can only be voted on by bonded validators
+	if proposal.ProposalType == v1.ProposalType_PROPOSAL_TYPE_EMERGENCY {
+		isBonded, err := k.isBondedValidator(ctx, voterAddr)
+		if err != nil {
+			return err
+		}
+
+		if !isBonded {
+			return errors.Wrap(types.ErrInvalidVote, "only bonded validators can vote on emergency proposals")
+		}
+	}
+
+	if len(ranking) > 0 && proposal.ProposalType != v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE {
+		return errors.Wrap(types.ErrInvalidVote, "ranked ballots can only be cast on multiple choice proposals")
+	}
+
 	for _, option := range options {
 		switch proposal.ProposalType {
 		case v1.ProposalType_PROPOSAL_TYPE_OPTIMISTIC:
@@ -41,25 +101,9 @@ func (k Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr sdk.Ac
 				return errors.Wrap(types.ErrInvalidVote, "optimistic proposals can only be rejected")
 			}
 		case v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE:
-			proposalOptionsStr, err := k.ProposalVoteOptions.Get(ctx, proposalID)
-			if err != nil {
-				if stderrors.Is(err, collections.ErrNotFound) {
-					return errors.Wrap(types.ErrInvalidProposal, "invalid multiple choice proposal, no options set")
-				}
-
+			if err := k.assertProposalVoteOption(ctx, proposalID, option.Option); err != nil {
 				return err
 			}
-
-			// verify votes only on existing votes
-			if proposalOptionsStr.OptionOne == "" && option.Option == v1.OptionOne { // should never trigger option one is always mandatory
-				return errors.Wrap(types.ErrInvalidVote, "invalid vote option")
-			} else if proposalOptionsStr.OptionTwo == "" && option.Option == v1.OptionTwo { // should never trigger option two is always mandatory
-				return errors.Wrap(types.ErrInvalidVote, "invalid vote option")
-			} else if proposalOptionsStr.OptionThree == "" && option.Option == v1.OptionThree {
-				return errors.Wrap(types.ErrInvalidVote, "invalid vote option")
-			} else if proposalOptionsStr.OptionFour == "" && option.Option == v1.OptionFour {
-				return errors.Wrap(types.ErrInvalidVote, "invalid vote option")
-			}
 		}
 
 		if !v1.ValidWeightedVoteOption(*option) {
@@ -67,7 +111,14 @@ func (k Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr sdk.Ac
 		}
 	}
 
+	for _, option := range ranking {
+		if err := k.assertProposalVoteOption(ctx, proposalID, option); err != nil {
+			return err
+		}
+	}
+
 	vote := v1.NewVote(proposalID, voterAddr, options, metadata)
+	vote.Ranking = ranking
 	err = k.Votes.Set(ctx, collections.Join(proposalID, voterAddr), vote)
 	if err != nil {
 		return err
@@ -79,6 +130,30 @@ func (k Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr sdk.Ac
 		return err
 	}
 
+	castOptions := make([]types.VoteCastOption, 0, len(options))
+	for _, option := range options {
+		weight, err := sdkmath.LegacyNewDecFromStr(option.Weight)
+		if err != nil {
+			return err
+		}
+		castOptions = append(castOptions, types.VoteCastOption{Option: int32(option.Option), Weight: weight})
+	}
+
+	// called with the full vote content so that other modules do not need to read it back from the store
+	err = k.Hooks().AfterVoteCast(ctx, proposalID, voterAddr, castOptions)
+	if err != nil {
+		return err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := k.checkWhaleVote(ctx, params, proposalID, voterAddr, options); err != nil {
+		return err
+	}
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -92,6 +167,47 @@ func (k Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr sdk.Ac
 	return nil
 }
 
+// assertProposalVoteOption returns an error if the option is not one of the options of a multiple choice proposal.
+func (k Keeper) assertProposalVoteOption(ctx context.Context, proposalID uint64, option v1.VoteOption) error {
+	proposalOptionsStr, err := k.ProposalVoteOptions.Get(ctx, proposalID)
+	if err != nil {
+		if stderrors.Is(err, collections.ErrNotFound) {
+			return errors.Wrap(types.ErrInvalidProposal, "invalid multiple choice proposal, no options set")
+		}
+
+		return err
+	}
+
+	// verify votes only on existing votes
+	if proposalOptionsStr.OptionOne == "" && option == v1.OptionOne { // should never trigger option one is always mandatory
+		return errors.Wrap(types.ErrInvalidVote, "invalid vote option")
+	} else if proposalOptionsStr.OptionTwo == "" && option == v1.OptionTwo { // should never trigger option two is always mandatory
+		return errors.Wrap(types.ErrInvalidVote, "invalid vote option")
+	} else if proposalOptionsStr.OptionThree == "" && option == v1.OptionThree {
+		return errors.Wrap(types.ErrInvalidVote, "invalid vote option")
+	} else if proposalOptionsStr.OptionFour == "" && option == v1.OptionFour {
+		return errors.Wrap(types.ErrInvalidVote, "invalid vote option")
+	}
+
+	return nil
+}
+
+// isBondedValidator returns true if the given address is the operator address of a bonded validator.
+func (k Keeper) isBondedValidator(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	valAddrStr, err := k.sk.ValidatorAddressCodec().BytesToString(addr)
+	if err != nil {
+		return false, err
+	}
+
+	validators, err := k.getCurrentValidators(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	_, ok := validators[valAddrStr]
+	return ok, nil
+}
+
 // deleteVotes deletes all the votes from a given proposalID.
 func (k Keeper) deleteVotes(ctx context.Context, proposalID uint64) error {
 	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
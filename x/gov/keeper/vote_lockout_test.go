@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+)
+
+func (suite *KeeperTestSuite) TestVoteLockout() {
+	suite.reset()
+	ctx := suite.ctx
+
+	params, err := suite.govKeeper.Params.Get(ctx)
+	suite.Require().NoError(err)
+
+	submit := func(lockout time.Duration) (uint64, error) {
+		res, err := suite.msgSrvr.SubmitProposal(ctx, &v1.MsgSubmitProposal{
+			InitialDeposit:    params.MinDeposit,
+			Metadata:          "metadata",
+			Proposer:          suite.addrs[0].String(),
+			Title:             "title",
+			Summary:           "summary",
+			VoteLockoutPeriod: &lockout,
+		})
+		if err != nil {
+			return 0, err
+		}
+		return res.ProposalId, nil
+	}
+
+	// the lockout must be positive and shorter than the voting period
+	_, err = submit(0)
+	suite.Require().ErrorIs(err, types.ErrInvalidProposal)
+	_, err = submit(*params.VotingPeriod)
+	suite.Require().ErrorIs(err, types.ErrInvalidProposal)
+
+	proposalID, err := submit(time.Hour)
+	suite.Require().NoError(err)
+	proposal, err := suite.govKeeper.Proposals.Get(ctx, proposalID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(v1.StatusVotingPeriod, proposal.Status)
+	suite.Require().Equal(time.Hour, *proposal.VoteLockoutPeriod)
+
+	vote := func(voter int, option v1.VoteOption) error {
+		_, err := suite.msgSrvr.Vote(ctx, v1.NewMsgVote(suite.addrs[voter], proposalID, option, ""))
+		return err
+	}
+
+	// votes can be changed before the lockout
+	suite.Require().NoError(vote(0, v1.OptionYes))
+	suite.Require().NoError(vote(0, v1.OptionNo))
+
+	// votes cannot be changed anymore in the final hour of the voting period
+	ctx = ctx.WithHeaderInfo(header.Info{Time: proposal.VotingEndTime.Add(-30 * time.Minute)})
+	suite.Require().ErrorIs(vote(0, v1.OptionYes), types.ErrVoteLocked)
+	_, err = suite.msgSrvr.VoteWeighted(ctx, v1.NewMsgVoteWeighted(suite.addrs[0], proposalID, v1.NewNonSplitVoteOption(v1.OptionAbstain), ""))
+	suite.Require().ErrorIs(err, types.ErrVoteLocked)
+
+	vote0, err := suite.govKeeper.Votes.Get(ctx, collections.Join(proposalID, suite.addrs[0]))
+	suite.Require().NoError(err)
+	suite.Require().Equal(v1.NewNonSplitVoteOption(v1.OptionNo), v1.WeightedVoteOptions(vote0.Options))
+
+	// but voters who have not voted yet can still vote
+	suite.Require().NoError(vote(1, v1.OptionYes))
+	suite.Require().ErrorIs(vote(1, v1.OptionNo), types.ErrVoteLocked)
+}
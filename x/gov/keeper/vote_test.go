@@ -7,6 +7,7 @@ import (
 
 	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/gov/types"
 	v1 "cosmossdk.io/x/gov/types/v1"
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
@@ -161,3 +162,47 @@ func TestVotes_MultipleChoiceProposal(t *testing.T) {
 	require.NoError(t, govKeeper.AddVote(ctx, proposalID, addrs[1], v1.NewNonSplitVoteOption(v1.OptionTwo), ""))
 	require.NoError(t, govKeeper.AddVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionThree), ""))
 }
+
+func TestVotes_RankedChoice(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t)
+	authKeeper, bankKeeper, stakingKeeper := mocks.acctKeeper, mocks.bankKeeper, mocks.stakingKeeper
+	addrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 1, sdkmath.NewInt(10000000))
+	authKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	proposal, err := govKeeper.SubmitProposal(ctx, nil, "", "title", "description", addrs[0], v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE)
+	require.NoError(t, err)
+	err = govKeeper.ProposalVoteOptions.Set(ctx, proposal.Id, v1.ProposalVoteOptions{
+		OptionOne:   "Vote for @tac0turle",
+		OptionTwo:   "Vote for @facudomedica",
+		OptionThree: "Vote for @alexanderbez",
+	})
+	require.NoError(t, err)
+	proposal.Status = v1.StatusVotingPeriod
+	require.NoError(t, govKeeper.Proposals.Set(ctx, proposal.Id, proposal))
+
+	standard, err := govKeeper.SubmitProposal(ctx, nil, "", "title", "description", addrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+	standard.Status = v1.StatusVotingPeriod
+	require.NoError(t, govKeeper.Proposals.Set(ctx, standard.Id, standard))
+
+	// invalid rankings
+	require.ErrorIs(t, govKeeper.AddRankedVote(ctx, proposal.Id, addrs[0], nil, ""), types.ErrInvalidVote)
+	require.ErrorIs(t, govKeeper.AddRankedVote(ctx, proposal.Id, addrs[0], []v1.VoteOption{v1.OptionOne, v1.OptionOne}, ""), types.ErrInvalidVote)
+	require.ErrorIs(t, govKeeper.AddRankedVote(ctx, proposal.Id, addrs[0], []v1.VoteOption{v1.OptionOne, v1.OptionSpam}, ""), types.ErrInvalidVote)
+	require.ErrorIs(t, govKeeper.AddRankedVote(ctx, proposal.Id, addrs[0], []v1.VoteOption{v1.OptionOne, v1.OptionFour}, ""), types.ErrInvalidVote) // option four is not defined.
+	require.ErrorIs(t, govKeeper.AddRankedVote(ctx, standard.Id, addrs[0], []v1.VoteOption{v1.OptionOne}, ""), types.ErrInvalidVote)
+
+	// valid ranking, the vote counts for the most preferred option
+	ranking := []v1.VoteOption{v1.OptionThree, v1.OptionOne}
+	require.NoError(t, govKeeper.AddRankedVote(ctx, proposal.Id, addrs[0], ranking, ""))
+	vote, err := govKeeper.Votes.Get(ctx, collections.Join(proposal.Id, addrs[0]))
+	require.NoError(t, err)
+	require.Equal(t, ranking, vote.Ranking)
+	require.Equal(t, v1.WeightedVoteOptions(v1.NewNonSplitVoteOption(v1.OptionThree)), v1.WeightedVoteOptions(vote.Options))
+
+	// a regular vote replaces the ranked ballot
+	require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionTwo), ""))
+	vote, err = govKeeper.Votes.Get(ctx, collections.Join(proposal.Id, addrs[0]))
+	require.NoError(t, err)
+	require.Empty(t, vote.Ranking)
+}
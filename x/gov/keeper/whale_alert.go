@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// checkWhaleVote emits an EventWhaleVote and counts the alert in the whale alerts of the proposal
+// when the voting power of the voter exceeds the whale vote threshold.
+func (k Keeper) checkWhaleVote(ctx context.Context, params v1.Params, proposalID uint64, voterAddr sdk.AccAddress, options v1.WeightedVoteOptions) error {
+	threshold := params.WhaleAlert.GetVoteThresholdOrZero()
+	if threshold.IsZero() {
+		return nil
+	}
+
+	totalBonded, err := k.sk.TotalBondedTokens(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !totalBonded.IsPositive() {
+		return nil
+	}
+
+	votingPower, err := k.votingPower(ctx, voterAddr)
+	if err != nil {
+		return err
+	}
+
+	share := votingPower.QuoInt(totalBonded)
+	if share.LTE(threshold) {
+		return nil
+	}
+
+	voter, err := k.authKeeper.AddressCodec().BytesToString(voterAddr)
+	if err != nil {
+		return err
+	}
+
+	alerts, err := k.GetWhaleAlerts(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	alerts.VoteAlerts++
+	if err := k.WhaleAlerts.Set(ctx, proposalID, alerts); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&v1.EventWhaleVote{
+		ProposalId:  proposalID,
+		Voter:       voter,
+		Options:     options.String(),
+		VotingPower: votingPower.String(),
+		Share:       share.String(),
+	})
+}
+
+// checkWhaleDeposit emits an EventWhaleDeposit and counts the alert in the whale alerts of the proposal
+// when a deposit exceeds the whale deposit threshold for any denom of the minimum deposit of the proposal.
+func (k Keeper) checkWhaleDeposit(ctx context.Context, params v1.Params, proposalID uint64, depositorAddr sdk.AccAddress, amount, minDeposit sdk.Coins) error {
+	threshold := params.WhaleAlert.GetDepositThresholdOrZero()
+	if threshold.IsZero() {
+		return nil
+	}
+
+	share := math.LegacyZeroDec()
+	for _, minDep := range minDeposit {
+		if !minDep.Amount.IsPositive() {
+			continue
+		}
+
+		denomShare := math.LegacyNewDecFromInt(amount.AmountOf(minDep.Denom)).QuoInt(minDep.Amount)
+		if denomShare.GT(share) {
+			share = denomShare
+		}
+	}
+
+	if share.LTE(threshold) {
+		return nil
+	}
+
+	depositor, err := k.authKeeper.AddressCodec().BytesToString(depositorAddr)
+	if err != nil {
+		return err
+	}
+
+	alerts, err := k.GetWhaleAlerts(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	alerts.DepositAlerts++
+	alerts.WhaleDeposits = sdk.NewCoins(alerts.WhaleDeposits...).Add(amount...)
+	if err := k.WhaleAlerts.Set(ctx, proposalID, alerts); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&v1.EventWhaleDeposit{
+		ProposalId: proposalID,
+		Depositor:  depositor,
+		Amount:     amount,
+		Share:      share.String(),
+	})
+}
+
+// votingPower returns the voting power of a voter at the time of its vote: the tokens of its delegations
+// to bonded validators and, for a bonded validator, all the tokens bonded to it, as it votes on behalf of
+// its delegators until they vote themselves.
+func (k Keeper) votingPower(ctx context.Context, voterAddr sdk.AccAddress) (math.LegacyDec, error) {
+	validators, err := k.getCurrentValidators(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	valAddrStr, err := k.sk.ValidatorAddressCodec().BytesToString(voterAddr)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	votingPower := math.LegacyZeroDec()
+	if val, ok := validators[valAddrStr]; ok {
+		votingPower = math.LegacyNewDecFromInt(val.BondedTokens)
+	}
+
+	err = k.sk.IterateDelegations(ctx, voterAddr, func(_ int64, delegation sdk.DelegationI) (stop bool) {
+		// the self-delegation of a validator is already counted in its bonded tokens
+		if delegation.GetValidatorAddr() == valAddrStr {
+			return false
+		}
+
+		if val, ok := validators[delegation.GetValidatorAddr()]; ok && val.DelegatorShares.IsPositive() {
+			// delegation shares * bonded / total shares
+			votingPower = votingPower.Add(delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares))
+		}
+
+		return false
+	})
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return votingPower, nil
+}
+
+// GetWhaleAlerts returns the aggregated whale alerts of a proposal.
+func (k Keeper) GetWhaleAlerts(ctx context.Context, proposalID uint64) (v1.WhaleAlerts, error) {
+	alerts, err := k.WhaleAlerts.Get(ctx, proposalID)
+	if err != nil && !errors.IsOf(err, collections.ErrNotFound) {
+		return v1.WhaleAlerts{}, err
+	}
+
+	alerts.ProposalId = proposalID
+	return alerts, nil
+}
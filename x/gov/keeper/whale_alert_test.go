@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	v1 "cosmossdk.io/x/gov/types/v1"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestWhaleAlerts(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+	bankKeeper, stakingKeeper := mocks.bankKeeper, mocks.stakingKeeper
+	trackMockBalances(bankKeeper)
+	stakingKeeper.EXPECT().TokensFromConsensusPower(ctx, gomock.Any()).DoAndReturn(func(ctx sdk.Context, power int64) sdkmath.Int {
+		return sdk.TokensFromConsensusPower(power, sdkmath.NewIntFromUint64(1000000))
+	}).AnyTimes()
+	stakingKeeper.EXPECT().BondDenom(ctx).Return(sdk.DefaultBondDenom, nil).AnyTimes()
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+	stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(sdkmath.NewInt(1000000), nil).AnyTimes()
+
+	addrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 3, sdkmath.NewInt(100000000))
+	valAddr := sdk.ValAddress(addrs[0])
+
+	// a single bonded validator holding 40% of the bonded tokens, the third
+	// account delegating 10% of them
+	stakingKeeper.EXPECT().
+		IterateBondedValidatorsByPower(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(index int64, validator sdk.ValidatorI) bool) error {
+			fn(0, stakingtypes.Validator{
+				OperatorAddress: valAddr.String(),
+				Status:          stakingtypes.Bonded,
+				Tokens:          sdkmath.NewInt(400000),
+				DelegatorShares: sdkmath.LegacyNewDec(400000),
+			})
+			return nil
+		}).AnyTimes()
+	stakingKeeper.EXPECT().
+		IterateDelegations(gomock.Any(), addrs[2], gomock.Any()).
+		DoAndReturn(func(ctx context.Context, voter sdk.AccAddress, fn func(index int64, d sdk.DelegationI) bool) error {
+			fn(0, stakingtypes.NewDelegation(addrs[2].String(), valAddr.String(), sdkmath.LegacyNewDec(100000)))
+			return nil
+		}).AnyTimes()
+	stakingKeeper.EXPECT().IterateDelegations(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	params, err := govKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.WhaleAlert = &v1.WhaleAlertParams{
+		VoteThreshold:    "0.25",
+		DepositThreshold: "0.5",
+	}
+	require.NoError(t, govKeeper.Params.Set(ctx, params))
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+	require.NoError(t, err)
+
+	// deposits above half of the minimum deposit raise an alert
+	minDeposit := params.MinDeposit[0].Amount
+	small := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, minDeposit.QuoRaw(4)))
+	large := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, minDeposit))
+	_, err = govKeeper.AddDeposit(ctx, proposal.Id, addrs[1], small)
+	require.NoError(t, err)
+
+	alerts, err := govKeeper.GetWhaleAlerts(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, v1.WhaleAlerts{ProposalId: proposal.Id}, alerts)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	activated, err := govKeeper.AddDeposit(ctx, proposal.Id, addrs[1], large)
+	require.NoError(t, err)
+	require.True(t, activated)
+	requireEvent(t, ctx, "cosmos.gov.v1.EventWhaleDeposit")
+
+	alerts, err = govKeeper.GetWhaleAlerts(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), alerts.DepositAlerts)
+	require.Equal(t, large, sdk.NewCoins(alerts.WhaleDeposits...))
+
+	// the validator votes with 40% of the bonded tokens, above the threshold,
+	// while the delegator only holds 10% of them
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	requireEvent(t, ctx, "cosmos.gov.v1.EventWhaleVote")
+	require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, addrs[2], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+	require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, addrs[1], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+
+	alerts, err = govKeeper.GetWhaleAlerts(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), alerts.VoteAlerts)
+	require.Equal(t, uint64(1), alerts.DepositAlerts)
+}
+
+func requireEvent(t *testing.T, ctx sdk.Context, eventType string) {
+	t.Helper()
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			return
+		}
+	}
+	require.Failf(t, "event not emitted", "missing event %s", eventType)
+}
@@ -7,6 +7,7 @@ package testutil
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	address "cosmossdk.io/core/address"
 	math "cosmossdk.io/math"
@@ -185,6 +186,51 @@ func (mr *MockBankKeeperMockRecorder) Balance(arg0, arg1 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Balance", reflect.TypeOf((*MockBankKeeper)(nil).Balance), arg0, arg1)
 }
 
+// BalanceAlerts mocks base method.
+func (m *MockBankKeeper) BalanceAlerts(arg0 context.Context, arg1 *types.QueryBalanceAlertsRequest) (*types.QueryBalanceAlertsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BalanceAlerts", arg0, arg1)
+	ret0, _ := ret[0].(*types.QueryBalanceAlertsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BalanceAlerts indicates an expected call of BalanceAlerts.
+func (mr *MockBankKeeperMockRecorder) BalanceAlerts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalanceAlerts", reflect.TypeOf((*MockBankKeeper)(nil).BalanceAlerts), arg0, arg1)
+}
+
+// BalanceHistory mocks base method.
+func (m *MockBankKeeper) BalanceHistory(arg0 context.Context, arg1 *types.QueryBalanceHistoryRequest) (*types.QueryBalanceHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BalanceHistory", arg0, arg1)
+	ret0, _ := ret[0].(*types.QueryBalanceHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BalanceHistory indicates an expected call of BalanceHistory.
+func (mr *MockBankKeeperMockRecorder) BalanceHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalanceHistory", reflect.TypeOf((*MockBankKeeper)(nil).BalanceHistory), arg0, arg1)
+}
+
+// BalancesBatch mocks base method.
+func (m *MockBankKeeper) BalancesBatch(arg0 context.Context, arg1 *types.QueryBalancesBatchRequest) (*types.QueryBalancesBatchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BalancesBatch", arg0, arg1)
+	ret0, _ := ret[0].(*types.QueryBalancesBatchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BalancesBatch indicates an expected call of BalancesBatch.
+func (mr *MockBankKeeperMockRecorder) BalancesBatch(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalancesBatch", reflect.TypeOf((*MockBankKeeper)(nil).BalancesBatch), arg0, arg1)
+}
+
 // BlockedAddr mocks base method.
 func (m *MockBankKeeper) BlockedAddr(addr types0.AccAddress) bool {
 	m.ctrl.T.Helper()
@@ -213,6 +259,35 @@ func (mr *MockBankKeeperMockRecorder) BurnCoins(ctx, address, amt interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BurnCoins", reflect.TypeOf((*MockBankKeeper)(nil).BurnCoins), ctx, address, amt)
 }
 
+// ChangeFactoryDenomAdmin mocks base method.
+func (m *MockBankKeeper) ChangeFactoryDenomAdmin(ctx context.Context, admin, denom, newAdmin string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeFactoryDenomAdmin", ctx, admin, denom, newAdmin)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeFactoryDenomAdmin indicates an expected call of ChangeFactoryDenomAdmin.
+func (mr *MockBankKeeperMockRecorder) ChangeFactoryDenomAdmin(ctx, admin, denom, newAdmin interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeFactoryDenomAdmin", reflect.TypeOf((*MockBankKeeper)(nil).ChangeFactoryDenomAdmin), ctx, admin, denom, newAdmin)
+}
+
+// CirculatingSupply mocks base method.
+func (m *MockBankKeeper) CirculatingSupply(arg0 context.Context, arg1 *types.QueryCirculatingSupplyRequest) (*types.QueryCirculatingSupplyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CirculatingSupply", arg0, arg1)
+	ret0, _ := ret[0].(*types.QueryCirculatingSupplyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CirculatingSupply indicates an expected call of CirculatingSupply.
+func (mr *MockBankKeeperMockRecorder) CirculatingSupply(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CirculatingSupply", reflect.TypeOf((*MockBankKeeper)(nil).CirculatingSupply), arg0, arg1)
+}
+
 // ClearSendRestriction mocks base method.
 func (m *MockBankKeeper) ClearSendRestriction() {
 	m.ctrl.T.Helper()
@@ -225,6 +300,21 @@ func (mr *MockBankKeeperMockRecorder) ClearSendRestriction() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearSendRestriction", reflect.TypeOf((*MockBankKeeper)(nil).ClearSendRestriction))
 }
 
+// CreateFactoryDenom mocks base method.
+func (m *MockBankKeeper) CreateFactoryDenom(ctx context.Context, creator, subdenom string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFactoryDenom", ctx, creator, subdenom)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFactoryDenom indicates an expected call of CreateFactoryDenom.
+func (mr *MockBankKeeperMockRecorder) CreateFactoryDenom(ctx, creator, subdenom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFactoryDenom", reflect.TypeOf((*MockBankKeeper)(nil).CreateFactoryDenom), ctx, creator, subdenom)
+}
+
 // DelegateCoins mocks base method.
 func (m *MockBankKeeper) DelegateCoins(ctx context.Context, delegatorAddr, moduleAccAddr types0.AccAddress, amt types0.Coins) error {
 	m.ctrl.T.Helper()
@@ -330,6 +420,66 @@ func (mr *MockBankKeeperMockRecorder) DenomOwnersByQuery(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenomOwnersByQuery", reflect.TypeOf((*MockBankKeeper)(nil).DenomOwnersByQuery), arg0, arg1)
 }
 
+// DenomTransferFee mocks base method.
+func (m *MockBankKeeper) DenomTransferFee(arg0 context.Context, arg1 *types.QueryDenomTransferFeeRequest) (*types.QueryDenomTransferFeeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DenomTransferFee", arg0, arg1)
+	ret0, _ := ret[0].(*types.QueryDenomTransferFeeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DenomTransferFee indicates an expected call of DenomTransferFee.
+func (mr *MockBankKeeperMockRecorder) DenomTransferFee(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenomTransferFee", reflect.TypeOf((*MockBankKeeper)(nil).DenomTransferFee), arg0, arg1)
+}
+
+// DenomTransferFees mocks base method.
+func (m *MockBankKeeper) DenomTransferFees(arg0 context.Context, arg1 *types.QueryDenomTransferFeesRequest) (*types.QueryDenomTransferFeesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DenomTransferFees", arg0, arg1)
+	ret0, _ := ret[0].(*types.QueryDenomTransferFeesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DenomTransferFees indicates an expected call of DenomTransferFees.
+func (mr *MockBankKeeperMockRecorder) DenomTransferFees(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenomTransferFees", reflect.TypeOf((*MockBankKeeper)(nil).DenomTransferFees), arg0, arg1)
+}
+
+// DenomTransferHook mocks base method.
+func (m *MockBankKeeper) DenomTransferHook(arg0 context.Context, arg1 *types.QueryDenomTransferHookRequest) (*types.QueryDenomTransferHookResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DenomTransferHook", arg0, arg1)
+	ret0, _ := ret[0].(*types.QueryDenomTransferHookResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DenomTransferHook indicates an expected call of DenomTransferHook.
+func (mr *MockBankKeeperMockRecorder) DenomTransferHook(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenomTransferHook", reflect.TypeOf((*MockBankKeeper)(nil).DenomTransferHook), arg0, arg1)
+}
+
+// DenomTransferHooks mocks base method.
+func (m *MockBankKeeper) DenomTransferHooks(arg0 context.Context, arg1 *types.QueryDenomTransferHooksRequest) (*types.QueryDenomTransferHooksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DenomTransferHooks", arg0, arg1)
+	ret0, _ := ret[0].(*types.QueryDenomTransferHooksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DenomTransferHooks indicates an expected call of DenomTransferHooks.
+func (mr *MockBankKeeperMockRecorder) DenomTransferHooks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenomTransferHooks", reflect.TypeOf((*MockBankKeeper)(nil).DenomTransferHooks), arg0, arg1)
+}
+
 // DenomsMetadata mocks base method.
 func (m *MockBankKeeper) DenomsMetadata(arg0 context.Context, arg1 *types.QueryDenomsMetadataRequest) (*types.QueryDenomsMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -345,6 +495,20 @@ func (mr *MockBankKeeperMockRecorder) DenomsMetadata(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenomsMetadata", reflect.TypeOf((*MockBankKeeper)(nil).DenomsMetadata), arg0, arg1)
 }
 
+// EmitModuleTransferTrace mocks base method.
+func (m *MockBankKeeper) EmitModuleTransferTrace(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EmitModuleTransferTrace", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EmitModuleTransferTrace indicates an expected call of EmitModuleTransferTrace.
+func (mr *MockBankKeeperMockRecorder) EmitModuleTransferTrace(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmitModuleTransferTrace", reflect.TypeOf((*MockBankKeeper)(nil).EmitModuleTransferTrace), ctx)
+}
+
 // ExportGenesis mocks base method.
 func (m *MockBankKeeper) ExportGenesis(arg0 context.Context) *types.GenesisState {
 	m.ctrl.T.Helper()
@@ -359,6 +523,78 @@ func (mr *MockBankKeeperMockRecorder) ExportGenesis(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportGenesis", reflect.TypeOf((*MockBankKeeper)(nil).ExportGenesis), arg0)
 }
 
+// FactoryBurn mocks base method.
+func (m *MockBankKeeper) FactoryBurn(ctx context.Context, admin string, amount types0.Coin, burnFrom string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FactoryBurn", ctx, admin, amount, burnFrom)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FactoryBurn indicates an expected call of FactoryBurn.
+func (mr *MockBankKeeperMockRecorder) FactoryBurn(ctx, admin, amount, burnFrom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FactoryBurn", reflect.TypeOf((*MockBankKeeper)(nil).FactoryBurn), ctx, admin, amount, burnFrom)
+}
+
+// FactoryDenom mocks base method.
+func (m *MockBankKeeper) FactoryDenom(arg0 context.Context, arg1 *types.QueryFactoryDenomRequest) (*types.QueryFactoryDenomResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FactoryDenom", arg0, arg1)
+	ret0, _ := ret[0].(*types.QueryFactoryDenomResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FactoryDenom indicates an expected call of FactoryDenom.
+func (mr *MockBankKeeperMockRecorder) FactoryDenom(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FactoryDenom", reflect.TypeOf((*MockBankKeeper)(nil).FactoryDenom), arg0, arg1)
+}
+
+// FactoryDenomsFromCreator mocks base method.
+func (m *MockBankKeeper) FactoryDenomsFromCreator(arg0 context.Context, arg1 *types.QueryFactoryDenomsFromCreatorRequest) (*types.QueryFactoryDenomsFromCreatorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FactoryDenomsFromCreator", arg0, arg1)
+	ret0, _ := ret[0].(*types.QueryFactoryDenomsFromCreatorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FactoryDenomsFromCreator indicates an expected call of FactoryDenomsFromCreator.
+func (mr *MockBankKeeperMockRecorder) FactoryDenomsFromCreator(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FactoryDenomsFromCreator", reflect.TypeOf((*MockBankKeeper)(nil).FactoryDenomsFromCreator), arg0, arg1)
+}
+
+// FactoryMint mocks base method.
+func (m *MockBankKeeper) FactoryMint(ctx context.Context, admin string, amount types0.Coin, mintTo string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FactoryMint", ctx, admin, amount, mintTo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FactoryMint indicates an expected call of FactoryMint.
+func (mr *MockBankKeeperMockRecorder) FactoryMint(ctx, admin, amount, mintTo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FactoryMint", reflect.TypeOf((*MockBankKeeper)(nil).FactoryMint), ctx, admin, amount, mintTo)
+}
+
+// ForceTransfer mocks base method.
+func (m *MockBankKeeper) ForceTransfer(ctx context.Context, admin string, amount types0.Coin, from, to string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForceTransfer", ctx, admin, amount, from, to)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ForceTransfer indicates an expected call of ForceTransfer.
+func (mr *MockBankKeeperMockRecorder) ForceTransfer(ctx, admin, amount, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceTransfer", reflect.TypeOf((*MockBankKeeper)(nil).ForceTransfer), ctx, admin, amount, from, to)
+}
+
 // GetAccountsBalances mocks base method.
 func (m *MockBankKeeper) GetAccountsBalances(ctx context.Context) []types.Balance {
 	m.ctrl.T.Helper()
@@ -373,6 +609,51 @@ func (mr *MockBankKeeperMockRecorder) GetAccountsBalances(ctx interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountsBalances", reflect.TypeOf((*MockBankKeeper)(nil).GetAccountsBalances), ctx)
 }
 
+// GetAllAccountActivities mocks base method.
+func (m *MockBankKeeper) GetAllAccountActivities(ctx context.Context) ([]types.AccountActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllAccountActivities", ctx)
+	ret0, _ := ret[0].([]types.AccountActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllAccountActivities indicates an expected call of GetAllAccountActivities.
+func (mr *MockBankKeeperMockRecorder) GetAllAccountActivities(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllAccountActivities", reflect.TypeOf((*MockBankKeeper)(nil).GetAllAccountActivities), ctx)
+}
+
+// GetAllBalanceAlerts mocks base method.
+func (m *MockBankKeeper) GetAllBalanceAlerts(ctx context.Context) ([]types.BalanceAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllBalanceAlerts", ctx)
+	ret0, _ := ret[0].([]types.BalanceAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllBalanceAlerts indicates an expected call of GetAllBalanceAlerts.
+func (mr *MockBankKeeperMockRecorder) GetAllBalanceAlerts(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllBalanceAlerts", reflect.TypeOf((*MockBankKeeper)(nil).GetAllBalanceAlerts), ctx)
+}
+
+// GetAllBalanceJournalEntries mocks base method.
+func (m *MockBankKeeper) GetAllBalanceJournalEntries(ctx context.Context) ([]types.BalanceJournalEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllBalanceJournalEntries", ctx)
+	ret0, _ := ret[0].([]types.BalanceJournalEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllBalanceJournalEntries indicates an expected call of GetAllBalanceJournalEntries.
+func (mr *MockBankKeeperMockRecorder) GetAllBalanceJournalEntries(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllBalanceJournalEntries", reflect.TypeOf((*MockBankKeeper)(nil).GetAllBalanceJournalEntries), ctx)
+}
+
 // GetAllBalances mocks base method.
 func (m *MockBankKeeper) GetAllBalances(ctx context.Context, addr types0.AccAddress) types0.Coins {
 	m.ctrl.T.Helper()
@@ -401,6 +682,81 @@ func (mr *MockBankKeeperMockRecorder) GetAllDenomMetaData(ctx interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).GetAllDenomMetaData), ctx)
 }
 
+// GetAllDenomTransferFees mocks base method.
+func (m *MockBankKeeper) GetAllDenomTransferFees(ctx context.Context) ([]types.DenomTransferFee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllDenomTransferFees", ctx)
+	ret0, _ := ret[0].([]types.DenomTransferFee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllDenomTransferFees indicates an expected call of GetAllDenomTransferFees.
+func (mr *MockBankKeeperMockRecorder) GetAllDenomTransferFees(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDenomTransferFees", reflect.TypeOf((*MockBankKeeper)(nil).GetAllDenomTransferFees), ctx)
+}
+
+// GetAllDenomTransferHooks mocks base method.
+func (m *MockBankKeeper) GetAllDenomTransferHooks(ctx context.Context) ([]types.DenomTransferHook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllDenomTransferHooks", ctx)
+	ret0, _ := ret[0].([]types.DenomTransferHook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllDenomTransferHooks indicates an expected call of GetAllDenomTransferHooks.
+func (mr *MockBankKeeperMockRecorder) GetAllDenomTransferHooks(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDenomTransferHooks", reflect.TypeOf((*MockBankKeeper)(nil).GetAllDenomTransferHooks), ctx)
+}
+
+// GetAllFactoryDenoms mocks base method.
+func (m *MockBankKeeper) GetAllFactoryDenoms(ctx context.Context) ([]types.FactoryDenom, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllFactoryDenoms", ctx)
+	ret0, _ := ret[0].([]types.FactoryDenom)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllFactoryDenoms indicates an expected call of GetAllFactoryDenoms.
+func (mr *MockBankKeeperMockRecorder) GetAllFactoryDenoms(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllFactoryDenoms", reflect.TypeOf((*MockBankKeeper)(nil).GetAllFactoryDenoms), ctx)
+}
+
+// GetAllHolds mocks base method.
+func (m *MockBankKeeper) GetAllHolds(ctx context.Context) ([]types.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllHolds", ctx)
+	ret0, _ := ret[0].([]types.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllHolds indicates an expected call of GetAllHolds.
+func (mr *MockBankKeeperMockRecorder) GetAllHolds(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllHolds", reflect.TypeOf((*MockBankKeeper)(nil).GetAllHolds), ctx)
+}
+
+// GetAllNonCirculatingAddresses mocks base method.
+func (m *MockBankKeeper) GetAllNonCirculatingAddresses(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllNonCirculatingAddresses", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllNonCirculatingAddresses indicates an expected call of GetAllNonCirculatingAddresses.
+func (mr *MockBankKeeperMockRecorder) GetAllNonCirculatingAddresses(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllNonCirculatingAddresses", reflect.TypeOf((*MockBankKeeper)(nil).GetAllNonCirculatingAddresses), ctx)
+}
+
 // GetAllSendEnabledEntries mocks base method.
 func (m *MockBankKeeper) GetAllSendEnabledEntries(ctx context.Context) []types.SendEnabled {
 	m.ctrl.T.Helper()
@@ -415,6 +771,36 @@ func (mr *MockBankKeeperMockRecorder) GetAllSendEnabledEntries(ctx interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSendEnabledEntries", reflect.TypeOf((*MockBankKeeper)(nil).GetAllSendEnabledEntries), ctx)
 }
 
+// GetAllSendRestrictionPolicies mocks base method.
+func (m *MockBankKeeper) GetAllSendRestrictionPolicies(ctx context.Context) ([]types.SendRestrictionPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllSendRestrictionPolicies", ctx)
+	ret0, _ := ret[0].([]types.SendRestrictionPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllSendRestrictionPolicies indicates an expected call of GetAllSendRestrictionPolicies.
+func (mr *MockBankKeeperMockRecorder) GetAllSendRestrictionPolicies(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSendRestrictionPolicies", reflect.TypeOf((*MockBankKeeper)(nil).GetAllSendRestrictionPolicies), ctx)
+}
+
+// GetAllSpendingLimits mocks base method.
+func (m *MockBankKeeper) GetAllSpendingLimits(ctx context.Context) ([]types.SpendingLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllSpendingLimits", ctx)
+	ret0, _ := ret[0].([]types.SpendingLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllSpendingLimits indicates an expected call of GetAllSpendingLimits.
+func (mr *MockBankKeeperMockRecorder) GetAllSpendingLimits(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSpendingLimits", reflect.TypeOf((*MockBankKeeper)(nil).GetAllSpendingLimits), ctx)
+}
+
 // GetAuthority mocks base method.
 func (m *MockBankKeeper) GetAuthority() string {
 	m.ctrl.T.Helper()
@@ -457,6 +843,22 @@ func (mr *MockBankKeeperMockRecorder) GetBlockedAddresses() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedAddresses", reflect.TypeOf((*MockBankKeeper)(nil).GetBlockedAddresses))
 }
 
+// GetCirculatingSupply mocks base method.
+func (m *MockBankKeeper) GetCirculatingSupply(ctx context.Context, denom string) (types0.Coin, types0.Coin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCirculatingSupply", ctx, denom)
+	ret0, _ := ret[0].(types0.Coin)
+	ret1, _ := ret[1].(types0.Coin)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCirculatingSupply indicates an expected call of GetCirculatingSupply.
+func (mr *MockBankKeeperMockRecorder) GetCirculatingSupply(ctx, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCirculatingSupply", reflect.TypeOf((*MockBankKeeper)(nil).GetCirculatingSupply), ctx, denom)
+}
+
 // GetDenomMetaData mocks base method.
 func (m *MockBankKeeper) GetDenomMetaData(ctx context.Context, denom string) (types.Metadata, bool) {
 	m.ctrl.T.Helper()
@@ -472,6 +874,80 @@ func (mr *MockBankKeeperMockRecorder) GetDenomMetaData(ctx, denom interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).GetDenomMetaData), ctx, denom)
 }
 
+// GetDenomTransferFee mocks base method.
+func (m *MockBankKeeper) GetDenomTransferFee(ctx context.Context, denom string) (types.DenomTransferFee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDenomTransferFee", ctx, denom)
+	ret0, _ := ret[0].(types.DenomTransferFee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDenomTransferFee indicates an expected call of GetDenomTransferFee.
+func (mr *MockBankKeeperMockRecorder) GetDenomTransferFee(ctx, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDenomTransferFee", reflect.TypeOf((*MockBankKeeper)(nil).GetDenomTransferFee), ctx, denom)
+}
+
+// GetDenomTransferHook mocks base method.
+func (m *MockBankKeeper) GetDenomTransferHook(ctx context.Context, denom string) (types.DenomTransferHook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDenomTransferHook", ctx, denom)
+	ret0, _ := ret[0].(types.DenomTransferHook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDenomTransferHook indicates an expected call of GetDenomTransferHook.
+func (mr *MockBankKeeperMockRecorder) GetDenomTransferHook(ctx, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDenomTransferHook", reflect.TypeOf((*MockBankKeeper)(nil).GetDenomTransferHook), ctx, denom)
+}
+
+// GetFactoryDenom mocks base method.
+func (m *MockBankKeeper) GetFactoryDenom(ctx context.Context, denom string) (types.FactoryDenom, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFactoryDenom", ctx, denom)
+	ret0, _ := ret[0].(types.FactoryDenom)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFactoryDenom indicates an expected call of GetFactoryDenom.
+func (mr *MockBankKeeperMockRecorder) GetFactoryDenom(ctx, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFactoryDenom", reflect.TypeOf((*MockBankKeeper)(nil).GetFactoryDenom), ctx, denom)
+}
+
+// GetHeldCoins mocks base method.
+func (m *MockBankKeeper) GetHeldCoins(ctx context.Context, addr types0.AccAddress) types0.Coins {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHeldCoins", ctx, addr)
+	ret0, _ := ret[0].(types0.Coins)
+	return ret0
+}
+
+// GetHeldCoins indicates an expected call of GetHeldCoins.
+func (mr *MockBankKeeperMockRecorder) GetHeldCoins(ctx, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHeldCoins", reflect.TypeOf((*MockBankKeeper)(nil).GetHeldCoins), ctx, addr)
+}
+
+// GetModuleTransferTrace mocks base method.
+func (m *MockBankKeeper) GetModuleTransferTrace(ctx context.Context) ([]types.ModuleTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModuleTransferTrace", ctx)
+	ret0, _ := ret[0].([]types.ModuleTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModuleTransferTrace indicates an expected call of GetModuleTransferTrace.
+func (mr *MockBankKeeperMockRecorder) GetModuleTransferTrace(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModuleTransferTrace", reflect.TypeOf((*MockBankKeeper)(nil).GetModuleTransferTrace), ctx)
+}
+
 // GetPaginatedTotalSupply mocks base method.
 func (m *MockBankKeeper) GetPaginatedTotalSupply(ctx context.Context, pagination *query.PageRequest) (types0.Coins, *query.PageResponse, error) {
 	m.ctrl.T.Helper()
@@ -517,6 +993,21 @@ func (mr *MockBankKeeperMockRecorder) GetSendEnabledEntry(ctx, denom interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSendEnabledEntry", reflect.TypeOf((*MockBankKeeper)(nil).GetSendEnabledEntry), ctx, denom)
 }
 
+// GetSendRestrictionPolicy mocks base method.
+func (m *MockBankKeeper) GetSendRestrictionPolicy(ctx context.Context, name string) (types.SendRestrictionPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSendRestrictionPolicy", ctx, name)
+	ret0, _ := ret[0].(types.SendRestrictionPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSendRestrictionPolicy indicates an expected call of GetSendRestrictionPolicy.
+func (mr *MockBankKeeperMockRecorder) GetSendRestrictionPolicy(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSendRestrictionPolicy", reflect.TypeOf((*MockBankKeeper)(nil).GetSendRestrictionPolicy), ctx, name)
+}
+
 // GetSupply mocks base method.
 func (m *MockBankKeeper) GetSupply(ctx context.Context, denom string) types0.Coin {
 	m.ctrl.T.Helper()
@@ -573,6 +1064,21 @@ func (mr *MockBankKeeperMockRecorder) HasSupply(ctx, denom interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasSupply", reflect.TypeOf((*MockBankKeeper)(nil).HasSupply), ctx, denom)
 }
 
+// Holds mocks base method.
+func (m *MockBankKeeper) Holds(arg0 context.Context, arg1 *types.QueryHoldsRequest) (*types.QueryHoldsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Holds", arg0, arg1)
+	ret0, _ := ret[0].(*types.QueryHoldsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Holds indicates an expected call of Holds.
+func (mr *MockBankKeeperMockRecorder) Holds(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Holds", reflect.TypeOf((*MockBankKeeper)(nil).Holds), arg0, arg1)
+}
+
 // InitGenesis mocks base method.
 func (m *MockBankKeeper) InitGenesis(arg0 context.Context, arg1 *types.GenesisState) {
 	m.ctrl.T.Helper()
@@ -734,6 +1240,21 @@ func (mr *MockBankKeeperMockRecorder) MintCoins(ctx, moduleName, amt interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MintCoins", reflect.TypeOf((*MockBankKeeper)(nil).MintCoins), ctx, moduleName, amt)
 }
 
+// NonCirculatingAddresses mocks base method.
+func (m *MockBankKeeper) NonCirculatingAddresses(arg0 context.Context, arg1 *types.QueryNonCirculatingAddressesRequest) (*types.QueryNonCirculatingAddressesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NonCirculatingAddresses", arg0, arg1)
+	ret0, _ := ret[0].(*types.QueryNonCirculatingAddressesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NonCirculatingAddresses indicates an expected call of NonCirculatingAddresses.
+func (mr *MockBankKeeperMockRecorder) NonCirculatingAddresses(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NonCirculatingAddresses", reflect.TypeOf((*MockBankKeeper)(nil).NonCirculatingAddresses), arg0, arg1)
+}
+
 // Params mocks base method.
 func (m *MockBankKeeper) Params(arg0 context.Context, arg1 *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	m.ctrl.T.Helper()
@@ -749,6 +1270,20 @@ func (mr *MockBankKeeperMockRecorder) Params(arg0, arg1 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Params", reflect.TypeOf((*MockBankKeeper)(nil).Params), arg0, arg1)
 }
 
+// PlaceHold mocks base method.
+func (m *MockBankKeeper) PlaceHold(ctx context.Context, holder string, addr types0.AccAddress, amt types0.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaceHold", ctx, holder, addr, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PlaceHold indicates an expected call of PlaceHold.
+func (mr *MockBankKeeperMockRecorder) PlaceHold(ctx, holder, addr, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaceHold", reflect.TypeOf((*MockBankKeeper)(nil).PlaceHold), ctx, holder, addr, amt)
+}
+
 // PrependSendRestriction mocks base method.
 func (m *MockBankKeeper) PrependSendRestriction(restriction types.SendRestrictionFn) {
 	m.ctrl.T.Helper()
@@ -761,6 +1296,118 @@ func (mr *MockBankKeeperMockRecorder) PrependSendRestriction(restriction interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrependSendRestriction", reflect.TypeOf((*MockBankKeeper)(nil).PrependSendRestriction), restriction)
 }
 
+// PruneBalanceJournal mocks base method.
+func (m *MockBankKeeper) PruneBalanceJournal(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneBalanceJournal", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PruneBalanceJournal indicates an expected call of PruneBalanceJournal.
+func (mr *MockBankKeeperMockRecorder) PruneBalanceJournal(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneBalanceJournal", reflect.TypeOf((*MockBankKeeper)(nil).PruneBalanceJournal), ctx)
+}
+
+// RegisterDenomMetadata mocks base method.
+func (m *MockBankKeeper) RegisterDenomMetadata(ctx context.Context, sender string, metadata types.Metadata) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterDenomMetadata", ctx, sender, metadata)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterDenomMetadata indicates an expected call of RegisterDenomMetadata.
+func (mr *MockBankKeeperMockRecorder) RegisterDenomMetadata(ctx, sender, metadata interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterDenomMetadata", reflect.TypeOf((*MockBankKeeper)(nil).RegisterDenomMetadata), ctx, sender, metadata)
+}
+
+// ReleaseHold mocks base method.
+func (m *MockBankKeeper) ReleaseHold(ctx context.Context, holder string, addr types0.AccAddress, amt types0.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseHold", ctx, holder, addr, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseHold indicates an expected call of ReleaseHold.
+func (mr *MockBankKeeperMockRecorder) ReleaseHold(ctx, holder, addr, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseHold", reflect.TypeOf((*MockBankKeeper)(nil).ReleaseHold), ctx, holder, addr, amt)
+}
+
+// RemoveDenomTransferFee mocks base method.
+func (m *MockBankKeeper) RemoveDenomTransferFee(ctx context.Context, denom string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveDenomTransferFee", ctx, denom)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveDenomTransferFee indicates an expected call of RemoveDenomTransferFee.
+func (mr *MockBankKeeperMockRecorder) RemoveDenomTransferFee(ctx, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDenomTransferFee", reflect.TypeOf((*MockBankKeeper)(nil).RemoveDenomTransferFee), ctx, denom)
+}
+
+// RemoveDenomTransferHook mocks base method.
+func (m *MockBankKeeper) RemoveDenomTransferHook(ctx context.Context, denom string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveDenomTransferHook", ctx, denom)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveDenomTransferHook indicates an expected call of RemoveDenomTransferHook.
+func (mr *MockBankKeeperMockRecorder) RemoveDenomTransferHook(ctx, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDenomTransferHook", reflect.TypeOf((*MockBankKeeper)(nil).RemoveDenomTransferHook), ctx, denom)
+}
+
+// RemoveNonCirculatingAddress mocks base method.
+func (m *MockBankKeeper) RemoveNonCirculatingAddress(ctx context.Context, address string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveNonCirculatingAddress", ctx, address)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveNonCirculatingAddress indicates an expected call of RemoveNonCirculatingAddress.
+func (mr *MockBankKeeperMockRecorder) RemoveNonCirculatingAddress(ctx, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNonCirculatingAddress", reflect.TypeOf((*MockBankKeeper)(nil).RemoveNonCirculatingAddress), ctx, address)
+}
+
+// RemoveSendRestrictionPolicy mocks base method.
+func (m *MockBankKeeper) RemoveSendRestrictionPolicy(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveSendRestrictionPolicy", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveSendRestrictionPolicy indicates an expected call of RemoveSendRestrictionPolicy.
+func (mr *MockBankKeeperMockRecorder) RemoveSendRestrictionPolicy(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSendRestrictionPolicy", reflect.TypeOf((*MockBankKeeper)(nil).RemoveSendRestrictionPolicy), ctx, name)
+}
+
+// ReplaceDenomMetadata mocks base method.
+func (m *MockBankKeeper) ReplaceDenomMetadata(ctx context.Context, sender string, metadata types.Metadata) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceDenomMetadata", ctx, sender, metadata)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceDenomMetadata indicates an expected call of ReplaceDenomMetadata.
+func (mr *MockBankKeeperMockRecorder) ReplaceDenomMetadata(ctx, sender, metadata interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceDenomMetadata", reflect.TypeOf((*MockBankKeeper)(nil).ReplaceDenomMetadata), ctx, sender, metadata)
+}
+
 // SendCoins mocks base method.
 func (m *MockBankKeeper) SendCoins(ctx context.Context, fromAddr, toAddr types0.AccAddress, amt types0.Coins) error {
 	m.ctrl.T.Helper()
@@ -832,6 +1479,50 @@ func (mr *MockBankKeeperMockRecorder) SendEnabled(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEnabled", reflect.TypeOf((*MockBankKeeper)(nil).SendEnabled), arg0, arg1)
 }
 
+// SendRestrictionPolicies mocks base method.
+func (m *MockBankKeeper) SendRestrictionPolicies(arg0 context.Context, arg1 *types.QuerySendRestrictionPoliciesRequest) (*types.QuerySendRestrictionPoliciesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendRestrictionPolicies", arg0, arg1)
+	ret0, _ := ret[0].(*types.QuerySendRestrictionPoliciesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendRestrictionPolicies indicates an expected call of SendRestrictionPolicies.
+func (mr *MockBankKeeperMockRecorder) SendRestrictionPolicies(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendRestrictionPolicies", reflect.TypeOf((*MockBankKeeper)(nil).SendRestrictionPolicies), arg0, arg1)
+}
+
+// SendRestrictionPolicy mocks base method.
+func (m *MockBankKeeper) SendRestrictionPolicy(arg0 context.Context, arg1 *types.QuerySendRestrictionPolicyRequest) (*types.QuerySendRestrictionPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendRestrictionPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*types.QuerySendRestrictionPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendRestrictionPolicy indicates an expected call of SendRestrictionPolicy.
+func (mr *MockBankKeeperMockRecorder) SendRestrictionPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendRestrictionPolicy", reflect.TypeOf((*MockBankKeeper)(nil).SendRestrictionPolicy), arg0, arg1)
+}
+
+// SetAccountActivity mocks base method.
+func (m *MockBankKeeper) SetAccountActivity(ctx context.Context, activity types.AccountActivity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAccountActivity", ctx, activity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAccountActivity indicates an expected call of SetAccountActivity.
+func (mr *MockBankKeeperMockRecorder) SetAccountActivity(ctx, activity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAccountActivity", reflect.TypeOf((*MockBankKeeper)(nil).SetAccountActivity), ctx, activity)
+}
+
 // SetAllSendEnabled mocks base method.
 func (m *MockBankKeeper) SetAllSendEnabled(ctx context.Context, sendEnableds []*types.SendEnabled) {
 	m.ctrl.T.Helper()
@@ -844,6 +1535,34 @@ func (mr *MockBankKeeperMockRecorder) SetAllSendEnabled(ctx, sendEnableds interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAllSendEnabled", reflect.TypeOf((*MockBankKeeper)(nil).SetAllSendEnabled), ctx, sendEnableds)
 }
 
+// SetBalanceAlert mocks base method.
+func (m *MockBankKeeper) SetBalanceAlert(ctx context.Context, alert types.BalanceAlert) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBalanceAlert", ctx, alert)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetBalanceAlert indicates an expected call of SetBalanceAlert.
+func (mr *MockBankKeeperMockRecorder) SetBalanceAlert(ctx, alert interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBalanceAlert", reflect.TypeOf((*MockBankKeeper)(nil).SetBalanceAlert), ctx, alert)
+}
+
+// SetBalanceJournalEntry mocks base method.
+func (m *MockBankKeeper) SetBalanceJournalEntry(ctx context.Context, entry types.BalanceJournalEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBalanceJournalEntry", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetBalanceJournalEntry indicates an expected call of SetBalanceJournalEntry.
+func (mr *MockBankKeeperMockRecorder) SetBalanceJournalEntry(ctx, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBalanceJournalEntry", reflect.TypeOf((*MockBankKeeper)(nil).SetBalanceJournalEntry), ctx, entry)
+}
+
 // SetDenomMetaData mocks base method.
 func (m *MockBankKeeper) SetDenomMetaData(ctx context.Context, denomMetaData types.Metadata) {
 	m.ctrl.T.Helper()
@@ -856,6 +1575,90 @@ func (mr *MockBankKeeperMockRecorder) SetDenomMetaData(ctx, denomMetaData interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomMetaData), ctx, denomMetaData)
 }
 
+// SetDenomTransferFee mocks base method.
+func (m *MockBankKeeper) SetDenomTransferFee(ctx context.Context, fee types.DenomTransferFee) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDenomTransferFee", ctx, fee)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDenomTransferFee indicates an expected call of SetDenomTransferFee.
+func (mr *MockBankKeeperMockRecorder) SetDenomTransferFee(ctx, fee interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomTransferFee", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomTransferFee), ctx, fee)
+}
+
+// SetDenomTransferHook mocks base method.
+func (m *MockBankKeeper) SetDenomTransferHook(ctx context.Context, hook types.DenomTransferHook) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDenomTransferHook", ctx, hook)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDenomTransferHook indicates an expected call of SetDenomTransferHook.
+func (mr *MockBankKeeperMockRecorder) SetDenomTransferHook(ctx, hook interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomTransferHook", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomTransferHook), ctx, hook)
+}
+
+// SetFactoryDenom mocks base method.
+func (m *MockBankKeeper) SetFactoryDenom(ctx context.Context, factoryDenom types.FactoryDenom) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFactoryDenom", ctx, factoryDenom)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFactoryDenom indicates an expected call of SetFactoryDenom.
+func (mr *MockBankKeeperMockRecorder) SetFactoryDenom(ctx, factoryDenom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFactoryDenom", reflect.TypeOf((*MockBankKeeper)(nil).SetFactoryDenom), ctx, factoryDenom)
+}
+
+// SetFactoryDenomMetadata mocks base method.
+func (m *MockBankKeeper) SetFactoryDenomMetadata(ctx context.Context, admin string, metadata types.Metadata) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFactoryDenomMetadata", ctx, admin, metadata)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFactoryDenomMetadata indicates an expected call of SetFactoryDenomMetadata.
+func (mr *MockBankKeeperMockRecorder) SetFactoryDenomMetadata(ctx, admin, metadata interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFactoryDenomMetadata", reflect.TypeOf((*MockBankKeeper)(nil).SetFactoryDenomMetadata), ctx, admin, metadata)
+}
+
+// SetHold mocks base method.
+func (m *MockBankKeeper) SetHold(ctx context.Context, hold types.Hold) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHold", ctx, hold)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHold indicates an expected call of SetHold.
+func (mr *MockBankKeeperMockRecorder) SetHold(ctx, hold interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHold", reflect.TypeOf((*MockBankKeeper)(nil).SetHold), ctx, hold)
+}
+
+// SetNonCirculatingAddress mocks base method.
+func (m *MockBankKeeper) SetNonCirculatingAddress(ctx context.Context, address string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNonCirculatingAddress", ctx, address)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetNonCirculatingAddress indicates an expected call of SetNonCirculatingAddress.
+func (mr *MockBankKeeperMockRecorder) SetNonCirculatingAddress(ctx, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNonCirculatingAddress", reflect.TypeOf((*MockBankKeeper)(nil).SetNonCirculatingAddress), ctx, address)
+}
+
 // SetParams mocks base method.
 func (m *MockBankKeeper) SetParams(ctx context.Context, params types.Params) error {
 	m.ctrl.T.Helper()
@@ -882,6 +1685,34 @@ func (mr *MockBankKeeperMockRecorder) SetSendEnabled(ctx, denom, value interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSendEnabled", reflect.TypeOf((*MockBankKeeper)(nil).SetSendEnabled), ctx, denom, value)
 }
 
+// SetSendRestrictionPolicy mocks base method.
+func (m *MockBankKeeper) SetSendRestrictionPolicy(ctx context.Context, policy types.SendRestrictionPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSendRestrictionPolicy", ctx, policy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSendRestrictionPolicy indicates an expected call of SetSendRestrictionPolicy.
+func (mr *MockBankKeeperMockRecorder) SetSendRestrictionPolicy(ctx, policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSendRestrictionPolicy", reflect.TypeOf((*MockBankKeeper)(nil).SetSendRestrictionPolicy), ctx, policy)
+}
+
+// SetSpendingLimit mocks base method.
+func (m *MockBankKeeper) SetSpendingLimit(ctx context.Context, limit types.SpendingLimit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSpendingLimit", ctx, limit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSpendingLimit indicates an expected call of SetSpendingLimit.
+func (mr *MockBankKeeperMockRecorder) SetSpendingLimit(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSpendingLimit", reflect.TypeOf((*MockBankKeeper)(nil).SetSpendingLimit), ctx, limit)
+}
+
 // SpendableBalanceByDenom mocks base method.
 func (m *MockBankKeeper) SpendableBalanceByDenom(arg0 context.Context, arg1 *types.QuerySpendableBalanceByDenomRequest) (*types.QuerySpendableBalanceByDenomResponse, error) {
 	m.ctrl.T.Helper()
@@ -940,6 +1771,21 @@ func (mr *MockBankKeeperMockRecorder) SpendableCoins(ctx, addr interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableCoins", reflect.TypeOf((*MockBankKeeper)(nil).SpendableCoins), ctx, addr)
 }
 
+// SpendingLimits mocks base method.
+func (m *MockBankKeeper) SpendingLimits(arg0 context.Context, arg1 *types.QuerySpendingLimitsRequest) (*types.QuerySpendingLimitsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SpendingLimits", arg0, arg1)
+	ret0, _ := ret[0].(*types.QuerySpendingLimitsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SpendingLimits indicates an expected call of SpendingLimits.
+func (mr *MockBankKeeperMockRecorder) SpendingLimits(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendingLimits", reflect.TypeOf((*MockBankKeeper)(nil).SpendingLimits), arg0, arg1)
+}
+
 // SupplyOf mocks base method.
 func (m *MockBankKeeper) SupplyOf(arg0 context.Context, arg1 *types.QuerySupplyOfRequest) (*types.QuerySupplyOfResponse, error) {
 	m.ctrl.T.Helper()
@@ -955,6 +1801,22 @@ func (mr *MockBankKeeperMockRecorder) SupplyOf(arg0, arg1 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SupplyOf", reflect.TypeOf((*MockBankKeeper)(nil).SupplyOf), arg0, arg1)
 }
 
+// SweepDust mocks base method.
+func (m *MockBankKeeper) SweepDust(ctx context.Context, sender string, addresses []string) ([]string, types0.Coins, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SweepDust", ctx, sender, addresses)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(types0.Coins)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SweepDust indicates an expected call of SweepDust.
+func (mr *MockBankKeeperMockRecorder) SweepDust(ctx, sender, addresses interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SweepDust", reflect.TypeOf((*MockBankKeeper)(nil).SweepDust), ctx, sender, addresses)
+}
+
 // TotalSupply mocks base method.
 func (m *MockBankKeeper) TotalSupply(arg0 context.Context, arg1 *types.QueryTotalSupplyRequest) (*types.QueryTotalSupplyResponse, error) {
 	m.ctrl.T.Helper()
@@ -998,6 +1860,21 @@ func (mr *MockBankKeeperMockRecorder) UndelegateCoinsFromModuleToAccount(ctx, se
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UndelegateCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).UndelegateCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
 }
 
+// UpdateSpendingLimit mocks base method.
+func (m *MockBankKeeper) UpdateSpendingLimit(ctx context.Context, address, denom string, dailyLimit math.Int) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSpendingLimit", ctx, address, denom, dailyLimit)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSpendingLimit indicates an expected call of UpdateSpendingLimit.
+func (mr *MockBankKeeperMockRecorder) UpdateSpendingLimit(ctx, address, denom, dailyLimit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSpendingLimit", reflect.TypeOf((*MockBankKeeper)(nil).UpdateSpendingLimit), ctx, address, denom, dailyLimit)
+}
+
 // ValidateBalance mocks base method.
 func (m *MockBankKeeper) ValidateBalance(ctx context.Context, addr types0.AccAddress) error {
 	m.ctrl.T.Helper()
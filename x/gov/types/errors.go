@@ -27,4 +27,8 @@ var (
 	ErrInvalidDepositDenom     = errors.Register(ModuleName, 23, "invalid deposit denom")
 	ErrTitleTooLong            = errors.Register(ModuleName, 24, "title too long")
 	ErrTooLateToCancel         = errors.Register(ModuleName, 25, "too late to cancel proposal")
+	ErrInvalidLineItemVeto     = errors.Register(ModuleName, 26, "invalid line-item veto")
+	ErrChunkedExecution        = errors.Register(ModuleName, 27, "chunked execution is disabled")
+	ErrVoteLocked              = errors.Register(ModuleName, 28, "vote locked")
+	ErrInvalidProposalDraft    = errors.Register(ModuleName, 29, "invalid proposal draft")
 )
@@ -8,6 +8,12 @@ const (
 	EventTypeInactiveProposal = "inactive_proposal"
 	EventTypeActiveProposal   = "active_proposal"
 	EventTypeCancelProposal   = "cancel_proposal"
+	EventTypeLineItemVeto     = "line_item_veto"
+	EventTypeTimelockProposal = "timelock_proposal"
+	EventTypeExecuteProposal  = "execute_proposal"
+	EventTypeCreateDraft      = "create_proposal_draft"
+	EventTypeUpdateDraft      = "update_proposal_draft"
+	EventTypePromoteDraft     = "promote_proposal_draft"
 
 	AttributeKeyProposalResult       = "proposal_result"
 	AttributeKeyVoter                = "voter"
@@ -17,6 +23,11 @@ const (
 	AttributeKeyVotingPeriodStart    = "voting_period_start"
 	AttributeKeyProposalLog          = "proposal_log"           // log of proposal execution
 	AttributeKeyProposalDepositError = "proposal_deposit_error" // error on proposal deposit refund/burn
+	AttributeKeyMessageIndex         = "message_index"          // index of a proposal message struck by the line-item veto
+	AttributeKeyExecutedMessages     = "executed_messages"      // number of proposal messages executed in the block by the chunked execution
+	AttributeKeyGasUsed              = "gas_used"               // gas consumed by the chunked execution of a proposal in the block
+	AttributeKeyDraftID              = "draft_id"
+	AttributeKeyDraftVersion         = "draft_version"
 
 	AttributeValueProposalDropped            = "proposal_dropped"             // didn't meet min deposit
 	AttributeValueProposalPassed             = "proposal_passed"              // met vote quorum
@@ -25,6 +36,8 @@ const (
 	AttributeValueOptimisticProposalRejected = "optimistic_proposal_rejected" // didn't meet optimistic vote quorum
 	AttributeValueProposalFailed             = "proposal_failed"              // error on proposal handler
 	AttributeValueProposalCanceled           = "proposal_canceled"            // error on proposal handler
+	AttributeValueProposalTimelocked         = "proposal_timelocked"          // passed, executed at the end of the line-item veto timelock
+	AttributeValueProposalExecuting          = "proposal_executing"           // passed, executed across several blocks
 
 	AttributeKeyProposalType   = "proposal_type"
 	AttributeSignalTitle       = "signal_title"
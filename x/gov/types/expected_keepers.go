@@ -65,6 +65,19 @@ type GovHooks interface {
 	AfterProposalVote(ctx context.Context, proposalID uint64, voterAddr sdk.AccAddress) error        // Must be called after a vote on a proposal is cast
 	AfterProposalFailedMinDeposit(ctx context.Context, proposalID uint64) error                      // Must be called when proposal fails to reach min deposit
 	AfterProposalVotingPeriodEnded(ctx context.Context, proposalID uint64) error                     // Must be called when proposal's finishes it's voting period
+
+	// AfterVoteCast is called once a vote has been stored, with the weighted options chosen by the voter.
+	AfterVoteCast(ctx context.Context, proposalID uint64, voterAddr sdk.AccAddress, options []VoteCastOption) error
+	// AfterDepositAdded is called once a deposit has been stored, with the amount added by this deposit
+	// and whether it activated the proposal voting period.
+	AfterDepositAdded(ctx context.Context, proposalID uint64, depositorAddr sdk.AccAddress, amount sdk.Coins, activatedVotingPeriod bool) error
+}
+
+// VoteCastOption is a single weighted option of a vote, as passed to the AfterVoteCast hook.
+// Option holds the numeric value of the v1 VoteOption enum.
+type VoteCastOption struct {
+	Option int32
+	Weight math.LegacyDec
 }
 
 type GovHooksWrapper struct{ GovHooks }
@@ -56,3 +56,19 @@ func (h MultiGovHooks) AfterProposalVotingPeriodEnded(ctx context.Context, propo
 	}
 	return errs
 }
+
+func (h MultiGovHooks) AfterVoteCast(ctx context.Context, proposalID uint64, voterAddr sdk.AccAddress, options []VoteCastOption) error {
+	var errs error
+	for i := range h {
+		errs = errors.Join(errs, h[i].AfterVoteCast(ctx, proposalID, voterAddr, options))
+	}
+	return errs
+}
+
+func (h MultiGovHooks) AfterDepositAdded(ctx context.Context, proposalID uint64, depositorAddr sdk.AccAddress, amount sdk.Coins, activatedVotingPeriod bool) error {
+	var errs error
+	for i := range h {
+		errs = errors.Join(errs, h[i].AfterDepositAdded(ctx, proposalID, depositorAddr, amount, activatedVotingPeriod))
+	}
+	return errs
+}
@@ -26,6 +26,19 @@ var (
 	ConstitutionKey              = collections.NewPrefix(49) // ConstitutionKey stores a chain's constitution.
 	ProposalVoteOptionsKeyPrefix = collections.NewPrefix(50) // ProposalVoteOptionsKeyPrefix stores the vote options of proposals.
 	MessageBasedParamsKey        = collections.NewPrefix(51) // MessageBasedParamsKey stores the message based gov params.
+
+	SecurityCouncilProposalQueuePrefix = collections.NewPrefix(52) // SecurityCouncilProposalQueuePrefix stores the active security council proposals.
+	FinishedProposalsKeyPrefix         = collections.NewPrefix(53) // FinishedProposalsKeyPrefix stores the finished proposals awaiting archival.
+	ArchivedProposalsKeyPrefix         = collections.NewPrefix(54) // ArchivedProposalsKeyPrefix stores the archived proposals summaries.
+	DepositHistoryKeyPrefix            = collections.NewPrefix(55) // DepositHistoryKeyPrefix stores the deposit records of depositors.
+	TimelockProposalQueuePrefix        = collections.NewPrefix(56) // TimelockProposalQueuePrefix stores the passed proposals awaiting execution at the end of their timelock.
+	LineItemVetoesKeyPrefix            = collections.NewPrefix(57) // LineItemVetoesKeyPrefix stores the proposal messages struck by the line-item veto authority.
+	ScheduleKeyPrefix                  = collections.NewPrefix(58) // ScheduleKeyPrefix stores the index of the upcoming governance events.
+	ProposalExecutionsKeyPrefix        = collections.NewPrefix(59) // ProposalExecutionsKeyPrefix stores the progress of the chunked executions of passed proposals.
+	ProposalDraftIDKey                 = collections.NewPrefix(60) // ProposalDraftIDKey stores the sequence representing the next proposal draft ID.
+	ProposalDraftsKeyPrefix            = collections.NewPrefix(61) // ProposalDraftsKeyPrefix stores the proposal drafts.
+	ProposalDraftVersionsKeyPrefix     = collections.NewPrefix(62) // ProposalDraftVersionsKeyPrefix stores the versions of the proposal drafts.
+	WhaleAlertsKeyPrefix               = collections.NewPrefix(63) // WhaleAlertsKeyPrefix stores the aggregated whale alerts of the proposals.
 )
 
 // Reserved kvstore keys
@@ -16,10 +16,15 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgDeposit{}, "cosmos-sdk/v1/MsgDeposit")
 	legacy.RegisterAminoMsg(cdc, &MsgVote{}, "cosmos-sdk/v1/MsgVote")
 	legacy.RegisterAminoMsg(cdc, &MsgVoteWeighted{}, "cosmos-sdk/v1/MsgVoteWeighted")
+	legacy.RegisterAminoMsg(cdc, &MsgVoteRanked{}, "cosmos-sdk/v1/MsgVoteRanked")
 	legacy.RegisterAminoMsg(cdc, &MsgExecLegacyContent{}, "cosmos-sdk/v1/MsgExecLegacyContent")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/gov/v1/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateMessageParams{}, "x/gov/v1/MsgUpdateMessageParams")
 	legacy.RegisterAminoMsg(cdc, &MsgSudoExec{}, "cosmos-sdk/x/gov/v1/MsgSudoExec")
+	legacy.RegisterAminoMsg(cdc, &MsgVetoProposalMessages{}, "cosmos-sdk/v1/MsgVetoProposalMessages")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateProposalDraft{}, "cosmos-sdk/v1/MsgCreateProposalDraft")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateProposalDraft{}, "cosmos-sdk/v1/MsgUpdateProposalDraft")
+	legacy.RegisterAminoMsg(cdc, &MsgPromoteProposalDraft{}, "cosmos-sdk/v1/MsgPromoteProposalDraft")
 }
 
 // RegisterInterfaces registers the interfaces types with the Interface Registry.
@@ -29,11 +34,16 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgSubmitMultipleChoiceProposal{},
 		&MsgVote{},
 		&MsgVoteWeighted{},
+		&MsgVoteRanked{},
 		&MsgDeposit{},
 		&MsgExecLegacyContent{},
 		&MsgUpdateParams{},
 		&MsgUpdateMessageParams{},
 		&MsgSudoExec{},
+		&MsgVetoProposalMessages{},
+		&MsgCreateProposalDraft{},
+		&MsgUpdateProposalDraft{},
+		&MsgPromoteProposalDraft{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -9,6 +9,7 @@ import (
 	"cosmossdk.io/core/address"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new genesis state for the governance module
@@ -34,8 +35,9 @@ func (data GenesisState) Empty() bool {
 
 // ValidateGenesis checks if gov genesis state is valid ranges
 // It checks if params are in valid ranges
-// It also makes sure that the provided proposal IDs are unique and
-// that there are no duplicate deposit or vote records and no vote or deposits for non-existent proposals
+// It also makes sure that the provided proposal and archived proposal IDs are unique and
+// that there are no duplicate deposit, vote or line-item veto records and no vote or deposits for non-existent proposals.
+// Each proposal draft must have all of its versions, and only them.
 func ValidateGenesis(ac address.Codec, data *GenesisState) error {
 	if data.StartingProposalId == 0 {
 		return errors.New("starting proposal id must be greater than 0")
@@ -45,14 +47,37 @@ func ValidateGenesis(ac address.Codec, data *GenesisState) error {
 
 	// weed out duplicate proposals
 	proposalIds := make(map[uint64]struct{})
+	executingIds := make(map[uint64]struct{})
 	for _, p := range data.Proposals {
 		if _, ok := proposalIds[p.Id]; ok {
 			return fmt.Errorf("duplicate proposal id: %d", p.Id)
 		}
 
+		if p.Status == StatusTimelock && p.TimelockEndTime == nil {
+			return fmt.Errorf("timelocked proposal %d has no timelock end time", p.Id)
+		}
+
+		if p.Status == StatusExecuting {
+			executingIds[p.Id] = struct{}{}
+		}
+
 		proposalIds[p.Id] = struct{}{}
 	}
 
+	// weed out duplicate archived proposals, an archived proposal cannot be a live proposal
+	archivedIds := make(map[uint64]struct{})
+	for _, p := range data.ArchivedProposals {
+		if _, ok := proposalIds[p.Id]; ok {
+			return fmt.Errorf("archived proposal id %d is also a live proposal", p.Id)
+		}
+
+		if _, ok := archivedIds[p.Id]; ok {
+			return fmt.Errorf("duplicate archived proposal id: %d", p.Id)
+		}
+
+		archivedIds[p.Id] = struct{}{}
+	}
+
 	// weed out duplicate deposits
 	errGroup.Go(func() error {
 		type depositKey struct {
@@ -76,6 +101,29 @@ func ValidateGenesis(ac address.Codec, data *GenesisState) error {
 		return nil
 	})
 
+	// weed out duplicate deposit records
+	errGroup.Go(func() error {
+		type recordKey struct {
+			ProposalId uint64
+			Depositor  string
+		}
+		recordIds := make(map[recordKey]struct{})
+		for _, r := range data.DepositHistory {
+			if _, err := ac.StringToBytes(r.Depositor); err != nil {
+				return fmt.Errorf("deposit record %v has invalid depositor address: %w", r, err)
+			}
+
+			rk := recordKey{r.ProposalId, r.Depositor}
+			if _, ok := recordIds[rk]; ok {
+				return fmt.Errorf("duplicate deposit record: %v", r)
+			}
+
+			recordIds[rk] = struct{}{}
+		}
+
+		return nil
+	})
+
 	// weed out duplicate votes
 	errGroup.Go(func() error {
 		type voteKey struct {
@@ -99,6 +147,132 @@ func ValidateGenesis(ac address.Codec, data *GenesisState) error {
 		return nil
 	})
 
+	// weed out duplicate line-item vetoes, vetoes are kept once their proposal is archived
+	errGroup.Go(func() error {
+		type vetoKey struct {
+			ProposalId   uint64
+			MessageIndex uint32
+		}
+		vetoIds := make(map[vetoKey]struct{})
+		for _, v := range data.LineItemVetoes {
+			_, live := proposalIds[v.ProposalId]
+			if _, archived := archivedIds[v.ProposalId]; !live && !archived {
+				return fmt.Errorf("line-item veto %v has non-existent proposal id: %d", v, v.ProposalId)
+			}
+
+			if v.Justification == "" {
+				return fmt.Errorf("line-item veto %v has an empty justification", v)
+			}
+
+			vk := vetoKey{v.ProposalId, v.MessageIndex}
+			if _, ok := vetoIds[vk]; ok {
+				return fmt.Errorf("duplicate line-item veto: %v", v)
+			}
+
+			vetoIds[vk] = struct{}{}
+		}
+
+		return nil
+	})
+
+	// each executing proposal must have exactly one chunked execution in progress
+	errGroup.Go(func() error {
+		executionIds := make(map[uint64]struct{})
+		for _, e := range data.ProposalExecutions {
+			if _, ok := executingIds[e.ProposalId]; !ok {
+				return fmt.Errorf("proposal execution %v has no executing proposal with id: %d", e, e.ProposalId)
+			}
+
+			if _, ok := executionIds[e.ProposalId]; ok {
+				return fmt.Errorf("duplicate proposal execution: %v", e)
+			}
+
+			executionIds[e.ProposalId] = struct{}{}
+		}
+
+		if len(executionIds) != len(executingIds) {
+			return errors.New("executing proposals must each have a proposal execution")
+		}
+
+		return nil
+	})
+
+	// each proposal draft must have exactly its versions from 1 to its latest version
+	errGroup.Go(func() error {
+		latestVersions := make(map[uint64]uint64)
+		for _, d := range data.ProposalDrafts {
+			if d.Id == 0 {
+				return errors.New("proposal draft id must be greater than 0")
+			}
+
+			if _, ok := latestVersions[d.Id]; ok {
+				return fmt.Errorf("duplicate proposal draft id: %d", d.Id)
+			}
+
+			if d.LatestVersion == 0 {
+				return fmt.Errorf("proposal draft %d has no version", d.Id)
+			}
+
+			latestVersions[d.Id] = d.LatestVersion
+		}
+
+		type versionKey struct {
+			DraftId uint64
+			Version uint64
+		}
+		versionIds := make(map[versionKey]struct{})
+		for _, v := range data.ProposalDraftVersions {
+			latestVersion, ok := latestVersions[v.DraftId]
+			if !ok {
+				return fmt.Errorf("proposal draft version %d has non-existent draft id: %d", v.Version, v.DraftId)
+			}
+
+			if v.Version == 0 || v.Version > latestVersion {
+				return fmt.Errorf("proposal draft %d has version %d out of range, its latest version is %d", v.DraftId, v.Version, latestVersion)
+			}
+
+			vk := versionKey{v.DraftId, v.Version}
+			if _, ok := versionIds[vk]; ok {
+				return fmt.Errorf("duplicate proposal draft version %d of draft %d", v.Version, v.DraftId)
+			}
+
+			versionIds[vk] = struct{}{}
+		}
+
+		var versions uint64
+		for _, latestVersion := range latestVersions {
+			versions += latestVersion
+		}
+
+		if uint64(len(versionIds)) != versions {
+			return errors.New("proposal drafts must each have all of their versions")
+		}
+
+		return nil
+	})
+
+	// weed out duplicate whale alerts
+	errGroup.Go(func() error {
+		alertIds := make(map[uint64]struct{})
+		for _, a := range data.WhaleAlerts {
+			if a.ProposalId == 0 {
+				return errors.New("whale alerts proposal id must be greater than 0")
+			}
+
+			if _, ok := alertIds[a.ProposalId]; ok {
+				return fmt.Errorf("duplicate whale alerts of proposal: %d", a.ProposalId)
+			}
+
+			if !sdk.Coins(a.WhaleDeposits).IsValid() {
+				return fmt.Errorf("whale alerts of proposal %d have invalid whale deposits: %s", a.ProposalId, sdk.Coins(a.WhaleDeposits))
+			}
+
+			alertIds[a.ProposalId] = struct{}{}
+		}
+
+		return nil
+	})
+
 	// verify params
 	errGroup.Go(func() error {
 		return data.Params.ValidateBasic(ac)
@@ -117,5 +291,11 @@ func (data GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 			return err
 		}
 	}
+	for _, v := range data.ProposalDraftVersions {
+		err := v.UnpackInterfaces(unpacker)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
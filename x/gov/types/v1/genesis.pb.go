@@ -52,6 +52,34 @@ type GenesisState struct {
 	//
 	// Since: cosmos-sdk 0.50
 	Constitution string `protobuf:"bytes,9,opt,name=constitution,proto3" json:"constitution,omitempty"`
+	// archived_proposals defines all the archived proposals present at genesis.
+	//
+	// Since: x/gov v1.0.0
+	ArchivedProposals []*ArchivedProposal `protobuf:"bytes,10,rep,name=archived_proposals,json=archivedProposals,proto3" json:"archived_proposals,omitempty"`
+	// deposit_history defines the deposit records of all depositors present at genesis.
+	//
+	// Since: x/gov v1.0.0
+	DepositHistory []*DepositRecord `protobuf:"bytes,11,rep,name=deposit_history,json=depositHistory,proto3" json:"deposit_history,omitempty"`
+	// line_item_vetoes defines all the messages of proposals struck by the line-item veto authority.
+	//
+	// Since: x/gov v1.0.0
+	LineItemVetoes []*LineItemVeto `protobuf:"bytes,12,rep,name=line_item_vetoes,json=lineItemVetoes,proto3" json:"line_item_vetoes,omitempty"`
+	// proposal_executions defines the progress of the chunked executions of passed proposals.
+	//
+	// Since: x/gov v1.0.0
+	ProposalExecutions []*ProposalExecution `protobuf:"bytes,13,rep,name=proposal_executions,json=proposalExecutions,proto3" json:"proposal_executions,omitempty"`
+	// proposal_drafts defines all the proposal drafts present at genesis.
+	//
+	// Since: x/gov v1.0.0
+	ProposalDrafts []*ProposalDraft `protobuf:"bytes,14,rep,name=proposal_drafts,json=proposalDrafts,proto3" json:"proposal_drafts,omitempty"`
+	// proposal_draft_versions defines the versions of all the proposal drafts present at genesis.
+	//
+	// Since: x/gov v1.0.0
+	ProposalDraftVersions []*ProposalDraftVersion `protobuf:"bytes,15,rep,name=proposal_draft_versions,json=proposalDraftVersions,proto3" json:"proposal_draft_versions,omitempty"`
+	// whale_alerts defines the aggregated whale alerts of the proposals present at genesis.
+	//
+	// Since: x/gov v1.0.0
+	WhaleAlerts []*WhaleAlerts `protobuf:"bytes,16,rep,name=whale_alerts,json=whaleAlerts,proto3" json:"whale_alerts,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -153,6 +181,55 @@ func (m *GenesisState) GetConstitution() string {
 	return ""
 }
 
+func (m *GenesisState) GetArchivedProposals() []*ArchivedProposal {
+	if m != nil {
+		return m.ArchivedProposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDepositHistory() []*DepositRecord {
+	if m != nil {
+		return m.DepositHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLineItemVetoes() []*LineItemVeto {
+	if m != nil {
+		return m.LineItemVetoes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProposalExecutions() []*ProposalExecution {
+	if m != nil {
+		return m.ProposalExecutions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProposalDrafts() []*ProposalDraft {
+	if m != nil {
+		return m.ProposalDrafts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProposalDraftVersions() []*ProposalDraftVersion {
+	if m != nil {
+		return m.ProposalDraftVersions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWhaleAlerts() []*WhaleAlerts {
+	if m != nil {
+		return m.WhaleAlerts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.gov.v1.GenesisState")
 }
@@ -160,30 +237,42 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/genesis.proto", fileDescriptor_ef7cfd15e3ded621) }
 
 var fileDescriptor_ef7cfd15e3ded621 = []byte{
-	// 365 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcd, 0x4e, 0xc2, 0x40,
-	0x14, 0x85, 0x19, 0xfe, 0x84, 0xa1, 0xb8, 0x18, 0x7f, 0x68, 0xc0, 0x34, 0x0d, 0xab, 0xba, 0xb0,
-	0x15, 0x0c, 0x0f, 0x20, 0x21, 0x21, 0xee, 0x48, 0x35, 0x2e, 0xdc, 0x90, 0x4a, 0x9b, 0xa6, 0x11,
-	0x7a, 0x9b, 0xce, 0x75, 0x22, 0x6f, 0xe1, 0x63, 0xb9, 0x64, 0xe9, 0xd2, 0xc0, 0x63, 0xb8, 0x31,
-	0x4c, 0x5b, 0x81, 0xea, 0xf6, 0x9e, 0xef, 0x9c, 0x7b, 0x72, 0x73, 0x69, 0x67, 0x06, 0x7c, 0x01,
-	0xdc, 0xf2, 0x41, 0x58, 0xa2, 0x67, 0xf9, 0x5e, 0xe8, 0xf1, 0x80, 0x9b, 0x51, 0x0c, 0x08, 0xac,
-	0x99, 0x88, 0xa6, 0x0f, 0xc2, 0x14, 0xbd, 0x76, 0x2b, 0xc7, 0x82, 0x48, 0xb8, 0xee, 0x77, 0x89,
-	0x2a, 0xe3, 0xc4, 0x79, 0x8f, 0x0e, 0x7a, 0xec, 0x9a, 0x9e, 0x72, 0x74, 0x62, 0x0c, 0x42, 0x7f,
-	0x1a, 0xc5, 0x10, 0x01, 0x77, 0xe6, 0xd3, 0xc0, 0x55, 0x89, 0x4e, 0x8c, 0xb2, 0xcd, 0x32, 0x6d,
-	0x92, 0x4a, 0x77, 0x2e, 0xeb, 0xd3, 0x9a, 0xeb, 0x45, 0xc0, 0x03, 0xe4, 0x6a, 0x51, 0x2f, 0x19,
-	0x8d, 0xfe, 0xb9, 0x79, 0xb0, 0xdd, 0x1c, 0x25, 0xb2, 0xfd, 0xcb, 0xb1, 0x4b, 0x5a, 0x11, 0x80,
-	0x1e, 0x57, 0x4b, 0xd2, 0x70, 0x92, 0x33, 0x3c, 0x02, 0x7a, 0x76, 0x42, 0xb0, 0x01, 0xad, 0x67,
-	0x3d, 0xb8, 0x5a, 0x96, 0x78, 0x2b, 0x87, 0x67, 0x65, 0xec, 0x1d, 0xc9, 0xc6, 0xf4, 0x38, 0xdd,
-	0x36, 0x8d, 0x9c, 0xd8, 0x59, 0x70, 0xb5, 0xa2, 0x13, 0xa3, 0xd1, 0xbf, 0xf8, 0xbf, 0xdb, 0x44,
-	0x32, 0xc3, 0xa2, 0x4a, 0xec, 0xa6, 0xbb, 0x3f, 0x62, 0x23, 0xda, 0x14, 0x90, 0x9c, 0x23, 0xc9,
-	0xa9, 0xca, 0x9c, 0xce, 0xdf, 0xca, 0xdb, 0xb3, 0xec, 0x62, 0x14, 0xb1, 0x37, 0x61, 0xb7, 0x54,
-	0x41, 0x67, 0x3e, 0x5f, 0x66, 0x21, 0x47, 0x32, 0xa4, 0x9d, 0x0b, 0x79, 0xd8, 0x22, 0x7b, 0x19,
-	0x0d, 0xdc, 0x0d, 0xd8, 0x15, 0xad, 0xa6, 0xe6, 0x9a, 0x34, 0x9f, 0xe5, 0xaf, 0x20, 0x45, 0x3b,
-	0x85, 0x58, 0x97, 0x2a, 0x33, 0x08, 0x39, 0x06, 0xf8, 0x8a, 0x01, 0x84, 0x6a, 0x5d, 0x27, 0x46,
-	0xdd, 0x3e, 0x98, 0x0d, 0x07, 0x1f, 0x6b, 0x8d, 0xac, 0xd6, 0x1a, 0xf9, 0x5a, 0x6b, 0xe4, 0x7d,
-	0xa3, 0x15, 0x56, 0x1b, 0xad, 0xf0, 0xb9, 0xd1, 0x0a, 0x4f, 0xe9, 0x73, 0x71, 0xf7, 0xc5, 0x0c,
-	0xc0, 0x7a, 0x93, 0x8f, 0x83, 0xcb, 0xc8, 0xe3, 0x96, 0xe8, 0x3d, 0x57, 0xe5, 0xef, 0xdc, 0xfc,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0x61, 0x60, 0xe9, 0xdf, 0x82, 0x02, 0x00, 0x00,
+	// 558 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x9b, 0x75, 0x2b, 0xad, 0x9b, 0x76, 0xc3, 0x63, 0xd4, 0xda, 0x50, 0x88, 0xc6, 0xa5,
+	0x1c, 0x48, 0x69, 0xd1, 0x8e, 0x1c, 0x3a, 0xb5, 0x1a, 0x93, 0x10, 0x1a, 0x06, 0x15, 0x09, 0x0e,
+	0x51, 0x68, 0x4c, 0x6b, 0x91, 0xc6, 0x51, 0x5e, 0xe3, 0xad, 0x9f, 0x02, 0x3e, 0x16, 0xc7, 0x1d,
+	0x39, 0xa2, 0xf6, 0x8b, 0xa0, 0x3a, 0x49, 0xff, 0x84, 0x6e, 0x47, 0x3f, 0xef, 0xef, 0x7d, 0xf2,
+	0xfa, 0x89, 0xf5, 0xa2, 0x93, 0xa1, 0x80, 0x89, 0x80, 0xd6, 0x48, 0xa8, 0x96, 0x6a, 0xb7, 0x46,
+	0x2c, 0x64, 0xc0, 0xc1, 0x89, 0x62, 0x21, 0x05, 0xae, 0x25, 0x45, 0x67, 0x24, 0x94, 0xa3, 0xda,
+	0xc7, 0x8d, 0x1c, 0x2b, 0x54, 0xc2, 0x9d, 0xfe, 0x2c, 0x23, 0xf3, 0x22, 0xe9, 0xfc, 0x20, 0x3d,
+	0xc9, 0xf0, 0x4b, 0xf4, 0x08, 0xa4, 0x17, 0x4b, 0x1e, 0x8e, 0xdc, 0x28, 0x16, 0x91, 0x00, 0x2f,
+	0x70, 0xb9, 0x4f, 0x0c, 0xdb, 0x68, 0xee, 0x52, 0x9c, 0xd5, 0xae, 0xd2, 0xd2, 0xa5, 0x8f, 0x3b,
+	0xa8, 0xec, 0xb3, 0x48, 0x00, 0x97, 0x40, 0x76, 0xec, 0x62, 0xb3, 0xda, 0x79, 0xec, 0x6c, 0x7c,
+	0xdd, 0xe9, 0x25, 0x65, 0xba, 0xe4, 0xf0, 0x73, 0xb4, 0xa7, 0x84, 0x64, 0x40, 0x8a, 0xba, 0xe1,
+	0x30, 0xd7, 0x30, 0x10, 0x92, 0xd1, 0x84, 0xc0, 0x67, 0xa8, 0x92, 0xcd, 0x01, 0x64, 0x57, 0xe3,
+	0x8d, 0x1c, 0x9e, 0x0d, 0x43, 0x57, 0x24, 0xbe, 0x40, 0xf5, 0xf4, 0x6b, 0x6e, 0xe4, 0xc5, 0xde,
+	0x04, 0xc8, 0x9e, 0x6d, 0x34, 0xab, 0x9d, 0x27, 0xdb, 0x67, 0xbb, 0xd2, 0xcc, 0xf9, 0x0e, 0x31,
+	0x68, 0xcd, 0x5f, 0x97, 0x70, 0x0f, 0xd5, 0x94, 0x48, 0xe2, 0x48, 0x7c, 0x4a, 0xda, 0xe7, 0xe4,
+	0xff, 0x91, 0x17, 0xb1, 0xac, 0x6c, 0x4c, 0xb5, 0xa6, 0xe0, 0x2e, 0x32, 0xa5, 0x17, 0x04, 0xd3,
+	0xcc, 0xe4, 0x81, 0x36, 0x39, 0xce, 0x99, 0x7c, 0x5c, 0x20, 0x6b, 0x1e, 0x55, 0xb9, 0x12, 0xf0,
+	0x0b, 0x54, 0x4a, 0x9b, 0xcb, 0xba, 0xf9, 0x28, 0x9f, 0x82, 0x2e, 0xd2, 0x14, 0xc2, 0xa7, 0xc8,
+	0x1c, 0x8a, 0x10, 0x24, 0x97, 0x3f, 0x24, 0x17, 0x21, 0xa9, 0xd8, 0x46, 0xb3, 0x42, 0x37, 0x34,
+	0xfc, 0x0e, 0x61, 0x2f, 0x1e, 0x8e, 0xb9, 0x62, 0xbe, 0xbb, 0x0a, 0x19, 0xe9, 0x90, 0x9f, 0xe6,
+	0xec, 0xbb, 0x29, 0xb8, 0x0c, 0xfb, 0xa1, 0x97, 0x53, 0x00, 0xf7, 0xd1, 0x7e, 0x16, 0xfa, 0x98,
+	0x83, 0x14, 0xf1, 0x94, 0x54, 0xed, 0xe2, 0xdd, 0xa9, 0x53, 0x36, 0x14, 0xb1, 0x4f, 0xb3, 0x3f,
+	0xf5, 0x26, 0xe9, 0xc1, 0x7d, 0x74, 0x10, 0xf0, 0x90, 0xb9, 0x5c, 0xb2, 0x89, 0xab, 0x98, 0x14,
+	0x0c, 0x88, 0x69, 0x17, 0xb7, 0xa4, 0xfe, 0x96, 0x87, 0xec, 0x52, 0xb2, 0xc9, 0x80, 0x49, 0x41,
+	0xeb, 0xc1, 0xda, 0x89, 0x01, 0x7e, 0x8f, 0x0e, 0x97, 0x2f, 0x98, 0xdd, 0xb0, 0xa1, 0xbe, 0x33,
+	0x90, 0x9a, 0x76, 0xb2, 0xef, 0x78, 0x43, 0xfd, 0x0c, 0xa4, 0x38, 0xca, 0x4b, 0xfa, 0x82, 0x4b,
+	0x4b, 0x3f, 0xf6, 0xbe, 0x49, 0x20, 0xf5, 0xad, 0x17, 0xcc, 0xec, 0x7a, 0x0b, 0x88, 0xd6, 0xa3,
+	0xf5, 0x23, 0xe0, 0x2f, 0xa8, 0xb1, 0x69, 0xe3, 0x2a, 0x16, 0x83, 0x9e, 0x6e, 0x5f, 0xdb, 0x3d,
+	0xbb, 0xcf, 0x6e, 0x90, 0xb0, 0xf4, 0x28, 0xda, 0xa2, 0x02, 0x7e, 0x8d, 0xcc, 0xeb, 0xb1, 0x17,
+	0x30, 0xd7, 0x0b, 0x58, 0x2c, 0x81, 0x1c, 0xd8, 0xc5, 0x2d, 0x4f, 0xed, 0xd3, 0x02, 0xe9, 0x6a,
+	0x82, 0x56, 0xaf, 0x57, 0x87, 0xf3, 0xb3, 0xdf, 0x33, 0xcb, 0xb8, 0x9d, 0x59, 0xc6, 0xdf, 0x99,
+	0x65, 0xfc, 0x9a, 0x5b, 0x85, 0xdb, 0xb9, 0x55, 0xf8, 0x33, 0xb7, 0x0a, 0x9f, 0xd3, 0x85, 0x03,
+	0xfe, 0x77, 0x87, 0x8b, 0xd6, 0x8d, 0x5e, 0x26, 0x72, 0x1a, 0x31, 0x68, 0xa9, 0xf6, 0xd7, 0x92,
+	0xde, 0x27, 0xaf, 0xfe, 0x0d, 0x00, 0xbf, 0x2a, 0x95, 0x28, 0x96, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -206,6 +295,106 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.WhaleAlerts) > 0 {
+		for iNdEx := len(m.WhaleAlerts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WhaleAlerts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.ProposalDraftVersions) > 0 {
+		for iNdEx := len(m.ProposalDraftVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposalDraftVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.ProposalDrafts) > 0 {
+		for iNdEx := len(m.ProposalDrafts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposalDrafts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.ProposalExecutions) > 0 {
+		for iNdEx := len(m.ProposalExecutions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposalExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.LineItemVetoes) > 0 {
+		for iNdEx := len(m.LineItemVetoes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LineItemVetoes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.DepositHistory) > 0 {
+		for iNdEx := len(m.DepositHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ArchivedProposals) > 0 {
+		for iNdEx := len(m.ArchivedProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ArchivedProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.Constitution) > 0 {
 		i -= len(m.Constitution)
 		copy(dAtA[i:], m.Constitution)
@@ -369,6 +558,48 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.ArchivedProposals) > 0 {
+		for _, e := range m.ArchivedProposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DepositHistory) > 0 {
+		for _, e := range m.DepositHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LineItemVetoes) > 0 {
+		for _, e := range m.LineItemVetoes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProposalExecutions) > 0 {
+		for _, e := range m.ProposalExecutions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProposalDrafts) > 0 {
+		for _, e := range m.ProposalDrafts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProposalDraftVersions) > 0 {
+		for _, e := range m.ProposalDraftVersions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WhaleAlerts) > 0 {
+		for _, e := range m.WhaleAlerts {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -704,6 +935,244 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.Constitution = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArchivedProposals = append(m.ArchivedProposals, &ArchivedProposal{})
+			if err := m.ArchivedProposals[len(m.ArchivedProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositHistory = append(m.DepositHistory, &DepositRecord{})
+			if err := m.DepositHistory[len(m.DepositHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LineItemVetoes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LineItemVetoes = append(m.LineItemVetoes, &LineItemVeto{})
+			if err := m.LineItemVetoes[len(m.LineItemVetoes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalExecutions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalExecutions = append(m.ProposalExecutions, &ProposalExecution{})
+			if err := m.ProposalExecutions[len(m.ProposalExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalDrafts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalDrafts = append(m.ProposalDrafts, &ProposalDraft{})
+			if err := m.ProposalDrafts[len(m.ProposalDrafts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalDraftVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalDraftVersions = append(m.ProposalDraftVersions, &ProposalDraftVersion{})
+			if err := m.ProposalDraftVersions[len(m.ProposalDraftVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhaleAlerts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhaleAlerts = append(m.WhaleAlerts, &WhaleAlerts{})
+			if err := m.WhaleAlerts[len(m.WhaleAlerts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -172,6 +172,139 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "deposit proposal_id:1 depositor:\"depositor\"",
 		},
+		{
+			name: "non-existent proposal id in line-item vetoes",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.LineItemVetoes = append(state.LineItemVetoes,
+					&v1.LineItemVeto{
+						ProposalId:    1,
+						Justification: "out of scope",
+					})
+
+				return state
+			},
+			expErrMsg: "has non-existent proposal id: 1",
+		},
+		{
+			name: "duplicate line-item vetoes",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.ArchivedProposals = append(state.ArchivedProposals, &v1.ArchivedProposal{Id: 1})
+				state.LineItemVetoes = append(state.LineItemVetoes,
+					&v1.LineItemVeto{
+						ProposalId:    1,
+						Justification: "out of scope",
+					},
+					&v1.LineItemVeto{
+						ProposalId:    1,
+						Justification: "out of scope",
+					})
+
+				return state
+			},
+			expErrMsg: "duplicate line-item veto",
+		},
+		{
+			name: "timelocked proposal without timelock end time",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Proposals = append(state.Proposals, &v1.Proposal{Id: 1, Status: v1.StatusTimelock})
+
+				return state
+			},
+			expErrMsg: "timelocked proposal 1 has no timelock end time",
+		},
+		{
+			name: "executing proposal",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Proposals = append(state.Proposals, &v1.Proposal{Id: 1, Status: v1.StatusExecuting, ChunkedExecution: true})
+				state.ProposalExecutions = append(state.ProposalExecutions, &v1.ProposalExecution{ProposalId: 1, NextMessageIndex: 1})
+
+				return state
+			},
+		},
+		{
+			name: "executing proposal without execution",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Proposals = append(state.Proposals, &v1.Proposal{Id: 1, Status: v1.StatusExecuting, ChunkedExecution: true})
+
+				return state
+			},
+			expErrMsg: "executing proposals must each have a proposal execution",
+		},
+		{
+			name: "execution of a proposal not executing",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Proposals = append(state.Proposals, &v1.Proposal{Id: 1, Status: v1.StatusPassed})
+				state.ProposalExecutions = append(state.ProposalExecutions, &v1.ProposalExecution{ProposalId: 1})
+
+				return state
+			},
+			expErrMsg: "has no executing proposal with id: 1",
+		},
+		{
+			name: "proposal draft",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.ProposalDrafts = append(state.ProposalDrafts, &v1.ProposalDraft{Id: 1, LatestVersion: 2})
+				state.ProposalDraftVersions = append(state.ProposalDraftVersions,
+					&v1.ProposalDraftVersion{DraftId: 1, Version: 1},
+					&v1.ProposalDraftVersion{DraftId: 1, Version: 2})
+
+				return state
+			},
+		},
+		{
+			name: "proposal draft missing a version",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.ProposalDrafts = append(state.ProposalDrafts, &v1.ProposalDraft{Id: 1, LatestVersion: 2})
+				state.ProposalDraftVersions = append(state.ProposalDraftVersions, &v1.ProposalDraftVersion{DraftId: 1, Version: 2})
+
+				return state
+			},
+			expErrMsg: "proposal drafts must each have all of their versions",
+		},
+		{
+			name: "proposal draft version after the latest version",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.ProposalDrafts = append(state.ProposalDrafts, &v1.ProposalDraft{Id: 1, LatestVersion: 1})
+				state.ProposalDraftVersions = append(state.ProposalDraftVersions,
+					&v1.ProposalDraftVersion{DraftId: 1, Version: 1},
+					&v1.ProposalDraftVersion{DraftId: 1, Version: 2})
+
+				return state
+			},
+			expErrMsg: "proposal draft 1 has version 2 out of range",
+		},
+		{
+			name: "version of a non-existent proposal draft",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.ProposalDraftVersions = append(state.ProposalDraftVersions, &v1.ProposalDraftVersion{DraftId: 1, Version: 1})
+
+				return state
+			},
+			expErrMsg: "has non-existent draft id: 1",
+		},
+		{
+			name: "duplicate whale alerts",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.WhaleAlerts = []*v1.WhaleAlerts{
+					{ProposalId: 1, VoteAlerts: 1},
+					{ProposalId: 1, DepositAlerts: 1},
+				}
+
+				return state
+			},
+			expErrMsg: "duplicate whale alerts of proposal: 1",
+		},
 	}
 
 	for _, tc := range testCases {
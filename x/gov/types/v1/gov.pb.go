@@ -47,6 +47,12 @@ const (
 	ProposalType_PROPOSAL_TYPE_OPTIMISTIC ProposalType = 3
 	// PROPOSAL_TYPE_EXPEDITED defines the type for an expedited proposal.
 	ProposalType_PROPOSAL_TYPE_EXPEDITED ProposalType = 4
+	// PROPOSAL_TYPE_SECURITY_COUNCIL defines the type for a security council proposal.
+	// It can only be submitted and voted on by the security council group policy.
+	ProposalType_PROPOSAL_TYPE_SECURITY_COUNCIL ProposalType = 5
+	// PROPOSAL_TYPE_EMERGENCY defines the type for an emergency proposal.
+	// It can only contain allowlisted messages and is voted on by bonded validators only.
+	ProposalType_PROPOSAL_TYPE_EMERGENCY ProposalType = 6
 )
 
 var ProposalType_name = map[int32]string{
@@ -55,14 +61,18 @@ var ProposalType_name = map[int32]string{
 	2: "PROPOSAL_TYPE_MULTIPLE_CHOICE",
 	3: "PROPOSAL_TYPE_OPTIMISTIC",
 	4: "PROPOSAL_TYPE_EXPEDITED",
+	5: "PROPOSAL_TYPE_SECURITY_COUNCIL",
+	6: "PROPOSAL_TYPE_EMERGENCY",
 }
 
 var ProposalType_value = map[string]int32{
-	"PROPOSAL_TYPE_UNSPECIFIED":     0,
-	"PROPOSAL_TYPE_STANDARD":        1,
-	"PROPOSAL_TYPE_MULTIPLE_CHOICE": 2,
-	"PROPOSAL_TYPE_OPTIMISTIC":      3,
-	"PROPOSAL_TYPE_EXPEDITED":       4,
+	"PROPOSAL_TYPE_UNSPECIFIED":      0,
+	"PROPOSAL_TYPE_STANDARD":         1,
+	"PROPOSAL_TYPE_MULTIPLE_CHOICE":  2,
+	"PROPOSAL_TYPE_OPTIMISTIC":       3,
+	"PROPOSAL_TYPE_EXPEDITED":        4,
+	"PROPOSAL_TYPE_SECURITY_COUNCIL": 5,
+	"PROPOSAL_TYPE_EMERGENCY":        6,
 }
 
 func (x ProposalType) String() string {
@@ -154,6 +164,16 @@ const (
 	// PROPOSAL_STATUS_FAILED defines a proposal status of a proposal that has
 	// failed.
 	ProposalStatus_PROPOSAL_STATUS_FAILED ProposalStatus = 5
+	// PROPOSAL_STATUS_TIMELOCK defines a proposal status of a multi-message proposal that has
+	// passed and awaits its execution at the end of the line-item veto timelock.
+	//
+	// Since: x/gov v1.0.0
+	ProposalStatus_PROPOSAL_STATUS_TIMELOCK ProposalStatus = 6
+	// PROPOSAL_STATUS_EXECUTING defines a proposal status of a passed proposal whose messages are
+	// being executed across several blocks.
+	//
+	// Since: x/gov v1.0.0
+	ProposalStatus_PROPOSAL_STATUS_EXECUTING ProposalStatus = 7
 )
 
 var ProposalStatus_name = map[int32]string{
@@ -163,6 +183,8 @@ var ProposalStatus_name = map[int32]string{
 	3: "PROPOSAL_STATUS_PASSED",
 	4: "PROPOSAL_STATUS_REJECTED",
 	5: "PROPOSAL_STATUS_FAILED",
+	6: "PROPOSAL_STATUS_TIMELOCK",
+	7: "PROPOSAL_STATUS_EXECUTING",
 }
 
 var ProposalStatus_value = map[string]int32{
@@ -172,6 +194,8 @@ var ProposalStatus_value = map[string]int32{
 	"PROPOSAL_STATUS_PASSED":         3,
 	"PROPOSAL_STATUS_REJECTED":       4,
 	"PROPOSAL_STATUS_FAILED":         5,
+	"PROPOSAL_STATUS_TIMELOCK":       6,
+	"PROPOSAL_STATUS_EXECUTING":      7,
 }
 
 func (x ProposalStatus) String() string {
@@ -182,6 +206,45 @@ func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e05cb1c0d030febb, []int{2}
 }
 
+// ScheduleEventType enumerates the kinds of upcoming governance events of the schedule.
+//
+// Since: x/gov v1.0.0
+type ScheduleEventType int32
+
+const (
+	// SCHEDULE_EVENT_TYPE_UNSPECIFIED defines an unspecified event type.
+	ScheduleEventType_SCHEDULE_EVENT_TYPE_UNSPECIFIED ScheduleEventType = 0
+	// SCHEDULE_EVENT_TYPE_DEPOSIT_END defines the end of the deposit period of a proposal.
+	ScheduleEventType_SCHEDULE_EVENT_TYPE_DEPOSIT_END ScheduleEventType = 1
+	// SCHEDULE_EVENT_TYPE_VOTING_END defines the end of the voting period of a proposal.
+	ScheduleEventType_SCHEDULE_EVENT_TYPE_VOTING_END ScheduleEventType = 2
+	// SCHEDULE_EVENT_TYPE_EXECUTION defines the execution of a passed proposal at the end of its
+	// line-item veto timelock.
+	ScheduleEventType_SCHEDULE_EVENT_TYPE_EXECUTION ScheduleEventType = 3
+)
+
+var ScheduleEventType_name = map[int32]string{
+	0: "SCHEDULE_EVENT_TYPE_UNSPECIFIED",
+	1: "SCHEDULE_EVENT_TYPE_DEPOSIT_END",
+	2: "SCHEDULE_EVENT_TYPE_VOTING_END",
+	3: "SCHEDULE_EVENT_TYPE_EXECUTION",
+}
+
+var ScheduleEventType_value = map[string]int32{
+	"SCHEDULE_EVENT_TYPE_UNSPECIFIED": 0,
+	"SCHEDULE_EVENT_TYPE_DEPOSIT_END": 1,
+	"SCHEDULE_EVENT_TYPE_VOTING_END":  2,
+	"SCHEDULE_EVENT_TYPE_EXECUTION":   3,
+}
+
+func (x ScheduleEventType) String() string {
+	return proto.EnumName(ScheduleEventType_name, int32(x))
+}
+
+func (ScheduleEventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{3}
+}
+
 // WeightedVoteOption defines a unit of vote for vote split.
 type WeightedVoteOption struct {
 	// option defines the valid vote options, it must not contain duplicate vote options.
@@ -353,6 +416,21 @@ type Proposal struct {
 	//
 	// Since: x/gov v1.0.0
 	ProposalType ProposalType `protobuf:"varint,16,opt,name=proposal_type,json=proposalType,proto3,enum=cosmos.gov.v1.ProposalType" json:"proposal_type,omitempty"`
+	// timelock_end_time is the time at which a proposal in the line-item veto timelock is executed.
+	//
+	// Since: x/gov v1.0.0
+	TimelockEndTime *time.Time `protobuf:"bytes,17,opt,name=timelock_end_time,json=timelockEndTime,proto3,stdtime" json:"timelock_end_time,omitempty"`
+	// chunked_execution defines whether the messages of the proposal, once passed, are executed across
+	// several blocks under the execution_gas_per_block budget rather than at once.
+	//
+	// Since: x/gov v1.0.0
+	ChunkedExecution bool `protobuf:"varint,18,opt,name=chunked_execution,json=chunkedExecution,proto3" json:"chunked_execution,omitempty"`
+	// vote_lockout_period is the final period of the voting period during which the voters cannot change
+	// their vote anymore, deterring last-minute coordinated vote flipping. Voters who have not voted yet can
+	// still cast their first vote. Unset means votes can be changed until the end of the voting period.
+	//
+	// Since: x/gov v1.0.0
+	VoteLockoutPeriod *time.Duration `protobuf:"bytes,19,opt,name=vote_lockout_period,json=voteLockoutPeriod,proto3,stdduration" json:"vote_lockout_period,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -501,6 +579,27 @@ func (m *Proposal) GetProposalType() ProposalType {
 	return ProposalType_PROPOSAL_TYPE_UNSPECIFIED
 }
 
+func (m *Proposal) GetTimelockEndTime() *time.Time {
+	if m != nil {
+		return m.TimelockEndTime
+	}
+	return nil
+}
+
+func (m *Proposal) GetChunkedExecution() bool {
+	if m != nil {
+		return m.ChunkedExecution
+	}
+	return false
+}
+
+func (m *Proposal) GetVoteLockoutPeriod() *time.Duration {
+	if m != nil {
+		return m.VoteLockoutPeriod
+	}
+	return nil
+}
+
 // ProposalVoteOptions defines the stringified vote options for proposals.
 // This allows to support multiple choice options for a given proposal.
 //
@@ -609,6 +708,11 @@ type TallyResult struct {
 	OptionFourCount string `protobuf:"bytes,8,opt,name=option_four_count,json=optionFourCount,proto3" json:"option_four_count,omitempty"`
 	// spam_count is the number of spam votes on a proposal.
 	SpamCount string `protobuf:"bytes,9,opt,name=spam_count,json=spamCount,proto3" json:"spam_count,omitempty"`
+	// ranked_choice is the instant-runoff tally of a multiple choice proposal. It is only set when the
+	// proposal received ranked ballots.
+	//
+	// Since: x/gov v1.0.0
+	RankedChoice *RankedChoiceTally `protobuf:"bytes,10,opt,name=ranked_choice,json=rankedChoice,proto3" json:"ranked_choice,omitempty"`
 }
 
 func (m *TallyResult) Reset()         { *m = TallyResult{} }
@@ -711,32 +815,35 @@ func (m *TallyResult) GetSpamCount() string {
 	return ""
 }
 
-// Vote defines a vote on a governance proposal.
-// A Vote consists of a proposal ID, the voter, and the vote option.
-type Vote struct {
-	// proposal_id defines the unique id of the proposal.
-	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
-	// voter is the voter address of the proposal.
-	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
-	// options is the weighted vote options.
-	Options []*WeightedVoteOption `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`
-	// metadata is any arbitrary metadata attached to the vote.
-	// the recommended format of the metadata is to be found here: https://docs.cosmos.network/v0.47/modules/gov#vote-5
-	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+func (m *TallyResult) GetRankedChoice() *RankedChoiceTally {
+	if m != nil {
+		return m.RankedChoice
+	}
+	return nil
 }
 
-func (m *Vote) Reset()         { *m = Vote{} }
-func (m *Vote) String() string { return proto.CompactTextString(m) }
-func (*Vote) ProtoMessage()    {}
-func (*Vote) Descriptor() ([]byte, []int) {
+// RankedChoiceTally defines the instant-runoff tally of the ranked ballots of a multiple choice proposal.
+//
+// Since: x/gov v1.0.0
+type RankedChoiceTally struct {
+	// rounds are the counting rounds of the tally, in order.
+	Rounds []*RankedChoiceRound `protobuf:"bytes,1,rep,name=rounds,proto3" json:"rounds,omitempty"`
+	// winner is the option which won the tally, it is unspecified when no ballot was counted.
+	Winner VoteOption `protobuf:"varint,2,opt,name=winner,proto3,enum=cosmos.gov.v1.VoteOption" json:"winner,omitempty"`
+}
+
+func (m *RankedChoiceTally) Reset()         { *m = RankedChoiceTally{} }
+func (m *RankedChoiceTally) String() string { return proto.CompactTextString(m) }
+func (*RankedChoiceTally) ProtoMessage()    {}
+func (*RankedChoiceTally) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e05cb1c0d030febb, []int{5}
 }
-func (m *Vote) XXX_Unmarshal(b []byte) error {
+func (m *RankedChoiceTally) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Vote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RankedChoiceTally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Vote.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RankedChoiceTally.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -746,69 +853,61 @@ func (m *Vote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Vote) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Vote.Merge(m, src)
+func (m *RankedChoiceTally) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RankedChoiceTally.Merge(m, src)
 }
-func (m *Vote) XXX_Size() int {
+func (m *RankedChoiceTally) XXX_Size() int {
 	return m.Size()
 }
-func (m *Vote) XXX_DiscardUnknown() {
-	xxx_messageInfo_Vote.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Vote proto.InternalMessageInfo
-
-func (m *Vote) GetProposalId() uint64 {
-	if m != nil {
-		return m.ProposalId
-	}
-	return 0
+func (m *RankedChoiceTally) XXX_DiscardUnknown() {
+	xxx_messageInfo_RankedChoiceTally.DiscardUnknown(m)
 }
 
-func (m *Vote) GetVoter() string {
-	if m != nil {
-		return m.Voter
-	}
-	return ""
-}
+var xxx_messageInfo_RankedChoiceTally proto.InternalMessageInfo
 
-func (m *Vote) GetOptions() []*WeightedVoteOption {
+func (m *RankedChoiceTally) GetRounds() []*RankedChoiceRound {
 	if m != nil {
-		return m.Options
+		return m.Rounds
 	}
 	return nil
 }
 
-func (m *Vote) GetMetadata() string {
+func (m *RankedChoiceTally) GetWinner() VoteOption {
 	if m != nil {
-		return m.Metadata
+		return m.Winner
 	}
-	return ""
+	return VoteOption_VOTE_OPTION_UNSPECIFIED
 }
 
-// DepositParams defines the params for deposits on governance proposals.
+// RankedChoiceRound defines a counting round of an instant-runoff tally. Each ballot counts for its most
+// preferred option which has not been eliminated in a previous round.
 //
-// Deprecated: Do not use.
-type DepositParams struct {
-	// Minimum deposit for a proposal to enter voting period.
-	MinDeposit []types.Coin `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit,omitempty"`
-	// Maximum period for Atom holders to deposit on a proposal. Initial value: 2
-	// months.
-	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
-}
-
-func (m *DepositParams) Reset()         { *m = DepositParams{} }
-func (m *DepositParams) String() string { return proto.CompactTextString(m) }
-func (*DepositParams) ProtoMessage()    {}
-func (*DepositParams) Descriptor() ([]byte, []int) {
+// Since: x/gov v1.0.0
+type RankedChoiceRound struct {
+	// option_one_count is the voting power counted for option one.
+	OptionOneCount string `protobuf:"bytes,1,opt,name=option_one_count,json=optionOneCount,proto3" json:"option_one_count,omitempty"`
+	// option_two_count is the voting power counted for option two.
+	OptionTwoCount string `protobuf:"bytes,2,opt,name=option_two_count,json=optionTwoCount,proto3" json:"option_two_count,omitempty"`
+	// option_three_count is the voting power counted for option three.
+	OptionThreeCount string `protobuf:"bytes,3,opt,name=option_three_count,json=optionThreeCount,proto3" json:"option_three_count,omitempty"`
+	// option_four_count is the voting power counted for option four.
+	OptionFourCount string `protobuf:"bytes,4,opt,name=option_four_count,json=optionFourCount,proto3" json:"option_four_count,omitempty"`
+	// eliminated is the option eliminated at the end of the round, it is unspecified for the last round.
+	Eliminated VoteOption `protobuf:"varint,5,opt,name=eliminated,proto3,enum=cosmos.gov.v1.VoteOption" json:"eliminated,omitempty"`
+}
+
+func (m *RankedChoiceRound) Reset()         { *m = RankedChoiceRound{} }
+func (m *RankedChoiceRound) String() string { return proto.CompactTextString(m) }
+func (*RankedChoiceRound) ProtoMessage()    {}
+func (*RankedChoiceRound) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e05cb1c0d030febb, []int{6}
 }
-func (m *DepositParams) XXX_Unmarshal(b []byte) error {
+func (m *RankedChoiceRound) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DepositParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RankedChoiceRound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DepositParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RankedChoiceRound.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -818,52 +917,86 @@ func (m *DepositParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *DepositParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DepositParams.Merge(m, src)
+func (m *RankedChoiceRound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RankedChoiceRound.Merge(m, src)
 }
-func (m *DepositParams) XXX_Size() int {
+func (m *RankedChoiceRound) XXX_Size() int {
 	return m.Size()
 }
-func (m *DepositParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_DepositParams.DiscardUnknown(m)
+func (m *RankedChoiceRound) XXX_DiscardUnknown() {
+	xxx_messageInfo_RankedChoiceRound.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DepositParams proto.InternalMessageInfo
+var xxx_messageInfo_RankedChoiceRound proto.InternalMessageInfo
 
-func (m *DepositParams) GetMinDeposit() []types.Coin {
+func (m *RankedChoiceRound) GetOptionOneCount() string {
 	if m != nil {
-		return m.MinDeposit
+		return m.OptionOneCount
 	}
-	return nil
+	return ""
 }
 
-func (m *DepositParams) GetMaxDepositPeriod() *time.Duration {
+func (m *RankedChoiceRound) GetOptionTwoCount() string {
 	if m != nil {
-		return m.MaxDepositPeriod
+		return m.OptionTwoCount
 	}
-	return nil
+	return ""
 }
 
-// VotingParams defines the params for voting on governance proposals.
-//
-// Deprecated: Do not use.
-type VotingParams struct {
-	// Duration of the voting period.
-	VotingPeriod *time.Duration `protobuf:"bytes,1,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
+func (m *RankedChoiceRound) GetOptionThreeCount() string {
+	if m != nil {
+		return m.OptionThreeCount
+	}
+	return ""
 }
 
-func (m *VotingParams) Reset()         { *m = VotingParams{} }
-func (m *VotingParams) String() string { return proto.CompactTextString(m) }
-func (*VotingParams) ProtoMessage()    {}
-func (*VotingParams) Descriptor() ([]byte, []int) {
+func (m *RankedChoiceRound) GetOptionFourCount() string {
+	if m != nil {
+		return m.OptionFourCount
+	}
+	return ""
+}
+
+func (m *RankedChoiceRound) GetEliminated() VoteOption {
+	if m != nil {
+		return m.Eliminated
+	}
+	return VoteOption_VOTE_OPTION_UNSPECIFIED
+}
+
+// ArchivedProposal defines the compacted summary of a finished proposal, kept once the proposal,
+// its votes and its deposits have been pruned from the state.
+//
+// Since: x/gov v1.0.0
+type ArchivedProposal struct {
+	// id defines the unique id of the proposal.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// title is the title of the proposal.
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// proposal_type defines the type of the proposal.
+	ProposalType ProposalType `protobuf:"varint,3,opt,name=proposal_type,json=proposalType,proto3,enum=cosmos.gov.v1.ProposalType" json:"proposal_type,omitempty"`
+	// status defines the final status of the proposal.
+	Status ProposalStatus `protobuf:"varint,4,opt,name=status,proto3,enum=cosmos.gov.v1.ProposalStatus" json:"status,omitempty"`
+	// final_tally_result is the final tally result of the proposal.
+	FinalTallyResult *TallyResult `protobuf:"bytes,5,opt,name=final_tally_result,json=finalTallyResult,proto3" json:"final_tally_result,omitempty"`
+	// voting_end_time is the end time of voting on the proposal.
+	VotingEndTime *time.Time `protobuf:"bytes,6,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time,omitempty"`
+	// finished_height is the block height at which the proposal has been tallied.
+	FinishedHeight int64 `protobuf:"varint,7,opt,name=finished_height,json=finishedHeight,proto3" json:"finished_height,omitempty"`
+}
+
+func (m *ArchivedProposal) Reset()         { *m = ArchivedProposal{} }
+func (m *ArchivedProposal) String() string { return proto.CompactTextString(m) }
+func (*ArchivedProposal) ProtoMessage()    {}
+func (*ArchivedProposal) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e05cb1c0d030febb, []int{7}
 }
-func (m *VotingParams) XXX_Unmarshal(b []byte) error {
+func (m *ArchivedProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *VotingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ArchivedProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_VotingParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ArchivedProposal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -873,51 +1006,98 @@ func (m *VotingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *VotingParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VotingParams.Merge(m, src)
+func (m *ArchivedProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedProposal.Merge(m, src)
 }
-func (m *VotingParams) XXX_Size() int {
+func (m *ArchivedProposal) XXX_Size() int {
 	return m.Size()
 }
-func (m *VotingParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_VotingParams.DiscardUnknown(m)
+func (m *ArchivedProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedProposal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VotingParams proto.InternalMessageInfo
+var xxx_messageInfo_ArchivedProposal proto.InternalMessageInfo
 
-func (m *VotingParams) GetVotingPeriod() *time.Duration {
+func (m *ArchivedProposal) GetId() uint64 {
 	if m != nil {
-		return m.VotingPeriod
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ArchivedProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ArchivedProposal) GetProposalType() ProposalType {
+	if m != nil {
+		return m.ProposalType
+	}
+	return ProposalType_PROPOSAL_TYPE_UNSPECIFIED
+}
+
+func (m *ArchivedProposal) GetStatus() ProposalStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
+}
+
+func (m *ArchivedProposal) GetFinalTallyResult() *TallyResult {
+	if m != nil {
+		return m.FinalTallyResult
 	}
 	return nil
 }
 
-// TallyParams defines the params for tallying votes on governance proposals.
-//
-// Deprecated: Do not use.
-type TallyParams struct {
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
-	Quorum string `protobuf:"bytes,1,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
-	Threshold string `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
-	VetoThreshold string `protobuf:"bytes,3,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+func (m *ArchivedProposal) GetVotingEndTime() *time.Time {
+	if m != nil {
+		return m.VotingEndTime
+	}
+	return nil
 }
 
-func (m *TallyParams) Reset()         { *m = TallyParams{} }
-func (m *TallyParams) String() string { return proto.CompactTextString(m) }
-func (*TallyParams) ProtoMessage()    {}
-func (*TallyParams) Descriptor() ([]byte, []int) {
+func (m *ArchivedProposal) GetFinishedHeight() int64 {
+	if m != nil {
+		return m.FinishedHeight
+	}
+	return 0
+}
+
+// DepositRecord defines the history of the deposits of a depositor on a proposal. Once the deposits
+// of the proposal are settled, it tracks the depositor's share of the deposits which has been refunded
+// and the share which has been charged, i.e. burned or sent to the proposal cancellation destination.
+//
+// Since: x/gov v1.0.0
+type DepositRecord struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// depositor defines the deposit address of the depositor.
+	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// deposited is the total amount deposited by the depositor on the proposal.
+	Deposited []types.Coin `protobuf:"bytes,3,rep,name=deposited,proto3" json:"deposited"`
+	// refunded is the amount refunded to the depositor.
+	Refunded []types.Coin `protobuf:"bytes,4,rep,name=refunded,proto3" json:"refunded"`
+	// charged is the amount of the depositor's deposit which has been burned or sent to the proposal
+	// cancellation destination.
+	Charged []types.Coin `protobuf:"bytes,5,rep,name=charged,proto3" json:"charged"`
+}
+
+func (m *DepositRecord) Reset()         { *m = DepositRecord{} }
+func (m *DepositRecord) String() string { return proto.CompactTextString(m) }
+func (*DepositRecord) ProtoMessage()    {}
+func (*DepositRecord) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e05cb1c0d030febb, []int{8}
 }
-func (m *TallyParams) XXX_Unmarshal(b []byte) error {
+func (m *DepositRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TallyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DepositRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TallyParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DepositRecord.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -927,133 +1107,84 @@ func (m *TallyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *TallyParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TallyParams.Merge(m, src)
+func (m *DepositRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositRecord.Merge(m, src)
 }
-func (m *TallyParams) XXX_Size() int {
+func (m *DepositRecord) XXX_Size() int {
 	return m.Size()
 }
-func (m *TallyParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_TallyParams.DiscardUnknown(m)
+func (m *DepositRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositRecord.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TallyParams proto.InternalMessageInfo
+var xxx_messageInfo_DepositRecord proto.InternalMessageInfo
 
-func (m *TallyParams) GetQuorum() string {
+func (m *DepositRecord) GetProposalId() uint64 {
 	if m != nil {
-		return m.Quorum
+		return m.ProposalId
 	}
-	return ""
+	return 0
 }
 
-func (m *TallyParams) GetThreshold() string {
+func (m *DepositRecord) GetDepositor() string {
 	if m != nil {
-		return m.Threshold
+		return m.Depositor
 	}
 	return ""
 }
 
-func (m *TallyParams) GetVetoThreshold() string {
+func (m *DepositRecord) GetDeposited() []types.Coin {
 	if m != nil {
-		return m.VetoThreshold
+		return m.Deposited
 	}
-	return ""
+	return nil
 }
 
-// Params defines the parameters for the x/gov module.
-//
-// Since: cosmos-sdk 0.47
-type Params struct {
-	// Minimum deposit for a proposal to enter voting period.
-	MinDeposit []types.Coin `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit"`
-	// Maximum period for stake holders to deposit on a proposal. Initial value: 2
-	// months.
-	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
-	// Duration of the voting period.
-	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
-	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
-	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
-	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
-	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
-	// The cancel ratio which will not be returned back to the depositors when a proposal is cancelled.
-	//
-	// Since: cosmos-sdk 0.50
-	ProposalCancelRatio string `protobuf:"bytes,8,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3" json:"proposal_cancel_ratio,omitempty"`
-	// The address which will receive (proposal_cancel_ratio * deposit) proposal deposits.
-	// If empty, the (proposal_cancel_ratio * deposit) proposal deposits will be burned.
-	//
-	// Since: cosmos-sdk 0.50
-	ProposalCancelDest string `protobuf:"bytes,9,opt,name=proposal_cancel_dest,json=proposalCancelDest,proto3" json:"proposal_cancel_dest,omitempty"`
-	// Duration of the voting period of an expedited proposal.
-	//
-	// Since: cosmos-sdk 0.50
-	ExpeditedVotingPeriod *time.Duration `protobuf:"bytes,10,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.67.
-	//
-	// Since: cosmos-sdk 0.50
-	ExpeditedThreshold string `protobuf:"bytes,11,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
-	// Minimum expedited deposit for a proposal to enter voting period.
-	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,12,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit"`
-	// burn deposits if a proposal does not meet quorum
-	//
-	// Since: cosmos-sdk 0.47
-	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
-	// burn deposits if the proposal does not enter voting period
-	//
-	// Since: cosmos-sdk 0.47
-	BurnProposalDepositPrevote bool `protobuf:"varint,14,opt,name=burn_proposal_deposit_prevote,json=burnProposalDepositPrevote,proto3" json:"burn_proposal_deposit_prevote,omitempty"`
-	// burn deposits if quorum with vote type no_veto is met
-	//
-	// Since: cosmos-sdk 0.47
-	BurnVoteVeto bool `protobuf:"varint,15,opt,name=burn_vote_veto,json=burnVoteVeto,proto3" json:"burn_vote_veto,omitempty"`
-	// The ratio representing the proportion of the deposit value minimum that must be met when making a deposit.
-	// Default value: 0.01. Meaning that for a chain with a min_deposit of 100stake, a deposit of 1stake would be
-	// required.
-	//
-	// Since: cosmos-sdk 0.50
-	MinDepositRatio string `protobuf:"bytes,16,opt,name=min_deposit_ratio,json=minDepositRatio,proto3" json:"min_deposit_ratio,omitempty"`
-	// proposal_cancel_max_period defines how far in the voting period a proposer can cancel a proposal.
-	// If the proposal is cancelled before the max cancel period, the deposit will be returned/burn to the
-	// depositors, according to the proposal_cancel_ratio and proposal_cancel_dest parameters.
-	// After the max cancel period, the proposal cannot be cancelled anymore.
-	//
-	// Since: x/gov v1.0.0
-	ProposalCancelMaxPeriod string `protobuf:"bytes,17,opt,name=proposal_cancel_max_period,json=proposalCancelMaxPeriod,proto3" json:"proposal_cancel_max_period,omitempty"`
-	// optimistic_authorized_addresses is an optional governance parameter that limits the authorized accounts than can
-	// submit optimistic proposals
-	//
-	// Since: x/gov v1.0.0
-	OptimisticAuthorizedAddresses []string `protobuf:"bytes,18,rep,name=optimistic_authorized_addresses,json=optimisticAuthorizedAddresses,proto3" json:"optimistic_authorized_addresses,omitempty"`
-	// optimistic rejected threshold defines at which percentage of NO votes, the optimistic proposal should fail and be
-	// converted to a standard proposal. The threshold is expressed as a percentage of the total bonded tokens.
-	//
-	// Since: x/gov v1.0.0
-	OptimisticRejectedThreshold string `protobuf:"bytes,19,opt,name=optimistic_rejected_threshold,json=optimisticRejectedThreshold,proto3" json:"optimistic_rejected_threshold,omitempty"`
-	// yes_quorum defines the minimum percentage of Yes votes in quorum for proposal to pass.
-	// Default value: 0 (disabled).
+func (m *DepositRecord) GetRefunded() []types.Coin {
+	if m != nil {
+		return m.Refunded
+	}
+	return nil
+}
+
+func (m *DepositRecord) GetCharged() []types.Coin {
+	if m != nil {
+		return m.Charged
+	}
+	return nil
+}
+
+// Vote defines a vote on a governance proposal.
+// A Vote consists of a proposal ID, the voter, and the vote option.
+type Vote struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// voter is the voter address of the proposal.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// options is the weighted vote options.
+	Options []*WeightedVoteOption `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`
+	// metadata is any arbitrary metadata attached to the vote.
+	// the recommended format of the metadata is to be found here: https://docs.cosmos.network/v0.47/modules/gov#vote-5
+	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// ranking is the ranked ballot of a vote on a multiple choice proposal, from the most to the least
+	// preferred option. When set, options contains the most preferred option with the full weight.
 	//
 	// Since: x/gov v1.0.0
-	YesQuorum string `protobuf:"bytes,20,opt,name=yes_quorum,json=yesQuorum,proto3" json:"yes_quorum,omitempty"`
+	Ranking []VoteOption `protobuf:"varint,6,rep,packed,name=ranking,proto3,enum=cosmos.gov.v1.VoteOption" json:"ranking,omitempty"`
 }
 
-func (m *Params) Reset()         { *m = Params{} }
-func (m *Params) String() string { return proto.CompactTextString(m) }
-func (*Params) ProtoMessage()    {}
-func (*Params) Descriptor() ([]byte, []int) {
+func (m *Vote) Reset()         { *m = Vote{} }
+func (m *Vote) String() string { return proto.CompactTextString(m) }
+func (*Vote) ProtoMessage()    {}
+func (*Vote) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e05cb1c0d030febb, []int{9}
 }
-func (m *Params) XXX_Unmarshal(b []byte) error {
+func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Vote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Vote.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1063,189 +1194,355 @@ func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Params) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Params.Merge(m, src)
+func (m *Vote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vote.Merge(m, src)
 }
-func (m *Params) XXX_Size() int {
+func (m *Vote) XXX_Size() int {
 	return m.Size()
 }
-func (m *Params) XXX_DiscardUnknown() {
-	xxx_messageInfo_Params.DiscardUnknown(m)
+func (m *Vote) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vote.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Params proto.InternalMessageInfo
+var xxx_messageInfo_Vote proto.InternalMessageInfo
 
-func (m *Params) GetMinDeposit() []types.Coin {
+func (m *Vote) GetProposalId() uint64 {
 	if m != nil {
-		return m.MinDeposit
+		return m.ProposalId
 	}
-	return nil
+	return 0
 }
 
-func (m *Params) GetMaxDepositPeriod() *time.Duration {
+func (m *Vote) GetVoter() string {
 	if m != nil {
-		return m.MaxDepositPeriod
+		return m.Voter
 	}
-	return nil
+	return ""
 }
 
-func (m *Params) GetVotingPeriod() *time.Duration {
+func (m *Vote) GetOptions() []*WeightedVoteOption {
 	if m != nil {
-		return m.VotingPeriod
+		return m.Options
 	}
 	return nil
 }
 
-func (m *Params) GetQuorum() string {
+func (m *Vote) GetMetadata() string {
 	if m != nil {
-		return m.Quorum
+		return m.Metadata
 	}
 	return ""
 }
 
-func (m *Params) GetThreshold() string {
+func (m *Vote) GetRanking() []VoteOption {
 	if m != nil {
-		return m.Threshold
+		return m.Ranking
 	}
-	return ""
+	return nil
 }
 
-func (m *Params) GetVetoThreshold() string {
-	if m != nil {
-		return m.VetoThreshold
-	}
-	return ""
+// DepositParams defines the params for deposits on governance proposals.
+//
+// Deprecated: Do not use.
+type DepositParams struct {
+	// Minimum deposit for a proposal to enter voting period.
+	MinDeposit []types.Coin `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit,omitempty"`
+	// Maximum period for Atom holders to deposit on a proposal. Initial value: 2
+	// months.
+	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 }
 
-func (m *Params) GetMinInitialDepositRatio() string {
-	if m != nil {
-		return m.MinInitialDepositRatio
-	}
-	return ""
+func (m *DepositParams) Reset()         { *m = DepositParams{} }
+func (m *DepositParams) String() string { return proto.CompactTextString(m) }
+func (*DepositParams) ProtoMessage()    {}
+func (*DepositParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{10}
 }
-
-func (m *Params) GetProposalCancelRatio() string {
-	if m != nil {
-		return m.ProposalCancelRatio
-	}
-	return ""
+func (m *DepositParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Params) GetProposalCancelDest() string {
-	if m != nil {
-		return m.ProposalCancelDest
+func (m *DepositParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *DepositParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositParams.Merge(m, src)
+}
+func (m *DepositParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositParams.DiscardUnknown(m)
 }
 
-func (m *Params) GetExpeditedVotingPeriod() *time.Duration {
+var xxx_messageInfo_DepositParams proto.InternalMessageInfo
+
+func (m *DepositParams) GetMinDeposit() []types.Coin {
 	if m != nil {
-		return m.ExpeditedVotingPeriod
+		return m.MinDeposit
 	}
 	return nil
 }
 
-func (m *Params) GetExpeditedThreshold() string {
+func (m *DepositParams) GetMaxDepositPeriod() *time.Duration {
 	if m != nil {
-		return m.ExpeditedThreshold
+		return m.MaxDepositPeriod
 	}
-	return ""
+	return nil
 }
 
-func (m *Params) GetExpeditedMinDeposit() []types.Coin {
-	if m != nil {
-		return m.ExpeditedMinDeposit
-	}
-	return nil
+// VotingParams defines the params for voting on governance proposals.
+//
+// Deprecated: Do not use.
+type VotingParams struct {
+	// Duration of the voting period.
+	VotingPeriod *time.Duration `protobuf:"bytes,1,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
 }
 
-func (m *Params) GetBurnVoteQuorum() bool {
-	if m != nil {
-		return m.BurnVoteQuorum
+func (m *VotingParams) Reset()         { *m = VotingParams{} }
+func (m *VotingParams) String() string { return proto.CompactTextString(m) }
+func (*VotingParams) ProtoMessage()    {}
+func (*VotingParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{11}
+}
+func (m *VotingParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotingParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotingParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return false
+}
+func (m *VotingParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotingParams.Merge(m, src)
+}
+func (m *VotingParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotingParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotingParams.DiscardUnknown(m)
 }
 
-func (m *Params) GetBurnProposalDepositPrevote() bool {
+var xxx_messageInfo_VotingParams proto.InternalMessageInfo
+
+func (m *VotingParams) GetVotingPeriod() *time.Duration {
 	if m != nil {
-		return m.BurnProposalDepositPrevote
+		return m.VotingPeriod
 	}
-	return false
+	return nil
 }
 
-func (m *Params) GetBurnVoteVeto() bool {
-	if m != nil {
-		return m.BurnVoteVeto
-	}
-	return false
+// TallyParams defines the params for tallying votes on governance proposals.
+//
+// Deprecated: Do not use.
+type TallyParams struct {
+	// Minimum percentage of total stake needed to vote for a result to be
+	// considered valid.
+	Quorum string `protobuf:"bytes,1,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	Threshold string `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// Minimum value of Veto votes to Total votes ratio for proposal to be
+	// vetoed. Default value: 1/3.
+	VetoThreshold string `protobuf:"bytes,3,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
 }
 
-func (m *Params) GetMinDepositRatio() string {
-	if m != nil {
-		return m.MinDepositRatio
-	}
-	return ""
+func (m *TallyParams) Reset()         { *m = TallyParams{} }
+func (m *TallyParams) String() string { return proto.CompactTextString(m) }
+func (*TallyParams) ProtoMessage()    {}
+func (*TallyParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{12}
 }
-
-func (m *Params) GetProposalCancelMaxPeriod() string {
-	if m != nil {
-		return m.ProposalCancelMaxPeriod
+func (m *TallyParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TallyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TallyParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *TallyParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TallyParams.Merge(m, src)
+}
+func (m *TallyParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *TallyParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_TallyParams.DiscardUnknown(m)
 }
 
-func (m *Params) GetOptimisticAuthorizedAddresses() []string {
+var xxx_messageInfo_TallyParams proto.InternalMessageInfo
+
+func (m *TallyParams) GetQuorum() string {
 	if m != nil {
-		return m.OptimisticAuthorizedAddresses
+		return m.Quorum
 	}
-	return nil
+	return ""
 }
 
-func (m *Params) GetOptimisticRejectedThreshold() string {
+func (m *TallyParams) GetThreshold() string {
 	if m != nil {
-		return m.OptimisticRejectedThreshold
+		return m.Threshold
 	}
 	return ""
 }
 
-func (m *Params) GetYesQuorum() string {
+func (m *TallyParams) GetVetoThreshold() string {
 	if m != nil {
-		return m.YesQuorum
+		return m.VetoThreshold
 	}
 	return ""
 }
 
-// MessageBasedParams defines the parameters of specific messages in a proposal.
-// It is used to define the parameters of a proposal that is based on a specific message.
-// Once a message has message based params, it only supports a standard proposal type.
+// Params defines the parameters for the x/gov module.
 //
-// Since: x/gov v1.0.0
-type MessageBasedParams struct {
+// Since: cosmos-sdk 0.47
+type Params struct {
+	// Minimum deposit for a proposal to enter voting period.
+	MinDeposit []types.Coin `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit"`
+	// Maximum period for stake holders to deposit on a proposal. Initial value: 2
+	// months.
+	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
-	VotingPeriod *time.Duration `protobuf:"bytes,1,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be considered valid.
-	Quorum string `protobuf:"bytes,2,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
+	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
+	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
+	// The cancel ratio which will not be returned back to the depositors when a proposal is cancelled.
+	//
+	// Since: cosmos-sdk 0.50
+	ProposalCancelRatio string `protobuf:"bytes,8,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3" json:"proposal_cancel_ratio,omitempty"`
+	// The address which will receive (proposal_cancel_ratio * deposit) proposal deposits.
+	// If empty, the (proposal_cancel_ratio * deposit) proposal deposits will be burned.
+	//
+	// Since: cosmos-sdk 0.50
+	ProposalCancelDest string `protobuf:"bytes,9,opt,name=proposal_cancel_dest,json=proposalCancelDest,proto3" json:"proposal_cancel_dest,omitempty"`
+	// Duration of the voting period of an expedited proposal.
+	//
+	// Since: cosmos-sdk 0.50
+	ExpeditedVotingPeriod *time.Duration `protobuf:"bytes,10,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty"`
+	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.67.
+	//
+	// Since: cosmos-sdk 0.50
+	ExpeditedThreshold string `protobuf:"bytes,11,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
+	//  Minimum expedited deposit for a proposal to enter voting period.
+	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,12,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit"`
+	// burn deposits if a proposal does not meet quorum
+	//
+	// Since: cosmos-sdk 0.47
+	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
+	// burn deposits if the proposal does not enter voting period
+	//
+	// Since: cosmos-sdk 0.47
+	BurnProposalDepositPrevote bool `protobuf:"varint,14,opt,name=burn_proposal_deposit_prevote,json=burnProposalDepositPrevote,proto3" json:"burn_proposal_deposit_prevote,omitempty"`
+	// burn deposits if quorum with vote type no_veto is met
+	//
+	// Since: cosmos-sdk 0.47
+	BurnVoteVeto bool `protobuf:"varint,15,opt,name=burn_vote_veto,json=burnVoteVeto,proto3" json:"burn_vote_veto,omitempty"`
+	// The ratio representing the proportion of the deposit value minimum that must be met when making a deposit.
+	// Default value: 0.01. Meaning that for a chain with a min_deposit of 100stake, a deposit of 1stake would be
+	// required.
+	//
+	// Since: cosmos-sdk 0.50
+	MinDepositRatio string `protobuf:"bytes,16,opt,name=min_deposit_ratio,json=minDepositRatio,proto3" json:"min_deposit_ratio,omitempty"`
+	// proposal_cancel_max_period defines how far in the voting period a proposer can cancel a proposal.
+	// If the proposal is cancelled before the max cancel period, the deposit will be returned/burn to the
+	// depositors, according to the proposal_cancel_ratio and proposal_cancel_dest parameters.
+	// After the max cancel period, the proposal cannot be cancelled anymore.
+	//
+	// Since: x/gov v1.0.0
+	ProposalCancelMaxPeriod string `protobuf:"bytes,17,opt,name=proposal_cancel_max_period,json=proposalCancelMaxPeriod,proto3" json:"proposal_cancel_max_period,omitempty"`
+	// optimistic_authorized_addresses is an optional governance parameter that limits the authorized accounts than can
+	// submit optimistic proposals
+	//
+	// Since: x/gov v1.0.0
+	OptimisticAuthorizedAddresses []string `protobuf:"bytes,18,rep,name=optimistic_authorized_addresses,json=optimisticAuthorizedAddresses,proto3" json:"optimistic_authorized_addresses,omitempty"`
+	// optimistic rejected threshold defines at which percentage of NO votes, the optimistic proposal should fail and be
+	// converted to a standard proposal. The threshold is expressed as a percentage of the total bonded tokens.
+	//
+	// Since: x/gov v1.0.0
+	OptimisticRejectedThreshold string `protobuf:"bytes,19,opt,name=optimistic_rejected_threshold,json=optimisticRejectedThreshold,proto3" json:"optimistic_rejected_threshold,omitempty"`
 	// yes_quorum defines the minimum percentage of Yes votes in quorum for proposal to pass.
-	// If zero then the yes_quorum is disabled.
+	// Default value: 0 (disabled).
+	//
+	// Since: x/gov v1.0.0
 	YesQuorum string `protobuf:"bytes,20,opt,name=yes_quorum,json=yesQuorum,proto3" json:"yes_quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass.
-	Threshold string `protobuf:"bytes,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be vetoed.
-	VetoThreshold string `protobuf:"bytes,4,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	// security_council defines the parameters of the security council proposal track.
+	//
+	// Since: x/gov v1.0.0
+	SecurityCouncil *SecurityCouncilParams `protobuf:"bytes,21,opt,name=security_council,json=securityCouncil,proto3" json:"security_council,omitempty"`
+	// emergency defines the parameters of emergency proposals.
+	//
+	// Since: x/gov v1.0.0
+	Emergency *EmergencyParams `protobuf:"bytes,22,opt,name=emergency,proto3" json:"emergency,omitempty"`
+	// proposal_archive_retention defines the number of blocks after which a finished proposal is archived.
+	// Archiving a proposal replaces it by a compacted summary and deletes its votes and deposits.
+	// Default value: 0 (disabled).
+	//
+	// Since: x/gov v1.0.0
+	ProposalArchiveRetention uint64 `protobuf:"varint,23,opt,name=proposal_archive_retention,json=proposalArchiveRetention,proto3" json:"proposal_archive_retention,omitempty"`
+	// deposit_burn_ratio defines the ratio of each deposit which is burned when the deposits of a proposal
+	// are burned (see burn_vote_quorum, burn_proposal_deposit_prevote and burn_vote_veto). The remainder of
+	// each deposit is refunded to its depositor, pro rata to its share of the deposits.
+	// Default value: 1 (the deposits are fully burned).
+	//
+	// Since: x/gov v1.0.0
+	DepositBurnRatio string `protobuf:"bytes,24,opt,name=deposit_burn_ratio,json=depositBurnRatio,proto3" json:"deposit_burn_ratio,omitempty"`
+	// line_item_veto defines the parameters of the line-item veto of multi-message proposals.
+	//
+	// Since: x/gov v1.0.0
+	LineItemVeto *LineItemVetoParams `protobuf:"bytes,25,opt,name=line_item_veto,json=lineItemVeto,proto3" json:"line_item_veto,omitempty"`
+	// execution_gas_per_block defines the gas budget per block for the execution of the messages of
+	// passed proposals submitted with chunked execution. Zero disables the chunked execution.
+	//
+	// Since: x/gov v1.0.0
+	ExecutionGasPerBlock uint64 `protobuf:"varint,26,opt,name=execution_gas_per_block,json=executionGasPerBlock,proto3" json:"execution_gas_per_block,omitempty"`
+	// whale_alert defines the thresholds above which a single vote or deposit emits a whale alert.
+	//
+	// Since: x/gov v1.0.0
+	WhaleAlert *WhaleAlertParams `protobuf:"bytes,27,opt,name=whale_alert,json=whaleAlert,proto3" json:"whale_alert,omitempty"`
 }
 
-func (m *MessageBasedParams) Reset()         { *m = MessageBasedParams{} }
-func (m *MessageBasedParams) String() string { return proto.CompactTextString(m) }
-func (*MessageBasedParams) ProtoMessage()    {}
-func (*MessageBasedParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{10}
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{13}
 }
-func (m *MessageBasedParams) XXX_Unmarshal(b []byte) error {
+func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MessageBasedParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MessageBasedParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1255,959 +1552,1338 @@ func (m *MessageBasedParams) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MessageBasedParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MessageBasedParams.Merge(m, src)
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
 }
-func (m *MessageBasedParams) XXX_Size() int {
+func (m *Params) XXX_Size() int {
 	return m.Size()
 }
-func (m *MessageBasedParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MessageBasedParams.DiscardUnknown(m)
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MessageBasedParams proto.InternalMessageInfo
+var xxx_messageInfo_Params proto.InternalMessageInfo
 
-func (m *MessageBasedParams) GetVotingPeriod() *time.Duration {
+func (m *Params) GetMinDeposit() []types.Coin {
 	if m != nil {
-		return m.VotingPeriod
+		return m.MinDeposit
 	}
 	return nil
 }
 
-func (m *MessageBasedParams) GetQuorum() string {
+func (m *Params) GetMaxDepositPeriod() *time.Duration {
 	if m != nil {
-		return m.Quorum
+		return m.MaxDepositPeriod
 	}
-	return ""
+	return nil
 }
 
-func (m *MessageBasedParams) GetYesQuorum() string {
+func (m *Params) GetVotingPeriod() *time.Duration {
 	if m != nil {
-		return m.YesQuorum
+		return m.VotingPeriod
+	}
+	return nil
+}
+
+func (m *Params) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
 	}
 	return ""
 }
 
-func (m *MessageBasedParams) GetThreshold() string {
+func (m *Params) GetThreshold() string {
 	if m != nil {
 		return m.Threshold
 	}
 	return ""
 }
 
-func (m *MessageBasedParams) GetVetoThreshold() string {
+func (m *Params) GetVetoThreshold() string {
 	if m != nil {
 		return m.VetoThreshold
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("cosmos.gov.v1.ProposalType", ProposalType_name, ProposalType_value)
-	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
-	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
-	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.v1.WeightedVoteOption")
-	proto.RegisterType((*Deposit)(nil), "cosmos.gov.v1.Deposit")
-	proto.RegisterType((*Proposal)(nil), "cosmos.gov.v1.Proposal")
-	proto.RegisterType((*ProposalVoteOptions)(nil), "cosmos.gov.v1.ProposalVoteOptions")
-	proto.RegisterType((*TallyResult)(nil), "cosmos.gov.v1.TallyResult")
-	proto.RegisterType((*Vote)(nil), "cosmos.gov.v1.Vote")
-	proto.RegisterType((*DepositParams)(nil), "cosmos.gov.v1.DepositParams")
-	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1.VotingParams")
-	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
-	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
-	proto.RegisterType((*MessageBasedParams)(nil), "cosmos.gov.v1.MessageBasedParams")
+func (m *Params) GetMinInitialDepositRatio() string {
+	if m != nil {
+		return m.MinInitialDepositRatio
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
-
-var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1856 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x73, 0xe3, 0x48,
-	0x15, 0x1f, 0xd9, 0x8e, 0x63, 0xbf, 0x38, 0x8e, 0xa6, 0x93, 0xd9, 0x28, 0xc9, 0xe6, 0x63, 0xcc,
-	0xd6, 0x56, 0x6a, 0xd8, 0xd8, 0x64, 0x61, 0x28, 0x6a, 0x59, 0x0a, 0xec, 0x58, 0x43, 0x34, 0x24,
-	0xb1, 0x91, 0x35, 0xc9, 0x0c, 0x17, 0xa1, 0x44, 0x3d, 0x89, 0xc0, 0x52, 0x1b, 0xa9, 0x9d, 0xc4,
-	0xfc, 0x15, 0x7b, 0xa2, 0x38, 0x51, 0xdc, 0xe0, 0xc8, 0x61, 0x8b, 0xe2, 0xca, 0x6d, 0x8b, 0x03,
-	0xb5, 0xb5, 0x27, 0x2e, 0x0c, 0xd4, 0xcc, 0x81, 0xaa, 0xfd, 0x13, 0x28, 0x0e, 0x54, 0x7f, 0xc8,
-	0x92, 0x1d, 0x67, 0x92, 0x6c, 0xed, 0x25, 0x91, 0xde, 0xfb, 0xfd, 0x5e, 0xbf, 0x7e, 0x5f, 0xdd,
-	0x16, 0x2c, 0x9e, 0x90, 0xc8, 0x27, 0x51, 0xed, 0x94, 0x9c, 0xd7, 0xce, 0xb7, 0xd9, 0xbf, 0x6a,
-	0x2f, 0x24, 0x94, 0xa0, 0x59, 0xa1, 0xa8, 0x32, 0xc9, 0xf9, 0xf6, 0xf2, 0x9a, 0xc4, 0x1d, 0x3b,
-	0x11, 0xae, 0x9d, 0x6f, 0x1f, 0x63, 0xea, 0x6c, 0xd7, 0x4e, 0x88, 0x17, 0x08, 0xf8, 0xf2, 0xc2,
-	0x29, 0x39, 0x25, 0xfc, 0xb1, 0xc6, 0x9e, 0xa4, 0x74, 0xfd, 0x94, 0x90, 0xd3, 0x2e, 0xae, 0xf1,
-	0xb7, 0xe3, 0xfe, 0xcb, 0x1a, 0xf5, 0x7c, 0x1c, 0x51, 0xc7, 0xef, 0x49, 0xc0, 0xd2, 0x38, 0xc0,
-	0x09, 0x06, 0x52, 0xb5, 0x36, 0xae, 0x72, 0xfb, 0xa1, 0x43, 0x3d, 0x12, 0xaf, 0xb8, 0x24, 0x3c,
-	0xb2, 0xc5, 0xa2, 0xd2, 0x5b, 0xa1, 0xba, 0xef, 0xf8, 0x5e, 0x40, 0x6a, 0xfc, 0xaf, 0x10, 0x55,
-	0x08, 0xa0, 0x23, 0xec, 0x9d, 0x9e, 0x51, 0xec, 0x1e, 0x12, 0x8a, 0x5b, 0x3d, 0x66, 0x09, 0x6d,
-	0x43, 0x9e, 0xf0, 0x27, 0x4d, 0xd9, 0x50, 0x36, 0xcb, 0x1f, 0x2e, 0x55, 0x47, 0x76, 0x5d, 0x4d,
-	0xa0, 0xa6, 0x04, 0xa2, 0xf7, 0x21, 0x7f, 0xc1, 0x0d, 0x69, 0x99, 0x0d, 0x65, 0xb3, 0xd8, 0x28,
-	0x7f, 0xf1, 0xe9, 0x16, 0x48, 0x56, 0x13, 0x9f, 0x98, 0x52, 0x5b, 0xf9, 0xbd, 0x02, 0xd3, 0x4d,
-	0xdc, 0x23, 0x91, 0x47, 0xd1, 0x3a, 0xcc, 0xf4, 0x42, 0xd2, 0x23, 0x91, 0xd3, 0xb5, 0x3d, 0x97,
-	0xaf, 0x95, 0x33, 0x21, 0x16, 0x19, 0x2e, 0xfa, 0x2e, 0x14, 0x5d, 0x81, 0x25, 0xa1, 0xb4, 0xab,
-	0x7d, 0xf1, 0xe9, 0xd6, 0x82, 0xb4, 0x5b, 0x77, 0xdd, 0x10, 0x47, 0x51, 0x87, 0x86, 0x5e, 0x70,
-	0x6a, 0x26, 0x50, 0xf4, 0x31, 0xe4, 0x1d, 0x9f, 0xf4, 0x03, 0xaa, 0x65, 0x37, 0xb2, 0x9b, 0x33,
-	0x89, 0xff, 0x2c, 0x4d, 0x55, 0x99, 0xa6, 0xea, 0x0e, 0xf1, 0x82, 0x46, 0xf1, 0xb3, 0x57, 0xeb,
-	0xf7, 0xfe, 0xf8, 0x9f, 0x3f, 0x3d, 0x52, 0x4c, 0xc9, 0xa9, 0xfc, 0x2d, 0x0f, 0x85, 0xb6, 0x74,
-	0x02, 0x95, 0x21, 0x33, 0x74, 0x2d, 0xe3, 0xb9, 0xe8, 0x5b, 0x50, 0xf0, 0x71, 0x14, 0x39, 0xa7,
-	0x38, 0xd2, 0x32, 0xdc, 0xf8, 0x42, 0x55, 0x64, 0xa4, 0x1a, 0x67, 0xa4, 0x5a, 0x0f, 0x06, 0xe6,
-	0x10, 0x85, 0x1e, 0x43, 0x3e, 0xa2, 0x0e, 0xed, 0x47, 0x5a, 0x96, 0x07, 0x73, 0x75, 0x2c, 0x98,
-	0xf1, 0x52, 0x1d, 0x0e, 0x32, 0x25, 0x18, 0xed, 0x02, 0x7a, 0xe9, 0x05, 0x4e, 0xd7, 0xa6, 0x4e,
-	0xb7, 0x3b, 0xb0, 0x43, 0x1c, 0xf5, 0xbb, 0x54, 0xcb, 0x6d, 0x28, 0x9b, 0x33, 0x1f, 0x2e, 0x8f,
-	0x99, 0xb0, 0x18, 0xc4, 0xe4, 0x08, 0x53, 0xe5, 0xac, 0x94, 0x04, 0xd5, 0x61, 0x26, 0xea, 0x1f,
-	0xfb, 0x1e, 0xb5, 0x59, 0x99, 0x69, 0x53, 0xd2, 0xc4, 0xb8, 0xd7, 0x56, 0x5c, 0x83, 0x8d, 0xdc,
-	0x27, 0xff, 0x5a, 0x57, 0x4c, 0x10, 0x24, 0x26, 0x46, 0x4f, 0x41, 0x95, 0xd1, 0xb5, 0x71, 0xe0,
-	0x0a, 0x3b, 0xf9, 0x5b, 0xda, 0x29, 0x4b, 0xa6, 0x1e, 0xb8, 0xdc, 0x96, 0x01, 0xb3, 0x94, 0x50,
-	0xa7, 0x6b, 0x4b, 0xb9, 0x36, 0x7d, 0x87, 0x1c, 0x95, 0x38, 0x35, 0x2e, 0xa0, 0x3d, 0xb8, 0x7f,
-	0x4e, 0xa8, 0x17, 0x9c, 0xda, 0x11, 0x75, 0x42, 0xb9, 0xbf, 0xc2, 0x2d, 0xfd, 0x9a, 0x13, 0xd4,
-	0x0e, 0x63, 0x72, 0xc7, 0x76, 0x41, 0x8a, 0x92, 0x3d, 0x16, 0x6f, 0x69, 0x6b, 0x56, 0x10, 0xe3,
-	0x2d, 0x2e, 0xb3, 0x22, 0xa1, 0x8e, 0xeb, 0x50, 0x47, 0x03, 0x56, 0xb6, 0xe6, 0xf0, 0x1d, 0x2d,
-	0xc0, 0x14, 0xf5, 0x68, 0x17, 0x6b, 0x33, 0x5c, 0x21, 0x5e, 0x90, 0x06, 0xd3, 0x51, 0xdf, 0xf7,
-	0x9d, 0x70, 0xa0, 0x95, 0xb8, 0x3c, 0x7e, 0x45, 0xdf, 0x81, 0x82, 0xe8, 0x08, 0x1c, 0x6a, 0xb3,
-	0x37, 0xb4, 0xc0, 0x10, 0x89, 0x36, 0xa0, 0x88, 0x2f, 0x7b, 0xd8, 0xf5, 0x28, 0x76, 0xb5, 0xf2,
-	0x86, 0xb2, 0x59, 0x68, 0x64, 0x34, 0xc5, 0x4c, 0x84, 0xe8, 0x1b, 0x30, 0xfb, 0xd2, 0xf1, 0xba,
-	0xd8, 0xb5, 0x43, 0xec, 0x44, 0x24, 0xd0, 0xe6, 0xf8, 0xba, 0x25, 0x21, 0x34, 0xb9, 0x0c, 0xfd,
-	0x08, 0x66, 0x87, 0x1d, 0x4a, 0x07, 0x3d, 0xac, 0xa9, 0xbc, 0x84, 0x57, 0xae, 0x29, 0x61, 0x6b,
-	0xd0, 0xc3, 0x66, 0xa9, 0x97, 0x7a, 0xab, 0xfc, 0x45, 0x81, 0xf9, 0x58, 0x9d, 0x8c, 0x8d, 0x08,
-	0xad, 0x02, 0x88, 0xc9, 0x61, 0x93, 0x00, 0xf3, 0xfe, 0x2a, 0x9a, 0x45, 0x21, 0x69, 0x05, 0x38,
-	0xa5, 0xa6, 0x17, 0x44, 0xb4, 0x7e, 0xac, 0xb6, 0x2e, 0x08, 0x7a, 0x08, 0xa5, 0x58, 0x7d, 0x16,
-	0x62, 0xcc, 0x3b, 0xab, 0x68, 0xce, 0x48, 0x00, 0x13, 0xb1, 0xe1, 0x22, 0x21, 0x2f, 0x49, 0x3f,
-	0xe4, 0x8d, 0x53, 0x34, 0xa5, 0xd1, 0x27, 0xa4, 0x1f, 0xa6, 0x00, 0x51, 0xcf, 0xf1, 0x79, 0x5b,
-	0x0c, 0x01, 0x9d, 0x9e, 0xe3, 0x57, 0xfe, 0x97, 0x85, 0x99, 0x74, 0x1f, 0x6d, 0x41, 0x71, 0x80,
-	0x23, 0xfb, 0x84, 0x0f, 0x16, 0xee, 0x71, 0x43, 0x4d, 0x4d, 0x39, 0x83, 0x49, 0xcd, 0xc2, 0x00,
-	0x47, 0x3b, 0x0c, 0x81, 0x1e, 0xc3, 0xac, 0x73, 0x1c, 0x51, 0xc7, 0x0b, 0x24, 0x25, 0x73, 0x0d,
-	0xa5, 0x24, 0x61, 0x82, 0xf6, 0x4d, 0x28, 0x04, 0x44, 0x32, 0xb2, 0xd7, 0x30, 0xa6, 0x03, 0x22,
-	0xc0, 0x3f, 0x00, 0x14, 0x10, 0xfb, 0xc2, 0xa3, 0x67, 0xf6, 0x39, 0xa6, 0x31, 0x2d, 0x77, 0x0d,
-	0x6d, 0x2e, 0x20, 0x47, 0x1e, 0x3d, 0x3b, 0xc4, 0x54, 0xd2, 0xbf, 0x07, 0x6a, 0x92, 0x04, 0x49,
-	0x9e, 0xba, 0x32, 0xbe, 0x8d, 0x80, 0x9a, 0xe5, 0x61, 0x6a, 0xc6, 0x99, 0xf4, 0x22, 0x5e, 0x36,
-	0xff, 0x36, 0xa6, 0x75, 0x21, 0xd7, 0xfc, 0x18, 0x50, 0x3a, 0x75, 0x92, 0x3b, 0x3d, 0x91, 0xab,
-	0xa6, 0x12, 0x2a, 0xd8, 0x1f, 0xc1, 0xfd, 0x54, 0x56, 0x25, 0xb9, 0x30, 0x91, 0x3c, 0x97, 0xe4,
-	0x5a, 0x70, 0xb7, 0x00, 0x58, 0xa6, 0x25, 0xa9, 0x38, 0x91, 0x54, 0x64, 0x08, 0x0e, 0xaf, 0xfc,
-	0x59, 0x81, 0x1c, 0xab, 0xd8, 0x9b, 0x8f, 0xa9, 0x2a, 0x4c, 0x9d, 0x13, 0x8a, 0x6f, 0x3e, 0xa2,
-	0x04, 0x0c, 0x7d, 0x1f, 0xa6, 0x85, 0x6f, 0x91, 0x96, 0xe3, 0xb3, 0xef, 0xe1, 0x58, 0x3f, 0x5d,
-	0x3d, 0x92, 0xcd, 0x98, 0x31, 0x32, 0x5b, 0xa6, 0x46, 0x67, 0xcb, 0xd3, 0x5c, 0x21, 0xab, 0xe6,
-	0x2a, 0xff, 0x54, 0x60, 0x56, 0x4e, 0xc8, 0xb6, 0x13, 0x3a, 0x7e, 0x84, 0x5e, 0xc0, 0x8c, 0xef,
-	0x05, 0xc3, 0x81, 0xab, 0xdc, 0x34, 0x70, 0x57, 0xd9, 0xc0, 0xfd, 0xf2, 0xd5, 0xfa, 0x83, 0x14,
-	0xeb, 0x03, 0xe2, 0x7b, 0x14, 0xfb, 0x3d, 0x3a, 0x30, 0xc1, 0xf7, 0x82, 0x78, 0x04, 0xfb, 0x80,
-	0x7c, 0xe7, 0x32, 0x06, 0xd9, 0x3d, 0x1c, 0x7a, 0xc4, 0xe5, 0x81, 0x60, 0x2b, 0x8c, 0xcf, 0xcd,
-	0xa6, 0xbc, 0xab, 0x34, 0xde, 0xfb, 0xf2, 0xd5, 0xfa, 0xbb, 0x57, 0x89, 0xc9, 0x22, 0xbf, 0x65,
-	0x63, 0x55, 0xf5, 0x9d, 0xcb, 0x78, 0x27, 0x5c, 0xff, 0x51, 0x46, 0x53, 0x2a, 0xcf, 0xa1, 0x74,
-	0xc8, 0xc7, 0xad, 0xdc, 0x5d, 0x13, 0xe4, 0xf8, 0x8d, 0x57, 0x57, 0x6e, 0x5a, 0x3d, 0xc7, 0xad,
-	0x97, 0x04, 0x2b, 0x65, 0xf9, 0x77, 0x8a, 0xec, 0x78, 0x69, 0xf9, 0x7d, 0xc8, 0xff, 0xaa, 0x4f,
-	0xc2, 0xbe, 0x2f, 0xdb, 0xfd, 0xca, 0xa5, 0x46, 0x68, 0xd1, 0x07, 0x50, 0x64, 0xc5, 0x1c, 0x9d,
-	0x91, 0xae, 0x7b, 0xcd, 0xfd, 0x27, 0x01, 0xa0, 0xc7, 0x50, 0xe6, 0xcd, 0x9a, 0x50, 0xb2, 0x13,
-	0x29, 0xb3, 0x0c, 0x65, 0xc5, 0x20, 0xee, 0xe0, 0x5f, 0x01, 0xf2, 0xd2, 0x37, 0xfd, 0x8e, 0x39,
-	0x4d, 0x1d, 0xa2, 0xe9, 0xfc, 0xed, 0x7f, 0xb5, 0xfc, 0xe5, 0x26, 0xe7, 0xe7, 0x6a, 0x2e, 0xb2,
-	0x5f, 0x21, 0x17, 0xa9, 0xb8, 0xe7, 0x6e, 0x1f, 0xf7, 0xa9, 0xbb, 0xc7, 0x3d, 0x7f, 0x8b, 0xb8,
-	0x23, 0x03, 0x96, 0x58, 0xa0, 0xbd, 0xc0, 0xa3, 0x5e, 0x72, 0x6b, 0xb1, 0xb9, 0xfb, 0x13, 0xe6,
-	0x16, 0xb3, 0xf0, 0x8e, 0xef, 0x05, 0x86, 0xc0, 0xcb, 0xf0, 0x98, 0x0c, 0x8d, 0x1a, 0xf0, 0x60,
-	0x38, 0x49, 0x4e, 0x9c, 0xe0, 0x04, 0x77, 0xa5, 0x99, 0xc2, 0x44, 0x33, 0xf3, 0x31, 0x78, 0x87,
-	0x63, 0x85, 0x8d, 0xa7, 0xb0, 0x30, 0x6e, 0xc3, 0xc5, 0x51, 0x3c, 0xcf, 0xae, 0x9f, 0x3d, 0x68,
-	0xd4, 0x58, 0x13, 0x47, 0x14, 0x1d, 0xc1, 0xe2, 0xf0, 0x42, 0x60, 0x8f, 0xe6, 0x0d, 0x6e, 0x97,
-	0xb7, 0x07, 0x43, 0xfe, 0x61, 0x3a, 0x81, 0x3f, 0x84, 0xf9, 0xc4, 0x70, 0x12, 0xef, 0x99, 0x89,
-	0xdb, 0x44, 0x43, 0x68, 0x12, 0xf4, 0xe7, 0x90, 0x58, 0xb6, 0xd3, 0x75, 0x5e, 0xba, 0x43, 0x9d,
-	0x27, 0x3e, 0xec, 0x27, 0x05, 0xbf, 0x09, 0xea, 0x71, 0x3f, 0x0c, 0xd8, 0x76, 0xb1, 0x2d, 0xab,
-	0x8c, 0xdd, 0xab, 0x0a, 0x66, 0x99, 0xc9, 0xd9, 0xc8, 0xfd, 0xa9, 0xa8, 0xae, 0x3a, 0xac, 0x72,
-	0xe4, 0x30, 0xdc, 0xc3, 0x26, 0x09, 0x31, 0x63, 0x8b, 0x7b, 0x95, 0xb9, 0xcc, 0x40, 0xf1, 0x15,
-	0x27, 0xee, 0x06, 0x81, 0x40, 0xef, 0x41, 0x39, 0x59, 0x8c, 0x95, 0x15, 0xbf, 0x65, 0x15, 0xcc,
-	0x52, 0xbc, 0x14, 0x3b, 0x8b, 0xd9, 0xa1, 0x96, 0xda, 0xa2, 0x2c, 0x09, 0x75, 0x62, 0xac, 0xe6,
-	0x92, 0xd6, 0x15, 0xe5, 0xf0, 0x13, 0x58, 0x1e, 0x2f, 0x07, 0xd6, 0xcf, 0x32, 0x8b, 0xf7, 0x27,
-	0x1a, 0x59, 0x1c, 0x2d, 0x85, 0x7d, 0xe7, 0x52, 0xa6, 0xed, 0xe7, 0xb0, 0xce, 0x8e, 0x19, 0xdf,
-	0x8b, 0xa8, 0x77, 0x62, 0x3b, 0x7d, 0x7a, 0x46, 0x42, 0xef, 0xd7, 0xd8, 0xb5, 0x1d, 0x51, 0x4a,
-	0x38, 0xd2, 0xd0, 0x46, 0xf6, 0xad, 0x65, 0xb6, 0x9a, 0x18, 0xa8, 0x0f, 0xf9, 0xf5, 0x98, 0x8e,
-	0x4c, 0x48, 0x01, 0xec, 0x10, 0xff, 0x02, 0x9f, 0x8c, 0x96, 0xc8, 0xfc, 0x44, 0x8f, 0x57, 0x12,
-	0x92, 0x29, 0x39, 0x49, 0xad, 0x6c, 0x01, 0xb0, 0x7b, 0x99, 0xcc, 0xe5, 0xc2, 0xe4, 0x31, 0x30,
-	0xc0, 0x91, 0x48, 0x6b, 0xe5, 0x37, 0x19, 0x40, 0xfb, 0xe2, 0xc7, 0x59, 0xc3, 0x89, 0xb0, 0xfb,
-	0x75, 0x9e, 0x22, 0xa9, 0xc9, 0x95, 0x79, 0xeb, 0xe4, 0xba, 0x9b, 0xcf, 0xa3, 0x83, 0x2e, 0x7b,
-	0xf7, 0x41, 0x97, 0xbb, 0xc5, 0xa0, 0x7b, 0xf4, 0x07, 0x05, 0x4a, 0xe9, 0x9b, 0x3c, 0x5a, 0x85,
-	0xa5, 0xb6, 0xd9, 0x6a, 0xb7, 0x3a, 0xf5, 0x3d, 0xdb, 0x7a, 0xd1, 0xd6, 0xed, 0x67, 0x07, 0x9d,
-	0xb6, 0xbe, 0x63, 0x3c, 0x31, 0xf4, 0xa6, 0x7a, 0x0f, 0x2d, 0xc3, 0x3b, 0xa3, 0xea, 0x8e, 0x55,
-	0x3f, 0x68, 0xd6, 0xcd, 0xa6, 0xaa, 0xa0, 0x87, 0xb0, 0x3a, 0xaa, 0xdb, 0x7f, 0xb6, 0x67, 0x19,
-	0xed, 0x3d, 0xdd, 0xde, 0xd9, 0x6d, 0x19, 0x3b, 0xba, 0x9a, 0x41, 0xef, 0x82, 0x36, 0x0a, 0x69,
-	0xb5, 0x2d, 0x63, 0xdf, 0xe8, 0x58, 0xc6, 0x8e, 0x9a, 0x45, 0x2b, 0xb0, 0x38, 0xaa, 0xd5, 0x9f,
-	0xb7, 0xf5, 0xa6, 0x61, 0xe9, 0x4d, 0x35, 0xf7, 0xe8, 0xbf, 0x0a, 0x40, 0xea, 0x73, 0xc5, 0x0a,
-	0x2c, 0x1e, 0xb6, 0x2c, 0x61, 0xa0, 0x75, 0x30, 0xe6, 0xe5, 0x3c, 0xcc, 0xa5, 0x95, 0xad, 0x03,
-	0x5d, 0x55, 0xc6, 0x85, 0x2f, 0xf4, 0xce, 0x55, 0xa1, 0x75, 0xd4, 0x52, 0x33, 0x68, 0x11, 0xe6,
-	0xd3, 0xc2, 0x7a, 0xa3, 0x63, 0xd5, 0x8d, 0x03, 0x35, 0x83, 0x1e, 0xc0, 0xfd, 0x11, 0xf4, 0xae,
-	0xa9, 0xeb, 0x6a, 0x16, 0x21, 0x28, 0xa7, 0xc5, 0x07, 0x2d, 0x35, 0x8b, 0x16, 0x40, 0x4d, 0xcb,
-	0x9e, 0xb4, 0x9e, 0x99, 0x6a, 0x8e, 0xed, 0x7f, 0x14, 0x69, 0x1f, 0x19, 0xd6, 0xae, 0x7d, 0xa8,
-	0x5b, 0x2d, 0x35, 0x37, 0xce, 0xe9, 0xb4, 0xeb, 0xfb, 0xea, 0xd4, 0x72, 0x46, 0x55, 0x1e, 0xfd,
-	0x5d, 0x81, 0xf2, 0xe8, 0x37, 0x03, 0xb4, 0x0e, 0x2b, 0xc3, 0x60, 0x75, 0xac, 0xba, 0xf5, 0xac,
-	0x33, 0x16, 0x84, 0x0a, 0xac, 0x8d, 0x03, 0x9a, 0x7a, 0xbb, 0xd5, 0x31, 0x2c, 0xbb, 0xad, 0x9b,
-	0x46, 0x6b, 0x3c, 0x65, 0x12, 0x73, 0xd8, 0xb2, 0x8c, 0x83, 0x1f, 0xc7, 0x90, 0xcc, 0x48, 0xc6,
-	0x25, 0xa4, 0x5d, 0xef, 0x74, 0xf4, 0xa6, 0x9a, 0x1d, 0x49, 0xa7, 0xd4, 0x99, 0xfa, 0x53, 0x7d,
-	0x87, 0x67, 0x6c, 0x12, 0xf3, 0x49, 0xdd, 0xd8, 0xd3, 0x9b, 0xea, 0x54, 0xe3, 0xf1, 0x67, 0xaf,
-	0xd7, 0x94, 0xcf, 0x5f, 0xaf, 0x29, 0xff, 0x7e, 0xbd, 0xa6, 0x7c, 0xf2, 0x66, 0xed, 0xde, 0xe7,
-	0x6f, 0xd6, 0xee, 0xfd, 0xe3, 0xcd, 0xda, 0xbd, 0x9f, 0xad, 0x88, 0x62, 0x8d, 0xdc, 0x5f, 0x56,
-	0x3d, 0x52, 0xbb, 0xe4, 0x5f, 0xe3, 0xd8, 0xcf, 0xd0, 0xa8, 0x76, 0xbe, 0x7d, 0x9c, 0xe7, 0x1d,
-	0xf9, 0xed, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x3d, 0xf3, 0x07, 0xab, 0x13, 0x00, 0x00,
+func (m *Params) GetProposalCancelRatio() string {
+	if m != nil {
+		return m.ProposalCancelRatio
+	}
+	return ""
 }
 
-func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetProposalCancelDest() string {
+	if m != nil {
+		return m.ProposalCancelDest
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *WeightedVoteOption) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetExpeditedVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.ExpeditedVotingPeriod
+	}
+	return nil
 }
 
-func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Weight) > 0 {
-		i -= len(m.Weight)
-		copy(dAtA[i:], m.Weight)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Weight)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Option != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.Option))
-		i--
-		dAtA[i] = 0x8
+func (m *Params) GetExpeditedThreshold() string {
+	if m != nil {
+		return m.ExpeditedThreshold
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Deposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetExpeditedMinDeposit() []types.Coin {
+	if m != nil {
+		return m.ExpeditedMinDeposit
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetBurnVoteQuorum() bool {
+	if m != nil {
+		return m.BurnVoteQuorum
+	}
+	return false
 }
 
-func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+func (m *Params) GetBurnProposalDepositPrevote() bool {
+	if m != nil {
+		return m.BurnProposalDepositPrevote
 	}
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Depositor)))
-		i--
-		dAtA[i] = 0x12
+	return false
+}
+
+func (m *Params) GetBurnVoteVeto() bool {
+	if m != nil {
+		return m.BurnVoteVeto
 	}
-	if m.ProposalId != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x8
+	return false
+}
+
+func (m *Params) GetMinDepositRatio() string {
+	if m != nil {
+		return m.MinDepositRatio
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Proposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetProposalCancelMaxPeriod() string {
+	if m != nil {
+		return m.ProposalCancelMaxPeriod
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetOptimisticAuthorizedAddresses() []string {
+	if m != nil {
+		return m.OptimisticAuthorizedAddresses
+	}
+	return nil
 }
 
-func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ProposalType != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.ProposalType))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x80
+func (m *Params) GetOptimisticRejectedThreshold() string {
+	if m != nil {
+		return m.OptimisticRejectedThreshold
 	}
-	if len(m.FailedReason) > 0 {
-		i -= len(m.FailedReason)
-		copy(dAtA[i:], m.FailedReason)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.FailedReason)))
-		i--
-		dAtA[i] = 0x7a
+	return ""
+}
+
+func (m *Params) GetYesQuorum() string {
+	if m != nil {
+		return m.YesQuorum
 	}
-	if m.Expedited {
-		i--
-		if m.Expedited {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x70
+	return ""
+}
+
+func (m *Params) GetSecurityCouncil() *SecurityCouncilParams {
+	if m != nil {
+		return m.SecurityCouncil
 	}
-	if len(m.Proposer) > 0 {
-		i -= len(m.Proposer)
-		copy(dAtA[i:], m.Proposer)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
-		i--
-		dAtA[i] = 0x6a
+	return nil
+}
+
+func (m *Params) GetEmergency() *EmergencyParams {
+	if m != nil {
+		return m.Emergency
 	}
-	if len(m.Summary) > 0 {
-		i -= len(m.Summary)
-		copy(dAtA[i:], m.Summary)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Summary)))
-		i--
-		dAtA[i] = 0x62
+	return nil
+}
+
+func (m *Params) GetProposalArchiveRetention() uint64 {
+	if m != nil {
+		return m.ProposalArchiveRetention
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0x5a
+	return 0
+}
+
+func (m *Params) GetDepositBurnRatio() string {
+	if m != nil {
+		return m.DepositBurnRatio
 	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x52
+	return ""
+}
+
+func (m *Params) GetLineItemVeto() *LineItemVetoParams {
+	if m != nil {
+		return m.LineItemVeto
 	}
-	if m.VotingEndTime != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
-		if err1 != nil {
-			return 0, err1
-		}
-		i -= n1
-		i = encodeVarintGov(dAtA, i, uint64(n1))
-		i--
-		dAtA[i] = 0x4a
+	return nil
+}
+
+func (m *Params) GetExecutionGasPerBlock() uint64 {
+	if m != nil {
+		return m.ExecutionGasPerBlock
 	}
-	if m.VotingStartTime != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
-		if err2 != nil {
-			return 0, err2
-		}
-		i -= n2
-		i = encodeVarintGov(dAtA, i, uint64(n2))
-		i--
-		dAtA[i] = 0x42
+	return 0
+}
+
+func (m *Params) GetWhaleAlert() *WhaleAlertParams {
+	if m != nil {
+		return m.WhaleAlert
 	}
-	if len(m.TotalDeposit) > 0 {
-		for iNdEx := len(m.TotalDeposit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
+	return nil
+}
+
+// WhaleAlertParams defines the thresholds above which a single vote or deposit is reported as a
+// governance whale, with an EventWhaleVote or EventWhaleDeposit event.
+//
+// Since: x/gov v1.0.0
+type WhaleAlertParams struct {
+	// vote_threshold is the share of the total bonded tokens above which the voting power of a single
+	// vote is reported. Empty or zero disables the whale vote alerts.
+	VoteThreshold string `protobuf:"bytes,1,opt,name=vote_threshold,json=voteThreshold,proto3" json:"vote_threshold,omitempty"`
+	// deposit_threshold is the share of the minimum deposit of the proposal above which a single
+	// deposit is reported. Empty or zero disables the whale deposit alerts.
+	DepositThreshold string `protobuf:"bytes,2,opt,name=deposit_threshold,json=depositThreshold,proto3" json:"deposit_threshold,omitempty"`
+}
+
+func (m *WhaleAlertParams) Reset()         { *m = WhaleAlertParams{} }
+func (m *WhaleAlertParams) String() string { return proto.CompactTextString(m) }
+func (*WhaleAlertParams) ProtoMessage()    {}
+func (*WhaleAlertParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{14}
+}
+func (m *WhaleAlertParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WhaleAlertParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WhaleAlertParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.DepositEndTime != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
-		if err3 != nil {
-			return 0, err3
-		}
-		i -= n3
-		i = encodeVarintGov(dAtA, i, uint64(n3))
-		i--
-		dAtA[i] = 0x32
+}
+func (m *WhaleAlertParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WhaleAlertParams.Merge(m, src)
+}
+func (m *WhaleAlertParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *WhaleAlertParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_WhaleAlertParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WhaleAlertParams proto.InternalMessageInfo
+
+func (m *WhaleAlertParams) GetVoteThreshold() string {
+	if m != nil {
+		return m.VoteThreshold
 	}
-	if m.SubmitTime != nil {
-		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
-		if err4 != nil {
-			return 0, err4
-		}
-		i -= n4
-		i = encodeVarintGov(dAtA, i, uint64(n4))
-		i--
-		dAtA[i] = 0x2a
+	return ""
+}
+
+func (m *WhaleAlertParams) GetDepositThreshold() string {
+	if m != nil {
+		return m.DepositThreshold
 	}
-	if m.FinalTallyResult != nil {
-		{
-			size, err := m.FinalTallyResult.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGov(dAtA, i, uint64(size))
+	return ""
+}
+
+// WhaleAlerts defines the aggregated whale alerts of a proposal.
+//
+// Since: x/gov v1.0.0
+type WhaleAlerts struct {
+	// proposal_id is the id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// vote_alerts is the number of whale votes cast on the proposal. A whale changing its vote is
+	// counted again.
+	VoteAlerts uint64 `protobuf:"varint,2,opt,name=vote_alerts,json=voteAlerts,proto3" json:"vote_alerts,omitempty"`
+	// deposit_alerts is the number of whale deposits made on the proposal.
+	DepositAlerts uint64 `protobuf:"varint,3,opt,name=deposit_alerts,json=depositAlerts,proto3" json:"deposit_alerts,omitempty"`
+	// whale_deposits is the total amount deposited by the whale deposits.
+	WhaleDeposits []types.Coin `protobuf:"bytes,4,rep,name=whale_deposits,json=whaleDeposits,proto3" json:"whale_deposits"`
+}
+
+func (m *WhaleAlerts) Reset()         { *m = WhaleAlerts{} }
+func (m *WhaleAlerts) String() string { return proto.CompactTextString(m) }
+func (*WhaleAlerts) ProtoMessage()    {}
+func (*WhaleAlerts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{15}
+}
+func (m *WhaleAlerts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WhaleAlerts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WhaleAlerts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Status != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if len(m.Messages) > 0 {
-		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+}
+func (m *WhaleAlerts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WhaleAlerts.Merge(m, src)
+}
+func (m *WhaleAlerts) XXX_Size() int {
+	return m.Size()
+}
+func (m *WhaleAlerts) XXX_DiscardUnknown() {
+	xxx_messageInfo_WhaleAlerts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WhaleAlerts proto.InternalMessageInfo
+
+func (m *WhaleAlerts) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	if m.Id != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *WhaleAlerts) GetVoteAlerts() uint64 {
+	if m != nil {
+		return m.VoteAlerts
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ProposalVoteOptions) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *WhaleAlerts) GetDepositAlerts() uint64 {
+	if m != nil {
+		return m.DepositAlerts
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ProposalVoteOptions) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *WhaleAlerts) GetWhaleDeposits() []types.Coin {
+	if m != nil {
+		return m.WhaleDeposits
+	}
+	return nil
 }
 
-func (m *ProposalVoteOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OptionSpam) > 0 {
-		i -= len(m.OptionSpam)
-		copy(dAtA[i:], m.OptionSpam)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionSpam)))
-		i--
-		dAtA[i] = 0x2a
+// LineItemVetoParams defines the parameters of the line-item veto.
+// When enabled, a passed proposal with more than one message is executed at the end of a timelock,
+// during which the line-item veto authority can strike some of its messages. The remaining messages
+// are executed normally.
+//
+// Since: x/gov v1.0.0
+type LineItemVetoParams struct {
+	// authority is the address allowed to strike messages of timelocked proposals.
+	// When empty, the line-item veto is disabled.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Duration of the timelock between the end of the voting period and the execution of a passed
+	// multi-message proposal.
+	Timelock *time.Duration `protobuf:"bytes,2,opt,name=timelock,proto3,stdduration" json:"timelock,omitempty"`
+}
+
+func (m *LineItemVetoParams) Reset()         { *m = LineItemVetoParams{} }
+func (m *LineItemVetoParams) String() string { return proto.CompactTextString(m) }
+func (*LineItemVetoParams) ProtoMessage()    {}
+func (*LineItemVetoParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{16}
+}
+func (m *LineItemVetoParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LineItemVetoParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LineItemVetoParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.OptionFour) > 0 {
-		i -= len(m.OptionFour)
-		copy(dAtA[i:], m.OptionFour)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionFour)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *LineItemVetoParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LineItemVetoParams.Merge(m, src)
+}
+func (m *LineItemVetoParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *LineItemVetoParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_LineItemVetoParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LineItemVetoParams proto.InternalMessageInfo
+
+func (m *LineItemVetoParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if len(m.OptionThree) > 0 {
-		i -= len(m.OptionThree)
-		copy(dAtA[i:], m.OptionThree)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionThree)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *LineItemVetoParams) GetTimelock() *time.Duration {
+	if m != nil {
+		return m.Timelock
 	}
-	if len(m.OptionTwo) > 0 {
-		i -= len(m.OptionTwo)
-		copy(dAtA[i:], m.OptionTwo)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionTwo)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+// LineItemVeto defines a message of a proposal struck by the line-item veto authority.
+//
+// Since: x/gov v1.0.0
+type LineItemVeto struct {
+	// proposal_id is the id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// message_index is the index of the struck message in the proposal messages.
+	MessageIndex uint32 `protobuf:"varint,2,opt,name=message_index,json=messageIndex,proto3" json:"message_index,omitempty"`
+	// msg_type_url is the type url of the struck message.
+	MsgTypeUrl string `protobuf:"bytes,3,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// authority is the address of the line-item veto authority that struck the message.
+	Authority string `protobuf:"bytes,4,opt,name=authority,proto3" json:"authority,omitempty"`
+	// justification is the reason given by the authority for striking the message.
+	Justification string `protobuf:"bytes,5,opt,name=justification,proto3" json:"justification,omitempty"`
+	// vetoed_at is the time at which the message was struck.
+	VetoedAt time.Time `protobuf:"bytes,6,opt,name=vetoed_at,json=vetoedAt,proto3,stdtime" json:"vetoed_at"`
+}
+
+func (m *LineItemVeto) Reset()         { *m = LineItemVeto{} }
+func (m *LineItemVeto) String() string { return proto.CompactTextString(m) }
+func (*LineItemVeto) ProtoMessage()    {}
+func (*LineItemVeto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{17}
+}
+func (m *LineItemVeto) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LineItemVeto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LineItemVeto.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.OptionOne) > 0 {
-		i -= len(m.OptionOne)
-		copy(dAtA[i:], m.OptionOne)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionOne)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *LineItemVeto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LineItemVeto.Merge(m, src)
+}
+func (m *LineItemVeto) XXX_Size() int {
+	return m.Size()
+}
+func (m *LineItemVeto) XXX_DiscardUnknown() {
+	xxx_messageInfo_LineItemVeto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LineItemVeto proto.InternalMessageInfo
+
+func (m *LineItemVeto) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *TallyResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *LineItemVeto) GetMessageIndex() uint32 {
+	if m != nil {
+		return m.MessageIndex
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *TallyResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *LineItemVeto) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
 }
 
-func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SpamCount) > 0 {
-		i -= len(m.SpamCount)
-		copy(dAtA[i:], m.SpamCount)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.SpamCount)))
-		i--
-		dAtA[i] = 0x4a
+func (m *LineItemVeto) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if len(m.OptionFourCount) > 0 {
-		i -= len(m.OptionFourCount)
-		copy(dAtA[i:], m.OptionFourCount)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionFourCount)))
-		i--
-		dAtA[i] = 0x42
+	return ""
+}
+
+func (m *LineItemVeto) GetJustification() string {
+	if m != nil {
+		return m.Justification
 	}
-	if len(m.OptionThreeCount) > 0 {
-		i -= len(m.OptionThreeCount)
-		copy(dAtA[i:], m.OptionThreeCount)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionThreeCount)))
-		i--
-		dAtA[i] = 0x3a
+	return ""
+}
+
+func (m *LineItemVeto) GetVetoedAt() time.Time {
+	if m != nil {
+		return m.VetoedAt
 	}
-	if len(m.OptionTwoCount) > 0 {
-		i -= len(m.OptionTwoCount)
-		copy(dAtA[i:], m.OptionTwoCount)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionTwoCount)))
-		i--
-		dAtA[i] = 0x32
+	return time.Time{}
+}
+
+// ScheduleEntry defines an upcoming governance event.
+//
+// Since: x/gov v1.0.0
+type ScheduleEntry struct {
+	// time is the time at which the event happens.
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	// proposal_id is the id of the proposal concerned by the event.
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// event_type is the kind of the event.
+	EventType ScheduleEventType `protobuf:"varint,3,opt,name=event_type,json=eventType,proto3,enum=cosmos.gov.v1.ScheduleEventType" json:"event_type,omitempty"`
+}
+
+func (m *ScheduleEntry) Reset()         { *m = ScheduleEntry{} }
+func (m *ScheduleEntry) String() string { return proto.CompactTextString(m) }
+func (*ScheduleEntry) ProtoMessage()    {}
+func (*ScheduleEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{18}
+}
+func (m *ScheduleEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.OptionOneCount) > 0 {
-		i -= len(m.OptionOneCount)
-		copy(dAtA[i:], m.OptionOneCount)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionOneCount)))
-		i--
-		dAtA[i] = 0x2a
+}
+func (m *ScheduleEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleEntry.Merge(m, src)
+}
+func (m *ScheduleEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleEntry proto.InternalMessageInfo
+
+func (m *ScheduleEntry) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	if len(m.NoWithVetoCount) > 0 {
-		i -= len(m.NoWithVetoCount)
-		copy(dAtA[i:], m.NoWithVetoCount)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.NoWithVetoCount)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.NoCount) > 0 {
-		i -= len(m.NoCount)
-		copy(dAtA[i:], m.NoCount)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.NoCount)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.AbstainCount) > 0 {
-		i -= len(m.AbstainCount)
-		copy(dAtA[i:], m.AbstainCount)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.AbstainCount)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.YesCount) > 0 {
-		i -= len(m.YesCount)
-		copy(dAtA[i:], m.YesCount)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.YesCount)))
-		i--
-		dAtA[i] = 0xa
+	return time.Time{}
+}
+
+func (m *ScheduleEntry) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Vote) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ScheduleEntry) GetEventType() ScheduleEventType {
+	if m != nil {
+		return m.EventType
 	}
-	return dAtA[:n], nil
+	return ScheduleEventType_SCHEDULE_EVENT_TYPE_UNSPECIFIED
 }
 
-func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SecurityCouncilParams defines the parameters of the security council proposal track.
+// Security council proposals are meant for emergency actions (e.g. halts or parameter rollbacks).
+// They skip the deposit period and are decided by the vote of the security council group policy.
+//
+// Since: x/gov v1.0.0
+type SecurityCouncilParams struct {
+	// policy_address is the x/group policy address of the security council.
+	// When empty, the security council proposal track is disabled.
+	PolicyAddress string `protobuf:"bytes,1,opt,name=policy_address,json=policyAddress,proto3" json:"policy_address,omitempty"`
+	// Duration of the voting period of security council proposals.
+	VotingPeriod *time.Duration `protobuf:"bytes,2,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
 }
 
-func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Options) > 0 {
-		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+func (m *SecurityCouncilParams) Reset()         { *m = SecurityCouncilParams{} }
+func (m *SecurityCouncilParams) String() string { return proto.CompactTextString(m) }
+func (*SecurityCouncilParams) ProtoMessage()    {}
+func (*SecurityCouncilParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{19}
+}
+func (m *SecurityCouncilParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecurityCouncilParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SecurityCouncilParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ProposalId != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *DepositParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *SecurityCouncilParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecurityCouncilParams.Merge(m, src)
 }
-
-func (m *DepositParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SecurityCouncilParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecurityCouncilParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecurityCouncilParams.DiscardUnknown(m)
 }
 
-func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxDepositPeriod != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err6 != nil {
-			return 0, err6
-		}
-		i -= n6
-		i = encodeVarintGov(dAtA, i, uint64(n6))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.MinDeposit) > 0 {
-		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_SecurityCouncilParams proto.InternalMessageInfo
+
+func (m *SecurityCouncilParams) GetPolicyAddress() string {
+	if m != nil {
+		return m.PolicyAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *VotingParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SecurityCouncilParams) GetVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.VotingPeriod
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *VotingParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EmergencyParams defines the parameters of emergency proposals.
+// Emergency proposals are meant for time-critical fixes (e.g. halting a compromised channel).
+// Only bonded validators can vote on them, and they require a supermajority of the total bonded stake to pass.
+//
+// Since: x/gov v1.0.0
+type EmergencyParams struct {
+	// allowed_messages is the list of message type URLs an emergency proposal can contain.
+	// When empty, emergency proposals are disabled.
+	AllowedMessages []string `protobuf:"bytes,1,rep,name=allowed_messages,json=allowedMessages,proto3" json:"allowed_messages,omitempty"`
+	// Duration of the voting period of emergency proposals.
+	VotingPeriod *time.Duration `protobuf:"bytes,2,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
+	// Minimum proportion of the total bonded stake of validators voting Yes for an emergency proposal to pass.
+	// Default value: 0.667.
+	Threshold string `protobuf:"bytes,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
 }
 
-func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VotingPeriod != nil {
-		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err7 != nil {
-			return 0, err7
+func (m *EmergencyParams) Reset()         { *m = EmergencyParams{} }
+func (m *EmergencyParams) String() string { return proto.CompactTextString(m) }
+func (*EmergencyParams) ProtoMessage()    {}
+func (*EmergencyParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{20}
+}
+func (m *EmergencyParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmergencyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmergencyParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n7
-		i = encodeVarintGov(dAtA, i, uint64(n7))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *TallyParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EmergencyParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmergencyParams.Merge(m, src)
 }
-
-func (m *TallyParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EmergencyParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmergencyParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmergencyParams.DiscardUnknown(m)
 }
 
-func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VetoThreshold) > 0 {
-		i -= len(m.VetoThreshold)
-		copy(dAtA[i:], m.VetoThreshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoThreshold)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Threshold) > 0 {
-		i -= len(m.Threshold)
-		copy(dAtA[i:], m.Threshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_EmergencyParams proto.InternalMessageInfo
+
+func (m *EmergencyParams) GetAllowedMessages() []string {
+	if m != nil {
+		return m.AllowedMessages
 	}
-	if len(m.Quorum) > 0 {
-		i -= len(m.Quorum)
-		copy(dAtA[i:], m.Quorum)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *EmergencyParams) GetVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.VotingPeriod
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EmergencyParams) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MessageBasedParams defines the parameters of specific messages in a proposal.
+// It is used to define the parameters of a proposal that is based on a specific message.
+// Once a message has message based params, it only supports a standard proposal type.
+//
+// Since: x/gov v1.0.0
+type MessageBasedParams struct {
+	// Duration of the voting period.
+	VotingPeriod *time.Duration `protobuf:"bytes,1,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
+	// Minimum percentage of total stake needed to vote for a result to be considered valid.
+	Quorum string `protobuf:"bytes,2,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// yes_quorum defines the minimum percentage of Yes votes in quorum for proposal to pass.
+	// If zero then the yes_quorum is disabled.
+	YesQuorum string `protobuf:"bytes,20,opt,name=yes_quorum,json=yesQuorum,proto3" json:"yes_quorum,omitempty"`
+	// Minimum proportion of Yes votes for proposal to pass.
+	Threshold string `protobuf:"bytes,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// Minimum value of Veto votes to Total votes ratio for proposal to be vetoed.
+	VetoThreshold string `protobuf:"bytes,4,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.YesQuorum) > 0 {
-		i -= len(m.YesQuorum)
-		copy(dAtA[i:], m.YesQuorum)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.YesQuorum)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
+func (m *MessageBasedParams) Reset()         { *m = MessageBasedParams{} }
+func (m *MessageBasedParams) String() string { return proto.CompactTextString(m) }
+func (*MessageBasedParams) ProtoMessage()    {}
+func (*MessageBasedParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{21}
+}
+func (m *MessageBasedParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageBasedParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageBasedParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.OptimisticRejectedThreshold) > 0 {
-		i -= len(m.OptimisticRejectedThreshold)
-		copy(dAtA[i:], m.OptimisticRejectedThreshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.OptimisticRejectedThreshold)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x9a
+}
+func (m *MessageBasedParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageBasedParams.Merge(m, src)
+}
+func (m *MessageBasedParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageBasedParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageBasedParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageBasedParams proto.InternalMessageInfo
+
+func (m *MessageBasedParams) GetVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.VotingPeriod
 	}
-	if len(m.OptimisticAuthorizedAddresses) > 0 {
-		for iNdEx := len(m.OptimisticAuthorizedAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.OptimisticAuthorizedAddresses[iNdEx])
-			copy(dAtA[i:], m.OptimisticAuthorizedAddresses[iNdEx])
-			i = encodeVarintGov(dAtA, i, uint64(len(m.OptimisticAuthorizedAddresses[iNdEx])))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x92
-		}
+	return nil
+}
+
+func (m *MessageBasedParams) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
 	}
-	if len(m.ProposalCancelMaxPeriod) > 0 {
-		i -= len(m.ProposalCancelMaxPeriod)
-		copy(dAtA[i:], m.ProposalCancelMaxPeriod)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelMaxPeriod)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
+	return ""
+}
+
+func (m *MessageBasedParams) GetYesQuorum() string {
+	if m != nil {
+		return m.YesQuorum
 	}
-	if len(m.MinDepositRatio) > 0 {
-		i -= len(m.MinDepositRatio)
-		copy(dAtA[i:], m.MinDepositRatio)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.MinDepositRatio)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+	return ""
+}
+
+func (m *MessageBasedParams) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
 	}
-	if m.BurnVoteVeto {
-		i--
-		if m.BurnVoteVeto {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x78
+	return ""
+}
+
+func (m *MessageBasedParams) GetVetoThreshold() string {
+	if m != nil {
+		return m.VetoThreshold
 	}
-	if m.BurnProposalDepositPrevote {
-		i--
-		if m.BurnProposalDepositPrevote {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return ""
+}
+
+// ProposalExecution defines the progress of the chunked execution of a passed proposal.
+//
+// Since: x/gov v1.0.0
+type ProposalExecution struct {
+	// proposal_id is the id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// next_message_index is the index of the next proposal message to execute.
+	NextMessageIndex uint32 `protobuf:"varint,2,opt,name=next_message_index,json=nextMessageIndex,proto3" json:"next_message_index,omitempty"`
+	// gas_used is the gas consumed by the messages executed so far.
+	GasUsed uint64 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// start_height is the height the execution started at.
+	StartHeight int64 `protobuf:"varint,4,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+}
+
+func (m *ProposalExecution) Reset()         { *m = ProposalExecution{} }
+func (m *ProposalExecution) String() string { return proto.CompactTextString(m) }
+func (*ProposalExecution) ProtoMessage()    {}
+func (*ProposalExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{22}
+}
+func (m *ProposalExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x70
+		return b[:n], nil
 	}
-	if m.BurnVoteQuorum {
-		i--
-		if m.BurnVoteQuorum {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x68
+}
+func (m *ProposalExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalExecution.Merge(m, src)
+}
+func (m *ProposalExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalExecution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalExecution proto.InternalMessageInfo
+
+func (m *ProposalExecution) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	if len(m.ExpeditedMinDeposit) > 0 {
-		for iNdEx := len(m.ExpeditedMinDeposit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ExpeditedMinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x62
-		}
+	return 0
+}
+
+func (m *ProposalExecution) GetNextMessageIndex() uint32 {
+	if m != nil {
+		return m.NextMessageIndex
 	}
-	if len(m.ExpeditedThreshold) > 0 {
-		i -= len(m.ExpeditedThreshold)
-		copy(dAtA[i:], m.ExpeditedThreshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.ExpeditedThreshold)))
-		i--
-		dAtA[i] = 0x5a
+	return 0
+}
+
+func (m *ProposalExecution) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
 	}
-	if m.ExpeditedVotingPeriod != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
-		if err8 != nil {
-			return 0, err8
-		}
-		i -= n8
-		i = encodeVarintGov(dAtA, i, uint64(n8))
-		i--
-		dAtA[i] = 0x52
+	return 0
+}
+
+func (m *ProposalExecution) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
 	}
-	if len(m.ProposalCancelDest) > 0 {
-		i -= len(m.ProposalCancelDest)
-		copy(dAtA[i:], m.ProposalCancelDest)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelDest)))
-		i--
-		dAtA[i] = 0x4a
+	return 0
+}
+
+// ProposalDraft defines a proposal iterated on by its owner before its submission. Drafts are not
+// voted on: each update of a draft records a new immutable ProposalDraftVersion, and the latest
+// version is submitted as a proposal once the draft is promoted.
+//
+// Since: x/gov v1.0.0
+type ProposalDraft struct {
+	// id defines the unique id of the draft.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address of the account allowed to update and promote the draft.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// latest_version is the number of the latest version of the draft, starting at 1.
+	LatestVersion uint64 `protobuf:"varint,3,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	// created_at is the time at which the draft was created.
+	CreatedAt time.Time `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	// proposal_id is the id of the proposal the draft was promoted to, 0 while it is not promoted.
+	// A promoted draft cannot be updated anymore.
+	ProposalId uint64 `protobuf:"varint,5,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *ProposalDraft) Reset()         { *m = ProposalDraft{} }
+func (m *ProposalDraft) String() string { return proto.CompactTextString(m) }
+func (*ProposalDraft) ProtoMessage()    {}
+func (*ProposalDraft) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{23}
+}
+func (m *ProposalDraft) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalDraft) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalDraft.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ProposalCancelRatio) > 0 {
-		i -= len(m.ProposalCancelRatio)
-		copy(dAtA[i:], m.ProposalCancelRatio)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelRatio)))
-		i--
-		dAtA[i] = 0x42
+}
+func (m *ProposalDraft) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalDraft.Merge(m, src)
+}
+func (m *ProposalDraft) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalDraft) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalDraft.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalDraft proto.InternalMessageInfo
+
+func (m *ProposalDraft) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.MinInitialDepositRatio) > 0 {
-		i -= len(m.MinInitialDepositRatio)
-		copy(dAtA[i:], m.MinInitialDepositRatio)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.MinInitialDepositRatio)))
-		i--
-		dAtA[i] = 0x3a
+	return 0
+}
+
+func (m *ProposalDraft) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	if len(m.VetoThreshold) > 0 {
-		i -= len(m.VetoThreshold)
-		copy(dAtA[i:], m.VetoThreshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoThreshold)))
-		i--
-		dAtA[i] = 0x32
+	return ""
+}
+
+func (m *ProposalDraft) GetLatestVersion() uint64 {
+	if m != nil {
+		return m.LatestVersion
 	}
-	if len(m.Threshold) > 0 {
-		i -= len(m.Threshold)
-		copy(dAtA[i:], m.Threshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
-		i--
-		dAtA[i] = 0x2a
+	return 0
+}
+
+func (m *ProposalDraft) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
 	}
-	if len(m.Quorum) > 0 {
-		i -= len(m.Quorum)
-		copy(dAtA[i:], m.Quorum)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
-		i--
-		dAtA[i] = 0x22
+	return time.Time{}
+}
+
+func (m *ProposalDraft) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	if m.VotingPeriod != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err9 != nil {
-			return 0, err9
+	return 0
+}
+
+// ProposalDraftVersion defines the content of a version of a proposal draft.
+//
+// Since: x/gov v1.0.0
+type ProposalDraftVersion struct {
+	// draft_id is the id of the draft.
+	DraftId uint64 `protobuf:"varint,1,opt,name=draft_id,json=draftId,proto3" json:"draft_id,omitempty"`
+	// version is the number of the version.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// messages are the arbitrary messages to be executed if the promoted proposal passes.
+	Messages []*types1.Any `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages,omitempty"`
+	// metadata is any arbitrary metadata attached to the proposal.
+	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// title is the title of the proposal.
+	Title string `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	// summary is the summary of the proposal.
+	Summary string `protobuf:"bytes,6,opt,name=summary,proto3" json:"summary,omitempty"`
+	// proposal_type defines the type of the proposal.
+	ProposalType ProposalType `protobuf:"varint,7,opt,name=proposal_type,json=proposalType,proto3,enum=cosmos.gov.v1.ProposalType" json:"proposal_type,omitempty"`
+	// created_at is the time at which the version was recorded.
+	CreatedAt time.Time `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+}
+
+func (m *ProposalDraftVersion) Reset()         { *m = ProposalDraftVersion{} }
+func (m *ProposalDraftVersion) String() string { return proto.CompactTextString(m) }
+func (*ProposalDraftVersion) ProtoMessage()    {}
+func (*ProposalDraftVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{24}
+}
+func (m *ProposalDraftVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalDraftVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalDraftVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n9
-		i = encodeVarintGov(dAtA, i, uint64(n9))
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if m.MaxDepositPeriod != nil {
-		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err10 != nil {
-			return 0, err10
-		}
-		i -= n10
-		i = encodeVarintGov(dAtA, i, uint64(n10))
+}
+func (m *ProposalDraftVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalDraftVersion.Merge(m, src)
+}
+func (m *ProposalDraftVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalDraftVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalDraftVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalDraftVersion proto.InternalMessageInfo
+
+func (m *ProposalDraftVersion) GetDraftId() uint64 {
+	if m != nil {
+		return m.DraftId
+	}
+	return 0
+}
+
+func (m *ProposalDraftVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ProposalDraftVersion) GetMessages() []*types1.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func (m *ProposalDraftVersion) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+func (m *ProposalDraftVersion) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ProposalDraftVersion) GetSummary() string {
+	if m != nil {
+		return m.Summary
+	}
+	return ""
+}
+
+func (m *ProposalDraftVersion) GetProposalType() ProposalType {
+	if m != nil {
+		return m.ProposalType
+	}
+	return ProposalType_PROPOSAL_TYPE_UNSPECIFIED
+}
+
+func (m *ProposalDraftVersion) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.gov.v1.ProposalType", ProposalType_name, ProposalType_value)
+	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
+	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
+	proto.RegisterEnum("cosmos.gov.v1.ScheduleEventType", ScheduleEventType_name, ScheduleEventType_value)
+	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.v1.WeightedVoteOption")
+	proto.RegisterType((*Deposit)(nil), "cosmos.gov.v1.Deposit")
+	proto.RegisterType((*Proposal)(nil), "cosmos.gov.v1.Proposal")
+	proto.RegisterType((*ProposalVoteOptions)(nil), "cosmos.gov.v1.ProposalVoteOptions")
+	proto.RegisterType((*TallyResult)(nil), "cosmos.gov.v1.TallyResult")
+	proto.RegisterType((*RankedChoiceTally)(nil), "cosmos.gov.v1.RankedChoiceTally")
+	proto.RegisterType((*RankedChoiceRound)(nil), "cosmos.gov.v1.RankedChoiceRound")
+	proto.RegisterType((*ArchivedProposal)(nil), "cosmos.gov.v1.ArchivedProposal")
+	proto.RegisterType((*DepositRecord)(nil), "cosmos.gov.v1.DepositRecord")
+	proto.RegisterType((*Vote)(nil), "cosmos.gov.v1.Vote")
+	proto.RegisterType((*DepositParams)(nil), "cosmos.gov.v1.DepositParams")
+	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1.VotingParams")
+	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
+	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
+	proto.RegisterType((*WhaleAlertParams)(nil), "cosmos.gov.v1.WhaleAlertParams")
+	proto.RegisterType((*WhaleAlerts)(nil), "cosmos.gov.v1.WhaleAlerts")
+	proto.RegisterType((*LineItemVetoParams)(nil), "cosmos.gov.v1.LineItemVetoParams")
+	proto.RegisterType((*LineItemVeto)(nil), "cosmos.gov.v1.LineItemVeto")
+	proto.RegisterType((*ScheduleEntry)(nil), "cosmos.gov.v1.ScheduleEntry")
+	proto.RegisterType((*SecurityCouncilParams)(nil), "cosmos.gov.v1.SecurityCouncilParams")
+	proto.RegisterType((*EmergencyParams)(nil), "cosmos.gov.v1.EmergencyParams")
+	proto.RegisterType((*MessageBasedParams)(nil), "cosmos.gov.v1.MessageBasedParams")
+	proto.RegisterType((*ProposalExecution)(nil), "cosmos.gov.v1.ProposalExecution")
+	proto.RegisterType((*ProposalDraft)(nil), "cosmos.gov.v1.ProposalDraft")
+	proto.RegisterType((*ProposalDraftVersion)(nil), "cosmos.gov.v1.ProposalDraftVersion")
+}
+
+func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
+
+var fileDescriptor_e05cb1c0d030febb = []byte{
+	// 2986 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcf, 0x73, 0x23, 0x47,
+	0xf5, 0xdf, 0xd1, 0x2f, 0x4b, 0x4f, 0x3f, 0x3c, 0x6e, 0x7b, 0xe3, 0xb1, 0x1d, 0xff, 0x88, 0xb2,
+	0xdf, 0x7c, 0x97, 0x4d, 0xd6, 0x66, 0x13, 0x96, 0x0a, 0xc9, 0x42, 0x22, 0x4b, 0xb3, 0x6b, 0x6d,
+	0x6c, 0x4b, 0x8c, 0x64, 0xef, 0x2e, 0x97, 0x61, 0xac, 0x69, 0x4b, 0x93, 0x48, 0x33, 0x62, 0xa6,
+	0x65, 0x5b, 0x9c, 0x38, 0x51, 0x45, 0x51, 0x45, 0xe5, 0x14, 0x38, 0x51, 0x1c, 0x38, 0x50, 0x45,
+	0x15, 0x95, 0x43, 0x0e, 0x1c, 0xb8, 0xe5, 0x92, 0x63, 0x2a, 0x27, 0x0e, 0x10, 0xa8, 0xe4, 0x00,
+	0x15, 0xaa, 0xf8, 0x03, 0x38, 0x51, 0xfd, 0x63, 0x34, 0xa3, 0x1f, 0x5e, 0xcb, 0xde, 0x70, 0xd9,
+	0xd5, 0xbc, 0xfe, 0x7c, 0x5e, 0x77, 0xbf, 0xf7, 0xfa, 0xf5, 0xeb, 0x6e, 0xc3, 0x62, 0xc3, 0xf1,
+	0x3a, 0x8e, 0xb7, 0xd5, 0x74, 0x4e, 0xb6, 0x4e, 0xee, 0xd0, 0xff, 0x36, 0xbb, 0xae, 0x43, 0x1c,
+	0x94, 0xe5, 0x0d, 0x9b, 0x54, 0x72, 0x72, 0x67, 0x79, 0x4d, 0xe0, 0x8e, 0x0c, 0x0f, 0x6f, 0x9d,
+	0xdc, 0x39, 0xc2, 0xc4, 0xb8, 0xb3, 0xd5, 0x70, 0x2c, 0x9b, 0xc3, 0x97, 0x17, 0x9a, 0x4e, 0xd3,
+	0x61, 0x3f, 0xb7, 0xe8, 0x2f, 0x21, 0x5d, 0x6f, 0x3a, 0x4e, 0xb3, 0x8d, 0xb7, 0xd8, 0xd7, 0x51,
+	0xef, 0x78, 0x8b, 0x58, 0x1d, 0xec, 0x11, 0xa3, 0xd3, 0x15, 0x80, 0xa5, 0x51, 0x80, 0x61, 0xf7,
+	0x45, 0xd3, 0xda, 0x68, 0x93, 0xd9, 0x73, 0x0d, 0x62, 0x39, 0x7e, 0x8f, 0x4b, 0x7c, 0x44, 0x3a,
+	0xef, 0x54, 0x8c, 0x96, 0x37, 0xcd, 0x19, 0x1d, 0xcb, 0x76, 0xb6, 0xd8, 0xbf, 0x5c, 0x94, 0x77,
+	0x00, 0x3d, 0xc2, 0x56, 0xb3, 0x45, 0xb0, 0x79, 0xe8, 0x10, 0x5c, 0xe9, 0x52, 0x4d, 0xe8, 0x0e,
+	0x24, 0x1c, 0xf6, 0x4b, 0x91, 0x36, 0xa4, 0x9b, 0xb9, 0x57, 0x97, 0x36, 0x87, 0x66, 0xbd, 0x19,
+	0x40, 0x35, 0x01, 0x44, 0x2f, 0x41, 0xe2, 0x94, 0x29, 0x52, 0x22, 0x1b, 0xd2, 0xcd, 0xd4, 0x76,
+	0xee, 0xb3, 0x8f, 0x6e, 0x83, 0x60, 0x95, 0x70, 0x43, 0x13, 0xad, 0xf9, 0xdf, 0x48, 0x30, 0x53,
+	0xc2, 0x5d, 0xc7, 0xb3, 0x08, 0x5a, 0x87, 0x74, 0xd7, 0x75, 0xba, 0x8e, 0x67, 0xb4, 0x75, 0xcb,
+	0x64, 0x7d, 0xc5, 0x34, 0xf0, 0x45, 0x65, 0x13, 0x7d, 0x1b, 0x52, 0x26, 0xc7, 0x3a, 0xae, 0xd0,
+	0xab, 0x7c, 0xf6, 0xd1, 0xed, 0x05, 0xa1, 0xb7, 0x60, 0x9a, 0x2e, 0xf6, 0xbc, 0x1a, 0x71, 0x2d,
+	0xbb, 0xa9, 0x05, 0x50, 0x74, 0x0f, 0x12, 0x46, 0xc7, 0xe9, 0xd9, 0x44, 0x89, 0x6e, 0x44, 0x6f,
+	0xa6, 0x83, 0xf1, 0x53, 0x37, 0x6d, 0x0a, 0x37, 0x6d, 0x16, 0x1d, 0xcb, 0xde, 0x4e, 0x7d, 0xf2,
+	0xf9, 0xfa, 0xb5, 0xdf, 0xfd, 0xe3, 0xc3, 0x5b, 0x92, 0x26, 0x38, 0xf9, 0x0f, 0x92, 0x90, 0xac,
+	0x8a, 0x41, 0xa0, 0x1c, 0x44, 0x06, 0x43, 0x8b, 0x58, 0x26, 0xfa, 0x26, 0x24, 0x3b, 0xd8, 0xf3,
+	0x8c, 0x26, 0xf6, 0x94, 0x08, 0x53, 0xbe, 0xb0, 0xc9, 0x3d, 0xb2, 0xe9, 0x7b, 0x64, 0xb3, 0x60,
+	0xf7, 0xb5, 0x01, 0x0a, 0xdd, 0x85, 0x84, 0x47, 0x0c, 0xd2, 0xf3, 0x94, 0x28, 0x33, 0xe6, 0xea,
+	0x88, 0x31, 0xfd, 0xae, 0x6a, 0x0c, 0xa4, 0x09, 0x30, 0xda, 0x01, 0x74, 0x6c, 0xd9, 0x46, 0x5b,
+	0x27, 0x46, 0xbb, 0xdd, 0xd7, 0x5d, 0xec, 0xf5, 0xda, 0x44, 0x89, 0x6d, 0x48, 0x37, 0xd3, 0xaf,
+	0x2e, 0x8f, 0xa8, 0xa8, 0x53, 0x88, 0xc6, 0x10, 0x9a, 0xcc, 0x58, 0x21, 0x09, 0x2a, 0x40, 0xda,
+	0xeb, 0x1d, 0x75, 0x2c, 0xa2, 0xd3, 0x30, 0x53, 0xe2, 0x42, 0xc5, 0xe8, 0xa8, 0xeb, 0x7e, 0x0c,
+	0x6e, 0xc7, 0xde, 0xff, 0xdb, 0xba, 0xa4, 0x01, 0x27, 0x51, 0x31, 0x7a, 0x08, 0xb2, 0xb0, 0xae,
+	0x8e, 0x6d, 0x93, 0xeb, 0x49, 0x4c, 0xa9, 0x27, 0x27, 0x98, 0xaa, 0x6d, 0x32, 0x5d, 0x65, 0xc8,
+	0x12, 0x87, 0x18, 0x6d, 0x5d, 0xc8, 0x95, 0x99, 0x4b, 0xf8, 0x28, 0xc3, 0xa8, 0x7e, 0x00, 0xed,
+	0xc2, 0xdc, 0x89, 0x43, 0x2c, 0xbb, 0xa9, 0x7b, 0xc4, 0x70, 0xc5, 0xfc, 0x92, 0x53, 0x8e, 0x6b,
+	0x96, 0x53, 0x6b, 0x94, 0xc9, 0x06, 0xb6, 0x03, 0x42, 0x14, 0xcc, 0x31, 0x35, 0xa5, 0xae, 0x2c,
+	0x27, 0xfa, 0x53, 0x5c, 0xa6, 0x41, 0x42, 0x0c, 0xd3, 0x20, 0x86, 0x02, 0x34, 0x6c, 0xb5, 0xc1,
+	0x37, 0x5a, 0x80, 0x38, 0xb1, 0x48, 0x1b, 0x2b, 0x69, 0xd6, 0xc0, 0x3f, 0x90, 0x02, 0x33, 0x5e,
+	0xaf, 0xd3, 0x31, 0xdc, 0xbe, 0x92, 0x61, 0x72, 0xff, 0x13, 0x7d, 0x0b, 0x92, 0x7c, 0x45, 0x60,
+	0x57, 0xc9, 0x5e, 0xb0, 0x04, 0x06, 0x48, 0xb4, 0x01, 0x29, 0x7c, 0xd6, 0xc5, 0xa6, 0x45, 0xb0,
+	0xa9, 0xe4, 0x36, 0xa4, 0x9b, 0xc9, 0xed, 0x88, 0x22, 0x69, 0x81, 0x10, 0xbd, 0x08, 0xd9, 0x63,
+	0xc3, 0x6a, 0x63, 0x53, 0x77, 0xb1, 0xe1, 0x39, 0xb6, 0x32, 0xcb, 0xfa, 0xcd, 0x70, 0xa1, 0xc6,
+	0x64, 0xe8, 0x6d, 0xc8, 0x0e, 0x56, 0x28, 0xe9, 0x77, 0xb1, 0x22, 0xb3, 0x10, 0x5e, 0x39, 0x27,
+	0x84, 0xeb, 0xfd, 0x2e, 0xd6, 0x32, 0xdd, 0xd0, 0x17, 0x75, 0x11, 0xb5, 0x64, 0xdb, 0x69, 0xbc,
+	0x17, 0x98, 0x75, 0x6e, 0x5a, 0x17, 0xf9, 0x54, 0xdf, 0xb0, 0x2f, 0xc3, 0x5c, 0xa3, 0xd5, 0xb3,
+	0xdf, 0xc3, 0xa6, 0x8e, 0xcf, 0x70, 0xa3, 0xc7, 0x72, 0x14, 0xa2, 0xd3, 0xd3, 0x64, 0xd1, 0xa0,
+	0xfa, 0x72, 0x54, 0x81, 0xf9, 0x13, 0x87, 0x60, 0x9d, 0x2a, 0x70, 0x7a, 0x44, 0xef, 0x62, 0xd7,
+	0x72, 0x4c, 0x65, 0x9e, 0x75, 0xbe, 0x34, 0xd6, 0x79, 0x49, 0xe4, 0xd1, 0xed, 0xd8, 0xaf, 0x68,
+	0xdf, 0x34, 0xb2, 0xf0, 0x2e, 0xa7, 0x56, 0x19, 0x33, 0xff, 0x47, 0x09, 0xe6, 0xfd, 0xa9, 0x06,
+	0x29, 0xd0, 0x43, 0xab, 0x00, 0x3c, 0x0b, 0xea, 0x8e, 0x8d, 0x59, 0xae, 0x48, 0x69, 0x29, 0x2e,
+	0xa9, 0xd8, 0x38, 0xd4, 0x4c, 0x4e, 0x1d, 0x25, 0x12, 0x6e, 0xae, 0x9f, 0x3a, 0xe8, 0x05, 0xc8,
+	0xf8, 0xcd, 0x2d, 0x17, 0x63, 0x96, 0x25, 0x52, 0x5a, 0x5a, 0x00, 0xa8, 0x88, 0x26, 0x4a, 0x01,
+	0x39, 0x76, 0x7a, 0x2e, 0x4b, 0x02, 0x29, 0x4d, 0x28, 0xbd, 0xef, 0xf4, 0xdc, 0x10, 0xc0, 0xeb,
+	0x1a, 0x1d, 0x25, 0x1e, 0x06, 0xd4, 0xba, 0x46, 0x27, 0xff, 0x71, 0x0c, 0xd2, 0xe1, 0x9c, 0x70,
+	0x1b, 0x52, 0x7d, 0xec, 0xe9, 0x0d, 0x96, 0x24, 0xd9, 0x88, 0xb7, 0xe5, 0x50, 0xc6, 0x2e, 0x53,
+	0xa9, 0x96, 0xec, 0x63, 0xaf, 0x48, 0x11, 0xe8, 0x2e, 0x64, 0x8d, 0x23, 0x8f, 0x18, 0x96, 0x2d,
+	0x28, 0x91, 0x73, 0x28, 0x19, 0x01, 0xe3, 0xb4, 0x97, 0x21, 0x69, 0x3b, 0x82, 0x11, 0x3d, 0x87,
+	0x31, 0x63, 0x3b, 0x1c, 0xfc, 0x5d, 0x40, 0xb6, 0xa3, 0x9f, 0x5a, 0xa4, 0xa5, 0x9f, 0x60, 0xe2,
+	0xd3, 0x62, 0xe7, 0xd0, 0x66, 0x6d, 0xe7, 0x91, 0x45, 0x5a, 0x87, 0x98, 0x08, 0xfa, 0xeb, 0x20,
+	0x07, 0x4e, 0x10, 0xe4, 0xf8, 0xd8, 0x56, 0x54, 0xb6, 0x89, 0x96, 0x1b, 0xb8, 0x66, 0x94, 0x49,
+	0x4e, 0xfd, 0x6e, 0x13, 0x4f, 0x63, 0xd6, 0x4f, 0x45, 0x9f, 0xf7, 0x00, 0x85, 0x5d, 0x27, 0xb8,
+	0x33, 0x13, 0xb9, 0x72, 0xc8, 0xa1, 0x9c, 0xfd, 0x06, 0xcc, 0x85, 0xbc, 0x2a, 0xc8, 0xc9, 0x89,
+	0xe4, 0xd9, 0xc0, 0xd7, 0x9c, 0x7b, 0x1b, 0x80, 0x7a, 0x5a, 0x90, 0x52, 0x13, 0x49, 0x29, 0x8a,
+	0xe0, 0x70, 0x15, 0xb2, 0xae, 0xc1, 0x96, 0x4d, 0xa3, 0xe5, 0x58, 0x0d, 0xcc, 0xb2, 0x52, 0xfa,
+	0xd5, 0x8d, 0x91, 0x75, 0xac, 0x31, 0x4c, 0x91, 0x41, 0x78, 0xb4, 0x64, 0xdc, 0x90, 0x28, 0xff,
+	0x13, 0x09, 0xe6, 0xc6, 0x30, 0xe8, 0x75, 0x48, 0xb8, 0x4e, 0xcf, 0x36, 0x3d, 0x45, 0xda, 0x88,
+	0x5e, 0xa0, 0x55, 0xa3, 0x40, 0x4d, 0xe0, 0x69, 0x9d, 0x71, 0x6a, 0xd9, 0x36, 0xe6, 0x9b, 0xfb,
+	0xd3, 0xeb, 0x0c, 0x0e, 0xcc, 0xff, 0x29, 0x02, 0x73, 0x63, 0x0a, 0x27, 0x3a, 0x5f, 0xba, 0xb2,
+	0xf3, 0x23, 0xcf, 0xe0, 0xfc, 0xe8, 0xb3, 0x38, 0x3f, 0x36, 0x9d, 0xf3, 0xbf, 0x03, 0x80, 0xdb,
+	0x56, 0xc7, 0xb2, 0x0d, 0x9a, 0xdd, 0xe3, 0x17, 0x99, 0x2e, 0x04, 0xce, 0xff, 0x3b, 0x02, 0x72,
+	0xc1, 0x6d, 0xb4, 0xac, 0x13, 0x6c, 0x9e, 0x5b, 0xe3, 0x0c, 0xb6, 0xa8, 0x48, 0x78, 0x8b, 0x1a,
+	0xdb, 0x0b, 0xa2, 0x97, 0xdd, 0x0b, 0x82, 0x4a, 0x28, 0xf6, 0xec, 0x95, 0x50, 0xfc, 0x0a, 0x95,
+	0xd0, 0x84, 0x1d, 0x3e, 0x71, 0xb5, 0x1d, 0xfe, 0xff, 0x61, 0xf6, 0xd8, 0xb2, 0x2d, 0xaf, 0x85,
+	0x4d, 0xbd, 0xc5, 0xeb, 0x5e, 0xba, 0xec, 0xa3, 0x5a, 0xce, 0x17, 0xef, 0xf0, 0x7a, 0xf7, 0x0f,
+	0x11, 0xc8, 0x8a, 0x72, 0x45, 0xc3, 0x0d, 0xc7, 0x35, 0xff, 0x77, 0x55, 0xef, 0xf6, 0x80, 0x87,
+	0xcd, 0x4b, 0x15, 0xbe, 0x01, 0x0d, 0xbd, 0x0d, 0x49, 0x17, 0x1f, 0xf7, 0x6c, 0x13, 0x9b, 0x4a,
+	0xec, 0x12, 0x2a, 0x06, 0x2c, 0xf4, 0x3d, 0x98, 0x69, 0xb4, 0x0c, 0xb7, 0xc9, 0x22, 0x73, 0x7a,
+	0x05, 0x3e, 0x29, 0xff, 0x2f, 0x09, 0x62, 0x34, 0x78, 0x2f, 0xb6, 0xd3, 0x26, 0xc4, 0xe9, 0x1e,
+	0x7d, 0xb1, 0x8d, 0x38, 0x0c, 0xbd, 0x09, 0x33, 0x7c, 0x25, 0x79, 0x62, 0x6a, 0x2f, 0x8c, 0x04,
+	0xcf, 0xf8, 0x49, 0x48, 0xf3, 0x19, 0x43, 0x25, 0x5d, 0x7c, 0xa4, 0xa4, 0x7b, 0x0d, 0x66, 0x68,
+	0x9a, 0xb4, 0xec, 0xa6, 0x92, 0xd8, 0x88, 0x3e, 0x7d, 0x31, 0xfa, 0xc8, 0x87, 0xb1, 0x64, 0x54,
+	0x8e, 0xe5, 0xff, 0x2a, 0x0d, 0xc2, 0xa3, 0x6a, 0xb8, 0x46, 0xc7, 0x43, 0x4f, 0x20, 0xdd, 0xb1,
+	0xec, 0x41, 0x71, 0x2c, 0x5d, 0x64, 0xc3, 0x55, 0x6a, 0xc3, 0xaf, 0x3e, 0x5f, 0xbf, 0x1e, 0x62,
+	0xbd, 0xe2, 0x74, 0x2c, 0x82, 0x3b, 0x5d, 0xd2, 0xd7, 0xa0, 0x63, 0xd9, 0x7e, 0xb9, 0xdc, 0x01,
+	0xd4, 0x31, 0xce, 0x7c, 0x90, 0x5f, 0x0f, 0x45, 0x2e, 0xaa, 0x87, 0x6e, 0x7c, 0xf5, 0xf9, 0xfa,
+	0xf3, 0xe3, 0xc4, 0xa0, 0x13, 0x56, 0x2f, 0xc9, 0x1d, 0xe3, 0xcc, 0x9f, 0x09, 0x6b, 0x7f, 0x23,
+	0xa2, 0x48, 0xf9, 0xc7, 0x90, 0x39, 0x64, 0x0b, 0x47, 0xcc, 0xae, 0x04, 0x62, 0x21, 0xf9, 0xbd,
+	0x4b, 0xd3, 0x55, 0x63, 0x19, 0xce, 0x0a, 0x69, 0xfe, 0xb5, 0x24, 0x2a, 0x1a, 0xa1, 0xf9, 0x25,
+	0x48, 0xfc, 0xa8, 0xe7, 0xb8, 0xbd, 0x8e, 0x22, 0x4d, 0x3e, 0x80, 0xf2, 0x56, 0xf4, 0x0a, 0xa4,
+	0x68, 0xbe, 0xf6, 0x5a, 0x4e, 0xdb, 0x3c, 0xe7, 0xac, 0x1a, 0x00, 0xd0, 0x5d, 0xc8, 0xb1, 0x62,
+	0x24, 0xa0, 0x44, 0x27, 0x52, 0xb2, 0x14, 0x55, 0xf7, 0x41, 0x6c, 0x80, 0xbf, 0xc8, 0x41, 0x42,
+	0x8c, 0x4d, 0xbd, 0xa4, 0x4f, 0x43, 0xeb, 0x22, 0xec, 0xbf, 0xbd, 0xab, 0xf9, 0x2f, 0x36, 0xd9,
+	0x3f, 0xe3, 0xbe, 0x88, 0x5e, 0xc1, 0x17, 0x21, 0xbb, 0xc7, 0xa6, 0xb7, 0x7b, 0xfc, 0xf2, 0x76,
+	0x4f, 0x4c, 0x61, 0x77, 0x54, 0x86, 0x25, 0x6a, 0x68, 0xcb, 0xb6, 0x88, 0x15, 0x9c, 0x30, 0x75,
+	0x36, 0x7c, 0x65, 0x66, 0xa2, 0x86, 0xe7, 0x3a, 0x96, 0x5d, 0xe6, 0x78, 0x3f, 0x4f, 0x53, 0x34,
+	0xda, 0x86, 0xeb, 0x83, 0xf4, 0xd3, 0x30, 0xec, 0x06, 0x6e, 0x0b, 0x35, 0xc9, 0x89, 0x6a, 0xe6,
+	0x7d, 0x70, 0x91, 0x61, 0xb9, 0x8e, 0x87, 0xb0, 0x30, 0xaa, 0xc3, 0xc4, 0x9e, 0x5f, 0xaf, 0x9d,
+	0x9f, 0xb0, 0xd0, 0xb0, 0xb2, 0x12, 0xf6, 0x08, 0x7a, 0x04, 0x8b, 0x83, 0xc3, 0x9b, 0x3e, 0xec,
+	0x37, 0x98, 0xce, 0x6f, 0xd7, 0x07, 0xfc, 0xc3, 0xb0, 0x03, 0xdf, 0x82, 0xf9, 0x40, 0x71, 0x60,
+	0xef, 0xf4, 0xc4, 0x69, 0xa2, 0x01, 0x34, 0x30, 0xfa, 0x63, 0x08, 0x34, 0xeb, 0xe1, 0x38, 0xcf,
+	0x5c, 0x22, 0xce, 0x83, 0x31, 0xec, 0x05, 0x01, 0x7f, 0x13, 0xe4, 0xa3, 0x9e, 0x6b, 0xeb, 0xec,
+	0x18, 0x27, 0xa2, 0x2c, 0xcb, 0x4e, 0x7b, 0x39, 0x2a, 0xa7, 0x69, 0xf5, 0xfb, 0x3c, 0xba, 0x0a,
+	0xb0, 0xca, 0x90, 0x03, 0x73, 0x0f, 0x16, 0x89, 0x8b, 0x29, 0x9b, 0x9f, 0x81, 0xb5, 0x65, 0x0a,
+	0xf2, 0xcb, 0x0c, 0x7f, 0x35, 0x70, 0x04, 0xba, 0x01, 0xb9, 0xa0, 0x33, 0x1a, 0x56, 0xec, 0x44,
+	0x9c, 0xd4, 0x32, 0x7e, 0x57, 0xf4, 0xac, 0x41, 0xeb, 0xb6, 0xd0, 0x14, 0x45, 0x48, 0xc8, 0x13,
+	0x6d, 0x35, 0x1b, 0x2c, 0x5d, 0x1e, 0x0e, 0xef, 0xc0, 0xf2, 0x68, 0x38, 0xd0, 0xf5, 0x2c, 0xbc,
+	0x38, 0x37, 0x51, 0xc9, 0xe2, 0x70, 0x28, 0xec, 0x19, 0x67, 0xc2, 0x6d, 0x3f, 0x84, 0x75, 0xba,
+	0x37, 0x75, 0x2c, 0x8f, 0x58, 0x0d, 0xdd, 0xe8, 0x91, 0x96, 0xe3, 0x5a, 0x3f, 0xc6, 0xa6, 0x6e,
+	0xf0, 0x50, 0xc2, 0x9e, 0x82, 0x36, 0xa2, 0x4f, 0x0d, 0xb3, 0xd5, 0x40, 0x41, 0x61, 0xc0, 0x2f,
+	0xf8, 0x74, 0xa4, 0x41, 0x08, 0xa0, 0xbb, 0xf8, 0x5d, 0xdc, 0x18, 0x0e, 0x91, 0xf9, 0x89, 0x23,
+	0x5e, 0x09, 0x48, 0x9a, 0xe0, 0x04, 0xb1, 0x72, 0x1b, 0x80, 0x9e, 0x3b, 0x85, 0x2f, 0x17, 0x26,
+	0xa7, 0x81, 0x3e, 0xf6, 0x84, 0x5b, 0x2b, 0x20, 0x7b, 0xb8, 0xd1, 0x73, 0x2d, 0xd2, 0x67, 0x25,
+	0x72, 0xc3, 0x6a, 0x2b, 0xd7, 0x59, 0xb4, 0xdf, 0x18, 0xd9, 0x62, 0x6b, 0x02, 0x56, 0xe4, 0x28,
+	0x9e, 0x78, 0xb5, 0x59, 0x6f, 0x58, 0x8c, 0xee, 0x41, 0x0a, 0x77, 0xb0, 0xdb, 0xc4, 0x76, 0xa3,
+	0xaf, 0x3c, 0xc7, 0x34, 0xad, 0x8d, 0x68, 0x52, 0xfd, 0x76, 0xa1, 0x23, 0x20, 0xa0, 0x7b, 0x21,
+	0x07, 0x1a, 0xbc, 0x8a, 0xd6, 0x5d, 0x4c, 0xb0, 0xcd, 0xee, 0x21, 0x16, 0x59, 0x85, 0xa2, 0xf8,
+	0x08, 0x51, 0x66, 0x6b, 0x7e, 0x3b, 0x3d, 0x30, 0xf8, 0x61, 0xc3, 0x02, 0x8d, 0xc7, 0x8e, 0x32,
+	0xd1, 0x06, 0xfe, 0x75, 0xdb, 0x76, 0xcf, 0xb5, 0x79, 0xf0, 0x3c, 0x80, 0x5c, 0xdb, 0xb2, 0xb1,
+	0x4e, 0xf7, 0x5c, 0x1e, 0x9e, 0x4b, 0x1b, 0xd2, 0x84, 0x22, 0x66, 0xd7, 0xb2, 0x71, 0x99, 0xe0,
+	0x0e, 0x8d, 0x56, 0x31, 0x83, 0x4c, 0x3b, 0x24, 0x43, 0x77, 0x69, 0x22, 0x11, 0x77, 0x24, 0x7a,
+	0xd3, 0xf0, 0x68, 0xfc, 0xe9, 0x47, 0xf4, 0x92, 0x44, 0x59, 0x66, 0x33, 0x58, 0x18, 0x34, 0x3f,
+	0x30, 0xbc, 0x2a, 0x76, 0xb7, 0x69, 0x1b, 0x7a, 0x1b, 0xd2, 0xa7, 0x2d, 0xa3, 0x8d, 0x75, 0xa3,
+	0x8d, 0x5d, 0xa2, 0xac, 0xb0, 0xce, 0xd7, 0x47, 0x2b, 0x28, 0x8a, 0x28, 0x50, 0x80, 0xe8, 0x1a,
+	0x4e, 0x07, 0x92, 0xfc, 0x4f, 0x25, 0x90, 0x47, 0x01, 0x2c, 0xd1, 0xd3, 0x05, 0x17, 0x44, 0x95,
+	0x74, 0x4e, 0xa2, 0x77, 0x08, 0x0e, 0xe2, 0xe8, 0x4d, 0x98, 0xf3, 0x6d, 0x79, 0xd1, 0x6e, 0xee,
+	0x9b, 0x72, 0x40, 0xce, 0x7f, 0x2c, 0x41, 0x3a, 0x18, 0x88, 0x77, 0x71, 0xa5, 0xb9, 0x0e, 0x69,
+	0x36, 0x48, 0x36, 0x75, 0x8f, 0xf5, 0x13, 0xd3, 0x80, 0x8a, 0x84, 0x86, 0xff, 0x03, 0xff, 0x96,
+	0xd3, 0xc7, 0x44, 0x19, 0x26, 0x2b, 0xa4, 0x02, 0xf6, 0x0e, 0xe4, 0xb8, 0x0d, 0x85, 0xd8, 0xbb,
+	0x54, 0x8d, 0x9d, 0x65, 0x5c, 0x91, 0x51, 0xbc, 0xfc, 0xcf, 0x24, 0x40, 0xe3, 0xce, 0xa6, 0xa7,
+	0x07, 0x91, 0x0c, 0x48, 0x5f, 0xd8, 0xf2, 0x29, 0xa7, 0x87, 0x01, 0x14, 0xbd, 0x09, 0x49, 0xff,
+	0xb6, 0x6d, 0xda, 0x92, 0x62, 0x40, 0xc8, 0xff, 0x32, 0x02, 0x99, 0xf0, 0x58, 0x2e, 0x36, 0xe9,
+	0x8b, 0x90, 0x15, 0x37, 0xe4, 0xba, 0x65, 0x9b, 0xf8, 0x8c, 0xf5, 0x99, 0xd5, 0x32, 0x42, 0x58,
+	0xa6, 0x32, 0xb4, 0x01, 0x99, 0x8e, 0xd7, 0x64, 0xa7, 0x4d, 0xbd, 0xe7, 0xb6, 0xc5, 0xd5, 0x18,
+	0x74, 0xbc, 0x26, 0x3d, 0x4f, 0x1e, 0xb8, 0xed, 0xe1, 0xd9, 0xc6, 0xa6, 0x9f, 0xed, 0x0d, 0xc8,
+	0xbe, 0xdb, 0xf3, 0x88, 0x75, 0x6c, 0x35, 0xd8, 0x8c, 0x44, 0x4d, 0x3f, 0x2c, 0x44, 0x05, 0x48,
+	0xd1, 0x95, 0x46, 0x93, 0x2a, 0x99, 0xe2, 0xa4, 0x98, 0xa4, 0xbe, 0x62, 0xa7, 0xc5, 0x24, 0xa7,
+	0x15, 0x48, 0xfe, 0xf7, 0x12, 0x64, 0x6b, 0x8d, 0x16, 0x36, 0x7b, 0x6d, 0xac, 0xda, 0xc4, 0xa5,
+	0xd7, 0x25, 0x31, 0x76, 0xf2, 0x94, 0x2e, 0xa1, 0x8f, 0x31, 0x46, 0x8d, 0x1a, 0x19, 0x33, 0xea,
+	0x5b, 0x00, 0xf8, 0x04, 0xdb, 0x24, 0x7c, 0x3e, 0x1f, 0xbd, 0x8d, 0x19, 0x0c, 0x86, 0x02, 0xd9,
+	0x21, 0x3d, 0x85, 0xfd, 0x9f, 0xb4, 0xa8, 0xbe, 0x3e, 0x31, 0x93, 0xa2, 0xb7, 0x20, 0xd7, 0x75,
+	0xda, 0x56, 0xa3, 0xef, 0xef, 0x2f, 0x17, 0xc6, 0x56, 0x96, 0xe3, 0x85, 0x70, 0xbc, 0xda, 0x8c,
+	0x5c, 0xa1, 0xda, 0xcc, 0x7f, 0x28, 0xc1, 0xec, 0x48, 0x82, 0x46, 0xdf, 0x00, 0xd9, 0x68, 0xb7,
+	0x9d, 0x53, 0x5a, 0x7d, 0xf8, 0x4f, 0x33, 0xb4, 0xc4, 0x4e, 0x69, 0xb3, 0x42, 0xbe, 0x27, 0xc4,
+	0x5f, 0xcf, 0x20, 0x86, 0x4b, 0xd9, 0xe8, 0x05, 0xa5, 0x6c, 0xfe, 0x83, 0x08, 0x20, 0x31, 0x80,
+	0x6d, 0xc3, 0xc3, 0xe6, 0xd7, 0x79, 0x12, 0x0a, 0x55, 0xdf, 0x91, 0xa7, 0x56, 0xdf, 0x97, 0xdc,
+	0x77, 0x2f, 0x35, 0xc3, 0x09, 0xc5, 0x7a, 0x6c, 0x8a, 0x62, 0x9d, 0x3e, 0x05, 0xce, 0xf9, 0xb5,
+	0x58, 0x70, 0x6b, 0x7f, 0x61, 0xe6, 0x78, 0x05, 0x90, 0x8d, 0xcf, 0x88, 0x3e, 0x29, 0x7d, 0xc8,
+	0xb4, 0x65, 0x2f, 0x9c, 0x42, 0x96, 0x20, 0x49, 0xf7, 0xb8, 0x9e, 0x87, 0x4d, 0x91, 0x93, 0x67,
+	0x9a, 0x86, 0x77, 0xe0, 0x61, 0x93, 0x5e, 0xbc, 0xf3, 0x67, 0x23, 0x71, 0x81, 0x13, 0x63, 0x17,
+	0x38, 0x69, 0x26, 0x13, 0xb7, 0x37, 0x7f, 0x91, 0x20, 0x3b, 0x28, 0x17, 0x5d, 0xe3, 0x98, 0x8c,
+	0xdd, 0x95, 0x6d, 0x42, 0xdc, 0x39, 0xb5, 0xa7, 0xb9, 0x84, 0x60, 0x30, 0xba, 0x53, 0xb4, 0x0d,
+	0x82, 0x3d, 0xa2, 0x9f, 0x60, 0xd7, 0xa3, 0x99, 0x47, 0xec, 0x14, 0x5c, 0x7a, 0xc8, 0x85, 0xa8,
+	0x08, 0xd0, 0x70, 0xb1, 0x41, 0x78, 0xea, 0x89, 0x5d, 0x22, 0x55, 0xa4, 0x04, 0xaf, 0x30, 0xf6,
+	0xbe, 0x1a, 0x1f, 0x35, 0x65, 0xfe, 0xd3, 0x08, 0x2c, 0x0c, 0x4d, 0xcf, 0xef, 0x7e, 0x09, 0x92,
+	0x26, 0xfd, 0x0e, 0x3c, 0x30, 0xc3, 0xbe, 0xcb, 0x26, 0x7d, 0xa9, 0xf2, 0x47, 0xce, 0x13, 0x90,
+	0xff, 0x39, 0xf4, 0x34, 0x1a, 0x9d, 0xea, 0x69, 0x34, 0x7c, 0xa9, 0x12, 0x3b, 0xef, 0x9d, 0x2c,
+	0x7e, 0xce, 0x3b, 0x59, 0x62, 0xf8, 0x9d, 0x6c, 0xec, 0x7a, 0x72, 0xe6, 0xb2, 0xd7, 0x93, 0xc3,
+	0x36, 0x4f, 0x5e, 0xc9, 0xe6, 0xb7, 0xfe, 0x29, 0x41, 0x26, 0xdc, 0x07, 0x5a, 0x85, 0xa5, 0xaa,
+	0x56, 0xa9, 0x56, 0x6a, 0x85, 0x5d, 0xbd, 0xfe, 0xa4, 0xaa, 0xea, 0x07, 0xfb, 0xb5, 0xaa, 0x5a,
+	0x2c, 0xdf, 0x2f, 0xab, 0x25, 0xf9, 0x1a, 0x5a, 0x86, 0xe7, 0x86, 0x9b, 0x6b, 0xf5, 0xc2, 0x7e,
+	0xa9, 0xa0, 0x95, 0x64, 0x09, 0xbd, 0x00, 0xab, 0xc3, 0x6d, 0x7b, 0x07, 0xbb, 0xf5, 0x72, 0x75,
+	0x57, 0xd5, 0x8b, 0x3b, 0x95, 0x72, 0x51, 0x95, 0x23, 0xe8, 0x79, 0x50, 0x86, 0x21, 0x95, 0x6a,
+	0xbd, 0xbc, 0x57, 0xae, 0xd5, 0xcb, 0x45, 0x39, 0x8a, 0x56, 0x60, 0x71, 0xb8, 0x55, 0x7d, 0x5c,
+	0x55, 0x4b, 0xe5, 0xba, 0x5a, 0x92, 0x63, 0x28, 0x0f, 0x6b, 0x23, 0x3d, 0xab, 0xc5, 0x03, 0xad,
+	0x5c, 0x7f, 0xa2, 0x17, 0x2b, 0x07, 0xfb, 0xc5, 0xf2, 0xae, 0x1c, 0x9f, 0xa0, 0x60, 0x4f, 0xd5,
+	0x1e, 0xa8, 0xfb, 0xc5, 0x27, 0x72, 0xe2, 0xd6, 0x7f, 0x24, 0x80, 0xd0, 0x1f, 0x0d, 0xac, 0xc0,
+	0xe2, 0x61, 0xa5, 0xce, 0x47, 0x50, 0xd9, 0x1f, 0x99, 0xe6, 0x3c, 0xcc, 0x86, 0x1b, 0x2b, 0xfb,
+	0xaa, 0x2c, 0x8d, 0x0a, 0x9f, 0xa8, 0xb5, 0x71, 0x61, 0xfd, 0x51, 0x45, 0x8e, 0xa0, 0x45, 0x98,
+	0x0f, 0x0b, 0x0b, 0xdb, 0xb5, 0x7a, 0xa1, 0xbc, 0x2f, 0x47, 0xd0, 0x75, 0x98, 0x1b, 0x42, 0xef,
+	0x68, 0xaa, 0x2a, 0x47, 0x11, 0x82, 0x5c, 0x58, 0xbc, 0x5f, 0x91, 0xa3, 0x68, 0x01, 0xe4, 0xb0,
+	0xec, 0x7e, 0xe5, 0x40, 0x93, 0x63, 0xd4, 0x80, 0xc3, 0x48, 0xfd, 0x51, 0xb9, 0xbe, 0xa3, 0x1f,
+	0xaa, 0xf5, 0x8a, 0x1c, 0x1b, 0xe5, 0xd4, 0xaa, 0x85, 0x3d, 0x39, 0xbe, 0x1c, 0x91, 0xa5, 0x5b,
+	0x3f, 0x8f, 0x40, 0x6e, 0xf8, 0xbe, 0x1a, 0xad, 0xc3, 0xca, 0xc0, 0x58, 0xb5, 0x7a, 0xa1, 0x7e,
+	0x50, 0x1b, 0x31, 0x42, 0xd8, 0xe2, 0x02, 0x50, 0x52, 0xab, 0x95, 0x5a, 0xb9, 0xae, 0x57, 0x55,
+	0xad, 0x5c, 0x19, 0xf5, 0xb9, 0xc0, 0x1c, 0x56, 0xea, 0xe5, 0xfd, 0x07, 0x3e, 0x24, 0x32, 0x14,
+	0x32, 0x02, 0x52, 0x2d, 0xd4, 0x6a, 0x6a, 0x49, 0x8e, 0x0e, 0xc5, 0x83, 0x68, 0xd3, 0xd4, 0x87,
+	0x6a, 0x91, 0xbb, 0x7c, 0x02, 0xf3, 0x7e, 0xa1, 0xbc, 0xab, 0x96, 0xe4, 0xf8, 0x24, 0x66, 0xbd,
+	0xbc, 0xa7, 0xee, 0x56, 0x8a, 0xef, 0xc8, 0x89, 0xa1, 0x28, 0x16, 0xad, 0xea, 0x63, 0xb5, 0x78,
+	0x40, 0x47, 0x26, 0xcf, 0xdc, 0xfa, 0xad, 0x04, 0x73, 0x63, 0x85, 0x05, 0x7a, 0x11, 0xd6, 0x6b,
+	0xc5, 0x1d, 0xb5, 0x74, 0xb0, 0xab, 0xea, 0xea, 0xa1, 0xba, 0x5f, 0x9f, 0xb4, 0x00, 0xce, 0x01,
+	0xf9, 0x86, 0x51, 0xf7, 0xa9, 0x55, 0xf2, 0xb0, 0x36, 0x09, 0x24, 0x2c, 0x43, 0x31, 0x11, 0x6a,
+	0xb9, 0x49, 0x18, 0x31, 0xcc, 0xca, 0xbe, 0x1c, 0xdd, 0xbe, 0xfb, 0xc9, 0x17, 0x6b, 0xd2, 0xa7,
+	0x5f, 0xac, 0x49, 0x7f, 0xff, 0x62, 0x4d, 0x7a, 0xff, 0xcb, 0xb5, 0x6b, 0x9f, 0x7e, 0xb9, 0x76,
+	0xed, 0xcf, 0x5f, 0xae, 0x5d, 0xfb, 0xc1, 0x0a, 0xcf, 0x12, 0x9e, 0xf9, 0xde, 0xa6, 0xe5, 0x6c,
+	0x9d, 0xb1, 0xbf, 0xfb, 0xa1, 0x59, 0xc4, 0xa3, 0x7f, 0xd4, 0x93, 0x60, 0x8b, 0xff, 0xb5, 0xff,
+	0x0e, 0x00, 0x10, 0x95, 0x20, 0xc2, 0x15, 0x24, 0x00, 0x00,
+}
+
+func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedVoteOption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weight) > 0 {
+		i -= len(m.Weight)
+		copy(dAtA[i:], m.Weight)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Weight)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.MinDeposit) > 0 {
-		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Option != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Deposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2215,13 +2891,25 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGov(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
 		}
 	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MessageBasedParams) Marshal() (dAtA []byte, err error) {
+func (m *Proposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2231,462 +2919,4852 @@ func (m *MessageBasedParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MessageBasedParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MessageBasedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.YesQuorum) > 0 {
-		i -= len(m.YesQuorum)
-		copy(dAtA[i:], m.YesQuorum)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.YesQuorum)))
+	if m.VoteLockoutPeriod != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VoteLockoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VoteLockoutPeriod):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintGov(dAtA, i, uint64(n1))
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xa2
+		dAtA[i] = 0x9a
 	}
-	if len(m.VetoThreshold) > 0 {
-		i -= len(m.VetoThreshold)
-		copy(dAtA[i:], m.VetoThreshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoThreshold)))
+	if m.ChunkedExecution {
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Threshold) > 0 {
-		i -= len(m.Threshold)
-		copy(dAtA[i:], m.Threshold)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+		if m.ChunkedExecution {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Quorum) > 0 {
-		i -= len(m.Quorum)
-		copy(dAtA[i:], m.Quorum)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x90
 	}
-	if m.VotingPeriod != nil {
-		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err11 != nil {
-			return 0, err11
+	if m.TimelockEndTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.TimelockEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TimelockEndTime):])
+		if err2 != nil {
+			return 0, err2
 		}
-		i -= n11
-		i = encodeVarintGov(dAtA, i, uint64(n11))
+		i -= n2
+		i = encodeVarintGov(dAtA, i, uint64(n2))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGov(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if m.ProposalType != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalType))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *WeightedVoteOption) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.FailedReason) > 0 {
+		i -= len(m.FailedReason)
+		copy(dAtA[i:], m.FailedReason)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.FailedReason)))
+		i--
+		dAtA[i] = 0x7a
 	}
-	var l int
-	_ = l
-	if m.Option != 0 {
-		n += 1 + sovGov(uint64(m.Option))
+	if m.Expedited {
+		i--
+		if m.Expedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
 	}
-	l = len(m.Weight)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x6a
 	}
-	return n
-}
-
-func (m *Deposit) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Summary) > 0 {
+		i -= len(m.Summary)
+		copy(dAtA[i:], m.Summary)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Summary)))
+		i--
+		dAtA[i] = 0x62
 	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovGov(uint64(m.ProposalId))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x52
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+	if m.VotingEndTime != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
+		if err3 != nil {
+			return 0, err3
 		}
+		i -= n3
+		i = encodeVarintGov(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x4a
 	}
-	return n
-}
-
-func (m *Proposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovGov(uint64(m.Id))
-	}
-	if len(m.Messages) > 0 {
-		for _, e := range m.Messages {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+	if m.VotingStartTime != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime):])
+		if err4 != nil {
+			return 0, err4
 		}
-	}
-	if m.Status != 0 {
-		n += 1 + sovGov(uint64(m.Status))
-	}
-	if m.FinalTallyResult != nil {
-		l = m.FinalTallyResult.Size()
-		n += 1 + l + sovGov(uint64(l))
-	}
-	if m.SubmitTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime)
-		n += 1 + l + sovGov(uint64(l))
-	}
-	if m.DepositEndTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime)
-		n += 1 + l + sovGov(uint64(l))
+		i -= n4
+		i = encodeVarintGov(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x42
 	}
 	if len(m.TotalDeposit) > 0 {
-		for _, e := range m.TotalDeposit {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+		for iNdEx := len(m.TotalDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
 		}
 	}
-	if m.VotingStartTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime)
-		n += 1 + l + sovGov(uint64(l))
-	}
-	if m.VotingEndTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime)
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.DepositEndTime != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintGov(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.Summary)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.SubmitTime != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintGov(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Proposer)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.FinalTallyResult != nil {
+		{
+			size, err := m.FinalTallyResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Expedited {
-		n += 2
+	if m.Status != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.FailedReason)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.ProposalType != 0 {
-		n += 2 + sovGov(uint64(m.ProposalType))
+	if m.Id != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ProposalVoteOptions) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProposalVoteOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalVoteOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalVoteOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OptionOne)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.OptionSpam) > 0 {
+		i -= len(m.OptionSpam)
+		copy(dAtA[i:], m.OptionSpam)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionSpam)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.OptionTwo)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.OptionFour) > 0 {
+		i -= len(m.OptionFour)
+		copy(dAtA[i:], m.OptionFour)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionFour)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.OptionThree)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.OptionThree) > 0 {
+		i -= len(m.OptionThree)
+		copy(dAtA[i:], m.OptionThree)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionThree)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.OptionFour)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.OptionTwo) > 0 {
+		i -= len(m.OptionTwo)
+		copy(dAtA[i:], m.OptionTwo)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionTwo)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.OptionSpam)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.OptionOne) > 0 {
+		i -= len(m.OptionOne)
+		copy(dAtA[i:], m.OptionOne)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionOne)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TallyResult) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TallyResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TallyResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.YesCount)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.RankedChoice != nil {
+		{
+			size, err := m.RankedChoice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
 	}
-	l = len(m.AbstainCount)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.SpamCount) > 0 {
+		i -= len(m.SpamCount)
+		copy(dAtA[i:], m.SpamCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.SpamCount)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = len(m.NoCount)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.OptionFourCount) > 0 {
+		i -= len(m.OptionFourCount)
+		copy(dAtA[i:], m.OptionFourCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionFourCount)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.NoWithVetoCount)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.OptionThreeCount) > 0 {
+		i -= len(m.OptionThreeCount)
+		copy(dAtA[i:], m.OptionThreeCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionThreeCount)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.OptionOneCount)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.OptionTwoCount) > 0 {
+		i -= len(m.OptionTwoCount)
+		copy(dAtA[i:], m.OptionTwoCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionTwoCount)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.OptionTwoCount)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.OptionOneCount) > 0 {
+		i -= len(m.OptionOneCount)
+		copy(dAtA[i:], m.OptionOneCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionOneCount)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.OptionThreeCount)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.NoWithVetoCount) > 0 {
+		i -= len(m.NoWithVetoCount)
+		copy(dAtA[i:], m.NoWithVetoCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.NoWithVetoCount)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.OptionFourCount)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.NoCount) > 0 {
+		i -= len(m.NoCount)
+		copy(dAtA[i:], m.NoCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.NoCount)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.SpamCount)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.AbstainCount) > 0 {
+		i -= len(m.AbstainCount)
+		copy(dAtA[i:], m.AbstainCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.AbstainCount)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.YesCount) > 0 {
+		i -= len(m.YesCount)
+		copy(dAtA[i:], m.YesCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.YesCount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Vote) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RankedChoiceTally) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RankedChoiceTally) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RankedChoiceTally) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovGov(uint64(m.ProposalId))
-	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.Winner != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Winner))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Options) > 0 {
-		for _, e := range m.Options {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+	if len(m.Rounds) > 0 {
+		for iNdEx := len(m.Rounds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rounds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DepositParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.MinDeposit) > 0 {
-		for _, e := range m.MinDeposit {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
-		}
-	}
-	if m.MaxDepositPeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod)
-		n += 1 + l + sovGov(uint64(l))
+func (m *RankedChoiceRound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *VotingParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.VotingPeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod)
-		n += 1 + l + sovGov(uint64(l))
-	}
-	return n
+func (m *RankedChoiceRound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TallyParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RankedChoiceRound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Quorum)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.Eliminated != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Eliminated))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.Threshold)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.OptionFourCount) > 0 {
+		i -= len(m.OptionFourCount)
+		copy(dAtA[i:], m.OptionFourCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionFourCount)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.VetoThreshold)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.OptionThreeCount) > 0 {
+		i -= len(m.OptionThreeCount)
+		copy(dAtA[i:], m.OptionThreeCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionThreeCount)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.OptionTwoCount) > 0 {
+		i -= len(m.OptionTwoCount)
+		copy(dAtA[i:], m.OptionTwoCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionTwoCount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OptionOneCount) > 0 {
+		i -= len(m.OptionOneCount)
+		copy(dAtA[i:], m.OptionOneCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptionOneCount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ArchivedProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.MinDeposit) > 0 {
-		for _, e := range m.MinDeposit {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+	if m.FinishedHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.FinishedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.VotingEndTime != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime):])
+		if err9 != nil {
+			return 0, err9
 		}
+		i -= n9
+		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.MaxDepositPeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod)
-		n += 1 + l + sovGov(uint64(l))
+	if m.FinalTallyResult != nil {
+		{
+			size, err := m.FinalTallyResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.VotingPeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod)
-		n += 1 + l + sovGov(uint64(l))
+	if m.Status != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Quorum)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.ProposalType != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalType))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Threshold)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.VetoThreshold)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if m.Id != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.MinInitialDepositRatio)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ProposalCancelRatio)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *DepositRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Charged) > 0 {
+		for iNdEx := len(m.Charged) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Charged[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	l = len(m.ProposalCancelDest)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Refunded) > 0 {
+		for iNdEx := len(m.Refunded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refunded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.ExpeditedVotingPeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod)
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Deposited) > 0 {
+		for iNdEx := len(m.Deposited) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposited[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.ExpeditedThreshold)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.ExpeditedMinDeposit) > 0 {
-		for _, e := range m.ExpeditedMinDeposit {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ranking) > 0 {
+		dAtA12 := make([]byte, len(m.Ranking)*10)
+		var j11 int
+		for _, num := range m.Ranking {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
 		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintGov(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.BurnVoteQuorum {
-		n += 2
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.BurnProposalDepositPrevote {
-		n += 2
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.BurnVoteVeto {
-		n += 2
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.MinDepositRatio)
-	if l > 0 {
-		n += 2 + l + sovGov(uint64(l))
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.ProposalCancelMaxPeriod)
-	if l > 0 {
-		n += 2 + l + sovGov(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.OptimisticAuthorizedAddresses) > 0 {
-		for _, s := range m.OptimisticAuthorizedAddresses {
-			l = len(s)
-			n += 2 + l + sovGov(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *DepositParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxDepositPeriod != nil {
+		n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintGov(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VotingParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotingParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotingPeriod != nil {
+		n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintGov(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TallyParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TallyParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VetoThreshold) > 0 {
+		i -= len(m.VetoThreshold)
+		copy(dAtA[i:], m.VetoThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoThreshold)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WhaleAlert != nil {
+		{
+			size, err := m.WhaleAlert.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.ExecutionGasPerBlock != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ExecutionGasPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.LineItemVeto != nil {
+		{
+			size, err := m.LineItemVeto.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.DepositBurnRatio) > 0 {
+		i -= len(m.DepositBurnRatio)
+		copy(dAtA[i:], m.DepositBurnRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.DepositBurnRatio)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.ProposalArchiveRetention != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalArchiveRetention))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.Emergency != nil {
+		{
+			size, err := m.Emergency.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.SecurityCouncil != nil {
+		{
+			size, err := m.SecurityCouncil.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.YesQuorum) > 0 {
+		i -= len(m.YesQuorum)
+		copy(dAtA[i:], m.YesQuorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.YesQuorum)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.OptimisticRejectedThreshold) > 0 {
+		i -= len(m.OptimisticRejectedThreshold)
+		copy(dAtA[i:], m.OptimisticRejectedThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptimisticRejectedThreshold)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.OptimisticAuthorizedAddresses) > 0 {
+		for iNdEx := len(m.OptimisticAuthorizedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OptimisticAuthorizedAddresses[iNdEx])
+			copy(dAtA[i:], m.OptimisticAuthorizedAddresses[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.OptimisticAuthorizedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.ProposalCancelMaxPeriod) > 0 {
+		i -= len(m.ProposalCancelMaxPeriod)
+		copy(dAtA[i:], m.ProposalCancelMaxPeriod)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelMaxPeriod)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.MinDepositRatio) > 0 {
+		i -= len(m.MinDepositRatio)
+		copy(dAtA[i:], m.MinDepositRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MinDepositRatio)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.BurnVoteVeto {
+		i--
+		if m.BurnVoteVeto {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.BurnProposalDepositPrevote {
+		i--
+		if m.BurnProposalDepositPrevote {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.BurnVoteQuorum {
+		i--
+		if m.BurnVoteQuorum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.ExpeditedMinDeposit) > 0 {
+		for iNdEx := len(m.ExpeditedMinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpeditedMinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ExpeditedThreshold) > 0 {
+		i -= len(m.ExpeditedThreshold)
+		copy(dAtA[i:], m.ExpeditedThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ExpeditedThreshold)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ExpeditedVotingPeriod != nil {
+		n19, err19 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
+		if err19 != nil {
+			return 0, err19
+		}
+		i -= n19
+		i = encodeVarintGov(dAtA, i, uint64(n19))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ProposalCancelDest) > 0 {
+		i -= len(m.ProposalCancelDest)
+		copy(dAtA[i:], m.ProposalCancelDest)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelDest)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ProposalCancelRatio) > 0 {
+		i -= len(m.ProposalCancelRatio)
+		copy(dAtA[i:], m.ProposalCancelRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelRatio)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.MinInitialDepositRatio) > 0 {
+		i -= len(m.MinInitialDepositRatio)
+		copy(dAtA[i:], m.MinInitialDepositRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MinInitialDepositRatio)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.VetoThreshold) > 0 {
+		i -= len(m.VetoThreshold)
+		copy(dAtA[i:], m.VetoThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoThreshold)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.VotingPeriod != nil {
+		n20, err20 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err20 != nil {
+			return 0, err20
+		}
+		i -= n20
+		i = encodeVarintGov(dAtA, i, uint64(n20))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxDepositPeriod != nil {
+		n21, err21 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err21 != nil {
+			return 0, err21
+		}
+		i -= n21
+		i = encodeVarintGov(dAtA, i, uint64(n21))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WhaleAlertParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WhaleAlertParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WhaleAlertParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DepositThreshold) > 0 {
+		i -= len(m.DepositThreshold)
+		copy(dAtA[i:], m.DepositThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.DepositThreshold)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VoteThreshold) > 0 {
+		i -= len(m.VoteThreshold)
+		copy(dAtA[i:], m.VoteThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VoteThreshold)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WhaleAlerts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WhaleAlerts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WhaleAlerts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WhaleDeposits) > 0 {
+		for iNdEx := len(m.WhaleDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WhaleDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.DepositAlerts != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.DepositAlerts))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VoteAlerts != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.VoteAlerts))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LineItemVetoParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LineItemVetoParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LineItemVetoParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timelock != nil {
+		n22, err22 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.Timelock, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Timelock):])
+		if err22 != nil {
+			return 0, err22
+		}
+		i -= n22
+		i = encodeVarintGov(dAtA, i, uint64(n22))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LineItemVeto) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LineItemVeto) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LineItemVeto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n23, err23 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.VetoedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VetoedAt):])
+	if err23 != nil {
+		return 0, err23
+	}
+	i -= n23
+	i = encodeVarintGov(dAtA, i, uint64(n23))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Justification) > 0 {
+		i -= len(m.Justification)
+		copy(dAtA[i:], m.Justification)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Justification)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MessageIndex != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MessageIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EventType != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.EventType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	n24, err24 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err24 != nil {
+		return 0, err24
+	}
+	i -= n24
+	i = encodeVarintGov(dAtA, i, uint64(n24))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SecurityCouncilParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SecurityCouncilParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecurityCouncilParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotingPeriod != nil {
+		n25, err25 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err25 != nil {
+			return 0, err25
+		}
+		i -= n25
+		i = encodeVarintGov(dAtA, i, uint64(n25))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PolicyAddress) > 0 {
+		i -= len(m.PolicyAddress)
+		copy(dAtA[i:], m.PolicyAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.PolicyAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmergencyParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmergencyParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmergencyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.VotingPeriod != nil {
+		n26, err26 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err26 != nil {
+			return 0, err26
+		}
+		i -= n26
+		i = encodeVarintGov(dAtA, i, uint64(n26))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AllowedMessages) > 0 {
+		for iNdEx := len(m.AllowedMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMessages[iNdEx])
+			copy(dAtA[i:], m.AllowedMessages[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.AllowedMessages[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageBasedParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageBasedParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageBasedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.YesQuorum) > 0 {
+		i -= len(m.YesQuorum)
+		copy(dAtA[i:], m.YesQuorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.YesQuorum)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.VetoThreshold) > 0 {
+		i -= len(m.VetoThreshold)
+		copy(dAtA[i:], m.VetoThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoThreshold)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.VotingPeriod != nil {
+		n27, err27 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err27 != nil {
+			return 0, err27
+		}
+		i -= n27
+		i = encodeVarintGov(dAtA, i, uint64(n27))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NextMessageIndex != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.NextMessageIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalDraft) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalDraft) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalDraft) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x28
+	}
+	n28, err28 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err28 != nil {
+		return 0, err28
+	}
+	i -= n28
+	i = encodeVarintGov(dAtA, i, uint64(n28))
+	i--
+	dAtA[i] = 0x22
+	if m.LatestVersion != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.LatestVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalDraftVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalDraftVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalDraftVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n29, err29 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err29 != nil {
+		return 0, err29
+	}
+	i -= n29
+	i = encodeVarintGov(dAtA, i, uint64(n29))
+	i--
+	dAtA[i] = 0x42
+	if m.ProposalType != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalType))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Summary) > 0 {
+		i -= len(m.Summary)
+		copy(dAtA[i:], m.Summary)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Summary)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Version != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DraftId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.DraftId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WeightedVoteOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Option != 0 {
+		n += 1 + sovGov(uint64(m.Option))
+	}
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *Deposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGov(uint64(m.Id))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovGov(uint64(m.Status))
+	}
+	if m.FinalTallyResult != nil {
+		l = m.FinalTallyResult.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.SubmitTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.DepositEndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DepositEndTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.TotalDeposit) > 0 {
+		for _, e := range m.TotalDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.VotingStartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingStartTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.VotingEndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Summary)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Expedited {
+		n += 2
+	}
+	l = len(m.FailedReason)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.ProposalType != 0 {
+		n += 2 + sovGov(uint64(m.ProposalType))
+	}
+	if m.TimelockEndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TimelockEndTime)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.ChunkedExecution {
+		n += 3
+	}
+	if m.VoteLockoutPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VoteLockoutPeriod)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *ProposalVoteOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OptionOne)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.OptionTwo)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.OptionThree)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.OptionFour)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.OptionSpam)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *TallyResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.YesCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.AbstainCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.NoCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.NoWithVetoCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.OptionOneCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.OptionTwoCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.OptionThreeCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.OptionFourCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.SpamCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.RankedChoice != nil {
+		l = m.RankedChoice.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *RankedChoiceTally) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rounds) > 0 {
+		for _, e := range m.Rounds {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.Winner != 0 {
+		n += 1 + sovGov(uint64(m.Winner))
+	}
+	return n
+}
+
+func (m *RankedChoiceRound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OptionOneCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.OptionTwoCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.OptionThreeCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.OptionFourCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Eliminated != 0 {
+		n += 1 + sovGov(uint64(m.Eliminated))
+	}
+	return n
+}
+
+func (m *ArchivedProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGov(uint64(m.Id))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.ProposalType != 0 {
+		n += 1 + sovGov(uint64(m.ProposalType))
+	}
+	if m.Status != 0 {
+		n += 1 + sovGov(uint64(m.Status))
+	}
+	if m.FinalTallyResult != nil {
+		l = m.FinalTallyResult.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.VotingEndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.VotingEndTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.FinishedHeight != 0 {
+		n += 1 + sovGov(uint64(m.FinishedHeight))
+	}
+	return n
+}
+
+func (m *DepositRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Deposited) > 0 {
+		for _, e := range m.Deposited {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.Refunded) > 0 {
+		for _, e := range m.Refunded {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.Charged) > 0 {
+		for _, e := range m.Charged {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Vote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Ranking) > 0 {
+		l = 0
+		for _, e := range m.Ranking {
+			l += sovGov(uint64(e))
+		}
+		n += 1 + sovGov(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *DepositParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.MaxDepositPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *VotingParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *TallyParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.VetoThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.MaxDepositPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.VotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.VetoThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.MinInitialDepositRatio)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ProposalCancelRatio)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ProposalCancelDest)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.ExpeditedVotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ExpeditedThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.ExpeditedMinDeposit) > 0 {
+		for _, e := range m.ExpeditedMinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.BurnVoteQuorum {
+		n += 2
+	}
+	if m.BurnProposalDepositPrevote {
+		n += 2
+	}
+	if m.BurnVoteVeto {
+		n += 2
+	}
+	l = len(m.MinDepositRatio)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.ProposalCancelMaxPeriod)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if len(m.OptimisticAuthorizedAddresses) > 0 {
+		for _, s := range m.OptimisticAuthorizedAddresses {
+			l = len(s)
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.OptimisticRejectedThreshold)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.YesQuorum)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.SecurityCouncil != nil {
+		l = m.SecurityCouncil.Size()
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.Emergency != nil {
+		l = m.Emergency.Size()
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.ProposalArchiveRetention != 0 {
+		n += 2 + sovGov(uint64(m.ProposalArchiveRetention))
+	}
+	l = len(m.DepositBurnRatio)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.LineItemVeto != nil {
+		l = m.LineItemVeto.Size()
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if m.ExecutionGasPerBlock != 0 {
+		n += 2 + sovGov(uint64(m.ExecutionGasPerBlock))
+	}
+	if m.WhaleAlert != nil {
+		l = m.WhaleAlert.Size()
+		n += 2 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *WhaleAlertParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VoteThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.DepositThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *WhaleAlerts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	if m.VoteAlerts != 0 {
+		n += 1 + sovGov(uint64(m.VoteAlerts))
+	}
+	if m.DepositAlerts != 0 {
+		n += 1 + sovGov(uint64(m.DepositAlerts))
+	}
+	if len(m.WhaleDeposits) > 0 {
+		for _, e := range m.WhaleDeposits {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LineItemVetoParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Timelock != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Timelock)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *LineItemVeto) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	if m.MessageIndex != 0 {
+		n += 1 + sovGov(uint64(m.MessageIndex))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Justification)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VetoedAt)
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *ScheduleEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovGov(uint64(l))
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	if m.EventType != 0 {
+		n += 1 + sovGov(uint64(m.EventType))
+	}
+	return n
+}
+
+func (m *SecurityCouncilParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PolicyAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.VotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *EmergencyParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedMessages) > 0 {
+		for _, s := range m.AllowedMessages {
+			l = len(s)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.VotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *MessageBasedParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.VetoThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.YesQuorum)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *ProposalExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	if m.NextMessageIndex != 0 {
+		n += 1 + sovGov(uint64(m.NextMessageIndex))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovGov(uint64(m.GasUsed))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovGov(uint64(m.StartHeight))
+	}
+	return n
+}
+
+func (m *ProposalDraft) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGov(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.LatestVersion != 0 {
+		n += 1 + sovGov(uint64(m.LatestVersion))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovGov(uint64(l))
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *ProposalDraftVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DraftId != 0 {
+		n += 1 + sovGov(uint64(m.DraftId))
+	}
+	if m.Version != 0 {
+		n += 1 + sovGov(uint64(m.Version))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Summary)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.ProposalType != 0 {
+		n += 1 + sovGov(uint64(m.ProposalType))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Deposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types1.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalTallyResult == nil {
+				m.FinalTallyResult = &TallyResult{}
+			}
+			if err := m.FinalTallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmitTime == nil {
+				m.SubmitTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DepositEndTime == nil {
+				m.DepositEndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.DepositEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalDeposit = append(m.TotalDeposit, types.Coin{})
+			if err := m.TotalDeposit[len(m.TotalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingStartTime == nil {
+				m.VotingStartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VotingStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingEndTime == nil {
+				m.VotingEndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expedited = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalType", wireType)
+			}
+			m.ProposalType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalType |= ProposalType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimelockEndTime == nil {
+				m.TimelockEndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.TimelockEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkedExecution", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChunkedExecution = bool(v != 0)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteLockoutPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VoteLockoutPeriod == nil {
+				m.VoteLockoutPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VoteLockoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalVoteOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalVoteOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalVoteOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionOne", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionOne = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionTwo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionTwo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionThree", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionThree = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionFour", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionFour = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionSpam", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionSpam = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.YesCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AbstainCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NoCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVetoCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NoWithVetoCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionOneCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionOneCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionTwoCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionTwoCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionThreeCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionThreeCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionFourCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionFourCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpamCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpamCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RankedChoice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RankedChoice == nil {
+				m.RankedChoice = &RankedChoiceTally{}
+			}
+			if err := m.RankedChoice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RankedChoiceTally) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RankedChoiceTally: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RankedChoiceTally: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rounds = append(m.Rounds, &RankedChoiceRound{})
+			if err := m.Rounds[len(m.Rounds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
+			}
+			m.Winner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Winner |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RankedChoiceRound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RankedChoiceRound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RankedChoiceRound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionOneCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionOneCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionTwoCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionTwoCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionThreeCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionThreeCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionFourCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionFourCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eliminated", wireType)
+			}
+			m.Eliminated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Eliminated |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchivedProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivedProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivedProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalType", wireType)
+			}
+			m.ProposalType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalType |= ProposalType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalTallyResult == nil {
+				m.FinalTallyResult = &TallyResult{}
+			}
+			if err := m.FinalTallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingEndTime == nil {
+				m.VotingEndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedHeight", wireType)
+			}
+			m.FinishedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinishedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposited", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposited = append(m.Deposited, types.Coin{})
+			if err := m.Deposited[len(m.Deposited)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refunded = append(m.Refunded, types.Coin{})
+			if err := m.Refunded[len(m.Refunded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Charged", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Charged = append(m.Charged, types.Coin{})
+			if err := m.Charged[len(m.Charged)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, &WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v VoteOption
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= VoteOption(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ranking = append(m.Ranking, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGov
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGov
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Ranking) == 0 {
+					m.Ranking = make([]VoteOption, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v VoteOption
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGov
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= VoteOption(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ranking = append(m.Ranking, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ranking", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.OptimisticRejectedThreshold)
-	if l > 0 {
-		n += 2 + l + sovGov(uint64(l))
-	}
-	l = len(m.YesQuorum)
-	if l > 0 {
-		n += 2 + l + sovGov(uint64(l))
-	}
-	return n
-}
 
-func (m *MessageBasedParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.VotingPeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod)
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Quorum)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.Threshold)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.VetoThreshold)
-	if l > 0 {
-		n += 1 + l + sovGov(uint64(l))
-	}
-	l = len(m.YesQuorum)
-	if l > 0 {
-		n += 2 + l + sovGov(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGov(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGov(x uint64) (n int) {
-	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
+func (m *DepositParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2709,17 +7787,17 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
+			return fmt.Errorf("proto: DepositParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DepositParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
 			}
-			m.Option = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2729,16 +7807,31 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Option |= VoteOption(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2748,23 +7841,113 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxDepositPeriod == nil {
+				m.MaxDepositPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VotingParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VotingParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VotingParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Weight = string(dAtA[iNdEx:postIndex])
+			if m.VotingPeriod == nil {
+				m.VotingPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2787,7 +7970,7 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Deposit) Unmarshal(dAtA []byte) error {
+func (m *TallyParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2810,17 +7993,17 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: TallyParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
 			}
-			m.ProposalId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2830,14 +8013,27 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2865,13 +8061,13 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.Threshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2881,25 +8077,23 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2922,7 +8116,7 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Proposal) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2945,123 +8139,15 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Messages = append(m.Messages, &types1.Any{})
-			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= ProposalStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.FinalTallyResult == nil {
-				m.FinalTallyResult = &TallyResult{}
-			}
-			if err := m.FinalTallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3088,16 +8174,14 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SubmitTime == nil {
-				m.SubmitTime = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositEndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3124,50 +8208,16 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DepositEndTime == nil {
-				m.DepositEndTime = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.DepositEndTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.MaxDepositPeriod == nil {
+				m.MaxDepositPeriod = new(time.Duration)
 			}
-			m.TotalDeposit = append(m.TotalDeposit, types.Coin{})
-			if err := m.TotalDeposit[len(m.TotalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingStartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3194,18 +8244,18 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VotingStartTime == nil {
-				m.VotingStartTime = new(time.Time)
+			if m.VotingPeriod == nil {
+				m.VotingPeriod = new(time.Duration)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VotingStartTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3215,31 +8265,27 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VotingEndTime == nil {
-				m.VotingEndTime = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3267,11 +8313,11 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.Threshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3299,11 +8345,11 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3331,11 +8377,11 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Summary = string(dAtA[iNdEx:postIndex])
+			m.MinInitialDepositRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3363,31 +8409,11 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposer = string(dAtA[iNdEx:postIndex])
+			m.ProposalCancelRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Expedited = bool(v != 0)
-		case 15:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedReason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelDest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3415,13 +8441,13 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailedReason = string(dAtA[iNdEx:postIndex])
+			m.ProposalCancelDest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalType", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
 			}
-			m.ProposalType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3431,64 +8457,31 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalType |= ProposalType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGov
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProposalVoteOptions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.ExpeditedVotingPeriod == nil {
+				m.ExpeditedVotingPeriod = new(time.Duration)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ExpeditedVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProposalVoteOptions: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProposalVoteOptions: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionOne", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3516,13 +8509,13 @@ func (m *ProposalVoteOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OptionOne = string(dAtA[iNdEx:postIndex])
+			m.ExpeditedThreshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionTwo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedMinDeposit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3532,29 +8525,31 @@ func (m *ProposalVoteOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OptionTwo = string(dAtA[iNdEx:postIndex])
+			m.ExpeditedMinDeposit = append(m.ExpeditedMinDeposit, types.Coin{})
+			if err := m.ExpeditedMinDeposit[len(m.ExpeditedMinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionThree", wireType)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnVoteQuorum", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3564,29 +8559,17 @@ func (m *ProposalVoteOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OptionThree = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionFour", wireType)
+			m.BurnVoteQuorum = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnProposalDepositPrevote", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3596,27 +8579,35 @@ func (m *ProposalVoteOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
+			m.BurnProposalDepositPrevote = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnVoteVeto", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.OptionFour = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			m.BurnVoteVeto = bool(v != 0)
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionSpam", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDepositRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3637,68 +8628,18 @@ func (m *ProposalVoteOptions) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OptionSpam = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TallyResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDepositRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelMaxPeriod", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3726,11 +8667,11 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.YesCount = string(dAtA[iNdEx:postIndex])
+			m.ProposalCancelMaxPeriod = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticAuthorizedAddresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3758,11 +8699,11 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AbstainCount = string(dAtA[iNdEx:postIndex])
+			m.OptimisticAuthorizedAddresses = append(m.OptimisticAuthorizedAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticRejectedThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3790,11 +8731,11 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NoCount = string(dAtA[iNdEx:postIndex])
+			m.OptimisticRejectedThreshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVetoCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field YesQuorum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3822,13 +8763,13 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NoWithVetoCount = string(dAtA[iNdEx:postIndex])
+			m.YesQuorum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionOneCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityCouncil", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3838,29 +8779,33 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OptionOneCount = string(dAtA[iNdEx:postIndex])
+			if m.SecurityCouncil == nil {
+				m.SecurityCouncil = &SecurityCouncilParams{}
+			}
+			if err := m.SecurityCouncil.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionTwoCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Emergency", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3870,27 +8815,50 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OptionTwoCount = string(dAtA[iNdEx:postIndex])
+			if m.Emergency == nil {
+				m.Emergency = &EmergencyParams{}
+			}
+			if err := m.Emergency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalArchiveRetention", wireType)
+			}
+			m.ProposalArchiveRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalArchiveRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionThreeCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositBurnRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3918,13 +8886,13 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OptionThreeCount = string(dAtA[iNdEx:postIndex])
+			m.DepositBurnRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionFourCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LineItemVeto", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3934,29 +8902,52 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OptionFourCount = string(dAtA[iNdEx:postIndex])
+			if m.LineItemVeto == nil {
+				m.LineItemVeto = &LineItemVetoParams{}
+			}
+			if err := m.LineItemVeto.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionGasPerBlock", wireType)
+			}
+			m.ExecutionGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpamCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WhaleAlert", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -3966,23 +8957,27 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpamCount = string(dAtA[iNdEx:postIndex])
+			if m.WhaleAlert == nil {
+				m.WhaleAlert = &WhaleAlertParams{}
+			}
+			if err := m.WhaleAlert.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4005,7 +9000,7 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Vote) Unmarshal(dAtA []byte) error {
+func (m *WhaleAlertParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4028,17 +9023,17 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+			return fmt.Errorf("proto: WhaleAlertParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WhaleAlertParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
 			}
-			m.ProposalId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -4048,14 +9043,27 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4083,13 +9091,63 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			m.DepositThreshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WhaleAlerts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WhaleAlerts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WhaleAlerts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -4099,31 +9157,54 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGov
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteAlerts", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
+			m.VoteAlerts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteAlerts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAlerts", wireType)
 			}
-			m.Options = append(m.Options, &WeightedVoteOption{})
-			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DepositAlerts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositAlerts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WhaleDeposits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -4133,23 +9214,25 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.WhaleDeposits = append(m.WhaleDeposits, types.Coin{})
+			if err := m.WhaleDeposits[len(m.WhaleDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4172,7 +9255,7 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DepositParams) Unmarshal(dAtA []byte) error {
+func (m *LineItemVetoParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4195,17 +9278,17 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DepositParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: LineItemVetoParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DepositParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LineItemVetoParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -4215,29 +9298,27 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinDeposit = append(m.MinDeposit, types.Coin{})
-			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timelock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4264,10 +9345,10 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxDepositPeriod == nil {
-				m.MaxDepositPeriod = new(time.Duration)
+			if m.Timelock == nil {
+				m.Timelock = new(time.Duration)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.Timelock, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4292,7 +9373,7 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VotingParams) Unmarshal(dAtA []byte) error {
+func (m *LineItemVeto) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4315,17 +9396,55 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VotingParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: LineItemVeto: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VotingParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LineItemVeto: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageIndex", wireType)
+			}
+			m.MessageIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessageIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -4335,81 +9454,27 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VotingPeriod == nil {
-				m.VotingPeriod = new(time.Duration)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TallyParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TallyParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4437,11 +9502,11 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Quorum = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Justification", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4469,13 +9534,13 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Threshold = string(dAtA[iNdEx:postIndex])
+			m.Justification = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -4485,23 +9550,24 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.VetoedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4524,7 +9590,7 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ScheduleEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4547,15 +9613,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduleEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduleEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4582,16 +9648,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinDeposit = append(m.MinDeposit, types.Coin{})
-			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var msglen int
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -4601,29 +9666,113 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGov
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			m.EventType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventType |= ScheduleEventType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGov
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxDepositPeriod == nil {
-				m.MaxDepositPeriod = new(time.Duration)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SecurityCouncilParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SecurityCouncilParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SecurityCouncilParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.PolicyAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
 			}
@@ -4659,9 +9808,59 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmergencyParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmergencyParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmergencyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMessages", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4689,13 +9888,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Quorum = string(dAtA[iNdEx:postIndex])
+			m.AllowedMessages = append(m.AllowedMessages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -4705,27 +9904,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Threshold = string(dAtA[iNdEx:postIndex])
+			if m.VotingPeriod == nil {
+				m.VotingPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4753,13 +9956,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			m.Threshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageBasedParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageBasedParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialDepositRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -4769,27 +10022,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinInitialDepositRatio = string(dAtA[iNdEx:postIndex])
+			if m.VotingPeriod == nil {
+				m.VotingPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4817,11 +10074,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposalCancelRatio = string(dAtA[iNdEx:postIndex])
+			m.Quorum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelDest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4849,13 +10106,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposalCancelDest = string(dAtA[iNdEx:postIndex])
+			m.Threshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -4865,31 +10122,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpeditedVotingPeriod == nil {
-				m.ExpeditedVotingPeriod = new(time.Duration)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ExpeditedVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field YesQuorum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4917,47 +10170,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExpeditedThreshold = string(dAtA[iNdEx:postIndex])
+			m.YesQuorum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedMinDeposit", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExpeditedMinDeposit = append(m.ExpeditedMinDeposit, types.Coin{})
-			if err := m.ExpeditedMinDeposit[len(m.ExpeditedMinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 13:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnVoteQuorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var v int
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -4967,17 +10236,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.BurnVoteQuorum = bool(v != 0)
-		case 14:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnProposalDepositPrevote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextMessageIndex", wireType)
 			}
-			var v int
+			m.NextMessageIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -4987,17 +10255,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.NextMessageIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.BurnProposalDepositPrevote = bool(v != 0)
-		case 15:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnVoteVeto", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 			}
-			var v int
+			m.GasUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -5007,17 +10274,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.GasUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.BurnVoteVeto = bool(v != 0)
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDepositRatio", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			var stringLen uint64
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -5027,29 +10293,66 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGov
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinDepositRatio = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelMaxPeriod", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalDraft) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
 			}
-			var stringLen uint64
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalDraft: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalDraft: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -5059,27 +10362,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProposalCancelMaxPeriod = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 18:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticAuthorizedAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5107,13 +10397,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OptimisticAuthorizedAddresses = append(m.OptimisticAuthorizedAddresses, string(dAtA[iNdEx:postIndex]))
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticRejectedThreshold", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestVersion", wireType)
 			}
-			var stringLen uint64
+			m.LatestVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -5123,29 +10413,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LatestVersion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OptimisticRejectedThreshold = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 20:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field YesQuorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -5155,24 +10432,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.YesQuorum = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -5194,7 +10491,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
+func (m *ProposalDraftVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5217,15 +10514,53 @@ func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MessageBasedParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProposalDraftVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MessageBasedParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProposalDraftVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DraftId", wireType)
+			}
+			m.DraftId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DraftId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5252,16 +10587,14 @@ func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VotingPeriod == nil {
-				m.VotingPeriod = new(time.Duration)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			m.Messages = append(m.Messages, &types1.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5289,11 +10622,11 @@ func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Quorum = string(dAtA[iNdEx:postIndex])
+			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5321,11 +10654,11 @@ func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Threshold = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5353,13 +10686,32 @@ func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			m.Summary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 20:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalType", wireType)
+			}
+			m.ProposalType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalType |= ProposalType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field YesQuorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -5369,23 +10721,24 @@ func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.YesQuorum = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14,6 +14,8 @@ import (
 var (
 	_, _, _, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgCancelProposal{}, &MsgSubmitMultipleChoiceProposal{}
 	_, _                   codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
+	_, _, _                sdk.Msg                            = &MsgCreateProposalDraft{}, &MsgUpdateProposalDraft{}, &MsgPromoteProposalDraft{}
+	_, _                   codectypes.UnpackInterfacesMessage = &MsgCreateProposalDraft{}, &MsgUpdateProposalDraft{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal.
@@ -157,3 +159,57 @@ func (msg *MsgSudoExec) SetSudoedMsg(input sdk.Msg) (*MsgSudoExec, error) {
 
 	return msg, nil
 }
+
+// NewMsgCreateProposalDraft creates a new MsgCreateProposalDraft.
+func NewMsgCreateProposalDraft(messages []sdk.Msg, owner, metadata, title, summary string, proposalType ProposalType) (*MsgCreateProposalDraft, error) {
+	anys, err := sdktx.SetMsgs(messages)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgCreateProposalDraft{
+		Owner:        owner,
+		Messages:     anys,
+		Metadata:     metadata,
+		Title:        title,
+		Summary:      summary,
+		ProposalType: proposalType,
+	}, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgCreateProposalDraft) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, m.Messages)
+}
+
+// NewMsgUpdateProposalDraft creates a new MsgUpdateProposalDraft.
+func NewMsgUpdateProposalDraft(draftID uint64, messages []sdk.Msg, owner, metadata, title, summary string, proposalType ProposalType) (*MsgUpdateProposalDraft, error) {
+	anys, err := sdktx.SetMsgs(messages)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgUpdateProposalDraft{
+		Owner:        owner,
+		DraftId:      draftID,
+		Messages:     anys,
+		Metadata:     metadata,
+		Title:        title,
+		Summary:      summary,
+		ProposalType: proposalType,
+	}, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgUpdateProposalDraft) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, m.Messages)
+}
+
+// NewMsgPromoteProposalDraft creates a new MsgPromoteProposalDraft.
+func NewMsgPromoteProposalDraft(draftID uint64, owner string, initialDeposit sdk.Coins) *MsgPromoteProposalDraft {
+	return &MsgPromoteProposalDraft{
+		Owner:          owner,
+		DraftId:        draftID,
+		InitialDeposit: initialDeposit,
+	}
+}
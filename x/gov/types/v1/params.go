@@ -2,6 +2,7 @@ package v1
 
 import (
 	"fmt"
+	"slices"
 	"time"
 
 	"cosmossdk.io/core/address"
@@ -14,6 +15,9 @@ import (
 const (
 	DefaultPeriod                         time.Duration = time.Hour * 24 * 2 // 2 days
 	DefaultExpeditedPeriod                time.Duration = time.Hour * 24 * 1 // 1 day
+	DefaultSecurityCouncilPeriod          time.Duration = time.Hour * 1      // 1 hour
+	DefaultEmergencyPeriod                time.Duration = time.Hour * 1      // 1 hour
+	DefaultLineItemVetoTimelock           time.Duration = time.Hour * 24 * 1 // 1 day
 	DefaultMinExpeditedDepositTokensRatio               = 5
 )
 
@@ -36,6 +40,8 @@ var (
 	DefaultMinDepositRatio              = sdkmath.LegacyMustNewDecFromStr("0.01")
 	DefaultOptimisticRejectedThreshold  = sdkmath.LegacyMustNewDecFromStr("0.1")
 	DefaultOptimisticAuthorizedAddreses = []string(nil)
+	DefaultEmergencyThreshold           = sdkmath.LegacyNewDecWithPrec(667, 3)
+	DefaultDepositBurnRatio             = sdkmath.LegacyOneDec()
 )
 
 // NewParams creates a new Params instance with given values.
@@ -70,7 +76,7 @@ func NewParams(
 
 // DefaultParams returns the default governance params
 func DefaultParams() Params {
-	return NewParams(
+	params := NewParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinExpeditedDepositTokens)),
 		DefaultPeriod,
@@ -92,6 +98,31 @@ func DefaultParams() Params {
 		DefaultOptimisticRejectedThreshold.String(),
 		DefaultOptimisticAuthorizedAddreses,
 	)
+	params.SecurityCouncil = DefaultSecurityCouncilParams()
+	params.Emergency = DefaultEmergencyParams()
+	params.LineItemVeto = DefaultLineItemVetoParams()
+	params.DepositBurnRatio = DefaultDepositBurnRatio.String()
+	params.WhaleAlert = DefaultWhaleAlertParams()
+
+	return params
+}
+
+// DefaultSecurityCouncilParams returns the default security council params.
+// The security council track is disabled by default as no policy address is set.
+func DefaultSecurityCouncilParams() *SecurityCouncilParams {
+	votingPeriod := DefaultSecurityCouncilPeriod
+	return &SecurityCouncilParams{
+		VotingPeriod: &votingPeriod,
+	}
+}
+
+// DefaultLineItemVetoParams returns the default line-item veto params.
+// The line-item veto is disabled by default as no authority is set.
+func DefaultLineItemVetoParams() *LineItemVetoParams {
+	timelock := DefaultLineItemVetoTimelock
+	return &LineItemVetoParams{
+		Timelock: &timelock,
+	}
 }
 
 // ValidateBasic performs basic validation on governance parameters.
@@ -242,6 +273,20 @@ func (p Params) ValidateBasic(addressCodec address.Codec) error {
 		return fmt.Errorf("max cancel period of cancel proposal is too large: %s", proposalCancelMaxPeriod)
 	}
 
+	// an empty deposit burn ratio defaults to burning the deposits in full
+	if p.DepositBurnRatio != "" {
+		depositBurnRatio, err := sdkmath.LegacyNewDecFromStr(p.DepositBurnRatio)
+		if err != nil {
+			return fmt.Errorf("invalid deposit burn ratio: %w", err)
+		}
+		if depositBurnRatio.IsNegative() {
+			return fmt.Errorf("deposit burn ratio must be positive: %s", depositBurnRatio)
+		}
+		if depositBurnRatio.GT(sdkmath.LegacyOneDec()) {
+			return fmt.Errorf("deposit burn ratio is too large: %s", depositBurnRatio)
+		}
+	}
+
 	if len(p.ProposalCancelDest) != 0 {
 		_, err := sdk.AccAddressFromBech32(p.ProposalCancelDest)
 		if err != nil {
@@ -249,9 +294,130 @@ func (p Params) ValidateBasic(addressCodec address.Codec) error {
 		}
 	}
 
+	if p.SecurityCouncil != nil {
+		if err := p.SecurityCouncil.ValidateBasic(addressCodec); err != nil {
+			return err
+		}
+	}
+
+	if p.Emergency != nil {
+		if err := p.Emergency.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	if p.LineItemVeto != nil {
+		if err := p.LineItemVeto.ValidateBasic(addressCodec); err != nil {
+			return err
+		}
+	}
+
+	if p.WhaleAlert != nil {
+		if err := p.WhaleAlert.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// GetDepositBurnRatioOrDefault returns the deposit burn ratio, defaulting to
+// burning the deposits in full if it is not set.
+func (p Params) GetDepositBurnRatioOrDefault() sdkmath.LegacyDec {
+	if p.DepositBurnRatio == "" {
+		return DefaultDepositBurnRatio
+	}
+	return sdkmath.LegacyMustNewDecFromStr(p.DepositBurnRatio)
+}
+
+// DefaultWhaleAlertParams returns the default whale alert params.
+// The whale alerts are disabled by default as no threshold is set.
+func DefaultWhaleAlertParams() *WhaleAlertParams {
+	return &WhaleAlertParams{
+		VoteThreshold:    sdkmath.LegacyZeroDec().String(),
+		DepositThreshold: sdkmath.LegacyZeroDec().String(),
+	}
+}
+
+// ValidateBasic performs basic validation on whale alert parameters.
+func (p WhaleAlertParams) ValidateBasic() error {
+	if p.VoteThreshold != "" {
+		voteThreshold, err := sdkmath.LegacyNewDecFromStr(p.VoteThreshold)
+		if err != nil {
+			return fmt.Errorf("invalid whale vote threshold string: %w", err)
+		}
+		if voteThreshold.IsNegative() {
+			return fmt.Errorf("whale vote threshold cannot be negative: %s", voteThreshold)
+		}
+		if voteThreshold.GT(sdkmath.LegacyOneDec()) {
+			return fmt.Errorf("whale vote threshold too large: %s", voteThreshold)
+		}
+	}
+
+	if p.DepositThreshold != "" {
+		depositThreshold, err := sdkmath.LegacyNewDecFromStr(p.DepositThreshold)
+		if err != nil {
+			return fmt.Errorf("invalid whale deposit threshold string: %w", err)
+		}
+		if depositThreshold.IsNegative() {
+			return fmt.Errorf("whale deposit threshold cannot be negative: %s", depositThreshold)
+		}
+	}
+
+	return nil
+}
+
+// GetVoteThresholdOrZero returns the whale vote threshold, zero meaning that the whale vote alerts are disabled.
+func (p *WhaleAlertParams) GetVoteThresholdOrZero() sdkmath.LegacyDec {
+	if p == nil || p.VoteThreshold == "" {
+		return sdkmath.LegacyZeroDec()
+	}
+	return sdkmath.LegacyMustNewDecFromStr(p.VoteThreshold)
+}
+
+// GetDepositThresholdOrZero returns the whale deposit threshold, zero meaning that the whale deposit alerts are disabled.
+func (p *WhaleAlertParams) GetDepositThresholdOrZero() sdkmath.LegacyDec {
+	if p == nil || p.DepositThreshold == "" {
+		return sdkmath.LegacyZeroDec()
+	}
+	return sdkmath.LegacyMustNewDecFromStr(p.DepositThreshold)
+}
+
+// DefaultEmergencyParams returns the default emergency params.
+// Emergency proposals are disabled by default as no message is allowed.
+func DefaultEmergencyParams() *EmergencyParams {
+	votingPeriod := DefaultEmergencyPeriod
+	return &EmergencyParams{
+		VotingPeriod: &votingPeriod,
+		Threshold:    DefaultEmergencyThreshold.String(),
+	}
+}
+
+// ValidateBasic performs basic validation on security council parameters.
+func (p SecurityCouncilParams) ValidateBasic(addressCodec address.Codec) error {
+	if p.PolicyAddress == "" {
+		return nil
+	}
+
+	if _, err := addressCodec.StringToBytes(p.PolicyAddress); err != nil {
+		return fmt.Errorf("invalid security council policy address: %s", p.PolicyAddress)
+	}
+
+	if p.VotingPeriod == nil {
+		return fmt.Errorf("security council voting period must not be nil: %d", p.VotingPeriod)
+	}
+	if p.VotingPeriod.Seconds() <= 0 {
+		return fmt.Errorf("security council voting period must be positive: %s", p.VotingPeriod)
+	}
+
+	return nil
+}
+
+// IsEnabled returns true if a security council policy address is configured.
+func (p *SecurityCouncilParams) IsEnabled() bool {
+	return p != nil && p.PolicyAddress != ""
+}
+
 // ValidateBasic performs basic validation on governance parameters.
 func (p MessageBasedParams) ValidateBasic() error {
 	if p.VotingPeriod == nil {
@@ -307,3 +473,76 @@ func (p MessageBasedParams) ValidateBasic() error {
 
 	return nil
 }
+
+// ValidateBasic performs basic validation on emergency parameters.
+func (p EmergencyParams) ValidateBasic() error {
+	if len(p.AllowedMessages) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]struct{}, len(p.AllowedMessages))
+	for _, msgURL := range p.AllowedMessages {
+		if msgURL == "" {
+			return fmt.Errorf("emergency allowed message type url cannot be empty")
+		}
+		if _, ok := seen[msgURL]; ok {
+			return fmt.Errorf("duplicate emergency allowed message type url: %s", msgURL)
+		}
+		seen[msgURL] = struct{}{}
+	}
+
+	if p.VotingPeriod == nil {
+		return fmt.Errorf("emergency voting period must not be nil: %d", p.VotingPeriod)
+	}
+	if p.VotingPeriod.Seconds() <= 0 {
+		return fmt.Errorf("emergency voting period must be positive: %s", p.VotingPeriod)
+	}
+
+	threshold, err := sdkmath.LegacyNewDecFromStr(p.Threshold)
+	if err != nil {
+		return fmt.Errorf("invalid emergency threshold string: %w", err)
+	}
+	if threshold.LTE(sdkmath.LegacyNewDecWithPrec(5, 1)) {
+		return fmt.Errorf("emergency vote threshold must be a supermajority: %s", threshold)
+	}
+	if threshold.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("emergency vote threshold too large: %s", threshold)
+	}
+
+	return nil
+}
+
+// IsEnabled returns true if at least one message type is allowed in emergency proposals.
+func (p *EmergencyParams) IsEnabled() bool {
+	return p != nil && len(p.AllowedMessages) > 0
+}
+
+// IsMessageAllowed returns true if the given message type url is allowed in emergency proposals.
+func (p *EmergencyParams) IsMessageAllowed(msgURL string) bool {
+	return p.IsEnabled() && slices.Contains(p.AllowedMessages, msgURL)
+}
+
+// ValidateBasic performs basic validation on line-item veto parameters.
+func (p LineItemVetoParams) ValidateBasic(addressCodec address.Codec) error {
+	if p.Authority == "" {
+		return nil
+	}
+
+	if _, err := addressCodec.StringToBytes(p.Authority); err != nil {
+		return fmt.Errorf("invalid line-item veto authority: %s", p.Authority)
+	}
+
+	if p.Timelock == nil {
+		return fmt.Errorf("line-item veto timelock must not be nil: %d", p.Timelock)
+	}
+	if p.Timelock.Seconds() <= 0 {
+		return fmt.Errorf("line-item veto timelock must be positive: %s", p.Timelock)
+	}
+
+	return nil
+}
+
+// IsEnabled returns true if a line-item veto authority is configured.
+func (p *LineItemVetoParams) IsEnabled() bool {
+	return p != nil && p.Authority != ""
+}
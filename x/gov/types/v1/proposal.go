@@ -20,6 +20,8 @@ const (
 	StatusPassed        = ProposalStatus_PROPOSAL_STATUS_PASSED
 	StatusRejected      = ProposalStatus_PROPOSAL_STATUS_REJECTED
 	StatusFailed        = ProposalStatus_PROPOSAL_STATUS_FAILED
+	StatusTimelock      = ProposalStatus_PROPOSAL_STATUS_TIMELOCK
+	StatusExecuting     = ProposalStatus_PROPOSAL_STATUS_EXECUTING
 )
 
 // NewProposal creates a new Proposal instance
@@ -67,7 +69,7 @@ func (p Proposal) GetMsgs() ([]sdk.Msg, error) {
 // the proposal is expedited. Otherwise, returns the regular min deposit from
 // gov params.
 func (p Proposal) GetMinDepositFromParams(params Params) sdk.Coins {
-	if p.Expedited {
+	if p.Expedited || p.ProposalType == ProposalType_PROPOSAL_TYPE_EMERGENCY {
 		return params.ExpeditedMinDeposit
 	}
 	return params.MinDeposit
@@ -136,7 +138,9 @@ func ValidProposalStatus(status ProposalStatus) bool {
 		status == StatusVotingPeriod ||
 		status == StatusPassed ||
 		status == StatusRejected ||
-		status == StatusFailed {
+		status == StatusFailed ||
+		status == StatusTimelock ||
+		status == StatusExecuting {
 		return true
 	}
 	return false
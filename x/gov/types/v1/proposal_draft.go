@@ -0,0 +1,19 @@
+package v1
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var _ codectypes.UnpackInterfacesMessage = ProposalDraftVersion{}
+
+// GetMsgs unpacks the messages of the draft version.
+func (v ProposalDraftVersion) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(v.Messages, "sdk.MsgProposal")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (v ProposalDraftVersion) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, v.Messages)
+}
@@ -13,6 +13,7 @@ type ValidatorGovInfo struct {
 	DelegatorShares     math.LegacyDec      // Total outstanding delegator shares
 	DelegatorDeductions math.LegacyDec      // Delegator deductions from validator's delegators voting independently
 	Vote                WeightedVoteOptions // Vote of the validator
+	Ranking             []VoteOption        // Ranked ballot of the validator on a multiple choice proposal
 }
 
 // NewValidatorGovInfo creates a ValidatorGovInfo instance
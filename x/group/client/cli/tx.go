@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/version"
 )
 
@@ -41,6 +42,8 @@ func TxCmd(name string) *cobra.Command {
 		MsgCreateGroupPolicyCmd(),
 		MsgUpdateGroupPolicyDecisionPolicyCmd(),
 		MsgSubmitProposalCmd(),
+		MsgSubmitVotesBatchCmd(),
+		NewCmdSignVote(),
 		NewCmdDraftProposal(),
 	)
 
@@ -448,6 +451,8 @@ Parameters:
 	"title": "My proposal",
 	"summary": "This is a proposal to send 10 stake to cosmos1...",
 	"proposers": ["cosmos1...", "cosmos1..."],
+	// optional, executes the messages again every N epochs until cancelled
+	"recurrence_epochs": 30,
 }
 
 metadata example: 
@@ -500,6 +505,7 @@ metadata example:
 			if err != nil {
 				return err
 			}
+			msg.RecurrenceEpochs = prop.RecurrenceEpochs
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -510,3 +516,121 @@ metadata example:
 
 	return cmd
 }
+
+// NewCmdSignVote creates a CLI command signing a vote off-chain, to be submitted
+// by a relayer with Msg/SubmitVotesBatch.
+func NewCmdSignVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-vote [proposal-id] [voter] [vote-option] [metadata]",
+		Short: "Sign a vote on a proposal off-chain, to be submitted by a relayer",
+		Long: `Sign a vote on a proposal off-chain and print the signed vote, to be submitted by a relayer with submit-votes-batch.
+Note, the '--from' flag is ignored as it is implied from [voter]. The vote is signed for the chain given by the '--chain-id' flag.
+Parameters:
+	proposal-id: unique ID of the proposal
+	voter: voter account address
+	vote-option: choice of the voter
+		VOTE_OPTION_NO: no
+		VOTE_OPTION_YES: yes
+		VOTE_OPTION_ABSTAIN: abstain
+		VOTE_OPTION_NO_WITH_VETO: no-with-veto
+	metadata: metadata for the vote`,
+		Example: fmt.Sprintf(`%s tx group sign-vote 1 cosmos1... VOTE_OPTION_YES "" --chain-id my-chain > vote.json`, version.AppName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cmd.Flags().Set(flags.FlagFrom, args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.ChainID == "" {
+				return errors.New("the chain ID is required to sign a vote")
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			option, err := group.VoteOptionFromString(args[2])
+			if err != nil {
+				return err
+			}
+
+			voter := clientCtx.GetFromAddress().String()
+			vote := group.SignedVote{ProposalId: proposalID, Voter: voter, Option: option, Metadata: args[3]}
+			signature, pubKey, err := clientCtx.Keyring.SignByAddress(clientCtx.GetFromAddress(), vote.SignBytes(clientCtx.ChainID), signing.SignMode_SIGN_MODE_DIRECT)
+			if err != nil {
+				return err
+			}
+
+			vote, err = group.NewSignedVote(proposalID, voter, option, args[3], pubKey, signature)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&vote)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// MsgSubmitVotesBatchCmd creates a CLI command for Msg/SubmitVotesBatch.
+//
+// This command is being handled better here, not converting to autocli
+func MsgSubmitVotesBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-votes-batch [relayer] [signed-votes-json-file]",
+		Short: "Submit a batch of votes signed off-chain by the voters",
+		Long: `Submit a batch of votes signed off-chain by the voters with sign-vote, paying the fees on their behalf.
+Note, the '--from' flag is ignored as it is implied from [relayer].`,
+		Example: fmt.Sprintf(`
+%s tx group submit-votes-batch [relayer] votes.json
+
+Where votes.json contains the signed votes output by sign-vote:
+
+{
+	"votes": [
+		{
+			"proposal_id": "1",
+			"voter": "cosmos1...",
+			"option": "VOTE_OPTION_YES",
+			"metadata": "",
+			"pub_key": {"@type": "/cosmos.crypto.secp256k1.PubKey", "key": "..."},
+			"signature": "..."
+		}
+	]
+}`, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cmd.Flags().Set(flags.FlagFrom, args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseSignedVotes(clientCtx.Codec, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg.Relayer = clientCtx.GetFromAddress().String()
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -814,3 +814,55 @@ func (s *CLITestSuite) TestTxSubmitProposal() {
 		})
 	}
 }
+
+func (s *CLITestSuite) TestTxSignVoteAndSubmitVotesBatch() {
+	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 2)
+	voter, relayer := accounts[0].Address, accounts[1].Address
+
+	out, err := clitestutil.ExecTestCLICmd(s.clientCtx, groupcli.NewCmdSignVote(), []string{"1", voter.String(), "VOTE_OPTION_YES", validMetadata})
+	s.Require().NoError(err, out.String())
+
+	var vote group.SignedVote
+	s.Require().NoError(s.clientCtx.Codec.UnmarshalJSON(out.Bytes(), &vote), out.String())
+	s.Require().Equal(voter.String(), vote.Voter)
+	pubKey, err := vote.GetPubKeyValue()
+	s.Require().NoError(err)
+	s.Require().True(pubKey.VerifySignature(vote.SignBytes("test-chain"), vote.Signature))
+
+	_, err = clitestutil.ExecTestCLICmd(s.clientCtx, groupcli.NewCmdSignVote(), []string{"1", voter.String(), "VOTE_OPTION_MAYBE", ""})
+	s.Require().ErrorContains(err, "not a valid vote option")
+
+	votesFile := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf(`{"votes": [%s]}`, out.String()))
+	emptyVotesFile := testutil.WriteToNewTempFile(s.T(), `{"votes": []}`)
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErrMsg string
+	}{
+		{
+			"correct data",
+			append([]string{relayer.String(), votesFile.Name()}, s.commonFlags...),
+			"",
+		},
+		{
+			"no signed votes",
+			append([]string{relayer.String(), emptyVotesFile.Name()}, s.commonFlags...),
+			"no signed votes",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			out, err := clitestutil.ExecTestCLICmd(s.clientCtx, groupcli.MsgSubmitVotesBatchCmd(), tc.args)
+			if tc.expectErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expectErrMsg)
+			} else {
+				s.Require().NoError(err, out.String())
+				txResp := sdk.TxResponse{}
+				s.Require().NoError(s.clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+			}
+		})
+	}
+}
@@ -52,6 +52,25 @@ func parseMembers(membersFile string) ([]group.MemberRequest, error) {
 	return members.Members, nil
 }
 
+// parseSignedVotes reads and parses the votes signed off-chain.
+func parseSignedVotes(cdc codec.Codec, votesFile string) (*group.MsgSubmitVotesBatch, error) {
+	contents, err := os.ReadFile(votesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var msg group.MsgSubmitVotesBatch
+	if err := cdc.UnmarshalJSON(contents, &msg); err != nil {
+		return nil, fmt.Errorf("failed to parse signed votes: %w", err)
+	}
+
+	if len(msg.Votes) == 0 {
+		return nil, fmt.Errorf("no signed votes in %s", votesFile)
+	}
+
+	return &msg, nil
+}
+
 func execFromString(execStr string) group.Exec {
 	exec := group.Exec_EXEC_UNSPECIFIED
 	if execStr == ExecTry {
@@ -70,6 +89,8 @@ type Proposal struct {
 	Proposers []string          `json:"proposers"`
 	Title     string            `json:"title"`
 	Summary   string            `json:"summary"`
+	// RecurrenceEpochs, when non zero, makes the proposal recurring.
+	RecurrenceEpochs uint64 `json:"recurrence_epochs,omitempty"`
 }
 
 func getCLIProposal(path string) (Proposal, error) {
@@ -25,11 +25,15 @@ func RegisterLegacyAminoCodec(cdc *codectypes.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateGroupPolicyAdmin{}, "cosmos-sdk/MsgUpdateGroupPolicyAdmin")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateGroupPolicyDecisionPolicy{}, "cosmos-sdk/MsgUpdateGroupDecisionPolicy")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateGroupPolicyMetadata{}, "cosmos-sdk/MsgUpdateGroupPolicyMetadata")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateGroupPolicyVoteReceipts{}, "cosmos-sdk/MsgUpdateGroupPolicyReceipts")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateGroupPolicyExecutionFeeLimit{}, "cosmos-sdk/MsgUpdateGroupPolicyFeeLimit")
 	legacy.RegisterAminoMsg(cdc, &MsgSubmitProposal{}, "cosmos-sdk/group/MsgSubmitProposal")
 	legacy.RegisterAminoMsg(cdc, &MsgWithdrawProposal{}, "cosmos-sdk/group/MsgWithdrawProposal")
 	legacy.RegisterAminoMsg(cdc, &MsgVote{}, "cosmos-sdk/group/MsgVote")
+	legacy.RegisterAminoMsg(cdc, &MsgSubmitVotesBatch{}, "cosmos-sdk/group/MsgSubmitVotesBatch")
 	legacy.RegisterAminoMsg(cdc, &MsgExec{}, "cosmos-sdk/group/MsgExec")
 	legacy.RegisterAminoMsg(cdc, &MsgLeaveGroup{}, "cosmos-sdk/group/MsgLeaveGroup")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelRecurringProposal{}, "cosmos-sdk/group/MsgCancelRecurrence")
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry.
@@ -44,11 +48,15 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateGroupPolicyAdmin{},
 		&MsgUpdateGroupPolicyDecisionPolicy{},
 		&MsgUpdateGroupPolicyMetadata{},
+		&MsgUpdateGroupPolicyVoteReceipts{},
+		&MsgUpdateGroupPolicyExecutionFeeLimit{},
 		&MsgSubmitProposal{},
 		&MsgWithdrawProposal{},
 		&MsgVote{},
+		&MsgSubmitVotesBatch{},
 		&MsgExec{},
 		&MsgLeaveGroup{},
+		&MsgCancelRecurringProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -20,14 +20,26 @@ type Config struct {
 	// summary field
 	// Defaults to 10200 if not explicitly set.
 	MaxProposalSummaryLen uint64
+
+	// RecurringProposalEpoch defines the length of an epoch used to schedule
+	// the executions of recurring proposals.
+	// Defaults to one day if not explicitly set.
+	RecurringProposalEpoch time.Duration
+
+	// MaxRecurringProposalExecutions defines the max number of recurring
+	// proposals executed in a block, the others being executed in the next blocks.
+	// Defaults to 100 if not explicitly set.
+	MaxRecurringProposalExecutions uint64
 }
 
 // DefaultConfig returns the default config for group.
 func DefaultConfig() Config {
 	return Config{
-		MaxExecutionPeriod:    2 * time.Hour * 24 * 7, // Two weeks.
-		MaxMetadataLen:        255,
-		MaxProposalTitleLen:   255,
-		MaxProposalSummaryLen: 10200,
+		MaxExecutionPeriod:             2 * time.Hour * 24 * 7, // Two weeks.
+		MaxMetadataLen:                 255,
+		MaxProposalTitleLen:            255,
+		MaxProposalSummaryLen:          10200,
+		RecurringProposalEpoch:         time.Hour * 24, // One day.
+		MaxRecurringProposalExecutions: 100,
 	}
 }
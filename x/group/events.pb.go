@@ -528,6 +528,196 @@ func (m *EventProposalPruned) GetTallyResult() *TallyResult {
 	return nil
 }
 
+// EventRecurringProposalExecuted is an event emitted when the messages of a recurring proposal are executed.
+//
+// Since: x/group 1.0.0
+type EventRecurringProposalExecuted struct {
+	// proposal_id is the unique ID of the proposal the recurrence originates from.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// result is the recurrence execution result.
+	Result ProposalExecutorResult `protobuf:"varint,2,opt,name=result,proto3,enum=cosmos.group.v1.ProposalExecutorResult" json:"result,omitempty"`
+	// logs contains error logs in case the execution result is FAILURE.
+	Logs string `protobuf:"bytes,3,opt,name=logs,proto3" json:"logs,omitempty"`
+}
+
+func (m *EventRecurringProposalExecuted) Reset()         { *m = EventRecurringProposalExecuted{} }
+func (m *EventRecurringProposalExecuted) String() string { return proto.CompactTextString(m) }
+func (*EventRecurringProposalExecuted) ProtoMessage()    {}
+func (*EventRecurringProposalExecuted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8d753981546f032, []int{10}
+}
+func (m *EventRecurringProposalExecuted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRecurringProposalExecuted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRecurringProposalExecuted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRecurringProposalExecuted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecurringProposalExecuted.Merge(m, src)
+}
+func (m *EventRecurringProposalExecuted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRecurringProposalExecuted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecurringProposalExecuted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRecurringProposalExecuted proto.InternalMessageInfo
+
+func (m *EventRecurringProposalExecuted) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *EventRecurringProposalExecuted) GetResult() ProposalExecutorResult {
+	if m != nil {
+		return m.Result
+	}
+	return PROPOSAL_EXECUTOR_RESULT_UNSPECIFIED
+}
+
+func (m *EventRecurringProposalExecuted) GetLogs() string {
+	if m != nil {
+		return m.Logs
+	}
+	return ""
+}
+
+// EventRecurringProposalCancelled is an event emitted when the recurrence of a proposal is cancelled.
+//
+// Since: x/group 1.0.0
+type EventRecurringProposalCancelled struct {
+	// proposal_id is the unique ID of the proposal the recurrence originates from.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *EventRecurringProposalCancelled) Reset()         { *m = EventRecurringProposalCancelled{} }
+func (m *EventRecurringProposalCancelled) String() string { return proto.CompactTextString(m) }
+func (*EventRecurringProposalCancelled) ProtoMessage()    {}
+func (*EventRecurringProposalCancelled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8d753981546f032, []int{11}
+}
+func (m *EventRecurringProposalCancelled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRecurringProposalCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRecurringProposalCancelled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRecurringProposalCancelled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRecurringProposalCancelled.Merge(m, src)
+}
+func (m *EventRecurringProposalCancelled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRecurringProposalCancelled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRecurringProposalCancelled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRecurringProposalCancelled proto.InternalMessageInfo
+
+func (m *EventRecurringProposalCancelled) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// EventMemberTally is an event emitted for each vote counted in the final tally of a proposal
+// whose group policy stores vote receipts.
+//
+// Since: x/group 1.0.0
+type EventMemberTally struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// voter is the account address of the voter.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// option is the voter's choice on the proposal.
+	Option VoteOption `protobuf:"varint,3,opt,name=option,proto3,enum=cosmos.group.v1.VoteOption" json:"option,omitempty"`
+	// weight is the voter's weight counted in the tally.
+	Weight string `protobuf:"bytes,4,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *EventMemberTally) Reset()         { *m = EventMemberTally{} }
+func (m *EventMemberTally) String() string { return proto.CompactTextString(m) }
+func (*EventMemberTally) ProtoMessage()    {}
+func (*EventMemberTally) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8d753981546f032, []int{12}
+}
+func (m *EventMemberTally) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMemberTally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMemberTally.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMemberTally) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMemberTally.Merge(m, src)
+}
+func (m *EventMemberTally) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMemberTally) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMemberTally.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMemberTally proto.InternalMessageInfo
+
+func (m *EventMemberTally) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *EventMemberTally) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *EventMemberTally) GetOption() VoteOption {
+	if m != nil {
+		return m.Option
+	}
+	return VOTE_OPTION_UNSPECIFIED
+}
+
+func (m *EventMemberTally) GetWeight() string {
+	if m != nil {
+		return m.Weight
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventCreateGroup)(nil), "cosmos.group.v1.EventCreateGroup")
 	proto.RegisterType((*EventUpdateGroup)(nil), "cosmos.group.v1.EventUpdateGroup")
@@ -539,40 +729,48 @@ func init() {
 	proto.RegisterType((*EventExec)(nil), "cosmos.group.v1.EventExec")
 	proto.RegisterType((*EventLeaveGroup)(nil), "cosmos.group.v1.EventLeaveGroup")
 	proto.RegisterType((*EventProposalPruned)(nil), "cosmos.group.v1.EventProposalPruned")
+	proto.RegisterType((*EventRecurringProposalExecuted)(nil), "cosmos.group.v1.EventRecurringProposalExecuted")
+	proto.RegisterType((*EventRecurringProposalCancelled)(nil), "cosmos.group.v1.EventRecurringProposalCancelled")
+	proto.RegisterType((*EventMemberTally)(nil), "cosmos.group.v1.EventMemberTally")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/events.proto", fileDescriptor_e8d753981546f032) }
 
 var fileDescriptor_e8d753981546f032 = []byte{
-	// 433 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4f, 0x8b, 0xda, 0x40,
-	0x18, 0xc6, 0x1d, 0x2b, 0x5a, 0xc7, 0x52, 0xcb, 0xf4, 0x0f, 0xd1, 0x4a, 0x14, 0x2f, 0xf5, 0x50,
-	0x13, 0xb4, 0xd0, 0xf6, 0x26, 0xb5, 0x48, 0x11, 0x3c, 0x48, 0xec, 0x1f, 0xe8, 0xc5, 0x46, 0x67,
-	0xb0, 0xa1, 0xd1, 0x09, 0x33, 0x93, 0x54, 0x8f, 0xfb, 0x0d, 0xf6, 0xa3, 0xec, 0x61, 0x3f, 0xc4,
-	0x1e, 0x65, 0x4f, 0x7b, 0x5c, 0xf4, 0x8b, 0x2c, 0x99, 0x4c, 0x54, 0x5c, 0x96, 0x08, 0x7b, 0x9b,
-	0x99, 0xf7, 0xf7, 0x3c, 0x79, 0x9f, 0x79, 0x33, 0xb0, 0x32, 0xa5, 0x7c, 0x4e, 0xb9, 0x39, 0x63,
-	0xd4, 0xf7, 0xcc, 0xa0, 0x65, 0x92, 0x80, 0x2c, 0x04, 0x37, 0x3c, 0x46, 0x05, 0x45, 0xc5, 0xa8,
-	0x6a, 0xc8, 0xaa, 0x11, 0xb4, 0xca, 0xa5, 0xe8, 0x60, 0x2c, 0xcb, 0xa6, 0xaa, 0xca, 0x4d, 0xf9,
-	0xed, 0xb1, 0x93, 0x58, 0x79, 0x44, 0x15, 0xeb, 0x4d, 0xf8, 0xa2, 0x17, 0x1a, 0x7f, 0x65, 0xc4,
-	0x16, 0xe4, 0x5b, 0x88, 0xa0, 0x12, 0x7c, 0x2a, 0xd9, 0xb1, 0x83, 0x35, 0x50, 0x03, 0x8d, 0x8c,
-	0x95, 0x93, 0xfb, 0x3e, 0xde, 0xe1, 0x3f, 0x3c, 0x7c, 0x0a, 0x3e, 0x80, 0x6f, 0x8e, 0xdd, 0x87,
-	0xd4, 0x75, 0xa6, 0x2b, 0xd4, 0x86, 0x39, 0x1b, 0x63, 0x46, 0x38, 0x97, 0x9a, 0x7c, 0x57, 0xbb,
-	0xbe, 0x6c, 0xbe, 0x52, 0x7d, 0x7f, 0x89, 0x2a, 0x23, 0xc1, 0x9c, 0xc5, 0xcc, 0x8a, 0xc1, 0x9d,
-	0xdb, 0xc1, 0xc7, 0x1f, 0xe1, 0xf6, 0x11, 0xbe, 0x94, 0x6e, 0x23, 0x7f, 0x32, 0x77, 0xc4, 0x90,
-	0x51, 0x8f, 0x72, 0xdb, 0x45, 0x55, 0x58, 0xf0, 0xd4, 0x7a, 0x1f, 0x08, 0xc6, 0x47, 0x7d, 0x5c,
-	0xff, 0x0c, 0x5f, 0x4b, 0xdd, 0x2f, 0x47, 0xfc, 0xc5, 0xcc, 0xfe, 0x7f, 0xba, 0xf2, 0x3d, 0xcc,
-	0x4b, 0xe5, 0x4f, 0x2a, 0x48, 0x32, 0x7d, 0x06, 0x14, 0xde, 0x5b, 0x92, 0x69, 0x22, 0x8e, 0x3a,
-	0x30, 0xcb, 0x08, 0xf7, 0x5d, 0xa1, 0xa5, 0x6b, 0xa0, 0xf1, 0xbc, 0xfd, 0xce, 0x38, 0xfa, 0x45,
-	0x8c, 0xb8, 0xd1, 0xd0, 0xcf, 0x17, 0x94, 0x59, 0x12, 0xb7, 0x94, 0x0c, 0x21, 0x98, 0x71, 0xe9,
-	0x8c, 0x6b, 0x4f, 0xc2, 0x0b, 0xb4, 0xe4, 0xba, 0xfe, 0x07, 0x16, 0x65, 0x0b, 0x03, 0x62, 0x07,
-	0x89, 0xd3, 0x3e, 0x9c, 0x42, 0xfa, 0xd4, 0x29, 0x5c, 0x00, 0x35, 0x86, 0xb8, 0xbb, 0x21, 0xf3,
-	0x17, 0x04, 0x27, 0xe7, 0xfd, 0x04, 0xb3, 0x5c, 0xd8, 0xc2, 0xe7, 0x2a, 0x6f, 0xf5, 0xc1, 0xbc,
-	0x23, 0x89, 0x59, 0x0a, 0x47, 0x1d, 0xf8, 0x4c, 0xd8, 0xae, 0xbb, 0x1a, 0xab, 0xeb, 0x0a, 0xf3,
-	0x16, 0xda, 0x95, 0x7b, 0xf2, 0xef, 0x21, 0xa4, 0xee, 0xa8, 0x20, 0xf6, 0x9b, 0xae, 0x71, 0xb5,
-	0xd1, 0xc1, 0x7a, 0xa3, 0x83, 0xdb, 0x8d, 0x0e, 0xce, 0xb7, 0x7a, 0x6a, 0xbd, 0xd5, 0x53, 0x37,
-	0x5b, 0x3d, 0xf5, 0x5b, 0x65, 0xe5, 0xf8, 0x9f, 0xe1, 0x50, 0x73, 0x19, 0xbd, 0xb8, 0x49, 0x56,
-	0xbe, 0xb4, 0x0f, 0x77, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9e, 0x96, 0x2a, 0x36, 0xd2, 0x03, 0x00,
-	0x00,
+	// 523 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xe3, 0x12, 0x52, 0x3a, 0x41, 0x2d, 0x5a, 0xa0, 0x72, 0x3f, 0xe4, 0x54, 0xb9, 0xd0,
+	0x03, 0xb5, 0xd5, 0x54, 0x02, 0x6e, 0x15, 0xa9, 0x2a, 0x54, 0xa9, 0x88, 0xc8, 0xe1, 0x43, 0xe2,
+	0x12, 0x1c, 0xef, 0x2a, 0xb5, 0x70, 0xbc, 0xd6, 0xee, 0xda, 0x6d, 0x8e, 0xbc, 0x01, 0x27, 0x9e,
+	0x83, 0x03, 0x0f, 0xc1, 0xb1, 0xe2, 0xc4, 0x11, 0x25, 0x2f, 0x82, 0x76, 0xbc, 0x69, 0x4b, 0x0a,
+	0x72, 0x24, 0x0e, 0xbd, 0x79, 0x76, 0x7e, 0x33, 0xf9, 0xff, 0x67, 0xb2, 0x0b, 0x9b, 0x21, 0x97,
+	0x43, 0x2e, 0xbd, 0x81, 0xe0, 0x59, 0xea, 0xe5, 0xbb, 0x1e, 0xcb, 0x59, 0xa2, 0xa4, 0x9b, 0x0a,
+	0xae, 0x38, 0x59, 0x29, 0xb2, 0x2e, 0x66, 0xdd, 0x7c, 0x77, 0x7d, 0xad, 0x38, 0xe8, 0x61, 0xda,
+	0x33, 0x59, 0x0c, 0xd6, 0x37, 0x66, 0x3b, 0xa9, 0x51, 0xca, 0x4c, 0xb2, 0xb9, 0x03, 0xf7, 0x0e,
+	0x75, 0xe3, 0x03, 0xc1, 0x02, 0xc5, 0x5e, 0x68, 0x84, 0xac, 0xc1, 0x1d, 0x64, 0x7b, 0x11, 0xb5,
+	0xad, 0x2d, 0x6b, 0xbb, 0xea, 0x2f, 0x62, 0x7c, 0x44, 0x2f, 0xf0, 0x37, 0x29, 0x9d, 0x07, 0x3f,
+	0x86, 0xd5, 0xd9, 0xee, 0x1d, 0x1e, 0x47, 0xe1, 0x88, 0xb4, 0x60, 0x31, 0xa0, 0x54, 0x30, 0x29,
+	0xb1, 0x66, 0xa9, 0x6d, 0xff, 0xf8, 0xb6, 0xf3, 0xc0, 0xe8, 0x7e, 0x5e, 0x64, 0xba, 0x4a, 0x44,
+	0xc9, 0xc0, 0x9f, 0x82, 0x17, 0xdd, 0xae, 0xfc, 0xf8, 0x7f, 0x74, 0x7b, 0x02, 0xf7, 0xb1, 0x5b,
+	0x37, 0xeb, 0x0f, 0x23, 0xd5, 0x11, 0x3c, 0xe5, 0x32, 0x88, 0x49, 0x03, 0xea, 0xa9, 0xf9, 0xbe,
+	0x34, 0x04, 0xd3, 0xa3, 0x23, 0xda, 0x7c, 0x06, 0x0f, 0xb1, 0xee, 0x5d, 0xa4, 0x4e, 0xa8, 0x08,
+	0x4e, 0xe7, 0xaf, 0x7c, 0x0c, 0x4b, 0x58, 0xf9, 0x96, 0x2b, 0x56, 0x4e, 0x7f, 0xb2, 0x0c, 0x7e,
+	0x78, 0xc6, 0xc2, 0x52, 0x9c, 0xec, 0x43, 0x4d, 0x30, 0x99, 0xc5, 0xca, 0x5e, 0xd8, 0xb2, 0xb6,
+	0x97, 0x5b, 0x8f, 0xdc, 0x99, 0xbf, 0x88, 0x3b, 0x15, 0xaa, 0xfb, 0x65, 0x8a, 0x0b, 0x1f, 0x71,
+	0xdf, 0x94, 0x11, 0x02, 0xd5, 0x98, 0x0f, 0xa4, 0x7d, 0x4b, 0x0f, 0xd0, 0xc7, 0xef, 0xe6, 0x07,
+	0x58, 0x41, 0x09, 0xc7, 0x2c, 0xc8, 0x4b, 0xb7, 0x7d, 0x75, 0x0b, 0x0b, 0xf3, 0x6e, 0xe1, 0xab,
+	0x65, 0xd6, 0x30, 0x55, 0xd7, 0x11, 0x59, 0xc2, 0x68, 0xb9, 0xdf, 0xa7, 0x50, 0x93, 0x2a, 0x50,
+	0x99, 0x34, 0x7e, 0x1b, 0xff, 0xf4, 0xdb, 0x45, 0xcc, 0x37, 0x38, 0xd9, 0x87, 0xbb, 0x2a, 0x88,
+	0xe3, 0x51, 0xcf, 0x8c, 0x4b, 0xfb, 0xad, 0xb7, 0x36, 0xaf, 0x95, 0xbf, 0xd6, 0x90, 0x99, 0x51,
+	0x5d, 0x5d, 0x06, 0xcd, 0x2f, 0x16, 0x38, 0x28, 0xd9, 0x67, 0x61, 0x26, 0xb4, 0x9d, 0x3f, 0x27,
+	0xcb, 0xe8, 0x0d, 0x6d, 0xab, 0x0d, 0x8d, 0xbf, 0xeb, 0x3a, 0x08, 0x92, 0x90, 0xc5, 0xf1, 0x1c,
+	0xc2, 0xf4, 0x3e, 0x8a, 0x1b, 0xfe, 0x92, 0x0d, 0xfb, 0x4c, 0xe0, 0x10, 0xca, 0xed, 0xb8, 0x70,
+	0x3b, 0xe7, 0x8a, 0x89, 0xd2, 0xbd, 0x17, 0x18, 0xd9, 0x83, 0x1a, 0x4f, 0x55, 0xc4, 0x13, 0xd4,
+	0xbf, 0xdc, 0xda, 0xb8, 0x66, 0x5f, 0xdf, 0x91, 0x57, 0x88, 0xf8, 0x06, 0x25, 0xab, 0x50, 0x3b,
+	0x65, 0xd1, 0xe0, 0x44, 0xd9, 0x55, 0x34, 0x6d, 0xa2, 0xb6, 0xfb, 0x7d, 0xec, 0x58, 0xe7, 0x63,
+	0xc7, 0xfa, 0x35, 0x76, 0xac, 0xcf, 0x13, 0xa7, 0x72, 0x3e, 0x71, 0x2a, 0x3f, 0x27, 0x4e, 0xe5,
+	0xbd, 0xd1, 0x20, 0xe9, 0x47, 0x37, 0xe2, 0xde, 0x59, 0xf1, 0x02, 0xf6, 0x6b, 0xf8, 0xf2, 0xed,
+	0xfd, 0x1e, 0x00, 0xad, 0xe0, 0xe4, 0xc6, 0x62, 0x05, 0x00, 0x00,
 }
 
 func (m *EventCreateGroup) Marshal() (dAtA []byte, err error) {
@@ -895,6 +1093,121 @@ func (m *EventProposalPruned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventRecurringProposalExecuted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecurringProposalExecuted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecurringProposalExecuted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		i -= len(m.Logs)
+		copy(dAtA[i:], m.Logs)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Logs)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Result != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRecurringProposalCancelled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRecurringProposalCancelled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRecurringProposalCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMemberTally) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMemberTally) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMemberTally) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weight) > 0 {
+		i -= len(m.Weight)
+		copy(dAtA[i:], m.Weight)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Weight)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Option != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -1046,6 +1359,60 @@ func (m *EventProposalPruned) Size() (n int) {
 	return n
 }
 
+func (m *EventRecurringProposalExecuted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovEvents(uint64(m.ProposalId))
+	}
+	if m.Result != 0 {
+		n += 1 + sovEvents(uint64(m.Result))
+	}
+	l = len(m.Logs)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRecurringProposalCancelled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovEvents(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *EventMemberTally) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovEvents(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Option != 0 {
+		n += 1 + sovEvents(uint64(m.Option))
+	}
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1906,6 +2273,347 @@ func (m *EventProposalPruned) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventRecurringProposalExecuted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRecurringProposalExecuted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRecurringProposalExecuted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ProposalExecutorResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRecurringProposalCancelled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRecurringProposalCancelled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRecurringProposalCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMemberTally) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMemberTally: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMemberTally: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
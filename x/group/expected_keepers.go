@@ -2,6 +2,7 @@ package group
 
 import (
 	context "context"
+	"time"
 
 	"cosmossdk.io/core/address"
 
@@ -28,3 +29,11 @@ type AccountKeeper interface {
 type BankKeeper interface {
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 }
+
+// FeegrantKeeper defines the expected interface needed to grant the fee
+// allowances of the executors of the accepted proposals.
+type FeegrantKeeper interface {
+	GrantBasicAllowance(ctx context.Context, granter, grantee sdk.AccAddress, spendLimit sdk.Coins, expiration *time.Time) error
+	RevokeAllowance(ctx context.Context, granter, grantee sdk.AccAddress) error
+	HasAllowance(ctx context.Context, granter, grantee sdk.AccAddress) (bool, error)
+}
@@ -79,6 +79,25 @@ func (s GenesisState) Validate() error {
 			return errorsmod.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("proposal with ProposalId %d doesn't exist", v.ProposalId))
 		}
 	}
+
+	for _, p := range s.RecurringProposals {
+
+		// check that group policy with recurring proposal address exists
+		if _, exists := groupPolicies[p.GroupPolicyAddress]; !exists {
+			return errorsmod.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("group policy account with address %s doesn't correspond to recurring proposal address", p.GroupPolicyAddress))
+		}
+
+		if err := p.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "RecurringProposal validation failed")
+		}
+	}
+
+	// Vote receipts outlive their proposals, which are pruned.
+	for _, v := range s.VoteReceipts {
+		if err := v.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "VoteReceipt validation failed")
+		}
+	}
 	return nil
 }
 
@@ -96,5 +115,11 @@ func (s GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 			return err
 		}
 	}
+	for _, p := range s.RecurringProposals {
+		err := p.UnpackInterfaces(unpacker)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -43,6 +43,14 @@ type GenesisState struct {
 	Proposals []*Proposal `protobuf:"bytes,7,rep,name=proposals,proto3" json:"proposals,omitempty"`
 	// votes is the list of votes.
 	Votes []*Vote `protobuf:"bytes,8,rep,name=votes,proto3" json:"votes,omitempty"`
+	// recurring_proposals is the list of recurring proposals.
+	//
+	// Since: x/group 1.0.0
+	RecurringProposals []*RecurringProposal `protobuf:"bytes,9,rep,name=recurring_proposals,json=recurringProposals,proto3" json:"recurring_proposals,omitempty"`
+	// vote_receipts is the list of vote receipts.
+	//
+	// Since: x/group 1.0.0
+	VoteReceipts []*VoteReceipt `protobuf:"bytes,10,rep,name=vote_receipts,json=voteReceipts,proto3" json:"vote_receipts,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -134,6 +142,20 @@ func (m *GenesisState) GetVotes() []*Vote {
 	return nil
 }
 
+func (m *GenesisState) GetRecurringProposals() []*RecurringProposal {
+	if m != nil {
+		return m.RecurringProposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVoteReceipts() []*VoteReceipt {
+	if m != nil {
+		return m.VoteReceipts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.group.v1.GenesisState")
 }
@@ -141,28 +163,31 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1/genesis.proto", fileDescriptor_cc6105fe3ef99f06) }
 
 var fileDescriptor_cc6105fe3ef99f06 = []byte{
-	// 328 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x4a, 0x7a, 0x41,
-	0x14, 0xc7, 0xbd, 0x3f, 0xff, 0xfc, 0x74, 0xfc, 0x53, 0x0c, 0x05, 0x37, 0xad, 0xc1, 0x5a, 0x09,
-	0xc1, 0x5c, 0xb4, 0x45, 0xeb, 0xda, 0x48, 0x8b, 0x40, 0xae, 0xd0, 0xa2, 0x4d, 0xa8, 0x9d, 0xe4,
-	0x92, 0x7a, 0xc6, 0x7b, 0x26, 0xc9, 0xb7, 0xe8, 0x09, 0x7a, 0x9e, 0x96, 0x2e, 0x5b, 0x86, 0xbe,
-	0x48, 0x78, 0x46, 0x31, 0xd4, 0xdd, 0xcc, 0x99, 0xcf, 0xf7, 0x7c, 0xbe, 0x30, 0xe2, 0xac, 0x87,
-	0x34, 0x44, 0x0a, 0xfa, 0x31, 0xbe, 0x99, 0x60, 0x52, 0x0f, 0xfa, 0x30, 0x02, 0x8a, 0x48, 0x9b,
-	0x18, 0x2d, 0xca, 0x03, 0xf7, 0xac, 0xf9, 0x59, 0x4f, 0xea, 0xe5, 0xca, 0x36, 0x6f, 0xa7, 0x06,
-	0x56, 0xf4, 0xc5, 0x67, 0x52, 0x14, 0x9a, 0x2e, 0xdf, 0xb6, 0x1d, 0x0b, 0xb2, 0x22, 0x72, 0x0c,
-	0x3e, 0x11, 0x8c, 0x7d, 0xaf, 0xea, 0xd5, 0x52, 0x61, 0x96, 0x07, 0x6d, 0x18, 0xcb, 0x86, 0xc8,
-	0xf0, 0x99, 0xfc, 0x7f, 0xd5, 0x64, 0x2d, 0xdf, 0x28, 0xeb, 0x2d, 0x99, 0x6e, 0x2e, 0x0f, 0x77,
-	0xa3, 0x17, 0x0c, 0x57, 0xa4, 0xbc, 0x11, 0x45, 0xb7, 0x70, 0x08, 0xc3, 0x2e, 0xc4, 0xe4, 0x27,
-	0x39, 0x7a, 0xba, 0x3f, 0x7a, 0xcf, 0x50, 0x58, 0xe8, 0x6f, 0x2e, 0x24, 0x6b, 0xe2, 0xd0, 0xad,
-	0x30, 0x38, 0x88, 0x7a, 0x53, 0xae, 0x96, 0xe2, 0x6a, 0x25, 0x9e, 0xb7, 0x78, 0xbc, 0x2c, 0xd8,
-	0x14, 0xa5, 0x3f, 0x64, 0x04, 0xe4, 0xa7, 0xd9, 0x56, 0xdd, 0x6f, 0x73, 0x41, 0xae, 0x5b, 0xdc,
-	0x6c, 0x8a, 0x80, 0xe4, 0xb9, 0x28, 0x98, 0x18, 0x0d, 0x52, 0x67, 0xc0, 0xba, 0x0c, 0xeb, 0xf2,
-	0xeb, 0xd9, 0xd2, 0x75, 0x2d, 0x72, 0xeb, 0x2b, 0xf9, 0xff, 0x59, 0x73, 0xb2, 0xa3, 0x69, 0xad,
-	0x88, 0x70, 0xc3, 0xca, 0x4b, 0x91, 0x9e, 0xa0, 0x05, 0xf2, 0xb3, 0x1c, 0x3a, 0xde, 0x09, 0x3d,
-	0xa0, 0x85, 0xd0, 0x31, 0xb7, 0xfa, 0x6b, 0xae, 0xbc, 0xd9, 0x5c, 0x79, 0x3f, 0x73, 0xe5, 0x7d,
-	0x2c, 0x54, 0x62, 0xb6, 0x50, 0x89, 0xef, 0x85, 0x4a, 0x3c, 0x1e, 0xb9, 0x18, 0x3d, 0xbf, 0xea,
-	0x08, 0x83, 0x77, 0xf7, 0xbf, 0xdd, 0x0c, 0xff, 0xeb, 0xd5, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x8c, 0xb4, 0xee, 0x7e, 0x26, 0x02, 0x00, 0x00,
+	// 375 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0x4e, 0xea, 0x40,
+	0x14, 0x87, 0xe9, 0xe5, 0xcf, 0x85, 0xa1, 0x70, 0x6f, 0xe6, 0x5e, 0x93, 0x0a, 0xda, 0x20, 0x2b,
+	0x12, 0x93, 0x36, 0xe0, 0xc2, 0xb5, 0x6e, 0x88, 0x0b, 0x13, 0x52, 0x12, 0x17, 0x6e, 0x08, 0xd4,
+	0x63, 0xd3, 0x08, 0x4c, 0x99, 0x33, 0x34, 0xf2, 0x16, 0x3e, 0x8d, 0xcf, 0xe0, 0x92, 0xa5, 0x4b,
+	0x03, 0x2f, 0x62, 0x7a, 0x06, 0xac, 0x01, 0xdc, 0xcd, 0x39, 0xf3, 0xfd, 0xce, 0x77, 0x92, 0x19,
+	0x76, 0xea, 0x0b, 0x9c, 0x08, 0x74, 0x03, 0x29, 0xe6, 0x91, 0x1b, 0xb7, 0xdd, 0x00, 0xa6, 0x80,
+	0x21, 0x3a, 0x91, 0x14, 0x4a, 0xf0, 0x3f, 0xfa, 0xda, 0xa1, 0x6b, 0x27, 0x6e, 0xd7, 0xea, 0xbb,
+	0xbc, 0x5a, 0x44, 0xb0, 0xa1, 0x9b, 0xaf, 0x39, 0x66, 0x76, 0x75, 0xbe, 0xaf, 0x86, 0x0a, 0x78,
+	0x9d, 0x95, 0x08, 0x1c, 0x20, 0xcc, 0x2c, 0xa3, 0x61, 0xb4, 0x72, 0x5e, 0x91, 0x1a, 0x7d, 0x98,
+	0xf1, 0x0e, 0x2b, 0xd0, 0x19, 0xad, 0x5f, 0x8d, 0x6c, 0xab, 0xdc, 0xa9, 0x39, 0x3b, 0x32, 0xa7,
+	0x9b, 0x1c, 0x6e, 0xa6, 0x8f, 0xc2, 0xdb, 0x90, 0xfc, 0x8a, 0x55, 0xf4, 0xc0, 0x09, 0x4c, 0x46,
+	0x20, 0xd1, 0xca, 0x52, 0xf4, 0xe4, 0x70, 0xf4, 0x96, 0x20, 0xcf, 0x0c, 0xd2, 0x02, 0x79, 0x8b,
+	0xfd, 0xd5, 0x23, 0x22, 0x31, 0x0e, 0xfd, 0x05, 0xad, 0x96, 0xa3, 0xd5, 0xaa, 0xd4, 0xef, 0x51,
+	0x3b, 0x59, 0xb0, 0xcb, 0xaa, 0xdf, 0xc8, 0x10, 0xd0, 0xca, 0x93, 0xad, 0x71, 0xd8, 0xa6, 0x83,
+	0xb4, 0x6e, 0x25, 0x9d, 0x14, 0x02, 0xf2, 0x33, 0x66, 0x46, 0x52, 0x44, 0x02, 0x87, 0x63, 0xd2,
+	0x15, 0x48, 0x57, 0xde, 0xf6, 0x12, 0xd7, 0x25, 0x2b, 0x6d, 0x4b, 0xb4, 0x7e, 0x93, 0xe6, 0x78,
+	0x4f, 0xd3, 0xdb, 0x10, 0x5e, 0xca, 0xf2, 0x73, 0x96, 0x8f, 0x85, 0x02, 0xb4, 0x8a, 0x14, 0x3a,
+	0xda, 0x0b, 0xdd, 0x09, 0x05, 0x9e, 0x66, 0x78, 0x9f, 0xfd, 0x93, 0xe0, 0xcf, 0xa5, 0x0c, 0xa7,
+	0xc1, 0x20, 0xf5, 0x95, 0x28, 0xda, 0xdc, 0x8b, 0x7a, 0x5b, 0xf6, 0x4b, 0xcc, 0xe5, 0x6e, 0x8b,
+	0xde, 0x24, 0x99, 0x3e, 0x90, 0xe0, 0x43, 0x18, 0x29, 0xb4, 0xd8, 0x0f, 0x6f, 0x42, 0x9b, 0x68,
+	0xc8, 0x33, 0xe3, 0xb4, 0xc0, 0x6b, 0xe7, 0x6d, 0x65, 0x1b, 0xcb, 0x95, 0x6d, 0x7c, 0xac, 0x6c,
+	0xe3, 0x65, 0x6d, 0x67, 0x96, 0x6b, 0x3b, 0xf3, 0xbe, 0xb6, 0x33, 0xf7, 0xff, 0xf5, 0x10, 0x7c,
+	0x78, 0x72, 0x42, 0xe1, 0x3e, 0xeb, 0x7f, 0x37, 0x2a, 0xd0, 0x7f, 0xbb, 0xf8, 0x1c, 0x00, 0xfe,
+	0x08, 0x5e, 0x35, 0xbe, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -185,6 +210,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VoteReceipts) > 0 {
+		for iNdEx := len(m.VoteReceipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteReceipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.RecurringProposals) > 0 {
+		for iNdEx := len(m.RecurringProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecurringProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Votes) > 0 {
 		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -329,6 +382,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RecurringProposals) > 0 {
+		for _, e := range m.RecurringProposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VoteReceipts) > 0 {
+		for _, e := range m.VoteReceipts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -594,6 +659,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecurringProposals = append(m.RecurringProposals, &RecurringProposal{})
+			if err := m.RecurringProposals[len(m.RecurringProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteReceipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteReceipts = append(m.VoteReceipts, &VoteReceipt{})
+			if err := m.VoteReceipts[len(m.VoteReceipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
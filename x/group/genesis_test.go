@@ -734,6 +734,36 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid vote receipt of a pruned proposal",
+			GenesisState{
+				VoteReceipts: []*VoteReceipt{
+					{
+						ProposalId: 7,
+						Voter:      memberAddr.String(),
+						SubmitTime: submittedAt,
+						Option:     VOTE_OPTION_YES,
+						Weight:     "1",
+					},
+				},
+			},
+			false,
+		},
+		{
+			"invalid vote receipt weight",
+			GenesisState{
+				VoteReceipts: []*VoteReceipt{
+					{
+						ProposalId: 7,
+						Voter:      memberAddr.String(),
+						SubmitTime: submittedAt,
+						Option:     VOTE_OPTION_YES,
+						Weight:     "-1",
+					},
+				},
+			},
+			true,
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -4,12 +4,16 @@ import (
 	"context"
 )
 
-// EndBlocker called at every block, updates proposal's `FinalTallyResult` and
-// prunes expired proposals.
+// EndBlocker called at every block, updates proposal's `FinalTallyResult`,
+// prunes expired proposals and executes due recurring proposals.
 func (k Keeper) EndBlocker(ctx context.Context) error {
 	if err := k.TallyProposalsAtVPEnd(ctx, k.environment); err != nil {
 		return err
 	}
 
+	if err := k.ExecuteRecurringProposals(ctx, k.environment); err != nil {
+		return err
+	}
+
 	return k.PruneProposals(ctx, k.environment)
 }
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/group"
+	"cosmossdk.io/x/group/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// grantExecutionFeeAllowances grants the group members a fee allowance, paid by
+// the group policy account, to execute an accepted proposal. The allowances are
+// bounded by the execution fee limit of the group policy and expire with the
+// execution period of the proposal.
+//
+// A member already holding an allowance from the group policy account for the
+// execution of another open proposal shares it, while a member holding one
+// granted otherwise, such as by a proposal message, is left as is.
+func (k Keeper) grantExecutionFeeAllowances(ctx context.Context, p *group.Proposal, policyInfo group.GroupPolicyInfo) error {
+	if k.feegrantKeeper == nil || policyInfo.ExecutionFeeLimit.IsZero() {
+		return nil
+	}
+
+	policyAddr, err := k.accKeeper.AddressCodec().StringToBytes(policyInfo.Address)
+	if err != nil {
+		return err
+	}
+
+	shared, err := k.openExecutionFeeGrantees(ctx, policyAddr, p.Id)
+	if err != nil {
+		return err
+	}
+
+	memberIt, err := k.groupMemberByGroupIndex.Get(k.environment.KVStoreService.OpenKVStore(ctx), policyInfo.GroupId)
+	if err != nil {
+		return err
+	}
+	defer memberIt.Close()
+
+	expiration := p.VotingPeriodEnd.Add(k.config.MaxExecutionPeriod)
+	for {
+		var member group.GroupMember
+		_, err := memberIt.LoadNext(&member)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		memberAddr, err := k.accKeeper.AddressCodec().StringToBytes(member.Member.Address)
+		if err != nil {
+			return err
+		}
+
+		hasAllowance, err := k.feegrantKeeper.HasAllowance(ctx, policyAddr, memberAddr)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case !hasAllowance:
+			if err := k.feegrantKeeper.GrantBasicAllowance(ctx, policyAddr, memberAddr, policyInfo.ExecutionFeeLimit, &expiration); err != nil {
+				return err
+			}
+		case !shared[member.Member.Address]:
+			continue
+		}
+
+		p.ExecutionFeeGrantees = append(p.ExecutionFeeGrantees, member.Member.Address)
+	}
+
+	return nil
+}
+
+// revokeExecutionFeeAllowances revokes the fee allowances granted for the
+// execution of a proposal, except the ones shared with another open proposal.
+func (k Keeper) revokeExecutionFeeAllowances(ctx context.Context, p group.Proposal) error {
+	if k.feegrantKeeper == nil || len(p.ExecutionFeeGrantees) == 0 {
+		return nil
+	}
+
+	policyAddr, err := k.accKeeper.AddressCodec().StringToBytes(p.GroupPolicyAddress)
+	if err != nil {
+		return err
+	}
+
+	shared, err := k.openExecutionFeeGrantees(ctx, policyAddr, p.Id)
+	if err != nil {
+		return err
+	}
+
+	for _, grantee := range p.ExecutionFeeGrantees {
+		if shared[grantee] {
+			continue
+		}
+
+		granteeAddr, err := k.accKeeper.AddressCodec().StringToBytes(grantee)
+		if err != nil {
+			return err
+		}
+
+		// the allowance may have been used up or have expired
+		hasAllowance, err := k.feegrantKeeper.HasAllowance(ctx, policyAddr, granteeAddr)
+		if err != nil {
+			return err
+		}
+		if !hasAllowance {
+			continue
+		}
+
+		if err := k.feegrantKeeper.RevokeAllowance(ctx, policyAddr, granteeAddr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// openExecutionFeeGrantees returns the execution fee grantees of the accepted
+// proposals of a group policy not executed yet, other than the given one.
+func (k Keeper) openExecutionFeeGrantees(ctx context.Context, policyAddr sdk.AccAddress, proposalID uint64) (map[string]bool, error) {
+	proposals, err := k.proposalsByGroupPolicy(ctx, policyAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	grantees := make(map[string]bool)
+	for _, proposal := range proposals {
+		if proposal.Id == proposalID ||
+			proposal.Status != group.PROPOSAL_STATUS_ACCEPTED ||
+			proposal.ExecutorResult == group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
+			continue
+		}
+
+		for _, grantee := range proposal.ExecutionFeeGrantees {
+			grantees[grantee] = true
+		}
+	}
+
+	return grantees, nil
+}
@@ -43,6 +43,14 @@ func (k Keeper) InitGenesis(ctx context.Context, cdc codec.JSONCodec, data json.
 		panic(errors.Wrap(err, "votes"))
 	}
 
+	if err := k.recurringProposalTable.Import(store, genesisState.RecurringProposals, 0); err != nil {
+		panic(errors.Wrap(err, "recurring proposals"))
+	}
+
+	if err := k.voteReceiptTable.Import(store, genesisState.VoteReceipts, 0); err != nil {
+		panic(errors.Wrap(err, "vote receipts"))
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -91,5 +99,19 @@ func (k Keeper) ExportGenesis(ctx context.Context, _ codec.JSONCodec) *group.Gen
 	}
 	genesisState.Votes = votes
 
+	var recurringProposals []*group.RecurringProposal
+	_, err = k.recurringProposalTable.Export(store, &recurringProposals)
+	if err != nil {
+		panic(errors.Wrap(err, "recurring proposals"))
+	}
+	genesisState.RecurringProposals = recurringProposals
+
+	var voteReceipts []*group.VoteReceipt
+	_, err = k.voteReceiptTable.Export(store, &voteReceipts)
+	if err != nil {
+		panic(errors.Wrap(err, "vote receipts"))
+	}
+	genesisState.VoteReceipts = voteReceipts
+
 	return genesisState
 }
@@ -136,6 +136,7 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 		ProposalSeq:    1,
 		Proposals:      []*group.Proposal{proposal},
 		Votes:          []*group.Vote{{ProposalId: proposal.Id, Voter: memberAddr.String(), SubmitTime: submittedAt, Option: group.VOTE_OPTION_YES}},
+		VoteReceipts:   []*group.VoteReceipt{{ProposalId: 5, Voter: memberAddr.String(), SubmitTime: submittedAt, Option: group.VOTE_OPTION_NO, Weight: "2"}},
 	}
 	genesisBytes, err := cdc.MarshalJSON(genesisState)
 	s.Require().NoError(err)
@@ -184,6 +185,10 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 		s.Require().Equal(votesRes.Votes[0], genesisState.Votes[0])
 	}
 
+	receiptsRes, err := s.keeper.ProposalVoteReceipts(ctx, &group.QueryProposalVoteReceiptsRequest{ProposalId: 5})
+	s.Require().NoError(err)
+	s.Require().Equal(genesisState.VoteReceipts, receiptsRes.VoteReceipts)
+
 	exported := s.keeper.ExportGenesis(sdkCtx, cdc)
 	bz, err := cdc.MarshalJSON(exported)
 	s.Require().NoError(err)
@@ -209,6 +214,7 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 		s.assertProposalsEqual(g, res)
 	}
 	s.Require().Equal(genesisState.Votes, exportedGenesisState.Votes)
+	s.Require().Equal(genesisState.VoteReceipts, exportedGenesisState.VoteReceipts)
 
 	s.Require().Equal(genesisState.GroupSeq, exportedGenesisState.GroupSeq)
 	s.Require().Equal(genesisState.GroupPolicySeq, exportedGenesisState.GroupPolicySeq)
@@ -371,3 +371,64 @@ func (k Keeper) Groups(ctx context.Context, request *group.QueryGroupsRequest) (
 		Pagination: pageRes,
 	}, nil
 }
+
+// RecurringProposal queries the recurrence of a recurring proposal.
+func (k Keeper) RecurringProposal(ctx context.Context, request *group.QueryRecurringProposalRequest) (*group.QueryRecurringProposalResponse, error) {
+	recurringProposal, err := k.getRecurringProposal(ctx, request.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryRecurringProposalResponse{RecurringProposal: &recurringProposal}, nil
+}
+
+// RecurringProposalsByGroupPolicy queries all recurring proposals of a group policy.
+func (k Keeper) RecurringProposalsByGroupPolicy(ctx context.Context, request *group.QueryRecurringProposalsByGroupPolicyRequest) (*group.QueryRecurringProposalsByGroupPolicyResponse, error) {
+	addr, err := k.accKeeper.AddressCodec().StringToBytes(request.Address)
+	if err != nil {
+		return nil, err
+	}
+	it, err := k.recurringProposalByGroupPolicyIndex.GetPaginated(k.environment.KVStoreService.OpenKVStore(ctx), addr, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var recurringProposals []*group.RecurringProposal
+	pageRes, err := orm.Paginate(it, request.Pagination, &recurringProposals)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryRecurringProposalsByGroupPolicyResponse{
+		RecurringProposals: recurringProposals,
+		Pagination:         pageRes,
+	}, nil
+}
+
+// ProposalVoteReceipts queries the vote receipts of a proposal.
+func (k Keeper) ProposalVoteReceipts(ctx context.Context, request *group.QueryProposalVoteReceiptsRequest) (*group.QueryProposalVoteReceiptsResponse, error) {
+	it, err := k.voteReceiptByProposalIndex.GetPaginated(k.environment.KVStoreService.OpenKVStore(ctx), request.ProposalId, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var voteReceipts []*group.VoteReceipt
+	pageRes, err := orm.Paginate(it, request.Pagination, &voteReceipts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryProposalVoteReceiptsResponse{
+		VoteReceipts: voteReceipts,
+		Pagination:   pageRes,
+	}, nil
+}
+
+// getRecurringProposal gets the recurring proposal of the given proposal id.
+func (k Keeper) getRecurringProposal(ctx context.Context, proposalID uint64) (group.RecurringProposal, error) {
+	var p group.RecurringProposal
+	if err := k.recurringProposalTable.GetOne(k.environment.KVStoreService.OpenKVStore(ctx), orm.PrimaryKey(&group.RecurringProposal{ProposalId: proposalID}), &p); err != nil {
+		return group.RecurringProposal{}, errorsmod.Wrap(err, "load recurring proposal")
+	}
+	return p, nil
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"math"
 	"time"
 
 	"cosmossdk.io/core/appmodule"
@@ -15,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 const (
@@ -44,11 +46,21 @@ const (
 	VoteTablePrefix           byte = 0x40
 	VoteByProposalIndexPrefix byte = 0x41
 	VoteByVoterIndexPrefix    byte = 0x42
+
+	// Recurring Proposal Table
+	RecurringProposalTablePrefix              byte = 0x50
+	RecurringProposalsByNextExecutionPrefix   byte = 0x51
+	RecurringProposalByGroupPolicyIndexPrefix byte = 0x52
+
+	// Vote Receipt Table
+	VoteReceiptTablePrefix           byte = 0x60
+	VoteReceiptByProposalIndexPrefix byte = 0x61
 )
 
 type Keeper struct {
-	environment appmodule.Environment
-	accKeeper   group.AccountKeeper
+	environment    appmodule.Environment
+	accKeeper      group.AccountKeeper
+	feegrantKeeper group.FeegrantKeeper
 
 	// Group Table
 	groupTable        orm.AutoUInt64Table
@@ -75,6 +87,15 @@ type Keeper struct {
 	voteByProposalIndex orm.Index
 	voteByVoterIndex    orm.Index
 
+	// Recurring Proposal Table
+	recurringProposalTable              orm.PrimaryKeyTable
+	recurringProposalsByNextExecution   orm.Index
+	recurringProposalByGroupPolicyIndex orm.Index
+
+	// Vote Receipt Table
+	voteReceiptTable           orm.PrimaryKeyTable
+	voteReceiptByProposalIndex orm.Index
+
 	router baseapp.MessageRouter
 
 	config group.Config
@@ -116,6 +137,14 @@ func NewKeeper(env appmodule.Environment, cdc codec.Codec, router baseapp.Messag
 	if config.MaxProposalSummaryLen <= 0 {
 		config.MaxProposalSummaryLen = defaultConfig.MaxProposalSummaryLen
 	}
+	// If RecurringProposalEpoch not set by app developer, set to default value.
+	if config.RecurringProposalEpoch <= 0 {
+		config.RecurringProposalEpoch = defaultConfig.RecurringProposalEpoch
+	}
+	// If MaxRecurringProposalExecutions not set by app developer, set to default value.
+	if config.MaxRecurringProposalExecutions == 0 {
+		config.MaxRecurringProposalExecutions = defaultConfig.MaxRecurringProposalExecutions
+	}
 	k.config = config
 
 	groupTable, err := orm.NewAutoUInt64Table([2]byte{GroupTablePrefix}, GroupTableSeqPrefix, &group.GroupInfo{}, cdc)
@@ -232,9 +261,57 @@ func NewKeeper(env appmodule.Environment, cdc codec.Codec, router baseapp.Messag
 	}
 	k.voteTable = *voteTable
 
+	// Recurring Proposal Table
+	recurringProposalTable, err := orm.NewPrimaryKeyTable([2]byte{RecurringProposalTablePrefix}, &group.RecurringProposal{}, cdc)
+	if err != nil {
+		panic(err.Error())
+	}
+	k.recurringProposalsByNextExecution, err = orm.NewIndex(recurringProposalTable, RecurringProposalsByNextExecutionPrefix, func(value interface{}) ([]interface{}, error) {
+		nextExecution := value.(*group.RecurringProposal).NextExecutionTime
+		return []interface{}{sdk.FormatTimeBytes(nextExecution)}, nil
+	}, []byte{})
+	if err != nil {
+		panic(err.Error())
+	}
+	k.recurringProposalByGroupPolicyIndex, err = orm.NewIndex(recurringProposalTable, RecurringProposalByGroupPolicyIndexPrefix, func(value interface{}) ([]interface{}, error) {
+		addr, err := accKeeper.AddressCodec().StringToBytes(value.(*group.RecurringProposal).GroupPolicyAddress)
+		if err != nil {
+			return nil, err
+		}
+		return []interface{}{addr}, nil
+	}, []byte{})
+	if err != nil {
+		panic(err.Error())
+	}
+	k.recurringProposalTable = *recurringProposalTable
+
+	// Vote Receipt Table
+	voteReceiptTable, err := orm.NewPrimaryKeyTable([2]byte{VoteReceiptTablePrefix}, &group.VoteReceipt{}, cdc)
+	if err != nil {
+		panic(err.Error())
+	}
+	k.voteReceiptByProposalIndex, err = orm.NewIndex(voteReceiptTable, VoteReceiptByProposalIndexPrefix, func(value interface{}) ([]interface{}, error) {
+		return []interface{}{value.(*group.VoteReceipt).ProposalId}, nil
+	}, group.VoteReceipt{}.ProposalId)
+	if err != nil {
+		panic(err.Error())
+	}
+	k.voteReceiptTable = *voteReceiptTable
+
 	return k
 }
 
+// SetFeegrantKeeper sets the keeper granting the fee allowances of the executors
+// of the accepted proposals, which are not granted until it is set. This method
+// must take a pointer, and the keeper can only be set once.
+func (k *Keeper) SetFeegrantKeeper(feegrantKeeper group.FeegrantKeeper) {
+	if k.feegrantKeeper != nil {
+		panic("cannot set feegrant keeper twice")
+	}
+
+	k.feegrantKeeper = feegrantKeeper
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger() log.Logger {
 	return k.environment.Logger.With("module", fmt.Sprintf("x/%s", group.ModuleName))
@@ -283,14 +360,19 @@ func (k Keeper) proposalsByVPEnd(ctx context.Context, endTime time.Time) (propos
 	return proposals, nil
 }
 
-// pruneProposal deletes a proposal from state.
-func (k Keeper) pruneProposal(ctx context.Context, proposalID uint64) error {
-	err := k.proposalTable.Delete(k.environment.KVStoreService.OpenKVStore(ctx), proposalID)
+// pruneProposal deletes a proposal from state and revokes the fee allowances
+// granted for its execution.
+func (k Keeper) pruneProposal(ctx context.Context, proposal group.Proposal) error {
+	err := k.proposalTable.Delete(k.environment.KVStoreService.OpenKVStore(ctx), proposal.Id)
 	if err != nil {
 		return err
 	}
 
-	k.Logger().Debug(fmt.Sprintf("Pruned proposal %d", proposalID))
+	if err := k.revokeExecutionFeeAllowances(ctx, proposal); err != nil {
+		return err
+	}
+
+	k.Logger().Debug(fmt.Sprintf("Pruned proposal %d", proposal.Id))
 	return nil
 }
 
@@ -358,6 +440,60 @@ func (k Keeper) pruneVotes(ctx context.Context, proposalID uint64) error {
 	return nil
 }
 
+// storeVoteReceipts keeps the votes for a finally tallied proposal as vote
+// receipts, along with the weight of their voters, and emits the tally
+// breakdown per member.
+func (k Keeper) storeVoteReceipts(ctx context.Context, proposalID, groupID uint64) error {
+	votes, err := k.votesByProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	kvStore := k.environment.KVStoreService.OpenKVStore(ctx)
+	for _, v := range votes {
+		// Members who left the group after voting aren't counted in the tally,
+		// their receipt is kept with a zero weight.
+		weight := "0"
+		var member group.GroupMember
+		err := k.groupMemberTable.GetOne(kvStore, orm.PrimaryKey(&group.GroupMember{
+			GroupId: groupID,
+			Member:  &group.Member{Address: v.Voter},
+		}), &member)
+		switch {
+		case err == nil:
+			weight = member.Member.Weight
+		case !sdkerrors.ErrNotFound.Is(err):
+			return err
+		}
+
+		if err := k.voteReceiptTable.Create(kvStore, &group.VoteReceipt{
+			ProposalId: v.ProposalId,
+			Voter:      v.Voter,
+			Option:     v.Option,
+			Metadata:   v.Metadata,
+			SubmitTime: v.SubmitTime,
+			Weight:     weight,
+		}); err != nil {
+			return errorsmod.Wrap(err, "vote receipt")
+		}
+
+		if weight == "0" {
+			continue
+		}
+
+		if err := k.environment.EventService.EventManager(ctx).Emit(&group.EventMemberTally{
+			ProposalId: v.ProposalId,
+			Voter:      v.Voter,
+			Option:     v.Option,
+			Weight:     weight,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // votesByProposal returns all votes for a given proposal.
 func (k Keeper) votesByProposal(ctx context.Context, proposalID uint64) ([]group.Vote, error) {
 	it, err := k.voteByProposalIndex.Get(k.environment.KVStoreService.OpenKVStore(ctx), proposalID)
@@ -393,7 +529,7 @@ func (k Keeper) PruneProposals(ctx context.Context, env appmodule.Environment) e
 	for _, proposal := range proposals {
 		proposal := proposal
 
-		err := k.pruneProposal(ctx, proposal.Id)
+		err := k.pruneProposal(ctx, proposal)
 		if err != nil {
 			return err
 		}
@@ -434,7 +570,7 @@ func (k Keeper) TallyProposalsAtVPEnd(ctx context.Context, env appmodule.Environ
 
 		proposalID := proposal.Id
 		if proposal.Status == group.PROPOSAL_STATUS_ABORTED || proposal.Status == group.PROPOSAL_STATUS_WITHDRAWN {
-			if err := k.pruneProposal(ctx, proposalID); err != nil {
+			if err := k.pruneProposal(ctx, proposal); err != nil {
 				return err
 			}
 			if err := k.pruneVotes(ctx, proposalID); err != nil {
@@ -464,6 +600,160 @@ func (k Keeper) TallyProposalsAtVPEnd(ctx context.Context, env appmodule.Environ
 	return nil
 }
 
+// recurrenceInterval returns the duration between two executions of a recurring
+// proposal executed every `epochs` epochs.
+func (k Keeper) recurrenceInterval(epochs uint64) (time.Duration, error) {
+	if epochs == 0 || epochs > uint64(math.MaxInt64/k.config.RecurringProposalEpoch) {
+		return 0, errors.ErrInvalid.Wrapf("invalid recurrence epochs: %d", epochs)
+	}
+	return time.Duration(epochs) * k.config.RecurringProposalEpoch, nil
+}
+
+// scheduleRecurringProposal adds an executed recurring proposal to the
+// recurrence queue, its next execution being `recurrence_epochs` epochs later.
+func (k Keeper) scheduleRecurringProposal(ctx context.Context, proposal group.Proposal) error {
+	interval, err := k.recurrenceInterval(proposal.RecurrenceEpochs)
+	if err != nil {
+		return err
+	}
+
+	return k.recurringProposalTable.Create(k.environment.KVStoreService.OpenKVStore(ctx), &group.RecurringProposal{
+		ProposalId:         proposal.Id,
+		GroupPolicyAddress: proposal.GroupPolicyAddress,
+		Messages:           proposal.Messages,
+		RecurrenceEpochs:   proposal.RecurrenceEpochs,
+		NextExecutionTime:  k.environment.HeaderService.GetHeaderInfo(ctx).Time.Add(interval),
+		GroupVersion:       proposal.GroupVersion,
+		GroupPolicyVersion: proposal.GroupPolicyVersion,
+	})
+}
+
+// dueRecurringProposals returns at most `limit` recurring proposals whose next
+// execution time is before the `endTime` time argument.
+func (k Keeper) dueRecurringProposals(ctx context.Context, endTime time.Time, limit uint64) (recurringProposals []group.RecurringProposal, err error) {
+	it, err := k.recurringProposalsByNextExecution.PrefixScan(k.environment.KVStoreService.OpenKVStore(ctx), nil, sdk.FormatTimeBytes(endTime))
+	if err != nil {
+		return recurringProposals, err
+	}
+	defer it.Close()
+
+	for uint64(len(recurringProposals)) < limit {
+		// Declared in the loop for the same reason as in proposalsByVPEnd.
+		var recurringProposal group.RecurringProposal
+		_, err := it.LoadNext(&recurringProposal)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return recurringProposals, err
+		}
+		recurringProposals = append(recurringProposals, recurringProposal)
+	}
+
+	return recurringProposals, nil
+}
+
+// ExecuteRecurringProposals executes the messages of the recurring proposals
+// whose next execution time has been reached, at most MaxRecurringProposalExecutions
+// per block, and schedules their next execution. A recurring proposal that
+// cannot be processed is logged and skipped, so that it never halts the chain.
+func (k Keeper) ExecuteRecurringProposals(ctx context.Context, env appmodule.Environment) error {
+	now := env.HeaderService.GetHeaderInfo(ctx).Time
+	recurringProposals, err := k.dueRecurringProposals(ctx, now, k.config.MaxRecurringProposalExecutions)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, recurringProposal := range recurringProposals {
+		// Caching context so that we don't update the store in case of failure.
+		cacheCtx, flush := sdkCtx.CacheContext()
+		if err := k.executeRecurringProposal(cacheCtx, now, recurringProposal); err != nil {
+			k.Logger().Error("recurring proposal processing failed", "cause", err, "proposalID", recurringProposal.ProposalId)
+			continue
+		}
+		flush()
+	}
+
+	return nil
+}
+
+// executeRecurringProposal executes the messages of a due recurring proposal
+// and schedules its next execution. The recurrence is cancelled instead if the
+// group or the group policy has been updated since the proposal was accepted,
+// as the decision policy may not allow it anymore.
+func (k Keeper) executeRecurringProposal(ctx sdk.Context, now time.Time, recurringProposal group.RecurringProposal) error {
+	store := k.environment.KVStoreService.OpenKVStore(ctx)
+
+	policyInfo, err := k.getGroupPolicyInfo(ctx, recurringProposal.GroupPolicyAddress)
+	if err != nil {
+		return errorsmod.Wrap(err, "group policy")
+	}
+
+	groupInfo, err := k.getGroupInfo(ctx, policyInfo.GroupId)
+	if err != nil {
+		return errorsmod.Wrap(err, "group")
+	}
+
+	if groupInfo.Version != recurringProposal.GroupVersion || policyInfo.Version != recurringProposal.GroupPolicyVersion {
+		if err := k.recurringProposalTable.Delete(store, &recurringProposal); err != nil {
+			return errorsmod.Wrap(err, "recurring proposal delete")
+		}
+
+		return k.environment.EventService.EventManager(ctx).Emit(&group.EventRecurringProposalCancelled{ProposalId: recurringProposal.ProposalId})
+	}
+
+	policyAddr, err := k.accKeeper.AddressCodec().StringToBytes(policyInfo.Address)
+	if err != nil {
+		return err
+	}
+
+	interval, err := k.recurrenceInterval(recurringProposal.RecurrenceEpochs)
+	if err != nil {
+		return err
+	}
+
+	result := group.PROPOSAL_EXECUTOR_RESULT_SUCCESS
+	var logs string
+
+	// Caching context so that we don't update the store in case of failure.
+	cacheCtx, flush := ctx.CacheContext()
+	msgs, err := recurringProposal.GetMsgs()
+	if err == nil {
+		var results []sdk.Result
+		if results, err = k.safeExecuteMsgs(cacheCtx, k.router, msgs, policyAddr); err == nil {
+			flush()
+
+			for _, res := range results {
+				// NOTE: The sdk msg handler creates a new EventManager, so events must be correctly propagated back to the current context
+				ctx.EventManager().EmitEvents(res.GetEvents())
+			}
+		}
+	}
+	if err != nil {
+		result = group.PROPOSAL_EXECUTOR_RESULT_FAILURE
+		logs = fmt.Sprintf("recurring proposal execution failed on proposal %d, because of error %s", recurringProposal.ProposalId, err.Error())
+		k.Logger().Info("recurring proposal execution failed", "cause", err, "proposalID", recurringProposal.ProposalId)
+	}
+
+	// Keep the recurrence cadence, unless executions have been missed.
+	recurringProposal.Executions++
+	recurringProposal.NextExecutionTime = recurringProposal.NextExecutionTime.Add(interval)
+	if !recurringProposal.NextExecutionTime.After(now) {
+		recurringProposal.NextExecutionTime = now.Add(interval)
+	}
+
+	if err := k.recurringProposalTable.Update(store, &recurringProposal); err != nil {
+		return errorsmod.Wrap(err, "recurring proposal update")
+	}
+
+	return k.environment.EventService.EventManager(ctx).Emit(&group.EventRecurringProposalExecuted{
+		ProposalId: recurringProposal.ProposalId,
+		Result:     result,
+		Logs:       logs,
+	})
+}
+
 // assertMetadataLength returns an error if given metadata length
 // is greater than defined MaxMetadataLen in the module configuration
 func (k Keeper) assertMetadataLength(metadata, description string) error {
@@ -508,6 +508,47 @@ func (k Keeper) UpdateGroupPolicyMetadata(ctx context.Context, msg *group.MsgUpd
 	return &group.MsgUpdateGroupPolicyMetadataResponse{}, nil
 }
 
+// UpdateGroupPolicyVoteReceipts updates whether a group policy stores vote receipts.
+func (k Keeper) UpdateGroupPolicyVoteReceipts(ctx context.Context, msg *group.MsgUpdateGroupPolicyVoteReceipts) (*group.MsgUpdateGroupPolicyVoteReceiptsResponse, error) {
+	kvStore := k.environment.KVStoreService.OpenKVStore(ctx)
+
+	action := func(groupPolicy *group.GroupPolicyInfo) error {
+		groupPolicy.StoreVoteReceipts = msg.StoreVoteReceipts
+		groupPolicy.Version++
+		return k.groupPolicyTable.Update(kvStore, groupPolicy)
+	}
+
+	err := k.doUpdateGroupPolicy(ctx, msg.GroupPolicyAddress, msg.Admin, action, "group policy vote receipts updated")
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgUpdateGroupPolicyVoteReceiptsResponse{}, nil
+}
+
+// UpdateGroupPolicyExecutionFeeLimit updates the spend limit of the fee allowances
+// granted by a group policy to the executors of its proposals.
+func (k Keeper) UpdateGroupPolicyExecutionFeeLimit(ctx context.Context, msg *group.MsgUpdateGroupPolicyExecutionFeeLimit) (*group.MsgUpdateGroupPolicyExecutionFeeLimitResponse, error) {
+	if err := msg.ExecutionFeeLimit.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	kvStore := k.environment.KVStoreService.OpenKVStore(ctx)
+
+	action := func(groupPolicy *group.GroupPolicyInfo) error {
+		groupPolicy.ExecutionFeeLimit = msg.ExecutionFeeLimit
+		groupPolicy.Version++
+		return k.groupPolicyTable.Update(kvStore, groupPolicy)
+	}
+
+	err := k.doUpdateGroupPolicy(ctx, msg.GroupPolicyAddress, msg.Admin, action, "group policy execution fee limit updated")
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgUpdateGroupPolicyExecutionFeeLimitResponse{}, nil
+}
+
 func (k Keeper) SubmitProposal(ctx context.Context, msg *group.MsgSubmitProposal) (*group.MsgSubmitProposalResponse, error) {
 	if len(msg.Proposers) == 0 {
 		return nil, errorsmod.Wrap(errors.ErrEmpty, "proposers")
@@ -560,6 +601,17 @@ func (k Keeper) SubmitProposal(ctx context.Context, msg *group.MsgSubmitProposal
 		return nil, err
 	}
 
+	if msg.RecurrenceEpochs > 0 {
+		// A recurring proposal without messages would have nothing to execute.
+		if len(msgs) == 0 {
+			return nil, errorsmod.Wrap(errors.ErrEmpty, "recurring proposal messages")
+		}
+
+		if _, err := k.recurrenceInterval(msg.RecurrenceEpochs); err != nil {
+			return nil, err
+		}
+	}
+
 	kvStore := k.environment.KVStoreService.OpenKVStore(ctx)
 	policyAcc, err := k.getGroupPolicyInfo(ctx, msg.GroupPolicyAddress)
 	if err != nil {
@@ -607,6 +659,7 @@ func (k Keeper) SubmitProposal(ctx context.Context, msg *group.MsgSubmitProposal
 		FinalTallyResult:   group.DefaultTallyResult(),
 		Title:              msg.Title,
 		Summary:            msg.Summary,
+		RecurrenceEpochs:   msg.RecurrenceEpochs,
 	}
 
 	if err := m.SetMsgs(msgs); err != nil {
@@ -775,8 +828,43 @@ func (k Keeper) Vote(ctx context.Context, msg *group.MsgVote) (*group.MsgVoteRes
 	return &group.MsgVoteResponse{}, nil
 }
 
+// SubmitVotesBatch submits the votes signed off-chain by group members, so that
+// members without gas tokens can vote through a relayer paying the fees. The
+// signature of every vote is verified, and the whole batch fails if any of its
+// votes is invalid.
+func (k Keeper) SubmitVotesBatch(ctx context.Context, msg *group.MsgSubmitVotesBatch) (*group.MsgSubmitVotesBatchResponse, error) {
+	if _, err := k.accKeeper.AddressCodec().StringToBytes(msg.Relayer); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid relayer address: %s", msg.Relayer)
+	}
+
+	if len(msg.Votes) == 0 {
+		return nil, errorsmod.Wrap(errors.ErrEmpty, "votes")
+	}
+
+	chainID := k.environment.HeaderService.GetHeaderInfo(ctx).ChainID
+	for i, vote := range msg.Votes {
+		k.environment.GasService.GetGasMeter(ctx).Consume(gasCostPerSignedVote, "verify signed vote")
+		if err := k.verifySignedVote(ctx, chainID, vote); err != nil {
+			return nil, errorsmod.Wrapf(err, "vote %d", i)
+		}
+
+		if _, err := k.Vote(ctx, &group.MsgVote{
+			ProposalId: vote.ProposalId,
+			Voter:      vote.Voter,
+			Option:     vote.Option,
+			Metadata:   vote.Metadata,
+		}); err != nil {
+			return nil, errorsmod.Wrapf(err, "vote %d", i)
+		}
+	}
+
+	return &group.MsgSubmitVotesBatchResponse{}, nil
+}
+
 // doTallyAndUpdate performs a tally, and, if the tally result is final, then:
 // - updates the proposal's `Status` and `FinalTallyResult` fields,
+// - stores the votes as vote receipts if the group policy stores vote receipts,
+// - grants the execution fee allowances of an accepted proposal,
 // - prune all the votes.
 func (k Keeper) doTallyAndUpdate(ctx context.Context, p *group.Proposal, groupInfo group.GroupInfo, policyInfo group.GroupPolicyInfo) error {
 	policy, err := policyInfo.GetDecisionPolicy()
@@ -797,12 +885,20 @@ func (k Keeper) doTallyAndUpdate(ctx context.Context, p *group.Proposal, groupIn
 	// If the result was final (i.e. enough votes to pass) or if the voting
 	// period ended, then we consider the proposal as final.
 	if isFinal := result.Final || k.environment.HeaderService.GetHeaderInfo(ctx).Time.After(p.VotingPeriodEnd); isFinal {
+		if policyInfo.StoreVoteReceipts {
+			if err := k.storeVoteReceipts(ctx, p.Id, policyInfo.GroupId); err != nil {
+				return err
+			}
+		}
 		if err := k.pruneVotes(ctx, p.Id); err != nil {
 			return err
 		}
 		p.FinalTallyResult = tallyResult
 		if result.Allow {
 			p.Status = group.PROPOSAL_STATUS_ACCEPTED
+			if err := k.grantExecutionFeeAllowances(ctx, p, policyInfo); err != nil {
+				return err
+			}
 		} else {
 			p.Status = group.PROPOSAL_STATUS_REJECTED
 		}
@@ -877,7 +973,14 @@ func (k Keeper) Exec(goCtx context.Context, msg *group.MsgExec) (*group.MsgExecR
 	// Update proposal in proposalTable
 	// If proposal has successfully run, delete it from state.
 	if proposal.ExecutorResult == group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
-		if err := k.pruneProposal(ctx, proposal.Id); err != nil {
+		// A recurring proposal keeps on executing its messages after being pruned.
+		if proposal.RecurrenceEpochs > 0 {
+			if err := k.scheduleRecurringProposal(ctx, proposal); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := k.pruneProposal(ctx, proposal); err != nil {
 			return nil, err
 		}
 
@@ -1163,3 +1266,39 @@ func validateMsgs(msgs []sdk.Msg) error {
 
 	return nil
 }
+
+// CancelRecurringProposal implements the MsgServer/CancelRecurringProposal method.
+func (k Keeper) CancelRecurringProposal(ctx context.Context, msg *group.MsgCancelRecurringProposal) (*group.MsgCancelRecurringProposalResponse, error) {
+	if msg.ProposalId == 0 {
+		return nil, errorsmod.Wrap(errors.ErrEmpty, "proposal id")
+	}
+
+	if _, err := k.accKeeper.AddressCodec().StringToBytes(msg.Address); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid group policy admin / group policy address: %s", msg.Address)
+	}
+
+	recurringProposal, err := k.getRecurringProposal(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	policyInfo, err := k.getGroupPolicyInfo(ctx, recurringProposal.GroupPolicyAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "load group policy")
+	}
+
+	// Only the group policy admin or the group policy itself (through a proposal) can cancel a recurrence.
+	if msg.Address != policyInfo.Admin && msg.Address != policyInfo.Address {
+		return nil, errorsmod.Wrapf(errors.ErrUnauthorized, "given address is neither group policy admin nor group policy account: %s", msg.Address)
+	}
+
+	if err := k.recurringProposalTable.Delete(k.environment.KVStoreService.OpenKVStore(ctx), &recurringProposal); err != nil {
+		return nil, err
+	}
+
+	if err := k.environment.EventService.EventManager(ctx).Emit(&group.EventRecurringProposalCancelled{ProposalId: msg.ProposalId}); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgCancelRecurringProposalResponse{}, nil
+}
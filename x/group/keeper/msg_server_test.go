@@ -14,17 +14,21 @@ import (
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
+	authtypes "cosmossdk.io/x/auth/types"
 	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/group"
+	"cosmossdk.io/x/group/errors"
 	"cosmossdk.io/x/group/internal/math"
 	"cosmossdk.io/x/group/keeper"
 	minttypes "cosmossdk.io/x/mint/types"
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
@@ -3028,6 +3032,366 @@ func (s *TestSuite) TestExecPrunedProposalsAndVotes() {
 	}
 }
 
+func (s *TestSuite) TestRecurringProposal() {
+	addr1 := s.addrs[0]
+	addr2 := s.addrs[1]
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: s.groupPolicyAddr.String(),
+		ToAddress:   addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	proposers := []string{addr2.String()}
+	interval := 2 * group.DefaultConfig().RecurringProposalEpoch
+
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+
+	// recurring proposals must have messages
+	_, err := s.groupKeeper.SubmitProposal(sdkCtx, &group.MsgSubmitProposal{
+		GroupPolicyAddress: s.groupPolicyAddr.String(),
+		Proposers:          proposers,
+		RecurrenceEpochs:   2,
+	})
+	s.Require().ErrorContains(err, "recurring proposal messages")
+
+	proposalReq := &group.MsgSubmitProposal{
+		GroupPolicyAddress: s.groupPolicyAddr.String(),
+		Proposers:          proposers,
+		RecurrenceEpochs:   2,
+	}
+	s.Require().NoError(proposalReq.SetMsgs([]sdk.Msg{msgSend}))
+	proposalRes, err := s.groupKeeper.SubmitProposal(sdkCtx, proposalReq)
+	s.Require().NoError(err)
+	proposalID := proposalRes.ProposalId
+
+	_, err = s.groupKeeper.Vote(sdkCtx, &group.MsgVote{ProposalId: proposalID, Voter: proposers[0], Option: group.VOTE_OPTION_YES})
+	s.Require().NoError(err)
+
+	// executing the proposal schedules its recurrence
+	execTime := s.blockTime.Add(minExecutionPeriod)
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{Time: execTime})
+	s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, nil).Times(2)
+	_, err = s.groupKeeper.Exec(sdkCtx, &group.MsgExec{Executor: addr1.String(), ProposalId: proposalID})
+	s.Require().NoError(err)
+
+	res, err := s.groupKeeper.RecurringProposal(sdkCtx, &group.QueryRecurringProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(s.groupPolicyAddr.String(), res.RecurringProposal.GroupPolicyAddress)
+	s.Require().Equal(execTime.Add(interval), res.RecurringProposal.NextExecutionTime)
+	s.Require().Equal(uint64(0), res.RecurringProposal.Executions)
+
+	// nothing is executed before the next execution time
+	s.Require().NoError(s.groupKeeper.ExecuteRecurringProposals(sdkCtx.WithHeaderInfo(header.Info{Time: execTime.Add(interval / 2)}), s.environment))
+
+	// the messages are executed again once the next execution time is reached
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{Time: execTime.Add(interval).Add(time.Second)})
+	s.Require().NoError(s.groupKeeper.ExecuteRecurringProposals(sdkCtx, s.environment))
+
+	resByPolicy, err := s.groupKeeper.RecurringProposalsByGroupPolicy(sdkCtx, &group.QueryRecurringProposalsByGroupPolicyRequest{Address: s.groupPolicyAddr.String()})
+	s.Require().NoError(err)
+	s.Require().Len(resByPolicy.RecurringProposals, 1)
+	s.Require().Equal(uint64(1), resByPolicy.RecurringProposals[0].Executions)
+	s.Require().Equal(execTime.Add(2*interval), resByPolicy.RecurringProposals[0].NextExecutionTime)
+
+	// a panicking message handler fails the execution, which is still rescheduled
+	s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Do(func(context.Context, *banktypes.MsgSend) { panic("out of gas") })
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{Time: execTime.Add(2 * interval).Add(time.Second)})
+	s.Require().NoError(s.groupKeeper.ExecuteRecurringProposals(sdkCtx, s.environment))
+
+	res, err = s.groupKeeper.RecurringProposal(sdkCtx, &group.QueryRecurringProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), res.RecurringProposal.Executions)
+	s.Require().Equal(execTime.Add(3*interval), res.RecurringProposal.NextExecutionTime)
+
+	// only the group policy admin or account can cancel the recurrence
+	_, err = s.groupKeeper.CancelRecurringProposal(sdkCtx, &group.MsgCancelRecurringProposal{ProposalId: proposalID, Address: addr2.String()})
+	s.Require().ErrorContains(err, "neither group policy admin nor group policy account")
+
+	_, err = s.groupKeeper.CancelRecurringProposal(sdkCtx, &group.MsgCancelRecurringProposal{ProposalId: proposalID, Address: addr1.String()})
+	s.Require().NoError(err)
+
+	_, err = s.groupKeeper.RecurringProposal(sdkCtx, &group.QueryRecurringProposalRequest{ProposalId: proposalID})
+	s.Require().ErrorContains(err, "load recurring proposal: not found")
+
+	// a cancelled recurrence is not executed anymore
+	s.Require().NoError(s.groupKeeper.ExecuteRecurringProposals(sdkCtx.WithHeaderInfo(header.Info{Time: execTime.Add(3 * interval)}), s.environment))
+
+	// the recurrence is cancelled once the group is updated
+	proposalRes, err = s.groupKeeper.SubmitProposal(sdkCtx, proposalReq)
+	s.Require().NoError(err)
+	proposalID = proposalRes.ProposalId
+
+	_, err = s.groupKeeper.Vote(sdkCtx, &group.MsgVote{ProposalId: proposalID, Voter: proposers[0], Option: group.VOTE_OPTION_YES})
+	s.Require().NoError(err)
+
+	execTime = sdkCtx.HeaderInfo().Time.Add(minExecutionPeriod)
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{Time: execTime})
+	s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, nil)
+	_, err = s.groupKeeper.Exec(sdkCtx, &group.MsgExec{Executor: addr1.String(), ProposalId: proposalID})
+	s.Require().NoError(err)
+
+	_, err = s.groupKeeper.UpdateGroupMetadata(sdkCtx, &group.MsgUpdateGroupMetadata{Admin: addr1.String(), GroupId: s.groupID})
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.groupKeeper.ExecuteRecurringProposals(sdkCtx.WithHeaderInfo(header.Info{Time: execTime.Add(interval).Add(time.Second)}), s.environment))
+	_, err = s.groupKeeper.RecurringProposal(sdkCtx, &group.QueryRecurringProposalRequest{ProposalId: proposalID})
+	s.Require().ErrorContains(err, "load recurring proposal: not found")
+}
+
+func (s *TestSuite) TestVoteReceipts() {
+	admin := s.addrs[0].String()
+	voters := []string{s.addrs[1].String(), s.addrs[4].String()}
+
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+
+	// only the group policy admin can enable vote receipts
+	_, err := s.groupKeeper.UpdateGroupPolicyVoteReceipts(sdkCtx, &group.MsgUpdateGroupPolicyVoteReceipts{
+		Admin:              voters[0],
+		GroupPolicyAddress: s.groupPolicyAddr.String(),
+		StoreVoteReceipts:  true,
+	})
+	s.Require().ErrorContains(err, "not group policy admin")
+
+	_, err = s.groupKeeper.UpdateGroupPolicyVoteReceipts(sdkCtx, &group.MsgUpdateGroupPolicyVoteReceipts{
+		Admin:              admin,
+		GroupPolicyAddress: s.groupPolicyAddr.String(),
+		StoreVoteReceipts:  true,
+	})
+	s.Require().NoError(err)
+
+	policyRes, err := s.groupKeeper.GroupPolicyInfo(sdkCtx, &group.QueryGroupPolicyInfoRequest{Address: s.groupPolicyAddr.String()})
+	s.Require().NoError(err)
+	s.Require().True(policyRes.Info.StoreVoteReceipts)
+
+	proposalRes, err := s.groupKeeper.SubmitProposal(sdkCtx, &group.MsgSubmitProposal{
+		GroupPolicyAddress: s.groupPolicyAddr.String(),
+		Proposers:          voters[:1],
+	})
+	s.Require().NoError(err)
+	proposalID := proposalRes.ProposalId
+
+	_, err = s.groupKeeper.Vote(sdkCtx, &group.MsgVote{ProposalId: proposalID, Voter: voters[0], Option: group.VOTE_OPTION_YES})
+	s.Require().NoError(err)
+	_, err = s.groupKeeper.Vote(sdkCtx, &group.MsgVote{ProposalId: proposalID, Voter: voters[1], Option: group.VOTE_OPTION_NO, Metadata: "too costly"})
+	s.Require().NoError(err)
+
+	// no vote receipts before the final tally
+	res, err := s.groupKeeper.ProposalVoteReceipts(sdkCtx, &group.QueryProposalVoteReceiptsRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Empty(res.VoteReceipts)
+
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{Time: s.blockTime.Add(2 * time.Second)})
+	s.Require().NoError(s.groupKeeper.TallyProposalsAtVPEnd(sdkCtx, s.environment))
+
+	// votes are pruned, but kept as vote receipts with their voter weight
+	votesRes, err := s.groupKeeper.VotesByProposal(sdkCtx, &group.QueryVotesByProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Empty(votesRes.Votes)
+
+	res, err = s.groupKeeper.ProposalVoteReceipts(sdkCtx, &group.QueryProposalVoteReceiptsRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Len(res.VoteReceipts, 2)
+	receipts := make(map[string]*group.VoteReceipt)
+	for _, r := range res.VoteReceipts {
+		receipts[r.Voter] = r
+	}
+	s.Require().Equal(group.VOTE_OPTION_YES, receipts[voters[0]].Option)
+	s.Require().Equal("2", receipts[voters[0]].Weight)
+	s.Require().Equal(group.VOTE_OPTION_NO, receipts[voters[1]].Option)
+	s.Require().Equal("1", receipts[voters[1]].Weight)
+	s.Require().Equal("too costly", receipts[voters[1]].Metadata)
+
+	var tallies int
+	for _, e := range sdkCtx.EventManager().Events() {
+		if e.Type == "cosmos.group.v1.EventMemberTally" {
+			tallies++
+		}
+	}
+	s.Require().Equal(2, tallies)
+}
+
+// feegrantKeeper keeps the basic allowances granted by the group keeper in memory.
+type feegrantKeeper struct {
+	allowances map[string]sdk.Coins
+}
+
+func (f *feegrantKeeper) GrantBasicAllowance(_ context.Context, granter, grantee sdk.AccAddress, spendLimit sdk.Coins, _ *time.Time) error {
+	f.allowances[granter.String()+grantee.String()] = spendLimit
+	return nil
+}
+
+func (f *feegrantKeeper) RevokeAllowance(_ context.Context, granter, grantee sdk.AccAddress) error {
+	delete(f.allowances, granter.String()+grantee.String())
+	return nil
+}
+
+func (f *feegrantKeeper) HasAllowance(_ context.Context, granter, grantee sdk.AccAddress) (bool, error) {
+	_, ok := f.allowances[granter.String()+grantee.String()]
+	return ok, nil
+}
+
+func (s *TestSuite) TestExecutionFeeAllowances() {
+	admin := s.addrs[0].String()
+	members := []sdk.AccAddress{s.addrs[1], s.addrs[4]}
+	feeLimit := sdk.NewCoins(sdk.NewInt64Coin("test", 100))
+
+	feegrant := &feegrantKeeper{allowances: make(map[string]sdk.Coins)}
+	s.groupKeeper.SetFeegrantKeeper(feegrant)
+
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+
+	// only the group policy admin can set the execution fee limit
+	_, err := s.groupKeeper.UpdateGroupPolicyExecutionFeeLimit(sdkCtx, &group.MsgUpdateGroupPolicyExecutionFeeLimit{
+		Admin:              members[0].String(),
+		GroupPolicyAddress: s.groupPolicyAddr.String(),
+		ExecutionFeeLimit:  feeLimit,
+	})
+	s.Require().ErrorContains(err, "not group policy admin")
+
+	_, err = s.groupKeeper.UpdateGroupPolicyExecutionFeeLimit(sdkCtx, &group.MsgUpdateGroupPolicyExecutionFeeLimit{
+		Admin:              admin,
+		GroupPolicyAddress: s.groupPolicyAddr.String(),
+		ExecutionFeeLimit:  feeLimit,
+	})
+	s.Require().NoError(err)
+
+	policyRes, err := s.groupKeeper.GroupPolicyInfo(sdkCtx, &group.QueryGroupPolicyInfoRequest{Address: s.groupPolicyAddr.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(feeLimit, policyRes.Info.ExecutionFeeLimit)
+
+	// an allowance not granted by the group keeper is left as is
+	otherLimit := sdk.NewCoins(sdk.NewInt64Coin("test", 5))
+	s.Require().NoError(feegrant.GrantBasicAllowance(sdkCtx, s.groupPolicyAddr, members[0], otherLimit, nil))
+
+	submitAndAccept := func(ctx sdk.Context) uint64 {
+		proposalRes, err := s.groupKeeper.SubmitProposal(ctx, &group.MsgSubmitProposal{
+			GroupPolicyAddress: s.groupPolicyAddr.String(),
+			Proposers:          []string{members[0].String()},
+		})
+		s.Require().NoError(err)
+		_, err = s.groupKeeper.Vote(ctx, &group.MsgVote{ProposalId: proposalRes.ProposalId, Voter: members[0].String(), Option: group.VOTE_OPTION_YES})
+		s.Require().NoError(err)
+		return proposalRes.ProposalId
+	}
+
+	firstID := submitAndAccept(sdkCtx)
+	secondID := submitAndAccept(sdkCtx)
+
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{Time: s.blockTime.Add(2 * time.Second)})
+	s.Require().NoError(s.groupKeeper.TallyProposalsAtVPEnd(sdkCtx, s.environment))
+
+	// both proposals share the allowance granted to the member without one
+	for _, id := range []uint64{firstID, secondID} {
+		res, err := s.groupKeeper.Proposal(sdkCtx, &group.QueryProposalRequest{ProposalId: id})
+		s.Require().NoError(err)
+		s.Require().Equal(group.PROPOSAL_STATUS_ACCEPTED, res.Proposal.Status)
+		s.Require().Equal([]string{members[1].String()}, res.Proposal.ExecutionFeeGrantees)
+	}
+	s.Require().Equal(feeLimit, feegrant.allowances[s.groupPolicyAddr.String()+members[1].String()])
+	s.Require().Equal(otherLimit, feegrant.allowances[s.groupPolicyAddr.String()+members[0].String()])
+
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{Time: s.blockTime.Add(minExecutionPeriod + 2*time.Second)})
+
+	// the allowance is kept while the other proposal is open
+	_, err = s.groupKeeper.Exec(sdkCtx, &group.MsgExec{ProposalId: firstID, Executor: members[1].String()})
+	s.Require().NoError(err)
+	s.Require().Contains(feegrant.allowances, s.groupPolicyAddr.String()+members[1].String())
+
+	_, err = s.groupKeeper.Exec(sdkCtx, &group.MsgExec{ProposalId: secondID, Executor: members[1].String()})
+	s.Require().NoError(err)
+	s.Require().NotContains(feegrant.allowances, s.groupPolicyAddr.String()+members[1].String())
+	s.Require().Equal(otherLimit, feegrant.allowances[s.groupPolicyAddr.String()+members[0].String()])
+}
+
+func (s *TestSuite) TestSubmitVotesBatch() {
+	privs := []*secp256k1.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	voters := make([]sdk.AccAddress, len(privs))
+	for i, priv := range privs {
+		voters[i] = sdk.AccAddress(priv.PubKey().Address())
+	}
+
+	// the first voter never sent a transaction, the second one has a public key set
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), voters[0]).Return(nil).AnyTimes()
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), voters[2]).Return(nil).AnyTimes()
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), voters[1]).Return(authtypes.NewBaseAccount(voters[1], privs[1].PubKey(), 1, 0)).AnyTimes()
+
+	policyAddr, _ := s.createGroupAndGroupPolicy(s.addrs[0], []group.MemberRequest{
+		{Address: voters[0].String(), Weight: "1"},
+		{Address: voters[1].String(), Weight: "1"},
+	}, group.NewThresholdDecisionPolicy("2", time.Second, 0))
+
+	proposalRes, err := s.groupKeeper.SubmitProposal(s.ctx, &group.MsgSubmitProposal{
+		GroupPolicyAddress: policyAddr,
+		Proposers:          []string{voters[0].String()},
+	})
+	s.Require().NoError(err)
+
+	chainID := "test-chain"
+	sdkCtx := s.sdkCtx.WithHeaderInfo(header.Info{Time: s.blockTime, ChainID: chainID})
+	signVote := func(priv *secp256k1.PrivKey, voter sdk.AccAddress, chainID string) group.SignedVote {
+		vote := group.SignedVote{ProposalId: proposalRes.ProposalId, Voter: voter.String(), Option: group.VOTE_OPTION_YES}
+		sig, err := priv.Sign(vote.SignBytes(chainID))
+		s.Require().NoError(err)
+		vote, err = group.NewSignedVote(vote.ProposalId, vote.Voter, vote.Option, vote.Metadata, priv.PubKey(), sig)
+		s.Require().NoError(err)
+		return vote
+	}
+	relayer := s.addrs[5].String()
+
+	testCases := []struct {
+		name   string
+		votes  []group.SignedVote
+		expErr error
+	}{
+		{
+			name:   "no votes",
+			expErr: errors.ErrEmpty,
+		},
+		{
+			name:   "signed for another chain",
+			votes:  []group.SignedVote{signVote(privs[0], voters[0], "other-chain")},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:   "signed with a key not matching the voter address",
+			votes:  []group.SignedVote{signVote(privs[2], voters[0], chainID)},
+			expErr: sdkerrors.ErrInvalidPubKey,
+		},
+		{
+			name:   "signed with a key not matching the voter account",
+			votes:  []group.SignedVote{signVote(privs[2], voters[1], chainID)},
+			expErr: sdkerrors.ErrInvalidPubKey,
+		},
+		{
+			name:   "signed by a non member",
+			votes:  []group.SignedVote{signVote(privs[2], voters[2], chainID)},
+			expErr: sdkerrors.ErrNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.groupKeeper.SubmitVotesBatch(sdkCtx, &group.MsgSubmitVotesBatch{Relayer: relayer, Votes: tc.votes})
+			s.Require().ErrorIs(err, tc.expErr)
+		})
+	}
+
+	votes := []group.SignedVote{signVote(privs[0], voters[0], chainID), signVote(privs[1], voters[1], chainID)}
+	_, err = s.groupKeeper.SubmitVotesBatch(sdkCtx, &group.MsgSubmitVotesBatch{Relayer: relayer, Votes: votes})
+	s.Require().NoError(err)
+
+	for _, voter := range voters[:2] {
+		res, err := s.groupKeeper.VoteByProposalVoter(sdkCtx, &group.QueryVoteByProposalVoterRequest{ProposalId: proposalRes.ProposalId, Voter: voter.String()})
+		s.Require().NoError(err)
+		s.Require().Equal(group.VOTE_OPTION_YES, res.Vote.Option)
+	}
+
+	// a signed vote cannot be replayed
+	_, err = s.groupKeeper.SubmitVotesBatch(sdkCtx, &group.MsgSubmitVotesBatch{Relayer: relayer, Votes: votes[:1]})
+	s.Require().ErrorContains(err, "store vote")
+}
+
 func (s *TestSuite) TestLeaveGroup() {
 	addrs := simtestutil.CreateIncrementalAccounts(7)
 
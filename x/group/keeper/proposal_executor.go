@@ -39,12 +39,17 @@ func (s Keeper) doExecuteMsgs(ctx sdk.Context, router baseapp.MessageRouter, pro
 		return nil, err
 	}
 
+	return s.executeMsgs(ctx, router, msgs, groupPolicyAcc)
+}
+
+// executeMsgs routes the given messages, which must all be authorized by the group policy account, to their handlers.
+func (s Keeper) executeMsgs(ctx sdk.Context, router baseapp.MessageRouter, msgs []sdk.Msg, groupPolicyAcc sdk.AccAddress) ([]sdk.Result, error) {
 	results := make([]sdk.Result, len(msgs))
 	if err := ensureMsgAuthZ(msgs, groupPolicyAcc, s.cdc); err != nil {
 		return nil, err
 	}
 	for i, msg := range msgs {
-		handler := s.router.Handler(msg)
+		handler := router.Handler(msg)
 		if handler == nil {
 			return nil, errorsmod.Wrapf(errors.ErrInvalid, "no message handler found for %q", sdk.MsgTypeURL(msg))
 		}
@@ -62,6 +67,18 @@ func (s Keeper) doExecuteMsgs(ctx sdk.Context, router baseapp.MessageRouter, pro
 	return results, nil
 }
 
+// safeExecuteMsgs executes the messages like executeMsgs and recovers from a
+// panic of their handlers, as recurring proposals are executed in the EndBlocker.
+func (s Keeper) safeExecuteMsgs(ctx sdk.Context, router baseapp.MessageRouter, msgs []sdk.Msg, groupPolicyAcc sdk.AccAddress) (results []sdk.Result, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("handling x/group recurring proposal msgs panicked: %v", r)
+		}
+	}()
+
+	return s.executeMsgs(ctx, router, msgs, groupPolicyAcc)
+}
+
 // ensureMsgAuthZ checks that if a message requires signers that all of them
 // are equal to the given account address of group policy.
 func ensureMsgAuthZ(msgs []sdk.Msg, groupPolicyAcc sdk.AccAddress, cdc codec.Codec) error {
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/group"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// gasCostPerSignedVote is the gas consumed to verify the signature of a vote
+// signed off-chain, matching the default cost of a secp256k1 signature
+// verification in the ante handler.
+const gasCostPerSignedVote = uint64(1000)
+
+// verifySignedVote checks that a vote was signed off-chain by its voter. The
+// vote must be signed with the public key of the voter account or, if the
+// account has no public key yet, e.g. because it never sent a transaction, with
+// a public key matching the voter address.
+func (k Keeper) verifySignedVote(ctx context.Context, chainID string, vote group.SignedVote) error {
+	voterAddr, err := k.accKeeper.AddressCodec().StringToBytes(vote.Voter)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid voter address: %s", vote.Voter)
+	}
+
+	pubKey, err := vote.GetPubKeyValue()
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+
+	if acc := k.accKeeper.GetAccount(ctx, voterAddr); acc != nil && acc.GetPubKey() != nil {
+		if !acc.GetPubKey().Equals(pubKey) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "public key does not match the account of voter %s", vote.Voter)
+		}
+	} else if !bytes.Equal(pubKey.Address(), voterAddr) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "public key does not match the address of voter %s", vote.Voter)
+	}
+
+	if !pubKey.VerifySignature(vote.SignBytes(chainID), vote.Signature) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "signature verification failed for voter %s", vote.Voter)
+	}
+
+	return nil
+}
@@ -121,6 +121,30 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "groups",
 					Short:     "Query for all groups on chain",
 				},
+				{
+					RpcMethod: "RecurringProposal",
+					Use:       "recurring-proposal [proposal-id]",
+					Short:     "Query for recurring proposal by proposal id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+				},
+				{
+					RpcMethod: "RecurringProposalsByGroupPolicy",
+					Use:       "recurring-proposals-by-group-policy [group-policy-account]",
+					Short:     "Query for recurring proposals by account address of group policy",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"},
+					},
+				},
+				{
+					RpcMethod: "ProposalVoteReceipts",
+					Use:       "proposal-vote-receipts [proposal-id]",
+					Short:     "Query the vote receipts of a finally tallied proposal whose group policy stores vote receipts",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -159,6 +183,22 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "admin"}, {ProtoField: "group_policy_address"}, {ProtoField: "metadata"},
 					},
 				},
+				{
+					RpcMethod: "UpdateGroupPolicyExecutionFeeLimit",
+					Use:       "update-group-policy-execution-fee-limit [admin] [group-policy-account] [execution-fee-limit]",
+					Short:     "Update the fees a group policy grants its members for executing its accepted proposals",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "admin"}, {ProtoField: "group_policy_address"}, {ProtoField: "execution_fee_limit", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "UpdateGroupPolicyVoteReceipts",
+					Use:       "update-group-policy-vote-receipts [admin] [group-policy-account] [store-vote-receipts]",
+					Short:     "Update whether a group policy keeps the votes on its proposals as vote receipts after their final tally",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "admin"}, {ProtoField: "group_policy_address"}, {ProtoField: "store_vote_receipts"},
+					},
+				},
 				{
 					RpcMethod: "WithdrawProposal",
 					Use:       "withdraw-proposal [proposal-id] [group-policy-admin-or-proposer]",
@@ -205,6 +245,14 @@ Parameters:
 						{ProtoField: "address"}, {ProtoField: "group_id"},
 					},
 				},
+				{
+					RpcMethod: "CancelRecurringProposal",
+					Use:       "cancel-recurring-proposal [proposal-id] [group-policy-admin-or-account]",
+					Short:     "Cancel the recurrence of a recurring proposal",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"}, {ProtoField: "address"},
+					},
+				},
 			},
 		},
 	}
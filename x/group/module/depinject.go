@@ -33,6 +33,7 @@ type GroupInputs struct {
 	Cdc              codec.Codec
 	AccountKeeper    group.AccountKeeper
 	BankKeeper       group.BankKeeper
+	FeegrantKeeper   group.FeegrantKeeper `optional:"true"`
 	Registry         cdctypes.InterfaceRegistry
 	MsgServiceRouter baseapp.MessageRouter
 }
@@ -50,12 +51,17 @@ func ProvideModule(in GroupInputs) GroupOutputs {
 		in.MsgServiceRouter,
 		in.AccountKeeper,
 		group.Config{
-			MaxExecutionPeriod:    in.Config.MaxExecutionPeriod.AsDuration(),
-			MaxMetadataLen:        in.Config.MaxMetadataLen,
-			MaxProposalTitleLen:   in.Config.MaxProposalTitleLen,
-			MaxProposalSummaryLen: in.Config.MaxProposalSummaryLen,
+			MaxExecutionPeriod:             in.Config.MaxExecutionPeriod.AsDuration(),
+			MaxMetadataLen:                 in.Config.MaxMetadataLen,
+			MaxProposalTitleLen:            in.Config.MaxProposalTitleLen,
+			MaxProposalSummaryLen:          in.Config.MaxProposalSummaryLen,
+			RecurringProposalEpoch:         in.Config.RecurringProposalEpoch.AsDuration(),
+			MaxRecurringProposalExecutions: in.Config.MaxRecurringProposalExecutions,
 		},
 	)
+	if in.FeegrantKeeper != nil {
+		k.SetFeegrantKeeper(in.FeegrantKeeper)
+	}
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.Registry)
 	return GroupOutputs{GroupKeeper: k, Module: m}
 }
@@ -1,9 +1,12 @@
 package group
 
 import (
+	"fmt"
+
 	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx"
@@ -17,12 +20,16 @@ var (
 	_ sdk.Msg = &MsgUpdateGroupMembers{}
 	_ sdk.Msg = &MsgCreateGroupWithPolicy{}
 	_ sdk.Msg = &MsgCreateGroupPolicy{}
+	_ sdk.Msg = &MsgCancelRecurringProposal{}
 	_ sdk.Msg = &MsgUpdateGroupPolicyAdmin{}
 	_ sdk.Msg = &MsgUpdateGroupPolicyDecisionPolicy{}
 	_ sdk.Msg = &MsgUpdateGroupPolicyMetadata{}
+	_ sdk.Msg = &MsgUpdateGroupPolicyVoteReceipts{}
+	_ sdk.Msg = &MsgUpdateGroupPolicyExecutionFeeLimit{}
 	_ sdk.Msg = &MsgLeaveGroup{}
 	_ sdk.Msg = &MsgExec{}
 	_ sdk.Msg = &MsgVote{}
+	_ sdk.Msg = &MsgSubmitVotesBatch{}
 	_ sdk.Msg = &MsgWithdrawProposal{}
 	_ sdk.Msg = &MsgSubmitProposal{}
 	_ sdk.Msg = &MsgCreateGroupPolicy{}
@@ -30,6 +37,8 @@ var (
 	_ types.UnpackInterfacesMessage = MsgCreateGroupPolicy{}
 	_ types.UnpackInterfacesMessage = MsgUpdateGroupPolicyDecisionPolicy{}
 	_ types.UnpackInterfacesMessage = MsgCreateGroupWithPolicy{}
+	_ types.UnpackInterfacesMessage = MsgSubmitVotesBatch{}
+	_ types.UnpackInterfacesMessage = SignedVote{}
 )
 
 // GetGroupID gets the group id of the MsgUpdateGroupMetadata.
@@ -221,3 +230,62 @@ func (m MsgSubmitProposal) GetMsgs() ([]sdk.Msg, error) {
 func (m MsgSubmitProposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	return tx.UnpackInterfaces(unpacker, m.Messages)
 }
+
+// NewSignedVote creates a new SignedVote, signed off-chain by the voter with
+// the given public key.
+func NewSignedVote(proposalID uint64, voter string, option VoteOption, metadata string, pubKey cryptotypes.PubKey, signature []byte) (SignedVote, error) {
+	pkAny, err := types.NewAnyWithValue(pubKey)
+	if err != nil {
+		return SignedVote{}, err
+	}
+
+	return SignedVote{
+		ProposalId: proposalID,
+		Voter:      voter,
+		Option:     option,
+		Metadata:   metadata,
+		PubKey:     pkAny,
+		Signature:  signature,
+	}, nil
+}
+
+// SignBytes returns the bytes the voter signs off-chain to vote through a
+// MsgSubmitVotesBatch. They bind the chain, so a signed vote can't be replayed
+// on another chain, and a voter can only vote once on a proposal.
+func (v SignedVote) SignBytes(chainID string) []byte {
+	return []byte(fmt.Sprintf(
+		"cosmos-sdk/group/SignedVote\nchain_id: %s\nproposal_id: %d\nvoter: %s\noption: %s\nmetadata: %s",
+		chainID, v.ProposalId, v.Voter, v.Option, v.Metadata,
+	))
+}
+
+// GetPubKeyValue returns the public key the vote is signed with.
+func (v SignedVote) GetPubKeyValue() (cryptotypes.PubKey, error) {
+	if v.PubKey == nil {
+		return nil, fmt.Errorf("signed vote of %s has no public key", v.Voter)
+	}
+
+	pubKey, ok := v.PubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (cryptotypes.PubKey)(nil), v.PubKey.GetCachedValue())
+	}
+
+	return pubKey, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (v SignedVote) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(v.PubKey, &pubKey)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgSubmitVotesBatch) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, vote := range m.Votes {
+		if err := vote.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
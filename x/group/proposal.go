@@ -25,3 +25,13 @@ func (p *Proposal) SetMsgs(msgs []sdk.Msg) error {
 func (p Proposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	return tx.UnpackInterfaces(unpacker, p.Messages)
 }
+
+// GetMsgs unpacks p.Messages Any's into sdk.Msg's
+func (p *RecurringProposal) GetMsgs() ([]sdk.Msg, error) {
+	return tx.GetMsgs(p.Messages, "recurring proposal")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p RecurringProposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return tx.UnpackInterfaces(unpacker, p.Messages)
+}
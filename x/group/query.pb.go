@@ -1486,6 +1486,338 @@ func (m *QueryGroupsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryRecurringProposalRequest is the Query/RecurringProposal request type.
+//
+// Since: x/group 1.0.0
+type QueryRecurringProposalRequest struct {
+	// proposal_id is the unique ID of the proposal the recurrence originates from.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryRecurringProposalRequest) Reset()         { *m = QueryRecurringProposalRequest{} }
+func (m *QueryRecurringProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecurringProposalRequest) ProtoMessage()    {}
+func (*QueryRecurringProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{28}
+}
+func (m *QueryRecurringProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecurringProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecurringProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecurringProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecurringProposalRequest.Merge(m, src)
+}
+func (m *QueryRecurringProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecurringProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecurringProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecurringProposalRequest proto.InternalMessageInfo
+
+func (m *QueryRecurringProposalRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryRecurringProposalResponse is the Query/RecurringProposal response type.
+//
+// Since: x/group 1.0.0
+type QueryRecurringProposalResponse struct {
+	// recurring_proposal is the recurring proposal info.
+	RecurringProposal *RecurringProposal `protobuf:"bytes,1,opt,name=recurring_proposal,json=recurringProposal,proto3" json:"recurring_proposal,omitempty"`
+}
+
+func (m *QueryRecurringProposalResponse) Reset()         { *m = QueryRecurringProposalResponse{} }
+func (m *QueryRecurringProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecurringProposalResponse) ProtoMessage()    {}
+func (*QueryRecurringProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{29}
+}
+func (m *QueryRecurringProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecurringProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecurringProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecurringProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecurringProposalResponse.Merge(m, src)
+}
+func (m *QueryRecurringProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecurringProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecurringProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecurringProposalResponse proto.InternalMessageInfo
+
+func (m *QueryRecurringProposalResponse) GetRecurringProposal() *RecurringProposal {
+	if m != nil {
+		return m.RecurringProposal
+	}
+	return nil
+}
+
+// QueryRecurringProposalsByGroupPolicyRequest is the Query/RecurringProposalsByGroupPolicy request type.
+//
+// Since: x/group 1.0.0
+type QueryRecurringProposalsByGroupPolicyRequest struct {
+	// address is the account address of the group policy related to recurring proposals.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRecurringProposalsByGroupPolicyRequest) Reset() {
+	*m = QueryRecurringProposalsByGroupPolicyRequest{}
+}
+func (m *QueryRecurringProposalsByGroupPolicyRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryRecurringProposalsByGroupPolicyRequest) ProtoMessage() {}
+func (*QueryRecurringProposalsByGroupPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{30}
+}
+func (m *QueryRecurringProposalsByGroupPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecurringProposalsByGroupPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecurringProposalsByGroupPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecurringProposalsByGroupPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecurringProposalsByGroupPolicyRequest.Merge(m, src)
+}
+func (m *QueryRecurringProposalsByGroupPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecurringProposalsByGroupPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecurringProposalsByGroupPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecurringProposalsByGroupPolicyRequest proto.InternalMessageInfo
+
+func (m *QueryRecurringProposalsByGroupPolicyRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryRecurringProposalsByGroupPolicyRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRecurringProposalsByGroupPolicyResponse is the Query/RecurringProposalsByGroupPolicy response type.
+//
+// Since: x/group 1.0.0
+type QueryRecurringProposalsByGroupPolicyResponse struct {
+	// recurring_proposals are the recurring proposals with given group policy.
+	RecurringProposals []*RecurringProposal `protobuf:"bytes,1,rep,name=recurring_proposals,json=recurringProposals,proto3" json:"recurring_proposals,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRecurringProposalsByGroupPolicyResponse) Reset() {
+	*m = QueryRecurringProposalsByGroupPolicyResponse{}
+}
+func (m *QueryRecurringProposalsByGroupPolicyResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryRecurringProposalsByGroupPolicyResponse) ProtoMessage() {}
+func (*QueryRecurringProposalsByGroupPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{31}
+}
+func (m *QueryRecurringProposalsByGroupPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecurringProposalsByGroupPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecurringProposalsByGroupPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecurringProposalsByGroupPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecurringProposalsByGroupPolicyResponse.Merge(m, src)
+}
+func (m *QueryRecurringProposalsByGroupPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecurringProposalsByGroupPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecurringProposalsByGroupPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecurringProposalsByGroupPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryRecurringProposalsByGroupPolicyResponse) GetRecurringProposals() []*RecurringProposal {
+	if m != nil {
+		return m.RecurringProposals
+	}
+	return nil
+}
+
+func (m *QueryRecurringProposalsByGroupPolicyResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryProposalVoteReceiptsRequest is the Query/ProposalVoteReceipts request type.
+//
+// Since: x/group 1.0.0
+type QueryProposalVoteReceiptsRequest struct {
+	// proposal_id is the unique ID of a proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProposalVoteReceiptsRequest) Reset()         { *m = QueryProposalVoteReceiptsRequest{} }
+func (m *QueryProposalVoteReceiptsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalVoteReceiptsRequest) ProtoMessage()    {}
+func (*QueryProposalVoteReceiptsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{32}
+}
+func (m *QueryProposalVoteReceiptsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalVoteReceiptsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalVoteReceiptsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalVoteReceiptsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalVoteReceiptsRequest.Merge(m, src)
+}
+func (m *QueryProposalVoteReceiptsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalVoteReceiptsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalVoteReceiptsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalVoteReceiptsRequest proto.InternalMessageInfo
+
+func (m *QueryProposalVoteReceiptsRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *QueryProposalVoteReceiptsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryProposalVoteReceiptsResponse is the Query/ProposalVoteReceipts response type.
+//
+// Since: x/group 1.0.0
+type QueryProposalVoteReceiptsResponse struct {
+	// vote_receipts are the list of vote receipts for given proposal_id.
+	VoteReceipts []*VoteReceipt `protobuf:"bytes,1,rep,name=vote_receipts,json=voteReceipts,proto3" json:"vote_receipts,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProposalVoteReceiptsResponse) Reset()         { *m = QueryProposalVoteReceiptsResponse{} }
+func (m *QueryProposalVoteReceiptsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalVoteReceiptsResponse) ProtoMessage()    {}
+func (*QueryProposalVoteReceiptsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{33}
+}
+func (m *QueryProposalVoteReceiptsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalVoteReceiptsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalVoteReceiptsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalVoteReceiptsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalVoteReceiptsResponse.Merge(m, src)
+}
+func (m *QueryProposalVoteReceiptsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalVoteReceiptsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalVoteReceiptsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalVoteReceiptsResponse proto.InternalMessageInfo
+
+func (m *QueryProposalVoteReceiptsResponse) GetVoteReceipts() []*VoteReceipt {
+	if m != nil {
+		return m.VoteReceipts
+	}
+	return nil
+}
+
+func (m *QueryProposalVoteReceiptsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGroupInfoRequest)(nil), "cosmos.group.v1.QueryGroupInfoRequest")
 	proto.RegisterType((*QueryGroupInfoResponse)(nil), "cosmos.group.v1.QueryGroupInfoResponse")
@@ -1515,94 +1847,113 @@ func init() {
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.group.v1.QueryTallyResultResponse")
 	proto.RegisterType((*QueryGroupsRequest)(nil), "cosmos.group.v1.QueryGroupsRequest")
 	proto.RegisterType((*QueryGroupsResponse)(nil), "cosmos.group.v1.QueryGroupsResponse")
+	proto.RegisterType((*QueryRecurringProposalRequest)(nil), "cosmos.group.v1.QueryRecurringProposalRequest")
+	proto.RegisterType((*QueryRecurringProposalResponse)(nil), "cosmos.group.v1.QueryRecurringProposalResponse")
+	proto.RegisterType((*QueryRecurringProposalsByGroupPolicyRequest)(nil), "cosmos.group.v1.QueryRecurringProposalsByGroupPolicyRequest")
+	proto.RegisterType((*QueryRecurringProposalsByGroupPolicyResponse)(nil), "cosmos.group.v1.QueryRecurringProposalsByGroupPolicyResponse")
+	proto.RegisterType((*QueryProposalVoteReceiptsRequest)(nil), "cosmos.group.v1.QueryProposalVoteReceiptsRequest")
+	proto.RegisterType((*QueryProposalVoteReceiptsResponse)(nil), "cosmos.group.v1.QueryProposalVoteReceiptsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/query.proto", fileDescriptor_0fcf9f1d74302290) }
 
 var fileDescriptor_0fcf9f1d74302290 = []byte{
-	// 1298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x33, 0xa5, 0xf9, 0xf5, 0xd2, 0x36, 0xea, 0x34, 0x6d, 0x93, 0x6d, 0xe4, 0x84, 0x2d,
-	0xe4, 0x77, 0x76, 0x63, 0x27, 0x4d, 0x11, 0x50, 0xa1, 0x5a, 0x82, 0x90, 0x43, 0x51, 0x6a, 0x2a,
-	0x24, 0x10, 0x52, 0xb4, 0xae, 0x37, 0xd6, 0x0a, 0x7b, 0xc7, 0xf5, 0x6e, 0x22, 0xac, 0xc8, 0x17,
-	0x24, 0x38, 0x20, 0x0e, 0xd0, 0x22, 0x54, 0x22, 0x0e, 0x3d, 0x20, 0xd1, 0x23, 0x07, 0x10, 0x12,
-	0xb7, 0xde, 0x7a, 0xac, 0xe0, 0xc2, 0x09, 0xa1, 0x04, 0x89, 0x3f, 0x82, 0x0b, 0xda, 0x99, 0xb7,
-	0xf6, 0xfe, 0xf6, 0x5a, 0x58, 0xe0, 0x4b, 0x55, 0xef, 0xbc, 0x99, 0xf7, 0x99, 0xef, 0x7b, 0x3b,
-	0xfb, 0x9d, 0xc0, 0x95, 0xbb, 0xcc, 0xaa, 0x32, 0x4b, 0x2d, 0xd7, 0xd9, 0x7e, 0x4d, 0x3d, 0xc8,
-	0xaa, 0xf7, 0xf6, 0xf5, 0x7a, 0x43, 0xa9, 0xd5, 0x99, 0xcd, 0xe8, 0xb8, 0x18, 0x54, 0xf8, 0xa0,
-	0x72, 0x90, 0x95, 0x26, 0xca, 0xac, 0xcc, 0xf8, 0x98, 0xea, 0xfc, 0x4f, 0x84, 0x49, 0xd3, 0x65,
-	0xc6, 0xca, 0x15, 0x5d, 0xd5, 0x6a, 0x86, 0xaa, 0x99, 0x26, 0xb3, 0x35, 0xdb, 0x60, 0xa6, 0x85,
-	0xa3, 0xa1, 0x0c, 0x76, 0xa3, 0xa6, 0xbb, 0x83, 0x4b, 0x38, 0x58, 0xd4, 0x2c, 0x5d, 0xa4, 0x56,
-	0x0f, 0xb2, 0x45, 0xdd, 0xd6, 0xb2, 0x6a, 0x4d, 0x2b, 0x1b, 0x26, 0x5f, 0x09, 0x63, 0xa7, 0x44,
-	0xec, 0xae, 0xc8, 0x8f, 0x68, 0x62, 0xe8, 0xbc, 0x56, 0x35, 0x4c, 0xa6, 0xf2, 0x7f, 0xc5, 0x23,
-	0x39, 0x07, 0x17, 0x6f, 0x3b, 0xeb, 0x6d, 0x39, 0x69, 0xb7, 0xcd, 0x3d, 0x56, 0xd0, 0xef, 0xed,
-	0xeb, 0x96, 0x4d, 0xa7, 0x60, 0x84, 0xa3, 0xec, 0x1a, 0xa5, 0x49, 0x32, 0x4b, 0x16, 0x4e, 0x17,
-	0x86, 0xf9, 0xef, 0xed, 0x92, 0xfc, 0x26, 0x5c, 0x0a, 0xce, 0xb1, 0x6a, 0xcc, 0xb4, 0x74, 0xaa,
-	0xc0, 0x69, 0xc3, 0xdc, 0x63, 0x7c, 0xc2, 0x58, 0x4e, 0x52, 0x02, 0xc2, 0x28, 0xed, 0x19, 0x3c,
-	0x4e, 0xbe, 0x0d, 0x57, 0xda, 0x2b, 0xed, 0xb0, 0x8a, 0x71, 0xb7, 0xe1, 0x65, 0xc8, 0xc1, 0xb0,
-	0x56, 0x2a, 0xd5, 0x75, 0xcb, 0xe2, 0x2b, 0x8e, 0xe6, 0x27, 0x7f, 0xf9, 0x61, 0x75, 0x02, 0x17,
-	0xbd, 0x29, 0x46, 0xde, 0xb6, 0xeb, 0x86, 0x59, 0x2e, 0xb8, 0x81, 0xf2, 0x1d, 0x98, 0x8e, 0x5e,
-	0x12, 0x11, 0x37, 0x7c, 0x88, 0xb3, 0xd1, 0x88, 0x9e, 0x79, 0x02, 0xb4, 0x09, 0x93, 0xed, 0x55,
-	0x6f, 0xe9, 0xd5, 0xa2, 0x5e, 0xb7, 0x3a, 0x2b, 0x45, 0xdf, 0x00, 0x68, 0xd7, 0x67, 0xf2, 0x14,
-	0x4f, 0x39, 0xe7, 0xa6, 0x74, 0x8a, 0xa9, 0x88, 0x3e, 0xc2, 0x62, 0x2a, 0x3b, 0x5a, 0x59, 0xc7,
-	0x65, 0x0b, 0x9e, 0x99, 0xf2, 0x37, 0x04, 0xa6, 0x22, 0xf2, 0xe3, 0x96, 0x36, 0x61, 0xb8, 0x2a,
-	0x1e, 0x4d, 0x92, 0xd9, 0xe7, 0x16, 0xc6, 0x72, 0xd3, 0xd1, 0xbb, 0x12, 0xf3, 0x0a, 0x6e, 0x30,
-	0xdd, 0x8a, 0xa0, 0x9b, 0xef, 0x48, 0x27, 0x92, 0xfa, 0xf0, 0x1e, 0xf8, 0xf0, 0xac, 0x7c, 0xe3,
-	0x66, 0xa9, 0x6a, 0x98, 0xae, 0x3e, 0x0a, 0x0c, 0x6a, 0xce, 0xef, 0x8e, 0x35, 0x14, 0x61, 0x3d,
-	0x13, 0xed, 0x6b, 0x02, 0x52, 0x14, 0x15, 0xaa, 0x96, 0x83, 0x21, 0x2e, 0x8f, 0x2b, 0x5a, 0x52,
-	0xb7, 0x62, 0x64, 0xef, 0x14, 0xfb, 0x98, 0xc0, 0x6c, 0xa0, 0x4d, 0x0d, 0xdd, 0xca, 0x8b, 0x9f,
-	0xff, 0x61, 0x63, 0xfd, 0x48, 0xe0, 0xf9, 0x04, 0x0e, 0x94, 0x6a, 0x0b, 0xce, 0x09, 0x90, 0x1a,
-	0x06, 0xa0, 0x64, 0x9d, 0xdf, 0x9e, 0xb3, 0x65, 0xef, 0xba, 0xbd, 0xd3, 0xef, 0x28, 0x46, 0xbf,
-	0xbe, 0x68, 0xbc, 0x38, 0x51, 0xfd, 0xfd, 0xd7, 0x7f, 0xa2, 0x5e, 0x87, 0x09, 0x8e, 0xbd, 0x53,
-	0x67, 0x35, 0x66, 0x69, 0x15, 0x57, 0xc7, 0x19, 0x18, 0xab, 0xe1, 0xa3, 0x76, 0x2b, 0x82, 0xfb,
-	0x68, 0xbb, 0x24, 0xbf, 0x85, 0x1f, 0x91, 0xf6, 0x44, 0xdc, 0xe3, 0x35, 0x18, 0x71, 0xc3, 0xf0,
-	0xc0, 0x9d, 0x0a, 0xed, 0xae, 0x35, 0xa9, 0x15, 0x2a, 0x3f, 0x22, 0x20, 0xfb, 0x16, 0x74, 0x3b,
-	0x52, 0x88, 0xf0, 0x2f, 0x3e, 0x0f, 0x3d, 0xab, 0xf1, 0x77, 0x04, 0xae, 0x26, 0x22, 0xa2, 0x02,
-	0xd7, 0x61, 0xd4, 0xdd, 0x96, 0x5b, 0xe0, 0x04, 0x09, 0xda, 0xb1, 0xbd, 0xab, 0x6a, 0x1d, 0x66,
-	0x38, 0xe8, 0x3b, 0xcc, 0xd6, 0xf3, 0x2d, 0x5c, 0xe7, 0x57, 0x3d, 0x6d, 0x81, 0x9d, 0x37, 0xe9,
-	0xc0, 0x99, 0xc0, 0x39, 0x12, 0xdf, 0x24, 0x1e, 0x26, 0xdf, 0xc2, 0xb7, 0x33, 0x32, 0x27, 0x2a,
-	0xb3, 0x08, 0xa7, 0x9d, 0x60, 0xec, 0x8b, 0x8b, 0x21, 0x51, 0x9c, 0xe8, 0x02, 0x0f, 0x91, 0x3f,
-	0x21, 0xe8, 0x13, 0x9c, 0x67, 0x56, 0xbe, 0xeb, 0x06, 0xed, 0x59, 0xd5, 0xbf, 0x24, 0xe8, 0x2e,
-	0x42, 0x20, 0xb8, 0xa9, 0x65, 0x21, 0x94, 0x5b, 0xea, 0x98, 0x5d, 0x89, 0x98, 0xde, 0x95, 0xf8,
-	0x3e, 0x41, 0x7b, 0x82, 0x58, 0xbe, 0xe2, 0xb6, 0x6a, 0x47, 0x52, 0xd5, 0xae, 0x67, 0x5a, 0x7d,
-	0xe1, 0x9a, 0x02, 0x3f, 0xd4, 0xff, 0x2a, 0xd4, 0xc3, 0xa0, 0x25, 0x40, 0x4b, 0xd4, 0x07, 0x07,
-	0xca, 0x11, 0xf1, 0x7a, 0x61, 0x0f, 0x5a, 0x3f, 0xd8, 0x95, 0x97, 0xe1, 0x32, 0x67, 0xbb, 0xa3,
-	0x55, 0x2a, 0xce, 0xd9, 0xb6, 0x5f, 0xb1, 0x53, 0x7f, 0x1c, 0xde, 0xc5, 0xde, 0xf4, 0xcd, 0xc5,
-	0x4d, 0xdd, 0x80, 0x41, 0xdb, 0x79, 0x8c, 0x87, 0x40, 0xd8, 0xb7, 0x7a, 0x26, 0xe5, 0x47, 0x9f,
-	0xfe, 0x3e, 0x33, 0xf0, 0xf8, 0xaf, 0xef, 0x97, 0x48, 0x41, 0xcc, 0x92, 0xdf, 0x07, 0xea, 0x91,
-	0xcc, 0x25, 0xea, 0x55, 0x45, 0xee, 0x13, 0xb8, 0xe0, 0x5b, 0xbe, 0x0f, 0x2a, 0x91, 0xfb, 0xfb,
-	0x3c, 0x0c, 0x72, 0x28, 0xfa, 0x19, 0x81, 0xd1, 0x56, 0x22, 0x3a, 0x17, 0x82, 0x88, 0xbc, 0xd6,
-	0x49, 0xf3, 0x1d, 0xe3, 0x44, 0x52, 0x59, 0xf9, 0xe8, 0xd7, 0x3f, 0x1f, 0x9c, 0x5a, 0xa0, 0x73,
-	0x6a, 0xf0, 0x62, 0x8a, 0x9e, 0xd4, 0xdc, 0x63, 0xea, 0xa1, 0xeb, 0x4f, 0x9b, 0xf4, 0x5b, 0x02,
-	0xe3, 0x01, 0xa3, 0x42, 0x57, 0x12, 0x92, 0x85, 0x6e, 0x7b, 0xd2, 0x6a, 0xca, 0x68, 0x04, 0xdc,
-	0xe0, 0x80, 0x0a, 0x5d, 0x89, 0x01, 0xe4, 0xb6, 0xaa, 0x81, 0x9c, 0xf8, 0xb6, 0x36, 0xe9, 0x43,
-	0x02, 0x67, 0xbc, 0x97, 0x28, 0xba, 0x98, 0x90, 0xd5, 0x7f, 0xd1, 0x93, 0x96, 0xd2, 0x84, 0x22,
-	0x5d, 0x96, 0xd3, 0x2d, 0xd3, 0xc5, 0x18, 0x3a, 0xbc, 0x83, 0x79, 0x15, 0x3c, 0x22, 0x70, 0xd6,
-	0x77, 0x55, 0xa1, 0x49, 0x09, 0x03, 0x66, 0x57, 0x5a, 0x4e, 0x15, 0x8b, 0x74, 0x6b, 0x9c, 0x6e,
-	0x89, 0x2e, 0x44, 0xd3, 0x59, 0xbb, 0xc5, 0xc6, 0x2e, 0xf7, 0xc4, 0x8e, 0x72, 0x55, 0xc3, 0x6c,
-	0xd2, 0x9f, 0x09, 0x4c, 0x44, 0xdd, 0x11, 0x68, 0xb6, 0x53, 0xd5, 0x42, 0xf7, 0x1a, 0x29, 0xd7,
-	0xcd, 0x14, 0x24, 0x7e, 0x85, 0x13, 0x5f, 0xa3, 0xeb, 0x49, 0xd5, 0x36, 0x74, 0x4e, 0x2e, 0x86,
-	0x3c, 0xca, 0xfe, 0x14, 0x86, 0x17, 0x02, 0xa7, 0x83, 0xf7, 0xe9, 0x9c, 0xeb, 0x66, 0x0a, 0xc2,
-	0xbf, 0xc4, 0xe1, 0x73, 0x74, 0x2d, 0x05, 0xbc, 0x5f, 0xf6, 0x4f, 0x09, 0x8c, 0xb8, 0x26, 0x83,
-	0xbe, 0x18, 0x9d, 0x3a, 0xe0, 0x86, 0xa4, 0xb9, 0x4e, 0x61, 0x48, 0xa5, 0x72, 0xaa, 0x45, 0x3a,
-	0x1f, 0xa2, 0x72, 0x4f, 0x6f, 0xf5, 0xd0, 0x73, 0xb4, 0x37, 0xe9, 0x13, 0x02, 0x97, 0xa2, 0xed,
-	0x2e, 0x5d, 0x4f, 0xce, 0x19, 0xe9, 0xdf, 0xa5, 0x8d, 0xee, 0x26, 0x21, 0xf6, 0xab, 0x1c, 0x7b,
-	0x93, 0x6e, 0xc4, 0x62, 0xb7, 0x9b, 0x00, 0x0f, 0x01, 0xcf, 0xfb, 0xff, 0x84, 0xc0, 0x85, 0x08,
-	0x57, 0x4a, 0xd7, 0xa2, 0x59, 0xe2, 0x4d, 0xb3, 0x94, 0xed, 0x62, 0x06, 0xa2, 0xbf, 0xce, 0xd1,
-	0x5f, 0xa3, 0x37, 0x42, 0xe8, 0x8e, 0xcf, 0x71, 0xa8, 0x5b, 0x7a, 0x73, 0x2f, 0xe6, 0xd7, 0x5f,
-	0x3d, 0xe4, 0x0f, 0x9b, 0xf4, 0x31, 0x81, 0xf1, 0x80, 0x01, 0x8d, 0x3b, 0x6a, 0xa3, 0x0d, 0x73,
-	0xdc, 0x51, 0x1b, 0xe3, 0x6a, 0x13, 0xfa, 0x97, 0xfb, 0x33, 0x2f, 0x78, 0xa0, 0x65, 0xbe, 0x22,
-	0x70, 0xc6, 0xeb, 0xff, 0xe2, 0x8e, 0xdb, 0x08, 0xe3, 0x1a, 0x77, 0xdc, 0x46, 0xd9, 0xc9, 0x84,
-	0x5e, 0x6e, 0x11, 0xa2, 0xa2, 0xa8, 0xe1, 0x23, 0x02, 0xe7, 0xfc, 0x4e, 0x8b, 0x76, 0x38, 0x41,
-	0x7d, 0x56, 0x51, 0x5a, 0x49, 0x17, 0x8c, 0x78, 0xeb, 0x1c, 0x6f, 0x95, 0x2e, 0x27, 0x9c, 0xb7,
-	0xe2, 0x8b, 0xe0, 0x69, 0xd5, 0x23, 0x02, 0x63, 0x1e, 0xff, 0x43, 0x17, 0xa2, 0x53, 0x86, 0x3d,
-	0x99, 0xb4, 0x98, 0x22, 0x12, 0xc9, 0x36, 0x39, 0xd9, 0x1a, 0x55, 0xe2, 0xdf, 0xa6, 0x40, 0x17,
-	0x72, 0xeb, 0x45, 0x6d, 0x18, 0x12, 0x7b, 0xa5, 0x57, 0x93, 0x94, 0x70, 0x89, 0x5e, 0x48, 0x0e,
-	0x42, 0x98, 0x19, 0x0e, 0x33, 0x45, 0x2f, 0xc7, 0xc8, 0x94, 0x57, 0x9e, 0x1e, 0x67, 0xc8, 0xb3,
-	0xe3, 0x0c, 0xf9, 0xe3, 0x38, 0x43, 0x3e, 0x3f, 0xc9, 0x0c, 0x3c, 0x3b, 0xc9, 0x0c, 0xfc, 0x76,
-	0x92, 0x19, 0x78, 0x0f, 0x5d, 0xba, 0x55, 0xfa, 0x40, 0x31, 0x98, 0xfa, 0xa1, 0x98, 0x50, 0x1c,
-	0xe2, 0x7f, 0xe4, 0x5e, 0xff, 0x27, 0x00, 0x00, 0xff, 0xff, 0x52, 0x63, 0x6a, 0x95, 0xbf, 0x17,
-	0x00, 0x00,
+	// 1511 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0xcd, 0x6f, 0xdc, 0xc4,
+	0x1b, 0xc7, 0x33, 0xfd, 0xf5, 0x2d, 0x4f, 0xfa, 0xa2, 0x4e, 0xd3, 0x76, 0xe3, 0xe6, 0xb7, 0x09,
+	0x2e, 0xa4, 0x79, 0xb5, 0xb3, 0x9b, 0x34, 0x2d, 0xd0, 0x96, 0x76, 0x25, 0x5a, 0x7a, 0x28, 0x6a,
+	0xb7, 0x15, 0x12, 0x08, 0x69, 0xe5, 0x64, 0xdd, 0x95, 0x45, 0x62, 0x6f, 0x6d, 0x27, 0x62, 0x55,
+	0xed, 0x05, 0x09, 0x0e, 0xc0, 0x01, 0x5a, 0x84, 0x4a, 0xc4, 0xa1, 0x07, 0x24, 0xca, 0xa9, 0x1c,
+	0x40, 0x48, 0x48, 0x1c, 0x7a, 0xeb, 0x81, 0x43, 0x05, 0x12, 0xe2, 0x84, 0x50, 0x83, 0xe0, 0xdf,
+	0x40, 0x9e, 0x79, 0xbc, 0xeb, 0x97, 0xb1, 0xd7, 0x0b, 0xab, 0xb2, 0x97, 0x68, 0x3d, 0xf3, 0x3c,
+	0x33, 0x9f, 0xf9, 0x3e, 0x8f, 0x67, 0xe6, 0x71, 0xe0, 0xe8, 0x8a, 0xe5, 0xac, 0x59, 0x8e, 0x5a,
+	0xb3, 0xad, 0xf5, 0xba, 0xba, 0x51, 0x50, 0x6f, 0xae, 0xeb, 0x76, 0x43, 0xa9, 0xdb, 0x96, 0x6b,
+	0xd1, 0xfd, 0xbc, 0x53, 0x61, 0x9d, 0xca, 0x46, 0x41, 0x1a, 0xae, 0x59, 0x35, 0x8b, 0xf5, 0xa9,
+	0xde, 0x2f, 0x6e, 0x26, 0x8d, 0xd6, 0x2c, 0xab, 0xb6, 0xaa, 0xab, 0x5a, 0xdd, 0x50, 0x35, 0xd3,
+	0xb4, 0x5c, 0xcd, 0x35, 0x2c, 0xd3, 0xc1, 0xde, 0xd8, 0x0c, 0x6e, 0xa3, 0xae, 0xfb, 0x9d, 0xd3,
+	0xd8, 0xb9, 0xac, 0x39, 0x3a, 0x9f, 0x5a, 0xdd, 0x28, 0x2c, 0xeb, 0xae, 0x56, 0x50, 0xeb, 0x5a,
+	0xcd, 0x30, 0xd9, 0x48, 0x68, 0x3b, 0xc2, 0x6d, 0x2b, 0x7c, 0x7e, 0x44, 0xe3, 0x5d, 0x07, 0xb4,
+	0x35, 0xc3, 0xb4, 0x54, 0xf6, 0x97, 0x37, 0xc9, 0x45, 0x38, 0x74, 0xd5, 0x1b, 0xef, 0xa2, 0x37,
+	0xed, 0x25, 0xf3, 0x86, 0x55, 0xd6, 0x6f, 0xae, 0xeb, 0x8e, 0x4b, 0x47, 0x60, 0x37, 0x43, 0xa9,
+	0x18, 0xd5, 0x1c, 0x19, 0x27, 0x93, 0xdb, 0xcb, 0xbb, 0xd8, 0xf3, 0xa5, 0xaa, 0xfc, 0x0a, 0x1c,
+	0x8e, 0xfa, 0x38, 0x75, 0xcb, 0x74, 0x74, 0xaa, 0xc0, 0x76, 0xc3, 0xbc, 0x61, 0x31, 0x87, 0xa1,
+	0xa2, 0xa4, 0x44, 0x84, 0x51, 0xda, 0x1e, 0xcc, 0x4e, 0xbe, 0x0a, 0x47, 0xdb, 0x23, 0x5d, 0xb1,
+	0x56, 0x8d, 0x95, 0x46, 0x90, 0xa1, 0x08, 0xbb, 0xb4, 0x6a, 0xd5, 0xd6, 0x1d, 0x87, 0x8d, 0x38,
+	0x58, 0xca, 0xfd, 0xf4, 0xcd, 0xdc, 0x30, 0x0e, 0x7a, 0x9e, 0xf7, 0x5c, 0x73, 0x6d, 0xc3, 0xac,
+	0x95, 0x7d, 0x43, 0xf9, 0x3a, 0x8c, 0x8a, 0x87, 0x44, 0xc4, 0xc5, 0x10, 0xe2, 0xb8, 0x18, 0x31,
+	0xe0, 0xc7, 0x41, 0x9b, 0x90, 0x6b, 0x8f, 0x7a, 0x59, 0x5f, 0x5b, 0xd6, 0x6d, 0xa7, 0xb3, 0x52,
+	0xf4, 0x02, 0x40, 0x3b, 0x3e, 0xb9, 0x6d, 0x6c, 0xca, 0x09, 0x7f, 0x4a, 0x2f, 0x98, 0x0a, 0xcf,
+	0x23, 0x0c, 0xa6, 0x72, 0x45, 0xab, 0xe9, 0x38, 0x6c, 0x39, 0xe0, 0x29, 0x7f, 0x4e, 0x60, 0x44,
+	0x30, 0x3f, 0x2e, 0x69, 0x09, 0x76, 0xad, 0xf1, 0xa6, 0x1c, 0x19, 0xff, 0xdf, 0xe4, 0x50, 0x71,
+	0x54, 0xbc, 0x2a, 0xee, 0x57, 0xf6, 0x8d, 0xe9, 0x45, 0x01, 0xdd, 0xf1, 0x8e, 0x74, 0x7c, 0xd2,
+	0x10, 0xde, 0x9d, 0x10, 0x9e, 0x53, 0x6a, 0x9c, 0xaf, 0xae, 0x19, 0xa6, 0xaf, 0x8f, 0x02, 0x3b,
+	0x34, 0xef, 0xb9, 0x63, 0x0c, 0xb9, 0x59, 0xcf, 0x44, 0xfb, 0x8c, 0x80, 0x24, 0xa2, 0x42, 0xd5,
+	0x8a, 0xb0, 0x93, 0xc9, 0xe3, 0x8b, 0x96, 0x96, 0xad, 0x68, 0xd9, 0x3b, 0xc5, 0xde, 0x25, 0x30,
+	0x1e, 0x49, 0x53, 0x43, 0x77, 0x4a, 0xfc, 0xf1, 0x29, 0x26, 0xd6, 0xb7, 0x04, 0x9e, 0x49, 0xe1,
+	0x40, 0xa9, 0x2e, 0xc2, 0x3e, 0x0e, 0x52, 0x47, 0x03, 0x94, 0xac, 0xf3, 0xdb, 0xb3, 0xb7, 0x16,
+	0x1c, 0xb7, 0x77, 0xfa, 0x6d, 0x26, 0xe8, 0xd7, 0x17, 0x89, 0x97, 0x24, 0x6a, 0x38, 0xff, 0xfa,
+	0x4f, 0xd4, 0x93, 0x30, 0xcc, 0xb0, 0xaf, 0xd8, 0x56, 0xdd, 0x72, 0xb4, 0x55, 0x5f, 0xc7, 0x31,
+	0x18, 0xaa, 0x63, 0x53, 0x3b, 0x15, 0xc1, 0x6f, 0xba, 0x54, 0x95, 0x5f, 0x85, 0x43, 0x11, 0x47,
+	0x5c, 0xe3, 0x09, 0xd8, 0xed, 0x9b, 0xe1, 0x86, 0x3b, 0x12, 0x5b, 0x5d, 0xcb, 0xa9, 0x65, 0x2a,
+	0xdf, 0x23, 0x20, 0x87, 0x06, 0xf4, 0x33, 0x92, 0x8b, 0xf0, 0x2f, 0x8e, 0x87, 0x9e, 0xc5, 0xf8,
+	0x4b, 0x02, 0xc7, 0x52, 0x11, 0x51, 0x81, 0x93, 0x30, 0xe8, 0x2f, 0xcb, 0x0f, 0x70, 0x8a, 0x04,
+	0x6d, 0xdb, 0xde, 0x45, 0xd5, 0x86, 0x31, 0x06, 0xfa, 0x9a, 0xe5, 0xea, 0xa5, 0x16, 0xae, 0xf7,
+	0x64, 0x67, 0x0d, 0xb0, 0xf7, 0x26, 0x6d, 0x78, 0x0e, 0xb9, 0x6d, 0x1d, 0x74, 0xe6, 0x66, 0xf2,
+	0x65, 0x7c, 0x3b, 0x85, 0x73, 0xa2, 0x32, 0x53, 0xb0, 0xdd, 0x33, 0xc6, 0xbc, 0x38, 0x14, 0x13,
+	0xc5, 0xb3, 0x2e, 0x33, 0x13, 0xf9, 0x3d, 0x82, 0xf7, 0x04, 0xaf, 0xcd, 0x29, 0x75, 0x9d, 0xa0,
+	0x3d, 0x8b, 0xfa, 0x27, 0x04, 0x46, 0xc5, 0x20, 0xb8, 0xa8, 0x19, 0x2e, 0x94, 0x1f, 0xea, 0x84,
+	0x55, 0x71, 0x9b, 0xde, 0x85, 0xf8, 0x36, 0xc1, 0xeb, 0x09, 0x62, 0x85, 0x82, 0xdb, 0x8a, 0x1d,
+	0xc9, 0x14, 0xbb, 0x9e, 0x69, 0xf5, 0xb1, 0x7f, 0x29, 0x08, 0x43, 0xfd, 0xa7, 0x42, 0xdd, 0x8d,
+	0x5e, 0x09, 0xf0, 0x4a, 0xd4, 0x07, 0x1b, 0xca, 0x26, 0x81, 0xa3, 0x42, 0xb4, 0x7e, 0xb8, 0xae,
+	0xbc, 0x00, 0x47, 0x18, 0xdb, 0x75, 0x6d, 0x75, 0xd5, 0xdb, 0xdb, 0xd6, 0x57, 0xdd, 0xcc, 0x87,
+	0xc3, 0xeb, 0x90, 0x8b, 0xfb, 0xe2, 0xa2, 0xce, 0xc0, 0x0e, 0xd7, 0x6b, 0xc6, 0x4d, 0x20, 0x7e,
+	0x6f, 0x0d, 0x38, 0x95, 0x06, 0x1f, 0xfd, 0x36, 0x36, 0x70, 0xff, 0xaf, 0xaf, 0xa7, 0x49, 0x99,
+	0x7b, 0xc9, 0x6f, 0x02, 0x0d, 0x48, 0xe6, 0x13, 0xf5, 0x2a, 0x22, 0xb7, 0x09, 0x1c, 0x0c, 0x0d,
+	0xdf, 0x0f, 0x91, 0x38, 0x07, 0xff, 0x67, 0x4c, 0x65, 0x7d, 0x65, 0xdd, 0xf6, 0x32, 0xb1, 0xeb,
+	0xc3, 0xda, 0x81, 0x7c, 0xd2, 0x08, 0xb8, 0xc0, 0xab, 0x40, 0x6d, 0xbf, 0xb3, 0x12, 0x39, 0xbf,
+	0xe5, 0xd8, 0x62, 0xe3, 0xe3, 0x1c, 0xb0, 0xa3, 0x4d, 0xf2, 0x57, 0x04, 0x66, 0xc4, 0xb3, 0xf6,
+	0xdf, 0xd1, 0xfe, 0x23, 0x81, 0xd9, 0x6c, 0xac, 0xa8, 0xd7, 0x35, 0x38, 0x18, 0xd7, 0xcb, 0xcf,
+	0x8e, 0x2c, 0x82, 0xd1, 0x98, 0x60, 0x3d, 0xcc, 0x98, 0x0f, 0xfc, 0xab, 0x72, 0xf0, 0x18, 0x2e,
+	0xeb, 0x2b, 0xba, 0x51, 0x77, 0x9d, 0xa7, 0x7e, 0x82, 0x3e, 0xf0, 0xef, 0xc6, 0x62, 0x1a, 0x54,
+	0xf4, 0x3c, 0xec, 0xf5, 0x76, 0xfe, 0x8a, 0x8d, 0x1d, 0x89, 0x75, 0x6d, 0xc0, 0xbb, 0xbc, 0x67,
+	0xa3, 0xfd, 0xd0, 0x3b, 0xfd, 0x8a, 0xbf, 0x1c, 0x86, 0x1d, 0x8c, 0x98, 0x7e, 0x48, 0x60, 0xb0,
+	0xf5, 0x6a, 0xd3, 0x89, 0x18, 0x8c, 0xf0, 0x43, 0x8a, 0x74, 0xbc, 0xa3, 0x1d, 0x9f, 0x54, 0x56,
+	0xde, 0xf9, 0xf9, 0x8f, 0x3b, 0xdb, 0x26, 0xe9, 0x84, 0x1a, 0xfd, 0x14, 0x84, 0x55, 0xa0, 0x79,
+	0xc3, 0x52, 0x6f, 0xe1, 0xef, 0x6a, 0x93, 0x7e, 0x41, 0x60, 0x7f, 0xa4, 0x34, 0xa0, 0xb3, 0x29,
+	0x93, 0xc5, 0xbe, 0xaf, 0x48, 0x73, 0x19, 0xad, 0x11, 0x70, 0x91, 0x01, 0x2a, 0x74, 0x36, 0x01,
+	0x90, 0x15, 0x32, 0x0d, 0xe4, 0xc4, 0xb7, 0xb2, 0x49, 0xef, 0x12, 0xd8, 0x13, 0xfc, 0x6c, 0x41,
+	0xa7, 0x52, 0x66, 0x0d, 0x7f, 0x5a, 0x91, 0xa6, 0xb3, 0x98, 0x22, 0x5d, 0x81, 0xd1, 0xcd, 0xd0,
+	0xa9, 0x04, 0x3a, 0xfc, 0xea, 0x11, 0x54, 0x70, 0x93, 0xc0, 0xde, 0xd0, 0xc7, 0x01, 0x9a, 0x36,
+	0x61, 0xa4, 0xbc, 0x94, 0x66, 0x32, 0xd9, 0x22, 0xdd, 0x3c, 0xa3, 0x9b, 0xa6, 0x93, 0x62, 0x3a,
+	0xa7, 0xb2, 0xdc, 0xa8, 0xb0, 0x2a, 0xd4, 0x53, 0x6e, 0xcd, 0x30, 0x9b, 0xf4, 0x7b, 0x02, 0xc3,
+	0xa2, 0xaa, 0x9c, 0x16, 0x3a, 0x45, 0x2d, 0xf6, 0x25, 0x41, 0x2a, 0x76, 0xe3, 0x82, 0xc4, 0x2f,
+	0x32, 0xe2, 0x13, 0x74, 0x21, 0x2d, 0xda, 0x86, 0xce, 0xc8, 0x79, 0x57, 0x40, 0xd9, 0xef, 0xe2,
+	0xf0, 0x5c, 0xe0, 0x6c, 0xf0, 0x21, 0x9d, 0x8b, 0xdd, 0xb8, 0x20, 0xfc, 0x29, 0x06, 0x5f, 0xa4,
+	0xf3, 0x19, 0xe0, 0xc3, 0xb2, 0xbf, 0x4f, 0x60, 0xb7, 0xbf, 0x37, 0xd1, 0xe7, 0xc4, 0x53, 0x47,
+	0xce, 0x5c, 0x69, 0xa2, 0x93, 0x19, 0x52, 0xa9, 0x8c, 0x6a, 0x8a, 0x1e, 0x8f, 0x51, 0xf9, 0x3b,
+	0xad, 0x7a, 0x2b, 0xb0, 0x0d, 0x37, 0xe9, 0x43, 0x02, 0x87, 0xc5, 0x87, 0x0f, 0x5d, 0x48, 0x9f,
+	0x53, 0x78, 0xac, 0x4a, 0x8b, 0xdd, 0x39, 0x21, 0xf6, 0x69, 0x86, 0xbd, 0x44, 0x17, 0x13, 0xb1,
+	0xdb, 0x49, 0x80, 0x9b, 0x40, 0xe0, 0xfd, 0x7f, 0x48, 0xe0, 0xa0, 0xa0, 0x0e, 0xa4, 0xf3, 0x62,
+	0x96, 0xe4, 0x32, 0x55, 0x2a, 0x74, 0xe1, 0x81, 0xe8, 0x2f, 0x33, 0xf4, 0x97, 0xe8, 0x99, 0x18,
+	0x3a, 0x3b, 0x5f, 0x96, 0x1b, 0xad, 0xf3, 0xba, 0xe2, 0x35, 0xd8, 0x61, 0xfd, 0xd5, 0x5b, 0xac,
+	0xb1, 0x49, 0xef, 0x13, 0xd8, 0x1f, 0x29, 0xf9, 0x92, 0xb6, 0x5a, 0x71, 0x89, 0x2a, 0xcd, 0x65,
+	0xb4, 0xee, 0x98, 0xbf, 0x1e, 0x91, 0x13, 0x04, 0x8f, 0xa4, 0xcc, 0xa7, 0x04, 0xf6, 0x04, 0x2b,
+	0xae, 0xa4, 0xed, 0x56, 0x50, 0x2a, 0x26, 0x6d, 0xb7, 0xa2, 0x02, 0x2e, 0x25, 0x97, 0x5b, 0x84,
+	0xa8, 0x28, 0x6a, 0x78, 0x8f, 0xc0, 0xbe, 0x70, 0x6d, 0x43, 0x3b, 0xec, 0xa0, 0xa1, 0xe2, 0x4c,
+	0x9a, 0xcd, 0x66, 0x8c, 0x78, 0x0b, 0x0c, 0x6f, 0x8e, 0xce, 0xa4, 0xec, 0xb7, 0xfc, 0x44, 0x08,
+	0xa4, 0xea, 0x26, 0x81, 0xa1, 0x40, 0xc5, 0x41, 0x27, 0xc5, 0x53, 0xc6, 0xab, 0x20, 0x69, 0x2a,
+	0x83, 0x25, 0x92, 0x2d, 0x31, 0xb2, 0x79, 0xaa, 0x24, 0xbf, 0x4d, 0x91, 0x2c, 0x64, 0xc5, 0x0e,
+	0x75, 0x61, 0x27, 0x5f, 0x2b, 0x3d, 0x96, 0xa6, 0x84, 0x4f, 0xf4, 0x6c, 0xba, 0x11, 0xc2, 0x8c,
+	0x31, 0x98, 0x11, 0x7a, 0x24, 0x41, 0x26, 0xfa, 0x80, 0xc0, 0x81, 0xd8, 0x85, 0x95, 0x2a, 0xe2,
+	0xc1, 0x93, 0x8a, 0x12, 0x49, 0xcd, 0x6c, 0x8f, 0x5c, 0xcf, 0x33, 0xae, 0x05, 0x5a, 0x88, 0x71,
+	0xc5, 0x6f, 0xda, 0x91, 0x17, 0xe0, 0x4f, 0x02, 0x63, 0x1d, 0x6e, 0xee, 0xf4, 0x74, 0x46, 0x1e,
+	0xf1, 0x2e, 0x7a, 0xe6, 0x1f, 0x7a, 0xe3, 0xda, 0x2e, 0xb0, 0xb5, 0x9d, 0xa3, 0x67, 0x33, 0xac,
+	0x2d, 0x6d, 0x63, 0xfd, 0x81, 0xc0, 0xb0, 0xe8, 0x16, 0x9d, 0x74, 0xc6, 0xa6, 0xdc, 0xff, 0xa5,
+	0x62, 0x37, 0x2e, 0xb8, 0x8e, 0xb3, 0x6c, 0x1d, 0xa7, 0xe8, 0x52, 0xe6, 0x44, 0x0e, 0xdd, 0xe9,
+	0x4b, 0xca, 0xa3, 0x27, 0x79, 0xf2, 0xf8, 0x49, 0x9e, 0xfc, 0xfe, 0x24, 0x4f, 0x3e, 0xda, 0xca,
+	0x0f, 0x3c, 0xde, 0xca, 0x0f, 0xfc, 0xba, 0x95, 0x1f, 0x78, 0x03, 0x0b, 0x3d, 0xa7, 0xfa, 0x96,
+	0x62, 0x58, 0xea, 0xdb, 0x7c, 0xe0, 0xe5, 0x9d, 0xec, 0x3f, 0x96, 0x0b, 0x7f, 0x0f, 0x00, 0x1c,
+	0x2d, 0x31, 0xbb, 0x8c, 0x1d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1651,6 +2002,19 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(ctx context.Context, in *QueryGroupsRequest, opts ...grpc.CallOption) (*QueryGroupsResponse, error)
+	// RecurringProposal queries the recurrence of a recurring proposal based on proposal id.
+	//
+	// Since: x/group 1.0.0
+	RecurringProposal(ctx context.Context, in *QueryRecurringProposalRequest, opts ...grpc.CallOption) (*QueryRecurringProposalResponse, error)
+	// RecurringProposalsByGroupPolicy queries recurring proposals based on account address of group policy.
+	//
+	// Since: x/group 1.0.0
+	RecurringProposalsByGroupPolicy(ctx context.Context, in *QueryRecurringProposalsByGroupPolicyRequest, opts ...grpc.CallOption) (*QueryRecurringProposalsByGroupPolicyResponse, error)
+	// ProposalVoteReceipts queries the vote receipts of a proposal, kept after its
+	// final tally when its group policy stores vote receipts.
+	//
+	// Since: x/group 1.0.0
+	ProposalVoteReceipts(ctx context.Context, in *QueryProposalVoteReceiptsRequest, opts ...grpc.CallOption) (*QueryProposalVoteReceiptsResponse, error)
 }
 
 type queryClient struct {
@@ -1787,6 +2151,33 @@ func (c *queryClient) Groups(ctx context.Context, in *QueryGroupsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) RecurringProposal(ctx context.Context, in *QueryRecurringProposalRequest, opts ...grpc.CallOption) (*QueryRecurringProposalResponse, error) {
+	out := new(QueryRecurringProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/RecurringProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecurringProposalsByGroupPolicy(ctx context.Context, in *QueryRecurringProposalsByGroupPolicyRequest, opts ...grpc.CallOption) (*QueryRecurringProposalsByGroupPolicyResponse, error) {
+	out := new(QueryRecurringProposalsByGroupPolicyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/RecurringProposalsByGroupPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalVoteReceipts(ctx context.Context, in *QueryProposalVoteReceiptsRequest, opts ...grpc.CallOption) (*QueryProposalVoteReceiptsResponse, error) {
+	out := new(QueryProposalVoteReceiptsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/ProposalVoteReceipts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GroupInfo queries group info based on group id.
@@ -1823,6 +2214,19 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error)
+	// RecurringProposal queries the recurrence of a recurring proposal based on proposal id.
+	//
+	// Since: x/group 1.0.0
+	RecurringProposal(context.Context, *QueryRecurringProposalRequest) (*QueryRecurringProposalResponse, error)
+	// RecurringProposalsByGroupPolicy queries recurring proposals based on account address of group policy.
+	//
+	// Since: x/group 1.0.0
+	RecurringProposalsByGroupPolicy(context.Context, *QueryRecurringProposalsByGroupPolicyRequest) (*QueryRecurringProposalsByGroupPolicyResponse, error)
+	// ProposalVoteReceipts queries the vote receipts of a proposal, kept after its
+	// final tally when its group policy stores vote receipts.
+	//
+	// Since: x/group 1.0.0
+	ProposalVoteReceipts(context.Context, *QueryProposalVoteReceiptsRequest) (*QueryProposalVoteReceiptsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1871,6 +2275,15 @@ func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTall
 func (*UnimplementedQueryServer) Groups(ctx context.Context, req *QueryGroupsRequest) (*QueryGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Groups not implemented")
 }
+func (*UnimplementedQueryServer) RecurringProposal(ctx context.Context, req *QueryRecurringProposalRequest) (*QueryRecurringProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecurringProposal not implemented")
+}
+func (*UnimplementedQueryServer) RecurringProposalsByGroupPolicy(ctx context.Context, req *QueryRecurringProposalsByGroupPolicyRequest) (*QueryRecurringProposalsByGroupPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecurringProposalsByGroupPolicy not implemented")
+}
+func (*UnimplementedQueryServer) ProposalVoteReceipts(ctx context.Context, req *QueryProposalVoteReceiptsRequest) (*QueryProposalVoteReceiptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalVoteReceipts not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2128,30 +2541,84 @@ func _Query_Groups_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.group.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GroupInfo",
-			Handler:    _Query_GroupInfo_Handler,
-		},
-		{
-			MethodName: "GroupPolicyInfo",
-			Handler:    _Query_GroupPolicyInfo_Handler,
-		},
-		{
-			MethodName: "GroupMembers",
-			Handler:    _Query_GroupMembers_Handler,
-		},
-		{
-			MethodName: "GroupsByAdmin",
-			Handler:    _Query_GroupsByAdmin_Handler,
-		},
-		{
-			MethodName: "GroupPoliciesByGroup",
-			Handler:    _Query_GroupPoliciesByGroup_Handler,
-		},
+func _Query_RecurringProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecurringProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecurringProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/RecurringProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecurringProposal(ctx, req.(*QueryRecurringProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecurringProposalsByGroupPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecurringProposalsByGroupPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecurringProposalsByGroupPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/RecurringProposalsByGroupPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecurringProposalsByGroupPolicy(ctx, req.(*QueryRecurringProposalsByGroupPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalVoteReceipts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalVoteReceiptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalVoteReceipts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/ProposalVoteReceipts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalVoteReceipts(ctx, req.(*QueryProposalVoteReceiptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.group.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GroupInfo",
+			Handler:    _Query_GroupInfo_Handler,
+		},
+		{
+			MethodName: "GroupPolicyInfo",
+			Handler:    _Query_GroupPolicyInfo_Handler,
+		},
+		{
+			MethodName: "GroupMembers",
+			Handler:    _Query_GroupMembers_Handler,
+		},
+		{
+			MethodName: "GroupsByAdmin",
+			Handler:    _Query_GroupsByAdmin_Handler,
+		},
+		{
+			MethodName: "GroupPoliciesByGroup",
+			Handler:    _Query_GroupPoliciesByGroup_Handler,
+		},
 		{
 			MethodName: "GroupPoliciesByAdmin",
 			Handler:    _Query_GroupPoliciesByAdmin_Handler,
@@ -2188,6 +2655,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Groups",
 			Handler:    _Query_Groups_Handler,
 		},
+		{
+			MethodName: "RecurringProposal",
+			Handler:    _Query_RecurringProposal_Handler,
+		},
+		{
+			MethodName: "RecurringProposalsByGroupPolicy",
+			Handler:    _Query_RecurringProposalsByGroupPolicy_Handler,
+		},
+		{
+			MethodName: "ProposalVoteReceipts",
+			Handler:    _Query_ProposalVoteReceipts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
@@ -3321,151 +3800,394 @@ func (m *QueryGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryGroupInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovQuery(uint64(m.GroupId))
+func (m *QueryRecurringProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGroupInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Info != nil {
-		l = m.Info.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryRecurringProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupPolicyInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRecurringProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupPolicyInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Info != nil {
-		l = m.Info.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryRecurringProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGroupMembersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovQuery(uint64(m.GroupId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryRecurringProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupMembersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRecurringProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.RecurringProposal != nil {
+		{
+			size, err := m.RecurringProposal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupsByAdminRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryRecurringProposalsByGroupPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGroupsByAdminResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRecurringProposalsByGroupPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecurringProposalsByGroupPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Groups) > 0 {
-		for _, e := range m.Groups {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupPoliciesByGroupRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRecurringProposalsByGroupPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecurringProposalsByGroupPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecurringProposalsByGroupPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovQuery(uint64(m.GroupId))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecurringProposals) > 0 {
+		for iNdEx := len(m.RecurringProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecurringProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalVoteReceiptsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalVoteReceiptsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalVoteReceiptsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalVoteReceiptsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalVoteReceiptsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalVoteReceiptsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VoteReceipts) > 0 {
+		for iNdEx := len(m.VoteReceipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteReceipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGroupInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *QueryGroupInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupPolicyInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupPolicyInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupMembersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupMembersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupsByAdminRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupsByAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Groups) > 0 {
+		for _, e := range m.Groups {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupPoliciesByGroupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
@@ -3777,12 +4499,108 @@ func (m *QueryGroupsResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
+func (m *QueryRecurringProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryRecurringProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecurringProposal != nil {
+		l = m.RecurringProposal.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecurringProposalsByGroupPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecurringProposalsByGroupPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RecurringProposals) > 0 {
+		for _, e := range m.RecurringProposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalVoteReceiptsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalVoteReceiptsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VoteReceipts) > 0 {
+		for _, e := range m.VoteReceipts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
 func (m *QueryGroupInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3793,30 +4611,492 @@ func (m *QueryGroupInfoRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &GroupInfo{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupPolicyInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupPolicyInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupPolicyInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupPolicyInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupPolicyInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupPolicyInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &GroupPolicyInfo{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupMembersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupMembersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGroupMembersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGroupMembersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGroupMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Members = append(m.Members, &GroupMember{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupInfoRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.GroupId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3826,11 +5106,28 @@ func (m *QueryGroupInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3852,7 +5149,7 @@ func (m *QueryGroupInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupsByAdminRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3875,15 +5172,47 @@ func (m *QueryGroupInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupsByAdminRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupsByAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3910,10 +5239,10 @@ func (m *QueryGroupInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Info == nil {
-				m.Info = &GroupInfo{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3938,7 +5267,7 @@ func (m *QueryGroupInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupPolicyInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupsByAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3961,17 +5290,17 @@ func (m *QueryGroupPolicyInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupPolicyInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupsByAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupPolicyInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupsByAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3981,23 +5310,61 @@ func (m *QueryGroupPolicyInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Groups = append(m.Groups, &GroupInfo{})
+			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4020,7 +5387,7 @@ func (m *QueryGroupPolicyInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupPolicyInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupPoliciesByGroupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4043,15 +5410,34 @@ func (m *QueryGroupPolicyInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupPolicyInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupPoliciesByGroupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupPolicyInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupPoliciesByGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4078,10 +5464,10 @@ func (m *QueryGroupPolicyInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Info == nil {
-				m.Info = &GroupPolicyInfo{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4106,7 +5492,7 @@ func (m *QueryGroupPolicyInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupMembersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupPoliciesByGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4129,17 +5515,17 @@ func (m *QueryGroupMembersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupMembersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupPoliciesByGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupPoliciesByGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicies", wireType)
 			}
-			m.GroupId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4149,11 +5535,26 @@ func (m *QueryGroupMembersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPolicies = append(m.GroupPolicies, &GroupPolicyInfo{})
+			if err := m.GroupPolicies[len(m.GroupPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -4184,7 +5585,7 @@ func (m *QueryGroupMembersRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -4211,7 +5612,7 @@ func (m *QueryGroupMembersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupMembersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupPoliciesByAdminRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4234,17 +5635,17 @@ func (m *QueryGroupMembersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupMembersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupPoliciesByAdminRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupPoliciesByAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4254,25 +5655,23 @@ func (m *QueryGroupMembersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, &GroupMember{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -4304,7 +5703,7 @@ func (m *QueryGroupMembersResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -4331,7 +5730,7 @@ func (m *QueryGroupMembersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupsByAdminRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupPoliciesByAdminResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4354,17 +5753,17 @@ func (m *QueryGroupsByAdminRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupsByAdminRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupPoliciesByAdminResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupsByAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupPoliciesByAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicies", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4374,23 +5773,25 @@ func (m *QueryGroupsByAdminRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.GroupPolicies = append(m.GroupPolicies, &GroupPolicyInfo{})
+			if err := m.GroupPolicies[len(m.GroupPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -4422,7 +5823,7 @@ func (m *QueryGroupsByAdminRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -4449,7 +5850,7 @@ func (m *QueryGroupsByAdminRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupsByAdminResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4472,17 +5873,17 @@ func (m *QueryGroupsByAdminResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupsByAdminResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupsByAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var msglen int
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4492,29 +5893,64 @@ func (m *QueryGroupsByAdminResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Groups = append(m.Groups, &GroupInfo{})
-			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
-		case 2:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4541,10 +5977,10 @@ func (m *QueryGroupsByAdminResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Proposal == nil {
+				m.Proposal = &Proposal{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4569,7 +6005,7 @@ func (m *QueryGroupsByAdminResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupPoliciesByGroupRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalsByGroupPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4592,17 +6028,17 @@ func (m *QueryGroupPoliciesByGroupRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupPoliciesByGroupRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalsByGroupPolicyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupPoliciesByGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalsByGroupPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.GroupId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4612,11 +6048,24 @@ func (m *QueryGroupPoliciesByGroupRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GroupId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -4674,7 +6123,7 @@ func (m *QueryGroupPoliciesByGroupRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupPoliciesByGroupResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalsByGroupPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4697,15 +6146,15 @@ func (m *QueryGroupPoliciesByGroupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupPoliciesByGroupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalsByGroupPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupPoliciesByGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalsByGroupPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4732,8 +6181,8 @@ func (m *QueryGroupPoliciesByGroupResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupPolicies = append(m.GroupPolicies, &GroupPolicyInfo{})
-			if err := m.GroupPolicies[len(m.GroupPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Proposals = append(m.Proposals, &Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4794,7 +6243,7 @@ func (m *QueryGroupPoliciesByGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupPoliciesByAdminRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteByProposalVoterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4817,17 +6266,17 @@ func (m *QueryGroupPoliciesByAdminRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupPoliciesByAdminRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteByProposalVoterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupPoliciesByAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteByProposalVoterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var stringLen uint64
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4837,29 +6286,16 @@ func (m *QueryGroupPoliciesByAdminRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4869,27 +6305,23 @@ func (m *QueryGroupPoliciesByAdminRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4912,7 +6344,7 @@ func (m *QueryGroupPoliciesByAdminRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupPoliciesByAdminResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVoteByProposalVoterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4935,49 +6367,15 @@ func (m *QueryGroupPoliciesByAdminResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupPoliciesByAdminResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVoteByProposalVoterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupPoliciesByAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVoteByProposalVoterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicies", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GroupPolicies = append(m.GroupPolicies, &GroupPolicyInfo{})
-			if err := m.GroupPolicies[len(m.GroupPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5004,10 +6402,10 @@ func (m *QueryGroupPoliciesByAdminResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Vote == nil {
+				m.Vote = &Vote{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5032,7 +6430,7 @@ func (m *QueryGroupPoliciesByAdminResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVotesByProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5055,10 +6453,10 @@ func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotesByProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotesByProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5080,6 +6478,42 @@ func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5101,7 +6535,7 @@ func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVotesByProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5124,15 +6558,15 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotesByProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotesByProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5159,10 +6593,44 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Proposal == nil {
-				m.Proposal = &Proposal{}
+			m.Votes = append(m.Votes, &Vote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5187,7 +6655,7 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalsByGroupPolicyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVotesByVoterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5210,15 +6678,15 @@ func (m *QueryProposalsByGroupPolicyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalsByGroupPolicyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotesByVoterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalsByGroupPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotesByVoterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5246,7 +6714,7 @@ func (m *QueryProposalsByGroupPolicyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -5305,7 +6773,7 @@ func (m *QueryProposalsByGroupPolicyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalsByGroupPolicyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVotesByVoterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5328,15 +6796,15 @@ func (m *QueryProposalsByGroupPolicyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalsByGroupPolicyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVotesByVoterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalsByGroupPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVotesByVoterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5363,8 +6831,8 @@ func (m *QueryProposalsByGroupPolicyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposals = append(m.Proposals, &Proposal{})
-			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Votes = append(m.Votes, &Vote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5425,7 +6893,7 @@ func (m *QueryProposalsByGroupPolicyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteByProposalVoterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupsByMemberRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5448,34 +6916,15 @@ func (m *QueryVoteByProposalVoterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteByProposalVoterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupsByMemberRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteByProposalVoterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupsByMemberRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5503,61 +6952,11 @@ func (m *QueryVoteByProposalVoterRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryVoteByProposalVoterResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteByProposalVoterResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteByProposalVoterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5584,10 +6983,10 @@ func (m *QueryVoteByProposalVoterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Vote == nil {
-				m.Vote = &Vote{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5612,7 +7011,7 @@ func (m *QueryVoteByProposalVoterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotesByProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupsByMemberResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5635,17 +7034,17 @@ func (m *QueryVotesByProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotesByProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupsByMemberResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotesByProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupsByMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
-			m.ProposalId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5655,11 +7054,26 @@ func (m *QueryVotesByProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groups = append(m.Groups, &GroupInfo{})
+			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -5690,7 +7104,7 @@ func (m *QueryVotesByProposalRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5717,7 +7131,7 @@ func (m *QueryVotesByProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotesByProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTallyResultRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5740,17 +7154,17 @@ func (m *QueryVotesByProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotesByProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTallyResultRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotesByProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTallyResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var msglen int
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5760,29 +7174,64 @@ func (m *QueryVotesByProposalResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Votes = append(m.Votes, &Vote{})
-			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTallyResultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTallyResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5809,10 +7258,7 @@ func (m *QueryVotesByProposalResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5837,7 +7283,7 @@ func (m *QueryVotesByProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotesByVoterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5860,44 +7306,12 @@ func (m *QueryVotesByVoterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotesByVoterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotesByVoterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -5955,7 +7369,7 @@ func (m *QueryVotesByVoterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotesByVoterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGroupsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5978,15 +7392,15 @@ func (m *QueryVotesByVoterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotesByVoterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGroupsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotesByVoterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6013,8 +7427,8 @@ func (m *QueryVotesByVoterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Votes = append(m.Votes, &Vote{})
-			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Groups = append(m.Groups, &GroupInfo{})
+			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6075,7 +7489,7 @@ func (m *QueryVotesByVoterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupsByMemberRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRecurringProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6098,17 +7512,17 @@ func (m *QueryGroupsByMemberRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupsByMemberRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecurringProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupsByMemberRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecurringProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var stringLen uint64
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6118,27 +7532,64 @@ func (m *QueryGroupsByMemberRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecurringProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecurringProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecurringProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringProposal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6165,10 +7616,10 @@ func (m *QueryGroupsByMemberRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.RecurringProposal == nil {
+				m.RecurringProposal = &RecurringProposal{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RecurringProposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6193,7 +7644,7 @@ func (m *QueryGroupsByMemberRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupsByMemberResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRecurringProposalsByGroupPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6216,17 +7667,17 @@ func (m *QueryGroupsByMemberResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupsByMemberResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecurringProposalsByGroupPolicyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupsByMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecurringProposalsByGroupPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6236,25 +7687,23 @@ func (m *QueryGroupsByMemberResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Groups = append(m.Groups, &GroupInfo{})
-			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -6286,7 +7735,7 @@ func (m *QueryGroupsByMemberResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -6313,7 +7762,7 @@ func (m *QueryGroupsByMemberResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTallyResultRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRecurringProposalsByGroupPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6336,17 +7785,17 @@ func (m *QueryTallyResultRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTallyResultRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecurringProposalsByGroupPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTallyResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecurringProposalsByGroupPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringProposals", wireType)
 			}
-			m.ProposalId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6356,64 +7805,29 @@ func (m *QueryTallyResultRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.RecurringProposals = append(m.RecurringProposals, &RecurringProposal{})
+			if err := m.RecurringProposals[len(m.RecurringProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTallyResultResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTallyResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6440,7 +7854,10 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Tally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6465,7 +7882,7 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalVoteReceiptsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6488,12 +7905,31 @@ func (m *QueryGroupsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalVoteReceiptsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalVoteReceiptsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -6551,7 +7987,7 @@ func (m *QueryGroupsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGroupsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalVoteReceiptsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6574,15 +8010,15 @@ func (m *QueryGroupsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGroupsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalVoteReceiptsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGroupsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalVoteReceiptsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteReceipts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6609,8 +8045,8 @@ func (m *QueryGroupsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Groups = append(m.Groups, &GroupInfo{})
-			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VoteReceipts = append(m.VoteReceipts, &VoteReceipt{})
+			if err := m.VoteReceipts[len(m.VoteReceipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
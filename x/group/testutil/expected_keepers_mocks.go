@@ -141,6 +141,81 @@ func (mr *MockBankKeeperMockRecorder) Burn(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Burn", reflect.TypeOf((*MockBankKeeper)(nil).Burn), arg0, arg1)
 }
 
+// ChangeDenomAdmin mocks base method.
+func (m *MockBankKeeper) ChangeDenomAdmin(arg0 context.Context, arg1 *types.MsgChangeDenomAdmin) (*types.MsgChangeDenomAdminResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeDenomAdmin", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgChangeDenomAdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeDenomAdmin indicates an expected call of ChangeDenomAdmin.
+func (mr *MockBankKeeperMockRecorder) ChangeDenomAdmin(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeDenomAdmin", reflect.TypeOf((*MockBankKeeper)(nil).ChangeDenomAdmin), arg0, arg1)
+}
+
+// CreateDenom mocks base method.
+func (m *MockBankKeeper) CreateDenom(arg0 context.Context, arg1 *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDenom", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgCreateDenomResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDenom indicates an expected call of CreateDenom.
+func (mr *MockBankKeeperMockRecorder) CreateDenom(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDenom", reflect.TypeOf((*MockBankKeeper)(nil).CreateDenom), arg0, arg1)
+}
+
+// FactoryBurn mocks base method.
+func (m *MockBankKeeper) FactoryBurn(arg0 context.Context, arg1 *types.MsgFactoryBurn) (*types.MsgFactoryBurnResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FactoryBurn", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgFactoryBurnResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FactoryBurn indicates an expected call of FactoryBurn.
+func (mr *MockBankKeeperMockRecorder) FactoryBurn(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FactoryBurn", reflect.TypeOf((*MockBankKeeper)(nil).FactoryBurn), arg0, arg1)
+}
+
+// FactoryMint mocks base method.
+func (m *MockBankKeeper) FactoryMint(arg0 context.Context, arg1 *types.MsgFactoryMint) (*types.MsgFactoryMintResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FactoryMint", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgFactoryMintResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FactoryMint indicates an expected call of FactoryMint.
+func (mr *MockBankKeeperMockRecorder) FactoryMint(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FactoryMint", reflect.TypeOf((*MockBankKeeper)(nil).FactoryMint), arg0, arg1)
+}
+
+// ForceTransfer mocks base method.
+func (m *MockBankKeeper) ForceTransfer(arg0 context.Context, arg1 *types.MsgForceTransfer) (*types.MsgForceTransferResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForceTransfer", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgForceTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForceTransfer indicates an expected call of ForceTransfer.
+func (mr *MockBankKeeperMockRecorder) ForceTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceTransfer", reflect.TypeOf((*MockBankKeeper)(nil).ForceTransfer), arg0, arg1)
+}
+
 // GetAllBalances mocks base method.
 func (m *MockBankKeeper) GetAllBalances(ctx context.Context, addr types0.AccAddress) types0.Coins {
 	m.ctrl.T.Helper()
@@ -184,6 +259,36 @@ func (mr *MockBankKeeperMockRecorder) MultiSend(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MultiSend", reflect.TypeOf((*MockBankKeeper)(nil).MultiSend), arg0, arg1)
 }
 
+// RemoveDenomTransferFee mocks base method.
+func (m *MockBankKeeper) RemoveDenomTransferFee(arg0 context.Context, arg1 *types.MsgRemoveDenomTransferFee) (*types.MsgRemoveDenomTransferFeeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveDenomTransferFee", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgRemoveDenomTransferFeeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveDenomTransferFee indicates an expected call of RemoveDenomTransferFee.
+func (mr *MockBankKeeperMockRecorder) RemoveDenomTransferFee(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDenomTransferFee", reflect.TypeOf((*MockBankKeeper)(nil).RemoveDenomTransferFee), arg0, arg1)
+}
+
+// RemoveDenomTransferHook mocks base method.
+func (m *MockBankKeeper) RemoveDenomTransferHook(arg0 context.Context, arg1 *types.MsgRemoveDenomTransferHook) (*types.MsgRemoveDenomTransferHookResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveDenomTransferHook", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgRemoveDenomTransferHookResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveDenomTransferHook indicates an expected call of RemoveDenomTransferHook.
+func (mr *MockBankKeeperMockRecorder) RemoveDenomTransferHook(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDenomTransferHook", reflect.TypeOf((*MockBankKeeper)(nil).RemoveDenomTransferHook), arg0, arg1)
+}
+
 // Send mocks base method.
 func (m *MockBankKeeper) Send(arg0 context.Context, arg1 *types.MsgSend) (*types.MsgSendResponse, error) {
 	m.ctrl.T.Helper()
@@ -213,6 +318,96 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderMo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
 }
 
+// SetBalanceAlerts mocks base method.
+func (m *MockBankKeeper) SetBalanceAlerts(arg0 context.Context, arg1 *types.MsgSetBalanceAlerts) (*types.MsgSetBalanceAlertsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBalanceAlerts", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgSetBalanceAlertsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetBalanceAlerts indicates an expected call of SetBalanceAlerts.
+func (mr *MockBankKeeperMockRecorder) SetBalanceAlerts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBalanceAlerts", reflect.TypeOf((*MockBankKeeper)(nil).SetBalanceAlerts), arg0, arg1)
+}
+
+// SetDenomMetadata mocks base method.
+func (m *MockBankKeeper) SetDenomMetadata(arg0 context.Context, arg1 *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDenomMetadata", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgSetDenomMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDenomMetadata indicates an expected call of SetDenomMetadata.
+func (mr *MockBankKeeperMockRecorder) SetDenomMetadata(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomMetadata", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomMetadata), arg0, arg1)
+}
+
+// SetDenomTransferFee mocks base method.
+func (m *MockBankKeeper) SetDenomTransferFee(arg0 context.Context, arg1 *types.MsgSetDenomTransferFee) (*types.MsgSetDenomTransferFeeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDenomTransferFee", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgSetDenomTransferFeeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDenomTransferFee indicates an expected call of SetDenomTransferFee.
+func (mr *MockBankKeeperMockRecorder) SetDenomTransferFee(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomTransferFee", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomTransferFee), arg0, arg1)
+}
+
+// SetDenomTransferHook mocks base method.
+func (m *MockBankKeeper) SetDenomTransferHook(arg0 context.Context, arg1 *types.MsgSetDenomTransferHook) (*types.MsgSetDenomTransferHookResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDenomTransferHook", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgSetDenomTransferHookResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDenomTransferHook indicates an expected call of SetDenomTransferHook.
+func (mr *MockBankKeeperMockRecorder) SetDenomTransferHook(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomTransferHook", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomTransferHook), arg0, arg1)
+}
+
+// SetFactoryDenomMetadata mocks base method.
+func (m *MockBankKeeper) SetFactoryDenomMetadata(arg0 context.Context, arg1 *types.MsgSetFactoryDenomMetadata) (*types.MsgSetFactoryDenomMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFactoryDenomMetadata", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgSetFactoryDenomMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetFactoryDenomMetadata indicates an expected call of SetFactoryDenomMetadata.
+func (mr *MockBankKeeperMockRecorder) SetFactoryDenomMetadata(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFactoryDenomMetadata", reflect.TypeOf((*MockBankKeeper)(nil).SetFactoryDenomMetadata), arg0, arg1)
+}
+
+// SetNonCirculatingAddresses mocks base method.
+func (m *MockBankKeeper) SetNonCirculatingAddresses(arg0 context.Context, arg1 *types.MsgSetNonCirculatingAddresses) (*types.MsgSetNonCirculatingAddressesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNonCirculatingAddresses", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgSetNonCirculatingAddressesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetNonCirculatingAddresses indicates an expected call of SetNonCirculatingAddresses.
+func (mr *MockBankKeeperMockRecorder) SetNonCirculatingAddresses(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNonCirculatingAddresses", reflect.TypeOf((*MockBankKeeper)(nil).SetNonCirculatingAddresses), arg0, arg1)
+}
+
 // SetSendEnabled mocks base method.
 func (m *MockBankKeeper) SetSendEnabled(arg0 context.Context, arg1 *types.MsgSetSendEnabled) (*types.MsgSetSendEnabledResponse, error) {
 	m.ctrl.T.Helper()
@@ -228,6 +423,36 @@ func (mr *MockBankKeeperMockRecorder) SetSendEnabled(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSendEnabled", reflect.TypeOf((*MockBankKeeper)(nil).SetSendEnabled), arg0, arg1)
 }
 
+// SetSendRestrictionPolicies mocks base method.
+func (m *MockBankKeeper) SetSendRestrictionPolicies(arg0 context.Context, arg1 *types.MsgSetSendRestrictionPolicies) (*types.MsgSetSendRestrictionPoliciesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSendRestrictionPolicies", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgSetSendRestrictionPoliciesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSendRestrictionPolicies indicates an expected call of SetSendRestrictionPolicies.
+func (mr *MockBankKeeperMockRecorder) SetSendRestrictionPolicies(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSendRestrictionPolicies", reflect.TypeOf((*MockBankKeeper)(nil).SetSendRestrictionPolicies), arg0, arg1)
+}
+
+// SetSpendingLimit mocks base method.
+func (m *MockBankKeeper) SetSpendingLimit(arg0 context.Context, arg1 *types.MsgSetSpendingLimit) (*types.MsgSetSpendingLimitResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSpendingLimit", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgSetSpendingLimitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSpendingLimit indicates an expected call of SetSpendingLimit.
+func (mr *MockBankKeeperMockRecorder) SetSpendingLimit(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSpendingLimit", reflect.TypeOf((*MockBankKeeper)(nil).SetSpendingLimit), arg0, arg1)
+}
+
 // SpendableCoins mocks base method.
 func (m *MockBankKeeper) SpendableCoins(ctx context.Context, addr types0.AccAddress) types0.Coins {
 	m.ctrl.T.Helper()
@@ -242,6 +467,36 @@ func (mr *MockBankKeeperMockRecorder) SpendableCoins(ctx, addr interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableCoins", reflect.TypeOf((*MockBankKeeper)(nil).SpendableCoins), ctx, addr)
 }
 
+// SweepDust mocks base method.
+func (m *MockBankKeeper) SweepDust(arg0 context.Context, arg1 *types.MsgSweepDust) (*types.MsgSweepDustResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SweepDust", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgSweepDustResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SweepDust indicates an expected call of SweepDust.
+func (mr *MockBankKeeperMockRecorder) SweepDust(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SweepDust", reflect.TypeOf((*MockBankKeeper)(nil).SweepDust), arg0, arg1)
+}
+
+// UpdateDenomMetadata mocks base method.
+func (m *MockBankKeeper) UpdateDenomMetadata(arg0 context.Context, arg1 *types.MsgUpdateDenomMetadata) (*types.MsgUpdateDenomMetadataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDenomMetadata", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgUpdateDenomMetadataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDenomMetadata indicates an expected call of UpdateDenomMetadata.
+func (mr *MockBankKeeperMockRecorder) UpdateDenomMetadata(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDenomMetadata", reflect.TypeOf((*MockBankKeeper)(nil).UpdateDenomMetadata), arg0, arg1)
+}
+
 // UpdateParams mocks base method.
 func (m *MockBankKeeper) UpdateParams(arg0 context.Context, arg1 *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	m.ctrl.T.Helper()
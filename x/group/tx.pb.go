@@ -8,6 +8,8 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -960,6 +962,225 @@ func (m *MsgUpdateGroupPolicyMetadataResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateGroupPolicyMetadataResponse proto.InternalMessageInfo
 
+// MsgUpdateGroupPolicyVoteReceipts is the Msg/UpdateGroupPolicyVoteReceipts request type.
+//
+// Since: x/group 1.0.0
+type MsgUpdateGroupPolicyVoteReceipts struct {
+	// admin is the account address of the group admin.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// group_policy_address is the account address of group policy.
+	GroupPolicyAddress string `protobuf:"bytes,2,opt,name=group_policy_address,json=groupPolicyAddress,proto3" json:"group_policy_address,omitempty"`
+	// store_vote_receipts defines whether the votes on the proposals of the group
+	// policy are kept as vote receipts once the proposals are finally tallied.
+	StoreVoteReceipts bool `protobuf:"varint,3,opt,name=store_vote_receipts,json=storeVoteReceipts,proto3" json:"store_vote_receipts,omitempty"`
+}
+
+func (m *MsgUpdateGroupPolicyVoteReceipts) Reset()         { *m = MsgUpdateGroupPolicyVoteReceipts{} }
+func (m *MsgUpdateGroupPolicyVoteReceipts) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateGroupPolicyVoteReceipts) ProtoMessage()    {}
+func (*MsgUpdateGroupPolicyVoteReceipts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{18}
+}
+func (m *MsgUpdateGroupPolicyVoteReceipts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGroupPolicyVoteReceipts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGroupPolicyVoteReceipts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGroupPolicyVoteReceipts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGroupPolicyVoteReceipts.Merge(m, src)
+}
+func (m *MsgUpdateGroupPolicyVoteReceipts) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGroupPolicyVoteReceipts) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGroupPolicyVoteReceipts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGroupPolicyVoteReceipts proto.InternalMessageInfo
+
+func (m *MsgUpdateGroupPolicyVoteReceipts) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *MsgUpdateGroupPolicyVoteReceipts) GetGroupPolicyAddress() string {
+	if m != nil {
+		return m.GroupPolicyAddress
+	}
+	return ""
+}
+
+func (m *MsgUpdateGroupPolicyVoteReceipts) GetStoreVoteReceipts() bool {
+	if m != nil {
+		return m.StoreVoteReceipts
+	}
+	return false
+}
+
+// MsgUpdateGroupPolicyVoteReceiptsResponse is the Msg/UpdateGroupPolicyVoteReceipts response type.
+//
+// Since: x/group 1.0.0
+type MsgUpdateGroupPolicyVoteReceiptsResponse struct {
+}
+
+func (m *MsgUpdateGroupPolicyVoteReceiptsResponse) Reset() {
+	*m = MsgUpdateGroupPolicyVoteReceiptsResponse{}
+}
+func (m *MsgUpdateGroupPolicyVoteReceiptsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateGroupPolicyVoteReceiptsResponse) ProtoMessage()    {}
+func (*MsgUpdateGroupPolicyVoteReceiptsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{19}
+}
+func (m *MsgUpdateGroupPolicyVoteReceiptsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGroupPolicyVoteReceiptsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGroupPolicyVoteReceiptsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGroupPolicyVoteReceiptsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGroupPolicyVoteReceiptsResponse.Merge(m, src)
+}
+func (m *MsgUpdateGroupPolicyVoteReceiptsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGroupPolicyVoteReceiptsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGroupPolicyVoteReceiptsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGroupPolicyVoteReceiptsResponse proto.InternalMessageInfo
+
+// MsgUpdateGroupPolicyExecutionFeeLimit is the Msg/UpdateGroupPolicyExecutionFeeLimit request type.
+//
+// Since: x/group 1.0.0
+type MsgUpdateGroupPolicyExecutionFeeLimit struct {
+	// admin is the account address of the group admin.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// group_policy_address is the account address of group policy.
+	GroupPolicyAddress string `protobuf:"bytes,2,opt,name=group_policy_address,json=groupPolicyAddress,proto3" json:"group_policy_address,omitempty"`
+	// execution_fee_limit is the spend limit of the fee allowances granted to
+	// the group members for the execution of each accepted proposal. An empty
+	// limit disables the allowances.
+	ExecutionFeeLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=execution_fee_limit,json=executionFeeLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"execution_fee_limit"`
+}
+
+func (m *MsgUpdateGroupPolicyExecutionFeeLimit) Reset()         { *m = MsgUpdateGroupPolicyExecutionFeeLimit{} }
+func (m *MsgUpdateGroupPolicyExecutionFeeLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateGroupPolicyExecutionFeeLimit) ProtoMessage()    {}
+func (*MsgUpdateGroupPolicyExecutionFeeLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{20}
+}
+func (m *MsgUpdateGroupPolicyExecutionFeeLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGroupPolicyExecutionFeeLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGroupPolicyExecutionFeeLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGroupPolicyExecutionFeeLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGroupPolicyExecutionFeeLimit.Merge(m, src)
+}
+func (m *MsgUpdateGroupPolicyExecutionFeeLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGroupPolicyExecutionFeeLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGroupPolicyExecutionFeeLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGroupPolicyExecutionFeeLimit proto.InternalMessageInfo
+
+func (m *MsgUpdateGroupPolicyExecutionFeeLimit) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *MsgUpdateGroupPolicyExecutionFeeLimit) GetGroupPolicyAddress() string {
+	if m != nil {
+		return m.GroupPolicyAddress
+	}
+	return ""
+}
+
+func (m *MsgUpdateGroupPolicyExecutionFeeLimit) GetExecutionFeeLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ExecutionFeeLimit
+	}
+	return nil
+}
+
+// MsgUpdateGroupPolicyExecutionFeeLimitResponse is the Msg/UpdateGroupPolicyExecutionFeeLimit response type.
+//
+// Since: x/group 1.0.0
+type MsgUpdateGroupPolicyExecutionFeeLimitResponse struct {
+}
+
+func (m *MsgUpdateGroupPolicyExecutionFeeLimitResponse) Reset() {
+	*m = MsgUpdateGroupPolicyExecutionFeeLimitResponse{}
+}
+func (m *MsgUpdateGroupPolicyExecutionFeeLimitResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgUpdateGroupPolicyExecutionFeeLimitResponse) ProtoMessage() {}
+func (*MsgUpdateGroupPolicyExecutionFeeLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{21}
+}
+func (m *MsgUpdateGroupPolicyExecutionFeeLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGroupPolicyExecutionFeeLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGroupPolicyExecutionFeeLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGroupPolicyExecutionFeeLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGroupPolicyExecutionFeeLimitResponse.Merge(m, src)
+}
+func (m *MsgUpdateGroupPolicyExecutionFeeLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGroupPolicyExecutionFeeLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGroupPolicyExecutionFeeLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGroupPolicyExecutionFeeLimitResponse proto.InternalMessageInfo
+
 // MsgSubmitProposal is the Msg/SubmitProposal request type.
 type MsgSubmitProposal struct {
 	// group_policy_address is the account address of group policy.
@@ -983,13 +1204,19 @@ type MsgSubmitProposal struct {
 	//
 	// Since: cosmos-sdk 0.47
 	Summary string `protobuf:"bytes,7,opt,name=summary,proto3" json:"summary,omitempty"`
+	// recurrence_epochs, when non zero, flags the proposal as recurring: once
+	// executed, its messages are executed again every `recurrence_epochs` epochs
+	// until the recurrence is cancelled.
+	//
+	// Since: x/group 1.0.0
+	RecurrenceEpochs uint64 `protobuf:"varint,8,opt,name=recurrence_epochs,json=recurrenceEpochs,proto3" json:"recurrence_epochs,omitempty"`
 }
 
 func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
 func (m *MsgSubmitProposal) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitProposal) ProtoMessage()    {}
 func (*MsgSubmitProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{18}
+	return fileDescriptor_6b8d3d629f136420, []int{22}
 }
 func (m *MsgSubmitProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1028,7 +1255,7 @@ func (m *MsgSubmitProposalResponse) Reset()         { *m = MsgSubmitProposalResp
 func (m *MsgSubmitProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitProposalResponse) ProtoMessage()    {}
 func (*MsgSubmitProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{19}
+	return fileDescriptor_6b8d3d629f136420, []int{23}
 }
 func (m *MsgSubmitProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1076,7 +1303,7 @@ func (m *MsgWithdrawProposal) Reset()         { *m = MsgWithdrawProposal{} }
 func (m *MsgWithdrawProposal) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawProposal) ProtoMessage()    {}
 func (*MsgWithdrawProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{20}
+	return fileDescriptor_6b8d3d629f136420, []int{24}
 }
 func (m *MsgWithdrawProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1127,7 +1354,7 @@ func (m *MsgWithdrawProposalResponse) Reset()         { *m = MsgWithdrawProposal
 func (m *MsgWithdrawProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawProposalResponse) ProtoMessage()    {}
 func (*MsgWithdrawProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{21}
+	return fileDescriptor_6b8d3d629f136420, []int{25}
 }
 func (m *MsgWithdrawProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1175,7 +1402,7 @@ func (m *MsgVote) Reset()         { *m = MsgVote{} }
 func (m *MsgVote) String() string { return proto.CompactTextString(m) }
 func (*MsgVote) ProtoMessage()    {}
 func (*MsgVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{22}
+	return fileDescriptor_6b8d3d629f136420, []int{26}
 }
 func (m *MsgVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1247,7 +1474,7 @@ func (m *MsgVoteResponse) Reset()         { *m = MsgVoteResponse{} }
 func (m *MsgVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgVoteResponse) ProtoMessage()    {}
 func (*MsgVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{23}
+	return fileDescriptor_6b8d3d629f136420, []int{27}
 }
 func (m *MsgVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1276,26 +1503,36 @@ func (m *MsgVoteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgVoteResponse proto.InternalMessageInfo
 
-// MsgExec is the Msg/Exec request type.
-type MsgExec struct {
+// SignedVote is a vote on a proposal signed off-chain by the voter.
+//
+// Since: x/group 1.0.0
+type SignedVote struct {
 	// proposal is the unique ID of the proposal.
 	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
-	// executor is the account address used to execute the proposal.
-	Executor string `protobuf:"bytes,2,opt,name=executor,proto3" json:"executor,omitempty"`
+	// voter is the voter account address.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// option is the voter's choice on the proposal.
+	Option VoteOption `protobuf:"varint,3,opt,name=option,proto3,enum=cosmos.group.v1.VoteOption" json:"option,omitempty"`
+	// metadata is any arbitrary metadata attached to the vote.
+	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// pub_key is the public key of the voter the vote is signed with.
+	PubKey *types.Any `protobuf:"bytes,5,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// signature is the signature of the voter over the vote sign bytes.
+	Signature []byte `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (m *MsgExec) Reset()         { *m = MsgExec{} }
-func (m *MsgExec) String() string { return proto.CompactTextString(m) }
-func (*MsgExec) ProtoMessage()    {}
-func (*MsgExec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{24}
+func (m *SignedVote) Reset()         { *m = SignedVote{} }
+func (m *SignedVote) String() string { return proto.CompactTextString(m) }
+func (*SignedVote) ProtoMessage()    {}
+func (*SignedVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{28}
 }
-func (m *MsgExec) XXX_Unmarshal(b []byte) error {
+func (m *SignedVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgExec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SignedVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgExec.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SignedVote.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1305,50 +1542,82 @@ func (m *MsgExec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MsgExec) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgExec.Merge(m, src)
+func (m *SignedVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedVote.Merge(m, src)
 }
-func (m *MsgExec) XXX_Size() int {
+func (m *SignedVote) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgExec) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgExec.DiscardUnknown(m)
+func (m *SignedVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedVote.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgExec proto.InternalMessageInfo
+var xxx_messageInfo_SignedVote proto.InternalMessageInfo
 
-func (m *MsgExec) GetProposalId() uint64 {
+func (m *SignedVote) GetProposalId() uint64 {
 	if m != nil {
 		return m.ProposalId
 	}
 	return 0
 }
 
-func (m *MsgExec) GetExecutor() string {
+func (m *SignedVote) GetVoter() string {
 	if m != nil {
-		return m.Executor
+		return m.Voter
 	}
 	return ""
 }
 
-// MsgExecResponse is the Msg/Exec request type.
-type MsgExecResponse struct {
-	// result is the final result of the proposal execution.
-	Result ProposalExecutorResult `protobuf:"varint,2,opt,name=result,proto3,enum=cosmos.group.v1.ProposalExecutorResult" json:"result,omitempty"`
+func (m *SignedVote) GetOption() VoteOption {
+	if m != nil {
+		return m.Option
+	}
+	return VOTE_OPTION_UNSPECIFIED
 }
 
-func (m *MsgExecResponse) Reset()         { *m = MsgExecResponse{} }
-func (m *MsgExecResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgExecResponse) ProtoMessage()    {}
-func (*MsgExecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{25}
+func (m *SignedVote) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
 }
-func (m *MsgExecResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *SignedVote) GetPubKey() *types.Any {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *SignedVote) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// MsgSubmitVotesBatch is the Msg/SubmitVotesBatch request type.
+//
+// Since: x/group 1.0.0
+type MsgSubmitVotesBatch struct {
+	// relayer is the account address submitting the votes and paying the fees.
+	Relayer string `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// votes are the votes signed off-chain by the voters.
+	Votes []SignedVote `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes"`
+}
+
+func (m *MsgSubmitVotesBatch) Reset()         { *m = MsgSubmitVotesBatch{} }
+func (m *MsgSubmitVotesBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitVotesBatch) ProtoMessage()    {}
+func (*MsgSubmitVotesBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{29}
+}
+func (m *MsgSubmitVotesBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgExecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubmitVotesBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgExecResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubmitVotesBatch.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1358,45 +1627,50 @@ func (m *MsgExecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgExecResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgExecResponse.Merge(m, src)
+func (m *MsgSubmitVotesBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitVotesBatch.Merge(m, src)
 }
-func (m *MsgExecResponse) XXX_Size() int {
+func (m *MsgSubmitVotesBatch) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgExecResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgExecResponse.DiscardUnknown(m)
+func (m *MsgSubmitVotesBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitVotesBatch.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgExecResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSubmitVotesBatch proto.InternalMessageInfo
 
-func (m *MsgExecResponse) GetResult() ProposalExecutorResult {
+func (m *MsgSubmitVotesBatch) GetRelayer() string {
 	if m != nil {
-		return m.Result
+		return m.Relayer
 	}
-	return PROPOSAL_EXECUTOR_RESULT_UNSPECIFIED
+	return ""
 }
 
-// MsgLeaveGroup is the Msg/LeaveGroup request type.
-type MsgLeaveGroup struct {
-	// address is the account address of the group member.
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// group_id is the unique ID of the group.
-	GroupId uint64 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+func (m *MsgSubmitVotesBatch) GetVotes() []SignedVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
 }
 
-func (m *MsgLeaveGroup) Reset()         { *m = MsgLeaveGroup{} }
-func (m *MsgLeaveGroup) String() string { return proto.CompactTextString(m) }
-func (*MsgLeaveGroup) ProtoMessage()    {}
-func (*MsgLeaveGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{26}
+// MsgSubmitVotesBatchResponse is the Msg/SubmitVotesBatch response type.
+//
+// Since: x/group 1.0.0
+type MsgSubmitVotesBatchResponse struct {
 }
-func (m *MsgLeaveGroup) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgSubmitVotesBatchResponse) Reset()         { *m = MsgSubmitVotesBatchResponse{} }
+func (m *MsgSubmitVotesBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitVotesBatchResponse) ProtoMessage()    {}
+func (*MsgSubmitVotesBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{30}
+}
+func (m *MsgSubmitVotesBatchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgLeaveGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubmitVotesBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgLeaveGroup.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubmitVotesBatchResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1406,24 +1680,166 @@ func (m *MsgLeaveGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *MsgLeaveGroup) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgLeaveGroup.Merge(m, src)
+func (m *MsgSubmitVotesBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitVotesBatchResponse.Merge(m, src)
 }
-func (m *MsgLeaveGroup) XXX_Size() int {
+func (m *MsgSubmitVotesBatchResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgLeaveGroup) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgLeaveGroup.DiscardUnknown(m)
+func (m *MsgSubmitVotesBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitVotesBatchResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgLeaveGroup proto.InternalMessageInfo
+var xxx_messageInfo_MsgSubmitVotesBatchResponse proto.InternalMessageInfo
 
-func (m *MsgLeaveGroup) GetAddress() string {
-	if m != nil {
-		return m.Address
-	}
-	return ""
-}
+// MsgExec is the Msg/Exec request type.
+type MsgExec struct {
+	// proposal is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// executor is the account address used to execute the proposal.
+	Executor string `protobuf:"bytes,2,opt,name=executor,proto3" json:"executor,omitempty"`
+}
+
+func (m *MsgExec) Reset()         { *m = MsgExec{} }
+func (m *MsgExec) String() string { return proto.CompactTextString(m) }
+func (*MsgExec) ProtoMessage()    {}
+func (*MsgExec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{31}
+}
+func (m *MsgExec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExec.Merge(m, src)
+}
+func (m *MsgExec) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExec) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExec proto.InternalMessageInfo
+
+func (m *MsgExec) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgExec) GetExecutor() string {
+	if m != nil {
+		return m.Executor
+	}
+	return ""
+}
+
+// MsgExecResponse is the Msg/Exec request type.
+type MsgExecResponse struct {
+	// result is the final result of the proposal execution.
+	Result ProposalExecutorResult `protobuf:"varint,2,opt,name=result,proto3,enum=cosmos.group.v1.ProposalExecutorResult" json:"result,omitempty"`
+}
+
+func (m *MsgExecResponse) Reset()         { *m = MsgExecResponse{} }
+func (m *MsgExecResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExecResponse) ProtoMessage()    {}
+func (*MsgExecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{32}
+}
+func (m *MsgExecResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecResponse.Merge(m, src)
+}
+func (m *MsgExecResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecResponse proto.InternalMessageInfo
+
+func (m *MsgExecResponse) GetResult() ProposalExecutorResult {
+	if m != nil {
+		return m.Result
+	}
+	return PROPOSAL_EXECUTOR_RESULT_UNSPECIFIED
+}
+
+// MsgLeaveGroup is the Msg/LeaveGroup request type.
+type MsgLeaveGroup struct {
+	// address is the account address of the group member.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+}
+
+func (m *MsgLeaveGroup) Reset()         { *m = MsgLeaveGroup{} }
+func (m *MsgLeaveGroup) String() string { return proto.CompactTextString(m) }
+func (*MsgLeaveGroup) ProtoMessage()    {}
+func (*MsgLeaveGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{33}
+}
+func (m *MsgLeaveGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLeaveGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLeaveGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLeaveGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLeaveGroup.Merge(m, src)
+}
+func (m *MsgLeaveGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLeaveGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLeaveGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLeaveGroup proto.InternalMessageInfo
+
+func (m *MsgLeaveGroup) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
 
 func (m *MsgLeaveGroup) GetGroupId() uint64 {
 	if m != nil {
@@ -1440,7 +1856,7 @@ func (m *MsgLeaveGroupResponse) Reset()         { *m = MsgLeaveGroupResponse{} }
 func (m *MsgLeaveGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLeaveGroupResponse) ProtoMessage()    {}
 func (*MsgLeaveGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{27}
+	return fileDescriptor_6b8d3d629f136420, []int{34}
 }
 func (m *MsgLeaveGroupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1469,6 +1885,102 @@ func (m *MsgLeaveGroupResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgLeaveGroupResponse proto.InternalMessageInfo
 
+// MsgCancelRecurringProposal is the Msg/CancelRecurringProposal request type.
+//
+// Since: x/group 1.0.0
+type MsgCancelRecurringProposal struct {
+	// proposal_id is the unique ID of the proposal the recurrence originates from.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// address is the admin of the group policy or the group policy account itself.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MsgCancelRecurringProposal) Reset()         { *m = MsgCancelRecurringProposal{} }
+func (m *MsgCancelRecurringProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRecurringProposal) ProtoMessage()    {}
+func (*MsgCancelRecurringProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{35}
+}
+func (m *MsgCancelRecurringProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRecurringProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRecurringProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRecurringProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRecurringProposal.Merge(m, src)
+}
+func (m *MsgCancelRecurringProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRecurringProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRecurringProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRecurringProposal proto.InternalMessageInfo
+
+func (m *MsgCancelRecurringProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgCancelRecurringProposal) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// MsgCancelRecurringProposalResponse is the Msg/CancelRecurringProposal response type.
+//
+// Since: x/group 1.0.0
+type MsgCancelRecurringProposalResponse struct {
+}
+
+func (m *MsgCancelRecurringProposalResponse) Reset()         { *m = MsgCancelRecurringProposalResponse{} }
+func (m *MsgCancelRecurringProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRecurringProposalResponse) ProtoMessage()    {}
+func (*MsgCancelRecurringProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{36}
+}
+func (m *MsgCancelRecurringProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRecurringProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRecurringProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRecurringProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRecurringProposalResponse.Merge(m, src)
+}
+func (m *MsgCancelRecurringProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRecurringProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRecurringProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRecurringProposalResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmos.group.v1.Exec", Exec_name, Exec_value)
 	proto.RegisterType((*MsgCreateGroup)(nil), "cosmos.group.v1.MsgCreateGroup")
@@ -1489,112 +2001,148 @@ func init() {
 	proto.RegisterType((*MsgUpdateGroupPolicyDecisionPolicyResponse)(nil), "cosmos.group.v1.MsgUpdateGroupPolicyDecisionPolicyResponse")
 	proto.RegisterType((*MsgUpdateGroupPolicyMetadata)(nil), "cosmos.group.v1.MsgUpdateGroupPolicyMetadata")
 	proto.RegisterType((*MsgUpdateGroupPolicyMetadataResponse)(nil), "cosmos.group.v1.MsgUpdateGroupPolicyMetadataResponse")
+	proto.RegisterType((*MsgUpdateGroupPolicyVoteReceipts)(nil), "cosmos.group.v1.MsgUpdateGroupPolicyVoteReceipts")
+	proto.RegisterType((*MsgUpdateGroupPolicyVoteReceiptsResponse)(nil), "cosmos.group.v1.MsgUpdateGroupPolicyVoteReceiptsResponse")
+	proto.RegisterType((*MsgUpdateGroupPolicyExecutionFeeLimit)(nil), "cosmos.group.v1.MsgUpdateGroupPolicyExecutionFeeLimit")
+	proto.RegisterType((*MsgUpdateGroupPolicyExecutionFeeLimitResponse)(nil), "cosmos.group.v1.MsgUpdateGroupPolicyExecutionFeeLimitResponse")
 	proto.RegisterType((*MsgSubmitProposal)(nil), "cosmos.group.v1.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "cosmos.group.v1.MsgSubmitProposalResponse")
 	proto.RegisterType((*MsgWithdrawProposal)(nil), "cosmos.group.v1.MsgWithdrawProposal")
 	proto.RegisterType((*MsgWithdrawProposalResponse)(nil), "cosmos.group.v1.MsgWithdrawProposalResponse")
 	proto.RegisterType((*MsgVote)(nil), "cosmos.group.v1.MsgVote")
 	proto.RegisterType((*MsgVoteResponse)(nil), "cosmos.group.v1.MsgVoteResponse")
+	proto.RegisterType((*SignedVote)(nil), "cosmos.group.v1.SignedVote")
+	proto.RegisterType((*MsgSubmitVotesBatch)(nil), "cosmos.group.v1.MsgSubmitVotesBatch")
+	proto.RegisterType((*MsgSubmitVotesBatchResponse)(nil), "cosmos.group.v1.MsgSubmitVotesBatchResponse")
 	proto.RegisterType((*MsgExec)(nil), "cosmos.group.v1.MsgExec")
 	proto.RegisterType((*MsgExecResponse)(nil), "cosmos.group.v1.MsgExecResponse")
 	proto.RegisterType((*MsgLeaveGroup)(nil), "cosmos.group.v1.MsgLeaveGroup")
 	proto.RegisterType((*MsgLeaveGroupResponse)(nil), "cosmos.group.v1.MsgLeaveGroupResponse")
+	proto.RegisterType((*MsgCancelRecurringProposal)(nil), "cosmos.group.v1.MsgCancelRecurringProposal")
+	proto.RegisterType((*MsgCancelRecurringProposalResponse)(nil), "cosmos.group.v1.MsgCancelRecurringProposalResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/tx.proto", fileDescriptor_6b8d3d629f136420) }
 
 var fileDescriptor_6b8d3d629f136420 = []byte{
-	// 1432 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xda, 0xce, 0xd7, 0x0b, 0x75, 0x93, 0x6d, 0xd2, 0x6e, 0xb6, 0xad, 0xed, 0x4e, 0x3f,
-	0x92, 0x5a, 0x8d, 0xdd, 0x38, 0xb4, 0x12, 0x06, 0x09, 0x35, 0xa9, 0x41, 0x41, 0x18, 0xa2, 0x6d,
-	0x4b, 0x81, 0x8b, 0xd9, 0x64, 0xb7, 0xcb, 0xaa, 0xb1, 0xd7, 0x78, 0xd6, 0x69, 0x72, 0xe3, 0xe3,
-	0x02, 0x08, 0x09, 0x24, 0xf8, 0x03, 0xe0, 0xc6, 0xb1, 0x48, 0x3d, 0x70, 0xe3, 0x86, 0xaa, 0x72,
-	0xa9, 0x38, 0x71, 0x42, 0xd0, 0x0a, 0xf5, 0xc6, 0xbf, 0x00, 0xda, 0x99, 0xdd, 0xf1, 0x8e, 0x77,
-	0xd7, 0xbb, 0xb1, 0x2c, 0xb8, 0x44, 0xde, 0x79, 0xbf, 0xf7, 0xf5, 0x7b, 0x6f, 0xde, 0xcc, 0x04,
-	0xa4, 0x1d, 0x0b, 0x37, 0x2d, 0x5c, 0x36, 0x3a, 0x56, 0xb7, 0x5d, 0xde, 0x5b, 0x2d, 0xdb, 0xfb,
-	0xa5, 0x76, 0xc7, 0xb2, 0x2d, 0xf1, 0x28, 0x95, 0x94, 0x88, 0xa4, 0xb4, 0xb7, 0x2a, 0xcf, 0x1b,
-	0x96, 0x61, 0x11, 0x59, 0xd9, 0xf9, 0x45, 0x61, 0xf2, 0x22, 0x85, 0x35, 0xa8, 0xc0, 0xd5, 0x71,
-	0x45, 0x86, 0x65, 0x19, 0xbb, 0x7a, 0x99, 0x7c, 0x6d, 0x77, 0xef, 0x94, 0xd5, 0xd6, 0x81, 0x2b,
-	0x3a, 0x19, 0x70, 0x7b, 0xd0, 0xd6, 0x3d, 0xbd, 0x13, 0xae, 0xb0, 0x89, 0x0d, 0x47, 0xd4, 0xc4,
-	0x86, 0x2b, 0x98, 0x53, 0x9b, 0x66, 0xcb, 0x2a, 0x93, 0xbf, 0x74, 0x09, 0xfd, 0x22, 0x40, 0xb6,
-	0x8e, 0x8d, 0x8d, 0x8e, 0xae, 0xda, 0xfa, 0xab, 0x8e, 0x35, 0xb1, 0x04, 0xe3, 0xaa, 0xd6, 0x34,
-	0x5b, 0x92, 0x50, 0x10, 0x96, 0xa7, 0xd7, 0xa5, 0x5f, 0x1f, 0xac, 0xcc, 0xbb, 0x71, 0x5d, 0xd3,
-	0xb4, 0x8e, 0x8e, 0xf1, 0x0d, 0xbb, 0x63, 0xb6, 0x0c, 0x85, 0xc2, 0xc4, 0x0d, 0x98, 0x6c, 0xea,
-	0xcd, 0x6d, 0xbd, 0x83, 0xa5, 0x54, 0x21, 0xbd, 0x3c, 0x53, 0xc9, 0x95, 0xfa, 0x52, 0x2f, 0xd5,
-	0x89, 0x5c, 0xd1, 0x3f, 0xe8, 0xea, 0xd8, 0x5e, 0x9f, 0x7e, 0xf8, 0x7b, 0x7e, 0xec, 0xfb, 0x67,
-	0xf7, 0x8b, 0x82, 0xe2, 0x69, 0x8a, 0x32, 0x4c, 0x35, 0x75, 0x5b, 0xd5, 0x54, 0x5b, 0x95, 0xd2,
-	0x8e, 0x5f, 0x85, 0x7d, 0x57, 0x97, 0x3f, 0x7e, 0x76, 0xbf, 0x48, 0x9d, 0x7d, 0xfe, 0xec, 0x7e,
-	0xd1, 0x65, 0x6c, 0x05, 0x6b, 0x77, 0xcb, 0x7c, 0xe8, 0x68, 0x0d, 0x8e, 0xf3, 0x2b, 0x8a, 0x8e,
-	0xdb, 0x56, 0x0b, 0xeb, 0xe2, 0x22, 0x4c, 0x91, 0x68, 0x1a, 0xa6, 0x46, 0xf2, 0xca, 0x28, 0x93,
-	0xe4, 0x7b, 0x53, 0x43, 0x7f, 0x09, 0xb0, 0x50, 0xc7, 0xc6, 0xad, 0xb6, 0xe6, 0x69, 0xd5, 0xdd,
-	0xa0, 0x0e, 0xcb, 0x84, 0xdf, 0x49, 0x8a, 0x73, 0x22, 0x6e, 0x41, 0x96, 0xa6, 0xda, 0xe8, 0x12,
-	0x3f, 0x58, 0x4a, 0x1f, 0x96, 0xab, 0x23, 0xd4, 0x00, 0x8d, 0x13, 0x57, 0xcb, 0x3c, 0x2b, 0x05,
-	0x9e, 0x95, 0x60, 0x36, 0x28, 0x0f, 0xa7, 0x43, 0x05, 0x1e, 0x47, 0xe8, 0x67, 0x01, 0x8e, 0xf1,
-	0x88, 0x6b, 0x24, 0xad, 0x11, 0xd2, 0x70, 0x05, 0xa6, 0x5b, 0xfa, 0xbd, 0x06, 0x35, 0x97, 0x8e,
-	0x31, 0x37, 0xd5, 0xd2, 0xef, 0x91, 0x08, 0xaa, 0x2b, 0x7c, 0xae, 0xb9, 0xc8, 0x5c, 0x09, 0x1c,
-	0x9d, 0x86, 0x93, 0x21, 0xcb, 0x2c, 0xcf, 0x1f, 0x04, 0xd2, 0x26, 0x1c, 0x13, 0xb4, 0xd5, 0x46,
-	0x99, 0xea, 0xa0, 0x8e, 0xbe, 0xcc, 0xe7, 0x73, 0x66, 0x40, 0xed, 0xa8, 0x06, 0x2a, 0x40, 0x2e,
-	0x5c, 0xc2, 0xb2, 0xfa, 0x26, 0x05, 0xf3, 0x7c, 0xf3, 0x6f, 0x59, 0xbb, 0xe6, 0xce, 0xc1, 0x7f,
-	0x94, 0x93, 0xa8, 0xc2, 0x51, 0x4d, 0xdf, 0x31, 0xb1, 0x69, 0xb5, 0x1a, 0x6d, 0xe2, 0x59, 0xca,
-	0x14, 0x84, 0xe5, 0x99, 0xca, 0x7c, 0x89, 0xce, 0xb1, 0x92, 0x37, 0xc7, 0x4a, 0xd7, 0x5a, 0x07,
-	0xeb, 0xe8, 0xd1, 0x83, 0x95, 0x5c, 0x7f, 0xef, 0x5f, 0x77, 0x0d, 0xd0, 0xc8, 0x95, 0xac, 0xc6,
-	0x7d, 0x57, 0x2b, 0x9f, 0x7e, 0x9b, 0x1f, 0xe3, 0xa9, 0xcb, 0x47, 0x0e, 0x03, 0xaa, 0x83, 0x14,
-	0x38, 0x15, 0xb6, 0xce, 0x06, 0x43, 0x05, 0x26, 0x55, 0xca, 0x42, 0x2c, 0x3f, 0x1e, 0x10, 0x7d,
-	0x92, 0x82, 0x45, 0xbe, 0x1a, 0xd4, 0xe8, 0x70, 0xdb, 0xe5, 0x35, 0x98, 0xa7, 0x7c, 0x53, 0xd6,
-	0x1a, 0x5e, 0x38, 0xa9, 0x18, 0x75, 0xd1, 0xf0, 0x7b, 0x26, 0x92, 0x61, 0xf7, 0xd7, 0x1a, 0x4f,
-	0xea, 0xb9, 0xc8, 0x7e, 0xf4, 0xe5, 0x89, 0xce, 0xc2, 0x99, 0x48, 0x21, 0xeb, 0xca, 0x1f, 0xd3,
-	0x20, 0xf1, 0xfc, 0xdf, 0x36, 0xed, 0xf7, 0x87, 0xec, 0xcc, 0x91, 0x9c, 0x34, 0xe7, 0x21, 0x4b,
-	0xe9, 0xee, 0xeb, 0xe4, 0x23, 0x06, 0x37, 0x09, 0x2a, 0xb0, 0xc0, 0x55, 0x85, 0xa1, 0x33, 0x04,
-	0x7d, 0xcc, 0x47, 0x3e, 0xd3, 0x59, 0xed, 0xd3, 0x51, 0xb1, 0x5b, 0x89, 0xf1, 0x82, 0xb0, 0x3c,
-	0xc5, 0x17, 0x0c, 0xd3, 0x66, 0x09, 0xd9, 0x35, 0x13, 0x23, 0xde, 0x35, 0x57, 0x83, 0xbb, 0xe6,
-	0x6c, 0xe4, 0xae, 0xe9, 0x55, 0x07, 0x7d, 0x26, 0x40, 0x21, 0x4a, 0x98, 0xe0, 0x5c, 0x1d, 0x65,
-	0x5f, 0xa3, 0x9f, 0x52, 0x80, 0xc2, 0x9a, 0x8d, 0x4f, 0xfd, 0x7f, 0xdd, 0x7a, 0x21, 0x95, 0x4c,
-	0x8f, 0xb8, 0x92, 0xd5, 0x60, 0x25, 0x97, 0x22, 0xb7, 0x2a, 0x6f, 0x0b, 0x5d, 0x82, 0x62, 0x3c,
-	0x81, 0x6c, 0xdb, 0xfe, 0x2d, 0x90, 0xb1, 0x19, 0x80, 0x0f, 0x7d, 0x50, 0x8e, 0x92, 0xe9, 0x41,
-	0x27, 0xeb, 0xd5, 0xa4, 0xf4, 0xf0, 0xf9, 0xa0, 0x0b, 0x70, 0x6e, 0x90, 0x9c, 0x11, 0xf3, 0x67,
-	0x0a, 0xe6, 0xea, 0xd8, 0xb8, 0xd1, 0xdd, 0x6e, 0x9a, 0xf6, 0x56, 0xc7, 0x6a, 0x5b, 0x58, 0xdd,
-	0x8d, 0xcc, 0x4e, 0x18, 0x22, 0xbb, 0x53, 0x30, 0xdd, 0x26, 0x76, 0xbd, 0x31, 0x37, 0xad, 0xf4,
-	0x16, 0x06, 0x9e, 0xc0, 0x97, 0x1d, 0x19, 0xc6, 0xaa, 0xa1, 0x63, 0x29, 0x43, 0xe6, 0x63, 0x68,
-	0xeb, 0x29, 0x0c, 0x25, 0x5e, 0x84, 0x8c, 0xbe, 0xaf, 0xef, 0x90, 0xf9, 0x94, 0xad, 0x2c, 0x04,
-	0xa6, 0x69, 0x6d, 0x5f, 0xdf, 0x51, 0x08, 0x44, 0x9c, 0x87, 0x71, 0xdb, 0xb4, 0x77, 0x75, 0x32,
-	0x9e, 0xa6, 0x15, 0xfa, 0x21, 0x4a, 0x30, 0x89, 0xbb, 0xcd, 0xa6, 0xda, 0x39, 0x90, 0x26, 0xc9,
-	0xba, 0xf7, 0x59, 0x7d, 0xc1, 0xeb, 0xd5, 0x5e, 0xf0, 0x4e, 0x41, 0x90, 0xaf, 0x20, 0xf4, 0xf1,
-	0x12, 0x60, 0x13, 0xbd, 0x44, 0x4e, 0x57, 0x7e, 0x91, 0x0d, 0x9c, 0x3c, 0xcc, 0xb4, 0xdd, 0xb5,
-	0xde, 0xcc, 0x01, 0x6f, 0x69, 0x53, 0x43, 0xdf, 0xd1, 0x5b, 0xac, 0x33, 0xab, 0xb4, 0x8e, 0x7a,
-	0x8f, 0xd5, 0x28, 0x4e, 0xd1, 0x7f, 0x13, 0x48, 0x25, 0xbc, 0x09, 0x54, 0xaf, 0x38, 0x19, 0x7a,
-	0x5f, 0xfd, 0x47, 0x27, 0xcb, 0xaf, 0x3f, 0x16, 0xf7, 0x82, 0xda, 0xbf, 0xcc, 0x9a, 0xec, 0x1f,
-	0x01, 0x26, 0xeb, 0xd8, 0x78, 0xcb, 0xb2, 0xe3, 0xf3, 0x75, 0x76, 0xe2, 0x9e, 0x65, 0xeb, 0x9d,
-	0xd8, 0xa0, 0x29, 0x4c, 0x5c, 0x83, 0x09, 0xab, 0x6d, 0x9b, 0x16, 0xbd, 0x1f, 0x64, 0x2b, 0x27,
-	0x03, 0x55, 0x77, 0xfc, 0xbe, 0x49, 0x20, 0x8a, 0x0b, 0xe5, 0xda, 0x2e, 0xd3, 0xd7, 0x76, 0xc9,
-	0x9b, 0xa8, 0xba, 0x44, 0x76, 0x27, 0x89, 0xc3, 0x21, 0x4b, 0x0a, 0x23, 0xcb, 0xf1, 0x8e, 0xe6,
-	0xe0, 0xa8, 0xfb, 0x93, 0x91, 0xf2, 0x05, 0x25, 0xc5, 0xb1, 0x16, 0x4f, 0xca, 0xf3, 0x30, 0xe5,
-	0x38, 0xec, 0xda, 0x56, 0x3c, 0x2f, 0x0c, 0x59, 0x2d, 0x3a, 0xe1, 0xb1, 0xcf, 0xc8, 0x08, 0x9d,
-	0x10, 0x90, 0x42, 0x22, 0x24, 0xb9, 0x79, 0xad, 0xf9, 0x32, 0x4c, 0x74, 0x74, 0xdc, 0xdd, 0xb5,
-	0x89, 0xcb, 0x6c, 0x65, 0x29, 0x40, 0x85, 0x57, 0xe9, 0x9a, 0xeb, 0x42, 0x21, 0x70, 0xc5, 0x55,
-	0x43, 0x5f, 0x0a, 0x70, 0xa4, 0x8e, 0x8d, 0xd7, 0x75, 0x75, 0xcf, 0x7d, 0x8b, 0x0f, 0x71, 0x3b,
-	0x1d, 0x70, 0x7f, 0xa7, 0x6f, 0x46, 0x7f, 0xbb, 0xe6, 0xc2, 0xf2, 0xeb, 0xf9, 0x47, 0x27, 0xc8,
-	0xd3, 0xb8, 0xb7, 0xe0, 0xe5, 0x5a, 0x2c, 0x42, 0xa6, 0x46, 0xc7, 0xc2, 0x6c, 0xed, 0xed, 0xda,
-	0x46, 0xe3, 0xd6, 0x1b, 0x37, 0xb6, 0x6a, 0x1b, 0x9b, 0xaf, 0x6c, 0xd6, 0xae, 0xcf, 0x8e, 0x89,
-	0xcf, 0xc1, 0x14, 0x59, 0xbd, 0xa9, 0xbc, 0x33, 0x2b, 0x54, 0x1e, 0xcd, 0x40, 0xba, 0x8e, 0x0d,
-	0xf1, 0x36, 0xcc, 0xf8, 0xff, 0xcf, 0x90, 0x0f, 0x5e, 0xde, 0xb8, 0xdb, 0x86, 0xbc, 0x14, 0x03,
-	0x60, 0xc4, 0xef, 0x82, 0x18, 0xf2, 0x7a, 0xbf, 0x10, 0xa6, 0x1e, 0xc4, 0xc9, 0xa5, 0x64, 0x38,
-	0xe6, 0xed, 0x0e, 0xcc, 0x06, 0x9e, 0xc8, 0xe7, 0x62, 0x6c, 0x10, 0x94, 0x7c, 0x29, 0x09, 0x8a,
-	0xf9, 0xb1, 0xe0, 0x58, 0xd8, 0x13, 0x75, 0x29, 0x36, 0x5c, 0x0a, 0x94, 0xcb, 0x09, 0x81, 0xcc,
-	0xa1, 0x09, 0x73, 0xc1, 0xd7, 0xe3, 0xf9, 0x98, 0x22, 0x50, 0x98, 0xbc, 0x92, 0x08, 0xc6, 0x5c,
-	0x75, 0x61, 0x21, 0xfc, 0x49, 0x70, 0x31, 0xc6, 0x4e, 0x0f, 0x2a, 0xaf, 0x26, 0x86, 0x32, 0xb7,
-	0xfb, 0x70, 0x3c, 0xe2, 0xd1, 0x56, 0x8c, 0x21, 0xcb, 0x87, 0x95, 0x2b, 0xc9, 0xb1, 0xcc, 0xf3,
-	0xd7, 0x02, 0xe4, 0xe3, 0x6e, 0xaf, 0x6b, 0x89, 0xec, 0xf2, 0x4a, 0xf2, 0x8b, 0x43, 0x28, 0xb1,
-	0xa8, 0x3e, 0x12, 0x60, 0x31, 0xfa, 0x8e, 0xb7, 0x92, 0xc8, 0x34, 0xeb, 0xb7, 0x2b, 0x87, 0x82,
-	0xb3, 0x18, 0xde, 0x83, 0x6c, 0xdf, 0x6d, 0x0a, 0x85, 0x19, 0xe2, 0x31, 0x72, 0x31, 0x1e, 0xe3,
-	0xdf, 0xb0, 0x81, 0xdb, 0x40, 0xe8, 0x86, 0xed, 0x47, 0x85, 0x6f, 0xd8, 0xa8, 0x63, 0x5b, 0x5c,
-	0x87, 0x0c, 0x39, 0xb2, 0xa5, 0x30, 0x2d, 0x47, 0x22, 0x17, 0xa2, 0x24, 0x7e, 0x1b, 0x64, 0xae,
-	0x86, 0xda, 0x70, 0x24, 0xe1, 0x36, 0xb8, 0x73, 0xe8, 0x26, 0x80, 0xef, 0x08, 0xc9, 0x85, 0xe1,
-	0x7b, 0x72, 0xf9, 0xc2, 0x60, 0xb9, 0x67, 0x55, 0x1e, 0xff, 0xd0, 0x79, 0x47, 0xaf, 0x97, 0x1e,
-	0x3e, 0xc9, 0x09, 0x8f, 0x9f, 0xe4, 0x84, 0x3f, 0x9e, 0xe4, 0x84, 0xaf, 0x9e, 0xe6, 0xc6, 0x1e,
-	0x3f, 0xcd, 0x8d, 0xfd, 0xf6, 0x34, 0x37, 0xf6, 0xae, 0x7b, 0x2c, 0x61, 0xed, 0x6e, 0xc9, 0xb4,
-	0xca, 0xfb, 0xf4, 0x3c, 0xd9, 0x9e, 0x20, 0x57, 0xcf, 0xb5, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0xaa, 0x49, 0x9b, 0xdc, 0x29, 0x17, 0x00, 0x00,
+	// 1866 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x37, 0x25, 0x7f, 0xbe, 0x24, 0xb2, 0x4d, 0xdb, 0x89, 0xcc, 0x24, 0x92, 0x96, 0xeb, 0xc4,
+	0x5e, 0x6d, 0x4c, 0xad, 0xe5, 0x26, 0xc0, 0xaa, 0x8b, 0x16, 0xb1, 0x57, 0x59, 0xa4, 0x5d, 0xb5,
+	0x06, 0xb3, 0xdb, 0x6d, 0x7b, 0x51, 0x29, 0x69, 0xc2, 0x10, 0x91, 0x48, 0x95, 0x43, 0x39, 0x11,
+	0x7a, 0xe9, 0x07, 0x50, 0xb4, 0x45, 0x3f, 0xd1, 0xf6, 0x52, 0xf4, 0xd0, 0xde, 0x16, 0x3d, 0xa5,
+	0xc0, 0x1e, 0x7a, 0x6a, 0x6f, 0xc5, 0x62, 0x7b, 0x59, 0xf4, 0xd4, 0x53, 0x5a, 0x24, 0x28, 0x7c,
+	0x6b, 0xff, 0x84, 0x16, 0x33, 0x43, 0x8e, 0x48, 0x91, 0x14, 0x69, 0xc1, 0x68, 0xba, 0x17, 0x5b,
+	0x9c, 0xf7, 0x7b, 0xf3, 0xde, 0xfb, 0xbd, 0x37, 0x1f, 0x6f, 0x20, 0xdf, 0xb6, 0x70, 0xcf, 0xc2,
+	0x15, 0xdd, 0xb6, 0x06, 0xfd, 0xca, 0xf1, 0x5e, 0xc5, 0x79, 0xac, 0xf4, 0x6d, 0xcb, 0xb1, 0xc4,
+	0x65, 0x26, 0x51, 0xa8, 0x44, 0x39, 0xde, 0x93, 0xd6, 0x75, 0x4b, 0xb7, 0xa8, 0xac, 0x42, 0x7e,
+	0x31, 0x98, 0xb4, 0xc9, 0x60, 0x4d, 0x26, 0x70, 0x75, 0x5c, 0x91, 0x6e, 0x59, 0x7a, 0x17, 0x55,
+	0xe8, 0x57, 0x6b, 0x70, 0xbf, 0xa2, 0x99, 0x43, 0x57, 0x74, 0x39, 0x64, 0x76, 0xd8, 0x47, 0x9e,
+	0xde, 0x25, 0x57, 0xd8, 0xc3, 0x3a, 0x11, 0xf5, 0xb0, 0xee, 0x0a, 0x56, 0xb5, 0x9e, 0x61, 0x5a,
+	0x15, 0xfa, 0xd7, 0x1d, 0x2a, 0xb8, 0xd8, 0x96, 0x86, 0x51, 0xe5, 0x78, 0xaf, 0x85, 0x1c, 0x6d,
+	0xaf, 0xd2, 0xb6, 0x0c, 0x93, 0xc9, 0xe5, 0xbf, 0x08, 0x90, 0x6b, 0x60, 0xfd, 0xd0, 0x46, 0x9a,
+	0x83, 0xde, 0x22, 0xd6, 0x44, 0x05, 0xe6, 0xb4, 0x4e, 0xcf, 0x30, 0xf3, 0x42, 0x49, 0xd8, 0x59,
+	0x3a, 0xc8, 0xff, 0xf5, 0x83, 0xdd, 0x75, 0xd7, 0xef, 0xdb, 0x9d, 0x8e, 0x8d, 0x30, 0xbe, 0xe7,
+	0xd8, 0x86, 0xa9, 0xab, 0x0c, 0x26, 0x1e, 0xc2, 0x42, 0x0f, 0xf5, 0x5a, 0xc8, 0xc6, 0xf9, 0x4c,
+	0x29, 0xbb, 0x73, 0xae, 0x5a, 0x50, 0xc6, 0xa8, 0x51, 0x1a, 0x54, 0xae, 0xa2, 0xaf, 0x0f, 0x10,
+	0x76, 0x0e, 0x96, 0x3e, 0x7c, 0x5a, 0x9c, 0x79, 0xff, 0xe4, 0x49, 0x59, 0x50, 0x3d, 0x4d, 0x51,
+	0x82, 0xc5, 0x1e, 0x72, 0xb4, 0x8e, 0xe6, 0x68, 0xf9, 0x2c, 0xb1, 0xab, 0xf2, 0xef, 0xda, 0xce,
+	0xb7, 0x4f, 0x9e, 0x94, 0x99, 0xb1, 0x1f, 0x9c, 0x3c, 0x29, 0xbb, 0x8c, 0xee, 0xe2, 0xce, 0xc3,
+	0x4a, 0xd0, 0x75, 0x79, 0x1f, 0x2e, 0x06, 0x47, 0x54, 0x84, 0xfb, 0x96, 0x89, 0x91, 0xb8, 0x09,
+	0x8b, 0xd4, 0x9b, 0xa6, 0xd1, 0xa1, 0x71, 0xcd, 0xaa, 0x0b, 0xf4, 0xfb, 0x6e, 0x47, 0xfe, 0xa7,
+	0x00, 0x1b, 0x0d, 0xac, 0xbf, 0xdb, 0xef, 0x78, 0x5a, 0x0d, 0xd7, 0xa9, 0xd3, 0x32, 0xe1, 0x37,
+	0x92, 0x09, 0x18, 0x11, 0x8f, 0x20, 0xc7, 0x42, 0x6d, 0x0e, 0xa8, 0x1d, 0x9c, 0xcf, 0x9e, 0x96,
+	0xab, 0x0b, 0x6c, 0x02, 0xe6, 0x27, 0xae, 0x55, 0x82, 0xac, 0x94, 0x82, 0xac, 0x84, 0xa3, 0x91,
+	0x8b, 0x70, 0x35, 0x52, 0xe0, 0x71, 0x24, 0xff, 0x59, 0x80, 0xb5, 0x20, 0xe2, 0x36, 0x0d, 0xeb,
+	0x0c, 0x69, 0xb8, 0x09, 0x4b, 0x26, 0x7a, 0xd4, 0x64, 0xd3, 0x65, 0x13, 0xa6, 0x5b, 0x34, 0xd1,
+	0x23, 0xea, 0x41, 0x6d, 0x37, 0x18, 0x6b, 0x21, 0x36, 0x56, 0x0a, 0x97, 0xaf, 0xc2, 0xe5, 0x88,
+	0x61, 0x1e, 0xe7, 0xef, 0x05, 0xb8, 0x18, 0x94, 0x37, 0xdc, 0x52, 0x3b, 0xcb, 0x50, 0x27, 0x55,
+	0xf4, 0x6b, 0xc1, 0x78, 0x5e, 0x9a, 0x90, 0x3b, 0xa6, 0x21, 0x97, 0xa0, 0x10, 0x2d, 0xe1, 0x51,
+	0xfd, 0x22, 0x03, 0xeb, 0xc1, 0xe2, 0x3f, 0xb2, 0xba, 0x46, 0x7b, 0xf8, 0x3f, 0x8a, 0x49, 0xd4,
+	0x60, 0xb9, 0x83, 0xda, 0x06, 0x36, 0x2c, 0xb3, 0xd9, 0xa7, 0x96, 0xf3, 0xb3, 0x25, 0x61, 0xe7,
+	0x5c, 0x75, 0x5d, 0x61, 0xfb, 0x9c, 0xe2, 0xed, 0x73, 0xca, 0x6d, 0x73, 0x78, 0x20, 0x7f, 0xf4,
+	0xc1, 0x6e, 0x61, 0xbc, 0xf6, 0xdf, 0x74, 0x27, 0x60, 0x9e, 0xab, 0xb9, 0x4e, 0xe0, 0xbb, 0x56,
+	0xfd, 0xde, 0x6f, 0x8a, 0x33, 0x41, 0xea, 0x8a, 0xb1, 0x9b, 0x01, 0xd3, 0x91, 0x55, 0xb8, 0x12,
+	0x35, 0xce, 0x37, 0x86, 0x2a, 0x2c, 0x68, 0x8c, 0x85, 0x44, 0x7e, 0x3c, 0xa0, 0xfc, 0x9d, 0x0c,
+	0x6c, 0x06, 0xb3, 0xc1, 0x26, 0x9d, 0x6e, 0xb9, 0x7c, 0x0e, 0xd6, 0x19, 0xdf, 0x8c, 0xb5, 0xa6,
+	0xe7, 0x4e, 0x26, 0x41, 0x5d, 0xd4, 0xfd, 0x96, 0xa9, 0x64, 0xda, 0xf5, 0xb5, 0x1f, 0x24, 0x75,
+	0x2b, 0xb6, 0x1e, 0x7d, 0x71, 0xca, 0x2f, 0xc3, 0x4b, 0xb1, 0x42, 0x5e, 0x95, 0x7f, 0xc8, 0x42,
+	0x3e, 0xc8, 0xff, 0x7b, 0x86, 0xf3, 0x60, 0xca, 0xca, 0x3c, 0x93, 0x93, 0xe6, 0x1a, 0xe4, 0x18,
+	0xdd, 0x63, 0x95, 0x7c, 0x41, 0x0f, 0xec, 0x04, 0x55, 0xd8, 0x08, 0x64, 0x85, 0xa3, 0x67, 0x29,
+	0x7a, 0xcd, 0x47, 0x3e, 0xd7, 0xd9, 0x1b, 0xd3, 0xd1, 0xb0, 0x9b, 0x89, 0xb9, 0x92, 0xb0, 0xb3,
+	0x18, 0x4c, 0x18, 0x66, 0xc5, 0x12, 0xb1, 0x6a, 0xe6, 0xcf, 0x78, 0xd5, 0xdc, 0x0a, 0xaf, 0x9a,
+	0x97, 0x63, 0x57, 0xcd, 0x28, 0x3b, 0xf2, 0xf7, 0x05, 0x28, 0xc5, 0x09, 0x53, 0x9c, 0xab, 0x67,
+	0x59, 0xd7, 0xf2, 0x9f, 0x32, 0x20, 0x47, 0x15, 0x5b, 0x30, 0xf4, 0x17, 0xba, 0xf4, 0x22, 0x32,
+	0x99, 0x3d, 0xe3, 0x4c, 0xd6, 0xc2, 0x99, 0xdc, 0x8e, 0x5d, 0xaa, 0xc1, 0xb9, 0xe4, 0x1b, 0x50,
+	0x4e, 0x26, 0x90, 0x2f, 0xdb, 0x7f, 0x09, 0x70, 0x25, 0x0a, 0x3e, 0xf5, 0x41, 0x79, 0x96, 0x4c,
+	0x4f, 0x3a, 0x59, 0x6f, 0xa5, 0xa5, 0x27, 0x18, 0x8f, 0x7c, 0x1d, 0xb6, 0x26, 0xc9, 0x39, 0x31,
+	0xdf, 0xcd, 0x40, 0x29, 0x0a, 0xf8, 0x25, 0xcb, 0x41, 0x2a, 0x6a, 0x23, 0xa3, 0xef, 0xe0, 0x17,
+	0x4a, 0x8e, 0x02, 0x6b, 0xd8, 0xb1, 0x6c, 0xd4, 0x3c, 0xb6, 0x1c, 0xd4, 0xb4, 0x5d, 0x97, 0x28,
+	0x4f, 0x8b, 0xea, 0x2a, 0x15, 0xf9, 0x7d, 0x3d, 0x2d, 0x61, 0x9e, 0x9e, 0x5c, 0x86, 0x9d, 0x24,
+	0x1e, 0x38, 0x69, 0xff, 0xce, 0xc0, 0xb5, 0x28, 0x70, 0xfd, 0x31, 0x6a, 0x0f, 0x1c, 0xc3, 0x32,
+	0xef, 0x20, 0xf4, 0xb6, 0xd1, 0x33, 0x9c, 0x17, 0xca, 0xdc, 0xcf, 0x04, 0x58, 0x43, 0x9e, 0x47,
+	0xcd, 0xfb, 0x08, 0x35, 0xbb, 0xc4, 0x27, 0xf7, 0xa2, 0xbe, 0xe9, 0x1d, 0x35, 0xa4, 0x93, 0x52,
+	0xdc, 0x4e, 0x4a, 0x39, 0xb4, 0x0c, 0xf3, 0xe0, 0x0e, 0x39, 0x65, 0x7e, 0xf7, 0xf7, 0xe2, 0x8e,
+	0x6e, 0x38, 0x0f, 0x06, 0x2d, 0xa5, 0x6d, 0xf5, 0xdc, 0x46, 0xaf, 0xe2, 0x63, 0x93, 0x75, 0x70,
+	0x44, 0x01, 0xff, 0xea, 0xe4, 0x49, 0xf9, 0x7c, 0x17, 0xe9, 0x5a, 0x7b, 0xd8, 0x24, 0xbd, 0x18,
+	0x66, 0x47, 0xd4, 0x2a, 0x1a, 0xe7, 0xe3, 0xb4, 0xd9, 0xf1, 0xf4, 0xe4, 0x0a, 0xec, 0xa6, 0x22,
+	0x9c, 0xa7, 0xe8, 0xc7, 0x59, 0x58, 0x6d, 0x60, 0xfd, 0xde, 0xa0, 0xd5, 0x33, 0x9c, 0x23, 0xdb,
+	0xea, 0x5b, 0x58, 0xeb, 0xc6, 0xd2, 0x2b, 0x4c, 0x41, 0xef, 0x15, 0x58, 0xea, 0xd3, 0x79, 0xbd,
+	0xe3, 0x7b, 0x49, 0x1d, 0x0d, 0x4c, 0xbc, 0x59, 0xbe, 0x46, 0x64, 0x18, 0x6b, 0x3a, 0xc2, 0xf9,
+	0xd9, 0x52, 0x36, 0x6e, 0x4b, 0x55, 0x39, 0x4a, 0x7c, 0x05, 0x66, 0x09, 0x97, 0xf4, 0xdc, 0xcd,
+	0x55, 0x37, 0x42, 0xb7, 0x04, 0xc2, 0x83, 0x4a, 0x21, 0xe2, 0x3a, 0xcc, 0x39, 0x86, 0xd3, 0x45,
+	0xf4, 0xd8, 0x5d, 0x52, 0xd9, 0x87, 0x98, 0x87, 0x05, 0x3c, 0xe8, 0xf5, 0x34, 0x7b, 0x98, 0x5f,
+	0xa0, 0xe3, 0xde, 0xa7, 0xf8, 0x2a, 0xac, 0xda, 0xa8, 0x3d, 0xb0, 0x6d, 0x64, 0xb6, 0x51, 0x13,
+	0xf5, 0xad, 0xf6, 0x03, 0x9c, 0x5f, 0xa4, 0x27, 0xdf, 0xca, 0x48, 0x50, 0xa7, 0xe3, 0xb5, 0xd7,
+	0xbd, 0x0d, 0x7b, 0x14, 0x29, 0x49, 0xa3, 0xec, 0x4b, 0x23, 0xeb, 0xf0, 0x43, 0xd4, 0xcb, 0x6f,
+	0xc0, 0x66, 0x68, 0x90, 0x9f, 0xba, 0x45, 0x38, 0xd7, 0x77, 0xc7, 0x46, 0x07, 0x2f, 0x78, 0x43,
+	0x77, 0x3b, 0xf2, 0x6f, 0x59, 0x2b, 0x47, 0x0e, 0xec, 0x8e, 0xad, 0x3d, 0xe2, 0x09, 0x4d, 0x52,
+	0xf4, 0x5f, 0x87, 0x33, 0x29, 0xaf, 0xc3, 0xb5, 0x9b, 0x24, 0x42, 0xef, 0x6b, 0xfc, 0xfe, 0xc8,
+	0xe3, 0x1b, 0xf7, 0xc5, 0xed, 0xd2, 0xc6, 0x87, 0x79, 0x45, 0xfe, 0x47, 0x80, 0x85, 0x06, 0xd6,
+	0xc9, 0x86, 0x92, 0xec, 0xb6, 0x02, 0x73, 0x64, 0xbf, 0xb3, 0x13, 0x9d, 0x66, 0x30, 0x71, 0x1f,
+	0xe6, 0xad, 0x3e, 0x59, 0x08, 0xb4, 0xd8, 0x72, 0xd5, 0xcb, 0xa1, 0x12, 0x21, 0x76, 0xbf, 0x48,
+	0x21, 0xaa, 0x0b, 0x0d, 0xd4, 0xe8, 0xec, 0x58, 0x8d, 0xa6, 0xaf, 0xb8, 0xda, 0x36, 0x5d, 0xd3,
+	0xd4, 0x0f, 0x42, 0x56, 0x3e, 0x8a, 0x2c, 0x62, 0x5d, 0x5e, 0x85, 0x65, 0xf7, 0x27, 0x27, 0xe5,
+	0x97, 0x19, 0x80, 0x7b, 0x86, 0x6e, 0xa2, 0xce, 0x27, 0x84, 0x97, 0xb7, 0x60, 0xa1, 0x3f, 0x68,
+	0x35, 0x1f, 0xa2, 0x21, 0xa5, 0x26, 0xee, 0x36, 0x94, 0xff, 0x68, 0xe4, 0x58, 0xdb, 0x1e, 0xf6,
+	0x1d, 0x4b, 0x39, 0x1a, 0xb4, 0x3e, 0x8f, 0x86, 0xea, 0x7c, 0x9f, 0xfe, 0x27, 0xdb, 0x07, 0x36,
+	0x74, 0x53, 0x73, 0x06, 0x36, 0x5b, 0xab, 0xe7, 0xd5, 0xd1, 0x80, 0xfc, 0x47, 0x56, 0xef, 0x6c,
+	0xb9, 0x10, 0x17, 0xf1, 0x81, 0xe6, 0xb4, 0x1f, 0x90, 0x72, 0xb6, 0x51, 0x57, 0x1b, 0x22, 0x3b,
+	0xb9, 0xbb, 0x73, 0x81, 0xe2, 0x1b, 0x8c, 0x33, 0xaf, 0xc7, 0x08, 0x53, 0x30, 0x4a, 0x80, 0xbf,
+	0xc1, 0x60, 0x4a, 0xee, 0x62, 0x70, 0xe7, 0x8a, 0x5d, 0x0c, 0xe3, 0x8e, 0xba, 0x8b, 0x61, 0x7c,
+	0x98, 0xe7, 0xfd, 0x87, 0x6c, 0x31, 0x90, 0x2a, 0x4a, 0x4e, 0xfa, 0xa7, 0x60, 0x91, 0x9d, 0x24,
+	0x56, 0x72, 0xde, 0x39, 0xb2, 0x56, 0x26, 0x8e, 0xf3, 0xcf, 0xd8, 0xca, 0x24, 0x2e, 0xc8, 0x2a,
+	0x2c, 0xbb, 0x3f, 0xf9, 0x96, 0xf4, 0x59, 0x98, 0xb7, 0x11, 0x1e, 0x74, 0x1d, 0x6a, 0x32, 0x57,
+	0xdd, 0x0e, 0xd1, 0xe6, 0xad, 0xf0, 0xba, 0x6b, 0x42, 0xa5, 0x70, 0xd5, 0x55, 0x93, 0x7f, 0x22,
+	0xc0, 0x85, 0x06, 0xd6, 0xdf, 0x46, 0xda, 0xb1, 0xfb, 0x10, 0x39, 0x45, 0x6b, 0x3e, 0xe1, 0xf1,
+	0x82, 0x3d, 0x98, 0xf9, 0xb7, 0xa9, 0x42, 0x54, 0x7c, 0x23, 0xfb, 0xf2, 0x25, 0xd8, 0x08, 0x0c,
+	0xf0, 0x6c, 0xbc, 0x2f, 0x80, 0x44, 0x3a, 0x23, 0xcd, 0x6c, 0xa3, 0xae, 0x4a, 0x37, 0x7d, 0xc3,
+	0xd4, 0xff, 0x1f, 0x36, 0x59, 0xbf, 0x4b, 0xe4, 0x1c, 0x92, 0xb7, 0x40, 0x1e, 0x1b, 0xf6, 0x79,
+	0xea, 0x05, 0x54, 0x2e, 0xc3, 0x6c, 0x9d, 0x1d, 0x86, 0x2b, 0xf5, 0x2f, 0xd7, 0x0f, 0x9b, 0xef,
+	0x7e, 0xe1, 0xde, 0x51, 0xfd, 0xf0, 0xee, 0x9d, 0xbb, 0xf5, 0x37, 0x57, 0x66, 0xc4, 0xf3, 0xb0,
+	0x48, 0x47, 0xdf, 0x51, 0xbf, 0xb2, 0x22, 0x54, 0x9f, 0x2e, 0x43, 0xb6, 0x81, 0x75, 0xf1, 0x3d,
+	0x38, 0xe7, 0x7f, 0x35, 0x2e, 0x86, 0x5b, 0xf1, 0x40, 0xef, 0x28, 0x6d, 0x27, 0x00, 0x78, 0x25,
+	0x75, 0x41, 0x8c, 0x78, 0x8b, 0xbd, 0x1e, 0xa5, 0x1e, 0xc6, 0x49, 0x4a, 0x3a, 0x1c, 0xb7, 0x76,
+	0x1f, 0x56, 0x42, 0x0f, 0x9e, 0x5b, 0x09, 0x73, 0x50, 0x94, 0x74, 0x23, 0x0d, 0x8a, 0xdb, 0xb1,
+	0x60, 0x2d, 0xea, 0xc1, 0x71, 0x3b, 0xd1, 0x5d, 0x06, 0x94, 0x2a, 0x29, 0x81, 0xdc, 0xa0, 0x01,
+	0xab, 0xe1, 0xb7, 0xc0, 0x6b, 0x09, 0x49, 0x60, 0x30, 0x69, 0x37, 0x15, 0x8c, 0x9b, 0x1a, 0xc0,
+	0x46, 0xf4, 0x03, 0xcf, 0x2b, 0x09, 0xf3, 0x8c, 0xa0, 0xd2, 0x5e, 0x6a, 0x28, 0x37, 0xfb, 0x18,
+	0x2e, 0xc6, 0x3c, 0xc1, 0x95, 0x13, 0xc8, 0xf2, 0x61, 0xa5, 0x6a, 0x7a, 0x2c, 0xb7, 0xfc, 0x73,
+	0x01, 0x8a, 0x49, 0x6f, 0x11, 0xfb, 0xa9, 0xe6, 0x0d, 0x2a, 0x49, 0x9f, 0x9e, 0x42, 0x89, 0x7b,
+	0xf5, 0x2d, 0x01, 0x36, 0xe3, 0x3b, 0xf6, 0xdd, 0x54, 0x53, 0xf3, 0x7a, 0xbb, 0x79, 0x2a, 0x38,
+	0xf7, 0xe1, 0x47, 0x02, 0x5c, 0x9d, 0xdc, 0x1c, 0xef, 0xa5, 0x9a, 0xd8, 0xaf, 0x22, 0xbd, 0x7e,
+	0x6a, 0x15, 0xee, 0xcf, 0xaf, 0x05, 0x90, 0x53, 0xf4, 0x9d, 0xb7, 0x52, 0x59, 0x08, 0xe9, 0x49,
+	0x9f, 0x99, 0x4e, 0x8f, 0xbb, 0xf7, 0x35, 0xc8, 0x8d, 0xb5, 0x5c, 0x72, 0xd4, 0x8c, 0x41, 0x8c,
+	0x54, 0x4e, 0xc6, 0xf8, 0xf7, 0xb7, 0x50, 0x17, 0x10, 0xb9, 0xbf, 0x8d, 0xa3, 0xa4, 0x1b, 0x69,
+	0x50, 0xdc, 0xce, 0x01, 0xcc, 0xd2, 0x2b, 0x69, 0x3e, 0x4a, 0x8b, 0x48, 0xa4, 0x52, 0x9c, 0xc4,
+	0xef, 0x6b, 0xe8, 0x06, 0xb7, 0x15, 0x1f, 0xeb, 0x08, 0x25, 0xdd, 0x48, 0x83, 0xf2, 0xfb, 0x4a,
+	0x8f, 0xbb, 0x48, 0x5f, 0x89, 0x44, 0x2a, 0xc5, 0x49, 0xf8, 0x1c, 0xef, 0x00, 0xf8, 0xae, 0x2a,
+	0x85, 0x28, 0xfc, 0x48, 0x2e, 0x5d, 0x9f, 0x2c, 0xe7, 0xb3, 0x7e, 0x03, 0x2e, 0xc5, 0xdd, 0x2a,
+	0x5e, 0x8d, 0xdc, 0x20, 0xa3, 0xc1, 0xd2, 0xfe, 0x29, 0xc0, 0x9e, 0x71, 0x69, 0xee, 0x9b, 0xe4,
+	0x22, 0x7b, 0xa0, 0x7c, 0xf8, 0xac, 0x20, 0x7c, 0xfc, 0xac, 0x20, 0xfc, 0xe3, 0x59, 0x41, 0xf8,
+	0xe9, 0xf3, 0xc2, 0xcc, 0xc7, 0xcf, 0x0b, 0x33, 0x7f, 0x7b, 0x5e, 0x98, 0xf9, 0xaa, 0x7b, 0x45,
+	0xc1, 0x9d, 0x87, 0x8a, 0x61, 0x55, 0x1e, 0xb3, 0x6b, 0x47, 0x6b, 0x9e, 0xde, 0xe4, 0xf7, 0xff,
+	0x3b, 0x00, 0x58, 0xfa, 0xe1, 0x5c, 0x2b, 0x1f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1627,16 +2175,34 @@ type MsgClient interface {
 	UpdateGroupPolicyDecisionPolicy(ctx context.Context, in *MsgUpdateGroupPolicyDecisionPolicy, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyDecisionPolicyResponse, error)
 	// UpdateGroupPolicyMetadata updates a group policy metadata.
 	UpdateGroupPolicyMetadata(ctx context.Context, in *MsgUpdateGroupPolicyMetadata, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyMetadataResponse, error)
+	// UpdateGroupPolicyVoteReceipts updates whether a group policy stores vote receipts.
+	//
+	// Since: x/group 1.0.0
+	UpdateGroupPolicyVoteReceipts(ctx context.Context, in *MsgUpdateGroupPolicyVoteReceipts, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyVoteReceiptsResponse, error)
+	// UpdateGroupPolicyExecutionFeeLimit updates the spend limit of the fee
+	// allowances granted by a group policy to the executors of its proposals.
+	//
+	// Since: x/group 1.0.0
+	UpdateGroupPolicyExecutionFeeLimit(ctx context.Context, in *MsgUpdateGroupPolicyExecutionFeeLimit, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyExecutionFeeLimitResponse, error)
 	// SubmitProposal submits a new proposal.
 	SubmitProposal(ctx context.Context, in *MsgSubmitProposal, opts ...grpc.CallOption) (*MsgSubmitProposalResponse, error)
 	// WithdrawProposal withdraws a proposal.
 	WithdrawProposal(ctx context.Context, in *MsgWithdrawProposal, opts ...grpc.CallOption) (*MsgWithdrawProposalResponse, error)
 	// Vote allows a voter to vote on a proposal.
 	Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error)
+	// SubmitVotesBatch submits a batch of votes signed off-chain by the group
+	// members, aggregated by a relayer paying the transaction fees.
+	//
+	// Since: x/group 1.0.0
+	SubmitVotesBatch(ctx context.Context, in *MsgSubmitVotesBatch, opts ...grpc.CallOption) (*MsgSubmitVotesBatchResponse, error)
 	// Exec executes a proposal.
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error)
+	// CancelRecurringProposal stops the recurrence of a recurring proposal.
+	//
+	// Since: x/group 1.0.0
+	CancelRecurringProposal(ctx context.Context, in *MsgCancelRecurringProposal, opts ...grpc.CallOption) (*MsgCancelRecurringProposalResponse, error)
 }
 
 type msgClient struct {
@@ -1728,6 +2294,24 @@ func (c *msgClient) UpdateGroupPolicyMetadata(ctx context.Context, in *MsgUpdate
 	return out, nil
 }
 
+func (c *msgClient) UpdateGroupPolicyVoteReceipts(ctx context.Context, in *MsgUpdateGroupPolicyVoteReceipts, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyVoteReceiptsResponse, error) {
+	out := new(MsgUpdateGroupPolicyVoteReceiptsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/UpdateGroupPolicyVoteReceipts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateGroupPolicyExecutionFeeLimit(ctx context.Context, in *MsgUpdateGroupPolicyExecutionFeeLimit, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyExecutionFeeLimitResponse, error) {
+	out := new(MsgUpdateGroupPolicyExecutionFeeLimitResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/UpdateGroupPolicyExecutionFeeLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SubmitProposal(ctx context.Context, in *MsgSubmitProposal, opts ...grpc.CallOption) (*MsgSubmitProposalResponse, error) {
 	out := new(MsgSubmitProposalResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/SubmitProposal", in, out, opts...)
@@ -1755,6 +2339,15 @@ func (c *msgClient) Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) SubmitVotesBatch(ctx context.Context, in *MsgSubmitVotesBatch, opts ...grpc.CallOption) (*MsgSubmitVotesBatchResponse, error) {
+	out := new(MsgSubmitVotesBatchResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/SubmitVotesBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error) {
 	out := new(MsgExecResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/Exec", in, out, opts...)
@@ -1773,6 +2366,15 @@ func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) CancelRecurringProposal(ctx context.Context, in *MsgCancelRecurringProposal, opts ...grpc.CallOption) (*MsgCancelRecurringProposalResponse, error) {
+	out := new(MsgCancelRecurringProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/CancelRecurringProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateGroup creates a new group with an admin account address, a list of members and some optional metadata.
@@ -1793,16 +2395,34 @@ type MsgServer interface {
 	UpdateGroupPolicyDecisionPolicy(context.Context, *MsgUpdateGroupPolicyDecisionPolicy) (*MsgUpdateGroupPolicyDecisionPolicyResponse, error)
 	// UpdateGroupPolicyMetadata updates a group policy metadata.
 	UpdateGroupPolicyMetadata(context.Context, *MsgUpdateGroupPolicyMetadata) (*MsgUpdateGroupPolicyMetadataResponse, error)
+	// UpdateGroupPolicyVoteReceipts updates whether a group policy stores vote receipts.
+	//
+	// Since: x/group 1.0.0
+	UpdateGroupPolicyVoteReceipts(context.Context, *MsgUpdateGroupPolicyVoteReceipts) (*MsgUpdateGroupPolicyVoteReceiptsResponse, error)
+	// UpdateGroupPolicyExecutionFeeLimit updates the spend limit of the fee
+	// allowances granted by a group policy to the executors of its proposals.
+	//
+	// Since: x/group 1.0.0
+	UpdateGroupPolicyExecutionFeeLimit(context.Context, *MsgUpdateGroupPolicyExecutionFeeLimit) (*MsgUpdateGroupPolicyExecutionFeeLimitResponse, error)
 	// SubmitProposal submits a new proposal.
 	SubmitProposal(context.Context, *MsgSubmitProposal) (*MsgSubmitProposalResponse, error)
 	// WithdrawProposal withdraws a proposal.
 	WithdrawProposal(context.Context, *MsgWithdrawProposal) (*MsgWithdrawProposalResponse, error)
 	// Vote allows a voter to vote on a proposal.
 	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
+	// SubmitVotesBatch submits a batch of votes signed off-chain by the group
+	// members, aggregated by a relayer paying the transaction fees.
+	//
+	// Since: x/group 1.0.0
+	SubmitVotesBatch(context.Context, *MsgSubmitVotesBatch) (*MsgSubmitVotesBatchResponse, error)
 	// Exec executes a proposal.
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error)
+	// CancelRecurringProposal stops the recurrence of a recurring proposal.
+	//
+	// Since: x/group 1.0.0
+	CancelRecurringProposal(context.Context, *MsgCancelRecurringProposal) (*MsgCancelRecurringProposalResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1836,6 +2456,12 @@ func (*UnimplementedMsgServer) UpdateGroupPolicyDecisionPolicy(ctx context.Conte
 func (*UnimplementedMsgServer) UpdateGroupPolicyMetadata(ctx context.Context, req *MsgUpdateGroupPolicyMetadata) (*MsgUpdateGroupPolicyMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupPolicyMetadata not implemented")
 }
+func (*UnimplementedMsgServer) UpdateGroupPolicyVoteReceipts(ctx context.Context, req *MsgUpdateGroupPolicyVoteReceipts) (*MsgUpdateGroupPolicyVoteReceiptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupPolicyVoteReceipts not implemented")
+}
+func (*UnimplementedMsgServer) UpdateGroupPolicyExecutionFeeLimit(ctx context.Context, req *MsgUpdateGroupPolicyExecutionFeeLimit) (*MsgUpdateGroupPolicyExecutionFeeLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupPolicyExecutionFeeLimit not implemented")
+}
 func (*UnimplementedMsgServer) SubmitProposal(ctx context.Context, req *MsgSubmitProposal) (*MsgSubmitProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitProposal not implemented")
 }
@@ -1845,12 +2471,18 @@ func (*UnimplementedMsgServer) WithdrawProposal(ctx context.Context, req *MsgWit
 func (*UnimplementedMsgServer) Vote(ctx context.Context, req *MsgVote) (*MsgVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
 }
+func (*UnimplementedMsgServer) SubmitVotesBatch(ctx context.Context, req *MsgSubmitVotesBatch) (*MsgSubmitVotesBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitVotesBatch not implemented")
+}
 func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Exec not implemented")
 }
 func (*UnimplementedMsgServer) LeaveGroup(ctx context.Context, req *MsgLeaveGroup) (*MsgLeaveGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
 }
+func (*UnimplementedMsgServer) CancelRecurringProposal(ctx context.Context, req *MsgCancelRecurringProposal) (*MsgCancelRecurringProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRecurringProposal not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2018,49 +2650,85 @@ func _Msg_UpdateGroupPolicyMetadata_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_SubmitProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitProposal)
+func _Msg_UpdateGroupPolicyVoteReceipts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGroupPolicyVoteReceipts)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).SubmitProposal(ctx, in)
+		return srv.(MsgServer).UpdateGroupPolicyVoteReceipts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cosmos.group.v1.Msg/SubmitProposal",
+		FullMethod: "/cosmos.group.v1.Msg/UpdateGroupPolicyVoteReceipts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitProposal(ctx, req.(*MsgSubmitProposal))
+		return srv.(MsgServer).UpdateGroupPolicyVoteReceipts(ctx, req.(*MsgUpdateGroupPolicyVoteReceipts))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_WithdrawProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawProposal)
+func _Msg_UpdateGroupPolicyExecutionFeeLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGroupPolicyExecutionFeeLimit)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).WithdrawProposal(ctx, in)
+		return srv.(MsgServer).UpdateGroupPolicyExecutionFeeLimit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cosmos.group.v1.Msg/WithdrawProposal",
+		FullMethod: "/cosmos.group.v1.Msg/UpdateGroupPolicyExecutionFeeLimit",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawProposal(ctx, req.(*MsgWithdrawProposal))
+		return srv.(MsgServer).UpdateGroupPolicyExecutionFeeLimit(ctx, req.(*MsgUpdateGroupPolicyExecutionFeeLimit))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgVote)
+func _Msg_SubmitProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitProposal)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).Vote(ctx, in)
+		return srv.(MsgServer).SubmitProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/SubmitProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitProposal(ctx, req.(*MsgSubmitProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/WithdrawProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawProposal(ctx, req.(*MsgWithdrawProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Vote(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
@@ -2072,6 +2740,24 @@ func _Msg_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitVotesBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitVotesBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitVotesBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/SubmitVotesBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitVotesBatch(ctx, req.(*MsgSubmitVotesBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgExec)
 	if err := dec(in); err != nil {
@@ -2108,6 +2794,24 @@ func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelRecurringProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRecurringProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRecurringProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/CancelRecurringProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRecurringProposal(ctx, req.(*MsgCancelRecurringProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.group.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2148,6 +2852,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateGroupPolicyMetadata",
 			Handler:    _Msg_UpdateGroupPolicyMetadata_Handler,
 		},
+		{
+			MethodName: "UpdateGroupPolicyVoteReceipts",
+			Handler:    _Msg_UpdateGroupPolicyVoteReceipts_Handler,
+		},
+		{
+			MethodName: "UpdateGroupPolicyExecutionFeeLimit",
+			Handler:    _Msg_UpdateGroupPolicyExecutionFeeLimit_Handler,
+		},
 		{
 			MethodName: "SubmitProposal",
 			Handler:    _Msg_SubmitProposal_Handler,
@@ -2160,6 +2872,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Vote",
 			Handler:    _Msg_Vote_Handler,
 		},
+		{
+			MethodName: "SubmitVotesBatch",
+			Handler:    _Msg_SubmitVotesBatch_Handler,
+		},
 		{
 			MethodName: "Exec",
 			Handler:    _Msg_Exec_Handler,
@@ -2168,6 +2884,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LeaveGroup",
 			Handler:    _Msg_LeaveGroup_Handler,
 		},
+		{
+			MethodName: "CancelRecurringProposal",
+			Handler:    _Msg_CancelRecurringProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/tx.proto",
@@ -2859,6 +3579,150 @@ func (m *MsgUpdateGroupPolicyMetadataResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateGroupPolicyVoteReceipts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupPolicyVoteReceipts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupPolicyVoteReceipts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StoreVoteReceipts {
+		i--
+		if m.StoreVoteReceipts {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.GroupPolicyAddress) > 0 {
+		i -= len(m.GroupPolicyAddress)
+		copy(dAtA[i:], m.GroupPolicyAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupPolicyAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGroupPolicyVoteReceiptsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupPolicyVoteReceiptsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupPolicyVoteReceiptsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGroupPolicyExecutionFeeLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupPolicyExecutionFeeLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupPolicyExecutionFeeLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExecutionFeeLimit) > 0 {
+		for iNdEx := len(m.ExecutionFeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutionFeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.GroupPolicyAddress) > 0 {
+		i -= len(m.GroupPolicyAddress)
+		copy(dAtA[i:], m.GroupPolicyAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupPolicyAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGroupPolicyExecutionFeeLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupPolicyExecutionFeeLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupPolicyExecutionFeeLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgSubmitProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2879,6 +3743,11 @@ func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RecurrenceEpochs != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RecurrenceEpochs))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.Summary) > 0 {
 		i -= len(m.Summary)
 		copy(dAtA[i:], m.Summary)
@@ -3099,7 +3968,7 @@ func (m *MsgVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgExec) Marshal() (dAtA []byte, err error) {
+func (m *SignedVote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3109,20 +3978,51 @@ func (m *MsgExec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgExec) MarshalTo(dAtA []byte) (int, error) {
+func (m *SignedVote) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SignedVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Executor) > 0 {
-		i -= len(m.Executor)
-		copy(dAtA[i:], m.Executor)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Executor)))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PubKey != nil {
+		{
+			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Option != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Voter)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -3134,7 +4034,7 @@ func (m *MsgExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgExecResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitVotesBatch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3144,25 +4044,41 @@ func (m *MsgExecResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgExecResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitVotesBatch) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitVotesBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Result != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Result))
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Relayer)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgLeaveGroup) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitVotesBatchResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3172,17 +4088,103 @@ func (m *MsgLeaveGroup) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgLeaveGroup) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitVotesBatchResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgLeaveGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitVotesBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.GroupId != 0 {
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x10
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLeaveGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLeaveGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLeaveGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GroupId != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.GroupId))
 		i--
 		dAtA[i] = 0x10
@@ -3220,6 +4222,64 @@ func (m *MsgLeaveGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelRecurringProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRecurringProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRecurringProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRecurringProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRecurringProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRecurringProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -3532,6 +4592,67 @@ func (m *MsgUpdateGroupPolicyMetadataResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateGroupPolicyVoteReceipts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupPolicyAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StoreVoteReceipts {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateGroupPolicyVoteReceiptsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateGroupPolicyExecutionFeeLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupPolicyAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ExecutionFeeLimit) > 0 {
+		for _, e := range m.ExecutionFeeLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateGroupPolicyExecutionFeeLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgSubmitProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3569,6 +4690,9 @@ func (m *MsgSubmitProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.RecurrenceEpochs != 0 {
+		n += 1 + sovTx(uint64(m.RecurrenceEpochs))
+	}
 	return n
 }
 
@@ -3644,6 +4768,65 @@ func (m *MsgVoteResponse) Size() (n int) {
 	return n
 }
 
+func (m *SignedVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Option != 0 {
+		n += 1 + sovTx(uint64(m.Option))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitVotesBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitVotesBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgExec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3697,18 +4880,43 @@ func (m *MsgLeaveGroupResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+func (m *MsgCancelRecurringProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelRecurringProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowTx
@@ -5651,7 +6859,7 @@ func (m *MsgUpdateGroupPolicyMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateGroupPolicyVoteReceipts) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5674,15 +6882,15 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateGroupPolicyVoteReceipts: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateGroupPolicyVoteReceipts: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5710,11 +6918,11 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5742,13 +6950,13 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposers = append(m.Proposers, string(dAtA[iNdEx:postIndex]))
+			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreVoteReceipts", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5758,29 +6966,117 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.StoreVoteReceipts = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGroupPolicyVoteReceiptsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGroupPolicyVoteReceiptsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGroupPolicyVoteReceiptsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGroupPolicyExecutionFeeLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGroupPolicyExecutionFeeLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGroupPolicyExecutionFeeLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5790,48 +7086,27 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, &types.Any{})
-			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
-			}
-			m.Exec = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Exec |= Exec(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5859,13 +7134,13 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionFeeLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5875,23 +7150,25 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Summary = string(dAtA[iNdEx:postIndex])
+			m.ExecutionFeeLimit = append(m.ExecutionFeeLimit, types1.Coin{})
+			if err := m.ExecutionFeeLimit[len(m.ExecutionFeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5914,7 +7191,7 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateGroupPolicyExecutionFeeLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5937,31 +7214,12 @@ func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateGroupPolicyExecutionFeeLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateGroupPolicyExecutionFeeLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5983,7 +7241,7 @@ func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6006,17 +7264,962 @@ func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposers = append(m.Proposers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
+			}
+			m.Exec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exec |= Exec(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurrenceEpochs", wireType)
+			}
+			m.RecurrenceEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecurrenceEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
+			}
+			m.Exec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exec |= Exec(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignedVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignedVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignedVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &types.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitVotesBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitVotesBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitVotesBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
 			}
-			m.ProposalId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6026,16 +8229,29 @@ func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6045,23 +8261,25 @@ func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Votes = append(m.Votes, SignedVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6084,7 +8302,7 @@ func (m *MsgWithdrawProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitVotesBatchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6107,10 +8325,10 @@ func (m *MsgWithdrawProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitVotesBatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitVotesBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6134,7 +8352,7 @@ func (m *MsgWithdrawProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVote) Unmarshal(dAtA []byte) error {
+func (m *MsgExec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6157,10 +8375,10 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6184,58 +8402,7 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
-			}
-			m.Option = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Option |= VoteOption(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6263,27 +8430,8 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.Executor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
-			}
-			m.Exec = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Exec |= Exec(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6305,7 +8453,7 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6328,12 +8476,31 @@ func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ProposalExecutorResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6355,7 +8522,7 @@ func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExec) Unmarshal(dAtA []byte) error {
+func (m *MsgLeaveGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6378,34 +8545,15 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExec: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLeaveGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLeaveGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6433,8 +8581,27 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Executor = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6456,7 +8623,7 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLeaveGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6479,31 +8646,12 @@ func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLeaveGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLeaveGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			m.Result = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Result |= ProposalExecutorResult(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6525,7 +8673,7 @@ func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLeaveGroup) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelRecurringProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6548,13 +8696,32 @@ func (m *MsgLeaveGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLeaveGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelRecurringProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLeaveGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelRecurringProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
@@ -6586,25 +8753,6 @@ func (m *MsgLeaveGroup) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
-			}
-			m.GroupId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GroupId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6626,7 +8774,7 @@ func (m *MsgLeaveGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLeaveGroupResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelRecurringProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6649,10 +8797,10 @@ func (m *MsgLeaveGroupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLeaveGroupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelRecurringProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLeaveGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelRecurringProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -324,6 +324,10 @@ func (g Proposal) PrimaryKeyFields() []interface{} {
 	return []interface{}{g.Id}
 }
 
+func (g RecurringProposal) PrimaryKeyFields() []interface{} {
+	return []interface{}{g.ProposalId}
+}
+
 // ValidateBasic does basic validation on group policy info.
 func (g GroupPolicyInfo) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(g.Admin)
@@ -349,6 +353,9 @@ func (g GroupPolicyInfo) ValidateBasic() error {
 	if err := policy.ValidateBasic(); err != nil {
 		return errorsmod.Wrap(err, "group policy's decision policy")
 	}
+	if err := g.ExecutionFeeLimit.Validate(); err != nil {
+		return errorsmod.Wrap(err, "group policy execution fee limit")
+	}
 	return nil
 }
 
@@ -418,6 +425,31 @@ func (g Proposal) ValidateBasic() error {
 	if err != nil {
 		return errorsmod.Wrap(err, "proposal FinalTallyResult veto count")
 	}
+	for _, grantee := range g.ExecutionFeeGrantees {
+		if _, err := sdk.AccAddressFromBech32(grantee); err != nil {
+			return errorsmod.Wrap(err, "proposal execution fee grantee")
+		}
+	}
+	return nil
+}
+
+var _ orm.Validateable = RecurringProposal{}
+
+// ValidateBasic does basic validation on recurring proposal.
+func (g RecurringProposal) ValidateBasic() error {
+	if g.ProposalId == 0 {
+		return errorsmod.Wrap(errors.ErrEmpty, "recurring proposal id")
+	}
+	_, err := sdk.AccAddressFromBech32(g.GroupPolicyAddress)
+	if err != nil {
+		return errorsmod.Wrap(err, "recurring proposal group policy address")
+	}
+	if g.RecurrenceEpochs == 0 {
+		return errorsmod.Wrap(errors.ErrEmpty, "recurring proposal recurrence epochs")
+	}
+	if len(g.Messages) == 0 {
+		return errorsmod.Wrap(errors.ErrEmpty, "recurring proposal messages")
+	}
 	return nil
 }
 
@@ -447,6 +479,25 @@ func (v Vote) ValidateBasic() error {
 	return nil
 }
 
+func (v VoteReceipt) PrimaryKeyFields() []interface{} {
+	addr := sdk.MustAccAddressFromBech32(v.Voter)
+
+	return []interface{}{v.ProposalId, addr.Bytes()}
+}
+
+var _ orm.Validateable = VoteReceipt{}
+
+// ValidateBasic does basic validation on vote receipt.
+func (v VoteReceipt) ValidateBasic() error {
+	if err := (Vote{ProposalId: v.ProposalId, Voter: v.Voter, Option: v.Option}).ValidateBasic(); err != nil {
+		return err
+	}
+	if _, err := math.NewNonNegativeDecFromString(v.Weight); err != nil {
+		return errorsmod.Wrap(err, "vote receipt weight")
+	}
+	return nil
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (q QueryGroupPoliciesByGroupResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpackGroupPolicies(unpacker, q.GroupPolicies)
@@ -7,6 +7,8 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -653,6 +655,20 @@ type GroupPolicyInfo struct {
 	DecisionPolicy *types.Any `protobuf:"bytes,6,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy,omitempty"`
 	// created_at is a timestamp specifying when a group policy was created.
 	CreatedAt time.Time `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	// store_vote_receipts defines whether the votes on the proposals of the group
+	// policy are kept as vote receipts once the proposals are finally tallied,
+	// instead of being pruned.
+	//
+	// Since: x/group 1.0.0
+	StoreVoteReceipts bool `protobuf:"varint,8,opt,name=store_vote_receipts,json=storeVoteReceipts,proto3" json:"store_vote_receipts,omitempty"`
+	// execution_fee_limit, when set, is the spend limit of the fee allowances
+	// granted by the group policy account to the group members once a proposal
+	// is accepted, so that the members executing it don't pay its fees out of
+	// pocket. Each proposal gets its own allowances, which are revoked once the
+	// proposal is closed.
+	//
+	// Since: x/group 1.0.0
+	ExecutionFeeLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=execution_fee_limit,json=executionFeeLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"execution_fee_limit"`
 }
 
 func (m *GroupPolicyInfo) Reset()         { *m = GroupPolicyInfo{} }
@@ -738,6 +754,18 @@ type Proposal struct {
 	//
 	// Since: cosmos-sdk 0.47
 	Summary string `protobuf:"bytes,14,opt,name=summary,proto3" json:"summary,omitempty"`
+	// recurrence_epochs, when non zero, flags the proposal as recurring: once
+	// executed, its messages are executed again every `recurrence_epochs` epochs
+	// until the recurrence is cancelled.
+	//
+	// Since: x/group 1.0.0
+	RecurrenceEpochs uint64 `protobuf:"varint,15,opt,name=recurrence_epochs,json=recurrenceEpochs,proto3" json:"recurrence_epochs,omitempty"`
+	// execution_fee_grantees are the account addresses of the group members
+	// holding a fee allowance for the execution of the proposal, granted by the
+	// group policy account once the proposal was accepted.
+	//
+	// Since: x/group 1.0.0
+	ExecutionFeeGrantees []string `protobuf:"bytes,16,rep,name=execution_fee_grantees,json=executionFeeGrantees,proto3" json:"execution_fee_grantees,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -773,6 +801,65 @@ func (m *Proposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Proposal proto.InternalMessageInfo
 
+// RecurringProposal defines the recurrence of an executed recurring proposal.
+// Its messages are executed on behalf of the group policy every
+// `recurrence_epochs` epochs, without any new vote, until cancelled.
+//
+// Since: x/group 1.0.0
+type RecurringProposal struct {
+	// proposal_id is the unique id of the proposal the recurrence originates from.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// group_policy_address is the account address of group policy.
+	GroupPolicyAddress string `protobuf:"bytes,2,opt,name=group_policy_address,json=groupPolicyAddress,proto3" json:"group_policy_address,omitempty"`
+	// messages is the list of `sdk.Msg`s executed at each recurrence.
+	Messages []*types.Any `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages,omitempty"`
+	// recurrence_epochs is the number of epochs between two executions.
+	RecurrenceEpochs uint64 `protobuf:"varint,4,opt,name=recurrence_epochs,json=recurrenceEpochs,proto3" json:"recurrence_epochs,omitempty"`
+	// next_execution_time is the timestamp after which the messages are executed again.
+	NextExecutionTime time.Time `protobuf:"bytes,5,opt,name=next_execution_time,json=nextExecutionTime,proto3,stdtime" json:"next_execution_time"`
+	// executions is the number of times the messages have been executed by the recurrence.
+	Executions uint64 `protobuf:"varint,6,opt,name=executions,proto3" json:"executions,omitempty"`
+	// group_version is the version of the group when the proposal was executed. The recurrence is cancelled once the
+	// group is updated.
+	GroupVersion uint64 `protobuf:"varint,7,opt,name=group_version,json=groupVersion,proto3" json:"group_version,omitempty"`
+	// group_policy_version is the version of the group policy when the proposal was executed. The recurrence is
+	// cancelled once the group policy is updated.
+	GroupPolicyVersion uint64 `protobuf:"varint,8,opt,name=group_policy_version,json=groupPolicyVersion,proto3" json:"group_policy_version,omitempty"`
+}
+
+func (m *RecurringProposal) Reset()         { *m = RecurringProposal{} }
+func (m *RecurringProposal) String() string { return proto.CompactTextString(m) }
+func (*RecurringProposal) ProtoMessage()    {}
+func (*RecurringProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
+}
+func (m *RecurringProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecurringProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecurringProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecurringProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecurringProposal.Merge(m, src)
+}
+func (m *RecurringProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecurringProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecurringProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecurringProposal proto.InternalMessageInfo
+
 // TallyResult represents the sum of weighted votes for each vote option.
 type TallyResult struct {
 	// yes_count is the weighted sum of yes votes.
@@ -789,7 +876,7 @@ func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -837,7 +924,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{11}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -901,6 +988,102 @@ func (m *Vote) GetSubmitTime() time.Time {
 	return time.Time{}
 }
 
+// VoteReceipt is the record of a vote kept after the final tally of a proposal
+// whose group policy stores vote receipts.
+//
+// Since: x/group 1.0.0
+type VoteReceipt struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// voter is the account address of the voter.
+	Voter string `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	// option is the voter's choice on the proposal.
+	Option VoteOption `protobuf:"varint,3,opt,name=option,proto3,enum=cosmos.group.v1.VoteOption" json:"option,omitempty"`
+	// metadata is any arbitrary metadata attached to the vote.
+	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// submit_time is the timestamp when the vote was submitted.
+	SubmitTime time.Time `protobuf:"bytes,5,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time"`
+	// weight is the voter's weight in the group when the proposal was finally tallied.
+	// It is zero when the voter left the group before the final tally, in which
+	// case the vote wasn't counted.
+	Weight string `protobuf:"bytes,6,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *VoteReceipt) Reset()         { *m = VoteReceipt{} }
+func (m *VoteReceipt) String() string { return proto.CompactTextString(m) }
+func (*VoteReceipt) ProtoMessage()    {}
+func (*VoteReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{12}
+}
+func (m *VoteReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteReceipt.Merge(m, src)
+}
+func (m *VoteReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteReceipt proto.InternalMessageInfo
+
+func (m *VoteReceipt) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *VoteReceipt) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *VoteReceipt) GetOption() VoteOption {
+	if m != nil {
+		return m.Option
+	}
+	return VOTE_OPTION_UNSPECIFIED
+}
+
+func (m *VoteReceipt) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+func (m *VoteReceipt) GetSubmitTime() time.Time {
+	if m != nil {
+		return m.SubmitTime
+	}
+	return time.Time{}
+}
+
+func (m *VoteReceipt) GetWeight() string {
+	if m != nil {
+		return m.Weight
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.group.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.group.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
@@ -914,99 +1097,118 @@ func init() {
 	proto.RegisterType((*GroupMember)(nil), "cosmos.group.v1.GroupMember")
 	proto.RegisterType((*GroupPolicyInfo)(nil), "cosmos.group.v1.GroupPolicyInfo")
 	proto.RegisterType((*Proposal)(nil), "cosmos.group.v1.Proposal")
+	proto.RegisterType((*RecurringProposal)(nil), "cosmos.group.v1.RecurringProposal")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.group.v1.TallyResult")
 	proto.RegisterType((*Vote)(nil), "cosmos.group.v1.Vote")
+	proto.RegisterType((*VoteReceipt)(nil), "cosmos.group.v1.VoteReceipt")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/types.proto", fileDescriptor_f5bddd15d7a54a9d) }
 
 var fileDescriptor_f5bddd15d7a54a9d = []byte{
-	// 1358 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xf6, 0xda, 0x8e, 0x3f, 0x5e, 0x27, 0xb6, 0x3b, 0xcd, 0xaf, 0xd9, 0x24, 0xfd, 0xd9, 0xc1,
-	0xad, 0x20, 0x0a, 0xaa, 0xdd, 0xa6, 0x12, 0x48, 0x3d, 0x61, 0x3b, 0x5b, 0xea, 0xa8, 0xb5, 0xad,
-	0xf5, 0x3a, 0xa1, 0xbd, 0xac, 0x36, 0xde, 0xa9, 0xb3, 0xaa, 0xbd, 0x63, 0x76, 0xc7, 0x49, 0xfd,
-	0x1f, 0x54, 0x5c, 0xe8, 0x91, 0x0b, 0x52, 0x25, 0x2e, 0x1c, 0x7b, 0xa8, 0x38, 0x70, 0x44, 0x1c,
-	0x2a, 0x0e, 0xa8, 0xe2, 0xc4, 0x09, 0x50, 0x73, 0x28, 0x27, 0x4e, 0x5c, 0x41, 0x68, 0x67, 0x66,
-	0x1d, 0x7f, 0x24, 0x2e, 0xa9, 0x2a, 0x2e, 0x51, 0x66, 0x9e, 0xe7, 0x7d, 0xe7, 0x7d, 0xde, 0xaf,
-	0x4d, 0x60, 0xb5, 0x45, 0xdc, 0x2e, 0x71, 0x0b, 0x6d, 0x87, 0xf4, 0x7b, 0x85, 0x83, 0x6b, 0x05,
-	0x3a, 0xe8, 0x61, 0x37, 0xdf, 0x73, 0x08, 0x25, 0x28, 0xc5, 0xc1, 0x3c, 0x03, 0xf3, 0x07, 0xd7,
-	0x56, 0x16, 0xdb, 0xa4, 0x4d, 0x18, 0x56, 0xf0, 0x7e, 0xe3, 0xb4, 0x95, 0x4c, 0x9b, 0x90, 0x76,
-	0x07, 0x17, 0xd8, 0x69, 0xaf, 0x7f, 0xbf, 0x60, 0xf6, 0x1d, 0x83, 0x5a, 0xc4, 0x16, 0x78, 0x76,
-	0x12, 0xa7, 0x56, 0x17, 0xbb, 0xd4, 0xe8, 0xf6, 0x04, 0x61, 0x99, 0xbf, 0xa3, 0x73, 0xcf, 0xe2,
-	0x51, 0x01, 0x4d, 0xda, 0x1a, 0xf6, 0x40, 0x40, 0xe7, 0x8c, 0xae, 0x65, 0x93, 0x02, 0xfb, 0xc9,
-	0xaf, 0x72, 0xdf, 0x48, 0x10, 0xb9, 0x83, 0xbb, 0x7b, 0xd8, 0x41, 0x9b, 0x10, 0x35, 0x4c, 0xd3,
-	0xc1, 0xae, 0x2b, 0x4b, 0x6b, 0xd2, 0x7a, 0xbc, 0x24, 0xff, 0xf4, 0xec, 0xca, 0xa2, 0xf0, 0x5d,
-	0xe4, 0x48, 0x83, 0x3a, 0x96, 0xdd, 0x56, 0x7d, 0x22, 0xba, 0x00, 0x91, 0x43, 0x6c, 0xb5, 0xf7,
-	0xa9, 0x1c, 0xf4, 0x4c, 0x54, 0x71, 0x42, 0x2b, 0x10, 0xeb, 0x62, 0x6a, 0x98, 0x06, 0x35, 0xe4,
-	0x10, 0x43, 0x86, 0x67, 0xb4, 0x05, 0x31, 0xc3, 0x34, 0xb1, 0xa9, 0x1b, 0x54, 0x0e, 0xaf, 0x49,
-	0xeb, 0x89, 0xcd, 0x95, 0x3c, 0x8f, 0x39, 0xef, 0xc7, 0x9c, 0xd7, 0x7c, 0xbd, 0xa5, 0x85, 0xe7,
-	0xbf, 0x64, 0x03, 0x8f, 0x7f, 0xcd, 0x4a, 0x5f, 0xbf, 0x7a, 0xba, 0x21, 0xb1, 0x97, 0xb1, 0x59,
-	0xa4, 0xb9, 0x43, 0x58, 0xe0, 0x71, 0xab, 0xf8, 0xd3, 0x3e, 0x76, 0xe9, 0x7f, 0x15, 0x7e, 0xee,
-	0x7b, 0x09, 0x96, 0xb4, 0x7d, 0x07, 0xbb, 0xfb, 0xa4, 0x63, 0x6e, 0xe1, 0x96, 0xe5, 0x5a, 0xc4,
-	0xae, 0x93, 0x8e, 0xd5, 0x1a, 0xa0, 0x8b, 0x10, 0xa7, 0x3e, 0xc4, 0xa3, 0x50, 0x8f, 0x2f, 0xd0,
-	0x47, 0x10, 0x3d, 0xb4, 0x6c, 0x93, 0x1c, 0xba, 0xec, 0xb9, 0xc4, 0xe6, 0xbb, 0xf9, 0x89, 0x76,
-	0xc9, 0x8f, 0xfb, 0xdb, 0xe5, 0x6c, 0xd5, 0x37, 0xbb, 0x51, 0xf9, 0xe1, 0xd9, 0x95, 0xcc, 0x6c,
-	0x9b, 0xcf, 0x5e, 0x3d, 0xdd, 0xc8, 0x71, 0xca, 0x15, 0xd7, 0x7c, 0x50, 0x38, 0x25, 0xd4, 0xdc,
-	0x73, 0x09, 0xe4, 0x3a, 0x76, 0x5a, 0xd8, 0xa6, 0x46, 0x1b, 0x4f, 0xe8, 0xc8, 0x00, 0xf4, 0x86,
-	0x98, 0x10, 0x32, 0x72, 0xf3, 0x16, 0x94, 0x6c, 0xff, 0x3b, 0x25, 0x97, 0x46, 0x94, 0x9c, 0x16,
-	0x6d, 0xee, 0x3b, 0x09, 0xfe, 0x77, 0xe2, 0x73, 0xe8, 0x0e, 0x2c, 0x1c, 0x10, 0x6a, 0xd9, 0x6d,
-	0xbd, 0x87, 0x1d, 0x8b, 0xf0, 0x9a, 0x24, 0x36, 0x97, 0xa7, 0xfa, 0x6d, 0x4b, 0xcc, 0x1f, 0x6f,
-	0xb7, 0x2f, 0x86, 0xed, 0x36, 0xcf, 0xcd, 0xeb, 0xcc, 0x1a, 0xdd, 0x83, 0xc5, 0xae, 0x65, 0xeb,
-	0xf8, 0x21, 0x6e, 0xf5, 0x3d, 0xb6, 0xef, 0x35, 0x78, 0x46, 0xaf, 0xa8, 0x6b, 0xd9, 0x8a, 0xef,
-	0x84, 0xfb, 0xce, 0xfd, 0x21, 0x41, 0xfc, 0x63, 0x2f, 0x11, 0x15, 0xfb, 0x3e, 0x41, 0x49, 0x08,
-	0x5a, 0x3c, 0xda, 0xb0, 0x1a, 0xb4, 0x4c, 0x94, 0x87, 0x39, 0xc3, 0xec, 0x5a, 0x36, 0xef, 0xd3,
-	0x19, 0xad, 0xcd, 0x69, 0x33, 0xe7, 0x4f, 0x86, 0xe8, 0x01, 0x76, 0xbc, 0x64, 0xb1, 0xf1, 0x0b,
-	0xab, 0xfe, 0x11, 0xbd, 0x03, 0xf3, 0x94, 0x50, 0xa3, 0xa3, 0x8b, 0xa1, 0x98, 0x63, 0x96, 0x09,
-	0x76, 0xb7, 0xcb, 0x27, 0xe3, 0x16, 0x40, 0xcb, 0xc1, 0x06, 0xe5, 0xe3, 0x1b, 0x39, 0xeb, 0xf8,
-	0xc6, 0x85, 0x71, 0x91, 0xe6, 0xee, 0x42, 0x82, 0xe9, 0x15, 0xdb, 0x67, 0x19, 0x62, 0xac, 0x0f,
-	0xf4, 0xa1, 0xee, 0x28, 0x3b, 0x57, 0x4c, 0x54, 0x80, 0x48, 0x97, 0x91, 0x44, 0xa2, 0x97, 0xa6,
-	0x9a, 0x4d, 0x6c, 0x02, 0x41, 0xcb, 0xfd, 0x15, 0x84, 0x14, 0xf3, 0xcd, 0xbb, 0x81, 0x65, 0xf4,
-	0x4d, 0xd6, 0xc3, 0x68, 0x4c, 0xc1, 0xf1, 0x98, 0x86, 0x05, 0x09, 0x9d, 0xbd, 0x20, 0xe1, 0xd3,
-	0x0b, 0x32, 0x37, 0x5e, 0x10, 0x03, 0x52, 0xa6, 0x68, 0x6c, 0xbd, 0xc7, 0xb4, 0x88, 0x94, 0x2f,
-	0x4e, 0xa5, 0xbc, 0x68, 0x0f, 0x4a, 0xb9, 0xd7, 0x0f, 0x95, 0x9a, 0x34, 0xc7, 0x47, 0x7d, 0xbc,
-	0xa0, 0xd1, 0x37, 0x2f, 0xe8, 0x8d, 0xd8, 0xa3, 0x27, 0xd9, 0xc0, 0xef, 0x4f, 0xb2, 0x52, 0xee,
-	0xef, 0x39, 0x88, 0xd5, 0x1d, 0xd2, 0x23, 0xae, 0xd1, 0x99, 0x6a, 0xe5, 0x6d, 0x58, 0xe4, 0x49,
-	0xe5, 0x82, 0x74, 0xbf, 0x2a, 0xaf, 0xeb, 0x6c, 0xd4, 0x3e, 0xae, 0xa8, 0x40, 0x66, 0xb6, 0xf9,
-	0x07, 0x10, 0xef, 0xb1, 0x18, 0xb0, 0xe3, 0xca, 0xe1, 0xb5, 0xd0, 0x4c, 0xe7, 0xc7, 0x54, 0xb4,
-	0x0d, 0x09, 0xb7, 0xbf, 0xd7, 0xb5, 0xa8, 0xee, 0x7d, 0x74, 0x59, 0x45, 0xce, 0x94, 0x11, 0xe0,
-	0xd6, 0x1e, 0x8e, 0x2e, 0xc1, 0x02, 0xd7, 0xea, 0xd7, 0x37, 0xc2, 0xd2, 0x30, 0xcf, 0x2e, 0x77,
-	0x44, 0x91, 0xaf, 0x4e, 0x24, 0xc4, 0xe7, 0x46, 0x19, 0x77, 0x54, 0xb6, 0x6f, 0xf1, 0x21, 0x44,
-	0x5c, 0x6a, 0xd0, 0xbe, 0x2b, 0xc7, 0xd6, 0xa4, 0xf5, 0xe4, 0x66, 0x76, 0x6a, 0x20, 0xfc, 0xec,
-	0x37, 0x18, 0x4d, 0x15, 0x74, 0xd4, 0x04, 0x74, 0xdf, 0xb2, 0x8d, 0x8e, 0x4e, 0x8d, 0x4e, 0x67,
-	0xa0, 0x3b, 0xd8, 0xed, 0x77, 0xa8, 0x1c, 0x67, 0x12, 0x2f, 0x4e, 0x39, 0xd1, 0x3c, 0x92, 0xca,
-	0x38, 0xa5, 0xb8, 0x27, 0x92, 0x0b, 0x4c, 0x33, 0x17, 0x23, 0x20, 0x6a, 0xc2, 0xb9, 0xb1, 0x35,
-	0xab, 0x63, 0xdb, 0x94, 0xe1, 0xac, 0x89, 0x4b, 0x8d, 0xee, 0x5a, 0xc5, 0x36, 0x51, 0x1d, 0x52,
-	0x7c, 0xd5, 0x12, 0xc7, 0x0f, 0x35, 0xc1, 0xf4, 0xbe, 0x77, 0xaa, 0x5e, 0x45, 0xf0, 0x79, 0x60,
-	0x6a, 0x12, 0x8f, 0x9d, 0xd1, 0x55, 0xaf, 0x5f, 0x5c, 0xd7, 0x68, 0x63, 0x57, 0x9e, 0x5f, 0x0b,
-	0x9d, 0x36, 0x48, 0xea, 0x90, 0x85, 0x16, 0x61, 0x8e, 0x5a, 0xb4, 0x83, 0xe5, 0x05, 0xd6, 0x5e,
-	0xfc, 0xe0, 0x4d, 0xac, 0xdb, 0xef, 0x76, 0x0d, 0x67, 0x20, 0x27, 0xd9, 0xbd, 0x7f, 0xbc, 0x11,
-	0xf6, 0x86, 0x20, 0xf7, 0xa5, 0x04, 0x89, 0xd1, 0x04, 0xad, 0x42, 0x7c, 0x80, 0x5d, 0xbd, 0x45,
-	0xfa, 0x36, 0x15, 0x9f, 0xd3, 0xd8, 0x00, 0xbb, 0x65, 0xef, 0xec, 0x35, 0x89, 0xb1, 0xe7, 0x52,
-	0xc3, 0xb2, 0x05, 0x81, 0xff, 0x2d, 0x32, 0x2f, 0x2e, 0x39, 0x69, 0x19, 0x62, 0x36, 0x11, 0x38,
-	0xef, 0xf4, 0xa8, 0x4d, 0x38, 0xf4, 0x3e, 0x20, 0x9b, 0xe8, 0x87, 0x16, 0xdd, 0xd7, 0x0f, 0x30,
-	0xf5, 0x49, 0x7c, 0xc9, 0xa4, 0x6c, 0xb2, 0x6b, 0xd1, 0xfd, 0x1d, 0x4c, 0x39, 0x59, 0xc4, 0xf7,
-	0xa7, 0x04, 0xe1, 0x1d, 0x42, 0x31, 0xca, 0x42, 0xa2, 0x27, 0x52, 0x77, 0xbc, 0x78, 0xc1, 0xbf,
-	0xe2, 0x7b, 0xee, 0x80, 0x50, 0xb1, 0x7a, 0x67, 0xee, 0x39, 0x46, 0x43, 0xd7, 0x21, 0x42, 0x7a,
-	0xde, 0x67, 0x8d, 0x45, 0x99, 0xdc, 0x5c, 0x9d, 0x2a, 0x95, 0xf7, 0x6e, 0x8d, 0x51, 0x54, 0x41,
-	0x9d, 0xb9, 0x1c, 0xdf, 0xe2, 0x38, 0x6e, 0x7c, 0x2e, 0x01, 0x1c, 0x3f, 0x8f, 0x56, 0x61, 0x69,
-	0xa7, 0xa6, 0x29, 0x7a, 0xad, 0xae, 0x55, 0x6a, 0x55, 0xbd, 0x59, 0x6d, 0xd4, 0x95, 0x72, 0xe5,
-	0x66, 0x45, 0xd9, 0x4a, 0x07, 0xd0, 0x79, 0x48, 0x8d, 0x82, 0x77, 0x95, 0x46, 0x5a, 0x42, 0x4b,
-	0x70, 0x7e, 0xf4, 0xb2, 0x58, 0x6a, 0x68, 0xc5, 0x4a, 0x35, 0x1d, 0x44, 0x08, 0x92, 0xa3, 0x40,
-	0xb5, 0x96, 0x0e, 0xa1, 0x8b, 0x20, 0x8f, 0xdf, 0xe9, 0xbb, 0x15, 0xed, 0x96, 0xbe, 0xa3, 0x68,
-	0xb5, 0x74, 0x78, 0x25, 0xfc, 0xe8, 0xab, 0x4c, 0x60, 0xe3, 0x47, 0x09, 0x92, 0xe3, 0xb3, 0x8a,
-	0xb2, 0xb0, 0x5a, 0x57, 0x6b, 0xf5, 0x5a, 0xa3, 0x78, 0x5b, 0x6f, 0x68, 0x45, 0xad, 0xd9, 0x98,
-	0x88, 0xec, 0xff, 0xb0, 0x3c, 0x49, 0x68, 0x34, 0x4b, 0x77, 0x2a, 0x9a, 0xa6, 0x6c, 0xa5, 0x25,
-	0xef, 0xd9, 0x49, 0xb8, 0x58, 0x2e, 0x2b, 0x75, 0x0f, 0x0d, 0x9e, 0x84, 0xaa, 0xca, 0xb6, 0x52,
-	0xf6, 0xd0, 0x90, 0x97, 0x91, 0x29, 0xdb, 0x52, 0x4d, 0xf5, 0xc0, 0xf0, 0x49, 0xef, 0x7a, 0x82,
-	0xb6, 0xd4, 0xe2, 0x6e, 0x35, 0x3d, 0x27, 0x04, 0x7d, 0x2b, 0xc1, 0x85, 0x93, 0x87, 0x11, 0xad,
-	0xc3, 0xe5, 0xa1, 0xbd, 0xf2, 0x89, 0x52, 0x6e, 0x6a, 0x35, 0x55, 0x57, 0x95, 0x46, 0xf3, 0xb6,
-	0x36, 0xa1, 0xf0, 0x32, 0xac, 0x9d, 0xca, 0xac, 0xd6, 0x34, 0x5d, 0x6d, 0x56, 0xd3, 0xd2, 0x4c,
-	0x56, 0xa3, 0x59, 0x2e, 0x2b, 0x8d, 0x46, 0x3a, 0x38, 0x93, 0x75, 0xb3, 0x58, 0xb9, 0xdd, 0x54,
-	0x95, 0x74, 0x88, 0x07, 0x5f, 0xca, 0x3f, 0x7f, 0x99, 0x91, 0x5e, 0xbc, 0xcc, 0x48, 0xbf, 0xbd,
-	0xcc, 0x48, 0x8f, 0x8f, 0x32, 0x81, 0x17, 0x47, 0x99, 0xc0, 0xcf, 0x47, 0x99, 0xc0, 0x3d, 0xd1,
-	0xf3, 0xae, 0xf9, 0x20, 0x6f, 0x91, 0xc2, 0x43, 0xfe, 0xcf, 0xdf, 0x5e, 0x84, 0xb5, 0xdf, 0xf5,
-	0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x1e, 0x5f, 0xf0, 0x46, 0x13, 0x0e, 0x00, 0x00,
+	// 1627 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xbf, 0x6f, 0x1b, 0xc9,
+	0x15, 0xd6, 0x92, 0x14, 0x45, 0x3d, 0xea, 0x07, 0x35, 0x52, 0xec, 0x95, 0xe4, 0x90, 0x0a, 0xef,
+	0x90, 0x08, 0x3a, 0x78, 0x79, 0xd6, 0x01, 0x09, 0xe0, 0x2a, 0x24, 0xb5, 0xbe, 0xa3, 0x61, 0x93,
+	0xc4, 0x92, 0x94, 0xe2, 0x6b, 0x16, 0x4b, 0xee, 0x98, 0x5a, 0x1c, 0xb9, 0xc3, 0xec, 0x0c, 0x25,
+	0xf3, 0x3f, 0x38, 0xa4, 0xc9, 0x05, 0x69, 0x82, 0x00, 0x01, 0x0e, 0x48, 0x13, 0xa4, 0x72, 0x71,
+	0x48, 0x80, 0x74, 0x09, 0x52, 0x18, 0x29, 0x82, 0x43, 0xaa, 0x54, 0xb9, 0xc0, 0x2e, 0x9c, 0x2a,
+	0x55, 0xba, 0x34, 0xc1, 0xfc, 0x58, 0x6a, 0x49, 0x8a, 0xd2, 0xc9, 0x30, 0xd2, 0x5c, 0x23, 0x70,
+	0xe6, 0xfb, 0xde, 0xcc, 0x7b, 0x6f, 0xde, 0x37, 0x6f, 0x56, 0xb0, 0xdb, 0x21, 0xb4, 0x4f, 0x68,
+	0xa1, 0x1b, 0x90, 0xe1, 0xa0, 0x70, 0x76, 0xaf, 0xc0, 0x46, 0x03, 0x4c, 0x8d, 0x41, 0x40, 0x18,
+	0x41, 0xeb, 0x12, 0x34, 0x04, 0x68, 0x9c, 0xdd, 0xdb, 0xd9, 0xea, 0x92, 0x2e, 0x11, 0x58, 0x81,
+	0xff, 0x92, 0xb4, 0x9d, 0x6c, 0x97, 0x90, 0x6e, 0x0f, 0x17, 0xc4, 0xa8, 0x3d, 0x7c, 0x5a, 0x70,
+	0x87, 0x81, 0xc3, 0x3c, 0xe2, 0x2b, 0x3c, 0x37, 0x8d, 0x33, 0xaf, 0x8f, 0x29, 0x73, 0xfa, 0x03,
+	0x45, 0xd8, 0x96, 0xfb, 0xd8, 0x72, 0x65, 0xb5, 0xa9, 0x82, 0xa6, 0x6d, 0x1d, 0x7f, 0xa4, 0xa0,
+	0x0d, 0xa7, 0xef, 0xf9, 0xa4, 0x20, 0xfe, 0x86, 0x9e, 0xa8, 0x68, 0xda, 0x0e, 0xc5, 0x85, 0xb3,
+	0x7b, 0x6d, 0xcc, 0x9c, 0x7b, 0x85, 0x0e, 0xf1, 0x94, 0x27, 0xf9, 0xdf, 0x69, 0x90, 0x7c, 0x8c,
+	0xfb, 0x6d, 0x1c, 0xa0, 0x43, 0x58, 0x72, 0x5c, 0x37, 0xc0, 0x94, 0xea, 0xda, 0x9e, 0xb6, 0xbf,
+	0x5c, 0xd2, 0xff, 0xf6, 0xc5, 0xdd, 0x2d, 0xb5, 0x77, 0x51, 0x22, 0x0d, 0x16, 0x78, 0x7e, 0xd7,
+	0x0a, 0x89, 0xe8, 0x16, 0x24, 0xcf, 0xb1, 0xd7, 0x3d, 0x65, 0x7a, 0x8c, 0x9b, 0x58, 0x6a, 0x84,
+	0x76, 0x20, 0xd5, 0xc7, 0xcc, 0x71, 0x1d, 0xe6, 0xe8, 0x71, 0x81, 0x8c, 0xc7, 0xe8, 0x08, 0x52,
+	0x8e, 0xeb, 0x62, 0xd7, 0x76, 0x98, 0x9e, 0xd8, 0xd3, 0xf6, 0xd3, 0x87, 0x3b, 0x86, 0x8c, 0xc9,
+	0x08, 0x63, 0x32, 0x9a, 0x61, 0x3e, 0x4a, 0xab, 0x2f, 0xfe, 0x91, 0x5b, 0xf8, 0xec, 0xab, 0x9c,
+	0xf6, 0x9b, 0xd7, 0xcf, 0x0f, 0x34, 0xb1, 0x33, 0x76, 0x8b, 0x2c, 0x7f, 0x0e, 0xab, 0xd2, 0x6f,
+	0x0b, 0xff, 0x78, 0x88, 0x29, 0xfb, 0x7f, 0xb9, 0x9f, 0xff, 0xb3, 0x06, 0xb7, 0x9b, 0xa7, 0x01,
+	0xa6, 0xa7, 0xa4, 0xe7, 0x1e, 0xe1, 0x8e, 0x47, 0x3d, 0xe2, 0xd7, 0x49, 0xcf, 0xeb, 0x8c, 0xd0,
+	0x1d, 0x58, 0x66, 0x21, 0x24, 0xbd, 0xb0, 0x2e, 0x26, 0xd0, 0x0f, 0x61, 0xe9, 0xdc, 0xf3, 0x5d,
+	0x72, 0x4e, 0xc5, 0x76, 0xe9, 0xc3, 0xef, 0x1a, 0x53, 0xe5, 0x64, 0x4c, 0xae, 0x77, 0x22, 0xd9,
+	0x56, 0x68, 0x76, 0xbf, 0xf2, 0x97, 0x2f, 0xee, 0x66, 0xaf, 0xb6, 0xf9, 0xc9, 0xeb, 0xe7, 0x07,
+	0x79, 0x49, 0xb9, 0x4b, 0xdd, 0x4f, 0x0a, 0x73, 0x5c, 0xcd, 0xbf, 0xd0, 0x40, 0xaf, 0xe3, 0xa0,
+	0x83, 0x7d, 0xe6, 0x74, 0xf1, 0x54, 0x1c, 0x59, 0x80, 0xc1, 0x18, 0x53, 0x81, 0x44, 0x66, 0xde,
+	0x42, 0x24, 0x0f, 0xbf, 0x5e, 0x24, 0xef, 0x44, 0x22, 0x99, 0xe7, 0x6d, 0xfe, 0x4f, 0x1a, 0x7c,
+	0xeb, 0xd2, 0xed, 0xd0, 0x63, 0x58, 0x3d, 0x23, 0xcc, 0xf3, 0xbb, 0xf6, 0x00, 0x07, 0x1e, 0x91,
+	0x67, 0x92, 0x3e, 0xdc, 0x9e, 0xa9, 0xb7, 0x23, 0xa5, 0x4f, 0x59, 0x6e, 0xbf, 0x18, 0x97, 0xdb,
+	0x8a, 0x34, 0xaf, 0x0b, 0x6b, 0xf4, 0x31, 0x6c, 0xf5, 0x3d, 0xdf, 0xc6, 0xcf, 0x70, 0x67, 0xc8,
+	0xd9, 0xe1, 0xaa, 0xb1, 0x1b, 0xae, 0x8a, 0xfa, 0x9e, 0x6f, 0x86, 0x8b, 0xc8, 0xb5, 0xf3, 0xff,
+	0xd6, 0x60, 0xf9, 0x43, 0x9e, 0x88, 0x8a, 0xff, 0x94, 0xa0, 0x35, 0x88, 0x79, 0xd2, 0xdb, 0x84,
+	0x15, 0xf3, 0x5c, 0x64, 0xc0, 0xa2, 0xe3, 0xf6, 0x3d, 0x5f, 0x8f, 0x5d, 0x53, 0xda, 0x92, 0x76,
+	0xa5, 0xfe, 0x74, 0x58, 0x3a, 0xc3, 0x01, 0x4f, 0x96, 0x90, 0x5f, 0xc2, 0x0a, 0x87, 0xe8, 0x3b,
+	0xb0, 0xc2, 0x08, 0x73, 0x7a, 0xb6, 0x12, 0xc5, 0xa2, 0xb0, 0x4c, 0x8b, 0xb9, 0x13, 0xa9, 0x8c,
+	0x8f, 0x00, 0x3a, 0x01, 0x76, 0x98, 0x94, 0x6f, 0xf2, 0xa6, 0xf2, 0x5d, 0x56, 0xc6, 0x45, 0x96,
+	0x7f, 0x02, 0x69, 0x11, 0xaf, 0xba, 0x7d, 0xb6, 0x21, 0x25, 0xea, 0xc0, 0x1e, 0xc7, 0xbd, 0x24,
+	0xc6, 0x15, 0x17, 0x15, 0x20, 0xd9, 0x17, 0x24, 0x95, 0xe8, 0xdb, 0x33, 0xc5, 0xa6, 0x6e, 0x02,
+	0x45, 0xcb, 0xff, 0x31, 0x01, 0xeb, 0x62, 0x6d, 0x59, 0x0d, 0x22, 0xa3, 0x6f, 0x72, 0x3d, 0x44,
+	0x7d, 0x8a, 0x4d, 0xfa, 0x34, 0x3e, 0x90, 0xf8, 0xcd, 0x0f, 0x24, 0x31, 0xff, 0x40, 0x16, 0x27,
+	0x0f, 0xc4, 0x81, 0x75, 0x57, 0x15, 0xb6, 0x3d, 0x10, 0xb1, 0xa8, 0x94, 0x6f, 0xcd, 0xa4, 0xbc,
+	0xe8, 0x8f, 0x4a, 0xf9, 0xeb, 0x45, 0x65, 0xad, 0xb9, 0x93, 0x52, 0x9f, 0x3c, 0xd0, 0xa5, 0x37,
+	0x3f, 0x50, 0x64, 0xc0, 0x26, 0x65, 0x24, 0xc0, 0xf6, 0x19, 0x61, 0xd8, 0x0e, 0x70, 0x07, 0x7b,
+	0x03, 0x46, 0xf5, 0xd4, 0x9e, 0xb6, 0x9f, 0xb2, 0x36, 0x04, 0x74, 0x4c, 0x18, 0xb6, 0x14, 0x80,
+	0x7e, 0xa6, 0xc1, 0xe6, 0x85, 0x94, 0x9e, 0x62, 0x6c, 0xf7, 0xbc, 0xbe, 0xc7, 0xf4, 0xe5, 0xbd,
+	0xb8, 0x50, 0x93, 0x0a, 0x84, 0x77, 0x2e, 0x43, 0x75, 0x2e, 0xa3, 0x4c, 0x3c, 0xbf, 0xf4, 0x80,
+	0xbb, 0xf0, 0xdb, 0xaf, 0x72, 0xfb, 0x5d, 0x8f, 0x9d, 0x0e, 0xdb, 0x46, 0x87, 0xf4, 0x55, 0x8b,
+	0x2c, 0x44, 0xae, 0x0b, 0xd9, 0xb6, 0xb9, 0x01, 0xfd, 0xe5, 0xeb, 0xe7, 0x07, 0x2b, 0x3d, 0xdc,
+	0x75, 0x3a, 0x23, 0x9b, 0xf7, 0x3e, 0x2a, 0x7d, 0xdf, 0x18, 0xef, 0xfe, 0x00, 0xe3, 0x47, 0x7c,
+	0xef, 0xfb, 0xa9, 0x4f, 0x3f, 0xcf, 0x2d, 0xfc, 0xeb, 0xf3, 0x9c, 0x96, 0xff, 0x6f, 0x12, 0x52,
+	0xf5, 0x80, 0x0c, 0x08, 0x75, 0x7a, 0x33, 0x72, 0x7c, 0x08, 0x5b, 0xb2, 0x30, 0xe4, 0xa1, 0xd8,
+	0x61, 0x65, 0x5d, 0xa7, 0x4e, 0xd4, 0xbd, 0xa8, 0x4a, 0x85, 0x5c, 0x29, 0xd5, 0xef, 0xc3, 0xf2,
+	0x40, 0xf8, 0x80, 0x03, 0xaa, 0x27, 0xf6, 0xe2, 0x57, 0x2e, 0x7e, 0x41, 0x45, 0x0f, 0x21, 0x4d,
+	0x87, 0xed, 0xbe, 0xc7, 0x6c, 0xfe, 0xb0, 0xd0, 0x17, 0x6f, 0x7a, 0xaa, 0x20, 0xad, 0x39, 0x8e,
+	0xde, 0x81, 0x55, 0x19, 0x6b, 0x58, 0xa3, 0x49, 0x91, 0x86, 0x15, 0x31, 0x79, 0xac, 0x0a, 0xf5,
+	0xfd, 0xa9, 0x84, 0x84, 0xdc, 0x25, 0xc1, 0x8d, 0x86, 0x1d, 0x5a, 0xfc, 0x00, 0x92, 0x94, 0x39,
+	0x6c, 0x28, 0x0b, 0x64, 0xed, 0x30, 0x37, 0x23, 0xea, 0x30, 0xfb, 0x0d, 0x41, 0xb3, 0x14, 0x1d,
+	0xb5, 0x00, 0x3d, 0xf5, 0x7c, 0xa7, 0x67, 0x33, 0xa7, 0xd7, 0x1b, 0xd9, 0x01, 0xa6, 0xc3, 0x1e,
+	0x2f, 0x1a, 0x1e, 0xe2, 0x9d, 0x99, 0x45, 0x9a, 0x9c, 0x64, 0x09, 0x4e, 0x69, 0x99, 0x07, 0x29,
+	0x03, 0xcc, 0x88, 0x25, 0x22, 0x20, 0x6a, 0xc1, 0xc6, 0x44, 0xab, 0xb0, 0xb1, 0xef, 0xea, 0x70,
+	0xd3, 0xc4, 0xad, 0x47, 0xfb, 0x85, 0xe9, 0xbb, 0xa8, 0x0e, 0xeb, 0xb2, 0xca, 0x48, 0x10, 0xba,
+	0x9a, 0x16, 0xf1, 0x7e, 0x6f, 0x6e, 0xbc, 0xa6, 0xe2, 0x4b, 0xc7, 0xac, 0x35, 0x3c, 0x31, 0x46,
+	0xef, 0xf3, 0x7a, 0xa1, 0xd4, 0xe9, 0x62, 0xaa, 0xaf, 0xec, 0xc5, 0xe7, 0x5d, 0x06, 0xd6, 0x98,
+	0x85, 0xb6, 0x60, 0x91, 0x79, 0xac, 0x87, 0xf5, 0x55, 0x51, 0x5e, 0x72, 0xc0, 0x6f, 0x1d, 0x3a,
+	0xec, 0xf7, 0x9d, 0x60, 0xa4, 0xaf, 0x89, 0xf9, 0x70, 0x88, 0xde, 0x83, 0x8d, 0x00, 0x77, 0x86,
+	0x41, 0x80, 0xfd, 0x0e, 0xb6, 0xf1, 0x80, 0x74, 0x4e, 0xa9, 0xbe, 0x2e, 0x4e, 0x32, 0x73, 0x01,
+	0x98, 0x62, 0x1e, 0x55, 0xe1, 0xd6, 0xa4, 0x88, 0xbb, 0x81, 0xe3, 0x33, 0x8c, 0xa9, 0x9e, 0xb9,
+	0xa6, 0x5e, 0xb7, 0xa2, 0xf2, 0xfb, 0x50, 0x59, 0xdd, 0x4f, 0x70, 0x05, 0xe6, 0x7f, 0x1f, 0x87,
+	0x0d, 0x4b, 0x6c, 0xc5, 0xb3, 0x19, 0xca, 0x30, 0x07, 0xe9, 0x81, 0xfa, 0x7d, 0xd1, 0x26, 0x20,
+	0x9c, 0xaa, 0xbc, 0x5d, 0x5d, 0x46, 0xf3, 0x1c, 0xff, 0x5a, 0x79, 0xbe, 0x34, 0x6f, 0x89, 0x39,
+	0x79, 0x7b, 0x02, 0x9b, 0x3e, 0x7e, 0xc6, 0x22, 0x8f, 0x89, 0x37, 0x93, 0xea, 0x06, 0x5f, 0x65,
+	0xfc, 0x98, 0x10, 0x8a, 0xcd, 0x02, 0x8c, 0x57, 0xa5, 0x4a, 0xae, 0x91, 0x99, 0x59, 0x45, 0x2f,
+	0xdd, 0x40, 0xd1, 0xa9, 0x79, 0x8a, 0x56, 0x27, 0xf7, 0x2b, 0x0d, 0xd2, 0x51, 0x5d, 0xed, 0xc2,
+	0xf2, 0x08, 0x53, 0xbb, 0x43, 0x86, 0x3e, 0x53, 0x2f, 0xc9, 0xd4, 0x08, 0xd3, 0x32, 0x1f, 0x73,
+	0x4f, 0x9c, 0x36, 0x65, 0x8e, 0xe7, 0x2b, 0x82, 0x7c, 0x86, 0xaf, 0xa8, 0x49, 0x49, 0xda, 0x86,
+	0x94, 0x4f, 0x14, 0x2e, 0x2f, 0xc8, 0x25, 0x9f, 0x48, 0xe8, 0x3d, 0x40, 0x3e, 0xb1, 0xcf, 0x3d,
+	0x76, 0x6a, 0x9f, 0x61, 0x16, 0x92, 0x64, 0x7f, 0x5d, 0xf7, 0xc9, 0x89, 0xc7, 0x4e, 0x8f, 0x31,
+	0x93, 0x64, 0xe5, 0xdf, 0x7f, 0x34, 0x48, 0xf0, 0x36, 0x74, 0x7d, 0x31, 0x19, 0xb0, 0xc8, 0x3b,
+	0x59, 0x70, 0xfd, 0x9b, 0x4b, 0xd0, 0xd0, 0x07, 0x90, 0x24, 0x03, 0x9e, 0x61, 0xe1, 0xe5, 0xda,
+	0xe1, 0xee, 0x8c, 0xc2, 0xf9, 0xbe, 0x35, 0x41, 0xb1, 0x14, 0xf5, 0xca, 0x77, 0xc1, 0x5b, 0xbc,
+	0xc5, 0xf3, 0x3f, 0x8f, 0x41, 0x3a, 0xd2, 0x7d, 0xbf, 0x59, 0xd1, 0x47, 0xbe, 0xf3, 0x92, 0xd1,
+	0xef, 0xbc, 0x83, 0x9f, 0x6a, 0x00, 0x17, 0x6e, 0xa1, 0x5d, 0xb8, 0x7d, 0x5c, 0x6b, 0x9a, 0x76,
+	0xad, 0xde, 0xac, 0xd4, 0xaa, 0x76, 0xab, 0xda, 0xa8, 0x9b, 0xe5, 0xca, 0x83, 0x8a, 0x79, 0x94,
+	0x59, 0x40, 0x9b, 0xb0, 0x1e, 0x05, 0x9f, 0x98, 0x8d, 0x8c, 0x86, 0x6e, 0xc3, 0x66, 0x74, 0xb2,
+	0x58, 0x6a, 0x34, 0x8b, 0x95, 0x6a, 0x26, 0x86, 0x10, 0xac, 0x45, 0x81, 0x6a, 0x2d, 0x13, 0x47,
+	0x77, 0x40, 0x9f, 0x9c, 0xb3, 0x4f, 0x2a, 0xcd, 0x8f, 0xec, 0x63, 0xb3, 0x59, 0xcb, 0x24, 0x76,
+	0x12, 0x9f, 0xfe, 0x3a, 0xbb, 0x70, 0xf0, 0x57, 0x0d, 0xd6, 0x26, 0x1b, 0x1f, 0xca, 0xc1, 0x6e,
+	0xdd, 0xaa, 0xd5, 0x6b, 0x8d, 0xe2, 0x23, 0xbb, 0xd1, 0x2c, 0x36, 0x5b, 0x8d, 0x29, 0xcf, 0xbe,
+	0x0d, 0xdb, 0xd3, 0x84, 0x46, 0xab, 0xf4, 0xb8, 0xd2, 0x6c, 0x9a, 0x47, 0x19, 0x8d, 0x6f, 0x3b,
+	0x0d, 0x17, 0xcb, 0x65, 0xb3, 0xce, 0xd1, 0xd8, 0x65, 0xa8, 0x65, 0x3e, 0x34, 0xcb, 0x1c, 0x8d,
+	0xf3, 0x8c, 0xcc, 0xd8, 0x96, 0x6a, 0x16, 0x07, 0x13, 0x97, 0xed, 0xcb, 0x03, 0x3a, 0xb2, 0x8a,
+	0x27, 0xd5, 0xcc, 0xa2, 0x0a, 0xe8, 0x0f, 0x1a, 0xdc, 0xba, 0xbc, 0xb3, 0xa1, 0x7d, 0x78, 0x77,
+	0x6c, 0x6f, 0xfe, 0xc8, 0x2c, 0xb7, 0x9a, 0x35, 0xcb, 0xb6, 0xcc, 0x46, 0xeb, 0x51, 0x73, 0x2a,
+	0xc2, 0x77, 0x61, 0x6f, 0x2e, 0xb3, 0x5a, 0x6b, 0xda, 0x56, 0xab, 0x9a, 0xd1, 0xae, 0x64, 0x35,
+	0x5a, 0xe5, 0xb2, 0xd9, 0x68, 0x64, 0x62, 0x57, 0xb2, 0x1e, 0x14, 0x2b, 0x8f, 0x5a, 0x96, 0x99,
+	0x89, 0x4b, 0xe7, 0x4b, 0xc6, 0x8b, 0x97, 0x59, 0xed, 0xcb, 0x97, 0x59, 0xed, 0x9f, 0x2f, 0xb3,
+	0xda, 0x67, 0xaf, 0xb2, 0x0b, 0x5f, 0xbe, 0xca, 0x2e, 0xfc, 0xfd, 0x55, 0x76, 0xe1, 0x63, 0xa5,
+	0x05, 0xea, 0x7e, 0x62, 0x78, 0xa4, 0xf0, 0x4c, 0xfe, 0xb7, 0xa8, 0x9d, 0x14, 0x65, 0xf9, 0xc1,
+	0xff, 0x06, 0x00, 0x3e, 0x00, 0x7e, 0x85, 0x44, 0x12, 0x00, 0x00,
 }
 
 func (this *GroupPolicyInfo) Equal(that interface{}) bool {
@@ -1049,6 +1251,17 @@ func (this *GroupPolicyInfo) Equal(that interface{}) bool {
 	if !this.CreatedAt.Equal(that1.CreatedAt) {
 		return false
 	}
+	if this.StoreVoteReceipts != that1.StoreVoteReceipts {
+		return false
+	}
+	if len(this.ExecutionFeeLimit) != len(that1.ExecutionFeeLimit) {
+		return false
+	}
+	for i := range this.ExecutionFeeLimit {
+		if !this.ExecutionFeeLimit[i].Equal(&that1.ExecutionFeeLimit[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *Member) Marshal() (dAtA []byte, err error) {
@@ -1392,6 +1605,30 @@ func (m *GroupPolicyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExecutionFeeLimit) > 0 {
+		for iNdEx := len(m.ExecutionFeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutionFeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.StoreVoteReceipts {
+		i--
+		if m.StoreVoteReceipts {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
 	if err8 != nil {
 		return 0, err8
@@ -1466,6 +1703,22 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExecutionFeeGrantees) > 0 {
+		for iNdEx := len(m.ExecutionFeeGrantees) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExecutionFeeGrantees[iNdEx])
+			copy(dAtA[i:], m.ExecutionFeeGrantees[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.ExecutionFeeGrantees[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.RecurrenceEpochs != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RecurrenceEpochs))
+		i--
+		dAtA[i] = 0x78
+	}
 	if len(m.Summary) > 0 {
 		i -= len(m.Summary)
 		copy(dAtA[i:], m.Summary)
@@ -1571,6 +1824,83 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RecurringProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecurringProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecurringProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GroupPolicyVersion != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GroupPolicyVersion))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.GroupVersion != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GroupVersion))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Executions != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Executions))
+		i--
+		dAtA[i] = 0x30
+	}
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NextExecutionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextExecutionTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintTypes(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x2a
+	if m.RecurrenceEpochs != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RecurrenceEpochs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.GroupPolicyAddress) > 0 {
+		i -= len(m.GroupPolicyAddress)
+		copy(dAtA[i:], m.GroupPolicyAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupPolicyAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TallyResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1642,12 +1972,74 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
-	if err13 != nil {
-		return 0, err13
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
+	if err14 != nil {
+		return 0, err14
 	}
-	i -= n13
-	i = encodeVarintTypes(dAtA, i, uint64(n13))
+	i -= n14
+	i = encodeVarintTypes(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Option != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoteReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weight) > 0 {
+		i -= len(m.Weight)
+		copy(dAtA[i:], m.Weight)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Weight)))
+		i--
+		dAtA[i] = 0x32
+	}
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintTypes(dAtA, i, uint64(n15))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Metadata) > 0 {
@@ -1854,6 +2246,15 @@ func (m *GroupPolicyInfo) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
 	n += 1 + l + sovTypes(uint64(l))
+	if m.StoreVoteReceipts {
+		n += 2
+	}
+	if len(m.ExecutionFeeLimit) > 0 {
+		for _, e := range m.ExecutionFeeLimit {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1912,6 +2313,51 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.RecurrenceEpochs != 0 {
+		n += 1 + sovTypes(uint64(m.RecurrenceEpochs))
+	}
+	if len(m.ExecutionFeeGrantees) > 0 {
+		for _, s := range m.ExecutionFeeGrantees {
+			l = len(s)
+			n += 2 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RecurringProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTypes(uint64(m.ProposalId))
+	}
+	l = len(m.GroupPolicyAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.RecurrenceEpochs != 0 {
+		n += 1 + sovTypes(uint64(m.RecurrenceEpochs))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextExecutionTime)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Executions != 0 {
+		n += 1 + sovTypes(uint64(m.Executions))
+	}
+	if m.GroupVersion != 0 {
+		n += 1 + sovTypes(uint64(m.GroupVersion))
+	}
+	if m.GroupPolicyVersion != 0 {
+		n += 1 + sovTypes(uint64(m.GroupPolicyVersion))
+	}
 	return n
 }
 
@@ -1965,6 +2411,35 @@ func (m *Vote) Size() (n int) {
 	return n
 }
 
+func (m *VoteReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTypes(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Option != 0 {
+		n += 1 + sovTypes(uint64(m.Option))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime)
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3202,6 +3677,60 @@ func (m *GroupPolicyInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreVoteReceipts", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StoreVoteReceipts = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionFeeLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionFeeLimit = append(m.ExecutionFeeLimit, types1.Coin{})
+			if err := m.ExecutionFeeLimit[len(m.ExecutionFeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3640,6 +4169,57 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Summary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurrenceEpochs", wireType)
+			}
+			m.RecurrenceEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecurrenceEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionFeeGrantees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionFeeGrantees = append(m.ExecutionFeeGrantees, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3661,7 +4241,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TallyResult) Unmarshal(dAtA []byte) error {
+func (m *RecurringProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3684,17 +4264,17 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecurringProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecurringProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var stringLen uint64
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3704,27 +4284,14 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.YesCount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3752,13 +4319,13 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AbstainCount = string(dAtA[iNdEx:postIndex])
+			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3768,29 +4335,50 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NoCount = string(dAtA[iNdEx:postIndex])
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurrenceEpochs", wireType)
+			}
+			m.RecurrenceEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecurrenceEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVetoCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextExecutionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3800,44 +4388,280 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NoWithVetoCount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.Executions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Executions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupVersion", wireType)
+			}
+			m.GroupVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyVersion", wireType)
+			}
+			m.GroupPolicyVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupPolicyVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.YesCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AbstainCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NoCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVetoCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NoWithVetoCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
 func (m *Vote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -4024,6 +4848,223 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VoteReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
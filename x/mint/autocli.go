@@ -29,6 +29,17 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "annual-provisions",
 					Short:     "Query the current minting annual provisions value",
 				},
+				{
+					RpcMethod: "EmissionSchedules",
+					Use:       "emission-schedules",
+					Short:     "Query the emission schedules of the denoms minted in addition to the mint denom",
+				},
+				{
+					RpcMethod:      "DenomMinter",
+					Use:            "denom-minter [denom]",
+					Short:          "Query the minting state of a denom with an emission schedule",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -43,6 +54,22 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
 					GovProposal:    true,
 				},
+				{
+					RpcMethod:      "SetEmissionSchedule",
+					Use:            "set-emission-schedule-proposal [schedule]",
+					Short:          "Submit a proposal to set the emission schedule of a denom minted in addition to the mint denom",
+					Long:           "Submit a proposal to set the emission schedule of a denom minted in addition to the mint denom. The denom emissions must be enabled in the mint module params.",
+					Example:        fmt.Sprintf(`%s tx mint set-emission-schedule-proposal '{ "denom": "incentive", "initial_annual_provisions": "1000000.0", "reduction_period": "6311520", "reduction_factor": "0.5", "destinations": [{ "module_name": "fee_collector", "weight": "1.0" }] }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "schedule"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "RemoveEmissionSchedule",
+					Use:            "remove-emission-schedule-proposal [denom]",
+					Short:          "Submit a proposal to remove the emission schedule of a denom, which stops minting it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+					GovProposal:    true,
+				},
 			},
 		},
 	}
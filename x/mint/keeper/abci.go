@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"cosmossdk.io/core/event"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/mint/types"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -37,7 +38,11 @@ func (k Keeper) BeginBlocker(ctx context.Context, ic types.InflationCalculationF
 		return err
 	}
 
-	minter.Inflation = ic(ctx, minter, params, bondedRatio)
+	if params.BurnOffset {
+		minter.Inflation = params.NetIssuanceRate
+	} else {
+		minter.Inflation = ic(ctx, minter, params, bondedRatio)
+	}
 	minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
 	if err = k.Minter.Set(ctx, minter); err != nil {
 		return err
@@ -45,6 +50,21 @@ func (k Keeper) BeginBlocker(ctx context.Context, ic types.InflationCalculationF
 
 	// mint coins, update supply
 	mintedCoin := minter.BlockProvision(params)
+
+	// offset the coins burned since the last mint
+	burnOffset := math.ZeroInt()
+	if params.BurnOffset {
+		burned, err := k.GetBurned(ctx)
+		if err != nil {
+			return err
+		}
+		if err = k.Burned.Remove(ctx); err != nil {
+			return err
+		}
+
+		burnOffset = math.MinInt(burned, mintedCoin.Amount)
+		mintedCoin.Amount = mintedCoin.Amount.Sub(burnOffset)
+	}
 	mintedCoins := sdk.NewCoins(mintedCoin)
 
 	err = k.MintCoins(ctx, mintedCoins)
@@ -62,11 +82,22 @@ func (k Keeper) BeginBlocker(ctx context.Context, ic types.InflationCalculationF
 		defer telemetry.ModuleSetGauge(types.ModuleName, float32(mintedCoin.Amount.Int64()), "minted_tokens")
 	}
 
-	return k.environment.EventService.EventManager(ctx).EmitKV(
+	err = k.environment.EventService.EventManager(ctx).EmitKV(
 		types.EventTypeMint,
 		event.NewAttribute(types.AttributeKeyBondedRatio, bondedRatio.String()),
 		event.NewAttribute(types.AttributeKeyInflation, minter.Inflation.String()),
 		event.NewAttribute(types.AttributeKeyAnnualProvisions, minter.AnnualProvisions.String()),
 		event.NewAttribute(sdk.AttributeKeyAmount, mintedCoin.Amount.String()),
+		event.NewAttribute(types.AttributeKeyBurnOffset, burnOffset.String()),
 	)
+	if err != nil {
+		return err
+	}
+
+	// mint the denoms of the emission schedules
+	if !params.DenomEmissionsEnabled {
+		return nil
+	}
+
+	return k.mintDenomEmissions(ctx, params)
 }
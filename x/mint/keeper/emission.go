@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/mint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetEmissionSchedule sets the emission schedule of a denom, and resets its
+// minting state to the initial annual provisions of the schedule.
+func (k Keeper) SetEmissionSchedule(ctx context.Context, schedule types.EmissionSchedule) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.DenomEmissionsEnabled {
+		return types.ErrDenomEmissionsDisabled
+	}
+
+	if err := k.validateEmissionSchedule(schedule, params); err != nil {
+		return err
+	}
+
+	if err := k.EmissionSchedules.Set(ctx, schedule.Denom, schedule); err != nil {
+		return err
+	}
+
+	height := k.environment.HeaderService.GetHeaderInfo(ctx).Height
+	return k.DenomMinters.Set(ctx, schedule.Denom, types.NewDenomMinter(schedule, height))
+}
+
+// RemoveEmissionSchedule removes the emission schedule and the minting state
+// of a denom.
+func (k Keeper) RemoveEmissionSchedule(ctx context.Context, denom string) error {
+	has, err := k.EmissionSchedules.Has(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if !has {
+		return errorsmod.Wrapf(types.ErrEmissionScheduleNotFound, "denom %s", denom)
+	}
+
+	if err := k.EmissionSchedules.Remove(ctx, denom); err != nil {
+		return err
+	}
+
+	return k.DenomMinters.Remove(ctx, denom)
+}
+
+// validateEmissionSchedule checks an emission schedule against the params, and
+// decodes the addresses of its destinations.
+func (k Keeper) validateEmissionSchedule(schedule types.EmissionSchedule, params types.Params) error {
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
+
+	if schedule.Denom == params.MintDenom {
+		return errorsmod.Wrapf(types.ErrInvalidEmissionSchedule, "denom %s is the mint denom", schedule.Denom)
+	}
+
+	for _, destination := range schedule.Destinations {
+		if destination.Address == "" {
+			continue
+		}
+		if _, err := k.authKeeper.AddressCodec().StringToBytes(destination.Address); err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidEmissionSchedule, "invalid destination address %s: %s", destination.Address, err)
+		}
+	}
+
+	return nil
+}
+
+// mintDenomEmissions mints the block provision of the denom of each emission
+// schedule, and sends it to the destinations of the schedule.
+func (k Keeper) mintDenomEmissions(ctx context.Context, params types.Params) error {
+	height := k.environment.HeaderService.GetHeaderInfo(ctx).Height
+
+	return k.EmissionSchedules.Walk(ctx, nil, func(denom string, schedule types.EmissionSchedule) (bool, error) {
+		modified := false
+		minter, err := k.DenomMinters.Get(ctx, denom)
+		if errors.Is(err, collections.ErrNotFound) {
+			minter = types.NewDenomMinter(schedule, height)
+			modified = true
+		} else if err != nil {
+			return true, err
+		}
+
+		// reduce the annual provisions once per reduction period
+		if schedule.ReductionPeriod > 0 && height-minter.LastReductionHeight >= int64(schedule.ReductionPeriod) {
+			minter.AnnualProvisions = minter.AnnualProvisions.Mul(math.LegacyOneDec().Sub(schedule.ReductionFactor))
+			minter.LastReductionHeight = height
+			modified = true
+		}
+
+		if modified {
+			if err := k.DenomMinters.Set(ctx, denom, minter); err != nil {
+				return true, err
+			}
+		}
+
+		mintedCoin := minter.BlockProvision(params)
+		if !mintedCoin.IsPositive() {
+			return false, nil
+		}
+
+		if err := k.MintCoins(ctx, sdk.NewCoins(mintedCoin)); err != nil {
+			return true, err
+		}
+
+		if err := k.sendDenomEmission(ctx, schedule, mintedCoin); err != nil {
+			return true, err
+		}
+
+		return false, k.environment.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeDenomMint,
+			event.NewAttribute(types.AttributeKeyDenom, denom),
+			event.NewAttribute(types.AttributeKeyAnnualProvisions, minter.AnnualProvisions.String()),
+			event.NewAttribute(sdk.AttributeKeyAmount, mintedCoin.Amount.String()),
+		)
+	})
+}
+
+// sendDenomEmission splits the minted coin between the destinations of an
+// emission schedule by weight. The last destination receives the remainder
+// left by the truncation of the shares.
+func (k Keeper) sendDenomEmission(ctx context.Context, schedule types.EmissionSchedule, mintedCoin sdk.Coin) error {
+	remaining := mintedCoin.Amount
+	for i, destination := range schedule.Destinations {
+		amount := remaining
+		if i < len(schedule.Destinations)-1 {
+			amount = destination.Weight.MulInt(mintedCoin.Amount).TruncateInt()
+		}
+		remaining = remaining.Sub(amount)
+
+		if !amount.IsPositive() {
+			continue
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(mintedCoin.Denom, amount))
+		if destination.ModuleName != "" {
+			if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, destination.ModuleName, coins); err != nil {
+				return err
+			}
+			continue
+		}
+
+		addr, err := k.authKeeper.AddressCodec().StringToBytes(destination.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
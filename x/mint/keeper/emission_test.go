@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/mint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *IntegrationTestSuite) TestDenomEmissions() {
+	s.accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	params := types.DefaultParams()
+	params.BlocksPerYear = 100
+	s.Require().NoError(s.mintKeeper.Params.Set(s.ctx, params))
+
+	recipient := sdk.AccAddress([]byte("recipient___________"))
+	recipientStr, err := s.accountKeeper.AddressCodec().BytesToString(recipient)
+	s.Require().NoError(err)
+	schedule := types.EmissionSchedule{
+		Denom:                   "incentive",
+		InitialAnnualProvisions: math.LegacyNewDec(10000),
+		ReductionPeriod:         10,
+		ReductionFactor:         math.LegacyNewDecWithPrec(5, 1),
+		Destinations: []types.EmissionDestination{
+			{ModuleName: authtypes.FeeCollectorName, Weight: math.LegacyNewDecWithPrec(3, 1)},
+			{Address: recipientStr, Weight: math.LegacyNewDecWithPrec(7, 1)},
+		},
+	}
+	msg := &types.MsgSetEmissionSchedule{Authority: s.mintKeeper.GetAuthority(), Schedule: schedule}
+
+	// denom emissions are behind a feature flag
+	_, err = s.msgServer.SetEmissionSchedule(s.ctx, msg)
+	s.Require().ErrorIs(err, types.ErrDenomEmissionsDisabled)
+
+	params.DenomEmissionsEnabled = true
+	s.Require().NoError(s.mintKeeper.Params.Set(s.ctx, params))
+
+	_, err = s.msgServer.SetEmissionSchedule(s.ctx, &types.MsgSetEmissionSchedule{Authority: recipientStr, Schedule: schedule})
+	s.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	invalid := schedule
+	invalid.Denom = params.MintDenom
+	_, err = s.msgServer.SetEmissionSchedule(s.ctx, &types.MsgSetEmissionSchedule{Authority: s.mintKeeper.GetAuthority(), Schedule: invalid})
+	s.Require().ErrorIs(err, types.ErrInvalidEmissionSchedule)
+
+	invalid = schedule
+	invalid.Destinations = invalid.Destinations[:1]
+	_, err = s.msgServer.SetEmissionSchedule(s.ctx, &types.MsgSetEmissionSchedule{Authority: s.mintKeeper.GetAuthority(), Schedule: invalid})
+	s.Require().ErrorIs(err, types.ErrInvalidEmissionSchedule)
+
+	_, err = s.msgServer.SetEmissionSchedule(s.ctx, msg)
+	s.Require().NoError(err)
+
+	// 10000 over 100 blocks is split 30/70 between the destinations, in
+	// addition to the mint denom
+	s.stakingKeeper.EXPECT().StakingTokenSupply(gomock.Any()).Return(math.NewInt(0), nil).Times(2)
+	s.stakingKeeper.EXPECT().BondedRatio(gomock.Any()).Return(math.LegacyNewDecWithPrec(5, 1), nil).Times(2)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins()).Return(nil).Times(2)
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("incentive", 100))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin("incentive", 30))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, recipient, sdk.NewCoins(sdk.NewInt64Coin("incentive", 70))).Return(nil)
+	s.Require().NoError(s.mintKeeper.BeginBlocker(s.ctx, types.DefaultInflationCalculationFn))
+
+	// the annual provisions are halved after the reduction period
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: s.ctx.HeaderInfo().Height + 10})
+	s.bankKeeper.EXPECT().MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("incentive", 50))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin("incentive", 15))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(sdk.NewInt64Coin("incentive", 35))).Return(nil)
+	s.Require().NoError(s.mintKeeper.BeginBlocker(ctx, types.DefaultInflationCalculationFn))
+
+	minter, err := s.mintKeeper.DenomMinters.Get(ctx, "incentive")
+	s.Require().NoError(err)
+	s.Require().Equal(types.DenomMinter{Denom: "incentive", AnnualProvisions: math.LegacyNewDec(5000), LastReductionHeight: ctx.HeaderInfo().Height}, minter)
+
+	// removing the schedule stops minting the denom
+	_, err = s.msgServer.RemoveEmissionSchedule(ctx, &types.MsgRemoveEmissionSchedule{Authority: s.mintKeeper.GetAuthority(), Denom: "incentive"})
+	s.Require().NoError(err)
+	_, err = s.msgServer.RemoveEmissionSchedule(ctx, &types.MsgRemoveEmissionSchedule{Authority: s.mintKeeper.GetAuthority(), Denom: "incentive"})
+	s.Require().ErrorIs(err, types.ErrEmissionScheduleNotFound)
+	has, err := s.mintKeeper.DenomMinters.Has(ctx, "incentive")
+	s.Require().NoError(err)
+	s.Require().False(has)
+}
@@ -16,6 +16,21 @@ func (keeper Keeper) InitGenesis(ctx context.Context, ak types.AccountKeeper, da
 		panic(err)
 	}
 
+	for _, schedule := range data.EmissionSchedules {
+		if err := keeper.validateEmissionSchedule(schedule, data.Params); err != nil {
+			panic(err)
+		}
+		if err := keeper.EmissionSchedules.Set(ctx, schedule.Denom, schedule); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, minter := range data.DenomMinters {
+		if err := keeper.DenomMinters.Set(ctx, minter.Denom, minter); err != nil {
+			panic(err)
+		}
+	}
+
 	ak.GetModuleAccount(ctx, types.ModuleName)
 }
 
@@ -31,5 +46,23 @@ func (keeper Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		panic(err)
 	}
 
-	return types.NewGenesisState(minter, params)
+	genState := types.NewGenesisState(minter, params)
+
+	err = keeper.EmissionSchedules.Walk(ctx, nil, func(_ string, schedule types.EmissionSchedule) (bool, error) {
+		genState.EmissionSchedules = append(genState.EmissionSchedules, schedule)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = keeper.DenomMinters.Walk(ctx, nil, func(_ string, minter types.DenomMinter) (bool, error) {
+		genState.DenomMinters = append(genState.DenomMinters, minter)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return genState
 }
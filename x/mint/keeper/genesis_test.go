@@ -71,6 +71,15 @@ func (s *GenesisTestSuite) TestImportExportGenesis() {
 		math.LegacyNewDecWithPrec(69, 2),
 		uint64(60*60*8766/5),
 	)
+	genesisState.Params.DenomEmissionsEnabled = true
+	genesisState.EmissionSchedules = []types.EmissionSchedule{{
+		Denom:                   "incentive",
+		InitialAnnualProvisions: math.LegacyNewDec(1000),
+		ReductionFactor:         math.LegacyZeroDec(),
+		Destinations:            []types.EmissionDestination{{ModuleName: "fee_collector", Weight: math.LegacyOneDec()}},
+	}}
+	genesisState.DenomMinters = []types.DenomMinter{types.NewDenomMinter(genesisState.EmissionSchedules[0], 0)}
+	s.Require().NoError(types.ValidateGenesis(*genesisState))
 
 	s.keeper.InitGenesis(s.sdkCtx, s.accountKeeper, genesisState)
 
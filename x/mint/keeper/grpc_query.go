@@ -2,8 +2,15 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/mint/types"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 var _ types.QueryServer = queryServer{}
@@ -45,3 +52,36 @@ func (q queryServer) AnnualProvisions(ctx context.Context, _ *types.QueryAnnualP
 
 	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: minter.AnnualProvisions}, nil
 }
+
+// EmissionSchedules returns the emission schedules of the mint module.
+func (q queryServer) EmissionSchedules(ctx context.Context, req *types.QueryEmissionSchedulesRequest) (*types.QueryEmissionSchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	schedules, pageRes, err := query.CollectionPaginate(ctx, q.k.EmissionSchedules, req.Pagination, func(_ string, schedule types.EmissionSchedule) (types.EmissionSchedule, error) {
+		return schedule, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEmissionSchedulesResponse{Schedules: schedules, Pagination: pageRes}, nil
+}
+
+// DenomMinter returns the minting state of a denom with an emission schedule.
+func (q queryServer) DenomMinter(ctx context.Context, req *types.QueryDenomMinterRequest) (*types.QueryDenomMinterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	minter, err := q.k.DenomMinters.Get(ctx, req.Denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "denom minter not found for denom %s", req.Denom)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomMinterResponse{Minter: minter}, nil
+}
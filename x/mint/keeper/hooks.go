@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ banktypes.BurnHooks = Hooks{}
+
+// Hooks wrapper struct for mint keeper
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the mint burn hooks
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterCoinsBurned tracks the amount of mint denom burned since the last mint,
+// when burn-offset minting is enabled.
+func (h Hooks) AfterCoinsBurned(ctx context.Context, _ sdk.AccAddress, amount sdk.Coins) error {
+	params, err := h.k.Params.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			// the mint genesis has not been initialized yet
+			return nil
+		}
+		return err
+	}
+
+	if !params.BurnOffset {
+		return nil
+	}
+
+	burnedAmt := amount.AmountOf(params.MintDenom)
+	if burnedAmt.IsZero() {
+		return nil
+	}
+
+	burned, err := h.k.GetBurned(ctx)
+	if err != nil {
+		return err
+	}
+
+	return h.k.Burned.Set(ctx, burned.Add(burnedAmt))
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/collections"
@@ -19,6 +20,7 @@ type Keeper struct {
 	cdc              codec.BinaryCodec
 	environment      appmodule.Environment
 	stakingKeeper    types.StakingKeeper
+	authKeeper       types.AccountKeeper
 	bankKeeper       types.BankKeeper
 	logger           log.Logger
 	feeCollectorName string
@@ -29,6 +31,13 @@ type Keeper struct {
 	Schema collections.Schema
 	Params collections.Item[types.Params]
 	Minter collections.Item[types.Minter]
+	// Burned is the amount of mint denom burned since the last mint, used by
+	// burn-offset minting.
+	Burned collections.Item[math.Int]
+	// EmissionSchedules key: denom | value: EmissionSchedule
+	EmissionSchedules collections.Map[string, types.EmissionSchedule]
+	// DenomMinters key: denom | value: DenomMinter
+	DenomMinters collections.Map[string, types.DenomMinter]
 }
 
 // NewKeeper creates a new mint Keeper instance
@@ -51,12 +60,28 @@ func NewKeeper(
 		cdc:              cdc,
 		environment:      env,
 		stakingKeeper:    sk,
+		authKeeper:       ak,
 		bankKeeper:       bk,
 		logger:           env.Logger,
 		feeCollectorName: feeCollectorName,
 		authority:        authority,
 		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		Minter:           collections.NewItem(sb, types.MinterKey, "minter", codec.CollValue[types.Minter](cdc)),
+		Burned:           collections.NewItem(sb, types.BurnedKey, "burned", sdk.IntValue),
+		EmissionSchedules: collections.NewMap(
+			sb,
+			types.EmissionSchedulesKeyPrefix,
+			"emission_schedules",
+			collections.StringKey,
+			codec.CollValue[types.EmissionSchedule](cdc),
+		),
+		DenomMinters: collections.NewMap(
+			sb,
+			types.DenomMintersKeyPrefix,
+			"denom_minters",
+			collections.StringKey,
+			codec.CollValue[types.DenomMinter](cdc),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -105,3 +130,28 @@ func (k Keeper) MintCoins(ctx context.Context, newCoins sdk.Coins) error {
 func (k Keeper) AddCollectedFees(ctx context.Context, fees sdk.Coins) error {
 	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, fees)
 }
+
+// AnnualProvisions returns the annual provisions of the current minter, in the
+// mint denom.
+func (k Keeper) AnnualProvisions(ctx context.Context) (sdk.DecCoins, error) {
+	minter, err := k.Minter.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.NewDecCoins(sdk.NewDecCoinFromDec(params.MintDenom, minter.AnnualProvisions)), nil
+}
+
+// GetBurned returns the amount of mint denom burned since the last mint.
+func (k Keeper) GetBurned(ctx context.Context) (math.Int, error) {
+	burned, err := k.Burned.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+	return burned, err
+}
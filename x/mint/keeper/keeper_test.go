@@ -30,6 +30,7 @@ type IntegrationTestSuite struct {
 	ctx           sdk.Context
 	msgServer     types.MsgServer
 	stakingKeeper *minttestutil.MockStakingKeeper
+	accountKeeper *minttestutil.MockAccountKeeper
 	bankKeeper    *minttestutil.MockBankKeeper
 }
 
@@ -63,6 +64,7 @@ func (s *IntegrationTestSuite) SetupTest() {
 		govModuleNameStr,
 	)
 	s.stakingKeeper = stakingKeeper
+	s.accountKeeper = accountKeeper
 	s.bankKeeper = bankKeeper
 
 	s.Require().Equal(testCtx.Ctx.Logger().With("module", "x/"+types.ModuleName),
@@ -97,3 +99,52 @@ func (s *IntegrationTestSuite) TestAliasFunctions() {
 	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, fees).Return(nil)
 	s.Require().Nil(s.mintKeeper.AddCollectedFees(s.ctx, fees))
 }
+
+func (s *IntegrationTestSuite) TestAnnualProvisions() {
+	params := types.DefaultParams()
+	s.Require().NoError(s.mintKeeper.Params.Set(s.ctx, params))
+	s.Require().NoError(s.mintKeeper.Minter.Set(s.ctx, types.NewMinter(math.LegacyNewDecWithPrec(13, 2), math.LegacyNewDec(1000))))
+
+	provisions, err := s.mintKeeper.AnnualProvisions(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin(params.MintDenom, 1000)), provisions)
+}
+
+func (s *IntegrationTestSuite) TestBurnOffset() {
+	params := types.DefaultParams()
+	params.BlocksPerYear = 100
+	params.BurnOffset = true
+	params.NetIssuanceRate = math.LegacyNewDecWithPrec(5, 2)
+	s.Require().NoError(s.mintKeeper.Params.Set(s.ctx, params))
+
+	// only burns of the mint denom are tracked
+	hooks := s.mintKeeper.Hooks()
+	burned := sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 30), sdk.NewInt64Coin("other", 1000))
+	s.Require().NoError(hooks.AfterCoinsBurned(s.ctx, sdk.AccAddress{}, burned))
+	s.Require().NoError(hooks.AfterCoinsBurned(s.ctx, sdk.AccAddress{}, burned))
+	amount, err := s.mintKeeper.GetBurned(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(60), amount)
+
+	// 5% of 200000 over 100 blocks, minus the 60 burned
+	s.stakingKeeper.EXPECT().StakingTokenSupply(s.ctx).Return(math.NewInt(200000), nil).Times(2)
+	s.stakingKeeper.EXPECT().BondedRatio(s.ctx).Return(math.LegacyNewDecWithPrec(5, 1), nil).Times(2)
+	expected := sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 40))
+	s.bankKeeper.EXPECT().MintCoins(s.ctx, types.ModuleName, expected).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, expected).Return(nil)
+	s.Require().NoError(s.mintKeeper.BeginBlocker(s.ctx, types.DefaultInflationCalculationFn))
+
+	minter, err := s.mintKeeper.Minter.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(params.NetIssuanceRate, minter.Inflation)
+
+	// the burn counter is reset, and nothing is minted when the burns exceed
+	// the provision
+	amount, err = s.mintKeeper.GetBurned(s.ctx)
+	s.Require().NoError(err)
+	s.Require().True(amount.IsZero())
+
+	s.Require().NoError(hooks.AfterCoinsBurned(s.ctx, sdk.AccAddress{}, sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 500))))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins()).Return(nil)
+	s.Require().NoError(s.mintKeeper.BeginBlocker(s.ctx, types.DefaultInflationCalculationFn))
+}
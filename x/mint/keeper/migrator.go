@@ -1,6 +1,10 @@
 package keeper
 
-import "context"
+import (
+	"context"
+
+	"cosmossdk.io/math"
+)
 
 // Migrator is a struct for handling in-place state migrations.
 type Migrator struct {
@@ -21,3 +25,16 @@ func NewMigrator(k Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx context.Context) error {
 	return nil
 }
+
+// Migrate2to3 migrates the x/mint module state from the consensus version 2 to
+// version 3. Specifically, it sets the net issuance rate param used by
+// burn-offset minting to zero.
+func (m Migrator) Migrate2to3(ctx context.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	params.NetIssuanceRate = math.LegacyZeroDec()
+	return m.keeper.Params.Set(ctx, params)
+}
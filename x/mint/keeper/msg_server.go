@@ -37,3 +37,29 @@ func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// SetEmissionSchedule sets the emission schedule of a denom.
+func (ms msgServer) SetEmissionSchedule(ctx context.Context, msg *types.MsgSetEmissionSchedule) (*types.MsgSetEmissionScheduleResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := ms.Keeper.SetEmissionSchedule(ctx, msg.Schedule); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetEmissionScheduleResponse{}, nil
+}
+
+// RemoveEmissionSchedule removes the emission schedule of a denom.
+func (ms msgServer) RemoveEmissionSchedule(ctx context.Context, msg *types.MsgRemoveEmissionSchedule) (*types.MsgRemoveEmissionScheduleResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := ms.Keeper.RemoveEmissionSchedule(ctx, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveEmissionScheduleResponse{}, nil
+}
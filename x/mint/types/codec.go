@@ -12,6 +12,8 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/mint/Params", nil)
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/mint/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgSetEmissionSchedule{}, "cosmos-sdk/x/mint/MsgSetEmission")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveEmissionSchedule{}, "cosmos-sdk/x/mint/MsgRemoveEmission")
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry.
@@ -19,6 +21,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgSetEmissionSchedule{},
+		&MsgRemoveEmissionSchedule{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewDenomMinter returns the initial minting state of the denom of an emission
+// schedule set at the given height.
+func NewDenomMinter(schedule EmissionSchedule, height int64) DenomMinter {
+	return DenomMinter{
+		Denom:               schedule.Denom,
+		AnnualProvisions:    schedule.InitialAnnualProvisions,
+		LastReductionHeight: height,
+	}
+}
+
+// BlockProvision returns the amount of denom minted in a block.
+func (m DenomMinter) BlockProvision(params Params) sdk.Coin {
+	provisionAmt := m.AnnualProvisions.QuoInt(math.NewIntFromUint64(params.BlocksPerYear))
+	return sdk.NewCoin(m.Denom, provisionAmt.TruncateInt())
+}
+
+// Validate does a sanity check on the emission schedule. The addresses of the
+// destinations are only checked to be set.
+func (s EmissionSchedule) Validate() error {
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return errors.Wrap(ErrInvalidEmissionSchedule, err.Error())
+	}
+	if s.InitialAnnualProvisions.IsNil() || s.InitialAnnualProvisions.IsNegative() {
+		return errors.Wrapf(ErrInvalidEmissionSchedule, "initial annual provisions cannot be negative: %s", s.InitialAnnualProvisions)
+	}
+	if s.ReductionFactor.IsNil() || s.ReductionFactor.IsNegative() || s.ReductionFactor.GT(math.LegacyOneDec()) {
+		return errors.Wrapf(ErrInvalidEmissionSchedule, "reduction factor must be between 0 and 1: %s", s.ReductionFactor)
+	}
+	if len(s.Destinations) == 0 {
+		return errors.Wrap(ErrInvalidEmissionSchedule, "no destinations")
+	}
+
+	total := math.LegacyZeroDec()
+	for _, destination := range s.Destinations {
+		if (destination.ModuleName == "") == (destination.Address == "") {
+			return errors.Wrap(ErrInvalidEmissionSchedule, "exactly one of the module name and the address of a destination must be set")
+		}
+		if destination.Weight.IsNil() || !destination.Weight.IsPositive() {
+			return errors.Wrapf(ErrInvalidEmissionSchedule, "destination weight must be positive: %s", destination.Weight)
+		}
+		total = total.Add(destination.Weight)
+	}
+	if !total.Equal(math.LegacyOneDec()) {
+		return errors.Wrapf(ErrInvalidEmissionSchedule, "destination weights must sum to 1, got %s", total)
+	}
+
+	return nil
+}
@@ -2,4 +2,9 @@ package types
 
 import "cosmossdk.io/errors"
 
-var ErrInvalidSigner = errors.Register(ModuleName, 1, "expected authority account as only signer for proposal message")
+var (
+	ErrInvalidSigner            = errors.Register(ModuleName, 1, "expected authority account as only signer for proposal message")
+	ErrDenomEmissionsDisabled   = errors.Register(ModuleName, 2, "denom emissions are disabled")
+	ErrInvalidEmissionSchedule  = errors.Register(ModuleName, 3, "invalid emission schedule")
+	ErrEmissionScheduleNotFound = errors.Register(ModuleName, 4, "emission schedule not found")
+)
@@ -2,9 +2,12 @@ package types
 
 // Minting module event types
 const (
-	EventTypeMint = ModuleName
+	EventTypeMint      = ModuleName
+	EventTypeDenomMint = "denom_mint"
 
 	AttributeKeyBondedRatio      = "bonded_ratio"
 	AttributeKeyInflation        = "inflation"
 	AttributeKeyAnnualProvisions = "annual_provisions"
+	AttributeKeyBurnOffset       = "burn_offset"
+	AttributeKeyDenom            = "denom"
 )
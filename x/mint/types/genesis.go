@@ -2,7 +2,9 @@ package types
 
 import (
 	context "context"
+	"fmt"
 
+	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
 )
 
@@ -41,5 +43,37 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
-	return ValidateMinter(data.Minter)
+	if err := ValidateMinter(data.Minter); err != nil {
+		return err
+	}
+
+	schedules := make(map[string]bool, len(data.EmissionSchedules))
+	for _, schedule := range data.EmissionSchedules {
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+		if schedule.Denom == data.Params.MintDenom {
+			return errors.Wrapf(ErrInvalidEmissionSchedule, "denom %s is the mint denom", schedule.Denom)
+		}
+		if schedules[schedule.Denom] {
+			return errors.Wrapf(ErrInvalidEmissionSchedule, "duplicate emission schedule for denom %s", schedule.Denom)
+		}
+		schedules[schedule.Denom] = true
+	}
+
+	minters := make(map[string]bool, len(data.DenomMinters))
+	for _, minter := range data.DenomMinters {
+		if !schedules[minter.Denom] {
+			return fmt.Errorf("denom minter for denom %s without emission schedule", minter.Denom)
+		}
+		if minters[minter.Denom] {
+			return fmt.Errorf("duplicate denom minter for denom %s", minter.Denom)
+		}
+		if minter.AnnualProvisions.IsNil() || minter.AnnualProvisions.IsNegative() {
+			return fmt.Errorf("denom minter annual provisions should be non-negative, is %s", minter.AnnualProvisions)
+		}
+		minters[minter.Denom] = true
+	}
+
+	return nil
 }
@@ -30,6 +30,12 @@ type GenesisState struct {
 	Minter Minter `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter"`
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// emission_schedules defines the emission schedules of the denoms minted in
+	// addition to the mint denom.
+	EmissionSchedules []EmissionSchedule `protobuf:"bytes,3,rep,name=emission_schedules,json=emissionSchedules,proto3" json:"emission_schedules"`
+	// denom_minters defines the minting state of the denoms with an emission
+	// schedule.
+	DenomMinters []DenomMinter `protobuf:"bytes,4,rep,name=denom_minters,json=denomMinters,proto3" json:"denom_minters"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +85,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetEmissionSchedules() []EmissionSchedule {
+	if m != nil {
+		return m.EmissionSchedules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomMinters() []DenomMinter {
+	if m != nil {
+		return m.DenomMinters
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.mint.v1beta1.GenesisState")
 }
@@ -86,21 +106,26 @@ func init() {
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/genesis.proto", fileDescriptor_0e215eb1d09cd648) }
 
 var fileDescriptor_0e215eb1d09cd648 = []byte{
-	// 223 bytes of a gzipped FileDescriptorProto
+	// 297 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
 	0xcd, 0x2f, 0xd6, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
 	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
 	0x28, 0xd1, 0x03, 0x29, 0xd1, 0x83, 0x2a, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb,
 	0x83, 0x58, 0x10, 0xa5, 0x52, 0x72, 0xd8, 0x4c, 0x03, 0xeb, 0x83, 0xc8, 0x0b, 0x26, 0xe6, 0x66,
-	0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x90, 0x52, 0x1f, 0x23, 0x17, 0x8f, 0x3b, 0xc4, 0xbe, 0xe0,
-	0x92, 0xc4, 0x92, 0x54, 0x21, 0x3b, 0x2e, 0x36, 0x90, 0x8e, 0xd4, 0x22, 0x09, 0x46, 0x05, 0x46,
-	0x0d, 0x6e, 0x23, 0x69, 0x3d, 0x2c, 0xf6, 0xeb, 0xf9, 0x82, 0x95, 0x38, 0x71, 0x9e, 0xb8, 0x27,
-	0xcf, 0xb0, 0xe2, 0xf9, 0x06, 0x2d, 0xc6, 0x20, 0xa8, 0x2e, 0x90, 0xfe, 0x82, 0xc4, 0xa2, 0xc4,
-	0xdc, 0x62, 0x09, 0x26, 0x3c, 0xfa, 0x03, 0xc0, 0x4a, 0x50, 0xf4, 0x43, 0x74, 0x39, 0x19, 0x9f,
-	0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31,
-	0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x24, 0xc4, 0xa0, 0xe2, 0x94, 0x6c,
-	0xbd, 0xcc, 0x7c, 0xfd, 0x0a, 0x88, 0x2f, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x9e,
-	0x31, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x66, 0xa4, 0xb5, 0x5b, 0x4f, 0x01, 0x00, 0x00,
+	0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x90, 0xd2, 0x41, 0x26, 0x2e, 0x1e, 0x77, 0x88, 0x7d, 0xc1,
+	0x25, 0x89, 0x25, 0xa9, 0x42, 0x76, 0x5c, 0x6c, 0x20, 0x1d, 0xa9, 0x45, 0x12, 0x8c, 0x0a, 0x8c,
+	0x1a, 0xdc, 0x46, 0xd2, 0x7a, 0x58, 0xec, 0xd7, 0xf3, 0x05, 0x2b, 0x71, 0xe2, 0x3c, 0x71, 0x4f,
+	0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x5d, 0x20, 0xfd, 0x05, 0x89, 0x45, 0x89,
+	0xb9, 0xc5, 0x12, 0x4c, 0x78, 0xf4, 0x07, 0x80, 0x95, 0xa0, 0xe8, 0x87, 0xe8, 0x12, 0x8a, 0xe7,
+	0x12, 0x4a, 0xcd, 0xcd, 0x2c, 0x2e, 0xce, 0xcc, 0xcf, 0x8b, 0x2f, 0x4e, 0xce, 0x48, 0x4d, 0x29,
+	0xcd, 0x49, 0x2d, 0x96, 0x60, 0x56, 0x60, 0xd6, 0xe0, 0x36, 0x52, 0xc5, 0x6a, 0x96, 0x2b, 0x54,
+	0x79, 0x30, 0x54, 0x35, 0xb2, 0xa9, 0x82, 0xa9, 0x68, 0x92, 0xc5, 0x42, 0x01, 0x5c, 0xbc, 0x29,
+	0xa9, 0x79, 0xf9, 0xb9, 0xf1, 0x10, 0x07, 0x17, 0x4b, 0xb0, 0x80, 0xcd, 0x56, 0xc0, 0x6a, 0xb6,
+	0x0b, 0x48, 0x25, 0xa6, 0x67, 0x79, 0x52, 0x10, 0xe2, 0xc5, 0x4e, 0xc6, 0x27, 0x1e, 0xc9, 0x31,
+	0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb,
+	0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x25, 0x09, 0x31, 0xb3, 0x38, 0x25, 0x5b, 0x2f, 0x33, 0x5f,
+	0xbf, 0x02, 0x12, 0x31, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0xf0, 0x37, 0x06, 0x0c,
+	0x00, 0x67, 0x7d, 0x46, 0x6b, 0x02, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -123,6 +148,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomMinters) > 0 {
+		for iNdEx := len(m.DenomMinters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomMinters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.EmissionSchedules) > 0 {
+		for iNdEx := len(m.EmissionSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EmissionSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -167,6 +220,18 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.EmissionSchedules) > 0 {
+		for _, e := range m.EmissionSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomMinters) > 0 {
+		for _, e := range m.DenomMinters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -271,6 +336,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmissionSchedules = append(m.EmissionSchedules, EmissionSchedule{})
+			if err := m.EmissionSchedules[len(m.EmissionSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomMinters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomMinters = append(m.DenomMinters, DenomMinter{})
+			if err := m.DenomMinters[len(m.DenomMinters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -6,6 +6,13 @@ var (
 	// MinterKey is the key to use for the keeper store.
 	MinterKey = collections.NewPrefix(0)
 	ParamsKey = collections.NewPrefix(1)
+	// BurnedKey is the key of the amount of mint denom burned since the last mint.
+	BurnedKey = collections.NewPrefix(2)
+	// EmissionSchedulesKeyPrefix is the prefix of the emission schedules, by denom.
+	EmissionSchedulesKeyPrefix = collections.NewPrefix(3)
+	// DenomMintersKeyPrefix is the prefix of the minting state of the denoms
+	// with an emission schedule, by denom.
+	DenomMintersKeyPrefix = collections.NewPrefix(4)
 )
 
 const (
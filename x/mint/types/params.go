@@ -19,18 +19,22 @@ func NewParams(mintDenom string, inflationRateChange, inflationMax, inflationMin
 		InflationMin:        inflationMin,
 		GoalBonded:          goalBonded,
 		BlocksPerYear:       blocksPerYear,
+		NetIssuanceRate:     math.LegacyZeroDec(),
 	}
 }
 
 // DefaultParams returns default x/mint module parameters.
 func DefaultParams() Params {
 	return Params{
-		MintDenom:           sdk.DefaultBondDenom,
-		InflationRateChange: math.LegacyNewDecWithPrec(13, 2),
-		InflationMax:        math.LegacyNewDecWithPrec(20, 2),
-		InflationMin:        math.LegacyNewDecWithPrec(7, 2),
-		GoalBonded:          math.LegacyNewDecWithPrec(67, 2),
-		BlocksPerYear:       uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+		MintDenom:             sdk.DefaultBondDenom,
+		InflationRateChange:   math.LegacyNewDecWithPrec(13, 2),
+		InflationMax:          math.LegacyNewDecWithPrec(20, 2),
+		InflationMin:          math.LegacyNewDecWithPrec(7, 2),
+		GoalBonded:            math.LegacyNewDecWithPrec(67, 2),
+		BlocksPerYear:         uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+		BurnOffset:            false,
+		NetIssuanceRate:       math.LegacyZeroDec(),
+		DenomEmissionsEnabled: false,
 	}
 }
 
@@ -54,6 +58,9 @@ func (p Params) Validate() error {
 	if err := validateBlocksPerYear(p.BlocksPerYear); err != nil {
 		return err
 	}
+	if err := validateNetIssuanceRate(p.NetIssuanceRate); err != nil {
+		return err
+	}
 	if p.InflationMax.LT(p.InflationMin) {
 		return fmt.Errorf(
 			"max inflation (%s) must be greater than or equal to min inflation (%s)",
@@ -168,3 +175,22 @@ func validateBlocksPerYear(i interface{}) error {
 
 	return nil
 }
+
+func validateNetIssuanceRate(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("net issuance rate cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("net issuance rate cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("net issuance rate too large: %s", v)
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -270,6 +271,203 @@ func (m *QueryAnnualProvisionsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAnnualProvisionsResponse proto.InternalMessageInfo
 
+// QueryEmissionSchedulesRequest is the request type for the
+// Query/EmissionSchedules RPC method.
+type QueryEmissionSchedulesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEmissionSchedulesRequest) Reset()         { *m = QueryEmissionSchedulesRequest{} }
+func (m *QueryEmissionSchedulesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionSchedulesRequest) ProtoMessage()    {}
+func (*QueryEmissionSchedulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{6}
+}
+func (m *QueryEmissionSchedulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionSchedulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionSchedulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEmissionSchedulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionSchedulesRequest.Merge(m, src)
+}
+func (m *QueryEmissionSchedulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmissionSchedulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionSchedulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEmissionSchedulesRequest proto.InternalMessageInfo
+
+func (m *QueryEmissionSchedulesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEmissionSchedulesResponse is the response type for the
+// Query/EmissionSchedules RPC method.
+type QueryEmissionSchedulesResponse struct {
+	// schedules are the emission schedules.
+	Schedules []EmissionSchedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEmissionSchedulesResponse) Reset()         { *m = QueryEmissionSchedulesResponse{} }
+func (m *QueryEmissionSchedulesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionSchedulesResponse) ProtoMessage()    {}
+func (*QueryEmissionSchedulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{7}
+}
+func (m *QueryEmissionSchedulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionSchedulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionSchedulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEmissionSchedulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionSchedulesResponse.Merge(m, src)
+}
+func (m *QueryEmissionSchedulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmissionSchedulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionSchedulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEmissionSchedulesResponse proto.InternalMessageInfo
+
+func (m *QueryEmissionSchedulesResponse) GetSchedules() []EmissionSchedule {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
+}
+
+func (m *QueryEmissionSchedulesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDenomMinterRequest is the request type for the Query/DenomMinter RPC
+// method.
+type QueryDenomMinterRequest struct {
+	// denom is the denom to query the minting state of.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryDenomMinterRequest) Reset()         { *m = QueryDenomMinterRequest{} }
+func (m *QueryDenomMinterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomMinterRequest) ProtoMessage()    {}
+func (*QueryDenomMinterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{8}
+}
+func (m *QueryDenomMinterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomMinterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomMinterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomMinterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomMinterRequest.Merge(m, src)
+}
+func (m *QueryDenomMinterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomMinterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomMinterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomMinterRequest proto.InternalMessageInfo
+
+func (m *QueryDenomMinterRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryDenomMinterResponse is the response type for the Query/DenomMinter RPC
+// method.
+type QueryDenomMinterResponse struct {
+	// minter is the minting state of the denom.
+	Minter DenomMinter `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter"`
+}
+
+func (m *QueryDenomMinterResponse) Reset()         { *m = QueryDenomMinterResponse{} }
+func (m *QueryDenomMinterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomMinterResponse) ProtoMessage()    {}
+func (*QueryDenomMinterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{9}
+}
+func (m *QueryDenomMinterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomMinterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomMinterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomMinterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomMinterResponse.Merge(m, src)
+}
+func (m *QueryDenomMinterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomMinterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomMinterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomMinterResponse proto.InternalMessageInfo
+
+func (m *QueryDenomMinterResponse) GetMinter() DenomMinter {
+	if m != nil {
+		return m.Minter
+	}
+	return DenomMinter{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.mint.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.mint.v1beta1.QueryParamsResponse")
@@ -277,42 +475,60 @@ func init() {
 	proto.RegisterType((*QueryInflationResponse)(nil), "cosmos.mint.v1beta1.QueryInflationResponse")
 	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsRequest")
 	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsResponse")
+	proto.RegisterType((*QueryEmissionSchedulesRequest)(nil), "cosmos.mint.v1beta1.QueryEmissionSchedulesRequest")
+	proto.RegisterType((*QueryEmissionSchedulesResponse)(nil), "cosmos.mint.v1beta1.QueryEmissionSchedulesResponse")
+	proto.RegisterType((*QueryDenomMinterRequest)(nil), "cosmos.mint.v1beta1.QueryDenomMinterRequest")
+	proto.RegisterType((*QueryDenomMinterResponse)(nil), "cosmos.mint.v1beta1.QueryDenomMinterResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/query.proto", fileDescriptor_d0a1e393be338aea) }
 
 var fileDescriptor_d0a1e393be338aea = []byte{
-	// 480 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4d, 0x8b, 0xd3, 0x40,
-	0x18, 0xc7, 0x33, 0x8a, 0x85, 0x8e, 0x1e, 0x76, 0x67, 0xd7, 0x97, 0x4d, 0x77, 0xa7, 0x4b, 0x84,
-	0xb5, 0xac, 0x38, 0x43, 0xbb, 0xe0, 0x51, 0xb0, 0xec, 0x45, 0xf0, 0xb0, 0x2e, 0x7a, 0xf1, 0x22,
-	0xb3, 0x71, 0x8c, 0xc1, 0x66, 0x26, 0x9b, 0x99, 0x16, 0x7b, 0x13, 0xf1, 0xe8, 0x41, 0xf0, 0x4b,
-	0xe8, 0xcd, 0x83, 0x5f, 0x41, 0xe8, 0xb1, 0xe8, 0x45, 0x3c, 0x14, 0x69, 0x05, 0xbf, 0x86, 0x64,
-	0x66, 0x5a, 0x69, 0x9a, 0xa0, 0xe2, 0x25, 0x24, 0xcf, 0xdb, 0xff, 0x37, 0xcf, 0x7f, 0x02, 0x9b,
-	0xa1, 0x54, 0x89, 0x54, 0x34, 0x89, 0x85, 0xa6, 0x83, 0xf6, 0x09, 0xd7, 0xac, 0x4d, 0x4f, 0xfb,
-	0x3c, 0x1b, 0x92, 0x34, 0x93, 0x5a, 0xa2, 0x0d, 0x5b, 0x40, 0xf2, 0x02, 0xe2, 0x0a, 0xfc, 0xcd,
-	0x48, 0x46, 0xd2, 0xe4, 0x69, 0xfe, 0x66, 0x4b, 0xfd, 0xed, 0x48, 0xca, 0xa8, 0xc7, 0x29, 0x4b,
-	0x63, 0xca, 0x84, 0x90, 0x9a, 0xe9, 0x58, 0x0a, 0xe5, 0xb2, 0xb8, 0x4c, 0xc9, 0x4c, 0xb5, 0xf9,
-	0x75, 0x96, 0xc4, 0x42, 0x52, 0xf3, 0x74, 0xa1, 0x2d, 0xdb, 0xf2, 0xc8, 0x2a, 0x39, 0x10, 0xf3,
-	0x11, 0x6c, 0x42, 0x74, 0x2f, 0xa7, 0x3c, 0x62, 0x19, 0x4b, 0xd4, 0x31, 0x3f, 0xed, 0x73, 0xa5,
-	0x83, 0x07, 0x70, 0x63, 0x29, 0xaa, 0x52, 0x29, 0x14, 0x47, 0xb7, 0x60, 0x2d, 0x35, 0x91, 0x2b,
-	0x60, 0x17, 0xb4, 0xce, 0x77, 0x1a, 0xa4, 0xe4, 0x50, 0xc4, 0x36, 0x75, 0xeb, 0xa3, 0x49, 0xd3,
-	0x7b, 0xf7, 0xf3, 0xc3, 0x3e, 0x38, 0x76, 0x5d, 0xc1, 0x65, 0x78, 0xd1, 0x8c, 0xbd, 0x23, 0x9e,
-	0xf4, 0xcc, 0x99, 0xe6, 0x7a, 0x02, 0x5e, 0x2a, 0x26, 0x9c, 0xe4, 0x7d, 0x58, 0x8f, 0xe7, 0x41,
-	0xa3, 0x7a, 0xa1, 0x7b, 0x33, 0x1f, 0xfc, 0x6d, 0xd2, 0x6c, 0x58, 0x71, 0xf5, 0xf8, 0x19, 0x89,
-	0x25, 0x4d, 0x98, 0x7e, 0x4a, 0xee, 0xf2, 0x88, 0x85, 0xc3, 0x43, 0x1e, 0x7e, 0xfe, 0x78, 0x03,
-	0x3a, 0xb6, 0x43, 0x1e, 0x5a, 0x8a, 0xdf, 0x83, 0x02, 0x0c, 0xb7, 0x8d, 0xde, 0x6d, 0x21, 0xfa,
-	0xac, 0x77, 0x94, 0xc9, 0x41, 0xac, 0xf2, 0x15, 0xcf, 0x79, 0x5e, 0x01, 0xb8, 0x53, 0x51, 0xe0,
-	0xb8, 0x42, 0xb8, 0xce, 0x4c, 0x2e, 0x5f, 0xaa, 0x4b, 0xfe, 0x27, 0xdf, 0x1a, 0x2b, 0x88, 0x75,
-	0x3e, 0x9d, 0x85, 0xe7, 0x0c, 0x06, 0x7a, 0x01, 0x60, 0xcd, 0xee, 0x15, 0x5d, 0x2b, 0x5d, 0xfa,
-	0xaa, 0x89, 0x7e, 0xeb, 0xcf, 0x85, 0xf6, 0x30, 0xc1, 0xd5, 0x97, 0x5f, 0x7e, 0xbc, 0x3d, 0xb3,
-	0x83, 0x1a, 0xb4, 0xec, 0x6e, 0x59, 0xf3, 0xd0, 0x6b, 0x00, 0xeb, 0x0b, 0x7f, 0xd0, 0x7e, 0xf5,
-	0xf0, 0xa2, 0xbb, 0xfe, 0xf5, 0xbf, 0xaa, 0x75, 0x2c, 0x7b, 0x86, 0x65, 0x17, 0xe1, 0x52, 0x96,
-	0x85, 0x85, 0xe8, 0x3d, 0x80, 0x6b, 0x45, 0x77, 0x50, 0xbb, 0x5a, 0xa9, 0xc2, 0x6a, 0xbf, 0xf3,
-	0x2f, 0x2d, 0x8e, 0x91, 0x18, 0xc6, 0x16, 0xda, 0x2b, 0x65, 0x5c, 0xb9, 0x17, 0xdd, 0x83, 0xd1,
-	0x14, 0x83, 0xf1, 0x14, 0x83, 0xef, 0x53, 0x0c, 0xde, 0xcc, 0xb0, 0x37, 0x9e, 0x61, 0xef, 0xeb,
-	0x0c, 0x7b, 0x0f, 0xb7, 0x96, 0xee, 0xc8, 0x73, 0x3b, 0x48, 0x0f, 0x53, 0xae, 0x4e, 0x6a, 0xe6,
-	0x07, 0x3d, 0xf8, 0x15, 0x00, 0x00, 0xff, 0xff, 0x8c, 0x7d, 0x50, 0x82, 0x5a, 0x04, 0x00, 0x00,
+	// 701 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0x4f, 0x6b, 0xd4, 0x4e,
+	0x18, 0xc7, 0x77, 0xfa, 0xa3, 0x0b, 0xfb, 0xf4, 0x77, 0x68, 0xa7, 0xd5, 0xb6, 0x69, 0x9b, 0x2e,
+	0x11, 0xdb, 0x75, 0x6d, 0x13, 0xba, 0x05, 0x8f, 0x82, 0xb5, 0x2a, 0x82, 0x4a, 0x5d, 0xf5, 0xe2,
+	0x65, 0x99, 0xa6, 0x63, 0x1a, 0xdc, 0xcc, 0xa4, 0x3b, 0xd9, 0x62, 0x11, 0x41, 0xc4, 0xa3, 0x07,
+	0x41, 0xf0, 0xe8, 0x59, 0x0f, 0x82, 0x07, 0x2f, 0xbe, 0x83, 0x1e, 0x8b, 0x5e, 0xc4, 0x43, 0x91,
+	0xae, 0xe0, 0xdb, 0x90, 0xcc, 0x4c, 0xf6, 0x6f, 0x62, 0x5b, 0xbc, 0x2c, 0xc9, 0x33, 0xcf, 0xf7,
+	0x79, 0x3e, 0x33, 0xf3, 0x7c, 0xb3, 0x30, 0xef, 0x72, 0x11, 0x70, 0xe1, 0x04, 0x3e, 0x8b, 0x9c,
+	0xdd, 0x95, 0x4d, 0x1a, 0x91, 0x15, 0x67, 0xa7, 0x49, 0x1b, 0x7b, 0x76, 0xd8, 0xe0, 0x11, 0xc7,
+	0xe3, 0x2a, 0xc1, 0x8e, 0x13, 0x6c, 0x9d, 0x60, 0x94, 0xb5, 0x6a, 0x93, 0x08, 0xaa, 0xb2, 0xdb,
+	0xda, 0x90, 0x78, 0x3e, 0x23, 0x91, 0xcf, 0x99, 0x2a, 0x60, 0x4c, 0x78, 0xdc, 0xe3, 0xf2, 0xd1,
+	0x89, 0x9f, 0x74, 0x74, 0xd6, 0xe3, 0xdc, 0xab, 0x53, 0x87, 0x84, 0xbe, 0x43, 0x18, 0xe3, 0x91,
+	0x94, 0x08, 0xbd, 0x6a, 0xa6, 0x51, 0x49, 0x02, 0xb5, 0x3e, 0x46, 0x02, 0x9f, 0x71, 0x47, 0xfe,
+	0xea, 0xd0, 0xb4, 0x92, 0xd4, 0x54, 0x27, 0x0d, 0x2d, 0x5f, 0xac, 0x09, 0xc0, 0x77, 0x63, 0xc6,
+	0x0d, 0xd2, 0x20, 0x81, 0xa8, 0xd2, 0x9d, 0x26, 0x15, 0x91, 0xf5, 0x00, 0xc6, 0x7b, 0xa2, 0x22,
+	0xe4, 0x4c, 0x50, 0x7c, 0x19, 0xf2, 0xa1, 0x8c, 0x4c, 0xa1, 0x22, 0x2a, 0x8d, 0x54, 0x66, 0xec,
+	0x94, 0x03, 0xb0, 0x95, 0x68, 0xad, 0xb0, 0x7f, 0x38, 0x9f, 0x7b, 0xff, 0xfb, 0x53, 0x19, 0x55,
+	0xb5, 0xca, 0x9a, 0x84, 0x33, 0xb2, 0xec, 0x4d, 0xf6, 0xa8, 0x2e, 0xf7, 0x94, 0xf4, 0x63, 0x70,
+	0xb6, 0x7f, 0x41, 0xb7, 0xbc, 0x0f, 0x05, 0x3f, 0x09, 0xca, 0xae, 0xff, 0xaf, 0x5d, 0x8a, 0x0b,
+	0xff, 0x38, 0x9c, 0x9f, 0x51, 0xcd, 0xc5, 0xd6, 0x63, 0xdb, 0xe7, 0x4e, 0x40, 0xa2, 0x6d, 0xfb,
+	0x16, 0xf5, 0x88, 0xbb, 0xb7, 0x4e, 0xdd, 0xaf, 0x9f, 0x97, 0x41, 0xb3, 0xad, 0x53, 0x57, 0x51,
+	0x74, 0x0a, 0x59, 0x26, 0xcc, 0xca, 0x7e, 0x57, 0x18, 0x6b, 0x92, 0xfa, 0x46, 0x83, 0xef, 0xfa,
+	0x22, 0x3e, 0xe2, 0x84, 0xe7, 0x25, 0x82, 0xb9, 0x8c, 0x04, 0xcd, 0xe5, 0xc2, 0x18, 0x91, 0x6b,
+	0xb5, 0xb0, 0xbd, 0xf8, 0x8f, 0x7c, 0xa3, 0xa4, 0xaf, 0x99, 0xe5, 0x69, 0x8a, 0x6b, 0x81, 0x2f,
+	0xe2, 0xc8, 0x3d, 0x77, 0x9b, 0x6e, 0x35, 0xeb, 0x34, 0xe1, 0xc4, 0xd7, 0x01, 0x3a, 0x33, 0xa5,
+	0x2f, 0x65, 0x21, 0xb9, 0x94, 0x78, 0x00, 0x6d, 0x35, 0xae, 0x9d, 0xab, 0xf1, 0xa8, 0xd6, 0x56,
+	0xbb, 0x94, 0xd6, 0x17, 0x04, 0x66, 0x56, 0x27, 0xbd, 0xe1, 0x3b, 0x50, 0x10, 0x49, 0x70, 0x0a,
+	0x15, 0xff, 0x2b, 0x8d, 0x54, 0xce, 0xa7, 0x5e, 0x7f, 0x7f, 0x89, 0xee, 0x41, 0xe8, 0x94, 0xc0,
+	0x37, 0x7a, 0xd0, 0x87, 0x24, 0xfa, 0xe2, 0xb1, 0xe8, 0x0a, 0xa6, 0x87, 0xdd, 0x81, 0x49, 0x89,
+	0xbe, 0x4e, 0x19, 0x0f, 0x6e, 0xfb, 0x2c, 0xa2, 0x8d, 0xe4, 0x78, 0x26, 0x60, 0x78, 0x2b, 0x8e,
+	0xca, 0x93, 0x29, 0x54, 0xd5, 0x8b, 0x55, 0x83, 0xa9, 0x41, 0x81, 0xde, 0xe5, 0x55, 0xc8, 0x07,
+	0x32, 0xa2, 0x0f, 0xb3, 0x98, 0xba, 0xc5, 0x2e, 0x65, 0xcf, 0x98, 0x2b, 0x69, 0xe5, 0x6d, 0x1e,
+	0x86, 0x65, 0x07, 0xfc, 0x1c, 0x41, 0x5e, 0xd9, 0x01, 0x2f, 0xa6, 0x56, 0x1a, 0xf4, 0x9e, 0x51,
+	0x3a, 0x3e, 0x51, 0xc1, 0x5a, 0xe7, 0x5e, 0x7c, 0xfb, 0xf5, 0x66, 0x68, 0x0e, 0xcf, 0x38, 0x69,
+	0x9f, 0x04, 0xe5, 0x39, 0xfc, 0x0a, 0x41, 0xa1, 0x6d, 0x2b, 0x5c, 0xce, 0x2e, 0xde, 0x6f, 0x4a,
+	0xe3, 0xe2, 0x89, 0x72, 0x35, 0xcb, 0x82, 0x64, 0x29, 0x62, 0x33, 0x95, 0xa5, 0xed, 0x3c, 0xfc,
+	0x01, 0xc1, 0x68, 0xbf, 0xa9, 0xf0, 0x4a, 0x76, 0xa7, 0x0c, 0x87, 0x1a, 0x95, 0xd3, 0x48, 0x34,
+	0xa3, 0x2d, 0x19, 0x4b, 0x78, 0x21, 0x95, 0x71, 0xc0, 0xce, 0xf8, 0x23, 0x82, 0xb1, 0x01, 0x43,
+	0xe0, 0xbf, 0x74, 0xce, 0xf2, 0xa9, 0xb1, 0x7a, 0x2a, 0x8d, 0xc6, 0x75, 0x24, 0xee, 0x05, 0xbc,
+	0x98, 0x8a, 0x4b, 0xb5, 0xae, 0xd6, 0xb1, 0xd4, 0x3b, 0x04, 0x23, 0x5d, 0xa3, 0x89, 0x97, 0xb2,
+	0xbb, 0x0e, 0x9a, 0xc5, 0x58, 0x3e, 0x61, 0xb6, 0xa6, 0xab, 0x48, 0xba, 0x25, 0x5c, 0x4e, 0xa5,
+	0x93, 0x4e, 0xab, 0x29, 0x3f, 0x08, 0xe7, 0xa9, 0x7c, 0x7d, 0xb6, 0xb6, 0xba, 0x7f, 0x64, 0xa2,
+	0x83, 0x23, 0x13, 0xfd, 0x3c, 0x32, 0xd1, 0xeb, 0x96, 0x99, 0x3b, 0x68, 0x99, 0xb9, 0xef, 0x2d,
+	0x33, 0xf7, 0x70, 0xba, 0xe7, 0x5b, 0xf9, 0x44, 0x15, 0x8b, 0xf6, 0x42, 0x2a, 0x36, 0xf3, 0xf2,
+	0x8f, 0x6a, 0xf5, 0xcf, 0x00, 0x4a, 0x2e, 0x5d, 0x1c, 0x8e, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -333,6 +549,11 @@ type QueryClient interface {
 	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// EmissionSchedules returns the emission schedules of the denoms minted in
+	// addition to the mint denom.
+	EmissionSchedules(ctx context.Context, in *QueryEmissionSchedulesRequest, opts ...grpc.CallOption) (*QueryEmissionSchedulesResponse, error)
+	// DenomMinter returns the minting state of a denom with an emission schedule.
+	DenomMinter(ctx context.Context, in *QueryDenomMinterRequest, opts ...grpc.CallOption) (*QueryDenomMinterResponse, error)
 }
 
 type queryClient struct {
@@ -370,6 +591,24 @@ func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvi
 	return out, nil
 }
 
+func (c *queryClient) EmissionSchedules(ctx context.Context, in *QueryEmissionSchedulesRequest, opts ...grpc.CallOption) (*QueryEmissionSchedulesResponse, error) {
+	out := new(QueryEmissionSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/EmissionSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomMinter(ctx context.Context, in *QueryDenomMinterRequest, opts ...grpc.CallOption) (*QueryDenomMinterResponse, error) {
+	out := new(QueryDenomMinterResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/DenomMinter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of minting parameters.
@@ -378,6 +617,11 @@ type QueryServer interface {
 	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// EmissionSchedules returns the emission schedules of the denoms minted in
+	// addition to the mint denom.
+	EmissionSchedules(context.Context, *QueryEmissionSchedulesRequest) (*QueryEmissionSchedulesResponse, error)
+	// DenomMinter returns the minting state of a denom with an emission schedule.
+	DenomMinter(context.Context, *QueryDenomMinterRequest) (*QueryDenomMinterResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -393,6 +637,12 @@ func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflat
 func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
 }
+func (*UnimplementedQueryServer) EmissionSchedules(ctx context.Context, req *QueryEmissionSchedulesRequest) (*QueryEmissionSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmissionSchedules not implemented")
+}
+func (*UnimplementedQueryServer) DenomMinter(ctx context.Context, req *QueryDenomMinterRequest) (*QueryDenomMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomMinter not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -452,6 +702,42 @@ func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EmissionSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmissionSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EmissionSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/EmissionSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EmissionSchedules(ctx, req.(*QueryEmissionSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomMinter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomMinterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomMinter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/DenomMinter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomMinter(ctx, req.(*QueryDenomMinterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.mint.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -468,6 +754,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AnnualProvisions",
 			Handler:    _Query_AnnualProvisions_Handler,
 		},
+		{
+			MethodName: "EmissionSchedules",
+			Handler:    _Query_EmissionSchedules_Handler,
+		},
+		{
+			MethodName: "DenomMinter",
+			Handler:    _Query_DenomMinter_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/query.proto",
@@ -641,78 +935,281 @@ func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryEmissionSchedulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryEmissionSchedulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryEmissionSchedulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryInflationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEmissionSchedulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryInflationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Inflation.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryEmissionSchedulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAnnualProvisionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryEmissionSchedulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAnnualProvisionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDenomMinterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomMinterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomMinterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.AnnualProvisions.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
+func (m *QueryDenomMinterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomMinterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomMinterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Minter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInflationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInflationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAnnualProvisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAnnualProvisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEmissionSchedulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEmissionSchedulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomMinterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomMinterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Minter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
@@ -1117,6 +1614,377 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEmissionSchedulesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionSchedulesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionSchedulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmissionSchedulesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionSchedulesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionSchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, EmissionSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomMinterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomMinterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomMinterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomMinterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomMinterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomMinterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -130,37 +130,236 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetEmissionSchedule is the Msg/SetEmissionSchedule request type.
+type MsgSetEmissionSchedule struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// schedule defines the emission schedule to set.
+	Schedule EmissionSchedule `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule"`
+}
+
+func (m *MsgSetEmissionSchedule) Reset()         { *m = MsgSetEmissionSchedule{} }
+func (m *MsgSetEmissionSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgSetEmissionSchedule) ProtoMessage()    {}
+func (*MsgSetEmissionSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d933a8bf5e188a, []int{2}
+}
+func (m *MsgSetEmissionSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetEmissionSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetEmissionSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetEmissionSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetEmissionSchedule.Merge(m, src)
+}
+func (m *MsgSetEmissionSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetEmissionSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetEmissionSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetEmissionSchedule proto.InternalMessageInfo
+
+func (m *MsgSetEmissionSchedule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetEmissionSchedule) GetSchedule() EmissionSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return EmissionSchedule{}
+}
+
+// MsgSetEmissionScheduleResponse defines the response structure for executing
+// a MsgSetEmissionSchedule message.
+type MsgSetEmissionScheduleResponse struct {
+}
+
+func (m *MsgSetEmissionScheduleResponse) Reset()         { *m = MsgSetEmissionScheduleResponse{} }
+func (m *MsgSetEmissionScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetEmissionScheduleResponse) ProtoMessage()    {}
+func (*MsgSetEmissionScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d933a8bf5e188a, []int{3}
+}
+func (m *MsgSetEmissionScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetEmissionScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetEmissionScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetEmissionScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetEmissionScheduleResponse.Merge(m, src)
+}
+func (m *MsgSetEmissionScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetEmissionScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetEmissionScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetEmissionScheduleResponse proto.InternalMessageInfo
+
+// MsgRemoveEmissionSchedule is the Msg/RemoveEmissionSchedule request type.
+type MsgRemoveEmissionSchedule struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// denom is the denom of the emission schedule to remove.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgRemoveEmissionSchedule) Reset()         { *m = MsgRemoveEmissionSchedule{} }
+func (m *MsgRemoveEmissionSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveEmissionSchedule) ProtoMessage()    {}
+func (*MsgRemoveEmissionSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d933a8bf5e188a, []int{4}
+}
+func (m *MsgRemoveEmissionSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveEmissionSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveEmissionSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveEmissionSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveEmissionSchedule.Merge(m, src)
+}
+func (m *MsgRemoveEmissionSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveEmissionSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveEmissionSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveEmissionSchedule proto.InternalMessageInfo
+
+func (m *MsgRemoveEmissionSchedule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveEmissionSchedule) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgRemoveEmissionScheduleResponse defines the response structure for
+// executing a MsgRemoveEmissionSchedule message.
+type MsgRemoveEmissionScheduleResponse struct {
+}
+
+func (m *MsgRemoveEmissionScheduleResponse) Reset()         { *m = MsgRemoveEmissionScheduleResponse{} }
+func (m *MsgRemoveEmissionScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveEmissionScheduleResponse) ProtoMessage()    {}
+func (*MsgRemoveEmissionScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0d933a8bf5e188a, []int{5}
+}
+func (m *MsgRemoveEmissionScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveEmissionScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveEmissionScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveEmissionScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveEmissionScheduleResponse.Merge(m, src)
+}
+func (m *MsgRemoveEmissionScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveEmissionScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveEmissionScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveEmissionScheduleResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.mint.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.mint.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetEmissionSchedule)(nil), "cosmos.mint.v1beta1.MsgSetEmissionSchedule")
+	proto.RegisterType((*MsgSetEmissionScheduleResponse)(nil), "cosmos.mint.v1beta1.MsgSetEmissionScheduleResponse")
+	proto.RegisterType((*MsgRemoveEmissionSchedule)(nil), "cosmos.mint.v1beta1.MsgRemoveEmissionSchedule")
+	proto.RegisterType((*MsgRemoveEmissionScheduleResponse)(nil), "cosmos.mint.v1beta1.MsgRemoveEmissionScheduleResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/tx.proto", fileDescriptor_a0d933a8bf5e188a) }
 
 var fileDescriptor_a0d933a8bf5e188a = []byte{
-	// 337 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xc8, 0xea, 0x81, 0x64, 0xf5,
-	0xa0, 0xb2, 0x52, 0xe2, 0x30, 0x2d, 0xc5, 0xe9, 0xfa, 0x65, 0x86, 0x20, 0x0a, 0xa2, 0x5a, 0x4a,
-	0x30, 0x31, 0x37, 0x33, 0x2f, 0x5f, 0x1f, 0x4c, 0x42, 0x85, 0xe4, 0xb0, 0x19, 0x0f, 0x36, 0x0d,
-	0x22, 0x2f, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x66, 0xea, 0x83, 0x58, 0x50, 0x51, 0x49, 0x88, 0xae,
-	0x78, 0x88, 0x04, 0xd4, 0x0d, 0x60, 0x8e, 0xd2, 0x7e, 0x46, 0x2e, 0x7e, 0xdf, 0xe2, 0xf4, 0xd0,
-	0x82, 0x94, 0xc4, 0x92, 0xd4, 0x80, 0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x21, 0x33, 0x2e, 0xce, 0xc4,
-	0xd2, 0x92, 0x8c, 0xfc, 0xa2, 0xcc, 0x92, 0x4a, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x4e, 0x27, 0x89,
-	0x4b, 0x5b, 0x74, 0x45, 0xa0, 0x1a, 0x1d, 0x53, 0x52, 0x8a, 0x52, 0x8b, 0x8b, 0x83, 0x4b, 0x8a,
-	0x32, 0xf3, 0xd2, 0x83, 0x10, 0x4a, 0x85, 0xec, 0xb8, 0xd8, 0x0a, 0xc0, 0x26, 0x48, 0x30, 0x29,
-	0x30, 0x6a, 0x70, 0x1b, 0x49, 0xeb, 0x61, 0xf1, 0xae, 0x1e, 0xc4, 0x12, 0x27, 0xce, 0x13, 0xf7,
-	0xe4, 0x19, 0x56, 0x3c, 0xdf, 0xa0, 0xc5, 0x18, 0x04, 0xd5, 0x65, 0x65, 0xd2, 0xf4, 0x7c, 0x83,
-	0x16, 0xc2, 0xbc, 0xae, 0xe7, 0x1b, 0xb4, 0x14, 0x21, 0x26, 0xe8, 0x16, 0xa7, 0x64, 0xeb, 0x57,
-	0x40, 0x7c, 0x8d, 0xe6, 0x5a, 0x25, 0x49, 0x2e, 0x71, 0x34, 0xa1, 0xa0, 0xd4, 0xe2, 0x82, 0xfc,
-	0xbc, 0xe2, 0x54, 0xa3, 0x02, 0x2e, 0x66, 0xdf, 0xe2, 0x74, 0xa1, 0x24, 0x2e, 0x1e, 0x14, 0xff,
-	0xa9, 0x60, 0x75, 0x17, 0x9a, 0x21, 0x52, 0x3a, 0xc4, 0xa8, 0x82, 0x59, 0x25, 0xc5, 0xda, 0x00,
-	0xf2, 0x8a, 0x93, 0xf1, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7,
-	0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x41, 0xe3,
-	0xa0, 0x38, 0x25, 0x5b, 0x2f, 0x33, 0x1f, 0xe6, 0x97, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36,
-	0x70, 0x54, 0x18, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x7f, 0xe5, 0xfd, 0x6d, 0x3c, 0x02, 0x00,
-	0x00,
+	// 493 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x73, 0x45, 0xad, 0xc8, 0x81, 0x84, 0x70, 0xa3, 0x36, 0x31, 0xe8, 0x48, 0x5d, 0x90,
+	0xaa, 0x40, 0x6d, 0xa5, 0x41, 0x19, 0x32, 0x20, 0x11, 0x89, 0x8d, 0x48, 0xc8, 0x11, 0x0b, 0x0b,
+	0x72, 0xea, 0xd3, 0xd5, 0x2a, 0xf6, 0x59, 0x7e, 0xd7, 0xd0, 0x6e, 0x15, 0x23, 0x13, 0x5f, 0x81,
+	0x8d, 0x31, 0x03, 0x3b, 0x6b, 0xc7, 0x0a, 0x16, 0x26, 0x84, 0x92, 0x21, 0x0b, 0x1f, 0x02, 0xd9,
+	0x77, 0x4e, 0x5b, 0xf7, 0x2c, 0x51, 0xd4, 0x25, 0xb9, 0xbb, 0xf7, 0x7f, 0xff, 0xf7, 0x7e, 0xbe,
+	0xa7, 0xc3, 0xf7, 0x77, 0x39, 0x84, 0x1c, 0x9c, 0x30, 0x88, 0x84, 0x33, 0x6e, 0x8f, 0xa8, 0xf0,
+	0xda, 0x8e, 0x38, 0xb4, 0xe3, 0x84, 0x0b, 0x6e, 0xac, 0xca, 0xa8, 0x9d, 0x46, 0x6d, 0x15, 0x35,
+	0xd7, 0xf3, 0x14, 0x60, 0xce, 0xb8, 0x9d, 0xfe, 0x49, 0xb5, 0x79, 0xd7, 0x0b, 0x83, 0x88, 0x3b,
+	0xd9, 0xaf, 0x3a, 0x22, 0x3a, 0xfb, 0xcc, 0x4d, 0xc6, 0x6b, 0x8c, 0x33, 0x9e, 0x2d, 0x9d, 0x74,
+	0xa5, 0x4e, 0x1b, 0x32, 0xeb, 0xad, 0x0c, 0xa8, 0x1e, 0xb2, 0x8d, 0xf5, 0x0d, 0xe1, 0x3b, 0x03,
+	0x60, 0xaf, 0x63, 0xdf, 0x13, 0xf4, 0x95, 0x97, 0x78, 0x21, 0x18, 0x5d, 0x5c, 0xf5, 0x0e, 0xc4,
+	0x1e, 0x4f, 0x02, 0x71, 0x54, 0x47, 0x4d, 0xb4, 0x55, 0xed, 0xd7, 0xbf, 0x7f, 0xdd, 0xae, 0xa9,
+	0xc4, 0xe7, 0xbe, 0x9f, 0x50, 0x80, 0xa1, 0x48, 0x82, 0x88, 0xb9, 0x67, 0x52, 0xe3, 0x19, 0x5e,
+	0x89, 0x33, 0x87, 0xfa, 0x52, 0x13, 0x6d, 0xdd, 0xda, 0xb9, 0x67, 0x6b, 0x70, 0x6d, 0x59, 0xa4,
+	0x5f, 0x3d, 0xf9, 0xf5, 0xa0, 0xf2, 0x65, 0x3e, 0x69, 0x21, 0x57, 0x65, 0xf5, 0x9e, 0x7e, 0x98,
+	0x4f, 0x5a, 0x67, 0x7e, 0x1f, 0xe7, 0x93, 0xd6, 0x86, 0x74, 0xd8, 0x06, 0x7f, 0xdf, 0x39, 0x94,
+	0xd4, 0x85, 0x6e, 0xad, 0x06, 0x5e, 0x2f, 0x1c, 0xb9, 0x14, 0x62, 0x1e, 0x01, 0xb5, 0x7e, 0x20,
+	0xbc, 0x36, 0x00, 0x36, 0xa4, 0xe2, 0x45, 0x18, 0x00, 0x04, 0x3c, 0x1a, 0xee, 0xee, 0x51, 0xff,
+	0xe0, 0x1d, 0xfd, 0x6f, 0xc6, 0x97, 0xf8, 0x26, 0x28, 0x0f, 0x45, 0xf9, 0x48, 0x4b, 0x59, 0x2c,
+	0x78, 0x9e, 0x77, 0xe1, 0xd0, 0xeb, 0x5c, 0x26, 0x6e, 0x6a, 0x89, 0xcf, 0x21, 0x58, 0x4d, 0x4c,
+	0xf4, 0x50, 0x0b, 0xee, 0xcf, 0x08, 0x37, 0x06, 0xc0, 0x5c, 0x1a, 0xf2, 0x31, 0xbd, 0x36, 0xf4,
+	0x1a, 0x5e, 0xf6, 0x69, 0xc4, 0xc3, 0x8c, 0xbb, 0xea, 0xca, 0x4d, 0xaf, 0x7b, 0x19, 0x61, 0x53,
+	0x8b, 0x70, 0xb1, 0x1b, 0x6b, 0x13, 0x6f, 0x94, 0xb6, 0x98, 0x83, 0xec, 0xfc, 0x59, 0xc2, 0x37,
+	0x06, 0xc0, 0x8c, 0x11, 0xbe, 0x7d, 0x61, 0x42, 0x1f, 0x6a, 0xbf, 0x79, 0x61, 0x0c, 0xcc, 0x27,
+	0xff, 0xa2, 0xca, 0x6b, 0x19, 0xef, 0xf1, 0xaa, 0x6e, 0x50, 0x1e, 0x97, 0x99, 0x68, 0xc4, 0x66,
+	0xe7, 0x0a, 0xe2, 0x45, 0xe1, 0x63, 0x84, 0xd7, 0x4a, 0xae, 0xca, 0x2e, 0xf3, 0xd3, 0xeb, 0xcd,
+	0xee, 0xd5, 0xf4, 0x79, 0x0b, 0xe6, 0xf2, 0x71, 0x3a, 0x98, 0xfd, 0xce, 0xc9, 0x94, 0xa0, 0xd3,
+	0x29, 0x41, 0xbf, 0xa7, 0x04, 0x7d, 0x9a, 0x91, 0xca, 0xe9, 0x8c, 0x54, 0x7e, 0xce, 0x48, 0xe5,
+	0x8d, 0x7a, 0x41, 0xc0, 0xdf, 0xb7, 0x03, 0x9e, 0x5f, 0xaa, 0x38, 0x8a, 0x29, 0x8c, 0x56, 0xb2,
+	0x87, 0xa4, 0xf3, 0x77, 0x00, 0xee, 0xc5, 0x23, 0x29, 0xfa, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -180,6 +379,13 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetEmissionSchedule defines a governance operation for setting the emission
+	// schedule of a denom minted in addition to the mint denom. The minting state
+	// of the denom is reset to the initial annual provisions of the schedule.
+	SetEmissionSchedule(ctx context.Context, in *MsgSetEmissionSchedule, opts ...grpc.CallOption) (*MsgSetEmissionScheduleResponse, error)
+	// RemoveEmissionSchedule defines a governance operation for removing the
+	// emission schedule of a denom, which stops minting it.
+	RemoveEmissionSchedule(ctx context.Context, in *MsgRemoveEmissionSchedule, opts ...grpc.CallOption) (*MsgRemoveEmissionScheduleResponse, error)
 }
 
 type msgClient struct {
@@ -199,6 +405,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetEmissionSchedule(ctx context.Context, in *MsgSetEmissionSchedule, opts ...grpc.CallOption) (*MsgSetEmissionScheduleResponse, error) {
+	out := new(MsgSetEmissionScheduleResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Msg/SetEmissionSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveEmissionSchedule(ctx context.Context, in *MsgRemoveEmissionSchedule, opts ...grpc.CallOption) (*MsgRemoveEmissionScheduleResponse, error) {
+	out := new(MsgRemoveEmissionScheduleResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Msg/RemoveEmissionSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/mint module
@@ -206,6 +430,13 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetEmissionSchedule defines a governance operation for setting the emission
+	// schedule of a denom minted in addition to the mint denom. The minting state
+	// of the denom is reset to the initial annual provisions of the schedule.
+	SetEmissionSchedule(context.Context, *MsgSetEmissionSchedule) (*MsgSetEmissionScheduleResponse, error)
+	// RemoveEmissionSchedule defines a governance operation for removing the
+	// emission schedule of a denom, which stops minting it.
+	RemoveEmissionSchedule(context.Context, *MsgRemoveEmissionSchedule) (*MsgRemoveEmissionScheduleResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -215,6 +446,12 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetEmissionSchedule(ctx context.Context, req *MsgSetEmissionSchedule) (*MsgSetEmissionScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEmissionSchedule not implemented")
+}
+func (*UnimplementedMsgServer) RemoveEmissionSchedule(ctx context.Context, req *MsgRemoveEmissionSchedule) (*MsgRemoveEmissionScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveEmissionSchedule not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -238,6 +475,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetEmissionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetEmissionSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetEmissionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Msg/SetEmissionSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetEmissionSchedule(ctx, req.(*MsgSetEmissionSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveEmissionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveEmissionSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveEmissionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Msg/RemoveEmissionSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveEmissionSchedule(ctx, req.(*MsgRemoveEmissionSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.mint.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -246,6 +519,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetEmissionSchedule",
+			Handler:    _Msg_SetEmissionSchedule_Handler,
+		},
+		{
+			MethodName: "RemoveEmissionSchedule",
+			Handler:    _Msg_RemoveEmissionSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/tx.proto",
@@ -314,69 +595,242 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetEmissionSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetEmissionSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetEmissionSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetEmissionScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetEmissionScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetEmissionScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgRemoveEmissionSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgRemoveEmissionSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
+
+func (m *MsgRemoveEmissionSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveEmissionScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveEmissionScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveEmissionScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetEmissionSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Schedule.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetEmissionScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveEmissionSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveEmissionScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
 		if wireType == 4 {
 			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
@@ -520,6 +974,335 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetEmissionSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetEmissionSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetEmissionSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetEmissionScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetEmissionScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetEmissionScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveEmissionSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveEmissionSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveEmissionSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveEmissionScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveEmissionScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveEmissionScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
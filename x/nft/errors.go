@@ -12,4 +12,7 @@ var (
 	ErrNFTNotExists   = errors.Register(ModuleName, 6, "nft does not exist")
 	ErrEmptyClassID   = errors.Register(ModuleName, 7, "empty class id")
 	ErrEmptyNFTID     = errors.Register(ModuleName, 8, "empty nft id")
+	ErrInvalidLease   = errors.Register(ModuleName, 9, "invalid lease")
+	ErrNFTLeased      = errors.Register(ModuleName, 10, "nft is leased")
+	ErrLeaseNotExists = errors.Register(ModuleName, 11, "lease does not exist")
 )
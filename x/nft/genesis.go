@@ -21,6 +21,17 @@ func ValidateGenesis(data GenesisState, ac address.Codec) error {
 			}
 		}
 	}
+	for _, lease := range data.Leases {
+		if len(lease.ClassId) == 0 {
+			return ErrEmptyClassID
+		}
+		if len(lease.Id) == 0 {
+			return ErrEmptyNFTID
+		}
+		if _, err := ac.StringToBytes(lease.Lessee); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -27,6 +27,9 @@ func (k Keeper) InitGenesis(ctx context.Context, data *nft.GenesisState) {
 			}
 		}
 	}
+	for _, lease := range data.Leases {
+		k.setLease(ctx, *lease)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context.
@@ -65,5 +68,6 @@ func (k Keeper) ExportGenesis(ctx context.Context) *nft.GenesisState {
 	return &nft.GenesisState{
 		Classes: classes,
 		Entries: entries,
+		Leases:  k.GetLeases(ctx),
 	}
 }
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
 	"cosmossdk.io/x/nft"
 
@@ -259,3 +260,54 @@ func (k Keeper) Classes(ctx context.Context, r *nft.QueryClassesRequest) (*nft.Q
 		Pagination: pageRes,
 	}, nil
 }
+
+// Lease return the active lease of an NFT based on its class and id.
+func (k Keeper) Lease(ctx context.Context, r *nft.QueryLeaseRequest) (*nft.QueryLeaseResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(r.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	lease, has := k.GetLease(ctx, r.ClassId, r.Id)
+	if !has {
+		return nil, errorsmod.Wrap(nft.ErrLeaseNotExists, r.Id)
+	}
+	return &nft.QueryLeaseResponse{Lease: &lease}, nil
+}
+
+// Leases return the active leases of NFTs granted to a lessee.
+func (k Keeper) Leases(ctx context.Context, r *nft.QueryLeasesRequest) (*nft.QueryLeasesResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	lessee, err := k.ac.StringToBytes(r.Lessee)
+	if err != nil {
+		return nil, err
+	}
+
+	var leases []*nft.Lease
+	pageRes, err := query.Paginate(k.prefixStoreLeaseByLessee(ctx, lessee), r.Pagination, func(key, _ []byte) error {
+		classID, nftID := parseNftOfClassByOwnerStoreKey(key)
+		// the expired leases not removed yet are skipped
+		if lease, has := k.GetLease(ctx, classID, nftID); has {
+			leases = append(leases, &lease)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &nft.QueryLeasesResponse{
+		Leases:     leases,
+		Pagination: pageRes,
+	}, nil
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"bytes"
+	"time"
 
 	"cosmossdk.io/x/nft"
 	"cosmossdk.io/x/nft/internal/conv"
@@ -16,6 +17,9 @@ var (
 	NFTOfClassByOwnerKey = []byte{0x03}
 	OwnerKey             = []byte{0x04}
 	ClassTotalSupply     = []byte{0x05}
+	LeaseKey             = []byte{0x06}
+	LeaseByLesseeKey     = []byte{0x07}
+	LeaseQueueKey        = []byte{0x08}
 
 	Delimiter   = []byte{0x00}
 	Placeholder = []byte{0x01}
@@ -105,3 +109,82 @@ func ownerStoreKey(classID, nftID string) []byte {
 	copy(key[len(OwnerKey)+len(classIDBz)+len(Delimiter):], nftIDBz)
 	return key
 }
+
+// leaseStoreKey returns the byte representation of the nft lease key
+// Items are stored with the following key: values
+// 0x06<classID><Delimiter(1 Byte)><nftID>
+func leaseStoreKey(classID, nftID string) []byte {
+	classIDBz := conv.UnsafeStrToBytes(classID)
+	nftIDBz := conv.UnsafeStrToBytes(nftID)
+
+	key := make([]byte, len(LeaseKey)+len(classIDBz)+len(Delimiter)+len(nftIDBz))
+	copy(key, LeaseKey)
+	copy(key[len(LeaseKey):], classIDBz)
+	copy(key[len(LeaseKey)+len(classIDBz):], Delimiter)
+	copy(key[len(LeaseKey)+len(classIDBz)+len(Delimiter):], nftIDBz)
+	return key
+}
+
+// leaseByLesseeStoreKey returns the byte representation of the nft lease by lessee key
+// Items are stored with the following key: values
+// 0x07<lessee><Delimiter(1 Byte)><classID><Delimiter(1 Byte)><nftID>
+func leaseByLesseeStoreKey(lessee sdk.AccAddress, classID, nftID string) []byte {
+	prefix := prefixLeaseByLesseeStoreKey(lessee)
+	classIDBz := conv.UnsafeStrToBytes(classID)
+	nftIDBz := conv.UnsafeStrToBytes(nftID)
+
+	key := make([]byte, len(prefix)+len(classIDBz)+len(Delimiter)+len(nftIDBz))
+	copy(key, prefix)
+	copy(key[len(prefix):], classIDBz)
+	copy(key[len(prefix)+len(classIDBz):], Delimiter)
+	copy(key[len(prefix)+len(classIDBz)+len(Delimiter):], nftIDBz)
+	return key
+}
+
+// prefixLeaseByLesseeStoreKey returns the prefix of the result of the method leaseByLesseeStoreKey
+// Items are stored with the following key: values
+// 0x07<lessee><Delimiter>
+func prefixLeaseByLesseeStoreKey(lessee sdk.AccAddress) []byte {
+	lessee = address.MustLengthPrefix(lessee)
+
+	key := make([]byte, len(LeaseByLesseeKey)+len(lessee)+len(Delimiter))
+	copy(key, LeaseByLesseeKey)
+	copy(key[len(LeaseByLesseeKey):], lessee)
+	copy(key[len(LeaseByLesseeKey)+len(lessee):], Delimiter)
+	return key
+}
+
+// leaseQueueStoreKey returns the byte representation of the nft lease expiration queue key
+// Items are stored with the following key: values
+// 0x08<expiration><Delimiter(1 Byte)><classID><Delimiter(1 Byte)><nftID>
+func leaseQueueStoreKey(expiration time.Time, classID, nftID string) []byte {
+	expirationBz := sdk.FormatTimeBytes(expiration)
+	classIDBz := conv.UnsafeStrToBytes(classID)
+	nftIDBz := conv.UnsafeStrToBytes(nftID)
+
+	key := make([]byte, len(LeaseQueueKey)+len(expirationBz)+len(Delimiter)+len(classIDBz)+len(Delimiter)+len(nftIDBz))
+	copy(key, LeaseQueueKey)
+	copy(key[len(LeaseQueueKey):], expirationBz)
+	copy(key[len(LeaseQueueKey)+len(expirationBz):], Delimiter)
+	copy(key[len(LeaseQueueKey)+len(expirationBz)+len(Delimiter):], classIDBz)
+	copy(key[len(LeaseQueueKey)+len(expirationBz)+len(Delimiter)+len(classIDBz):], Delimiter)
+	copy(key[len(LeaseQueueKey)+len(expirationBz)+len(Delimiter)+len(classIDBz)+len(Delimiter):], nftIDBz)
+	return key
+}
+
+// leaseQueueEndKey returns the end, exclusive, of the range of the lease queue keys
+// expiring at or before the given time, without the LeaseQueueKey prefix
+func leaseQueueEndKey(t time.Time) []byte {
+	return append(sdk.FormatTimeBytes(t), Placeholder...)
+}
+
+// parseLeaseQueueStoreKey parses the key of the lease expiration queue, without the LeaseQueueKey prefix
+func parseLeaseQueueStoreKey(key []byte) (classID, nftID string) {
+	ret := bytes.Split(key, Delimiter)
+	if len(ret) != 3 {
+		panic("invalid leaseQueueStoreKey")
+	}
+	classID = string(ret[1])
+	nftID = string(ret[2])
+	return
+}
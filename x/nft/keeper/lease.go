@@ -0,0 +1,230 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"cosmossdk.io/x/nft"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LeaseNFT defines a method for granting the usage rights of a nft to a lessee until an expiration time.
+// The nft cannot be transferred nor burned until the lease ends.
+// Note: When the upper module uses this method, it needs to authenticate nft
+func (k Keeper) LeaseNFT(ctx context.Context, classID, nftID string, lessee sdk.AccAddress, expiration time.Time) error {
+	if !k.HasClass(ctx, classID) {
+		return errors.Wrap(nft.ErrClassNotExists, classID)
+	}
+
+	if !k.HasNFT(ctx, classID, nftID) {
+		return errors.Wrap(nft.ErrNFTNotExists, nftID)
+	}
+
+	owner := k.GetOwner(ctx, classID, nftID)
+	if owner.Equals(lessee) {
+		return errors.Wrap(nft.ErrInvalidLease, "the owner cannot lease its own nft")
+	}
+
+	if !expiration.After(k.env.HeaderService.GetHeaderInfo(ctx).Time) {
+		return errors.Wrapf(nft.ErrInvalidLease, "expiration %s is not in the future", expiration)
+	}
+
+	if _, has := k.GetLease(ctx, classID, nftID); has {
+		return errors.Wrap(nft.ErrNFTLeased, nftID)
+	}
+
+	// a lease expired in the current block may not have been removed yet
+	if lease, has := k.getLease(ctx, classID, nftID); has {
+		k.deleteLease(ctx, lease)
+	}
+
+	ownerStr, err := k.ac.BytesToString(owner)
+	if err != nil {
+		return err
+	}
+
+	lesseeStr, err := k.ac.BytesToString(lessee)
+	if err != nil {
+		return err
+	}
+
+	k.setLease(ctx, nft.Lease{
+		ClassId:    classID,
+		Id:         nftID,
+		Lessee:     lesseeStr,
+		Expiration: expiration,
+	})
+
+	return k.env.EventService.EventManager(ctx).Emit(&nft.EventGrantLease{
+		ClassId:    classID,
+		Id:         nftID,
+		Owner:      ownerStr,
+		Lessee:     lesseeStr,
+		Expiration: expiration,
+	})
+}
+
+// ReturnNFT defines a method for ending the active lease of a nft before its expiration,
+// returning its usage rights to its owner.
+// Note: When the upper module uses this method, it needs to authenticate the lessee
+func (k Keeper) ReturnNFT(ctx context.Context, classID, nftID string) error {
+	lease, has := k.GetLease(ctx, classID, nftID)
+	if !has {
+		return errors.Wrap(nft.ErrLeaseNotExists, nftID)
+	}
+
+	k.deleteLease(ctx, lease)
+
+	return k.env.EventService.EventManager(ctx).Emit(&nft.EventEndLease{
+		ClassId: classID,
+		Id:      nftID,
+		Lessee:  lease.Lessee,
+	})
+}
+
+// GetLease returns the active lease of the specified classID and nftID, if any
+func (k Keeper) GetLease(ctx context.Context, classID, nftID string) (nft.Lease, bool) {
+	lease, has := k.getLease(ctx, classID, nftID)
+	if !has || !lease.Expiration.After(k.env.HeaderService.GetHeaderInfo(ctx).Time) {
+		return nft.Lease{}, false
+	}
+	return lease, true
+}
+
+// GetUser returns the address holding the usage rights of the specified nft,
+// the lessee of its active lease if any and its owner otherwise
+func (k Keeper) GetUser(ctx context.Context, classID, nftID string) sdk.AccAddress {
+	lease, has := k.GetLease(ctx, classID, nftID)
+	if !has {
+		return k.GetOwner(ctx, classID, nftID)
+	}
+
+	lessee, err := k.ac.StringToBytes(lease.Lessee)
+	if err != nil {
+		panic(err)
+	}
+	return lessee
+}
+
+// HasUsageRights determines whether the specified address holds the usage rights of the specified nft.
+// Modules gating features on nfts, e.g. in-game assets, should check the usage rights rather than the ownership.
+func (k Keeper) HasUsageRights(ctx context.Context, classID, nftID string, addr sdk.AccAddress) bool {
+	user := k.GetUser(ctx, classID, nftID)
+	return !user.Empty() && bytes.Equal(user, addr)
+}
+
+// GetLeases returns all the leases, including the expired ones not removed yet
+func (k Keeper) GetLeases(ctx context.Context) (leases []*nft.Lease) {
+	store := k.env.KVStoreService.OpenKVStore(ctx)
+	leaseStore := prefix.NewStore(runtime.KVStoreAdapter(store), LeaseKey)
+	iterator := leaseStore.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var lease nft.Lease
+		k.cdc.MustUnmarshal(iterator.Value(), &lease)
+		leases = append(leases, &lease)
+	}
+	return leases
+}
+
+// RemoveExpiredLeases removes at most limit leases whose expiration time is reached.
+func (k Keeper) RemoveExpiredLeases(ctx context.Context, limit int) error {
+	store := k.env.KVStoreService.OpenKVStore(ctx)
+	queueStore := prefix.NewStore(runtime.KVStoreAdapter(store), LeaseQueueKey)
+	blockTime := k.env.HeaderService.GetHeaderInfo(ctx).Time
+
+	var expired []nft.Lease
+	iterator := queueStore.Iterator(nil, leaseQueueEndKey(blockTime))
+	for ; iterator.Valid() && len(expired) < limit; iterator.Next() {
+		classID, nftID := parseLeaseQueueStoreKey(iterator.Key())
+		lease, has := k.getLease(ctx, classID, nftID)
+		if !has {
+			panic("lease queue entry without lease")
+		}
+		expired = append(expired, lease)
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, lease := range expired {
+		k.deleteLease(ctx, lease)
+
+		if err := k.env.EventService.EventManager(ctx).Emit(&nft.EventEndLease{
+			ClassId: lease.ClassId,
+			Id:      lease.Id,
+			Lessee:  lease.Lessee,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isLeased determines whether the specified nft has an active lease
+func (k Keeper) isLeased(ctx context.Context, classID, nftID string) bool {
+	_, has := k.GetLease(ctx, classID, nftID)
+	return has
+}
+
+func (k Keeper) getLease(ctx context.Context, classID, nftID string) (nft.Lease, bool) {
+	store := k.env.KVStoreService.OpenKVStore(ctx)
+	bz, err := store.Get(leaseStoreKey(classID, nftID))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return nft.Lease{}, false
+	}
+	var lease nft.Lease
+	k.cdc.MustUnmarshal(bz, &lease)
+	return lease, true
+}
+
+func (k Keeper) setLease(ctx context.Context, lease nft.Lease) {
+	lessee, err := k.ac.StringToBytes(lease.Lessee)
+	if err != nil {
+		panic(err)
+	}
+
+	store := k.env.KVStoreService.OpenKVStore(ctx)
+	if err := store.Set(leaseStoreKey(lease.ClassId, lease.Id), k.cdc.MustMarshal(&lease)); err != nil {
+		panic(err)
+	}
+	if err := store.Set(leaseByLesseeStoreKey(lessee, lease.ClassId, lease.Id), Placeholder); err != nil {
+		panic(err)
+	}
+	if err := store.Set(leaseQueueStoreKey(lease.Expiration, lease.ClassId, lease.Id), Placeholder); err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) deleteLease(ctx context.Context, lease nft.Lease) {
+	lessee, err := k.ac.StringToBytes(lease.Lessee)
+	if err != nil {
+		panic(err)
+	}
+
+	store := k.env.KVStoreService.OpenKVStore(ctx)
+	if err := store.Delete(leaseStoreKey(lease.ClassId, lease.Id)); err != nil {
+		panic(err)
+	}
+	if err := store.Delete(leaseByLesseeStoreKey(lessee, lease.ClassId, lease.Id)); err != nil {
+		panic(err)
+	}
+	if err := store.Delete(leaseQueueStoreKey(lease.Expiration, lease.ClassId, lease.Id)); err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) prefixStoreLeaseByLessee(ctx context.Context, lessee sdk.AccAddress) prefix.Store {
+	store := k.env.KVStoreService.OpenKVStore(ctx)
+	key := prefixLeaseByLesseeStoreKey(lessee)
+	return prefix.NewStore(runtime.KVStoreAdapter(store), key)
+}
@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/nft"
+)
+
+func (s *TestSuite) mintLeasableNFT() {
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, ExpClass))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, ExpNFT, s.addrs[0]))
+}
+
+func (s *TestSuite) TestLeaseNFT() {
+	s.mintLeasableNFT()
+	blockTime := s.ctx.HeaderInfo().Time
+	expiration := blockTime.Add(time.Hour)
+
+	// invalid leases
+	err := s.nftKeeper.LeaseNFT(s.ctx, testClassID, "kitty2", s.addrs[1], expiration)
+	s.Require().ErrorIs(err, nft.ErrNFTNotExists)
+	err = s.nftKeeper.LeaseNFT(s.ctx, testClassID, testID, s.addrs[0], expiration)
+	s.Require().ErrorIs(err, nft.ErrInvalidLease)
+	err = s.nftKeeper.LeaseNFT(s.ctx, testClassID, testID, s.addrs[1], blockTime)
+	s.Require().ErrorIs(err, nft.ErrInvalidLease)
+
+	s.Require().True(s.nftKeeper.HasUsageRights(s.ctx, testClassID, testID, s.addrs[0]))
+
+	err = s.nftKeeper.LeaseNFT(s.ctx, testClassID, testID, s.addrs[1], expiration)
+	s.Require().NoError(err)
+
+	lease, has := s.nftKeeper.GetLease(s.ctx, testClassID, testID)
+	s.Require().True(has)
+	s.Require().Equal(nft.Lease{ClassId: testClassID, Id: testID, Lessee: s.encodedAddrs[1], Expiration: expiration}, lease)
+
+	// the lessee holds the usage rights, the owner keeps the ownership
+	s.Require().True(s.nftKeeper.HasUsageRights(s.ctx, testClassID, testID, s.addrs[1]))
+	s.Require().False(s.nftKeeper.HasUsageRights(s.ctx, testClassID, testID, s.addrs[0]))
+	s.Require().Equal(s.addrs[0], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+
+	// a leased nft can neither be leased again, transferred nor burned
+	err = s.nftKeeper.LeaseNFT(s.ctx, testClassID, testID, s.addrs[2], expiration)
+	s.Require().ErrorIs(err, nft.ErrNFTLeased)
+	err = s.nftKeeper.Transfer(s.ctx, testClassID, testID, s.addrs[2])
+	s.Require().ErrorIs(err, nft.ErrNFTLeased)
+	err = s.nftKeeper.Burn(s.ctx, testClassID, testID)
+	s.Require().ErrorIs(err, nft.ErrNFTLeased)
+
+	// ending the lease returns the usage rights to the owner
+	s.Require().NoError(s.nftKeeper.ReturnNFT(s.ctx, testClassID, testID))
+	_, has = s.nftKeeper.GetLease(s.ctx, testClassID, testID)
+	s.Require().False(has)
+	s.Require().True(s.nftKeeper.HasUsageRights(s.ctx, testClassID, testID, s.addrs[0]))
+	s.Require().ErrorIs(s.nftKeeper.ReturnNFT(s.ctx, testClassID, testID), nft.ErrLeaseNotExists)
+
+	s.Require().NoError(s.nftKeeper.Transfer(s.ctx, testClassID, testID, s.addrs[2]))
+}
+
+func (s *TestSuite) TestRemoveExpiredLeases() {
+	s.mintLeasableNFT()
+	blockTime := s.ctx.HeaderInfo().Time
+	expiration := blockTime.Add(time.Hour)
+
+	s.Require().NoError(s.nftKeeper.LeaseNFT(s.ctx, testClassID, testID, s.addrs[1], expiration))
+
+	// nothing is removed before the expiration
+	s.Require().NoError(s.nftKeeper.RemoveExpiredLeases(s.ctx, 200))
+	s.Require().Len(s.nftKeeper.GetLeases(s.ctx), 1)
+
+	// an expired lease is inactive even before its removal
+	ctx := s.ctx.WithHeaderInfo(header.Info{Time: expiration})
+	_, has := s.nftKeeper.GetLease(ctx, testClassID, testID)
+	s.Require().False(has)
+	s.Require().True(s.nftKeeper.HasUsageRights(ctx, testClassID, testID, s.addrs[0]))
+	s.Require().Len(s.nftKeeper.GetLeases(ctx), 1)
+
+	s.Require().NoError(s.nftKeeper.RemoveExpiredLeases(ctx, 200))
+	s.Require().Empty(s.nftKeeper.GetLeases(ctx))
+
+	// the nft can be leased again
+	s.Require().NoError(s.nftKeeper.LeaseNFT(ctx, testClassID, testID, s.addrs[2], expiration.Add(time.Hour)))
+	s.Require().True(s.nftKeeper.HasUsageRights(ctx, testClassID, testID, s.addrs[2]))
+}
+
+func (s *TestSuite) TestLeaseQueries() {
+	s.mintLeasableNFT()
+	expiration := s.ctx.HeaderInfo().Time.Add(time.Hour)
+
+	_, err := s.queryClient.Lease(s.ctx, &nft.QueryLeaseRequest{ClassId: testClassID, Id: testID})
+	s.Require().Error(err)
+
+	s.Require().NoError(s.nftKeeper.LeaseNFT(s.ctx, testClassID, testID, s.addrs[1], expiration))
+	expLease := nft.Lease{ClassId: testClassID, Id: testID, Lessee: s.encodedAddrs[1], Expiration: expiration}
+
+	res, err := s.queryClient.Lease(s.ctx, &nft.QueryLeaseRequest{ClassId: testClassID, Id: testID})
+	s.Require().NoError(err)
+	s.Require().Equal(&expLease, res.Lease)
+
+	leasesRes, err := s.queryClient.Leases(s.ctx, &nft.QueryLeasesRequest{Lessee: s.encodedAddrs[1]})
+	s.Require().NoError(err)
+	s.Require().Equal([]*nft.Lease{&expLease}, leasesRes.Leases)
+
+	leasesRes, err = s.queryClient.Leases(s.ctx, &nft.QueryLeasesRequest{Lessee: s.encodedAddrs[2]})
+	s.Require().NoError(err)
+	s.Require().Empty(leasesRes.Leases)
+
+	_, err = s.queryClient.Leases(s.ctx, &nft.QueryLeasesRequest{})
+	s.Require().Error(err)
+}
+
+func (s *TestSuite) TestLeaseGenesis() {
+	s.mintLeasableNFT()
+	expiration := s.ctx.HeaderInfo().Time.Add(time.Hour)
+	s.Require().NoError(s.nftKeeper.LeaseNFT(s.ctx, testClassID, testID, s.addrs[1], expiration))
+
+	genesis := s.nftKeeper.ExportGenesis(s.ctx)
+	s.Require().Equal([]*nft.Lease{{ClassId: testClassID, Id: testID, Lessee: s.encodedAddrs[1], Expiration: expiration}}, genesis.Leases)
+	s.Require().NoError(nft.ValidateGenesis(*genesis, s.accountKeeper.AddressCodec()))
+
+	s.SetupTest()
+	s.nftKeeper.InitGenesis(s.ctx, genesis)
+	s.Require().True(s.nftKeeper.HasUsageRights(s.ctx, testClassID, testID, s.addrs[1]))
+	s.Require().NoError(s.nftKeeper.RemoveExpiredLeases(s.ctx.WithHeaderInfo(header.Info{Time: expiration}), 200))
+	s.Require().Empty(s.nftKeeper.GetLeases(s.ctx))
+}
+
+func (s *TestSuite) TestMsgGrantAndEndLease() {
+	s.mintLeasableNFT()
+	expiration := s.ctx.HeaderInfo().Time.Add(time.Hour)
+
+	// only the owner can grant a lease
+	_, err := s.nftKeeper.GrantLease(s.ctx, &nft.MsgGrantLease{
+		ClassId:    testClassID,
+		Id:         testID,
+		Owner:      s.encodedAddrs[2],
+		Lessee:     s.encodedAddrs[1],
+		Expiration: expiration,
+	})
+	s.Require().Error(err)
+
+	_, err = s.nftKeeper.GrantLease(s.ctx, &nft.MsgGrantLease{
+		ClassId:    testClassID,
+		Id:         testID,
+		Owner:      s.encodedAddrs[0],
+		Lessee:     s.encodedAddrs[1],
+		Expiration: expiration,
+	})
+	s.Require().NoError(err)
+
+	// only the lessee can end the lease
+	_, err = s.nftKeeper.EndLease(s.ctx, &nft.MsgEndLease{ClassId: testClassID, Id: testID, Lessee: s.encodedAddrs[0]})
+	s.Require().Error(err)
+
+	_, err = s.nftKeeper.EndLease(s.ctx, &nft.MsgEndLease{ClassId: testClassID, Id: testID, Lessee: s.encodedAddrs[1]})
+	s.Require().NoError(err)
+	s.Require().True(s.nftKeeper.HasUsageRights(s.ctx, testClassID, testID, s.addrs[0]))
+}
@@ -52,3 +52,65 @@ func (k Keeper) Send(ctx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespons
 
 	return &nft.MsgSendResponse{}, nil
 }
+
+// GrantLease implements GrantLease method of the types.MsgServer.
+func (k Keeper) GrantLease(ctx context.Context, msg *nft.MsgGrantLease) (*nft.MsgGrantLeaseResponse, error) {
+	if len(msg.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(msg.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	sender, err := k.ac.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", msg.Owner)
+	}
+
+	lessee, err := k.ac.StringToBytes(msg.Lessee)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid lessee address (%s)", msg.Lessee)
+	}
+
+	owner := k.GetOwner(ctx, msg.ClassId, msg.Id)
+	if !bytes.Equal(owner, sender) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", msg.Owner, msg.Id)
+	}
+
+	if err := k.LeaseNFT(ctx, msg.ClassId, msg.Id, lessee, msg.Expiration); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgGrantLeaseResponse{}, nil
+}
+
+// EndLease implements EndLease method of the types.MsgServer.
+func (k Keeper) EndLease(ctx context.Context, msg *nft.MsgEndLease) (*nft.MsgEndLeaseResponse, error) {
+	if len(msg.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(msg.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	if _, err := k.ac.StringToBytes(msg.Lessee); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid lessee address (%s)", msg.Lessee)
+	}
+
+	lease, has := k.GetLease(ctx, msg.ClassId, msg.Id)
+	if !has {
+		return nil, errorsmod.Wrap(nft.ErrLeaseNotExists, msg.Id)
+	}
+
+	if lease.Lessee != msg.Lessee {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the lessee of nft %s", msg.Lessee, msg.Id)
+	}
+
+	if err := k.ReturnNFT(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgEndLeaseResponse{}, nil
+}
@@ -66,6 +66,10 @@ func (k Keeper) Burn(ctx context.Context, classID, nftID string) error {
 // Note: this method does not check whether the class already exists in nft.
 // The upper-layer application needs to check it when it needs to use it
 func (k Keeper) burnWithNoCheck(ctx context.Context, classID, nftID string) error {
+	if k.isLeased(ctx, classID, nftID) {
+		return errors.Wrap(nft.ErrNFTLeased, nftID)
+	}
+
 	owner := k.GetOwner(ctx, classID, nftID)
 	nftStore := k.getNFTStore(ctx, classID)
 	nftStore.Delete([]byte(nftID))
@@ -136,6 +140,10 @@ func (k Keeper) transferWithNoCheck(ctx context.Context,
 	nftID string,
 	receiver sdk.AccAddress,
 ) error {
+	if k.isLeased(ctx, classID, nftID) {
+		return errors.Wrap(nft.ErrNFTLeased, nftID)
+	}
+
 	owner := k.GetOwner(ctx, classID, nftID)
 	k.deleteOwner(ctx, classID, nftID, owner)
 	k.setOwner(ctx, classID, nftID, receiver)
@@ -0,0 +1,13 @@
+package module
+
+import (
+	"context"
+
+	"cosmossdk.io/x/nft/keeper"
+)
+
+// EndBlocker removes the expired leases, returning their usage rights to the owners
+func EndBlocker(ctx context.Context, k keeper.Keeper) error {
+	// 200 is an arbitrary value, we can change it later if needed
+	return k.RemoveExpiredLeases(ctx, 200)
+}
@@ -79,6 +79,25 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query all NFT classes.",
 					Example:   fmt.Sprintf(`%s query %s classes`, version.AppName, nft.ModuleName),
 				},
+				{
+					RpcMethod: "Lease",
+					Use:       "lease [class-id] [nft-id]",
+					Short:     "Query the active lease of an NFT based on its class and id.",
+					Example:   fmt.Sprintf(`%s query %s lease <class-id> <nft-id>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "Leases",
+					Use:       "leases [lessee]",
+					Short:     "Query the active leases of NFTs granted to a lessee.",
+					Example:   fmt.Sprintf(`%s query %s leases <lessee>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "lessee"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -95,6 +114,27 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					// Sender is the signer of the transaction and is automatically added as from flag by AutoCLI.
 				},
+				{
+					RpcMethod: "GrantLease",
+					Use:       "grant-lease [class-id] [nft-id] [lessee] [expiration] --from [owner]",
+					Short:     "Grant the usage rights of an NFT to a lessee until an expiration time",
+					Example:   fmt.Sprintf(`%s tx %s grant-lease <class-id> <nft-id> <lessee> 2006-01-02T15:04:05Z --from <owner>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+						{ProtoField: "id"},
+						{ProtoField: "lessee"},
+						{ProtoField: "expiration"},
+					},
+				},
+				{
+					RpcMethod: "EndLease",
+					Use:       "end-lease [class-id] [nft-id] --from [lessee]",
+					Short:     "End the active lease of an NFT, returning its usage rights to the owner",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+						{ProtoField: "id"},
+					},
+				},
 			},
 		},
 	}
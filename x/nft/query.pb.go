@@ -1243,6 +1243,225 @@ func (m *QueryClassesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryLeaseRequest is the request type for the Query/Lease RPC method
+//
+// Since: nft v0.1.1
+type QueryLeaseRequest struct {
+	// class_id associated with the nft
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// id is a unique identifier of the NFT
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryLeaseRequest) Reset()         { *m = QueryLeaseRequest{} }
+func (m *QueryLeaseRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLeaseRequest) ProtoMessage()    {}
+func (*QueryLeaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{24}
+}
+func (m *QueryLeaseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLeaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLeaseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLeaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLeaseRequest.Merge(m, src)
+}
+func (m *QueryLeaseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLeaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLeaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLeaseRequest proto.InternalMessageInfo
+
+func (m *QueryLeaseRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *QueryLeaseRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// QueryLeaseResponse is the response type for the Query/Lease RPC method
+//
+// Since: nft v0.1.1
+type QueryLeaseResponse struct {
+	// lease is the active lease of the NFT
+	Lease *Lease `protobuf:"bytes,1,opt,name=lease,proto3" json:"lease,omitempty"`
+}
+
+func (m *QueryLeaseResponse) Reset()         { *m = QueryLeaseResponse{} }
+func (m *QueryLeaseResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLeaseResponse) ProtoMessage()    {}
+func (*QueryLeaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{25}
+}
+func (m *QueryLeaseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLeaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLeaseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLeaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLeaseResponse.Merge(m, src)
+}
+func (m *QueryLeaseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLeaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLeaseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLeaseResponse proto.InternalMessageInfo
+
+func (m *QueryLeaseResponse) GetLease() *Lease {
+	if m != nil {
+		return m.Lease
+	}
+	return nil
+}
+
+// QueryLeasesRequest is the request type for the Query/Leases RPC method
+//
+// Since: nft v0.1.1
+type QueryLeasesRequest struct {
+	// lessee is the address granted the usage rights of the NFTs
+	Lessee string `protobuf:"bytes,1,opt,name=lessee,proto3" json:"lessee,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLeasesRequest) Reset()         { *m = QueryLeasesRequest{} }
+func (m *QueryLeasesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLeasesRequest) ProtoMessage()    {}
+func (*QueryLeasesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{26}
+}
+func (m *QueryLeasesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLeasesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLeasesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLeasesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLeasesRequest.Merge(m, src)
+}
+func (m *QueryLeasesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLeasesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLeasesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLeasesRequest proto.InternalMessageInfo
+
+func (m *QueryLeasesRequest) GetLessee() string {
+	if m != nil {
+		return m.Lessee
+	}
+	return ""
+}
+
+func (m *QueryLeasesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryLeasesResponse is the response type for the Query/Leases RPC method
+//
+// Since: nft v0.1.1
+type QueryLeasesResponse struct {
+	// leases are the active leases granted to the lessee
+	Leases []*Lease `protobuf:"bytes,1,rep,name=leases,proto3" json:"leases,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLeasesResponse) Reset()         { *m = QueryLeasesResponse{} }
+func (m *QueryLeasesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLeasesResponse) ProtoMessage()    {}
+func (*QueryLeasesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{27}
+}
+func (m *QueryLeasesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLeasesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLeasesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLeasesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLeasesResponse.Merge(m, src)
+}
+func (m *QueryLeasesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLeasesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLeasesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLeasesResponse proto.InternalMessageInfo
+
+func (m *QueryLeasesResponse) GetLeases() []*Lease {
+	if m != nil {
+		return m.Leases
+	}
+	return nil
+}
+
+func (m *QueryLeasesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.nft.v1beta1.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceByQueryStringRequest)(nil), "cosmos.nft.v1beta1.QueryBalanceByQueryStringRequest")
@@ -1268,71 +1487,82 @@ func init() {
 	proto.RegisterType((*QueryClassByQueryStringResponse)(nil), "cosmos.nft.v1beta1.QueryClassByQueryStringResponse")
 	proto.RegisterType((*QueryClassesRequest)(nil), "cosmos.nft.v1beta1.QueryClassesRequest")
 	proto.RegisterType((*QueryClassesResponse)(nil), "cosmos.nft.v1beta1.QueryClassesResponse")
+	proto.RegisterType((*QueryLeaseRequest)(nil), "cosmos.nft.v1beta1.QueryLeaseRequest")
+	proto.RegisterType((*QueryLeaseResponse)(nil), "cosmos.nft.v1beta1.QueryLeaseResponse")
+	proto.RegisterType((*QueryLeasesRequest)(nil), "cosmos.nft.v1beta1.QueryLeasesRequest")
+	proto.RegisterType((*QueryLeasesResponse)(nil), "cosmos.nft.v1beta1.QueryLeasesResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/query.proto", fileDescriptor_0d24e0db697b0f9d) }
 
 var fileDescriptor_0d24e0db697b0f9d = []byte{
-	// 936 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0x41, 0x6f, 0xe3, 0x54,
-	0x10, 0xc7, 0xfb, 0x92, 0xa6, 0x85, 0x59, 0x01, 0xcb, 0x6c, 0xc5, 0x26, 0xde, 0xae, 0x37, 0xeb,
-	0xdd, 0x36, 0xd9, 0x2e, 0x6b, 0x37, 0xc9, 0x02, 0x12, 0xbb, 0x70, 0x28, 0x22, 0xa8, 0x20, 0x15,
-	0x48, 0x7b, 0x42, 0x42, 0xc8, 0x69, 0x9c, 0xc8, 0x22, 0xb5, 0xd3, 0xd8, 0x01, 0xaa, 0xaa, 0x07,
-	0x7a, 0x40, 0x54, 0x5c, 0x90, 0xe8, 0x81, 0x23, 0x07, 0x0e, 0x7c, 0x14, 0x8e, 0x95, 0xb8, 0x70,
-	0x44, 0x2d, 0x5f, 0x80, 0x6f, 0x80, 0xfc, 0x3c, 0x4e, 0x9d, 0xe6, 0xf9, 0xb9, 0x0e, 0x1c, 0x1d,
-	0xcf, 0xbc, 0xff, 0x2f, 0xe3, 0xf9, 0xfb, 0x9f, 0x80, 0xba, 0xeb, 0x7a, 0x7b, 0xae, 0x67, 0x38,
-	0x5d, 0xdf, 0xf8, 0xaa, 0xd6, 0xb6, 0x7c, 0xb3, 0x66, 0xec, 0x8f, 0xac, 0xe1, 0x81, 0x3e, 0x18,
-	0xba, 0xbe, 0x8b, 0x18, 0xde, 0xd7, 0x9d, 0xae, 0xaf, 0xd3, 0x7d, 0x65, 0x8d, 0x7a, 0xda, 0xa6,
-	0x67, 0x85, 0xc5, 0xe3, 0xd6, 0x81, 0xd9, 0xb3, 0x1d, 0xd3, 0xb7, 0x5d, 0x27, 0xec, 0x57, 0x96,
-	0x7b, 0xae, 0xdb, 0xeb, 0x5b, 0x86, 0x39, 0xb0, 0x0d, 0xd3, 0x71, 0x5c, 0x9f, 0xdf, 0xf4, 0xa2,
-	0xbb, 0x02, 0xf5, 0x40, 0x89, 0xdf, 0xd5, 0x9a, 0x70, 0xeb, 0xd3, 0xe0, 0xf4, 0x0d, 0xb3, 0x6f,
-	0x3a, 0xbb, 0x56, 0xcb, 0xda, 0x1f, 0x59, 0x9e, 0x8f, 0x25, 0x78, 0x61, 0xb7, 0x6f, 0x7a, 0xde,
-	0x17, 0x76, 0xa7, 0xc8, 0xca, 0xac, 0xfa, 0x62, 0x6b, 0x91, 0x5f, 0x6f, 0x76, 0x70, 0x09, 0x0a,
-	0xee, 0xd7, 0x8e, 0x35, 0x2c, 0xe6, 0xf8, 0xe7, 0xe1, 0x85, 0xb6, 0x0d, 0xe5, 0xf8, 0x39, 0x1b,
-	0x07, 0xfc, 0x6a, 0xdb, 0x1f, 0xda, 0x4e, 0x6f, 0xe6, 0x43, 0x75, 0x58, 0x9a, 0x84, 0xf3, 0x06,
-	0xae, 0xe3, 0x59, 0xf8, 0x1a, 0x2c, 0x98, 0x7b, 0xee, 0xc8, 0xf1, 0xf9, 0x31, 0xf3, 0x2d, 0xba,
-	0xd2, 0x9e, 0xc1, 0x7d, 0x09, 0x44, 0x4a, 0xf3, 0xbb, 0xf0, 0x2a, 0x2f, 0xff, 0x38, 0x90, 0xbe,
-	0x06, 0xf2, 0xcb, 0x90, 0xb3, 0x3b, 0xc4, 0x9b, 0xb3, 0x3b, 0xda, 0x47, 0xa0, 0x5e, 0xf6, 0x67,
-	0xfd, 0xfe, 0x57, 0x0f, 0x5b, 0x03, 0x8c, 0xc3, 0x10, 0xfa, 0x78, 0x4a, 0x2c, 0x3e, 0xa5, 0xb7,
-	0xe0, 0x5e, 0xa2, 0xb0, 0xb4, 0xd1, 0x20, 0x91, 0xed, 0xd1, 0x60, 0xd0, 0x3f, 0x48, 0xa7, 0xd4,
-	0x9e, 0x93, 0x52, 0xd8, 0x90, 0xf1, 0x3b, 0x6a, 0x4f, 0x68, 0xd5, 0x22, 0xb9, 0x94, 0xe7, 0xf1,
-	0x36, 0x6d, 0x94, 0x50, 0x2c, 0xa5, 0xf7, 0x07, 0x06, 0x37, 0x79, 0xfd, 0x56, 0x73, 0xc7, 0x9b,
-	0x75, 0xfd, 0xb0, 0x09, 0x70, 0xe9, 0xb5, 0x62, 0xbe, 0xcc, 0xaa, 0x37, 0xea, 0xab, 0x3a, 0x99,
-	0x35, 0x30, 0xa6, 0x1e, 0xba, 0x98, 0x5c, 0xa5, 0x7f, 0x62, 0xf6, 0x22, 0x03, 0xb5, 0x62, 0x9d,
-	0xda, 0x09, 0xa3, 0xd5, 0x0a, 0x69, 0x88, 0xfd, 0x31, 0xcc, 0x3b, 0x5d, 0xdf, 0x2b, 0xb2, 0x72,
-	0xbe, 0x7a, 0xa3, 0x7e, 0x5b, 0x9f, 0x7e, 0x09, 0xe8, 0x5b, 0xcd, 0x9d, 0x16, 0x2f, 0xc2, 0x0f,
-	0x26, 0x50, 0x72, 0x1c, 0xa5, 0x92, 0x8a, 0x12, 0x2a, 0x4d, 0xb0, 0x3c, 0x87, 0x57, 0x22, 0x94,
-	0x19, 0xd6, 0x72, 0x13, 0x96, 0xa3, 0xee, 0xff, 0xba, 0xe1, 0xef, 0x5c, 0x3e, 0xa1, 0xf1, 0x48,
-	0x1e, 0x41, 0xde, 0xe9, 0x86, 0xcf, 0x52, 0x32, 0x91, 0xa0, 0x46, 0xfb, 0x10, 0xee, 0x26, 0x90,
-	0x64, 0x3f, 0x4b, 0xa7, 0xc7, 0xf3, 0x5e, 0x80, 0x7a, 0x8d, 0x45, 0x7e, 0x46, 0x4e, 0xe7, 0xf5,
-	0x59, 0x5d, 0xf0, 0x3e, 0x99, 0x8e, 0xc4, 0x88, 0xd6, 0x80, 0x02, 0x2f, 0x20, 0xde, 0x92, 0x88,
-	0x37, 0xec, 0x08, 0xeb, 0xb4, 0x16, 0x59, 0x51, 0xc4, 0x30, 0xeb, 0x99, 0x9f, 0x93, 0x41, 0xf9,
-	0x87, 0xd6, 0x78, 0x12, 0x93, 0x36, 0x60, 0x33, 0xdb, 0xe0, 0x94, 0xd1, 0xeb, 0x7c, 0x7c, 0x3e,
-	0x81, 0x36, 0x20, 0x9c, 0x8e, 0x15, 0x99, 0x41, 0x82, 0x1a, 0x55, 0xfe, 0x6f, 0x8e, 0xa8, 0xff,
-	0xf3, 0x12, 0x14, 0x38, 0x16, 0x9e, 0x32, 0x58, 0xa4, 0xe8, 0xc0, 0x8a, 0x08, 0x41, 0x90, 0x94,
-	0x4a, 0x35, 0xbd, 0x30, 0x14, 0xd5, 0xde, 0x3c, 0xfe, 0xe3, 0xef, 0x9f, 0x72, 0xeb, 0xa8, 0x1b,
-	0x82, 0x44, 0x6e, 0x87, 0xc5, 0xc6, 0x21, 0x7f, 0xf5, 0x1c, 0x19, 0x87, 0xd1, 0xfe, 0x1c, 0xe1,
-	0x6f, 0x0c, 0x96, 0x44, 0x89, 0x86, 0x4f, 0xd3, 0xa4, 0x45, 0xbb, 0xa9, 0xbc, 0x91, 0xb1, 0x8b,
-	0xe8, 0x1f, 0x70, 0xfa, 0xbb, 0x78, 0x47, 0x42, 0x8f, 0x27, 0x0c, 0x0a, 0x3c, 0x86, 0x70, 0x25,
-	0x51, 0x25, 0x9e, 0xaf, 0xca, 0x6a, 0x5a, 0x19, 0xa9, 0xd7, 0xb8, 0xfa, 0x63, 0x7c, 0x24, 0x52,
-	0xe7, 0x23, 0x8b, 0x4d, 0xcc, 0x38, 0x0c, 0xc6, 0xf6, 0x0b, 0x03, 0x9c, 0x8e, 0x44, 0xac, 0xcb,
-	0x15, 0x85, 0x23, 0x6b, 0x64, 0xea, 0x21, 0xe4, 0xfb, 0x1c, 0xf9, 0x0e, 0x96, 0x12, 0x91, 0xf1,
-	0x7b, 0x06, 0x0b, 0x61, 0xbc, 0x61, 0xf2, 0x20, 0x26, 0xd2, 0x59, 0xa9, 0xa4, 0xd6, 0x91, 0xfc,
-	0x13, 0x2e, 0x5f, 0xc1, 0x15, 0x91, 0xbc, 0xc7, 0x6b, 0xe3, 0x4b, 0xf6, 0x2b, 0x83, 0x5b, 0x82,
-	0xa4, 0xc5, 0x46, 0x8a, 0x9e, 0x70, 0x5e, 0x4f, 0xb3, 0x35, 0x11, 0xb1, 0xc6, 0x89, 0x97, 0x51,
-	0x49, 0x26, 0xc6, 0x11, 0xcc, 0x07, 0x21, 0x8a, 0x0f, 0x13, 0x15, 0x62, 0x89, 0xaf, 0xac, 0xa4,
-	0x54, 0x91, 0x70, 0x99, 0x0b, 0x2b, 0x58, 0x34, 0xc4, 0x3f, 0x95, 0x3d, 0x3c, 0x66, 0x90, 0xdf,
-	0x6a, 0xee, 0xe0, 0x03, 0xd9, 0x81, 0x91, 0xea, 0x43, 0x79, 0x11, 0x89, 0xae, 0x73, 0xd1, 0x35,
-	0xac, 0x26, 0x89, 0x4e, 0x2d, 0xf4, 0xcf, 0x0c, 0x6e, 0x5e, 0x8d, 0x3b, 0x5c, 0x97, 0x89, 0x09,
-	0x1f, 0x4e, 0x2d, 0x43, 0x07, 0xb1, 0xde, 0xe3, 0xac, 0x25, 0xbc, 0x9d, 0xc0, 0x8a, 0xdf, 0x31,
-	0x28, 0xf0, 0xf7, 0xb3, 0xc4, 0xf7, 0xf1, 0x74, 0x95, 0xf8, 0x7e, 0x22, 0x17, 0x35, 0x9d, 0x2b,
-	0x57, 0x71, 0x55, 0xa4, 0x4c, 0x51, 0x10, 0x5f, 0xe3, 0xc0, 0xf4, 0xd3, 0x91, 0x28, 0x31, 0x7d,
-	0x62, 0x86, 0x4b, 0x4c, 0x9f, 0x9c, 0xb9, 0x72, 0xd3, 0x73, 0x4c, 0xfc, 0x96, 0xc1, 0x22, 0x25,
-	0xa0, 0x24, 0x65, 0x26, 0x33, 0x58, 0x92, 0x32, 0x57, 0xc2, 0x54, 0xfe, 0x9e, 0xa6, 0x89, 0x6d,
-	0xbc, 0xfe, 0xfb, 0xb9, 0xca, 0xce, 0xce, 0x55, 0xf6, 0xd7, 0xb9, 0xca, 0x7e, 0xbc, 0x50, 0xe7,
-	0xce, 0x2e, 0xd4, 0xb9, 0x3f, 0x2f, 0xd4, 0xb9, 0xcf, 0xe8, 0xbf, 0xa8, 0xd7, 0xf9, 0x52, 0xb7,
-	0x5d, 0xe3, 0x9b, 0xa0, 0xbb, 0xbd, 0xc0, 0xff, 0x2a, 0x36, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff,
-	0xc0, 0x88, 0xda, 0x47, 0xc8, 0x0e, 0x00, 0x00,
+	// 1051 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcf, 0x6f, 0xdb, 0x64,
+	0x18, 0xee, 0x97, 0x36, 0x29, 0xbc, 0x93, 0xa0, 0x7b, 0x5b, 0x6d, 0xa9, 0xd7, 0xa5, 0x99, 0xbb,
+	0xa6, 0x59, 0xbb, 0xd9, 0x4d, 0x32, 0x40, 0x62, 0x83, 0x43, 0x11, 0x41, 0x03, 0x54, 0x20, 0xed,
+	0x09, 0x09, 0x21, 0xb7, 0x71, 0xa3, 0x88, 0xcc, 0xce, 0xfa, 0x39, 0x40, 0x55, 0xf5, 0xc0, 0x0e,
+	0x83, 0xc1, 0x05, 0xc4, 0x0e, 0x1c, 0x39, 0x70, 0xe0, 0x4f, 0xe1, 0x38, 0x89, 0x0b, 0x47, 0xd4,
+	0xf2, 0x87, 0x20, 0x7f, 0xdf, 0xeb, 0xc4, 0x6e, 0x3e, 0xdb, 0x75, 0xb6, 0xa3, 0xed, 0xe7, 0x7d,
+	0x9f, 0xa7, 0xef, 0x8f, 0xef, 0x7b, 0x1a, 0x28, 0xed, 0xbb, 0xfc, 0xa1, 0xcb, 0x4d, 0xe7, 0xc0,
+	0x33, 0xbf, 0xae, 0xed, 0xd9, 0x9e, 0x55, 0x33, 0x1f, 0x0d, 0xec, 0xc3, 0x23, 0xa3, 0x7f, 0xe8,
+	0x7a, 0x2e, 0xa2, 0xfc, 0x6e, 0x38, 0x07, 0x9e, 0x41, 0xdf, 0xb5, 0x75, 0x8a, 0xd9, 0xb3, 0xb8,
+	0x2d, 0xc1, 0xc3, 0xd0, 0xbe, 0xd5, 0xe9, 0x3a, 0x96, 0xd7, 0x75, 0x1d, 0x19, 0xaf, 0x2d, 0x75,
+	0x5c, 0xb7, 0xd3, 0xb3, 0x4d, 0xab, 0xdf, 0x35, 0x2d, 0xc7, 0x71, 0x3d, 0xf1, 0x91, 0x07, 0x5f,
+	0x15, 0xec, 0x3e, 0x93, 0xf8, 0xaa, 0x37, 0x61, 0xfe, 0x33, 0x3f, 0xfb, 0x96, 0xd5, 0xb3, 0x9c,
+	0x7d, 0xbb, 0x65, 0x3f, 0x1a, 0xd8, 0xdc, 0xc3, 0x45, 0x78, 0x65, 0xbf, 0x67, 0x71, 0xfe, 0x65,
+	0xb7, 0x5d, 0x64, 0x65, 0x56, 0x7d, 0xb5, 0x35, 0x2b, 0x9e, 0x1f, 0xb4, 0x71, 0x01, 0xf2, 0xee,
+	0x37, 0x8e, 0x7d, 0x58, 0xcc, 0x89, 0xf7, 0xf2, 0x41, 0xdf, 0x81, 0x72, 0x38, 0xcf, 0xd6, 0x91,
+	0x78, 0xda, 0xf1, 0x0e, 0xbb, 0x4e, 0x67, 0xe2, 0xa4, 0x06, 0x2c, 0x44, 0xc5, 0xf1, 0xbe, 0xeb,
+	0x70, 0x1b, 0xaf, 0x40, 0xc1, 0x7a, 0xe8, 0x0e, 0x1c, 0x4f, 0xa4, 0x99, 0x69, 0xd1, 0x93, 0x7e,
+	0x0f, 0x6e, 0x24, 0x88, 0x48, 0x09, 0x7e, 0x17, 0x2e, 0x0b, 0xf8, 0x27, 0x3e, 0xf5, 0x05, 0x24,
+	0xbf, 0x06, 0xb9, 0x6e, 0x9b, 0xf4, 0xe6, 0xba, 0x6d, 0xfd, 0x23, 0x28, 0x8d, 0xe2, 0xb3, 0xfe,
+	0xfd, 0xe7, 0x93, 0xad, 0x03, 0x86, 0xc5, 0x90, 0xf4, 0x61, 0x95, 0x58, 0xb8, 0x4a, 0x6f, 0xc1,
+	0x72, 0x2c, 0x71, 0x62, 0xa0, 0x49, 0x24, 0x3b, 0x83, 0x7e, 0xbf, 0x77, 0x94, 0xae, 0x52, 0xbf,
+	0x0f, 0xcb, 0xa1, 0x80, 0x8c, 0x7f, 0xa3, 0x7e, 0x07, 0xe6, 0x43, 0xd1, 0xa9, 0xfd, 0x78, 0x1b,
+	0xca, 0x21, 0x78, 0xb6, 0x5e, 0xfe, 0xc4, 0x60, 0x4e, 0xe0, 0xb7, 0x9b, 0xbb, 0x7c, 0xd2, 0xf1,
+	0xc3, 0x26, 0xc0, 0x68, 0xd7, 0x8a, 0xd3, 0x65, 0x56, 0xbd, 0x54, 0xaf, 0x18, 0xb4, 0xac, 0xfe,
+	0x62, 0x1a, 0x72, 0x8b, 0x69, 0xab, 0x8c, 0x4f, 0xad, 0x4e, 0xb0, 0x40, 0xad, 0x50, 0xa4, 0xfe,
+	0x94, 0xc1, 0xe5, 0x90, 0x1a, 0xd2, 0xbe, 0x01, 0x33, 0xce, 0x81, 0xc7, 0x8b, 0xac, 0x3c, 0x5d,
+	0xbd, 0x54, 0xbf, 0x6a, 0x8c, 0x1f, 0x02, 0xc6, 0x76, 0x73, 0xb7, 0x25, 0x40, 0xf8, 0x41, 0x44,
+	0x4a, 0x4e, 0x48, 0x59, 0x4b, 0x95, 0x22, 0x99, 0x22, 0x5a, 0xee, 0xc3, 0xeb, 0x81, 0x94, 0x09,
+	0xc6, 0xf2, 0x01, 0x2c, 0x05, 0xd1, 0x2f, 0x3a, 0xe1, 0xef, 0x8c, 0x3a, 0x34, 0x2c, 0xc9, 0x2d,
+	0x98, 0x76, 0x0e, 0x64, 0x2f, 0x13, 0x2a, 0xe2, 0x63, 0xf4, 0x0f, 0xe1, 0x7a, 0x8c, 0x92, 0xec,
+	0xb9, 0x0c, 0x6a, 0xcf, 0x7b, 0xbe, 0xd4, 0x0b, 0x0c, 0xf2, 0x3d, 0x28, 0x8d, 0xf0, 0x59, 0xb7,
+	0xe0, 0x7d, 0xc0, 0x51, 0xf0, 0x50, 0xad, 0x09, 0x79, 0x01, 0x20, 0xbd, 0x8b, 0x2a, 0xbd, 0x32,
+	0x42, 0xe2, 0xf4, 0x16, 0xad, 0xa2, 0x4a, 0xc3, 0xa4, 0x39, 0xbf, 0xa0, 0x05, 0x15, 0x2f, 0xed,
+	0x61, 0x25, 0xa2, 0x6b, 0xc0, 0x26, 0x5e, 0x83, 0x67, 0x0c, 0x16, 0xa2, 0xf9, 0x49, 0x68, 0x03,
+	0x64, 0x75, 0xec, 0x60, 0x19, 0x12, 0xa4, 0x06, 0xc8, 0x97, 0xb7, 0x11, 0xc1, 0xb9, 0xff, 0xb1,
+	0x6d, 0x71, 0x7b, 0x82, 0x41, 0x0e, 0x1a, 0x4a, 0xf1, 0xa3, 0xe2, 0xf7, 0xfc, 0x17, 0x49, 0xc5,
+	0x97, 0x11, 0x12, 0xa7, 0x7b, 0xe1, 0x34, 0xc3, 0xda, 0x5f, 0x81, 0x42, 0xcf, 0xe6, 0xdc, 0xb6,
+	0x49, 0x05, 0x3d, 0x9d, 0xeb, 0x49, 0x6e, 0xe2, 0x9e, 0xfc, 0xc2, 0x60, 0x3e, 0x42, 0x4b, 0xf2,
+	0x6b, 0x3e, 0xaf, 0x95, 0xd2, 0x11, 0xa9, 0x9f, 0x80, 0x2f, 0xad, 0x21, 0xf5, 0xef, 0xe7, 0x20,
+	0x2f, 0x34, 0xe1, 0x33, 0x06, 0xb3, 0x74, 0x97, 0xe3, 0x9a, 0x4a, 0x81, 0xc2, 0xba, 0x68, 0xd5,
+	0x74, 0xa0, 0x24, 0xd5, 0xdf, 0x7c, 0xfc, 0xf7, 0x7f, 0xbf, 0xe6, 0x36, 0xd1, 0x30, 0x15, 0x16,
+	0x69, 0x4f, 0x82, 0xcd, 0x63, 0x71, 0x17, 0x9c, 0x98, 0xc7, 0xc1, 0x3c, 0x9c, 0xe0, 0x9f, 0x0c,
+	0x16, 0x54, 0x16, 0x03, 0xef, 0xa6, 0x51, 0xab, 0x0e, 0x0b, 0xed, 0x8d, 0x8c, 0x51, 0xa4, 0x7e,
+	0x45, 0xa8, 0xbf, 0x8e, 0xd7, 0x12, 0xd4, 0xe3, 0x53, 0x06, 0x79, 0xe1, 0x0b, 0x70, 0x35, 0x96,
+	0x25, 0x6c, 0x78, 0xb4, 0x4a, 0x1a, 0x8c, 0xd8, 0x6b, 0x82, 0x7d, 0x03, 0x6f, 0xa9, 0xd8, 0x45,
+	0xc9, 0x42, 0x15, 0x33, 0x8f, 0xfd, 0xb2, 0xfd, 0xce, 0x00, 0xc7, 0x3d, 0x0a, 0xd6, 0x93, 0x19,
+	0x95, 0x25, 0x6b, 0x64, 0x8a, 0x21, 0xc9, 0x37, 0x84, 0xe4, 0x6b, 0xb8, 0x18, 0x2b, 0x19, 0x7f,
+	0x60, 0x50, 0x90, 0x7e, 0x03, 0xe3, 0x0b, 0x11, 0xb1, 0x4b, 0xda, 0x5a, 0x2a, 0x8e, 0xe8, 0xef,
+	0x08, 0xfa, 0x35, 0x5c, 0x55, 0xd1, 0x73, 0x81, 0x0d, 0x0f, 0xd9, 0x1f, 0x0c, 0xe6, 0x15, 0xd6,
+	0x07, 0x1b, 0x29, 0x7c, 0xca, 0x7a, 0xdd, 0xcd, 0x16, 0x44, 0x8a, 0x75, 0xa1, 0x78, 0x09, 0xb5,
+	0x78, 0xc5, 0x38, 0x80, 0x19, 0xdf, 0xd5, 0xe0, 0xcd, 0x58, 0x86, 0x90, 0x05, 0xd3, 0x56, 0x53,
+	0x50, 0x44, 0x5c, 0x16, 0xc4, 0x1a, 0x16, 0x4d, 0xf5, 0xff, 0x2e, 0x1c, 0x1f, 0x33, 0x98, 0xde,
+	0x6e, 0xee, 0xe2, 0x4a, 0x52, 0xc2, 0x80, 0xf5, 0x66, 0x32, 0x88, 0x48, 0x37, 0x05, 0xe9, 0x3a,
+	0x56, 0xe3, 0x48, 0xc7, 0x06, 0xfa, 0x37, 0x06, 0x73, 0xe7, 0xfd, 0x07, 0x6e, 0x26, 0x91, 0x29,
+	0x9b, 0x53, 0xcb, 0x10, 0x41, 0x5a, 0x97, 0x85, 0xd6, 0x45, 0xbc, 0x1a, 0xa3, 0x15, 0x9f, 0x30,
+	0xc8, 0x8b, 0x0b, 0x33, 0x61, 0xef, 0xc3, 0x76, 0x47, 0xab, 0xa4, 0xc1, 0x88, 0xd9, 0x10, 0xcc,
+	0x55, 0xac, 0xa8, 0x98, 0xe9, 0x6e, 0x0e, 0x8f, 0xb1, 0xbf, 0xf4, 0xe3, 0x1e, 0x25, 0x61, 0xe9,
+	0x63, 0x4d, 0x95, 0xd6, 0xc8, 0x14, 0x73, 0x91, 0xa5, 0x17, 0x32, 0xf1, 0x3b, 0x06, 0xb3, 0x64,
+	0x49, 0x12, 0x6e, 0x99, 0xa8, 0x29, 0xd2, 0xaa, 0xe9, 0xc0, 0x8b, 0x9c, 0xd3, 0x81, 0x9b, 0xf9,
+	0x91, 0x41, 0x5e, 0x5c, 0xa7, 0x09, 0xfd, 0x0a, 0x1b, 0x14, 0xad, 0x92, 0x06, 0x23, 0xf6, 0xba,
+	0x60, 0xbf, 0x8d, 0xeb, 0x2a, 0x76, 0x79, 0x73, 0x8f, 0xcd, 0xf5, 0x13, 0x06, 0x05, 0x91, 0x85,
+	0x63, 0x0a, 0x0d, 0x4f, 0x3f, 0x05, 0xa3, 0xc6, 0x42, 0xdf, 0x10, 0x7a, 0x56, 0x71, 0x25, 0x49,
+	0x8f, 0x34, 0x39, 0x27, 0x5b, 0xb7, 0xff, 0x3a, 0x2d, 0xb1, 0xe7, 0xa7, 0x25, 0xf6, 0xef, 0x69,
+	0x89, 0xfd, 0x7c, 0x56, 0x9a, 0x7a, 0x7e, 0x56, 0x9a, 0xfa, 0xe7, 0xac, 0x34, 0xf5, 0x39, 0xfd,
+	0x64, 0xc2, 0xdb, 0x5f, 0x19, 0x5d, 0xd7, 0xfc, 0xd6, 0xcf, 0xb2, 0x57, 0x10, 0xbf, 0x68, 0x34,
+	0xfe, 0x1f, 0x00, 0xd9, 0xf8, 0x0c, 0x3d, 0x6f, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1382,6 +1612,14 @@ type QueryClient interface {
 	ClassByQueryString(ctx context.Context, in *QueryClassByQueryStringRequest, opts ...grpc.CallOption) (*QueryClassByQueryStringResponse, error)
 	// Classes queries all NFT classes
 	Classes(ctx context.Context, in *QueryClassesRequest, opts ...grpc.CallOption) (*QueryClassesResponse, error)
+	// Lease queries the active lease of an NFT based on its class and id.
+	//
+	// Since: nft v0.1.1
+	Lease(ctx context.Context, in *QueryLeaseRequest, opts ...grpc.CallOption) (*QueryLeaseResponse, error)
+	// Leases queries the active leases of NFTs granted to a lessee.
+	//
+	// Since: nft v0.1.1
+	Leases(ctx context.Context, in *QueryLeasesRequest, opts ...grpc.CallOption) (*QueryLeasesResponse, error)
 }
 
 type queryClient struct {
@@ -1500,6 +1738,24 @@ func (c *queryClient) Classes(ctx context.Context, in *QueryClassesRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) Lease(ctx context.Context, in *QueryLeaseRequest, opts ...grpc.CallOption) (*QueryLeaseResponse, error) {
+	out := new(QueryLeaseResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.Query/Lease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Leases(ctx context.Context, in *QueryLeasesRequest, opts ...grpc.CallOption) (*QueryLeasesResponse, error) {
+	out := new(QueryLeasesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.Query/Leases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Balance queries the number of NFTs of a given class owned by the owner, same as balanceOf in ERC721
@@ -1537,6 +1793,14 @@ type QueryServer interface {
 	ClassByQueryString(context.Context, *QueryClassByQueryStringRequest) (*QueryClassByQueryStringResponse, error)
 	// Classes queries all NFT classes
 	Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error)
+	// Lease queries the active lease of an NFT based on its class and id.
+	//
+	// Since: nft v0.1.1
+	Lease(context.Context, *QueryLeaseRequest) (*QueryLeaseResponse, error)
+	// Leases queries the active leases of NFTs granted to a lessee.
+	//
+	// Since: nft v0.1.1
+	Leases(context.Context, *QueryLeasesRequest) (*QueryLeasesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1579,6 +1843,12 @@ func (*UnimplementedQueryServer) ClassByQueryString(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) Classes(ctx context.Context, req *QueryClassesRequest) (*QueryClassesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Classes not implemented")
 }
+func (*UnimplementedQueryServer) Lease(ctx context.Context, req *QueryLeaseRequest) (*QueryLeaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lease not implemented")
+}
+func (*UnimplementedQueryServer) Leases(ctx context.Context, req *QueryLeasesRequest) (*QueryLeasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Leases not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1800,6 +2070,42 @@ func _Query_Classes_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Lease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Lease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.nft.v1beta1.Query/Lease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Lease(ctx, req.(*QueryLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Leases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLeasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Leases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.nft.v1beta1.Query/Leases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Leases(ctx, req.(*QueryLeasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.nft.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1852,6 +2158,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Classes",
 			Handler:    _Query_Classes_Handler,
 		},
+		{
+			MethodName: "Lease",
+			Handler:    _Query_Lease_Handler,
+		},
+		{
+			MethodName: "Leases",
+			Handler:    _Query_Leases_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/nft/v1beta1/query.proto",
@@ -2693,49 +3007,212 @@ func (m *QueryClassesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryLeaseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryLeaseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeaseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBalanceByQueryStringRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryLeaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Lease != nil {
+		{
+			size, err := m.Lease.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLeasesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeasesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeasesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Lessee) > 0 {
+		i -= len(m.Lessee)
+		copy(dAtA[i:], m.Lessee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Lessee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLeasesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeasesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeasesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Leases) > 0 {
+		for iNdEx := len(m.Leases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Leases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBalanceByQueryStringRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *QueryBalanceResponse) Size() (n int) {
@@ -3056,6 +3533,72 @@ func (m *QueryClassesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryLeaseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLeaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Lease != nil {
+		l = m.Lease.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLeasesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lessee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLeasesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Leases) > 0 {
+		for _, e := range m.Leases {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5334,6 +5877,444 @@ func (m *QueryClassesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryLeaseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLeaseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLeaseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLeaseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLeaseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLeaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lease", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Lease == nil {
+				m.Lease = &Lease{}
+			}
+			if err := m.Lease.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLeasesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLeasesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLeasesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lessee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lessee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLeasesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLeasesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLeasesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Leases = append(m.Leases, &Lease{})
+			if err := m.Leases[len(m.Leases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -28,6 +28,92 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Example:        fmt.Sprintf(`$ %s query protocolpool unclaimed-budget cosmos1...`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
 				},
+				{
+					RpcMethod:      "EscrowedSpend",
+					Use:            "escrowed-spend [id]",
+					Short:          "Query an escrowed community pool spend by id",
+					Example:        fmt.Sprintf(`$ %s query protocolpool escrowed-spend 1`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "EscrowedSpends",
+					Use:       "escrowed-spends",
+					Short:     "Query all escrowed community pool spends",
+					Example:   fmt.Sprintf(`$ %s query protocolpool escrowed-spends`, version.AppName),
+				},
+				{
+					RpcMethod:      "SpendAllowance",
+					Use:            "spend-allowance [denom]",
+					Short:          "Query the remaining amount of a denom which can be spent from the community pool in the current spend window",
+					Example:        fmt.Sprintf(`$ %s query protocolpool spend-allowance uatom`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod: "SpendAllowances",
+					Use:       "spend-allowances",
+					Short:     "Query the remaining amounts of all the limited denoms which can be spent from the community pool",
+					Example:   fmt.Sprintf(`$ %s query protocolpool spend-allowances`, version.AppName),
+				},
+				{
+					RpcMethod: "DenomPolicies",
+					Use:       "denom-policies",
+					Short:     "Query the policies applied to the community pool holdings of unwanted denoms",
+					Example:   fmt.Sprintf(`$ %s query protocolpool denom-policies`, version.AppName),
+				},
+				{
+					RpcMethod: "FundingSources",
+					Use:       "funding-sources",
+					Short:     "Query the cumulative amounts which entered the community pool, per funding source",
+					Example:   fmt.Sprintf(`$ %s query protocolpool funding-sources`, version.AppName),
+				},
+				{
+					RpcMethod:      "RecurringGrant",
+					Use:            "recurring-grant [recipient]",
+					Short:          "Query the recurring grant of a recipient",
+					Example:        fmt.Sprintf(`$ %s query protocolpool recurring-grant cosmos1...`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient"}},
+				},
+				{
+					RpcMethod: "RecurringGrants",
+					Use:       "recurring-grants",
+					Short:     "Query all recurring grants",
+					Example:   fmt.Sprintf(`$ %s query protocolpool recurring-grants`, version.AppName),
+				},
+				{
+					RpcMethod: "PoolStaking",
+					Use:       "pool-staking",
+					Short:     "Query the liquid balance and the delegations of the community pool",
+					Example:   fmt.Sprintf(`$ %s query protocolpool pool-staking`, version.AppName),
+				},
+				{
+					RpcMethod: "PoolBalanceChanges",
+					Skip:      true, // skipped because streaming queries are not supported
+				},
+				{
+					RpcMethod:      "Position",
+					Use:            "position [id]",
+					Short:          "Query a community pool investment position by its id",
+					Example:        fmt.Sprintf(`$ %s query protocolpool position 1`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "Positions",
+					Use:       "positions",
+					Short:     "Query the community pool investment positions, optionally filtered by status",
+					Example:   fmt.Sprintf(`$ %s query protocolpool positions --status POSITION_STATUS_OPEN`, version.AppName),
+				},
+				{
+					RpcMethod: "DeployedCapital",
+					Use:       "deployed-capital",
+					Short:     "Query the total principal and current value of the open community pool investment positions",
+					Example:   fmt.Sprintf(`$ %s query protocolpool deployed-capital`, version.AppName),
+				},
+				{
+					RpcMethod: "OutflowFreeze",
+					Use:       "outflow-freeze",
+					Short:     "Query the freeze parameters and the active freeze of the community pool outflows",
+					Example:   fmt.Sprintf(`$ %s query protocolpool outflow-freeze`, version.AppName),
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -89,6 +175,142 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod: "CommunityPoolEscrowSpend",
+					Skip:      true, // skipped because milestones are not supported as positional args
+				},
+				{
+					RpcMethod: "SetSpendLimits",
+					Skip:      true, // skipped because spend limits are not supported as positional args
+				},
+				{
+					RpcMethod: "SetDenomPolicies",
+					Skip:      true, // skipped because denom policies are not supported as positional args
+				},
+				{
+					RpcMethod: "ApproveMilestone",
+					Use:       "approve-milestone [escrow-id] [milestone-index]",
+					Short:     "Approve a milestone of an escrowed spend and release its funds to the recipient",
+					Example:   fmt.Sprintf(`$ %s tx protocolpool approve-milestone 1 0 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "escrow_id"},
+						{ProtoField: "milestone_index"},
+					},
+				},
+				{
+					RpcMethod: "CreateRecurringGrant",
+					Use:       "create-recurring-grant [recipient] [amount] [period] [renewal-interval]",
+					Short:     "Create a recurring grant paying the recipient every period, renewed by governance every renewal interval",
+					Example:   fmt.Sprintf(`$ %s tx protocolpool create-recurring-grant cosmos1... 1000uatom 720h 8760h --renewal-deposit 1000uatom --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "recipient"},
+						{ProtoField: "amount"},
+						{ProtoField: "period"},
+						{ProtoField: "renewal_interval"},
+					},
+					GovProposal: true,
+				},
+				{
+					RpcMethod: "RenewRecurringGrant",
+					Use:       "renew-recurring-grant [recipient]",
+					Short:     "Renew the recurring grant of a recipient until its next renewal",
+					Example:   fmt.Sprintf(`$ %s tx protocolpool renew-recurring-grant cosmos1... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "recipient"},
+					},
+					GovProposal: true,
+				},
+				{
+					RpcMethod: "CancelRecurringGrant",
+					Use:       "cancel-recurring-grant [recipient]",
+					Short:     "Cancel the recurring grant of a recipient",
+					Example:   fmt.Sprintf(`$ %s tx protocolpool cancel-recurring-grant cosmos1... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "recipient"},
+					},
+					GovProposal: true,
+				},
+				{
+					RpcMethod: "PoolDelegate",
+					Use:       "pool-delegate [validator-address] [amount]",
+					Short:     "Delegate funds of the community pool to a validator",
+					Example:   fmt.Sprintf(`$ %s tx protocolpool pool-delegate cosmosvaloper1... 1000stake --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+						{ProtoField: "amount"},
+					},
+					GovProposal: true,
+				},
+				{
+					RpcMethod: "PoolUndelegate",
+					Use:       "pool-undelegate [validator-address] [amount]",
+					Short:     "Undelegate funds of the community pool from a validator",
+					Example:   fmt.Sprintf(`$ %s tx protocolpool pool-undelegate cosmosvaloper1... 1000stake --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+						{ProtoField: "amount"},
+					},
+					GovProposal: true,
+				},
+				{
+					RpcMethod: "OpenPosition",
+					Use:       "open-position [venue] [principal]",
+					Short:     "Record an investment position of the community pool, sending its principal to the recipient if set",
+					Example:   fmt.Sprintf(`$ %s tx protocolpool open-position osmosis/pool/1 1000uatom --recipient cosmos1... --reporter cosmos1... --expected-return 1100uatom --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "venue"},
+						{ProtoField: "principal"},
+					},
+					GovProposal: true,
+				},
+				{
+					RpcMethod: "ReportPosition",
+					Use:       "report-position [position-id] [current-value]",
+					Short:     "Report the current value of a community pool investment position, closing it with --close",
+					Example:   fmt.Sprintf(`$ %s tx protocolpool report-position 1 1050uatom --report-note "accrued fees" --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "position_id"},
+						{ProtoField: "current_value"},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"note": {Name: "report-note", Usage: "Free-form note attached to the report"},
+					},
+				},
+				{
+					RpcMethod: "SetFreezeParams",
+					Use:       "set-freeze-params [params]",
+					Short:     "Set who can freeze the community pool outflows in an emergency, and for how long",
+					Example:   fmt.Sprintf(`$ %s tx protocolpool set-freeze-params '{"security_authority": "cosmos1...", "max_duration": "604800s", "ratification_deposit": [{"denom": "stake", "amount": "1000"}]}' --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "params"},
+					},
+					GovProposal: true,
+				},
+				{
+					RpcMethod: "FreezeOutflows",
+					Use:       "freeze-outflows [duration]",
+					Short:     "Freeze the community pool outflows, as the security authority or the authority",
+					Example:   fmt.Sprintf(`$ %s tx protocolpool freeze-outflows 72h --reason "ongoing governance attack" --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "duration"},
+					},
+				},
+				{
+					RpcMethod: "RatifyFreeze",
+					Use:       "ratify-freeze [freeze-start-time]",
+					Short:     "Ratify the active freeze of the community pool outflows",
+					Example:   fmt.Sprintf(`$ %s tx protocolpool ratify-freeze 2024-01-01T00:00:00Z --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "freeze_start_time"},
+					},
+					GovProposal: true,
+				},
+				{
+					RpcMethod: "LiftFreeze",
+					Use:       "lift-freeze",
+					Short:     "Lift the active freeze of the community pool outflows, as the authority or the security authority",
+					Example:   fmt.Sprintf(`$ %s tx protocolpool lift-freeze --from mykey`, version.AppName),
+				},
 			},
 		},
 	}
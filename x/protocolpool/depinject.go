@@ -3,9 +3,12 @@ package protocolpool
 import (
 	modulev1 "cosmossdk.io/api/cosmos/protocolpool/module/v1"
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
 	authtypes "cosmossdk.io/x/auth/types"
+	govkeeper "cosmossdk.io/x/gov/keeper"
+	govtypes "cosmossdk.io/x/gov/types"
 	"cosmossdk.io/x/protocolpool/keeper"
 	"cosmossdk.io/x/protocolpool/simulation"
 	"cosmossdk.io/x/protocolpool/types"
@@ -24,26 +27,32 @@ func init() {
 	appconfig.RegisterModule(
 		&modulev1.Module{},
 		appconfig.Provide(ProvideModule),
+		appconfig.Invoke(InvokeSetGovMsgServer),
 	)
 }
 
 type ModuleInputs struct {
 	depinject.In
 
-	Config      *modulev1.Module
-	Codec       codec.Codec
-	Environment appmodule.Environment
+	Config          *modulev1.Module
+	Codec           codec.Codec
+	Environment     appmodule.Environment
+	MemStoreService store.MemoryStoreService
 
 	AccountKeeper types.AccountKeeper
 	BankKeeper    types.BankKeeper
 	StakingKeeper types.StakingKeeper
+
+	SwapHook     types.SwapHook         `optional:"true"`
+	BalanceHooks types.PoolBalanceHooks `optional:"true"`
 }
 
 type ModuleOutputs struct {
 	depinject.Out
 
-	Keeper keeper.Keeper
-	Module appmodule.AppModule
+	Keeper   keeper.Keeper
+	Module   appmodule.AppModule
+	GovHooks govtypes.GovHooksWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -53,15 +62,35 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
 	}
 
-	k := keeper.NewKeeper(in.Codec, in.Environment, in.AccountKeeper, in.BankKeeper, in.StakingKeeper, authority.String())
+	env := in.Environment
+	env.MemStoreService = in.MemStoreService
+
+	k := keeper.NewKeeper(in.Codec, env, in.AccountKeeper, in.BankKeeper, in.StakingKeeper, authority.String())
+	if in.SwapHook != nil {
+		k.SetSwapHook(in.SwapHook)
+	}
+	if in.BalanceHooks != nil {
+		k.SetBalanceHooks(in.BalanceHooks)
+	}
 	m := NewAppModule(in.Codec, k, in.AccountKeeper, in.BankKeeper)
 
 	return ModuleOutputs{
-		Keeper: k,
-		Module: m,
+		Keeper:   k,
+		Module:   m,
+		GovHooks: govtypes.GovHooksWrapper{GovHooks: k.Hooks()},
 	}
 }
 
+// InvokeSetGovMsgServer sets the gov msg server used to submit the renewal
+// proposals of the recurring grants.
+func InvokeSetGovMsgServer(k keeper.Keeper, govKeeper *govkeeper.Keeper) {
+	if govKeeper == nil {
+		return
+	}
+
+	k.SetGovMsgServer(govkeeper.NewMsgServerImpl(govKeeper))
+}
+
 // ____________________________________________________________________________
 
 // AppModuleSimulation functions
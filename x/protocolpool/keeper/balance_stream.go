@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"context"
+	"sync"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/x/protocolpool/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// balanceChangeBufferSize is the number of balance changes buffered for a
+// subscriber. A subscriber falling further behind is dropped.
+const balanceChangeBufferSize = 100
+
+// balanceStream records the community pool balance changes of a block in the
+// memory store, so that changes made by failed transactions are discarded with
+// the rest of their state, and publishes them to the subscribers once the block
+// is committed.
+type balanceStream struct {
+	// pending key: Height | Sequence | value: PoolBalanceChange
+	pending  collections.Map[collections.Pair[int64, uint64], types.PoolBalanceChange]
+	sequence collections.Sequence
+
+	mu          sync.Mutex
+	nextID      uint64
+	subscribers map[uint64]chan types.PoolBalanceChange
+}
+
+func newBalanceStream(cdc codec.BinaryCodec, memStoreService store.MemoryStoreService) *balanceStream {
+	sb := collections.NewSchemaBuilderFromAccessor(memStoreService.OpenMemoryStore)
+	s := &balanceStream{
+		pending:     collections.NewMap(sb, types.PendingBalanceChangeKey, "pending_balance_changes", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key), codec.CollValue[types.PoolBalanceChange](cdc)),
+		sequence:    collections.NewSequence(sb, types.PendingBalanceChangeSequenceKey, "pending_balance_change_sequence"),
+		subscribers: make(map[uint64]chan types.PoolBalanceChange),
+	}
+	if _, err := sb.Build(); err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// record stores a balance change until the block is committed. The changes of
+// the previous blocks, which have been published already, are removed first.
+func (s *balanceStream) record(ctx context.Context, change types.PoolBalanceChange) error {
+	rng := new(collections.Range[collections.Pair[int64, uint64]]).
+		EndExclusive(collections.Join(change.Height, uint64(0)))
+	if err := s.pending.Clear(ctx, rng); err != nil {
+		return err
+	}
+
+	seq, err := s.sequence.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.pending.Set(ctx, collections.Join(change.Height, seq), change)
+}
+
+// pendingChanges returns the balance changes recorded at the given height.
+func (s *balanceStream) pendingChanges(ctx context.Context, height int64) ([]types.PoolBalanceChange, error) {
+	iter, err := s.pending.Iterate(ctx, collections.NewPrefixedPairRange[int64, uint64](height))
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Values()
+}
+
+// subscribe registers a new subscriber and returns its id along with the
+// channel the balance changes are sent to.
+func (s *balanceStream) subscribe() (uint64, <-chan types.PoolBalanceChange) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := s.nextID
+	s.nextID++
+	ch := make(chan types.PoolBalanceChange, balanceChangeBufferSize)
+	s.subscribers[id] = ch
+
+	return id, ch
+}
+
+// unsubscribe removes a subscriber, closing its channel.
+func (s *balanceStream) unsubscribe(id uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if ch, ok := s.subscribers[id]; ok {
+		close(ch)
+		delete(s.subscribers, id)
+	}
+}
+
+// publish sends the balance changes to all the subscribers without blocking.
+// The channel of a subscriber whose buffer is full is closed and the subscriber
+// is removed.
+func (s *balanceStream) publish(changes []types.PoolBalanceChange) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, ch := range s.subscribers {
+		if !trySend(ch, changes) {
+			close(ch)
+			delete(s.subscribers, id)
+		}
+	}
+}
+
+// trySend sends the balance changes to the channel, returning false if its
+// buffer is full.
+func trySend(ch chan<- types.PoolBalanceChange, changes []types.PoolBalanceChange) bool {
+	for _, change := range changes {
+		select {
+		case ch <- change:
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// SetBalanceHooks sets the hooks called whenever the community pool balance is
+// changed by the protocolpool module.
+func (k *Keeper) SetBalanceHooks(hooks types.PoolBalanceHooks) {
+	if k.balanceHooks != nil {
+		panic("cannot set balance hooks twice")
+	}
+
+	k.balanceHooks = hooks
+}
+
+// afterBalanceChanged calls the balance hooks and records the change for the
+// subscribers of the balance changes stream.
+func (k Keeper) afterBalanceChanged(ctx context.Context, source string, increase, decrease sdk.Coins) error {
+	if increase.IsZero() && decrease.IsZero() {
+		return nil
+	}
+
+	change := types.PoolBalanceChange{
+		Height:   k.environment.HeaderService.GetHeaderInfo(ctx).Height,
+		Source:   source,
+		Increase: increase,
+		Decrease: decrease,
+	}
+
+	if k.balanceHooks != nil {
+		if err := k.balanceHooks.AfterPoolBalanceChanged(ctx, change); err != nil {
+			return err
+		}
+	}
+
+	if k.balanceStream == nil {
+		return nil
+	}
+
+	return k.balanceStream.record(ctx, change)
+}
+
+// PublishBalanceChanges sends the community pool balance changes of the current
+// block to the subscribers of the balance changes stream. It must be called once
+// the block state is final, as the changes are not reverted after being sent.
+func (k Keeper) PublishBalanceChanges(ctx context.Context) error {
+	if k.balanceStream == nil {
+		return nil
+	}
+
+	changes, err := k.balanceStream.pendingChanges(ctx, k.environment.HeaderService.GetHeaderInfo(ctx).Height)
+	if err != nil || len(changes) == 0 {
+		return err
+	}
+
+	k.balanceStream.publish(changes)
+	return nil
+}
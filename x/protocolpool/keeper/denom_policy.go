@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/protocolpool/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetDenomPolicies replaces the policies applied to the community pool holdings
+// of unwanted denoms.
+func (k Keeper) SetDenomPolicies(ctx context.Context, policies []types.DenomPolicy) error {
+	if err := types.ValidateDenomPolicies(policies); err != nil {
+		return err
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, policy := range policies {
+		if policy.Denom == bondDenom {
+			return errorsmod.Wrapf(types.ErrInvalidDenomPolicy, "cannot set a policy for the bond denom %s", bondDenom)
+		}
+		if policy.Action == types.DenomPolicyActionSwap && k.swapHook == nil {
+			return errorsmod.Wrapf(types.ErrInvalidDenomPolicy, "cannot swap %s: no swap hook registered", policy.Denom)
+		}
+	}
+
+	if err := k.DenomPolicies.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	for _, policy := range policies {
+		if err := k.DenomPolicies.Set(ctx, policy.Denom, policy); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ApplyDenomPolicies burns or swaps the community pool holdings of the denoms
+// with a policy. A failed swap is reverted and logged, leaving the holdings of
+// the denom in the community pool until the next block.
+func (k Keeper) ApplyDenomPolicies(ctx context.Context) error {
+	poolAddr := k.authKeeper.GetModuleAddress(types.ModuleName)
+
+	var policies []types.DenomPolicy
+	err := k.DenomPolicies.Walk(ctx, nil, func(_ string, policy types.DenomPolicy) (stop bool, err error) {
+		policies = append(policies, policy)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, policy := range policies {
+		balance := k.bankKeeper.GetBalance(ctx, poolAddr, policy.Denom)
+		if balance.IsZero() {
+			continue
+		}
+
+		switch policy.Action {
+		case types.DenomPolicyActionBurn:
+			if err := k.bankKeeper.BurnCoins(ctx, poolAddr, sdk.NewCoins(balance)); err != nil {
+				return err
+			}
+			if err := k.afterBalanceChanged(ctx, types.BalanceChangeSourceDenomPolicy, nil, sdk.NewCoins(balance)); err != nil {
+				return err
+			}
+			k.Logger(ctx).Info("burned community pool holdings", "amount", balance.String())
+
+		case types.DenomPolicyActionSwap:
+			if k.swapHook == nil {
+				k.Logger(ctx).Error("cannot swap community pool holdings: no swap hook registered", "amount", balance.String())
+				continue
+			}
+
+			err := k.environment.BranchService.Execute(ctx, func(ctx context.Context) error {
+				before := k.bankKeeper.GetAllBalances(ctx, poolAddr)
+				if err := k.swapHook.SwapCommunityPoolDenom(ctx, poolAddr, balance); err != nil {
+					return err
+				}
+
+				increase, decrease := balanceDiff(before, k.bankKeeper.GetAllBalances(ctx, poolAddr))
+				return k.afterBalanceChanged(ctx, types.BalanceChangeSourceDenomPolicy, increase, decrease)
+			})
+			if err != nil {
+				k.Logger(ctx).Error("failed to swap community pool holdings", "amount", balance.String(), "err", err)
+				continue
+			}
+			k.Logger(ctx).Info("swapped community pool holdings", "amount", balance.String())
+		}
+	}
+
+	return nil
+}
+
+// balanceDiff returns the coins gained and lost going from the before to the
+// after balance.
+func balanceDiff(before, after sdk.Coins) (increase, decrease sdk.Coins) {
+	for _, coin := range after {
+		if diff := coin.Amount.Sub(before.AmountOf(coin.Denom)); diff.IsPositive() {
+			increase = increase.Add(sdk.NewCoin(coin.Denom, diff))
+		}
+	}
+	for _, coin := range before {
+		if diff := coin.Amount.Sub(after.AmountOf(coin.Denom)); diff.IsPositive() {
+			decrease = decrease.Add(sdk.NewCoin(coin.Denom, diff))
+		}
+	}
+
+	return increase, decrease
+}
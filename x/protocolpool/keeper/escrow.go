@@ -0,0 +1,206 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/protocolpool/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CreateEscrowedSpend moves the total amount of the milestones from the community pool
+// to the escrow account and stores the escrowed spend. It returns the escrowed spend id.
+func (k Keeper) CreateEscrowedSpend(ctx context.Context, recipient, reviewer string, milestones []types.Milestone, deadline time.Time) (uint64, error) {
+	if _, err := k.authKeeper.AddressCodec().StringToBytes(recipient); err != nil {
+		return 0, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	if reviewer != "" {
+		if _, err := k.authKeeper.AddressCodec().StringToBytes(reviewer); err != nil {
+			return 0, sdkerrors.ErrInvalidAddress.Wrapf("invalid reviewer address: %s", err)
+		}
+	}
+
+	if err := types.ValidateMilestones(milestones); err != nil {
+		return 0, err
+	}
+
+	currentTime := k.environment.HeaderService.GetHeaderInfo(ctx).Time
+	if !deadline.After(currentTime) {
+		return 0, errorsmod.Wrap(types.ErrInvalidEscrow, "deadline must be after the current block time")
+	}
+
+	if err := k.checkOutflowsNotFrozen(ctx); err != nil {
+		return 0, err
+	}
+	if err := k.trackSpend(ctx, types.TotalAmount(milestones)); err != nil {
+		return 0, err
+	}
+
+	// move the escrowed funds out of the community pool
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.EscrowAccount, types.TotalAmount(milestones)); err != nil {
+		return 0, err
+	}
+	if err := k.afterBalanceChanged(ctx, types.BalanceChangeSourceEscrow, nil, types.TotalAmount(milestones)); err != nil {
+		return 0, err
+	}
+
+	id, err := k.EscrowedSpendSequence.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	escrow := types.EscrowedSpend{
+		Id:         id,
+		Recipient:  recipient,
+		Reviewer:   reviewer,
+		Milestones: make([]types.Milestone, len(milestones)),
+		Deadline:   deadline,
+	}
+	for i, m := range milestones {
+		escrow.Milestones[i] = types.Milestone{Description: m.Description, Amount: m.Amount}
+	}
+
+	if err := k.setEscrowedSpend(ctx, escrow); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// ApproveMilestone approves a milestone of an escrowed spend and releases its amount
+// to the recipient. Only the reviewer of the escrowed spend or the authority can
+// approve milestones, and not while the community pool outflows are frozen.
+func (k Keeper) ApproveMilestone(ctx context.Context, approver []byte, escrowID, milestoneIndex uint64) (sdk.Coins, error) {
+	escrow, err := k.EscrowedSpends.Get(ctx, escrowID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidEscrow, "no escrowed spend found for id %d", escrowID)
+		}
+		return nil, err
+	}
+
+	isAuthority, err := k.hasPermission(ctx, approver)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthority {
+		if escrow.Reviewer == "" {
+			return nil, types.ErrUnauthorized
+		}
+		reviewer, err := k.authKeeper.AddressCodec().StringToBytes(escrow.Reviewer)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(reviewer, approver) {
+			return nil, types.ErrUnauthorized
+		}
+	}
+
+	if k.environment.HeaderService.GetHeaderInfo(ctx).Time.After(escrow.Deadline) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidEscrow, "escrowed spend %d deadline has passed", escrowID)
+	}
+
+	if milestoneIndex >= uint64(len(escrow.Milestones)) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidEscrow, "milestone %d does not exist", milestoneIndex)
+	}
+
+	milestone := &escrow.Milestones[milestoneIndex]
+	if milestone.Approved {
+		return nil, errorsmod.Wrapf(types.ErrInvalidEscrow, "milestone %d is already approved", milestoneIndex)
+	}
+	milestone.Approved = true
+
+	if err := k.checkOutflowsNotFrozen(ctx); err != nil {
+		return nil, err
+	}
+
+	recipient, err := k.authKeeper.AddressCodec().StringToBytes(escrow.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.EscrowAccount, recipient, milestone.Amount); err != nil {
+		return nil, fmt.Errorf("error while releasing milestone funds to the recipient %s: %w", escrow.Recipient, err)
+	}
+
+	if types.TotalAmount(unapprovedMilestones(escrow)).IsZero() {
+		return milestone.Amount, k.removeEscrowedSpend(ctx, escrow)
+	}
+
+	return milestone.Amount, k.EscrowedSpends.Set(ctx, escrow.Id, escrow)
+}
+
+// ClawbackExpiredEscrows returns the funds of the milestones not approved before the
+// deadline of their escrowed spend to the community pool, and removes the expired
+// escrowed spends.
+func (k Keeper) ClawbackExpiredEscrows(ctx context.Context) error {
+	currentTime := k.environment.HeaderService.GetHeaderInfo(ctx).Time
+	rng := new(collections.Range[collections.Pair[time.Time, uint64]]).
+		EndExclusive(collections.Join(currentTime, uint64(0)))
+
+	iter, err := k.EscrowDeadlineQueue.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		escrow, err := k.EscrowedSpends.Get(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+
+		clawback := types.TotalAmount(unapprovedMilestones(escrow))
+		if !clawback.IsZero() {
+			if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.EscrowAccount, types.ModuleName, clawback); err != nil {
+				return err
+			}
+			if err := k.afterBalanceChanged(ctx, types.BalanceChangeSourceEscrow, clawback, nil); err != nil {
+				return err
+			}
+		}
+
+		k.Logger(ctx).Debug(fmt.Sprintf("Clawing back escrowed spend %d. Amount: %s", escrow.Id, clawback))
+
+		if err := k.removeEscrowedSpend(ctx, escrow); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) setEscrowedSpend(ctx context.Context, escrow types.EscrowedSpend) error {
+	if err := k.EscrowedSpends.Set(ctx, escrow.Id, escrow); err != nil {
+		return err
+	}
+	return k.EscrowDeadlineQueue.Set(ctx, collections.Join(escrow.Deadline, escrow.Id))
+}
+
+func (k Keeper) removeEscrowedSpend(ctx context.Context, escrow types.EscrowedSpend) error {
+	if err := k.EscrowedSpends.Remove(ctx, escrow.Id); err != nil {
+		return err
+	}
+	return k.EscrowDeadlineQueue.Remove(ctx, collections.Join(escrow.Deadline, escrow.Id))
+}
+
+func unapprovedMilestones(escrow types.EscrowedSpend) []types.Milestone {
+	var milestones []types.Milestone
+	for _, m := range escrow.Milestones {
+		if !m.Approved {
+			milestones = append(milestones, m)
+		}
+	}
+	return milestones
+}
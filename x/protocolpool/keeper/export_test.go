@@ -0,0 +1,10 @@
+package keeper
+
+// BalanceStreamSubscribers returns the number of subscribers of the balance
+// changes stream.
+func (k Keeper) BalanceStreamSubscribers() int {
+	k.balanceStream.mu.Lock()
+	defer k.balanceStream.mu.Unlock()
+
+	return len(k.balanceStream.subscribers)
+}
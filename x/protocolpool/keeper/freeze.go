@@ -0,0 +1,274 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	govv1 "cosmossdk.io/x/gov/types/v1"
+	"cosmossdk.io/x/protocolpool/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetFreezeParams sets who can freeze the community pool outflows, and for how
+// long. It does not affect the active freeze, if any.
+func (k Keeper) SetFreezeParams(ctx context.Context, params types.FreezeParams) error {
+	if params.SecurityAuthority != "" {
+		if _, err := k.authKeeper.AddressCodec().StringToBytes(params.SecurityAuthority); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid security authority address: %s", err)
+		}
+	}
+
+	if err := types.ValidateFreezeParams(params); err != nil {
+		return err
+	}
+
+	return k.FreezeParams.Set(ctx, params)
+}
+
+// GetFreezeParams returns the parameters of the freeze of the community pool
+// outflows.
+func (k Keeper) GetFreezeParams(ctx context.Context) (types.FreezeParams, error) {
+	params, err := k.FreezeParams.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.FreezeParams{}, err
+	}
+
+	return params, nil
+}
+
+// GetOutflowFreeze returns the active freeze of the community pool outflows, and
+// false if the outflows are not frozen.
+func (k Keeper) GetOutflowFreeze(ctx context.Context) (types.OutflowFreeze, bool, error) {
+	freeze, err := k.OutflowFreeze.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.OutflowFreeze{}, false, nil
+		}
+		return types.OutflowFreeze{}, false, err
+	}
+
+	// the freeze is removed at the end of the block it expires in
+	if !k.environment.HeaderService.GetHeaderInfo(ctx).Time.Before(freeze.EndTime) {
+		return types.OutflowFreeze{}, false, nil
+	}
+
+	return freeze, true, nil
+}
+
+// FreezeOutflows freezes the community pool outflows for the given duration. The
+// sender must be the security authority or the authority. A freeze activated by
+// the security authority must be ratified by governance: a ratification proposal
+// is submitted, and the freeze is lifted if the proposal does not pass.
+func (k Keeper) FreezeOutflows(ctx context.Context, sender []byte, duration time.Duration, reason string) (types.OutflowFreeze, error) {
+	params, err := k.GetFreezeParams(ctx)
+	if err != nil {
+		return types.OutflowFreeze{}, err
+	}
+
+	isAuthority, err := k.isAuthorityOrSecurityAuthority(ctx, sender, params)
+	if err != nil {
+		return types.OutflowFreeze{}, err
+	}
+
+	if _, frozen, err := k.GetOutflowFreeze(ctx); err != nil {
+		return types.OutflowFreeze{}, err
+	} else if frozen {
+		return types.OutflowFreeze{}, errorsmod.Wrap(types.ErrInvalidFreeze, "community pool outflows are already frozen")
+	}
+
+	if duration <= 0 {
+		return types.OutflowFreeze{}, errorsmod.Wrap(types.ErrInvalidFreeze, "duration must be positive")
+	}
+	if duration > params.MaxDuration {
+		return types.OutflowFreeze{}, errorsmod.Wrapf(types.ErrInvalidFreeze, "duration %s exceeds the max duration %s", duration, params.MaxDuration)
+	}
+
+	frozenBy, err := k.authKeeper.AddressCodec().BytesToString(sender)
+	if err != nil {
+		return types.OutflowFreeze{}, err
+	}
+
+	currentTime := k.environment.HeaderService.GetHeaderInfo(ctx).Time
+	freeze := types.OutflowFreeze{
+		FrozenBy:  frozenBy,
+		Reason:    reason,
+		StartTime: currentTime,
+		EndTime:   currentTime.Add(duration),
+		Ratified:  isAuthority,
+	}
+
+	if !isAuthority {
+		freeze.RatificationProposalId, err = k.submitRatificationProposal(ctx, freeze, params.RatificationDeposit)
+		if err != nil {
+			return types.OutflowFreeze{}, errorsmod.Wrapf(types.ErrInvalidFreeze, "failed to submit the ratification proposal: %s", err)
+		}
+	}
+
+	if err := k.OutflowFreeze.Set(ctx, freeze); err != nil {
+		return types.OutflowFreeze{}, err
+	}
+
+	k.Logger(ctx).Info("froze community pool outflows", "frozen_by", frozenBy, "end_time", freeze.EndTime, "reason", reason)
+
+	return freeze, nil
+}
+
+// RatifyFreeze ratifies the active freeze of the community pool outflows, which
+// then lasts until its end time. The start time identifies the ratified freeze.
+func (k Keeper) RatifyFreeze(ctx context.Context, startTime time.Time) error {
+	freeze, frozen, err := k.GetOutflowFreeze(ctx)
+	if err != nil {
+		return err
+	}
+	if !frozen || !freeze.StartTime.Equal(startTime) {
+		return errorsmod.Wrapf(types.ErrInvalidFreeze, "no active freeze started at %s", startTime)
+	}
+	if freeze.Ratified {
+		return errorsmod.Wrap(types.ErrInvalidFreeze, "freeze is already ratified")
+	}
+
+	freeze.Ratified = true
+	freeze.RatificationProposalId = 0
+
+	return k.OutflowFreeze.Set(ctx, freeze)
+}
+
+// LiftFreeze lifts the active freeze of the community pool outflows. The sender
+// must be the authority or the security authority.
+func (k Keeper) LiftFreeze(ctx context.Context, sender []byte) error {
+	params, err := k.GetFreezeParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := k.isAuthorityOrSecurityAuthority(ctx, sender, params); err != nil {
+		return err
+	}
+
+	if _, frozen, err := k.GetOutflowFreeze(ctx); err != nil {
+		return err
+	} else if !frozen {
+		return errorsmod.Wrap(types.ErrInvalidFreeze, "community pool outflows are not frozen")
+	}
+
+	k.Logger(ctx).Info("lifted community pool outflow freeze")
+
+	return k.OutflowFreeze.Remove(ctx)
+}
+
+// ExpireOutflowFreeze removes the freeze of the community pool outflows once it
+// has reached its end time.
+func (k Keeper) ExpireOutflowFreeze(ctx context.Context) error {
+	freeze, err := k.OutflowFreeze.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if k.environment.HeaderService.GetHeaderInfo(ctx).Time.Before(freeze.EndTime) {
+		return nil
+	}
+
+	k.Logger(ctx).Info("community pool outflow freeze expired", "frozen_by", freeze.FrozenBy)
+
+	return k.OutflowFreeze.Remove(ctx)
+}
+
+// liftUnratifiedFreeze lifts the freeze whose ratification proposal ended without
+// ratifying it. It is a no-op for other proposals.
+func (k Keeper) liftUnratifiedFreeze(ctx context.Context, proposalID uint64) error {
+	freeze, err := k.OutflowFreeze.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if freeze.Ratified || freeze.RatificationProposalId != proposalID {
+		return nil
+	}
+
+	k.Logger(ctx).Info("lifting community pool outflow freeze not ratified by governance", "proposal_id", proposalID)
+
+	return k.OutflowFreeze.Remove(ctx)
+}
+
+// checkOutflowsNotFrozen returns an error if the community pool outflows are
+// frozen.
+func (k Keeper) checkOutflowsNotFrozen(ctx context.Context) error {
+	freeze, frozen, err := k.GetOutflowFreeze(ctx)
+	if err != nil {
+		return err
+	}
+	if frozen {
+		return errorsmod.Wrapf(types.ErrOutflowsFrozen, "until %s", freeze.EndTime)
+	}
+
+	return nil
+}
+
+// isAuthorityOrSecurityAuthority returns whether the sender is the authority, and
+// an error if it is neither the authority nor the security authority.
+func (k Keeper) isAuthorityOrSecurityAuthority(ctx context.Context, sender []byte, params types.FreezeParams) (bool, error) {
+	isAuthority, err := k.hasPermission(ctx, sender)
+	if err != nil {
+		return false, err
+	}
+	if isAuthority {
+		return true, nil
+	}
+
+	if params.SecurityAuthority != "" {
+		securityAuthority, err := k.authKeeper.AddressCodec().StringToBytes(params.SecurityAuthority)
+		if err != nil {
+			return false, err
+		}
+		if sdk.AccAddress(securityAuthority).Equals(sdk.AccAddress(sender)) {
+			return false, nil
+		}
+	}
+
+	return false, errorsmod.Wrap(types.ErrUnauthorized, "sender is neither the security authority nor the authority")
+}
+
+// submitRatificationProposal submits a proposal to ratify the freeze, with the
+// community pool as proposer and the given initial deposit.
+func (k Keeper) submitRatificationProposal(ctx context.Context, freeze types.OutflowFreeze, deposit sdk.Coins) (uint64, error) {
+	if k.govMsgServer.ms == nil {
+		return 0, errors.New("no gov msg server set")
+	}
+
+	proposer, err := k.authKeeper.AddressCodec().BytesToString(k.authKeeper.GetModuleAddress(types.ModuleName))
+	if err != nil {
+		return 0, err
+	}
+
+	msg, err := govv1.NewMsgSubmitProposal(
+		[]sdk.Msg{&types.MsgRatifyFreeze{Authority: k.authority, FreezeStartTime: freeze.StartTime}},
+		deposit,
+		proposer,
+		"",
+		"Ratify community pool outflow freeze",
+		fmt.Sprintf("Keep the community pool outflows frozen by %s until %s. Reason: %s. The freeze is lifted if this proposal does not pass.", freeze.FrozenBy, freeze.EndTime, freeze.Reason),
+		govv1.ProposalType_PROPOSAL_TYPE_STANDARD,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := k.govMsgServer.ms.SubmitProposal(ctx, msg)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.ProposalId, nil
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/protocolpool/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetFundingSources returns the cumulative amounts which entered the community
+// pool, per funding source.
+func (k Keeper) GetFundingSources(ctx context.Context) ([]types.FundingSource, error) {
+	var sources []types.FundingSource
+	err := k.FundingSources.Walk(ctx, nil, func(_ string, value types.FundingSource) (stop bool, err error) {
+		sources = append(sources, value)
+		return false, nil
+	})
+	return sources, err
+}
+
+// recordFunding adds amount to the cumulative total of the given funding source.
+func (k Keeper) recordFunding(ctx context.Context, source string, amount sdk.Coins) error {
+	if amount.IsZero() {
+		return nil
+	}
+
+	fundingSource, err := k.FundingSources.Get(ctx, source)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		fundingSource = types.FundingSource{Source: source}
+	}
+
+	fundingSource.Amount = fundingSource.Amount.Add(amount...)
+	return k.FundingSources.Set(ctx, source, fundingSource)
+}
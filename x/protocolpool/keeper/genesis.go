@@ -2,8 +2,11 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/protocolpool/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -48,6 +51,80 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error
 		return fmt.Errorf("failed to set to distribute: %w", err)
 	}
 
+	var nextEscrowID uint64
+	for _, escrow := range data.EscrowedSpends {
+		if err := k.setEscrowedSpend(ctx, escrow); err != nil {
+			return fmt.Errorf("failed to set escrowed spend %d: %w", escrow.Id, err)
+		}
+		if escrow.Id >= nextEscrowID {
+			nextEscrowID = escrow.Id + 1
+		}
+	}
+	if err := k.EscrowedSpendSequence.Set(ctx, nextEscrowID); err != nil {
+		return fmt.Errorf("failed to set escrowed spend sequence: %w", err)
+	}
+
+	for _, limit := range data.SpendLimits {
+		if err := k.SpendLimits.Set(ctx, limit.Denom, limit); err != nil {
+			return fmt.Errorf("failed to set spend limit for denom %s: %w", limit.Denom, err)
+		}
+	}
+	for _, window := range data.SpendWindows {
+		if err := k.SpendWindows.Set(ctx, window.Denom, window); err != nil {
+			return fmt.Errorf("failed to set spend window for denom %s: %w", window.Denom, err)
+		}
+	}
+	for _, policy := range data.DenomPolicies {
+		if err := k.DenomPolicies.Set(ctx, policy.Denom, policy); err != nil {
+			return fmt.Errorf("failed to set denom policy for denom %s: %w", policy.Denom, err)
+		}
+	}
+	for _, source := range data.FundingSources {
+		if err := k.FundingSources.Set(ctx, source.Source, source); err != nil {
+			return fmt.Errorf("failed to set funding source %s: %w", source.Source, err)
+		}
+	}
+	for _, grant := range data.RecurringGrants {
+		recipient, err := k.authKeeper.AddressCodec().StringToBytes(grant.Recipient)
+		if err != nil {
+			return fmt.Errorf("failed to decode recipient address: %w", err)
+		}
+		if err := k.setRecurringGrant(ctx, recipient, grant); err != nil {
+			return fmt.Errorf("failed to set recurring grant for recipient %s: %w", grant.Recipient, err)
+		}
+	}
+	for _, delegation := range data.PoolDelegations {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(delegation.ValidatorAddress)
+		if err != nil {
+			return fmt.Errorf("failed to decode validator address: %w", err)
+		}
+		if err := k.PoolDelegations.Set(ctx, valAddr, delegation.Principal); err != nil {
+			return fmt.Errorf("failed to set pool delegation for validator %s: %w", delegation.ValidatorAddress, err)
+		}
+	}
+
+	var nextPositionID uint64
+	for _, position := range data.Positions {
+		if err := k.Positions.Set(ctx, position.Id, position); err != nil {
+			return fmt.Errorf("failed to set position %d: %w", position.Id, err)
+		}
+		if position.Id >= nextPositionID {
+			nextPositionID = position.Id + 1
+		}
+	}
+	if err := k.PositionSequence.Set(ctx, nextPositionID); err != nil {
+		return fmt.Errorf("failed to set position sequence: %w", err)
+	}
+
+	if err := k.FreezeParams.Set(ctx, data.FreezeParams); err != nil {
+		return fmt.Errorf("failed to set freeze params: %w", err)
+	}
+	if data.OutflowFreeze != nil {
+		if err := k.OutflowFreeze.Set(ctx, *data.OutflowFreeze); err != nil {
+			return fmt.Errorf("failed to set outflow freeze: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -90,5 +167,85 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	err = k.EscrowedSpends.Walk(ctx, nil, func(_ uint64, value types.EscrowedSpend) (stop bool, err error) {
+		genState.EscrowedSpends = append(genState.EscrowedSpends, value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.SpendLimits.Walk(ctx, nil, func(_ string, value types.SpendLimit) (stop bool, err error) {
+		genState.SpendLimits = append(genState.SpendLimits, value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.SpendWindows.Walk(ctx, nil, func(_ string, value types.SpendWindow) (stop bool, err error) {
+		genState.SpendWindows = append(genState.SpendWindows, value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.DenomPolicies.Walk(ctx, nil, func(_ string, value types.DenomPolicy) (stop bool, err error) {
+		genState.DenomPolicies = append(genState.DenomPolicies, value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.FundingSources.Walk(ctx, nil, func(_ string, value types.FundingSource) (stop bool, err error) {
+		genState.FundingSources = append(genState.FundingSources, value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.RecurringGrants.Walk(ctx, nil, func(_ sdk.AccAddress, value types.RecurringGrant) (stop bool, err error) {
+		genState.RecurringGrants = append(genState.RecurringGrants, value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.PoolDelegations.Walk(ctx, nil, func(key sdk.ValAddress, value math.Int) (stop bool, err error) {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(key)
+		if err != nil {
+			return true, err
+		}
+		genState.PoolDelegations = append(genState.PoolDelegations, types.PoolDelegation{ValidatorAddress: valAddr, Principal: value})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Positions.Walk(ctx, nil, func(_ uint64, value types.Position) (stop bool, err error) {
+		genState.Positions = append(genState.Positions, value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genState.FreezeParams, err = k.GetFreezeParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	freeze, err := k.OutflowFreeze.Get(ctx)
+	if err == nil {
+		genState.OutflowFreeze = &freeze
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
 	return genState, nil
 }
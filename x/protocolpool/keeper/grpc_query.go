@@ -12,6 +12,7 @@ import (
 	"cosmossdk.io/x/protocolpool/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 var _ types.QueryServer = Querier{}
@@ -76,3 +77,295 @@ func (k Querier) UnclaimedBudget(ctx context.Context, req *types.QueryUnclaimedB
 		TranchesLeft:    budget.TranchesLeft,
 	}, nil
 }
+
+// EscrowedSpend queries an escrowed community pool spend by id
+func (k Querier) EscrowedSpend(ctx context.Context, req *types.QueryEscrowedSpendRequest) (*types.QueryEscrowedSpendResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	escrow, err := k.Keeper.EscrowedSpends.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no escrowed spend found for id: %d", req.Id)
+		}
+		return nil, err
+	}
+
+	return &types.QueryEscrowedSpendResponse{EscrowedSpend: &escrow}, nil
+}
+
+// EscrowedSpends queries all escrowed community pool spends
+func (k Querier) EscrowedSpends(ctx context.Context, req *types.QueryEscrowedSpendsRequest) (*types.QueryEscrowedSpendsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	escrows, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.EscrowedSpends, req.Pagination, func(_ uint64, value types.EscrowedSpend) (types.EscrowedSpend, error) {
+		return value, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEscrowedSpendsResponse{EscrowedSpends: escrows, Pagination: pageRes}, nil
+}
+
+// SpendAllowance returns the remaining amount of a denom which can be spent from
+// the community pool within the current spend window.
+func (k Querier) SpendAllowance(ctx context.Context, req *types.QuerySpendAllowanceRequest) (*types.QuerySpendAllowanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty denom")
+	}
+
+	allowance, err := k.Keeper.GetSpendAllowance(ctx, req.Denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no spend limit found for denom %s", req.Denom)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySpendAllowanceResponse{Allowance: allowance}, nil
+}
+
+// SpendAllowances returns the remaining amounts of all the limited denoms which
+// can be spent from the community pool within their current spend window.
+func (k Querier) SpendAllowances(ctx context.Context, req *types.QuerySpendAllowancesRequest) (*types.QuerySpendAllowancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	allowances, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.SpendLimits, req.Pagination, func(_ string, value types.SpendLimit) (types.SpendAllowance, error) {
+		return k.Keeper.spendAllowance(ctx, value)
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySpendAllowancesResponse{Allowances: allowances, Pagination: pageRes}, nil
+}
+
+// DenomPolicies returns the policies applied to the community pool holdings of
+// unwanted denoms.
+func (k Querier) DenomPolicies(ctx context.Context, req *types.QueryDenomPoliciesRequest) (*types.QueryDenomPoliciesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	policies, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.DenomPolicies, req.Pagination, func(_ string, value types.DenomPolicy) (types.DenomPolicy, error) {
+		return value, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomPoliciesResponse{DenomPolicies: policies, Pagination: pageRes}, nil
+}
+
+// FundingSources returns the cumulative amounts which entered the community pool,
+// per funding source.
+func (k Querier) FundingSources(ctx context.Context, req *types.QueryFundingSourcesRequest) (*types.QueryFundingSourcesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sources, err := k.GetFundingSources(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFundingSourcesResponse{FundingSources: sources}, nil
+}
+
+// RecurringGrant queries the recurring grant of a recipient
+func (k Querier) RecurringGrant(ctx context.Context, req *types.QueryRecurringGrantRequest) (*types.QueryRecurringGrantResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	recipient, err := k.Keeper.authKeeper.AddressCodec().StringToBytes(req.Recipient)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipient address: %s", err)
+	}
+
+	grant, err := k.Keeper.RecurringGrants.Get(ctx, recipient)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no recurring grant found for recipient: %s", req.Recipient)
+		}
+		return nil, err
+	}
+
+	return &types.QueryRecurringGrantResponse{RecurringGrant: &grant}, nil
+}
+
+// RecurringGrants queries all recurring grants
+func (k Querier) RecurringGrants(ctx context.Context, req *types.QueryRecurringGrantsRequest) (*types.QueryRecurringGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	grants, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.RecurringGrants, req.Pagination, func(_ sdk.AccAddress, value types.RecurringGrant) (types.RecurringGrant, error) {
+		return value, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRecurringGrantsResponse{RecurringGrants: grants, Pagination: pageRes}, nil
+}
+
+// PoolStaking queries the liquid bond denom balance and the delegations of the community pool
+func (k Querier) PoolStaking(ctx context.Context, req *types.QueryPoolStakingRequest) (*types.QueryPoolStakingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	bondDenom, err := k.Keeper.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	delegations, err := k.Keeper.GetPoolDelegationBalances(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	principal := math.ZeroInt()
+	for _, delegation := range delegations {
+		principal = principal.Add(delegation.Delegation.Principal)
+	}
+
+	poolAddr := k.Keeper.authKeeper.GetModuleAddress(types.ModuleName)
+	return &types.QueryPoolStakingResponse{
+		Liquid:          k.Keeper.bankKeeper.GetBalance(ctx, poolAddr, bondDenom),
+		BondedPrincipal: principal,
+		Delegations:     delegations,
+	}, nil
+}
+
+// PoolBalanceChanges streams the community pool balance changes made by the
+// protocolpool module once their block is committed, optionally only the ones
+// changing the balance of the requested denom.
+func (k Querier) PoolBalanceChanges(req *types.QueryPoolBalanceChangesRequest, stream types.Query_PoolBalanceChangesServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Denom != "" {
+		if err := sdk.ValidateDenom(req.Denom); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if k.Keeper.balanceStream == nil {
+		return status.Error(codes.Unavailable, "pool balance changes stream is not enabled")
+	}
+
+	id, changes := k.Keeper.balanceStream.subscribe()
+	defer k.Keeper.balanceStream.unsubscribe(id)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+
+		case change, ok := <-changes:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "pool balance changes stream fell behind")
+			}
+
+			if req.Denom != "" && change.Increase.AmountOf(req.Denom).IsZero() && change.Decrease.AmountOf(req.Denom).IsZero() {
+				continue
+			}
+
+			if err := stream.Send(&types.QueryPoolBalanceChangesResponse{Change: change}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Position queries a community pool investment position by id
+func (k Querier) Position(ctx context.Context, req *types.QueryPositionRequest) (*types.QueryPositionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	position, err := k.Keeper.Positions.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no position found for id: %d", req.Id)
+		}
+		return nil, err
+	}
+
+	return &types.QueryPositionResponse{Position: position}, nil
+}
+
+// Positions queries the community pool investment positions, optionally filtered
+// by status
+func (k Querier) Positions(ctx context.Context, req *types.QueryPositionsRequest) (*types.QueryPositionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	positions, pageRes, err := query.CollectionFilteredPaginate(ctx, k.Keeper.Positions, req.Pagination,
+		func(_ uint64, value types.Position) (bool, error) {
+			return req.Status == types.PositionStatusUnspecified || value.Status == req.Status, nil
+		},
+		func(_ uint64, value types.Position) (types.Position, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPositionsResponse{Positions: positions, Pagination: pageRes}, nil
+}
+
+// DeployedCapital queries the total principal and current value of the open
+// community pool investment positions
+func (k Querier) DeployedCapital(ctx context.Context, req *types.QueryDeployedCapitalRequest) (*types.QueryDeployedCapitalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	openPositions, principal, currentValue, err := k.Keeper.GetDeployedCapital(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDeployedCapitalResponse{
+		OpenPositions: openPositions,
+		Principal:     principal,
+		CurrentValue:  currentValue,
+	}, nil
+}
+
+// OutflowFreeze queries the freeze parameters and the active freeze of the
+// community pool outflows
+func (k Querier) OutflowFreeze(ctx context.Context, req *types.QueryOutflowFreezeRequest) (*types.QueryOutflowFreezeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	params, err := k.Keeper.GetFreezeParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryOutflowFreezeResponse{Params: params}
+	freeze, frozen, err := k.Keeper.GetOutflowFreeze(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if frozen {
+		res.Freeze = &freeze
+	}
+
+	return res, nil
+}
@@ -3,7 +3,11 @@ package keeper_test
 import (
 	"time"
 
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/math"
+	authtypes "cosmossdk.io/x/auth/types"
 	"cosmossdk.io/x/protocolpool/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -121,3 +125,64 @@ func (suite *KeeperTestSuite) TestUnclaimedBudget() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSpendAllowance() {
+	suite.SetupTest()
+	limit := types.SpendLimit{Denom: "foo", Limit: math.NewInt(120), Window: time.Hour}
+	suite.Require().NoError(suite.poolKeeper.SetSpendLimits(suite.ctx, []types.SpendLimit{limit}))
+
+	_, err := suite.queryServer.SpendAllowance(suite.ctx, &types.QuerySpendAllowanceRequest{Denom: "bar"})
+	suite.Require().ErrorContains(err, "no spend limit found for denom bar")
+
+	// no funds spent yet
+	resp, err := suite.queryServer.SpendAllowance(suite.ctx, &types.QuerySpendAllowanceRequest{Denom: "foo"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(120), resp.Allowance.Remaining)
+	suite.Require().Nil(resp.Allowance.WindowEnd)
+
+	start := suite.environment.HeaderService.GetHeaderInfo(suite.ctx).Time
+	suite.Require().NoError(suite.poolKeeper.SpendWindows.Set(suite.ctx, "foo", types.SpendWindow{Denom: "foo", Start: start, Spent: math.NewInt(100)}))
+
+	allResp, err := suite.queryServer.SpendAllowances(suite.ctx, &types.QuerySpendAllowancesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(allResp.Allowances, 1)
+	suite.Require().Equal(limit, allResp.Allowances[0].Limit)
+	suite.Require().Equal(math.NewInt(100), allResp.Allowances[0].Spent)
+	suite.Require().Equal(math.NewInt(20), allResp.Allowances[0].Remaining)
+	suite.Require().Equal(start.Add(time.Hour), *allResp.Allowances[0].WindowEnd)
+
+	// the allowance is restored once the window elapsed
+	suite.ctx = suite.ctx.WithHeaderInfo(header.Info{Time: start.Add(time.Hour)})
+	resp, err = suite.queryServer.SpendAllowance(suite.ctx, &types.QuerySpendAllowanceRequest{Denom: "foo"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(120), resp.Allowance.Remaining)
+}
+
+func (suite *KeeperTestSuite) TestFundingSources() {
+	suite.SetupTest()
+	suite.mockStreamFunds()
+
+	depositor := sdk.AccAddress("depositor__________")
+	govAcc := authtypes.NewEmptyModuleAccount("gov")
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(suite.ctx, gomock.Any(), types.ModuleName, amount).Return(nil).Times(3)
+	suite.authKeeper.EXPECT().GetAccount(suite.ctx, depositor).Return(authtypes.NewBaseAccountWithAddress(depositor)).Times(2)
+	suite.authKeeper.EXPECT().GetAccount(suite.ctx, govAcc.GetAddress()).Return(govAcc)
+
+	resp, err := suite.queryServer.FundingSources(suite.ctx, &types.QueryFundingSourcesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(resp.FundingSources)
+
+	suite.Require().NoError(suite.poolKeeper.FundCommunityPool(suite.ctx, amount, depositor))
+	suite.Require().NoError(suite.poolKeeper.FundCommunityPool(suite.ctx, amount, depositor))
+	suite.Require().NoError(suite.poolKeeper.FundCommunityPool(suite.ctx, amount, govAcc.GetAddress()))
+	suite.Require().NoError(suite.poolKeeper.SetToDistribute(suite.ctx, amount, suite.poolKeeper.GetAuthority()))
+
+	resp, err = suite.queryServer.FundingSources(suite.ctx, &types.QueryFundingSourcesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.FundingSource{
+		{Source: types.FundingSourceDirect, Amount: amount.MulInt(math.NewInt(2))},
+		{Source: types.FundingSourceDistribution, Amount: amount},
+		{Source: "gov", Amount: amount},
+	}, resp.FundingSources)
+}
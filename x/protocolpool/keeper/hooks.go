@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	govtypes "cosmossdk.io/x/gov/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Hooks wrapper struct for the protocolpool keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ govtypes.GovHooks = Hooks{}
+
+// Hooks returns the gov hooks of the protocolpool keeper, which cancel the
+// recurring grants whose renewal proposal did not pass and lift the outflow
+// freezes whose ratification proposal did not pass.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterProposalVotingPeriodEnded cancels the recurring grant of a renewal proposal
+// which ended without renewing it, and lifts the freeze of a ratification proposal
+// which ended without ratifying it.
+func (h Hooks) AfterProposalVotingPeriodEnded(ctx context.Context, proposalID uint64) error {
+	if err := h.k.cancelUnrenewedGrant(ctx, proposalID); err != nil {
+		return err
+	}
+	return h.k.liftUnratifiedFreeze(ctx, proposalID)
+}
+
+// AfterProposalFailedMinDeposit cancels the recurring grant of a renewal proposal,
+// or lifts the freeze of a ratification proposal, which did not reach the minimum
+// deposit.
+func (h Hooks) AfterProposalFailedMinDeposit(ctx context.Context, proposalID uint64) error {
+	if err := h.k.cancelUnrenewedGrant(ctx, proposalID); err != nil {
+		return err
+	}
+	return h.k.liftUnratifiedFreeze(ctx, proposalID)
+}
+
+func (h Hooks) AfterProposalSubmission(_ context.Context, _ uint64) error {
+	return nil
+}
+
+func (h Hooks) AfterProposalDeposit(_ context.Context, _ uint64, _ sdk.AccAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterProposalVote(_ context.Context, _ uint64, _ sdk.AccAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterVoteCast(_ context.Context, _ uint64, _ sdk.AccAddress, _ []govtypes.VoteCastOption) error {
+	return nil
+}
+
+func (h Hooks) AfterDepositAdded(_ context.Context, _ uint64, _ sdk.AccAddress, _ sdk.Coins, _ bool) error {
+	return nil
+}
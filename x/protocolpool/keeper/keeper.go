@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/core/appmodule"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
@@ -39,6 +40,52 @@ type Keeper struct {
 	RecipientFundDistribution collections.Map[sdk.AccAddress, math.Int]
 	// ToDistribute is to keep track of funds distributed
 	ToDistribute collections.Item[math.Int]
+	// EscrowedSpends key: EscrowID | value: EscrowedSpend
+	EscrowedSpends collections.Map[uint64, types.EscrowedSpend]
+	// EscrowedSpendSequence generates the escrowed spend ids
+	EscrowedSpendSequence collections.Sequence
+	// EscrowDeadlineQueue key: Deadline | EscrowID
+	EscrowDeadlineQueue collections.KeySet[collections.Pair[time.Time, uint64]]
+	// SpendLimits key: Denom | value: SpendLimit
+	SpendLimits collections.Map[string, types.SpendLimit]
+	// SpendWindows key: Denom | value: SpendWindow
+	SpendWindows collections.Map[string, types.SpendWindow]
+	// DenomPolicies key: Denom | value: DenomPolicy
+	DenomPolicies collections.Map[string, types.DenomPolicy]
+	// FundingSources key: Source | value: FundingSource
+	FundingSources collections.Map[string, types.FundingSource]
+	// RecurringGrants key: RecipientAddr | value: RecurringGrant
+	RecurringGrants collections.Map[sdk.AccAddress, types.RecurringGrant]
+	// RecurringGrantQueue key: NextPayment | RecipientAddr
+	RecurringGrantQueue collections.KeySet[collections.Pair[time.Time, sdk.AccAddress]]
+	// RenewalProposals key: ProposalID | value: RecipientAddr
+	RenewalProposals collections.Map[uint64, sdk.AccAddress]
+	// PoolDelegations key: ValAddr | value: Principal delegated in math.Int
+	PoolDelegations collections.Map[sdk.ValAddress, math.Int]
+	// Positions key: PositionID | value: Position
+	Positions collections.Map[uint64, types.Position]
+	// PositionSequence generates the position ids
+	PositionSequence collections.Sequence
+	// FreezeParams defines who can freeze the community pool outflows
+	FreezeParams collections.Item[types.FreezeParams]
+	// OutflowFreeze is the active freeze of the community pool outflows, if any
+	OutflowFreeze collections.Item[types.OutflowFreeze]
+
+	// swapHook swaps the holdings of denoms with a swap policy
+	swapHook types.SwapHook
+	// govMsgServer submits the renewal proposals of the recurring grants
+	govMsgServer *govMsgServerHolder
+	// balanceHooks are called whenever the community pool balance is changed
+	balanceHooks types.PoolBalanceHooks
+	// balanceStream publishes the community pool balance changes, it is nil
+	// when no memory store service is set on the environment
+	balanceStream *balanceStream
+}
+
+// govMsgServerHolder holds the gov msg server so that it can be set on a keeper
+// value after it has been passed around.
+type govMsgServerHolder struct {
+	ms types.GovMsgServer
 }
 
 func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, authority string,
@@ -51,6 +98,10 @@ func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, ak types.Accoun
 	if addr := ak.GetModuleAddress(types.StreamAccount); addr == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.StreamAccount))
 	}
+	// ensure escrow account is set
+	if addr := ak.GetModuleAddress(types.EscrowAccount); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.EscrowAccount))
+	}
 
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 
@@ -66,6 +117,22 @@ func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, ak types.Accoun
 		RecipientFundPercentage:   collections.NewMap(sb, types.RecipientFundPercentageKey, "recipient_fund_percentage", sdk.AccAddressKey, sdk.IntValue),
 		RecipientFundDistribution: collections.NewMap(sb, types.RecipientFundDistributionKey, "recipient_fund_distribution", sdk.AccAddressKey, sdk.IntValue),
 		ToDistribute:              collections.NewItem(sb, types.ToDistributeKey, "to_distribute", sdk.IntValue),
+		EscrowedSpends:            collections.NewMap(sb, types.EscrowedSpendKey, "escrowed_spends", collections.Uint64Key, codec.CollValue[types.EscrowedSpend](cdc)),
+		EscrowedSpendSequence:     collections.NewSequence(sb, types.EscrowedSpendSequenceKey, "escrowed_spend_sequence"),
+		EscrowDeadlineQueue:       collections.NewKeySet(sb, types.EscrowDeadlineQueueKey, "escrow_deadline_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key)),
+		SpendLimits:               collections.NewMap(sb, types.SpendLimitKey, "spend_limits", collections.StringKey, codec.CollValue[types.SpendLimit](cdc)),
+		SpendWindows:              collections.NewMap(sb, types.SpendWindowKey, "spend_windows", collections.StringKey, codec.CollValue[types.SpendWindow](cdc)),
+		DenomPolicies:             collections.NewMap(sb, types.DenomPolicyKey, "denom_policies", collections.StringKey, codec.CollValue[types.DenomPolicy](cdc)),
+		FundingSources:            collections.NewMap(sb, types.FundingSourceKey, "funding_sources", collections.StringKey, codec.CollValue[types.FundingSource](cdc)),
+		RecurringGrants:           collections.NewMap(sb, types.RecurringGrantKey, "recurring_grants", sdk.AccAddressKey, codec.CollValue[types.RecurringGrant](cdc)),
+		RecurringGrantQueue:       collections.NewKeySet(sb, types.RecurringGrantQueueKey, "recurring_grant_queue", collections.PairKeyCodec(sdk.TimeKey, sdk.AccAddressKey)),
+		RenewalProposals:          collections.NewMap(sb, types.RenewalProposalKey, "renewal_proposals", collections.Uint64Key, collcodec.KeyToValueCodec(sdk.AccAddressKey)),
+		PoolDelegations:           collections.NewMap(sb, types.PoolDelegationKey, "pool_delegations", sdk.ValAddressKey, sdk.IntValue),
+		Positions:                 collections.NewMap(sb, types.PositionKey, "positions", collections.Uint64Key, codec.CollValue[types.Position](cdc)),
+		PositionSequence:          collections.NewSequence(sb, types.PositionSequenceKey, "position_sequence"),
+		FreezeParams:              collections.NewItem(sb, types.FreezeParamsKey, "freeze_params", codec.CollValue[types.FreezeParams](cdc)),
+		OutflowFreeze:             collections.NewItem(sb, types.OutflowFreezeKey, "outflow_freeze", codec.CollValue[types.OutflowFreeze](cdc)),
+		govMsgServer:              &govMsgServerHolder{},
 	}
 
 	schema, err := sb.Build()
@@ -74,9 +141,33 @@ func NewKeeper(cdc codec.BinaryCodec, env appmodule.Environment, ak types.Accoun
 	}
 	keeper.Schema = schema
 
+	if env.MemStoreService != nil {
+		keeper.balanceStream = newBalanceStream(cdc, env.MemStoreService)
+	}
+
 	return keeper
 }
 
+// SetSwapHook sets the hook used to swap the community pool holdings of denoms
+// with a swap policy.
+func (k *Keeper) SetSwapHook(hook types.SwapHook) {
+	if k.swapHook != nil {
+		panic("cannot set swap hook twice")
+	}
+
+	k.swapHook = hook
+}
+
+// SetGovMsgServer sets the gov msg server used to submit the renewal proposals
+// of the recurring grants.
+func (k Keeper) SetGovMsgServer(ms types.GovMsgServer) {
+	if k.govMsgServer.ms != nil {
+		panic("cannot set gov msg server twice")
+	}
+
+	k.govMsgServer.ms = ms
+}
+
 // GetAuthority returns the x/protocolpool module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -88,19 +179,46 @@ func (k Keeper) Logger(ctx context.Context) log.Logger {
 }
 
 // FundCommunityPool allows an account to directly fund the community fund pool.
+// The funds are attributed to the name of the sending module account, or to the
+// direct funding source for regular accounts.
 func (k Keeper) FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
-	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	source := types.FundingSourceDirect
+	if moduleAcc, ok := k.authKeeper.GetAccount(ctx, sender).(sdk.ModuleAccountI); ok {
+		source = moduleAcc.GetName()
+	}
+
+	if err := k.recordFunding(ctx, source, amount); err != nil {
+		return err
+	}
+
+	return k.afterBalanceChanged(ctx, source, amount, nil)
 }
 
 // DistributeFromCommunityPool distributes funds from the protocolpool module account to
-// a receiver address.
+// a receiver address. It fails while the community pool outflows are frozen.
 func (k Keeper) DistributeFromCommunityPool(ctx context.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error {
-	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiveAddr, amount)
+	if err := k.checkOutflowsNotFrozen(ctx); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiveAddr, amount); err != nil {
+		return err
+	}
+
+	return k.afterBalanceChanged(ctx, types.BalanceChangeSourceSpend, nil, amount)
 }
 
 // DistributeFromStreamFunds distributes funds from the protocolpool's stream module account to
-// a receiver address.
+// a receiver address. It fails while the community pool outflows are frozen.
 func (k Keeper) DistributeFromStreamFunds(ctx context.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error {
+	if err := k.checkOutflowsNotFrozen(ctx); err != nil {
+		return err
+	}
+
 	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.StreamAccount, receiveAddr, amount)
 }
 
@@ -217,10 +335,20 @@ func (k Keeper) SetToDistribute(ctx context.Context, amount sdk.Coins, addr stri
 	if err != nil {
 		return fmt.Errorf("error while setting ToDistribute: %v", err)
 	}
-	return nil
+
+	if err := k.recordFunding(ctx, types.FundingSourceDistribution, amount); err != nil {
+		return err
+	}
+
+	return k.afterBalanceChanged(ctx, types.FundingSourceDistribution, amount, nil)
 }
 
 func (k Keeper) sendFundsToStreamModule(ctx context.Context, denom string, percentage math.Int) error {
+	// the streaming funds stay in the community pool while its outflows are frozen
+	if _, frozen, err := k.GetOutflowFreeze(ctx); err != nil || frozen {
+		return err
+	}
+
 	totalPoolAmt, err := k.GetCommunityPool(ctx)
 	if err != nil {
 		return err
@@ -236,7 +364,7 @@ func (k Keeper) sendFundsToStreamModule(ctx context.Context, denom string, perce
 		return err
 	}
 
-	return nil
+	return k.afterBalanceChanged(ctx, types.BalanceChangeSourceStream, nil, streamAmt)
 }
 
 func (k Keeper) hasPermission(ctx context.Context, addr []byte) (bool, error) {
@@ -374,22 +502,31 @@ func (k Keeper) calculateClaimableFunds(ctx context.Context, recipient sdk.AccAd
 		return sdk.Coin{}, fmt.Errorf("budget period has not passed yet")
 	}
 
-	// Calculate how many periods have passed
-	periodsPassed := int64(timeElapsed) / int64(*budget.Period)
+	// Calculate how many periods have passed, a budget cannot release more
+	// tranches than it has left
+	periodsPassed := uint64(timeElapsed / *budget.Period)
+	if periodsPassed > budget.TranchesLeft {
+		periodsPassed = budget.TranchesLeft
+	}
 
 	// Calculate the amount to distribute for all passed periods
-	coinsToDistribute := math.NewInt(periodsPassed).Mul(budget.TotalBudget.Amount.QuoRaw(int64(budget.Tranches)))
-	amount = sdk.NewCoin(budget.TotalBudget.Denom, coinsToDistribute)
+	coinsToDistribute := math.NewIntFromUint64(periodsPassed).Mul(budget.TotalBudget.Amount.QuoRaw(int64(budget.Tranches)))
 
 	// update the budget's remaining tranches
-	budget.TranchesLeft -= uint64(periodsPassed)
+	budget.TranchesLeft -= periodsPassed
+
+	// the last tranche releases the remainder left over by the integer division
+	if budget.TranchesLeft == 0 {
+		coinsToDistribute = budget.TotalBudget.Amount.Sub(budget.ClaimedAmount.Amount)
+	}
+	amount = sdk.NewCoin(budget.TotalBudget.Denom, coinsToDistribute)
 
 	// update the ClaimedAmount
 	claimedAmount := budget.ClaimedAmount.Add(amount)
 	budget.ClaimedAmount = &claimedAmount
 
-	// Update the last claim time for the budget
-	nextClaimFrom := budget.NextClaimFrom.Add(*budget.Period)
+	// Update the last claim time for the budget, skipping all the claimed periods
+	nextClaimFrom := budget.NextClaimFrom.Add(time.Duration(periodsPassed) * *budget.Period)
 	budget.NextClaimFrom = &nextClaimFrom
 
 	k.Logger(ctx).Debug(fmt.Sprintf("Processing budget for recipient: %s. Amount: %s", budget.RecipientAddress, coinsToDistribute.String()))
@@ -1,21 +1,27 @@
 package keeper_test
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	authtypes "cosmossdk.io/x/auth/types"
+	govv1 "cosmossdk.io/x/gov/types/v1"
 	poolkeeper "cosmossdk.io/x/protocolpool/keeper"
 	pooltestutil "cosmossdk.io/x/protocolpool/testutil"
 	"cosmossdk.io/x/protocolpool/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec/address"
@@ -28,6 +34,7 @@ import (
 var (
 	poolAcc   = authtypes.NewEmptyModuleAccount(types.ModuleName)
 	streamAcc = authtypes.NewEmptyModuleAccount(types.StreamAccount)
+	escrowAcc = authtypes.NewEmptyModuleAccount(types.EscrowAccount)
 )
 
 type KeeperTestSuite struct {
@@ -46,10 +53,16 @@ type KeeperTestSuite struct {
 
 func (s *KeeperTestSuite) SetupTest() {
 	key := storetypes.NewKVStoreKey(types.StoreKey)
+	memKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
 	storeService := runtime.NewKVStoreService(key)
 	environment := runtime.NewEnvironment(storeService, log.NewNopLogger())
-	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
-	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+	environment.MemStoreService = runtime.NewMemStoreService(memKey)
+	testCtx := testutil.DefaultContextWithKeys(
+		map[string]*storetypes.KVStoreKey{types.StoreKey: key},
+		map[string]*storetypes.TransientStoreKey{"transient_test": storetypes.NewTransientStoreKey("transient_test")},
+		map[string]*storetypes.MemoryStoreKey{memKey.Name(): memKey},
+	)
+	ctx := testCtx.WithHeaderInfo(header.Info{Time: time.Now()})
 	encCfg := moduletestutil.MakeTestEncodingConfig()
 
 	// gomock initializations
@@ -58,6 +71,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(poolAcc.GetAddress())
 	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
 	accountKeeper.EXPECT().GetModuleAddress(types.StreamAccount).Return(streamAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAddress(types.EscrowAccount).Return(escrowAcc.GetAddress())
 	s.authKeeper = accountKeeper
 
 	bankKeeper := pooltestutil.NewMockBankKeeper(ctrl)
@@ -106,6 +120,475 @@ func (s *KeeperTestSuite) mockStreamFunds() {
 	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, poolAcc.GetName(), streamAcc.GetName(), gomock.Any()).AnyTimes()
 }
 
+// swapHook is a types.SwapHook recording the swapped coins.
+type swapHook struct {
+	err     error
+	swapped sdk.Coins
+}
+
+func (h *swapHook) SwapCommunityPoolDenom(_ context.Context, _ sdk.AccAddress, coin sdk.Coin) error {
+	if h.err != nil {
+		return h.err
+	}
+	h.swapped = h.swapped.Add(coin)
+	return nil
+}
+
+func (s *KeeperTestSuite) TestSetDenomPolicies() {
+	burn := types.DenomPolicy{Denom: "ibc/dust", Action: types.DenomPolicyActionBurn}
+	swap := types.DenomPolicy{Denom: "ibc/asset", Action: types.DenomPolicyActionSwap}
+
+	err := s.poolKeeper.SetDenomPolicies(s.ctx, []types.DenomPolicy{{Denom: "stake", Action: types.DenomPolicyActionBurn}})
+	s.Require().ErrorContains(err, "cannot set a policy for the bond denom stake")
+
+	err = s.poolKeeper.SetDenomPolicies(s.ctx, []types.DenomPolicy{{Denom: "ibc/dust"}})
+	s.Require().ErrorContains(err, "invalid action")
+
+	err = s.poolKeeper.SetDenomPolicies(s.ctx, []types.DenomPolicy{burn, swap})
+	s.Require().ErrorContains(err, "no swap hook registered")
+
+	s.poolKeeper.SetSwapHook(&swapHook{})
+	s.Require().NoError(s.poolKeeper.SetDenomPolicies(s.ctx, []types.DenomPolicy{burn, swap}))
+
+	// policies are replaced
+	s.Require().NoError(s.poolKeeper.SetDenomPolicies(s.ctx, []types.DenomPolicy{swap}))
+	has, err := s.poolKeeper.DenomPolicies.Has(s.ctx, burn.Denom)
+	s.Require().NoError(err)
+	s.Require().False(has)
+}
+
+// balanceHooks is a types.PoolBalanceHooks recording the balance changes.
+type balanceHooks struct {
+	changes []types.PoolBalanceChange
+}
+
+func (h *balanceHooks) AfterPoolBalanceChanged(_ context.Context, change types.PoolBalanceChange) error {
+	h.changes = append(h.changes, change)
+	return nil
+}
+
+func (s *KeeperTestSuite) TestApplyDenomPolicies() {
+	hook := &swapHook{}
+	s.poolKeeper.SetSwapHook(hook)
+	hooks := &balanceHooks{}
+	s.poolKeeper.SetBalanceHooks(hooks)
+	s.Require().NoError(s.poolKeeper.SetDenomPolicies(s.ctx, []types.DenomPolicy{
+		{Denom: "ibc/dust", Action: types.DenomPolicyActionBurn},
+		{Denom: "ibc/asset", Action: types.DenomPolicyActionSwap},
+		{Denom: "ibc/empty", Action: types.DenomPolicyActionBurn},
+	}))
+
+	dust := sdk.NewInt64Coin("ibc/dust", 3)
+	asset := sdk.NewInt64Coin("ibc/asset", 1000)
+	s.authKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(poolAcc.GetAddress()).Times(2)
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), poolAcc.GetAddress(), "ibc/dust").Return(dust).Times(2)
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), poolAcc.GetAddress(), "ibc/asset").Return(asset).Times(2)
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), poolAcc.GetAddress(), "ibc/empty").Return(sdk.NewInt64Coin("ibc/empty", 0)).Times(2)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), poolAcc.GetAddress().Bytes(), sdk.NewCoins(dust)).Return(nil).Times(2)
+	gomock.InOrder(
+		s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), poolAcc.GetAddress()).Return(sdk.NewCoins(asset, sdk.NewInt64Coin("stake", 500))),
+		s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), poolAcc.GetAddress()).Return(sdk.NewCoins(sdk.NewInt64Coin("stake", 1500))),
+		s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), poolAcc.GetAddress()).Return(sdk.NewCoins(asset, sdk.NewInt64Coin("stake", 1500))),
+	)
+
+	s.Require().NoError(s.poolKeeper.ApplyDenomPolicies(s.ctx))
+	s.Require().Equal(sdk.NewCoins(asset), hook.swapped)
+	s.Require().Equal([]types.PoolBalanceChange{
+		{Source: types.BalanceChangeSourceDenomPolicy, Increase: sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), Decrease: sdk.NewCoins(asset)},
+		{Source: types.BalanceChangeSourceDenomPolicy, Decrease: sdk.NewCoins(dust)},
+	}, hooks.changes)
+
+	// a failed swap does not fail the block
+	hook.err = errors.New("no liquidity")
+	s.Require().NoError(s.poolKeeper.ApplyDenomPolicies(s.ctx))
+	s.Require().Equal(sdk.NewCoins(asset), hook.swapped)
+	s.Require().Len(hooks.changes, 3)
+}
+
+// balanceChangesStream is a types.Query_PoolBalanceChangesServer recording the
+// sent balance changes.
+type balanceChangesStream struct {
+	grpc.ServerStream
+
+	ctx     context.Context
+	changes chan types.PoolBalanceChange
+}
+
+func (s *balanceChangesStream) Context() context.Context { return s.ctx }
+
+func (s *balanceChangesStream) Send(res *types.QueryPoolBalanceChangesResponse) error {
+	s.changes <- res.Change
+	return nil
+}
+
+func (s *KeeperTestSuite) TestPoolBalanceChanges() {
+	hooks := &balanceHooks{}
+	s.poolKeeper.SetBalanceHooks(hooks)
+
+	err := s.queryServer.PoolBalanceChanges(nil, nil)
+	s.Require().ErrorContains(err, "empty request")
+	err = s.queryServer.PoolBalanceChanges(&types.QueryPoolBalanceChangesRequest{Denom: "!"}, nil)
+	s.Require().ErrorContains(err, "invalid denom")
+
+	streamCtx, cancel := context.WithCancel(context.Background())
+	stream := &balanceChangesStream{ctx: streamCtx, changes: make(chan types.PoolBalanceChange, 10)}
+	done := make(chan error)
+	go func() {
+		done <- s.queryServer.PoolBalanceChanges(&types.QueryPoolBalanceChangesRequest{Denom: "stake"}, stream)
+	}()
+	s.Require().Eventually(func() bool { return s.poolKeeper.BalanceStreamSubscribers() == 1 }, time.Second, time.Millisecond)
+
+	depositor := sdk.AccAddress([]byte("depositor___________"))
+	recipient := sdk.AccAddress([]byte("recipient___________"))
+	stake := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	asset := sdk.NewCoins(sdk.NewInt64Coin("ibc/asset", 100))
+	s.authKeeper.EXPECT().GetAccount(gomock.Any(), depositor).Return(authtypes.NewBaseAccountWithAddress(depositor)).AnyTimes()
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), depositor, types.ModuleName, stake).Return(nil).AnyTimes()
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, recipient, gomock.Any()).Return(nil).AnyTimes()
+
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: 10, Time: s.ctx.HeaderInfo().Time})
+	s.Require().NoError(s.poolKeeper.FundCommunityPool(ctx, stake, depositor))
+	s.Require().NoError(s.poolKeeper.DistributeFromCommunityPool(ctx, asset, recipient))
+
+	// the changes of reverted state are not published
+	cacheCtx, _ := ctx.CacheContext()
+	s.Require().NoError(s.poolKeeper.DistributeFromCommunityPool(cacheCtx, stake, recipient))
+
+	s.Require().Equal([]types.PoolBalanceChange{
+		{Height: 10, Source: types.FundingSourceDirect, Increase: stake},
+		{Height: 10, Source: types.BalanceChangeSourceSpend, Decrease: asset},
+		{Height: 10, Source: types.BalanceChangeSourceSpend, Decrease: stake},
+	}, hooks.changes)
+
+	s.Require().NoError(s.poolKeeper.PublishBalanceChanges(ctx))
+	s.Require().Equal(types.PoolBalanceChange{Height: 10, Source: types.FundingSourceDirect, Increase: stake}, <-stream.changes)
+
+	// the changes of the previous blocks are not published again
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 11, Time: ctx.HeaderInfo().Time})
+	s.Require().NoError(s.poolKeeper.DistributeFromCommunityPool(ctx, stake, recipient))
+	s.Require().NoError(s.poolKeeper.PublishBalanceChanges(ctx))
+	s.Require().Equal(types.PoolBalanceChange{Height: 11, Source: types.BalanceChangeSourceSpend, Decrease: stake}, <-stream.changes)
+	s.Require().Empty(stream.changes)
+
+	cancel()
+	s.Require().NoError(<-done)
+	s.Require().Zero(s.poolKeeper.BalanceStreamSubscribers())
+}
+
+// govMsgServer is a types.GovMsgServer recording the submitted proposals.
+type govMsgServer struct {
+	proposals []*govv1.MsgSubmitProposal
+}
+
+func (g *govMsgServer) SubmitProposal(_ context.Context, msg *govv1.MsgSubmitProposal) (*govv1.MsgSubmitProposalResponse, error) {
+	g.proposals = append(g.proposals, msg)
+	return &govv1.MsgSubmitProposalResponse{ProposalId: uint64(len(g.proposals))}, nil
+}
+
+func (s *KeeperTestSuite) TestRecurringGrants() {
+	recipient := sdk.AccAddress([]byte("recipient__________"))
+	recipientStr, err := s.authKeeper.AddressCodec().BytesToString(recipient)
+	s.Require().NoError(err)
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	start := s.ctx.HeaderInfo().Time
+
+	err = s.poolKeeper.CreateRecurringGrant(s.ctx, recipientStr, amount, time.Hour, 2*time.Hour, deposit)
+	s.Require().ErrorContains(err, "no gov msg server set")
+
+	gov := &govMsgServer{}
+	s.poolKeeper.SetGovMsgServer(gov)
+	s.Require().NoError(s.poolKeeper.CreateRecurringGrant(s.ctx, recipientStr, amount, time.Hour, 2*time.Hour, deposit))
+	err = s.poolKeeper.CreateRecurringGrant(s.ctx, recipientStr, amount, time.Hour, 2*time.Hour, deposit)
+	s.Require().ErrorIs(err, types.ErrInvalidRecurringGrant)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, recipient, amount).Return(nil).Times(4)
+	s.authKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(poolAcc.GetAddress()).Times(2)
+
+	// nothing is paid before the first period elapses
+	s.Require().NoError(s.poolKeeper.ProcessRecurringGrants(s.ctx))
+
+	// first payment, before the renewal time
+	ctx := s.ctx.WithHeaderInfo(header.Info{Time: start.Add(time.Hour)})
+	s.Require().NoError(s.poolKeeper.ProcessRecurringGrants(ctx))
+	grant, err := s.poolKeeper.RecurringGrants.Get(ctx, recipient)
+	s.Require().NoError(err)
+	s.Require().Equal(start.Add(2*time.Hour), grant.NextPayment)
+	s.Require().Empty(gov.proposals)
+
+	// second payment submits the renewal proposal
+	ctx = s.ctx.WithHeaderInfo(header.Info{Time: start.Add(2 * time.Hour)})
+	s.Require().NoError(s.poolKeeper.ProcessRecurringGrants(ctx))
+	s.Require().Len(gov.proposals, 1)
+	s.Require().Equal(deposit, sdk.Coins(gov.proposals[0].InitialDeposit))
+	grant, err = s.poolKeeper.RecurringGrants.Get(ctx, recipient)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), grant.RenewalProposalId)
+
+	// the proposal passes and renews the grant
+	_, err = s.msgServer.RenewRecurringGrant(ctx, &types.MsgRenewRecurringGrant{Authority: s.poolKeeper.GetAuthority(), Recipient: recipientStr})
+	s.Require().NoError(err)
+	s.Require().NoError(s.poolKeeper.Hooks().AfterProposalVotingPeriodEnded(ctx, 1))
+	grant, err = s.poolKeeper.RecurringGrants.Get(ctx, recipient)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(0), grant.RenewalProposalId)
+	s.Require().Equal(start.Add(4*time.Hour), grant.RenewalTime)
+
+	// third payment, before the next renewal time
+	ctx = s.ctx.WithHeaderInfo(header.Info{Time: start.Add(3 * time.Hour)})
+	s.Require().NoError(s.poolKeeper.ProcessRecurringGrants(ctx))
+	s.Require().Len(gov.proposals, 1)
+
+	// fourth payment submits the next renewal proposal, which is rejected
+	ctx = s.ctx.WithHeaderInfo(header.Info{Time: start.Add(4 * time.Hour)})
+	s.Require().NoError(s.poolKeeper.ProcessRecurringGrants(ctx))
+	s.Require().Len(gov.proposals, 2)
+	s.Require().NoError(s.poolKeeper.Hooks().AfterProposalVotingPeriodEnded(ctx, 2))
+
+	has, err := s.poolKeeper.RecurringGrants.Has(ctx, recipient)
+	s.Require().NoError(err)
+	s.Require().False(has)
+	has, err = s.poolKeeper.RenewalProposals.Has(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().False(has)
+
+	// no payment is made once the grant is cancelled
+	ctx = s.ctx.WithHeaderInfo(header.Info{Time: start.Add(5 * time.Hour)})
+	s.Require().NoError(s.poolKeeper.ProcessRecurringGrants(ctx))
+}
+
+func (s *KeeperTestSuite) TestPoolStaking() {
+	valAddr := sdk.ValAddress([]byte("validator__________"))
+	valAddrStr, err := address.NewBech32Codec("cosmosvaloper").BytesToString(valAddr)
+	s.Require().NoError(err)
+	validator := stakingtypes.Validator{OperatorAddress: valAddrStr, Tokens: math.NewInt(1000), DelegatorShares: math.LegacyNewDec(1000)}
+	poolAddr := poolAcc.GetAddress()
+
+	s.authKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(poolAddr).AnyTimes()
+	s.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+	s.stakingKeeper.EXPECT().GetValidator(s.ctx, valAddr).Return(validator, nil).AnyTimes()
+
+	// only the bond denom can be delegated
+	_, err = s.msgServer.PoolDelegate(s.ctx, &types.MsgPoolDelegate{Authority: s.poolKeeper.GetAuthority(), ValidatorAddress: valAddrStr, Amount: sdk.NewInt64Coin("foo", 100)})
+	s.Require().ErrorIs(err, types.ErrInvalidPoolDelegation)
+
+	_, err = s.msgServer.PoolDelegate(s.ctx, &types.MsgPoolDelegate{Authority: "invalid", ValidatorAddress: valAddrStr, Amount: sdk.NewInt64Coin("stake", 100)})
+	s.Require().Error(err)
+
+	s.stakingKeeper.EXPECT().Delegate(s.ctx, poolAddr, math.NewInt(100), stakingtypes.Unbonded, validator, true).Return(math.LegacyNewDec(100), nil).Times(2)
+	for i := 0; i < 2; i++ {
+		_, err = s.msgServer.PoolDelegate(s.ctx, &types.MsgPoolDelegate{Authority: s.poolKeeper.GetAuthority(), ValidatorAddress: valAddrStr, Amount: sdk.NewInt64Coin("stake", 100)})
+		s.Require().NoError(err)
+	}
+	principal, err := s.poolKeeper.PoolDelegations.Get(s.ctx, valAddr)
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(200), principal)
+
+	// rewards are not part of the principal
+	delegation := stakingtypes.Delegation{ValidatorAddress: valAddrStr, Shares: math.LegacyNewDec(200)}
+	s.stakingKeeper.EXPECT().Delegation(s.ctx, poolAddr, valAddr).Return(delegation, nil).Times(2)
+	s.bankKeeper.EXPECT().GetBalance(s.ctx, poolAddr, "stake").Return(sdk.NewInt64Coin("stake", 50))
+	res, err := s.queryServer.PoolStaking(s.ctx, &types.QueryPoolStakingRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin("stake", 50), res.Liquid)
+	s.Require().Equal(math.NewInt(200), res.BondedPrincipal)
+	s.Require().Len(res.Delegations, 1)
+	s.Require().Equal(math.NewInt(200), res.Delegations[0].Balance)
+
+	s.stakingKeeper.EXPECT().ValidateUnbondAmount(s.ctx, poolAddr, valAddr, math.NewInt(150)).Return(math.LegacyNewDec(150), nil)
+	s.stakingKeeper.EXPECT().Undelegate(s.ctx, poolAddr, valAddr, math.LegacyNewDec(150)).Return(s.ctx.HeaderInfo().Time, math.NewInt(150), nil)
+	undelegateRes, err := s.msgServer.PoolUndelegate(s.ctx, &types.MsgPoolUndelegate{Authority: s.poolKeeper.GetAuthority(), ValidatorAddress: valAddrStr, Amount: sdk.NewInt64Coin("stake", 150)})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin("stake", 150), undelegateRes.Amount)
+	principal, err = s.poolKeeper.PoolDelegations.Get(s.ctx, valAddr)
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(50), principal)
+
+	// the principal is removed with the delegation
+	s.stakingKeeper.EXPECT().ValidateUnbondAmount(s.ctx, poolAddr, valAddr, math.NewInt(50)).Return(math.LegacyNewDec(50), nil)
+	s.stakingKeeper.EXPECT().Undelegate(s.ctx, poolAddr, valAddr, math.LegacyNewDec(50)).Return(s.ctx.HeaderInfo().Time, math.NewInt(45), nil)
+	s.stakingKeeper.EXPECT().Delegation(s.ctx, poolAddr, valAddr).Return(nil, collections.ErrNotFound)
+	_, err = s.msgServer.PoolUndelegate(s.ctx, &types.MsgPoolUndelegate{Authority: s.poolKeeper.GetAuthority(), ValidatorAddress: valAddrStr, Amount: sdk.NewInt64Coin("stake", 50)})
+	s.Require().NoError(err)
+	has, err := s.poolKeeper.PoolDelegations.Has(s.ctx, valAddr)
+	s.Require().NoError(err)
+	s.Require().False(has)
+}
+
+func (s *KeeperTestSuite) TestPositions() {
+	s.SetupTest()
+
+	recipient := sdk.AccAddress("ica_________________")
+	reporter := sdk.AccAddress("reporter____________")
+	principal := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	maturity := s.ctx.HeaderInfo().Time.Add(time.Hour)
+	authority := s.poolKeeper.GetAuthority()
+
+	_, err := s.msgServer.OpenPosition(s.ctx, &types.MsgOpenPosition{Authority: "invalid", Venue: "osmosis/pool/1", Principal: principal})
+	s.Require().ErrorContains(err, "invalid authority")
+	_, err = s.msgServer.OpenPosition(s.ctx, &types.MsgOpenPosition{Authority: authority, Principal: principal})
+	s.Require().ErrorIs(err, types.ErrInvalidPosition)
+	_, err = s.msgServer.OpenPosition(s.ctx, &types.MsgOpenPosition{Authority: authority, Venue: "osmosis/pool/1"})
+	s.Require().ErrorIs(err, types.ErrInvalidPosition)
+	past := s.ctx.HeaderInfo().Time.Add(-time.Hour)
+	_, err = s.msgServer.OpenPosition(s.ctx, &types.MsgOpenPosition{Authority: authority, Venue: "osmosis/pool/1", Principal: principal, Maturity: &past})
+	s.Require().ErrorContains(err, "maturity must be after the current block time")
+
+	// the principal is sent to the recipient
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(s.ctx, types.ModuleName, recipient, principal).Return(nil)
+	res, err := s.msgServer.OpenPosition(s.ctx, &types.MsgOpenPosition{
+		Authority:      authority,
+		Venue:          "osmosis/pool/1",
+		Recipient:      recipient.String(),
+		Principal:      principal,
+		ExpectedReturn: sdk.NewCoins(sdk.NewInt64Coin("stake", 1100)),
+		Maturity:       &maturity,
+		Reporter:       reporter.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(0), res.Id)
+
+	// no funds are moved without recipient
+	res, err = s.msgServer.OpenPosition(s.ctx, &types.MsgOpenPosition{Authority: authority, Venue: "lending", Principal: principal})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), res.Id)
+
+	position, err := s.queryServer.Position(s.ctx, &types.QueryPositionRequest{Id: 0})
+	s.Require().NoError(err)
+	s.Require().Equal(types.PositionStatusOpen, position.Position.Status)
+	s.Require().Equal(principal, position.Position.CurrentValue)
+	s.Require().Equal(reporter.String(), position.Position.Reporter)
+	_, err = s.queryServer.Position(s.ctx, &types.QueryPositionRequest{Id: 2})
+	s.Require().Error(err)
+
+	// only the reporter or the authority can report
+	_, err = s.msgServer.ReportPosition(s.ctx, &types.MsgReportPosition{Reporter: recipient.String(), PositionId: 0, CurrentValue: principal})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.ReportPosition(s.ctx, &types.MsgReportPosition{Reporter: reporter.String(), PositionId: 1, CurrentValue: principal})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	currentValue := sdk.NewCoins(sdk.NewInt64Coin("stake", 1050))
+	_, err = s.msgServer.ReportPosition(s.ctx, &types.MsgReportPosition{Reporter: reporter.String(), PositionId: 0, CurrentValue: currentValue, Note: "accrued fees"})
+	s.Require().NoError(err)
+	_, err = s.msgServer.ReportPosition(s.ctx, &types.MsgReportPosition{Reporter: authority, PositionId: 1, CurrentValue: principal, Close: true})
+	s.Require().NoError(err)
+
+	position, err = s.queryServer.Position(s.ctx, &types.QueryPositionRequest{Id: 0})
+	s.Require().NoError(err)
+	s.Require().Equal(currentValue, position.Position.CurrentValue)
+	s.Require().Equal("accrued fees", position.Position.LastReportNote)
+	s.Require().Equal(s.ctx.HeaderInfo().Time, *position.Position.LastReportTime)
+
+	// closed positions can no longer be reported on
+	_, err = s.msgServer.ReportPosition(s.ctx, &types.MsgReportPosition{Reporter: authority, PositionId: 1, CurrentValue: principal})
+	s.Require().ErrorIs(err, types.ErrInvalidPosition)
+
+	positions, err := s.queryServer.Positions(s.ctx, &types.QueryPositionsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(positions.Positions, 2)
+	positions, err = s.queryServer.Positions(s.ctx, &types.QueryPositionsRequest{Status: types.PositionStatusClosed})
+	s.Require().NoError(err)
+	s.Require().Len(positions.Positions, 1)
+	s.Require().Equal(uint64(1), positions.Positions[0].Id)
+
+	deployed, err := s.queryServer.DeployedCapital(s.ctx, &types.QueryDeployedCapitalRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), deployed.OpenPositions)
+	s.Require().Equal(principal, deployed.Principal)
+	s.Require().Equal(currentValue, deployed.CurrentValue)
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
+
+func (s *KeeperTestSuite) TestOutflowFreeze() {
+	securityAuthority := sdk.AccAddress([]byte("security_authority_"))
+	securityAuthorityStr, err := s.authKeeper.AddressCodec().BytesToString(securityAuthority)
+	s.Require().NoError(err)
+	recipient := sdk.AccAddress([]byte("recipient__________"))
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	start := s.ctx.HeaderInfo().Time
+
+	// the outflows cannot be frozen while the max duration is zero
+	_, err = s.msgServer.FreezeOutflows(s.ctx, &types.MsgFreezeOutflows{Sender: s.poolKeeper.GetAuthority(), Duration: time.Hour})
+	s.Require().ErrorIs(err, types.ErrInvalidFreeze)
+
+	_, err = s.msgServer.SetFreezeParams(s.ctx, &types.MsgSetFreezeParams{Authority: s.poolKeeper.GetAuthority(), Params: types.FreezeParams{SecurityAuthority: securityAuthorityStr}})
+	s.Require().ErrorIs(err, types.ErrInvalidFreeze)
+	params := types.FreezeParams{SecurityAuthority: securityAuthorityStr, MaxDuration: 24 * time.Hour, RatificationDeposit: deposit}
+	_, err = s.msgServer.SetFreezeParams(s.ctx, &types.MsgSetFreezeParams{Authority: s.poolKeeper.GetAuthority(), Params: params})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.FreezeOutflows(s.ctx, &types.MsgFreezeOutflows{Sender: recipient.String(), Duration: time.Hour})
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.FreezeOutflows(s.ctx, &types.MsgFreezeOutflows{Sender: securityAuthorityStr, Duration: 48 * time.Hour})
+	s.Require().ErrorContains(err, "exceeds the max duration")
+
+	// the ratification of the freezes of the security authority is mandatory
+	_, err = s.msgServer.FreezeOutflows(s.ctx, &types.MsgFreezeOutflows{Sender: securityAuthorityStr, Duration: time.Hour})
+	s.Require().ErrorContains(err, "no gov msg server set")
+
+	gov := &govMsgServer{}
+	s.poolKeeper.SetGovMsgServer(gov)
+	s.authKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(poolAcc.GetAddress()).Times(2)
+
+	res, err := s.msgServer.FreezeOutflows(s.ctx, &types.MsgFreezeOutflows{Sender: securityAuthorityStr, Duration: 2 * time.Hour, Reason: "attack"})
+	s.Require().NoError(err)
+	s.Require().Equal(start.Add(2*time.Hour), res.EndTime)
+	s.Require().Equal(uint64(1), res.RatificationProposalId)
+	s.Require().Len(gov.proposals, 1)
+	s.Require().Equal(deposit, sdk.Coins(gov.proposals[0].InitialDeposit))
+
+	_, err = s.msgServer.FreezeOutflows(s.ctx, &types.MsgFreezeOutflows{Sender: securityAuthorityStr, Duration: time.Hour})
+	s.Require().ErrorContains(err, "already frozen")
+
+	// outflows fail while frozen
+	err = s.poolKeeper.DistributeFromCommunityPool(s.ctx, amount, recipient)
+	s.Require().ErrorIs(err, types.ErrOutflowsFrozen)
+
+	query, err := s.queryServer.OutflowFreeze(s.ctx, &types.QueryOutflowFreezeRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(params, query.Params)
+	s.Require().NotNil(query.Freeze)
+	s.Require().False(query.Freeze.Ratified)
+
+	// a ratification proposal of another freeze does not ratify it
+	_, err = s.msgServer.RatifyFreeze(s.ctx, &types.MsgRatifyFreeze{Authority: s.poolKeeper.GetAuthority(), FreezeStartTime: start.Add(-time.Hour)})
+	s.Require().ErrorIs(err, types.ErrInvalidFreeze)
+
+	// the freeze is lifted when its ratification proposal is rejected
+	s.Require().NoError(s.poolKeeper.Hooks().AfterProposalVotingPeriodEnded(s.ctx, 1))
+	_, frozen, err := s.poolKeeper.GetOutflowFreeze(s.ctx)
+	s.Require().NoError(err)
+	s.Require().False(frozen)
+
+	// a ratified freeze lasts until its end time
+	_, err = s.msgServer.FreezeOutflows(s.ctx, &types.MsgFreezeOutflows{Sender: securityAuthorityStr, Duration: 2 * time.Hour})
+	s.Require().NoError(err)
+	_, err = s.msgServer.RatifyFreeze(s.ctx, &types.MsgRatifyFreeze{Authority: s.poolKeeper.GetAuthority(), FreezeStartTime: start})
+	s.Require().NoError(err)
+	s.Require().NoError(s.poolKeeper.Hooks().AfterProposalVotingPeriodEnded(s.ctx, 2))
+
+	ctx := s.ctx.WithHeaderInfo(header.Info{Time: start.Add(time.Hour)})
+	s.Require().NoError(s.poolKeeper.ExpireOutflowFreeze(ctx))
+	err = s.poolKeeper.DistributeFromCommunityPool(ctx, amount, recipient)
+	s.Require().ErrorIs(err, types.ErrOutflowsFrozen)
+
+	ctx = s.ctx.WithHeaderInfo(header.Info{Time: start.Add(2 * time.Hour)})
+	s.Require().NoError(s.poolKeeper.ExpireOutflowFreeze(ctx))
+	has, err := s.poolKeeper.OutflowFreeze.Has(ctx)
+	s.Require().NoError(err)
+	s.Require().False(has)
+
+	// a freeze of the authority needs no ratification and can be lifted early
+	_, err = s.msgServer.FreezeOutflows(ctx, &types.MsgFreezeOutflows{Sender: s.poolKeeper.GetAuthority(), Duration: time.Hour})
+	s.Require().NoError(err)
+	s.Require().Len(gov.proposals, 2)
+	_, err = s.msgServer.LiftFreeze(ctx, &types.MsgLiftFreeze{Sender: securityAuthorityStr})
+	s.Require().NoError(err)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, amount).Return(nil)
+	s.Require().NoError(s.poolKeeper.DistributeFromCommunityPool(ctx, amount, recipient))
+}
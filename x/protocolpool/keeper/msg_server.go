@@ -95,6 +95,10 @@ func (k MsgServer) CommunityPoolSpend(ctx context.Context, msg *types.MsgCommuni
 		return nil, err
 	}
 
+	if err := k.trackSpend(ctx, msg.Amount); err != nil {
+		return nil, err
+	}
+
 	// distribute funds from community pool module account
 	if err := k.Keeper.DistributeFromCommunityPool(ctx, msg.Amount, recipient); err != nil {
 		return nil, err
@@ -105,6 +109,228 @@ func (k MsgServer) CommunityPoolSpend(ctx context.Context, msg *types.MsgCommuni
 	return &types.MsgCommunityPoolSpendResponse{}, nil
 }
 
+func (k MsgServer) CommunityPoolEscrowSpend(ctx context.Context, msg *types.MsgCommunityPoolEscrowSpend) (*types.MsgCommunityPoolEscrowSpendResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	id, err := k.Keeper.CreateEscrowedSpend(ctx, msg.Recipient, msg.Reviewer, msg.Milestones, msg.Deadline)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("escrowed community pool funds for recipient", "id", id, "amount", types.TotalAmount(msg.Milestones).String(), "recipient", msg.Recipient)
+
+	return &types.MsgCommunityPoolEscrowSpendResponse{Id: id}, nil
+}
+
+func (k MsgServer) ApproveMilestone(ctx context.Context, msg *types.MsgApproveMilestone) (*types.MsgApproveMilestoneResponse, error) {
+	approver, err := k.Keeper.authKeeper.AddressCodec().StringToBytes(msg.Approver)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid approver address: %s", err)
+	}
+
+	amount, err := k.Keeper.ApproveMilestone(ctx, approver, msg.EscrowId, msg.MilestoneIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveMilestoneResponse{Amount: amount}, nil
+}
+
+func (k MsgServer) SetSpendLimits(ctx context.Context, msg *types.MsgSetSpendLimits) (*types.MsgSetSpendLimitsResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.SetSpendLimits(ctx, msg.SpendLimits); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetSpendLimitsResponse{}, nil
+}
+
+func (k MsgServer) SetDenomPolicies(ctx context.Context, msg *types.MsgSetDenomPolicies) (*types.MsgSetDenomPoliciesResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.SetDenomPolicies(ctx, msg.DenomPolicies); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetDenomPoliciesResponse{}, nil
+}
+
+func (k MsgServer) CreateRecurringGrant(ctx context.Context, msg *types.MsgCreateRecurringGrant) (*types.MsgCreateRecurringGrantResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.CreateRecurringGrant(ctx, msg.Recipient, msg.Amount, msg.Period, msg.RenewalInterval, msg.RenewalDeposit); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("created recurring grant", "recipient", msg.Recipient, "amount", msg.Amount.String(), "period", msg.Period)
+
+	return &types.MsgCreateRecurringGrantResponse{}, nil
+}
+
+func (k MsgServer) RenewRecurringGrant(ctx context.Context, msg *types.MsgRenewRecurringGrant) (*types.MsgRenewRecurringGrantResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	recipient, err := k.Keeper.authKeeper.AddressCodec().StringToBytes(msg.Recipient)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	if err := k.Keeper.RenewRecurringGrant(ctx, recipient); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRenewRecurringGrantResponse{}, nil
+}
+
+func (k MsgServer) CancelRecurringGrant(ctx context.Context, msg *types.MsgCancelRecurringGrant) (*types.MsgCancelRecurringGrantResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	recipient, err := k.Keeper.authKeeper.AddressCodec().StringToBytes(msg.Recipient)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	if err := k.Keeper.CancelRecurringGrant(ctx, recipient); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelRecurringGrantResponse{}, nil
+}
+
+func (k MsgServer) PoolDelegate(ctx context.Context, msg *types.MsgPoolDelegate) (*types.MsgPoolDelegateResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	valAddr, err := k.Keeper.stakingKeeper.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if err := k.Keeper.PoolDelegate(ctx, valAddr, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("delegated from the community pool", "amount", msg.Amount.String(), "validator", msg.ValidatorAddress)
+
+	return &types.MsgPoolDelegateResponse{}, nil
+}
+
+func (k MsgServer) PoolUndelegate(ctx context.Context, msg *types.MsgPoolUndelegate) (*types.MsgPoolUndelegateResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	valAddr, err := k.Keeper.stakingKeeper.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	completionTime, undelegated, err := k.Keeper.PoolUndelegate(ctx, valAddr, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("undelegated from the community pool", "amount", undelegated.String(), "validator", msg.ValidatorAddress, "completion_time", completionTime)
+
+	return &types.MsgPoolUndelegateResponse{
+		CompletionTime: completionTime,
+		Amount:         sdk.NewCoin(msg.Amount.Denom, undelegated),
+	}, nil
+}
+
+func (k MsgServer) OpenPosition(ctx context.Context, msg *types.MsgOpenPosition) (*types.MsgOpenPositionResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	id, err := k.Keeper.OpenPosition(ctx, msg.Venue, msg.Recipient, msg.Principal, msg.ExpectedReturn, msg.Maturity, msg.Reporter)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("opened community pool position", "id", id, "venue", msg.Venue, "principal", msg.Principal.String())
+
+	return &types.MsgOpenPositionResponse{Id: id}, nil
+}
+
+func (k MsgServer) ReportPosition(ctx context.Context, msg *types.MsgReportPosition) (*types.MsgReportPositionResponse, error) {
+	reporter, err := k.Keeper.authKeeper.AddressCodec().StringToBytes(msg.Reporter)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid reporter address: %s", err)
+	}
+
+	if err := k.Keeper.ReportPosition(ctx, reporter, msg.PositionId, msg.CurrentValue, msg.Close, msg.Note); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReportPositionResponse{}, nil
+}
+
+func (k MsgServer) SetFreezeParams(ctx context.Context, msg *types.MsgSetFreezeParams) (*types.MsgSetFreezeParamsResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.SetFreezeParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetFreezeParamsResponse{}, nil
+}
+
+func (k MsgServer) FreezeOutflows(ctx context.Context, msg *types.MsgFreezeOutflows) (*types.MsgFreezeOutflowsResponse, error) {
+	sender, err := k.Keeper.authKeeper.AddressCodec().StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	freeze, err := k.Keeper.FreezeOutflows(ctx, sender, msg.Duration, msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFreezeOutflowsResponse{EndTime: freeze.EndTime, RatificationProposalId: freeze.RatificationProposalId}, nil
+}
+
+func (k MsgServer) RatifyFreeze(ctx context.Context, msg *types.MsgRatifyFreeze) (*types.MsgRatifyFreezeResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RatifyFreeze(ctx, msg.FreezeStartTime); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRatifyFreezeResponse{}, nil
+}
+
+func (k MsgServer) LiftFreeze(ctx context.Context, msg *types.MsgLiftFreeze) (*types.MsgLiftFreezeResponse, error) {
+	sender, err := k.Keeper.authKeeper.AddressCodec().StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if err := k.Keeper.LiftFreeze(ctx, sender); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgLiftFreezeResponse{}, nil
+}
+
 func (k MsgServer) CreateContinuousFund(ctx context.Context, msg *types.MsgCreateContinuousFund) (*types.MsgCreateContinuousFundResponse, error) {
 	if err := k.validateAuthority(msg.Authority); err != nil {
 		return nil, err
@@ -3,6 +3,8 @@ package keeper_test
 import (
 	"time"
 
+	"github.com/golang/mock/gomock"
+
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/math"
@@ -274,6 +276,72 @@ func (suite *KeeperTestSuite) TestMsgClaimBudget() {
 			expErr:           false,
 			claimableFunds:   sdk.NewInt64Coin("foo", 50),
 		},
+		"valid claim of multiple elapsed tranches": {
+			preRun: func() {
+				startTime := suite.environment.HeaderService.GetHeaderInfo(suite.ctx).Time.Add(-190 * time.Second)
+				budget := types.Budget{
+					RecipientAddress: recipientAddr.String(),
+					TotalBudget:      &fooCoin,
+					StartTime:        &startTime,
+					Tranches:         5,
+					Period:           &period,
+				}
+				err := suite.poolKeeper.BudgetProposal.Set(suite.ctx, recipientAddr, budget)
+				suite.Require().NoError(err)
+			},
+			recipientAddress: recipientAddr,
+			expErr:           false,
+			claimableFunds:   sdk.NewInt64Coin("foo", 60),
+		},
+		"valid claim capped to the tranches left": {
+			preRun: func() {
+				startTime := suite.environment.HeaderService.GetHeaderInfo(suite.ctx).Time.Add(-600 * time.Second)
+				budget := types.Budget{
+					RecipientAddress: recipientAddr.String(),
+					TotalBudget:      &fooCoin,
+					StartTime:        &startTime,
+					Tranches:         3,
+					Period:           &period,
+				}
+				err := suite.poolKeeper.BudgetProposal.Set(suite.ctx, recipientAddr, budget)
+				suite.Require().NoError(err)
+			},
+			recipientAddress: recipientAddr,
+			expErr:           false,
+			// the last tranche releases the remainder of 100 / 3
+			claimableFunds: sdk.NewInt64Coin("foo", 100),
+		},
+		"last tranche releases the remainder": {
+			preRun: func() {
+				budget := types.Budget{
+					RecipientAddress: recipientAddr.String(),
+					TotalBudget:      &fooCoin,
+					StartTime:        &startTime,
+					Tranches:         3,
+					Period:           &period,
+				}
+				err := suite.poolKeeper.BudgetProposal.Set(suite.ctx, recipientAddr, budget)
+				suite.Require().NoError(err)
+
+				// Claim the first tranche
+				msg := &types.MsgClaimBudget{
+					RecipientAddress: recipientAddr.String(),
+				}
+				suite.mockSendCoinsFromModuleToAccount(recipientAddr)
+				resp, err := suite.msgServer.ClaimBudget(suite.ctx, msg)
+				suite.Require().NoError(err)
+				suite.Require().Equal(sdk.NewInt64Coin("foo", 33), resp.Amount)
+
+				// Skip the two remaining periods
+				newBlockTime := suite.environment.HeaderService.GetHeaderInfo(suite.ctx).Time.Add(120 * time.Second)
+				suite.ctx = suite.ctx.WithHeaderInfo(header.Info{
+					Time: newBlockTime,
+				})
+			},
+			recipientAddress: recipientAddr,
+			expErr:           false,
+			claimableFunds:   sdk.NewInt64Coin("foo", 67),
+		},
 		"budget ended for recipient": {
 			preRun: func() {
 				// Prepare the budget proposal with valid start time and period
@@ -893,3 +961,357 @@ func (suite *KeeperTestSuite) TestCancelContinuousFund() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestCommunityPoolEscrowSpend() {
+	reviewerAddr := sdk.AccAddress([]byte("reviewer____________"))
+	deadline := suite.environment.HeaderService.GetHeaderInfo(suite.ctx).Time.Add(time.Hour)
+	milestones := []types.Milestone{
+		{Description: "design", Amount: sdk.NewCoins(fooCoin)},
+		{Description: "delivery", Amount: sdk.NewCoins(fooCoin2)},
+	}
+
+	testCases := map[string]struct {
+		input     *types.MsgCommunityPoolEscrowSpend
+		preRun    func()
+		expErr    bool
+		expErrMsg string
+	}{
+		"invalid authority": {
+			input: &types.MsgCommunityPoolEscrowSpend{
+				Authority:  recipientAddr.String(),
+				Recipient:  recipientAddr.String(),
+				Milestones: milestones,
+				Deadline:   deadline,
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		"no milestones": {
+			input: &types.MsgCommunityPoolEscrowSpend{
+				Authority: suite.poolKeeper.GetAuthority(),
+				Recipient: recipientAddr.String(),
+				Deadline:  deadline,
+			},
+			expErr:    true,
+			expErrMsg: "milestones cannot be empty",
+		},
+		"deadline in the past": {
+			input: &types.MsgCommunityPoolEscrowSpend{
+				Authority:  suite.poolKeeper.GetAuthority(),
+				Recipient:  recipientAddr.String(),
+				Milestones: milestones,
+				Deadline:   deadline.Add(-2 * time.Hour),
+			},
+			expErr:    true,
+			expErrMsg: "deadline must be after the current block time",
+		},
+		"all good": {
+			input: &types.MsgCommunityPoolEscrowSpend{
+				Authority:  suite.poolKeeper.GetAuthority(),
+				Recipient:  recipientAddr.String(),
+				Reviewer:   reviewerAddr.String(),
+				Milestones: milestones,
+				Deadline:   deadline,
+			},
+			preRun: func() {
+				suite.bankKeeper.EXPECT().SendCoinsFromModuleToModule(suite.ctx, types.ModuleName, types.EscrowAccount, sdk.NewCoins(sdk.NewInt64Coin("foo", 150))).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+			resp, err := suite.msgServer.CommunityPoolEscrowSpend(suite.ctx, tc.input)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			escrow, err := suite.poolKeeper.EscrowedSpends.Get(suite.ctx, resp.Id)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.input.Recipient, escrow.Recipient)
+			suite.Require().Equal(tc.input.Reviewer, escrow.Reviewer)
+			suite.Require().Len(escrow.Milestones, 2)
+
+			has, err := suite.poolKeeper.EscrowDeadlineQueue.Has(suite.ctx, collections.Join(escrow.Deadline, escrow.Id))
+			suite.Require().NoError(err)
+			suite.Require().True(has)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestApproveMilestone() {
+	reviewerAddr := sdk.AccAddress([]byte("reviewer____________"))
+
+	createEscrow := func() uint64 {
+		deadline := suite.environment.HeaderService.GetHeaderInfo(suite.ctx).Time.Add(time.Hour)
+		suite.bankKeeper.EXPECT().SendCoinsFromModuleToModule(suite.ctx, types.ModuleName, types.EscrowAccount, gomock.Any()).Return(nil)
+		resp, err := suite.msgServer.CommunityPoolEscrowSpend(suite.ctx, &types.MsgCommunityPoolEscrowSpend{
+			Authority: suite.poolKeeper.GetAuthority(),
+			Recipient: recipientAddr.String(),
+			Reviewer:  reviewerAddr.String(),
+			Milestones: []types.Milestone{
+				{Description: "design", Amount: sdk.NewCoins(fooCoin)},
+				{Description: "delivery", Amount: sdk.NewCoins(fooCoin2)},
+			},
+			Deadline: deadline,
+		})
+		suite.Require().NoError(err)
+		return resp.Id
+	}
+
+	testCases := map[string]struct {
+		preRun    func() *types.MsgApproveMilestone
+		expErr    bool
+		expErrMsg string
+		expAmount sdk.Coins
+		postRun   func(id uint64)
+	}{
+		"unknown escrow": {
+			preRun: func() *types.MsgApproveMilestone {
+				return &types.MsgApproveMilestone{Approver: reviewerAddr.String(), EscrowId: 42}
+			},
+			expErr:    true,
+			expErrMsg: "no escrowed spend found for id 42",
+		},
+		"unauthorized approver": {
+			preRun: func() *types.MsgApproveMilestone {
+				id := createEscrow()
+				return &types.MsgApproveMilestone{Approver: recipientAddr.String(), EscrowId: id}
+			},
+			expErr:    true,
+			expErrMsg: types.ErrUnauthorized.Error(),
+		},
+		"milestone out of range": {
+			preRun: func() *types.MsgApproveMilestone {
+				id := createEscrow()
+				return &types.MsgApproveMilestone{Approver: reviewerAddr.String(), EscrowId: id, MilestoneIndex: 2}
+			},
+			expErr:    true,
+			expErrMsg: "milestone 2 does not exist",
+		},
+		"milestone already approved": {
+			preRun: func() *types.MsgApproveMilestone {
+				id := createEscrow()
+				suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, types.EscrowAccount, recipientAddr, sdk.NewCoins(fooCoin)).Return(nil)
+				_, err := suite.msgServer.ApproveMilestone(suite.ctx, &types.MsgApproveMilestone{Approver: reviewerAddr.String(), EscrowId: id})
+				suite.Require().NoError(err)
+				return &types.MsgApproveMilestone{Approver: reviewerAddr.String(), EscrowId: id}
+			},
+			expErr:    true,
+			expErrMsg: "milestone 0 is already approved",
+		},
+		"deadline passed": {
+			preRun: func() *types.MsgApproveMilestone {
+				id := createEscrow()
+				suite.ctx = suite.ctx.WithHeaderInfo(header.Info{
+					Time: suite.environment.HeaderService.GetHeaderInfo(suite.ctx).Time.Add(2 * time.Hour),
+				})
+				return &types.MsgApproveMilestone{Approver: reviewerAddr.String(), EscrowId: id}
+			},
+			expErr:    true,
+			expErrMsg: "deadline has passed",
+		},
+		"approved by reviewer": {
+			preRun: func() *types.MsgApproveMilestone {
+				id := createEscrow()
+				suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, types.EscrowAccount, recipientAddr, sdk.NewCoins(fooCoin2)).Return(nil)
+				return &types.MsgApproveMilestone{Approver: reviewerAddr.String(), EscrowId: id, MilestoneIndex: 1}
+			},
+			expAmount: sdk.NewCoins(fooCoin2),
+			postRun: func(id uint64) {
+				escrow, err := suite.poolKeeper.EscrowedSpends.Get(suite.ctx, id)
+				suite.Require().NoError(err)
+				suite.Require().False(escrow.Milestones[0].Approved)
+				suite.Require().True(escrow.Milestones[1].Approved)
+			},
+		},
+		"last milestone approved by authority removes escrow": {
+			preRun: func() *types.MsgApproveMilestone {
+				id := createEscrow()
+				suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, types.EscrowAccount, recipientAddr, sdk.NewCoins(fooCoin2)).Return(nil)
+				_, err := suite.msgServer.ApproveMilestone(suite.ctx, &types.MsgApproveMilestone{Approver: reviewerAddr.String(), EscrowId: id, MilestoneIndex: 1})
+				suite.Require().NoError(err)
+				suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, types.EscrowAccount, recipientAddr, sdk.NewCoins(fooCoin)).Return(nil)
+				return &types.MsgApproveMilestone{Approver: suite.poolKeeper.GetAuthority(), EscrowId: id}
+			},
+			expAmount: sdk.NewCoins(fooCoin),
+			postRun: func(id uint64) {
+				_, err := suite.poolKeeper.EscrowedSpends.Get(suite.ctx, id)
+				suite.Require().ErrorIs(err, collections.ErrNotFound)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			msg := tc.preRun()
+			resp, err := suite.msgServer.ApproveMilestone(suite.ctx, msg)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expAmount, resp.Amount)
+			if tc.postRun != nil {
+				tc.postRun(msg.EscrowId)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestClawbackExpiredEscrows() {
+	deadline := suite.environment.HeaderService.GetHeaderInfo(suite.ctx).Time.Add(time.Hour)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToModule(suite.ctx, types.ModuleName, types.EscrowAccount, gomock.Any()).Return(nil)
+	resp, err := suite.msgServer.CommunityPoolEscrowSpend(suite.ctx, &types.MsgCommunityPoolEscrowSpend{
+		Authority: suite.poolKeeper.GetAuthority(),
+		Recipient: recipientAddr.String(),
+		Milestones: []types.Milestone{
+			{Description: "design", Amount: sdk.NewCoins(fooCoin)},
+			{Description: "delivery", Amount: sdk.NewCoins(fooCoin2)},
+		},
+		Deadline: deadline,
+	})
+	suite.Require().NoError(err)
+
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, types.EscrowAccount, recipientAddr, sdk.NewCoins(fooCoin)).Return(nil)
+	_, err = suite.msgServer.ApproveMilestone(suite.ctx, &types.MsgApproveMilestone{Approver: suite.poolKeeper.GetAuthority(), EscrowId: resp.Id})
+	suite.Require().NoError(err)
+
+	// nothing is clawed back before the deadline
+	suite.Require().NoError(suite.poolKeeper.ClawbackExpiredEscrows(suite.ctx))
+	_, err = suite.poolKeeper.EscrowedSpends.Get(suite.ctx, resp.Id)
+	suite.Require().NoError(err)
+
+	// the unapproved milestone is returned to the community pool after the deadline
+	suite.ctx = suite.ctx.WithHeaderInfo(header.Info{Time: deadline.Add(time.Second)})
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToModule(suite.ctx, types.EscrowAccount, types.ModuleName, sdk.NewCoins(fooCoin2)).Return(nil)
+	suite.Require().NoError(suite.poolKeeper.ClawbackExpiredEscrows(suite.ctx))
+	_, err = suite.poolKeeper.EscrowedSpends.Get(suite.ctx, resp.Id)
+	suite.Require().ErrorIs(err, collections.ErrNotFound)
+}
+
+func (suite *KeeperTestSuite) TestSetSpendLimits() {
+	testCases := map[string]struct {
+		input     *types.MsgSetSpendLimits
+		expErr    bool
+		expErrMsg string
+	}{
+		"invalid authority": {
+			input: &types.MsgSetSpendLimits{
+				Authority:   recipientAddr.String(),
+				SpendLimits: []types.SpendLimit{{Denom: "foo", Limit: math.NewInt(100), Window: time.Hour}},
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		"duplicate denom": {
+			input: &types.MsgSetSpendLimits{
+				Authority: suite.poolKeeper.GetAuthority(),
+				SpendLimits: []types.SpendLimit{
+					{Denom: "foo", Limit: math.NewInt(100), Window: time.Hour},
+					{Denom: "foo", Limit: math.NewInt(50), Window: time.Hour},
+				},
+			},
+			expErr:    true,
+			expErrMsg: "duplicate spend limit for denom foo",
+		},
+		"zero window": {
+			input: &types.MsgSetSpendLimits{
+				Authority:   suite.poolKeeper.GetAuthority(),
+				SpendLimits: []types.SpendLimit{{Denom: "foo", Limit: math.NewInt(100)}},
+			},
+			expErr:    true,
+			expErrMsg: "window for denom foo must be positive",
+		},
+		"all good": {
+			input: &types.MsgSetSpendLimits{
+				Authority:   suite.poolKeeper.GetAuthority(),
+				SpendLimits: []types.SpendLimit{{Denom: "foo", Limit: math.NewInt(100), Window: time.Hour}},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			_, err := suite.msgServer.SetSpendLimits(suite.ctx, tc.input)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			limit, err := suite.poolKeeper.SpendLimits.Get(suite.ctx, "foo")
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.input.SpendLimits[0], limit)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCommunityPoolSpendLimit() {
+	suite.SetupTest()
+	authority := suite.poolKeeper.GetAuthority()
+	_, err := suite.msgServer.SetSpendLimits(suite.ctx, &types.MsgSetSpendLimits{
+		Authority:   authority,
+		SpendLimits: []types.SpendLimit{{Denom: "foo", Limit: math.NewInt(120), Window: time.Hour}},
+	})
+	suite.Require().NoError(err)
+
+	spend := func(amount sdk.Coins) error {
+		_, err := suite.msgServer.CommunityPoolSpend(suite.ctx, &types.MsgCommunityPoolSpend{
+			Authority: authority,
+			Recipient: recipientAddr.String(),
+			Amount:    amount,
+		})
+		return err
+	}
+
+	// spend 100foo and unlimited denoms within the window
+	barCoin := sdk.NewInt64Coin("bar", 1000)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, recipientAddr, gomock.Any()).Return(nil).Times(2)
+	suite.Require().NoError(spend(sdk.NewCoins(fooCoin, barCoin)))
+
+	// spending another 50foo exceeds the limit
+	err = spend(sdk.NewCoins(fooCoin2))
+	suite.Require().ErrorIs(err, types.ErrSpendLimitExceeded)
+
+	// the window is tracked across spends
+	window, err := suite.poolKeeper.SpendWindows.Get(suite.ctx, "foo")
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(100), window.Spent)
+
+	// once the window elapsed, a new window starts
+	suite.ctx = suite.ctx.WithHeaderInfo(header.Info{Time: window.Start.Add(time.Hour)})
+	suite.Require().NoError(spend(sdk.NewCoins(fooCoin2)))
+
+	window, err = suite.poolKeeper.SpendWindows.Get(suite.ctx, "foo")
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(50), window.Spent)
+	suite.Require().Equal(window.Start, suite.environment.HeaderService.GetHeaderInfo(suite.ctx).Time)
+
+	// escrowed spends count against the limit as well
+	_, err = suite.msgServer.CommunityPoolEscrowSpend(suite.ctx, &types.MsgCommunityPoolEscrowSpend{
+		Authority:  authority,
+		Recipient:  recipientAddr.String(),
+		Milestones: []types.Milestone{{Description: "delivery", Amount: sdk.NewCoins(fooCoin)}},
+		Deadline:   window.Start.Add(24 * time.Hour),
+	})
+	suite.Require().ErrorIs(err, types.ErrSpendLimitExceeded)
+
+	// removing the limit removes its window
+	_, err = suite.msgServer.SetSpendLimits(suite.ctx, &types.MsgSetSpendLimits{Authority: authority})
+	suite.Require().NoError(err)
+	has, err := suite.poolKeeper.SpendWindows.Has(suite.ctx, "foo")
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/protocolpool/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PoolDelegate delegates the given amount of the community pool to a validator and
+// adds it to the principal of the community pool delegation to the validator.
+func (k Keeper) PoolDelegate(ctx context.Context, valAddr sdk.ValAddress, amount sdk.Coin) error {
+	if err := k.checkOutflowsNotFrozen(ctx); err != nil {
+		return err
+	}
+	if err := k.validatePoolStakingAmount(ctx, amount); err != nil {
+		return err
+	}
+
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	poolAddr := k.authKeeper.GetModuleAddress(types.ModuleName)
+	if _, err := k.stakingKeeper.Delegate(ctx, poolAddr, amount.Amount, stakingtypes.Unbonded, validator, true); err != nil {
+		return err
+	}
+	if err := k.afterBalanceChanged(ctx, types.BalanceChangeSourcePoolDelegation, nil, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	principal, err := k.getPoolDelegationPrincipal(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.PoolDelegations.Set(ctx, valAddr, principal.Add(amount.Amount))
+}
+
+// PoolUndelegate undelegates the given amount of the community pool from a
+// validator and deducts it from the principal of the community pool delegation to
+// the validator. The funds are returned to the community pool once the unbonding
+// period is over. It returns the completion time of the unbonding and the amount
+// unbonded.
+func (k Keeper) PoolUndelegate(ctx context.Context, valAddr sdk.ValAddress, amount sdk.Coin) (time.Time, math.Int, error) {
+	if err := k.validatePoolStakingAmount(ctx, amount); err != nil {
+		return time.Time{}, math.Int{}, err
+	}
+
+	poolAddr := k.authKeeper.GetModuleAddress(types.ModuleName)
+	shares, err := k.stakingKeeper.ValidateUnbondAmount(ctx, poolAddr, valAddr, amount.Amount)
+	if err != nil {
+		return time.Time{}, math.Int{}, err
+	}
+
+	completionTime, undelegated, err := k.stakingKeeper.Undelegate(ctx, poolAddr, valAddr, shares)
+	if err != nil {
+		return time.Time{}, math.Int{}, err
+	}
+
+	// the principal of a delegation which no longer exists is removed, even if
+	// slashes made the undelegated amount lower than the principal
+	_, err = k.stakingKeeper.Delegation(ctx, poolAddr, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return completionTime, undelegated, k.PoolDelegations.Remove(ctx, valAddr)
+	}
+	if err != nil {
+		return time.Time{}, math.Int{}, err
+	}
+
+	principal, err := k.getPoolDelegationPrincipal(ctx, valAddr)
+	if err != nil {
+		return time.Time{}, math.Int{}, err
+	}
+
+	principal = principal.Sub(math.MinInt(principal, undelegated))
+	if principal.IsZero() {
+		return completionTime, undelegated, k.PoolDelegations.Remove(ctx, valAddr)
+	}
+
+	return completionTime, undelegated, k.PoolDelegations.Set(ctx, valAddr, principal)
+}
+
+// GetPoolDelegationBalances returns the principal and the current balance of the
+// community pool delegations.
+func (k Keeper) GetPoolDelegationBalances(ctx context.Context) ([]types.PoolDelegationBalance, error) {
+	poolAddr := k.authKeeper.GetModuleAddress(types.ModuleName)
+
+	var balances []types.PoolDelegationBalance
+	err := k.PoolDelegations.Walk(ctx, nil, func(valAddr sdk.ValAddress, principal math.Int) (stop bool, err error) {
+		valAddrStr, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+		if err != nil {
+			return true, err
+		}
+
+		balance := math.ZeroInt()
+		delegation, err := k.stakingKeeper.Delegation(ctx, poolAddr, valAddr)
+		switch {
+		case err == nil:
+			validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+			if err != nil {
+				return true, err
+			}
+			balance = validator.TokensFromShares(delegation.GetShares()).TruncateInt()
+		case !errors.Is(err, collections.ErrNotFound):
+			return true, err
+		}
+
+		balances = append(balances, types.PoolDelegationBalance{
+			Delegation: types.PoolDelegation{ValidatorAddress: valAddrStr, Principal: principal},
+			Balance:    balance,
+		})
+		return false, nil
+	})
+
+	return balances, err
+}
+
+func (k Keeper) getPoolDelegationPrincipal(ctx context.Context, valAddr sdk.ValAddress) (math.Int, error) {
+	principal, err := k.PoolDelegations.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+	return principal, err
+}
+
+func (k Keeper) validatePoolStakingAmount(ctx context.Context, amount sdk.Coin) error {
+	if !amount.IsValid() || !amount.IsPositive() {
+		return errorsmod.Wrapf(types.ErrInvalidPoolDelegation, "invalid amount %s", amount)
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+	if amount.Denom != bondDenom {
+		return errorsmod.Wrapf(types.ErrInvalidPoolDelegation, "invalid denom %s, expected bond denom %s", amount.Denom, bondDenom)
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/protocolpool/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// OpenPosition records an investment position of the community pool. If a
+// recipient is given, the principal is sent to it from the community pool.
+// It returns the position id.
+func (k Keeper) OpenPosition(ctx context.Context, venue, recipient string, principal, expectedReturn sdk.Coins, maturity *time.Time, reporter string) (uint64, error) {
+	if err := types.ValidatePosition(venue, principal, expectedReturn); err != nil {
+		return 0, err
+	}
+
+	if reporter != "" {
+		if _, err := k.authKeeper.AddressCodec().StringToBytes(reporter); err != nil {
+			return 0, sdkerrors.ErrInvalidAddress.Wrapf("invalid reporter address: %s", err)
+		}
+	}
+
+	currentTime := k.environment.HeaderService.GetHeaderInfo(ctx).Time
+	if maturity != nil && !maturity.After(currentTime) {
+		return 0, errorsmod.Wrap(types.ErrInvalidPosition, "maturity must be after the current block time")
+	}
+
+	if recipient != "" {
+		recipientAddr, err := k.authKeeper.AddressCodec().StringToBytes(recipient)
+		if err != nil {
+			return 0, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+		}
+
+		if err := k.checkOutflowsNotFrozen(ctx); err != nil {
+			return 0, err
+		}
+		if err := k.trackSpend(ctx, principal); err != nil {
+			return 0, err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipientAddr, principal); err != nil {
+			return 0, err
+		}
+		if err := k.afterBalanceChanged(ctx, types.BalanceChangeSourcePosition, nil, principal); err != nil {
+			return 0, err
+		}
+	}
+
+	id, err := k.PositionSequence.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	position := types.Position{
+		Id:             id,
+		Venue:          venue,
+		Recipient:      recipient,
+		Principal:      principal,
+		ExpectedReturn: expectedReturn,
+		Maturity:       maturity,
+		Reporter:       reporter,
+		Status:         types.PositionStatusOpen,
+		OpenedAt:       currentTime,
+		CurrentValue:   principal,
+	}
+
+	return id, k.Positions.Set(ctx, id, position)
+}
+
+// ReportPosition updates the current value of an open position and closes it if
+// requested. Only the reporter of the position or the authority can report on
+// positions.
+func (k Keeper) ReportPosition(ctx context.Context, reporter []byte, id uint64, currentValue sdk.Coins, closePosition bool, note string) error {
+	position, err := k.GetPosition(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	isAuthority, err := k.hasPermission(ctx, reporter)
+	if err != nil {
+		return err
+	}
+	if !isAuthority {
+		if position.Reporter == "" {
+			return errorsmod.Wrapf(types.ErrUnauthorized, "position %d has no reporter", id)
+		}
+		reporterAddr, err := k.authKeeper.AddressCodec().StringToBytes(position.Reporter)
+		if err != nil {
+			return err
+		}
+		if !sdk.AccAddress(reporterAddr).Equals(sdk.AccAddress(reporter)) {
+			return errorsmod.Wrapf(types.ErrUnauthorized, "not the reporter of position %d", id)
+		}
+	}
+
+	if position.Status != types.PositionStatusOpen {
+		return errorsmod.Wrapf(types.ErrInvalidPosition, "position %d is not open", id)
+	}
+	if !currentValue.IsValid() {
+		return errorsmod.Wrapf(types.ErrInvalidPosition, "invalid current value %s", currentValue)
+	}
+
+	currentTime := k.environment.HeaderService.GetHeaderInfo(ctx).Time
+	position.CurrentValue = currentValue
+	position.LastReportTime = &currentTime
+	position.LastReportNote = note
+	if closePosition {
+		position.Status = types.PositionStatusClosed
+	}
+
+	return k.Positions.Set(ctx, id, position)
+}
+
+// GetPosition returns the position with the given id.
+func (k Keeper) GetPosition(ctx context.Context, id uint64) (types.Position, error) {
+	position, err := k.Positions.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Position{}, errorsmod.Wrapf(types.ErrInvalidPosition, "no position found for id %d", id)
+		}
+		return types.Position{}, err
+	}
+
+	return position, nil
+}
+
+// GetDeployedCapital returns the number of open positions along with their total
+// principal and current value.
+func (k Keeper) GetDeployedCapital(ctx context.Context) (openPositions uint64, principal, currentValue sdk.Coins, err error) {
+	principal, currentValue = sdk.NewCoins(), sdk.NewCoins()
+	err = k.Positions.Walk(ctx, nil, func(_ uint64, position types.Position) (stop bool, err error) {
+		if position.Status != types.PositionStatusOpen {
+			return false, nil
+		}
+
+		openPositions++
+		principal = principal.Add(position.Principal...)
+		currentValue = currentValue.Add(position.CurrentValue...)
+		return false, nil
+	})
+
+	return openPositions, principal, currentValue, err
+}
@@ -0,0 +1,238 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	govv1 "cosmossdk.io/x/gov/types/v1"
+	"cosmossdk.io/x/protocolpool/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CreateRecurringGrant stores a recurring grant paying the given amount from the
+// community pool to the recipient every period. The first payment is made one
+// period after the creation, and the first renewal proposal is submitted one
+// renewal interval after the creation.
+func (k Keeper) CreateRecurringGrant(ctx context.Context, recipient string, amount sdk.Coins, period, renewalInterval time.Duration, renewalDeposit sdk.Coins) error {
+	if k.govMsgServer.ms == nil {
+		return errorsmod.Wrap(types.ErrInvalidRecurringGrant, "no gov msg server set to submit the renewal proposals")
+	}
+
+	recipientAddr, err := k.authKeeper.AddressCodec().StringToBytes(recipient)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	if err := types.ValidateRecurringGrant(recipient, amount, period, renewalInterval, renewalDeposit); err != nil {
+		return err
+	}
+
+	has, err := k.RecurringGrants.Has(ctx, recipientAddr)
+	if err != nil {
+		return err
+	}
+	if has {
+		return errorsmod.Wrapf(types.ErrInvalidRecurringGrant, "recurring grant already exists for recipient %s", recipient)
+	}
+
+	currentTime := k.environment.HeaderService.GetHeaderInfo(ctx).Time
+	grant := types.RecurringGrant{
+		Recipient:       recipient,
+		Amount:          amount,
+		Period:          period,
+		NextPayment:     currentTime.Add(period),
+		RenewalInterval: renewalInterval,
+		RenewalTime:     currentTime.Add(renewalInterval),
+		RenewalDeposit:  renewalDeposit,
+	}
+
+	return k.setRecurringGrant(ctx, recipientAddr, grant)
+}
+
+// RenewRecurringGrant continues a recurring grant until its next renewal, one
+// renewal interval from now. It clears the pending renewal proposal, if any.
+func (k Keeper) RenewRecurringGrant(ctx context.Context, recipient sdk.AccAddress) error {
+	grant, err := k.getRecurringGrant(ctx, recipient)
+	if err != nil {
+		return err
+	}
+
+	if grant.RenewalProposalId != 0 {
+		if err := k.RenewalProposals.Remove(ctx, grant.RenewalProposalId); err != nil {
+			return err
+		}
+		grant.RenewalProposalId = 0
+	}
+	grant.RenewalTime = k.environment.HeaderService.GetHeaderInfo(ctx).Time.Add(grant.RenewalInterval)
+
+	return k.RecurringGrants.Set(ctx, recipient, grant)
+}
+
+// CancelRecurringGrant removes the recurring grant of the recipient.
+func (k Keeper) CancelRecurringGrant(ctx context.Context, recipient sdk.AccAddress) error {
+	grant, err := k.getRecurringGrant(ctx, recipient)
+	if err != nil {
+		return err
+	}
+
+	return k.removeRecurringGrant(ctx, recipient, grant)
+}
+
+// ProcessRecurringGrants pays the recurring grants due by the current block time
+// and schedules their next payment. The renewal proposal of a grant is submitted
+// with its first payment at or after its renewal time, unless a renewal proposal
+// is already pending. Failed payments and proposal submissions are logged and
+// skipped so that they do not halt the chain.
+func (k Keeper) ProcessRecurringGrants(ctx context.Context) error {
+	currentTime := k.environment.HeaderService.GetHeaderInfo(ctx).Time
+	rng := collections.NewPrefixUntilPairRange[time.Time, sdk.AccAddress](currentTime)
+
+	iter, err := k.RecurringGrantQueue.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		recipient := key.K2()
+		grant, err := k.RecurringGrants.Get(ctx, recipient)
+		if err != nil {
+			return err
+		}
+
+		if err := k.RecurringGrantQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		err = k.environment.BranchService.Execute(ctx, func(ctx context.Context) error {
+			if err := k.trackSpend(ctx, grant.Amount); err != nil {
+				return err
+			}
+			return k.DistributeFromCommunityPool(ctx, grant.Amount, recipient)
+		})
+		if err != nil {
+			k.Logger(ctx).Error("failed to pay recurring grant", "recipient", grant.Recipient, "amount", grant.Amount.String(), "err", err)
+		} else {
+			k.Logger(ctx).Info("paid recurring grant", "recipient", grant.Recipient, "amount", grant.Amount.String())
+		}
+		grant.NextPayment = grant.NextPayment.Add(grant.Period)
+
+		if grant.RenewalProposalId == 0 && !currentTime.Before(grant.RenewalTime) {
+			var proposalID uint64
+			err := k.environment.BranchService.Execute(ctx, func(ctx context.Context) error {
+				proposalID, err = k.submitRenewalProposal(ctx, grant)
+				return err
+			})
+			if err != nil {
+				k.Logger(ctx).Error("failed to submit recurring grant renewal proposal", "recipient", grant.Recipient, "err", err)
+			} else {
+				grant.RenewalProposalId = proposalID
+			}
+		}
+
+		if err := k.setRecurringGrant(ctx, recipient, grant); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// cancelUnrenewedGrant cancels the recurring grant whose renewal proposal ended
+// without renewing it. It is a no-op for other proposals.
+func (k Keeper) cancelUnrenewedGrant(ctx context.Context, proposalID uint64) error {
+	recipient, err := k.RenewalProposals.Get(ctx, proposalID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	grant, err := k.RecurringGrants.Get(ctx, recipient)
+	if err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("cancelling recurring grant not renewed by governance", "recipient", grant.Recipient, "proposal_id", proposalID)
+
+	return k.removeRecurringGrant(ctx, recipient, grant)
+}
+
+// submitRenewalProposal submits a proposal to renew the recurring grant, with the
+// community pool as proposer and its renewal deposit as initial deposit.
+func (k Keeper) submitRenewalProposal(ctx context.Context, grant types.RecurringGrant) (uint64, error) {
+	if k.govMsgServer.ms == nil {
+		return 0, errors.New("no gov msg server set")
+	}
+
+	proposer, err := k.authKeeper.AddressCodec().BytesToString(k.authKeeper.GetModuleAddress(types.ModuleName))
+	if err != nil {
+		return 0, err
+	}
+
+	msg, err := govv1.NewMsgSubmitProposal(
+		[]sdk.Msg{&types.MsgRenewRecurringGrant{Authority: k.authority, Recipient: grant.Recipient}},
+		grant.RenewalDeposit,
+		proposer,
+		"",
+		"Renew community pool recurring grant",
+		fmt.Sprintf("Continue paying %s every %s from the community pool to %s. The recurring grant is cancelled if this proposal does not pass.", grant.Amount, grant.Period, grant.Recipient),
+		govv1.ProposalType_PROPOSAL_TYPE_STANDARD,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := k.govMsgServer.ms.SubmitProposal(ctx, msg)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.ProposalId, nil
+}
+
+func (k Keeper) getRecurringGrant(ctx context.Context, recipient sdk.AccAddress) (types.RecurringGrant, error) {
+	grant, err := k.RecurringGrants.Get(ctx, recipient)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.RecurringGrant{}, errorsmod.Wrapf(types.ErrInvalidRecurringGrant, "no recurring grant found for recipient %s", recipient)
+		}
+		return types.RecurringGrant{}, err
+	}
+
+	return grant, nil
+}
+
+func (k Keeper) setRecurringGrant(ctx context.Context, recipient sdk.AccAddress, grant types.RecurringGrant) error {
+	if err := k.RecurringGrants.Set(ctx, recipient, grant); err != nil {
+		return err
+	}
+	if grant.RenewalProposalId != 0 {
+		if err := k.RenewalProposals.Set(ctx, grant.RenewalProposalId, recipient); err != nil {
+			return err
+		}
+	}
+	return k.RecurringGrantQueue.Set(ctx, collections.Join(grant.NextPayment, recipient))
+}
+
+func (k Keeper) removeRecurringGrant(ctx context.Context, recipient sdk.AccAddress, grant types.RecurringGrant) error {
+	if err := k.RecurringGrants.Remove(ctx, recipient); err != nil {
+		return err
+	}
+	if grant.RenewalProposalId != 0 {
+		if err := k.RenewalProposals.Remove(ctx, grant.RenewalProposalId); err != nil {
+			return err
+		}
+	}
+	return k.RecurringGrantQueue.Remove(ctx, collections.Join(grant.NextPayment, recipient))
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/protocolpool/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetSpendLimits replaces the per-denom community pool spend limits. The spend
+// windows of denoms which are no longer limited are removed, while the ones of
+// denoms which remain limited are kept so that a limit change does not reset the
+// amount already spent within the current window.
+func (k Keeper) SetSpendLimits(ctx context.Context, limits []types.SpendLimit) error {
+	if err := types.ValidateSpendLimits(limits); err != nil {
+		return err
+	}
+
+	limited := make(map[string]bool, len(limits))
+	for _, limit := range limits {
+		limited[limit.Denom] = true
+	}
+
+	if err := k.SpendLimits.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	var stale []string
+	err := k.SpendWindows.Walk(ctx, nil, func(denom string, _ types.SpendWindow) (stop bool, err error) {
+		if !limited[denom] {
+			stale = append(stale, denom)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, denom := range stale {
+		if err := k.SpendWindows.Remove(ctx, denom); err != nil {
+			return err
+		}
+	}
+
+	for _, limit := range limits {
+		if err := k.SpendLimits.Set(ctx, limit.Denom, limit); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetSpendAllowance returns the remaining amount of a limited denom which can be
+// spent from the community pool within the current spend window.
+func (k Keeper) GetSpendAllowance(ctx context.Context, denom string) (types.SpendAllowance, error) {
+	limit, err := k.SpendLimits.Get(ctx, denom)
+	if err != nil {
+		return types.SpendAllowance{}, err
+	}
+
+	return k.spendAllowance(ctx, limit)
+}
+
+// trackSpend records the given amount as spent from the community pool within
+// the current spend windows of the limited denoms. It returns an error if the
+// amount of any denom exceeds the remaining allowance of its window.
+func (k Keeper) trackSpend(ctx context.Context, amount sdk.Coins) error {
+	currentTime := k.environment.HeaderService.GetHeaderInfo(ctx).Time
+
+	for _, coin := range amount {
+		limit, err := k.SpendLimits.Get(ctx, coin.Denom)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			return err
+		}
+
+		window, err := k.currentSpendWindow(ctx, limit)
+		if err != nil {
+			return err
+		}
+		if window == nil {
+			window = &types.SpendWindow{Denom: coin.Denom, Start: currentTime, Spent: math.ZeroInt()}
+		}
+
+		spent := window.Spent.Add(coin.Amount)
+		if spent.GT(limit.Limit) {
+			return errorsmod.Wrapf(types.ErrSpendLimitExceeded, "spending %s would exceed the limit of %s%s, %s%s remaining until %s",
+				coin, limit.Limit, coin.Denom, limit.Limit.Sub(window.Spent), coin.Denom, window.Start.Add(limit.Window))
+		}
+		window.Spent = spent
+
+		if err := k.SpendWindows.Set(ctx, coin.Denom, *window); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// currentSpendWindow returns the spend window of the limit's denom if it has not
+// elapsed yet, nil otherwise.
+func (k Keeper) currentSpendWindow(ctx context.Context, limit types.SpendLimit) (*types.SpendWindow, error) {
+	window, err := k.SpendWindows.Get(ctx, limit.Denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	currentTime := k.environment.HeaderService.GetHeaderInfo(ctx).Time
+	if !currentTime.Before(window.Start.Add(limit.Window)) {
+		return nil, nil
+	}
+
+	return &window, nil
+}
+
+func (k Keeper) spendAllowance(ctx context.Context, limit types.SpendLimit) (types.SpendAllowance, error) {
+	allowance := types.SpendAllowance{
+		Limit:     limit,
+		Spent:     math.ZeroInt(),
+		Remaining: limit.Limit,
+	}
+
+	window, err := k.currentSpendWindow(ctx, limit)
+	if err != nil {
+		return types.SpendAllowance{}, err
+	}
+	if window != nil {
+		windowEnd := window.Start.Add(limit.Window)
+		allowance.Spent = window.Spent
+		allowance.Remaining = limit.Limit.Sub(window.Spent)
+		allowance.WindowEnd = &windowEnd
+	}
+
+	return allowance, nil
+}
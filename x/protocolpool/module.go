@@ -30,8 +30,10 @@ var (
 	_ module.AppModuleSimulation   = AppModule{}
 	_ module.HasGenesis            = AppModule{}
 
-	_ appmodule.AppModule   = AppModule{}
-	_ appmodule.HasServices = AppModule{}
+	_ appmodule.AppModule     = AppModule{}
+	_ appmodule.HasServices   = AppModule{}
+	_ appmodule.HasEndBlocker = AppModule{}
+	_ appmodule.HasPrecommit  = AppModule{}
 )
 
 // AppModule implements an application module for the pool module
@@ -116,5 +118,30 @@ func (am AppModule) ExportGenesis(ctx context.Context, cdc codec.JSONCodec) json
 	return cdc.MustMarshalJSON(gs)
 }
 
+// EndBlock returns the unapproved funds of expired escrowed spends to the community pool,
+// pays the due recurring grants, applies the denom policies to the community pool holdings
+// and removes the expired outflow freeze.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	if err := am.keeper.ExpireOutflowFreeze(ctx); err != nil {
+		return err
+	}
+
+	if err := am.keeper.ClawbackExpiredEscrows(ctx); err != nil {
+		return err
+	}
+
+	if err := am.keeper.ProcessRecurringGrants(ctx); err != nil {
+		return err
+	}
+
+	return am.keeper.ApplyDenomPolicies(ctx)
+}
+
+// Precommit publishes the community pool balance changes of the committed block
+// to the subscribers of the balance changes stream.
+func (am AppModule) Precommit(ctx context.Context) error {
+	return am.keeper.PublishBalanceChanges(ctx)
+}
+
 // ConsensusVersion implements HasConsensusVersion
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
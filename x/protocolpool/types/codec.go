@@ -16,6 +16,21 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgCreateContinuousFund{},
 		&MsgCancelContinuousFund{},
 		&MsgWithdrawContinuousFund{},
+		&MsgCommunityPoolEscrowSpend{},
+		&MsgApproveMilestone{},
+		&MsgSetSpendLimits{},
+		&MsgSetDenomPolicies{},
+		&MsgCreateRecurringGrant{},
+		&MsgRenewRecurringGrant{},
+		&MsgCancelRecurringGrant{},
+		&MsgPoolDelegate{},
+		&MsgPoolUndelegate{},
+		&MsgOpenPosition{},
+		&MsgReportPosition{},
+		&MsgSetFreezeParams{},
+		&MsgFreezeOutflows{},
+		&MsgRatifyFreeze{},
+		&MsgLiftFreeze{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
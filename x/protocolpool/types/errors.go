@@ -3,6 +3,16 @@ package types
 import "cosmossdk.io/errors"
 
 var (
-	ErrInvalidSigner   = errors.Register(ModuleName, 2, "expected authority account as only signer for community pool spend message")
-	ErrNoRecipientFund = errors.Register(ModuleName, 3, "no recipient fund found for recipient")
+	ErrInvalidSigner         = errors.Register(ModuleName, 2, "expected authority account as only signer for community pool spend message")
+	ErrNoRecipientFund       = errors.Register(ModuleName, 3, "no recipient fund found for recipient")
+	ErrInvalidEscrow         = errors.Register(ModuleName, 4, "invalid escrowed spend")
+	ErrUnauthorized          = errors.Register(ModuleName, 5, "approver is neither the reviewer nor the authority")
+	ErrSpendLimitExceeded    = errors.Register(ModuleName, 6, "community pool spend limit exceeded")
+	ErrInvalidSpendLimit     = errors.Register(ModuleName, 7, "invalid spend limit")
+	ErrInvalidDenomPolicy    = errors.Register(ModuleName, 8, "invalid denom policy")
+	ErrInvalidRecurringGrant = errors.Register(ModuleName, 9, "invalid recurring grant")
+	ErrInvalidPoolDelegation = errors.Register(ModuleName, 10, "invalid community pool delegation")
+	ErrInvalidPosition       = errors.Register(ModuleName, 11, "invalid community pool position")
+	ErrOutflowsFrozen        = errors.Register(ModuleName, 12, "community pool outflows are frozen")
+	ErrInvalidFreeze         = errors.Register(ModuleName, 13, "invalid community pool outflow freeze")
 )
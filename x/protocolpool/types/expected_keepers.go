@@ -2,8 +2,12 @@ package types
 
 import (
 	context "context"
+	"time"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
+	govv1 "cosmossdk.io/x/gov/types/v1"
+	stakingtypes "cosmossdk.io/x/staking/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -18,7 +22,9 @@ type AccountKeeper interface {
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
 	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, address []byte, amt sdk.Coins) error
 	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
@@ -27,4 +33,31 @@ type BankKeeper interface {
 
 type StakingKeeper interface {
 	BondDenom(ctx context.Context) (string, error)
+	ValidatorAddressCodec() address.Codec
+	GetValidator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error)
+	Delegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.DelegationI, error)
+	Delegate(ctx context.Context, delAddr sdk.AccAddress, bondAmt math.Int, tokenSrc stakingtypes.BondStatus, validator stakingtypes.Validator, subtractAccount bool) (math.LegacyDec, error)
+	ValidateUnbondAmount(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amt math.Int) (math.LegacyDec, error)
+	Undelegate(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount math.LegacyDec) (time.Time, math.Int, error)
+}
+
+// SwapHook defines the interface a swap venue implements to dispose of the
+// community pool holdings of denoms with a swap policy.
+type SwapHook interface {
+	// SwapCommunityPoolDenom swaps the given coin held by the pool account and
+	// credits the proceeds back to the pool account.
+	SwapCommunityPoolDenom(ctx context.Context, pool sdk.AccAddress, coin sdk.Coin) error
+}
+
+// PoolBalanceHooks defines the interface of the hooks called whenever the
+// protocolpool module changes the community pool balance.
+type PoolBalanceHooks interface {
+	// AfterPoolBalanceChanged is called after the community pool balance changed.
+	AfterPoolBalanceChanged(ctx context.Context, change PoolBalanceChange) error
+}
+
+// GovMsgServer defines the expected interface of the gov msg server, used to
+// submit the renewal proposals of the recurring grants.
+type GovMsgServer interface {
+	SubmitProposal(ctx context.Context, msg *govv1.MsgSubmitProposal) (*govv1.MsgSubmitProposalResponse, error)
 }
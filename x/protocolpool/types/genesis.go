@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -36,9 +37,230 @@ func ValidateGenesis(gs *GenesisState) error {
 			return err
 		}
 	}
+	seenEscrows := make(map[uint64]bool, len(gs.EscrowedSpends))
+	for _, es := range gs.EscrowedSpends {
+		if seenEscrows[es.Id] {
+			return fmt.Errorf("duplicate escrowed spend id %d", es.Id)
+		}
+		seenEscrows[es.Id] = true
+
+		if es.Recipient == "" {
+			return fmt.Errorf("recipient cannot be empty")
+		}
+		if err := ValidateMilestones(es.Milestones); err != nil {
+			return err
+		}
+	}
+	if err := ValidateSpendLimits(gs.SpendLimits); err != nil {
+		return err
+	}
+	seenWindows := make(map[string]bool, len(gs.SpendWindows))
+	for _, sw := range gs.SpendWindows {
+		if seenWindows[sw.Denom] {
+			return fmt.Errorf("duplicate spend window for denom %s", sw.Denom)
+		}
+		seenWindows[sw.Denom] = true
+
+		if sw.Spent.IsNil() || sw.Spent.IsNegative() {
+			return fmt.Errorf("spend window amount for denom %s cannot be nil or negative", sw.Denom)
+		}
+	}
+	seenSources := make(map[string]bool, len(gs.FundingSources))
+	for _, fs := range gs.FundingSources {
+		if fs.Source == "" {
+			return fmt.Errorf("funding source cannot be empty")
+		}
+		if seenSources[fs.Source] {
+			return fmt.Errorf("duplicate funding source %s", fs.Source)
+		}
+		seenSources[fs.Source] = true
+
+		if err := fs.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid amount for funding source %s: %w", fs.Source, err)
+		}
+	}
+	seenGrants := make(map[string]bool, len(gs.RecurringGrants))
+	for _, grant := range gs.RecurringGrants {
+		if seenGrants[grant.Recipient] {
+			return fmt.Errorf("duplicate recurring grant for recipient %s", grant.Recipient)
+		}
+		seenGrants[grant.Recipient] = true
+
+		if err := ValidateRecurringGrant(grant.Recipient, grant.Amount, grant.Period, grant.RenewalInterval, grant.RenewalDeposit); err != nil {
+			return err
+		}
+	}
+	seenDelegations := make(map[string]bool, len(gs.PoolDelegations))
+	for _, delegation := range gs.PoolDelegations {
+		if delegation.ValidatorAddress == "" {
+			return fmt.Errorf("pool delegation validator address cannot be empty")
+		}
+		if seenDelegations[delegation.ValidatorAddress] {
+			return fmt.Errorf("duplicate pool delegation for validator %s", delegation.ValidatorAddress)
+		}
+		seenDelegations[delegation.ValidatorAddress] = true
+
+		if delegation.Principal.IsNil() || !delegation.Principal.IsPositive() {
+			return fmt.Errorf("pool delegation principal for validator %s must be positive", delegation.ValidatorAddress)
+		}
+	}
+	seenPositions := make(map[uint64]bool, len(gs.Positions))
+	for _, position := range gs.Positions {
+		if seenPositions[position.Id] {
+			return fmt.Errorf("duplicate position id %d", position.Id)
+		}
+		seenPositions[position.Id] = true
+
+		if err := ValidatePosition(position.Venue, position.Principal, position.ExpectedReturn); err != nil {
+			return err
+		}
+		if position.Status != PositionStatusOpen && position.Status != PositionStatusClosed {
+			return errors.Wrapf(ErrInvalidPosition, "invalid status %s for position %d", position.Status, position.Id)
+		}
+		if !position.CurrentValue.IsValid() {
+			return errors.Wrapf(ErrInvalidPosition, "invalid current value %s for position %d", position.CurrentValue, position.Id)
+		}
+	}
+	if err := ValidateFreezeParams(gs.FreezeParams); err != nil {
+		return err
+	}
+	if gs.OutflowFreeze != nil {
+		if gs.OutflowFreeze.FrozenBy == "" {
+			return errors.Wrap(ErrInvalidFreeze, "frozen by cannot be empty")
+		}
+		if !gs.OutflowFreeze.EndTime.After(gs.OutflowFreeze.StartTime) {
+			return errors.Wrap(ErrInvalidFreeze, "end time must be after the start time")
+		}
+		if gs.OutflowFreeze.Ratified == (gs.OutflowFreeze.RatificationProposalId != 0) {
+			return errors.Wrap(ErrInvalidFreeze, "a freeze must be either ratified or pending ratification")
+		}
+	}
+	return ValidateDenomPolicies(gs.DenomPolicies)
+}
+
+// ValidateFreezeParams validates the parameters of the freeze of the community
+// pool outflows.
+func ValidateFreezeParams(params FreezeParams) error {
+	if params.MaxDuration < 0 {
+		return errors.Wrap(ErrInvalidFreeze, "max duration cannot be negative")
+	}
+	if params.SecurityAuthority != "" && params.MaxDuration == 0 {
+		return errors.Wrap(ErrInvalidFreeze, "max duration must be positive when a security authority is set")
+	}
+	if err := params.RatificationDeposit.Validate(); err != nil {
+		return errors.Wrapf(ErrInvalidFreeze, "invalid ratification deposit: %s", err)
+	}
+
 	return nil
 }
 
+// ValidatePosition validates the terms of a community pool investment position.
+func ValidatePosition(venue string, principal, expectedReturn sdk.Coins) error {
+	if venue == "" {
+		return errors.Wrap(ErrInvalidPosition, "venue cannot be empty")
+	}
+	if principal.IsZero() || !principal.IsValid() {
+		return errors.Wrapf(ErrInvalidPosition, "invalid principal %s", principal)
+	}
+	if !expectedReturn.IsValid() {
+		return errors.Wrapf(ErrInvalidPosition, "invalid expected return %s", expectedReturn)
+	}
+
+	return nil
+}
+
+// ValidateRecurringGrant validates the terms of a recurring grant.
+func ValidateRecurringGrant(recipient string, amount sdk.Coins, period, renewalInterval time.Duration, renewalDeposit sdk.Coins) error {
+	if recipient == "" {
+		return errors.Wrap(ErrInvalidRecurringGrant, "recipient cannot be empty")
+	}
+	if !amount.IsValid() {
+		return errors.Wrapf(ErrInvalidRecurringGrant, "invalid amount %s", amount)
+	}
+	if period <= 0 {
+		return errors.Wrap(ErrInvalidRecurringGrant, "period must be positive")
+	}
+	if renewalInterval <= 0 {
+		return errors.Wrap(ErrInvalidRecurringGrant, "renewal interval must be positive")
+	}
+	if err := renewalDeposit.Validate(); err != nil {
+		return errors.Wrapf(ErrInvalidRecurringGrant, "invalid renewal deposit: %s", err)
+	}
+
+	return nil
+}
+
+// ValidateDenomPolicies validates the policies applied to the community pool
+// holdings of unwanted denoms.
+func ValidateDenomPolicies(policies []DenomPolicy) error {
+	seen := make(map[string]bool, len(policies))
+	for _, policy := range policies {
+		if err := sdk.ValidateDenom(policy.Denom); err != nil {
+			return errors.Wrap(ErrInvalidDenomPolicy, err.Error())
+		}
+		if seen[policy.Denom] {
+			return errors.Wrapf(ErrInvalidDenomPolicy, "duplicate policy for denom %s", policy.Denom)
+		}
+		seen[policy.Denom] = true
+
+		if policy.Action != DenomPolicyActionBurn && policy.Action != DenomPolicyActionSwap {
+			return errors.Wrapf(ErrInvalidDenomPolicy, "invalid action %s for denom %s", policy.Action, policy.Denom)
+		}
+	}
+
+	return nil
+}
+
+// ValidateSpendLimits validates the per-denom community pool spend limits.
+func ValidateSpendLimits(limits []SpendLimit) error {
+	seen := make(map[string]bool, len(limits))
+	for _, limit := range limits {
+		if err := sdk.ValidateDenom(limit.Denom); err != nil {
+			return errors.Wrap(ErrInvalidSpendLimit, err.Error())
+		}
+		if seen[limit.Denom] {
+			return errors.Wrapf(ErrInvalidSpendLimit, "duplicate spend limit for denom %s", limit.Denom)
+		}
+		seen[limit.Denom] = true
+
+		if limit.Limit.IsNil() || limit.Limit.IsNegative() {
+			return errors.Wrapf(ErrInvalidSpendLimit, "limit for denom %s cannot be nil or negative", limit.Denom)
+		}
+		if limit.Window <= 0 {
+			return errors.Wrapf(ErrInvalidSpendLimit, "window for denom %s must be positive", limit.Denom)
+		}
+	}
+
+	return nil
+}
+
+// ValidateMilestones validates the milestones of an escrowed spend.
+func ValidateMilestones(milestones []Milestone) error {
+	if len(milestones) == 0 {
+		return errors.Wrap(ErrInvalidEscrow, "milestones cannot be empty")
+	}
+
+	for i, m := range milestones {
+		if m.Amount.IsZero() {
+			return errors.Wrapf(ErrInvalidEscrow, "milestone %d amount cannot be zero", i)
+		}
+		if err := m.Amount.Validate(); err != nil {
+			return errors.Wrapf(ErrInvalidEscrow, "milestone %d amount is invalid: %s", i, err)
+		}
+	}
+
+	return nil
+}
+
+// TotalAmount returns the total amount of the given milestones.
+func TotalAmount(milestones []Milestone) sdk.Coins {
+	total := sdk.NewCoins()
+	for _, m := range milestones {
+		total = total.Add(m.Amount...)
+	}
+	return total
+}
+
 func validateBudget(bp Budget) error {
 	if bp.RecipientAddress == "" {
 		return fmt.Errorf("recipient cannot be empty")
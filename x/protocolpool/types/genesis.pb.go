@@ -32,6 +32,29 @@ type GenesisState struct {
 	// Budget defines the budget proposals at genesis.
 	Budget       []*Budget             `protobuf:"bytes,2,rep,name=budget,proto3" json:"budget,omitempty"`
 	ToDistribute cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=to_distribute,json=toDistribute,proto3,customtype=cosmossdk.io/math.Int" json:"to_distribute"`
+	// EscrowedSpends defines the escrowed spends at genesis.
+	EscrowedSpends []EscrowedSpend `protobuf:"bytes,4,rep,name=escrowed_spends,json=escrowedSpends,proto3" json:"escrowed_spends"`
+	// SpendLimits defines the per-denom community pool spend limits at genesis.
+	SpendLimits []SpendLimit `protobuf:"bytes,5,rep,name=spend_limits,json=spendLimits,proto3" json:"spend_limits"`
+	// SpendWindows defines the per-denom current spend windows at genesis.
+	SpendWindows []SpendWindow `protobuf:"bytes,6,rep,name=spend_windows,json=spendWindows,proto3" json:"spend_windows"`
+	// DenomPolicies defines the policies applied to the community pool holdings of
+	// unwanted denoms at genesis.
+	DenomPolicies []DenomPolicy `protobuf:"bytes,7,rep,name=denom_policies,json=denomPolicies,proto3" json:"denom_policies"`
+	// FundingSources defines the cumulative amounts which entered the community
+	// pool, per funding source, at genesis.
+	FundingSources []FundingSource `protobuf:"bytes,8,rep,name=funding_sources,json=fundingSources,proto3" json:"funding_sources"`
+	// RecurringGrants defines the recurring grants at genesis.
+	RecurringGrants []RecurringGrant `protobuf:"bytes,9,rep,name=recurring_grants,json=recurringGrants,proto3" json:"recurring_grants"`
+	// PoolDelegations defines the principal of the community pool delegations at genesis.
+	PoolDelegations []PoolDelegation `protobuf:"bytes,10,rep,name=pool_delegations,json=poolDelegations,proto3" json:"pool_delegations"`
+	// Positions defines the community pool investment positions at genesis.
+	Positions []Position `protobuf:"bytes,11,rep,name=positions,proto3" json:"positions"`
+	// FreezeParams defines who can freeze the community pool outflows at genesis.
+	FreezeParams FreezeParams `protobuf:"bytes,12,opt,name=freeze_params,json=freezeParams,proto3" json:"freeze_params"`
+	// OutflowFreeze defines the active freeze of the community pool outflows at
+	// genesis, if any.
+	OutflowFreeze *OutflowFreeze `protobuf:"bytes,13,opt,name=outflow_freeze,json=outflowFreeze,proto3" json:"outflow_freeze,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -81,6 +104,76 @@ func (m *GenesisState) GetBudget() []*Budget {
 	return nil
 }
 
+func (m *GenesisState) GetEscrowedSpends() []EscrowedSpend {
+	if m != nil {
+		return m.EscrowedSpends
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSpendLimits() []SpendLimit {
+	if m != nil {
+		return m.SpendLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSpendWindows() []SpendWindow {
+	if m != nil {
+		return m.SpendWindows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomPolicies() []DenomPolicy {
+	if m != nil {
+		return m.DenomPolicies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFundingSources() []FundingSource {
+	if m != nil {
+		return m.FundingSources
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRecurringGrants() []RecurringGrant {
+	if m != nil {
+		return m.RecurringGrants
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolDelegations() []PoolDelegation {
+	if m != nil {
+		return m.PoolDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPositions() []Position {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFreezeParams() FreezeParams {
+	if m != nil {
+		return m.FreezeParams
+	}
+	return FreezeParams{}
+}
+
+func (m *GenesisState) GetOutflowFreeze() *OutflowFreeze {
+	if m != nil {
+		return m.OutflowFreeze
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.protocolpool.v1.GenesisState")
 }
@@ -90,26 +183,43 @@ func init() {
 }
 
 var fileDescriptor_72560a99455b4146 = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0xce, 0xcf, 0x29, 0xc8, 0xcf, 0xcf, 0xd1,
-	0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x03, 0x8b, 0x0b, 0x89, 0x41,
-	0x54, 0xe9, 0x21, 0xab, 0xd2, 0x2b, 0x33, 0x94, 0x52, 0xc2, 0xa1, 0xbb, 0xa4, 0xb2, 0x20, 0x15,
-	0xaa, 0x5a, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07, 0xb1, 0xa0, 0xa2, 0x92, 0x10,
-	0x9d, 0xf1, 0x10, 0x09, 0x64, 0xe3, 0x95, 0x5e, 0x32, 0x72, 0xf1, 0xb8, 0x43, 0xac, 0x0f, 0x2e,
-	0x49, 0x2c, 0x49, 0x15, 0xf2, 0xe7, 0xe2, 0x4f, 0xce, 0xcf, 0x2b, 0xc9, 0xcc, 0x2b, 0xcd, 0x2f,
-	0x2d, 0x8e, 0x4f, 0x2b, 0xcd, 0x4b, 0x91, 0x60, 0x54, 0x60, 0xd6, 0xe0, 0x36, 0x52, 0xd3, 0xc3,
-	0xee, 0x2e, 0x3d, 0x67, 0xb8, 0x72, 0xb7, 0xd2, 0xbc, 0x94, 0x20, 0xbe, 0x64, 0x14, 0xbe, 0x90,
-	0x19, 0x17, 0x5b, 0x52, 0x69, 0x4a, 0x7a, 0x6a, 0x89, 0x04, 0x13, 0xd8, 0x1c, 0x39, 0x5c, 0xe6,
-	0x38, 0x81, 0x55, 0x05, 0x41, 0x55, 0x0b, 0x05, 0x70, 0xf1, 0x96, 0xe4, 0xc7, 0xa7, 0x64, 0x16,
-	0x97, 0x14, 0x65, 0x26, 0x95, 0x96, 0xa4, 0x4a, 0x30, 0x2b, 0x30, 0x6a, 0x70, 0x3a, 0x69, 0x9f,
-	0xb8, 0x27, 0xcf, 0x70, 0xeb, 0x9e, 0xbc, 0x28, 0xc4, 0x94, 0xe2, 0x94, 0x6c, 0xbd, 0xcc, 0x7c,
-	0xfd, 0xdc, 0xc4, 0x92, 0x0c, 0x3d, 0xcf, 0xbc, 0x92, 0x4b, 0x5b, 0x74, 0xb9, 0xa0, 0xc6, 0x7b,
-	0xe6, 0x95, 0x04, 0xf1, 0x94, 0xe4, 0xbb, 0xc0, 0x0d, 0x70, 0xb2, 0x3e, 0xf1, 0x48, 0x8e, 0xf1,
-	0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e,
-	0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x45, 0x14, 0xc3, 0x2a, 0x50, 0x83, 0x18, 0x1c, 0xbe, 0x49,
-	0x6c, 0x60, 0x31, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1e, 0x8d, 0xab, 0x75, 0xc4, 0x01,
-	0x00, 0x00,
+	// 572 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x1b, 0x36, 0xc6, 0xe6, 0x36, 0x1d, 0xb2, 0x00, 0x99, 0x1d, 0xb2, 0x32, 0x06, 0x9a,
+	0x84, 0x48, 0xb5, 0x21, 0x71, 0xe1, 0x56, 0xca, 0xa6, 0x89, 0x89, 0x55, 0x19, 0xd2, 0x24, 0x2e,
+	0x51, 0x9a, 0xb8, 0xc1, 0x22, 0xf5, 0x17, 0xe5, 0x73, 0x56, 0xc6, 0x53, 0x70, 0xe7, 0x35, 0x78,
+	0x88, 0x1d, 0x27, 0x4e, 0x88, 0xc3, 0x84, 0xda, 0x17, 0x41, 0x71, 0xd2, 0x2e, 0x95, 0x48, 0x6e,
+	0xf6, 0x3f, 0xbf, 0xef, 0x67, 0xeb, 0xb3, 0x63, 0xb2, 0xeb, 0x03, 0x8e, 0x01, 0xbb, 0x71, 0x02,
+	0x0a, 0x7c, 0x88, 0x62, 0x80, 0xa8, 0x7b, 0xb1, 0xdf, 0x0d, 0xb9, 0xe4, 0x28, 0xd0, 0xd6, 0x39,
+	0x7d, 0x94, 0x53, 0x76, 0x99, 0xb2, 0x2f, 0xf6, 0xb7, 0x76, 0x2a, 0xaa, 0xd5, 0x65, 0xcc, 0x0b,
+	0x7a, 0xeb, 0x41, 0x08, 0x21, 0xe8, 0x61, 0x37, 0x1b, 0x15, 0xe9, 0xe3, 0xbc, 0xd2, 0xcd, 0x3f,
+	0x94, 0xf5, 0x3b, 0x3f, 0xd6, 0x49, 0xeb, 0x28, 0x5f, 0xfe, 0x4c, 0x79, 0x8a, 0xd3, 0x53, 0xb2,
+	0xe9, 0x83, 0x54, 0x42, 0xa6, 0x90, 0xa2, 0x3b, 0x4a, 0x65, 0xc0, 0x8c, 0xce, 0xca, 0x5e, 0xf3,
+	0xe0, 0xb9, 0xfd, 0xff, 0x7d, 0xd9, 0x6f, 0x17, 0xf8, 0x61, 0x2a, 0x03, 0xa7, 0xed, 0x2f, 0xcd,
+	0xe9, 0x6b, 0xb2, 0x36, 0x4c, 0x83, 0x90, 0x2b, 0x76, 0x47, 0x7b, 0xac, 0x2a, 0x4f, 0x4f, 0x53,
+	0x4e, 0x41, 0xd3, 0x01, 0x31, 0x15, 0xb8, 0x81, 0x40, 0x95, 0x88, 0x61, 0xaa, 0x38, 0x5b, 0xe9,
+	0x18, 0x7b, 0x1b, 0xbd, 0x17, 0x57, 0x37, 0xdb, 0x8d, 0x3f, 0x37, 0xdb, 0x0f, 0x73, 0x0b, 0x06,
+	0x5f, 0x6c, 0x01, 0xdd, 0xb1, 0xa7, 0x3e, 0xdb, 0xc7, 0x52, 0xfd, 0xfa, 0xf9, 0x92, 0x14, 0xfa,
+	0x63, 0xa9, 0x9c, 0x96, 0x82, 0xfe, 0x42, 0x40, 0x3f, 0x92, 0x4d, 0x8e, 0x7e, 0x02, 0x13, 0x1e,
+	0xb8, 0x18, 0x73, 0x19, 0x20, 0x5b, 0xd5, 0x5b, 0x7a, 0x56, 0xb5, 0xa5, 0x77, 0x05, 0x7e, 0x96,
+	0xd1, 0xbd, 0xd5, 0x6c, 0x69, 0xa7, 0xcd, 0xcb, 0x21, 0xd2, 0xf7, 0xa4, 0xa5, 0x65, 0x6e, 0x24,
+	0xc6, 0x42, 0x21, 0xbb, 0xab, 0x95, 0x3b, 0x55, 0x4a, 0x5d, 0x75, 0x92, 0xa1, 0x85, 0xaf, 0x89,
+	0x8b, 0x04, 0xe9, 0x07, 0x62, 0xe6, 0xb2, 0x89, 0x90, 0x01, 0x4c, 0x90, 0xad, 0x69, 0xdb, 0xd3,
+	0x5a, 0xdb, 0xb9, 0x66, 0x0b, 0x5d, 0x0b, 0x6f, 0x23, 0xa4, 0x03, 0xd2, 0x0e, 0xb8, 0x84, 0xb1,
+	0x1b, 0x43, 0x24, 0x7c, 0xc1, 0x91, 0xdd, 0xab, 0x17, 0xf6, 0x33, 0x7a, 0x90, 0xc1, 0x97, 0x85,
+	0xd0, 0x0c, 0x16, 0x91, 0xe0, 0x98, 0x35, 0x31, 0xbb, 0x14, 0x42, 0x86, 0x2e, 0x42, 0x9a, 0xf8,
+	0x1c, 0xd9, 0x7a, 0x7d, 0x13, 0x0f, 0x73, 0xfc, 0x4c, 0xd3, 0xf3, 0x26, 0x8e, 0xca, 0x21, 0xd2,
+	0x73, 0x72, 0x3f, 0xe1, 0x7e, 0x9a, 0x24, 0x99, 0x37, 0x4c, 0x3c, 0xa9, 0x90, 0x6d, 0xd4, 0x5f,
+	0x3b, 0x67, 0xce, 0x1f, 0x65, 0x78, 0xe1, 0xdd, 0x4c, 0x96, 0x52, 0x2d, 0xce, 0x0a, 0xdc, 0x80,
+	0x47, 0x3c, 0xf4, 0x94, 0x00, 0x89, 0x8c, 0xd4, 0x8b, 0x07, 0x00, 0x51, 0x7f, 0x81, 0xcf, 0xc5,
+	0xf1, 0x52, 0x8a, 0xb4, 0x4f, 0x36, 0x62, 0x40, 0x91, 0x1b, 0x9b, 0xda, 0xd8, 0xa9, 0x36, 0xa2,
+	0x28, 0xb9, 0x6e, 0x0b, 0xe9, 0x29, 0x31, 0x47, 0x09, 0xe7, 0xdf, 0xb8, 0x1b, 0x7b, 0x89, 0x37,
+	0x46, 0xd6, 0xea, 0x18, 0x7b, 0xcd, 0x83, 0xdd, 0xca, 0x5e, 0x6a, 0x78, 0xa0, 0xd9, 0xf9, 0x81,
+	0x8f, 0x4a, 0x19, 0x3d, 0x21, 0x6d, 0x48, 0xd5, 0x28, 0x82, 0x89, 0x9b, 0xe7, 0xcc, 0xec, 0x18,
+	0x75, 0xa7, 0x73, 0x9a, 0xd3, 0xb9, 0xd8, 0x31, 0xa1, 0x3c, 0xed, 0xbd, 0xb9, 0x9a, 0x5a, 0xc6,
+	0xf5, 0xd4, 0x32, 0xfe, 0x4e, 0x2d, 0xe3, 0xfb, 0xcc, 0x6a, 0x5c, 0xcf, 0xac, 0xc6, 0xef, 0x99,
+	0xd5, 0xf8, 0xf4, 0x64, 0xe9, 0xf7, 0xfb, 0xba, 0xfc, 0x28, 0xe9, 0x17, 0x69, 0xb8, 0xa6, 0xb3,
+	0x57, 0xff, 0x06, 0x00, 0xb7, 0xf7, 0xc8, 0x25, 0xf6, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -132,6 +242,140 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OutflowFreeze != nil {
+		{
+			size, err := m.OutflowFreeze.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	{
+		size, err := m.FreezeParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.PoolDelegations) > 0 {
+		for iNdEx := len(m.PoolDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.RecurringGrants) > 0 {
+		for iNdEx := len(m.RecurringGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecurringGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.FundingSources) > 0 {
+		for iNdEx := len(m.FundingSources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FundingSources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.DenomPolicies) > 0 {
+		for iNdEx := len(m.DenomPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.SpendWindows) > 0 {
+		for iNdEx := len(m.SpendWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SpendLimits) > 0 {
+		for iNdEx := len(m.SpendLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.EscrowedSpends) > 0 {
+		for iNdEx := len(m.EscrowedSpends) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EscrowedSpends[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size := m.ToDistribute.Size()
 		i -= size
@@ -204,6 +448,60 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.ToDistribute.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.EscrowedSpends) > 0 {
+		for _, e := range m.EscrowedSpends {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SpendLimits) > 0 {
+		for _, e := range m.SpendLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SpendWindows) > 0 {
+		for _, e := range m.SpendWindows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomPolicies) > 0 {
+		for _, e := range m.DenomPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FundingSources) > 0 {
+		for _, e := range m.FundingSources {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RecurringGrants) > 0 {
+		for _, e := range m.RecurringGrants {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolDelegations) > 0 {
+		for _, e := range m.PoolDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.FreezeParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.OutflowFreeze != nil {
+		l = m.OutflowFreeze.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -344,6 +642,347 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedSpends", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowedSpends = append(m.EscrowedSpends, EscrowedSpend{})
+			if err := m.EscrowedSpends[len(m.EscrowedSpends)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimits = append(m.SpendLimits, SpendLimit{})
+			if err := m.SpendLimits[len(m.SpendLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendWindows = append(m.SpendWindows, SpendWindow{})
+			if err := m.SpendWindows[len(m.SpendWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomPolicies = append(m.DenomPolicies, DenomPolicy{})
+			if err := m.DenomPolicies[len(m.DenomPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingSources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingSources = append(m.FundingSources, FundingSource{})
+			if err := m.FundingSources[len(m.FundingSources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecurringGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecurringGrants = append(m.RecurringGrants, RecurringGrant{})
+			if err := m.RecurringGrants[len(m.RecurringGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolDelegations = append(m.PoolDelegations, PoolDelegation{})
+			if err := m.PoolDelegations[len(m.PoolDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, Position{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreezeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FreezeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutflowFreeze", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OutflowFreeze == nil {
+				m.OutflowFreeze = &OutflowFreeze{}
+			}
+			if err := m.OutflowFreeze.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -9,9 +9,39 @@ const (
 	// StreamAccount is the name constant used for stream account
 	StreamAccount = "stream_acc"
 
+	// EscrowAccount is the name constant used for the account holding escrowed spends
+	EscrowAccount = "escrow_acc"
+
+	// FundingSourceDistribution is the funding source of the community tax
+	FundingSourceDistribution = "distribution"
+
+	// FundingSourceDirect is the funding source of funds sent by regular accounts
+	FundingSourceDirect = "direct"
+
+	// BalanceChangeSourceSpend is the source of the balance changes of funds paid out of the community pool
+	BalanceChangeSourceSpend = "spend"
+
+	// BalanceChangeSourceStream is the source of the balance changes of funds sent to the stream account
+	BalanceChangeSourceStream = "stream"
+
+	// BalanceChangeSourceEscrow is the source of the balance changes of funds moved to or clawed back from the escrow account
+	BalanceChangeSourceEscrow = "escrow"
+
+	// BalanceChangeSourceDenomPolicy is the source of the balance changes of the denom policies
+	BalanceChangeSourceDenomPolicy = "denom_policy"
+
+	// BalanceChangeSourcePoolDelegation is the source of the balance changes of the community pool delegations
+	BalanceChangeSourcePoolDelegation = "pool_delegation"
+
+	// BalanceChangeSourcePosition is the source of the balance changes of funds deployed into investment positions
+	BalanceChangeSourcePosition = "position"
+
 	// StoreKey is the store key string for protocolpool
 	StoreKey = ModuleName
 
+	// MemStoreKey is the memory store key string for protocolpool
+	MemStoreKey = "mem_" + ModuleName
+
 	// RouterKey is the message route for protocolpool
 	RouterKey = ModuleName
 
@@ -27,4 +57,24 @@ var (
 	RecipientFundPercentageKey   = collections.NewPrefix(4)
 	RecipientFundDistributionKey = collections.NewPrefix(5)
 	ToDistributeKey              = collections.NewPrefix(6)
+	EscrowedSpendKey             = collections.NewPrefix(7)
+	EscrowedSpendSequenceKey     = collections.NewPrefix(8)
+	EscrowDeadlineQueueKey       = collections.NewPrefix(9)
+	SpendLimitKey                = collections.NewPrefix(10)
+	SpendWindowKey               = collections.NewPrefix(11)
+	DenomPolicyKey               = collections.NewPrefix(12)
+	FundingSourceKey             = collections.NewPrefix(13)
+	RecurringGrantKey            = collections.NewPrefix(14)
+	RecurringGrantQueueKey       = collections.NewPrefix(15)
+	RenewalProposalKey           = collections.NewPrefix(16)
+	PoolDelegationKey            = collections.NewPrefix(17)
+
+	// PendingBalanceChangeKey and PendingBalanceChangeSequenceKey are prefixes of the memory store
+	PendingBalanceChangeKey         = collections.NewPrefix(18)
+	PendingBalanceChangeSequenceKey = collections.NewPrefix(19)
+
+	PositionKey         = collections.NewPrefix(20)
+	PositionSequenceKey = collections.NewPrefix(21)
+	FreezeParamsKey     = collections.NewPrefix(22)
+	OutflowFreezeKey    = collections.NewPrefix(23)
 )
@@ -7,6 +7,10 @@ import (
 var (
 	_ sdk.Msg = (*MsgFundCommunityPool)(nil)
 	_ sdk.Msg = (*MsgCommunityPoolSpend)(nil)
+	_ sdk.Msg = (*MsgCommunityPoolEscrowSpend)(nil)
+	_ sdk.Msg = (*MsgApproveMilestone)(nil)
+	_ sdk.Msg = (*MsgSetSpendLimits)(nil)
+	_ sdk.Msg = (*MsgSetDenomPolicies)(nil)
 )
 
 // NewMsgFundCommunityPool returns a new MsgFundCommunityPool with a sender and
@@ -217,37 +217,37 @@ func (m *MsgCommunityPoolSpendResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCommunityPoolSpendResponse proto.InternalMessageInfo
 
-// MsgSubmitBudgetProposal defines budget proposal type.
-type MsgSubmitBudgetProposal struct {
+// MsgCommunityPoolEscrowSpend defines a message for moving tokens from the community
+// pool to an escrow. The funds of each milestone are released to the recipient once
+// it is approved, and the funds of the milestones not approved before the deadline
+// are clawed back to the community pool.
+type MsgCommunityPoolEscrowSpend struct {
 	// Authority is the address that controls the module (defaults to x/gov unless overwritten).
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// RecipientAddress is the address of the recipient who can claim the budget.
-	RecipientAddress string `protobuf:"bytes,2,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
-	// TotalBudget is the total amount allocated for the budget.
-	TotalBudget *types.Coin `protobuf:"bytes,3,opt,name=total_budget,json=totalBudget,proto3" json:"total_budget,omitempty"`
-	// StartTime is the time when the budget becomes claimable.
-	// If StartTime is less than the current block time, proposal will not be accepted.
-	StartTime *time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
-	// Tranches is the number of times the total budget amount is to be distributed.
-	Tranches uint64 `protobuf:"varint,5,opt,name=tranches,proto3" json:"tranches,omitempty"`
-	// Period is the time interval(number of seconds) at which funds distribution should be performed.
-	// For example, if a period is set to 3600, it represents an action that
-	// should occur every hour (3600 seconds).
-	Period *time.Duration `protobuf:"bytes,6,opt,name=period,proto3,stdduration" json:"period,omitempty"`
-}
-
-func (m *MsgSubmitBudgetProposal) Reset()         { *m = MsgSubmitBudgetProposal{} }
-func (m *MsgSubmitBudgetProposal) String() string { return proto.CompactTextString(m) }
-func (*MsgSubmitBudgetProposal) ProtoMessage()    {}
-func (*MsgSubmitBudgetProposal) Descriptor() ([]byte, []int) {
+	// Recipient is the address of the account receiving the released funds.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// Reviewer is the address allowed to approve milestones besides the authority.
+	// If empty, only the authority can approve milestones.
+	Reviewer string `protobuf:"bytes,3,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
+	// Milestones are the deliverables of the escrowed spend.
+	Milestones []Milestone `protobuf:"bytes,4,rep,name=milestones,proto3" json:"milestones"`
+	// Deadline is the time after which the funds of the milestones not yet approved
+	// are clawed back to the community pool.
+	Deadline time.Time `protobuf:"bytes,5,opt,name=deadline,proto3,stdtime" json:"deadline"`
+}
+
+func (m *MsgCommunityPoolEscrowSpend) Reset()         { *m = MsgCommunityPoolEscrowSpend{} }
+func (m *MsgCommunityPoolEscrowSpend) String() string { return proto.CompactTextString(m) }
+func (*MsgCommunityPoolEscrowSpend) ProtoMessage()    {}
+func (*MsgCommunityPoolEscrowSpend) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09efe14517e7f6dc, []int{4}
 }
-func (m *MsgSubmitBudgetProposal) XXX_Unmarshal(b []byte) error {
+func (m *MsgCommunityPoolEscrowSpend) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubmitBudgetProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCommunityPoolEscrowSpend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubmitBudgetProposal.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCommunityPoolEscrowSpend.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -257,77 +257,72 @@ func (m *MsgSubmitBudgetProposal) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgSubmitBudgetProposal) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubmitBudgetProposal.Merge(m, src)
+func (m *MsgCommunityPoolEscrowSpend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommunityPoolEscrowSpend.Merge(m, src)
 }
-func (m *MsgSubmitBudgetProposal) XXX_Size() int {
+func (m *MsgCommunityPoolEscrowSpend) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubmitBudgetProposal) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubmitBudgetProposal.DiscardUnknown(m)
+func (m *MsgCommunityPoolEscrowSpend) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommunityPoolEscrowSpend.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubmitBudgetProposal proto.InternalMessageInfo
+var xxx_messageInfo_MsgCommunityPoolEscrowSpend proto.InternalMessageInfo
 
-func (m *MsgSubmitBudgetProposal) GetAuthority() string {
+func (m *MsgCommunityPoolEscrowSpend) GetAuthority() string {
 	if m != nil {
 		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgSubmitBudgetProposal) GetRecipientAddress() string {
+func (m *MsgCommunityPoolEscrowSpend) GetRecipient() string {
 	if m != nil {
-		return m.RecipientAddress
+		return m.Recipient
 	}
 	return ""
 }
 
-func (m *MsgSubmitBudgetProposal) GetTotalBudget() *types.Coin {
+func (m *MsgCommunityPoolEscrowSpend) GetReviewer() string {
 	if m != nil {
-		return m.TotalBudget
+		return m.Reviewer
 	}
-	return nil
+	return ""
 }
 
-func (m *MsgSubmitBudgetProposal) GetStartTime() *time.Time {
+func (m *MsgCommunityPoolEscrowSpend) GetMilestones() []Milestone {
 	if m != nil {
-		return m.StartTime
+		return m.Milestones
 	}
 	return nil
 }
 
-func (m *MsgSubmitBudgetProposal) GetTranches() uint64 {
-	if m != nil {
-		return m.Tranches
-	}
-	return 0
-}
-
-func (m *MsgSubmitBudgetProposal) GetPeriod() *time.Duration {
+func (m *MsgCommunityPoolEscrowSpend) GetDeadline() time.Time {
 	if m != nil {
-		return m.Period
+		return m.Deadline
 	}
-	return nil
+	return time.Time{}
 }
 
-// MsgSubmitBudgetProposalResponse defines the response to executing a
-// MsgSubmitBudgetProposal message.
-type MsgSubmitBudgetProposalResponse struct {
+// MsgCommunityPoolEscrowSpendResponse defines the response to executing a
+// MsgCommunityPoolEscrowSpend message.
+type MsgCommunityPoolEscrowSpendResponse struct {
+	// id is the identifier of the created escrowed spend.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgSubmitBudgetProposalResponse) Reset()         { *m = MsgSubmitBudgetProposalResponse{} }
-func (m *MsgSubmitBudgetProposalResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSubmitBudgetProposalResponse) ProtoMessage()    {}
-func (*MsgSubmitBudgetProposalResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCommunityPoolEscrowSpendResponse) Reset()         { *m = MsgCommunityPoolEscrowSpendResponse{} }
+func (m *MsgCommunityPoolEscrowSpendResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCommunityPoolEscrowSpendResponse) ProtoMessage()    {}
+func (*MsgCommunityPoolEscrowSpendResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09efe14517e7f6dc, []int{5}
 }
-func (m *MsgSubmitBudgetProposalResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCommunityPoolEscrowSpendResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubmitBudgetProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCommunityPoolEscrowSpendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubmitBudgetProposalResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCommunityPoolEscrowSpendResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -337,35 +332,47 @@ func (m *MsgSubmitBudgetProposalResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *MsgSubmitBudgetProposalResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubmitBudgetProposalResponse.Merge(m, src)
+func (m *MsgCommunityPoolEscrowSpendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommunityPoolEscrowSpendResponse.Merge(m, src)
 }
-func (m *MsgSubmitBudgetProposalResponse) XXX_Size() int {
+func (m *MsgCommunityPoolEscrowSpendResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubmitBudgetProposalResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubmitBudgetProposalResponse.DiscardUnknown(m)
+func (m *MsgCommunityPoolEscrowSpendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommunityPoolEscrowSpendResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubmitBudgetProposalResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCommunityPoolEscrowSpendResponse proto.InternalMessageInfo
 
-// MsgClaimBudget defines a message for claiming the distributed budget.
-type MsgClaimBudget struct {
-	RecipientAddress string `protobuf:"bytes,1,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+func (m *MsgCommunityPoolEscrowSpendResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *MsgClaimBudget) Reset()         { *m = MsgClaimBudget{} }
-func (m *MsgClaimBudget) String() string { return proto.CompactTextString(m) }
-func (*MsgClaimBudget) ProtoMessage()    {}
-func (*MsgClaimBudget) Descriptor() ([]byte, []int) {
+// MsgApproveMilestone defines a message for approving a milestone of an escrowed spend.
+type MsgApproveMilestone struct {
+	// Approver is the address of the reviewer of the escrowed spend or the authority.
+	Approver string `protobuf:"bytes,1,opt,name=approver,proto3" json:"approver,omitempty"`
+	// EscrowId is the identifier of the escrowed spend.
+	EscrowId uint64 `protobuf:"varint,2,opt,name=escrow_id,json=escrowId,proto3" json:"escrow_id,omitempty"`
+	// MilestoneIndex is the index of the approved milestone.
+	MilestoneIndex uint64 `protobuf:"varint,3,opt,name=milestone_index,json=milestoneIndex,proto3" json:"milestone_index,omitempty"`
+}
+
+func (m *MsgApproveMilestone) Reset()         { *m = MsgApproveMilestone{} }
+func (m *MsgApproveMilestone) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveMilestone) ProtoMessage()    {}
+func (*MsgApproveMilestone) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09efe14517e7f6dc, []int{6}
 }
-func (m *MsgClaimBudget) XXX_Unmarshal(b []byte) error {
+func (m *MsgApproveMilestone) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgClaimBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgApproveMilestone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgClaimBudget.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgApproveMilestone.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -375,43 +382,58 @@ func (m *MsgClaimBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *MsgClaimBudget) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgClaimBudget.Merge(m, src)
+func (m *MsgApproveMilestone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveMilestone.Merge(m, src)
 }
-func (m *MsgClaimBudget) XXX_Size() int {
+func (m *MsgApproveMilestone) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgClaimBudget) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgClaimBudget.DiscardUnknown(m)
+func (m *MsgApproveMilestone) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveMilestone.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgClaimBudget proto.InternalMessageInfo
+var xxx_messageInfo_MsgApproveMilestone proto.InternalMessageInfo
 
-func (m *MsgClaimBudget) GetRecipientAddress() string {
+func (m *MsgApproveMilestone) GetApprover() string {
 	if m != nil {
-		return m.RecipientAddress
+		return m.Approver
 	}
 	return ""
 }
 
-// MsgClaimBudgetResponse defines the response to executing a
-// MsgClaimBudget message.
-type MsgClaimBudgetResponse struct {
-	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+func (m *MsgApproveMilestone) GetEscrowId() uint64 {
+	if m != nil {
+		return m.EscrowId
+	}
+	return 0
 }
 
-func (m *MsgClaimBudgetResponse) Reset()         { *m = MsgClaimBudgetResponse{} }
-func (m *MsgClaimBudgetResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgClaimBudgetResponse) ProtoMessage()    {}
-func (*MsgClaimBudgetResponse) Descriptor() ([]byte, []int) {
+func (m *MsgApproveMilestone) GetMilestoneIndex() uint64 {
+	if m != nil {
+		return m.MilestoneIndex
+	}
+	return 0
+}
+
+// MsgApproveMilestoneResponse defines the response to executing a
+// MsgApproveMilestone message.
+type MsgApproveMilestoneResponse struct {
+	// amount is the amount released to the recipient.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgApproveMilestoneResponse) Reset()         { *m = MsgApproveMilestoneResponse{} }
+func (m *MsgApproveMilestoneResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveMilestoneResponse) ProtoMessage()    {}
+func (*MsgApproveMilestoneResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09efe14517e7f6dc, []int{7}
 }
-func (m *MsgClaimBudgetResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgApproveMilestoneResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgClaimBudgetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgApproveMilestoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgClaimBudgetResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgApproveMilestoneResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -421,49 +443,47 @@ func (m *MsgClaimBudgetResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgClaimBudgetResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgClaimBudgetResponse.Merge(m, src)
+func (m *MsgApproveMilestoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveMilestoneResponse.Merge(m, src)
 }
-func (m *MsgClaimBudgetResponse) XXX_Size() int {
+func (m *MsgApproveMilestoneResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgClaimBudgetResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgClaimBudgetResponse.DiscardUnknown(m)
+func (m *MsgApproveMilestoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveMilestoneResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgClaimBudgetResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgApproveMilestoneResponse proto.InternalMessageInfo
 
-func (m *MsgClaimBudgetResponse) GetAmount() types.Coin {
+func (m *MsgApproveMilestoneResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.Amount
 	}
-	return types.Coin{}
+	return nil
 }
 
-// MsgCreateContinuousFund defines a message for adding continuous funds.
-type MsgCreateContinuousFund struct {
+// MsgSetSpendLimits defines a message for setting the per-denom limits of the
+// community pool spends.
+type MsgSetSpendLimits struct {
 	// Authority is the address that controls the module (defaults to x/gov unless overwritten).
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// Recipient address of the account receiving funds.
-	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
-	// Percentage is the percentage of funds to be allocated from Community pool.
-	Percentage cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=percentage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"percentage"`
-	// Optional, if expiry is set, removes the state object when expired.
-	Expiry *time.Time `protobuf:"bytes,4,opt,name=expiry,proto3,stdtime" json:"expiry,omitempty"`
+	// SpendLimits are the per-denom spend limits, replacing the existing ones.
+	// Denoms without a spend limit can be spent without limit.
+	SpendLimits []SpendLimit `protobuf:"bytes,2,rep,name=spend_limits,json=spendLimits,proto3" json:"spend_limits"`
 }
 
-func (m *MsgCreateContinuousFund) Reset()         { *m = MsgCreateContinuousFund{} }
-func (m *MsgCreateContinuousFund) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateContinuousFund) ProtoMessage()    {}
-func (*MsgCreateContinuousFund) Descriptor() ([]byte, []int) {
+func (m *MsgSetSpendLimits) Reset()         { *m = MsgSetSpendLimits{} }
+func (m *MsgSetSpendLimits) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSpendLimits) ProtoMessage()    {}
+func (*MsgSetSpendLimits) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09efe14517e7f6dc, []int{8}
 }
-func (m *MsgCreateContinuousFund) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetSpendLimits) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateContinuousFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetSpendLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateContinuousFund.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetSpendLimits.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -473,56 +493,49 @@ func (m *MsgCreateContinuousFund) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateContinuousFund) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateContinuousFund.Merge(m, src)
+func (m *MsgSetSpendLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSpendLimits.Merge(m, src)
 }
-func (m *MsgCreateContinuousFund) XXX_Size() int {
+func (m *MsgSetSpendLimits) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateContinuousFund) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateContinuousFund.DiscardUnknown(m)
+func (m *MsgSetSpendLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSpendLimits.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateContinuousFund proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetSpendLimits proto.InternalMessageInfo
 
-func (m *MsgCreateContinuousFund) GetAuthority() string {
+func (m *MsgSetSpendLimits) GetAuthority() string {
 	if m != nil {
 		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgCreateContinuousFund) GetRecipient() string {
-	if m != nil {
-		return m.Recipient
-	}
-	return ""
-}
-
-func (m *MsgCreateContinuousFund) GetExpiry() *time.Time {
+func (m *MsgSetSpendLimits) GetSpendLimits() []SpendLimit {
 	if m != nil {
-		return m.Expiry
+		return m.SpendLimits
 	}
 	return nil
 }
 
-// MsgCreateContinuousFundResponse defines the response to executing a
-// MsgCreateContinuousFund message.
-type MsgCreateContinuousFundResponse struct {
+// MsgSetSpendLimitsResponse defines the response to executing a
+// MsgSetSpendLimits message.
+type MsgSetSpendLimitsResponse struct {
 }
 
-func (m *MsgCreateContinuousFundResponse) Reset()         { *m = MsgCreateContinuousFundResponse{} }
-func (m *MsgCreateContinuousFundResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateContinuousFundResponse) ProtoMessage()    {}
-func (*MsgCreateContinuousFundResponse) Descriptor() ([]byte, []int) {
+func (m *MsgSetSpendLimitsResponse) Reset()         { *m = MsgSetSpendLimitsResponse{} }
+func (m *MsgSetSpendLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSpendLimitsResponse) ProtoMessage()    {}
+func (*MsgSetSpendLimitsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09efe14517e7f6dc, []int{9}
 }
-func (m *MsgCreateContinuousFundResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetSpendLimitsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateContinuousFundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetSpendLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateContinuousFundResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetSpendLimitsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -532,38 +545,39 @@ func (m *MsgCreateContinuousFundResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateContinuousFundResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateContinuousFundResponse.Merge(m, src)
+func (m *MsgSetSpendLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSpendLimitsResponse.Merge(m, src)
 }
-func (m *MsgCreateContinuousFundResponse) XXX_Size() int {
+func (m *MsgSetSpendLimitsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateContinuousFundResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateContinuousFundResponse.DiscardUnknown(m)
+func (m *MsgSetSpendLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSpendLimitsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateContinuousFundResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetSpendLimitsResponse proto.InternalMessageInfo
 
-// MsgCancelContinuousFund defines a message to cancel continuous funds for a specific recipient.
-type MsgCancelContinuousFund struct {
-	// Authority is the account address of authority.
+// MsgSetDenomPolicies defines a message for setting the policies applied to the
+// community pool holdings of unwanted denoms.
+type MsgSetDenomPolicies struct {
+	// Authority is the address that controls the module (defaults to x/gov unless overwritten).
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// RecipientAddress is the account address of recipient whose funds are to be cancelled.
-	RecipientAddress string `protobuf:"bytes,2,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+	// DenomPolicies are the denom policies, replacing the existing ones.
+	DenomPolicies []DenomPolicy `protobuf:"bytes,2,rep,name=denom_policies,json=denomPolicies,proto3" json:"denom_policies"`
 }
 
-func (m *MsgCancelContinuousFund) Reset()         { *m = MsgCancelContinuousFund{} }
-func (m *MsgCancelContinuousFund) String() string { return proto.CompactTextString(m) }
-func (*MsgCancelContinuousFund) ProtoMessage()    {}
-func (*MsgCancelContinuousFund) Descriptor() ([]byte, []int) {
+func (m *MsgSetDenomPolicies) Reset()         { *m = MsgSetDenomPolicies{} }
+func (m *MsgSetDenomPolicies) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomPolicies) ProtoMessage()    {}
+func (*MsgSetDenomPolicies) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09efe14517e7f6dc, []int{10}
 }
-func (m *MsgCancelContinuousFund) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetDenomPolicies) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCancelContinuousFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetDenomPolicies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCancelContinuousFund.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetDenomPolicies.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -573,59 +587,49 @@ func (m *MsgCancelContinuousFund) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgCancelContinuousFund) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCancelContinuousFund.Merge(m, src)
+func (m *MsgSetDenomPolicies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomPolicies.Merge(m, src)
 }
-func (m *MsgCancelContinuousFund) XXX_Size() int {
+func (m *MsgSetDenomPolicies) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCancelContinuousFund) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCancelContinuousFund.DiscardUnknown(m)
+func (m *MsgSetDenomPolicies) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomPolicies.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCancelContinuousFund proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetDenomPolicies proto.InternalMessageInfo
 
-func (m *MsgCancelContinuousFund) GetAuthority() string {
+func (m *MsgSetDenomPolicies) GetAuthority() string {
 	if m != nil {
 		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgCancelContinuousFund) GetRecipientAddress() string {
+func (m *MsgSetDenomPolicies) GetDenomPolicies() []DenomPolicy {
 	if m != nil {
-		return m.RecipientAddress
+		return m.DenomPolicies
 	}
-	return ""
+	return nil
 }
 
-// MsgCancelContinuousFundResponse defines the response to executing a
-// MsgCancelContinuousFund message.
-type MsgCancelContinuousFundResponse struct {
-	// CanceledTime is the canceled time.
-	CanceledTime time.Time `protobuf:"bytes,1,opt,name=canceled_time,json=canceledTime,proto3,stdtime" json:"canceled_time"`
-	// CanceledHeight defines the canceled block height.
-	CanceledHeight uint64 `protobuf:"varint,2,opt,name=canceled_height,json=canceledHeight,proto3" json:"canceled_height,omitempty"`
-	// RecipientAddress is the account address of recipient whose funds are cancelled.
-	RecipientAddress string `protobuf:"bytes,3,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
-	// withdrawnAllocatedFund represents the fund allocated to this recipient (if any) that have not been withdrawn yet,
-	// before a cancellation request has been initiated.
-	// It involves first withdrawing the funds and then canceling the request.
-	WithdrawnAllocatedFund types.Coin `protobuf:"bytes,4,opt,name=withdrawn_allocated_fund,json=withdrawnAllocatedFund,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdrawn_allocated_fund"`
+// MsgSetDenomPoliciesResponse defines the response to executing a
+// MsgSetDenomPolicies message.
+type MsgSetDenomPoliciesResponse struct {
 }
 
-func (m *MsgCancelContinuousFundResponse) Reset()         { *m = MsgCancelContinuousFundResponse{} }
-func (m *MsgCancelContinuousFundResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCancelContinuousFundResponse) ProtoMessage()    {}
-func (*MsgCancelContinuousFundResponse) Descriptor() ([]byte, []int) {
+func (m *MsgSetDenomPoliciesResponse) Reset()         { *m = MsgSetDenomPoliciesResponse{} }
+func (m *MsgSetDenomPoliciesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomPoliciesResponse) ProtoMessage()    {}
+func (*MsgSetDenomPoliciesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_09efe14517e7f6dc, []int{11}
 }
-func (m *MsgCancelContinuousFundResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetDenomPoliciesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCancelContinuousFundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetDenomPoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCancelContinuousFundResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetDenomPoliciesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -635,63 +639,130 @@ func (m *MsgCancelContinuousFundResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *MsgCancelContinuousFundResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCancelContinuousFundResponse.Merge(m, src)
+func (m *MsgSetDenomPoliciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomPoliciesResponse.Merge(m, src)
 }
-func (m *MsgCancelContinuousFundResponse) XXX_Size() int {
+func (m *MsgSetDenomPoliciesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCancelContinuousFundResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCancelContinuousFundResponse.DiscardUnknown(m)
+func (m *MsgSetDenomPoliciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomPoliciesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCancelContinuousFundResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetDenomPoliciesResponse proto.InternalMessageInfo
 
-func (m *MsgCancelContinuousFundResponse) GetCanceledTime() time.Time {
-	if m != nil {
-		return m.CanceledTime
+// MsgCreateRecurringGrant defines a message for paying a fixed amount from the
+// community pool to a recipient every period. A renewal proposal to continue the
+// grant is submitted every renewal interval, and the grant is cancelled if the
+// proposal is not passed.
+type MsgCreateRecurringGrant struct {
+	// Authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Recipient is the address of the account receiving the payments.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// Amount is the amount paid to the recipient every period.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// Period is the duration between two payments.
+	Period time.Duration `protobuf:"bytes,4,opt,name=period,proto3,stdduration" json:"period"`
+	// RenewalInterval is the duration between two renewal proposals.
+	RenewalInterval time.Duration `protobuf:"bytes,5,opt,name=renewal_interval,json=renewalInterval,proto3,stdduration" json:"renewal_interval"`
+	// RenewalDeposit is the initial deposit of the renewal proposals, paid from the
+	// community pool.
+	RenewalDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=renewal_deposit,json=renewalDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"renewal_deposit"`
+}
+
+func (m *MsgCreateRecurringGrant) Reset()         { *m = MsgCreateRecurringGrant{} }
+func (m *MsgCreateRecurringGrant) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateRecurringGrant) ProtoMessage()    {}
+func (*MsgCreateRecurringGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{12}
+}
+func (m *MsgCreateRecurringGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateRecurringGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateRecurringGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return time.Time{}
+}
+func (m *MsgCreateRecurringGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateRecurringGrant.Merge(m, src)
+}
+func (m *MsgCreateRecurringGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateRecurringGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateRecurringGrant.DiscardUnknown(m)
 }
 
-func (m *MsgCancelContinuousFundResponse) GetCanceledHeight() uint64 {
+var xxx_messageInfo_MsgCreateRecurringGrant proto.InternalMessageInfo
+
+func (m *MsgCreateRecurringGrant) GetAuthority() string {
 	if m != nil {
-		return m.CanceledHeight
+		return m.Authority
 	}
-	return 0
+	return ""
 }
 
-func (m *MsgCancelContinuousFundResponse) GetRecipientAddress() string {
+func (m *MsgCreateRecurringGrant) GetRecipient() string {
 	if m != nil {
-		return m.RecipientAddress
+		return m.Recipient
 	}
 	return ""
 }
 
-func (m *MsgCancelContinuousFundResponse) GetWithdrawnAllocatedFund() types.Coin {
+func (m *MsgCreateRecurringGrant) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.WithdrawnAllocatedFund
+		return m.Amount
 	}
-	return types.Coin{}
+	return nil
 }
 
-// MsgWithdrawContinuousFund defines a message for withdrawing the continuous fund allocated to it.
-type MsgWithdrawContinuousFund struct {
-	RecipientAddress string `protobuf:"bytes,1,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+func (m *MsgCreateRecurringGrant) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
 }
 
-func (m *MsgWithdrawContinuousFund) Reset()         { *m = MsgWithdrawContinuousFund{} }
-func (m *MsgWithdrawContinuousFund) String() string { return proto.CompactTextString(m) }
-func (*MsgWithdrawContinuousFund) ProtoMessage()    {}
-func (*MsgWithdrawContinuousFund) Descriptor() ([]byte, []int) {
-	return fileDescriptor_09efe14517e7f6dc, []int{12}
+func (m *MsgCreateRecurringGrant) GetRenewalInterval() time.Duration {
+	if m != nil {
+		return m.RenewalInterval
+	}
+	return 0
 }
-func (m *MsgWithdrawContinuousFund) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgCreateRecurringGrant) GetRenewalDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RenewalDeposit
+	}
+	return nil
+}
+
+// MsgCreateRecurringGrantResponse defines the response to executing a
+// MsgCreateRecurringGrant message.
+type MsgCreateRecurringGrantResponse struct {
+}
+
+func (m *MsgCreateRecurringGrantResponse) Reset()         { *m = MsgCreateRecurringGrantResponse{} }
+func (m *MsgCreateRecurringGrantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateRecurringGrantResponse) ProtoMessage()    {}
+func (*MsgCreateRecurringGrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{13}
+}
+func (m *MsgCreateRecurringGrantResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWithdrawContinuousFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateRecurringGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWithdrawContinuousFund.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateRecurringGrantResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -701,43 +772,39 @@ func (m *MsgWithdrawContinuousFund) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgWithdrawContinuousFund) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWithdrawContinuousFund.Merge(m, src)
+func (m *MsgCreateRecurringGrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateRecurringGrantResponse.Merge(m, src)
 }
-func (m *MsgWithdrawContinuousFund) XXX_Size() int {
+func (m *MsgCreateRecurringGrantResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWithdrawContinuousFund) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWithdrawContinuousFund.DiscardUnknown(m)
+func (m *MsgCreateRecurringGrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateRecurringGrantResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWithdrawContinuousFund proto.InternalMessageInfo
-
-func (m *MsgWithdrawContinuousFund) GetRecipientAddress() string {
-	if m != nil {
-		return m.RecipientAddress
-	}
-	return ""
-}
+var xxx_messageInfo_MsgCreateRecurringGrantResponse proto.InternalMessageInfo
 
-// MsgWithdrawContinuousFundResponse defines the response to executing a
-// MsgWithdrawContinuousFund message.
-type MsgWithdrawContinuousFundResponse struct {
-	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+// MsgRenewRecurringGrant defines a message for continuing a recurring grant until
+// its next renewal.
+type MsgRenewRecurringGrant struct {
+	// Authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Recipient is the address of the recipient of the recurring grant.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
 }
 
-func (m *MsgWithdrawContinuousFundResponse) Reset()         { *m = MsgWithdrawContinuousFundResponse{} }
-func (m *MsgWithdrawContinuousFundResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgWithdrawContinuousFundResponse) ProtoMessage()    {}
-func (*MsgWithdrawContinuousFundResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_09efe14517e7f6dc, []int{13}
+func (m *MsgRenewRecurringGrant) Reset()         { *m = MsgRenewRecurringGrant{} }
+func (m *MsgRenewRecurringGrant) String() string { return proto.CompactTextString(m) }
+func (*MsgRenewRecurringGrant) ProtoMessage()    {}
+func (*MsgRenewRecurringGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{14}
 }
-func (m *MsgWithdrawContinuousFundResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRenewRecurringGrant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWithdrawContinuousFundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRenewRecurringGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWithdrawContinuousFundResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRenewRecurringGrant.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -747,1199 +814,8148 @@ func (m *MsgWithdrawContinuousFundResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgWithdrawContinuousFundResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWithdrawContinuousFundResponse.Merge(m, src)
+func (m *MsgRenewRecurringGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenewRecurringGrant.Merge(m, src)
 }
-func (m *MsgWithdrawContinuousFundResponse) XXX_Size() int {
+func (m *MsgRenewRecurringGrant) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWithdrawContinuousFundResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWithdrawContinuousFundResponse.DiscardUnknown(m)
+func (m *MsgRenewRecurringGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenewRecurringGrant.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWithdrawContinuousFundResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRenewRecurringGrant proto.InternalMessageInfo
 
-func (m *MsgWithdrawContinuousFundResponse) GetAmount() types.Coin {
+func (m *MsgRenewRecurringGrant) GetAuthority() string {
 	if m != nil {
-		return m.Amount
+		return m.Authority
 	}
-	return types.Coin{}
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*MsgFundCommunityPool)(nil), "cosmos.protocolpool.v1.MsgFundCommunityPool")
-	proto.RegisterType((*MsgFundCommunityPoolResponse)(nil), "cosmos.protocolpool.v1.MsgFundCommunityPoolResponse")
-	proto.RegisterType((*MsgCommunityPoolSpend)(nil), "cosmos.protocolpool.v1.MsgCommunityPoolSpend")
-	proto.RegisterType((*MsgCommunityPoolSpendResponse)(nil), "cosmos.protocolpool.v1.MsgCommunityPoolSpendResponse")
-	proto.RegisterType((*MsgSubmitBudgetProposal)(nil), "cosmos.protocolpool.v1.MsgSubmitBudgetProposal")
-	proto.RegisterType((*MsgSubmitBudgetProposalResponse)(nil), "cosmos.protocolpool.v1.MsgSubmitBudgetProposalResponse")
-	proto.RegisterType((*MsgClaimBudget)(nil), "cosmos.protocolpool.v1.MsgClaimBudget")
-	proto.RegisterType((*MsgClaimBudgetResponse)(nil), "cosmos.protocolpool.v1.MsgClaimBudgetResponse")
-	proto.RegisterType((*MsgCreateContinuousFund)(nil), "cosmos.protocolpool.v1.MsgCreateContinuousFund")
-	proto.RegisterType((*MsgCreateContinuousFundResponse)(nil), "cosmos.protocolpool.v1.MsgCreateContinuousFundResponse")
-	proto.RegisterType((*MsgCancelContinuousFund)(nil), "cosmos.protocolpool.v1.MsgCancelContinuousFund")
-	proto.RegisterType((*MsgCancelContinuousFundResponse)(nil), "cosmos.protocolpool.v1.MsgCancelContinuousFundResponse")
-	proto.RegisterType((*MsgWithdrawContinuousFund)(nil), "cosmos.protocolpool.v1.MsgWithdrawContinuousFund")
-	proto.RegisterType((*MsgWithdrawContinuousFundResponse)(nil), "cosmos.protocolpool.v1.MsgWithdrawContinuousFundResponse")
+func (m *MsgRenewRecurringGrant) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("cosmos/protocolpool/v1/tx.proto", fileDescriptor_09efe14517e7f6dc) }
-
-var fileDescriptor_09efe14517e7f6dc = []byte{
-	// 1001 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xc4, 0xae, 0x55, 0x4f, 0x4a, 0xa0, 0xab, 0xe0, 0x6e, 0x96, 0xe2, 0x4d, 0x7c, 0x80,
-	0xa8, 0x22, 0xbb, 0xb8, 0x40, 0x0b, 0x01, 0x09, 0xd5, 0x09, 0x08, 0x24, 0x2c, 0x95, 0x0d, 0x12,
-	0x12, 0x17, 0x6b, 0xbc, 0x3b, 0x1d, 0x8f, 0xba, 0xbb, 0xb3, 0xda, 0x99, 0x4d, 0xe2, 0x4a, 0x48,
-	0x15, 0x12, 0xa8, 0xc7, 0x1e, 0x39, 0xf6, 0x84, 0x10, 0xa7, 0x1e, 0xfa, 0x47, 0x54, 0xe2, 0x52,
-	0xf5, 0x84, 0x38, 0xb4, 0x28, 0x41, 0x2a, 0x7f, 0x03, 0xe2, 0x80, 0x76, 0x77, 0xbc, 0xb6, 0xe3,
-	0xf5, 0xa6, 0xae, 0x92, 0x9e, 0x6c, 0xbf, 0x79, 0x3f, 0xbe, 0xef, 0x9b, 0xf7, 0x66, 0xc6, 0x50,
-	0xb7, 0x19, 0xf7, 0x18, 0x37, 0x83, 0x90, 0x09, 0x66, 0x33, 0x37, 0x60, 0xcc, 0x35, 0x77, 0x5b,
-	0xa6, 0xd8, 0x37, 0x12, 0x93, 0x52, 0x4f, 0x1d, 0x8c, 0x71, 0x07, 0x63, 0xb7, 0xa5, 0x2d, 0x13,
-	0x46, 0x58, 0x62, 0x34, 0xe3, 0x6f, 0xe9, 0xba, 0xd6, 0x90, 0xe9, 0x7a, 0x88, 0x63, 0x73, 0xb7,
-	0xd5, 0xc3, 0x02, 0xb5, 0x4c, 0x9b, 0x51, 0x5f, 0xae, 0xaf, 0xa4, 0xeb, 0xdd, 0x34, 0x70, 0x3c,
-	0xb5, 0x76, 0x41, 0x86, 0x7a, 0x9c, 0xc4, 0x00, 0x3c, 0x4e, 0xe4, 0x82, 0x4e, 0x18, 0x23, 0x2e,
-	0x4e, 0x21, 0xf6, 0xa2, 0x1b, 0xa6, 0xa0, 0x1e, 0xe6, 0x02, 0x79, 0xc1, 0xb0, 0xe8, 0x51, 0x07,
-	0x27, 0x0a, 0x91, 0xa0, 0x4c, 0x16, 0x6d, 0xfe, 0x0e, 0xe0, 0x72, 0x87, 0x93, 0xcf, 0x23, 0xdf,
-	0xd9, 0x62, 0x9e, 0x17, 0xf9, 0x54, 0x0c, 0xae, 0x33, 0xe6, 0x2a, 0x36, 0xac, 0x22, 0x8f, 0x45,
-	0xbe, 0x50, 0xc1, 0x6a, 0x79, 0x7d, 0xf1, 0xf2, 0x8a, 0x21, 0x11, 0xc5, 0xf0, 0x0d, 0x09, 0xdf,
-	0xd8, 0x62, 0xd4, 0x6f, 0xbf, 0xfb, 0xf0, 0x89, 0x5e, 0xfa, 0xed, 0xa9, 0xbe, 0x4e, 0xa8, 0xe8,
-	0x47, 0x3d, 0xc3, 0x66, 0x9e, 0x84, 0x2f, 0x3f, 0x36, 0xb8, 0x73, 0xd3, 0x14, 0x83, 0x00, 0xf3,
-	0x24, 0x80, 0x5b, 0x32, 0xb5, 0x72, 0x05, 0xd6, 0x1c, 0x1c, 0x30, 0x4e, 0x05, 0x0b, 0xd5, 0x85,
-	0x55, 0xb0, 0x5e, 0x6b, 0xab, 0x8f, 0x1f, 0x6c, 0x2c, 0xcb, 0x52, 0xd7, 0x1c, 0x27, 0xc4, 0x9c,
-	0xef, 0x88, 0x90, 0xfa, 0xc4, 0x1a, 0xb9, 0x6e, 0xd6, 0xef, 0xdc, 0xd3, 0x4b, 0xff, 0xdc, 0xd3,
-	0x4b, 0x3f, 0x3c, 0xbb, 0x7f, 0x69, 0x64, 0x6f, 0x36, 0xe0, 0xc5, 0x3c, 0x32, 0x16, 0xe6, 0x01,
-	0xf3, 0x39, 0x6e, 0x1e, 0x00, 0xf8, 0x7a, 0x87, 0x93, 0x89, 0xc5, 0x9d, 0x00, 0xfb, 0x4e, 0x8c,
-	0x04, 0x45, 0xa2, 0xcf, 0x42, 0x2a, 0x06, 0x2a, 0x38, 0x0e, 0x49, 0xe6, 0xaa, 0x5c, 0x84, 0xb5,
-	0x10, 0xdb, 0x34, 0xa0, 0xd8, 0x17, 0x29, 0x03, 0x6b, 0x64, 0x18, 0x13, 0xb1, 0x7c, 0x6a, 0x22,
-	0x6e, 0x2e, 0x25, 0x22, 0x64, 0x90, 0x9a, 0x3a, 0x7c, 0x33, 0x97, 0x63, 0xa6, 0xc2, 0x7f, 0x0b,
-	0xf0, 0x42, 0x87, 0x93, 0x9d, 0xa8, 0xe7, 0x51, 0xd1, 0x8e, 0x1c, 0x82, 0xc5, 0xf5, 0x90, 0x05,
-	0x8c, 0x23, 0xf7, 0x85, 0x75, 0xf8, 0x0c, 0x9e, 0xcf, 0x68, 0x77, 0x51, 0xea, 0x75, 0xec, 0x8e,
-	0xbe, 0x96, 0x85, 0x48, 0xbb, 0xf2, 0x09, 0x3c, 0x27, 0x98, 0x40, 0x6e, 0xb7, 0x97, 0xc0, 0x52,
-	0xcb, 0xab, 0xa0, 0x50, 0x36, 0x6b, 0x31, 0x71, 0x4f, 0x49, 0x28, 0x9f, 0x42, 0xc8, 0x05, 0x0a,
-	0x45, 0x37, 0x9e, 0x02, 0xb5, 0x92, 0xc4, 0x6a, 0x46, 0x3a, 0x01, 0xc6, 0x70, 0x02, 0x8c, 0x6f,
-	0x86, 0x23, 0xd2, 0xae, 0xdc, 0x7d, 0xaa, 0x03, 0xab, 0x96, 0xc4, 0xc4, 0x56, 0x45, 0x83, 0x67,
-	0x45, 0x88, 0x7c, 0xbb, 0x8f, 0xb9, 0x7a, 0x66, 0x15, 0xac, 0x57, 0xac, 0xec, 0xb7, 0x72, 0x15,
-	0x56, 0x03, 0x1c, 0x52, 0xe6, 0xa8, 0x55, 0x09, 0xea, 0x68, 0xe2, 0x6d, 0x39, 0x5a, 0xed, 0xca,
-	0xcf, 0x71, 0x5e, 0xe9, 0x3e, 0xb5, 0x3f, 0x6b, 0x50, 0x9f, 0xa1, 0x7e, 0xb6, 0x43, 0x0c, 0x2e,
-	0xc5, 0x5b, 0xe8, 0x22, 0xea, 0x49, 0x6a, 0xb9, 0xfa, 0x82, 0x79, 0xf5, 0xdd, 0xac, 0xc7, 0x58,
-	0xa6, 0x33, 0x35, 0xbf, 0x87, 0xf5, 0xc9, 0x82, 0x43, 0x28, 0x13, 0xe7, 0x00, 0x38, 0xa5, 0x16,
-	0x6e, 0xfe, 0x92, 0x76, 0xe4, 0x56, 0x88, 0x91, 0xc0, 0x5b, 0xcc, 0x17, 0xd4, 0x8f, 0x58, 0xc4,
-	0xe3, 0x41, 0x7e, 0xe1, 0x8e, 0xbc, 0x32, 0x35, 0x99, 0x45, 0x71, 0xa3, 0x99, 0xfd, 0x1a, 0xc2,
-	0x00, 0x87, 0x36, 0xf6, 0x05, 0x22, 0x38, 0x69, 0xc0, 0x5a, 0xbb, 0x15, 0x33, 0xfb, 0xf3, 0x89,
-	0xfe, 0x46, 0x1a, 0xcc, 0x9d, 0x9b, 0x06, 0x65, 0xa6, 0x87, 0x44, 0xdf, 0xf8, 0x0a, 0x13, 0x64,
-	0x0f, 0xb6, 0xb1, 0xfd, 0xf8, 0xc1, 0x06, 0x94, 0xb9, 0xb7, 0xb1, 0x6d, 0x8d, 0x25, 0x51, 0x3e,
-	0x84, 0x55, 0xbc, 0x1f, 0xd0, 0x70, 0xf0, 0xdc, 0x3d, 0x29, 0xfd, 0x67, 0xf4, 0x4e, 0x9e, 0x4e,
-	0x59, 0xef, 0xfc, 0x0a, 0x52, 0x2d, 0x91, 0x6f, 0x63, 0xf7, 0x84, 0xb4, 0x3c, 0x99, 0xe9, 0x9e,
-	0x62, 0xf3, 0xf7, 0x42, 0x4a, 0x27, 0x07, 0x6a, 0xd6, 0x7f, 0x5f, 0xc2, 0x57, 0xec, 0x64, 0x1d,
-	0x3b, 0xe9, 0x58, 0x83, 0x63, 0x25, 0x3c, 0x1b, 0xef, 0x56, 0x22, 0xe3, 0xb9, 0x61, 0x68, 0x32,
-	0xdd, 0x6f, 0xc3, 0x57, 0xb3, 0x54, 0x7d, 0x4c, 0x49, 0x3f, 0xed, 0x8b, 0x8a, 0xb5, 0x34, 0x34,
-	0x7f, 0x91, 0x58, 0xf3, 0xe9, 0x96, 0xe7, 0x3e, 0xcc, 0x7e, 0x04, 0x50, 0xdd, 0xa3, 0xa2, 0xef,
-	0x84, 0x68, 0xcf, 0xef, 0x22, 0xd7, 0x65, 0x36, 0x12, 0xd8, 0xe9, 0xde, 0x88, 0x7c, 0x47, 0x76,
-	0xc2, 0x89, 0x4e, 0x53, 0x3d, 0x2b, 0x76, 0x6d, 0x58, 0x2b, 0x96, 0xb2, 0x79, 0x0b, 0xae, 0x74,
-	0x38, 0xf9, 0x56, 0x2e, 0x1e, 0x69, 0x89, 0x53, 0x3e, 0x58, 0xee, 0x00, 0xb8, 0x36, 0xb3, 0xf8,
-	0x4b, 0x3d, 0x64, 0x2e, 0xff, 0x5b, 0x85, 0xe5, 0x0e, 0x27, 0xca, 0x1e, 0x3c, 0x3f, 0xfd, 0xdc,
-	0x79, 0xc7, 0xc8, 0x7f, 0xcb, 0x19, 0x79, 0xef, 0x09, 0xed, 0xfd, 0x79, 0xbc, 0x33, 0x96, 0xb7,
-	0xa0, 0x92, 0xf3, 0xf2, 0xd8, 0x28, 0xc8, 0x35, 0xed, 0xae, 0x7d, 0x30, 0x97, 0x7b, 0x56, 0xfb,
-	0x36, 0x80, 0xcb, 0xb9, 0x17, 0xbe, 0x59, 0x90, 0x2f, 0x2f, 0x40, 0xbb, 0x3a, 0x67, 0x40, 0x06,
-	0x01, 0xc3, 0xc5, 0xf1, 0x1b, 0xed, 0xad, 0x22, 0x22, 0x23, 0x3f, 0xcd, 0x78, 0x3e, 0xbf, 0x09,
-	0xa6, 0xb9, 0x17, 0x49, 0x11, 0xd3, 0xbc, 0x80, 0x42, 0xa6, 0x45, 0x47, 0xb0, 0xf2, 0x13, 0x80,
-	0xf5, 0x19, 0xe3, 0xd6, 0x2a, 0xc8, 0x99, 0x1f, 0xa2, 0x7d, 0x34, 0x77, 0xc8, 0xa4, 0x16, 0x79,
-	0x17, 0x41, 0xa1, 0x16, 0x39, 0x01, 0xc5, 0x5a, 0x14, 0x9c, 0xdf, 0xda, 0x99, 0xdb, 0xcf, 0xee,
-	0x5f, 0x02, 0xed, 0x8f, 0x1f, 0x1e, 0x34, 0xc0, 0xa3, 0x83, 0x06, 0xf8, 0xeb, 0xa0, 0x01, 0xee,
-	0x1e, 0x36, 0x4a, 0x8f, 0x0e, 0x1b, 0xa5, 0x3f, 0x0e, 0x1b, 0xa5, 0xef, 0xd6, 0x26, 0xee, 0xd4,
-	0xfd, 0xc9, 0x7f, 0x5b, 0xc9, 0x1c, 0xf7, 0xaa, 0x89, 0xed, 0xbd, 0xff, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0xbe, 0xf0, 0xd2, 0xdd, 0x91, 0x0d, 0x00, 0x00,
+// MsgRenewRecurringGrantResponse defines the response to executing a
+// MsgRenewRecurringGrant message.
+type MsgRenewRecurringGrantResponse struct {
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgRenewRecurringGrantResponse) Reset()         { *m = MsgRenewRecurringGrantResponse{} }
+func (m *MsgRenewRecurringGrantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRenewRecurringGrantResponse) ProtoMessage()    {}
+func (*MsgRenewRecurringGrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{15}
+}
+func (m *MsgRenewRecurringGrantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRenewRecurringGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRenewRecurringGrantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRenewRecurringGrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenewRecurringGrantResponse.Merge(m, src)
+}
+func (m *MsgRenewRecurringGrantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRenewRecurringGrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenewRecurringGrantResponse.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgRenewRecurringGrantResponse proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// FundCommunityPool defines a method to allow an account to directly
-	// fund the community pool.
-	FundCommunityPool(ctx context.Context, in *MsgFundCommunityPool, opts ...grpc.CallOption) (*MsgFundCommunityPoolResponse, error)
-	// CommunityPoolSpend defines a governance operation for sending tokens from
-	// the community pool in the x/protocolpool module to another account, which
-	// could be the governance module itself. The authority is defined in the
-	// keeper.
-	CommunityPoolSpend(ctx context.Context, in *MsgCommunityPoolSpend, opts ...grpc.CallOption) (*MsgCommunityPoolSpendResponse, error)
-	// SubmitBudgetProposal defines a method to set a budget proposal.
-	SubmitBudgetProposal(ctx context.Context, in *MsgSubmitBudgetProposal, opts ...grpc.CallOption) (*MsgSubmitBudgetProposalResponse, error)
-	// ClaimBudget defines a method to claim the distributed budget.
-	ClaimBudget(ctx context.Context, in *MsgClaimBudget, opts ...grpc.CallOption) (*MsgClaimBudgetResponse, error)
-	// CreateContinuousFund defines a method to add funds continuously.
-	CreateContinuousFund(ctx context.Context, in *MsgCreateContinuousFund, opts ...grpc.CallOption) (*MsgCreateContinuousFundResponse, error)
-	// WithdrawContinuousFund defines a method to withdraw continuous fund allocated.
-	WithdrawContinuousFund(ctx context.Context, in *MsgWithdrawContinuousFund, opts ...grpc.CallOption) (*MsgWithdrawContinuousFundResponse, error)
-	// CancelContinuousFund defines a method for cancelling continuous fund.
-	CancelContinuousFund(ctx context.Context, in *MsgCancelContinuousFund, opts ...grpc.CallOption) (*MsgCancelContinuousFundResponse, error)
+// MsgCancelRecurringGrant defines a message for cancelling a recurring grant.
+type MsgCancelRecurringGrant struct {
+	// Authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Recipient is the address of the recipient of the recurring grant.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgCancelRecurringGrant) Reset()         { *m = MsgCancelRecurringGrant{} }
+func (m *MsgCancelRecurringGrant) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRecurringGrant) ProtoMessage()    {}
+func (*MsgCancelRecurringGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{16}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgCancelRecurringGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRecurringGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRecurringGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRecurringGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRecurringGrant.Merge(m, src)
+}
+func (m *MsgCancelRecurringGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRecurringGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRecurringGrant.DiscardUnknown(m)
 }
 
-func (c *msgClient) FundCommunityPool(ctx context.Context, in *MsgFundCommunityPool, opts ...grpc.CallOption) (*MsgFundCommunityPoolResponse, error) {
-	out := new(MsgFundCommunityPoolResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/FundCommunityPool", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCancelRecurringGrant proto.InternalMessageInfo
+
+func (m *MsgCancelRecurringGrant) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CommunityPoolSpend(ctx context.Context, in *MsgCommunityPoolSpend, opts ...grpc.CallOption) (*MsgCommunityPoolSpendResponse, error) {
-	out := new(MsgCommunityPoolSpendResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/CommunityPoolSpend", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCancelRecurringGrant) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SubmitBudgetProposal(ctx context.Context, in *MsgSubmitBudgetProposal, opts ...grpc.CallOption) (*MsgSubmitBudgetProposalResponse, error) {
-	out := new(MsgSubmitBudgetProposalResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/SubmitBudgetProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+// MsgCancelRecurringGrantResponse defines the response to executing a
+// MsgCancelRecurringGrant message.
+type MsgCancelRecurringGrantResponse struct {
+}
+
+func (m *MsgCancelRecurringGrantResponse) Reset()         { *m = MsgCancelRecurringGrantResponse{} }
+func (m *MsgCancelRecurringGrantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRecurringGrantResponse) ProtoMessage()    {}
+func (*MsgCancelRecurringGrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{17}
+}
+func (m *MsgCancelRecurringGrantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRecurringGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRecurringGrantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCancelRecurringGrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRecurringGrantResponse.Merge(m, src)
+}
+func (m *MsgCancelRecurringGrantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRecurringGrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRecurringGrantResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) ClaimBudget(ctx context.Context, in *MsgClaimBudget, opts ...grpc.CallOption) (*MsgClaimBudgetResponse, error) {
-	out := new(MsgClaimBudgetResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/ClaimBudget", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCancelRecurringGrantResponse proto.InternalMessageInfo
+
+// MsgPoolDelegate defines a message for delegating community pool funds to a
+// validator. The delegation rewards are paid back to the community pool.
+type MsgPoolDelegate struct {
+	// Authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// ValidatorAddress is the address of the validator to delegate to.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// Amount is the amount of bond denom to delegate.
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgPoolDelegate) Reset()         { *m = MsgPoolDelegate{} }
+func (m *MsgPoolDelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgPoolDelegate) ProtoMessage()    {}
+func (*MsgPoolDelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{18}
+}
+func (m *MsgPoolDelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPoolDelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPoolDelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgPoolDelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPoolDelegate.Merge(m, src)
+}
+func (m *MsgPoolDelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPoolDelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPoolDelegate.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateContinuousFund(ctx context.Context, in *MsgCreateContinuousFund, opts ...grpc.CallOption) (*MsgCreateContinuousFundResponse, error) {
-	out := new(MsgCreateContinuousFundResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/CreateContinuousFund", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgPoolDelegate proto.InternalMessageInfo
+
+func (m *MsgPoolDelegate) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) WithdrawContinuousFund(ctx context.Context, in *MsgWithdrawContinuousFund, opts ...grpc.CallOption) (*MsgWithdrawContinuousFundResponse, error) {
-	out := new(MsgWithdrawContinuousFundResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/WithdrawContinuousFund", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgPoolDelegate) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CancelContinuousFund(ctx context.Context, in *MsgCancelContinuousFund, opts ...grpc.CallOption) (*MsgCancelContinuousFundResponse, error) {
-	out := new(MsgCancelContinuousFundResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/CancelContinuousFund", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgPoolDelegate) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// FundCommunityPool defines a method to allow an account to directly
-	// fund the community pool.
-	FundCommunityPool(context.Context, *MsgFundCommunityPool) (*MsgFundCommunityPoolResponse, error)
-	// CommunityPoolSpend defines a governance operation for sending tokens from
-	// the community pool in the x/protocolpool module to another account, which
-	// could be the governance module itself. The authority is defined in the
-	// keeper.
-	CommunityPoolSpend(context.Context, *MsgCommunityPoolSpend) (*MsgCommunityPoolSpendResponse, error)
-	// SubmitBudgetProposal defines a method to set a budget proposal.
-	SubmitBudgetProposal(context.Context, *MsgSubmitBudgetProposal) (*MsgSubmitBudgetProposalResponse, error)
-	// ClaimBudget defines a method to claim the distributed budget.
-	ClaimBudget(context.Context, *MsgClaimBudget) (*MsgClaimBudgetResponse, error)
-	// CreateContinuousFund defines a method to add funds continuously.
-	CreateContinuousFund(context.Context, *MsgCreateContinuousFund) (*MsgCreateContinuousFundResponse, error)
-	// WithdrawContinuousFund defines a method to withdraw continuous fund allocated.
-	WithdrawContinuousFund(context.Context, *MsgWithdrawContinuousFund) (*MsgWithdrawContinuousFundResponse, error)
-	// CancelContinuousFund defines a method for cancelling continuous fund.
-	CancelContinuousFund(context.Context, *MsgCancelContinuousFund) (*MsgCancelContinuousFundResponse, error)
+// MsgPoolDelegateResponse defines the response to executing a MsgPoolDelegate
+// message.
+type MsgPoolDelegateResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgPoolDelegateResponse) Reset()         { *m = MsgPoolDelegateResponse{} }
+func (m *MsgPoolDelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPoolDelegateResponse) ProtoMessage()    {}
+func (*MsgPoolDelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{19}
+}
+func (m *MsgPoolDelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPoolDelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPoolDelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPoolDelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPoolDelegateResponse.Merge(m, src)
+}
+func (m *MsgPoolDelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPoolDelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPoolDelegateResponse.DiscardUnknown(m)
 }
 
-func (*UnimplementedMsgServer) FundCommunityPool(ctx context.Context, req *MsgFundCommunityPool) (*MsgFundCommunityPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FundCommunityPool not implemented")
+var xxx_messageInfo_MsgPoolDelegateResponse proto.InternalMessageInfo
+
+// MsgPoolUndelegate defines a message for undelegating community pool funds from
+// a validator. The funds are returned to the community pool once the unbonding
+// period is over.
+type MsgPoolUndelegate struct {
+	// Authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// ValidatorAddress is the address of the validator to undelegate from.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// Amount is the amount of bond denom to undelegate.
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
 }
-func (*UnimplementedMsgServer) CommunityPoolSpend(ctx context.Context, req *MsgCommunityPoolSpend) (*MsgCommunityPoolSpendResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CommunityPoolSpend not implemented")
+
+func (m *MsgPoolUndelegate) Reset()         { *m = MsgPoolUndelegate{} }
+func (m *MsgPoolUndelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgPoolUndelegate) ProtoMessage()    {}
+func (*MsgPoolUndelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{20}
 }
-func (*UnimplementedMsgServer) SubmitBudgetProposal(ctx context.Context, req *MsgSubmitBudgetProposal) (*MsgSubmitBudgetProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitBudgetProposal not implemented")
+func (m *MsgPoolUndelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) ClaimBudget(ctx context.Context, req *MsgClaimBudget) (*MsgClaimBudgetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimBudget not implemented")
+func (m *MsgPoolUndelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPoolUndelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) CreateContinuousFund(ctx context.Context, req *MsgCreateContinuousFund) (*MsgCreateContinuousFundResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateContinuousFund not implemented")
+func (m *MsgPoolUndelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPoolUndelegate.Merge(m, src)
 }
-func (*UnimplementedMsgServer) WithdrawContinuousFund(ctx context.Context, req *MsgWithdrawContinuousFund) (*MsgWithdrawContinuousFundResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawContinuousFund not implemented")
+func (m *MsgPoolUndelegate) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) CancelContinuousFund(ctx context.Context, req *MsgCancelContinuousFund) (*MsgCancelContinuousFundResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelContinuousFund not implemented")
+func (m *MsgPoolUndelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPoolUndelegate.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgPoolUndelegate proto.InternalMessageInfo
 
-func _Msg_FundCommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFundCommunityPool)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).FundCommunityPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.protocolpool.v1.Msg/FundCommunityPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FundCommunityPool(ctx, req.(*MsgFundCommunityPool))
+func (m *MsgPoolUndelegate) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CommunityPoolSpend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCommunityPoolSpend)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CommunityPoolSpend(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.protocolpool.v1.Msg/CommunityPoolSpend",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CommunityPoolSpend(ctx, req.(*MsgCommunityPoolSpend))
+func (m *MsgPoolUndelegate) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SubmitBudgetProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitBudgetProposal)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitBudgetProposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.protocolpool.v1.Msg/SubmitBudgetProposal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitBudgetProposal(ctx, req.(*MsgSubmitBudgetProposal))
+func (m *MsgPoolUndelegate) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Msg_ClaimBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClaimBudget)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClaimBudget(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.protocolpool.v1.Msg/ClaimBudget",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClaimBudget(ctx, req.(*MsgClaimBudget))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgPoolUndelegateResponse defines the response to executing a MsgPoolUndelegate
+// message.
+type MsgPoolUndelegateResponse struct {
+	// completion_time is the time at which the funds are returned to the community pool.
+	CompletionTime time.Time `protobuf:"bytes,1,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+	// amount is the amount of bond denom being unbonded.
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
 }
 
-func _Msg_CreateContinuousFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateContinuousFund)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateContinuousFund(ctx, in)
+func (m *MsgPoolUndelegateResponse) Reset()         { *m = MsgPoolUndelegateResponse{} }
+func (m *MsgPoolUndelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPoolUndelegateResponse) ProtoMessage()    {}
+func (*MsgPoolUndelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{21}
+}
+func (m *MsgPoolUndelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPoolUndelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPoolUndelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.protocolpool.v1.Msg/CreateContinuousFund",
+}
+func (m *MsgPoolUndelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPoolUndelegateResponse.Merge(m, src)
+}
+func (m *MsgPoolUndelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPoolUndelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPoolUndelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPoolUndelegateResponse proto.InternalMessageInfo
+
+func (m *MsgPoolUndelegateResponse) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateContinuousFund(ctx, req.(*MsgCreateContinuousFund))
+	return time.Time{}
+}
+
+func (m *MsgPoolUndelegateResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Msg_WithdrawContinuousFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawContinuousFund)
-	if err := dec(in); err != nil {
-		return nil, err
+// MsgOpenPosition defines a message for recording community pool funds deployed
+// into an external protocol.
+type MsgOpenPosition struct {
+	// Authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Venue is a free-form description of where the funds are deployed.
+	Venue string `protobuf:"bytes,2,opt,name=venue,proto3" json:"venue,omitempty"`
+	// Recipient is the address the principal is sent to from the community pool,
+	// e.g. an interchain account or a vault contract. If empty, no funds are moved
+	// and the position only records funds spent by other means.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// Principal is the amount of funds deployed.
+	Principal github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=principal,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"principal"`
+	// ExpectedReturn is the amount expected back from the venue at maturity.
+	ExpectedReturn github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=expected_return,json=expectedReturn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"expected_return"`
+	// Maturity is the time at which the position is expected to be closed. It is
+	// empty for open-ended positions.
+	Maturity *time.Time `protobuf:"bytes,6,opt,name=maturity,proto3,stdtime" json:"maturity,omitempty"`
+	// Reporter is the address allowed to report on the position besides the authority.
+	Reporter string `protobuf:"bytes,7,opt,name=reporter,proto3" json:"reporter,omitempty"`
+}
+
+func (m *MsgOpenPosition) Reset()         { *m = MsgOpenPosition{} }
+func (m *MsgOpenPosition) String() string { return proto.CompactTextString(m) }
+func (*MsgOpenPosition) ProtoMessage()    {}
+func (*MsgOpenPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{22}
+}
+func (m *MsgOpenPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOpenPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOpenPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawContinuousFund(ctx, in)
+}
+func (m *MsgOpenPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOpenPosition.Merge(m, src)
+}
+func (m *MsgOpenPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOpenPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOpenPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOpenPosition proto.InternalMessageInfo
+
+func (m *MsgOpenPosition) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.protocolpool.v1.Msg/WithdrawContinuousFund",
+	return ""
+}
+
+func (m *MsgOpenPosition) GetVenue() string {
+	if m != nil {
+		return m.Venue
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawContinuousFund(ctx, req.(*MsgWithdrawContinuousFund))
+	return ""
+}
+
+func (m *MsgOpenPosition) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CancelContinuousFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelContinuousFund)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgOpenPosition) GetPrincipal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Principal
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelContinuousFund(ctx, in)
+	return nil
+}
+
+func (m *MsgOpenPosition) GetExpectedReturn() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ExpectedReturn
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.protocolpool.v1.Msg/CancelContinuousFund",
+	return nil
+}
+
+func (m *MsgOpenPosition) GetMaturity() *time.Time {
+	if m != nil {
+		return m.Maturity
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelContinuousFund(ctx, req.(*MsgCancelContinuousFund))
+	return nil
+}
+
+func (m *MsgOpenPosition) GetReporter() string {
+	if m != nil {
+		return m.Reporter
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.protocolpool.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "FundCommunityPool",
-			Handler:    _Msg_FundCommunityPool_Handler,
-		},
-		{
-			MethodName: "CommunityPoolSpend",
-			Handler:    _Msg_CommunityPoolSpend_Handler,
-		},
-		{
-			MethodName: "SubmitBudgetProposal",
-			Handler:    _Msg_SubmitBudgetProposal_Handler,
-		},
-		{
-			MethodName: "ClaimBudget",
-			Handler:    _Msg_ClaimBudget_Handler,
-		},
-		{
-			MethodName: "CreateContinuousFund",
-			Handler:    _Msg_CreateContinuousFund_Handler,
-		},
-		{
-			MethodName: "WithdrawContinuousFund",
-			Handler:    _Msg_WithdrawContinuousFund_Handler,
-		},
-		{
-			MethodName: "CancelContinuousFund",
-			Handler:    _Msg_CancelContinuousFund_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmos/protocolpool/v1/tx.proto",
+// MsgOpenPositionResponse defines the response to executing a MsgOpenPosition
+// message.
+type MsgOpenPositionResponse struct {
+	// id is the identifier of the opened position.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgFundCommunityPool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgOpenPositionResponse) Reset()         { *m = MsgOpenPositionResponse{} }
+func (m *MsgOpenPositionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgOpenPositionResponse) ProtoMessage()    {}
+func (*MsgOpenPositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{23}
+}
+func (m *MsgOpenPositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOpenPositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOpenPositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgFundCommunityPool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgOpenPositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOpenPositionResponse.Merge(m, src)
+}
+func (m *MsgOpenPositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOpenPositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOpenPositionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgFundCommunityPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_MsgOpenPositionResponse proto.InternalMessageInfo
+
+func (m *MsgOpenPositionResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	return 0
+}
+
+// MsgReportPosition defines a message for reporting on a community pool
+// investment position.
+type MsgReportPosition struct {
+	// Reporter is the address of the reporter of the position or the authority.
+	Reporter string `protobuf:"bytes,1,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	// PositionId is the identifier of the position.
+	PositionId uint64 `protobuf:"varint,2,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty"`
+	// CurrentValue is the current value of the position.
+	CurrentValue github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=current_value,json=currentValue,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"current_value"`
+	// Close marks the position as closed, once its capital has been withdrawn
+	// from the venue.
+	Close bool `protobuf:"varint,4,opt,name=close,proto3" json:"close,omitempty"`
+	// Note is a free-form note attached to the report.
+	Note string `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (m *MsgReportPosition) Reset()         { *m = MsgReportPosition{} }
+func (m *MsgReportPosition) String() string { return proto.CompactTextString(m) }
+func (*MsgReportPosition) ProtoMessage()    {}
+func (*MsgReportPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{24}
+}
+func (m *MsgReportPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgReportPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportPosition.Merge(m, src)
+}
+func (m *MsgReportPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportPosition.DiscardUnknown(m)
 }
 
-func (m *MsgFundCommunityPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgReportPosition proto.InternalMessageInfo
+
+func (m *MsgReportPosition) GetReporter() string {
+	if m != nil {
+		return m.Reporter
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgFundCommunityPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgReportPosition) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
 }
 
-func (m *MsgFundCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgReportPosition) GetCurrentValue() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CurrentValue
+	}
+	return nil
 }
 
-func (m *MsgCommunityPoolSpend) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReportPosition) GetClose() bool {
+	if m != nil {
+		return m.Close
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgCommunityPoolSpend) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgReportPosition) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
 }
 
-func (m *MsgCommunityPoolSpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+// MsgReportPositionResponse defines the response to executing a
+// MsgReportPosition message.
+type MsgReportPositionResponse struct {
+}
+
+func (m *MsgReportPositionResponse) Reset()         { *m = MsgReportPositionResponse{} }
+func (m *MsgReportPositionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReportPositionResponse) ProtoMessage()    {}
+func (*MsgReportPositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{25}
+}
+func (m *MsgReportPositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportPositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportPositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgReportPositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportPositionResponse.Merge(m, src)
+}
+func (m *MsgReportPositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportPositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportPositionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCommunityPoolSpendResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgReportPositionResponse proto.InternalMessageInfo
+
+// MsgSetFreezeParams defines a message for setting who can freeze the community
+// pool outflows in an emergency, and for how long.
+type MsgSetFreezeParams struct {
+	// Authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Params are the freeze parameters.
+	Params FreezeParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func (m *MsgCommunityPoolSpendResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetFreezeParams) Reset()         { *m = MsgSetFreezeParams{} }
+func (m *MsgSetFreezeParams) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFreezeParams) ProtoMessage()    {}
+func (*MsgSetFreezeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{26}
+}
+func (m *MsgSetFreezeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFreezeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFreezeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFreezeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFreezeParams.Merge(m, src)
+}
+func (m *MsgSetFreezeParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFreezeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFreezeParams.DiscardUnknown(m)
 }
 
-func (m *MsgCommunityPoolSpendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSetFreezeParams proto.InternalMessageInfo
+
+func (m *MsgSetFreezeParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func (m *MsgSubmitBudgetProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetFreezeParams) GetParams() FreezeParams {
+	if m != nil {
+		return m.Params
 	}
-	return dAtA[:n], nil
+	return FreezeParams{}
 }
 
-func (m *MsgSubmitBudgetProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetFreezeParamsResponse defines the response to executing a
+// MsgSetFreezeParams message.
+type MsgSetFreezeParamsResponse struct {
 }
 
-func (m *MsgSubmitBudgetProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Period != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Period):])
-		if err1 != nil {
-			return 0, err1
-		}
-		i -= n1
-		i = encodeVarintTx(dAtA, i, uint64(n1))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Tranches != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Tranches))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.StartTime != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
-		if err2 != nil {
-			return 0, err2
+func (m *MsgSetFreezeParamsResponse) Reset()         { *m = MsgSetFreezeParamsResponse{} }
+func (m *MsgSetFreezeParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFreezeParamsResponse) ProtoMessage()    {}
+func (*MsgSetFreezeParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{27}
+}
+func (m *MsgSetFreezeParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFreezeParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFreezeParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n2
-		i = encodeVarintTx(dAtA, i, uint64(n2))
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if m.TotalBudget != nil {
-		{
-			size, err := m.TotalBudget.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.RecipientAddress) > 0 {
-		i -= len(m.RecipientAddress)
-		copy(dAtA[i:], m.RecipientAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RecipientAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgSubmitBudgetProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetFreezeParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFreezeParamsResponse.Merge(m, src)
 }
-
-func (m *MsgSubmitBudgetProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetFreezeParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFreezeParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFreezeParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitBudgetProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSetFreezeParamsResponse proto.InternalMessageInfo
+
+// MsgFreezeOutflows defines a message for freezing the community pool outflows.
+// A freeze activated by the security authority must be ratified by governance: a
+// ratification proposal is submitted, and the freeze is lifted if it does not pass.
+type MsgFreezeOutflows struct {
+	// Sender is the address of the security authority or the authority.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Duration is the duration of the freeze, at most the max duration of the
+	// freeze parameters.
+	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration"`
+	// Reason is a free-form description of why the outflows are frozen.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *MsgClaimBudget) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgFreezeOutflows) Reset()         { *m = MsgFreezeOutflows{} }
+func (m *MsgFreezeOutflows) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeOutflows) ProtoMessage()    {}
+func (*MsgFreezeOutflows) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{28}
+}
+func (m *MsgFreezeOutflows) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeOutflows) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeOutflows.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgFreezeOutflows) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeOutflows.Merge(m, src)
+}
+func (m *MsgFreezeOutflows) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeOutflows) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeOutflows.DiscardUnknown(m)
 }
 
-func (m *MsgClaimBudget) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgFreezeOutflows proto.InternalMessageInfo
+
+func (m *MsgFreezeOutflows) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (m *MsgClaimBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RecipientAddress) > 0 {
-		i -= len(m.RecipientAddress)
-		copy(dAtA[i:], m.RecipientAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RecipientAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgFreezeOutflows) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgClaimBudgetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgFreezeOutflows) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgClaimBudgetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgFreezeOutflowsResponse defines the response to executing a
+// MsgFreezeOutflows message.
+type MsgFreezeOutflowsResponse struct {
+	// end_time is the time at which the freeze expires.
+	EndTime time.Time `protobuf:"bytes,1,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// ratification_proposal_id is the id of the proposal ratifying the freeze, zero
+	// if the freeze was activated by the authority.
+	RatificationProposalId uint64 `protobuf:"varint,2,opt,name=ratification_proposal_id,json=ratificationProposalId,proto3" json:"ratification_proposal_id,omitempty"`
 }
 
-func (m *MsgClaimBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgFreezeOutflowsResponse) Reset()         { *m = MsgFreezeOutflowsResponse{} }
+func (m *MsgFreezeOutflowsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeOutflowsResponse) ProtoMessage()    {}
+func (*MsgFreezeOutflowsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{29}
+}
+func (m *MsgFreezeOutflowsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeOutflowsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeOutflowsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateContinuousFund) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgFreezeOutflowsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeOutflowsResponse.Merge(m, src)
 }
-
-func (m *MsgCreateContinuousFund) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgFreezeOutflowsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeOutflowsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeOutflowsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateContinuousFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Expiry != nil {
-		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry):])
-		if err5 != nil {
-			return 0, err5
-		}
-		i -= n5
-		i = encodeVarintTx(dAtA, i, uint64(n5))
-		i--
-		dAtA[i] = 0x22
-	}
-	{
-		size := m.Percentage.Size()
-		i -= size
-		if _, err := m.Percentage.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgFreezeOutflowsResponse proto.InternalMessageInfo
+
+func (m *MsgFreezeOutflowsResponse) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *MsgCreateContinuousFundResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgFreezeOutflowsResponse) GetRatificationProposalId() uint64 {
+	if m != nil {
+		return m.RatificationProposalId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCreateContinuousFundResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRatifyFreeze defines a message for ratifying the active freeze of the
+// community pool outflows.
+type MsgRatifyFreeze struct {
+	// Authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// FreezeStartTime is the start time of the ratified freeze, so that a
+	// ratification proposal cannot ratify a later freeze.
+	FreezeStartTime time.Time `protobuf:"bytes,2,opt,name=freeze_start_time,json=freezeStartTime,proto3,stdtime" json:"freeze_start_time"`
 }
 
-func (m *MsgCreateContinuousFundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRatifyFreeze) Reset()         { *m = MsgRatifyFreeze{} }
+func (m *MsgRatifyFreeze) String() string { return proto.CompactTextString(m) }
+func (*MsgRatifyFreeze) ProtoMessage()    {}
+func (*MsgRatifyFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{30}
 }
-
-func (m *MsgCancelContinuousFund) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRatifyFreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRatifyFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRatifyFreeze.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCancelContinuousFund) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRatifyFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRatifyFreeze.Merge(m, src)
+}
+func (m *MsgRatifyFreeze) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRatifyFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRatifyFreeze.DiscardUnknown(m)
 }
 
-func (m *MsgCancelContinuousFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RecipientAddress) > 0 {
-		i -= len(m.RecipientAddress)
-		copy(dAtA[i:], m.RecipientAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RecipientAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgRatifyFreeze proto.InternalMessageInfo
+
+func (m *MsgRatifyFreeze) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCancelContinuousFundResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRatifyFreeze) GetFreezeStartTime() time.Time {
+	if m != nil {
+		return m.FreezeStartTime
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *MsgCancelContinuousFundResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRatifyFreezeResponse defines the response to executing a MsgRatifyFreeze
+// message.
+type MsgRatifyFreezeResponse struct {
 }
 
-func (m *MsgCancelContinuousFundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.WithdrawnAllocatedFund.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRatifyFreezeResponse) Reset()         { *m = MsgRatifyFreezeResponse{} }
+func (m *MsgRatifyFreezeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRatifyFreezeResponse) ProtoMessage()    {}
+func (*MsgRatifyFreezeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{31}
+}
+func (m *MsgRatifyFreezeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRatifyFreezeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRatifyFreezeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.RecipientAddress) > 0 {
-		i -= len(m.RecipientAddress)
-		copy(dAtA[i:], m.RecipientAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RecipientAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.CanceledHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CanceledHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CanceledTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CanceledTime):])
-	if err7 != nil {
-		return 0, err7
+		return b[:n], nil
 	}
-	i -= n7
-	i = encodeVarintTx(dAtA, i, uint64(n7))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgWithdrawContinuousFund) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRatifyFreezeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRatifyFreezeResponse.Merge(m, src)
+}
+func (m *MsgRatifyFreezeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRatifyFreezeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRatifyFreezeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawContinuousFund) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRatifyFreezeResponse proto.InternalMessageInfo
+
+// MsgLiftFreeze defines a message for lifting the active freeze of the community
+// pool outflows.
+type MsgLiftFreeze struct {
+	// Sender is the address of the authority or the security authority.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func (m *MsgWithdrawContinuousFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RecipientAddress) > 0 {
-		i -= len(m.RecipientAddress)
-		copy(dAtA[i:], m.RecipientAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RecipientAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgLiftFreeze) Reset()         { *m = MsgLiftFreeze{} }
+func (m *MsgLiftFreeze) String() string { return proto.CompactTextString(m) }
+func (*MsgLiftFreeze) ProtoMessage()    {}
+func (*MsgLiftFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{32}
+}
+func (m *MsgLiftFreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLiftFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLiftFreeze.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgLiftFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLiftFreeze.Merge(m, src)
+}
+func (m *MsgLiftFreeze) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLiftFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLiftFreeze.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawContinuousFundResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgLiftFreeze proto.InternalMessageInfo
+
+func (m *MsgLiftFreeze) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgWithdrawContinuousFundResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgLiftFreezeResponse defines the response to executing a MsgLiftFreeze
+// message.
+type MsgLiftFreezeResponse struct {
 }
 
-func (m *MsgWithdrawContinuousFundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgLiftFreezeResponse) Reset()         { *m = MsgLiftFreezeResponse{} }
+func (m *MsgLiftFreezeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLiftFreezeResponse) ProtoMessage()    {}
+func (*MsgLiftFreezeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{33}
+}
+func (m *MsgLiftFreezeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLiftFreezeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLiftFreezeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgLiftFreezeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLiftFreezeResponse.Merge(m, src)
+}
+func (m *MsgLiftFreezeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLiftFreezeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLiftFreezeResponse.DiscardUnknown(m)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+var xxx_messageInfo_MsgLiftFreezeResponse proto.InternalMessageInfo
+
+// MsgSubmitBudgetProposal defines budget proposal type.
+type MsgSubmitBudgetProposal struct {
+	// Authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// RecipientAddress is the address of the recipient who can claim the budget.
+	RecipientAddress string `protobuf:"bytes,2,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+	// TotalBudget is the total amount allocated for the budget.
+	TotalBudget *types.Coin `protobuf:"bytes,3,opt,name=total_budget,json=totalBudget,proto3" json:"total_budget,omitempty"`
+	// StartTime is the time when the budget becomes claimable.
+	// If StartTime is less than the current block time, proposal will not be accepted.
+	StartTime *time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	// Tranches is the number of times the total budget amount is to be distributed.
+	Tranches uint64 `protobuf:"varint,5,opt,name=tranches,proto3" json:"tranches,omitempty"`
+	// Period is the time interval(number of seconds) at which funds distribution should be performed.
+	// For example, if a period is set to 3600, it represents an action that
+	// should occur every hour (3600 seconds).
+	Period *time.Duration `protobuf:"bytes,6,opt,name=period,proto3,stdduration" json:"period,omitempty"`
 }
-func (m *MsgFundCommunityPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+
+func (m *MsgSubmitBudgetProposal) Reset()         { *m = MsgSubmitBudgetProposal{} }
+func (m *MsgSubmitBudgetProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitBudgetProposal) ProtoMessage()    {}
+func (*MsgSubmitBudgetProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{34}
+}
+func (m *MsgSubmitBudgetProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitBudgetProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitBudgetProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+}
+func (m *MsgSubmitBudgetProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitBudgetProposal.Merge(m, src)
+}
+func (m *MsgSubmitBudgetProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitBudgetProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitBudgetProposal.DiscardUnknown(m)
 }
 
-func (m *MsgFundCommunityPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgSubmitBudgetProposal proto.InternalMessageInfo
+
+func (m *MsgSubmitBudgetProposal) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgCommunityPoolSpend) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSubmitBudgetProposal) GetRecipientAddress() string {
+	if m != nil {
+		return m.RecipientAddress
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgSubmitBudgetProposal) GetTotalBudget() *types.Coin {
+	if m != nil {
+		return m.TotalBudget
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return nil
+}
+
+func (m *MsgSubmitBudgetProposal) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	return n
+	return nil
 }
 
-func (m *MsgCommunityPoolSpendResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSubmitBudgetProposal) GetTranches() uint64 {
+	if m != nil {
+		return m.Tranches
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *MsgSubmitBudgetProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSubmitBudgetProposal) GetPeriod() *time.Duration {
+	if m != nil {
+		return m.Period
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RecipientAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TotalBudget != nil {
-		l = m.TotalBudget.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.StartTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Tranches != 0 {
-		n += 1 + sovTx(uint64(m.Tranches))
-	}
-	if m.Period != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Period)
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return nil
 }
 
-func (m *MsgSubmitBudgetProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// MsgSubmitBudgetProposalResponse defines the response to executing a
+// MsgSubmitBudgetProposal message.
+type MsgSubmitBudgetProposalResponse struct {
 }
 
-func (m *MsgClaimBudget) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RecipientAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSubmitBudgetProposalResponse) Reset()         { *m = MsgSubmitBudgetProposalResponse{} }
+func (m *MsgSubmitBudgetProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitBudgetProposalResponse) ProtoMessage()    {}
+func (*MsgSubmitBudgetProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{35}
+}
+func (m *MsgSubmitBudgetProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitBudgetProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitBudgetProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgSubmitBudgetProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitBudgetProposalResponse.Merge(m, src)
+}
+func (m *MsgSubmitBudgetProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitBudgetProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitBudgetProposalResponse.DiscardUnknown(m)
 }
 
-func (m *MsgClaimBudgetResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+var xxx_messageInfo_MsgSubmitBudgetProposalResponse proto.InternalMessageInfo
+
+// MsgClaimBudget defines a message for claiming the distributed budget.
+type MsgClaimBudget struct {
+	RecipientAddress string `protobuf:"bytes,1,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
 }
 
-func (m *MsgCreateContinuousFund) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Percentage.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Expiry != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry)
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgClaimBudget) Reset()         { *m = MsgClaimBudget{} }
+func (m *MsgClaimBudget) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimBudget) ProtoMessage()    {}
+func (*MsgClaimBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{36}
+}
+func (m *MsgClaimBudget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimBudget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgClaimBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimBudget.Merge(m, src)
+}
+func (m *MsgClaimBudget) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimBudget.DiscardUnknown(m)
 }
 
-func (m *MsgCreateContinuousFundResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgClaimBudget proto.InternalMessageInfo
+
+func (m *MsgClaimBudget) GetRecipientAddress() string {
+	if m != nil {
+		return m.RecipientAddress
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgCancelContinuousFund) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RecipientAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+// MsgClaimBudgetResponse defines the response to executing a
+// MsgClaimBudget message.
+type MsgClaimBudgetResponse struct {
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func (m *MsgCancelContinuousFundResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CanceledTime)
-	n += 1 + l + sovTx(uint64(l))
-	if m.CanceledHeight != 0 {
-		n += 1 + sovTx(uint64(m.CanceledHeight))
+func (m *MsgClaimBudgetResponse) Reset()         { *m = MsgClaimBudgetResponse{} }
+func (m *MsgClaimBudgetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimBudgetResponse) ProtoMessage()    {}
+func (*MsgClaimBudgetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{37}
+}
+func (m *MsgClaimBudgetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimBudgetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimBudgetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.RecipientAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgClaimBudgetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimBudgetResponse.Merge(m, src)
+}
+func (m *MsgClaimBudgetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimBudgetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimBudgetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimBudgetResponse proto.InternalMessageInfo
+
+func (m *MsgClaimBudgetResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	l = m.WithdrawnAllocatedFund.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return types.Coin{}
 }
 
-func (m *MsgWithdrawContinuousFund) Size() (n int) {
-	if m == nil {
-		return 0
+// MsgCreateContinuousFund defines a message for adding continuous funds.
+type MsgCreateContinuousFund struct {
+	// Authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Recipient address of the account receiving funds.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// Percentage is the percentage of funds to be allocated from Community pool.
+	Percentage cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=percentage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"percentage"`
+	// Optional, if expiry is set, removes the state object when expired.
+	Expiry *time.Time `protobuf:"bytes,4,opt,name=expiry,proto3,stdtime" json:"expiry,omitempty"`
+}
+
+func (m *MsgCreateContinuousFund) Reset()         { *m = MsgCreateContinuousFund{} }
+func (m *MsgCreateContinuousFund) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateContinuousFund) ProtoMessage()    {}
+func (*MsgCreateContinuousFund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{38}
+}
+func (m *MsgCreateContinuousFund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateContinuousFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateContinuousFund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.RecipientAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgCreateContinuousFund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateContinuousFund.Merge(m, src)
+}
+func (m *MsgCreateContinuousFund) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateContinuousFund) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateContinuousFund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateContinuousFund proto.InternalMessageInfo
+
+func (m *MsgCreateContinuousFund) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgCreateContinuousFund) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *MsgCreateContinuousFund) GetExpiry() *time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return nil
+}
+
+// MsgCreateContinuousFundResponse defines the response to executing a
+// MsgCreateContinuousFund message.
+type MsgCreateContinuousFundResponse struct {
+}
+
+func (m *MsgCreateContinuousFundResponse) Reset()         { *m = MsgCreateContinuousFundResponse{} }
+func (m *MsgCreateContinuousFundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateContinuousFundResponse) ProtoMessage()    {}
+func (*MsgCreateContinuousFundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{39}
+}
+func (m *MsgCreateContinuousFundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateContinuousFundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateContinuousFundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateContinuousFundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateContinuousFundResponse.Merge(m, src)
+}
+func (m *MsgCreateContinuousFundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateContinuousFundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateContinuousFundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateContinuousFundResponse proto.InternalMessageInfo
+
+// MsgCancelContinuousFund defines a message to cancel continuous funds for a specific recipient.
+type MsgCancelContinuousFund struct {
+	// Authority is the account address of authority.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// RecipientAddress is the account address of recipient whose funds are to be cancelled.
+	RecipientAddress string `protobuf:"bytes,2,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+}
+
+func (m *MsgCancelContinuousFund) Reset()         { *m = MsgCancelContinuousFund{} }
+func (m *MsgCancelContinuousFund) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelContinuousFund) ProtoMessage()    {}
+func (*MsgCancelContinuousFund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{40}
+}
+func (m *MsgCancelContinuousFund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelContinuousFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelContinuousFund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelContinuousFund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelContinuousFund.Merge(m, src)
+}
+func (m *MsgCancelContinuousFund) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelContinuousFund) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelContinuousFund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelContinuousFund proto.InternalMessageInfo
+
+func (m *MsgCancelContinuousFund) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgCancelContinuousFund) GetRecipientAddress() string {
+	if m != nil {
+		return m.RecipientAddress
+	}
+	return ""
+}
+
+// MsgCancelContinuousFundResponse defines the response to executing a
+// MsgCancelContinuousFund message.
+type MsgCancelContinuousFundResponse struct {
+	// CanceledTime is the canceled time.
+	CanceledTime time.Time `protobuf:"bytes,1,opt,name=canceled_time,json=canceledTime,proto3,stdtime" json:"canceled_time"`
+	// CanceledHeight defines the canceled block height.
+	CanceledHeight uint64 `protobuf:"varint,2,opt,name=canceled_height,json=canceledHeight,proto3" json:"canceled_height,omitempty"`
+	// RecipientAddress is the account address of recipient whose funds are cancelled.
+	RecipientAddress string `protobuf:"bytes,3,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+	// withdrawnAllocatedFund represents the fund allocated to this recipient (if any) that have not been withdrawn yet,
+	// before a cancellation request has been initiated.
+	// It involves first withdrawing the funds and then canceling the request.
+	WithdrawnAllocatedFund types.Coin `protobuf:"bytes,4,opt,name=withdrawn_allocated_fund,json=withdrawnAllocatedFund,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdrawn_allocated_fund"`
+}
+
+func (m *MsgCancelContinuousFundResponse) Reset()         { *m = MsgCancelContinuousFundResponse{} }
+func (m *MsgCancelContinuousFundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelContinuousFundResponse) ProtoMessage()    {}
+func (*MsgCancelContinuousFundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{41}
+}
+func (m *MsgCancelContinuousFundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelContinuousFundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelContinuousFundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelContinuousFundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelContinuousFundResponse.Merge(m, src)
+}
+func (m *MsgCancelContinuousFundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelContinuousFundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelContinuousFundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelContinuousFundResponse proto.InternalMessageInfo
+
+func (m *MsgCancelContinuousFundResponse) GetCanceledTime() time.Time {
+	if m != nil {
+		return m.CanceledTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgCancelContinuousFundResponse) GetCanceledHeight() uint64 {
+	if m != nil {
+		return m.CanceledHeight
+	}
+	return 0
+}
+
+func (m *MsgCancelContinuousFundResponse) GetRecipientAddress() string {
+	if m != nil {
+		return m.RecipientAddress
+	}
+	return ""
+}
+
+func (m *MsgCancelContinuousFundResponse) GetWithdrawnAllocatedFund() types.Coin {
+	if m != nil {
+		return m.WithdrawnAllocatedFund
+	}
+	return types.Coin{}
+}
+
+// MsgWithdrawContinuousFund defines a message for withdrawing the continuous fund allocated to it.
+type MsgWithdrawContinuousFund struct {
+	RecipientAddress string `protobuf:"bytes,1,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+}
+
+func (m *MsgWithdrawContinuousFund) Reset()         { *m = MsgWithdrawContinuousFund{} }
+func (m *MsgWithdrawContinuousFund) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawContinuousFund) ProtoMessage()    {}
+func (*MsgWithdrawContinuousFund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{42}
+}
+func (m *MsgWithdrawContinuousFund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawContinuousFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawContinuousFund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawContinuousFund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawContinuousFund.Merge(m, src)
+}
+func (m *MsgWithdrawContinuousFund) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawContinuousFund) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawContinuousFund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawContinuousFund proto.InternalMessageInfo
+
+func (m *MsgWithdrawContinuousFund) GetRecipientAddress() string {
+	if m != nil {
+		return m.RecipientAddress
+	}
+	return ""
+}
+
+// MsgWithdrawContinuousFundResponse defines the response to executing a
+// MsgWithdrawContinuousFund message.
+type MsgWithdrawContinuousFundResponse struct {
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawContinuousFundResponse) Reset()         { *m = MsgWithdrawContinuousFundResponse{} }
+func (m *MsgWithdrawContinuousFundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawContinuousFundResponse) ProtoMessage()    {}
+func (*MsgWithdrawContinuousFundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{43}
+}
+func (m *MsgWithdrawContinuousFundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawContinuousFundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawContinuousFundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawContinuousFundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawContinuousFundResponse.Merge(m, src)
+}
+func (m *MsgWithdrawContinuousFundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawContinuousFundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawContinuousFundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawContinuousFundResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawContinuousFundResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*MsgFundCommunityPool)(nil), "cosmos.protocolpool.v1.MsgFundCommunityPool")
+	proto.RegisterType((*MsgFundCommunityPoolResponse)(nil), "cosmos.protocolpool.v1.MsgFundCommunityPoolResponse")
+	proto.RegisterType((*MsgCommunityPoolSpend)(nil), "cosmos.protocolpool.v1.MsgCommunityPoolSpend")
+	proto.RegisterType((*MsgCommunityPoolSpendResponse)(nil), "cosmos.protocolpool.v1.MsgCommunityPoolSpendResponse")
+	proto.RegisterType((*MsgCommunityPoolEscrowSpend)(nil), "cosmos.protocolpool.v1.MsgCommunityPoolEscrowSpend")
+	proto.RegisterType((*MsgCommunityPoolEscrowSpendResponse)(nil), "cosmos.protocolpool.v1.MsgCommunityPoolEscrowSpendResponse")
+	proto.RegisterType((*MsgApproveMilestone)(nil), "cosmos.protocolpool.v1.MsgApproveMilestone")
+	proto.RegisterType((*MsgApproveMilestoneResponse)(nil), "cosmos.protocolpool.v1.MsgApproveMilestoneResponse")
+	proto.RegisterType((*MsgSetSpendLimits)(nil), "cosmos.protocolpool.v1.MsgSetSpendLimits")
+	proto.RegisterType((*MsgSetSpendLimitsResponse)(nil), "cosmos.protocolpool.v1.MsgSetSpendLimitsResponse")
+	proto.RegisterType((*MsgSetDenomPolicies)(nil), "cosmos.protocolpool.v1.MsgSetDenomPolicies")
+	proto.RegisterType((*MsgSetDenomPoliciesResponse)(nil), "cosmos.protocolpool.v1.MsgSetDenomPoliciesResponse")
+	proto.RegisterType((*MsgCreateRecurringGrant)(nil), "cosmos.protocolpool.v1.MsgCreateRecurringGrant")
+	proto.RegisterType((*MsgCreateRecurringGrantResponse)(nil), "cosmos.protocolpool.v1.MsgCreateRecurringGrantResponse")
+	proto.RegisterType((*MsgRenewRecurringGrant)(nil), "cosmos.protocolpool.v1.MsgRenewRecurringGrant")
+	proto.RegisterType((*MsgRenewRecurringGrantResponse)(nil), "cosmos.protocolpool.v1.MsgRenewRecurringGrantResponse")
+	proto.RegisterType((*MsgCancelRecurringGrant)(nil), "cosmos.protocolpool.v1.MsgCancelRecurringGrant")
+	proto.RegisterType((*MsgCancelRecurringGrantResponse)(nil), "cosmos.protocolpool.v1.MsgCancelRecurringGrantResponse")
+	proto.RegisterType((*MsgPoolDelegate)(nil), "cosmos.protocolpool.v1.MsgPoolDelegate")
+	proto.RegisterType((*MsgPoolDelegateResponse)(nil), "cosmos.protocolpool.v1.MsgPoolDelegateResponse")
+	proto.RegisterType((*MsgPoolUndelegate)(nil), "cosmos.protocolpool.v1.MsgPoolUndelegate")
+	proto.RegisterType((*MsgPoolUndelegateResponse)(nil), "cosmos.protocolpool.v1.MsgPoolUndelegateResponse")
+	proto.RegisterType((*MsgOpenPosition)(nil), "cosmos.protocolpool.v1.MsgOpenPosition")
+	proto.RegisterType((*MsgOpenPositionResponse)(nil), "cosmos.protocolpool.v1.MsgOpenPositionResponse")
+	proto.RegisterType((*MsgReportPosition)(nil), "cosmos.protocolpool.v1.MsgReportPosition")
+	proto.RegisterType((*MsgReportPositionResponse)(nil), "cosmos.protocolpool.v1.MsgReportPositionResponse")
+	proto.RegisterType((*MsgSetFreezeParams)(nil), "cosmos.protocolpool.v1.MsgSetFreezeParams")
+	proto.RegisterType((*MsgSetFreezeParamsResponse)(nil), "cosmos.protocolpool.v1.MsgSetFreezeParamsResponse")
+	proto.RegisterType((*MsgFreezeOutflows)(nil), "cosmos.protocolpool.v1.MsgFreezeOutflows")
+	proto.RegisterType((*MsgFreezeOutflowsResponse)(nil), "cosmos.protocolpool.v1.MsgFreezeOutflowsResponse")
+	proto.RegisterType((*MsgRatifyFreeze)(nil), "cosmos.protocolpool.v1.MsgRatifyFreeze")
+	proto.RegisterType((*MsgRatifyFreezeResponse)(nil), "cosmos.protocolpool.v1.MsgRatifyFreezeResponse")
+	proto.RegisterType((*MsgLiftFreeze)(nil), "cosmos.protocolpool.v1.MsgLiftFreeze")
+	proto.RegisterType((*MsgLiftFreezeResponse)(nil), "cosmos.protocolpool.v1.MsgLiftFreezeResponse")
+	proto.RegisterType((*MsgSubmitBudgetProposal)(nil), "cosmos.protocolpool.v1.MsgSubmitBudgetProposal")
+	proto.RegisterType((*MsgSubmitBudgetProposalResponse)(nil), "cosmos.protocolpool.v1.MsgSubmitBudgetProposalResponse")
+	proto.RegisterType((*MsgClaimBudget)(nil), "cosmos.protocolpool.v1.MsgClaimBudget")
+	proto.RegisterType((*MsgClaimBudgetResponse)(nil), "cosmos.protocolpool.v1.MsgClaimBudgetResponse")
+	proto.RegisterType((*MsgCreateContinuousFund)(nil), "cosmos.protocolpool.v1.MsgCreateContinuousFund")
+	proto.RegisterType((*MsgCreateContinuousFundResponse)(nil), "cosmos.protocolpool.v1.MsgCreateContinuousFundResponse")
+	proto.RegisterType((*MsgCancelContinuousFund)(nil), "cosmos.protocolpool.v1.MsgCancelContinuousFund")
+	proto.RegisterType((*MsgCancelContinuousFundResponse)(nil), "cosmos.protocolpool.v1.MsgCancelContinuousFundResponse")
+	proto.RegisterType((*MsgWithdrawContinuousFund)(nil), "cosmos.protocolpool.v1.MsgWithdrawContinuousFund")
+	proto.RegisterType((*MsgWithdrawContinuousFundResponse)(nil), "cosmos.protocolpool.v1.MsgWithdrawContinuousFundResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/protocolpool/v1/tx.proto", fileDescriptor_09efe14517e7f6dc) }
+
+var fileDescriptor_09efe14517e7f6dc = []byte{
+	// 2136 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0x4f, 0x6f, 0xdc, 0xc6,
+	0x15, 0x17, 0x57, 0xab, 0x8d, 0xf4, 0xf4, 0xcf, 0x62, 0x14, 0x69, 0x45, 0xc5, 0x5a, 0x6b, 0xd3,
+	0xd6, 0x7f, 0x52, 0xed, 0x66, 0xed, 0xc4, 0x4a, 0xe5, 0x00, 0xae, 0x65, 0x25, 0xa9, 0x50, 0xaf,
+	0xa3, 0x52, 0x6d, 0x0a, 0xf4, 0xb2, 0xa0, 0xc8, 0x11, 0x45, 0x84, 0xcb, 0x61, 0x39, 0xb3, 0x2b,
+	0xc9, 0x40, 0x80, 0x34, 0x40, 0x8b, 0x1c, 0x7a, 0xc8, 0xa5, 0x45, 0x7a, 0x33, 0x50, 0xa0, 0x08,
+	0xda, 0x43, 0x73, 0xf0, 0x87, 0x08, 0xd0, 0x43, 0x03, 0x9f, 0x8a, 0x1e, 0x9c, 0xc2, 0x0e, 0x90,
+	0x7e, 0x88, 0x1c, 0x8a, 0x21, 0x87, 0xb3, 0xe4, 0x2e, 0x97, 0xbb, 0x54, 0x24, 0xb5, 0xe8, 0xc9,
+	0xda, 0x99, 0xdf, 0x7b, 0xef, 0xf7, 0xde, 0xbc, 0x79, 0xf3, 0x66, 0x68, 0x28, 0xe9, 0x98, 0x34,
+	0x31, 0xa9, 0xba, 0x1e, 0xa6, 0x58, 0xc7, 0xb6, 0x8b, 0xb1, 0x5d, 0x6d, 0xd7, 0xaa, 0xf4, 0xa8,
+	0xe2, 0x0f, 0xc9, 0x0b, 0x01, 0xa0, 0x12, 0x05, 0x54, 0xda, 0x35, 0x65, 0xde, 0xc4, 0x26, 0xf6,
+	0x07, 0xab, 0xec, 0xaf, 0x60, 0x5e, 0x59, 0xe1, 0xea, 0xf6, 0x34, 0x82, 0xaa, 0xed, 0xda, 0x1e,
+	0xa2, 0x5a, 0xad, 0xaa, 0x63, 0xcb, 0xe1, 0xf3, 0xe5, 0x7e, 0xe6, 0x8e, 0x5d, 0xc4, 0x6d, 0x28,
+	0x4b, 0x01, 0xa6, 0x11, 0x28, 0x8f, 0x9a, 0x57, 0x16, 0xb9, 0x78, 0x93, 0x98, 0x4c, 0xaa, 0x49,
+	0x4c, 0x3e, 0x51, 0x32, 0x31, 0x36, 0x6d, 0x14, 0xe8, 0xdd, 0x6b, 0xed, 0x57, 0xa9, 0xd5, 0x44,
+	0x84, 0x6a, 0x4d, 0x37, 0x24, 0xd6, 0x0d, 0x30, 0x5a, 0x9e, 0x46, 0x2d, 0xcc, 0x89, 0x95, 0xff,
+	0x26, 0xc1, 0x7c, 0x9d, 0x98, 0x6f, 0xb5, 0x1c, 0xe3, 0x2e, 0x6e, 0x36, 0x5b, 0x8e, 0x45, 0x8f,
+	0x77, 0x30, 0xb6, 0x65, 0x1d, 0x0a, 0x5a, 0x13, 0xb7, 0x1c, 0x5a, 0x94, 0x2e, 0x8d, 0x5e, 0x99,
+	0xbc, 0xbe, 0x54, 0xe1, 0x8c, 0x98, 0x8b, 0x15, 0xee, 0x62, 0xe5, 0x2e, 0xb6, 0x9c, 0xcd, 0x57,
+	0x3e, 0x7f, 0x52, 0x1a, 0xf9, 0xf3, 0x97, 0xa5, 0x2b, 0xa6, 0x45, 0x0f, 0x5a, 0x7b, 0x15, 0x1d,
+	0x37, 0x39, 0x7d, 0xfe, 0xcf, 0x1a, 0x31, 0xde, 0xe3, 0xae, 0x32, 0x01, 0xa2, 0x72, 0xd5, 0xf2,
+	0x4d, 0x98, 0x30, 0x90, 0x8b, 0x89, 0x45, 0xb1, 0x57, 0xcc, 0x5d, 0x92, 0xae, 0x4c, 0x6c, 0x16,
+	0x1f, 0x3f, 0x5a, 0x9b, 0xe7, 0xa6, 0xee, 0x18, 0x86, 0x87, 0x08, 0xd9, 0xa5, 0x9e, 0xe5, 0x98,
+	0x6a, 0x07, 0xba, 0xb1, 0xf0, 0xd1, 0xc3, 0xd2, 0xc8, 0xbf, 0x1f, 0x96, 0x46, 0x3e, 0xfc, 0xfa,
+	0xb3, 0x6b, 0x9d, 0xf1, 0xf2, 0x0a, 0xbc, 0x98, 0xe4, 0x8c, 0x8a, 0x88, 0x8b, 0x1d, 0x82, 0xca,
+	0x4f, 0x25, 0x78, 0xa1, 0x4e, 0xcc, 0xd8, 0xe4, 0xae, 0x8b, 0x1c, 0x83, 0x31, 0xd1, 0x5a, 0xf4,
+	0x00, 0x7b, 0x16, 0x3d, 0x2e, 0x4a, 0x83, 0x98, 0x08, 0xa8, 0xfc, 0x22, 0x4c, 0x78, 0x48, 0xb7,
+	0x5c, 0x0b, 0x39, 0x34, 0xf0, 0x40, 0xed, 0x0c, 0x44, 0x82, 0x38, 0x7a, 0x66, 0x41, 0xdc, 0x98,
+	0xf1, 0x83, 0x20, 0x28, 0x95, 0x4b, 0x70, 0x31, 0xd1, 0x47, 0x11, 0x85, 0x27, 0x39, 0x58, 0xee,
+	0x46, 0xbc, 0x49, 0x74, 0x0f, 0x1f, 0x7e, 0xbb, 0x58, 0xdc, 0xec, 0x89, 0x45, 0x9a, 0x5c, 0x27,
+	0x4a, 0xaf, 0xc2, 0xb8, 0x87, 0xda, 0x16, 0x3a, 0x44, 0x5e, 0x71, 0x74, 0x80, 0x98, 0x40, 0xca,
+	0x6f, 0x03, 0x34, 0x2d, 0x1b, 0x11, 0x8a, 0x1d, 0x44, 0x8a, 0x79, 0x3f, 0xbe, 0xab, 0x95, 0xe4,
+	0x5d, 0x5b, 0xa9, 0x87, 0xc8, 0xcd, 0x3c, 0x8b, 0xb3, 0x1a, 0x11, 0x95, 0x7f, 0x08, 0xe3, 0x06,
+	0xd2, 0x0c, 0xdb, 0x72, 0x50, 0x71, 0xec, 0x92, 0x74, 0x65, 0xf2, 0xba, 0x52, 0x09, 0x76, 0x4d,
+	0x25, 0xdc, 0x35, 0x95, 0x9f, 0x86, 0xdb, 0x6a, 0x73, 0x9c, 0xc9, 0x7f, 0xfc, 0x65, 0x49, 0x52,
+	0x85, 0x54, 0xcf, 0x0a, 0xbc, 0x06, 0x2f, 0xa5, 0xc4, 0x37, 0x5c, 0x07, 0x79, 0x06, 0x72, 0x96,
+	0xe1, 0x07, 0x38, 0xaf, 0xe6, 0x2c, 0xa3, 0xfc, 0x50, 0x82, 0xe7, 0xeb, 0xc4, 0xbc, 0xe3, 0xba,
+	0x1e, 0x6e, 0x23, 0x41, 0x99, 0xc5, 0x47, 0x0b, 0xc6, 0xbc, 0x81, 0xcb, 0x21, 0x90, 0xf2, 0x32,
+	0x4c, 0x20, 0xdf, 0x68, 0xc3, 0x32, 0xfc, 0xd5, 0xc8, 0xab, 0xe3, 0xc1, 0xc0, 0xb6, 0x21, 0x5f,
+	0x86, 0x59, 0x11, 0x81, 0x86, 0xe5, 0x18, 0xe8, 0xc8, 0x8f, 0x7c, 0x5e, 0x9d, 0x11, 0xc3, 0xdb,
+	0x6c, 0x74, 0x63, 0x9a, 0xb9, 0x26, 0x94, 0x96, 0x3f, 0x94, 0x60, 0x39, 0x81, 0xa2, 0x70, 0xe9,
+	0x3c, 0xaa, 0x46, 0xf9, 0x53, 0x09, 0xe6, 0xea, 0xc4, 0xdc, 0x45, 0xd4, 0x8f, 0xe7, 0x3d, 0xab,
+	0x69, 0x51, 0x72, 0xe2, 0xac, 0xfd, 0x31, 0x4c, 0x11, 0xa6, 0xa6, 0x61, 0xfb, 0x7a, 0x8a, 0x39,
+	0x9f, 0x78, 0xb9, 0x5f, 0x26, 0x75, 0x4c, 0xf2, 0x54, 0x9a, 0x24, 0x1d, 0x12, 0x3d, 0x99, 0xb0,
+	0x0c, 0x4b, 0x3d, 0x4c, 0xc5, 0x3e, 0xfc, 0x6b, 0xb0, 0xde, 0xbb, 0x88, 0x6e, 0x21, 0x07, 0x37,
+	0x77, 0xb0, 0x6d, 0xe9, 0x16, 0x3a, 0xb9, 0x27, 0x3b, 0x30, 0x63, 0x30, 0x45, 0x0d, 0x97, 0x6b,
+	0xe2, 0xbe, 0xbc, 0xd4, 0xcf, 0x97, 0x8e, 0xd9, 0x63, 0xee, 0xcc, 0xb4, 0x11, 0x65, 0xd2, 0xe3,
+	0xce, 0x45, 0x58, 0x4e, 0x20, 0x2c, 0x1c, 0xfa, 0x55, 0x1e, 0x16, 0x59, 0xe2, 0x7b, 0x48, 0xa3,
+	0x48, 0x45, 0x7a, 0xcb, 0x63, 0x14, 0xdf, 0xf6, 0xb4, 0xa0, 0xd4, 0x9f, 0x6b, 0x51, 0x39, 0x8f,
+	0xd2, 0x2b, 0xdf, 0x82, 0x82, 0x8b, 0x3c, 0x0b, 0x1b, 0xc5, 0xbc, 0x5f, 0x38, 0x96, 0x7a, 0x0a,
+	0xc7, 0x16, 0x3f, 0x6e, 0x83, 0xba, 0xf1, 0x09, 0xab, 0x1b, 0x5c, 0x44, 0xbe, 0x0f, 0x17, 0x3c,
+	0xe4, 0xa0, 0x43, 0xcd, 0x6e, 0x58, 0x0e, 0x45, 0x5e, 0x5b, 0xb3, 0x8b, 0x63, 0xc3, 0xab, 0x99,
+	0xe5, 0xc2, 0xdb, 0x5c, 0x56, 0xa6, 0x10, 0x0e, 0x35, 0xf8, 0x89, 0x58, 0x2c, 0x9c, 0xbe, 0xeb,
+	0x33, 0xdc, 0xc6, 0x56, 0x60, 0xa2, 0x27, 0x45, 0x56, 0xa1, 0xd4, 0x27, 0x05, 0x44, 0x9a, 0x7c,
+	0x22, 0xc1, 0x42, 0x9d, 0x98, 0x2a, 0x53, 0xf4, 0xdf, 0xcd, 0x92, 0x1e, 0xf6, 0x97, 0x60, 0x25,
+	0x99, 0x99, 0x20, 0xff, 0x07, 0x29, 0xc8, 0x71, 0xcd, 0xd1, 0x91, 0xfd, 0x3f, 0xc6, 0x9e, 0xc7,
+	0x3e, 0x81, 0x5a, 0xb4, 0x03, 0x9a, 0xad, 0x13, 0x93, 0x1d, 0x49, 0x5b, 0xc8, 0x46, 0xa6, 0x46,
+	0xd1, 0x89, 0x69, 0xdf, 0x87, 0xb9, 0xb6, 0x66, 0x5b, 0x86, 0x46, 0xb1, 0xd7, 0xd0, 0x02, 0x14,
+	0xa7, 0xbf, 0xfa, 0xf8, 0xd1, 0xda, 0x45, 0x2e, 0xff, 0x6e, 0x88, 0x89, 0x2b, 0xba, 0xd0, 0xee,
+	0x1a, 0x97, 0xd7, 0x23, 0x5b, 0x56, 0x4a, 0xcf, 0xdb, 0xa0, 0x5a, 0xf5, 0xeb, 0x80, 0x96, 0x60,
+	0xb1, 0xcb, 0x47, 0xe1, 0xff, 0x57, 0xc1, 0xd9, 0xc1, 0xe6, 0x7e, 0xe6, 0x18, 0xff, 0xb7, 0x11,
+	0xf8, 0xa3, 0x04, 0x4b, 0x3d, 0x6e, 0x8a, 0x53, 0xba, 0x0e, 0xb3, 0x3a, 0x6e, 0xba, 0x36, 0x62,
+	0x25, 0xa5, 0xc1, 0xae, 0x0c, 0x45, 0x29, 0x43, 0xe3, 0x33, 0xd3, 0x11, 0x66, 0xd3, 0x11, 0xd6,
+	0xb9, 0x4c, 0xac, 0xcb, 0xdf, 0x8c, 0xfa, 0xc9, 0xf8, 0x8e, 0x8b, 0x9c, 0x1d, 0x4c, 0x2c, 0xa6,
+	0xf0, 0xc4, 0x4b, 0x31, 0x0f, 0x63, 0x6d, 0xe4, 0xb4, 0x10, 0x6f, 0xc2, 0x83, 0x1f, 0xf1, 0x9d,
+	0x35, 0x3a, 0xfc, 0xe9, 0x61, 0xc1, 0x84, 0xeb, 0x59, 0x8e, 0x6e, 0xb9, 0x9a, 0xcd, 0x7b, 0xcb,
+	0x53, 0xad, 0xa2, 0x1d, 0xed, 0xac, 0x6c, 0xa3, 0x23, 0x17, 0xe9, 0x14, 0x19, 0x0d, 0x0f, 0xd1,
+	0x96, 0xe7, 0x14, 0xc7, 0xce, 0xa0, 0x6c, 0x87, 0x36, 0x54, 0xdf, 0x84, 0xfc, 0x06, 0x8c, 0x37,
+	0x35, 0xda, 0xf2, 0xa3, 0x5c, 0x18, 0xb8, 0xf6, 0xf9, 0xa0, 0xe1, 0x0d, 0x25, 0x82, 0x8e, 0xdd,
+	0xc5, 0x1e, 0x45, 0x5e, 0xf1, 0xb9, 0xc1, 0x1d, 0x7b, 0x80, 0xec, 0x49, 0xd2, 0xab, 0xb0, 0xd8,
+	0xb5, 0xfa, 0x7d, 0x5b, 0xe3, 0xdf, 0xe5, 0xfc, 0x6d, 0xab, 0xfa, 0xaa, 0x44, 0xae, 0x44, 0x69,
+	0x48, 0xc3, 0xd2, 0x90, 0x4b, 0x30, 0xe9, 0x72, 0x0d, 0x9d, 0xd6, 0x18, 0xc2, 0xa1, 0x6d, 0x43,
+	0x76, 0x61, 0x9a, 0xd5, 0x4e, 0xe4, 0xd0, 0x46, 0x5b, 0xb3, 0x5b, 0xe8, 0x2c, 0x3a, 0x88, 0x29,
+	0x6e, 0xe1, 0x5d, 0x66, 0x80, 0x25, 0xaf, 0x6e, 0x63, 0x82, 0xfc, 0x36, 0x62, 0x5c, 0x0d, 0x7e,
+	0xc8, 0x32, 0xe4, 0x1d, 0x4c, 0x83, 0x4b, 0xc9, 0x84, 0xea, 0xff, 0xcd, 0xfb, 0xf1, 0xd0, 0x17,
+	0xde, 0x5f, 0xc6, 0xc3, 0x22, 0x6a, 0xdd, 0x43, 0x09, 0xe4, 0xa0, 0x5d, 0x7b, 0xcb, 0x43, 0xe8,
+	0x01, 0xda, 0xd1, 0x3c, 0xad, 0x79, 0xf2, 0xf6, 0x72, 0x13, 0x0a, 0xae, 0xaf, 0x81, 0x6f, 0xf3,
+	0xef, 0xf4, 0x6b, 0x2b, 0xa3, 0xd6, 0xc2, 0x1d, 0x1f, 0x48, 0xf6, 0xa4, 0xc0, 0x8b, 0xa0, 0xf4,
+	0x32, 0x14, 0x0e, 0xfc, 0x25, 0x28, 0xd6, 0xc1, 0xdc, 0x3b, 0x2d, 0xba, 0x6f, 0xe3, 0x43, 0x22,
+	0xbf, 0x02, 0x05, 0x82, 0x1c, 0x63, 0x88, 0x35, 0xe7, 0x38, 0xf9, 0x36, 0x8c, 0x87, 0xcf, 0x1e,
+	0xa2, 0x44, 0x0d, 0xd1, 0x61, 0x09, 0x21, 0x79, 0x01, 0x0a, 0x1e, 0xd2, 0x08, 0x76, 0x82, 0x1a,
+	0xa2, 0xf2, 0x5f, 0x1b, 0x93, 0xcc, 0x1d, 0x6e, 0xa5, 0xfc, 0xfb, 0xa0, 0xe6, 0xc6, 0xd9, 0x8a,
+	0x8c, 0xbe, 0x0d, 0xe3, 0xec, 0x92, 0x91, 0xb9, 0xd8, 0x3e, 0x87, 0x1c, 0x83, 0x8d, 0xcb, 0xaf,
+	0x43, 0x91, 0xb1, 0xd9, 0xb7, 0x74, 0x9f, 0x13, 0x7b, 0x26, 0x72, 0x31, 0xd1, 0xec, 0x4e, 0x0e,
+	0x2f, 0x44, 0xe7, 0x77, 0xf8, 0xf4, 0xb6, 0xc1, 0xc2, 0xc8, 0xca, 0xac, 0xca, 0x66, 0x8f, 0x03,
+	0x7a, 0xdf, 0xe2, 0x8e, 0x31, 0xb7, 0xef, 0x6b, 0x68, 0x10, 0xaa, 0x79, 0x34, 0xf0, 0x27, 0x97,
+	0xc1, 0x9f, 0xd9, 0x40, 0x7c, 0x97, 0x49, 0xb3, 0xf9, 0x3e, 0x87, 0x77, 0x94, 0xac, 0xc8, 0x87,
+	0xfb, 0x30, 0x5d, 0x27, 0xe6, 0x3d, 0x6b, 0x9f, 0xa7, 0x4b, 0xf6, 0x54, 0x88, 0xaf, 0xd8, 0x22,
+	0xbc, 0x10, 0xd3, 0x27, 0x0c, 0x7d, 0x93, 0xf3, 0x49, 0xec, 0xb6, 0xf6, 0xd8, 0x3d, 0xaf, 0x65,
+	0x98, 0x88, 0x86, 0xf1, 0x3c, 0x71, 0xe4, 0xde, 0x84, 0x39, 0x71, 0xbe, 0x74, 0xf5, 0x0a, 0xfd,
+	0xe5, 0x2f, 0x08, 0x11, 0x3e, 0x2e, 0xbf, 0x01, 0x53, 0x14, 0x53, 0xcd, 0x6e, 0xec, 0xf9, 0xb4,
+	0x06, 0x36, 0x0a, 0xea, 0xa4, 0x0f, 0x0f, 0x9c, 0x90, 0x6f, 0x03, 0x44, 0xd6, 0x2d, 0x3f, 0x64,
+	0xe1, 0x9f, 0x20, 0xe1, 0x6a, 0xc9, 0x0a, 0x8c, 0x53, 0x4f, 0x73, 0xf4, 0x03, 0x44, 0xfc, 0xba,
+	0x94, 0x57, 0xc5, 0x6f, 0xd6, 0x07, 0xf0, 0xdb, 0x50, 0x61, 0xd0, 0x26, 0xcb, 0x47, 0x6f, 0x42,
+	0x7d, 0xfa, 0xd8, 0xa4, 0xe8, 0x8b, 0x15, 0xc2, 0x30, 0xc3, 0x5a, 0x5d, 0x5b, 0xb3, 0x9a, 0xdc,
+	0xb5, 0xc4, 0xf8, 0x4a, 0x59, 0xe3, 0xbb, 0xb1, 0xc0, 0xb8, 0xf4, 0x6a, 0x2a, 0xbf, 0x0f, 0x0b,
+	0x71, 0x83, 0x89, 0x6f, 0x1e, 0xd2, 0x59, 0xbd, 0x79, 0xfc, 0x29, 0x17, 0xb9, 0x5a, 0xdf, 0xc5,
+	0x0e, 0xb5, 0x9c, 0x16, 0x6e, 0x11, 0xf6, 0xd4, 0x79, 0xee, 0x57, 0xeb, 0x9f, 0x00, 0xb8, 0xc8,
+	0xd3, 0x91, 0x43, 0x35, 0x13, 0xf1, 0xae, 0xaa, 0xc6, 0x3c, 0xfb, 0xe7, 0x93, 0xd2, 0x72, 0x20,
+	0x4c, 0x8c, 0xf7, 0x2a, 0x16, 0xae, 0x36, 0x35, 0x7a, 0x50, 0xb9, 0x87, 0x4c, 0x4d, 0x3f, 0xde,
+	0x42, 0xfa, 0xe3, 0x47, 0x6b, 0xc0, 0x75, 0x6f, 0x21, 0x5d, 0x8d, 0x28, 0x91, 0x5f, 0x87, 0x02,
+	0x3a, 0x72, 0x2d, 0xef, 0x78, 0xe8, 0x9c, 0xe4, 0xf8, 0xd4, 0xfb, 0x67, 0x3c, 0x4e, 0x22, 0x77,
+	0x3e, 0x8d, 0x5e, 0xe1, 0x4e, 0x29, 0x96, 0xa7, 0xb3, 0xbb, 0x7b, 0xbc, 0xf9, 0x2a, 0x07, 0xa5,
+	0x3e, 0x54, 0x45, 0xfe, 0x6d, 0xc3, 0xb4, 0xee, 0xcf, 0xa3, 0x13, 0x1c, 0x2f, 0x53, 0xa1, 0xa8,
+	0xbf, 0xbb, 0x2f, 0xc3, 0xac, 0x50, 0x75, 0x80, 0x2c, 0xf3, 0x80, 0xf2, 0xa3, 0x65, 0x26, 0x1c,
+	0xfe, 0x91, 0x3f, 0x9a, 0xec, 0xee, 0x68, 0xe6, 0x62, 0xf6, 0x6b, 0x09, 0x8a, 0x87, 0x16, 0x3d,
+	0x30, 0x3c, 0xed, 0xd0, 0x69, 0x68, 0xb6, 0x8d, 0x75, 0x8d, 0xf5, 0xc1, 0xfb, 0x2d, 0xa7, 0xf3,
+	0xa4, 0x72, 0x8a, 0xbb, 0x69, 0x41, 0x18, 0xbb, 0x13, 0xda, 0x62, 0xa1, 0x2c, 0x3f, 0xf0, 0x4f,
+	0xee, 0x9f, 0xf3, 0xc9, 0xae, 0x94, 0x38, 0xe3, 0xc2, 0xf2, 0x91, 0x04, 0xab, 0x7d, 0x8d, 0x9f,
+	0x6b, 0x91, 0xb9, 0xfe, 0xf7, 0x79, 0x18, 0xad, 0x13, 0x53, 0x3e, 0x84, 0xb9, 0xde, 0x0f, 0x42,
+	0xdf, 0xef, 0xfb, 0xb6, 0x9e, 0xf0, 0xc5, 0x45, 0x79, 0x35, 0x0b, 0x5a, 0x78, 0xf9, 0x00, 0xe4,
+	0x84, 0x6f, 0x33, 0x6b, 0x29, 0xba, 0x7a, 0xe1, 0xca, 0x6b, 0x99, 0xe0, 0xc2, 0xf6, 0x07, 0x12,
+	0xcc, 0x27, 0x1e, 0xf8, 0xd5, 0x14, 0x7d, 0x49, 0x02, 0xca, 0x7a, 0x46, 0x01, 0x41, 0x01, 0xc1,
+	0x64, 0xf4, 0x44, 0xfb, 0x5e, 0x9a, 0x23, 0x1d, 0x9c, 0x52, 0x19, 0x0e, 0x17, 0xf3, 0x34, 0xf1,
+	0x20, 0x49, 0xf3, 0x34, 0x49, 0x40, 0x59, 0xcf, 0x28, 0x20, 0x28, 0xfc, 0x46, 0x82, 0x85, 0x3e,
+	0xdb, 0xad, 0x96, 0xa2, 0x33, 0x59, 0x44, 0xf9, 0x41, 0x66, 0x91, 0x78, 0x2c, 0x92, 0x0e, 0x82,
+	0xd4, 0x58, 0x24, 0x08, 0x28, 0xeb, 0x19, 0x05, 0x04, 0x85, 0xdf, 0x4a, 0x50, 0xec, 0xfb, 0x2d,
+	0xee, 0xc6, 0xb0, 0xc9, 0x1c, 0x11, 0x52, 0x6e, 0x9d, 0x40, 0x48, 0xd0, 0xa1, 0x70, 0xa1, 0xe7,
+	0x0b, 0xd4, 0xcb, 0x29, 0x0a, 0xbb, 0xc1, 0xca, 0x8d, 0x0c, 0x60, 0x61, 0xd5, 0x81, 0x99, 0xae,
+	0xef, 0x39, 0x57, 0xd3, 0x76, 0x51, 0x0c, 0xaa, 0xd4, 0x86, 0x86, 0x46, 0xbd, 0xec, 0xf9, 0xee,
+	0xf2, 0x72, 0xba, 0x9a, 0x18, 0x58, 0xb9, 0x91, 0x01, 0x9c, 0xb0, 0xf3, 0xba, 0x5e, 0x8e, 0x07,
+	0xef, 0xbc, 0xb8, 0x80, 0xb2, 0x9e, 0x51, 0x40, 0x50, 0x78, 0x1f, 0x9e, 0x4f, 0x7a, 0x78, 0x4f,
+	0xab, 0x21, 0x09, 0x78, 0xe5, 0x66, 0x36, 0x7c, 0xc2, 0x7e, 0xcb, 0x12, 0x81, 0x04, 0x01, 0x65,
+	0x3d, 0xa3, 0x80, 0xa0, 0x70, 0x00, 0x53, 0xb1, 0xe7, 0xef, 0xcb, 0x29, 0x8a, 0xa2, 0x40, 0xa5,
+	0x3a, 0x24, 0x30, 0x9a, 0xd4, 0x5d, 0x0f, 0xcd, 0x57, 0x07, 0xa8, 0xe8, 0x40, 0x95, 0xda, 0xd0,
+	0xd0, 0xa8, 0x67, 0xb1, 0xb7, 0xd4, 0x34, 0xcf, 0xa2, 0x40, 0xa5, 0x3a, 0x24, 0x30, 0xea, 0x59,
+	0xd7, 0x5b, 0xdc, 0xd5, 0xd4, 0x84, 0x88, 0x42, 0x95, 0xda, 0xd0, 0x50, 0x61, 0xef, 0x97, 0x30,
+	0xdb, 0xfd, 0x8c, 0x75, 0x2d, 0x7d, 0x03, 0x46, 0xb1, 0xca, 0xf5, 0xe1, 0xb1, 0x51, 0x17, 0xbb,
+	0x1e, 0x9e, 0xd2, 0x5c, 0x8c, 0x43, 0x95, 0xda, 0xd0, 0xd0, 0xe8, 0xe2, 0xc5, 0x5e, 0x68, 0xd2,
+	0x16, 0x2f, 0x0a, 0x54, 0xaa, 0x43, 0x02, 0x85, 0xa5, 0x3d, 0x80, 0xc8, 0x1b, 0xca, 0x77, 0x53,
+	0xc4, 0x3b, 0x30, 0x65, 0x6d, 0x28, 0x58, 0x68, 0x43, 0x19, 0xfb, 0xe0, 0xeb, 0xcf, 0xae, 0x49,
+	0x9b, 0xb7, 0x3e, 0x7f, 0xba, 0x22, 0x7d, 0xf1, 0x74, 0x45, 0xfa, 0xd7, 0xd3, 0x15, 0xe9, 0xe3,
+	0x67, 0x2b, 0x23, 0x5f, 0x3c, 0x5b, 0x19, 0xf9, 0xc7, 0xb3, 0x95, 0x91, 0x5f, 0xac, 0xc6, 0x2e,
+	0x8a, 0x47, 0xf1, 0xff, 0x19, 0xe5, 0x37, 0xa7, 0x7b, 0x05, 0x7f, 0xec, 0xc6, 0x7f, 0x06, 0x00,
+	0x14, 0x07, 0x11, 0x45, 0xac, 0x25, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// FundCommunityPool defines a method to allow an account to directly
+	// fund the community pool.
+	FundCommunityPool(ctx context.Context, in *MsgFundCommunityPool, opts ...grpc.CallOption) (*MsgFundCommunityPoolResponse, error)
+	// CommunityPoolSpend defines a governance operation for sending tokens from
+	// the community pool in the x/protocolpool module to another account, which
+	// could be the governance module itself. The authority is defined in the
+	// keeper.
+	CommunityPoolSpend(ctx context.Context, in *MsgCommunityPoolSpend, opts ...grpc.CallOption) (*MsgCommunityPoolSpendResponse, error)
+	// SubmitBudgetProposal defines a method to set a budget proposal.
+	SubmitBudgetProposal(ctx context.Context, in *MsgSubmitBudgetProposal, opts ...grpc.CallOption) (*MsgSubmitBudgetProposalResponse, error)
+	// ClaimBudget defines a method to claim the distributed budget.
+	ClaimBudget(ctx context.Context, in *MsgClaimBudget, opts ...grpc.CallOption) (*MsgClaimBudgetResponse, error)
+	// CreateContinuousFund defines a method to add funds continuously.
+	CreateContinuousFund(ctx context.Context, in *MsgCreateContinuousFund, opts ...grpc.CallOption) (*MsgCreateContinuousFundResponse, error)
+	// WithdrawContinuousFund defines a method to withdraw continuous fund allocated.
+	WithdrawContinuousFund(ctx context.Context, in *MsgWithdrawContinuousFund, opts ...grpc.CallOption) (*MsgWithdrawContinuousFundResponse, error)
+	// CancelContinuousFund defines a method for cancelling continuous fund.
+	CancelContinuousFund(ctx context.Context, in *MsgCancelContinuousFund, opts ...grpc.CallOption) (*MsgCancelContinuousFundResponse, error)
+	// CommunityPoolEscrowSpend defines a governance operation for moving tokens from
+	// the community pool to an escrow, from which they are released to the
+	// recipient milestone by milestone. The authority is defined in the keeper.
+	CommunityPoolEscrowSpend(ctx context.Context, in *MsgCommunityPoolEscrowSpend, opts ...grpc.CallOption) (*MsgCommunityPoolEscrowSpendResponse, error)
+	// ApproveMilestone defines a method for the reviewer or the authority to approve
+	// a milestone of an escrowed spend and release its funds to the recipient.
+	ApproveMilestone(ctx context.Context, in *MsgApproveMilestone, opts ...grpc.CallOption) (*MsgApproveMilestoneResponse, error)
+	// SetSpendLimits defines a governance operation for setting the per-denom
+	// limits of the community pool spends. The authority is defined in the keeper.
+	SetSpendLimits(ctx context.Context, in *MsgSetSpendLimits, opts ...grpc.CallOption) (*MsgSetSpendLimitsResponse, error)
+	// SetDenomPolicies defines a governance operation for setting the policies
+	// applied to the community pool holdings of unwanted denoms. The authority is
+	// defined in the keeper.
+	SetDenomPolicies(ctx context.Context, in *MsgSetDenomPolicies, opts ...grpc.CallOption) (*MsgSetDenomPoliciesResponse, error)
+	// CreateRecurringGrant defines a governance operation for paying a fixed amount
+	// from the community pool to a recipient every period. The authority is defined
+	// in the keeper.
+	CreateRecurringGrant(ctx context.Context, in *MsgCreateRecurringGrant, opts ...grpc.CallOption) (*MsgCreateRecurringGrantResponse, error)
+	// RenewRecurringGrant defines a governance operation for continuing a recurring
+	// grant until its next renewal. It is the message of the renewal proposals. The
+	// authority is defined in the keeper.
+	RenewRecurringGrant(ctx context.Context, in *MsgRenewRecurringGrant, opts ...grpc.CallOption) (*MsgRenewRecurringGrantResponse, error)
+	// CancelRecurringGrant defines a governance operation for cancelling a recurring
+	// grant. The authority is defined in the keeper.
+	CancelRecurringGrant(ctx context.Context, in *MsgCancelRecurringGrant, opts ...grpc.CallOption) (*MsgCancelRecurringGrantResponse, error)
+	// PoolDelegate defines a governance operation for delegating community pool
+	// funds to a validator. The authority is defined in the keeper.
+	PoolDelegate(ctx context.Context, in *MsgPoolDelegate, opts ...grpc.CallOption) (*MsgPoolDelegateResponse, error)
+	// PoolUndelegate defines a governance operation for undelegating community pool
+	// funds from a validator. The authority is defined in the keeper.
+	PoolUndelegate(ctx context.Context, in *MsgPoolUndelegate, opts ...grpc.CallOption) (*MsgPoolUndelegateResponse, error)
+	// OpenPosition defines a governance operation for recording an investment
+	// position of the community pool, optionally sending its principal out of the
+	// community pool. The authority is defined in the keeper.
+	OpenPosition(ctx context.Context, in *MsgOpenPosition, opts ...grpc.CallOption) (*MsgOpenPositionResponse, error)
+	// ReportPosition defines a method for the reporter of a position, or the
+	// authority, to report its current value and to close it.
+	ReportPosition(ctx context.Context, in *MsgReportPosition, opts ...grpc.CallOption) (*MsgReportPositionResponse, error)
+	// SetFreezeParams defines a governance operation for setting who can freeze the
+	// community pool outflows in an emergency, and for how long. The authority is
+	// defined in the keeper.
+	SetFreezeParams(ctx context.Context, in *MsgSetFreezeParams, opts ...grpc.CallOption) (*MsgSetFreezeParamsResponse, error)
+	// FreezeOutflows defines a method for the security authority, or the authority,
+	// to freeze the community pool outflows for a bounded duration.
+	FreezeOutflows(ctx context.Context, in *MsgFreezeOutflows, opts ...grpc.CallOption) (*MsgFreezeOutflowsResponse, error)
+	// RatifyFreeze defines a governance operation for ratifying the active freeze
+	// of the community pool outflows. It is the message of the ratification
+	// proposals. The authority is defined in the keeper.
+	RatifyFreeze(ctx context.Context, in *MsgRatifyFreeze, opts ...grpc.CallOption) (*MsgRatifyFreezeResponse, error)
+	// LiftFreeze defines a method for the authority, or the security authority, to
+	// lift the active freeze of the community pool outflows.
+	LiftFreeze(ctx context.Context, in *MsgLiftFreeze, opts ...grpc.CallOption) (*MsgLiftFreezeResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) FundCommunityPool(ctx context.Context, in *MsgFundCommunityPool, opts ...grpc.CallOption) (*MsgFundCommunityPoolResponse, error) {
+	out := new(MsgFundCommunityPoolResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/FundCommunityPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CommunityPoolSpend(ctx context.Context, in *MsgCommunityPoolSpend, opts ...grpc.CallOption) (*MsgCommunityPoolSpendResponse, error) {
+	out := new(MsgCommunityPoolSpendResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/CommunityPoolSpend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitBudgetProposal(ctx context.Context, in *MsgSubmitBudgetProposal, opts ...grpc.CallOption) (*MsgSubmitBudgetProposalResponse, error) {
+	out := new(MsgSubmitBudgetProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/SubmitBudgetProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimBudget(ctx context.Context, in *MsgClaimBudget, opts ...grpc.CallOption) (*MsgClaimBudgetResponse, error) {
+	out := new(MsgClaimBudgetResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/ClaimBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateContinuousFund(ctx context.Context, in *MsgCreateContinuousFund, opts ...grpc.CallOption) (*MsgCreateContinuousFundResponse, error) {
+	out := new(MsgCreateContinuousFundResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/CreateContinuousFund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawContinuousFund(ctx context.Context, in *MsgWithdrawContinuousFund, opts ...grpc.CallOption) (*MsgWithdrawContinuousFundResponse, error) {
+	out := new(MsgWithdrawContinuousFundResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/WithdrawContinuousFund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelContinuousFund(ctx context.Context, in *MsgCancelContinuousFund, opts ...grpc.CallOption) (*MsgCancelContinuousFundResponse, error) {
+	out := new(MsgCancelContinuousFundResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/CancelContinuousFund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CommunityPoolEscrowSpend(ctx context.Context, in *MsgCommunityPoolEscrowSpend, opts ...grpc.CallOption) (*MsgCommunityPoolEscrowSpendResponse, error) {
+	out := new(MsgCommunityPoolEscrowSpendResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/CommunityPoolEscrowSpend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveMilestone(ctx context.Context, in *MsgApproveMilestone, opts ...grpc.CallOption) (*MsgApproveMilestoneResponse, error) {
+	out := new(MsgApproveMilestoneResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/ApproveMilestone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSpendLimits(ctx context.Context, in *MsgSetSpendLimits, opts ...grpc.CallOption) (*MsgSetSpendLimitsResponse, error) {
+	out := new(MsgSetSpendLimitsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/SetSpendLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomPolicies(ctx context.Context, in *MsgSetDenomPolicies, opts ...grpc.CallOption) (*MsgSetDenomPoliciesResponse, error) {
+	out := new(MsgSetDenomPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/SetDenomPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateRecurringGrant(ctx context.Context, in *MsgCreateRecurringGrant, opts ...grpc.CallOption) (*MsgCreateRecurringGrantResponse, error) {
+	out := new(MsgCreateRecurringGrantResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/CreateRecurringGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RenewRecurringGrant(ctx context.Context, in *MsgRenewRecurringGrant, opts ...grpc.CallOption) (*MsgRenewRecurringGrantResponse, error) {
+	out := new(MsgRenewRecurringGrantResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/RenewRecurringGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelRecurringGrant(ctx context.Context, in *MsgCancelRecurringGrant, opts ...grpc.CallOption) (*MsgCancelRecurringGrantResponse, error) {
+	out := new(MsgCancelRecurringGrantResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/CancelRecurringGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PoolDelegate(ctx context.Context, in *MsgPoolDelegate, opts ...grpc.CallOption) (*MsgPoolDelegateResponse, error) {
+	out := new(MsgPoolDelegateResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/PoolDelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PoolUndelegate(ctx context.Context, in *MsgPoolUndelegate, opts ...grpc.CallOption) (*MsgPoolUndelegateResponse, error) {
+	out := new(MsgPoolUndelegateResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/PoolUndelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) OpenPosition(ctx context.Context, in *MsgOpenPosition, opts ...grpc.CallOption) (*MsgOpenPositionResponse, error) {
+	out := new(MsgOpenPositionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/OpenPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReportPosition(ctx context.Context, in *MsgReportPosition, opts ...grpc.CallOption) (*MsgReportPositionResponse, error) {
+	out := new(MsgReportPositionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/ReportPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFreezeParams(ctx context.Context, in *MsgSetFreezeParams, opts ...grpc.CallOption) (*MsgSetFreezeParamsResponse, error) {
+	out := new(MsgSetFreezeParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/SetFreezeParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FreezeOutflows(ctx context.Context, in *MsgFreezeOutflows, opts ...grpc.CallOption) (*MsgFreezeOutflowsResponse, error) {
+	out := new(MsgFreezeOutflowsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/FreezeOutflows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RatifyFreeze(ctx context.Context, in *MsgRatifyFreeze, opts ...grpc.CallOption) (*MsgRatifyFreezeResponse, error) {
+	out := new(MsgRatifyFreezeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/RatifyFreeze", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LiftFreeze(ctx context.Context, in *MsgLiftFreeze, opts ...grpc.CallOption) (*MsgLiftFreezeResponse, error) {
+	out := new(MsgLiftFreezeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/LiftFreeze", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// FundCommunityPool defines a method to allow an account to directly
+	// fund the community pool.
+	FundCommunityPool(context.Context, *MsgFundCommunityPool) (*MsgFundCommunityPoolResponse, error)
+	// CommunityPoolSpend defines a governance operation for sending tokens from
+	// the community pool in the x/protocolpool module to another account, which
+	// could be the governance module itself. The authority is defined in the
+	// keeper.
+	CommunityPoolSpend(context.Context, *MsgCommunityPoolSpend) (*MsgCommunityPoolSpendResponse, error)
+	// SubmitBudgetProposal defines a method to set a budget proposal.
+	SubmitBudgetProposal(context.Context, *MsgSubmitBudgetProposal) (*MsgSubmitBudgetProposalResponse, error)
+	// ClaimBudget defines a method to claim the distributed budget.
+	ClaimBudget(context.Context, *MsgClaimBudget) (*MsgClaimBudgetResponse, error)
+	// CreateContinuousFund defines a method to add funds continuously.
+	CreateContinuousFund(context.Context, *MsgCreateContinuousFund) (*MsgCreateContinuousFundResponse, error)
+	// WithdrawContinuousFund defines a method to withdraw continuous fund allocated.
+	WithdrawContinuousFund(context.Context, *MsgWithdrawContinuousFund) (*MsgWithdrawContinuousFundResponse, error)
+	// CancelContinuousFund defines a method for cancelling continuous fund.
+	CancelContinuousFund(context.Context, *MsgCancelContinuousFund) (*MsgCancelContinuousFundResponse, error)
+	// CommunityPoolEscrowSpend defines a governance operation for moving tokens from
+	// the community pool to an escrow, from which they are released to the
+	// recipient milestone by milestone. The authority is defined in the keeper.
+	CommunityPoolEscrowSpend(context.Context, *MsgCommunityPoolEscrowSpend) (*MsgCommunityPoolEscrowSpendResponse, error)
+	// ApproveMilestone defines a method for the reviewer or the authority to approve
+	// a milestone of an escrowed spend and release its funds to the recipient.
+	ApproveMilestone(context.Context, *MsgApproveMilestone) (*MsgApproveMilestoneResponse, error)
+	// SetSpendLimits defines a governance operation for setting the per-denom
+	// limits of the community pool spends. The authority is defined in the keeper.
+	SetSpendLimits(context.Context, *MsgSetSpendLimits) (*MsgSetSpendLimitsResponse, error)
+	// SetDenomPolicies defines a governance operation for setting the policies
+	// applied to the community pool holdings of unwanted denoms. The authority is
+	// defined in the keeper.
+	SetDenomPolicies(context.Context, *MsgSetDenomPolicies) (*MsgSetDenomPoliciesResponse, error)
+	// CreateRecurringGrant defines a governance operation for paying a fixed amount
+	// from the community pool to a recipient every period. The authority is defined
+	// in the keeper.
+	CreateRecurringGrant(context.Context, *MsgCreateRecurringGrant) (*MsgCreateRecurringGrantResponse, error)
+	// RenewRecurringGrant defines a governance operation for continuing a recurring
+	// grant until its next renewal. It is the message of the renewal proposals. The
+	// authority is defined in the keeper.
+	RenewRecurringGrant(context.Context, *MsgRenewRecurringGrant) (*MsgRenewRecurringGrantResponse, error)
+	// CancelRecurringGrant defines a governance operation for cancelling a recurring
+	// grant. The authority is defined in the keeper.
+	CancelRecurringGrant(context.Context, *MsgCancelRecurringGrant) (*MsgCancelRecurringGrantResponse, error)
+	// PoolDelegate defines a governance operation for delegating community pool
+	// funds to a validator. The authority is defined in the keeper.
+	PoolDelegate(context.Context, *MsgPoolDelegate) (*MsgPoolDelegateResponse, error)
+	// PoolUndelegate defines a governance operation for undelegating community pool
+	// funds from a validator. The authority is defined in the keeper.
+	PoolUndelegate(context.Context, *MsgPoolUndelegate) (*MsgPoolUndelegateResponse, error)
+	// OpenPosition defines a governance operation for recording an investment
+	// position of the community pool, optionally sending its principal out of the
+	// community pool. The authority is defined in the keeper.
+	OpenPosition(context.Context, *MsgOpenPosition) (*MsgOpenPositionResponse, error)
+	// ReportPosition defines a method for the reporter of a position, or the
+	// authority, to report its current value and to close it.
+	ReportPosition(context.Context, *MsgReportPosition) (*MsgReportPositionResponse, error)
+	// SetFreezeParams defines a governance operation for setting who can freeze the
+	// community pool outflows in an emergency, and for how long. The authority is
+	// defined in the keeper.
+	SetFreezeParams(context.Context, *MsgSetFreezeParams) (*MsgSetFreezeParamsResponse, error)
+	// FreezeOutflows defines a method for the security authority, or the authority,
+	// to freeze the community pool outflows for a bounded duration.
+	FreezeOutflows(context.Context, *MsgFreezeOutflows) (*MsgFreezeOutflowsResponse, error)
+	// RatifyFreeze defines a governance operation for ratifying the active freeze
+	// of the community pool outflows. It is the message of the ratification
+	// proposals. The authority is defined in the keeper.
+	RatifyFreeze(context.Context, *MsgRatifyFreeze) (*MsgRatifyFreezeResponse, error)
+	// LiftFreeze defines a method for the authority, or the security authority, to
+	// lift the active freeze of the community pool outflows.
+	LiftFreeze(context.Context, *MsgLiftFreeze) (*MsgLiftFreezeResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) FundCommunityPool(ctx context.Context, req *MsgFundCommunityPool) (*MsgFundCommunityPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundCommunityPool not implemented")
+}
+func (*UnimplementedMsgServer) CommunityPoolSpend(ctx context.Context, req *MsgCommunityPoolSpend) (*MsgCommunityPoolSpendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommunityPoolSpend not implemented")
+}
+func (*UnimplementedMsgServer) SubmitBudgetProposal(ctx context.Context, req *MsgSubmitBudgetProposal) (*MsgSubmitBudgetProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBudgetProposal not implemented")
+}
+func (*UnimplementedMsgServer) ClaimBudget(ctx context.Context, req *MsgClaimBudget) (*MsgClaimBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimBudget not implemented")
+}
+func (*UnimplementedMsgServer) CreateContinuousFund(ctx context.Context, req *MsgCreateContinuousFund) (*MsgCreateContinuousFundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateContinuousFund not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawContinuousFund(ctx context.Context, req *MsgWithdrawContinuousFund) (*MsgWithdrawContinuousFundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawContinuousFund not implemented")
+}
+func (*UnimplementedMsgServer) CancelContinuousFund(ctx context.Context, req *MsgCancelContinuousFund) (*MsgCancelContinuousFundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelContinuousFund not implemented")
+}
+func (*UnimplementedMsgServer) CommunityPoolEscrowSpend(ctx context.Context, req *MsgCommunityPoolEscrowSpend) (*MsgCommunityPoolEscrowSpendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommunityPoolEscrowSpend not implemented")
+}
+func (*UnimplementedMsgServer) ApproveMilestone(ctx context.Context, req *MsgApproveMilestone) (*MsgApproveMilestoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveMilestone not implemented")
+}
+func (*UnimplementedMsgServer) SetSpendLimits(ctx context.Context, req *MsgSetSpendLimits) (*MsgSetSpendLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSpendLimits not implemented")
+}
+func (*UnimplementedMsgServer) SetDenomPolicies(ctx context.Context, req *MsgSetDenomPolicies) (*MsgSetDenomPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomPolicies not implemented")
+}
+func (*UnimplementedMsgServer) CreateRecurringGrant(ctx context.Context, req *MsgCreateRecurringGrant) (*MsgCreateRecurringGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecurringGrant not implemented")
+}
+func (*UnimplementedMsgServer) RenewRecurringGrant(ctx context.Context, req *MsgRenewRecurringGrant) (*MsgRenewRecurringGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewRecurringGrant not implemented")
+}
+func (*UnimplementedMsgServer) CancelRecurringGrant(ctx context.Context, req *MsgCancelRecurringGrant) (*MsgCancelRecurringGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRecurringGrant not implemented")
+}
+func (*UnimplementedMsgServer) PoolDelegate(ctx context.Context, req *MsgPoolDelegate) (*MsgPoolDelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolDelegate not implemented")
+}
+func (*UnimplementedMsgServer) PoolUndelegate(ctx context.Context, req *MsgPoolUndelegate) (*MsgPoolUndelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolUndelegate not implemented")
+}
+func (*UnimplementedMsgServer) OpenPosition(ctx context.Context, req *MsgOpenPosition) (*MsgOpenPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenPosition not implemented")
+}
+func (*UnimplementedMsgServer) ReportPosition(ctx context.Context, req *MsgReportPosition) (*MsgReportPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportPosition not implemented")
+}
+func (*UnimplementedMsgServer) SetFreezeParams(ctx context.Context, req *MsgSetFreezeParams) (*MsgSetFreezeParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFreezeParams not implemented")
+}
+func (*UnimplementedMsgServer) FreezeOutflows(ctx context.Context, req *MsgFreezeOutflows) (*MsgFreezeOutflowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeOutflows not implemented")
+}
+func (*UnimplementedMsgServer) RatifyFreeze(ctx context.Context, req *MsgRatifyFreeze) (*MsgRatifyFreezeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RatifyFreeze not implemented")
+}
+func (*UnimplementedMsgServer) LiftFreeze(ctx context.Context, req *MsgLiftFreeze) (*MsgLiftFreezeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiftFreeze not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_FundCommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundCommunityPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundCommunityPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Msg/FundCommunityPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundCommunityPool(ctx, req.(*MsgFundCommunityPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CommunityPoolSpend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommunityPoolSpend)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommunityPoolSpend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Msg/CommunityPoolSpend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommunityPoolSpend(ctx, req.(*MsgCommunityPoolSpend))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitBudgetProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitBudgetProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitBudgetProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Msg/SubmitBudgetProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitBudgetProposal(ctx, req.(*MsgSubmitBudgetProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimBudget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Msg/ClaimBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimBudget(ctx, req.(*MsgClaimBudget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateContinuousFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateContinuousFund)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateContinuousFund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Msg/CreateContinuousFund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateContinuousFund(ctx, req.(*MsgCreateContinuousFund))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawContinuousFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawContinuousFund)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawContinuousFund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Msg/WithdrawContinuousFund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawContinuousFund(ctx, req.(*MsgWithdrawContinuousFund))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelContinuousFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelContinuousFund)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelContinuousFund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Msg/CancelContinuousFund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelContinuousFund(ctx, req.(*MsgCancelContinuousFund))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CommunityPoolEscrowSpend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommunityPoolEscrowSpend)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommunityPoolEscrowSpend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Msg/CommunityPoolEscrowSpend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommunityPoolEscrowSpend(ctx, req.(*MsgCommunityPoolEscrowSpend))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveMilestone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveMilestone)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveMilestone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Msg/ApproveMilestone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveMilestone(ctx, req.(*MsgApproveMilestone))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSpendLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSpendLimits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSpendLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Msg/SetSpendLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSpendLimits(ctx, req.(*MsgSetSpendLimits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomPolicies)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Msg/SetDenomPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomPolicies(ctx, req.(*MsgSetDenomPolicies))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateRecurringGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateRecurringGrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateRecurringGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Msg/CreateRecurringGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateRecurringGrant(ctx, req.(*MsgCreateRecurringGrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RenewRecurringGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRenewRecurringGrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RenewRecurringGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Msg/RenewRecurringGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RenewRecurringGrant(ctx, req.(*MsgRenewRecurringGrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelRecurringGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRecurringGrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRecurringGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Msg/CancelRecurringGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRecurringGrant(ctx, req.(*MsgCancelRecurringGrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PoolDelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPoolDelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PoolDelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Msg/PoolDelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PoolDelegate(ctx, req.(*MsgPoolDelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PoolUndelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPoolUndelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PoolUndelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Msg/PoolUndelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PoolUndelegate(ctx, req.(*MsgPoolUndelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OpenPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOpenPosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OpenPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Msg/OpenPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OpenPosition(ctx, req.(*MsgOpenPosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReportPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReportPosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReportPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Msg/ReportPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReportPosition(ctx, req.(*MsgReportPosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFreezeParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFreezeParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFreezeParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Msg/SetFreezeParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFreezeParams(ctx, req.(*MsgSetFreezeParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FreezeOutflows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeOutflows)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeOutflows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Msg/FreezeOutflows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeOutflows(ctx, req.(*MsgFreezeOutflows))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RatifyFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRatifyFreeze)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RatifyFreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Msg/RatifyFreeze",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RatifyFreeze(ctx, req.(*MsgRatifyFreeze))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LiftFreeze_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiftFreeze)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LiftFreeze(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Msg/LiftFreeze",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LiftFreeze(ctx, req.(*MsgLiftFreeze))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.protocolpool.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FundCommunityPool",
+			Handler:    _Msg_FundCommunityPool_Handler,
+		},
+		{
+			MethodName: "CommunityPoolSpend",
+			Handler:    _Msg_CommunityPoolSpend_Handler,
+		},
+		{
+			MethodName: "SubmitBudgetProposal",
+			Handler:    _Msg_SubmitBudgetProposal_Handler,
+		},
+		{
+			MethodName: "ClaimBudget",
+			Handler:    _Msg_ClaimBudget_Handler,
+		},
+		{
+			MethodName: "CreateContinuousFund",
+			Handler:    _Msg_CreateContinuousFund_Handler,
+		},
+		{
+			MethodName: "WithdrawContinuousFund",
+			Handler:    _Msg_WithdrawContinuousFund_Handler,
+		},
+		{
+			MethodName: "CancelContinuousFund",
+			Handler:    _Msg_CancelContinuousFund_Handler,
+		},
+		{
+			MethodName: "CommunityPoolEscrowSpend",
+			Handler:    _Msg_CommunityPoolEscrowSpend_Handler,
+		},
+		{
+			MethodName: "ApproveMilestone",
+			Handler:    _Msg_ApproveMilestone_Handler,
+		},
+		{
+			MethodName: "SetSpendLimits",
+			Handler:    _Msg_SetSpendLimits_Handler,
+		},
+		{
+			MethodName: "SetDenomPolicies",
+			Handler:    _Msg_SetDenomPolicies_Handler,
+		},
+		{
+			MethodName: "CreateRecurringGrant",
+			Handler:    _Msg_CreateRecurringGrant_Handler,
+		},
+		{
+			MethodName: "RenewRecurringGrant",
+			Handler:    _Msg_RenewRecurringGrant_Handler,
+		},
+		{
+			MethodName: "CancelRecurringGrant",
+			Handler:    _Msg_CancelRecurringGrant_Handler,
+		},
+		{
+			MethodName: "PoolDelegate",
+			Handler:    _Msg_PoolDelegate_Handler,
+		},
+		{
+			MethodName: "PoolUndelegate",
+			Handler:    _Msg_PoolUndelegate_Handler,
+		},
+		{
+			MethodName: "OpenPosition",
+			Handler:    _Msg_OpenPosition_Handler,
+		},
+		{
+			MethodName: "ReportPosition",
+			Handler:    _Msg_ReportPosition_Handler,
+		},
+		{
+			MethodName: "SetFreezeParams",
+			Handler:    _Msg_SetFreezeParams_Handler,
+		},
+		{
+			MethodName: "FreezeOutflows",
+			Handler:    _Msg_FreezeOutflows_Handler,
+		},
+		{
+			MethodName: "RatifyFreeze",
+			Handler:    _Msg_RatifyFreeze_Handler,
+		},
+		{
+			MethodName: "LiftFreeze",
+			Handler:    _Msg_LiftFreeze_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/protocolpool/v1/tx.proto",
+}
+
+func (m *MsgFundCommunityPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundCommunityPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundCommunityPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundCommunityPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundCommunityPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommunityPoolSpend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommunityPoolSpend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommunityPoolSpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommunityPoolSpendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommunityPoolSpendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommunityPoolSpendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommunityPoolEscrowSpend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommunityPoolEscrowSpend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommunityPoolEscrowSpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Milestones) > 0 {
+		for iNdEx := len(m.Milestones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Milestones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Reviewer) > 0 {
+		i -= len(m.Reviewer)
+		copy(dAtA[i:], m.Reviewer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reviewer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommunityPoolEscrowSpendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommunityPoolEscrowSpendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommunityPoolEscrowSpendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveMilestone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveMilestone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveMilestone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MilestoneIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MilestoneIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EscrowId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EscrowId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Approver) > 0 {
+		i -= len(m.Approver)
+		copy(dAtA[i:], m.Approver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Approver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveMilestoneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveMilestoneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveMilestoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSpendLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSpendLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSpendLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpendLimits) > 0 {
+		for iNdEx := len(m.SpendLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSpendLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSpendLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSpendLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomPolicies) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomPolicies) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomPolicies) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomPolicies) > 0 {
+		for iNdEx := len(m.DenomPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomPoliciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomPoliciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomPoliciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateRecurringGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRecurringGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRecurringGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RenewalDeposit) > 0 {
+		for iNdEx := len(m.RenewalDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RenewalDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RenewalInterval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RenewalInterval):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateRecurringGrantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRecurringGrantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRecurringGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenewRecurringGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenewRecurringGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenewRecurringGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenewRecurringGrantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenewRecurringGrantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenewRecurringGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRecurringGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRecurringGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRecurringGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRecurringGrantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRecurringGrantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRecurringGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPoolDelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPoolDelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPoolDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPoolDelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPoolDelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPoolDelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPoolUndelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPoolUndelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPoolUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPoolUndelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPoolUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPoolUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTx(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Maturity != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Maturity, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Maturity):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintTx(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ExpectedReturn) > 0 {
+		for iNdEx := len(m.ExpectedReturn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpectedReturn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Principal) > 0 {
+		for iNdEx := len(m.Principal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Principal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Venue) > 0 {
+		i -= len(m.Venue)
+		copy(dAtA[i:], m.Venue)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Venue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Note) > 0 {
+		i -= len(m.Note)
+		copy(dAtA[i:], m.Note)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Note)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Close {
+		i--
+		if m.Close {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CurrentValue) > 0 {
+		for iNdEx := len(m.CurrentValue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrentValue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFreezeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFreezeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFreezeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFreezeParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFreezeParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFreezeParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeOutflows) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeOutflows) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeOutflows) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintTx(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeOutflowsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeOutflowsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeOutflowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RatificationProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RatificationProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintTx(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRatifyFreeze) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRatifyFreeze) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRatifyFreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.FreezeStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FreezeStartTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintTx(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRatifyFreezeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRatifyFreezeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRatifyFreezeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiftFreeze) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiftFreeze) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiftFreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiftFreezeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiftFreezeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiftFreezeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitBudgetProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitBudgetProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitBudgetProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Period != nil {
+		n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Period):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintTx(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Tranches != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Tranches))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartTime != nil {
+		n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintTx(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TotalBudget != nil {
+		{
+			size, err := m.TotalBudget.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitBudgetProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitBudgetProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitBudgetProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimBudget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimBudgetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimBudgetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateContinuousFund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateContinuousFund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateContinuousFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiry != nil {
+		n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry):])
+		if err17 != nil {
+			return 0, err17
+		}
+		i -= n17
+		i = encodeVarintTx(dAtA, i, uint64(n17))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Percentage.Size()
+		i -= size
+		if _, err := m.Percentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateContinuousFundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateContinuousFundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateContinuousFundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelContinuousFund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelContinuousFund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelContinuousFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelContinuousFundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelContinuousFundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelContinuousFundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.WithdrawnAllocatedFund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CanceledHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CanceledHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	n19, err19 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CanceledTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CanceledTime):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintTx(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawContinuousFund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawContinuousFund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawContinuousFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawContinuousFundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawContinuousFundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawContinuousFundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgFundCommunityPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFundCommunityPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCommunityPoolSpend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCommunityPoolSpendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCommunityPoolEscrowSpend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reviewer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Milestones) > 0 {
+		for _, e := range m.Milestones {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCommunityPoolEscrowSpendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgApproveMilestone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Approver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EscrowId != 0 {
+		n += 1 + sovTx(uint64(m.EscrowId))
+	}
+	if m.MilestoneIndex != 0 {
+		n += 1 + sovTx(uint64(m.MilestoneIndex))
+	}
+	return n
+}
+
+func (m *MsgApproveMilestoneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetSpendLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SpendLimits) > 0 {
+		for _, e := range m.SpendLimits {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetSpendLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetDenomPolicies) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DenomPolicies) > 0 {
+		for _, e := range m.DenomPolicies {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetDenomPoliciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateRecurringGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RenewalInterval)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.RenewalDeposit) > 0 {
+		for _, e := range m.RenewalDeposit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateRecurringGrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRenewRecurringGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRenewRecurringGrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelRecurringGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelRecurringGrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPoolDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPoolDelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPoolUndelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPoolUndelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgOpenPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Venue)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Principal) > 0 {
+		for _, e := range m.Principal {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ExpectedReturn) > 0 {
+		for _, e := range m.ExpectedReturn {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Maturity != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Maturity)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgOpenPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgReportPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	if len(m.CurrentValue) > 0 {
+		for _, e := range m.CurrentValue {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Close {
+		n += 2
+	}
+	l = len(m.Note)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReportPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetFreezeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetFreezeParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFreezeOutflows) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFreezeOutflowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	if m.RatificationProposalId != 0 {
+		n += 1 + sovTx(uint64(m.RatificationProposalId))
+	}
+	return n
+}
+
+func (m *MsgRatifyFreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FreezeStartTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRatifyFreezeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLiftFreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLiftFreezeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitBudgetProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TotalBudget != nil {
+		l = m.TotalBudget.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Tranches != 0 {
+		n += 1 + sovTx(uint64(m.Tranches))
+	}
+	if m.Period != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Period)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitBudgetProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimBudgetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateContinuousFund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Percentage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Expiry != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateContinuousFundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelContinuousFund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelContinuousFundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CanceledTime)
+	n += 1 + l + sovTx(uint64(l))
+	if m.CanceledHeight != 0 {
+		n += 1 + sovTx(uint64(m.CanceledHeight))
+	}
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.WithdrawnAllocatedFund.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawContinuousFund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawContinuousFundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundCommunityPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundCommunityPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundCommunityPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundCommunityPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundCommunityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommunityPoolSpend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommunityPoolSpend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommunityPoolSpend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommunityPoolSpendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommunityPoolSpendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommunityPoolSpendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommunityPoolEscrowSpend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommunityPoolEscrowSpend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommunityPoolEscrowSpend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reviewer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reviewer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Milestones = append(m.Milestones, Milestone{})
+			if err := m.Milestones[len(m.Milestones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommunityPoolEscrowSpendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommunityPoolEscrowSpendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommunityPoolEscrowSpendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveMilestone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveMilestone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveMilestone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowId", wireType)
+			}
+			m.EscrowId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EscrowId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MilestoneIndex", wireType)
+			}
+			m.MilestoneIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MilestoneIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveMilestoneResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveMilestoneResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveMilestoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSpendLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSpendLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSpendLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimits = append(m.SpendLimits, SpendLimit{})
+			if err := m.SpendLimits[len(m.SpendLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSpendLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSpendLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSpendLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDenomPolicies) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDenomPolicies: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDenomPolicies: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomPolicies = append(m.DenomPolicies, DenomPolicy{})
+			if err := m.DenomPolicies[len(m.DenomPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDenomPoliciesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDenomPoliciesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDenomPoliciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateRecurringGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRecurringGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRecurringGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenewalInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RenewalInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenewalDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RenewalDeposit = append(m.RenewalDeposit, types.Coin{})
+			if err := m.RenewalDeposit[len(m.RenewalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateRecurringGrantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRecurringGrantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRecurringGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRenewRecurringGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenewRecurringGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenewRecurringGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRenewRecurringGrantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenewRecurringGrantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenewRecurringGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRecurringGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRecurringGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRecurringGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRecurringGrantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRecurringGrantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRecurringGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPoolDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPoolDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPoolDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPoolDelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPoolDelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPoolDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPoolUndelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPoolUndelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPoolUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPoolUndelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPoolUndelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPoolUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Venue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Venue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Principal = append(m.Principal, types.Coin{})
+			if err := m.Principal[len(m.Principal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedReturn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedReturn = append(m.ExpectedReturn, types.Coin{})
+			if err := m.ExpectedReturn[len(m.ExpectedReturn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maturity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Maturity == nil {
+				m.Maturity = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Maturity, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenPositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenPositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReportPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReportPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReportPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentValue = append(m.CurrentValue, types.Coin{})
+			if err := m.CurrentValue[len(m.CurrentValue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Close", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Close = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Note", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Note = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReportPositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReportPositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReportPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFreezeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFreezeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFreezeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFreezeParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFreezeParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFreezeParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgWithdrawContinuousFundResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
+func (m *MsgFreezeOutflows) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1962,15 +8978,47 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundCommunityPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFreezeOutflows: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundCommunityPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFreezeOutflows: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1997,14 +9045,13 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2032,7 +9079,7 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2055,7 +9102,7 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundCommunityPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFreezeOutflowsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2078,12 +9125,64 @@ func (m *MsgFundCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundCommunityPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFreezeOutflowsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundCommunityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFreezeOutflowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RatificationProposalId", wireType)
+			}
+			m.RatificationProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RatificationProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2105,7 +9204,7 @@ func (m *MsgFundCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCommunityPoolSpend) Unmarshal(dAtA []byte) error {
+func (m *MsgRatifyFreeze) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2128,10 +9227,10 @@ func (m *MsgCommunityPoolSpend) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCommunityPoolSpend: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRatifyFreeze: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCommunityPoolSpend: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRatifyFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2168,9 +9267,9 @@ func (m *MsgCommunityPoolSpend) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FreezeStartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2180,29 +9279,130 @@ func (m *MsgCommunityPoolSpend) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.FreezeStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRatifyFreezeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRatifyFreezeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRatifyFreezeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiftFreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiftFreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiftFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2212,25 +9412,23 @@ func (m *MsgCommunityPoolSpend) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2253,7 +9451,7 @@ func (m *MsgCommunityPoolSpend) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCommunityPoolSpendResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLiftFreezeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2276,10 +9474,10 @@ func (m *MsgCommunityPoolSpendResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCommunityPoolSpendResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLiftFreezeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCommunityPoolSpendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLiftFreezeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
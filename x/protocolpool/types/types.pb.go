@@ -7,6 +7,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -31,6 +32,74 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DenomPolicyAction defines how the community pool disposes of the holdings of a
+// denom.
+type DenomPolicyAction int32
+
+const (
+	// DENOM_POLICY_ACTION_UNSPECIFIED defines an invalid action.
+	DenomPolicyActionUnspecified DenomPolicyAction = 0
+	// DENOM_POLICY_ACTION_BURN burns the holdings of the denom.
+	DenomPolicyActionBurn DenomPolicyAction = 1
+	// DENOM_POLICY_ACTION_SWAP swaps the holdings of the denom through the
+	// registered swap hook.
+	DenomPolicyActionSwap DenomPolicyAction = 2
+)
+
+var DenomPolicyAction_name = map[int32]string{
+	0: "DENOM_POLICY_ACTION_UNSPECIFIED",
+	1: "DENOM_POLICY_ACTION_BURN",
+	2: "DENOM_POLICY_ACTION_SWAP",
+}
+
+var DenomPolicyAction_value = map[string]int32{
+	"DENOM_POLICY_ACTION_UNSPECIFIED": 0,
+	"DENOM_POLICY_ACTION_BURN":        1,
+	"DENOM_POLICY_ACTION_SWAP":        2,
+}
+
+func (x DenomPolicyAction) String() string {
+	return proto.EnumName(DenomPolicyAction_name, int32(x))
+}
+
+func (DenomPolicyAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{0}
+}
+
+// PositionStatus defines the status of a community pool investment position.
+type PositionStatus int32
+
+const (
+	// POSITION_STATUS_UNSPECIFIED defines an invalid status.
+	PositionStatusUnspecified PositionStatus = 0
+	// POSITION_STATUS_OPEN defines a position whose capital is still deployed.
+	PositionStatusOpen PositionStatus = 1
+	// POSITION_STATUS_CLOSED defines a position whose capital has been withdrawn
+	// from the venue. Closed positions are kept as a record and can no longer be
+	// reported on.
+	PositionStatusClosed PositionStatus = 2
+)
+
+var PositionStatus_name = map[int32]string{
+	0: "POSITION_STATUS_UNSPECIFIED",
+	1: "POSITION_STATUS_OPEN",
+	2: "POSITION_STATUS_CLOSED",
+}
+
+var PositionStatus_value = map[string]int32{
+	"POSITION_STATUS_UNSPECIFIED": 0,
+	"POSITION_STATUS_OPEN":        1,
+	"POSITION_STATUS_CLOSED":      2,
+}
+
+func (x PositionStatus) String() string {
+	return proto.EnumName(PositionStatus_name, int32(x))
+}
+
+func (PositionStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{1}
+}
+
 // Budget defines the fields of a budget proposal.
 type Budget struct {
 	// recipient_address is the address of the recipient who can claim the budget.
@@ -200,304 +269,5007 @@ func (m *ContinuousFund) GetExpiry() *time.Time {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Budget)(nil), "cosmos.protocolpool.v1.Budget")
-	proto.RegisterType((*ContinuousFund)(nil), "cosmos.protocolpool.v1.ContinuousFund")
+// Milestone defines a deliverable of an escrowed spend and the amount released
+// to the recipient once it is approved.
+type Milestone struct {
+	// description describes the deliverable of the milestone.
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	// amount is the amount released to the recipient once the milestone is approved.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// approved is true once the milestone has been approved and its amount released.
+	Approved bool `protobuf:"varint,3,opt,name=approved,proto3" json:"approved,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("cosmos/protocolpool/v1/types.proto", fileDescriptor_c1b7d0ea246d7f44)
+func (m *Milestone) Reset()         { *m = Milestone{} }
+func (m *Milestone) String() string { return proto.CompactTextString(m) }
+func (*Milestone) ProtoMessage()    {}
+func (*Milestone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{2}
+}
+func (m *Milestone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Milestone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Milestone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Milestone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Milestone.Merge(m, src)
+}
+func (m *Milestone) XXX_Size() int {
+	return m.Size()
+}
+func (m *Milestone) XXX_DiscardUnknown() {
+	xxx_messageInfo_Milestone.DiscardUnknown(m)
 }
 
-var fileDescriptor_c1b7d0ea246d7f44 = []byte{
-	// 531 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x14, 0x8c, 0x69, 0x08, 0xcd, 0xb6, 0x29, 0x60, 0x55, 0xc8, 0x0d, 0x92, 0x13, 0xc2, 0x25, 0x97,
-	0xda, 0x0a, 0x48, 0x80, 0x04, 0x12, 0xd4, 0x09, 0x15, 0x87, 0x5e, 0x08, 0x9c, 0xb8, 0x58, 0x1b,
-	0xfb, 0xc5, 0x5d, 0x61, 0xfb, 0x59, 0xbb, 0xeb, 0x28, 0xf9, 0x8b, 0x1e, 0xf9, 0x90, 0x7e, 0x44,
-	0x8f, 0x55, 0x05, 0x12, 0xe2, 0x50, 0x50, 0xf2, 0x23, 0xc8, 0xeb, 0x4d, 0x48, 0x7b, 0x09, 0xb7,
-	0xf5, 0xbc, 0x99, 0xf1, 0xec, 0x3c, 0x2d, 0xe9, 0x04, 0x28, 0x12, 0x14, 0x6e, 0xc6, 0x51, 0x62,
-	0x80, 0x71, 0x86, 0x18, 0xbb, 0x93, 0x9e, 0x2b, 0x67, 0x19, 0x08, 0x47, 0xa1, 0xe6, 0xa3, 0x92,
-	0xe3, 0xac, 0x73, 0x9c, 0x49, 0xaf, 0xb9, 0x1f, 0x61, 0x84, 0x0a, 0x74, 0x8b, 0x53, 0x39, 0x6f,
-	0x1e, 0x94, 0x6c, 0xbf, 0x1c, 0xac, 0x4b, 0x9b, 0xb6, 0xfe, 0xd9, 0x88, 0x0a, 0x70, 0x27, 0xbd,
-	0x11, 0x48, 0xda, 0x73, 0x03, 0x64, 0xa9, 0x9e, 0xb7, 0x22, 0xc4, 0x28, 0x86, 0x32, 0xcc, 0x28,
-	0x1f, 0xbb, 0x92, 0x25, 0x20, 0x24, 0x4d, 0xb2, 0xa5, 0xc1, 0x6d, 0x42, 0x98, 0x73, 0x2a, 0x19,
-	0x6a, 0x83, 0xce, 0xf7, 0x2d, 0x52, 0xf3, 0xf2, 0x30, 0x02, 0x69, 0xbe, 0x27, 0x0f, 0x39, 0x04,
-	0x2c, 0x63, 0x90, 0x4a, 0x9f, 0x86, 0x21, 0x07, 0x21, 0x2c, 0xa3, 0x6d, 0x74, 0xeb, 0x9e, 0x75,
-	0x75, 0x7e, 0xb8, 0xaf, 0x83, 0x1d, 0x95, 0x93, 0x4f, 0x92, 0xb3, 0x34, 0x1a, 0x3e, 0x58, 0x49,
-	0x34, 0x6e, 0xbe, 0x21, 0xbb, 0x12, 0x25, 0x8d, 0xfd, 0x91, 0xb2, 0xb5, 0xee, 0xb4, 0x8d, 0xee,
-	0xce, 0xb3, 0x03, 0x47, 0xcb, 0x8b, 0x9b, 0x38, 0xfa, 0x26, 0x4e, 0x1f, 0x59, 0x3a, 0xdc, 0x51,
-	0x74, 0x1d, 0xe2, 0x1d, 0xd9, 0x0b, 0x62, 0xca, 0x12, 0x08, 0x7d, 0x9a, 0x60, 0x9e, 0x4a, 0x6b,
-	0x6b, 0x93, 0xbe, 0xa1, 0x05, 0x47, 0x8a, 0x6f, 0xbe, 0x25, 0x44, 0x48, 0xca, 0xa5, 0x5f, 0x54,
-	0x61, 0x55, 0x95, 0xba, 0xe9, 0x94, 0x35, 0x38, 0xcb, 0x1a, 0x9c, 0xcf, 0xcb, 0x9e, 0xbc, 0xea,
-	0xd9, 0xef, 0x96, 0x31, 0xac, 0x2b, 0x4d, 0x81, 0x9a, 0x1f, 0xc8, 0xfd, 0x14, 0xa6, 0xd2, 0x57,
-	0xb6, 0xfe, 0x98, 0x63, 0x62, 0xdd, 0xfd, 0x4f, 0x97, 0x46, 0x21, 0xec, 0x17, 0xba, 0x63, 0x8e,
-	0x89, 0xd9, 0x24, 0xdb, 0x92, 0xd3, 0x34, 0x38, 0x05, 0x61, 0xd5, 0xda, 0x46, 0xb7, 0x3a, 0x5c,
-	0x7d, 0x9b, 0x4f, 0x49, 0x63, 0x79, 0xf6, 0x63, 0x18, 0x4b, 0xeb, 0x9e, 0x22, 0xec, 0x2e, 0xc1,
-	0x13, 0x18, 0x4b, 0xf3, 0x25, 0xa9, 0x65, 0xc0, 0x19, 0x86, 0xd6, 0xb6, 0x6e, 0xe1, 0x76, 0x82,
-	0x81, 0x5e, 0xa7, 0x57, 0xfd, 0x56, 0x04, 0xd0, 0xf4, 0xce, 0x0f, 0x83, 0xec, 0xf5, 0x31, 0x95,
-	0x2c, 0xcd, 0x31, 0x17, 0xc7, 0x79, 0x1a, 0x9a, 0x2f, 0x48, 0x7d, 0xb5, 0xab, 0x8d, 0x6b, 0xfd,
-	0x47, 0x35, 0x3f, 0x12, 0x92, 0x01, 0x0f, 0x20, 0x95, 0x34, 0x02, 0xb5, 0xcd, 0xba, 0xd7, 0xbb,
-	0xb8, 0x6e, 0x55, 0x7e, 0x5d, 0xb7, 0x1e, 0x97, 0x62, 0x11, 0x7e, 0x75, 0x18, 0xba, 0x09, 0x95,
-	0xa7, 0xce, 0x09, 0x44, 0x34, 0x98, 0x0d, 0x20, 0xb8, 0x3a, 0x3f, 0x24, 0xda, 0x7b, 0x00, 0xc1,
-	0x70, 0xcd, 0xc4, 0x7c, 0x45, 0x6a, 0x30, 0xcd, 0x18, 0x9f, 0xe9, 0xe5, 0x6e, 0x2e, 0x56, 0xf3,
-	0xbd, 0xd7, 0x17, 0x73, 0xdb, 0xb8, 0x9c, 0xdb, 0xc6, 0x9f, 0xb9, 0x6d, 0x9c, 0x2d, 0xec, 0xca,
-	0xe5, 0xc2, 0xae, 0xfc, 0x5c, 0xd8, 0x95, 0x2f, 0x4f, 0x6e, 0x44, 0x99, 0xde, 0x7c, 0x9e, 0xea,
-	0x6d, 0x8e, 0x6a, 0x0a, 0x7b, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0xb0, 0x0b, 0xb8, 0xdb, 0xc2,
-	0x03, 0x00, 0x00,
+var xxx_messageInfo_Milestone proto.InternalMessageInfo
+
+func (m *Milestone) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-func (m *Budget) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Milestone) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Budget) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Milestone) GetApproved() bool {
+	if m != nil {
+		return m.Approved
+	}
+	return false
 }
 
-func (m *Budget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Period != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Period):])
-		if err1 != nil {
-			return 0, err1
+// EscrowedSpend defines community pool funds approved by governance that are held
+// in escrow and released milestone by milestone.
+type EscrowedSpend struct {
+	// id is the unique identifier of the escrowed spend.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// recipient is the address of the account receiving the released funds.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// reviewer is the address allowed to approve milestones besides the authority.
+	// If empty, only the authority can approve milestones.
+	Reviewer string `protobuf:"bytes,3,opt,name=reviewer,proto3" json:"reviewer,omitempty"`
+	// milestones are the deliverables of the escrowed spend.
+	Milestones []Milestone `protobuf:"bytes,4,rep,name=milestones,proto3" json:"milestones"`
+	// deadline is the time after which the funds of the milestones not yet approved
+	// are clawed back to the community pool.
+	Deadline time.Time `protobuf:"bytes,5,opt,name=deadline,proto3,stdtime" json:"deadline"`
+}
+
+func (m *EscrowedSpend) Reset()         { *m = EscrowedSpend{} }
+func (m *EscrowedSpend) String() string { return proto.CompactTextString(m) }
+func (*EscrowedSpend) ProtoMessage()    {}
+func (*EscrowedSpend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{3}
+}
+func (m *EscrowedSpend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EscrowedSpend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EscrowedSpend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n1
-		i = encodeVarintTypes(dAtA, i, uint64(n1))
-		i--
-		dAtA[i] = 0x42
+		return b[:n], nil
 	}
-	if m.TranchesLeft != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.TranchesLeft))
-		i--
-		dAtA[i] = 0x38
+}
+func (m *EscrowedSpend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowedSpend.Merge(m, src)
+}
+func (m *EscrowedSpend) XXX_Size() int {
+	return m.Size()
+}
+func (m *EscrowedSpend) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowedSpend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscrowedSpend proto.InternalMessageInfo
+
+func (m *EscrowedSpend) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if m.Tranches != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Tranches))
-		i--
-		dAtA[i] = 0x30
+	return 0
+}
+
+func (m *EscrowedSpend) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	if m.NextClaimFrom != nil {
-		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.NextClaimFrom, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextClaimFrom):])
-		if err2 != nil {
-			return 0, err2
-		}
-		i -= n2
-		i = encodeVarintTypes(dAtA, i, uint64(n2))
-		i--
-		dAtA[i] = 0x2a
+	return ""
+}
+
+func (m *EscrowedSpend) GetReviewer() string {
+	if m != nil {
+		return m.Reviewer
 	}
-	if m.StartTime != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
-		if err3 != nil {
-			return 0, err3
-		}
-		i -= n3
-		i = encodeVarintTypes(dAtA, i, uint64(n3))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *EscrowedSpend) GetMilestones() []Milestone {
+	if m != nil {
+		return m.Milestones
 	}
-	if m.ClaimedAmount != nil {
-		{
-			size, err := m.ClaimedAmount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *EscrowedSpend) GetDeadline() time.Time {
+	if m != nil {
+		return m.Deadline
 	}
-	if m.TotalBudget != nil {
-		{
-			size, err := m.TotalBudget.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+	return time.Time{}
+}
+
+// SpendLimit defines the maximum amount of a denom which can be spent from the
+// community pool within a spend window.
+type SpendLimit struct {
+	// denom is the denom the limit applies to.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// limit is the maximum amount of the denom spent within a spend window.
+	Limit cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=limit,proto3,customtype=cosmossdk.io/math.Int" json:"limit"`
+	// window is the duration of a spend window.
+	Window time.Duration `protobuf:"bytes,3,opt,name=window,proto3,stdduration" json:"window"`
+}
+
+func (m *SpendLimit) Reset()         { *m = SpendLimit{} }
+func (m *SpendLimit) String() string { return proto.CompactTextString(m) }
+func (*SpendLimit) ProtoMessage()    {}
+func (*SpendLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{4}
+}
+func (m *SpendLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpendLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpendLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.RecipientAddress) > 0 {
-		i -= len(m.RecipientAddress)
-		copy(dAtA[i:], m.RecipientAddress)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.RecipientAddress)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *SpendLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendLimit.Merge(m, src)
+}
+func (m *SpendLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpendLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendLimit proto.InternalMessageInfo
+
+func (m *SpendLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ContinuousFund) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SpendLimit) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ContinuousFund) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SpendWindow tracks the amount of a denom spent from the community pool within
+// the current spend window.
+type SpendWindow struct {
+	// denom is the denom spent.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// start is the start time of the spend window.
+	Start time.Time `protobuf:"bytes,2,opt,name=start,proto3,stdtime" json:"start"`
+	// spent is the amount of the denom spent within the spend window.
+	Spent cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=spent,proto3,customtype=cosmossdk.io/math.Int" json:"spent"`
 }
 
-func (m *ContinuousFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Expiry != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry):])
-		if err6 != nil {
-			return 0, err6
+func (m *SpendWindow) Reset()         { *m = SpendWindow{} }
+func (m *SpendWindow) String() string { return proto.CompactTextString(m) }
+func (*SpendWindow) ProtoMessage()    {}
+func (*SpendWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{5}
+}
+func (m *SpendWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpendWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpendWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n6
-		i = encodeVarintTypes(dAtA, i, uint64(n6))
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	{
-		size := m.Percentage.Size()
-		i -= size
-		if _, err := m.Percentage.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+}
+func (m *SpendWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendWindow.Merge(m, src)
+}
+func (m *SpendWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpendWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendWindow proto.InternalMessageInfo
+
+func (m *SpendWindow) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *SpendWindow) GetStart() time.Time {
+	if m != nil {
+		return m.Start
 	}
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+// SpendAllowance defines the remaining amount of a denom which can be spent from
+// the community pool within the current spend window.
+type SpendAllowance struct {
+	// limit is the spend limit of the denom.
+	Limit SpendLimit `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit"`
+	// spent is the amount of the denom spent within the current spend window.
+	Spent cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=spent,proto3,customtype=cosmossdk.io/math.Int" json:"spent"`
+	// remaining is the amount of the denom which can still be spent within the
+	// current spend window.
+	Remaining cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=remaining,proto3,customtype=cosmossdk.io/math.Int" json:"remaining"`
+	// window_end is the end time of the current spend window. It is unset if no
+	// funds have been spent within a spend window yet.
+	WindowEnd *time.Time `protobuf:"bytes,4,opt,name=window_end,json=windowEnd,proto3,stdtime" json:"window_end,omitempty"`
+}
+
+func (m *SpendAllowance) Reset()         { *m = SpendAllowance{} }
+func (m *SpendAllowance) String() string { return proto.CompactTextString(m) }
+func (*SpendAllowance) ProtoMessage()    {}
+func (*SpendAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{6}
+}
+func (m *SpendAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpendAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpendAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *Budget) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SpendAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendAllowance.Merge(m, src)
+}
+func (m *SpendAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpendAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendAllowance proto.InternalMessageInfo
+
+func (m *SpendAllowance) GetLimit() SpendLimit {
+	if m != nil {
+		return m.Limit
 	}
-	var l int
-	_ = l
-	l = len(m.RecipientAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return SpendLimit{}
+}
+
+func (m *SpendAllowance) GetWindowEnd() *time.Time {
+	if m != nil {
+		return m.WindowEnd
 	}
-	if m.TotalBudget != nil {
-		l = m.TotalBudget.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	return nil
+}
+
+// DenomPolicy defines the action applied to the community pool holdings of a
+// denom at the end of every block.
+type DenomPolicy struct {
+	// denom is the denom the policy applies to.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// action is the action applied to the holdings of the denom.
+	Action DenomPolicyAction `protobuf:"varint,2,opt,name=action,proto3,enum=cosmos.protocolpool.v1.DenomPolicyAction" json:"action,omitempty"`
+}
+
+func (m *DenomPolicy) Reset()         { *m = DenomPolicy{} }
+func (m *DenomPolicy) String() string { return proto.CompactTextString(m) }
+func (*DenomPolicy) ProtoMessage()    {}
+func (*DenomPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{7}
+}
+func (m *DenomPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ClaimedAmount != nil {
-		l = m.ClaimedAmount.Size()
-		n += 1 + l + sovTypes(uint64(l))
+}
+func (m *DenomPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomPolicy.Merge(m, src)
+}
+func (m *DenomPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomPolicy proto.InternalMessageInfo
+
+func (m *DenomPolicy) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if m.StartTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
-		n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+func (m *DenomPolicy) GetAction() DenomPolicyAction {
+	if m != nil {
+		return m.Action
 	}
-	if m.NextClaimFrom != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextClaimFrom)
-		n += 1 + l + sovTypes(uint64(l))
+	return DenomPolicyActionUnspecified
+}
+
+// FundingSource defines the cumulative amount which entered the community pool
+// from a funding source.
+type FundingSource struct {
+	// source is the funding source. It is "distribution" for the community tax,
+	// "direct" for funds sent by regular accounts, or the name of the module which
+	// sent the funds, e.g. "gov" for charged proposal deposits.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// amount is the cumulative amount which entered the community pool from the
+	// funding source.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *FundingSource) Reset()         { *m = FundingSource{} }
+func (m *FundingSource) String() string { return proto.CompactTextString(m) }
+func (*FundingSource) ProtoMessage()    {}
+func (*FundingSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{8}
+}
+func (m *FundingSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundingSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundingSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Tranches != 0 {
-		n += 1 + sovTypes(uint64(m.Tranches))
+}
+func (m *FundingSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingSource.Merge(m, src)
+}
+func (m *FundingSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundingSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundingSource proto.InternalMessageInfo
+
+func (m *FundingSource) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	if m.TranchesLeft != 0 {
-		n += 1 + sovTypes(uint64(m.TranchesLeft))
+	return ""
+}
+
+func (m *FundingSource) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	if m.Period != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Period)
-		n += 1 + l + sovTypes(uint64(l))
+	return nil
+}
+
+// RecurringGrant defines community pool funds approved by governance that are paid
+// to a recipient every period, until a renewal proposal to continue the grant is
+// rejected.
+type RecurringGrant struct {
+	// recipient is the address of the account receiving the payments.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the amount paid to the recipient every period.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// period is the duration between two payments.
+	Period time.Duration `protobuf:"bytes,3,opt,name=period,proto3,stdduration" json:"period"`
+	// next_payment is the time of the next payment.
+	NextPayment time.Time `protobuf:"bytes,4,opt,name=next_payment,json=nextPayment,proto3,stdtime" json:"next_payment"`
+	// renewal_interval is the duration between two renewal proposals.
+	RenewalInterval time.Duration `protobuf:"bytes,5,opt,name=renewal_interval,json=renewalInterval,proto3,stdduration" json:"renewal_interval"`
+	// renewal_time is the time at which the next renewal proposal is submitted.
+	RenewalTime time.Time `protobuf:"bytes,6,opt,name=renewal_time,json=renewalTime,proto3,stdtime" json:"renewal_time"`
+	// renewal_deposit is the initial deposit of the renewal proposals, paid from the
+	// community pool.
+	RenewalDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=renewal_deposit,json=renewalDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"renewal_deposit"`
+	// renewal_proposal_id is the id of the pending renewal proposal. It is zero if no
+	// renewal proposal is pending.
+	RenewalProposalId uint64 `protobuf:"varint,8,opt,name=renewal_proposal_id,json=renewalProposalId,proto3" json:"renewal_proposal_id,omitempty"`
+}
+
+func (m *RecurringGrant) Reset()         { *m = RecurringGrant{} }
+func (m *RecurringGrant) String() string { return proto.CompactTextString(m) }
+func (*RecurringGrant) ProtoMessage()    {}
+func (*RecurringGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{9}
+}
+func (m *RecurringGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecurringGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecurringGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *RecurringGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecurringGrant.Merge(m, src)
+}
+func (m *RecurringGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecurringGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecurringGrant.DiscardUnknown(m)
 }
 
-func (m *ContinuousFund) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_RecurringGrant proto.InternalMessageInfo
+
+func (m *RecurringGrant) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	var l int
-	_ = l
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+func (m *RecurringGrant) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	l = m.Percentage.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.Expiry != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry)
-		n += 1 + l + sovTypes(uint64(l))
+	return nil
+}
+
+func (m *RecurringGrant) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
 	}
-	return n
+	return 0
 }
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *RecurringGrant) GetNextPayment() time.Time {
+	if m != nil {
+		return m.NextPayment
+	}
+	return time.Time{}
 }
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *RecurringGrant) GetRenewalInterval() time.Duration {
+	if m != nil {
+		return m.RenewalInterval
+	}
+	return 0
 }
-func (m *Budget) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+
+func (m *RecurringGrant) GetRenewalTime() time.Time {
+	if m != nil {
+		return m.RenewalTime
+	}
+	return time.Time{}
+}
+
+func (m *RecurringGrant) GetRenewalDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RenewalDeposit
+	}
+	return nil
+}
+
+func (m *RecurringGrant) GetRenewalProposalId() uint64 {
+	if m != nil {
+		return m.RenewalProposalId
+	}
+	return 0
+}
+
+// PoolDelegation defines the community pool funds delegated to a validator.
+type PoolDelegation struct {
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// principal is the amount of bond denom delegated by the community pool to the
+	// validator, net of the undelegated amounts. It does not account for slashes.
+	Principal cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=principal,proto3,customtype=cosmossdk.io/math.Int" json:"principal"`
+}
+
+func (m *PoolDelegation) Reset()         { *m = PoolDelegation{} }
+func (m *PoolDelegation) String() string { return proto.CompactTextString(m) }
+func (*PoolDelegation) ProtoMessage()    {}
+func (*PoolDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{10}
+}
+func (m *PoolDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolDelegation.Merge(m, src)
+}
+func (m *PoolDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolDelegation proto.InternalMessageInfo
+
+func (m *PoolDelegation) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// PoolDelegationBalance defines the principal and the current balance of a
+// community pool delegation.
+type PoolDelegationBalance struct {
+	// delegation is the community pool delegation.
+	Delegation PoolDelegation `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation"`
+	// balance is the current amount of bond denom of the delegation, after slashes.
+	Balance cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=balance,proto3,customtype=cosmossdk.io/math.Int" json:"balance"`
+}
+
+func (m *PoolDelegationBalance) Reset()         { *m = PoolDelegationBalance{} }
+func (m *PoolDelegationBalance) String() string { return proto.CompactTextString(m) }
+func (*PoolDelegationBalance) ProtoMessage()    {}
+func (*PoolDelegationBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{11}
+}
+func (m *PoolDelegationBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolDelegationBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolDelegationBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolDelegationBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolDelegationBalance.Merge(m, src)
+}
+func (m *PoolDelegationBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolDelegationBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolDelegationBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolDelegationBalance proto.InternalMessageInfo
+
+func (m *PoolDelegationBalance) GetDelegation() PoolDelegation {
+	if m != nil {
+		return m.Delegation
+	}
+	return PoolDelegation{}
+}
+
+// PoolBalanceChange defines a change of the community pool balance made by the
+// protocolpool module.
+type PoolBalanceChange struct {
+	// height is the block height at which the change was made.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// source is the origin of the change, i.e. the funding source of an inflow or
+	// the operation which moved funds out of the community pool.
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// increase is the amount which entered the community pool.
+	Increase github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=increase,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"increase"`
+	// decrease is the amount which left the community pool.
+	Decrease github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=decrease,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"decrease"`
+}
+
+func (m *PoolBalanceChange) Reset()         { *m = PoolBalanceChange{} }
+func (m *PoolBalanceChange) String() string { return proto.CompactTextString(m) }
+func (*PoolBalanceChange) ProtoMessage()    {}
+func (*PoolBalanceChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{12}
+}
+func (m *PoolBalanceChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolBalanceChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolBalanceChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolBalanceChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolBalanceChange.Merge(m, src)
+}
+func (m *PoolBalanceChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolBalanceChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolBalanceChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolBalanceChange proto.InternalMessageInfo
+
+func (m *PoolBalanceChange) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PoolBalanceChange) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *PoolBalanceChange) GetIncrease() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Increase
+	}
+	return nil
+}
+
+func (m *PoolBalanceChange) GetDecrease() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Decrease
+	}
+	return nil
+}
+
+// Position defines community pool funds deployed into an external protocol,
+// e.g. through an interchain account or a local module.
+type Position struct {
+	// id is the unique identifier of the position.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// venue is a free-form description of where the funds are deployed, e.g.
+	// "osmosis/pool/1".
+	Venue string `protobuf:"bytes,2,opt,name=venue,proto3" json:"venue,omitempty"`
+	// recipient is the address the principal was sent to when the position was
+	// opened. It is empty if the funds were moved out of the community pool by
+	// other means.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// principal is the amount of funds deployed.
+	Principal github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=principal,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"principal"`
+	// expected_return is the amount expected back from the venue at maturity.
+	ExpectedReturn github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=expected_return,json=expectedReturn,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"expected_return"`
+	// maturity is the time at which the position is expected to be closed. It is
+	// empty for open-ended positions.
+	Maturity *time.Time `protobuf:"bytes,6,opt,name=maturity,proto3,stdtime" json:"maturity,omitempty"`
+	// reporter is the address allowed to report on the position besides the
+	// authority.
+	Reporter string `protobuf:"bytes,7,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	// status is the status of the position.
+	Status PositionStatus `protobuf:"varint,8,opt,name=status,proto3,enum=cosmos.protocolpool.v1.PositionStatus" json:"status,omitempty"`
+	// opened_at is the time at which the position was opened.
+	OpenedAt time.Time `protobuf:"bytes,9,opt,name=opened_at,json=openedAt,proto3,stdtime" json:"opened_at"`
+	// current_value is the value of the position as last reported. It is the
+	// principal until the first report.
+	CurrentValue github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=current_value,json=currentValue,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"current_value"`
+	// last_report_time is the time of the last report on the position, empty if the
+	// position has not been reported on yet.
+	LastReportTime *time.Time `protobuf:"bytes,11,opt,name=last_report_time,json=lastReportTime,proto3,stdtime" json:"last_report_time,omitempty"`
+	// last_report_note is the note attached to the last report on the position.
+	LastReportNote string `protobuf:"bytes,12,opt,name=last_report_note,json=lastReportNote,proto3" json:"last_report_note,omitempty"`
+}
+
+func (m *Position) Reset()         { *m = Position{} }
+func (m *Position) String() string { return proto.CompactTextString(m) }
+func (*Position) ProtoMessage()    {}
+func (*Position) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{13}
+}
+func (m *Position) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Position) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Position.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Position) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Position.Merge(m, src)
+}
+func (m *Position) XXX_Size() int {
+	return m.Size()
+}
+func (m *Position) XXX_DiscardUnknown() {
+	xxx_messageInfo_Position.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Position proto.InternalMessageInfo
+
+func (m *Position) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Position) GetVenue() string {
+	if m != nil {
+		return m.Venue
+	}
+	return ""
+}
+
+func (m *Position) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *Position) GetPrincipal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Principal
+	}
+	return nil
+}
+
+func (m *Position) GetExpectedReturn() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ExpectedReturn
+	}
+	return nil
+}
+
+func (m *Position) GetMaturity() *time.Time {
+	if m != nil {
+		return m.Maturity
+	}
+	return nil
+}
+
+func (m *Position) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
+func (m *Position) GetStatus() PositionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PositionStatusUnspecified
+}
+
+func (m *Position) GetOpenedAt() time.Time {
+	if m != nil {
+		return m.OpenedAt
+	}
+	return time.Time{}
+}
+
+func (m *Position) GetCurrentValue() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CurrentValue
+	}
+	return nil
+}
+
+func (m *Position) GetLastReportTime() *time.Time {
+	if m != nil {
+		return m.LastReportTime
+	}
+	return nil
+}
+
+func (m *Position) GetLastReportNote() string {
+	if m != nil {
+		return m.LastReportNote
+	}
+	return ""
+}
+
+// FreezeParams defines who can freeze the community pool outflows in an
+// emergency, and for how long.
+type FreezeParams struct {
+	// security_authority is the address allowed to freeze the community pool
+	// outflows besides the authority. The outflows can only be frozen by the
+	// authority when it is empty.
+	SecurityAuthority string `protobuf:"bytes,1,opt,name=security_authority,json=securityAuthority,proto3" json:"security_authority,omitempty"`
+	// max_duration is the longest duration of a freeze.
+	MaxDuration time.Duration `protobuf:"bytes,2,opt,name=max_duration,json=maxDuration,proto3,stdduration" json:"max_duration"`
+	// ratification_deposit is the initial deposit of the ratification proposals of
+	// the freezes, paid from the community pool.
+	RatificationDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=ratification_deposit,json=ratificationDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"ratification_deposit"`
+}
+
+func (m *FreezeParams) Reset()         { *m = FreezeParams{} }
+func (m *FreezeParams) String() string { return proto.CompactTextString(m) }
+func (*FreezeParams) ProtoMessage()    {}
+func (*FreezeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{14}
+}
+func (m *FreezeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FreezeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FreezeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FreezeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FreezeParams.Merge(m, src)
+}
+func (m *FreezeParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *FreezeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_FreezeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FreezeParams proto.InternalMessageInfo
+
+func (m *FreezeParams) GetSecurityAuthority() string {
+	if m != nil {
+		return m.SecurityAuthority
+	}
+	return ""
+}
+
+func (m *FreezeParams) GetMaxDuration() time.Duration {
+	if m != nil {
+		return m.MaxDuration
+	}
+	return 0
+}
+
+func (m *FreezeParams) GetRatificationDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RatificationDeposit
+	}
+	return nil
+}
+
+// OutflowFreeze defines an active freeze of the community pool outflows. A
+// freeze lasts until its end time, unless its ratification proposal does not
+// pass, in which case it is lifted when the proposal ends.
+type OutflowFreeze struct {
+	// frozen_by is the address of the account which activated the freeze.
+	FrozenBy string `protobuf:"bytes,1,opt,name=frozen_by,json=frozenBy,proto3" json:"frozen_by,omitempty"`
+	// reason is a free-form description of why the outflows were frozen.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// start_time is the time at which the freeze was activated.
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time is the time at which the freeze expires.
+	EndTime time.Time `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// ratification_proposal_id is the id of the proposal ratifying the freeze. It
+	// is zero once the freeze is ratified, or if it was activated by the authority.
+	RatificationProposalId uint64 `protobuf:"varint,5,opt,name=ratification_proposal_id,json=ratificationProposalId,proto3" json:"ratification_proposal_id,omitempty"`
+	// ratified is true if the freeze was ratified by governance, or activated by
+	// the authority.
+	Ratified bool `protobuf:"varint,6,opt,name=ratified,proto3" json:"ratified,omitempty"`
+}
+
+func (m *OutflowFreeze) Reset()         { *m = OutflowFreeze{} }
+func (m *OutflowFreeze) String() string { return proto.CompactTextString(m) }
+func (*OutflowFreeze) ProtoMessage()    {}
+func (*OutflowFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{15}
+}
+func (m *OutflowFreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutflowFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutflowFreeze.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutflowFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutflowFreeze.Merge(m, src)
+}
+func (m *OutflowFreeze) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutflowFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutflowFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutflowFreeze proto.InternalMessageInfo
+
+func (m *OutflowFreeze) GetFrozenBy() string {
+	if m != nil {
+		return m.FrozenBy
+	}
+	return ""
+}
+
+func (m *OutflowFreeze) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *OutflowFreeze) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *OutflowFreeze) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *OutflowFreeze) GetRatificationProposalId() uint64 {
+	if m != nil {
+		return m.RatificationProposalId
+	}
+	return 0
+}
+
+func (m *OutflowFreeze) GetRatified() bool {
+	if m != nil {
+		return m.Ratified
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.protocolpool.v1.DenomPolicyAction", DenomPolicyAction_name, DenomPolicyAction_value)
+	proto.RegisterEnum("cosmos.protocolpool.v1.PositionStatus", PositionStatus_name, PositionStatus_value)
+	proto.RegisterType((*Budget)(nil), "cosmos.protocolpool.v1.Budget")
+	proto.RegisterType((*ContinuousFund)(nil), "cosmos.protocolpool.v1.ContinuousFund")
+	proto.RegisterType((*Milestone)(nil), "cosmos.protocolpool.v1.Milestone")
+	proto.RegisterType((*EscrowedSpend)(nil), "cosmos.protocolpool.v1.EscrowedSpend")
+	proto.RegisterType((*SpendLimit)(nil), "cosmos.protocolpool.v1.SpendLimit")
+	proto.RegisterType((*SpendWindow)(nil), "cosmos.protocolpool.v1.SpendWindow")
+	proto.RegisterType((*SpendAllowance)(nil), "cosmos.protocolpool.v1.SpendAllowance")
+	proto.RegisterType((*DenomPolicy)(nil), "cosmos.protocolpool.v1.DenomPolicy")
+	proto.RegisterType((*FundingSource)(nil), "cosmos.protocolpool.v1.FundingSource")
+	proto.RegisterType((*RecurringGrant)(nil), "cosmos.protocolpool.v1.RecurringGrant")
+	proto.RegisterType((*PoolDelegation)(nil), "cosmos.protocolpool.v1.PoolDelegation")
+	proto.RegisterType((*PoolDelegationBalance)(nil), "cosmos.protocolpool.v1.PoolDelegationBalance")
+	proto.RegisterType((*PoolBalanceChange)(nil), "cosmos.protocolpool.v1.PoolBalanceChange")
+	proto.RegisterType((*Position)(nil), "cosmos.protocolpool.v1.Position")
+	proto.RegisterType((*FreezeParams)(nil), "cosmos.protocolpool.v1.FreezeParams")
+	proto.RegisterType((*OutflowFreeze)(nil), "cosmos.protocolpool.v1.OutflowFreeze")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/protocolpool/v1/types.proto", fileDescriptor_c1b7d0ea246d7f44)
+}
+
+var fileDescriptor_c1b7d0ea246d7f44 = []byte{
+	// 1764 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcf, 0x6f, 0x1b, 0xc7,
+	0x15, 0x16, 0x29, 0x8a, 0xa6, 0x9e, 0x24, 0x46, 0x9a, 0xc8, 0xc2, 0x5a, 0x69, 0x24, 0x9a, 0x05,
+	0x0a, 0x35, 0x85, 0xa9, 0x28, 0x4d, 0x9b, 0xa0, 0x0e, 0x9c, 0xf0, 0x97, 0x5c, 0x16, 0x8a, 0xc4,
+	0x2e, 0xed, 0x04, 0xed, 0x65, 0x31, 0xda, 0x7d, 0xa2, 0x06, 0xd9, 0x9d, 0x59, 0xec, 0x0e, 0x29,
+	0x29, 0x87, 0x9e, 0x0b, 0xa1, 0x87, 0x5c, 0x0a, 0xe4, 0x22, 0x14, 0x68, 0x2f, 0x45, 0x5b, 0xf4,
+	0xe4, 0x53, 0x0f, 0x3d, 0xe7, 0x18, 0x18, 0x2d, 0x50, 0xf4, 0x90, 0x14, 0xf6, 0x7f, 0xd0, 0xbf,
+	0x20, 0x98, 0xd9, 0x59, 0x8a, 0xa4, 0xa3, 0x88, 0x12, 0x6c, 0x9f, 0xa4, 0x99, 0x79, 0xdf, 0x9b,
+	0xf7, 0xbe, 0x37, 0xf3, 0xcd, 0xe3, 0x42, 0xd9, 0x15, 0x71, 0x20, 0xe2, 0xcd, 0x30, 0x12, 0x52,
+	0xb8, 0xc2, 0x0f, 0x85, 0xf0, 0x37, 0xfb, 0x5b, 0x9b, 0xf2, 0x24, 0xc4, 0xb8, 0xa2, 0x67, 0xc9,
+	0x4a, 0x62, 0x53, 0x19, 0xb6, 0xa9, 0xf4, 0xb7, 0x56, 0x97, 0xbb, 0xa2, 0x2b, 0xf4, 0xe4, 0xa6,
+	0xfa, 0x2f, 0x59, 0x5f, 0xbd, 0x95, 0x58, 0x3b, 0xc9, 0xc2, 0x30, 0x74, 0x75, 0xcd, 0x6c, 0xb6,
+	0x4f, 0x63, 0xdc, 0xec, 0x6f, 0xed, 0xa3, 0xa4, 0x5b, 0x9b, 0xae, 0x60, 0xdc, 0xac, 0xaf, 0x77,
+	0x85, 0xe8, 0xfa, 0x98, 0x04, 0xb3, 0xdf, 0x3b, 0xd8, 0x94, 0x2c, 0xc0, 0x58, 0xd2, 0x20, 0x4c,
+	0x1d, 0x8c, 0x1b, 0x78, 0xbd, 0x88, 0x4a, 0x26, 0x8c, 0x83, 0xf2, 0xbf, 0xa6, 0x21, 0x5f, 0xeb,
+	0x79, 0x5d, 0x94, 0xa4, 0x09, 0x4b, 0x11, 0xba, 0x2c, 0x64, 0xc8, 0xa5, 0x43, 0x3d, 0x2f, 0xc2,
+	0x38, 0xb6, 0x32, 0xa5, 0xcc, 0xc6, 0x6c, 0xcd, 0x7a, 0xfc, 0xe8, 0xce, 0xb2, 0x09, 0xac, 0x9a,
+	0xac, 0x74, 0x64, 0xc4, 0x78, 0xd7, 0x5e, 0x1c, 0x40, 0xcc, 0x3c, 0x79, 0x0f, 0xe6, 0xa5, 0x90,
+	0xd4, 0x77, 0xf6, 0xb5, 0x5b, 0x2b, 0x5b, 0xca, 0x6c, 0xcc, 0xbd, 0x75, 0xab, 0x62, 0xe0, 0x2a,
+	0x93, 0x8a, 0xc9, 0xa4, 0x52, 0x17, 0x8c, 0xdb, 0x73, 0xda, 0xdc, 0x04, 0xf1, 0x01, 0x14, 0x5d,
+	0x9f, 0xb2, 0x00, 0x3d, 0x87, 0x06, 0xa2, 0xc7, 0xa5, 0x35, 0x7d, 0x19, 0x7e, 0xc1, 0x00, 0xaa,
+	0xda, 0x9e, 0xbc, 0x0f, 0x10, 0x4b, 0x1a, 0x49, 0x47, 0x51, 0x61, 0xe5, 0x34, 0x7a, 0xb5, 0x92,
+	0xd0, 0x50, 0x49, 0x69, 0xa8, 0x3c, 0x48, 0x79, 0xaa, 0xe5, 0x3e, 0xfb, 0x7a, 0x3d, 0x63, 0xcf,
+	0x6a, 0x8c, 0x9a, 0x25, 0x3f, 0x87, 0x57, 0x38, 0x1e, 0x4b, 0x47, 0xbb, 0x75, 0x0e, 0x22, 0x11,
+	0x58, 0x33, 0x13, 0x7a, 0x59, 0x50, 0xc0, 0xba, 0xc2, 0x6d, 0x47, 0x22, 0x20, 0xab, 0x50, 0x90,
+	0x11, 0xe5, 0xee, 0x21, 0xc6, 0x56, 0xbe, 0x94, 0xd9, 0xc8, 0xd9, 0x83, 0x31, 0xf9, 0x3e, 0x2c,
+	0xa4, 0xff, 0x3b, 0x3e, 0x1e, 0x48, 0xeb, 0x86, 0x36, 0x98, 0x4f, 0x27, 0x77, 0xf0, 0x40, 0x92,
+	0x77, 0x20, 0x1f, 0x62, 0xc4, 0x84, 0x67, 0x15, 0x0c, 0x0b, 0xe3, 0x11, 0x34, 0x4c, 0x39, 0x6b,
+	0xb9, 0xcf, 0x55, 0x00, 0xc6, 0xbc, 0xfc, 0xef, 0x0c, 0x14, 0xeb, 0x82, 0x4b, 0xc6, 0x7b, 0xa2,
+	0x17, 0x6f, 0xf7, 0xb8, 0x47, 0x7e, 0x0a, 0xb3, 0x83, 0x5a, 0x5d, 0x5a, 0xd6, 0x73, 0x53, 0xf2,
+	0x4b, 0x80, 0x10, 0x23, 0x17, 0xb9, 0xa4, 0x5d, 0xd4, 0xd5, 0x9c, 0xad, 0x6d, 0x7d, 0xf1, 0xd5,
+	0xfa, 0xd4, 0x7f, 0xbf, 0x5a, 0x7f, 0x2d, 0x01, 0xc7, 0xde, 0x27, 0x15, 0x26, 0x36, 0x03, 0x2a,
+	0x0f, 0x2b, 0x3b, 0xd8, 0xa5, 0xee, 0x49, 0x03, 0xdd, 0xc7, 0x8f, 0xee, 0x80, 0xf1, 0xdd, 0x40,
+	0xd7, 0x1e, 0x72, 0x42, 0xde, 0x85, 0x3c, 0x1e, 0x87, 0x2c, 0x3a, 0xb1, 0xa6, 0x27, 0x24, 0xd6,
+	0xd8, 0x97, 0xff, 0x9e, 0x81, 0xd9, 0x0f, 0x99, 0x8f, 0xb1, 0x14, 0x1c, 0x49, 0x09, 0xe6, 0x3c,
+	0x8c, 0xdd, 0x88, 0x85, 0x8a, 0x82, 0x24, 0x29, 0x7b, 0x78, 0x8a, 0xb8, 0x90, 0x37, 0xc7, 0x28,
+	0x5b, 0x9a, 0xfe, 0xce, 0x63, 0x54, 0x7b, 0x53, 0xe5, 0xf4, 0x97, 0xaf, 0xd7, 0x37, 0xba, 0x4c,
+	0x1e, 0xf6, 0xf6, 0x2b, 0xae, 0x08, 0xcc, 0x5d, 0x34, 0x7f, 0xee, 0xc4, 0xde, 0x27, 0xe6, 0x96,
+	0x2b, 0x40, 0x6c, 0x1b, 0xd7, 0xaa, 0xcc, 0x34, 0x0c, 0x23, 0xd1, 0x47, 0x4f, 0x27, 0x54, 0xb0,
+	0x07, 0xe3, 0xf2, 0xe7, 0x59, 0x58, 0x68, 0xc6, 0x6e, 0x24, 0x8e, 0xd0, 0xeb, 0x84, 0xc8, 0x3d,
+	0x52, 0x84, 0x2c, 0xf3, 0x74, 0xac, 0x39, 0x3b, 0xcb, 0xc6, 0xea, 0x92, 0x9d, 0xbc, 0x2e, 0x6f,
+	0x43, 0x21, 0xc2, 0x3e, 0xc3, 0x23, 0x8c, 0xac, 0xe9, 0x4b, 0x60, 0x03, 0x4b, 0x72, 0x1f, 0x20,
+	0x48, 0xf9, 0x8b, 0xad, 0x9c, 0x26, 0xe5, 0x76, 0xe5, 0xdb, 0xe5, 0xaa, 0x32, 0x60, 0xba, 0x96,
+	0x53, 0xe4, 0xd8, 0x43, 0x50, 0xf2, 0x01, 0x14, 0x3c, 0xa4, 0x9e, 0xcf, 0x38, 0x4e, 0x70, 0x3d,
+	0x0a, 0x0a, 0xaf, 0x2b, 0x39, 0x40, 0x95, 0xff, 0x98, 0x01, 0xd0, 0x94, 0xec, 0xb0, 0x80, 0x49,
+	0xb2, 0x0c, 0x33, 0x1e, 0x72, 0x11, 0x98, 0x32, 0x26, 0x03, 0x52, 0x85, 0x19, 0x5f, 0x2d, 0x1b,
+	0x66, 0x7e, 0x64, 0x0e, 0xde, 0xcd, 0x67, 0x0f, 0x5e, 0x8b, 0xcb, 0xa1, 0x23, 0xd7, 0xe2, 0xd2,
+	0x4e, 0x90, 0xe4, 0x2e, 0xe4, 0x8f, 0x18, 0xf7, 0xc4, 0xd1, 0x40, 0x4a, 0x2e, 0xbc, 0x44, 0x3a,
+	0xcc, 0xe4, 0x22, 0x25, 0x10, 0x15, 0xe4, 0x9c, 0x0e, 0xf2, 0x63, 0x3d, 0xbe, 0x20, 0xca, 0x9f,
+	0xc1, 0x8c, 0xd6, 0x0f, 0x2b, 0x7b, 0x05, 0x26, 0x12, 0x88, 0xca, 0x30, 0x0e, 0xd1, 0x08, 0xdd,
+	0x55, 0x33, 0xd4, 0xc8, 0xf2, 0x1f, 0xb2, 0x50, 0xd4, 0x41, 0x56, 0x7d, 0x5f, 0x1c, 0x51, 0xee,
+	0x22, 0xb9, 0x97, 0xf2, 0x96, 0xd1, 0x11, 0x95, 0x2f, 0x2a, 0xf1, 0x79, 0x01, 0x4c, 0x8d, 0x0d,
+	0x69, 0x83, 0xa8, 0xb2, 0xd7, 0x8d, 0x8a, 0xb4, 0xd4, 0xc1, 0x0e, 0x28, 0xe3, 0x8c, 0x77, 0xaf,
+	0x93, 0xdc, 0x39, 0x5a, 0x69, 0x7a, 0x52, 0x0f, 0x07, 0xb9, 0x37, 0xb9, 0xa6, 0x27, 0x98, 0x26,
+	0xf7, 0xca, 0x07, 0x30, 0xd7, 0x50, 0x95, 0x6a, 0x0b, 0x9f, 0xb9, 0x27, 0x17, 0x9e, 0xb5, 0x3c,
+	0x75, 0xb5, 0x92, 0xa8, 0xa4, 0x8b, 0x6f, 0xfd, 0xf0, 0x22, 0xd2, 0x86, 0x5c, 0x55, 0x35, 0xc0,
+	0x36, 0xc0, 0xf2, 0xef, 0x32, 0xb0, 0xa0, 0xd4, 0x96, 0xf1, 0x6e, 0x47, 0xf4, 0x22, 0x17, 0xc9,
+	0x0a, 0xe4, 0x63, 0xfd, 0x9f, 0xd9, 0xcb, 0x8c, 0x5e, 0x8a, 0x32, 0x95, 0xff, 0x9f, 0x83, 0xa2,
+	0x8d, 0x6e, 0x2f, 0x52, 0x2a, 0x70, 0x3f, 0xa2, 0x5c, 0x5e, 0xfb, 0x19, 0x78, 0x29, 0x4a, 0x7a,
+	0x77, 0xf0, 0xde, 0x5d, 0xe5, 0xaa, 0x26, 0x10, 0x72, 0x1f, 0xe6, 0xf5, 0xbb, 0x1d, 0xd2, 0x93,
+	0x40, 0x25, 0x97, 0xbb, 0xc2, 0x5d, 0x9c, 0x53, 0xc8, 0x76, 0x02, 0x24, 0xbb, 0xb0, 0x18, 0x21,
+	0xc7, 0x23, 0xea, 0x3b, 0x8c, 0x4b, 0x8c, 0xfa, 0xd4, 0xb7, 0x66, 0x26, 0x8f, 0xe7, 0x15, 0x03,
+	0x6e, 0x19, 0xac, 0x0a, 0x2c, 0xf5, 0xa7, 0x7b, 0x92, 0xfc, 0x55, 0x02, 0x33, 0x48, 0xb5, 0x46,
+	0x24, 0xa4, 0xbe, 0x1d, 0x0f, 0x43, 0x11, 0x33, 0xd5, 0x35, 0x3c, 0xf7, 0x62, 0x14, 0xcd, 0x1e,
+	0x8d, 0x64, 0x0b, 0x52, 0x81, 0x57, 0xd3, 0x5d, 0xc3, 0x48, 0x84, 0x22, 0x56, 0xbc, 0x24, 0x1d,
+	0x49, 0xce, 0x5e, 0x32, 0x4b, 0x6d, 0xb3, 0xd2, 0xf2, 0xca, 0x7f, 0xcd, 0x40, 0xb1, 0x2d, 0x84,
+	0xdf, 0x40, 0x1f, 0xbb, 0x9a, 0x1c, 0xb2, 0x0b, 0x4b, 0x7d, 0xea, 0x33, 0x8f, 0x4a, 0x11, 0x8d,
+	0xb5, 0x96, 0xb7, 0x1f, 0x3f, 0xba, 0xf3, 0xba, 0x89, 0xfe, 0xa3, 0xd4, 0x66, 0xac, 0xc7, 0xec,
+	0x8f, 0xcd, 0x2b, 0x69, 0x09, 0x23, 0xc6, 0x5d, 0x16, 0x52, 0xff, 0x3a, 0x0a, 0x75, 0x8e, 0x2e,
+	0xff, 0x2d, 0x03, 0x37, 0x47, 0xa3, 0xad, 0x51, 0x5f, 0x4b, 0xe8, 0x0e, 0x80, 0x37, 0x98, 0x34,
+	0x3a, 0xfa, 0x83, 0x8b, 0x24, 0x61, 0xcc, 0x85, 0x79, 0x2f, 0xcf, 0xf1, 0xa4, 0x09, 0x37, 0xf6,
+	0x13, 0xc7, 0xd7, 0x09, 0x38, 0xc5, 0x96, 0x7f, 0x9f, 0x85, 0x25, 0xb5, 0x97, 0x09, 0xb2, 0x7e,
+	0x48, 0x79, 0x57, 0x8b, 0xcc, 0x21, 0xb2, 0xee, 0x61, 0x72, 0xa3, 0xa7, 0x6d, 0x33, 0x1a, 0x12,
+	0x9f, 0xec, 0x88, 0xf8, 0x74, 0xa1, 0xc0, 0xb8, 0x1b, 0x21, 0x8d, 0xd1, 0x9a, 0x7e, 0xfe, 0x27,
+	0x68, 0xe0, 0x5c, 0x6d, 0xe4, 0xa1, 0xd9, 0x28, 0xf7, 0x02, 0x36, 0x4a, 0x9d, 0x97, 0xff, 0x9c,
+	0x87, 0x42, 0x5b, 0x1d, 0x57, 0xc5, 0xf5, 0x78, 0x8b, 0xb5, 0x0c, 0x33, 0x7d, 0xe4, 0xbd, 0x94,
+	0x85, 0x64, 0x30, 0xaa, 0x84, 0xd3, 0x93, 0x2b, 0x21, 0x1b, 0x3e, 0x7c, 0x2f, 0x20, 0xa9, 0x73,
+	0xef, 0xea, 0xc2, 0xe3, 0x71, 0x88, 0xae, 0x44, 0xcf, 0x89, 0x50, 0xf6, 0x22, 0x6e, 0xcd, 0xbc,
+	0x80, 0x0b, 0x9f, 0xee, 0x61, 0xeb, 0x2d, 0xc8, 0x7b, 0x50, 0x08, 0xa8, 0xec, 0x45, 0x4c, 0x9e,
+	0x58, 0xf9, 0x09, 0xdf, 0xda, 0x01, 0x22, 0xe9, 0x4b, 0x43, 0x11, 0x49, 0x8c, 0xac, 0x1b, 0x97,
+	0xb0, 0x3a, 0xb0, 0x24, 0xf7, 0x20, 0x1f, 0x4b, 0x2a, 0x7b, 0xb1, 0xd6, 0x95, 0xe2, 0x77, 0x5d,
+	0xb4, 0xa4, 0xc8, 0x1d, 0x6d, 0x6d, 0x1b, 0x14, 0xa9, 0xc2, 0xac, 0x08, 0x91, 0xab, 0x9f, 0x8d,
+	0xd2, 0x9a, 0xbd, 0x4a, 0x3f, 0x9a, 0xc0, 0xaa, 0x92, 0x84, 0xb0, 0xa0, 0x5e, 0x4a, 0xf5, 0xeb,
+	0xb7, 0x4f, 0xfd, 0x1e, 0x5a, 0xf0, 0xfc, 0xa9, 0x9e, 0x37, 0x3b, 0x7c, 0xa4, 0x36, 0x20, 0xbf,
+	0x80, 0x45, 0x9f, 0xc6, 0xd2, 0x49, 0x58, 0x48, 0x1e, 0x87, 0xb9, 0x09, 0x09, 0x2f, 0x2a, 0xa4,
+	0xad, 0x81, 0x6a, 0x89, 0x6c, 0x8c, 0xfa, 0xe2, 0x42, 0xa2, 0x35, 0xaf, 0x8f, 0xfb, 0x90, 0xe5,
+	0xae, 0x90, 0xa8, 0xba, 0xc5, 0xf9, 0xed, 0x08, 0xf1, 0x53, 0x6c, 0xd3, 0x88, 0x06, 0x31, 0xb9,
+	0x0f, 0x24, 0x56, 0x4d, 0x02, 0x93, 0x27, 0x0e, 0xed, 0xc9, 0x43, 0xa1, 0x2b, 0x7f, 0x59, 0x6f,
+	0xb0, 0x94, 0x62, 0xaa, 0x29, 0x84, 0x6c, 0xc3, 0x7c, 0x40, 0x8f, 0x9d, 0xf4, 0x13, 0xc3, 0xe0,
+	0xa7, 0xff, 0x04, 0x8f, 0xe6, 0x5c, 0x40, 0x8f, 0xd3, 0x69, 0xf2, 0x1b, 0x58, 0x56, 0xff, 0x1d,
+	0x30, 0x57, 0x8f, 0x07, 0x8f, 0xdd, 0x0b, 0x90, 0xaa, 0x57, 0x87, 0x37, 0x32, 0x2f, 0x5e, 0xf9,
+	0x1f, 0x59, 0x58, 0xd8, 0xeb, 0xc9, 0x03, 0x5f, 0x1c, 0x25, 0x44, 0x91, 0x9f, 0xc0, 0xec, 0x41,
+	0x24, 0x3e, 0x45, 0xee, 0xec, 0x5f, 0xce, 0x4c, 0x21, 0x31, 0xad, 0x9d, 0x28, 0xfd, 0x55, 0xf2,
+	0x64, 0xa8, 0x98, 0xb5, 0xcd, 0x88, 0xd4, 0x47, 0xbe, 0x51, 0x4c, 0x5f, 0xe1, 0xb8, 0x0e, 0x7d,
+	0xa7, 0x78, 0x1f, 0x0a, 0xc8, 0xbd, 0x49, 0x3f, 0x73, 0x9c, 0xbb, 0xb8, 0x81, 0xdc, 0xd3, 0x0e,
+	0xde, 0x05, 0x6b, 0x84, 0xe6, 0xe1, 0xd7, 0x7d, 0x46, 0x8b, 0xe7, 0xca, 0xf0, 0xfa, 0xf9, 0x13,
+	0xaf, 0x7e, 0xf1, 0x26, 0x2b, 0xe8, 0x69, 0x85, 0x28, 0xd8, 0x83, 0xf1, 0x1b, 0x8f, 0x33, 0xb0,
+	0xf4, 0x4c, 0x83, 0x4c, 0x9a, 0xb0, 0xde, 0x68, 0xee, 0xee, 0x7d, 0xe8, 0xb4, 0xf7, 0x76, 0x5a,
+	0xf5, 0x5f, 0x39, 0xd5, 0xfa, 0x83, 0xd6, 0xde, 0xae, 0xf3, 0x70, 0xb7, 0xd3, 0x6e, 0xd6, 0x5b,
+	0xdb, 0xad, 0x66, 0x63, 0x71, 0x6a, 0xb5, 0x74, 0x7a, 0x56, 0xfa, 0xde, 0x33, 0xd8, 0x87, 0x3c,
+	0x0e, 0xd1, 0xd5, 0xce, 0xc9, 0x3b, 0x60, 0x7d, 0x9b, 0x9b, 0xda, 0x43, 0x7b, 0x77, 0x31, 0xb3,
+	0x7a, 0xeb, 0xf4, 0xac, 0x74, 0xf3, 0x19, 0x7c, 0x4d, 0x69, 0xda, 0x05, 0xc0, 0xce, 0xc7, 0xd5,
+	0xf6, 0x62, 0xf6, 0x02, 0x60, 0xe7, 0x88, 0x86, 0xab, 0xb9, 0xdf, 0xfe, 0x69, 0x6d, 0xea, 0x8d,
+	0x7f, 0xea, 0x9e, 0x66, 0x58, 0x79, 0xc8, 0x3d, 0x78, 0xad, 0xbd, 0xd7, 0x69, 0x25, 0x6e, 0x1e,
+	0x54, 0x1f, 0x3c, 0xec, 0x8c, 0x65, 0xf3, 0xfa, 0xe9, 0x59, 0xe9, 0xd6, 0x28, 0x68, 0x38, 0x95,
+	0x37, 0x61, 0x79, 0x1c, 0xbf, 0xd7, 0x6e, 0xaa, 0x34, 0x56, 0x4e, 0xcf, 0x4a, 0x64, 0x14, 0xb8,
+	0x17, 0x22, 0x27, 0x6f, 0xc3, 0xca, 0x38, 0xa2, 0xbe, 0xb3, 0xd7, 0x69, 0x36, 0x16, 0xb3, 0xab,
+	0xd6, 0xe9, 0x59, 0x69, 0x79, 0x14, 0x53, 0xf7, 0x45, 0x8c, 0x5e, 0x92, 0x40, 0xed, 0xee, 0x17,
+	0x4f, 0xd6, 0x32, 0x5f, 0x3e, 0x59, 0xcb, 0xfc, 0xef, 0xc9, 0x5a, 0xe6, 0xb3, 0xa7, 0x6b, 0x53,
+	0x5f, 0x3e, 0x5d, 0x9b, 0xfa, 0xcf, 0xd3, 0xb5, 0xa9, 0x5f, 0xdf, 0x1e, 0xe9, 0x3f, 0x8e, 0x47,
+	0xbf, 0x6b, 0xea, 0xab, 0xb2, 0x9f, 0xd7, 0x73, 0x3f, 0xfe, 0x66, 0x00, 0x08, 0x8a, 0x45, 0xdc,
+	0xfb, 0x14, 0x00, 0x00,
+}
+
+func (m *Budget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Budget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Budget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Period != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Period):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTypes(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TranchesLeft != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TranchesLeft))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Tranches != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Tranches))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NextClaimFrom != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.NextClaimFrom, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextClaimFrom):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintTypes(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.StartTime != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintTypes(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ClaimedAmount != nil {
+		{
+			size, err := m.ClaimedAmount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TotalBudget != nil {
+		{
+			size, err := m.TotalBudget.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContinuousFund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContinuousFund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContinuousFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiry != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintTypes(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Percentage.Size()
+		i -= size
+		if _, err := m.Percentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Milestone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Milestone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Milestone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Approved {
+		i--
+		if m.Approved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EscrowedSpend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EscrowedSpend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EscrowedSpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTypes(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Milestones) > 0 {
+		for iNdEx := len(m.Milestones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Milestones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Reviewer) > 0 {
+		i -= len(m.Reviewer)
+		copy(dAtA[i:], m.Reviewer)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reviewer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpendLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpendLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpendLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintTypes(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpendWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpendWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpendWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Spent.Size()
+		i -= size
+		if _, err := m.Spent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintTypes(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpendAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpendAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpendAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowEnd != nil {
+		n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.WindowEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.WindowEnd):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintTypes(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Remaining.Size()
+		i -= size
+		if _, err := m.Remaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Spent.Size()
+		i -= size
+		if _, err := m.Spent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Action != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FundingSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundingSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundingSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecurringGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecurringGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecurringGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RenewalProposalId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RenewalProposalId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.RenewalDeposit) > 0 {
+		for iNdEx := len(m.RenewalDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RenewalDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RenewalTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RenewalTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintTypes(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x32
+	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RenewalInterval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RenewalInterval):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintTypes(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x2a
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NextPayment, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextPayment):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintTypes(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x22
+	n15, err15 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintTypes(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Principal.Size()
+		i -= size
+		if _, err := m.Principal.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolDelegationBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolDelegationBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolDelegationBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolBalanceChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolBalanceChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolBalanceChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Decrease) > 0 {
+		for iNdEx := len(m.Decrease) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Decrease[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Increase) > 0 {
+		for iNdEx := len(m.Increase) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Increase[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Position) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Position) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastReportNote) > 0 {
+		i -= len(m.LastReportNote)
+		copy(dAtA[i:], m.LastReportNote)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LastReportNote)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.LastReportTime != nil {
+		n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastReportTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastReportTime):])
+		if err17 != nil {
+			return 0, err17
+		}
+		i -= n17
+		i = encodeVarintTypes(dAtA, i, uint64(n17))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.CurrentValue) > 0 {
+		for iNdEx := len(m.CurrentValue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrentValue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.OpenedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.OpenedAt):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintTypes(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x4a
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Maturity != nil {
+		n19, err19 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Maturity, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Maturity):])
+		if err19 != nil {
+			return 0, err19
+		}
+		i -= n19
+		i = encodeVarintTypes(dAtA, i, uint64(n19))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ExpectedReturn) > 0 {
+		for iNdEx := len(m.ExpectedReturn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpectedReturn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Principal) > 0 {
+		for iNdEx := len(m.Principal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Principal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Venue) > 0 {
+		i -= len(m.Venue)
+		copy(dAtA[i:], m.Venue)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Venue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FreezeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FreezeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FreezeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RatificationDeposit) > 0 {
+		for iNdEx := len(m.RatificationDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RatificationDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n20, err20 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxDuration):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintTypes(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0x12
+	if len(m.SecurityAuthority) > 0 {
+		i -= len(m.SecurityAuthority)
+		copy(dAtA[i:], m.SecurityAuthority)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SecurityAuthority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutflowFreeze) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutflowFreeze) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutflowFreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ratified {
+		i--
+		if m.Ratified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RatificationProposalId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RatificationProposalId))
+		i--
+		dAtA[i] = 0x28
+	}
+	n21, err21 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err21 != nil {
+		return 0, err21
+	}
+	i -= n21
+	i = encodeVarintTypes(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0x22
+	n22, err22 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintTypes(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FrozenBy) > 0 {
+		i -= len(m.FrozenBy)
+		copy(dAtA[i:], m.FrozenBy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.FrozenBy)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Budget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.TotalBudget != nil {
+		l = m.TotalBudget.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ClaimedAmount != nil {
+		l = m.ClaimedAmount.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.StartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.NextClaimFrom != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextClaimFrom)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Tranches != 0 {
+		n += 1 + sovTypes(uint64(m.Tranches))
+	}
+	if m.TranchesLeft != 0 {
+		n += 1 + sovTypes(uint64(m.TranchesLeft))
+	}
+	if m.Period != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Period)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ContinuousFund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Percentage.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Expiry != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Milestone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Approved {
+		n += 2
+	}
+	return n
+}
+
+func (m *EscrowedSpend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Reviewer)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Milestones) > 0 {
+		for _, e := range m.Milestones {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *SpendLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Limit.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *SpendWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Spent.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *SpendAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Limit.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Spent.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Remaining.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.WindowEnd != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.WindowEnd)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovTypes(uint64(m.Action))
+	}
+	return n
+}
+
+func (m *FundingSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RecurringGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextPayment)
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RenewalInterval)
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RenewalTime)
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.RenewalDeposit) > 0 {
+		for _, e := range m.RenewalDeposit {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.RenewalProposalId != 0 {
+		n += 1 + sovTypes(uint64(m.RenewalProposalId))
+	}
+	return n
+}
+
+func (m *PoolDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Principal.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *PoolDelegationBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Delegation.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *PoolBalanceChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Increase) > 0 {
+		for _, e := range m.Increase {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Decrease) > 0 {
+		for _, e := range m.Decrease {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Position) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Venue)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Principal) > 0 {
+		for _, e := range m.Principal {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.ExpectedReturn) > 0 {
+		for _, e := range m.ExpectedReturn {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Maturity != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Maturity)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.OpenedAt)
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.CurrentValue) > 0 {
+		for _, e := range m.CurrentValue {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.LastReportTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastReportTime)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.LastReportNote)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *FreezeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SecurityAuthority)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxDuration)
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.RatificationDeposit) > 0 {
+		for _, e := range m.RatificationDeposit {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OutflowFreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FrozenBy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.RatificationProposalId != 0 {
+		n += 1 + sovTypes(uint64(m.RatificationProposalId))
+	}
+	if m.Ratified {
+		n += 2
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Budget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Budget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Budget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBudget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TotalBudget == nil {
+				m.TotalBudget = &types.Coin{}
+			}
+			if err := m.TotalBudget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClaimedAmount == nil {
+				m.ClaimedAmount = &types.Coin{}
+			}
+			if err := m.ClaimedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextClaimFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextClaimFrom == nil {
+				m.NextClaimFrom = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.NextClaimFrom, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tranches", wireType)
+			}
+			m.Tranches = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tranches |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TranchesLeft", wireType)
+			}
+			m.TranchesLeft = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TranchesLeft |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Period == nil {
+				m.Period = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContinuousFund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContinuousFund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContinuousFund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Percentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiry == nil {
+				m.Expiry = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Milestone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Milestone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Milestone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Approved = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EscrowedSpend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EscrowedSpend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EscrowedSpend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reviewer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reviewer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Milestones = append(m.Milestones, Milestone{})
+			if err := m.Milestones[len(m.Milestones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpendLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpendLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpendLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpendWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpendWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpendWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpendAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpendAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpendAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowEnd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WindowEnd == nil {
+				m.WindowEnd = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.WindowEnd, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= DenomPolicyAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundingSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundingSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundingSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecurringGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecurringGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecurringGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPayment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextPayment, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenewalInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RenewalInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenewalTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RenewalTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenewalDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RenewalDeposit = append(m.RenewalDeposit, types.Coin{})
+			if err := m.RenewalDeposit[len(m.RenewalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenewalProposalId", wireType)
+			}
+			m.RenewalProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RenewalProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Principal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolDelegationBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolDelegationBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolDelegationBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolBalanceChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolBalanceChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolBalanceChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Increase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Increase = append(m.Increase, types.Coin{})
+			if err := m.Increase[len(m.Increase)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decrease", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Decrease = append(m.Decrease, types.Coin{})
+			if err := m.Decrease[len(m.Decrease)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Position) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Position: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Venue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Venue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Principal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Principal = append(m.Principal, types.Coin{})
+			if err := m.Principal[len(m.Principal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedReturn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedReturn = append(m.ExpectedReturn, types.Coin{})
+			if err := m.ExpectedReturn[len(m.ExpectedReturn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maturity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Maturity == nil {
+				m.Maturity = new(time.Time)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Budget: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Budget: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Maturity, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -525,11 +5297,30 @@ func (m *Budget) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			m.Reporter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PositionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalBudget", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -556,16 +5347,13 @@ func (m *Budget) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TotalBudget == nil {
-				m.TotalBudget = &types.Coin{}
-			}
-			if err := m.TotalBudget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.OpenedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentValue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -592,16 +5380,14 @@ func (m *Budget) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClaimedAmount == nil {
-				m.ClaimedAmount = &types.Coin{}
-			}
-			if err := m.ClaimedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CurrentValue = append(m.CurrentValue, types.Coin{})
+			if err := m.CurrentValue[len(m.CurrentValue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReportTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -628,18 +5414,18 @@ func (m *Budget) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartTime == nil {
-				m.StartTime = new(time.Time)
+			if m.LastReportTime == nil {
+				m.LastReportTime = new(time.Time)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastReportTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextClaimFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReportNote", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -649,33 +5435,79 @@ func (m *Budget) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NextClaimFrom == nil {
-				m.NextClaimFrom = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.NextClaimFrom, dAtA[iNdEx:postIndex]); err != nil {
+			m.LastReportNote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tranches", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.Tranches = 0
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FreezeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FreezeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FreezeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityAuthority", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -685,16 +5517,29 @@ func (m *Budget) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Tranches |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TranchesLeft", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.TranchesLeft = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -704,14 +5549,28 @@ func (m *Budget) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TranchesLeft |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RatificationDeposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -738,10 +5597,8 @@ func (m *Budget) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Period == nil {
-				m.Period = new(time.Duration)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.Period, dAtA[iNdEx:postIndex]); err != nil {
+			m.RatificationDeposit = append(m.RatificationDeposit, types.Coin{})
+			if err := m.RatificationDeposit[len(m.RatificationDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -766,7 +5623,7 @@ func (m *Budget) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContinuousFund) Unmarshal(dAtA []byte) error {
+func (m *OutflowFreeze) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -789,15 +5646,15 @@ func (m *ContinuousFund) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContinuousFund: wiretype end group for non-group")
+			return fmt.Errorf("proto: OutflowFreeze: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContinuousFund: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OutflowFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -825,11 +5682,11 @@ func (m *ContinuousFund) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.FrozenBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -857,13 +5714,11 @@ func (m *ContinuousFund) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Percentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -890,13 +5745,82 @@ func (m *ContinuousFund) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Expiry == nil {
-				m.Expiry = new(time.Time)
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RatificationProposalId", wireType)
+			}
+			m.RatificationProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RatificationProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ratified = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -34,6 +34,37 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "signing-infos",
 					Short:     "Query signing information of all validators",
 				},
+				{
+					RpcMethod: "DowntimeOffenses",
+					Use:       "downtime-offenses [validator-conspub/address]",
+					Short:     "Query a validator's downtime offenses and the fraction of its next downtime slash",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "cons_address"},
+					},
+				},
+				{
+					RpcMethod: "SigningWindows",
+					Use:       "signing-windows [validator-conspub/address]",
+					Short:     "Query a validator's uptime over windows of recent blocks",
+					Long:      "Query a validator's uptime over windows of recent blocks, defaulting to the last 1000, 10000 and 100000 blocks. Windows must be multiples of 1000 blocks.",
+					Example:   fmt.Sprintf("%s query slashing signing-windows [validator-conspub/address] --windows 5000,50000", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "cons_address"},
+					},
+				},
+				{
+					RpcMethod: "InsuranceBond",
+					Use:       "insurance-bond [validator-address]",
+					Short:     "Query a validator's insurance bond",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+					},
+				},
+				{
+					RpcMethod: "InsuranceBonds",
+					Use:       "insurance-bonds",
+					Short:     "Query the insurance bonds of all validators",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -45,6 +76,39 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Unjail a jailed validator",
 					Example:   fmt.Sprintf("%s tx slashing unjail --from [validator]", version.AppName),
 				},
+				{
+					RpcMethod: "Heartbeat",
+					Use:       "heartbeat",
+					Short:     "Signal that a validator outside the active set is online",
+					Example:   fmt.Sprintf("%s tx slashing heartbeat --from [validator]", version.AppName),
+				},
+				{
+					RpcMethod: "DepositInsuranceBond",
+					Use:       "deposit-insurance-bond [amount]",
+					Short:     "Lock funds in a validator's insurance bond, compensating its delegators when it is slashed for downtime",
+					Example:   fmt.Sprintf("%s tx slashing deposit-insurance-bond 1000stake --from [validator]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "WithdrawInsuranceBond",
+					Use:       "withdraw-insurance-bond [amount]",
+					Short:     "Withdraw funds from the insurance bond of a validator which is not jailed",
+					Example:   fmt.Sprintf("%s tx slashing withdraw-insurance-bond 1000stake --from [validator]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod:      "RevertTombstone",
+					Use:            "revert-tombstone-proposal [validator-address] [reason]",
+					Short:          "Submit a proposal to revert the tombstone of a validator after a proven false-positive equivocation",
+					Long:           "Submit a proposal to revert the tombstone of a validator after a proven false-positive equivocation, e.g. caused by a consensus bug. The validator stays jailed for the tombstone reversal cooldown, after which it can be unjailed.",
+					Example:        fmt.Sprintf(`%s tx slashing revert-tombstone-proposal cosmosvaloper1... "equivocation caused by a consensus bug, see the post-mortem"`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "reason"}},
+					GovProposal:    true,
+				},
 				{
 					RpcMethod:      "UpdateParams",
 					Use:            "update-params-proposal [params]",
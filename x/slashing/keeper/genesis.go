@@ -53,6 +53,16 @@ func (keeper Keeper) InitGenesis(ctx context.Context, stakingKeeper types.Stakin
 		}
 	}
 
+	for _, bond := range data.InsuranceBonds {
+		valAddr, err := keeper.sk.ValidatorAddressCodec().StringToBytes(bond.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		if err := keeper.InsuranceBonds.Set(ctx, valAddr, bond); err != nil {
+			panic(err)
+		}
+	}
+
 	if err := keeper.Params.Set(ctx, data.Params); err != nil {
 		panic(err)
 	}
@@ -93,5 +103,17 @@ func (keeper Keeper) ExportGenesis(ctx context.Context) (data *types.GenesisStat
 	if err != nil {
 		panic(err)
 	}
-	return types.NewGenesisState(params, signingInfos, missedBlocks)
+
+	insuranceBonds := make([]types.InsuranceBond, 0)
+	err = keeper.InsuranceBonds.Walk(ctx, nil, func(_ sdk.ValAddress, bond types.InsuranceBond) (stop bool, err error) {
+		insuranceBonds = append(insuranceBonds, bond)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	genesis := types.NewGenesisState(params, signingInfos, missedBlocks)
+	genesis.InsuranceBonds = insuranceBonds
+	return genesis
 }
@@ -10,6 +10,7 @@ import (
 	"cosmossdk.io/x/slashing/types"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
@@ -53,7 +54,11 @@ func (k Keeper) SigningInfo(ctx context.Context, req *types.QuerySigningInfoRequ
 		return nil, status.Errorf(codes.NotFound, "SigningInfo not found for validator %s", req.ConsAddress)
 	}
 
-	return &types.QuerySigningInfoResponse{ValSigningInfo: signingInfo}, nil
+	height := k.environment.HeaderService.GetHeaderInfo(ctx).Height
+	return &types.QuerySigningInfoResponse{
+		ValSigningInfo:       signingInfo,
+		GraceBlocksRemaining: signingInfo.GraceBlocksRemaining(height),
+	}, nil
 }
 
 // SigningInfos returns signing-infos of all validators.
@@ -80,3 +85,109 @@ func (k Keeper) SigningInfos(ctx context.Context, req *types.QuerySigningInfosRe
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+// DowntimeOffenses returns the downtime offense counter of a validator, and the
+// fraction slashed for its next downtime offense.
+func (k Keeper) DowntimeOffenses(ctx context.Context, req *types.QueryDowntimeOffensesRequest) (*types.QueryDowntimeOffensesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := k.sk.ConsensusAddressCodec().StringToBytes(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	signingInfo, err := k.ValidatorSigningInfo.Get(ctx, consAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "SigningInfo not found for validator %s", req.ConsAddress)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	offenses := signingInfo.DecayedDowntimeOffenses(k.environment.HeaderService.GetHeaderInfo(ctx).Time, params.DowntimeOffenseDecayPeriod)
+	return &types.QueryDowntimeOffensesResponse{
+		Offenses:          offenses,
+		LastOffenseTime:   signingInfo.LastDowntimeOffenseTime,
+		NextSlashFraction: params.DowntimeSlashFraction(offenses + 1),
+	}, nil
+}
+
+// SigningWindows returns the uptime of a validator over windows of recent
+// blocks.
+func (k Keeper) SigningWindows(ctx context.Context, req *types.QuerySigningWindowsRequest) (*types.QuerySigningWindowsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := k.sk.ConsensusAddressCodec().StringToBytes(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	windows := req.Windows
+	if len(windows) == 0 {
+		windows = DefaultSigningWindows
+	}
+
+	res := make([]types.SigningWindow, 0, len(windows))
+	for _, window := range windows {
+		signingWindow, err := k.GetSigningWindow(ctx, consAddr, window)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		res = append(res, signingWindow)
+	}
+
+	return &types.QuerySigningWindowsResponse{Windows: res}, nil
+}
+
+// InsuranceBond returns the insurance bond of a specific validator.
+func (k Querier) InsuranceBond(ctx context.Context, req *types.QueryInsuranceBondRequest) (*types.QueryInsuranceBondResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	bond, err := k.Keeper.InsuranceBonds.Get(ctx, valAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "InsuranceBond not found for validator %s", req.ValidatorAddress)
+	}
+
+	return &types.QueryInsuranceBondResponse{Bond: bond}, nil
+}
+
+// InsuranceBonds returns the insurance bonds of all validators.
+func (k Querier) InsuranceBonds(ctx context.Context, req *types.QueryInsuranceBondsRequest) (*types.QueryInsuranceBondsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	bonds, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.InsuranceBonds, req.Pagination, func(_ sdk.ValAddress, bond types.InsuranceBond) (types.InsuranceBond, error) {
+		return bond, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryInsuranceBondsResponse{Bonds: bonds, Pagination: pageRes}, nil
+}
@@ -4,6 +4,7 @@ import (
 	gocontext "context"
 	"time"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/slashing/testutil"
 	slashingtypes "cosmossdk.io/x/slashing/types"
 
@@ -94,3 +95,56 @@ func (s *KeeperTestSuite) TestGRPCSigningInfos() {
 	require.NotNil(infoResp.Pagination.NextKey)
 	require.Equal(uint64(2), infoResp.Pagination.Total)
 }
+
+func (s *KeeperTestSuite) TestGRPCDowntimeOffenses() {
+	queryClient, ctx, keeper := s.queryClient, s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	_, err := queryClient.DowntimeOffenses(gocontext.Background(), &slashingtypes.QueryDowntimeOffensesRequest{ConsAddress: ""})
+	require.ErrorContains(err, "invalid request")
+
+	consStr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(consAddr)
+	require.NoError(err)
+
+	_, err = queryClient.DowntimeOffenses(gocontext.Background(), &slashingtypes.QueryDowntimeOffensesRequest{ConsAddress: consStr})
+	require.ErrorContains(err, "SigningInfo not found")
+
+	params := testutil.TestParams()
+	params.DowntimeSlashFractionTiers = []math.LegacyDec{
+		math.LegacyNewDecWithPrec(1, 3),
+		math.LegacyNewDecWithPrec(1, 2),
+		math.LegacyNewDecWithPrec(5, 2),
+	}
+	params.DowntimeOffenseDecayPeriod = 24 * time.Hour
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	// two offenses, the last one a day and an hour ago, decay to one
+	signingInfo := slashingtypes.NewValidatorSigningInfo(consStr, 0, time.Unix(2, 0), false, int64(0))
+	signingInfo.DowntimeOffenses = 2
+	signingInfo.LastDowntimeOffenseTime = ctx.HeaderInfo().Time.Add(-25 * time.Hour)
+	require.NoError(keeper.ValidatorSigningInfo.Set(ctx, consAddr, signingInfo))
+
+	res, err := queryClient.DowntimeOffenses(gocontext.Background(), &slashingtypes.QueryDowntimeOffensesRequest{ConsAddress: consStr})
+	require.NoError(err)
+	require.Equal(uint64(1), res.Offenses)
+	require.Equal(signingInfo.LastDowntimeOffenseTime, res.LastOffenseTime)
+	require.Equal(params.DowntimeSlashFractionTiers[1], res.NextSlashFraction)
+
+	// the last tier applies to all the following offenses
+	signingInfo.DowntimeOffenses = 5
+	signingInfo.LastDowntimeOffenseTime = ctx.HeaderInfo().Time
+	require.NoError(keeper.ValidatorSigningInfo.Set(ctx, consAddr, signingInfo))
+
+	res, err = queryClient.DowntimeOffenses(gocontext.Background(), &slashingtypes.QueryDowntimeOffensesRequest{ConsAddress: consStr})
+	require.NoError(err)
+	require.Equal(uint64(5), res.Offenses)
+	require.Equal(params.DowntimeSlashFractionTiers[2], res.NextSlashFraction)
+
+	// without tiers, the slash fraction downtime applies
+	params.DowntimeSlashFractionTiers = nil
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	res, err = queryClient.DowntimeOffenses(gocontext.Background(), &slashingtypes.QueryDowntimeOffensesRequest{ConsAddress: consStr})
+	require.NoError(err)
+	require.Equal(params.SlashFractionDowntime, res.NextSlashFraction)
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/slashing/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Heartbeat records a heartbeat of a validator outside the active set in its
+// signing info. The signing info is created if the validator was never bonded.
+func (k Keeper) Heartbeat(ctx context.Context, validatorAddr sdk.ValAddress) error {
+	validator, err := k.sk.Validator(ctx, validatorAddr)
+	if err != nil {
+		return err
+	}
+	if validator == nil {
+		return types.ErrNoValidatorForAddress
+	}
+
+	if validator.IsBonded() {
+		return types.ErrValidatorBonded
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+
+	headerInfo := k.environment.HeaderService.GetHeaderInfo(ctx)
+	info, err := k.ValidatorSigningInfo.Get(ctx, consAddr)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+
+		consStr, err := k.sk.ConsensusAddressCodec().BytesToString(consAddr)
+		if err != nil {
+			return err
+		}
+		info = types.NewValidatorSigningInfo(consStr, headerInfo.Height, time.Unix(0, 0), false, 0)
+	}
+
+	info.LastHeartbeatHeight = headerInfo.Height
+	info.LastHeartbeatTime = headerInfo.Time
+	if err := k.ValidatorSigningInfo.Set(ctx, consAddr, info); err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeHeartbeat,
+		event.NewAttribute(types.AttributeKeyAddress, info.Address),
+		event.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", headerInfo.Height)),
+	)
+}
@@ -43,13 +43,31 @@ func (h Hooks) AfterValidatorBonded(ctx context.Context, consAddr sdk.ConsAddres
 			false,
 			0,
 		)
+
+		// a new validator accrues missed blocks without being jailed during the grace period
+		params, err := h.k.Params.Get(ctx)
+		if err != nil {
+			return err
+		}
+		if params.NewValidatorGraceBlocks > 0 {
+			signingInfo.GraceEndHeight = blockHeight + params.NewValidatorGraceBlocks
+		}
 	}
 
 	return h.k.ValidatorSigningInfo.Set(ctx, consAddr, signingInfo)
 }
 
-// AfterValidatorRemoved deletes the address-pubkey relation when a validator is removed,
-func (h Hooks) AfterValidatorRemoved(ctx context.Context, consAddr sdk.ConsAddress, _ sdk.ValAddress) error {
+// AfterValidatorRemoved deletes the address-pubkey relation and the signing
+// history when a validator is removed, and refunds its insurance bond.
+func (h Hooks) AfterValidatorRemoved(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	if err := h.k.refundInsuranceBond(ctx, valAddr); err != nil {
+		return err
+	}
+
+	if err := h.k.DeleteSigningWindowBuckets(ctx, consAddr); err != nil {
+		return err
+	}
+
 	return h.k.AddrPubkeyRelation.Remove(ctx, crypto.Address(consAddr))
 }
 
@@ -1,6 +1,11 @@
 package keeper_test
 
 import (
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/core/comet"
+	"cosmossdk.io/core/header"
+	slashingtypes "cosmossdk.io/x/slashing/types"
 	stakingtypes "cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -44,3 +49,51 @@ func (s *KeeperTestSuite) TestAfterValidatorCreatedOrRemoved() {
 	_, err = keeper.GetPubkey(ctx, addr.Bytes())
 	require.Error(err)
 }
+
+func (s *KeeperTestSuite) TestNewValidatorGracePeriod() {
+	require := s.Require()
+
+	params, err := s.slashingKeeper.Params.Get(s.ctx)
+	require.NoError(err)
+	params.NewValidatorGraceBlocks = 2000
+	require.NoError(s.slashingKeeper.Params.Set(s.ctx, params))
+
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	valStr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(addr)
+	require.NoError(err)
+	consAddr := sdk.ConsAddress(pubKey.Address())
+	consStr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(consAddr)
+	require.NoError(err)
+	val, err := stakingtypes.NewValidator(valStr, pubKey, stakingtypes.Description{})
+	require.NoError(err)
+	val.Status = stakingtypes.Bonded
+
+	// the grace period starts when the validator is bonded for the first time
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: 10})
+	require.NoError(s.slashingKeeper.Hooks().AfterValidatorBonded(ctx, consAddr, sdk.ValAddress(addr)))
+	res, err := s.slashingKeeper.SigningInfo(ctx, &slashingtypes.QuerySigningInfoRequest{ConsAddress: consStr})
+	require.NoError(err)
+	require.Equal(int64(2010), res.ValSigningInfo.GraceEndHeight)
+	require.Equal(int64(2000), res.GraceBlocksRemaining)
+
+	// the validator accrues missed blocks past the liveness threshold without being jailed
+	info := res.ValSigningInfo
+	info.MissedBlocksCounter = params.SignedBlocksWindow - params.MinSignedPerWindowInt()
+	require.NoError(s.slashingKeeper.ValidatorSigningInfo.Set(ctx, consAddr, info))
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 10 + params.SignedBlocksWindow + 1})
+	s.stakingKeeper.EXPECT().ValidatorIdentifier(gomock.Any(), consAddr).Return(consAddr, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(val, nil).AnyTimes()
+	require.NoError(s.slashingKeeper.HandleValidatorSignature(ctx, pubKey.Address(), 100, comet.BlockIDFlagAbsent))
+
+	info, err = s.slashingKeeper.ValidatorSigningInfo.Get(ctx, consAddr)
+	require.NoError(err)
+	require.Equal(params.SignedBlocksWindow-params.MinSignedPerWindowInt()+1, info.MissedBlocksCounter)
+
+	// the grace period isn't restarted when the validator bonds again
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 3000})
+	require.NoError(s.slashingKeeper.Hooks().AfterValidatorBonded(ctx, consAddr, sdk.ValAddress(addr)))
+	res, err = s.slashingKeeper.SigningInfo(ctx, &slashingtypes.QuerySigningInfoRequest{ConsAddress: consStr})
+	require.NoError(err)
+	require.Equal(int64(2010), res.ValSigningInfo.GraceEndHeight)
+	require.Equal(int64(0), res.GraceBlocksRemaining)
+}
@@ -107,6 +107,10 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 		// bitmap value at this index has not changed, no need to update counter
 	}
 
+	if err := k.recordSigningWindowBlock(ctx, consAddr, height, missed); err != nil {
+		return err
+	}
+
 	minSignedPerWindow := params.MinSignedPerWindowInt()
 
 	consStr, err := k.sk.ConsensusAddressCodec().BytesToString(consAddr)
@@ -136,8 +140,16 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 	minHeight := signInfo.StartHeight + signedBlocksWindow
 	maxMissed := signedBlocksWindow - minSignedPerWindow
 
-	// if we are past the minimum height and the validator has missed too many blocks, punish them
-	if height > minHeight && signInfo.MissedBlocksCounter > maxMissed {
+	// if we are past the minimum height and the validator has missed too many blocks, punish them,
+	// unless they are a new validator within their grace period
+	downtime := height > minHeight && signInfo.MissedBlocksCounter > maxMissed
+	if downtime && signInfo.GraceBlocksRemaining(height) > 0 {
+		logger.Info(
+			"validator would have been slashed for downtime, but is within its grace period",
+			"validator", consStr,
+			"grace_end_height", signInfo.GraceEndHeight,
+		)
+	} else if downtime {
 		modifiedSignInfo = true
 		validator, err := k.sk.ValidatorByConsAddr(ctx, consAddr)
 		if err != nil {
@@ -152,13 +164,20 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 			// That's fine since this is just used to filter unbonding delegations & redelegations.
 			distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
-			slashFractionDowntime, err := k.SlashFractionDowntime(ctx)
+			// the fraction slashed depends on the number of recent downtime
+			// offenses of the validator when tiered
+			now := k.environment.HeaderService.GetHeaderInfo(ctx).Time
+			signInfo.DowntimeOffenses = signInfo.DecayedDowntimeOffenses(now, params.DowntimeOffenseDecayPeriod) + 1
+			signInfo.LastDowntimeOffenseTime = now
+			slashFractionDowntime := params.DowntimeSlashFraction(signInfo.DowntimeOffenses)
+
+			coinsBurned, err := k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, slashFractionDowntime, st.Infraction_INFRACTION_DOWNTIME)
 			if err != nil {
 				return err
 			}
 
-			coinsBurned, err := k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, slashFractionDowntime, st.Infraction_INFRACTION_DOWNTIME)
-			if err != nil {
+			// compensate the delegators from the insurance bond of the validator
+			if err := k.payInsurance(ctx, validator); err != nil {
 				return err
 			}
 
@@ -200,6 +219,7 @@ func (k Keeper) HandleValidatorSignatureWithParams(ctx context.Context, params t
 				"min_height", minHeight,
 				"threshold", minSignedPerWindow,
 				"slashed", slashFractionDowntime.String(),
+				"offenses", signInfo.DowntimeOffenses,
 				"jailed_until", signInfo.JailedUntil,
 			)
 		} else {
@@ -0,0 +1,241 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/slashing/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// getInsuranceBond returns the insurance bond of a validator, or an empty bond
+// in the bond denom if it has none.
+func (k Keeper) getInsuranceBond(ctx context.Context, valAddr sdk.ValAddress) (types.InsuranceBond, error) {
+	bondDenom, err := k.sk.BondDenom(ctx)
+	if err != nil {
+		return types.InsuranceBond{}, err
+	}
+
+	bond, err := k.InsuranceBonds.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		valStr, err := k.sk.ValidatorAddressCodec().BytesToString(valAddr)
+		if err != nil {
+			return types.InsuranceBond{}, err
+		}
+
+		return types.InsuranceBond{
+			ValidatorAddress: valStr,
+			Amount:           sdk.NewCoin(bondDenom, sdkmath.ZeroInt()),
+			PaidOut:          sdk.NewCoin(bondDenom, sdkmath.ZeroInt()),
+		}, nil
+	} else if err != nil {
+		return types.InsuranceBond{}, err
+	}
+
+	if bond.Amount.Denom != bondDenom {
+		return types.InsuranceBond{}, types.ErrInvalidInsuranceBondDenom.Wrapf("expected %s, got %s", bondDenom, bond.Amount.Denom)
+	}
+
+	return bond, nil
+}
+
+// DepositInsuranceBond locks funds of the operator account of a validator in
+// its insurance bond.
+func (k Keeper) DepositInsuranceBond(ctx context.Context, valAddr sdk.ValAddress, amount sdk.Coin) error {
+	if _, err := k.sk.Validator(ctx, valAddr); err != nil {
+		return err
+	}
+
+	if !amount.IsValid() || !amount.IsPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid insurance bond deposit: %s", amount)
+	}
+
+	bond, err := k.getInsuranceBond(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if amount.Denom != bond.Amount.Denom {
+		return types.ErrInvalidInsuranceBondDenom.Wrapf("expected %s, got %s", bond.Amount.Denom, amount.Denom)
+	}
+
+	if err := k.bk.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(valAddr), types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	bond.Amount = bond.Amount.Add(amount)
+	if err := k.InsuranceBonds.Set(ctx, valAddr, bond); err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeDepositInsuranceBond,
+		event.NewAttribute(types.AttributeKeyValidator, bond.ValidatorAddress),
+		event.NewAttribute(types.AttributeKeyAmount, amount.String()),
+	)
+}
+
+// WithdrawInsuranceBond returns funds of the insurance bond of a validator to
+// its operator account. The bond cannot be withdrawn while the validator is
+// jailed, so that it remains available to compensate its delegators.
+func (k Keeper) WithdrawInsuranceBond(ctx context.Context, valAddr sdk.ValAddress, amount sdk.Coin) error {
+	validator, err := k.sk.Validator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if validator.IsJailed() {
+		return types.ErrInsuranceBondLocked
+	}
+
+	if !amount.IsValid() || !amount.IsPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid insurance bond withdrawal: %s", amount)
+	}
+
+	bond, err := k.getInsuranceBond(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if amount.Denom != bond.Amount.Denom {
+		return types.ErrInvalidInsuranceBondDenom.Wrapf("expected %s, got %s", bond.Amount.Denom, amount.Denom)
+	}
+
+	if bond.Amount.IsLT(amount) {
+		return types.ErrInsufficientInsuranceBond.Wrapf("%s is smaller than %s", bond.Amount, amount)
+	}
+
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.AccAddress(valAddr), sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	bond.Amount = bond.Amount.Sub(amount)
+	if err := k.InsuranceBonds.Set(ctx, valAddr, bond); err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeWithdrawInsuranceBond,
+		event.NewAttribute(types.AttributeKeyValidator, bond.ValidatorAddress),
+		event.NewAttribute(types.AttributeKeyAmount, amount.String()),
+	)
+}
+
+// refundInsuranceBond returns the whole insurance bond of a validator to its
+// operator account and deletes it.
+func (k Keeper) refundInsuranceBond(ctx context.Context, valAddr sdk.ValAddress) error {
+	bond, err := k.InsuranceBonds.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if bond.Amount.IsPositive() {
+		if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.AccAddress(valAddr), sdk.NewCoins(bond.Amount)); err != nil {
+			return err
+		}
+	}
+
+	return k.InsuranceBonds.Remove(ctx, valAddr)
+}
+
+// payInsurance compensates the delegators of a validator slashed for downtime
+// for the tokens their delegations lost, pro-rata to their losses and up to the
+// insurance bond of the validator. It must be called right after the slash,
+// with the validator as it was before the slash. The self-delegation of the
+// validator, and its unbonding delegations and redelegations, are not
+// compensated.
+func (k Keeper) payInsurance(ctx context.Context, slashed sdk.ValidatorI) error {
+	valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(slashed.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	bond, err := k.InsuranceBonds.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if !bond.Amount.IsPositive() {
+		return nil
+	}
+
+	validator, err := k.sk.Validator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	delegations, err := k.sk.GetValidatorDelegations(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	operator, err := k.ak.AddressCodec().BytesToString(valAddr)
+	if err != nil {
+		return err
+	}
+
+	totalLoss := sdkmath.LegacyZeroDec()
+	losses := make([]sdkmath.LegacyDec, len(delegations))
+	for i, delegation := range delegations {
+		if delegation.DelegatorAddress == operator {
+			losses[i] = sdkmath.LegacyZeroDec()
+			continue
+		}
+
+		losses[i] = slashed.TokensFromShares(delegation.Shares).Sub(validator.TokensFromShares(delegation.Shares))
+		totalLoss = totalLoss.Add(losses[i])
+	}
+
+	if !totalLoss.IsPositive() {
+		return nil
+	}
+
+	// delegators are fully compensated if the bond covers all their losses,
+	// and pro-rata otherwise
+	ratio := sdkmath.LegacyOneDec()
+	if available := bond.Amount.Amount.ToLegacyDec(); available.LT(totalLoss) {
+		ratio = available.Quo(totalLoss)
+	}
+
+	paid := sdkmath.ZeroInt()
+	for i, delegation := range delegations {
+		payout := losses[i].Mul(ratio).TruncateInt()
+		if !payout.IsPositive() {
+			continue
+		}
+
+		delAddr, err := k.ak.AddressCodec().StringToBytes(delegation.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+
+		coin := sdk.NewCoin(bond.Amount.Denom, payout)
+		if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(coin)); err != nil {
+			return err
+		}
+
+		if err := k.environment.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeInsurancePayout,
+			event.NewAttribute(types.AttributeKeyValidator, bond.ValidatorAddress),
+			event.NewAttribute(types.AttributeKeyDelegator, delegation.DelegatorAddress),
+			event.NewAttribute(types.AttributeKeyAmount, coin.String()),
+		); err != nil {
+			return err
+		}
+
+		paid = paid.Add(payout)
+	}
+
+	bond.Amount.Amount = bond.Amount.Amount.Sub(paid)
+	bond.PaidOut.Amount = bond.PaidOut.Amount.Add(paid)
+	return k.InsuranceBonds.Set(ctx, valAddr, bond)
+}
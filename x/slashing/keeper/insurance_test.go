@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	st "cosmossdk.io/api/cosmos/staking/v1beta1"
+	"cosmossdk.io/core/comet"
+	"cosmossdk.io/core/header"
+	sdkmath "cosmossdk.io/math"
+	slashingtypes "cosmossdk.io/x/slashing/types"
+	"cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestInsuranceBond() {
+	require := s.Require()
+
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(addr)
+	valStr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(addr)
+	require.NoError(err)
+
+	val, err := types.NewValidator(valStr, pubKey, types.Description{Moniker: "test"})
+	require.NoError(err)
+
+	s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().Validator(s.ctx, valAddr).Return(val, nil).AnyTimes()
+
+	// the bond must be denominated in the bond denom
+	_, err = s.msgServer.DepositInsuranceBond(s.ctx, slashingtypes.NewMsgDepositInsuranceBond(valStr, sdk.NewInt64Coin("atom", 100)))
+	require.ErrorIs(err, slashingtypes.ErrInvalidInsuranceBondDenom)
+
+	deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, sdk.AccAddress(valAddr), slashingtypes.ModuleName, sdk.NewCoins(deposit)).Return(nil).Times(2)
+	_, err = s.msgServer.DepositInsuranceBond(s.ctx, slashingtypes.NewMsgDepositInsuranceBond(valStr, deposit))
+	require.NoError(err)
+	_, err = s.msgServer.DepositInsuranceBond(s.ctx, slashingtypes.NewMsgDepositInsuranceBond(valStr, deposit))
+	require.NoError(err)
+
+	res, err := s.queryClient.InsuranceBond(s.ctx, &slashingtypes.QueryInsuranceBondRequest{ValidatorAddress: valStr})
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200), res.Bond.Amount)
+	require.Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), res.Bond.PaidOut)
+
+	// more than the bond cannot be withdrawn
+	_, err = s.msgServer.WithdrawInsuranceBond(s.ctx, slashingtypes.NewMsgWithdrawInsuranceBond(valStr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 201)))
+	require.ErrorIs(err, slashingtypes.ErrInsufficientInsuranceBond)
+
+	withdrawal := sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(s.ctx, slashingtypes.ModuleName, sdk.AccAddress(valAddr), sdk.NewCoins(withdrawal)).Return(nil)
+	_, err = s.msgServer.WithdrawInsuranceBond(s.ctx, slashingtypes.NewMsgWithdrawInsuranceBond(valStr, withdrawal))
+	require.NoError(err)
+
+	bonds, err := s.queryClient.InsuranceBonds(s.ctx, &slashingtypes.QueryInsuranceBondsRequest{})
+	require.NoError(err)
+	require.Len(bonds.Bonds, 1)
+	require.Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 150), bonds.Bonds[0].Amount)
+}
+
+func (s *KeeperTestSuite) TestInsuranceBondLockedWhileJailed() {
+	require := s.Require()
+
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(addr)
+	valStr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(addr)
+	require.NoError(err)
+
+	val, err := types.NewValidator(valStr, pubKey, types.Description{Moniker: "test"})
+	require.NoError(err)
+	val.Jailed = true
+
+	s.stakingKeeper.EXPECT().Validator(s.ctx, valAddr).Return(val, nil)
+	_, err = s.msgServer.WithdrawInsuranceBond(s.ctx, slashingtypes.NewMsgWithdrawInsuranceBond(valStr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	require.ErrorIs(err, slashingtypes.ErrInsuranceBondLocked)
+}
+
+func (s *KeeperTestSuite) TestInsurancePayout() {
+	require := s.Require()
+
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(addr)
+	valStr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(addr)
+	require.NoError(err)
+	operatorStr, err := ac.BytesToString(addr)
+	require.NoError(err)
+	del1, del2 := sdk.AccAddress([]byte("delegator1__________")), sdk.AccAddress([]byte("delegator2__________"))
+	del1Str, err := ac.BytesToString(del1)
+	require.NoError(err)
+	del2Str, err := ac.BytesToString(del2)
+	require.NoError(err)
+
+	val, err := types.NewValidator(valStr, pubKey, types.Description{Moniker: "test"})
+	require.NoError(err)
+	val.Status = types.Bonded
+	val.Tokens = sdkmath.NewInt(1000)
+	val.DelegatorShares = sdkmath.LegacyNewDec(1000)
+	slashed := val
+	slashed.Tokens = sdkmath.NewInt(990)
+	consAddr := sdk.ConsAddress(pubKey.Address())
+
+	// a bond covering half of the losses of the delegators
+	bond := slashingtypes.InsuranceBond{
+		ValidatorAddress: valStr,
+		Amount:           sdk.NewInt64Coin(sdk.DefaultBondDenom, 3),
+		PaidOut:          sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+	}
+	require.NoError(s.slashingKeeper.InsuranceBonds.Set(s.ctx, valAddr, bond))
+
+	// the validator misses the block which makes it cross the liveness threshold
+	params, err := s.slashingKeeper.Params.Get(s.ctx)
+	require.NoError(err)
+	signingInfo := slashingtypes.NewValidatorSigningInfo(consAddr.String(), 0, time.Unix(0, 0), false, params.SignedBlocksWindow-params.MinSignedPerWindowInt())
+	require.NoError(s.slashingKeeper.ValidatorSigningInfo.Set(s.ctx, consAddr, signingInfo))
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: params.SignedBlocksWindow + 1, Time: s.ctx.HeaderInfo().Time})
+
+	s.stakingKeeper.EXPECT().ValidatorIdentifier(gomock.Any(), consAddr).Return(consAddr, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(ctx, consAddr).Return(val, nil).Times(2)
+	s.stakingKeeper.EXPECT().SlashWithInfractionReason(ctx, consAddr, gomock.Any(), int64(1000), params.SlashFractionDowntime, st.Infraction_INFRACTION_DOWNTIME).Return(sdkmath.NewInt(10), nil)
+	s.stakingKeeper.EXPECT().Validator(ctx, valAddr).Return(slashed, nil)
+	s.stakingKeeper.EXPECT().GetValidatorDelegations(ctx, valAddr).Return([]types.Delegation{
+		types.NewDelegation(operatorStr, valStr, sdkmath.LegacyNewDec(400)),
+		types.NewDelegation(del1Str, valStr, sdkmath.LegacyNewDec(400)),
+		types.NewDelegation(del2Str, valStr, sdkmath.LegacyNewDec(200)),
+	}, nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, slashingtypes.ModuleName, del1, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2))).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, slashingtypes.ModuleName, del2, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))).Return(nil)
+	s.stakingKeeper.EXPECT().Jail(ctx, consAddr).Return(nil)
+
+	require.NoError(s.slashingKeeper.HandleValidatorSignature(ctx, pubKey.Address(), 1000, comet.BlockIDFlagAbsent))
+
+	bond, err = s.slashingKeeper.InsuranceBonds.Get(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), bond.Amount)
+	require.Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 3), bond.PaidOut)
+}
@@ -22,6 +22,8 @@ type Keeper struct {
 	environment appmodule.Environment
 	cdc         codec.BinaryCodec
 	legacyAmino *codec.LegacyAmino
+	ak          types.AccountKeeper
+	bk          types.BankKeeper
 	sk          types.StakingKeeper
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
@@ -35,15 +37,21 @@ type Keeper struct {
 	AddrPubkeyRelation collections.Map[[]byte, cryptotypes.PubKey]
 	// ValidatorMissedBlockBitmap key: ConsAddr | value: byte key for a validator's missed block bitmap chunk
 	ValidatorMissedBlockBitmap collections.Map[collections.Pair[[]byte, uint64], []byte]
+	// InsuranceBonds key: ValAddr | value: InsuranceBond
+	InsuranceBonds collections.Map[sdk.ValAddress, types.InsuranceBond]
+	// SigningWindowBuckets key: ConsAddr | slot | value: SigningWindowBucket
+	SigningWindowBuckets collections.Map[collections.Pair[[]byte, uint64], types.SigningWindowBucket]
 }
 
 // NewKeeper creates a slashing keeper
-func NewKeeper(environment appmodule.Environment, cdc codec.BinaryCodec, legacyAmino *codec.LegacyAmino, sk types.StakingKeeper, authority string) Keeper {
+func NewKeeper(environment appmodule.Environment, cdc codec.BinaryCodec, legacyAmino *codec.LegacyAmino, ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, authority string) Keeper {
 	sb := collections.NewSchemaBuilder(environment.KVStoreService)
 	k := Keeper{
 		environment: environment,
 		cdc:         cdc,
 		legacyAmino: legacyAmino,
+		ak:          ak,
+		bk:          bk,
 		sk:          sk,
 		authority:   authority,
 		Params:      collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
@@ -68,6 +76,20 @@ func NewKeeper(environment appmodule.Environment, cdc codec.BinaryCodec, legacyA
 			collections.PairKeyCodec(sdk.LengthPrefixedBytesKey, collections.Uint64Key),
 			collections.BytesValue,
 		),
+		InsuranceBonds: collections.NewMap(
+			sb,
+			types.InsuranceBondKeyPrefix,
+			"insurance_bonds",
+			sdk.ValAddressKey,
+			codec.CollValue[types.InsuranceBond](cdc),
+		),
+		SigningWindowBuckets: collections.NewMap(
+			sb,
+			types.SigningWindowBucketKeyPrefix,
+			"signing_window_buckets",
+			collections.PairKeyCodec(sdk.LengthPrefixedBytesKey, collections.Uint64Key),
+			codec.CollValue[types.SigningWindowBucket](cdc),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -34,6 +34,8 @@ type KeeperTestSuite struct {
 	suite.Suite
 
 	ctx            sdk.Context
+	accountKeeper  *slashingtestutil.MockAccountKeeper
+	bankKeeper     *slashingtestutil.MockBankKeeper
 	stakingKeeper  *slashingtestutil.MockStakingKeeper
 	slashingKeeper slashingkeeper.Keeper
 	queryClient    slashingtypes.QueryClient
@@ -52,6 +54,9 @@ func (s *KeeperTestSuite) SetupTest() {
 
 	// gomock initializations
 	ctrl := gomock.NewController(s.T())
+	s.accountKeeper = slashingtestutil.NewMockAccountKeeper(ctrl)
+	s.accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+	s.bankKeeper = slashingtestutil.NewMockBankKeeper(ctrl)
 	s.stakingKeeper = slashingtestutil.NewMockStakingKeeper(ctrl)
 	s.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
 	s.stakingKeeper.EXPECT().ConsensusAddressCodec().Return(address.NewBech32Codec("cosmosvalcons")).AnyTimes()
@@ -64,6 +69,8 @@ func (s *KeeperTestSuite) SetupTest() {
 		env,
 		encCfg.Codec,
 		encCfg.Amino,
+		s.accountKeeper,
+		s.bankKeeper,
 		s.stakingKeeper,
 		authStr,
 	)
@@ -161,7 +168,7 @@ func (s *KeeperTestSuite) TestValidatorMissedBlockBMMigrationToColls() {
 		func(i int64) {
 			s.ctx.KVStore(s.key).Set(validatorMissedBlockBitmapKey(consAddr, index), []byte{})
 		},
-		"7ad1f994d45ec9495ae5f990a3fba100c2cc70167a154c33fb43882dc004eafd",
+		"cd61136410fc4a07b5fb8a9b6dbbeaad2210293020e0aaaa79f629cbeecda66e",
 	)
 	s.Require().NoError(err)
 
@@ -173,7 +180,7 @@ func (s *KeeperTestSuite) TestValidatorMissedBlockBMMigrationToColls() {
 			err := s.slashingKeeper.SetMissedBlockBitmapChunk(s.ctx, consAddr, index, []byte{})
 			s.Require().NoError(err)
 		},
-		"7ad1f994d45ec9495ae5f990a3fba100c2cc70167a154c33fb43882dc004eafd",
+		"cd61136410fc4a07b5fb8a9b6dbbeaad2210293020e0aaaa79f629cbeecda66e",
 	)
 	s.Require().NoError(err)
 }
@@ -54,3 +54,74 @@ func (k msgServer) Unjail(ctx context.Context, msg *types.MsgUnjail) (*types.Msg
 
 	return &types.MsgUnjailResponse{}, nil
 }
+
+// Heartbeat implements MsgServer.Heartbeat method.
+// Validators outside the active set can submit a heartbeat to signal they are
+// ready to (re-)enter it.
+func (k msgServer) Heartbeat(ctx context.Context, msg *types.MsgHeartbeat) (*types.MsgHeartbeatResponse, error) {
+	valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddr)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("validator input address: %s", err)
+	}
+
+	if err := k.Keeper.Heartbeat(ctx, valAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgHeartbeatResponse{}, nil
+}
+
+// DepositInsuranceBond implements MsgServer.DepositInsuranceBond method.
+// Validators can lock funds in an insurance bond which compensates their
+// delegators when they are slashed for downtime.
+func (k msgServer) DepositInsuranceBond(ctx context.Context, msg *types.MsgDepositInsuranceBond) (*types.MsgDepositInsuranceBondResponse, error) {
+	valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddr)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("validator input address: %s", err)
+	}
+
+	if err := k.Keeper.DepositInsuranceBond(ctx, valAddr, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDepositInsuranceBondResponse{}, nil
+}
+
+// WithdrawInsuranceBond implements MsgServer.WithdrawInsuranceBond method.
+// Validators which are not jailed can withdraw funds from their insurance bond.
+func (k msgServer) WithdrawInsuranceBond(ctx context.Context, msg *types.MsgWithdrawInsuranceBond) (*types.MsgWithdrawInsuranceBondResponse, error) {
+	valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddr)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("validator input address: %s", err)
+	}
+
+	if err := k.Keeper.WithdrawInsuranceBond(ctx, valAddr, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawInsuranceBondResponse{}, nil
+}
+
+// RevertTombstone implements MsgServer.RevertTombstone method.
+// It defines a method for the authority to revert the tombstone of a validator
+// after a proven false-positive equivocation.
+func (k msgServer) RevertTombstone(ctx context.Context, msg *types.MsgRevertTombstone) (*types.MsgRevertTombstoneResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("validator input address: %s", err)
+	}
+
+	if msg.Reason == "" {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("reason cannot be empty")
+	}
+
+	if err := k.Keeper.RevertTombstone(ctx, valAddr, msg.Reason); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevertTombstoneResponse{}, nil
+}
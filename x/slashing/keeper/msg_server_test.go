@@ -3,6 +3,9 @@ package keeper_test
 import (
 	"time"
 
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/core/header"
 	sdkmath "cosmossdk.io/math"
 	slashingtypes "cosmossdk.io/x/slashing/types"
 	"cosmossdk.io/x/staking/types"
@@ -359,3 +362,126 @@ func (s *KeeperTestSuite) TestUnjail() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestHeartbeat() {
+	require := s.Require()
+
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(addr)
+	consAddr := sdk.ConsAddress(addr)
+	valStr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(addr)
+	require.NoError(err)
+	addrStr, err := ac.BytesToString(addr)
+	require.NoError(err)
+
+	val, err := types.NewValidator(valStr, pubKey, types.Description{Moniker: "test"})
+	require.NoError(err)
+	val.Tokens = sdkmath.NewInt(1000)
+	val.DelegatorShares = sdkmath.LegacyNewDec(1)
+
+	// bonded validators cannot send heartbeats
+	val.Status = types.Bonded
+	s.stakingKeeper.EXPECT().Validator(s.ctx, valAddr).Return(val, nil)
+	_, err = s.msgServer.Heartbeat(s.ctx, slashingtypes.NewMsgHeartbeat(valStr))
+	require.ErrorIs(err, slashingtypes.ErrValidatorBonded)
+
+	// the signing info of a never bonded validator is created
+	val.Status = types.Unbonded
+	s.stakingKeeper.EXPECT().Validator(s.ctx, valAddr).Return(val, nil)
+	_, err = s.msgServer.Heartbeat(s.ctx, slashingtypes.NewMsgHeartbeat(valStr))
+	require.NoError(err)
+
+	info, err := s.slashingKeeper.ValidatorSigningInfo.Get(s.ctx, consAddr)
+	require.NoError(err)
+	require.Equal(s.ctx.HeaderInfo().Height, info.LastHeartbeatHeight)
+	require.Equal(s.ctx.HeaderInfo().Time, info.LastHeartbeatTime)
+
+	// a recent heartbeat is required to unjail
+	params, err := s.slashingKeeper.Params.Get(s.ctx)
+	require.NoError(err)
+	params.UnjailHeartbeatWindow = time.Hour
+	require.NoError(s.slashingKeeper.Params.Set(s.ctx, params))
+
+	val.Jailed = true
+	del := types.NewDelegation(addrStr, valStr, sdkmath.LegacyNewDec(100))
+	s.ctx = s.ctx.WithHeaderInfo(header.Info{Height: s.ctx.HeaderInfo().Height + 1, Time: s.ctx.HeaderInfo().Time.Add(2 * time.Hour)})
+	s.stakingKeeper.EXPECT().Validator(s.ctx, valAddr).Return(val, nil).Times(3)
+	s.stakingKeeper.EXPECT().Delegation(s.ctx, addr, valAddr).Return(del, nil).Times(2)
+	_, err = s.msgServer.Unjail(s.ctx, slashingtypes.NewMsgUnjail(valStr))
+	require.ErrorIs(err, slashingtypes.ErrMissingHeartbeat)
+
+	_, err = s.msgServer.Heartbeat(s.ctx, slashingtypes.NewMsgHeartbeat(valStr))
+	require.NoError(err)
+
+	s.stakingKeeper.EXPECT().Unjail(s.ctx, consAddr).Return(nil)
+	_, err = s.msgServer.Unjail(s.ctx, slashingtypes.NewMsgUnjail(valStr))
+	require.NoError(err)
+}
+
+func (s *KeeperTestSuite) TestRevertTombstone() {
+	require := s.Require()
+
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(addr)
+	consAddr := sdk.ConsAddress(addr)
+	valStr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(addr)
+	require.NoError(err)
+	addrStr, err := ac.BytesToString(addr)
+	require.NoError(err)
+	authority := s.slashingKeeper.GetAuthority()
+
+	val, err := types.NewValidator(valStr, pubKey, types.Description{Moniker: "test"})
+	require.NoError(err)
+	val.Tokens = sdkmath.NewInt(1000)
+	val.DelegatorShares = sdkmath.LegacyNewDec(1)
+	val.Jailed = true
+
+	// tombstoned validators are jailed forever by x/evidence
+	info := slashingtypes.NewValidatorSigningInfo(consAddr.String(), 0, time.Unix(253402300799, 0), true, 10)
+	require.NoError(s.slashingKeeper.ValidatorSigningInfo.Set(s.ctx, consAddr, info))
+
+	_, err = s.msgServer.RevertTombstone(s.ctx, slashingtypes.NewMsgRevertTombstone(addrStr, valStr, "consensus bug"))
+	require.ErrorIs(err, slashingtypes.ErrInvalidSigner)
+
+	// tombstone reversals are disabled without a cooldown
+	s.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+	_, err = s.msgServer.RevertTombstone(s.ctx, slashingtypes.NewMsgRevertTombstone(authority, valStr, "consensus bug"))
+	require.ErrorIs(err, slashingtypes.ErrTombstoneReversalDisabled)
+
+	params, err := s.slashingKeeper.Params.Get(s.ctx)
+	require.NoError(err)
+	params.TombstoneReversalCooldown = 24 * time.Hour
+	require.NoError(s.slashingKeeper.Params.Set(s.ctx, params))
+
+	_, err = s.msgServer.RevertTombstone(s.ctx, slashingtypes.NewMsgRevertTombstone(authority, valStr, ""))
+	require.Error(err)
+
+	s.stakingKeeper.EXPECT().ValidatorIdentifier(gomock.Any(), consAddr).Return(nil, nil).AnyTimes()
+	require.NoError(s.slashingKeeper.SetMissedBlockBitmapValue(s.ctx, consAddr, 0, true))
+	_, err = s.msgServer.RevertTombstone(s.ctx, slashingtypes.NewMsgRevertTombstone(authority, valStr, "consensus bug"))
+	require.NoError(err)
+
+	info, err = s.slashingKeeper.ValidatorSigningInfo.Get(s.ctx, consAddr)
+	require.NoError(err)
+	require.False(info.Tombstoned)
+	require.Equal(s.ctx.HeaderInfo().Time.Add(params.TombstoneReversalCooldown), info.JailedUntil)
+	require.Zero(info.MissedBlocksCounter)
+	missed, err := s.slashingKeeper.GetMissedBlockBitmapValue(s.ctx, consAddr, 0)
+	require.NoError(err)
+	require.False(missed)
+
+	// the tombstone can only be reverted once
+	_, err = s.msgServer.RevertTombstone(s.ctx, slashingtypes.NewMsgRevertTombstone(authority, valStr, "consensus bug"))
+	require.ErrorIs(err, slashingtypes.ErrValidatorNotTombstoned)
+
+	// the validator can be unjailed after the cooldown
+	del := types.NewDelegation(addrStr, valStr, sdkmath.LegacyNewDec(100))
+	s.stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del, nil).Times(2)
+	_, err = s.msgServer.Unjail(s.ctx, slashingtypes.NewMsgUnjail(valStr))
+	require.ErrorIs(err, slashingtypes.ErrValidatorJailed)
+
+	s.ctx = s.ctx.WithHeaderInfo(header.Info{Height: s.ctx.HeaderInfo().Height + 1, Time: info.JailedUntil})
+	s.stakingKeeper.EXPECT().Unjail(s.ctx, consAddr).Return(nil)
+	_, err = s.msgServer.Unjail(s.ctx, slashingtypes.NewMsgUnjail(valStr))
+	require.NoError(err)
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/slashing/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PerformanceScore implements the x/staking ValidatorPerformanceScorer
+// interface, scoring a validator by its uptime over the signed blocks window:
+// one minus the fraction of the window it missed. Validators which were never
+// bonded, and thus have no signing info, score zero.
+func (k Keeper) PerformanceScore(ctx context.Context, valAddr sdk.ValAddress) (sdkmath.LegacyDec, error) {
+	validator, err := k.sk.Validator(ctx, valAddr)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+	if validator == nil {
+		return sdkmath.LegacyDec{}, types.ErrNoValidatorForAddress
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+
+	info, err := k.ValidatorSigningInfo.Get(ctx, consAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return sdkmath.LegacyZeroDec(), nil
+		}
+		return sdkmath.LegacyDec{}, err
+	}
+
+	window, err := k.SignedBlocksWindow(ctx)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+
+	missed := sdkmath.LegacyNewDec(info.MissedBlocksCounter).QuoInt64(window)
+	if missed.GT(sdkmath.LegacyOneDec()) {
+		return sdkmath.LegacyZeroDec(), nil
+	}
+
+	return sdkmath.LegacyOneDec().Sub(missed), nil
+}
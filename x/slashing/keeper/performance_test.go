@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	slashingtypes "cosmossdk.io/x/slashing/types"
+	"cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestPerformanceScore() {
+	require := s.Require()
+
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	valAddr := sdk.ValAddress(addr)
+	consAddr := sdk.ConsAddress(addr)
+	valStr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(addr)
+	require.NoError(err)
+	consStr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(addr)
+	require.NoError(err)
+
+	val, err := types.NewValidator(valStr, pubKey, types.Description{Moniker: "test"})
+	require.NoError(err)
+	s.stakingKeeper.EXPECT().Validator(s.ctx, valAddr).Return(val, nil).Times(3)
+
+	// a validator which was never bonded scores zero
+	score, err := s.slashingKeeper.PerformanceScore(s.ctx, valAddr)
+	require.NoError(err)
+	require.True(score.IsZero())
+
+	// the test params have a signed blocks window of 1000 blocks
+	info := slashingtypes.NewValidatorSigningInfo(consStr, 1, time.Unix(0, 0), false, 100)
+	require.NoError(s.slashingKeeper.ValidatorSigningInfo.Set(s.ctx, consAddr, info))
+	score, err = s.slashingKeeper.PerformanceScore(s.ctx, valAddr)
+	require.NoError(err)
+	require.Equal(sdkmath.LegacyNewDecWithPrec(9, 1), score)
+
+	info.MissedBlocksCounter = 0
+	require.NoError(s.slashingKeeper.ValidatorSigningInfo.Set(s.ctx, consAddr, info))
+	score, err = s.slashingKeeper.PerformanceScore(s.ctx, valAddr)
+	require.NoError(err)
+	require.Equal(sdkmath.LegacyOneDec(), score)
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/slashing/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultSigningWindows are the sizes, in blocks, of the signing windows
+// returned when none are requested.
+var DefaultSigningWindows = []uint64{1_000, 10_000, 100_000}
+
+// recordSigningWindowBlock records whether a validator signed the block at the
+// given height in its signing history. The bucket of the height takes over the
+// ring buffer slot of the bucket SigningWindowBuckets before it.
+func (k Keeper) recordSigningWindowBlock(ctx context.Context, addr sdk.ConsAddress, height int64, missed bool) error {
+	bucketIndex := uint64(height) / types.SigningWindowBucketBlocks
+	key := collections.Join(addr.Bytes(), bucketIndex%types.SigningWindowBuckets)
+
+	bucket, err := k.SigningWindowBuckets.Get(ctx, key)
+	switch {
+	case err == nil && bucket.Bucket == bucketIndex:
+	case err == nil || errors.Is(err, collections.ErrNotFound):
+		bucket = types.SigningWindowBucket{Bucket: bucketIndex}
+	default:
+		return err
+	}
+
+	if missed {
+		bucket.MissedBlocks++
+	} else {
+		bucket.SignedBlocks++
+	}
+
+	return k.SigningWindowBuckets.Set(ctx, key, bucket)
+}
+
+// GetSigningWindow returns the uptime of a validator over the last window
+// blocks of its signing history. Windows are aligned to buckets, so the most
+// recent bucket of a window may only be partially filled. Blocks during which
+// the validator was jailed are not counted.
+func (k Keeper) GetSigningWindow(ctx context.Context, addr sdk.ConsAddress, window uint64) (types.SigningWindow, error) {
+	if window == 0 || window%types.SigningWindowBucketBlocks != 0 || window > types.SigningWindowBucketBlocks*types.SigningWindowBuckets {
+		return types.SigningWindow{}, fmt.Errorf("signing window must be a positive multiple of %d blocks up to %d blocks, got %d",
+			types.SigningWindowBucketBlocks, types.SigningWindowBucketBlocks*types.SigningWindowBuckets, window)
+	}
+
+	height := k.environment.HeaderService.GetHeaderInfo(ctx).Height
+	current := uint64(height) / types.SigningWindowBucketBlocks
+	buckets := window / types.SigningWindowBucketBlocks
+
+	res := types.SigningWindow{Window: window, Uptime: sdkmath.LegacyZeroDec()}
+	rng := collections.NewPrefixedPairRange[[]byte, uint64](addr.Bytes())
+	err := k.SigningWindowBuckets.Walk(ctx, rng, func(_ collections.Pair[[]byte, uint64], bucket types.SigningWindowBucket) (bool, error) {
+		// skip the buckets left over in the ring buffer from before the window
+		if bucket.Bucket > current || current-bucket.Bucket >= buckets {
+			return false, nil
+		}
+
+		res.SignedBlocks += bucket.SignedBlocks
+		res.MissedBlocks += bucket.MissedBlocks
+		return false, nil
+	})
+	if err != nil {
+		return types.SigningWindow{}, err
+	}
+
+	if total := res.SignedBlocks + res.MissedBlocks; total > 0 {
+		res.Uptime = sdkmath.LegacyNewDec(int64(res.SignedBlocks)).QuoInt64(int64(total))
+	}
+
+	return res, nil
+}
+
+// DeleteSigningWindowBuckets removes a validator's signing history from state.
+func (k Keeper) DeleteSigningWindowBuckets(ctx context.Context, addr sdk.ConsAddress) error {
+	return k.SigningWindowBuckets.Clear(ctx, collections.NewPrefixedPairRange[[]byte, uint64](addr.Bytes()))
+}
@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/comet"
+	"cosmossdk.io/core/header"
+	sdkmath "cosmossdk.io/math"
+	slashingtypes "cosmossdk.io/x/slashing/types"
+	"cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestSigningWindows() {
+	require := s.Require()
+
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	valStr, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(addr)
+	require.NoError(err)
+	consAddr := sdk.ConsAddress(pubKey.Address())
+	consStr, err := s.stakingKeeper.ConsensusAddressCodec().BytesToString(consAddr)
+	require.NoError(err)
+
+	val, err := types.NewValidator(valStr, pubKey, types.Description{Moniker: "test"})
+	require.NoError(err)
+	s.stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), consAddr).Return(val, nil).AnyTimes()
+	s.stakingKeeper.EXPECT().ValidatorIdentifier(gomock.Any(), consAddr).Return(consAddr, nil).AnyTimes()
+	require.NoError(s.slashingKeeper.ValidatorSigningInfo.Set(s.ctx, consAddr, slashingtypes.NewValidatorSigningInfo(consStr, 0, time.Unix(0, 0), false, 0)))
+
+	// the signing history of the last 100 buckets, with a stale bucket in the
+	// ring buffer slot of the current bucket
+	for _, bucket := range []slashingtypes.SigningWindowBucket{
+		{Bucket: 0, SignedBlocks: 1000},
+		{Bucket: 1, MissedBlocks: 1000},
+		{Bucket: 90, SignedBlocks: 1000},
+		{Bucket: 99, SignedBlocks: 900, MissedBlocks: 100},
+	} {
+		key := collections.Join(consAddr.Bytes(), bucket.Bucket%slashingtypes.SigningWindowBuckets)
+		require.NoError(s.slashingKeeper.SigningWindowBuckets.Set(s.ctx, key, bucket))
+	}
+
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: 100_500})
+	require.NoError(s.slashingKeeper.HandleValidatorSignature(ctx, pubKey.Address(), 100, comet.BlockIDFlagAbsent))
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 100_501})
+	require.NoError(s.slashingKeeper.HandleValidatorSignature(ctx, pubKey.Address(), 100, comet.BlockIDFlagCommit))
+
+	res, err := s.slashingKeeper.SigningWindows(ctx, &slashingtypes.QuerySigningWindowsRequest{ConsAddress: consStr})
+	require.NoError(err)
+	require.Equal([]slashingtypes.SigningWindow{
+		{Window: 1_000, SignedBlocks: 1, MissedBlocks: 1, Uptime: sdkmath.LegacyNewDecWithPrec(5, 1)},
+		{Window: 10_000, SignedBlocks: 901, MissedBlocks: 101, Uptime: sdkmath.LegacyNewDec(901).QuoInt64(1002)},
+		{Window: 100_000, SignedBlocks: 1901, MissedBlocks: 1101, Uptime: sdkmath.LegacyNewDec(1901).QuoInt64(3002)},
+	}, res.Windows)
+
+	// windows must be multiples of the bucket size within the signing history
+	_, err = s.slashingKeeper.SigningWindows(ctx, &slashingtypes.QuerySigningWindowsRequest{ConsAddress: consStr, Windows: []uint64{1_500}})
+	require.Error(err)
+	_, err = s.slashingKeeper.SigningWindows(ctx, &slashingtypes.QuerySigningWindowsRequest{ConsAddress: consStr, Windows: []uint64{101_000}})
+	require.Error(err)
+
+	// the signing history is removed with the validator
+	require.NoError(s.slashingKeeper.DeleteSigningWindowBuckets(ctx, consAddr))
+	window, err := s.slashingKeeper.GetSigningWindow(ctx, consAddr, 100_000)
+	require.NoError(err)
+	require.Equal(slashingtypes.SigningWindow{Window: 100_000, Uptime: sdkmath.LegacyZeroDec()}, window)
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/slashing/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RevertTombstone reverts the tombstone of a validator, which stays jailed for
+// the tombstone reversal cooldown. Its missed blocks are reset, so that it isn't
+// slashed for downtime right after being unjailed. The stake slashed for the
+// equivocation is not restored.
+func (k Keeper) RevertTombstone(ctx context.Context, validatorAddr sdk.ValAddress, reason string) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.TombstoneReversalCooldown == 0 {
+		return types.ErrTombstoneReversalDisabled
+	}
+
+	validator, err := k.sk.Validator(ctx, validatorAddr)
+	if err != nil {
+		return err
+	}
+	if validator == nil {
+		return types.ErrNoValidatorForAddress
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+
+	info, err := k.ValidatorSigningInfo.Get(ctx, consAddr)
+	if err != nil {
+		return types.ErrNoSigningInfoFound.Wrap(err.Error())
+	}
+
+	if !info.Tombstoned {
+		return types.ErrValidatorNotTombstoned
+	}
+
+	info.Tombstoned = false
+	info.JailedUntil = k.environment.HeaderService.GetHeaderInfo(ctx).Time.Add(params.TombstoneReversalCooldown)
+	info.MissedBlocksCounter = 0
+	if err := k.DeleteMissedBlockBitmap(ctx, consAddr); err != nil {
+		return err
+	}
+	if err := k.ValidatorSigningInfo.Set(ctx, consAddr, info); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info(
+		"reverted validator tombstone",
+		"validator", validator.GetOperator(),
+		"jailed_until", info.JailedUntil,
+		"reason", reason,
+	)
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeRevertTombstone,
+		event.NewAttribute(types.AttributeKeyValidator, validator.GetOperator()),
+		event.NewAttribute(types.AttributeKeyAddress, info.Address),
+		event.NewAttribute(types.AttributeKeyJailedUntil, info.JailedUntil.Format(time.RFC3339)),
+		event.NewAttribute(types.AttributeKeyReason, reason),
+	)
+}
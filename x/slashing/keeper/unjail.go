@@ -67,5 +67,17 @@ func (k Keeper) Unjail(ctx context.Context, validatorAddr sdk.ValAddress) error
 		}
 	}
 
+	// cannot be unjailed without a recent heartbeat, if required
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.UnjailHeartbeatWindow > 0 {
+		now := k.environment.HeaderService.GetHeaderInfo(ctx).Time
+		if info.LastHeartbeatHeight == 0 || now.Sub(info.LastHeartbeatTime) > params.UnjailHeartbeatWindow {
+			return errors.Wrapf(types.ErrMissingHeartbeat, "no heartbeat since %s", now.Add(-params.UnjailHeartbeatWindow))
+		}
+	}
+
 	return k.sk.Unjail(ctx, consAddr)
 }
@@ -12,14 +12,22 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/slashing/Params", nil)
 	legacy.RegisterAminoMsg(cdc, &MsgUnjail{}, "cosmos-sdk/MsgUnjail")
+	legacy.RegisterAminoMsg(cdc, &MsgHeartbeat{}, "cosmos-sdk/MsgHeartbeat")
+	legacy.RegisterAminoMsg(cdc, &MsgDepositInsuranceBond{}, "cosmos-sdk/MsgDepositInsuranceBond")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawInsuranceBond{}, "cosmos-sdk/MsgWithdrawInsuranceBond")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/slashing/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgRevertTombstone{}, "cosmos-sdk/MsgRevertTombstone")
 }
 
 // RegisterInterfaces registers the interfaces types with the Interface Registry.
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUnjail{},
+		&MsgHeartbeat{},
+		&MsgDepositInsuranceBond{},
+		&MsgWithdrawInsuranceBond{},
 		&MsgUpdateParams{},
+		&MsgRevertTombstone{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
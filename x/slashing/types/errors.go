@@ -14,4 +14,11 @@ var (
 	ErrValidatorTombstoned          = errors.Register(ModuleName, 9, "validator already tombstoned")
 	ErrInvalidSigner                = errors.Register(ModuleName, 10, "expected authority account as only signer for proposal message")
 	ErrInvalidConsPubKey            = errors.Register(ModuleName, 11, "invalid consensus pubkey")
+	ErrValidatorBonded              = errors.Register(ModuleName, 12, "validator is bonded; heartbeats are only accepted from validators outside the active set")
+	ErrMissingHeartbeat             = errors.Register(ModuleName, 13, "validator has no recent heartbeat; cannot be unjailed")
+	ErrInvalidInsuranceBondDenom    = errors.Register(ModuleName, 14, "insurance bond must be denominated in the bond denom")
+	ErrInsufficientInsuranceBond    = errors.Register(ModuleName, 15, "insufficient insurance bond")
+	ErrInsuranceBondLocked          = errors.Register(ModuleName, 16, "insurance bond is locked while the validator is jailed")
+	ErrTombstoneReversalDisabled    = errors.Register(ModuleName, 17, "tombstone reversals are disabled")
+	ErrValidatorNotTombstoned       = errors.Register(ModuleName, 18, "validator is not tombstoned")
 )
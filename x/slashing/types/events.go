@@ -2,8 +2,14 @@ package types
 
 // Slashing module event types
 const (
-	EventTypeSlash    = "slash"
-	EventTypeLiveness = "liveness"
+	EventTypeSlash     = "slash"
+	EventTypeLiveness  = "liveness"
+	EventTypeHeartbeat = "heartbeat"
+
+	EventTypeDepositInsuranceBond  = "deposit_insurance_bond"
+	EventTypeWithdrawInsuranceBond = "withdraw_insurance_bond"
+	EventTypeInsurancePayout       = "insurance_payout"
+	EventTypeRevertTombstone       = "revert_tombstone"
 
 	AttributeKeyAddress      = "address"
 	AttributeKeyHeight       = "height"
@@ -12,6 +18,10 @@ const (
 	AttributeKeyJailed       = "jailed"
 	AttributeKeyMissedBlocks = "missed_blocks"
 	AttributeKeyBurnedCoins  = "burned_coins"
+	AttributeKeyValidator    = "validator"
+	AttributeKeyDelegator    = "delegator"
+	AttributeKeyAmount       = "amount"
+	AttributeKeyJailedUntil  = "jailed_until"
 
 	AttributeValueUnspecified      = "unspecified"
 	AttributeValueDoubleSign       = "double_sign"
@@ -23,6 +23,9 @@ type BankKeeper interface {
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	LockedCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 }
 
 // StakingKeeper expected staking keeper
@@ -53,6 +56,12 @@ type StakingKeeper interface {
 	// IsValidatorJailed returns if the validator is jailed.
 	IsValidatorJailed(ctx context.Context, addr sdk.ConsAddress) (bool, error)
 
+	// GetValidatorDelegations returns all the delegations to a validator.
+	GetValidatorDelegations(ctx context.Context, valAddr sdk.ValAddress) ([]stakingtypes.Delegation, error)
+
+	// BondDenom returns the denom of the staking tokens.
+	BondDenom(ctx context.Context) (string, error)
+
 	// ValidatorIdentifier maps the new cons key to previous cons key (which is the address before the rotation).
 	// (that is: newConsKey -> oldConsKey)
 	ValidatorIdentifier(context.Context, sdk.ConsAddress) (sdk.ConsAddress, error)
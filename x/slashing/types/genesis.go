@@ -29,9 +29,10 @@ func NewMissedBlock(index int64, missed bool) MissedBlock {
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:       DefaultParams(),
-		SigningInfos: []SigningInfo{},
-		MissedBlocks: []ValidatorMissedBlocks{},
+		Params:         DefaultParams(),
+		SigningInfos:   []SigningInfo{},
+		MissedBlocks:   []ValidatorMissedBlocks{},
+		InsuranceBonds: []InsuranceBond{},
 	}
 }
 
@@ -62,5 +63,20 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	seenBonds := make(map[string]bool, len(data.InsuranceBonds))
+	for _, bond := range data.InsuranceBonds {
+		if seenBonds[bond.ValidatorAddress] {
+			return fmt.Errorf("duplicate insurance bond for validator %s", bond.ValidatorAddress)
+		}
+		seenBonds[bond.ValidatorAddress] = true
+
+		if err := bond.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid insurance bond of validator %s: %w", bond.ValidatorAddress, err)
+		}
+		if err := bond.PaidOut.Validate(); err != nil {
+			return fmt.Errorf("invalid insurance bond payouts of validator %s: %w", bond.ValidatorAddress, err)
+		}
+	}
+
 	return nil
 }
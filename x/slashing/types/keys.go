@@ -36,6 +36,16 @@ const (
 	// (N - 256) + (N / ChunkSize) * (512 * f)
 	MissedBlockBitmapChunkSize = 1024 // 2^10 bits
 
+	// SigningWindowBucketBlocks defines the number of blocks aggregated in one
+	// bucket of a validator's signing history.
+	SigningWindowBucketBlocks = 1000
+
+	// SigningWindowBuckets defines the number of buckets kept in a validator's
+	// signing history ring buffer, which bounds the largest signing window
+	// that can be queried to SigningWindowBuckets * SigningWindowBucketBlocks
+	// blocks.
+	SigningWindowBuckets = 100
+
 	// GovModuleName duplicates the gov module's name to avoid a cyclic dependency with x/gov.
 	// It should be synced with the gov module's name if it is ever changed.
 	// See: https://github.com/cosmos/cosmos-sdk/blob/b62a28aac041829da5ded4aeacfcd7a42873d1c8/x/gov/types/keys.go#L9
@@ -50,12 +60,18 @@ const (
 // - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><chunk_index>: bitmap_chunk
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x04<valAddr_Bytes>: InsuranceBond
+//
+// - 0x05<consAddrLen (1 Byte)><consAddress_Bytes><slot>: SigningWindowBucket
 
 var (
 	ParamsKey                           = collections.NewPrefix(0) // Prefix for params key
 	ValidatorSigningInfoKeyPrefix       = collections.NewPrefix(1) // Prefix for signing info
 	ValidatorMissedBlockBitmapKeyPrefix = collections.NewPrefix(2) // Prefix for missed block bitmap
 	AddrPubkeyRelationKeyPrefix         = collections.NewPrefix(3) // Prefix for address-pubkey relation
+	InsuranceBondKeyPrefix              = collections.NewPrefix(4) // Prefix for insurance bond
+	SigningWindowBucketKeyPrefix        = collections.NewPrefix(5) // Prefix for signing window bucket
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
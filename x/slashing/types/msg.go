@@ -7,7 +7,11 @@ import (
 // verify interface at compile time
 var (
 	_ sdk.Msg = &MsgUnjail{}
+	_ sdk.Msg = &MsgHeartbeat{}
+	_ sdk.Msg = &MsgDepositInsuranceBond{}
+	_ sdk.Msg = &MsgWithdrawInsuranceBond{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgRevertTombstone{}
 )
 
 // NewMsgUnjail creates a new MsgUnjail instance
@@ -16,3 +20,35 @@ func NewMsgUnjail(validatorAddr string) *MsgUnjail {
 		ValidatorAddr: validatorAddr,
 	}
 }
+
+// NewMsgHeartbeat creates a new MsgHeartbeat instance
+func NewMsgHeartbeat(validatorAddr string) *MsgHeartbeat {
+	return &MsgHeartbeat{
+		ValidatorAddr: validatorAddr,
+	}
+}
+
+// NewMsgDepositInsuranceBond creates a new MsgDepositInsuranceBond instance
+func NewMsgDepositInsuranceBond(validatorAddr string, amount sdk.Coin) *MsgDepositInsuranceBond {
+	return &MsgDepositInsuranceBond{
+		ValidatorAddr: validatorAddr,
+		Amount:        amount,
+	}
+}
+
+// NewMsgRevertTombstone creates a new MsgRevertTombstone instance
+func NewMsgRevertTombstone(authority, validatorAddr, reason string) *MsgRevertTombstone {
+	return &MsgRevertTombstone{
+		Authority:        authority,
+		ValidatorAddress: validatorAddr,
+		Reason:           reason,
+	}
+}
+
+// NewMsgWithdrawInsuranceBond creates a new MsgWithdrawInsuranceBond instance
+func NewMsgWithdrawInsuranceBond(validatorAddr string, amount sdk.Coin) *MsgWithdrawInsuranceBond {
+	return &MsgWithdrawInsuranceBond{
+		ValidatorAddr: validatorAddr,
+		Amount:        amount,
+	}
+}
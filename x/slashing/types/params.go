@@ -61,6 +61,23 @@ func (p Params) Validate() error {
 	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
 		return err
 	}
+	if err := validateUnjailHeartbeatWindow(p.UnjailHeartbeatWindow); err != nil {
+		return err
+	}
+	for _, fraction := range p.DowntimeSlashFractionTiers {
+		if err := validateSlashFractionDowntime(fraction); err != nil {
+			return err
+		}
+	}
+	if err := validateDowntimeOffenseDecayPeriod(p.DowntimeOffenseDecayPeriod); err != nil {
+		return err
+	}
+	if err := validateTombstoneReversalCooldown(p.TombstoneReversalCooldown); err != nil {
+		return err
+	}
+	if err := validateNewValidatorGraceBlocks(p.NewValidatorGraceBlocks); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -147,6 +164,77 @@ func validateSlashFractionDowntime(i interface{}) error {
 	return nil
 }
 
+func validateUnjailHeartbeatWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("unjail heartbeat window cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateDowntimeOffenseDecayPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("downtime offense decay period cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateTombstoneReversalCooldown(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("tombstone reversal cooldown cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateNewValidatorGraceBlocks(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("new validator grace blocks cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
+// DowntimeSlashFraction returns the fraction slashed for the given downtime
+// offense of a validator, counted from one, from the downtime slash fraction
+// tiers if set.
+func (p Params) DowntimeSlashFraction(offense uint64) math.LegacyDec {
+	tiers := p.DowntimeSlashFractionTiers
+	if len(tiers) == 0 {
+		return p.SlashFractionDowntime
+	}
+
+	if offense > uint64(len(tiers)) {
+		offense = uint64(len(tiers))
+	}
+	if offense == 0 {
+		offense = 1
+	}
+
+	return tiers[offense-1]
+}
+
 // MinSignedPerWindowInt returns min signed per window as an integer (vs the decimal in the param)
 func (p *Params) MinSignedPerWindowInt() int64 {
 	signedBlocksWindow := p.SignedBlocksWindow
@@ -17,3 +17,28 @@ func NewValidatorSigningInfo(
 		MissedBlocksCounter: missedBlocksCounter,
 	}
 }
+
+// GraceBlocksRemaining returns the number of blocks left at the given height
+// in the grace period of the validator.
+func (i ValidatorSigningInfo) GraceBlocksRemaining(height int64) int64 {
+	if i.GraceEndHeight <= height {
+		return 0
+	}
+	return i.GraceEndHeight - height
+}
+
+// DecayedDowntimeOffenses returns the number of downtime offenses of the
+// validator at the given time, decremented once every decay period elapsed
+// since its last offense. A zero decay period never decrements it.
+func (i ValidatorSigningInfo) DecayedDowntimeOffenses(now time.Time, decayPeriod time.Duration) uint64 {
+	if decayPeriod <= 0 || i.DowntimeOffenses == 0 || !now.After(i.LastDowntimeOffenseTime) {
+		return i.DowntimeOffenses
+	}
+
+	decays := uint64(now.Sub(i.LastDowntimeOffenseTime) / decayPeriod)
+	if decays >= i.DowntimeOffenses {
+		return 0
+	}
+
+	return i.DowntimeOffenses - decays
+}
@@ -7,6 +7,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -50,6 +51,20 @@ type ValidatorSigningInfo struct {
 	// A counter of missed (unsigned) blocks. It is used to avoid unnecessary
 	// reads in the missed block bitmap.
 	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// Height of the last heartbeat sent by the validator while outside the
+	// active set.
+	LastHeartbeatHeight int64 `protobuf:"varint,7,opt,name=last_heartbeat_height,json=lastHeartbeatHeight,proto3" json:"last_heartbeat_height,omitempty"`
+	// Timestamp of the last heartbeat sent by the validator while outside the
+	// active set.
+	LastHeartbeatTime time.Time `protobuf:"bytes,8,opt,name=last_heartbeat_time,json=lastHeartbeatTime,proto3,stdtime" json:"last_heartbeat_time"`
+	// Number of downtime offenses of the validator, as of the last one, which
+	// decays by one every downtime_offense_decay_period.
+	DowntimeOffenses uint64 `protobuf:"varint,9,opt,name=downtime_offenses,json=downtimeOffenses,proto3" json:"downtime_offenses,omitempty"`
+	// Timestamp of the last downtime offense of the validator.
+	LastDowntimeOffenseTime time.Time `protobuf:"bytes,10,opt,name=last_downtime_offense_time,json=lastDowntimeOffenseTime,proto3,stdtime" json:"last_downtime_offense_time"`
+	// Height until which the validator accrues missed blocks without being
+	// jailed for downtime, set when the validator is bonded for the first time.
+	GraceEndHeight int64 `protobuf:"varint,11,opt,name=grace_end_height,json=graceEndHeight,proto3" json:"grace_end_height,omitempty"`
 }
 
 func (m *ValidatorSigningInfo) Reset()         { *m = ValidatorSigningInfo{} }
@@ -128,6 +143,41 @@ func (m *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
 	return 0
 }
 
+func (m *ValidatorSigningInfo) GetLastHeartbeatHeight() int64 {
+	if m != nil {
+		return m.LastHeartbeatHeight
+	}
+	return 0
+}
+
+func (m *ValidatorSigningInfo) GetLastHeartbeatTime() time.Time {
+	if m != nil {
+		return m.LastHeartbeatTime
+	}
+	return time.Time{}
+}
+
+func (m *ValidatorSigningInfo) GetDowntimeOffenses() uint64 {
+	if m != nil {
+		return m.DowntimeOffenses
+	}
+	return 0
+}
+
+func (m *ValidatorSigningInfo) GetLastDowntimeOffenseTime() time.Time {
+	if m != nil {
+		return m.LastDowntimeOffenseTime
+	}
+	return time.Time{}
+}
+
+func (m *ValidatorSigningInfo) GetGraceEndHeight() int64 {
+	if m != nil {
+		return m.GraceEndHeight
+	}
+	return 0
+}
+
 // Params represents the parameters used for by the slashing module.
 type Params struct {
 	SignedBlocksWindow      int64                       `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
@@ -135,6 +185,26 @@ type Params struct {
 	DowntimeJailDuration    time.Duration               `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_downtime"`
+	// unjail_heartbeat_window is the period within which a jailed validator must
+	// have sent a heartbeat to be unjailed. Zero disables the requirement.
+	UnjailHeartbeatWindow time.Duration `protobuf:"bytes,6,opt,name=unjail_heartbeat_window,json=unjailHeartbeatWindow,proto3,stdduration" json:"unjail_heartbeat_window"`
+	// downtime_slash_fraction_tiers are the fractions slashed for the successive
+	// downtime offenses of a validator, the last one applying to all the
+	// offenses after it, e.g. [0, 0.0001, 0.001] only jails a validator for its
+	// first offense. slash_fraction_downtime applies to all the offenses if
+	// empty.
+	DowntimeSlashFractionTiers []cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,rep,name=downtime_slash_fraction_tiers,json=downtimeSlashFractionTiers,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"downtime_slash_fraction_tiers"`
+	// downtime_offense_decay_period is the period after which the downtime
+	// offense counter of a validator is decremented, the counter decrementing
+	// again every period since the last offense. Zero never decrements it.
+	DowntimeOffenseDecayPeriod time.Duration `protobuf:"bytes,8,opt,name=downtime_offense_decay_period,json=downtimeOffenseDecayPeriod,proto3,stdduration" json:"downtime_offense_decay_period"`
+	// tombstone_reversal_cooldown is the period a validator whose tombstone is
+	// reverted stays jailed for. Zero disables tombstone reversals.
+	TombstoneReversalCooldown time.Duration `protobuf:"bytes,9,opt,name=tombstone_reversal_cooldown,json=tombstoneReversalCooldown,proto3,stdduration" json:"tombstone_reversal_cooldown"`
+	// new_validator_grace_blocks is the number of blocks after its first bonding
+	// during which a validator accrues missed blocks without being jailed for
+	// downtime. Zero disables the grace period.
+	NewValidatorGraceBlocks int64 `protobuf:"varint,10,opt,name=new_validator_grace_blocks,json=newValidatorGraceBlocks,proto3" json:"new_validator_grace_blocks,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -184,9 +254,241 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetUnjailHeartbeatWindow() time.Duration {
+	if m != nil {
+		return m.UnjailHeartbeatWindow
+	}
+	return 0
+}
+
+func (m *Params) GetDowntimeOffenseDecayPeriod() time.Duration {
+	if m != nil {
+		return m.DowntimeOffenseDecayPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetTombstoneReversalCooldown() time.Duration {
+	if m != nil {
+		return m.TombstoneReversalCooldown
+	}
+	return 0
+}
+
+func (m *Params) GetNewValidatorGraceBlocks() int64 {
+	if m != nil {
+		return m.NewValidatorGraceBlocks
+	}
+	return 0
+}
+
+// InsuranceBond defines the insurance bond locked by a validator to compensate
+// its delegators for the stake they lose when the validator is slashed for
+// downtime.
+type InsuranceBond struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// amount is the amount of the bond left to compensate delegators.
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// paid_out is the total amount of the bond paid out to delegators.
+	PaidOut types.Coin `protobuf:"bytes,3,opt,name=paid_out,json=paidOut,proto3" json:"paid_out"`
+}
+
+func (m *InsuranceBond) Reset()         { *m = InsuranceBond{} }
+func (m *InsuranceBond) String() string { return proto.CompactTextString(m) }
+func (*InsuranceBond) ProtoMessage()    {}
+func (*InsuranceBond) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
+}
+func (m *InsuranceBond) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsuranceBond) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsuranceBond.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InsuranceBond) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsuranceBond.Merge(m, src)
+}
+func (m *InsuranceBond) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsuranceBond) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsuranceBond.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsuranceBond proto.InternalMessageInfo
+
+func (m *InsuranceBond) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *InsuranceBond) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *InsuranceBond) GetPaidOut() types.Coin {
+	if m != nil {
+		return m.PaidOut
+	}
+	return types.Coin{}
+}
+
+// SigningWindowBucket defines the number of blocks signed and missed by a
+// validator within one bucket of its signing history. Buckets are stored in a
+// ring buffer, so the slot of a bucket is reused once it is too old to be part
+// of any signing window.
+type SigningWindowBucket struct {
+	// bucket is the index of the bucket, i.e. the height divided by the bucket
+	// size.
+	Bucket uint64 `protobuf:"varint,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// signed_blocks is the number of blocks of the bucket signed by the validator.
+	SignedBlocks uint64 `protobuf:"varint,2,opt,name=signed_blocks,json=signedBlocks,proto3" json:"signed_blocks,omitempty"`
+	// missed_blocks is the number of blocks of the bucket missed by the validator.
+	MissedBlocks uint64 `protobuf:"varint,3,opt,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks,omitempty"`
+}
+
+func (m *SigningWindowBucket) Reset()         { *m = SigningWindowBucket{} }
+func (m *SigningWindowBucket) String() string { return proto.CompactTextString(m) }
+func (*SigningWindowBucket) ProtoMessage()    {}
+func (*SigningWindowBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{3}
+}
+func (m *SigningWindowBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningWindowBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningWindowBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigningWindowBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningWindowBucket.Merge(m, src)
+}
+func (m *SigningWindowBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningWindowBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningWindowBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigningWindowBucket proto.InternalMessageInfo
+
+func (m *SigningWindowBucket) GetBucket() uint64 {
+	if m != nil {
+		return m.Bucket
+	}
+	return 0
+}
+
+func (m *SigningWindowBucket) GetSignedBlocks() uint64 {
+	if m != nil {
+		return m.SignedBlocks
+	}
+	return 0
+}
+
+func (m *SigningWindowBucket) GetMissedBlocks() uint64 {
+	if m != nil {
+		return m.MissedBlocks
+	}
+	return 0
+}
+
+// SigningWindow defines the uptime of a validator over a window of recent
+// blocks.
+type SigningWindow struct {
+	// window is the number of blocks of the window.
+	Window uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// signed_blocks is the number of blocks of the window signed by the validator.
+	SignedBlocks uint64 `protobuf:"varint,2,opt,name=signed_blocks,json=signedBlocks,proto3" json:"signed_blocks,omitempty"`
+	// missed_blocks is the number of blocks of the window missed by the validator.
+	MissedBlocks uint64 `protobuf:"varint,3,opt,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks,omitempty"`
+	// uptime is the fraction of the blocks of the window signed by the validator.
+	Uptime cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=uptime,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"uptime"`
+}
+
+func (m *SigningWindow) Reset()         { *m = SigningWindow{} }
+func (m *SigningWindow) String() string { return proto.CompactTextString(m) }
+func (*SigningWindow) ProtoMessage()    {}
+func (*SigningWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{4}
+}
+func (m *SigningWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigningWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningWindow.Merge(m, src)
+}
+func (m *SigningWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigningWindow proto.InternalMessageInfo
+
+func (m *SigningWindow) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *SigningWindow) GetSignedBlocks() uint64 {
+	if m != nil {
+		return m.SignedBlocks
+	}
+	return 0
+}
+
+func (m *SigningWindow) GetMissedBlocks() uint64 {
+	if m != nil {
+		return m.MissedBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
+	proto.RegisterType((*InsuranceBond)(nil), "cosmos.slashing.v1beta1.InsuranceBond")
+	proto.RegisterType((*SigningWindowBucket)(nil), "cosmos.slashing.v1beta1.SigningWindowBucket")
+	proto.RegisterType((*SigningWindow)(nil), "cosmos.slashing.v1beta1.SigningWindow")
 }
 
 func init() {
@@ -194,47 +496,73 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 633 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xee, 0x00, 0x3f, 0x7e, 0x3a, 0xad, 0x07, 0xc7, 0x22, 0x4b, 0x95, 0x6d, 0x21, 0xd1, 0x34,
-	0x24, 0xec, 0x0a, 0x26, 0x1e, 0xe0, 0x64, 0x69, 0x8c, 0x1a, 0x12, 0xc9, 0xe2, 0x9f, 0xc4, 0x83,
-	0x9b, 0xd9, 0x9d, 0xe9, 0x76, 0x64, 0x77, 0xa6, 0xd9, 0x99, 0x15, 0xf8, 0x0a, 0x26, 0x26, 0x1c,
-	0x3d, 0x7a, 0xe4, 0xc8, 0x81, 0xaf, 0x60, 0xc2, 0x91, 0x70, 0x32, 0x1e, 0xd0, 0x94, 0x03, 0x7e,
-	0x0c, 0xb3, 0x33, 0xbb, 0x80, 0x70, 0xe3, 0xd2, 0xb4, 0xcf, 0xf3, 0xbc, 0xcf, 0x3b, 0xef, 0xf3,
-	0xbe, 0x29, 0x7c, 0x18, 0x0a, 0x99, 0x08, 0xe9, 0xca, 0x18, 0xcb, 0x3e, 0xe3, 0x91, 0xfb, 0x69,
-	0x21, 0xa0, 0x0a, 0x2f, 0x9c, 0x01, 0xce, 0x20, 0x15, 0x4a, 0xa0, 0x49, 0xa3, 0x73, 0xce, 0xe0,
-	0x42, 0xd7, 0xa8, 0x47, 0x22, 0x12, 0x5a, 0xe3, 0xe6, 0xdf, 0x8c, 0xbc, 0x61, 0x47, 0x42, 0x44,
-	0x31, 0x75, 0xf5, 0xaf, 0x20, 0xeb, 0xb9, 0x24, 0x4b, 0xb1, 0x62, 0x82, 0x17, 0x7c, 0xf3, 0x32,
-	0xaf, 0x58, 0x42, 0xa5, 0xc2, 0xc9, 0xa0, 0x10, 0x4c, 0x99, 0x7e, 0xbe, 0x71, 0x2e, 0x9a, 0x1b,
-	0xea, 0x36, 0x4e, 0x18, 0x17, 0xae, 0xfe, 0x34, 0xd0, 0xec, 0xf7, 0x11, 0x58, 0x7f, 0x8b, 0x63,
-	0x46, 0xb0, 0x12, 0xe9, 0x3a, 0x8b, 0x38, 0xe3, 0xd1, 0x0b, 0xde, 0x13, 0x68, 0x19, 0xfe, 0x8f,
-	0x09, 0x49, 0xa9, 0x94, 0x16, 0x68, 0x81, 0xf6, 0xcd, 0xce, 0xcc, 0xd1, 0xfe, 0xfc, 0x74, 0x61,
-	0xb7, 0x22, 0xb8, 0xa4, 0x5c, 0x66, 0xf2, 0xa9, 0x91, 0xac, 0xab, 0x94, 0xf1, 0xc8, 0x2b, 0x2b,
-	0xd0, 0x0c, 0xac, 0x49, 0x85, 0x53, 0xe5, 0xf7, 0x29, 0x8b, 0xfa, 0xca, 0x1a, 0x69, 0x81, 0xf6,
-	0xa8, 0x57, 0xd5, 0xd8, 0x73, 0x0d, 0xa1, 0x07, 0xb0, 0xc6, 0x38, 0xa1, 0x5b, 0xbe, 0xe8, 0xf5,
-	0x24, 0x55, 0xd6, 0x68, 0x2e, 0xe9, 0x8c, 0x58, 0xc0, 0xab, 0x6a, 0xfc, 0x95, 0x86, 0xd1, 0x2a,
-	0xac, 0x7d, 0xc4, 0x2c, 0xa6, 0xc4, 0xcf, 0xb8, 0x62, 0xb1, 0x35, 0xd6, 0x02, 0xed, 0xea, 0x62,
-	0xc3, 0x31, 0x29, 0x38, 0x65, 0x0a, 0xce, 0xeb, 0x32, 0x85, 0xce, 0xad, 0x83, 0xe3, 0x66, 0x65,
-	0xe7, 0x57, 0x13, 0xec, 0x9e, 0xee, 0xcd, 0x01, 0xaf, 0x6a, 0xca, 0xdf, 0xe4, 0xd5, 0xc8, 0x86,
-	0x50, 0x89, 0x24, 0x90, 0x4a, 0x70, 0x4a, 0xac, 0xff, 0x5a, 0xa0, 0x7d, 0xc3, 0xbb, 0x80, 0xa0,
-	0x45, 0x38, 0x91, 0x30, 0x29, 0x29, 0xf1, 0x83, 0x58, 0x84, 0x1b, 0xd2, 0x0f, 0x45, 0xc6, 0x15,
-	0x4d, 0xad, 0x71, 0x3d, 0xc0, 0x1d, 0x43, 0x76, 0x34, 0xb7, 0x62, 0xa8, 0xa5, 0xb1, 0x3f, 0xdf,
-	0x9a, 0x60, 0xf6, 0xcb, 0x18, 0x1c, 0x5f, 0xc3, 0x29, 0x4e, 0x24, 0x7a, 0x04, 0xeb, 0x92, 0x45,
-	0xfc, 0xdc, 0x64, 0x93, 0x71, 0x22, 0x36, 0x75, 0x8c, 0xa3, 0x1e, 0x32, 0x9c, 0xf1, 0x78, 0xa7,
-	0x19, 0xc4, 0xf2, 0xb6, 0xdc, 0x2f, 0xaa, 0x06, 0x34, 0x2d, 0x4b, 0xf2, 0xdc, 0x6a, 0x9d, 0x27,
-	0xf9, 0x44, 0x3f, 0x8f, 0x9b, 0xf7, 0x4c, 0xfa, 0x92, 0x6c, 0x38, 0x4c, 0xb8, 0x09, 0x56, 0x7d,
-	0x67, 0x95, 0x46, 0x38, 0xdc, 0xee, 0xd2, 0xf0, 0x68, 0x7f, 0x1e, 0x16, 0xcb, 0xe9, 0xd2, 0xd0,
-	0x8c, 0x8e, 0x12, 0xc6, 0xd7, 0xb5, 0xe7, 0x1a, 0x4d, 0x8b, 0x56, 0x1f, 0xe0, 0x5d, 0x22, 0x36,
-	0x79, 0x7e, 0x34, 0x7e, 0x9e, 0x8c, 0x5f, 0x9e, 0x97, 0x5e, 0x40, 0x75, 0x71, 0xea, 0x4a, 0xb2,
-	0xdd, 0x42, 0x60, 0x82, 0xfd, 0x7a, 0x16, 0x6c, 0xbd, 0xf4, 0x79, 0x89, 0x59, 0x5c, 0x8a, 0x90,
-	0x84, 0x0d, 0x7d, 0xe8, 0x7e, 0x2f, 0xc5, 0x61, 0x8e, 0xf8, 0x44, 0x64, 0x41, 0x4c, 0xf5, 0x70,
-	0x7a, 0x7b, 0xd7, 0x9f, 0x67, 0x52, 0x3b, 0x3f, 0x2b, 0x8c, 0xbb, 0xda, 0x37, 0x9f, 0x0f, 0x71,
-	0x38, 0x79, 0xa5, 0xa9, 0x79, 0x9b, 0xde, 0xf1, 0xf5, 0x3b, 0x4e, 0x5c, 0xea, 0x68, 0x4c, 0x97,
-	0x66, 0x3e, 0x9f, 0xee, 0xcd, 0xdd, 0x37, 0xe2, 0x79, 0x49, 0x36, 0xdc, 0xad, 0xf3, 0x7f, 0x01,
-	0x73, 0x04, 0x9d, 0xe5, 0xdd, 0xa1, 0x0d, 0x0e, 0x86, 0x36, 0x38, 0x1c, 0xda, 0xe0, 0xf7, 0xd0,
-	0x06, 0x3b, 0x27, 0x76, 0xe5, 0xf0, 0xc4, 0xae, 0xfc, 0x38, 0xb1, 0x2b, 0xef, 0xa7, 0xff, 0x79,
-	0xc7, 0x85, 0x6a, 0xb5, 0x3d, 0xa0, 0x32, 0x18, 0xd7, 0xe1, 0x3f, 0xfe, 0x1b, 0x00, 0x00, 0xff,
-	0xff, 0x57, 0xe6, 0xe5, 0x80, 0x63, 0x04, 0x00, 0x00,
+	// 1045 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4d, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xcf, 0xc6, 0x8e, 0x93, 0x8c, 0x9d, 0xbf, 0x92, 0x49, 0x52, 0x6f, 0xdc, 0x7f, 0x1c, 0xc7,
+	0x08, 0x64, 0x15, 0xc5, 0xa6, 0x41, 0xe2, 0xd0, 0x20, 0x21, 0x1c, 0x03, 0x2d, 0xaa, 0x9a, 0x68,
+	0x53, 0x40, 0x70, 0x60, 0x19, 0xef, 0x8e, 0xd7, 0x83, 0x77, 0x67, 0xac, 0x9d, 0xd9, 0xb8, 0xf9,
+	0x0a, 0x9c, 0x2a, 0x71, 0xe1, 0xc8, 0xb1, 0xc7, 0x1e, 0xfa, 0x05, 0xb8, 0xf5, 0x58, 0xf5, 0x84,
+	0x38, 0x04, 0x94, 0x1c, 0xca, 0xa7, 0x40, 0x68, 0x5e, 0x76, 0x63, 0x3b, 0xe2, 0x60, 0xc2, 0xc5,
+	0xf2, 0x3e, 0x2f, 0xbf, 0xe7, 0x79, 0x7e, 0xf3, 0xbc, 0x80, 0x77, 0x3c, 0xc6, 0x23, 0xc6, 0x5b,
+	0x3c, 0x44, 0xbc, 0x4f, 0x68, 0xd0, 0x3a, 0xbd, 0xdb, 0xc5, 0x02, 0xdd, 0xcd, 0x04, 0xcd, 0x61,
+	0xcc, 0x04, 0x83, 0x65, 0x6d, 0xd7, 0xcc, 0xc4, 0xc6, 0xae, 0xb2, 0x11, 0xb0, 0x80, 0x29, 0x9b,
+	0x96, 0xfc, 0xa7, 0xcd, 0x2b, 0x55, 0x03, 0xdb, 0x45, 0x1c, 0x67, 0x90, 0x1e, 0x23, 0x34, 0xd5,
+	0x07, 0x8c, 0x05, 0x21, 0x6e, 0xa9, 0xaf, 0x6e, 0xd2, 0x6b, 0xf9, 0x49, 0x8c, 0x04, 0x61, 0xa9,
+	0x7e, 0x67, 0x5a, 0x2f, 0x48, 0x84, 0xb9, 0x40, 0xd1, 0xd0, 0x18, 0x6c, 0xe9, 0x00, 0xae, 0x8e,
+	0x6c, 0x92, 0xd3, 0xaa, 0x35, 0x14, 0x11, 0xca, 0x5a, 0xea, 0x57, 0x8b, 0xea, 0x3f, 0x2e, 0x80,
+	0x8d, 0x2f, 0x51, 0x48, 0x7c, 0x24, 0x58, 0x7c, 0x42, 0x02, 0x4a, 0x68, 0xf0, 0x80, 0xf6, 0x18,
+	0x3c, 0x00, 0x8b, 0xc8, 0xf7, 0x63, 0xcc, 0xb9, 0x6d, 0xd5, 0xac, 0xc6, 0x72, 0x7b, 0xf7, 0xf5,
+	0x8b, 0xbd, 0x6d, 0x03, 0x77, 0xc8, 0x28, 0xc7, 0x94, 0x27, 0xfc, 0x63, 0x6d, 0x72, 0x22, 0x62,
+	0x42, 0x03, 0x27, 0xf5, 0x80, 0xbb, 0xa0, 0xc4, 0x05, 0x8a, 0x85, 0xdb, 0xc7, 0x24, 0xe8, 0x0b,
+	0x7b, 0xbe, 0x66, 0x35, 0x72, 0x4e, 0x51, 0xc9, 0xee, 0x2b, 0x11, 0x7c, 0x1b, 0x94, 0x08, 0xf5,
+	0xf1, 0x13, 0x97, 0xf5, 0x7a, 0x1c, 0x0b, 0x3b, 0x27, 0x4d, 0xda, 0xf3, 0xb6, 0xe5, 0x14, 0x95,
+	0xfc, 0x48, 0x89, 0xe1, 0x43, 0x50, 0xfa, 0x1e, 0x91, 0x10, 0xfb, 0x6e, 0x42, 0x05, 0x09, 0xed,
+	0x7c, 0xcd, 0x6a, 0x14, 0xf7, 0x2b, 0x4d, 0xcd, 0x42, 0x33, 0x65, 0xa1, 0xf9, 0x38, 0x65, 0xa1,
+	0xbd, 0xf2, 0xf2, 0x7c, 0x67, 0xee, 0xe9, 0xef, 0x3b, 0xd6, 0xb3, 0x37, 0xcf, 0xef, 0x58, 0x4e,
+	0x51, 0xbb, 0x7f, 0x21, 0xbd, 0x61, 0x15, 0x00, 0xc1, 0xa2, 0x2e, 0x17, 0x8c, 0x62, 0xdf, 0x5e,
+	0xa8, 0x59, 0x8d, 0x25, 0x67, 0x4c, 0x02, 0xf7, 0xc1, 0x66, 0x44, 0x38, 0xc7, 0xbe, 0xdb, 0x0d,
+	0x99, 0x37, 0xe0, 0xae, 0xc7, 0x12, 0x2a, 0x70, 0x6c, 0x17, 0x54, 0x01, 0xeb, 0x5a, 0xd9, 0x56,
+	0xba, 0x43, 0xad, 0x92, 0x3e, 0x21, 0xe2, 0xb2, 0x54, 0x14, 0x8b, 0x2e, 0x46, 0x59, 0xd1, 0x8b,
+	0xda, 0x47, 0x2a, 0xef, 0xa7, 0x3a, 0x53, 0xfc, 0xd7, 0x60, 0x7d, 0xca, 0x47, 0xbe, 0xa2, 0xbd,
+	0x34, 0x6b, 0x71, 0x6b, 0x13, 0xe0, 0xd2, 0x0c, 0xbe, 0x0b, 0xd6, 0x7c, 0x36, 0xa2, 0x12, 0x4f,
+	0x52, 0x8b, 0x29, 0xc7, 0xdc, 0x5e, 0xae, 0x59, 0x8d, 0xbc, 0xb3, 0x9a, 0x2a, 0x8e, 0x8c, 0x1c,
+	0xf6, 0x40, 0x45, 0xe5, 0x31, 0xed, 0xa1, 0xd3, 0x01, 0xb3, 0xa6, 0x53, 0x96, 0x60, 0x9d, 0xc9,
+	0x20, 0x2a, 0xa9, 0x06, 0x58, 0x0d, 0x62, 0xe4, 0x61, 0x17, 0x53, 0x3f, 0xa5, 0xa7, 0xa8, 0xe8,
+	0xf9, 0x9f, 0x92, 0x7f, 0x42, 0x7d, 0xcd, 0xcc, 0xbd, 0xfc, 0x9f, 0x3f, 0xef, 0x58, 0xf5, 0xbf,
+	0x16, 0x41, 0xe1, 0x18, 0xc5, 0x28, 0xe2, 0xf0, 0x3d, 0xb0, 0xc1, 0x49, 0x40, 0xaf, 0x9e, 0x64,
+	0x44, 0xa8, 0xcf, 0x46, 0xaa, 0x29, 0x73, 0x0e, 0xd4, 0x3a, 0xfd, 0x22, 0x5f, 0x29, 0x0d, 0x24,
+	0xf2, 0x11, 0xa9, 0x6b, 0xbc, 0x86, 0x38, 0x4e, 0x5d, 0x64, 0x17, 0x96, 0xda, 0x1f, 0xc8, 0x9c,
+	0x7f, 0x3b, 0xdf, 0xb9, 0xad, 0x7b, 0x99, 0xfb, 0x83, 0x26, 0x61, 0xad, 0x08, 0x89, 0x7e, 0xf3,
+	0x21, 0x0e, 0x90, 0x77, 0xd6, 0xc1, 0xde, 0xeb, 0x17, 0x7b, 0xc0, 0xb4, 0x7a, 0x07, 0x7b, 0xba,
+	0x38, 0x18, 0x11, 0x7a, 0xa2, 0x30, 0x8f, 0x71, 0x6c, 0x42, 0x7d, 0x0b, 0x6e, 0x65, 0xd4, 0xc9,
+	0x3e, 0x73, 0xd3, 0x61, 0x55, 0xed, 0x5c, 0xdc, 0xdf, 0xba, 0xc6, 0x5d, 0xc7, 0x18, 0x68, 0xea,
+	0x7e, 0xca, 0xa8, 0xdb, 0x48, 0x71, 0x3e, 0x47, 0x24, 0x4c, 0x8d, 0x20, 0x07, 0x15, 0xb5, 0x56,
+	0xdc, 0x5e, 0x8c, 0x3c, 0x29, 0x71, 0x7d, 0x96, 0x74, 0x43, 0xac, 0x8a, 0xb3, 0xf3, 0x37, 0xaa,
+	0xa7, 0xac, 0x90, 0x3f, 0x35, 0xc0, 0x1d, 0x85, 0x2b, 0xeb, 0x83, 0x14, 0x94, 0xaf, 0x05, 0xd5,
+	0xb9, 0xd9, 0x0b, 0x37, 0x8a, 0xb8, 0x39, 0x15, 0x51, 0x83, 0xc2, 0xef, 0x40, 0x39, 0xa1, 0x8a,
+	0xbd, 0xab, 0x71, 0x30, 0x2f, 0x56, 0x98, 0x91, 0xc5, 0x4d, 0x0d, 0x94, 0x4d, 0x84, 0x79, 0xa6,
+	0x33, 0xb0, 0x9d, 0x3d, 0xd3, 0x54, 0x69, 0x82, 0xe0, 0x98, 0xdb, 0x8b, 0xb5, 0xdc, 0x0d, 0xea,
+	0xaa, 0xa4, 0xe0, 0x27, 0xe3, 0xf5, 0x3d, 0x96, 0xc8, 0x70, 0x00, 0xb6, 0xaf, 0x0d, 0x97, 0x8f,
+	0x3d, 0x74, 0x26, 0x1b, 0x93, 0x30, 0xdf, 0x5e, 0x9a, 0xb1, 0xc4, 0xca, 0xd4, 0x10, 0x77, 0x24,
+	0xd8, 0xb1, 0xc2, 0x82, 0x7d, 0x70, 0x3b, 0x5b, 0x66, 0x6e, 0x8c, 0x4f, 0x71, 0xcc, 0x51, 0xe8,
+	0x7a, 0x8c, 0x85, 0xd2, 0xc9, 0x5e, 0x9e, 0x31, 0xd4, 0x56, 0x06, 0xe6, 0x18, 0xac, 0x43, 0x03,
+	0x05, 0x0f, 0x40, 0x85, 0xe2, 0x91, 0x7b, 0x9a, 0x5e, 0x0e, 0x57, 0x8f, 0xb7, 0x1e, 0x51, 0xb5,
+	0x38, 0x72, 0x4e, 0x99, 0xe2, 0x51, 0x76, 0x5a, 0x3e, 0x93, 0x7a, 0x3d, 0xa6, 0xf7, 0x76, 0x7f,
+	0x78, 0xf3, 0xfc, 0xce, 0xff, 0x35, 0x8b, 0x7b, 0xdc, 0x1f, 0xb4, 0x9e, 0x5c, 0x1d, 0x59, 0x3d,
+	0xf5, 0xf5, 0x73, 0x0b, 0xac, 0x3c, 0xa0, 0x3c, 0x89, 0x11, 0xf5, 0x70, 0x9b, 0x51, 0x1f, 0x3e,
+	0x02, 0x6b, 0x57, 0xd1, 0xfe, 0xf9, 0x32, 0x65, 0x01, 0x27, 0x2f, 0xd3, 0xea, 0xe9, 0x94, 0x1c,
+	0x7e, 0x08, 0x0a, 0x28, 0x92, 0x2b, 0xdc, 0x9e, 0x37, 0xb4, 0x18, 0x04, 0x79, 0x98, 0xd3, 0x1b,
+	0xde, 0x3c, 0x64, 0x84, 0xb6, 0x97, 0x25, 0x2d, 0x9a, 0x12, 0xe3, 0x03, 0x3f, 0x02, 0x4b, 0x43,
+	0x44, 0x7c, 0x97, 0x25, 0xc2, 0xce, 0xcd, 0xe0, 0xbf, 0x28, 0xbd, 0x8e, 0x12, 0x51, 0x1f, 0x81,
+	0x75, 0x73, 0x6d, 0x75, 0x8f, 0xb6, 0x13, 0x6f, 0x80, 0x05, 0xbc, 0x05, 0x0a, 0x5d, 0xf5, 0x4f,
+	0x95, 0x96, 0x77, 0xcc, 0x17, 0x7c, 0x0b, 0xac, 0x4c, 0x6c, 0x41, 0x95, 0x74, 0xde, 0x29, 0x8d,
+	0xaf, 0x3f, 0x69, 0x34, 0x71, 0xbd, 0x54, 0x66, 0x79, 0xa7, 0x34, 0x7e, 0xb5, 0xea, 0xbf, 0x58,
+	0x60, 0x65, 0x22, 0xb2, 0x8c, 0x39, 0xb6, 0x53, 0xf3, 0x8e, 0xf9, 0xfa, 0xef, 0x62, 0xc2, 0x47,
+	0xa0, 0x90, 0x0c, 0xd5, 0x02, 0xc9, 0xab, 0x07, 0xfb, 0xb7, 0x83, 0x66, 0x50, 0xda, 0x07, 0xcf,
+	0x2e, 0xaa, 0xd6, 0xcb, 0x8b, 0xaa, 0xf5, 0xea, 0xa2, 0x6a, 0xfd, 0x71, 0x51, 0xb5, 0x9e, 0x5e,
+	0x56, 0xe7, 0x5e, 0x5d, 0x56, 0xe7, 0x7e, 0xbd, 0xac, 0xce, 0x7d, 0xb3, 0x3d, 0x81, 0x3a, 0xd6,
+	0x5b, 0xe2, 0x6c, 0x88, 0x79, 0xb7, 0xa0, 0xfa, 0xfe, 0xfd, 0xbf, 0x07, 0x00, 0x27, 0x1c, 0x7f,
+	0xca, 0xe0, 0x09, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -274,6 +602,21 @@ func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
 	if this.MissedBlocksCounter != that1.MissedBlocksCounter {
 		return false
 	}
+	if this.LastHeartbeatHeight != that1.LastHeartbeatHeight {
+		return false
+	}
+	if !this.LastHeartbeatTime.Equal(that1.LastHeartbeatTime) {
+		return false
+	}
+	if this.DowntimeOffenses != that1.DowntimeOffenses {
+		return false
+	}
+	if !this.LastDowntimeOffenseTime.Equal(that1.LastDowntimeOffenseTime) {
+		return false
+	}
+	if this.GraceEndHeight != that1.GraceEndHeight {
+		return false
+	}
 	return true
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -310,6 +653,119 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if this.UnjailHeartbeatWindow != that1.UnjailHeartbeatWindow {
+		return false
+	}
+	if len(this.DowntimeSlashFractionTiers) != len(that1.DowntimeSlashFractionTiers) {
+		return false
+	}
+	for i := range this.DowntimeSlashFractionTiers {
+		if !this.DowntimeSlashFractionTiers[i].Equal(that1.DowntimeSlashFractionTiers[i]) {
+			return false
+		}
+	}
+	if this.DowntimeOffenseDecayPeriod != that1.DowntimeOffenseDecayPeriod {
+		return false
+	}
+	if this.TombstoneReversalCooldown != that1.TombstoneReversalCooldown {
+		return false
+	}
+	if this.NewValidatorGraceBlocks != that1.NewValidatorGraceBlocks {
+		return false
+	}
+	return true
+}
+func (this *InsuranceBond) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*InsuranceBond)
+	if !ok {
+		that2, ok := that.(InsuranceBond)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
+	}
+	if !this.PaidOut.Equal(&that1.PaidOut) {
+		return false
+	}
+	return true
+}
+func (this *SigningWindowBucket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SigningWindowBucket)
+	if !ok {
+		that2, ok := that.(SigningWindowBucket)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Bucket != that1.Bucket {
+		return false
+	}
+	if this.SignedBlocks != that1.SignedBlocks {
+		return false
+	}
+	if this.MissedBlocks != that1.MissedBlocks {
+		return false
+	}
+	return true
+}
+func (this *SigningWindow) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SigningWindow)
+	if !ok {
+		that2, ok := that.(SigningWindow)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Window != that1.Window {
+		return false
+	}
+	if this.SignedBlocks != that1.SignedBlocks {
+		return false
+	}
+	if this.MissedBlocks != that1.MissedBlocks {
+		return false
+	}
+	if !this.Uptime.Equal(that1.Uptime) {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -332,27 +788,58 @@ func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.MissedBlocksCounter != 0 {
-		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
+	if m.GraceEndHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.GraceEndHeight))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x58
 	}
-	if m.Tombstoned {
-		i--
-		if m.Tombstoned {
-			dAtA[i] = 1
-		} else {
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastDowntimeOffenseTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastDowntimeOffenseTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSlashing(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x52
+	if m.DowntimeOffenses != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.DowntimeOffenses))
+		i--
+		dAtA[i] = 0x48
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastHeartbeatTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastHeartbeatTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x42
+	if m.LastHeartbeatHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.LastHeartbeatHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Tombstoned {
+		i--
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
 			dAtA[i] = 0
 		}
 		i--
 		dAtA[i] = 0x28
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
-	if err1 != nil {
-		return 0, err1
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n1
-	i = encodeVarintSlashing(dAtA, i, uint64(n1))
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x22
 	if m.IndexOffset != 0 {
@@ -395,6 +882,49 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NewValidatorGraceBlocks != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.NewValidatorGraceBlocks))
+		i--
+		dAtA[i] = 0x50
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TombstoneReversalCooldown, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TombstoneReversalCooldown):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintSlashing(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x4a
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeOffenseDecayPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeOffenseDecayPeriod):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintSlashing(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x42
+	if len(m.DowntimeSlashFractionTiers) > 0 {
+		for iNdEx := len(m.DowntimeSlashFractionTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.DowntimeSlashFractionTiers[iNdEx].Size()
+				i -= size
+				if _, err := m.DowntimeSlashFractionTiers[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnjailHeartbeatWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnjailHeartbeatWindow):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintSlashing(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -415,12 +945,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i -= n7
+	i = encodeVarintSlashing(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -441,6 +971,142 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *InsuranceBond) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsuranceBond) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsuranceBond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PaidOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SigningWindowBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningWindowBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningWindowBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedBlocks != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SignedBlocks != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.SignedBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Bucket != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Bucket))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SigningWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Uptime.Size()
+		i -= size
+		if _, err := m.Uptime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MissedBlocks != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SignedBlocks != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.SignedBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Window != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSlashing(v)
 	base := offset
@@ -476,36 +1142,788 @@ func (m *ValidatorSigningInfo) Size() (n int) {
 	if m.MissedBlocksCounter != 0 {
 		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
 	}
-	return n
-}
+	if m.LastHeartbeatHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.LastHeartbeatHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastHeartbeatTime)
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.DowntimeOffenses != 0 {
+		n += 1 + sovSlashing(uint64(m.DowntimeOffenses))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastDowntimeOffenseTime)
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.GraceEndHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.GraceEndHeight))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovSlashing(uint64(m.SignedBlocksWindow))
+	}
+	l = m.MinSignedPerWindow.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFractionDoubleSign.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFractionDowntime.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnjailHeartbeatWindow)
+	n += 1 + l + sovSlashing(uint64(l))
+	if len(m.DowntimeSlashFractionTiers) > 0 {
+		for _, e := range m.DowntimeSlashFractionTiers {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeOffenseDecayPeriod)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TombstoneReversalCooldown)
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.NewValidatorGraceBlocks != 0 {
+		n += 1 + sovSlashing(uint64(m.NewValidatorGraceBlocks))
+	}
+	return n
+}
+
+func (m *InsuranceBond) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.PaidOut.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func (m *SigningWindowBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Bucket != 0 {
+		n += 1 + sovSlashing(uint64(m.Bucket))
+	}
+	if m.SignedBlocks != 0 {
+		n += 1 + sovSlashing(uint64(m.SignedBlocks))
+	}
+	if m.MissedBlocks != 0 {
+		n += 1 + sovSlashing(uint64(m.MissedBlocks))
+	}
+	return n
+}
+
+func (m *SigningWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovSlashing(uint64(m.Window))
+	}
+	if m.SignedBlocks != 0 {
+		n += 1 + sovSlashing(uint64(m.SignedBlocks))
+	}
+	if m.MissedBlocks != 0 {
+		n += 1 + sovSlashing(uint64(m.MissedBlocks))
+	}
+	l = m.Uptime.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func sovSlashing(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSlashing(x uint64) (n int) {
+	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstoned = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatHeight", wireType)
+			}
+			m.LastHeartbeatHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeartbeatHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeatTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastHeartbeatTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeOffenses", wireType)
+			}
+			m.DowntimeOffenses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DowntimeOffenses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDowntimeOffenseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastDowntimeOffenseTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GraceEndHeight", wireType)
+			}
+			m.GraceEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GraceEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDoubleSign", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDoubleSign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntime", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnjailHeartbeatWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnjailHeartbeatWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeSlashFractionTiers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.DowntimeSlashFractionTiers = append(m.DowntimeSlashFractionTiers, v)
+			if err := m.DowntimeSlashFractionTiers[len(m.DowntimeSlashFractionTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeOffenseDecayPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DowntimeOffenseDecayPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TombstoneReversalCooldown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TombstoneReversalCooldown, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValidatorGraceBlocks", wireType)
+			}
+			m.NewValidatorGraceBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewValidatorGraceBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SignedBlocksWindow != 0 {
-		n += 1 + sovSlashing(uint64(m.SignedBlocksWindow))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.MinSignedPerWindow.Size()
-	n += 1 + l + sovSlashing(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration)
-	n += 1 + l + sovSlashing(uint64(l))
-	l = m.SlashFractionDoubleSign.Size()
-	n += 1 + l + sovSlashing(uint64(l))
-	l = m.SlashFractionDowntime.Size()
-	n += 1 + l + sovSlashing(uint64(l))
-	return n
-}
-
-func sovSlashing(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozSlashing(x uint64) (n int) {
-	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
+func (m *InsuranceBond) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -528,15 +1946,15 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSigningInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: InsuranceBond: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InsuranceBond: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -564,13 +1982,13 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.StartHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -580,33 +1998,28 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
 			}
-			m.IndexOffset = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSlashing
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.IndexOffset |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
 			}
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -633,15 +2046,65 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PaidOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SigningWindowBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningWindowBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningWindowBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
 			}
-			var v int
+			m.Bucket = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -651,17 +2114,16 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Bucket |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Tombstoned = bool(v != 0)
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocks", wireType)
 			}
-			m.MissedBlocksCounter = 0
+			m.SignedBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -671,7 +2133,26 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				m.SignedBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
+			}
+			m.MissedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -697,7 +2178,7 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *SigningWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -720,17 +2201,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: SigningWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SigningWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-			m.SignedBlocksWindow = 0
+			m.Window = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -740,16 +2221,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				m.Window |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocks", wireType)
 			}
-			var byteLen int
+			m.SignedBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -759,30 +2240,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.SignedBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthSlashing
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSlashing
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDuration", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
 			}
-			var msglen int
+			m.MissedBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -792,63 +2259,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MissedBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSlashing
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSlashing
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDoubleSign", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSlashing
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthSlashing
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSlashing
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SlashFractionDoubleSign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -858,22 +2278,23 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSlashing
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSlashing
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Uptime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
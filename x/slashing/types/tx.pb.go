@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -106,6 +107,268 @@ func (m *MsgUnjailResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnjailResponse proto.InternalMessageInfo
 
+// MsgHeartbeat defines the Msg/Heartbeat request type
+type MsgHeartbeat struct {
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *MsgHeartbeat) Reset()         { *m = MsgHeartbeat{} }
+func (m *MsgHeartbeat) String() string { return proto.CompactTextString(m) }
+func (*MsgHeartbeat) ProtoMessage()    {}
+func (*MsgHeartbeat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{2}
+}
+func (m *MsgHeartbeat) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgHeartbeat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgHeartbeat.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgHeartbeat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgHeartbeat.Merge(m, src)
+}
+func (m *MsgHeartbeat) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgHeartbeat) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgHeartbeat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgHeartbeat proto.InternalMessageInfo
+
+func (m *MsgHeartbeat) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return ""
+}
+
+// MsgHeartbeatResponse defines the Msg/Heartbeat response type
+type MsgHeartbeatResponse struct {
+}
+
+func (m *MsgHeartbeatResponse) Reset()         { *m = MsgHeartbeatResponse{} }
+func (m *MsgHeartbeatResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgHeartbeatResponse) ProtoMessage()    {}
+func (*MsgHeartbeatResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{3}
+}
+func (m *MsgHeartbeatResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgHeartbeatResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgHeartbeatResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgHeartbeatResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgHeartbeatResponse.Merge(m, src)
+}
+func (m *MsgHeartbeatResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgHeartbeatResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgHeartbeatResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgHeartbeatResponse proto.InternalMessageInfo
+
+// MsgDepositInsuranceBond defines the Msg/DepositInsuranceBond request type
+type MsgDepositInsuranceBond struct {
+	ValidatorAddr string     `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	Amount        types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgDepositInsuranceBond) Reset()         { *m = MsgDepositInsuranceBond{} }
+func (m *MsgDepositInsuranceBond) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositInsuranceBond) ProtoMessage()    {}
+func (*MsgDepositInsuranceBond) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{4}
+}
+func (m *MsgDepositInsuranceBond) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositInsuranceBond) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositInsuranceBond.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDepositInsuranceBond) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositInsuranceBond.Merge(m, src)
+}
+func (m *MsgDepositInsuranceBond) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositInsuranceBond) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositInsuranceBond.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDepositInsuranceBond proto.InternalMessageInfo
+
+func (m *MsgDepositInsuranceBond) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return ""
+}
+
+func (m *MsgDepositInsuranceBond) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// MsgDepositInsuranceBondResponse defines the Msg/DepositInsuranceBond response type
+type MsgDepositInsuranceBondResponse struct {
+}
+
+func (m *MsgDepositInsuranceBondResponse) Reset()         { *m = MsgDepositInsuranceBondResponse{} }
+func (m *MsgDepositInsuranceBondResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositInsuranceBondResponse) ProtoMessage()    {}
+func (*MsgDepositInsuranceBondResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{5}
+}
+func (m *MsgDepositInsuranceBondResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositInsuranceBondResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositInsuranceBondResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDepositInsuranceBondResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositInsuranceBondResponse.Merge(m, src)
+}
+func (m *MsgDepositInsuranceBondResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositInsuranceBondResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositInsuranceBondResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDepositInsuranceBondResponse proto.InternalMessageInfo
+
+// MsgWithdrawInsuranceBond defines the Msg/WithdrawInsuranceBond request type
+type MsgWithdrawInsuranceBond struct {
+	ValidatorAddr string     `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	Amount        types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgWithdrawInsuranceBond) Reset()         { *m = MsgWithdrawInsuranceBond{} }
+func (m *MsgWithdrawInsuranceBond) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawInsuranceBond) ProtoMessage()    {}
+func (*MsgWithdrawInsuranceBond) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{6}
+}
+func (m *MsgWithdrawInsuranceBond) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawInsuranceBond) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawInsuranceBond.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawInsuranceBond) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawInsuranceBond.Merge(m, src)
+}
+func (m *MsgWithdrawInsuranceBond) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawInsuranceBond) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawInsuranceBond.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawInsuranceBond proto.InternalMessageInfo
+
+func (m *MsgWithdrawInsuranceBond) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return ""
+}
+
+func (m *MsgWithdrawInsuranceBond) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// MsgWithdrawInsuranceBondResponse defines the Msg/WithdrawInsuranceBond response type
+type MsgWithdrawInsuranceBondResponse struct {
+}
+
+func (m *MsgWithdrawInsuranceBondResponse) Reset()         { *m = MsgWithdrawInsuranceBondResponse{} }
+func (m *MsgWithdrawInsuranceBondResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawInsuranceBondResponse) ProtoMessage()    {}
+func (*MsgWithdrawInsuranceBondResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{7}
+}
+func (m *MsgWithdrawInsuranceBondResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawInsuranceBondResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawInsuranceBondResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawInsuranceBondResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawInsuranceBondResponse.Merge(m, src)
+}
+func (m *MsgWithdrawInsuranceBondResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawInsuranceBondResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawInsuranceBondResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawInsuranceBondResponse proto.InternalMessageInfo
+
 // MsgUpdateParams is the Msg/UpdateParams request type.
 //
 // Since: cosmos-sdk 0.47
@@ -122,7 +385,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c5611c0c4a59d9d, []int{2}
+	return fileDescriptor_3c5611c0c4a59d9d, []int{8}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -176,7 +439,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c5611c0c4a59d9d, []int{3}
+	return fileDescriptor_3c5611c0c4a59d9d, []int{9}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -205,46 +468,176 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgRevertTombstone is the Msg/RevertTombstone request type.
+type MsgRevertTombstone struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// validator_address is the operator address of the tombstoned validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// reason is the justification of the reversal, e.g. a link to the
+	// post-mortem of the consensus bug which caused the equivocation.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgRevertTombstone) Reset()         { *m = MsgRevertTombstone{} }
+func (m *MsgRevertTombstone) String() string { return proto.CompactTextString(m) }
+func (*MsgRevertTombstone) ProtoMessage()    {}
+func (*MsgRevertTombstone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{10}
+}
+func (m *MsgRevertTombstone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevertTombstone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevertTombstone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevertTombstone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevertTombstone.Merge(m, src)
+}
+func (m *MsgRevertTombstone) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevertTombstone) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevertTombstone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevertTombstone proto.InternalMessageInfo
+
+func (m *MsgRevertTombstone) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRevertTombstone) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgRevertTombstone) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// MsgRevertTombstoneResponse defines the response structure for executing a
+// MsgRevertTombstone message.
+type MsgRevertTombstoneResponse struct {
+}
+
+func (m *MsgRevertTombstoneResponse) Reset()         { *m = MsgRevertTombstoneResponse{} }
+func (m *MsgRevertTombstoneResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevertTombstoneResponse) ProtoMessage()    {}
+func (*MsgRevertTombstoneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5611c0c4a59d9d, []int{11}
+}
+func (m *MsgRevertTombstoneResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevertTombstoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevertTombstoneResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevertTombstoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevertTombstoneResponse.Merge(m, src)
+}
+func (m *MsgRevertTombstoneResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevertTombstoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevertTombstoneResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevertTombstoneResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUnjail)(nil), "cosmos.slashing.v1beta1.MsgUnjail")
 	proto.RegisterType((*MsgUnjailResponse)(nil), "cosmos.slashing.v1beta1.MsgUnjailResponse")
+	proto.RegisterType((*MsgHeartbeat)(nil), "cosmos.slashing.v1beta1.MsgHeartbeat")
+	proto.RegisterType((*MsgHeartbeatResponse)(nil), "cosmos.slashing.v1beta1.MsgHeartbeatResponse")
+	proto.RegisterType((*MsgDepositInsuranceBond)(nil), "cosmos.slashing.v1beta1.MsgDepositInsuranceBond")
+	proto.RegisterType((*MsgDepositInsuranceBondResponse)(nil), "cosmos.slashing.v1beta1.MsgDepositInsuranceBondResponse")
+	proto.RegisterType((*MsgWithdrawInsuranceBond)(nil), "cosmos.slashing.v1beta1.MsgWithdrawInsuranceBond")
+	proto.RegisterType((*MsgWithdrawInsuranceBondResponse)(nil), "cosmos.slashing.v1beta1.MsgWithdrawInsuranceBondResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.slashing.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.slashing.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRevertTombstone)(nil), "cosmos.slashing.v1beta1.MsgRevertTombstone")
+	proto.RegisterType((*MsgRevertTombstoneResponse)(nil), "cosmos.slashing.v1beta1.MsgRevertTombstoneResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/slashing/v1beta1/tx.proto", fileDescriptor_3c5611c0c4a59d9d) }
 
 var fileDescriptor_3c5611c0c4a59d9d = []byte{
-	// 455 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0xce, 0x49, 0x2c, 0xce, 0xc8, 0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd1, 0x07,
-	0xb1, 0x20, 0xca, 0xa5, 0xd4, 0x70, 0x19, 0x08, 0xd7, 0x0f, 0x51, 0x27, 0x09, 0x51, 0x17, 0x0f,
-	0x31, 0x00, 0x6a, 0x07, 0x44, 0x0a, 0x6a, 0xa3, 0x7e, 0x6e, 0x31, 0x48, 0x37, 0x88, 0x82, 0x4a,
-	0x08, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x90, 0xd2, 0x62, 0x46, 0x2e, 0x4e,
-	0xdf, 0xe2, 0xf4, 0xd0, 0xbc, 0xac, 0xc4, 0xcc, 0x1c, 0xa1, 0x14, 0x2e, 0xbe, 0xb2, 0xc4, 0x9c,
-	0xcc, 0x94, 0xc4, 0x92, 0xfc, 0xa2, 0xf8, 0xc4, 0x94, 0x94, 0x22, 0x09, 0x46, 0x05, 0x46, 0x0d,
-	0x4e, 0x27, 0xdb, 0x57, 0xf7, 0xe4, 0xd9, 0x41, 0xfc, 0xd4, 0xe2, 0xe2, 0x4b, 0x5b, 0x74, 0x65,
-	0xa1, 0xd6, 0x85, 0xc1, 0xd4, 0x3a, 0x42, 0xa4, 0x82, 0x4b, 0x8a, 0x32, 0xf3, 0xd2, 0x17, 0x3d,
-	0xdf, 0xa0, 0x05, 0x53, 0xbc, 0xe2, 0xf9, 0x06, 0x2d, 0xc6, 0x20, 0xde, 0x32, 0x64, 0x85, 0x56,
-	0x06, 0x1d, 0x0b, 0xe4, 0x19, 0x9a, 0x9e, 0x6f, 0xd0, 0x42, 0xb3, 0xac, 0xeb, 0xf9, 0x06, 0x2d,
-	0x11, 0x88, 0xd1, 0xba, 0xc5, 0x29, 0xd9, 0xfa, 0x70, 0x77, 0x29, 0x09, 0x73, 0x09, 0xc2, 0x39,
-	0x41, 0xa9, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x4a, 0xc7, 0x19, 0xb9, 0xf8, 0x41, 0xa2, 0x05,
-	0x29, 0x89, 0x25, 0xa9, 0x01, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x42, 0x66, 0x5c, 0x9c, 0x89, 0xa5,
-	0x25, 0x19, 0xf9, 0x45, 0x99, 0x25, 0x95, 0x50, 0xb7, 0x4b, 0x5c, 0xda, 0xa2, 0x0b, 0x35, 0x55,
-	0x0f, 0xc5, 0x9d, 0x41, 0x08, 0xa5, 0x42, 0x4e, 0x5c, 0x6c, 0x05, 0x60, 0x13, 0x24, 0x98, 0x14,
-	0x18, 0x35, 0xb8, 0x8d, 0xe4, 0xf5, 0x70, 0xc4, 0x9a, 0x1e, 0xc4, 0x22, 0x27, 0xce, 0x13, 0xf7,
-	0xe4, 0x19, 0x20, 0xbe, 0x83, 0xea, 0xb4, 0xb2, 0x00, 0x79, 0x09, 0x61, 0x26, 0xc8, 0x37, 0xaa,
-	0x48, 0xbe, 0xa9, 0x40, 0x44, 0x29, 0x9a, 0xab, 0x95, 0x24, 0xb9, 0xc4, 0xd1, 0x84, 0x60, 0x9e,
-	0x34, 0xba, 0xc4, 0xc8, 0xc5, 0xec, 0x5b, 0x9c, 0x2e, 0x14, 0xc1, 0xc5, 0x06, 0x8d, 0x23, 0x25,
-	0x9c, 0x4e, 0x83, 0x07, 0x91, 0x94, 0x16, 0x61, 0x35, 0x30, 0x1b, 0x84, 0xb2, 0xb8, 0x78, 0x50,
-	0x82, 0x50, 0x03, 0xaf, 0x5e, 0x24, 0x95, 0x52, 0x06, 0xc4, 0xaa, 0x84, 0xd9, 0x25, 0xc5, 0xda,
-	0x00, 0x0a, 0x31, 0x27, 0xeb, 0x15, 0x8f, 0xe4, 0x18, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48,
-	0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1,
-	0x58, 0x8e, 0x21, 0x0a, 0x9a, 0xba, 0x8a, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0x91, 0x83, 0xad, 0xa4,
-	0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x9c, 0x70, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x11,
-	0xf4, 0x13, 0xa9, 0x7a, 0x03, 0x00, 0x00,
+	// 754 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x4f, 0x13, 0x4d,
+	0x18, 0xee, 0x7c, 0x84, 0x7e, 0xe9, 0x7c, 0x7c, 0x1f, 0x1f, 0x6b, 0xa5, 0x65, 0x23, 0xdb, 0xb2,
+	0x06, 0x43, 0x6a, 0xba, 0x5b, 0x20, 0x31, 0x58, 0xf4, 0x60, 0xf5, 0x80, 0x87, 0x1a, 0x53, 0x7f,
+	0xc6, 0x0b, 0x99, 0xb2, 0x93, 0x65, 0x81, 0xee, 0x34, 0x3b, 0x43, 0x85, 0x9b, 0x21, 0x31, 0x51,
+	0x4f, 0xfe, 0x09, 0x46, 0x2f, 0x1c, 0x7b, 0x20, 0xf1, 0x4f, 0x90, 0x23, 0xe1, 0xe4, 0x45, 0x34,
+	0x25, 0xb1, 0x89, 0x7f, 0x85, 0xd9, 0xee, 0xec, 0xb4, 0xbb, 0x74, 0x0b, 0x18, 0x0f, 0x5e, 0xda,
+	0xce, 0xbc, 0xcf, 0xfb, 0x3e, 0xcf, 0xf3, 0xce, 0xcc, 0x9b, 0xc2, 0xec, 0x0a, 0xa1, 0x35, 0x42,
+	0x75, 0xba, 0x81, 0xe8, 0xaa, 0x65, 0x9b, 0x7a, 0x63, 0xb6, 0x8a, 0x19, 0x9a, 0xd5, 0xd9, 0x96,
+	0x56, 0x77, 0x08, 0x23, 0x52, 0xca, 0x43, 0x68, 0x3e, 0x42, 0xe3, 0x08, 0x39, 0x69, 0x12, 0x93,
+	0x74, 0x30, 0xba, 0xfb, 0xcb, 0x83, 0xcb, 0x0a, 0x2f, 0x58, 0x45, 0x14, 0x8b, 0x62, 0x2b, 0xc4,
+	0xb2, 0x79, 0xfc, 0x4a, 0x14, 0xa1, 0xa8, 0xef, 0xe1, 0x26, 0x3c, 0xdc, 0xb2, 0x47, 0xc0, 0x35,
+	0x78, 0x21, 0xae, 0x48, 0xaf, 0x51, 0x37, 0xdb, 0xfd, 0xe2, 0x81, 0x31, 0x54, 0xb3, 0x6c, 0xa2,
+	0x77, 0x3e, 0xbd, 0x2d, 0xf5, 0x03, 0x80, 0x89, 0x32, 0x35, 0x1f, 0xd9, 0x6b, 0xc8, 0xda, 0x90,
+	0x0c, 0xf8, 0x5f, 0x03, 0x6d, 0x58, 0x06, 0x62, 0xc4, 0x59, 0x46, 0x86, 0xe1, 0xa4, 0x41, 0x16,
+	0xcc, 0x24, 0x4a, 0x37, 0x7f, 0x1c, 0x65, 0xfe, 0x76, 0xd7, 0x98, 0xd2, 0xc3, 0xbd, 0xfc, 0x24,
+	0xa7, 0x7b, 0xec, 0x63, 0x6f, 0x79, 0xa1, 0x07, 0xcc, 0xb1, 0x6c, 0xf3, 0x7d, 0xbb, 0x99, 0xf3,
+	0xc1, 0xbb, 0xed, 0x66, 0x0e, 0x54, 0xfe, 0x6d, 0xf4, 0x02, 0x8b, 0x85, 0x57, 0xef, 0x32, 0xb1,
+	0x9d, 0x76, 0x33, 0x17, 0x22, 0x7b, 0xd3, 0x6e, 0xe6, 0x92, 0x5e, 0xe9, 0x3c, 0x35, 0xd6, 0x75,
+	0xa1, 0x4b, 0xbd, 0x00, 0xc7, 0xc4, 0xa2, 0x82, 0x69, 0x9d, 0xd8, 0x14, 0xab, 0xaf, 0x01, 0x1c,
+	0x29, 0x53, 0x73, 0x09, 0x23, 0x87, 0x55, 0x31, 0x62, 0xd2, 0x52, 0x84, 0xfa, 0xa9, 0x53, 0x25,
+	0x87, 0x15, 0xea, 0x11, 0xea, 0x52, 0x41, 0x75, 0x82, 0x5a, 0x1d, 0x87, 0xc9, 0xde, 0xb5, 0xd0,
+	0xf8, 0x05, 0xc0, 0x54, 0x99, 0x9a, 0x77, 0x70, 0x9d, 0x50, 0x8b, 0xdd, 0xb5, 0xe9, 0xa6, 0x83,
+	0xec, 0x15, 0x5c, 0x22, 0xb6, 0xf1, 0xfb, 0xe4, 0x4a, 0x37, 0x60, 0x1c, 0xd5, 0xc8, 0xa6, 0xcd,
+	0xd2, 0x7f, 0x65, 0xc1, 0xcc, 0x3f, 0x73, 0x13, 0x1a, 0x4f, 0x77, 0x2f, 0x99, 0x7f, 0x1f, 0xb5,
+	0xdb, 0xc4, 0xb2, 0x4b, 0x89, 0xfd, 0xa3, 0x4c, 0xcc, 0x3b, 0x15, 0x9e, 0x53, 0x2c, 0x46, 0x98,
+	0x55, 0x83, 0x66, 0xfb, 0x79, 0x50, 0xa7, 0x60, 0x26, 0x22, 0x24, 0x5a, 0xf0, 0x15, 0xc0, 0x74,
+	0x99, 0x9a, 0x4f, 0x2c, 0xb6, 0x6a, 0x38, 0xe8, 0xf9, 0x9f, 0xd9, 0x83, 0xc5, 0x88, 0x1e, 0x5c,
+	0x0e, 0xf6, 0xa0, 0xaf, 0x09, 0x55, 0x85, 0xd9, 0xa8, 0x98, 0xe8, 0xc2, 0x27, 0x00, 0x47, 0xdd,
+	0x2b, 0x5c, 0x37, 0x10, 0xc3, 0xf7, 0x91, 0x83, 0x6a, 0x54, 0xba, 0x06, 0x13, 0x68, 0x93, 0xad,
+	0x12, 0xc7, 0x62, 0xdb, 0xdc, 0x77, 0xfa, 0x70, 0x2f, 0xcf, 0x9f, 0x80, 0x16, 0xb4, 0xdb, 0x85,
+	0x4a, 0x25, 0x18, 0xaf, 0x77, 0x2a, 0x70, 0xab, 0x19, 0x2d, 0x62, 0x04, 0x69, 0x1e, 0x51, 0xc0,
+	0xb0, 0x97, 0x59, 0x5c, 0x70, 0x0d, 0x77, 0x6b, 0xba, 0x5e, 0xa7, 0x7b, 0xbc, 0x6e, 0x75, 0xe7,
+	0x4f, 0x48, 0xb5, 0x3a, 0x01, 0x53, 0xa1, 0x2d, 0x61, 0xf2, 0x3b, 0x80, 0x52, 0x99, 0x9a, 0x15,
+	0xdc, 0xc0, 0x0e, 0x7b, 0x48, 0x6a, 0x55, 0xca, 0x88, 0x8d, 0x7f, 0xd9, 0xe7, 0x3d, 0x38, 0x16,
+	0x3c, 0x11, 0x4c, 0x3d, 0xcb, 0x67, 0xba, 0x1f, 0xff, 0x37, 0x42, 0xfb, 0xd2, 0x38, 0x8c, 0x3b,
+	0x18, 0x51, 0x62, 0xa7, 0x87, 0xdc, 0x22, 0x15, 0xbe, 0x2a, 0x16, 0x4e, 0xf6, 0x62, 0x32, 0x78,
+	0xee, 0x21, 0x47, 0xea, 0x25, 0x28, 0x9f, 0xdc, 0xf5, 0xdb, 0x30, 0xf7, 0x71, 0x18, 0x0e, 0x95,
+	0xa9, 0x29, 0x3d, 0x85, 0x71, 0x3e, 0x57, 0xd5, 0xc8, 0x13, 0x12, 0x63, 0x4d, 0xce, 0x9d, 0x8e,
+	0xf1, 0x19, 0x24, 0x04, 0x13, 0xdd, 0xb1, 0x37, 0x3d, 0x28, 0x51, 0xc0, 0xe4, 0xfc, 0x99, 0x60,
+	0x82, 0x62, 0x07, 0xc0, 0x64, 0xdf, 0xb1, 0x55, 0x18, 0x54, 0xa7, 0x5f, 0x86, 0xbc, 0x70, 0xde,
+	0x0c, 0x21, 0xe2, 0x25, 0x80, 0x17, 0xfb, 0x0f, 0x8e, 0xd9, 0x41, 0x35, 0xfb, 0xa6, 0xc8, 0xd7,
+	0xcf, 0x9d, 0x22, 0x74, 0xac, 0xc1, 0x91, 0xc0, 0xcb, 0x9d, 0x19, 0x78, 0x56, 0x3d, 0x48, 0xb9,
+	0x70, 0x56, 0xa4, 0xe0, 0xa2, 0x70, 0x34, 0xfc, 0x80, 0xae, 0x0e, 0x2a, 0x12, 0x02, 0xcb, 0xf3,
+	0xe7, 0x00, 0xfb, 0xa4, 0xf2, 0xf0, 0x0b, 0x77, 0x3a, 0x94, 0x16, 0x77, 0x5b, 0x0a, 0xd8, 0x6f,
+	0x29, 0xe0, 0xa0, 0xa5, 0x80, 0x6f, 0x2d, 0x05, 0xbc, 0x3d, 0x56, 0x62, 0x07, 0xc7, 0x4a, 0xec,
+	0xf3, 0xb1, 0x12, 0x7b, 0xc6, 0x1f, 0x05, 0x35, 0xd6, 0x35, 0x8b, 0xf4, 0x8e, 0x08, 0xb6, 0x5d,
+	0xc7, 0xb4, 0x1a, 0xef, 0xfc, 0xa3, 0x98, 0xff, 0x39, 0x00, 0x79, 0xb7, 0xaf, 0x5a, 0x33, 0x09,
+	0x00, 0x00,
 }
 
 func (this *MsgUnjail) Equal(that interface{}) bool {
@@ -292,14 +685,14 @@ func (this *MsgUnjailResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *MsgUpdateParams) Equal(that interface{}) bool {
+func (this *MsgHeartbeat) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgUpdateParams)
+	that1, ok := that.(*MsgHeartbeat)
 	if !ok {
-		that2, ok := that.(MsgUpdateParams)
+		that2, ok := that.(MsgHeartbeat)
 		if ok {
 			that1 = &that2
 		} else {
@@ -311,22 +704,40 @@ func (this *MsgUpdateParams) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Authority != that1.Authority {
+	if this.ValidatorAddr != that1.ValidatorAddr {
 		return false
 	}
-	if !this.Params.Equal(&that1.Params) {
+	return true
+}
+func (this *MsgHeartbeatResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgHeartbeatResponse)
+	if !ok {
+		that2, ok := that.(MsgHeartbeatResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
 	return true
 }
-func (this *MsgUpdateParamsResponse) Equal(that interface{}) bool {
+func (this *MsgDepositInsuranceBond) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgUpdateParamsResponse)
+	that1, ok := that.(*MsgDepositInsuranceBond)
 	if !ok {
-		that2, ok := that.(MsgUpdateParamsResponse)
+		that2, ok := that.(MsgDepositInsuranceBond)
 		if ok {
 			that1 = &that2
 		} else {
@@ -338,30 +749,218 @@ func (this *MsgUpdateParamsResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.ValidatorAddr != that1.ValidatorAddr {
+		return false
+	}
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
+	}
 	return true
 }
+func (this *MsgDepositInsuranceBondResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
+	that1, ok := that.(*MsgDepositInsuranceBondResponse)
+	if !ok {
+		that2, ok := that.(MsgDepositInsuranceBondResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgWithdrawInsuranceBond) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgWithdrawInsuranceBond)
+	if !ok {
+		that2, ok := that.(MsgWithdrawInsuranceBond)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddr != that1.ValidatorAddr {
+		return false
+	}
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
+	}
+	return true
+}
+func (this *MsgWithdrawInsuranceBondResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgWithdrawInsuranceBondResponse)
+	if !ok {
+		that2, ok := that.(MsgWithdrawInsuranceBondResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgUpdateParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateParams)
+	if !ok {
+		that2, ok := that.(MsgUpdateParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if !this.Params.Equal(&that1.Params) {
+		return false
+	}
+	return true
+}
+func (this *MsgUpdateParamsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateParamsResponse)
+	if !ok {
+		that2, ok := that.(MsgUpdateParamsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgRevertTombstone) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgRevertTombstone)
+	if !ok {
+		that2, ok := that.(MsgRevertTombstone)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	return true
+}
+func (this *MsgRevertTombstoneResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgRevertTombstoneResponse)
+	if !ok {
+		that2, ok := that.(MsgRevertTombstoneResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
 	// Unjail defines a method for unjailing a jailed validator, thus returning
 	// them into the bonded validator set, so they can begin receiving provisions
 	// and rewards again.
 	Unjail(ctx context.Context, in *MsgUnjail, opts ...grpc.CallOption) (*MsgUnjailResponse, error)
+	// Heartbeat defines a method for a validator outside the active set to signal
+	// that it is online and ready to (re-)enter the active set.
+	Heartbeat(ctx context.Context, in *MsgHeartbeat, opts ...grpc.CallOption) (*MsgHeartbeatResponse, error)
+	// DepositInsuranceBond defines a method for a validator to lock funds in its
+	// insurance bond, which compensates its delegators when it is slashed for
+	// downtime.
+	DepositInsuranceBond(ctx context.Context, in *MsgDepositInsuranceBond, opts ...grpc.CallOption) (*MsgDepositInsuranceBondResponse, error)
+	// WithdrawInsuranceBond defines a method for a validator which is not jailed
+	// to withdraw funds from its insurance bond.
+	WithdrawInsuranceBond(ctx context.Context, in *MsgWithdrawInsuranceBond, opts ...grpc.CallOption) (*MsgWithdrawInsuranceBondResponse, error)
 	// UpdateParams defines a governance operation for updating the x/slashing module
 	// parameters. The authority defaults to the x/gov module account.
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RevertTombstone defines a governance operation for reverting the tombstone
+	// of a validator, e.g. after a proven false-positive equivocation. The
+	// validator can be unjailed once the tombstone reversal cooldown has passed.
+	RevertTombstone(ctx context.Context, in *MsgRevertTombstone, opts ...grpc.CallOption) (*MsgRevertTombstoneResponse, error)
 }
 
 type msgClient struct {
@@ -381,6 +980,33 @@ func (c *msgClient) Unjail(ctx context.Context, in *MsgUnjail, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) Heartbeat(ctx context.Context, in *MsgHeartbeat, opts ...grpc.CallOption) (*MsgHeartbeatResponse, error) {
+	out := new(MsgHeartbeatResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Msg/Heartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DepositInsuranceBond(ctx context.Context, in *MsgDepositInsuranceBond, opts ...grpc.CallOption) (*MsgDepositInsuranceBondResponse, error) {
+	out := new(MsgDepositInsuranceBondResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Msg/DepositInsuranceBond", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawInsuranceBond(ctx context.Context, in *MsgWithdrawInsuranceBond, opts ...grpc.CallOption) (*MsgWithdrawInsuranceBondResponse, error) {
+	out := new(MsgWithdrawInsuranceBondResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Msg/WithdrawInsuranceBond", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Msg/UpdateParams", in, out, opts...)
@@ -390,17 +1016,40 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RevertTombstone(ctx context.Context, in *MsgRevertTombstone, opts ...grpc.CallOption) (*MsgRevertTombstoneResponse, error) {
+	out := new(MsgRevertTombstoneResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Msg/RevertTombstone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Unjail defines a method for unjailing a jailed validator, thus returning
 	// them into the bonded validator set, so they can begin receiving provisions
 	// and rewards again.
 	Unjail(context.Context, *MsgUnjail) (*MsgUnjailResponse, error)
+	// Heartbeat defines a method for a validator outside the active set to signal
+	// that it is online and ready to (re-)enter the active set.
+	Heartbeat(context.Context, *MsgHeartbeat) (*MsgHeartbeatResponse, error)
+	// DepositInsuranceBond defines a method for a validator to lock funds in its
+	// insurance bond, which compensates its delegators when it is slashed for
+	// downtime.
+	DepositInsuranceBond(context.Context, *MsgDepositInsuranceBond) (*MsgDepositInsuranceBondResponse, error)
+	// WithdrawInsuranceBond defines a method for a validator which is not jailed
+	// to withdraw funds from its insurance bond.
+	WithdrawInsuranceBond(context.Context, *MsgWithdrawInsuranceBond) (*MsgWithdrawInsuranceBondResponse, error)
 	// UpdateParams defines a governance operation for updating the x/slashing module
 	// parameters. The authority defaults to the x/gov module account.
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RevertTombstone defines a governance operation for reverting the tombstone
+	// of a validator, e.g. after a proven false-positive equivocation. The
+	// validator can be unjailed once the tombstone reversal cooldown has passed.
+	RevertTombstone(context.Context, *MsgRevertTombstone) (*MsgRevertTombstoneResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -410,9 +1059,21 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) Unjail(ctx context.Context, req *MsgUnjail) (*MsgUnjailResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Unjail not implemented")
 }
+func (*UnimplementedMsgServer) Heartbeat(ctx context.Context, req *MsgHeartbeat) (*MsgHeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (*UnimplementedMsgServer) DepositInsuranceBond(ctx context.Context, req *MsgDepositInsuranceBond) (*MsgDepositInsuranceBondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositInsuranceBond not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawInsuranceBond(ctx context.Context, req *MsgWithdrawInsuranceBond) (*MsgWithdrawInsuranceBondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawInsuranceBond not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) RevertTombstone(ctx context.Context, req *MsgRevertTombstone) (*MsgRevertTombstoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertTombstone not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -436,6 +1097,60 @@ func _Msg_Unjail_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgHeartbeat)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Msg/Heartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Heartbeat(ctx, req.(*MsgHeartbeat))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DepositInsuranceBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositInsuranceBond)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositInsuranceBond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Msg/DepositInsuranceBond",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositInsuranceBond(ctx, req.(*MsgDepositInsuranceBond))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawInsuranceBond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawInsuranceBond)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawInsuranceBond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Msg/WithdrawInsuranceBond",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawInsuranceBond(ctx, req.(*MsgWithdrawInsuranceBond))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -454,6 +1169,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RevertTombstone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevertTombstone)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevertTombstone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Msg/RevertTombstone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevertTombstone(ctx, req.(*MsgRevertTombstone))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -462,10 +1195,26 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Unjail",
 			Handler:    _Msg_Unjail_Handler,
 		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _Msg_Heartbeat_Handler,
+		},
+		{
+			MethodName: "DepositInsuranceBond",
+			Handler:    _Msg_DepositInsuranceBond_Handler,
+		},
+		{
+			MethodName: "WithdrawInsuranceBond",
+			Handler:    _Msg_WithdrawInsuranceBond_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RevertTombstone",
+			Handler:    _Msg_RevertTombstone_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/tx.proto",
@@ -524,7 +1273,7 @@ func (m *MsgUnjailResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgHeartbeat) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -534,24 +1283,203 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgHeartbeat) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgHeartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgHeartbeatResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgHeartbeatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositInsuranceBond) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositInsuranceBond) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositInsuranceBond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositInsuranceBondResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositInsuranceBondResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositInsuranceBondResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawInsuranceBond) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawInsuranceBond) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawInsuranceBond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawInsuranceBondResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawInsuranceBondResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawInsuranceBondResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
 	i--
 	dAtA[i] = 0x12
 	if len(m.Authority) > 0 {
@@ -587,6 +1515,73 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRevertTombstone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevertTombstone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevertTombstone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevertTombstoneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevertTombstoneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevertTombstoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -620,37 +1615,566 @@ func (m *MsgUnjailResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
+func (m *MsgHeartbeat) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Authority)
+	l = len(m.ValidatorAddr)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	return n
+}
+
+func (m *MsgHeartbeatResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositInsuranceBond) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDepositInsuranceBondResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawInsuranceBond) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawInsuranceBondResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevertTombstone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevertTombstoneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUnjail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnjailResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjailResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjailResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgHeartbeat) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgHeartbeat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgHeartbeat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgHeartbeatResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgHeartbeatResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositInsuranceBond) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositInsuranceBond: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositInsuranceBond: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositInsuranceBondResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositInsuranceBondResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositInsuranceBondResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUnjail) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawInsuranceBond) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -673,10 +2197,10 @@ func (m *MsgUnjail) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnjail: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawInsuranceBond: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnjail: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawInsuranceBond: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -711,6 +2235,39 @@ func (m *MsgUnjail) Unmarshal(dAtA []byte) error {
 			}
 			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -732,7 +2289,7 @@ func (m *MsgUnjail) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnjailResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawInsuranceBondResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -755,10 +2312,10 @@ func (m *MsgUnjailResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnjailResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawInsuranceBondResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnjailResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawInsuranceBondResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -947,6 +2504,202 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRevertTombstone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevertTombstone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevertTombstone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevertTombstoneResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevertTombstoneResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevertTombstoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -137,6 +137,116 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query the current staking parameters information",
 					Long:      "Query values set as staking parameters.",
 				},
+				{
+					RpcMethod:      "DelegationPosition",
+					Use:            "delegation-position [position-id]",
+					Short:          "Query a delegation position by id",
+					Example:        fmt.Sprintf(`%s query staking delegation-position 1`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "position_id"}},
+				},
+				{
+					RpcMethod:      "OwnerDelegationPositions",
+					Use:            "delegation-positions [owner-addr]",
+					Short:          "Query all delegation positions of an owner",
+					Example:        fmt.Sprintf(`%s query staking delegation-positions cosmos1...`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "owner"}},
+				},
+				{
+					RpcMethod:      "TokenizeShareRecordById",
+					Use:            "tokenize-share-record [record-id]",
+					Short:          "Query a tokenize share record by id",
+					Example:        fmt.Sprintf(`%s query staking tokenize-share-record 1`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "TokenizeShareRecordByDenom",
+					Use:            "tokenize-share-record-by-denom [denom]",
+					Short:          "Query the tokenize share record of a share token denom",
+					Example:        fmt.Sprintf(`%s query staking tokenize-share-record-by-denom cosmosvaloper.../1`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "TokenizeShareRecordsOwned",
+					Use:            "tokenize-share-records-owned [owner-addr]",
+					Short:          "Query all tokenize share records of an owner",
+					Example:        fmt.Sprintf(`%s query staking tokenize-share-records-owned cosmos1...`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "owner"}},
+				},
+				{
+					RpcMethod: "AllTokenizeShareRecords",
+					Use:       "all-tokenize-share-records",
+					Short:     "Query all tokenize share records",
+				},
+				{
+					RpcMethod: "TotalLiquidStaked",
+					Use:       "total-liquid-staked",
+					Short:     "Query the amount of tokens tokenized into share tokens",
+					Long:      "Query the amount of tokens tokenized into share tokens, and the tokenized shares of a validator if --validator-address is given.",
+				},
+				{
+					RpcMethod:      "DelegatorAutoCompoundSettings",
+					Use:            "auto-compound-settings [delegator-addr]",
+					Short:          "Query the delegations of a delegator whose rewards are auto-compounded",
+					Example:        fmt.Sprintf(`%s query staking auto-compound-settings cosmos1...`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "delegator_addr"}},
+				},
+				{
+					RpcMethod:      "ScheduledCommissionChange",
+					Use:            "scheduled-commission-change [validator-addr]",
+					Short:          "Query the commission rate change scheduled by a validator",
+					Example:        fmt.Sprintf(`%s query staking scheduled-commission-change cosmosvaloper...`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_addr"}},
+				},
+				{
+					RpcMethod:      "ValidatorPerformance",
+					Use:            "validator-performance [validator-addr]",
+					Short:          "Query the signed, missed and proposed blocks of a validator over the performance window",
+					Example:        fmt.Sprintf(`%s query staking validator-performance cosmosvaloper...`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_addr"}},
+				},
+				{
+					RpcMethod:      "UnbondingQueue",
+					Use:            "unbonding-queue [start-time] [end-time]",
+					Short:          "Query the unbonding delegation entries maturing within a time range, with the amounts maturing per day",
+					Example:        fmt.Sprintf(`%s query staking unbonding-queue 2024-01-01T00:00:00Z 2024-01-22T00:00:00Z`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "start_time"}, {ProtoField: "end_time"}},
+				},
+				{
+					RpcMethod:      "RedelegationQueue",
+					Use:            "redelegation-queue [start-time] [end-time]",
+					Short:          "Query the redelegation entries maturing within a time range, with the amounts maturing per day",
+					Example:        fmt.Sprintf(`%s query staking redelegation-queue 2024-01-01T00:00:00Z 2024-01-22T00:00:00Z`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "start_time"}, {ProtoField: "end_time"}},
+				},
+				{
+					RpcMethod: "DenomBondedAmounts",
+					Use:       "denom-bonded-amounts",
+					Short:     "Query the amounts of tokens bonded per bondable denom, along with their weights",
+				},
+				{
+					RpcMethod:      "ValidatorMultiAssetPools",
+					Use:            "validator-multi-asset-pools [validator-addr]",
+					Short:          "Query the tokens of the bondable denoms other than the bond denom delegated to a validator",
+					Example:        fmt.Sprintf(`%s query staking validator-multi-asset-pools cosmosvaloper...`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_addr"}},
+				},
+				{
+					RpcMethod:      "DelegatorMultiAssetDelegations",
+					Use:            "multi-asset-delegations [delegator-addr]",
+					Short:          "Query the delegations of the bondable denoms other than the bond denom of a delegator",
+					Example:        fmt.Sprintf(`%s query staking multi-asset-delegations cosmos1...`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "delegator_addr"}},
+				},
+				{
+					RpcMethod: "MaxValidatorsSchedule",
+					Use:       "max-validators-schedule",
+					Short:     "Query the changes of the max validators param scheduled at future heights",
+				},
+				{
+					RpcMethod: "RedelegationExemptions",
+					Use:       "redelegation-exemptions",
+					Short:     "Query the redelegation exemptions approved by governance for validator consolidations",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -150,6 +260,22 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Example:        fmt.Sprintf("%s tx staking delegate cosmosvaloper... 1000stake --from mykey", version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount"}},
 				},
+				{
+					RpcMethod:      "FlashUndelegate",
+					Use:            "flash-unbond [validator-addr] [amount] --from [delegator_address]",
+					Short:          "Unbond tokens from a validator without waiting for the unbonding period, in exchange for a penalty",
+					Long:           "Unbond an amount of tokens from a validator and receive them immediately. The flash_undelegation_penalty param of the tokens is sent to the community pool, and at most max_flash_undelegation_rate of the tokens of a validator can be flash unbonded per block.",
+					Example:        fmt.Sprintf(`%s tx staking flash-unbond cosmosvaloper... 100stake --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "ExecStakingOperations",
+					Use:            "exec-operations [operation-json...] --from [delegator_address]",
+					Short:          "Execute a batch of delegations, redelegations and restakings of rewards",
+					Long:           "Execute a batch of staking operations of a delegator, all of them failing if any fails. Grantees of a DelegateOnBehalfAuthorization execute them on behalf of the granter with authz.",
+					Example:        fmt.Sprintf(`%s tx staking exec-operations '{"operation_type":"STAKING_OPERATION_TYPE_RESTAKE_REWARDS","validator_address":"cosmosvaloper..."}' --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "operations", Varargs: true}},
+				},
 				{
 					RpcMethod:      "BeginRedelegate",
 					Use:            "redelegate [src-validator-addr] [dst-validator-addr] [amount] --from [delegator]",
@@ -180,6 +306,72 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Example:        fmt.Sprintf(`%s tx staking rotate-cons-pubkey myvalidator {"@type":"/cosmos.crypto.ed25519.PubKey","key":"oWg2ISpLF405Jcm2vXV+2v4fnjodh6aafuIdeoW+rUw="}`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "new_pubkey"}},
 				},
+				{
+					RpcMethod:      "TokenizeDelegation",
+					Use:            "tokenize-delegation [validator-addr] [amount] --from [delegator_address]",
+					Short:          "Tokenize delegated shares into a transferable delegation position",
+					Long:           "Tokenize an amount of bonded shares into a delegation position. The shares cannot be unbonded or redelegated until the position is redeemed.",
+					Example:        fmt.Sprintf(`%s tx staking tokenize-delegation cosmosvaloper... 100stake --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "TransferDelegationPosition",
+					Use:            "transfer-delegation-position [position-id] [recipient] --from [owner]",
+					Short:          "Transfer a delegation position and its delegated shares to a new owner",
+					Example:        fmt.Sprintf(`%s tx staking transfer-delegation-position 1 cosmos1... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "position_id"}, {ProtoField: "recipient"}},
+				},
+				{
+					RpcMethod:      "RedeemDelegationPosition",
+					Use:            "redeem-delegation-position [position-id] --from [owner]",
+					Short:          "Redeem a delegation position, unlocking its delegated shares",
+					Example:        fmt.Sprintf(`%s tx staking redeem-delegation-position 1 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "position_id"}},
+				},
+				{
+					RpcMethod:      "TokenizeShares",
+					Use:            "tokenize-share [validator-addr] [amount] --from [delegator_address]",
+					Short:          "Tokenize delegated shares into liquid share tokens",
+					Long:           "Tokenize an amount of bonded shares into share tokens that can be transferred. The rewards of the tokenized shares go to the owner of the created tokenize share record, the delegator unless --tokenized-share-owner is given.",
+					Example:        fmt.Sprintf(`%s tx staking tokenize-share cosmosvaloper... 100stake --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "RedeemTokensForShares",
+					Use:            "redeem-tokens [amount] --from [delegator_address]",
+					Short:          "Redeem share tokens for the delegated shares they are worth",
+					Example:        fmt.Sprintf(`%s tx staking redeem-tokens 100cosmosvaloper.../1 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "TransferTokenizeShareRecord",
+					Use:            "transfer-tokenize-share-record [record-id] [new-owner] --from [sender]",
+					Short:          "Transfer the ownership, and the rewards, of a tokenize share record",
+					Example:        fmt.Sprintf(`%s tx staking transfer-tokenize-share-record 1 cosmos1... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tokenize_share_record_id"}, {ProtoField: "new_owner"}},
+				},
+				{
+					RpcMethod:      "SetAutoCompound",
+					Use:            "set-auto-compound [validator-addr] [enabled] --from [delegator_address]",
+					Short:          "Enable or disable the auto-compounding of the rewards of a delegation",
+					Long:           "Enable or disable the automatic re-delegation of the rewards of a delegation. The rewards are withdrawn and re-delegated by the end blocker once they reach the min_auto_compound_reward param.",
+					Example:        fmt.Sprintf(`%s tx staking set-auto-compound cosmosvaloper... true --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "enabled"}},
+				},
+				{
+					RpcMethod:      "ScheduleCommissionChange",
+					Use:            "schedule-commission-change [new-rate] --from [validator]",
+					Short:          "Schedule a commission rate change, applied after the commission change notice period",
+					Long:           "Schedule a commission rate change of the validator, replacing any change it already scheduled. The change is applied by the end blocker once the commission_change_notice_period param has elapsed.",
+					Example:        fmt.Sprintf(`%s tx staking schedule-commission-change 0.1 --from myvalidator`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "new_rate"}},
+				},
+				{
+					RpcMethod: "CancelCommissionChange",
+					Use:       "cancel-commission-change --from [validator]",
+					Short:     "Cancel the commission rate change scheduled by the validator",
+					Example:   fmt.Sprintf(`%s tx staking cancel-commission-change --from myvalidator`, version.AppName),
+				},
 				{
 					RpcMethod:      "UpdateParams",
 					Use:            "update-params-proposal [params]",
@@ -189,6 +381,60 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
 					GovProposal:    true,
 				},
+				{
+					RpcMethod:      "SetBondableDenom",
+					Use:            "set-bondable-denom-proposal [denom] [weight]",
+					Short:          "Submit a proposal to register, update or remove a bondable denom other than the bond denom",
+					Long:           "Submit a proposal to set the weight the tokens of a denom count as towards the voting power when delegated. A zero weight removes the bondable denom.",
+					Example:        fmt.Sprintf(`%s tx staking set-bondable-denom-proposal ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2 0.5`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "weight"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "DelegateMultiAsset",
+					Use:            "delegate-multi-asset [validator-addr] [amount] --from [delegator_address]",
+					Short:          "Delegate tokens of a bondable denom other than the bond denom to a validator",
+					Example:        fmt.Sprintf("%s tx staking delegate-multi-asset cosmosvaloper... 1000uatom --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "UndelegateMultiAsset",
+					Use:            "unbond-multi-asset [validator-addr] [amount] --from [delegator_address]",
+					Short:          "Unbond tokens of a bondable denom other than the bond denom from a validator",
+					Example:        fmt.Sprintf("%s tx staking unbond-multi-asset cosmosvaloper... 100uatom --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:   "ScheduleMaxValidators",
+					Use:         "schedule-max-validators-proposal --changes [change]...",
+					Short:       "Submit a proposal to schedule changes of the max validators param at future heights",
+					Long:        "Submit a proposal to schedule changes of the max validators param at future heights, replacing the changes previously scheduled. Without changes, the proposal cancels the schedule.",
+					Example:     fmt.Sprintf(`%s tx staking schedule-max-validators-proposal --changes '{"height":"1000","max_validators":110}' --changes '{"height":"2000","max_validators":120}'`, version.AppName),
+					GovProposal: true,
+				},
+				{
+					RpcMethod:      "ApproveRedelegationExemption",
+					Use:            "approve-redelegation-exemption-proposal [exemption]",
+					Short:          "Submit a proposal to approve a validator consolidation, lifting the redelegation restrictions from the source validator until the expiry",
+					Example:        fmt.Sprintf(`%s tx staking approve-redelegation-exemption-proposal '{"validator_src_address":"cosmosvaloper1...","validator_dst_address":"cosmosvaloper1...","expiry":"2024-01-01T00:00:00Z"}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "exemption"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "ForceUndelegate",
+					Use:            "force-undelegate-proposal [delegator] [validator] [reason]",
+					Short:          "Submit a proposal to forcibly undelegate a delegation, the whole delegation unless an amount is given, and optionally freeze it",
+					Example:        fmt.Sprintf(`%s tx staking force-undelegate-proposal cosmos1... cosmosvaloper1... "court order" --amount 100stake --freeze`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "delegator_address"}, {ProtoField: "validator_address"}, {ProtoField: "reason"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "UnfreezeDelegation",
+					Use:            "unfreeze-delegation-proposal [delegator] [validator] [reason]",
+					Short:          "Submit a proposal to unfreeze a delegation frozen by a forced undelegation",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "delegator_address"}, {ProtoField: "validator_address"}, {ProtoField: "reason"}},
+					GovProposal:    true,
+				},
 			},
 			EnhanceCustomCommand: true,
 		},
@@ -6,6 +6,7 @@ import (
 	cmttypes "github.com/cometbft/cometbft/types"
 	gogotypes "github.com/cosmos/gogoproto/types"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/staking/keeper"
 	"cosmossdk.io/x/staking/types"
 
@@ -55,9 +56,131 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateDelegationPositions(data.DelegationPositions); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateTokenizeShareRecords(data.TokenizeShareRecords); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateAutoCompoundSettings(data.AutoCompoundSettings); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateScheduledCommissionChanges(data.ScheduledCommissionChanges); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateBondableDenoms(data.BondableDenoms, data.Params.BondDenom); err != nil {
+		return err
+	}
+
+	if err := types.ValidateMaxValidatorsChanges(data.MaxValidatorsSchedule, 0); err != nil {
+		return err
+	}
+
+	if err := types.ValidateRedelegationExemptions(data.RedelegationExemptions); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateFrozenDelegations(data.FrozenDelegations); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
+func validateGenesisStateTokenizeShareRecords(records []types.TokenizeShareRecord) error {
+	ids := make(map[uint64]bool, len(records))
+	for _, record := range records {
+		if ids[record.Id] {
+			return fmt.Errorf("duplicate tokenize share record in genesis state: id %d", record.Id)
+		}
+		ids[record.Id] = true
+
+		if record.ModuleAccount != types.TokenizeShareModuleAccountName(record.Id) {
+			return fmt.Errorf("tokenize share record %d has invalid module account %s", record.Id, record.ModuleAccount)
+		}
+	}
+
+	return nil
+}
+
+func validateGenesisStateAutoCompoundSettings(settings []types.AutoCompoundSetting) error {
+	seen := make(map[string]bool, len(settings))
+	for _, setting := range settings {
+		key := setting.DelegatorAddress + "/" + setting.ValidatorAddress
+		if seen[key] {
+			return fmt.Errorf("duplicate auto-compound setting in genesis state: delegator %s, validator %s", setting.DelegatorAddress, setting.ValidatorAddress)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+func validateGenesisStateFrozenDelegations(pairs []types.DVPair) error {
+	seen := make(map[string]bool, len(pairs))
+	for _, pair := range pairs {
+		key := pair.DelegatorAddress + "/" + pair.ValidatorAddress
+		if seen[key] {
+			return fmt.Errorf("duplicate frozen delegation in genesis state: delegator %s, validator %s", pair.DelegatorAddress, pair.ValidatorAddress)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+func validateGenesisStateScheduledCommissionChanges(changes []types.ScheduledCommissionChange) error {
+	seen := make(map[string]bool, len(changes))
+	for _, change := range changes {
+		if seen[change.ValidatorAddress] {
+			return fmt.Errorf("duplicate scheduled commission change in genesis state: validator %s", change.ValidatorAddress)
+		}
+		seen[change.ValidatorAddress] = true
+
+		if change.NewRate.IsNil() || change.NewRate.IsNegative() || change.NewRate.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("scheduled commission change of validator %s has invalid rate %s", change.ValidatorAddress, change.NewRate)
+		}
+	}
+
+	return nil
+}
+
+func validateGenesisStateBondableDenoms(denoms []types.BondableDenom, bondDenom string) error {
+	seen := make(map[string]bool, len(denoms))
+	for _, denom := range denoms {
+		if seen[denom.Denom] {
+			return fmt.Errorf("duplicate bondable denom in genesis state: %s", denom.Denom)
+		}
+		seen[denom.Denom] = true
+
+		if err := types.ValidateBondableDenom(denom.Denom, denom.Weight, bondDenom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateGenesisStateDelegationPositions(positions []types.DelegationPosition) error {
+	ids := make(map[uint64]bool, len(positions))
+	for _, position := range positions {
+		if ids[position.Id] {
+			return fmt.Errorf("duplicate delegation position in genesis state: id %d", position.Id)
+		}
+		ids[position.Id] = true
+
+		if position.Shares.IsNil() || !position.Shares.IsPositive() {
+			return fmt.Errorf("delegation position %d must have positive shares", position.Id)
+		}
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) error {
 	addrMap := make(map[string]bool, len(validators))
 
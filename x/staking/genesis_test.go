@@ -41,6 +41,34 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = types.Bonded
 		}, true},
+		// validate bondable denoms
+		{"bondable denom", func(data *types.GenesisState) {
+			data.BondableDenoms = []types.BondableDenom{{Denom: "uatom", Weight: math.LegacyNewDecWithPrec(5, 1)}}
+		}, false},
+		{"bond denom as bondable denom", func(data *types.GenesisState) {
+			data.BondableDenoms = []types.BondableDenom{{Denom: sdk.DefaultBondDenom, Weight: math.LegacyOneDec()}}
+		}, true},
+		{"zero weight bondable denom", func(data *types.GenesisState) {
+			data.BondableDenoms = []types.BondableDenom{{Denom: "uatom", Weight: math.LegacyZeroDec()}}
+		}, true},
+		{"duplicate bondable denom", func(data *types.GenesisState) {
+			data.BondableDenoms = []types.BondableDenom{{Denom: "uatom", Weight: math.LegacyOneDec()}, {Denom: "uatom", Weight: math.LegacyOneDec()}}
+		}, true},
+		// validate max validators schedule
+		{"max validators schedule", func(data *types.GenesisState) {
+			data.MaxValidatorsSchedule = []types.MaxValidatorsChange{{Height: 10, MaxValidators: 110}, {Height: 20, MaxValidators: 120}}
+		}, false},
+		{"duplicate max validators change", func(data *types.GenesisState) {
+			data.MaxValidatorsSchedule = []types.MaxValidatorsChange{{Height: 10, MaxValidators: 110}, {Height: 10, MaxValidators: 120}}
+		}, true},
+		{"zero max validators change", func(data *types.GenesisState) {
+			data.MaxValidatorsSchedule = []types.MaxValidatorsChange{{Height: 10, MaxValidators: 0}}
+		}, true},
+		// validate frozen delegations
+		{"duplicate frozen delegation", func(data *types.GenesisState) {
+			pair := types.DVPair{DelegatorAddress: "cosmos1del", ValidatorAddress: "cosmosvaloper1val"}
+			data.FrozenDelegations = []types.DVPair{pair, pair}
+		}, true},
 	}
 
 	for _, tt := range tests {
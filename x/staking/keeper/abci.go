@@ -12,14 +12,49 @@ import (
 )
 
 // BeginBlocker will persist the current header and validator set as a historical entry
-// and prune the oldest entry based on the HistoricalEntries parameter
+// and prune the oldest entry based on the HistoricalEntries parameter, and track the
+// performance of the validators over the PerformanceWindow parameter
 func (k *Keeper) BeginBlocker(ctx context.Context) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
-	return k.TrackHistoricalInfo(ctx)
+
+	if err := k.TrackHistoricalInfo(ctx); err != nil {
+		return err
+	}
+
+	return k.TrackValidatorPerformance(ctx)
 }
 
-// EndBlocker called at every block, update validator set
+// EndBlocker called at every block, reset the flash undelegation quotas, apply scheduled
+// commission changes, complete the mature multi-asset unbondings, auto-compound
+// delegation rewards, apply the scheduled MaxValidators changes, prune the expired redelegation
+// exemptions and update validator set
 func (k *Keeper) EndBlocker(ctx context.Context) ([]abci.ValidatorUpdate, error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	if err := k.FlashUndelegatedAmounts.Clear(ctx, nil); err != nil {
+		return nil, err
+	}
+
+	if err := k.ApplyScheduledCommissionChanges(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := k.CompleteMatureMultiAssetUnbondings(ctx); err != nil {
+		return nil, err
+	}
+
+	// compounded rewards are delegated before the validator set update to account for them
+	if err := k.ProcessAutoCompounds(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := k.ApplyMaxValidatorsChanges(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := k.PruneExpiredRedelegationExemptions(ctx); err != nil {
+		return nil, err
+	}
+
 	return k.BlockValidatorUpdates(ctx)
 }
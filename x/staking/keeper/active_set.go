@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"sort"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// activeSetCandidate is a validator of the power store along with its power.
+type activeSetCandidate struct {
+	valAddr sdk.ValAddress
+	power   int64
+}
+
+// activeSetCandidates returns the validators contending for the active set,
+// highest power to lowest, up to maxValidators of them.
+//
+// The power store breaks the ties between validators with the same power by
+// address. When a performance scorer is set and the validators with the power
+// of the last seat outnumber the seats left, they are instead ordered by
+// decreasing performance score, falling back to the address ordering between
+// equal scores.
+func (k Keeper) activeSetCandidates(ctx context.Context, maxValidators uint32) ([]sdk.ValAddress, error) {
+	iterator, err := k.ValidatorsPowerStoreIterator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var (
+		candidates    []activeSetCandidate
+		boundaryPower int64
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		// key is of format prefix (1 byte) || powerbytes (8 bytes) || addrLen (1byte) || addrBytes
+		power := int64(binary.BigEndian.Uint64(iterator.Key()[1:9]))
+
+		// past the last seat, only the validators tied with it are retrieved,
+		// zero-power validators never being bonded
+		if len(candidates) >= int(maxValidators) &&
+			(k.performanceScorer == nil || boundaryPower == 0 || power != boundaryPower) {
+			break
+		}
+
+		candidates = append(candidates, activeSetCandidate{valAddr: sdk.ValAddress(iterator.Value()), power: power})
+		if len(candidates) == int(maxValidators) {
+			boundaryPower = power
+		}
+	}
+
+	if len(candidates) > int(maxValidators) {
+		start := sort.Search(len(candidates), func(i int) bool {
+			return candidates[i].power <= boundaryPower
+		})
+
+		if err := k.sortByPerformance(ctx, candidates[start:]); err != nil {
+			return nil, err
+		}
+
+		candidates = candidates[:maxValidators]
+	}
+
+	valAddrs := make([]sdk.ValAddress, len(candidates))
+	for i, candidate := range candidates {
+		valAddrs[i] = candidate.valAddr
+	}
+
+	return valAddrs, nil
+}
+
+// sortByPerformance sorts the given candidates by decreasing performance
+// score, keeping their order between equal scores.
+func (k Keeper) sortByPerformance(ctx context.Context, candidates []activeSetCandidate) error {
+	scores := make(map[string]math.LegacyDec, len(candidates))
+	for _, candidate := range candidates {
+		score, err := k.performanceScorer.PerformanceScore(ctx, candidate.valAddr)
+		if err != nil {
+			return err
+		}
+		if score.IsNil() {
+			score = math.LegacyZeroDec()
+		}
+
+		scores[string(candidate.valAddr)] = score
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return scores[string(candidates[i].valAddr)].GT(scores[string(candidates[j].valAddr)])
+	})
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/testutil"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type mockPerformanceScorer map[string]math.LegacyDec
+
+func (m mockPerformanceScorer) PerformanceScore(_ context.Context, valAddr sdk.ValAddress) (math.LegacyDec, error) {
+	return m[string(valAddr)], nil
+}
+
+func (s *KeeperTestSuite) TestActiveSetPerformanceTieBreak() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.MaxValidators = 3
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	// one validator with a power of 200 and four tied with a power of 100
+	// contending for the last two seats
+	for i, power := range []int64{200, 100, 100, 100, 100} {
+		validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address().Bytes()), PKs[i])
+		validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, power))
+		require.NoError(keeper.SetValidator(ctx, validator))
+		require.NoError(keeper.SetValidatorByPowerIndex(ctx, validator))
+		require.NoError(keeper.SetValidatorByConsAddr(ctx, validator))
+	}
+
+	// the power store orders the tied validators by address
+	var ordered []sdk.ValAddress
+	iterator, err := keeper.ValidatorsPowerStoreIterator(ctx)
+	require.NoError(err)
+	for ; iterator.Valid(); iterator.Next() {
+		ordered = append(ordered, iterator.Value())
+	}
+	require.NoError(iterator.Close())
+	require.Len(ordered, 5)
+
+	// the last tied validator performs best, the others equally
+	scores := mockPerformanceScorer{}
+	for _, valAddr := range ordered[1:] {
+		scores[string(valAddr)] = math.LegacyOneDec()
+	}
+	scores[string(ordered[4])] = math.LegacyNewDec(2)
+	keeper.SetPerformanceScorer(scores)
+	require.Panics(func() { keeper.SetPerformanceScorer(scores) })
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any()).AnyTimes()
+	s.applyValidatorSetUpdates(ctx, keeper, 3)
+
+	// the best performing validator wins a seat, and the address ordering
+	// breaks the tie between equal scores
+	for i, bonded := range []bool{true, true, false, false, true} {
+		validator, err := keeper.GetValidator(ctx, ordered[i])
+		require.NoError(err)
+		require.Equal(bonded, validator.IsBonded(), "validator %d", i)
+	}
+}
@@ -0,0 +1,250 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// errBelowAutoCompoundThreshold is returned to revert the withdrawal of rewards
+// too small to be auto-compounded.
+var errBelowAutoCompoundThreshold = errors.New("rewards below the auto-compound threshold")
+
+// SetAutoCompound opts a delegation into, or out of, the auto-compounding of its
+// rewards. Only existing delegations can be opted in.
+func (k Keeper) SetAutoCompound(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) error {
+	key := collections.Join(delAddr, valAddr)
+	if !enabled {
+		return k.AutoCompoundSettings.Remove(ctx, key)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.MaxAutoCompoundsPerBlock == 0 {
+		return types.ErrAutoCompoundDisabled
+	}
+
+	has, err := k.Delegations.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return types.ErrNoDelegation
+	}
+
+	return k.AutoCompoundSettings.Set(ctx, key)
+}
+
+// GetDelegatorAutoCompoundSettings returns the validators of the auto-compounding
+// delegations of a delegator.
+func (k Keeper) GetDelegatorAutoCompoundSettings(ctx context.Context, delAddr sdk.AccAddress) ([]sdk.ValAddress, error) {
+	var valAddrs []sdk.ValAddress
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.ValAddress](delAddr)
+	err := k.AutoCompoundSettings.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress]) (bool, error) {
+		valAddrs = append(valAddrs, key.K2())
+		return false, nil
+	})
+
+	return valAddrs, err
+}
+
+// ProcessAutoCompounds withdraws and re-delegates the rewards of the
+// auto-compounding delegations. At most MaxAutoCompoundsPerBlock delegations
+// are processed per block, the next block resuming where the previous one
+// stopped. Only the rewards paid to the delegator itself, in bond denom, and
+// worth at least MinAutoCompoundReward are compounded. A failing delegation is
+// skipped without halting the processing.
+func (k Keeper) ProcessAutoCompounds(ctx context.Context) error {
+	if k.rewardsWithdrawer == nil {
+		return nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.MaxAutoCompoundsPerBlock == 0 {
+		return nil
+	}
+
+	threshold := params.MinAutoCompoundReward
+	if threshold.IsNil() {
+		threshold = math.ZeroInt()
+	}
+
+	keys, next, err := k.nextAutoCompounds(ctx, params.MaxAutoCompoundsPerBlock)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		has, err := k.Delegations.Has(ctx, key)
+		if err != nil {
+			return err
+		}
+		// the delegation was fully undelegated or redelegated
+		if !has {
+			if err := k.AutoCompoundSettings.Remove(ctx, key); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// frozen delegations cannot be increased
+		frozen, err := k.IsDelegationFrozen(ctx, key.K1(), key.K2())
+		if err != nil {
+			return err
+		}
+		if frozen {
+			continue
+		}
+
+		err = k.environment.BranchService.Execute(ctx, func(ctx context.Context) error {
+			return k.autoCompound(ctx, key.K1(), key.K2(), params.BondDenom, threshold)
+		})
+		if err != nil && !errors.Is(err, errBelowAutoCompoundThreshold) {
+			k.Logger().Error("failed to auto-compound delegation rewards", "delegator", key.K1().String(), "validator", key.K2().String(), "error", err)
+		}
+	}
+
+	if next == nil {
+		return k.AutoCompoundCursor.Remove(ctx)
+	}
+
+	return k.AutoCompoundCursor.Set(ctx, *next)
+}
+
+// nextAutoCompounds returns up to limit auto-compounding delegations, starting
+// from the cursor, and the key of the delegation following them, if any.
+func (k Keeper) nextAutoCompounds(ctx context.Context, limit uint32) (
+	keys []collections.Pair[sdk.AccAddress, sdk.ValAddress], next *collections.Pair[sdk.AccAddress, sdk.ValAddress], err error,
+) {
+	var rng collections.Ranger[collections.Pair[sdk.AccAddress, sdk.ValAddress]]
+	cursor, err := k.AutoCompoundCursor.Get(ctx)
+	switch {
+	case err == nil:
+		rng = new(collections.Range[collections.Pair[sdk.AccAddress, sdk.ValAddress]]).StartInclusive(cursor)
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, nil, err
+	}
+
+	err = k.AutoCompoundSettings.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress]) (bool, error) {
+		if uint32(len(keys)) == limit {
+			next = &key
+			return true, nil
+		}
+
+		keys = append(keys, key)
+		return false, nil
+	})
+
+	return keys, next, err
+}
+
+// autoCompound withdraws the rewards of a delegation and re-delegates them. It
+// returns errBelowAutoCompoundThreshold, for the caller to revert the
+// withdrawal, when the rewards are below the threshold.
+func (k Keeper) autoCompound(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, bondDenom string, threshold math.Int) error {
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	amount, err := k.withdrawBondDenomRewards(ctx, delAddr, valAddr, bondDenom)
+	if err != nil {
+		return err
+	}
+
+	if !amount.IsPositive() || amount.LT(threshold) {
+		return errBelowAutoCompoundThreshold
+	}
+
+	if _, err := k.Delegate(ctx, delAddr, amount, types.Unbonded, validator, true); err != nil {
+		return err
+	}
+
+	delStr, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return err
+	}
+	valStr, err := k.validatorAddressCodec.BytesToString(valAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeAutoCompound,
+		event.NewAttribute(types.AttributeKeyDelegator, delStr),
+		event.NewAttribute(types.AttributeKeyValidator, valStr),
+		event.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, amount).String()),
+	)
+}
+
+// RestakeRewards withdraws the rewards of a delegation and delegates them back
+// to the validator, returning the restaked amount. Only the rewards paid to the
+// delegator itself, in bond denom, are restaked.
+func (k Keeper) RestakeRewards(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (math.Int, error) {
+	if k.rewardsWithdrawer == nil {
+		return math.ZeroInt(), types.ErrRestakeUnavailable
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	amount, err := k.withdrawBondDenomRewards(ctx, delAddr, valAddr, bondDenom)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	if !amount.IsPositive() {
+		return math.ZeroInt(), nil
+	}
+
+	if _, err := k.Delegate(ctx, delAddr, amount, types.Unbonded, validator, true); err != nil {
+		return math.ZeroInt(), err
+	}
+
+	delStr, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+	valStr, err := k.validatorAddressCodec.BytesToString(valAddr)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	return amount, k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeRestakeRewards,
+		event.NewAttribute(types.AttributeKeyDelegator, delStr),
+		event.NewAttribute(types.AttributeKeyValidator, valStr),
+		event.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, amount).String()),
+	)
+}
+
+// withdrawBondDenomRewards withdraws the rewards of a delegation and returns
+// the amount of bond denom paid to the delegator.
+func (k Keeper) withdrawBondDenomRewards(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, bondDenom string) (math.Int, error) {
+	// the rewards are measured on the delegator balance, as they are paid to
+	// the withdraw address, which may not be the delegator
+	before := k.bankKeeper.GetBalance(ctx, delAddr, bondDenom)
+	if _, err := k.rewardsWithdrawer.WithdrawDelegationRewards(ctx, delAddr, valAddr); err != nil {
+		return math.ZeroInt(), err
+	}
+
+	return k.bankKeeper.GetBalance(ctx, delAddr, bondDenom).Amount.Sub(before.Amount), nil
+}
@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ScheduleCommissionChange schedules a commission rate change of a validator,
+// applied once the commission change notice period has elapsed. It replaces
+// the change previously scheduled by the validator, if any.
+func (k Keeper) ScheduleCommissionChange(ctx context.Context, valAddr sdk.ValAddress, newRate math.LegacyDec) (types.ScheduledCommissionChange, error) {
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return types.ScheduledCommissionChange{}, err
+	}
+
+	// the rate is validated against the current commission, but the 24 hours
+	// between two commission changes are only checked when the change is applied
+	commission := validator.Commission
+	commission.UpdateTime = time.Time{}
+	blockTime := k.environment.HeaderService.GetHeaderInfo(ctx).Time
+	if err := commission.ValidateNewRate(newRate, blockTime); err != nil {
+		return types.ScheduledCommissionChange{}, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.ScheduledCommissionChange{}, err
+	}
+	if newRate.LT(params.MinCommissionRate) {
+		return types.ScheduledCommissionChange{}, types.ErrCommissionLTMinRate
+	}
+
+	if err := k.removeScheduledCommissionChange(ctx, valAddr); err != nil && !errors.Is(err, types.ErrNoScheduledCommissionChange) {
+		return types.ScheduledCommissionChange{}, err
+	}
+
+	change := types.ScheduledCommissionChange{
+		ValidatorAddress: validator.OperatorAddress,
+		NewRate:          newRate,
+		EffectiveTime:    blockTime.Add(params.CommissionChangeNoticePeriod),
+	}
+	if err := k.SetScheduledCommissionChange(ctx, valAddr, change); err != nil {
+		return types.ScheduledCommissionChange{}, err
+	}
+
+	return change, k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeScheduleCommissionChange,
+		event.NewAttribute(types.AttributeKeyValidator, change.ValidatorAddress),
+		event.NewAttribute(types.AttributeKeyCommissionRate, newRate.String()),
+		event.NewAttribute(types.AttributeKeyEffectiveTime, change.EffectiveTime.Format(time.RFC3339)),
+	)
+}
+
+// CancelCommissionChange cancels the commission rate change scheduled by a
+// validator.
+func (k Keeper) CancelCommissionChange(ctx context.Context, valAddr sdk.ValAddress) error {
+	if err := k.removeScheduledCommissionChange(ctx, valAddr); err != nil {
+		return err
+	}
+
+	valStr, err := k.validatorAddressCodec.BytesToString(valAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCancelCommissionChange,
+		event.NewAttribute(types.AttributeKeyValidator, valStr),
+	)
+}
+
+// SetScheduledCommissionChange stores a scheduled commission change and
+// inserts it in the queue of the scheduled commission changes.
+func (k Keeper) SetScheduledCommissionChange(ctx context.Context, valAddr sdk.ValAddress, change types.ScheduledCommissionChange) error {
+	if err := k.ScheduledCommissionChanges.Set(ctx, valAddr, change); err != nil {
+		return err
+	}
+
+	return k.ScheduledCommissionChangeQueue.Set(ctx, collections.Join(change.EffectiveTime, valAddr))
+}
+
+// removeScheduledCommissionChange removes the commission change scheduled by a
+// validator from the store and from the queue.
+func (k Keeper) removeScheduledCommissionChange(ctx context.Context, valAddr sdk.ValAddress) error {
+	change, err := k.ScheduledCommissionChanges.Get(ctx, valAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ErrNoScheduledCommissionChange
+		}
+		return err
+	}
+
+	if err := k.ScheduledCommissionChanges.Remove(ctx, valAddr); err != nil {
+		return err
+	}
+
+	return k.ScheduledCommissionChangeQueue.Remove(ctx, collections.Join(change.EffectiveTime, valAddr))
+}
+
+// ApplyScheduledCommissionChanges applies the scheduled commission changes
+// whose effective time has been reached. A change which is no longer valid,
+// e.g. because the commission was edited in the last 24 hours, is dropped and
+// reported by an event.
+func (k Keeper) ApplyScheduledCommissionChanges(ctx context.Context) error {
+	blockTime := k.environment.HeaderService.GetHeaderInfo(ctx).Time
+
+	var matured []collections.Pair[time.Time, sdk.ValAddress]
+	err := k.ScheduledCommissionChangeQueue.Walk(ctx, nil, func(key collections.Pair[time.Time, sdk.ValAddress]) (bool, error) {
+		if key.K1().After(blockTime) {
+			return true, nil
+		}
+
+		matured = append(matured, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range matured {
+		valAddr := key.K2()
+		change, err := k.ScheduledCommissionChanges.Get(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+		if err := k.removeScheduledCommissionChange(ctx, valAddr); err != nil {
+			return err
+		}
+
+		err = k.environment.BranchService.Execute(ctx, func(ctx context.Context) error {
+			return k.applyCommissionChange(ctx, valAddr, change.NewRate)
+		})
+		if err != nil {
+			k.Logger().Error("failed to apply scheduled commission change", "validator", change.ValidatorAddress, "error", err)
+			if err := k.environment.EventService.EventManager(ctx).EmitKV(
+				types.EventTypeFailCommissionChange,
+				event.NewAttribute(types.AttributeKeyValidator, change.ValidatorAddress),
+				event.NewAttribute(types.AttributeKeyCommissionRate, change.NewRate.String()),
+				event.NewAttribute(types.AttributeKeyReason, err.Error()),
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// applyCommissionChange sets the commission rate of a validator.
+func (k Keeper) applyCommissionChange(ctx context.Context, valAddr sdk.ValAddress, newRate math.LegacyDec) error {
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	commission, err := k.UpdateValidatorCommission(ctx, validator, newRate)
+	if err != nil {
+		return err
+	}
+
+	if err := k.Hooks().BeforeValidatorModified(ctx, valAddr); err != nil {
+		return err
+	}
+
+	validator.Commission = commission
+	if err := k.SetValidator(ctx, validator); err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeApplyCommissionChange,
+		event.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress),
+		event.NewAttribute(types.AttributeKeyCommissionRate, commission.String()),
+	)
+}
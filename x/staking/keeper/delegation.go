@@ -329,6 +329,10 @@ func (k Keeper) SetUnbondingDelegationEntry(
 		return ubd, err
 	}
 
+	if err := k.afterUnbondingAmountChanged(ctx, delegatorAddr, validatorAddr, balance); err != nil {
+		return ubd, err
+	}
+
 	// only call the hook for new entries since
 	// calls to AfterUnbondingInitiated are not idempotent
 	if isNewUbdEntry {
@@ -696,8 +700,10 @@ func (k Keeper) Delegate(
 	// Get or create the delegation object and call the appropriate hook if present
 	delegation, err := k.Delegations.Get(ctx, collections.Join(delAddr, sdk.ValAddress(valbz)))
 	if err == nil {
-		// found
-		err = k.Hooks().BeforeDelegationSharesModified(ctx, delAddr, valbz)
+		// found, the shares issued to the delegation are computed ahead of
+		// the update of the validator for the amount-carrying hook
+		_, issuedShares := validator.AddTokensFromDel(bondAmt)
+		err = k.beforeDelegationSharesModified(ctx, delAddr, valbz, issuedShares)
 	} else if errors.Is(err, collections.ErrNotFound) {
 		// not found
 		delAddrStr, err1 := k.authKeeper.AddressCodec().BytesToString(delAddr)
@@ -799,7 +805,7 @@ func (k Keeper) Unbond(
 	}
 
 	// call the before-delegation-modified hook
-	if err := k.Hooks().BeforeDelegationSharesModified(ctx, delAddr, valAddr); err != nil {
+	if err := k.beforeDelegationSharesModified(ctx, delAddr, valAddr, shares.Neg()); err != nil {
 		return amount, err
 	}
 
@@ -938,6 +944,10 @@ func (k Keeper) Undelegate(
 		return time.Time{}, math.Int{}, types.ErrMaxUnbondingDelegationEntries
 	}
 
+	if err := k.checkUnlockedShares(ctx, delAddr, valAddr, sharesAmount); err != nil {
+		return time.Time{}, math.Int{}, err
+	}
+
 	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, sharesAmount)
 	if err != nil {
 		return time.Time{}, math.Int{}, err
@@ -995,6 +1005,7 @@ func (k Keeper) CompleteUnbonding(ctx context.Context, delAddr sdk.AccAddress, v
 	}
 
 	// loop through all the entries and complete unbonding mature entries
+	completedAmount := math.ZeroInt()
 	for i := 0; i < len(ubd.Entries); i++ {
 		entry := ubd.Entries[i]
 		if entry.IsMature(ctxTime) && !entry.OnHold() {
@@ -1014,6 +1025,7 @@ func (k Keeper) CompleteUnbonding(ctx context.Context, delAddr sdk.AccAddress, v
 				}
 
 				balances = balances.Add(amt)
+				completedAmount = completedAmount.Add(entry.Balance)
 			}
 		}
 	}
@@ -1029,6 +1041,10 @@ func (k Keeper) CompleteUnbonding(ctx context.Context, delAddr sdk.AccAddress, v
 		return nil, err
 	}
 
+	if err := k.afterUnbondingAmountChanged(ctx, delAddr, valAddr, completedAmount.Neg()); err != nil {
+		return nil, err
+	}
+
 	return balances, nil
 }
 
@@ -1055,23 +1071,36 @@ func (k Keeper) BeginRedelegation(
 		return time.Time{}, err
 	}
 
-	// check if this is a transitive redelegation
-	hasRecRedel, err := k.HasReceivingRedelegation(ctx, delAddr, valSrcAddr)
+	// the redelegation restrictions are lifted during the validator consolidations
+	// approved by governance
+	exempt, err := k.IsRedelegationExempt(ctx, valSrcAddr, valDstAddr)
 	if err != nil {
 		return time.Time{}, err
 	}
 
-	if hasRecRedel {
-		return time.Time{}, types.ErrTransitiveRedelegation
-	}
+	if !exempt {
+		// check if this is a transitive redelegation
+		hasRecRedel, err := k.HasReceivingRedelegation(ctx, delAddr, valSrcAddr)
+		if err != nil {
+			return time.Time{}, err
+		}
 
-	hasMaxRedels, err := k.HasMaxRedelegationEntries(ctx, delAddr, valSrcAddr, valDstAddr)
-	if err != nil {
-		return time.Time{}, err
+		if hasRecRedel {
+			return time.Time{}, types.ErrTransitiveRedelegation
+		}
+
+		hasMaxRedels, err := k.HasMaxRedelegationEntries(ctx, delAddr, valSrcAddr, valDstAddr)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		if hasMaxRedels {
+			return time.Time{}, types.ErrMaxRedelegationEntries
+		}
 	}
 
-	if hasMaxRedels {
-		return time.Time{}, types.ErrMaxRedelegationEntries
+	if err := k.checkUnlockedShares(ctx, delAddr, valSrcAddr, sharesAmount); err != nil {
+		return time.Time{}, err
 	}
 
 	returnAmount, err := k.Unbond(ctx, delAddr, valSrcAddr, sharesAmount)
@@ -0,0 +1,326 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TokenizeDelegation tokenizes delegation shares into a transferable delegation
+// position owned by the delegator. The shares stay in the delegation but cannot
+// be undelegated or redelegated until the position is redeemed.
+func (k Keeper) TokenizeDelegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec) (uint64, error) {
+	if !shares.IsPositive() {
+		return 0, errorsmod.Wrap(types.ErrNotEnoughDelegationShares, "shares must be positive")
+	}
+
+	// self-delegations back the validator's minimum self-delegation and cannot be tokenized
+	if bytes.Equal(delAddr, valAddr) {
+		return 0, types.ErrSelfDelegationPosition
+	}
+
+	delegation, err := k.Delegations.Get(ctx, collections.Join(delAddr, valAddr))
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, types.ErrNoDelegation
+	} else if err != nil {
+		return 0, err
+	}
+
+	locked, err := k.GetLockedShares(ctx, delAddr, valAddr)
+	if err != nil {
+		return 0, err
+	}
+
+	if delegation.Shares.Sub(locked).LT(shares) {
+		return 0, errorsmod.Wrapf(types.ErrNotEnoughDelegationShares, "%s shares are not locked in delegation positions", delegation.Shares.Sub(locked))
+	}
+
+	id, err := k.DelegationPositionSequence.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	position := types.DelegationPosition{
+		Id:               id,
+		Owner:            delegation.DelegatorAddress,
+		ValidatorAddress: delegation.ValidatorAddress,
+		Shares:           shares,
+	}
+
+	return id, k.SetDelegationPosition(ctx, position)
+}
+
+// TransferDelegationPosition transfers a delegation position to a new owner,
+// moving its shares from the delegation of the current owner to the delegation
+// of the new owner. The delegation hooks are called for both delegations so that
+// rewards accrue to the current owner of the position.
+func (k Keeper) TransferDelegationPosition(ctx context.Context, owner sdk.AccAddress, positionID uint64, recipient sdk.AccAddress) error {
+	position, err := k.getOwnedDelegationPosition(ctx, owner, positionID)
+	if err != nil {
+		return err
+	}
+
+	if bytes.Equal(owner, recipient) {
+		return errorsmod.Wrap(types.ErrInvalidDelegationPosition, "recipient is already the owner of the position")
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(position.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	if err := k.transferDelegationShares(ctx, owner, recipient, valAddr, position.Shares); err != nil {
+		return err
+	}
+
+	if err := k.DelegationPositionsByOwner.Remove(ctx, collections.Join(owner, positionID)); err != nil {
+		return err
+	}
+
+	position.Owner, err = k.authKeeper.AddressCodec().BytesToString(recipient)
+	if err != nil {
+		return err
+	}
+
+	return k.SetDelegationPosition(ctx, position)
+}
+
+// RedeemDelegationPosition removes a delegation position, unlocking its shares
+// in the delegation of its owner.
+func (k Keeper) RedeemDelegationPosition(ctx context.Context, owner sdk.AccAddress, positionID uint64) (types.DelegationPosition, error) {
+	position, err := k.getOwnedDelegationPosition(ctx, owner, positionID)
+	if err != nil {
+		return types.DelegationPosition{}, err
+	}
+
+	return position, k.RemoveDelegationPosition(ctx, position)
+}
+
+// SetDelegationPosition sets a delegation position and indexes it by owner.
+func (k Keeper) SetDelegationPosition(ctx context.Context, position types.DelegationPosition) error {
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(position.Owner)
+	if err != nil {
+		return err
+	}
+
+	if err := k.DelegationPositions.Set(ctx, position.Id, position); err != nil {
+		return err
+	}
+
+	return k.DelegationPositionsByOwner.Set(ctx, collections.Join(sdk.AccAddress(owner), position.Id))
+}
+
+// RemoveDelegationPosition removes a delegation position and its owner index.
+func (k Keeper) RemoveDelegationPosition(ctx context.Context, position types.DelegationPosition) error {
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(position.Owner)
+	if err != nil {
+		return err
+	}
+
+	if err := k.DelegationPositions.Remove(ctx, position.Id); err != nil {
+		return err
+	}
+
+	return k.DelegationPositionsByOwner.Remove(ctx, collections.Join(sdk.AccAddress(owner), position.Id))
+}
+
+// GetOwnerDelegationPositions returns all the delegation positions of an owner.
+func (k Keeper) GetOwnerDelegationPositions(ctx context.Context, owner sdk.AccAddress) ([]types.DelegationPosition, error) {
+	var positions []types.DelegationPosition
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, uint64](owner)
+	err := k.DelegationPositionsByOwner.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, uint64]) (stop bool, err error) {
+		position, err := k.DelegationPositions.Get(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+
+		positions = append(positions, position)
+		return false, nil
+	})
+
+	return positions, err
+}
+
+// GetLockedShares returns the shares of a delegation locked in delegation positions.
+func (k Keeper) GetLockedShares(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (math.LegacyDec, error) {
+	positions, err := k.GetOwnerDelegationPositions(ctx, delAddr)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	locked := math.LegacyZeroDec()
+	for _, position := range positions {
+		posValAddr, err := k.validatorAddressCodec.StringToBytes(position.ValidatorAddress)
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+
+		if bytes.Equal(posValAddr, valAddr) {
+			locked = locked.Add(position.Shares)
+		}
+	}
+
+	return locked, nil
+}
+
+// reduceDelegationPositions reduces the shares of the delegation positions of a
+// delegation, most recent first, so that they do not exceed the shares of the
+// delegation. Slashing a redelegation can unbond shares locked in delegation
+// positions of the destination delegation.
+func (k Keeper) reduceDelegationPositions(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	shares := math.LegacyZeroDec()
+	delegation, err := k.Delegations.Get(ctx, collections.Join(delAddr, valAddr))
+	if err == nil {
+		shares = delegation.Shares
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	locked, err := k.GetLockedShares(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	excess := locked.Sub(shares)
+	if !excess.IsPositive() {
+		return nil
+	}
+
+	positions, err := k.GetOwnerDelegationPositions(ctx, delAddr)
+	if err != nil {
+		return err
+	}
+
+	for i := len(positions) - 1; i >= 0 && excess.IsPositive(); i-- {
+		position := positions[i]
+		posValAddr, err := k.validatorAddressCodec.StringToBytes(position.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(posValAddr, valAddr) {
+			continue
+		}
+
+		if position.Shares.LTE(excess) {
+			excess = excess.Sub(position.Shares)
+			if err := k.RemoveDelegationPosition(ctx, position); err != nil {
+				return err
+			}
+			continue
+		}
+
+		position.Shares = position.Shares.Sub(excess)
+		excess = math.LegacyZeroDec()
+		if err := k.DelegationPositions.Set(ctx, position.Id, position); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkUnlockedShares returns an error if removing the given shares from a
+// delegation would remove shares locked in delegation positions.
+func (k Keeper) checkUnlockedShares(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	delegation, err := k.Delegations.Get(ctx, collections.Join(delAddr, valAddr))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ErrNoDelegatorForAddress
+	} else if err != nil {
+		return err
+	}
+
+	locked, err := k.GetLockedShares(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if delegation.Shares.Sub(shares).LT(locked) {
+		return errorsmod.Wrapf(types.ErrDelegationSharesLocked, "%s shares are locked in delegation positions", locked)
+	}
+
+	return nil
+}
+
+func (k Keeper) getOwnedDelegationPosition(ctx context.Context, owner sdk.AccAddress, positionID uint64) (types.DelegationPosition, error) {
+	position, err := k.DelegationPositions.Get(ctx, positionID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DelegationPosition{}, errorsmod.Wrapf(types.ErrInvalidDelegationPosition, "no delegation position found for id %d", positionID)
+	} else if err != nil {
+		return types.DelegationPosition{}, err
+	}
+
+	isOwner, err := k.DelegationPositionsByOwner.Has(ctx, collections.Join(owner, positionID))
+	if err != nil {
+		return types.DelegationPosition{}, err
+	}
+
+	if !isOwner {
+		return types.DelegationPosition{}, errorsmod.Wrapf(types.ErrInvalidDelegationPosition, "delegation position %d is owned by %s", positionID, position.Owner)
+	}
+
+	return position, nil
+}
+
+// transferDelegationShares moves delegation shares from one delegator to another,
+// without moving the underlying tokens of the validator.
+func (k Keeper) transferDelegationShares(ctx context.Context, from, to sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	fromDelegation, err := k.Delegations.Get(ctx, collections.Join(from, valAddr))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ErrNoDelegatorForAddress
+	} else if err != nil {
+		return err
+	}
+
+	if fromDelegation.Shares.LT(shares) {
+		return errorsmod.Wrap(types.ErrNotEnoughDelegationShares, fromDelegation.Shares.String())
+	}
+
+	if err := k.beforeDelegationSharesModified(ctx, from, valAddr, shares.Neg()); err != nil {
+		return err
+	}
+
+	fromDelegation.Shares = fromDelegation.Shares.Sub(shares)
+	if fromDelegation.Shares.IsZero() {
+		if err := k.RemoveDelegation(ctx, fromDelegation); err != nil {
+			return err
+		}
+	} else {
+		if err := k.SetDelegation(ctx, fromDelegation); err != nil {
+			return err
+		}
+
+		if err := k.Hooks().AfterDelegationModified(ctx, from, valAddr); err != nil {
+			return err
+		}
+	}
+
+	toDelegation, err := k.Delegations.Get(ctx, collections.Join(to, valAddr))
+	if err == nil {
+		err = k.beforeDelegationSharesModified(ctx, to, valAddr, shares)
+	} else if errors.Is(err, collections.ErrNotFound) {
+		toAddrStr, err1 := k.authKeeper.AddressCodec().BytesToString(to)
+		if err1 != nil {
+			return err1
+		}
+
+		toDelegation = types.NewDelegation(toAddrStr, fromDelegation.ValidatorAddress, math.LegacyZeroDec())
+		err = k.Hooks().BeforeDelegationCreated(ctx, to, valAddr)
+	}
+	if err != nil {
+		return err
+	}
+
+	toDelegation.Shares = toDelegation.Shares.Add(shares)
+	if err := k.SetDelegation(ctx, toDelegation); err != nil {
+		return err
+	}
+
+	return k.Hooks().AfterDelegationModified(ctx, to, valAddr)
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FlashUndelegate undelegates shares without waiting for the unbonding period.
+// The FlashUndelegationPenalty of the undelegated tokens is sent to the
+// community pool and the rest paid to the delegator. At most
+// MaxFlashUndelegationRate of the tokens of a validator can be flash
+// undelegated per block, and the delegations to jailed validators, which may
+// still be slashed for their infractions, cannot be flash undelegated. It
+// returns the amounts paid to the delegator and to the community pool.
+func (k Keeper) FlashUndelegate(
+	ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount math.LegacyDec,
+) (payout, penalty math.Int, err error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.Int{}, math.Int{}, err
+	}
+
+	maxRate := params.MaxFlashUndelegationRate
+	if k.communityPoolFunder == nil || maxRate.IsNil() || !maxRate.IsPositive() {
+		return math.Int{}, math.Int{}, types.ErrFlashUndelegationDisabled
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return math.Int{}, math.Int{}, err
+	}
+
+	if validator.IsJailed() {
+		return math.Int{}, math.Int{}, types.ErrValidatorJailed
+	}
+
+	if err := k.checkUnlockedShares(ctx, delAddr, valAddr, sharesAmount); err != nil {
+		return math.Int{}, math.Int{}, err
+	}
+
+	undelegated, err := k.FlashUndelegatedAmounts.Get(ctx, valAddr)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return math.Int{}, math.Int{}, err
+		}
+		undelegated = math.ZeroInt()
+	}
+
+	// the quota is computed on the tokens of the validator at the start of the block
+	quota := maxRate.MulInt(validator.Tokens.Add(undelegated)).TruncateInt()
+	tokens := validator.TokensFromShares(sharesAmount).TruncateInt()
+	if undelegated.Add(tokens).GT(quota) {
+		return math.Int{}, math.Int{}, types.ErrFlashUndelegationQuotaExceeded.Wrapf("remaining quota: %s", quota.Sub(undelegated))
+	}
+
+	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, sharesAmount)
+	if err != nil {
+		return math.Int{}, math.Int{}, err
+	}
+
+	if err := k.FlashUndelegatedAmounts.Set(ctx, valAddr, undelegated.Add(returnAmount)); err != nil {
+		return math.Int{}, math.Int{}, err
+	}
+
+	// transfer the validator tokens to the not bonded pool, from which they are paid
+	if validator.IsBonded() {
+		if err := k.bondedTokensToNotBonded(ctx, returnAmount); err != nil {
+			return math.Int{}, math.Int{}, err
+		}
+	}
+
+	penaltyRate := params.FlashUndelegationPenalty
+	if penaltyRate.IsNil() {
+		penaltyRate = math.LegacyZeroDec()
+	}
+
+	// the penalty is rounded up so that it cannot be avoided by splitting the undelegation
+	penalty = math.MinInt(penaltyRate.MulInt(returnAmount).Ceil().TruncateInt(), returnAmount)
+	payout = returnAmount.Sub(penalty)
+
+	if penalty.IsPositive() {
+		penaltyCoins := sdk.NewCoins(sdk.NewCoin(params.BondDenom, penalty))
+		if err := k.communityPoolFunder.FundCommunityPool(ctx, penaltyCoins, k.authKeeper.GetModuleAddress(types.NotBondedPoolName)); err != nil {
+			return math.Int{}, math.Int{}, err
+		}
+	}
+
+	if payout.IsPositive() {
+		payoutCoins := sdk.NewCoins(sdk.NewCoin(params.BondDenom, payout))
+		if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, payoutCoins); err != nil {
+			return math.Int{}, math.Int{}, err
+		}
+	}
+
+	return payout, penalty, nil
+}
@@ -0,0 +1,221 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ForceUndelegate undelegates tokens of a delegation on behalf of the forced
+// undelegation authority, e.g. to comply with a regulatory order, and freezes
+// the delegation when freeze is set. A nil amount undelegates all the shares of
+// the delegation not locked in delegation positions. The tokens go through the
+// unbonding period as for a regular undelegation. Forced undelegations are
+// disabled unless the ForcedUndelegationEnabled param is set.
+func (k Keeper) ForceUndelegate(
+	ctx context.Context, authority string, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount *sdk.Coin, freeze bool, reason string,
+) (time.Time, sdk.Coin, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	if !params.ForcedUndelegationEnabled {
+		return time.Time{}, sdk.Coin{}, types.ErrForcedUndelegationDisabled
+	}
+
+	if err := k.checkForcedUndelegationAuthority(params, authority); err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	delegation, err := k.Delegations.Get(ctx, collections.Join(delAddr, valAddr))
+	if errors.Is(err, collections.ErrNotFound) {
+		return time.Time{}, sdk.Coin{}, types.ErrNoDelegation
+	} else if err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	shares := delegation.Shares
+	if amount != nil {
+		if amount.Denom != params.BondDenom {
+			return time.Time{}, sdk.Coin{}, errorsmod.Wrapf(
+				sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", amount.Denom, params.BondDenom,
+			)
+		}
+
+		shares, err = k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount.Amount)
+		if err != nil {
+			return time.Time{}, sdk.Coin{}, err
+		}
+	} else {
+		// the shares locked in delegation positions belong to their holders
+		locked, err := k.GetLockedShares(ctx, delAddr, valAddr)
+		if err != nil {
+			return time.Time{}, sdk.Coin{}, err
+		}
+
+		shares = shares.Sub(locked)
+		if !shares.IsPositive() {
+			return time.Time{}, sdk.Coin{}, errorsmod.Wrap(types.ErrNotEnoughDelegationShares, "all the shares are locked in delegation positions")
+		}
+	}
+
+	completionTime, returnAmount, err := k.Undelegate(ctx, delAddr, valAddr, shares)
+	if err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	undelegated := sdk.NewCoin(params.BondDenom, returnAmount)
+
+	delegator, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	validator, err := k.validatorAddressCodec.BytesToString(valAddr)
+	if err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeForceUndelegate,
+		event.NewAttribute(types.AttributeKeyAuthority, authority),
+		event.NewAttribute(types.AttributeKeyDelegator, delegator),
+		event.NewAttribute(types.AttributeKeyValidator, validator),
+		event.NewAttribute(sdk.AttributeKeyAmount, undelegated.String()),
+		event.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		event.NewAttribute(types.AttributeKeyReason, reason),
+	); err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	if freeze {
+		if err := k.FrozenDelegations.Set(ctx, collections.Join(delAddr, valAddr)); err != nil {
+			return time.Time{}, sdk.Coin{}, err
+		}
+
+		if err := k.environment.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeFreezeDelegation,
+			event.NewAttribute(types.AttributeKeyAuthority, authority),
+			event.NewAttribute(types.AttributeKeyDelegator, delegator),
+			event.NewAttribute(types.AttributeKeyValidator, validator),
+			event.NewAttribute(types.AttributeKeyReason, reason),
+		); err != nil {
+			return time.Time{}, sdk.Coin{}, err
+		}
+	}
+
+	return completionTime, undelegated, nil
+}
+
+// UnfreezeDelegation unfreezes a delegation frozen by a forced undelegation.
+// Delegations can be unfrozen by the forced undelegation authority even when
+// forced undelegations have since been disabled.
+func (k Keeper) UnfreezeDelegation(ctx context.Context, authority string, delAddr sdk.AccAddress, valAddr sdk.ValAddress, reason string) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := k.checkForcedUndelegationAuthority(params, authority); err != nil {
+		return err
+	}
+
+	key := collections.Join(delAddr, valAddr)
+	frozen, err := k.FrozenDelegations.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+	if !frozen {
+		return types.ErrDelegationNotFrozen
+	}
+
+	if err := k.FrozenDelegations.Remove(ctx, key); err != nil {
+		return err
+	}
+
+	delegator, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return err
+	}
+
+	validator, err := k.validatorAddressCodec.BytesToString(valAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeUnfreezeDelegation,
+		event.NewAttribute(types.AttributeKeyAuthority, authority),
+		event.NewAttribute(types.AttributeKeyDelegator, delegator),
+		event.NewAttribute(types.AttributeKeyValidator, validator),
+		event.NewAttribute(types.AttributeKeyReason, reason),
+	)
+}
+
+// IsDelegationFrozen returns true if the delegation of a delegator to a
+// validator is frozen.
+func (k Keeper) IsDelegationFrozen(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (bool, error) {
+	return k.FrozenDelegations.Has(ctx, collections.Join(delAddr, valAddr))
+}
+
+// GetFrozenDelegations returns the delegator-validator pairs of all the frozen
+// delegations.
+func (k Keeper) GetFrozenDelegations(ctx context.Context) ([]types.DVPair, error) {
+	var pairs []types.DVPair
+	err := k.FrozenDelegations.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress]) (bool, error) {
+		delegator, err := k.authKeeper.AddressCodec().BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+
+		validator, err := k.validatorAddressCodec.BytesToString(key.K2())
+		if err != nil {
+			return true, err
+		}
+
+		pairs = append(pairs, types.DVPair{DelegatorAddress: delegator, ValidatorAddress: validator})
+		return false, nil
+	})
+
+	return pairs, err
+}
+
+// checkDelegationNotFrozen returns ErrDelegationFrozen if the delegation of a
+// delegator to a validator is frozen. Frozen delegations cannot be increased,
+// undelegated, redelegated or tokenized by their delegator.
+func (k Keeper) checkDelegationNotFrozen(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	frozen, err := k.IsDelegationFrozen(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if frozen {
+		return types.ErrDelegationFrozen
+	}
+
+	return nil
+}
+
+// checkForcedUndelegationAuthority checks that the signer is the forced
+// undelegation authority, the module authority unless set in the params.
+func (k Keeper) checkForcedUndelegationAuthority(params types.Params, authority string) error {
+	expected := params.ForcedUndelegationAuthority
+	if expected == "" {
+		expected = k.authority
+	}
+
+	if expected != authority {
+		return errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expected, authority)
+	}
+
+	return nil
+}
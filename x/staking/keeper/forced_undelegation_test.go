@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestForcedUndelegation() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	comm := types.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	msg, err := types.NewMsgCreateValidator(ValAddr.String(), PKS[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000_000), types.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	delAddr := sdk.AccAddress(PKS[1].Address())
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), delAddr, types.NotBondedPoolName, gomock.Any()).AnyTimes()
+	_, err = msgServer.Delegate(ctx, types.NewMsgDelegate(delAddr.String(), ValAddr.String(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000_000)))
+	require.NoError(err)
+
+	compliance := sdk.AccAddress(PKS[2].Address()).String()
+	amount := sdk.NewInt64Coin(sdk.DefaultBondDenom, 4_000_000)
+
+	// forced undelegations are disabled by default
+	_, err = msgServer.ForceUndelegate(ctx, types.NewMsgForceUndelegate(keeper.GetAuthority(), delAddr.String(), ValAddr.String(), &amount, true, "court order"))
+	require.ErrorIs(err, types.ErrForcedUndelegationDisabled)
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.ForcedUndelegationEnabled = true
+	params.ForcedUndelegationAuthority = compliance
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: keeper.GetAuthority(), Params: params})
+	require.NoError(err)
+
+	// only the designated authority can force undelegations
+	_, err = msgServer.ForceUndelegate(ctx, types.NewMsgForceUndelegate(keeper.GetAuthority(), delAddr.String(), ValAddr.String(), &amount, true, "court order"))
+	require.ErrorIs(err, types.ErrInvalidSigner)
+
+	res, err := msgServer.ForceUndelegate(ctx, types.NewMsgForceUndelegate(compliance, delAddr.String(), ValAddr.String(), &amount, true, "court order"))
+	require.NoError(err)
+	require.Equal(amount, res.Amount)
+	requireEventType(s, ctx, types.EventTypeForceUndelegate)
+	requireEventType(s, ctx, types.EventTypeFreezeDelegation)
+
+	ubd, err := keeper.GetUnbondingDelegation(ctx, delAddr, ValAddr)
+	require.NoError(err)
+	require.Len(ubd.Entries, 1)
+	require.Equal(res.CompletionTime, ubd.Entries[0].CompletionTime)
+
+	frozen, err := keeper.IsDelegationFrozen(ctx, delAddr, ValAddr)
+	require.NoError(err)
+	require.True(frozen)
+
+	// the delegator cannot change a frozen delegation
+	coin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)
+	_, err = msgServer.Delegate(ctx, types.NewMsgDelegate(delAddr.String(), ValAddr.String(), coin))
+	require.ErrorIs(err, types.ErrDelegationFrozen)
+	_, err = msgServer.Undelegate(ctx, types.NewMsgUndelegate(delAddr.String(), ValAddr.String(), coin))
+	require.ErrorIs(err, types.ErrDelegationFrozen)
+	_, err = msgServer.CancelUnbondingDelegation(ctx, types.NewMsgCancelUnbondingDelegation(delAddr.String(), ValAddr.String(), ctx.HeaderInfo().Height, coin))
+	require.ErrorIs(err, types.ErrDelegationFrozen)
+
+	pairs, err := keeper.GetFrozenDelegations(ctx)
+	require.NoError(err)
+	require.Equal([]types.DVPair{{DelegatorAddress: delAddr.String(), ValidatorAddress: ValAddr.String()}}, pairs)
+
+	_, err = msgServer.UnfreezeDelegation(ctx, types.NewMsgUnfreezeDelegation(keeper.GetAuthority(), delAddr.String(), ValAddr.String(), "order lifted"))
+	require.ErrorIs(err, types.ErrInvalidSigner)
+	_, err = msgServer.UnfreezeDelegation(ctx, types.NewMsgUnfreezeDelegation(compliance, delAddr.String(), ValAddr.String(), "order lifted"))
+	require.NoError(err)
+	requireEventType(s, ctx, types.EventTypeUnfreezeDelegation)
+	_, err = msgServer.UnfreezeDelegation(ctx, types.NewMsgUnfreezeDelegation(compliance, delAddr.String(), ValAddr.String(), "order lifted"))
+	require.ErrorIs(err, types.ErrDelegationNotFrozen)
+
+	_, err = msgServer.Delegate(ctx, types.NewMsgDelegate(delAddr.String(), ValAddr.String(), coin))
+	require.NoError(err)
+
+	// without an amount, the whole delegation is undelegated
+	res, err = msgServer.ForceUndelegate(ctx, types.NewMsgForceUndelegate(compliance, delAddr.String(), ValAddr.String(), nil, false, "court order"))
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 7_000_000), res.Amount)
+
+	_, err = keeper.Delegation(ctx, delAddr, ValAddr)
+	require.Error(err)
+	frozen, err = keeper.IsDelegationFrozen(ctx, delAddr, ValAddr)
+	require.NoError(err)
+	require.False(frozen)
+}
+
+func requireEventType(s *KeeperTestSuite, ctx sdk.Context, eventType string) {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			return
+		}
+	}
+	s.Require().Failf("event not emitted", "missing event %s", eventType)
+}
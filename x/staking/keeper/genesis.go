@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
@@ -138,6 +140,167 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) (res
 		}
 	}
 
+	var nextPositionID uint64
+	for _, position := range data.DelegationPositions {
+		if err := k.SetDelegationPosition(ctx, position); err != nil {
+			panic(err)
+		}
+
+		if position.Id >= nextPositionID {
+			nextPositionID = position.Id + 1
+		}
+	}
+
+	if err := k.DelegationPositionSequence.Set(ctx, nextPositionID); err != nil {
+		panic(err)
+	}
+
+	var nextRecordID uint64
+	for _, record := range data.TokenizeShareRecords {
+		if err := k.SetTokenizeShareRecord(ctx, record); err != nil {
+			panic(err)
+		}
+
+		if record.Id >= nextRecordID {
+			nextRecordID = record.Id + 1
+		}
+
+		// the liquid shares of each validator are the shares delegated by the records
+		valAddr, err := k.validatorAddressCodec.StringToBytes(record.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		delegation, err := k.Delegations.Get(ctx, collections.Join(sdk.AccAddress(record.GetModuleAddress()), sdk.ValAddress(valAddr)))
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			panic(err)
+		}
+
+		if err := k.addValidatorLiquidShares(ctx, valAddr, delegation.Shares.TruncateInt()); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := k.TokenizeShareRecordSequence.Set(ctx, nextRecordID); err != nil {
+		panic(err)
+	}
+
+	for _, setting := range data.AutoCompoundSettings {
+		delAddr, err := k.authKeeper.AddressCodec().StringToBytes(setting.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		valAddr, err := k.validatorAddressCodec.StringToBytes(setting.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.AutoCompoundSettings.Set(ctx, collections.Join(sdk.AccAddress(delAddr), sdk.ValAddress(valAddr))); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, change := range data.ScheduledCommissionChanges {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(change.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.SetScheduledCommissionChange(ctx, valAddr, change); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, bondable := range data.BondableDenoms {
+		if err := k.BondableDenoms.Set(ctx, bondable.Denom, bondable); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, pool := range data.MultiAssetPools {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(pool.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.setMultiAssetPool(ctx, valAddr, pool); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, delegation := range data.MultiAssetDelegations {
+		delAddr, err := k.authKeeper.AddressCodec().StringToBytes(delegation.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		valAddr, err := k.validatorAddressCodec.StringToBytes(delegation.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		key := collections.Join3(sdk.AccAddress(delAddr), sdk.ValAddress(valAddr), delegation.Denom)
+		if err := k.MultiAssetDelegations.Set(ctx, key, delegation); err != nil {
+			panic(err)
+		}
+	}
+
+	var nextUnbondingID uint64
+	for _, unbonding := range data.MultiAssetUnbondings {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(unbonding.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.setMultiAssetUnbonding(ctx, valAddr, unbonding); err != nil {
+			panic(err)
+		}
+
+		if unbonding.Id >= nextUnbondingID {
+			nextUnbondingID = unbonding.Id + 1
+		}
+	}
+
+	if err := k.MultiAssetUnbondingSequence.Set(ctx, nextUnbondingID); err != nil {
+		panic(err)
+	}
+
+	for _, change := range data.MaxValidatorsSchedule {
+		if err := k.MaxValidatorsSchedule.Set(ctx, change.Height, change); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, exemption := range data.RedelegationExemptions {
+		valSrcAddr, err := k.validatorAddressCodec.StringToBytes(exemption.ValidatorSrcAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.RedelegationExemptions.Set(ctx, valSrcAddr, exemption); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, pair := range data.FrozenDelegations {
+		delAddr, err := k.authKeeper.AddressCodec().StringToBytes(pair.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		valAddr, err := k.validatorAddressCodec.StringToBytes(pair.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.FrozenDelegations.Set(ctx, collections.Join(sdk.AccAddress(delAddr), sdk.ValAddress(valAddr))); err != nil {
+			panic(err)
+		}
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -237,6 +400,111 @@ func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	var delegationPositions []types.DelegationPosition
+
+	err = k.DelegationPositions.Walk(ctx, nil, func(_ uint64, position types.DelegationPosition) (stop bool, err error) {
+		delegationPositions = append(delegationPositions, position)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var tokenizeShareRecords []types.TokenizeShareRecord
+
+	err = k.TokenizeShareRecords.Walk(ctx, nil, func(_ uint64, record types.TokenizeShareRecord) (stop bool, err error) {
+		tokenizeShareRecords = append(tokenizeShareRecords, record)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var autoCompoundSettings []types.AutoCompoundSetting
+
+	err = k.AutoCompoundSettings.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress]) (stop bool, err error) {
+		delAddr, err := k.authKeeper.AddressCodec().BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+
+		valAddr, err := k.validatorAddressCodec.BytesToString(key.K2())
+		if err != nil {
+			return true, err
+		}
+
+		autoCompoundSettings = append(autoCompoundSettings, types.AutoCompoundSetting{DelegatorAddress: delAddr, ValidatorAddress: valAddr})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var scheduledCommissionChanges []types.ScheduledCommissionChange
+
+	err = k.ScheduledCommissionChanges.Walk(ctx, nil, func(_ sdk.ValAddress, change types.ScheduledCommissionChange) (stop bool, err error) {
+		scheduledCommissionChanges = append(scheduledCommissionChanges, change)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var bondableDenoms []types.BondableDenom
+
+	err = k.BondableDenoms.Walk(ctx, nil, func(_ string, bondable types.BondableDenom) (stop bool, err error) {
+		bondableDenoms = append(bondableDenoms, bondable)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var multiAssetPools []types.MultiAssetPool
+
+	err = k.MultiAssetPools.Walk(ctx, nil, func(_ collections.Pair[sdk.ValAddress, string], pool types.MultiAssetPool) (stop bool, err error) {
+		multiAssetPools = append(multiAssetPools, pool)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var multiAssetDelegations []types.MultiAssetDelegation
+
+	err = k.MultiAssetDelegations.Walk(ctx, nil, func(_ collections.Triple[sdk.AccAddress, sdk.ValAddress, string], delegation types.MultiAssetDelegation) (stop bool, err error) {
+		multiAssetDelegations = append(multiAssetDelegations, delegation)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var multiAssetUnbondings []types.MultiAssetUnbonding
+
+	err = k.MultiAssetUnbondingQueue.Walk(ctx, nil, func(_ collections.Pair[time.Time, uint64], unbonding types.MultiAssetUnbonding) (stop bool, err error) {
+		multiAssetUnbondings = append(multiAssetUnbondings, unbonding)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	maxValidatorsSchedule, err := k.GetMaxValidatorsSchedule(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	redelegationExemptions, err := k.GetRedelegationExemptions(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	frozenDelegations, err := k.GetFrozenDelegations(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	var lastValidatorPowers []types.LastValidatorPower
 
 	err = k.IterateLastValidatorPowers(ctx, func(addr sdk.ValAddress, power int64) (stop bool) {
@@ -280,5 +548,17 @@ func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		UnbondingDelegations: unbondingDelegations,
 		Redelegations:        redelegations,
 		Exported:             true,
+		DelegationPositions:  delegationPositions,
+		TokenizeShareRecords: tokenizeShareRecords,
+		AutoCompoundSettings: autoCompoundSettings,
+
+		ScheduledCommissionChanges: scheduledCommissionChanges,
+		BondableDenoms:             bondableDenoms,
+		MultiAssetPools:            multiAssetPools,
+		MultiAssetDelegations:      multiAssetDelegations,
+		MultiAssetUnbondings:       multiAssetUnbondings,
+		MaxValidatorsSchedule:      maxValidatorsSchedule,
+		RedelegationExemptions:     redelegationExemptions,
+		FrozenDelegations:          frozenDelegations,
 	}
 }
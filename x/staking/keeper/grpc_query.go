@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/staking/types"
@@ -650,3 +652,475 @@ func redelegationsToRedelegationResponses(ctx context.Context, k *Keeper, redels
 
 	return resp, nil
 }
+
+// DelegationPosition queries a delegation position by id
+func (k Querier) DelegationPosition(ctx context.Context, req *types.QueryDelegationPositionRequest) (*types.QueryDelegationPositionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	position, err := k.DelegationPositions.Get(ctx, req.PositionId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "delegation position %d not found", req.PositionId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelegationPositionResponse{Position: position}, nil
+}
+
+// OwnerDelegationPositions queries all delegation positions of an owner
+func (k Querier) OwnerDelegationPositions(ctx context.Context, req *types.QueryOwnerDelegationPositionsRequest) (*types.QueryOwnerDelegationPositionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Owner == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner address cannot be empty")
+	}
+
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	positions, pageRes, err := query.CollectionPaginate(ctx, k.DelegationPositionsByOwner, req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (types.DelegationPosition, error) {
+			return k.DelegationPositions.Get(ctx, key.K2())
+		}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](owner),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOwnerDelegationPositionsResponse{Positions: positions, Pagination: pageRes}, nil
+}
+
+// TokenizeShareRecordById queries a tokenize share record by id
+func (k Querier) TokenizeShareRecordById(ctx context.Context, req *types.QueryTokenizeShareRecordByIdRequest) (*types.QueryTokenizeShareRecordByIdResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	record, err := k.TokenizeShareRecords.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "tokenize share record %d not found", req.Id)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTokenizeShareRecordByIdResponse{Record: record}, nil
+}
+
+// TokenizeShareRecordByDenom queries a tokenize share record by share token denom
+func (k Querier) TokenizeShareRecordByDenom(ctx context.Context, req *types.QueryTokenizeShareRecordByDenomRequest) (*types.QueryTokenizeShareRecordByDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "denom cannot be empty")
+	}
+
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, req.Denom)
+	if err != nil {
+		if errors.Is(err, types.ErrTokenizeShareRecordNotExists) {
+			return nil, status.Errorf(codes.NotFound, "tokenize share record for denom %s not found", req.Denom)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTokenizeShareRecordByDenomResponse{Record: record}, nil
+}
+
+// TokenizeShareRecordsOwned queries all tokenize share records of an owner
+func (k Querier) TokenizeShareRecordsOwned(ctx context.Context, req *types.QueryTokenizeShareRecordsOwnedRequest) (*types.QueryTokenizeShareRecordsOwnedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Owner == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner address cannot be empty")
+	}
+
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	records, pageRes, err := query.CollectionPaginate(ctx, k.TokenizeShareRecordsByOwner, req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (types.TokenizeShareRecord, error) {
+			return k.TokenizeShareRecords.Get(ctx, key.K2())
+		}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](owner),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTokenizeShareRecordsOwnedResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// AllTokenizeShareRecords queries all tokenize share records
+func (k Querier) AllTokenizeShareRecords(ctx context.Context, req *types.QueryAllTokenizeShareRecordsRequest) (*types.QueryAllTokenizeShareRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	records, pageRes, err := query.CollectionPaginate(ctx, k.TokenizeShareRecords, req.Pagination,
+		func(_ uint64, record types.TokenizeShareRecord) (types.TokenizeShareRecord, error) {
+			return record, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllTokenizeShareRecordsResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// TotalLiquidStaked queries the amount of tokens tokenized into share tokens,
+// and the tokenized shares of a validator if one is given
+func (k Querier) TotalLiquidStaked(ctx context.Context, req *types.QueryTotalLiquidStakedRequest) (*types.QueryTotalLiquidStakedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	tokens, err := k.GetTotalLiquidStakedTokens(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	validatorShares := math.LegacyZeroDec()
+	if req.ValidatorAddress != "" {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(req.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		shares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		validatorShares = math.LegacyNewDecFromInt(shares)
+	}
+
+	return &types.QueryTotalLiquidStakedResponse{Tokens: tokens, ValidatorShares: validatorShares}, nil
+}
+
+// DelegatorAutoCompoundSettings queries the auto-compounding delegations of a delegator
+func (k Querier) DelegatorAutoCompoundSettings(ctx context.Context, req *types.QueryDelegatorAutoCompoundSettingsRequest) (*types.QueryDelegatorAutoCompoundSettingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.DelegatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "delegator address cannot be empty")
+	}
+
+	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, pageRes, err := query.CollectionPaginate(ctx, k.AutoCompoundSettings, req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, sdk.ValAddress], _ collections.NoValue) (types.AutoCompoundSetting, error) {
+			valAddr, err := k.validatorAddressCodec.BytesToString(key.K2())
+			if err != nil {
+				return types.AutoCompoundSetting{}, err
+			}
+
+			return types.AutoCompoundSetting{DelegatorAddress: req.DelegatorAddr, ValidatorAddress: valAddr}, nil
+		}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, sdk.ValAddress](delAddr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelegatorAutoCompoundSettingsResponse{Settings: settings, Pagination: pageRes}, nil
+}
+
+// ScheduledCommissionChange queries the commission rate change scheduled by a validator
+func (k Querier) ScheduledCommissionChange(ctx context.Context, req *types.QueryScheduledCommissionChangeRequest) (*types.QueryScheduledCommissionChangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	change, err := k.ScheduledCommissionChanges.Get(ctx, valAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no scheduled commission change for validator %s", req.ValidatorAddr)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryScheduledCommissionChangeResponse{Change: change}, nil
+}
+
+// ValidatorPerformance queries the performance statistics of a validator
+func (k Querier) ValidatorPerformance(ctx context.Context, req *types.QueryValidatorPerformanceRequest) (*types.QueryValidatorPerformanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := k.GetValidator(ctx, valAddr); err != nil {
+		if errors.Is(err, types.ErrNoValidatorFound) {
+			return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	performance, err := k.GetValidatorPerformance(ctx, valAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorPerformanceResponse{Performance: performance}, nil
+}
+
+// UnbondingQueue queries the unbonding delegation entries maturing within a
+// time range
+func (k Querier) UnbondingQueue(ctx context.Context, req *types.QueryUnbondingQueueRequest) (*types.QueryUnbondingQueueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !req.EndTime.After(req.StartTime) {
+		return nil, status.Error(codes.InvalidArgument, "end time must be after start time")
+	}
+
+	entries, err := k.GetMaturingUnbondings(ctx, req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	dailyAmounts := queueDailyAmounts(entries, func(entry types.UnbondingQueueEntry) (time.Time, math.Int) {
+		return entry.CompletionTime, entry.Balance
+	})
+
+	entries, pageRes, err := paginateQueueEntries(entries, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryUnbondingQueueResponse{Entries: entries, DailyAmounts: dailyAmounts, Pagination: pageRes}, nil
+}
+
+// RedelegationQueue queries the redelegation entries maturing within a time
+// range
+func (k Querier) RedelegationQueue(ctx context.Context, req *types.QueryRedelegationQueueRequest) (*types.QueryRedelegationQueueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !req.EndTime.After(req.StartTime) {
+		return nil, status.Error(codes.InvalidArgument, "end time must be after start time")
+	}
+
+	entries, err := k.GetMaturingRedelegations(ctx, req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	dailyAmounts := queueDailyAmounts(entries, func(entry types.RedelegationQueueEntry) (time.Time, math.Int) {
+		return entry.CompletionTime, entry.InitialBalance
+	})
+
+	entries, pageRes, err := paginateQueueEntries(entries, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRedelegationQueueResponse{Entries: entries, DailyAmounts: dailyAmounts, Pagination: pageRes}, nil
+}
+
+// paginateQueueEntries applies an offset based pagination to queue entries.
+func paginateQueueEntries[T any](entries []T, pageReq *query.PageRequest) ([]T, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	if len(pageReq.Key) > 0 {
+		return nil, nil, status.Error(codes.InvalidArgument, "key based pagination is not supported, use offset")
+	}
+
+	if pageReq.Reverse {
+		reversed := make([]T, len(entries))
+		for i, entry := range entries {
+			reversed[len(entries)-1-i] = entry
+		}
+		entries = reversed
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	total := uint64(len(entries))
+	start := min(pageReq.Offset, total)
+	end := min(start+limit, total)
+
+	pageRes := &query.PageResponse{}
+	if pageReq.CountTotal {
+		pageRes.Total = total
+	}
+
+	return entries[start:end], pageRes, nil
+}
+
+// DenomBondedAmounts queries the amounts of tokens bonded per bondable denom
+func (k Querier) DenomBondedAmounts(ctx context.Context, req *types.QueryDenomBondedAmountsRequest) (*types.QueryDenomBondedAmountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	bonded, err := k.TotalBondedTokens(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	amounts := []types.DenomBondedAmount{{Denom: bondDenom, Weight: math.LegacyOneDec(), Amount: bonded}}
+	indexes := make(map[string]int)
+	err = k.BondableDenoms.Walk(ctx, nil, func(denom string, bondable types.BondableDenom) (bool, error) {
+		indexes[denom] = len(amounts)
+		amounts = append(amounts, types.DenomBondedAmount{Denom: denom, Weight: bondable.Weight, Amount: math.ZeroInt()})
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// the tokens of the bondable denoms count as bonded once delegated to a
+	// bonded validator, as the tokens of the bond denom
+	err = k.MultiAssetPools.Walk(ctx, nil, func(key collections.Pair[sdk.ValAddress, string], pool types.MultiAssetPool) (bool, error) {
+		i, ok := indexes[pool.Denom]
+		if !ok {
+			return false, nil
+		}
+
+		validator, err := k.GetValidator(ctx, key.K1())
+		if err != nil {
+			return true, err
+		}
+		if validator.IsBonded() {
+			amounts[i].Amount = amounts[i].Amount.Add(pool.Tokens)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomBondedAmountsResponse{Amounts: amounts}, nil
+}
+
+// ValidatorMultiAssetPools queries the tokens of the bondable denoms delegated to a validator
+func (k Querier) ValidatorMultiAssetPools(ctx context.Context, req *types.QueryValidatorMultiAssetPoolsRequest) (*types.QueryValidatorMultiAssetPoolsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	pools, err := k.GetValidatorMultiAssetPools(ctx, valAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorMultiAssetPoolsResponse{Pools: pools}, nil
+}
+
+// DelegatorMultiAssetDelegations queries the delegations of the bondable denoms of a delegator
+func (k Querier) DelegatorMultiAssetDelegations(ctx context.Context, req *types.QueryDelegatorMultiAssetDelegationsRequest) (*types.QueryDelegatorMultiAssetDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.DelegatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "delegator address cannot be empty")
+	}
+
+	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	delegations, pageRes, err := query.CollectionPaginate(ctx, k.MultiAssetDelegations, req.Pagination,
+		func(key collections.Triple[sdk.AccAddress, sdk.ValAddress, string], delegation types.MultiAssetDelegation) (types.MultiAssetDelegationResponse, error) {
+			pool, err := k.MultiAssetPools.Get(ctx, collections.Join(key.K2(), key.K3()))
+			if err != nil {
+				return types.MultiAssetDelegationResponse{}, err
+			}
+
+			balance := sdk.NewCoin(delegation.Denom, pool.TokensFromShares(delegation.Shares).TruncateInt())
+			return types.MultiAssetDelegationResponse{Delegation: delegation, Balance: balance}, nil
+		}, query.WithCollectionPaginationTriplePrefix[sdk.AccAddress, sdk.ValAddress, string](delAddr),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelegatorMultiAssetDelegationsResponse{Delegations: delegations, Pagination: pageRes}, nil
+}
+
+// MaxValidatorsSchedule queries the changes of the MaxValidators param scheduled at future heights
+func (k Querier) MaxValidatorsSchedule(ctx context.Context, req *types.QueryMaxValidatorsScheduleRequest) (*types.QueryMaxValidatorsScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	changes, err := k.GetMaxValidatorsSchedule(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMaxValidatorsScheduleResponse{Changes: changes}, nil
+}
+
+// RedelegationExemptions queries the redelegation exemptions approved by governance for validator consolidations
+func (k Querier) RedelegationExemptions(ctx context.Context, req *types.QueryRedelegationExemptionsRequest) (*types.QueryRedelegationExemptionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	exemptions, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.RedelegationExemptions, req.Pagination, func(_ sdk.ValAddress, exemption types.RedelegationExemption) (types.RedelegationExemption, error) {
+		return exemption, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRedelegationExemptionsResponse{Exemptions: exemptions, Pagination: pageRes}, nil
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// beforeDelegationSharesModified calls the BeforeDelegationSharesModified hook
+// and, if the hooks implement StakingAmountHooks, its variant carrying the
+// signed change of the delegation shares.
+func (k *Keeper) beforeDelegationSharesModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesDelta math.LegacyDec) error {
+	hooks := k.Hooks()
+	if err := hooks.BeforeDelegationSharesModified(ctx, delAddr, valAddr); err != nil {
+		return err
+	}
+
+	amountHooks, ok := hooks.(types.StakingAmountHooks)
+	if !ok {
+		return nil
+	}
+
+	return amountHooks.BeforeDelegationSharesModifiedWithDelta(ctx, delAddr, valAddr, sharesDelta)
+}
+
+// afterUnbondingAmountChanged calls the AfterUnbondingAmountChanged hook, if the
+// hooks implement StakingAmountHooks, with the signed change of the tokens
+// unbonding from a validator. It is a no-op if they didn't change.
+func (k *Keeper) afterUnbondingAmountChanged(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amountDelta math.Int) error {
+	if amountDelta.IsZero() {
+		return nil
+	}
+
+	amountHooks, ok := k.Hooks().(types.StakingAmountHooks)
+	if !ok {
+		return nil
+	}
+
+	return amountHooks.AfterUnbondingAmountChanged(ctx, delAddr, valAddr, amountDelta)
+}
@@ -1,9 +1,37 @@
 package keeper_test
 
 import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// mockAmountHooks records the deltas of the amount-carrying hooks, the other
+// hooks being no-ops.
+type mockAmountHooks struct {
+	types.MultiStakingHooks
+
+	sharesDeltas []math.LegacyDec
+	amountDeltas []math.Int
+}
+
+func (m *mockAmountHooks) BeforeDelegationSharesModifiedWithDelta(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress, sharesDelta math.LegacyDec) error {
+	m.sharesDeltas = append(m.sharesDeltas, sharesDelta)
+	return nil
+}
+
+func (m *mockAmountHooks) AfterUnbondingAmountChanged(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress, amountDelta math.Int) error {
+	m.amountDeltas = append(m.amountDeltas, amountDelta)
+	return nil
+}
+
 func (s *KeeperTestSuite) TestHookAfterConsensusPubKeyUpdate() {
 	stKeeper := s.stakingKeeper
 	ctx := s.ctx
@@ -13,3 +41,41 @@ func (s *KeeperTestSuite) TestHookAfterConsensusPubKeyUpdate() {
 	err := stKeeper.Hooks().AfterConsensusPubKeyUpdate(ctx, PKs[0], PKs[1], rotationFee)
 	require.NoError(err)
 }
+
+func (s *KeeperTestSuite) TestAmountHooks() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), gomock.Any(), types.NotBondedPoolName, gomock.Any()).AnyTimes()
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 10, Time: time.Now()})
+
+	// the amount hooks are called through the multi hooks
+	hooks := &mockAmountHooks{}
+	keeper.SetHooks(types.NewMultiStakingHooks(hooks))
+
+	comm := types.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	msg, err := types.NewMsgCreateValidator(ValAddr.String(), PKs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), types.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	// adds up to the self-delegation of the validator
+	_, err = keeper.PrecompileDelegate(ctx, Addr, ValAddr, math.NewInt(1000))
+	require.NoError(err)
+	require.Equal([]math.LegacyDec{math.LegacyNewDec(1000)}, hooks.sharesDeltas)
+
+	_, _, err = keeper.PrecompileUndelegate(ctx, Addr, ValAddr, math.NewInt(200))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(-200), hooks.sharesDeltas[1])
+	require.Equal([]math.Int{math.NewInt(200)}, hooks.amountDeltas)
+
+	_, err = msgServer.CancelUnbondingDelegation(ctx, &types.MsgCancelUnbondingDelegation{
+		DelegatorAddress: Addr.String(),
+		ValidatorAddress: ValAddr.String(),
+		Amount:           sdk.NewInt64Coin(sdk.DefaultBondDenom, 50),
+		CreationHeight:   10,
+	})
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(50), hooks.sharesDeltas[2])
+	require.Equal(math.NewInt(-50), hooks.amountDeltas[1])
+}
@@ -73,6 +73,10 @@ type Keeper struct {
 	authKeeper            types.AccountKeeper
 	bankKeeper            types.BankKeeper
 	hooks                 types.StakingHooks
+	performanceScorer     types.ValidatorPerformanceScorer
+	rewardsWithdrawer     types.DelegationRewardsWithdrawer
+	communityPoolFunder   types.CommunityPoolFunder
+	bondedTokenHooks      types.BondedTokenHooks
 	authority             string
 	validatorAddressCodec addresscodec.Codec
 	consensusAddressCodec addresscodec.Codec
@@ -129,6 +133,54 @@ type Keeper struct {
 	// ValidatorConsPubKeyRotationHistory: consPubkey rotation history by validator
 	// A index is being added with key `BlockConsPubKeyRotationHistory`: consPubkey rotation history by height
 	RotationHistory *collections.IndexedMap[collections.Pair[[]byte, uint64], types.ConsPubKeyRotationHistory, rotationHistoryIndexes]
+	// DelegationPositions key: positionID | value: DelegationPosition
+	DelegationPositions collections.Map[uint64, types.DelegationPosition]
+	// DelegationPositionSequence generates the delegation position ids
+	DelegationPositionSequence collections.Sequence
+	// DelegationPositionsByOwner key: owner+positionID | value: none used (index key for delegation positions by owner)
+	DelegationPositionsByOwner collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	// TokenizeShareRecords key: recordID | value: TokenizeShareRecord
+	TokenizeShareRecords collections.Map[uint64, types.TokenizeShareRecord]
+	// TokenizeShareRecordSequence generates the tokenize share record ids
+	TokenizeShareRecordSequence collections.Sequence
+	// TokenizeShareRecordsByOwner key: owner+recordID | value: none used (index key for tokenize share records by owner)
+	TokenizeShareRecordsByOwner collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	// TokenizeShareRecordsByDenom key: share token denom | value: recordID
+	TokenizeShareRecordsByDenom collections.Map[string, uint64]
+	// ValidatorLiquidShares key: valAddr | value: delegator shares of the validator tokenized into share tokens
+	ValidatorLiquidShares collections.Map[[]byte, math.Int]
+	// AutoCompoundSettings key: delAddr+valAddr | value: none used (delegations opted into auto-compounding)
+	AutoCompoundSettings collections.KeySet[collections.Pair[sdk.AccAddress, sdk.ValAddress]]
+	// AutoCompoundCursor value: delAddr+valAddr of the auto-compounding delegation to resume processing from
+	AutoCompoundCursor collections.Item[collections.Pair[sdk.AccAddress, sdk.ValAddress]]
+	// ScheduledCommissionChanges key: valAddr | value: ScheduledCommissionChange
+	ScheduledCommissionChanges collections.Map[sdk.ValAddress, types.ScheduledCommissionChange]
+	// ScheduledCommissionChangeQueue key: effectiveTime+valAddr | value: none used (queue of the scheduled commission changes by effective time)
+	ScheduledCommissionChangeQueue collections.KeySet[collections.Pair[time.Time, sdk.ValAddress]]
+	// FlashUndelegatedAmounts key: valAddr | value: tokens flash undelegated from the validator in the current block
+	FlashUndelegatedAmounts collections.Map[sdk.ValAddress, math.Int]
+	// ValidatorPerformance key: bucket+valAddr | value: performance counters of the validator over the bucket of blocks
+	ValidatorPerformance collections.Map[collections.Pair[uint64, sdk.ValAddress], types.ValidatorPerformanceCounters]
+	// BondableDenoms key: denom | value: BondableDenom (bondable denoms other than the bond denom)
+	BondableDenoms collections.Map[string, types.BondableDenom]
+	// MultiAssetPools key: valAddr+denom | value: MultiAssetPool
+	MultiAssetPools collections.Map[collections.Pair[sdk.ValAddress, string], types.MultiAssetPool]
+	// MultiAssetDelegations key: delAddr+valAddr+denom | value: MultiAssetDelegation
+	MultiAssetDelegations collections.Map[collections.Triple[sdk.AccAddress, sdk.ValAddress, string], types.MultiAssetDelegation]
+	// MultiAssetUnbondingQueue key: completionTime+unbondingID | value: MultiAssetUnbonding
+	MultiAssetUnbondingQueue collections.Map[collections.Pair[time.Time, uint64], types.MultiAssetUnbonding]
+	// MultiAssetUnbondingSequence generates the multi-asset unbonding ids
+	MultiAssetUnbondingSequence collections.Sequence
+	// MultiAssetUnbondingByValIndex key: valAddr+completionTime+unbondingID | value: none used (index key for MultiAssetUnbondingQueue stored by validator index)
+	MultiAssetUnbondingByValIndex collections.KeySet[collections.Triple[sdk.ValAddress, time.Time, uint64]]
+	// MultiAssetPoolByDenomIndex key: denom+valAddr | value: none used (index key for MultiAssetPools stored by denom index)
+	MultiAssetPoolByDenomIndex collections.KeySet[collections.Pair[string, sdk.ValAddress]]
+	// MaxValidatorsSchedule key: height | value: MaxValidatorsChange
+	MaxValidatorsSchedule collections.Map[int64, types.MaxValidatorsChange]
+	// RedelegationExemptions key: valSrcAddr | value: RedelegationExemption
+	RedelegationExemptions collections.Map[sdk.ValAddress, types.RedelegationExemption]
+	// FrozenDelegations key: delAddr+valAddr | value: none used (delegations frozen by the forced undelegation authority)
+	FrozenDelegations collections.KeySet[collections.Pair[sdk.AccAddress, sdk.ValAddress]]
 }
 
 // NewKeeper creates a new staking Keeper instance
@@ -304,6 +356,133 @@ func NewKeeper(
 			codec.CollValue[types.ConsPubKeyRotationHistory](cdc),
 			NewRotationHistoryIndexes(sb),
 		),
+		// key format is: 114 | positionID
+		DelegationPositions:        collections.NewMap(sb, types.DelegationPositionKey, "delegation_positions", collections.Uint64Key, codec.CollValue[types.DelegationPosition](cdc)),
+		DelegationPositionSequence: collections.NewSequence(sb, types.DelegationPositionSequenceKey, "delegation_position_sequence"),
+		// key format is: 116 | lengthPrefixedBytes(owner) | positionID
+		DelegationPositionsByOwner: collections.NewKeySet(
+			sb, types.DelegationPositionByOwnerKey,
+			"delegation_positions_by_owner",
+			collections.PairKeyCodec(sdk.LengthPrefixedAddressKey(sdk.AccAddressKey), collections.Uint64Key), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed for prefix iteration by owner
+		),
+		// key format is: 117 | recordID
+		TokenizeShareRecords:        collections.NewMap(sb, types.TokenizeShareRecordKey, "tokenize_share_records", collections.Uint64Key, codec.CollValue[types.TokenizeShareRecord](cdc)),
+		TokenizeShareRecordSequence: collections.NewSequence(sb, types.TokenizeShareRecordSequenceKey, "tokenize_share_record_sequence"),
+		// key format is: 119 | lengthPrefixedBytes(owner) | recordID
+		TokenizeShareRecordsByOwner: collections.NewKeySet(
+			sb, types.TokenizeShareRecordByOwnerKey,
+			"tokenize_share_records_by_owner",
+			collections.PairKeyCodec(sdk.LengthPrefixedAddressKey(sdk.AccAddressKey), collections.Uint64Key), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed for prefix iteration by owner
+		),
+		// key format is: 120 | denom
+		TokenizeShareRecordsByDenom: collections.NewMap(sb, types.TokenizeShareRecordByDenomKey, "tokenize_share_records_by_denom", collections.StringKey, collections.Uint64Value),
+		// key format is: 121 | valAddr
+		ValidatorLiquidShares: collections.NewMap(sb, types.ValidatorLiquidSharesKey, "validator_liquid_shares", sdk.LengthPrefixedBytesKey, sdk.IntValue),
+		// key format is: 122 | lengthPrefixedBytes(delAddr) | lengthPrefixedBytes(valAddr)
+		AutoCompoundSettings: collections.NewKeySet(
+			sb, types.AutoCompoundSettingKey,
+			"auto_compound_settings",
+			collections.PairKeyCodec(
+				sdk.LengthPrefixedAddressKey(sdk.AccAddressKey), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed for prefix iteration by delegator
+				sdk.LengthPrefixedAddressKey(sdk.ValAddressKey), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to delimit the validator address
+			),
+		),
+		// key format is: 123
+		AutoCompoundCursor: collections.NewItem(
+			sb, types.AutoCompoundCursorKey,
+			"auto_compound_cursor",
+			collcodec.KeyToValueCodec(collections.PairKeyCodec(
+				sdk.LengthPrefixedAddressKey(sdk.AccAddressKey), //nolint: staticcheck // sdk.LengthPrefixedAddressKey matches the AutoCompoundSettings keys
+				sdk.LengthPrefixedAddressKey(sdk.ValAddressKey), //nolint: staticcheck // sdk.LengthPrefixedAddressKey matches the AutoCompoundSettings keys
+			)),
+		),
+		// key format is: 124 | valAddr
+		ScheduledCommissionChanges: collections.NewMap(sb, types.ScheduledCommissionChangeKey, "scheduled_commission_changes", sdk.ValAddressKey, codec.CollValue[types.ScheduledCommissionChange](cdc)),
+		// key format is: 125 | time | valAddr
+		ScheduledCommissionChangeQueue: collections.NewKeySet(
+			sb, types.ScheduledCommissionChangeQueueKey,
+			"scheduled_commission_change_queue",
+			collections.PairKeyCodec(sdk.TimeKey, sdk.ValAddressKey),
+		),
+		// key format is: 126 | valAddr
+		FlashUndelegatedAmounts: collections.NewMap(sb, types.FlashUndelegatedAmountKey, "flash_undelegated_amounts", sdk.ValAddressKey, sdk.IntValue),
+		// key format is: 127 | bucket | valAddr
+		ValidatorPerformance: collections.NewMap(
+			sb, types.ValidatorPerformanceKey,
+			"validator_performance",
+			collections.PairKeyCodec(collections.Uint64Key, sdk.ValAddressKey),
+			codec.CollValue[types.ValidatorPerformanceCounters](cdc),
+		),
+		// key format is: 128 | denom
+		BondableDenoms: collections.NewMap(sb, types.BondableDenomKey, "bondable_denoms", collections.StringKey, codec.CollValue[types.BondableDenom](cdc)),
+		// key format is: 129 | lengthPrefixedBytes(valAddr) | denom
+		MultiAssetPools: collections.NewMap(
+			sb, types.MultiAssetPoolKey,
+			"multi_asset_pools",
+			collections.PairKeyCodec(
+				sdk.LengthPrefixedAddressKey(sdk.ValAddressKey), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed for prefix iteration by validator
+				collections.StringKey,
+			),
+			codec.CollValue[types.MultiAssetPool](cdc),
+		),
+		// key format is: 130 | lengthPrefixedBytes(delAddr) | lengthPrefixedBytes(valAddr) | denom
+		MultiAssetDelegations: collections.NewMap(
+			sb, types.MultiAssetDelegationKey,
+			"multi_asset_delegations",
+			collections.TripleKeyCodec(
+				sdk.LengthPrefixedAddressKey(sdk.AccAddressKey), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed for prefix iteration by delegator
+				sdk.LengthPrefixedAddressKey(sdk.ValAddressKey), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to delimit the validator address
+				collections.StringKey,
+			),
+			codec.CollValue[types.MultiAssetDelegation](cdc),
+		),
+		// key format is: 131 | time | unbondingID
+		MultiAssetUnbondingQueue: collections.NewMap(
+			sb, types.MultiAssetUnbondingQueueKey,
+			"multi_asset_unbonding_queue",
+			collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key),
+			codec.CollValue[types.MultiAssetUnbonding](cdc),
+		),
+		MultiAssetUnbondingSequence: collections.NewSequence(sb, types.MultiAssetUnbondingSequenceKey, "multi_asset_unbonding_sequence"),
+		// key format is: 136 | lengthPrefixedBytes(valAddr) | time | unbondingID
+		MultiAssetUnbondingByValIndex: collections.NewKeySet(
+			sb, types.MultiAssetUnbondingByValIndexKey,
+			"multi_asset_unbonding_by_val_index",
+			collections.TripleKeyCodec(
+				sdk.LengthPrefixedAddressKey(sdk.ValAddressKey), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed for prefix iteration by validator
+				sdk.TimeKey,
+				collections.Uint64Key,
+			),
+		),
+		// key format is: 137 | denom | valAddr
+		MultiAssetPoolByDenomIndex: collections.NewKeySet(
+			sb, types.MultiAssetPoolByDenomIndexKey,
+			"multi_asset_pool_by_denom_index",
+			collections.PairKeyCodec(collections.StringKey, sdk.ValAddressKey),
+		),
+		// key format is: 133 | height
+		MaxValidatorsSchedule: collections.NewMap(
+			sb, types.MaxValidatorsScheduleKey,
+			"max_validators_schedule",
+			collections.Int64Key,
+			codec.CollValue[types.MaxValidatorsChange](cdc),
+		),
+		// key format is: 134 | valSrcAddr
+		RedelegationExemptions: collections.NewMap(
+			sb, types.RedelegationExemptionKey,
+			"redelegation_exemptions",
+			sdk.ValAddressKey,
+			codec.CollValue[types.RedelegationExemption](cdc),
+		),
+		// key format is: 135 | lengthPrefixedBytes(delAddr) | lengthPrefixedBytes(valAddr)
+		FrozenDelegations: collections.NewKeySet(
+			sb, types.FrozenDelegationKey,
+			"frozen_delegations",
+			collections.PairKeyCodec(
+				sdk.LengthPrefixedAddressKey(sdk.AccAddressKey), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed for prefix iteration by delegator
+				sdk.LengthPrefixedAddressKey(sdk.ValAddressKey), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to delimit the validator address
+			),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -339,6 +518,52 @@ func (k *Keeper) SetHooks(sh types.StakingHooks) {
 	k.hooks = sh
 }
 
+// SetPerformanceScorer sets the scorer breaking the ties between the validators
+// contending for the last seats of the active set. Like SetHooks, this method
+// must take a pointer, and the scorer can only be set once.
+func (k *Keeper) SetPerformanceScorer(scorer types.ValidatorPerformanceScorer) {
+	if k.performanceScorer != nil {
+		panic("cannot set validator performance scorer twice")
+	}
+
+	k.performanceScorer = scorer
+}
+
+// SetRewardsWithdrawer sets the withdrawer of the delegation rewards which are
+// auto-compounded by the end blocker. Auto-compounding is a no-op until it is
+// set. Like SetHooks, this method must take a pointer, and the withdrawer can
+// only be set once.
+func (k *Keeper) SetRewardsWithdrawer(withdrawer types.DelegationRewardsWithdrawer) {
+	if k.rewardsWithdrawer != nil {
+		panic("cannot set delegation rewards withdrawer twice")
+	}
+
+	k.rewardsWithdrawer = withdrawer
+}
+
+// SetCommunityPoolFunder sets the funder of the community pool receiving the
+// penalties of the flash undelegations, which are disabled until it is set.
+// Like SetHooks, this method must take a pointer, and the funder can only be
+// set once.
+func (k *Keeper) SetCommunityPoolFunder(funder types.CommunityPoolFunder) {
+	if k.communityPoolFunder != nil {
+		panic("cannot set community pool funder twice")
+	}
+
+	k.communityPoolFunder = funder
+}
+
+// SetBondedTokenHooks sets the hooks notified of the changes of the bonded
+// tokens made through the precompile API. Like SetHooks, this method must take
+// a pointer, and the hooks can only be set once.
+func (k *Keeper) SetBondedTokenHooks(hooks types.BondedTokenHooks) {
+	if k.bondedTokenHooks != nil {
+		panic("cannot set bonded token hooks twice")
+	}
+
+	k.bondedTokenHooks = hooks
+}
+
 // GetAuthority returns the x/staking module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
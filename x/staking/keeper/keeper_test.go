@@ -253,7 +253,7 @@ func (s *KeeperTestSuite) TestLastTotalPowerMigrationToColls() {
 
 			s.ctx.KVStore(s.key).Set(getLastValidatorPowerKey(valAddrs[i]), bz)
 		},
-		"198aa9b8c1d9bc02308b7b2a48944f3e4b05c6b8312cb0bcc73518d1260f682d",
+		"a157a758b0d83fcfa5283d40dd59b0efa3b5b6a326bc0a40402177a16ebc35b9",
 	)
 	s.Require().NoError(err)
 
@@ -268,7 +268,7 @@ func (s *KeeperTestSuite) TestLastTotalPowerMigrationToColls() {
 			err = s.stakingKeeper.LastValidatorPower.Set(s.ctx, valAddrs[i], intV)
 			s.Require().NoError(err)
 		},
-		"198aa9b8c1d9bc02308b7b2a48944f3e4b05c6b8312cb0bcc73518d1260f682d",
+		"a157a758b0d83fcfa5283d40dd59b0efa3b5b6a326bc0a40402177a16ebc35b9",
 	)
 	s.Require().NoError(err)
 }
@@ -286,7 +286,7 @@ func (s *KeeperTestSuite) TestSrcRedelegationsMigrationToColls() {
 			// legacy method to set in the state
 			s.ctx.KVStore(s.key).Set(getREDByValSrcIndexKey(addrs[i], valAddrs[i], valAddrs[i+1]), []byte{})
 		},
-		"cae99e5c0498356a290f9478b7db73d522840b736878a9d4c00b56d1ddd7fd04",
+		"fc40181538e30649bed356d89f965ee43fbb123720adf12ea5c0e9fdd323057c",
 	)
 	s.Require().NoError(err)
 
@@ -299,7 +299,7 @@ func (s *KeeperTestSuite) TestSrcRedelegationsMigrationToColls() {
 			err := s.stakingKeeper.RedelegationsByValSrc.Set(s.ctx, collections.Join3(valAddrs[i].Bytes(), addrs[i].Bytes(), valAddrs[i+1].Bytes()), []byte{})
 			s.Require().NoError(err)
 		},
-		"cae99e5c0498356a290f9478b7db73d522840b736878a9d4c00b56d1ddd7fd04",
+		"fc40181538e30649bed356d89f965ee43fbb123720adf12ea5c0e9fdd323057c",
 	)
 
 	s.Require().NoError(err)
@@ -318,7 +318,10 @@ func (s *KeeperTestSuite) TestDstRedelegationsMigrationToColls() {
 			// legacy method to set in the state
 			s.ctx.KVStore(s.key).Set(getREDByValDstIndexKey(addrs[i], valAddrs[i], valAddrs[i+1]), []byte{})
 		},
-		"1b7687449a83f8176a60aeced7bcfc69a2b957b9eefad60c69a9fae9acfdaa81", // this hash obtained when ran this test in main branch
+		// the hash covers the whole store, including the params set up by the
+		// test, and so changes with the staking params. It is obtained with the
+		// legacy method and must match the one obtained with collections below.
+		"4b428a0949aefeb411a5a85b46c82c9e0734cf7284bfced2946ff8a09c9eea43",
 	)
 	s.Require().NoError(err)
 
@@ -331,7 +334,7 @@ func (s *KeeperTestSuite) TestDstRedelegationsMigrationToColls() {
 			err := s.stakingKeeper.RedelegationsByValDst.Set(s.ctx, collections.Join3(valAddrs[i+1].Bytes(), addrs[i].Bytes(), valAddrs[i].Bytes()), []byte{})
 			s.Require().NoError(err)
 		},
-		"1b7687449a83f8176a60aeced7bcfc69a2b957b9eefad60c69a9fae9acfdaa81",
+		"4b428a0949aefeb411a5a85b46c82c9e0734cf7284bfced2946ff8a09c9eea43",
 	)
 
 	s.Require().NoError(err)
@@ -362,7 +365,7 @@ func (s *KeeperTestSuite) TestUnbondingDelegationsMigrationToColls() {
 			s.ctx.KVStore(s.key).Set(getUBDKey(delAddrs[i], valAddrs[i]), bz)
 			s.ctx.KVStore(s.key).Set(getUBDByValIndexKey(delAddrs[i], valAddrs[i]), []byte{})
 		},
-		"70454ad98368368aaff32d207a7a115fba49133ecf2a225d8e3eca88c6b2324c",
+		"a7e0710803b4b02a9b839700ab0349b9c508bfff71bd5b4789a5cbf1a16b0807",
 	)
 	s.Require().NoError(err)
 
@@ -386,7 +389,7 @@ func (s *KeeperTestSuite) TestUnbondingDelegationsMigrationToColls() {
 			err := s.stakingKeeper.SetUnbondingDelegation(s.ctx, ubd)
 			s.Require().NoError(err)
 		},
-		"70454ad98368368aaff32d207a7a115fba49133ecf2a225d8e3eca88c6b2324c",
+		"a7e0710803b4b02a9b839700ab0349b9c508bfff71bd5b4789a5cbf1a16b0807",
 	)
 	s.Require().NoError(err)
 }
@@ -403,7 +406,7 @@ func (s *KeeperTestSuite) TestUBDQueueMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getUnbondingDelegationTimeKey(date), []byte{})
 		},
-		"2dd1dd08ea1cc2b0a076c420e3888b218647b9409b435f75e5730b0e4f25e890",
+		"4be1f74f51714647842f314a7747d3c7781e6e5d1bb92a49257f23e5814c268a",
 	)
 	s.Require().NoError(err)
 
@@ -416,7 +419,7 @@ func (s *KeeperTestSuite) TestUBDQueueMigrationToColls() {
 			err := s.stakingKeeper.SetUBDQueueTimeSlice(s.ctx, date, nil)
 			s.Require().NoError(err)
 		},
-		"2dd1dd08ea1cc2b0a076c420e3888b218647b9409b435f75e5730b0e4f25e890",
+		"4be1f74f51714647842f314a7747d3c7781e6e5d1bb92a49257f23e5814c268a",
 	)
 	s.Require().NoError(err)
 }
@@ -450,7 +453,7 @@ func (s *KeeperTestSuite) TestValidatorsMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getValidatorKey(valAddrs[i]), valBz)
 		},
-		"aa495d55fb45df89fcf1d4326331bfc1244ef879764abe76f6ce2a41ccd4180d",
+		"3e326bed232c99e63c18d7500ffd391a8ece1d23e5335ecb2bd5288da45697de",
 	)
 	s.Require().NoError(err)
 
@@ -476,7 +479,7 @@ func (s *KeeperTestSuite) TestValidatorsMigrationToColls() {
 			err := s.stakingKeeper.SetValidator(s.ctx, val)
 			s.Require().NoError(err)
 		},
-		"aa495d55fb45df89fcf1d4326331bfc1244ef879764abe76f6ce2a41ccd4180d",
+		"3e326bed232c99e63c18d7500ffd391a8ece1d23e5335ecb2bd5288da45697de",
 	)
 	s.Require().NoError(err)
 }
@@ -499,7 +502,7 @@ func (s *KeeperTestSuite) TestValidatorQueueMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getValidatorQueueKey(endTime, endHeight), bz)
 		},
-		"b23a5905ced2b76c46ddd0f7d39e2ed7dcc68cd81993c497ee314b2e1a158595",
+		"c0733f653a0b85be7678f65a2f300dfae6e92c372966ee8a0dda6c943752c86f",
 	)
 	s.Require().NoError(err)
 
@@ -514,7 +517,7 @@ func (s *KeeperTestSuite) TestValidatorQueueMigrationToColls() {
 			err := s.stakingKeeper.SetUnbondingValidatorsQueue(s.ctx, endTime, endHeight, addrs)
 			s.Require().NoError(err)
 		},
-		"b23a5905ced2b76c46ddd0f7d39e2ed7dcc68cd81993c497ee314b2e1a158595",
+		"c0733f653a0b85be7678f65a2f300dfae6e92c372966ee8a0dda6c943752c86f",
 	)
 	s.Require().NoError(err)
 }
@@ -542,7 +545,7 @@ func (s *KeeperTestSuite) TestRedelegationQueueMigrationToColls() {
 			s.Require().NoError(err)
 			s.ctx.KVStore(s.key).Set(getRedelegationTimeKey(date), bz)
 		},
-		"d6a1c46c7c5793ff7094b67252c82883aecb75c8359428a59aacd3657fa16235",
+		"e76a880b8e3ca619138c2494af41a0fb0eec8ce7e50108a2cb7f860c1ab942e7",
 	)
 	s.Require().NoError(err)
 
@@ -564,7 +567,7 @@ func (s *KeeperTestSuite) TestRedelegationQueueMigrationToColls() {
 			err := s.stakingKeeper.SetRedelegationQueueTimeSlice(s.ctx, date, dvvTriplets.Triplets)
 			s.Require().NoError(err)
 		},
-		"d6a1c46c7c5793ff7094b67252c82883aecb75c8359428a59aacd3657fa16235",
+		"e76a880b8e3ca619138c2494af41a0fb0eec8ce7e50108a2cb7f860c1ab942e7",
 	)
 	s.Require().NoError(err)
 }
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+)
+
+// GetMaturingUnbondings returns the unbonding delegation entries completing
+// within [start, end), sorted by completion time.
+func (k Keeper) GetMaturingUnbondings(ctx context.Context, start, end time.Time) ([]types.UnbondingQueueEntry, error) {
+	var entries []types.UnbondingQueueEntry
+	seen := make(map[types.DVPair]bool)
+	err := k.UnbondingQueue.Walk(ctx, new(collections.Range[time.Time]).StartInclusive(start).EndExclusive(end), func(_ time.Time, timeslice types.DVPairs) (bool, error) {
+		for _, pair := range timeslice.Pairs {
+			// an unbonding delegation is queued once per completion time
+			if seen[pair] {
+				continue
+			}
+			seen[pair] = true
+
+			delAddr, err := k.authKeeper.AddressCodec().StringToBytes(pair.DelegatorAddress)
+			if err != nil {
+				return true, err
+			}
+			valAddr, err := k.validatorAddressCodec.StringToBytes(pair.ValidatorAddress)
+			if err != nil {
+				return true, err
+			}
+
+			// the queue is not cleaned up when unbonding entries are cancelled
+			ubd, err := k.UnbondingDelegations.Get(ctx, collections.Join(delAddr, valAddr))
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			} else if err != nil {
+				return true, err
+			}
+
+			for _, entry := range ubd.Entries {
+				if entry.CompletionTime.Before(start) || !entry.CompletionTime.Before(end) {
+					continue
+				}
+				entries = append(entries, types.UnbondingQueueEntry{
+					DelegatorAddress: ubd.DelegatorAddress,
+					ValidatorAddress: ubd.ValidatorAddress,
+					CreationHeight:   entry.CreationHeight,
+					CompletionTime:   entry.CompletionTime,
+					Balance:          entry.Balance,
+				})
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].CompletionTime.Before(entries[j].CompletionTime)
+	})
+
+	return entries, nil
+}
+
+// GetMaturingRedelegations returns the redelegation entries completing within
+// [start, end), sorted by completion time.
+func (k Keeper) GetMaturingRedelegations(ctx context.Context, start, end time.Time) ([]types.RedelegationQueueEntry, error) {
+	var entries []types.RedelegationQueueEntry
+	seen := make(map[types.DVVTriplet]bool)
+	err := k.RedelegationQueue.Walk(ctx, new(collections.Range[time.Time]).StartInclusive(start).EndExclusive(end), func(_ time.Time, timeslice types.DVVTriplets) (bool, error) {
+		for _, triplet := range timeslice.Triplets {
+			// a redelegation is queued once per completion time
+			if seen[triplet] {
+				continue
+			}
+			seen[triplet] = true
+
+			delAddr, err := k.authKeeper.AddressCodec().StringToBytes(triplet.DelegatorAddress)
+			if err != nil {
+				return true, err
+			}
+			valSrcAddr, err := k.validatorAddressCodec.StringToBytes(triplet.ValidatorSrcAddress)
+			if err != nil {
+				return true, err
+			}
+			valDstAddr, err := k.validatorAddressCodec.StringToBytes(triplet.ValidatorDstAddress)
+			if err != nil {
+				return true, err
+			}
+
+			red, err := k.Redelegations.Get(ctx, collections.Join3(delAddr, valSrcAddr, valDstAddr))
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			} else if err != nil {
+				return true, err
+			}
+
+			for _, entry := range red.Entries {
+				if entry.CompletionTime.Before(start) || !entry.CompletionTime.Before(end) {
+					continue
+				}
+				entries = append(entries, types.RedelegationQueueEntry{
+					DelegatorAddress:    red.DelegatorAddress,
+					ValidatorSrcAddress: red.ValidatorSrcAddress,
+					ValidatorDstAddress: red.ValidatorDstAddress,
+					CreationHeight:      entry.CreationHeight,
+					CompletionTime:      entry.CompletionTime,
+					InitialBalance:      entry.InitialBalance,
+					SharesDst:           entry.SharesDst,
+				})
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].CompletionTime.Before(entries[j].CompletionTime)
+	})
+
+	return entries, nil
+}
+
+// queueDailyAmounts sums the amounts of queue entries, sorted by completion
+// time, per UTC day of completion.
+func queueDailyAmounts[T any](entries []T, completion func(T) (time.Time, math.Int)) []types.QueueDailyAmount {
+	var amounts []types.QueueDailyAmount
+	for _, entry := range entries {
+		completionTime, amount := completion(entry)
+		day := completionTime.UTC().Truncate(24 * time.Hour)
+		if n := len(amounts); n > 0 && amounts[n-1].Date.Equal(day) {
+			amounts[n-1].Amount = amounts[n-1].Amount.Add(amount)
+			continue
+		}
+		amounts = append(amounts, types.QueueDailyAmount{Date: day, Amount: amount})
+	}
+
+	return amounts
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func (s *KeeperTestSuite) TestGRPCQueryUnbondingQueue() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	delAddrs, valAddrs := createValAddrs(2)
+	s.accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+	valCodec, delCodec := address.NewBech32Codec("cosmosvaloper"), address.NewBech32Codec("cosmos")
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	ubd0 := stakingtypes.NewUnbondingDelegation(delAddrs[0], valAddrs[0], 10, day.Add(time.Hour), math.NewInt(5), 1, valCodec, delCodec)
+	ubd0.AddEntry(11, day.Add(25*time.Hour), math.NewInt(7), 2)
+	ubd1 := stakingtypes.NewUnbondingDelegation(delAddrs[1], valAddrs[0], 12, day.Add(2*time.Hour), math.NewInt(3), 3, valCodec, delCodec)
+	for _, ubd := range []stakingtypes.UnbondingDelegation{ubd0, ubd1} {
+		require.NoError(keeper.SetUnbondingDelegation(ctx, ubd))
+		for _, entry := range ubd.Entries {
+			require.NoError(keeper.InsertUBDQueue(ctx, ubd, entry.CompletionTime))
+		}
+	}
+
+	_, err := queryClient.UnbondingQueue(ctx, &stakingtypes.QueryUnbondingQueueRequest{StartTime: day, EndTime: day})
+	require.Error(err)
+
+	res, err := queryClient.UnbondingQueue(ctx, &stakingtypes.QueryUnbondingQueueRequest{StartTime: day, EndTime: day.Add(48 * time.Hour)})
+	require.NoError(err)
+	require.Len(res.Entries, 3)
+	require.Equal(stakingtypes.UnbondingQueueEntry{
+		DelegatorAddress: ubd1.DelegatorAddress,
+		ValidatorAddress: ubd1.ValidatorAddress,
+		CreationHeight:   12,
+		CompletionTime:   day.Add(2 * time.Hour),
+		Balance:          math.NewInt(3),
+	}, res.Entries[1])
+	require.Equal(day.Add(time.Hour), res.Entries[0].CompletionTime)
+	require.Equal(day.Add(25*time.Hour), res.Entries[2].CompletionTime)
+	require.Equal([]stakingtypes.QueueDailyAmount{
+		{Date: day, Amount: math.NewInt(8)},
+		{Date: day.Add(24 * time.Hour), Amount: math.NewInt(7)},
+	}, res.DailyAmounts)
+
+	// the daily amounts cover all the entries while the entries are paginated
+	res, err = queryClient.UnbondingQueue(ctx, &stakingtypes.QueryUnbondingQueueRequest{
+		StartTime:  day,
+		EndTime:    day.Add(48 * time.Hour),
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Len(res.Entries, 1)
+	require.Equal(ubd1.DelegatorAddress, res.Entries[0].DelegatorAddress)
+	require.Len(res.DailyAmounts, 2)
+	require.Equal(uint64(3), res.Pagination.Total)
+
+	res, err = queryClient.UnbondingQueue(ctx, &stakingtypes.QueryUnbondingQueueRequest{StartTime: day.Add(2 * time.Hour), EndTime: day.Add(25 * time.Hour)})
+	require.NoError(err)
+	require.Len(res.Entries, 1)
+	require.Equal(day.Add(2*time.Hour), res.Entries[0].CompletionTime)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryRedelegationQueue() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	delAddrs, valAddrs := createValAddrs(3)
+	s.accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	red := stakingtypes.NewRedelegation(delAddrs[0], valAddrs[0], valAddrs[1], 10, day.Add(time.Hour), math.NewInt(5), math.LegacyNewDec(5), 1,
+		address.NewBech32Codec("cosmosvaloper"), address.NewBech32Codec("cosmos"))
+	red.AddEntry(11, day.Add(49*time.Hour), math.NewInt(7), math.LegacyNewDec(7), 2)
+	require.NoError(keeper.SetRedelegation(ctx, red))
+	for _, entry := range red.Entries {
+		require.NoError(keeper.InsertRedelegationQueue(ctx, red, entry.CompletionTime))
+	}
+
+	res, err := queryClient.RedelegationQueue(ctx, &stakingtypes.QueryRedelegationQueueRequest{StartTime: day, EndTime: day.Add(24 * time.Hour)})
+	require.NoError(err)
+	require.Equal([]stakingtypes.RedelegationQueueEntry{{
+		DelegatorAddress:    red.DelegatorAddress,
+		ValidatorSrcAddress: red.ValidatorSrcAddress,
+		ValidatorDstAddress: red.ValidatorDstAddress,
+		CreationHeight:      10,
+		CompletionTime:      day.Add(time.Hour),
+		InitialBalance:      math.NewInt(5),
+		SharesDst:           math.LegacyNewDec(5),
+	}}, res.Entries)
+	require.Equal([]stakingtypes.QueueDailyAmount{{Date: day, Amount: math.NewInt(5)}}, res.DailyAmounts)
+
+	res, err = queryClient.RedelegationQueue(ctx, &stakingtypes.QueryRedelegationQueueRequest{StartTime: day, EndTime: day.Add(72 * time.Hour)})
+	require.NoError(err)
+	require.Len(res.Entries, 2)
+	require.Len(res.DailyAmounts, 2)
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/staking/types"
+)
+
+// ScheduleMaxValidators replaces the changes of the MaxValidators param
+// scheduled at future heights, allowing governance to ramp the size of the
+// validator set up or down over several blocks instead of a single abrupt
+// change. An empty schedule cancels the changes previously scheduled.
+func (k Keeper) ScheduleMaxValidators(ctx context.Context, changes []types.MaxValidatorsChange) error {
+	height := k.environment.HeaderService.GetHeaderInfo(ctx).Height
+	if err := types.ValidateMaxValidatorsChanges(changes, height); err != nil {
+		return err
+	}
+
+	if err := k.MaxValidatorsSchedule.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	for _, change := range changes {
+		if err := k.MaxValidatorsSchedule.Set(ctx, change.Height, change); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetMaxValidatorsSchedule returns the scheduled changes of the MaxValidators
+// param, by height.
+func (k Keeper) GetMaxValidatorsSchedule(ctx context.Context) ([]types.MaxValidatorsChange, error) {
+	var changes []types.MaxValidatorsChange
+	err := k.MaxValidatorsSchedule.Walk(ctx, nil, func(_ int64, change types.MaxValidatorsChange) (bool, error) {
+		changes = append(changes, change)
+		return false, nil
+	})
+
+	return changes, err
+}
+
+// ApplyMaxValidatorsChanges sets the MaxValidators param to the changes
+// scheduled up to the current height, before the validator set update.
+func (k Keeper) ApplyMaxValidatorsChanges(ctx context.Context) error {
+	height := k.environment.HeaderService.GetHeaderInfo(ctx).Height
+
+	var matured []types.MaxValidatorsChange
+	rng := new(collections.Range[int64]).EndInclusive(height)
+	err := k.MaxValidatorsSchedule.Walk(ctx, rng, func(_ int64, change types.MaxValidatorsChange) (bool, error) {
+		matured = append(matured, change)
+		return false, nil
+	})
+	if err != nil || len(matured) == 0 {
+		return err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, change := range matured {
+		if err := k.MaxValidatorsSchedule.Remove(ctx, change.Height); err != nil {
+			return err
+		}
+
+		params.MaxValidators = change.MaxValidators
+		if err := k.environment.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeApplyMaxValidatorsChange,
+			event.NewAttribute(types.AttributeKeyMaxValidators, strconv.FormatUint(uint64(change.MaxValidators), 10)),
+		); err != nil {
+			return err
+		}
+	}
+
+	return k.Params.Set(ctx, params)
+}
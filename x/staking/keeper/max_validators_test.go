@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestScheduleMaxValidators() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 10, Time: ctx.HeaderInfo().Time})
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	initial := params.MaxValidators
+
+	changes := []types.MaxValidatorsChange{{Height: 20, MaxValidators: initial + 10}, {Height: 30, MaxValidators: initial + 20}}
+	_, err = msgServer.ScheduleMaxValidators(ctx, &types.MsgScheduleMaxValidators{Authority: Addr.String(), Changes: changes})
+	require.ErrorIs(err, types.ErrInvalidSigner)
+	_, err = msgServer.ScheduleMaxValidators(ctx, &types.MsgScheduleMaxValidators{
+		Authority: keeper.GetAuthority(),
+		Changes:   []types.MaxValidatorsChange{{Height: 10, MaxValidators: initial}},
+	})
+	require.ErrorIs(err, types.ErrInvalidMaxValidatorsChange)
+	_, err = msgServer.ScheduleMaxValidators(ctx, &types.MsgScheduleMaxValidators{
+		Authority: keeper.GetAuthority(),
+		Changes:   []types.MaxValidatorsChange{{Height: 20, MaxValidators: 0}},
+	})
+	require.ErrorIs(err, types.ErrInvalidMaxValidatorsChange)
+	_, err = msgServer.ScheduleMaxValidators(ctx, &types.MsgScheduleMaxValidators{Authority: keeper.GetAuthority(), Changes: changes})
+	require.NoError(err)
+
+	res, err := s.queryClient.MaxValidatorsSchedule(ctx, &types.QueryMaxValidatorsScheduleRequest{})
+	require.NoError(err)
+	require.Equal(changes, res.Changes)
+
+	// the changes are applied once their height is reached
+	require.NoError(keeper.ApplyMaxValidatorsChanges(ctx))
+	params, err = keeper.Params.Get(ctx)
+	require.NoError(err)
+	require.Equal(initial, params.MaxValidators)
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 25})
+	require.NoError(keeper.ApplyMaxValidatorsChanges(ctx))
+	params, err = keeper.Params.Get(ctx)
+	require.NoError(err)
+	require.Equal(initial+10, params.MaxValidators)
+
+	schedule, err := keeper.GetMaxValidatorsSchedule(ctx)
+	require.NoError(err)
+	require.Equal(changes[1:], schedule)
+
+	// an empty schedule cancels the remaining changes
+	_, err = msgServer.ScheduleMaxValidators(ctx, &types.MsgScheduleMaxValidators{Authority: keeper.GetAuthority()})
+	require.NoError(err)
+	schedule, err = keeper.GetMaxValidatorsSchedule(ctx)
+	require.NoError(err)
+	require.Empty(schedule)
+}
@@ -48,6 +48,15 @@ func (k msgServer) CreateValidator(ctx context.Context, msg *types.MsgCreateVali
 		return nil, err
 	}
 
+	// on permissioned networks, only allowlisted operators can create a validator
+	allowed, err := k.IsValidatorAllowed(ctx, msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, errorsmod.Wrapf(types.ErrValidatorNotAllowlisted, "%s", msg.ValidatorAddress)
+	}
+
 	minCommRate, err := k.MinCommissionRate(ctx)
 	if err != nil {
 		return nil, err
@@ -262,6 +271,10 @@ func (k msgServer) Delegate(ctx context.Context, msg *types.MsgDelegate) (*types
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
 	}
 
+	if err := k.checkDelegationNotFrozen(ctx, delegatorAddress, valAddr); err != nil {
+		return nil, err
+	}
+
 	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
 		return nil, errorsmod.Wrap(
 			sdkerrors.ErrInvalidRequest,
@@ -332,6 +345,14 @@ func (k msgServer) BeginRedelegate(ctx context.Context, msg *types.MsgBeginRedel
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
 	}
 
+	if err := k.checkDelegationNotFrozen(ctx, delegatorAddress, valSrcAddr); err != nil {
+		return nil, err
+	}
+
+	if err := k.checkDelegationNotFrozen(ctx, delegatorAddress, valDstAddr); err != nil {
+		return nil, err
+	}
+
 	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
 		return nil, errorsmod.Wrap(
 			sdkerrors.ErrInvalidRequest,
@@ -402,6 +423,10 @@ func (k msgServer) Undelegate(ctx context.Context, msg *types.MsgUndelegate) (*t
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
 	}
 
+	if err := k.checkDelegationNotFrozen(ctx, delegatorAddress, addr); err != nil {
+		return nil, err
+	}
+
 	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
 		return nil, errorsmod.Wrap(
 			sdkerrors.ErrInvalidRequest,
@@ -474,6 +499,10 @@ func (k msgServer) CancelUnbondingDelegation(ctx context.Context, msg *types.Msg
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
 	}
 
+	if err := k.checkDelegationNotFrozen(ctx, delegatorAddress, valAddr); err != nil {
+		return nil, err
+	}
+
 	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
 		return nil, errorsmod.Wrap(
 			sdkerrors.ErrInvalidRequest,
@@ -576,6 +605,10 @@ func (k msgServer) CancelUnbondingDelegation(ctx context.Context, msg *types.Msg
 		return nil, err
 	}
 
+	if err := k.afterUnbondingAmountChanged(ctx, delegatorAddress, valAddr, msg.Amount.Amount.Neg()); err != nil {
+		return nil, err
+	}
+
 	if err := k.environment.EventService.EventManager(ctx).EmitKV(
 		types.EventTypeCancelUnbondingDelegation,
 		event.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
@@ -599,6 +632,14 @@ func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams)
 		return nil, err
 	}
 
+	if err := msg.Params.ValidateAllowlistAddresses(k.validatorAddressCodec); err != nil {
+		return nil, err
+	}
+
+	if err := msg.Params.ValidateForcedUndelegationAuthority(k.authKeeper.AddressCodec()); err != nil {
+		return nil, err
+	}
+
 	// get previous staking params
 	previousParams, err := k.Params.Get(ctx)
 	if err != nil {
@@ -716,3 +757,615 @@ func (k msgServer) RotateConsPubKey(ctx context.Context, msg *types.MsgRotateCon
 
 	return res, nil
 }
+
+// TokenizeDelegation defines a method for tokenizing delegation shares into a
+// transferable delegation position.
+func (k msgServer) TokenizeDelegation(ctx context.Context, msg *types.MsgTokenizeDelegation) (*types.MsgTokenizeDelegationResponse, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if err := k.checkDelegationNotFrozen(ctx, delegatorAddress, valAddr); err != nil {
+		return nil, err
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid shares amount",
+		)
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Amount.Denom != bondDenom {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
+		)
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delegatorAddress, valAddr, msg.Amount.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	positionID, err := k.Keeper.TokenizeDelegation(ctx, delegatorAddress, valAddr, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeTokenizeDelegation,
+		event.NewAttribute(types.AttributeKeyPositionID, strconv.FormatUint(positionID, 10)),
+		event.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+		event.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+		event.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTokenizeDelegationResponse{PositionId: positionID}, nil
+}
+
+// TransferDelegationPosition defines a method for transferring a delegation
+// position to a new owner.
+func (k msgServer) TransferDelegationPosition(ctx context.Context, msg *types.MsgTransferDelegationPosition) (*types.MsgTransferDelegationPositionResponse, error) {
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+
+	recipient, err := k.authKeeper.AddressCodec().StringToBytes(msg.Recipient)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	if err := k.Keeper.TransferDelegationPosition(ctx, owner, msg.PositionId, recipient); err != nil {
+		return nil, err
+	}
+
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeTransferPosition,
+		event.NewAttribute(types.AttributeKeyPositionID, strconv.FormatUint(msg.PositionId, 10)),
+		event.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+		event.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferDelegationPositionResponse{}, nil
+}
+
+// RedeemDelegationPosition defines a method for redeeming a delegation position,
+// unlocking its delegation shares.
+func (k msgServer) RedeemDelegationPosition(ctx context.Context, msg *types.MsgRedeemDelegationPosition) (*types.MsgRedeemDelegationPositionResponse, error) {
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+
+	position, err := k.Keeper.RedeemDelegationPosition(ctx, owner, msg.PositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeRedeemPosition,
+		event.NewAttribute(types.AttributeKeyPositionID, strconv.FormatUint(msg.PositionId, 10)),
+		event.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+		event.NewAttribute(types.AttributeKeyValidator, position.ValidatorAddress),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRedeemDelegationPositionResponse{}, nil
+}
+
+// TokenizeShares defines a method for tokenizing delegation shares into liquid
+// share tokens.
+func (k msgServer) TokenizeShares(ctx context.Context, msg *types.MsgTokenizeShares) (*types.MsgTokenizeSharesResponse, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if err := k.checkDelegationNotFrozen(ctx, delegatorAddress, valAddr); err != nil {
+		return nil, err
+	}
+
+	owner := delegatorAddress
+	if msg.TokenizedShareOwner != "" {
+		owner, err = k.authKeeper.AddressCodec().StringToBytes(msg.TokenizedShareOwner)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid tokenized share owner address: %s", err)
+		}
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid shares amount",
+		)
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Amount.Denom != bondDenom {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
+		)
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delegatorAddress, valAddr, msg.Amount.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	record, shareTokens, err := k.Keeper.TokenizeShares(ctx, delegatorAddress, valAddr, shares, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeTokenizeShares,
+		event.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+		event.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+		event.NewAttribute(types.AttributeKeyShareOwner, record.Owner),
+		event.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(record.Id, 10)),
+		event.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTokenizeSharesResponse{Amount: shareTokens, RecordId: record.Id}, nil
+}
+
+// RedeemTokensForShares defines a method for redeeming liquid share tokens for
+// the delegation shares they are worth.
+func (k msgServer) RedeemTokensForShares(ctx context.Context, msg *types.MsgRedeemTokensForShares) (*types.MsgRedeemTokensForSharesResponse, error) {
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid share tokens amount",
+		)
+	}
+
+	record, tokens, err := k.Keeper.RedeemTokensForShares(ctx, delegatorAddress, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := sdk.NewCoin(bondDenom, tokens)
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeRedeemShares,
+		event.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+		event.NewAttribute(types.AttributeKeyValidator, record.ValidatorAddress),
+		event.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(record.Id, 10)),
+		event.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRedeemTokensForSharesResponse{Amount: amount}, nil
+}
+
+// TransferTokenizeShareRecord defines a method for transferring the ownership of
+// a tokenize share record.
+func (k msgServer) TransferTokenizeShareRecord(ctx context.Context, msg *types.MsgTransferTokenizeShareRecord) (*types.MsgTransferTokenizeShareRecordResponse, error) {
+	sender, err := k.authKeeper.AddressCodec().StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	newOwner, err := k.authKeeper.AddressCodec().StringToBytes(msg.NewOwner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid new owner address: %s", err)
+	}
+
+	if err := k.Keeper.TransferTokenizeShareRecord(ctx, sender, msg.TokenizeShareRecordId, newOwner); err != nil {
+		return nil, err
+	}
+
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeTransferTokenizeShareRecord,
+		event.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(msg.TokenizeShareRecordId, 10)),
+		event.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		event.NewAttribute(types.AttributeKeyShareOwner, msg.NewOwner),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferTokenizeShareRecordResponse{}, nil
+}
+
+// SetAutoCompound defines a method for a delegator to opt into, or out of, the
+// auto-compounding of the rewards of a delegation
+func (k msgServer) SetAutoCompound(ctx context.Context, msg *types.MsgSetAutoCompound) (*types.MsgSetAutoCompoundResponse, error) {
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if err := k.Keeper.SetAutoCompound(ctx, delegatorAddress, valAddr, msg.Enabled); err != nil {
+		return nil, err
+	}
+
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSetAutoCompound,
+		event.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+		event.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+		event.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(msg.Enabled)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAutoCompoundResponse{}, nil
+}
+
+// ScheduleCommissionChange defines a method for a validator to schedule a commission rate change
+func (k msgServer) ScheduleCommissionChange(ctx context.Context, msg *types.MsgScheduleCommissionChange) (*types.MsgScheduleCommissionChangeResponse, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if msg.NewRate.IsNil() || msg.NewRate.IsNegative() || msg.NewRate.GT(math.LegacyOneDec()) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "commission rate must be between 0 and 1 (inclusive)")
+	}
+
+	change, err := k.Keeper.ScheduleCommissionChange(ctx, valAddr, msg.NewRate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgScheduleCommissionChangeResponse{EffectiveTime: change.EffectiveTime}, nil
+}
+
+// CancelCommissionChange defines a method for a validator to cancel its scheduled commission rate change
+func (k msgServer) CancelCommissionChange(ctx context.Context, msg *types.MsgCancelCommissionChange) (*types.MsgCancelCommissionChangeResponse, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if err := k.Keeper.CancelCommissionChange(ctx, valAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelCommissionChangeResponse{}, nil
+}
+
+// FlashUndelegate defines a method for undelegating tokens without waiting for the unbonding period
+func (k msgServer) FlashUndelegate(ctx context.Context, msg *types.MsgFlashUndelegate) (*types.MsgFlashUndelegateResponse, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if err := k.checkDelegationNotFrozen(ctx, delegatorAddress, valAddr); err != nil {
+		return nil, err
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid shares amount",
+		)
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Amount.Denom != bondDenom {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
+		)
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delegatorAddress, valAddr, msg.Amount.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	payout, penalty, err := k.Keeper.FlashUndelegate(ctx, delegatorAddress, valAddr, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	payoutCoin := sdk.NewCoin(bondDenom, payout)
+	penaltyCoin := sdk.NewCoin(bondDenom, penalty)
+
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeFlashUndelegate,
+		event.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+		event.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+		event.NewAttribute(sdk.AttributeKeyAmount, payoutCoin.String()),
+		event.NewAttribute(types.AttributeKeyPenalty, penaltyCoin.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFlashUndelegateResponse{Amount: payoutCoin, Penalty: penaltyCoin}, nil
+}
+
+// ExecStakingOperations defines a method for executing a batch of staking
+// operations of a delegator
+func (k msgServer) ExecStakingOperations(ctx context.Context, msg *types.MsgExecStakingOperations) (*types.MsgExecStakingOperationsResponse, error) {
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if len(msg.Operations) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "staking operations cannot be empty")
+	}
+	if len(msg.Operations) > types.MaxStakingOperations {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "too many staking operations: %d > %d", len(msg.Operations), types.MaxStakingOperations)
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	restaked := math.ZeroInt()
+	for i, op := range msg.Operations {
+		switch op.OperationType {
+		case types.StakingOperationTypeDelegate:
+			_, err = k.Delegate(ctx, &types.MsgDelegate{
+				DelegatorAddress: msg.DelegatorAddress,
+				ValidatorAddress: op.ValidatorAddress,
+				Amount:           op.Amount,
+			})
+		case types.StakingOperationTypeRedelegate:
+			_, err = k.BeginRedelegate(ctx, &types.MsgBeginRedelegate{
+				DelegatorAddress:    msg.DelegatorAddress,
+				ValidatorSrcAddress: op.ValidatorAddress,
+				ValidatorDstAddress: op.ValidatorDstAddress,
+				Amount:              op.Amount,
+			})
+		case types.StakingOperationTypeRestakeRewards:
+			var valAddr []byte
+			valAddr, err = k.validatorAddressCodec.StringToBytes(op.ValidatorAddress)
+			if err != nil {
+				return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+			}
+
+			if err = k.checkDelegationNotFrozen(ctx, delegatorAddress, valAddr); err != nil {
+				return nil, errorsmod.Wrapf(err, "staking operation %d", i)
+			}
+
+			var amount math.Int
+			amount, err = k.Keeper.RestakeRewards(ctx, delegatorAddress, valAddr)
+			if err == nil {
+				restaked = restaked.Add(amount)
+			}
+		default:
+			err = sdkerrors.ErrInvalidRequest.Wrapf("unknown staking operation type: %s", op.OperationType)
+		}
+
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "staking operation %d", i)
+		}
+	}
+
+	return &types.MsgExecStakingOperationsResponse{RestakedAmount: sdk.NewCoin(bondDenom, restaked)}, nil
+}
+
+// SetBondableDenom defines a governance operation for registering, updating or
+// removing a bondable denom other than the bond denom
+func (k msgServer) SetBondableDenom(ctx context.Context, msg *types.MsgSetBondableDenom) (*types.MsgSetBondableDenomResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if msg.Weight.IsNil() || msg.Weight.IsNegative() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid weight: %s", msg.Weight)
+	}
+
+	if err := k.Keeper.SetBondableDenom(ctx, msg.Denom, msg.Weight); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetBondableDenomResponse{}, nil
+}
+
+// ScheduleMaxValidators defines a governance operation for scheduling a ramp of
+// changes of the MaxValidators param at future heights
+func (k msgServer) ScheduleMaxValidators(ctx context.Context, msg *types.MsgScheduleMaxValidators) (*types.MsgScheduleMaxValidatorsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := k.Keeper.ScheduleMaxValidators(ctx, msg.Changes); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgScheduleMaxValidatorsResponse{}, nil
+}
+
+// ApproveRedelegationExemption defines a governance operation for approving a
+// validator consolidation, during which the delegators of the source validator
+// can redelegate from it without the redelegation restrictions
+func (k msgServer) ApproveRedelegationExemption(ctx context.Context, msg *types.MsgApproveRedelegationExemption) (*types.MsgApproveRedelegationExemptionResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := k.Keeper.ApproveRedelegationExemption(ctx, msg.Exemption); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveRedelegationExemptionResponse{}, nil
+}
+
+// DelegateMultiAsset defines a method for delegating tokens of a bondable denom
+// other than the bond denom to a validator
+func (k msgServer) DelegateMultiAsset(ctx context.Context, msg *types.MsgDelegateMultiAsset) (*types.MsgDelegateMultiAssetResponse, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if err := k.checkDelegationNotFrozen(ctx, delegatorAddress, valAddr); err != nil {
+		return nil, err
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid delegation amount",
+		)
+	}
+
+	newShares, err := k.Keeper.DelegateMultiAsset(ctx, delegatorAddress, valAddr, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeDelegate,
+		event.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+		event.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+		event.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		event.NewAttribute(types.AttributeKeyNewShares, newShares.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDelegateMultiAssetResponse{Shares: newShares}, nil
+}
+
+// UndelegateMultiAsset defines a method for undelegating tokens of a bondable
+// denom other than the bond denom from a validator
+func (k msgServer) UndelegateMultiAsset(ctx context.Context, msg *types.MsgUndelegateMultiAsset) (*types.MsgUndelegateMultiAssetResponse, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if err := k.checkDelegationNotFrozen(ctx, delegatorAddress, valAddr); err != nil {
+		return nil, err
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid shares amount",
+		)
+	}
+
+	completionTime, undelegated, err := k.Keeper.UndelegateMultiAsset(ctx, delegatorAddress, valAddr, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeUnbond,
+		event.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+		event.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+		event.NewAttribute(sdk.AttributeKeyAmount, undelegated.String()),
+		event.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUndelegateMultiAssetResponse{CompletionTime: completionTime, Amount: undelegated}, nil
+}
+
+// ForceUndelegate defines an operation of the forced undelegation authority for
+// forcibly undelegating, and optionally freezing, a delegation
+func (k msgServer) ForceUndelegate(ctx context.Context, msg *types.MsgForceUndelegate) (*types.MsgForceUndelegateResponse, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if msg.Amount != nil && (!msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive()) {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid shares amount",
+		)
+	}
+
+	completionTime, undelegated, err := k.Keeper.ForceUndelegate(ctx, msg.Authority, delegatorAddress, valAddr, msg.Amount, msg.Freeze, msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgForceUndelegateResponse{CompletionTime: completionTime, Amount: undelegated}, nil
+}
+
+// UnfreezeDelegation defines an operation of the forced undelegation authority
+// for unfreezing a delegation frozen by a forced undelegation
+func (k msgServer) UnfreezeDelegation(ctx context.Context, msg *types.MsgUnfreezeDelegation) (*types.MsgUnfreezeDelegationResponse, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if err := k.Keeper.UnfreezeDelegation(ctx, msg.Authority, delegatorAddress, valAddr, msg.Reason); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnfreezeDelegationResponse{}, nil
+}
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"bytes"
+	"context"
 	"testing"
 	"time"
 
@@ -1023,6 +1025,206 @@ func (s *KeeperTestSuite) TestMsgCancelUnbondingDelegation() {
 	}
 }
 
+func (s *KeeperTestSuite) TestMsgCreateValidatorAllowlist() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.ValidatorAllowlist = []string{sdk.ValAddress(PKs[1].Address()).String()}
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	comm := types.NewCommissionRates(math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDec(0))
+	msg, err := types.NewMsgCreateValidator(ValAddr.String(), PKs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000), types.Description{Moniker: "NewValidator"}, comm, math.OneInt())
+	require.NoError(err)
+
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.ErrorIs(err, types.ErrValidatorNotAllowlisted)
+
+	params.ValidatorAllowlist = append(params.ValidatorAllowlist, ValAddr.String())
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+}
+
+func (s *KeeperTestSuite) TestMsgDelegationPositions() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	comm := types.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	amt := sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: keeper.TokensFromConsensusPower(s.ctx, int64(100))}
+	msg, err := types.NewMsgCreateValidator(ValAddr.String(), PKS[0], amt, types.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	delAddr := sdk.AccAddress(PKS[1].Address())
+	recipient := sdk.AccAddress(PKS[2].Address())
+	require.NoError(keeper.SetDelegation(ctx, types.NewDelegation(delAddr.String(), ValAddr.String(), math.LegacyNewDec(100))))
+
+	// self-delegations cannot be tokenized
+	_, err = msgServer.TokenizeDelegation(ctx, types.NewMsgTokenizeDelegation(Addr.String(), ValAddr.String(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+	require.ErrorIs(err, types.ErrSelfDelegationPosition)
+
+	res, err := msgServer.TokenizeDelegation(ctx, types.NewMsgTokenizeDelegation(delAddr.String(), ValAddr.String(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 60)))
+	require.NoError(err)
+
+	position, err := keeper.DelegationPositions.Get(ctx, res.PositionId)
+	require.NoError(err)
+	require.Equal(delAddr.String(), position.Owner)
+	require.Equal(math.LegacyNewDec(60), position.Shares)
+
+	// locked shares cannot be tokenized twice nor unbonded
+	_, err = msgServer.TokenizeDelegation(ctx, types.NewMsgTokenizeDelegation(delAddr.String(), ValAddr.String(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)))
+	require.ErrorIs(err, types.ErrNotEnoughDelegationShares)
+	_, err = msgServer.Undelegate(ctx, types.NewMsgUndelegate(delAddr.String(), ValAddr.String(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)))
+	require.ErrorIs(err, types.ErrDelegationSharesLocked)
+
+	// only the owner can transfer the position
+	_, err = msgServer.TransferDelegationPosition(ctx, types.NewMsgTransferDelegationPosition(recipient.String(), res.PositionId, recipient.String()))
+	require.ErrorIs(err, types.ErrInvalidDelegationPosition)
+
+	_, err = msgServer.TransferDelegationPosition(ctx, types.NewMsgTransferDelegationPosition(delAddr.String(), res.PositionId, recipient.String()))
+	require.NoError(err)
+
+	delegation, err := keeper.Delegations.Get(ctx, collections.Join(delAddr, ValAddr))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(40), delegation.Shares)
+	delegation, err = keeper.Delegations.Get(ctx, collections.Join(recipient, ValAddr))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(60), delegation.Shares)
+
+	positions, err := keeper.GetOwnerDelegationPositions(ctx, recipient)
+	require.NoError(err)
+	require.Len(positions, 1)
+	require.Equal(recipient.String(), positions[0].Owner)
+
+	locked, err := keeper.GetLockedShares(ctx, delAddr, ValAddr)
+	require.NoError(err)
+	require.True(locked.IsZero())
+
+	// redeeming the position unlocks its shares
+	_, err = msgServer.RedeemDelegationPosition(ctx, types.NewMsgRedeemDelegationPosition(recipient.String(), res.PositionId))
+	require.NoError(err)
+
+	locked, err = keeper.GetLockedShares(ctx, recipient, ValAddr)
+	require.NoError(err)
+	require.True(locked.IsZero())
+	_, err = keeper.DelegationPositions.Get(ctx, res.PositionId)
+	require.ErrorIs(err, collections.ErrNotFound)
+}
+
+func (s *KeeperTestSuite) TestMsgTokenizeShares() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	comm := types.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	amt := sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: keeper.TokensFromConsensusPower(s.ctx, int64(100))}
+	msg, err := types.NewMsgCreateValidator(ValAddr.String(), PKS[0], amt, types.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	delAddr := sdk.AccAddress(PKS[1].Address())
+	owner := sdk.AccAddress(PKS[2].Address())
+	require.NoError(keeper.SetDelegation(ctx, types.NewDelegation(delAddr.String(), ValAddr.String(), math.LegacyNewDec(100))))
+
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), delAddr).Return(nil).AnyTimes()
+	s.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.BondedPoolName).Return(bondedAcc).AnyTimes()
+	s.accountKeeper.EXPECT().GetModuleAddress(types.ShareTokenPoolName).Return(authtypes.NewModuleAddress(types.ShareTokenPoolName)).AnyTimes()
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), bondedAcc.GetAddress(), sdk.DefaultBondDenom).Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)).AnyTimes()
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ShareTokenPoolName, gomock.Any()).Return(nil).AnyTimes()
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ShareTokenPoolName, delAddr, gomock.Any()).Return(nil).AnyTimes()
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), delAddr, types.ShareTokenPoolName, gomock.Any()).Return(nil).AnyTimes()
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), authtypes.NewModuleAddress(types.ShareTokenPoolName).Bytes(), gomock.Any()).Return(nil).AnyTimes()
+
+	// self-delegations cannot be tokenized
+	_, err = msgServer.TokenizeShares(ctx, types.NewMsgTokenizeShares(Addr.String(), ValAddr.String(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), ""))
+	require.ErrorIs(err, types.ErrSelfDelegationPosition)
+
+	// tokenizations exceeding the liquid staking caps are rejected
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(5, 1)
+	require.NoError(keeper.Params.Set(ctx, params))
+	_, err = msgServer.TokenizeShares(ctx, types.NewMsgTokenizeShares(delAddr.String(), ValAddr.String(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 60), owner.String()))
+	require.ErrorIs(err, types.ErrGlobalLiquidStakingCapExceeded)
+
+	validator, err := keeper.GetValidator(ctx, ValAddr)
+	require.NoError(err)
+	params.GlobalLiquidStakingCap = math.LegacyOneDec()
+	params.ValidatorLiquidStakingCap = math.LegacyNewDec(50).Quo(validator.DelegatorShares)
+	require.NoError(keeper.Params.Set(ctx, params))
+	_, err = msgServer.TokenizeShares(ctx, types.NewMsgTokenizeShares(delAddr.String(), ValAddr.String(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 60), owner.String()))
+	require.ErrorIs(err, types.ErrValidatorLiquidStakingCapExceeded)
+
+	params.ValidatorLiquidStakingCap = math.LegacyOneDec()
+	require.NoError(keeper.Params.Set(ctx, params))
+	res, err := msgServer.TokenizeShares(ctx, types.NewMsgTokenizeShares(delAddr.String(), ValAddr.String(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 60), owner.String()))
+	require.NoError(err)
+
+	record, err := keeper.TokenizeShareRecords.Get(ctx, res.RecordId)
+	require.NoError(err)
+	require.Equal(owner.String(), record.Owner)
+	require.Equal(sdk.NewInt64Coin(record.GetShareTokenDenom(), 60), res.Amount)
+
+	recordAddr := sdk.AccAddress(record.GetModuleAddress())
+	delegation, err := keeper.Delegations.Get(ctx, collections.Join(recordAddr, ValAddr))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(60), delegation.Shares)
+	delegation, err = keeper.Delegations.Get(ctx, collections.Join(delAddr, ValAddr))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(40), delegation.Shares)
+
+	liquid, err := s.queryClient.TotalLiquidStaked(ctx, &types.QueryTotalLiquidStakedRequest{ValidatorAddress: ValAddr.String()})
+	require.NoError(err)
+	require.Equal(math.NewInt(60), liquid.Tokens)
+	require.Equal(math.LegacyNewDec(60), liquid.ValidatorShares)
+
+	// only the owner can transfer the record
+	_, err = msgServer.TransferTokenizeShareRecord(ctx, types.NewMsgTransferTokenizeShareRecord(res.RecordId, delAddr.String(), delAddr.String()))
+	require.ErrorIs(err, types.ErrNotTokenizeShareRecordOwner)
+
+	_, err = msgServer.TransferTokenizeShareRecord(ctx, types.NewMsgTransferTokenizeShareRecord(res.RecordId, owner.String(), delAddr.String()))
+	require.NoError(err)
+
+	records, err := keeper.GetTokenizeShareRecordsByOwner(ctx, delAddr)
+	require.NoError(err)
+	require.Len(records, 1)
+	records, err = keeper.GetTokenizeShareRecordsByOwner(ctx, owner)
+	require.NoError(err)
+	require.Empty(records)
+
+	_, err = msgServer.RedeemTokensForShares(ctx, types.NewMsgRedeemTokensForShares(delAddr.String(), sdk.NewInt64Coin("unknown", 10)))
+	require.ErrorIs(err, types.ErrTokenizeShareRecordNotExists)
+
+	redeemRes, err := msgServer.RedeemTokensForShares(ctx, types.NewMsgRedeemTokensForShares(delAddr.String(), sdk.NewInt64Coin(record.GetShareTokenDenom(), 20)))
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20), redeemRes.Amount)
+
+	delegation, err = keeper.Delegations.Get(ctx, collections.Join(delAddr, ValAddr))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(60), delegation.Shares)
+	liquidShares, err := keeper.GetValidatorLiquidShares(ctx, ValAddr)
+	require.NoError(err)
+	require.Equal(math.NewInt(40), liquidShares)
+
+	// redeeming all the shares removes the record
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), recordAddr).Return(sdk.NewCoins())
+	_, err = msgServer.RedeemTokensForShares(ctx, types.NewMsgRedeemTokensForShares(delAddr.String(), sdk.NewInt64Coin(record.GetShareTokenDenom(), 40)))
+	require.NoError(err)
+
+	_, err = keeper.TokenizeShareRecords.Get(ctx, res.RecordId)
+	require.ErrorIs(err, collections.ErrNotFound)
+	liquidShares, err = keeper.GetValidatorLiquidShares(ctx, ValAddr)
+	require.NoError(err)
+	require.True(liquidShares.IsZero())
+}
+
 func (s *KeeperTestSuite) TestMsgUpdateParams() {
 	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
 	require := s.Require()
@@ -1172,6 +1374,40 @@ func (s *KeeperTestSuite) TestMsgUpdateParams() {
 			},
 			expErrMsg: "unbonding time must be positive",
 		},
+		{
+			name: "duplicate validator allowlist address",
+			input: &types.MsgUpdateParams{
+				Authority: keeper.GetAuthority(),
+				Params: types.Params{
+					UnbondingTime:      types.DefaultUnbondingTime,
+					MaxEntries:         types.DefaultMaxEntries,
+					MaxValidators:      types.DefaultMaxValidators,
+					HistoricalEntries:  types.DefaultHistoricalEntries,
+					MinCommissionRate:  types.DefaultMinCommissionRate,
+					BondDenom:          "denom",
+					KeyRotationFee:     types.DefaultParams().KeyRotationFee,
+					ValidatorAllowlist: []string{ValAddr.String(), ValAddr.String()},
+				},
+			},
+			expErrMsg: "duplicate validator allowlist address",
+		},
+		{
+			name: "invalid validator allowlist address",
+			input: &types.MsgUpdateParams{
+				Authority: keeper.GetAuthority(),
+				Params: types.Params{
+					UnbondingTime:      types.DefaultUnbondingTime,
+					MaxEntries:         types.DefaultMaxEntries,
+					MaxValidators:      types.DefaultMaxValidators,
+					HistoricalEntries:  types.DefaultHistoricalEntries,
+					MinCommissionRate:  types.DefaultMinCommissionRate,
+					BondDenom:          "denom",
+					KeyRotationFee:     types.DefaultParams().KeyRotationFee,
+					ValidatorAllowlist: []string{Addr.String()},
+				},
+			},
+			expErrMsg: "invalid validator allowlist address",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1378,3 +1614,318 @@ func (s *KeeperTestSuite) TestConsKeyRotn() {
 		})
 	}
 }
+
+// mockRewardsWithdrawer pays fixed rewards to the delegator on each withdrawal.
+type mockRewardsWithdrawer struct {
+	withdrawals int
+}
+
+func (m *mockRewardsWithdrawer) WithdrawDelegationRewards(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress) (sdk.Coins, error) {
+	m.withdrawals++
+	return nil, nil
+}
+
+func (s *KeeperTestSuite) TestMsgSetAutoCompound() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	comm := types.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	amt := sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: keeper.TokensFromConsensusPower(s.ctx, int64(100))}
+	msg, err := types.NewMsgCreateValidator(ValAddr.String(), PKS[0], amt, types.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	delAddr := sdk.AccAddress(PKS[1].Address())
+	otherDelAddr := sdk.AccAddress(PKS[2].Address())
+	require.NoError(keeper.SetDelegation(ctx, types.NewDelegation(delAddr.String(), ValAddr.String(), math.LegacyNewDec(100))))
+	require.NoError(keeper.SetDelegation(ctx, types.NewDelegation(otherDelAddr.String(), ValAddr.String(), math.LegacyNewDec(100))))
+
+	// only existing delegations can be opted in
+	_, err = msgServer.SetAutoCompound(ctx, types.NewMsgSetAutoCompound(delAddr.String(), sdk.ValAddress(PKS[2].Address()).String(), true))
+	require.ErrorIs(err, types.ErrNoDelegation)
+
+	_, err = msgServer.SetAutoCompound(ctx, types.NewMsgSetAutoCompound(delAddr.String(), ValAddr.String(), true))
+	require.NoError(err)
+	_, err = msgServer.SetAutoCompound(ctx, types.NewMsgSetAutoCompound(otherDelAddr.String(), ValAddr.String(), true))
+	require.NoError(err)
+
+	res, err := s.queryClient.DelegatorAutoCompoundSettings(ctx, &types.QueryDelegatorAutoCompoundSettingsRequest{DelegatorAddr: delAddr.String()})
+	require.NoError(err)
+	require.Equal([]types.AutoCompoundSetting{{DelegatorAddress: delAddr.String(), ValidatorAddress: ValAddr.String()}}, res.Settings)
+
+	// auto-compounding is a no-op until the rewards withdrawer is set
+	require.NoError(keeper.ProcessAutoCompounds(ctx))
+
+	withdrawer := &mockRewardsWithdrawer{}
+	keeper.SetRewardsWithdrawer(withdrawer)
+
+	// a single delegation is processed per block
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.MaxAutoCompoundsPerBlock = 1
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	// the delegations are processed in key order, the rewards being measured on the delegator balance
+	first, second := delAddr, otherDelAddr
+	if bytes.Compare(first, second) > 0 {
+		first, second = second, first
+	}
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), first, sdk.DefaultBondDenom).Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)).Times(1)
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), first, sdk.DefaultBondDenom).Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5000)).Times(1)
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), first, types.NotBondedPoolName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5000))).Return(nil).Times(1)
+	require.NoError(keeper.ProcessAutoCompounds(ctx))
+	require.Equal(1, withdrawer.withdrawals)
+
+	delegation, err := keeper.Delegations.Get(ctx, collections.Join(first, ValAddr))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(5100), delegation.Shares)
+
+	cursor, err := keeper.AutoCompoundCursor.Get(ctx)
+	require.NoError(err)
+	require.Equal(collections.Join(second, ValAddr), cursor)
+
+	// rewards below the threshold are not compounded
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), second, sdk.DefaultBondDenom).Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)).Times(1)
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), second, sdk.DefaultBondDenom).Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)).Times(1)
+	require.NoError(keeper.ProcessAutoCompounds(ctx))
+	require.Equal(2, withdrawer.withdrawals)
+
+	delegation, err = keeper.Delegations.Get(ctx, collections.Join(second, ValAddr))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(100), delegation.Shares)
+
+	// the processing wraps around once all the delegations were processed
+	_, err = keeper.AutoCompoundCursor.Get(ctx)
+	require.ErrorIs(err, collections.ErrNotFound)
+
+	// settings of removed delegations are cleaned up
+	delegation, err = keeper.Delegations.Get(ctx, collections.Join(first, ValAddr))
+	require.NoError(err)
+	require.NoError(keeper.RemoveDelegation(ctx, delegation))
+	require.NoError(keeper.ProcessAutoCompounds(ctx))
+	has, err := keeper.AutoCompoundSettings.Has(ctx, collections.Join(first, ValAddr))
+	require.NoError(err)
+	require.False(has)
+
+	_, err = msgServer.SetAutoCompound(ctx, types.NewMsgSetAutoCompound(second.String(), ValAddr.String(), false))
+	require.NoError(err)
+	valAddrs, err := keeper.GetDelegatorAutoCompoundSettings(ctx, second)
+	require.NoError(err)
+	require.Empty(valAddrs)
+}
+
+func (s *KeeperTestSuite) TestMsgScheduleCommissionChange() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	comm := types.NewCommissionRates(math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(5, 1), math.LegacyNewDecWithPrec(1, 1))
+	amt := sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: keeper.TokensFromConsensusPower(s.ctx, int64(100))}
+	msg, err := types.NewMsgCreateValidator(ValAddr.String(), PKS[0], amt, types.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	// the change rules of the commission apply when scheduling
+	_, err = msgServer.ScheduleCommissionChange(ctx, types.NewMsgScheduleCommissionChange(ValAddr.String(), math.LegacyNewDecWithPrec(3, 1)))
+	require.ErrorIs(err, types.ErrCommissionGTMaxChangeRate)
+	_, err = msgServer.ScheduleCommissionChange(ctx, types.NewMsgScheduleCommissionChange(sdk.ValAddress(PKS[1].Address()).String(), math.LegacyNewDecWithPrec(2, 1)))
+	require.ErrorIs(err, types.ErrNoValidatorFound)
+
+	// the commission can be scheduled within 24 hours of the creation of the validator
+	res, err := msgServer.ScheduleCommissionChange(ctx, types.NewMsgScheduleCommissionChange(ValAddr.String(), math.LegacyNewDecWithPrec(2, 1)))
+	require.NoError(err)
+	require.Equal(ctx.HeaderInfo().Time.Add(types.DefaultCommissionChangeNoticePeriod), res.EffectiveTime)
+
+	queryRes, err := s.queryClient.ScheduledCommissionChange(ctx, &types.QueryScheduledCommissionChangeRequest{ValidatorAddr: ValAddr.String()})
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecWithPrec(2, 1), queryRes.Change.NewRate)
+
+	_, err = msgServer.CancelCommissionChange(ctx, types.NewMsgCancelCommissionChange(ValAddr.String()))
+	require.NoError(err)
+	_, err = msgServer.CancelCommissionChange(ctx, types.NewMsgCancelCommissionChange(ValAddr.String()))
+	require.ErrorIs(err, types.ErrNoScheduledCommissionChange)
+	_, err = s.queryClient.ScheduledCommissionChange(ctx, &types.QueryScheduledCommissionChangeRequest{ValidatorAddr: ValAddr.String()})
+	require.ErrorContains(err, "no scheduled commission change")
+
+	// scheduling again replaces the pending change
+	_, err = msgServer.ScheduleCommissionChange(ctx, types.NewMsgScheduleCommissionChange(ValAddr.String(), math.LegacyNewDecWithPrec(15, 2)))
+	require.NoError(err)
+	ctx = ctx.WithHeaderInfo(header.Info{Time: ctx.HeaderInfo().Time.Add(time.Hour)})
+	res, err = msgServer.ScheduleCommissionChange(ctx, types.NewMsgScheduleCommissionChange(ValAddr.String(), math.LegacyNewDecWithPrec(2, 1)))
+	require.NoError(err)
+
+	// the change is not applied before its effective time
+	require.NoError(keeper.ApplyScheduledCommissionChanges(ctx.WithHeaderInfo(header.Info{Time: res.EffectiveTime.Add(-time.Second)})))
+	validator, err := keeper.GetValidator(ctx, ValAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecWithPrec(1, 1), validator.Commission.Rate)
+
+	ctx = ctx.WithHeaderInfo(header.Info{Time: res.EffectiveTime})
+	require.NoError(keeper.ApplyScheduledCommissionChanges(ctx))
+	validator, err = keeper.GetValidator(ctx, ValAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecWithPrec(2, 1), validator.Commission.Rate)
+	require.Equal(res.EffectiveTime, validator.Commission.UpdateTime)
+
+	// a change no longer valid when its effective time is reached is dropped
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.CommissionChangeNoticePeriod = time.Hour
+	require.NoError(keeper.Params.Set(ctx, params))
+	res, err = msgServer.ScheduleCommissionChange(ctx, types.NewMsgScheduleCommissionChange(ValAddr.String(), math.LegacyNewDecWithPrec(25, 2)))
+	require.NoError(err)
+	require.NoError(keeper.ApplyScheduledCommissionChanges(ctx.WithHeaderInfo(header.Info{Time: res.EffectiveTime})))
+	validator, err = keeper.GetValidator(ctx, ValAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecWithPrec(2, 1), validator.Commission.Rate)
+	has, err := keeper.ScheduledCommissionChanges.Has(ctx, ValAddr)
+	require.NoError(err)
+	require.False(has)
+}
+
+// mockCommunityPoolFunder records the funds sent to the community pool.
+type mockCommunityPoolFunder struct {
+	funded sdk.Coins
+	sender sdk.AccAddress
+}
+
+func (m *mockCommunityPoolFunder) FundCommunityPool(_ context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	m.funded = m.funded.Add(amount...)
+	m.sender = sender
+	return nil
+}
+
+func (s *KeeperTestSuite) TestMsgFlashUndelegate() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	comm := types.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	amt := sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: math.NewInt(100_000_000)}
+	msg, err := types.NewMsgCreateValidator(ValAddr.String(), PKS[0], amt, types.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	flashUndelegate := func(amount int64) (*types.MsgFlashUndelegateResponse, error) {
+		return msgServer.FlashUndelegate(ctx, types.NewMsgFlashUndelegate(Addr.String(), ValAddr.String(), sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)))
+	}
+
+	// flash undelegations are disabled by default
+	_, err = flashUndelegate(1_000_000)
+	require.ErrorIs(err, types.ErrFlashUndelegationDisabled)
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.MaxFlashUndelegationRate = math.LegacyNewDecWithPrec(1, 1)
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	// and until the community pool funder is set
+	_, err = flashUndelegate(1_000_000)
+	require.ErrorIs(err, types.ErrFlashUndelegationDisabled)
+
+	funder := &mockCommunityPoolFunder{}
+	keeper.SetCommunityPoolFunder(funder)
+
+	_, err = msgServer.FlashUndelegate(ctx, types.NewMsgFlashUndelegate(Addr.String(), ValAddr.String(), sdk.NewInt64Coin("foo", 1_000_000)))
+	require.ErrorContains(err, "invalid coin denomination")
+
+	// the penalty is sent to the community pool from the not bonded pool
+	notBondedPool := authtypes.NewModuleAddress(types.NotBondedPoolName)
+	s.accountKeeper.EXPECT().GetModuleAddress(types.NotBondedPoolName).Return(notBondedPool).AnyTimes()
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), types.NotBondedPoolName, Addr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5_400_000))).Return(nil).Times(1)
+	res, err := flashUndelegate(6_000_000)
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5_400_000), res.Amount)
+	require.Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 600_000), res.Penalty)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 600_000)), funder.funded)
+	require.Equal(notBondedPool, funder.sender)
+
+	validator, err := keeper.GetValidator(ctx, ValAddr)
+	require.NoError(err)
+	require.Equal(math.NewInt(94_000_000), validator.Tokens)
+
+	// the quota is 10% of the tokens of the validator at the start of the block
+	_, err = flashUndelegate(5_000_000)
+	require.ErrorIs(err, types.ErrFlashUndelegationQuotaExceeded)
+
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), types.NotBondedPoolName, Addr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 3_600_000))).Return(nil).Times(1)
+	_, err = flashUndelegate(4_000_000)
+	require.NoError(err)
+
+	undelegated, err := keeper.FlashUndelegatedAmounts.Get(ctx, ValAddr)
+	require.NoError(err)
+	require.Equal(math.NewInt(10_000_000), undelegated)
+
+	// the quota is reset for the next block
+	require.NoError(keeper.FlashUndelegatedAmounts.Clear(ctx, nil))
+
+	// jailed validators may still be slashed for their infractions, so their
+	// delegations cannot be flash undelegated
+	validator, err = keeper.GetValidator(ctx, ValAddr)
+	require.NoError(err)
+	validator.Jailed = true
+	require.NoError(keeper.SetValidator(ctx, validator))
+	_, err = flashUndelegate(1_000_000)
+	require.ErrorIs(err, types.ErrValidatorJailed)
+}
+
+func (s *KeeperTestSuite) TestMsgExecStakingOperations() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	comm := types.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	msg, err := types.NewMsgCreateValidator(ValAddr.String(), PKS[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), types.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	_, err = msgServer.ExecStakingOperations(ctx, &types.MsgExecStakingOperations{DelegatorAddress: Addr.String()})
+	require.ErrorContains(err, "staking operations cannot be empty")
+
+	delegate := types.StakingOperation{
+		OperationType:    types.StakingOperationTypeDelegate,
+		ValidatorAddress: ValAddr.String(),
+		Amount:           sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
+	}
+	restake := types.StakingOperation{
+		OperationType:    types.StakingOperationTypeRestakeRewards,
+		ValidatorAddress: ValAddr.String(),
+	}
+
+	// restaking is unavailable until the rewards withdrawer is set, failing the
+	// whole batch, whose state changes are discarded with the transaction
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = msgServer.ExecStakingOperations(cacheCtx, &types.MsgExecStakingOperations{
+		DelegatorAddress: Addr.String(),
+		Operations:       []types.StakingOperation{delegate, restake},
+	})
+	require.ErrorIs(err, types.ErrRestakeUnavailable)
+
+	withdrawer := &mockRewardsWithdrawer{}
+	keeper.SetRewardsWithdrawer(withdrawer)
+
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), Addr, sdk.DefaultBondDenom).Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)).Times(1)
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), Addr, sdk.DefaultBondDenom).Return(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500)).Times(1)
+	res, err := msgServer.ExecStakingOperations(ctx, &types.MsgExecStakingOperations{
+		DelegatorAddress: Addr.String(),
+		Operations:       []types.StakingOperation{delegate, restake},
+	})
+	require.NoError(err)
+	require.Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500), res.RestakedAmount)
+	require.Equal(1, withdrawer.withdrawals)
+
+	delegation, err := keeper.Delegations.Get(ctx, collections.Join(Addr, ValAddr))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDec(1600), delegation.Shares)
+
+	_, err = msgServer.ExecStakingOperations(ctx, &types.MsgExecStakingOperations{
+		DelegatorAddress: Addr.String(),
+		Operations:       []types.StakingOperation{{ValidatorAddress: ValAddr.String()}},
+	})
+	require.ErrorContains(err, "unknown staking operation type")
+}
@@ -0,0 +1,485 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetBondableDenom registers or updates a bondable denom other than the bond
+// denom with the weight its tokens count as towards the voting power, a zero
+// weight removing it. The tokens of the denom already delegated remain so, and
+// the voting power of their validators is updated to the new weight.
+func (k Keeper) SetBondableDenom(ctx context.Context, denom string, weight math.LegacyDec) error {
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	if weight.IsNil() || weight.IsZero() {
+		has, err := k.BondableDenoms.Has(ctx, denom)
+		if err != nil {
+			return err
+		}
+		if !has {
+			return types.ErrDenomNotBondable.Wrap(denom)
+		}
+
+		if err := k.BondableDenoms.Remove(ctx, denom); err != nil {
+			return err
+		}
+	} else {
+		if err := types.ValidateBondableDenom(denom, weight, bondDenom); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+
+		if err := k.BondableDenoms.Set(ctx, denom, types.BondableDenom{Denom: denom, Weight: weight}); err != nil {
+			return err
+		}
+	}
+
+	var valAddrs []sdk.ValAddress
+	rng := collections.NewPrefixedPairRange[string, sdk.ValAddress](denom)
+	err = k.MultiAssetPoolByDenomIndex.Walk(ctx, rng, func(key collections.Pair[string, sdk.ValAddress]) (bool, error) {
+		valAddrs = append(valAddrs, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, valAddr := range valAddrs {
+		if _, err := k.refreshMultiAssetTokens(ctx, valAddr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetBondableDenomWeight returns the weight of a bondable denom other than the
+// bond denom.
+func (k Keeper) GetBondableDenomWeight(ctx context.Context, denom string) (math.LegacyDec, error) {
+	bondable, err := k.BondableDenoms.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.LegacyDec{}, types.ErrDenomNotBondable.Wrap(denom)
+	} else if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return bondable.Weight, nil
+}
+
+// GetValidatorMultiAssetPools returns the tokens of the bondable denoms other
+// than the bond denom delegated to a validator.
+func (k Keeper) GetValidatorMultiAssetPools(ctx context.Context, valAddr sdk.ValAddress) ([]types.MultiAssetPool, error) {
+	var pools []types.MultiAssetPool
+	rng := collections.NewPrefixedPairRange[sdk.ValAddress, string](valAddr)
+	err := k.MultiAssetPools.Walk(ctx, rng, func(_ collections.Pair[sdk.ValAddress, string], pool types.MultiAssetPool) (bool, error) {
+		pools = append(pools, pool)
+		return false, nil
+	})
+
+	return pools, err
+}
+
+// DelegateMultiAsset delegates tokens of a bondable denom other than the bond
+// denom to a validator, and returns the shares of the pool of the denom of the
+// validator issued to the delegator.
+func (k Keeper) DelegateMultiAsset(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) (math.LegacyDec, error) {
+	if _, err := k.GetBondableDenomWeight(ctx, amount.Denom); err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	pool, err := k.MultiAssetPools.Get(ctx, collections.Join(valAddr, amount.Denom))
+	if errors.Is(err, collections.ErrNotFound) {
+		pool = types.NewMultiAssetPool(validator.OperatorAddress, amount.Denom)
+	} else if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	pool, issuedShares := pool.AddTokens(amount.Amount)
+	if err := k.setMultiAssetPool(ctx, valAddr, pool); err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	delKey := collections.Join3(delAddr, valAddr, amount.Denom)
+	delegation, err := k.MultiAssetDelegations.Get(ctx, delKey)
+	if errors.Is(err, collections.ErrNotFound) {
+		delStr, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+
+		delegation = types.MultiAssetDelegation{
+			DelegatorAddress: delStr,
+			ValidatorAddress: validator.OperatorAddress,
+			Denom:            amount.Denom,
+			Shares:           math.LegacyZeroDec(),
+		}
+	} else if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	delegation.Shares = delegation.Shares.Add(issuedShares)
+	if err := k.MultiAssetDelegations.Set(ctx, delKey, delegation); err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if _, err := k.refreshMultiAssetTokens(ctx, valAddr); err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return issuedShares, nil
+}
+
+// UndelegateMultiAsset undelegates tokens of a bondable denom other than the
+// bond denom from a validator. The tokens are released to the delegator after
+// the unbonding period, or immediately if the validator is unbonded or has been
+// removed. It returns the completion time of the unbonding and the undelegated
+// tokens.
+func (k Keeper) UndelegateMultiAsset(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin) (time.Time, sdk.Coin, error) {
+	delKey := collections.Join3(delAddr, valAddr, amount.Denom)
+	delegation, err := k.MultiAssetDelegations.Get(ctx, delKey)
+	if errors.Is(err, collections.ErrNotFound) {
+		return time.Time{}, sdk.Coin{}, types.ErrNoDelegation
+	} else if err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	poolKey := collections.Join(valAddr, amount.Denom)
+	pool, err := k.MultiAssetPools.Get(ctx, poolKey)
+	if err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	shares, err := pool.SharesFromTokens(amount.Amount)
+	if err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+	if shares.GT(delegation.Shares) {
+		return time.Time{}, sdk.Coin{}, types.ErrNotEnoughDelegationShares.Wrapf("%s > %s", shares, delegation.Shares)
+	}
+
+	pool, tokens := pool.RemoveShares(shares)
+	if pool.Shares.IsZero() {
+		err = k.removeMultiAssetPool(ctx, valAddr, amount.Denom)
+	} else {
+		err = k.setMultiAssetPool(ctx, valAddr, pool)
+	}
+	if err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	delegation.Shares = delegation.Shares.Sub(shares)
+	if delegation.Shares.IsZero() {
+		err = k.MultiAssetDelegations.Remove(ctx, delKey)
+	} else {
+		err = k.MultiAssetDelegations.Set(ctx, delKey, delegation)
+	}
+	if err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	validator, err := k.refreshMultiAssetTokens(ctx, valAddr)
+	if err != nil && !errors.Is(err, types.ErrNoValidatorFound) {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	headerInfo := k.environment.HeaderService.GetHeaderInfo(ctx)
+	balance := sdk.NewCoin(amount.Denom, tokens)
+	if errors.Is(err, types.ErrNoValidatorFound) || validator.IsUnbonded() {
+		if balance.IsPositive() {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(balance)); err != nil {
+				return time.Time{}, sdk.Coin{}, err
+			}
+		}
+
+		return headerInfo.Time, balance, nil
+	}
+
+	unbondingTime, err := k.UnbondingTime(ctx)
+	if err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	id, err := k.MultiAssetUnbondingSequence.Next(ctx)
+	if err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	completionTime := headerInfo.Time.Add(unbondingTime)
+	unbonding := types.MultiAssetUnbonding{
+		Id:               id,
+		DelegatorAddress: delegation.DelegatorAddress,
+		ValidatorAddress: delegation.ValidatorAddress,
+		Balance:          balance,
+		CreationHeight:   headerInfo.Height,
+		CompletionTime:   completionTime,
+	}
+	if err := k.setMultiAssetUnbonding(ctx, valAddr, unbonding); err != nil {
+		return time.Time{}, sdk.Coin{}, err
+	}
+
+	return completionTime, balance, nil
+}
+
+// CompleteMatureMultiAssetUnbondings releases the tokens of the multi-asset
+// unbondings whose completion time has been reached to their delegators.
+func (k Keeper) CompleteMatureMultiAssetUnbondings(ctx context.Context) error {
+	blockTime := k.environment.HeaderService.GetHeaderInfo(ctx).Time
+
+	var matured []types.MultiAssetUnbonding
+	err := k.MultiAssetUnbondingQueue.Walk(ctx, nil, func(key collections.Pair[time.Time, uint64], unbonding types.MultiAssetUnbonding) (bool, error) {
+		if key.K1().After(blockTime) {
+			return true, nil
+		}
+
+		matured = append(matured, unbonding)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, unbonding := range matured {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(unbonding.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+
+		if err := k.removeMultiAssetUnbonding(ctx, valAddr, unbonding); err != nil {
+			return err
+		}
+
+		if !unbonding.Balance.IsPositive() {
+			continue
+		}
+
+		delAddr, err := k.authKeeper.AddressCodec().StringToBytes(unbonding.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(unbonding.Balance)); err != nil {
+			return err
+		}
+
+		if err := k.environment.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeCompleteUnbonding,
+			event.NewAttribute(sdk.AttributeKeyAmount, unbonding.Balance.String()),
+			event.NewAttribute(types.AttributeKeyValidator, unbonding.ValidatorAddress),
+			event.NewAttribute(types.AttributeKeyDelegator, unbonding.DelegatorAddress),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// slashMultiAsset slashes by the slash factor the tokens of the bondable denoms
+// delegated to a validator, and those unbonding from it since the infraction
+// height, burning them. It returns the validator with its updated multi-asset
+// tokens, along with the bond denom equivalent of the slashed tokens.
+func (k Keeper) slashMultiAsset(ctx context.Context, validator types.Validator, infractionHeight int64, slashFactor math.LegacyDec) (types.Validator, math.Int, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+	if err != nil {
+		return validator, math.ZeroInt(), err
+	}
+
+	burned := sdk.NewCoins()
+	pools, err := k.GetValidatorMultiAssetPools(ctx, valAddr)
+	if err != nil {
+		return validator, math.ZeroInt(), err
+	}
+
+	for _, pool := range pools {
+		amount := math.LegacyNewDecFromInt(pool.Tokens).Mul(slashFactor).TruncateInt()
+		if !amount.IsPositive() {
+			continue
+		}
+
+		pool.Tokens = pool.Tokens.Sub(amount)
+		if err := k.setMultiAssetPool(ctx, valAddr, pool); err != nil {
+			return validator, math.ZeroInt(), err
+		}
+		burned = burned.Add(sdk.NewCoin(pool.Denom, amount))
+	}
+
+	var unbondings []types.MultiAssetUnbonding
+	rng := collections.NewPrefixedTripleRange[sdk.ValAddress, time.Time, uint64](valAddr)
+	err = k.MultiAssetUnbondingByValIndex.Walk(ctx, rng, func(key collections.Triple[sdk.ValAddress, time.Time, uint64]) (bool, error) {
+		unbonding, err := k.MultiAssetUnbondingQueue.Get(ctx, collections.Join(key.K2(), key.K3()))
+		if err != nil {
+			return true, err
+		}
+
+		if unbonding.CreationHeight >= infractionHeight {
+			unbondings = append(unbondings, unbonding)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return validator, math.ZeroInt(), err
+	}
+
+	for _, unbonding := range unbondings {
+		amount := math.LegacyNewDecFromInt(unbonding.Balance.Amount).Mul(slashFactor).TruncateInt()
+		amount = math.MinInt(amount, unbonding.Balance.Amount)
+		if !amount.IsPositive() {
+			continue
+		}
+
+		unbonding.Balance.Amount = unbonding.Balance.Amount.Sub(amount)
+		if err := k.MultiAssetUnbondingQueue.Set(ctx, collections.Join(unbonding.CompletionTime, unbonding.Id), unbonding); err != nil {
+			return validator, math.ZeroInt(), err
+		}
+		burned = burned.Add(sdk.NewCoin(unbonding.Balance.Denom, amount))
+	}
+
+	if burned.IsZero() {
+		return validator, math.ZeroInt(), nil
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, k.authKeeper.GetModuleAddress(types.ModuleName), burned); err != nil {
+		return validator, math.ZeroInt(), err
+	}
+
+	slashed, err := k.weightedMultiAssetTokens(ctx, burned)
+	if err != nil {
+		return validator, math.ZeroInt(), err
+	}
+
+	validator, err = k.refreshMultiAssetTokens(ctx, valAddr)
+	if err != nil {
+		return validator, math.ZeroInt(), err
+	}
+
+	return validator, slashed, nil
+}
+
+// setMultiAssetPool sets the multi-asset pool of a validator, indexing it by
+// denom.
+func (k Keeper) setMultiAssetPool(ctx context.Context, valAddr sdk.ValAddress, pool types.MultiAssetPool) error {
+	if err := k.MultiAssetPools.Set(ctx, collections.Join(valAddr, pool.Denom), pool); err != nil {
+		return err
+	}
+
+	return k.MultiAssetPoolByDenomIndex.Set(ctx, collections.Join(pool.Denom, valAddr))
+}
+
+// removeMultiAssetPool removes the multi-asset pool of a validator for a denom,
+// along with its index.
+func (k Keeper) removeMultiAssetPool(ctx context.Context, valAddr sdk.ValAddress, denom string) error {
+	if err := k.MultiAssetPools.Remove(ctx, collections.Join(valAddr, denom)); err != nil {
+		return err
+	}
+
+	return k.MultiAssetPoolByDenomIndex.Remove(ctx, collections.Join(denom, valAddr))
+}
+
+// setMultiAssetUnbonding sets a multi-asset unbonding in the queue, indexing it
+// by validator.
+func (k Keeper) setMultiAssetUnbonding(ctx context.Context, valAddr sdk.ValAddress, unbonding types.MultiAssetUnbonding) error {
+	if err := k.MultiAssetUnbondingQueue.Set(ctx, collections.Join(unbonding.CompletionTime, unbonding.Id), unbonding); err != nil {
+		return err
+	}
+
+	return k.MultiAssetUnbondingByValIndex.Set(ctx, collections.Join3(valAddr, unbonding.CompletionTime, unbonding.Id))
+}
+
+// removeMultiAssetUnbonding removes a multi-asset unbonding from the queue,
+// along with its index.
+func (k Keeper) removeMultiAssetUnbonding(ctx context.Context, valAddr sdk.ValAddress, unbonding types.MultiAssetUnbonding) error {
+	if err := k.MultiAssetUnbondingQueue.Remove(ctx, collections.Join(unbonding.CompletionTime, unbonding.Id)); err != nil {
+		return err
+	}
+
+	return k.MultiAssetUnbondingByValIndex.Remove(ctx, collections.Join3(valAddr, unbonding.CompletionTime, unbonding.Id))
+}
+
+// weightedMultiAssetTokens returns the bond denom equivalent of tokens of the
+// bondable denoms, the tokens of the denoms no longer bondable counting for
+// nothing.
+func (k Keeper) weightedMultiAssetTokens(ctx context.Context, coins sdk.Coins) (math.Int, error) {
+	total := math.LegacyZeroDec()
+	for _, coin := range coins {
+		weight, err := k.GetBondableDenomWeight(ctx, coin.Denom)
+		if errors.Is(err, types.ErrDenomNotBondable) {
+			continue
+		} else if err != nil {
+			return math.ZeroInt(), err
+		}
+
+		total = total.Add(weight.MulInt(coin.Amount))
+	}
+
+	return total.TruncateInt(), nil
+}
+
+// refreshMultiAssetTokens recomputes the multi-asset tokens of a validator from
+// its pools and the current weights, updating its position in the power index.
+func (k Keeper) refreshMultiAssetTokens(ctx context.Context, valAddr sdk.ValAddress) (types.Validator, error) {
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return validator, err
+	}
+
+	pools, err := k.GetValidatorMultiAssetPools(ctx, valAddr)
+	if err != nil {
+		return validator, err
+	}
+
+	coins := sdk.NewCoins()
+	for _, pool := range pools {
+		coins = coins.Add(sdk.NewCoin(pool.Denom, pool.Tokens))
+	}
+
+	tokens, err := k.weightedMultiAssetTokens(ctx, coins)
+	if err != nil {
+		return validator, err
+	}
+
+	if tokens.Equal(validator.GetMultiAssetTokens()) {
+		return validator, nil
+	}
+
+	if err := k.DeleteValidatorByPowerIndex(ctx, validator); err != nil {
+		return validator, err
+	}
+
+	if tokens.IsZero() {
+		validator.MultiAssetTokens = nil
+	} else {
+		validator.MultiAssetTokens = &tokens
+	}
+
+	if err := k.SetValidator(ctx, validator); err != nil {
+		return validator, err
+	}
+
+	if err := k.SetValidatorByPowerIndex(ctx, validator); err != nil {
+		return validator, err
+	}
+
+	return validator, nil
+}
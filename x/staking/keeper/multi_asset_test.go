@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/staking/testutil"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestMultiAssetStaking() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 10, Time: ctx.HeaderInfo().Time})
+	stakingAcc := authtypes.NewEmptyModuleAccount(types.ModuleName)
+	s.accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(stakingAcc.GetAddress()).AnyTimes()
+	s.accountKeeper.EXPECT().GetModuleAddress(types.BondedPoolName).Return(bondedAcc.GetAddress()).AnyTimes()
+
+	// the bond denom and the other denoms are registered by the authority only
+	_, err := msgServer.SetBondableDenom(ctx, &types.MsgSetBondableDenom{Authority: Addr.String(), Denom: "uatom", Weight: math.LegacyOneDec()})
+	require.ErrorIs(err, types.ErrInvalidSigner)
+	_, err = msgServer.SetBondableDenom(ctx, &types.MsgSetBondableDenom{Authority: keeper.GetAuthority(), Denom: sdk.DefaultBondDenom, Weight: math.LegacyOneDec()})
+	require.ErrorContains(err, "cannot be the bond denom")
+	_, err = msgServer.SetBondableDenom(ctx, &types.MsgSetBondableDenom{Authority: keeper.GetAuthority(), Denom: "uatom", Weight: math.LegacyNewDecWithPrec(5, 1)})
+	require.NoError(err)
+
+	validator := testutil.NewValidator(s.T(), ValAddr, PKs[0])
+	validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 10))
+	validator = validator.UpdateStatus(types.Bonded)
+	require.NoError(keeper.SetValidator(ctx, validator))
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, validator))
+	require.NoError(keeper.SetValidatorByPowerIndex(ctx, validator))
+
+	_, err = msgServer.DelegateMultiAsset(ctx, types.NewMsgDelegateMultiAsset(Addr.String(), ValAddr.String(), sdk.NewInt64Coin("uosmo", 1000)))
+	require.ErrorIs(err, types.ErrDenomNotBondable)
+
+	// the tokens count for half the bond denom towards the voting power
+	amount := sdk.NewCoin("uatom", keeper.TokensFromConsensusPower(ctx, 20))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), Addr, types.ModuleName, sdk.NewCoins(amount)).Return(nil)
+	res, err := msgServer.DelegateMultiAsset(ctx, types.NewMsgDelegateMultiAsset(Addr.String(), ValAddr.String(), amount))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecFromInt(amount.Amount), res.Shares)
+
+	validator, err = keeper.GetValidator(ctx, ValAddr)
+	require.NoError(err)
+	require.Equal(keeper.TokensFromConsensusPower(ctx, 10), validator.GetMultiAssetTokens())
+	require.Equal(int64(20), validator.ConsensusPower(keeper.PowerReduction(ctx)))
+
+	// a weight change updates the voting power of the validator
+	_, err = msgServer.SetBondableDenom(ctx, &types.MsgSetBondableDenom{Authority: keeper.GetAuthority(), Denom: "uatom", Weight: math.LegacyOneDec()})
+	require.NoError(err)
+	validator, err = keeper.GetValidator(ctx, ValAddr)
+	require.NoError(err)
+	require.Equal(int64(30), validator.ConsensusPower(keeper.PowerReduction(ctx)))
+
+	undelegateRes, err := msgServer.UndelegateMultiAsset(ctx, types.NewMsgUndelegateMultiAsset(Addr.String(), ValAddr.String(), sdk.NewCoin("uatom", keeper.TokensFromConsensusPower(ctx, 5))))
+	require.NoError(err)
+	require.Equal(sdk.NewCoin("uatom", keeper.TokensFromConsensusPower(ctx, 5)), undelegateRes.Amount)
+
+	// the pool and the unbonding are indexed by denom and validator respectively
+	has, err := keeper.MultiAssetPoolByDenomIndex.Has(ctx, collections.Join("uatom", ValAddr))
+	require.NoError(err)
+	require.True(has)
+	has, err = keeper.MultiAssetUnbondingByValIndex.Has(ctx, collections.Join3(ValAddr, undelegateRes.CompletionTime, uint64(0)))
+	require.NoError(err)
+	require.True(has)
+
+	poolsRes, err := s.queryClient.ValidatorMultiAssetPools(ctx, &types.QueryValidatorMultiAssetPoolsRequest{ValidatorAddr: ValAddr.String()})
+	require.NoError(err)
+	require.Len(poolsRes.Pools, 1)
+	require.Equal(keeper.TokensFromConsensusPower(ctx, 15), poolsRes.Pools[0].Tokens)
+
+	// the pool and the unbonding tokens are slashed along with the bond denom
+	// tokens, and deducted from the amount of bond denom tokens slashed
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), stakingAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin("uatom", keeper.TokensFromConsensusPower(ctx, 10)))).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), bondedAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(2_500_000)))).Return(nil)
+	burned, err := keeper.Slash(ctx, sdk.ConsAddress(PKs[0].Address()), 10, 25, math.LegacyNewDecWithPrec(5, 1))
+	require.NoError(err)
+	require.Equal(math.NewInt(2_500_000), burned)
+
+	validator, err = keeper.GetValidator(ctx, ValAddr)
+	require.NoError(err)
+	require.Equal(math.NewInt(7_500_000), validator.Tokens)
+	require.Equal(math.NewInt(7_500_000), validator.GetMultiAssetTokens())
+
+	delegationsRes, err := s.queryClient.DelegatorMultiAssetDelegations(ctx, &types.QueryDelegatorMultiAssetDelegationsRequest{DelegatorAddr: Addr.String()})
+	require.NoError(err)
+	require.Len(delegationsRes.Delegations, 1)
+	require.Equal(sdk.NewCoin("uatom", math.NewInt(7_500_000)), delegationsRes.Delegations[0].Balance)
+
+	// the slashed unbonding completes after the unbonding period
+	require.NoError(keeper.CompleteMatureMultiAssetUnbondings(ctx))
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 11, Time: undelegateRes.CompletionTime})
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, Addr, sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(2_500_000)))).Return(nil)
+	require.NoError(keeper.CompleteMatureMultiAssetUnbondings(ctx))
+	has, err = keeper.MultiAssetUnbondingByValIndex.Has(ctx, collections.Join3(ValAddr, undelegateRes.CompletionTime, uint64(0)))
+	require.NoError(err)
+	require.False(has)
+
+	// the tokens of a removed denom no longer count towards the voting power
+	_, err = msgServer.SetBondableDenom(ctx, &types.MsgSetBondableDenom{Authority: keeper.GetAuthority(), Denom: "uatom", Weight: math.LegacyZeroDec()})
+	require.NoError(err)
+	validator, err = keeper.GetValidator(ctx, ValAddr)
+	require.NoError(err)
+	require.True(validator.GetMultiAssetTokens().IsZero())
+}
@@ -54,3 +54,9 @@ func (k Keeper) MinCommissionRate(ctx context.Context) (math.LegacyDec, error) {
 	params, err := k.Params.Get(ctx)
 	return params.MinCommissionRate, err
 }
+
+// IsValidatorAllowed - Whether the operator address is allowed to create a validator
+func (k Keeper) IsValidatorAllowed(ctx context.Context, operatorAddr string) (bool, error) {
+	params, err := k.Params.Get(ctx)
+	return params.IsValidatorAllowed(operatorAddr), err
+}
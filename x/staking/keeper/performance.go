@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/comet"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TrackValidatorPerformance records the votes of the last commit and the
+// proposer of the current block in the performance counters of the
+// validators, and prunes the counters which left the performance window.
+func (k Keeper) TrackValidatorPerformance(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.PerformanceWindow == 0 {
+		return nil
+	}
+
+	height := k.environment.HeaderService.GetHeaderInfo(ctx).Height
+	if height <= 0 {
+		return nil
+	}
+
+	bucket := uint64(height) / types.PerformanceBucketBlocks
+	cometInfo := sdk.UnwrapSDKContext(ctx).CometInfo()
+
+	for _, vote := range cometInfo.LastCommit.Votes {
+		err := k.updatePerformanceCounters(ctx, bucket, vote.Validator.Address, func(counters *types.ValidatorPerformanceCounters) {
+			// a nil vote is counted as signed, consistently with the downtime tracking of x/slashing
+			if vote.BlockIDFlag == comet.BlockIDFlagAbsent {
+				counters.MissedBlocks++
+			} else {
+				counters.SignedBlocks++
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(cometInfo.ProposerAddress) > 0 {
+		err := k.updatePerformanceCounters(ctx, bucket, cometInfo.ProposerAddress, func(counters *types.ValidatorPerformanceCounters) {
+			counters.ProposedBlocks++
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// prune the buckets entirely out of the window
+	startBucket := performanceStartBucket(height, params.PerformanceWindow)
+	if startBucket == 0 {
+		return nil
+	}
+
+	return k.ValidatorPerformance.Clear(ctx, collections.NewPrefixUntilPairRange[uint64, sdk.ValAddress](startBucket-1))
+}
+
+// GetValidatorPerformance returns the performance statistics of a validator
+// over the performance window.
+func (k Keeper) GetValidatorPerformance(ctx context.Context, valAddr sdk.ValAddress) (types.ValidatorPerformance, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.ValidatorPerformance{}, err
+	}
+
+	valStr, err := k.validatorAddressCodec.BytesToString(valAddr)
+	if err != nil {
+		return types.ValidatorPerformance{}, err
+	}
+
+	performance := types.ValidatorPerformance{ValidatorAddress: valStr}
+	if params.PerformanceWindow == 0 {
+		return performance, nil
+	}
+
+	height := k.environment.HeaderService.GetHeaderInfo(ctx).Height
+	startBucket := performanceStartBucket(height, params.PerformanceWindow)
+	performance.StartHeight = int64(startBucket * types.PerformanceBucketBlocks)
+
+	for bucket := startBucket; bucket <= uint64(height)/types.PerformanceBucketBlocks; bucket++ {
+		counters, err := k.ValidatorPerformance.Get(ctx, collections.Join(bucket, valAddr))
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			return types.ValidatorPerformance{}, err
+		}
+
+		performance.SignedBlocks += counters.SignedBlocks
+		performance.MissedBlocks += counters.MissedBlocks
+		performance.ProposedBlocks += counters.ProposedBlocks
+	}
+
+	return performance, nil
+}
+
+// updatePerformanceCounters applies an update to the performance counters of
+// the validator with the given consensus address in a bucket. Unknown
+// validators, e.g. removed since the last commit, are ignored.
+func (k Keeper) updatePerformanceCounters(ctx context.Context, bucket uint64, consAddr sdk.ConsAddress, update func(*types.ValidatorPerformanceCounters)) error {
+	validator, err := k.GetValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		if errors.Is(err, types.ErrNoValidatorFound) {
+			return nil
+		}
+		return err
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	key := collections.Join(bucket, sdk.ValAddress(valAddr))
+	counters, err := k.ValidatorPerformance.Get(ctx, key)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	update(&counters)
+	return k.ValidatorPerformance.Set(ctx, key, counters)
+}
+
+// performanceStartBucket returns the oldest bucket of the performance window
+// ending at the given height.
+func performanceStartBucket(height int64, window uint64) uint64 {
+	if height <= 0 || uint64(height) < window {
+		return 0
+	}
+
+	return (uint64(height) - window + 1) / types.PerformanceBucketBlocks
+}
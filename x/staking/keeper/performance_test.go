@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/comet"
+	"cosmossdk.io/core/header"
+	stakingkeeper "cosmossdk.io/x/staking/keeper"
+	"cosmossdk.io/x/staking/testutil"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestTrackValidatorPerformance() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	querier := stakingkeeper.NewQuerier(keeper)
+	require := s.Require()
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	params.PerformanceWindow = 200
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	valAddrs := make([]sdk.ValAddress, 2)
+	consAddrs := make([][]byte, 2)
+	for i := range valAddrs {
+		valAddrs[i] = sdk.ValAddress(PKs[i].Address().Bytes())
+		consAddrs[i] = PKs[i].Address().Bytes()
+		validator := testutil.NewValidator(s.T(), valAddrs[i], PKs[i])
+		require.NoError(keeper.SetValidator(ctx, validator))
+		require.NoError(keeper.SetValidatorByConsAddr(ctx, validator))
+	}
+
+	// the first validator proposes every block and signs, the second one
+	// misses every other block
+	for height := int64(1); height <= 300; height++ {
+		flag := comet.BlockIDFlagCommit
+		if height%2 == 0 {
+			flag = comet.BlockIDFlagAbsent
+		}
+
+		ctx := ctx.WithHeaderInfo(header.Info{Height: height}).WithCometInfo(comet.Info{
+			ProposerAddress: consAddrs[0],
+			LastCommit: comet.CommitInfo{Votes: []comet.VoteInfo{
+				{Validator: comet.Validator{Address: consAddrs[0]}, BlockIDFlag: comet.BlockIDFlagCommit},
+				{Validator: comet.Validator{Address: consAddrs[1]}, BlockIDFlag: flag},
+				// unknown validators are ignored
+				{Validator: comet.Validator{Address: PKs[2].Address().Bytes()}, BlockIDFlag: comet.BlockIDFlagCommit},
+			}},
+		})
+		require.NoError(keeper.TrackValidatorPerformance(ctx))
+	}
+
+	// the window of 200 blocks ending at height 300 starts from the bucket of
+	// height 101, the older buckets being pruned
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 300})
+	has, err := keeper.ValidatorPerformance.Has(ctx, collections.Join(uint64(0), valAddrs[0]))
+	require.NoError(err)
+	require.False(has)
+
+	performance, err := keeper.GetValidatorPerformance(ctx, valAddrs[0])
+	require.NoError(err)
+	require.Equal(stakingtypes.ValidatorPerformance{
+		ValidatorAddress: valAddrs[0].String(),
+		StartHeight:      100,
+		SignedBlocks:     201,
+		ProposedBlocks:   201,
+	}, performance)
+
+	res, err := querier.ValidatorPerformance(ctx, &stakingtypes.QueryValidatorPerformanceRequest{ValidatorAddr: valAddrs[1].String()})
+	require.NoError(err)
+	require.Equal(stakingtypes.ValidatorPerformance{
+		ValidatorAddress: valAddrs[1].String(),
+		StartHeight:      100,
+		SignedBlocks:     100,
+		MissedBlocks:     101,
+	}, res.Performance)
+
+	_, err = querier.ValidatorPerformance(ctx, &stakingtypes.QueryValidatorPerformanceRequest{ValidatorAddr: sdk.ValAddress(PKs[2].Address()).String()})
+	require.Error(err)
+
+	// disabling the tracking stops recording new blocks
+	params.PerformanceWindow = 0
+	require.NoError(keeper.Params.Set(ctx, params))
+	require.NoError(keeper.TrackValidatorPerformance(ctx.WithHeaderInfo(header.Info{Height: 301})))
+	performance, err = keeper.GetValidatorPerformance(ctx, valAddrs[0])
+	require.NoError(err)
+	require.Equal(stakingtypes.ValidatorPerformance{ValidatorAddress: valAddrs[0].String()}, performance)
+}
@@ -0,0 +1,212 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// precompileCallKey marks the context of a call to the precompile API.
+type precompileCallKey struct{}
+
+// The precompile API lets an EVM staking precompile delegate, undelegate and
+// redelegate on behalf of the caller of a contract, with the same checks as the
+// corresponding messages, and read the resulting bonded tokens. A call fully
+// updates the staking state before notifying the BondedTokenHooks, and a call
+// made from within another one, e.g. by a contract called back by the hooks, is
+// rejected, so that the representation of the bonded tokens never observes a
+// partially updated state.
+
+// PrecompileDelegate delegates amount bond denom tokens of the delegator to the
+// validator. It returns the tokens bonded by the delegator to the validator
+// after the delegation.
+func (k Keeper) PrecompileDelegate(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int) (math.Int, error) {
+	ctx, err := enterPrecompile(ctx)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	delegator, validator, err := k.precompileAddresses(delAddr, valAddr)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	if _, err := NewMsgServerImpl(&k).Delegate(ctx, &types.MsgDelegate{
+		DelegatorAddress: delegator,
+		ValidatorAddress: validator,
+		Amount:           sdk.NewCoin(bondDenom, amount),
+	}); err != nil {
+		return math.Int{}, err
+	}
+
+	return k.afterBondedTokensChanged(ctx, delAddr, valAddr)
+}
+
+// PrecompileUndelegate undelegates amount bond denom tokens of the delegator
+// from the validator. It returns the completion time of the unbonding and the
+// tokens bonded by the delegator to the validator after the undelegation.
+func (k Keeper) PrecompileUndelegate(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int) (time.Time, math.Int, error) {
+	ctx, err := enterPrecompile(ctx)
+	if err != nil {
+		return time.Time{}, math.Int{}, err
+	}
+
+	delegator, validator, err := k.precompileAddresses(delAddr, valAddr)
+	if err != nil {
+		return time.Time{}, math.Int{}, err
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return time.Time{}, math.Int{}, err
+	}
+
+	res, err := NewMsgServerImpl(&k).Undelegate(ctx, &types.MsgUndelegate{
+		DelegatorAddress: delegator,
+		ValidatorAddress: validator,
+		Amount:           sdk.NewCoin(bondDenom, amount),
+	})
+	if err != nil {
+		return time.Time{}, math.Int{}, err
+	}
+
+	bondedTokens, err := k.afterBondedTokensChanged(ctx, delAddr, valAddr)
+	if err != nil {
+		return time.Time{}, math.Int{}, err
+	}
+
+	return res.CompletionTime, bondedTokens, nil
+}
+
+// PrecompileRedelegate redelegates amount bond denom tokens of the delegator
+// from the source validator to the destination one. It returns the completion
+// time of the redelegation.
+func (k Keeper) PrecompileRedelegate(ctx context.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, amount math.Int) (time.Time, error) {
+	ctx, err := enterPrecompile(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	delegator, validatorSrc, err := k.precompileAddresses(delAddr, valSrcAddr)
+	if err != nil {
+		return time.Time{}, err
+	}
+	validatorDst, err := k.validatorAddressCodec.BytesToString(valDstAddr)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	res, err := NewMsgServerImpl(&k).BeginRedelegate(ctx, &types.MsgBeginRedelegate{
+		DelegatorAddress:    delegator,
+		ValidatorSrcAddress: validatorSrc,
+		ValidatorDstAddress: validatorDst,
+		Amount:              sdk.NewCoin(bondDenom, amount),
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if _, err := k.afterBondedTokensChanged(ctx, delAddr, valSrcAddr); err != nil {
+		return time.Time{}, err
+	}
+	if _, err := k.afterBondedTokensChanged(ctx, delAddr, valDstAddr); err != nil {
+		return time.Time{}, err
+	}
+
+	return res.CompletionTime, nil
+}
+
+// GetBondedTokens returns the tokens bonded by a delegator to a validator,
+// zero if it has no delegation to the validator.
+func (k Keeper) GetBondedTokens(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (math.Int, error) {
+	delegation, err := k.Delegations.Get(ctx, collections.Join(delAddr, valAddr))
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	} else if err != nil {
+		return math.Int{}, err
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	return validator.TokensFromShares(delegation.Shares).TruncateInt(), nil
+}
+
+// GetUnbondingTokens returns the tokens of a delegator unbonding from a
+// validator, zero if it has no unbonding delegation from the validator.
+func (k Keeper) GetUnbondingTokens(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (math.Int, error) {
+	ubd, err := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	if errors.Is(err, types.ErrNoUnbondingDelegation) {
+		return math.ZeroInt(), nil
+	} else if err != nil {
+		return math.Int{}, err
+	}
+
+	tokens := math.ZeroInt()
+	for _, entry := range ubd.Entries {
+		tokens = tokens.Add(entry.Balance)
+	}
+
+	return tokens, nil
+}
+
+// enterPrecompile marks the context as being within a call to the precompile
+// API, failing if it already is.
+func enterPrecompile(ctx context.Context) (context.Context, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if sdkCtx.Value(precompileCallKey{}) != nil {
+		return nil, types.ErrPrecompileReentrancy
+	}
+
+	return sdkCtx.WithValue(precompileCallKey{}, true), nil
+}
+
+// precompileAddresses encodes the addresses of a delegator and a validator.
+func (k Keeper) precompileAddresses(delAddr sdk.AccAddress, valAddr sdk.ValAddress) (delegator, validator string, err error) {
+	delegator, err = k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return "", "", err
+	}
+
+	validator, err = k.validatorAddressCodec.BytesToString(valAddr)
+	if err != nil {
+		return "", "", err
+	}
+
+	return delegator, validator, nil
+}
+
+// afterBondedTokensChanged notifies the bonded token hooks, if set, of the
+// tokens bonded by a delegator to a validator, and returns them.
+func (k Keeper) afterBondedTokensChanged(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (math.Int, error) {
+	bondedTokens, err := k.GetBondedTokens(ctx, delAddr, valAddr)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	if k.bondedTokenHooks != nil {
+		if err := k.bondedTokenHooks.AfterBondedTokensChanged(ctx, delAddr, valAddr, bondedTokens); err != nil {
+			return math.Int{}, err
+		}
+	}
+
+	return bondedTokens, nil
+}
@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// mockBondedTokenHooks records the bonded tokens it is notified of, and calls
+// the precompile API back if reenter is set.
+type mockBondedTokenHooks struct {
+	reenter      func(ctx context.Context) error
+	bondedTokens map[string]math.Int
+}
+
+func (m *mockBondedTokenHooks) AfterBondedTokensChanged(ctx context.Context, _ sdk.AccAddress, valAddr sdk.ValAddress, bondedTokens math.Int) error {
+	m.bondedTokens[valAddr.String()] = bondedTokens
+	if m.reenter != nil {
+		return m.reenter(ctx)
+	}
+	return nil
+}
+
+func (s *KeeperTestSuite) TestPrecompileAPI() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), gomock.Any(), types.NotBondedPoolName, gomock.Any()).AnyTimes()
+
+	comm := types.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	valAddrs := []sdk.ValAddress{ValAddr, sdk.ValAddress(PKs[1].Address())}
+	for i, valAddr := range valAddrs {
+		msg, err := types.NewMsgCreateValidator(valAddr.String(), PKs[i], sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), types.Description{Moniker: "NewVal"}, comm, math.OneInt())
+		require.NoError(err)
+		_, err = msgServer.CreateValidator(ctx, msg)
+		require.NoError(err)
+	}
+
+	hooks := &mockBondedTokenHooks{bondedTokens: make(map[string]math.Int)}
+	keeper.SetBondedTokenHooks(hooks)
+
+	bondedTokens, err := keeper.PrecompileDelegate(ctx, Addr, ValAddr, math.NewInt(1000))
+	require.NoError(err)
+	// the self-delegation of the validator adds up
+	require.Equal(math.NewInt(1100), bondedTokens)
+	require.Equal(math.NewInt(1100), hooks.bondedTokens[ValAddr.String()])
+
+	_, err = keeper.PrecompileDelegate(ctx, Addr, ValAddr, math.ZeroInt())
+	require.ErrorContains(err, "invalid delegation amount")
+
+	_, err = keeper.PrecompileRedelegate(ctx, Addr, ValAddr, valAddrs[1], math.NewInt(400))
+	require.NoError(err)
+	require.Equal(math.NewInt(700), hooks.bondedTokens[ValAddr.String()])
+	require.Equal(math.NewInt(400), hooks.bondedTokens[valAddrs[1].String()])
+
+	_, bondedTokens, err = keeper.PrecompileUndelegate(ctx, Addr, valAddrs[1], math.NewInt(100))
+	require.NoError(err)
+	require.Equal(math.NewInt(300), bondedTokens)
+
+	unbondingTokens, err := keeper.GetUnbondingTokens(ctx, Addr, valAddrs[1])
+	require.NoError(err)
+	require.Equal(math.NewInt(100), unbondingTokens)
+
+	// the hooks cannot call the precompile API back
+	hooks.reenter = func(ctx context.Context) error {
+		_, err := keeper.PrecompileDelegate(ctx, Addr, ValAddr, math.NewInt(1))
+		return err
+	}
+	_, err = keeper.PrecompileDelegate(ctx, Addr, ValAddr, math.NewInt(1))
+	require.ErrorIs(err, types.ErrPrecompileReentrancy)
+
+	require.Panics(func() { keeper.SetBondedTokenHooks(hooks) })
+}
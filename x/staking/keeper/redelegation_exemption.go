@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ApproveRedelegationExemption approves a validator consolidation: until the
+// expiry of the exemption, the delegators of the source validator can
+// redelegate from it, to the destination validator if any, without the
+// transitive redelegation and maximum entries restrictions. It replaces the
+// exemption previously approved for the source validator.
+func (k Keeper) ApproveRedelegationExemption(ctx context.Context, exemption types.RedelegationExemption) error {
+	if err := types.ValidateRedelegationExemptions([]types.RedelegationExemption{exemption}); err != nil {
+		return err
+	}
+
+	valSrcAddr, err := k.validatorAddressCodec.StringToBytes(exemption.ValidatorSrcAddress)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidRedelegationExemption, "invalid source validator address: %s", err)
+	}
+
+	if _, err := k.GetValidator(ctx, valSrcAddr); err != nil {
+		return err
+	}
+
+	if exemption.ValidatorDstAddress != "" {
+		valDstAddr, err := k.validatorAddressCodec.StringToBytes(exemption.ValidatorDstAddress)
+		if err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidRedelegationExemption, "invalid destination validator address: %s", err)
+		}
+
+		if _, err := k.GetValidator(ctx, valDstAddr); err != nil {
+			return err
+		}
+	}
+
+	blockTime := k.environment.HeaderService.GetHeaderInfo(ctx).Time
+	if !exemption.Expiry.After(blockTime) {
+		return errorsmod.Wrapf(types.ErrInvalidRedelegationExemption, "expiry %s must be after the block time %s", exemption.Expiry, blockTime)
+	}
+
+	if err := k.RedelegationExemptions.Set(ctx, valSrcAddr, exemption); err != nil {
+		return err
+	}
+
+	return k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeApproveRedelegationExemption,
+		event.NewAttribute(types.AttributeKeySrcValidator, exemption.ValidatorSrcAddress),
+		event.NewAttribute(types.AttributeKeyDstValidator, exemption.ValidatorDstAddress),
+		event.NewAttribute(types.AttributeKeyExpiry, exemption.Expiry.Format(time.RFC3339)),
+	)
+}
+
+// IsRedelegationExempt returns whether a redelegation between two validators
+// is exempted from the redelegation restrictions by an exemption which has
+// not expired.
+func (k Keeper) IsRedelegationExempt(ctx context.Context, valSrcAddr, valDstAddr sdk.ValAddress) (bool, error) {
+	exemption, err := k.RedelegationExemptions.Get(ctx, valSrcAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	if !exemption.Expiry.After(k.environment.HeaderService.GetHeaderInfo(ctx).Time) {
+		return false, nil
+	}
+
+	if exemption.ValidatorDstAddress == "" {
+		return true, nil
+	}
+
+	valDstStr, err := k.validatorAddressCodec.BytesToString(valDstAddr)
+	if err != nil {
+		return false, err
+	}
+
+	return exemption.ValidatorDstAddress == valDstStr, nil
+}
+
+// GetRedelegationExemptions returns the redelegation exemptions, by source
+// validator.
+func (k Keeper) GetRedelegationExemptions(ctx context.Context) ([]types.RedelegationExemption, error) {
+	var exemptions []types.RedelegationExemption
+	err := k.RedelegationExemptions.Walk(ctx, nil, func(_ sdk.ValAddress, exemption types.RedelegationExemption) (bool, error) {
+		exemptions = append(exemptions, exemption)
+		return false, nil
+	})
+
+	return exemptions, err
+}
+
+// PruneExpiredRedelegationExemptions deletes the redelegation exemptions which
+// expired.
+func (k Keeper) PruneExpiredRedelegationExemptions(ctx context.Context) error {
+	blockTime := k.environment.HeaderService.GetHeaderInfo(ctx).Time
+
+	var expired []sdk.ValAddress
+	err := k.RedelegationExemptions.Walk(ctx, nil, func(valSrcAddr sdk.ValAddress, exemption types.RedelegationExemption) (bool, error) {
+		if !exemption.Expiry.After(blockTime) {
+			expired = append(expired, valSrcAddr)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, valSrcAddr := range expired {
+		if err := k.RedelegationExemptions.Remove(ctx, valSrcAddr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	coreheader "cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	stakingkeeper "cosmossdk.io/x/staking/keeper"
+	"cosmossdk.io/x/staking/testutil"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestRedelegationExemption() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	_, addrVals := createValAddrs(3)
+
+	// create two bonded validators, the first one with a self-delegation
+	valTokens := keeper.TokensFromConsensusPower(ctx, 10)
+	for i := 0; i < 2; i++ {
+		validator := testutil.NewValidator(s.T(), addrVals[i], PKs[i])
+		validator, _ = validator.AddTokensFromDel(valTokens)
+		s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+		_ = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+	}
+	val0AccAddr := sdk.AccAddress(addrVals[0].Bytes())
+	selfDelegation := stakingtypes.NewDelegation(val0AccAddr.String(), addrVals[0].String(), math.LegacyNewDecFromInt(valTokens))
+	require.NoError(keeper.SetDelegation(ctx, selfDelegation))
+
+	maxEntries, err := keeper.MaxEntries(ctx)
+	require.NoError(err)
+	for i := uint32(0); i < maxEntries; i++ {
+		_, err = keeper.BeginRedelegation(ctx, val0AccAddr, addrVals[0], addrVals[1], math.LegacyNewDec(1))
+		require.NoError(err)
+	}
+	_, err = keeper.BeginRedelegation(ctx, val0AccAddr, addrVals[0], addrVals[1], math.LegacyNewDec(1))
+	require.ErrorIs(err, stakingtypes.ErrMaxRedelegationEntries)
+
+	expiry := ctx.HeaderInfo().Time.Add(time.Hour)
+	exemption := stakingtypes.RedelegationExemption{
+		ValidatorSrcAddress: addrVals[0].String(),
+		ValidatorDstAddress: addrVals[1].String(),
+		Expiry:              expiry,
+	}
+	_, err = msgServer.ApproveRedelegationExemption(ctx, &stakingtypes.MsgApproveRedelegationExemption{Authority: Addr.String(), Exemption: exemption})
+	require.ErrorIs(err, stakingtypes.ErrInvalidSigner)
+
+	// the expiry must be in the future
+	expired := exemption
+	expired.Expiry = ctx.HeaderInfo().Time
+	_, err = msgServer.ApproveRedelegationExemption(ctx, &stakingtypes.MsgApproveRedelegationExemption{Authority: keeper.GetAuthority(), Exemption: expired})
+	require.ErrorIs(err, stakingtypes.ErrInvalidRedelegationExemption)
+
+	// the destination validator must exist
+	unknown := exemption
+	unknown.ValidatorDstAddress = addrVals[2].String()
+	_, err = msgServer.ApproveRedelegationExemption(ctx, &stakingtypes.MsgApproveRedelegationExemption{Authority: keeper.GetAuthority(), Exemption: unknown})
+	require.ErrorIs(err, stakingtypes.ErrNoValidatorFound)
+
+	_, err = msgServer.ApproveRedelegationExemption(ctx, &stakingtypes.MsgApproveRedelegationExemption{Authority: keeper.GetAuthority(), Exemption: exemption})
+	require.NoError(err)
+
+	res, err := s.queryClient.RedelegationExemptions(ctx, &stakingtypes.QueryRedelegationExemptionsRequest{})
+	require.NoError(err)
+	require.Equal([]stakingtypes.RedelegationExemption{exemption}, res.Exemptions)
+
+	// the exempted redelegation is no longer limited by the max entries
+	_, err = keeper.BeginRedelegation(ctx, val0AccAddr, addrVals[0], addrVals[1], math.LegacyNewDec(1))
+	require.NoError(err)
+
+	exempt, err := keeper.IsRedelegationExempt(ctx, addrVals[0], addrVals[2])
+	require.NoError(err)
+	require.False(exempt)
+
+	// the restrictions apply again once the exemption expired, and it is pruned
+	ctx = ctx.WithHeaderInfo(coreheader.Info{Time: expiry})
+	_, err = keeper.BeginRedelegation(ctx, val0AccAddr, addrVals[0], addrVals[1], math.LegacyNewDec(1))
+	require.ErrorIs(err, stakingtypes.ErrMaxRedelegationEntries)
+
+	require.NoError(keeper.PruneExpiredRedelegationExemptions(ctx))
+	exemptions, err := keeper.GetRedelegationExemptions(ctx)
+	require.NoError(err)
+	require.Empty(exemptions)
+}
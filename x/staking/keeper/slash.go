@@ -144,6 +144,15 @@ func (k Keeper) Slash(ctx context.Context, consAddr sdk.ConsAddress, infractionH
 		}
 	}
 
+	// The tokens of the other bondable denoms delegated to the validator, or
+	// unbonding from it since the infraction, are slashed by the same factor,
+	// and their bond denom equivalent is deducted from the slash amount
+	validator, multiAssetSlashed, err := k.slashMultiAsset(ctx, validator, infractionHeight, slashFactor)
+	if err != nil {
+		return math.NewInt(0), err
+	}
+	remainingSlashAmount = remainingSlashAmount.Sub(multiAssetSlashed)
+
 	// cannot decrease balance below zero
 	tokensToBurn := math.MinInt(remainingSlashAmount, validator.Tokens)
 	tokensToBurn = math.MaxInt(tokensToBurn, math.ZeroInt()) // defensive.
@@ -289,6 +298,22 @@ func (k Keeper) SlashUnbondingDelegation(ctx context.Context, unbondingDelegatio
 		return math.ZeroInt(), err
 	}
 
+	if !burnedAmount.IsZero() {
+		delAddr, err := k.authKeeper.AddressCodec().StringToBytes(unbondingDelegation.DelegatorAddress)
+		if err != nil {
+			return math.ZeroInt(), err
+		}
+
+		valAddr, err := k.validatorAddressCodec.StringToBytes(unbondingDelegation.ValidatorAddress)
+		if err != nil {
+			return math.ZeroInt(), err
+		}
+
+		if err := k.afterUnbondingAmountChanged(ctx, delAddr, valAddr, burnedAmount.Neg()); err != nil {
+			return math.ZeroInt(), err
+		}
+	}
+
 	return totalSlashAmount, nil
 }
 
@@ -354,6 +379,10 @@ func (k Keeper) SlashRedelegation(ctx context.Context, srcValidator types.Valida
 			return math.ZeroInt(), err
 		}
 
+		if err := k.reduceDelegationPositions(ctx, delegatorAddress, valDstAddr); err != nil {
+			return math.ZeroInt(), err
+		}
+
 		dstValidator, err := k.GetValidator(ctx, valDstAddr)
 		if err != nil {
 			return math.ZeroInt(), err
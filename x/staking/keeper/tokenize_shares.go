@@ -0,0 +1,357 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// vestingAccount defines the methods of a vesting account used to restrict the
+// tokenization of vesting delegations.
+type vestingAccount interface {
+	GetDelegatedFree() sdk.Coins
+	GetDelegatedVesting() sdk.Coins
+}
+
+// TokenizeShares tokenizes delegation shares into liquid share tokens. The shares
+// are moved to the delegation of a module account owned by a new tokenize share
+// record and share tokens worth one share each are minted to the delegator. The
+// owner of the record is entitled to the rewards of the tokenized shares.
+func (k Keeper) TokenizeShares(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec, owner sdk.AccAddress) (types.TokenizeShareRecord, sdk.Coin, error) {
+	// self-delegations back the validator's minimum self-delegation and cannot be tokenized
+	if bytes.Equal(delAddr, valAddr) {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrSelfDelegationPosition
+	}
+
+	// share tokens are minted one per share, the fractional part stays in the delegation
+	sharesInt := shares.TruncateInt()
+	if !sharesInt.IsPositive() {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrTinyTokenizeShares
+	}
+	shares = math.LegacyNewDecFromInt(sharesInt)
+
+	if err := k.checkUnlockedShares(ctx, delAddr, valAddr, shares); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if err := k.checkVestingTokenization(ctx, delAddr, validator.TokensFromShares(shares).TruncateInt()); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if err := k.checkLiquidStakingCaps(ctx, validator, sharesInt); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	id, err := k.TokenizeShareRecordSequence.Next(ctx)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	ownerStr, err := k.authKeeper.AddressCodec().BytesToString(owner)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	record := types.TokenizeShareRecord{
+		Id:               id,
+		Owner:            ownerStr,
+		ModuleAccount:    types.TokenizeShareModuleAccountName(id),
+		ValidatorAddress: validator.OperatorAddress,
+	}
+
+	if err := k.transferDelegationShares(ctx, delAddr, record.GetModuleAddress(), valAddr, shares); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	shareTokens := sdk.NewCoin(record.GetShareTokenDenom(), sharesInt)
+	if err := k.bankKeeper.MintCoins(ctx, types.ShareTokenPoolName, sdk.NewCoins(shareTokens)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ShareTokenPoolName, delAddr, sdk.NewCoins(shareTokens)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if err := k.addValidatorLiquidShares(ctx, valAddr, sharesInt); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	return record, shareTokens, k.SetTokenizeShareRecord(ctx, record)
+}
+
+// RedeemTokensForShares burns share tokens and moves the shares they are worth
+// from the delegation of the tokenize share record back to the delegation of the
+// redeemer. The record is removed, and the remaining balances of its module
+// account sent to its owner, once all its shares are redeemed.
+func (k Keeper) RedeemTokensForShares(ctx context.Context, delAddr sdk.AccAddress, shareTokens sdk.Coin) (types.TokenizeShareRecord, math.Int, error) {
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, shareTokens.Denom)
+	if err != nil {
+		return types.TokenizeShareRecord{}, math.Int{}, err
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(record.ValidatorAddress)
+	if err != nil {
+		return types.TokenizeShareRecord{}, math.Int{}, err
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return types.TokenizeShareRecord{}, math.Int{}, err
+	}
+
+	shares := math.LegacyNewDecFromInt(shareTokens.Amount)
+	tokens := validator.TokensFromShares(shares).TruncateInt()
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ShareTokenPoolName, sdk.NewCoins(shareTokens)); err != nil {
+		return types.TokenizeShareRecord{}, math.Int{}, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, k.authKeeper.GetModuleAddress(types.ShareTokenPoolName), sdk.NewCoins(shareTokens)); err != nil {
+		return types.TokenizeShareRecord{}, math.Int{}, err
+	}
+
+	recordAddr := sdk.AccAddress(record.GetModuleAddress())
+	if err := k.transferDelegationShares(ctx, recordAddr, delAddr, valAddr, shares); err != nil {
+		return types.TokenizeShareRecord{}, math.Int{}, err
+	}
+
+	if err := k.addValidatorLiquidShares(ctx, valAddr, shareTokens.Amount.Neg()); err != nil {
+		return types.TokenizeShareRecord{}, math.Int{}, err
+	}
+
+	found, err := k.Delegations.Has(ctx, collections.Join(recordAddr, sdk.ValAddress(valAddr)))
+	if err != nil || found {
+		return record, tokens, err
+	}
+
+	// all the shares are redeemed, the rewards left in the module account go to the owner
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return types.TokenizeShareRecord{}, math.Int{}, err
+	}
+
+	if balances := k.bankKeeper.GetAllBalances(ctx, recordAddr); !balances.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, recordAddr, owner, balances); err != nil {
+			return types.TokenizeShareRecord{}, math.Int{}, err
+		}
+	}
+
+	return record, tokens, k.RemoveTokenizeShareRecord(ctx, record)
+}
+
+// TransferTokenizeShareRecord transfers the ownership of a tokenize share record,
+// and with it the rewards of its tokenized shares, to a new owner.
+func (k Keeper) TransferTokenizeShareRecord(ctx context.Context, sender sdk.AccAddress, recordID uint64, newOwner sdk.AccAddress) error {
+	record, err := k.TokenizeShareRecords.Get(ctx, recordID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return errorsmod.Wrapf(types.ErrTokenizeShareRecordNotExists, "id %d", recordID)
+	} else if err != nil {
+		return err
+	}
+
+	isOwner, err := k.TokenizeShareRecordsByOwner.Has(ctx, collections.Join(sender, recordID))
+	if err != nil {
+		return err
+	}
+
+	if !isOwner {
+		return errorsmod.Wrapf(types.ErrNotTokenizeShareRecordOwner, "tokenize share record %d is owned by %s", recordID, record.Owner)
+	}
+
+	if err := k.TokenizeShareRecordsByOwner.Remove(ctx, collections.Join(sender, recordID)); err != nil {
+		return err
+	}
+
+	record.Owner, err = k.authKeeper.AddressCodec().BytesToString(newOwner)
+	if err != nil {
+		return err
+	}
+
+	return k.SetTokenizeShareRecord(ctx, record)
+}
+
+// SetTokenizeShareRecord sets a tokenize share record and indexes it by owner
+// and share token denom.
+func (k Keeper) SetTokenizeShareRecord(ctx context.Context, record types.TokenizeShareRecord) error {
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	if err := k.TokenizeShareRecords.Set(ctx, record.Id, record); err != nil {
+		return err
+	}
+
+	if err := k.TokenizeShareRecordsByDenom.Set(ctx, record.GetShareTokenDenom(), record.Id); err != nil {
+		return err
+	}
+
+	return k.TokenizeShareRecordsByOwner.Set(ctx, collections.Join(sdk.AccAddress(owner), record.Id))
+}
+
+// RemoveTokenizeShareRecord removes a tokenize share record and its indexes.
+func (k Keeper) RemoveTokenizeShareRecord(ctx context.Context, record types.TokenizeShareRecord) error {
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	if err := k.TokenizeShareRecords.Remove(ctx, record.Id); err != nil {
+		return err
+	}
+
+	if err := k.TokenizeShareRecordsByDenom.Remove(ctx, record.GetShareTokenDenom()); err != nil {
+		return err
+	}
+
+	return k.TokenizeShareRecordsByOwner.Remove(ctx, collections.Join(sdk.AccAddress(owner), record.Id))
+}
+
+// GetTokenizeShareRecordByDenom returns the tokenize share record of a share token denom.
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx context.Context, denom string) (types.TokenizeShareRecord, error) {
+	id, err := k.TokenizeShareRecordsByDenom.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.TokenizeShareRecord{}, errorsmod.Wrapf(types.ErrTokenizeShareRecordNotExists, "denom %s", denom)
+	} else if err != nil {
+		return types.TokenizeShareRecord{}, err
+	}
+
+	return k.TokenizeShareRecords.Get(ctx, id)
+}
+
+// GetTokenizeShareRecordsByOwner returns all the tokenize share records of an owner.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx context.Context, owner sdk.AccAddress) ([]types.TokenizeShareRecord, error) {
+	var records []types.TokenizeShareRecord
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, uint64](owner)
+	err := k.TokenizeShareRecordsByOwner.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, uint64]) (stop bool, err error) {
+		record, err := k.TokenizeShareRecords.Get(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+
+		records = append(records, record)
+		return false, nil
+	})
+
+	return records, err
+}
+
+// GetValidatorLiquidShares returns the delegator shares of a validator tokenized
+// into share tokens.
+func (k Keeper) GetValidatorLiquidShares(ctx context.Context, valAddr sdk.ValAddress) (math.Int, error) {
+	shares, err := k.ValidatorLiquidShares.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+
+	return shares, err
+}
+
+// GetTotalLiquidStakedTokens returns the amount of bond denom tokens tokenized
+// into share tokens. It is computed from the current exchange rate of each
+// validator so that slashes are accounted for.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx context.Context) (math.Int, error) {
+	total := math.ZeroInt()
+	err := k.ValidatorLiquidShares.Walk(ctx, nil, func(valAddr []byte, shares math.Int) (stop bool, err error) {
+		validator, err := k.GetValidator(ctx, valAddr)
+		if err != nil {
+			return true, err
+		}
+
+		total = total.Add(validator.TokensFromShares(math.LegacyNewDecFromInt(shares)).TruncateInt())
+		return false, nil
+	})
+
+	return total, err
+}
+
+func (k Keeper) addValidatorLiquidShares(ctx context.Context, valAddr sdk.ValAddress, shares math.Int) error {
+	liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	liquidShares = liquidShares.Add(shares)
+	if !liquidShares.IsPositive() {
+		return k.ValidatorLiquidShares.Remove(ctx, valAddr)
+	}
+
+	return k.ValidatorLiquidShares.Set(ctx, valAddr, liquidShares)
+}
+
+// checkLiquidStakingCaps returns an error if tokenizing the given shares of a
+// validator would exceed the global or the validator liquid staking cap.
+func (k Keeper) checkLiquidStakingCaps(ctx context.Context, validator types.Validator, shares math.Int) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.GlobalLiquidStakingCap.IsNil() && params.GlobalLiquidStakingCap.LT(math.LegacyOneDec()) {
+		totalBonded, err := k.TotalBondedTokens(ctx)
+		if err != nil {
+			return err
+		}
+
+		liquidTokens, err := k.GetTotalLiquidStakedTokens(ctx)
+		if err != nil {
+			return err
+		}
+
+		liquidTokens = liquidTokens.Add(validator.TokensFromShares(math.LegacyNewDecFromInt(shares)).TruncateInt())
+		if totalBonded.IsZero() || math.LegacyNewDecFromInt(liquidTokens).QuoInt(totalBonded).GT(params.GlobalLiquidStakingCap) {
+			return types.ErrGlobalLiquidStakingCapExceeded
+		}
+	}
+
+	if !params.ValidatorLiquidStakingCap.IsNil() && params.ValidatorLiquidStakingCap.LT(math.LegacyOneDec()) {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(validator.OperatorAddress)
+		if err != nil {
+			return err
+		}
+
+		liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+
+		liquidShares = liquidShares.Add(shares)
+		if math.LegacyNewDecFromInt(liquidShares).Quo(validator.DelegatorShares).GT(params.ValidatorLiquidStakingCap) {
+			return types.ErrValidatorLiquidStakingCapExceeded
+		}
+	}
+
+	return nil
+}
+
+// checkVestingTokenization returns an error if a vesting account tries to
+// tokenize more than its free delegated tokens, as share tokens can be sent.
+func (k Keeper) checkVestingTokenization(ctx context.Context, delAddr sdk.AccAddress, tokens math.Int) error {
+	acc, ok := k.authKeeper.GetAccount(ctx, delAddr).(vestingAccount)
+	if !ok {
+		return nil
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	if acc.GetDelegatedFree().AmountOf(bondDenom).LT(tokens) {
+		return errorsmod.Wrapf(types.ErrExceedingFreeVestingDelegations, "%s%s delegated vesting tokens cannot be tokenized", acc.GetDelegatedVesting().AmountOf(bondDenom), bondDenom)
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/staking/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -283,6 +284,7 @@ func (k Keeper) unbondingDelegationEntryCanComplete(ctx context.Context, id uint
 	ubd.Entries[i].UnbondingOnHoldRefCount--
 
 	// Check if entry is matured.
+	completedAmount := math.ZeroInt()
 	if !ubd.Entries[i].OnHold() && ubd.Entries[i].IsMature(k.environment.HeaderService.GetHeaderInfo(ctx).Time) {
 		// If matured, complete it.
 		delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(ubd.DelegatorAddress)
@@ -305,6 +307,8 @@ func (k Keeper) unbondingDelegationEntryCanComplete(ctx context.Context, id uint
 			}
 		}
 
+		completedAmount = ubd.Entries[i].Balance
+
 		// Remove entry
 		ubd.RemoveEntry(int64(i))
 		// Remove from the UnbondingIndex
@@ -317,10 +321,25 @@ func (k Keeper) unbondingDelegationEntryCanComplete(ctx context.Context, id uint
 
 	// set the unbonding delegation or remove it if there are no more entries
 	if len(ubd.Entries) == 0 {
-		return k.RemoveUnbondingDelegation(ctx, ubd)
+		err = k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		err = k.SetUnbondingDelegation(ctx, ubd)
+	}
+	if err != nil || completedAmount.IsZero() {
+		return err
 	}
 
-	return k.SetUnbondingDelegation(ctx, ubd)
+	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(ubd.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(ubd.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+
+	return k.afterUnbondingAmountChanged(ctx, delAddr, valAddr, completedAmount.Neg())
 }
 
 func (k Keeper) redelegationEntryCanComplete(ctx context.Context, id uint64) error {
@@ -155,17 +155,15 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) (updates
 		return nil, err
 	}
 
-	// Iterate over validators, highest power to lowest.
-	iterator, err := k.ValidatorsPowerStoreIterator(ctx)
+	// Retrieve the candidates for the active set, highest power to lowest.
+	candidates, err := k.activeSetCandidates(ctx, maxValidators)
 	if err != nil {
 		return nil, err
 	}
-	defer iterator.Close()
 
-	for count := 0; iterator.Valid() && count < int(maxValidators); iterator.Next() {
+	for _, valAddr := range candidates {
 		// everything that is iterated in this loop is becoming or already a
 		// part of the bonded validator set
-		valAddr := sdk.ValAddress(iterator.Value())
 		validator, err := k.GetValidator(ctx, valAddr)
 		if err != nil {
 			return nil, fmt.Errorf("validator record not found for address: %X", valAddr)
@@ -220,7 +218,6 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) (updates
 		}
 
 		delete(last, valAddrStr)
-		count++
 
 		totalPower = totalPower.Add(math.NewInt(newPower))
 	}
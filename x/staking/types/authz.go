@@ -15,6 +15,10 @@ import (
 // Tracking issues https://github.com/cosmos/cosmos-sdk/issues/9054, https://github.com/cosmos/cosmos-sdk/discussions/9072
 const gasCostPerIteration = uint64(10)
 
+// MaxStakingOperations is the maximum number of operations of a
+// MsgExecStakingOperations.
+const MaxStakingOperations = 50
+
 // NewStakeAuthorization creates a new StakeAuthorization object.
 func NewStakeAuthorization(allowed, denied []sdk.ValAddress, authzType AuthorizationType, amount *sdk.Coin) (*StakeAuthorization, error) {
 	allowedValidators, deniedValidators, err := validateAllowAndDenyValidators(allowed, denied)
@@ -153,6 +157,121 @@ func (a StakeAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.Acce
 	}, nil
 }
 
+// IncreaseLimit implements authz.LimitedAuthorization.IncreaseLimit. It adds
+// the given amount, which must be in the denom of MaxTokens, to MaxTokens.
+// Authorizations without MaxTokens have no limit to increase.
+func (a *StakeAuthorization) IncreaseLimit(amount sdk.Coins) error {
+	if a.MaxTokens == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("authorization has no max tokens to increase")
+	}
+
+	if !amount.IsValid() || len(amount) != 1 || amount[0].Denom != a.MaxTokens.Denom {
+		return sdkerrors.ErrInvalidCoins.Wrapf("limit increase must be a positive amount of %s, got %s", a.MaxTokens.Denom, amount)
+	}
+
+	maxTokens := a.MaxTokens.Add(amount[0])
+	a.MaxTokens = &maxTokens
+	return nil
+}
+
+// NewDelegateOnBehalfAuthorization creates a new DelegateOnBehalfAuthorization object.
+func NewDelegateOnBehalfAuthorization(caps ...ValidatorCap) (*DelegateOnBehalfAuthorization, error) {
+	a := &DelegateOnBehalfAuthorization{ValidatorCaps: caps}
+	if err := a.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a DelegateOnBehalfAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgExecStakingOperations{})
+}
+
+// ValidateBasic performs a stateless validation of the fields.
+// It fails if no validator is listed, if a validator is listed twice or if a
+// cap is negative.
+func (a DelegateOnBehalfAuthorization) ValidateBasic() error {
+	if len(a.ValidatorCaps) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("validator caps cannot be empty")
+	}
+
+	found := make(map[string]bool, len(a.ValidatorCaps))
+	for _, c := range a.ValidatorCaps {
+		if c.ValidatorAddress == "" {
+			return sdkerrors.ErrInvalidAddress.Wrap("empty validator address")
+		}
+		if found[c.ValidatorAddress] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate validator address: %s", c.ValidatorAddress)
+		}
+		found[c.ValidatorAddress] = true
+
+		if c.MaxTokens != nil && !c.MaxTokens.IsValid() {
+			return sdkerrors.ErrInvalidCoins.Wrapf("invalid cap of validator %s: %s", c.ValidatorAddress, c.MaxTokens)
+		}
+	}
+
+	return nil
+}
+
+// Accept implements Authorization.Accept. It checks that every operation of the
+// MsgExecStakingOperations only involves listed validators, and deducts the
+// delegated and redelegated amounts from the caps of their destination
+// validators. The restakings of rewards, whose amounts are only known when
+// they are executed, are not capped.
+func (a DelegateOnBehalfAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	execMsg, ok := msg.(*MsgExecStakingOperations)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidRequest.Wrap("unknown msg type")
+	}
+
+	caps := make([]ValidatorCap, len(a.ValidatorCaps))
+	copy(caps, a.ValidatorCaps)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	findCap := func(validatorAddress string) (*ValidatorCap, error) {
+		for i := range caps {
+			sdkCtx.GasMeter().ConsumeGas(gasCostPerIteration, "delegate on behalf authorization")
+			if caps[i].ValidatorAddress == validatorAddress {
+				return &caps[i], nil
+			}
+		}
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("cannot operate on %s validator", validatorAddress)
+	}
+
+	for _, op := range execMsg.Operations {
+		c, err := findCap(op.ValidatorAddress)
+		if err != nil {
+			return authz.AcceptResponse{}, err
+		}
+
+		switch op.OperationType {
+		case StakingOperationTypeRestakeRewards:
+			continue
+		case StakingOperationTypeRedelegate:
+			if c, err = findCap(op.ValidatorDstAddress); err != nil {
+				return authz.AcceptResponse{}, err
+			}
+		}
+
+		if c.MaxTokens == nil {
+			continue
+		}
+
+		limitLeft, err := c.MaxTokens.SafeSub(op.Amount)
+		if err != nil {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot exceed the cap of %s validator: %s", c.ValidatorAddress, err)
+		}
+		c.MaxTokens = &limitLeft
+	}
+
+	return authz.AcceptResponse{
+		Accept:  true,
+		Updated: &DelegateOnBehalfAuthorization{ValidatorCaps: caps},
+	}, nil
+}
+
 func validateAllowAndDenyValidators(allowed, denied []sdk.ValAddress) ([]string, []string, error) {
 	if len(allowed) == 0 && len(denied) == 0 {
 		return nil, nil, sdkerrors.ErrInvalidRequest.Wrap("both allowed & deny list cannot be empty")
@@ -222,10 +222,120 @@ func (m *StakeAuthorization_Validators) GetAddress() []string {
 	return nil
 }
 
+// DelegateOnBehalfAuthorization defines an authorization for a grantee to
+// manage the delegations of the granter with Msg/ExecStakingOperations,
+// delegating, redelegating and restaking the rewards in batches. The grantee
+// can only operate on the validators listed in validator_caps, up to their
+// caps. The authorization expires with its grant.
+type DelegateOnBehalfAuthorization struct {
+	// validator_caps defines the validators the grantee can operate on, and the
+	// maximum amount of tokens it can delegate or redelegate to each of them.
+	ValidatorCaps []ValidatorCap `protobuf:"bytes,1,rep,name=validator_caps,json=validatorCaps,proto3" json:"validator_caps"`
+}
+
+func (m *DelegateOnBehalfAuthorization) Reset()         { *m = DelegateOnBehalfAuthorization{} }
+func (m *DelegateOnBehalfAuthorization) String() string { return proto.CompactTextString(m) }
+func (*DelegateOnBehalfAuthorization) ProtoMessage()    {}
+func (*DelegateOnBehalfAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6d8cdbc6f4432f0, []int{1}
+}
+func (m *DelegateOnBehalfAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegateOnBehalfAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateOnBehalfAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegateOnBehalfAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateOnBehalfAuthorization.Merge(m, src)
+}
+func (m *DelegateOnBehalfAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegateOnBehalfAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateOnBehalfAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegateOnBehalfAuthorization proto.InternalMessageInfo
+
+func (m *DelegateOnBehalfAuthorization) GetValidatorCaps() []ValidatorCap {
+	if m != nil {
+		return m.ValidatorCaps
+	}
+	return nil
+}
+
+// ValidatorCap defines the maximum amount of tokens a grantee can delegate or
+// redelegate to a validator on behalf of a granter.
+type ValidatorCap struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// max_tokens specifies the maximum amount of tokens which can be delegated or
+	// redelegated to the validator. If it is empty, there is no cap. The restaked
+	// rewards are not counted.
+	MaxTokens *types.Coin `protobuf:"bytes,2,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty"`
+}
+
+func (m *ValidatorCap) Reset()         { *m = ValidatorCap{} }
+func (m *ValidatorCap) String() string { return proto.CompactTextString(m) }
+func (*ValidatorCap) ProtoMessage()    {}
+func (*ValidatorCap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6d8cdbc6f4432f0, []int{2}
+}
+func (m *ValidatorCap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorCap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorCap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorCap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorCap.Merge(m, src)
+}
+func (m *ValidatorCap) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorCap) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorCap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorCap proto.InternalMessageInfo
+
+func (m *ValidatorCap) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorCap) GetMaxTokens() *types.Coin {
+	if m != nil {
+		return m.MaxTokens
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.staking.v1beta1.AuthorizationType", AuthorizationType_name, AuthorizationType_value)
 	proto.RegisterType((*StakeAuthorization)(nil), "cosmos.staking.v1beta1.StakeAuthorization")
 	proto.RegisterType((*StakeAuthorization_Validators)(nil), "cosmos.staking.v1beta1.StakeAuthorization.Validators")
+	proto.RegisterType((*DelegateOnBehalfAuthorization)(nil), "cosmos.staking.v1beta1.DelegateOnBehalfAuthorization")
+	proto.RegisterType((*ValidatorCap)(nil), "cosmos.staking.v1beta1.ValidatorCap")
 }
 
 func init() {
@@ -233,42 +343,50 @@ func init() {
 }
 
 var fileDescriptor_d6d8cdbc6f4432f0 = []byte{
-	// 560 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xc1, 0x6e, 0x12, 0x41,
-	0x18, 0xc7, 0xd9, 0xb6, 0x51, 0x19, 0x8d, 0x81, 0x49, 0x63, 0x28, 0xda, 0x6d, 0xe5, 0x60, 0x91,
-	0xca, 0x6c, 0x8a, 0xd1, 0x83, 0x27, 0x77, 0x61, 0x2d, 0x9b, 0x90, 0xa5, 0x59, 0x16, 0xa3, 0xbd,
-	0x6c, 0x06, 0x76, 0x03, 0x13, 0x60, 0x87, 0x30, 0x43, 0x85, 0xde, 0x4c, 0x3c, 0x79, 0xf2, 0x39,
-	0x3c, 0x35, 0xa6, 0x0f, 0x61, 0x3c, 0x35, 0x3d, 0x79, 0xd3, 0xc0, 0xa1, 0xef, 0xe0, 0xc9, 0xec,
-	0xee, 0x80, 0x55, 0x68, 0x2f, 0x5e, 0x60, 0x98, 0xef, 0xc7, 0xf7, 0xff, 0xcd, 0xec, 0xcc, 0x82,
-	0x4c, 0x93, 0xb2, 0x1e, 0x65, 0x0a, 0xe3, 0xb8, 0x43, 0xfc, 0x96, 0x72, 0xb4, 0xd7, 0xf0, 0x38,
-	0xde, 0x53, 0xf0, 0x90, 0xb7, 0x8f, 0x51, 0x7f, 0x40, 0x39, 0x85, 0xf7, 0x22, 0x06, 0x09, 0x06,
-	0x09, 0x26, 0xbd, 0xde, 0xa2, 0x2d, 0x1a, 0x22, 0x4a, 0x30, 0x8a, 0xe8, 0xf4, 0x46, 0x44, 0x3b,
-	0x51, 0x41, 0xfc, 0x35, 0x2a, 0xc9, 0x22, 0xac, 0x81, 0x99, 0x37, 0x4f, 0x6a, 0x52, 0xe2, 0x8b,
-	0x7a, 0x12, 0xf7, 0x88, 0x4f, 0x95, 0xf0, 0x33, 0x9a, 0xca, 0xfc, 0x5a, 0x03, 0xb0, 0xc6, 0x71,
-	0xc7, 0x53, 0x87, 0xbc, 0x4d, 0x07, 0xe4, 0x18, 0x73, 0x42, 0x7d, 0xe8, 0x01, 0xd0, 0xc3, 0x23,
-	0x87, 0xd3, 0x8e, 0xe7, 0xb3, 0x94, 0xb4, 0x2d, 0x65, 0x6f, 0x17, 0x36, 0x90, 0x08, 0x0b, 0xda,
-	0xcf, 0x24, 0x51, 0x91, 0x12, 0x5f, 0xdb, 0xfd, 0xfc, 0x63, 0x6b, 0xa7, 0x45, 0x78, 0x7b, 0xd8,
-	0x40, 0x4d, 0xda, 0x13, 0x56, 0xe2, 0x2b, 0xcf, 0xdc, 0x8e, 0xc2, 0xc7, 0x7d, 0x8f, 0x85, 0xb0,
-	0x15, 0xef, 0xe1, 0x91, 0x1d, 0x36, 0x86, 0x1f, 0x24, 0x00, 0x70, 0xb7, 0x4b, 0xdf, 0x39, 0x5d,
-	0xc2, 0x78, 0x6a, 0x25, 0xcc, 0x79, 0x86, 0x96, 0xef, 0x07, 0x5a, 0xf4, 0x44, 0xaf, 0x71, 0x97,
-	0xb8, 0x98, 0xd3, 0x01, 0xd3, 0x9e, 0x7c, 0xb9, 0x38, 0xc9, 0xed, 0x5c, 0x8a, 0x5c, 0xc4, 0x15,
-	0x35, 0xc8, 0xaa, 0x10, 0xc6, 0xcb, 0x31, 0x2b, 0x8e, 0x67, 0x3f, 0xe0, 0x7b, 0x09, 0xc4, 0x5d,
-	0xcf, 0x1f, 0x47, 0x16, 0xab, 0xff, 0x63, 0xb1, 0x1b, 0x58, 0x3c, 0xba, 0xde, 0xa2, 0xe4, 0xf9,
-	0x63, 0x21, 0x71, 0xcb, 0x15, 0x63, 0xf8, 0x06, 0x40, 0x7c, 0x99, 0x72, 0x82, 0x1d, 0x4b, 0xad,
-	0x6d, 0x4b, 0xd9, 0xbb, 0x85, 0xc7, 0x57, 0xb9, 0xfc, 0xd5, 0xd7, 0x1e, 0xf7, 0x3d, 0x2b, 0x89,
-	0xff, 0x9d, 0x4a, 0xbf, 0x04, 0xe0, 0x8f, 0x20, 0x2c, 0x80, 0x9b, 0xd8, 0x75, 0x07, 0x1e, 0x0b,
-	0x1e, 0xeb, 0x6a, 0x36, 0xae, 0xa5, 0xce, 0x4f, 0xf3, 0xeb, 0xa2, 0xbf, 0x1a, 0x55, 0x6a, 0x7c,
-	0x40, 0xfc, 0x96, 0x35, 0x03, 0x5f, 0x94, 0xbf, 0x9d, 0xe6, 0xc5, 0x41, 0x46, 0xd1, 0xc1, 0x5d,
-	0x2a, 0xf0, 0xf1, 0xe2, 0x24, 0xb7, 0x79, 0xed, 0xd2, 0xb5, 0x3b, 0x00, 0x1c, 0xcd, 0x5d, 0x72,
-	0xe7, 0x12, 0x48, 0x2e, 0x2c, 0x01, 0x66, 0x80, 0xac, 0xd6, 0xed, 0x72, 0xd5, 0x32, 0x0e, 0x55,
-	0xdb, 0xa8, 0x9a, 0x8e, 0xfd, 0xf6, 0x40, 0x77, 0xea, 0x66, 0xed, 0x40, 0x2f, 0x1a, 0xaf, 0x0c,
-	0xbd, 0x94, 0x88, 0xc1, 0x2d, 0x70, 0x7f, 0x09, 0x53, 0xd2, 0x2b, 0xfa, 0xbe, 0x6a, 0xeb, 0x09,
-	0x09, 0x3e, 0x04, 0x9b, 0x4b, 0x9b, 0xcc, 0x91, 0x95, 0x2b, 0x10, 0x4b, 0x9f, 0x23, 0xab, 0xb0,
-	0x00, 0xd0, 0x12, 0xa4, 0xa8, 0x9a, 0x45, 0xbd, 0xe2, 0xd4, 0x4d, 0xad, 0x6a, 0x96, 0x0c, 0x73,
-	0x7f, 0x96, 0x6b, 0x54, 0xcd, 0xc4, 0x9a, 0xf6, 0xfc, 0xeb, 0x44, 0x96, 0xce, 0x26, 0xb2, 0xf4,
-	0x73, 0x22, 0x4b, 0x9f, 0xa6, 0x72, 0xec, 0x6c, 0x2a, 0xc7, 0xbe, 0x4f, 0xe5, 0xd8, 0xe1, 0x83,
-	0x68, 0x6f, 0x98, 0xdb, 0x41, 0x84, 0x2a, 0xa3, 0xf9, 0x3b, 0x21, 0xbc, 0x1b, 0x8d, 0x1b, 0xe1,
-	0x85, 0x7c, 0xfa, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xeb, 0x8f, 0x0f, 0x1f, 0x32, 0x04, 0x00, 0x00,
+	// 674 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xc1, 0x4f, 0x1a, 0x4d,
+	0x14, 0xc0, 0x19, 0x35, 0xdf, 0xf7, 0x31, 0xfa, 0x19, 0x98, 0x98, 0x06, 0x6d, 0x5d, 0x95, 0x34,
+	0x95, 0x62, 0xd9, 0x8d, 0x34, 0x6d, 0x1a, 0x4f, 0xdd, 0x85, 0xad, 0x92, 0x98, 0xc5, 0x2c, 0x68,
+	0x5a, 0x2f, 0x9b, 0x81, 0x9d, 0xc2, 0x06, 0xd8, 0x21, 0xcc, 0x48, 0xc5, 0x5b, 0x93, 0x9e, 0x7a,
+	0xea, 0xb1, 0x7f, 0x42, 0xd3, 0x93, 0x69, 0xfc, 0x23, 0x4c, 0xd3, 0x83, 0xf1, 0xd4, 0x53, 0xdb,
+	0xe8, 0xc1, 0xff, 0xa1, 0xa7, 0x86, 0xdd, 0x61, 0x01, 0x45, 0xdb, 0xa4, 0x17, 0x58, 0xe6, 0xfd,
+	0x78, 0xef, 0x37, 0x6f, 0xdf, 0x0c, 0x8c, 0x97, 0x29, 0x6b, 0x50, 0xa6, 0x30, 0x8e, 0x6b, 0x8e,
+	0x5b, 0x51, 0xda, 0xab, 0x25, 0xc2, 0xf1, 0xaa, 0x82, 0xf7, 0x78, 0xf5, 0x40, 0x6e, 0xb6, 0x28,
+	0xa7, 0xe8, 0x96, 0xcf, 0xc8, 0x82, 0x91, 0x05, 0x33, 0x37, 0x53, 0xa1, 0x15, 0xea, 0x21, 0x4a,
+	0xf7, 0xc9, 0xa7, 0xe7, 0x66, 0x7d, 0xda, 0xf2, 0x03, 0xe2, 0xaf, 0x7e, 0x48, 0x12, 0xc5, 0x4a,
+	0x98, 0x91, 0xa0, 0x52, 0x99, 0x3a, 0xae, 0x88, 0x47, 0x71, 0xc3, 0x71, 0xa9, 0xe2, 0x7d, 0xfa,
+	0x4b, 0xf1, 0x9f, 0x13, 0x10, 0x15, 0x38, 0xae, 0x11, 0x75, 0x8f, 0x57, 0x69, 0xcb, 0x39, 0xc0,
+	0xdc, 0xa1, 0x2e, 0x22, 0x10, 0x36, 0xf0, 0xbe, 0xc5, 0x69, 0x8d, 0xb8, 0x2c, 0x06, 0x16, 0x41,
+	0x62, 0x32, 0x3d, 0x2b, 0x8b, 0x62, 0xdd, 0xf4, 0x3d, 0x49, 0x39, 0x43, 0x1d, 0x57, 0x5b, 0xf9,
+	0xf8, 0x7d, 0x61, 0xb9, 0xe2, 0xf0, 0xea, 0x5e, 0x49, 0x2e, 0xd3, 0x86, 0xb0, 0x12, 0x5f, 0x29,
+	0x66, 0xd7, 0x14, 0xde, 0x69, 0x12, 0xe6, 0xc1, 0x66, 0xb8, 0x81, 0xf7, 0x8b, 0x5e, 0x62, 0xf4,
+	0x06, 0x40, 0x88, 0xeb, 0x75, 0xfa, 0xca, 0xaa, 0x3b, 0x8c, 0xc7, 0xc6, 0xbc, 0x3a, 0x8f, 0xe4,
+	0xd1, 0xfd, 0x90, 0xaf, 0x7a, 0xca, 0x3b, 0xb8, 0xee, 0xd8, 0x98, 0xd3, 0x16, 0xd3, 0x1e, 0x7c,
+	0xba, 0x38, 0x4c, 0x2e, 0x0f, 0x94, 0xbc, 0x8a, 0x2b, 0x6a, 0xb7, 0xd6, 0xa6, 0xc3, 0xf8, 0x46,
+	0xc8, 0x0c, 0xe3, 0xde, 0x0f, 0xf4, 0x1a, 0xc0, 0xb0, 0x4d, 0xdc, 0x8e, 0x6f, 0x31, 0xfe, 0x37,
+	0x16, 0x2b, 0x5d, 0x8b, 0x7b, 0x37, 0x5b, 0x64, 0x89, 0xdb, 0x11, 0x12, 0xff, 0xd9, 0xe2, 0x19,
+	0x3d, 0x87, 0x08, 0x0f, 0x52, 0x56, 0xb7, 0x63, 0xb1, 0x89, 0x45, 0x90, 0x98, 0x4e, 0xdf, 0xbf,
+	0xce, 0x65, 0x28, 0x6f, 0xb1, 0xd3, 0x24, 0x66, 0x14, 0x5f, 0x5e, 0x9a, 0x7b, 0x0a, 0x61, 0x5f,
+	0x10, 0xa5, 0xe1, 0xbf, 0xd8, 0xb6, 0x5b, 0x84, 0x75, 0x5f, 0xeb, 0x78, 0x22, 0xac, 0xc5, 0x4e,
+	0x8f, 0x52, 0x33, 0x22, 0xbf, 0xea, 0x47, 0x0a, 0xbc, 0xe5, 0xb8, 0x15, 0xb3, 0x07, 0xae, 0x6d,
+	0x7c, 0x3e, 0x4a, 0x89, 0x41, 0x96, 0xfd, 0xc1, 0x1d, 0x29, 0xf0, 0xf6, 0xe2, 0x30, 0x39, 0x7f,
+	0xe3, 0xd6, 0xb5, 0x29, 0x08, 0xdb, 0x81, 0x4b, 0xfc, 0x0b, 0x80, 0xf3, 0x59, 0x52, 0x27, 0x15,
+	0xcc, 0x49, 0xde, 0xd5, 0x48, 0x15, 0xd7, 0x5f, 0x0e, 0xcf, 0xe1, 0x0e, 0x9c, 0x0e, 0x78, 0xab,
+	0x8c, 0x9b, 0xbe, 0xf4, 0x64, 0xfa, 0xee, 0x75, 0x1d, 0x09, 0x76, 0x9a, 0xc1, 0x4d, 0x2d, 0x7c,
+	0xfc, 0x6d, 0x21, 0xf4, 0xe1, 0xe2, 0x30, 0x09, 0xcc, 0xff, 0xdb, 0x03, 0x01, 0xb6, 0x56, 0xf8,
+	0xf3, 0x1d, 0x25, 0x06, 0x76, 0x74, 0xa3, 0x6c, 0xfc, 0x3d, 0x80, 0x53, 0x83, 0xf5, 0x91, 0x01,
+	0xa3, 0x7d, 0xfb, 0x7e, 0xd7, 0x41, 0x22, 0xac, 0x2d, 0x9d, 0x1e, 0xa5, 0x44, 0xaf, 0xfa, 0xce,
+	0xc3, 0xed, 0x8f, 0xb4, 0x2f, 0xad, 0xa3, 0x27, 0x43, 0xa7, 0x72, 0xec, 0x37, 0xa7, 0x72, 0xe0,
+	0xa0, 0x25, 0x4f, 0x01, 0x8c, 0x5e, 0x19, 0x16, 0x14, 0x87, 0x92, 0xba, 0x5d, 0xdc, 0xc8, 0x9b,
+	0xb9, 0x5d, 0xb5, 0x98, 0xcb, 0x1b, 0x56, 0xf1, 0xc5, 0x96, 0x6e, 0x6d, 0x1b, 0x85, 0x2d, 0x3d,
+	0x93, 0x7b, 0x96, 0xd3, 0xb3, 0x91, 0x10, 0x5a, 0x80, 0xb7, 0x47, 0x30, 0x59, 0x7d, 0x53, 0x5f,
+	0x57, 0x8b, 0x7a, 0x04, 0xa0, 0x25, 0x38, 0x3f, 0x32, 0x49, 0x80, 0x8c, 0x5d, 0x83, 0x98, 0x7a,
+	0x80, 0x8c, 0xa3, 0x34, 0x94, 0x47, 0x20, 0x19, 0xd5, 0xc8, 0xe8, 0x9b, 0xd6, 0xb6, 0xa1, 0xe5,
+	0x8d, 0x6c, 0xce, 0x58, 0xef, 0xd5, 0xcd, 0xe5, 0x8d, 0xc8, 0x84, 0xf6, 0xf8, 0xf8, 0x4c, 0x02,
+	0x27, 0x67, 0x12, 0xf8, 0x71, 0x26, 0x81, 0x77, 0xe7, 0x52, 0xe8, 0xe4, 0x5c, 0x0a, 0x7d, 0x3d,
+	0x97, 0x42, 0xbb, 0x77, 0xfc, 0x9e, 0x30, 0xbb, 0x26, 0x3b, 0x54, 0xd9, 0x0f, 0x6e, 0x5f, 0xef,
+	0x16, 0x2a, 0xfd, 0xe3, 0x5d, 0x7d, 0x0f, 0x7f, 0x0d, 0x00, 0xfe, 0x02, 0x6f, 0xb6, 0x9c, 0x05,
+	0x00, 0x00,
 }
 
 func (m *StakeAuthorization) Marshal() (dAtA []byte, err error) {
@@ -394,6 +512,85 @@ func (m *StakeAuthorization_Validators) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *DelegateOnBehalfAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateOnBehalfAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateOnBehalfAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorCaps) > 0 {
+		for iNdEx := len(m.ValidatorCaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorCaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorCap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorCap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxTokens != nil {
+		{
+			size, err := m.MaxTokens.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuthz(v)
 	base := offset
@@ -463,6 +660,38 @@ func (m *StakeAuthorization_Validators) Size() (n int) {
 	return n
 }
 
+func (m *DelegateOnBehalfAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorCaps) > 0 {
+		for _, e := range m.ValidatorCaps {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.MaxTokens != nil {
+		l = m.MaxTokens.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
 func sovAuthz(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -726,6 +955,208 @@ func (m *StakeAuthorization_Validators) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DelegateOnBehalfAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegateOnBehalfAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegateOnBehalfAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorCaps = append(m.ValidatorCaps, ValidatorCap{})
+			if err := m.ValidatorCaps[len(m.ValidatorCaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorCap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorCap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorCap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxTokens == nil {
+				m.MaxTokens = &types.Coin{}
+			}
+			if err := m.MaxTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAuthz(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
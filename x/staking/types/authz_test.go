@@ -378,3 +378,69 @@ func TestAuthzAuthorizations(t *testing.T) {
 		})
 	}
 }
+
+func TestStakeAuthorizationIncreaseLimit(t *testing.T) {
+	unlimited, err := stakingtypes.NewStakeAuthorization([]sdk.ValAddress{val1}, nil, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, nil)
+	require.NoError(t, err)
+	require.Error(t, unlimited.IncreaseLimit(sdk.NewCoins(coin50)))
+
+	limited, err := stakingtypes.NewStakeAuthorization([]sdk.ValAddress{val1}, nil, stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE, &coin100)
+	require.NoError(t, err)
+	require.Error(t, limited.IncreaseLimit(sdk.NewCoins(sdk.NewInt64Coin("stake", 50))))
+	require.NoError(t, limited.IncreaseLimit(sdk.NewCoins(coin50)))
+	require.Equal(t, coin150, *limited.MaxTokens)
+}
+
+func TestDelegateOnBehalfAuthorization(t *testing.T) {
+	key := storetypes.NewKVStoreKey(stakingtypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithHeaderInfo(coreheader.Info{})
+
+	_, err := stakingtypes.NewDelegateOnBehalfAuthorization()
+	require.Error(t, err)
+	_, err = stakingtypes.NewDelegateOnBehalfAuthorization(
+		stakingtypes.ValidatorCap{ValidatorAddress: val1.String()},
+		stakingtypes.ValidatorCap{ValidatorAddress: val1.String()},
+	)
+	require.ErrorContains(t, err, "duplicate validator address")
+
+	auth, err := stakingtypes.NewDelegateOnBehalfAuthorization(
+		stakingtypes.ValidatorCap{ValidatorAddress: val1.String(), MaxTokens: &coin150},
+		stakingtypes.ValidatorCap{ValidatorAddress: val2.String()},
+	)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MsgTypeURL(&stakingtypes.MsgExecStakingOperations{}), auth.MsgTypeURL())
+
+	execMsg := func(ops ...stakingtypes.StakingOperation) *stakingtypes.MsgExecStakingOperations {
+		return &stakingtypes.MsgExecStakingOperations{DelegatorAddress: delAddr.String(), Operations: ops}
+	}
+	delegate := func(val sdk.ValAddress, amount sdk.Coin) stakingtypes.StakingOperation {
+		return stakingtypes.StakingOperation{OperationType: stakingtypes.StakingOperationTypeDelegate, ValidatorAddress: val.String(), Amount: amount}
+	}
+	redelegate := func(src, dst sdk.ValAddress, amount sdk.Coin) stakingtypes.StakingOperation {
+		return stakingtypes.StakingOperation{OperationType: stakingtypes.StakingOperationTypeRedelegate, ValidatorAddress: src.String(), ValidatorDstAddress: dst.String(), Amount: amount}
+	}
+	restake := stakingtypes.StakingOperation{OperationType: stakingtypes.StakingOperationTypeRestakeRewards, ValidatorAddress: val1.String()}
+
+	// unlisted validators are rejected
+	_, err = auth.Accept(ctx, execMsg(delegate(val3, coin50)))
+	require.ErrorContains(t, err, "cannot operate on")
+	_, err = auth.Accept(ctx, execMsg(redelegate(val2, val3, coin50)))
+	require.ErrorContains(t, err, "cannot operate on")
+
+	// the amounts are deducted from the caps of the destination validators
+	resp, err := auth.Accept(ctx, execMsg(delegate(val1, coin50), redelegate(val1, val2, coin100), redelegate(val2, val1, coin50), restake))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Equal(t, &stakingtypes.DelegateOnBehalfAuthorization{ValidatorCaps: []stakingtypes.ValidatorCap{
+		{ValidatorAddress: val1.String(), MaxTokens: &coin50},
+		{ValidatorAddress: val2.String()},
+	}}, resp.Updated)
+	// the original authorization is left untouched
+	require.Equal(t, &coin150, auth.ValidatorCaps[0].MaxTokens)
+
+	// the caps cannot be exceeded
+	_, err = resp.Updated.(*stakingtypes.DelegateOnBehalfAuthorization).Accept(ctx, execMsg(delegate(val1, coin100)))
+	require.ErrorContains(t, err, "cannot exceed the cap")
+}
@@ -19,11 +19,30 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgCancelUnbondingDelegation{}, "cosmos-sdk/MsgCancelUnbondingDelegation")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/staking/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgRotateConsPubKey{}, "cosmos-sdk/MsgRotateConsPubKey")
+	legacy.RegisterAminoMsg(cdc, &MsgTokenizeDelegation{}, "cosmos-sdk/MsgTokenizeDelegation")
+	legacy.RegisterAminoMsg(cdc, &MsgTransferDelegationPosition{}, "cosmos-sdk/MsgTransferDelPosition")
+	legacy.RegisterAminoMsg(cdc, &MsgRedeemDelegationPosition{}, "cosmos-sdk/MsgRedeemDelegationPosition")
+	legacy.RegisterAminoMsg(cdc, &MsgTokenizeShares{}, "cosmos-sdk/MsgTokenizeShares")
+	legacy.RegisterAminoMsg(cdc, &MsgRedeemTokensForShares{}, "cosmos-sdk/MsgRedeemTokensForShares")
+	legacy.RegisterAminoMsg(cdc, &MsgTransferTokenizeShareRecord{}, "cosmos-sdk/MsgTransferTokenizeRecord")
+	legacy.RegisterAminoMsg(cdc, &MsgSetAutoCompound{}, "cosmos-sdk/MsgSetAutoCompound")
+	legacy.RegisterAminoMsg(cdc, &MsgScheduleCommissionChange{}, "cosmos-sdk/MsgScheduleCommissionChange")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelCommissionChange{}, "cosmos-sdk/MsgCancelCommissionChange")
+	legacy.RegisterAminoMsg(cdc, &MsgFlashUndelegate{}, "cosmos-sdk/MsgFlashUndelegate")
+	legacy.RegisterAminoMsg(cdc, &MsgExecStakingOperations{}, "cosmos-sdk/MsgExecStakingOperations")
+	legacy.RegisterAminoMsg(cdc, &MsgSetBondableDenom{}, "cosmos-sdk/MsgSetBondableDenom")
+	legacy.RegisterAminoMsg(cdc, &MsgDelegateMultiAsset{}, "cosmos-sdk/MsgDelegateMultiAsset")
+	legacy.RegisterAminoMsg(cdc, &MsgUndelegateMultiAsset{}, "cosmos-sdk/MsgUndelegateMultiAsset")
+	legacy.RegisterAminoMsg(cdc, &MsgScheduleMaxValidators{}, "cosmos-sdk/MsgScheduleMaxValidators")
+	legacy.RegisterAminoMsg(cdc, &MsgApproveRedelegationExemption{}, "cosmos-sdk/MsgApproveRedelegationExempt")
+	legacy.RegisterAminoMsg(cdc, &MsgForceUndelegate{}, "cosmos-sdk/MsgForceUndelegate")
+	legacy.RegisterAminoMsg(cdc, &MsgUnfreezeDelegation{}, "cosmos-sdk/MsgUnfreezeDelegation")
 
 	cdc.RegisterInterface((*isStakeAuthorization_Validators)(nil), nil)
 	cdc.RegisterConcrete(&StakeAuthorization_AllowList{}, "cosmos-sdk/StakeAuthorization/AllowList", nil)
 	cdc.RegisterConcrete(&StakeAuthorization_DenyList{}, "cosmos-sdk/StakeAuthorization/DenyList", nil)
 	cdc.RegisterConcrete(&StakeAuthorization{}, "cosmos-sdk/StakeAuthorization", nil)
+	cdc.RegisterConcrete(&DelegateOnBehalfAuthorization{}, "cosmos-sdk/DelegateOnBehalfAuthorization", nil)
 	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/staking/Params", nil)
 }
 
@@ -37,6 +56,24 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgBeginRedelegate{},
 		&MsgCancelUnbondingDelegation{},
 		&MsgUpdateParams{},
+		&MsgTokenizeDelegation{},
+		&MsgTransferDelegationPosition{},
+		&MsgRedeemDelegationPosition{},
+		&MsgTokenizeShares{},
+		&MsgRedeemTokensForShares{},
+		&MsgTransferTokenizeShareRecord{},
+		&MsgSetAutoCompound{},
+		&MsgScheduleCommissionChange{},
+		&MsgCancelCommissionChange{},
+		&MsgFlashUndelegate{},
+		&MsgExecStakingOperations{},
+		&MsgSetBondableDenom{},
+		&MsgDelegateMultiAsset{},
+		&MsgUndelegateMultiAsset{},
+		&MsgScheduleMaxValidators{},
+		&MsgApproveRedelegationExemption{},
+		&MsgForceUndelegate{},
+		&MsgUnfreezeDelegation{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
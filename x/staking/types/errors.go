@@ -52,4 +52,49 @@ var (
 	ErrConsensusPubKeyAlreadyUsedForValidator = errors.Register(ModuleName, 46, "consensus pubkey is already used for a validator")
 	ErrExceedingMaxConsPubKeyRotations        = errors.Register(ModuleName, 47, "exceeding maximum consensus pubkey rotations within unbonding period")
 	ErrConsensusPubKeyLenInvalid              = errors.Register(ModuleName, 48, "consensus pubkey len is invalid")
+
+	ErrValidatorNotAllowlisted = errors.Register(ModuleName, 49, "validator operator address is not in the validator allowlist")
+
+	// delegation position errors
+	ErrInvalidDelegationPosition = errors.Register(ModuleName, 50, "invalid delegation position")
+	ErrDelegationSharesLocked    = errors.Register(ModuleName, 51, "delegation shares are locked in delegation positions")
+	ErrSelfDelegationPosition    = errors.Register(ModuleName, 52, "validator self-delegation cannot be tokenized")
+
+	// liquid staking errors
+	ErrTokenizeShareRecordNotExists      = errors.Register(ModuleName, 53, "tokenize share record not exists")
+	ErrNotTokenizeShareRecordOwner       = errors.Register(ModuleName, 54, "not tokenize share record owner")
+	ErrGlobalLiquidStakingCapExceeded    = errors.Register(ModuleName, 55, "tokenization exceeds the global liquid staking cap")
+	ErrValidatorLiquidStakingCapExceeded = errors.Register(ModuleName, 56, "tokenization exceeds the validator liquid staking cap")
+	ErrExceedingFreeVestingDelegations   = errors.Register(ModuleName, 57, "trying to exceed vested free delegation for vesting account")
+	ErrTinyTokenizeShares                = errors.Register(ModuleName, 58, "too few tokens to tokenize (truncates to zero shares)")
+
+	// auto-compound errors
+	ErrAutoCompoundDisabled = errors.Register(ModuleName, 59, "auto-compounding is disabled")
+
+	// scheduled commission change errors
+	ErrNoScheduledCommissionChange = errors.Register(ModuleName, 60, "no scheduled commission change found")
+
+	// flash undelegation errors
+	ErrFlashUndelegationDisabled      = errors.Register(ModuleName, 61, "flash undelegations are disabled")
+	ErrFlashUndelegationQuotaExceeded = errors.Register(ModuleName, 62, "flash undelegation exceeds the validator quota for this block")
+
+	// staking operations errors
+	ErrRestakeUnavailable = errors.Register(ModuleName, 63, "restaking of rewards is unavailable")
+
+	// precompile errors
+	ErrPrecompileReentrancy = errors.Register(ModuleName, 64, "reentrant call to the staking precompile API")
+
+	// multi-asset staking errors
+	ErrDenomNotBondable = errors.Register(ModuleName, 65, "denom is not bondable")
+
+	// ErrInvalidMaxValidatorsChange is returned when a scheduled change of the MaxValidators param is invalid.
+	ErrInvalidMaxValidatorsChange = errors.Register(ModuleName, 66, "invalid max validators change")
+
+	// ErrInvalidRedelegationExemption is returned when a redelegation exemption is invalid.
+	ErrInvalidRedelegationExemption = errors.Register(ModuleName, 67, "invalid redelegation exemption")
+
+	// forced undelegation errors
+	ErrForcedUndelegationDisabled = errors.Register(ModuleName, 68, "forced undelegations are disabled")
+	ErrDelegationFrozen           = errors.Register(ModuleName, 69, "delegation is frozen")
+	ErrDelegationNotFrozen        = errors.Register(ModuleName, 70, "delegation is not frozen")
 )
@@ -2,14 +2,33 @@ package types
 
 // staking module event types
 const (
-	EventTypeCompleteUnbonding         = "complete_unbonding"
-	EventTypeCompleteRedelegation      = "complete_redelegation"
-	EventTypeCreateValidator           = "create_validator"
-	EventTypeEditValidator             = "edit_validator"
-	EventTypeDelegate                  = "delegate"
-	EventTypeUnbond                    = "unbond"
-	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
-	EventTypeRedelegate                = "redelegate"
+	EventTypeCompleteUnbonding            = "complete_unbonding"
+	EventTypeCompleteRedelegation         = "complete_redelegation"
+	EventTypeCreateValidator              = "create_validator"
+	EventTypeEditValidator                = "edit_validator"
+	EventTypeDelegate                     = "delegate"
+	EventTypeUnbond                       = "unbond"
+	EventTypeCancelUnbondingDelegation    = "cancel_unbonding_delegation"
+	EventTypeRedelegate                   = "redelegate"
+	EventTypeTokenizeDelegation           = "tokenize_delegation"
+	EventTypeTransferPosition             = "transfer_delegation_position"
+	EventTypeRedeemPosition               = "redeem_delegation_position"
+	EventTypeTokenizeShares               = "tokenize_shares"
+	EventTypeRedeemShares                 = "redeem_tokens_for_shares"
+	EventTypeTransferTokenizeShareRecord  = "transfer_tokenize_share_record"
+	EventTypeSetAutoCompound              = "set_auto_compound"
+	EventTypeAutoCompound                 = "auto_compound"
+	EventTypeScheduleCommissionChange     = "schedule_commission_change"
+	EventTypeCancelCommissionChange       = "cancel_commission_change"
+	EventTypeApplyCommissionChange        = "apply_commission_change"
+	EventTypeFailCommissionChange         = "fail_commission_change"
+	EventTypeFlashUndelegate              = "flash_undelegate"
+	EventTypeRestakeRewards               = "restake_rewards"
+	EventTypeApplyMaxValidatorsChange     = "apply_max_validators_change"
+	EventTypeApproveRedelegationExemption = "approve_redelegation_exemption"
+	EventTypeForceUndelegate              = "force_undelegate"
+	EventTypeFreezeDelegation             = "freeze_delegation"
+	EventTypeUnfreezeDelegation           = "unfreeze_delegation"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -20,4 +39,16 @@ const (
 	AttributeKeyCreationHeight    = "creation_height"
 	AttributeKeyCompletionTime    = "completion_time"
 	AttributeKeyNewShares         = "new_shares"
+	AttributeKeyPositionID        = "position_id"
+	AttributeKeyOwner             = "owner"
+	AttributeKeyRecipient         = "recipient"
+	AttributeKeyShareRecordID     = "share_record_id"
+	AttributeKeyShareOwner        = "share_owner"
+	AttributeKeyEnabled           = "enabled"
+	AttributeKeyEffectiveTime     = "effective_time"
+	AttributeKeyReason            = "reason"
+	AttributeKeyPenalty           = "penalty"
+	AttributeKeyMaxValidators     = "max_validators"
+	AttributeKeyExpiry            = "expiry"
+	AttributeKeyAuthority         = "authority"
 )
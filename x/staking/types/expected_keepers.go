@@ -39,7 +39,10 @@ type BankKeeper interface {
 	SendCoinsFromModuleToModule(ctx context.Context, senderPool, recipientPool string, amt sdk.Coins) error
 	UndelegateCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	DelegateCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 
+	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(context.Context, []byte, sdk.Coins) error
 }
 
@@ -110,6 +113,45 @@ type StakingHooks interface {
 	AfterConsensusPubKeyUpdate(ctx context.Context, oldPubKey, newPubKey cryptotypes.PubKey, rotationFee sdk.Coin) error
 }
 
+// StakingAmountHooks are optionally implemented by the StakingHooks needing the
+// amounts of the delegation and unbonding transitions, which spares them from
+// reading them back from the staking state. The staking keeper calls them along
+// the StakingHooks of the same transitions.
+type StakingAmountHooks interface {
+	BeforeDelegationSharesModifiedWithDelta(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesDelta math.LegacyDec) error // Must be called when a delegation's shares are modified, with the signed change of its shares
+	AfterUnbondingAmountChanged(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amountDelta math.Int) error                   // Must be called when the tokens unbonding from a validator change, with the signed change of the tokens
+}
+
+// ValidatorPerformanceScorer scores the on-chain performance of a validator,
+// such as its uptime or its participation in governance. The staking keeper uses
+// the scores to select, among the validators with the same power contending for
+// the last seats of the active set, the ones joining it. Higher scores win.
+type ValidatorPerformanceScorer interface {
+	PerformanceScore(ctx context.Context, valAddr sdk.ValAddress) (math.LegacyDec, error)
+}
+
+// DelegationRewardsWithdrawer withdraws the rewards of a delegation, paying them
+// to the withdraw address of the delegator. The staking keeper uses it to
+// auto-compound the rewards of the delegations opted into auto-compounding.
+type DelegationRewardsWithdrawer interface {
+	WithdrawDelegationRewards(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error)
+}
+
+// CommunityPoolFunder funds the community pool. The staking keeper uses it to
+// collect the penalties of the flash undelegations.
+type CommunityPoolFunder interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// BondedTokenHooks are notified by the precompile API of the staking keeper of
+// the tokens bonded by a delegator to a validator once they changed, so that a
+// representation of them, such as the state of an EVM staking precompile, can
+// be kept in sync. They are called once the staking state is fully updated,
+// and cannot call the precompile API back.
+type BondedTokenHooks interface {
+	AfterBondedTokensChanged(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, bondedTokens math.Int) error
+}
+
 // StakingHooksWrapper is a wrapper for modules to inject StakingHooks using depinject.
 type StakingHooksWrapper struct{ StakingHooks }
 
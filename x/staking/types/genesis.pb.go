@@ -46,6 +46,36 @@ type GenesisState struct {
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	// exported defines a bool to identify whether the chain dealing with exported or initialized genesis.
 	Exported bool `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// delegation_positions defines the delegation positions at genesis.
+	DelegationPositions []DelegationPosition `protobuf:"bytes,9,rep,name=delegation_positions,json=delegationPositions,proto3" json:"delegation_positions"`
+	// tokenize_share_records defines the tokenize share records at genesis.
+	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,10,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records"`
+	// auto_compound_settings defines the auto-compounding delegations at genesis.
+	AutoCompoundSettings []AutoCompoundSetting `protobuf:"bytes,11,rep,name=auto_compound_settings,json=autoCompoundSettings,proto3" json:"auto_compound_settings"`
+	// scheduled_commission_changes defines the pending commission rate changes at
+	// genesis.
+	ScheduledCommissionChanges []ScheduledCommissionChange `protobuf:"bytes,12,rep,name=scheduled_commission_changes,json=scheduledCommissionChanges,proto3" json:"scheduled_commission_changes"`
+	// bondable_denoms defines the bondable denoms other than the bond denom at
+	// genesis.
+	BondableDenoms []BondableDenom `protobuf:"bytes,13,rep,name=bondable_denoms,json=bondableDenoms,proto3" json:"bondable_denoms"`
+	// multi_asset_pools defines the tokens of the bondable denoms delegated to
+	// the validators at genesis.
+	MultiAssetPools []MultiAssetPool `protobuf:"bytes,14,rep,name=multi_asset_pools,json=multiAssetPools,proto3" json:"multi_asset_pools"`
+	// multi_asset_delegations defines the delegations of the bondable denoms at
+	// genesis.
+	MultiAssetDelegations []MultiAssetDelegation `protobuf:"bytes,15,rep,name=multi_asset_delegations,json=multiAssetDelegations,proto3" json:"multi_asset_delegations"`
+	// multi_asset_unbondings defines the unbondings of the bondable denoms at
+	// genesis.
+	MultiAssetUnbondings []MultiAssetUnbonding `protobuf:"bytes,16,rep,name=multi_asset_unbondings,json=multiAssetUnbondings,proto3" json:"multi_asset_unbondings"`
+	// max_validators_schedule defines the scheduled changes of the MaxValidators
+	// param at genesis.
+	MaxValidatorsSchedule []MaxValidatorsChange `protobuf:"bytes,17,rep,name=max_validators_schedule,json=maxValidatorsSchedule,proto3" json:"max_validators_schedule"`
+	// redelegation_exemptions defines the redelegation exemptions approved by
+	// governance at genesis.
+	RedelegationExemptions []RedelegationExemption `protobuf:"bytes,18,rep,name=redelegation_exemptions,json=redelegationExemptions,proto3" json:"redelegation_exemptions"`
+	// frozen_delegations defines the delegator-validator pairs whose
+	// delegations are frozen by the forced undelegation authority at genesis.
+	FrozenDelegations []DVPair `protobuf:"bytes,19,rep,name=frozen_delegations,json=frozenDelegations,proto3" json:"frozen_delegations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -130,6 +160,83 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetDelegationPositions() []DelegationPosition {
+	if m != nil {
+		return m.DelegationPositions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoCompoundSettings() []AutoCompoundSetting {
+	if m != nil {
+		return m.AutoCompoundSettings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduledCommissionChanges() []ScheduledCommissionChange {
+	if m != nil {
+		return m.ScheduledCommissionChanges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBondableDenoms() []BondableDenom {
+	if m != nil {
+		return m.BondableDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMultiAssetPools() []MultiAssetPool {
+	if m != nil {
+		return m.MultiAssetPools
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMultiAssetDelegations() []MultiAssetDelegation {
+	if m != nil {
+		return m.MultiAssetDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMultiAssetUnbondings() []MultiAssetUnbonding {
+	if m != nil {
+		return m.MultiAssetUnbondings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMaxValidatorsSchedule() []MaxValidatorsChange {
+	if m != nil {
+		return m.MaxValidatorsSchedule
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRedelegationExemptions() []RedelegationExemption {
+	if m != nil {
+		return m.RedelegationExemptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFrozenDelegations() []DVPair {
+	if m != nil {
+		return m.FrozenDelegations
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -181,39 +288,60 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 501 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x18, 0x86, 0x6d, 0x42, 0xd2, 0xf4, 0x52, 0x10, 0x1c, 0x09, 0x32, 0x11, 0x72, 0x42, 0xd4, 0x21,
-	0x2a, 0xaa, 0x4d, 0x83, 0xc4, 0xc0, 0xd6, 0x08, 0x09, 0x55, 0xaa, 0x44, 0xe5, 0x52, 0x86, 0x2e,
-	0xd1, 0x05, 0x9f, 0xdc, 0x53, 0x9c, 0x3b, 0xcb, 0xf7, 0xb5, 0x94, 0x7f, 0xc0, 0xc8, 0xc6, 0xda,
-	0x91, 0x91, 0xa1, 0x3f, 0xa2, 0x63, 0xd5, 0x09, 0x31, 0x54, 0x28, 0x19, 0xe0, 0x67, 0x20, 0xdf,
-	0x39, 0xe6, 0xaa, 0xd4, 0x4b, 0x94, 0xcb, 0xf7, 0xbc, 0xcf, 0xfb, 0x49, 0xb9, 0x43, 0xeb, 0x1f,
-	0x85, 0x9c, 0x0a, 0xe9, 0x4b, 0x20, 0x13, 0xc6, 0x23, 0xff, 0x64, 0x6b, 0x4c, 0x81, 0x6c, 0xf9,
-	0x11, 0xe5, 0x54, 0x32, 0xe9, 0x25, 0xa9, 0x00, 0x81, 0x1f, 0x6b, 0xca, 0xcb, 0x29, 0x2f, 0xa7,
-	0xda, 0xcd, 0x48, 0x44, 0x42, 0x21, 0x7e, 0xf6, 0x4d, 0xd3, 0xed, 0x32, 0xe7, 0x22, 0xad, 0xa9,
-	0x27, 0x9a, 0x1a, 0xe9, 0x78, 0x5e, 0xa0, 0x47, 0x0f, 0xc9, 0x94, 0x71, 0xe1, 0xab, 0x4f, 0xfd,
-	0x53, 0xef, 0x5b, 0x15, 0xad, 0xbd, 0xd5, 0x3b, 0xed, 0x03, 0x01, 0x8a, 0xb7, 0x51, 0x2d, 0x21,
-	0x29, 0x99, 0x4a, 0xc7, 0xee, 0xda, 0xfd, 0xc6, 0xc0, 0xf5, 0x6e, 0xdf, 0xd1, 0xdb, 0x53, 0xd4,
-	0x70, 0xf5, 0xe2, 0xba, 0x63, 0x7d, 0xff, 0xf3, 0x63, 0xc3, 0x0e, 0xf2, 0x20, 0x3e, 0x44, 0x0f,
-	0x62, 0x22, 0x61, 0x04, 0x02, 0x48, 0x3c, 0x4a, 0xc4, 0x27, 0x9a, 0x3a, 0x77, 0xba, 0x76, 0x7f,
-	0x6d, 0xf8, 0x22, 0x83, 0x7f, 0x5d, 0x77, 0x5a, 0xda, 0x29, 0xc3, 0x89, 0xc7, 0x84, 0x3f, 0x25,
-	0x70, 0xe4, 0xed, 0x70, 0xb8, 0x3a, 0xdf, 0x44, 0x79, 0xd9, 0x0e, 0x07, 0xed, 0xbc, 0x9f, 0x99,
-	0xde, 0x67, 0xa2, 0xbd, 0xcc, 0x83, 0x19, 0x6a, 0x29, 0xf7, 0x09, 0x89, 0x59, 0x48, 0x40, 0xa4,
-	0xda, 0x2f, 0x9d, 0x4a, 0xb7, 0xd2, 0x6f, 0x0c, 0x36, 0xca, 0xb6, 0xdd, 0x25, 0x12, 0x3e, 0x2c,
-	0x32, 0x4a, 0x65, 0x6e, 0xfe, 0x28, 0x5e, 0x1a, 0x4b, 0xbc, 0x8b, 0x50, 0xd1, 0x22, 0x9d, 0xbb,
-	0xca, 0xff, 0xac, 0xcc, 0x5f, 0x84, 0x4d, 0xad, 0x91, 0xc7, 0xef, 0x50, 0x23, 0xa4, 0x31, 0x8d,
-	0x08, 0x30, 0xc1, 0xa5, 0x53, 0x55, 0xba, 0x5e, 0x99, 0xee, 0x4d, 0x81, 0x9a, 0x3e, 0xd3, 0x80,
-	0x27, 0xa8, 0x75, 0xcc, 0xc7, 0x82, 0x87, 0x8c, 0x47, 0x23, 0x53, 0x5d, 0x53, 0xea, 0xe7, 0x65,
-	0xea, 0x83, 0x45, 0xe8, 0xf6, 0x8e, 0xe6, 0xf1, 0xf2, 0x5c, 0xe2, 0x03, 0x74, 0x2f, 0xa5, 0x66,
-	0xc9, 0x8a, 0x2a, 0x59, 0x2f, 0x2b, 0x09, 0x0c, 0xd8, 0xb4, 0xdf, 0xb4, 0xe0, 0x36, 0xaa, 0xd3,
-	0xd3, 0x44, 0xa4, 0x40, 0x43, 0xa7, 0xde, 0xb5, 0xfb, 0xf5, 0xa0, 0x38, 0xf7, 0x8e, 0x10, 0x5e,
-	0xfe, 0xd3, 0xf0, 0x00, 0xad, 0x90, 0x30, 0x4c, 0xa9, 0xd4, 0xf7, 0x73, 0x75, 0xe8, 0x5c, 0x9d,
-	0x6f, 0x36, 0xf3, 0x2d, 0xb6, 0xf5, 0x64, 0x1f, 0x52, 0xc6, 0xa3, 0x60, 0x01, 0xe2, 0x26, 0xaa,
-	0xfe, 0xbf, 0x84, 0x95, 0x40, 0x1f, 0x5e, 0xd7, 0xbf, 0x9c, 0x75, 0xac, 0xbf, 0x67, 0x1d, 0x6b,
-	0xf8, 0xea, 0x62, 0xe6, 0xda, 0x97, 0x33, 0xd7, 0xfe, 0x3d, 0x73, 0xed, 0xaf, 0x73, 0xd7, 0xba,
-	0x9c, 0xbb, 0xd6, 0xcf, 0xb9, 0x6b, 0x1d, 0x3e, 0xbd, 0x71, 0x4f, 0x4f, 0x8b, 0x97, 0x07, 0x9f,
-	0x13, 0x2a, 0xc7, 0x35, 0xf5, 0x84, 0x5e, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x45, 0x8a, 0xed,
-	0xf3, 0xec, 0x03, 0x00, 0x00,
+	// 841 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x96, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xbd, 0x84, 0xa6, 0xce, 0x38, 0x75, 0xea, 0xa9, 0x9d, 0x2c, 0x56, 0x65, 0x9b, 0xa8,
+	0x20, 0xab, 0x10, 0x9b, 0x04, 0x89, 0x03, 0x37, 0x3b, 0x41, 0xa8, 0x52, 0x11, 0x96, 0xdd, 0x56,
+	0x50, 0x09, 0xad, 0xc6, 0xde, 0x61, 0xbd, 0xf2, 0xee, 0xcc, 0xb2, 0x6f, 0x5c, 0x4c, 0x0f, 0x9c,
+	0x39, 0xf2, 0x11, 0x7a, 0xe4, 0x88, 0x44, 0x3f, 0x44, 0x8f, 0x55, 0x4f, 0x88, 0x43, 0x85, 0x92,
+	0x03, 0x7c, 0x0c, 0xb4, 0x33, 0xbb, 0xeb, 0x59, 0xad, 0xd7, 0xe1, 0x12, 0x65, 0xf3, 0xfe, 0xff,
+	0xff, 0x6f, 0x34, 0xf3, 0x66, 0x5e, 0xd0, 0xbd, 0x19, 0x07, 0x9f, 0x43, 0x1f, 0x04, 0x59, 0xb8,
+	0xcc, 0xe9, 0x3f, 0x3b, 0x9d, 0x52, 0x41, 0x4e, 0xfb, 0x0e, 0x65, 0x14, 0x5c, 0xe8, 0x05, 0x21,
+	0x17, 0x1c, 0x1f, 0x2a, 0x55, 0x2f, 0x56, 0xf5, 0x62, 0x55, 0xb3, 0xee, 0x70, 0x87, 0x4b, 0x49,
+	0x3f, 0xfa, 0x4d, 0xa9, 0x9b, 0x45, 0x99, 0x89, 0x5b, 0xa9, 0xde, 0x53, 0x2a, 0x4b, 0xd9, 0x63,
+	0x80, 0x2a, 0xd5, 0x88, 0xef, 0x32, 0xde, 0x97, 0x3f, 0xd5, 0x9f, 0x8e, 0xff, 0xa8, 0xa2, 0xfd,
+	0x2f, 0xd5, 0x9a, 0x26, 0x82, 0x08, 0x8a, 0x07, 0x68, 0x37, 0x20, 0x21, 0xf1, 0xc1, 0x34, 0x3a,
+	0x46, 0xb7, 0x72, 0xd6, 0xea, 0x6d, 0x5e, 0x63, 0x6f, 0x24, 0x55, 0xc3, 0xbd, 0x57, 0x6f, 0xdb,
+	0xa5, 0xdf, 0xfe, 0xf9, 0xfd, 0xbe, 0x31, 0x8e, 0x8d, 0xf8, 0x29, 0xba, 0xed, 0x11, 0x10, 0x96,
+	0xe0, 0x82, 0x78, 0x56, 0xc0, 0x7f, 0xa4, 0xa1, 0xf9, 0x4e, 0xc7, 0xe8, 0xee, 0x0f, 0x3f, 0x89,
+	0xc4, 0x7f, 0xbd, 0x6d, 0x37, 0x54, 0x26, 0xd8, 0x8b, 0x9e, 0xcb, 0xfb, 0x3e, 0x11, 0xf3, 0xde,
+	0x03, 0x26, 0xde, 0xbc, 0x3c, 0x41, 0x31, 0xec, 0x01, 0x13, 0x2a, 0xb3, 0x1a, 0x25, 0x3d, 0x8a,
+	0x82, 0x46, 0x51, 0x0e, 0x76, 0x51, 0x43, 0x66, 0x3f, 0x23, 0x9e, 0x6b, 0x13, 0xc1, 0x43, 0x95,
+	0x0f, 0xe6, 0x4e, 0x67, 0xa7, 0x5b, 0x39, 0xbb, 0x5f, 0xb4, 0xda, 0x87, 0x04, 0xc4, 0x93, 0xc4,
+	0x23, 0xa3, 0xf4, 0x95, 0xdf, 0xf1, 0x72, 0x65, 0xc0, 0x0f, 0x11, 0x4a, 0x29, 0x60, 0xbe, 0x2b,
+	0xf3, 0xdf, 0x2f, 0xca, 0x4f, 0xcd, 0x7a, 0xac, 0xe6, 0xc7, 0x5f, 0xa3, 0x8a, 0x4d, 0x3d, 0xea,
+	0x10, 0xe1, 0x72, 0x06, 0xe6, 0x0d, 0x19, 0x77, 0x5c, 0x14, 0x77, 0x91, 0x4a, 0xf5, 0x3c, 0x3d,
+	0x01, 0x2f, 0x50, 0x63, 0xc9, 0xa6, 0x9c, 0xd9, 0x2e, 0x73, 0x2c, 0x3d, 0x7a, 0x57, 0x46, 0x7f,
+	0x54, 0x14, 0xfd, 0x38, 0x31, 0x6d, 0x66, 0xd4, 0x97, 0xf9, 0x3a, 0xe0, 0xc7, 0xe8, 0x56, 0x48,
+	0x75, 0xc8, 0x4d, 0x09, 0xb9, 0x57, 0x04, 0x19, 0x53, 0x7b, 0x63, 0x7a, 0x36, 0x05, 0x37, 0x51,
+	0x99, 0xae, 0x02, 0x1e, 0x0a, 0x6a, 0x9b, 0xe5, 0x8e, 0xd1, 0x2d, 0x8f, 0xd3, 0x6f, 0x3c, 0x47,
+	0xf5, 0xb5, 0xd4, 0x0a, 0x38, 0xb8, 0x8a, 0xbc, 0xb7, 0xfd, 0xa0, 0xd7, 0xab, 0x1e, 0xc5, 0x96,
+	0xcc, 0x41, 0xdb, 0xb9, 0x32, 0x60, 0x0f, 0x1d, 0x0a, 0xbe, 0xa0, 0xcc, 0x7d, 0x4e, 0x2d, 0x98,
+	0x93, 0x90, 0x5a, 0x21, 0x9d, 0xf1, 0xd0, 0x06, 0x13, 0x6d, 0xdf, 0xca, 0x47, 0xb1, 0x6b, 0x12,
+	0x99, 0xc6, 0xd2, 0x93, 0xd9, 0x4a, 0x91, 0xaf, 0x4b, 0x1a, 0x59, 0x0a, 0x6e, 0xcd, 0xb8, 0x1f,
+	0xf0, 0x25, 0xb3, 0x2d, 0xa0, 0x42, 0xb8, 0xcc, 0x01, 0xb3, 0xb2, 0x9d, 0x36, 0x58, 0x0a, 0x7e,
+	0x1e, 0x9b, 0x26, 0xca, 0x93, 0xa1, 0x91, 0x7c, 0x1d, 0xf0, 0xcf, 0xe8, 0x2e, 0xcc, 0xe6, 0xd4,
+	0x5e, 0x7a, 0xd4, 0x8e, 0x90, 0xbe, 0x0b, 0x10, 0xed, 0xe7, 0x6c, 0x4e, 0x98, 0x43, 0xc1, 0xdc,
+	0x97, 0xcc, 0xd3, 0x22, 0xe6, 0x24, 0xf1, 0x9e, 0xa7, 0xd6, 0x73, 0xe9, 0xd4, 0xc9, 0x4d, 0x28,
+	0x52, 0x01, 0xfe, 0x16, 0x1d, 0x44, 0xed, 0x44, 0xa6, 0x1e, 0xb5, 0x6c, 0xca, 0xb8, 0x0f, 0xe6,
+	0x2d, 0x89, 0xfc, 0xa0, 0x08, 0x39, 0x8c, 0xe5, 0x17, 0x91, 0x5a, 0xc7, 0x54, 0xa7, 0x7a, 0x05,
+	0xf0, 0x77, 0xa8, 0xe6, 0x2f, 0x3d, 0xe1, 0x5a, 0x04, 0x80, 0x0a, 0x2b, 0xe0, 0xdc, 0x03, 0xb3,
+	0x2a, 0xc3, 0x3f, 0x2c, 0x0a, 0xff, 0x2a, 0x32, 0x0c, 0x22, 0xfd, 0x88, 0x73, 0x4f, 0x4f, 0x3f,
+	0xf0, 0x33, 0x25, 0xc0, 0x1c, 0x1d, 0xe9, 0xf1, 0x7a, 0xf3, 0x1f, 0x48, 0xc8, 0xc7, 0xd7, 0x43,
+	0x36, 0x5f, 0xb1, 0x86, 0xbf, 0x41, 0x20, 0x1b, 0x43, 0x07, 0xa6, 0xf7, 0x10, 0xcc, 0xdb, 0xdb,
+	0x1b, 0x63, 0xcd, 0x4b, 0xef, 0x76, 0xa6, 0x31, 0xfc, 0x7c, 0x1d, 0x30, 0x43, 0x47, 0x3e, 0x59,
+	0xad, 0xdf, 0x51, 0xb0, 0x92, 0x53, 0x34, 0x6b, 0xd7, 0xe0, 0xc8, 0x2a, 0x7d, 0xed, 0x20, 0xdf,
+	0x0d, 0x0d, 0x5f, 0xaf, 0x27, 0x0d, 0x84, 0x7f, 0x40, 0x47, 0xfa, 0xdd, 0xb7, 0xe8, 0x8a, 0xfa,
+	0x81, 0xda, 0x4e, 0x2c, 0x79, 0x27, 0xff, 0xe7, 0x2d, 0xf9, 0x22, 0x71, 0xe9, 0xc4, 0xc3, 0x70,
+	0x93, 0x02, 0xf0, 0x37, 0x08, 0x7f, 0x1f, 0xf2, 0xe7, 0x94, 0x65, 0x0e, 0xef, 0x4e, 0x67, 0x67,
+	0xdb, 0x58, 0xbb, 0x78, 0x32, 0x22, 0x6e, 0xe6, 0x15, 0xaf, 0xa9, 0x10, 0xed, 0xa8, 0x8e, 0xe7,
+	0x08, 0xe7, 0x07, 0x0a, 0x3e, 0x43, 0x37, 0x89, 0x6d, 0x87, 0x14, 0xd4, 0xec, 0xdc, 0x1b, 0x9a,
+	0x6f, 0x5e, 0x9e, 0xd4, 0x63, 0xce, 0x40, 0x55, 0x26, 0x22, 0x74, 0x99, 0x33, 0x4e, 0x84, 0xb8,
+	0x8e, 0x6e, 0xac, 0x07, 0xe4, 0xce, 0x58, 0x7d, 0x7c, 0x5e, 0xfe, 0xe5, 0x45, 0xbb, 0xf4, 0xef,
+	0x8b, 0x76, 0x69, 0xf8, 0xd9, 0xab, 0xcb, 0x96, 0xf1, 0xfa, 0xb2, 0x65, 0xfc, 0x7d, 0xd9, 0x32,
+	0x7e, 0xbd, 0x6a, 0x95, 0x5e, 0x5f, 0xb5, 0x4a, 0x7f, 0x5e, 0xb5, 0x4a, 0x4f, 0xef, 0x66, 0x66,
+	0xe8, 0x2a, 0xfd, 0xaf, 0x40, 0xfc, 0x14, 0x50, 0x98, 0xee, 0xca, 0xf1, 0xfe, 0xe9, 0x7f, 0x03,
+	0x00, 0x60, 0x74, 0xb6, 0x0c, 0x88, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -236,6 +364,168 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FrozenDelegations) > 0 {
+		for iNdEx := len(m.FrozenDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.RedelegationExemptions) > 0 {
+		for iNdEx := len(m.RedelegationExemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedelegationExemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.MaxValidatorsSchedule) > 0 {
+		for iNdEx := len(m.MaxValidatorsSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxValidatorsSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.MultiAssetUnbondings) > 0 {
+		for iNdEx := len(m.MultiAssetUnbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MultiAssetUnbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.MultiAssetDelegations) > 0 {
+		for iNdEx := len(m.MultiAssetDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MultiAssetDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.MultiAssetPools) > 0 {
+		for iNdEx := len(m.MultiAssetPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MultiAssetPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.BondableDenoms) > 0 {
+		for iNdEx := len(m.BondableDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BondableDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ScheduledCommissionChanges) > 0 {
+		for iNdEx := len(m.ScheduledCommissionChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledCommissionChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.AutoCompoundSettings) > 0 {
+		for iNdEx := len(m.AutoCompoundSettings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompoundSettings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.DelegationPositions) > 0 {
+		for iNdEx := len(m.DelegationPositions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegationPositions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -428,6 +718,72 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.DelegationPositions) > 0 {
+		for _, e := range m.DelegationPositions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoCompoundSettings) > 0 {
+		for _, e := range m.AutoCompoundSettings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScheduledCommissionChanges) > 0 {
+		for _, e := range m.ScheduledCommissionChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BondableDenoms) > 0 {
+		for _, e := range m.BondableDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MultiAssetPools) > 0 {
+		for _, e := range m.MultiAssetPools {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MultiAssetDelegations) > 0 {
+		for _, e := range m.MultiAssetDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MultiAssetUnbondings) > 0 {
+		for _, e := range m.MultiAssetUnbondings {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MaxValidatorsSchedule) > 0 {
+		for _, e := range m.MaxValidatorsSchedule {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RedelegationExemptions) > 0 {
+		for _, e := range m.RedelegationExemptions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FrozenDelegations) > 0 {
+		for _, e := range m.FrozenDelegations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -738,6 +1094,380 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationPositions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationPositions = append(m.DelegationPositions, DelegationPosition{})
+			if err := m.DelegationPositions[len(m.DelegationPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundSettings = append(m.AutoCompoundSettings, AutoCompoundSetting{})
+			if err := m.AutoCompoundSettings[len(m.AutoCompoundSettings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledCommissionChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledCommissionChanges = append(m.ScheduledCommissionChanges, ScheduledCommissionChange{})
+			if err := m.ScheduledCommissionChanges[len(m.ScheduledCommissionChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondableDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondableDenoms = append(m.BondableDenoms, BondableDenom{})
+			if err := m.BondableDenoms[len(m.BondableDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiAssetPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultiAssetPools = append(m.MultiAssetPools, MultiAssetPool{})
+			if err := m.MultiAssetPools[len(m.MultiAssetPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiAssetDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultiAssetDelegations = append(m.MultiAssetDelegations, MultiAssetDelegation{})
+			if err := m.MultiAssetDelegations[len(m.MultiAssetDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiAssetUnbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MultiAssetUnbondings = append(m.MultiAssetUnbondings, MultiAssetUnbonding{})
+			if err := m.MultiAssetUnbondings[len(m.MultiAssetUnbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorsSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxValidatorsSchedule = append(m.MaxValidatorsSchedule, MaxValidatorsChange{})
+			if err := m.MaxValidatorsSchedule[len(m.MaxValidatorsSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationExemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedelegationExemptions = append(m.RedelegationExemptions, RedelegationExemption{})
+			if err := m.RedelegationExemptions[len(m.RedelegationExemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenDelegations = append(m.FrozenDelegations, DVPair{})
+			if err := m.FrozenDelegations[len(m.FrozenDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
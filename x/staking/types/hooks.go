@@ -10,7 +10,10 @@ import (
 )
 
 // combine multiple staking hooks, all hook functions are run in array sequence
-var _ StakingHooks = &MultiStakingHooks{}
+var (
+	_ StakingHooks       = &MultiStakingHooks{}
+	_ StakingAmountHooks = &MultiStakingHooks{}
+)
 
 type MultiStakingHooks []StakingHooks
 
@@ -126,3 +129,31 @@ func (h MultiStakingHooks) AfterConsensusPubKeyUpdate(ctx context.Context, oldPu
 	}
 	return nil
 }
+
+// BeforeDelegationSharesModifiedWithDelta calls the hooks implementing StakingAmountHooks.
+func (h MultiStakingHooks) BeforeDelegationSharesModifiedWithDelta(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesDelta sdkmath.LegacyDec) error {
+	for i := range h {
+		amountHooks, ok := h[i].(StakingAmountHooks)
+		if !ok {
+			continue
+		}
+		if err := amountHooks.BeforeDelegationSharesModifiedWithDelta(ctx, delAddr, valAddr, sharesDelta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterUnbondingAmountChanged calls the hooks implementing StakingAmountHooks.
+func (h MultiStakingHooks) AfterUnbondingAmountChanged(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amountDelta sdkmath.Int) error {
+	for i := range h {
+		amountHooks, ok := h[i].(StakingAmountHooks)
+		if !ok {
+			continue
+		}
+		if err := amountHooks.AfterUnbondingAmountChanged(ctx, delAddr, valAddr, amountDelta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -70,6 +70,41 @@ var (
 	ValidatorConsensusKeyRotationRecordIndexKey = collections.NewPrefix(104) // this key is used to restrict the validator next rotation within waiting (unbonding) period
 	NewToOldConsKeyMap                          = collections.NewPrefix(105) // prefix for rotated cons address to new cons address
 	OldToNewConsKeyMap                          = collections.NewPrefix(106) // prefix for rotated cons address to new cons address
+
+	DelegationPositionKey         = collections.NewPrefix(114) // key for a delegation position
+	DelegationPositionSequenceKey = collections.NewPrefix(115) // key for the sequence of the delegation position ids
+	DelegationPositionByOwnerKey  = collections.NewPrefix(116) // prefix for each key for a delegation position, by owner
+
+	TokenizeShareRecordKey         = collections.NewPrefix(117) // key for a tokenize share record
+	TokenizeShareRecordSequenceKey = collections.NewPrefix(118) // key for the sequence of the tokenize share record ids
+	TokenizeShareRecordByOwnerKey  = collections.NewPrefix(119) // prefix for each key for a tokenize share record, by owner
+	TokenizeShareRecordByDenomKey  = collections.NewPrefix(120) // key for the id of a tokenize share record, by denom
+	ValidatorLiquidSharesKey       = collections.NewPrefix(121) // key for the tokenized delegator shares of a validator
+
+	AutoCompoundSettingKey = collections.NewPrefix(122) // prefix for each key for an auto-compounding delegation
+	AutoCompoundCursorKey  = collections.NewPrefix(123) // key for the auto-compounding delegation to resume processing from
+
+	ScheduledCommissionChangeKey      = collections.NewPrefix(124) // prefix for each key for a scheduled commission change
+	ScheduledCommissionChangeQueueKey = collections.NewPrefix(125) // prefix for the timestamps in the scheduled commission change queue
+
+	FlashUndelegatedAmountKey = collections.NewPrefix(126) // prefix for the amount flash undelegated from each validator in the current block
+
+	ValidatorPerformanceKey = collections.NewPrefix(127) // prefix for the performance counters of each validator, by bucket of blocks
+
+	BondableDenomKey               = collections.NewPrefix(128) // prefix for the weight of each bondable denom other than the bond denom
+	MultiAssetPoolKey              = collections.NewPrefix(129) // prefix for the tokens of a bondable denom delegated to a validator
+	MultiAssetDelegationKey        = collections.NewPrefix(130) // prefix for the shares of a multi-asset pool owned by a delegator
+	MultiAssetUnbondingQueueKey    = collections.NewPrefix(131) // prefix for the multi-asset unbondings, by completion time
+	MultiAssetUnbondingSequenceKey = collections.NewPrefix(132) // key for the sequence of the multi-asset unbonding ids
+
+	MaxValidatorsScheduleKey = collections.NewPrefix(133) // prefix for the changes of the MaxValidators param scheduled by governance, by height
+
+	RedelegationExemptionKey = collections.NewPrefix(134) // prefix for the redelegation exemptions approved by governance, by source validator
+
+	FrozenDelegationKey = collections.NewPrefix(135) // prefix for the delegations frozen by the forced undelegation authority
+
+	MultiAssetUnbondingByValIndexKey = collections.NewPrefix(136) // prefix for the multi-asset unbondings, by validator
+	MultiAssetPoolByDenomIndexKey    = collections.NewPrefix(137) // prefix for the validators having a multi-asset pool, by denom
 )
 
 // UnbondingType defines the type of unbonding operation
@@ -108,7 +143,7 @@ func GetValidatorsByPowerIndexKey(validator Validator, powerReduction math.Int,
 	// NOTE the address doesn't need to be stored because counter bytes must always be different
 	// NOTE the larger values are of higher value
 
-	consensusPower := sdk.TokensToConsensusPower(validator.Tokens, powerReduction)
+	consensusPower := validator.PotentialConsensusPower(powerReduction)
 	consensusPowerBytes := make([]byte, 8)
 	binary.BigEndian.PutUint64(consensusPowerBytes, uint64(consensusPower))
 
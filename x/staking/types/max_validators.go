@@ -0,0 +1,28 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+)
+
+// ValidateMaxValidatorsChanges validates a schedule of changes of the
+// MaxValidators param, which must be at distinct heights after the given
+// height and keep at least one validator.
+func ValidateMaxValidatorsChanges(changes []MaxValidatorsChange, height int64) error {
+	seen := make(map[int64]bool, len(changes))
+	for _, change := range changes {
+		if seen[change.Height] {
+			return errors.Wrapf(ErrInvalidMaxValidatorsChange, "duplicate change at height %d", change.Height)
+		}
+		seen[change.Height] = true
+
+		if change.Height <= height {
+			return errors.Wrapf(ErrInvalidMaxValidatorsChange, "height %d must be after height %d", change.Height, height)
+		}
+
+		if change.MaxValidators == 0 {
+			return errors.Wrapf(ErrInvalidMaxValidatorsChange, "max validators at height %d must be positive", change.Height)
+		}
+	}
+
+	return nil
+}
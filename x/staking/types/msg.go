@@ -20,6 +20,23 @@ var (
 	_ sdk.Msg                            = &MsgBeginRedelegate{}
 	_ sdk.Msg                            = &MsgCancelUnbondingDelegation{}
 	_ sdk.Msg                            = &MsgUpdateParams{}
+	_ sdk.Msg                            = &MsgTokenizeDelegation{}
+	_ sdk.Msg                            = &MsgTransferDelegationPosition{}
+	_ sdk.Msg                            = &MsgRedeemDelegationPosition{}
+	_ sdk.Msg                            = &MsgTokenizeShares{}
+	_ sdk.Msg                            = &MsgRedeemTokensForShares{}
+	_ sdk.Msg                            = &MsgTransferTokenizeShareRecord{}
+	_ sdk.Msg                            = &MsgSetAutoCompound{}
+	_ sdk.Msg                            = &MsgScheduleCommissionChange{}
+	_ sdk.Msg                            = &MsgCancelCommissionChange{}
+	_ sdk.Msg                            = &MsgFlashUndelegate{}
+	_ sdk.Msg                            = &MsgSetBondableDenom{}
+	_ sdk.Msg                            = &MsgDelegateMultiAsset{}
+	_ sdk.Msg                            = &MsgUndelegateMultiAsset{}
+	_ sdk.Msg                            = &MsgScheduleMaxValidators{}
+	_ sdk.Msg                            = &MsgApproveRedelegationExemption{}
+	_ sdk.Msg                            = &MsgForceUndelegate{}
+	_ sdk.Msg                            = &MsgUnfreezeDelegation{}
 )
 
 // NewMsgCreateValidator creates a new MsgCreateValidator instance.
@@ -143,6 +160,133 @@ func NewMsgCancelUnbondingDelegation(delAddr, valAddr string, creationHeight int
 	}
 }
 
+// NewMsgTokenizeDelegation creates a new MsgTokenizeDelegation instance.
+func NewMsgTokenizeDelegation(delAddr, valAddr string, amount sdk.Coin) *MsgTokenizeDelegation {
+	return &MsgTokenizeDelegation{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Amount:           amount,
+	}
+}
+
+// NewMsgTransferDelegationPosition creates a new MsgTransferDelegationPosition instance.
+func NewMsgTransferDelegationPosition(owner string, positionID uint64, recipient string) *MsgTransferDelegationPosition {
+	return &MsgTransferDelegationPosition{
+		Owner:      owner,
+		PositionId: positionID,
+		Recipient:  recipient,
+	}
+}
+
+// NewMsgRedeemDelegationPosition creates a new MsgRedeemDelegationPosition instance.
+func NewMsgRedeemDelegationPosition(owner string, positionID uint64) *MsgRedeemDelegationPosition {
+	return &MsgRedeemDelegationPosition{
+		Owner:      owner,
+		PositionId: positionID,
+	}
+}
+
+// NewMsgTokenizeShares creates a new MsgTokenizeShares instance.
+func NewMsgTokenizeShares(delAddr, valAddr string, amount sdk.Coin, owner string) *MsgTokenizeShares {
+	return &MsgTokenizeShares{
+		DelegatorAddress:    delAddr,
+		ValidatorAddress:    valAddr,
+		Amount:              amount,
+		TokenizedShareOwner: owner,
+	}
+}
+
+// NewMsgRedeemTokensForShares creates a new MsgRedeemTokensForShares instance.
+func NewMsgRedeemTokensForShares(delAddr string, amount sdk.Coin) *MsgRedeemTokensForShares {
+	return &MsgRedeemTokensForShares{
+		DelegatorAddress: delAddr,
+		Amount:           amount,
+	}
+}
+
+// NewMsgTransferTokenizeShareRecord creates a new MsgTransferTokenizeShareRecord instance.
+func NewMsgTransferTokenizeShareRecord(recordID uint64, sender, newOwner string) *MsgTransferTokenizeShareRecord {
+	return &MsgTransferTokenizeShareRecord{
+		TokenizeShareRecordId: recordID,
+		Sender:                sender,
+		NewOwner:              newOwner,
+	}
+}
+
+// NewMsgSetAutoCompound creates a new MsgSetAutoCompound instance.
+func NewMsgSetAutoCompound(delAddr, valAddr string, enabled bool) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Enabled:          enabled,
+	}
+}
+
+// NewMsgScheduleCommissionChange creates a new MsgScheduleCommissionChange instance.
+func NewMsgScheduleCommissionChange(valAddr string, newRate math.LegacyDec) *MsgScheduleCommissionChange {
+	return &MsgScheduleCommissionChange{
+		ValidatorAddress: valAddr,
+		NewRate:          newRate,
+	}
+}
+
+// NewMsgFlashUndelegate creates a new MsgFlashUndelegate instance.
+func NewMsgFlashUndelegate(delAddr, valAddr string, amount sdk.Coin) *MsgFlashUndelegate {
+	return &MsgFlashUndelegate{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Amount:           amount,
+	}
+}
+
+// NewMsgDelegateMultiAsset creates a new MsgDelegateMultiAsset instance.
+func NewMsgDelegateMultiAsset(delAddr, valAddr string, amount sdk.Coin) *MsgDelegateMultiAsset {
+	return &MsgDelegateMultiAsset{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Amount:           amount,
+	}
+}
+
+// NewMsgUndelegateMultiAsset creates a new MsgUndelegateMultiAsset instance.
+func NewMsgUndelegateMultiAsset(delAddr, valAddr string, amount sdk.Coin) *MsgUndelegateMultiAsset {
+	return &MsgUndelegateMultiAsset{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Amount:           amount,
+	}
+}
+
+// NewMsgForceUndelegate creates a new MsgForceUndelegate instance. A nil amount
+// undelegates the whole delegation.
+func NewMsgForceUndelegate(authority, delAddr, valAddr string, amount *sdk.Coin, freeze bool, reason string) *MsgForceUndelegate {
+	return &MsgForceUndelegate{
+		Authority:        authority,
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Amount:           amount,
+		Freeze:           freeze,
+		Reason:           reason,
+	}
+}
+
+// NewMsgUnfreezeDelegation creates a new MsgUnfreezeDelegation instance.
+func NewMsgUnfreezeDelegation(authority, delAddr, valAddr, reason string) *MsgUnfreezeDelegation {
+	return &MsgUnfreezeDelegation{
+		Authority:        authority,
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Reason:           reason,
+	}
+}
+
+// NewMsgCancelCommissionChange creates a new MsgCancelCommissionChange instance.
+func NewMsgCancelCommissionChange(valAddr string) *MsgCancelCommissionChange {
+	return &MsgCancelCommissionChange{
+		ValidatorAddress: valAddr,
+	}
+}
+
 // NewMsgRotateConsPubKey creates a new MsgRotateConsPubKey instance.
 func NewMsgRotateConsPubKey(valAddr string, pubKey cryptotypes.PubKey) (*MsgRotateConsPubKey, error) {
 	var pkAny *codectypes.Any
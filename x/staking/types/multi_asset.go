@@ -0,0 +1,88 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateBondableDenom validates a bondable denom registered with a weight,
+// which must be strictly positive, the bond denom being always bondable.
+func ValidateBondableDenom(denom string, weight math.LegacyDec, bondDenom string) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return err
+	}
+
+	if denom == bondDenom {
+		return fmt.Errorf("bondable denom cannot be the bond denom %s", bondDenom)
+	}
+
+	if weight.IsNil() || !weight.IsPositive() {
+		return fmt.Errorf("weight of bondable denom %s must be positive: %s", denom, weight)
+	}
+
+	return nil
+}
+
+// NewMultiAssetPool creates a new empty pool of a bondable denom of a validator.
+func NewMultiAssetPool(valAddr, denom string) MultiAssetPool {
+	return MultiAssetPool{
+		ValidatorAddress: valAddr,
+		Denom:            denom,
+		Tokens:           math.ZeroInt(),
+		Shares:           math.LegacyZeroDec(),
+	}
+}
+
+// SharesFromTokens returns the shares of the pool worth an amount of tokens.
+func (p MultiAssetPool) SharesFromTokens(amt math.Int) (math.LegacyDec, error) {
+	if p.Tokens.IsZero() {
+		return math.LegacyZeroDec(), ErrInsufficientShares
+	}
+
+	return p.Shares.MulInt(amt).QuoInt(p.Tokens), nil
+}
+
+// TokensFromShares returns the amount of tokens an amount of shares of the pool
+// is worth.
+func (p MultiAssetPool) TokensFromShares(shares math.LegacyDec) math.LegacyDec {
+	if p.Shares.IsZero() {
+		return math.LegacyZeroDec()
+	}
+
+	return shares.MulInt(p.Tokens).Quo(p.Shares)
+}
+
+// AddTokens adds tokens to the pool and returns the shares issued for them,
+// one share per token for an empty pool.
+func (p MultiAssetPool) AddTokens(amt math.Int) (MultiAssetPool, math.LegacyDec) {
+	var issuedShares math.LegacyDec
+	if p.Shares.IsZero() {
+		issuedShares = math.LegacyNewDecFromInt(amt)
+	} else {
+		issuedShares = p.Shares.MulInt(amt).QuoInt(p.Tokens)
+	}
+
+	p.Tokens = p.Tokens.Add(amt)
+	p.Shares = p.Shares.Add(issuedShares)
+
+	return p, issuedShares
+}
+
+// RemoveShares removes shares from the pool and returns the amount of tokens
+// they are worth, all the tokens being returned for the last shares.
+func (p MultiAssetPool) RemoveShares(shares math.LegacyDec) (MultiAssetPool, math.Int) {
+	var tokens math.Int
+	if shares.Equal(p.Shares) {
+		tokens = p.Tokens
+	} else {
+		tokens = p.TokensFromShares(shares).TruncateInt()
+	}
+
+	p.Tokens = p.Tokens.Sub(tokens)
+	p.Shares = p.Shares.Sub(shares)
+
+	return p, tokens
+}
@@ -3,9 +3,11 @@ package types
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
+	"cosmossdk.io/core/address"
 	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -29,6 +31,23 @@ const (
 	// value by not adding the staking module to the application module manager's
 	// SetOrderBeginBlockers.
 	DefaultHistoricalEntries uint32 = 10000
+
+	// DefaultMaxAutoCompoundsPerBlock is the default maximum number of
+	// auto-compounding delegations processed in a block
+	DefaultMaxAutoCompoundsPerBlock uint32 = 100
+
+	// DefaultCommissionChangeNoticePeriod is the default delay, one week, before
+	// a scheduled commission change is applied
+	DefaultCommissionChangeNoticePeriod time.Duration = time.Hour * 24 * 7
+
+	// DefaultPerformanceWindow is the default number of recent blocks over
+	// which the performance of the validators is tracked
+	DefaultPerformanceWindow uint64 = 10000
+
+	// PerformanceBucketBlocks is the number of consecutive blocks whose
+	// performance counters are aggregated in a single record. The performance
+	// window is tracked with this granularity.
+	PerformanceBucketBlocks uint64 = 100
 )
 
 var (
@@ -37,6 +56,21 @@ var (
 
 	// DefaultKeyRotationFee is fees used to rotate the ConsPubkey or Operator key
 	DefaultKeyRotationFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)
+
+	// DefaultGlobalLiquidStakingCap is set to 100%, allowing all the bonded tokens to be tokenized
+	DefaultGlobalLiquidStakingCap = math.LegacyOneDec()
+
+	// DefaultValidatorLiquidStakingCap is set to 100%, allowing all the shares of a validator to be tokenized
+	DefaultValidatorLiquidStakingCap = math.LegacyOneDec()
+
+	// DefaultMinAutoCompoundReward is the default minimum amount of rewards to auto-compound
+	DefaultMinAutoCompoundReward = math.NewInt(1000)
+
+	// DefaultFlashUndelegationPenalty is set to 10%
+	DefaultFlashUndelegationPenalty = math.LegacyNewDecWithPrec(1, 1)
+
+	// DefaultMaxFlashUndelegationRate is set to 0%, disabling flash undelegations
+	DefaultMaxFlashUndelegationRate = math.LegacyZeroDec()
 )
 
 // NewParams creates a new Params instance
@@ -53,6 +87,19 @@ func NewParams(unbondingTime time.Duration,
 		BondDenom:         bondDenom,
 		MinCommissionRate: minCommissionRate,
 		KeyRotationFee:    keyRotationFee,
+
+		GlobalLiquidStakingCap:    DefaultGlobalLiquidStakingCap,
+		ValidatorLiquidStakingCap: DefaultValidatorLiquidStakingCap,
+
+		MaxAutoCompoundsPerBlock: DefaultMaxAutoCompoundsPerBlock,
+		MinAutoCompoundReward:    DefaultMinAutoCompoundReward,
+
+		CommissionChangeNoticePeriod: DefaultCommissionChangeNoticePeriod,
+
+		FlashUndelegationPenalty: DefaultFlashUndelegationPenalty,
+		MaxFlashUndelegationRate: DefaultMaxFlashUndelegationRate,
+
+		PerformanceWindow: DefaultPerformanceWindow,
 	}
 }
 
@@ -119,9 +166,68 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateValidatorAllowlist(p.ValidatorAllowlist); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap("global", p.GlobalLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap("validator", p.ValidatorLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateMinAutoCompoundReward(p.MinAutoCompoundReward); err != nil {
+		return err
+	}
+
+	if p.CommissionChangeNoticePeriod < 0 {
+		return fmt.Errorf("commission change notice period cannot be negative: %s", p.CommissionChangeNoticePeriod)
+	}
+
+	if err := validateFlashUndelegationRate("penalty", p.FlashUndelegationPenalty); err != nil {
+		return err
+	}
+
+	if err := validateFlashUndelegationRate("max rate", p.MaxFlashUndelegationRate); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateAllowlistAddresses checks that the validator allowlist only contains valid operator addresses.
+func (p Params) ValidateAllowlistAddresses(validatorAddressCodec address.Codec) error {
+	for _, addr := range p.ValidatorAllowlist {
+		if _, err := validatorAddressCodec.StringToBytes(addr); err != nil {
+			return fmt.Errorf("invalid validator allowlist address %s: %w", addr, err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateForcedUndelegationAuthority validates the address of the forced
+// undelegation authority, which defaults to the module authority when empty.
+func (p Params) ValidateForcedUndelegationAuthority(addressCodec address.Codec) error {
+	if p.ForcedUndelegationAuthority == "" {
+		return nil
+	}
+
+	if _, err := addressCodec.StringToBytes(p.ForcedUndelegationAuthority); err != nil {
+		return fmt.Errorf("invalid forced undelegation authority address %s: %w", p.ForcedUndelegationAuthority, err)
+	}
+
 	return nil
 }
 
+// IsValidatorAllowed returns true if the given operator address is allowed to create a validator.
+// Validator creation is permissionless when the allowlist is empty.
+func (p Params) IsValidatorAllowed(operatorAddr string) bool {
+	return len(p.ValidatorAllowlist) == 0 || slices.Contains(p.ValidatorAllowlist, operatorAddr)
+}
+
 func validateUnbondingTime(i interface{}) error {
 	v, ok := i.(time.Duration)
 	if !ok {
@@ -234,3 +340,69 @@ func validateKeyRotationFee(i interface{}) error {
 
 	return nil
 }
+
+func validateValidatorAllowlist(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]struct{}, len(v))
+	for _, addr := range v {
+		if strings.TrimSpace(addr) == "" {
+			return errors.New("validator allowlist cannot contain a blank address")
+		}
+
+		if _, ok := seen[addr]; ok {
+			return fmt.Errorf("duplicate validator allowlist address: %s", addr)
+		}
+		seen[addr] = struct{}{}
+	}
+
+	return nil
+}
+
+// validateLiquidStakingCap validates a liquid staking cap, a nil cap (params set
+// before the caps were introduced) meaning no cap.
+func validateLiquidStakingCap(name string, v math.LegacyDec) error {
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("%s liquid staking cap cannot be negative: %s", name, v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("%s liquid staking cap cannot be greater than 100%%: %s", name, v)
+	}
+
+	return nil
+}
+
+// validateFlashUndelegationRate validates a flash undelegation fraction, a nil
+// fraction (params set before flash undelegations were introduced) meaning zero.
+func validateFlashUndelegationRate(name string, v math.LegacyDec) error {
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("flash undelegation %s cannot be negative: %s", name, v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("flash undelegation %s cannot be greater than 100%%: %s", name, v)
+	}
+
+	return nil
+}
+
+// validateMinAutoCompoundReward validates the auto-compound threshold, a nil
+// threshold (params set before auto-compounding was introduced) meaning none.
+func validateMinAutoCompoundReward(v math.Int) error {
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("min auto-compound reward cannot be negative: %s", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ ValidatorPerformanceScorer = MultiValidatorPerformanceScorer{}
+
+// MultiValidatorPerformanceScorer combines multiple performance scorers, the
+// score of a validator being the sum of its scores.
+type MultiValidatorPerformanceScorer []ValidatorPerformanceScorer
+
+func NewMultiValidatorPerformanceScorer(scorers ...ValidatorPerformanceScorer) MultiValidatorPerformanceScorer {
+	return scorers
+}
+
+func (s MultiValidatorPerformanceScorer) PerformanceScore(ctx context.Context, valAddr sdk.ValAddress) (math.LegacyDec, error) {
+	score := math.LegacyZeroDec()
+	for i := range s {
+		sc, err := s[i].PerformanceScore(ctx, valAddr)
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+
+		score = score.Add(sc)
+	}
+
+	return score, nil
+}
@@ -9,9 +9,16 @@ import (
 // - NotBondedPool -> "not_bonded_tokens_pool"
 //
 // - BondedPool -> "bonded_tokens_pool"
+//
+// - ShareTokenPool -> "share_tokens_pool"
 const (
 	NotBondedPoolName = "not_bonded_tokens_pool"
 	BondedPoolName    = "bonded_tokens_pool"
+
+	// ShareTokenPoolName is the module account minting and burning the share
+	// tokens of the tokenized delegations. It is the only staking module account
+	// with the Minter permission, so that no other staking flow can mint.
+	ShareTokenPoolName = "share_tokens_pool"
 )
 
 // NewPool creates a new Pool instance used for queries
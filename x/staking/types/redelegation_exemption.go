@@ -0,0 +1,27 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+)
+
+// ValidateRedelegationExemptions validates redelegation exemptions, which must
+// be for distinct source validators and redelegate to another validator.
+func ValidateRedelegationExemptions(exemptions []RedelegationExemption) error {
+	seen := make(map[string]bool, len(exemptions))
+	for _, exemption := range exemptions {
+		if exemption.ValidatorSrcAddress == "" {
+			return errors.Wrap(ErrInvalidRedelegationExemption, "empty source validator address")
+		}
+
+		if seen[exemption.ValidatorSrcAddress] {
+			return errors.Wrapf(ErrInvalidRedelegationExemption, "duplicate exemption for validator %s", exemption.ValidatorSrcAddress)
+		}
+		seen[exemption.ValidatorSrcAddress] = true
+
+		if exemption.ValidatorDstAddress == exemption.ValidatorSrcAddress {
+			return errors.Wrapf(ErrInvalidRedelegationExemption, "validator %s cannot be both source and destination", exemption.ValidatorSrcAddress)
+		}
+	}
+
+	return nil
+}
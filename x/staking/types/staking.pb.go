@@ -441,6 +441,11 @@ type Validator struct {
 	UnbondingOnHoldRefCount int64 `protobuf:"varint,12,opt,name=unbonding_on_hold_ref_count,json=unbondingOnHoldRefCount,proto3" json:"unbonding_on_hold_ref_count,omitempty"`
 	// list of unbonding ids, each uniquely identifying an unbonding of this validator
 	UnbondingIds []uint64 `protobuf:"varint,13,rep,packed,name=unbonding_ids,json=unbondingIds,proto3" json:"unbonding_ids,omitempty"`
+	// multi_asset_tokens defines the bond denom equivalent of the tokens of the
+	// bondable denoms other than the bond denom delegated to the validator, by
+	// the weights of the denoms. They contribute to the voting power of the
+	// validator along with its tokens.
+	MultiAssetTokens *cosmossdk_io_math.Int `protobuf:"bytes,14,opt,name=multi_asset_tokens,json=multiAssetTokens,proto3,customtype=cosmossdk.io/math.Int" json:"multi_asset_tokens,omitempty"`
 }
 
 func (m *Validator) Reset()         { *m = Validator{} }
@@ -1001,6 +1006,46 @@ type Params struct {
 	// key_rotation_fee is fee to be spent when rotating validator's key
 	// (either consensus pubkey or operator key)
 	KeyRotationFee types2.Coin `protobuf:"bytes,7,opt,name=key_rotation_fee,json=keyRotationFee,proto3" json:"key_rotation_fee"`
+	// validator_allowlist defines the operator addresses allowed to create a validator,
+	// enabling permissioned networks. An empty list means validator creation is permissionless.
+	ValidatorAllowlist []string `protobuf:"bytes,8,rep,name=validator_allowlist,json=validatorAllowlist,proto3" json:"validator_allowlist,omitempty"`
+	// global_liquid_staking_cap defines the maximum fraction of the total bonded
+	// tokens which can be tokenized into share tokens.
+	GlobalLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap defines the maximum fraction of the delegator
+	// shares of a validator which can be tokenized into share tokens.
+	ValidatorLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_liquid_staking_cap"`
+	// max_auto_compounds_per_block defines the maximum number of auto-compounding
+	// delegations processed by the end blocker in a single block. Zero disables
+	// auto-compounding.
+	MaxAutoCompoundsPerBlock uint32 `protobuf:"varint,11,opt,name=max_auto_compounds_per_block,json=maxAutoCompoundsPerBlock,proto3" json:"max_auto_compounds_per_block,omitempty"`
+	// min_auto_compound_reward defines the minimum amount of bond denom rewards a
+	// delegation must have accrued to be auto-compounded.
+	MinAutoCompoundReward cosmossdk_io_math.Int `protobuf:"bytes,12,opt,name=min_auto_compound_reward,json=minAutoCompoundReward,proto3,customtype=cosmossdk.io/math.Int" json:"min_auto_compound_reward"`
+	// commission_change_notice_period defines the delay between the scheduling
+	// of a commission change by a validator and its application.
+	CommissionChangeNoticePeriod time.Duration `protobuf:"bytes,13,opt,name=commission_change_notice_period,json=commissionChangeNoticePeriod,proto3,stdduration" json:"commission_change_notice_period"`
+	// flash_undelegation_penalty defines the fraction of the tokens of a flash
+	// undelegation sent to the community pool in exchange for skipping the
+	// unbonding period.
+	FlashUndelegationPenalty cosmossdk_io_math.LegacyDec `protobuf:"bytes,14,opt,name=flash_undelegation_penalty,json=flashUndelegationPenalty,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"flash_undelegation_penalty"`
+	// max_flash_undelegation_rate defines the maximum fraction of the tokens of a
+	// validator which can be flash undelegated in a single block. Zero disables
+	// flash undelegations.
+	MaxFlashUndelegationRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,15,opt,name=max_flash_undelegation_rate,json=maxFlashUndelegationRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_flash_undelegation_rate"`
+	// performance_window defines the number of recent blocks over which the
+	// performance statistics of the validators are tracked. Zero disables the
+	// tracking.
+	PerformanceWindow uint64 `protobuf:"varint,16,opt,name=performance_window,json=performanceWindow,proto3" json:"performance_window,omitempty"`
+	// forced_undelegation_enabled enables the forced undelegations of
+	// delegations by the forced undelegation authority, e.g. for compliance
+	// purposes. Forced undelegations are disabled by default.
+	ForcedUndelegationEnabled bool `protobuf:"varint,17,opt,name=forced_undelegation_enabled,json=forcedUndelegationEnabled,proto3" json:"forced_undelegation_enabled,omitempty"`
+	// forced_undelegation_authority defines the address allowed to force
+	// undelegations and to freeze delegations, typically a compliance module
+	// account designated by governance. An empty address means the module
+	// authority.
+	ForcedUndelegationAuthority string `protobuf:"bytes,18,opt,name=forced_undelegation_authority,json=forcedUndelegationAuthority,proto3" json:"forced_undelegation_authority,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -1078,6 +1123,48 @@ func (m *Params) GetKeyRotationFee() types2.Coin {
 	return types2.Coin{}
 }
 
+func (m *Params) GetValidatorAllowlist() []string {
+	if m != nil {
+		return m.ValidatorAllowlist
+	}
+	return nil
+}
+
+func (m *Params) GetMaxAutoCompoundsPerBlock() uint32 {
+	if m != nil {
+		return m.MaxAutoCompoundsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetCommissionChangeNoticePeriod() time.Duration {
+	if m != nil {
+		return m.CommissionChangeNoticePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetPerformanceWindow() uint64 {
+	if m != nil {
+		return m.PerformanceWindow
+	}
+	return 0
+}
+
+func (m *Params) GetForcedUndelegationEnabled() bool {
+	if m != nil {
+		return m.ForcedUndelegationEnabled
+	}
+	return false
+}
+
+func (m *Params) GetForcedUndelegationAuthority() string {
+	if m != nil {
+		return m.ForcedUndelegationAuthority
+	}
+	return ""
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1414,1136 +1501,2013 @@ func (m *ValAddrsOfRotatedConsKeys) GetAddresses() [][]byte {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
-	proto.RegisterEnum("cosmos.staking.v1beta1.Infraction", Infraction_name, Infraction_value)
-	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.v1beta1.HistoricalInfo")
-	proto.RegisterType((*HistoricalRecord)(nil), "cosmos.staking.v1beta1.HistoricalRecord")
-	proto.RegisterType((*CommissionRates)(nil), "cosmos.staking.v1beta1.CommissionRates")
-	proto.RegisterType((*Commission)(nil), "cosmos.staking.v1beta1.Commission")
-	proto.RegisterType((*Description)(nil), "cosmos.staking.v1beta1.Description")
-	proto.RegisterType((*Validator)(nil), "cosmos.staking.v1beta1.Validator")
-	proto.RegisterType((*ValAddresses)(nil), "cosmos.staking.v1beta1.ValAddresses")
-	proto.RegisterType((*DVPair)(nil), "cosmos.staking.v1beta1.DVPair")
-	proto.RegisterType((*DVPairs)(nil), "cosmos.staking.v1beta1.DVPairs")
-	proto.RegisterType((*DVVTriplet)(nil), "cosmos.staking.v1beta1.DVVTriplet")
-	proto.RegisterType((*DVVTriplets)(nil), "cosmos.staking.v1beta1.DVVTriplets")
-	proto.RegisterType((*Delegation)(nil), "cosmos.staking.v1beta1.Delegation")
-	proto.RegisterType((*UnbondingDelegation)(nil), "cosmos.staking.v1beta1.UnbondingDelegation")
-	proto.RegisterType((*UnbondingDelegationEntry)(nil), "cosmos.staking.v1beta1.UnbondingDelegationEntry")
-	proto.RegisterType((*RedelegationEntry)(nil), "cosmos.staking.v1beta1.RedelegationEntry")
-	proto.RegisterType((*Redelegation)(nil), "cosmos.staking.v1beta1.Redelegation")
-	proto.RegisterType((*Params)(nil), "cosmos.staking.v1beta1.Params")
-	proto.RegisterType((*DelegationResponse)(nil), "cosmos.staking.v1beta1.DelegationResponse")
-	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
-	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
-	proto.RegisterType((*Pool)(nil), "cosmos.staking.v1beta1.Pool")
-	proto.RegisterType((*ValidatorUpdates)(nil), "cosmos.staking.v1beta1.ValidatorUpdates")
-	proto.RegisterType((*ConsPubKeyRotationHistory)(nil), "cosmos.staking.v1beta1.ConsPubKeyRotationHistory")
-	proto.RegisterType((*ValAddrsOfRotatedConsKeys)(nil), "cosmos.staking.v1beta1.ValAddrsOfRotatedConsKeys")
+// DelegationPosition represents delegation shares tokenized into a transferable
+// position. The shares remain delegated by the owner of the position and cannot
+// be undelegated or redelegated until the position is redeemed. Transferring the
+// position moves the shares to the delegation of the new owner.
+type DelegationPosition struct {
+	// id is the unique identifier of the position.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the current owner of the position.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// validator_address is the address of the validator the shares are delegated to.
+	ValidatorAddress string `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// shares are the delegation shares of the position.
+	Shares cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=shares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"shares"`
+}
+
+func (m *DelegationPosition) Reset()         { *m = DelegationPosition{} }
+func (m *DelegationPosition) String() string { return proto.CompactTextString(m) }
+func (*DelegationPosition) ProtoMessage()    {}
+func (*DelegationPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{24}
+}
+func (m *DelegationPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegationPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationPosition.Merge(m, src)
+}
+func (m *DelegationPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationPosition.DiscardUnknown(m)
 }
 
-func init() {
-	proto.RegisterFile("cosmos/staking/v1beta1/staking.proto", fileDescriptor_64c30c6cf92913c9)
+var xxx_messageInfo_DelegationPosition proto.InternalMessageInfo
+
+func (m *DelegationPosition) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 2083 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4d, 0x6c, 0x5b, 0x49,
-	0x1d, 0xcf, 0xb3, 0x5d, 0x27, 0xf9, 0xdb, 0x89, 0x9d, 0xe9, 0x97, 0xe3, 0xee, 0xc6, 0xae, 0xb7,
-	0xb0, 0x6d, 0xa1, 0x0e, 0x2d, 0xa8, 0x87, 0x80, 0x40, 0x75, 0xec, 0x6e, 0xbd, 0x1f, 0x49, 0x78,
-	0x4e, 0x02, 0xcb, 0xd7, 0xd3, 0xf8, 0xbd, 0xb1, 0xfd, 0x88, 0x3d, 0xcf, 0xbc, 0x19, 0xb7, 0xf5,
-	0x9d, 0xc3, 0x2a, 0x2b, 0xa4, 0x3d, 0x01, 0x12, 0xaa, 0xa8, 0xc4, 0x65, 0xb9, 0xed, 0xa1, 0xe2,
-	0xce, 0x6d, 0x41, 0x42, 0xaa, 0x7a, 0x42, 0x48, 0x04, 0xd4, 0x1e, 0xba, 0x82, 0x0b, 0xe2, 0xc4,
-	0x11, 0xcd, 0xbc, 0x79, 0x1f, 0x8e, 0xe3, 0xe6, 0xa3, 0x2b, 0xb4, 0x62, 0x2f, 0x91, 0x67, 0xe6,
-	0xff, 0xff, 0xbd, 0xff, 0xf7, 0xcc, 0xff, 0x1f, 0xb8, 0x64, 0x3a, 0xac, 0xe7, 0xb0, 0x65, 0xc6,
-	0xf1, 0x8e, 0x4d, 0xdb, 0xcb, 0x77, 0xaf, 0x37, 0x09, 0xc7, 0xd7, 0xfd, 0x75, 0xb9, 0xef, 0x3a,
-	0xdc, 0x41, 0xe7, 0x3c, 0xaa, 0xb2, 0xbf, 0xab, 0xa8, 0xf2, 0x67, 0xda, 0x4e, 0xdb, 0x91, 0x24,
-	0xcb, 0xe2, 0x97, 0x47, 0x9d, 0x5f, 0x6c, 0x3b, 0x4e, 0xbb, 0x4b, 0x96, 0xe5, 0xaa, 0x39, 0x68,
-	0x2d, 0x63, 0x3a, 0x54, 0x47, 0x4b, 0xfb, 0x8f, 0xac, 0x81, 0x8b, 0xb9, 0xed, 0x50, 0x75, 0x5e,
-	0xd8, 0x7f, 0xce, 0xed, 0x1e, 0x61, 0x1c, 0xf7, 0xfa, 0x3e, 0xb6, 0x27, 0x89, 0xe1, 0x7d, 0x54,
-	0x89, 0xa5, 0xb0, 0x95, 0x2a, 0x4d, 0xcc, 0x48, 0xa0, 0x87, 0xe9, 0xd8, 0x3e, 0xf6, 0x02, 0xee,
-	0xd9, 0xd4, 0x59, 0x96, 0x7f, 0xd5, 0xd6, 0x2b, 0x9c, 0x50, 0x8b, 0xb8, 0x3d, 0x9b, 0xf2, 0x65,
-	0x3e, 0xec, 0x13, 0xe6, 0xfd, 0x55, 0xa7, 0x17, 0x22, 0xa7, 0xb8, 0x69, 0xda, 0xd1, 0xc3, 0xd2,
-	0x2f, 0x34, 0x98, 0xbf, 0x63, 0x33, 0xee, 0xb8, 0xb6, 0x89, 0xbb, 0x75, 0xda, 0x72, 0xd0, 0xd7,
-	0x21, 0xd9, 0x21, 0xd8, 0x22, 0x6e, 0x4e, 0x2b, 0x6a, 0x97, 0x53, 0x37, 0x72, 0xe5, 0x10, 0xa0,
-	0xec, 0xf1, 0xde, 0x91, 0xe7, 0x95, 0xd9, 0x8f, 0xf7, 0x0a, 0x53, 0x1f, 0x3e, 0xff, 0xe8, 0xaa,
-	0xa6, 0x2b, 0x16, 0x54, 0x85, 0xe4, 0x5d, 0xdc, 0x65, 0x84, 0xe7, 0x62, 0xc5, 0xf8, 0xe5, 0xd4,
-	0x8d, 0x8b, 0xe5, 0x83, 0x6d, 0x5e, 0xde, 0xc6, 0x5d, 0xdb, 0xc2, 0xdc, 0x19, 0x45, 0xf1, 0x78,
-	0x57, 0x62, 0x39, 0xad, 0xf4, 0xbe, 0x06, 0xd9, 0x50, 0x32, 0x9d, 0x98, 0x8e, 0x6b, 0xa1, 0x1c,
-	0x4c, 0xe3, 0x7e, 0xbf, 0x83, 0x59, 0x47, 0x0a, 0x97, 0xd6, 0xfd, 0x25, 0xfa, 0x1a, 0x24, 0x84,
-	0x91, 0x73, 0x31, 0x29, 0x73, 0xbe, 0xec, 0x79, 0xa0, 0xec, 0x7b, 0xa0, 0xbc, 0xe9, 0x7b, 0xa0,
-	0x92, 0xf8, 0xe0, 0x6f, 0x05, 0x4d, 0x97, 0xd4, 0xe8, 0x75, 0xc8, 0xdc, 0xf5, 0x05, 0x61, 0x86,
-	0xc4, 0x8d, 0x4b, 0xdc, 0xf9, 0x70, 0xfb, 0x0e, 0x66, 0x9d, 0xd2, 0xcf, 0x63, 0x90, 0x59, 0x75,
-	0x7a, 0x3d, 0x9b, 0x31, 0xdb, 0xa1, 0x3a, 0xe6, 0x84, 0xa1, 0x37, 0x21, 0xe1, 0x62, 0x4e, 0xa4,
-	0x24, 0xb3, 0x95, 0x9b, 0x42, 0x8d, 0xbf, 0xec, 0x15, 0x2e, 0x78, 0x0a, 0x33, 0x6b, 0xa7, 0x6c,
-	0x3b, 0xcb, 0x3d, 0xcc, 0x3b, 0xe5, 0xb7, 0x49, 0x1b, 0x9b, 0xc3, 0x2a, 0x31, 0x9f, 0x3c, 0xba,
-	0x06, 0xca, 0x1e, 0x55, 0x62, 0x7a, 0x3a, 0x4b, 0x0c, 0xf4, 0x6d, 0x98, 0xe9, 0xe1, 0xfb, 0x86,
-	0xc4, 0x8b, 0xbd, 0x14, 0xde, 0x74, 0x0f, 0xdf, 0x17, 0xf2, 0xa1, 0x1f, 0x41, 0x46, 0x40, 0x9a,
-	0x1d, 0x4c, 0xdb, 0xc4, 0x43, 0x8e, 0xbf, 0x14, 0xf2, 0x5c, 0x0f, 0xdf, 0x5f, 0x95, 0x68, 0x02,
-	0x7f, 0x25, 0xf1, 0xc9, 0xc3, 0x82, 0x56, 0xfa, 0xbd, 0x06, 0x10, 0x1a, 0x06, 0x61, 0xc8, 0x9a,
-	0xc1, 0x4a, 0x7e, 0x94, 0xa9, 0x30, 0x7a, 0x7d, 0x52, 0x24, 0xec, 0x33, 0x6b, 0x65, 0x4e, 0x88,
-	0xf7, 0x78, 0xaf, 0xa0, 0x79, 0x5f, 0xcd, 0x98, 0x63, 0x66, 0x4f, 0x0d, 0xfa, 0x16, 0xe6, 0xc4,
-	0x38, 0xa2, 0xc3, 0x25, 0xa0, 0x70, 0xba, 0x07, 0x08, 0x1e, 0xb7, 0x38, 0x57, 0x3a, 0x7c, 0xa8,
-	0x41, 0xaa, 0x4a, 0x98, 0xe9, 0xda, 0x7d, 0x91, 0xc4, 0x22, 0xca, 0x7a, 0x0e, 0xb5, 0x77, 0x54,
-	0x0a, 0xcc, 0xea, 0xfe, 0x12, 0xe5, 0x61, 0xc6, 0xb6, 0x08, 0xe5, 0x36, 0x1f, 0x7a, 0x6e, 0xd2,
-	0x83, 0xb5, 0xe0, 0xba, 0x47, 0x9a, 0xcc, 0xf6, 0xed, 0xac, 0xfb, 0x4b, 0x74, 0x05, 0xb2, 0x8c,
-	0x98, 0x03, 0xd7, 0xe6, 0x43, 0xc3, 0x74, 0x28, 0xc7, 0x26, 0xcf, 0x25, 0x24, 0x49, 0xc6, 0xdf,
-	0x5f, 0xf5, 0xb6, 0x05, 0x88, 0x45, 0x38, 0xb6, 0xbb, 0x2c, 0x77, 0xca, 0x03, 0x51, 0x4b, 0x25,
-	0xea, 0xee, 0x34, 0xcc, 0x06, 0xa9, 0x83, 0x56, 0x21, 0xeb, 0xf4, 0x89, 0x2b, 0x7e, 0x1b, 0xd8,
-	0xb2, 0x5c, 0xc2, 0x98, 0x8a, 0xc6, 0xdc, 0x93, 0x47, 0xd7, 0xce, 0x28, 0x83, 0xdf, 0xf2, 0x4e,
-	0x1a, 0xdc, 0xb5, 0x69, 0x5b, 0xcf, 0xf8, 0x1c, 0x6a, 0x1b, 0xbd, 0x2b, 0x5c, 0x46, 0x19, 0xa1,
-	0x6c, 0xc0, 0x8c, 0xfe, 0xa0, 0xb9, 0x43, 0x86, 0xca, 0xa8, 0x67, 0xc6, 0x8c, 0x7a, 0x8b, 0x0e,
-	0x2b, 0xb9, 0x3f, 0x86, 0xd0, 0xa6, 0x3b, 0xec, 0x73, 0xa7, 0xbc, 0x31, 0x68, 0xbe, 0x45, 0x86,
-	0xc2, 0x55, 0x0a, 0x67, 0x43, 0xc2, 0xa0, 0x73, 0x90, 0xfc, 0x31, 0xb6, 0xbb, 0xc4, 0x92, 0x16,
-	0x99, 0xd1, 0xd5, 0x0a, 0xad, 0x40, 0x92, 0x71, 0xcc, 0x07, 0x4c, 0x9a, 0x61, 0xfe, 0x46, 0x69,
-	0x52, 0x6c, 0x54, 0x1c, 0x6a, 0x35, 0x24, 0xa5, 0xae, 0x38, 0xd0, 0x2a, 0x24, 0xb9, 0xb3, 0x43,
-	0xa8, 0x32, 0x50, 0xe5, 0x4b, 0x2a, 0x9a, 0xcf, 0x8e, 0x47, 0x73, 0x9d, 0xf2, 0x48, 0x1c, 0xd7,
-	0x29, 0xd7, 0x15, 0x2b, 0xfa, 0x01, 0x64, 0x2d, 0xd2, 0x25, 0x6d, 0x69, 0x39, 0xd6, 0xc1, 0x2e,
-	0x61, 0xb9, 0xa4, 0x84, 0xbb, 0x7e, 0xec, 0xe4, 0xd0, 0x33, 0x01, 0x54, 0x43, 0x22, 0xa1, 0x0d,
-	0x48, 0x59, 0x61, 0x38, 0xe5, 0xa6, 0xa5, 0x31, 0x5f, 0x9b, 0xa4, 0x63, 0x24, 0xf2, 0xa2, 0xb5,
-	0x30, 0x0a, 0x21, 0x22, 0x68, 0x40, 0x9b, 0x0e, 0xb5, 0x6c, 0xda, 0x36, 0x3a, 0xc4, 0x6e, 0x77,
-	0x78, 0x6e, 0xa6, 0xa8, 0x5d, 0x8e, 0xeb, 0x99, 0x60, 0xff, 0x8e, 0xdc, 0x46, 0x1b, 0x30, 0x1f,
-	0x92, 0xca, 0x0c, 0x99, 0x3d, 0x6e, 0x86, 0xcc, 0x05, 0x00, 0x82, 0x04, 0xbd, 0x03, 0x10, 0xe6,
-	0x60, 0x0e, 0x24, 0x5a, 0xe9, 0xf0, 0x6c, 0x8e, 0x2a, 0x13, 0x01, 0x40, 0xdf, 0x87, 0xd3, 0x3d,
-	0x9b, 0x1a, 0x8c, 0x74, 0x5b, 0x86, 0xb2, 0x9c, 0xc0, 0x4d, 0x1d, 0xdf, 0x9b, 0x0b, 0x3d, 0x9b,
-	0x36, 0x48, 0xb7, 0x55, 0x0d, 0x50, 0xd0, 0x37, 0xe0, 0x42, 0xa8, 0xbd, 0x43, 0x8d, 0x8e, 0xd3,
-	0xb5, 0x0c, 0x97, 0xb4, 0x0c, 0xd3, 0x19, 0x50, 0x9e, 0x4b, 0x4b, 0x9b, 0x9d, 0x0f, 0x48, 0xd6,
-	0xe9, 0x1d, 0xa7, 0x6b, 0xe9, 0xa4, 0xb5, 0x2a, 0x8e, 0xd1, 0x6b, 0x10, 0xaa, 0x6e, 0xd8, 0x16,
-	0xcb, 0xcd, 0x15, 0xe3, 0x97, 0x13, 0x7a, 0x3a, 0xd8, 0xac, 0x5b, 0x6c, 0x65, 0xe6, 0xbd, 0x87,
-	0x85, 0xa9, 0x4f, 0x1e, 0x16, 0xa6, 0x4a, 0xb7, 0x21, 0xbd, 0x8d, 0xbb, 0x2a, 0x8f, 0x08, 0x43,
-	0x37, 0x61, 0x16, 0xfb, 0x8b, 0x9c, 0x56, 0x8c, 0xbf, 0x30, 0x0f, 0x43, 0xd2, 0xd2, 0x6f, 0x35,
-	0x48, 0x56, 0xb7, 0x37, 0xb0, 0xed, 0xa2, 0x1a, 0x2c, 0x84, 0x81, 0x79, 0xd4, 0x94, 0x0e, 0x63,
-	0xd9, 0xcf, 0xe9, 0x35, 0x58, 0x08, 0x2e, 0xb0, 0x00, 0xc6, 0xbb, 0x57, 0x2e, 0x3e, 0x79, 0x74,
-	0xed, 0x55, 0x05, 0x13, 0x54, 0x92, 0x7d, 0x78, 0x77, 0xf7, 0xed, 0x47, 0x74, 0x7e, 0x13, 0xa6,
-	0x3d, 0x51, 0x19, 0xfa, 0x16, 0x9c, 0xea, 0x8b, 0x1f, 0x52, 0xd5, 0xd4, 0x8d, 0xa5, 0x89, 0x01,
-	0x2e, 0xe9, 0xa3, 0xe1, 0xe0, 0xf1, 0x95, 0xde, 0x8f, 0x01, 0x54, 0xb7, 0xb7, 0x37, 0x5d, 0xbb,
-	0xdf, 0x25, 0xfc, 0xd3, 0xd2, 0x7d, 0x0b, 0xce, 0x86, 0xba, 0x33, 0xd7, 0x3c, 0xbe, 0xfe, 0xa7,
-	0x03, 0xfe, 0x86, 0x6b, 0x1e, 0x08, 0x6b, 0x31, 0x1e, 0xc0, 0xc6, 0x8f, 0x0f, 0x5b, 0x65, 0x7c,
-	0xdc, 0xb2, 0xdf, 0x85, 0x54, 0x68, 0x0c, 0x86, 0xea, 0x30, 0xc3, 0xd5, 0x6f, 0x65, 0xe0, 0xd2,
-	0x64, 0x03, 0xfb, 0x6c, 0x51, 0x23, 0x07, 0xec, 0xa5, 0xff, 0x68, 0x00, 0x91, 0x1c, 0xf9, 0x6c,
-	0xc6, 0x18, 0xaa, 0x43, 0x52, 0x55, 0xe2, 0xf8, 0x49, 0x2b, 0xb1, 0x02, 0x88, 0x18, 0xf5, 0x67,
-	0x31, 0x38, 0xbd, 0xe5, 0x67, 0xef, 0x67, 0xdf, 0x06, 0x5b, 0x30, 0x4d, 0x28, 0x77, 0x6d, 0x69,
-	0x04, 0xe1, 0xf3, 0xaf, 0x4c, 0xf2, 0xf9, 0x01, 0x4a, 0xd5, 0x28, 0x77, 0x87, 0xd1, 0x08, 0xf0,
-	0xb1, 0x22, 0xf6, 0xf8, 0x55, 0x1c, 0x72, 0x93, 0x58, 0xc5, 0x6b, 0xd8, 0x74, 0x89, 0xdc, 0xf0,
-	0x2f, 0x19, 0x4d, 0x16, 0xcc, 0x79, 0x7f, 0x5b, 0xdd, 0x31, 0x3a, 0x88, 0x57, 0x99, 0x08, 0x2e,
-	0x41, 0x7a, 0xb2, 0x67, 0xd8, 0x7c, 0x88, 0x20, 0x6f, 0x99, 0x4d, 0xc8, 0xd8, 0xd4, 0xe6, 0x36,
-	0xee, 0x1a, 0x4d, 0xdc, 0xc5, 0xd4, 0xf4, 0x9f, 0xab, 0xc7, 0xba, 0x12, 0xe6, 0x15, 0x46, 0xc5,
-	0x83, 0x40, 0x35, 0x98, 0xf6, 0xd1, 0x12, 0xc7, 0x47, 0xf3, 0x79, 0xd1, 0x45, 0x48, 0x47, 0x2f,
-	0x06, 0xf9, 0xf4, 0x48, 0xe8, 0xa9, 0xc8, 0xbd, 0x70, 0xd8, 0xcd, 0x93, 0x7c, 0xe1, 0xcd, 0xa3,
-	0x5e, 0x77, 0xbf, 0x8e, 0xc3, 0x82, 0x4e, 0xac, 0xff, 0x7f, 0xb7, 0x6c, 0x00, 0x78, 0xa9, 0x2a,
-	0x2a, 0xa9, 0xf2, 0xcc, 0x09, 0xf2, 0x7d, 0xd6, 0x03, 0xa9, 0x32, 0xfe, 0xbf, 0xf2, 0xd0, 0x5f,
-	0x63, 0x90, 0x8e, 0x7a, 0xe8, 0x73, 0x79, 0x69, 0xa1, 0xb5, 0xb0, 0x4c, 0x25, 0x64, 0x99, 0xba,
-	0x32, 0xa9, 0x4c, 0x8d, 0x45, 0xf3, 0x21, 0xf5, 0xe9, 0x79, 0x1c, 0x92, 0x1b, 0xd8, 0xc5, 0x3d,
-	0x86, 0xd6, 0xc7, 0x1e, 0xb2, 0x5e, 0x23, 0xb9, 0x38, 0x16, 0xcc, 0x55, 0x35, 0x7d, 0xf1, 0x62,
-	0xf9, 0x97, 0x93, 0xde, 0xb1, 0x5f, 0x80, 0x79, 0xd1, 0x10, 0x87, 0x9d, 0xbd, 0x34, 0xee, 0x9c,
-	0xec, 0x6b, 0x03, 0xed, 0x19, 0x2a, 0x40, 0x4a, 0x90, 0x85, 0x75, 0x58, 0xd0, 0x40, 0x0f, 0xdf,
-	0xaf, 0x79, 0x3b, 0xe8, 0x1a, 0xa0, 0x4e, 0x30, 0x98, 0x30, 0x42, 0x43, 0x08, 0xba, 0x85, 0xf0,
-	0xc4, 0x27, 0x7f, 0x15, 0x40, 0x48, 0x61, 0x58, 0x84, 0x3a, 0x3d, 0xd5, 0xd5, 0xcd, 0x8a, 0x9d,
-	0xaa, 0xd8, 0x40, 0x3f, 0xd5, 0xbc, 0xf7, 0xf0, 0xbe, 0xb6, 0x59, 0xb5, 0x23, 0x9b, 0x47, 0x48,
-	0x8a, 0x7f, 0xef, 0x15, 0xf2, 0x43, 0xdc, 0xeb, 0xae, 0x94, 0x0e, 0xc0, 0x29, 0x1d, 0xd4, 0xc9,
-	0x8b, 0x87, 0xf3, 0x68, 0xdb, 0x8d, 0xea, 0x90, 0xdd, 0x21, 0x43, 0xc3, 0x75, 0xb8, 0x57, 0x68,
-	0x5a, 0x84, 0xa8, 0xc6, 0x65, 0xd1, 0xf7, 0x6d, 0x13, 0x33, 0x12, 0x79, 0xe7, 0xdb, 0xb4, 0x92,
-	0x10, 0xd2, 0xe9, 0xf3, 0x3b, 0x64, 0xa8, 0x2b, 0xbe, 0xdb, 0x84, 0xac, 0x5c, 0x12, 0x99, 0xb2,
-	0xfb, 0xfc, 0xa3, 0xab, 0x4a, 0xe8, 0x6b, 0xcc, 0xda, 0x59, 0xbe, 0x1f, 0xcc, 0xe6, 0x3c, 0xf7,
-	0x8a, 0x47, 0x2f, 0x0a, 0x2f, 0x20, 0x9d, 0xb0, 0xbe, 0x68, 0x1e, 0x45, 0xb3, 0x11, 0x69, 0x0a,
-	0xb4, 0x17, 0x37, 0x1b, 0x21, 0xff, 0x48, 0xb3, 0x11, 0x49, 0xcf, 0x6f, 0x86, 0xf5, 0x3f, 0x76,
-	0x98, 0x36, 0xd1, 0xc8, 0x54, 0x4c, 0x32, 0xeb, 0xa7, 0x4a, 0x7f, 0xd2, 0x60, 0x71, 0x2c, 0x92,
-	0x03, 0x91, 0x4d, 0x40, 0x6e, 0xe4, 0x50, 0x46, 0xc4, 0x50, 0x89, 0x7e, 0xb2, 0xc4, 0x58, 0x70,
-	0xc7, 0x2e, 0x81, 0x4f, 0xe7, 0x22, 0x53, 0x55, 0xec, 0x0f, 0x1a, 0x9c, 0x89, 0x0a, 0x10, 0xa8,
-	0xd2, 0x80, 0x74, 0xf4, 0xd3, 0x4a, 0x89, 0x4b, 0x47, 0x51, 0x22, 0x2a, 0xff, 0x08, 0x08, 0xda,
-	0x0e, 0xab, 0x85, 0x37, 0x14, 0xbc, 0x7e, 0x64, 0xa3, 0xf8, 0x82, 0x1d, 0x58, 0x35, 0x3c, 0xdf,
-	0xfc, 0x53, 0x83, 0xc4, 0x86, 0xe3, 0x74, 0xd1, 0x4f, 0x60, 0x81, 0x3a, 0xdc, 0x10, 0x99, 0x45,
-	0x2c, 0x43, 0xcd, 0x08, 0xbc, 0x4a, 0x5c, 0x7b, 0xa1, 0xad, 0xfe, 0xb1, 0x57, 0x18, 0xe7, 0x1c,
-	0x35, 0xa0, 0x1a, 0x45, 0x51, 0x87, 0x57, 0x24, 0xd1, 0xa6, 0x37, 0x46, 0x68, 0xc1, 0xdc, 0xe8,
-	0xe7, 0xbc, 0x6a, 0x7d, 0xeb, 0xb0, 0xcf, 0xcd, 0x1d, 0xfa, 0xa9, 0x74, 0x33, 0xf2, 0x9d, 0x95,
-	0x19, 0xe1, 0xb5, 0x7f, 0x09, 0xcf, 0xbd, 0x0b, 0xd9, 0xa0, 0x54, 0x6d, 0xc9, 0x39, 0x16, 0x13,
-	0xa1, 0xe1, 0x8d, 0xb4, 0xfc, 0x46, 0xa1, 0x18, 0x9d, 0xd8, 0xe2, 0xa6, 0x69, 0x97, 0xf7, 0xf1,
-	0x8c, 0x98, 0x53, 0xf1, 0x96, 0x1e, 0xc7, 0x60, 0x71, 0xd5, 0xa1, 0x4c, 0x0d, 0x73, 0x54, 0x42,
-	0x7b, 0x23, 0xd8, 0x21, 0xba, 0x32, 0x61, 0xd4, 0x94, 0x1e, 0x1f, 0x28, 0x6d, 0x43, 0x46, 0xdc,
-	0xac, 0xa6, 0x43, 0x5f, 0x72, 0x9e, 0x34, 0xe7, 0x74, 0x2d, 0x25, 0xd1, 0x0e, 0x19, 0x0a, 0x5c,
-	0x4a, 0xee, 0x8d, 0xe0, 0xc6, 0x4f, 0x86, 0x4b, 0xc9, 0xbd, 0x08, 0xee, 0x39, 0x48, 0xaa, 0x67,
-	0x55, 0x42, 0x3e, 0x1a, 0xd4, 0x0a, 0xdd, 0x84, 0xb8, 0xa8, 0x82, 0xa7, 0x8e, 0x51, 0x37, 0x04,
-	0x43, 0xe4, 0x36, 0x6b, 0xc0, 0xa2, 0x1a, 0x10, 0xb0, 0xf5, 0x96, 0xb4, 0x28, 0x91, 0x0a, 0xbd,
-	0x45, 0x86, 0x07, 0x4c, 0x0b, 0xd2, 0x47, 0x9a, 0x16, 0x5c, 0xfd, 0x9d, 0x06, 0x10, 0xce, 0xc5,
-	0xd0, 0x97, 0xe1, 0x7c, 0x65, 0x7d, 0xad, 0x6a, 0x34, 0x36, 0x6f, 0x6d, 0x6e, 0x35, 0x8c, 0xad,
-	0xb5, 0xc6, 0x46, 0x6d, 0xb5, 0x7e, 0xbb, 0x5e, 0xab, 0x66, 0xa7, 0xf2, 0x99, 0xdd, 0x07, 0xc5,
-	0xd4, 0x16, 0x65, 0x7d, 0x62, 0xda, 0x2d, 0x9b, 0x58, 0xe8, 0x8b, 0x70, 0x66, 0x94, 0x5a, 0xac,
-	0x6a, 0xd5, 0xac, 0x96, 0x4f, 0xef, 0x3e, 0x28, 0xce, 0x78, 0xad, 0x01, 0xb1, 0xd0, 0x65, 0x38,
-	0x3b, 0x4e, 0x57, 0x5f, 0x7b, 0x23, 0x1b, 0xcb, 0xcf, 0xed, 0x3e, 0x28, 0xce, 0x06, 0x3d, 0x04,
-	0x2a, 0x01, 0x8a, 0x52, 0x2a, 0xbc, 0x78, 0x1e, 0x76, 0x1f, 0x14, 0x93, 0x5e, 0xb6, 0xe4, 0x13,
-	0xef, 0xfd, 0x66, 0x69, 0xea, 0xea, 0x0f, 0x01, 0xea, 0xb4, 0xe5, 0x62, 0x53, 0x56, 0x85, 0x3c,
-	0x9c, 0xab, 0xaf, 0xdd, 0xd6, 0x6f, 0xad, 0x6e, 0xd6, 0xd7, 0xd7, 0x46, 0xc5, 0xde, 0x77, 0x56,
-	0x5d, 0xdf, 0xaa, 0xbc, 0x5d, 0x33, 0x1a, 0xf5, 0x37, 0xd6, 0xb2, 0x1a, 0x3a, 0x0f, 0xa7, 0x47,
-	0xce, 0xbe, 0xb3, 0xb6, 0x59, 0x7f, 0xa7, 0x96, 0x8d, 0x55, 0x6e, 0x7e, 0xfc, 0x74, 0x49, 0x7b,
-	0xfc, 0x74, 0x49, 0xfb, 0xfb, 0xd3, 0x25, 0xed, 0x83, 0x67, 0x4b, 0x53, 0x8f, 0x9f, 0x2d, 0x4d,
-	0xfd, 0xf9, 0xd9, 0xd2, 0xd4, 0xf7, 0x5e, 0x19, 0xc9, 0xc3, 0xf0, 0x26, 0x92, 0xff, 0xcc, 0x68,
-	0x26, 0x65, 0xd4, 0x7c, 0xf5, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x51, 0xe0, 0x99, 0x08, 0x44,
-	0x1a, 0x00, 0x00,
+func (m *DelegationPosition) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
-	return StakingDescription()
+func (m *DelegationPosition) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
 }
-func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
-	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
-	var gzipped = []byte{
-		// 11501 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x94, 0x1c, 0xc7,
-		0x75, 0x1f, 0xbe, 0x3d, 0xef, 0xb9, 0xf3, 0xea, 0xad, 0x5d, 0x00, 0x83, 0x01, 0x89, 0x5d, 0x36,
-		0x45, 0x12, 0x04, 0xc9, 0x05, 0x09, 0x12, 0x20, 0xb9, 0x90, 0xc4, 0xff, 0xcc, 0xec, 0x00, 0x3b,
-		0xe0, 0xbe, 0xd8, 0x33, 0x0b, 0x11, 0xb4, 0xad, 0x76, 0x6f, 0x4f, 0xed, 0x6e, 0x13, 0x33, 0xdd,
-		0xa3, 0xe9, 0x1e, 0x60, 0x97, 0x1f, 0xfe, 0x47, 0x8e, 0xec, 0x44, 0x86, 0x1f, 0x91, 0x63, 0x1f,
-		0x5b, 0xb6, 0x04, 0x5b, 0xb2, 0x63, 0x4b, 0x76, 0xe2, 0xc4, 0xb6, 0x14, 0x3f, 0xe2, 0x13, 0xc7,
-		0xce, 0x71, 0x12, 0xdb, 0x27, 0xc9, 0x51, 0xfc, 0x21, 0x71, 0x72, 0x8e, 0x19, 0x5b, 0xf2, 0x89,
-		0x14, 0x59, 0x4e, 0xfc, 0x90, 0x13, 0xe7, 0xe8, 0x24, 0x27, 0xa7, 0x5e, 0xfd, 0x98, 0xc7, 0xce,
-		0x2c, 0x44, 0xca, 0x8a, 0x9d, 0x2f, 0xc0, 0x74, 0xd5, 0xbd, 0xbf, 0xaa, 0xba, 0x75, 0xeb, 0xd6,
-		0xad, 0x5b, 0x8f, 0x85, 0xdf, 0xbf, 0x0a, 0x8b, 0x7b, 0xb6, 0xbd, 0xd7, 0xc6, 0x17, 0xba, 0x3d,
-		0xdb, 0xb5, 0x77, 0xfa, 0xbb, 0x17, 0x5a, 0xd8, 0x31, 0x7a, 0x66, 0xd7, 0xb5, 0x7b, 0x4b, 0x34,
-		0x0d, 0x15, 0x18, 0xc5, 0x92, 0xa0, 0x50, 0xd6, 0x61, 0xf6, 0xaa, 0xd9, 0xc6, 0x2b, 0x1e, 0x61,
-		0x03, 0xbb, 0xe8, 0x05, 0x88, 0xed, 0x9a, 0x6d, 0x5c, 0x94, 0x16, 0xa3, 0xe7, 0x32, 0x17, 0xdf,
-		0xb1, 0x34, 0xc0, 0xb4, 0x14, 0xe6, 0xd8, 0x22, 0xc9, 0x2a, 0xe5, 0x50, 0xfe, 0x77, 0x0c, 0xe6,
-		0x46, 0xe4, 0x22, 0x04, 0x31, 0x4b, 0xef, 0x10, 0x44, 0xe9, 0x5c, 0x5a, 0xa5, 0xbf, 0x51, 0x11,
-		0x92, 0x5d, 0xdd, 0xb8, 0xa5, 0xef, 0xe1, 0x62, 0x84, 0x26, 0x8b, 0x4f, 0x74, 0x16, 0xa0, 0x85,
-		0xbb, 0xd8, 0x6a, 0x61, 0xcb, 0x38, 0x2c, 0x46, 0x17, 0xa3, 0xe7, 0xd2, 0x6a, 0x20, 0x05, 0x3d,
-		0x01, 0xb3, 0xdd, 0xfe, 0x4e, 0xdb, 0x34, 0xb4, 0x00, 0x19, 0x2c, 0x46, 0xcf, 0xc5, 0x55, 0x99,
-		0x65, 0xac, 0xf8, 0xc4, 0x8f, 0x41, 0xe1, 0x0e, 0xd6, 0x6f, 0x05, 0x49, 0x33, 0x94, 0x34, 0x4f,
-		0x92, 0x03, 0x84, 0x55, 0xc8, 0x76, 0xb0, 0xe3, 0xe8, 0x7b, 0x58, 0x73, 0x0f, 0xbb, 0xb8, 0x18,
-		0xa3, 0xad, 0x5f, 0x1c, 0x6a, 0xfd, 0x60, 0xcb, 0x33, 0x9c, 0xab, 0x79, 0xd8, 0xc5, 0xa8, 0x0c,
-		0x69, 0x6c, 0xf5, 0x3b, 0x0c, 0x21, 0x3e, 0x46, 0x7e, 0x35, 0xab, 0xdf, 0x19, 0x44, 0x49, 0x11,
-		0x36, 0x0e, 0x91, 0x74, 0x70, 0xef, 0xb6, 0x69, 0xe0, 0x62, 0x82, 0x02, 0x3c, 0x36, 0x04, 0xd0,
-		0x60, 0xf9, 0x83, 0x18, 0x82, 0x0f, 0x55, 0x21, 0x8d, 0x0f, 0x5c, 0x6c, 0x39, 0xa6, 0x6d, 0x15,
-		0x93, 0x14, 0xe4, 0x91, 0x11, 0xbd, 0x88, 0xdb, 0xad, 0x41, 0x08, 0x9f, 0x0f, 0x5d, 0x86, 0xa4,
-		0xdd, 0x75, 0x4d, 0xdb, 0x72, 0x8a, 0xa9, 0x45, 0xe9, 0x5c, 0xe6, 0xe2, 0x03, 0x23, 0x15, 0x61,
-		0x93, 0xd1, 0xa8, 0x82, 0x18, 0xd5, 0x41, 0x76, 0xec, 0x7e, 0xcf, 0xc0, 0x9a, 0x61, 0xb7, 0xb0,
-		0x66, 0x5a, 0xbb, 0x76, 0x31, 0x4d, 0x01, 0x16, 0x86, 0x1b, 0x42, 0x09, 0xab, 0x76, 0x0b, 0xd7,
-		0xad, 0x5d, 0x5b, 0xcd, 0x3b, 0xa1, 0x6f, 0x74, 0x12, 0x12, 0xce, 0xa1, 0xe5, 0xea, 0x07, 0xc5,
-		0x2c, 0xd5, 0x10, 0xfe, 0x45, 0x54, 0x07, 0xb7, 0x4c, 0x52, 0x5c, 0x31, 0xc7, 0x54, 0x87, 0x7f,
-		0x2a, 0xbf, 0x94, 0x80, 0xc2, 0x34, 0xca, 0x77, 0x05, 0xe2, 0xbb, 0xa4, 0xfd, 0xc5, 0xc8, 0x71,
-		0xa4, 0xc3, 0x78, 0xc2, 0xe2, 0x4d, 0xdc, 0xa7, 0x78, 0xcb, 0x90, 0xb1, 0xb0, 0xe3, 0xe2, 0x16,
-		0xd3, 0x95, 0xe8, 0x94, 0xda, 0x06, 0x8c, 0x69, 0x58, 0xd9, 0x62, 0xf7, 0xa5, 0x6c, 0xaf, 0x42,
-		0xc1, 0xab, 0x92, 0xd6, 0xd3, 0xad, 0x3d, 0xa1, 0xb5, 0x17, 0x26, 0xd5, 0x64, 0xa9, 0x26, 0xf8,
-		0x54, 0xc2, 0xa6, 0xe6, 0x71, 0xe8, 0x1b, 0xad, 0x00, 0xd8, 0x16, 0xb6, 0x77, 0xb5, 0x16, 0x36,
-		0xda, 0xc5, 0xd4, 0x18, 0x29, 0x6d, 0x12, 0x92, 0x21, 0x29, 0xd9, 0x2c, 0xd5, 0x68, 0xa3, 0x17,
-		0x7d, 0x25, 0x4c, 0x8e, 0xd1, 0xa1, 0x75, 0x36, 0xfc, 0x86, 0xf4, 0x70, 0x1b, 0xf2, 0x3d, 0x4c,
-		0x46, 0x04, 0x6e, 0xf1, 0x96, 0xa5, 0x69, 0x25, 0x96, 0x26, 0xb6, 0x4c, 0xe5, 0x6c, 0xac, 0x61,
-		0xb9, 0x5e, 0xf0, 0x13, 0x3d, 0x0c, 0x5e, 0x82, 0x46, 0xd5, 0x0a, 0xa8, 0x7d, 0xca, 0x8a, 0xc4,
-		0x0d, 0xbd, 0x83, 0x4b, 0x6f, 0x40, 0x3e, 0x2c, 0x1e, 0x34, 0x0f, 0x71, 0xc7, 0xd5, 0x7b, 0x2e,
-		0xd5, 0xc2, 0xb8, 0xca, 0x3e, 0x90, 0x0c, 0x51, 0x6c, 0xb5, 0xa8, 0xfd, 0x8b, 0xab, 0xe4, 0x27,
-		0xfa, 0xff, 0xfc, 0x06, 0x47, 0x69, 0x83, 0x1f, 0x1d, 0xee, 0xd1, 0x10, 0xf2, 0x60, 0xbb, 0x4b,
-		0xcf, 0x43, 0x2e, 0xd4, 0x80, 0x69, 0x8b, 0x56, 0x7e, 0x3a, 0x06, 0x27, 0x46, 0x62, 0xa3, 0x57,
-		0x61, 0xbe, 0x6f, 0x99, 0x96, 0x8b, 0x7b, 0xdd, 0x1e, 0x26, 0x2a, 0xcb, 0xca, 0x2a, 0x7e, 0x3e,
-		0x39, 0x46, 0xe9, 0xb6, 0x83, 0xd4, 0x0c, 0x45, 0x9d, 0xeb, 0x0f, 0x27, 0xa2, 0x9b, 0x90, 0x21,
-		0xfa, 0xa1, 0xf7, 0x74, 0x0a, 0xc8, 0x46, 0xe3, 0xc5, 0xe9, 0x9a, 0xbc, 0xb4, 0xe2, 0x73, 0x56,
-		0xa2, 0x1f, 0x94, 0x22, 0x6a, 0x10, 0x0b, 0xed, 0x43, 0xf6, 0x36, 0xee, 0x99, 0xbb, 0xa6, 0xc1,
-		0xb0, 0x89, 0x38, 0xf3, 0x17, 0x5f, 0x98, 0x12, 0xfb, 0x46, 0x80, 0xb5, 0xe1, 0xea, 0x2e, 0x5e,
-		0x86, 0xed, 0x8d, 0x1b, 0x35, 0xb5, 0x7e, 0xb5, 0x5e, 0x5b, 0x51, 0x43, 0xc8, 0xa5, 0x4f, 0x49,
-		0x90, 0x09, 0xd4, 0x85, 0x98, 0x2d, 0xab, 0xdf, 0xd9, 0xc1, 0x3d, 0x2e, 0x71, 0xfe, 0x85, 0xce,
-		0x40, 0x7a, 0xb7, 0xdf, 0x6e, 0x33, 0xb5, 0x61, 0x73, 0x5e, 0x8a, 0x24, 0x10, 0x95, 0x21, 0x56,
-		0x8a, 0x1b, 0x02, 0x6a, 0xa5, 0xc8, 0x6f, 0xf4, 0x30, 0x64, 0x4c, 0x47, 0xeb, 0xe1, 0x2e, 0xd6,
-		0x5d, 0xdc, 0x2a, 0xc6, 0x16, 0xa5, 0x73, 0xa9, 0x4a, 0xa4, 0x28, 0xa9, 0x60, 0x3a, 0x2a, 0x4f,
-		0x45, 0x25, 0x48, 0x09, 0xdd, 0x2b, 0xc6, 0x09, 0x85, 0xea, 0x7d, 0xb3, 0x3c, 0xce, 0x9d, 0x10,
-		0x79, 0xec, 0x5b, 0x79, 0x0e, 0x66, 0x87, 0x1a, 0x89, 0x0a, 0x90, 0x59, 0xa9, 0x55, 0xd7, 0xca,
-		0x6a, 0xb9, 0x59, 0xdf, 0xdc, 0x90, 0x67, 0x50, 0x1e, 0x02, 0xed, 0x96, 0xa5, 0xf3, 0xe9, 0xd4,
-		0x17, 0x92, 0xf2, 0xfb, 0xdf, 0xff, 0xfe, 0xf7, 0x47, 0x94, 0x5f, 0x4b, 0xc0, 0xfc, 0x28, 0x2b,
-		0x37, 0xd2, 0xe0, 0xfa, 0x32, 0x89, 0x86, 0x64, 0x52, 0x86, 0x78, 0x5b, 0xdf, 0xc1, 0x6d, 0xda,
-		0xb8, 0xfc, 0xc5, 0x27, 0xa6, 0xb2, 0xa3, 0x4b, 0x6b, 0x84, 0x45, 0x65, 0x9c, 0xe8, 0xdd, 0x5c,
-		0x72, 0x71, 0x8a, 0x70, 0x7e, 0x3a, 0x04, 0x62, 0xfd, 0xb8, 0x94, 0xcf, 0x40, 0x9a, 0xfc, 0xcf,
-		0xba, 0x25, 0xc1, 0xba, 0x85, 0x24, 0xd0, 0x6e, 0x29, 0x41, 0x8a, 0x1a, 0xb6, 0x16, 0xf6, 0xba,
-		0x4c, 0x7c, 0x13, 0x53, 0xd0, 0xc2, 0xbb, 0x7a, 0xbf, 0xed, 0x6a, 0xb7, 0xf5, 0x76, 0x1f, 0x53,
-		0x13, 0x95, 0x56, 0xb3, 0x3c, 0xf1, 0x06, 0x49, 0x43, 0x0b, 0x90, 0x61, 0x76, 0xd0, 0xb4, 0x5a,
-		0xf8, 0x80, 0xce, 0x84, 0x71, 0x95, 0x99, 0xc6, 0x3a, 0x49, 0x21, 0xc5, 0xbf, 0xee, 0xd8, 0x96,
-		0x30, 0x26, 0xb4, 0x08, 0x92, 0x40, 0x8b, 0x7f, 0x7e, 0x70, 0x12, 0x7e, 0x70, 0x74, 0xf3, 0x86,
-		0xac, 0xdf, 0x63, 0x50, 0xa0, 0x14, 0xcf, 0xf2, 0xb1, 0xaa, 0xb7, 0x8b, 0xb3, 0x54, 0x01, 0xf2,
-		0x2c, 0x79, 0x93, 0xa7, 0x2a, 0x3f, 0x1f, 0x81, 0x18, 0x9d, 0x0a, 0x0a, 0x90, 0x69, 0xde, 0xdc,
-		0xaa, 0x69, 0x2b, 0x9b, 0xdb, 0x95, 0xb5, 0x9a, 0x2c, 0x91, 0xae, 0xa7, 0x09, 0x57, 0xd7, 0x36,
-		0xcb, 0x4d, 0x39, 0xe2, 0x7d, 0xd7, 0x37, 0x9a, 0x97, 0x9f, 0x93, 0xa3, 0x1e, 0xc3, 0x36, 0x4b,
-		0x88, 0x05, 0x09, 0x9e, 0xbd, 0x28, 0xc7, 0x91, 0x0c, 0x59, 0x06, 0x50, 0x7f, 0xb5, 0xb6, 0x72,
-		0xf9, 0x39, 0x39, 0x11, 0x4e, 0x79, 0xf6, 0xa2, 0x9c, 0x44, 0x39, 0x48, 0xd3, 0x94, 0xca, 0xe6,
-		0xe6, 0x9a, 0x9c, 0xf2, 0x30, 0x1b, 0x4d, 0xb5, 0xbe, 0x71, 0x4d, 0x4e, 0x7b, 0x98, 0xd7, 0xd4,
-		0xcd, 0xed, 0x2d, 0x19, 0x3c, 0x84, 0xf5, 0x5a, 0xa3, 0x51, 0xbe, 0x56, 0x93, 0x33, 0x1e, 0x45,
-		0xe5, 0x66, 0xb3, 0xd6, 0x90, 0xb3, 0xa1, 0x6a, 0x3d, 0x7b, 0x51, 0xce, 0x79, 0x45, 0xd4, 0x36,
-		0xb6, 0xd7, 0xe5, 0x3c, 0x9a, 0x85, 0x1c, 0x2b, 0x42, 0x54, 0xa2, 0x30, 0x90, 0x74, 0xf9, 0x39,
-		0x59, 0xf6, 0x2b, 0xc2, 0x50, 0x66, 0x43, 0x09, 0x97, 0x9f, 0x93, 0x91, 0x52, 0x85, 0x38, 0x55,
-		0x43, 0x84, 0x20, 0xbf, 0x56, 0xae, 0xd4, 0xd6, 0xb4, 0xcd, 0x2d, 0x32, 0x68, 0xca, 0x6b, 0xb2,
-		0xe4, 0xa7, 0xa9, 0xb5, 0x57, 0xb6, 0xeb, 0x6a, 0x6d, 0x45, 0x8e, 0x04, 0xd3, 0xb6, 0x6a, 0xe5,
-		0x66, 0x6d, 0x45, 0x8e, 0x2a, 0x06, 0xcc, 0x8f, 0x9a, 0x02, 0x47, 0x0e, 0xa1, 0x80, 0x2e, 0x44,
-		0xc6, 0xe8, 0x02, 0xc5, 0x1a, 0xd4, 0x05, 0xe5, 0x73, 0x11, 0x98, 0x1b, 0xe1, 0x06, 0x8c, 0x2c,
-		0xe4, 0x25, 0x88, 0x33, 0x5d, 0x66, 0xa6, 0xf8, 0xf1, 0x91, 0xfe, 0x04, 0xd5, 0xec, 0x21, 0xe7,
-		0x88, 0xf2, 0x05, 0xdd, 0xc6, 0xe8, 0x18, 0xb7, 0x91, 0x40, 0x0c, 0x29, 0xec, 0x37, 0x0d, 0x4d,
-		0xd7, 0xcc, 0xa3, 0xb9, 0x3c, 0x8d, 0x47, 0x43, 0xd3, 0x8e, 0x37, 0x6d, 0xc7, 0x47, 0x4c, 0xdb,
-		0x57, 0x60, 0x76, 0x08, 0x68, 0xea, 0xe9, 0xf3, 0x03, 0x12, 0x14, 0xc7, 0x09, 0x67, 0x82, 0x49,
-		0x8c, 0x84, 0x4c, 0xe2, 0x95, 0x41, 0x09, 0x3e, 0x34, 0xbe, 0x13, 0x86, 0xfa, 0xfa, 0x13, 0x12,
-		0x9c, 0x1c, 0xbd, 0x3c, 0x18, 0x59, 0x87, 0x77, 0x43, 0xa2, 0x83, 0xdd, 0x7d, 0x5b, 0x38, 0xc2,
-		0x8f, 0x8e, 0x70, 0xaf, 0x48, 0xf6, 0x60, 0x67, 0x73, 0xae, 0xa0, 0x7f, 0x16, 0x1d, 0xe7, 0xe3,
-		0xb3, 0xda, 0x0c, 0xd5, 0xf4, 0xdb, 0x23, 0x70, 0x62, 0x24, 0xf8, 0xc8, 0x8a, 0x3e, 0x08, 0x60,
-		0x5a, 0xdd, 0xbe, 0xcb, 0x9c, 0x5d, 0x66, 0x89, 0xd3, 0x34, 0x85, 0x1a, 0x2f, 0x62, 0x65, 0xfb,
-		0xae, 0x97, 0xcf, 0x26, 0x51, 0x60, 0x49, 0x94, 0xe0, 0x05, 0xbf, 0xa2, 0x31, 0x5a, 0xd1, 0xb3,
-		0x63, 0x5a, 0x3a, 0xa4, 0x98, 0x4f, 0x83, 0x6c, 0xb4, 0x4d, 0x6c, 0xb9, 0x9a, 0xe3, 0xf6, 0xb0,
-		0xde, 0x31, 0xad, 0x3d, 0x36, 0xcf, 0x2e, 0xc7, 0x77, 0xf5, 0xb6, 0x83, 0xd5, 0x02, 0xcb, 0x6e,
-		0x88, 0x5c, 0xc2, 0x41, 0x15, 0xa8, 0x17, 0xe0, 0x48, 0x84, 0x38, 0x58, 0xb6, 0xc7, 0xa1, 0x7c,
-		0x4f, 0x1a, 0x32, 0x81, 0xc5, 0x14, 0x7a, 0x08, 0xb2, 0xaf, 0xeb, 0xb7, 0x75, 0x4d, 0x2c, 0x90,
-		0x99, 0x24, 0x32, 0x24, 0x6d, 0x8b, 0x2f, 0x92, 0x9f, 0x86, 0x79, 0x4a, 0x62, 0xf7, 0x5d, 0xdc,
-		0xd3, 0x8c, 0xb6, 0xee, 0x38, 0x54, 0x68, 0x29, 0x4a, 0x8a, 0x48, 0xde, 0x26, 0xc9, 0xaa, 0x8a,
-		0x1c, 0x74, 0x09, 0xe6, 0x28, 0x47, 0xa7, 0xdf, 0x76, 0xcd, 0x6e, 0x1b, 0x6b, 0x64, 0xc9, 0xee,
-		0xd0, 0x29, 0xc7, 0xab, 0xd9, 0x2c, 0xa1, 0x58, 0xe7, 0x04, 0xa4, 0x46, 0x0e, 0x5a, 0x81, 0x07,
-		0x29, 0xdb, 0x1e, 0xb6, 0x70, 0x4f, 0x77, 0xb1, 0x86, 0xdf, 0xd7, 0xd7, 0xdb, 0x8e, 0xa6, 0x5b,
-		0x2d, 0x6d, 0x5f, 0x77, 0xf6, 0x8b, 0xf3, 0x9e, 0x5b, 0x72, 0x9a, 0x10, 0x5e, 0xe3, 0x74, 0x35,
-		0x4a, 0x56, 0xb6, 0x5a, 0xab, 0xba, 0xb3, 0x8f, 0x96, 0xe1, 0x24, 0x45, 0x71, 0xdc, 0x9e, 0x69,
-		0xed, 0x69, 0xc6, 0x3e, 0x36, 0x6e, 0x69, 0x7d, 0x77, 0xf7, 0x85, 0xe2, 0x99, 0x60, 0xf9, 0xb4,
-		0x86, 0x0d, 0x4a, 0x53, 0x25, 0x24, 0xdb, 0xee, 0xee, 0x0b, 0xa8, 0x01, 0x59, 0xd2, 0x19, 0x1d,
-		0xf3, 0x0d, 0xac, 0xed, 0xda, 0x3d, 0x3a, 0x87, 0xe6, 0x47, 0x98, 0xa6, 0x80, 0x04, 0x97, 0x36,
-		0x39, 0xc3, 0xba, 0xdd, 0xc2, 0xcb, 0xf1, 0xc6, 0x56, 0xad, 0xb6, 0xa2, 0x66, 0x04, 0xca, 0x55,
-		0xbb, 0x47, 0x14, 0x6a, 0xcf, 0xf6, 0x04, 0x9c, 0x61, 0x0a, 0xb5, 0x67, 0x0b, 0xf1, 0x5e, 0x82,
-		0x39, 0xc3, 0x60, 0x6d, 0x36, 0x0d, 0x8d, 0x2f, 0xac, 0x9d, 0xa2, 0x1c, 0x12, 0x96, 0x61, 0x5c,
-		0x63, 0x04, 0x5c, 0xc7, 0x1d, 0xf4, 0x22, 0x9c, 0xf0, 0x85, 0x15, 0x64, 0x9c, 0x1d, 0x6a, 0xe5,
-		0x20, 0xeb, 0x25, 0x98, 0xeb, 0x1e, 0x0e, 0x33, 0xa2, 0x50, 0x89, 0xdd, 0xc3, 0x41, 0xb6, 0xe7,
-		0x61, 0xbe, 0xbb, 0xdf, 0x1d, 0xe6, 0x3b, 0x1f, 0xe4, 0x43, 0xdd, 0xfd, 0xee, 0x20, 0xe3, 0x23,
-		0x34, 0xca, 0xd2, 0xc3, 0x06, 0xf5, 0x0e, 0x4f, 0x05, 0xc9, 0x03, 0x19, 0x68, 0x09, 0x64, 0xc3,
-		0xd0, 0xb0, 0xa5, 0xef, 0xb4, 0xb1, 0xa6, 0xf7, 0xb0, 0xa5, 0x3b, 0xc5, 0x05, 0x4a, 0x1c, 0x73,
-		0x7b, 0x7d, 0xac, 0xe6, 0x0d, 0xa3, 0x46, 0x33, 0xcb, 0x34, 0x0f, 0x9d, 0x87, 0x59, 0x7b, 0xe7,
-		0x75, 0x83, 0x69, 0xa4, 0xd6, 0xed, 0xe1, 0x5d, 0xf3, 0xa0, 0xf8, 0x0e, 0x2a, 0xde, 0x02, 0xc9,
-		0xa0, 0xfa, 0xb8, 0x45, 0x93, 0xd1, 0xe3, 0x20, 0x1b, 0xce, 0xbe, 0xde, 0xeb, 0x52, 0x93, 0xec,
-		0x74, 0x75, 0x03, 0x17, 0x1f, 0x61, 0xa4, 0x2c, 0x7d, 0x43, 0x24, 0x93, 0x11, 0xe1, 0xdc, 0x31,
-		0x77, 0x5d, 0x81, 0xf8, 0x18, 0x1b, 0x11, 0x34, 0x8d, 0xa3, 0x9d, 0x03, 0x99, 0x48, 0x22, 0x54,
-		0xf0, 0x39, 0x4a, 0x96, 0xef, 0xee, 0x77, 0x83, 0xe5, 0x3e, 0x0c, 0x39, 0x42, 0xe9, 0x17, 0xfa,
-		0x38, 0x73, 0xdc, 0xba, 0xfb, 0x81, 0x12, 0x9f, 0x83, 0x93, 0x84, 0xa8, 0x83, 0x5d, 0xbd, 0xa5,
-		0xbb, 0x7a, 0x80, 0xfa, 0x49, 0x4a, 0x4d, 0xc4, 0xbe, 0xce, 0x33, 0x43, 0xf5, 0xec, 0xf5, 0x77,
-		0x0e, 0x3d, 0xc5, 0x7a, 0x8a, 0xd5, 0x93, 0xa4, 0x09, 0xd5, 0x7a, 0xdb, 0x56, 0x53, 0xca, 0x32,
-		0x64, 0x83, 0x7a, 0x8f, 0xd2, 0xc0, 0x34, 0x5f, 0x96, 0x88, 0x13, 0x54, 0xdd, 0x5c, 0x21, 0xee,
-		0xcb, 0x6b, 0x35, 0x39, 0x42, 0xdc, 0xa8, 0xb5, 0x7a, 0xb3, 0xa6, 0xa9, 0xdb, 0x1b, 0xcd, 0xfa,
-		0x7a, 0x4d, 0x8e, 0x06, 0x1c, 0xfb, 0xeb, 0xb1, 0xd4, 0xa3, 0xf2, 0x63, 0xca, 0x2f, 0x47, 0x21,
-		0x1f, 0x5e, 0x5b, 0xa3, 0x77, 0xc2, 0x29, 0x11, 0x22, 0x73, 0xb0, 0xab, 0xdd, 0x31, 0x7b, 0x74,
-		0x40, 0x76, 0x74, 0x36, 0x39, 0x7a, 0xfa, 0x33, 0xcf, 0xa9, 0x1a, 0xd8, 0x7d, 0x8f, 0xd9, 0x23,
-		0xc3, 0xad, 0xa3, 0xbb, 0x68, 0x0d, 0x16, 0x2c, 0x5b, 0x73, 0x5c, 0xdd, 0x6a, 0xe9, 0xbd, 0x96,
-		0xe6, 0x07, 0x27, 0x35, 0xdd, 0x30, 0xb0, 0xe3, 0xd8, 0x6c, 0x22, 0xf4, 0x50, 0x1e, 0xb0, 0xec,
-		0x06, 0x27, 0xf6, 0x67, 0x88, 0x32, 0x27, 0x1d, 0x50, 0xdf, 0xe8, 0x38, 0xf5, 0x3d, 0x03, 0xe9,
-		0x8e, 0xde, 0xd5, 0xb0, 0xe5, 0xf6, 0x0e, 0xa9, 0x7f, 0x9e, 0x52, 0x53, 0x1d, 0xbd, 0x5b, 0x23,
-		0xdf, 0xe8, 0x06, 0x3c, 0xea, 0x93, 0x6a, 0x6d, 0xbc, 0xa7, 0x1b, 0x87, 0x1a, 0x75, 0xc6, 0x69,
-		0xa0, 0x47, 0x33, 0x6c, 0x6b, 0xb7, 0x6d, 0x1a, 0xae, 0x43, 0xed, 0x03, 0xb3, 0x71, 0x8a, 0xcf,
-		0xb1, 0x46, 0x19, 0xae, 0x3b, 0xb6, 0x45, 0x7d, 0xf0, 0xaa, 0xa0, 0x7e, 0xfb, 0x7a, 0x38, 0xdc,
-		0x4b, 0x31, 0x39, 0x7e, 0x3d, 0x96, 0x8a, 0xcb, 0x89, 0xeb, 0xb1, 0x54, 0x42, 0x4e, 0x5e, 0x8f,
-		0xa5, 0x52, 0x72, 0xfa, 0x7a, 0x2c, 0x95, 0x96, 0x41, 0xf9, 0xb6, 0x34, 0x64, 0x83, 0x2b, 0x03,
-		0xb2, 0xd0, 0x32, 0xe8, 0xdc, 0x28, 0x51, 0xeb, 0xf9, 0xf0, 0x91, 0xeb, 0x88, 0xa5, 0x2a, 0x99,
-		0x34, 0x97, 0x13, 0xcc, 0x0d, 0x57, 0x19, 0x27, 0x71, 0x58, 0x88, 0x5a, 0x63, 0xe6, 0xf6, 0xa4,
-		0x54, 0xfe, 0x85, 0xae, 0x41, 0xe2, 0x75, 0x87, 0x62, 0x27, 0x28, 0xf6, 0x3b, 0x8e, 0xc6, 0xbe,
-		0xde, 0xa0, 0xe0, 0xe9, 0xeb, 0x0d, 0x6d, 0x63, 0x53, 0x5d, 0x2f, 0xaf, 0xa9, 0x9c, 0x1d, 0x9d,
-		0x86, 0x58, 0x5b, 0x7f, 0xe3, 0x30, 0x3c, 0xbd, 0xd2, 0x24, 0xb4, 0x04, 0x85, 0xbe, 0xc5, 0x56,
-		0xdd, 0xa4, 0xab, 0x08, 0x55, 0x21, 0x48, 0x95, 0xf7, 0x73, 0xd7, 0x08, 0xfd, 0x94, 0xea, 0x71,
-		0x1a, 0x62, 0x77, 0xb0, 0x7e, 0x2b, 0x3c, 0x09, 0xd2, 0x24, 0x74, 0x0e, 0xb2, 0x2d, 0xbc, 0xd3,
-		0xdf, 0xd3, 0x7a, 0xb8, 0xa5, 0x1b, 0x6e, 0xd8, 0xf4, 0x67, 0x68, 0x96, 0x4a, 0x73, 0xd0, 0xcb,
-		0x90, 0x26, 0x7d, 0x64, 0xd1, 0x3e, 0x9e, 0xa5, 0x22, 0x78, 0xea, 0x68, 0x11, 0xf0, 0x2e, 0x16,
-		0x4c, 0xaa, 0xcf, 0x8f, 0xae, 0x43, 0xc2, 0xd5, 0x7b, 0x7b, 0xd8, 0xa5, 0x96, 0x3f, 0x3f, 0x22,
-		0x5c, 0x35, 0x02, 0xa9, 0x49, 0x39, 0x88, 0x58, 0xa9, 0x8e, 0x72, 0x04, 0xb4, 0x0a, 0x49, 0xf6,
-		0xcb, 0x29, 0xce, 0x2d, 0x46, 0x8f, 0x0f, 0xa6, 0x0a, 0xf6, 0xb7, 0xd1, 0x66, 0x5d, 0x80, 0x38,
-		0x55, 0x36, 0x04, 0xc0, 0xd5, 0x4d, 0x9e, 0x41, 0x29, 0x88, 0x55, 0x37, 0x55, 0x62, 0xb7, 0x64,
-		0xc8, 0xb2, 0x54, 0x6d, 0xab, 0x5e, 0xab, 0xd6, 0xe4, 0x88, 0x72, 0x09, 0x12, 0x4c, 0x83, 0x88,
-		0x4d, 0xf3, 0x74, 0x48, 0x9e, 0xe1, 0x9f, 0x1c, 0x43, 0x12, 0xb9, 0xdb, 0xeb, 0x95, 0x9a, 0x2a,
-		0x47, 0x94, 0x6d, 0x28, 0x0c, 0x48, 0x1d, 0x9d, 0x80, 0x59, 0xb5, 0xd6, 0xac, 0x6d, 0x90, 0x55,
-		0x9b, 0xb6, 0xbd, 0xf1, 0xf2, 0xc6, 0xe6, 0x7b, 0x36, 0xe4, 0x99, 0x70, 0xb2, 0x30, 0x90, 0x12,
-		0x9a, 0x07, 0xd9, 0x4f, 0x6e, 0x6c, 0x6e, 0xab, 0xb4, 0x36, 0xdf, 0x19, 0x01, 0x79, 0x50, 0x6c,
-		0xe8, 0x14, 0xcc, 0x35, 0xcb, 0xea, 0xb5, 0x5a, 0x53, 0x63, 0x2b, 0x51, 0x0f, 0x7a, 0x1e, 0xe4,
-		0x60, 0xc6, 0xd5, 0x3a, 0x5d, 0x68, 0x2f, 0xc0, 0x99, 0x60, 0x6a, 0xed, 0xd5, 0x66, 0x6d, 0xa3,
-		0x41, 0x0b, 0x2f, 0x6f, 0x5c, 0x23, 0xd6, 0x7a, 0x00, 0x4f, 0xac, 0x7d, 0xa3, 0xa4, 0xaa, 0x61,
-		0xbc, 0xda, 0xda, 0x8a, 0x1c, 0x1b, 0x4c, 0xde, 0xdc, 0xa8, 0x6d, 0x5e, 0x95, 0xe3, 0x83, 0xa5,
-		0xd3, 0xf5, 0x70, 0x02, 0x95, 0xe0, 0xe4, 0x60, 0xaa, 0x56, 0xdb, 0x68, 0xaa, 0x37, 0xe5, 0xe4,
-		0x60, 0xc1, 0x8d, 0x9a, 0x7a, 0xa3, 0x5e, 0xad, 0xc9, 0x29, 0x74, 0x12, 0x50, 0xb8, 0x46, 0xcd,
-		0xd5, 0xcd, 0x15, 0x39, 0x3d, 0x64, 0x9f, 0x14, 0x07, 0xb2, 0xc1, 0x45, 0xe9, 0xd7, 0xc4, 0x34,
-		0x2a, 0x1f, 0x8e, 0x40, 0x26, 0xb0, 0xc8, 0x24, 0xab, 0x03, 0xbd, 0xdd, 0xb6, 0xef, 0x68, 0x7a,
-		0xdb, 0xd4, 0x1d, 0x6e, 0xbd, 0x80, 0x26, 0x95, 0x49, 0xca, 0xb4, 0xd6, 0x62, 0xfa, 0xf9, 0x22,
-		0xf1, 0xf5, 0x38, 0x5f, 0xc4, 0xe5, 0x84, 0xf2, 0xc3, 0x12, 0xc8, 0x83, 0xab, 0xc7, 0x81, 0xe6,
-		0x4b, 0xe3, 0x9a, 0xff, 0x35, 0xe9, 0xbb, 0x8f, 0x4a, 0x90, 0x0f, 0x2f, 0x19, 0x07, 0xaa, 0xf7,
-		0xd0, 0x5f, 0x6a, 0xf5, 0x7e, 0x2f, 0x02, 0xb9, 0xd0, 0x42, 0x71, 0xda, 0xda, 0xbd, 0x0f, 0x66,
-		0xcd, 0x16, 0xee, 0x74, 0x6d, 0x17, 0x5b, 0xc6, 0xa1, 0xd6, 0xc6, 0xb7, 0x71, 0xbb, 0xa8, 0x50,
-		0x13, 0x7f, 0xe1, 0xe8, 0xa5, 0xe8, 0x52, 0xdd, 0xe7, 0x5b, 0x23, 0x6c, 0xcb, 0x73, 0xf5, 0x95,
-		0xda, 0xfa, 0xd6, 0x66, 0xb3, 0xb6, 0x51, 0xbd, 0x29, 0xac, 0x8b, 0x2a, 0x9b, 0x03, 0x64, 0x6f,
-		0xa3, 0xd1, 0xde, 0x02, 0x79, 0xb0, 0x52, 0xc4, 0x56, 0x8c, 0xa8, 0x96, 0x3c, 0x83, 0xe6, 0xa0,
-		0xb0, 0xb1, 0xa9, 0x35, 0xea, 0x2b, 0x35, 0xad, 0x76, 0xf5, 0x6a, 0xad, 0xda, 0x6c, 0xb0, 0xe0,
-		0xa2, 0x47, 0xdd, 0x94, 0x23, 0x41, 0x11, 0xff, 0x50, 0x14, 0xe6, 0x46, 0xd4, 0x04, 0x95, 0x79,
-		0x58, 0x80, 0x45, 0x2a, 0x9e, 0x9a, 0xa6, 0xf6, 0x4b, 0xc4, 0x31, 0xdf, 0xd2, 0x7b, 0x2e, 0x8f,
-		0x22, 0x3c, 0x0e, 0x44, 0x4a, 0x96, 0x4b, 0xfc, 0x84, 0x1e, 0x0f, 0xda, 0xb2, 0x58, 0x41, 0xc1,
-		0x4f, 0x67, 0x71, 0xdb, 0x27, 0x01, 0x75, 0x6d, 0xc7, 0x74, 0xcd, 0xdb, 0x58, 0x33, 0x2d, 0x11,
-		0xe1, 0x8d, 0x2d, 0x4a, 0xe7, 0x62, 0xaa, 0x2c, 0x72, 0xea, 0x96, 0xeb, 0x51, 0x5b, 0x78, 0x4f,
-		0x1f, 0xa0, 0x26, 0x7e, 0x4c, 0x54, 0x95, 0x45, 0x8e, 0x47, 0xfd, 0x10, 0x64, 0x5b, 0x76, 0x9f,
-		0x2c, 0xa8, 0x18, 0x1d, 0xb1, 0x16, 0x92, 0x9a, 0x61, 0x69, 0x1e, 0x09, 0x5f, 0x2a, 0xfb, 0xa1,
-		0xe5, 0xac, 0x9a, 0x61, 0x69, 0x8c, 0xe4, 0x31, 0x28, 0xe8, 0x7b, 0x7b, 0x3d, 0x02, 0x2e, 0x80,
-		0xd8, 0xe2, 0x3f, 0xef, 0x25, 0x53, 0xc2, 0xd2, 0x75, 0x48, 0x09, 0x39, 0x10, 0x7f, 0x98, 0x48,
-		0x42, 0xeb, 0xb2, 0x88, 0x56, 0xe4, 0x5c, 0x5a, 0x4d, 0x59, 0x22, 0xf3, 0x21, 0xc8, 0x9a, 0x8e,
-		0xe6, 0xef, 0x6d, 0x46, 0x16, 0x23, 0xe7, 0x52, 0x6a, 0xc6, 0x74, 0xbc, 0x3d, 0x12, 0xe5, 0x13,
-		0x11, 0xc8, 0x87, 0x77, 0x6d, 0xd1, 0x0a, 0xa4, 0xda, 0x36, 0xdf, 0x64, 0x61, 0x47, 0x06, 0xce,
-		0x4d, 0xd8, 0xe8, 0x5d, 0x5a, 0xe3, 0xf4, 0xaa, 0xc7, 0x59, 0xfa, 0x37, 0x12, 0xa4, 0x44, 0x32,
-		0x3a, 0x09, 0xb1, 0xae, 0xee, 0xee, 0x53, 0xb8, 0x78, 0x25, 0x22, 0x4b, 0x2a, 0xfd, 0x26, 0xe9,
-		0x4e, 0x57, 0x67, 0xfb, 0x44, 0x3c, 0x9d, 0x7c, 0x93, 0x7e, 0x6d, 0x63, 0xbd, 0x45, 0x23, 0x0b,
-		0x76, 0xa7, 0x83, 0x2d, 0xd7, 0x11, 0xfd, 0xca, 0xd3, 0xab, 0x3c, 0x19, 0x3d, 0x01, 0xb3, 0x6e,
-		0x4f, 0x37, 0xdb, 0x21, 0xda, 0x18, 0xa5, 0x95, 0x45, 0x86, 0x47, 0xbc, 0x0c, 0xa7, 0x05, 0x6e,
-		0x0b, 0xbb, 0xba, 0xb1, 0x8f, 0x5b, 0x3e, 0x53, 0x82, 0x46, 0x10, 0x4f, 0x71, 0x82, 0x15, 0x9e,
-		0x2f, 0x78, 0x95, 0xcf, 0x44, 0x60, 0x56, 0xc4, 0x42, 0x5a, 0x9e, 0xb0, 0xd6, 0x01, 0x74, 0xcb,
-		0xb2, 0xdd, 0xa0, 0xb8, 0x86, 0x55, 0x79, 0x88, 0x6f, 0xa9, 0xec, 0x31, 0xa9, 0x01, 0x80, 0xd2,
-		0x1f, 0x4a, 0x00, 0x7e, 0xd6, 0x58, 0xb9, 0x2d, 0x40, 0x86, 0xef, 0xc9, 0xd3, 0x83, 0x1d, 0x2c,
-		0x7c, 0x06, 0x2c, 0xe9, 0xaa, 0xd9, 0xa6, 0x41, 0xce, 0x1d, 0xbc, 0x67, 0x5a, 0x7c, 0x77, 0x86,
-		0x7d, 0x88, 0x20, 0x67, 0xcc, 0xdf, 0x9e, 0x54, 0x21, 0xe5, 0xe0, 0x8e, 0x6e, 0xb9, 0xa6, 0xc1,
-		0xf7, 0x5b, 0x2e, 0x1f, 0xab, 0xf2, 0x4b, 0x0d, 0xce, 0xad, 0x7a, 0x38, 0xca, 0x39, 0x48, 0x89,
-		0x54, 0xe2, 0xf8, 0x6d, 0x6c, 0x6e, 0xd4, 0xe4, 0x19, 0x94, 0x84, 0x68, 0xa3, 0xd6, 0x94, 0x25,
-		0xb2, 0x88, 0x2d, 0xaf, 0xd5, 0xcb, 0x0d, 0x39, 0x52, 0xf9, 0xff, 0x61, 0xce, 0xb0, 0x3b, 0x83,
-		0x05, 0x56, 0xe4, 0x81, 0x00, 0xa2, 0xb3, 0x2a, 0xbd, 0xf6, 0x14, 0x27, 0xda, 0xb3, 0xdb, 0xba,
-		0xb5, 0xb7, 0x64, 0xf7, 0xf6, 0xfc, 0x63, 0x31, 0x64, 0xad, 0xe1, 0x04, 0x0e, 0xc7, 0x74, 0x77,
-		0xfe, 0x42, 0x92, 0x7e, 0x34, 0x12, 0xbd, 0xb6, 0x55, 0xf9, 0xa9, 0x48, 0xe9, 0x1a, 0x63, 0xdc,
-		0x12, 0xcd, 0x51, 0xf1, 0x6e, 0x1b, 0x1b, 0xa4, 0xf2, 0xf0, 0x47, 0x4f, 0xc0, 0xfc, 0x9e, 0xbd,
-		0x67, 0x53, 0xa4, 0x0b, 0xe4, 0x17, 0x3f, 0x57, 0x93, 0xf6, 0x52, 0x4b, 0x13, 0x0f, 0xe1, 0x2c,
-		0x6f, 0xc0, 0x1c, 0x27, 0xd6, 0xe8, 0xf6, 0x3d, 0x0b, 0x55, 0xa0, 0x23, 0xe3, 0xe4, 0xc5, 0x9f,
-		0xfd, 0x03, 0xea, 0x95, 0xa8, 0xb3, 0x9c, 0x95, 0xe4, 0xb1, 0x68, 0xc6, 0xb2, 0x0a, 0x27, 0x42,
-		0x78, 0xcc, 0x46, 0xe0, 0xde, 0x04, 0xc4, 0x7f, 0xce, 0x11, 0xe7, 0x02, 0x88, 0x0d, 0xce, 0xba,
-		0x5c, 0x85, 0xdc, 0x71, 0xb0, 0xfe, 0x05, 0xc7, 0xca, 0xe2, 0x20, 0xc8, 0x35, 0x28, 0x50, 0x10,
-		0xa3, 0xef, 0xb8, 0x76, 0x87, 0x1a, 0xe0, 0xa3, 0x61, 0xfe, 0xe5, 0x1f, 0xb0, 0x41, 0x9b, 0x27,
-		0x6c, 0x55, 0x8f, 0x6b, 0x79, 0x19, 0xe8, 0x89, 0x85, 0x16, 0x36, 0xda, 0x13, 0x10, 0x7e, 0x83,
-		0x57, 0xc4, 0xa3, 0x5f, 0xbe, 0x01, 0xf3, 0xe4, 0x37, 0xb5, 0x8f, 0xc1, 0x9a, 0x4c, 0x0e, 0xaa,
-		0x17, 0xff, 0xed, 0x07, 0x98, 0x5d, 0x98, 0xf3, 0x00, 0x02, 0x75, 0x0a, 0xf4, 0xe2, 0x1e, 0x76,
-		0x5d, 0xdc, 0x73, 0x34, 0xbd, 0x3d, 0xaa, 0x7a, 0x81, 0xa8, 0x64, 0xf1, 0x07, 0xbf, 0x14, 0xee,
-		0xc5, 0x6b, 0x8c, 0xb3, 0xdc, 0x6e, 0x2f, 0x6f, 0xc3, 0xa9, 0x11, 0x5a, 0x31, 0x05, 0xe6, 0x0f,
-		0x71, 0xcc, 0xf9, 0x21, 0xcd, 0x20, 0xb0, 0x5b, 0x20, 0xd2, 0xbd, 0xbe, 0x9c, 0x02, 0xf3, 0x23,
-		0x1c, 0x13, 0x71, 0x5e, 0xd1, 0xa5, 0x04, 0xf1, 0x3a, 0xcc, 0xde, 0xc6, 0xbd, 0x1d, 0xdb, 0xe1,
-		0x91, 0xe0, 0x29, 0xe0, 0x3e, 0xca, 0xe1, 0x0a, 0x9c, 0x91, 0x86, 0x86, 0x09, 0xd6, 0x8b, 0x90,
-		0xda, 0xd5, 0x0d, 0x3c, 0x05, 0xc4, 0x3d, 0x0e, 0x91, 0x24, 0xf4, 0x84, 0xb5, 0x0c, 0xd9, 0x3d,
-		0x9b, 0x4f, 0x91, 0x93, 0xd9, 0x7f, 0x98, 0xb3, 0x67, 0x04, 0x0f, 0x87, 0xe8, 0xda, 0xdd, 0x7e,
-		0x9b, 0xcc, 0x9f, 0x93, 0x21, 0x7e, 0x44, 0x40, 0x08, 0x1e, 0x0e, 0x71, 0x0c, 0xb1, 0x7e, 0x4c,
-		0x40, 0x38, 0x01, 0x79, 0xbe, 0x04, 0x19, 0xdb, 0x6a, 0x1f, 0xda, 0xd6, 0x34, 0x95, 0xf8, 0x38,
-		0x47, 0x00, 0xce, 0x42, 0x00, 0xae, 0x40, 0x7a, 0xda, 0x8e, 0xf8, 0xf1, 0x2f, 0x89, 0xe1, 0x21,
-		0x7a, 0xe0, 0x1a, 0x14, 0x84, 0x81, 0x32, 0x6d, 0x6b, 0x0a, 0x88, 0x9f, 0xe0, 0x10, 0xf9, 0x00,
-		0x1b, 0x6f, 0x86, 0x8b, 0x1d, 0x77, 0x0f, 0x4f, 0x03, 0xf2, 0x09, 0xd1, 0x0c, 0xce, 0xc2, 0x45,
-		0xb9, 0x83, 0x2d, 0x63, 0x7f, 0x3a, 0x84, 0x4f, 0x0a, 0x51, 0x0a, 0x1e, 0x02, 0x51, 0x85, 0x5c,
-		0x47, 0xef, 0x39, 0xfb, 0x7a, 0x7b, 0xaa, 0xee, 0xf8, 0x49, 0x8e, 0x91, 0xf5, 0x98, 0xb8, 0x44,
-		0xfa, 0xd6, 0x71, 0x60, 0x7e, 0x4a, 0x48, 0x24, 0xc0, 0xc6, 0x87, 0x9e, 0xe3, 0xd2, 0xb0, 0xf9,
-		0x71, 0xd0, 0xfe, 0x9e, 0x18, 0x7a, 0x8c, 0x77, 0x3d, 0x88, 0x78, 0x05, 0xd2, 0x8e, 0xf9, 0xc6,
-		0x54, 0x30, 0x7f, 0x5f, 0xf4, 0x34, 0x65, 0x20, 0xcc, 0x37, 0xe1, 0xf4, 0xc8, 0x69, 0x62, 0x0a,
-		0xb0, 0x9f, 0xe6, 0x60, 0x27, 0x47, 0x4c, 0x15, 0xdc, 0x24, 0x1c, 0x17, 0xf2, 0x1f, 0x08, 0x93,
-		0x80, 0x07, 0xb0, 0xb6, 0xc8, 0xa2, 0xc5, 0xd1, 0x77, 0x8f, 0x27, 0xb5, 0x7f, 0x28, 0xa4, 0xc6,
-		0x78, 0x43, 0x52, 0x6b, 0xc2, 0x49, 0x8e, 0x78, 0xbc, 0x7e, 0xfd, 0x19, 0x61, 0x58, 0x19, 0xf7,
-		0x76, 0xb8, 0x77, 0xbf, 0x01, 0x4a, 0x9e, 0x38, 0x85, 0x77, 0xec, 0x68, 0x1d, 0xbd, 0x3b, 0x05,
-		0xf2, 0xcf, 0x72, 0x64, 0x61, 0xf1, 0x3d, 0xf7, 0xda, 0x59, 0xd7, 0xbb, 0x04, 0xfc, 0x55, 0x28,
-		0x0a, 0xf0, 0xbe, 0xd5, 0xc3, 0x86, 0xbd, 0x67, 0x99, 0x6f, 0xe0, 0xd6, 0x14, 0xd0, 0x3f, 0x37,
-		0xd0, 0x55, 0xdb, 0x01, 0x76, 0x82, 0x5c, 0x07, 0xd9, 0xf3, 0x55, 0x34, 0xb3, 0xd3, 0xb5, 0x7b,
-		0xee, 0x04, 0xc4, 0x4f, 0x89, 0x9e, 0xf2, 0xf8, 0xea, 0x94, 0x6d, 0xb9, 0x06, 0xec, 0x2c, 0xc9,
-		0xb4, 0x2a, 0xf9, 0x69, 0x0e, 0x94, 0xf3, 0xb9, 0xb8, 0xe1, 0x30, 0xec, 0x4e, 0x57, 0xef, 0x4d,
-		0x63, 0xff, 0xfe, 0x91, 0x30, 0x1c, 0x9c, 0x85, 0x1b, 0x0e, 0xe2, 0xd1, 0x91, 0xd9, 0x7e, 0x0a,
-		0x84, 0x9f, 0x17, 0x86, 0x43, 0xf0, 0x70, 0x08, 0xe1, 0x30, 0x4c, 0x01, 0xf1, 0x0b, 0x02, 0x42,
-		0xf0, 0x10, 0x88, 0x57, 0xfc, 0x89, 0xb6, 0x87, 0xf7, 0x4c, 0xc7, 0xe5, 0x87, 0xc1, 0x8e, 0x86,
-		0xfa, 0xc5, 0x2f, 0x85, 0x9d, 0x30, 0x35, 0xc0, 0x4a, 0x2c, 0x11, 0xdf, 0x48, 0xa1, 0x4b, 0xb6,
-		0xc9, 0x15, 0xfb, 0x25, 0x61, 0x89, 0x02, 0x6c, 0xa4, 0x6e, 0x01, 0x0f, 0x91, 0x88, 0xdd, 0x20,
-		0x0b, 0x95, 0x29, 0xe0, 0xfe, 0xf1, 0x40, 0xe5, 0x1a, 0x82, 0x97, 0x60, 0x06, 0xfc, 0x9f, 0xbe,
-		0x75, 0x0b, 0x1f, 0x4e, 0xa5, 0x9d, 0xbf, 0x3c, 0xe0, 0xff, 0x6c, 0x33, 0x4e, 0x66, 0x43, 0x0a,
-		0x03, 0xfe, 0x14, 0x9a, 0x74, 0xd6, 0xb3, 0xf8, 0x2d, 0x5f, 0xe6, 0xed, 0x0d, 0xbb, 0x53, 0xcb,
-		0x6b, 0x44, 0xc9, 0xc3, 0x4e, 0xcf, 0x64, 0xb0, 0x0f, 0x7c, 0xd9, 0xd3, 0xf3, 0x90, 0xcf, 0xb3,
-		0x7c, 0x15, 0x72, 0x21, 0x87, 0x67, 0x32, 0xd4, 0xb7, 0x72, 0xa8, 0x6c, 0xd0, 0xdf, 0x59, 0xbe,
-		0x04, 0x31, 0xe2, 0xbc, 0x4c, 0x66, 0xff, 0x36, 0xce, 0x4e, 0xc9, 0x97, 0xdf, 0x05, 0x29, 0xe1,
-		0xb4, 0x4c, 0x66, 0xfd, 0x9b, 0x9c, 0xd5, 0x63, 0x21, 0xec, 0xc2, 0x61, 0x99, 0xcc, 0xfe, 0xb7,
-		0x04, 0xbb, 0x60, 0x21, 0xec, 0xd3, 0x8b, 0xf0, 0x57, 0xbf, 0x23, 0xc6, 0x27, 0x1d, 0x21, 0xbb,
-		0x2b, 0x90, 0xe4, 0x9e, 0xca, 0x64, 0xee, 0x6f, 0xe7, 0x85, 0x0b, 0x8e, 0xe5, 0xe7, 0x21, 0x3e,
-		0xa5, 0xc0, 0xbf, 0x8b, 0xb3, 0x32, 0xfa, 0xe5, 0x2a, 0x64, 0x02, 0xde, 0xc9, 0x64, 0xf6, 0xef,
-		0xe6, 0xec, 0x41, 0x2e, 0x52, 0x75, 0xee, 0x9d, 0x4c, 0x06, 0xf8, 0xdb, 0xa2, 0xea, 0x9c, 0x83,
-		0x88, 0x4d, 0x38, 0x26, 0x93, 0xb9, 0x3f, 0x24, 0xa4, 0x2e, 0x58, 0x96, 0x5f, 0x82, 0xb4, 0x37,
-		0xd9, 0x4c, 0xe6, 0xff, 0x1e, 0xce, 0xef, 0xf3, 0x10, 0x09, 0x04, 0x26, 0xbb, 0xc9, 0x10, 0x7f,
-		0x47, 0x48, 0x20, 0xc0, 0x45, 0x86, 0xd1, 0xa0, 0x03, 0x33, 0x19, 0xe9, 0x7b, 0xc5, 0x30, 0x1a,
-		0xf0, 0x5f, 0x48, 0x6f, 0x52, 0x9b, 0x3f, 0x19, 0xe2, 0xfb, 0x44, 0x6f, 0x52, 0x7a, 0x52, 0x8d,
-		0x41, 0x8f, 0x60, 0x32, 0xc6, 0x0f, 0x88, 0x6a, 0x0c, 0x38, 0x04, 0xcb, 0x5b, 0x80, 0x86, 0xbd,
-		0x81, 0xc9, 0x78, 0x1f, 0xe6, 0x78, 0xb3, 0x43, 0xce, 0xc0, 0xf2, 0x7b, 0xe0, 0xe4, 0x68, 0x4f,
-		0x60, 0x32, 0xea, 0x0f, 0x7e, 0x79, 0x60, 0xed, 0x16, 0x74, 0x04, 0x96, 0x9b, 0xfe, 0x94, 0x12,
-		0xf4, 0x02, 0x26, 0xc3, 0xfe, 0xd0, 0x97, 0xc3, 0x86, 0x3b, 0xe8, 0x04, 0x2c, 0x97, 0x01, 0xfc,
-		0x09, 0x78, 0x32, 0xd6, 0x47, 0x39, 0x56, 0x80, 0x89, 0x0c, 0x0d, 0x3e, 0xff, 0x4e, 0xe6, 0xbf,
-		0x27, 0x86, 0x06, 0xe7, 0x20, 0x43, 0x43, 0x4c, 0xbd, 0x93, 0xb9, 0x7f, 0x58, 0x0c, 0x0d, 0xc1,
-		0x42, 0x34, 0x3b, 0x30, 0xbb, 0x4d, 0x46, 0xf8, 0xb8, 0xd0, 0xec, 0x00, 0xd7, 0xf2, 0x06, 0xcc,
-		0x0e, 0x4d, 0x88, 0x93, 0xa1, 0x7e, 0x94, 0x43, 0xc9, 0x83, 0xf3, 0x61, 0x70, 0xf2, 0xe2, 0x93,
-		0xe1, 0x64, 0xb4, 0x1f, 0x1b, 0x98, 0xbc, 0xf8, 0x5c, 0xb8, 0x7c, 0x05, 0x52, 0x56, 0xbf, 0xdd,
-		0x26, 0x83, 0x07, 0x1d, 0x7d, 0xda, 0xb7, 0xf8, 0x5f, 0xbe, 0xc2, 0xa5, 0x23, 0x18, 0x96, 0x2f,
-		0x41, 0x1c, 0x77, 0x76, 0x70, 0x6b, 0x12, 0xe7, 0x17, 0xbf, 0x22, 0x0c, 0x26, 0xa1, 0x5e, 0x7e,
-		0x09, 0x80, 0x85, 0x46, 0xe8, 0x36, 0xfc, 0x04, 0xde, 0x3f, 0xfc, 0x0a, 0x3f, 0x5e, 0xe7, 0xb3,
-		0xf8, 0x00, 0xec, 0xb0, 0xde, 0xd1, 0x00, 0x5f, 0x0a, 0x03, 0xd0, 0x1e, 0x79, 0x11, 0x92, 0xaf,
-		0x3b, 0xb6, 0xe5, 0xea, 0x7b, 0x93, 0xb8, 0xff, 0x88, 0x73, 0x0b, 0x7a, 0x22, 0xb0, 0x8e, 0xdd,
-		0xc3, 0xae, 0xbe, 0xe7, 0x4c, 0xe2, 0xfd, 0xaf, 0x9c, 0xd7, 0x63, 0x20, 0xcc, 0x86, 0xee, 0xb8,
-		0xd3, 0xb4, 0xfb, 0xbf, 0x09, 0x66, 0xc1, 0x40, 0x2a, 0x4d, 0x7e, 0xdf, 0xc2, 0x87, 0x93, 0x78,
-		0xff, 0x58, 0x54, 0x9a, 0xd3, 0x2f, 0xbf, 0x0b, 0xd2, 0xe4, 0x27, 0x3b, 0x33, 0x3b, 0x81, 0xf9,
-		0x4f, 0x38, 0xb3, 0xcf, 0x41, 0x4a, 0x76, 0xdc, 0x96, 0x6b, 0x4e, 0x16, 0xf6, 0x9f, 0xf2, 0x9e,
-		0x16, 0xf4, 0xcb, 0x65, 0xc8, 0x38, 0x6e, 0xab, 0xd5, 0xe7, 0xfe, 0xe9, 0x04, 0xf6, 0x3f, 0xfb,
-		0x8a, 0x17, 0xb2, 0xf0, 0x78, 0x48, 0x6f, 0xdf, 0xb9, 0xe5, 0x76, 0x6d, 0xba, 0xdf, 0x32, 0x09,
-		0xe1, 0xcb, 0x1c, 0x21, 0xc0, 0xb2, 0x5c, 0x85, 0x2c, 0x69, 0x8b, 0xb8, 0x8b, 0x30, 0x09, 0xe2,
-		0xcf, 0xb9, 0x00, 0x42, 0x4c, 0x95, 0x6f, 0xfe, 0x8d, 0xcf, 0x9e, 0x95, 0x3e, 0xf3, 0xd9, 0xb3,
-		0xd2, 0xef, 0x7d, 0xf6, 0xac, 0xf4, 0xa1, 0xcf, 0x9d, 0x9d, 0xf9, 0xcc, 0xe7, 0xce, 0xce, 0xfc,
-		0xce, 0xe7, 0xce, 0xce, 0x8c, 0x8e, 0x12, 0xc3, 0x35, 0xfb, 0x9a, 0xcd, 0xe2, 0xc3, 0xaf, 0x3d,
-		0xb2, 0x67, 0xba, 0xfb, 0xfd, 0x9d, 0x25, 0xc3, 0xee, 0x5c, 0x30, 0x6c, 0xa7, 0x63, 0x3b, 0x17,
-		0xc2, 0x71, 0x5d, 0xfa, 0x0b, 0xfe, 0x97, 0x44, 0xd6, 0xcc, 0xe1, 0x70, 0xae, 0x6e, 0x1d, 0x8e,
-		0xbb, 0x4c, 0x79, 0x19, 0xa2, 0x65, 0xeb, 0x10, 0x9d, 0x66, 0x06, 0x4e, 0xeb, 0xf7, 0xda, 0xfc,
-		0xe0, 0x66, 0x92, 0x7c, 0x6f, 0xf7, 0xda, 0x68, 0xde, 0x3f, 0x5d, 0x2d, 0x9d, 0xcb, 0xf2, 0x23,
-		0xd3, 0x95, 0xef, 0x96, 0x8e, 0xd7, 0x92, 0x54, 0xd9, 0x3a, 0xa4, 0x0d, 0xd9, 0x92, 0x5e, 0x7b,
-		0x72, 0x62, 0x9c, 0xfb, 0x96, 0x65, 0xdf, 0xb1, 0x48, 0xb5, 0xbb, 0x3b, 0x22, 0xc6, 0x7d, 0x76,
-		0x30, 0xc6, 0xfd, 0x1e, 0xdc, 0x6e, 0xbf, 0x4c, 0xe8, 0x9a, 0x84, 0x65, 0x27, 0xc1, 0xee, 0x08,
-		0xc0, 0xf7, 0x46, 0xe0, 0xec, 0x50, 0x38, 0x9b, 0x2b, 0xc1, 0x38, 0x21, 0x2c, 0x43, 0x6a, 0x45,
-		0xe8, 0x56, 0x11, 0x92, 0x0e, 0x36, 0x6c, 0xab, 0xe5, 0x50, 0x41, 0x44, 0x55, 0xf1, 0x49, 0x04,
-		0x61, 0xe9, 0x96, 0xed, 0xf0, 0xa3, 0xcf, 0xec, 0xa3, 0xf2, 0x91, 0x63, 0x0a, 0x22, 0x27, 0x4a,
-		0x12, 0xd2, 0x78, 0x66, 0x4a, 0x69, 0x88, 0x46, 0x84, 0x22, 0xff, 0xd3, 0x4a, 0xe5, 0x07, 0x22,
-		0xb0, 0x30, 0x28, 0x15, 0x32, 0xb2, 0x1c, 0x57, 0xef, 0x74, 0xc7, 0x89, 0xe5, 0x0a, 0xa4, 0x9b,
-		0x82, 0xe6, 0xd8, 0x72, 0xb9, 0x77, 0x4c, 0xb9, 0xe4, 0xbd, 0xa2, 0x84, 0x60, 0x2e, 0x4e, 0x29,
-		0x18, 0xaf, 0x1d, 0xf7, 0x25, 0x99, 0xff, 0x99, 0x80, 0xd3, 0x6c, 0x38, 0x69, 0x6c, 0x28, 0xb1,
-		0x0f, 0x2e, 0x93, 0x6c, 0x30, 0x6b, 0xf2, 0x3e, 0x89, 0xf2, 0x32, 0xcc, 0xd5, 0x89, 0xb5, 0x20,
-		0xab, 0x20, 0x7f, 0x87, 0x67, 0xe4, 0xe9, 0xf0, 0xc5, 0x90, 0xc3, 0xcf, 0xf7, 0xb7, 0x82, 0x49,
-		0xca, 0xb7, 0x48, 0x20, 0x37, 0x0c, 0xbd, 0xad, 0xf7, 0xbe, 0x5a, 0x28, 0xf4, 0x3c, 0x00, 0x3b,
-		0xee, 0xe1, 0x5d, 0xdc, 0xcc, 0x5f, 0x2c, 0x2e, 0x05, 0x1b, 0xb7, 0xc4, 0x4a, 0xa2, 0x47, 0xa8,
-		0xd2, 0x94, 0x96, 0xfc, 0x3c, 0xff, 0x2a, 0x80, 0x9f, 0x81, 0xce, 0xc0, 0xa9, 0x46, 0xb5, 0xbc,
-		0x56, 0x56, 0xc5, 0x21, 0xa1, 0xc6, 0x56, 0xad, 0xca, 0xae, 0x59, 0xcd, 0xa0, 0x93, 0x80, 0x82,
-		0x99, 0xde, 0xa1, 0xa6, 0x13, 0x30, 0x1b, 0x4c, 0x67, 0x77, 0x5e, 0x22, 0xc4, 0x53, 0x34, 0x3b,
-		0xdd, 0x36, 0xa6, 0x3b, 0x8f, 0x9a, 0x29, 0xa4, 0x36, 0xd9, 0x09, 0xf9, 0xcd, 0x7f, 0xc7, 0xee,
-		0x41, 0xcc, 0xf9, 0xec, 0x9e, 0xcc, 0x97, 0xd7, 0x60, 0x56, 0x37, 0x0c, 0xdc, 0x0d, 0x41, 0x4e,
-		0x30, 0xd5, 0x04, 0x90, 0xee, 0xa5, 0x72, 0x4e, 0x1f, 0xed, 0x79, 0x48, 0x38, 0xb4, 0xf5, 0x93,
-		0x20, 0x7e, 0x8b, 0x43, 0x70, 0xf2, 0x65, 0x0b, 0x66, 0xd9, 0xbd, 0x3e, 0x1c, 0xa8, 0xc6, 0xd1,
-		0x71, 0x86, 0x7f, 0xf2, 0xa9, 0xa7, 0xe9, 0xce, 0xea, 0x43, 0xe1, 0x6e, 0x19, 0xa1, 0x4e, 0xaa,
-		0xcc, 0xb1, 0xfd, 0x8a, 0x62, 0xc8, 0x8b, 0xf2, 0x78, 0x85, 0x8f, 0x2e, 0xec, 0x57, 0x78, 0x61,
-		0x67, 0x47, 0xe9, 0x40, 0xa0, 0xa4, 0x1c, 0x47, 0x65, 0x19, 0x95, 0xda, 0xb8, 0x31, 0xfd, 0xda,
-		0x13, 0xc3, 0xb3, 0x13, 0xfb, 0xef, 0x29, 0x8a, 0x7c, 0x25, 0x58, 0x8c, 0x37, 0xf6, 0x3e, 0x12,
-		0x83, 0x59, 0xbd, 0x63, 0x5a, 0xf6, 0x05, 0xfa, 0x2f, 0x1f, 0x73, 0x71, 0xfa, 0x31, 0xc5, 0xa6,
-		0xe4, 0x65, 0x36, 0x14, 0x26, 0x6b, 0xcc, 0x9f, 0x7c, 0xe7, 0x4f, 0xc4, 0xfd, 0xe1, 0xb2, 0xbc,
-		0x0e, 0xb2, 0x38, 0x12, 0x8c, 0x2d, 0xc3, 0x6e, 0x4d, 0x15, 0xa5, 0xf8, 0x53, 0x81, 0x21, 0xe2,
-		0x5b, 0x35, 0xce, 0xba, 0xfc, 0x4e, 0x48, 0x79, 0x30, 0x93, 0x3c, 0x13, 0x01, 0xe2, 0x71, 0x10,
-		0xbf, 0x84, 0x8d, 0xcc, 0x69, 0xbc, 0xd0, 0x2f, 0x0b, 0x7e, 0x36, 0x42, 0x37, 0x48, 0x6b, 0xae,
-		0x41, 0xbe, 0x65, 0x5b, 0xae, 0x66, 0x77, 0x4c, 0x17, 0x77, 0xba, 0xee, 0x44, 0xbf, 0xee, 0xcf,
-		0x19, 0x48, 0x4a, 0xcd, 0x11, 0xbe, 0x4d, 0xc1, 0x46, 0x6a, 0xc2, 0x6e, 0xfd, 0x4d, 0x53, 0x93,
-		0xff, 0xee, 0xd5, 0x84, 0xf2, 0x90, 0x9a, 0xdc, 0x97, 0x76, 0x38, 0xad, 0x5b, 0xdc, 0x98, 0xbb,
-		0x07, 0x4c, 0x0b, 0x3c, 0xed, 0xf8, 0x44, 0x14, 0xce, 0x72, 0xe2, 0x1d, 0xdd, 0xc1, 0x17, 0x6e,
-		0x3f, 0xb3, 0x83, 0x5d, 0xfd, 0x99, 0x0b, 0x86, 0x6d, 0x8a, 0x99, 0x7c, 0x8e, 0x1b, 0x6b, 0x92,
-		0xbf, 0xc4, 0xf3, 0x4b, 0x23, 0xb7, 0xbb, 0x4b, 0xe3, 0x8d, 0x7c, 0x69, 0x58, 0x07, 0x95, 0x36,
-		0xc4, 0xaa, 0xb6, 0x69, 0x91, 0xb9, 0xad, 0x85, 0x2d, 0xbb, 0xc3, 0xcd, 0x2d, 0xfb, 0x40, 0xab,
-		0x90, 0xd0, 0x3b, 0x76, 0xdf, 0x72, 0x99, 0xa9, 0xad, 0x3c, 0xfd, 0x1b, 0x6f, 0x2e, 0xcc, 0xfc,
-		0xc7, 0x37, 0x17, 0x4e, 0x30, 0x58, 0xa7, 0x75, 0x6b, 0xc9, 0xb4, 0x2f, 0x74, 0x74, 0x77, 0x9f,
-		0x0c, 0xdf, 0xdf, 0xfe, 0xf4, 0x53, 0xc0, 0xcb, 0xab, 0x5b, 0xee, 0x27, 0x3f, 0xff, 0x33, 0xe7,
-		0x25, 0x95, 0xf3, 0x2f, 0xc7, 0xbe, 0xf0, 0xb1, 0x05, 0x49, 0xe9, 0x42, 0x72, 0x05, 0x1b, 0x47,
-		0x14, 0x58, 0x1f, 0x28, 0xf0, 0x19, 0x5e, 0xe0, 0x99, 0xe1, 0x02, 0xd9, 0x81, 0xbd, 0x15, 0x6c,
-		0x04, 0x8a, 0x5d, 0xc1, 0x46, 0xb8, 0xc4, 0xca, 0xca, 0xef, 0xfc, 0xfe, 0xd9, 0x99, 0xf7, 0x7f,
-		0xf6, 0xec, 0xcc, 0xd8, 0x2e, 0x53, 0x26, 0x77, 0x99, 0xd7, 0x53, 0x3f, 0x11, 0x83, 0x07, 0xe9,
-		0xfd, 0xd2, 0x5e, 0xc7, 0xb4, 0xdc, 0x0b, 0x46, 0xef, 0xb0, 0xeb, 0xda, 0x64, 0xe0, 0xda, 0xbb,
-		0xbc, 0xa3, 0x66, 0xfd, 0xec, 0x25, 0x96, 0x3d, 0xba, 0x9b, 0x94, 0x5d, 0x88, 0x6f, 0x11, 0x3e,
-		0x22, 0x08, 0xd7, 0x76, 0xf5, 0x36, 0xf7, 0x36, 0xd8, 0x07, 0x49, 0x65, 0x77, 0x52, 0x23, 0x2c,
-		0xd5, 0x14, 0xd7, 0x51, 0xdb, 0x58, 0xdf, 0x65, 0x57, 0x7b, 0xa2, 0xd4, 0x4d, 0x4d, 0x91, 0x04,
-		0x7a, 0x8b, 0x67, 0x1e, 0xe2, 0x7a, 0x9f, 0x1d, 0x98, 0x89, 0x12, 0xff, 0x95, 0x7e, 0x28, 0x2f,
-		0x43, 0x92, 0xef, 0x9b, 0x23, 0x19, 0xa2, 0xb7, 0xf0, 0x21, 0x2d, 0x27, 0xab, 0x92, 0x9f, 0x68,
-		0x09, 0xe2, 0xb4, 0xf2, 0xfc, 0xce, 0x62, 0x71, 0x69, 0xa8, 0xf6, 0x4b, 0xb4, 0x92, 0x2a, 0x23,
-		0x53, 0xae, 0x43, 0x6a, 0xc5, 0x26, 0xda, 0x13, 0x46, 0x4b, 0x33, 0x34, 0x5a, 0xe7, 0x6e, 0x9f,
-		0xf7, 0x9d, 0xca, 0x3e, 0xd0, 0x49, 0x48, 0xb0, 0xab, 0x5e, 0xfc, 0xd0, 0x0f, 0xff, 0x52, 0xaa,
-		0x90, 0xa4, 0xd8, 0x9b, 0x5d, 0xef, 0x7a, 0xb5, 0x14, 0xb8, 0x5e, 0xcd, 0xe1, 0x23, 0x7e, 0x65,
-		0x11, 0xc4, 0x5a, 0xba, 0xab, 0xf3, 0x76, 0xd3, 0xdf, 0xca, 0xbb, 0x21, 0xc5, 0x41, 0x1c, 0x74,
-		0x11, 0xa2, 0x76, 0xd7, 0xe1, 0xc7, 0x76, 0x4a, 0xe3, 0x9a, 0xb2, 0xd9, 0xad, 0xc4, 0x88, 0x52,
-		0xa9, 0x84, 0xb8, 0xb2, 0x31, 0x56, 0x2d, 0x9e, 0x0b, 0xa9, 0x45, 0x07, 0xbb, 0x3b, 0xbb, 0xae,
-		0xff, 0x83, 0x75, 0xe7, 0x90, 0x2a, 0x78, 0x8a, 0x72, 0x2f, 0x02, 0x67, 0x03, 0xb9, 0xb7, 0x71,
-		0xcf, 0x31, 0x6d, 0x8b, 0x69, 0x13, 0xd7, 0x14, 0x14, 0xa8, 0x20, 0xcf, 0x1f, 0xa3, 0x2a, 0xef,
-		0x82, 0x68, 0xb9, 0xdb, 0x45, 0x25, 0x48, 0xd1, 0x6f, 0xc3, 0x66, 0xba, 0x12, 0x53, 0xbd, 0x6f,
-		0x92, 0xe7, 0xd8, 0xbb, 0xee, 0x1d, 0xbd, 0xe7, 0xdd, 0x84, 0x16, 0xdf, 0xca, 0x8b, 0x90, 0xae,
-		0xda, 0x96, 0x83, 0x2d, 0xa7, 0x4f, 0x7d, 0xd8, 0x9d, 0xb6, 0x6d, 0xdc, 0xe2, 0x08, 0xec, 0x83,
-		0x08, 0x5b, 0xef, 0x76, 0x29, 0x67, 0x4c, 0x25, 0x3f, 0xf9, 0xe8, 0xd9, 0x1c, 0x2b, 0x9e, 0x4b,
-		0xc7, 0x13, 0x0f, 0x6f, 0xa0, 0xef, 0x8c, 0x4a, 0xf0, 0xc0, 0xf0, 0x40, 0xba, 0x85, 0x0f, 0x9d,
-		0xe3, 0x8e, 0xa3, 0x57, 0x21, 0xbd, 0x45, 0x9f, 0x95, 0x79, 0x19, 0x1f, 0xa2, 0x12, 0x24, 0x71,
-		0xeb, 0xe2, 0xa5, 0x4b, 0xcf, 0xbc, 0xc8, 0xb4, 0x7c, 0x75, 0x46, 0x15, 0x09, 0xe8, 0x2c, 0xa4,
-		0x1d, 0x6c, 0x74, 0x2f, 0x5e, 0xba, 0x7c, 0xeb, 0x19, 0xa6, 0x56, 0xab, 0x33, 0xaa, 0x9f, 0xb4,
-		0x9c, 0x22, 0x2d, 0xfe, 0xc2, 0xc7, 0x17, 0xa4, 0x4a, 0x1c, 0xa2, 0x4e, 0xbf, 0xf3, 0xb6, 0xe9,
-		0xc6, 0x1f, 0x27, 0x60, 0x31, 0x90, 0xcb, 0x26, 0x85, 0xdb, 0x7a, 0xdb, 0x6c, 0xe9, 0xfe, 0x63,
-		0x40, 0x72, 0xa0, 0xfd, 0x94, 0x62, 0x8c, 0xb5, 0x3f, 0x52, 0x8a, 0xca, 0xcf, 0x49, 0x90, 0xbd,
-		0x21, 0x90, 0x1b, 0xd8, 0x45, 0x57, 0x00, 0xbc, 0x92, 0xc4, 0x50, 0x39, 0xb3, 0x34, 0x58, 0xd6,
-		0x92, 0xc7, 0xa3, 0x06, 0xc8, 0xd1, 0xf3, 0x54, 0x01, 0xbb, 0xb6, 0xc3, 0x6f, 0xc5, 0x4e, 0x60,
-		0xf5, 0x88, 0xd1, 0x93, 0x80, 0xa8, 0x55, 0xd3, 0x6e, 0xdb, 0xae, 0x69, 0xed, 0x69, 0x5d, 0xfb,
-		0x0e, 0x7f, 0x6b, 0x20, 0xaa, 0xca, 0x34, 0xe7, 0x06, 0xcd, 0xd8, 0x22, 0xe9, 0xa4, 0xd2, 0x69,
-		0x0f, 0x85, 0x2c, 0xc7, 0xf4, 0x56, 0xab, 0x87, 0x1d, 0x87, 0x1b, 0x2e, 0xf1, 0x89, 0xae, 0x40,
-		0xb2, 0xdb, 0xdf, 0xd1, 0x84, 0x95, 0xc8, 0x5c, 0x7c, 0x60, 0xd4, 0x98, 0x17, 0xba, 0xc1, 0x47,
-		0x7d, 0xa2, 0xdb, 0xdf, 0x21, 0x9a, 0xf2, 0x10, 0x64, 0x47, 0x54, 0x26, 0x73, 0xdb, 0xaf, 0x07,
-		0x7d, 0xc9, 0x88, 0xb7, 0x40, 0xeb, 0xf6, 0x4c, 0xbb, 0x67, 0xba, 0x87, 0xf4, 0xb8, 0x5d, 0x54,
-		0x95, 0x45, 0xc6, 0x16, 0x4f, 0x57, 0x6e, 0x41, 0xa1, 0x41, 0xdd, 0x74, 0xbf, 0xe6, 0x97, 0xfc,
-		0xfa, 0x49, 0x93, 0xeb, 0x37, 0xb6, 0x66, 0x91, 0xa1, 0x9a, 0x9d, 0xff, 0xf7, 0x12, 0x64, 0x2a,
-		0x64, 0xe0, 0xd6, 0x57, 0xae, 0xb6, 0xf5, 0x3d, 0xf4, 0x0c, 0x9c, 0xa8, 0xac, 0x6d, 0x56, 0x5f,
-		0xd6, 0xea, 0x2b, 0xda, 0xd5, 0xb5, 0xf2, 0x35, 0xff, 0x7c, 0x6f, 0xe9, 0xe4, 0xdd, 0x7b, 0x8b,
-		0x28, 0x40, 0xbb, 0x6d, 0xd1, 0xb5, 0x24, 0xba, 0x00, 0xf3, 0x61, 0x96, 0x72, 0xa5, 0x51, 0xdb,
-		0x68, 0xca, 0x52, 0xe9, 0xc4, 0xdd, 0x7b, 0x8b, 0xb3, 0x01, 0x8e, 0xf2, 0x8e, 0x83, 0x2d, 0x77,
-		0x98, 0xa1, 0xba, 0xb9, 0xbe, 0x5e, 0x6f, 0xca, 0x91, 0x21, 0x86, 0xaa, 0xdd, 0xe9, 0x98, 0x2e,
-		0x7a, 0x1c, 0x66, 0xc3, 0x0c, 0x1b, 0xf5, 0x35, 0x39, 0x5a, 0x42, 0x77, 0xef, 0x2d, 0xe6, 0x03,
-		0xd4, 0x1b, 0x66, 0xbb, 0x94, 0xfa, 0xe0, 0x8f, 0x9d, 0x9d, 0xf9, 0xe4, 0xdf, 0x3d, 0x2b, 0x55,
-		0xd6, 0xc7, 0x8e, 0xb9, 0x67, 0x8f, 0x37, 0xe6, 0xc2, 0xf3, 0xf6, 0x57, 0x1e, 0x08, 0x99, 0x1b,
-		0xee, 0x87, 0x05, 0x8c, 0xf1, 0xb4, 0xc3, 0x6d, 0x52, 0x6c, 0xa1, 0x74, 0xb4, 0x7b, 0x50, 0x9a,
-		0x30, 0x29, 0x94, 0x26, 0x1a, 0x06, 0xe5, 0x45, 0xc8, 0x6d, 0xe9, 0x3d, 0xb7, 0x81, 0xdd, 0x55,
-		0xac, 0xb7, 0x70, 0x2f, 0xec, 0x3f, 0xe4, 0x84, 0xff, 0x80, 0x20, 0x46, 0x9d, 0x04, 0x36, 0x7f,
-		0xd2, 0xdf, 0xca, 0x3e, 0xc4, 0xe8, 0x49, 0x62, 0xcf, 0xb7, 0xe0, 0x1c, 0xcc, 0xb7, 0x20, 0x33,
-		0xc3, 0xa1, 0x8b, 0x1d, 0x11, 0xfe, 0xa2, 0x1f, 0xe8, 0x39, 0xe1, 0x21, 0x44, 0x8f, 0xf6, 0x10,
-		0xf8, 0xf0, 0xe2, 0x7e, 0x42, 0x1b, 0x92, 0xbc, 0x8b, 0xbd, 0x8a, 0x48, 0x7e, 0x45, 0xd0, 0x3a,
-		0x14, 0xba, 0x7a, 0xcf, 0xa5, 0xf7, 0x14, 0xf7, 0x69, 0x2b, 0xf8, 0x08, 0x5e, 0x18, 0xb6, 0x27,
-		0xa1, 0xc6, 0xf2, 0x52, 0x72, 0xdd, 0x60, 0xa2, 0xf2, 0x9f, 0x63, 0x90, 0xe0, 0xc2, 0x78, 0x17,
-		0x24, 0xb9, 0x58, 0xf9, 0x98, 0x7b, 0x70, 0x69, 0x78, 0x9a, 0x5d, 0xf2, 0xa6, 0x43, 0x8e, 0x27,
-		0x78, 0xd0, 0xa3, 0x90, 0x32, 0xf6, 0x75, 0xd3, 0xd2, 0xcc, 0x16, 0x77, 0x40, 0x33, 0x9f, 0x7d,
-		0x73, 0x21, 0x59, 0x25, 0x69, 0xf5, 0x15, 0x35, 0x49, 0x33, 0xeb, 0x2d, 0xe2, 0xd3, 0xec, 0x63,
-		0x73, 0x6f, 0xdf, 0xe5, 0x76, 0x83, 0x7f, 0xa1, 0x17, 0x20, 0x46, 0x14, 0x82, 0xdf, 0x62, 0x2f,
-		0x0d, 0xad, 0x2c, 0xbc, 0xd0, 0x4f, 0x25, 0x45, 0x0a, 0xfe, 0xd0, 0x7f, 0x5a, 0x90, 0x54, 0xca,
-		0x81, 0xaa, 0x90, 0x6b, 0xeb, 0x8e, 0xab, 0xd1, 0xf9, 0x98, 0x14, 0x1f, 0xa7, 0x10, 0xa7, 0x87,
-		0x05, 0xc2, 0x05, 0xcb, 0xab, 0x9e, 0x21, 0x5c, 0x2c, 0xa9, 0x85, 0xce, 0x81, 0x4c, 0x41, 0x0c,
-		0x3a, 0x02, 0x99, 0x97, 0x98, 0xa0, 0x72, 0xcf, 0x93, 0x74, 0x36, 0x30, 0xa9, 0xaf, 0x78, 0x06,
-		0xd2, 0xf4, 0xde, 0x2c, 0x25, 0x61, 0xc7, 0xd7, 0x53, 0x24, 0x81, 0x66, 0x3e, 0x06, 0x05, 0xdf,
-		0xea, 0x33, 0x92, 0x14, 0x43, 0xf1, 0x93, 0x29, 0xe1, 0xd3, 0x30, 0x6f, 0xe1, 0x03, 0x7a, 0xa0,
-		0x3e, 0x44, 0x9d, 0xa6, 0xd4, 0x88, 0xe4, 0xdd, 0x08, 0x73, 0x3c, 0x02, 0x79, 0x43, 0x08, 0x9f,
-		0xd1, 0x02, 0xa5, 0xcd, 0x79, 0xa9, 0x94, 0xec, 0x34, 0xa4, 0xf4, 0x6e, 0x97, 0x11, 0x64, 0xb8,
-		0xd5, 0xef, 0x76, 0x69, 0xd6, 0x79, 0x98, 0xa5, 0x6d, 0xec, 0x61, 0xa7, 0xdf, 0x76, 0x39, 0x48,
-		0x96, 0xd2, 0x14, 0x48, 0x86, 0xca, 0xd2, 0x29, 0xed, 0xc3, 0x90, 0xc3, 0xb7, 0xcd, 0x16, 0xb6,
-		0x0c, 0xcc, 0xe8, 0x72, 0x94, 0x2e, 0x2b, 0x12, 0x29, 0xd1, 0xe3, 0xe0, 0x59, 0x73, 0x4d, 0xcc,
-		0x34, 0x79, 0x86, 0x27, 0xd2, 0xcb, 0x2c, 0x59, 0x29, 0x42, 0x6c, 0x45, 0x77, 0x75, 0xe2, 0x2e,
-		0xb9, 0x07, 0x6c, 0xfa, 0xcc, 0xaa, 0xe4, 0xa7, 0xf2, 0x0b, 0x51, 0x88, 0xdd, 0xb0, 0x5d, 0x8c,
-		0x9e, 0x0d, 0xb8, 0xb2, 0xf9, 0x51, 0xfa, 0xdc, 0x30, 0xf7, 0x2c, 0xdc, 0x5a, 0x77, 0xf6, 0x02,
-		0x8f, 0xdc, 0xf8, 0xea, 0x14, 0x09, 0xa9, 0xd3, 0x3c, 0xc4, 0x7b, 0x76, 0xdf, 0x6a, 0x89, 0x83,
-		0xdf, 0xf4, 0x03, 0xd5, 0x20, 0xe5, 0x69, 0x49, 0x6c, 0x92, 0x96, 0x14, 0x88, 0x96, 0x10, 0x1d,
-		0xe6, 0x09, 0x6a, 0x72, 0x87, 0x2b, 0x4b, 0x05, 0xd2, 0x9e, 0xf1, 0xe2, 0xda, 0x36, 0x9d, 0xc2,
-		0xfa, 0x6c, 0x64, 0x8a, 0xf4, 0xfa, 0xde, 0x13, 0x1e, 0xd3, 0x38, 0xd9, 0xcb, 0xe0, 0xd2, 0x0b,
-		0xa9, 0x15, 0x7f, 0x70, 0x27, 0x49, 0xdb, 0xe5, 0xab, 0x15, 0x7b, 0x74, 0xe7, 0x01, 0x48, 0x3b,
-		0xe6, 0x9e, 0xa5, 0xbb, 0xfd, 0x1e, 0xe6, 0x9a, 0xe7, 0x27, 0x90, 0x5c, 0xff, 0x12, 0x04, 0xd3,
-		0xb4, 0xc0, 0xcb, 0x6d, 0x17, 0x60, 0xce, 0x7f, 0x33, 0xcd, 0x47, 0x61, 0x5a, 0x86, 0xbc, 0xac,
-		0x86, 0xc8, 0x51, 0x7e, 0x55, 0x82, 0x04, 0x9f, 0xb1, 0xfc, 0x6e, 0x90, 0x46, 0x77, 0x43, 0x64,
-		0x5c, 0x37, 0x44, 0xef, 0xbf, 0x1b, 0xca, 0x00, 0x5e, 0x35, 0x1d, 0xfe, 0xac, 0xca, 0x08, 0xb7,
-		0x8a, 0x55, 0xb1, 0x61, 0xee, 0xf1, 0x71, 0x1f, 0x60, 0x52, 0x7e, 0x57, 0x22, 0x1e, 0x3e, 0xcf,
-		0x47, 0x65, 0xc8, 0x89, 0x7a, 0x69, 0xbb, 0x6d, 0x7d, 0x8f, 0xab, 0xe2, 0x83, 0x63, 0x2b, 0x47,
-		0x66, 0x61, 0x35, 0xc3, 0xeb, 0x43, 0xfd, 0x89, 0x91, 0xdd, 0x1a, 0x19, 0xd3, 0xad, 0x21, 0x3d,
-		0x8a, 0xde, 0x9f, 0x1e, 0x85, 0x7a, 0x3c, 0x36, 0xd0, 0xe3, 0xca, 0xef, 0x4b, 0xfc, 0xc5, 0xb6,
-		0x16, 0xbb, 0xc1, 0xf1, 0x97, 0xd5, 0x55, 0xaf, 0x71, 0xdd, 0x6a, 0xe1, 0x96, 0x36, 0xd4, 0x67,
-		0x0f, 0x0f, 0x23, 0x86, 0xeb, 0xec, 0xf7, 0x1d, 0x12, 0x28, 0x0d, 0xbf, 0x0f, 0x3f, 0x1d, 0x81,
-		0xd9, 0x21, 0xfa, 0xbf, 0x7a, 0x7d, 0x19, 0x1e, 0xbd, 0xf1, 0x29, 0x47, 0x6f, 0x62, 0xec, 0xe8,
-		0xfd, 0x54, 0x84, 0x06, 0x00, 0xba, 0xb6, 0xa3, 0xb7, 0xbf, 0x16, 0xb6, 0xf7, 0x0c, 0xa4, 0xbb,
-		0x76, 0x5b, 0x63, 0x39, 0xec, 0xea, 0x4d, 0xaa, 0x6b, 0xb7, 0xd5, 0x21, 0x35, 0x8b, 0xbf, 0x45,
-		0x86, 0x39, 0xf1, 0x16, 0x74, 0x42, 0x72, 0x70, 0x40, 0xf5, 0x20, 0xcb, 0x44, 0xc1, 0xbd, 0xa6,
-		0xa7, 0x89, 0x0c, 0xa8, 0x1b, 0x26, 0x0d, 0x7b, 0x79, 0xac, 0xda, 0x8c, 0x52, 0xe5, 0x74, 0x84,
-		0x83, 0x39, 0x19, 0xa3, 0x22, 0x47, 0x41, 0x8b, 0xa5, 0x72, 0x3a, 0xe5, 0xfb, 0x25, 0x80, 0x35,
-		0x22, 0x59, 0xda, 0x5e, 0xe2, 0xef, 0x38, 0xb4, 0x0a, 0x5a, 0xa8, 0xe4, 0xb3, 0xe3, 0x3a, 0x8d,
-		0x97, 0x9f, 0x75, 0x82, 0xf5, 0xae, 0x42, 0xce, 0xd7, 0x6d, 0x07, 0x8b, 0xca, 0x9c, 0x3d, 0x62,
-		0x55, 0xda, 0xc0, 0xae, 0x9a, 0xbd, 0x1d, 0xf8, 0x52, 0xfe, 0x99, 0x04, 0x69, 0x5a, 0xa7, 0x75,
-		0xec, 0xea, 0xa1, 0x3e, 0x94, 0xee, 0xbf, 0x0f, 0x1f, 0x04, 0x60, 0x30, 0x8e, 0xf9, 0x06, 0xe6,
-		0x9a, 0x95, 0xa6, 0x29, 0x0d, 0xf3, 0x0d, 0x8c, 0x2e, 0x7b, 0x02, 0x8f, 0x1e, 0x2d, 0x70, 0xb1,
-		0x6a, 0xe5, 0x62, 0x3f, 0x05, 0x49, 0xfa, 0xa6, 0xe8, 0x81, 0xc3, 0x17, 0xa2, 0x09, 0xab, 0xdf,
-		0x69, 0x1e, 0x38, 0xca, 0xeb, 0x90, 0x6c, 0x1e, 0xb0, 0x78, 0xe2, 0x19, 0x48, 0xf7, 0x6c, 0x9b,
-		0x7b, 0x7f, 0xcc, 0xeb, 0x4e, 0x91, 0x04, 0xea, 0xec, 0x88, 0x18, 0x5a, 0xc4, 0x8f, 0xa1, 0xf9,
-		0x41, 0xc0, 0xe8, 0x54, 0x41, 0x40, 0xb2, 0xfa, 0xcc, 0x85, 0x46, 0x12, 0x7a, 0x12, 0x4e, 0x35,
-		0xea, 0xd7, 0x36, 0x6a, 0x2b, 0xda, 0x7a, 0xe3, 0xda, 0xc0, 0xb5, 0xfa, 0x52, 0xe1, 0xee, 0xbd,
-		0xc5, 0x0c, 0x5f, 0x76, 0x8e, 0xa3, 0xde, 0x52, 0x6b, 0x37, 0x36, 0x9b, 0x35, 0x59, 0x62, 0xd4,
-		0x5b, 0x3d, 0x7c, 0xdb, 0x76, 0xd9, 0xa3, 0xbd, 0x4f, 0xc3, 0xe9, 0x11, 0xd4, 0xde, 0xe2, 0x73,
-		0xf6, 0xee, 0xbd, 0xc5, 0xdc, 0x56, 0x0f, 0x33, 0x2d, 0xa3, 0x1c, 0x4b, 0x50, 0x1c, 0xe6, 0xd8,
-		0xdc, 0xda, 0x6c, 0x94, 0xd7, 0xe4, 0xc5, 0x92, 0x7c, 0xf7, 0xde, 0x62, 0x56, 0x98, 0x0c, 0x42,
-		0xff, 0xf6, 0xaf, 0x3e, 0xbf, 0x3d, 0x15, 0x8a, 0x1a, 0xb3, 0x75, 0x5d, 0x57, 0xef, 0xe9, 0x9d,
-		0xe3, 0x2e, 0x3f, 0x27, 0x6c, 0xf8, 0x2b, 0x3f, 0x17, 0x81, 0x82, 0xb7, 0xb8, 0xd9, 0xa2, 0x25,
-		0xa0, 0x67, 0x83, 0x11, 0xbf, 0xcc, 0xd8, 0xb9, 0x83, 0x51, 0x8b, 0x80, 0xe0, 0x3b, 0x21, 0x25,
-		0x9c, 0x64, 0x3e, 0xa8, 0x16, 0x47, 0xcc, 0x6f, 0x9c, 0x82, 0xb3, 0x7a, 0x1c, 0xe8, 0x25, 0x48,
-		0x7b, 0x43, 0xcc, 0x7b, 0x26, 0x6d, 0xfc, 0x98, 0xe4, 0xfc, 0x3e, 0x0f, 0x7a, 0xd1, 0x5f, 0xc6,
-		0xc5, 0xc6, 0x2d, 0x0c, 0x6f, 0x30, 0x02, 0xce, 0xec, 0x2d, 0xe1, 0x9e, 0x86, 0x98, 0xbe, 0x63,
-		0x98, 0xdc, 0x00, 0x3f, 0x30, 0xcc, 0x57, 0xae, 0x54, 0xeb, 0x9c, 0x89, 0x52, 0x2a, 0x75, 0x1e,
-		0x4c, 0xe1, 0xf2, 0xa2, 0x8f, 0xd2, 0x1c, 0x68, 0x6c, 0x2d, 0xcc, 0xbc, 0x8b, 0x54, 0x47, 0x3f,
-		0xa8, 0xd0, 0xe5, 0xf0, 0x29, 0x48, 0x92, 0xcc, 0x3d, 0xfe, 0x50, 0x41, 0x54, 0x4d, 0x74, 0xf4,
-		0x83, 0x6b, 0xba, 0x73, 0x3d, 0x96, 0x8a, 0xca, 0x31, 0xe5, 0x27, 0x89, 0xa7, 0x12, 0x92, 0x0a,
-		0x7a, 0x02, 0x10, 0xe1, 0xd0, 0xf7, 0xb0, 0x46, 0x86, 0x2e, 0x15, 0xaf, 0xc0, 0x2d, 0x74, 0xf4,
-		0x83, 0xf2, 0x1e, 0xde, 0xe8, 0x77, 0x68, 0x05, 0x1c, 0xb4, 0x0e, 0xb2, 0x20, 0x16, 0x3d, 0xcb,
-		0xc5, 0x7f, 0x7a, 0xf8, 0x61, 0x5c, 0x4e, 0xc0, 0x26, 0x80, 0x0f, 0x93, 0x09, 0x20, 0xcf, 0xf0,
-		0xbc, 0x23, 0x1e, 0xa1, 0xa6, 0x44, 0xc3, 0x4d, 0x51, 0x5e, 0x82, 0xc2, 0x40, 0x0f, 0x20, 0x05,
-		0x72, 0x3c, 0x62, 0x45, 0xb7, 0xcf, 0xd9, 0x0a, 0x27, 0xad, 0x66, 0x58, 0x64, 0x8a, 0x1e, 0x27,
-		0x58, 0x4e, 0xfd, 0xe2, 0xc7, 0x16, 0x24, 0xba, 0x99, 0xf3, 0x04, 0xe4, 0x42, 0x7d, 0x20, 0xa2,
-		0xc8, 0x92, 0x1f, 0x45, 0xf6, 0x89, 0x5f, 0x83, 0x2c, 0x31, 0x40, 0xb8, 0xc5, 0x69, 0x1f, 0x85,
-		0x02, 0x33, 0x90, 0x83, 0xb2, 0x66, 0x0e, 0xcf, 0xba, 0x10, 0xb8, 0x22, 0x3c, 0xa0, 0xb0, 0xd8,
-		0x33, 0x82, 0xea, 0x9a, 0xee, 0x28, 0x9b, 0x00, 0x7e, 0xa7, 0xa2, 0x32, 0x3c, 0x48, 0x8c, 0x47,
-		0xf0, 0xb8, 0x27, 0x7f, 0x20, 0x2b, 0xe4, 0x31, 0x96, 0x08, 0x91, 0x7f, 0xa4, 0x93, 0x3d, 0x93,
-		0xb5, 0x4a, 0x29, 0x2a, 0xaf, 0x7c, 0xf2, 0xb3, 0x67, 0xa5, 0xb7, 0xc7, 0x16, 0xfc, 0x87, 0x9b,
-		0x70, 0x26, 0x90, 0x49, 0x14, 0x30, 0x14, 0x88, 0x2a, 0x04, 0xf4, 0x95, 0x64, 0x4e, 0x0a, 0x28,
-		0x1d, 0x19, 0xff, 0x2d, 0x1d, 0x6d, 0x76, 0x26, 0x47, 0x9b, 0x26, 0xc7, 0xbb, 0x46, 0x07, 0xe5,
-		0xff, 0x69, 0x1a, 0x92, 0x2a, 0x7e, 0x5f, 0x1f, 0x3b, 0x2e, 0xba, 0x08, 0x31, 0x6c, 0xec, 0xdb,
-		0xa3, 0x62, 0xa0, 0xa4, 0x81, 0x4b, 0x9c, 0xae, 0x66, 0xec, 0xdb, 0xab, 0x33, 0x2a, 0xa5, 0x45,
-		0x97, 0x20, 0xbe, 0xdb, 0xee, 0xf3, 0xf0, 0xd5, 0x80, 0xcd, 0x0a, 0x32, 0x5d, 0x25, 0x44, 0xab,
-		0x33, 0x2a, 0xa3, 0x26, 0x45, 0xd1, 0x17, 0xcd, 0xa3, 0x47, 0x17, 0x55, 0xb7, 0x76, 0x69, 0x51,
-		0x84, 0x16, 0x55, 0x00, 0x4c, 0xcb, 0x74, 0x35, 0x1a, 0xda, 0xe1, 0x56, 0xe3, 0xa1, 0xf1, 0x9c,
-		0xa6, 0x4b, 0x83, 0x41, 0xab, 0x33, 0x6a, 0xda, 0x14, 0x1f, 0xa4, 0xba, 0xef, 0xeb, 0xe3, 0xde,
-		0x21, 0xf7, 0xd6, 0xc6, 0x56, 0xf7, 0x15, 0x42, 0x44, 0xaa, 0x4b, 0xa9, 0x89, 0x91, 0x65, 0x4f,
-		0xed, 0xb9, 0x07, 0xfc, 0x01, 0xd9, 0x85, 0x71, 0x9c, 0xf4, 0xbd, 0xbd, 0xe6, 0xc1, 0xea, 0x8c,
-		0x9a, 0x34, 0xd8, 0x4f, 0xf4, 0x82, 0xe7, 0x82, 0x65, 0x86, 0xbd, 0x9e, 0x10, 0x2f, 0x0b, 0xfb,
-		0xcc, 0x08, 0x57, 0x0c, 0x6d, 0x40, 0xbe, 0x6d, 0x3a, 0xae, 0xe6, 0x58, 0x7a, 0xd7, 0xd9, 0xb7,
-		0x5d, 0x87, 0xc6, 0x4f, 0x32, 0x17, 0x1f, 0x19, 0x87, 0xb0, 0x66, 0x3a, 0x6e, 0x43, 0x10, 0xaf,
-		0xce, 0xa8, 0xb9, 0x76, 0x30, 0x81, 0xe0, 0xd9, 0xbb, 0xbb, 0xb8, 0xe7, 0x01, 0xd2, 0x38, 0xcb,
-		0x11, 0x78, 0x9b, 0x84, 0x5a, 0xf0, 0x13, 0x3c, 0x3b, 0x98, 0x80, 0xbe, 0x01, 0xe6, 0xda, 0xb6,
-		0xde, 0xf2, 0xe0, 0x34, 0x63, 0xbf, 0x6f, 0xdd, 0xa2, 0x41, 0x99, 0xcc, 0xc5, 0xc7, 0xc7, 0x56,
-		0xd2, 0xd6, 0x5b, 0x02, 0xa2, 0x4a, 0x18, 0x56, 0x67, 0xd4, 0xd9, 0xf6, 0x60, 0x22, 0x7a, 0x2f,
-		0xcc, 0xeb, 0xdd, 0x6e, 0xfb, 0x70, 0x10, 0xbd, 0x40, 0xd1, 0xcf, 0x8f, 0x43, 0x2f, 0x13, 0x9e,
-		0x41, 0x78, 0xa4, 0x0f, 0xa5, 0xa2, 0x26, 0xc8, 0xdd, 0x1e, 0xa6, 0x37, 0xa6, 0xba, 0xdc, 0xcb,
-		0xa0, 0xaf, 0x53, 0x65, 0x2e, 0x3e, 0x36, 0x0e, 0x7b, 0x8b, 0xd1, 0x0b, 0xa7, 0x64, 0x75, 0x46,
-		0x2d, 0x74, 0xc3, 0x49, 0x0c, 0xd5, 0x36, 0x30, 0x7d, 0x3c, 0x8f, 0xa3, 0xce, 0x4e, 0x42, 0xa5,
-		0xf4, 0x61, 0xd4, 0x50, 0x12, 0xaa, 0x41, 0x86, 0x2d, 0x45, 0x35, 0x62, 0x0c, 0xe9, 0x9b, 0x56,
-		0x99, 0x8b, 0xca, 0xd8, 0x11, 0x4a, 0x49, 0x6f, 0xd8, 0x2e, 0x5e, 0x9d, 0x51, 0x01, 0x7b, 0x5f,
-		0x48, 0x87, 0x13, 0xf4, 0x79, 0xaf, 0x43, 0x2d, 0x6c, 0x78, 0x8b, 0x73, 0x14, 0xf0, 0x89, 0x71,
-		0x80, 0xf4, 0x85, 0xeb, 0xc3, 0x1b, 0x41, 0x3b, 0xbc, 0x3a, 0xa3, 0xce, 0xdd, 0x1e, 0x4e, 0x26,
-		0x2a, 0xb6, 0x6b, 0x5a, 0x7a, 0xdb, 0x7c, 0x03, 0xb3, 0x29, 0x94, 0x3e, 0x6c, 0x79, 0x84, 0x8a,
-		0x5d, 0xe5, 0xd4, 0x74, 0x62, 0x25, 0x2a, 0xb6, 0x1b, 0x4c, 0xa8, 0x24, 0xf9, 0x59, 0x4f, 0xef,
-		0xa1, 0xb6, 0xa4, 0x9c, 0x62, 0x8f, 0xb3, 0x5d, 0x8f, 0xa5, 0x40, 0xce, 0x28, 0x8f, 0x41, 0x26,
-		0x60, 0x98, 0x50, 0x11, 0x92, 0xfc, 0x34, 0x8c, 0x38, 0x35, 0xca, 0x3f, 0x95, 0x3c, 0x64, 0x83,
-		0xc6, 0x48, 0xf9, 0x90, 0xe4, 0x71, 0xd2, 0x37, 0x25, 0x8a, 0xe1, 0x88, 0x74, 0xda, 0xf7, 0x54,
-		0x1e, 0x16, 0x53, 0x9b, 0xc8, 0x67, 0xdb, 0xaf, 0x59, 0x9a, 0xc8, 0x67, 0x56, 0xb4, 0x00, 0x99,
-		0xee, 0xc5, 0xae, 0x47, 0x12, 0xa5, 0x24, 0xd0, 0xbd, 0xd8, 0x15, 0x04, 0x0f, 0x41, 0x96, 0xb4,
-		0x5b, 0x0b, 0xfa, 0x4b, 0x69, 0x35, 0x43, 0xd2, 0x38, 0x89, 0xf2, 0xaf, 0x22, 0x20, 0x0f, 0x1a,
-		0x30, 0x2f, 0x54, 0x2d, 0x1d, 0x3b, 0x54, 0x7d, 0x7a, 0x30, 0x48, 0xee, 0xc7, 0xc5, 0xd7, 0x40,
-		0xf6, 0xc3, 0xbb, 0x6c, 0xaa, 0x19, 0xef, 0xff, 0x0d, 0x38, 0xaa, 0x6a, 0xc1, 0x18, 0xf0, 0x5c,
-		0xaf, 0x86, 0x36, 0x2b, 0xc5, 0x9f, 0xfc, 0x18, 0xec, 0x70, 0xcf, 0x89, 0xd9, 0xee, 0xb6, 0x74,
-		0x17, 0x8b, 0xf8, 0x58, 0x60, 0xdf, 0xf2, 0x51, 0x28, 0xe8, 0xdd, 0xae, 0xe6, 0xb8, 0xba, 0x8b,
-		0xb9, 0xaf, 0xc1, 0x22, 0x0f, 0x39, 0xbd, 0xdb, 0xa5, 0x4f, 0xab, 0x33, 0x5f, 0xe3, 0x11, 0xc8,
-		0x13, 0x9b, 0x6e, 0xea, 0x6d, 0xe1, 0x2a, 0x24, 0x98, 0x4b, 0xc2, 0x53, 0x99, 0x77, 0xa0, 0xb4,
-		0xbc, 0x1e, 0xa7, 0xf6, 0xdc, 0x5b, 0x53, 0x49, 0x81, 0x35, 0x15, 0xe2, 0x6f, 0x7d, 0x30, 0xf9,
-		0x88, 0xf7, 0x51, 0x46, 0x6f, 0x1a, 0xcc, 0xd3, 0xf5, 0xd7, 0x6d, 0x16, 0x2c, 0x49, 0xa9, 0xec,
-		0x43, 0x51, 0x21, 0x1f, 0xb6, 0xfd, 0x28, 0x0f, 0x11, 0xf7, 0x80, 0x97, 0x12, 0x71, 0x0f, 0x88,
-		0xa7, 0xeb, 0xbd, 0xb7, 0x9b, 0x1f, 0x31, 0xdb, 0x71, 0x3e, 0x3f, 0xd6, 0xa1, 0x14, 0x20, 0x17,
-		0x9a, 0x13, 0x94, 0x93, 0x30, 0x3f, 0xca, 0xc4, 0x2b, 0xfb, 0x5e, 0x7a, 0xc8, 0x54, 0xa3, 0x4b,
-		0x90, 0xf2, 0x6c, 0xfc, 0x88, 0xd5, 0x31, 0x2d, 0x56, 0x10, 0xab, 0x1e, 0x69, 0x28, 0x9c, 0x1f,
-		0x09, 0x85, 0xf3, 0x95, 0x6f, 0x86, 0xe2, 0x38, 0xfb, 0x3d, 0x10, 0xe4, 0x8b, 0x79, 0x02, 0x3b,
-		0x09, 0x09, 0xfe, 0xb0, 0x65, 0x84, 0x6e, 0x60, 0xf1, 0x2f, 0x22, 0x48, 0x66, 0xcb, 0xa3, 0x6c,
-		0x5f, 0x8b, 0x7e, 0x28, 0x1a, 0x9c, 0x1e, 0x6b, 0xc3, 0xc7, 0x6f, 0x85, 0x31, 0x20, 0xbe, 0x15,
-		0x66, 0x88, 0xea, 0x38, 0xb4, 0xad, 0xe2, 0x20, 0x0b, 0xfb, 0x52, 0x3e, 0x1c, 0x85, 0x93, 0xa3,
-		0x2d, 0x39, 0x5a, 0x84, 0x2c, 0xf1, 0x5b, 0xdd, 0xb0, 0x8b, 0x0b, 0x1d, 0xfd, 0xa0, 0xc9, 0xfd,
-		0x5b, 0xbe, 0x95, 0x10, 0xf1, 0xb6, 0x12, 0xd0, 0x36, 0xcc, 0xb6, 0x6d, 0x43, 0x6f, 0x6b, 0x81,
-		0xad, 0x1c, 0x3e, 0x88, 0x1e, 0x1e, 0x12, 0x76, 0x38, 0x64, 0x48, 0x0c, 0x0e, 0xd7, 0xff, 0x02,
-		0xc5, 0x58, 0xf3, 0x76, 0x7d, 0xd0, 0x0a, 0x64, 0x3a, 0xa6, 0xb3, 0x83, 0xf7, 0xf5, 0xdb, 0xa6,
-		0xdd, 0xe3, 0xa3, 0x69, 0x58, 0x69, 0xd6, 0x7d, 0x1a, 0xb1, 0xc3, 0x14, 0x60, 0x0b, 0x74, 0x49,
-		0x7c, 0xe4, 0xc6, 0x57, 0xe2, 0xd8, 0xd6, 0x64, 0xdc, 0x1e, 0x52, 0x72, 0xec, 0x1e, 0xd2, 0xa8,
-		0x0d, 0x9b, 0xd4, 0xe8, 0x0d, 0x9b, 0x0f, 0x06, 0xbb, 0x26, 0x3c, 0xf7, 0x0d, 0xed, 0xe1, 0xa0,
-		0x06, 0xcc, 0x73, 0xfe, 0x56, 0x48, 0xf6, 0x23, 0x8e, 0x3a, 0xb0, 0xf1, 0x35, 0x28, 0x73, 0x24,
-		0xd8, 0xc7, 0x8b, 0x3d, 0x7a, 0x7f, 0x62, 0x17, 0x9b, 0xa8, 0xb1, 0xc0, 0x26, 0xea, 0xff, 0x65,
-		0x5d, 0xf1, 0x81, 0x28, 0xcc, 0x0e, 0x39, 0x12, 0x23, 0x77, 0x87, 0xc7, 0x45, 0x64, 0x45, 0xc3,
-		0xa2, 0xc7, 0x6e, 0x18, 0xef, 0xeb, 0xd8, 0xe4, 0xbe, 0x8e, 0xbf, 0x85, 0x7d, 0x9d, 0xb8, 0xbf,
-		0xbe, 0x7e, 0x5b, 0x7b, 0xe1, 0xa3, 0x12, 0x94, 0xc6, 0x7b, 0x5f, 0x23, 0xbb, 0xe3, 0x58, 0x1b,
-		0x08, 0xe3, 0xe6, 0xb8, 0x47, 0x20, 0x3f, 0xe0, 0x1b, 0x32, 0x55, 0xce, 0x85, 0x56, 0xe1, 0xca,
-		0xb7, 0x46, 0xbd, 0x89, 0x27, 0xe4, 0xc0, 0x8d, 0x18, 0xad, 0xaf, 0xc0, 0x5c, 0x0b, 0x1b, 0x66,
-		0xeb, 0x7e, 0x07, 0xeb, 0x2c, 0xe7, 0xfe, 0x7f, 0x63, 0x75, 0x58, 0x4b, 0xbe, 0x0f, 0x20, 0xa5,
-		0x62, 0xa7, 0x4b, 0xfc, 0x31, 0x54, 0x81, 0x34, 0x3e, 0x30, 0x70, 0xd7, 0xf5, 0x0f, 0x55, 0x8c,
-		0x5a, 0x22, 0x30, 0xea, 0x9a, 0xa0, 0x24, 0x0b, 0x64, 0x8f, 0x0d, 0x3d, 0xcb, 0x63, 0x00, 0xe3,
-		0x97, 0xf3, 0x9c, 0x3d, 0x18, 0x04, 0xb8, 0x2c, 0x82, 0x00, 0xd1, 0xb1, 0xeb, 0x5b, 0xc6, 0x35,
-		0x10, 0x05, 0x78, 0x96, 0x47, 0x01, 0x62, 0x13, 0x0a, 0x0b, 0x85, 0x01, 0xaa, 0xa1, 0x30, 0x40,
-		0x62, 0x42, 0x33, 0xc7, 0xc4, 0x01, 0x2e, 0x8b, 0x38, 0x40, 0x72, 0x42, 0x8d, 0x07, 0x02, 0x01,
-		0xef, 0x0a, 0x04, 0x02, 0xd2, 0xc3, 0xd1, 0xd6, 0x10, 0xeb, 0x88, 0x48, 0xc0, 0x8b, 0x5e, 0x24,
-		0x20, 0x3b, 0x36, 0x8a, 0xc0, 0x99, 0x07, 0x43, 0x01, 0x9b, 0x43, 0xa1, 0x80, 0x1c, 0xff, 0xc3,
-		0x56, 0xe3, 0x20, 0x26, 0xc4, 0x02, 0x36, 0x87, 0x62, 0x01, 0xf9, 0x09, 0x80, 0x13, 0x82, 0x01,
-		0xdf, 0x38, 0x3a, 0x18, 0x30, 0x7e, 0xb9, 0xce, 0xab, 0x39, 0x5d, 0x34, 0x40, 0x1b, 0x13, 0x0d,
-		0x90, 0xc7, 0xae, 0x5c, 0x19, 0xfc, 0xd4, 0xe1, 0x80, 0xed, 0x11, 0xe1, 0x00, 0xb6, 0x70, 0x3f,
-		0x37, 0x16, 0x7c, 0x8a, 0x78, 0xc0, 0xf6, 0x88, 0x78, 0x00, 0x9a, 0x08, 0x3b, 0x31, 0x20, 0x70,
-		0x35, 0x1c, 0x10, 0x98, 0x1b, 0xe3, 0x75, 0xfa, 0xa3, 0x7d, 0x4c, 0x44, 0x60, 0x67, 0x5c, 0x44,
-		0x80, 0xad, 0xda, 0x9f, 0x1c, 0x8b, 0x78, 0x8c, 0x90, 0xc0, 0xe6, 0x50, 0x48, 0xe0, 0xc4, 0x04,
-		0x4d, 0x9b, 0x3e, 0x26, 0xc0, 0x9e, 0x6d, 0x67, 0x0f, 0xb6, 0x83, 0x9c, 0xb9, 0x1e, 0x4b, 0x65,
-		0xe4, 0xac, 0xf2, 0x38, 0xf1, 0x60, 0x06, 0xec, 0x1c, 0x59, 0x2b, 0xe0, 0x5e, 0xcf, 0xee, 0x89,
-		0x7b, 0x0c, 0xf4, 0x43, 0x39, 0x47, 0xd6, 0x88, 0xbe, 0x4d, 0x3b, 0x22, 0x7e, 0x40, 0xd7, 0x64,
-		0x01, 0x3b, 0xa6, 0xfc, 0xa2, 0xe4, 0xf3, 0xd2, 0x08, 0x42, 0x70, 0x7d, 0x99, 0xe6, 0xeb, 0xcb,
-		0x40, 0x54, 0x21, 0x12, 0x8e, 0x2a, 0x2c, 0x40, 0x86, 0xac, 0xb5, 0x06, 0x02, 0x06, 0x7a, 0xd7,
-		0x0b, 0x18, 0x88, 0x03, 0x54, 0x2c, 0xf6, 0xc0, 0xa7, 0x25, 0xb6, 0x9b, 0x58, 0xf0, 0x0e, 0x93,
-		0xb1, 0xa5, 0x2e, 0x7a, 0x0a, 0xe6, 0x02, 0xb4, 0xde, 0x1a, 0x8e, 0xad, 0x9e, 0x65, 0x8f, 0xba,
-		0xcc, 0x17, 0x73, 0xbf, 0x2e, 0xf9, 0x12, 0xf2, 0x23, 0x0d, 0xa3, 0x82, 0x02, 0xd2, 0x5b, 0x14,
-		0x14, 0x88, 0xdc, 0x77, 0x50, 0x20, 0xb8, 0x26, 0x8d, 0x86, 0xd7, 0xa4, 0xff, 0x43, 0xf2, 0xfb,
-		0xc4, 0x5b, 0xe2, 0x1b, 0x76, 0x0b, 0xf3, 0x55, 0x22, 0xfd, 0x4d, 0x5c, 0x92, 0xb6, 0xbd, 0xc7,
-		0xd7, 0x82, 0xe4, 0x27, 0xa1, 0xf2, 0x26, 0x9e, 0x34, 0x9f, 0x57, 0xbc, 0x05, 0x66, 0x3c, 0x78,
-		0x8f, 0x83, 0x5f, 0x6e, 0x48, 0xf8, 0x97, 0x1b, 0xbc, 0xcb, 0xc7, 0xc9, 0xc0, 0xe5, 0x63, 0xf4,
-		0x02, 0xa4, 0x69, 0xb0, 0x5f, 0xb3, 0xbb, 0xe2, 0x6f, 0x8c, 0x9d, 0x19, 0x7f, 0xb1, 0xc1, 0xa1,
-		0x47, 0xae, 0xd9, 0x65, 0x08, 0xdf, 0xe3, 0x48, 0x87, 0x3c, 0x8e, 0x07, 0x20, 0x4d, 0x6a, 0xcf,
-		0xfe, 0x56, 0x06, 0xf0, 0x9b, 0xeb, 0x22, 0x41, 0xf9, 0xa9, 0x08, 0x14, 0x06, 0x26, 0x9a, 0x91,
-		0x6d, 0x1f, 0xb5, 0x8d, 0x3c, 0x9d, 0x3c, 0xce, 0x02, 0xec, 0xe9, 0x8e, 0x76, 0x47, 0xb7, 0x5c,
-		0xfe, 0x27, 0xf1, 0xa2, 0x6a, 0x20, 0x05, 0x95, 0x20, 0x45, 0xbe, 0xfa, 0x0e, 0xff, 0xa3, 0x78,
-		0x51, 0xd5, 0xfb, 0x46, 0xab, 0x90, 0xc0, 0xb7, 0xe9, 0xeb, 0xae, 0xec, 0x8d, 0xe4, 0x93, 0xc3,
-		0xcb, 0x61, 0x92, 0x5d, 0x29, 0x92, 0xce, 0xfe, 0xe2, 0x9b, 0x0b, 0x32, 0xa3, 0x7e, 0xd2, 0xbb,
-		0x30, 0xa6, 0x72, 0xfe, 0xb0, 0x14, 0x52, 0x03, 0x52, 0xa0, 0x71, 0xc0, 0xac, 0x58, 0xde, 0x13,
-		0x99, 0xb2, 0x33, 0xde, 0x6a, 0xae, 0x83, 0x3b, 0x5d, 0xdb, 0x6e, 0x6b, 0x6c, 0x8c, 0x97, 0x21,
-		0x1f, 0x9e, 0x57, 0xd9, 0xdf, 0xb1, 0x72, 0x75, 0xd3, 0xd2, 0x42, 0x4e, 0x70, 0x96, 0x25, 0xb2,
-		0x31, 0x75, 0x3d, 0x96, 0x92, 0xe4, 0xc8, 0xf5, 0x58, 0x2a, 0x22, 0x47, 0x95, 0x2d, 0x38, 0x31,
-		0x72, 0x5e, 0x45, 0xcf, 0x43, 0xda, 0x9f, 0x92, 0xd9, 0x31, 0xfd, 0x23, 0x22, 0x2d, 0x3e, 0xad,
-		0xf2, 0x2b, 0x92, 0x0f, 0x19, 0x8e, 0xdd, 0xd4, 0x20, 0xc1, 0xce, 0x4c, 0xf2, 0xf3, 0x31, 0x4f,
-		0x4d, 0x37, 0x23, 0x2f, 0xb1, 0x03, 0x95, 0x2a, 0x67, 0x56, 0xde, 0x0b, 0x09, 0x96, 0x82, 0x32,
-		0x90, 0xf4, 0xdf, 0x91, 0x06, 0x48, 0x94, 0xab, 0xd5, 0xda, 0x96, 0x78, 0x14, 0xb6, 0xb2, 0xa9,
-		0x36, 0xe5, 0x08, 0x49, 0x56, 0x6b, 0xd7, 0x6b, 0xd5, 0xa6, 0x1c, 0x45, 0xb3, 0x90, 0x63, 0xbf,
-		0xb5, 0xab, 0x9b, 0xea, 0x7a, 0xb9, 0x29, 0xc7, 0x02, 0x49, 0x8d, 0xda, 0xc6, 0x4a, 0x4d, 0x95,
-		0xe3, 0xca, 0x33, 0x70, 0x7a, 0xec, 0x1c, 0xee, 0x07, 0x66, 0xa4, 0x40, 0x60, 0x46, 0xf9, 0x70,
-		0x84, 0x2c, 0x6a, 0xc6, 0x4d, 0xcc, 0xe8, 0xfa, 0x40, 0xc3, 0x2f, 0x1e, 0x63, 0x56, 0x1f, 0x68,
-		0x3d, 0x59, 0xc7, 0xf4, 0xf0, 0x2e, 0x76, 0x8d, 0x7d, 0xe6, 0x28, 0x30, 0x0b, 0x94, 0x53, 0x73,
-		0x3c, 0x95, 0x32, 0x39, 0x8c, 0xec, 0x75, 0x6c, 0xb8, 0x1a, 0x53, 0x22, 0x87, 0xff, 0xa1, 0xe4,
-		0x1c, 0x4b, 0x6d, 0xb0, 0x44, 0xe5, 0x9b, 0x8f, 0x25, 0xcb, 0x34, 0xc4, 0xd5, 0x5a, 0x53, 0xbd,
-		0x29, 0x47, 0x11, 0x82, 0x3c, 0xfd, 0xa9, 0x35, 0x36, 0xca, 0x5b, 0x8d, 0xd5, 0x4d, 0x22, 0xcb,
-		0x39, 0x28, 0x08, 0x59, 0x8a, 0xc4, 0xb8, 0xf2, 0x04, 0x9c, 0x1a, 0xe3, 0x55, 0x8c, 0x38, 0xc4,
-		0xfa, 0x71, 0x29, 0x48, 0x1d, 0xf6, 0x0c, 0x36, 0x21, 0xe1, 0xb8, 0xba, 0xdb, 0x77, 0xb8, 0x10,
-		0x9f, 0x9f, 0xd6, 0xcd, 0x58, 0x12, 0x3f, 0x1a, 0x94, 0x5d, 0xe5, 0x30, 0xca, 0x25, 0xc8, 0x87,
-		0x73, 0xc6, 0xcb, 0xc0, 0x57, 0xa2, 0x88, 0x72, 0x05, 0xd0, 0xb0, 0xf7, 0x31, 0x62, 0x79, 0x29,
-		0x8d, 0x5a, 0x5e, 0xfe, 0xb8, 0x04, 0x67, 0x8e, 0xf0, 0x34, 0xd0, 0x2b, 0x03, 0x8d, 0x7c, 0xf1,
-		0x38, 0x7e, 0x0a, 0xfb, 0xab, 0xa4, 0x87, 0x03, 0xcd, 0x7c, 0x16, 0xb2, 0xc1, 0xf4, 0xe9, 0x1a,
-		0xf9, 0xc5, 0x88, 0x3f, 0x88, 0xc3, 0xeb, 0x60, 0xdf, 0x04, 0x4a, 0x5f, 0xa5, 0x09, 0x7c, 0x27,
-		0x80, 0x7b, 0x20, 0x4e, 0x51, 0xf3, 0x79, 0xf4, 0xc1, 0x11, 0xf1, 0x45, 0x6c, 0x34, 0x0f, 0xf8,
-		0x20, 0x48, 0xbb, 0xfc, 0x97, 0x83, 0x1a, 0xc1, 0xa0, 0x40, 0x9f, 0xce, 0xb1, 0x8e, 0xf7, 0x67,
-		0x92, 0xa7, 0x9b, 0x8c, 0xfd, 0xe0, 0x01, 0x4b, 0x76, 0xd0, 0x4d, 0x38, 0x35, 0xe0, 0x28, 0x78,
-		0xd0, 0xb1, 0x69, 0xfd, 0x85, 0x13, 0x61, 0x7f, 0x41, 0x40, 0x07, 0x67, 0xfb, 0x78, 0x78, 0xb6,
-		0xbf, 0x09, 0xe0, 0x07, 0x07, 0xfc, 0x63, 0x7f, 0x52, 0xf0, 0xd8, 0xdf, 0x25, 0x88, 0x13, 0x4d,
-		0x12, 0x72, 0x1a, 0x36, 0xc5, 0x44, 0x13, 0x02, 0xc1, 0x05, 0x46, 0xad, 0x98, 0x80, 0x86, 0x03,
-		0xb4, 0x63, 0x8a, 0x78, 0x57, 0xb8, 0x88, 0x87, 0xc6, 0x86, 0x7a, 0x47, 0x17, 0xf5, 0x06, 0xc4,
-		0x69, 0xcf, 0x8f, 0xbc, 0x4b, 0xf9, 0x4d, 0x00, 0xba, 0xeb, 0xf6, 0xcc, 0x9d, 0xbe, 0x5f, 0xc0,
-		0xc2, 0x68, 0xcd, 0x29, 0x0b, 0xba, 0xca, 0x03, 0x5c, 0x85, 0xe6, 0x7d, 0xd6, 0x80, 0x1a, 0x05,
-		0x00, 0x95, 0x0d, 0xc8, 0x87, 0x79, 0x47, 0xdf, 0x0d, 0xf5, 0x1f, 0x57, 0x49, 0x0b, 0xff, 0xc6,
-		0xf3, 0x8e, 0xf8, 0x83, 0x47, 0xf4, 0x43, 0x79, 0x7f, 0x04, 0xb2, 0x41, 0xc5, 0xfb, 0xeb, 0xe7,
-		0x82, 0x28, 0xdf, 0x26, 0x41, 0xca, 0x6b, 0xfe, 0x11, 0x87, 0x9d, 0xfd, 0x3b, 0xc2, 0xde, 0xe6,
-		0x05, 0xdb, 0x26, 0x8a, 0x7a, 0xdb, 0x44, 0x57, 0xbc, 0xe9, 0x6f, 0x5c, 0x40, 0x24, 0x28, 0x6b,
-		0x71, 0xe0, 0x90, 0xcf, 0xf6, 0x57, 0xa6, 0xbb, 0x8a, 0x37, 0x0f, 0xf1, 0xe0, 0x35, 0x3a, 0xf6,
-		0xa1, 0xb4, 0x02, 0x27, 0x8c, 0xd8, 0x40, 0x0c, 0xde, 0xd9, 0x93, 0x8e, 0x7d, 0x67, 0xcf, 0x2b,
-		0x25, 0x12, 0x2c, 0xe5, 0x7b, 0x25, 0x48, 0x89, 0x31, 0x81, 0xde, 0x1d, 0x3c, 0x79, 0x26, 0xb6,
-		0x36, 0xc7, 0xda, 0x23, 0x8e, 0x1f, 0x38, 0x78, 0x36, 0x74, 0xe0, 0x3a, 0x7a, 0xdc, 0x03, 0xd7,
-		0xdc, 0xb3, 0xfb, 0x33, 0x09, 0xe4, 0xc1, 0x11, 0xfb, 0x55, 0xd7, 0x6e, 0x78, 0x9a, 0x8b, 0x8e,
-		0x98, 0xe6, 0xc6, 0x9d, 0xa2, 0x8e, 0x8d, 0x3b, 0x45, 0x3d, 0xdc, 0xea, 0xf8, 0x7d, 0xb6, 0xfa,
-		0x03, 0x11, 0xc8, 0x04, 0xc2, 0xa3, 0xe8, 0xb9, 0xd0, 0x89, 0xec, 0xc5, 0xa3, 0x42, 0xa9, 0x81,
-		0x23, 0xd9, 0x21, 0x31, 0x45, 0x8e, 0x2f, 0xa6, 0xb7, 0xfe, 0x76, 0xd6, 0xe8, 0x0b, 0xac, 0xf1,
-		0x31, 0x17, 0x58, 0xff, 0x86, 0x04, 0x29, 0xcf, 0xed, 0x3e, 0xee, 0x26, 0xe6, 0x49, 0x48, 0x70,
-		0xcf, 0x92, 0xed, 0x62, 0xf2, 0xaf, 0x91, 0x61, 0xe5, 0x12, 0xa4, 0xc4, 0x5f, 0x40, 0xe4, 0xb3,
-		0x9a, 0xf7, 0x7d, 0xfe, 0x45, 0xc8, 0x04, 0x36, 0x80, 0x89, 0x69, 0xdc, 0xa8, 0xbd, 0x47, 0x9e,
-		0x29, 0x25, 0xef, 0xde, 0x5b, 0x8c, 0x6e, 0xe0, 0x3b, 0x64, 0x34, 0xab, 0xb5, 0xea, 0x6a, 0xad,
-		0xfa, 0xb2, 0x2c, 0x95, 0x32, 0x77, 0xef, 0x2d, 0x26, 0x55, 0x4c, 0x23, 0x8a, 0xe7, 0x5f, 0x86,
-		0xc2, 0x40, 0xc7, 0x84, 0xdd, 0x16, 0x04, 0xf9, 0x95, 0xed, 0xad, 0xb5, 0x7a, 0xb5, 0xdc, 0xac,
-		0x69, 0xec, 0xdc, 0x2e, 0x3a, 0x05, 0x73, 0x6b, 0xf5, 0x6b, 0xab, 0x4d, 0xad, 0xba, 0x56, 0xaf,
-		0x6d, 0x34, 0xb5, 0x72, 0xb3, 0x59, 0xae, 0xbe, 0x2c, 0x47, 0x2e, 0xde, 0xcb, 0x40, 0xac, 0x5c,
-		0xa9, 0xd6, 0x51, 0x15, 0x62, 0x34, 0x14, 0x72, 0xe4, 0x09, 0xb0, 0xd2, 0xd1, 0xb1, 0x61, 0x74,
-		0x15, 0xe2, 0x34, 0x4a, 0x82, 0x8e, 0x3e, 0x12, 0x56, 0x9a, 0x10, 0x2c, 0x26, 0x95, 0xa1, 0x23,
-		0xf2, 0xc8, 0x33, 0x62, 0xa5, 0xa3, 0x63, 0xc7, 0x68, 0x0d, 0x92, 0x62, 0x91, 0x3c, 0xe9, 0xe0,
-		0x56, 0x69, 0x62, 0x40, 0x97, 0x34, 0x8d, 0x05, 0x1b, 0x8e, 0x3e, 0x3e, 0x56, 0x9a, 0x10, 0x55,
-		0x46, 0x75, 0xef, 0x3e, 0xd3, 0x84, 0x13, 0x61, 0xa5, 0x49, 0x71, 0x62, 0xa4, 0x42, 0xda, 0x0f,
-		0xe3, 0x4c, 0x3e, 0x14, 0x57, 0x9a, 0x22, 0x60, 0x8e, 0xde, 0x0b, 0xb9, 0xf0, 0x52, 0x77, 0xba,
-		0x53, 0x67, 0xa5, 0x29, 0x23, 0xd2, 0x04, 0x3f, 0xbc, 0xee, 0x9d, 0xee, 0x14, 0x5a, 0x69, 0xca,
-		0x00, 0x35, 0x7a, 0x1d, 0x66, 0x87, 0xd7, 0xa5, 0xd3, 0x1f, 0x4a, 0x2b, 0x1d, 0x23, 0x64, 0x8d,
-		0x3a, 0x80, 0x46, 0xac, 0x67, 0x8f, 0x71, 0x46, 0xad, 0x74, 0x9c, 0x08, 0x36, 0x6a, 0x41, 0x61,
-		0x70, 0x91, 0x38, 0xed, 0x99, 0xb5, 0xd2, 0xd4, 0xd1, 0x6c, 0x56, 0x4a, 0x78, 0x71, 0x39, 0xed,
-		0x19, 0xb6, 0xd2, 0xd4, 0xc1, 0x6d, 0xb4, 0x0d, 0x10, 0x58, 0x1f, 0x4e, 0x71, 0xa6, 0xad, 0x34,
-		0x4d, 0x98, 0x1b, 0x75, 0x61, 0x6e, 0xd4, 0xc2, 0xf1, 0x38, 0x47, 0xdc, 0x4a, 0xc7, 0x8a, 0x7e,
-		0x13, 0x7d, 0x0e, 0x2f, 0x01, 0xa7, 0x3b, 0xf2, 0x56, 0x9a, 0x32, 0x0c, 0x5e, 0x29, 0x8f, 0x3d,
-		0xe7, 0xfc, 0xd8, 0x91, 0xe7, 0x9c, 0xfd, 0x93, 0xcb, 0xde, 0xd9, 0xe6, 0x5f, 0x7f, 0x0e, 0xde,
-		0xc1, 0x5f, 0xd0, 0x71, 0x5c, 0xfd, 0x96, 0x69, 0xed, 0x79, 0x4f, 0x19, 0xf1, 0x6f, 0x7e, 0xc8,
-		0xf9, 0x24, 0x7f, 0xae, 0x47, 0xa4, 0x4e, 0x78, 0xd0, 0x68, 0xec, 0x2b, 0x8f, 0x93, 0xee, 0x43,
-		0x4c, 0x3e, 0xbe, 0x7c, 0xc4, 0x63, 0x49, 0x13, 0x9e, 0x64, 0x1a, 0xf1, 0x98, 0x52, 0xe9, 0xc8,
-		0x37, 0x06, 0x4a, 0x47, 0x9d, 0xfb, 0x56, 0x7e, 0x40, 0x82, 0xfc, 0xaa, 0xe9, 0xb8, 0x76, 0xcf,
-		0x34, 0xf4, 0x36, 0x9d, 0x5e, 0xae, 0x4c, 0x7b, 0x2b, 0xab, 0x92, 0x26, 0xee, 0x0a, 0x7f, 0x6f,
-		0x89, 0xdf, 0x14, 0x5a, 0x81, 0xc4, 0x6d, 0xbd, 0xcd, 0xee, 0x44, 0x05, 0x1f, 0x5b, 0x1b, 0x94,
-		0x79, 0xc0, 0x8f, 0x0a, 0xa2, 0x30, 0xde, 0xe5, 0x48, 0x51, 0x52, 0xbe, 0x43, 0x02, 0xd9, 0xaf,
-		0x99, 0x8a, 0x0d, 0xbb, 0xd7, 0xa2, 0x4b, 0x81, 0x6e, 0x37, 0x70, 0x56, 0x40, 0x7c, 0x52, 0x97,
-		0xcf, 0xec, 0x60, 0xcf, 0x6f, 0x1b, 0xef, 0x64, 0xc5, 0x02, 0x0e, 0xd6, 0x88, 0x2b, 0xe7, 0xd1,
-		0x51, 0x57, 0xce, 0x95, 0xef, 0xa7, 0x37, 0x5c, 0x3a, 0x1d, 0xd3, 0x21, 0xa3, 0x43, 0xa5, 0x8b,
-		0xfb, 0xeb, 0x10, 0xeb, 0xe9, 0x2e, 0x5f, 0xf2, 0x56, 0x2e, 0x1f, 0xfb, 0xc9, 0x28, 0xd6, 0x66,
-		0x8a, 0x81, 0x5e, 0x81, 0x54, 0x47, 0x3f, 0xd0, 0x28, 0x5e, 0xe4, 0xab, 0xc2, 0x4b, 0x76, 0xf4,
-		0x03, 0x52, 0x3f, 0xf4, 0x5e, 0x28, 0x10, 0x48, 0x63, 0x5f, 0xb7, 0xf6, 0x30, 0x43, 0x8e, 0x7e,
-		0x55, 0xc8, 0xb9, 0x8e, 0x7e, 0x50, 0xa5, 0x68, 0x04, 0x9f, 0x3f, 0xad, 0xf5, 0x6b, 0x12, 0x8f,
-		0x63, 0x50, 0xc1, 0x20, 0x1d, 0x64, 0xc3, 0xfb, 0xa2, 0x85, 0x8a, 0x4d, 0x97, 0xc7, 0xc6, 0x69,
-		0xc2, 0x80, 0x58, 0x2b, 0x39, 0x52, 0xbd, 0xcf, 0xbc, 0xb9, 0x20, 0xb1, 0x52, 0x0b, 0xc6, 0x90,
-		0xd8, 0x33, 0x2c, 0x3c, 0xa3, 0x4d, 0xd9, 0xe1, 0x39, 0xe1, 0x55, 0x33, 0x40, 0x60, 0xdc, 0x24,
-		0x9f, 0xb7, 0xe1, 0x93, 0x12, 0x64, 0x56, 0x02, 0x8f, 0x39, 0x16, 0x21, 0xd9, 0xb1, 0x2d, 0xf3,
-		0x16, 0xee, 0x79, 0xbb, 0x66, 0xec, 0x93, 0x78, 0xbe, 0xec, 0x6f, 0x02, 0xba, 0x87, 0xe2, 0xbd,
-		0x23, 0xf1, 0x4d, 0xb8, 0xee, 0xe0, 0x1d, 0xc7, 0x14, 0x72, 0x56, 0xc5, 0x27, 0x7a, 0x1c, 0x64,
-		0x07, 0x1b, 0xfd, 0x9e, 0xe9, 0x1e, 0x6a, 0x86, 0x6d, 0xb9, 0xba, 0xe1, 0xf2, 0xc8, 0x40, 0x41,
-		0xa4, 0x57, 0x59, 0x32, 0x01, 0x69, 0x61, 0x57, 0x37, 0xdb, 0xec, 0xac, 0x68, 0x5a, 0x15, 0x9f,
-		0xbc, 0xaa, 0x77, 0x93, 0xc1, 0x95, 0x71, 0x15, 0x64, 0xbb, 0x8b, 0x7b, 0xa1, 0x23, 0x32, 0x4c,
-		0x1b, 0x8b, 0xbf, 0xfd, 0xe9, 0xa7, 0xe6, 0xb9, 0xc0, 0xf9, 0xf1, 0x0a, 0xf6, 0xb7, 0x0b, 0xd4,
-		0x82, 0xe0, 0x10, 0x67, 0x67, 0x6e, 0x86, 0xf6, 0xc9, 0xfa, 0x3b, 0xfe, 0xc3, 0x36, 0xf3, 0x43,
-		0x42, 0x2d, 0x5b, 0x87, 0x95, 0xe2, 0x6f, 0xf9, 0xd0, 0xfe, 0xca, 0xf9, 0x65, 0x7c, 0x18, 0xdc,
-		0x34, 0xa3, 0x30, 0x64, 0x51, 0xf1, 0xba, 0x6e, 0xb6, 0xc5, 0x9f, 0x4f, 0x55, 0xf9, 0x17, 0x5a,
-		0xf6, 0x02, 0x9e, 0x31, 0xba, 0x42, 0x53, 0xc6, 0xe9, 0x46, 0xc5, 0xb6, 0x5a, 0xe1, 0xc8, 0x26,
-		0xaa, 0x42, 0xc2, 0xb5, 0x6f, 0x61, 0x8b, 0x0b, 0xa8, 0xf2, 0xc4, 0x31, 0xde, 0x86, 0x53, 0x39,
-		0x2b, 0xfa, 0x46, 0x90, 0x5b, 0xb8, 0x8d, 0xf7, 0xd8, 0x0d, 0xce, 0x7d, 0xbd, 0x87, 0xd9, 0xfb,
-		0x01, 0xf7, 0xf5, 0xf2, 0x5b, 0xc1, 0x83, 0x6a, 0x50, 0x24, 0xb4, 0x15, 0x7e, 0x2e, 0x34, 0xc9,
-		0xb7, 0xb3, 0xc7, 0xb4, 0x31, 0xa0, 0x79, 0x41, 0x5b, 0x18, 0x7a, 0x5e, 0xf4, 0x71, 0x90, 0xfb,
-		0xd6, 0x8e, 0x6d, 0xd1, 0xbf, 0x3a, 0xc8, 0xd7, 0x75, 0x29, 0xb6, 0x77, 0xea, 0xa5, 0xf3, 0xbd,
-		0xd3, 0x2d, 0xc8, 0xfb, 0xa4, 0x74, 0x84, 0xa4, 0x8f, 0x3b, 0x42, 0x72, 0x1e, 0x00, 0x21, 0x41,
-		0xeb, 0x00, 0xfe, 0x18, 0xa4, 0x9b, 0x77, 0x99, 0xf1, 0x3d, 0xe6, 0x8f, 0xe6, 0x60, 0x63, 0x02,
-		0x00, 0xe8, 0x1b, 0x60, 0xae, 0x63, 0x5a, 0x9a, 0x83, 0xdb, 0xbb, 0x1a, 0x97, 0x1c, 0xc1, 0xcd,
-		0x1c, 0xbf, 0x37, 0x67, 0x3b, 0xa6, 0xd5, 0xc0, 0xed, 0xdd, 0x15, 0x0f, 0x05, 0xbd, 0x13, 0xce,
-		0xf8, 0xad, 0xb7, 0x2d, 0x6d, 0xdf, 0x6e, 0xb7, 0xb4, 0x1e, 0xde, 0xd5, 0x0c, 0xfa, 0xba, 0x5f,
-		0x96, 0xca, 0xec, 0x94, 0x47, 0xb2, 0x69, 0xad, 0xda, 0xed, 0x96, 0x8a, 0x77, 0xab, 0x24, 0x1b,
-		0x3d, 0x0c, 0x7e, 0xd3, 0x35, 0xb3, 0xe5, 0x14, 0x73, 0x8b, 0xd1, 0x73, 0x31, 0x35, 0xeb, 0x25,
-		0xd6, 0x5b, 0xce, 0x72, 0xea, 0x83, 0x1f, 0x5b, 0x98, 0xf9, 0xc2, 0xc7, 0x16, 0x66, 0x94, 0xab,
-		0xf4, 0x95, 0x2b, 0x3e, 0x8e, 0xb0, 0x83, 0x2e, 0x43, 0x5a, 0x17, 0x1f, 0xec, 0x0e, 0xdb, 0x11,
-		0xe3, 0xd0, 0x27, 0x55, 0x7e, 0x52, 0x82, 0xc4, 0xca, 0x8d, 0x2d, 0xdd, 0xec, 0xa1, 0x1a, 0xcc,
-		0xfa, 0x8a, 0x39, 0xed, 0x90, 0xf6, 0x75, 0x59, 0x8c, 0xe9, 0x8d, 0x71, 0x87, 0xe7, 0xd2, 0x95,
-		0x87, 0x7e, 0xfb, 0xd3, 0x4f, 0x3d, 0xc8, 0x61, 0x6e, 0x0c, 0x9c, 0xa3, 0x13, 0x78, 0x83, 0xe7,
-		0xeb, 0x02, 0x6d, 0xbe, 0x0e, 0x49, 0x56, 0x55, 0x07, 0xbd, 0x04, 0xf1, 0x2e, 0xf9, 0xc1, 0xf7,
-		0x04, 0xce, 0x8e, 0x55, 0x70, 0x4a, 0x1f, 0x54, 0x07, 0xc6, 0xa7, 0x7c, 0x47, 0x04, 0x60, 0xe5,
-		0xc6, 0x8d, 0x66, 0xcf, 0xec, 0xb6, 0xb1, 0xfb, 0x56, 0xb5, 0x7d, 0x1b, 0x4e, 0x04, 0x6e, 0x67,
-		0xf7, 0x8c, 0xe3, 0xb7, 0x7f, 0xce, 0xbf, 0xa8, 0xdd, 0x33, 0x46, 0xc2, 0xb6, 0x1c, 0xd7, 0x83,
-		0x8d, 0x1e, 0x1f, 0x76, 0xc5, 0x71, 0x87, 0x25, 0xfb, 0x2a, 0x64, 0x7c, 0x61, 0x38, 0xa8, 0x0e,
-		0x29, 0x97, 0xff, 0xe6, 0x02, 0x56, 0xc6, 0x0b, 0x58, 0xb0, 0x05, 0x85, 0xec, 0xb1, 0x2b, 0x7f,
-		0x21, 0x01, 0x04, 0xc6, 0xc8, 0xd7, 0xa7, 0x8e, 0xa1, 0x3a, 0x24, 0xb8, 0x25, 0x8e, 0xde, 0xf7,
-		0x1b, 0x9c, 0x0c, 0x20, 0x20, 0xd4, 0xef, 0x8a, 0xc0, 0xdc, 0xb6, 0x18, 0xbd, 0x5f, 0xff, 0x32,
-		0xd8, 0x86, 0x24, 0xb6, 0xdc, 0x9e, 0xe9, 0xed, 0x6a, 0x3d, 0x3d, 0xae, 0xcf, 0x47, 0x34, 0xaa,
-		0x66, 0xb9, 0xbd, 0xc3, 0xa0, 0x06, 0x08, 0xac, 0x80, 0x3c, 0x3e, 0x12, 0x85, 0xe2, 0x38, 0x56,
-		0xe2, 0x0d, 0x1b, 0x3d, 0x4c, 0x13, 0xc2, 0x97, 0x56, 0xf3, 0x22, 0x99, 0xcf, 0x31, 0x2a, 0x10,
-		0xaf, 0x8c, 0x28, 0x17, 0x21, 0xbd, 0x3f, 0x37, 0x2c, 0xef, 0x23, 0xd0, 0x59, 0xa6, 0x09, 0x05,
-		0x71, 0x0b, 0x66, 0x47, 0x6f, 0xeb, 0x96, 0x21, 0xdc, 0xd5, 0x63, 0x4d, 0x09, 0xe2, 0x26, 0x4d,
-		0x85, 0x41, 0xa0, 0x1a, 0x24, 0x05, 0x5a, 0xec, 0xf8, 0x68, 0x82, 0x17, 0x3d, 0x04, 0xd9, 0xe0,
-		0xc4, 0x40, 0x5d, 0x8f, 0x98, 0x9a, 0x09, 0xcc, 0x0b, 0x93, 0x66, 0x9e, 0xc4, 0x91, 0x33, 0x0f,
-		0xf7, 0xee, 0x7e, 0x84, 0x1e, 0x56, 0x6f, 0xfd, 0xd5, 0xef, 0x96, 0x2d, 0x00, 0x36, 0x54, 0x89,
-		0x25, 0xe5, 0x3d, 0x73, 0x1f, 0xe3, 0x3d, 0xcd, 0x40, 0x56, 0x1c, 0xf7, 0x6b, 0xd5, 0x43, 0xbf,
-		0x1b, 0x81, 0x6c, 0xb0, 0x87, 0xfe, 0x5a, 0x4e, 0x5a, 0x68, 0xc3, 0x37, 0x53, 0xec, 0x42, 0xcf,
-		0xe3, 0xe3, 0xcc, 0xd4, 0x90, 0x36, 0x4f, 0xb0, 0x4f, 0x9f, 0x8f, 0x42, 0x82, 0x9f, 0xb8, 0xdb,
-		0x1c, 0x72, 0x64, 0xa5, 0x49, 0x4f, 0x12, 0xe4, 0xc4, 0x93, 0x04, 0x23, 0xfd, 0xd8, 0x47, 0x20,
-		0x4f, 0x16, 0xc4, 0xa1, 0x63, 0x7c, 0xd2, 0xb9, 0x1c, 0x5d, 0xd7, 0xfa, 0x87, 0xd6, 0xd1, 0x02,
-		0x64, 0x08, 0x99, 0x6f, 0x87, 0x09, 0x0d, 0x74, 0xf4, 0x83, 0x1a, 0x4b, 0x41, 0x4f, 0x01, 0xda,
-		0xf7, 0x02, 0x13, 0x9a, 0x2f, 0x08, 0x42, 0x37, 0xeb, 0xe7, 0x08, 0xf2, 0x07, 0x01, 0x48, 0x2d,
-		0x34, 0xf6, 0xec, 0x34, 0x7f, 0xd4, 0x9b, 0xa4, 0xac, 0xd0, 0xa7, 0xa7, 0xbf, 0x55, 0x62, 0xfe,
-		0xf0, 0xc0, 0xb2, 0x99, 0x2f, 0x47, 0x9a, 0x53, 0x0c, 0x8a, 0x3f, 0x7d, 0x73, 0xa1, 0x74, 0xa8,
-		0x77, 0xda, 0xcb, 0xca, 0x08, 0x1c, 0x65, 0xd4, 0x4a, 0x9e, 0x38, 0xce, 0xe1, 0x65, 0x37, 0xaa,
-		0x83, 0x7c, 0x0b, 0x1f, 0x6a, 0x3d, 0xfe, 0x37, 0xb9, 0xb5, 0x5d, 0x8c, 0xf9, 0xc2, 0xe5, 0xf4,
-		0xd2, 0x88, 0x47, 0xc0, 0x97, 0xaa, 0xb6, 0x69, 0xf1, 0x2d, 0xb0, 0xfc, 0x2d, 0x7c, 0xa8, 0x72,
-		0xbe, 0xab, 0x18, 0x2f, 0xbf, 0x83, 0x8c, 0x94, 0xbb, 0x9f, 0xff, 0x99, 0xf3, 0x67, 0x02, 0x0f,
-		0x5a, 0x1f, 0x78, 0xb1, 0x39, 0xd6, 0xbd, 0xc4, 0xe9, 0x45, 0xfe, 0x04, 0xe4, 0x1d, 0xfb, 0x5f,
-		0x07, 0x08, 0x2c, 0x0a, 0xa4, 0xa3, 0x17, 0x1b, 0x3e, 0x7f, 0x68, 0xb1, 0x11, 0x18, 0x9e, 0xef,
-		0xf6, 0xed, 0x7f, 0x64, 0x52, 0x6b, 0x82, 0x9a, 0xc9, 0x99, 0xe8, 0xa8, 0x9f, 0x51, 0xfe, 0xb5,
-		0x04, 0xa7, 0x87, 0x34, 0xd9, 0xab, 0xb2, 0x01, 0xa8, 0x17, 0xc8, 0xa4, 0x1a, 0x21, 0xf6, 0x99,
-		0xef, 0x6f, 0x60, 0xcc, 0xf6, 0x86, 0x26, 0x81, 0xb7, 0x66, 0x22, 0xe3, 0x56, 0xec, 0x37, 0x25,
-		0x98, 0x0f, 0x56, 0xc0, 0x6b, 0x4a, 0x03, 0xb2, 0xc1, 0xa2, 0x79, 0x23, 0xde, 0x31, 0x4d, 0x23,
-		0x82, 0xf5, 0x0f, 0x81, 0xa0, 0x1b, 0xbe, 0xb5, 0x60, 0x41, 0xc1, 0x67, 0xa6, 0x16, 0x8a, 0xa8,
-		0xd8, 0x48, 0xab, 0xc1, 0xfa, 0xe6, 0x0f, 0x25, 0x88, 0x6d, 0xd9, 0x76, 0x1b, 0xbd, 0x0f, 0x66,
-		0x2d, 0xdb, 0xd5, 0xc8, 0xc8, 0xc2, 0x2d, 0x8d, 0xc7, 0x08, 0x98, 0x25, 0xae, 0x1d, 0x29, 0xab,
-		0x2f, 0xbe, 0xb9, 0x30, 0xcc, 0x39, 0xea, 0x51, 0xf9, 0x82, 0x65, 0xbb, 0x15, 0x4a, 0xd4, 0x64,
-		0x61, 0x84, 0x5d, 0xc8, 0x85, 0x8b, 0x63, 0xd6, 0xba, 0x3c, 0xa9, 0xb8, 0xdc, 0xc4, 0xa2, 0xb2,
-		0x3b, 0x81, 0x72, 0xd8, 0x1b, 0xd1, 0x7f, 0x4c, 0x7a, 0xee, 0x26, 0xc8, 0x37, 0x06, 0xcf, 0x2f,
-		0xd5, 0x20, 0x29, 0xce, 0x2b, 0x49, 0x53, 0x1e, 0x85, 0x0a, 0x8a, 0x93, 0xf3, 0x2a, 0x9f, 0x89,
-		0xc0, 0xe9, 0xaa, 0x6d, 0x39, 0x3c, 0x98, 0xc3, 0x07, 0x34, 0x0b, 0xc1, 0x1e, 0xa2, 0xc7, 0xc7,
-		0x84, 0x9a, 0xb2, 0xc3, 0x01, 0xa5, 0x1b, 0x50, 0x20, 0x33, 0xab, 0x61, 0x5b, 0x5f, 0x65, 0x3c,
-		0x29, 0x67, 0xb7, 0x5b, 0xbc, 0x46, 0xb7, 0xf0, 0x21, 0xc1, 0xb5, 0xf0, 0x9d, 0x10, 0x6e, 0xf4,
-		0xfe, 0x70, 0x2d, 0x7c, 0x27, 0x80, 0xeb, 0x6f, 0x95, 0xc7, 0x42, 0x5b, 0xe5, 0x97, 0x21, 0x4a,
-		0xac, 0x60, 0xfc, 0x18, 0x76, 0x83, 0x30, 0x04, 0x66, 0xb3, 0x06, 0x9c, 0xe6, 0x01, 0x02, 0x67,
-		0x73, 0x97, 0x4a, 0x14, 0xd3, 0x06, 0xbd, 0x8c, 0x0f, 0x47, 0x44, 0x0b, 0xb2, 0x53, 0x45, 0x0b,
-		0xce, 0xff, 0xbc, 0x04, 0xe0, 0xc7, 0xc5, 0xd0, 0x93, 0x70, 0xaa, 0xb2, 0xb9, 0xb1, 0xa2, 0x35,
-		0x9a, 0xe5, 0xe6, 0x76, 0x23, 0xfc, 0x27, 0x63, 0xc4, 0x23, 0x58, 0x4e, 0x17, 0x1b, 0xe6, 0xae,
-		0x89, 0x5b, 0xe8, 0x51, 0x98, 0x0f, 0x53, 0x93, 0xaf, 0xda, 0x8a, 0x2c, 0x95, 0xb2, 0x77, 0xef,
-		0x2d, 0xa6, 0xd8, 0xd2, 0x00, 0xb7, 0xd0, 0x39, 0x38, 0x31, 0x4c, 0x57, 0xdf, 0xb8, 0x26, 0x47,
-		0x4a, 0xb9, 0xbb, 0xf7, 0x16, 0xd3, 0xde, 0x1a, 0x02, 0x29, 0x80, 0x82, 0x94, 0x1c, 0x2f, 0x5a,
-		0x82, 0xbb, 0xf7, 0x16, 0x13, 0x6c, 0xb4, 0x94, 0x62, 0x1f, 0xfc, 0xb1, 0xb3, 0x33, 0xe7, 0xbf,
-		0x09, 0xa0, 0x6e, 0xed, 0xf6, 0x74, 0x83, 0x5a, 0x85, 0x12, 0x9c, 0xac, 0x6f, 0x5c, 0x55, 0xcb,
-		0xd5, 0x66, 0x7d, 0x73, 0x63, 0xe0, 0x2f, 0xdd, 0x84, 0xf3, 0x56, 0x36, 0xb7, 0x2b, 0x6b, 0x35,
-		0xad, 0x51, 0xbf, 0xb6, 0xc1, 0x76, 0xfc, 0x43, 0x79, 0xef, 0xd9, 0x68, 0xd6, 0xd7, 0x6b, 0x72,
-		0xa4, 0x72, 0x79, 0xec, 0x8e, 0xd2, 0x03, 0xa1, 0x71, 0xe8, 0xcf, 0x44, 0xa1, 0x6d, 0xa4, 0xff,
-		0x13, 0x00, 0x00, 0xff, 0xff, 0xb6, 0x1a, 0x0c, 0x35, 0x2d, 0xa5, 0x00, 0x00,
+
+// TokenizeShareRecord represents delegation shares tokenized into fungible share
+// tokens. The shares are delegated by the module account of the record, and the
+// share tokens, of denom {validator_address}/{id}, are redeemable one for one
+// against them. The owner of the record is entitled to the rewards of the shares.
+type TokenizeShareRecord struct {
+	// id is the unique identifier of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address entitled to the rewards of the tokenized shares.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// module_account is the name of the module account delegating the tokenized shares.
+	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
+	// validator_address is the address of the validator the shares are delegated to.
+	ValidatorAddress string `protobuf:"bytes,4,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{25}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	r := bytes.NewReader(gzipped)
-	gzipr, err := compress_gzip.NewReader(r)
-	if err != nil {
-		panic(err)
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	ungzipped, err := io_ioutil.ReadAll(gzipr)
-	if err != nil {
-		panic(err)
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	if err := github_com_cosmos_gogoproto_proto.Unmarshal(ungzipped, d); err != nil {
-		panic(err)
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
 	}
-	return d
+	return ""
 }
-func (this *CommissionRates) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *TokenizeShareRecord) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
+	return ""
+}
 
-	that1, ok := that.(*CommissionRates)
-	if !ok {
-		that2, ok := that.(CommissionRates)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// AutoCompoundSetting records that a delegator opted into the automatic
+// re-delegation of the rewards of its delegation to a validator.
+type AutoCompoundSetting struct {
+	// delegator_address is the encoded address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the encoded address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *AutoCompoundSetting) Reset()         { *m = AutoCompoundSetting{} }
+func (m *AutoCompoundSetting) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundSetting) ProtoMessage()    {}
+func (*AutoCompoundSetting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{26}
+}
+func (m *AutoCompoundSetting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundSetting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundSetting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Rate.Equal(that1.Rate) {
-		return false
-	}
-	if !this.MaxRate.Equal(that1.MaxRate) {
-		return false
-	}
-	if !this.MaxChangeRate.Equal(that1.MaxChangeRate) {
-		return false
+}
+func (m *AutoCompoundSetting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundSetting.Merge(m, src)
+}
+func (m *AutoCompoundSetting) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundSetting) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundSetting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundSetting proto.InternalMessageInfo
+
+func (m *AutoCompoundSetting) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	return true
+	return ""
 }
-func (this *Commission) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *AutoCompoundSetting) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
+	return ""
+}
 
-	that1, ok := that.(*Commission)
-	if !ok {
-		that2, ok := that.(Commission)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// ScheduledCommissionChange defines a commission rate change scheduled by a
+// validator, applied once its notice period has elapsed.
+type ScheduledCommissionChange struct {
+	// validator_address is the encoded address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// new_rate is the commission rate applied at the effective time.
+	NewRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=new_rate,json=newRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"new_rate"`
+	// effective_time is the time from which the change is applied.
+	EffectiveTime time.Time `protobuf:"bytes,3,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time"`
+}
+
+func (m *ScheduledCommissionChange) Reset()         { *m = ScheduledCommissionChange{} }
+func (m *ScheduledCommissionChange) String() string { return proto.CompactTextString(m) }
+func (*ScheduledCommissionChange) ProtoMessage()    {}
+func (*ScheduledCommissionChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{27}
+}
+func (m *ScheduledCommissionChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledCommissionChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledCommissionChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.CommissionRates.Equal(&that1.CommissionRates) {
-		return false
-	}
-	if !this.UpdateTime.Equal(that1.UpdateTime) {
-		return false
+}
+func (m *ScheduledCommissionChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledCommissionChange.Merge(m, src)
+}
+func (m *ScheduledCommissionChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledCommissionChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledCommissionChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledCommissionChange proto.InternalMessageInfo
+
+func (m *ScheduledCommissionChange) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return true
+	return ""
 }
-func (this *Description) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ScheduledCommissionChange) GetEffectiveTime() time.Time {
+	if m != nil {
+		return m.EffectiveTime
 	}
+	return time.Time{}
+}
 
-	that1, ok := that.(*Description)
-	if !ok {
-		that2, ok := that.(Description)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// ValidatorPerformanceCounters defines the performance statistics of a
+// validator accumulated over a bucket of consecutive blocks.
+type ValidatorPerformanceCounters struct {
+	// signed_blocks is the number of blocks signed by the validator.
+	SignedBlocks uint64 `protobuf:"varint,1,opt,name=signed_blocks,json=signedBlocks,proto3" json:"signed_blocks,omitempty"`
+	// missed_blocks is the number of blocks missed by the validator while it was
+	// in the validator set.
+	MissedBlocks uint64 `protobuf:"varint,2,opt,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks,omitempty"`
+	// proposed_blocks is the number of blocks proposed by the validator.
+	ProposedBlocks uint64 `protobuf:"varint,3,opt,name=proposed_blocks,json=proposedBlocks,proto3" json:"proposed_blocks,omitempty"`
+}
+
+func (m *ValidatorPerformanceCounters) Reset()         { *m = ValidatorPerformanceCounters{} }
+func (m *ValidatorPerformanceCounters) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformanceCounters) ProtoMessage()    {}
+func (*ValidatorPerformanceCounters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{28}
+}
+func (m *ValidatorPerformanceCounters) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPerformanceCounters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformanceCounters.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Moniker != that1.Moniker {
-		return false
-	}
-	if this.Identity != that1.Identity {
-		return false
-	}
-	if this.Website != that1.Website {
-		return false
-	}
-	if this.SecurityContact != that1.SecurityContact {
-		return false
+}
+func (m *ValidatorPerformanceCounters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformanceCounters.Merge(m, src)
+}
+func (m *ValidatorPerformanceCounters) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPerformanceCounters) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformanceCounters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPerformanceCounters proto.InternalMessageInfo
+
+func (m *ValidatorPerformanceCounters) GetSignedBlocks() uint64 {
+	if m != nil {
+		return m.SignedBlocks
 	}
-	if this.Details != that1.Details {
-		return false
+	return 0
+}
+
+func (m *ValidatorPerformanceCounters) GetMissedBlocks() uint64 {
+	if m != nil {
+		return m.MissedBlocks
 	}
-	return true
+	return 0
 }
-func (this *UnbondingDelegationEntry) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ValidatorPerformanceCounters) GetProposedBlocks() uint64 {
+	if m != nil {
+		return m.ProposedBlocks
 	}
+	return 0
+}
 
-	that1, ok := that.(*UnbondingDelegationEntry)
-	if !ok {
-		that2, ok := that.(UnbondingDelegationEntry)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// ValidatorPerformance defines the performance statistics of a validator over
+// the recent blocks.
+type ValidatorPerformance struct {
+	// validator_address is the encoded address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// start_height is the first height covered by the statistics.
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// signed_blocks is the number of blocks signed by the validator.
+	SignedBlocks uint64 `protobuf:"varint,3,opt,name=signed_blocks,json=signedBlocks,proto3" json:"signed_blocks,omitempty"`
+	// missed_blocks is the number of blocks missed by the validator while it was
+	// in the validator set.
+	MissedBlocks uint64 `protobuf:"varint,4,opt,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks,omitempty"`
+	// proposed_blocks is the number of blocks proposed by the validator.
+	ProposedBlocks uint64 `protobuf:"varint,5,opt,name=proposed_blocks,json=proposedBlocks,proto3" json:"proposed_blocks,omitempty"`
+}
+
+func (m *ValidatorPerformance) Reset()         { *m = ValidatorPerformance{} }
+func (m *ValidatorPerformance) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformance) ProtoMessage()    {}
+func (*ValidatorPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{29}
+}
+func (m *ValidatorPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.CreationHeight != that1.CreationHeight {
-		return false
-	}
-	if !this.CompletionTime.Equal(that1.CompletionTime) {
-		return false
-	}
-	if !this.InitialBalance.Equal(that1.InitialBalance) {
-		return false
+}
+func (m *ValidatorPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformance.Merge(m, src)
+}
+func (m *ValidatorPerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPerformance proto.InternalMessageInfo
+
+func (m *ValidatorPerformance) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	if !this.Balance.Equal(that1.Balance) {
-		return false
+	return ""
+}
+
+func (m *ValidatorPerformance) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
 	}
-	if this.UnbondingId != that1.UnbondingId {
-		return false
+	return 0
+}
+
+func (m *ValidatorPerformance) GetSignedBlocks() uint64 {
+	if m != nil {
+		return m.SignedBlocks
 	}
-	if this.UnbondingOnHoldRefCount != that1.UnbondingOnHoldRefCount {
-		return false
+	return 0
+}
+
+func (m *ValidatorPerformance) GetMissedBlocks() uint64 {
+	if m != nil {
+		return m.MissedBlocks
 	}
-	return true
+	return 0
 }
-func (this *RedelegationEntry) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ValidatorPerformance) GetProposedBlocks() uint64 {
+	if m != nil {
+		return m.ProposedBlocks
 	}
+	return 0
+}
 
-	that1, ok := that.(*RedelegationEntry)
-	if !ok {
-		that2, ok := that.(RedelegationEntry)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// BondableDenom defines a denom other than the bond denom which can be
+// delegated to the validators, contributing to their voting power.
+type BondableDenom struct {
+	// denom is the bondable denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// weight is the amount of bond denom tokens each token of the denom counts as
+	// towards the voting power.
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+}
+
+func (m *BondableDenom) Reset()         { *m = BondableDenom{} }
+func (m *BondableDenom) String() string { return proto.CompactTextString(m) }
+func (*BondableDenom) ProtoMessage()    {}
+func (*BondableDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{30}
+}
+func (m *BondableDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BondableDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BondableDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *BondableDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BondableDenom.Merge(m, src)
+}
+func (m *BondableDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *BondableDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_BondableDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BondableDenom proto.InternalMessageInfo
+
+func (m *BondableDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if this.CreationHeight != that1.CreationHeight {
-		return false
+	return ""
+}
+
+// MultiAssetPool defines the tokens of a bondable denom delegated to a
+// validator, along with the shares issued for them.
+type MultiAssetPool struct {
+	// validator_address is the encoded address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// denom is the bondable denom of the tokens.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// tokens is the amount of tokens delegated to the validator.
+	Tokens cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=tokens,proto3,customtype=cosmossdk.io/math.Int" json:"tokens"`
+	// shares is the total amount of shares issued to the delegators.
+	Shares cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=shares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"shares"`
+}
+
+func (m *MultiAssetPool) Reset()         { *m = MultiAssetPool{} }
+func (m *MultiAssetPool) String() string { return proto.CompactTextString(m) }
+func (*MultiAssetPool) ProtoMessage()    {}
+func (*MultiAssetPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{31}
+}
+func (m *MultiAssetPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiAssetPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiAssetPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !this.CompletionTime.Equal(that1.CompletionTime) {
-		return false
+}
+func (m *MultiAssetPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiAssetPool.Merge(m, src)
+}
+func (m *MultiAssetPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiAssetPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiAssetPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiAssetPool proto.InternalMessageInfo
+
+func (m *MultiAssetPool) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	if !this.InitialBalance.Equal(that1.InitialBalance) {
-		return false
+	return ""
+}
+
+func (m *MultiAssetPool) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if !this.SharesDst.Equal(that1.SharesDst) {
-		return false
+	return ""
+}
+
+// MultiAssetDelegation defines the shares of the pool of a bondable denom of a
+// validator owned by a delegator.
+type MultiAssetDelegation struct {
+	// delegator_address is the encoded address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the encoded address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// denom is the bondable denom of the delegation.
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// shares is the amount of shares of the pool owned by the delegator.
+	Shares cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=shares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"shares"`
+}
+
+func (m *MultiAssetDelegation) Reset()         { *m = MultiAssetDelegation{} }
+func (m *MultiAssetDelegation) String() string { return proto.CompactTextString(m) }
+func (*MultiAssetDelegation) ProtoMessage()    {}
+func (*MultiAssetDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{32}
+}
+func (m *MultiAssetDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiAssetDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiAssetDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.UnbondingId != that1.UnbondingId {
-		return false
+}
+func (m *MultiAssetDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiAssetDelegation.Merge(m, src)
+}
+func (m *MultiAssetDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiAssetDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiAssetDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiAssetDelegation proto.InternalMessageInfo
+
+func (m *MultiAssetDelegation) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	if this.UnbondingOnHoldRefCount != that1.UnbondingOnHoldRefCount {
-		return false
+	return ""
+}
+
+func (m *MultiAssetDelegation) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return true
+	return ""
 }
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *MultiAssetDelegation) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
+	return ""
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// MultiAssetUnbonding defines tokens of a bondable denom unbonding from a
+// validator.
+type MultiAssetUnbonding struct {
+	// id is the unique id of the unbonding.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// delegator_address is the encoded address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the encoded address of the validator.
+	ValidatorAddress string `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// balance is the amount of tokens released to the delegator at completion.
+	Balance types2.Coin `protobuf:"bytes,4,opt,name=balance,proto3" json:"balance"`
+	// creation_height is the height the unbonding started at.
+	CreationHeight int64 `protobuf:"varint,5,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+	// completion_time is the time the unbonding completes at.
+	CompletionTime time.Time `protobuf:"bytes,6,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+}
+
+func (m *MultiAssetUnbonding) Reset()         { *m = MultiAssetUnbonding{} }
+func (m *MultiAssetUnbonding) String() string { return proto.CompactTextString(m) }
+func (*MultiAssetUnbonding) ProtoMessage()    {}
+func (*MultiAssetUnbonding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{33}
+}
+func (m *MultiAssetUnbonding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiAssetUnbonding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiAssetUnbonding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *MultiAssetUnbonding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiAssetUnbonding.Merge(m, src)
+}
+func (m *MultiAssetUnbonding) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiAssetUnbonding) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiAssetUnbonding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiAssetUnbonding proto.InternalMessageInfo
+
+func (m *MultiAssetUnbonding) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if this.UnbondingTime != that1.UnbondingTime {
-		return false
+	return 0
+}
+
+func (m *MultiAssetUnbonding) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
 	}
-	if this.MaxValidators != that1.MaxValidators {
-		return false
+	return ""
+}
+
+func (m *MultiAssetUnbonding) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	if this.MaxEntries != that1.MaxEntries {
-		return false
+	return ""
+}
+
+func (m *MultiAssetUnbonding) GetBalance() types2.Coin {
+	if m != nil {
+		return m.Balance
 	}
-	if this.HistoricalEntries != that1.HistoricalEntries {
+	return types2.Coin{}
+}
+
+func (m *MultiAssetUnbonding) GetCreationHeight() int64 {
+	if m != nil {
+		return m.CreationHeight
+	}
+	return 0
+}
+
+func (m *MultiAssetUnbonding) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
+// MaxValidatorsChange defines a change of the MaxValidators param scheduled by
+// governance at a future height.
+type MaxValidatorsChange struct {
+	// height is the height at the end of which the change is applied.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// max_validators is the maximum number of validators applied at the height.
+	MaxValidators uint32 `protobuf:"varint,2,opt,name=max_validators,json=maxValidators,proto3" json:"max_validators,omitempty"`
+}
+
+func (m *MaxValidatorsChange) Reset()         { *m = MaxValidatorsChange{} }
+func (m *MaxValidatorsChange) String() string { return proto.CompactTextString(m) }
+func (*MaxValidatorsChange) ProtoMessage()    {}
+func (*MaxValidatorsChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{34}
+}
+func (m *MaxValidatorsChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaxValidatorsChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaxValidatorsChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaxValidatorsChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaxValidatorsChange.Merge(m, src)
+}
+func (m *MaxValidatorsChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaxValidatorsChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaxValidatorsChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaxValidatorsChange proto.InternalMessageInfo
+
+func (m *MaxValidatorsChange) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MaxValidatorsChange) GetMaxValidators() uint32 {
+	if m != nil {
+		return m.MaxValidators
+	}
+	return 0
+}
+
+// RedelegationExemption defines a validator consolidation approved by
+// governance, during which the delegators of the source validator can
+// redelegate from it without the transitive redelegation and maximum entries
+// restrictions.
+type RedelegationExemption struct {
+	// validator_src_address is the address of the validator the delegators can
+	// redelegate from.
+	ValidatorSrcAddress string `protobuf:"bytes,1,opt,name=validator_src_address,json=validatorSrcAddress,proto3" json:"validator_src_address,omitempty"`
+	// validator_dst_address is the address of the only validator the delegators
+	// can redelegate to without restrictions. Empty allows any validator.
+	ValidatorDstAddress string `protobuf:"bytes,2,opt,name=validator_dst_address,json=validatorDstAddress,proto3" json:"validator_dst_address,omitempty"`
+	// expiry is the time after which the exemption no longer applies.
+	Expiry time.Time `protobuf:"bytes,3,opt,name=expiry,proto3,stdtime" json:"expiry"`
+}
+
+func (m *RedelegationExemption) Reset()         { *m = RedelegationExemption{} }
+func (m *RedelegationExemption) String() string { return proto.CompactTextString(m) }
+func (*RedelegationExemption) ProtoMessage()    {}
+func (*RedelegationExemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{35}
+}
+func (m *RedelegationExemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedelegationExemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedelegationExemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedelegationExemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedelegationExemption.Merge(m, src)
+}
+func (m *RedelegationExemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedelegationExemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedelegationExemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedelegationExemption proto.InternalMessageInfo
+
+func (m *RedelegationExemption) GetValidatorSrcAddress() string {
+	if m != nil {
+		return m.ValidatorSrcAddress
+	}
+	return ""
+}
+
+func (m *RedelegationExemption) GetValidatorDstAddress() string {
+	if m != nil {
+		return m.ValidatorDstAddress
+	}
+	return ""
+}
+
+func (m *RedelegationExemption) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
+	proto.RegisterEnum("cosmos.staking.v1beta1.Infraction", Infraction_name, Infraction_value)
+	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.v1beta1.HistoricalInfo")
+	proto.RegisterType((*HistoricalRecord)(nil), "cosmos.staking.v1beta1.HistoricalRecord")
+	proto.RegisterType((*CommissionRates)(nil), "cosmos.staking.v1beta1.CommissionRates")
+	proto.RegisterType((*Commission)(nil), "cosmos.staking.v1beta1.Commission")
+	proto.RegisterType((*Description)(nil), "cosmos.staking.v1beta1.Description")
+	proto.RegisterType((*Validator)(nil), "cosmos.staking.v1beta1.Validator")
+	proto.RegisterType((*ValAddresses)(nil), "cosmos.staking.v1beta1.ValAddresses")
+	proto.RegisterType((*DVPair)(nil), "cosmos.staking.v1beta1.DVPair")
+	proto.RegisterType((*DVPairs)(nil), "cosmos.staking.v1beta1.DVPairs")
+	proto.RegisterType((*DVVTriplet)(nil), "cosmos.staking.v1beta1.DVVTriplet")
+	proto.RegisterType((*DVVTriplets)(nil), "cosmos.staking.v1beta1.DVVTriplets")
+	proto.RegisterType((*Delegation)(nil), "cosmos.staking.v1beta1.Delegation")
+	proto.RegisterType((*UnbondingDelegation)(nil), "cosmos.staking.v1beta1.UnbondingDelegation")
+	proto.RegisterType((*UnbondingDelegationEntry)(nil), "cosmos.staking.v1beta1.UnbondingDelegationEntry")
+	proto.RegisterType((*RedelegationEntry)(nil), "cosmos.staking.v1beta1.RedelegationEntry")
+	proto.RegisterType((*Redelegation)(nil), "cosmos.staking.v1beta1.Redelegation")
+	proto.RegisterType((*Params)(nil), "cosmos.staking.v1beta1.Params")
+	proto.RegisterType((*DelegationResponse)(nil), "cosmos.staking.v1beta1.DelegationResponse")
+	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
+	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
+	proto.RegisterType((*Pool)(nil), "cosmos.staking.v1beta1.Pool")
+	proto.RegisterType((*ValidatorUpdates)(nil), "cosmos.staking.v1beta1.ValidatorUpdates")
+	proto.RegisterType((*ConsPubKeyRotationHistory)(nil), "cosmos.staking.v1beta1.ConsPubKeyRotationHistory")
+	proto.RegisterType((*ValAddrsOfRotatedConsKeys)(nil), "cosmos.staking.v1beta1.ValAddrsOfRotatedConsKeys")
+	proto.RegisterType((*DelegationPosition)(nil), "cosmos.staking.v1beta1.DelegationPosition")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
+	proto.RegisterType((*AutoCompoundSetting)(nil), "cosmos.staking.v1beta1.AutoCompoundSetting")
+	proto.RegisterType((*ScheduledCommissionChange)(nil), "cosmos.staking.v1beta1.ScheduledCommissionChange")
+	proto.RegisterType((*ValidatorPerformanceCounters)(nil), "cosmos.staking.v1beta1.ValidatorPerformanceCounters")
+	proto.RegisterType((*ValidatorPerformance)(nil), "cosmos.staking.v1beta1.ValidatorPerformance")
+	proto.RegisterType((*BondableDenom)(nil), "cosmos.staking.v1beta1.BondableDenom")
+	proto.RegisterType((*MultiAssetPool)(nil), "cosmos.staking.v1beta1.MultiAssetPool")
+	proto.RegisterType((*MultiAssetDelegation)(nil), "cosmos.staking.v1beta1.MultiAssetDelegation")
+	proto.RegisterType((*MultiAssetUnbonding)(nil), "cosmos.staking.v1beta1.MultiAssetUnbonding")
+	proto.RegisterType((*MaxValidatorsChange)(nil), "cosmos.staking.v1beta1.MaxValidatorsChange")
+	proto.RegisterType((*RedelegationExemption)(nil), "cosmos.staking.v1beta1.RedelegationExemption")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/staking/v1beta1/staking.proto", fileDescriptor_64c30c6cf92913c9)
+}
+
+var fileDescriptor_64c30c6cf92913c9 = []byte{
+	// 2867 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x5d, 0x6c, 0x5c, 0x47,
+	0xf5, 0xf7, 0xdd, 0xdd, 0x38, 0xf6, 0xb1, 0xbd, 0x6b, 0x8f, 0x9d, 0x74, 0xed, 0xa4, 0xb6, 0xb3,
+	0xed, 0xff, 0x9f, 0x34, 0x10, 0xbb, 0x09, 0x28, 0x0f, 0x01, 0x05, 0x79, 0xbd, 0x4e, 0xb3, 0x6d,
+	0xe3, 0x6c, 0xaf, 0x3f, 0x4a, 0xa1, 0x70, 0x35, 0x7b, 0xef, 0xec, 0xee, 0xc5, 0x77, 0xef, 0x6c,
+	0xef, 0xcc, 0xc6, 0x5e, 0x9e, 0x79, 0xa8, 0x52, 0x21, 0x05, 0x21, 0x15, 0x24, 0x14, 0x51, 0x89,
+	0x97, 0xf2, 0x56, 0xa1, 0x8a, 0x77, 0xde, 0x0a, 0x12, 0x52, 0x94, 0x27, 0x84, 0x44, 0x0a, 0xed,
+	0x43, 0x2b, 0x78, 0x41, 0x3c, 0x01, 0x02, 0x09, 0xcd, 0xc7, 0xfd, 0x58, 0xef, 0xba, 0xf1, 0x17,
+	0x28, 0x82, 0x97, 0x64, 0xef, 0xcc, 0x39, 0xbf, 0x39, 0xe7, 0xcc, 0x99, 0x73, 0xce, 0x9c, 0x31,
+	0x3c, 0x6b, 0x53, 0xd6, 0xa4, 0x6c, 0x91, 0x71, 0xbc, 0xe5, 0xfa, 0xf5, 0xc5, 0x3b, 0x97, 0xab,
+	0x84, 0xe3, 0xcb, 0xe1, 0xf7, 0x42, 0x2b, 0xa0, 0x9c, 0xa2, 0xd3, 0x8a, 0x6a, 0x21, 0x1c, 0xd5,
+	0x54, 0x33, 0x53, 0x75, 0x5a, 0xa7, 0x92, 0x64, 0x51, 0xfc, 0x52, 0xd4, 0x33, 0xd3, 0x75, 0x4a,
+	0xeb, 0x1e, 0x59, 0x94, 0x5f, 0xd5, 0x76, 0x6d, 0x11, 0xfb, 0x1d, 0x3d, 0x35, 0xbb, 0x7b, 0xca,
+	0x69, 0x07, 0x98, 0xbb, 0xd4, 0xd7, 0xf3, 0x73, 0xbb, 0xe7, 0xb9, 0xdb, 0x24, 0x8c, 0xe3, 0x66,
+	0x2b, 0xc4, 0x56, 0x92, 0x58, 0x6a, 0x51, 0x2d, 0x96, 0xc6, 0xd6, 0xaa, 0x54, 0x31, 0x23, 0x91,
+	0x1e, 0x36, 0x75, 0x43, 0xec, 0x09, 0xdc, 0x74, 0x7d, 0xba, 0x28, 0xff, 0xd5, 0x43, 0x67, 0x39,
+	0xf1, 0x1d, 0x12, 0x34, 0x5d, 0x9f, 0x2f, 0xf2, 0x4e, 0x8b, 0x30, 0xf5, 0xaf, 0x9e, 0x3d, 0x93,
+	0x98, 0xc5, 0x55, 0xdb, 0x4d, 0x4e, 0x16, 0x7e, 0x60, 0x40, 0xf6, 0xa6, 0xcb, 0x38, 0x0d, 0x5c,
+	0x1b, 0x7b, 0x65, 0xbf, 0x46, 0xd1, 0x97, 0x60, 0xb0, 0x41, 0xb0, 0x43, 0x82, 0xbc, 0x31, 0x6f,
+	0x5c, 0x18, 0xb9, 0x92, 0x5f, 0x88, 0x01, 0x16, 0x14, 0xef, 0x4d, 0x39, 0x5f, 0x1c, 0xfe, 0xe0,
+	0xd1, 0xdc, 0xc0, 0xbb, 0x9f, 0xbc, 0x77, 0xd1, 0x30, 0x35, 0x0b, 0x2a, 0xc1, 0xe0, 0x1d, 0xec,
+	0x31, 0xc2, 0xf3, 0xa9, 0xf9, 0xf4, 0x85, 0x91, 0x2b, 0xe7, 0x16, 0xfa, 0xdb, 0x7c, 0x61, 0x13,
+	0x7b, 0xae, 0x83, 0x39, 0xed, 0x46, 0x51, 0xbc, 0xd7, 0x52, 0x79, 0xa3, 0xf0, 0x96, 0x01, 0xe3,
+	0xb1, 0x64, 0x26, 0xb1, 0x69, 0xe0, 0xa0, 0x3c, 0x9c, 0xc4, 0xad, 0x56, 0x03, 0xb3, 0x86, 0x14,
+	0x6e, 0xd4, 0x0c, 0x3f, 0xd1, 0x17, 0x21, 0x23, 0x8c, 0x9c, 0x4f, 0x49, 0x99, 0x67, 0x16, 0xd4,
+	0x0e, 0x2c, 0x84, 0x3b, 0xb0, 0xb0, 0x1e, 0xee, 0x40, 0x31, 0x73, 0xef, 0xc3, 0x39, 0xc3, 0x94,
+	0xd4, 0xe8, 0x3c, 0xe4, 0xee, 0x84, 0x82, 0x30, 0x4b, 0xe2, 0xa6, 0x25, 0x6e, 0x36, 0x1e, 0xbe,
+	0x89, 0x59, 0xa3, 0xf0, 0x76, 0x0a, 0x72, 0xcb, 0xb4, 0xd9, 0x74, 0x19, 0x73, 0xa9, 0x6f, 0x62,
+	0x4e, 0x18, 0x7a, 0x11, 0x32, 0x01, 0xe6, 0x44, 0x4a, 0x32, 0x5c, 0xbc, 0x2a, 0xd4, 0xf8, 0xed,
+	0xa3, 0xb9, 0x33, 0x4a, 0x61, 0xe6, 0x6c, 0x2d, 0xb8, 0x74, 0xb1, 0x89, 0x79, 0x63, 0xe1, 0x65,
+	0x52, 0xc7, 0x76, 0xa7, 0x44, 0xec, 0x87, 0xef, 0x5f, 0x02, 0x6d, 0x8f, 0x12, 0xb1, 0x95, 0xce,
+	0x12, 0x03, 0xbd, 0x02, 0x43, 0x4d, 0xbc, 0x63, 0x49, 0xbc, 0xd4, 0x91, 0xf0, 0x4e, 0x36, 0xf1,
+	0x8e, 0x90, 0x0f, 0x7d, 0x13, 0x72, 0x02, 0xd2, 0x6e, 0x60, 0xbf, 0x4e, 0x14, 0x72, 0xfa, 0x48,
+	0xc8, 0x63, 0x4d, 0xbc, 0xb3, 0x2c, 0xd1, 0x04, 0xfe, 0xb5, 0xcc, 0xa7, 0xef, 0xcc, 0x19, 0x85,
+	0x5f, 0x18, 0x00, 0xb1, 0x61, 0x10, 0x86, 0x71, 0x3b, 0xfa, 0x92, 0x8b, 0x32, 0xed, 0x46, 0xe7,
+	0xf7, 0xf2, 0x84, 0x5d, 0x66, 0x2d, 0x8e, 0x09, 0xf1, 0x1e, 0x3c, 0x9a, 0x33, 0xd4, 0xaa, 0x39,
+	0xbb, 0xc7, 0xec, 0x23, 0xed, 0x96, 0x83, 0x39, 0xb1, 0xf6, 0xb9, 0xe1, 0x12, 0xf0, 0xde, 0x87,
+	0x21, 0x20, 0x28, 0x6e, 0x31, 0xaf, 0x75, 0x78, 0xd7, 0x80, 0x91, 0x12, 0x61, 0x76, 0xe0, 0xb6,
+	0xc4, 0x21, 0x16, 0x5e, 0xd6, 0xa4, 0xbe, 0xbb, 0xa5, 0x8f, 0xc0, 0xb0, 0x19, 0x7e, 0xa2, 0x19,
+	0x18, 0x72, 0x1d, 0xe2, 0x73, 0x97, 0x77, 0xd4, 0x36, 0x99, 0xd1, 0xb7, 0xe0, 0xda, 0x26, 0x55,
+	0xe6, 0x86, 0x76, 0x36, 0xc3, 0x4f, 0xf4, 0x1c, 0x8c, 0x33, 0x62, 0xb7, 0x03, 0x97, 0x77, 0x2c,
+	0x9b, 0xfa, 0x1c, 0xdb, 0x3c, 0x9f, 0x91, 0x24, 0xb9, 0x70, 0x7c, 0x59, 0x0d, 0x0b, 0x10, 0x87,
+	0x70, 0xec, 0x7a, 0x2c, 0x7f, 0x42, 0x81, 0xe8, 0x4f, 0x2d, 0xea, 0x1f, 0x4e, 0xc2, 0x70, 0x74,
+	0x74, 0xd0, 0x32, 0x8c, 0xd3, 0x16, 0x09, 0xc4, 0x6f, 0x0b, 0x3b, 0x4e, 0x40, 0x18, 0xd3, 0xde,
+	0x98, 0x7f, 0xf8, 0xfe, 0xa5, 0x29, 0x6d, 0xf0, 0x25, 0x35, 0xb3, 0xc6, 0x03, 0xd7, 0xaf, 0x9b,
+	0xb9, 0x90, 0x43, 0x0f, 0xa3, 0xd7, 0xc4, 0x96, 0xf9, 0x8c, 0xf8, 0xac, 0xcd, 0xac, 0x56, 0xbb,
+	0xba, 0x45, 0x3a, 0xda, 0xa8, 0x53, 0x3d, 0x46, 0x5d, 0xf2, 0x3b, 0xc5, 0xfc, 0xaf, 0x62, 0x68,
+	0x3b, 0xe8, 0xb4, 0x38, 0x5d, 0xa8, 0xb4, 0xab, 0x2f, 0x91, 0x8e, 0x99, 0x8b, 0x70, 0x2a, 0x12,
+	0x06, 0x9d, 0x86, 0xc1, 0x6f, 0x61, 0xd7, 0x23, 0x8e, 0xb4, 0xc8, 0x90, 0xa9, 0xbf, 0xd0, 0x35,
+	0x18, 0x64, 0x1c, 0xf3, 0x36, 0x93, 0x66, 0xc8, 0x5e, 0x29, 0xec, 0xe5, 0x1b, 0x45, 0xea, 0x3b,
+	0x6b, 0x92, 0xd2, 0xd4, 0x1c, 0x68, 0x19, 0x06, 0x39, 0xdd, 0x22, 0xbe, 0x36, 0x50, 0xf1, 0x73,
+	0xda, 0x9b, 0x4f, 0xf5, 0x7a, 0x73, 0xd9, 0xe7, 0x09, 0x3f, 0x2e, 0xfb, 0xdc, 0xd4, 0xac, 0xe8,
+	0x75, 0x18, 0x77, 0x88, 0x47, 0xea, 0xd2, 0x72, 0xac, 0x81, 0x03, 0xc2, 0xf2, 0x83, 0x12, 0xee,
+	0xf2, 0x81, 0x0f, 0x87, 0x99, 0x8b, 0xa0, 0xd6, 0x24, 0x12, 0xaa, 0xc0, 0x88, 0x13, 0xbb, 0x53,
+	0xfe, 0xa4, 0x34, 0xe6, 0x33, 0x7b, 0xe9, 0x98, 0xf0, 0xbc, 0x64, 0x2c, 0x4c, 0x42, 0x08, 0x0f,
+	0x6a, 0xfb, 0x55, 0xea, 0x3b, 0xae, 0x5f, 0xb7, 0x1a, 0xc4, 0xad, 0x37, 0x78, 0x7e, 0x68, 0xde,
+	0xb8, 0x90, 0x36, 0x73, 0xd1, 0xf8, 0x4d, 0x39, 0x8c, 0x2a, 0x90, 0x8d, 0x49, 0xe5, 0x09, 0x19,
+	0x3e, 0xe8, 0x09, 0x19, 0x8b, 0x00, 0x04, 0x09, 0xba, 0x05, 0x10, 0x9f, 0xc1, 0x3c, 0x48, 0xb4,
+	0xc2, 0xe3, 0x4f, 0x73, 0x52, 0x99, 0x04, 0x00, 0xfa, 0x3a, 0x4c, 0x36, 0x5d, 0xdf, 0x62, 0xc4,
+	0xab, 0x59, 0xda, 0x72, 0x02, 0x77, 0xe4, 0xe0, 0xbb, 0x39, 0xd1, 0x74, 0xfd, 0x35, 0xe2, 0xd5,
+	0x4a, 0x11, 0x0a, 0xfa, 0x32, 0x9c, 0x89, 0xb5, 0xa7, 0xbe, 0xd5, 0xa0, 0x9e, 0x63, 0x05, 0xa4,
+	0x66, 0xd9, 0xb4, 0xed, 0xf3, 0xfc, 0xa8, 0xb4, 0xd9, 0x53, 0x11, 0xc9, 0x6d, 0xff, 0x26, 0xf5,
+	0x1c, 0x93, 0xd4, 0x96, 0xc5, 0x34, 0x7a, 0x06, 0x62, 0xd5, 0x2d, 0xd7, 0x61, 0xf9, 0xb1, 0xf9,
+	0xf4, 0x85, 0x8c, 0x39, 0x1a, 0x0d, 0x96, 0x1d, 0x86, 0x36, 0x00, 0x35, 0xdb, 0x1e, 0x77, 0x2d,
+	0xcc, 0x18, 0xe1, 0x96, 0x76, 0xc6, 0xac, 0x14, 0xff, 0xfc, 0x7e, 0x45, 0x1f, 0x97, 0x10, 0x4b,
+	0x02, 0x61, 0x5d, 0x02, 0x5c, 0x1b, 0x7a, 0xf3, 0x9d, 0xb9, 0x81, 0x4f, 0xdf, 0x99, 0x1b, 0x28,
+	0xdc, 0x80, 0xd1, 0x4d, 0xec, 0xe9, 0xe3, 0x49, 0x18, 0xba, 0x0a, 0xc3, 0x38, 0xfc, 0xc8, 0x1b,
+	0xf3, 0xe9, 0xcf, 0x3c, 0xde, 0x31, 0x69, 0xe1, 0xa7, 0x06, 0x0c, 0x96, 0x36, 0x2b, 0xd8, 0x0d,
+	0xd0, 0x0a, 0x4c, 0xc4, 0xfe, 0xbe, 0xdf, 0x48, 0x11, 0x1f, 0x11, 0x3d, 0x8e, 0x56, 0x61, 0x22,
+	0xca, 0x8b, 0x11, 0x8c, 0x4a, 0x57, 0xe7, 0x1e, 0xbe, 0x7f, 0xe9, 0x69, 0x0d, 0x13, 0x05, 0xa8,
+	0x5d, 0x78, 0x77, 0x76, 0x8d, 0x27, 0x74, 0x7e, 0x11, 0x4e, 0x2a, 0x51, 0x19, 0xfa, 0x0a, 0x9c,
+	0x68, 0x89, 0x1f, 0x52, 0xd5, 0x91, 0x2b, 0xb3, 0x7b, 0x9e, 0x1b, 0x49, 0x9f, 0xf4, 0x32, 0xc5,
+	0x57, 0x78, 0x2b, 0x05, 0x50, 0xda, 0xdc, 0x5c, 0x0f, 0xdc, 0x96, 0x47, 0xf8, 0x71, 0xe9, 0xbe,
+	0x01, 0xa7, 0x62, 0xdd, 0x59, 0x60, 0x1f, 0x5c, 0xff, 0xc9, 0x88, 0x7f, 0x2d, 0xb0, 0xfb, 0xc2,
+	0x3a, 0x8c, 0x47, 0xb0, 0xe9, 0x83, 0xc3, 0x96, 0x18, 0xef, 0xb5, 0xec, 0x57, 0x61, 0x24, 0x36,
+	0x06, 0x43, 0x65, 0x18, 0xe2, 0xfa, 0xb7, 0x36, 0x70, 0x61, 0x6f, 0x03, 0x87, 0x6c, 0x49, 0x23,
+	0x47, 0xec, 0x85, 0xbf, 0x1a, 0x00, 0x89, 0xa3, 0xf7, 0x64, 0xfa, 0x18, 0x2a, 0xc3, 0xa0, 0x0e,
+	0xf0, 0xe9, 0xc3, 0x06, 0x78, 0x0d, 0x90, 0x30, 0xea, 0x77, 0x53, 0x30, 0xb9, 0x11, 0x06, 0x85,
+	0x27, 0xdf, 0x06, 0x1b, 0x70, 0x92, 0xf8, 0x3c, 0x70, 0xa5, 0x11, 0xc4, 0x9e, 0x3f, 0xbf, 0xd7,
+	0x9e, 0xf7, 0x51, 0x6a, 0xc5, 0xe7, 0x41, 0x27, 0xe9, 0x01, 0x21, 0x56, 0xc2, 0x1e, 0x3f, 0x4a,
+	0x43, 0x7e, 0x2f, 0x56, 0x51, 0x64, 0xdb, 0x01, 0x91, 0x03, 0x61, 0xee, 0x32, 0x64, 0x1c, 0xce,
+	0x86, 0xc3, 0x3a, 0x75, 0x99, 0x20, 0x8a, 0x3d, 0xe1, 0x5c, 0x82, 0xf4, 0x70, 0xd5, 0x5d, 0x36,
+	0x46, 0x90, 0xc9, 0x6b, 0x1d, 0x72, 0xae, 0xef, 0x72, 0x17, 0x7b, 0x56, 0x15, 0x7b, 0xd8, 0xb7,
+	0xc3, 0x2a, 0xf8, 0x40, 0x99, 0x26, 0xab, 0x31, 0x8a, 0x0a, 0x02, 0xad, 0xc0, 0xc9, 0x10, 0x2d,
+	0x73, 0x70, 0xb4, 0x90, 0x17, 0x9d, 0x83, 0xd1, 0x64, 0xbe, 0x91, 0x15, 0x4d, 0xc6, 0x1c, 0x49,
+	0xa4, 0x9b, 0xc7, 0x25, 0xb4, 0xc1, 0xcf, 0x4c, 0x68, 0xba, 0x68, 0xfc, 0x71, 0x1a, 0x26, 0x4c,
+	0xe2, 0xfc, 0xf7, 0x6f, 0x4b, 0x05, 0x40, 0x1d, 0x55, 0x11, 0x49, 0xf3, 0x99, 0xc3, 0x9e, 0xf7,
+	0x61, 0x05, 0x52, 0x62, 0xfc, 0x3f, 0xb5, 0x43, 0xbf, 0x4b, 0xc1, 0x68, 0x72, 0x87, 0xfe, 0x27,
+	0x93, 0x16, 0x5a, 0x8d, 0xc3, 0x54, 0x46, 0x86, 0xa9, 0xe7, 0xf6, 0x0a, 0x53, 0x3d, 0xde, 0xfc,
+	0x98, 0xf8, 0xf4, 0xcf, 0x11, 0x18, 0xac, 0xe0, 0x00, 0x37, 0x19, 0xba, 0xdd, 0x53, 0x1f, 0xab,
+	0xfb, 0xe9, 0x74, 0x8f, 0x33, 0x97, 0x74, 0x53, 0x47, 0xf9, 0xf2, 0x0f, 0xf7, 0x2a, 0x8f, 0xff,
+	0x0f, 0xb2, 0xe2, 0x9e, 0x1d, 0x29, 0xa4, 0x8c, 0x3b, 0x26, 0xaf, 0xcb, 0x91, 0xf6, 0x0c, 0xcd,
+	0xc1, 0x88, 0x20, 0x8b, 0xe3, 0xb0, 0xa0, 0x81, 0x26, 0xde, 0x59, 0x51, 0x23, 0xe8, 0x12, 0xa0,
+	0x46, 0xd4, 0xef, 0xb0, 0x62, 0x43, 0x08, 0xba, 0x89, 0x78, 0x26, 0x24, 0x7f, 0x1a, 0x40, 0x48,
+	0x61, 0x39, 0xc4, 0xa7, 0x4d, 0x7d, 0x59, 0x1c, 0x16, 0x23, 0x25, 0x31, 0x80, 0xbe, 0x63, 0xa8,
+	0x32, 0x7b, 0xd7, 0x6d, 0x5c, 0xdf, 0x72, 0xd6, 0xf7, 0x71, 0x28, 0xfe, 0xf2, 0x68, 0x6e, 0xa6,
+	0x83, 0x9b, 0xde, 0xb5, 0x42, 0x1f, 0x9c, 0x42, 0xbf, 0x06, 0x81, 0xa8, 0xc7, 0xbb, 0x6f, 0xf3,
+	0xa8, 0x0c, 0xe3, 0x5b, 0xa4, 0x63, 0x05, 0x94, 0xab, 0x40, 0x53, 0x23, 0x44, 0xdf, 0x87, 0xa6,
+	0xc3, 0xbd, 0x15, 0x8d, 0xae, 0xc4, 0xf5, 0xc1, 0xf5, 0x8b, 0x19, 0x21, 0x9d, 0x99, 0xdd, 0x22,
+	0x1d, 0x53, 0xf3, 0xdd, 0x20, 0x04, 0x99, 0x30, 0x99, 0x48, 0x8a, 0x9e, 0x47, 0xb7, 0x3d, 0x97,
+	0x89, 0x6b, 0x50, 0x7a, 0x7f, 0x2e, 0x87, 0xe2, 0xb4, 0x18, 0x32, 0xa3, 0x37, 0x60, 0xba, 0xee,
+	0xd1, 0x2a, 0xf6, 0x2c, 0xcf, 0x7d, 0xa3, 0xed, 0x3a, 0x96, 0x76, 0x34, 0xcb, 0xc6, 0xad, 0xfc,
+	0xf0, 0x91, 0xba, 0x25, 0xa7, 0x15, 0xf0, 0xcb, 0x12, 0x77, 0x4d, 0xc1, 0x2e, 0xe3, 0x16, 0xda,
+	0x86, 0xb3, 0xb1, 0x1a, 0x7d, 0x56, 0x85, 0x23, 0xad, 0x3a, 0x1d, 0x61, 0xf7, 0x2c, 0x7c, 0x1d,
+	0xce, 0x0a, 0x07, 0xc4, 0x6d, 0x4e, 0xc5, 0x6e, 0xb6, 0x68, 0xdb, 0x77, 0x98, 0xd5, 0x22, 0x81,
+	0x55, 0xf5, 0xa8, 0xbd, 0x25, 0x2f, 0x60, 0x63, 0x66, 0xbe, 0x89, 0x77, 0x96, 0xda, 0x9c, 0x2e,
+	0x87, 0x14, 0x15, 0x12, 0x14, 0xc5, 0x3c, 0x72, 0x21, 0x2f, 0x1c, 0xa1, 0x8b, 0xdf, 0x0a, 0xc8,
+	0x36, 0x0e, 0x1c, 0x79, 0xaf, 0x1a, 0x2e, 0x3e, 0x7f, 0x80, 0xd8, 0xad, 0xc4, 0x3d, 0xd5, 0x74,
+	0xfd, 0xe4, 0x6a, 0xa6, 0x84, 0x43, 0x14, 0xe6, 0x12, 0xfe, 0xa6, 0x3b, 0x58, 0x3e, 0xe5, 0xae,
+	0x4d, 0x84, 0xc0, 0x2e, 0x75, 0xf2, 0x63, 0x07, 0x3c, 0xb4, 0x67, 0x63, 0x40, 0xd5, 0xc3, 0x5a,
+	0x95, 0x70, 0x15, 0x89, 0x86, 0x38, 0xcc, 0xd4, 0x3c, 0xcc, 0x1a, 0x56, 0xdb, 0x8f, 0x03, 0x8b,
+	0xd5, 0x22, 0x3e, 0xf6, 0x78, 0x27, 0x9f, 0x3d, 0xd2, 0x96, 0xe4, 0x25, 0xf2, 0x46, 0x02, 0xb8,
+	0xa2, 0x70, 0x51, 0x1b, 0xce, 0x88, 0x1d, 0xe9, 0xb3, 0xb2, 0x3c, 0xaa, 0xb9, 0xa3, 0x2d, 0xdb,
+	0xc4, 0x3b, 0x37, 0x76, 0xaf, 0x2c, 0xcf, 0xe4, 0x25, 0x40, 0x2d, 0x12, 0xd4, 0x68, 0xd0, 0x14,
+	0x49, 0xd3, 0xda, 0x76, 0x7d, 0x87, 0x6e, 0xe7, 0xc7, 0x65, 0x66, 0x9b, 0x48, 0xcc, 0xbc, 0x2a,
+	0x27, 0xd0, 0x75, 0x38, 0x53, 0xa3, 0x81, 0x4d, 0x9c, 0x6e, 0x11, 0x89, 0x8f, 0xab, 0xa2, 0xb3,
+	0x33, 0x21, 0x3b, 0x3b, 0xd3, 0x8a, 0x24, 0xb9, 0xd6, 0x8a, 0x22, 0x40, 0xaf, 0xc3, 0xd3, 0xfd,
+	0xf8, 0x71, 0x9b, 0x37, 0xa8, 0x68, 0x7d, 0xe5, 0xd1, 0x63, 0xd2, 0xda, 0x99, 0x5e, 0xec, 0xa5,
+	0x90, 0xf9, 0xda, 0xb3, 0x22, 0x7f, 0xde, 0xfd, 0xe4, 0xbd, 0x8b, 0xda, 0x3e, 0x97, 0x98, 0xb3,
+	0xb5, 0xb8, 0x13, 0x3d, 0x04, 0xa8, 0xa0, 0x2f, 0xae, 0xc2, 0x28, 0x2e, 0x4b, 0x4d, 0xc2, 0x5a,
+	0xd4, 0x67, 0xb2, 0xb3, 0x11, 0x63, 0xea, 0x3c, 0xb0, 0xf7, 0x75, 0x28, 0xa2, 0xec, 0xea, 0x6c,
+	0x24, 0x92, 0xf6, 0xf5, 0xb8, 0x2a, 0x4c, 0x3d, 0x2e, 0xc6, 0x25, 0xf3, 0x95, 0x66, 0x92, 0xb5,
+	0xc0, 0x40, 0xe1, 0xd7, 0x06, 0x4c, 0xf7, 0xe4, 0xb7, 0x48, 0x64, 0x1b, 0x50, 0x40, 0xba, 0xb6,
+	0x81, 0x07, 0x1d, 0x2d, 0xfa, 0xe1, 0xd2, 0xe5, 0x44, 0xb0, 0x7b, 0xf6, 0x98, 0xca, 0x5b, 0x5d,
+	0xdb, 0xfc, 0xd2, 0x80, 0xa9, 0xa4, 0x00, 0x91, 0x2a, 0x6b, 0x30, 0x9a, 0x5c, 0x5a, 0x2b, 0xf1,
+	0xec, 0x7e, 0x94, 0x48, 0xca, 0xdf, 0x05, 0x82, 0x36, 0xe3, 0x1a, 0x42, 0xbd, 0x40, 0x5c, 0xde,
+	0xb7, 0x51, 0x42, 0xc1, 0xfa, 0xd6, 0x12, 0x6a, 0x6f, 0xfe, 0x64, 0x40, 0xa6, 0x42, 0xa9, 0x87,
+	0xde, 0x80, 0x09, 0x9f, 0x72, 0x4b, 0xe4, 0x5b, 0xe2, 0x84, 0x3d, 0x20, 0x55, 0x9f, 0xad, 0x7c,
+	0xa6, 0xad, 0xfe, 0xf8, 0x68, 0xae, 0x97, 0xb3, 0x5f, 0x68, 0xcc, 0xf9, 0x94, 0x17, 0x25, 0x91,
+	0x6a, 0x10, 0xa1, 0x1a, 0x8c, 0x75, 0x2f, 0xa7, 0x6a, 0xb8, 0xa5, 0xc7, 0x2d, 0x37, 0xf6, 0xd8,
+	0xa5, 0x46, 0xab, 0x89, 0x75, 0xae, 0x0d, 0x89, 0x5d, 0xfb, 0xb3, 0xd8, 0xb9, 0xd7, 0x60, 0x3c,
+	0xca, 0xa5, 0x1b, 0xb2, 0x69, 0xce, 0x84, 0x6b, 0xa8, 0xfe, 0x79, 0xd8, 0x3e, 0x98, 0x4f, 0x3e,
+	0x0f, 0x89, 0xf7, 0xa5, 0x85, 0x5d, 0x3c, 0x5d, 0xe6, 0xd4, 0xbc, 0x85, 0x07, 0x29, 0x98, 0x5e,
+	0xa6, 0x3e, 0xd3, 0x9d, 0x63, 0x9d, 0xe6, 0xd5, 0x7b, 0x4f, 0x47, 0xb4, 0x3b, 0xfb, 0xf6, 0xb5,
+	0x47, 0x7b, 0xbb, 0xd7, 0x9b, 0x90, 0x13, 0xf5, 0xb6, 0x4d, 0xfd, 0x23, 0x36, 0xaf, 0xc7, 0xa8,
+	0xe7, 0x68, 0x89, 0x44, 0xeb, 0x7a, 0x13, 0x72, 0x3e, 0xd9, 0xee, 0xc2, 0x4d, 0x1f, 0x0e, 0xd7,
+	0x27, 0xdb, 0x09, 0xdc, 0xd3, 0xe2, 0x75, 0x4d, 0x5e, 0xb6, 0x32, 0x32, 0xe0, 0xea, 0x2f, 0x74,
+	0x15, 0xd2, 0xa2, 0x36, 0x3a, 0x71, 0x80, 0xb8, 0x21, 0x18, 0x12, 0x35, 0xee, 0x1a, 0x4c, 0xeb,
+	0xb6, 0x21, 0xbb, 0x5d, 0x93, 0x16, 0x25, 0x52, 0xa1, 0x97, 0x48, 0xa7, 0x4f, 0x0f, 0x71, 0x74,
+	0x7f, 0x3d, 0xc4, 0xbf, 0x77, 0x05, 0xce, 0x0a, 0x65, 0xae, 0xf8, 0x1f, 0x65, 0x21, 0xe5, 0x3a,
+	0x72, 0x4b, 0x32, 0x66, 0xca, 0x75, 0xd0, 0x02, 0x9c, 0xa0, 0xdb, 0x3e, 0x09, 0xb4, 0x4f, 0xee,
+	0x0d, 0xad, 0xc8, 0xfa, 0x37, 0x38, 0xd2, 0x87, 0x6f, 0x70, 0xac, 0x46, 0x4d, 0x9e, 0xcc, 0x91,
+	0x92, 0x66, 0xd8, 0xe9, 0x51, 0xa7, 0xfd, 0xa1, 0x01, 0x93, 0xf2, 0x50, 0xb8, 0xdf, 0x26, 0xb2,
+	0xb5, 0xaf, 0x5f, 0x21, 0x8f, 0xaa, 0xbd, 0xb8, 0x31, 0x50, 0xa7, 0xed, 0x11, 0x0b, 0xdb, 0xea,
+	0x92, 0xa8, 0x1e, 0x8c, 0xc6, 0xd4, 0xe8, 0x92, 0x1a, 0xec, 0x6f, 0xa4, 0xcc, 0xe1, 0xbb, 0xad,
+	0x4a, 0xa9, 0x9f, 0x19, 0x30, 0x99, 0x2c, 0xb9, 0xd6, 0x08, 0xe7, 0xae, 0x5f, 0x7f, 0x52, 0x5b,
+	0xc4, 0x4a, 0xe8, 0xb7, 0x53, 0x30, 0xbd, 0x66, 0x37, 0x88, 0x30, 0x8f, 0xb3, 0xbc, 0xab, 0x92,
+	0xeb, 0xbf, 0xa6, 0x71, 0x78, 0x6f, 0x7a, 0x05, 0x86, 0xc4, 0xd9, 0x3f, 0x8e, 0xc7, 0x58, 0x9f,
+	0x6c, 0xcb, 0x9a, 0xab, 0x02, 0x59, 0x52, 0xab, 0x11, 0x9b, 0xbb, 0x77, 0xf4, 0xbb, 0x65, 0xfa,
+	0xc0, 0xaf, 0x32, 0x11, 0x40, 0xf4, 0x74, 0x39, 0x50, 0xf8, 0x9e, 0x01, 0x67, 0x23, 0xbd, 0x2a,
+	0x71, 0xed, 0x26, 0x9b, 0x0b, 0x24, 0x60, 0xe2, 0x49, 0x83, 0xb9, 0x75, 0x9f, 0x38, 0xaa, 0xca,
+	0x67, 0xda, 0x6d, 0x47, 0xd5, 0xa0, 0xac, 0xec, 0x25, 0x91, 0xb0, 0x68, 0x4c, 0x94, 0x52, 0x44,
+	0x6a, 0x50, 0x13, 0x9d, 0x87, 0x5c, 0x2b, 0xa0, 0x2d, 0x9a, 0x20, 0x4b, 0x4b, 0xb2, 0x6c, 0x38,
+	0xac, 0x08, 0x0b, 0xff, 0x30, 0x60, 0xaa, 0x9f, 0x4c, 0xc7, 0xbe, 0x4f, 0xe7, 0x60, 0x94, 0x71,
+	0x1c, 0xf0, 0xb0, 0x7d, 0x95, 0x92, 0xad, 0x96, 0x11, 0x39, 0xa6, 0x7b, 0x57, 0x3d, 0xea, 0xa7,
+	0xf7, 0xa3, 0x7e, 0x66, 0x7f, 0xea, 0x9f, 0xe8, 0xab, 0x7e, 0x1b, 0xc6, 0x44, 0xde, 0x16, 0xc5,
+	0xaf, 0xba, 0x8a, 0x4f, 0xc1, 0x09, 0x75, 0x49, 0x57, 0x8f, 0xc9, 0xea, 0x43, 0x84, 0xac, 0xed,
+	0x58, 0xec, 0x23, 0x84, 0x2c, 0x85, 0x52, 0xb8, 0x97, 0x82, 0xec, 0xad, 0xe8, 0x51, 0x49, 0x16,
+	0x29, 0xc7, 0x6d, 0xef, 0x48, 0x91, 0x54, 0x52, 0x91, 0x9b, 0xd1, 0x83, 0x6c, 0xfa, 0x90, 0xb7,
+	0x40, 0xcd, 0x7f, 0xdc, 0x51, 0xbc, 0xf0, 0xfd, 0x14, 0x4c, 0xc5, 0x26, 0x79, 0xf2, 0xdb, 0xf4,
+	0x91, 0x7d, 0xd3, 0xbb, 0x1c, 0xe5, 0x58, 0xad, 0xf2, 0xb7, 0x14, 0x4c, 0xc6, 0x56, 0x89, 0xba,
+	0xf6, 0x3d, 0x59, 0xad, 0xaf, 0x91, 0x52, 0xc7, 0x63, 0xa4, 0x23, 0xa4, 0xfa, 0xeb, 0xdd, 0x77,
+	0x93, 0x83, 0x5e, 0xb2, 0xfa, 0xb5, 0xbd, 0x4f, 0xec, 0xb7, 0xed, 0x3d, 0x78, 0xc4, 0xb6, 0x77,
+	0x61, 0x1d, 0x26, 0x6f, 0x25, 0xbb, 0x82, 0x3a, 0x81, 0xc5, 0x45, 0xa1, 0xea, 0xc0, 0xeb, 0xaf,
+	0x7d, 0xb6, 0x16, 0x0b, 0x6f, 0xa6, 0xe0, 0x54, 0xd7, 0x6d, 0x66, 0x87, 0x34, 0xd5, 0xdf, 0x0d,
+	0xec, 0xd9, 0xff, 0x35, 0xfe, 0x3d, 0xfd, 0xdf, 0xd4, 0x91, 0xfa, 0xbf, 0x4b, 0x30, 0x48, 0x76,
+	0x5a, 0x6e, 0xd0, 0x39, 0x78, 0x72, 0xd4, 0x8c, 0x17, 0x7f, 0x6e, 0x00, 0xc4, 0x7f, 0x34, 0x82,
+	0x3e, 0x0f, 0x4f, 0x15, 0x6f, 0xaf, 0x96, 0xac, 0xb5, 0xf5, 0xa5, 0xf5, 0x8d, 0x35, 0x6b, 0x63,
+	0x75, 0xad, 0xb2, 0xb2, 0x5c, 0xbe, 0x51, 0x5e, 0x29, 0x8d, 0x0f, 0xcc, 0xe4, 0xee, 0xde, 0x9f,
+	0x1f, 0xd9, 0xf0, 0x59, 0x8b, 0xd8, 0x6e, 0xcd, 0x25, 0x0e, 0xfa, 0x7f, 0x98, 0xea, 0xa6, 0x16,
+	0x5f, 0x2b, 0xa5, 0x71, 0x63, 0x66, 0xf4, 0xee, 0xfd, 0xf9, 0x21, 0x75, 0x54, 0x88, 0x83, 0x2e,
+	0xc0, 0xa9, 0x5e, 0xba, 0xf2, 0xea, 0x0b, 0xe3, 0xa9, 0x99, 0xb1, 0xbb, 0xf7, 0xe7, 0x87, 0xe3,
+	0x33, 0x55, 0x00, 0x94, 0xa4, 0xd4, 0x78, 0xe9, 0x19, 0xb8, 0x7b, 0x7f, 0x7e, 0x50, 0xdd, 0xee,
+	0x66, 0x32, 0x6f, 0xfe, 0x64, 0x76, 0xe0, 0xe2, 0x37, 0x00, 0xca, 0x7e, 0x2d, 0xc0, 0xb6, 0xdc,
+	0xb7, 0x19, 0x38, 0x5d, 0x5e, 0xbd, 0x61, 0x2e, 0x2d, 0xaf, 0x97, 0x6f, 0xaf, 0x76, 0x8b, 0xbd,
+	0x6b, 0xae, 0x74, 0x7b, 0xa3, 0xf8, 0xf2, 0x8a, 0xb5, 0x56, 0x7e, 0x61, 0x75, 0xdc, 0x40, 0x4f,
+	0xc1, 0x64, 0xd7, 0xdc, 0xab, 0xab, 0xeb, 0xe5, 0x5b, 0x2b, 0xe3, 0xa9, 0xe2, 0xd5, 0x0f, 0x3e,
+	0x9a, 0x35, 0x1e, 0x7c, 0x34, 0x6b, 0xfc, 0xfe, 0xa3, 0x59, 0xe3, 0xde, 0xc7, 0xb3, 0x03, 0x0f,
+	0x3e, 0x9e, 0x1d, 0xf8, 0xcd, 0xc7, 0xb3, 0x03, 0x5f, 0x3b, 0xdb, 0x15, 0x46, 0xe2, 0xce, 0x89,
+	0xfc, 0x4b, 0xbf, 0xea, 0xa0, 0x34, 0xfd, 0x17, 0xfe, 0x35, 0x00, 0x4d, 0x1e, 0x2b, 0xae, 0x61,
+	0x29, 0x00, 0x00,
+}
+
+func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
+	return StakingDescription()
+}
+func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
+	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
+	var gzipped = []byte{
+		// 14638 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7b, 0x78, 0x5c, 0xc9,
+		0x55, 0x20, 0xee, 0xdb, 0xdd, 0x6a, 0x75, 0x9f, 0x96, 0x5a, 0xad, 0x2b, 0xd9, 0x6e, 0xb7, 0x5f,
+		0x9a, 0xeb, 0x99, 0xb1, 0xc7, 0x13, 0xcb, 0x33, 0x9e, 0xb1, 0x67, 0xa6, 0x3d, 0x8f, 0xb4, 0xa4,
+		0xb6, 0x2d, 0x8f, 0x2c, 0x69, 0x6e, 0x4b, 0x9e, 0x07, 0x49, 0x3a, 0x57, 0xdd, 0x25, 0xa9, 0xc7,
+		0xdd, 0xf7, 0xf6, 0xf4, 0xbd, 0x6d, 0x5b, 0xf3, 0x07, 0xdf, 0x40, 0x78, 0x24, 0x0e, 0x8f, 0x40,
+		0x80, 0x84, 0x24, 0x86, 0x04, 0x7e, 0x90, 0x00, 0x3f, 0xf8, 0x85, 0x24, 0xbc, 0x7e, 0x7c, 0xcb,
+		0xc2, 0x7e, 0xcb, 0x2e, 0xf0, 0xed, 0x23, 0x9b, 0x3f, 0x76, 0xd9, 0xfd, 0x96, 0x2c, 0x49, 0xf8,
+		0x48, 0x36, 0x84, 0xdd, 0x00, 0x61, 0x81, 0xcd, 0xc2, 0xb7, 0xdf, 0xa9, 0xc7, 0x7d, 0xdf, 0x7e,
+		0xc8, 0x9e, 0x30, 0x04, 0xfe, 0x91, 0xba, 0xaa, 0xce, 0x39, 0x55, 0x75, 0xea, 0xd4, 0xa9, 0x53,
+		0xa7, 0x4e, 0xd5, 0x85, 0xcf, 0x5d, 0x80, 0x99, 0x2d, 0xc3, 0xd8, 0x6a, 0x92, 0xd3, 0xed, 0x8e,
+		0x61, 0x19, 0x1b, 0xdd, 0xcd, 0xd3, 0x75, 0x62, 0xd6, 0x3a, 0x8d, 0xb6, 0x65, 0x74, 0x66, 0x69,
+		0x9e, 0x3c, 0xc1, 0x20, 0x66, 0x05, 0x84, 0x72, 0x05, 0x26, 0x2f, 0x34, 0x9a, 0x64, 0xc1, 0x06,
+		0xac, 0x10, 0x4b, 0x7e, 0x1c, 0x12, 0x9b, 0x8d, 0x26, 0xc9, 0x4b, 0x33, 0xf1, 0x13, 0x99, 0x33,
+		0xf7, 0xce, 0xfa, 0x90, 0x66, 0xbd, 0x18, 0xab, 0x98, 0xad, 0x52, 0x0c, 0xe5, 0xef, 0x12, 0x30,
+		0x15, 0x52, 0x2a, 0xcb, 0x90, 0xd0, 0xb5, 0x16, 0x52, 0x94, 0x4e, 0xa4, 0x55, 0xfa, 0x5b, 0xce,
+		0xc3, 0x68, 0x5b, 0xab, 0x5d, 0xd3, 0xb6, 0x48, 0x3e, 0x46, 0xb3, 0x45, 0x52, 0x3e, 0x02, 0x50,
+		0x27, 0x6d, 0xa2, 0xd7, 0x89, 0x5e, 0xdb, 0xc9, 0xc7, 0x67, 0xe2, 0x27, 0xd2, 0xaa, 0x2b, 0x47,
+		0x7e, 0x10, 0x26, 0xdb, 0xdd, 0x8d, 0x66, 0xa3, 0x56, 0x75, 0x81, 0xc1, 0x4c, 0xfc, 0xc4, 0x88,
+		0x9a, 0x63, 0x05, 0x0b, 0x0e, 0xf0, 0x71, 0x98, 0xb8, 0x41, 0xb4, 0x6b, 0x6e, 0xd0, 0x0c, 0x05,
+		0xcd, 0x62, 0xb6, 0x0b, 0x70, 0x1e, 0xc6, 0x5a, 0xc4, 0x34, 0xb5, 0x2d, 0x52, 0xb5, 0x76, 0xda,
+		0x24, 0x9f, 0xa0, 0xbd, 0x9f, 0x09, 0xf4, 0xde, 0xdf, 0xf3, 0x0c, 0xc7, 0x5a, 0xdb, 0x69, 0x13,
+		0xb9, 0x04, 0x69, 0xa2, 0x77, 0x5b, 0x8c, 0xc2, 0x48, 0x04, 0xff, 0xca, 0x7a, 0xb7, 0xe5, 0xa7,
+		0x92, 0x42, 0x34, 0x4e, 0x62, 0xd4, 0x24, 0x9d, 0xeb, 0x8d, 0x1a, 0xc9, 0x27, 0x29, 0x81, 0xe3,
+		0x01, 0x02, 0x15, 0x56, 0xee, 0xa7, 0x21, 0xf0, 0xe4, 0x79, 0x48, 0x93, 0x9b, 0x16, 0xd1, 0xcd,
+		0x86, 0xa1, 0xe7, 0x47, 0x29, 0x91, 0xfb, 0x42, 0x46, 0x91, 0x34, 0xeb, 0x7e, 0x12, 0x0e, 0x9e,
+		0x7c, 0x0e, 0x46, 0x8d, 0xb6, 0xd5, 0x30, 0x74, 0x33, 0x9f, 0x9a, 0x91, 0x4e, 0x64, 0xce, 0x1c,
+		0x0a, 0x15, 0x84, 0x15, 0x06, 0xa3, 0x0a, 0x60, 0x79, 0x11, 0x72, 0xa6, 0xd1, 0xed, 0xd4, 0x48,
+		0xb5, 0x66, 0xd4, 0x49, 0xb5, 0xa1, 0x6f, 0x1a, 0xf9, 0x34, 0x25, 0x70, 0x34, 0xd8, 0x11, 0x0a,
+		0x38, 0x6f, 0xd4, 0xc9, 0xa2, 0xbe, 0x69, 0xa8, 0x59, 0xd3, 0x93, 0x96, 0xf7, 0x41, 0xd2, 0xdc,
+		0xd1, 0x2d, 0xed, 0x66, 0x7e, 0x8c, 0x4a, 0x08, 0x4f, 0xa1, 0xe8, 0x90, 0x7a, 0x03, 0xab, 0xcb,
+		0x8f, 0x33, 0xd1, 0xe1, 0x49, 0xe5, 0xd7, 0x92, 0x30, 0x31, 0x88, 0xf0, 0x9d, 0x87, 0x91, 0x4d,
+		0xec, 0x7f, 0x3e, 0x36, 0x0c, 0x77, 0x18, 0x8e, 0x97, 0xbd, 0xc9, 0x5d, 0xb2, 0xb7, 0x04, 0x19,
+		0x9d, 0x98, 0x16, 0xa9, 0x33, 0x59, 0x89, 0x0f, 0x28, 0x6d, 0xc0, 0x90, 0x82, 0xc2, 0x96, 0xd8,
+		0x95, 0xb0, 0xbd, 0x00, 0x13, 0x76, 0x93, 0xaa, 0x1d, 0x4d, 0xdf, 0x12, 0x52, 0x7b, 0xba, 0x5f,
+		0x4b, 0x66, 0xcb, 0x02, 0x4f, 0x45, 0x34, 0x35, 0x4b, 0x3c, 0x69, 0x79, 0x01, 0xc0, 0xd0, 0x89,
+		0xb1, 0x59, 0xad, 0x93, 0x5a, 0x33, 0x9f, 0x8a, 0xe0, 0xd2, 0x0a, 0x82, 0x04, 0xb8, 0x64, 0xb0,
+		0xdc, 0x5a, 0x53, 0x7e, 0xc2, 0x11, 0xc2, 0xd1, 0x08, 0x19, 0xba, 0xc2, 0xa6, 0x5f, 0x40, 0x0e,
+		0xd7, 0x21, 0xdb, 0x21, 0x38, 0x23, 0x48, 0x9d, 0xf7, 0x2c, 0x4d, 0x1b, 0x31, 0xdb, 0xb7, 0x67,
+		0x2a, 0x47, 0x63, 0x1d, 0x1b, 0xef, 0xb8, 0x93, 0xf2, 0x31, 0xb0, 0x33, 0xaa, 0x54, 0xac, 0x80,
+		0xea, 0xa7, 0x31, 0x91, 0xb9, 0xac, 0xb5, 0x48, 0xe1, 0x55, 0xc8, 0x7a, 0xd9, 0x23, 0x4f, 0xc3,
+		0x88, 0x69, 0x69, 0x1d, 0x8b, 0x4a, 0xe1, 0x88, 0xca, 0x12, 0x72, 0x0e, 0xe2, 0x44, 0xaf, 0x53,
+		0xfd, 0x37, 0xa2, 0xe2, 0x4f, 0xf9, 0xcd, 0x4e, 0x87, 0xe3, 0xb4, 0xc3, 0xf7, 0x07, 0x47, 0xd4,
+		0x43, 0xd9, 0xdf, 0xef, 0xc2, 0x63, 0x30, 0xee, 0xe9, 0xc0, 0xa0, 0x55, 0x2b, 0x3f, 0x9f, 0x80,
+		0xbd, 0xa1, 0xb4, 0xe5, 0x17, 0x60, 0xba, 0xab, 0x37, 0x74, 0x8b, 0x74, 0xda, 0x1d, 0x82, 0x22,
+		0xcb, 0xea, 0xca, 0x7f, 0x71, 0x34, 0x42, 0xe8, 0xd6, 0xdd, 0xd0, 0x8c, 0x8a, 0x3a, 0xd5, 0x0d,
+		0x66, 0xca, 0x2f, 0x42, 0x06, 0xe5, 0x43, 0xeb, 0x68, 0x94, 0x20, 0x9b, 0x8d, 0x67, 0x06, 0xeb,
+		0xf2, 0xec, 0x82, 0x83, 0x39, 0x17, 0x7f, 0xa7, 0x14, 0x53, 0xdd, 0xb4, 0xe4, 0x6d, 0x18, 0xbb,
+		0x4e, 0x3a, 0x8d, 0xcd, 0x46, 0x8d, 0xd1, 0x46, 0x76, 0x66, 0xcf, 0x3c, 0x3e, 0x20, 0xed, 0xab,
+		0x2e, 0xd4, 0x8a, 0xa5, 0x59, 0xa4, 0x08, 0xeb, 0xcb, 0x57, 0xcb, 0xea, 0xe2, 0x85, 0xc5, 0xf2,
+		0x82, 0xea, 0xa1, 0x5c, 0xf8, 0xa4, 0x04, 0x19, 0x57, 0x5b, 0x50, 0x6d, 0xe9, 0xdd, 0xd6, 0x06,
+		0xe9, 0x70, 0x8e, 0xf3, 0x94, 0x7c, 0x10, 0xd2, 0x9b, 0xdd, 0x66, 0x93, 0x89, 0x0d, 0x5b, 0xf3,
+		0x52, 0x98, 0x81, 0x22, 0x83, 0x5a, 0x8a, 0x2b, 0x02, 0xaa, 0xa5, 0xf0, 0xb7, 0x7c, 0x0c, 0x32,
+		0x0d, 0xb3, 0xda, 0x21, 0x6d, 0xa2, 0x59, 0xa4, 0x9e, 0x4f, 0xcc, 0x48, 0x27, 0x52, 0x73, 0xb1,
+		0xbc, 0xa4, 0x42, 0xc3, 0x54, 0x79, 0xae, 0x5c, 0x80, 0x94, 0x90, 0xbd, 0xfc, 0x08, 0x42, 0xa8,
+		0x76, 0x9a, 0x95, 0x71, 0xec, 0xa4, 0x28, 0x63, 0x69, 0xe5, 0x51, 0x98, 0x0c, 0x74, 0x52, 0x9e,
+		0x80, 0xcc, 0x42, 0x79, 0x7e, 0xa9, 0xa4, 0x96, 0xd6, 0x16, 0x57, 0x96, 0x73, 0x7b, 0xe4, 0x2c,
+		0xb8, 0xfa, 0x9d, 0x93, 0x4e, 0xa6, 0x53, 0x5f, 0x1a, 0xcd, 0xbd, 0xf6, 0xda, 0x6b, 0xaf, 0xc5,
+		0x94, 0xdf, 0x4a, 0xc2, 0x74, 0x98, 0x96, 0x0b, 0x55, 0xb8, 0x0e, 0x4f, 0xe2, 0x1e, 0x9e, 0x94,
+		0x60, 0xa4, 0xa9, 0x6d, 0x90, 0x26, 0xed, 0x5c, 0xf6, 0xcc, 0x83, 0x03, 0xe9, 0xd1, 0xd9, 0x25,
+		0x44, 0x51, 0x19, 0xa6, 0xfc, 0x34, 0xe7, 0xdc, 0x08, 0xa5, 0x70, 0x72, 0x30, 0x0a, 0xa8, 0xfd,
+		0x38, 0x97, 0x0f, 0x42, 0x1a, 0xff, 0xb3, 0x61, 0x49, 0xb2, 0x61, 0xc1, 0x0c, 0x3a, 0x2c, 0x05,
+		0x48, 0x51, 0xc5, 0x56, 0x27, 0xf6, 0x90, 0x89, 0x34, 0xaa, 0x82, 0x3a, 0xd9, 0xd4, 0xba, 0x4d,
+		0xab, 0x7a, 0x5d, 0x6b, 0x76, 0x09, 0x55, 0x51, 0x69, 0x75, 0x8c, 0x67, 0x5e, 0xc5, 0x3c, 0xf9,
+		0x28, 0x64, 0x98, 0x1e, 0x6c, 0xe8, 0x75, 0x72, 0x93, 0xae, 0x84, 0x23, 0x2a, 0x53, 0x8d, 0x8b,
+		0x98, 0x83, 0xd5, 0xbf, 0x6c, 0x1a, 0xba, 0x50, 0x26, 0xb4, 0x0a, 0xcc, 0xa0, 0xd5, 0x3f, 0xe6,
+		0x5f, 0x84, 0x0f, 0x87, 0x77, 0x2f, 0xa0, 0xfd, 0x8e, 0xc3, 0x04, 0x85, 0x78, 0x84, 0xcf, 0x55,
+		0xad, 0x99, 0x9f, 0xa4, 0x02, 0x90, 0x65, 0xd9, 0x2b, 0x3c, 0x57, 0xf9, 0xe5, 0x18, 0x24, 0xe8,
+		0x52, 0x30, 0x01, 0x99, 0xb5, 0x17, 0x57, 0xcb, 0xd5, 0x85, 0x95, 0xf5, 0xb9, 0xa5, 0x72, 0x4e,
+		0xc2, 0xa1, 0xa7, 0x19, 0x17, 0x96, 0x56, 0x4a, 0x6b, 0xb9, 0x98, 0x9d, 0x5e, 0x5c, 0x5e, 0x3b,
+		0xf7, 0x68, 0x2e, 0x6e, 0x23, 0xac, 0xb3, 0x8c, 0x84, 0x1b, 0xe0, 0x91, 0x33, 0xb9, 0x11, 0x39,
+		0x07, 0x63, 0x8c, 0xc0, 0xe2, 0x0b, 0xe5, 0x85, 0x73, 0x8f, 0xe6, 0x92, 0xde, 0x9c, 0x47, 0xce,
+		0xe4, 0x46, 0xe5, 0x71, 0x48, 0xd3, 0x9c, 0xb9, 0x95, 0x95, 0xa5, 0x5c, 0xca, 0xa6, 0x59, 0x59,
+		0x53, 0x17, 0x97, 0x2f, 0xe6, 0xd2, 0x36, 0xcd, 0x8b, 0xea, 0xca, 0xfa, 0x6a, 0x0e, 0x6c, 0x0a,
+		0x57, 0xca, 0x95, 0x4a, 0xe9, 0x62, 0x39, 0x97, 0xb1, 0x21, 0xe6, 0x5e, 0x5c, 0x2b, 0x57, 0x72,
+		0x63, 0x9e, 0x66, 0x3d, 0x72, 0x26, 0x37, 0x6e, 0x57, 0x51, 0x5e, 0x5e, 0xbf, 0x92, 0xcb, 0xca,
+		0x93, 0x30, 0xce, 0xaa, 0x10, 0x8d, 0x98, 0xf0, 0x65, 0x9d, 0x7b, 0x34, 0x97, 0x73, 0x1a, 0xc2,
+		0xa8, 0x4c, 0x7a, 0x32, 0xce, 0x3d, 0x9a, 0x93, 0x95, 0x79, 0x18, 0xa1, 0x62, 0x28, 0xcb, 0x90,
+		0x5d, 0x2a, 0xcd, 0x95, 0x97, 0xaa, 0x2b, 0xab, 0x38, 0x69, 0x4a, 0x4b, 0x39, 0xc9, 0xc9, 0x53,
+		0xcb, 0xcf, 0xad, 0x2f, 0xaa, 0xe5, 0x85, 0x5c, 0xcc, 0x9d, 0xb7, 0x5a, 0x2e, 0xad, 0x95, 0x17,
+		0x72, 0x71, 0xa5, 0x06, 0xd3, 0x61, 0x4b, 0x60, 0xe8, 0x14, 0x72, 0xc9, 0x42, 0x2c, 0x42, 0x16,
+		0x28, 0x2d, 0xbf, 0x2c, 0x28, 0x5f, 0x88, 0xc1, 0x54, 0x88, 0x19, 0x10, 0x5a, 0xc9, 0x33, 0x30,
+		0xc2, 0x64, 0x99, 0xa9, 0xe2, 0x07, 0x42, 0xed, 0x09, 0x2a, 0xd9, 0x01, 0xe3, 0x88, 0xe2, 0xb9,
+		0xcd, 0xc6, 0x78, 0x84, 0xd9, 0x88, 0x24, 0x02, 0x02, 0xfb, 0xd6, 0xc0, 0x72, 0xcd, 0x2c, 0x9a,
+		0x73, 0x83, 0x58, 0x34, 0x34, 0x6f, 0xb8, 0x65, 0x7b, 0x24, 0x64, 0xd9, 0x3e, 0x0f, 0x93, 0x01,
+		0x42, 0x03, 0x2f, 0x9f, 0xef, 0x90, 0x20, 0x1f, 0xc5, 0x9c, 0x3e, 0x2a, 0x31, 0xe6, 0x51, 0x89,
+		0xe7, 0xfd, 0x1c, 0xbc, 0x27, 0x7a, 0x10, 0x02, 0x63, 0xfd, 0x51, 0x09, 0xf6, 0x85, 0x6f, 0x0f,
+		0x42, 0xdb, 0xf0, 0x34, 0x24, 0x5b, 0xc4, 0xda, 0x36, 0x84, 0x21, 0x7c, 0x7f, 0x88, 0x79, 0x85,
+		0xc5, 0xfe, 0xc1, 0xe6, 0x58, 0xf2, 0x13, 0xfe, 0xb6, 0x1e, 0x8d, 0xda, 0xac, 0x04, 0x5a, 0xfa,
+		0xae, 0x18, 0xec, 0x0d, 0x25, 0x1e, 0xda, 0xd0, 0xc3, 0x00, 0x0d, 0xbd, 0xdd, 0xb5, 0x98, 0xb1,
+		0xcb, 0x34, 0x71, 0x9a, 0xe6, 0x50, 0xe5, 0x85, 0x5a, 0xb6, 0x6b, 0xd9, 0xe5, 0x6c, 0x11, 0x05,
+		0x96, 0x45, 0x01, 0x1e, 0x77, 0x1a, 0x9a, 0xa0, 0x0d, 0x3d, 0x12, 0xd1, 0xd3, 0x80, 0x60, 0x3e,
+		0x04, 0xb9, 0x5a, 0xb3, 0x41, 0x74, 0xab, 0x6a, 0x5a, 0x1d, 0xa2, 0xb5, 0x1a, 0xfa, 0x16, 0x5b,
+		0x67, 0x8b, 0x23, 0x9b, 0x5a, 0xd3, 0x24, 0xea, 0x04, 0x2b, 0xae, 0x88, 0x52, 0xc4, 0xa0, 0x02,
+		0xd4, 0x71, 0x61, 0x24, 0x3d, 0x18, 0xac, 0xd8, 0xc6, 0x50, 0x7e, 0x20, 0x0d, 0x19, 0xd7, 0x66,
+		0x4a, 0xbe, 0x07, 0xc6, 0x5e, 0xd6, 0xae, 0x6b, 0x55, 0xb1, 0x41, 0x66, 0x9c, 0xc8, 0x60, 0xde,
+		0x2a, 0xcb, 0x92, 0x1f, 0x82, 0x69, 0x0a, 0x62, 0x74, 0x2d, 0xd2, 0xa9, 0xd6, 0x9a, 0x9a, 0x69,
+		0x52, 0xa6, 0xa5, 0x28, 0xa8, 0x8c, 0x65, 0x2b, 0x58, 0x34, 0x2f, 0x4a, 0xe4, 0xb3, 0x30, 0x45,
+		0x31, 0x5a, 0xdd, 0xa6, 0xd5, 0x68, 0x37, 0x49, 0x15, 0xb7, 0xec, 0x66, 0x1e, 0xdc, 0x2d, 0x9b,
+		0x44, 0x88, 0x2b, 0x1c, 0x00, 0x5b, 0x64, 0xca, 0x0b, 0x70, 0x98, 0xa2, 0x6d, 0x11, 0x9d, 0x74,
+		0x34, 0x8b, 0x54, 0xc9, 0x2b, 0x5d, 0xad, 0x69, 0x56, 0x35, 0xbd, 0x5e, 0xdd, 0xd6, 0xcc, 0xed,
+		0xfc, 0xb4, 0x6d, 0x96, 0x1c, 0x40, 0xc0, 0x8b, 0x1c, 0xae, 0x4c, 0xc1, 0x4a, 0x7a, 0xfd, 0x92,
+		0x66, 0x6e, 0xcb, 0x45, 0xd8, 0x47, 0xa9, 0x98, 0x56, 0xa7, 0xa1, 0x6f, 0x55, 0x6b, 0xdb, 0xa4,
+		0x76, 0xad, 0xda, 0xb5, 0x36, 0x1f, 0xcf, 0x1f, 0x74, 0xd7, 0x4f, 0x5b, 0x58, 0xa1, 0x30, 0xf3,
+		0x08, 0xb2, 0x6e, 0x6d, 0x3e, 0x2e, 0x57, 0x60, 0x0c, 0x07, 0xa3, 0xd5, 0x78, 0x95, 0x54, 0x37,
+		0x8d, 0x0e, 0x5d, 0x43, 0xb3, 0x21, 0xaa, 0xc9, 0xc5, 0xc1, 0xd9, 0x15, 0x8e, 0x70, 0xc5, 0xa8,
+		0x93, 0xe2, 0x48, 0x65, 0xb5, 0x5c, 0x5e, 0x50, 0x33, 0x82, 0xca, 0x05, 0xa3, 0x83, 0x02, 0xb5,
+		0x65, 0xd8, 0x0c, 0xce, 0x30, 0x81, 0xda, 0x32, 0x04, 0x7b, 0xcf, 0xc2, 0x54, 0xad, 0xc6, 0xfa,
+		0xdc, 0xa8, 0x55, 0xf9, 0xc6, 0xda, 0xcc, 0xe7, 0x3c, 0xcc, 0xaa, 0xd5, 0x2e, 0x32, 0x00, 0x2e,
+		0xe3, 0xa6, 0xfc, 0x04, 0xec, 0x75, 0x98, 0xe5, 0x46, 0x9c, 0x0c, 0xf4, 0xd2, 0x8f, 0x7a, 0x16,
+		0xa6, 0xda, 0x3b, 0x41, 0x44, 0xd9, 0x53, 0x63, 0x7b, 0xc7, 0x8f, 0xf6, 0x18, 0x4c, 0xb7, 0xb7,
+		0xdb, 0x41, 0xbc, 0x93, 0x6e, 0x3c, 0xb9, 0xbd, 0xdd, 0xf6, 0x23, 0xde, 0x47, 0xbd, 0x2c, 0x1d,
+		0x52, 0xa3, 0xd6, 0xe1, 0x7e, 0x37, 0xb8, 0xab, 0x40, 0x9e, 0x85, 0x5c, 0xad, 0x56, 0x25, 0xba,
+		0xb6, 0xd1, 0x24, 0x55, 0xad, 0x43, 0x74, 0xcd, 0xcc, 0x1f, 0xa5, 0xc0, 0x09, 0xab, 0xd3, 0x25,
+		0x6a, 0xb6, 0x56, 0x2b, 0xd3, 0xc2, 0x12, 0x2d, 0x93, 0x4f, 0xc2, 0xa4, 0xb1, 0xf1, 0x72, 0x8d,
+		0x49, 0x64, 0xb5, 0xdd, 0x21, 0x9b, 0x8d, 0x9b, 0xf9, 0x7b, 0x29, 0x7b, 0x27, 0xb0, 0x80, 0xca,
+		0xe3, 0x2a, 0xcd, 0x96, 0x1f, 0x80, 0x5c, 0xcd, 0xdc, 0xd6, 0x3a, 0x6d, 0xaa, 0x92, 0xcd, 0xb6,
+		0x56, 0x23, 0xf9, 0xfb, 0x18, 0x28, 0xcb, 0x5f, 0x16, 0xd9, 0x38, 0x23, 0xcc, 0x1b, 0x8d, 0x4d,
+		0x4b, 0x50, 0x3c, 0xce, 0x66, 0x04, 0xcd, 0xe3, 0xd4, 0x4e, 0x40, 0x0e, 0x39, 0xe1, 0xa9, 0xf8,
+		0x04, 0x05, 0xcb, 0xb6, 0xb7, 0xdb, 0xee, 0x7a, 0x8f, 0xc1, 0x78, 0x7b, 0xdb, 0x5d, 0xe9, 0x03,
+		0xcc, 0x70, 0x6b, 0x6f, 0xbb, 0x6a, 0x7c, 0x14, 0xf6, 0x21, 0x50, 0x8b, 0x58, 0x5a, 0x5d, 0xb3,
+		0x34, 0x17, 0xf4, 0x9b, 0x28, 0x34, 0xb2, 0xfd, 0x0a, 0x2f, 0xf4, 0xb4, 0xb3, 0xd3, 0xdd, 0xd8,
+		0xb1, 0x05, 0xeb, 0x14, 0x6b, 0x27, 0xe6, 0x09, 0xd1, 0x7a, 0xdd, 0x76, 0x53, 0x4a, 0x11, 0xc6,
+		0xdc, 0x72, 0x2f, 0xa7, 0x81, 0x49, 0x7e, 0x4e, 0x42, 0x23, 0x68, 0x7e, 0x65, 0x01, 0xcd, 0x97,
+		0x97, 0xca, 0xb9, 0x18, 0x9a, 0x51, 0x4b, 0x8b, 0x6b, 0xe5, 0xaa, 0xba, 0xbe, 0xbc, 0xb6, 0x78,
+		0xa5, 0x9c, 0x8b, 0xbb, 0x0c, 0xfb, 0xcb, 0x89, 0xd4, 0xfd, 0xb9, 0xe3, 0xca, 0xaf, 0xc7, 0x21,
+		0xeb, 0xdd, 0x5b, 0xcb, 0x4f, 0xc2, 0x7e, 0xe1, 0x22, 0x33, 0x89, 0x55, 0xbd, 0xd1, 0xe8, 0xd0,
+		0x09, 0xd9, 0xd2, 0xd8, 0xe2, 0x68, 0xcb, 0xcf, 0x34, 0x87, 0xaa, 0x10, 0xeb, 0xf9, 0x46, 0x07,
+		0xa7, 0x5b, 0x4b, 0xb3, 0xe4, 0x25, 0x38, 0xaa, 0x1b, 0x55, 0xd3, 0xd2, 0xf4, 0xba, 0xd6, 0xa9,
+		0x57, 0x1d, 0xe7, 0x64, 0x55, 0xab, 0xd5, 0x88, 0x69, 0x1a, 0x6c, 0x21, 0xb4, 0xa9, 0x1c, 0xd2,
+		0x8d, 0x0a, 0x07, 0x76, 0x56, 0x88, 0x12, 0x07, 0xf5, 0x89, 0x6f, 0x3c, 0x4a, 0x7c, 0x0f, 0x42,
+		0xba, 0xa5, 0xb5, 0xab, 0x44, 0xb7, 0x3a, 0x3b, 0xd4, 0x3e, 0x4f, 0xa9, 0xa9, 0x96, 0xd6, 0x2e,
+		0x63, 0x5a, 0xbe, 0x0a, 0xf7, 0x3b, 0xa0, 0xd5, 0x26, 0xd9, 0xd2, 0x6a, 0x3b, 0x55, 0x6a, 0x8c,
+		0x53, 0x47, 0x4f, 0xb5, 0x66, 0xe8, 0x9b, 0xcd, 0x46, 0xcd, 0x32, 0xf3, 0x19, 0x5b, 0xc7, 0x29,
+		0x0e, 0xc6, 0x12, 0x45, 0xb8, 0x6c, 0x1a, 0x3a, 0xb5, 0xc1, 0xe7, 0x05, 0xf4, 0xeb, 0x37, 0xc2,
+		0xde, 0x51, 0x4a, 0xe4, 0x46, 0x2e, 0x27, 0x52, 0x23, 0xb9, 0xe4, 0xe5, 0x44, 0x2a, 0x99, 0x1b,
+		0xbd, 0x9c, 0x48, 0xa5, 0x72, 0xe9, 0xcb, 0x89, 0x54, 0x3a, 0x07, 0xca, 0x77, 0xa6, 0x61, 0xcc,
+		0xbd, 0x33, 0xc0, 0x8d, 0x56, 0x8d, 0xae, 0x8d, 0x12, 0xd5, 0x9e, 0xc7, 0x7a, 0xee, 0x23, 0x66,
+		0xe7, 0x71, 0xd1, 0x2c, 0x26, 0x99, 0x19, 0xae, 0x32, 0x4c, 0x34, 0x58, 0x50, 0xac, 0x09, 0x33,
+		0x7b, 0x52, 0x2a, 0x4f, 0xc9, 0x17, 0x21, 0xf9, 0xb2, 0x49, 0x69, 0x27, 0x29, 0xed, 0x7b, 0x7b,
+		0xd3, 0xbe, 0x5c, 0xa1, 0xc4, 0xd3, 0x97, 0x2b, 0xd5, 0xe5, 0x15, 0xf5, 0x4a, 0x69, 0x49, 0xe5,
+		0xe8, 0xf2, 0x01, 0x48, 0x34, 0xb5, 0x57, 0x77, 0xbc, 0xcb, 0x2b, 0xcd, 0x92, 0x67, 0x61, 0xa2,
+		0xab, 0xb3, 0x5d, 0x37, 0x0e, 0x15, 0x42, 0x4d, 0xb8, 0xa1, 0xb2, 0x4e, 0xe9, 0x12, 0xc2, 0x0f,
+		0x28, 0x1e, 0x07, 0x20, 0x81, 0x6e, 0x60, 0xef, 0x22, 0x48, 0xb3, 0xe4, 0x13, 0x30, 0x56, 0x27,
+		0x1b, 0xdd, 0xad, 0x6a, 0x87, 0xd4, 0xb5, 0x9a, 0xe5, 0x55, 0xfd, 0x19, 0x5a, 0xa4, 0xd2, 0x12,
+		0xf9, 0x59, 0x48, 0xe3, 0x18, 0xe9, 0x74, 0x8c, 0x27, 0x29, 0x0b, 0x4e, 0xf5, 0x66, 0x01, 0xfb,
+		0xaf, 0x0a, 0x24, 0xd5, 0xc1, 0x97, 0x2f, 0x43, 0xd2, 0xd2, 0x3a, 0x5b, 0xc4, 0xa2, 0x9a, 0x3f,
+		0x7b, 0x66, 0x76, 0x10, 0x4a, 0x6b, 0x14, 0x03, 0xd9, 0x4a, 0x65, 0x94, 0x53, 0x90, 0x2f, 0xc1,
+		0x28, 0xfb, 0x65, 0xe6, 0xa7, 0x66, 0xe2, 0xc3, 0x13, 0x53, 0x05, 0xfa, 0xeb, 0xa8, 0xb3, 0x4e,
+		0xc3, 0x08, 0x15, 0x36, 0x19, 0x80, 0x8b, 0x5b, 0x6e, 0x8f, 0x9c, 0x82, 0xc4, 0xfc, 0x8a, 0x8a,
+		0x7a, 0x2b, 0x07, 0x63, 0x2c, 0xb7, 0xba, 0xba, 0x58, 0x9e, 0x2f, 0xe7, 0x62, 0xca, 0x59, 0x48,
+		0x32, 0x09, 0x42, 0x9d, 0x66, 0xcb, 0x50, 0x6e, 0x0f, 0x4f, 0x72, 0x1a, 0x92, 0x28, 0x5d, 0xbf,
+		0x32, 0x57, 0x56, 0x73, 0x31, 0x65, 0x1d, 0x26, 0x7c, 0x5c, 0x97, 0xf7, 0xc2, 0xa4, 0x5a, 0x5e,
+		0x2b, 0x2f, 0xe3, 0xae, 0xad, 0xba, 0xbe, 0xfc, 0xec, 0xf2, 0xca, 0xf3, 0xe8, 0xf2, 0xf0, 0x64,
+		0x0b, 0x05, 0x29, 0xc9, 0xd3, 0x90, 0x73, 0xb2, 0x2b, 0x2b, 0xeb, 0x2a, 0x6d, 0xcd, 0xf7, 0xc4,
+		0x20, 0xe7, 0x67, 0x9b, 0xbc, 0x1f, 0xa6, 0xd6, 0x4a, 0xea, 0xc5, 0xf2, 0x5a, 0x95, 0xed, 0x44,
+		0x6d, 0xd2, 0xd3, 0x90, 0x73, 0x17, 0x5c, 0x58, 0xa4, 0x1b, 0xed, 0xa3, 0x70, 0xd0, 0x9d, 0x5b,
+		0x7e, 0x61, 0xad, 0xbc, 0x5c, 0xa1, 0x95, 0x97, 0x96, 0x2f, 0xa2, 0xb6, 0xf6, 0xd1, 0x13, 0x7b,
+		0xdf, 0x38, 0x36, 0xd5, 0x4b, 0xaf, 0xbc, 0xb4, 0x90, 0x4b, 0xf8, 0xb3, 0x57, 0x96, 0xcb, 0x2b,
+		0x17, 0x72, 0x23, 0xfe, 0xda, 0xe9, 0x7e, 0x38, 0x29, 0x17, 0x60, 0x9f, 0x3f, 0xb7, 0x5a, 0x5e,
+		0x5e, 0x53, 0x5f, 0xcc, 0x8d, 0xfa, 0x2b, 0xae, 0x94, 0xd5, 0xab, 0x8b, 0xf3, 0xe5, 0x5c, 0x4a,
+		0xde, 0x07, 0xb2, 0xb7, 0x45, 0x6b, 0x97, 0x56, 0x16, 0x72, 0xe9, 0x80, 0x7e, 0x52, 0x4c, 0x18,
+		0x73, 0x6f, 0x4a, 0xbf, 0x21, 0xaa, 0x51, 0x79, 0x7f, 0x0c, 0x32, 0xae, 0x4d, 0x26, 0xee, 0x0e,
+		0xb4, 0x66, 0xd3, 0xb8, 0x51, 0xd5, 0x9a, 0x0d, 0xcd, 0xe4, 0xda, 0x0b, 0x68, 0x56, 0x09, 0x73,
+		0x06, 0xd5, 0x16, 0x83, 0xaf, 0x17, 0xc9, 0x37, 0xe2, 0x7a, 0x31, 0x92, 0x4b, 0x2a, 0x3f, 0x26,
+		0x41, 0xce, 0xbf, 0x7b, 0xf4, 0x75, 0x5f, 0x8a, 0xea, 0xfe, 0x37, 0x64, 0xec, 0x3e, 0x24, 0x41,
+		0xd6, 0xbb, 0x65, 0xf4, 0x35, 0xef, 0x9e, 0xbf, 0xd7, 0xe6, 0xfd, 0x61, 0x0c, 0xc6, 0x3d, 0x1b,
+		0xc5, 0x41, 0x5b, 0xf7, 0x0a, 0x4c, 0x36, 0xea, 0xa4, 0xd5, 0x36, 0x2c, 0x3c, 0x6d, 0xac, 0x36,
+		0xc9, 0x75, 0xd2, 0xcc, 0x2b, 0x54, 0xc5, 0x9f, 0xee, 0xbd, 0x15, 0x9d, 0x5d, 0x74, 0xf0, 0x96,
+		0x10, 0xad, 0x38, 0xb5, 0xb8, 0x50, 0xbe, 0xb2, 0xba, 0xb2, 0x56, 0x5e, 0x9e, 0x7f, 0x51, 0x68,
+		0x17, 0x35, 0xd7, 0xf0, 0x81, 0xbd, 0x8e, 0x4a, 0x7b, 0x15, 0x72, 0xfe, 0x46, 0xa1, 0xae, 0x08,
+		0x69, 0x56, 0x6e, 0x8f, 0x3c, 0x05, 0x13, 0xcb, 0x2b, 0xd5, 0xca, 0xe2, 0x42, 0xb9, 0x5a, 0xbe,
+		0x70, 0xa1, 0x3c, 0xbf, 0x56, 0x61, 0xce, 0x45, 0x1b, 0x7a, 0x2d, 0x17, 0x73, 0xb3, 0xf8, 0x03,
+		0x71, 0x98, 0x0a, 0x69, 0x89, 0x5c, 0xe2, 0x6e, 0x01, 0xe6, 0xa9, 0x38, 0x35, 0x48, 0xeb, 0x67,
+		0xd1, 0x30, 0x5f, 0xd5, 0x3a, 0x16, 0xf7, 0x22, 0x3c, 0x00, 0xc8, 0x25, 0xdd, 0x42, 0x3b, 0xa1,
+		0xc3, 0x9d, 0xb6, 0xcc, 0x57, 0x30, 0xe1, 0xe4, 0x33, 0xbf, 0xed, 0x9b, 0x40, 0x6e, 0x1b, 0x66,
+		0xc3, 0x6a, 0x5c, 0xc7, 0x33, 0x4c, 0xe1, 0xe1, 0x45, 0xdf, 0x41, 0x42, 0xcd, 0x89, 0x92, 0x45,
+		0xdd, 0xb2, 0xa1, 0x75, 0xb2, 0xa5, 0xf9, 0xa0, 0xd1, 0x8e, 0x89, 0xab, 0x39, 0x51, 0x62, 0x43,
+		0xdf, 0x03, 0x63, 0x75, 0xa3, 0x8b, 0x1b, 0x2a, 0x06, 0x87, 0xda, 0x42, 0x52, 0x33, 0x2c, 0xcf,
+		0x06, 0xe1, 0x5b, 0x65, 0xc7, 0xb5, 0x3c, 0xa6, 0x66, 0x58, 0x1e, 0x03, 0x39, 0x0e, 0x13, 0xda,
+		0xd6, 0x56, 0x07, 0x89, 0x0b, 0x42, 0x6c, 0xf3, 0x9f, 0xb5, 0xb3, 0x29, 0x60, 0xe1, 0x32, 0xa4,
+		0x04, 0x1f, 0xd0, 0x1e, 0x46, 0x4e, 0x54, 0xdb, 0xcc, 0xa3, 0x15, 0x43, 0x6f, 0xb3, 0x2e, 0x0a,
+		0xef, 0x81, 0xb1, 0x86, 0x59, 0x75, 0xce, 0x36, 0x63, 0x33, 0xb1, 0x13, 0x29, 0x35, 0xd3, 0x30,
+		0xed, 0x33, 0x12, 0xe5, 0xa3, 0x31, 0xc8, 0x7a, 0x4f, 0x6d, 0xe5, 0x05, 0x48, 0x35, 0x0d, 0x7e,
+		0xc8, 0xc2, 0x42, 0x06, 0x4e, 0xf4, 0x39, 0xe8, 0x9d, 0x5d, 0xe2, 0xf0, 0xaa, 0x8d, 0x59, 0xf8,
+		0x77, 0x12, 0xa4, 0x44, 0xb6, 0xbc, 0x0f, 0x12, 0x6d, 0xcd, 0xda, 0xa6, 0xe4, 0x46, 0xe6, 0x62,
+		0x39, 0x49, 0xa5, 0x69, 0xcc, 0x37, 0xdb, 0x1a, 0x3b, 0x27, 0xe2, 0xf9, 0x98, 0xc6, 0x71, 0x6d,
+		0x12, 0xad, 0x4e, 0x3d, 0x0b, 0x46, 0xab, 0x45, 0x74, 0xcb, 0x14, 0xe3, 0xca, 0xf3, 0xe7, 0x79,
+		0x36, 0x06, 0x0f, 0x58, 0x1d, 0xad, 0xd1, 0xf4, 0xc0, 0x26, 0x28, 0x6c, 0x4e, 0x14, 0xd8, 0xc0,
+		0x45, 0x38, 0x20, 0xe8, 0xd6, 0x89, 0xa5, 0xd5, 0xb6, 0x49, 0xdd, 0x41, 0x4a, 0x52, 0x0f, 0xe2,
+		0x7e, 0x0e, 0xb0, 0xc0, 0xcb, 0x05, 0xae, 0xf2, 0xe9, 0x18, 0x4c, 0x0a, 0x5f, 0x48, 0xdd, 0x66,
+		0xd6, 0x15, 0x00, 0x4d, 0xd7, 0x0d, 0xcb, 0xcd, 0xae, 0xa0, 0x28, 0x07, 0xf0, 0x66, 0x4b, 0x36,
+		0x92, 0xea, 0x22, 0x50, 0xf8, 0x13, 0x09, 0xc0, 0x29, 0x8a, 0xe4, 0xdb, 0x51, 0xc8, 0xf0, 0x33,
+		0x79, 0x1a, 0xd8, 0xc1, 0xdc, 0x67, 0xc0, 0xb2, 0xd0, 0x6b, 0x82, 0x4e, 0xce, 0x0d, 0xb2, 0xd5,
+		0xd0, 0xf9, 0xe9, 0x0c, 0x4b, 0x08, 0x27, 0x67, 0xc2, 0x39, 0x9e, 0x54, 0x21, 0x65, 0x92, 0x96,
+		0xa6, 0x5b, 0x8d, 0x1a, 0x3f, 0x6f, 0x39, 0x37, 0x54, 0xe3, 0x67, 0x2b, 0x1c, 0x5b, 0xb5, 0xe9,
+		0x28, 0x27, 0x20, 0x25, 0x72, 0xd1, 0xf0, 0x5b, 0x5e, 0x59, 0x2e, 0xe7, 0xf6, 0xc8, 0xa3, 0x10,
+		0xaf, 0x94, 0xd7, 0x72, 0x12, 0x6e, 0x62, 0x4b, 0x4b, 0x8b, 0xa5, 0x4a, 0x2e, 0x36, 0xf7, 0xad,
+		0x30, 0x55, 0x33, 0x5a, 0xfe, 0x0a, 0xe7, 0x72, 0x3e, 0x07, 0xa2, 0x79, 0x49, 0x7a, 0xe9, 0x14,
+		0x07, 0xda, 0x32, 0x9a, 0x9a, 0xbe, 0x35, 0x6b, 0x74, 0xb6, 0x9c, 0xb0, 0x18, 0xdc, 0x6b, 0x98,
+		0xae, 0xe0, 0x98, 0xf6, 0xc6, 0x5f, 0x4b, 0xd2, 0x4f, 0xc4, 0xe2, 0x17, 0x57, 0xe7, 0x7e, 0x36,
+		0x56, 0xb8, 0xc8, 0x10, 0x57, 0x45, 0x77, 0x54, 0xb2, 0xd9, 0x24, 0x35, 0x6c, 0x3c, 0xfc, 0xe9,
+		0x83, 0x30, 0xbd, 0x65, 0x6c, 0x19, 0x94, 0xd2, 0x69, 0xfc, 0xc5, 0x1a, 0x21, 0xa7, 0xed, 0xdc,
+		0x42, 0xdf, 0x20, 0x9c, 0xe2, 0x32, 0x4c, 0x71, 0xe0, 0x2a, 0x3d, 0xbe, 0x67, 0xae, 0x0a, 0xb9,
+		0xa7, 0x9f, 0x3c, 0xff, 0x8b, 0x7f, 0x44, 0xad, 0x12, 0x75, 0x92, 0xa3, 0x62, 0x19, 0xf3, 0x66,
+		0x14, 0x55, 0xd8, 0xeb, 0xa1, 0xc7, 0x74, 0x04, 0xe9, 0xf4, 0xa1, 0xf8, 0xdb, 0x9c, 0xe2, 0x94,
+		0x8b, 0x62, 0x85, 0xa3, 0x16, 0xe7, 0x61, 0x7c, 0x18, 0x5a, 0xff, 0x8a, 0xd3, 0x1a, 0x23, 0x6e,
+		0x22, 0x17, 0x61, 0x82, 0x12, 0xa9, 0x75, 0x4d, 0xcb, 0x68, 0x51, 0x05, 0xdc, 0x9b, 0xcc, 0xbf,
+		0xfe, 0x23, 0x36, 0x69, 0xb3, 0x88, 0x36, 0x6f, 0x63, 0x15, 0x8b, 0x40, 0x23, 0x16, 0xf0, 0x74,
+		0xb7, 0x0f, 0x85, 0xdf, 0xe1, 0x0d, 0xb1, 0xe1, 0x8b, 0x57, 0x61, 0x1a, 0x7f, 0x53, 0xfd, 0xe8,
+		0x6e, 0x49, 0x7f, 0xa7, 0x7a, 0xfe, 0x3f, 0xbc, 0x83, 0xe9, 0x85, 0x29, 0x9b, 0x80, 0xab, 0x4d,
+		0xae, 0x51, 0xdc, 0x22, 0x96, 0x45, 0x3a, 0x66, 0x55, 0x6b, 0x86, 0x35, 0xcf, 0xe5, 0x95, 0xcc,
+		0xff, 0xe8, 0x57, 0xbc, 0xa3, 0x78, 0x91, 0x61, 0x96, 0x9a, 0xcd, 0xe2, 0x3a, 0xec, 0x0f, 0x91,
+		0x8a, 0x01, 0x68, 0x7e, 0x80, 0xd3, 0x9c, 0x0e, 0x48, 0x06, 0x92, 0x5d, 0x05, 0x91, 0x6f, 0x8f,
+		0xe5, 0x00, 0x34, 0x3f, 0xc8, 0x69, 0xca, 0x1c, 0x57, 0x0c, 0x29, 0x52, 0xbc, 0x0c, 0x93, 0xd7,
+		0x49, 0x67, 0xc3, 0x30, 0xb9, 0x27, 0x78, 0x00, 0x72, 0x1f, 0xe2, 0xe4, 0x26, 0x38, 0x22, 0x75,
+		0x0d, 0x23, 0xad, 0x27, 0x20, 0xb5, 0xa9, 0xd5, 0xc8, 0x00, 0x24, 0x6e, 0x73, 0x12, 0xa3, 0x08,
+		0x8f, 0xa8, 0x25, 0x18, 0xdb, 0x32, 0xf8, 0x12, 0xd9, 0x1f, 0xfd, 0xc7, 0x38, 0x7a, 0x46, 0xe0,
+		0x70, 0x12, 0x6d, 0xa3, 0xdd, 0x6d, 0xe2, 0xfa, 0xd9, 0x9f, 0xc4, 0x8f, 0x0b, 0x12, 0x02, 0x87,
+		0x93, 0x18, 0x82, 0xad, 0x1f, 0x16, 0x24, 0x4c, 0x17, 0x3f, 0x9f, 0xc1, 0x03, 0xe2, 0xe6, 0x8e,
+		0xa1, 0x0f, 0xd2, 0x88, 0x8f, 0x70, 0x0a, 0xc0, 0x51, 0x90, 0xc0, 0x79, 0x48, 0x0f, 0x3a, 0x10,
+		0x3f, 0xf5, 0x15, 0x31, 0x3d, 0xc4, 0x08, 0x5c, 0x84, 0x09, 0xa1, 0xa0, 0x30, 0x04, 0xa8, 0x3f,
+		0x89, 0x9f, 0xe6, 0x24, 0xb2, 0x2e, 0x34, 0xde, 0x0d, 0x8b, 0x98, 0xd6, 0x16, 0x19, 0x84, 0xc8,
+		0x47, 0x45, 0x37, 0x38, 0x0a, 0x67, 0xe5, 0x06, 0xd1, 0x6b, 0xdb, 0x83, 0x51, 0xf8, 0x98, 0x60,
+		0xa5, 0xc0, 0x41, 0x12, 0xf3, 0x30, 0xde, 0xd2, 0x3a, 0xe6, 0xb6, 0xd6, 0x1c, 0x68, 0x38, 0x7e,
+		0x86, 0xd3, 0x18, 0xb3, 0x91, 0x38, 0x47, 0xba, 0xfa, 0x30, 0x64, 0x7e, 0x56, 0x70, 0xa4, 0xab,
+		0x7b, 0x08, 0xad, 0xc2, 0xb4, 0x69, 0x51, 0xb7, 0xf9, 0x30, 0xd4, 0x7e, 0x4e, 0x4c, 0x3d, 0x86,
+		0x7b, 0xc5, 0x4d, 0xf1, 0x3c, 0xa4, 0xcd, 0xc6, 0xab, 0x03, 0x91, 0xf9, 0x7f, 0xc5, 0x48, 0x53,
+		0x04, 0x44, 0x7e, 0x11, 0x0e, 0x84, 0x2e, 0x13, 0x03, 0x10, 0xfb, 0x79, 0x4e, 0x6c, 0x5f, 0xc8,
+		0x52, 0xc1, 0x55, 0xc2, 0xb0, 0x24, 0x7f, 0x41, 0xa8, 0x04, 0xe2, 0xa3, 0xb5, 0x8a, 0x9b, 0x16,
+		0x53, 0xdb, 0x1c, 0x8e, 0x6b, 0xff, 0x9f, 0xe0, 0x1a, 0xc3, 0xf5, 0x70, 0x6d, 0x0d, 0xf6, 0x71,
+		0x8a, 0xc3, 0x8d, 0xeb, 0xc7, 0x85, 0x62, 0x65, 0xd8, 0xeb, 0xde, 0xd1, 0xfd, 0x16, 0x28, 0xd8,
+		0xec, 0x14, 0xd6, 0xb1, 0x59, 0x45, 0x5f, 0x73, 0x7f, 0xca, 0xbf, 0xc8, 0x29, 0x0b, 0x8d, 0x6f,
+		0x9b, 0xd7, 0xe6, 0x15, 0xad, 0x8d, 0xc4, 0x5f, 0x80, 0xbc, 0x20, 0xde, 0xd5, 0x3b, 0xa4, 0x66,
+		0x6c, 0xe9, 0x8d, 0x57, 0x49, 0x7d, 0x00, 0xd2, 0x9f, 0xf0, 0x0d, 0xd5, 0xba, 0x0b, 0x1d, 0x29,
+		0x2f, 0x42, 0xce, 0xb6, 0x55, 0xaa, 0x8d, 0x56, 0xdb, 0xe8, 0x58, 0x7d, 0x28, 0x7e, 0x52, 0x8c,
+		0x94, 0x8d, 0xb7, 0x48, 0xd1, 0x8a, 0x65, 0x60, 0xb1, 0x24, 0x83, 0x8a, 0xe4, 0xa7, 0x38, 0xa1,
+		0x71, 0x07, 0x8b, 0x2b, 0x8e, 0x9a, 0xd1, 0x6a, 0x6b, 0x9d, 0x41, 0xf4, 0xdf, 0x2f, 0x09, 0xc5,
+		0xc1, 0x51, 0xb8, 0xe2, 0x40, 0x8b, 0x0e, 0x57, 0xfb, 0x01, 0x28, 0xfc, 0xb2, 0x50, 0x1c, 0x02,
+		0x87, 0x93, 0x10, 0x06, 0xc3, 0x00, 0x24, 0x7e, 0x45, 0x90, 0x10, 0x38, 0x48, 0xe2, 0x39, 0x67,
+		0xa1, 0xed, 0x90, 0xad, 0x86, 0x69, 0xf1, 0x60, 0xb0, 0xde, 0xa4, 0x7e, 0xf5, 0x2b, 0x5e, 0x23,
+		0x4c, 0x75, 0xa1, 0xa2, 0x26, 0xe2, 0x07, 0x29, 0x74, 0xcb, 0xd6, 0xbf, 0x61, 0xbf, 0x26, 0x34,
+		0x91, 0x0b, 0x0d, 0xdb, 0xe6, 0xb2, 0x10, 0x91, 0xed, 0x35, 0xdc, 0xa8, 0x0c, 0x40, 0xee, 0xff,
+		0xf7, 0x35, 0xae, 0x22, 0x70, 0x91, 0xa6, 0xcb, 0xfe, 0xe9, 0xea, 0xd7, 0xc8, 0xce, 0x40, 0xd2,
+		0xf9, 0xeb, 0x3e, 0xfb, 0x67, 0x9d, 0x61, 0x32, 0x1d, 0x32, 0xe1, 0xb3, 0xa7, 0xe4, 0x7e, 0xb1,
+		0x9e, 0xf9, 0x6f, 0xfb, 0x1a, 0xef, 0xaf, 0xd7, 0x9c, 0x2a, 0x2e, 0x41, 0x8e, 0xe7, 0x38, 0x06,
+		0x6c, 0x5f, 0x62, 0xef, 0xf8, 0x9a, 0x2d, 0xe7, 0x1e, 0x9b, 0xa7, 0x78, 0x01, 0xc6, 0x3d, 0x06,
+		0x4f, 0x7f, 0x52, 0xdf, 0xc1, 0x49, 0x8d, 0xb9, 0xed, 0x9d, 0xe2, 0x59, 0x48, 0xa0, 0xf1, 0xd2,
+		0x1f, 0xfd, 0x3b, 0x39, 0x3a, 0x05, 0x2f, 0x3e, 0x05, 0x29, 0x61, 0xb4, 0xf4, 0x47, 0xfd, 0x2e,
+		0x8e, 0x6a, 0xa3, 0x20, 0xba, 0x30, 0x58, 0xfa, 0xa3, 0x7f, 0xb7, 0x40, 0x17, 0x28, 0x88, 0x3e,
+		0x38, 0x0b, 0x7f, 0xf3, 0xdd, 0x09, 0x86, 0x2e, 0x50, 0x8a, 0x18, 0xcb, 0xc2, 0x2c, 0x95, 0xfe,
+		0xd8, 0xef, 0xe2, 0x95, 0x0b, 0x8c, 0xe2, 0x63, 0x30, 0x32, 0x20, 0xc3, 0xbf, 0x97, 0xa3, 0x32,
+		0xf8, 0xe2, 0x3c, 0x64, 0x5c, 0xd6, 0x49, 0x7f, 0xf4, 0xef, 0xe3, 0xe8, 0x6e, 0x2c, 0x6c, 0x3a,
+		0xb7, 0x4e, 0xfa, 0x13, 0xf8, 0x7e, 0xd1, 0x74, 0x8e, 0x81, 0x6c, 0x13, 0x86, 0x49, 0x7f, 0xec,
+		0xf7, 0x08, 0xae, 0x0b, 0x94, 0xe2, 0x33, 0x90, 0xb6, 0x17, 0x9b, 0xfe, 0xf8, 0x3f, 0xc0, 0xf1,
+		0x1d, 0x1c, 0xe4, 0x40, 0x57, 0x1f, 0x82, 0xc4, 0x0f, 0x0a, 0x0e, 0xb8, 0xb0, 0x70, 0x1a, 0xf9,
+		0x0d, 0x98, 0xfe, 0x94, 0xde, 0x2b, 0xa6, 0x91, 0xcf, 0x7e, 0xc1, 0xd1, 0xa4, 0x3a, 0xbf, 0x3f,
+		0x89, 0x1f, 0x12, 0xa3, 0x49, 0xe1, 0xb1, 0x19, 0x7e, 0x8b, 0xa0, 0x3f, 0x8d, 0xf7, 0x89, 0x66,
+		0xf8, 0x0c, 0x82, 0xe2, 0x2a, 0xc8, 0x41, 0x6b, 0xa0, 0x3f, 0xbd, 0xf7, 0x73, 0x7a, 0x93, 0x01,
+		0x63, 0xa0, 0xf8, 0x3c, 0xec, 0x0b, 0xb7, 0x04, 0xfa, 0x53, 0xfd, 0xd1, 0xaf, 0xf9, 0xf6, 0x6e,
+		0x6e, 0x43, 0xa0, 0xb8, 0x06, 0xd3, 0x61, 0x56, 0x40, 0x7f, 0xb2, 0x1f, 0xf8, 0x9a, 0x57, 0x71,
+		0xbb, 0x8d, 0x80, 0x62, 0x09, 0xc0, 0x59, 0x80, 0xfb, 0xd3, 0xfa, 0x10, 0xa7, 0xe5, 0x42, 0xc2,
+		0xa9, 0xc1, 0xd7, 0xdf, 0xfe, 0xf8, 0xb7, 0xc5, 0xd4, 0xe0, 0x18, 0x38, 0x35, 0xc4, 0xd2, 0xdb,
+		0x1f, 0xfb, 0xc7, 0xc4, 0xd4, 0x10, 0x28, 0x28, 0xd9, 0xae, 0xd5, 0xad, 0x3f, 0x85, 0x8f, 0x08,
+		0xc9, 0x76, 0x61, 0x15, 0x97, 0x61, 0x32, 0xb0, 0x20, 0xf6, 0x27, 0xf5, 0x13, 0x9c, 0x54, 0xce,
+		0xbf, 0x1e, 0xba, 0x17, 0x2f, 0xbe, 0x18, 0xf6, 0xa7, 0xf6, 0x93, 0xbe, 0xc5, 0x8b, 0xaf, 0x85,
+		0xc5, 0xf3, 0x90, 0xd2, 0xbb, 0xcd, 0x26, 0x4e, 0x1e, 0xb9, 0x77, 0xb4, 0x6f, 0xfe, 0xbf, 0x7f,
+		0x9d, 0x73, 0x47, 0x20, 0x14, 0xcf, 0xc2, 0x08, 0x69, 0x6d, 0x90, 0x7a, 0x3f, 0xcc, 0x2f, 0x7f,
+		0x5d, 0x28, 0x4c, 0x84, 0x2e, 0x3e, 0x03, 0xc0, 0x5c, 0x23, 0xf4, 0x18, 0xbe, 0x0f, 0xee, 0x9f,
+		0x7c, 0x9d, 0x87, 0xd7, 0x39, 0x28, 0x0e, 0x01, 0x16, 0xac, 0xd7, 0x9b, 0xc0, 0x57, 0xbc, 0x04,
+		0xe8, 0x88, 0x3c, 0x01, 0xa3, 0x78, 0x90, 0x66, 0x69, 0x5b, 0xfd, 0xb0, 0xff, 0x94, 0x63, 0x0b,
+		0x78, 0x64, 0x58, 0xcb, 0xe8, 0x10, 0x4b, 0xdb, 0x32, 0xfb, 0xe1, 0xfe, 0x0f, 0x8e, 0x6b, 0x23,
+		0x20, 0x72, 0x4d, 0x33, 0xad, 0x41, 0xfa, 0xfd, 0x3f, 0x05, 0xb2, 0x40, 0xc0, 0x46, 0xe3, 0xef,
+		0x6b, 0x64, 0xa7, 0x1f, 0xee, 0x57, 0x45, 0xa3, 0x39, 0x7c, 0xf1, 0x29, 0x48, 0xe3, 0x4f, 0x16,
+		0x33, 0xdb, 0x07, 0xf9, 0xcf, 0x38, 0xb2, 0x83, 0x81, 0x35, 0x9b, 0x56, 0xdd, 0x6a, 0xf4, 0x67,
+		0xf6, 0x9f, 0xf3, 0x91, 0x16, 0xf0, 0xc5, 0x12, 0x64, 0x4c, 0xab, 0x5e, 0xef, 0x72, 0xfb, 0xb4,
+		0x0f, 0xfa, 0x5f, 0x7c, 0xdd, 0x76, 0x59, 0xd8, 0x38, 0x38, 0xda, 0x37, 0xae, 0x59, 0x6d, 0x83,
+		0x9e, 0xb7, 0xf4, 0xa3, 0xf0, 0x35, 0x4e, 0xc1, 0x85, 0x52, 0x9c, 0x87, 0x31, 0xec, 0x8b, 0xb8,
+		0x8b, 0xd0, 0x8f, 0xc4, 0x5f, 0x72, 0x06, 0x78, 0x90, 0xe6, 0xde, 0xfe, 0x3b, 0x9f, 0x3f, 0x22,
+		0x7d, 0xfa, 0xf3, 0x47, 0xa4, 0x3f, 0xfc, 0xfc, 0x11, 0xe9, 0x3d, 0x5f, 0x38, 0xb2, 0xe7, 0xd3,
+		0x5f, 0x38, 0xb2, 0xe7, 0xf7, 0xbf, 0x70, 0x64, 0x4f, 0xb8, 0x97, 0x18, 0x2e, 0x1a, 0x17, 0x0d,
+		0xe6, 0x1f, 0x7e, 0xe9, 0xbe, 0xad, 0x86, 0xb5, 0xdd, 0xdd, 0x98, 0xad, 0x19, 0xad, 0xd3, 0x35,
+		0xc3, 0x6c, 0x19, 0xe6, 0x69, 0xaf, 0x5f, 0x97, 0xfe, 0x82, 0xbf, 0x95, 0xe0, 0x00, 0x23, 0xe3,
+		0xb8, 0x73, 0x35, 0x7d, 0x27, 0xea, 0x32, 0xe5, 0x39, 0x88, 0x97, 0xf4, 0x1d, 0xf9, 0x00, 0x53,
+		0x70, 0xd5, 0x6e, 0xa7, 0xc9, 0x03, 0x37, 0x47, 0x31, 0xbd, 0xde, 0x69, 0xa2, 0x9b, 0x5d, 0x44,
+		0x57, 0xe3, 0x71, 0x0e, 0x4b, 0xcc, 0x7d, 0x9f, 0x34, 0x5c, 0x4f, 0x52, 0x25, 0x7d, 0x87, 0x76,
+		0x64, 0x55, 0x7a, 0xe9, 0x4d, 0x7d, 0xfd, 0xdc, 0xd7, 0x74, 0xe3, 0x86, 0x8e, 0xcd, 0x6e, 0x6f,
+		0x08, 0x1f, 0xf7, 0x11, 0xbf, 0x8f, 0xfb, 0x79, 0xd2, 0x6c, 0x3e, 0x8b, 0x70, 0x18, 0xbe, 0x60,
+		0x6e, 0x24, 0xd9, 0x1d, 0x01, 0x78, 0x6f, 0x0c, 0x8e, 0x04, 0xdc, 0xd9, 0x5c, 0x08, 0xa2, 0x98,
+		0x50, 0x84, 0xd4, 0x82, 0x90, 0xad, 0x3c, 0x5e, 0x65, 0xac, 0x19, 0x7a, 0xdd, 0xa4, 0x8c, 0x88,
+		0xab, 0x22, 0x89, 0x8c, 0xd0, 0x35, 0xdd, 0x30, 0x79, 0xe8, 0x33, 0x4b, 0xcc, 0x7d, 0x70, 0x48,
+		0x46, 0x8c, 0x8b, 0x9a, 0x04, 0x37, 0x1e, 0x1e, 0x90, 0x1b, 0xa2, 0x13, 0x1e, 0xcf, 0xff, 0xa0,
+		0x5c, 0x79, 0x5f, 0x0c, 0x8e, 0xfa, 0xb9, 0x82, 0x33, 0xcb, 0xb4, 0xb4, 0x56, 0x3b, 0x8a, 0x2d,
+		0xe7, 0x21, 0xbd, 0x26, 0x60, 0x86, 0xe6, 0xcb, 0xed, 0x21, 0xf9, 0x92, 0xb5, 0xab, 0x12, 0x8c,
+		0x39, 0x33, 0x20, 0x63, 0xec, 0x7e, 0xec, 0x8a, 0x33, 0x7f, 0x93, 0x84, 0x03, 0x6c, 0x3a, 0x55,
+		0xd9, 0x54, 0x62, 0x09, 0xce, 0x93, 0x31, 0x77, 0x51, 0xff, 0x73, 0x12, 0xe5, 0x59, 0x98, 0x5a,
+		0x44, 0x6d, 0x81, 0xbb, 0x20, 0xe7, 0x84, 0x27, 0x34, 0x3a, 0x7c, 0xc6, 0x63, 0xf0, 0xf3, 0xf3,
+		0x2d, 0x77, 0x96, 0xf2, 0x6d, 0x12, 0xe4, 0x2a, 0x35, 0xad, 0xa9, 0x75, 0xee, 0x94, 0x94, 0xfc,
+		0x18, 0x00, 0x0b, 0xf7, 0xb0, 0x2f, 0x6e, 0x66, 0xcf, 0xe4, 0x67, 0xdd, 0x9d, 0x9b, 0x65, 0x35,
+		0x21, 0x73, 0xd4, 0x34, 0x85, 0xc5, 0x9f, 0x27, 0x5f, 0x00, 0x70, 0x0a, 0xe4, 0x83, 0xb0, 0xbf,
+		0x32, 0x5f, 0x5a, 0x2a, 0xa9, 0x22, 0x48, 0xa8, 0xb2, 0x5a, 0x9e, 0x67, 0xd7, 0xac, 0xf6, 0x60,
+		0x7c, 0x8d, 0xbb, 0xd0, 0x0e, 0x6a, 0xda, 0x0b, 0x93, 0xee, 0x7c, 0x76, 0xe7, 0x25, 0x86, 0x96,
+		0x62, 0xa3, 0xd5, 0x6e, 0x12, 0x7a, 0xf2, 0x58, 0x6d, 0x08, 0xae, 0xf5, 0x37, 0x42, 0x7e, 0xf7,
+		0x3f, 0xb2, 0x7b, 0x10, 0x53, 0x0e, 0xba, 0xcd, 0xf3, 0xe2, 0x12, 0x4c, 0x62, 0x64, 0x66, 0xdb,
+		0x43, 0xb2, 0x8f, 0xaa, 0x46, 0x82, 0xf4, 0x2c, 0x95, 0x63, 0x3a, 0xd4, 0x1e, 0x83, 0xa4, 0x49,
+		0x7b, 0xdf, 0x8f, 0xc4, 0xef, 0x71, 0x12, 0x1c, 0xbc, 0xa8, 0xc3, 0x24, 0xbb, 0xd7, 0x47, 0x5c,
+		0xcd, 0xe8, 0xed, 0x67, 0xf8, 0x67, 0x9f, 0x7c, 0x88, 0x9e, 0xac, 0xde, 0xe3, 0x1d, 0x96, 0x10,
+		0x71, 0x52, 0x73, 0x9c, 0xb6, 0xd3, 0x50, 0x02, 0x59, 0x51, 0x1f, 0x6f, 0x70, 0xef, 0xca, 0x7e,
+		0x83, 0x57, 0x76, 0x24, 0x4c, 0x06, 0x5c, 0x35, 0x8d, 0x73, 0xaa, 0xac, 0x60, 0xae, 0x1c, 0x35,
+		0xa7, 0x5f, 0x7a, 0x30, 0xb8, 0x3a, 0xb1, 0x7f, 0xa7, 0x28, 0xe5, 0xf3, 0xee, 0x6a, 0xec, 0xb9,
+		0xf7, 0xc1, 0x04, 0x4c, 0xe2, 0x65, 0x02, 0xe3, 0x34, 0xfd, 0xcb, 0xe7, 0xdc, 0x08, 0x4d, 0x0c,
+		0x70, 0x28, 0x79, 0x8e, 0x4d, 0x85, 0xfe, 0x12, 0xf3, 0x67, 0xdf, 0xf3, 0xd3, 0x23, 0xce, 0x74,
+		0x29, 0x5e, 0x81, 0x9c, 0x08, 0x09, 0x26, 0x7a, 0xcd, 0xa8, 0x0f, 0xe4, 0xa5, 0xf8, 0x73, 0x41,
+		0x43, 0xf8, 0xb7, 0xca, 0x1c, 0xb5, 0xf8, 0x24, 0x9e, 0xf4, 0x71, 0x32, 0xfd, 0x2c, 0x13, 0x41,
+		0xc4, 0xc6, 0x40, 0xbb, 0x84, 0xcd, 0xcc, 0x41, 0xac, 0xd0, 0xaf, 0x09, 0x7c, 0x36, 0x43, 0x31,
+		0x42, 0xa2, 0x78, 0x11, 0xb2, 0x75, 0x43, 0xb7, 0xaa, 0x46, 0xab, 0x61, 0x91, 0x56, 0xdb, 0xea,
+		0x6b, 0xd7, 0xfd, 0x25, 0x23, 0x92, 0x52, 0xc7, 0x11, 0x6f, 0x45, 0xa0, 0x61, 0x4b, 0xd8, 0xad,
+		0xbf, 0x41, 0x5a, 0xf2, 0xbf, 0xec, 0x96, 0x50, 0x1c, 0x6c, 0xc9, 0xae, 0xa4, 0xc3, 0xac, 0x5f,
+		0xe3, 0xca, 0xdc, 0xba, 0xc9, 0xa4, 0xc0, 0x96, 0x8e, 0x8f, 0xc6, 0xe1, 0x08, 0x07, 0xde, 0xd0,
+		0x4c, 0x72, 0xfa, 0xfa, 0xc3, 0x1b, 0xc4, 0xd2, 0x1e, 0x3e, 0x5d, 0x33, 0x1a, 0x62, 0x25, 0x9f,
+		0x62, 0xe5, 0xb3, 0x58, 0x3e, 0xcb, 0xcb, 0x0b, 0xa1, 0xc7, 0xdd, 0x85, 0x68, 0x25, 0x5f, 0x08,
+		0xca, 0xa0, 0xd2, 0x84, 0xc4, 0xbc, 0xd1, 0xd0, 0x71, 0x6d, 0xab, 0x13, 0xdd, 0x68, 0x71, 0x75,
+		0xcb, 0x12, 0xf2, 0x25, 0x48, 0x6a, 0x2d, 0xa3, 0xab, 0x5b, 0x4c, 0xd5, 0xce, 0x3d, 0xf4, 0x3b,
+		0x9f, 0x3d, 0xba, 0xe7, 0xbf, 0x7c, 0xf6, 0xe8, 0x5e, 0x46, 0xd6, 0xac, 0x5f, 0x9b, 0x6d, 0x18,
+		0xa7, 0x5b, 0x9a, 0xb5, 0x8d, 0xd3, 0xf7, 0x33, 0x9f, 0x3a, 0x05, 0xbc, 0xbe, 0x45, 0xdd, 0xfa,
+		0xd8, 0x17, 0x3f, 0x7e, 0x52, 0x52, 0x39, 0x7e, 0x31, 0xf1, 0xa5, 0x0f, 0x1f, 0x95, 0x94, 0x36,
+		0x8c, 0x2e, 0x90, 0x5a, 0x8f, 0x0a, 0x17, 0x7d, 0x15, 0x3e, 0xcc, 0x2b, 0x3c, 0x18, 0xac, 0x90,
+		0x05, 0xec, 0x2d, 0x90, 0x9a, 0xab, 0xda, 0x05, 0x52, 0xf3, 0xd6, 0x38, 0xb7, 0xf0, 0xfb, 0x9f,
+		0x3b, 0xb2, 0xe7, 0xb5, 0xcf, 0x1f, 0xd9, 0x13, 0x39, 0x64, 0x4a, 0xff, 0x21, 0xb3, 0x47, 0xea,
+		0xa7, 0x13, 0x70, 0x98, 0xde, 0x2f, 0xed, 0xb4, 0x1a, 0xba, 0x75, 0xba, 0xd6, 0xd9, 0x69, 0x5b,
+		0x06, 0x4e, 0x5c, 0x63, 0x93, 0x0f, 0xd4, 0xa4, 0x53, 0x3c, 0xcb, 0x8a, 0xc3, 0x87, 0x49, 0xd9,
+		0x84, 0x91, 0x55, 0xc4, 0x43, 0x46, 0x58, 0x86, 0xa5, 0x35, 0xb9, 0xb5, 0xc1, 0x12, 0x98, 0xcb,
+		0xee, 0xa4, 0xc6, 0x58, 0x6e, 0x43, 0x5c, 0x47, 0x6d, 0x12, 0x6d, 0x93, 0x5d, 0xed, 0x89, 0x53,
+		0x33, 0x35, 0x85, 0x19, 0xf4, 0x16, 0xcf, 0x34, 0x8c, 0x68, 0x5d, 0x16, 0x30, 0x13, 0x47, 0xfb,
+		0x95, 0x26, 0x94, 0x67, 0x61, 0x94, 0x9f, 0x9b, 0x63, 0xc4, 0xc8, 0x35, 0xb2, 0x43, 0xeb, 0x19,
+		0x53, 0xf1, 0xa7, 0x3c, 0x0b, 0x23, 0xb4, 0xf1, 0xfc, 0xce, 0x62, 0x7e, 0x36, 0xd0, 0xfa, 0x59,
+		0xda, 0x48, 0x95, 0x81, 0x29, 0x97, 0x21, 0xb5, 0x60, 0xa0, 0xf4, 0x78, 0xa9, 0xa5, 0x19, 0x35,
+		0xda, 0xe6, 0x76, 0x97, 0x8f, 0x9d, 0xca, 0x12, 0x18, 0x98, 0xce, 0xae, 0x7a, 0xf1, 0xa0, 0x1f,
+		0x9e, 0x52, 0xe6, 0x61, 0x94, 0xd2, 0x5e, 0x69, 0xdb, 0xd7, 0xab, 0x25, 0xd7, 0xf5, 0x6a, 0x4e,
+		0x3e, 0xe6, 0x34, 0x56, 0x86, 0x04, 0x5e, 0xe7, 0xe0, 0xfd, 0xa6, 0xbf, 0x95, 0xa7, 0x21, 0xc5,
+		0x89, 0x98, 0xf2, 0x19, 0x88, 0x1b, 0x6d, 0x93, 0x87, 0xed, 0x14, 0xa2, 0xba, 0xb2, 0xd2, 0x9e,
+		0x4b, 0xa0, 0x50, 0xa9, 0x08, 0x3c, 0xb7, 0x1c, 0x29, 0x16, 0x8f, 0x7a, 0xc4, 0xa2, 0x45, 0xac,
+		0x8d, 0x4d, 0xcb, 0xf9, 0xc1, 0x86, 0x33, 0x20, 0x0a, 0xb6, 0xa0, 0xdc, 0x8e, 0xc1, 0x11, 0x57,
+		0xe9, 0x75, 0xd2, 0x41, 0xc7, 0x11, 0x93, 0x26, 0x2e, 0x29, 0xb2, 0xab, 0x81, 0xbc, 0x3c, 0x42,
+		0x54, 0x9e, 0x82, 0x78, 0xa9, 0xdd, 0xc6, 0xdb, 0xce, 0x34, 0x5d, 0x33, 0x98, 0xac, 0x24, 0x54,
+		0x3b, 0x8d, 0x65, 0xa6, 0xb1, 0x69, 0xdd, 0xd0, 0x3a, 0xf6, 0x4d, 0x68, 0x91, 0x56, 0x9e, 0x80,
+		0xf4, 0xbc, 0xa1, 0x9b, 0x44, 0x37, 0xbb, 0xd4, 0x86, 0xdd, 0x68, 0x1a, 0xb5, 0x6b, 0x9c, 0x02,
+		0x4b, 0x20, 0xb3, 0xb5, 0x76, 0x9b, 0x62, 0x26, 0x54, 0xfc, 0xc9, 0x67, 0xcf, 0x4a, 0x24, 0x7b,
+		0xce, 0x0e, 0xc7, 0x1e, 0xde, 0x41, 0xc7, 0x18, 0x95, 0xe0, 0x50, 0x70, 0x22, 0x5d, 0x23, 0x3b,
+		0xe6, 0xb0, 0xf3, 0xe8, 0x05, 0x48, 0xaf, 0xd2, 0x67, 0x65, 0x9e, 0x25, 0x3b, 0x72, 0x01, 0xdf,
+		0x1e, 0x39, 0x73, 0xf6, 0xec, 0xc3, 0x4f, 0x30, 0x29, 0xbf, 0xb4, 0x47, 0x15, 0x19, 0xf2, 0x11,
+		0x48, 0x9b, 0xa4, 0xd6, 0x3e, 0x73, 0xf6, 0xdc, 0xb5, 0x87, 0x99, 0x58, 0x5d, 0xda, 0xa3, 0x3a,
+		0x59, 0xc5, 0x14, 0xf6, 0xf8, 0x4b, 0x1f, 0x39, 0x2a, 0xcd, 0x8d, 0x40, 0xdc, 0xec, 0xb6, 0x5e,
+		0x37, 0xd9, 0xf8, 0x6a, 0x12, 0x66, 0x5c, 0xa5, 0x6c, 0x51, 0xb8, 0xae, 0x35, 0x1b, 0x75, 0xcd,
+		0x79, 0x0c, 0x28, 0xe7, 0xea, 0x3f, 0x85, 0x88, 0xd0, 0xf6, 0x3d, 0xb9, 0xa8, 0x7c, 0x42, 0x82,
+		0xb1, 0xab, 0x82, 0x32, 0xbe, 0x1e, 0x74, 0x1e, 0xc0, 0xae, 0x49, 0x4c, 0x95, 0x83, 0xb3, 0xfe,
+		0xba, 0x66, 0x6d, 0x1c, 0xd5, 0x05, 0x2e, 0x3f, 0x46, 0x05, 0xb0, 0x6d, 0x98, 0xfc, 0x56, 0x6c,
+		0x1f, 0x54, 0x1b, 0x18, 0x03, 0x30, 0xa9, 0x56, 0xab, 0x5e, 0x37, 0x2c, 0x0c, 0x09, 0x69, 0x1b,
+		0x37, 0xf8, 0x5b, 0x03, 0x71, 0x35, 0x47, 0x4b, 0xae, 0xd2, 0x82, 0x55, 0xcc, 0xc7, 0x46, 0xa7,
+		0x6d, 0x2a, 0xb8, 0x1d, 0xd3, 0xea, 0xf5, 0x0e, 0x31, 0x4d, 0xae, 0xb8, 0x44, 0x12, 0xaf, 0xe2,
+		0xb6, 0xbb, 0x1b, 0x55, 0xa1, 0x25, 0xf0, 0x32, 0x73, 0xc8, 0x9c, 0x17, 0xb2, 0xc1, 0x67, 0x7d,
+		0xb2, 0xdd, 0xdd, 0x40, 0x49, 0xb9, 0x07, 0xc6, 0x42, 0x1a, 0x93, 0xb9, 0xee, 0xb4, 0x83, 0xbe,
+		0x64, 0xc4, 0x7b, 0x50, 0x6d, 0x77, 0x1a, 0x46, 0xa7, 0x61, 0xed, 0xd0, 0x70, 0xbb, 0xb8, 0x9a,
+		0x13, 0x05, 0xab, 0x3c, 0x5f, 0xb9, 0x06, 0x13, 0x15, 0x6a, 0xa6, 0x3b, 0x2d, 0x3f, 0xeb, 0xb4,
+		0x4f, 0xea, 0xdf, 0xbe, 0xc8, 0x96, 0xc5, 0x02, 0x2d, 0x3b, 0xf9, 0x9f, 0x24, 0xc8, 0xcc, 0xe1,
+		0xc4, 0x5d, 0x5c, 0xb8, 0xd0, 0xd4, 0xb6, 0xe4, 0x87, 0x61, 0xef, 0xdc, 0xd2, 0xca, 0xfc, 0xb3,
+		0xd5, 0xc5, 0x85, 0xea, 0x85, 0xa5, 0xd2, 0x45, 0x27, 0xbe, 0xb7, 0xb0, 0xef, 0xd6, 0xed, 0x19,
+		0xd9, 0x05, 0xbb, 0xae, 0xd3, 0xbd, 0xa4, 0x7c, 0x1a, 0xa6, 0xbd, 0x28, 0xa5, 0xb9, 0x0a, 0x06,
+		0xfb, 0x4a, 0x85, 0xbd, 0xb7, 0x6e, 0xcf, 0x4c, 0xba, 0x30, 0x4a, 0x1b, 0x26, 0xd1, 0xad, 0x20,
+		0xc2, 0xfc, 0xca, 0x95, 0x2b, 0x8b, 0x6b, 0xb9, 0x58, 0x00, 0x01, 0x83, 0x2c, 0x1b, 0x96, 0xfc,
+		0x00, 0x4c, 0x7a, 0x11, 0x96, 0x17, 0x97, 0x72, 0xf1, 0x82, 0x7c, 0xeb, 0xf6, 0x4c, 0xd6, 0x05,
+		0xbd, 0xdc, 0x68, 0x16, 0x52, 0xef, 0xfc, 0xc9, 0x23, 0x7b, 0x3e, 0xf6, 0xff, 0x1c, 0x91, 0xe6,
+		0xae, 0x44, 0xce, 0xb9, 0x47, 0x86, 0x9b, 0x73, 0xde, 0x75, 0xfb, 0xeb, 0x87, 0xe0, 0x90, 0xbf,
+		0xd0, 0xa3, 0x8c, 0x07, 0x9d, 0x6e, 0xfd, 0x7c, 0x0b, 0x85, 0xde, 0xe6, 0x41, 0xa1, 0xcf, 0xa2,
+		0x50, 0xe8, 0xab, 0x18, 0x94, 0x27, 0x60, 0x1c, 0xc3, 0x81, 0x2b, 0xc4, 0xba, 0x44, 0xb4, 0x3a,
+		0xe9, 0x78, 0xed, 0x87, 0x71, 0x61, 0x3f, 0xc8, 0x90, 0xa0, 0x46, 0x02, 0x5b, 0x3f, 0xe9, 0x6f,
+		0x65, 0x1b, 0x12, 0x88, 0xea, 0xd8, 0x16, 0x1c, 0x83, 0x26, 0x30, 0x77, 0x63, 0xc7, 0x22, 0x26,
+		0x47, 0x61, 0x09, 0xf9, 0x51, 0x61, 0x21, 0xc4, 0x7b, 0x5b, 0x08, 0x7c, 0x7a, 0x71, 0x3b, 0xa1,
+		0x09, 0xa3, 0x7c, 0x88, 0xed, 0x86, 0x48, 0x4e, 0x43, 0xe4, 0x2b, 0x30, 0xd1, 0xd6, 0x3a, 0x16,
+		0xbd, 0xa7, 0xb8, 0x4d, 0x7b, 0xc1, 0x67, 0xf0, 0xd1, 0xa0, 0x3e, 0xf1, 0x74, 0x96, 0xd7, 0x32,
+		0xde, 0x76, 0x67, 0x2a, 0x7f, 0x9c, 0x80, 0x24, 0x67, 0xc6, 0x53, 0x30, 0xca, 0xd9, 0xca, 0xe7,
+		0xdc, 0xe1, 0xd9, 0xe0, 0x32, 0x3b, 0x6b, 0x2f, 0x87, 0x9c, 0x9e, 0xc0, 0x91, 0xef, 0x87, 0x54,
+		0x6d, 0x5b, 0x6b, 0xe8, 0xd5, 0x46, 0x9d, 0x1b, 0xa0, 0x99, 0xcf, 0x7f, 0xf6, 0xe8, 0xe8, 0x3c,
+		0xe6, 0x2d, 0x2e, 0xa8, 0xa3, 0xb4, 0x70, 0xb1, 0x8e, 0x36, 0xcd, 0x36, 0x69, 0x6c, 0x6d, 0x5b,
+		0x5c, 0x6f, 0xf0, 0x14, 0x3e, 0xce, 0x86, 0x02, 0xc1, 0x6f, 0xb1, 0x17, 0x02, 0x3b, 0x0b, 0xdb,
+		0xf5, 0x33, 0x97, 0xc2, 0x8a, 0xdf, 0xf3, 0xdf, 0x8e, 0x4a, 0x2a, 0xc5, 0x90, 0xe7, 0x61, 0xbc,
+		0xa9, 0x99, 0x56, 0x95, 0xae, 0xc7, 0x58, 0xfd, 0x08, 0x25, 0x71, 0x20, 0xc8, 0x10, 0xce, 0x58,
+		0xde, 0xf4, 0x0c, 0x62, 0xb1, 0xac, 0x3a, 0x5e, 0xb2, 0xa5, 0x44, 0x6a, 0x74, 0x06, 0x32, 0x2b,
+		0x31, 0x49, 0xf9, 0x9e, 0xc5, 0x7c, 0x36, 0x31, 0xa9, 0xad, 0x78, 0x10, 0xd2, 0xf4, 0xde, 0x2c,
+		0x05, 0x61, 0xe1, 0xeb, 0x29, 0xcc, 0xa0, 0x85, 0xc7, 0x61, 0xc2, 0xd1, 0xfa, 0x0c, 0x24, 0xc5,
+		0xa8, 0x38, 0xd9, 0x14, 0xf0, 0x21, 0x98, 0xd6, 0xc9, 0x4d, 0xab, 0xea, 0x64, 0x33, 0xe8, 0x34,
+		0x85, 0x96, 0xb1, 0xec, 0xaa, 0x17, 0xe3, 0x3e, 0xc8, 0xd6, 0x04, 0xf3, 0x19, 0x2c, 0x50, 0xd8,
+		0x71, 0x3b, 0x97, 0x82, 0x1d, 0x80, 0x94, 0xd6, 0x6e, 0x33, 0x80, 0x0c, 0xd7, 0xfa, 0xed, 0x36,
+		0x2d, 0x3a, 0x09, 0x93, 0xb4, 0x8f, 0x1d, 0x62, 0x76, 0x9b, 0x16, 0x27, 0x32, 0x46, 0x61, 0x26,
+		0xb0, 0x40, 0x65, 0xf9, 0x14, 0xf6, 0x18, 0x8c, 0x93, 0xeb, 0x78, 0x77, 0xa0, 0x46, 0x18, 0xdc,
+		0x38, 0x85, 0x1b, 0x13, 0x99, 0x14, 0xe8, 0x01, 0xb0, 0xb5, 0x79, 0x55, 0xac, 0x34, 0x59, 0x46,
+		0x4f, 0xe4, 0x97, 0x58, 0xb6, 0x92, 0x87, 0xc4, 0x82, 0x66, 0x69, 0x68, 0x2e, 0x59, 0x37, 0xd9,
+		0xf2, 0x39, 0xa6, 0xe2, 0x4f, 0xe5, 0x57, 0xe2, 0x90, 0xb8, 0x6a, 0x58, 0x44, 0x7e, 0xc4, 0x65,
+		0xca, 0x66, 0xc3, 0xe4, 0xb9, 0xd2, 0xd8, 0xd2, 0x49, 0xfd, 0x8a, 0xb9, 0xe5, 0x7a, 0xe4, 0xc6,
+		0x11, 0xa7, 0x98, 0x47, 0x9c, 0xa6, 0x61, 0xa4, 0x63, 0x74, 0xf5, 0xba, 0x08, 0xfc, 0xa6, 0x09,
+		0xb9, 0x0c, 0x29, 0x5b, 0x4a, 0x12, 0xfd, 0xa4, 0x64, 0x02, 0xa5, 0x04, 0x65, 0x98, 0x67, 0xa8,
+		0xa3, 0x1b, 0x5c, 0x58, 0xe6, 0x20, 0x6d, 0x2b, 0xaf, 0xfc, 0xc8, 0x10, 0x02, 0xeb, 0xa0, 0xe1,
+		0x12, 0x69, 0x8f, 0xbd, 0xcd, 0x3c, 0x26, 0x71, 0x39, 0xbb, 0x80, 0x73, 0xcf, 0x23, 0x56, 0xfc,
+		0xc1, 0x9d, 0x51, 0xda, 0x2f, 0x47, 0xac, 0xd8, 0xa3, 0x3b, 0x87, 0x30, 0x92, 0x6e, 0x4b, 0xd7,
+		0xac, 0x6e, 0x87, 0x70, 0xc9, 0x73, 0x32, 0xb0, 0xd4, 0xb9, 0x04, 0xc1, 0x24, 0xcd, 0xc9, 0x90,
+		0x4f, 0xc3, 0x94, 0x9d, 0xa8, 0x3a, 0x54, 0x98, 0x94, 0xc9, 0x76, 0x51, 0x45, 0x94, 0x28, 0xbf,
+		0x29, 0x41, 0x92, 0xaf, 0x58, 0xce, 0x30, 0x48, 0xe1, 0xc3, 0x10, 0x8b, 0x1a, 0x86, 0xf8, 0xee,
+		0x87, 0xa1, 0x04, 0x60, 0x37, 0xd3, 0xe4, 0xcf, 0xaa, 0x84, 0x98, 0x55, 0xac, 0x89, 0x95, 0xc6,
+		0x16, 0x9f, 0xf7, 0x2e, 0x24, 0xe5, 0x0f, 0x24, 0x48, 0xdb, 0xe5, 0x72, 0x09, 0xc6, 0x45, 0xbb,
+		0xaa, 0x9b, 0x4d, 0x6d, 0x8b, 0x8b, 0xe2, 0xe1, 0xc8, 0xc6, 0xe1, 0x2a, 0xac, 0x66, 0x78, 0x7b,
+		0x30, 0x11, 0x3e, 0xac, 0xb1, 0x88, 0x61, 0xf5, 0xc8, 0x51, 0x7c, 0x77, 0x72, 0xe4, 0x19, 0xf1,
+		0x84, 0x6f, 0xc4, 0x95, 0xcf, 0x49, 0xfc, 0xc5, 0xb6, 0x3a, 0xa9, 0xff, 0x7d, 0x0e, 0xd5, 0x4b,
+		0x5c, 0xb6, 0xea, 0xa4, 0x5e, 0x0d, 0x8c, 0xd9, 0xb1, 0x20, 0x45, 0x6f, 0x9b, 0x9d, 0xb1, 0x93,
+		0x05, 0x95, 0x8a, 0x33, 0x86, 0x9f, 0x8a, 0xc1, 0x64, 0x00, 0xfe, 0x9b, 0x6f, 0x2c, 0xbd, 0xb3,
+		0x77, 0x64, 0xc0, 0xd9, 0x9b, 0x8c, 0x9c, 0xbd, 0x9f, 0x8c, 0x51, 0x07, 0x40, 0xdb, 0x30, 0xb5,
+		0xe6, 0x37, 0x42, 0xf7, 0x1e, 0x84, 0x74, 0xdb, 0x68, 0x56, 0x59, 0x09, 0xbb, 0x7a, 0x93, 0x6a,
+		0x1b, 0x4d, 0x35, 0x20, 0x66, 0x23, 0x77, 0x49, 0x31, 0x27, 0xef, 0xc2, 0x20, 0x8c, 0xfa, 0x27,
+		0x54, 0x07, 0xc6, 0x18, 0x2b, 0xb8, 0xd5, 0xf4, 0x10, 0xf2, 0x00, 0x7f, 0xe5, 0xa5, 0xa0, 0x95,
+		0xc7, 0x9a, 0xcd, 0x20, 0xd5, 0xe4, 0xb6, 0x8d, 0xc1, 0x8c, 0x8c, 0x7c, 0x2c, 0x0a, 0x83, 0x49,
+		0xb1, 0xca, 0xe1, 0x94, 0x1f, 0x91, 0x00, 0x96, 0x90, 0xb3, 0xb4, 0xbf, 0x68, 0xef, 0x98, 0xb4,
+		0x09, 0x55, 0x4f, 0xcd, 0x47, 0xa2, 0x06, 0x8d, 0xd7, 0x3f, 0x66, 0xba, 0xdb, 0x3d, 0x0f, 0xe3,
+		0x8e, 0x6c, 0x9b, 0x44, 0x34, 0xe6, 0x48, 0x8f, 0x5d, 0x69, 0x85, 0x58, 0xea, 0xd8, 0x75, 0x57,
+		0x4a, 0xf9, 0x17, 0x12, 0xa4, 0x69, 0x9b, 0xf0, 0xbd, 0x10, 0xcf, 0x18, 0x4a, 0xbb, 0x1f, 0xc3,
+		0xc3, 0x00, 0x8c, 0x0c, 0x46, 0xb0, 0x70, 0xc9, 0x4a, 0xd3, 0x1c, 0x8c, 0x4b, 0x91, 0xcf, 0xd9,
+		0x0c, 0x8f, 0xf7, 0x66, 0xb8, 0xd8, 0xb5, 0x72, 0xb6, 0xef, 0x87, 0x51, 0xfa, 0xa6, 0xe8, 0x4d,
+		0x93, 0x6f, 0x44, 0xf1, 0x59, 0xaa, 0xb5, 0x9b, 0xa6, 0xf2, 0x32, 0x8c, 0xae, 0xdd, 0x64, 0xfe,
+		0xc4, 0x83, 0x90, 0xee, 0x18, 0x06, 0xb7, 0xfe, 0x98, 0xd5, 0x9d, 0xc2, 0x0c, 0x6a, 0xec, 0x08,
+		0x1f, 0x5a, 0xcc, 0xf1, 0xa1, 0x39, 0x4e, 0xc0, 0xf8, 0x40, 0x4e, 0x40, 0xdc, 0x7d, 0x8e, 0x7b,
+		0x66, 0x92, 0xfc, 0x26, 0xd8, 0x5f, 0x59, 0xbc, 0xb8, 0x5c, 0x5e, 0xa8, 0x5e, 0xa9, 0x5c, 0xf4,
+		0x5d, 0xab, 0x2f, 0x4c, 0xdc, 0xba, 0x3d, 0x93, 0xe1, 0xdb, 0xce, 0x28, 0xe8, 0x55, 0xb5, 0x7c,
+		0x75, 0x65, 0xad, 0x9c, 0x93, 0x18, 0xf4, 0x6a, 0x87, 0x5c, 0x37, 0x2c, 0xf6, 0x68, 0xef, 0x43,
+		0x70, 0x20, 0x04, 0xda, 0xde, 0x7c, 0x4e, 0xde, 0xba, 0x3d, 0x33, 0xbe, 0xda, 0x21, 0x4c, 0xca,
+		0x28, 0xc6, 0x2c, 0xe4, 0x83, 0x18, 0x2b, 0xab, 0x2b, 0x95, 0xd2, 0x52, 0x6e, 0xa6, 0x90, 0xbb,
+		0x75, 0x7b, 0x66, 0x4c, 0xa8, 0x0c, 0x84, 0x7f, 0xfd, 0x77, 0x9f, 0xef, 0x4a, 0xc1, 0x61, 0x7f,
+		0xe1, 0xe9, 0xb6, 0xd6, 0xd1, 0x5a, 0xc3, 0x6e, 0x3f, 0xfb, 0x1c, 0xf8, 0x2b, 0x9f, 0x88, 0xc1,
+		0x84, 0xbd, 0xb9, 0x59, 0xa5, 0x35, 0xc8, 0x8f, 0xb8, 0x3d, 0x7e, 0x99, 0xc8, 0xb5, 0x83, 0x41,
+		0x0b, 0x87, 0x20, 0x1e, 0xf5, 0x70, 0x23, 0x99, 0x4f, 0xaa, 0x99, 0x90, 0xf5, 0x8d, 0x43, 0x70,
+		0x54, 0x1b, 0x43, 0x7e, 0x06, 0xd2, 0xf6, 0x14, 0xb3, 0x9f, 0x49, 0x8b, 0x9e, 0x93, 0x1c, 0xdf,
+		0xc1, 0xc1, 0x97, 0xcb, 0xc4, 0x36, 0x2e, 0x11, 0xb5, 0x31, 0xbc, 0xca, 0x00, 0x38, 0xb2, 0x80,
+		0x97, 0x1f, 0x82, 0x84, 0xb6, 0x51, 0x6b, 0xe4, 0x47, 0x82, 0x2e, 0x17, 0x86, 0x57, 0x9a, 0x9b,
+		0x5f, 0xe4, 0x48, 0x14, 0x52, 0x59, 0xe4, 0xce, 0x14, 0xce, 0x2f, 0xfa, 0x28, 0xcd, 0xcd, 0x2a,
+		0xdb, 0x0b, 0x33, 0xeb, 0x22, 0xd5, 0xd2, 0x6e, 0xce, 0x61, 0x1a, 0x27, 0x20, 0x16, 0x6e, 0xf1,
+		0x87, 0x0a, 0xe2, 0x6a, 0xb2, 0xa5, 0xdd, 0xbc, 0xa8, 0x99, 0x97, 0x13, 0xa9, 0x78, 0x2e, 0xa1,
+		0xfc, 0x0c, 0x5a, 0x2a, 0x1e, 0xae, 0xc8, 0x0f, 0x82, 0x8c, 0x18, 0x78, 0x06, 0x87, 0x53, 0x97,
+		0xb2, 0x57, 0xd0, 0x9d, 0x68, 0x69, 0x37, 0x4b, 0x5b, 0x64, 0xb9, 0xdb, 0xa2, 0x0d, 0x30, 0x65,
+		0x3c, 0xb0, 0xe3, 0xc0, 0x62, 0x64, 0x39, 0xfb, 0x0f, 0x04, 0x1f, 0xc6, 0xe5, 0x00, 0x6c, 0x01,
+		0x78, 0x3f, 0x2e, 0x00, 0x59, 0x46, 0x4f, 0x94, 0x78, 0xbb, 0x12, 0xf7, 0x76, 0x45, 0x79, 0x06,
+		0x26, 0x7c, 0x23, 0x20, 0x2b, 0x30, 0xce, 0x3d, 0x56, 0xf4, 0xf8, 0x9c, 0xed, 0x70, 0xd2, 0x6a,
+		0x86, 0x79, 0xa6, 0x68, 0x38, 0x41, 0x31, 0xf5, 0xab, 0xe8, 0x26, 0xc5, 0xc3, 0x9c, 0x07, 0x61,
+		0xdc, 0x33, 0x06, 0xc2, 0x8b, 0x2c, 0x39, 0x5e, 0x64, 0x07, 0xf8, 0x25, 0x18, 0x43, 0x05, 0x44,
+		0xea, 0x1c, 0xf6, 0x7e, 0x98, 0x60, 0x0a, 0xd2, 0xcf, 0x6b, 0x66, 0xf0, 0x5c, 0x11, 0x0c, 0x57,
+		0x60, 0xdc, 0x81, 0x73, 0xd8, 0x9e, 0x11, 0x50, 0x17, 0x35, 0x53, 0x59, 0x01, 0x70, 0x06, 0x55,
+		0x2e, 0xc1, 0x61, 0x54, 0x1e, 0xee, 0x70, 0x4f, 0xfe, 0x40, 0x96, 0xc7, 0x62, 0x2c, 0x20, 0x90,
+		0x13, 0xd2, 0xc9, 0x9e, 0xc9, 0xba, 0x44, 0x21, 0xe6, 0x9e, 0xfb, 0xd8, 0xe7, 0x8f, 0x48, 0xaf,
+		0x8f, 0x2e, 0xf8, 0xcf, 0x2f, 0xc2, 0x41, 0x57, 0x21, 0x0a, 0xa0, 0xc7, 0x11, 0x35, 0xe1, 0x14,
+		0xce, 0x62, 0x61, 0x3f, 0x87, 0x52, 0x4f, 0xff, 0x6f, 0xa1, 0xb7, 0xda, 0xe9, 0xef, 0x6d, 0xea,
+		0xef, 0xef, 0x0a, 0x77, 0xca, 0xff, 0xf3, 0x34, 0x8c, 0xaa, 0xe4, 0x95, 0x2e, 0x31, 0x2d, 0xf9,
+		0x0c, 0x24, 0x48, 0x6d, 0xdb, 0x08, 0xf3, 0x81, 0x62, 0x07, 0x67, 0x39, 0x5c, 0xb9, 0xb6, 0x6d,
+		0x5c, 0xda, 0xa3, 0x52, 0x58, 0xf9, 0x2c, 0x8c, 0x6c, 0x36, 0xbb, 0xdc, 0x7d, 0xe5, 0xd3, 0x59,
+		0x6e, 0xa4, 0x0b, 0x08, 0x74, 0x69, 0x8f, 0xca, 0xa0, 0xb1, 0x2a, 0xfa, 0xa2, 0x79, 0xbc, 0x77,
+		0x55, 0x78, 0xed, 0x19, 0xab, 0x42, 0x58, 0x79, 0x0e, 0xa0, 0xa1, 0x37, 0xac, 0x2a, 0x75, 0xed,
+		0xe4, 0x47, 0x82, 0xca, 0xca, 0x8b, 0xd9, 0xb0, 0xa8, 0x33, 0x08, 0x8f, 0x0e, 0x1a, 0x22, 0x81,
+		0xcd, 0x7d, 0xa5, 0x4b, 0x3a, 0x3b, 0xf9, 0x64, 0xef, 0xe6, 0x3e, 0x87, 0x40, 0xd8, 0x5c, 0x0a,
+		0x8d, 0x4a, 0x96, 0x3d, 0xb5, 0x67, 0xdd, 0xcc, 0xa7, 0x82, 0x6a, 0xce, 0x8d, 0x49, 0xdf, 0xdb,
+		0x5b, 0xbb, 0x89, 0xe7, 0x19, 0x35, 0xf6, 0x53, 0x7e, 0xdc, 0x36, 0xc1, 0x32, 0x41, 0xab, 0xc7,
+		0x83, 0xcb, 0xdc, 0x3e, 0x7b, 0x84, 0x29, 0x26, 0x2f, 0x43, 0xb6, 0xd9, 0x30, 0xad, 0xaa, 0xa9,
+		0x6b, 0x6d, 0x73, 0xdb, 0xb0, 0x4c, 0xea, 0x3f, 0xc1, 0x17, 0xc0, 0x23, 0x28, 0x2c, 0x35, 0x4c,
+		0xab, 0x22, 0x80, 0x2f, 0xed, 0x51, 0xc7, 0x9b, 0xee, 0x0c, 0xa4, 0x67, 0x6c, 0x6e, 0x92, 0x8e,
+		0x4d, 0x30, 0x3f, 0xde, 0x9b, 0xde, 0x0a, 0x42, 0x0b, 0x7c, 0xa4, 0x67, 0xb8, 0x33, 0xe4, 0x6f,
+		0x81, 0xa9, 0xa6, 0xa1, 0xd5, 0x6d, 0x72, 0xd5, 0xda, 0x76, 0x57, 0xbf, 0x46, 0x9d, 0x32, 0xf8,
+		0xe8, 0x69, 0x54, 0x23, 0x0d, 0xad, 0x2e, 0x48, 0xcc, 0x23, 0xc2, 0xa5, 0x3d, 0xea, 0x64, 0xd3,
+		0x9f, 0x29, 0xbf, 0x0d, 0xa6, 0xb5, 0x76, 0xbb, 0xb9, 0xe3, 0xa7, 0x3e, 0x41, 0xa9, 0x9f, 0x8c,
+		0xa2, 0x5e, 0x42, 0x1c, 0x3f, 0x79, 0x59, 0x0b, 0xe4, 0xca, 0x6b, 0xe8, 0x4e, 0x22, 0xf4, 0xc6,
+		0x54, 0x9b, 0x5b, 0x19, 0xf4, 0x75, 0x2a, 0xfc, 0x54, 0x40, 0x04, 0xed, 0x55, 0x06, 0x2f, 0x8c,
+		0x92, 0x4b, 0x7b, 0xd0, 0xf3, 0xe4, 0xc9, 0x62, 0x54, 0x8d, 0x1a, 0xa1, 0x8f, 0xe7, 0x71, 0xaa,
+		0x93, 0xfd, 0xa8, 0x52, 0x78, 0x2f, 0x55, 0x4f, 0x96, 0x5c, 0x86, 0x0c, 0xdb, 0x8a, 0xe2, 0xc1,
+		0x0c, 0xa1, 0x6f, 0x5a, 0x65, 0xce, 0x28, 0x91, 0x33, 0x94, 0x82, 0xa2, 0x97, 0xeb, 0xd2, 0x1e,
+		0x15, 0x88, 0x9d, 0x92, 0x35, 0xd8, 0x4b, 0x9f, 0xf7, 0xda, 0xa9, 0x7a, 0x15, 0x6f, 0x7e, 0x8a,
+		0x12, 0x7c, 0x30, 0x8a, 0x20, 0x7d, 0xe1, 0x7a, 0xe7, 0xaa, 0x5b, 0x0f, 0x5f, 0xda, 0xa3, 0x4e,
+		0x5d, 0x0f, 0x66, 0xa3, 0x88, 0x6d, 0x36, 0x74, 0xad, 0x89, 0xaf, 0x4c, 0x32, 0x6b, 0x66, 0xba,
+		0xb7, 0x88, 0x5d, 0xe0, 0xd0, 0x74, 0x61, 0x45, 0x11, 0xdb, 0x74, 0x67, 0xcc, 0x8d, 0xf2, 0x58,
+		0x4f, 0xfb, 0xa1, 0xb6, 0xd1, 0x5c, 0x8a, 0x3d, 0xce, 0x76, 0x39, 0x91, 0x82, 0x5c, 0x46, 0x39,
+		0x0e, 0x19, 0x97, 0x62, 0xc2, 0x53, 0x28, 0x1e, 0x0d, 0x23, 0xa2, 0x46, 0x79, 0x52, 0xc9, 0xc2,
+		0x98, 0x5b, 0x19, 0x29, 0xef, 0x91, 0x20, 0xe3, 0xd2, 0x33, 0x88, 0xe9, 0xf6, 0x48, 0xa7, 0x1d,
+		0x4b, 0xe5, 0x98, 0x58, 0xda, 0x44, 0x39, 0x3b, 0x7e, 0x1d, 0xa3, 0x99, 0x7c, 0x65, 0xc5, 0xc7,
+		0x21, 0xda, 0x67, 0xda, 0x36, 0x48, 0x9c, 0x82, 0x40, 0xfb, 0x4c, 0x5b, 0x00, 0xdc, 0x03, 0x63,
+		0xd8, 0xef, 0xaa, 0xdb, 0x5e, 0x4a, 0xab, 0x19, 0xcc, 0xe3, 0x20, 0xca, 0xbf, 0x89, 0x41, 0xce,
+		0xaf, 0xc0, 0x6c, 0x57, 0xb5, 0x34, 0xb4, 0xab, 0xfa, 0x80, 0xdf, 0x49, 0xee, 0xf8, 0xc5, 0x97,
+		0x20, 0xe7, 0xb8, 0x77, 0xd9, 0x52, 0x13, 0x6d, 0xff, 0xf9, 0x0c, 0x55, 0x75, 0xa2, 0xe6, 0xcd,
+		0x90, 0x2f, 0x78, 0x0e, 0x2b, 0xc5, 0x27, 0x3f, 0xfc, 0x03, 0x6e, 0x1b, 0x31, 0xeb, 0xed, 0xba,
+		0x66, 0x11, 0xe1, 0x1f, 0x73, 0x30, 0xd1, 0xd6, 0x40, 0x6f, 0xb2, 0x69, 0x69, 0x16, 0xe1, 0xb6,
+		0x06, 0xf3, 0x3c, 0x8c, 0x6b, 0xed, 0x36, 0x7d, 0x5a, 0x9d, 0xd9, 0x1a, 0xf7, 0x41, 0x16, 0x75,
+		0x7a, 0x43, 0x6b, 0x0a, 0x53, 0x21, 0xc9, 0x4c, 0x12, 0x9e, 0xcb, 0xac, 0x03, 0xa5, 0x0e, 0x63,
+		0x6e, 0x7d, 0x6e, 0xef, 0xa9, 0x24, 0xd7, 0x9e, 0x4a, 0xe6, 0x6f, 0x7d, 0x30, 0xfe, 0x88, 0xf7,
+		0x51, 0xc2, 0x0f, 0x0d, 0xa6, 0xe9, 0xfe, 0xeb, 0x3a, 0x73, 0x96, 0xa4, 0x54, 0x96, 0x50, 0x54,
+		0xc8, 0x7a, 0x75, 0xbf, 0x9c, 0x85, 0x98, 0x75, 0x93, 0xd7, 0x12, 0xb3, 0x6e, 0xa2, 0xa5, 0x6b,
+		0xbf, 0xb7, 0x9b, 0x0d, 0x59, 0xed, 0x38, 0x9e, 0xe3, 0xeb, 0x50, 0x26, 0x60, 0x5c, 0xd0, 0x64,
+		0x9b, 0xf2, 0x7d, 0x30, 0x1d, 0xa6, 0xe2, 0x95, 0x6d, 0x98, 0x0e, 0x53, 0xd5, 0xf2, 0x59, 0x48,
+		0xd9, 0x3a, 0x3e, 0x64, 0x77, 0x4c, 0xab, 0x15, 0xc0, 0xaa, 0x0d, 0xea, 0x71, 0xe7, 0xc7, 0x3c,
+		0xee, 0x7c, 0xe5, 0xed, 0x90, 0x8f, 0xd2, 0xdf, 0x3e, 0x27, 0x5f, 0xc2, 0x66, 0xd8, 0x3e, 0x48,
+		0xf2, 0x87, 0x2d, 0x63, 0xf4, 0x00, 0x8b, 0xa7, 0x90, 0x91, 0x4c, 0x97, 0xc7, 0x69, 0x36, 0x4b,
+		0x28, 0x55, 0x38, 0x10, 0xa9, 0xc3, 0xa3, 0x8f, 0xc2, 0x18, 0x21, 0x7e, 0x14, 0x56, 0x13, 0xcd,
+		0x31, 0x69, 0x5f, 0x45, 0x20, 0x0b, 0x4b, 0x29, 0xef, 0x8f, 0xc3, 0xbe, 0x70, 0x4d, 0x2e, 0xcf,
+		0xc0, 0x18, 0xda, 0xad, 0x96, 0xd7, 0xc4, 0x85, 0x96, 0x76, 0x73, 0x8d, 0xdb, 0xb7, 0xfc, 0x28,
+		0x21, 0x66, 0x1f, 0x25, 0xc8, 0xeb, 0x30, 0xd9, 0x34, 0x6a, 0x5a, 0xb3, 0xea, 0x3a, 0xca, 0xe1,
+		0x93, 0xe8, 0x58, 0x80, 0xd9, 0x5e, 0x97, 0x21, 0x2a, 0x1c, 0x2e, 0xff, 0x13, 0x94, 0xc6, 0x92,
+		0x7d, 0xea, 0x23, 0x2f, 0x40, 0xa6, 0xd5, 0x30, 0x37, 0xc8, 0xb6, 0x76, 0xbd, 0x61, 0x74, 0xf8,
+		0x6c, 0x0a, 0x0a, 0xcd, 0x15, 0x07, 0x46, 0x9c, 0x30, 0xb9, 0xd0, 0x5c, 0x43, 0x32, 0x12, 0x7a,
+		0xf0, 0x95, 0x1c, 0x5a, 0x9b, 0x44, 0x9d, 0x21, 0x8d, 0x46, 0x9e, 0x21, 0x85, 0x1d, 0xd8, 0xa4,
+		0xc2, 0x0f, 0x6c, 0xde, 0xe9, 0x1e, 0x1a, 0xef, 0xda, 0x17, 0x38, 0xc3, 0x91, 0x2b, 0x30, 0xcd,
+		0xf1, 0xeb, 0x1e, 0xde, 0x87, 0x84, 0x3a, 0xb0, 0xf9, 0xe5, 0xe7, 0xb9, 0x2c, 0xd0, 0xa3, 0xd9,
+		0x1e, 0xdf, 0x1d, 0xdb, 0xc5, 0x21, 0x6a, 0xc2, 0x75, 0x88, 0xfa, 0x0f, 0x6c, 0x28, 0xde, 0x11,
+		0x87, 0xc9, 0x80, 0x21, 0x11, 0x7a, 0x3a, 0x1c, 0xe5, 0x91, 0x15, 0x1d, 0x8b, 0x0f, 0xdd, 0x31,
+		0x3e, 0xd6, 0x89, 0xfe, 0x63, 0x3d, 0x72, 0x17, 0xc7, 0x3a, 0xb9, 0xbb, 0xb1, 0x7e, 0x5d, 0x47,
+		0xe1, 0x43, 0x12, 0x14, 0xa2, 0xad, 0xaf, 0xd0, 0xe1, 0x18, 0xea, 0x00, 0x21, 0x6a, 0x8d, 0xbb,
+		0x0f, 0xb2, 0x3e, 0xdb, 0x90, 0x89, 0xf2, 0xb8, 0x67, 0x17, 0xae, 0x7c, 0x47, 0x1c, 0xa6, 0xc3,
+		0x0c, 0xb8, 0x90, 0xd9, 0xfa, 0x1c, 0x4c, 0xd5, 0x49, 0xad, 0x51, 0xdf, 0xed, 0x64, 0x9d, 0xe4,
+		0xd8, 0xff, 0x34, 0x57, 0x83, 0x52, 0xf2, 0x43, 0x00, 0x29, 0x95, 0x98, 0x6d, 0xb4, 0xc7, 0xf0,
+		0x38, 0x82, 0xdc, 0xac, 0x91, 0xb6, 0xe5, 0x04, 0x55, 0x84, 0x6d, 0x11, 0x18, 0x74, 0x59, 0x40,
+		0xe2, 0x06, 0xd9, 0x46, 0xc3, 0x93, 0x19, 0xea, 0x03, 0x88, 0xde, 0xce, 0x73, 0x74, 0xb7, 0x13,
+		0xe0, 0x9c, 0x70, 0x02, 0xc4, 0x23, 0xf7, 0xb7, 0x0c, 0xcb, 0xe7, 0x05, 0x78, 0x84, 0x7b, 0x01,
+		0x12, 0x7d, 0x2a, 0xf3, 0xb8, 0x01, 0xe6, 0x3d, 0x6e, 0x80, 0x64, 0x9f, 0x6e, 0x46, 0xf8, 0x01,
+		0xce, 0x09, 0x3f, 0xc0, 0x68, 0x9f, 0x16, 0xfb, 0x1c, 0x01, 0x4f, 0xb9, 0x1c, 0x01, 0xe9, 0xa0,
+		0xb7, 0xd5, 0x83, 0x1a, 0xe2, 0x09, 0x78, 0xc2, 0xf6, 0x04, 0x8c, 0x45, 0x7a, 0x11, 0x38, 0xb2,
+		0xdf, 0x15, 0xb0, 0x12, 0x70, 0x05, 0x8c, 0xf3, 0x0f, 0x5b, 0x45, 0x91, 0xe8, 0xe3, 0x0b, 0x58,
+		0x09, 0xf8, 0x02, 0xb2, 0x7d, 0x08, 0xf6, 0x71, 0x06, 0xbc, 0x25, 0xdc, 0x19, 0x10, 0xbd, 0x5d,
+		0xe7, 0xcd, 0x1c, 0xcc, 0x1b, 0x50, 0x8d, 0xf0, 0x06, 0xe4, 0x22, 0x77, 0xae, 0x8c, 0xfc, 0xc0,
+		0xee, 0x80, 0xf5, 0x10, 0x77, 0x00, 0xdb, 0xb8, 0x9f, 0x88, 0x24, 0x3e, 0x80, 0x3f, 0x60, 0x3d,
+		0xc4, 0x1f, 0x20, 0xf7, 0x25, 0xdb, 0xd7, 0x21, 0x70, 0xc1, 0xeb, 0x10, 0x98, 0x8a, 0xb0, 0x3a,
+		0x9d, 0xd9, 0x1e, 0xe1, 0x11, 0xd8, 0x88, 0xf2, 0x08, 0xb0, 0x5d, 0xfb, 0x9b, 0x22, 0x29, 0x0e,
+		0xe1, 0x12, 0x58, 0x09, 0xb8, 0x04, 0xf6, 0xf6, 0x91, 0xb4, 0xc1, 0x7d, 0x02, 0xec, 0xd9, 0x76,
+		0xf6, 0x60, 0x3b, 0xe4, 0x32, 0x97, 0x13, 0xa9, 0x4c, 0x6e, 0x4c, 0x79, 0x00, 0x26, 0x05, 0x29,
+		0x5b, 0xcf, 0xe1, 0x5e, 0x81, 0x74, 0x3a, 0x46, 0x47, 0xdc, 0x63, 0xa0, 0x09, 0xe5, 0x04, 0x8c,
+		0xd9, 0xa0, 0xbd, 0xfd, 0x07, 0x74, 0x4f, 0xe6, 0xd2, 0x63, 0xca, 0xaf, 0x4a, 0x30, 0xe6, 0x56,
+		0x51, 0x9e, 0xfd, 0x65, 0x9a, 0xef, 0x2f, 0x5d, 0x5e, 0x85, 0x98, 0xd7, 0xab, 0x80, 0xcf, 0x29,
+		0xb7, 0x03, 0x0e, 0x03, 0xad, 0x6d, 0x3b, 0x0c, 0x44, 0x00, 0x15, 0xf3, 0x3d, 0xf0, 0x65, 0x89,
+		0x9d, 0x26, 0x4e, 0xd8, 0xc1, 0x64, 0x6c, 0xab, 0x2b, 0x9f, 0x82, 0x29, 0x17, 0xac, 0xbd, 0x87,
+		0x63, 0xbb, 0xe7, 0x9c, 0x0d, 0x5d, 0xe2, 0x9b, 0xb9, 0x7f, 0x29, 0xc1, 0x64, 0x40, 0x45, 0x86,
+		0x3a, 0x05, 0xa4, 0xbb, 0xe4, 0x14, 0x88, 0xed, 0xda, 0x29, 0xe0, 0xde, 0x93, 0xc6, 0xbd, 0x7b,
+		0xd2, 0xbf, 0x92, 0x60, 0xdc, 0xa3, 0xa9, 0x71, 0x08, 0x6a, 0x46, 0x9d, 0xf0, 0x5d, 0x22, 0xfd,
+		0x8d, 0x26, 0x49, 0xd3, 0xd8, 0xe2, 0x7b, 0x41, 0xfc, 0x89, 0x50, 0xf6, 0xc2, 0x93, 0xe6, 0xeb,
+		0x8a, 0xbd, 0xc1, 0x1c, 0x71, 0xdf, 0xe3, 0xe0, 0x97, 0x1b, 0x92, 0xce, 0xe5, 0x06, 0xfb, 0xf2,
+		0xf1, 0xa8, 0xeb, 0xf2, 0xb1, 0xfc, 0x38, 0xa4, 0xa9, 0xb3, 0xbf, 0x6a, 0xb4, 0xd9, 0x62, 0xed,
+		0x33, 0x6d, 0xbc, 0x17, 0x1b, 0x4c, 0x1a, 0x72, 0x4d, 0x7f, 0xb9, 0x2c, 0x8e, 0xb4, 0xc7, 0xe2,
+		0x38, 0x04, 0x69, 0x6c, 0x3d, 0xfb, 0x56, 0x06, 0xf0, 0x9b, 0xeb, 0x22, 0x43, 0xf9, 0xd9, 0x18,
+		0x4c, 0xf8, 0x16, 0x9a, 0xd0, 0xbe, 0x87, 0x1d, 0x23, 0x0f, 0xc6, 0x8f, 0x23, 0x00, 0x5b, 0x9a,
+		0x59, 0xbd, 0xa1, 0xe9, 0x16, 0xff, 0x24, 0x5e, 0x5c, 0x75, 0xe5, 0xe0, 0x45, 0x06, 0x4c, 0x75,
+		0x4d, 0xfe, 0x51, 0xbc, 0xb8, 0x6a, 0xa7, 0xf1, 0x36, 0x12, 0xb9, 0x4e, 0x5f, 0x77, 0x65, 0x6f,
+		0x24, 0xef, 0x0b, 0x6e, 0x87, 0xb1, 0x78, 0x2e, 0x8f, 0x83, 0xfd, 0xe5, 0xcf, 0x1e, 0xcd, 0x31,
+		0xe8, 0x37, 0xd9, 0x17, 0xc6, 0x54, 0x8e, 0xef, 0xe5, 0x42, 0xca, 0xc7, 0x05, 0xea, 0x07, 0x1c,
+		0x13, 0xdb, 0x7b, 0xe4, 0x29, 0x8b, 0xf1, 0x56, 0xc7, 0x5b, 0xa4, 0xd5, 0x36, 0x8c, 0x66, 0x95,
+		0xcd, 0xf1, 0x12, 0x64, 0x6d, 0x5e, 0xb1, 0xd5, 0x94, 0x7e, 0xc7, 0xca, 0x42, 0xd7, 0x98, 0xc7,
+		0x08, 0x1e, 0x63, 0x99, 0x6c, 0x4e, 0x5d, 0x4e, 0xa4, 0xa4, 0x1c, 0x3e, 0xf8, 0x1d, 0xcb, 0xc5,
+		0x95, 0x55, 0xd8, 0x1b, 0xba, 0xae, 0xca, 0x8f, 0x41, 0xda, 0x59, 0x92, 0x59, 0x98, 0x7e, 0x0f,
+		0x4f, 0x8b, 0x03, 0xab, 0xfc, 0x86, 0x04, 0x7b, 0x43, 0x57, 0x56, 0xb9, 0x0c, 0x49, 0x16, 0x33,
+		0xc9, 0xe3, 0x63, 0x4e, 0x0d, 0xb6, 0x22, 0xcf, 0xb2, 0x80, 0x4a, 0x95, 0x23, 0x2b, 0x6f, 0x83,
+		0x24, 0xcb, 0x91, 0x33, 0x30, 0xea, 0xbc, 0x23, 0x0d, 0x90, 0x2c, 0xcd, 0xcf, 0x97, 0x57, 0xc5,
+		0xa3, 0xb0, 0x73, 0x2b, 0x2a, 0x7e, 0x96, 0x0e, 0x20, 0xa9, 0x96, 0x2f, 0x97, 0xe7, 0xd7, 0x72,
+		0x71, 0xfc, 0x90, 0x1b, 0xfb, 0x5d, 0xbd, 0x80, 0x5f, 0x05, 0x58, 0xcb, 0x25, 0x5c, 0x59, 0x95,
+		0xf2, 0xf2, 0x42, 0x59, 0xcd, 0x8d, 0x28, 0x0f, 0xc3, 0x01, 0xd1, 0x8e, 0xa0, 0x47, 0xc8, 0x76,
+		0xcc, 0x48, 0x2e, 0xc7, 0x0c, 0xbe, 0x28, 0x5f, 0x88, 0x5e, 0x98, 0xf1, 0xa3, 0x0d, 0x9e, 0x8e,
+		0x9f, 0x19, 0x62, 0x55, 0xf7, 0xf5, 0x1e, 0xf7, 0x31, 0x1d, 0xb2, 0x49, 0xac, 0xda, 0x36, 0x33,
+		0x14, 0x98, 0x06, 0x1a, 0x57, 0xc7, 0x79, 0x2e, 0x45, 0x32, 0x19, 0xd8, 0xcb, 0xa4, 0x66, 0x55,
+		0x99, 0x10, 0x99, 0xfc, 0x43, 0xc9, 0xe3, 0x2c, 0xb7, 0xc2, 0x32, 0x95, 0xb7, 0x0f, 0xc5, 0xcb,
+		0x34, 0x8c, 0xa8, 0x65, 0x7c, 0xfa, 0x3f, 0x8e, 0x5f, 0xab, 0xa3, 0x3f, 0xab, 0x95, 0xe5, 0xd2,
+		0x6a, 0xe5, 0xd2, 0x0a, 0xf2, 0x72, 0x0a, 0x26, 0x04, 0x2f, 0x45, 0xe6, 0x88, 0xf2, 0x20, 0xec,
+		0x8f, 0xb0, 0x2a, 0x42, 0x82, 0x58, 0x3f, 0x22, 0xb9, 0xa1, 0xbd, 0x96, 0xc1, 0x0a, 0x24, 0x4d,
+		0x4b, 0xb3, 0xba, 0x26, 0x67, 0xe2, 0x63, 0x83, 0x9a, 0x19, 0xb3, 0xe2, 0x47, 0x85, 0xa2, 0xab,
+		0x9c, 0x8c, 0x72, 0x16, 0xb2, 0xde, 0x92, 0x68, 0x1e, 0x38, 0x42, 0x14, 0x53, 0xce, 0x83, 0x1c,
+		0xb4, 0x3e, 0x42, 0xb6, 0x97, 0x52, 0xd8, 0xf6, 0xf2, 0xa7, 0x24, 0x38, 0xd8, 0xc3, 0xd2, 0x90,
+		0x9f, 0xf3, 0x75, 0xf2, 0x89, 0x61, 0xec, 0x14, 0xf6, 0x55, 0xd2, 0x1d, 0x5f, 0x37, 0x1f, 0x81,
+		0x31, 0x77, 0xfe, 0x60, 0x9d, 0xfc, 0x72, 0x0c, 0xf6, 0x86, 0x1a, 0x2d, 0x2e, 0x15, 0x28, 0xdd,
+		0xa1, 0x0a, 0x7c, 0x12, 0xc0, 0xba, 0x29, 0xa2, 0xa8, 0xf9, 0x3a, 0x7a, 0x38, 0xc4, 0xbf, 0x48,
+		0x6a, 0x6b, 0x37, 0xf9, 0x24, 0x48, 0x5b, 0xfc, 0x17, 0xfa, 0x4f, 0x5c, 0x4e, 0x81, 0x2e, 0x5d,
+		0x63, 0x4d, 0xfb, 0x33, 0xc9, 0x83, 0x2d, 0xc6, 0xb9, 0xeb, 0xde, 0x6c, 0x53, 0x7e, 0x11, 0xf6,
+		0xfb, 0x0c, 0x05, 0x9b, 0x74, 0x62, 0x50, 0x7b, 0x61, 0xaf, 0xd7, 0x5e, 0x10, 0xa4, 0xdd, 0xab,
+		0xfd, 0x88, 0x77, 0xb5, 0x7f, 0x11, 0xc0, 0x71, 0x0e, 0x38, 0x61, 0x7f, 0x92, 0x3b, 0xec, 0xef,
+		0x2c, 0x8c, 0xa0, 0x24, 0x09, 0x3e, 0x05, 0x55, 0x31, 0x4a, 0x82, 0xcb, 0xb9, 0xc0, 0xa0, 0x95,
+		0x06, 0xc8, 0x41, 0x07, 0x6d, 0x44, 0x15, 0x4f, 0x79, 0xab, 0xb8, 0x27, 0xd2, 0xd5, 0x1b, 0x5e,
+		0xd5, 0xab, 0x30, 0x42, 0x47, 0x3e, 0xf4, 0x2e, 0xe5, 0x5b, 0x01, 0x34, 0xcb, 0xea, 0x34, 0x36,
+		0xba, 0x4e, 0x05, 0x47, 0xc3, 0x25, 0xa7, 0x24, 0xe0, 0xe6, 0x0e, 0x71, 0x11, 0x9a, 0x76, 0x50,
+		0x5d, 0x62, 0xe4, 0x22, 0xa8, 0x2c, 0x43, 0xd6, 0x8b, 0x1b, 0x7e, 0x37, 0xd4, 0x79, 0x5c, 0x25,
+		0x2d, 0xec, 0x1b, 0xdb, 0x3a, 0xe2, 0x0f, 0x1e, 0xd1, 0x84, 0xf2, 0x5a, 0x0c, 0xc6, 0xdc, 0x82,
+		0xf7, 0x8f, 0xcf, 0x04, 0x51, 0xbe, 0x53, 0x82, 0x94, 0xdd, 0xfd, 0x1e, 0xc1, 0xce, 0xce, 0x1d,
+		0x61, 0xfb, 0xf0, 0x82, 0x1d, 0x13, 0xc5, 0xed, 0x63, 0xa2, 0xf3, 0xf6, 0xf2, 0x17, 0xe5, 0x10,
+		0x71, 0xf3, 0x5a, 0x04, 0x1c, 0xf2, 0xd5, 0xfe, 0xfc, 0x60, 0x57, 0xf1, 0xf0, 0x08, 0xcb, 0x75,
+		0x8d, 0x8e, 0x25, 0x94, 0xba, 0x2b, 0xc2, 0x88, 0x4d, 0x44, 0xf7, 0x9d, 0x3d, 0x69, 0xe8, 0x3b,
+		0x7b, 0x76, 0x2d, 0x31, 0x77, 0x2d, 0xef, 0x95, 0x20, 0x25, 0xe6, 0x84, 0xfc, 0xb4, 0x3b, 0xf2,
+		0x4c, 0x1c, 0x6d, 0x46, 0xea, 0x23, 0x4e, 0xdf, 0x41, 0x09, 0x06, 0x5c, 0xc7, 0x87, 0x0d, 0xb8,
+		0xe6, 0x96, 0xdd, 0x5f, 0xe0, 0xa7, 0x5c, 0x7c, 0x33, 0xf6, 0x8e, 0x5b, 0x17, 0x5c, 0xe6, 0xe2,
+		0x21, 0xcb, 0x5c, 0x54, 0x14, 0x75, 0x22, 0x2a, 0x8a, 0x3a, 0xd8, 0xeb, 0x91, 0x5d, 0xf6, 0xfa,
+		0x1d, 0x31, 0xc8, 0xb8, 0xdc, 0xa3, 0xf2, 0xa3, 0x9e, 0x88, 0xec, 0x99, 0x5e, 0xae, 0x54, 0x57,
+		0x48, 0xb6, 0x87, 0x4d, 0xb1, 0xe1, 0xd9, 0x74, 0xf7, 0x6f, 0x67, 0x85, 0x5f, 0x60, 0x1d, 0x89,
+		0xb8, 0xc0, 0xfa, 0xed, 0x12, 0xa4, 0x6c, 0xb3, 0x7b, 0xd8, 0x43, 0xcc, 0x7d, 0x90, 0xe4, 0x96,
+		0x25, 0x3b, 0xc5, 0xe4, 0xa9, 0x50, 0xb7, 0x72, 0x01, 0x52, 0xe2, 0x0b, 0x88, 0x7c, 0x55, 0xb3,
+		0xd3, 0x27, 0x9f, 0x80, 0x8c, 0xeb, 0x00, 0x18, 0x55, 0xe3, 0x72, 0xf9, 0xf9, 0xdc, 0x9e, 0xc2,
+		0xe8, 0xad, 0xdb, 0x33, 0xf1, 0x65, 0x72, 0x03, 0x67, 0xb3, 0x5a, 0x9e, 0xbf, 0x54, 0x9e, 0x7f,
+		0x36, 0x27, 0x15, 0x32, 0xb7, 0x6e, 0xcf, 0x8c, 0xaa, 0x84, 0x7a, 0x14, 0x4f, 0x3e, 0x0b, 0x13,
+		0xbe, 0x81, 0xf1, 0x9a, 0x2d, 0x32, 0x64, 0x17, 0xd6, 0x57, 0x97, 0x16, 0xe7, 0x4b, 0x6b, 0xe5,
+		0x2a, 0x8b, 0xdb, 0xc5, 0x0f, 0xcc, 0x2c, 0x2d, 0x5e, 0xbc, 0xb4, 0x56, 0x9d, 0x5f, 0x5a, 0x2c,
+		0x2f, 0xaf, 0x55, 0x4b, 0x6b, 0x6b, 0xa5, 0xf9, 0x67, 0x73, 0xb1, 0x33, 0xb7, 0x33, 0x90, 0xc0,
+		0xe8, 0x3c, 0x79, 0x1e, 0x12, 0xd4, 0x15, 0xd2, 0x33, 0x02, 0xac, 0xd0, 0xdb, 0x37, 0x2c, 0x5f,
+		0x80, 0x11, 0xea, 0x25, 0x91, 0x7b, 0x87, 0x84, 0x15, 0xfa, 0x38, 0x8b, 0xb1, 0x31, 0x74, 0x46,
+		0xf6, 0x8c, 0x11, 0x2b, 0xf4, 0xf6, 0x1d, 0xcb, 0x4b, 0x30, 0x2a, 0x36, 0xc9, 0xfd, 0x02, 0xb7,
+		0x0a, 0x7d, 0x1d, 0xba, 0xd8, 0x35, 0xe6, 0x6c, 0xe8, 0x1d, 0x3e, 0x56, 0xe8, 0xe3, 0x55, 0xc6,
+		0x27, 0x34, 0xf8, 0x76, 0xb4, 0x4f, 0x44, 0x58, 0xa1, 0x9f, 0x9f, 0x58, 0x56, 0x21, 0xed, 0xb8,
+		0x71, 0xfa, 0x07, 0xc5, 0x15, 0x06, 0x70, 0x98, 0xcb, 0x6f, 0x83, 0x71, 0xef, 0x56, 0x77, 0xb0,
+		0xa8, 0xb3, 0xc2, 0x80, 0x1e, 0x69, 0xa4, 0xef, 0xdd, 0xf7, 0x0e, 0x16, 0x85, 0x56, 0x18, 0xd0,
+		0x41, 0x2d, 0xbf, 0x0c, 0x93, 0xc1, 0x7d, 0xe9, 0xe0, 0x41, 0x69, 0x85, 0x21, 0x5c, 0xd6, 0x72,
+		0x0b, 0xe4, 0x90, 0xfd, 0xec, 0x10, 0x31, 0x6a, 0x85, 0x61, 0x3c, 0xd8, 0x72, 0x1d, 0x26, 0xfc,
+		0x9b, 0xc4, 0x41, 0x63, 0xd6, 0x0a, 0x03, 0x7b, 0xb3, 0x59, 0x2d, 0xde, 0xcd, 0xe5, 0xa0, 0x31,
+		0x6c, 0x85, 0x81, 0x9d, 0xdb, 0xf2, 0x3a, 0x80, 0x6b, 0x7f, 0x38, 0x40, 0x4c, 0x5b, 0x61, 0x10,
+		0x37, 0xb7, 0xdc, 0x86, 0xa9, 0xb0, 0x8d, 0xe3, 0x30, 0x21, 0x6e, 0x85, 0xa1, 0xbc, 0xdf, 0x28,
+		0xcf, 0xde, 0x2d, 0xe0, 0x60, 0x21, 0x6f, 0x85, 0x01, 0xdd, 0xe0, 0x73, 0xa5, 0xc8, 0x38, 0xe7,
+		0xe3, 0x3d, 0xe3, 0x9c, 0x9d, 0xc8, 0x65, 0x3b, 0xb6, 0xf9, 0x73, 0x2a, 0xdc, 0xcb, 0x5f, 0xd0,
+		0x31, 0x2d, 0xed, 0x5a, 0x43, 0xdf, 0xb2, 0x9f, 0x32, 0xe2, 0x69, 0x1e, 0xe4, 0xbc, 0x8f, 0x41,
+		0xcd, 0x8a, 0xdc, 0x3e, 0x0f, 0x1a, 0x45, 0xbe, 0xf2, 0xd8, 0xef, 0x3e, 0x44, 0xff, 0xf0, 0xe5,
+		0x1e, 0x8f, 0x25, 0xf5, 0x79, 0x92, 0x29, 0xe4, 0x31, 0xa5, 0x42, 0xcf, 0x37, 0x06, 0x0a, 0xbd,
+		0xe2, 0xbe, 0x95, 0xf7, 0x49, 0x90, 0xbd, 0xd4, 0x30, 0x2d, 0xa3, 0xd3, 0xa8, 0x69, 0x4d, 0xba,
+		0xbc, 0x9c, 0x1f, 0xf4, 0x56, 0xd6, 0x5c, 0x1a, 0xcd, 0x15, 0xfe, 0xde, 0x12, 0x43, 0x91, 0x17,
+		0x20, 0x79, 0x5d, 0x6b, 0xb2, 0x3b, 0x51, 0xee, 0xc7, 0xd6, 0xfc, 0x3c, 0x77, 0xd9, 0x51, 0x6e,
+		0x2a, 0x0c, 0xb7, 0x18, 0xcb, 0x4b, 0xca, 0xbb, 0x25, 0xc8, 0x39, 0x2d, 0x53, 0x49, 0xcd, 0xe8,
+		0xd4, 0xe9, 0x56, 0xa0, 0xdd, 0x76, 0xc5, 0x0a, 0x88, 0xa4, 0xfc, 0x28, 0x37, 0xb2, 0x62, 0x7d,
+		0x8d, 0xac, 0x84, 0xcb, 0xc0, 0x0a, 0xb9, 0x72, 0x1e, 0x0f, 0xbb, 0x72, 0xae, 0xfc, 0x08, 0xbd,
+		0xe1, 0xd2, 0x6a, 0x35, 0x4c, 0x9c, 0x1d, 0x2a, 0xdd, 0xdc, 0x5f, 0x86, 0x44, 0x47, 0xb3, 0xf8,
+		0x96, 0x77, 0xee, 0xdc, 0xd0, 0x4f, 0x46, 0xb1, 0x3e, 0x53, 0x1a, 0xf2, 0x73, 0x80, 0x37, 0x24,
+		0xaa, 0x94, 0x5e, 0xec, 0x8e, 0xe8, 0xe1, 0x45, 0x11, 0x6c, 0x9f, 0xfc, 0x36, 0xc0, 0x7b, 0x1e,
+		0x78, 0xb2, 0xac, 0x6f, 0x11, 0x46, 0x39, 0x7e, 0x47, 0x94, 0xc7, 0x5b, 0xda, 0xcd, 0x79, 0x4a,
+		0x0d, 0xe9, 0xf3, 0xa7, 0xb5, 0x7e, 0x4b, 0x02, 0x70, 0x18, 0x23, 0x6b, 0x78, 0xe8, 0x22, 0x52,
+		0xb4, 0x52, 0x71, 0xe8, 0x72, 0x3c, 0x4a, 0x12, 0x7c, 0x6c, 0x9d, 0x1b, 0xc7, 0xe6, 0x7d, 0xfa,
+		0xb3, 0x47, 0x25, 0x56, 0xeb, 0x44, 0x2d, 0xc0, 0xf6, 0x0c, 0x73, 0xcf, 0x54, 0x07, 0x1c, 0xf0,
+		0x71, 0x61, 0x55, 0x33, 0x82, 0xc0, 0xb0, 0xb1, 0x9c, 0xf7, 0xe1, 0x63, 0x12, 0x64, 0x16, 0x5c,
+		0x8f, 0x39, 0xe2, 0xa9, 0x99, 0xa1, 0x37, 0xae, 0x91, 0x8e, 0x7d, 0x6a, 0xc6, 0x92, 0x68, 0xf9,
+		0xb2, 0x6f, 0x02, 0x5a, 0x3b, 0xe2, 0xbd, 0x23, 0x91, 0x46, 0xac, 0x1b, 0x64, 0xc3, 0x6c, 0x08,
+		0x3e, 0xab, 0x22, 0x89, 0x21, 0x10, 0x26, 0xa9, 0x75, 0xd1, 0x99, 0x8f, 0x9f, 0x03, 0xb5, 0xf0,
+		0xcb, 0xc1, 0xcc, 0x33, 0x30, 0x21, 0xf2, 0xe7, 0x59, 0x36, 0x12, 0xa9, 0x13, 0x4b, 0x6b, 0x34,
+		0x59, 0xac, 0x68, 0x5a, 0x15, 0x49, 0xde, 0xd4, 0xcf, 0x8d, 0xba, 0x77, 0xc6, 0xf3, 0x90, 0x33,
+		0xda, 0xa4, 0xe3, 0x09, 0x91, 0x61, 0xd2, 0x98, 0xff, 0xcc, 0xa7, 0x4e, 0x4d, 0x73, 0x86, 0xf3,
+		0xf0, 0x0a, 0xf6, 0xed, 0x02, 0x75, 0x42, 0x60, 0xf0, 0x6c, 0xf9, 0x45, 0xcf, 0x39, 0x59, 0x77,
+		0xc3, 0x79, 0xd8, 0x66, 0x3a, 0xc0, 0xd4, 0x92, 0xbe, 0x33, 0x97, 0xff, 0x3d, 0x87, 0xb4, 0xb3,
+		0x73, 0xc6, 0xa7, 0x64, 0x5c, 0x87, 0x66, 0x94, 0x0c, 0x6e, 0x2a, 0x5e, 0xd6, 0x1a, 0x4d, 0xf1,
+		0xf9, 0x54, 0x95, 0xa7, 0xe4, 0xa2, 0xed, 0xf0, 0x4c, 0xd0, 0x1d, 0x9a, 0x12, 0x25, 0x1b, 0x73,
+		0x86, 0x5e, 0xf7, 0x7a, 0x36, 0xe5, 0x79, 0x48, 0x5a, 0xc6, 0x35, 0xa2, 0x73, 0x06, 0xcd, 0x3d,
+		0x38, 0xc4, 0xdb, 0x70, 0x2a, 0x47, 0x95, 0xdf, 0x02, 0xb9, 0x3a, 0x69, 0x92, 0x2d, 0xca, 0x39,
+		0xfc, 0xaa, 0x3c, 0x61, 0xef, 0x07, 0xec, 0xea, 0xe5, 0xb7, 0x09, 0x9b, 0x54, 0x85, 0x52, 0x92,
+		0x57, 0xbd, 0xcf, 0x85, 0x8e, 0xf2, 0xe3, 0xec, 0x88, 0x3e, 0xba, 0x24, 0xcf, 0xad, 0x0b, 0xdd,
+		0x24, 0x50, 0x82, 0xba, 0xfa, 0x86, 0xa1, 0xd3, 0xaf, 0x0e, 0xf2, 0x7d, 0x5d, 0x8a, 0x9d, 0x9d,
+		0xda, 0xf9, 0xfc, 0xec, 0x74, 0x15, 0xb2, 0x0e, 0x28, 0x9d, 0x21, 0xe9, 0x61, 0x67, 0xc8, 0xb8,
+		0x4d, 0x00, 0x41, 0xf0, 0xf3, 0x84, 0xce, 0x1c, 0xcc, 0x03, 0x8f, 0x51, 0xe9, 0x3b, 0x9b, 0xdd,
+		0x9d, 0x71, 0x11, 0xc0, 0x6b, 0x16, 0xad, 0x86, 0x5e, 0x35, 0x49, 0x73, 0xb3, 0xca, 0x39, 0x87,
+		0x74, 0x33, 0xc3, 0x8f, 0xe6, 0x64, 0xab, 0xa1, 0x57, 0x48, 0x73, 0x73, 0xc1, 0xa6, 0x22, 0x3f,
+		0x09, 0x07, 0x9d, 0xde, 0x1b, 0x7a, 0x75, 0xdb, 0x68, 0xd6, 0xab, 0x1d, 0xb2, 0x59, 0xad, 0xd1,
+		0xd7, 0xfd, 0xc6, 0x28, 0xcf, 0xf6, 0xdb, 0x20, 0x2b, 0xfa, 0x25, 0xa3, 0x59, 0x57, 0xc9, 0xe6,
+		0x3c, 0x16, 0xe3, 0x41, 0x9a, 0x83, 0xdd, 0xa8, 0x63, 0x54, 0x4a, 0x1c, 0x43, 0xe3, 0xed, 0xcc,
+		0xc5, 0x3a, 0x06, 0xca, 0xca, 0xad, 0x6e, 0xd3, 0x6a, 0x54, 0x35, 0xd3, 0x24, 0x56, 0x95, 0x0b,
+		0x63, 0x96, 0x36, 0xff, 0xf8, 0xa0, 0x4d, 0xcf, 0x51, 0x12, 0x25, 0xa4, 0xb0, 0x46, 0x09, 0x14,
+		0x53, 0xef, 0xfc, 0xf0, 0xd1, 0x3d, 0x5f, 0xfa, 0xf0, 0xd1, 0x3d, 0xca, 0x05, 0xfa, 0x78, 0x16,
+		0x9f, 0x9e, 0xc4, 0x94, 0xcf, 0x41, 0x5a, 0x13, 0x09, 0x76, 0x35, 0xae, 0xc7, 0xf4, 0x76, 0x40,
+		0xf1, 0x56, 0x60, 0x72, 0xe1, 0xea, 0xaa, 0xd6, 0xe8, 0xc8, 0x65, 0x7c, 0x2e, 0x55, 0xc8, 0xfb,
+		0xa0, 0x9a, 0xc2, 0x99, 0x22, 0x3c, 0x5f, 0x5e, 0x8e, 0x8a, 0xc9, 0x4b, 0xcf, 0xdd, 0xf3, 0x99,
+		0x4f, 0x9d, 0x3a, 0xcc, 0xc9, 0x5c, 0xf5, 0x85, 0xe7, 0x09, 0x7a, 0xfe, 0xb0, 0x3d, 0x57, 0x9f,
+		0x2f, 0xc3, 0x28, 0x6b, 0xaa, 0x29, 0x3f, 0x03, 0x23, 0x6d, 0xfc, 0x91, 0x97, 0x3c, 0x2f, 0xa8,
+		0x06, 0xe7, 0x0d, 0x85, 0x77, 0x4b, 0x19, 0xc3, 0x53, 0xde, 0x1d, 0x03, 0x58, 0xb8, 0x7a, 0x75,
+		0xad, 0xd3, 0x68, 0x37, 0x89, 0x75, 0xb7, 0xfa, 0xbe, 0x0e, 0x7b, 0x9d, 0xbe, 0x9b, 0x9d, 0xda,
+		0xf0, 0xfd, 0x9f, 0x72, 0xee, 0x7f, 0x77, 0x6a, 0xa1, 0x64, 0xeb, 0xa6, 0x65, 0x93, 0x8d, 0x0f,
+		0x4f, 0x76, 0xc1, 0xb4, 0x82, 0x9c, 0x7d, 0x01, 0x32, 0x0e, 0x33, 0x4c, 0x79, 0x11, 0x52, 0x16,
+		0xff, 0xcd, 0x19, 0xac, 0x44, 0x33, 0x58, 0xa0, 0xb9, 0x99, 0x6c, 0xa3, 0x2b, 0x7f, 0x2d, 0x01,
+		0xb8, 0xa6, 0xde, 0x1b, 0x53, 0xc6, 0xd0, 0x2f, 0xc1, 0x15, 0x7c, 0x7c, 0xd7, 0x4f, 0x7b, 0x32,
+		0x02, 0x2e, 0xa6, 0x7e, 0x6f, 0x0c, 0x3f, 0x47, 0xcc, 0x95, 0xc2, 0x1b, 0x9f, 0x07, 0xeb, 0x30,
+		0x4a, 0x74, 0xab, 0xd3, 0xb0, 0x0f, 0xcb, 0x1e, 0x8a, 0x1a, 0xf3, 0x90, 0x4e, 0x95, 0x75, 0xab,
+		0xb3, 0xe3, 0x96, 0x00, 0x41, 0xcb, 0xc5, 0x8f, 0x0f, 0xc6, 0x21, 0x1f, 0x85, 0x8a, 0x46, 0x76,
+		0xad, 0x43, 0x68, 0x86, 0xf7, 0x2e, 0x6c, 0x56, 0x64, 0xf3, 0xa5, 0x4b, 0x05, 0x34, 0xf6, 0x50,
+		0xb8, 0x10, 0x74, 0x77, 0xd6, 0x5d, 0xd6, 0xa1, 0x40, 0x17, 0xaf, 0x35, 0x98, 0x10, 0x97, 0x6b,
+		0x36, 0xb4, 0xa6, 0x86, 0x17, 0xcb, 0xe3, 0xc3, 0xaf, 0x34, 0xe2, 0x82, 0xce, 0x1c, 0x23, 0x21,
+		0x97, 0x61, 0x54, 0x50, 0x4b, 0x0c, 0x4f, 0x4d, 0xe0, 0xe2, 0x25, 0x2a, 0xf7, 0x7a, 0x43, 0x2d,
+		0x9a, 0x84, 0x9a, 0xb1, 0xf3, 0x16, 0xeb, 0xfd, 0x16, 0xb4, 0x64, 0xcf, 0x05, 0x8d, 0x1b, 0x8d,
+		0x3f, 0x4e, 0x63, 0xe0, 0xeb, 0xdf, 0xfc, 0xc3, 0xb2, 0x0a, 0xc0, 0xa6, 0x2a, 0x6a, 0xd2, 0x7c,
+		0x62, 0xb7, 0xf3, 0x3d, 0xcd, 0x88, 0x2c, 0x98, 0xd6, 0x37, 0x6a, 0x84, 0xfe, 0x20, 0x06, 0x63,
+		0xee, 0x11, 0xfa, 0x47, 0xb9, 0x68, 0xc9, 0xcb, 0x8e, 0x9a, 0x62, 0xf7, 0x84, 0x1e, 0x88, 0x52,
+		0x53, 0x01, 0x69, 0xee, 0xa3, 0x9f, 0xfe, 0x36, 0x03, 0x49, 0x1e, 0xc8, 0xb7, 0x12, 0xb0, 0x8f,
+		0xa5, 0x7e, 0x2f, 0x1d, 0x8c, 0x8b, 0x97, 0x0e, 0x42, 0xcd, 0xe3, 0xfb, 0x00, 0xdf, 0x3f, 0xa8,
+		0x7a, 0xa2, 0x03, 0xf1, 0x04, 0x05, 0xb7, 0xcb, 0x76, 0xef, 0x4d, 0x0c, 0x90, 0x44, 0x30, 0x47,
+		0x0f, 0x23, 0x0c, 0x5e, 0xc9, 0x2a, 0xb3, 0x1c, 0xf9, 0x14, 0xc8, 0xdb, 0xb6, 0xbf, 0xa3, 0xea,
+		0x30, 0x02, 0xe1, 0x26, 0x9d, 0x12, 0x01, 0x8e, 0x4f, 0xbd, 0x18, 0x7a, 0xbd, 0xca, 0x5e, 0xb3,
+		0xe6, 0x6f, 0x85, 0x63, 0xce, 0x02, 0x66, 0xc8, 0xdf, 0x21, 0x31, 0x33, 0xdb, 0xb7, 0x1b, 0xe7,
+		0xbb, 0x9c, 0xb5, 0x01, 0x26, 0xc5, 0x9f, 0x7f, 0xf6, 0x68, 0x61, 0x47, 0x6b, 0x35, 0x8b, 0x4a,
+		0x08, 0x1d, 0x25, 0xcc, 0x41, 0x80, 0xf6, 0xb8, 0x77, 0x37, 0x2f, 0x2f, 0x42, 0x0e, 0x9f, 0x75,
+		0xe8, 0xf0, 0x4f, 0x7d, 0x57, 0x37, 0x09, 0xe1, 0xfb, 0xa1, 0x03, 0xb3, 0x21, 0x6f, 0x8b, 0xcf,
+		0xe2, 0x1b, 0xdd, 0xfc, 0x64, 0x2d, 0x7b, 0x8d, 0xec, 0xa8, 0x1c, 0xef, 0x02, 0x21, 0xb2, 0x0a,
+		0x53, 0xae, 0x45, 0xb1, 0xd9, 0x34, 0x6e, 0x34, 0x1b, 0x26, 0x6e, 0x83, 0xe2, 0x83, 0x89, 0x9c,
+		0xec, 0x2c, 0x8b, 0x02, 0x59, 0x7e, 0x05, 0x0e, 0x6c, 0x35, 0x8d, 0x0d, 0xbc, 0xf5, 0xd6, 0x78,
+		0xa5, 0xdb, 0xa8, 0x57, 0xb9, 0xa0, 0x55, 0x6b, 0x5a, 0x3b, 0x9f, 0xbe, 0x23, 0x6f, 0xc9, 0x3e,
+		0x46, 0x78, 0x89, 0xd2, 0xad, 0x30, 0xb2, 0xf3, 0x5a, 0x5b, 0xbe, 0x01, 0x87, 0x9c, 0x6e, 0x84,
+		0xd4, 0x0a, 0x77, 0x54, 0xeb, 0x01, 0x9b, 0x76, 0xa0, 0xe2, 0xa7, 0xe1, 0x10, 0x0a, 0xa0, 0xd6,
+		0xb5, 0x0c, 0x1c, 0xcd, 0x36, 0x86, 0x7f, 0x98, 0xd5, 0x36, 0xe9, 0xf0, 0x30, 0xe6, 0x0c, 0x95,
+		0xb4, 0x3c, 0xbe, 0xe5, 0xd1, 0xb5, 0x8c, 0x79, 0x01, 0xb1, 0x4a, 0x3a, 0xcc, 0x01, 0xdc, 0x80,
+		0x3c, 0x0a, 0x82, 0x07, 0xbf, 0xda, 0x21, 0x37, 0xb4, 0x4e, 0x3d, 0x3f, 0xb6, 0xcb, 0x67, 0xda,
+		0xf7, 0xb6, 0x1a, 0xba, 0xbb, 0x36, 0x95, 0x92, 0x93, 0x0d, 0x38, 0xea, 0x92, 0x37, 0xee, 0xc1,
+		0xd2, 0x0d, 0xab, 0x51, 0x23, 0xd8, 0xe0, 0x86, 0x51, 0xcf, 0x8f, 0x0f, 0x39, 0x69, 0x0f, 0x39,
+		0x04, 0x99, 0x0f, 0x6b, 0x99, 0x92, 0x5b, 0xa5, 0xd4, 0x64, 0x0b, 0x0a, 0x9b, 0x4d, 0xcd, 0xdc,
+		0xae, 0x76, 0x75, 0x47, 0xb1, 0x54, 0xdb, 0x44, 0xd7, 0x9a, 0xd6, 0x4e, 0x3e, 0x7b, 0x47, 0x43,
+		0x92, 0xa7, 0x94, 0xd7, 0x5d, 0x84, 0x57, 0x19, 0x5d, 0xb9, 0x0b, 0x07, 0x71, 0x44, 0x42, 0x6a,
+		0xa6, 0x53, 0x75, 0xe2, 0xce, 0xaa, 0x6d, 0x69, 0x37, 0x2f, 0xf8, 0x6b, 0xa6, 0x73, 0xf2, 0x14,
+		0xc8, 0x6d, 0xd2, 0xa1, 0xe7, 0xbe, 0xf8, 0x42, 0xe5, 0x8d, 0x86, 0x5e, 0x37, 0x6e, 0xd0, 0xab,
+		0x07, 0x09, 0x75, 0xd2, 0x55, 0xf2, 0x3c, 0x2d, 0x90, 0x9f, 0x86, 0x83, 0x9b, 0x46, 0xa7, 0x46,
+		0xea, 0xde, 0x26, 0xb2, 0xc7, 0x4d, 0xea, 0xf4, 0x56, 0x41, 0x4a, 0x3d, 0xc0, 0x40, 0xdc, 0x75,
+		0xb1, 0xa7, 0x4d, 0xea, 0xf2, 0x5b, 0xe0, 0x70, 0x18, 0xbe, 0xd6, 0xb5, 0xb6, 0xd9, 0xdb, 0xc6,
+		0x72, 0x9f, 0x65, 0xed, 0x60, 0x90, 0x76, 0x49, 0x20, 0x17, 0xef, 0xc5, 0xf5, 0xf3, 0xd6, 0x17,
+		0x3f, 0x7e, 0xf2, 0xa0, 0xeb, 0xf5, 0xfc, 0x9b, 0xf6, 0x41, 0x00, 0x53, 0xfa, 0xb8, 0x15, 0x96,
+		0x1d, 0xb3, 0xd4, 0xbe, 0x63, 0x74, 0x05, 0xc0, 0xa1, 0x69, 0x5f, 0x32, 0x8a, 0xf4, 0xd3, 0x08,
+		0x48, 0x8f, 0x67, 0xc3, 0xb5, 0x68, 0x3f, 0xed, 0x58, 0x85, 0xb1, 0x7e, 0x3a, 0xce, 0xbd, 0x5e,
+		0x71, 0x24, 0x6a, 0x0b, 0xec, 0x51, 0xfe, 0xad, 0x84, 0xa1, 0xa8, 0xbe, 0xf5, 0xcd, 0x6e, 0x72,
+		0x0d, 0xe4, 0x0e, 0xf1, 0x0c, 0x83, 0xd5, 0x11, 0x41, 0x2d, 0xbb, 0x5b, 0x2e, 0x27, 0x3b, 0xfe,
+		0xd2, 0xbb, 0x64, 0xde, 0x72, 0xdb, 0xe6, 0x77, 0x25, 0xbc, 0x5c, 0x57, 0x0f, 0x72, 0xbf, 0x02,
+		0x63, 0xee, 0xaa, 0x79, 0x27, 0xee, 0x1d, 0xa4, 0x13, 0xee, 0xf6, 0x7b, 0x88, 0xc8, 0x57, 0x1d,
+		0x1b, 0x82, 0x9d, 0x40, 0x3c, 0x3c, 0x30, 0x53, 0x44, 0xc3, 0x42, 0x6d, 0x09, 0x36, 0x36, 0x7f,
+		0x22, 0x41, 0x62, 0xd5, 0x30, 0x9a, 0xf2, 0x2b, 0x30, 0xa9, 0x1b, 0x56, 0x15, 0xd7, 0x5b, 0x52,
+		0x17, 0x3e, 0x20, 0x66, 0x9f, 0x95, 0x7b, 0xf2, 0xea, 0xcb, 0x9f, 0x3d, 0x1a, 0xc4, 0x0c, 0x53,
+		0x8d, 0x13, 0xba, 0x61, 0xcd, 0x51, 0x20, 0xe6, 0x20, 0x92, 0x37, 0x61, 0xdc, 0x5b, 0x1d, 0xb3,
+		0xe1, 0x4a, 0xfd, 0xaa, 0x1b, 0xef, 0x5b, 0xd5, 0xd8, 0x86, 0xab, 0x1e, 0xf6, 0x20, 0xfd, 0x57,
+		0x71, 0xe4, 0x5e, 0x84, 0xdc, 0x55, 0x7f, 0xb0, 0x64, 0x19, 0x46, 0x45, 0x70, 0xa4, 0x34, 0x60,
+		0xdc, 0xa5, 0x9b, 0x9d, 0x1c, 0x57, 0xf9, 0x74, 0x0c, 0x0e, 0x60, 0x0c, 0x25, 0xf7, 0x1c, 0xf3,
+		0x65, 0x9e, 0x9d, 0xf7, 0xec, 0xa0, 0xbb, 0x33, 0xd4, 0xaf, 0x3d, 0x16, 0xf4, 0x5e, 0x5f, 0x85,
+		0x09, 0xb4, 0xb7, 0xd1, 0xf3, 0x7c, 0x67, 0xce, 0xeb, 0x71, 0xa3, 0x59, 0xe7, 0x2d, 0x42, 0xd7,
+		0xf5, 0x55, 0x98, 0xd0, 0xc9, 0x0d, 0x0f, 0xdd, 0xf8, 0xee, 0xe8, 0xea, 0xe4, 0x86, 0x8b, 0xae,
+		0x13, 0x97, 0x93, 0xf0, 0xc4, 0xe5, 0x9c, 0x83, 0x38, 0xda, 0x46, 0x23, 0x43, 0xe8, 0x0d, 0x44,
+		0x70, 0xd9, 0xb8, 0x15, 0x38, 0xc0, 0xdd, 0x86, 0xe6, 0xca, 0x26, 0xe5, 0x28, 0xa1, 0x1d, 0x7a,
+		0x96, 0xec, 0x84, 0xf8, 0x10, 0xc7, 0x06, 0xf3, 0x21, 0xfe, 0x6f, 0x8f, 0xe2, 0x5c, 0x35, 0xcc,
+		0x06, 0xfe, 0xc7, 0x60, 0x3f, 0xfe, 0x50, 0x61, 0x42, 0x8d, 0x35, 0xea, 0xf8, 0x96, 0x9f, 0x71,
+		0x43, 0xe7, 0x21, 0x72, 0xbd, 0x74, 0x39, 0x03, 0x0b, 0x77, 0x70, 0xc4, 0x77, 0xef, 0xe0, 0x58,
+		0xb6, 0x9d, 0x3c, 0x89, 0x3b, 0x5a, 0x34, 0x85, 0xa7, 0x87, 0xcd, 0xf6, 0xcf, 0x48, 0x30, 0x45,
+		0x27, 0x45, 0xe3, 0x55, 0x42, 0x5d, 0xfb, 0xfc, 0x14, 0xf2, 0x4e, 0x7b, 0x8f, 0x3b, 0x06, 0xa3,
+		0xde, 0x6d, 0x92, 0xaa, 0x56, 0x63, 0x9b, 0x44, 0xda, 0x75, 0x75, 0x9c, 0xe5, 0x96, 0x58, 0x66,
+		0x38, 0x93, 0x12, 0xbb, 0xf7, 0xb6, 0xb2, 0x4e, 0x7d, 0x42, 0x82, 0x29, 0xb7, 0xc9, 0x55, 0x21,
+		0x96, 0x85, 0x5f, 0x56, 0x7a, 0x83, 0xba, 0x88, 0x59, 0xa3, 0x7f, 0x24, 0x06, 0x07, 0x2a, 0xb5,
+		0x6d, 0x82, 0xec, 0xa9, 0xcf, 0xfb, 0x2c, 0xb9, 0xf0, 0x3a, 0xa5, 0xdd, 0x4b, 0xd3, 0x73, 0x90,
+		0xc2, 0xb9, 0x7f, 0x37, 0x0e, 0x63, 0x75, 0x72, 0x83, 0xda, 0x5c, 0xab, 0x90, 0x25, 0x9b, 0x9b,
+		0xa4, 0x66, 0x35, 0xae, 0xf3, 0x73, 0xcb, 0xf8, 0xd0, 0xa7, 0x32, 0x36, 0x01, 0xfb, 0xe8, 0x72,
+		0x8f, 0xf2, 0x03, 0x12, 0x1c, 0xb2, 0xfb, 0xb5, 0xea, 0xd8, 0x6e, 0xd4, 0xb9, 0x40, 0x3a, 0x26,
+		0x1e, 0x69, 0xf0, 0x07, 0x4f, 0x5d, 0x4f, 0x00, 0x26, 0xc4, 0x83, 0xa6, 0xfc, 0xfd, 0xbf, 0x63,
+		0x30, 0x8e, 0x1c, 0x75, 0x80, 0xf8, 0x93, 0x40, 0x2c, 0x93, 0x03, 0x1d, 0x87, 0x09, 0xfb, 0xed,
+		0x02, 0x0e, 0xc6, 0x6e, 0xf9, 0x65, 0x45, 0x36, 0x03, 0x54, 0xfe, 0x8f, 0x04, 0xd3, 0x61, 0x6d,
+		0xba, 0xeb, 0xe3, 0x74, 0x0f, 0x8c, 0x99, 0x96, 0xd6, 0xb1, 0xaa, 0x9e, 0x77, 0x1b, 0x32, 0x34,
+		0x8f, 0xfb, 0xae, 0x02, 0xdd, 0x8f, 0x0f, 0xd2, 0xfd, 0xc4, 0x60, 0xdd, 0x1f, 0x09, 0xed, 0x7e,
+		0x17, 0xc6, 0x71, 0xdd, 0x46, 0xe3, 0x97, 0x6d, 0xc5, 0xc3, 0x3f, 0x39, 0xb5, 0x0c, 0xc9, 0x1b,
+		0x4e, 0xb3, 0xef, 0x40, 0x65, 0x31, 0x2a, 0xca, 0x7b, 0x62, 0x90, 0xbd, 0x62, 0x1f, 0x2a, 0x51,
+		0x23, 0xe5, 0x6e, 0xf3, 0xdb, 0xee, 0x48, 0xcc, 0xf7, 0xb1, 0x2e, 0x6e, 0x90, 0xc4, 0x77, 0xfb,
+		0xb1, 0x2e, 0x86, 0x7f, 0xb7, 0xb5, 0xb8, 0xf2, 0xde, 0x18, 0x4c, 0x3b, 0x2c, 0x79, 0xe3, 0xbb,
+		0xe9, 0x6d, 0xfe, 0xc6, 0x7d, 0x82, 0x72, 0x57, 0xb9, 0xf2, 0x37, 0x31, 0x98, 0x72, 0xb8, 0x62,
+		0x7b, 0xed, 0x03, 0xab, 0x5a, 0x28, 0x93, 0x62, 0x77, 0x87, 0x49, 0x77, 0xb0, 0xd4, 0x3f, 0xed,
+		0xdd, 0x9b, 0x0c, 0xbb, 0xc9, 0x0a, 0x73, 0x7b, 0x8f, 0x0c, 0xea, 0xf6, 0x4e, 0xde, 0xa1, 0xdb,
+		0x5b, 0x59, 0x83, 0xa9, 0x2b, 0x6e, 0xaf, 0x20, 0x5f, 0xc0, 0xa2, 0x6e, 0x5a, 0x0c, 0xe6, 0x5a,
+		0x54, 0xde, 0x49, 0xef, 0x6d, 0xb9, 0x76, 0x33, 0x37, 0x49, 0x8b, 0xc5, 0x0d, 0x44, 0xfa, 0x7f,
+		0xa5, 0xd7, 0xc7, 0xff, 0x1b, 0xbb, 0x23, 0xff, 0x6f, 0x09, 0x92, 0xe4, 0x66, 0xbb, 0xd1, 0xd9,
+		0x19, 0x7e, 0x71, 0xe4, 0x88, 0x27, 0x7f, 0x59, 0x02, 0x70, 0x82, 0x46, 0xf0, 0xcd, 0xe7, 0xb9,
+		0x95, 0xe5, 0x85, 0x6a, 0x65, 0xad, 0xb4, 0xb6, 0x5e, 0xf1, 0x7e, 0x4f, 0x55, 0xbc, 0x10, 0x6d,
+		0xb6, 0x49, 0xad, 0xb1, 0xd9, 0x20, 0x75, 0xf9, 0x7e, 0x98, 0xf6, 0x42, 0x63, 0xaa, 0xbc, 0x90,
+		0x93, 0x0a, 0x63, 0xb7, 0x6e, 0xcf, 0xa4, 0xd8, 0x54, 0x21, 0xf8, 0xbd, 0x93, 0xbd, 0x41, 0x38,
+		0xfc, 0x16, 0x6b, 0xac, 0x30, 0x7e, 0xeb, 0xf6, 0x4c, 0xda, 0x99, 0x53, 0x0a, 0xc8, 0x6e, 0x48,
+		0x4e, 0x2f, 0x5e, 0x80, 0x5b, 0xb7, 0x67, 0x92, 0x6c, 0x77, 0x57, 0x48, 0xe0, 0x3b, 0xd0, 0x27,
+		0xdf, 0x0a, 0xb0, 0xa8, 0x6f, 0x76, 0xb4, 0x1a, 0x1d, 0xb7, 0x02, 0xec, 0x5b, 0x5c, 0xbe, 0xa0,
+		0x96, 0xe6, 0xd7, 0x16, 0x57, 0x96, 0xbd, 0xcd, 0xf6, 0x95, 0x2d, 0xac, 0xac, 0xcf, 0x2d, 0x95,
+		0xab, 0xf8, 0xf2, 0x34, 0x0b, 0x87, 0xf7, 0x94, 0x3d, 0xbf, 0xbc, 0xb6, 0x78, 0xa5, 0x9c, 0x8b,
+		0xcd, 0x9d, 0x8b, 0x0c, 0xb7, 0x3c, 0xe4, 0x51, 0x23, 0x8e, 0xe7, 0xc4, 0x1b, 0x63, 0xf9, 0xce,
+		0xc9, 0xc8, 0x18, 0xcb, 0x2d, 0xa2, 0x13, 0xb3, 0x61, 0xee, 0x2a, 0xc6, 0x72, 0xa0, 0xb8, 0xcd,
+		0x21, 0x3f, 0x2d, 0xf9, 0xc9, 0x2c, 0x8c, 0x5d, 0x64, 0x6d, 0x42, 0x29, 0xc0, 0x6b, 0x26, 0x49,
+		0xcf, 0xf5, 0xff, 0xc8, 0x88, 0x02, 0xe6, 0x2c, 0xf2, 0x04, 0x24, 0x32, 0x44, 0xf9, 0x25, 0xfe,
+		0x85, 0x1b, 0x76, 0x1b, 0xc3, 0xb9, 0x0d, 0x34, 0xb6, 0x8b, 0xd5, 0x8e, 0x7e, 0x13, 0x67, 0x0d,
+		0x09, 0xb1, 0xef, 0x7d, 0x35, 0x60, 0x2f, 0xa5, 0xed, 0xcc, 0x28, 0x4a, 0x5f, 0x1c, 0xd5, 0x9e,
+		0x8c, 0x6a, 0x2d, 0xbe, 0x20, 0xe4, 0x98, 0x59, 0x88, 0xe2, 0x6e, 0xf9, 0x54, 0x33, 0x50, 0x6c,
+		0xca, 0x4b, 0x21, 0x2f, 0x1b, 0x0e, 0x17, 0xa1, 0xe9, 0xc2, 0x97, 0x57, 0x20, 0xe3, 0xe8, 0x1c,
+		0xb4, 0x86, 0xe2, 0xc3, 0xbb, 0xcf, 0xdc, 0x14, 0xe4, 0x6b, 0xb0, 0xd7, 0x39, 0x9a, 0x71, 0x93,
+		0x66, 0x4f, 0x5a, 0x3d, 0x38, 0xc4, 0xa1, 0xb5, 0xbb, 0x8e, 0xe9, 0x6e, 0xb0, 0x1c, 0x75, 0xd8,
+		0xb8, 0xdb, 0x71, 0x24, 0x6e, 0xd6, 0x0d, 0xed, 0x7e, 0xf2, 0x52, 0xc1, 0x08, 0x41, 0x72, 0xb3,
+		0x6d, 0x74, 0xf0, 0x8e, 0x5f, 0x8a, 0xba, 0x46, 0xed, 0xb4, 0xbc, 0x0d, 0xd3, 0x6e, 0xef, 0x32,
+		0xdf, 0x4c, 0x9b, 0xf9, 0x74, 0xef, 0x81, 0x0e, 0xee, 0xbf, 0x3d, 0x03, 0x5d, 0x0f, 0x14, 0x9b,
+		0x72, 0x13, 0xf6, 0x59, 0x7c, 0xe3, 0xca, 0xc2, 0xdb, 0xaa, 0x1d, 0xba, 0x75, 0x35, 0xf3, 0xd0,
+		0x9b, 0x95, 0x21, 0xdb, 0x5d, 0x0f, 0x2b, 0xad, 0x60, 0x39, 0xad, 0xcd, 0x7b, 0x2a, 0x60, 0xb2,
+		0x3d, 0xa5, 0x99, 0xcf, 0xf4, 0xae, 0x2d, 0x64, 0x1f, 0xea, 0xa9, 0x4d, 0x0b, 0x96, 0x9b, 0xf2,
+		0xb7, 0xc2, 0x21, 0x53, 0x6c, 0x05, 0xab, 0x81, 0x63, 0x02, 0x7c, 0x54, 0xb8, 0xa7, 0xdb, 0x2f,
+		0x72, 0x1b, 0xe9, 0xae, 0xb9, 0x60, 0x46, 0x41, 0x61, 0xbc, 0xe4, 0xc4, 0x06, 0xb7, 0xef, 0xd9,
+		0xe1, 0x1b, 0x0b, 0x13, 0xc3, 0x47, 0x61, 0x7b, 0x44, 0x31, 0xda, 0xdb, 0x01, 0x77, 0x35, 0xd9,
+		0x0d, 0x77, 0x89, 0x29, 0xbf, 0x15, 0x26, 0xdd, 0xa1, 0x65, 0x6d, 0xc3, 0x68, 0x62, 0x64, 0x59,
+		0x9c, 0x3e, 0x2f, 0x13, 0x41, 0xdc, 0x6b, 0xf3, 0xbb, 0xa9, 0x4f, 0xb4, 0x3c, 0x45, 0xa6, 0x6c,
+		0xc0, 0x7e, 0x37, 0x79, 0xb7, 0xf0, 0x4f, 0xd0, 0x4a, 0xde, 0xd4, 0xbf, 0x92, 0xf0, 0x29, 0xb6,
+		0xb7, 0x15, 0x02, 0x40, 0x05, 0xc3, 0x5d, 0xa1, 0x3d, 0x0f, 0xcd, 0x7c, 0xae, 0xb7, 0x60, 0x84,
+		0xd8, 0xa7, 0x1e, 0xc1, 0x68, 0x05, 0xcb, 0x4d, 0x59, 0x87, 0xfd, 0x5e, 0x6b, 0xa9, 0x2a, 0x46,
+		0x31, 0x3f, 0xd9, 0xa7, 0xba, 0xa0, 0x4d, 0xe6, 0xed, 0x9d, 0xbb, 0x5c, 0x08, 0x90, 0xfc, 0x0a,
+		0xec, 0xf7, 0xba, 0xe2, 0x85, 0xd9, 0x65, 0xe6, 0x65, 0x5a, 0xdf, 0xa9, 0x81, 0x5c, 0xcf, 0x02,
+		0xcb, 0x5d, 0xe3, 0xbe, 0x4e, 0x18, 0x84, 0x29, 0xbf, 0x00, 0xf2, 0x66, 0xc7, 0x78, 0x95, 0xe8,
+		0x9e, 0xc1, 0x9b, 0x1a, 0x36, 0x50, 0x6e, 0x92, 0x11, 0x71, 0x0d, 0x95, 0xb2, 0x0d, 0x72, 0x70,
+		0x41, 0x91, 0xcf, 0x78, 0xaf, 0xde, 0xf6, 0xb2, 0xfc, 0x83, 0x97, 0x72, 0xdd, 0xd7, 0x65, 0x1d,
+		0x3f, 0xe5, 0x1d, 0x9b, 0x22, 0x3f, 0x1c, 0x83, 0xfd, 0x0c, 0xf0, 0x74, 0xcb, 0x44, 0x93, 0x01,
+		0xff, 0x71, 0xeb, 0x63, 0x9c, 0x37, 0x0d, 0x73, 0xae, 0x3f, 0x3c, 0xc0, 0x27, 0xce, 0x9f, 0x84,
+		0x51, 0x93, 0x74, 0xae, 0x37, 0x42, 0xbf, 0x8b, 0x5f, 0x61, 0x25, 0xe2, 0x93, 0xdc, 0x5f, 0x15,
+		0xdf, 0xf5, 0x16, 0x28, 0x45, 0x8c, 0x63, 0x46, 0x47, 0x42, 0xa7, 0xff, 0xe7, 0xcd, 0x29, 0x72,
+		0x9c, 0x3e, 0xdf, 0x4a, 0x31, 0xe6, 0x2e, 0x46, 0xb2, 0xe1, 0xd4, 0x00, 0x1f, 0xf3, 0x6e, 0x99,
+		0x5b, 0xbc, 0x11, 0x36, 0x5f, 0xde, 0xd7, 0x82, 0xa3, 0x0c, 0x2e, 0x60, 0x4e, 0x59, 0x37, 0xfb,
+		0x58, 0x67, 0x3d, 0xee, 0xba, 0xec, 0xee, 0x29, 0xfe, 0x3b, 0xb9, 0xe1, 0x32, 0x98, 0x51, 0x18,
+		0x25, 0x03, 0x61, 0x26, 0xe1, 0xd7, 0x13, 0x20, 0x5f, 0x31, 0xb7, 0xe6, 0x71, 0x83, 0xe7, 0xfa,
+		0x52, 0xaa, 0x2f, 0x4e, 0x5b, 0xba, 0xf3, 0x38, 0x6d, 0xd5, 0x13, 0x2a, 0x1d, 0x1b, 0xee, 0xe2,
+		0x43, 0x44, 0xbc, 0xf4, 0xdb, 0xc3, 0xe3, 0xa5, 0x77, 0xeb, 0x6c, 0x09, 0x09, 0x9a, 0xbe, 0x18,
+		0xb6, 0xd3, 0x67, 0xce, 0x86, 0x42, 0xd4, 0x7c, 0xcf, 0x4b, 0x83, 0xee, 0xf5, 0x47, 0x76, 0xbf,
+		0xd7, 0xbf, 0x00, 0x49, 0x7e, 0xf6, 0x92, 0xdc, 0xd5, 0xd9, 0x0b, 0xc7, 0x96, 0x8b, 0xee, 0x57,
+		0xae, 0x06, 0xf5, 0x18, 0x30, 0x94, 0xe2, 0x9b, 0x85, 0xe2, 0xfa, 0xf6, 0x2f, 0x7e, 0xfc, 0x64,
+		0xb0, 0x7b, 0x78, 0xf4, 0x7c, 0xd8, 0x35, 0x3d, 0x83, 0x62, 0xa6, 0x1c, 0x82, 0x42, 0x30, 0x57,
+		0x1c, 0x36, 0x2a, 0x3f, 0x15, 0x87, 0xdc, 0x15, 0x73, 0xab, 0x5c, 0x6f, 0x58, 0xaf, 0xa7, 0x64,
+		0xde, 0x6d, 0x5f, 0xd5, 0x55, 0x98, 0xf0, 0x87, 0x16, 0x31, 0x89, 0x3c, 0x35, 0x5c, 0xac, 0x5d,
+		0xd6, 0x7b, 0xbd, 0x47, 0x7e, 0x3e, 0x5c, 0xda, 0x13, 0xc3, 0x85, 0xd7, 0x07, 0x85, 0xbc, 0xf8,
+		0x74, 0xff, 0x71, 0x3c, 0xe8, 0x1d, 0x47, 0xcf, 0x90, 0x28, 0x05, 0xc8, 0xfb, 0xf3, 0xec, 0x31,
+		0xbc, 0x8d, 0xef, 0x0f, 0x98, 0x62, 0x7b, 0x41, 0xde, 0xa8, 0xfe, 0xc5, 0x27, 0x21, 0xa9, 0xb5,
+		0xec, 0xf3, 0xa6, 0x41, 0xe7, 0x01, 0xc7, 0x29, 0x3e, 0xe1, 0x61, 0x60, 0xa0, 0x7f, 0xc8, 0xc0,
+		0x7d, 0x5e, 0x06, 0x0a, 0x7e, 0x28, 0x7b, 0x61, 0xca, 0x95, 0xb4, 0xd9, 0xf6, 0xae, 0x38, 0x55,
+		0xcb, 0x73, 0x64, 0xab, 0xa1, 0xdb, 0x26, 0x11, 0xf9, 0xc7, 0x19, 0xfb, 0xe8, 0x8c, 0x4d, 0x62,
+		0x17, 0x63, 0xf3, 0xe6, 0xfe, 0x63, 0xe3, 0x53, 0x52, 0x3e, 0xa6, 0x2b, 0x6d, 0x28, 0x04, 0x73,
+		0xed, 0x50, 0x8d, 0x10, 0x5f, 0xa8, 0x74, 0xa7, 0xbe, 0xd0, 0x8f, 0xc4, 0x60, 0xfc, 0x8a, 0xb9,
+		0xb5, 0xae, 0xdf, 0xed, 0x81, 0x7f, 0x63, 0x4d, 0x9b, 0xf3, 0xfd, 0x87, 0x26, 0xef, 0x1d, 0x1a,
+		0x87, 0x23, 0x18, 0xb7, 0xb4, 0xd7, 0x93, 0xf3, 0x7a, 0x8e, 0x88, 0xab, 0xa3, 0xb1, 0xe1, 0x3b,
+		0xaa, 0x7c, 0x29, 0x06, 0x87, 0x70, 0x9d, 0x43, 0x2f, 0x7b, 0xf3, 0x1f, 0xd0, 0xe5, 0x88, 0x3b,
+		0x1a, 0xde, 0xb0, 0xe3, 0x84, 0x44, 0xd8, 0x71, 0x42, 0xf1, 0x72, 0x7f, 0x39, 0x38, 0xee, 0xb3,
+		0x23, 0xa2, 0x38, 0xa9, 0xdc, 0x0f, 0xf7, 0xf6, 0x2a, 0xb7, 0x15, 0xec, 0x6f, 0x4b, 0x30, 0x81,
+		0xe2, 0x43, 0x83, 0x6e, 0x78, 0xfc, 0x33, 0x46, 0x82, 0xd8, 0xa1, 0x77, 0xfd, 0xb8, 0xef, 0x80,
+		0xba, 0xbc, 0xa8, 0xb1, 0x5d, 0x7a, 0x51, 0x8b, 0x8f, 0x61, 0xd7, 0x1d, 0x92, 0xd8, 0xe5, 0x7b,
+		0x43, 0xa3, 0xf6, 0x7c, 0x6d, 0x56, 0x0e, 0xc0, 0x7e, 0x5f, 0x96, 0xdd, 0xc5, 0x77, 0xc6, 0xe8,
+		0xda, 0xc2, 0xe2, 0x5d, 0x9c, 0x88, 0xa2, 0xbb, 0x7e, 0xf6, 0xf9, 0x36, 0x00, 0x8c, 0x09, 0x18,
+		0x20, 0xc4, 0xe8, 0x81, 0x28, 0x73, 0xf4, 0x33, 0x9f, 0x3a, 0x25, 0xf6, 0xa7, 0x2c, 0x43, 0x4d,
+		0xeb, 0xe4, 0x06, 0x8b, 0x0b, 0x2a, 0x96, 0xfa, 0x9b, 0x27, 0x47, 0xbc, 0xe2, 0xe1, 0xef, 0xb2,
+		0x72, 0x18, 0x0e, 0x86, 0x64, 0xdb, 0x9c, 0xfa, 0xc5, 0x18, 0xd5, 0x25, 0xc2, 0xc3, 0xf7, 0x4d,
+		0x3e, 0x31, 0x8b, 0xf3, 0xfd, 0xe7, 0xdb, 0x8c, 0x97, 0xa1, 0x41, 0xce, 0x28, 0x6f, 0x86, 0xc3,
+		0xa1, 0x05, 0xb6, 0x1a, 0xc6, 0xef, 0x24, 0x71, 0xaf, 0x6b, 0xd5, 0x3e, 0x3d, 0x05, 0x91, 0xb5,
+		0x58, 0x57, 0x3e, 0x2f, 0x31, 0x12, 0x1d, 0x4d, 0x37, 0x37, 0x49, 0x27, 0x24, 0x96, 0xca, 0x8e,
+		0x1e, 0x92, 0x06, 0x8b, 0x1e, 0xf2, 0x55, 0x19, 0xf3, 0x57, 0x89, 0x33, 0xbc, 0x43, 0x6a, 0x8d,
+		0x76, 0x83, 0x88, 0xc8, 0xa2, 0x5e, 0x33, 0xdc, 0x06, 0x2d, 0x3e, 0xee, 0xe6, 0x18, 0xab, 0x0c,
+		0xb9, 0x74, 0x8f, 0x8f, 0x4b, 0x4e, 0x4f, 0x44, 0x17, 0x94, 0xe3, 0x70, 0x5f, 0xcf, 0x3e, 0xda,
+		0x32, 0xf8, 0xf3, 0x12, 0x93, 0x51, 0x52, 0x27, 0xa4, 0xf5, 0x0d, 0xe0, 0x45, 0xf1, 0xa9, 0xf0,
+		0x3e, 0xdd, 0xef, 0x9b, 0x4a, 0x11, 0xed, 0x51, 0xee, 0x83, 0x63, 0x3d, 0x8a, 0xed, 0x6e, 0xfd,
+		0x55, 0x0c, 0x26, 0x5d, 0x72, 0xc2, 0xaf, 0x81, 0x7f, 0x53, 0xae, 0x77, 0x4b, 0xb0, 0x57, 0x9c,
+		0x01, 0xd4, 0xf9, 0xd1, 0x02, 0x1b, 0xb1, 0x44, 0x9f, 0x8e, 0x4d, 0xd9, 0x68, 0x94, 0x3d, 0x2b,
+		0x88, 0x54, 0x7c, 0xa6, 0xff, 0x24, 0x3d, 0x14, 0x3e, 0x49, 0x19, 0x8f, 0x95, 0xeb, 0x70, 0x20,
+		0x90, 0x69, 0x4f, 0x4e, 0xa7, 0xa7, 0xd2, 0x2e, 0x7a, 0x7a, 0x90, 0x4e, 0x23, 0xa3, 0x53, 0x77,
+		0x24, 0x2b, 0xc5, 0x32, 0x16, 0xeb, 0xca, 0x17, 0x25, 0xc8, 0xdb, 0x92, 0x41, 0xab, 0x37, 0x2f,
+		0x18, 0x9d, 0xbb, 0x3b, 0xf0, 0x77, 0x64, 0x8e, 0x15, 0x2f, 0xf4, 0x67, 0xed, 0xb1, 0xb0, 0x59,
+		0xe0, 0xeb, 0x8c, 0xf2, 0x76, 0x98, 0x89, 0x2a, 0xbb, 0x3b, 0x8c, 0xc6, 0x77, 0x37, 0x8f, 0xb8,
+		0xd4, 0x47, 0x58, 0xc4, 0xe5, 0x63, 0x90, 0x0f, 0x3d, 0xcf, 0x72, 0x74, 0xee, 0xde, 0x90, 0x93,
+		0xa9, 0xc5, 0x3a, 0x7e, 0x30, 0x9c, 0x7f, 0x3c, 0xab, 0x5f, 0xe4, 0x0a, 0x87, 0x93, 0xcf, 0x02,
+		0xae, 0xca, 0x5c, 0xa8, 0xfb, 0x69, 0x4f, 0x8c, 0x13, 0x64, 0x92, 0xfc, 0xa4, 0x9b, 0xdd, 0x9c,
+		0x96, 0xdf, 0xc0, 0x09, 0xe9, 0x24, 0x6b, 0xa8, 0x72, 0x02, 0xee, 0xef, 0xcd, 0x01, 0x5b, 0xd5,
+		0x7c, 0x77, 0x8c, 0xee, 0x99, 0x2b, 0xc4, 0x72, 0x1f, 0x9e, 0xbd, 0x51, 0x75, 0x4d, 0x1e, 0xa3,
+		0xf1, 0xd9, 0x3d, 0x11, 0xf6, 0x02, 0x88, 0x48, 0xee, 0x62, 0xc7, 0xea, 0xeb, 0x32, 0x77, 0xab,
+		0xf9, 0x72, 0x6d, 0x3e, 0xbd, 0x3b, 0x46, 0x57, 0x1a, 0x71, 0x58, 0xf3, 0x0f, 0x30, 0x66, 0xb4,
+		0xb8, 0xd8, 0xdf, 0x24, 0xf4, 0xad, 0x63, 0x51, 0xbd, 0x55, 0x6e, 0xc0, 0xb1, 0x1e, 0xc5, 0xf6,
+		0x3c, 0x0e, 0x46, 0xa9, 0x4a, 0x77, 0x16, 0xa5, 0xaa, 0x7c, 0x4a, 0x82, 0x03, 0xf6, 0x56, 0xe5,
+		0xf5, 0x1e, 0x84, 0xe2, 0xc5, 0xfe, 0x1c, 0xbb, 0x37, 0x6c, 0x8f, 0x15, 0xe0, 0xd7, 0x31, 0xb8,
+		0x27, 0xb2, 0xd0, 0x16, 0xb1, 0x9f, 0x63, 0x53, 0xd1, 0x7b, 0xc9, 0xea, 0x9b, 0xd4, 0x8b, 0x31,
+		0xfc, 0x74, 0xf5, 0xb1, 0x45, 0xf9, 0x80, 0x04, 0x85, 0x60, 0xf6, 0x5d, 0x5a, 0xab, 0x9f, 0x86,
+		0x51, 0x71, 0x59, 0x6f, 0xa8, 0x9b, 0x57, 0x1c, 0x89, 0x7e, 0xaa, 0x94, 0x5f, 0x95, 0x5c, 0xa1,
+		0x17, 0x4c, 0xd0, 0x18, 0xad, 0x40, 0xd6, 0x10, 0x89, 0xaa, 0xeb, 0x3d, 0xd9, 0xc8, 0x53, 0x72,
+		0x3f, 0x05, 0xfa, 0xb6, 0xec, 0xb8, 0xe1, 0x4e, 0xbe, 0x0e, 0x4f, 0x3b, 0xbc, 0xf1, 0xdc, 0x91,
+		0xca, 0xdf, 0x31, 0xeb, 0x08, 0x1f, 0x78, 0xf6, 0xf3, 0xe4, 0xae, 0x59, 0x47, 0x15, 0x00, 0x9b,
+		0xb3, 0xe2, 0xae, 0xd7, 0x89, 0x41, 0x47, 0xc6, 0xdd, 0x68, 0x17, 0x99, 0x5d, 0x18, 0x4d, 0xa1,
+		0x7d, 0x54, 0x5e, 0x81, 0x99, 0xa8, 0x32, 0xd7, 0xe5, 0xc3, 0x89, 0x0e, 0xea, 0xd0, 0x6b, 0xa4,
+		0x5e, 0xdd, 0x85, 0xe8, 0x67, 0x05, 0x72, 0x89, 0xf1, 0xfc, 0x8f, 0x25, 0xea, 0x08, 0xa9, 0x10,
+		0xcb, 0x1b, 0x7d, 0xbe, 0x5b, 0x7f, 0x4f, 0x78, 0xb0, 0xb7, 0x13, 0xb5, 0x1e, 0xbf, 0x1b, 0x51,
+		0xeb, 0xc5, 0x87, 0x83, 0x2e, 0xa1, 0x23, 0x81, 0x65, 0xdf, 0xd3, 0x21, 0xee, 0xe6, 0xf0, 0x67,
+		0xfb, 0xdd, 0x1c, 0xe2, 0xb0, 0xc1, 0x89, 0x24, 0xf9, 0x27, 0x37, 0x87, 0xf7, 0x54, 0xc6, 0xe1,
+		0x8c, 0x62, 0xc0, 0xe1, 0xd0, 0x02, 0x5b, 0x56, 0x9d, 0x18, 0x74, 0xe9, 0xae, 0xc4, 0xa0, 0xff,
+		0x52, 0x8c, 0x79, 0xf4, 0xf4, 0x7a, 0xa0, 0xce, 0x6f, 0xce, 0x61, 0x2a, 0xf7, 0x1f, 0x26, 0x25,
+		0xea, 0x14, 0xc0, 0x35, 0x50, 0x9f, 0x94, 0xe0, 0x68, 0x44, 0xd9, 0x1b, 0xf8, 0x64, 0xe0, 0xbf,
+		0xb2, 0xe5, 0x40, 0x98, 0x9f, 0x9e, 0x68, 0xab, 0x5d, 0xeb, 0xa7, 0x55, 0x18, 0x15, 0x11, 0x7f,
+		0xb1, 0x3b, 0x8a, 0xee, 0x12, 0x64, 0x8a, 0xe7, 0x82, 0xba, 0xe8, 0x58, 0xb8, 0x7d, 0xed, 0xa1,
+		0xa8, 0x28, 0x30, 0x13, 0x55, 0x66, 0x6b, 0xa5, 0x3f, 0x65, 0x03, 0x57, 0x6a, 0xd3, 0xaf, 0x6c,
+		0x87, 0x07, 0xeb, 0xef, 0x96, 0x13, 0x57, 0xf1, 0x4b, 0x99, 0x9c, 0x08, 0x1f, 0x9f, 0xdd, 0x47,
+		0x9e, 0x39, 0xa4, 0x8a, 0xc5, 0x20, 0x3f, 0x7c, 0x27, 0x14, 0x91, 0xdd, 0x51, 0x1e, 0x80, 0xe3,
+		0x7d, 0xba, 0x6b, 0xb3, 0xe6, 0xaf, 0xb9, 0x19, 0x6d, 0x74, 0x6a, 0xc4, 0x65, 0x46, 0xef, 0x96,
+		0x1b, 0x6f, 0xd0, 0x6b, 0x2b, 0x0f, 0x0f, 0x6c, 0x50, 0xd9, 0x46, 0x2d, 0x3e, 0xd5, 0xdf, 0x21,
+		0xe4, 0x55, 0x76, 0x2b, 0x38, 0xa5, 0xf2, 0x14, 0xe6, 0x77, 0x88, 0x66, 0x1a, 0xec, 0x7b, 0xa1,
+		0x69, 0x95, 0xa7, 0x8a, 0x0f, 0x05, 0xc7, 0xcb, 0x6f, 0x93, 0x7b, 0x79, 0xac, 0xfc, 0x02, 0xb7,
+		0xc9, 0xbd, 0xd9, 0x6f, 0x60, 0x4d, 0xf2, 0xc9, 0x18, 0x3f, 0x0f, 0x65, 0xac, 0x70, 0x9d, 0x61,
+		0x7c, 0x93, 0x89, 0x8b, 0x33, 0xc6, 0x09, 0xcf, 0x18, 0x3f, 0x12, 0x1c, 0xe3, 0x19, 0xff, 0xba,
+		0xe1, 0xe7, 0x8d, 0x72, 0x14, 0x0e, 0x87, 0x16, 0x88, 0x81, 0x3e, 0xf9, 0xef, 0x63, 0x30, 0x1d,
+		0xb6, 0x79, 0x91, 0x9f, 0x05, 0xa5, 0xb2, 0x56, 0x7a, 0x76, 0x71, 0xf9, 0x62, 0x75, 0x65, 0xb5,
+		0xac, 0x96, 0xe8, 0xb5, 0x92, 0xb5, 0x17, 0x57, 0xcb, 0xbe, 0xab, 0x34, 0xc7, 0x6e, 0xdd, 0x9e,
+		0x39, 0x1a, 0x46, 0xc1, 0x7d, 0xbd, 0xa6, 0x0c, 0x47, 0x23, 0x88, 0x2d, 0x94, 0x97, 0xca, 0x17,
+		0x4b, 0xf8, 0x41, 0x87, 0xc2, 0xcc, 0xad, 0xdb, 0x33, 0x87, 0xc2, 0x28, 0xd9, 0xc1, 0x35, 0x8b,
+		0x70, 0x4f, 0x04, 0x19, 0xb5, 0x6c, 0x13, 0x8a, 0x15, 0x94, 0x5b, 0xb7, 0x67, 0x8e, 0x84, 0x11,
+		0x72, 0x45, 0x9a, 0xa8, 0x70, 0x7f, 0x24, 0x29, 0x2c, 0xc0, 0xff, 0xcf, 0x97, 0xd4, 0x85, 0x4a,
+		0x2e, 0x5e, 0xb8, 0xff, 0xd6, 0xed, 0x19, 0x25, 0x9c, 0x1e, 0xb5, 0xc3, 0xd9, 0xd3, 0x35, 0x26,
+		0xbb, 0xce, 0x73, 0xe6, 0xf7, 0x0e, 0x40, 0xfc, 0x8a, 0xb9, 0x25, 0xbf, 0x02, 0x13, 0xfe, 0xa8,
+		0xc3, 0xc8, 0x38, 0xff, 0x60, 0x90, 0x58, 0xe1, 0xcc, 0xe0, 0xb0, 0xf6, 0xac, 0xbd, 0x06, 0xe3,
+		0xde, 0x60, 0xb2, 0x13, 0x3d, 0x88, 0x78, 0x20, 0x0b, 0x0f, 0x0d, 0x0a, 0x69, 0x57, 0xf6, 0x16,
+		0x48, 0xd9, 0x03, 0x73, 0xac, 0x07, 0xb6, 0x00, 0x2a, 0x3c, 0x38, 0x00, 0x90, 0x4d, 0xfd, 0x15,
+		0x98, 0xf0, 0x07, 0x07, 0xf5, 0xe2, 0x9e, 0x0f, 0xb6, 0x70, 0x66, 0x70, 0x58, 0xbb, 0xca, 0x0d,
+		0x00, 0xd7, 0x22, 0x74, 0x5f, 0x0f, 0x0a, 0x0e, 0x58, 0xe1, 0xd4, 0x40, 0x60, 0x76, 0x1d, 0xdf,
+		0x2f, 0xc1, 0x81, 0xe8, 0x30, 0x89, 0x47, 0x7b, 0x8d, 0x79, 0x14, 0x56, 0xe1, 0xc9, 0xdd, 0x60,
+		0xd9, 0x2d, 0xda, 0x86, 0x31, 0x4f, 0x90, 0xc0, 0xf1, 0x5e, 0x1d, 0x72, 0x01, 0x16, 0x4e, 0x0f,
+		0x08, 0x68, 0xd7, 0x64, 0x41, 0x2e, 0x70, 0x56, 0xdf, 0x4b, 0x26, 0xfc, 0xc0, 0x85, 0x47, 0x86,
+		0x00, 0xb6, 0x6b, 0x7d, 0x15, 0xe4, 0x90, 0x73, 0xef, 0x5e, 0xc3, 0x16, 0x04, 0x2f, 0x9c, 0x1d,
+		0x0a, 0xdc, 0xae, 0xfb, 0x07, 0x25, 0x28, 0xf4, 0x38, 0xfe, 0xed, 0x49, 0x35, 0x12, 0xad, 0xf0,
+		0xd4, 0xae, 0xd0, 0xec, 0x46, 0x7d, 0x8f, 0x04, 0xf9, 0xc8, 0x53, 0xd8, 0x9e, 0x2c, 0x8e, 0x40,
+		0x2a, 0x9c, 0xdf, 0x05, 0x92, 0xdd, 0x1c, 0x1d, 0xb2, 0xbe, 0xc3, 0xd3, 0x07, 0x06, 0x60, 0x36,
+		0x03, 0x2d, 0x3c, 0x3c, 0x30, 0xa8, 0x5d, 0xdf, 0x3b, 0x24, 0xd8, 0x1b, 0x7a, 0xa4, 0x25, 0x3f,
+		0xd4, 0xb7, 0x1b, 0x3e, 0x8c, 0xc2, 0xe3, 0xc3, 0x62, 0xd8, 0xad, 0xf8, 0x61, 0x09, 0x0e, 0xf6,
+		0x3a, 0xf5, 0x3a, 0x37, 0xc0, 0x18, 0x87, 0xe0, 0x15, 0x9e, 0xde, 0x1d, 0x9e, 0x5b, 0xed, 0xfa,
+		0xcf, 0x97, 0x7a, 0xa9, 0x5d, 0x1f, 0x6c, 0xe1, 0xcc, 0xe0, 0xb0, 0x1e, 0x79, 0x8c, 0x3c, 0xab,
+		0xe9, 0x25, 0x8f, 0x51, 0x48, 0x85, 0xf3, 0xbb, 0x40, 0xb2, 0x9b, 0xf3, 0x5d, 0x12, 0xec, 0x8b,
+		0x38, 0xb3, 0x78, 0xb8, 0xaf, 0xa2, 0x0d, 0x34, 0xe5, 0x89, 0xa1, 0x51, 0xdc, 0x43, 0xe1, 0x3f,
+		0x5f, 0xe8, 0x35, 0x14, 0x3e, 0xd8, 0xc2, 0x99, 0xc1, 0x61, 0x3d, 0x73, 0x23, 0xdc, 0x73, 0xdb,
+		0xd3, 0x3c, 0x08, 0xc3, 0x28, 0x3c, 0x3e, 0x2c, 0x86, 0x7b, 0x9d, 0x08, 0xb8, 0x32, 0x1f, 0xec,
+		0x2d, 0x58, 0x1e, 0xe0, 0xc2, 0x23, 0x43, 0x00, 0xbb, 0xd7, 0x89, 0x10, 0xc7, 0xe1, 0xa9, 0x01,
+		0x6c, 0x16, 0x07, 0xbc, 0x70, 0x76, 0x28, 0x70, 0xbb, 0xee, 0xd7, 0x24, 0x98, 0x0e, 0x75, 0x88,
+		0x9d, 0x1e, 0xc8, 0xba, 0x70, 0x35, 0xe0, 0xb1, 0x21, 0x11, 0x3c, 0x43, 0x1f, 0xee, 0xa5, 0x79,
+		0x68, 0x80, 0xd9, 0xe4, 0xc1, 0x28, 0x3c, 0x3e, 0x2c, 0x86, 0xdd, 0x8a, 0xf7, 0x4b, 0x70, 0xa8,
+		0xa7, 0xa3, 0xa4, 0x57, 0xff, 0x7a, 0x21, 0x16, 0x9e, 0xd9, 0x25, 0xa2, 0x67, 0x3a, 0xfa, 0xfc,
+		0x14, 0x3d, 0xa7, 0xa3, 0x17, 0xb6, 0x70, 0x66, 0x70, 0x58, 0xb7, 0x48, 0x86, 0x6c, 0x77, 0x7b,
+		0x5b, 0x9c, 0x7e, 0xf0, 0xc2, 0xd9, 0xa1, 0xc0, 0x45, 0xdd, 0x85, 0x91, 0xd7, 0x70, 0xf7, 0x7d,
+		0xa7, 0x37, 0xf6, 0xfe, 0xef, 0x00, 0x0a, 0x9c, 0x00, 0x88, 0x87, 0xf4, 0x00, 0x00,
+	}
+	r := bytes.NewReader(gzipped)
+	gzipr, err := compress_gzip.NewReader(r)
+	if err != nil {
+		panic(err)
+	}
+	ungzipped, err := io_ioutil.ReadAll(gzipr)
+	if err != nil {
+		panic(err)
+	}
+	if err := github_com_cosmos_gogoproto_proto.Unmarshal(ungzipped, d); err != nil {
+		panic(err)
+	}
+	return d
+}
+func (this *CommissionRates) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommissionRates)
+	if !ok {
+		that2, ok := that.(CommissionRates)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if this.BondDenom != that1.BondDenom {
+	if !this.Rate.Equal(that1.Rate) {
 		return false
 	}
-	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
+	if !this.MaxRate.Equal(that1.MaxRate) {
 		return false
 	}
-	if !this.KeyRotationFee.Equal(&that1.KeyRotationFee) {
+	if !this.MaxChangeRate.Equal(that1.MaxChangeRate) {
 		return false
 	}
 	return true
 }
-func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
+func (this *Commission) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RedelegationEntryResponse)
+	that1, ok := that.(*Commission)
 	if !ok {
-		that2, ok := that.(RedelegationEntryResponse)
+		that2, ok := that.(Commission)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2555,22 +3519,22 @@ func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.RedelegationEntry.Equal(&that1.RedelegationEntry) {
+	if !this.CommissionRates.Equal(&that1.CommissionRates) {
 		return false
 	}
-	if !this.Balance.Equal(that1.Balance) {
+	if !this.UpdateTime.Equal(that1.UpdateTime) {
 		return false
 	}
 	return true
 }
-func (this *Pool) Equal(that interface{}) bool {
+func (this *Description) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Pool)
+	that1, ok := that.(*Description)
 	if !ok {
-		that2, ok := that.(Pool)
+		that2, ok := that.(Description)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2582,65 +3546,286 @@ func (this *Pool) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.NotBondedTokens.Equal(that1.NotBondedTokens) {
+	if this.Moniker != that1.Moniker {
 		return false
 	}
-	if !this.BondedTokens.Equal(that1.BondedTokens) {
+	if this.Identity != that1.Identity {
+		return false
+	}
+	if this.Website != that1.Website {
+		return false
+	}
+	if this.SecurityContact != that1.SecurityContact {
+		return false
+	}
+	if this.Details != that1.Details {
 		return false
 	}
 	return true
 }
-func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *UnbondingDelegationEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *HistoricalInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *HistoricalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Valset) > 0 {
-		for iNdEx := len(m.Valset) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Valset[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	that1, ok := that.(*UnbondingDelegationEntry)
+	if !ok {
+		that2, ok := that.(UnbondingDelegationEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	{
-		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *HistoricalRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if this.CreationHeight != that1.CreationHeight {
+		return false
+	}
+	if !this.CompletionTime.Equal(that1.CompletionTime) {
+		return false
+	}
+	if !this.InitialBalance.Equal(that1.InitialBalance) {
+		return false
+	}
+	if !this.Balance.Equal(that1.Balance) {
+		return false
+	}
+	if this.UnbondingId != that1.UnbondingId {
+		return false
+	}
+	if this.UnbondingOnHoldRefCount != that1.UnbondingOnHoldRefCount {
+		return false
+	}
+	return true
+}
+func (this *RedelegationEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RedelegationEntry)
+	if !ok {
+		that2, ok := that.(RedelegationEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CreationHeight != that1.CreationHeight {
+		return false
+	}
+	if !this.CompletionTime.Equal(that1.CompletionTime) {
+		return false
+	}
+	if !this.InitialBalance.Equal(that1.InitialBalance) {
+		return false
+	}
+	if !this.SharesDst.Equal(that1.SharesDst) {
+		return false
+	}
+	if this.UnbondingId != that1.UnbondingId {
+		return false
+	}
+	if this.UnbondingOnHoldRefCount != that1.UnbondingOnHoldRefCount {
+		return false
+	}
+	return true
+}
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.UnbondingTime != that1.UnbondingTime {
+		return false
+	}
+	if this.MaxValidators != that1.MaxValidators {
+		return false
+	}
+	if this.MaxEntries != that1.MaxEntries {
+		return false
+	}
+	if this.HistoricalEntries != that1.HistoricalEntries {
+		return false
+	}
+	if this.BondDenom != that1.BondDenom {
+		return false
+	}
+	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
+		return false
+	}
+	if !this.KeyRotationFee.Equal(&that1.KeyRotationFee) {
+		return false
+	}
+	if len(this.ValidatorAllowlist) != len(that1.ValidatorAllowlist) {
+		return false
+	}
+	for i := range this.ValidatorAllowlist {
+		if this.ValidatorAllowlist[i] != that1.ValidatorAllowlist[i] {
+			return false
+		}
+	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
+	if this.MaxAutoCompoundsPerBlock != that1.MaxAutoCompoundsPerBlock {
+		return false
+	}
+	if !this.MinAutoCompoundReward.Equal(that1.MinAutoCompoundReward) {
+		return false
+	}
+	if this.CommissionChangeNoticePeriod != that1.CommissionChangeNoticePeriod {
+		return false
+	}
+	if !this.FlashUndelegationPenalty.Equal(that1.FlashUndelegationPenalty) {
+		return false
+	}
+	if !this.MaxFlashUndelegationRate.Equal(that1.MaxFlashUndelegationRate) {
+		return false
+	}
+	if this.PerformanceWindow != that1.PerformanceWindow {
+		return false
+	}
+	if this.ForcedUndelegationEnabled != that1.ForcedUndelegationEnabled {
+		return false
+	}
+	if this.ForcedUndelegationAuthority != that1.ForcedUndelegationAuthority {
+		return false
+	}
+	return true
+}
+func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RedelegationEntryResponse)
+	if !ok {
+		that2, ok := that.(RedelegationEntryResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RedelegationEntry.Equal(&that1.RedelegationEntry) {
+		return false
+	}
+	if !this.Balance.Equal(that1.Balance) {
+		return false
+	}
+	return true
+}
+func (this *Pool) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Pool)
+	if !ok {
+		that2, ok := that.(Pool)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.NotBondedTokens.Equal(that1.NotBondedTokens) {
+		return false
+	}
+	if !this.BondedTokens.Equal(that1.BondedTokens) {
+		return false
+	}
+	return true
+}
+func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoricalInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoricalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Valset) > 0 {
+		for iNdEx := len(m.Valset) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Valset[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoricalRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
@@ -2856,6 +4041,18 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MultiAssetTokens != nil {
+		{
+			size := m.MultiAssetTokens.Size()
+			i -= size
+			if _, err := m.MultiAssetTokens.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
 	if len(m.UnbondingIds) > 0 {
 		dAtA6 := make([]byte, len(m.UnbondingIds)*10)
 		var j5 int
@@ -3474,9 +4671,109 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ForcedUndelegationAuthority) > 0 {
+		i -= len(m.ForcedUndelegationAuthority)
+		copy(dAtA[i:], m.ForcedUndelegationAuthority)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ForcedUndelegationAuthority)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.ForcedUndelegationEnabled {
+		i--
+		if m.ForcedUndelegationEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.PerformanceWindow != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.PerformanceWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	{
-		size, err := m.KeyRotationFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.MaxFlashUndelegationRate.Size()
+		i -= size
+		if _, err := m.MaxFlashUndelegationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.FlashUndelegationPenalty.Size()
+		i -= size
+		if _, err := m.FlashUndelegationPenalty.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CommissionChangeNoticePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CommissionChangeNoticePeriod):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintStaking(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.MinAutoCompoundReward.Size()
+		i -= size
+		if _, err := m.MinAutoCompoundReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.MaxAutoCompoundsPerBlock != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxAutoCompoundsPerBlock))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.ValidatorAllowlist) > 0 {
+		for iNdEx := len(m.ValidatorAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorAllowlist[iNdEx])
+			copy(dAtA[i:], m.ValidatorAllowlist[iNdEx])
+			i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size, err := m.KeyRotationFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
 		i -= size
@@ -3516,12 +4813,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err14 != nil {
-		return 0, err14
+	n15, err15 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err15 != nil {
+		return 0, err15
 	}
-	i -= n14
-	i = encodeVarintStaking(dAtA, i, uint64(n14))
+	i -= n15
+	i = encodeVarintStaking(dAtA, i, uint64(n15))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -3841,540 +5138,3185 @@ func (m *ValAddrsOfRotatedConsKeys) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStaking(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DelegationPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *HistoricalInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *DelegationPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Header.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if len(m.Valset) > 0 {
-		for _, e := range m.Valset {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x22
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *HistoricalRecord) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Apphash)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Time != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time)
-		n += 1 + l + sovStaking(uint64(l))
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ValidatorsHash)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CommissionRates) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AutoCompoundSetting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Rate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MaxRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MaxChangeRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Commission) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CommissionRates.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdateTime)
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+func (m *AutoCompoundSetting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Description) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AutoCompoundSetting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.Identity)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.Website)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.SecurityContact)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Details)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Validator) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ScheduledCommissionChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledCommissionChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledCommissionChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	if m.ConsensusPubkey != nil {
-		l = m.ConsensusPubkey.Size()
-		n += 1 + l + sovStaking(uint64(l))
+	n23, err23 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EffectiveTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime):])
+	if err23 != nil {
+		return 0, err23
 	}
-	if m.Jailed {
-		n += 2
+	i -= n23
+	i = encodeVarintStaking(dAtA, i, uint64(n23))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.NewRate.Size()
+		i -= size
+		if _, err := m.NewRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	if m.Status != 0 {
-		n += 1 + sovStaking(uint64(m.Status))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Tokens.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.DelegatorShares.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.Description.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if m.UnbondingHeight != 0 {
-		n += 1 + sovStaking(uint64(m.UnbondingHeight))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondingTime)
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.Commission.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.MinSelfDelegation.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if m.UnbondingOnHoldRefCount != 0 {
-		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
-	}
-	if len(m.UnbondingIds) > 0 {
-		l = 0
-		for _, e := range m.UnbondingIds {
-			l += sovStaking(uint64(e))
-		}
-		n += 1 + sovStaking(uint64(l)) + l
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ValAddresses) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovStaking(uint64(l))
-		}
+func (m *ValidatorPerformanceCounters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DVPair) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ValidatorPerformanceCounters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformanceCounters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if m.ProposedBlocks != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.ProposedBlocks))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if m.MissedBlocks != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MissedBlocks))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.SignedBlocks != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.SignedBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DVPairs) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Pairs) > 0 {
-		for _, e := range m.Pairs {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
+func (m *ValidatorPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DVVTriplet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ValidatorPerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if m.ProposedBlocks != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.ProposedBlocks))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.ValidatorSrcAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if m.MissedBlocks != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MissedBlocks))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.ValidatorDstAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if m.SignedBlocks != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.SignedBlocks))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
-}
-
-func (m *DVVTriplets) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.StartHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	if len(m.Triplets) > 0 {
-		for _, e := range m.Triplets {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Delegation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BondableDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BondableDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BondableDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Shares.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UnbondingDelegation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MultiAssetPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiAssetPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiAssetPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Tokens.Size()
+		i -= size
+		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *UnbondingDelegationEntry) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MultiAssetDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiAssetDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiAssetDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CreationHeight != 0 {
-		n += 1 + sovStaking(uint64(m.CreationHeight))
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.InitialBalance.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.Balance.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if m.UnbondingId != 0 {
-		n += 1 + sovStaking(uint64(m.UnbondingId))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.UnbondingOnHoldRefCount != 0 {
-		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RedelegationEntry) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MultiAssetUnbonding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiAssetUnbonding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiAssetUnbonding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	n24, err24 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err24 != nil {
+		return 0, err24
+	}
+	i -= n24
+	i = encodeVarintStaking(dAtA, i, uint64(n24))
+	i--
+	dAtA[i] = 0x32
 	if m.CreationHeight != 0 {
-		n += 1 + sovStaking(uint64(m.CreationHeight))
+		i = encodeVarintStaking(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.InitialBalance.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.SharesDst.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if m.UnbondingId != 0 {
-		n += 1 + sovStaking(uint64(m.UnbondingId))
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
-	if m.UnbondingOnHoldRefCount != 0 {
-		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Redelegation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MaxValidatorsChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MaxValidatorsChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaxValidatorsChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.ValidatorSrcAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
-	}
-	l = len(m.ValidatorDstAddress)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if m.MaxValidators != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxValidators))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RedelegationExemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegationExemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegationExemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime)
-	n += 1 + l + sovStaking(uint64(l))
-	if m.MaxValidators != 0 {
-		n += 1 + sovStaking(uint64(m.MaxValidators))
-	}
-	if m.MaxEntries != 0 {
-		n += 1 + sovStaking(uint64(m.MaxEntries))
+	n26, err26 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err26 != nil {
+		return 0, err26
 	}
-	if m.HistoricalEntries != 0 {
-		n += 1 + sovStaking(uint64(m.HistoricalEntries))
+	i -= n26
+	i = encodeVarintStaking(dAtA, i, uint64(n26))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorDstAddress) > 0 {
+		i -= len(m.ValidatorDstAddress)
+		copy(dAtA[i:], m.ValidatorDstAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorDstAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.BondDenom)
-	if l > 0 {
-		n += 1 + l + sovStaking(uint64(l))
+	if len(m.ValidatorSrcAddress) > 0 {
+		i -= len(m.ValidatorSrcAddress)
+		copy(dAtA[i:], m.ValidatorSrcAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorSrcAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.MinCommissionRate.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.KeyRotationFee.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStaking(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
-	var l int
-	_ = l
-	l = m.Delegation.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.Balance.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	return n
+	dAtA[offset] = uint8(v)
+	return base
 }
-
-func (m *RedelegationEntryResponse) Size() (n int) {
+func (m *HistoricalInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.RedelegationEntry.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.Balance.Size()
+	l = m.Header.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Valset) > 0 {
+		for _, e := range m.Valset {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *RedelegationResponse) Size() (n int) {
+func (m *HistoricalRecord) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Redelegation.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
+	l = len(m.Apphash)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Time != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorsHash)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
 	}
 	return n
 }
 
-func (m *Pool) Size() (n int) {
+func (m *CommissionRates) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.NotBondedTokens.Size()
+	l = m.Rate.Size()
 	n += 1 + l + sovStaking(uint64(l))
-	l = m.BondedTokens.Size()
+	l = m.MaxRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxChangeRate.Size()
 	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
-func (m *ValidatorUpdates) Size() (n int) {
+func (m *Commission) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Updates) > 0 {
-		for _, e := range m.Updates {
-			l = e.Size()
-			n += 1 + l + sovStaking(uint64(l))
-		}
-	}
+	l = m.CommissionRates.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdateTime)
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
-func (m *ConsPubKeyRotationHistory) Size() (n int) {
+func (m *Description) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.OperatorAddress)
+	l = len(m.Moniker)
 	if l > 0 {
 		n += 1 + l + sovStaking(uint64(l))
 	}
-	if m.OldConsPubkey != nil {
-		l = m.OldConsPubkey.Size()
+	l = len(m.Identity)
+	if l > 0 {
 		n += 1 + l + sovStaking(uint64(l))
 	}
-	if m.NewConsPubkey != nil {
-		l = m.NewConsPubkey.Size()
+	l = len(m.Website)
+	if l > 0 {
 		n += 1 + l + sovStaking(uint64(l))
 	}
-	if m.Height != 0 {
-		n += 1 + sovStaking(uint64(m.Height))
+	l = len(m.SecurityContact)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
 	}
-	l = m.Fee.Size()
+	l = len(m.Details)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *Validator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.ConsensusPubkey != nil {
+		l = m.ConsensusPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.Status != 0 {
+		n += 1 + sovStaking(uint64(m.Status))
+	}
+	l = m.Tokens.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.DelegatorShares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Description.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.UnbondingHeight != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondingTime)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Commission.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MinSelfDelegation.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if m.UnbondingOnHoldRefCount != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
+	}
+	if len(m.UnbondingIds) > 0 {
+		l = 0
+		for _, e := range m.UnbondingIds {
+			l += sovStaking(uint64(e))
+		}
+		n += 1 + sovStaking(uint64(l)) + l
+	}
+	if m.MultiAssetTokens != nil {
+		l = m.MultiAssetTokens.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
 	return n
 }
 
-func (m *ValAddrsOfRotatedConsKeys) Size() (n int) {
+func (m *ValAddresses) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
 	if len(m.Addresses) > 0 {
-		for _, b := range m.Addresses {
-			l = len(b)
+		for _, s := range m.Addresses {
+			l = len(s)
 			n += 1 + l + sovStaking(uint64(l))
 		}
 	}
 	return n
 }
 
-func sovStaking(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *DVPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
 }
-func sozStaking(x uint64) (n int) {
-	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *DVPairs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pairs) > 0 {
+		for _, e := range m.Pairs {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
 }
-func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
+
+func (m *DVVTriplet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorSrcAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorDstAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *DVVTriplets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Triplets) > 0 {
+		for _, e := range m.Triplets {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Delegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *UnbondingDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnbondingDelegationEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreationHeight != 0 {
+		n += 1 + sovStaking(uint64(m.CreationHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.InitialBalance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.UnbondingId != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingId))
+	}
+	if m.UnbondingOnHoldRefCount != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
+	}
+	return n
+}
+
+func (m *RedelegationEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreationHeight != 0 {
+		n += 1 + sovStaking(uint64(m.CreationHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.InitialBalance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.SharesDst.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.UnbondingId != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingId))
+	}
+	if m.UnbondingOnHoldRefCount != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
+	}
+	return n
+}
+
+func (m *Redelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorSrcAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorDstAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime)
+	n += 1 + l + sovStaking(uint64(l))
+	if m.MaxValidators != 0 {
+		n += 1 + sovStaking(uint64(m.MaxValidators))
+	}
+	if m.MaxEntries != 0 {
+		n += 1 + sovStaking(uint64(m.MaxEntries))
+	}
+	if m.HistoricalEntries != 0 {
+		n += 1 + sovStaking(uint64(m.HistoricalEntries))
+	}
+	l = len(m.BondDenom)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.MinCommissionRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.KeyRotationFee.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.ValidatorAllowlist) > 0 {
+		for _, s := range m.ValidatorAllowlist {
+			l = len(s)
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.MaxAutoCompoundsPerBlock != 0 {
+		n += 1 + sovStaking(uint64(m.MaxAutoCompoundsPerBlock))
+	}
+	l = m.MinAutoCompoundReward.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CommissionChangeNoticePeriod)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.FlashUndelegationPenalty.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxFlashUndelegationRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.PerformanceWindow != 0 {
+		n += 2 + sovStaking(uint64(m.PerformanceWindow))
+	}
+	if m.ForcedUndelegationEnabled {
+		n += 3
+	}
+	l = len(m.ForcedUndelegationAuthority)
+	if l > 0 {
+		n += 2 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Delegation.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *RedelegationEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RedelegationEntry.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *RedelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Redelegation.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NotBondedTokens.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.BondedTokens.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *ValidatorUpdates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsPubKeyRotationHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.OldConsPubkey != nil {
+		l = m.OldConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.NewConsPubkey != nil {
+		l = m.NewConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *ValAddrsOfRotatedConsKeys) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, b := range m.Addresses {
+			l = len(b)
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegationPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *AutoCompoundSetting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledCommissionChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.NewRate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *ValidatorPerformanceCounters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignedBlocks != 0 {
+		n += 1 + sovStaking(uint64(m.SignedBlocks))
+	}
+	if m.MissedBlocks != 0 {
+		n += 1 + sovStaking(uint64(m.MissedBlocks))
+	}
+	if m.ProposedBlocks != 0 {
+		n += 1 + sovStaking(uint64(m.ProposedBlocks))
+	}
+	return n
+}
+
+func (m *ValidatorPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovStaking(uint64(m.StartHeight))
+	}
+	if m.SignedBlocks != 0 {
+		n += 1 + sovStaking(uint64(m.SignedBlocks))
+	}
+	if m.MissedBlocks != 0 {
+		n += 1 + sovStaking(uint64(m.MissedBlocks))
+	}
+	if m.ProposedBlocks != 0 {
+		n += 1 + sovStaking(uint64(m.ProposedBlocks))
+	}
+	return n
+}
+
+func (m *BondableDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *MultiAssetPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Tokens.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Shares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *MultiAssetDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *MultiAssetUnbonding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.CreationHeight != 0 {
+		n += 1 + sovStaking(uint64(m.CreationHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *MaxValidatorsChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	if m.MaxValidators != 0 {
+		n += 1 + sovStaking(uint64(m.MaxValidators))
+	}
+	return n
+}
+
+func (m *RedelegationExemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorSrcAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorDstAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func sovStaking(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStaking(x uint64) (n int) {
+	return sovStaking(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoricalInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoricalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Valset = append(m.Valset, Validator{})
+			if err := m.Valset[len(m.Valset)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoricalRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoricalRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoricalRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apphash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Apphash = append(m.Apphash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Apphash == nil {
+				m.Apphash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorsHash = append(m.ValidatorsHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorsHash == nil {
+				m.ValidatorsHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommissionRates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommissionRates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommissionRates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Commission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Commission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Commission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Description) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Description: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Website = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityContact = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Validator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusPubkey == nil {
+				m.ConsensusPubkey = &types1.Any{}
+			}
+			if err := m.ConsensusPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BondStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingHeight", wireType)
+			}
+			m.UnbondingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+			}
+			m.UnbondingOnHoldRefCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStaking
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UnbondingIds = append(m.UnbondingIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStaking
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStaking
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStaking
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UnbondingIds) == 0 {
+					m.UnbondingIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStaking
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UnbondingIds = append(m.UnbondingIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingIds", wireType)
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiAssetTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MultiAssetTokens = &v
+			if err := m.MultiAssetTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValAddresses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValAddresses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVPairs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVPairs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pairs = append(m.Pairs, DVPair{})
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVVTriplet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVVTriplet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVVTriplets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVVTriplets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Triplets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Triplets = append(m.Triplets, DVVTriplet{})
+			if err := m.Triplets[len(m.Triplets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Delegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HistoricalInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HistoricalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4384,30 +8326,29 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4417,23 +8358,23 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Valset = append(m.Valset, Validator{})
-			if err := m.Valset[len(m.Valset)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4458,7 +8399,7 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HistoricalRecord) Unmarshal(dAtA []byte) error {
+func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4481,17 +8422,17 @@ func (m *HistoricalRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HistoricalRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HistoricalRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Apphash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4501,31 +8442,29 @@ func (m *HistoricalRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Apphash = append(m.Apphash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Apphash == nil {
-				m.Apphash = []byte{}
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4535,33 +8474,29 @@ func (m *HistoricalRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Time == nil {
-				m.Time = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4571,24 +8506,24 @@ func (m *HistoricalRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorsHash = append(m.ValidatorsHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.ValidatorsHash == nil {
-				m.ValidatorsHash = []byte{}
+			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -4612,7 +8547,7 @@ func (m *HistoricalRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommissionRates) Unmarshal(dAtA []byte) error {
+func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4635,17 +8570,36 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommissionRates: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommissionRates: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4655,29 +8609,28 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4705,13 +8658,13 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4739,10 +8692,48 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
+			}
+			m.UnbondingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+			}
+			m.UnbondingOnHoldRefCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -4764,7 +8755,7 @@ func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Commission) Unmarshal(dAtA []byte) error {
+func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4787,17 +8778,69 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Commission: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Commission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4807,30 +8850,31 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommissionRates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4840,25 +8884,64 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SharesDst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
+			}
+			m.UnbondingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+			}
+			m.UnbondingOnHoldRefCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -4880,7 +8963,7 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Description) Unmarshal(dAtA []byte) error {
+func (m *Redelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4903,15 +8986,15 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Description: wiretype end group for non-group")
+			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4939,11 +9022,11 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4971,11 +9054,11 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5003,13 +9086,13 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Website = string(dAtA[iNdEx:postIndex])
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5019,55 +9102,25 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecurityContact = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Entries = append(m.Entries, RedelegationEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Details = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5090,7 +9143,7 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Validator) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5113,17 +9166,17 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5133,29 +9186,87 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+			}
+			m.MaxValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+			}
+			m.MaxEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+			}
+			m.HistoricalEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5165,33 +9276,29 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsensusPubkey == nil {
-				m.ConsensusPubkey = &types1.Any{}
-			}
-			if err := m.ConsensusPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5201,17 +9308,31 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Jailed = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationFee", wireType)
 			}
-			m.Status = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5221,14 +9342,28 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= BondStatus(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KeyRotationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAllowlist", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5256,13 +9391,11 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAllowlist = append(m.ValidatorAllowlist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5290,15 +9423,15 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5308,30 +9441,31 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAutoCompoundsPerBlock", wireType)
 			}
-			m.UnbondingHeight = 0
+			m.MaxAutoCompoundsPerBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5341,16 +9475,16 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingHeight |= int64(b&0x7F) << shift
+				m.MaxAutoCompoundsPerBlock |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAutoCompoundReward", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5360,28 +9494,29 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinAutoCompoundReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionChangeNoticePeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5408,13 +9543,13 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CommissionChangeNoticePeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FlashUndelegationPenalty", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5442,15 +9577,15 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FlashUndelegationPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFlashUndelegationRate", wireType)
 			}
-			m.UnbondingOnHoldRefCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5460,87 +9595,97 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowStaking
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFlashUndelegationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceWindow", wireType)
+			}
+			m.PerformanceWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
 				}
-				m.UnbondingIds = append(m.UnbondingIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowStaking
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthStaking
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerformanceWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthStaking
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForcedUndelegationEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.UnbondingIds) == 0 {
-					m.UnbondingIds = make([]uint64, 0, elementCount)
+			}
+			m.ForcedUndelegationEnabled = bool(v != 0)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForcedUndelegationAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowStaking
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.UnbondingIds = append(m.UnbondingIds, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingIds", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ForcedUndelegationAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -5562,7 +9707,7 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValAddresses) Unmarshal(dAtA []byte) error {
+func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5585,17 +9730,17 @@ func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValAddresses: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5605,23 +9750,57 @@ func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5644,7 +9823,7 @@ func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DVPair) Unmarshal(dAtA []byte) error {
+func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5667,17 +9846,17 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DVPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationEntryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationEntry", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5687,27 +9866,28 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.RedelegationEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5735,7 +9915,9 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5758,7 +9940,7 @@ func (m *DVPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DVPairs) Unmarshal(dAtA []byte) error {
+func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5781,15 +9963,15 @@ func (m *DVPairs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DVPairs: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVPairs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5816,8 +9998,41 @@ func (m *DVPairs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pairs = append(m.Pairs, DVPair{})
-			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Redelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, RedelegationEntryResponse{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5842,7 +10057,7 @@ func (m *DVPairs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
+func (m *Pool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5865,15 +10080,15 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DVVTriplet: wiretype end group for non-group")
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVVTriplet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBondedTokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5901,11 +10116,13 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.NotBondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5933,39 +10150,9 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5988,7 +10175,7 @@ func (m *DVVTriplet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
+func (m *ValidatorUpdates) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6011,15 +10198,15 @@ func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DVVTriplets: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorUpdates: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DVVTriplets: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorUpdates: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Triplets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6046,8 +10233,8 @@ func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Triplets = append(m.Triplets, DVVTriplet{})
-			if err := m.Triplets[len(m.Triplets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Updates = append(m.Updates, types3.ValidatorUpdate{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6072,7 +10259,7 @@ func (m *DVVTriplets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Delegation) Unmarshal(dAtA []byte) error {
+func (m *ConsPubKeyRotationHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6095,17 +10282,17 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsPubKeyRotationHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsPubKeyRotationHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6115,29 +10302,122 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.OperatorAddress = append(m.OperatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OperatorAddress == nil {
+				m.OperatorAddress = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldConsPubkey", wireType)
 			}
-			var stringLen uint64
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldConsPubkey == nil {
+				m.OldConsPubkey = &types1.Any{}
+			}
+			if err := m.OldConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewConsPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewConsPubkey == nil {
+				m.NewConsPubkey = &types1.Any{}
+			}
+			if err := m.NewConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6147,29 +10427,80 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValAddrsOfRotatedConsKeys) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValAddrsOfRotatedConsKeys: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValAddrsOfRotatedConsKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6179,25 +10510,23 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addresses = append(m.Addresses, make([]byte, postIndex-iNdEx))
+			copy(m.Addresses[len(m.Addresses)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6220,7 +10549,7 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
+func (m *DelegationPosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6243,15 +10572,34 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationPosition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationPosition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6279,9 +10627,9 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
@@ -6313,11 +10661,11 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 			}
 			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6327,23 +10675,23 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, UnbondingDelegationEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6368,7 +10716,7 @@ func (m *UnbondingDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6391,17 +10739,17 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnbondingDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.CreationHeight = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6411,16 +10759,16 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -6430,28 +10778,27 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6479,13 +10826,11 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6513,48 +10858,8 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
-			}
-			m.UnbondingId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
-			}
-			m.UnbondingOnHoldRefCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6576,90 +10881,38 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
+func (m *AutoCompoundSetting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationEntry: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
-			}
-			m.CreationHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreationHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompoundSetting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompoundSetting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6687,13 +10940,11 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharesDst", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6721,48 +10972,8 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SharesDst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
-			}
-			m.UnbondingId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
-			}
-			m.UnbondingOnHoldRefCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6784,7 +10995,7 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Redelegation) Unmarshal(dAtA []byte) error {
+func (m *ScheduledCommissionChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6807,15 +11018,15 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduledCommissionChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduledCommissionChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6843,11 +11054,11 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6875,43 +11086,13 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.NewRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6938,8 +11119,7 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, RedelegationEntry{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6964,7 +11144,7 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ValidatorPerformanceCounters) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6987,17 +11167,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorPerformanceCounters: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorPerformanceCounters: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocks", wireType)
 			}
-			var msglen int
+			m.SignedBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7007,30 +11187,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SignedBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
 			}
-			m.MaxValidators = 0
+			m.MissedBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7040,16 +11206,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxValidators |= uint32(b&0x7F) << shift
+				m.MissedBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedBlocks", wireType)
 			}
-			m.MaxEntries = 0
+			m.ProposedBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7059,33 +11225,64 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxEntries |= uint32(b&0x7F) << shift
+				m.ProposedBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.HistoricalEntries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.HistoricalEntries |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
 			}
-		case 5:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPerformance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPerformance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7113,13 +11310,51 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocks", wireType)
+			}
+			m.SignedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
 			}
-			var stringLen uint64
+			m.MissedBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7129,31 +11364,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MissedBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationFee", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedBlocks", wireType)
 			}
-			var msglen int
+			m.ProposedBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7163,25 +11383,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ProposedBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.KeyRotationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -7203,7 +11409,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *BondableDenom) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7226,17 +11432,17 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BondableDenom: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BondableDenom: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7246,30 +11452,29 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7279,22 +11484,23 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7319,7 +11525,7 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
+func (m *MultiAssetPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7342,17 +11548,17 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationEntryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiAssetPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiAssetPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RedelegationEntry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7362,28 +11568,27 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RedelegationEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7411,65 +11616,13 @@ func (m *RedelegationEntryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RedelegationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7479,30 +11632,31 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Redelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7512,23 +11666,23 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, RedelegationEntryResponse{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7553,7 +11707,7 @@ func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pool) Unmarshal(dAtA []byte) error {
+func (m *MultiAssetDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7576,15 +11730,15 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiAssetDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiAssetDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotBondedTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7612,13 +11766,11 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NotBondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7646,65 +11798,45 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidatorUpdates) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorUpdates: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorUpdates: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7714,23 +11846,23 @@ func (m *ValidatorUpdates) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Updates = append(m.Updates, types3.ValidatorUpdate{})
-			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7755,7 +11887,7 @@ func (m *ValidatorUpdates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsPubKeyRotationHistory) Unmarshal(dAtA []byte) error {
+func (m *MultiAssetUnbonding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7778,17 +11910,36 @@ func (m *ConsPubKeyRotationHistory) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsPubKeyRotationHistory: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiAssetUnbonding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsPubKeyRotationHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiAssetUnbonding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7798,31 +11949,29 @@ func (m *ConsPubKeyRotationHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = append(m.OperatorAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.OperatorAddress == nil {
-				m.OperatorAddress = []byte{}
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldConsPubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7832,31 +11981,27 @@ func (m *ConsPubKeyRotationHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OldConsPubkey == nil {
-				m.OldConsPubkey = &types1.Any{}
-			}
-			if err := m.OldConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewConsPubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7883,18 +12028,15 @@ func (m *ConsPubKeyRotationHistory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewConsPubkey == nil {
-				m.NewConsPubkey = &types1.Any{}
-			}
-			if err := m.NewConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
 			}
-			m.Height = 0
+			m.CreationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -7904,14 +12046,14 @@ func (m *ConsPubKeyRotationHistory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.CreationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7938,7 +12080,7 @@ func (m *ConsPubKeyRotationHistory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7963,7 +12105,7 @@ func (m *ConsPubKeyRotationHistory) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValAddrsOfRotatedConsKeys) Unmarshal(dAtA []byte) error {
+func (m *MaxValidatorsChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7986,17 +12128,105 @@ func (m *ValAddrsOfRotatedConsKeys) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValAddrsOfRotatedConsKeys: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaxValidatorsChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValAddrsOfRotatedConsKeys: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaxValidatorsChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidators", wireType)
+			}
+			m.MaxValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidators |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedelegationExemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedelegationExemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedelegationExemption: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -8006,23 +12236,88 @@ func (m *ValAddrsOfRotatedConsKeys) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, make([]byte, postIndex-iNdEx))
-			copy(m.Addresses[len(m.Addresses)-1], dAtA[iNdEx:postIndex])
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// TokenizeShareModuleAccountPrefix is the prefix of the names of the module
+// accounts delegating tokenized shares.
+const TokenizeShareModuleAccountPrefix = "tokenizeshare_"
+
+// TokenizeShareModuleAccountName returns the name of the module account
+// delegating the tokenized shares of a record.
+func TokenizeShareModuleAccountName(id uint64) string {
+	return fmt.Sprintf("%s%d", TokenizeShareModuleAccountPrefix, id)
+}
+
+// GetModuleAddress returns the address of the module account delegating the
+// tokenized shares of the record.
+func (r TokenizeShareRecord) GetModuleAddress() []byte {
+	return address.Module(r.ModuleAccount)
+}
+
+// GetShareTokenDenom returns the denom of the share tokens of the record.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return fmt.Sprintf("%s/%d", strings.ToLower(r.ValidatorAddress), r.Id)
+}
@@ -358,9 +358,20 @@ func (v Validator) ConsensusPower(r math.Int) int64 {
 	return 0
 }
 
-// PotentialConsensusPower returns the potential consensus-engine power.
+// PotentialConsensusPower returns the potential consensus-engine power, which
+// accounts for the multi-asset tokens of the validator.
 func (v Validator) PotentialConsensusPower(r math.Int) int64 {
-	return sdk.TokensToConsensusPower(v.Tokens, r)
+	return sdk.TokensToConsensusPower(v.Tokens.Add(v.GetMultiAssetTokens()), r)
+}
+
+// GetMultiAssetTokens returns the bond denom equivalent of the tokens of the
+// other bondable denoms delegated to the validator.
+func (v Validator) GetMultiAssetTokens() math.Int {
+	if v.MultiAssetTokens == nil {
+		return math.ZeroInt()
+	}
+
+	return *v.MultiAssetTokens
 }
 
 // UpdateStatus updates the location of the shares within a validator
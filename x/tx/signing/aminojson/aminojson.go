@@ -89,13 +89,14 @@ func (h SignModeHandler) GetSignBytes(_ context.Context, signerData signing.Sign
 	}
 
 	signDoc := &aminojsonpb.AminoSignDoc{
-		AccountNumber: signerData.AccountNumber,
-		TimeoutHeight: body.TimeoutHeight,
-		ChainId:       signerData.ChainID,
-		Sequence:      signerData.Sequence,
-		Memo:          body.Memo,
-		Msgs:          txData.Body.Messages,
-		Fee:           fee,
+		AccountNumber:    signerData.AccountNumber,
+		TimeoutHeight:    body.TimeoutHeight,
+		TimeoutTimestamp: body.TimeoutTimestamp,
+		ChainId:          signerData.ChainID,
+		Sequence:         signerData.Sequence,
+		Memo:             body.Memo,
+		Msgs:             txData.Body.Messages,
+		Fee:              fee,
 	}
 
 	return h.encoder.Marshal(signDoc)
@@ -61,6 +61,28 @@ func cosmosDecEncoder(_ *Encoder, v protoreflect.Value, w io.Writer) error {
 			return err
 		}
 		return jsonMarshal(w, dec.String())
+	case protoreflect.List:
+		// empty lists decode to nil slices, encoded as null by legacy amino JSON
+		if !val.IsValid() || val.Len() == 0 {
+			_, err := io.WriteString(w, "null")
+			return err
+		}
+		_, err := io.WriteString(w, "[")
+		if err != nil {
+			return err
+		}
+		for i := 0; i < val.Len(); i++ {
+			if i > 0 {
+				if _, err := io.WriteString(w, ","); err != nil {
+					return err
+				}
+			}
+			if err := cosmosDecEncoder(nil, val.Get(i), w); err != nil {
+				return err
+			}
+		}
+		_, err = io.WriteString(w, "]")
+		return err
 	default:
 		return fmt.Errorf("unsupported type %T", val)
 	}
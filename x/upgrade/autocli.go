@@ -40,6 +40,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "authority",
 					Short:     "Get the upgrade authority address",
 				},
+				{
+					RpcMethod: "SkipUpgrade",
+					Use:       "skip-upgrade [height]",
+					Short:     "Query the validator signals to skip the upgrade at a height",
+					Long:      "Gets the operator addresses of the validators that signalled to skip the upgrade scheduled at the given height, and whether the upgrade is recorded on-chain as skipped.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "height"},
+					},
+				},
 				{
 					RpcMethod: "UpgradedConsensusState",
 					Skip:      true, // Skipping this command as the query is deprecated.
@@ -55,6 +64,16 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:       "Submit a proposal to cancel a planned chain upgrade.",
 					GovProposal: true,
 				},
+				{
+					RpcMethod: "SignalSkipUpgrade",
+					Use:       "signal-skip-upgrade [validator] [height]",
+					Short:     "Signal to skip the upgrade scheduled at a height",
+					Long:      "Signal as a bonded validator that the upgrade scheduled at the given height should be skipped. The upgrade is recorded on-chain as skipped once validators holding more than 2/3 of the bonded power have signalled.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+						{ProtoField: "height"},
+					},
+				},
 				{
 					RpcMethod: "SoftwareUpgrade",
 					Skip:      true, // skipped because authority gated
@@ -41,7 +41,8 @@ type ModuleInputs struct {
 	AddressCodec       address.Codec
 	AppVersionModifier baseapp.AppVersionModifier
 
-	AppOpts servertypes.AppOptions `optional:"true"`
+	AppOpts       servertypes.AppOptions `optional:"true"`
+	StakingKeeper types.StakingKeeper    `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -77,7 +78,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	}
 
 	// set the governance module account as the authority for conducting upgrades
-	k := keeper.NewKeeper(in.Environment, skipUpgradeHeights, in.Cdc, homePath, in.AppVersionModifier, authorityStr)
+	k := keeper.NewKeeper(in.Environment, skipUpgradeHeights, in.Cdc, homePath, in.AppVersionModifier, in.StakingKeeper, authorityStr)
 	m := NewAppModule(k)
 
 	return ModuleOutputs{UpgradeKeeper: k, Module: m}
@@ -15,7 +15,8 @@ import (
 )
 
 // PreBlocker will check if there is a scheduled plan and if it is ready to be executed.
-// If the current height is in the provided set of heights to skip, it will skip and clear the upgrade plan.
+// If the current height is in the provided set of heights to skip, or validators signalled to skip the
+// upgrade at the current height, it will skip and clear the upgrade plan.
 // If it is ready, it will execute it if the handler is installed, and panic/abort otherwise.
 // If the plan is not ready, it will ensure the handler is not registered too early (and abort otherwise).
 //
@@ -32,6 +33,16 @@ func (k Keeper) PreBlocker(ctx context.Context) (appmodule.ResponsePreBlock, err
 	}
 	found := err == nil
 
+	// the skip upgrade heights are a node-local emergency override, for a chain already
+	// halted on a broken upgrade that can no longer record validator signals
+	skip := k.IsSkipHeight(blockHeight)
+	if !skip && found && plan.ShouldExecute(blockHeight) {
+		skip, err = k.IsSkipRecorded(ctx, blockHeight)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO remove with consensus messages
 	if !k.DowngradeVerified() {
 		k.SetDowngradeVerified(true)
@@ -39,8 +50,8 @@ func (k Keeper) PreBlocker(ctx context.Context) (appmodule.ResponsePreBlock, err
 		// It'll panic in these cases if there is no upgrade handler registered for the last applied upgrade.
 		// 1. If there is no scheduled upgrade.
 		// 2. If the plan is not ready.
-		// 3. If the plan is ready and skip upgrade height is set for current height.
-		if !found || !plan.ShouldExecute(blockHeight) || (plan.ShouldExecute(blockHeight) && k.IsSkipHeight(blockHeight)) {
+		// 3. If the plan is ready and skip upgrade height is set or signalled for current height.
+		if !found || !plan.ShouldExecute(blockHeight) || (plan.ShouldExecute(blockHeight) && skip) {
 			lastAppliedPlan, _, err := k.GetLastCompletedUpgrade(ctx)
 			if err != nil {
 				return nil, err
@@ -69,8 +80,8 @@ func (k Keeper) PreBlocker(ctx context.Context) (appmodule.ResponsePreBlock, err
 
 	// To make sure clear upgrade is executed at the same block
 	if plan.ShouldExecute(blockHeight) {
-		// If skip upgrade has been set for current height, we clear the upgrade plan
-		if k.IsSkipHeight(blockHeight) {
+		// If skip upgrade has been set or signalled for current height, we clear the upgrade plan
+		if skip {
 			skipUpgradeMsg := fmt.Sprintf("UPGRADE \"%s\" SKIPPED at %d: %s", plan.Name, plan.Height, plan.Info)
 			logger.Info(skipUpgradeMsg)
 
@@ -37,6 +37,7 @@ type TestSuite struct {
 	ctx       sdk.Context
 	baseApp   *baseapp.BaseApp
 	encCfg    moduletestutil.TestEncodingConfig
+	valAddr   sdk.ValAddress
 }
 
 func (s *TestSuite) VerifyDoUpgrade(t *testing.T) {
@@ -99,15 +100,26 @@ func (s *TestSuite) VerifyDone(t *testing.T, newCtx sdk.Context, name string) {
 	require.NoError(t, err)
 }
 
-func (s *TestSuite) VerifySet(t *testing.T, skipUpgradeHeights map[int64]bool) {
+func (s *TestSuite) VerifySet(t *testing.T, skipUpgradeHeights ...int64) {
 	t.Helper()
 	t.Log("Verify if the skip upgrade has been set")
 
-	for k := range skipUpgradeHeights {
-		require.True(t, s.keeper.IsSkipHeight(k))
+	for _, h := range skipUpgradeHeights {
+		skip, err := s.keeper.IsSkipRecorded(s.ctx, h)
+		require.NoError(t, err)
+		require.True(t, skip)
 	}
 }
 
+func (s *TestSuite) SignalSkip(t *testing.T, height int64) {
+	t.Helper()
+	t.Log("Signal to skip the upgrade with the whole validator set")
+
+	skipped, err := s.keeper.SignalSkipUpgrade(s.ctx, s.valAddr, height)
+	require.NoError(t, err)
+	require.True(t, skipped)
+}
+
 func setupTest(t *testing.T, height int64, skip map[int64]bool) *TestSuite {
 	t.Helper()
 	s := TestSuite{}
@@ -129,7 +141,9 @@ func setupTest(t *testing.T, height int64, skip map[int64]bool) *TestSuite {
 	authority, err := addresscodec.NewBech32Codec("cosmos").BytesToString(authtypes.NewModuleAddress(govModuleName))
 	require.NoError(t, err)
 
-	s.keeper = keeper.NewKeeper(env, skip, s.encCfg.Codec, t.TempDir(), s.baseApp, authority)
+	s.valAddr = sdk.ValAddress("validator")
+	sk := &stakingKeeper{validators: []sdk.ValAddress{s.valAddr}, powers: []int64{10}}
+	s.keeper = keeper.NewKeeper(env, skip, s.encCfg.Codec, t.TempDir(), s.baseApp, sk, authority)
 
 	s.ctx = testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now(), Height: height})
 
@@ -235,7 +249,6 @@ func TestContains(t *testing.T) {
 	var skipOne int64 = 11
 	s := setupTest(t, 10, map[int64]bool{skipOne: true})
 
-	s.VerifySet(t, map[int64]bool{skipOne: true})
 	t.Log("case where array contains the element")
 	require.True(t, s.keeper.IsSkipHeight(11))
 
@@ -243,20 +256,41 @@ func TestContains(t *testing.T) {
 	require.False(t, s.keeper.IsSkipHeight(4))
 }
 
+func TestSkipUpgradeByFlag(t *testing.T) {
+	var skipOne int64 = 11
+	s := setupTest(t, 10, map[int64]bool{skipOne: true})
+
+	err := s.keeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "test", Height: skipOne})
+	require.NoError(t, err)
+
+	t.Log("Verify if skip upgrade flag clears upgrade plan without validator signals")
+	skip, err := s.keeper.IsSkipRecorded(s.ctx, skipOne)
+	require.NoError(t, err)
+	require.False(t, skip)
+
+	newCtx := s.ctx.WithHeaderInfo(header.Info{Height: skipOne})
+	_, err = s.preModule.PreBlock(newCtx)
+	require.NoError(t, err)
+
+	s.VerifyCleared(t, newCtx)
+	s.VerifyNotDone(t, s.ctx, "test")
+}
+
 func TestSkipUpgradeSkippingAll(t *testing.T) {
 	var (
 		skipOne int64 = 11
 		skipTwo int64 = 20
 	)
-	s := setupTest(t, 10, map[int64]bool{skipOne: true, skipTwo: true})
+	s := setupTest(t, 10, map[int64]bool{})
 
 	newCtx := s.ctx
 
 	err := s.keeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "test", Height: skipOne})
 	require.NoError(t, err)
+	s.SignalSkip(t, skipOne)
 
-	t.Log("Verify if skip upgrade flag clears upgrade plan in both cases")
-	s.VerifySet(t, map[int64]bool{skipOne: true, skipTwo: true})
+	t.Log("Verify if skip upgrade signal clears upgrade plan in both cases")
+	s.VerifySet(t, skipOne)
 
 	newCtx = newCtx.WithHeaderInfo(header.Info{Height: skipOne})
 	_, err = s.preModule.PreBlock(newCtx)
@@ -265,6 +299,8 @@ func TestSkipUpgradeSkippingAll(t *testing.T) {
 	t.Log("Verify a second proposal also is being cleared")
 	err = s.keeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "test2", Height: skipTwo})
 	require.NoError(t, err)
+	s.SignalSkip(t, skipTwo)
+	s.VerifySet(t, skipTwo)
 
 	newCtx = newCtx.WithHeaderInfo(header.Info{Height: skipTwo})
 	_, err = s.preModule.PreBlock(newCtx)
@@ -282,15 +318,16 @@ func TestUpgradeSkippingOne(t *testing.T) {
 		skipOne int64 = 11
 		skipTwo int64 = 20
 	)
-	s := setupTest(t, 10, map[int64]bool{skipOne: true})
+	s := setupTest(t, 10, map[int64]bool{})
 
 	newCtx := s.ctx
 
 	err := s.keeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "test", Height: skipOne})
 	require.NoError(t, err)
+	s.SignalSkip(t, skipOne)
 
-	t.Log("Verify if skip upgrade flag clears upgrade plan in one case and does upgrade on another")
-	s.VerifySet(t, map[int64]bool{skipOne: true})
+	t.Log("Verify if skip upgrade signal clears upgrade plan in one case and does upgrade on another")
+	s.VerifySet(t, skipOne)
 
 	// Setting block height of proposal test
 	newCtx = newCtx.WithHeaderInfo(header.Info{Height: skipOne})
@@ -315,24 +352,26 @@ func TestUpgradeSkippingOnlyTwo(t *testing.T) {
 		skipTwo   int64 = 20
 		skipThree int64 = 25
 	)
-	s := setupTest(t, 10, map[int64]bool{skipOne: true, skipTwo: true})
+	s := setupTest(t, 10, map[int64]bool{})
 
 	newCtx := s.ctx
 
 	err := s.keeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "test", Height: skipOne})
 	require.NoError(t, err)
+	s.SignalSkip(t, skipOne)
 
-	t.Log("Verify if skip upgrade flag clears upgrade plan in both cases and does third upgrade")
-	s.VerifySet(t, map[int64]bool{skipOne: true, skipTwo: true})
+	t.Log("Verify if skip upgrade signal clears upgrade plan in both cases and does third upgrade")
+	s.VerifySet(t, skipOne)
 
 	// Setting block height of proposal test
 	newCtx = newCtx.WithHeaderInfo(header.Info{Height: skipOne})
 	_, err = s.preModule.PreBlock(newCtx)
 	require.NoError(t, err)
 
-	// A new proposal with height in skipUpgradeHeights
+	// A new proposal which validators signal to skip
 	err = s.keeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "test2", Height: skipTwo})
 	require.NoError(t, err)
+	s.SignalSkip(t, skipTwo)
 	// Setting block height of proposal test2
 	newCtx = newCtx.WithHeaderInfo(header.Info{Height: skipTwo})
 	_, err = s.preModule.PreBlock(newCtx)
@@ -396,8 +435,7 @@ func TestDumpUpgradeInfoToFile(t *testing.T) {
 
 // TODO: add testcase to for `no upgrade handler is present for last applied upgrade`.
 func TestBinaryVersion(t *testing.T) {
-	var skipHeight int64 = 15
-	s := setupTest(t, 10, map[int64]bool{skipHeight: true})
+	s := setupTest(t, 10, map[int64]bool{})
 
 	testCases := []struct {
 		name        string
@@ -470,7 +508,7 @@ func TestDowngradeVerification(t *testing.T) {
 	authority, err := addresscodec.NewBech32Codec("cosmos").BytesToString(authtypes.NewModuleAddress(govModuleName))
 	require.NoError(t, err)
 
-	k := keeper.NewKeeper(env, skip, encCfg.Codec, t.TempDir(), nil, authority)
+	k := keeper.NewKeeper(env, skip, encCfg.Codec, t.TempDir(), nil, nil, authority)
 	m := upgrade.NewAppModule(k)
 
 	// submit a plan.
@@ -519,7 +557,7 @@ func TestDowngradeVerification(t *testing.T) {
 		require.NoError(t, err)
 
 		// downgrade. now keeper does not have the handler.
-		k := keeper.NewKeeper(env, skip, encCfg.Codec, t.TempDir(), nil, authority)
+		k := keeper.NewKeeper(env, skip, encCfg.Codec, t.TempDir(), nil, nil, authority)
 		m := upgrade.NewAppModule(k)
 
 		// assertions
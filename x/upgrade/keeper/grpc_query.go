@@ -77,3 +77,27 @@ func (k Keeper) ModuleVersions(ctx context.Context, req *types.QueryModuleVersio
 func (k Keeper) Authority(c context.Context, req *types.QueryAuthorityRequest) (*types.QueryAuthorityResponse, error) {
 	return &types.QueryAuthorityResponse{Address: k.authority}, nil
 }
+
+// SkipUpgrade implements the Query/SkipUpgrade gRPC method
+func (k Keeper) SkipUpgrade(ctx context.Context, req *types.QuerySkipUpgradeRequest) (*types.QuerySkipUpgradeResponse, error) {
+	skip, err := k.IsSkipRecorded(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	signals, err := k.GetSkipSignals(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	validators := make([]string, 0, len(signals))
+	for _, valAddr := range signals {
+		valAddrStr, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, valAddrStr)
+	}
+
+	return &types.QuerySkipUpgradeResponse{Skip: skip, Validators: validators}, nil
+}
@@ -32,6 +32,7 @@ type UpgradeTestSuite struct {
 	queryClient      types.QueryClient
 	encCfg           moduletestutil.TestEncodingConfig
 	encodedAuthority string
+	valAddrs         []sdk.ValAddress
 }
 
 func (suite *UpgradeTestSuite) SetupTest() {
@@ -46,7 +47,9 @@ func (suite *UpgradeTestSuite) SetupTest() {
 	authority, err := addresscodec.NewBech32Codec("cosmos").BytesToString(authtypes.NewModuleAddress(types.GovModuleName))
 	suite.Require().NoError(err)
 	suite.encodedAuthority = authority
-	suite.upgradeKeeper = keeper.NewKeeper(env, skipUpgradeHeights, suite.encCfg.Codec, suite.T().TempDir(), nil, authority)
+	suite.valAddrs = []sdk.ValAddress{sdk.ValAddress("validator1"), sdk.ValAddress("validator2")}
+	sk := &stakingKeeper{validators: suite.valAddrs, powers: []int64{2, 1}}
+	suite.upgradeKeeper = keeper.NewKeeper(env, skipUpgradeHeights, suite.encCfg.Codec, suite.T().TempDir(), nil, sk, authority)
 	err = suite.upgradeKeeper.SetModuleVersionMap(suite.ctx, module.VersionMap{
 		"bank": 0,
 	})
@@ -238,6 +241,37 @@ func (suite *UpgradeTestSuite) TestAuthority() {
 	suite.Require().Equal(suite.encodedAuthority, res.Address)
 }
 
+func (suite *UpgradeTestSuite) TestSkipUpgrade() {
+	res, err := suite.queryClient.SkipUpgrade(context.Background(), &types.QuerySkipUpgradeRequest{Height: 100})
+	suite.Require().NoError(err)
+	suite.Require().False(res.Skip)
+	suite.Require().Empty(res.Validators)
+
+	err = suite.upgradeKeeper.ScheduleUpgrade(suite.ctx, types.Plan{Name: "test-plan", Height: 100})
+	suite.Require().NoError(err)
+
+	skipped, err := suite.upgradeKeeper.SignalSkipUpgrade(suite.ctx, suite.valAddrs[0], 100)
+	suite.Require().NoError(err)
+	suite.Require().False(skipped)
+
+	valAddr, err := addresscodec.NewBech32Codec("cosmosvaloper").BytesToString(suite.valAddrs[0])
+	suite.Require().NoError(err)
+
+	res, err = suite.queryClient.SkipUpgrade(context.Background(), &types.QuerySkipUpgradeRequest{Height: 100})
+	suite.Require().NoError(err)
+	suite.Require().False(res.Skip)
+	suite.Require().Equal([]string{valAddr}, res.Validators)
+
+	skipped, err = suite.upgradeKeeper.SignalSkipUpgrade(suite.ctx, suite.valAddrs[1], 100)
+	suite.Require().NoError(err)
+	suite.Require().True(skipped)
+
+	res, err = suite.queryClient.SkipUpgrade(context.Background(), &types.QuerySkipUpgradeRequest{Height: 100})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Skip)
+	suite.Require().Len(res.Validators, 2)
+}
+
 func TestUpgradeTestSuite(t *testing.T) {
 	suite.Run(t, new(UpgradeTestSuite))
 }
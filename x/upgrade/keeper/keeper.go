@@ -37,18 +37,20 @@ type Keeper struct {
 	cdc                codec.BinaryCodec               // App-wide binary codec
 	upgradeHandlers    map[string]types.UpgradeHandler // map of plan name to upgrade handler
 	versionModifier    xp.AppVersionModifier           // implements setting the protocol version field on BaseApp
+	stakingKeeper      types.StakingKeeper             // weighs validator signals to skip an upgrade
 	downgradeVerified  bool                            // tells if we've already sanity checked that this binary version isn't being used against an old state.
 	authority          string                          // the address capable of executing and canceling an upgrade. Usually the gov module account
 	initVersionMap     module.VersionMap               // the module version map at init genesis
 }
 
 // NewKeeper constructs an upgrade Keeper which requires the following arguments:
+// env - the environment with which to access upgrade's store
 // skipUpgradeHeights - map of heights to skip an upgrade
-// storeKey - a store key with which to access upgrade's store
 // cdc - the app-wide binary codec
 // homePath - root directory of the application's config
 // vs - the interface implemented by baseapp which allows setting baseapp's protocol version field
-func NewKeeper(env appmodule.Environment, skipUpgradeHeights map[int64]bool, cdc codec.BinaryCodec, homePath string, vs xp.AppVersionModifier, authority string) *Keeper {
+// sk - the staking keeper used to weigh validator signals to skip an upgrade
+func NewKeeper(env appmodule.Environment, skipUpgradeHeights map[int64]bool, cdc codec.BinaryCodec, homePath string, vs xp.AppVersionModifier, sk types.StakingKeeper, authority string) *Keeper {
 	k := &Keeper{
 		homePath:           homePath,
 		skipUpgradeHeights: skipUpgradeHeights,
@@ -56,6 +58,7 @@ func NewKeeper(env appmodule.Environment, skipUpgradeHeights map[int64]bool, cdc
 		cdc:                cdc,
 		upgradeHandlers:    map[string]types.UpgradeHandler{},
 		versionModifier:    vs,
+		stakingKeeper:      sk,
 		authority:          authority,
 	}
 
@@ -219,6 +222,12 @@ func (k Keeper) ScheduleUpgrade(ctx context.Context, plan types.Plan) error {
 		if err != nil {
 			return err
 		}
+
+		// skip signals are only valid for the plan they were given for
+		err = k.clearSkipSignals(ctx, oldPlan.Height)
+		if err != nil {
+			return err
+		}
 	}
 
 	bz, err := k.cdc.Marshal(&plan)
@@ -365,6 +374,11 @@ func (k Keeper) ClearUpgradePlan(ctx context.Context) error {
 		return err
 	}
 
+	err = k.clearSkipSignals(ctx, oldPlan.Height)
+	if err != nil {
+		return err
+	}
+
 	store := k.environment.KVStoreService.OpenKVStore(ctx)
 	return store.Delete(types.PlanKey())
 }
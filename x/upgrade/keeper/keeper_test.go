@@ -9,6 +9,7 @@ import (
 	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/stretchr/testify/suite"
 
+	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
@@ -36,6 +37,7 @@ type KeeperTestSuite struct {
 	homeDir          string
 	ctx              sdk.Context
 	msgSrvr          types.MsgServer
+	stakingKeeper    *stakingKeeper
 	addrs            []sdk.AccAddress
 	encodedAddrs     []string
 	encodedAuthority string
@@ -69,7 +71,11 @@ func (s *KeeperTestSuite) SetupTest() {
 	authority, err := ac.BytesToString(authtypes.NewModuleAddress(types.GovModuleName))
 	s.Require().NoError(err)
 	s.encodedAuthority = authority
-	s.upgradeKeeper = keeper.NewKeeper(env, skipUpgradeHeights, s.encCfg.Codec, homeDir, s.baseApp, authority)
+	s.stakingKeeper = &stakingKeeper{
+		validators: []sdk.ValAddress{sdk.ValAddress("validator1"), sdk.ValAddress("validator2"), sdk.ValAddress("validator3")},
+		powers:     []int64{40, 30, 30},
+	}
+	s.upgradeKeeper = keeper.NewKeeper(env, skipUpgradeHeights, s.encCfg.Codec, homeDir, s.baseApp, s.stakingKeeper, authority)
 
 	s.Require().Equal(testCtx.Ctx.Logger().With("module", "x/"+types.ModuleName), s.upgradeKeeper.Logger(testCtx.Ctx))
 	s.T().Log("home dir:", homeDir)
@@ -254,11 +260,40 @@ func (s *KeeperTestSuite) TestIsSkipHeight() {
 	skip := map[int64]bool{skipOne: true}
 	storeService := runtime.NewKVStoreService(s.key)
 	env := runtime.NewEnvironment(storeService, log.NewNopLogger())
-	upgradeKeeper := keeper.NewKeeper(env, skip, s.encCfg.Codec, s.T().TempDir(), s.baseApp, s.encodedAuthority)
+	upgradeKeeper := keeper.NewKeeper(env, skip, s.encCfg.Codec, s.T().TempDir(), s.baseApp, s.stakingKeeper, s.encodedAuthority)
 	s.Require().True(upgradeKeeper.IsSkipHeight(9))
 	s.Require().False(upgradeKeeper.IsSkipHeight(10))
 }
 
+func (s *KeeperTestSuite) TestIsSkipRecorded() {
+	var skipOne int64 = 11
+	ok, err := s.upgradeKeeper.IsSkipRecorded(s.ctx, skipOne)
+	s.Require().NoError(err)
+	s.Require().False(ok)
+
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "skip", Height: skipOne}))
+	for _, valAddr := range s.stakingKeeper.validators[:2] {
+		_, err = s.upgradeKeeper.SignalSkipUpgrade(s.ctx, valAddr, skipOne)
+		s.Require().NoError(err)
+	}
+
+	ok, err = s.upgradeKeeper.IsSkipRecorded(s.ctx, skipOne)
+	s.Require().NoError(err)
+	s.Require().True(ok)
+	ok, err = s.upgradeKeeper.IsSkipRecorded(s.ctx, 10)
+	s.Require().NoError(err)
+	s.Require().False(ok)
+
+	// rescheduling the upgrade drops the signals given for the previous plan
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "fixed", Height: skipOne}))
+	ok, err = s.upgradeKeeper.IsSkipRecorded(s.ctx, skipOne)
+	s.Require().NoError(err)
+	s.Require().False(ok)
+	signals, err := s.upgradeKeeper.GetSkipSignals(s.ctx, skipOne)
+	s.Require().NoError(err)
+	s.Require().Empty(signals)
+}
+
 func (s *KeeperTestSuite) TestUpgradedConsensusState() {
 	cs := []byte("IBC consensus state")
 	s.Require().NoError(s.upgradeKeeper.SetUpgradedConsensusState(s.ctx, 10, cs))
@@ -431,3 +466,23 @@ func (ps paramStore) Has(_ context.Context) (bool, error) {
 func (ps paramStore) Get(_ context.Context) (cmtproto.ConsensusParams, error) {
 	return ps.params, nil
 }
+
+type stakingKeeper struct {
+	validators []sdk.ValAddress
+	powers     []int64
+}
+
+var _ types.StakingKeeper = (*stakingKeeper)(nil)
+
+func (sk stakingKeeper) ValidatorAddressCodec() address.Codec {
+	return addresscodec.NewBech32Codec("cosmosvaloper")
+}
+
+func (sk stakingKeeper) IterateLastValidatorPowers(_ context.Context, handler func(operator sdk.ValAddress, power int64) (stop bool)) error {
+	for i, valAddr := range sk.validators {
+		if handler(valAddr, sk.powers[i]) {
+			break
+		}
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/x/upgrade/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 type msgServer struct {
@@ -24,6 +25,7 @@ func NewMsgServerImpl(k *Keeper) types.MsgServer {
 var (
 	_    types.MsgServer = msgServer{}
 	_, _ sdk.Msg         = &types.MsgSoftwareUpgrade{}, &types.MsgCancelUpgrade{}
+	_    sdk.Msg         = &types.MsgSignalSkipUpgrade{}
 )
 
 // SoftwareUpgrade implements the Msg/SoftwareUpgrade Msg service.
@@ -53,3 +55,22 @@ func (k msgServer) CancelUpgrade(ctx context.Context, msg *types.MsgCancelUpgrad
 
 	return &types.MsgCancelUpgradeResponse{}, nil
 }
+
+// SignalSkipUpgrade implements the Msg/SignalSkipUpgrade Msg service.
+func (k msgServer) SignalSkipUpgrade(ctx context.Context, msg *types.MsgSignalSkipUpgrade) (*types.MsgSignalSkipUpgradeResponse, error) {
+	if k.stakingKeeper == nil {
+		return nil, errors.Wrap(sdkerrors.ErrLogic, "staking keeper is not set")
+	}
+
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	skipped, err := k.Keeper.SignalSkipUpgrade(ctx, valAddr, msg.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSignalSkipUpgradeResponse{Skipped: skipped}, nil
+}
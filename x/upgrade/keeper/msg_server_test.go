@@ -130,3 +130,120 @@ func (s *KeeperTestSuite) TestCancelUpgrade() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestSignalSkipUpgrade() {
+	err := s.upgradeKeeper.ScheduleUpgrade(s.ctx, types.Plan{
+		Name:   "some name",
+		Info:   "some info",
+		Height: 20,
+	})
+	s.Require().NoError(err)
+
+	valAddrs := make([]string, len(s.stakingKeeper.validators))
+	for i, valAddr := range s.stakingKeeper.validators {
+		valAddrs[i], err = s.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+		s.Require().NoError(err)
+	}
+
+	unbonded, err := s.stakingKeeper.ValidatorAddressCodec().BytesToString(s.addrs[0])
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name       string
+		req        *types.MsgSignalSkipUpgrade
+		expectErr  bool
+		errMsg     string
+		expSkipped bool
+	}{
+		{
+			"invalid validator address",
+			&types.MsgSignalSkipUpgrade{
+				ValidatorAddress: "validator",
+				Height:           20,
+			},
+			true,
+			"invalid validator address",
+			false,
+		},
+		{
+			"no upgrade scheduled at height",
+			&types.MsgSignalSkipUpgrade{
+				ValidatorAddress: valAddrs[0],
+				Height:           21,
+			},
+			true,
+			"no upgrade is scheduled at height 21",
+			false,
+		},
+		{
+			"validator not bonded",
+			&types.MsgSignalSkipUpgrade{
+				ValidatorAddress: unbonded,
+				Height:           20,
+			},
+			true,
+			"validator is not bonded",
+			false,
+		},
+		{
+			"signal below supermajority",
+			&types.MsgSignalSkipUpgrade{
+				ValidatorAddress: valAddrs[0],
+				Height:           20,
+			},
+			false,
+			"",
+			false,
+		},
+		{
+			"duplicate signal",
+			&types.MsgSignalSkipUpgrade{
+				ValidatorAddress: valAddrs[0],
+				Height:           20,
+			},
+			true,
+			"validator already signalled to skip upgrade",
+			false,
+		},
+		{
+			"signal reaching supermajority",
+			&types.MsgSignalSkipUpgrade{
+				ValidatorAddress: valAddrs[1],
+				Height:           20,
+			},
+			false,
+			"",
+			true,
+		},
+		{
+			"signal after supermajority",
+			&types.MsgSignalSkipUpgrade{
+				ValidatorAddress: valAddrs[2],
+				Height:           20,
+			},
+			false,
+			"",
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := s.msgSrvr.SignalSkipUpgrade(s.ctx, tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expSkipped, res.Skipped)
+				skip, err := s.upgradeKeeper.IsSkipRecorded(s.ctx, 20)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expSkipped, skip)
+			}
+		})
+	}
+
+	s.Require().NoError(s.upgradeKeeper.ClearUpgradePlan(s.ctx))
+	skip, err := s.upgradeKeeper.IsSkipRecorded(s.ctx, 20)
+	s.Require().NoError(err)
+	s.Require().False(skip)
+}
@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SignalSkipUpgrade records the signal of a bonded validator to skip the upgrade
+// scheduled at the given height. Once validators holding more than 2/3 of the last
+// bonded power have signalled, the height is recorded as skipped and the PreBlocker
+// clears the plan at that height instead of halting the chain. It returns true if
+// the upgrade is recorded as skipped.
+func (k Keeper) SignalSkipUpgrade(ctx context.Context, valAddr sdk.ValAddress, height int64) (bool, error) {
+	if k.stakingKeeper == nil {
+		return false, errorsmod.Wrap(sdkerrors.ErrLogic, "staking keeper is not set")
+	}
+
+	plan, err := k.GetUpgradePlan(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	if plan.Height != height {
+		return false, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "no upgrade is scheduled at height %d; %s is scheduled at %d", height, plan.Name, plan.Height)
+	}
+
+	// the PreBlocker of the upgrade height runs before any transaction of that block
+	if height <= k.environment.HeaderService.GetHeaderInfo(ctx).Height {
+		return false, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "upgrade %s at height %d can no longer be skipped", plan.Name, height)
+	}
+
+	store := k.environment.KVStoreService.OpenKVStore(ctx)
+	has, err := store.Has(types.SkipSignalKey(height, valAddr))
+	if err != nil {
+		return false, err
+	}
+
+	if has {
+		return false, types.ErrDuplicateSkipSignal
+	}
+
+	valAddrStr, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	if err != nil {
+		return false, err
+	}
+
+	var (
+		valPower, signalledPower, totalPower int64
+		innerErr                             error
+	)
+	err = k.stakingKeeper.IterateLastValidatorPowers(ctx, func(operator sdk.ValAddress, power int64) bool {
+		totalPower += power
+		if operator.Equals(valAddr) {
+			valPower = power
+			signalledPower += power
+			return false
+		}
+
+		signalled, err := store.Has(types.SkipSignalKey(height, operator))
+		if err != nil {
+			innerErr = err
+			return true
+		}
+
+		if signalled {
+			signalledPower += power
+		}
+		return false
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if innerErr != nil {
+		return false, innerErr
+	}
+
+	if valPower <= 0 {
+		return false, errorsmod.Wrapf(types.ErrNotBondedValidator, "validator %s", valAddrStr)
+	}
+
+	if err := store.Set(types.SkipSignalKey(height, valAddr), []byte{1}); err != nil {
+		return false, err
+	}
+
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSignalSkipUpgrade,
+		event.NewAttribute(types.AttributeKeyValidator, valAddrStr),
+		event.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(height, 10)),
+	); err != nil {
+		return false, err
+	}
+
+	skip, err := k.IsSkipRecorded(ctx, height)
+	if err != nil {
+		return false, err
+	}
+
+	// a supermajority is strictly more than 2/3 of the last bonded power, CometBFT
+	// bounds the total voting power so that this cannot overflow
+	if skip || signalledPower*3 <= totalPower*2 {
+		return skip, nil
+	}
+
+	if err := store.Set(types.SkipHeightKey(height), []byte{1}); err != nil {
+		return false, err
+	}
+
+	k.Logger(ctx).Info("upgrade skipped by validator signal", "name", plan.Name, "height", height)
+
+	if err := k.environment.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSkipUpgrade,
+		event.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(height, 10)),
+	); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// IsSkipRecorded checks if the upgrade at the given height has been recorded as skipped
+// by a supermajority of validator signals.
+func (k Keeper) IsSkipRecorded(ctx context.Context, height int64) (bool, error) {
+	store := k.environment.KVStoreService.OpenKVStore(ctx)
+	return store.Has(types.SkipHeightKey(height))
+}
+
+// GetSkipSignals returns the operator addresses of the validators that signalled to
+// skip the upgrade at the given height.
+func (k Keeper) GetSkipSignals(ctx context.Context, height int64) ([]sdk.ValAddress, error) {
+	store := k.environment.KVStoreService.OpenKVStore(ctx)
+	prefix := types.SkipSignalPrefix(height)
+	it, err := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var validators []sdk.ValAddress
+	for ; it.Valid(); it.Next() {
+		validators = append(validators, sdk.ValAddress(it.Key()[len(prefix):]))
+	}
+
+	return validators, nil
+}
+
+// clearSkipSignals removes the validator signals and the skip record for the upgrade
+// at the given height.
+func (k Keeper) clearSkipSignals(ctx context.Context, height int64) error {
+	validators, err := k.GetSkipSignals(ctx, height)
+	if err != nil {
+		return err
+	}
+
+	store := k.environment.KVStoreService.OpenKVStore(ctx)
+	for _, valAddr := range validators {
+		if err := store.Delete(types.SkipSignalKey(height, valAddr)); err != nil {
+			return err
+		}
+	}
+
+	return store.Delete(types.SkipHeightKey(height))
+}
@@ -16,4 +16,8 @@ var (
 	ErrNoUpgradedConsensusStateFound = errors.Register(ModuleName, 5, "upgraded consensus state not found")
 	// ErrInvalidSigner error if the authority is not the signer for a proposal message
 	ErrInvalidSigner = errors.Register(ModuleName, 6, "expected authority account as only signer for proposal message")
+	// ErrNotBondedValidator error if the signer of a skip signal is not part of the last bonded validator set
+	ErrNotBondedValidator = errors.Register(ModuleName, 7, "validator is not bonded")
+	// ErrDuplicateSkipSignal error if a validator already signalled to skip an upgrade
+	ErrDuplicateSkipSignal = errors.Register(ModuleName, 8, "validator already signalled to skip upgrade")
 )
@@ -0,0 +1,10 @@
+package types
+
+// upgrade module event types
+const (
+	EventTypeSignalSkipUpgrade = "signal_skip_upgrade"
+	EventTypeSkipUpgrade       = "skip_upgrade"
+
+	AttributeKeyValidator = "validator"
+	AttributeKeyHeight    = "height"
+)
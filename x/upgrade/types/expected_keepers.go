@@ -0,0 +1,16 @@
+package types
+
+import (
+	"context"
+
+	"cosmossdk.io/core/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StakingKeeper defines the expected staking keeper used to weigh validator
+// signals to skip an upgrade.
+type StakingKeeper interface {
+	ValidatorAddressCodec() address.Codec
+	IterateLastValidatorPowers(ctx context.Context, handler func(operator sdk.ValAddress, power int64) (stop bool)) error
+}
@@ -1,6 +1,9 @@
 package types
 
-import "fmt"
+import (
+	"encoding/binary"
+	"fmt"
+)
 
 const (
 	// ModuleName is the name of this module
@@ -28,6 +31,13 @@ const (
 	// VersionMapByte is a prefix to look up module names (key) and versions (value)
 	VersionMapByte = 0x2
 
+	// SkipSignalByte is a prefix to look up validator signals to skip the upgrade at a height.
+	// 0x3 was used by the legacy protocol version and is not reused.
+	SkipSignalByte = 0x4
+
+	// SkipHeightByte is a prefix to look up heights whose upgrade was skipped by validator signal
+	SkipHeightByte = 0x5
+
 	// KeyUpgradedIBCState is the key under which upgraded ibc state is stored in the upgrade store
 	KeyUpgradedIBCState = "upgradedIBCState"
 
@@ -44,6 +54,30 @@ func PlanKey() []byte {
 	return []byte{PlanByte}
 }
 
+// SkipSignalPrefix is the prefix under which all validator signals to skip the upgrade
+// at the given height are saved
+func SkipSignalPrefix(height int64) []byte {
+	key := make([]byte, 9)
+	key[0] = SkipSignalByte
+	binary.BigEndian.PutUint64(key[1:], uint64(height))
+	return key
+}
+
+// SkipSignalKey is the key under which the signal of a validator to skip the upgrade at
+// the given height is saved
+func SkipSignalKey(height int64, valAddr []byte) []byte {
+	return append(SkipSignalPrefix(height), valAddr...)
+}
+
+// SkipHeightKey is the key under which the on-chain record to skip the upgrade at the
+// given height is saved
+func SkipHeightKey(height int64) []byte {
+	key := make([]byte, 9)
+	key[0] = SkipHeightByte
+	binary.BigEndian.PutUint64(key[1:], uint64(height))
+	return key
+}
+
 // UpgradedClientKey is the key under which the upgraded client state is saved
 // Connecting IBC chains can verify against the upgraded client in this path before
 // upgrading their clients
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -492,6 +493,110 @@ func (m *QueryAuthorityResponse) GetAddress() string {
 	return ""
 }
 
+// QuerySkipUpgradeRequest is the request type for the Query/SkipUpgrade RPC
+// method.
+type QuerySkipUpgradeRequest struct {
+	// height is the height of the upgrade to query skip signals for.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QuerySkipUpgradeRequest) Reset()         { *m = QuerySkipUpgradeRequest{} }
+func (m *QuerySkipUpgradeRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySkipUpgradeRequest) ProtoMessage()    {}
+func (*QuerySkipUpgradeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{10}
+}
+func (m *QuerySkipUpgradeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySkipUpgradeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySkipUpgradeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySkipUpgradeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySkipUpgradeRequest.Merge(m, src)
+}
+func (m *QuerySkipUpgradeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySkipUpgradeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySkipUpgradeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySkipUpgradeRequest proto.InternalMessageInfo
+
+func (m *QuerySkipUpgradeRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QuerySkipUpgradeResponse is the response type for the Query/SkipUpgrade RPC
+// method.
+type QuerySkipUpgradeResponse struct {
+	// skip is true if the upgrade at the given height is recorded as skipped.
+	Skip bool `protobuf:"varint,1,opt,name=skip,proto3" json:"skip,omitempty"`
+	// validators are the operator addresses of the validators that signalled
+	// to skip the upgrade at the given height.
+	Validators []string `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (m *QuerySkipUpgradeResponse) Reset()         { *m = QuerySkipUpgradeResponse{} }
+func (m *QuerySkipUpgradeResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySkipUpgradeResponse) ProtoMessage()    {}
+func (*QuerySkipUpgradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{11}
+}
+func (m *QuerySkipUpgradeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySkipUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySkipUpgradeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySkipUpgradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySkipUpgradeResponse.Merge(m, src)
+}
+func (m *QuerySkipUpgradeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySkipUpgradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySkipUpgradeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySkipUpgradeResponse proto.InternalMessageInfo
+
+func (m *QuerySkipUpgradeResponse) GetSkip() bool {
+	if m != nil {
+		return m.Skip
+	}
+	return false
+}
+
+func (m *QuerySkipUpgradeResponse) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryCurrentPlanRequest)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanRequest")
 	proto.RegisterType((*QueryCurrentPlanResponse)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanResponse")
@@ -503,6 +608,8 @@ func init() {
 	proto.RegisterType((*QueryModuleVersionsResponse)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsResponse")
 	proto.RegisterType((*QueryAuthorityRequest)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityRequest")
 	proto.RegisterType((*QueryAuthorityResponse)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityResponse")
+	proto.RegisterType((*QuerySkipUpgradeRequest)(nil), "cosmos.upgrade.v1beta1.QuerySkipUpgradeRequest")
+	proto.RegisterType((*QuerySkipUpgradeResponse)(nil), "cosmos.upgrade.v1beta1.QuerySkipUpgradeResponse")
 }
 
 func init() {
@@ -510,47 +617,54 @@ func init() {
 }
 
 var fileDescriptor_4a334d07ad8374f0 = []byte{
-	// 632 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x41, 0x4f, 0xd4, 0x40,
-	0x14, 0x66, 0x16, 0x44, 0x79, 0x6b, 0xd0, 0x4c, 0xe2, 0x52, 0x2b, 0x59, 0x71, 0x40, 0x85, 0x28,
-	0x1d, 0x58, 0x12, 0x63, 0x30, 0x1a, 0x95, 0x8b, 0x18, 0x25, 0xba, 0x46, 0x0f, 0x5e, 0x36, 0x03,
-	0x9d, 0x2c, 0x8d, 0xdd, 0x4e, 0xe9, 0x4c, 0x89, 0x84, 0x70, 0xf1, 0xe4, 0xd1, 0xc4, 0x78, 0xf5,
-	0xe6, 0xc5, 0x5f, 0xe2, 0x91, 0xc4, 0x8b, 0x07, 0x0f, 0x06, 0xfc, 0x09, 0xfe, 0x00, 0xd3, 0xe9,
-	0x94, 0x74, 0xa1, 0x2d, 0xe8, 0x6d, 0x3b, 0xef, 0xfb, 0xbe, 0xf7, 0xbd, 0xce, 0xf7, 0xb6, 0x40,
-	0xd6, 0x84, 0xec, 0x09, 0x49, 0xe3, 0xb0, 0x1b, 0x31, 0x97, 0xd3, 0xcd, 0xf9, 0x55, 0xae, 0xd8,
-	0x3c, 0xdd, 0x88, 0x79, 0xb4, 0xe5, 0x84, 0x91, 0x50, 0x02, 0x37, 0x52, 0x8c, 0x63, 0x30, 0x8e,
-	0xc1, 0xd8, 0xe3, 0x5d, 0x21, 0xba, 0x3e, 0xa7, 0x2c, 0xf4, 0x28, 0x0b, 0x02, 0xa1, 0x98, 0xf2,
-	0x44, 0x20, 0x53, 0x96, 0x3d, 0x55, 0xa2, 0x9c, 0xa9, 0x68, 0x14, 0xb9, 0x08, 0x63, 0xcf, 0x93,
-	0x56, 0x4b, 0x71, 0x14, 0xf1, 0x40, 0x3d, 0xf3, 0x59, 0xd0, 0xe6, 0x1b, 0x31, 0x97, 0x8a, 0x3c,
-	0x01, 0xeb, 0x68, 0x49, 0x86, 0x22, 0x90, 0x1c, 0xcf, 0xc1, 0x50, 0xe8, 0xb3, 0xc0, 0x42, 0x13,
-	0x68, 0xba, 0xde, 0x1a, 0x77, 0x8a, 0x1d, 0x3a, 0x9a, 0xa3, 0x91, 0x64, 0xd6, 0x34, 0x7a, 0x10,
-	0x86, 0xbe, 0xc7, 0xdd, 0x5c, 0x23, 0x8c, 0x61, 0x28, 0x60, 0x3d, 0xae, 0xc5, 0x46, 0xda, 0xfa,
-	0x37, 0x69, 0x99, 0xe6, 0x7d, 0x70, 0xd3, 0xbc, 0x01, 0xc3, 0xeb, 0xdc, 0xeb, 0xae, 0x2b, 0xcd,
-	0x18, 0x6c, 0x9b, 0x27, 0xb2, 0x0c, 0x44, 0x73, 0x5e, 0xa6, 0x2e, 0xdc, 0xa5, 0x04, 0x1d, 0xc8,
-	0x58, 0xbe, 0x50, 0x4c, 0xf1, 0xac, 0xdb, 0x65, 0xa8, 0xfb, 0x4c, 0xaa, 0x4e, 0x9f, 0x04, 0x24,
-	0x47, 0x8f, 0xf4, 0xc9, 0x62, 0xcd, 0x42, 0xc4, 0x83, 0xc9, 0x4a, 0x29, 0xe3, 0xe4, 0x36, 0x58,
-	0x66, 0x64, 0xb7, 0xb3, 0x96, 0x41, 0x3a, 0x32, 0xc1, 0x58, 0xb5, 0x09, 0x34, 0x7d, 0xb6, 0xdd,
-	0x88, 0x0b, 0x15, 0x92, 0x26, 0x8f, 0x87, 0xce, 0xa0, 0xf3, 0x35, 0x72, 0x17, 0x6c, 0xdd, 0xea,
-	0xa9, 0x70, 0x63, 0x9f, 0xbf, 0xe2, 0x91, 0x4c, 0x2e, 0x31, 0xe7, 0xb6, 0xa7, 0x0b, 0x9d, 0xdc,
-	0x2b, 0x82, 0xf4, 0x68, 0x25, 0x79, 0x51, 0x3d, 0xb8, 0x54, 0x48, 0x37, 0x0e, 0x57, 0xe0, 0x9c,
-	0xe1, 0x6f, 0x9a, 0x92, 0x85, 0x26, 0x06, 0xa7, 0xeb, 0xad, 0xab, 0x65, 0x77, 0xd6, 0x27, 0xd4,
-	0x1e, 0xed, 0xf5, 0xe9, 0x92, 0x31, 0xb8, 0x90, 0xde, 0x4b, 0xac, 0xd6, 0x45, 0xe4, 0xa9, 0xad,
-	0x2c, 0x2d, 0x2d, 0x68, 0x1c, 0x2e, 0x18, 0x0b, 0x16, 0x9c, 0x66, 0xae, 0x1b, 0x71, 0x29, 0x8d,
-	0xfd, 0xec, 0xb1, 0xf5, 0x67, 0x18, 0x4e, 0x69, 0x12, 0xfe, 0x8c, 0xa0, 0x9e, 0xcb, 0x19, 0xa6,
-	0x65, 0xee, 0x4a, 0xc2, 0x6a, 0xcf, 0x9d, 0x9c, 0x90, 0xda, 0x22, 0x37, 0xdf, 0x7d, 0xff, 0xfd,
-	0xb1, 0x76, 0x0d, 0x4f, 0xd1, 0x92, 0x45, 0x59, 0x4b, 0x49, 0x9d, 0x24, 0xbe, 0xf8, 0x0b, 0x82,
-	0x7a, 0x2e, 0x8b, 0xc7, 0x18, 0x3c, 0x1a, 0xf2, 0x63, 0x0c, 0x16, 0xc4, 0x9c, 0x2c, 0x68, 0x83,
-	0xb3, 0xf8, 0x46, 0x99, 0x41, 0x96, 0x92, 0xb4, 0x41, 0xba, 0x9d, 0xe4, 0x63, 0x07, 0xff, 0x44,
-	0xd0, 0x28, 0x0e, 0x2d, 0x5e, 0xac, 0x74, 0x50, 0xb9, 0x34, 0xf6, 0x9d, 0xff, 0xe2, 0x9a, 0x41,
-	0x96, 0xf5, 0x20, 0xf7, 0xf1, 0x3d, 0x5a, 0xfd, 0x97, 0x74, 0x64, 0x87, 0xe8, 0x76, 0x6e, 0x53,
-	0x77, 0xde, 0xd7, 0x10, 0xfe, 0x8a, 0x60, 0xb4, 0x3f, 0xe9, 0xb8, 0x55, 0x69, 0xad, 0x70, 0xab,
-	0xec, 0x85, 0x7f, 0xe2, 0x98, 0x31, 0xa8, 0x1e, 0x63, 0x06, 0x5f, 0x2f, 0x1b, 0xe3, 0xd0, 0xa2,
-	0xe1, 0x4f, 0x08, 0x46, 0x0e, 0xd6, 0x01, 0xcf, 0x56, 0x07, 0xe0, 0xd0, 0x3e, 0xd9, 0xce, 0x49,
-	0xe1, 0xc6, 0xdd, 0x8c, 0x76, 0x37, 0x89, 0xaf, 0x94, 0xa6, 0x25, 0xa3, 0x3c, 0xbc, 0xf5, 0x6d,
-	0xaf, 0x89, 0x76, 0xf7, 0x9a, 0xe8, 0xd7, 0x5e, 0x13, 0x7d, 0xd8, 0x6f, 0x0e, 0xec, 0xee, 0x37,
-	0x07, 0x7e, 0xec, 0x37, 0x07, 0x5e, 0x8f, 0xa7, 0x5c, 0xe9, 0xbe, 0x71, 0x3c, 0x41, 0xdf, 0x1e,
-	0x68, 0xa8, 0xad, 0x90, 0xcb, 0xd5, 0x61, 0xfd, 0xc9, 0x58, 0xf8, 0x1b, 0x00, 0x00, 0xff, 0xff,
-	0x28, 0x77, 0x58, 0xe1, 0xb4, 0x06, 0x00, 0x00,
+	// 745 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x41, 0x4f, 0x13, 0x4d,
+	0x18, 0xc7, 0x99, 0xd2, 0x97, 0x17, 0x9e, 0xbe, 0xe1, 0x7d, 0x33, 0xc9, 0x5b, 0x96, 0x15, 0x6b,
+	0x59, 0x50, 0x4b, 0xb4, 0x9d, 0x52, 0xa2, 0x31, 0x18, 0x8d, 0xc0, 0x45, 0x8c, 0x12, 0x2d, 0x91,
+	0x83, 0x97, 0xcd, 0xc0, 0x4e, 0xca, 0x86, 0xed, 0xee, 0xb2, 0x33, 0x4b, 0x24, 0x84, 0x8b, 0x27,
+	0x8f, 0x26, 0xc6, 0x2b, 0x37, 0x63, 0xe2, 0xd9, 0x0f, 0xe1, 0x91, 0xe8, 0xc5, 0x83, 0x07, 0x03,
+	0x7e, 0x10, 0xb3, 0xb3, 0xb3, 0x64, 0x4b, 0xbb, 0xa5, 0x7a, 0xdb, 0x99, 0x79, 0xfe, 0xcf, 0xf3,
+	0x7b, 0x66, 0xe6, 0x3f, 0x0b, 0xc6, 0x96, 0xc7, 0xdb, 0x1e, 0x27, 0xa1, 0xdf, 0x0a, 0xa8, 0xc5,
+	0xc8, 0xde, 0xfc, 0x26, 0x13, 0x74, 0x9e, 0xec, 0x86, 0x2c, 0xd8, 0xaf, 0xf9, 0x81, 0x27, 0x3c,
+	0x5c, 0x8c, 0x63, 0x6a, 0x2a, 0xa6, 0xa6, 0x62, 0xf4, 0xa9, 0x96, 0xe7, 0xb5, 0x1c, 0x46, 0xa8,
+	0x6f, 0x13, 0xea, 0xba, 0x9e, 0xa0, 0xc2, 0xf6, 0x5c, 0x1e, 0xab, 0xf4, 0xd9, 0x8c, 0xcc, 0x49,
+	0x96, 0x38, 0x6a, 0x32, 0x8e, 0x32, 0xe5, 0x88, 0xa8, 0x42, 0x72, 0x60, 0x4c, 0xc2, 0xc4, 0xb3,
+	0x88, 0x62, 0x25, 0x0c, 0x02, 0xe6, 0x8a, 0xa7, 0x0e, 0x75, 0x9b, 0x6c, 0x37, 0x64, 0x5c, 0x18,
+	0x8f, 0x41, 0xeb, 0x5e, 0xe2, 0xbe, 0xe7, 0x72, 0x86, 0xeb, 0x90, 0xf7, 0x1d, 0xea, 0x6a, 0xa8,
+	0x8c, 0x2a, 0x85, 0xc6, 0x54, 0xad, 0x37, 0x7c, 0x4d, 0x6a, 0x64, 0xa4, 0x51, 0x55, 0x85, 0x96,
+	0x7c, 0xdf, 0xb1, 0x99, 0x95, 0x2a, 0x84, 0x31, 0xe4, 0x5d, 0xda, 0x66, 0x32, 0xd9, 0x58, 0x53,
+	0x7e, 0x1b, 0x0d, 0xd0, 0xba, 0xc3, 0x55, 0xf1, 0x22, 0x8c, 0x6c, 0x33, 0xbb, 0xb5, 0x2d, 0xa4,
+	0x62, 0xb8, 0xa9, 0x46, 0xc6, 0x2a, 0x18, 0x52, 0xf3, 0x3c, 0xa6, 0xb0, 0x56, 0xa2, 0x68, 0x97,
+	0x87, 0x7c, 0x5d, 0x50, 0xc1, 0x92, 0x6a, 0x57, 0xa0, 0xe0, 0x50, 0x2e, 0xcc, 0x8e, 0x14, 0x10,
+	0x4d, 0x3d, 0x94, 0x33, 0x8b, 0x39, 0x0d, 0x19, 0x36, 0xcc, 0xf4, 0x4d, 0xa5, 0x48, 0xee, 0x80,
+	0xa6, 0x5a, 0xb6, 0xcc, 0xad, 0x24, 0xc4, 0xe4, 0x51, 0x8c, 0x96, 0x2b, 0xa3, 0xca, 0x3f, 0xcd,
+	0x62, 0xd8, 0x33, 0x43, 0x54, 0xe4, 0x51, 0x7e, 0x14, 0xfd, 0x97, 0x33, 0xee, 0x81, 0x2e, 0x4b,
+	0x3d, 0xf1, 0xac, 0xd0, 0x61, 0x1b, 0x2c, 0xe0, 0xd1, 0xf9, 0xa6, 0x68, 0xdb, 0x72, 0xc1, 0x4c,
+	0x6d, 0x11, 0xc4, 0x53, 0x6b, 0xd1, 0x46, 0xb5, 0xe1, 0x52, 0x4f, 0xb9, 0x22, 0x5c, 0x83, 0x7f,
+	0x95, 0x7e, 0x4f, 0x2d, 0x69, 0xa8, 0x3c, 0x5c, 0x29, 0x34, 0xae, 0x66, 0x9d, 0x59, 0x47, 0xa2,
+	0xe6, 0x78, 0xbb, 0x23, 0xaf, 0x31, 0x01, 0xff, 0xc7, 0xe7, 0x12, 0x8a, 0x6d, 0x2f, 0xb0, 0xc5,
+	0x7e, 0x72, 0x5b, 0x1a, 0x50, 0x3c, 0xbf, 0xa0, 0x10, 0x34, 0xf8, 0x9b, 0x5a, 0x56, 0xc0, 0x38,
+	0x57, 0xf8, 0xc9, 0xd0, 0x98, 0x57, 0x77, 0x62, 0x7d, 0xc7, 0xf6, 0xd5, 0x4e, 0x27, 0x7d, 0x67,
+	0x9d, 0xf1, 0x2e, 0x68, 0xdd, 0x12, 0x55, 0x08, 0x43, 0x9e, 0xef, 0xd8, 0xbe, 0x54, 0x8c, 0x36,
+	0xe5, 0x37, 0x5e, 0x02, 0xd8, 0xa3, 0x8e, 0x6d, 0x51, 0xe1, 0x05, 0x5c, 0xcb, 0x95, 0x87, 0x2b,
+	0x63, 0xcb, 0xd3, 0x5f, 0x3e, 0x55, 0x2f, 0xab, 0xee, 0x37, 0x92, 0xc5, 0xa5, 0x98, 0x69, 0x5d,
+	0x04, 0xb6, 0xdb, 0x6a, 0xa6, 0x44, 0x8d, 0xa3, 0x51, 0xf8, 0x4b, 0xd6, 0xc4, 0x47, 0x08, 0x0a,
+	0x29, 0x37, 0x60, 0x92, 0xb5, 0x87, 0x19, 0x96, 0xd2, 0xeb, 0x83, 0x0b, 0xe2, 0x9e, 0x8c, 0x9b,
+	0xaf, 0xbe, 0xfe, 0x7c, 0x9b, 0xbb, 0x86, 0x67, 0x49, 0x86, 0xd3, 0xb7, 0x62, 0x91, 0x19, 0x99,
+	0x0c, 0xbf, 0x47, 0x50, 0x48, 0x39, 0xe6, 0x02, 0xc0, 0x6e, 0x2b, 0xea, 0xf5, 0xc1, 0x05, 0x0a,
+	0x70, 0x41, 0x02, 0x56, 0xf1, 0x8d, 0x2c, 0x40, 0x1a, 0x8b, 0x24, 0x20, 0x39, 0x88, 0x6e, 0xf1,
+	0x21, 0xfe, 0x8e, 0xa0, 0xd8, 0xdb, 0x5a, 0x78, 0xb1, 0x2f, 0x41, 0x5f, 0x6b, 0xeb, 0x77, 0xff,
+	0x48, 0xab, 0x1a, 0x59, 0x95, 0x8d, 0x3c, 0xc0, 0xf7, 0x49, 0xff, 0x37, 0xb5, 0xcb, 0xe9, 0xe4,
+	0x20, 0xf5, 0x9e, 0x1c, 0xbe, 0xce, 0x21, 0xfc, 0x11, 0xc1, 0x78, 0xa7, 0x1f, 0x71, 0xa3, 0x2f,
+	0x5a, 0x4f, 0xef, 0xeb, 0x0b, 0xbf, 0xa5, 0x51, 0x6d, 0x10, 0xd9, 0xc6, 0x1c, 0xbe, 0x9e, 0xd5,
+	0xc6, 0xb9, 0xe7, 0x00, 0xbf, 0x43, 0x30, 0x76, 0x66, 0x5a, 0x5c, 0xed, 0x7f, 0x01, 0xce, 0xb9,
+	0x5e, 0xaf, 0x0d, 0x1a, 0xae, 0xe8, 0xe6, 0x24, 0xdd, 0x0c, 0x9e, 0xce, 0xbc, 0x2d, 0x67, 0x24,
+	0x1f, 0x10, 0x14, 0x52, 0x2e, 0xbf, 0xe0, 0x2e, 0x77, 0x3f, 0x21, 0x7a, 0x7d, 0x70, 0x81, 0xa2,
+	0xbb, 0x25, 0xe9, 0x08, 0xae, 0x66, 0xd1, 0x45, 0x4f, 0x8a, 0x99, 0x4c, 0x1e, 0xa8, 0x13, 0x5f,
+	0xbe, 0xfd, 0xf9, 0xa4, 0x84, 0x8e, 0x4f, 0x4a, 0xe8, 0xc7, 0x49, 0x09, 0xbd, 0x39, 0x2d, 0x0d,
+	0x1d, 0x9f, 0x96, 0x86, 0xbe, 0x9d, 0x96, 0x86, 0x5e, 0x4c, 0xc5, 0x79, 0xb8, 0xb5, 0x53, 0xb3,
+	0x3d, 0xf2, 0xf2, 0x2c, 0x9f, 0xd8, 0xf7, 0x19, 0xdf, 0x1c, 0x91, 0xbf, 0xe0, 0x85, 0x5f, 0x03,
+	0x00, 0x1e, 0xf2, 0x67, 0xc2, 0x1f, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -584,6 +698,9 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	Authority(ctx context.Context, in *QueryAuthorityRequest, opts ...grpc.CallOption) (*QueryAuthorityResponse, error)
+	// SkipUpgrade queries the validator signals and the on-chain skip record
+	// for the upgrade scheduled at the given height.
+	SkipUpgrade(ctx context.Context, in *QuerySkipUpgradeRequest, opts ...grpc.CallOption) (*QuerySkipUpgradeResponse, error)
 }
 
 type queryClient struct {
@@ -640,6 +757,15 @@ func (c *queryClient) Authority(ctx context.Context, in *QueryAuthorityRequest,
 	return out, nil
 }
 
+func (c *queryClient) SkipUpgrade(ctx context.Context, in *QuerySkipUpgradeRequest, opts ...grpc.CallOption) (*QuerySkipUpgradeResponse, error) {
+	out := new(QuerySkipUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/SkipUpgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// CurrentPlan queries the current upgrade plan.
@@ -661,6 +787,9 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error)
+	// SkipUpgrade queries the validator signals and the on-chain skip record
+	// for the upgrade scheduled at the given height.
+	SkipUpgrade(context.Context, *QuerySkipUpgradeRequest) (*QuerySkipUpgradeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -682,6 +811,9 @@ func (*UnimplementedQueryServer) ModuleVersions(ctx context.Context, req *QueryM
 func (*UnimplementedQueryServer) Authority(ctx context.Context, req *QueryAuthorityRequest) (*QueryAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authority not implemented")
 }
+func (*UnimplementedQueryServer) SkipUpgrade(ctx context.Context, req *QuerySkipUpgradeRequest) (*QuerySkipUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SkipUpgrade not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -777,6 +909,24 @@ func _Query_Authority_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SkipUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySkipUpgradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SkipUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/SkipUpgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SkipUpgrade(ctx, req.(*QuerySkipUpgradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -801,6 +951,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Authority",
 			Handler:    _Query_Authority_Handler,
 		},
+		{
+			MethodName: "SkipUpgrade",
+			Handler:    _Query_SkipUpgrade_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
@@ -1100,6 +1254,76 @@ func (m *QueryAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySkipUpgradeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySkipUpgradeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySkipUpgradeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySkipUpgradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySkipUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySkipUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Skip {
+		i--
+		if m.Skip {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1233,6 +1457,36 @@ func (m *QueryAuthorityResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySkipUpgradeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QuerySkipUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Skip {
+		n += 2
+	}
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1977,6 +2231,177 @@ func (m *QueryAuthorityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySkipUpgradeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySkipUpgradeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySkipUpgradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySkipUpgradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySkipUpgradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySkipUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skip", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Skip = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
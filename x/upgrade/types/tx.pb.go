@@ -214,42 +214,154 @@ func (m *MsgCancelUpgradeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelUpgradeResponse proto.InternalMessageInfo
 
+// MsgSignalSkipUpgrade is the Msg/SignalSkipUpgrade request type.
+type MsgSignalSkipUpgrade struct {
+	// validator_address is the operator address of the signalling validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// height is the height of the scheduled upgrade plan to skip.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *MsgSignalSkipUpgrade) Reset()         { *m = MsgSignalSkipUpgrade{} }
+func (m *MsgSignalSkipUpgrade) String() string { return proto.CompactTextString(m) }
+func (*MsgSignalSkipUpgrade) ProtoMessage()    {}
+func (*MsgSignalSkipUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2852c16e3ab79fef, []int{4}
+}
+func (m *MsgSignalSkipUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSignalSkipUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSignalSkipUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSignalSkipUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSignalSkipUpgrade.Merge(m, src)
+}
+func (m *MsgSignalSkipUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSignalSkipUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSignalSkipUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSignalSkipUpgrade proto.InternalMessageInfo
+
+func (m *MsgSignalSkipUpgrade) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgSignalSkipUpgrade) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// MsgSignalSkipUpgradeResponse is the Msg/SignalSkipUpgrade response type.
+type MsgSignalSkipUpgradeResponse struct {
+	// skipped is true if this signal brought the signalled power over the
+	// 2/3 threshold and the upgrade is now recorded as skipped.
+	Skipped bool `protobuf:"varint,1,opt,name=skipped,proto3" json:"skipped,omitempty"`
+}
+
+func (m *MsgSignalSkipUpgradeResponse) Reset()         { *m = MsgSignalSkipUpgradeResponse{} }
+func (m *MsgSignalSkipUpgradeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSignalSkipUpgradeResponse) ProtoMessage()    {}
+func (*MsgSignalSkipUpgradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2852c16e3ab79fef, []int{5}
+}
+func (m *MsgSignalSkipUpgradeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSignalSkipUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSignalSkipUpgradeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSignalSkipUpgradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSignalSkipUpgradeResponse.Merge(m, src)
+}
+func (m *MsgSignalSkipUpgradeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSignalSkipUpgradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSignalSkipUpgradeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSignalSkipUpgradeResponse proto.InternalMessageInfo
+
+func (m *MsgSignalSkipUpgradeResponse) GetSkipped() bool {
+	if m != nil {
+		return m.Skipped
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*MsgSoftwareUpgrade)(nil), "cosmos.upgrade.v1beta1.MsgSoftwareUpgrade")
 	proto.RegisterType((*MsgSoftwareUpgradeResponse)(nil), "cosmos.upgrade.v1beta1.MsgSoftwareUpgradeResponse")
 	proto.RegisterType((*MsgCancelUpgrade)(nil), "cosmos.upgrade.v1beta1.MsgCancelUpgrade")
 	proto.RegisterType((*MsgCancelUpgradeResponse)(nil), "cosmos.upgrade.v1beta1.MsgCancelUpgradeResponse")
+	proto.RegisterType((*MsgSignalSkipUpgrade)(nil), "cosmos.upgrade.v1beta1.MsgSignalSkipUpgrade")
+	proto.RegisterType((*MsgSignalSkipUpgradeResponse)(nil), "cosmos.upgrade.v1beta1.MsgSignalSkipUpgradeResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/upgrade/v1beta1/tx.proto", fileDescriptor_2852c16e3ab79fef) }
 
 var fileDescriptor_2852c16e3ab79fef = []byte{
-	// 398 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0x2d, 0x48, 0x2f, 0x4a, 0x4c, 0x49, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x83, 0x28, 0xd0,
-	0x83, 0x2a, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd1, 0x07, 0xb1,
-	0x20, 0xaa, 0xa5, 0x24, 0x21, 0xaa, 0xe3, 0x21, 0x12, 0x50, 0xad, 0x10, 0x29, 0x15, 0x1c, 0x36,
-	0xc1, 0x0c, 0x86, 0xa8, 0x12, 0x87, 0xaa, 0xca, 0x2d, 0x4e, 0xd7, 0x2f, 0x33, 0x04, 0x51, 0x50,
-	0x09, 0xc1, 0xc4, 0xdc, 0xcc, 0xbc, 0x7c, 0x7d, 0x30, 0x09, 0x11, 0x52, 0xda, 0xcd, 0xc8, 0x25,
-	0xe4, 0x5b, 0x9c, 0x1e, 0x9c, 0x9f, 0x56, 0x52, 0x9e, 0x58, 0x94, 0x1a, 0x0a, 0x31, 0x48, 0xc8,
-	0x8c, 0x8b, 0x33, 0xb1, 0xb4, 0x24, 0x23, 0xbf, 0x28, 0xb3, 0xa4, 0x52, 0x82, 0x51, 0x81, 0x51,
-	0x83, 0xd3, 0x49, 0xe2, 0xd2, 0x16, 0x5d, 0x11, 0xa8, 0x6b, 0x1c, 0x53, 0x52, 0x8a, 0x52, 0x8b,
-	0x8b, 0x83, 0x4b, 0x8a, 0x32, 0xf3, 0xd2, 0x83, 0x10, 0x4a, 0x85, 0xac, 0xb9, 0x58, 0x0a, 0x72,
-	0x12, 0xf3, 0x24, 0x98, 0x14, 0x18, 0x35, 0xb8, 0x8d, 0x64, 0xf4, 0xb0, 0x7b, 0x5c, 0x2f, 0x20,
-	0x27, 0x31, 0xcf, 0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x86, 0x15, 0xcf, 0x37, 0x68, 0x31, 0x06, 0x81,
-	0x35, 0x59, 0x19, 0x34, 0x3d, 0xdf, 0xa0, 0x85, 0x30, 0xac, 0xeb, 0xf9, 0x06, 0x2d, 0x59, 0x88,
-	0x01, 0xba, 0xc5, 0x29, 0xd9, 0xfa, 0x98, 0xce, 0x54, 0x92, 0xe1, 0x92, 0xc2, 0x14, 0x0d, 0x4a,
-	0x2d, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x55, 0xaa, 0xe2, 0x12, 0xf0, 0x2d, 0x4e, 0x77, 0x4e, 0xcc,
-	0x4b, 0x4e, 0xcd, 0xa1, 0xd0, 0x63, 0x56, 0x7a, 0x98, 0x6e, 0x93, 0x46, 0x75, 0x1b, 0x8a, 0x3d,
-	0x4a, 0x52, 0x5c, 0x12, 0xe8, 0x62, 0x30, 0x77, 0x19, 0xbd, 0x61, 0xe4, 0x62, 0xf6, 0x2d, 0x4e,
-	0x17, 0x2a, 0xe4, 0xe2, 0x47, 0x0f, 0x77, 0x2d, 0x5c, 0x21, 0x86, 0xe9, 0x4d, 0x29, 0x23, 0xe2,
-	0xd5, 0xc2, 0xac, 0x16, 0xca, 0xe6, 0xe2, 0x45, 0x0d, 0x0f, 0x0d, 0x3c, 0x86, 0xa0, 0xa8, 0x94,
-	0x32, 0x20, 0x56, 0x25, 0xcc, 0x32, 0x29, 0xd6, 0x06, 0x50, 0xe4, 0x3a, 0x99, 0x9d, 0x78, 0x24,
-	0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78,
-	0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x0c, 0xc4, 0xc4, 0xe2, 0x94, 0x6c, 0xbd, 0xcc,
-	0x7c, 0xfd, 0x0a, 0x78, 0xb2, 0x2e, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xa7, 0x50, 0x63,
-	0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x82, 0x8c, 0x8c, 0xfe, 0x5f, 0x03, 0x00, 0x00,
+	// 517 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0x80, 0x9b, 0x0d, 0x06, 0x35, 0x42, 0xac, 0x51, 0x35, 0x42, 0x28, 0xe9, 0x88, 0x38, 0x54,
+	0x15, 0x4d, 0xd6, 0x82, 0x26, 0x54, 0x4e, 0x94, 0x73, 0x11, 0x4a, 0x05, 0x07, 0x2e, 0x93, 0xb7,
+	0x18, 0xd7, 0x4a, 0x1a, 0x87, 0xd8, 0xeb, 0x36, 0x4e, 0x88, 0x23, 0x27, 0x7e, 0x06, 0xc7, 0x1e,
+	0x76, 0x82, 0x3f, 0xb0, 0xe3, 0xb4, 0x13, 0x27, 0x84, 0x5a, 0xa1, 0xfe, 0x0d, 0x94, 0xd8, 0xe9,
+	0x94, 0x64, 0x9b, 0x8a, 0xb8, 0xb4, 0xf5, 0xf3, 0xe7, 0xf7, 0xbe, 0x67, 0xbb, 0x06, 0xf5, 0x3d,
+	0xca, 0x46, 0x94, 0xd9, 0xfb, 0x21, 0x8e, 0xa0, 0x8b, 0xec, 0x71, 0x7b, 0x17, 0x71, 0xd8, 0xb6,
+	0xf9, 0xa1, 0x15, 0x46, 0x94, 0x53, 0x75, 0x43, 0x00, 0x96, 0x04, 0x2c, 0x09, 0xe8, 0x55, 0x4c,
+	0x31, 0x4d, 0x10, 0x3b, 0xfe, 0x25, 0x68, 0xfd, 0x9e, 0xa0, 0x77, 0xc4, 0x84, 0x5c, 0x2a, 0xa6,
+	0x1e, 0x5d, 0x52, 0x29, 0x4d, 0x2c, 0xa8, 0xbb, 0x92, 0x1a, 0x31, 0x6c, 0x8f, 0xdb, 0xf1, 0x97,
+	0x9c, 0xa8, 0xc0, 0x11, 0x09, 0xa8, 0x9d, 0x7c, 0x8a, 0x90, 0xf9, 0x43, 0x01, 0x6a, 0x9f, 0xe1,
+	0x01, 0x7d, 0xcf, 0x0f, 0x60, 0x84, 0xde, 0x88, 0x44, 0xea, 0x36, 0x28, 0xc3, 0x7d, 0x3e, 0xa4,
+	0x11, 0xe1, 0x47, 0x9a, 0xb2, 0xa9, 0x34, 0xca, 0x3d, 0xed, 0xec, 0xb8, 0x55, 0x95, 0x36, 0x2f,
+	0x5c, 0x37, 0x42, 0x8c, 0x0d, 0x78, 0x44, 0x02, 0xec, 0x9c, 0xa3, 0xea, 0x73, 0x70, 0x2d, 0xf4,
+	0x61, 0xa0, 0xad, 0x6c, 0x2a, 0x8d, 0x5b, 0x9d, 0x9a, 0x75, 0x71, 0xe3, 0xd6, 0x6b, 0x1f, 0x06,
+	0xbd, 0xf2, 0xc9, 0xaf, 0x7a, 0xe9, 0xdb, 0x7c, 0xd2, 0x54, 0x9c, 0x64, 0x51, 0x77, 0xeb, 0xf3,
+	0x7c, 0xd2, 0x3c, 0x4f, 0xf6, 0x65, 0x3e, 0x69, 0x3e, 0x10, 0x09, 0x5a, 0xcc, 0xf5, 0xec, 0xa2,
+	0xa6, 0x59, 0x03, 0x7a, 0x31, 0xea, 0x20, 0x16, 0xd2, 0x80, 0x21, 0xf3, 0x23, 0x58, 0xef, 0x33,
+	0xfc, 0x12, 0x06, 0x7b, 0xc8, 0xff, 0xcf, 0xc6, 0xba, 0x56, 0xd1, 0xed, 0x7e, 0xd6, 0x2d, 0x53,
+	0xc7, 0xd4, 0x81, 0x96, 0x8f, 0x2d, 0xbc, 0xbe, 0x2b, 0xa0, 0x1a, 0x6b, 0x13, 0x1c, 0x40, 0x7f,
+	0xe0, 0x91, 0x30, 0x95, 0x7b, 0x05, 0x2a, 0x63, 0xe8, 0x13, 0x17, 0x72, 0x1a, 0xed, 0x40, 0xa1,
+	0x22, 0x25, 0x1f, 0x9e, 0x1d, 0xb7, 0xe4, 0x66, 0x58, 0x6f, 0x53, 0x26, 0x6b, 0xbb, 0x3e, 0xce,
+	0xc5, 0xd5, 0x0d, 0xb0, 0x36, 0x44, 0x04, 0x0f, 0x79, 0x72, 0x1e, 0xab, 0x8e, 0x1c, 0x75, 0xbb,
+	0x71, 0x33, 0xc5, 0x52, 0x71, 0x53, 0xf5, 0xdc, 0x86, 0xe7, 0x1d, 0xcd, 0x67, 0xa0, 0x76, 0x51,
+	0x3c, 0x6d, 0x4e, 0xd5, 0xc0, 0x0d, 0xe6, 0x91, 0x30, 0x44, 0x6e, 0x62, 0x7e, 0xd3, 0x49, 0x87,
+	0x9d, 0x3f, 0x2b, 0x60, 0xb5, 0xcf, 0xb0, 0xfa, 0x01, 0xdc, 0xc9, 0x5f, 0xb7, 0xe6, 0x65, 0x17,
+	0xa5, 0x78, 0xba, 0x7a, 0x67, 0x79, 0x76, 0x21, 0xe5, 0x81, 0xdb, 0xd9, 0x6b, 0xd0, 0xb8, 0x22,
+	0x49, 0x86, 0xd4, 0xb7, 0x96, 0x25, 0x17, 0xc5, 0x0e, 0x40, 0xa5, 0x78, 0xb4, 0x8f, 0xaf, 0xb2,
+	0xce, 0xd3, 0xfa, 0xd3, 0x7f, 0xa1, 0xd3, 0xc2, 0xfa, 0xf5, 0x4f, 0xf1, 0x9f, 0xa9, 0xb7, 0x7d,
+	0x32, 0x35, 0x94, 0xd3, 0xa9, 0xa1, 0xfc, 0x9e, 0x1a, 0xca, 0xd7, 0x99, 0x51, 0x3a, 0x9d, 0x19,
+	0xa5, 0x9f, 0x33, 0xa3, 0xf4, 0xae, 0x26, 0xb2, 0x32, 0xd7, 0xb3, 0x08, 0xb5, 0x0f, 0x17, 0xcf,
+	0x08, 0x3f, 0x0a, 0x11, 0xdb, 0x5d, 0x4b, 0x5e, 0x84, 0x27, 0x7f, 0x07, 0x00, 0x52, 0xc1, 0xc6,
+	0x94, 0xcf, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -273,6 +385,11 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opts ...grpc.CallOption) (*MsgCancelUpgradeResponse, error)
+	// SignalSkipUpgrade defines an operation for a bonded validator to signal
+	// that the currently scheduled upgrade should be skipped. Once validators
+	// holding more than 2/3 of the last bonded power have signalled, the
+	// upgrade is recorded on-chain as skipped.
+	SignalSkipUpgrade(ctx context.Context, in *MsgSignalSkipUpgrade, opts ...grpc.CallOption) (*MsgSignalSkipUpgradeResponse, error)
 }
 
 type msgClient struct {
@@ -301,6 +418,15 @@ func (c *msgClient) CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opt
 	return out, nil
 }
 
+func (c *msgClient) SignalSkipUpgrade(ctx context.Context, in *MsgSignalSkipUpgrade, opts ...grpc.CallOption) (*MsgSignalSkipUpgradeResponse, error) {
+	out := new(MsgSignalSkipUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Msg/SignalSkipUpgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SoftwareUpgrade is a governance operation for initiating a software upgrade.
@@ -312,6 +438,11 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CancelUpgrade(context.Context, *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error)
+	// SignalSkipUpgrade defines an operation for a bonded validator to signal
+	// that the currently scheduled upgrade should be skipped. Once validators
+	// holding more than 2/3 of the last bonded power have signalled, the
+	// upgrade is recorded on-chain as skipped.
+	SignalSkipUpgrade(context.Context, *MsgSignalSkipUpgrade) (*MsgSignalSkipUpgradeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -324,6 +455,9 @@ func (*UnimplementedMsgServer) SoftwareUpgrade(ctx context.Context, req *MsgSoft
 func (*UnimplementedMsgServer) CancelUpgrade(ctx context.Context, req *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelUpgrade not implemented")
 }
+func (*UnimplementedMsgServer) SignalSkipUpgrade(ctx context.Context, req *MsgSignalSkipUpgrade) (*MsgSignalSkipUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalSkipUpgrade not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -365,6 +499,24 @@ func _Msg_CancelUpgrade_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SignalSkipUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSignalSkipUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SignalSkipUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Msg/SignalSkipUpgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SignalSkipUpgrade(ctx, req.(*MsgSignalSkipUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -377,6 +529,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelUpgrade",
 			Handler:    _Msg_CancelUpgrade_Handler,
 		},
+		{
+			MethodName: "SignalSkipUpgrade",
+			Handler:    _Msg_SignalSkipUpgrade_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/tx.proto",
@@ -498,6 +654,74 @@ func (m *MsgCancelUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSignalSkipUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSignalSkipUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignalSkipUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSignalSkipUpgradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSignalSkipUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignalSkipUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Skipped {
+		i--
+		if m.Skipped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -555,6 +779,34 @@ func (m *MsgCancelUpgradeResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSignalSkipUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *MsgSignalSkipUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Skipped {
+		n += 2
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -858,6 +1110,177 @@ func (m *MsgCancelUpgradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSignalSkipUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSignalSkipUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSignalSkipUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSignalSkipUpgradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSignalSkipUpgradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSignalSkipUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skipped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Skipped = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0